@@ -9,15 +9,16 @@ import (
 	"context"
 
 	"github.com/jackc/pgx/v5/pgtype"
+	pg_models "github.com/nucleuscloud/neosync/backend/sql/postgresql/models"
 )
 
 const createAccountApiKey = `-- name: CreateAccountApiKey :one
 INSERT INTO neosync_api.account_api_keys (
-  key_name, key_value, account_id, expires_at, created_by_id, updated_by_id, user_id
+  key_name, key_value, account_id, expires_at, created_by_id, updated_by_id, user_id, scopes
 ) VALUES (
-  $1, $2, $3, $4, $5, $6, $7
+  $1, $2, $3, $4, $5, $6, $7, $8
 )
-RETURNING id, account_id, key_value, created_by_id, updated_by_id, created_at, updated_at, expires_at, key_name, user_id
+RETURNING id, account_id, key_value, created_by_id, updated_by_id, created_at, updated_at, expires_at, key_name, user_id, scopes, last_used_at
 `
 
 type CreateAccountApiKeyParams struct {
@@ -28,6 +29,7 @@ type CreateAccountApiKeyParams struct {
 	CreatedByID pgtype.UUID
 	UpdatedByID pgtype.UUID
 	UserID      pgtype.UUID
+	Scopes      *pg_models.AccountApiKeyScopes
 }
 
 func (q *Queries) CreateAccountApiKey(ctx context.Context, db DBTX, arg CreateAccountApiKeyParams) (NeosyncApiAccountApiKey, error) {
@@ -39,6 +41,7 @@ func (q *Queries) CreateAccountApiKey(ctx context.Context, db DBTX, arg CreateAc
 		arg.CreatedByID,
 		arg.UpdatedByID,
 		arg.UserID,
+		arg.Scopes,
 	)
 	var i NeosyncApiAccountApiKey
 	err := row.Scan(
@@ -52,12 +55,14 @@ func (q *Queries) CreateAccountApiKey(ctx context.Context, db DBTX, arg CreateAc
 		&i.ExpiresAt,
 		&i.KeyName,
 		&i.UserID,
+		&i.Scopes,
+		&i.LastUsedAt,
 	)
 	return i, err
 }
 
 const getAccountApiKeyById = `-- name: GetAccountApiKeyById :one
-SELECT id, account_id, key_value, created_by_id, updated_by_id, created_at, updated_at, expires_at, key_name, user_id from neosync_api.account_api_keys WHERE id = $1
+SELECT id, account_id, key_value, created_by_id, updated_by_id, created_at, updated_at, expires_at, key_name, user_id, scopes, last_used_at from neosync_api.account_api_keys WHERE id = $1
 `
 
 func (q *Queries) GetAccountApiKeyById(ctx context.Context, db DBTX, id pgtype.UUID) (NeosyncApiAccountApiKey, error) {
@@ -74,12 +79,14 @@ func (q *Queries) GetAccountApiKeyById(ctx context.Context, db DBTX, id pgtype.U
 		&i.ExpiresAt,
 		&i.KeyName,
 		&i.UserID,
+		&i.Scopes,
+		&i.LastUsedAt,
 	)
 	return i, err
 }
 
 const getAccountApiKeyByKeyValue = `-- name: GetAccountApiKeyByKeyValue :one
-SELECT id, account_id, key_value, created_by_id, updated_by_id, created_at, updated_at, expires_at, key_name, user_id from neosync_api.account_api_keys WHERE key_value = $1
+SELECT id, account_id, key_value, created_by_id, updated_by_id, created_at, updated_at, expires_at, key_name, user_id, scopes, last_used_at from neosync_api.account_api_keys WHERE key_value = $1
 `
 
 func (q *Queries) GetAccountApiKeyByKeyValue(ctx context.Context, db DBTX, keyValue string) (NeosyncApiAccountApiKey, error) {
@@ -96,12 +103,14 @@ func (q *Queries) GetAccountApiKeyByKeyValue(ctx context.Context, db DBTX, keyVa
 		&i.ExpiresAt,
 		&i.KeyName,
 		&i.UserID,
+		&i.Scopes,
+		&i.LastUsedAt,
 	)
 	return i, err
 }
 
 const getAccountApiKeys = `-- name: GetAccountApiKeys :many
-SELECT aak.id, aak.account_id, aak.key_value, aak.created_by_id, aak.updated_by_id, aak.created_at, aak.updated_at, aak.expires_at, aak.key_name, aak.user_id from neosync_api.account_api_keys aak
+SELECT aak.id, aak.account_id, aak.key_value, aak.created_by_id, aak.updated_by_id, aak.created_at, aak.updated_at, aak.expires_at, aak.key_name, aak.user_id, aak.scopes, aak.last_used_at from neosync_api.account_api_keys aak
 INNER JOIN neosync_api.accounts a on a.id = aak.account_id
 WHERE a.id = $1
 `
@@ -126,6 +135,8 @@ func (q *Queries) GetAccountApiKeys(ctx context.Context, db DBTX, accountid pgty
 			&i.ExpiresAt,
 			&i.KeyName,
 			&i.UserID,
+			&i.Scopes,
+			&i.LastUsedAt,
 		); err != nil {
 			return nil, err
 		}
@@ -165,13 +176,24 @@ func (q *Queries) RemoveAccountApiKey(ctx context.Context, db DBTX, id pgtype.UU
 	return err
 }
 
+const updateAccountApiKeyLastUsedAt = `-- name: UpdateAccountApiKeyLastUsedAt :exec
+UPDATE neosync_api.account_api_keys
+SET last_used_at = CURRENT_TIMESTAMP
+WHERE id = $1
+`
+
+func (q *Queries) UpdateAccountApiKeyLastUsedAt(ctx context.Context, db DBTX, id pgtype.UUID) error {
+	_, err := db.Exec(ctx, updateAccountApiKeyLastUsedAt, id)
+	return err
+}
+
 const updateAccountApiKeyValue = `-- name: UpdateAccountApiKeyValue :one
 UPDATE neosync_api.account_api_keys
 SET key_value = $1,
     expires_at = $2,
     updated_by_id = $3
 WHERE id = $4
-RETURNING id, account_id, key_value, created_by_id, updated_by_id, created_at, updated_at, expires_at, key_name, user_id
+RETURNING id, account_id, key_value, created_by_id, updated_by_id, created_at, updated_at, expires_at, key_name, user_id, scopes, last_used_at
 `
 
 type UpdateAccountApiKeyValueParams struct {
@@ -200,6 +222,8 @@ func (q *Queries) UpdateAccountApiKeyValue(ctx context.Context, db DBTX, arg Upd
 		&i.ExpiresAt,
 		&i.KeyName,
 		&i.UserID,
+		&i.Scopes,
+		&i.LastUsedAt,
 	)
 	return i, err
 }
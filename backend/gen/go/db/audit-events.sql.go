@@ -0,0 +1,124 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.25.0
+// source: audit-events.sql
+
+package db_queries
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const createAuditEvent = `-- name: CreateAuditEvent :one
+INSERT INTO neosync_api.audit_events (
+  account_id, actor_type, actor_id, procedure, resource_id, request_diff, status_code
+) VALUES (
+  $1, $2, $3, $4, $5, $6, $7
+)
+RETURNING id, created_at, account_id, actor_type, actor_id, procedure, resource_id, request_diff, status_code
+`
+
+type CreateAuditEventParams struct {
+	AccountID   pgtype.UUID
+	ActorType   int16
+	ActorID     pgtype.UUID
+	Procedure   string
+	ResourceID  pgtype.Text
+	RequestDiff []byte
+	StatusCode  string
+}
+
+func (q *Queries) CreateAuditEvent(ctx context.Context, db DBTX, arg CreateAuditEventParams) (NeosyncApiAuditEvent, error) {
+	row := db.QueryRow(ctx, createAuditEvent,
+		arg.AccountID,
+		arg.ActorType,
+		arg.ActorID,
+		arg.Procedure,
+		arg.ResourceID,
+		arg.RequestDiff,
+		arg.StatusCode,
+	)
+	var i NeosyncApiAuditEvent
+	err := row.Scan(
+		&i.ID,
+		&i.CreatedAt,
+		&i.AccountID,
+		&i.ActorType,
+		&i.ActorID,
+		&i.Procedure,
+		&i.ResourceID,
+		&i.RequestDiff,
+		&i.StatusCode,
+	)
+	return i, err
+}
+
+const getAuditEvents = `-- name: GetAuditEvents :many
+SELECT id, created_at, account_id, actor_type, actor_id, procedure, resource_id, request_diff, status_code FROM neosync_api.audit_events
+WHERE account_id = $1
+  AND ($2::text IS NULL OR resource_id = $2::text)
+  AND ($3::text IS NULL OR procedure = $3::text)
+  AND ($4::uuid IS NULL OR actor_id = $4::uuid)
+  AND ($5::timestamp IS NULL OR created_at >= $5::timestamp)
+  AND ($6::timestamp IS NULL OR created_at < $6::timestamp)
+  AND (
+    $7::timestamp IS NULL
+    OR (created_at, id) < ($7::timestamp, $8::uuid)
+  )
+ORDER BY created_at DESC, id DESC
+LIMIT $9
+`
+
+type GetAuditEventsParams struct {
+	AccountId       pgtype.UUID
+	ResourceId      pgtype.Text
+	Procedure       pgtype.Text
+	ActorId         pgtype.UUID
+	StartTime       pgtype.Timestamp
+	EndTime         pgtype.Timestamp
+	CursorCreatedAt pgtype.Timestamp
+	CursorId        pgtype.UUID
+	Limit           int32
+}
+
+func (q *Queries) GetAuditEvents(ctx context.Context, db DBTX, arg GetAuditEventsParams) ([]NeosyncApiAuditEvent, error) {
+	rows, err := db.Query(ctx, getAuditEvents,
+		arg.AccountId,
+		arg.ResourceId,
+		arg.Procedure,
+		arg.ActorId,
+		arg.StartTime,
+		arg.EndTime,
+		arg.CursorCreatedAt,
+		arg.CursorId,
+		arg.Limit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []NeosyncApiAuditEvent
+	for rows.Next() {
+		var i NeosyncApiAuditEvent
+		if err := rows.Scan(
+			&i.ID,
+			&i.CreatedAt,
+			&i.AccountID,
+			&i.ActorType,
+			&i.ActorID,
+			&i.Procedure,
+			&i.ResourceID,
+			&i.RequestDiff,
+			&i.StatusCode,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
@@ -116,6 +116,48 @@ func (q *Queries) GetConnectionByNameAndAccount(ctx context.Context, db DBTX, ar
 	return i, err
 }
 
+const getConnectionsBatchForUpdate = `-- name: GetConnectionsBatchForUpdate :many
+SELECT id, created_at, updated_at, name, account_id, connection_config, created_by_id, updated_by_id from neosync_api.connections
+WHERE id > $1
+ORDER BY id
+LIMIT $2
+FOR UPDATE
+`
+
+type GetConnectionsBatchForUpdateParams struct {
+	AfterId   pgtype.UUID
+	BatchSize int32
+}
+
+func (q *Queries) GetConnectionsBatchForUpdate(ctx context.Context, db DBTX, arg GetConnectionsBatchForUpdateParams) ([]NeosyncApiConnection, error) {
+	rows, err := db.Query(ctx, getConnectionsBatchForUpdate, arg.AfterId, arg.BatchSize)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []NeosyncApiConnection
+	for rows.Next() {
+		var i NeosyncApiConnection
+		if err := rows.Scan(
+			&i.ID,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.Name,
+			&i.AccountID,
+			&i.ConnectionConfig,
+			&i.CreatedByID,
+			&i.UpdatedByID,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const getConnectionsByAccount = `-- name: GetConnectionsByAccount :many
 SELECT c.id, c.created_at, c.updated_at, c.name, c.account_id, c.connection_config, c.created_by_id, c.updated_by_id from neosync_api.connections c
 INNER JOIN neosync_api.accounts a ON a.id = c.account_id
@@ -279,3 +321,19 @@ func (q *Queries) UpdateConnection(ctx context.Context, db DBTX, arg UpdateConne
 	)
 	return i, err
 }
+
+const updateConnectionConfig = `-- name: UpdateConnectionConfig :exec
+UPDATE neosync_api.connections
+SET connection_config = $1
+WHERE id = $2
+`
+
+type UpdateConnectionConfigParams struct {
+	ConnectionConfig *pg_models.ConnectionConfig
+	ID               pgtype.UUID
+}
+
+func (q *Queries) UpdateConnectionConfig(ctx context.Context, db DBTX, arg UpdateConnectionConfigParams) error {
+	_, err := db.Exec(ctx, updateConnectionConfig, arg.ConnectionConfig, arg.ID)
+	return err
+}
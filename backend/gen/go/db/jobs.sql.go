@@ -15,11 +15,12 @@ import (
 const createJob = `-- name: CreateJob :one
 INSERT INTO neosync_api.jobs (
   name, account_id, status, connection_options, mappings,
-  cron_schedule, created_by_id, updated_by_id, workflow_options, sync_options
+  cron_schedule, created_by_id, updated_by_id, workflow_options, sync_options,
+  triggers
 ) VALUES (
-  $1, $2, $3, $4, $5, $6, $7, $8, $9, $10
+  $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11
 )
-RETURNING id, created_at, updated_at, name, account_id, status, connection_options, mappings, cron_schedule, created_by_id, updated_by_id, workflow_options, sync_options
+RETURNING id, created_at, updated_at, name, account_id, status, connection_options, mappings, cron_schedule, created_by_id, updated_by_id, workflow_options, sync_options, triggers
 `
 
 type CreateJobParams struct {
@@ -33,6 +34,7 @@ type CreateJobParams struct {
 	UpdatedByID       pgtype.UUID
 	WorkflowOptions   *pg_models.WorkflowOptions
 	SyncOptions       *pg_models.ActivityOptions
+	Triggers          []*pg_models.JobTrigger
 }
 
 func (q *Queries) CreateJob(ctx context.Context, db DBTX, arg CreateJobParams) (NeosyncApiJob, error) {
@@ -47,6 +49,7 @@ func (q *Queries) CreateJob(ctx context.Context, db DBTX, arg CreateJobParams) (
 		arg.UpdatedByID,
 		arg.WorkflowOptions,
 		arg.SyncOptions,
+		arg.Triggers,
 	)
 	var i NeosyncApiJob
 	err := row.Scan(
@@ -63,6 +66,7 @@ func (q *Queries) CreateJob(ctx context.Context, db DBTX, arg CreateJobParams) (
 		&i.UpdatedByID,
 		&i.WorkflowOptions,
 		&i.SyncOptions,
+		&i.Triggers,
 	)
 	return i, err
 }
@@ -104,6 +108,87 @@ type CreateJobConnectionDestinationsParams struct {
 	Options      *pg_models.JobDestinationOptions
 }
 
+const createJobRunWatermark = `-- name: CreateJobRunWatermark :exec
+INSERT INTO neosync_api.job_run_watermarks (
+  job_id, job_run_id, schema_name, table_name, column_name, start_value, end_value
+) VALUES (
+  $1, $2, $3, $4, $5, $6, $7
+)
+ON CONFLICT(job_id, job_run_id, schema_name, table_name)
+DO NOTHING
+`
+
+type CreateJobRunWatermarkParams struct {
+	JobID      pgtype.UUID
+	JobRunID   string
+	SchemaName string
+	TableName  string
+	ColumnName string
+	StartValue pgtype.Text
+	EndValue   string
+}
+
+func (q *Queries) CreateJobRunWatermark(ctx context.Context, db DBTX, arg CreateJobRunWatermarkParams) error {
+	_, err := db.Exec(ctx, createJobRunWatermark,
+		arg.JobID,
+		arg.JobRunID,
+		arg.SchemaName,
+		arg.TableName,
+		arg.ColumnName,
+		arg.StartValue,
+		arg.EndValue,
+	)
+	return err
+}
+
+const createJobVersion = `-- name: CreateJobVersion :one
+INSERT INTO neosync_api.job_versions (
+  job_id, version, cron_schedule, connection_options, mappings, destinations, created_by_id
+) VALUES (
+  $1,
+  (SELECT COALESCE(MAX(v.version), 0) + 1 FROM neosync_api.job_versions v WHERE v.job_id = $1),
+  $2,
+  $3,
+  $4,
+  $5,
+  $6
+)
+RETURNING id, created_at, job_id, version, cron_schedule, connection_options, mappings, destinations, created_by_id
+`
+
+type CreateJobVersionParams struct {
+	JobId             pgtype.UUID
+	CronSchedule      pgtype.Text
+	ConnectionOptions *pg_models.JobSourceOptions
+	Mappings          []*pg_models.JobMapping
+	Destinations      []*pg_models.JobVersionDestination
+	CreatedById       pgtype.UUID
+}
+
+func (q *Queries) CreateJobVersion(ctx context.Context, db DBTX, arg CreateJobVersionParams) (NeosyncApiJobVersion, error) {
+	row := db.QueryRow(ctx, createJobVersion,
+		arg.JobId,
+		arg.CronSchedule,
+		arg.ConnectionOptions,
+		arg.Mappings,
+		arg.Destinations,
+		arg.CreatedById,
+	)
+	var i NeosyncApiJobVersion
+	err := row.Scan(
+		&i.ID,
+		&i.CreatedAt,
+		&i.JobID,
+		&i.Version,
+		&i.CronSchedule,
+		&i.ConnectionOptions,
+		&i.Mappings,
+		&i.Destinations,
+		&i.CreatedByID,
+	)
+	return i, err
+}
+
 const deleteJob = `-- name: DeleteJob :exec
 DELETE FROM neosync_api.jobs WHERE id = $1
 `
@@ -114,7 +199,7 @@ func (q *Queries) DeleteJob(ctx context.Context, db DBTX, id pgtype.UUID) error
 }
 
 const getJobById = `-- name: GetJobById :one
-SELECT id, created_at, updated_at, name, account_id, status, connection_options, mappings, cron_schedule, created_by_id, updated_by_id, workflow_options, sync_options from neosync_api.jobs WHERE id = $1
+SELECT id, created_at, updated_at, name, account_id, status, connection_options, mappings, cron_schedule, created_by_id, updated_by_id, workflow_options, sync_options, triggers from neosync_api.jobs WHERE id = $1
 `
 
 func (q *Queries) GetJobById(ctx context.Context, db DBTX, id pgtype.UUID) (NeosyncApiJob, error) {
@@ -134,12 +219,13 @@ func (q *Queries) GetJobById(ctx context.Context, db DBTX, id pgtype.UUID) (Neos
 		&i.UpdatedByID,
 		&i.WorkflowOptions,
 		&i.SyncOptions,
+		&i.Triggers,
 	)
 	return i, err
 }
 
 const getJobByNameAndAccount = `-- name: GetJobByNameAndAccount :one
-SELECT j.id, j.created_at, j.updated_at, j.name, j.account_id, j.status, j.connection_options, j.mappings, j.cron_schedule, j.created_by_id, j.updated_by_id, j.workflow_options, j.sync_options from neosync_api.jobs j
+SELECT j.id, j.created_at, j.updated_at, j.name, j.account_id, j.status, j.connection_options, j.mappings, j.cron_schedule, j.created_by_id, j.updated_by_id, j.workflow_options, j.sync_options, j.triggers from neosync_api.jobs j
 INNER JOIN neosync_api.accounts a ON a.id = j.account_id
 WHERE a.id = $1 AND j.name = $2
 `
@@ -166,6 +252,7 @@ func (q *Queries) GetJobByNameAndAccount(ctx context.Context, db DBTX, arg GetJo
 		&i.UpdatedByID,
 		&i.WorkflowOptions,
 		&i.SyncOptions,
+		&i.Triggers,
 	)
 	return i, err
 }
@@ -255,8 +342,190 @@ func (q *Queries) GetJobConnectionDestinationsByJobIds(ctx context.Context, db D
 	return items, nil
 }
 
+const getJobRunSchemaDriftReport = `-- name: GetJobRunSchemaDriftReport :one
+SELECT jrsdr.id, jrsdr.created_at, jrsdr.job_id, jrsdr.job_run_id, jrsdr.halted, jrsdr.report from neosync_api.job_run_schema_drift_reports jrsdr
+WHERE jrsdr.job_id = $1 AND jrsdr.job_run_id = $2
+`
+
+type GetJobRunSchemaDriftReportParams struct {
+	JobID    pgtype.UUID
+	JobRunID string
+}
+
+func (q *Queries) GetJobRunSchemaDriftReport(ctx context.Context, db DBTX, arg GetJobRunSchemaDriftReportParams) (NeosyncApiJobRunSchemaDriftReport, error) {
+	row := db.QueryRow(ctx, getJobRunSchemaDriftReport, arg.JobID, arg.JobRunID)
+	var i NeosyncApiJobRunSchemaDriftReport
+	err := row.Scan(
+		&i.ID,
+		&i.CreatedAt,
+		&i.JobID,
+		&i.JobRunID,
+		&i.Halted,
+		&i.Report,
+	)
+	return i, err
+}
+
+const getJobRunValidationReport = `-- name: GetJobRunValidationReport :one
+SELECT jrvr.id, jrvr.created_at, jrvr.job_id, jrvr.job_run_id, jrvr.passed, jrvr.report from neosync_api.job_run_validation_reports jrvr
+WHERE jrvr.job_id = $1 AND jrvr.job_run_id = $2
+`
+
+type GetJobRunValidationReportParams struct {
+	JobID    pgtype.UUID
+	JobRunID string
+}
+
+func (q *Queries) GetJobRunValidationReport(ctx context.Context, db DBTX, arg GetJobRunValidationReportParams) (NeosyncApiJobRunValidationReport, error) {
+	row := db.QueryRow(ctx, getJobRunValidationReport, arg.JobID, arg.JobRunID)
+	var i NeosyncApiJobRunValidationReport
+	err := row.Scan(
+		&i.ID,
+		&i.CreatedAt,
+		&i.JobID,
+		&i.JobRunID,
+		&i.Passed,
+		&i.Report,
+	)
+	return i, err
+}
+
+const getJobRunWatermarks = `-- name: GetJobRunWatermarks :many
+SELECT jrw.id, jrw.created_at, jrw.job_id, jrw.job_run_id, jrw.schema_name, jrw.table_name, jrw.column_name, jrw.start_value, jrw.end_value from neosync_api.job_run_watermarks jrw
+WHERE jrw.job_id = $1 AND jrw.job_run_id = $2
+ORDER BY jrw.schema_name, jrw.table_name
+`
+
+type GetJobRunWatermarksParams struct {
+	JobID    pgtype.UUID
+	JobRunID string
+}
+
+func (q *Queries) GetJobRunWatermarks(ctx context.Context, db DBTX, arg GetJobRunWatermarksParams) ([]NeosyncApiJobRunWatermark, error) {
+	rows, err := db.Query(ctx, getJobRunWatermarks, arg.JobID, arg.JobRunID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []NeosyncApiJobRunWatermark
+	for rows.Next() {
+		var i NeosyncApiJobRunWatermark
+		if err := rows.Scan(
+			&i.ID,
+			&i.CreatedAt,
+			&i.JobID,
+			&i.JobRunID,
+			&i.SchemaName,
+			&i.TableName,
+			&i.ColumnName,
+			&i.StartValue,
+			&i.EndValue,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getJobVersionById = `-- name: GetJobVersionById :one
+SELECT id, created_at, job_id, version, cron_schedule, connection_options, mappings, destinations, created_by_id from neosync_api.job_versions WHERE id = $1
+`
+
+func (q *Queries) GetJobVersionById(ctx context.Context, db DBTX, id pgtype.UUID) (NeosyncApiJobVersion, error) {
+	row := db.QueryRow(ctx, getJobVersionById, id)
+	var i NeosyncApiJobVersion
+	err := row.Scan(
+		&i.ID,
+		&i.CreatedAt,
+		&i.JobID,
+		&i.Version,
+		&i.CronSchedule,
+		&i.ConnectionOptions,
+		&i.Mappings,
+		&i.Destinations,
+		&i.CreatedByID,
+	)
+	return i, err
+}
+
+const getJobVersions = `-- name: GetJobVersions :many
+SELECT id, created_at, job_id, version, cron_schedule, connection_options, mappings, destinations, created_by_id from neosync_api.job_versions
+WHERE job_id = $1
+ORDER BY version DESC
+`
+
+func (q *Queries) GetJobVersions(ctx context.Context, db DBTX, jobID pgtype.UUID) ([]NeosyncApiJobVersion, error) {
+	rows, err := db.Query(ctx, getJobVersions, jobID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []NeosyncApiJobVersion
+	for rows.Next() {
+		var i NeosyncApiJobVersion
+		if err := rows.Scan(
+			&i.ID,
+			&i.CreatedAt,
+			&i.JobID,
+			&i.Version,
+			&i.CronSchedule,
+			&i.ConnectionOptions,
+			&i.Mappings,
+			&i.Destinations,
+			&i.CreatedByID,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getJobWatermarks = `-- name: GetJobWatermarks :many
+SELECT jw.id, jw.created_at, jw.updated_at, jw.job_id, jw.schema_name, jw.table_name, jw.column_name, jw.watermark_value, jw.job_run_id from neosync_api.job_watermarks jw
+WHERE jw.job_id = $1
+ORDER BY jw.schema_name, jw.table_name
+`
+
+func (q *Queries) GetJobWatermarks(ctx context.Context, db DBTX, jobID pgtype.UUID) ([]NeosyncApiJobWatermark, error) {
+	rows, err := db.Query(ctx, getJobWatermarks, jobID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []NeosyncApiJobWatermark
+	for rows.Next() {
+		var i NeosyncApiJobWatermark
+		if err := rows.Scan(
+			&i.ID,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.JobID,
+			&i.SchemaName,
+			&i.TableName,
+			&i.ColumnName,
+			&i.WatermarkValue,
+			&i.JobRunID,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const getJobsByAccount = `-- name: GetJobsByAccount :many
-SELECT j.id, j.created_at, j.updated_at, j.name, j.account_id, j.status, j.connection_options, j.mappings, j.cron_schedule, j.created_by_id, j.updated_by_id, j.workflow_options, j.sync_options from neosync_api.jobs j
+SELECT j.id, j.created_at, j.updated_at, j.name, j.account_id, j.status, j.connection_options, j.mappings, j.cron_schedule, j.created_by_id, j.updated_by_id, j.workflow_options, j.sync_options, j.triggers from neosync_api.jobs j
 INNER JOIN neosync_api.accounts a ON a.id = j.account_id
 WHERE a.id = $1
 ORDER BY j.created_at DESC
@@ -285,6 +554,7 @@ func (q *Queries) GetJobsByAccount(ctx context.Context, db DBTX, accountid pgtyp
 			&i.UpdatedByID,
 			&i.WorkflowOptions,
 			&i.SyncOptions,
+			&i.Triggers,
 		); err != nil {
 			return nil, err
 		}
@@ -296,6 +566,98 @@ func (q *Queries) GetJobsByAccount(ctx context.Context, db DBTX, accountid pgtyp
 	return items, nil
 }
 
+const getJobsTriggeredByJob = `-- name: GetJobsTriggeredByJob :many
+SELECT j.id, j.created_at, j.updated_at, j.name, j.account_id, j.status, j.connection_options, j.mappings, j.cron_schedule, j.created_by_id, j.updated_by_id, j.workflow_options, j.sync_options, j.triggers from neosync_api.jobs j
+WHERE j.account_id = $1
+AND j.triggers @> jsonb_build_array(jsonb_build_object('jobId', $2::text))
+ORDER BY j.created_at ASC
+`
+
+type GetJobsTriggeredByJobParams struct {
+	AccountId pgtype.UUID
+	JobId     string
+}
+
+func (q *Queries) GetJobsTriggeredByJob(ctx context.Context, db DBTX, arg GetJobsTriggeredByJobParams) ([]NeosyncApiJob, error) {
+	rows, err := db.Query(ctx, getJobsTriggeredByJob, arg.AccountId, arg.JobId)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []NeosyncApiJob
+	for rows.Next() {
+		var i NeosyncApiJob
+		if err := rows.Scan(
+			&i.ID,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.Name,
+			&i.AccountID,
+			&i.Status,
+			&i.ConnectionOptions,
+			&i.Mappings,
+			&i.CronSchedule,
+			&i.CreatedByID,
+			&i.UpdatedByID,
+			&i.WorkflowOptions,
+			&i.SyncOptions,
+			&i.Triggers,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getLatestJobRunSchemaDriftReport = `-- name: GetLatestJobRunSchemaDriftReport :one
+SELECT jrsdr.id, jrsdr.created_at, jrsdr.job_id, jrsdr.job_run_id, jrsdr.halted, jrsdr.report from neosync_api.job_run_schema_drift_reports jrsdr
+WHERE jrsdr.job_id = $1
+ORDER BY jrsdr.created_at DESC
+LIMIT 1
+`
+
+func (q *Queries) GetLatestJobRunSchemaDriftReport(ctx context.Context, db DBTX, jobID pgtype.UUID) (NeosyncApiJobRunSchemaDriftReport, error) {
+	row := db.QueryRow(ctx, getLatestJobRunSchemaDriftReport, jobID)
+	var i NeosyncApiJobRunSchemaDriftReport
+	err := row.Scan(
+		&i.ID,
+		&i.CreatedAt,
+		&i.JobID,
+		&i.JobRunID,
+		&i.Halted,
+		&i.Report,
+	)
+	return i, err
+}
+
+const getLatestJobVersion = `-- name: GetLatestJobVersion :one
+SELECT id, created_at, job_id, version, cron_schedule, connection_options, mappings, destinations, created_by_id from neosync_api.job_versions
+WHERE job_id = $1
+ORDER BY version DESC
+LIMIT 1
+`
+
+func (q *Queries) GetLatestJobVersion(ctx context.Context, db DBTX, jobID pgtype.UUID) (NeosyncApiJobVersion, error) {
+	row := db.QueryRow(ctx, getLatestJobVersion, jobID)
+	var i NeosyncApiJobVersion
+	err := row.Scan(
+		&i.ID,
+		&i.CreatedAt,
+		&i.JobID,
+		&i.Version,
+		&i.CronSchedule,
+		&i.ConnectionOptions,
+		&i.Mappings,
+		&i.Destinations,
+		&i.CreatedByID,
+	)
+	return i, err
+}
+
 const isJobNameAvailable = `-- name: IsJobNameAvailable :one
 SELECT count(j.id) from neosync_api.jobs j
 INNER JOIN neosync_api.accounts a ON a.id = j.account_id
@@ -342,12 +704,115 @@ func (q *Queries) RemoveJobConnectionDestinations(ctx context.Context, db DBTX,
 	return err
 }
 
+const removeJobWatermark = `-- name: RemoveJobWatermark :exec
+DELETE FROM neosync_api.job_watermarks
+WHERE job_id = $1 AND schema_name = $2 AND table_name = $3
+`
+
+type RemoveJobWatermarkParams struct {
+	JobID      pgtype.UUID
+	SchemaName string
+	TableName  string
+}
+
+func (q *Queries) RemoveJobWatermark(ctx context.Context, db DBTX, arg RemoveJobWatermarkParams) error {
+	_, err := db.Exec(ctx, removeJobWatermark, arg.JobID, arg.SchemaName, arg.TableName)
+	return err
+}
+
+const removeJobWatermarks = `-- name: RemoveJobWatermarks :exec
+DELETE FROM neosync_api.job_watermarks WHERE job_id = $1
+`
+
+func (q *Queries) RemoveJobWatermarks(ctx context.Context, db DBTX, jobID pgtype.UUID) error {
+	_, err := db.Exec(ctx, removeJobWatermarks, jobID)
+	return err
+}
+
+const setJobRunSchemaDriftReport = `-- name: SetJobRunSchemaDriftReport :one
+INSERT INTO neosync_api.job_run_schema_drift_reports (
+  job_id, job_run_id, halted, report
+) VALUES (
+  $1, $2, $3, $4
+)
+ON CONFLICT(job_id, job_run_id)
+DO UPDATE
+SET halted = EXCLUDED.halted,
+report = EXCLUDED.report
+RETURNING id, created_at, job_id, job_run_id, halted, report
+`
+
+type SetJobRunSchemaDriftReportParams struct {
+	JobID    pgtype.UUID
+	JobRunID string
+	Halted   bool
+	Report   *pg_models.JobRunSchemaDriftReport
+}
+
+func (q *Queries) SetJobRunSchemaDriftReport(ctx context.Context, db DBTX, arg SetJobRunSchemaDriftReportParams) (NeosyncApiJobRunSchemaDriftReport, error) {
+	row := db.QueryRow(ctx, setJobRunSchemaDriftReport,
+		arg.JobID,
+		arg.JobRunID,
+		arg.Halted,
+		arg.Report,
+	)
+	var i NeosyncApiJobRunSchemaDriftReport
+	err := row.Scan(
+		&i.ID,
+		&i.CreatedAt,
+		&i.JobID,
+		&i.JobRunID,
+		&i.Halted,
+		&i.Report,
+	)
+	return i, err
+}
+
+const setJobRunValidationReport = `-- name: SetJobRunValidationReport :one
+INSERT INTO neosync_api.job_run_validation_reports (
+  job_id, job_run_id, passed, report
+) VALUES (
+  $1, $2, $3, $4
+)
+ON CONFLICT(job_id, job_run_id)
+DO UPDATE
+SET passed = EXCLUDED.passed,
+report = EXCLUDED.report
+RETURNING id, created_at, job_id, job_run_id, passed, report
+`
+
+type SetJobRunValidationReportParams struct {
+	JobID    pgtype.UUID
+	JobRunID string
+	Passed   bool
+	Report   *pg_models.JobRunValidationReport
+}
+
+func (q *Queries) SetJobRunValidationReport(ctx context.Context, db DBTX, arg SetJobRunValidationReportParams) (NeosyncApiJobRunValidationReport, error) {
+	row := db.QueryRow(ctx, setJobRunValidationReport,
+		arg.JobID,
+		arg.JobRunID,
+		arg.Passed,
+		arg.Report,
+	)
+	var i NeosyncApiJobRunValidationReport
+	err := row.Scan(
+		&i.ID,
+		&i.CreatedAt,
+		&i.JobID,
+		&i.JobRunID,
+		&i.Passed,
+		&i.Report,
+	)
+	return i, err
+}
+
 const setJobSyncOptions = `-- name: SetJobSyncOptions :one
 UPDATE neosync_api.jobs
 SET sync_options = $1,
 updated_by_id = $2
 WHERE id = $3
-RETURNING id, created_at, updated_at, name, account_id, status, connection_options, mappings, cron_schedule, created_by_id, updated_by_id, workflow_options, sync_options
+RETURNING id, created_at, updated_at, name, account_id, status, connection_options, mappings, cron_schedule, created_by_id, updated_by_id, workflow_options, sync_options, triggers
 `
 
 type SetJobSyncOptionsParams struct {
@@ -373,6 +838,91 @@ func (q *Queries) SetJobSyncOptions(ctx context.Context, db DBTX, arg SetJobSync
 		&i.UpdatedByID,
 		&i.WorkflowOptions,
 		&i.SyncOptions,
+		&i.Triggers,
+	)
+	return i, err
+}
+
+const setJobTriggers = `-- name: SetJobTriggers :one
+UPDATE neosync_api.jobs
+SET triggers = $1,
+updated_by_id = $2
+WHERE id = $3
+RETURNING id, created_at, updated_at, name, account_id, status, connection_options, mappings, cron_schedule, created_by_id, updated_by_id, workflow_options, sync_options, triggers
+`
+
+type SetJobTriggersParams struct {
+	Triggers    []*pg_models.JobTrigger
+	UpdatedByID pgtype.UUID
+	ID          pgtype.UUID
+}
+
+func (q *Queries) SetJobTriggers(ctx context.Context, db DBTX, arg SetJobTriggersParams) (NeosyncApiJob, error) {
+	row := db.QueryRow(ctx, setJobTriggers, arg.Triggers, arg.UpdatedByID, arg.ID)
+	var i NeosyncApiJob
+	err := row.Scan(
+		&i.ID,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.Name,
+		&i.AccountID,
+		&i.Status,
+		&i.ConnectionOptions,
+		&i.Mappings,
+		&i.CronSchedule,
+		&i.CreatedByID,
+		&i.UpdatedByID,
+		&i.WorkflowOptions,
+		&i.SyncOptions,
+		&i.Triggers,
+	)
+	return i, err
+}
+
+const setJobWatermark = `-- name: SetJobWatermark :one
+INSERT INTO neosync_api.job_watermarks (
+  job_id, schema_name, table_name, column_name, watermark_value, job_run_id
+) VALUES (
+  $1, $2, $3, $4, $5, $6
+)
+ON CONFLICT(job_id, schema_name, table_name)
+DO UPDATE
+SET column_name = EXCLUDED.column_name,
+watermark_value = EXCLUDED.watermark_value,
+job_run_id = EXCLUDED.job_run_id,
+updated_at = CURRENT_TIMESTAMP
+RETURNING id, created_at, updated_at, job_id, schema_name, table_name, column_name, watermark_value, job_run_id
+`
+
+type SetJobWatermarkParams struct {
+	JobID          pgtype.UUID
+	SchemaName     string
+	TableName      string
+	ColumnName     string
+	WatermarkValue string
+	JobRunID       string
+}
+
+func (q *Queries) SetJobWatermark(ctx context.Context, db DBTX, arg SetJobWatermarkParams) (NeosyncApiJobWatermark, error) {
+	row := db.QueryRow(ctx, setJobWatermark,
+		arg.JobID,
+		arg.SchemaName,
+		arg.TableName,
+		arg.ColumnName,
+		arg.WatermarkValue,
+		arg.JobRunID,
+	)
+	var i NeosyncApiJobWatermark
+	err := row.Scan(
+		&i.ID,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.JobID,
+		&i.SchemaName,
+		&i.TableName,
+		&i.ColumnName,
+		&i.WatermarkValue,
+		&i.JobRunID,
 	)
 	return i, err
 }
@@ -382,7 +932,7 @@ UPDATE neosync_api.jobs
 SET workflow_options = $1,
 updated_by_id = $2
 WHERE id = $3
-RETURNING id, created_at, updated_at, name, account_id, status, connection_options, mappings, cron_schedule, created_by_id, updated_by_id, workflow_options, sync_options
+RETURNING id, created_at, updated_at, name, account_id, status, connection_options, mappings, cron_schedule, created_by_id, updated_by_id, workflow_options, sync_options, triggers
 `
 
 type SetJobWorkflowOptionsParams struct {
@@ -408,6 +958,7 @@ func (q *Queries) SetJobWorkflowOptions(ctx context.Context, db DBTX, arg SetJob
 		&i.UpdatedByID,
 		&i.WorkflowOptions,
 		&i.SyncOptions,
+		&i.Triggers,
 	)
 	return i, err
 }
@@ -445,7 +996,7 @@ UPDATE neosync_api.jobs
 SET mappings = $1,
 updated_by_id = $2
 WHERE id = $3
-RETURNING id, created_at, updated_at, name, account_id, status, connection_options, mappings, cron_schedule, created_by_id, updated_by_id, workflow_options, sync_options
+RETURNING id, created_at, updated_at, name, account_id, status, connection_options, mappings, cron_schedule, created_by_id, updated_by_id, workflow_options, sync_options, triggers
 `
 
 type UpdateJobMappingsParams struct {
@@ -471,6 +1022,7 @@ func (q *Queries) UpdateJobMappings(ctx context.Context, db DBTX, arg UpdateJobM
 		&i.UpdatedByID,
 		&i.WorkflowOptions,
 		&i.SyncOptions,
+		&i.Triggers,
 	)
 	return i, err
 }
@@ -480,7 +1032,7 @@ UPDATE neosync_api.jobs
 SET cron_schedule = $1,
 updated_by_id = $2
 WHERE id = $3
-RETURNING id, created_at, updated_at, name, account_id, status, connection_options, mappings, cron_schedule, created_by_id, updated_by_id, workflow_options, sync_options
+RETURNING id, created_at, updated_at, name, account_id, status, connection_options, mappings, cron_schedule, created_by_id, updated_by_id, workflow_options, sync_options, triggers
 `
 
 type UpdateJobScheduleParams struct {
@@ -506,6 +1058,7 @@ func (q *Queries) UpdateJobSchedule(ctx context.Context, db DBTX, arg UpdateJobS
 		&i.UpdatedByID,
 		&i.WorkflowOptions,
 		&i.SyncOptions,
+		&i.Triggers,
 	)
 	return i, err
 }
@@ -515,7 +1068,7 @@ UPDATE neosync_api.jobs
 SET connection_options = $1,
 updated_by_id = $2
 WHERE id = $3
-RETURNING id, created_at, updated_at, name, account_id, status, connection_options, mappings, cron_schedule, created_by_id, updated_by_id, workflow_options, sync_options
+RETURNING id, created_at, updated_at, name, account_id, status, connection_options, mappings, cron_schedule, created_by_id, updated_by_id, workflow_options, sync_options, triggers
 `
 
 type UpdateJobSourceParams struct {
@@ -541,6 +1094,7 @@ func (q *Queries) UpdateJobSource(ctx context.Context, db DBTX, arg UpdateJobSou
 		&i.UpdatedByID,
 		&i.WorkflowOptions,
 		&i.SyncOptions,
+		&i.Triggers,
 	)
 	return i, err
 }
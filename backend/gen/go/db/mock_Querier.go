@@ -256,6 +256,64 @@ func (_c *MockQuerier_CreateAccountUserAssociation_Call) RunAndReturn(run func(c
 	return _c
 }
 
+// CreateAuditEvent provides a mock function with given fields: ctx, db, arg
+func (_m *MockQuerier) CreateAuditEvent(ctx context.Context, db DBTX, arg CreateAuditEventParams) (NeosyncApiAuditEvent, error) {
+	ret := _m.Called(ctx, db, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateAuditEvent")
+	}
+
+	var r0 NeosyncApiAuditEvent
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX, CreateAuditEventParams) (NeosyncApiAuditEvent, error)); ok {
+		return rf(ctx, db, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX, CreateAuditEventParams) NeosyncApiAuditEvent); ok {
+		r0 = rf(ctx, db, arg)
+	} else {
+		r0 = ret.Get(0).(NeosyncApiAuditEvent)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, DBTX, CreateAuditEventParams) error); ok {
+		r1 = rf(ctx, db, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQuerier_CreateAuditEvent_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateAuditEvent'
+type MockQuerier_CreateAuditEvent_Call struct {
+	*mock.Call
+}
+
+// CreateAuditEvent is a helper method to define mock.On call
+//   - ctx context.Context
+//   - db DBTX
+//   - arg CreateAuditEventParams
+func (_e *MockQuerier_Expecter) CreateAuditEvent(ctx interface{}, db interface{}, arg interface{}) *MockQuerier_CreateAuditEvent_Call {
+	return &MockQuerier_CreateAuditEvent_Call{Call: _e.mock.On("CreateAuditEvent", ctx, db, arg)}
+}
+
+func (_c *MockQuerier_CreateAuditEvent_Call) Run(run func(ctx context.Context, db DBTX, arg CreateAuditEventParams)) *MockQuerier_CreateAuditEvent_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(DBTX), args[2].(CreateAuditEventParams))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_CreateAuditEvent_Call) Return(_a0 NeosyncApiAuditEvent, _a1 error) *MockQuerier_CreateAuditEvent_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQuerier_CreateAuditEvent_Call) RunAndReturn(run func(context.Context, DBTX, CreateAuditEventParams) (NeosyncApiAuditEvent, error)) *MockQuerier_CreateAuditEvent_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // CreateConnection provides a mock function with given fields: ctx, db, arg
 func (_m *MockQuerier) CreateConnection(ctx context.Context, db DBTX, arg CreateConnectionParams) (NeosyncApiConnection, error) {
 	ret := _m.Called(ctx, db, arg)
@@ -546,6 +604,112 @@ func (_c *MockQuerier_CreateJobConnectionDestinations_Call) RunAndReturn(run fun
 	return _c
 }
 
+// CreateJobRunWatermark provides a mock function with given fields: ctx, db, arg
+func (_m *MockQuerier) CreateJobRunWatermark(ctx context.Context, db DBTX, arg CreateJobRunWatermarkParams) error {
+	ret := _m.Called(ctx, db, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateJobRunWatermark")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX, CreateJobRunWatermarkParams) error); ok {
+		r0 = rf(ctx, db, arg)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockQuerier_CreateJobRunWatermark_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateJobRunWatermark'
+type MockQuerier_CreateJobRunWatermark_Call struct {
+	*mock.Call
+}
+
+// CreateJobRunWatermark is a helper method to define mock.On call
+//   - ctx context.Context
+//   - db DBTX
+//   - arg CreateJobRunWatermarkParams
+func (_e *MockQuerier_Expecter) CreateJobRunWatermark(ctx interface{}, db interface{}, arg interface{}) *MockQuerier_CreateJobRunWatermark_Call {
+	return &MockQuerier_CreateJobRunWatermark_Call{Call: _e.mock.On("CreateJobRunWatermark", ctx, db, arg)}
+}
+
+func (_c *MockQuerier_CreateJobRunWatermark_Call) Run(run func(ctx context.Context, db DBTX, arg CreateJobRunWatermarkParams)) *MockQuerier_CreateJobRunWatermark_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(DBTX), args[2].(CreateJobRunWatermarkParams))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_CreateJobRunWatermark_Call) Return(_a0 error) *MockQuerier_CreateJobRunWatermark_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockQuerier_CreateJobRunWatermark_Call) RunAndReturn(run func(context.Context, DBTX, CreateJobRunWatermarkParams) error) *MockQuerier_CreateJobRunWatermark_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateJobVersion provides a mock function with given fields: ctx, db, arg
+func (_m *MockQuerier) CreateJobVersion(ctx context.Context, db DBTX, arg CreateJobVersionParams) (NeosyncApiJobVersion, error) {
+	ret := _m.Called(ctx, db, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateJobVersion")
+	}
+
+	var r0 NeosyncApiJobVersion
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX, CreateJobVersionParams) (NeosyncApiJobVersion, error)); ok {
+		return rf(ctx, db, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX, CreateJobVersionParams) NeosyncApiJobVersion); ok {
+		r0 = rf(ctx, db, arg)
+	} else {
+		r0 = ret.Get(0).(NeosyncApiJobVersion)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, DBTX, CreateJobVersionParams) error); ok {
+		r1 = rf(ctx, db, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQuerier_CreateJobVersion_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateJobVersion'
+type MockQuerier_CreateJobVersion_Call struct {
+	*mock.Call
+}
+
+// CreateJobVersion is a helper method to define mock.On call
+//   - ctx context.Context
+//   - db DBTX
+//   - arg CreateJobVersionParams
+func (_e *MockQuerier_Expecter) CreateJobVersion(ctx interface{}, db interface{}, arg interface{}) *MockQuerier_CreateJobVersion_Call {
+	return &MockQuerier_CreateJobVersion_Call{Call: _e.mock.On("CreateJobVersion", ctx, db, arg)}
+}
+
+func (_c *MockQuerier_CreateJobVersion_Call) Run(run func(ctx context.Context, db DBTX, arg CreateJobVersionParams)) *MockQuerier_CreateJobVersion_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(DBTX), args[2].(CreateJobVersionParams))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_CreateJobVersion_Call) Return(_a0 NeosyncApiJobVersion, _a1 error) *MockQuerier_CreateJobVersion_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQuerier_CreateJobVersion_Call) RunAndReturn(run func(context.Context, DBTX, CreateJobVersionParams) (NeosyncApiJobVersion, error)) *MockQuerier_CreateJobVersion_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // CreateMachineUser provides a mock function with given fields: ctx, db
 func (_m *MockQuerier) CreateMachineUser(ctx context.Context, db DBTX) (NeosyncApiUser, error) {
 	ret := _m.Called(ctx, db)
@@ -834,6 +998,122 @@ func (_c *MockQuerier_CreateUserDefinedTransformer_Call) RunAndReturn(run func(c
 	return _c
 }
 
+// CreateWebhook provides a mock function with given fields: ctx, db, arg
+func (_m *MockQuerier) CreateWebhook(ctx context.Context, db DBTX, arg CreateWebhookParams) (NeosyncApiWebhook, error) {
+	ret := _m.Called(ctx, db, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateWebhook")
+	}
+
+	var r0 NeosyncApiWebhook
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX, CreateWebhookParams) (NeosyncApiWebhook, error)); ok {
+		return rf(ctx, db, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX, CreateWebhookParams) NeosyncApiWebhook); ok {
+		r0 = rf(ctx, db, arg)
+	} else {
+		r0 = ret.Get(0).(NeosyncApiWebhook)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, DBTX, CreateWebhookParams) error); ok {
+		r1 = rf(ctx, db, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQuerier_CreateWebhook_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateWebhook'
+type MockQuerier_CreateWebhook_Call struct {
+	*mock.Call
+}
+
+// CreateWebhook is a helper method to define mock.On call
+//   - ctx context.Context
+//   - db DBTX
+//   - arg CreateWebhookParams
+func (_e *MockQuerier_Expecter) CreateWebhook(ctx interface{}, db interface{}, arg interface{}) *MockQuerier_CreateWebhook_Call {
+	return &MockQuerier_CreateWebhook_Call{Call: _e.mock.On("CreateWebhook", ctx, db, arg)}
+}
+
+func (_c *MockQuerier_CreateWebhook_Call) Run(run func(ctx context.Context, db DBTX, arg CreateWebhookParams)) *MockQuerier_CreateWebhook_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(DBTX), args[2].(CreateWebhookParams))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_CreateWebhook_Call) Return(_a0 NeosyncApiWebhook, _a1 error) *MockQuerier_CreateWebhook_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQuerier_CreateWebhook_Call) RunAndReturn(run func(context.Context, DBTX, CreateWebhookParams) (NeosyncApiWebhook, error)) *MockQuerier_CreateWebhook_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateWebhookDelivery provides a mock function with given fields: ctx, db, arg
+func (_m *MockQuerier) CreateWebhookDelivery(ctx context.Context, db DBTX, arg CreateWebhookDeliveryParams) (NeosyncApiWebhookDelivery, error) {
+	ret := _m.Called(ctx, db, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateWebhookDelivery")
+	}
+
+	var r0 NeosyncApiWebhookDelivery
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX, CreateWebhookDeliveryParams) (NeosyncApiWebhookDelivery, error)); ok {
+		return rf(ctx, db, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX, CreateWebhookDeliveryParams) NeosyncApiWebhookDelivery); ok {
+		r0 = rf(ctx, db, arg)
+	} else {
+		r0 = ret.Get(0).(NeosyncApiWebhookDelivery)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, DBTX, CreateWebhookDeliveryParams) error); ok {
+		r1 = rf(ctx, db, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQuerier_CreateWebhookDelivery_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateWebhookDelivery'
+type MockQuerier_CreateWebhookDelivery_Call struct {
+	*mock.Call
+}
+
+// CreateWebhookDelivery is a helper method to define mock.On call
+//   - ctx context.Context
+//   - db DBTX
+//   - arg CreateWebhookDeliveryParams
+func (_e *MockQuerier_Expecter) CreateWebhookDelivery(ctx interface{}, db interface{}, arg interface{}) *MockQuerier_CreateWebhookDelivery_Call {
+	return &MockQuerier_CreateWebhookDelivery_Call{Call: _e.mock.On("CreateWebhookDelivery", ctx, db, arg)}
+}
+
+func (_c *MockQuerier_CreateWebhookDelivery_Call) Run(run func(ctx context.Context, db DBTX, arg CreateWebhookDeliveryParams)) *MockQuerier_CreateWebhookDelivery_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(DBTX), args[2].(CreateWebhookDeliveryParams))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_CreateWebhookDelivery_Call) Return(_a0 NeosyncApiWebhookDelivery, _a1 error) *MockQuerier_CreateWebhookDelivery_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQuerier_CreateWebhookDelivery_Call) RunAndReturn(run func(context.Context, DBTX, CreateWebhookDeliveryParams) (NeosyncApiWebhookDelivery, error)) *MockQuerier_CreateWebhookDelivery_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // DeleteJob provides a mock function with given fields: ctx, db, id
 func (_m *MockQuerier) DeleteJob(ctx context.Context, db DBTX, id pgtype.UUID) error {
 	ret := _m.Called(ctx, db, id)
@@ -1340,6 +1620,64 @@ func (_c *MockQuerier_GetAccountOnboardingConfig_Call) RunAndReturn(run func(con
 	return _c
 }
 
+// GetAccountTransformerKey provides a mock function with given fields: ctx, db, id
+func (_m *MockQuerier) GetAccountTransformerKey(ctx context.Context, db DBTX, id pgtype.UUID) (pgtype.Text, error) {
+	ret := _m.Called(ctx, db, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAccountTransformerKey")
+	}
+
+	var r0 pgtype.Text
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX, pgtype.UUID) (pgtype.Text, error)); ok {
+		return rf(ctx, db, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX, pgtype.UUID) pgtype.Text); ok {
+		r0 = rf(ctx, db, id)
+	} else {
+		r0 = ret.Get(0).(pgtype.Text)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, DBTX, pgtype.UUID) error); ok {
+		r1 = rf(ctx, db, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQuerier_GetAccountTransformerKey_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAccountTransformerKey'
+type MockQuerier_GetAccountTransformerKey_Call struct {
+	*mock.Call
+}
+
+// GetAccountTransformerKey is a helper method to define mock.On call
+//   - ctx context.Context
+//   - db DBTX
+//   - id pgtype.UUID
+func (_e *MockQuerier_Expecter) GetAccountTransformerKey(ctx interface{}, db interface{}, id interface{}) *MockQuerier_GetAccountTransformerKey_Call {
+	return &MockQuerier_GetAccountTransformerKey_Call{Call: _e.mock.On("GetAccountTransformerKey", ctx, db, id)}
+}
+
+func (_c *MockQuerier_GetAccountTransformerKey_Call) Run(run func(ctx context.Context, db DBTX, id pgtype.UUID)) *MockQuerier_GetAccountTransformerKey_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(DBTX), args[2].(pgtype.UUID))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_GetAccountTransformerKey_Call) Return(_a0 pgtype.Text, _a1 error) *MockQuerier_GetAccountTransformerKey_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQuerier_GetAccountTransformerKey_Call) RunAndReturn(run func(context.Context, DBTX, pgtype.UUID) (pgtype.Text, error)) *MockQuerier_GetAccountTransformerKey_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetAccountUserAssociation provides a mock function with given fields: ctx, db, arg
 func (_m *MockQuerier) GetAccountUserAssociation(ctx context.Context, db DBTX, arg GetAccountUserAssociationParams) (NeosyncApiAccountUserAssociation, error) {
 	ret := _m.Called(ctx, db, arg)
@@ -1398,29 +1736,87 @@ func (_c *MockQuerier_GetAccountUserAssociation_Call) RunAndReturn(run func(cont
 	return _c
 }
 
-// GetAccountsByUser provides a mock function with given fields: ctx, db, id
-func (_m *MockQuerier) GetAccountsByUser(ctx context.Context, db DBTX, id pgtype.UUID) ([]NeosyncApiAccount, error) {
-	ret := _m.Called(ctx, db, id)
+// GetAccountUserCountByRole provides a mock function with given fields: ctx, db, arg
+func (_m *MockQuerier) GetAccountUserCountByRole(ctx context.Context, db DBTX, arg GetAccountUserCountByRoleParams) (int64, error) {
+	ret := _m.Called(ctx, db, arg)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetAccountsByUser")
+		panic("no return value specified for GetAccountUserCountByRole")
 	}
 
-	var r0 []NeosyncApiAccount
+	var r0 int64
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, DBTX, pgtype.UUID) ([]NeosyncApiAccount, error)); ok {
-		return rf(ctx, db, id)
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX, GetAccountUserCountByRoleParams) (int64, error)); ok {
+		return rf(ctx, db, arg)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, DBTX, pgtype.UUID) []NeosyncApiAccount); ok {
-		r0 = rf(ctx, db, id)
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX, GetAccountUserCountByRoleParams) int64); ok {
+		r0 = rf(ctx, db, arg)
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]NeosyncApiAccount)
-		}
+		r0 = ret.Get(0).(int64)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, DBTX, pgtype.UUID) error); ok {
-		r1 = rf(ctx, db, id)
+	if rf, ok := ret.Get(1).(func(context.Context, DBTX, GetAccountUserCountByRoleParams) error); ok {
+		r1 = rf(ctx, db, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQuerier_GetAccountUserCountByRole_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAccountUserCountByRole'
+type MockQuerier_GetAccountUserCountByRole_Call struct {
+	*mock.Call
+}
+
+// GetAccountUserCountByRole is a helper method to define mock.On call
+//   - ctx context.Context
+//   - db DBTX
+//   - arg GetAccountUserCountByRoleParams
+func (_e *MockQuerier_Expecter) GetAccountUserCountByRole(ctx interface{}, db interface{}, arg interface{}) *MockQuerier_GetAccountUserCountByRole_Call {
+	return &MockQuerier_GetAccountUserCountByRole_Call{Call: _e.mock.On("GetAccountUserCountByRole", ctx, db, arg)}
+}
+
+func (_c *MockQuerier_GetAccountUserCountByRole_Call) Run(run func(ctx context.Context, db DBTX, arg GetAccountUserCountByRoleParams)) *MockQuerier_GetAccountUserCountByRole_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(DBTX), args[2].(GetAccountUserCountByRoleParams))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_GetAccountUserCountByRole_Call) Return(_a0 int64, _a1 error) *MockQuerier_GetAccountUserCountByRole_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQuerier_GetAccountUserCountByRole_Call) RunAndReturn(run func(context.Context, DBTX, GetAccountUserCountByRoleParams) (int64, error)) *MockQuerier_GetAccountUserCountByRole_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetAccountsByUser provides a mock function with given fields: ctx, db, id
+func (_m *MockQuerier) GetAccountsByUser(ctx context.Context, db DBTX, id pgtype.UUID) ([]NeosyncApiAccount, error) {
+	ret := _m.Called(ctx, db, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAccountsByUser")
+	}
+
+	var r0 []NeosyncApiAccount
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX, pgtype.UUID) ([]NeosyncApiAccount, error)); ok {
+		return rf(ctx, db, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX, pgtype.UUID) []NeosyncApiAccount); ok {
+		r0 = rf(ctx, db, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]NeosyncApiAccount)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, DBTX, pgtype.UUID) error); ok {
+		r1 = rf(ctx, db, id)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -1575,6 +1971,66 @@ func (_c *MockQuerier_GetAnonymousUser_Call) RunAndReturn(run func(context.Conte
 	return _c
 }
 
+// GetAuditEvents provides a mock function with given fields: ctx, db, arg
+func (_m *MockQuerier) GetAuditEvents(ctx context.Context, db DBTX, arg GetAuditEventsParams) ([]NeosyncApiAuditEvent, error) {
+	ret := _m.Called(ctx, db, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAuditEvents")
+	}
+
+	var r0 []NeosyncApiAuditEvent
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX, GetAuditEventsParams) ([]NeosyncApiAuditEvent, error)); ok {
+		return rf(ctx, db, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX, GetAuditEventsParams) []NeosyncApiAuditEvent); ok {
+		r0 = rf(ctx, db, arg)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]NeosyncApiAuditEvent)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, DBTX, GetAuditEventsParams) error); ok {
+		r1 = rf(ctx, db, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQuerier_GetAuditEvents_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAuditEvents'
+type MockQuerier_GetAuditEvents_Call struct {
+	*mock.Call
+}
+
+// GetAuditEvents is a helper method to define mock.On call
+//   - ctx context.Context
+//   - db DBTX
+//   - arg GetAuditEventsParams
+func (_e *MockQuerier_Expecter) GetAuditEvents(ctx interface{}, db interface{}, arg interface{}) *MockQuerier_GetAuditEvents_Call {
+	return &MockQuerier_GetAuditEvents_Call{Call: _e.mock.On("GetAuditEvents", ctx, db, arg)}
+}
+
+func (_c *MockQuerier_GetAuditEvents_Call) Run(run func(ctx context.Context, db DBTX, arg GetAuditEventsParams)) *MockQuerier_GetAuditEvents_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(DBTX), args[2].(GetAuditEventsParams))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_GetAuditEvents_Call) Return(_a0 []NeosyncApiAuditEvent, _a1 error) *MockQuerier_GetAuditEvents_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQuerier_GetAuditEvents_Call) RunAndReturn(run func(context.Context, DBTX, GetAuditEventsParams) ([]NeosyncApiAuditEvent, error)) *MockQuerier_GetAuditEvents_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetConnectionById provides a mock function with given fields: ctx, db, id
 func (_m *MockQuerier) GetConnectionById(ctx context.Context, db DBTX, id pgtype.UUID) (NeosyncApiConnection, error) {
 	ret := _m.Called(ctx, db, id)
@@ -1691,6 +2147,66 @@ func (_c *MockQuerier_GetConnectionByNameAndAccount_Call) RunAndReturn(run func(
 	return _c
 }
 
+// GetConnectionsBatchForUpdate provides a mock function with given fields: ctx, db, arg
+func (_m *MockQuerier) GetConnectionsBatchForUpdate(ctx context.Context, db DBTX, arg GetConnectionsBatchForUpdateParams) ([]NeosyncApiConnection, error) {
+	ret := _m.Called(ctx, db, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetConnectionsBatchForUpdate")
+	}
+
+	var r0 []NeosyncApiConnection
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX, GetConnectionsBatchForUpdateParams) ([]NeosyncApiConnection, error)); ok {
+		return rf(ctx, db, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX, GetConnectionsBatchForUpdateParams) []NeosyncApiConnection); ok {
+		r0 = rf(ctx, db, arg)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]NeosyncApiConnection)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, DBTX, GetConnectionsBatchForUpdateParams) error); ok {
+		r1 = rf(ctx, db, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQuerier_GetConnectionsBatchForUpdate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetConnectionsBatchForUpdate'
+type MockQuerier_GetConnectionsBatchForUpdate_Call struct {
+	*mock.Call
+}
+
+// GetConnectionsBatchForUpdate is a helper method to define mock.On call
+//   - ctx context.Context
+//   - db DBTX
+//   - arg GetConnectionsBatchForUpdateParams
+func (_e *MockQuerier_Expecter) GetConnectionsBatchForUpdate(ctx interface{}, db interface{}, arg interface{}) *MockQuerier_GetConnectionsBatchForUpdate_Call {
+	return &MockQuerier_GetConnectionsBatchForUpdate_Call{Call: _e.mock.On("GetConnectionsBatchForUpdate", ctx, db, arg)}
+}
+
+func (_c *MockQuerier_GetConnectionsBatchForUpdate_Call) Run(run func(ctx context.Context, db DBTX, arg GetConnectionsBatchForUpdateParams)) *MockQuerier_GetConnectionsBatchForUpdate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(DBTX), args[2].(GetConnectionsBatchForUpdateParams))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_GetConnectionsBatchForUpdate_Call) Return(_a0 []NeosyncApiConnection, _a1 error) *MockQuerier_GetConnectionsBatchForUpdate_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQuerier_GetConnectionsBatchForUpdate_Call) RunAndReturn(run func(context.Context, DBTX, GetConnectionsBatchForUpdateParams) ([]NeosyncApiConnection, error)) *MockQuerier_GetConnectionsBatchForUpdate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetConnectionsByAccount provides a mock function with given fields: ctx, db, accountid
 func (_m *MockQuerier) GetConnectionsByAccount(ctx context.Context, db DBTX, accountid pgtype.UUID) ([]NeosyncApiConnection, error) {
 	ret := _m.Called(ctx, db, accountid)
@@ -1811,6 +2327,66 @@ func (_c *MockQuerier_GetConnectionsByIds_Call) RunAndReturn(run func(context.Co
 	return _c
 }
 
+// GetEnabledWebhooksByEventType provides a mock function with given fields: ctx, db, arg
+func (_m *MockQuerier) GetEnabledWebhooksByEventType(ctx context.Context, db DBTX, arg GetEnabledWebhooksByEventTypeParams) ([]NeosyncApiWebhook, error) {
+	ret := _m.Called(ctx, db, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetEnabledWebhooksByEventType")
+	}
+
+	var r0 []NeosyncApiWebhook
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX, GetEnabledWebhooksByEventTypeParams) ([]NeosyncApiWebhook, error)); ok {
+		return rf(ctx, db, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX, GetEnabledWebhooksByEventTypeParams) []NeosyncApiWebhook); ok {
+		r0 = rf(ctx, db, arg)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]NeosyncApiWebhook)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, DBTX, GetEnabledWebhooksByEventTypeParams) error); ok {
+		r1 = rf(ctx, db, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQuerier_GetEnabledWebhooksByEventType_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetEnabledWebhooksByEventType'
+type MockQuerier_GetEnabledWebhooksByEventType_Call struct {
+	*mock.Call
+}
+
+// GetEnabledWebhooksByEventType is a helper method to define mock.On call
+//   - ctx context.Context
+//   - db DBTX
+//   - arg GetEnabledWebhooksByEventTypeParams
+func (_e *MockQuerier_Expecter) GetEnabledWebhooksByEventType(ctx interface{}, db interface{}, arg interface{}) *MockQuerier_GetEnabledWebhooksByEventType_Call {
+	return &MockQuerier_GetEnabledWebhooksByEventType_Call{Call: _e.mock.On("GetEnabledWebhooksByEventType", ctx, db, arg)}
+}
+
+func (_c *MockQuerier_GetEnabledWebhooksByEventType_Call) Run(run func(ctx context.Context, db DBTX, arg GetEnabledWebhooksByEventTypeParams)) *MockQuerier_GetEnabledWebhooksByEventType_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(DBTX), args[2].(GetEnabledWebhooksByEventTypeParams))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_GetEnabledWebhooksByEventType_Call) Return(_a0 []NeosyncApiWebhook, _a1 error) *MockQuerier_GetEnabledWebhooksByEventType_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQuerier_GetEnabledWebhooksByEventType_Call) RunAndReturn(run func(context.Context, DBTX, GetEnabledWebhooksByEventTypeParams) ([]NeosyncApiWebhook, error)) *MockQuerier_GetEnabledWebhooksByEventType_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetJobById provides a mock function with given fields: ctx, db, id
 func (_m *MockQuerier) GetJobById(ctx context.Context, db DBTX, id pgtype.UUID) (NeosyncApiJob, error) {
 	ret := _m.Called(ctx, db, id)
@@ -2105,29 +2681,27 @@ func (_c *MockQuerier_GetJobConnectionDestinationsByJobIds_Call) RunAndReturn(ru
 	return _c
 }
 
-// GetJobsByAccount provides a mock function with given fields: ctx, db, accountid
-func (_m *MockQuerier) GetJobsByAccount(ctx context.Context, db DBTX, accountid pgtype.UUID) ([]NeosyncApiJob, error) {
-	ret := _m.Called(ctx, db, accountid)
+// GetJobRunSchemaDriftReport provides a mock function with given fields: ctx, db, arg
+func (_m *MockQuerier) GetJobRunSchemaDriftReport(ctx context.Context, db DBTX, arg GetJobRunSchemaDriftReportParams) (NeosyncApiJobRunSchemaDriftReport, error) {
+	ret := _m.Called(ctx, db, arg)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetJobsByAccount")
+		panic("no return value specified for GetJobRunSchemaDriftReport")
 	}
 
-	var r0 []NeosyncApiJob
+	var r0 NeosyncApiJobRunSchemaDriftReport
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, DBTX, pgtype.UUID) ([]NeosyncApiJob, error)); ok {
-		return rf(ctx, db, accountid)
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX, GetJobRunSchemaDriftReportParams) (NeosyncApiJobRunSchemaDriftReport, error)); ok {
+		return rf(ctx, db, arg)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, DBTX, pgtype.UUID) []NeosyncApiJob); ok {
-		r0 = rf(ctx, db, accountid)
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX, GetJobRunSchemaDriftReportParams) NeosyncApiJobRunSchemaDriftReport); ok {
+		r0 = rf(ctx, db, arg)
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]NeosyncApiJob)
-		}
+		r0 = ret.Get(0).(NeosyncApiJobRunSchemaDriftReport)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, DBTX, pgtype.UUID) error); ok {
-		r1 = rf(ctx, db, accountid)
+	if rf, ok := ret.Get(1).(func(context.Context, DBTX, GetJobRunSchemaDriftReportParams) error); ok {
+		r1 = rf(ctx, db, arg)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -2135,57 +2709,57 @@ func (_m *MockQuerier) GetJobsByAccount(ctx context.Context, db DBTX, accountid
 	return r0, r1
 }
 
-// MockQuerier_GetJobsByAccount_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetJobsByAccount'
-type MockQuerier_GetJobsByAccount_Call struct {
+// MockQuerier_GetJobRunSchemaDriftReport_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetJobRunSchemaDriftReport'
+type MockQuerier_GetJobRunSchemaDriftReport_Call struct {
 	*mock.Call
 }
 
-// GetJobsByAccount is a helper method to define mock.On call
+// GetJobRunSchemaDriftReport is a helper method to define mock.On call
 //   - ctx context.Context
 //   - db DBTX
-//   - accountid pgtype.UUID
-func (_e *MockQuerier_Expecter) GetJobsByAccount(ctx interface{}, db interface{}, accountid interface{}) *MockQuerier_GetJobsByAccount_Call {
-	return &MockQuerier_GetJobsByAccount_Call{Call: _e.mock.On("GetJobsByAccount", ctx, db, accountid)}
+//   - arg GetJobRunSchemaDriftReportParams
+func (_e *MockQuerier_Expecter) GetJobRunSchemaDriftReport(ctx interface{}, db interface{}, arg interface{}) *MockQuerier_GetJobRunSchemaDriftReport_Call {
+	return &MockQuerier_GetJobRunSchemaDriftReport_Call{Call: _e.mock.On("GetJobRunSchemaDriftReport", ctx, db, arg)}
 }
 
-func (_c *MockQuerier_GetJobsByAccount_Call) Run(run func(ctx context.Context, db DBTX, accountid pgtype.UUID)) *MockQuerier_GetJobsByAccount_Call {
+func (_c *MockQuerier_GetJobRunSchemaDriftReport_Call) Run(run func(ctx context.Context, db DBTX, arg GetJobRunSchemaDriftReportParams)) *MockQuerier_GetJobRunSchemaDriftReport_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(DBTX), args[2].(pgtype.UUID))
+		run(args[0].(context.Context), args[1].(DBTX), args[2].(GetJobRunSchemaDriftReportParams))
 	})
 	return _c
 }
 
-func (_c *MockQuerier_GetJobsByAccount_Call) Return(_a0 []NeosyncApiJob, _a1 error) *MockQuerier_GetJobsByAccount_Call {
+func (_c *MockQuerier_GetJobRunSchemaDriftReport_Call) Return(_a0 NeosyncApiJobRunSchemaDriftReport, _a1 error) *MockQuerier_GetJobRunSchemaDriftReport_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockQuerier_GetJobsByAccount_Call) RunAndReturn(run func(context.Context, DBTX, pgtype.UUID) ([]NeosyncApiJob, error)) *MockQuerier_GetJobsByAccount_Call {
+func (_c *MockQuerier_GetJobRunSchemaDriftReport_Call) RunAndReturn(run func(context.Context, DBTX, GetJobRunSchemaDriftReportParams) (NeosyncApiJobRunSchemaDriftReport, error)) *MockQuerier_GetJobRunSchemaDriftReport_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetPersonalAccountByUserId provides a mock function with given fields: ctx, db, userid
-func (_m *MockQuerier) GetPersonalAccountByUserId(ctx context.Context, db DBTX, userid pgtype.UUID) (NeosyncApiAccount, error) {
-	ret := _m.Called(ctx, db, userid)
+// GetJobRunValidationReport provides a mock function with given fields: ctx, db, arg
+func (_m *MockQuerier) GetJobRunValidationReport(ctx context.Context, db DBTX, arg GetJobRunValidationReportParams) (NeosyncApiJobRunValidationReport, error) {
+	ret := _m.Called(ctx, db, arg)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetPersonalAccountByUserId")
+		panic("no return value specified for GetJobRunValidationReport")
 	}
 
-	var r0 NeosyncApiAccount
+	var r0 NeosyncApiJobRunValidationReport
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, DBTX, pgtype.UUID) (NeosyncApiAccount, error)); ok {
-		return rf(ctx, db, userid)
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX, GetJobRunValidationReportParams) (NeosyncApiJobRunValidationReport, error)); ok {
+		return rf(ctx, db, arg)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, DBTX, pgtype.UUID) NeosyncApiAccount); ok {
-		r0 = rf(ctx, db, userid)
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX, GetJobRunValidationReportParams) NeosyncApiJobRunValidationReport); ok {
+		r0 = rf(ctx, db, arg)
 	} else {
-		r0 = ret.Get(0).(NeosyncApiAccount)
+		r0 = ret.Get(0).(NeosyncApiJobRunValidationReport)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, DBTX, pgtype.UUID) error); ok {
-		r1 = rf(ctx, db, userid)
+	if rf, ok := ret.Get(1).(func(context.Context, DBTX, GetJobRunValidationReportParams) error); ok {
+		r1 = rf(ctx, db, arg)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -2193,59 +2767,59 @@ func (_m *MockQuerier) GetPersonalAccountByUserId(ctx context.Context, db DBTX,
 	return r0, r1
 }
 
-// MockQuerier_GetPersonalAccountByUserId_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPersonalAccountByUserId'
-type MockQuerier_GetPersonalAccountByUserId_Call struct {
+// MockQuerier_GetJobRunValidationReport_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetJobRunValidationReport'
+type MockQuerier_GetJobRunValidationReport_Call struct {
 	*mock.Call
 }
 
-// GetPersonalAccountByUserId is a helper method to define mock.On call
+// GetJobRunValidationReport is a helper method to define mock.On call
 //   - ctx context.Context
 //   - db DBTX
-//   - userid pgtype.UUID
-func (_e *MockQuerier_Expecter) GetPersonalAccountByUserId(ctx interface{}, db interface{}, userid interface{}) *MockQuerier_GetPersonalAccountByUserId_Call {
-	return &MockQuerier_GetPersonalAccountByUserId_Call{Call: _e.mock.On("GetPersonalAccountByUserId", ctx, db, userid)}
+//   - arg GetJobRunValidationReportParams
+func (_e *MockQuerier_Expecter) GetJobRunValidationReport(ctx interface{}, db interface{}, arg interface{}) *MockQuerier_GetJobRunValidationReport_Call {
+	return &MockQuerier_GetJobRunValidationReport_Call{Call: _e.mock.On("GetJobRunValidationReport", ctx, db, arg)}
 }
 
-func (_c *MockQuerier_GetPersonalAccountByUserId_Call) Run(run func(ctx context.Context, db DBTX, userid pgtype.UUID)) *MockQuerier_GetPersonalAccountByUserId_Call {
+func (_c *MockQuerier_GetJobRunValidationReport_Call) Run(run func(ctx context.Context, db DBTX, arg GetJobRunValidationReportParams)) *MockQuerier_GetJobRunValidationReport_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(DBTX), args[2].(pgtype.UUID))
+		run(args[0].(context.Context), args[1].(DBTX), args[2].(GetJobRunValidationReportParams))
 	})
 	return _c
 }
 
-func (_c *MockQuerier_GetPersonalAccountByUserId_Call) Return(_a0 NeosyncApiAccount, _a1 error) *MockQuerier_GetPersonalAccountByUserId_Call {
+func (_c *MockQuerier_GetJobRunValidationReport_Call) Return(_a0 NeosyncApiJobRunValidationReport, _a1 error) *MockQuerier_GetJobRunValidationReport_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockQuerier_GetPersonalAccountByUserId_Call) RunAndReturn(run func(context.Context, DBTX, pgtype.UUID) (NeosyncApiAccount, error)) *MockQuerier_GetPersonalAccountByUserId_Call {
+func (_c *MockQuerier_GetJobRunValidationReport_Call) RunAndReturn(run func(context.Context, DBTX, GetJobRunValidationReportParams) (NeosyncApiJobRunValidationReport, error)) *MockQuerier_GetJobRunValidationReport_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetTeamAccountsByUserId provides a mock function with given fields: ctx, db, userid
-func (_m *MockQuerier) GetTeamAccountsByUserId(ctx context.Context, db DBTX, userid pgtype.UUID) ([]NeosyncApiAccount, error) {
-	ret := _m.Called(ctx, db, userid)
+// GetJobRunWatermarks provides a mock function with given fields: ctx, db, arg
+func (_m *MockQuerier) GetJobRunWatermarks(ctx context.Context, db DBTX, arg GetJobRunWatermarksParams) ([]NeosyncApiJobRunWatermark, error) {
+	ret := _m.Called(ctx, db, arg)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetTeamAccountsByUserId")
+		panic("no return value specified for GetJobRunWatermarks")
 	}
 
-	var r0 []NeosyncApiAccount
+	var r0 []NeosyncApiJobRunWatermark
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, DBTX, pgtype.UUID) ([]NeosyncApiAccount, error)); ok {
-		return rf(ctx, db, userid)
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX, GetJobRunWatermarksParams) ([]NeosyncApiJobRunWatermark, error)); ok {
+		return rf(ctx, db, arg)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, DBTX, pgtype.UUID) []NeosyncApiAccount); ok {
-		r0 = rf(ctx, db, userid)
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX, GetJobRunWatermarksParams) []NeosyncApiJobRunWatermark); ok {
+		r0 = rf(ctx, db, arg)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]NeosyncApiAccount)
+			r0 = ret.Get(0).([]NeosyncApiJobRunWatermark)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, DBTX, pgtype.UUID) error); ok {
-		r1 = rf(ctx, db, userid)
+	if rf, ok := ret.Get(1).(func(context.Context, DBTX, GetJobRunWatermarksParams) error); ok {
+		r1 = rf(ctx, db, arg)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -2253,55 +2827,53 @@ func (_m *MockQuerier) GetTeamAccountsByUserId(ctx context.Context, db DBTX, use
 	return r0, r1
 }
 
-// MockQuerier_GetTeamAccountsByUserId_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetTeamAccountsByUserId'
-type MockQuerier_GetTeamAccountsByUserId_Call struct {
+// MockQuerier_GetJobRunWatermarks_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetJobRunWatermarks'
+type MockQuerier_GetJobRunWatermarks_Call struct {
 	*mock.Call
 }
 
-// GetTeamAccountsByUserId is a helper method to define mock.On call
+// GetJobRunWatermarks is a helper method to define mock.On call
 //   - ctx context.Context
 //   - db DBTX
-//   - userid pgtype.UUID
-func (_e *MockQuerier_Expecter) GetTeamAccountsByUserId(ctx interface{}, db interface{}, userid interface{}) *MockQuerier_GetTeamAccountsByUserId_Call {
-	return &MockQuerier_GetTeamAccountsByUserId_Call{Call: _e.mock.On("GetTeamAccountsByUserId", ctx, db, userid)}
+//   - arg GetJobRunWatermarksParams
+func (_e *MockQuerier_Expecter) GetJobRunWatermarks(ctx interface{}, db interface{}, arg interface{}) *MockQuerier_GetJobRunWatermarks_Call {
+	return &MockQuerier_GetJobRunWatermarks_Call{Call: _e.mock.On("GetJobRunWatermarks", ctx, db, arg)}
 }
 
-func (_c *MockQuerier_GetTeamAccountsByUserId_Call) Run(run func(ctx context.Context, db DBTX, userid pgtype.UUID)) *MockQuerier_GetTeamAccountsByUserId_Call {
+func (_c *MockQuerier_GetJobRunWatermarks_Call) Run(run func(ctx context.Context, db DBTX, arg GetJobRunWatermarksParams)) *MockQuerier_GetJobRunWatermarks_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(DBTX), args[2].(pgtype.UUID))
+		run(args[0].(context.Context), args[1].(DBTX), args[2].(GetJobRunWatermarksParams))
 	})
 	return _c
 }
 
-func (_c *MockQuerier_GetTeamAccountsByUserId_Call) Return(_a0 []NeosyncApiAccount, _a1 error) *MockQuerier_GetTeamAccountsByUserId_Call {
+func (_c *MockQuerier_GetJobRunWatermarks_Call) Return(_a0 []NeosyncApiJobRunWatermark, _a1 error) *MockQuerier_GetJobRunWatermarks_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockQuerier_GetTeamAccountsByUserId_Call) RunAndReturn(run func(context.Context, DBTX, pgtype.UUID) ([]NeosyncApiAccount, error)) *MockQuerier_GetTeamAccountsByUserId_Call {
+func (_c *MockQuerier_GetJobRunWatermarks_Call) RunAndReturn(run func(context.Context, DBTX, GetJobRunWatermarksParams) ([]NeosyncApiJobRunWatermark, error)) *MockQuerier_GetJobRunWatermarks_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetTemporalConfigByAccount provides a mock function with given fields: ctx, db, id
-func (_m *MockQuerier) GetTemporalConfigByAccount(ctx context.Context, db DBTX, id pgtype.UUID) (*pg_models.TemporalConfig, error) {
+// GetJobVersionById provides a mock function with given fields: ctx, db, id
+func (_m *MockQuerier) GetJobVersionById(ctx context.Context, db DBTX, id pgtype.UUID) (NeosyncApiJobVersion, error) {
 	ret := _m.Called(ctx, db, id)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetTemporalConfigByAccount")
+		panic("no return value specified for GetJobVersionById")
 	}
 
-	var r0 *pg_models.TemporalConfig
+	var r0 NeosyncApiJobVersion
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, DBTX, pgtype.UUID) (*pg_models.TemporalConfig, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX, pgtype.UUID) (NeosyncApiJobVersion, error)); ok {
 		return rf(ctx, db, id)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, DBTX, pgtype.UUID) *pg_models.TemporalConfig); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX, pgtype.UUID) NeosyncApiJobVersion); ok {
 		r0 = rf(ctx, db, id)
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*pg_models.TemporalConfig)
-		}
+		r0 = ret.Get(0).(NeosyncApiJobVersion)
 	}
 
 	if rf, ok := ret.Get(1).(func(context.Context, DBTX, pgtype.UUID) error); ok {
@@ -2313,59 +2885,59 @@ func (_m *MockQuerier) GetTemporalConfigByAccount(ctx context.Context, db DBTX,
 	return r0, r1
 }
 
-// MockQuerier_GetTemporalConfigByAccount_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetTemporalConfigByAccount'
-type MockQuerier_GetTemporalConfigByAccount_Call struct {
+// MockQuerier_GetJobVersionById_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetJobVersionById'
+type MockQuerier_GetJobVersionById_Call struct {
 	*mock.Call
 }
 
-// GetTemporalConfigByAccount is a helper method to define mock.On call
+// GetJobVersionById is a helper method to define mock.On call
 //   - ctx context.Context
 //   - db DBTX
 //   - id pgtype.UUID
-func (_e *MockQuerier_Expecter) GetTemporalConfigByAccount(ctx interface{}, db interface{}, id interface{}) *MockQuerier_GetTemporalConfigByAccount_Call {
-	return &MockQuerier_GetTemporalConfigByAccount_Call{Call: _e.mock.On("GetTemporalConfigByAccount", ctx, db, id)}
+func (_e *MockQuerier_Expecter) GetJobVersionById(ctx interface{}, db interface{}, id interface{}) *MockQuerier_GetJobVersionById_Call {
+	return &MockQuerier_GetJobVersionById_Call{Call: _e.mock.On("GetJobVersionById", ctx, db, id)}
 }
 
-func (_c *MockQuerier_GetTemporalConfigByAccount_Call) Run(run func(ctx context.Context, db DBTX, id pgtype.UUID)) *MockQuerier_GetTemporalConfigByAccount_Call {
+func (_c *MockQuerier_GetJobVersionById_Call) Run(run func(ctx context.Context, db DBTX, id pgtype.UUID)) *MockQuerier_GetJobVersionById_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		run(args[0].(context.Context), args[1].(DBTX), args[2].(pgtype.UUID))
 	})
 	return _c
 }
 
-func (_c *MockQuerier_GetTemporalConfigByAccount_Call) Return(_a0 *pg_models.TemporalConfig, _a1 error) *MockQuerier_GetTemporalConfigByAccount_Call {
+func (_c *MockQuerier_GetJobVersionById_Call) Return(_a0 NeosyncApiJobVersion, _a1 error) *MockQuerier_GetJobVersionById_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockQuerier_GetTemporalConfigByAccount_Call) RunAndReturn(run func(context.Context, DBTX, pgtype.UUID) (*pg_models.TemporalConfig, error)) *MockQuerier_GetTemporalConfigByAccount_Call {
+func (_c *MockQuerier_GetJobVersionById_Call) RunAndReturn(run func(context.Context, DBTX, pgtype.UUID) (NeosyncApiJobVersion, error)) *MockQuerier_GetJobVersionById_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetTemporalConfigByUserAccount provides a mock function with given fields: ctx, db, arg
-func (_m *MockQuerier) GetTemporalConfigByUserAccount(ctx context.Context, db DBTX, arg GetTemporalConfigByUserAccountParams) (*pg_models.TemporalConfig, error) {
-	ret := _m.Called(ctx, db, arg)
+// GetJobVersions provides a mock function with given fields: ctx, db, jobID
+func (_m *MockQuerier) GetJobVersions(ctx context.Context, db DBTX, jobID pgtype.UUID) ([]NeosyncApiJobVersion, error) {
+	ret := _m.Called(ctx, db, jobID)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetTemporalConfigByUserAccount")
+		panic("no return value specified for GetJobVersions")
 	}
 
-	var r0 *pg_models.TemporalConfig
+	var r0 []NeosyncApiJobVersion
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, DBTX, GetTemporalConfigByUserAccountParams) (*pg_models.TemporalConfig, error)); ok {
-		return rf(ctx, db, arg)
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX, pgtype.UUID) ([]NeosyncApiJobVersion, error)); ok {
+		return rf(ctx, db, jobID)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, DBTX, GetTemporalConfigByUserAccountParams) *pg_models.TemporalConfig); ok {
-		r0 = rf(ctx, db, arg)
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX, pgtype.UUID) []NeosyncApiJobVersion); ok {
+		r0 = rf(ctx, db, jobID)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*pg_models.TemporalConfig)
+			r0 = ret.Get(0).([]NeosyncApiJobVersion)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, DBTX, GetTemporalConfigByUserAccountParams) error); ok {
-		r1 = rf(ctx, db, arg)
+	if rf, ok := ret.Get(1).(func(context.Context, DBTX, pgtype.UUID) error); ok {
+		r1 = rf(ctx, db, jobID)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -2373,57 +2945,59 @@ func (_m *MockQuerier) GetTemporalConfigByUserAccount(ctx context.Context, db DB
 	return r0, r1
 }
 
-// MockQuerier_GetTemporalConfigByUserAccount_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetTemporalConfigByUserAccount'
-type MockQuerier_GetTemporalConfigByUserAccount_Call struct {
+// MockQuerier_GetJobVersions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetJobVersions'
+type MockQuerier_GetJobVersions_Call struct {
 	*mock.Call
 }
 
-// GetTemporalConfigByUserAccount is a helper method to define mock.On call
+// GetJobVersions is a helper method to define mock.On call
 //   - ctx context.Context
 //   - db DBTX
-//   - arg GetTemporalConfigByUserAccountParams
-func (_e *MockQuerier_Expecter) GetTemporalConfigByUserAccount(ctx interface{}, db interface{}, arg interface{}) *MockQuerier_GetTemporalConfigByUserAccount_Call {
-	return &MockQuerier_GetTemporalConfigByUserAccount_Call{Call: _e.mock.On("GetTemporalConfigByUserAccount", ctx, db, arg)}
+//   - jobID pgtype.UUID
+func (_e *MockQuerier_Expecter) GetJobVersions(ctx interface{}, db interface{}, jobID interface{}) *MockQuerier_GetJobVersions_Call {
+	return &MockQuerier_GetJobVersions_Call{Call: _e.mock.On("GetJobVersions", ctx, db, jobID)}
 }
 
-func (_c *MockQuerier_GetTemporalConfigByUserAccount_Call) Run(run func(ctx context.Context, db DBTX, arg GetTemporalConfigByUserAccountParams)) *MockQuerier_GetTemporalConfigByUserAccount_Call {
+func (_c *MockQuerier_GetJobVersions_Call) Run(run func(ctx context.Context, db DBTX, jobID pgtype.UUID)) *MockQuerier_GetJobVersions_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(DBTX), args[2].(GetTemporalConfigByUserAccountParams))
+		run(args[0].(context.Context), args[1].(DBTX), args[2].(pgtype.UUID))
 	})
 	return _c
 }
 
-func (_c *MockQuerier_GetTemporalConfigByUserAccount_Call) Return(_a0 *pg_models.TemporalConfig, _a1 error) *MockQuerier_GetTemporalConfigByUserAccount_Call {
+func (_c *MockQuerier_GetJobVersions_Call) Return(_a0 []NeosyncApiJobVersion, _a1 error) *MockQuerier_GetJobVersions_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockQuerier_GetTemporalConfigByUserAccount_Call) RunAndReturn(run func(context.Context, DBTX, GetTemporalConfigByUserAccountParams) (*pg_models.TemporalConfig, error)) *MockQuerier_GetTemporalConfigByUserAccount_Call {
+func (_c *MockQuerier_GetJobVersions_Call) RunAndReturn(run func(context.Context, DBTX, pgtype.UUID) ([]NeosyncApiJobVersion, error)) *MockQuerier_GetJobVersions_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetUser provides a mock function with given fields: ctx, db, id
-func (_m *MockQuerier) GetUser(ctx context.Context, db DBTX, id pgtype.UUID) (NeosyncApiUser, error) {
-	ret := _m.Called(ctx, db, id)
+// GetJobWatermarks provides a mock function with given fields: ctx, db, jobID
+func (_m *MockQuerier) GetJobWatermarks(ctx context.Context, db DBTX, jobID pgtype.UUID) ([]NeosyncApiJobWatermark, error) {
+	ret := _m.Called(ctx, db, jobID)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetUser")
+		panic("no return value specified for GetJobWatermarks")
 	}
 
-	var r0 NeosyncApiUser
+	var r0 []NeosyncApiJobWatermark
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, DBTX, pgtype.UUID) (NeosyncApiUser, error)); ok {
-		return rf(ctx, db, id)
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX, pgtype.UUID) ([]NeosyncApiJobWatermark, error)); ok {
+		return rf(ctx, db, jobID)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, DBTX, pgtype.UUID) NeosyncApiUser); ok {
-		r0 = rf(ctx, db, id)
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX, pgtype.UUID) []NeosyncApiJobWatermark); ok {
+		r0 = rf(ctx, db, jobID)
 	} else {
-		r0 = ret.Get(0).(NeosyncApiUser)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]NeosyncApiJobWatermark)
+		}
 	}
 
 	if rf, ok := ret.Get(1).(func(context.Context, DBTX, pgtype.UUID) error); ok {
-		r1 = rf(ctx, db, id)
+		r1 = rf(ctx, db, jobID)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -2431,57 +3005,59 @@ func (_m *MockQuerier) GetUser(ctx context.Context, db DBTX, id pgtype.UUID) (Ne
 	return r0, r1
 }
 
-// MockQuerier_GetUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUser'
-type MockQuerier_GetUser_Call struct {
+// MockQuerier_GetJobWatermarks_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetJobWatermarks'
+type MockQuerier_GetJobWatermarks_Call struct {
 	*mock.Call
 }
 
-// GetUser is a helper method to define mock.On call
+// GetJobWatermarks is a helper method to define mock.On call
 //   - ctx context.Context
 //   - db DBTX
-//   - id pgtype.UUID
-func (_e *MockQuerier_Expecter) GetUser(ctx interface{}, db interface{}, id interface{}) *MockQuerier_GetUser_Call {
-	return &MockQuerier_GetUser_Call{Call: _e.mock.On("GetUser", ctx, db, id)}
+//   - jobID pgtype.UUID
+func (_e *MockQuerier_Expecter) GetJobWatermarks(ctx interface{}, db interface{}, jobID interface{}) *MockQuerier_GetJobWatermarks_Call {
+	return &MockQuerier_GetJobWatermarks_Call{Call: _e.mock.On("GetJobWatermarks", ctx, db, jobID)}
 }
 
-func (_c *MockQuerier_GetUser_Call) Run(run func(ctx context.Context, db DBTX, id pgtype.UUID)) *MockQuerier_GetUser_Call {
+func (_c *MockQuerier_GetJobWatermarks_Call) Run(run func(ctx context.Context, db DBTX, jobID pgtype.UUID)) *MockQuerier_GetJobWatermarks_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		run(args[0].(context.Context), args[1].(DBTX), args[2].(pgtype.UUID))
 	})
 	return _c
 }
 
-func (_c *MockQuerier_GetUser_Call) Return(_a0 NeosyncApiUser, _a1 error) *MockQuerier_GetUser_Call {
+func (_c *MockQuerier_GetJobWatermarks_Call) Return(_a0 []NeosyncApiJobWatermark, _a1 error) *MockQuerier_GetJobWatermarks_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockQuerier_GetUser_Call) RunAndReturn(run func(context.Context, DBTX, pgtype.UUID) (NeosyncApiUser, error)) *MockQuerier_GetUser_Call {
+func (_c *MockQuerier_GetJobWatermarks_Call) RunAndReturn(run func(context.Context, DBTX, pgtype.UUID) ([]NeosyncApiJobWatermark, error)) *MockQuerier_GetJobWatermarks_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetUserAssociationByProviderSub provides a mock function with given fields: ctx, db, providerSub
-func (_m *MockQuerier) GetUserAssociationByProviderSub(ctx context.Context, db DBTX, providerSub string) (NeosyncApiUserIdentityProviderAssociation, error) {
-	ret := _m.Called(ctx, db, providerSub)
+// GetJobsByAccount provides a mock function with given fields: ctx, db, accountid
+func (_m *MockQuerier) GetJobsByAccount(ctx context.Context, db DBTX, accountid pgtype.UUID) ([]NeosyncApiJob, error) {
+	ret := _m.Called(ctx, db, accountid)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetUserAssociationByProviderSub")
+		panic("no return value specified for GetJobsByAccount")
 	}
 
-	var r0 NeosyncApiUserIdentityProviderAssociation
+	var r0 []NeosyncApiJob
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, DBTX, string) (NeosyncApiUserIdentityProviderAssociation, error)); ok {
-		return rf(ctx, db, providerSub)
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX, pgtype.UUID) ([]NeosyncApiJob, error)); ok {
+		return rf(ctx, db, accountid)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, DBTX, string) NeosyncApiUserIdentityProviderAssociation); ok {
-		r0 = rf(ctx, db, providerSub)
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX, pgtype.UUID) []NeosyncApiJob); ok {
+		r0 = rf(ctx, db, accountid)
 	} else {
-		r0 = ret.Get(0).(NeosyncApiUserIdentityProviderAssociation)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]NeosyncApiJob)
+		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, DBTX, string) error); ok {
-		r1 = rf(ctx, db, providerSub)
+	if rf, ok := ret.Get(1).(func(context.Context, DBTX, pgtype.UUID) error); ok {
+		r1 = rf(ctx, db, accountid)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -2489,57 +3065,59 @@ func (_m *MockQuerier) GetUserAssociationByProviderSub(ctx context.Context, db D
 	return r0, r1
 }
 
-// MockQuerier_GetUserAssociationByProviderSub_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUserAssociationByProviderSub'
-type MockQuerier_GetUserAssociationByProviderSub_Call struct {
+// MockQuerier_GetJobsByAccount_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetJobsByAccount'
+type MockQuerier_GetJobsByAccount_Call struct {
 	*mock.Call
 }
 
-// GetUserAssociationByProviderSub is a helper method to define mock.On call
+// GetJobsByAccount is a helper method to define mock.On call
 //   - ctx context.Context
 //   - db DBTX
-//   - providerSub string
-func (_e *MockQuerier_Expecter) GetUserAssociationByProviderSub(ctx interface{}, db interface{}, providerSub interface{}) *MockQuerier_GetUserAssociationByProviderSub_Call {
-	return &MockQuerier_GetUserAssociationByProviderSub_Call{Call: _e.mock.On("GetUserAssociationByProviderSub", ctx, db, providerSub)}
+//   - accountid pgtype.UUID
+func (_e *MockQuerier_Expecter) GetJobsByAccount(ctx interface{}, db interface{}, accountid interface{}) *MockQuerier_GetJobsByAccount_Call {
+	return &MockQuerier_GetJobsByAccount_Call{Call: _e.mock.On("GetJobsByAccount", ctx, db, accountid)}
 }
 
-func (_c *MockQuerier_GetUserAssociationByProviderSub_Call) Run(run func(ctx context.Context, db DBTX, providerSub string)) *MockQuerier_GetUserAssociationByProviderSub_Call {
+func (_c *MockQuerier_GetJobsByAccount_Call) Run(run func(ctx context.Context, db DBTX, accountid pgtype.UUID)) *MockQuerier_GetJobsByAccount_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(DBTX), args[2].(string))
+		run(args[0].(context.Context), args[1].(DBTX), args[2].(pgtype.UUID))
 	})
 	return _c
 }
 
-func (_c *MockQuerier_GetUserAssociationByProviderSub_Call) Return(_a0 NeosyncApiUserIdentityProviderAssociation, _a1 error) *MockQuerier_GetUserAssociationByProviderSub_Call {
+func (_c *MockQuerier_GetJobsByAccount_Call) Return(_a0 []NeosyncApiJob, _a1 error) *MockQuerier_GetJobsByAccount_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockQuerier_GetUserAssociationByProviderSub_Call) RunAndReturn(run func(context.Context, DBTX, string) (NeosyncApiUserIdentityProviderAssociation, error)) *MockQuerier_GetUserAssociationByProviderSub_Call {
+func (_c *MockQuerier_GetJobsByAccount_Call) RunAndReturn(run func(context.Context, DBTX, pgtype.UUID) ([]NeosyncApiJob, error)) *MockQuerier_GetJobsByAccount_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetUserByProviderSub provides a mock function with given fields: ctx, db, providerSub
-func (_m *MockQuerier) GetUserByProviderSub(ctx context.Context, db DBTX, providerSub string) (NeosyncApiUser, error) {
-	ret := _m.Called(ctx, db, providerSub)
+// GetJobsTriggeredByJob provides a mock function with given fields: ctx, db, arg
+func (_m *MockQuerier) GetJobsTriggeredByJob(ctx context.Context, db DBTX, arg GetJobsTriggeredByJobParams) ([]NeosyncApiJob, error) {
+	ret := _m.Called(ctx, db, arg)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetUserByProviderSub")
+		panic("no return value specified for GetJobsTriggeredByJob")
 	}
 
-	var r0 NeosyncApiUser
+	var r0 []NeosyncApiJob
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, DBTX, string) (NeosyncApiUser, error)); ok {
-		return rf(ctx, db, providerSub)
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX, GetJobsTriggeredByJobParams) ([]NeosyncApiJob, error)); ok {
+		return rf(ctx, db, arg)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, DBTX, string) NeosyncApiUser); ok {
-		r0 = rf(ctx, db, providerSub)
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX, GetJobsTriggeredByJobParams) []NeosyncApiJob); ok {
+		r0 = rf(ctx, db, arg)
 	} else {
-		r0 = ret.Get(0).(NeosyncApiUser)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]NeosyncApiJob)
+		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, DBTX, string) error); ok {
-		r1 = rf(ctx, db, providerSub)
+	if rf, ok := ret.Get(1).(func(context.Context, DBTX, GetJobsTriggeredByJobParams) error); ok {
+		r1 = rf(ctx, db, arg)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -2547,57 +3125,57 @@ func (_m *MockQuerier) GetUserByProviderSub(ctx context.Context, db DBTX, provid
 	return r0, r1
 }
 
-// MockQuerier_GetUserByProviderSub_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUserByProviderSub'
-type MockQuerier_GetUserByProviderSub_Call struct {
+// MockQuerier_GetJobsTriggeredByJob_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetJobsTriggeredByJob'
+type MockQuerier_GetJobsTriggeredByJob_Call struct {
 	*mock.Call
 }
 
-// GetUserByProviderSub is a helper method to define mock.On call
+// GetJobsTriggeredByJob is a helper method to define mock.On call
 //   - ctx context.Context
 //   - db DBTX
-//   - providerSub string
-func (_e *MockQuerier_Expecter) GetUserByProviderSub(ctx interface{}, db interface{}, providerSub interface{}) *MockQuerier_GetUserByProviderSub_Call {
-	return &MockQuerier_GetUserByProviderSub_Call{Call: _e.mock.On("GetUserByProviderSub", ctx, db, providerSub)}
+//   - arg GetJobsTriggeredByJobParams
+func (_e *MockQuerier_Expecter) GetJobsTriggeredByJob(ctx interface{}, db interface{}, arg interface{}) *MockQuerier_GetJobsTriggeredByJob_Call {
+	return &MockQuerier_GetJobsTriggeredByJob_Call{Call: _e.mock.On("GetJobsTriggeredByJob", ctx, db, arg)}
 }
 
-func (_c *MockQuerier_GetUserByProviderSub_Call) Run(run func(ctx context.Context, db DBTX, providerSub string)) *MockQuerier_GetUserByProviderSub_Call {
+func (_c *MockQuerier_GetJobsTriggeredByJob_Call) Run(run func(ctx context.Context, db DBTX, arg GetJobsTriggeredByJobParams)) *MockQuerier_GetJobsTriggeredByJob_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(DBTX), args[2].(string))
+		run(args[0].(context.Context), args[1].(DBTX), args[2].(GetJobsTriggeredByJobParams))
 	})
 	return _c
 }
 
-func (_c *MockQuerier_GetUserByProviderSub_Call) Return(_a0 NeosyncApiUser, _a1 error) *MockQuerier_GetUserByProviderSub_Call {
+func (_c *MockQuerier_GetJobsTriggeredByJob_Call) Return(_a0 []NeosyncApiJob, _a1 error) *MockQuerier_GetJobsTriggeredByJob_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockQuerier_GetUserByProviderSub_Call) RunAndReturn(run func(context.Context, DBTX, string) (NeosyncApiUser, error)) *MockQuerier_GetUserByProviderSub_Call {
+func (_c *MockQuerier_GetJobsTriggeredByJob_Call) RunAndReturn(run func(context.Context, DBTX, GetJobsTriggeredByJobParams) ([]NeosyncApiJob, error)) *MockQuerier_GetJobsTriggeredByJob_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetUserDefinedTransformerById provides a mock function with given fields: ctx, db, id
-func (_m *MockQuerier) GetUserDefinedTransformerById(ctx context.Context, db DBTX, id pgtype.UUID) (NeosyncApiTransformer, error) {
-	ret := _m.Called(ctx, db, id)
+// GetLatestJobRunSchemaDriftReport provides a mock function with given fields: ctx, db, jobID
+func (_m *MockQuerier) GetLatestJobRunSchemaDriftReport(ctx context.Context, db DBTX, jobID pgtype.UUID) (NeosyncApiJobRunSchemaDriftReport, error) {
+	ret := _m.Called(ctx, db, jobID)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetUserDefinedTransformerById")
+		panic("no return value specified for GetLatestJobRunSchemaDriftReport")
 	}
 
-	var r0 NeosyncApiTransformer
+	var r0 NeosyncApiJobRunSchemaDriftReport
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, DBTX, pgtype.UUID) (NeosyncApiTransformer, error)); ok {
-		return rf(ctx, db, id)
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX, pgtype.UUID) (NeosyncApiJobRunSchemaDriftReport, error)); ok {
+		return rf(ctx, db, jobID)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, DBTX, pgtype.UUID) NeosyncApiTransformer); ok {
-		r0 = rf(ctx, db, id)
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX, pgtype.UUID) NeosyncApiJobRunSchemaDriftReport); ok {
+		r0 = rf(ctx, db, jobID)
 	} else {
-		r0 = ret.Get(0).(NeosyncApiTransformer)
+		r0 = ret.Get(0).(NeosyncApiJobRunSchemaDriftReport)
 	}
 
 	if rf, ok := ret.Get(1).(func(context.Context, DBTX, pgtype.UUID) error); ok {
-		r1 = rf(ctx, db, id)
+		r1 = rf(ctx, db, jobID)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -2605,59 +3183,57 @@ func (_m *MockQuerier) GetUserDefinedTransformerById(ctx context.Context, db DBT
 	return r0, r1
 }
 
-// MockQuerier_GetUserDefinedTransformerById_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUserDefinedTransformerById'
-type MockQuerier_GetUserDefinedTransformerById_Call struct {
+// MockQuerier_GetLatestJobRunSchemaDriftReport_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetLatestJobRunSchemaDriftReport'
+type MockQuerier_GetLatestJobRunSchemaDriftReport_Call struct {
 	*mock.Call
 }
 
-// GetUserDefinedTransformerById is a helper method to define mock.On call
+// GetLatestJobRunSchemaDriftReport is a helper method to define mock.On call
 //   - ctx context.Context
 //   - db DBTX
-//   - id pgtype.UUID
-func (_e *MockQuerier_Expecter) GetUserDefinedTransformerById(ctx interface{}, db interface{}, id interface{}) *MockQuerier_GetUserDefinedTransformerById_Call {
-	return &MockQuerier_GetUserDefinedTransformerById_Call{Call: _e.mock.On("GetUserDefinedTransformerById", ctx, db, id)}
+//   - jobID pgtype.UUID
+func (_e *MockQuerier_Expecter) GetLatestJobRunSchemaDriftReport(ctx interface{}, db interface{}, jobID interface{}) *MockQuerier_GetLatestJobRunSchemaDriftReport_Call {
+	return &MockQuerier_GetLatestJobRunSchemaDriftReport_Call{Call: _e.mock.On("GetLatestJobRunSchemaDriftReport", ctx, db, jobID)}
 }
 
-func (_c *MockQuerier_GetUserDefinedTransformerById_Call) Run(run func(ctx context.Context, db DBTX, id pgtype.UUID)) *MockQuerier_GetUserDefinedTransformerById_Call {
+func (_c *MockQuerier_GetLatestJobRunSchemaDriftReport_Call) Run(run func(ctx context.Context, db DBTX, jobID pgtype.UUID)) *MockQuerier_GetLatestJobRunSchemaDriftReport_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		run(args[0].(context.Context), args[1].(DBTX), args[2].(pgtype.UUID))
 	})
 	return _c
 }
 
-func (_c *MockQuerier_GetUserDefinedTransformerById_Call) Return(_a0 NeosyncApiTransformer, _a1 error) *MockQuerier_GetUserDefinedTransformerById_Call {
+func (_c *MockQuerier_GetLatestJobRunSchemaDriftReport_Call) Return(_a0 NeosyncApiJobRunSchemaDriftReport, _a1 error) *MockQuerier_GetLatestJobRunSchemaDriftReport_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockQuerier_GetUserDefinedTransformerById_Call) RunAndReturn(run func(context.Context, DBTX, pgtype.UUID) (NeosyncApiTransformer, error)) *MockQuerier_GetUserDefinedTransformerById_Call {
+func (_c *MockQuerier_GetLatestJobRunSchemaDriftReport_Call) RunAndReturn(run func(context.Context, DBTX, pgtype.UUID) (NeosyncApiJobRunSchemaDriftReport, error)) *MockQuerier_GetLatestJobRunSchemaDriftReport_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetUserDefinedTransformersByAccount provides a mock function with given fields: ctx, db, accountid
-func (_m *MockQuerier) GetUserDefinedTransformersByAccount(ctx context.Context, db DBTX, accountid pgtype.UUID) ([]NeosyncApiTransformer, error) {
-	ret := _m.Called(ctx, db, accountid)
+// GetLatestJobVersion provides a mock function with given fields: ctx, db, jobID
+func (_m *MockQuerier) GetLatestJobVersion(ctx context.Context, db DBTX, jobID pgtype.UUID) (NeosyncApiJobVersion, error) {
+	ret := _m.Called(ctx, db, jobID)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetUserDefinedTransformersByAccount")
+		panic("no return value specified for GetLatestJobVersion")
 	}
 
-	var r0 []NeosyncApiTransformer
+	var r0 NeosyncApiJobVersion
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, DBTX, pgtype.UUID) ([]NeosyncApiTransformer, error)); ok {
-		return rf(ctx, db, accountid)
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX, pgtype.UUID) (NeosyncApiJobVersion, error)); ok {
+		return rf(ctx, db, jobID)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, DBTX, pgtype.UUID) []NeosyncApiTransformer); ok {
-		r0 = rf(ctx, db, accountid)
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX, pgtype.UUID) NeosyncApiJobVersion); ok {
+		r0 = rf(ctx, db, jobID)
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]NeosyncApiTransformer)
-		}
+		r0 = ret.Get(0).(NeosyncApiJobVersion)
 	}
 
 	if rf, ok := ret.Get(1).(func(context.Context, DBTX, pgtype.UUID) error); ok {
-		r1 = rf(ctx, db, accountid)
+		r1 = rf(ctx, db, jobID)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -2665,59 +3241,57 @@ func (_m *MockQuerier) GetUserDefinedTransformersByAccount(ctx context.Context,
 	return r0, r1
 }
 
-// MockQuerier_GetUserDefinedTransformersByAccount_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUserDefinedTransformersByAccount'
-type MockQuerier_GetUserDefinedTransformersByAccount_Call struct {
+// MockQuerier_GetLatestJobVersion_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetLatestJobVersion'
+type MockQuerier_GetLatestJobVersion_Call struct {
 	*mock.Call
 }
 
-// GetUserDefinedTransformersByAccount is a helper method to define mock.On call
+// GetLatestJobVersion is a helper method to define mock.On call
 //   - ctx context.Context
 //   - db DBTX
-//   - accountid pgtype.UUID
-func (_e *MockQuerier_Expecter) GetUserDefinedTransformersByAccount(ctx interface{}, db interface{}, accountid interface{}) *MockQuerier_GetUserDefinedTransformersByAccount_Call {
-	return &MockQuerier_GetUserDefinedTransformersByAccount_Call{Call: _e.mock.On("GetUserDefinedTransformersByAccount", ctx, db, accountid)}
+//   - jobID pgtype.UUID
+func (_e *MockQuerier_Expecter) GetLatestJobVersion(ctx interface{}, db interface{}, jobID interface{}) *MockQuerier_GetLatestJobVersion_Call {
+	return &MockQuerier_GetLatestJobVersion_Call{Call: _e.mock.On("GetLatestJobVersion", ctx, db, jobID)}
 }
 
-func (_c *MockQuerier_GetUserDefinedTransformersByAccount_Call) Run(run func(ctx context.Context, db DBTX, accountid pgtype.UUID)) *MockQuerier_GetUserDefinedTransformersByAccount_Call {
+func (_c *MockQuerier_GetLatestJobVersion_Call) Run(run func(ctx context.Context, db DBTX, jobID pgtype.UUID)) *MockQuerier_GetLatestJobVersion_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		run(args[0].(context.Context), args[1].(DBTX), args[2].(pgtype.UUID))
 	})
 	return _c
 }
 
-func (_c *MockQuerier_GetUserDefinedTransformersByAccount_Call) Return(_a0 []NeosyncApiTransformer, _a1 error) *MockQuerier_GetUserDefinedTransformersByAccount_Call {
+func (_c *MockQuerier_GetLatestJobVersion_Call) Return(_a0 NeosyncApiJobVersion, _a1 error) *MockQuerier_GetLatestJobVersion_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockQuerier_GetUserDefinedTransformersByAccount_Call) RunAndReturn(run func(context.Context, DBTX, pgtype.UUID) ([]NeosyncApiTransformer, error)) *MockQuerier_GetUserDefinedTransformersByAccount_Call {
+func (_c *MockQuerier_GetLatestJobVersion_Call) RunAndReturn(run func(context.Context, DBTX, pgtype.UUID) (NeosyncApiJobVersion, error)) *MockQuerier_GetLatestJobVersion_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetUserIdentitiesByTeamAccount provides a mock function with given fields: ctx, db, accountid
-func (_m *MockQuerier) GetUserIdentitiesByTeamAccount(ctx context.Context, db DBTX, accountid pgtype.UUID) ([]NeosyncApiUserIdentityProviderAssociation, error) {
-	ret := _m.Called(ctx, db, accountid)
+// GetPersonalAccountByUserId provides a mock function with given fields: ctx, db, userid
+func (_m *MockQuerier) GetPersonalAccountByUserId(ctx context.Context, db DBTX, userid pgtype.UUID) (NeosyncApiAccount, error) {
+	ret := _m.Called(ctx, db, userid)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetUserIdentitiesByTeamAccount")
+		panic("no return value specified for GetPersonalAccountByUserId")
 	}
 
-	var r0 []NeosyncApiUserIdentityProviderAssociation
+	var r0 NeosyncApiAccount
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, DBTX, pgtype.UUID) ([]NeosyncApiUserIdentityProviderAssociation, error)); ok {
-		return rf(ctx, db, accountid)
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX, pgtype.UUID) (NeosyncApiAccount, error)); ok {
+		return rf(ctx, db, userid)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, DBTX, pgtype.UUID) []NeosyncApiUserIdentityProviderAssociation); ok {
-		r0 = rf(ctx, db, accountid)
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX, pgtype.UUID) NeosyncApiAccount); ok {
+		r0 = rf(ctx, db, userid)
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]NeosyncApiUserIdentityProviderAssociation)
-		}
+		r0 = ret.Get(0).(NeosyncApiAccount)
 	}
 
 	if rf, ok := ret.Get(1).(func(context.Context, DBTX, pgtype.UUID) error); ok {
-		r1 = rf(ctx, db, accountid)
+		r1 = rf(ctx, db, userid)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -2725,59 +3299,59 @@ func (_m *MockQuerier) GetUserIdentitiesByTeamAccount(ctx context.Context, db DB
 	return r0, r1
 }
 
-// MockQuerier_GetUserIdentitiesByTeamAccount_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUserIdentitiesByTeamAccount'
-type MockQuerier_GetUserIdentitiesByTeamAccount_Call struct {
+// MockQuerier_GetPersonalAccountByUserId_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPersonalAccountByUserId'
+type MockQuerier_GetPersonalAccountByUserId_Call struct {
 	*mock.Call
 }
 
-// GetUserIdentitiesByTeamAccount is a helper method to define mock.On call
+// GetPersonalAccountByUserId is a helper method to define mock.On call
 //   - ctx context.Context
 //   - db DBTX
-//   - accountid pgtype.UUID
-func (_e *MockQuerier_Expecter) GetUserIdentitiesByTeamAccount(ctx interface{}, db interface{}, accountid interface{}) *MockQuerier_GetUserIdentitiesByTeamAccount_Call {
-	return &MockQuerier_GetUserIdentitiesByTeamAccount_Call{Call: _e.mock.On("GetUserIdentitiesByTeamAccount", ctx, db, accountid)}
+//   - userid pgtype.UUID
+func (_e *MockQuerier_Expecter) GetPersonalAccountByUserId(ctx interface{}, db interface{}, userid interface{}) *MockQuerier_GetPersonalAccountByUserId_Call {
+	return &MockQuerier_GetPersonalAccountByUserId_Call{Call: _e.mock.On("GetPersonalAccountByUserId", ctx, db, userid)}
 }
 
-func (_c *MockQuerier_GetUserIdentitiesByTeamAccount_Call) Run(run func(ctx context.Context, db DBTX, accountid pgtype.UUID)) *MockQuerier_GetUserIdentitiesByTeamAccount_Call {
+func (_c *MockQuerier_GetPersonalAccountByUserId_Call) Run(run func(ctx context.Context, db DBTX, userid pgtype.UUID)) *MockQuerier_GetPersonalAccountByUserId_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		run(args[0].(context.Context), args[1].(DBTX), args[2].(pgtype.UUID))
 	})
 	return _c
 }
 
-func (_c *MockQuerier_GetUserIdentitiesByTeamAccount_Call) Return(_a0 []NeosyncApiUserIdentityProviderAssociation, _a1 error) *MockQuerier_GetUserIdentitiesByTeamAccount_Call {
+func (_c *MockQuerier_GetPersonalAccountByUserId_Call) Return(_a0 NeosyncApiAccount, _a1 error) *MockQuerier_GetPersonalAccountByUserId_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockQuerier_GetUserIdentitiesByTeamAccount_Call) RunAndReturn(run func(context.Context, DBTX, pgtype.UUID) ([]NeosyncApiUserIdentityProviderAssociation, error)) *MockQuerier_GetUserIdentitiesByTeamAccount_Call {
+func (_c *MockQuerier_GetPersonalAccountByUserId_Call) RunAndReturn(run func(context.Context, DBTX, pgtype.UUID) (NeosyncApiAccount, error)) *MockQuerier_GetPersonalAccountByUserId_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetUserIdentityAssociationsByUserIds provides a mock function with given fields: ctx, db, dollar_1
-func (_m *MockQuerier) GetUserIdentityAssociationsByUserIds(ctx context.Context, db DBTX, dollar_1 []pgtype.UUID) ([]NeosyncApiUserIdentityProviderAssociation, error) {
-	ret := _m.Called(ctx, db, dollar_1)
+// GetTeamAccountsByUserId provides a mock function with given fields: ctx, db, userid
+func (_m *MockQuerier) GetTeamAccountsByUserId(ctx context.Context, db DBTX, userid pgtype.UUID) ([]NeosyncApiAccount, error) {
+	ret := _m.Called(ctx, db, userid)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetUserIdentityAssociationsByUserIds")
+		panic("no return value specified for GetTeamAccountsByUserId")
 	}
 
-	var r0 []NeosyncApiUserIdentityProviderAssociation
+	var r0 []NeosyncApiAccount
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, DBTX, []pgtype.UUID) ([]NeosyncApiUserIdentityProviderAssociation, error)); ok {
-		return rf(ctx, db, dollar_1)
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX, pgtype.UUID) ([]NeosyncApiAccount, error)); ok {
+		return rf(ctx, db, userid)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, DBTX, []pgtype.UUID) []NeosyncApiUserIdentityProviderAssociation); ok {
-		r0 = rf(ctx, db, dollar_1)
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX, pgtype.UUID) []NeosyncApiAccount); ok {
+		r0 = rf(ctx, db, userid)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]NeosyncApiUserIdentityProviderAssociation)
+			r0 = ret.Get(0).([]NeosyncApiAccount)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, DBTX, []pgtype.UUID) error); ok {
-		r1 = rf(ctx, db, dollar_1)
+	if rf, ok := ret.Get(1).(func(context.Context, DBTX, pgtype.UUID) error); ok {
+		r1 = rf(ctx, db, userid)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -2785,57 +3359,59 @@ func (_m *MockQuerier) GetUserIdentityAssociationsByUserIds(ctx context.Context,
 	return r0, r1
 }
 
-// MockQuerier_GetUserIdentityAssociationsByUserIds_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUserIdentityAssociationsByUserIds'
-type MockQuerier_GetUserIdentityAssociationsByUserIds_Call struct {
+// MockQuerier_GetTeamAccountsByUserId_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetTeamAccountsByUserId'
+type MockQuerier_GetTeamAccountsByUserId_Call struct {
 	*mock.Call
 }
 
-// GetUserIdentityAssociationsByUserIds is a helper method to define mock.On call
+// GetTeamAccountsByUserId is a helper method to define mock.On call
 //   - ctx context.Context
 //   - db DBTX
-//   - dollar_1 []pgtype.UUID
-func (_e *MockQuerier_Expecter) GetUserIdentityAssociationsByUserIds(ctx interface{}, db interface{}, dollar_1 interface{}) *MockQuerier_GetUserIdentityAssociationsByUserIds_Call {
-	return &MockQuerier_GetUserIdentityAssociationsByUserIds_Call{Call: _e.mock.On("GetUserIdentityAssociationsByUserIds", ctx, db, dollar_1)}
-}
-
-func (_c *MockQuerier_GetUserIdentityAssociationsByUserIds_Call) Run(run func(ctx context.Context, db DBTX, dollar_1 []pgtype.UUID)) *MockQuerier_GetUserIdentityAssociationsByUserIds_Call {
+//   - userid pgtype.UUID
+func (_e *MockQuerier_Expecter) GetTeamAccountsByUserId(ctx interface{}, db interface{}, userid interface{}) *MockQuerier_GetTeamAccountsByUserId_Call {
+	return &MockQuerier_GetTeamAccountsByUserId_Call{Call: _e.mock.On("GetTeamAccountsByUserId", ctx, db, userid)}
+}
+
+func (_c *MockQuerier_GetTeamAccountsByUserId_Call) Run(run func(ctx context.Context, db DBTX, userid pgtype.UUID)) *MockQuerier_GetTeamAccountsByUserId_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(DBTX), args[2].([]pgtype.UUID))
+		run(args[0].(context.Context), args[1].(DBTX), args[2].(pgtype.UUID))
 	})
 	return _c
 }
 
-func (_c *MockQuerier_GetUserIdentityAssociationsByUserIds_Call) Return(_a0 []NeosyncApiUserIdentityProviderAssociation, _a1 error) *MockQuerier_GetUserIdentityAssociationsByUserIds_Call {
+func (_c *MockQuerier_GetTeamAccountsByUserId_Call) Return(_a0 []NeosyncApiAccount, _a1 error) *MockQuerier_GetTeamAccountsByUserId_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockQuerier_GetUserIdentityAssociationsByUserIds_Call) RunAndReturn(run func(context.Context, DBTX, []pgtype.UUID) ([]NeosyncApiUserIdentityProviderAssociation, error)) *MockQuerier_GetUserIdentityAssociationsByUserIds_Call {
+func (_c *MockQuerier_GetTeamAccountsByUserId_Call) RunAndReturn(run func(context.Context, DBTX, pgtype.UUID) ([]NeosyncApiAccount, error)) *MockQuerier_GetTeamAccountsByUserId_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetUserIdentityByUserId provides a mock function with given fields: ctx, db, userID
-func (_m *MockQuerier) GetUserIdentityByUserId(ctx context.Context, db DBTX, userID pgtype.UUID) (NeosyncApiUserIdentityProviderAssociation, error) {
-	ret := _m.Called(ctx, db, userID)
+// GetTemporalConfigByAccount provides a mock function with given fields: ctx, db, id
+func (_m *MockQuerier) GetTemporalConfigByAccount(ctx context.Context, db DBTX, id pgtype.UUID) (*pg_models.TemporalConfig, error) {
+	ret := _m.Called(ctx, db, id)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetUserIdentityByUserId")
+		panic("no return value specified for GetTemporalConfigByAccount")
 	}
 
-	var r0 NeosyncApiUserIdentityProviderAssociation
+	var r0 *pg_models.TemporalConfig
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, DBTX, pgtype.UUID) (NeosyncApiUserIdentityProviderAssociation, error)); ok {
-		return rf(ctx, db, userID)
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX, pgtype.UUID) (*pg_models.TemporalConfig, error)); ok {
+		return rf(ctx, db, id)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, DBTX, pgtype.UUID) NeosyncApiUserIdentityProviderAssociation); ok {
-		r0 = rf(ctx, db, userID)
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX, pgtype.UUID) *pg_models.TemporalConfig); ok {
+		r0 = rf(ctx, db, id)
 	} else {
-		r0 = ret.Get(0).(NeosyncApiUserIdentityProviderAssociation)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*pg_models.TemporalConfig)
+		}
 	}
 
 	if rf, ok := ret.Get(1).(func(context.Context, DBTX, pgtype.UUID) error); ok {
-		r1 = rf(ctx, db, userID)
+		r1 = rf(ctx, db, id)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -2843,56 +3419,58 @@ func (_m *MockQuerier) GetUserIdentityByUserId(ctx context.Context, db DBTX, use
 	return r0, r1
 }
 
-// MockQuerier_GetUserIdentityByUserId_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUserIdentityByUserId'
-type MockQuerier_GetUserIdentityByUserId_Call struct {
+// MockQuerier_GetTemporalConfigByAccount_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetTemporalConfigByAccount'
+type MockQuerier_GetTemporalConfigByAccount_Call struct {
 	*mock.Call
 }
 
-// GetUserIdentityByUserId is a helper method to define mock.On call
+// GetTemporalConfigByAccount is a helper method to define mock.On call
 //   - ctx context.Context
 //   - db DBTX
-//   - userID pgtype.UUID
-func (_e *MockQuerier_Expecter) GetUserIdentityByUserId(ctx interface{}, db interface{}, userID interface{}) *MockQuerier_GetUserIdentityByUserId_Call {
-	return &MockQuerier_GetUserIdentityByUserId_Call{Call: _e.mock.On("GetUserIdentityByUserId", ctx, db, userID)}
+//   - id pgtype.UUID
+func (_e *MockQuerier_Expecter) GetTemporalConfigByAccount(ctx interface{}, db interface{}, id interface{}) *MockQuerier_GetTemporalConfigByAccount_Call {
+	return &MockQuerier_GetTemporalConfigByAccount_Call{Call: _e.mock.On("GetTemporalConfigByAccount", ctx, db, id)}
 }
 
-func (_c *MockQuerier_GetUserIdentityByUserId_Call) Run(run func(ctx context.Context, db DBTX, userID pgtype.UUID)) *MockQuerier_GetUserIdentityByUserId_Call {
+func (_c *MockQuerier_GetTemporalConfigByAccount_Call) Run(run func(ctx context.Context, db DBTX, id pgtype.UUID)) *MockQuerier_GetTemporalConfigByAccount_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		run(args[0].(context.Context), args[1].(DBTX), args[2].(pgtype.UUID))
 	})
 	return _c
 }
 
-func (_c *MockQuerier_GetUserIdentityByUserId_Call) Return(_a0 NeosyncApiUserIdentityProviderAssociation, _a1 error) *MockQuerier_GetUserIdentityByUserId_Call {
+func (_c *MockQuerier_GetTemporalConfigByAccount_Call) Return(_a0 *pg_models.TemporalConfig, _a1 error) *MockQuerier_GetTemporalConfigByAccount_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockQuerier_GetUserIdentityByUserId_Call) RunAndReturn(run func(context.Context, DBTX, pgtype.UUID) (NeosyncApiUserIdentityProviderAssociation, error)) *MockQuerier_GetUserIdentityByUserId_Call {
+func (_c *MockQuerier_GetTemporalConfigByAccount_Call) RunAndReturn(run func(context.Context, DBTX, pgtype.UUID) (*pg_models.TemporalConfig, error)) *MockQuerier_GetTemporalConfigByAccount_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// IsConnectionInAccount provides a mock function with given fields: ctx, db, arg
-func (_m *MockQuerier) IsConnectionInAccount(ctx context.Context, db DBTX, arg IsConnectionInAccountParams) (int64, error) {
+// GetTemporalConfigByUserAccount provides a mock function with given fields: ctx, db, arg
+func (_m *MockQuerier) GetTemporalConfigByUserAccount(ctx context.Context, db DBTX, arg GetTemporalConfigByUserAccountParams) (*pg_models.TemporalConfig, error) {
 	ret := _m.Called(ctx, db, arg)
 
 	if len(ret) == 0 {
-		panic("no return value specified for IsConnectionInAccount")
+		panic("no return value specified for GetTemporalConfigByUserAccount")
 	}
 
-	var r0 int64
+	var r0 *pg_models.TemporalConfig
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, DBTX, IsConnectionInAccountParams) (int64, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX, GetTemporalConfigByUserAccountParams) (*pg_models.TemporalConfig, error)); ok {
 		return rf(ctx, db, arg)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, DBTX, IsConnectionInAccountParams) int64); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX, GetTemporalConfigByUserAccountParams) *pg_models.TemporalConfig); ok {
 		r0 = rf(ctx, db, arg)
 	} else {
-		r0 = ret.Get(0).(int64)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*pg_models.TemporalConfig)
+		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, DBTX, IsConnectionInAccountParams) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, DBTX, GetTemporalConfigByUserAccountParams) error); ok {
 		r1 = rf(ctx, db, arg)
 	} else {
 		r1 = ret.Error(1)
@@ -2901,57 +3479,57 @@ func (_m *MockQuerier) IsConnectionInAccount(ctx context.Context, db DBTX, arg I
 	return r0, r1
 }
 
-// MockQuerier_IsConnectionInAccount_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'IsConnectionInAccount'
-type MockQuerier_IsConnectionInAccount_Call struct {
+// MockQuerier_GetTemporalConfigByUserAccount_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetTemporalConfigByUserAccount'
+type MockQuerier_GetTemporalConfigByUserAccount_Call struct {
 	*mock.Call
 }
 
-// IsConnectionInAccount is a helper method to define mock.On call
+// GetTemporalConfigByUserAccount is a helper method to define mock.On call
 //   - ctx context.Context
 //   - db DBTX
-//   - arg IsConnectionInAccountParams
-func (_e *MockQuerier_Expecter) IsConnectionInAccount(ctx interface{}, db interface{}, arg interface{}) *MockQuerier_IsConnectionInAccount_Call {
-	return &MockQuerier_IsConnectionInAccount_Call{Call: _e.mock.On("IsConnectionInAccount", ctx, db, arg)}
+//   - arg GetTemporalConfigByUserAccountParams
+func (_e *MockQuerier_Expecter) GetTemporalConfigByUserAccount(ctx interface{}, db interface{}, arg interface{}) *MockQuerier_GetTemporalConfigByUserAccount_Call {
+	return &MockQuerier_GetTemporalConfigByUserAccount_Call{Call: _e.mock.On("GetTemporalConfigByUserAccount", ctx, db, arg)}
 }
 
-func (_c *MockQuerier_IsConnectionInAccount_Call) Run(run func(ctx context.Context, db DBTX, arg IsConnectionInAccountParams)) *MockQuerier_IsConnectionInAccount_Call {
+func (_c *MockQuerier_GetTemporalConfigByUserAccount_Call) Run(run func(ctx context.Context, db DBTX, arg GetTemporalConfigByUserAccountParams)) *MockQuerier_GetTemporalConfigByUserAccount_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(DBTX), args[2].(IsConnectionInAccountParams))
+		run(args[0].(context.Context), args[1].(DBTX), args[2].(GetTemporalConfigByUserAccountParams))
 	})
 	return _c
 }
 
-func (_c *MockQuerier_IsConnectionInAccount_Call) Return(_a0 int64, _a1 error) *MockQuerier_IsConnectionInAccount_Call {
+func (_c *MockQuerier_GetTemporalConfigByUserAccount_Call) Return(_a0 *pg_models.TemporalConfig, _a1 error) *MockQuerier_GetTemporalConfigByUserAccount_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockQuerier_IsConnectionInAccount_Call) RunAndReturn(run func(context.Context, DBTX, IsConnectionInAccountParams) (int64, error)) *MockQuerier_IsConnectionInAccount_Call {
+func (_c *MockQuerier_GetTemporalConfigByUserAccount_Call) RunAndReturn(run func(context.Context, DBTX, GetTemporalConfigByUserAccountParams) (*pg_models.TemporalConfig, error)) *MockQuerier_GetTemporalConfigByUserAccount_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// IsConnectionNameAvailable provides a mock function with given fields: ctx, db, arg
-func (_m *MockQuerier) IsConnectionNameAvailable(ctx context.Context, db DBTX, arg IsConnectionNameAvailableParams) (int64, error) {
-	ret := _m.Called(ctx, db, arg)
+// GetUser provides a mock function with given fields: ctx, db, id
+func (_m *MockQuerier) GetUser(ctx context.Context, db DBTX, id pgtype.UUID) (NeosyncApiUser, error) {
+	ret := _m.Called(ctx, db, id)
 
 	if len(ret) == 0 {
-		panic("no return value specified for IsConnectionNameAvailable")
+		panic("no return value specified for GetUser")
 	}
 
-	var r0 int64
+	var r0 NeosyncApiUser
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, DBTX, IsConnectionNameAvailableParams) (int64, error)); ok {
-		return rf(ctx, db, arg)
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX, pgtype.UUID) (NeosyncApiUser, error)); ok {
+		return rf(ctx, db, id)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, DBTX, IsConnectionNameAvailableParams) int64); ok {
-		r0 = rf(ctx, db, arg)
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX, pgtype.UUID) NeosyncApiUser); ok {
+		r0 = rf(ctx, db, id)
 	} else {
-		r0 = ret.Get(0).(int64)
+		r0 = ret.Get(0).(NeosyncApiUser)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, DBTX, IsConnectionNameAvailableParams) error); ok {
-		r1 = rf(ctx, db, arg)
+	if rf, ok := ret.Get(1).(func(context.Context, DBTX, pgtype.UUID) error); ok {
+		r1 = rf(ctx, db, id)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -2959,57 +3537,57 @@ func (_m *MockQuerier) IsConnectionNameAvailable(ctx context.Context, db DBTX, a
 	return r0, r1
 }
 
-// MockQuerier_IsConnectionNameAvailable_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'IsConnectionNameAvailable'
-type MockQuerier_IsConnectionNameAvailable_Call struct {
+// MockQuerier_GetUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUser'
+type MockQuerier_GetUser_Call struct {
 	*mock.Call
 }
 
-// IsConnectionNameAvailable is a helper method to define mock.On call
+// GetUser is a helper method to define mock.On call
 //   - ctx context.Context
 //   - db DBTX
-//   - arg IsConnectionNameAvailableParams
-func (_e *MockQuerier_Expecter) IsConnectionNameAvailable(ctx interface{}, db interface{}, arg interface{}) *MockQuerier_IsConnectionNameAvailable_Call {
-	return &MockQuerier_IsConnectionNameAvailable_Call{Call: _e.mock.On("IsConnectionNameAvailable", ctx, db, arg)}
+//   - id pgtype.UUID
+func (_e *MockQuerier_Expecter) GetUser(ctx interface{}, db interface{}, id interface{}) *MockQuerier_GetUser_Call {
+	return &MockQuerier_GetUser_Call{Call: _e.mock.On("GetUser", ctx, db, id)}
 }
 
-func (_c *MockQuerier_IsConnectionNameAvailable_Call) Run(run func(ctx context.Context, db DBTX, arg IsConnectionNameAvailableParams)) *MockQuerier_IsConnectionNameAvailable_Call {
+func (_c *MockQuerier_GetUser_Call) Run(run func(ctx context.Context, db DBTX, id pgtype.UUID)) *MockQuerier_GetUser_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(DBTX), args[2].(IsConnectionNameAvailableParams))
+		run(args[0].(context.Context), args[1].(DBTX), args[2].(pgtype.UUID))
 	})
 	return _c
 }
 
-func (_c *MockQuerier_IsConnectionNameAvailable_Call) Return(_a0 int64, _a1 error) *MockQuerier_IsConnectionNameAvailable_Call {
+func (_c *MockQuerier_GetUser_Call) Return(_a0 NeosyncApiUser, _a1 error) *MockQuerier_GetUser_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockQuerier_IsConnectionNameAvailable_Call) RunAndReturn(run func(context.Context, DBTX, IsConnectionNameAvailableParams) (int64, error)) *MockQuerier_IsConnectionNameAvailable_Call {
+func (_c *MockQuerier_GetUser_Call) RunAndReturn(run func(context.Context, DBTX, pgtype.UUID) (NeosyncApiUser, error)) *MockQuerier_GetUser_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// IsJobNameAvailable provides a mock function with given fields: ctx, db, arg
-func (_m *MockQuerier) IsJobNameAvailable(ctx context.Context, db DBTX, arg IsJobNameAvailableParams) (int64, error) {
-	ret := _m.Called(ctx, db, arg)
+// GetUserAssociationByProviderSub provides a mock function with given fields: ctx, db, providerSub
+func (_m *MockQuerier) GetUserAssociationByProviderSub(ctx context.Context, db DBTX, providerSub string) (NeosyncApiUserIdentityProviderAssociation, error) {
+	ret := _m.Called(ctx, db, providerSub)
 
 	if len(ret) == 0 {
-		panic("no return value specified for IsJobNameAvailable")
+		panic("no return value specified for GetUserAssociationByProviderSub")
 	}
 
-	var r0 int64
+	var r0 NeosyncApiUserIdentityProviderAssociation
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, DBTX, IsJobNameAvailableParams) (int64, error)); ok {
-		return rf(ctx, db, arg)
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX, string) (NeosyncApiUserIdentityProviderAssociation, error)); ok {
+		return rf(ctx, db, providerSub)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, DBTX, IsJobNameAvailableParams) int64); ok {
-		r0 = rf(ctx, db, arg)
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX, string) NeosyncApiUserIdentityProviderAssociation); ok {
+		r0 = rf(ctx, db, providerSub)
 	} else {
-		r0 = ret.Get(0).(int64)
+		r0 = ret.Get(0).(NeosyncApiUserIdentityProviderAssociation)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, DBTX, IsJobNameAvailableParams) error); ok {
-		r1 = rf(ctx, db, arg)
+	if rf, ok := ret.Get(1).(func(context.Context, DBTX, string) error); ok {
+		r1 = rf(ctx, db, providerSub)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -3017,57 +3595,57 @@ func (_m *MockQuerier) IsJobNameAvailable(ctx context.Context, db DBTX, arg IsJo
 	return r0, r1
 }
 
-// MockQuerier_IsJobNameAvailable_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'IsJobNameAvailable'
-type MockQuerier_IsJobNameAvailable_Call struct {
+// MockQuerier_GetUserAssociationByProviderSub_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUserAssociationByProviderSub'
+type MockQuerier_GetUserAssociationByProviderSub_Call struct {
 	*mock.Call
 }
 
-// IsJobNameAvailable is a helper method to define mock.On call
+// GetUserAssociationByProviderSub is a helper method to define mock.On call
 //   - ctx context.Context
 //   - db DBTX
-//   - arg IsJobNameAvailableParams
-func (_e *MockQuerier_Expecter) IsJobNameAvailable(ctx interface{}, db interface{}, arg interface{}) *MockQuerier_IsJobNameAvailable_Call {
-	return &MockQuerier_IsJobNameAvailable_Call{Call: _e.mock.On("IsJobNameAvailable", ctx, db, arg)}
+//   - providerSub string
+func (_e *MockQuerier_Expecter) GetUserAssociationByProviderSub(ctx interface{}, db interface{}, providerSub interface{}) *MockQuerier_GetUserAssociationByProviderSub_Call {
+	return &MockQuerier_GetUserAssociationByProviderSub_Call{Call: _e.mock.On("GetUserAssociationByProviderSub", ctx, db, providerSub)}
 }
 
-func (_c *MockQuerier_IsJobNameAvailable_Call) Run(run func(ctx context.Context, db DBTX, arg IsJobNameAvailableParams)) *MockQuerier_IsJobNameAvailable_Call {
+func (_c *MockQuerier_GetUserAssociationByProviderSub_Call) Run(run func(ctx context.Context, db DBTX, providerSub string)) *MockQuerier_GetUserAssociationByProviderSub_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(DBTX), args[2].(IsJobNameAvailableParams))
+		run(args[0].(context.Context), args[1].(DBTX), args[2].(string))
 	})
 	return _c
 }
 
-func (_c *MockQuerier_IsJobNameAvailable_Call) Return(_a0 int64, _a1 error) *MockQuerier_IsJobNameAvailable_Call {
+func (_c *MockQuerier_GetUserAssociationByProviderSub_Call) Return(_a0 NeosyncApiUserIdentityProviderAssociation, _a1 error) *MockQuerier_GetUserAssociationByProviderSub_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockQuerier_IsJobNameAvailable_Call) RunAndReturn(run func(context.Context, DBTX, IsJobNameAvailableParams) (int64, error)) *MockQuerier_IsJobNameAvailable_Call {
+func (_c *MockQuerier_GetUserAssociationByProviderSub_Call) RunAndReturn(run func(context.Context, DBTX, string) (NeosyncApiUserIdentityProviderAssociation, error)) *MockQuerier_GetUserAssociationByProviderSub_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// IsTransformerNameAvailable provides a mock function with given fields: ctx, db, arg
-func (_m *MockQuerier) IsTransformerNameAvailable(ctx context.Context, db DBTX, arg IsTransformerNameAvailableParams) (int64, error) {
-	ret := _m.Called(ctx, db, arg)
+// GetUserByProviderSub provides a mock function with given fields: ctx, db, providerSub
+func (_m *MockQuerier) GetUserByProviderSub(ctx context.Context, db DBTX, providerSub string) (NeosyncApiUser, error) {
+	ret := _m.Called(ctx, db, providerSub)
 
 	if len(ret) == 0 {
-		panic("no return value specified for IsTransformerNameAvailable")
+		panic("no return value specified for GetUserByProviderSub")
 	}
 
-	var r0 int64
+	var r0 NeosyncApiUser
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, DBTX, IsTransformerNameAvailableParams) (int64, error)); ok {
-		return rf(ctx, db, arg)
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX, string) (NeosyncApiUser, error)); ok {
+		return rf(ctx, db, providerSub)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, DBTX, IsTransformerNameAvailableParams) int64); ok {
-		r0 = rf(ctx, db, arg)
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX, string) NeosyncApiUser); ok {
+		r0 = rf(ctx, db, providerSub)
 	} else {
-		r0 = ret.Get(0).(int64)
+		r0 = ret.Get(0).(NeosyncApiUser)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, DBTX, IsTransformerNameAvailableParams) error); ok {
-		r1 = rf(ctx, db, arg)
+	if rf, ok := ret.Get(1).(func(context.Context, DBTX, string) error); ok {
+		r1 = rf(ctx, db, providerSub)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -3075,57 +3653,57 @@ func (_m *MockQuerier) IsTransformerNameAvailable(ctx context.Context, db DBTX,
 	return r0, r1
 }
 
-// MockQuerier_IsTransformerNameAvailable_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'IsTransformerNameAvailable'
-type MockQuerier_IsTransformerNameAvailable_Call struct {
+// MockQuerier_GetUserByProviderSub_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUserByProviderSub'
+type MockQuerier_GetUserByProviderSub_Call struct {
 	*mock.Call
 }
 
-// IsTransformerNameAvailable is a helper method to define mock.On call
+// GetUserByProviderSub is a helper method to define mock.On call
 //   - ctx context.Context
 //   - db DBTX
-//   - arg IsTransformerNameAvailableParams
-func (_e *MockQuerier_Expecter) IsTransformerNameAvailable(ctx interface{}, db interface{}, arg interface{}) *MockQuerier_IsTransformerNameAvailable_Call {
-	return &MockQuerier_IsTransformerNameAvailable_Call{Call: _e.mock.On("IsTransformerNameAvailable", ctx, db, arg)}
+//   - providerSub string
+func (_e *MockQuerier_Expecter) GetUserByProviderSub(ctx interface{}, db interface{}, providerSub interface{}) *MockQuerier_GetUserByProviderSub_Call {
+	return &MockQuerier_GetUserByProviderSub_Call{Call: _e.mock.On("GetUserByProviderSub", ctx, db, providerSub)}
 }
 
-func (_c *MockQuerier_IsTransformerNameAvailable_Call) Run(run func(ctx context.Context, db DBTX, arg IsTransformerNameAvailableParams)) *MockQuerier_IsTransformerNameAvailable_Call {
+func (_c *MockQuerier_GetUserByProviderSub_Call) Run(run func(ctx context.Context, db DBTX, providerSub string)) *MockQuerier_GetUserByProviderSub_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(DBTX), args[2].(IsTransformerNameAvailableParams))
+		run(args[0].(context.Context), args[1].(DBTX), args[2].(string))
 	})
 	return _c
 }
 
-func (_c *MockQuerier_IsTransformerNameAvailable_Call) Return(_a0 int64, _a1 error) *MockQuerier_IsTransformerNameAvailable_Call {
+func (_c *MockQuerier_GetUserByProviderSub_Call) Return(_a0 NeosyncApiUser, _a1 error) *MockQuerier_GetUserByProviderSub_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockQuerier_IsTransformerNameAvailable_Call) RunAndReturn(run func(context.Context, DBTX, IsTransformerNameAvailableParams) (int64, error)) *MockQuerier_IsTransformerNameAvailable_Call {
+func (_c *MockQuerier_GetUserByProviderSub_Call) RunAndReturn(run func(context.Context, DBTX, string) (NeosyncApiUser, error)) *MockQuerier_GetUserByProviderSub_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// IsUserInAccount provides a mock function with given fields: ctx, db, arg
-func (_m *MockQuerier) IsUserInAccount(ctx context.Context, db DBTX, arg IsUserInAccountParams) (int64, error) {
-	ret := _m.Called(ctx, db, arg)
+// GetUserDefinedTransformerById provides a mock function with given fields: ctx, db, id
+func (_m *MockQuerier) GetUserDefinedTransformerById(ctx context.Context, db DBTX, id pgtype.UUID) (NeosyncApiTransformer, error) {
+	ret := _m.Called(ctx, db, id)
 
 	if len(ret) == 0 {
-		panic("no return value specified for IsUserInAccount")
+		panic("no return value specified for GetUserDefinedTransformerById")
 	}
 
-	var r0 int64
+	var r0 NeosyncApiTransformer
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, DBTX, IsUserInAccountParams) (int64, error)); ok {
-		return rf(ctx, db, arg)
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX, pgtype.UUID) (NeosyncApiTransformer, error)); ok {
+		return rf(ctx, db, id)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, DBTX, IsUserInAccountParams) int64); ok {
-		r0 = rf(ctx, db, arg)
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX, pgtype.UUID) NeosyncApiTransformer); ok {
+		r0 = rf(ctx, db, id)
 	} else {
-		r0 = ret.Get(0).(int64)
+		r0 = ret.Get(0).(NeosyncApiTransformer)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, DBTX, IsUserInAccountParams) error); ok {
-		r1 = rf(ctx, db, arg)
+	if rf, ok := ret.Get(1).(func(context.Context, DBTX, pgtype.UUID) error); ok {
+		r1 = rf(ctx, db, id)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -3133,105 +3711,1205 @@ func (_m *MockQuerier) IsUserInAccount(ctx context.Context, db DBTX, arg IsUserI
 	return r0, r1
 }
 
-// MockQuerier_IsUserInAccount_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'IsUserInAccount'
-type MockQuerier_IsUserInAccount_Call struct {
+// MockQuerier_GetUserDefinedTransformerById_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUserDefinedTransformerById'
+type MockQuerier_GetUserDefinedTransformerById_Call struct {
 	*mock.Call
 }
 
-// IsUserInAccount is a helper method to define mock.On call
+// GetUserDefinedTransformerById is a helper method to define mock.On call
 //   - ctx context.Context
 //   - db DBTX
-//   - arg IsUserInAccountParams
+//   - id pgtype.UUID
+func (_e *MockQuerier_Expecter) GetUserDefinedTransformerById(ctx interface{}, db interface{}, id interface{}) *MockQuerier_GetUserDefinedTransformerById_Call {
+	return &MockQuerier_GetUserDefinedTransformerById_Call{Call: _e.mock.On("GetUserDefinedTransformerById", ctx, db, id)}
+}
+
+func (_c *MockQuerier_GetUserDefinedTransformerById_Call) Run(run func(ctx context.Context, db DBTX, id pgtype.UUID)) *MockQuerier_GetUserDefinedTransformerById_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(DBTX), args[2].(pgtype.UUID))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_GetUserDefinedTransformerById_Call) Return(_a0 NeosyncApiTransformer, _a1 error) *MockQuerier_GetUserDefinedTransformerById_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQuerier_GetUserDefinedTransformerById_Call) RunAndReturn(run func(context.Context, DBTX, pgtype.UUID) (NeosyncApiTransformer, error)) *MockQuerier_GetUserDefinedTransformerById_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetUserDefinedTransformersByAccount provides a mock function with given fields: ctx, db, accountid
+func (_m *MockQuerier) GetUserDefinedTransformersByAccount(ctx context.Context, db DBTX, accountid pgtype.UUID) ([]NeosyncApiTransformer, error) {
+	ret := _m.Called(ctx, db, accountid)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetUserDefinedTransformersByAccount")
+	}
+
+	var r0 []NeosyncApiTransformer
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX, pgtype.UUID) ([]NeosyncApiTransformer, error)); ok {
+		return rf(ctx, db, accountid)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX, pgtype.UUID) []NeosyncApiTransformer); ok {
+		r0 = rf(ctx, db, accountid)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]NeosyncApiTransformer)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, DBTX, pgtype.UUID) error); ok {
+		r1 = rf(ctx, db, accountid)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQuerier_GetUserDefinedTransformersByAccount_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUserDefinedTransformersByAccount'
+type MockQuerier_GetUserDefinedTransformersByAccount_Call struct {
+	*mock.Call
+}
+
+// GetUserDefinedTransformersByAccount is a helper method to define mock.On call
+//   - ctx context.Context
+//   - db DBTX
+//   - accountid pgtype.UUID
+func (_e *MockQuerier_Expecter) GetUserDefinedTransformersByAccount(ctx interface{}, db interface{}, accountid interface{}) *MockQuerier_GetUserDefinedTransformersByAccount_Call {
+	return &MockQuerier_GetUserDefinedTransformersByAccount_Call{Call: _e.mock.On("GetUserDefinedTransformersByAccount", ctx, db, accountid)}
+}
+
+func (_c *MockQuerier_GetUserDefinedTransformersByAccount_Call) Run(run func(ctx context.Context, db DBTX, accountid pgtype.UUID)) *MockQuerier_GetUserDefinedTransformersByAccount_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(DBTX), args[2].(pgtype.UUID))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_GetUserDefinedTransformersByAccount_Call) Return(_a0 []NeosyncApiTransformer, _a1 error) *MockQuerier_GetUserDefinedTransformersByAccount_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQuerier_GetUserDefinedTransformersByAccount_Call) RunAndReturn(run func(context.Context, DBTX, pgtype.UUID) ([]NeosyncApiTransformer, error)) *MockQuerier_GetUserDefinedTransformersByAccount_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetUserIdentitiesByTeamAccount provides a mock function with given fields: ctx, db, accountid
+func (_m *MockQuerier) GetUserIdentitiesByTeamAccount(ctx context.Context, db DBTX, accountid pgtype.UUID) ([]GetUserIdentitiesByTeamAccountRow, error) {
+	ret := _m.Called(ctx, db, accountid)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetUserIdentitiesByTeamAccount")
+	}
+
+	var r0 []GetUserIdentitiesByTeamAccountRow
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX, pgtype.UUID) ([]GetUserIdentitiesByTeamAccountRow, error)); ok {
+		return rf(ctx, db, accountid)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX, pgtype.UUID) []GetUserIdentitiesByTeamAccountRow); ok {
+		r0 = rf(ctx, db, accountid)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]GetUserIdentitiesByTeamAccountRow)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, DBTX, pgtype.UUID) error); ok {
+		r1 = rf(ctx, db, accountid)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQuerier_GetUserIdentitiesByTeamAccount_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUserIdentitiesByTeamAccount'
+type MockQuerier_GetUserIdentitiesByTeamAccount_Call struct {
+	*mock.Call
+}
+
+// GetUserIdentitiesByTeamAccount is a helper method to define mock.On call
+//   - ctx context.Context
+//   - db DBTX
+//   - accountid pgtype.UUID
+func (_e *MockQuerier_Expecter) GetUserIdentitiesByTeamAccount(ctx interface{}, db interface{}, accountid interface{}) *MockQuerier_GetUserIdentitiesByTeamAccount_Call {
+	return &MockQuerier_GetUserIdentitiesByTeamAccount_Call{Call: _e.mock.On("GetUserIdentitiesByTeamAccount", ctx, db, accountid)}
+}
+
+func (_c *MockQuerier_GetUserIdentitiesByTeamAccount_Call) Run(run func(ctx context.Context, db DBTX, accountid pgtype.UUID)) *MockQuerier_GetUserIdentitiesByTeamAccount_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(DBTX), args[2].(pgtype.UUID))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_GetUserIdentitiesByTeamAccount_Call) Return(_a0 []GetUserIdentitiesByTeamAccountRow, _a1 error) *MockQuerier_GetUserIdentitiesByTeamAccount_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQuerier_GetUserIdentitiesByTeamAccount_Call) RunAndReturn(run func(context.Context, DBTX, pgtype.UUID) ([]GetUserIdentitiesByTeamAccountRow, error)) *MockQuerier_GetUserIdentitiesByTeamAccount_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetUserIdentityAssociationsByUserIds provides a mock function with given fields: ctx, db, dollar_1
+func (_m *MockQuerier) GetUserIdentityAssociationsByUserIds(ctx context.Context, db DBTX, dollar_1 []pgtype.UUID) ([]NeosyncApiUserIdentityProviderAssociation, error) {
+	ret := _m.Called(ctx, db, dollar_1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetUserIdentityAssociationsByUserIds")
+	}
+
+	var r0 []NeosyncApiUserIdentityProviderAssociation
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX, []pgtype.UUID) ([]NeosyncApiUserIdentityProviderAssociation, error)); ok {
+		return rf(ctx, db, dollar_1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX, []pgtype.UUID) []NeosyncApiUserIdentityProviderAssociation); ok {
+		r0 = rf(ctx, db, dollar_1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]NeosyncApiUserIdentityProviderAssociation)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, DBTX, []pgtype.UUID) error); ok {
+		r1 = rf(ctx, db, dollar_1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQuerier_GetUserIdentityAssociationsByUserIds_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUserIdentityAssociationsByUserIds'
+type MockQuerier_GetUserIdentityAssociationsByUserIds_Call struct {
+	*mock.Call
+}
+
+// GetUserIdentityAssociationsByUserIds is a helper method to define mock.On call
+//   - ctx context.Context
+//   - db DBTX
+//   - dollar_1 []pgtype.UUID
+func (_e *MockQuerier_Expecter) GetUserIdentityAssociationsByUserIds(ctx interface{}, db interface{}, dollar_1 interface{}) *MockQuerier_GetUserIdentityAssociationsByUserIds_Call {
+	return &MockQuerier_GetUserIdentityAssociationsByUserIds_Call{Call: _e.mock.On("GetUserIdentityAssociationsByUserIds", ctx, db, dollar_1)}
+}
+
+func (_c *MockQuerier_GetUserIdentityAssociationsByUserIds_Call) Run(run func(ctx context.Context, db DBTX, dollar_1 []pgtype.UUID)) *MockQuerier_GetUserIdentityAssociationsByUserIds_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(DBTX), args[2].([]pgtype.UUID))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_GetUserIdentityAssociationsByUserIds_Call) Return(_a0 []NeosyncApiUserIdentityProviderAssociation, _a1 error) *MockQuerier_GetUserIdentityAssociationsByUserIds_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQuerier_GetUserIdentityAssociationsByUserIds_Call) RunAndReturn(run func(context.Context, DBTX, []pgtype.UUID) ([]NeosyncApiUserIdentityProviderAssociation, error)) *MockQuerier_GetUserIdentityAssociationsByUserIds_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetUserIdentityByUserId provides a mock function with given fields: ctx, db, userID
+func (_m *MockQuerier) GetUserIdentityByUserId(ctx context.Context, db DBTX, userID pgtype.UUID) (NeosyncApiUserIdentityProviderAssociation, error) {
+	ret := _m.Called(ctx, db, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetUserIdentityByUserId")
+	}
+
+	var r0 NeosyncApiUserIdentityProviderAssociation
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX, pgtype.UUID) (NeosyncApiUserIdentityProviderAssociation, error)); ok {
+		return rf(ctx, db, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX, pgtype.UUID) NeosyncApiUserIdentityProviderAssociation); ok {
+		r0 = rf(ctx, db, userID)
+	} else {
+		r0 = ret.Get(0).(NeosyncApiUserIdentityProviderAssociation)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, DBTX, pgtype.UUID) error); ok {
+		r1 = rf(ctx, db, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQuerier_GetUserIdentityByUserId_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUserIdentityByUserId'
+type MockQuerier_GetUserIdentityByUserId_Call struct {
+	*mock.Call
+}
+
+// GetUserIdentityByUserId is a helper method to define mock.On call
+//   - ctx context.Context
+//   - db DBTX
+//   - userID pgtype.UUID
+func (_e *MockQuerier_Expecter) GetUserIdentityByUserId(ctx interface{}, db interface{}, userID interface{}) *MockQuerier_GetUserIdentityByUserId_Call {
+	return &MockQuerier_GetUserIdentityByUserId_Call{Call: _e.mock.On("GetUserIdentityByUserId", ctx, db, userID)}
+}
+
+func (_c *MockQuerier_GetUserIdentityByUserId_Call) Run(run func(ctx context.Context, db DBTX, userID pgtype.UUID)) *MockQuerier_GetUserIdentityByUserId_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(DBTX), args[2].(pgtype.UUID))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_GetUserIdentityByUserId_Call) Return(_a0 NeosyncApiUserIdentityProviderAssociation, _a1 error) *MockQuerier_GetUserIdentityByUserId_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQuerier_GetUserIdentityByUserId_Call) RunAndReturn(run func(context.Context, DBTX, pgtype.UUID) (NeosyncApiUserIdentityProviderAssociation, error)) *MockQuerier_GetUserIdentityByUserId_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetWebhookById provides a mock function with given fields: ctx, db, id
+func (_m *MockQuerier) GetWebhookById(ctx context.Context, db DBTX, id pgtype.UUID) (NeosyncApiWebhook, error) {
+	ret := _m.Called(ctx, db, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetWebhookById")
+	}
+
+	var r0 NeosyncApiWebhook
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX, pgtype.UUID) (NeosyncApiWebhook, error)); ok {
+		return rf(ctx, db, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX, pgtype.UUID) NeosyncApiWebhook); ok {
+		r0 = rf(ctx, db, id)
+	} else {
+		r0 = ret.Get(0).(NeosyncApiWebhook)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, DBTX, pgtype.UUID) error); ok {
+		r1 = rf(ctx, db, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQuerier_GetWebhookById_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetWebhookById'
+type MockQuerier_GetWebhookById_Call struct {
+	*mock.Call
+}
+
+// GetWebhookById is a helper method to define mock.On call
+//   - ctx context.Context
+//   - db DBTX
+//   - id pgtype.UUID
+func (_e *MockQuerier_Expecter) GetWebhookById(ctx interface{}, db interface{}, id interface{}) *MockQuerier_GetWebhookById_Call {
+	return &MockQuerier_GetWebhookById_Call{Call: _e.mock.On("GetWebhookById", ctx, db, id)}
+}
+
+func (_c *MockQuerier_GetWebhookById_Call) Run(run func(ctx context.Context, db DBTX, id pgtype.UUID)) *MockQuerier_GetWebhookById_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(DBTX), args[2].(pgtype.UUID))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_GetWebhookById_Call) Return(_a0 NeosyncApiWebhook, _a1 error) *MockQuerier_GetWebhookById_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQuerier_GetWebhookById_Call) RunAndReturn(run func(context.Context, DBTX, pgtype.UUID) (NeosyncApiWebhook, error)) *MockQuerier_GetWebhookById_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetWebhookDeliveries provides a mock function with given fields: ctx, db, arg
+func (_m *MockQuerier) GetWebhookDeliveries(ctx context.Context, db DBTX, arg GetWebhookDeliveriesParams) ([]NeosyncApiWebhookDelivery, error) {
+	ret := _m.Called(ctx, db, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetWebhookDeliveries")
+	}
+
+	var r0 []NeosyncApiWebhookDelivery
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX, GetWebhookDeliveriesParams) ([]NeosyncApiWebhookDelivery, error)); ok {
+		return rf(ctx, db, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX, GetWebhookDeliveriesParams) []NeosyncApiWebhookDelivery); ok {
+		r0 = rf(ctx, db, arg)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]NeosyncApiWebhookDelivery)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, DBTX, GetWebhookDeliveriesParams) error); ok {
+		r1 = rf(ctx, db, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQuerier_GetWebhookDeliveries_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetWebhookDeliveries'
+type MockQuerier_GetWebhookDeliveries_Call struct {
+	*mock.Call
+}
+
+// GetWebhookDeliveries is a helper method to define mock.On call
+//   - ctx context.Context
+//   - db DBTX
+//   - arg GetWebhookDeliveriesParams
+func (_e *MockQuerier_Expecter) GetWebhookDeliveries(ctx interface{}, db interface{}, arg interface{}) *MockQuerier_GetWebhookDeliveries_Call {
+	return &MockQuerier_GetWebhookDeliveries_Call{Call: _e.mock.On("GetWebhookDeliveries", ctx, db, arg)}
+}
+
+func (_c *MockQuerier_GetWebhookDeliveries_Call) Run(run func(ctx context.Context, db DBTX, arg GetWebhookDeliveriesParams)) *MockQuerier_GetWebhookDeliveries_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(DBTX), args[2].(GetWebhookDeliveriesParams))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_GetWebhookDeliveries_Call) Return(_a0 []NeosyncApiWebhookDelivery, _a1 error) *MockQuerier_GetWebhookDeliveries_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQuerier_GetWebhookDeliveries_Call) RunAndReturn(run func(context.Context, DBTX, GetWebhookDeliveriesParams) ([]NeosyncApiWebhookDelivery, error)) *MockQuerier_GetWebhookDeliveries_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetWebhooksByAccount provides a mock function with given fields: ctx, db, accountID
+func (_m *MockQuerier) GetWebhooksByAccount(ctx context.Context, db DBTX, accountID pgtype.UUID) ([]NeosyncApiWebhook, error) {
+	ret := _m.Called(ctx, db, accountID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetWebhooksByAccount")
+	}
+
+	var r0 []NeosyncApiWebhook
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX, pgtype.UUID) ([]NeosyncApiWebhook, error)); ok {
+		return rf(ctx, db, accountID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX, pgtype.UUID) []NeosyncApiWebhook); ok {
+		r0 = rf(ctx, db, accountID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]NeosyncApiWebhook)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, DBTX, pgtype.UUID) error); ok {
+		r1 = rf(ctx, db, accountID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQuerier_GetWebhooksByAccount_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetWebhooksByAccount'
+type MockQuerier_GetWebhooksByAccount_Call struct {
+	*mock.Call
+}
+
+// GetWebhooksByAccount is a helper method to define mock.On call
+//   - ctx context.Context
+//   - db DBTX
+//   - accountID pgtype.UUID
+func (_e *MockQuerier_Expecter) GetWebhooksByAccount(ctx interface{}, db interface{}, accountID interface{}) *MockQuerier_GetWebhooksByAccount_Call {
+	return &MockQuerier_GetWebhooksByAccount_Call{Call: _e.mock.On("GetWebhooksByAccount", ctx, db, accountID)}
+}
+
+func (_c *MockQuerier_GetWebhooksByAccount_Call) Run(run func(ctx context.Context, db DBTX, accountID pgtype.UUID)) *MockQuerier_GetWebhooksByAccount_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(DBTX), args[2].(pgtype.UUID))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_GetWebhooksByAccount_Call) Return(_a0 []NeosyncApiWebhook, _a1 error) *MockQuerier_GetWebhooksByAccount_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQuerier_GetWebhooksByAccount_Call) RunAndReturn(run func(context.Context, DBTX, pgtype.UUID) ([]NeosyncApiWebhook, error)) *MockQuerier_GetWebhooksByAccount_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// InitAccountTransformerKey provides a mock function with given fields: ctx, db, arg
+func (_m *MockQuerier) InitAccountTransformerKey(ctx context.Context, db DBTX, arg InitAccountTransformerKeyParams) (pgtype.Text, error) {
+	ret := _m.Called(ctx, db, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for InitAccountTransformerKey")
+	}
+
+	var r0 pgtype.Text
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX, InitAccountTransformerKeyParams) (pgtype.Text, error)); ok {
+		return rf(ctx, db, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX, InitAccountTransformerKeyParams) pgtype.Text); ok {
+		r0 = rf(ctx, db, arg)
+	} else {
+		r0 = ret.Get(0).(pgtype.Text)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, DBTX, InitAccountTransformerKeyParams) error); ok {
+		r1 = rf(ctx, db, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQuerier_InitAccountTransformerKey_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'InitAccountTransformerKey'
+type MockQuerier_InitAccountTransformerKey_Call struct {
+	*mock.Call
+}
+
+// InitAccountTransformerKey is a helper method to define mock.On call
+//   - ctx context.Context
+//   - db DBTX
+//   - arg InitAccountTransformerKeyParams
+func (_e *MockQuerier_Expecter) InitAccountTransformerKey(ctx interface{}, db interface{}, arg interface{}) *MockQuerier_InitAccountTransformerKey_Call {
+	return &MockQuerier_InitAccountTransformerKey_Call{Call: _e.mock.On("InitAccountTransformerKey", ctx, db, arg)}
+}
+
+func (_c *MockQuerier_InitAccountTransformerKey_Call) Run(run func(ctx context.Context, db DBTX, arg InitAccountTransformerKeyParams)) *MockQuerier_InitAccountTransformerKey_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(DBTX), args[2].(InitAccountTransformerKeyParams))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_InitAccountTransformerKey_Call) Return(_a0 pgtype.Text, _a1 error) *MockQuerier_InitAccountTransformerKey_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQuerier_InitAccountTransformerKey_Call) RunAndReturn(run func(context.Context, DBTX, InitAccountTransformerKeyParams) (pgtype.Text, error)) *MockQuerier_InitAccountTransformerKey_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// IsConnectionInAccount provides a mock function with given fields: ctx, db, arg
+func (_m *MockQuerier) IsConnectionInAccount(ctx context.Context, db DBTX, arg IsConnectionInAccountParams) (int64, error) {
+	ret := _m.Called(ctx, db, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for IsConnectionInAccount")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX, IsConnectionInAccountParams) (int64, error)); ok {
+		return rf(ctx, db, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX, IsConnectionInAccountParams) int64); ok {
+		r0 = rf(ctx, db, arg)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, DBTX, IsConnectionInAccountParams) error); ok {
+		r1 = rf(ctx, db, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQuerier_IsConnectionInAccount_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'IsConnectionInAccount'
+type MockQuerier_IsConnectionInAccount_Call struct {
+	*mock.Call
+}
+
+// IsConnectionInAccount is a helper method to define mock.On call
+//   - ctx context.Context
+//   - db DBTX
+//   - arg IsConnectionInAccountParams
+func (_e *MockQuerier_Expecter) IsConnectionInAccount(ctx interface{}, db interface{}, arg interface{}) *MockQuerier_IsConnectionInAccount_Call {
+	return &MockQuerier_IsConnectionInAccount_Call{Call: _e.mock.On("IsConnectionInAccount", ctx, db, arg)}
+}
+
+func (_c *MockQuerier_IsConnectionInAccount_Call) Run(run func(ctx context.Context, db DBTX, arg IsConnectionInAccountParams)) *MockQuerier_IsConnectionInAccount_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(DBTX), args[2].(IsConnectionInAccountParams))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_IsConnectionInAccount_Call) Return(_a0 int64, _a1 error) *MockQuerier_IsConnectionInAccount_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQuerier_IsConnectionInAccount_Call) RunAndReturn(run func(context.Context, DBTX, IsConnectionInAccountParams) (int64, error)) *MockQuerier_IsConnectionInAccount_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// IsConnectionNameAvailable provides a mock function with given fields: ctx, db, arg
+func (_m *MockQuerier) IsConnectionNameAvailable(ctx context.Context, db DBTX, arg IsConnectionNameAvailableParams) (int64, error) {
+	ret := _m.Called(ctx, db, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for IsConnectionNameAvailable")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX, IsConnectionNameAvailableParams) (int64, error)); ok {
+		return rf(ctx, db, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX, IsConnectionNameAvailableParams) int64); ok {
+		r0 = rf(ctx, db, arg)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, DBTX, IsConnectionNameAvailableParams) error); ok {
+		r1 = rf(ctx, db, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQuerier_IsConnectionNameAvailable_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'IsConnectionNameAvailable'
+type MockQuerier_IsConnectionNameAvailable_Call struct {
+	*mock.Call
+}
+
+// IsConnectionNameAvailable is a helper method to define mock.On call
+//   - ctx context.Context
+//   - db DBTX
+//   - arg IsConnectionNameAvailableParams
+func (_e *MockQuerier_Expecter) IsConnectionNameAvailable(ctx interface{}, db interface{}, arg interface{}) *MockQuerier_IsConnectionNameAvailable_Call {
+	return &MockQuerier_IsConnectionNameAvailable_Call{Call: _e.mock.On("IsConnectionNameAvailable", ctx, db, arg)}
+}
+
+func (_c *MockQuerier_IsConnectionNameAvailable_Call) Run(run func(ctx context.Context, db DBTX, arg IsConnectionNameAvailableParams)) *MockQuerier_IsConnectionNameAvailable_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(DBTX), args[2].(IsConnectionNameAvailableParams))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_IsConnectionNameAvailable_Call) Return(_a0 int64, _a1 error) *MockQuerier_IsConnectionNameAvailable_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQuerier_IsConnectionNameAvailable_Call) RunAndReturn(run func(context.Context, DBTX, IsConnectionNameAvailableParams) (int64, error)) *MockQuerier_IsConnectionNameAvailable_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// IsJobNameAvailable provides a mock function with given fields: ctx, db, arg
+func (_m *MockQuerier) IsJobNameAvailable(ctx context.Context, db DBTX, arg IsJobNameAvailableParams) (int64, error) {
+	ret := _m.Called(ctx, db, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for IsJobNameAvailable")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX, IsJobNameAvailableParams) (int64, error)); ok {
+		return rf(ctx, db, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX, IsJobNameAvailableParams) int64); ok {
+		r0 = rf(ctx, db, arg)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, DBTX, IsJobNameAvailableParams) error); ok {
+		r1 = rf(ctx, db, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQuerier_IsJobNameAvailable_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'IsJobNameAvailable'
+type MockQuerier_IsJobNameAvailable_Call struct {
+	*mock.Call
+}
+
+// IsJobNameAvailable is a helper method to define mock.On call
+//   - ctx context.Context
+//   - db DBTX
+//   - arg IsJobNameAvailableParams
+func (_e *MockQuerier_Expecter) IsJobNameAvailable(ctx interface{}, db interface{}, arg interface{}) *MockQuerier_IsJobNameAvailable_Call {
+	return &MockQuerier_IsJobNameAvailable_Call{Call: _e.mock.On("IsJobNameAvailable", ctx, db, arg)}
+}
+
+func (_c *MockQuerier_IsJobNameAvailable_Call) Run(run func(ctx context.Context, db DBTX, arg IsJobNameAvailableParams)) *MockQuerier_IsJobNameAvailable_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(DBTX), args[2].(IsJobNameAvailableParams))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_IsJobNameAvailable_Call) Return(_a0 int64, _a1 error) *MockQuerier_IsJobNameAvailable_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQuerier_IsJobNameAvailable_Call) RunAndReturn(run func(context.Context, DBTX, IsJobNameAvailableParams) (int64, error)) *MockQuerier_IsJobNameAvailable_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// IsTransformerNameAvailable provides a mock function with given fields: ctx, db, arg
+func (_m *MockQuerier) IsTransformerNameAvailable(ctx context.Context, db DBTX, arg IsTransformerNameAvailableParams) (int64, error) {
+	ret := _m.Called(ctx, db, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for IsTransformerNameAvailable")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX, IsTransformerNameAvailableParams) (int64, error)); ok {
+		return rf(ctx, db, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX, IsTransformerNameAvailableParams) int64); ok {
+		r0 = rf(ctx, db, arg)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, DBTX, IsTransformerNameAvailableParams) error); ok {
+		r1 = rf(ctx, db, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQuerier_IsTransformerNameAvailable_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'IsTransformerNameAvailable'
+type MockQuerier_IsTransformerNameAvailable_Call struct {
+	*mock.Call
+}
+
+// IsTransformerNameAvailable is a helper method to define mock.On call
+//   - ctx context.Context
+//   - db DBTX
+//   - arg IsTransformerNameAvailableParams
+func (_e *MockQuerier_Expecter) IsTransformerNameAvailable(ctx interface{}, db interface{}, arg interface{}) *MockQuerier_IsTransformerNameAvailable_Call {
+	return &MockQuerier_IsTransformerNameAvailable_Call{Call: _e.mock.On("IsTransformerNameAvailable", ctx, db, arg)}
+}
+
+func (_c *MockQuerier_IsTransformerNameAvailable_Call) Run(run func(ctx context.Context, db DBTX, arg IsTransformerNameAvailableParams)) *MockQuerier_IsTransformerNameAvailable_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(DBTX), args[2].(IsTransformerNameAvailableParams))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_IsTransformerNameAvailable_Call) Return(_a0 int64, _a1 error) *MockQuerier_IsTransformerNameAvailable_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQuerier_IsTransformerNameAvailable_Call) RunAndReturn(run func(context.Context, DBTX, IsTransformerNameAvailableParams) (int64, error)) *MockQuerier_IsTransformerNameAvailable_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// IsUserInAccount provides a mock function with given fields: ctx, db, arg
+func (_m *MockQuerier) IsUserInAccount(ctx context.Context, db DBTX, arg IsUserInAccountParams) (int64, error) {
+	ret := _m.Called(ctx, db, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for IsUserInAccount")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX, IsUserInAccountParams) (int64, error)); ok {
+		return rf(ctx, db, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX, IsUserInAccountParams) int64); ok {
+		r0 = rf(ctx, db, arg)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, DBTX, IsUserInAccountParams) error); ok {
+		r1 = rf(ctx, db, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQuerier_IsUserInAccount_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'IsUserInAccount'
+type MockQuerier_IsUserInAccount_Call struct {
+	*mock.Call
+}
+
+// IsUserInAccount is a helper method to define mock.On call
+//   - ctx context.Context
+//   - db DBTX
+//   - arg IsUserInAccountParams
 func (_e *MockQuerier_Expecter) IsUserInAccount(ctx interface{}, db interface{}, arg interface{}) *MockQuerier_IsUserInAccount_Call {
 	return &MockQuerier_IsUserInAccount_Call{Call: _e.mock.On("IsUserInAccount", ctx, db, arg)}
 }
 
-func (_c *MockQuerier_IsUserInAccount_Call) Run(run func(ctx context.Context, db DBTX, arg IsUserInAccountParams)) *MockQuerier_IsUserInAccount_Call {
+func (_c *MockQuerier_IsUserInAccount_Call) Run(run func(ctx context.Context, db DBTX, arg IsUserInAccountParams)) *MockQuerier_IsUserInAccount_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(DBTX), args[2].(IsUserInAccountParams))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_IsUserInAccount_Call) Return(_a0 int64, _a1 error) *MockQuerier_IsUserInAccount_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQuerier_IsUserInAccount_Call) RunAndReturn(run func(context.Context, DBTX, IsUserInAccountParams) (int64, error)) *MockQuerier_IsUserInAccount_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// IsUserInAccountApiKey provides a mock function with given fields: ctx, db, arg
+func (_m *MockQuerier) IsUserInAccountApiKey(ctx context.Context, db DBTX, arg IsUserInAccountApiKeyParams) (int64, error) {
+	ret := _m.Called(ctx, db, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for IsUserInAccountApiKey")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX, IsUserInAccountApiKeyParams) (int64, error)); ok {
+		return rf(ctx, db, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX, IsUserInAccountApiKeyParams) int64); ok {
+		r0 = rf(ctx, db, arg)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, DBTX, IsUserInAccountApiKeyParams) error); ok {
+		r1 = rf(ctx, db, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQuerier_IsUserInAccountApiKey_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'IsUserInAccountApiKey'
+type MockQuerier_IsUserInAccountApiKey_Call struct {
+	*mock.Call
+}
+
+// IsUserInAccountApiKey is a helper method to define mock.On call
+//   - ctx context.Context
+//   - db DBTX
+//   - arg IsUserInAccountApiKeyParams
+func (_e *MockQuerier_Expecter) IsUserInAccountApiKey(ctx interface{}, db interface{}, arg interface{}) *MockQuerier_IsUserInAccountApiKey_Call {
+	return &MockQuerier_IsUserInAccountApiKey_Call{Call: _e.mock.On("IsUserInAccountApiKey", ctx, db, arg)}
+}
+
+func (_c *MockQuerier_IsUserInAccountApiKey_Call) Run(run func(ctx context.Context, db DBTX, arg IsUserInAccountApiKeyParams)) *MockQuerier_IsUserInAccountApiKey_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(DBTX), args[2].(IsUserInAccountApiKeyParams))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_IsUserInAccountApiKey_Call) Return(_a0 int64, _a1 error) *MockQuerier_IsUserInAccountApiKey_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQuerier_IsUserInAccountApiKey_Call) RunAndReturn(run func(context.Context, DBTX, IsUserInAccountApiKeyParams) (int64, error)) *MockQuerier_IsUserInAccountApiKey_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RemoveAccountApiKey provides a mock function with given fields: ctx, db, id
+func (_m *MockQuerier) RemoveAccountApiKey(ctx context.Context, db DBTX, id pgtype.UUID) error {
+	ret := _m.Called(ctx, db, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RemoveAccountApiKey")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX, pgtype.UUID) error); ok {
+		r0 = rf(ctx, db, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockQuerier_RemoveAccountApiKey_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RemoveAccountApiKey'
+type MockQuerier_RemoveAccountApiKey_Call struct {
+	*mock.Call
+}
+
+// RemoveAccountApiKey is a helper method to define mock.On call
+//   - ctx context.Context
+//   - db DBTX
+//   - id pgtype.UUID
+func (_e *MockQuerier_Expecter) RemoveAccountApiKey(ctx interface{}, db interface{}, id interface{}) *MockQuerier_RemoveAccountApiKey_Call {
+	return &MockQuerier_RemoveAccountApiKey_Call{Call: _e.mock.On("RemoveAccountApiKey", ctx, db, id)}
+}
+
+func (_c *MockQuerier_RemoveAccountApiKey_Call) Run(run func(ctx context.Context, db DBTX, id pgtype.UUID)) *MockQuerier_RemoveAccountApiKey_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(DBTX), args[2].(IsUserInAccountParams))
+		run(args[0].(context.Context), args[1].(DBTX), args[2].(pgtype.UUID))
 	})
 	return _c
 }
 
-func (_c *MockQuerier_IsUserInAccount_Call) Return(_a0 int64, _a1 error) *MockQuerier_IsUserInAccount_Call {
-	_c.Call.Return(_a0, _a1)
+func (_c *MockQuerier_RemoveAccountApiKey_Call) Return(_a0 error) *MockQuerier_RemoveAccountApiKey_Call {
+	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *MockQuerier_IsUserInAccount_Call) RunAndReturn(run func(context.Context, DBTX, IsUserInAccountParams) (int64, error)) *MockQuerier_IsUserInAccount_Call {
+func (_c *MockQuerier_RemoveAccountApiKey_Call) RunAndReturn(run func(context.Context, DBTX, pgtype.UUID) error) *MockQuerier_RemoveAccountApiKey_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// IsUserInAccountApiKey provides a mock function with given fields: ctx, db, arg
-func (_m *MockQuerier) IsUserInAccountApiKey(ctx context.Context, db DBTX, arg IsUserInAccountApiKeyParams) (int64, error) {
+// RemoveAccountInvite provides a mock function with given fields: ctx, db, id
+func (_m *MockQuerier) RemoveAccountInvite(ctx context.Context, db DBTX, id pgtype.UUID) error {
+	ret := _m.Called(ctx, db, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RemoveAccountInvite")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX, pgtype.UUID) error); ok {
+		r0 = rf(ctx, db, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockQuerier_RemoveAccountInvite_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RemoveAccountInvite'
+type MockQuerier_RemoveAccountInvite_Call struct {
+	*mock.Call
+}
+
+// RemoveAccountInvite is a helper method to define mock.On call
+//   - ctx context.Context
+//   - db DBTX
+//   - id pgtype.UUID
+func (_e *MockQuerier_Expecter) RemoveAccountInvite(ctx interface{}, db interface{}, id interface{}) *MockQuerier_RemoveAccountInvite_Call {
+	return &MockQuerier_RemoveAccountInvite_Call{Call: _e.mock.On("RemoveAccountInvite", ctx, db, id)}
+}
+
+func (_c *MockQuerier_RemoveAccountInvite_Call) Run(run func(ctx context.Context, db DBTX, id pgtype.UUID)) *MockQuerier_RemoveAccountInvite_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(DBTX), args[2].(pgtype.UUID))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_RemoveAccountInvite_Call) Return(_a0 error) *MockQuerier_RemoveAccountInvite_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockQuerier_RemoveAccountInvite_Call) RunAndReturn(run func(context.Context, DBTX, pgtype.UUID) error) *MockQuerier_RemoveAccountInvite_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RemoveAccountUser provides a mock function with given fields: ctx, db, arg
+func (_m *MockQuerier) RemoveAccountUser(ctx context.Context, db DBTX, arg RemoveAccountUserParams) error {
 	ret := _m.Called(ctx, db, arg)
 
 	if len(ret) == 0 {
-		panic("no return value specified for IsUserInAccountApiKey")
+		panic("no return value specified for RemoveAccountUser")
 	}
 
-	var r0 int64
-	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, DBTX, IsUserInAccountApiKeyParams) (int64, error)); ok {
-		return rf(ctx, db, arg)
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX, RemoveAccountUserParams) error); ok {
+		r0 = rf(ctx, db, arg)
+	} else {
+		r0 = ret.Error(0)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, DBTX, IsUserInAccountApiKeyParams) int64); ok {
+
+	return r0
+}
+
+// MockQuerier_RemoveAccountUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RemoveAccountUser'
+type MockQuerier_RemoveAccountUser_Call struct {
+	*mock.Call
+}
+
+// RemoveAccountUser is a helper method to define mock.On call
+//   - ctx context.Context
+//   - db DBTX
+//   - arg RemoveAccountUserParams
+func (_e *MockQuerier_Expecter) RemoveAccountUser(ctx interface{}, db interface{}, arg interface{}) *MockQuerier_RemoveAccountUser_Call {
+	return &MockQuerier_RemoveAccountUser_Call{Call: _e.mock.On("RemoveAccountUser", ctx, db, arg)}
+}
+
+func (_c *MockQuerier_RemoveAccountUser_Call) Run(run func(ctx context.Context, db DBTX, arg RemoveAccountUserParams)) *MockQuerier_RemoveAccountUser_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(DBTX), args[2].(RemoveAccountUserParams))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_RemoveAccountUser_Call) Return(_a0 error) *MockQuerier_RemoveAccountUser_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockQuerier_RemoveAccountUser_Call) RunAndReturn(run func(context.Context, DBTX, RemoveAccountUserParams) error) *MockQuerier_RemoveAccountUser_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RemoveConnectionById provides a mock function with given fields: ctx, db, id
+func (_m *MockQuerier) RemoveConnectionById(ctx context.Context, db DBTX, id pgtype.UUID) error {
+	ret := _m.Called(ctx, db, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RemoveConnectionById")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX, pgtype.UUID) error); ok {
+		r0 = rf(ctx, db, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockQuerier_RemoveConnectionById_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RemoveConnectionById'
+type MockQuerier_RemoveConnectionById_Call struct {
+	*mock.Call
+}
+
+// RemoveConnectionById is a helper method to define mock.On call
+//   - ctx context.Context
+//   - db DBTX
+//   - id pgtype.UUID
+func (_e *MockQuerier_Expecter) RemoveConnectionById(ctx interface{}, db interface{}, id interface{}) *MockQuerier_RemoveConnectionById_Call {
+	return &MockQuerier_RemoveConnectionById_Call{Call: _e.mock.On("RemoveConnectionById", ctx, db, id)}
+}
+
+func (_c *MockQuerier_RemoveConnectionById_Call) Run(run func(ctx context.Context, db DBTX, id pgtype.UUID)) *MockQuerier_RemoveConnectionById_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(DBTX), args[2].(pgtype.UUID))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_RemoveConnectionById_Call) Return(_a0 error) *MockQuerier_RemoveConnectionById_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockQuerier_RemoveConnectionById_Call) RunAndReturn(run func(context.Context, DBTX, pgtype.UUID) error) *MockQuerier_RemoveConnectionById_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RemoveConnectionByNameAndAccount provides a mock function with given fields: ctx, db, arg
+func (_m *MockQuerier) RemoveConnectionByNameAndAccount(ctx context.Context, db DBTX, arg RemoveConnectionByNameAndAccountParams) error {
+	ret := _m.Called(ctx, db, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RemoveConnectionByNameAndAccount")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX, RemoveConnectionByNameAndAccountParams) error); ok {
 		r0 = rf(ctx, db, arg)
 	} else {
-		r0 = ret.Get(0).(int64)
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockQuerier_RemoveConnectionByNameAndAccount_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RemoveConnectionByNameAndAccount'
+type MockQuerier_RemoveConnectionByNameAndAccount_Call struct {
+	*mock.Call
+}
+
+// RemoveConnectionByNameAndAccount is a helper method to define mock.On call
+//   - ctx context.Context
+//   - db DBTX
+//   - arg RemoveConnectionByNameAndAccountParams
+func (_e *MockQuerier_Expecter) RemoveConnectionByNameAndAccount(ctx interface{}, db interface{}, arg interface{}) *MockQuerier_RemoveConnectionByNameAndAccount_Call {
+	return &MockQuerier_RemoveConnectionByNameAndAccount_Call{Call: _e.mock.On("RemoveConnectionByNameAndAccount", ctx, db, arg)}
+}
+
+func (_c *MockQuerier_RemoveConnectionByNameAndAccount_Call) Run(run func(ctx context.Context, db DBTX, arg RemoveConnectionByNameAndAccountParams)) *MockQuerier_RemoveConnectionByNameAndAccount_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(DBTX), args[2].(RemoveConnectionByNameAndAccountParams))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_RemoveConnectionByNameAndAccount_Call) Return(_a0 error) *MockQuerier_RemoveConnectionByNameAndAccount_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockQuerier_RemoveConnectionByNameAndAccount_Call) RunAndReturn(run func(context.Context, DBTX, RemoveConnectionByNameAndAccountParams) error) *MockQuerier_RemoveConnectionByNameAndAccount_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RemoveJobById provides a mock function with given fields: ctx, db, id
+func (_m *MockQuerier) RemoveJobById(ctx context.Context, db DBTX, id pgtype.UUID) error {
+	ret := _m.Called(ctx, db, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RemoveJobById")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX, pgtype.UUID) error); ok {
+		r0 = rf(ctx, db, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockQuerier_RemoveJobById_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RemoveJobById'
+type MockQuerier_RemoveJobById_Call struct {
+	*mock.Call
+}
+
+// RemoveJobById is a helper method to define mock.On call
+//   - ctx context.Context
+//   - db DBTX
+//   - id pgtype.UUID
+func (_e *MockQuerier_Expecter) RemoveJobById(ctx interface{}, db interface{}, id interface{}) *MockQuerier_RemoveJobById_Call {
+	return &MockQuerier_RemoveJobById_Call{Call: _e.mock.On("RemoveJobById", ctx, db, id)}
+}
+
+func (_c *MockQuerier_RemoveJobById_Call) Run(run func(ctx context.Context, db DBTX, id pgtype.UUID)) *MockQuerier_RemoveJobById_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(DBTX), args[2].(pgtype.UUID))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_RemoveJobById_Call) Return(_a0 error) *MockQuerier_RemoveJobById_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockQuerier_RemoveJobById_Call) RunAndReturn(run func(context.Context, DBTX, pgtype.UUID) error) *MockQuerier_RemoveJobById_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RemoveJobConnectionDestination provides a mock function with given fields: ctx, db, id
+func (_m *MockQuerier) RemoveJobConnectionDestination(ctx context.Context, db DBTX, id pgtype.UUID) error {
+	ret := _m.Called(ctx, db, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RemoveJobConnectionDestination")
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, DBTX, IsUserInAccountApiKeyParams) error); ok {
-		r1 = rf(ctx, db, arg)
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX, pgtype.UUID) error); ok {
+		r0 = rf(ctx, db, id)
 	} else {
-		r1 = ret.Error(1)
+		r0 = ret.Error(0)
 	}
 
-	return r0, r1
+	return r0
 }
 
-// MockQuerier_IsUserInAccountApiKey_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'IsUserInAccountApiKey'
-type MockQuerier_IsUserInAccountApiKey_Call struct {
+// MockQuerier_RemoveJobConnectionDestination_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RemoveJobConnectionDestination'
+type MockQuerier_RemoveJobConnectionDestination_Call struct {
 	*mock.Call
 }
 
-// IsUserInAccountApiKey is a helper method to define mock.On call
+// RemoveJobConnectionDestination is a helper method to define mock.On call
 //   - ctx context.Context
 //   - db DBTX
-//   - arg IsUserInAccountApiKeyParams
-func (_e *MockQuerier_Expecter) IsUserInAccountApiKey(ctx interface{}, db interface{}, arg interface{}) *MockQuerier_IsUserInAccountApiKey_Call {
-	return &MockQuerier_IsUserInAccountApiKey_Call{Call: _e.mock.On("IsUserInAccountApiKey", ctx, db, arg)}
+//   - id pgtype.UUID
+func (_e *MockQuerier_Expecter) RemoveJobConnectionDestination(ctx interface{}, db interface{}, id interface{}) *MockQuerier_RemoveJobConnectionDestination_Call {
+	return &MockQuerier_RemoveJobConnectionDestination_Call{Call: _e.mock.On("RemoveJobConnectionDestination", ctx, db, id)}
 }
 
-func (_c *MockQuerier_IsUserInAccountApiKey_Call) Run(run func(ctx context.Context, db DBTX, arg IsUserInAccountApiKeyParams)) *MockQuerier_IsUserInAccountApiKey_Call {
+func (_c *MockQuerier_RemoveJobConnectionDestination_Call) Run(run func(ctx context.Context, db DBTX, id pgtype.UUID)) *MockQuerier_RemoveJobConnectionDestination_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(DBTX), args[2].(IsUserInAccountApiKeyParams))
+		run(args[0].(context.Context), args[1].(DBTX), args[2].(pgtype.UUID))
 	})
 	return _c
 }
 
-func (_c *MockQuerier_IsUserInAccountApiKey_Call) Return(_a0 int64, _a1 error) *MockQuerier_IsUserInAccountApiKey_Call {
-	_c.Call.Return(_a0, _a1)
+func (_c *MockQuerier_RemoveJobConnectionDestination_Call) Return(_a0 error) *MockQuerier_RemoveJobConnectionDestination_Call {
+	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *MockQuerier_IsUserInAccountApiKey_Call) RunAndReturn(run func(context.Context, DBTX, IsUserInAccountApiKeyParams) (int64, error)) *MockQuerier_IsUserInAccountApiKey_Call {
+func (_c *MockQuerier_RemoveJobConnectionDestination_Call) RunAndReturn(run func(context.Context, DBTX, pgtype.UUID) error) *MockQuerier_RemoveJobConnectionDestination_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// RemoveAccountApiKey provides a mock function with given fields: ctx, db, id
-func (_m *MockQuerier) RemoveAccountApiKey(ctx context.Context, db DBTX, id pgtype.UUID) error {
-	ret := _m.Called(ctx, db, id)
+// RemoveJobConnectionDestinations provides a mock function with given fields: ctx, db, jobids
+func (_m *MockQuerier) RemoveJobConnectionDestinations(ctx context.Context, db DBTX, jobids []pgtype.UUID) error {
+	ret := _m.Called(ctx, db, jobids)
 
 	if len(ret) == 0 {
-		panic("no return value specified for RemoveAccountApiKey")
+		panic("no return value specified for RemoveJobConnectionDestinations")
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, DBTX, pgtype.UUID) error); ok {
-		r0 = rf(ctx, db, id)
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX, []pgtype.UUID) error); ok {
+		r0 = rf(ctx, db, jobids)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -3239,47 +4917,47 @@ func (_m *MockQuerier) RemoveAccountApiKey(ctx context.Context, db DBTX, id pgty
 	return r0
 }
 
-// MockQuerier_RemoveAccountApiKey_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RemoveAccountApiKey'
-type MockQuerier_RemoveAccountApiKey_Call struct {
+// MockQuerier_RemoveJobConnectionDestinations_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RemoveJobConnectionDestinations'
+type MockQuerier_RemoveJobConnectionDestinations_Call struct {
 	*mock.Call
 }
 
-// RemoveAccountApiKey is a helper method to define mock.On call
+// RemoveJobConnectionDestinations is a helper method to define mock.On call
 //   - ctx context.Context
 //   - db DBTX
-//   - id pgtype.UUID
-func (_e *MockQuerier_Expecter) RemoveAccountApiKey(ctx interface{}, db interface{}, id interface{}) *MockQuerier_RemoveAccountApiKey_Call {
-	return &MockQuerier_RemoveAccountApiKey_Call{Call: _e.mock.On("RemoveAccountApiKey", ctx, db, id)}
+//   - jobids []pgtype.UUID
+func (_e *MockQuerier_Expecter) RemoveJobConnectionDestinations(ctx interface{}, db interface{}, jobids interface{}) *MockQuerier_RemoveJobConnectionDestinations_Call {
+	return &MockQuerier_RemoveJobConnectionDestinations_Call{Call: _e.mock.On("RemoveJobConnectionDestinations", ctx, db, jobids)}
 }
 
-func (_c *MockQuerier_RemoveAccountApiKey_Call) Run(run func(ctx context.Context, db DBTX, id pgtype.UUID)) *MockQuerier_RemoveAccountApiKey_Call {
+func (_c *MockQuerier_RemoveJobConnectionDestinations_Call) Run(run func(ctx context.Context, db DBTX, jobids []pgtype.UUID)) *MockQuerier_RemoveJobConnectionDestinations_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(DBTX), args[2].(pgtype.UUID))
+		run(args[0].(context.Context), args[1].(DBTX), args[2].([]pgtype.UUID))
 	})
 	return _c
 }
 
-func (_c *MockQuerier_RemoveAccountApiKey_Call) Return(_a0 error) *MockQuerier_RemoveAccountApiKey_Call {
+func (_c *MockQuerier_RemoveJobConnectionDestinations_Call) Return(_a0 error) *MockQuerier_RemoveJobConnectionDestinations_Call {
 	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *MockQuerier_RemoveAccountApiKey_Call) RunAndReturn(run func(context.Context, DBTX, pgtype.UUID) error) *MockQuerier_RemoveAccountApiKey_Call {
+func (_c *MockQuerier_RemoveJobConnectionDestinations_Call) RunAndReturn(run func(context.Context, DBTX, []pgtype.UUID) error) *MockQuerier_RemoveJobConnectionDestinations_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// RemoveAccountInvite provides a mock function with given fields: ctx, db, id
-func (_m *MockQuerier) RemoveAccountInvite(ctx context.Context, db DBTX, id pgtype.UUID) error {
-	ret := _m.Called(ctx, db, id)
+// RemoveJobWatermark provides a mock function with given fields: ctx, db, arg
+func (_m *MockQuerier) RemoveJobWatermark(ctx context.Context, db DBTX, arg RemoveJobWatermarkParams) error {
+	ret := _m.Called(ctx, db, arg)
 
 	if len(ret) == 0 {
-		panic("no return value specified for RemoveAccountInvite")
+		panic("no return value specified for RemoveJobWatermark")
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, DBTX, pgtype.UUID) error); ok {
-		r0 = rf(ctx, db, id)
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX, RemoveJobWatermarkParams) error); ok {
+		r0 = rf(ctx, db, arg)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -3287,47 +4965,47 @@ func (_m *MockQuerier) RemoveAccountInvite(ctx context.Context, db DBTX, id pgty
 	return r0
 }
 
-// MockQuerier_RemoveAccountInvite_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RemoveAccountInvite'
-type MockQuerier_RemoveAccountInvite_Call struct {
+// MockQuerier_RemoveJobWatermark_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RemoveJobWatermark'
+type MockQuerier_RemoveJobWatermark_Call struct {
 	*mock.Call
 }
 
-// RemoveAccountInvite is a helper method to define mock.On call
+// RemoveJobWatermark is a helper method to define mock.On call
 //   - ctx context.Context
 //   - db DBTX
-//   - id pgtype.UUID
-func (_e *MockQuerier_Expecter) RemoveAccountInvite(ctx interface{}, db interface{}, id interface{}) *MockQuerier_RemoveAccountInvite_Call {
-	return &MockQuerier_RemoveAccountInvite_Call{Call: _e.mock.On("RemoveAccountInvite", ctx, db, id)}
+//   - arg RemoveJobWatermarkParams
+func (_e *MockQuerier_Expecter) RemoveJobWatermark(ctx interface{}, db interface{}, arg interface{}) *MockQuerier_RemoveJobWatermark_Call {
+	return &MockQuerier_RemoveJobWatermark_Call{Call: _e.mock.On("RemoveJobWatermark", ctx, db, arg)}
 }
 
-func (_c *MockQuerier_RemoveAccountInvite_Call) Run(run func(ctx context.Context, db DBTX, id pgtype.UUID)) *MockQuerier_RemoveAccountInvite_Call {
+func (_c *MockQuerier_RemoveJobWatermark_Call) Run(run func(ctx context.Context, db DBTX, arg RemoveJobWatermarkParams)) *MockQuerier_RemoveJobWatermark_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(DBTX), args[2].(pgtype.UUID))
+		run(args[0].(context.Context), args[1].(DBTX), args[2].(RemoveJobWatermarkParams))
 	})
 	return _c
 }
 
-func (_c *MockQuerier_RemoveAccountInvite_Call) Return(_a0 error) *MockQuerier_RemoveAccountInvite_Call {
+func (_c *MockQuerier_RemoveJobWatermark_Call) Return(_a0 error) *MockQuerier_RemoveJobWatermark_Call {
 	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *MockQuerier_RemoveAccountInvite_Call) RunAndReturn(run func(context.Context, DBTX, pgtype.UUID) error) *MockQuerier_RemoveAccountInvite_Call {
+func (_c *MockQuerier_RemoveJobWatermark_Call) RunAndReturn(run func(context.Context, DBTX, RemoveJobWatermarkParams) error) *MockQuerier_RemoveJobWatermark_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// RemoveAccountUser provides a mock function with given fields: ctx, db, arg
-func (_m *MockQuerier) RemoveAccountUser(ctx context.Context, db DBTX, arg RemoveAccountUserParams) error {
-	ret := _m.Called(ctx, db, arg)
+// RemoveJobWatermarks provides a mock function with given fields: ctx, db, jobID
+func (_m *MockQuerier) RemoveJobWatermarks(ctx context.Context, db DBTX, jobID pgtype.UUID) error {
+	ret := _m.Called(ctx, db, jobID)
 
 	if len(ret) == 0 {
-		panic("no return value specified for RemoveAccountUser")
+		panic("no return value specified for RemoveJobWatermarks")
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, DBTX, RemoveAccountUserParams) error); ok {
-		r0 = rf(ctx, db, arg)
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX, pgtype.UUID) error); ok {
+		r0 = rf(ctx, db, jobID)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -3335,42 +5013,42 @@ func (_m *MockQuerier) RemoveAccountUser(ctx context.Context, db DBTX, arg Remov
 	return r0
 }
 
-// MockQuerier_RemoveAccountUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RemoveAccountUser'
-type MockQuerier_RemoveAccountUser_Call struct {
+// MockQuerier_RemoveJobWatermarks_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RemoveJobWatermarks'
+type MockQuerier_RemoveJobWatermarks_Call struct {
 	*mock.Call
 }
 
-// RemoveAccountUser is a helper method to define mock.On call
+// RemoveJobWatermarks is a helper method to define mock.On call
 //   - ctx context.Context
 //   - db DBTX
-//   - arg RemoveAccountUserParams
-func (_e *MockQuerier_Expecter) RemoveAccountUser(ctx interface{}, db interface{}, arg interface{}) *MockQuerier_RemoveAccountUser_Call {
-	return &MockQuerier_RemoveAccountUser_Call{Call: _e.mock.On("RemoveAccountUser", ctx, db, arg)}
+//   - jobID pgtype.UUID
+func (_e *MockQuerier_Expecter) RemoveJobWatermarks(ctx interface{}, db interface{}, jobID interface{}) *MockQuerier_RemoveJobWatermarks_Call {
+	return &MockQuerier_RemoveJobWatermarks_Call{Call: _e.mock.On("RemoveJobWatermarks", ctx, db, jobID)}
 }
 
-func (_c *MockQuerier_RemoveAccountUser_Call) Run(run func(ctx context.Context, db DBTX, arg RemoveAccountUserParams)) *MockQuerier_RemoveAccountUser_Call {
+func (_c *MockQuerier_RemoveJobWatermarks_Call) Run(run func(ctx context.Context, db DBTX, jobID pgtype.UUID)) *MockQuerier_RemoveJobWatermarks_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(DBTX), args[2].(RemoveAccountUserParams))
+		run(args[0].(context.Context), args[1].(DBTX), args[2].(pgtype.UUID))
 	})
 	return _c
 }
 
-func (_c *MockQuerier_RemoveAccountUser_Call) Return(_a0 error) *MockQuerier_RemoveAccountUser_Call {
+func (_c *MockQuerier_RemoveJobWatermarks_Call) Return(_a0 error) *MockQuerier_RemoveJobWatermarks_Call {
 	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *MockQuerier_RemoveAccountUser_Call) RunAndReturn(run func(context.Context, DBTX, RemoveAccountUserParams) error) *MockQuerier_RemoveAccountUser_Call {
+func (_c *MockQuerier_RemoveJobWatermarks_Call) RunAndReturn(run func(context.Context, DBTX, pgtype.UUID) error) *MockQuerier_RemoveJobWatermarks_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// RemoveConnectionById provides a mock function with given fields: ctx, db, id
-func (_m *MockQuerier) RemoveConnectionById(ctx context.Context, db DBTX, id pgtype.UUID) error {
+// RemoveWebhookById provides a mock function with given fields: ctx, db, id
+func (_m *MockQuerier) RemoveWebhookById(ctx context.Context, db DBTX, id pgtype.UUID) error {
 	ret := _m.Called(ctx, db, id)
 
 	if len(ret) == 0 {
-		panic("no return value specified for RemoveConnectionById")
+		panic("no return value specified for RemoveWebhookById")
 	}
 
 	var r0 error
@@ -3383,249 +5061,288 @@ func (_m *MockQuerier) RemoveConnectionById(ctx context.Context, db DBTX, id pgt
 	return r0
 }
 
-// MockQuerier_RemoveConnectionById_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RemoveConnectionById'
-type MockQuerier_RemoveConnectionById_Call struct {
+// MockQuerier_RemoveWebhookById_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RemoveWebhookById'
+type MockQuerier_RemoveWebhookById_Call struct {
 	*mock.Call
 }
 
-// RemoveConnectionById is a helper method to define mock.On call
+// RemoveWebhookById is a helper method to define mock.On call
 //   - ctx context.Context
 //   - db DBTX
 //   - id pgtype.UUID
-func (_e *MockQuerier_Expecter) RemoveConnectionById(ctx interface{}, db interface{}, id interface{}) *MockQuerier_RemoveConnectionById_Call {
-	return &MockQuerier_RemoveConnectionById_Call{Call: _e.mock.On("RemoveConnectionById", ctx, db, id)}
+func (_e *MockQuerier_Expecter) RemoveWebhookById(ctx interface{}, db interface{}, id interface{}) *MockQuerier_RemoveWebhookById_Call {
+	return &MockQuerier_RemoveWebhookById_Call{Call: _e.mock.On("RemoveWebhookById", ctx, db, id)}
 }
 
-func (_c *MockQuerier_RemoveConnectionById_Call) Run(run func(ctx context.Context, db DBTX, id pgtype.UUID)) *MockQuerier_RemoveConnectionById_Call {
+func (_c *MockQuerier_RemoveWebhookById_Call) Run(run func(ctx context.Context, db DBTX, id pgtype.UUID)) *MockQuerier_RemoveWebhookById_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		run(args[0].(context.Context), args[1].(DBTX), args[2].(pgtype.UUID))
 	})
 	return _c
 }
 
-func (_c *MockQuerier_RemoveConnectionById_Call) Return(_a0 error) *MockQuerier_RemoveConnectionById_Call {
+func (_c *MockQuerier_RemoveWebhookById_Call) Return(_a0 error) *MockQuerier_RemoveWebhookById_Call {
 	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *MockQuerier_RemoveConnectionById_Call) RunAndReturn(run func(context.Context, DBTX, pgtype.UUID) error) *MockQuerier_RemoveConnectionById_Call {
+func (_c *MockQuerier_RemoveWebhookById_Call) RunAndReturn(run func(context.Context, DBTX, pgtype.UUID) error) *MockQuerier_RemoveWebhookById_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// RemoveConnectionByNameAndAccount provides a mock function with given fields: ctx, db, arg
-func (_m *MockQuerier) RemoveConnectionByNameAndAccount(ctx context.Context, db DBTX, arg RemoveConnectionByNameAndAccountParams) error {
-	ret := _m.Called(ctx, db, arg)
+// SetAnonymousUser provides a mock function with given fields: ctx, db
+func (_m *MockQuerier) SetAnonymousUser(ctx context.Context, db DBTX) (NeosyncApiUser, error) {
+	ret := _m.Called(ctx, db)
 
 	if len(ret) == 0 {
-		panic("no return value specified for RemoveConnectionByNameAndAccount")
+		panic("no return value specified for SetAnonymousUser")
 	}
 
-	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, DBTX, RemoveConnectionByNameAndAccountParams) error); ok {
-		r0 = rf(ctx, db, arg)
+	var r0 NeosyncApiUser
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX) (NeosyncApiUser, error)); ok {
+		return rf(ctx, db)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX) NeosyncApiUser); ok {
+		r0 = rf(ctx, db)
 	} else {
-		r0 = ret.Error(0)
+		r0 = ret.Get(0).(NeosyncApiUser)
 	}
 
-	return r0
+	if rf, ok := ret.Get(1).(func(context.Context, DBTX) error); ok {
+		r1 = rf(ctx, db)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
 }
 
-// MockQuerier_RemoveConnectionByNameAndAccount_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RemoveConnectionByNameAndAccount'
-type MockQuerier_RemoveConnectionByNameAndAccount_Call struct {
+// MockQuerier_SetAnonymousUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetAnonymousUser'
+type MockQuerier_SetAnonymousUser_Call struct {
 	*mock.Call
 }
 
-// RemoveConnectionByNameAndAccount is a helper method to define mock.On call
+// SetAnonymousUser is a helper method to define mock.On call
 //   - ctx context.Context
 //   - db DBTX
-//   - arg RemoveConnectionByNameAndAccountParams
-func (_e *MockQuerier_Expecter) RemoveConnectionByNameAndAccount(ctx interface{}, db interface{}, arg interface{}) *MockQuerier_RemoveConnectionByNameAndAccount_Call {
-	return &MockQuerier_RemoveConnectionByNameAndAccount_Call{Call: _e.mock.On("RemoveConnectionByNameAndAccount", ctx, db, arg)}
+func (_e *MockQuerier_Expecter) SetAnonymousUser(ctx interface{}, db interface{}) *MockQuerier_SetAnonymousUser_Call {
+	return &MockQuerier_SetAnonymousUser_Call{Call: _e.mock.On("SetAnonymousUser", ctx, db)}
 }
 
-func (_c *MockQuerier_RemoveConnectionByNameAndAccount_Call) Run(run func(ctx context.Context, db DBTX, arg RemoveConnectionByNameAndAccountParams)) *MockQuerier_RemoveConnectionByNameAndAccount_Call {
+func (_c *MockQuerier_SetAnonymousUser_Call) Run(run func(ctx context.Context, db DBTX)) *MockQuerier_SetAnonymousUser_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(DBTX), args[2].(RemoveConnectionByNameAndAccountParams))
+		run(args[0].(context.Context), args[1].(DBTX))
 	})
 	return _c
 }
 
-func (_c *MockQuerier_RemoveConnectionByNameAndAccount_Call) Return(_a0 error) *MockQuerier_RemoveConnectionByNameAndAccount_Call {
-	_c.Call.Return(_a0)
+func (_c *MockQuerier_SetAnonymousUser_Call) Return(_a0 NeosyncApiUser, _a1 error) *MockQuerier_SetAnonymousUser_Call {
+	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockQuerier_RemoveConnectionByNameAndAccount_Call) RunAndReturn(run func(context.Context, DBTX, RemoveConnectionByNameAndAccountParams) error) *MockQuerier_RemoveConnectionByNameAndAccount_Call {
+func (_c *MockQuerier_SetAnonymousUser_Call) RunAndReturn(run func(context.Context, DBTX) (NeosyncApiUser, error)) *MockQuerier_SetAnonymousUser_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// RemoveJobById provides a mock function with given fields: ctx, db, id
-func (_m *MockQuerier) RemoveJobById(ctx context.Context, db DBTX, id pgtype.UUID) error {
-	ret := _m.Called(ctx, db, id)
+// SetJobRunSchemaDriftReport provides a mock function with given fields: ctx, db, arg
+func (_m *MockQuerier) SetJobRunSchemaDriftReport(ctx context.Context, db DBTX, arg SetJobRunSchemaDriftReportParams) (NeosyncApiJobRunSchemaDriftReport, error) {
+	ret := _m.Called(ctx, db, arg)
 
 	if len(ret) == 0 {
-		panic("no return value specified for RemoveJobById")
+		panic("no return value specified for SetJobRunSchemaDriftReport")
 	}
 
-	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, DBTX, pgtype.UUID) error); ok {
-		r0 = rf(ctx, db, id)
+	var r0 NeosyncApiJobRunSchemaDriftReport
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX, SetJobRunSchemaDriftReportParams) (NeosyncApiJobRunSchemaDriftReport, error)); ok {
+		return rf(ctx, db, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX, SetJobRunSchemaDriftReportParams) NeosyncApiJobRunSchemaDriftReport); ok {
+		r0 = rf(ctx, db, arg)
 	} else {
-		r0 = ret.Error(0)
+		r0 = ret.Get(0).(NeosyncApiJobRunSchemaDriftReport)
 	}
 
-	return r0
+	if rf, ok := ret.Get(1).(func(context.Context, DBTX, SetJobRunSchemaDriftReportParams) error); ok {
+		r1 = rf(ctx, db, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
 }
 
-// MockQuerier_RemoveJobById_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RemoveJobById'
-type MockQuerier_RemoveJobById_Call struct {
+// MockQuerier_SetJobRunSchemaDriftReport_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetJobRunSchemaDriftReport'
+type MockQuerier_SetJobRunSchemaDriftReport_Call struct {
 	*mock.Call
 }
 
-// RemoveJobById is a helper method to define mock.On call
+// SetJobRunSchemaDriftReport is a helper method to define mock.On call
 //   - ctx context.Context
 //   - db DBTX
-//   - id pgtype.UUID
-func (_e *MockQuerier_Expecter) RemoveJobById(ctx interface{}, db interface{}, id interface{}) *MockQuerier_RemoveJobById_Call {
-	return &MockQuerier_RemoveJobById_Call{Call: _e.mock.On("RemoveJobById", ctx, db, id)}
+//   - arg SetJobRunSchemaDriftReportParams
+func (_e *MockQuerier_Expecter) SetJobRunSchemaDriftReport(ctx interface{}, db interface{}, arg interface{}) *MockQuerier_SetJobRunSchemaDriftReport_Call {
+	return &MockQuerier_SetJobRunSchemaDriftReport_Call{Call: _e.mock.On("SetJobRunSchemaDriftReport", ctx, db, arg)}
 }
 
-func (_c *MockQuerier_RemoveJobById_Call) Run(run func(ctx context.Context, db DBTX, id pgtype.UUID)) *MockQuerier_RemoveJobById_Call {
+func (_c *MockQuerier_SetJobRunSchemaDriftReport_Call) Run(run func(ctx context.Context, db DBTX, arg SetJobRunSchemaDriftReportParams)) *MockQuerier_SetJobRunSchemaDriftReport_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(DBTX), args[2].(pgtype.UUID))
+		run(args[0].(context.Context), args[1].(DBTX), args[2].(SetJobRunSchemaDriftReportParams))
 	})
 	return _c
 }
 
-func (_c *MockQuerier_RemoveJobById_Call) Return(_a0 error) *MockQuerier_RemoveJobById_Call {
-	_c.Call.Return(_a0)
+func (_c *MockQuerier_SetJobRunSchemaDriftReport_Call) Return(_a0 NeosyncApiJobRunSchemaDriftReport, _a1 error) *MockQuerier_SetJobRunSchemaDriftReport_Call {
+	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockQuerier_RemoveJobById_Call) RunAndReturn(run func(context.Context, DBTX, pgtype.UUID) error) *MockQuerier_RemoveJobById_Call {
+func (_c *MockQuerier_SetJobRunSchemaDriftReport_Call) RunAndReturn(run func(context.Context, DBTX, SetJobRunSchemaDriftReportParams) (NeosyncApiJobRunSchemaDriftReport, error)) *MockQuerier_SetJobRunSchemaDriftReport_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// RemoveJobConnectionDestination provides a mock function with given fields: ctx, db, id
-func (_m *MockQuerier) RemoveJobConnectionDestination(ctx context.Context, db DBTX, id pgtype.UUID) error {
-	ret := _m.Called(ctx, db, id)
+// SetJobRunValidationReport provides a mock function with given fields: ctx, db, arg
+func (_m *MockQuerier) SetJobRunValidationReport(ctx context.Context, db DBTX, arg SetJobRunValidationReportParams) (NeosyncApiJobRunValidationReport, error) {
+	ret := _m.Called(ctx, db, arg)
 
 	if len(ret) == 0 {
-		panic("no return value specified for RemoveJobConnectionDestination")
+		panic("no return value specified for SetJobRunValidationReport")
 	}
 
-	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, DBTX, pgtype.UUID) error); ok {
-		r0 = rf(ctx, db, id)
+	var r0 NeosyncApiJobRunValidationReport
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX, SetJobRunValidationReportParams) (NeosyncApiJobRunValidationReport, error)); ok {
+		return rf(ctx, db, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX, SetJobRunValidationReportParams) NeosyncApiJobRunValidationReport); ok {
+		r0 = rf(ctx, db, arg)
 	} else {
-		r0 = ret.Error(0)
+		r0 = ret.Get(0).(NeosyncApiJobRunValidationReport)
 	}
 
-	return r0
+	if rf, ok := ret.Get(1).(func(context.Context, DBTX, SetJobRunValidationReportParams) error); ok {
+		r1 = rf(ctx, db, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
 }
 
-// MockQuerier_RemoveJobConnectionDestination_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RemoveJobConnectionDestination'
-type MockQuerier_RemoveJobConnectionDestination_Call struct {
+// MockQuerier_SetJobRunValidationReport_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetJobRunValidationReport'
+type MockQuerier_SetJobRunValidationReport_Call struct {
 	*mock.Call
 }
 
-// RemoveJobConnectionDestination is a helper method to define mock.On call
+// SetJobRunValidationReport is a helper method to define mock.On call
 //   - ctx context.Context
 //   - db DBTX
-//   - id pgtype.UUID
-func (_e *MockQuerier_Expecter) RemoveJobConnectionDestination(ctx interface{}, db interface{}, id interface{}) *MockQuerier_RemoveJobConnectionDestination_Call {
-	return &MockQuerier_RemoveJobConnectionDestination_Call{Call: _e.mock.On("RemoveJobConnectionDestination", ctx, db, id)}
+//   - arg SetJobRunValidationReportParams
+func (_e *MockQuerier_Expecter) SetJobRunValidationReport(ctx interface{}, db interface{}, arg interface{}) *MockQuerier_SetJobRunValidationReport_Call {
+	return &MockQuerier_SetJobRunValidationReport_Call{Call: _e.mock.On("SetJobRunValidationReport", ctx, db, arg)}
 }
 
-func (_c *MockQuerier_RemoveJobConnectionDestination_Call) Run(run func(ctx context.Context, db DBTX, id pgtype.UUID)) *MockQuerier_RemoveJobConnectionDestination_Call {
+func (_c *MockQuerier_SetJobRunValidationReport_Call) Run(run func(ctx context.Context, db DBTX, arg SetJobRunValidationReportParams)) *MockQuerier_SetJobRunValidationReport_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(DBTX), args[2].(pgtype.UUID))
+		run(args[0].(context.Context), args[1].(DBTX), args[2].(SetJobRunValidationReportParams))
 	})
 	return _c
 }
 
-func (_c *MockQuerier_RemoveJobConnectionDestination_Call) Return(_a0 error) *MockQuerier_RemoveJobConnectionDestination_Call {
-	_c.Call.Return(_a0)
+func (_c *MockQuerier_SetJobRunValidationReport_Call) Return(_a0 NeosyncApiJobRunValidationReport, _a1 error) *MockQuerier_SetJobRunValidationReport_Call {
+	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockQuerier_RemoveJobConnectionDestination_Call) RunAndReturn(run func(context.Context, DBTX, pgtype.UUID) error) *MockQuerier_RemoveJobConnectionDestination_Call {
+func (_c *MockQuerier_SetJobRunValidationReport_Call) RunAndReturn(run func(context.Context, DBTX, SetJobRunValidationReportParams) (NeosyncApiJobRunValidationReport, error)) *MockQuerier_SetJobRunValidationReport_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// RemoveJobConnectionDestinations provides a mock function with given fields: ctx, db, jobids
-func (_m *MockQuerier) RemoveJobConnectionDestinations(ctx context.Context, db DBTX, jobids []pgtype.UUID) error {
-	ret := _m.Called(ctx, db, jobids)
+// SetJobSyncOptions provides a mock function with given fields: ctx, db, arg
+func (_m *MockQuerier) SetJobSyncOptions(ctx context.Context, db DBTX, arg SetJobSyncOptionsParams) (NeosyncApiJob, error) {
+	ret := _m.Called(ctx, db, arg)
 
 	if len(ret) == 0 {
-		panic("no return value specified for RemoveJobConnectionDestinations")
+		panic("no return value specified for SetJobSyncOptions")
 	}
 
-	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, DBTX, []pgtype.UUID) error); ok {
-		r0 = rf(ctx, db, jobids)
+	var r0 NeosyncApiJob
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX, SetJobSyncOptionsParams) (NeosyncApiJob, error)); ok {
+		return rf(ctx, db, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX, SetJobSyncOptionsParams) NeosyncApiJob); ok {
+		r0 = rf(ctx, db, arg)
 	} else {
-		r0 = ret.Error(0)
+		r0 = ret.Get(0).(NeosyncApiJob)
 	}
 
-	return r0
+	if rf, ok := ret.Get(1).(func(context.Context, DBTX, SetJobSyncOptionsParams) error); ok {
+		r1 = rf(ctx, db, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
 }
 
-// MockQuerier_RemoveJobConnectionDestinations_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RemoveJobConnectionDestinations'
-type MockQuerier_RemoveJobConnectionDestinations_Call struct {
+// MockQuerier_SetJobSyncOptions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetJobSyncOptions'
+type MockQuerier_SetJobSyncOptions_Call struct {
 	*mock.Call
 }
 
-// RemoveJobConnectionDestinations is a helper method to define mock.On call
+// SetJobSyncOptions is a helper method to define mock.On call
 //   - ctx context.Context
 //   - db DBTX
-//   - jobids []pgtype.UUID
-func (_e *MockQuerier_Expecter) RemoveJobConnectionDestinations(ctx interface{}, db interface{}, jobids interface{}) *MockQuerier_RemoveJobConnectionDestinations_Call {
-	return &MockQuerier_RemoveJobConnectionDestinations_Call{Call: _e.mock.On("RemoveJobConnectionDestinations", ctx, db, jobids)}
+//   - arg SetJobSyncOptionsParams
+func (_e *MockQuerier_Expecter) SetJobSyncOptions(ctx interface{}, db interface{}, arg interface{}) *MockQuerier_SetJobSyncOptions_Call {
+	return &MockQuerier_SetJobSyncOptions_Call{Call: _e.mock.On("SetJobSyncOptions", ctx, db, arg)}
 }
 
-func (_c *MockQuerier_RemoveJobConnectionDestinations_Call) Run(run func(ctx context.Context, db DBTX, jobids []pgtype.UUID)) *MockQuerier_RemoveJobConnectionDestinations_Call {
+func (_c *MockQuerier_SetJobSyncOptions_Call) Run(run func(ctx context.Context, db DBTX, arg SetJobSyncOptionsParams)) *MockQuerier_SetJobSyncOptions_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(DBTX), args[2].([]pgtype.UUID))
+		run(args[0].(context.Context), args[1].(DBTX), args[2].(SetJobSyncOptionsParams))
 	})
 	return _c
 }
 
-func (_c *MockQuerier_RemoveJobConnectionDestinations_Call) Return(_a0 error) *MockQuerier_RemoveJobConnectionDestinations_Call {
-	_c.Call.Return(_a0)
+func (_c *MockQuerier_SetJobSyncOptions_Call) Return(_a0 NeosyncApiJob, _a1 error) *MockQuerier_SetJobSyncOptions_Call {
+	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockQuerier_RemoveJobConnectionDestinations_Call) RunAndReturn(run func(context.Context, DBTX, []pgtype.UUID) error) *MockQuerier_RemoveJobConnectionDestinations_Call {
+func (_c *MockQuerier_SetJobSyncOptions_Call) RunAndReturn(run func(context.Context, DBTX, SetJobSyncOptionsParams) (NeosyncApiJob, error)) *MockQuerier_SetJobSyncOptions_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// SetAnonymousUser provides a mock function with given fields: ctx, db
-func (_m *MockQuerier) SetAnonymousUser(ctx context.Context, db DBTX) (NeosyncApiUser, error) {
-	ret := _m.Called(ctx, db)
+// SetJobTriggers provides a mock function with given fields: ctx, db, arg
+func (_m *MockQuerier) SetJobTriggers(ctx context.Context, db DBTX, arg SetJobTriggersParams) (NeosyncApiJob, error) {
+	ret := _m.Called(ctx, db, arg)
 
 	if len(ret) == 0 {
-		panic("no return value specified for SetAnonymousUser")
+		panic("no return value specified for SetJobTriggers")
 	}
 
-	var r0 NeosyncApiUser
+	var r0 NeosyncApiJob
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, DBTX) (NeosyncApiUser, error)); ok {
-		return rf(ctx, db)
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX, SetJobTriggersParams) (NeosyncApiJob, error)); ok {
+		return rf(ctx, db, arg)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, DBTX) NeosyncApiUser); ok {
-		r0 = rf(ctx, db)
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX, SetJobTriggersParams) NeosyncApiJob); ok {
+		r0 = rf(ctx, db, arg)
 	} else {
-		r0 = ret.Get(0).(NeosyncApiUser)
+		r0 = ret.Get(0).(NeosyncApiJob)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, DBTX) error); ok {
-		r1 = rf(ctx, db)
+	if rf, ok := ret.Get(1).(func(context.Context, DBTX, SetJobTriggersParams) error); ok {
+		r1 = rf(ctx, db, arg)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -3633,55 +5350,56 @@ func (_m *MockQuerier) SetAnonymousUser(ctx context.Context, db DBTX) (NeosyncAp
 	return r0, r1
 }
 
-// MockQuerier_SetAnonymousUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetAnonymousUser'
-type MockQuerier_SetAnonymousUser_Call struct {
+// MockQuerier_SetJobTriggers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetJobTriggers'
+type MockQuerier_SetJobTriggers_Call struct {
 	*mock.Call
 }
 
-// SetAnonymousUser is a helper method to define mock.On call
+// SetJobTriggers is a helper method to define mock.On call
 //   - ctx context.Context
 //   - db DBTX
-func (_e *MockQuerier_Expecter) SetAnonymousUser(ctx interface{}, db interface{}) *MockQuerier_SetAnonymousUser_Call {
-	return &MockQuerier_SetAnonymousUser_Call{Call: _e.mock.On("SetAnonymousUser", ctx, db)}
+//   - arg SetJobTriggersParams
+func (_e *MockQuerier_Expecter) SetJobTriggers(ctx interface{}, db interface{}, arg interface{}) *MockQuerier_SetJobTriggers_Call {
+	return &MockQuerier_SetJobTriggers_Call{Call: _e.mock.On("SetJobTriggers", ctx, db, arg)}
 }
 
-func (_c *MockQuerier_SetAnonymousUser_Call) Run(run func(ctx context.Context, db DBTX)) *MockQuerier_SetAnonymousUser_Call {
+func (_c *MockQuerier_SetJobTriggers_Call) Run(run func(ctx context.Context, db DBTX, arg SetJobTriggersParams)) *MockQuerier_SetJobTriggers_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(DBTX))
+		run(args[0].(context.Context), args[1].(DBTX), args[2].(SetJobTriggersParams))
 	})
 	return _c
 }
 
-func (_c *MockQuerier_SetAnonymousUser_Call) Return(_a0 NeosyncApiUser, _a1 error) *MockQuerier_SetAnonymousUser_Call {
+func (_c *MockQuerier_SetJobTriggers_Call) Return(_a0 NeosyncApiJob, _a1 error) *MockQuerier_SetJobTriggers_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockQuerier_SetAnonymousUser_Call) RunAndReturn(run func(context.Context, DBTX) (NeosyncApiUser, error)) *MockQuerier_SetAnonymousUser_Call {
+func (_c *MockQuerier_SetJobTriggers_Call) RunAndReturn(run func(context.Context, DBTX, SetJobTriggersParams) (NeosyncApiJob, error)) *MockQuerier_SetJobTriggers_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// SetJobSyncOptions provides a mock function with given fields: ctx, db, arg
-func (_m *MockQuerier) SetJobSyncOptions(ctx context.Context, db DBTX, arg SetJobSyncOptionsParams) (NeosyncApiJob, error) {
+// SetJobWatermark provides a mock function with given fields: ctx, db, arg
+func (_m *MockQuerier) SetJobWatermark(ctx context.Context, db DBTX, arg SetJobWatermarkParams) (NeosyncApiJobWatermark, error) {
 	ret := _m.Called(ctx, db, arg)
 
 	if len(ret) == 0 {
-		panic("no return value specified for SetJobSyncOptions")
+		panic("no return value specified for SetJobWatermark")
 	}
 
-	var r0 NeosyncApiJob
+	var r0 NeosyncApiJobWatermark
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, DBTX, SetJobSyncOptionsParams) (NeosyncApiJob, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX, SetJobWatermarkParams) (NeosyncApiJobWatermark, error)); ok {
 		return rf(ctx, db, arg)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, DBTX, SetJobSyncOptionsParams) NeosyncApiJob); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX, SetJobWatermarkParams) NeosyncApiJobWatermark); ok {
 		r0 = rf(ctx, db, arg)
 	} else {
-		r0 = ret.Get(0).(NeosyncApiJob)
+		r0 = ret.Get(0).(NeosyncApiJobWatermark)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, DBTX, SetJobSyncOptionsParams) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, DBTX, SetJobWatermarkParams) error); ok {
 		r1 = rf(ctx, db, arg)
 	} else {
 		r1 = ret.Error(1)
@@ -3690,32 +5408,32 @@ func (_m *MockQuerier) SetJobSyncOptions(ctx context.Context, db DBTX, arg SetJo
 	return r0, r1
 }
 
-// MockQuerier_SetJobSyncOptions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetJobSyncOptions'
-type MockQuerier_SetJobSyncOptions_Call struct {
+// MockQuerier_SetJobWatermark_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetJobWatermark'
+type MockQuerier_SetJobWatermark_Call struct {
 	*mock.Call
 }
 
-// SetJobSyncOptions is a helper method to define mock.On call
+// SetJobWatermark is a helper method to define mock.On call
 //   - ctx context.Context
 //   - db DBTX
-//   - arg SetJobSyncOptionsParams
-func (_e *MockQuerier_Expecter) SetJobSyncOptions(ctx interface{}, db interface{}, arg interface{}) *MockQuerier_SetJobSyncOptions_Call {
-	return &MockQuerier_SetJobSyncOptions_Call{Call: _e.mock.On("SetJobSyncOptions", ctx, db, arg)}
+//   - arg SetJobWatermarkParams
+func (_e *MockQuerier_Expecter) SetJobWatermark(ctx interface{}, db interface{}, arg interface{}) *MockQuerier_SetJobWatermark_Call {
+	return &MockQuerier_SetJobWatermark_Call{Call: _e.mock.On("SetJobWatermark", ctx, db, arg)}
 }
 
-func (_c *MockQuerier_SetJobSyncOptions_Call) Run(run func(ctx context.Context, db DBTX, arg SetJobSyncOptionsParams)) *MockQuerier_SetJobSyncOptions_Call {
+func (_c *MockQuerier_SetJobWatermark_Call) Run(run func(ctx context.Context, db DBTX, arg SetJobWatermarkParams)) *MockQuerier_SetJobWatermark_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(DBTX), args[2].(SetJobSyncOptionsParams))
+		run(args[0].(context.Context), args[1].(DBTX), args[2].(SetJobWatermarkParams))
 	})
 	return _c
 }
 
-func (_c *MockQuerier_SetJobSyncOptions_Call) Return(_a0 NeosyncApiJob, _a1 error) *MockQuerier_SetJobSyncOptions_Call {
+func (_c *MockQuerier_SetJobWatermark_Call) Return(_a0 NeosyncApiJobWatermark, _a1 error) *MockQuerier_SetJobWatermark_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockQuerier_SetJobSyncOptions_Call) RunAndReturn(run func(context.Context, DBTX, SetJobSyncOptionsParams) (NeosyncApiJob, error)) *MockQuerier_SetJobSyncOptions_Call {
+func (_c *MockQuerier_SetJobWatermark_Call) RunAndReturn(run func(context.Context, DBTX, SetJobWatermarkParams) (NeosyncApiJobWatermark, error)) *MockQuerier_SetJobWatermark_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -3778,6 +5496,54 @@ func (_c *MockQuerier_SetJobWorkflowOptions_Call) RunAndReturn(run func(context.
 	return _c
 }
 
+// UpdateAccountApiKeyLastUsedAt provides a mock function with given fields: ctx, db, id
+func (_m *MockQuerier) UpdateAccountApiKeyLastUsedAt(ctx context.Context, db DBTX, id pgtype.UUID) error {
+	ret := _m.Called(ctx, db, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateAccountApiKeyLastUsedAt")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX, pgtype.UUID) error); ok {
+		r0 = rf(ctx, db, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockQuerier_UpdateAccountApiKeyLastUsedAt_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateAccountApiKeyLastUsedAt'
+type MockQuerier_UpdateAccountApiKeyLastUsedAt_Call struct {
+	*mock.Call
+}
+
+// UpdateAccountApiKeyLastUsedAt is a helper method to define mock.On call
+//   - ctx context.Context
+//   - db DBTX
+//   - id pgtype.UUID
+func (_e *MockQuerier_Expecter) UpdateAccountApiKeyLastUsedAt(ctx interface{}, db interface{}, id interface{}) *MockQuerier_UpdateAccountApiKeyLastUsedAt_Call {
+	return &MockQuerier_UpdateAccountApiKeyLastUsedAt_Call{Call: _e.mock.On("UpdateAccountApiKeyLastUsedAt", ctx, db, id)}
+}
+
+func (_c *MockQuerier_UpdateAccountApiKeyLastUsedAt_Call) Run(run func(ctx context.Context, db DBTX, id pgtype.UUID)) *MockQuerier_UpdateAccountApiKeyLastUsedAt_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(DBTX), args[2].(pgtype.UUID))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_UpdateAccountApiKeyLastUsedAt_Call) Return(_a0 error) *MockQuerier_UpdateAccountApiKeyLastUsedAt_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockQuerier_UpdateAccountApiKeyLastUsedAt_Call) RunAndReturn(run func(context.Context, DBTX, pgtype.UUID) error) *MockQuerier_UpdateAccountApiKeyLastUsedAt_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // UpdateAccountApiKeyValue provides a mock function with given fields: ctx, db, arg
 func (_m *MockQuerier) UpdateAccountApiKeyValue(ctx context.Context, db DBTX, arg UpdateAccountApiKeyValueParams) (NeosyncApiAccountApiKey, error) {
 	ret := _m.Called(ctx, db, arg)
@@ -3952,6 +5718,122 @@ func (_c *MockQuerier_UpdateAccountOnboardingConfig_Call) RunAndReturn(run func(
 	return _c
 }
 
+// UpdateAccountTransformerKey provides a mock function with given fields: ctx, db, arg
+func (_m *MockQuerier) UpdateAccountTransformerKey(ctx context.Context, db DBTX, arg UpdateAccountTransformerKeyParams) (pgtype.Text, error) {
+	ret := _m.Called(ctx, db, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateAccountTransformerKey")
+	}
+
+	var r0 pgtype.Text
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX, UpdateAccountTransformerKeyParams) (pgtype.Text, error)); ok {
+		return rf(ctx, db, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX, UpdateAccountTransformerKeyParams) pgtype.Text); ok {
+		r0 = rf(ctx, db, arg)
+	} else {
+		r0 = ret.Get(0).(pgtype.Text)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, DBTX, UpdateAccountTransformerKeyParams) error); ok {
+		r1 = rf(ctx, db, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQuerier_UpdateAccountTransformerKey_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateAccountTransformerKey'
+type MockQuerier_UpdateAccountTransformerKey_Call struct {
+	*mock.Call
+}
+
+// UpdateAccountTransformerKey is a helper method to define mock.On call
+//   - ctx context.Context
+//   - db DBTX
+//   - arg UpdateAccountTransformerKeyParams
+func (_e *MockQuerier_Expecter) UpdateAccountTransformerKey(ctx interface{}, db interface{}, arg interface{}) *MockQuerier_UpdateAccountTransformerKey_Call {
+	return &MockQuerier_UpdateAccountTransformerKey_Call{Call: _e.mock.On("UpdateAccountTransformerKey", ctx, db, arg)}
+}
+
+func (_c *MockQuerier_UpdateAccountTransformerKey_Call) Run(run func(ctx context.Context, db DBTX, arg UpdateAccountTransformerKeyParams)) *MockQuerier_UpdateAccountTransformerKey_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(DBTX), args[2].(UpdateAccountTransformerKeyParams))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_UpdateAccountTransformerKey_Call) Return(_a0 pgtype.Text, _a1 error) *MockQuerier_UpdateAccountTransformerKey_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQuerier_UpdateAccountTransformerKey_Call) RunAndReturn(run func(context.Context, DBTX, UpdateAccountTransformerKeyParams) (pgtype.Text, error)) *MockQuerier_UpdateAccountTransformerKey_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateAccountUserRole provides a mock function with given fields: ctx, db, arg
+func (_m *MockQuerier) UpdateAccountUserRole(ctx context.Context, db DBTX, arg UpdateAccountUserRoleParams) (NeosyncApiAccountUserAssociation, error) {
+	ret := _m.Called(ctx, db, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateAccountUserRole")
+	}
+
+	var r0 NeosyncApiAccountUserAssociation
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX, UpdateAccountUserRoleParams) (NeosyncApiAccountUserAssociation, error)); ok {
+		return rf(ctx, db, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX, UpdateAccountUserRoleParams) NeosyncApiAccountUserAssociation); ok {
+		r0 = rf(ctx, db, arg)
+	} else {
+		r0 = ret.Get(0).(NeosyncApiAccountUserAssociation)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, DBTX, UpdateAccountUserRoleParams) error); ok {
+		r1 = rf(ctx, db, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQuerier_UpdateAccountUserRole_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateAccountUserRole'
+type MockQuerier_UpdateAccountUserRole_Call struct {
+	*mock.Call
+}
+
+// UpdateAccountUserRole is a helper method to define mock.On call
+//   - ctx context.Context
+//   - db DBTX
+//   - arg UpdateAccountUserRoleParams
+func (_e *MockQuerier_Expecter) UpdateAccountUserRole(ctx interface{}, db interface{}, arg interface{}) *MockQuerier_UpdateAccountUserRole_Call {
+	return &MockQuerier_UpdateAccountUserRole_Call{Call: _e.mock.On("UpdateAccountUserRole", ctx, db, arg)}
+}
+
+func (_c *MockQuerier_UpdateAccountUserRole_Call) Run(run func(ctx context.Context, db DBTX, arg UpdateAccountUserRoleParams)) *MockQuerier_UpdateAccountUserRole_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(DBTX), args[2].(UpdateAccountUserRoleParams))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_UpdateAccountUserRole_Call) Return(_a0 NeosyncApiAccountUserAssociation, _a1 error) *MockQuerier_UpdateAccountUserRole_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQuerier_UpdateAccountUserRole_Call) RunAndReturn(run func(context.Context, DBTX, UpdateAccountUserRoleParams) (NeosyncApiAccountUserAssociation, error)) *MockQuerier_UpdateAccountUserRole_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // UpdateActiveAccountInvitesToExpired provides a mock function with given fields: ctx, db, arg
 func (_m *MockQuerier) UpdateActiveAccountInvitesToExpired(ctx context.Context, db DBTX, arg UpdateActiveAccountInvitesToExpiredParams) (NeosyncApiAccountInvite, error) {
 	ret := _m.Called(ctx, db, arg)
@@ -4068,6 +5950,54 @@ func (_c *MockQuerier_UpdateConnection_Call) RunAndReturn(run func(context.Conte
 	return _c
 }
 
+// UpdateConnectionConfig provides a mock function with given fields: ctx, db, arg
+func (_m *MockQuerier) UpdateConnectionConfig(ctx context.Context, db DBTX, arg UpdateConnectionConfigParams) error {
+	ret := _m.Called(ctx, db, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateConnectionConfig")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX, UpdateConnectionConfigParams) error); ok {
+		r0 = rf(ctx, db, arg)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockQuerier_UpdateConnectionConfig_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateConnectionConfig'
+type MockQuerier_UpdateConnectionConfig_Call struct {
+	*mock.Call
+}
+
+// UpdateConnectionConfig is a helper method to define mock.On call
+//   - ctx context.Context
+//   - db DBTX
+//   - arg UpdateConnectionConfigParams
+func (_e *MockQuerier_Expecter) UpdateConnectionConfig(ctx interface{}, db interface{}, arg interface{}) *MockQuerier_UpdateConnectionConfig_Call {
+	return &MockQuerier_UpdateConnectionConfig_Call{Call: _e.mock.On("UpdateConnectionConfig", ctx, db, arg)}
+}
+
+func (_c *MockQuerier_UpdateConnectionConfig_Call) Run(run func(ctx context.Context, db DBTX, arg UpdateConnectionConfigParams)) *MockQuerier_UpdateConnectionConfig_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(DBTX), args[2].(UpdateConnectionConfigParams))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_UpdateConnectionConfig_Call) Return(_a0 error) *MockQuerier_UpdateConnectionConfig_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockQuerier_UpdateConnectionConfig_Call) RunAndReturn(run func(context.Context, DBTX, UpdateConnectionConfigParams) error) *MockQuerier_UpdateConnectionConfig_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // UpdateJobConnectionDestination provides a mock function with given fields: ctx, db, arg
 func (_m *MockQuerier) UpdateJobConnectionDestination(ctx context.Context, db DBTX, arg UpdateJobConnectionDestinationParams) (NeosyncApiJobDestinationConnectionAssociation, error) {
 	ret := _m.Called(ctx, db, arg)
@@ -4416,6 +6346,64 @@ func (_c *MockQuerier_UpdateUserDefinedTransformer_Call) RunAndReturn(run func(c
 	return _c
 }
 
+// UpdateWebhook provides a mock function with given fields: ctx, db, arg
+func (_m *MockQuerier) UpdateWebhook(ctx context.Context, db DBTX, arg UpdateWebhookParams) (NeosyncApiWebhook, error) {
+	ret := _m.Called(ctx, db, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateWebhook")
+	}
+
+	var r0 NeosyncApiWebhook
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX, UpdateWebhookParams) (NeosyncApiWebhook, error)); ok {
+		return rf(ctx, db, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX, UpdateWebhookParams) NeosyncApiWebhook); ok {
+		r0 = rf(ctx, db, arg)
+	} else {
+		r0 = ret.Get(0).(NeosyncApiWebhook)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, DBTX, UpdateWebhookParams) error); ok {
+		r1 = rf(ctx, db, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQuerier_UpdateWebhook_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateWebhook'
+type MockQuerier_UpdateWebhook_Call struct {
+	*mock.Call
+}
+
+// UpdateWebhook is a helper method to define mock.On call
+//   - ctx context.Context
+//   - db DBTX
+//   - arg UpdateWebhookParams
+func (_e *MockQuerier_Expecter) UpdateWebhook(ctx interface{}, db interface{}, arg interface{}) *MockQuerier_UpdateWebhook_Call {
+	return &MockQuerier_UpdateWebhook_Call{Call: _e.mock.On("UpdateWebhook", ctx, db, arg)}
+}
+
+func (_c *MockQuerier_UpdateWebhook_Call) Run(run func(ctx context.Context, db DBTX, arg UpdateWebhookParams)) *MockQuerier_UpdateWebhook_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(DBTX), args[2].(UpdateWebhookParams))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_UpdateWebhook_Call) Return(_a0 NeosyncApiWebhook, _a1 error) *MockQuerier_UpdateWebhook_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQuerier_UpdateWebhook_Call) RunAndReturn(run func(context.Context, DBTX, UpdateWebhookParams) (NeosyncApiWebhook, error)) *MockQuerier_UpdateWebhook_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewMockQuerier creates a new instance of MockQuerier. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewMockQuerier(t interface {
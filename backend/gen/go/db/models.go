@@ -17,6 +17,7 @@ type NeosyncApiAccount struct {
 	AccountSlug      string
 	TemporalConfig   *pg_models.TemporalConfig
 	OnboardingConfig *pg_models.AccountOnboardingConfig
+	TransformerKey   pgtype.Text
 }
 
 type NeosyncApiAccountApiKey struct {
@@ -30,6 +31,8 @@ type NeosyncApiAccountApiKey struct {
 	ExpiresAt   pgtype.Timestamp
 	KeyName     string
 	UserID      pgtype.UUID
+	Scopes      *pg_models.AccountApiKeyScopes
+	LastUsedAt  pgtype.Timestamp
 }
 
 type NeosyncApiAccountInvite struct {
@@ -42,6 +45,7 @@ type NeosyncApiAccountInvite struct {
 	CreatedAt    pgtype.Timestamp
 	UpdatedAt    pgtype.Timestamp
 	ExpiresAt    pgtype.Timestamp
+	Role         int16
 }
 
 type NeosyncApiAccountUserAssociation struct {
@@ -50,6 +54,19 @@ type NeosyncApiAccountUserAssociation struct {
 	UserID    pgtype.UUID
 	CreatedAt pgtype.Timestamp
 	UpdatedAt pgtype.Timestamp
+	Role      int16
+}
+
+type NeosyncApiAuditEvent struct {
+	ID          pgtype.UUID
+	CreatedAt   pgtype.Timestamp
+	AccountID   pgtype.UUID
+	ActorType   int16
+	ActorID     pgtype.UUID
+	Procedure   string
+	ResourceID  pgtype.Text
+	RequestDiff []byte
+	StatusCode  string
 }
 
 type NeosyncApiConnection struct {
@@ -77,6 +94,7 @@ type NeosyncApiJob struct {
 	UpdatedByID       pgtype.UUID
 	WorkflowOptions   *pg_models.WorkflowOptions
 	SyncOptions       *pg_models.ActivityOptions
+	Triggers          []*pg_models.JobTrigger
 }
 
 type NeosyncApiJobDestinationConnectionAssociation struct {
@@ -88,6 +106,60 @@ type NeosyncApiJobDestinationConnectionAssociation struct {
 	Options      *pg_models.JobDestinationOptions
 }
 
+type NeosyncApiJobRunSchemaDriftReport struct {
+	ID        pgtype.UUID
+	CreatedAt pgtype.Timestamp
+	JobID     pgtype.UUID
+	JobRunID  string
+	Halted    bool
+	Report    *pg_models.JobRunSchemaDriftReport
+}
+
+type NeosyncApiJobRunValidationReport struct {
+	ID        pgtype.UUID
+	CreatedAt pgtype.Timestamp
+	JobID     pgtype.UUID
+	JobRunID  string
+	Passed    bool
+	Report    *pg_models.JobRunValidationReport
+}
+
+type NeosyncApiJobRunWatermark struct {
+	ID         pgtype.UUID
+	CreatedAt  pgtype.Timestamp
+	JobID      pgtype.UUID
+	JobRunID   string
+	SchemaName string
+	TableName  string
+	ColumnName string
+	StartValue pgtype.Text
+	EndValue   string
+}
+
+type NeosyncApiJobVersion struct {
+	ID                pgtype.UUID
+	CreatedAt         pgtype.Timestamp
+	JobID             pgtype.UUID
+	Version           int32
+	CronSchedule      pgtype.Text
+	ConnectionOptions *pg_models.JobSourceOptions
+	Mappings          []*pg_models.JobMapping
+	Destinations      []*pg_models.JobVersionDestination
+	CreatedByID       pgtype.UUID
+}
+
+type NeosyncApiJobWatermark struct {
+	ID             pgtype.UUID
+	CreatedAt      pgtype.Timestamp
+	UpdatedAt      pgtype.Timestamp
+	JobID          pgtype.UUID
+	SchemaName     string
+	TableName      string
+	ColumnName     string
+	WatermarkValue string
+	JobRunID       string
+}
+
 type NeosyncApiTransformer struct {
 	ID                pgtype.UUID
 	CreatedAt         pgtype.Timestamp
@@ -115,3 +187,30 @@ type NeosyncApiUserIdentityProviderAssociation struct {
 	CreatedAt   pgtype.Timestamp
 	UpdatedAt   pgtype.Timestamp
 }
+
+type NeosyncApiWebhook struct {
+	ID          pgtype.UUID
+	CreatedAt   pgtype.Timestamp
+	UpdatedAt   pgtype.Timestamp
+	Name        string
+	AccountID   pgtype.UUID
+	Url         string
+	Secret      string
+	EventTypes  []int32
+	Enabled     bool
+	CreatedByID pgtype.UUID
+	UpdatedByID pgtype.UUID
+}
+
+type NeosyncApiWebhookDelivery struct {
+	ID         pgtype.UUID
+	CreatedAt  pgtype.Timestamp
+	WebhookID  pgtype.UUID
+	EventID    pgtype.UUID
+	EventType  int32
+	Payload    []byte
+	Attempt    int32
+	StatusCode pgtype.Int4
+	Error      pgtype.Text
+	Success    bool
+}
@@ -16,16 +16,21 @@ type Querier interface {
 	CreateAccountApiKey(ctx context.Context, db DBTX, arg CreateAccountApiKeyParams) (NeosyncApiAccountApiKey, error)
 	CreateAccountInvite(ctx context.Context, db DBTX, arg CreateAccountInviteParams) (NeosyncApiAccountInvite, error)
 	CreateAccountUserAssociation(ctx context.Context, db DBTX, arg CreateAccountUserAssociationParams) (NeosyncApiAccountUserAssociation, error)
+	CreateAuditEvent(ctx context.Context, db DBTX, arg CreateAuditEventParams) (NeosyncApiAuditEvent, error)
 	CreateConnection(ctx context.Context, db DBTX, arg CreateConnectionParams) (NeosyncApiConnection, error)
 	CreateIdentityProviderAssociation(ctx context.Context, db DBTX, arg CreateIdentityProviderAssociationParams) (NeosyncApiUserIdentityProviderAssociation, error)
 	CreateJob(ctx context.Context, db DBTX, arg CreateJobParams) (NeosyncApiJob, error)
 	CreateJobConnectionDestination(ctx context.Context, db DBTX, arg CreateJobConnectionDestinationParams) (NeosyncApiJobDestinationConnectionAssociation, error)
 	CreateJobConnectionDestinations(ctx context.Context, db DBTX, arg []CreateJobConnectionDestinationsParams) (int64, error)
+	CreateJobRunWatermark(ctx context.Context, db DBTX, arg CreateJobRunWatermarkParams) error
+	CreateJobVersion(ctx context.Context, db DBTX, arg CreateJobVersionParams) (NeosyncApiJobVersion, error)
 	CreateMachineUser(ctx context.Context, db DBTX) (NeosyncApiUser, error)
 	CreateNonMachineUser(ctx context.Context, db DBTX) (NeosyncApiUser, error)
 	CreatePersonalAccount(ctx context.Context, db DBTX, accountSlug string) (NeosyncApiAccount, error)
 	CreateTeamAccount(ctx context.Context, db DBTX, accountSlug string) (NeosyncApiAccount, error)
 	CreateUserDefinedTransformer(ctx context.Context, db DBTX, arg CreateUserDefinedTransformerParams) (NeosyncApiTransformer, error)
+	CreateWebhook(ctx context.Context, db DBTX, arg CreateWebhookParams) (NeosyncApiWebhook, error)
+	CreateWebhookDelivery(ctx context.Context, db DBTX, arg CreateWebhookDeliveryParams) (NeosyncApiWebhookDelivery, error)
 	DeleteJob(ctx context.Context, db DBTX, id pgtype.UUID) error
 	DeleteUserDefinedTransformerById(ctx context.Context, db DBTX, id pgtype.UUID) error
 	GetAccount(ctx context.Context, db DBTX, id pgtype.UUID) (NeosyncApiAccount, error)
@@ -35,20 +40,34 @@ type Querier interface {
 	GetAccountInvite(ctx context.Context, db DBTX, id pgtype.UUID) (NeosyncApiAccountInvite, error)
 	GetAccountInviteByToken(ctx context.Context, db DBTX, token string) (NeosyncApiAccountInvite, error)
 	GetAccountOnboardingConfig(ctx context.Context, db DBTX, id pgtype.UUID) (*pg_models.AccountOnboardingConfig, error)
+	GetAccountTransformerKey(ctx context.Context, db DBTX, id pgtype.UUID) (pgtype.Text, error)
 	GetAccountUserAssociation(ctx context.Context, db DBTX, arg GetAccountUserAssociationParams) (NeosyncApiAccountUserAssociation, error)
+	GetAccountUserCountByRole(ctx context.Context, db DBTX, arg GetAccountUserCountByRoleParams) (int64, error)
 	GetAccountsByUser(ctx context.Context, db DBTX, id pgtype.UUID) ([]NeosyncApiAccount, error)
 	GetActiveAccountInvites(ctx context.Context, db DBTX, accountid pgtype.UUID) ([]NeosyncApiAccountInvite, error)
 	GetAnonymousUser(ctx context.Context, db DBTX) (NeosyncApiUser, error)
+	GetAuditEvents(ctx context.Context, db DBTX, arg GetAuditEventsParams) ([]NeosyncApiAuditEvent, error)
 	GetConnectionById(ctx context.Context, db DBTX, id pgtype.UUID) (NeosyncApiConnection, error)
 	GetConnectionByNameAndAccount(ctx context.Context, db DBTX, arg GetConnectionByNameAndAccountParams) (NeosyncApiConnection, error)
+	GetConnectionsBatchForUpdate(ctx context.Context, db DBTX, arg GetConnectionsBatchForUpdateParams) ([]NeosyncApiConnection, error)
 	GetConnectionsByAccount(ctx context.Context, db DBTX, accountid pgtype.UUID) ([]NeosyncApiConnection, error)
 	GetConnectionsByIds(ctx context.Context, db DBTX, dollar_1 []pgtype.UUID) ([]NeosyncApiConnection, error)
+	GetEnabledWebhooksByEventType(ctx context.Context, db DBTX, arg GetEnabledWebhooksByEventTypeParams) ([]NeosyncApiWebhook, error)
 	GetJobById(ctx context.Context, db DBTX, id pgtype.UUID) (NeosyncApiJob, error)
 	GetJobByNameAndAccount(ctx context.Context, db DBTX, arg GetJobByNameAndAccountParams) (NeosyncApiJob, error)
 	GetJobConnectionDestination(ctx context.Context, db DBTX, id pgtype.UUID) (NeosyncApiJobDestinationConnectionAssociation, error)
 	GetJobConnectionDestinations(ctx context.Context, db DBTX, id pgtype.UUID) ([]NeosyncApiJobDestinationConnectionAssociation, error)
 	GetJobConnectionDestinationsByJobIds(ctx context.Context, db DBTX, jobids []pgtype.UUID) ([]NeosyncApiJobDestinationConnectionAssociation, error)
+	GetJobRunSchemaDriftReport(ctx context.Context, db DBTX, arg GetJobRunSchemaDriftReportParams) (NeosyncApiJobRunSchemaDriftReport, error)
+	GetJobRunValidationReport(ctx context.Context, db DBTX, arg GetJobRunValidationReportParams) (NeosyncApiJobRunValidationReport, error)
+	GetJobRunWatermarks(ctx context.Context, db DBTX, arg GetJobRunWatermarksParams) ([]NeosyncApiJobRunWatermark, error)
+	GetJobVersionById(ctx context.Context, db DBTX, id pgtype.UUID) (NeosyncApiJobVersion, error)
+	GetJobVersions(ctx context.Context, db DBTX, jobID pgtype.UUID) ([]NeosyncApiJobVersion, error)
+	GetJobWatermarks(ctx context.Context, db DBTX, jobID pgtype.UUID) ([]NeosyncApiJobWatermark, error)
 	GetJobsByAccount(ctx context.Context, db DBTX, accountid pgtype.UUID) ([]NeosyncApiJob, error)
+	GetJobsTriggeredByJob(ctx context.Context, db DBTX, arg GetJobsTriggeredByJobParams) ([]NeosyncApiJob, error)
+	GetLatestJobRunSchemaDriftReport(ctx context.Context, db DBTX, jobID pgtype.UUID) (NeosyncApiJobRunSchemaDriftReport, error)
+	GetLatestJobVersion(ctx context.Context, db DBTX, jobID pgtype.UUID) (NeosyncApiJobVersion, error)
 	GetPersonalAccountByUserId(ctx context.Context, db DBTX, userid pgtype.UUID) (NeosyncApiAccount, error)
 	GetTeamAccountsByUserId(ctx context.Context, db DBTX, userid pgtype.UUID) ([]NeosyncApiAccount, error)
 	GetTemporalConfigByAccount(ctx context.Context, db DBTX, id pgtype.UUID) (*pg_models.TemporalConfig, error)
@@ -58,9 +77,13 @@ type Querier interface {
 	GetUserByProviderSub(ctx context.Context, db DBTX, providerSub string) (NeosyncApiUser, error)
 	GetUserDefinedTransformerById(ctx context.Context, db DBTX, id pgtype.UUID) (NeosyncApiTransformer, error)
 	GetUserDefinedTransformersByAccount(ctx context.Context, db DBTX, accountid pgtype.UUID) ([]NeosyncApiTransformer, error)
-	GetUserIdentitiesByTeamAccount(ctx context.Context, db DBTX, accountid pgtype.UUID) ([]NeosyncApiUserIdentityProviderAssociation, error)
+	GetUserIdentitiesByTeamAccount(ctx context.Context, db DBTX, accountid pgtype.UUID) ([]GetUserIdentitiesByTeamAccountRow, error)
 	GetUserIdentityAssociationsByUserIds(ctx context.Context, db DBTX, dollar_1 []pgtype.UUID) ([]NeosyncApiUserIdentityProviderAssociation, error)
 	GetUserIdentityByUserId(ctx context.Context, db DBTX, userID pgtype.UUID) (NeosyncApiUserIdentityProviderAssociation, error)
+	GetWebhookById(ctx context.Context, db DBTX, id pgtype.UUID) (NeosyncApiWebhook, error)
+	GetWebhookDeliveries(ctx context.Context, db DBTX, arg GetWebhookDeliveriesParams) ([]NeosyncApiWebhookDelivery, error)
+	GetWebhooksByAccount(ctx context.Context, db DBTX, accountID pgtype.UUID) ([]NeosyncApiWebhook, error)
+	InitAccountTransformerKey(ctx context.Context, db DBTX, arg InitAccountTransformerKeyParams) (pgtype.Text, error)
 	IsConnectionInAccount(ctx context.Context, db DBTX, arg IsConnectionInAccountParams) (int64, error)
 	IsConnectionNameAvailable(ctx context.Context, db DBTX, arg IsConnectionNameAvailableParams) (int64, error)
 	IsJobNameAvailable(ctx context.Context, db DBTX, arg IsJobNameAvailableParams) (int64, error)
@@ -75,20 +98,32 @@ type Querier interface {
 	RemoveJobById(ctx context.Context, db DBTX, id pgtype.UUID) error
 	RemoveJobConnectionDestination(ctx context.Context, db DBTX, id pgtype.UUID) error
 	RemoveJobConnectionDestinations(ctx context.Context, db DBTX, jobids []pgtype.UUID) error
+	RemoveJobWatermark(ctx context.Context, db DBTX, arg RemoveJobWatermarkParams) error
+	RemoveJobWatermarks(ctx context.Context, db DBTX, jobID pgtype.UUID) error
+	RemoveWebhookById(ctx context.Context, db DBTX, id pgtype.UUID) error
 	SetAnonymousUser(ctx context.Context, db DBTX) (NeosyncApiUser, error)
+	SetJobRunSchemaDriftReport(ctx context.Context, db DBTX, arg SetJobRunSchemaDriftReportParams) (NeosyncApiJobRunSchemaDriftReport, error)
+	SetJobRunValidationReport(ctx context.Context, db DBTX, arg SetJobRunValidationReportParams) (NeosyncApiJobRunValidationReport, error)
 	SetJobSyncOptions(ctx context.Context, db DBTX, arg SetJobSyncOptionsParams) (NeosyncApiJob, error)
+	SetJobTriggers(ctx context.Context, db DBTX, arg SetJobTriggersParams) (NeosyncApiJob, error)
+	SetJobWatermark(ctx context.Context, db DBTX, arg SetJobWatermarkParams) (NeosyncApiJobWatermark, error)
 	SetJobWorkflowOptions(ctx context.Context, db DBTX, arg SetJobWorkflowOptionsParams) (NeosyncApiJob, error)
+	UpdateAccountApiKeyLastUsedAt(ctx context.Context, db DBTX, id pgtype.UUID) error
 	UpdateAccountApiKeyValue(ctx context.Context, db DBTX, arg UpdateAccountApiKeyValueParams) (NeosyncApiAccountApiKey, error)
 	UpdateAccountInviteToAccepted(ctx context.Context, db DBTX, id pgtype.UUID) (NeosyncApiAccountInvite, error)
 	UpdateAccountOnboardingConfig(ctx context.Context, db DBTX, arg UpdateAccountOnboardingConfigParams) (NeosyncApiAccount, error)
+	UpdateAccountTransformerKey(ctx context.Context, db DBTX, arg UpdateAccountTransformerKeyParams) (pgtype.Text, error)
+	UpdateAccountUserRole(ctx context.Context, db DBTX, arg UpdateAccountUserRoleParams) (NeosyncApiAccountUserAssociation, error)
 	UpdateActiveAccountInvitesToExpired(ctx context.Context, db DBTX, arg UpdateActiveAccountInvitesToExpiredParams) (NeosyncApiAccountInvite, error)
 	UpdateConnection(ctx context.Context, db DBTX, arg UpdateConnectionParams) (NeosyncApiConnection, error)
+	UpdateConnectionConfig(ctx context.Context, db DBTX, arg UpdateConnectionConfigParams) error
 	UpdateJobConnectionDestination(ctx context.Context, db DBTX, arg UpdateJobConnectionDestinationParams) (NeosyncApiJobDestinationConnectionAssociation, error)
 	UpdateJobMappings(ctx context.Context, db DBTX, arg UpdateJobMappingsParams) (NeosyncApiJob, error)
 	UpdateJobSchedule(ctx context.Context, db DBTX, arg UpdateJobScheduleParams) (NeosyncApiJob, error)
 	UpdateJobSource(ctx context.Context, db DBTX, arg UpdateJobSourceParams) (NeosyncApiJob, error)
 	UpdateTemporalConfigByAccount(ctx context.Context, db DBTX, arg UpdateTemporalConfigByAccountParams) (NeosyncApiAccount, error)
 	UpdateUserDefinedTransformer(ctx context.Context, db DBTX, arg UpdateUserDefinedTransformerParams) (NeosyncApiTransformer, error)
+	UpdateWebhook(ctx context.Context, db DBTX, arg UpdateWebhookParams) (NeosyncApiWebhook, error)
 }
 
 var _ Querier = (*Queries)(nil)
@@ -14,11 +14,11 @@ import (
 
 const createAccountInvite = `-- name: CreateAccountInvite :one
 INSERT INTO neosync_api.account_invites (
-  account_id, sender_user_id, email, expires_at
+  account_id, sender_user_id, email, expires_at, role
 ) VALUES (
-  $1, $2, $3, $4
+  $1, $2, $3, $4, $5
 )
-RETURNING id, account_id, sender_user_id, email, token, accepted, created_at, updated_at, expires_at
+RETURNING id, account_id, sender_user_id, email, token, accepted, created_at, updated_at, expires_at, role
 `
 
 type CreateAccountInviteParams struct {
@@ -26,6 +26,7 @@ type CreateAccountInviteParams struct {
 	SenderUserID pgtype.UUID
 	Email        string
 	ExpiresAt    pgtype.Timestamp
+	Role         int16
 }
 
 func (q *Queries) CreateAccountInvite(ctx context.Context, db DBTX, arg CreateAccountInviteParams) (NeosyncApiAccountInvite, error) {
@@ -34,6 +35,7 @@ func (q *Queries) CreateAccountInvite(ctx context.Context, db DBTX, arg CreateAc
 		arg.SenderUserID,
 		arg.Email,
 		arg.ExpiresAt,
+		arg.Role,
 	)
 	var i NeosyncApiAccountInvite
 	err := row.Scan(
@@ -46,26 +48,28 @@ func (q *Queries) CreateAccountInvite(ctx context.Context, db DBTX, arg CreateAc
 		&i.CreatedAt,
 		&i.UpdatedAt,
 		&i.ExpiresAt,
+		&i.Role,
 	)
 	return i, err
 }
 
 const createAccountUserAssociation = `-- name: CreateAccountUserAssociation :one
 INSERT INTO neosync_api.account_user_associations (
-  account_id, user_id
+  account_id, user_id, role
 ) VALUES (
-  $1, $2
+  $1, $2, $3
 )
-RETURNING id, account_id, user_id, created_at, updated_at
+RETURNING id, account_id, user_id, created_at, updated_at, role
 `
 
 type CreateAccountUserAssociationParams struct {
 	AccountID pgtype.UUID
 	UserID    pgtype.UUID
+	Role      int16
 }
 
 func (q *Queries) CreateAccountUserAssociation(ctx context.Context, db DBTX, arg CreateAccountUserAssociationParams) (NeosyncApiAccountUserAssociation, error) {
-	row := db.QueryRow(ctx, createAccountUserAssociation, arg.AccountID, arg.UserID)
+	row := db.QueryRow(ctx, createAccountUserAssociation, arg.AccountID, arg.UserID, arg.Role)
 	var i NeosyncApiAccountUserAssociation
 	err := row.Scan(
 		&i.ID,
@@ -73,6 +77,7 @@ func (q *Queries) CreateAccountUserAssociation(ctx context.Context, db DBTX, arg
 		&i.UserID,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.Role,
 	)
 	return i, err
 }
@@ -152,7 +157,7 @@ INSERT INTO neosync_api.accounts (
 ) VALUES (
   0, $1
 )
-RETURNING id, created_at, updated_at, account_type, account_slug, temporal_config, onboarding_config
+RETURNING id, created_at, updated_at, account_type, account_slug, temporal_config, onboarding_config, transformer_key
 `
 
 func (q *Queries) CreatePersonalAccount(ctx context.Context, db DBTX, accountSlug string) (NeosyncApiAccount, error) {
@@ -166,6 +171,7 @@ func (q *Queries) CreatePersonalAccount(ctx context.Context, db DBTX, accountSlu
 		&i.AccountSlug,
 		&i.TemporalConfig,
 		&i.OnboardingConfig,
+		&i.TransformerKey,
 	)
 	return i, err
 }
@@ -176,7 +182,7 @@ INSERT INTO neosync_api.accounts (
 ) VALUES (
   1, $1
 )
-RETURNING id, created_at, updated_at, account_type, account_slug, temporal_config, onboarding_config
+RETURNING id, created_at, updated_at, account_type, account_slug, temporal_config, onboarding_config, transformer_key
 `
 
 func (q *Queries) CreateTeamAccount(ctx context.Context, db DBTX, accountSlug string) (NeosyncApiAccount, error) {
@@ -190,12 +196,13 @@ func (q *Queries) CreateTeamAccount(ctx context.Context, db DBTX, accountSlug st
 		&i.AccountSlug,
 		&i.TemporalConfig,
 		&i.OnboardingConfig,
+		&i.TransformerKey,
 	)
 	return i, err
 }
 
 const getAccount = `-- name: GetAccount :one
-SELECT id, created_at, updated_at, account_type, account_slug, temporal_config, onboarding_config from neosync_api.accounts
+SELECT id, created_at, updated_at, account_type, account_slug, temporal_config, onboarding_config, transformer_key from neosync_api.accounts
 WHERE id = $1
 `
 
@@ -210,12 +217,13 @@ func (q *Queries) GetAccount(ctx context.Context, db DBTX, id pgtype.UUID) (Neos
 		&i.AccountSlug,
 		&i.TemporalConfig,
 		&i.OnboardingConfig,
+		&i.TransformerKey,
 	)
 	return i, err
 }
 
 const getAccountInvite = `-- name: GetAccountInvite :one
-SELECT id, account_id, sender_user_id, email, token, accepted, created_at, updated_at, expires_at FROM neosync_api.account_invites
+SELECT id, account_id, sender_user_id, email, token, accepted, created_at, updated_at, expires_at, role FROM neosync_api.account_invites
 WHERE id = $1
 `
 
@@ -232,12 +240,13 @@ func (q *Queries) GetAccountInvite(ctx context.Context, db DBTX, id pgtype.UUID)
 		&i.CreatedAt,
 		&i.UpdatedAt,
 		&i.ExpiresAt,
+		&i.Role,
 	)
 	return i, err
 }
 
 const getAccountInviteByToken = `-- name: GetAccountInviteByToken :one
-SELECT id, account_id, sender_user_id, email, token, accepted, created_at, updated_at, expires_at FROM neosync_api.account_invites
+SELECT id, account_id, sender_user_id, email, token, accepted, created_at, updated_at, expires_at, role FROM neosync_api.account_invites
 WHERE token = $1
 `
 
@@ -254,6 +263,7 @@ func (q *Queries) GetAccountInviteByToken(ctx context.Context, db DBTX, token st
 		&i.CreatedAt,
 		&i.UpdatedAt,
 		&i.ExpiresAt,
+		&i.Role,
 	)
 	return i, err
 }
@@ -271,8 +281,21 @@ func (q *Queries) GetAccountOnboardingConfig(ctx context.Context, db DBTX, id pg
 	return onboarding_config, err
 }
 
+const getAccountTransformerKey = `-- name: GetAccountTransformerKey :one
+SELECT transformer_key
+FROM neosync_api.accounts
+WHERE id = $1
+`
+
+func (q *Queries) GetAccountTransformerKey(ctx context.Context, db DBTX, id pgtype.UUID) (pgtype.Text, error) {
+	row := db.QueryRow(ctx, getAccountTransformerKey, id)
+	var transformer_key pgtype.Text
+	err := row.Scan(&transformer_key)
+	return transformer_key, err
+}
+
 const getAccountUserAssociation = `-- name: GetAccountUserAssociation :one
-SELECT aua.id, aua.account_id, aua.user_id, aua.created_at, aua.updated_at from neosync_api.account_user_associations aua
+SELECT aua.id, aua.account_id, aua.user_id, aua.created_at, aua.updated_at, aua.role from neosync_api.account_user_associations aua
 INNER JOIN neosync_api.accounts a ON a.id = aua.account_id
 INNER JOIN neosync_api.users u ON u.id = aua.user_id
 WHERE a.id = $1 AND u.id = $2
@@ -292,12 +315,30 @@ func (q *Queries) GetAccountUserAssociation(ctx context.Context, db DBTX, arg Ge
 		&i.UserID,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.Role,
 	)
 	return i, err
 }
 
+const getAccountUserCountByRole = `-- name: GetAccountUserCountByRole :one
+SELECT count(aua.id) from neosync_api.account_user_associations aua
+WHERE aua.account_id = $1 AND aua.role = $2
+`
+
+type GetAccountUserCountByRoleParams struct {
+	AccountId pgtype.UUID
+	Role      int16
+}
+
+func (q *Queries) GetAccountUserCountByRole(ctx context.Context, db DBTX, arg GetAccountUserCountByRoleParams) (int64, error) {
+	row := db.QueryRow(ctx, getAccountUserCountByRole, arg.AccountId, arg.Role)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
 const getAccountsByUser = `-- name: GetAccountsByUser :many
-SELECT a.id, a.created_at, a.updated_at, a.account_type, a.account_slug, a.temporal_config, a.onboarding_config
+SELECT a.id, a.created_at, a.updated_at, a.account_type, a.account_slug, a.temporal_config, a.onboarding_config, a.transformer_key
 FROM neosync_api.accounts a
 INNER JOIN neosync_api.account_api_keys aak ON aak.account_id = a.id
 INNER JOIN neosync_api.users u ON u.id = aak.user_id
@@ -305,7 +346,7 @@ WHERE u.id = $1
 
 UNION
 
-SELECT a.id, a.created_at, a.updated_at, a.account_type, a.account_slug, a.temporal_config, a.onboarding_config
+SELECT a.id, a.created_at, a.updated_at, a.account_type, a.account_slug, a.temporal_config, a.onboarding_config, a.transformer_key
 FROM neosync_api.accounts a
 INNER JOIN neosync_api.account_user_associations aua ON aua.account_id = a.id
 INNER JOIN neosync_api.users u ON u.id = aua.user_id
@@ -329,6 +370,7 @@ func (q *Queries) GetAccountsByUser(ctx context.Context, db DBTX, id pgtype.UUID
 			&i.AccountSlug,
 			&i.TemporalConfig,
 			&i.OnboardingConfig,
+			&i.TransformerKey,
 		); err != nil {
 			return nil, err
 		}
@@ -341,7 +383,7 @@ func (q *Queries) GetAccountsByUser(ctx context.Context, db DBTX, id pgtype.UUID
 }
 
 const getActiveAccountInvites = `-- name: GetActiveAccountInvites :many
-SELECT id, account_id, sender_user_id, email, token, accepted, created_at, updated_at, expires_at FROM neosync_api.account_invites
+SELECT id, account_id, sender_user_id, email, token, accepted, created_at, updated_at, expires_at, role FROM neosync_api.account_invites
 WHERE account_id = $1 AND expires_at > CURRENT_TIMESTAMP AND accepted = false
 `
 
@@ -364,6 +406,7 @@ func (q *Queries) GetActiveAccountInvites(ctx context.Context, db DBTX, accounti
 			&i.CreatedAt,
 			&i.UpdatedAt,
 			&i.ExpiresAt,
+			&i.Role,
 		); err != nil {
 			return nil, err
 		}
@@ -393,7 +436,7 @@ func (q *Queries) GetAnonymousUser(ctx context.Context, db DBTX) (NeosyncApiUser
 }
 
 const getPersonalAccountByUserId = `-- name: GetPersonalAccountByUserId :one
-SELECT a.id, a.created_at, a.updated_at, a.account_type, a.account_slug, a.temporal_config, a.onboarding_config from neosync_api.accounts a
+SELECT a.id, a.created_at, a.updated_at, a.account_type, a.account_slug, a.temporal_config, a.onboarding_config, a.transformer_key from neosync_api.accounts a
 INNER JOIN neosync_api.account_user_associations aua ON aua.account_id = a.id
 INNER JOIN neosync_api.users u ON u.id = aua.user_id
 WHERE u.id = $1 AND a.account_type = 0
@@ -410,12 +453,13 @@ func (q *Queries) GetPersonalAccountByUserId(ctx context.Context, db DBTX, useri
 		&i.AccountSlug,
 		&i.TemporalConfig,
 		&i.OnboardingConfig,
+		&i.TransformerKey,
 	)
 	return i, err
 }
 
 const getTeamAccountsByUserId = `-- name: GetTeamAccountsByUserId :many
-SELECT a.id, a.created_at, a.updated_at, a.account_type, a.account_slug, a.temporal_config, a.onboarding_config from neosync_api.accounts a
+SELECT a.id, a.created_at, a.updated_at, a.account_type, a.account_slug, a.temporal_config, a.onboarding_config, a.transformer_key from neosync_api.accounts a
 INNER JOIN neosync_api.account_user_associations aua ON aua.account_id = a.id
 INNER JOIN neosync_api.users u ON u.id = aua.user_id
 WHERE u.id = $1 AND a.account_type = 1
@@ -438,6 +482,7 @@ func (q *Queries) GetTeamAccountsByUserId(ctx context.Context, db DBTX, userid p
 			&i.AccountSlug,
 			&i.TemporalConfig,
 			&i.OnboardingConfig,
+			&i.TransformerKey,
 		); err != nil {
 			return nil, err
 		}
@@ -536,27 +581,37 @@ func (q *Queries) GetUserByProviderSub(ctx context.Context, db DBTX, providerSub
 }
 
 const getUserIdentitiesByTeamAccount = `-- name: GetUserIdentitiesByTeamAccount :many
-SELECT aipa.id, aipa.user_id, aipa.provider_sub, aipa.created_at, aipa.updated_at FROM neosync_api.user_identity_provider_associations aipa
+SELECT aipa.id, aipa.user_id, aipa.provider_sub, aipa.created_at, aipa.updated_at, aua.role FROM neosync_api.user_identity_provider_associations aipa
 JOIN neosync_api.account_user_associations aua ON aua.user_id = aipa.user_id
 JOIN neosync_api.accounts a ON a.id = aua.account_id
 WHERE aua.account_id = $1 AND a.account_type = 1
 `
 
-func (q *Queries) GetUserIdentitiesByTeamAccount(ctx context.Context, db DBTX, accountid pgtype.UUID) ([]NeosyncApiUserIdentityProviderAssociation, error) {
+type GetUserIdentitiesByTeamAccountRow struct {
+	ID          pgtype.UUID
+	UserID      pgtype.UUID
+	ProviderSub string
+	CreatedAt   pgtype.Timestamp
+	UpdatedAt   pgtype.Timestamp
+	Role        int16
+}
+
+func (q *Queries) GetUserIdentitiesByTeamAccount(ctx context.Context, db DBTX, accountid pgtype.UUID) ([]GetUserIdentitiesByTeamAccountRow, error) {
 	rows, err := db.Query(ctx, getUserIdentitiesByTeamAccount, accountid)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	var items []NeosyncApiUserIdentityProviderAssociation
+	var items []GetUserIdentitiesByTeamAccountRow
 	for rows.Next() {
-		var i NeosyncApiUserIdentityProviderAssociation
+		var i GetUserIdentitiesByTeamAccountRow
 		if err := rows.Scan(
 			&i.ID,
 			&i.UserID,
 			&i.ProviderSub,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.Role,
 		); err != nil {
 			return nil, err
 		}
@@ -617,6 +672,25 @@ func (q *Queries) GetUserIdentityByUserId(ctx context.Context, db DBTX, userID p
 	return i, err
 }
 
+const initAccountTransformerKey = `-- name: InitAccountTransformerKey :one
+UPDATE neosync_api.accounts
+SET transformer_key = COALESCE(transformer_key, $1::text)
+WHERE id = $2
+RETURNING transformer_key
+`
+
+type InitAccountTransformerKeyParams struct {
+	TransformerKey string
+	AccountId      pgtype.UUID
+}
+
+func (q *Queries) InitAccountTransformerKey(ctx context.Context, db DBTX, arg InitAccountTransformerKeyParams) (pgtype.Text, error) {
+	row := db.QueryRow(ctx, initAccountTransformerKey, arg.TransformerKey, arg.AccountId)
+	var transformer_key pgtype.Text
+	err := row.Scan(&transformer_key)
+	return transformer_key, err
+}
+
 const isUserInAccount = `-- name: IsUserInAccount :one
 SELECT count(aua.id) from neosync_api.account_user_associations aua
 INNER JOIN neosync_api.accounts a ON a.id = aua.account_id
@@ -689,7 +763,7 @@ const updateAccountInviteToAccepted = `-- name: UpdateAccountInviteToAccepted :o
 UPDATE neosync_api.account_invites
 SET accepted = true
 WHERE id = $1
-RETURNING id, account_id, sender_user_id, email, token, accepted, created_at, updated_at, expires_at
+RETURNING id, account_id, sender_user_id, email, token, accepted, created_at, updated_at, expires_at, role
 `
 
 func (q *Queries) UpdateAccountInviteToAccepted(ctx context.Context, db DBTX, id pgtype.UUID) (NeosyncApiAccountInvite, error) {
@@ -705,6 +779,7 @@ func (q *Queries) UpdateAccountInviteToAccepted(ctx context.Context, db DBTX, id
 		&i.CreatedAt,
 		&i.UpdatedAt,
 		&i.ExpiresAt,
+		&i.Role,
 	)
 	return i, err
 }
@@ -713,7 +788,7 @@ const updateAccountOnboardingConfig = `-- name: UpdateAccountOnboardingConfig :o
 UPDATE neosync_api.accounts
 SET onboarding_config = $1
 WHERE id = $2
-RETURNING id, created_at, updated_at, account_type, account_slug, temporal_config, onboarding_config
+RETURNING id, created_at, updated_at, account_type, account_slug, temporal_config, onboarding_config, transformer_key
 `
 
 type UpdateAccountOnboardingConfigParams struct {
@@ -732,6 +807,53 @@ func (q *Queries) UpdateAccountOnboardingConfig(ctx context.Context, db DBTX, ar
 		&i.AccountSlug,
 		&i.TemporalConfig,
 		&i.OnboardingConfig,
+		&i.TransformerKey,
+	)
+	return i, err
+}
+
+const updateAccountTransformerKey = `-- name: UpdateAccountTransformerKey :one
+UPDATE neosync_api.accounts
+SET transformer_key = $1::text
+WHERE id = $2
+RETURNING transformer_key
+`
+
+type UpdateAccountTransformerKeyParams struct {
+	TransformerKey string
+	AccountId      pgtype.UUID
+}
+
+func (q *Queries) UpdateAccountTransformerKey(ctx context.Context, db DBTX, arg UpdateAccountTransformerKeyParams) (pgtype.Text, error) {
+	row := db.QueryRow(ctx, updateAccountTransformerKey, arg.TransformerKey, arg.AccountId)
+	var transformer_key pgtype.Text
+	err := row.Scan(&transformer_key)
+	return transformer_key, err
+}
+
+const updateAccountUserRole = `-- name: UpdateAccountUserRole :one
+UPDATE neosync_api.account_user_associations
+SET role = $1
+WHERE account_id = $2 AND user_id = $3
+RETURNING id, account_id, user_id, created_at, updated_at, role
+`
+
+type UpdateAccountUserRoleParams struct {
+	Role      int16
+	AccountId pgtype.UUID
+	UserId    pgtype.UUID
+}
+
+func (q *Queries) UpdateAccountUserRole(ctx context.Context, db DBTX, arg UpdateAccountUserRoleParams) (NeosyncApiAccountUserAssociation, error) {
+	row := db.QueryRow(ctx, updateAccountUserRole, arg.Role, arg.AccountId, arg.UserId)
+	var i NeosyncApiAccountUserAssociation
+	err := row.Scan(
+		&i.ID,
+		&i.AccountID,
+		&i.UserID,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.Role,
 	)
 	return i, err
 }
@@ -740,7 +862,7 @@ const updateActiveAccountInvitesToExpired = `-- name: UpdateActiveAccountInvites
 UPDATE neosync_api.account_invites
 SET expires_at = CURRENT_TIMESTAMP
 WHERE account_id = $1 AND email = $2 AND expires_at > CURRENT_TIMESTAMP
-RETURNING id, account_id, sender_user_id, email, token, accepted, created_at, updated_at, expires_at
+RETURNING id, account_id, sender_user_id, email, token, accepted, created_at, updated_at, expires_at, role
 `
 
 type UpdateActiveAccountInvitesToExpiredParams struct {
@@ -761,6 +883,7 @@ func (q *Queries) UpdateActiveAccountInvitesToExpired(ctx context.Context, db DB
 		&i.CreatedAt,
 		&i.UpdatedAt,
 		&i.ExpiresAt,
+		&i.Role,
 	)
 	return i, err
 }
@@ -769,7 +892,7 @@ const updateTemporalConfigByAccount = `-- name: UpdateTemporalConfigByAccount :o
 UPDATE neosync_api.accounts
 SET temporal_config = $1
 WHERE id = $2
-RETURNING id, created_at, updated_at, account_type, account_slug, temporal_config, onboarding_config
+RETURNING id, created_at, updated_at, account_type, account_slug, temporal_config, onboarding_config, transformer_key
 `
 
 type UpdateTemporalConfigByAccountParams struct {
@@ -788,6 +911,7 @@ func (q *Queries) UpdateTemporalConfigByAccount(ctx context.Context, db DBTX, ar
 		&i.AccountSlug,
 		&i.TemporalConfig,
 		&i.OnboardingConfig,
+		&i.TransformerKey,
 	)
 	return i, err
 }
@@ -0,0 +1,315 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.25.0
+// source: webhooks.sql
+
+package db_queries
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const createWebhook = `-- name: CreateWebhook :one
+INSERT INTO neosync_api.webhooks (
+  name, account_id, url, secret, event_types, enabled, created_by_id, updated_by_id
+) VALUES (
+  $1, $2, $3, $4, $5, $6, $7, $8
+)
+RETURNING id, created_at, updated_at, name, account_id, url, secret, event_types, enabled, created_by_id, updated_by_id
+`
+
+type CreateWebhookParams struct {
+	Name        string
+	AccountID   pgtype.UUID
+	Url         string
+	Secret      string
+	EventTypes  []int32
+	Enabled     bool
+	CreatedByID pgtype.UUID
+	UpdatedByID pgtype.UUID
+}
+
+func (q *Queries) CreateWebhook(ctx context.Context, db DBTX, arg CreateWebhookParams) (NeosyncApiWebhook, error) {
+	row := db.QueryRow(ctx, createWebhook,
+		arg.Name,
+		arg.AccountID,
+		arg.Url,
+		arg.Secret,
+		arg.EventTypes,
+		arg.Enabled,
+		arg.CreatedByID,
+		arg.UpdatedByID,
+	)
+	var i NeosyncApiWebhook
+	err := row.Scan(
+		&i.ID,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.Name,
+		&i.AccountID,
+		&i.Url,
+		&i.Secret,
+		&i.EventTypes,
+		&i.Enabled,
+		&i.CreatedByID,
+		&i.UpdatedByID,
+	)
+	return i, err
+}
+
+const createWebhookDelivery = `-- name: CreateWebhookDelivery :one
+INSERT INTO neosync_api.webhook_deliveries (
+  webhook_id, event_id, event_type, payload, attempt, status_code, error, success
+) VALUES (
+  $1, $2, $3, $4, $5, $6, $7, $8
+)
+RETURNING id, created_at, webhook_id, event_id, event_type, payload, attempt, status_code, error, success
+`
+
+type CreateWebhookDeliveryParams struct {
+	WebhookID  pgtype.UUID
+	EventID    pgtype.UUID
+	EventType  int32
+	Payload    []byte
+	Attempt    int32
+	StatusCode pgtype.Int4
+	Error      pgtype.Text
+	Success    bool
+}
+
+func (q *Queries) CreateWebhookDelivery(ctx context.Context, db DBTX, arg CreateWebhookDeliveryParams) (NeosyncApiWebhookDelivery, error) {
+	row := db.QueryRow(ctx, createWebhookDelivery,
+		arg.WebhookID,
+		arg.EventID,
+		arg.EventType,
+		arg.Payload,
+		arg.Attempt,
+		arg.StatusCode,
+		arg.Error,
+		arg.Success,
+	)
+	var i NeosyncApiWebhookDelivery
+	err := row.Scan(
+		&i.ID,
+		&i.CreatedAt,
+		&i.WebhookID,
+		&i.EventID,
+		&i.EventType,
+		&i.Payload,
+		&i.Attempt,
+		&i.StatusCode,
+		&i.Error,
+		&i.Success,
+	)
+	return i, err
+}
+
+const getEnabledWebhooksByEventType = `-- name: GetEnabledWebhooksByEventType :many
+SELECT id, created_at, updated_at, name, account_id, url, secret, event_types, enabled, created_by_id, updated_by_id FROM neosync_api.webhooks
+WHERE account_id = $1
+  AND enabled = true
+  AND (cardinality(event_types) = 0 OR $2::integer = ANY(event_types))
+`
+
+type GetEnabledWebhooksByEventTypeParams struct {
+	AccountId pgtype.UUID
+	EventType int32
+}
+
+func (q *Queries) GetEnabledWebhooksByEventType(ctx context.Context, db DBTX, arg GetEnabledWebhooksByEventTypeParams) ([]NeosyncApiWebhook, error) {
+	rows, err := db.Query(ctx, getEnabledWebhooksByEventType, arg.AccountId, arg.EventType)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []NeosyncApiWebhook
+	for rows.Next() {
+		var i NeosyncApiWebhook
+		if err := rows.Scan(
+			&i.ID,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.Name,
+			&i.AccountID,
+			&i.Url,
+			&i.Secret,
+			&i.EventTypes,
+			&i.Enabled,
+			&i.CreatedByID,
+			&i.UpdatedByID,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getWebhookById = `-- name: GetWebhookById :one
+SELECT id, created_at, updated_at, name, account_id, url, secret, event_types, enabled, created_by_id, updated_by_id FROM neosync_api.webhooks
+WHERE id = $1
+`
+
+func (q *Queries) GetWebhookById(ctx context.Context, db DBTX, id pgtype.UUID) (NeosyncApiWebhook, error) {
+	row := db.QueryRow(ctx, getWebhookById, id)
+	var i NeosyncApiWebhook
+	err := row.Scan(
+		&i.ID,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.Name,
+		&i.AccountID,
+		&i.Url,
+		&i.Secret,
+		&i.EventTypes,
+		&i.Enabled,
+		&i.CreatedByID,
+		&i.UpdatedByID,
+	)
+	return i, err
+}
+
+const getWebhookDeliveries = `-- name: GetWebhookDeliveries :many
+SELECT id, created_at, webhook_id, event_id, event_type, payload, attempt, status_code, error, success FROM neosync_api.webhook_deliveries
+WHERE webhook_id = $1
+ORDER BY created_at DESC, id DESC
+LIMIT $2
+`
+
+type GetWebhookDeliveriesParams struct {
+	WebhookId pgtype.UUID
+	Limit     int32
+}
+
+func (q *Queries) GetWebhookDeliveries(ctx context.Context, db DBTX, arg GetWebhookDeliveriesParams) ([]NeosyncApiWebhookDelivery, error) {
+	rows, err := db.Query(ctx, getWebhookDeliveries, arg.WebhookId, arg.Limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []NeosyncApiWebhookDelivery
+	for rows.Next() {
+		var i NeosyncApiWebhookDelivery
+		if err := rows.Scan(
+			&i.ID,
+			&i.CreatedAt,
+			&i.WebhookID,
+			&i.EventID,
+			&i.EventType,
+			&i.Payload,
+			&i.Attempt,
+			&i.StatusCode,
+			&i.Error,
+			&i.Success,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getWebhooksByAccount = `-- name: GetWebhooksByAccount :many
+SELECT id, created_at, updated_at, name, account_id, url, secret, event_types, enabled, created_by_id, updated_by_id FROM neosync_api.webhooks
+WHERE account_id = $1
+ORDER BY created_at DESC
+`
+
+func (q *Queries) GetWebhooksByAccount(ctx context.Context, db DBTX, accountID pgtype.UUID) ([]NeosyncApiWebhook, error) {
+	rows, err := db.Query(ctx, getWebhooksByAccount, accountID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []NeosyncApiWebhook
+	for rows.Next() {
+		var i NeosyncApiWebhook
+		if err := rows.Scan(
+			&i.ID,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.Name,
+			&i.AccountID,
+			&i.Url,
+			&i.Secret,
+			&i.EventTypes,
+			&i.Enabled,
+			&i.CreatedByID,
+			&i.UpdatedByID,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const removeWebhookById = `-- name: RemoveWebhookById :exec
+DELETE FROM neosync_api.webhooks WHERE id = $1
+`
+
+func (q *Queries) RemoveWebhookById(ctx context.Context, db DBTX, id pgtype.UUID) error {
+	_, err := db.Exec(ctx, removeWebhookById, id)
+	return err
+}
+
+const updateWebhook = `-- name: UpdateWebhook :one
+UPDATE neosync_api.webhooks
+SET name = $1,
+  url = $2,
+  secret = COALESCE($3::text, secret),
+  event_types = $4,
+  enabled = $5,
+  updated_by_id = $6,
+  updated_at = CURRENT_TIMESTAMP
+WHERE id = $7
+RETURNING id, created_at, updated_at, name, account_id, url, secret, event_types, enabled, created_by_id, updated_by_id
+`
+
+type UpdateWebhookParams struct {
+	Name        string
+	Url         string
+	Secret      pgtype.Text
+	EventTypes  []int32
+	Enabled     bool
+	UpdatedById pgtype.UUID
+	ID          pgtype.UUID
+}
+
+func (q *Queries) UpdateWebhook(ctx context.Context, db DBTX, arg UpdateWebhookParams) (NeosyncApiWebhook, error) {
+	row := db.QueryRow(ctx, updateWebhook,
+		arg.Name,
+		arg.Url,
+		arg.Secret,
+		arg.EventTypes,
+		arg.Enabled,
+		arg.UpdatedById,
+		arg.ID,
+	)
+	var i NeosyncApiWebhook
+	err := row.Scan(
+		&i.ID,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.Name,
+		&i.AccountID,
+		&i.Url,
+		&i.Secret,
+		&i.EventTypes,
+		&i.Enabled,
+		&i.CreatedByID,
+		&i.UpdatedByID,
+	)
+	return i, err
+}
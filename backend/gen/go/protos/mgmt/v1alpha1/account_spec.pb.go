@@ -0,0 +1,998 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.33.0
+// 	protoc        (unknown)
+// source: mgmt/v1alpha1/account_spec.proto
+
+package mgmtv1alpha1
+
+import (
+	_ "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type AccountSpecResourceType int32
+
+const (
+	AccountSpecResourceType_ACCOUNT_SPEC_RESOURCE_TYPE_UNSPECIFIED AccountSpecResourceType = 0
+	AccountSpecResourceType_ACCOUNT_SPEC_RESOURCE_TYPE_CONNECTION  AccountSpecResourceType = 1
+	AccountSpecResourceType_ACCOUNT_SPEC_RESOURCE_TYPE_TRANSFORMER AccountSpecResourceType = 2
+	AccountSpecResourceType_ACCOUNT_SPEC_RESOURCE_TYPE_JOB         AccountSpecResourceType = 3
+)
+
+// Enum value maps for AccountSpecResourceType.
+var (
+	AccountSpecResourceType_name = map[int32]string{
+		0: "ACCOUNT_SPEC_RESOURCE_TYPE_UNSPECIFIED",
+		1: "ACCOUNT_SPEC_RESOURCE_TYPE_CONNECTION",
+		2: "ACCOUNT_SPEC_RESOURCE_TYPE_TRANSFORMER",
+		3: "ACCOUNT_SPEC_RESOURCE_TYPE_JOB",
+	}
+	AccountSpecResourceType_value = map[string]int32{
+		"ACCOUNT_SPEC_RESOURCE_TYPE_UNSPECIFIED": 0,
+		"ACCOUNT_SPEC_RESOURCE_TYPE_CONNECTION":  1,
+		"ACCOUNT_SPEC_RESOURCE_TYPE_TRANSFORMER": 2,
+		"ACCOUNT_SPEC_RESOURCE_TYPE_JOB":         3,
+	}
+)
+
+func (x AccountSpecResourceType) Enum() *AccountSpecResourceType {
+	p := new(AccountSpecResourceType)
+	*p = x
+	return p
+}
+
+func (x AccountSpecResourceType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AccountSpecResourceType) Descriptor() protoreflect.EnumDescriptor {
+	return file_mgmt_v1alpha1_account_spec_proto_enumTypes[0].Descriptor()
+}
+
+func (AccountSpecResourceType) Type() protoreflect.EnumType {
+	return &file_mgmt_v1alpha1_account_spec_proto_enumTypes[0]
+}
+
+func (x AccountSpecResourceType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use AccountSpecResourceType.Descriptor instead.
+func (AccountSpecResourceType) EnumDescriptor() ([]byte, []int) {
+	return file_mgmt_v1alpha1_account_spec_proto_rawDescGZIP(), []int{0}
+}
+
+type AccountSpecChangeAction int32
+
+const (
+	AccountSpecChangeAction_ACCOUNT_SPEC_CHANGE_ACTION_UNSPECIFIED AccountSpecChangeAction = 0
+	AccountSpecChangeAction_ACCOUNT_SPEC_CHANGE_ACTION_CREATE      AccountSpecChangeAction = 1
+	AccountSpecChangeAction_ACCOUNT_SPEC_CHANGE_ACTION_UPDATE      AccountSpecChangeAction = 2
+	AccountSpecChangeAction_ACCOUNT_SPEC_CHANGE_ACTION_UNCHANGED   AccountSpecChangeAction = 3
+)
+
+// Enum value maps for AccountSpecChangeAction.
+var (
+	AccountSpecChangeAction_name = map[int32]string{
+		0: "ACCOUNT_SPEC_CHANGE_ACTION_UNSPECIFIED",
+		1: "ACCOUNT_SPEC_CHANGE_ACTION_CREATE",
+		2: "ACCOUNT_SPEC_CHANGE_ACTION_UPDATE",
+		3: "ACCOUNT_SPEC_CHANGE_ACTION_UNCHANGED",
+	}
+	AccountSpecChangeAction_value = map[string]int32{
+		"ACCOUNT_SPEC_CHANGE_ACTION_UNSPECIFIED": 0,
+		"ACCOUNT_SPEC_CHANGE_ACTION_CREATE":      1,
+		"ACCOUNT_SPEC_CHANGE_ACTION_UPDATE":      2,
+		"ACCOUNT_SPEC_CHANGE_ACTION_UNCHANGED":   3,
+	}
+)
+
+func (x AccountSpecChangeAction) Enum() *AccountSpecChangeAction {
+	p := new(AccountSpecChangeAction)
+	*p = x
+	return p
+}
+
+func (x AccountSpecChangeAction) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AccountSpecChangeAction) Descriptor() protoreflect.EnumDescriptor {
+	return file_mgmt_v1alpha1_account_spec_proto_enumTypes[1].Descriptor()
+}
+
+func (AccountSpecChangeAction) Type() protoreflect.EnumType {
+	return &file_mgmt_v1alpha1_account_spec_proto_enumTypes[1]
+}
+
+func (x AccountSpecChangeAction) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use AccountSpecChangeAction.Descriptor instead.
+func (AccountSpecChangeAction) EnumDescriptor() ([]byte, []int) {
+	return file_mgmt_v1alpha1_account_spec_proto_rawDescGZIP(), []int{1}
+}
+
+// A declarative description of the connections, user defined transformers and jobs of an account that can be kept in source control.
+// Resources are matched to the resources of an account by name when the spec is applied.
+type AccountSpec struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The version of the spec format
+	Version      string             `protobuf:"bytes,1,opt,name=version,proto3" json:"version,omitempty"`
+	Connections  []*ConnectionSpec  `protobuf:"bytes,2,rep,name=connections,proto3" json:"connections,omitempty"`
+	Transformers []*TransformerSpec `protobuf:"bytes,3,rep,name=transformers,proto3" json:"transformers,omitempty"`
+	Jobs         []*JobSpec         `protobuf:"bytes,4,rep,name=jobs,proto3" json:"jobs,omitempty"`
+}
+
+func (x *AccountSpec) Reset() {
+	*x = AccountSpec{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mgmt_v1alpha1_account_spec_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AccountSpec) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AccountSpec) ProtoMessage() {}
+
+func (x *AccountSpec) ProtoReflect() protoreflect.Message {
+	mi := &file_mgmt_v1alpha1_account_spec_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AccountSpec.ProtoReflect.Descriptor instead.
+func (*AccountSpec) Descriptor() ([]byte, []int) {
+	return file_mgmt_v1alpha1_account_spec_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *AccountSpec) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+func (x *AccountSpec) GetConnections() []*ConnectionSpec {
+	if x != nil {
+		return x.Connections
+	}
+	return nil
+}
+
+func (x *AccountSpec) GetTransformers() []*TransformerSpec {
+	if x != nil {
+		return x.Transformers
+	}
+	return nil
+}
+
+func (x *AccountSpec) GetJobs() []*JobSpec {
+	if x != nil {
+		return x.Jobs
+	}
+	return nil
+}
+
+type ConnectionSpec struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Identifies the connection within the spec. Jobs refer to the connection by this id
+	Id   string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// Secrets are left empty when a connection is exported. Empty secrets keep the values of the existing connection when the spec is applied.
+	// The connection is only referenced, and must already exist, if not provided
+	ConnectionConfig *ConnectionConfig `protobuf:"bytes,3,opt,name=connection_config,json=connectionConfig,proto3,oneof" json:"connection_config,omitempty"`
+}
+
+func (x *ConnectionSpec) Reset() {
+	*x = ConnectionSpec{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mgmt_v1alpha1_account_spec_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ConnectionSpec) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConnectionSpec) ProtoMessage() {}
+
+func (x *ConnectionSpec) ProtoReflect() protoreflect.Message {
+	mi := &file_mgmt_v1alpha1_account_spec_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConnectionSpec.ProtoReflect.Descriptor instead.
+func (*ConnectionSpec) Descriptor() ([]byte, []int) {
+	return file_mgmt_v1alpha1_account_spec_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ConnectionSpec) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ConnectionSpec) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ConnectionSpec) GetConnectionConfig() *ConnectionConfig {
+	if x != nil {
+		return x.ConnectionConfig
+	}
+	return nil
+}
+
+type TransformerSpec struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Identifies the transformer within the spec. Job mappings refer to the transformer by this id
+	Id          string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name        string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description string `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	// The source of a transformer can not be changed after it has been created
+	Source TransformerSource  `protobuf:"varint,4,opt,name=source,proto3,enum=mgmt.v1alpha1.TransformerSource" json:"source,omitempty"`
+	Config *TransformerConfig `protobuf:"bytes,5,opt,name=config,proto3" json:"config,omitempty"`
+}
+
+func (x *TransformerSpec) Reset() {
+	*x = TransformerSpec{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mgmt_v1alpha1_account_spec_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TransformerSpec) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransformerSpec) ProtoMessage() {}
+
+func (x *TransformerSpec) ProtoReflect() protoreflect.Message {
+	mi := &file_mgmt_v1alpha1_account_spec_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransformerSpec.ProtoReflect.Descriptor instead.
+func (*TransformerSpec) Descriptor() ([]byte, []int) {
+	return file_mgmt_v1alpha1_account_spec_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *TransformerSpec) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *TransformerSpec) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *TransformerSpec) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *TransformerSpec) GetSource() TransformerSource {
+	if x != nil {
+		return x.Source
+	}
+	return TransformerSource_TRANSFORMER_SOURCE_UNSPECIFIED
+}
+
+func (x *TransformerSpec) GetConfig() *TransformerConfig {
+	if x != nil {
+		return x.Config
+	}
+	return nil
+}
+
+type JobSpec struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Identifies the job within the spec. Job triggers refer to the job by this id
+	Id   string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// The schedule of an existing job is left unchanged if not provided
+	CronSchedule    *string                 `protobuf:"bytes,3,opt,name=cron_schedule,json=cronSchedule,proto3,oneof" json:"cron_schedule,omitempty"`
+	Source          *JobSource              `protobuf:"bytes,4,opt,name=source,proto3" json:"source,omitempty"`
+	Destinations    []*CreateJobDestination `protobuf:"bytes,5,rep,name=destinations,proto3" json:"destinations,omitempty"`
+	Mappings        []*JobMapping           `protobuf:"bytes,6,rep,name=mappings,proto3" json:"mappings,omitempty"`
+	WorkflowOptions *WorkflowOptions        `protobuf:"bytes,7,opt,name=workflow_options,json=workflowOptions,proto3" json:"workflow_options,omitempty"`
+	SyncOptions     *ActivityOptions        `protobuf:"bytes,8,opt,name=sync_options,json=syncOptions,proto3" json:"sync_options,omitempty"`
+	Triggers        []*JobTrigger           `protobuf:"bytes,9,rep,name=triggers,proto3" json:"triggers,omitempty"`
+}
+
+func (x *JobSpec) Reset() {
+	*x = JobSpec{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mgmt_v1alpha1_account_spec_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *JobSpec) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JobSpec) ProtoMessage() {}
+
+func (x *JobSpec) ProtoReflect() protoreflect.Message {
+	mi := &file_mgmt_v1alpha1_account_spec_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JobSpec.ProtoReflect.Descriptor instead.
+func (*JobSpec) Descriptor() ([]byte, []int) {
+	return file_mgmt_v1alpha1_account_spec_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *JobSpec) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *JobSpec) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *JobSpec) GetCronSchedule() string {
+	if x != nil && x.CronSchedule != nil {
+		return *x.CronSchedule
+	}
+	return ""
+}
+
+func (x *JobSpec) GetSource() *JobSource {
+	if x != nil {
+		return x.Source
+	}
+	return nil
+}
+
+func (x *JobSpec) GetDestinations() []*CreateJobDestination {
+	if x != nil {
+		return x.Destinations
+	}
+	return nil
+}
+
+func (x *JobSpec) GetMappings() []*JobMapping {
+	if x != nil {
+		return x.Mappings
+	}
+	return nil
+}
+
+func (x *JobSpec) GetWorkflowOptions() *WorkflowOptions {
+	if x != nil {
+		return x.WorkflowOptions
+	}
+	return nil
+}
+
+func (x *JobSpec) GetSyncOptions() *ActivityOptions {
+	if x != nil {
+		return x.SyncOptions
+	}
+	return nil
+}
+
+func (x *JobSpec) GetTriggers() []*JobTrigger {
+	if x != nil {
+		return x.Triggers
+	}
+	return nil
+}
+
+// The change to a single resource of the account
+type AccountSpecChange struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ResourceType AccountSpecResourceType `protobuf:"varint,1,opt,name=resource_type,json=resourceType,proto3,enum=mgmt.v1alpha1.AccountSpecResourceType" json:"resource_type,omitempty"`
+	Name         string                  `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Action       AccountSpecChangeAction `protobuf:"varint,3,opt,name=action,proto3,enum=mgmt.v1alpha1.AccountSpecChangeAction" json:"action,omitempty"`
+	// The fields that differ from the existing resource. Only set for updates
+	Fields []string `protobuf:"bytes,4,rep,name=fields,proto3" json:"fields,omitempty"`
+	// The id of the resource in the account. Not set for resources that a dry run would create
+	Id *string `protobuf:"bytes,5,opt,name=id,proto3,oneof" json:"id,omitempty"`
+}
+
+func (x *AccountSpecChange) Reset() {
+	*x = AccountSpecChange{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mgmt_v1alpha1_account_spec_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AccountSpecChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AccountSpecChange) ProtoMessage() {}
+
+func (x *AccountSpecChange) ProtoReflect() protoreflect.Message {
+	mi := &file_mgmt_v1alpha1_account_spec_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AccountSpecChange.ProtoReflect.Descriptor instead.
+func (*AccountSpecChange) Descriptor() ([]byte, []int) {
+	return file_mgmt_v1alpha1_account_spec_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *AccountSpecChange) GetResourceType() AccountSpecResourceType {
+	if x != nil {
+		return x.ResourceType
+	}
+	return AccountSpecResourceType_ACCOUNT_SPEC_RESOURCE_TYPE_UNSPECIFIED
+}
+
+func (x *AccountSpecChange) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *AccountSpecChange) GetAction() AccountSpecChangeAction {
+	if x != nil {
+		return x.Action
+	}
+	return AccountSpecChangeAction_ACCOUNT_SPEC_CHANGE_ACTION_UNSPECIFIED
+}
+
+func (x *AccountSpecChange) GetFields() []string {
+	if x != nil {
+		return x.Fields
+	}
+	return nil
+}
+
+func (x *AccountSpecChange) GetId() string {
+	if x != nil && x.Id != nil {
+		return *x.Id
+	}
+	return ""
+}
+
+type ApplyAccountSpecRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AccountId string       `protobuf:"bytes,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	Spec      *AccountSpec `protobuf:"bytes,2,opt,name=spec,proto3" json:"spec,omitempty"`
+	// Only plan the changes without applying them
+	DryRun bool `protobuf:"varint,3,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+}
+
+func (x *ApplyAccountSpecRequest) Reset() {
+	*x = ApplyAccountSpecRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mgmt_v1alpha1_account_spec_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ApplyAccountSpecRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApplyAccountSpecRequest) ProtoMessage() {}
+
+func (x *ApplyAccountSpecRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mgmt_v1alpha1_account_spec_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApplyAccountSpecRequest.ProtoReflect.Descriptor instead.
+func (*ApplyAccountSpecRequest) Descriptor() ([]byte, []int) {
+	return file_mgmt_v1alpha1_account_spec_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ApplyAccountSpecRequest) GetAccountId() string {
+	if x != nil {
+		return x.AccountId
+	}
+	return ""
+}
+
+func (x *ApplyAccountSpecRequest) GetSpec() *AccountSpec {
+	if x != nil {
+		return x.Spec
+	}
+	return nil
+}
+
+func (x *ApplyAccountSpecRequest) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+type ApplyAccountSpecResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The changes in the order they are applied
+	Changes []*AccountSpecChange `protobuf:"bytes,1,rep,name=changes,proto3" json:"changes,omitempty"`
+}
+
+func (x *ApplyAccountSpecResponse) Reset() {
+	*x = ApplyAccountSpecResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mgmt_v1alpha1_account_spec_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ApplyAccountSpecResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApplyAccountSpecResponse) ProtoMessage() {}
+
+func (x *ApplyAccountSpecResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mgmt_v1alpha1_account_spec_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApplyAccountSpecResponse.ProtoReflect.Descriptor instead.
+func (*ApplyAccountSpecResponse) Descriptor() ([]byte, []int) {
+	return file_mgmt_v1alpha1_account_spec_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ApplyAccountSpecResponse) GetChanges() []*AccountSpecChange {
+	if x != nil {
+		return x.Changes
+	}
+	return nil
+}
+
+var File_mgmt_v1alpha1_account_spec_proto protoreflect.FileDescriptor
+
+var file_mgmt_v1alpha1_account_spec_proto_rawDesc = []byte{
+	0x0a, 0x20, 0x6d, 0x67, 0x6d, 0x74, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f,
+	0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x73, 0x70, 0x65, 0x63, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x12, 0x0d, 0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61,
+	0x31, 0x1a, 0x1b, 0x62, 0x75, 0x66, 0x2f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2f,
+	0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e,
+	0x6d, 0x67, 0x6d, 0x74, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f, 0x63, 0x6f,
+	0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x17,
+	0x6d, 0x67, 0x6d, 0x74, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f, 0x6a, 0x6f,
+	0x62, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x6d, 0x67, 0x6d, 0x74, 0x2f, 0x76, 0x31,
+	0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d,
+	0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xe9, 0x01, 0x0a, 0x0b, 0x41, 0x63, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x53, 0x70, 0x65, 0x63, 0x12, 0x29, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0f, 0xba, 0x48, 0x0c, 0x72, 0x0a,
+	0x0a, 0x08, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x12, 0x3f, 0x0a, 0x0b, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x6d, 0x67, 0x6d, 0x74, 0x2e,
+	0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x53, 0x70, 0x65, 0x63, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x12, 0x42, 0x0a, 0x0c, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72,
+	0x6d, 0x65, 0x72, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x6d, 0x67, 0x6d,
+	0x74, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73,
+	0x66, 0x6f, 0x72, 0x6d, 0x65, 0x72, 0x53, 0x70, 0x65, 0x63, 0x52, 0x0c, 0x74, 0x72, 0x61, 0x6e,
+	0x73, 0x66, 0x6f, 0x72, 0x6d, 0x65, 0x72, 0x73, 0x12, 0x2a, 0x0a, 0x04, 0x6a, 0x6f, 0x62, 0x73,
+	0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x76, 0x31,
+	0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4a, 0x6f, 0x62, 0x53, 0x70, 0x65, 0x63, 0x52, 0x04,
+	0x6a, 0x6f, 0x62, 0x73, 0x22, 0xc1, 0x01, 0x0a, 0x0e, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x53, 0x70, 0x65, 0x63, 0x12, 0x18, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x08, 0xba, 0x48, 0x05, 0x72, 0x03, 0xb0, 0x01, 0x01, 0x52, 0x02, 0x69,
+	0x64, 0x12, 0x2c, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x18, 0xba, 0x48, 0x15, 0x72, 0x13, 0x32, 0x11, 0x5e, 0x5b, 0x61, 0x2d, 0x7a, 0x30, 0x2d, 0x39,
+	0x2d, 0x5d, 0x7b, 0x33, 0x2c, 0x33, 0x30, 0x7d, 0x24, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12,
+	0x51, 0x0a, 0x11, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x63, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x6d, 0x67, 0x6d,
+	0x74, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x6e, 0x6e, 0x65,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x48, 0x00, 0x52, 0x10, 0x63,
+	0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x88,
+	0x01, 0x01, 0x42, 0x14, 0x0a, 0x12, 0x5f, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x22, 0xef, 0x01, 0x0a, 0x0f, 0x54, 0x72, 0x61,
+	0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x65, 0x72, 0x53, 0x70, 0x65, 0x63, 0x12, 0x18, 0x0a, 0x02,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0xba, 0x48, 0x05, 0x72, 0x03, 0xb0,
+	0x01, 0x01, 0x52, 0x02, 0x69, 0x64, 0x12, 0x2c, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xba, 0x48, 0x15, 0x72, 0x13, 0x32, 0x11, 0x5e, 0x5b, 0x61,
+	0x2d, 0x7a, 0x30, 0x2d, 0x39, 0x2d, 0x5d, 0x7b, 0x33, 0x2c, 0x33, 0x30, 0x7d, 0x24, 0x52, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72,
+	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x38, 0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x20, 0x2e, 0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x76, 0x31,
+	0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d,
+	0x65, 0x72, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x12, 0x38, 0x0a, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x20, 0x2e, 0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31,
+	0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x52, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x22, 0x8c, 0x04, 0x0a, 0x07, 0x4a,
+	0x6f, 0x62, 0x53, 0x70, 0x65, 0x63, 0x12, 0x18, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x08, 0xba, 0x48, 0x05, 0x72, 0x03, 0xb0, 0x01, 0x01, 0x52, 0x02, 0x69, 0x64,
+	0x12, 0x2c, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18,
+	0xba, 0x48, 0x15, 0x72, 0x13, 0x32, 0x11, 0x5e, 0x5b, 0x61, 0x2d, 0x7a, 0x30, 0x2d, 0x39, 0x2d,
+	0x5d, 0x7b, 0x33, 0x2c, 0x33, 0x30, 0x7d, 0x24, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x28,
+	0x0a, 0x0d, 0x63, 0x72, 0x6f, 0x6e, 0x5f, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x0c, 0x63, 0x72, 0x6f, 0x6e, 0x53, 0x63, 0x68,
+	0x65, 0x64, 0x75, 0x6c, 0x65, 0x88, 0x01, 0x01, 0x12, 0x38, 0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x6d, 0x67, 0x6d, 0x74, 0x2e,
+	0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4a, 0x6f, 0x62, 0x53, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x42, 0x06, 0xba, 0x48, 0x03, 0xc8, 0x01, 0x01, 0x52, 0x06, 0x73, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x12, 0x47, 0x0a, 0x0c, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x6d, 0x67, 0x6d, 0x74, 0x2e,
+	0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4a,
+	0x6f, 0x62, 0x44, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0c, 0x64,
+	0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x35, 0x0a, 0x08, 0x6d,
+	0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e,
+	0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4a, 0x6f,
+	0x62, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x52, 0x08, 0x6d, 0x61, 0x70, 0x70, 0x69, 0x6e,
+	0x67, 0x73, 0x12, 0x49, 0x0a, 0x10, 0x77, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x5f, 0x6f,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x6d,
+	0x67, 0x6d, 0x74, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x57, 0x6f, 0x72,
+	0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x0f, 0x77, 0x6f,
+	0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x41, 0x0a,
+	0x0c, 0x73, 0x79, 0x6e, 0x63, 0x5f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x08, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70,
+	0x68, 0x61, 0x31, 0x2e, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x4f, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x52, 0x0b, 0x73, 0x79, 0x6e, 0x63, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x12, 0x35, 0x0a, 0x08, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x73, 0x18, 0x09, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x19, 0x2e, 0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68,
+	0x61, 0x31, 0x2e, 0x4a, 0x6f, 0x62, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x52, 0x08, 0x74,
+	0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x73, 0x42, 0x10, 0x0a, 0x0e, 0x5f, 0x63, 0x72, 0x6f, 0x6e,
+	0x5f, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x22, 0xe8, 0x01, 0x0a, 0x11, 0x41, 0x63,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x53, 0x70, 0x65, 0x63, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x12,
+	0x4b, 0x0a, 0x0d, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x26, 0x2e, 0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x76, 0x31,
+	0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x53, 0x70,
+	0x65, 0x63, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0c,
+	0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x12, 0x0a, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x12, 0x3e, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x26, 0x2e, 0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31,
+	0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x53, 0x70, 0x65, 0x63, 0x43, 0x68, 0x61, 0x6e,
+	0x67, 0x65, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x16, 0x0a, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x12, 0x13, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x02, 0x69, 0x64, 0x88, 0x01, 0x01, 0x42, 0x05, 0x0a,
+	0x03, 0x5f, 0x69, 0x64, 0x22, 0x93, 0x01, 0x0a, 0x17, 0x41, 0x70, 0x70, 0x6c, 0x79, 0x41, 0x63,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x53, 0x70, 0x65, 0x63, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x27, 0x0a, 0x0a, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0xba, 0x48, 0x05, 0x72, 0x03, 0xb0, 0x01, 0x01, 0x52, 0x09,
+	0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x36, 0x0a, 0x04, 0x73, 0x70, 0x65,
+	0x63, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x76,
+	0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x53,
+	0x70, 0x65, 0x63, 0x42, 0x06, 0xba, 0x48, 0x03, 0xc8, 0x01, 0x01, 0x52, 0x04, 0x73, 0x70, 0x65,
+	0x63, 0x12, 0x17, 0x0a, 0x07, 0x64, 0x72, 0x79, 0x5f, 0x72, 0x75, 0x6e, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x06, 0x64, 0x72, 0x79, 0x52, 0x75, 0x6e, 0x22, 0x56, 0x0a, 0x18, 0x41, 0x70,
+	0x70, 0x6c, 0x79, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x53, 0x70, 0x65, 0x63, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3a, 0x0a, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x76,
+	0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x53,
+	0x70, 0x65, 0x63, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x67,
+	0x65, 0x73, 0x2a, 0xc0, 0x01, 0x0a, 0x17, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x53, 0x70,
+	0x65, 0x63, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x2a,
+	0x0a, 0x26, 0x41, 0x43, 0x43, 0x4f, 0x55, 0x4e, 0x54, 0x5f, 0x53, 0x50, 0x45, 0x43, 0x5f, 0x52,
+	0x45, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x53,
+	0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x29, 0x0a, 0x25, 0x41, 0x43,
+	0x43, 0x4f, 0x55, 0x4e, 0x54, 0x5f, 0x53, 0x50, 0x45, 0x43, 0x5f, 0x52, 0x45, 0x53, 0x4f, 0x55,
+	0x52, 0x43, 0x45, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x43, 0x4f, 0x4e, 0x4e, 0x45, 0x43, 0x54,
+	0x49, 0x4f, 0x4e, 0x10, 0x01, 0x12, 0x2a, 0x0a, 0x26, 0x41, 0x43, 0x43, 0x4f, 0x55, 0x4e, 0x54,
+	0x5f, 0x53, 0x50, 0x45, 0x43, 0x5f, 0x52, 0x45, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x5f, 0x54,
+	0x59, 0x50, 0x45, 0x5f, 0x54, 0x52, 0x41, 0x4e, 0x53, 0x46, 0x4f, 0x52, 0x4d, 0x45, 0x52, 0x10,
+	0x02, 0x12, 0x22, 0x0a, 0x1e, 0x41, 0x43, 0x43, 0x4f, 0x55, 0x4e, 0x54, 0x5f, 0x53, 0x50, 0x45,
+	0x43, 0x5f, 0x52, 0x45, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f,
+	0x4a, 0x4f, 0x42, 0x10, 0x03, 0x2a, 0xbd, 0x01, 0x0a, 0x17, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x53, 0x70, 0x65, 0x63, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x41, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x2a, 0x0a, 0x26, 0x41, 0x43, 0x43, 0x4f, 0x55, 0x4e, 0x54, 0x5f, 0x53, 0x50, 0x45,
+	0x43, 0x5f, 0x43, 0x48, 0x41, 0x4e, 0x47, 0x45, 0x5f, 0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f,
+	0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x25, 0x0a,
+	0x21, 0x41, 0x43, 0x43, 0x4f, 0x55, 0x4e, 0x54, 0x5f, 0x53, 0x50, 0x45, 0x43, 0x5f, 0x43, 0x48,
+	0x41, 0x4e, 0x47, 0x45, 0x5f, 0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x43, 0x52, 0x45, 0x41,
+	0x54, 0x45, 0x10, 0x01, 0x12, 0x25, 0x0a, 0x21, 0x41, 0x43, 0x43, 0x4f, 0x55, 0x4e, 0x54, 0x5f,
+	0x53, 0x50, 0x45, 0x43, 0x5f, 0x43, 0x48, 0x41, 0x4e, 0x47, 0x45, 0x5f, 0x41, 0x43, 0x54, 0x49,
+	0x4f, 0x4e, 0x5f, 0x55, 0x50, 0x44, 0x41, 0x54, 0x45, 0x10, 0x02, 0x12, 0x28, 0x0a, 0x24, 0x41,
+	0x43, 0x43, 0x4f, 0x55, 0x4e, 0x54, 0x5f, 0x53, 0x50, 0x45, 0x43, 0x5f, 0x43, 0x48, 0x41, 0x4e,
+	0x47, 0x45, 0x5f, 0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x55, 0x4e, 0x43, 0x48, 0x41, 0x4e,
+	0x47, 0x45, 0x44, 0x10, 0x03, 0x32, 0x7b, 0x0a, 0x12, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x53, 0x70, 0x65, 0x63, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x65, 0x0a, 0x10, 0x41,
+	0x70, 0x70, 0x6c, 0x79, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x53, 0x70, 0x65, 0x63, 0x12,
+	0x26, 0x2e, 0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e,
+	0x41, 0x70, 0x70, 0x6c, 0x79, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x53, 0x70, 0x65, 0x63,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x76,
+	0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x41, 0x70, 0x70, 0x6c, 0x79, 0x41, 0x63, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x53, 0x70, 0x65, 0x63, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x00, 0x42, 0xcc, 0x01, 0x0a, 0x11, 0x63, 0x6f, 0x6d, 0x2e, 0x6d, 0x67, 0x6d, 0x74, 0x2e,
+	0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x42, 0x10, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x53, 0x70, 0x65, 0x63, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x50, 0x67, 0x69,
+	0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6e, 0x75, 0x63, 0x6c, 0x65, 0x75, 0x73,
+	0x63, 0x6c, 0x6f, 0x75, 0x64, 0x2f, 0x6e, 0x65, 0x6f, 0x73, 0x79, 0x6e, 0x63, 0x2f, 0x62, 0x61,
+	0x63, 0x6b, 0x65, 0x6e, 0x64, 0x2f, 0x67, 0x65, 0x6e, 0x2f, 0x67, 0x6f, 0x2f, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x73, 0x2f, 0x6d, 0x67, 0x6d, 0x74, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61,
+	0x31, 0x3b, 0x6d, 0x67, 0x6d, 0x74, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0xa2, 0x02,
+	0x03, 0x4d, 0x58, 0x58, 0xaa, 0x02, 0x0d, 0x4d, 0x67, 0x6d, 0x74, 0x2e, 0x56, 0x31, 0x61, 0x6c,
+	0x70, 0x68, 0x61, 0x31, 0xca, 0x02, 0x0d, 0x4d, 0x67, 0x6d, 0x74, 0x5c, 0x56, 0x31, 0x61, 0x6c,
+	0x70, 0x68, 0x61, 0x31, 0xe2, 0x02, 0x19, 0x4d, 0x67, 0x6d, 0x74, 0x5c, 0x56, 0x31, 0x61, 0x6c,
+	0x70, 0x68, 0x61, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
+	0xea, 0x02, 0x0e, 0x4d, 0x67, 0x6d, 0x74, 0x3a, 0x3a, 0x56, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61,
+	0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_mgmt_v1alpha1_account_spec_proto_rawDescOnce sync.Once
+	file_mgmt_v1alpha1_account_spec_proto_rawDescData = file_mgmt_v1alpha1_account_spec_proto_rawDesc
+)
+
+func file_mgmt_v1alpha1_account_spec_proto_rawDescGZIP() []byte {
+	file_mgmt_v1alpha1_account_spec_proto_rawDescOnce.Do(func() {
+		file_mgmt_v1alpha1_account_spec_proto_rawDescData = protoimpl.X.CompressGZIP(file_mgmt_v1alpha1_account_spec_proto_rawDescData)
+	})
+	return file_mgmt_v1alpha1_account_spec_proto_rawDescData
+}
+
+var file_mgmt_v1alpha1_account_spec_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_mgmt_v1alpha1_account_spec_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
+var file_mgmt_v1alpha1_account_spec_proto_goTypes = []interface{}{
+	(AccountSpecResourceType)(0),     // 0: mgmt.v1alpha1.AccountSpecResourceType
+	(AccountSpecChangeAction)(0),     // 1: mgmt.v1alpha1.AccountSpecChangeAction
+	(*AccountSpec)(nil),              // 2: mgmt.v1alpha1.AccountSpec
+	(*ConnectionSpec)(nil),           // 3: mgmt.v1alpha1.ConnectionSpec
+	(*TransformerSpec)(nil),          // 4: mgmt.v1alpha1.TransformerSpec
+	(*JobSpec)(nil),                  // 5: mgmt.v1alpha1.JobSpec
+	(*AccountSpecChange)(nil),        // 6: mgmt.v1alpha1.AccountSpecChange
+	(*ApplyAccountSpecRequest)(nil),  // 7: mgmt.v1alpha1.ApplyAccountSpecRequest
+	(*ApplyAccountSpecResponse)(nil), // 8: mgmt.v1alpha1.ApplyAccountSpecResponse
+	(*ConnectionConfig)(nil),         // 9: mgmt.v1alpha1.ConnectionConfig
+	(TransformerSource)(0),           // 10: mgmt.v1alpha1.TransformerSource
+	(*TransformerConfig)(nil),        // 11: mgmt.v1alpha1.TransformerConfig
+	(*JobSource)(nil),                // 12: mgmt.v1alpha1.JobSource
+	(*CreateJobDestination)(nil),     // 13: mgmt.v1alpha1.CreateJobDestination
+	(*JobMapping)(nil),               // 14: mgmt.v1alpha1.JobMapping
+	(*WorkflowOptions)(nil),          // 15: mgmt.v1alpha1.WorkflowOptions
+	(*ActivityOptions)(nil),          // 16: mgmt.v1alpha1.ActivityOptions
+	(*JobTrigger)(nil),               // 17: mgmt.v1alpha1.JobTrigger
+}
+var file_mgmt_v1alpha1_account_spec_proto_depIdxs = []int32{
+	3,  // 0: mgmt.v1alpha1.AccountSpec.connections:type_name -> mgmt.v1alpha1.ConnectionSpec
+	4,  // 1: mgmt.v1alpha1.AccountSpec.transformers:type_name -> mgmt.v1alpha1.TransformerSpec
+	5,  // 2: mgmt.v1alpha1.AccountSpec.jobs:type_name -> mgmt.v1alpha1.JobSpec
+	9,  // 3: mgmt.v1alpha1.ConnectionSpec.connection_config:type_name -> mgmt.v1alpha1.ConnectionConfig
+	10, // 4: mgmt.v1alpha1.TransformerSpec.source:type_name -> mgmt.v1alpha1.TransformerSource
+	11, // 5: mgmt.v1alpha1.TransformerSpec.config:type_name -> mgmt.v1alpha1.TransformerConfig
+	12, // 6: mgmt.v1alpha1.JobSpec.source:type_name -> mgmt.v1alpha1.JobSource
+	13, // 7: mgmt.v1alpha1.JobSpec.destinations:type_name -> mgmt.v1alpha1.CreateJobDestination
+	14, // 8: mgmt.v1alpha1.JobSpec.mappings:type_name -> mgmt.v1alpha1.JobMapping
+	15, // 9: mgmt.v1alpha1.JobSpec.workflow_options:type_name -> mgmt.v1alpha1.WorkflowOptions
+	16, // 10: mgmt.v1alpha1.JobSpec.sync_options:type_name -> mgmt.v1alpha1.ActivityOptions
+	17, // 11: mgmt.v1alpha1.JobSpec.triggers:type_name -> mgmt.v1alpha1.JobTrigger
+	0,  // 12: mgmt.v1alpha1.AccountSpecChange.resource_type:type_name -> mgmt.v1alpha1.AccountSpecResourceType
+	1,  // 13: mgmt.v1alpha1.AccountSpecChange.action:type_name -> mgmt.v1alpha1.AccountSpecChangeAction
+	2,  // 14: mgmt.v1alpha1.ApplyAccountSpecRequest.spec:type_name -> mgmt.v1alpha1.AccountSpec
+	6,  // 15: mgmt.v1alpha1.ApplyAccountSpecResponse.changes:type_name -> mgmt.v1alpha1.AccountSpecChange
+	7,  // 16: mgmt.v1alpha1.AccountSpecService.ApplyAccountSpec:input_type -> mgmt.v1alpha1.ApplyAccountSpecRequest
+	8,  // 17: mgmt.v1alpha1.AccountSpecService.ApplyAccountSpec:output_type -> mgmt.v1alpha1.ApplyAccountSpecResponse
+	17, // [17:18] is the sub-list for method output_type
+	16, // [16:17] is the sub-list for method input_type
+	16, // [16:16] is the sub-list for extension type_name
+	16, // [16:16] is the sub-list for extension extendee
+	0,  // [0:16] is the sub-list for field type_name
+}
+
+func init() { file_mgmt_v1alpha1_account_spec_proto_init() }
+func file_mgmt_v1alpha1_account_spec_proto_init() {
+	if File_mgmt_v1alpha1_account_spec_proto != nil {
+		return
+	}
+	file_mgmt_v1alpha1_connection_proto_init()
+	file_mgmt_v1alpha1_job_proto_init()
+	file_mgmt_v1alpha1_transformer_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_mgmt_v1alpha1_account_spec_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AccountSpec); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mgmt_v1alpha1_account_spec_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ConnectionSpec); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mgmt_v1alpha1_account_spec_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TransformerSpec); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mgmt_v1alpha1_account_spec_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*JobSpec); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mgmt_v1alpha1_account_spec_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AccountSpecChange); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mgmt_v1alpha1_account_spec_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ApplyAccountSpecRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mgmt_v1alpha1_account_spec_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ApplyAccountSpecResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_mgmt_v1alpha1_account_spec_proto_msgTypes[1].OneofWrappers = []interface{}{}
+	file_mgmt_v1alpha1_account_spec_proto_msgTypes[3].OneofWrappers = []interface{}{}
+	file_mgmt_v1alpha1_account_spec_proto_msgTypes[4].OneofWrappers = []interface{}{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_mgmt_v1alpha1_account_spec_proto_rawDesc,
+			NumEnums:      2,
+			NumMessages:   7,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_mgmt_v1alpha1_account_spec_proto_goTypes,
+		DependencyIndexes: file_mgmt_v1alpha1_account_spec_proto_depIdxs,
+		EnumInfos:         file_mgmt_v1alpha1_account_spec_proto_enumTypes,
+		MessageInfos:      file_mgmt_v1alpha1_account_spec_proto_msgTypes,
+	}.Build()
+	File_mgmt_v1alpha1_account_spec_proto = out.File
+	file_mgmt_v1alpha1_account_spec_proto_rawDesc = nil
+	file_mgmt_v1alpha1_account_spec_proto_goTypes = nil
+	file_mgmt_v1alpha1_account_spec_proto_depIdxs = nil
+}
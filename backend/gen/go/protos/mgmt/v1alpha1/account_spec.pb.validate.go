@@ -0,0 +1,1191 @@
+// Code generated by protoc-gen-validate. DO NOT EDIT.
+// source: mgmt/v1alpha1/account_spec.proto
+
+package mgmtv1alpha1
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = anypb.Any{}
+	_ = sort.Sort
+)
+
+// Validate checks the field values on AccountSpec with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *AccountSpec) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AccountSpec with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in AccountSpecMultiError, or
+// nil if none found.
+func (m *AccountSpec) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AccountSpec) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Version
+
+	for idx, item := range m.GetConnections() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, AccountSpecValidationError{
+						field:  fmt.Sprintf("Connections[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, AccountSpecValidationError{
+						field:  fmt.Sprintf("Connections[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return AccountSpecValidationError{
+					field:  fmt.Sprintf("Connections[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetTransformers() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, AccountSpecValidationError{
+						field:  fmt.Sprintf("Transformers[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, AccountSpecValidationError{
+						field:  fmt.Sprintf("Transformers[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return AccountSpecValidationError{
+					field:  fmt.Sprintf("Transformers[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetJobs() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, AccountSpecValidationError{
+						field:  fmt.Sprintf("Jobs[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, AccountSpecValidationError{
+						field:  fmt.Sprintf("Jobs[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return AccountSpecValidationError{
+					field:  fmt.Sprintf("Jobs[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return AccountSpecMultiError(errors)
+	}
+
+	return nil
+}
+
+// AccountSpecMultiError is an error wrapping multiple validation errors
+// returned by AccountSpec.ValidateAll() if the designated constraints aren't met.
+type AccountSpecMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AccountSpecMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AccountSpecMultiError) AllErrors() []error { return m }
+
+// AccountSpecValidationError is the validation error returned by
+// AccountSpec.Validate if the designated constraints aren't met.
+type AccountSpecValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AccountSpecValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AccountSpecValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AccountSpecValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AccountSpecValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AccountSpecValidationError) ErrorName() string { return "AccountSpecValidationError" }
+
+// Error satisfies the builtin error interface
+func (e AccountSpecValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAccountSpec.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AccountSpecValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AccountSpecValidationError{}
+
+// Validate checks the field values on ConnectionSpec with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ConnectionSpec) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ConnectionSpec with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ConnectionSpecMultiError,
+// or nil if none found.
+func (m *ConnectionSpec) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ConnectionSpec) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Name
+
+	if m.ConnectionConfig != nil {
+
+		if all {
+			switch v := interface{}(m.GetConnectionConfig()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ConnectionSpecValidationError{
+						field:  "ConnectionConfig",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ConnectionSpecValidationError{
+						field:  "ConnectionConfig",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetConnectionConfig()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ConnectionSpecValidationError{
+					field:  "ConnectionConfig",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ConnectionSpecMultiError(errors)
+	}
+
+	return nil
+}
+
+// ConnectionSpecMultiError is an error wrapping multiple validation errors
+// returned by ConnectionSpec.ValidateAll() if the designated constraints
+// aren't met.
+type ConnectionSpecMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ConnectionSpecMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ConnectionSpecMultiError) AllErrors() []error { return m }
+
+// ConnectionSpecValidationError is the validation error returned by
+// ConnectionSpec.Validate if the designated constraints aren't met.
+type ConnectionSpecValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ConnectionSpecValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ConnectionSpecValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ConnectionSpecValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ConnectionSpecValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ConnectionSpecValidationError) ErrorName() string { return "ConnectionSpecValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ConnectionSpecValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sConnectionSpec.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ConnectionSpecValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ConnectionSpecValidationError{}
+
+// Validate checks the field values on TransformerSpec with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *TransformerSpec) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on TransformerSpec with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// TransformerSpecMultiError, or nil if none found.
+func (m *TransformerSpec) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *TransformerSpec) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Name
+
+	// no validation rules for Description
+
+	// no validation rules for Source
+
+	if all {
+		switch v := interface{}(m.GetConfig()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, TransformerSpecValidationError{
+					field:  "Config",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, TransformerSpecValidationError{
+					field:  "Config",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetConfig()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return TransformerSpecValidationError{
+				field:  "Config",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return TransformerSpecMultiError(errors)
+	}
+
+	return nil
+}
+
+// TransformerSpecMultiError is an error wrapping multiple validation errors
+// returned by TransformerSpec.ValidateAll() if the designated constraints
+// aren't met.
+type TransformerSpecMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TransformerSpecMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TransformerSpecMultiError) AllErrors() []error { return m }
+
+// TransformerSpecValidationError is the validation error returned by
+// TransformerSpec.Validate if the designated constraints aren't met.
+type TransformerSpecValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TransformerSpecValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TransformerSpecValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TransformerSpecValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TransformerSpecValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TransformerSpecValidationError) ErrorName() string { return "TransformerSpecValidationError" }
+
+// Error satisfies the builtin error interface
+func (e TransformerSpecValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTransformerSpec.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TransformerSpecValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TransformerSpecValidationError{}
+
+// Validate checks the field values on JobSpec with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *JobSpec) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on JobSpec with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in JobSpecMultiError, or nil if none found.
+func (m *JobSpec) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *JobSpec) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Name
+
+	if all {
+		switch v := interface{}(m.GetSource()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, JobSpecValidationError{
+					field:  "Source",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, JobSpecValidationError{
+					field:  "Source",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSource()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return JobSpecValidationError{
+				field:  "Source",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	for idx, item := range m.GetDestinations() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, JobSpecValidationError{
+						field:  fmt.Sprintf("Destinations[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, JobSpecValidationError{
+						field:  fmt.Sprintf("Destinations[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return JobSpecValidationError{
+					field:  fmt.Sprintf("Destinations[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetMappings() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, JobSpecValidationError{
+						field:  fmt.Sprintf("Mappings[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, JobSpecValidationError{
+						field:  fmt.Sprintf("Mappings[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return JobSpecValidationError{
+					field:  fmt.Sprintf("Mappings[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if all {
+		switch v := interface{}(m.GetWorkflowOptions()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, JobSpecValidationError{
+					field:  "WorkflowOptions",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, JobSpecValidationError{
+					field:  "WorkflowOptions",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetWorkflowOptions()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return JobSpecValidationError{
+				field:  "WorkflowOptions",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetSyncOptions()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, JobSpecValidationError{
+					field:  "SyncOptions",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, JobSpecValidationError{
+					field:  "SyncOptions",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSyncOptions()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return JobSpecValidationError{
+				field:  "SyncOptions",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	for idx, item := range m.GetTriggers() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, JobSpecValidationError{
+						field:  fmt.Sprintf("Triggers[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, JobSpecValidationError{
+						field:  fmt.Sprintf("Triggers[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return JobSpecValidationError{
+					field:  fmt.Sprintf("Triggers[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if m.CronSchedule != nil {
+		// no validation rules for CronSchedule
+	}
+
+	if len(errors) > 0 {
+		return JobSpecMultiError(errors)
+	}
+
+	return nil
+}
+
+// JobSpecMultiError is an error wrapping multiple validation errors returned
+// by JobSpec.ValidateAll() if the designated constraints aren't met.
+type JobSpecMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m JobSpecMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m JobSpecMultiError) AllErrors() []error { return m }
+
+// JobSpecValidationError is the validation error returned by JobSpec.Validate
+// if the designated constraints aren't met.
+type JobSpecValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e JobSpecValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e JobSpecValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e JobSpecValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e JobSpecValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e JobSpecValidationError) ErrorName() string { return "JobSpecValidationError" }
+
+// Error satisfies the builtin error interface
+func (e JobSpecValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sJobSpec.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = JobSpecValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = JobSpecValidationError{}
+
+// Validate checks the field values on AccountSpecChange with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *AccountSpecChange) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AccountSpecChange with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AccountSpecChangeMultiError, or nil if none found.
+func (m *AccountSpecChange) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AccountSpecChange) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ResourceType
+
+	// no validation rules for Name
+
+	// no validation rules for Action
+
+	if m.Id != nil {
+		// no validation rules for Id
+	}
+
+	if len(errors) > 0 {
+		return AccountSpecChangeMultiError(errors)
+	}
+
+	return nil
+}
+
+// AccountSpecChangeMultiError is an error wrapping multiple validation errors
+// returned by AccountSpecChange.ValidateAll() if the designated constraints
+// aren't met.
+type AccountSpecChangeMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AccountSpecChangeMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AccountSpecChangeMultiError) AllErrors() []error { return m }
+
+// AccountSpecChangeValidationError is the validation error returned by
+// AccountSpecChange.Validate if the designated constraints aren't met.
+type AccountSpecChangeValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AccountSpecChangeValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AccountSpecChangeValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AccountSpecChangeValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AccountSpecChangeValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AccountSpecChangeValidationError) ErrorName() string {
+	return "AccountSpecChangeValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AccountSpecChangeValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAccountSpecChange.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AccountSpecChangeValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AccountSpecChangeValidationError{}
+
+// Validate checks the field values on ApplyAccountSpecRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ApplyAccountSpecRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ApplyAccountSpecRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ApplyAccountSpecRequestMultiError, or nil if none found.
+func (m *ApplyAccountSpecRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ApplyAccountSpecRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for AccountId
+
+	if all {
+		switch v := interface{}(m.GetSpec()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ApplyAccountSpecRequestValidationError{
+					field:  "Spec",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ApplyAccountSpecRequestValidationError{
+					field:  "Spec",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSpec()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ApplyAccountSpecRequestValidationError{
+				field:  "Spec",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for DryRun
+
+	if len(errors) > 0 {
+		return ApplyAccountSpecRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ApplyAccountSpecRequestMultiError is an error wrapping multiple validation
+// errors returned by ApplyAccountSpecRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ApplyAccountSpecRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ApplyAccountSpecRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ApplyAccountSpecRequestMultiError) AllErrors() []error { return m }
+
+// ApplyAccountSpecRequestValidationError is the validation error returned by
+// ApplyAccountSpecRequest.Validate if the designated constraints aren't met.
+type ApplyAccountSpecRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ApplyAccountSpecRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ApplyAccountSpecRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ApplyAccountSpecRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ApplyAccountSpecRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ApplyAccountSpecRequestValidationError) ErrorName() string {
+	return "ApplyAccountSpecRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ApplyAccountSpecRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sApplyAccountSpecRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ApplyAccountSpecRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ApplyAccountSpecRequestValidationError{}
+
+// Validate checks the field values on ApplyAccountSpecResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ApplyAccountSpecResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ApplyAccountSpecResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ApplyAccountSpecResponseMultiError, or nil if none found.
+func (m *ApplyAccountSpecResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ApplyAccountSpecResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetChanges() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ApplyAccountSpecResponseValidationError{
+						field:  fmt.Sprintf("Changes[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ApplyAccountSpecResponseValidationError{
+						field:  fmt.Sprintf("Changes[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ApplyAccountSpecResponseValidationError{
+					field:  fmt.Sprintf("Changes[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ApplyAccountSpecResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ApplyAccountSpecResponseMultiError is an error wrapping multiple validation
+// errors returned by ApplyAccountSpecResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ApplyAccountSpecResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ApplyAccountSpecResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ApplyAccountSpecResponseMultiError) AllErrors() []error { return m }
+
+// ApplyAccountSpecResponseValidationError is the validation error returned by
+// ApplyAccountSpecResponse.Validate if the designated constraints aren't met.
+type ApplyAccountSpecResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ApplyAccountSpecResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ApplyAccountSpecResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ApplyAccountSpecResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ApplyAccountSpecResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ApplyAccountSpecResponseValidationError) ErrorName() string {
+	return "ApplyAccountSpecResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ApplyAccountSpecResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sApplyAccountSpecResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ApplyAccountSpecResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ApplyAccountSpecResponseValidationError{}
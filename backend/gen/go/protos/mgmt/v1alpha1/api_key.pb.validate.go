@@ -90,6 +90,35 @@ func (m *CreateAccountApiKeyRequest) validate(all bool) error {
 		}
 	}
 
+	if all {
+		switch v := interface{}(m.GetScopes()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateAccountApiKeyRequestValidationError{
+					field:  "Scopes",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateAccountApiKeyRequestValidationError{
+					field:  "Scopes",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetScopes()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateAccountApiKeyRequestValidationError{
+				field:  "Scopes",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
 	if len(errors) > 0 {
 		return CreateAccountApiKeyRequestMultiError(errors)
 	}
@@ -423,6 +452,64 @@ func (m *AccountApiKey) validate(all bool) error {
 		}
 	}
 
+	if all {
+		switch v := interface{}(m.GetScopes()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AccountApiKeyValidationError{
+					field:  "Scopes",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AccountApiKeyValidationError{
+					field:  "Scopes",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetScopes()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AccountApiKeyValidationError{
+				field:  "Scopes",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetLastUsedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AccountApiKeyValidationError{
+					field:  "LastUsedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AccountApiKeyValidationError{
+					field:  "LastUsedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetLastUsedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AccountApiKeyValidationError{
+				field:  "LastUsedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
 	if m.KeyValue != nil {
 		// no validation rules for KeyValue
 	}
@@ -505,6 +592,110 @@ var _ interface {
 	ErrorName() string
 } = AccountApiKeyValidationError{}
 
+// Validate checks the field values on AccountApiKeyScopes with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AccountApiKeyScopes) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AccountApiKeyScopes with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AccountApiKeyScopesMultiError, or nil if none found.
+func (m *AccountApiKeyScopes) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AccountApiKeyScopes) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ReadOnly
+
+	if len(errors) > 0 {
+		return AccountApiKeyScopesMultiError(errors)
+	}
+
+	return nil
+}
+
+// AccountApiKeyScopesMultiError is an error wrapping multiple validation
+// errors returned by AccountApiKeyScopes.ValidateAll() if the designated
+// constraints aren't met.
+type AccountApiKeyScopesMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AccountApiKeyScopesMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AccountApiKeyScopesMultiError) AllErrors() []error { return m }
+
+// AccountApiKeyScopesValidationError is the validation error returned by
+// AccountApiKeyScopes.Validate if the designated constraints aren't met.
+type AccountApiKeyScopesValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AccountApiKeyScopesValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AccountApiKeyScopesValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AccountApiKeyScopesValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AccountApiKeyScopesValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AccountApiKeyScopesValidationError) ErrorName() string {
+	return "AccountApiKeyScopesValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AccountApiKeyScopesValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAccountApiKeyScopes.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AccountApiKeyScopesValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AccountApiKeyScopesValidationError{}
+
 // Validate checks the field values on GetAccountApiKeysRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
@@ -1438,6 +1438,40 @@ func (m *CheckConnectionConfigResponse) validate(all bool) error {
 
 	}
 
+	for idx, item := range m.GetSecretReferenceErrors() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, CheckConnectionConfigResponseValidationError{
+						field:  fmt.Sprintf("SecretReferenceErrors[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, CheckConnectionConfigResponseValidationError{
+						field:  fmt.Sprintf("SecretReferenceErrors[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return CheckConnectionConfigResponseValidationError{
+					field:  fmt.Sprintf("SecretReferenceErrors[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
 	if m.ConnectionError != nil {
 		// no validation rules for ConnectionError
 	}
@@ -1523,6 +1557,114 @@ var _ interface {
 	ErrorName() string
 } = CheckConnectionConfigResponseValidationError{}
 
+// Validate checks the field values on SecretReferenceError with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SecretReferenceError) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SecretReferenceError with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SecretReferenceErrorMultiError, or nil if none found.
+func (m *SecretReferenceError) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SecretReferenceError) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Field
+
+	// no validation rules for Reference
+
+	// no validation rules for Error
+
+	if len(errors) > 0 {
+		return SecretReferenceErrorMultiError(errors)
+	}
+
+	return nil
+}
+
+// SecretReferenceErrorMultiError is an error wrapping multiple validation
+// errors returned by SecretReferenceError.ValidateAll() if the designated
+// constraints aren't met.
+type SecretReferenceErrorMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SecretReferenceErrorMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SecretReferenceErrorMultiError) AllErrors() []error { return m }
+
+// SecretReferenceErrorValidationError is the validation error returned by
+// SecretReferenceError.Validate if the designated constraints aren't met.
+type SecretReferenceErrorValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SecretReferenceErrorValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SecretReferenceErrorValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SecretReferenceErrorValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SecretReferenceErrorValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SecretReferenceErrorValidationError) ErrorName() string {
+	return "SecretReferenceErrorValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SecretReferenceErrorValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSecretReferenceError.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SecretReferenceErrorValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SecretReferenceErrorValidationError{}
+
 // Validate checks the field values on ConnectionRolePrivilege with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
@@ -2014,6 +2156,88 @@ func (m *ConnectionConfig) validate(all bool) error {
 			}
 		}
 
+	case *ConnectionConfig_MssqlConfig:
+		if v == nil {
+			err := ConnectionConfigValidationError{
+				field:  "Config",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetMssqlConfig()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ConnectionConfigValidationError{
+						field:  "MssqlConfig",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ConnectionConfigValidationError{
+						field:  "MssqlConfig",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetMssqlConfig()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ConnectionConfigValidationError{
+					field:  "MssqlConfig",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *ConnectionConfig_SqliteConfig:
+		if v == nil {
+			err := ConnectionConfigValidationError{
+				field:  "Config",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetSqliteConfig()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ConnectionConfigValidationError{
+						field:  "SqliteConfig",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ConnectionConfigValidationError{
+						field:  "SqliteConfig",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetSqliteConfig()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ConnectionConfigValidationError{
+					field:  "SqliteConfig",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
 	default:
 		_ = v // ensures v is used
 	}
@@ -3342,6 +3566,409 @@ var _ interface {
 	ErrorName() string
 } = MysqlConnectionConfigValidationError{}
 
+// Validate checks the field values on MssqlConnection with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *MssqlConnection) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on MssqlConnection with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// MssqlConnectionMultiError, or nil if none found.
+func (m *MssqlConnection) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *MssqlConnection) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Host
+
+	// no validation rules for Port
+
+	// no validation rules for Name
+
+	// no validation rules for User
+
+	// no validation rules for Pass
+
+	if len(errors) > 0 {
+		return MssqlConnectionMultiError(errors)
+	}
+
+	return nil
+}
+
+// MssqlConnectionMultiError is an error wrapping multiple validation errors
+// returned by MssqlConnection.ValidateAll() if the designated constraints
+// aren't met.
+type MssqlConnectionMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MssqlConnectionMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m MssqlConnectionMultiError) AllErrors() []error { return m }
+
+// MssqlConnectionValidationError is the validation error returned by
+// MssqlConnection.Validate if the designated constraints aren't met.
+type MssqlConnectionValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MssqlConnectionValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MssqlConnectionValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MssqlConnectionValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MssqlConnectionValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MssqlConnectionValidationError) ErrorName() string { return "MssqlConnectionValidationError" }
+
+// Error satisfies the builtin error interface
+func (e MssqlConnectionValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMssqlConnection.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MssqlConnectionValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MssqlConnectionValidationError{}
+
+// Validate checks the field values on MssqlConnectionConfig with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *MssqlConnectionConfig) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on MssqlConnectionConfig with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// MssqlConnectionConfigMultiError, or nil if none found.
+func (m *MssqlConnectionConfig) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *MssqlConnectionConfig) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetTunnel()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, MssqlConnectionConfigValidationError{
+					field:  "Tunnel",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, MssqlConnectionConfigValidationError{
+					field:  "Tunnel",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetTunnel()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return MssqlConnectionConfigValidationError{
+				field:  "Tunnel",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	switch v := m.ConnectionConfig.(type) {
+	case *MssqlConnectionConfig_Url:
+		if v == nil {
+			err := MssqlConnectionConfigValidationError{
+				field:  "ConnectionConfig",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+		// no validation rules for Url
+	case *MssqlConnectionConfig_Connection:
+		if v == nil {
+			err := MssqlConnectionConfigValidationError{
+				field:  "ConnectionConfig",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetConnection()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, MssqlConnectionConfigValidationError{
+						field:  "Connection",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, MssqlConnectionConfigValidationError{
+						field:  "Connection",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetConnection()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return MssqlConnectionConfigValidationError{
+					field:  "Connection",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	default:
+		_ = v // ensures v is used
+	}
+
+	if len(errors) > 0 {
+		return MssqlConnectionConfigMultiError(errors)
+	}
+
+	return nil
+}
+
+// MssqlConnectionConfigMultiError is an error wrapping multiple validation
+// errors returned by MssqlConnectionConfig.ValidateAll() if the designated
+// constraints aren't met.
+type MssqlConnectionConfigMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MssqlConnectionConfigMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m MssqlConnectionConfigMultiError) AllErrors() []error { return m }
+
+// MssqlConnectionConfigValidationError is the validation error returned by
+// MssqlConnectionConfig.Validate if the designated constraints aren't met.
+type MssqlConnectionConfigValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MssqlConnectionConfigValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MssqlConnectionConfigValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MssqlConnectionConfigValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MssqlConnectionConfigValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MssqlConnectionConfigValidationError) ErrorName() string {
+	return "MssqlConnectionConfigValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e MssqlConnectionConfigValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMssqlConnectionConfig.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MssqlConnectionConfigValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MssqlConnectionConfigValidationError{}
+
+// Validate checks the field values on SqliteConnectionConfig with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SqliteConnectionConfig) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SqliteConnectionConfig with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SqliteConnectionConfigMultiError, or nil if none found.
+func (m *SqliteConnectionConfig) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SqliteConnectionConfig) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Path
+
+	if len(errors) > 0 {
+		return SqliteConnectionConfigMultiError(errors)
+	}
+
+	return nil
+}
+
+// SqliteConnectionConfigMultiError is an error wrapping multiple validation
+// errors returned by SqliteConnectionConfig.ValidateAll() if the designated
+// constraints aren't met.
+type SqliteConnectionConfigMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SqliteConnectionConfigMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SqliteConnectionConfigMultiError) AllErrors() []error { return m }
+
+// SqliteConnectionConfigValidationError is the validation error returned by
+// SqliteConnectionConfig.Validate if the designated constraints aren't met.
+type SqliteConnectionConfigValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SqliteConnectionConfigValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SqliteConnectionConfigValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SqliteConnectionConfigValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SqliteConnectionConfigValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SqliteConnectionConfigValidationError) ErrorName() string {
+	return "SqliteConnectionConfigValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SqliteConnectionConfigValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSqliteConnectionConfig.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SqliteConnectionConfigValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SqliteConnectionConfigValidationError{}
+
 // Validate checks the field values on AwsS3ConnectionConfig with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
@@ -239,6 +239,108 @@ var _ interface {
 	ErrorName() string
 } = MysqlStreamConfigValidationError{}
 
+// Validate checks the field values on MssqlStreamConfig with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *MssqlStreamConfig) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on MssqlStreamConfig with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// MssqlStreamConfigMultiError, or nil if none found.
+func (m *MssqlStreamConfig) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *MssqlStreamConfig) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return MssqlStreamConfigMultiError(errors)
+	}
+
+	return nil
+}
+
+// MssqlStreamConfigMultiError is an error wrapping multiple validation errors
+// returned by MssqlStreamConfig.ValidateAll() if the designated constraints
+// aren't met.
+type MssqlStreamConfigMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MssqlStreamConfigMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m MssqlStreamConfigMultiError) AllErrors() []error { return m }
+
+// MssqlStreamConfigValidationError is the validation error returned by
+// MssqlStreamConfig.Validate if the designated constraints aren't met.
+type MssqlStreamConfigValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MssqlStreamConfigValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MssqlStreamConfigValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MssqlStreamConfigValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MssqlStreamConfigValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MssqlStreamConfigValidationError) ErrorName() string {
+	return "MssqlStreamConfigValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e MssqlStreamConfigValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMssqlStreamConfig.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MssqlStreamConfigValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MssqlStreamConfigValidationError{}
+
 // Validate checks the field values on AwsS3StreamConfig with the rules defined
 // in the proto definition for this message. If any rules are violated, the
 // first error encountered is returned, or nil if there are no violations.
@@ -516,6 +618,47 @@ func (m *ConnectionStreamConfig) validate(all bool) error {
 			}
 		}
 
+	case *ConnectionStreamConfig_MssqlConfig:
+		if v == nil {
+			err := ConnectionStreamConfigValidationError{
+				field:  "Config",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetMssqlConfig()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ConnectionStreamConfigValidationError{
+						field:  "MssqlConfig",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ConnectionStreamConfigValidationError{
+						field:  "MssqlConfig",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetMssqlConfig()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ConnectionStreamConfigValidationError{
+					field:  "MssqlConfig",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
 	default:
 		_ = v // ensures v is used
 	}
@@ -1047,71 +1190,42 @@ var _ interface {
 	ErrorName() string
 } = MysqlSchemaConfigValidationError{}
 
-// Validate checks the field values on AwsS3SchemaConfig with the rules defined
+// Validate checks the field values on MssqlSchemaConfig with the rules defined
 // in the proto definition for this message. If any rules are violated, the
 // first error encountered is returned, or nil if there are no violations.
-func (m *AwsS3SchemaConfig) Validate() error {
+func (m *MssqlSchemaConfig) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AwsS3SchemaConfig with the rules
+// ValidateAll checks the field values on MssqlSchemaConfig with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// AwsS3SchemaConfigMultiError, or nil if none found.
-func (m *AwsS3SchemaConfig) ValidateAll() error {
+// MssqlSchemaConfigMultiError, or nil if none found.
+func (m *MssqlSchemaConfig) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AwsS3SchemaConfig) validate(all bool) error {
+func (m *MssqlSchemaConfig) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	switch v := m.Id.(type) {
-	case *AwsS3SchemaConfig_JobId:
-		if v == nil {
-			err := AwsS3SchemaConfigValidationError{
-				field:  "Id",
-				reason: "oneof value cannot be a typed-nil",
-			}
-			if !all {
-				return err
-			}
-			errors = append(errors, err)
-		}
-		// no validation rules for JobId
-	case *AwsS3SchemaConfig_JobRunId:
-		if v == nil {
-			err := AwsS3SchemaConfigValidationError{
-				field:  "Id",
-				reason: "oneof value cannot be a typed-nil",
-			}
-			if !all {
-				return err
-			}
-			errors = append(errors, err)
-		}
-		// no validation rules for JobRunId
-	default:
-		_ = v // ensures v is used
-	}
-
 	if len(errors) > 0 {
-		return AwsS3SchemaConfigMultiError(errors)
+		return MssqlSchemaConfigMultiError(errors)
 	}
 
 	return nil
 }
 
-// AwsS3SchemaConfigMultiError is an error wrapping multiple validation errors
-// returned by AwsS3SchemaConfig.ValidateAll() if the designated constraints
+// MssqlSchemaConfigMultiError is an error wrapping multiple validation errors
+// returned by MssqlSchemaConfig.ValidateAll() if the designated constraints
 // aren't met.
-type AwsS3SchemaConfigMultiError []error
+type MssqlSchemaConfigMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AwsS3SchemaConfigMultiError) Error() string {
+func (m MssqlSchemaConfigMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1120,11 +1234,11 @@ func (m AwsS3SchemaConfigMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AwsS3SchemaConfigMultiError) AllErrors() []error { return m }
+func (m MssqlSchemaConfigMultiError) AllErrors() []error { return m }
 
-// AwsS3SchemaConfigValidationError is the validation error returned by
-// AwsS3SchemaConfig.Validate if the designated constraints aren't met.
-type AwsS3SchemaConfigValidationError struct {
+// MssqlSchemaConfigValidationError is the validation error returned by
+// MssqlSchemaConfig.Validate if the designated constraints aren't met.
+type MssqlSchemaConfigValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1132,24 +1246,24 @@ type AwsS3SchemaConfigValidationError struct {
 }
 
 // Field function returns field value.
-func (e AwsS3SchemaConfigValidationError) Field() string { return e.field }
+func (e MssqlSchemaConfigValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AwsS3SchemaConfigValidationError) Reason() string { return e.reason }
+func (e MssqlSchemaConfigValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AwsS3SchemaConfigValidationError) Cause() error { return e.cause }
+func (e MssqlSchemaConfigValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AwsS3SchemaConfigValidationError) Key() bool { return e.key }
+func (e MssqlSchemaConfigValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AwsS3SchemaConfigValidationError) ErrorName() string {
-	return "AwsS3SchemaConfigValidationError"
+func (e MssqlSchemaConfigValidationError) ErrorName() string {
+	return "MssqlSchemaConfigValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e AwsS3SchemaConfigValidationError) Error() string {
+func (e MssqlSchemaConfigValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1161,14 +1275,14 @@ func (e AwsS3SchemaConfigValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAwsS3SchemaConfig.%s: %s%s",
+		"invalid %sMssqlSchemaConfig.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AwsS3SchemaConfigValidationError{}
+var _ error = MssqlSchemaConfigValidationError{}
 
 var _ interface {
 	Field() string
@@ -1176,172 +1290,866 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AwsS3SchemaConfigValidationError{}
+} = MssqlSchemaConfigValidationError{}
 
-// Validate checks the field values on ConnectionSchemaConfig with the rules
+// Validate checks the field values on SqliteSchemaConfig with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ConnectionSchemaConfig) Validate() error {
+func (m *SqliteSchemaConfig) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ConnectionSchemaConfig with the rules
+// ValidateAll checks the field values on SqliteSchemaConfig with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// ConnectionSchemaConfigMultiError, or nil if none found.
-func (m *ConnectionSchemaConfig) ValidateAll() error {
+// SqliteSchemaConfigMultiError, or nil if none found.
+func (m *SqliteSchemaConfig) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ConnectionSchemaConfig) validate(all bool) error {
+func (m *SqliteSchemaConfig) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	switch v := m.Config.(type) {
-	case *ConnectionSchemaConfig_PgConfig:
-		if v == nil {
-			err := ConnectionSchemaConfigValidationError{
-				field:  "Config",
-				reason: "oneof value cannot be a typed-nil",
-			}
-			if !all {
-				return err
-			}
-			errors = append(errors, err)
-		}
+	if len(errors) > 0 {
+		return SqliteSchemaConfigMultiError(errors)
+	}
 
-		if all {
-			switch v := interface{}(m.GetPgConfig()).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, ConnectionSchemaConfigValidationError{
-						field:  "PgConfig",
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, ConnectionSchemaConfigValidationError{
-						field:  "PgConfig",
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(m.GetPgConfig()).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return ConnectionSchemaConfigValidationError{
-					field:  "PgConfig",
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
+	return nil
+}
 
-	case *ConnectionSchemaConfig_AwsS3Config:
-		if v == nil {
-			err := ConnectionSchemaConfigValidationError{
-				field:  "Config",
-				reason: "oneof value cannot be a typed-nil",
-			}
-			if !all {
-				return err
-			}
-			errors = append(errors, err)
-		}
+// SqliteSchemaConfigMultiError is an error wrapping multiple validation errors
+// returned by SqliteSchemaConfig.ValidateAll() if the designated constraints
+// aren't met.
+type SqliteSchemaConfigMultiError []error
 
-		if all {
-			switch v := interface{}(m.GetAwsS3Config()).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, ConnectionSchemaConfigValidationError{
-						field:  "AwsS3Config",
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, ConnectionSchemaConfigValidationError{
-						field:  "AwsS3Config",
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(m.GetAwsS3Config()).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return ConnectionSchemaConfigValidationError{
-					field:  "AwsS3Config",
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
+// Error returns a concatenation of all the error messages it wraps.
+func (m SqliteSchemaConfigMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SqliteSchemaConfigMultiError) AllErrors() []error { return m }
+
+// SqliteSchemaConfigValidationError is the validation error returned by
+// SqliteSchemaConfig.Validate if the designated constraints aren't met.
+type SqliteSchemaConfigValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SqliteSchemaConfigValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SqliteSchemaConfigValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SqliteSchemaConfigValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SqliteSchemaConfigValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SqliteSchemaConfigValidationError) ErrorName() string {
+	return "SqliteSchemaConfigValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SqliteSchemaConfigValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSqliteSchemaConfig.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SqliteSchemaConfigValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SqliteSchemaConfigValidationError{}
+
+// Validate checks the field values on AwsS3SchemaConfig with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *AwsS3SchemaConfig) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AwsS3SchemaConfig with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AwsS3SchemaConfigMultiError, or nil if none found.
+func (m *AwsS3SchemaConfig) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AwsS3SchemaConfig) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	switch v := m.Id.(type) {
+	case *AwsS3SchemaConfig_JobId:
+		if v == nil {
+			err := AwsS3SchemaConfigValidationError{
+				field:  "Id",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+		// no validation rules for JobId
+	case *AwsS3SchemaConfig_JobRunId:
+		if v == nil {
+			err := AwsS3SchemaConfigValidationError{
+				field:  "Id",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+		// no validation rules for JobRunId
+	default:
+		_ = v // ensures v is used
+	}
+
+	if len(errors) > 0 {
+		return AwsS3SchemaConfigMultiError(errors)
+	}
+
+	return nil
+}
+
+// AwsS3SchemaConfigMultiError is an error wrapping multiple validation errors
+// returned by AwsS3SchemaConfig.ValidateAll() if the designated constraints
+// aren't met.
+type AwsS3SchemaConfigMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AwsS3SchemaConfigMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AwsS3SchemaConfigMultiError) AllErrors() []error { return m }
+
+// AwsS3SchemaConfigValidationError is the validation error returned by
+// AwsS3SchemaConfig.Validate if the designated constraints aren't met.
+type AwsS3SchemaConfigValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AwsS3SchemaConfigValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AwsS3SchemaConfigValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AwsS3SchemaConfigValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AwsS3SchemaConfigValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AwsS3SchemaConfigValidationError) ErrorName() string {
+	return "AwsS3SchemaConfigValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AwsS3SchemaConfigValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAwsS3SchemaConfig.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AwsS3SchemaConfigValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AwsS3SchemaConfigValidationError{}
+
+// Validate checks the field values on ConnectionSchemaConfig with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ConnectionSchemaConfig) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ConnectionSchemaConfig with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ConnectionSchemaConfigMultiError, or nil if none found.
+func (m *ConnectionSchemaConfig) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ConnectionSchemaConfig) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	switch v := m.Config.(type) {
+	case *ConnectionSchemaConfig_PgConfig:
+		if v == nil {
+			err := ConnectionSchemaConfigValidationError{
+				field:  "Config",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetPgConfig()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ConnectionSchemaConfigValidationError{
+						field:  "PgConfig",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ConnectionSchemaConfigValidationError{
+						field:  "PgConfig",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetPgConfig()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ConnectionSchemaConfigValidationError{
+					field:  "PgConfig",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *ConnectionSchemaConfig_AwsS3Config:
+		if v == nil {
+			err := ConnectionSchemaConfigValidationError{
+				field:  "Config",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetAwsS3Config()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ConnectionSchemaConfigValidationError{
+						field:  "AwsS3Config",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ConnectionSchemaConfigValidationError{
+						field:  "AwsS3Config",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetAwsS3Config()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ConnectionSchemaConfigValidationError{
+					field:  "AwsS3Config",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *ConnectionSchemaConfig_MysqlConfig:
+		if v == nil {
+			err := ConnectionSchemaConfigValidationError{
+				field:  "Config",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetMysqlConfig()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ConnectionSchemaConfigValidationError{
+						field:  "MysqlConfig",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ConnectionSchemaConfigValidationError{
+						field:  "MysqlConfig",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetMysqlConfig()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ConnectionSchemaConfigValidationError{
+					field:  "MysqlConfig",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *ConnectionSchemaConfig_MssqlConfig:
+		if v == nil {
+			err := ConnectionSchemaConfigValidationError{
+				field:  "Config",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetMssqlConfig()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ConnectionSchemaConfigValidationError{
+						field:  "MssqlConfig",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ConnectionSchemaConfigValidationError{
+						field:  "MssqlConfig",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetMssqlConfig()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ConnectionSchemaConfigValidationError{
+					field:  "MssqlConfig",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *ConnectionSchemaConfig_SqliteConfig:
+		if v == nil {
+			err := ConnectionSchemaConfigValidationError{
+				field:  "Config",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetSqliteConfig()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ConnectionSchemaConfigValidationError{
+						field:  "SqliteConfig",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ConnectionSchemaConfigValidationError{
+						field:  "SqliteConfig",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetSqliteConfig()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ConnectionSchemaConfigValidationError{
+					field:  "SqliteConfig",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	default:
+		_ = v // ensures v is used
+	}
+
+	if len(errors) > 0 {
+		return ConnectionSchemaConfigMultiError(errors)
+	}
+
+	return nil
+}
+
+// ConnectionSchemaConfigMultiError is an error wrapping multiple validation
+// errors returned by ConnectionSchemaConfig.ValidateAll() if the designated
+// constraints aren't met.
+type ConnectionSchemaConfigMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ConnectionSchemaConfigMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ConnectionSchemaConfigMultiError) AllErrors() []error { return m }
+
+// ConnectionSchemaConfigValidationError is the validation error returned by
+// ConnectionSchemaConfig.Validate if the designated constraints aren't met.
+type ConnectionSchemaConfigValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ConnectionSchemaConfigValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ConnectionSchemaConfigValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ConnectionSchemaConfigValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ConnectionSchemaConfigValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ConnectionSchemaConfigValidationError) ErrorName() string {
+	return "ConnectionSchemaConfigValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ConnectionSchemaConfigValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sConnectionSchemaConfig.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ConnectionSchemaConfigValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ConnectionSchemaConfigValidationError{}
+
+// Validate checks the field values on DatabaseColumn with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *DatabaseColumn) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DatabaseColumn with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in DatabaseColumnMultiError,
+// or nil if none found.
+func (m *DatabaseColumn) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DatabaseColumn) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Schema
+
+	// no validation rules for Table
+
+	// no validation rules for Column
+
+	// no validation rules for DataType
+
+	// no validation rules for IsNullable
+
+	if len(errors) > 0 {
+		return DatabaseColumnMultiError(errors)
+	}
+
+	return nil
+}
+
+// DatabaseColumnMultiError is an error wrapping multiple validation errors
+// returned by DatabaseColumn.ValidateAll() if the designated constraints
+// aren't met.
+type DatabaseColumnMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DatabaseColumnMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DatabaseColumnMultiError) AllErrors() []error { return m }
+
+// DatabaseColumnValidationError is the validation error returned by
+// DatabaseColumn.Validate if the designated constraints aren't met.
+type DatabaseColumnValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DatabaseColumnValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DatabaseColumnValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DatabaseColumnValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DatabaseColumnValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DatabaseColumnValidationError) ErrorName() string { return "DatabaseColumnValidationError" }
+
+// Error satisfies the builtin error interface
+func (e DatabaseColumnValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDatabaseColumn.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
 
-	case *ConnectionSchemaConfig_MysqlConfig:
-		if v == nil {
-			err := ConnectionSchemaConfigValidationError{
-				field:  "Config",
-				reason: "oneof value cannot be a typed-nil",
+var _ error = DatabaseColumnValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DatabaseColumnValidationError{}
+
+// Validate checks the field values on GetConnectionSchemaRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetConnectionSchemaRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetConnectionSchemaRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetConnectionSchemaRequestMultiError, or nil if none found.
+func (m *GetConnectionSchemaRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetConnectionSchemaRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ConnectionId
+
+	if all {
+		switch v := interface{}(m.GetSchemaConfig()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetConnectionSchemaRequestValidationError{
+					field:  "SchemaConfig",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
 			}
-			if !all {
-				return err
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetConnectionSchemaRequestValidationError{
+					field:  "SchemaConfig",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSchemaConfig()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetConnectionSchemaRequestValidationError{
+				field:  "SchemaConfig",
+				reason: "embedded message failed validation",
+				cause:  err,
 			}
-			errors = append(errors, err)
 		}
+	}
+
+	if len(errors) > 0 {
+		return GetConnectionSchemaRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetConnectionSchemaRequestMultiError is an error wrapping multiple
+// validation errors returned by GetConnectionSchemaRequest.ValidateAll() if
+// the designated constraints aren't met.
+type GetConnectionSchemaRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetConnectionSchemaRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetConnectionSchemaRequestMultiError) AllErrors() []error { return m }
+
+// GetConnectionSchemaRequestValidationError is the validation error returned
+// by GetConnectionSchemaRequest.Validate if the designated constraints aren't met.
+type GetConnectionSchemaRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetConnectionSchemaRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetConnectionSchemaRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetConnectionSchemaRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetConnectionSchemaRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetConnectionSchemaRequestValidationError) ErrorName() string {
+	return "GetConnectionSchemaRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetConnectionSchemaRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetConnectionSchemaRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetConnectionSchemaRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetConnectionSchemaRequestValidationError{}
+
+// Validate checks the field values on GetConnectionSchemaResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetConnectionSchemaResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetConnectionSchemaResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetConnectionSchemaResponseMultiError, or nil if none found.
+func (m *GetConnectionSchemaResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetConnectionSchemaResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetSchemas() {
+		_, _ = idx, item
 
 		if all {
-			switch v := interface{}(m.GetMysqlConfig()).(type) {
+			switch v := interface{}(item).(type) {
 			case interface{ ValidateAll() error }:
 				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, ConnectionSchemaConfigValidationError{
-						field:  "MysqlConfig",
+					errors = append(errors, GetConnectionSchemaResponseValidationError{
+						field:  fmt.Sprintf("Schemas[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			case interface{ Validate() error }:
 				if err := v.Validate(); err != nil {
-					errors = append(errors, ConnectionSchemaConfigValidationError{
-						field:  "MysqlConfig",
+					errors = append(errors, GetConnectionSchemaResponseValidationError{
+						field:  fmt.Sprintf("Schemas[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			}
-		} else if v, ok := interface{}(m.GetMysqlConfig()).(interface{ Validate() error }); ok {
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				return ConnectionSchemaConfigValidationError{
-					field:  "MysqlConfig",
+				return GetConnectionSchemaResponseValidationError{
+					field:  fmt.Sprintf("Schemas[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
 				}
 			}
 		}
 
-	default:
-		_ = v // ensures v is used
 	}
 
 	if len(errors) > 0 {
-		return ConnectionSchemaConfigMultiError(errors)
+		return GetConnectionSchemaResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// ConnectionSchemaConfigMultiError is an error wrapping multiple validation
-// errors returned by ConnectionSchemaConfig.ValidateAll() if the designated
-// constraints aren't met.
-type ConnectionSchemaConfigMultiError []error
+// GetConnectionSchemaResponseMultiError is an error wrapping multiple
+// validation errors returned by GetConnectionSchemaResponse.ValidateAll() if
+// the designated constraints aren't met.
+type GetConnectionSchemaResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ConnectionSchemaConfigMultiError) Error() string {
+func (m GetConnectionSchemaResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1350,11 +2158,12 @@ func (m ConnectionSchemaConfigMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ConnectionSchemaConfigMultiError) AllErrors() []error { return m }
+func (m GetConnectionSchemaResponseMultiError) AllErrors() []error { return m }
 
-// ConnectionSchemaConfigValidationError is the validation error returned by
-// ConnectionSchemaConfig.Validate if the designated constraints aren't met.
-type ConnectionSchemaConfigValidationError struct {
+// GetConnectionSchemaResponseValidationError is the validation error returned
+// by GetConnectionSchemaResponse.Validate if the designated constraints
+// aren't met.
+type GetConnectionSchemaResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1362,24 +2171,24 @@ type ConnectionSchemaConfigValidationError struct {
 }
 
 // Field function returns field value.
-func (e ConnectionSchemaConfigValidationError) Field() string { return e.field }
+func (e GetConnectionSchemaResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ConnectionSchemaConfigValidationError) Reason() string { return e.reason }
+func (e GetConnectionSchemaResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ConnectionSchemaConfigValidationError) Cause() error { return e.cause }
+func (e GetConnectionSchemaResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ConnectionSchemaConfigValidationError) Key() bool { return e.key }
+func (e GetConnectionSchemaResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ConnectionSchemaConfigValidationError) ErrorName() string {
-	return "ConnectionSchemaConfigValidationError"
+func (e GetConnectionSchemaResponseValidationError) ErrorName() string {
+	return "GetConnectionSchemaResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ConnectionSchemaConfigValidationError) Error() string {
+func (e GetConnectionSchemaResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1391,14 +2200,14 @@ func (e ConnectionSchemaConfigValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sConnectionSchemaConfig.%s: %s%s",
+		"invalid %sGetConnectionSchemaResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ConnectionSchemaConfigValidationError{}
+var _ error = GetConnectionSchemaResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -1406,54 +2215,49 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ConnectionSchemaConfigValidationError{}
+} = GetConnectionSchemaResponseValidationError{}
 
-// Validate checks the field values on DatabaseColumn with the rules defined in
-// the proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *DatabaseColumn) Validate() error {
+// Validate checks the field values on GetConnectionForeignConstraintsRequest
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the first error encountered is returned, or nil if
+// there are no violations.
+func (m *GetConnectionForeignConstraintsRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on DatabaseColumn with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in DatabaseColumnMultiError,
-// or nil if none found.
-func (m *DatabaseColumn) ValidateAll() error {
+// ValidateAll checks the field values on
+// GetConnectionForeignConstraintsRequest with the rules defined in the proto
+// definition for this message. If any rules are violated, the result is a
+// list of violation errors wrapped in
+// GetConnectionForeignConstraintsRequestMultiError, or nil if none found.
+func (m *GetConnectionForeignConstraintsRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *DatabaseColumn) validate(all bool) error {
+func (m *GetConnectionForeignConstraintsRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Schema
-
-	// no validation rules for Table
-
-	// no validation rules for Column
-
-	// no validation rules for DataType
-
-	// no validation rules for IsNullable
+	// no validation rules for ConnectionId
 
 	if len(errors) > 0 {
-		return DatabaseColumnMultiError(errors)
+		return GetConnectionForeignConstraintsRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// DatabaseColumnMultiError is an error wrapping multiple validation errors
-// returned by DatabaseColumn.ValidateAll() if the designated constraints
-// aren't met.
-type DatabaseColumnMultiError []error
+// GetConnectionForeignConstraintsRequestMultiError is an error wrapping
+// multiple validation errors returned by
+// GetConnectionForeignConstraintsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetConnectionForeignConstraintsRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m DatabaseColumnMultiError) Error() string {
+func (m GetConnectionForeignConstraintsRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1462,11 +2266,12 @@ func (m DatabaseColumnMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m DatabaseColumnMultiError) AllErrors() []error { return m }
+func (m GetConnectionForeignConstraintsRequestMultiError) AllErrors() []error { return m }
 
-// DatabaseColumnValidationError is the validation error returned by
-// DatabaseColumn.Validate if the designated constraints aren't met.
-type DatabaseColumnValidationError struct {
+// GetConnectionForeignConstraintsRequestValidationError is the validation
+// error returned by GetConnectionForeignConstraintsRequest.Validate if the
+// designated constraints aren't met.
+type GetConnectionForeignConstraintsRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1474,22 +2279,24 @@ type DatabaseColumnValidationError struct {
 }
 
 // Field function returns field value.
-func (e DatabaseColumnValidationError) Field() string { return e.field }
+func (e GetConnectionForeignConstraintsRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e DatabaseColumnValidationError) Reason() string { return e.reason }
+func (e GetConnectionForeignConstraintsRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e DatabaseColumnValidationError) Cause() error { return e.cause }
+func (e GetConnectionForeignConstraintsRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e DatabaseColumnValidationError) Key() bool { return e.key }
+func (e GetConnectionForeignConstraintsRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e DatabaseColumnValidationError) ErrorName() string { return "DatabaseColumnValidationError" }
+func (e GetConnectionForeignConstraintsRequestValidationError) ErrorName() string {
+	return "GetConnectionForeignConstraintsRequestValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e DatabaseColumnValidationError) Error() string {
+func (e GetConnectionForeignConstraintsRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1501,14 +2308,14 @@ func (e DatabaseColumnValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sDatabaseColumn.%s: %s%s",
+		"invalid %sGetConnectionForeignConstraintsRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = DatabaseColumnValidationError{}
+var _ error = GetConnectionForeignConstraintsRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -1516,75 +2323,47 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = DatabaseColumnValidationError{}
+} = GetConnectionForeignConstraintsRequestValidationError{}
 
-// Validate checks the field values on GetConnectionSchemaRequest with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetConnectionSchemaRequest) Validate() error {
+// Validate checks the field values on ForeignKey with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ForeignKey) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetConnectionSchemaRequest with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// GetConnectionSchemaRequestMultiError, or nil if none found.
-func (m *GetConnectionSchemaRequest) ValidateAll() error {
+// ValidateAll checks the field values on ForeignKey with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ForeignKeyMultiError, or
+// nil if none found.
+func (m *ForeignKey) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetConnectionSchemaRequest) validate(all bool) error {
+func (m *ForeignKey) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for ConnectionId
+	// no validation rules for Table
 
-	if all {
-		switch v := interface{}(m.GetSchemaConfig()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, GetConnectionSchemaRequestValidationError{
-					field:  "SchemaConfig",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, GetConnectionSchemaRequestValidationError{
-					field:  "SchemaConfig",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetSchemaConfig()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return GetConnectionSchemaRequestValidationError{
-				field:  "SchemaConfig",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
+	// no validation rules for Column
 
 	if len(errors) > 0 {
-		return GetConnectionSchemaRequestMultiError(errors)
+		return ForeignKeyMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetConnectionSchemaRequestMultiError is an error wrapping multiple
-// validation errors returned by GetConnectionSchemaRequest.ValidateAll() if
-// the designated constraints aren't met.
-type GetConnectionSchemaRequestMultiError []error
+// ForeignKeyMultiError is an error wrapping multiple validation errors
+// returned by ForeignKey.ValidateAll() if the designated constraints aren't met.
+type ForeignKeyMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetConnectionSchemaRequestMultiError) Error() string {
+func (m ForeignKeyMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1593,11 +2372,11 @@ func (m GetConnectionSchemaRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetConnectionSchemaRequestMultiError) AllErrors() []error { return m }
+func (m ForeignKeyMultiError) AllErrors() []error { return m }
 
-// GetConnectionSchemaRequestValidationError is the validation error returned
-// by GetConnectionSchemaRequest.Validate if the designated constraints aren't met.
-type GetConnectionSchemaRequestValidationError struct {
+// ForeignKeyValidationError is the validation error returned by
+// ForeignKey.Validate if the designated constraints aren't met.
+type ForeignKeyValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1605,24 +2384,22 @@ type GetConnectionSchemaRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetConnectionSchemaRequestValidationError) Field() string { return e.field }
+func (e ForeignKeyValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetConnectionSchemaRequestValidationError) Reason() string { return e.reason }
+func (e ForeignKeyValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetConnectionSchemaRequestValidationError) Cause() error { return e.cause }
+func (e ForeignKeyValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetConnectionSchemaRequestValidationError) Key() bool { return e.key }
+func (e ForeignKeyValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetConnectionSchemaRequestValidationError) ErrorName() string {
-	return "GetConnectionSchemaRequestValidationError"
-}
+func (e ForeignKeyValidationError) ErrorName() string { return "ForeignKeyValidationError" }
 
 // Error satisfies the builtin error interface
-func (e GetConnectionSchemaRequestValidationError) Error() string {
+func (e ForeignKeyValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1634,14 +2411,14 @@ func (e GetConnectionSchemaRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetConnectionSchemaRequest.%s: %s%s",
+		"invalid %sForeignKey.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetConnectionSchemaRequestValidationError{}
+var _ error = ForeignKeyValidationError{}
 
 var _ interface {
 	Field() string
@@ -1649,78 +2426,77 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetConnectionSchemaRequestValidationError{}
+} = ForeignKeyValidationError{}
 
-// Validate checks the field values on GetConnectionSchemaResponse with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetConnectionSchemaResponse) Validate() error {
+// Validate checks the field values on ForeignConstraint with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ForeignConstraint) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetConnectionSchemaResponse with the
-// rules defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on ForeignConstraint with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GetConnectionSchemaResponseMultiError, or nil if none found.
-func (m *GetConnectionSchemaResponse) ValidateAll() error {
+// ForeignConstraintMultiError, or nil if none found.
+func (m *ForeignConstraint) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetConnectionSchemaResponse) validate(all bool) error {
+func (m *ForeignConstraint) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	for idx, item := range m.GetSchemas() {
-		_, _ = idx, item
+	// no validation rules for Column
 
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, GetConnectionSchemaResponseValidationError{
-						field:  fmt.Sprintf("Schemas[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, GetConnectionSchemaResponseValidationError{
-						field:  fmt.Sprintf("Schemas[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
+	// no validation rules for IsNullable
+
+	if all {
+		switch v := interface{}(m.GetForeignKey()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ForeignConstraintValidationError{
+					field:  "ForeignKey",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
 			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				return GetConnectionSchemaResponseValidationError{
-					field:  fmt.Sprintf("Schemas[%v]", idx),
+				errors = append(errors, ForeignConstraintValidationError{
+					field:  "ForeignKey",
 					reason: "embedded message failed validation",
 					cause:  err,
-				}
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetForeignKey()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ForeignConstraintValidationError{
+				field:  "ForeignKey",
+				reason: "embedded message failed validation",
+				cause:  err,
 			}
 		}
-
 	}
 
 	if len(errors) > 0 {
-		return GetConnectionSchemaResponseMultiError(errors)
+		return ForeignConstraintMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetConnectionSchemaResponseMultiError is an error wrapping multiple
-// validation errors returned by GetConnectionSchemaResponse.ValidateAll() if
-// the designated constraints aren't met.
-type GetConnectionSchemaResponseMultiError []error
+// ForeignConstraintMultiError is an error wrapping multiple validation errors
+// returned by ForeignConstraint.ValidateAll() if the designated constraints
+// aren't met.
+type ForeignConstraintMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetConnectionSchemaResponseMultiError) Error() string {
+func (m ForeignConstraintMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1729,12 +2505,11 @@ func (m GetConnectionSchemaResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetConnectionSchemaResponseMultiError) AllErrors() []error { return m }
+func (m ForeignConstraintMultiError) AllErrors() []error { return m }
 
-// GetConnectionSchemaResponseValidationError is the validation error returned
-// by GetConnectionSchemaResponse.Validate if the designated constraints
-// aren't met.
-type GetConnectionSchemaResponseValidationError struct {
+// ForeignConstraintValidationError is the validation error returned by
+// ForeignConstraint.Validate if the designated constraints aren't met.
+type ForeignConstraintValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1742,24 +2517,24 @@ type GetConnectionSchemaResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetConnectionSchemaResponseValidationError) Field() string { return e.field }
+func (e ForeignConstraintValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetConnectionSchemaResponseValidationError) Reason() string { return e.reason }
+func (e ForeignConstraintValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetConnectionSchemaResponseValidationError) Cause() error { return e.cause }
+func (e ForeignConstraintValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetConnectionSchemaResponseValidationError) Key() bool { return e.key }
+func (e ForeignConstraintValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetConnectionSchemaResponseValidationError) ErrorName() string {
-	return "GetConnectionSchemaResponseValidationError"
+func (e ForeignConstraintValidationError) ErrorName() string {
+	return "ForeignConstraintValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetConnectionSchemaResponseValidationError) Error() string {
+func (e ForeignConstraintValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1771,64 +2546,93 @@ func (e GetConnectionSchemaResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetConnectionSchemaResponse.%s: %s%s",
+		"invalid %sForeignConstraint.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetConnectionSchemaResponseValidationError{}
+var _ error = ForeignConstraintValidationError{}
 
 var _ interface {
 	Field() string
 	Reason() string
 	Key() bool
 	Cause() error
-	ErrorName() string
-} = GetConnectionSchemaResponseValidationError{}
-
-// Validate checks the field values on GetConnectionForeignConstraintsRequest
-// with the rules defined in the proto definition for this message. If any
-// rules are violated, the first error encountered is returned, or nil if
-// there are no violations.
-func (m *GetConnectionForeignConstraintsRequest) Validate() error {
+	ErrorName() string
+} = ForeignConstraintValidationError{}
+
+// Validate checks the field values on ForeignConstraintTables with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ForeignConstraintTables) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on
-// GetConnectionForeignConstraintsRequest with the rules defined in the proto
-// definition for this message. If any rules are violated, the result is a
-// list of violation errors wrapped in
-// GetConnectionForeignConstraintsRequestMultiError, or nil if none found.
-func (m *GetConnectionForeignConstraintsRequest) ValidateAll() error {
+// ValidateAll checks the field values on ForeignConstraintTables with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ForeignConstraintTablesMultiError, or nil if none found.
+func (m *ForeignConstraintTables) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetConnectionForeignConstraintsRequest) validate(all bool) error {
+func (m *ForeignConstraintTables) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for ConnectionId
+	for idx, item := range m.GetConstraints() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ForeignConstraintTablesValidationError{
+						field:  fmt.Sprintf("Constraints[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ForeignConstraintTablesValidationError{
+						field:  fmt.Sprintf("Constraints[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ForeignConstraintTablesValidationError{
+					field:  fmt.Sprintf("Constraints[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
 
 	if len(errors) > 0 {
-		return GetConnectionForeignConstraintsRequestMultiError(errors)
+		return ForeignConstraintTablesMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetConnectionForeignConstraintsRequestMultiError is an error wrapping
-// multiple validation errors returned by
-// GetConnectionForeignConstraintsRequest.ValidateAll() if the designated
+// ForeignConstraintTablesMultiError is an error wrapping multiple validation
+// errors returned by ForeignConstraintTables.ValidateAll() if the designated
 // constraints aren't met.
-type GetConnectionForeignConstraintsRequestMultiError []error
+type ForeignConstraintTablesMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetConnectionForeignConstraintsRequestMultiError) Error() string {
+func (m ForeignConstraintTablesMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1837,12 +2641,11 @@ func (m GetConnectionForeignConstraintsRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetConnectionForeignConstraintsRequestMultiError) AllErrors() []error { return m }
+func (m ForeignConstraintTablesMultiError) AllErrors() []error { return m }
 
-// GetConnectionForeignConstraintsRequestValidationError is the validation
-// error returned by GetConnectionForeignConstraintsRequest.Validate if the
-// designated constraints aren't met.
-type GetConnectionForeignConstraintsRequestValidationError struct {
+// ForeignConstraintTablesValidationError is the validation error returned by
+// ForeignConstraintTables.Validate if the designated constraints aren't met.
+type ForeignConstraintTablesValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1850,24 +2653,24 @@ type GetConnectionForeignConstraintsRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetConnectionForeignConstraintsRequestValidationError) Field() string { return e.field }
+func (e ForeignConstraintTablesValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetConnectionForeignConstraintsRequestValidationError) Reason() string { return e.reason }
+func (e ForeignConstraintTablesValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetConnectionForeignConstraintsRequestValidationError) Cause() error { return e.cause }
+func (e ForeignConstraintTablesValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetConnectionForeignConstraintsRequestValidationError) Key() bool { return e.key }
+func (e ForeignConstraintTablesValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetConnectionForeignConstraintsRequestValidationError) ErrorName() string {
-	return "GetConnectionForeignConstraintsRequestValidationError"
+func (e ForeignConstraintTablesValidationError) ErrorName() string {
+	return "ForeignConstraintTablesValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetConnectionForeignConstraintsRequestValidationError) Error() string {
+func (e ForeignConstraintTablesValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1879,14 +2682,14 @@ func (e GetConnectionForeignConstraintsRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetConnectionForeignConstraintsRequest.%s: %s%s",
+		"invalid %sForeignConstraintTables.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetConnectionForeignConstraintsRequestValidationError{}
+var _ error = ForeignConstraintTablesValidationError{}
 
 var _ interface {
 	Field() string
@@ -1894,47 +2697,93 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetConnectionForeignConstraintsRequestValidationError{}
+} = ForeignConstraintTablesValidationError{}
 
-// Validate checks the field values on ForeignKey with the rules defined in the
-// proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *ForeignKey) Validate() error {
+// Validate checks the field values on GetConnectionForeignConstraintsResponse
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the first error encountered is returned, or nil if
+// there are no violations.
+func (m *GetConnectionForeignConstraintsResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ForeignKey with the rules defined in
-// the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in ForeignKeyMultiError, or
-// nil if none found.
-func (m *ForeignKey) ValidateAll() error {
+// ValidateAll checks the field values on
+// GetConnectionForeignConstraintsResponse with the rules defined in the proto
+// definition for this message. If any rules are violated, the result is a
+// list of violation errors wrapped in
+// GetConnectionForeignConstraintsResponseMultiError, or nil if none found.
+func (m *GetConnectionForeignConstraintsResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ForeignKey) validate(all bool) error {
+func (m *GetConnectionForeignConstraintsResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Table
+	{
+		sorted_keys := make([]string, len(m.GetTableConstraints()))
+		i := 0
+		for key := range m.GetTableConstraints() {
+			sorted_keys[i] = key
+			i++
+		}
+		sort.Slice(sorted_keys, func(i, j int) bool { return sorted_keys[i] < sorted_keys[j] })
+		for _, key := range sorted_keys {
+			val := m.GetTableConstraints()[key]
+			_ = val
 
-	// no validation rules for Column
+			// no validation rules for TableConstraints[key]
+
+			if all {
+				switch v := interface{}(val).(type) {
+				case interface{ ValidateAll() error }:
+					if err := v.ValidateAll(); err != nil {
+						errors = append(errors, GetConnectionForeignConstraintsResponseValidationError{
+							field:  fmt.Sprintf("TableConstraints[%v]", key),
+							reason: "embedded message failed validation",
+							cause:  err,
+						})
+					}
+				case interface{ Validate() error }:
+					if err := v.Validate(); err != nil {
+						errors = append(errors, GetConnectionForeignConstraintsResponseValidationError{
+							field:  fmt.Sprintf("TableConstraints[%v]", key),
+							reason: "embedded message failed validation",
+							cause:  err,
+						})
+					}
+				}
+			} else if v, ok := interface{}(val).(interface{ Validate() error }); ok {
+				if err := v.Validate(); err != nil {
+					return GetConnectionForeignConstraintsResponseValidationError{
+						field:  fmt.Sprintf("TableConstraints[%v]", key),
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		}
+	}
 
 	if len(errors) > 0 {
-		return ForeignKeyMultiError(errors)
+		return GetConnectionForeignConstraintsResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// ForeignKeyMultiError is an error wrapping multiple validation errors
-// returned by ForeignKey.ValidateAll() if the designated constraints aren't met.
-type ForeignKeyMultiError []error
+// GetConnectionForeignConstraintsResponseMultiError is an error wrapping
+// multiple validation errors returned by
+// GetConnectionForeignConstraintsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type GetConnectionForeignConstraintsResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ForeignKeyMultiError) Error() string {
+func (m GetConnectionForeignConstraintsResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1943,11 +2792,12 @@ func (m ForeignKeyMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ForeignKeyMultiError) AllErrors() []error { return m }
+func (m GetConnectionForeignConstraintsResponseMultiError) AllErrors() []error { return m }
 
-// ForeignKeyValidationError is the validation error returned by
-// ForeignKey.Validate if the designated constraints aren't met.
-type ForeignKeyValidationError struct {
+// GetConnectionForeignConstraintsResponseValidationError is the validation
+// error returned by GetConnectionForeignConstraintsResponse.Validate if the
+// designated constraints aren't met.
+type GetConnectionForeignConstraintsResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1955,22 +2805,24 @@ type ForeignKeyValidationError struct {
 }
 
 // Field function returns field value.
-func (e ForeignKeyValidationError) Field() string { return e.field }
+func (e GetConnectionForeignConstraintsResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ForeignKeyValidationError) Reason() string { return e.reason }
+func (e GetConnectionForeignConstraintsResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ForeignKeyValidationError) Cause() error { return e.cause }
+func (e GetConnectionForeignConstraintsResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ForeignKeyValidationError) Key() bool { return e.key }
+func (e GetConnectionForeignConstraintsResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ForeignKeyValidationError) ErrorName() string { return "ForeignKeyValidationError" }
+func (e GetConnectionForeignConstraintsResponseValidationError) ErrorName() string {
+	return "GetConnectionForeignConstraintsResponseValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e ForeignKeyValidationError) Error() string {
+func (e GetConnectionForeignConstraintsResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1982,14 +2834,14 @@ func (e ForeignKeyValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sForeignKey.%s: %s%s",
+		"invalid %sGetConnectionForeignConstraintsResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ForeignKeyValidationError{}
+var _ error = GetConnectionForeignConstraintsResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -1997,77 +2849,50 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ForeignKeyValidationError{}
+} = GetConnectionForeignConstraintsResponseValidationError{}
 
-// Validate checks the field values on ForeignConstraint with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *ForeignConstraint) Validate() error {
+// Validate checks the field values on InitStatementOptions with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *InitStatementOptions) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ForeignConstraint with the rules
+// ValidateAll checks the field values on InitStatementOptions with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// ForeignConstraintMultiError, or nil if none found.
-func (m *ForeignConstraint) ValidateAll() error {
+// InitStatementOptionsMultiError, or nil if none found.
+func (m *InitStatementOptions) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ForeignConstraint) validate(all bool) error {
-	if m == nil {
-		return nil
-	}
-
-	var errors []error
-
-	// no validation rules for Column
-
-	// no validation rules for IsNullable
-
-	if all {
-		switch v := interface{}(m.GetForeignKey()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, ForeignConstraintValidationError{
-					field:  "ForeignKey",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, ForeignConstraintValidationError{
-					field:  "ForeignKey",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetForeignKey()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return ForeignConstraintValidationError{
-				field:  "ForeignKey",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
+func (m *InitStatementOptions) validate(all bool) error {
+	if m == nil {
+		return nil
 	}
 
+	var errors []error
+
+	// no validation rules for InitSchema
+
+	// no validation rules for TruncateBeforeInsert
+
+	// no validation rules for TruncateCascade
+
 	if len(errors) > 0 {
-		return ForeignConstraintMultiError(errors)
+		return InitStatementOptionsMultiError(errors)
 	}
 
 	return nil
 }
 
-// ForeignConstraintMultiError is an error wrapping multiple validation errors
-// returned by ForeignConstraint.ValidateAll() if the designated constraints
-// aren't met.
-type ForeignConstraintMultiError []error
+// InitStatementOptionsMultiError is an error wrapping multiple validation
+// errors returned by InitStatementOptions.ValidateAll() if the designated
+// constraints aren't met.
+type InitStatementOptionsMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ForeignConstraintMultiError) Error() string {
+func (m InitStatementOptionsMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2076,11 +2901,11 @@ func (m ForeignConstraintMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ForeignConstraintMultiError) AllErrors() []error { return m }
+func (m InitStatementOptionsMultiError) AllErrors() []error { return m }
 
-// ForeignConstraintValidationError is the validation error returned by
-// ForeignConstraint.Validate if the designated constraints aren't met.
-type ForeignConstraintValidationError struct {
+// InitStatementOptionsValidationError is the validation error returned by
+// InitStatementOptions.Validate if the designated constraints aren't met.
+type InitStatementOptionsValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2088,24 +2913,24 @@ type ForeignConstraintValidationError struct {
 }
 
 // Field function returns field value.
-func (e ForeignConstraintValidationError) Field() string { return e.field }
+func (e InitStatementOptionsValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ForeignConstraintValidationError) Reason() string { return e.reason }
+func (e InitStatementOptionsValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ForeignConstraintValidationError) Cause() error { return e.cause }
+func (e InitStatementOptionsValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ForeignConstraintValidationError) Key() bool { return e.key }
+func (e InitStatementOptionsValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ForeignConstraintValidationError) ErrorName() string {
-	return "ForeignConstraintValidationError"
+func (e InitStatementOptionsValidationError) ErrorName() string {
+	return "InitStatementOptionsValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ForeignConstraintValidationError) Error() string {
+func (e InitStatementOptionsValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2117,14 +2942,14 @@ func (e ForeignConstraintValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sForeignConstraint.%s: %s%s",
+		"invalid %sInitStatementOptions.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ForeignConstraintValidationError{}
+var _ error = InitStatementOptionsValidationError{}
 
 var _ interface {
 	Field() string
@@ -2132,78 +2957,77 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ForeignConstraintValidationError{}
+} = InitStatementOptionsValidationError{}
 
-// Validate checks the field values on ForeignConstraintTables with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ForeignConstraintTables) Validate() error {
+// Validate checks the field values on GetConnectionInitStatementsRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *GetConnectionInitStatementsRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ForeignConstraintTables with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// ForeignConstraintTablesMultiError, or nil if none found.
-func (m *ForeignConstraintTables) ValidateAll() error {
+// ValidateAll checks the field values on GetConnectionInitStatementsRequest
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// GetConnectionInitStatementsRequestMultiError, or nil if none found.
+func (m *GetConnectionInitStatementsRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ForeignConstraintTables) validate(all bool) error {
+func (m *GetConnectionInitStatementsRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	for idx, item := range m.GetConstraints() {
-		_, _ = idx, item
+	// no validation rules for ConnectionId
 
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, ForeignConstraintTablesValidationError{
-						field:  fmt.Sprintf("Constraints[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, ForeignConstraintTablesValidationError{
-						field:  fmt.Sprintf("Constraints[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
+	if all {
+		switch v := interface{}(m.GetOptions()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetConnectionInitStatementsRequestValidationError{
+					field:  "Options",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
 			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				return ForeignConstraintTablesValidationError{
-					field:  fmt.Sprintf("Constraints[%v]", idx),
+				errors = append(errors, GetConnectionInitStatementsRequestValidationError{
+					field:  "Options",
 					reason: "embedded message failed validation",
 					cause:  err,
-				}
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetOptions()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetConnectionInitStatementsRequestValidationError{
+				field:  "Options",
+				reason: "embedded message failed validation",
+				cause:  err,
 			}
 		}
-
 	}
 
 	if len(errors) > 0 {
-		return ForeignConstraintTablesMultiError(errors)
+		return GetConnectionInitStatementsRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// ForeignConstraintTablesMultiError is an error wrapping multiple validation
-// errors returned by ForeignConstraintTables.ValidateAll() if the designated
+// GetConnectionInitStatementsRequestMultiError is an error wrapping multiple
+// validation errors returned by
+// GetConnectionInitStatementsRequest.ValidateAll() if the designated
 // constraints aren't met.
-type ForeignConstraintTablesMultiError []error
+type GetConnectionInitStatementsRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ForeignConstraintTablesMultiError) Error() string {
+func (m GetConnectionInitStatementsRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2212,11 +3036,12 @@ func (m ForeignConstraintTablesMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ForeignConstraintTablesMultiError) AllErrors() []error { return m }
+func (m GetConnectionInitStatementsRequestMultiError) AllErrors() []error { return m }
 
-// ForeignConstraintTablesValidationError is the validation error returned by
-// ForeignConstraintTables.Validate if the designated constraints aren't met.
-type ForeignConstraintTablesValidationError struct {
+// GetConnectionInitStatementsRequestValidationError is the validation error
+// returned by GetConnectionInitStatementsRequest.Validate if the designated
+// constraints aren't met.
+type GetConnectionInitStatementsRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2224,24 +3049,24 @@ type ForeignConstraintTablesValidationError struct {
 }
 
 // Field function returns field value.
-func (e ForeignConstraintTablesValidationError) Field() string { return e.field }
+func (e GetConnectionInitStatementsRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ForeignConstraintTablesValidationError) Reason() string { return e.reason }
+func (e GetConnectionInitStatementsRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ForeignConstraintTablesValidationError) Cause() error { return e.cause }
+func (e GetConnectionInitStatementsRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ForeignConstraintTablesValidationError) Key() bool { return e.key }
+func (e GetConnectionInitStatementsRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ForeignConstraintTablesValidationError) ErrorName() string {
-	return "ForeignConstraintTablesValidationError"
+func (e GetConnectionInitStatementsRequestValidationError) ErrorName() string {
+	return "GetConnectionInitStatementsRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ForeignConstraintTablesValidationError) Error() string {
+func (e GetConnectionInitStatementsRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2253,14 +3078,14 @@ func (e ForeignConstraintTablesValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sForeignConstraintTables.%s: %s%s",
+		"invalid %sGetConnectionInitStatementsRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ForeignConstraintTablesValidationError{}
+var _ error = GetConnectionInitStatementsRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -2268,93 +3093,50 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ForeignConstraintTablesValidationError{}
+} = GetConnectionInitStatementsRequestValidationError{}
 
-// Validate checks the field values on GetConnectionForeignConstraintsResponse
-// with the rules defined in the proto definition for this message. If any
-// rules are violated, the first error encountered is returned, or nil if
-// there are no violations.
-func (m *GetConnectionForeignConstraintsResponse) Validate() error {
+// Validate checks the field values on GetConnectionInitStatementsResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *GetConnectionInitStatementsResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on
-// GetConnectionForeignConstraintsResponse with the rules defined in the proto
-// definition for this message. If any rules are violated, the result is a
-// list of violation errors wrapped in
-// GetConnectionForeignConstraintsResponseMultiError, or nil if none found.
-func (m *GetConnectionForeignConstraintsResponse) ValidateAll() error {
+// ValidateAll checks the field values on GetConnectionInitStatementsResponse
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// GetConnectionInitStatementsResponseMultiError, or nil if none found.
+func (m *GetConnectionInitStatementsResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetConnectionForeignConstraintsResponse) validate(all bool) error {
+func (m *GetConnectionInitStatementsResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	{
-		sorted_keys := make([]string, len(m.GetTableConstraints()))
-		i := 0
-		for key := range m.GetTableConstraints() {
-			sorted_keys[i] = key
-			i++
-		}
-		sort.Slice(sorted_keys, func(i, j int) bool { return sorted_keys[i] < sorted_keys[j] })
-		for _, key := range sorted_keys {
-			val := m.GetTableConstraints()[key]
-			_ = val
-
-			// no validation rules for TableConstraints[key]
-
-			if all {
-				switch v := interface{}(val).(type) {
-				case interface{ ValidateAll() error }:
-					if err := v.ValidateAll(); err != nil {
-						errors = append(errors, GetConnectionForeignConstraintsResponseValidationError{
-							field:  fmt.Sprintf("TableConstraints[%v]", key),
-							reason: "embedded message failed validation",
-							cause:  err,
-						})
-					}
-				case interface{ Validate() error }:
-					if err := v.Validate(); err != nil {
-						errors = append(errors, GetConnectionForeignConstraintsResponseValidationError{
-							field:  fmt.Sprintf("TableConstraints[%v]", key),
-							reason: "embedded message failed validation",
-							cause:  err,
-						})
-					}
-				}
-			} else if v, ok := interface{}(val).(interface{ Validate() error }); ok {
-				if err := v.Validate(); err != nil {
-					return GetConnectionForeignConstraintsResponseValidationError{
-						field:  fmt.Sprintf("TableConstraints[%v]", key),
-						reason: "embedded message failed validation",
-						cause:  err,
-					}
-				}
-			}
+	// no validation rules for TableInitStatements
 
-		}
-	}
+	// no validation rules for TableTruncateStatements
 
 	if len(errors) > 0 {
-		return GetConnectionForeignConstraintsResponseMultiError(errors)
+		return GetConnectionInitStatementsResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetConnectionForeignConstraintsResponseMultiError is an error wrapping
-// multiple validation errors returned by
-// GetConnectionForeignConstraintsResponse.ValidateAll() if the designated
+// GetConnectionInitStatementsResponseMultiError is an error wrapping multiple
+// validation errors returned by
+// GetConnectionInitStatementsResponse.ValidateAll() if the designated
 // constraints aren't met.
-type GetConnectionForeignConstraintsResponseMultiError []error
+type GetConnectionInitStatementsResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetConnectionForeignConstraintsResponseMultiError) Error() string {
+func (m GetConnectionInitStatementsResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2363,12 +3145,12 @@ func (m GetConnectionForeignConstraintsResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetConnectionForeignConstraintsResponseMultiError) AllErrors() []error { return m }
+func (m GetConnectionInitStatementsResponseMultiError) AllErrors() []error { return m }
 
-// GetConnectionForeignConstraintsResponseValidationError is the validation
-// error returned by GetConnectionForeignConstraintsResponse.Validate if the
-// designated constraints aren't met.
-type GetConnectionForeignConstraintsResponseValidationError struct {
+// GetConnectionInitStatementsResponseValidationError is the validation error
+// returned by GetConnectionInitStatementsResponse.Validate if the designated
+// constraints aren't met.
+type GetConnectionInitStatementsResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2376,24 +3158,24 @@ type GetConnectionForeignConstraintsResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetConnectionForeignConstraintsResponseValidationError) Field() string { return e.field }
+func (e GetConnectionInitStatementsResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetConnectionForeignConstraintsResponseValidationError) Reason() string { return e.reason }
+func (e GetConnectionInitStatementsResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetConnectionForeignConstraintsResponseValidationError) Cause() error { return e.cause }
+func (e GetConnectionInitStatementsResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetConnectionForeignConstraintsResponseValidationError) Key() bool { return e.key }
+func (e GetConnectionInitStatementsResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetConnectionForeignConstraintsResponseValidationError) ErrorName() string {
-	return "GetConnectionForeignConstraintsResponseValidationError"
+func (e GetConnectionInitStatementsResponseValidationError) ErrorName() string {
+	return "GetConnectionInitStatementsResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetConnectionForeignConstraintsResponseValidationError) Error() string {
+func (e GetConnectionInitStatementsResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2405,14 +3187,14 @@ func (e GetConnectionForeignConstraintsResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetConnectionForeignConstraintsResponse.%s: %s%s",
+		"invalid %sGetConnectionInitStatementsResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetConnectionForeignConstraintsResponseValidationError{}
+var _ error = GetConnectionInitStatementsResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -2420,50 +3202,44 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetConnectionForeignConstraintsResponseValidationError{}
+} = GetConnectionInitStatementsResponseValidationError{}
 
-// Validate checks the field values on InitStatementOptions with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *InitStatementOptions) Validate() error {
+// Validate checks the field values on PrimaryConstraint with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *PrimaryConstraint) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on InitStatementOptions with the rules
+// ValidateAll checks the field values on PrimaryConstraint with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// InitStatementOptionsMultiError, or nil if none found.
-func (m *InitStatementOptions) ValidateAll() error {
+// PrimaryConstraintMultiError, or nil if none found.
+func (m *PrimaryConstraint) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *InitStatementOptions) validate(all bool) error {
+func (m *PrimaryConstraint) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for InitSchema
-
-	// no validation rules for TruncateBeforeInsert
-
-	// no validation rules for TruncateCascade
-
 	if len(errors) > 0 {
-		return InitStatementOptionsMultiError(errors)
+		return PrimaryConstraintMultiError(errors)
 	}
 
 	return nil
 }
 
-// InitStatementOptionsMultiError is an error wrapping multiple validation
-// errors returned by InitStatementOptions.ValidateAll() if the designated
-// constraints aren't met.
-type InitStatementOptionsMultiError []error
+// PrimaryConstraintMultiError is an error wrapping multiple validation errors
+// returned by PrimaryConstraint.ValidateAll() if the designated constraints
+// aren't met.
+type PrimaryConstraintMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m InitStatementOptionsMultiError) Error() string {
+func (m PrimaryConstraintMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2472,11 +3248,11 @@ func (m InitStatementOptionsMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m InitStatementOptionsMultiError) AllErrors() []error { return m }
+func (m PrimaryConstraintMultiError) AllErrors() []error { return m }
 
-// InitStatementOptionsValidationError is the validation error returned by
-// InitStatementOptions.Validate if the designated constraints aren't met.
-type InitStatementOptionsValidationError struct {
+// PrimaryConstraintValidationError is the validation error returned by
+// PrimaryConstraint.Validate if the designated constraints aren't met.
+type PrimaryConstraintValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2484,24 +3260,24 @@ type InitStatementOptionsValidationError struct {
 }
 
 // Field function returns field value.
-func (e InitStatementOptionsValidationError) Field() string { return e.field }
+func (e PrimaryConstraintValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e InitStatementOptionsValidationError) Reason() string { return e.reason }
+func (e PrimaryConstraintValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e InitStatementOptionsValidationError) Cause() error { return e.cause }
+func (e PrimaryConstraintValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e InitStatementOptionsValidationError) Key() bool { return e.key }
+func (e PrimaryConstraintValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e InitStatementOptionsValidationError) ErrorName() string {
-	return "InitStatementOptionsValidationError"
+func (e PrimaryConstraintValidationError) ErrorName() string {
+	return "PrimaryConstraintValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e InitStatementOptionsValidationError) Error() string {
+func (e PrimaryConstraintValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2513,14 +3289,14 @@ func (e InitStatementOptionsValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sInitStatementOptions.%s: %s%s",
+		"invalid %sPrimaryConstraint.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = InitStatementOptionsValidationError{}
+var _ error = PrimaryConstraintValidationError{}
 
 var _ interface {
 	Field() string
@@ -2528,25 +3304,26 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = InitStatementOptionsValidationError{}
+} = PrimaryConstraintValidationError{}
 
-// Validate checks the field values on GetConnectionInitStatementsRequest with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the first error encountered is returned, or nil if there are
-// no violations.
-func (m *GetConnectionInitStatementsRequest) Validate() error {
+// Validate checks the field values on GetConnectionPrimaryConstraintsRequest
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the first error encountered is returned, or nil if
+// there are no violations.
+func (m *GetConnectionPrimaryConstraintsRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetConnectionInitStatementsRequest
-// with the rules defined in the proto definition for this message. If any
-// rules are violated, the result is a list of violation errors wrapped in
-// GetConnectionInitStatementsRequestMultiError, or nil if none found.
-func (m *GetConnectionInitStatementsRequest) ValidateAll() error {
+// ValidateAll checks the field values on
+// GetConnectionPrimaryConstraintsRequest with the rules defined in the proto
+// definition for this message. If any rules are violated, the result is a
+// list of violation errors wrapped in
+// GetConnectionPrimaryConstraintsRequestMultiError, or nil if none found.
+func (m *GetConnectionPrimaryConstraintsRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetConnectionInitStatementsRequest) validate(all bool) error {
+func (m *GetConnectionPrimaryConstraintsRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -2555,50 +3332,21 @@ func (m *GetConnectionInitStatementsRequest) validate(all bool) error {
 
 	// no validation rules for ConnectionId
 
-	if all {
-		switch v := interface{}(m.GetOptions()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, GetConnectionInitStatementsRequestValidationError{
-					field:  "Options",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, GetConnectionInitStatementsRequestValidationError{
-					field:  "Options",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetOptions()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return GetConnectionInitStatementsRequestValidationError{
-				field:  "Options",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
-
 	if len(errors) > 0 {
-		return GetConnectionInitStatementsRequestMultiError(errors)
+		return GetConnectionPrimaryConstraintsRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetConnectionInitStatementsRequestMultiError is an error wrapping multiple
-// validation errors returned by
-// GetConnectionInitStatementsRequest.ValidateAll() if the designated
+// GetConnectionPrimaryConstraintsRequestMultiError is an error wrapping
+// multiple validation errors returned by
+// GetConnectionPrimaryConstraintsRequest.ValidateAll() if the designated
 // constraints aren't met.
-type GetConnectionInitStatementsRequestMultiError []error
+type GetConnectionPrimaryConstraintsRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetConnectionInitStatementsRequestMultiError) Error() string {
+func (m GetConnectionPrimaryConstraintsRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2607,12 +3355,12 @@ func (m GetConnectionInitStatementsRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetConnectionInitStatementsRequestMultiError) AllErrors() []error { return m }
+func (m GetConnectionPrimaryConstraintsRequestMultiError) AllErrors() []error { return m }
 
-// GetConnectionInitStatementsRequestValidationError is the validation error
-// returned by GetConnectionInitStatementsRequest.Validate if the designated
-// constraints aren't met.
-type GetConnectionInitStatementsRequestValidationError struct {
+// GetConnectionPrimaryConstraintsRequestValidationError is the validation
+// error returned by GetConnectionPrimaryConstraintsRequest.Validate if the
+// designated constraints aren't met.
+type GetConnectionPrimaryConstraintsRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2620,24 +3368,24 @@ type GetConnectionInitStatementsRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetConnectionInitStatementsRequestValidationError) Field() string { return e.field }
+func (e GetConnectionPrimaryConstraintsRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetConnectionInitStatementsRequestValidationError) Reason() string { return e.reason }
+func (e GetConnectionPrimaryConstraintsRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetConnectionInitStatementsRequestValidationError) Cause() error { return e.cause }
+func (e GetConnectionPrimaryConstraintsRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetConnectionInitStatementsRequestValidationError) Key() bool { return e.key }
+func (e GetConnectionPrimaryConstraintsRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetConnectionInitStatementsRequestValidationError) ErrorName() string {
-	return "GetConnectionInitStatementsRequestValidationError"
+func (e GetConnectionPrimaryConstraintsRequestValidationError) ErrorName() string {
+	return "GetConnectionPrimaryConstraintsRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetConnectionInitStatementsRequestValidationError) Error() string {
+func (e GetConnectionPrimaryConstraintsRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2649,14 +3397,14 @@ func (e GetConnectionInitStatementsRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetConnectionInitStatementsRequest.%s: %s%s",
+		"invalid %sGetConnectionPrimaryConstraintsRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetConnectionInitStatementsRequestValidationError{}
+var _ error = GetConnectionPrimaryConstraintsRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -2664,50 +3412,93 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetConnectionInitStatementsRequestValidationError{}
+} = GetConnectionPrimaryConstraintsRequestValidationError{}
 
-// Validate checks the field values on GetConnectionInitStatementsResponse with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the first error encountered is returned, or nil if there are
-// no violations.
-func (m *GetConnectionInitStatementsResponse) Validate() error {
+// Validate checks the field values on GetConnectionPrimaryConstraintsResponse
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the first error encountered is returned, or nil if
+// there are no violations.
+func (m *GetConnectionPrimaryConstraintsResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetConnectionInitStatementsResponse
-// with the rules defined in the proto definition for this message. If any
-// rules are violated, the result is a list of violation errors wrapped in
-// GetConnectionInitStatementsResponseMultiError, or nil if none found.
-func (m *GetConnectionInitStatementsResponse) ValidateAll() error {
+// ValidateAll checks the field values on
+// GetConnectionPrimaryConstraintsResponse with the rules defined in the proto
+// definition for this message. If any rules are violated, the result is a
+// list of violation errors wrapped in
+// GetConnectionPrimaryConstraintsResponseMultiError, or nil if none found.
+func (m *GetConnectionPrimaryConstraintsResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetConnectionInitStatementsResponse) validate(all bool) error {
+func (m *GetConnectionPrimaryConstraintsResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for TableInitStatements
+	{
+		sorted_keys := make([]string, len(m.GetTableConstraints()))
+		i := 0
+		for key := range m.GetTableConstraints() {
+			sorted_keys[i] = key
+			i++
+		}
+		sort.Slice(sorted_keys, func(i, j int) bool { return sorted_keys[i] < sorted_keys[j] })
+		for _, key := range sorted_keys {
+			val := m.GetTableConstraints()[key]
+			_ = val
 
-	// no validation rules for TableTruncateStatements
+			// no validation rules for TableConstraints[key]
+
+			if all {
+				switch v := interface{}(val).(type) {
+				case interface{ ValidateAll() error }:
+					if err := v.ValidateAll(); err != nil {
+						errors = append(errors, GetConnectionPrimaryConstraintsResponseValidationError{
+							field:  fmt.Sprintf("TableConstraints[%v]", key),
+							reason: "embedded message failed validation",
+							cause:  err,
+						})
+					}
+				case interface{ Validate() error }:
+					if err := v.Validate(); err != nil {
+						errors = append(errors, GetConnectionPrimaryConstraintsResponseValidationError{
+							field:  fmt.Sprintf("TableConstraints[%v]", key),
+							reason: "embedded message failed validation",
+							cause:  err,
+						})
+					}
+				}
+			} else if v, ok := interface{}(val).(interface{ Validate() error }); ok {
+				if err := v.Validate(); err != nil {
+					return GetConnectionPrimaryConstraintsResponseValidationError{
+						field:  fmt.Sprintf("TableConstraints[%v]", key),
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		}
+	}
 
 	if len(errors) > 0 {
-		return GetConnectionInitStatementsResponseMultiError(errors)
+		return GetConnectionPrimaryConstraintsResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetConnectionInitStatementsResponseMultiError is an error wrapping multiple
-// validation errors returned by
-// GetConnectionInitStatementsResponse.ValidateAll() if the designated
+// GetConnectionPrimaryConstraintsResponseMultiError is an error wrapping
+// multiple validation errors returned by
+// GetConnectionPrimaryConstraintsResponse.ValidateAll() if the designated
 // constraints aren't met.
-type GetConnectionInitStatementsResponseMultiError []error
+type GetConnectionPrimaryConstraintsResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetConnectionInitStatementsResponseMultiError) Error() string {
+func (m GetConnectionPrimaryConstraintsResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2716,12 +3507,12 @@ func (m GetConnectionInitStatementsResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetConnectionInitStatementsResponseMultiError) AllErrors() []error { return m }
-
-// GetConnectionInitStatementsResponseValidationError is the validation error
-// returned by GetConnectionInitStatementsResponse.Validate if the designated
-// constraints aren't met.
-type GetConnectionInitStatementsResponseValidationError struct {
+func (m GetConnectionPrimaryConstraintsResponseMultiError) AllErrors() []error { return m }
+
+// GetConnectionPrimaryConstraintsResponseValidationError is the validation
+// error returned by GetConnectionPrimaryConstraintsResponse.Validate if the
+// designated constraints aren't met.
+type GetConnectionPrimaryConstraintsResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2729,24 +3520,24 @@ type GetConnectionInitStatementsResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetConnectionInitStatementsResponseValidationError) Field() string { return e.field }
+func (e GetConnectionPrimaryConstraintsResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetConnectionInitStatementsResponseValidationError) Reason() string { return e.reason }
+func (e GetConnectionPrimaryConstraintsResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetConnectionInitStatementsResponseValidationError) Cause() error { return e.cause }
+func (e GetConnectionPrimaryConstraintsResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetConnectionInitStatementsResponseValidationError) Key() bool { return e.key }
+func (e GetConnectionPrimaryConstraintsResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetConnectionInitStatementsResponseValidationError) ErrorName() string {
-	return "GetConnectionInitStatementsResponseValidationError"
+func (e GetConnectionPrimaryConstraintsResponseValidationError) ErrorName() string {
+	return "GetConnectionPrimaryConstraintsResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetConnectionInitStatementsResponseValidationError) Error() string {
+func (e GetConnectionPrimaryConstraintsResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2758,14 +3549,14 @@ func (e GetConnectionInitStatementsResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetConnectionInitStatementsResponse.%s: %s%s",
+		"invalid %sGetConnectionPrimaryConstraintsResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetConnectionInitStatementsResponseValidationError{}
+var _ error = GetConnectionPrimaryConstraintsResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -2773,44 +3564,48 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetConnectionInitStatementsResponseValidationError{}
+} = GetConnectionPrimaryConstraintsResponseValidationError{}
 
-// Validate checks the field values on PrimaryConstraint with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *PrimaryConstraint) Validate() error {
+// Validate checks the field values on GetConnectionUniqueConstraintsRequest
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the first error encountered is returned, or nil if
+// there are no violations.
+func (m *GetConnectionUniqueConstraintsRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on PrimaryConstraint with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// PrimaryConstraintMultiError, or nil if none found.
-func (m *PrimaryConstraint) ValidateAll() error {
+// ValidateAll checks the field values on GetConnectionUniqueConstraintsRequest
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// GetConnectionUniqueConstraintsRequestMultiError, or nil if none found.
+func (m *GetConnectionUniqueConstraintsRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *PrimaryConstraint) validate(all bool) error {
+func (m *GetConnectionUniqueConstraintsRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	// no validation rules for ConnectionId
+
 	if len(errors) > 0 {
-		return PrimaryConstraintMultiError(errors)
+		return GetConnectionUniqueConstraintsRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// PrimaryConstraintMultiError is an error wrapping multiple validation errors
-// returned by PrimaryConstraint.ValidateAll() if the designated constraints
-// aren't met.
-type PrimaryConstraintMultiError []error
+// GetConnectionUniqueConstraintsRequestMultiError is an error wrapping
+// multiple validation errors returned by
+// GetConnectionUniqueConstraintsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetConnectionUniqueConstraintsRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m PrimaryConstraintMultiError) Error() string {
+func (m GetConnectionUniqueConstraintsRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2819,11 +3614,12 @@ func (m PrimaryConstraintMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m PrimaryConstraintMultiError) AllErrors() []error { return m }
+func (m GetConnectionUniqueConstraintsRequestMultiError) AllErrors() []error { return m }
 
-// PrimaryConstraintValidationError is the validation error returned by
-// PrimaryConstraint.Validate if the designated constraints aren't met.
-type PrimaryConstraintValidationError struct {
+// GetConnectionUniqueConstraintsRequestValidationError is the validation error
+// returned by GetConnectionUniqueConstraintsRequest.Validate if the
+// designated constraints aren't met.
+type GetConnectionUniqueConstraintsRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2831,24 +3627,24 @@ type PrimaryConstraintValidationError struct {
 }
 
 // Field function returns field value.
-func (e PrimaryConstraintValidationError) Field() string { return e.field }
+func (e GetConnectionUniqueConstraintsRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e PrimaryConstraintValidationError) Reason() string { return e.reason }
+func (e GetConnectionUniqueConstraintsRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e PrimaryConstraintValidationError) Cause() error { return e.cause }
+func (e GetConnectionUniqueConstraintsRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e PrimaryConstraintValidationError) Key() bool { return e.key }
+func (e GetConnectionUniqueConstraintsRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e PrimaryConstraintValidationError) ErrorName() string {
-	return "PrimaryConstraintValidationError"
+func (e GetConnectionUniqueConstraintsRequestValidationError) ErrorName() string {
+	return "GetConnectionUniqueConstraintsRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e PrimaryConstraintValidationError) Error() string {
+func (e GetConnectionUniqueConstraintsRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2860,14 +3656,14 @@ func (e PrimaryConstraintValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sPrimaryConstraint.%s: %s%s",
+		"invalid %sGetConnectionUniqueConstraintsRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = PrimaryConstraintValidationError{}
+var _ error = GetConnectionUniqueConstraintsRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -2875,49 +3671,93 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = PrimaryConstraintValidationError{}
+} = GetConnectionUniqueConstraintsRequestValidationError{}
 
-// Validate checks the field values on GetConnectionPrimaryConstraintsRequest
+// Validate checks the field values on GetConnectionUniqueConstraintsResponse
 // with the rules defined in the proto definition for this message. If any
 // rules are violated, the first error encountered is returned, or nil if
 // there are no violations.
-func (m *GetConnectionPrimaryConstraintsRequest) Validate() error {
+func (m *GetConnectionUniqueConstraintsResponse) Validate() error {
 	return m.validate(false)
 }
 
 // ValidateAll checks the field values on
-// GetConnectionPrimaryConstraintsRequest with the rules defined in the proto
+// GetConnectionUniqueConstraintsResponse with the rules defined in the proto
 // definition for this message. If any rules are violated, the result is a
 // list of violation errors wrapped in
-// GetConnectionPrimaryConstraintsRequestMultiError, or nil if none found.
-func (m *GetConnectionPrimaryConstraintsRequest) ValidateAll() error {
+// GetConnectionUniqueConstraintsResponseMultiError, or nil if none found.
+func (m *GetConnectionUniqueConstraintsResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetConnectionPrimaryConstraintsRequest) validate(all bool) error {
+func (m *GetConnectionUniqueConstraintsResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for ConnectionId
+	{
+		sorted_keys := make([]string, len(m.GetTableConstraints()))
+		i := 0
+		for key := range m.GetTableConstraints() {
+			sorted_keys[i] = key
+			i++
+		}
+		sort.Slice(sorted_keys, func(i, j int) bool { return sorted_keys[i] < sorted_keys[j] })
+		for _, key := range sorted_keys {
+			val := m.GetTableConstraints()[key]
+			_ = val
+
+			// no validation rules for TableConstraints[key]
+
+			if all {
+				switch v := interface{}(val).(type) {
+				case interface{ ValidateAll() error }:
+					if err := v.ValidateAll(); err != nil {
+						errors = append(errors, GetConnectionUniqueConstraintsResponseValidationError{
+							field:  fmt.Sprintf("TableConstraints[%v]", key),
+							reason: "embedded message failed validation",
+							cause:  err,
+						})
+					}
+				case interface{ Validate() error }:
+					if err := v.Validate(); err != nil {
+						errors = append(errors, GetConnectionUniqueConstraintsResponseValidationError{
+							field:  fmt.Sprintf("TableConstraints[%v]", key),
+							reason: "embedded message failed validation",
+							cause:  err,
+						})
+					}
+				}
+			} else if v, ok := interface{}(val).(interface{ Validate() error }); ok {
+				if err := v.Validate(); err != nil {
+					return GetConnectionUniqueConstraintsResponseValidationError{
+						field:  fmt.Sprintf("TableConstraints[%v]", key),
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		}
+	}
 
 	if len(errors) > 0 {
-		return GetConnectionPrimaryConstraintsRequestMultiError(errors)
+		return GetConnectionUniqueConstraintsResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetConnectionPrimaryConstraintsRequestMultiError is an error wrapping
+// GetConnectionUniqueConstraintsResponseMultiError is an error wrapping
 // multiple validation errors returned by
-// GetConnectionPrimaryConstraintsRequest.ValidateAll() if the designated
+// GetConnectionUniqueConstraintsResponse.ValidateAll() if the designated
 // constraints aren't met.
-type GetConnectionPrimaryConstraintsRequestMultiError []error
+type GetConnectionUniqueConstraintsResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetConnectionPrimaryConstraintsRequestMultiError) Error() string {
+func (m GetConnectionUniqueConstraintsResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2926,12 +3766,12 @@ func (m GetConnectionPrimaryConstraintsRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetConnectionPrimaryConstraintsRequestMultiError) AllErrors() []error { return m }
+func (m GetConnectionUniqueConstraintsResponseMultiError) AllErrors() []error { return m }
 
-// GetConnectionPrimaryConstraintsRequestValidationError is the validation
-// error returned by GetConnectionPrimaryConstraintsRequest.Validate if the
+// GetConnectionUniqueConstraintsResponseValidationError is the validation
+// error returned by GetConnectionUniqueConstraintsResponse.Validate if the
 // designated constraints aren't met.
-type GetConnectionPrimaryConstraintsRequestValidationError struct {
+type GetConnectionUniqueConstraintsResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2939,24 +3779,24 @@ type GetConnectionPrimaryConstraintsRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetConnectionPrimaryConstraintsRequestValidationError) Field() string { return e.field }
+func (e GetConnectionUniqueConstraintsResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetConnectionPrimaryConstraintsRequestValidationError) Reason() string { return e.reason }
+func (e GetConnectionUniqueConstraintsResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetConnectionPrimaryConstraintsRequestValidationError) Cause() error { return e.cause }
+func (e GetConnectionUniqueConstraintsResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetConnectionPrimaryConstraintsRequestValidationError) Key() bool { return e.key }
+func (e GetConnectionUniqueConstraintsResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetConnectionPrimaryConstraintsRequestValidationError) ErrorName() string {
-	return "GetConnectionPrimaryConstraintsRequestValidationError"
+func (e GetConnectionUniqueConstraintsResponseValidationError) ErrorName() string {
+	return "GetConnectionUniqueConstraintsResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetConnectionPrimaryConstraintsRequestValidationError) Error() string {
+func (e GetConnectionUniqueConstraintsResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2968,14 +3808,14 @@ func (e GetConnectionPrimaryConstraintsRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetConnectionPrimaryConstraintsRequest.%s: %s%s",
+		"invalid %sGetConnectionUniqueConstraintsResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetConnectionPrimaryConstraintsRequestValidationError{}
+var _ error = GetConnectionUniqueConstraintsResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -2983,93 +3823,44 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetConnectionPrimaryConstraintsRequestValidationError{}
+} = GetConnectionUniqueConstraintsResponseValidationError{}
 
-// Validate checks the field values on GetConnectionPrimaryConstraintsResponse
-// with the rules defined in the proto definition for this message. If any
-// rules are violated, the first error encountered is returned, or nil if
-// there are no violations.
-func (m *GetConnectionPrimaryConstraintsResponse) Validate() error {
+// Validate checks the field values on UniqueConstraint with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *UniqueConstraint) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on
-// GetConnectionPrimaryConstraintsResponse with the rules defined in the proto
-// definition for this message. If any rules are violated, the result is a
-// list of violation errors wrapped in
-// GetConnectionPrimaryConstraintsResponseMultiError, or nil if none found.
-func (m *GetConnectionPrimaryConstraintsResponse) ValidateAll() error {
+// ValidateAll checks the field values on UniqueConstraint with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UniqueConstraintMultiError, or nil if none found.
+func (m *UniqueConstraint) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetConnectionPrimaryConstraintsResponse) validate(all bool) error {
+func (m *UniqueConstraint) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	{
-		sorted_keys := make([]string, len(m.GetTableConstraints()))
-		i := 0
-		for key := range m.GetTableConstraints() {
-			sorted_keys[i] = key
-			i++
-		}
-		sort.Slice(sorted_keys, func(i, j int) bool { return sorted_keys[i] < sorted_keys[j] })
-		for _, key := range sorted_keys {
-			val := m.GetTableConstraints()[key]
-			_ = val
-
-			// no validation rules for TableConstraints[key]
-
-			if all {
-				switch v := interface{}(val).(type) {
-				case interface{ ValidateAll() error }:
-					if err := v.ValidateAll(); err != nil {
-						errors = append(errors, GetConnectionPrimaryConstraintsResponseValidationError{
-							field:  fmt.Sprintf("TableConstraints[%v]", key),
-							reason: "embedded message failed validation",
-							cause:  err,
-						})
-					}
-				case interface{ Validate() error }:
-					if err := v.Validate(); err != nil {
-						errors = append(errors, GetConnectionPrimaryConstraintsResponseValidationError{
-							field:  fmt.Sprintf("TableConstraints[%v]", key),
-							reason: "embedded message failed validation",
-							cause:  err,
-						})
-					}
-				}
-			} else if v, ok := interface{}(val).(interface{ Validate() error }); ok {
-				if err := v.Validate(); err != nil {
-					return GetConnectionPrimaryConstraintsResponseValidationError{
-						field:  fmt.Sprintf("TableConstraints[%v]", key),
-						reason: "embedded message failed validation",
-						cause:  err,
-					}
-				}
-			}
-
-		}
-	}
-
 	if len(errors) > 0 {
-		return GetConnectionPrimaryConstraintsResponseMultiError(errors)
+		return UniqueConstraintMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetConnectionPrimaryConstraintsResponseMultiError is an error wrapping
-// multiple validation errors returned by
-// GetConnectionPrimaryConstraintsResponse.ValidateAll() if the designated
-// constraints aren't met.
-type GetConnectionPrimaryConstraintsResponseMultiError []error
+// UniqueConstraintMultiError is an error wrapping multiple validation errors
+// returned by UniqueConstraint.ValidateAll() if the designated constraints
+// aren't met.
+type UniqueConstraintMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetConnectionPrimaryConstraintsResponseMultiError) Error() string {
+func (m UniqueConstraintMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3078,12 +3869,11 @@ func (m GetConnectionPrimaryConstraintsResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetConnectionPrimaryConstraintsResponseMultiError) AllErrors() []error { return m }
+func (m UniqueConstraintMultiError) AllErrors() []error { return m }
 
-// GetConnectionPrimaryConstraintsResponseValidationError is the validation
-// error returned by GetConnectionPrimaryConstraintsResponse.Validate if the
-// designated constraints aren't met.
-type GetConnectionPrimaryConstraintsResponseValidationError struct {
+// UniqueConstraintValidationError is the validation error returned by
+// UniqueConstraint.Validate if the designated constraints aren't met.
+type UniqueConstraintValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3091,24 +3881,22 @@ type GetConnectionPrimaryConstraintsResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetConnectionPrimaryConstraintsResponseValidationError) Field() string { return e.field }
+func (e UniqueConstraintValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetConnectionPrimaryConstraintsResponseValidationError) Reason() string { return e.reason }
+func (e UniqueConstraintValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetConnectionPrimaryConstraintsResponseValidationError) Cause() error { return e.cause }
+func (e UniqueConstraintValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetConnectionPrimaryConstraintsResponseValidationError) Key() bool { return e.key }
+func (e UniqueConstraintValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetConnectionPrimaryConstraintsResponseValidationError) ErrorName() string {
-	return "GetConnectionPrimaryConstraintsResponseValidationError"
-}
+func (e UniqueConstraintValidationError) ErrorName() string { return "UniqueConstraintValidationError" }
 
 // Error satisfies the builtin error interface
-func (e GetConnectionPrimaryConstraintsResponseValidationError) Error() string {
+func (e UniqueConstraintValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3120,14 +3908,14 @@ func (e GetConnectionPrimaryConstraintsResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetConnectionPrimaryConstraintsResponse.%s: %s%s",
+		"invalid %sUniqueConstraint.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetConnectionPrimaryConstraintsResponseValidationError{}
+var _ error = UniqueConstraintValidationError{}
 
 var _ interface {
 	Field() string
@@ -3135,25 +3923,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetConnectionPrimaryConstraintsResponseValidationError{}
+} = UniqueConstraintValidationError{}
 
-// Validate checks the field values on GetConnectionUniqueConstraintsRequest
-// with the rules defined in the proto definition for this message. If any
-// rules are violated, the first error encountered is returned, or nil if
-// there are no violations.
-func (m *GetConnectionUniqueConstraintsRequest) Validate() error {
+// Validate checks the field values on ScanConnectionPiiRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ScanConnectionPiiRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetConnectionUniqueConstraintsRequest
-// with the rules defined in the proto definition for this message. If any
-// rules are violated, the result is a list of violation errors wrapped in
-// GetConnectionUniqueConstraintsRequestMultiError, or nil if none found.
-func (m *GetConnectionUniqueConstraintsRequest) ValidateAll() error {
+// ValidateAll checks the field values on ScanConnectionPiiRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ScanConnectionPiiRequestMultiError, or nil if none found.
+func (m *ScanConnectionPiiRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetConnectionUniqueConstraintsRequest) validate(all bool) error {
+func (m *ScanConnectionPiiRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -3162,21 +3949,57 @@ func (m *GetConnectionUniqueConstraintsRequest) validate(all bool) error {
 
 	// no validation rules for ConnectionId
 
+	if all {
+		switch v := interface{}(m.GetStreamConfig()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ScanConnectionPiiRequestValidationError{
+					field:  "StreamConfig",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ScanConnectionPiiRequestValidationError{
+					field:  "StreamConfig",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetStreamConfig()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ScanConnectionPiiRequestValidationError{
+				field:  "StreamConfig",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if m.SampleSize != nil {
+		// no validation rules for SampleSize
+	}
+
+	if m.MinConfidence != nil {
+		// no validation rules for MinConfidence
+	}
+
 	if len(errors) > 0 {
-		return GetConnectionUniqueConstraintsRequestMultiError(errors)
+		return ScanConnectionPiiRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetConnectionUniqueConstraintsRequestMultiError is an error wrapping
-// multiple validation errors returned by
-// GetConnectionUniqueConstraintsRequest.ValidateAll() if the designated
+// ScanConnectionPiiRequestMultiError is an error wrapping multiple validation
+// errors returned by ScanConnectionPiiRequest.ValidateAll() if the designated
 // constraints aren't met.
-type GetConnectionUniqueConstraintsRequestMultiError []error
+type ScanConnectionPiiRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetConnectionUniqueConstraintsRequestMultiError) Error() string {
+func (m ScanConnectionPiiRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3185,12 +4008,11 @@ func (m GetConnectionUniqueConstraintsRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetConnectionUniqueConstraintsRequestMultiError) AllErrors() []error { return m }
+func (m ScanConnectionPiiRequestMultiError) AllErrors() []error { return m }
 
-// GetConnectionUniqueConstraintsRequestValidationError is the validation error
-// returned by GetConnectionUniqueConstraintsRequest.Validate if the
-// designated constraints aren't met.
-type GetConnectionUniqueConstraintsRequestValidationError struct {
+// ScanConnectionPiiRequestValidationError is the validation error returned by
+// ScanConnectionPiiRequest.Validate if the designated constraints aren't met.
+type ScanConnectionPiiRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3198,24 +4020,24 @@ type GetConnectionUniqueConstraintsRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetConnectionUniqueConstraintsRequestValidationError) Field() string { return e.field }
+func (e ScanConnectionPiiRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetConnectionUniqueConstraintsRequestValidationError) Reason() string { return e.reason }
+func (e ScanConnectionPiiRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetConnectionUniqueConstraintsRequestValidationError) Cause() error { return e.cause }
+func (e ScanConnectionPiiRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetConnectionUniqueConstraintsRequestValidationError) Key() bool { return e.key }
+func (e ScanConnectionPiiRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetConnectionUniqueConstraintsRequestValidationError) ErrorName() string {
-	return "GetConnectionUniqueConstraintsRequestValidationError"
+func (e ScanConnectionPiiRequestValidationError) ErrorName() string {
+	return "ScanConnectionPiiRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetConnectionUniqueConstraintsRequestValidationError) Error() string {
+func (e ScanConnectionPiiRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3227,14 +4049,14 @@ func (e GetConnectionUniqueConstraintsRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetConnectionUniqueConstraintsRequest.%s: %s%s",
+		"invalid %sScanConnectionPiiRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetConnectionUniqueConstraintsRequestValidationError{}
+var _ error = ScanConnectionPiiRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -3242,93 +4064,78 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetConnectionUniqueConstraintsRequestValidationError{}
+} = ScanConnectionPiiRequestValidationError{}
 
-// Validate checks the field values on GetConnectionUniqueConstraintsResponse
-// with the rules defined in the proto definition for this message. If any
-// rules are violated, the first error encountered is returned, or nil if
-// there are no violations.
-func (m *GetConnectionUniqueConstraintsResponse) Validate() error {
+// Validate checks the field values on ScanConnectionPiiResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ScanConnectionPiiResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on
-// GetConnectionUniqueConstraintsResponse with the rules defined in the proto
-// definition for this message. If any rules are violated, the result is a
-// list of violation errors wrapped in
-// GetConnectionUniqueConstraintsResponseMultiError, or nil if none found.
-func (m *GetConnectionUniqueConstraintsResponse) ValidateAll() error {
+// ValidateAll checks the field values on ScanConnectionPiiResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ScanConnectionPiiResponseMultiError, or nil if none found.
+func (m *ScanConnectionPiiResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetConnectionUniqueConstraintsResponse) validate(all bool) error {
+func (m *ScanConnectionPiiResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	{
-		sorted_keys := make([]string, len(m.GetTableConstraints()))
-		i := 0
-		for key := range m.GetTableConstraints() {
-			sorted_keys[i] = key
-			i++
-		}
-		sort.Slice(sorted_keys, func(i, j int) bool { return sorted_keys[i] < sorted_keys[j] })
-		for _, key := range sorted_keys {
-			val := m.GetTableConstraints()[key]
-			_ = val
-
-			// no validation rules for TableConstraints[key]
+	for idx, item := range m.GetColumns() {
+		_, _ = idx, item
 
-			if all {
-				switch v := interface{}(val).(type) {
-				case interface{ ValidateAll() error }:
-					if err := v.ValidateAll(); err != nil {
-						errors = append(errors, GetConnectionUniqueConstraintsResponseValidationError{
-							field:  fmt.Sprintf("TableConstraints[%v]", key),
-							reason: "embedded message failed validation",
-							cause:  err,
-						})
-					}
-				case interface{ Validate() error }:
-					if err := v.Validate(); err != nil {
-						errors = append(errors, GetConnectionUniqueConstraintsResponseValidationError{
-							field:  fmt.Sprintf("TableConstraints[%v]", key),
-							reason: "embedded message failed validation",
-							cause:  err,
-						})
-					}
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ScanConnectionPiiResponseValidationError{
+						field:  fmt.Sprintf("Columns[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
 				}
-			} else if v, ok := interface{}(val).(interface{ Validate() error }); ok {
+			case interface{ Validate() error }:
 				if err := v.Validate(); err != nil {
-					return GetConnectionUniqueConstraintsResponseValidationError{
-						field:  fmt.Sprintf("TableConstraints[%v]", key),
+					errors = append(errors, ScanConnectionPiiResponseValidationError{
+						field:  fmt.Sprintf("Columns[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
-					}
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ScanConnectionPiiResponseValidationError{
+					field:  fmt.Sprintf("Columns[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
 				}
 			}
-
 		}
+
 	}
 
 	if len(errors) > 0 {
-		return GetConnectionUniqueConstraintsResponseMultiError(errors)
+		return ScanConnectionPiiResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetConnectionUniqueConstraintsResponseMultiError is an error wrapping
-// multiple validation errors returned by
-// GetConnectionUniqueConstraintsResponse.ValidateAll() if the designated
-// constraints aren't met.
-type GetConnectionUniqueConstraintsResponseMultiError []error
+// ScanConnectionPiiResponseMultiError is an error wrapping multiple validation
+// errors returned by ScanConnectionPiiResponse.ValidateAll() if the
+// designated constraints aren't met.
+type ScanConnectionPiiResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetConnectionUniqueConstraintsResponseMultiError) Error() string {
+func (m ScanConnectionPiiResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3337,12 +4144,11 @@ func (m GetConnectionUniqueConstraintsResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetConnectionUniqueConstraintsResponseMultiError) AllErrors() []error { return m }
+func (m ScanConnectionPiiResponseMultiError) AllErrors() []error { return m }
 
-// GetConnectionUniqueConstraintsResponseValidationError is the validation
-// error returned by GetConnectionUniqueConstraintsResponse.Validate if the
-// designated constraints aren't met.
-type GetConnectionUniqueConstraintsResponseValidationError struct {
+// ScanConnectionPiiResponseValidationError is the validation error returned by
+// ScanConnectionPiiResponse.Validate if the designated constraints aren't met.
+type ScanConnectionPiiResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3350,24 +4156,24 @@ type GetConnectionUniqueConstraintsResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetConnectionUniqueConstraintsResponseValidationError) Field() string { return e.field }
+func (e ScanConnectionPiiResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetConnectionUniqueConstraintsResponseValidationError) Reason() string { return e.reason }
+func (e ScanConnectionPiiResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetConnectionUniqueConstraintsResponseValidationError) Cause() error { return e.cause }
+func (e ScanConnectionPiiResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetConnectionUniqueConstraintsResponseValidationError) Key() bool { return e.key }
+func (e ScanConnectionPiiResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetConnectionUniqueConstraintsResponseValidationError) ErrorName() string {
-	return "GetConnectionUniqueConstraintsResponseValidationError"
+func (e ScanConnectionPiiResponseValidationError) ErrorName() string {
+	return "ScanConnectionPiiResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetConnectionUniqueConstraintsResponseValidationError) Error() string {
+func (e ScanConnectionPiiResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3379,14 +4185,14 @@ func (e GetConnectionUniqueConstraintsResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetConnectionUniqueConstraintsResponse.%s: %s%s",
+		"invalid %sScanConnectionPiiResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetConnectionUniqueConstraintsResponseValidationError{}
+var _ error = ScanConnectionPiiResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -3394,44 +4200,89 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetConnectionUniqueConstraintsResponseValidationError{}
+} = ScanConnectionPiiResponseValidationError{}
 
-// Validate checks the field values on UniqueConstraint with the rules defined
+// Validate checks the field values on PiiColumnReport with the rules defined
 // in the proto definition for this message. If any rules are violated, the
 // first error encountered is returned, or nil if there are no violations.
-func (m *UniqueConstraint) Validate() error {
+func (m *PiiColumnReport) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on UniqueConstraint with the rules
+// ValidateAll checks the field values on PiiColumnReport with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// UniqueConstraintMultiError, or nil if none found.
-func (m *UniqueConstraint) ValidateAll() error {
+// PiiColumnReportMultiError, or nil if none found.
+func (m *PiiColumnReport) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *UniqueConstraint) validate(all bool) error {
+func (m *PiiColumnReport) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	// no validation rules for Schema
+
+	// no validation rules for Table
+
+	// no validation rules for Column
+
+	// no validation rules for DataType
+
+	// no validation rules for Category
+
+	// no validation rules for Confidence
+
+	// no validation rules for SampledValues
+
+	// no validation rules for MatchedValues
+
+	if all {
+		switch v := interface{}(m.GetSuggestedMapping()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, PiiColumnReportValidationError{
+					field:  "SuggestedMapping",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, PiiColumnReportValidationError{
+					field:  "SuggestedMapping",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSuggestedMapping()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return PiiColumnReportValidationError{
+				field:  "SuggestedMapping",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
 	if len(errors) > 0 {
-		return UniqueConstraintMultiError(errors)
+		return PiiColumnReportMultiError(errors)
 	}
 
 	return nil
 }
 
-// UniqueConstraintMultiError is an error wrapping multiple validation errors
-// returned by UniqueConstraint.ValidateAll() if the designated constraints
+// PiiColumnReportMultiError is an error wrapping multiple validation errors
+// returned by PiiColumnReport.ValidateAll() if the designated constraints
 // aren't met.
-type UniqueConstraintMultiError []error
+type PiiColumnReportMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m UniqueConstraintMultiError) Error() string {
+func (m PiiColumnReportMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3440,11 +4291,11 @@ func (m UniqueConstraintMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m UniqueConstraintMultiError) AllErrors() []error { return m }
+func (m PiiColumnReportMultiError) AllErrors() []error { return m }
 
-// UniqueConstraintValidationError is the validation error returned by
-// UniqueConstraint.Validate if the designated constraints aren't met.
-type UniqueConstraintValidationError struct {
+// PiiColumnReportValidationError is the validation error returned by
+// PiiColumnReport.Validate if the designated constraints aren't met.
+type PiiColumnReportValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3452,22 +4303,22 @@ type UniqueConstraintValidationError struct {
 }
 
 // Field function returns field value.
-func (e UniqueConstraintValidationError) Field() string { return e.field }
+func (e PiiColumnReportValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e UniqueConstraintValidationError) Reason() string { return e.reason }
+func (e PiiColumnReportValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e UniqueConstraintValidationError) Cause() error { return e.cause }
+func (e PiiColumnReportValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e UniqueConstraintValidationError) Key() bool { return e.key }
+func (e PiiColumnReportValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e UniqueConstraintValidationError) ErrorName() string { return "UniqueConstraintValidationError" }
+func (e PiiColumnReportValidationError) ErrorName() string { return "PiiColumnReportValidationError" }
 
 // Error satisfies the builtin error interface
-func (e UniqueConstraintValidationError) Error() string {
+func (e PiiColumnReportValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3479,14 +4330,14 @@ func (e UniqueConstraintValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sUniqueConstraint.%s: %s%s",
+		"invalid %sPiiColumnReport.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = UniqueConstraintValidationError{}
+var _ error = PiiColumnReportValidationError{}
 
 var _ interface {
 	Field() string
@@ -3494,4 +4345,4 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = UniqueConstraintValidationError{}
+} = PiiColumnReportValidationError{}
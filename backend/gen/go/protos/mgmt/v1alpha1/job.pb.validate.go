@@ -586,6 +586,88 @@ func (m *JobSourceOptions) validate(all bool) error {
 			}
 		}
 
+	case *JobSourceOptions_Mssql:
+		if v == nil {
+			err := JobSourceOptionsValidationError{
+				field:  "Config",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetMssql()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, JobSourceOptionsValidationError{
+						field:  "Mssql",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, JobSourceOptionsValidationError{
+						field:  "Mssql",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetMssql()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return JobSourceOptionsValidationError{
+					field:  "Mssql",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *JobSourceOptions_PostgresCdc:
+		if v == nil {
+			err := JobSourceOptionsValidationError{
+				field:  "Config",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetPostgresCdc()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, JobSourceOptionsValidationError{
+						field:  "PostgresCdc",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, JobSourceOptionsValidationError{
+						field:  "PostgresCdc",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetPostgresCdc()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return JobSourceOptionsValidationError{
+					field:  "PostgresCdc",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
 	default:
 		_ = v // ensures v is used
 	}
@@ -1380,6 +1462,39 @@ func (m *PostgresSourceConnectionOptions) validate(all bool) error {
 
 	// no validation rules for SubsetByForeignKeyConstraints
 
+	if m.SchemaDriftOptions != nil {
+
+		if all {
+			switch v := interface{}(m.GetSchemaDriftOptions()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, PostgresSourceConnectionOptionsValidationError{
+						field:  "SchemaDriftOptions",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, PostgresSourceConnectionOptionsValidationError{
+						field:  "SchemaDriftOptions",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetSchemaDriftOptions()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return PostgresSourceConnectionOptionsValidationError{
+					field:  "SchemaDriftOptions",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
 	if len(errors) > 0 {
 		return PostgresSourceConnectionOptionsMultiError(errors)
 	}
@@ -1461,6 +1576,147 @@ var _ interface {
 	ErrorName() string
 } = PostgresSourceConnectionOptionsValidationError{}
 
+// Validate checks the field values on SchemaDriftOptions with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SchemaDriftOptions) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SchemaDriftOptions with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SchemaDriftOptionsMultiError, or nil if none found.
+func (m *SchemaDriftOptions) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SchemaDriftOptions) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for NewColumnPolicy
+
+	// no validation rules for RemovedColumnPolicy
+
+	// no validation rules for TypeChangePolicy
+
+	if m.NewColumnTransformer != nil {
+
+		if all {
+			switch v := interface{}(m.GetNewColumnTransformer()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, SchemaDriftOptionsValidationError{
+						field:  "NewColumnTransformer",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, SchemaDriftOptionsValidationError{
+						field:  "NewColumnTransformer",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetNewColumnTransformer()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return SchemaDriftOptionsValidationError{
+					field:  "NewColumnTransformer",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return SchemaDriftOptionsMultiError(errors)
+	}
+
+	return nil
+}
+
+// SchemaDriftOptionsMultiError is an error wrapping multiple validation errors
+// returned by SchemaDriftOptions.ValidateAll() if the designated constraints
+// aren't met.
+type SchemaDriftOptionsMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SchemaDriftOptionsMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SchemaDriftOptionsMultiError) AllErrors() []error { return m }
+
+// SchemaDriftOptionsValidationError is the validation error returned by
+// SchemaDriftOptions.Validate if the designated constraints aren't met.
+type SchemaDriftOptionsValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SchemaDriftOptionsValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SchemaDriftOptionsValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SchemaDriftOptionsValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SchemaDriftOptionsValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SchemaDriftOptionsValidationError) ErrorName() string {
+	return "SchemaDriftOptionsValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SchemaDriftOptionsValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSchemaDriftOptions.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SchemaDriftOptionsValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SchemaDriftOptionsValidationError{}
+
 // Validate checks the field values on PostgresSourceSchemaOption with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
@@ -1627,6 +1883,10 @@ func (m *PostgresSourceTableOption) validate(all bool) error {
 		// no validation rules for WhereClause
 	}
 
+	if m.WatermarkColumn != nil {
+		// no validation rules for WatermarkColumn
+	}
+
 	if len(errors) > 0 {
 		return PostgresSourceTableOptionMultiError(errors)
 	}
@@ -1707,76 +1967,224 @@ var _ interface {
 	ErrorName() string
 } = PostgresSourceTableOptionValidationError{}
 
-// Validate checks the field values on MysqlSourceConnectionOptions with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *MysqlSourceConnectionOptions) Validate() error {
+// Validate checks the field values on PostgresCdcSourceConnectionOptions with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *PostgresCdcSourceConnectionOptions) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on MysqlSourceConnectionOptions with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// MysqlSourceConnectionOptionsMultiError, or nil if none found.
-func (m *MysqlSourceConnectionOptions) ValidateAll() error {
+// ValidateAll checks the field values on PostgresCdcSourceConnectionOptions
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// PostgresCdcSourceConnectionOptionsMultiError, or nil if none found.
+func (m *PostgresCdcSourceConnectionOptions) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *MysqlSourceConnectionOptions) validate(all bool) error {
+func (m *PostgresCdcSourceConnectionOptions) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for HaltOnNewColumnAddition
+	// no validation rules for ConnectionId
 
-	for idx, item := range m.GetSchemas() {
-		_, _ = idx, item
+	// no validation rules for PublicationName
 
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, MysqlSourceConnectionOptionsValidationError{
-						field:  fmt.Sprintf("Schemas[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, MysqlSourceConnectionOptionsValidationError{
-						field:  fmt.Sprintf("Schemas[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return MysqlSourceConnectionOptionsValidationError{
-					field:  fmt.Sprintf("Schemas[%v]", idx),
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
+	// no validation rules for SkipInitialSnapshot
 
+	if m.SlotName != nil {
+		// no validation rules for SlotName
 	}
 
-	// no validation rules for ConnectionId
-
-	// no validation rules for SubsetByForeignKeyConstraints
-
 	if len(errors) > 0 {
-		return MysqlSourceConnectionOptionsMultiError(errors)
+		return PostgresCdcSourceConnectionOptionsMultiError(errors)
 	}
 
 	return nil
 }
 
-// MysqlSourceConnectionOptionsMultiError is an error wrapping multiple
+// PostgresCdcSourceConnectionOptionsMultiError is an error wrapping multiple
+// validation errors returned by
+// PostgresCdcSourceConnectionOptions.ValidateAll() if the designated
+// constraints aren't met.
+type PostgresCdcSourceConnectionOptionsMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PostgresCdcSourceConnectionOptionsMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PostgresCdcSourceConnectionOptionsMultiError) AllErrors() []error { return m }
+
+// PostgresCdcSourceConnectionOptionsValidationError is the validation error
+// returned by PostgresCdcSourceConnectionOptions.Validate if the designated
+// constraints aren't met.
+type PostgresCdcSourceConnectionOptionsValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PostgresCdcSourceConnectionOptionsValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PostgresCdcSourceConnectionOptionsValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PostgresCdcSourceConnectionOptionsValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PostgresCdcSourceConnectionOptionsValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PostgresCdcSourceConnectionOptionsValidationError) ErrorName() string {
+	return "PostgresCdcSourceConnectionOptionsValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e PostgresCdcSourceConnectionOptionsValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPostgresCdcSourceConnectionOptions.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PostgresCdcSourceConnectionOptionsValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PostgresCdcSourceConnectionOptionsValidationError{}
+
+// Validate checks the field values on MysqlSourceConnectionOptions with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *MysqlSourceConnectionOptions) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on MysqlSourceConnectionOptions with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// MysqlSourceConnectionOptionsMultiError, or nil if none found.
+func (m *MysqlSourceConnectionOptions) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *MysqlSourceConnectionOptions) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for HaltOnNewColumnAddition
+
+	for idx, item := range m.GetSchemas() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, MysqlSourceConnectionOptionsValidationError{
+						field:  fmt.Sprintf("Schemas[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, MysqlSourceConnectionOptionsValidationError{
+						field:  fmt.Sprintf("Schemas[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return MysqlSourceConnectionOptionsValidationError{
+					field:  fmt.Sprintf("Schemas[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for ConnectionId
+
+	// no validation rules for SubsetByForeignKeyConstraints
+
+	if m.SchemaDriftOptions != nil {
+
+		if all {
+			switch v := interface{}(m.GetSchemaDriftOptions()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, MysqlSourceConnectionOptionsValidationError{
+						field:  "SchemaDriftOptions",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, MysqlSourceConnectionOptionsValidationError{
+						field:  "SchemaDriftOptions",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetSchemaDriftOptions()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return MysqlSourceConnectionOptionsValidationError{
+					field:  "SchemaDriftOptions",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return MysqlSourceConnectionOptionsMultiError(errors)
+	}
+
+	return nil
+}
+
+// MysqlSourceConnectionOptionsMultiError is an error wrapping multiple
 // validation errors returned by MysqlSourceConnectionOptions.ValidateAll() if
 // the designated constraints aren't met.
 type MysqlSourceConnectionOptionsMultiError []error
@@ -2016,6 +2424,10 @@ func (m *MysqlSourceTableOption) validate(all bool) error {
 		// no validation rules for WhereClause
 	}
 
+	if m.WatermarkColumn != nil {
+		// no validation rules for WatermarkColumn
+	}
+
 	if len(errors) > 0 {
 		return MysqlSourceTableOptionMultiError(errors)
 	}
@@ -2096,44 +2508,115 @@ var _ interface {
 	ErrorName() string
 } = MysqlSourceTableOptionValidationError{}
 
-// Validate checks the field values on AwsS3SourceConnectionOptions with the
+// Validate checks the field values on MssqlSourceConnectionOptions with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *AwsS3SourceConnectionOptions) Validate() error {
+func (m *MssqlSourceConnectionOptions) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AwsS3SourceConnectionOptions with the
+// ValidateAll checks the field values on MssqlSourceConnectionOptions with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// AwsS3SourceConnectionOptionsMultiError, or nil if none found.
-func (m *AwsS3SourceConnectionOptions) ValidateAll() error {
+// MssqlSourceConnectionOptionsMultiError, or nil if none found.
+func (m *MssqlSourceConnectionOptions) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AwsS3SourceConnectionOptions) validate(all bool) error {
+func (m *MssqlSourceConnectionOptions) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	// no validation rules for HaltOnNewColumnAddition
+
+	for idx, item := range m.GetSchemas() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, MssqlSourceConnectionOptionsValidationError{
+						field:  fmt.Sprintf("Schemas[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, MssqlSourceConnectionOptionsValidationError{
+						field:  fmt.Sprintf("Schemas[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return MssqlSourceConnectionOptionsValidationError{
+					field:  fmt.Sprintf("Schemas[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
 	// no validation rules for ConnectionId
 
+	// no validation rules for SubsetByForeignKeyConstraints
+
+	if m.SchemaDriftOptions != nil {
+
+		if all {
+			switch v := interface{}(m.GetSchemaDriftOptions()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, MssqlSourceConnectionOptionsValidationError{
+						field:  "SchemaDriftOptions",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, MssqlSourceConnectionOptionsValidationError{
+						field:  "SchemaDriftOptions",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetSchemaDriftOptions()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return MssqlSourceConnectionOptionsValidationError{
+					field:  "SchemaDriftOptions",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
 	if len(errors) > 0 {
-		return AwsS3SourceConnectionOptionsMultiError(errors)
+		return MssqlSourceConnectionOptionsMultiError(errors)
 	}
 
 	return nil
 }
 
-// AwsS3SourceConnectionOptionsMultiError is an error wrapping multiple
-// validation errors returned by AwsS3SourceConnectionOptions.ValidateAll() if
+// MssqlSourceConnectionOptionsMultiError is an error wrapping multiple
+// validation errors returned by MssqlSourceConnectionOptions.ValidateAll() if
 // the designated constraints aren't met.
-type AwsS3SourceConnectionOptionsMultiError []error
+type MssqlSourceConnectionOptionsMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AwsS3SourceConnectionOptionsMultiError) Error() string {
+func (m MssqlSourceConnectionOptionsMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2142,12 +2625,12 @@ func (m AwsS3SourceConnectionOptionsMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AwsS3SourceConnectionOptionsMultiError) AllErrors() []error { return m }
+func (m MssqlSourceConnectionOptionsMultiError) AllErrors() []error { return m }
 
-// AwsS3SourceConnectionOptionsValidationError is the validation error returned
-// by AwsS3SourceConnectionOptions.Validate if the designated constraints
+// MssqlSourceConnectionOptionsValidationError is the validation error returned
+// by MssqlSourceConnectionOptions.Validate if the designated constraints
 // aren't met.
-type AwsS3SourceConnectionOptionsValidationError struct {
+type MssqlSourceConnectionOptionsValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2155,24 +2638,24 @@ type AwsS3SourceConnectionOptionsValidationError struct {
 }
 
 // Field function returns field value.
-func (e AwsS3SourceConnectionOptionsValidationError) Field() string { return e.field }
+func (e MssqlSourceConnectionOptionsValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AwsS3SourceConnectionOptionsValidationError) Reason() string { return e.reason }
+func (e MssqlSourceConnectionOptionsValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AwsS3SourceConnectionOptionsValidationError) Cause() error { return e.cause }
+func (e MssqlSourceConnectionOptionsValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AwsS3SourceConnectionOptionsValidationError) Key() bool { return e.key }
+func (e MssqlSourceConnectionOptionsValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AwsS3SourceConnectionOptionsValidationError) ErrorName() string {
-	return "AwsS3SourceConnectionOptionsValidationError"
+func (e MssqlSourceConnectionOptionsValidationError) ErrorName() string {
+	return "MssqlSourceConnectionOptionsValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e AwsS3SourceConnectionOptionsValidationError) Error() string {
+func (e MssqlSourceConnectionOptionsValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2184,14 +2667,14 @@ func (e AwsS3SourceConnectionOptionsValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAwsS3SourceConnectionOptions.%s: %s%s",
+		"invalid %sMssqlSourceConnectionOptions.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AwsS3SourceConnectionOptionsValidationError{}
+var _ error = MssqlSourceConnectionOptionsValidationError{}
 
 var _ interface {
 	Field() string
@@ -2199,172 +2682,80 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AwsS3SourceConnectionOptionsValidationError{}
+} = MssqlSourceConnectionOptionsValidationError{}
 
-// Validate checks the field values on JobDestinationOptions with the rules
+// Validate checks the field values on MssqlSourceSchemaOption with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *JobDestinationOptions) Validate() error {
+func (m *MssqlSourceSchemaOption) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on JobDestinationOptions with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on MssqlSourceSchemaOption with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// JobDestinationOptionsMultiError, or nil if none found.
-func (m *JobDestinationOptions) ValidateAll() error {
+// MssqlSourceSchemaOptionMultiError, or nil if none found.
+func (m *MssqlSourceSchemaOption) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *JobDestinationOptions) validate(all bool) error {
+func (m *MssqlSourceSchemaOption) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	switch v := m.Config.(type) {
-	case *JobDestinationOptions_PostgresOptions:
-		if v == nil {
-			err := JobDestinationOptionsValidationError{
-				field:  "Config",
-				reason: "oneof value cannot be a typed-nil",
-			}
-			if !all {
-				return err
-			}
-			errors = append(errors, err)
-		}
-
-		if all {
-			switch v := interface{}(m.GetPostgresOptions()).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, JobDestinationOptionsValidationError{
-						field:  "PostgresOptions",
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, JobDestinationOptionsValidationError{
-						field:  "PostgresOptions",
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(m.GetPostgresOptions()).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return JobDestinationOptionsValidationError{
-					field:  "PostgresOptions",
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
-
-	case *JobDestinationOptions_AwsS3Options:
-		if v == nil {
-			err := JobDestinationOptionsValidationError{
-				field:  "Config",
-				reason: "oneof value cannot be a typed-nil",
-			}
-			if !all {
-				return err
-			}
-			errors = append(errors, err)
-		}
-
-		if all {
-			switch v := interface{}(m.GetAwsS3Options()).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, JobDestinationOptionsValidationError{
-						field:  "AwsS3Options",
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, JobDestinationOptionsValidationError{
-						field:  "AwsS3Options",
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(m.GetAwsS3Options()).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return JobDestinationOptionsValidationError{
-					field:  "AwsS3Options",
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
+	// no validation rules for Schema
 
-	case *JobDestinationOptions_MysqlOptions:
-		if v == nil {
-			err := JobDestinationOptionsValidationError{
-				field:  "Config",
-				reason: "oneof value cannot be a typed-nil",
-			}
-			if !all {
-				return err
-			}
-			errors = append(errors, err)
-		}
+	for idx, item := range m.GetTables() {
+		_, _ = idx, item
 
 		if all {
-			switch v := interface{}(m.GetMysqlOptions()).(type) {
+			switch v := interface{}(item).(type) {
 			case interface{ ValidateAll() error }:
 				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, JobDestinationOptionsValidationError{
-						field:  "MysqlOptions",
+					errors = append(errors, MssqlSourceSchemaOptionValidationError{
+						field:  fmt.Sprintf("Tables[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			case interface{ Validate() error }:
 				if err := v.Validate(); err != nil {
-					errors = append(errors, JobDestinationOptionsValidationError{
-						field:  "MysqlOptions",
+					errors = append(errors, MssqlSourceSchemaOptionValidationError{
+						field:  fmt.Sprintf("Tables[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			}
-		} else if v, ok := interface{}(m.GetMysqlOptions()).(interface{ Validate() error }); ok {
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				return JobDestinationOptionsValidationError{
-					field:  "MysqlOptions",
+				return MssqlSourceSchemaOptionValidationError{
+					field:  fmt.Sprintf("Tables[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
 				}
 			}
 		}
 
-	default:
-		_ = v // ensures v is used
 	}
 
 	if len(errors) > 0 {
-		return JobDestinationOptionsMultiError(errors)
+		return MssqlSourceSchemaOptionMultiError(errors)
 	}
 
 	return nil
 }
 
-// JobDestinationOptionsMultiError is an error wrapping multiple validation
-// errors returned by JobDestinationOptions.ValidateAll() if the designated
+// MssqlSourceSchemaOptionMultiError is an error wrapping multiple validation
+// errors returned by MssqlSourceSchemaOption.ValidateAll() if the designated
 // constraints aren't met.
-type JobDestinationOptionsMultiError []error
+type MssqlSourceSchemaOptionMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m JobDestinationOptionsMultiError) Error() string {
+func (m MssqlSourceSchemaOptionMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2373,11 +2764,11 @@ func (m JobDestinationOptionsMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m JobDestinationOptionsMultiError) AllErrors() []error { return m }
+func (m MssqlSourceSchemaOptionMultiError) AllErrors() []error { return m }
 
-// JobDestinationOptionsValidationError is the validation error returned by
-// JobDestinationOptions.Validate if the designated constraints aren't met.
-type JobDestinationOptionsValidationError struct {
+// MssqlSourceSchemaOptionValidationError is the validation error returned by
+// MssqlSourceSchemaOption.Validate if the designated constraints aren't met.
+type MssqlSourceSchemaOptionValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2385,24 +2776,24 @@ type JobDestinationOptionsValidationError struct {
 }
 
 // Field function returns field value.
-func (e JobDestinationOptionsValidationError) Field() string { return e.field }
+func (e MssqlSourceSchemaOptionValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e JobDestinationOptionsValidationError) Reason() string { return e.reason }
+func (e MssqlSourceSchemaOptionValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e JobDestinationOptionsValidationError) Cause() error { return e.cause }
+func (e MssqlSourceSchemaOptionValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e JobDestinationOptionsValidationError) Key() bool { return e.key }
+func (e MssqlSourceSchemaOptionValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e JobDestinationOptionsValidationError) ErrorName() string {
-	return "JobDestinationOptionsValidationError"
+func (e MssqlSourceSchemaOptionValidationError) ErrorName() string {
+	return "MssqlSourceSchemaOptionValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e JobDestinationOptionsValidationError) Error() string {
+func (e MssqlSourceSchemaOptionValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2414,14 +2805,14 @@ func (e JobDestinationOptionsValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sJobDestinationOptions.%s: %s%s",
+		"invalid %sMssqlSourceSchemaOption.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = JobDestinationOptionsValidationError{}
+var _ error = MssqlSourceSchemaOptionValidationError{}
 
 var _ interface {
 	Field() string
@@ -2429,77 +2820,50 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = JobDestinationOptionsValidationError{}
+} = MssqlSourceSchemaOptionValidationError{}
 
-// Validate checks the field values on PostgresDestinationConnectionOptions
-// with the rules defined in the proto definition for this message. If any
-// rules are violated, the first error encountered is returned, or nil if
-// there are no violations.
-func (m *PostgresDestinationConnectionOptions) Validate() error {
+// Validate checks the field values on MssqlSourceTableOption with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *MssqlSourceTableOption) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on PostgresDestinationConnectionOptions
-// with the rules defined in the proto definition for this message. If any
-// rules are violated, the result is a list of violation errors wrapped in
-// PostgresDestinationConnectionOptionsMultiError, or nil if none found.
-func (m *PostgresDestinationConnectionOptions) ValidateAll() error {
+// ValidateAll checks the field values on MssqlSourceTableOption with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// MssqlSourceTableOptionMultiError, or nil if none found.
+func (m *MssqlSourceTableOption) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *PostgresDestinationConnectionOptions) validate(all bool) error {
+func (m *MssqlSourceTableOption) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if all {
-		switch v := interface{}(m.GetTruncateTable()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, PostgresDestinationConnectionOptionsValidationError{
-					field:  "TruncateTable",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, PostgresDestinationConnectionOptionsValidationError{
-					field:  "TruncateTable",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetTruncateTable()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return PostgresDestinationConnectionOptionsValidationError{
-				field:  "TruncateTable",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
+	// no validation rules for Table
 
-	// no validation rules for InitTableSchema
+	if m.WhereClause != nil {
+		// no validation rules for WhereClause
+	}
 
 	if len(errors) > 0 {
-		return PostgresDestinationConnectionOptionsMultiError(errors)
+		return MssqlSourceTableOptionMultiError(errors)
 	}
 
 	return nil
 }
 
-// PostgresDestinationConnectionOptionsMultiError is an error wrapping multiple
-// validation errors returned by
-// PostgresDestinationConnectionOptions.ValidateAll() if the designated
+// MssqlSourceTableOptionMultiError is an error wrapping multiple validation
+// errors returned by MssqlSourceTableOption.ValidateAll() if the designated
 // constraints aren't met.
-type PostgresDestinationConnectionOptionsMultiError []error
+type MssqlSourceTableOptionMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m PostgresDestinationConnectionOptionsMultiError) Error() string {
+func (m MssqlSourceTableOptionMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2508,12 +2872,11 @@ func (m PostgresDestinationConnectionOptionsMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m PostgresDestinationConnectionOptionsMultiError) AllErrors() []error { return m }
+func (m MssqlSourceTableOptionMultiError) AllErrors() []error { return m }
 
-// PostgresDestinationConnectionOptionsValidationError is the validation error
-// returned by PostgresDestinationConnectionOptions.Validate if the designated
-// constraints aren't met.
-type PostgresDestinationConnectionOptionsValidationError struct {
+// MssqlSourceTableOptionValidationError is the validation error returned by
+// MssqlSourceTableOption.Validate if the designated constraints aren't met.
+type MssqlSourceTableOptionValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2521,24 +2884,24 @@ type PostgresDestinationConnectionOptionsValidationError struct {
 }
 
 // Field function returns field value.
-func (e PostgresDestinationConnectionOptionsValidationError) Field() string { return e.field }
+func (e MssqlSourceTableOptionValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e PostgresDestinationConnectionOptionsValidationError) Reason() string { return e.reason }
+func (e MssqlSourceTableOptionValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e PostgresDestinationConnectionOptionsValidationError) Cause() error { return e.cause }
+func (e MssqlSourceTableOptionValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e PostgresDestinationConnectionOptionsValidationError) Key() bool { return e.key }
+func (e MssqlSourceTableOptionValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e PostgresDestinationConnectionOptionsValidationError) ErrorName() string {
-	return "PostgresDestinationConnectionOptionsValidationError"
+func (e MssqlSourceTableOptionValidationError) ErrorName() string {
+	return "MssqlSourceTableOptionValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e PostgresDestinationConnectionOptionsValidationError) Error() string {
+func (e MssqlSourceTableOptionValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2550,14 +2913,14 @@ func (e PostgresDestinationConnectionOptionsValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sPostgresDestinationConnectionOptions.%s: %s%s",
+		"invalid %sMssqlSourceTableOption.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = PostgresDestinationConnectionOptionsValidationError{}
+var _ error = MssqlSourceTableOptionValidationError{}
 
 var _ interface {
 	Field() string
@@ -2565,48 +2928,46 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = PostgresDestinationConnectionOptionsValidationError{}
+} = MssqlSourceTableOptionValidationError{}
 
-// Validate checks the field values on PostgresTruncateTableConfig with the
+// Validate checks the field values on AwsS3SourceConnectionOptions with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *PostgresTruncateTableConfig) Validate() error {
+func (m *AwsS3SourceConnectionOptions) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on PostgresTruncateTableConfig with the
+// ValidateAll checks the field values on AwsS3SourceConnectionOptions with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// PostgresTruncateTableConfigMultiError, or nil if none found.
-func (m *PostgresTruncateTableConfig) ValidateAll() error {
+// AwsS3SourceConnectionOptionsMultiError, or nil if none found.
+func (m *AwsS3SourceConnectionOptions) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *PostgresTruncateTableConfig) validate(all bool) error {
+func (m *AwsS3SourceConnectionOptions) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for TruncateBeforeInsert
-
-	// no validation rules for Cascade
+	// no validation rules for ConnectionId
 
 	if len(errors) > 0 {
-		return PostgresTruncateTableConfigMultiError(errors)
+		return AwsS3SourceConnectionOptionsMultiError(errors)
 	}
 
 	return nil
 }
 
-// PostgresTruncateTableConfigMultiError is an error wrapping multiple
-// validation errors returned by PostgresTruncateTableConfig.ValidateAll() if
+// AwsS3SourceConnectionOptionsMultiError is an error wrapping multiple
+// validation errors returned by AwsS3SourceConnectionOptions.ValidateAll() if
 // the designated constraints aren't met.
-type PostgresTruncateTableConfigMultiError []error
+type AwsS3SourceConnectionOptionsMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m PostgresTruncateTableConfigMultiError) Error() string {
+func (m AwsS3SourceConnectionOptionsMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2615,12 +2976,12 @@ func (m PostgresTruncateTableConfigMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m PostgresTruncateTableConfigMultiError) AllErrors() []error { return m }
+func (m AwsS3SourceConnectionOptionsMultiError) AllErrors() []error { return m }
 
-// PostgresTruncateTableConfigValidationError is the validation error returned
-// by PostgresTruncateTableConfig.Validate if the designated constraints
+// AwsS3SourceConnectionOptionsValidationError is the validation error returned
+// by AwsS3SourceConnectionOptions.Validate if the designated constraints
 // aren't met.
-type PostgresTruncateTableConfigValidationError struct {
+type AwsS3SourceConnectionOptionsValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2628,24 +2989,24 @@ type PostgresTruncateTableConfigValidationError struct {
 }
 
 // Field function returns field value.
-func (e PostgresTruncateTableConfigValidationError) Field() string { return e.field }
+func (e AwsS3SourceConnectionOptionsValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e PostgresTruncateTableConfigValidationError) Reason() string { return e.reason }
+func (e AwsS3SourceConnectionOptionsValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e PostgresTruncateTableConfigValidationError) Cause() error { return e.cause }
+func (e AwsS3SourceConnectionOptionsValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e PostgresTruncateTableConfigValidationError) Key() bool { return e.key }
+func (e AwsS3SourceConnectionOptionsValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e PostgresTruncateTableConfigValidationError) ErrorName() string {
-	return "PostgresTruncateTableConfigValidationError"
+func (e AwsS3SourceConnectionOptionsValidationError) ErrorName() string {
+	return "AwsS3SourceConnectionOptionsValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e PostgresTruncateTableConfigValidationError) Error() string {
+func (e AwsS3SourceConnectionOptionsValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2657,14 +3018,14 @@ func (e PostgresTruncateTableConfigValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sPostgresTruncateTableConfig.%s: %s%s",
+		"invalid %sAwsS3SourceConnectionOptions.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = PostgresTruncateTableConfigValidationError{}
+var _ error = AwsS3SourceConnectionOptionsValidationError{}
 
 var _ interface {
 	Field() string
@@ -2672,25 +3033,6931 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = PostgresTruncateTableConfigValidationError{}
+} = AwsS3SourceConnectionOptionsValidationError{}
 
-// Validate checks the field values on MysqlDestinationConnectionOptions with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the first error encountered is returned, or nil if there are
-// no violations.
-func (m *MysqlDestinationConnectionOptions) Validate() error {
+// Validate checks the field values on JobDestinationOptions with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *JobDestinationOptions) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on MysqlDestinationConnectionOptions
-// with the rules defined in the proto definition for this message. If any
+// ValidateAll checks the field values on JobDestinationOptions with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// JobDestinationOptionsMultiError, or nil if none found.
+func (m *JobDestinationOptions) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *JobDestinationOptions) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	switch v := m.Config.(type) {
+	case *JobDestinationOptions_PostgresOptions:
+		if v == nil {
+			err := JobDestinationOptionsValidationError{
+				field:  "Config",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetPostgresOptions()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, JobDestinationOptionsValidationError{
+						field:  "PostgresOptions",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, JobDestinationOptionsValidationError{
+						field:  "PostgresOptions",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetPostgresOptions()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return JobDestinationOptionsValidationError{
+					field:  "PostgresOptions",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *JobDestinationOptions_AwsS3Options:
+		if v == nil {
+			err := JobDestinationOptionsValidationError{
+				field:  "Config",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetAwsS3Options()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, JobDestinationOptionsValidationError{
+						field:  "AwsS3Options",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, JobDestinationOptionsValidationError{
+						field:  "AwsS3Options",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetAwsS3Options()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return JobDestinationOptionsValidationError{
+					field:  "AwsS3Options",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *JobDestinationOptions_MysqlOptions:
+		if v == nil {
+			err := JobDestinationOptionsValidationError{
+				field:  "Config",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetMysqlOptions()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, JobDestinationOptionsValidationError{
+						field:  "MysqlOptions",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, JobDestinationOptionsValidationError{
+						field:  "MysqlOptions",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetMysqlOptions()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return JobDestinationOptionsValidationError{
+					field:  "MysqlOptions",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *JobDestinationOptions_MssqlOptions:
+		if v == nil {
+			err := JobDestinationOptionsValidationError{
+				field:  "Config",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetMssqlOptions()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, JobDestinationOptionsValidationError{
+						field:  "MssqlOptions",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, JobDestinationOptionsValidationError{
+						field:  "MssqlOptions",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetMssqlOptions()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return JobDestinationOptionsValidationError{
+					field:  "MssqlOptions",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *JobDestinationOptions_SqliteOptions:
+		if v == nil {
+			err := JobDestinationOptionsValidationError{
+				field:  "Config",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetSqliteOptions()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, JobDestinationOptionsValidationError{
+						field:  "SqliteOptions",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, JobDestinationOptionsValidationError{
+						field:  "SqliteOptions",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetSqliteOptions()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return JobDestinationOptionsValidationError{
+					field:  "SqliteOptions",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	default:
+		_ = v // ensures v is used
+	}
+
+	if len(errors) > 0 {
+		return JobDestinationOptionsMultiError(errors)
+	}
+
+	return nil
+}
+
+// JobDestinationOptionsMultiError is an error wrapping multiple validation
+// errors returned by JobDestinationOptions.ValidateAll() if the designated
+// constraints aren't met.
+type JobDestinationOptionsMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m JobDestinationOptionsMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m JobDestinationOptionsMultiError) AllErrors() []error { return m }
+
+// JobDestinationOptionsValidationError is the validation error returned by
+// JobDestinationOptions.Validate if the designated constraints aren't met.
+type JobDestinationOptionsValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e JobDestinationOptionsValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e JobDestinationOptionsValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e JobDestinationOptionsValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e JobDestinationOptionsValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e JobDestinationOptionsValidationError) ErrorName() string {
+	return "JobDestinationOptionsValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e JobDestinationOptionsValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sJobDestinationOptions.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = JobDestinationOptionsValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = JobDestinationOptionsValidationError{}
+
+// Validate checks the field values on PostgresDestinationConnectionOptions
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the first error encountered is returned, or nil if
+// there are no violations.
+func (m *PostgresDestinationConnectionOptions) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PostgresDestinationConnectionOptions
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// PostgresDestinationConnectionOptionsMultiError, or nil if none found.
+func (m *PostgresDestinationConnectionOptions) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PostgresDestinationConnectionOptions) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetTruncateTable()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, PostgresDestinationConnectionOptionsValidationError{
+					field:  "TruncateTable",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, PostgresDestinationConnectionOptionsValidationError{
+					field:  "TruncateTable",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetTruncateTable()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return PostgresDestinationConnectionOptionsValidationError{
+				field:  "TruncateTable",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for InitTableSchema
+
+	if len(errors) > 0 {
+		return PostgresDestinationConnectionOptionsMultiError(errors)
+	}
+
+	return nil
+}
+
+// PostgresDestinationConnectionOptionsMultiError is an error wrapping multiple
+// validation errors returned by
+// PostgresDestinationConnectionOptions.ValidateAll() if the designated
+// constraints aren't met.
+type PostgresDestinationConnectionOptionsMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PostgresDestinationConnectionOptionsMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PostgresDestinationConnectionOptionsMultiError) AllErrors() []error { return m }
+
+// PostgresDestinationConnectionOptionsValidationError is the validation error
+// returned by PostgresDestinationConnectionOptions.Validate if the designated
+// constraints aren't met.
+type PostgresDestinationConnectionOptionsValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PostgresDestinationConnectionOptionsValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PostgresDestinationConnectionOptionsValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PostgresDestinationConnectionOptionsValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PostgresDestinationConnectionOptionsValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PostgresDestinationConnectionOptionsValidationError) ErrorName() string {
+	return "PostgresDestinationConnectionOptionsValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e PostgresDestinationConnectionOptionsValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPostgresDestinationConnectionOptions.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PostgresDestinationConnectionOptionsValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PostgresDestinationConnectionOptionsValidationError{}
+
+// Validate checks the field values on PostgresTruncateTableConfig with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *PostgresTruncateTableConfig) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PostgresTruncateTableConfig with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// PostgresTruncateTableConfigMultiError, or nil if none found.
+func (m *PostgresTruncateTableConfig) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PostgresTruncateTableConfig) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for TruncateBeforeInsert
+
+	// no validation rules for Cascade
+
+	if len(errors) > 0 {
+		return PostgresTruncateTableConfigMultiError(errors)
+	}
+
+	return nil
+}
+
+// PostgresTruncateTableConfigMultiError is an error wrapping multiple
+// validation errors returned by PostgresTruncateTableConfig.ValidateAll() if
+// the designated constraints aren't met.
+type PostgresTruncateTableConfigMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PostgresTruncateTableConfigMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PostgresTruncateTableConfigMultiError) AllErrors() []error { return m }
+
+// PostgresTruncateTableConfigValidationError is the validation error returned
+// by PostgresTruncateTableConfig.Validate if the designated constraints
+// aren't met.
+type PostgresTruncateTableConfigValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PostgresTruncateTableConfigValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PostgresTruncateTableConfigValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PostgresTruncateTableConfigValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PostgresTruncateTableConfigValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PostgresTruncateTableConfigValidationError) ErrorName() string {
+	return "PostgresTruncateTableConfigValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e PostgresTruncateTableConfigValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPostgresTruncateTableConfig.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PostgresTruncateTableConfigValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PostgresTruncateTableConfigValidationError{}
+
+// Validate checks the field values on MysqlDestinationConnectionOptions with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *MysqlDestinationConnectionOptions) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on MysqlDestinationConnectionOptions
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// MysqlDestinationConnectionOptionsMultiError, or nil if none found.
+func (m *MysqlDestinationConnectionOptions) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *MysqlDestinationConnectionOptions) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetTruncateTable()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, MysqlDestinationConnectionOptionsValidationError{
+					field:  "TruncateTable",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, MysqlDestinationConnectionOptionsValidationError{
+					field:  "TruncateTable",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetTruncateTable()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return MysqlDestinationConnectionOptionsValidationError{
+				field:  "TruncateTable",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for InitTableSchema
+
+	if len(errors) > 0 {
+		return MysqlDestinationConnectionOptionsMultiError(errors)
+	}
+
+	return nil
+}
+
+// MysqlDestinationConnectionOptionsMultiError is an error wrapping multiple
+// validation errors returned by
+// MysqlDestinationConnectionOptions.ValidateAll() if the designated
+// constraints aren't met.
+type MysqlDestinationConnectionOptionsMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MysqlDestinationConnectionOptionsMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m MysqlDestinationConnectionOptionsMultiError) AllErrors() []error { return m }
+
+// MysqlDestinationConnectionOptionsValidationError is the validation error
+// returned by MysqlDestinationConnectionOptions.Validate if the designated
+// constraints aren't met.
+type MysqlDestinationConnectionOptionsValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MysqlDestinationConnectionOptionsValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MysqlDestinationConnectionOptionsValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MysqlDestinationConnectionOptionsValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MysqlDestinationConnectionOptionsValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MysqlDestinationConnectionOptionsValidationError) ErrorName() string {
+	return "MysqlDestinationConnectionOptionsValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e MysqlDestinationConnectionOptionsValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMysqlDestinationConnectionOptions.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MysqlDestinationConnectionOptionsValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MysqlDestinationConnectionOptionsValidationError{}
+
+// Validate checks the field values on MysqlTruncateTableConfig with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *MysqlTruncateTableConfig) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on MysqlTruncateTableConfig with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// MysqlTruncateTableConfigMultiError, or nil if none found.
+func (m *MysqlTruncateTableConfig) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *MysqlTruncateTableConfig) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for TruncateBeforeInsert
+
+	if len(errors) > 0 {
+		return MysqlTruncateTableConfigMultiError(errors)
+	}
+
+	return nil
+}
+
+// MysqlTruncateTableConfigMultiError is an error wrapping multiple validation
+// errors returned by MysqlTruncateTableConfig.ValidateAll() if the designated
+// constraints aren't met.
+type MysqlTruncateTableConfigMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MysqlTruncateTableConfigMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m MysqlTruncateTableConfigMultiError) AllErrors() []error { return m }
+
+// MysqlTruncateTableConfigValidationError is the validation error returned by
+// MysqlTruncateTableConfig.Validate if the designated constraints aren't met.
+type MysqlTruncateTableConfigValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MysqlTruncateTableConfigValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MysqlTruncateTableConfigValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MysqlTruncateTableConfigValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MysqlTruncateTableConfigValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MysqlTruncateTableConfigValidationError) ErrorName() string {
+	return "MysqlTruncateTableConfigValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e MysqlTruncateTableConfigValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMysqlTruncateTableConfig.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MysqlTruncateTableConfigValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MysqlTruncateTableConfigValidationError{}
+
+// Validate checks the field values on MssqlDestinationConnectionOptions with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *MssqlDestinationConnectionOptions) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on MssqlDestinationConnectionOptions
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// MssqlDestinationConnectionOptionsMultiError, or nil if none found.
+func (m *MssqlDestinationConnectionOptions) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *MssqlDestinationConnectionOptions) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetTruncateTable()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, MssqlDestinationConnectionOptionsValidationError{
+					field:  "TruncateTable",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, MssqlDestinationConnectionOptionsValidationError{
+					field:  "TruncateTable",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetTruncateTable()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return MssqlDestinationConnectionOptionsValidationError{
+				field:  "TruncateTable",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for InitTableSchema
+
+	if len(errors) > 0 {
+		return MssqlDestinationConnectionOptionsMultiError(errors)
+	}
+
+	return nil
+}
+
+// MssqlDestinationConnectionOptionsMultiError is an error wrapping multiple
+// validation errors returned by
+// MssqlDestinationConnectionOptions.ValidateAll() if the designated
+// constraints aren't met.
+type MssqlDestinationConnectionOptionsMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MssqlDestinationConnectionOptionsMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m MssqlDestinationConnectionOptionsMultiError) AllErrors() []error { return m }
+
+// MssqlDestinationConnectionOptionsValidationError is the validation error
+// returned by MssqlDestinationConnectionOptions.Validate if the designated
+// constraints aren't met.
+type MssqlDestinationConnectionOptionsValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MssqlDestinationConnectionOptionsValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MssqlDestinationConnectionOptionsValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MssqlDestinationConnectionOptionsValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MssqlDestinationConnectionOptionsValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MssqlDestinationConnectionOptionsValidationError) ErrorName() string {
+	return "MssqlDestinationConnectionOptionsValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e MssqlDestinationConnectionOptionsValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMssqlDestinationConnectionOptions.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MssqlDestinationConnectionOptionsValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MssqlDestinationConnectionOptionsValidationError{}
+
+// Validate checks the field values on MssqlTruncateTableConfig with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *MssqlTruncateTableConfig) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on MssqlTruncateTableConfig with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// MssqlTruncateTableConfigMultiError, or nil if none found.
+func (m *MssqlTruncateTableConfig) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *MssqlTruncateTableConfig) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for TruncateBeforeInsert
+
+	if len(errors) > 0 {
+		return MssqlTruncateTableConfigMultiError(errors)
+	}
+
+	return nil
+}
+
+// MssqlTruncateTableConfigMultiError is an error wrapping multiple validation
+// errors returned by MssqlTruncateTableConfig.ValidateAll() if the designated
+// constraints aren't met.
+type MssqlTruncateTableConfigMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MssqlTruncateTableConfigMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m MssqlTruncateTableConfigMultiError) AllErrors() []error { return m }
+
+// MssqlTruncateTableConfigValidationError is the validation error returned by
+// MssqlTruncateTableConfig.Validate if the designated constraints aren't met.
+type MssqlTruncateTableConfigValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MssqlTruncateTableConfigValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MssqlTruncateTableConfigValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MssqlTruncateTableConfigValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MssqlTruncateTableConfigValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MssqlTruncateTableConfigValidationError) ErrorName() string {
+	return "MssqlTruncateTableConfigValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e MssqlTruncateTableConfigValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMssqlTruncateTableConfig.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MssqlTruncateTableConfigValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MssqlTruncateTableConfigValidationError{}
+
+// Validate checks the field values on SqliteDestinationConnectionOptions with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *SqliteDestinationConnectionOptions) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SqliteDestinationConnectionOptions
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// SqliteDestinationConnectionOptionsMultiError, or nil if none found.
+func (m *SqliteDestinationConnectionOptions) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SqliteDestinationConnectionOptions) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetTruncateTable()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SqliteDestinationConnectionOptionsValidationError{
+					field:  "TruncateTable",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SqliteDestinationConnectionOptionsValidationError{
+					field:  "TruncateTable",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetTruncateTable()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SqliteDestinationConnectionOptionsValidationError{
+				field:  "TruncateTable",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for InitTableSchema
+
+	if len(errors) > 0 {
+		return SqliteDestinationConnectionOptionsMultiError(errors)
+	}
+
+	return nil
+}
+
+// SqliteDestinationConnectionOptionsMultiError is an error wrapping multiple
+// validation errors returned by
+// SqliteDestinationConnectionOptions.ValidateAll() if the designated
+// constraints aren't met.
+type SqliteDestinationConnectionOptionsMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SqliteDestinationConnectionOptionsMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SqliteDestinationConnectionOptionsMultiError) AllErrors() []error { return m }
+
+// SqliteDestinationConnectionOptionsValidationError is the validation error
+// returned by SqliteDestinationConnectionOptions.Validate if the designated
+// constraints aren't met.
+type SqliteDestinationConnectionOptionsValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SqliteDestinationConnectionOptionsValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SqliteDestinationConnectionOptionsValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SqliteDestinationConnectionOptionsValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SqliteDestinationConnectionOptionsValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SqliteDestinationConnectionOptionsValidationError) ErrorName() string {
+	return "SqliteDestinationConnectionOptionsValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SqliteDestinationConnectionOptionsValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSqliteDestinationConnectionOptions.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SqliteDestinationConnectionOptionsValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SqliteDestinationConnectionOptionsValidationError{}
+
+// Validate checks the field values on SqliteTruncateTableConfig with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SqliteTruncateTableConfig) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SqliteTruncateTableConfig with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SqliteTruncateTableConfigMultiError, or nil if none found.
+func (m *SqliteTruncateTableConfig) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SqliteTruncateTableConfig) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for TruncateBeforeInsert
+
+	if len(errors) > 0 {
+		return SqliteTruncateTableConfigMultiError(errors)
+	}
+
+	return nil
+}
+
+// SqliteTruncateTableConfigMultiError is an error wrapping multiple validation
+// errors returned by SqliteTruncateTableConfig.ValidateAll() if the
+// designated constraints aren't met.
+type SqliteTruncateTableConfigMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SqliteTruncateTableConfigMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SqliteTruncateTableConfigMultiError) AllErrors() []error { return m }
+
+// SqliteTruncateTableConfigValidationError is the validation error returned by
+// SqliteTruncateTableConfig.Validate if the designated constraints aren't met.
+type SqliteTruncateTableConfigValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SqliteTruncateTableConfigValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SqliteTruncateTableConfigValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SqliteTruncateTableConfigValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SqliteTruncateTableConfigValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SqliteTruncateTableConfigValidationError) ErrorName() string {
+	return "SqliteTruncateTableConfigValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SqliteTruncateTableConfigValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSqliteTruncateTableConfig.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SqliteTruncateTableConfigValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SqliteTruncateTableConfigValidationError{}
+
+// Validate checks the field values on AwsS3DestinationConnectionOptions with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *AwsS3DestinationConnectionOptions) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AwsS3DestinationConnectionOptions
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// AwsS3DestinationConnectionOptionsMultiError, or nil if none found.
+func (m *AwsS3DestinationConnectionOptions) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AwsS3DestinationConnectionOptions) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Format
+
+	if m.MaxFileSizeBytes != nil {
+		// no validation rules for MaxFileSizeBytes
+	}
+
+	if len(errors) > 0 {
+		return AwsS3DestinationConnectionOptionsMultiError(errors)
+	}
+
+	return nil
+}
+
+// AwsS3DestinationConnectionOptionsMultiError is an error wrapping multiple
+// validation errors returned by
+// AwsS3DestinationConnectionOptions.ValidateAll() if the designated
+// constraints aren't met.
+type AwsS3DestinationConnectionOptionsMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AwsS3DestinationConnectionOptionsMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AwsS3DestinationConnectionOptionsMultiError) AllErrors() []error { return m }
+
+// AwsS3DestinationConnectionOptionsValidationError is the validation error
+// returned by AwsS3DestinationConnectionOptions.Validate if the designated
+// constraints aren't met.
+type AwsS3DestinationConnectionOptionsValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AwsS3DestinationConnectionOptionsValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AwsS3DestinationConnectionOptionsValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AwsS3DestinationConnectionOptionsValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AwsS3DestinationConnectionOptionsValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AwsS3DestinationConnectionOptionsValidationError) ErrorName() string {
+	return "AwsS3DestinationConnectionOptionsValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AwsS3DestinationConnectionOptionsValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAwsS3DestinationConnectionOptions.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AwsS3DestinationConnectionOptionsValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AwsS3DestinationConnectionOptionsValidationError{}
+
+// Validate checks the field values on CreateJobRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *CreateJobRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateJobRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateJobRequestMultiError, or nil if none found.
+func (m *CreateJobRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateJobRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for AccountId
+
+	// no validation rules for JobName
+
+	for idx, item := range m.GetMappings() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, CreateJobRequestValidationError{
+						field:  fmt.Sprintf("Mappings[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, CreateJobRequestValidationError{
+						field:  fmt.Sprintf("Mappings[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return CreateJobRequestValidationError{
+					field:  fmt.Sprintf("Mappings[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if all {
+		switch v := interface{}(m.GetSource()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateJobRequestValidationError{
+					field:  "Source",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateJobRequestValidationError{
+					field:  "Source",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSource()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateJobRequestValidationError{
+				field:  "Source",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	for idx, item := range m.GetDestinations() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, CreateJobRequestValidationError{
+						field:  fmt.Sprintf("Destinations[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, CreateJobRequestValidationError{
+						field:  fmt.Sprintf("Destinations[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return CreateJobRequestValidationError{
+					field:  fmt.Sprintf("Destinations[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for InitiateJobRun
+
+	if all {
+		switch v := interface{}(m.GetWorkflowOptions()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateJobRequestValidationError{
+					field:  "WorkflowOptions",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateJobRequestValidationError{
+					field:  "WorkflowOptions",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetWorkflowOptions()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateJobRequestValidationError{
+				field:  "WorkflowOptions",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetSyncOptions()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateJobRequestValidationError{
+					field:  "SyncOptions",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateJobRequestValidationError{
+					field:  "SyncOptions",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSyncOptions()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateJobRequestValidationError{
+				field:  "SyncOptions",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	for idx, item := range m.GetTriggers() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, CreateJobRequestValidationError{
+						field:  fmt.Sprintf("Triggers[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, CreateJobRequestValidationError{
+						field:  fmt.Sprintf("Triggers[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return CreateJobRequestValidationError{
+					field:  fmt.Sprintf("Triggers[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if m.CronSchedule != nil {
+		// no validation rules for CronSchedule
+	}
+
+	if len(errors) > 0 {
+		return CreateJobRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateJobRequestMultiError is an error wrapping multiple validation errors
+// returned by CreateJobRequest.ValidateAll() if the designated constraints
+// aren't met.
+type CreateJobRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateJobRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateJobRequestMultiError) AllErrors() []error { return m }
+
+// CreateJobRequestValidationError is the validation error returned by
+// CreateJobRequest.Validate if the designated constraints aren't met.
+type CreateJobRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateJobRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateJobRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateJobRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateJobRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateJobRequestValidationError) ErrorName() string { return "CreateJobRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e CreateJobRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateJobRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateJobRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateJobRequestValidationError{}
+
+// Validate checks the field values on WorkflowOptions with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *WorkflowOptions) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on WorkflowOptions with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// WorkflowOptionsMultiError, or nil if none found.
+func (m *WorkflowOptions) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *WorkflowOptions) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.RunTimeout != nil {
+		// no validation rules for RunTimeout
+	}
+
+	if len(errors) > 0 {
+		return WorkflowOptionsMultiError(errors)
+	}
+
+	return nil
+}
+
+// WorkflowOptionsMultiError is an error wrapping multiple validation errors
+// returned by WorkflowOptions.ValidateAll() if the designated constraints
+// aren't met.
+type WorkflowOptionsMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m WorkflowOptionsMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m WorkflowOptionsMultiError) AllErrors() []error { return m }
+
+// WorkflowOptionsValidationError is the validation error returned by
+// WorkflowOptions.Validate if the designated constraints aren't met.
+type WorkflowOptionsValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e WorkflowOptionsValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e WorkflowOptionsValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e WorkflowOptionsValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e WorkflowOptionsValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e WorkflowOptionsValidationError) ErrorName() string { return "WorkflowOptionsValidationError" }
+
+// Error satisfies the builtin error interface
+func (e WorkflowOptionsValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sWorkflowOptions.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = WorkflowOptionsValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = WorkflowOptionsValidationError{}
+
+// Validate checks the field values on ActivityOptions with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ActivityOptions) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ActivityOptions with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ActivityOptionsMultiError, or nil if none found.
+func (m *ActivityOptions) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ActivityOptions) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetRetryPolicy()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ActivityOptionsValidationError{
+					field:  "RetryPolicy",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ActivityOptionsValidationError{
+					field:  "RetryPolicy",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRetryPolicy()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ActivityOptionsValidationError{
+				field:  "RetryPolicy",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if m.ScheduleToCloseTimeout != nil {
+		// no validation rules for ScheduleToCloseTimeout
+	}
+
+	if m.StartToCloseTimeout != nil {
+		// no validation rules for StartToCloseTimeout
+	}
+
+	if len(errors) > 0 {
+		return ActivityOptionsMultiError(errors)
+	}
+
+	return nil
+}
+
+// ActivityOptionsMultiError is an error wrapping multiple validation errors
+// returned by ActivityOptions.ValidateAll() if the designated constraints
+// aren't met.
+type ActivityOptionsMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ActivityOptionsMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ActivityOptionsMultiError) AllErrors() []error { return m }
+
+// ActivityOptionsValidationError is the validation error returned by
+// ActivityOptions.Validate if the designated constraints aren't met.
+type ActivityOptionsValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ActivityOptionsValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ActivityOptionsValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ActivityOptionsValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ActivityOptionsValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ActivityOptionsValidationError) ErrorName() string { return "ActivityOptionsValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ActivityOptionsValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sActivityOptions.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ActivityOptionsValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ActivityOptionsValidationError{}
+
+// Validate checks the field values on RetryPolicy with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *RetryPolicy) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RetryPolicy with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in RetryPolicyMultiError, or
+// nil if none found.
+func (m *RetryPolicy) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RetryPolicy) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.MaximumAttempts != nil {
+		// no validation rules for MaximumAttempts
+	}
+
+	if len(errors) > 0 {
+		return RetryPolicyMultiError(errors)
+	}
+
+	return nil
+}
+
+// RetryPolicyMultiError is an error wrapping multiple validation errors
+// returned by RetryPolicy.ValidateAll() if the designated constraints aren't met.
+type RetryPolicyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RetryPolicyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RetryPolicyMultiError) AllErrors() []error { return m }
+
+// RetryPolicyValidationError is the validation error returned by
+// RetryPolicy.Validate if the designated constraints aren't met.
+type RetryPolicyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RetryPolicyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RetryPolicyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RetryPolicyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RetryPolicyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RetryPolicyValidationError) ErrorName() string { return "RetryPolicyValidationError" }
+
+// Error satisfies the builtin error interface
+func (e RetryPolicyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRetryPolicy.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RetryPolicyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RetryPolicyValidationError{}
+
+// Validate checks the field values on CreateJobResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *CreateJobResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateJobResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateJobResponseMultiError, or nil if none found.
+func (m *CreateJobResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateJobResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetJob()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateJobResponseValidationError{
+					field:  "Job",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateJobResponseValidationError{
+					field:  "Job",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetJob()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateJobResponseValidationError{
+				field:  "Job",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return CreateJobResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateJobResponseMultiError is an error wrapping multiple validation errors
+// returned by CreateJobResponse.ValidateAll() if the designated constraints
+// aren't met.
+type CreateJobResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateJobResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateJobResponseMultiError) AllErrors() []error { return m }
+
+// CreateJobResponseValidationError is the validation error returned by
+// CreateJobResponse.Validate if the designated constraints aren't met.
+type CreateJobResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateJobResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateJobResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateJobResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateJobResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateJobResponseValidationError) ErrorName() string {
+	return "CreateJobResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateJobResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateJobResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateJobResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateJobResponseValidationError{}
+
+// Validate checks the field values on JobMappingTransformer with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *JobMappingTransformer) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on JobMappingTransformer with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// JobMappingTransformerMultiError, or nil if none found.
+func (m *JobMappingTransformer) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *JobMappingTransformer) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Source
+
+	if all {
+		switch v := interface{}(m.GetConfig()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, JobMappingTransformerValidationError{
+					field:  "Config",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, JobMappingTransformerValidationError{
+					field:  "Config",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetConfig()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return JobMappingTransformerValidationError{
+				field:  "Config",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return JobMappingTransformerMultiError(errors)
+	}
+
+	return nil
+}
+
+// JobMappingTransformerMultiError is an error wrapping multiple validation
+// errors returned by JobMappingTransformer.ValidateAll() if the designated
+// constraints aren't met.
+type JobMappingTransformerMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m JobMappingTransformerMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m JobMappingTransformerMultiError) AllErrors() []error { return m }
+
+// JobMappingTransformerValidationError is the validation error returned by
+// JobMappingTransformer.Validate if the designated constraints aren't met.
+type JobMappingTransformerValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e JobMappingTransformerValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e JobMappingTransformerValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e JobMappingTransformerValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e JobMappingTransformerValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e JobMappingTransformerValidationError) ErrorName() string {
+	return "JobMappingTransformerValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e JobMappingTransformerValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sJobMappingTransformer.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = JobMappingTransformerValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = JobMappingTransformerValidationError{}
+
+// Validate checks the field values on JobMapping with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *JobMapping) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on JobMapping with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in JobMappingMultiError, or
+// nil if none found.
+func (m *JobMapping) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *JobMapping) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Schema
+
+	// no validation rules for Table
+
+	// no validation rules for Column
+
+	if all {
+		switch v := interface{}(m.GetTransformer()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, JobMappingValidationError{
+					field:  "Transformer",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, JobMappingValidationError{
+					field:  "Transformer",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetTransformer()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return JobMappingValidationError{
+				field:  "Transformer",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return JobMappingMultiError(errors)
+	}
+
+	return nil
+}
+
+// JobMappingMultiError is an error wrapping multiple validation errors
+// returned by JobMapping.ValidateAll() if the designated constraints aren't met.
+type JobMappingMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m JobMappingMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m JobMappingMultiError) AllErrors() []error { return m }
+
+// JobMappingValidationError is the validation error returned by
+// JobMapping.Validate if the designated constraints aren't met.
+type JobMappingValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e JobMappingValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e JobMappingValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e JobMappingValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e JobMappingValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e JobMappingValidationError) ErrorName() string { return "JobMappingValidationError" }
+
+// Error satisfies the builtin error interface
+func (e JobMappingValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sJobMapping.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = JobMappingValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = JobMappingValidationError{}
+
+// Validate checks the field values on GetJobRequest with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *GetJobRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetJobRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in GetJobRequestMultiError, or
+// nil if none found.
+func (m *GetJobRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetJobRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if len(errors) > 0 {
+		return GetJobRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetJobRequestMultiError is an error wrapping multiple validation errors
+// returned by GetJobRequest.ValidateAll() if the designated constraints
+// aren't met.
+type GetJobRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetJobRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetJobRequestMultiError) AllErrors() []error { return m }
+
+// GetJobRequestValidationError is the validation error returned by
+// GetJobRequest.Validate if the designated constraints aren't met.
+type GetJobRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetJobRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetJobRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetJobRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetJobRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetJobRequestValidationError) ErrorName() string { return "GetJobRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GetJobRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetJobRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetJobRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetJobRequestValidationError{}
+
+// Validate checks the field values on GetJobResponse with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *GetJobResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetJobResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in GetJobResponseMultiError,
+// or nil if none found.
+func (m *GetJobResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetJobResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetJob()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetJobResponseValidationError{
+					field:  "Job",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetJobResponseValidationError{
+					field:  "Job",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetJob()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetJobResponseValidationError{
+				field:  "Job",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GetJobResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetJobResponseMultiError is an error wrapping multiple validation errors
+// returned by GetJobResponse.ValidateAll() if the designated constraints
+// aren't met.
+type GetJobResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetJobResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetJobResponseMultiError) AllErrors() []error { return m }
+
+// GetJobResponseValidationError is the validation error returned by
+// GetJobResponse.Validate if the designated constraints aren't met.
+type GetJobResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetJobResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetJobResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetJobResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetJobResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetJobResponseValidationError) ErrorName() string { return "GetJobResponseValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GetJobResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetJobResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetJobResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetJobResponseValidationError{}
+
+// Validate checks the field values on UpdateJobScheduleRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdateJobScheduleRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateJobScheduleRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateJobScheduleRequestMultiError, or nil if none found.
+func (m *UpdateJobScheduleRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateJobScheduleRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if m.CronSchedule != nil {
+		// no validation rules for CronSchedule
+	}
+
+	if len(errors) > 0 {
+		return UpdateJobScheduleRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateJobScheduleRequestMultiError is an error wrapping multiple validation
+// errors returned by UpdateJobScheduleRequest.ValidateAll() if the designated
+// constraints aren't met.
+type UpdateJobScheduleRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateJobScheduleRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateJobScheduleRequestMultiError) AllErrors() []error { return m }
+
+// UpdateJobScheduleRequestValidationError is the validation error returned by
+// UpdateJobScheduleRequest.Validate if the designated constraints aren't met.
+type UpdateJobScheduleRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateJobScheduleRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateJobScheduleRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateJobScheduleRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateJobScheduleRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateJobScheduleRequestValidationError) ErrorName() string {
+	return "UpdateJobScheduleRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateJobScheduleRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateJobScheduleRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateJobScheduleRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateJobScheduleRequestValidationError{}
+
+// Validate checks the field values on UpdateJobScheduleResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdateJobScheduleResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateJobScheduleResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateJobScheduleResponseMultiError, or nil if none found.
+func (m *UpdateJobScheduleResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateJobScheduleResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetJob()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateJobScheduleResponseValidationError{
+					field:  "Job",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateJobScheduleResponseValidationError{
+					field:  "Job",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetJob()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateJobScheduleResponseValidationError{
+				field:  "Job",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return UpdateJobScheduleResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateJobScheduleResponseMultiError is an error wrapping multiple validation
+// errors returned by UpdateJobScheduleResponse.ValidateAll() if the
+// designated constraints aren't met.
+type UpdateJobScheduleResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateJobScheduleResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateJobScheduleResponseMultiError) AllErrors() []error { return m }
+
+// UpdateJobScheduleResponseValidationError is the validation error returned by
+// UpdateJobScheduleResponse.Validate if the designated constraints aren't met.
+type UpdateJobScheduleResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateJobScheduleResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateJobScheduleResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateJobScheduleResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateJobScheduleResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateJobScheduleResponseValidationError) ErrorName() string {
+	return "UpdateJobScheduleResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateJobScheduleResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateJobScheduleResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateJobScheduleResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateJobScheduleResponseValidationError{}
+
+// Validate checks the field values on PauseJobRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *PauseJobRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PauseJobRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// PauseJobRequestMultiError, or nil if none found.
+func (m *PauseJobRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PauseJobRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Pause
+
+	if m.Note != nil {
+		// no validation rules for Note
+	}
+
+	if len(errors) > 0 {
+		return PauseJobRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// PauseJobRequestMultiError is an error wrapping multiple validation errors
+// returned by PauseJobRequest.ValidateAll() if the designated constraints
+// aren't met.
+type PauseJobRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PauseJobRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PauseJobRequestMultiError) AllErrors() []error { return m }
+
+// PauseJobRequestValidationError is the validation error returned by
+// PauseJobRequest.Validate if the designated constraints aren't met.
+type PauseJobRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PauseJobRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PauseJobRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PauseJobRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PauseJobRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PauseJobRequestValidationError) ErrorName() string { return "PauseJobRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e PauseJobRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPauseJobRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PauseJobRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PauseJobRequestValidationError{}
+
+// Validate checks the field values on PauseJobResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *PauseJobResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PauseJobResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// PauseJobResponseMultiError, or nil if none found.
+func (m *PauseJobResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PauseJobResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetJob()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, PauseJobResponseValidationError{
+					field:  "Job",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, PauseJobResponseValidationError{
+					field:  "Job",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetJob()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return PauseJobResponseValidationError{
+				field:  "Job",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return PauseJobResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// PauseJobResponseMultiError is an error wrapping multiple validation errors
+// returned by PauseJobResponse.ValidateAll() if the designated constraints
+// aren't met.
+type PauseJobResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PauseJobResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PauseJobResponseMultiError) AllErrors() []error { return m }
+
+// PauseJobResponseValidationError is the validation error returned by
+// PauseJobResponse.Validate if the designated constraints aren't met.
+type PauseJobResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PauseJobResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PauseJobResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PauseJobResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PauseJobResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PauseJobResponseValidationError) ErrorName() string { return "PauseJobResponseValidationError" }
+
+// Error satisfies the builtin error interface
+func (e PauseJobResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPauseJobResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PauseJobResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PauseJobResponseValidationError{}
+
+// Validate checks the field values on UpdateJobSourceConnectionRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *UpdateJobSourceConnectionRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateJobSourceConnectionRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// UpdateJobSourceConnectionRequestMultiError, or nil if none found.
+func (m *UpdateJobSourceConnectionRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateJobSourceConnectionRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if all {
+		switch v := interface{}(m.GetSource()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateJobSourceConnectionRequestValidationError{
+					field:  "Source",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateJobSourceConnectionRequestValidationError{
+					field:  "Source",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSource()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateJobSourceConnectionRequestValidationError{
+				field:  "Source",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	for idx, item := range m.GetMappings() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, UpdateJobSourceConnectionRequestValidationError{
+						field:  fmt.Sprintf("Mappings[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, UpdateJobSourceConnectionRequestValidationError{
+						field:  fmt.Sprintf("Mappings[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return UpdateJobSourceConnectionRequestValidationError{
+					field:  fmt.Sprintf("Mappings[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return UpdateJobSourceConnectionRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateJobSourceConnectionRequestMultiError is an error wrapping multiple
+// validation errors returned by
+// UpdateJobSourceConnectionRequest.ValidateAll() if the designated
+// constraints aren't met.
+type UpdateJobSourceConnectionRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateJobSourceConnectionRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateJobSourceConnectionRequestMultiError) AllErrors() []error { return m }
+
+// UpdateJobSourceConnectionRequestValidationError is the validation error
+// returned by UpdateJobSourceConnectionRequest.Validate if the designated
+// constraints aren't met.
+type UpdateJobSourceConnectionRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateJobSourceConnectionRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateJobSourceConnectionRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateJobSourceConnectionRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateJobSourceConnectionRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateJobSourceConnectionRequestValidationError) ErrorName() string {
+	return "UpdateJobSourceConnectionRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateJobSourceConnectionRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateJobSourceConnectionRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateJobSourceConnectionRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateJobSourceConnectionRequestValidationError{}
+
+// Validate checks the field values on UpdateJobSourceConnectionResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *UpdateJobSourceConnectionResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateJobSourceConnectionResponse
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// UpdateJobSourceConnectionResponseMultiError, or nil if none found.
+func (m *UpdateJobSourceConnectionResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateJobSourceConnectionResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetJob()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateJobSourceConnectionResponseValidationError{
+					field:  "Job",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateJobSourceConnectionResponseValidationError{
+					field:  "Job",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetJob()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateJobSourceConnectionResponseValidationError{
+				field:  "Job",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return UpdateJobSourceConnectionResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateJobSourceConnectionResponseMultiError is an error wrapping multiple
+// validation errors returned by
+// UpdateJobSourceConnectionResponse.ValidateAll() if the designated
+// constraints aren't met.
+type UpdateJobSourceConnectionResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateJobSourceConnectionResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateJobSourceConnectionResponseMultiError) AllErrors() []error { return m }
+
+// UpdateJobSourceConnectionResponseValidationError is the validation error
+// returned by UpdateJobSourceConnectionResponse.Validate if the designated
+// constraints aren't met.
+type UpdateJobSourceConnectionResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateJobSourceConnectionResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateJobSourceConnectionResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateJobSourceConnectionResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateJobSourceConnectionResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateJobSourceConnectionResponseValidationError) ErrorName() string {
+	return "UpdateJobSourceConnectionResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateJobSourceConnectionResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateJobSourceConnectionResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateJobSourceConnectionResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateJobSourceConnectionResponseValidationError{}
+
+// Validate checks the field values on PostgresSourceSchemaSubset with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *PostgresSourceSchemaSubset) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PostgresSourceSchemaSubset with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// PostgresSourceSchemaSubsetMultiError, or nil if none found.
+func (m *PostgresSourceSchemaSubset) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PostgresSourceSchemaSubset) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetPostgresSchemas() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, PostgresSourceSchemaSubsetValidationError{
+						field:  fmt.Sprintf("PostgresSchemas[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, PostgresSourceSchemaSubsetValidationError{
+						field:  fmt.Sprintf("PostgresSchemas[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return PostgresSourceSchemaSubsetValidationError{
+					field:  fmt.Sprintf("PostgresSchemas[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return PostgresSourceSchemaSubsetMultiError(errors)
+	}
+
+	return nil
+}
+
+// PostgresSourceSchemaSubsetMultiError is an error wrapping multiple
+// validation errors returned by PostgresSourceSchemaSubset.ValidateAll() if
+// the designated constraints aren't met.
+type PostgresSourceSchemaSubsetMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PostgresSourceSchemaSubsetMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PostgresSourceSchemaSubsetMultiError) AllErrors() []error { return m }
+
+// PostgresSourceSchemaSubsetValidationError is the validation error returned
+// by PostgresSourceSchemaSubset.Validate if the designated constraints aren't met.
+type PostgresSourceSchemaSubsetValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PostgresSourceSchemaSubsetValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PostgresSourceSchemaSubsetValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PostgresSourceSchemaSubsetValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PostgresSourceSchemaSubsetValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PostgresSourceSchemaSubsetValidationError) ErrorName() string {
+	return "PostgresSourceSchemaSubsetValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e PostgresSourceSchemaSubsetValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPostgresSourceSchemaSubset.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PostgresSourceSchemaSubsetValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PostgresSourceSchemaSubsetValidationError{}
+
+// Validate checks the field values on MysqlSourceSchemaSubset with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *MysqlSourceSchemaSubset) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on MysqlSourceSchemaSubset with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// MysqlSourceSchemaSubsetMultiError, or nil if none found.
+func (m *MysqlSourceSchemaSubset) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *MysqlSourceSchemaSubset) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetMysqlSchemas() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, MysqlSourceSchemaSubsetValidationError{
+						field:  fmt.Sprintf("MysqlSchemas[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, MysqlSourceSchemaSubsetValidationError{
+						field:  fmt.Sprintf("MysqlSchemas[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return MysqlSourceSchemaSubsetValidationError{
+					field:  fmt.Sprintf("MysqlSchemas[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return MysqlSourceSchemaSubsetMultiError(errors)
+	}
+
+	return nil
+}
+
+// MysqlSourceSchemaSubsetMultiError is an error wrapping multiple validation
+// errors returned by MysqlSourceSchemaSubset.ValidateAll() if the designated
+// constraints aren't met.
+type MysqlSourceSchemaSubsetMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MysqlSourceSchemaSubsetMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m MysqlSourceSchemaSubsetMultiError) AllErrors() []error { return m }
+
+// MysqlSourceSchemaSubsetValidationError is the validation error returned by
+// MysqlSourceSchemaSubset.Validate if the designated constraints aren't met.
+type MysqlSourceSchemaSubsetValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MysqlSourceSchemaSubsetValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MysqlSourceSchemaSubsetValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MysqlSourceSchemaSubsetValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MysqlSourceSchemaSubsetValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MysqlSourceSchemaSubsetValidationError) ErrorName() string {
+	return "MysqlSourceSchemaSubsetValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e MysqlSourceSchemaSubsetValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMysqlSourceSchemaSubset.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MysqlSourceSchemaSubsetValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MysqlSourceSchemaSubsetValidationError{}
+
+// Validate checks the field values on MssqlSourceSchemaSubset with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *MssqlSourceSchemaSubset) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on MssqlSourceSchemaSubset with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// MssqlSourceSchemaSubsetMultiError, or nil if none found.
+func (m *MssqlSourceSchemaSubset) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *MssqlSourceSchemaSubset) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetMssqlSchemas() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, MssqlSourceSchemaSubsetValidationError{
+						field:  fmt.Sprintf("MssqlSchemas[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, MssqlSourceSchemaSubsetValidationError{
+						field:  fmt.Sprintf("MssqlSchemas[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return MssqlSourceSchemaSubsetValidationError{
+					field:  fmt.Sprintf("MssqlSchemas[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return MssqlSourceSchemaSubsetMultiError(errors)
+	}
+
+	return nil
+}
+
+// MssqlSourceSchemaSubsetMultiError is an error wrapping multiple validation
+// errors returned by MssqlSourceSchemaSubset.ValidateAll() if the designated
+// constraints aren't met.
+type MssqlSourceSchemaSubsetMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MssqlSourceSchemaSubsetMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m MssqlSourceSchemaSubsetMultiError) AllErrors() []error { return m }
+
+// MssqlSourceSchemaSubsetValidationError is the validation error returned by
+// MssqlSourceSchemaSubset.Validate if the designated constraints aren't met.
+type MssqlSourceSchemaSubsetValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MssqlSourceSchemaSubsetValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MssqlSourceSchemaSubsetValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MssqlSourceSchemaSubsetValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MssqlSourceSchemaSubsetValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MssqlSourceSchemaSubsetValidationError) ErrorName() string {
+	return "MssqlSourceSchemaSubsetValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e MssqlSourceSchemaSubsetValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMssqlSourceSchemaSubset.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MssqlSourceSchemaSubsetValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MssqlSourceSchemaSubsetValidationError{}
+
+// Validate checks the field values on JobSourceSqlSubetSchemas with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *JobSourceSqlSubetSchemas) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on JobSourceSqlSubetSchemas with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// JobSourceSqlSubetSchemasMultiError, or nil if none found.
+func (m *JobSourceSqlSubetSchemas) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *JobSourceSqlSubetSchemas) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	switch v := m.Schemas.(type) {
+	case *JobSourceSqlSubetSchemas_PostgresSubset:
+		if v == nil {
+			err := JobSourceSqlSubetSchemasValidationError{
+				field:  "Schemas",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetPostgresSubset()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, JobSourceSqlSubetSchemasValidationError{
+						field:  "PostgresSubset",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, JobSourceSqlSubetSchemasValidationError{
+						field:  "PostgresSubset",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetPostgresSubset()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return JobSourceSqlSubetSchemasValidationError{
+					field:  "PostgresSubset",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *JobSourceSqlSubetSchemas_MysqlSubset:
+		if v == nil {
+			err := JobSourceSqlSubetSchemasValidationError{
+				field:  "Schemas",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetMysqlSubset()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, JobSourceSqlSubetSchemasValidationError{
+						field:  "MysqlSubset",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, JobSourceSqlSubetSchemasValidationError{
+						field:  "MysqlSubset",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetMysqlSubset()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return JobSourceSqlSubetSchemasValidationError{
+					field:  "MysqlSubset",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *JobSourceSqlSubetSchemas_MssqlSubset:
+		if v == nil {
+			err := JobSourceSqlSubetSchemasValidationError{
+				field:  "Schemas",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetMssqlSubset()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, JobSourceSqlSubetSchemasValidationError{
+						field:  "MssqlSubset",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, JobSourceSqlSubetSchemasValidationError{
+						field:  "MssqlSubset",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetMssqlSubset()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return JobSourceSqlSubetSchemasValidationError{
+					field:  "MssqlSubset",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	default:
+		_ = v // ensures v is used
+	}
+
+	if len(errors) > 0 {
+		return JobSourceSqlSubetSchemasMultiError(errors)
+	}
+
+	return nil
+}
+
+// JobSourceSqlSubetSchemasMultiError is an error wrapping multiple validation
+// errors returned by JobSourceSqlSubetSchemas.ValidateAll() if the designated
+// constraints aren't met.
+type JobSourceSqlSubetSchemasMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m JobSourceSqlSubetSchemasMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m JobSourceSqlSubetSchemasMultiError) AllErrors() []error { return m }
+
+// JobSourceSqlSubetSchemasValidationError is the validation error returned by
+// JobSourceSqlSubetSchemas.Validate if the designated constraints aren't met.
+type JobSourceSqlSubetSchemasValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e JobSourceSqlSubetSchemasValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e JobSourceSqlSubetSchemasValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e JobSourceSqlSubetSchemasValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e JobSourceSqlSubetSchemasValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e JobSourceSqlSubetSchemasValidationError) ErrorName() string {
+	return "JobSourceSqlSubetSchemasValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e JobSourceSqlSubetSchemasValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sJobSourceSqlSubetSchemas.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = JobSourceSqlSubetSchemasValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = JobSourceSqlSubetSchemasValidationError{}
+
+// Validate checks the field values on SetJobSourceSqlConnectionSubsetsRequest
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the first error encountered is returned, or nil if
+// there are no violations.
+func (m *SetJobSourceSqlConnectionSubsetsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on
+// SetJobSourceSqlConnectionSubsetsRequest with the rules defined in the proto
+// definition for this message. If any rules are violated, the result is a
+// list of violation errors wrapped in
+// SetJobSourceSqlConnectionSubsetsRequestMultiError, or nil if none found.
+func (m *SetJobSourceSqlConnectionSubsetsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SetJobSourceSqlConnectionSubsetsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if all {
+		switch v := interface{}(m.GetSchemas()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SetJobSourceSqlConnectionSubsetsRequestValidationError{
+					field:  "Schemas",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SetJobSourceSqlConnectionSubsetsRequestValidationError{
+					field:  "Schemas",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSchemas()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SetJobSourceSqlConnectionSubsetsRequestValidationError{
+				field:  "Schemas",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for SubsetByForeignKeyConstraints
+
+	if len(errors) > 0 {
+		return SetJobSourceSqlConnectionSubsetsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// SetJobSourceSqlConnectionSubsetsRequestMultiError is an error wrapping
+// multiple validation errors returned by
+// SetJobSourceSqlConnectionSubsetsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type SetJobSourceSqlConnectionSubsetsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SetJobSourceSqlConnectionSubsetsRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SetJobSourceSqlConnectionSubsetsRequestMultiError) AllErrors() []error { return m }
+
+// SetJobSourceSqlConnectionSubsetsRequestValidationError is the validation
+// error returned by SetJobSourceSqlConnectionSubsetsRequest.Validate if the
+// designated constraints aren't met.
+type SetJobSourceSqlConnectionSubsetsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SetJobSourceSqlConnectionSubsetsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SetJobSourceSqlConnectionSubsetsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SetJobSourceSqlConnectionSubsetsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SetJobSourceSqlConnectionSubsetsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SetJobSourceSqlConnectionSubsetsRequestValidationError) ErrorName() string {
+	return "SetJobSourceSqlConnectionSubsetsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SetJobSourceSqlConnectionSubsetsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSetJobSourceSqlConnectionSubsetsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SetJobSourceSqlConnectionSubsetsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SetJobSourceSqlConnectionSubsetsRequestValidationError{}
+
+// Validate checks the field values on SetJobSourceSqlConnectionSubsetsResponse
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the first error encountered is returned, or nil if
+// there are no violations.
+func (m *SetJobSourceSqlConnectionSubsetsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on
+// SetJobSourceSqlConnectionSubsetsResponse with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in
+// SetJobSourceSqlConnectionSubsetsResponseMultiError, or nil if none found.
+func (m *SetJobSourceSqlConnectionSubsetsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SetJobSourceSqlConnectionSubsetsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetJob()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SetJobSourceSqlConnectionSubsetsResponseValidationError{
+					field:  "Job",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SetJobSourceSqlConnectionSubsetsResponseValidationError{
+					field:  "Job",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetJob()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SetJobSourceSqlConnectionSubsetsResponseValidationError{
+				field:  "Job",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return SetJobSourceSqlConnectionSubsetsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// SetJobSourceSqlConnectionSubsetsResponseMultiError is an error wrapping
+// multiple validation errors returned by
+// SetJobSourceSqlConnectionSubsetsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type SetJobSourceSqlConnectionSubsetsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SetJobSourceSqlConnectionSubsetsResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SetJobSourceSqlConnectionSubsetsResponseMultiError) AllErrors() []error { return m }
+
+// SetJobSourceSqlConnectionSubsetsResponseValidationError is the validation
+// error returned by SetJobSourceSqlConnectionSubsetsResponse.Validate if the
+// designated constraints aren't met.
+type SetJobSourceSqlConnectionSubsetsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SetJobSourceSqlConnectionSubsetsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SetJobSourceSqlConnectionSubsetsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SetJobSourceSqlConnectionSubsetsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SetJobSourceSqlConnectionSubsetsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SetJobSourceSqlConnectionSubsetsResponseValidationError) ErrorName() string {
+	return "SetJobSourceSqlConnectionSubsetsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SetJobSourceSqlConnectionSubsetsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSetJobSourceSqlConnectionSubsetsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SetJobSourceSqlConnectionSubsetsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SetJobSourceSqlConnectionSubsetsResponseValidationError{}
+
+// Validate checks the field values on UpdateJobDestinationConnectionRequest
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the first error encountered is returned, or nil if
+// there are no violations.
+func (m *UpdateJobDestinationConnectionRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateJobDestinationConnectionRequest
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// UpdateJobDestinationConnectionRequestMultiError, or nil if none found.
+func (m *UpdateJobDestinationConnectionRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateJobDestinationConnectionRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for JobId
+
+	// no validation rules for ConnectionId
+
+	if all {
+		switch v := interface{}(m.GetOptions()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateJobDestinationConnectionRequestValidationError{
+					field:  "Options",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateJobDestinationConnectionRequestValidationError{
+					field:  "Options",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetOptions()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateJobDestinationConnectionRequestValidationError{
+				field:  "Options",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for DestinationId
+
+	if len(errors) > 0 {
+		return UpdateJobDestinationConnectionRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateJobDestinationConnectionRequestMultiError is an error wrapping
+// multiple validation errors returned by
+// UpdateJobDestinationConnectionRequest.ValidateAll() if the designated
+// constraints aren't met.
+type UpdateJobDestinationConnectionRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateJobDestinationConnectionRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateJobDestinationConnectionRequestMultiError) AllErrors() []error { return m }
+
+// UpdateJobDestinationConnectionRequestValidationError is the validation error
+// returned by UpdateJobDestinationConnectionRequest.Validate if the
+// designated constraints aren't met.
+type UpdateJobDestinationConnectionRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateJobDestinationConnectionRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateJobDestinationConnectionRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateJobDestinationConnectionRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateJobDestinationConnectionRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateJobDestinationConnectionRequestValidationError) ErrorName() string {
+	return "UpdateJobDestinationConnectionRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateJobDestinationConnectionRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateJobDestinationConnectionRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateJobDestinationConnectionRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateJobDestinationConnectionRequestValidationError{}
+
+// Validate checks the field values on UpdateJobDestinationConnectionResponse
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the first error encountered is returned, or nil if
+// there are no violations.
+func (m *UpdateJobDestinationConnectionResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on
+// UpdateJobDestinationConnectionResponse with the rules defined in the proto
+// definition for this message. If any rules are violated, the result is a
+// list of violation errors wrapped in
+// UpdateJobDestinationConnectionResponseMultiError, or nil if none found.
+func (m *UpdateJobDestinationConnectionResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateJobDestinationConnectionResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetJob()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateJobDestinationConnectionResponseValidationError{
+					field:  "Job",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateJobDestinationConnectionResponseValidationError{
+					field:  "Job",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetJob()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateJobDestinationConnectionResponseValidationError{
+				field:  "Job",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return UpdateJobDestinationConnectionResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateJobDestinationConnectionResponseMultiError is an error wrapping
+// multiple validation errors returned by
+// UpdateJobDestinationConnectionResponse.ValidateAll() if the designated
+// constraints aren't met.
+type UpdateJobDestinationConnectionResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateJobDestinationConnectionResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateJobDestinationConnectionResponseMultiError) AllErrors() []error { return m }
+
+// UpdateJobDestinationConnectionResponseValidationError is the validation
+// error returned by UpdateJobDestinationConnectionResponse.Validate if the
+// designated constraints aren't met.
+type UpdateJobDestinationConnectionResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateJobDestinationConnectionResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateJobDestinationConnectionResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateJobDestinationConnectionResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateJobDestinationConnectionResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateJobDestinationConnectionResponseValidationError) ErrorName() string {
+	return "UpdateJobDestinationConnectionResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateJobDestinationConnectionResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateJobDestinationConnectionResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateJobDestinationConnectionResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateJobDestinationConnectionResponseValidationError{}
+
+// Validate checks the field values on DeleteJobDestinationConnectionRequest
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the first error encountered is returned, or nil if
+// there are no violations.
+func (m *DeleteJobDestinationConnectionRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteJobDestinationConnectionRequest
+// with the rules defined in the proto definition for this message. If any
 // rules are violated, the result is a list of violation errors wrapped in
-// MysqlDestinationConnectionOptionsMultiError, or nil if none found.
-func (m *MysqlDestinationConnectionOptions) ValidateAll() error {
+// DeleteJobDestinationConnectionRequestMultiError, or nil if none found.
+func (m *DeleteJobDestinationConnectionRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteJobDestinationConnectionRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for DestinationId
+
+	if len(errors) > 0 {
+		return DeleteJobDestinationConnectionRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteJobDestinationConnectionRequestMultiError is an error wrapping
+// multiple validation errors returned by
+// DeleteJobDestinationConnectionRequest.ValidateAll() if the designated
+// constraints aren't met.
+type DeleteJobDestinationConnectionRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteJobDestinationConnectionRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteJobDestinationConnectionRequestMultiError) AllErrors() []error { return m }
+
+// DeleteJobDestinationConnectionRequestValidationError is the validation error
+// returned by DeleteJobDestinationConnectionRequest.Validate if the
+// designated constraints aren't met.
+type DeleteJobDestinationConnectionRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteJobDestinationConnectionRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteJobDestinationConnectionRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteJobDestinationConnectionRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteJobDestinationConnectionRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteJobDestinationConnectionRequestValidationError) ErrorName() string {
+	return "DeleteJobDestinationConnectionRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteJobDestinationConnectionRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteJobDestinationConnectionRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteJobDestinationConnectionRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteJobDestinationConnectionRequestValidationError{}
+
+// Validate checks the field values on DeleteJobDestinationConnectionResponse
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the first error encountered is returned, or nil if
+// there are no violations.
+func (m *DeleteJobDestinationConnectionResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on
+// DeleteJobDestinationConnectionResponse with the rules defined in the proto
+// definition for this message. If any rules are violated, the result is a
+// list of violation errors wrapped in
+// DeleteJobDestinationConnectionResponseMultiError, or nil if none found.
+func (m *DeleteJobDestinationConnectionResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteJobDestinationConnectionResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return DeleteJobDestinationConnectionResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteJobDestinationConnectionResponseMultiError is an error wrapping
+// multiple validation errors returned by
+// DeleteJobDestinationConnectionResponse.ValidateAll() if the designated
+// constraints aren't met.
+type DeleteJobDestinationConnectionResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteJobDestinationConnectionResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteJobDestinationConnectionResponseMultiError) AllErrors() []error { return m }
+
+// DeleteJobDestinationConnectionResponseValidationError is the validation
+// error returned by DeleteJobDestinationConnectionResponse.Validate if the
+// designated constraints aren't met.
+type DeleteJobDestinationConnectionResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteJobDestinationConnectionResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteJobDestinationConnectionResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteJobDestinationConnectionResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteJobDestinationConnectionResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteJobDestinationConnectionResponseValidationError) ErrorName() string {
+	return "DeleteJobDestinationConnectionResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteJobDestinationConnectionResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteJobDestinationConnectionResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteJobDestinationConnectionResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteJobDestinationConnectionResponseValidationError{}
+
+// Validate checks the field values on CreateJobDestinationConnectionsRequest
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the first error encountered is returned, or nil if
+// there are no violations.
+func (m *CreateJobDestinationConnectionsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on
+// CreateJobDestinationConnectionsRequest with the rules defined in the proto
+// definition for this message. If any rules are violated, the result is a
+// list of violation errors wrapped in
+// CreateJobDestinationConnectionsRequestMultiError, or nil if none found.
+func (m *CreateJobDestinationConnectionsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateJobDestinationConnectionsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for JobId
+
+	for idx, item := range m.GetDestinations() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, CreateJobDestinationConnectionsRequestValidationError{
+						field:  fmt.Sprintf("Destinations[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, CreateJobDestinationConnectionsRequestValidationError{
+						field:  fmt.Sprintf("Destinations[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return CreateJobDestinationConnectionsRequestValidationError{
+					field:  fmt.Sprintf("Destinations[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return CreateJobDestinationConnectionsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateJobDestinationConnectionsRequestMultiError is an error wrapping
+// multiple validation errors returned by
+// CreateJobDestinationConnectionsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type CreateJobDestinationConnectionsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateJobDestinationConnectionsRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateJobDestinationConnectionsRequestMultiError) AllErrors() []error { return m }
+
+// CreateJobDestinationConnectionsRequestValidationError is the validation
+// error returned by CreateJobDestinationConnectionsRequest.Validate if the
+// designated constraints aren't met.
+type CreateJobDestinationConnectionsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateJobDestinationConnectionsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateJobDestinationConnectionsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateJobDestinationConnectionsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateJobDestinationConnectionsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateJobDestinationConnectionsRequestValidationError) ErrorName() string {
+	return "CreateJobDestinationConnectionsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateJobDestinationConnectionsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateJobDestinationConnectionsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateJobDestinationConnectionsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateJobDestinationConnectionsRequestValidationError{}
+
+// Validate checks the field values on CreateJobDestinationConnectionsResponse
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the first error encountered is returned, or nil if
+// there are no violations.
+func (m *CreateJobDestinationConnectionsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on
+// CreateJobDestinationConnectionsResponse with the rules defined in the proto
+// definition for this message. If any rules are violated, the result is a
+// list of violation errors wrapped in
+// CreateJobDestinationConnectionsResponseMultiError, or nil if none found.
+func (m *CreateJobDestinationConnectionsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateJobDestinationConnectionsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetJob()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateJobDestinationConnectionsResponseValidationError{
+					field:  "Job",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateJobDestinationConnectionsResponseValidationError{
+					field:  "Job",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetJob()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateJobDestinationConnectionsResponseValidationError{
+				field:  "Job",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return CreateJobDestinationConnectionsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateJobDestinationConnectionsResponseMultiError is an error wrapping
+// multiple validation errors returned by
+// CreateJobDestinationConnectionsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type CreateJobDestinationConnectionsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateJobDestinationConnectionsResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateJobDestinationConnectionsResponseMultiError) AllErrors() []error { return m }
+
+// CreateJobDestinationConnectionsResponseValidationError is the validation
+// error returned by CreateJobDestinationConnectionsResponse.Validate if the
+// designated constraints aren't met.
+type CreateJobDestinationConnectionsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateJobDestinationConnectionsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateJobDestinationConnectionsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateJobDestinationConnectionsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateJobDestinationConnectionsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateJobDestinationConnectionsResponseValidationError) ErrorName() string {
+	return "CreateJobDestinationConnectionsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateJobDestinationConnectionsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateJobDestinationConnectionsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateJobDestinationConnectionsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateJobDestinationConnectionsResponseValidationError{}
+
+// Validate checks the field values on DeleteJobRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *DeleteJobRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteJobRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteJobRequestMultiError, or nil if none found.
+func (m *DeleteJobRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteJobRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if len(errors) > 0 {
+		return DeleteJobRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteJobRequestMultiError is an error wrapping multiple validation errors
+// returned by DeleteJobRequest.ValidateAll() if the designated constraints
+// aren't met.
+type DeleteJobRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteJobRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteJobRequestMultiError) AllErrors() []error { return m }
+
+// DeleteJobRequestValidationError is the validation error returned by
+// DeleteJobRequest.Validate if the designated constraints aren't met.
+type DeleteJobRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteJobRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteJobRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteJobRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteJobRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteJobRequestValidationError) ErrorName() string { return "DeleteJobRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e DeleteJobRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteJobRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteJobRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteJobRequestValidationError{}
+
+// Validate checks the field values on DeleteJobResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *DeleteJobResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteJobResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteJobResponseMultiError, or nil if none found.
+func (m *DeleteJobResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteJobResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return DeleteJobResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteJobResponseMultiError is an error wrapping multiple validation errors
+// returned by DeleteJobResponse.ValidateAll() if the designated constraints
+// aren't met.
+type DeleteJobResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteJobResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteJobResponseMultiError) AllErrors() []error { return m }
+
+// DeleteJobResponseValidationError is the validation error returned by
+// DeleteJobResponse.Validate if the designated constraints aren't met.
+type DeleteJobResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteJobResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteJobResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteJobResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteJobResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteJobResponseValidationError) ErrorName() string {
+	return "DeleteJobResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteJobResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteJobResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteJobResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteJobResponseValidationError{}
+
+// Validate checks the field values on IsJobNameAvailableRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *IsJobNameAvailableRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on IsJobNameAvailableRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// IsJobNameAvailableRequestMultiError, or nil if none found.
+func (m *IsJobNameAvailableRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *IsJobNameAvailableRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Name
+
+	// no validation rules for AccountId
+
+	if len(errors) > 0 {
+		return IsJobNameAvailableRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// IsJobNameAvailableRequestMultiError is an error wrapping multiple validation
+// errors returned by IsJobNameAvailableRequest.ValidateAll() if the
+// designated constraints aren't met.
+type IsJobNameAvailableRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m IsJobNameAvailableRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m IsJobNameAvailableRequestMultiError) AllErrors() []error { return m }
+
+// IsJobNameAvailableRequestValidationError is the validation error returned by
+// IsJobNameAvailableRequest.Validate if the designated constraints aren't met.
+type IsJobNameAvailableRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e IsJobNameAvailableRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e IsJobNameAvailableRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e IsJobNameAvailableRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e IsJobNameAvailableRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e IsJobNameAvailableRequestValidationError) ErrorName() string {
+	return "IsJobNameAvailableRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e IsJobNameAvailableRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sIsJobNameAvailableRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = IsJobNameAvailableRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = IsJobNameAvailableRequestValidationError{}
+
+// Validate checks the field values on IsJobNameAvailableResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *IsJobNameAvailableResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on IsJobNameAvailableResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// IsJobNameAvailableResponseMultiError, or nil if none found.
+func (m *IsJobNameAvailableResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *IsJobNameAvailableResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for IsAvailable
+
+	if len(errors) > 0 {
+		return IsJobNameAvailableResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// IsJobNameAvailableResponseMultiError is an error wrapping multiple
+// validation errors returned by IsJobNameAvailableResponse.ValidateAll() if
+// the designated constraints aren't met.
+type IsJobNameAvailableResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m IsJobNameAvailableResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m IsJobNameAvailableResponseMultiError) AllErrors() []error { return m }
+
+// IsJobNameAvailableResponseValidationError is the validation error returned
+// by IsJobNameAvailableResponse.Validate if the designated constraints aren't met.
+type IsJobNameAvailableResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e IsJobNameAvailableResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e IsJobNameAvailableResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e IsJobNameAvailableResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e IsJobNameAvailableResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e IsJobNameAvailableResponseValidationError) ErrorName() string {
+	return "IsJobNameAvailableResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e IsJobNameAvailableResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sIsJobNameAvailableResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = IsJobNameAvailableResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = IsJobNameAvailableResponseValidationError{}
+
+// Validate checks the field values on GetJobRunsRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *GetJobRunsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetJobRunsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetJobRunsRequestMultiError, or nil if none found.
+func (m *GetJobRunsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetJobRunsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	switch v := m.Id.(type) {
+	case *GetJobRunsRequest_JobId:
+		if v == nil {
+			err := GetJobRunsRequestValidationError{
+				field:  "Id",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+		// no validation rules for JobId
+	case *GetJobRunsRequest_AccountId:
+		if v == nil {
+			err := GetJobRunsRequestValidationError{
+				field:  "Id",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+		// no validation rules for AccountId
+	default:
+		_ = v // ensures v is used
+	}
+
+	if len(errors) > 0 {
+		return GetJobRunsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetJobRunsRequestMultiError is an error wrapping multiple validation errors
+// returned by GetJobRunsRequest.ValidateAll() if the designated constraints
+// aren't met.
+type GetJobRunsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetJobRunsRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetJobRunsRequestMultiError) AllErrors() []error { return m }
+
+// GetJobRunsRequestValidationError is the validation error returned by
+// GetJobRunsRequest.Validate if the designated constraints aren't met.
+type GetJobRunsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetJobRunsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetJobRunsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetJobRunsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetJobRunsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetJobRunsRequestValidationError) ErrorName() string {
+	return "GetJobRunsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetJobRunsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetJobRunsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetJobRunsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetJobRunsRequestValidationError{}
+
+// Validate checks the field values on GetJobRunsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetJobRunsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetJobRunsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetJobRunsResponseMultiError, or nil if none found.
+func (m *GetJobRunsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetJobRunsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetJobRuns() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetJobRunsResponseValidationError{
+						field:  fmt.Sprintf("JobRuns[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetJobRunsResponseValidationError{
+						field:  fmt.Sprintf("JobRuns[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetJobRunsResponseValidationError{
+					field:  fmt.Sprintf("JobRuns[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return GetJobRunsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetJobRunsResponseMultiError is an error wrapping multiple validation errors
+// returned by GetJobRunsResponse.ValidateAll() if the designated constraints
+// aren't met.
+type GetJobRunsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetJobRunsResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetJobRunsResponseMultiError) AllErrors() []error { return m }
+
+// GetJobRunsResponseValidationError is the validation error returned by
+// GetJobRunsResponse.Validate if the designated constraints aren't met.
+type GetJobRunsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetJobRunsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetJobRunsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetJobRunsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetJobRunsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetJobRunsResponseValidationError) ErrorName() string {
+	return "GetJobRunsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetJobRunsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetJobRunsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetJobRunsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetJobRunsResponseValidationError{}
+
+// Validate checks the field values on GetJobRunRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *GetJobRunRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetJobRunRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetJobRunRequestMultiError, or nil if none found.
+func (m *GetJobRunRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetJobRunRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for JobRunId
+
+	// no validation rules for AccountId
+
+	if len(errors) > 0 {
+		return GetJobRunRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetJobRunRequestMultiError is an error wrapping multiple validation errors
+// returned by GetJobRunRequest.ValidateAll() if the designated constraints
+// aren't met.
+type GetJobRunRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetJobRunRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetJobRunRequestMultiError) AllErrors() []error { return m }
+
+// GetJobRunRequestValidationError is the validation error returned by
+// GetJobRunRequest.Validate if the designated constraints aren't met.
+type GetJobRunRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetJobRunRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetJobRunRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetJobRunRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetJobRunRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetJobRunRequestValidationError) ErrorName() string { return "GetJobRunRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GetJobRunRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetJobRunRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetJobRunRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetJobRunRequestValidationError{}
+
+// Validate checks the field values on GetJobRunResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *GetJobRunResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetJobRunResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetJobRunResponseMultiError, or nil if none found.
+func (m *GetJobRunResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetJobRunResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetJobRun()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetJobRunResponseValidationError{
+					field:  "JobRun",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetJobRunResponseValidationError{
+					field:  "JobRun",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetJobRun()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetJobRunResponseValidationError{
+				field:  "JobRun",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GetJobRunResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetJobRunResponseMultiError is an error wrapping multiple validation errors
+// returned by GetJobRunResponse.ValidateAll() if the designated constraints
+// aren't met.
+type GetJobRunResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetJobRunResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetJobRunResponseMultiError) AllErrors() []error { return m }
+
+// GetJobRunResponseValidationError is the validation error returned by
+// GetJobRunResponse.Validate if the designated constraints aren't met.
+type GetJobRunResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetJobRunResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetJobRunResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetJobRunResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetJobRunResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetJobRunResponseValidationError) ErrorName() string {
+	return "GetJobRunResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetJobRunResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetJobRunResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetJobRunResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetJobRunResponseValidationError{}
+
+// Validate checks the field values on CreateJobRunRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateJobRunRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateJobRunRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateJobRunRequestMultiError, or nil if none found.
+func (m *CreateJobRunRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateJobRunRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for JobId
+
+	if len(errors) > 0 {
+		return CreateJobRunRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateJobRunRequestMultiError is an error wrapping multiple validation
+// errors returned by CreateJobRunRequest.ValidateAll() if the designated
+// constraints aren't met.
+type CreateJobRunRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateJobRunRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateJobRunRequestMultiError) AllErrors() []error { return m }
+
+// CreateJobRunRequestValidationError is the validation error returned by
+// CreateJobRunRequest.Validate if the designated constraints aren't met.
+type CreateJobRunRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateJobRunRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateJobRunRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateJobRunRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateJobRunRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateJobRunRequestValidationError) ErrorName() string {
+	return "CreateJobRunRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateJobRunRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateJobRunRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateJobRunRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateJobRunRequestValidationError{}
+
+// Validate checks the field values on CreateJobRunResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateJobRunResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateJobRunResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateJobRunResponseMultiError, or nil if none found.
+func (m *CreateJobRunResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateJobRunResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return CreateJobRunResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateJobRunResponseMultiError is an error wrapping multiple validation
+// errors returned by CreateJobRunResponse.ValidateAll() if the designated
+// constraints aren't met.
+type CreateJobRunResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateJobRunResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateJobRunResponseMultiError) AllErrors() []error { return m }
+
+// CreateJobRunResponseValidationError is the validation error returned by
+// CreateJobRunResponse.Validate if the designated constraints aren't met.
+type CreateJobRunResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateJobRunResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateJobRunResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateJobRunResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateJobRunResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateJobRunResponseValidationError) ErrorName() string {
+	return "CreateJobRunResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateJobRunResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateJobRunResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateJobRunResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateJobRunResponseValidationError{}
+
+// Validate checks the field values on CancelJobRunRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CancelJobRunRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CancelJobRunRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CancelJobRunRequestMultiError, or nil if none found.
+func (m *CancelJobRunRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CancelJobRunRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for JobRunId
+
+	// no validation rules for AccountId
+
+	if len(errors) > 0 {
+		return CancelJobRunRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CancelJobRunRequestMultiError is an error wrapping multiple validation
+// errors returned by CancelJobRunRequest.ValidateAll() if the designated
+// constraints aren't met.
+type CancelJobRunRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CancelJobRunRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CancelJobRunRequestMultiError) AllErrors() []error { return m }
+
+// CancelJobRunRequestValidationError is the validation error returned by
+// CancelJobRunRequest.Validate if the designated constraints aren't met.
+type CancelJobRunRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CancelJobRunRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CancelJobRunRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CancelJobRunRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CancelJobRunRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CancelJobRunRequestValidationError) ErrorName() string {
+	return "CancelJobRunRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CancelJobRunRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCancelJobRunRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CancelJobRunRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CancelJobRunRequestValidationError{}
+
+// Validate checks the field values on CancelJobRunResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CancelJobRunResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CancelJobRunResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CancelJobRunResponseMultiError, or nil if none found.
+func (m *CancelJobRunResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CancelJobRunResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return CancelJobRunResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// CancelJobRunResponseMultiError is an error wrapping multiple validation
+// errors returned by CancelJobRunResponse.ValidateAll() if the designated
+// constraints aren't met.
+type CancelJobRunResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CancelJobRunResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CancelJobRunResponseMultiError) AllErrors() []error { return m }
+
+// CancelJobRunResponseValidationError is the validation error returned by
+// CancelJobRunResponse.Validate if the designated constraints aren't met.
+type CancelJobRunResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CancelJobRunResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CancelJobRunResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CancelJobRunResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CancelJobRunResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CancelJobRunResponseValidationError) ErrorName() string {
+	return "CancelJobRunResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CancelJobRunResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCancelJobRunResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CancelJobRunResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CancelJobRunResponseValidationError{}
+
+// Validate checks the field values on Job with the rules defined in the proto
+// definition for this message. If any rules are violated, the first error
+// encountered is returned, or nil if there are no violations.
+func (m *Job) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Job with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in JobMultiError, or nil if none found.
+func (m *Job) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Job) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for CreatedByUserId
+
+	if all {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, JobValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, JobValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return JobValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for UpdatedByUserId
+
+	if all {
+		switch v := interface{}(m.GetUpdatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, JobValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, JobValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUpdatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return JobValidationError{
+				field:  "UpdatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Name
+
+	if all {
+		switch v := interface{}(m.GetSource()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, JobValidationError{
+					field:  "Source",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, JobValidationError{
+					field:  "Source",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSource()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return JobValidationError{
+				field:  "Source",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	for idx, item := range m.GetDestinations() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, JobValidationError{
+						field:  fmt.Sprintf("Destinations[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, JobValidationError{
+						field:  fmt.Sprintf("Destinations[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return JobValidationError{
+					field:  fmt.Sprintf("Destinations[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetMappings() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, JobValidationError{
+						field:  fmt.Sprintf("Mappings[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, JobValidationError{
+						field:  fmt.Sprintf("Mappings[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return JobValidationError{
+					field:  fmt.Sprintf("Mappings[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for AccountId
+
+	if all {
+		switch v := interface{}(m.GetSyncOptions()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, JobValidationError{
+					field:  "SyncOptions",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, JobValidationError{
+					field:  "SyncOptions",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSyncOptions()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return JobValidationError{
+				field:  "SyncOptions",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetWorkflowOptions()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, JobValidationError{
+					field:  "WorkflowOptions",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, JobValidationError{
+					field:  "WorkflowOptions",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetWorkflowOptions()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return JobValidationError{
+				field:  "WorkflowOptions",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	for idx, item := range m.GetTriggers() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, JobValidationError{
+						field:  fmt.Sprintf("Triggers[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, JobValidationError{
+						field:  fmt.Sprintf("Triggers[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return JobValidationError{
+					field:  fmt.Sprintf("Triggers[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if m.CronSchedule != nil {
+		// no validation rules for CronSchedule
+	}
+
+	if len(errors) > 0 {
+		return JobMultiError(errors)
+	}
+
+	return nil
+}
+
+// JobMultiError is an error wrapping multiple validation errors returned by
+// Job.ValidateAll() if the designated constraints aren't met.
+type JobMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m JobMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m JobMultiError) AllErrors() []error { return m }
+
+// JobValidationError is the validation error returned by Job.Validate if the
+// designated constraints aren't met.
+type JobValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e JobValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e JobValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e JobValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e JobValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e JobValidationError) ErrorName() string { return "JobValidationError" }
+
+// Error satisfies the builtin error interface
+func (e JobValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sJob.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = JobValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = JobValidationError{}
+
+// Validate checks the field values on JobTrigger with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *JobTrigger) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on JobTrigger with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in JobTriggerMultiError, or
+// nil if none found.
+func (m *JobTrigger) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *MysqlDestinationConnectionOptions) validate(all bool) error {
+func (m *JobTrigger) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for JobId
+
+	// no validation rules for Condition
+
+	if len(errors) > 0 {
+		return JobTriggerMultiError(errors)
+	}
+
+	return nil
+}
+
+// JobTriggerMultiError is an error wrapping multiple validation errors
+// returned by JobTrigger.ValidateAll() if the designated constraints aren't met.
+type JobTriggerMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m JobTriggerMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m JobTriggerMultiError) AllErrors() []error { return m }
+
+// JobTriggerValidationError is the validation error returned by
+// JobTrigger.Validate if the designated constraints aren't met.
+type JobTriggerValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e JobTriggerValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e JobTriggerValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e JobTriggerValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e JobTriggerValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e JobTriggerValidationError) ErrorName() string { return "JobTriggerValidationError" }
+
+// Error satisfies the builtin error interface
+func (e JobTriggerValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sJobTrigger.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = JobTriggerValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = JobTriggerValidationError{}
+
+// Validate checks the field values on JobRecentRun with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *JobRecentRun) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on JobRecentRun with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in JobRecentRunMultiError, or
+// nil if none found.
+func (m *JobRecentRun) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *JobRecentRun) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -2698,51 +9965,49 @@ func (m *MysqlDestinationConnectionOptions) validate(all bool) error {
 	var errors []error
 
 	if all {
-		switch v := interface{}(m.GetTruncateTable()).(type) {
+		switch v := interface{}(m.GetStartTime()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, MysqlDestinationConnectionOptionsValidationError{
-					field:  "TruncateTable",
+				errors = append(errors, JobRecentRunValidationError{
+					field:  "StartTime",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, MysqlDestinationConnectionOptionsValidationError{
-					field:  "TruncateTable",
+				errors = append(errors, JobRecentRunValidationError{
+					field:  "StartTime",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetTruncateTable()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetStartTime()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return MysqlDestinationConnectionOptionsValidationError{
-				field:  "TruncateTable",
+			return JobRecentRunValidationError{
+				field:  "StartTime",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
 		}
 	}
 
-	// no validation rules for InitTableSchema
+	// no validation rules for JobRunId
 
 	if len(errors) > 0 {
-		return MysqlDestinationConnectionOptionsMultiError(errors)
+		return JobRecentRunMultiError(errors)
 	}
 
 	return nil
 }
 
-// MysqlDestinationConnectionOptionsMultiError is an error wrapping multiple
-// validation errors returned by
-// MysqlDestinationConnectionOptions.ValidateAll() if the designated
-// constraints aren't met.
-type MysqlDestinationConnectionOptionsMultiError []error
+// JobRecentRunMultiError is an error wrapping multiple validation errors
+// returned by JobRecentRun.ValidateAll() if the designated constraints aren't met.
+type JobRecentRunMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m MysqlDestinationConnectionOptionsMultiError) Error() string {
+func (m JobRecentRunMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2751,12 +10016,11 @@ func (m MysqlDestinationConnectionOptionsMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m MysqlDestinationConnectionOptionsMultiError) AllErrors() []error { return m }
+func (m JobRecentRunMultiError) AllErrors() []error { return m }
 
-// MysqlDestinationConnectionOptionsValidationError is the validation error
-// returned by MysqlDestinationConnectionOptions.Validate if the designated
-// constraints aren't met.
-type MysqlDestinationConnectionOptionsValidationError struct {
+// JobRecentRunValidationError is the validation error returned by
+// JobRecentRun.Validate if the designated constraints aren't met.
+type JobRecentRunValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2764,24 +10028,22 @@ type MysqlDestinationConnectionOptionsValidationError struct {
 }
 
 // Field function returns field value.
-func (e MysqlDestinationConnectionOptionsValidationError) Field() string { return e.field }
+func (e JobRecentRunValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e MysqlDestinationConnectionOptionsValidationError) Reason() string { return e.reason }
+func (e JobRecentRunValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e MysqlDestinationConnectionOptionsValidationError) Cause() error { return e.cause }
+func (e JobRecentRunValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e MysqlDestinationConnectionOptionsValidationError) Key() bool { return e.key }
+func (e JobRecentRunValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e MysqlDestinationConnectionOptionsValidationError) ErrorName() string {
-	return "MysqlDestinationConnectionOptionsValidationError"
-}
+func (e JobRecentRunValidationError) ErrorName() string { return "JobRecentRunValidationError" }
 
 // Error satisfies the builtin error interface
-func (e MysqlDestinationConnectionOptionsValidationError) Error() string {
+func (e JobRecentRunValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2793,14 +10055,14 @@ func (e MysqlDestinationConnectionOptionsValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sMysqlDestinationConnectionOptions.%s: %s%s",
+		"invalid %sJobRecentRun.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = MysqlDestinationConnectionOptionsValidationError{}
+var _ error = JobRecentRunValidationError{}
 
 var _ interface {
 	Field() string
@@ -2808,46 +10070,46 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = MysqlDestinationConnectionOptionsValidationError{}
+} = JobRecentRunValidationError{}
 
-// Validate checks the field values on MysqlTruncateTableConfig with the rules
+// Validate checks the field values on GetJobRecentRunsRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *MysqlTruncateTableConfig) Validate() error {
+func (m *GetJobRecentRunsRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on MysqlTruncateTableConfig with the
+// ValidateAll checks the field values on GetJobRecentRunsRequest with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// MysqlTruncateTableConfigMultiError, or nil if none found.
-func (m *MysqlTruncateTableConfig) ValidateAll() error {
+// GetJobRecentRunsRequestMultiError, or nil if none found.
+func (m *GetJobRecentRunsRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *MysqlTruncateTableConfig) validate(all bool) error {
+func (m *GetJobRecentRunsRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for TruncateBeforeInsert
+	// no validation rules for JobId
 
 	if len(errors) > 0 {
-		return MysqlTruncateTableConfigMultiError(errors)
+		return GetJobRecentRunsRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// MysqlTruncateTableConfigMultiError is an error wrapping multiple validation
-// errors returned by MysqlTruncateTableConfig.ValidateAll() if the designated
+// GetJobRecentRunsRequestMultiError is an error wrapping multiple validation
+// errors returned by GetJobRecentRunsRequest.ValidateAll() if the designated
 // constraints aren't met.
-type MysqlTruncateTableConfigMultiError []error
+type GetJobRecentRunsRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m MysqlTruncateTableConfigMultiError) Error() string {
+func (m GetJobRecentRunsRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2856,11 +10118,11 @@ func (m MysqlTruncateTableConfigMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m MysqlTruncateTableConfigMultiError) AllErrors() []error { return m }
+func (m GetJobRecentRunsRequestMultiError) AllErrors() []error { return m }
 
-// MysqlTruncateTableConfigValidationError is the validation error returned by
-// MysqlTruncateTableConfig.Validate if the designated constraints aren't met.
-type MysqlTruncateTableConfigValidationError struct {
+// GetJobRecentRunsRequestValidationError is the validation error returned by
+// GetJobRecentRunsRequest.Validate if the designated constraints aren't met.
+type GetJobRecentRunsRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2868,24 +10130,24 @@ type MysqlTruncateTableConfigValidationError struct {
 }
 
 // Field function returns field value.
-func (e MysqlTruncateTableConfigValidationError) Field() string { return e.field }
+func (e GetJobRecentRunsRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e MysqlTruncateTableConfigValidationError) Reason() string { return e.reason }
+func (e GetJobRecentRunsRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e MysqlTruncateTableConfigValidationError) Cause() error { return e.cause }
+func (e GetJobRecentRunsRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e MysqlTruncateTableConfigValidationError) Key() bool { return e.key }
+func (e GetJobRecentRunsRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e MysqlTruncateTableConfigValidationError) ErrorName() string {
-	return "MysqlTruncateTableConfigValidationError"
+func (e GetJobRecentRunsRequestValidationError) ErrorName() string {
+	return "GetJobRecentRunsRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e MysqlTruncateTableConfigValidationError) Error() string {
+func (e GetJobRecentRunsRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2897,14 +10159,14 @@ func (e MysqlTruncateTableConfigValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sMysqlTruncateTableConfig.%s: %s%s",
+		"invalid %sGetJobRecentRunsRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = MysqlTruncateTableConfigValidationError{}
+var _ error = GetJobRecentRunsRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -2912,46 +10174,78 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = MysqlTruncateTableConfigValidationError{}
+} = GetJobRecentRunsRequestValidationError{}
 
-// Validate checks the field values on AwsS3DestinationConnectionOptions with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the first error encountered is returned, or nil if there are
-// no violations.
-func (m *AwsS3DestinationConnectionOptions) Validate() error {
+// Validate checks the field values on GetJobRecentRunsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetJobRecentRunsResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AwsS3DestinationConnectionOptions
-// with the rules defined in the proto definition for this message. If any
-// rules are violated, the result is a list of violation errors wrapped in
-// AwsS3DestinationConnectionOptionsMultiError, or nil if none found.
-func (m *AwsS3DestinationConnectionOptions) ValidateAll() error {
+// ValidateAll checks the field values on GetJobRecentRunsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetJobRecentRunsResponseMultiError, or nil if none found.
+func (m *GetJobRecentRunsResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AwsS3DestinationConnectionOptions) validate(all bool) error {
+func (m *GetJobRecentRunsResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	for idx, item := range m.GetRecentRuns() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetJobRecentRunsResponseValidationError{
+						field:  fmt.Sprintf("RecentRuns[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetJobRecentRunsResponseValidationError{
+						field:  fmt.Sprintf("RecentRuns[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetJobRecentRunsResponseValidationError{
+					field:  fmt.Sprintf("RecentRuns[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
 	if len(errors) > 0 {
-		return AwsS3DestinationConnectionOptionsMultiError(errors)
+		return GetJobRecentRunsResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// AwsS3DestinationConnectionOptionsMultiError is an error wrapping multiple
-// validation errors returned by
-// AwsS3DestinationConnectionOptions.ValidateAll() if the designated
+// GetJobRecentRunsResponseMultiError is an error wrapping multiple validation
+// errors returned by GetJobRecentRunsResponse.ValidateAll() if the designated
 // constraints aren't met.
-type AwsS3DestinationConnectionOptionsMultiError []error
+type GetJobRecentRunsResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AwsS3DestinationConnectionOptionsMultiError) Error() string {
+func (m GetJobRecentRunsResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2960,12 +10254,11 @@ func (m AwsS3DestinationConnectionOptionsMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AwsS3DestinationConnectionOptionsMultiError) AllErrors() []error { return m }
+func (m GetJobRecentRunsResponseMultiError) AllErrors() []error { return m }
 
-// AwsS3DestinationConnectionOptionsValidationError is the validation error
-// returned by AwsS3DestinationConnectionOptions.Validate if the designated
-// constraints aren't met.
-type AwsS3DestinationConnectionOptionsValidationError struct {
+// GetJobRecentRunsResponseValidationError is the validation error returned by
+// GetJobRecentRunsResponse.Validate if the designated constraints aren't met.
+type GetJobRecentRunsResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2973,24 +10266,24 @@ type AwsS3DestinationConnectionOptionsValidationError struct {
 }
 
 // Field function returns field value.
-func (e AwsS3DestinationConnectionOptionsValidationError) Field() string { return e.field }
+func (e GetJobRecentRunsResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AwsS3DestinationConnectionOptionsValidationError) Reason() string { return e.reason }
+func (e GetJobRecentRunsResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AwsS3DestinationConnectionOptionsValidationError) Cause() error { return e.cause }
+func (e GetJobRecentRunsResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AwsS3DestinationConnectionOptionsValidationError) Key() bool { return e.key }
+func (e GetJobRecentRunsResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AwsS3DestinationConnectionOptionsValidationError) ErrorName() string {
-	return "AwsS3DestinationConnectionOptionsValidationError"
+func (e GetJobRecentRunsResponseValidationError) ErrorName() string {
+	return "GetJobRecentRunsResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e AwsS3DestinationConnectionOptionsValidationError) Error() string {
+func (e GetJobRecentRunsResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3002,129 +10295,62 @@ func (e AwsS3DestinationConnectionOptionsValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAwsS3DestinationConnectionOptions.%s: %s%s",
+		"invalid %sGetJobRecentRunsResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AwsS3DestinationConnectionOptionsValidationError{}
+var _ error = GetJobRecentRunsResponseValidationError{}
 
 var _ interface {
 	Field() string
 	Reason() string
 	Key() bool
-	Cause() error
-	ErrorName() string
-} = AwsS3DestinationConnectionOptionsValidationError{}
-
-// Validate checks the field values on CreateJobRequest with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *CreateJobRequest) Validate() error {
-	return m.validate(false)
-}
-
-// ValidateAll checks the field values on CreateJobRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// CreateJobRequestMultiError, or nil if none found.
-func (m *CreateJobRequest) ValidateAll() error {
-	return m.validate(true)
-}
-
-func (m *CreateJobRequest) validate(all bool) error {
-	if m == nil {
-		return nil
-	}
-
-	var errors []error
-
-	// no validation rules for AccountId
-
-	// no validation rules for JobName
-
-	for idx, item := range m.GetMappings() {
-		_, _ = idx, item
-
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, CreateJobRequestValidationError{
-						field:  fmt.Sprintf("Mappings[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, CreateJobRequestValidationError{
-						field:  fmt.Sprintf("Mappings[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return CreateJobRequestValidationError{
-					field:  fmt.Sprintf("Mappings[%v]", idx),
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
-
-	}
-
-	if all {
-		switch v := interface{}(m.GetSource()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, CreateJobRequestValidationError{
-					field:  "Source",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, CreateJobRequestValidationError{
-					field:  "Source",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetSource()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return CreateJobRequestValidationError{
-				field:  "Source",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
+	Cause() error
+	ErrorName() string
+} = GetJobRecentRunsResponseValidationError{}
+
+// Validate checks the field values on JobNextRuns with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *JobNextRuns) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on JobNextRuns with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in JobNextRunsMultiError, or
+// nil if none found.
+func (m *JobNextRuns) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *JobNextRuns) validate(all bool) error {
+	if m == nil {
+		return nil
 	}
 
-	for idx, item := range m.GetDestinations() {
+	var errors []error
+
+	for idx, item := range m.GetNextRunTimes() {
 		_, _ = idx, item
 
 		if all {
 			switch v := interface{}(item).(type) {
 			case interface{ ValidateAll() error }:
 				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, CreateJobRequestValidationError{
-						field:  fmt.Sprintf("Destinations[%v]", idx),
+					errors = append(errors, JobNextRunsValidationError{
+						field:  fmt.Sprintf("NextRunTimes[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			case interface{ Validate() error }:
 				if err := v.Validate(); err != nil {
-					errors = append(errors, CreateJobRequestValidationError{
-						field:  fmt.Sprintf("Destinations[%v]", idx),
+					errors = append(errors, JobNextRunsValidationError{
+						field:  fmt.Sprintf("NextRunTimes[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
@@ -3132,8 +10358,8 @@ func (m *CreateJobRequest) validate(all bool) error {
 			}
 		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				return CreateJobRequestValidationError{
-					field:  fmt.Sprintf("Destinations[%v]", idx),
+				return JobNextRunsValidationError{
+					field:  fmt.Sprintf("NextRunTimes[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
 				}
@@ -3142,84 +10368,19 @@ func (m *CreateJobRequest) validate(all bool) error {
 
 	}
 
-	// no validation rules for InitiateJobRun
-
-	if all {
-		switch v := interface{}(m.GetWorkflowOptions()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, CreateJobRequestValidationError{
-					field:  "WorkflowOptions",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, CreateJobRequestValidationError{
-					field:  "WorkflowOptions",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetWorkflowOptions()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return CreateJobRequestValidationError{
-				field:  "WorkflowOptions",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
-
-	if all {
-		switch v := interface{}(m.GetSyncOptions()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, CreateJobRequestValidationError{
-					field:  "SyncOptions",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, CreateJobRequestValidationError{
-					field:  "SyncOptions",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetSyncOptions()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return CreateJobRequestValidationError{
-				field:  "SyncOptions",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
-
-	if m.CronSchedule != nil {
-		// no validation rules for CronSchedule
-	}
-
 	if len(errors) > 0 {
-		return CreateJobRequestMultiError(errors)
+		return JobNextRunsMultiError(errors)
 	}
 
 	return nil
 }
 
-// CreateJobRequestMultiError is an error wrapping multiple validation errors
-// returned by CreateJobRequest.ValidateAll() if the designated constraints
-// aren't met.
-type CreateJobRequestMultiError []error
+// JobNextRunsMultiError is an error wrapping multiple validation errors
+// returned by JobNextRuns.ValidateAll() if the designated constraints aren't met.
+type JobNextRunsMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m CreateJobRequestMultiError) Error() string {
+func (m JobNextRunsMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3228,11 +10389,11 @@ func (m CreateJobRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m CreateJobRequestMultiError) AllErrors() []error { return m }
+func (m JobNextRunsMultiError) AllErrors() []error { return m }
 
-// CreateJobRequestValidationError is the validation error returned by
-// CreateJobRequest.Validate if the designated constraints aren't met.
-type CreateJobRequestValidationError struct {
+// JobNextRunsValidationError is the validation error returned by
+// JobNextRuns.Validate if the designated constraints aren't met.
+type JobNextRunsValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3240,22 +10401,22 @@ type CreateJobRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e CreateJobRequestValidationError) Field() string { return e.field }
+func (e JobNextRunsValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CreateJobRequestValidationError) Reason() string { return e.reason }
+func (e JobNextRunsValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CreateJobRequestValidationError) Cause() error { return e.cause }
+func (e JobNextRunsValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CreateJobRequestValidationError) Key() bool { return e.key }
+func (e JobNextRunsValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CreateJobRequestValidationError) ErrorName() string { return "CreateJobRequestValidationError" }
+func (e JobNextRunsValidationError) ErrorName() string { return "JobNextRunsValidationError" }
 
 // Error satisfies the builtin error interface
-func (e CreateJobRequestValidationError) Error() string {
+func (e JobNextRunsValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3267,14 +10428,14 @@ func (e CreateJobRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCreateJobRequest.%s: %s%s",
+		"invalid %sJobNextRuns.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CreateJobRequestValidationError{}
+var _ error = JobNextRunsValidationError{}
 
 var _ interface {
 	Field() string
@@ -3282,48 +10443,46 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CreateJobRequestValidationError{}
+} = JobNextRunsValidationError{}
 
-// Validate checks the field values on WorkflowOptions with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *WorkflowOptions) Validate() error {
+// Validate checks the field values on GetJobNextRunsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetJobNextRunsRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on WorkflowOptions with the rules
+// ValidateAll checks the field values on GetJobNextRunsRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// WorkflowOptionsMultiError, or nil if none found.
-func (m *WorkflowOptions) ValidateAll() error {
+// GetJobNextRunsRequestMultiError, or nil if none found.
+func (m *GetJobNextRunsRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *WorkflowOptions) validate(all bool) error {
+func (m *GetJobNextRunsRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if m.RunTimeout != nil {
-		// no validation rules for RunTimeout
-	}
+	// no validation rules for JobId
 
 	if len(errors) > 0 {
-		return WorkflowOptionsMultiError(errors)
+		return GetJobNextRunsRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// WorkflowOptionsMultiError is an error wrapping multiple validation errors
-// returned by WorkflowOptions.ValidateAll() if the designated constraints
-// aren't met.
-type WorkflowOptionsMultiError []error
+// GetJobNextRunsRequestMultiError is an error wrapping multiple validation
+// errors returned by GetJobNextRunsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetJobNextRunsRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m WorkflowOptionsMultiError) Error() string {
+func (m GetJobNextRunsRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3332,11 +10491,11 @@ func (m WorkflowOptionsMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m WorkflowOptionsMultiError) AllErrors() []error { return m }
+func (m GetJobNextRunsRequestMultiError) AllErrors() []error { return m }
 
-// WorkflowOptionsValidationError is the validation error returned by
-// WorkflowOptions.Validate if the designated constraints aren't met.
-type WorkflowOptionsValidationError struct {
+// GetJobNextRunsRequestValidationError is the validation error returned by
+// GetJobNextRunsRequest.Validate if the designated constraints aren't met.
+type GetJobNextRunsRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3344,22 +10503,24 @@ type WorkflowOptionsValidationError struct {
 }
 
 // Field function returns field value.
-func (e WorkflowOptionsValidationError) Field() string { return e.field }
+func (e GetJobNextRunsRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e WorkflowOptionsValidationError) Reason() string { return e.reason }
+func (e GetJobNextRunsRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e WorkflowOptionsValidationError) Cause() error { return e.cause }
+func (e GetJobNextRunsRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e WorkflowOptionsValidationError) Key() bool { return e.key }
+func (e GetJobNextRunsRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e WorkflowOptionsValidationError) ErrorName() string { return "WorkflowOptionsValidationError" }
+func (e GetJobNextRunsRequestValidationError) ErrorName() string {
+	return "GetJobNextRunsRequestValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e WorkflowOptionsValidationError) Error() string {
+func (e GetJobNextRunsRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3371,14 +10532,14 @@ func (e WorkflowOptionsValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sWorkflowOptions.%s: %s%s",
+		"invalid %sGetJobNextRunsRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = WorkflowOptionsValidationError{}
+var _ error = GetJobNextRunsRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -3386,24 +10547,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = WorkflowOptionsValidationError{}
+} = GetJobNextRunsRequestValidationError{}
 
-// Validate checks the field values on ActivityOptions with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *ActivityOptions) Validate() error {
+// Validate checks the field values on GetJobNextRunsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetJobNextRunsResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ActivityOptions with the rules
+// ValidateAll checks the field values on GetJobNextRunsResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// ActivityOptionsMultiError, or nil if none found.
-func (m *ActivityOptions) ValidateAll() error {
+// GetJobNextRunsResponseMultiError, or nil if none found.
+func (m *GetJobNextRunsResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ActivityOptions) validate(all bool) error {
+func (m *GetJobNextRunsResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -3411,56 +10572,48 @@ func (m *ActivityOptions) validate(all bool) error {
 	var errors []error
 
 	if all {
-		switch v := interface{}(m.GetRetryPolicy()).(type) {
+		switch v := interface{}(m.GetNextRuns()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, ActivityOptionsValidationError{
-					field:  "RetryPolicy",
+				errors = append(errors, GetJobNextRunsResponseValidationError{
+					field:  "NextRuns",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, ActivityOptionsValidationError{
-					field:  "RetryPolicy",
+				errors = append(errors, GetJobNextRunsResponseValidationError{
+					field:  "NextRuns",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetRetryPolicy()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetNextRuns()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return ActivityOptionsValidationError{
-				field:  "RetryPolicy",
+			return GetJobNextRunsResponseValidationError{
+				field:  "NextRuns",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
 		}
 	}
 
-	if m.ScheduleToCloseTimeout != nil {
-		// no validation rules for ScheduleToCloseTimeout
-	}
-
-	if m.StartToCloseTimeout != nil {
-		// no validation rules for StartToCloseTimeout
-	}
-
 	if len(errors) > 0 {
-		return ActivityOptionsMultiError(errors)
+		return GetJobNextRunsResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// ActivityOptionsMultiError is an error wrapping multiple validation errors
-// returned by ActivityOptions.ValidateAll() if the designated constraints
-// aren't met.
-type ActivityOptionsMultiError []error
+// GetJobNextRunsResponseMultiError is an error wrapping multiple validation
+// errors returned by GetJobNextRunsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type GetJobNextRunsResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ActivityOptionsMultiError) Error() string {
+func (m GetJobNextRunsResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3469,11 +10622,11 @@ func (m ActivityOptionsMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ActivityOptionsMultiError) AllErrors() []error { return m }
-
-// ActivityOptionsValidationError is the validation error returned by
-// ActivityOptions.Validate if the designated constraints aren't met.
-type ActivityOptionsValidationError struct {
+func (m GetJobNextRunsResponseMultiError) AllErrors() []error { return m }
+
+// GetJobNextRunsResponseValidationError is the validation error returned by
+// GetJobNextRunsResponse.Validate if the designated constraints aren't met.
+type GetJobNextRunsResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3481,22 +10634,24 @@ type ActivityOptionsValidationError struct {
 }
 
 // Field function returns field value.
-func (e ActivityOptionsValidationError) Field() string { return e.field }
+func (e GetJobNextRunsResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ActivityOptionsValidationError) Reason() string { return e.reason }
+func (e GetJobNextRunsResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ActivityOptionsValidationError) Cause() error { return e.cause }
+func (e GetJobNextRunsResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ActivityOptionsValidationError) Key() bool { return e.key }
+func (e GetJobNextRunsResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ActivityOptionsValidationError) ErrorName() string { return "ActivityOptionsValidationError" }
+func (e GetJobNextRunsResponseValidationError) ErrorName() string {
+	return "GetJobNextRunsResponseValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e ActivityOptionsValidationError) Error() string {
+func (e GetJobNextRunsResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3508,14 +10663,14 @@ func (e ActivityOptionsValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sActivityOptions.%s: %s%s",
+		"invalid %sGetJobNextRunsResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ActivityOptionsValidationError{}
+var _ error = GetJobNextRunsResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -3523,47 +10678,46 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ActivityOptionsValidationError{}
+} = GetJobNextRunsResponseValidationError{}
 
-// Validate checks the field values on RetryPolicy with the rules defined in
-// the proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *RetryPolicy) Validate() error {
+// Validate checks the field values on GetJobStatusRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetJobStatusRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on RetryPolicy with the rules defined in
-// the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in RetryPolicyMultiError, or
-// nil if none found.
-func (m *RetryPolicy) ValidateAll() error {
+// ValidateAll checks the field values on GetJobStatusRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetJobStatusRequestMultiError, or nil if none found.
+func (m *GetJobStatusRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *RetryPolicy) validate(all bool) error {
+func (m *GetJobStatusRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if m.MaximumAttempts != nil {
-		// no validation rules for MaximumAttempts
-	}
+	// no validation rules for JobId
 
 	if len(errors) > 0 {
-		return RetryPolicyMultiError(errors)
+		return GetJobStatusRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// RetryPolicyMultiError is an error wrapping multiple validation errors
-// returned by RetryPolicy.ValidateAll() if the designated constraints aren't met.
-type RetryPolicyMultiError []error
+// GetJobStatusRequestMultiError is an error wrapping multiple validation
+// errors returned by GetJobStatusRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetJobStatusRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m RetryPolicyMultiError) Error() string {
+func (m GetJobStatusRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3572,11 +10726,11 @@ func (m RetryPolicyMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m RetryPolicyMultiError) AllErrors() []error { return m }
+func (m GetJobStatusRequestMultiError) AllErrors() []error { return m }
 
-// RetryPolicyValidationError is the validation error returned by
-// RetryPolicy.Validate if the designated constraints aren't met.
-type RetryPolicyValidationError struct {
+// GetJobStatusRequestValidationError is the validation error returned by
+// GetJobStatusRequest.Validate if the designated constraints aren't met.
+type GetJobStatusRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3584,22 +10738,24 @@ type RetryPolicyValidationError struct {
 }
 
 // Field function returns field value.
-func (e RetryPolicyValidationError) Field() string { return e.field }
+func (e GetJobStatusRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e RetryPolicyValidationError) Reason() string { return e.reason }
+func (e GetJobStatusRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e RetryPolicyValidationError) Cause() error { return e.cause }
+func (e GetJobStatusRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e RetryPolicyValidationError) Key() bool { return e.key }
+func (e GetJobStatusRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e RetryPolicyValidationError) ErrorName() string { return "RetryPolicyValidationError" }
+func (e GetJobStatusRequestValidationError) ErrorName() string {
+	return "GetJobStatusRequestValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e RetryPolicyValidationError) Error() string {
+func (e GetJobStatusRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3611,14 +10767,14 @@ func (e RetryPolicyValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sRetryPolicy.%s: %s%s",
+		"invalid %sGetJobStatusRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = RetryPolicyValidationError{}
+var _ error = GetJobStatusRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -3626,73 +10782,46 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = RetryPolicyValidationError{}
+} = GetJobStatusRequestValidationError{}
 
-// Validate checks the field values on CreateJobResponse with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *CreateJobResponse) Validate() error {
+// Validate checks the field values on GetJobStatusResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetJobStatusResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on CreateJobResponse with the rules
+// ValidateAll checks the field values on GetJobStatusResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// CreateJobResponseMultiError, or nil if none found.
-func (m *CreateJobResponse) ValidateAll() error {
+// GetJobStatusResponseMultiError, or nil if none found.
+func (m *GetJobStatusResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *CreateJobResponse) validate(all bool) error {
+func (m *GetJobStatusResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if all {
-		switch v := interface{}(m.GetJob()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, CreateJobResponseValidationError{
-					field:  "Job",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, CreateJobResponseValidationError{
-					field:  "Job",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetJob()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return CreateJobResponseValidationError{
-				field:  "Job",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
+	// no validation rules for Status
 
 	if len(errors) > 0 {
-		return CreateJobResponseMultiError(errors)
+		return GetJobStatusResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// CreateJobResponseMultiError is an error wrapping multiple validation errors
-// returned by CreateJobResponse.ValidateAll() if the designated constraints
-// aren't met.
-type CreateJobResponseMultiError []error
+// GetJobStatusResponseMultiError is an error wrapping multiple validation
+// errors returned by GetJobStatusResponse.ValidateAll() if the designated
+// constraints aren't met.
+type GetJobStatusResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m CreateJobResponseMultiError) Error() string {
+func (m GetJobStatusResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3701,11 +10830,11 @@ func (m CreateJobResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m CreateJobResponseMultiError) AllErrors() []error { return m }
+func (m GetJobStatusResponseMultiError) AllErrors() []error { return m }
 
-// CreateJobResponseValidationError is the validation error returned by
-// CreateJobResponse.Validate if the designated constraints aren't met.
-type CreateJobResponseValidationError struct {
+// GetJobStatusResponseValidationError is the validation error returned by
+// GetJobStatusResponse.Validate if the designated constraints aren't met.
+type GetJobStatusResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3713,24 +10842,24 @@ type CreateJobResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e CreateJobResponseValidationError) Field() string { return e.field }
+func (e GetJobStatusResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CreateJobResponseValidationError) Reason() string { return e.reason }
+func (e GetJobStatusResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CreateJobResponseValidationError) Cause() error { return e.cause }
+func (e GetJobStatusResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CreateJobResponseValidationError) Key() bool { return e.key }
+func (e GetJobStatusResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CreateJobResponseValidationError) ErrorName() string {
-	return "CreateJobResponseValidationError"
+func (e GetJobStatusResponseValidationError) ErrorName() string {
+	return "GetJobStatusResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e CreateJobResponseValidationError) Error() string {
+func (e GetJobStatusResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3742,14 +10871,14 @@ func (e CreateJobResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCreateJobResponse.%s: %s%s",
+		"invalid %sGetJobStatusResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CreateJobResponseValidationError{}
+var _ error = GetJobStatusResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -3757,75 +10886,48 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CreateJobResponseValidationError{}
+} = GetJobStatusResponseValidationError{}
 
-// Validate checks the field values on JobMappingTransformer with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *JobMappingTransformer) Validate() error {
+// Validate checks the field values on JobStatusRecord with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *JobStatusRecord) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on JobMappingTransformer with the rules
+// ValidateAll checks the field values on JobStatusRecord with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// JobMappingTransformerMultiError, or nil if none found.
-func (m *JobMappingTransformer) ValidateAll() error {
+// JobStatusRecordMultiError, or nil if none found.
+func (m *JobStatusRecord) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *JobMappingTransformer) validate(all bool) error {
+func (m *JobStatusRecord) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Source
+	// no validation rules for JobId
 
-	if all {
-		switch v := interface{}(m.GetConfig()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, JobMappingTransformerValidationError{
-					field:  "Config",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, JobMappingTransformerValidationError{
-					field:  "Config",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetConfig()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return JobMappingTransformerValidationError{
-				field:  "Config",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
+	// no validation rules for Status
 
 	if len(errors) > 0 {
-		return JobMappingTransformerMultiError(errors)
+		return JobStatusRecordMultiError(errors)
 	}
 
 	return nil
 }
 
-// JobMappingTransformerMultiError is an error wrapping multiple validation
-// errors returned by JobMappingTransformer.ValidateAll() if the designated
-// constraints aren't met.
-type JobMappingTransformerMultiError []error
+// JobStatusRecordMultiError is an error wrapping multiple validation errors
+// returned by JobStatusRecord.ValidateAll() if the designated constraints
+// aren't met.
+type JobStatusRecordMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m JobMappingTransformerMultiError) Error() string {
+func (m JobStatusRecordMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3834,11 +10936,11 @@ func (m JobMappingTransformerMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m JobMappingTransformerMultiError) AllErrors() []error { return m }
+func (m JobStatusRecordMultiError) AllErrors() []error { return m }
 
-// JobMappingTransformerValidationError is the validation error returned by
-// JobMappingTransformer.Validate if the designated constraints aren't met.
-type JobMappingTransformerValidationError struct {
+// JobStatusRecordValidationError is the validation error returned by
+// JobStatusRecord.Validate if the designated constraints aren't met.
+type JobStatusRecordValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3846,24 +10948,22 @@ type JobMappingTransformerValidationError struct {
 }
 
 // Field function returns field value.
-func (e JobMappingTransformerValidationError) Field() string { return e.field }
+func (e JobStatusRecordValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e JobMappingTransformerValidationError) Reason() string { return e.reason }
+func (e JobStatusRecordValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e JobMappingTransformerValidationError) Cause() error { return e.cause }
+func (e JobStatusRecordValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e JobMappingTransformerValidationError) Key() bool { return e.key }
+func (e JobStatusRecordValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e JobMappingTransformerValidationError) ErrorName() string {
-	return "JobMappingTransformerValidationError"
-}
+func (e JobStatusRecordValidationError) ErrorName() string { return "JobStatusRecordValidationError" }
 
 // Error satisfies the builtin error interface
-func (e JobMappingTransformerValidationError) Error() string {
+func (e JobStatusRecordValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3875,14 +10975,14 @@ func (e JobMappingTransformerValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sJobMappingTransformer.%s: %s%s",
+		"invalid %sJobStatusRecord.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = JobMappingTransformerValidationError{}
+var _ error = JobStatusRecordValidationError{}
 
 var _ interface {
 	Field() string
@@ -3890,78 +10990,46 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = JobMappingTransformerValidationError{}
+} = JobStatusRecordValidationError{}
 
-// Validate checks the field values on JobMapping with the rules defined in the
-// proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *JobMapping) Validate() error {
+// Validate checks the field values on GetJobStatusesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetJobStatusesRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on JobMapping with the rules defined in
-// the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in JobMappingMultiError, or
-// nil if none found.
-func (m *JobMapping) ValidateAll() error {
+// ValidateAll checks the field values on GetJobStatusesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetJobStatusesRequestMultiError, or nil if none found.
+func (m *GetJobStatusesRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *JobMapping) validate(all bool) error {
+func (m *GetJobStatusesRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Schema
-
-	// no validation rules for Table
-
-	// no validation rules for Column
-
-	if all {
-		switch v := interface{}(m.GetTransformer()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, JobMappingValidationError{
-					field:  "Transformer",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, JobMappingValidationError{
-					field:  "Transformer",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetTransformer()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return JobMappingValidationError{
-				field:  "Transformer",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
+	// no validation rules for AccountId
 
 	if len(errors) > 0 {
-		return JobMappingMultiError(errors)
+		return GetJobStatusesRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// JobMappingMultiError is an error wrapping multiple validation errors
-// returned by JobMapping.ValidateAll() if the designated constraints aren't met.
-type JobMappingMultiError []error
+// GetJobStatusesRequestMultiError is an error wrapping multiple validation
+// errors returned by GetJobStatusesRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetJobStatusesRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m JobMappingMultiError) Error() string {
+func (m GetJobStatusesRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3970,11 +11038,11 @@ func (m JobMappingMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m JobMappingMultiError) AllErrors() []error { return m }
+func (m GetJobStatusesRequestMultiError) AllErrors() []error { return m }
 
-// JobMappingValidationError is the validation error returned by
-// JobMapping.Validate if the designated constraints aren't met.
-type JobMappingValidationError struct {
+// GetJobStatusesRequestValidationError is the validation error returned by
+// GetJobStatusesRequest.Validate if the designated constraints aren't met.
+type GetJobStatusesRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3982,22 +11050,24 @@ type JobMappingValidationError struct {
 }
 
 // Field function returns field value.
-func (e JobMappingValidationError) Field() string { return e.field }
+func (e GetJobStatusesRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e JobMappingValidationError) Reason() string { return e.reason }
+func (e GetJobStatusesRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e JobMappingValidationError) Cause() error { return e.cause }
+func (e GetJobStatusesRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e JobMappingValidationError) Key() bool { return e.key }
+func (e GetJobStatusesRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e JobMappingValidationError) ErrorName() string { return "JobMappingValidationError" }
+func (e GetJobStatusesRequestValidationError) ErrorName() string {
+	return "GetJobStatusesRequestValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e JobMappingValidationError) Error() string {
+func (e GetJobStatusesRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -4009,14 +11079,14 @@ func (e JobMappingValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sJobMapping.%s: %s%s",
+		"invalid %sGetJobStatusesRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = JobMappingValidationError{}
+var _ error = GetJobStatusesRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -4024,46 +11094,78 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = JobMappingValidationError{}
+} = GetJobStatusesRequestValidationError{}
 
-// Validate checks the field values on GetJobRequest with the rules defined in
-// the proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *GetJobRequest) Validate() error {
+// Validate checks the field values on GetJobStatusesResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetJobStatusesResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetJobRequest with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in GetJobRequestMultiError, or
-// nil if none found.
-func (m *GetJobRequest) ValidateAll() error {
+// ValidateAll checks the field values on GetJobStatusesResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetJobStatusesResponseMultiError, or nil if none found.
+func (m *GetJobStatusesResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetJobRequest) validate(all bool) error {
+func (m *GetJobStatusesResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Id
+	for idx, item := range m.GetStatuses() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetJobStatusesResponseValidationError{
+						field:  fmt.Sprintf("Statuses[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetJobStatusesResponseValidationError{
+						field:  fmt.Sprintf("Statuses[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetJobStatusesResponseValidationError{
+					field:  fmt.Sprintf("Statuses[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
 
 	if len(errors) > 0 {
-		return GetJobRequestMultiError(errors)
+		return GetJobStatusesResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetJobRequestMultiError is an error wrapping multiple validation errors
-// returned by GetJobRequest.ValidateAll() if the designated constraints
-// aren't met.
-type GetJobRequestMultiError []error
+// GetJobStatusesResponseMultiError is an error wrapping multiple validation
+// errors returned by GetJobStatusesResponse.ValidateAll() if the designated
+// constraints aren't met.
+type GetJobStatusesResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetJobRequestMultiError) Error() string {
+func (m GetJobStatusesResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -4072,11 +11174,11 @@ func (m GetJobRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetJobRequestMultiError) AllErrors() []error { return m }
+func (m GetJobStatusesResponseMultiError) AllErrors() []error { return m }
 
-// GetJobRequestValidationError is the validation error returned by
-// GetJobRequest.Validate if the designated constraints aren't met.
-type GetJobRequestValidationError struct {
+// GetJobStatusesResponseValidationError is the validation error returned by
+// GetJobStatusesResponse.Validate if the designated constraints aren't met.
+type GetJobStatusesResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -4084,22 +11186,24 @@ type GetJobRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetJobRequestValidationError) Field() string { return e.field }
+func (e GetJobStatusesResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetJobRequestValidationError) Reason() string { return e.reason }
+func (e GetJobStatusesResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetJobRequestValidationError) Cause() error { return e.cause }
+func (e GetJobStatusesResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetJobRequestValidationError) Key() bool { return e.key }
+func (e GetJobStatusesResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetJobRequestValidationError) ErrorName() string { return "GetJobRequestValidationError" }
+func (e GetJobStatusesResponseValidationError) ErrorName() string {
+	return "GetJobStatusesResponseValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e GetJobRequestValidationError) Error() string {
+func (e GetJobStatusesResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -4111,14 +11215,14 @@ func (e GetJobRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetJobRequest.%s: %s%s",
+		"invalid %sGetJobStatusesResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetJobRequestValidationError{}
+var _ error = GetJobStatusesResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -4126,73 +11230,46 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetJobRequestValidationError{}
+} = GetJobStatusesResponseValidationError{}
 
-// Validate checks the field values on GetJobResponse with the rules defined in
-// the proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *GetJobResponse) Validate() error {
+// Validate checks the field values on ActivityFailure with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ActivityFailure) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetJobResponse with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in GetJobResponseMultiError,
-// or nil if none found.
-func (m *GetJobResponse) ValidateAll() error {
+// ValidateAll checks the field values on ActivityFailure with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ActivityFailureMultiError, or nil if none found.
+func (m *ActivityFailure) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetJobResponse) validate(all bool) error {
+func (m *ActivityFailure) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if all {
-		switch v := interface{}(m.GetJob()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, GetJobResponseValidationError{
-					field:  "Job",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, GetJobResponseValidationError{
-					field:  "Job",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetJob()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return GetJobResponseValidationError{
-				field:  "Job",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
+	// no validation rules for Message
 
 	if len(errors) > 0 {
-		return GetJobResponseMultiError(errors)
+		return ActivityFailureMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetJobResponseMultiError is an error wrapping multiple validation errors
-// returned by GetJobResponse.ValidateAll() if the designated constraints
+// ActivityFailureMultiError is an error wrapping multiple validation errors
+// returned by ActivityFailure.ValidateAll() if the designated constraints
 // aren't met.
-type GetJobResponseMultiError []error
+type ActivityFailureMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetJobResponseMultiError) Error() string {
+func (m ActivityFailureMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -4201,11 +11278,11 @@ func (m GetJobResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetJobResponseMultiError) AllErrors() []error { return m }
-
-// GetJobResponseValidationError is the validation error returned by
-// GetJobResponse.Validate if the designated constraints aren't met.
-type GetJobResponseValidationError struct {
+func (m ActivityFailureMultiError) AllErrors() []error { return m }
+
+// ActivityFailureValidationError is the validation error returned by
+// ActivityFailure.Validate if the designated constraints aren't met.
+type ActivityFailureValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -4213,22 +11290,22 @@ type GetJobResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetJobResponseValidationError) Field() string { return e.field }
+func (e ActivityFailureValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetJobResponseValidationError) Reason() string { return e.reason }
+func (e ActivityFailureValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetJobResponseValidationError) Cause() error { return e.cause }
+func (e ActivityFailureValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetJobResponseValidationError) Key() bool { return e.key }
+func (e ActivityFailureValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetJobResponseValidationError) ErrorName() string { return "GetJobResponseValidationError" }
+func (e ActivityFailureValidationError) ErrorName() string { return "ActivityFailureValidationError" }
 
 // Error satisfies the builtin error interface
-func (e GetJobResponseValidationError) Error() string {
+func (e ActivityFailureValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -4240,14 +11317,14 @@ func (e GetJobResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetJobResponse.%s: %s%s",
+		"invalid %sActivityFailure.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetJobResponseValidationError{}
+var _ error = ActivityFailureValidationError{}
 
 var _ interface {
 	Field() string
@@ -4255,50 +11332,81 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetJobResponseValidationError{}
+} = ActivityFailureValidationError{}
 
-// Validate checks the field values on UpdateJobScheduleRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *UpdateJobScheduleRequest) Validate() error {
+// Validate checks the field values on PendingActivity with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *PendingActivity) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on UpdateJobScheduleRequest with the
-// rules defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on PendingActivity with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// UpdateJobScheduleRequestMultiError, or nil if none found.
-func (m *UpdateJobScheduleRequest) ValidateAll() error {
+// PendingActivityMultiError, or nil if none found.
+func (m *PendingActivity) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *UpdateJobScheduleRequest) validate(all bool) error {
+func (m *PendingActivity) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Id
+	// no validation rules for Status
+
+	// no validation rules for ActivityName
+
+	if m.LastFailure != nil {
+
+		if all {
+			switch v := interface{}(m.GetLastFailure()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, PendingActivityValidationError{
+						field:  "LastFailure",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, PendingActivityValidationError{
+						field:  "LastFailure",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetLastFailure()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return PendingActivityValidationError{
+					field:  "LastFailure",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
 
-	if m.CronSchedule != nil {
-		// no validation rules for CronSchedule
 	}
 
 	if len(errors) > 0 {
-		return UpdateJobScheduleRequestMultiError(errors)
+		return PendingActivityMultiError(errors)
 	}
 
 	return nil
 }
 
-// UpdateJobScheduleRequestMultiError is an error wrapping multiple validation
-// errors returned by UpdateJobScheduleRequest.ValidateAll() if the designated
-// constraints aren't met.
-type UpdateJobScheduleRequestMultiError []error
+// PendingActivityMultiError is an error wrapping multiple validation errors
+// returned by PendingActivity.ValidateAll() if the designated constraints
+// aren't met.
+type PendingActivityMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m UpdateJobScheduleRequestMultiError) Error() string {
+func (m PendingActivityMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -4307,11 +11415,11 @@ func (m UpdateJobScheduleRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m UpdateJobScheduleRequestMultiError) AllErrors() []error { return m }
+func (m PendingActivityMultiError) AllErrors() []error { return m }
 
-// UpdateJobScheduleRequestValidationError is the validation error returned by
-// UpdateJobScheduleRequest.Validate if the designated constraints aren't met.
-type UpdateJobScheduleRequestValidationError struct {
+// PendingActivityValidationError is the validation error returned by
+// PendingActivity.Validate if the designated constraints aren't met.
+type PendingActivityValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -4319,24 +11427,22 @@ type UpdateJobScheduleRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e UpdateJobScheduleRequestValidationError) Field() string { return e.field }
+func (e PendingActivityValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e UpdateJobScheduleRequestValidationError) Reason() string { return e.reason }
+func (e PendingActivityValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e UpdateJobScheduleRequestValidationError) Cause() error { return e.cause }
+func (e PendingActivityValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e UpdateJobScheduleRequestValidationError) Key() bool { return e.key }
+func (e PendingActivityValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e UpdateJobScheduleRequestValidationError) ErrorName() string {
-	return "UpdateJobScheduleRequestValidationError"
-}
+func (e PendingActivityValidationError) ErrorName() string { return "PendingActivityValidationError" }
 
 // Error satisfies the builtin error interface
-func (e UpdateJobScheduleRequestValidationError) Error() string {
+func (e PendingActivityValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -4348,14 +11454,14 @@ func (e UpdateJobScheduleRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sUpdateJobScheduleRequest.%s: %s%s",
+		"invalid %sPendingActivity.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = UpdateJobScheduleRequestValidationError{}
+var _ error = PendingActivityValidationError{}
 
 var _ interface {
 	Field() string
@@ -4363,183 +11469,184 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = UpdateJobScheduleRequestValidationError{}
+} = PendingActivityValidationError{}
 
-// Validate checks the field values on UpdateJobScheduleResponse with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *UpdateJobScheduleResponse) Validate() error {
+// Validate checks the field values on JobRun with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *JobRun) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on UpdateJobScheduleResponse with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// UpdateJobScheduleResponseMultiError, or nil if none found.
-func (m *UpdateJobScheduleResponse) ValidateAll() error {
+// ValidateAll checks the field values on JobRun with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in JobRunMultiError, or nil if none found.
+func (m *JobRun) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *UpdateJobScheduleResponse) validate(all bool) error {
+func (m *JobRun) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	// no validation rules for Id
+
+	// no validation rules for JobId
+
+	// no validation rules for Name
+
+	// no validation rules for Status
+
 	if all {
-		switch v := interface{}(m.GetJob()).(type) {
+		switch v := interface{}(m.GetStartedAt()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, UpdateJobScheduleResponseValidationError{
-					field:  "Job",
+				errors = append(errors, JobRunValidationError{
+					field:  "StartedAt",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, UpdateJobScheduleResponseValidationError{
-					field:  "Job",
+				errors = append(errors, JobRunValidationError{
+					field:  "StartedAt",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetJob()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetStartedAt()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return UpdateJobScheduleResponseValidationError{
-				field:  "Job",
+			return JobRunValidationError{
+				field:  "StartedAt",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
 		}
 	}
 
-	if len(errors) > 0 {
-		return UpdateJobScheduleResponseMultiError(errors)
-	}
-
-	return nil
-}
-
-// UpdateJobScheduleResponseMultiError is an error wrapping multiple validation
-// errors returned by UpdateJobScheduleResponse.ValidateAll() if the
-// designated constraints aren't met.
-type UpdateJobScheduleResponseMultiError []error
-
-// Error returns a concatenation of all the error messages it wraps.
-func (m UpdateJobScheduleResponseMultiError) Error() string {
-	var msgs []string
-	for _, err := range m {
-		msgs = append(msgs, err.Error())
-	}
-	return strings.Join(msgs, "; ")
-}
-
-// AllErrors returns a list of validation violation errors.
-func (m UpdateJobScheduleResponseMultiError) AllErrors() []error { return m }
-
-// UpdateJobScheduleResponseValidationError is the validation error returned by
-// UpdateJobScheduleResponse.Validate if the designated constraints aren't met.
-type UpdateJobScheduleResponseValidationError struct {
-	field  string
-	reason string
-	cause  error
-	key    bool
-}
-
-// Field function returns field value.
-func (e UpdateJobScheduleResponseValidationError) Field() string { return e.field }
-
-// Reason function returns reason value.
-func (e UpdateJobScheduleResponseValidationError) Reason() string { return e.reason }
-
-// Cause function returns cause value.
-func (e UpdateJobScheduleResponseValidationError) Cause() error { return e.cause }
-
-// Key function returns key value.
-func (e UpdateJobScheduleResponseValidationError) Key() bool { return e.key }
-
-// ErrorName returns error name.
-func (e UpdateJobScheduleResponseValidationError) ErrorName() string {
-	return "UpdateJobScheduleResponseValidationError"
-}
-
-// Error satisfies the builtin error interface
-func (e UpdateJobScheduleResponseValidationError) Error() string {
-	cause := ""
-	if e.cause != nil {
-		cause = fmt.Sprintf(" | caused by: %v", e.cause)
-	}
-
-	key := ""
-	if e.key {
-		key = "key for "
-	}
-
-	return fmt.Sprintf(
-		"invalid %sUpdateJobScheduleResponse.%s: %s%s",
-		key,
-		e.field,
-		e.reason,
-		cause)
-}
+	for idx, item := range m.GetPendingActivities() {
+		_, _ = idx, item
 
-var _ error = UpdateJobScheduleResponseValidationError{}
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, JobRunValidationError{
+						field:  fmt.Sprintf("PendingActivities[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, JobRunValidationError{
+						field:  fmt.Sprintf("PendingActivities[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return JobRunValidationError{
+					field:  fmt.Sprintf("PendingActivities[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
 
-var _ interface {
-	Field() string
-	Reason() string
-	Key() bool
-	Cause() error
-	ErrorName() string
-} = UpdateJobScheduleResponseValidationError{}
+	}
 
-// Validate checks the field values on PauseJobRequest with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *PauseJobRequest) Validate() error {
-	return m.validate(false)
-}
+	for idx, item := range m.GetWatermarks() {
+		_, _ = idx, item
 
-// ValidateAll checks the field values on PauseJobRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// PauseJobRequestMultiError, or nil if none found.
-func (m *PauseJobRequest) ValidateAll() error {
-	return m.validate(true)
-}
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, JobRunValidationError{
+						field:  fmt.Sprintf("Watermarks[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, JobRunValidationError{
+						field:  fmt.Sprintf("Watermarks[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return JobRunValidationError{
+					field:  fmt.Sprintf("Watermarks[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
 
-func (m *PauseJobRequest) validate(all bool) error {
-	if m == nil {
-		return nil
 	}
 
-	var errors []error
+	if m.CompletedAt != nil {
 
-	// no validation rules for Id
+		if all {
+			switch v := interface{}(m.GetCompletedAt()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, JobRunValidationError{
+						field:  "CompletedAt",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, JobRunValidationError{
+						field:  "CompletedAt",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetCompletedAt()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return JobRunValidationError{
+					field:  "CompletedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
 
-	// no validation rules for Pause
+	}
 
-	if m.Note != nil {
-		// no validation rules for Note
+	if m.JobVersionId != nil {
+		// no validation rules for JobVersionId
 	}
 
 	if len(errors) > 0 {
-		return PauseJobRequestMultiError(errors)
+		return JobRunMultiError(errors)
 	}
 
 	return nil
 }
 
-// PauseJobRequestMultiError is an error wrapping multiple validation errors
-// returned by PauseJobRequest.ValidateAll() if the designated constraints
-// aren't met.
-type PauseJobRequestMultiError []error
+// JobRunMultiError is an error wrapping multiple validation errors returned by
+// JobRun.ValidateAll() if the designated constraints aren't met.
+type JobRunMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m PauseJobRequestMultiError) Error() string {
+func (m JobRunMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -4548,11 +11655,11 @@ func (m PauseJobRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m PauseJobRequestMultiError) AllErrors() []error { return m }
+func (m JobRunMultiError) AllErrors() []error { return m }
 
-// PauseJobRequestValidationError is the validation error returned by
-// PauseJobRequest.Validate if the designated constraints aren't met.
-type PauseJobRequestValidationError struct {
+// JobRunValidationError is the validation error returned by JobRun.Validate if
+// the designated constraints aren't met.
+type JobRunValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -4560,22 +11667,22 @@ type PauseJobRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e PauseJobRequestValidationError) Field() string { return e.field }
+func (e JobRunValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e PauseJobRequestValidationError) Reason() string { return e.reason }
+func (e JobRunValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e PauseJobRequestValidationError) Cause() error { return e.cause }
+func (e JobRunValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e PauseJobRequestValidationError) Key() bool { return e.key }
+func (e JobRunValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e PauseJobRequestValidationError) ErrorName() string { return "PauseJobRequestValidationError" }
+func (e JobRunValidationError) ErrorName() string { return "JobRunValidationError" }
 
 // Error satisfies the builtin error interface
-func (e PauseJobRequestValidationError) Error() string {
+func (e JobRunValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -4587,14 +11694,14 @@ func (e PauseJobRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sPauseJobRequest.%s: %s%s",
+		"invalid %sJobRun.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = PauseJobRequestValidationError{}
+var _ error = JobRunValidationError{}
 
 var _ interface {
 	Field() string
@@ -4602,73 +11709,56 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = PauseJobRequestValidationError{}
+} = JobRunValidationError{}
 
-// Validate checks the field values on PauseJobResponse with the rules defined
+// Validate checks the field values on JobRunWatermark with the rules defined
 // in the proto definition for this message. If any rules are violated, the
 // first error encountered is returned, or nil if there are no violations.
-func (m *PauseJobResponse) Validate() error {
+func (m *JobRunWatermark) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on PauseJobResponse with the rules
+// ValidateAll checks the field values on JobRunWatermark with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// PauseJobResponseMultiError, or nil if none found.
-func (m *PauseJobResponse) ValidateAll() error {
+// JobRunWatermarkMultiError, or nil if none found.
+func (m *JobRunWatermark) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *PauseJobResponse) validate(all bool) error {
+func (m *JobRunWatermark) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if all {
-		switch v := interface{}(m.GetJob()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, PauseJobResponseValidationError{
-					field:  "Job",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, PauseJobResponseValidationError{
-					field:  "Job",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetJob()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return PauseJobResponseValidationError{
-				field:  "Job",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
+	// no validation rules for Schema
+
+	// no validation rules for Table
+
+	// no validation rules for Column
+
+	// no validation rules for EndValue
+
+	if m.StartValue != nil {
+		// no validation rules for StartValue
 	}
 
 	if len(errors) > 0 {
-		return PauseJobResponseMultiError(errors)
+		return JobRunWatermarkMultiError(errors)
 	}
 
 	return nil
 }
 
-// PauseJobResponseMultiError is an error wrapping multiple validation errors
-// returned by PauseJobResponse.ValidateAll() if the designated constraints
+// JobRunWatermarkMultiError is an error wrapping multiple validation errors
+// returned by JobRunWatermark.ValidateAll() if the designated constraints
 // aren't met.
-type PauseJobResponseMultiError []error
+type JobRunWatermarkMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m PauseJobResponseMultiError) Error() string {
+func (m JobRunWatermarkMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -4677,11 +11767,11 @@ func (m PauseJobResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m PauseJobResponseMultiError) AllErrors() []error { return m }
+func (m JobRunWatermarkMultiError) AllErrors() []error { return m }
 
-// PauseJobResponseValidationError is the validation error returned by
-// PauseJobResponse.Validate if the designated constraints aren't met.
-type PauseJobResponseValidationError struct {
+// JobRunWatermarkValidationError is the validation error returned by
+// JobRunWatermark.Validate if the designated constraints aren't met.
+type JobRunWatermarkValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -4689,22 +11779,22 @@ type PauseJobResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e PauseJobResponseValidationError) Field() string { return e.field }
+func (e JobRunWatermarkValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e PauseJobResponseValidationError) Reason() string { return e.reason }
+func (e JobRunWatermarkValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e PauseJobResponseValidationError) Cause() error { return e.cause }
+func (e JobRunWatermarkValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e PauseJobResponseValidationError) Key() bool { return e.key }
+func (e JobRunWatermarkValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e PauseJobResponseValidationError) ErrorName() string { return "PauseJobResponseValidationError" }
+func (e JobRunWatermarkValidationError) ErrorName() string { return "JobRunWatermarkValidationError" }
 
 // Error satisfies the builtin error interface
-func (e PauseJobResponseValidationError) Error() string {
+func (e JobRunWatermarkValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -4716,14 +11806,14 @@ func (e PauseJobResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sPauseJobResponse.%s: %s%s",
+		"invalid %sJobRunWatermark.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = PauseJobResponseValidationError{}
+var _ error = JobRunWatermarkValidationError{}
 
 var _ interface {
 	Field() string
@@ -4731,111 +11821,82 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = PauseJobResponseValidationError{}
+} = JobRunWatermarkValidationError{}
 
-// Validate checks the field values on UpdateJobSourceConnectionRequest with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the first error encountered is returned, or nil if there are
-// no violations.
-func (m *UpdateJobSourceConnectionRequest) Validate() error {
+// Validate checks the field values on JobWatermark with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *JobWatermark) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on UpdateJobSourceConnectionRequest with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the result is a list of violation errors wrapped in
-// UpdateJobSourceConnectionRequestMultiError, or nil if none found.
-func (m *UpdateJobSourceConnectionRequest) ValidateAll() error {
+// ValidateAll checks the field values on JobWatermark with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in JobWatermarkMultiError, or
+// nil if none found.
+func (m *JobWatermark) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *UpdateJobSourceConnectionRequest) validate(all bool) error {
+func (m *JobWatermark) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Id
+	// no validation rules for Schema
+
+	// no validation rules for Table
+
+	// no validation rules for Column
+
+	// no validation rules for Value
+
+	// no validation rules for JobRunId
 
 	if all {
-		switch v := interface{}(m.GetSource()).(type) {
+		switch v := interface{}(m.GetUpdatedAt()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, UpdateJobSourceConnectionRequestValidationError{
-					field:  "Source",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, UpdateJobSourceConnectionRequestValidationError{
-					field:  "Source",
+				errors = append(errors, JobWatermarkValidationError{
+					field:  "UpdatedAt",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
-		}
-	} else if v, ok := interface{}(m.GetSource()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return UpdateJobSourceConnectionRequestValidationError{
-				field:  "Source",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
-
-	for idx, item := range m.GetMappings() {
-		_, _ = idx, item
-
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, UpdateJobSourceConnectionRequestValidationError{
-						field:  fmt.Sprintf("Mappings[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, UpdateJobSourceConnectionRequestValidationError{
-						field:  fmt.Sprintf("Mappings[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				return UpdateJobSourceConnectionRequestValidationError{
-					field:  fmt.Sprintf("Mappings[%v]", idx),
+				errors = append(errors, JobWatermarkValidationError{
+					field:  "UpdatedAt",
 					reason: "embedded message failed validation",
 					cause:  err,
-				}
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUpdatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return JobWatermarkValidationError{
+				field:  "UpdatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
 			}
 		}
-
 	}
 
 	if len(errors) > 0 {
-		return UpdateJobSourceConnectionRequestMultiError(errors)
+		return JobWatermarkMultiError(errors)
 	}
 
 	return nil
 }
 
-// UpdateJobSourceConnectionRequestMultiError is an error wrapping multiple
-// validation errors returned by
-// UpdateJobSourceConnectionRequest.ValidateAll() if the designated
-// constraints aren't met.
-type UpdateJobSourceConnectionRequestMultiError []error
+// JobWatermarkMultiError is an error wrapping multiple validation errors
+// returned by JobWatermark.ValidateAll() if the designated constraints aren't met.
+type JobWatermarkMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m UpdateJobSourceConnectionRequestMultiError) Error() string {
+func (m JobWatermarkMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -4844,12 +11905,11 @@ func (m UpdateJobSourceConnectionRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m UpdateJobSourceConnectionRequestMultiError) AllErrors() []error { return m }
+func (m JobWatermarkMultiError) AllErrors() []error { return m }
 
-// UpdateJobSourceConnectionRequestValidationError is the validation error
-// returned by UpdateJobSourceConnectionRequest.Validate if the designated
-// constraints aren't met.
-type UpdateJobSourceConnectionRequestValidationError struct {
+// JobWatermarkValidationError is the validation error returned by
+// JobWatermark.Validate if the designated constraints aren't met.
+type JobWatermarkValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -4857,24 +11917,22 @@ type UpdateJobSourceConnectionRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e UpdateJobSourceConnectionRequestValidationError) Field() string { return e.field }
+func (e JobWatermarkValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e UpdateJobSourceConnectionRequestValidationError) Reason() string { return e.reason }
+func (e JobWatermarkValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e UpdateJobSourceConnectionRequestValidationError) Cause() error { return e.cause }
+func (e JobWatermarkValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e UpdateJobSourceConnectionRequestValidationError) Key() bool { return e.key }
+func (e JobWatermarkValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e UpdateJobSourceConnectionRequestValidationError) ErrorName() string {
-	return "UpdateJobSourceConnectionRequestValidationError"
-}
+func (e JobWatermarkValidationError) ErrorName() string { return "JobWatermarkValidationError" }
 
 // Error satisfies the builtin error interface
-func (e UpdateJobSourceConnectionRequestValidationError) Error() string {
+func (e JobWatermarkValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -4886,14 +11944,14 @@ func (e UpdateJobSourceConnectionRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sUpdateJobSourceConnectionRequest.%s: %s%s",
+		"invalid %sJobWatermark.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = UpdateJobSourceConnectionRequestValidationError{}
+var _ error = JobWatermarkValidationError{}
 
 var _ interface {
 	Field() string
@@ -4901,75 +11959,46 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = UpdateJobSourceConnectionRequestValidationError{}
+} = JobWatermarkValidationError{}
 
-// Validate checks the field values on UpdateJobSourceConnectionResponse with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the first error encountered is returned, or nil if there are
-// no violations.
-func (m *UpdateJobSourceConnectionResponse) Validate() error {
+// Validate checks the field values on GetJobWatermarksRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetJobWatermarksRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on UpdateJobSourceConnectionResponse
-// with the rules defined in the proto definition for this message. If any
-// rules are violated, the result is a list of violation errors wrapped in
-// UpdateJobSourceConnectionResponseMultiError, or nil if none found.
-func (m *UpdateJobSourceConnectionResponse) ValidateAll() error {
+// ValidateAll checks the field values on GetJobWatermarksRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetJobWatermarksRequestMultiError, or nil if none found.
+func (m *GetJobWatermarksRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *UpdateJobSourceConnectionResponse) validate(all bool) error {
+func (m *GetJobWatermarksRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if all {
-		switch v := interface{}(m.GetJob()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, UpdateJobSourceConnectionResponseValidationError{
-					field:  "Job",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, UpdateJobSourceConnectionResponseValidationError{
-					field:  "Job",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetJob()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return UpdateJobSourceConnectionResponseValidationError{
-				field:  "Job",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
+	// no validation rules for JobId
 
 	if len(errors) > 0 {
-		return UpdateJobSourceConnectionResponseMultiError(errors)
+		return GetJobWatermarksRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// UpdateJobSourceConnectionResponseMultiError is an error wrapping multiple
-// validation errors returned by
-// UpdateJobSourceConnectionResponse.ValidateAll() if the designated
+// GetJobWatermarksRequestMultiError is an error wrapping multiple validation
+// errors returned by GetJobWatermarksRequest.ValidateAll() if the designated
 // constraints aren't met.
-type UpdateJobSourceConnectionResponseMultiError []error
+type GetJobWatermarksRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m UpdateJobSourceConnectionResponseMultiError) Error() string {
+func (m GetJobWatermarksRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -4978,12 +12007,11 @@ func (m UpdateJobSourceConnectionResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m UpdateJobSourceConnectionResponseMultiError) AllErrors() []error { return m }
+func (m GetJobWatermarksRequestMultiError) AllErrors() []error { return m }
 
-// UpdateJobSourceConnectionResponseValidationError is the validation error
-// returned by UpdateJobSourceConnectionResponse.Validate if the designated
-// constraints aren't met.
-type UpdateJobSourceConnectionResponseValidationError struct {
+// GetJobWatermarksRequestValidationError is the validation error returned by
+// GetJobWatermarksRequest.Validate if the designated constraints aren't met.
+type GetJobWatermarksRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -4991,24 +12019,24 @@ type UpdateJobSourceConnectionResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e UpdateJobSourceConnectionResponseValidationError) Field() string { return e.field }
+func (e GetJobWatermarksRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e UpdateJobSourceConnectionResponseValidationError) Reason() string { return e.reason }
+func (e GetJobWatermarksRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e UpdateJobSourceConnectionResponseValidationError) Cause() error { return e.cause }
+func (e GetJobWatermarksRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e UpdateJobSourceConnectionResponseValidationError) Key() bool { return e.key }
+func (e GetJobWatermarksRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e UpdateJobSourceConnectionResponseValidationError) ErrorName() string {
-	return "UpdateJobSourceConnectionResponseValidationError"
+func (e GetJobWatermarksRequestValidationError) ErrorName() string {
+	return "GetJobWatermarksRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e UpdateJobSourceConnectionResponseValidationError) Error() string {
+func (e GetJobWatermarksRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -5020,14 +12048,14 @@ func (e UpdateJobSourceConnectionResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sUpdateJobSourceConnectionResponse.%s: %s%s",
+		"invalid %sGetJobWatermarksRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = UpdateJobSourceConnectionResponseValidationError{}
+var _ error = GetJobWatermarksRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -5035,47 +12063,47 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = UpdateJobSourceConnectionResponseValidationError{}
+} = GetJobWatermarksRequestValidationError{}
 
-// Validate checks the field values on PostgresSourceSchemaSubset with the
-// rules defined in the proto definition for this message. If any rules are
+// Validate checks the field values on GetJobWatermarksResponse with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *PostgresSourceSchemaSubset) Validate() error {
+func (m *GetJobWatermarksResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on PostgresSourceSchemaSubset with the
+// ValidateAll checks the field values on GetJobWatermarksResponse with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// PostgresSourceSchemaSubsetMultiError, or nil if none found.
-func (m *PostgresSourceSchemaSubset) ValidateAll() error {
+// GetJobWatermarksResponseMultiError, or nil if none found.
+func (m *GetJobWatermarksResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *PostgresSourceSchemaSubset) validate(all bool) error {
+func (m *GetJobWatermarksResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	for idx, item := range m.GetPostgresSchemas() {
+	for idx, item := range m.GetWatermarks() {
 		_, _ = idx, item
 
 		if all {
 			switch v := interface{}(item).(type) {
 			case interface{ ValidateAll() error }:
 				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, PostgresSourceSchemaSubsetValidationError{
-						field:  fmt.Sprintf("PostgresSchemas[%v]", idx),
+					errors = append(errors, GetJobWatermarksResponseValidationError{
+						field:  fmt.Sprintf("Watermarks[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			case interface{ Validate() error }:
 				if err := v.Validate(); err != nil {
-					errors = append(errors, PostgresSourceSchemaSubsetValidationError{
-						field:  fmt.Sprintf("PostgresSchemas[%v]", idx),
+					errors = append(errors, GetJobWatermarksResponseValidationError{
+						field:  fmt.Sprintf("Watermarks[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
@@ -5083,8 +12111,8 @@ func (m *PostgresSourceSchemaSubset) validate(all bool) error {
 			}
 		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				return PostgresSourceSchemaSubsetValidationError{
-					field:  fmt.Sprintf("PostgresSchemas[%v]", idx),
+				return GetJobWatermarksResponseValidationError{
+					field:  fmt.Sprintf("Watermarks[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
 				}
@@ -5094,19 +12122,19 @@ func (m *PostgresSourceSchemaSubset) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return PostgresSourceSchemaSubsetMultiError(errors)
+		return GetJobWatermarksResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// PostgresSourceSchemaSubsetMultiError is an error wrapping multiple
-// validation errors returned by PostgresSourceSchemaSubset.ValidateAll() if
-// the designated constraints aren't met.
-type PostgresSourceSchemaSubsetMultiError []error
+// GetJobWatermarksResponseMultiError is an error wrapping multiple validation
+// errors returned by GetJobWatermarksResponse.ValidateAll() if the designated
+// constraints aren't met.
+type GetJobWatermarksResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m PostgresSourceSchemaSubsetMultiError) Error() string {
+func (m GetJobWatermarksResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -5115,11 +12143,11 @@ func (m PostgresSourceSchemaSubsetMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m PostgresSourceSchemaSubsetMultiError) AllErrors() []error { return m }
+func (m GetJobWatermarksResponseMultiError) AllErrors() []error { return m }
 
-// PostgresSourceSchemaSubsetValidationError is the validation error returned
-// by PostgresSourceSchemaSubset.Validate if the designated constraints aren't met.
-type PostgresSourceSchemaSubsetValidationError struct {
+// GetJobWatermarksResponseValidationError is the validation error returned by
+// GetJobWatermarksResponse.Validate if the designated constraints aren't met.
+type GetJobWatermarksResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -5127,24 +12155,24 @@ type PostgresSourceSchemaSubsetValidationError struct {
 }
 
 // Field function returns field value.
-func (e PostgresSourceSchemaSubsetValidationError) Field() string { return e.field }
+func (e GetJobWatermarksResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e PostgresSourceSchemaSubsetValidationError) Reason() string { return e.reason }
+func (e GetJobWatermarksResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e PostgresSourceSchemaSubsetValidationError) Cause() error { return e.cause }
+func (e GetJobWatermarksResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e PostgresSourceSchemaSubsetValidationError) Key() bool { return e.key }
+func (e GetJobWatermarksResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e PostgresSourceSchemaSubsetValidationError) ErrorName() string {
-	return "PostgresSourceSchemaSubsetValidationError"
+func (e GetJobWatermarksResponseValidationError) ErrorName() string {
+	return "GetJobWatermarksResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e PostgresSourceSchemaSubsetValidationError) Error() string {
+func (e GetJobWatermarksResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -5156,14 +12184,14 @@ func (e PostgresSourceSchemaSubsetValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sPostgresSourceSchemaSubset.%s: %s%s",
+		"invalid %sGetJobWatermarksResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = PostgresSourceSchemaSubsetValidationError{}
+var _ error = GetJobWatermarksResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -5171,47 +12199,51 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = PostgresSourceSchemaSubsetValidationError{}
+} = GetJobWatermarksResponseValidationError{}
 
-// Validate checks the field values on MysqlSourceSchemaSubset with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on SetJobRunWatermarksRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *MysqlSourceSchemaSubset) Validate() error {
+func (m *SetJobRunWatermarksRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on MysqlSourceSchemaSubset with the
+// ValidateAll checks the field values on SetJobRunWatermarksRequest with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// MysqlSourceSchemaSubsetMultiError, or nil if none found.
-func (m *MysqlSourceSchemaSubset) ValidateAll() error {
+// SetJobRunWatermarksRequestMultiError, or nil if none found.
+func (m *SetJobRunWatermarksRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *MysqlSourceSchemaSubset) validate(all bool) error {
+func (m *SetJobRunWatermarksRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	for idx, item := range m.GetMysqlSchemas() {
+	// no validation rules for JobId
+
+	// no validation rules for JobRunId
+
+	for idx, item := range m.GetWatermarks() {
 		_, _ = idx, item
 
 		if all {
 			switch v := interface{}(item).(type) {
 			case interface{ ValidateAll() error }:
 				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, MysqlSourceSchemaSubsetValidationError{
-						field:  fmt.Sprintf("MysqlSchemas[%v]", idx),
+					errors = append(errors, SetJobRunWatermarksRequestValidationError{
+						field:  fmt.Sprintf("Watermarks[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			case interface{ Validate() error }:
 				if err := v.Validate(); err != nil {
-					errors = append(errors, MysqlSourceSchemaSubsetValidationError{
-						field:  fmt.Sprintf("MysqlSchemas[%v]", idx),
+					errors = append(errors, SetJobRunWatermarksRequestValidationError{
+						field:  fmt.Sprintf("Watermarks[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
@@ -5219,8 +12251,8 @@ func (m *MysqlSourceSchemaSubset) validate(all bool) error {
 			}
 		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				return MysqlSourceSchemaSubsetValidationError{
-					field:  fmt.Sprintf("MysqlSchemas[%v]", idx),
+				return SetJobRunWatermarksRequestValidationError{
+					field:  fmt.Sprintf("Watermarks[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
 				}
@@ -5230,19 +12262,19 @@ func (m *MysqlSourceSchemaSubset) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return MysqlSourceSchemaSubsetMultiError(errors)
+		return SetJobRunWatermarksRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// MysqlSourceSchemaSubsetMultiError is an error wrapping multiple validation
-// errors returned by MysqlSourceSchemaSubset.ValidateAll() if the designated
-// constraints aren't met.
-type MysqlSourceSchemaSubsetMultiError []error
+// SetJobRunWatermarksRequestMultiError is an error wrapping multiple
+// validation errors returned by SetJobRunWatermarksRequest.ValidateAll() if
+// the designated constraints aren't met.
+type SetJobRunWatermarksRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m MysqlSourceSchemaSubsetMultiError) Error() string {
+func (m SetJobRunWatermarksRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -5251,11 +12283,11 @@ func (m MysqlSourceSchemaSubsetMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m MysqlSourceSchemaSubsetMultiError) AllErrors() []error { return m }
+func (m SetJobRunWatermarksRequestMultiError) AllErrors() []error { return m }
 
-// MysqlSourceSchemaSubsetValidationError is the validation error returned by
-// MysqlSourceSchemaSubset.Validate if the designated constraints aren't met.
-type MysqlSourceSchemaSubsetValidationError struct {
+// SetJobRunWatermarksRequestValidationError is the validation error returned
+// by SetJobRunWatermarksRequest.Validate if the designated constraints aren't met.
+type SetJobRunWatermarksRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -5263,24 +12295,24 @@ type MysqlSourceSchemaSubsetValidationError struct {
 }
 
 // Field function returns field value.
-func (e MysqlSourceSchemaSubsetValidationError) Field() string { return e.field }
+func (e SetJobRunWatermarksRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e MysqlSourceSchemaSubsetValidationError) Reason() string { return e.reason }
+func (e SetJobRunWatermarksRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e MysqlSourceSchemaSubsetValidationError) Cause() error { return e.cause }
+func (e SetJobRunWatermarksRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e MysqlSourceSchemaSubsetValidationError) Key() bool { return e.key }
+func (e SetJobRunWatermarksRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e MysqlSourceSchemaSubsetValidationError) ErrorName() string {
-	return "MysqlSourceSchemaSubsetValidationError"
+func (e SetJobRunWatermarksRequestValidationError) ErrorName() string {
+	return "SetJobRunWatermarksRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e MysqlSourceSchemaSubsetValidationError) Error() string {
+func (e SetJobRunWatermarksRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -5292,14 +12324,14 @@ func (e MysqlSourceSchemaSubsetValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sMysqlSourceSchemaSubset.%s: %s%s",
+		"invalid %sSetJobRunWatermarksRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = MysqlSourceSchemaSubsetValidationError{}
+var _ error = SetJobRunWatermarksRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -5307,131 +12339,151 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = MysqlSourceSchemaSubsetValidationError{}
+} = SetJobRunWatermarksRequestValidationError{}
 
-// Validate checks the field values on JobSourceSqlSubetSchemas with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on SetJobRunWatermarksResponse with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *JobSourceSqlSubetSchemas) Validate() error {
+func (m *SetJobRunWatermarksResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SetJobRunWatermarksResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SetJobRunWatermarksResponseMultiError, or nil if none found.
+func (m *SetJobRunWatermarksResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SetJobRunWatermarksResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return SetJobRunWatermarksResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// SetJobRunWatermarksResponseMultiError is an error wrapping multiple
+// validation errors returned by SetJobRunWatermarksResponse.ValidateAll() if
+// the designated constraints aren't met.
+type SetJobRunWatermarksResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SetJobRunWatermarksResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SetJobRunWatermarksResponseMultiError) AllErrors() []error { return m }
+
+// SetJobRunWatermarksResponseValidationError is the validation error returned
+// by SetJobRunWatermarksResponse.Validate if the designated constraints
+// aren't met.
+type SetJobRunWatermarksResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SetJobRunWatermarksResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SetJobRunWatermarksResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SetJobRunWatermarksResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SetJobRunWatermarksResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SetJobRunWatermarksResponseValidationError) ErrorName() string {
+	return "SetJobRunWatermarksResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SetJobRunWatermarksResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSetJobRunWatermarksResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SetJobRunWatermarksResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SetJobRunWatermarksResponseValidationError{}
+
+// Validate checks the field values on JobWatermarkTable with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *JobWatermarkTable) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on JobSourceSqlSubetSchemas with the
-// rules defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on JobWatermarkTable with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// JobSourceSqlSubetSchemasMultiError, or nil if none found.
-func (m *JobSourceSqlSubetSchemas) ValidateAll() error {
+// JobWatermarkTableMultiError, or nil if none found.
+func (m *JobWatermarkTable) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *JobSourceSqlSubetSchemas) validate(all bool) error {
+func (m *JobWatermarkTable) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	switch v := m.Schemas.(type) {
-	case *JobSourceSqlSubetSchemas_PostgresSubset:
-		if v == nil {
-			err := JobSourceSqlSubetSchemasValidationError{
-				field:  "Schemas",
-				reason: "oneof value cannot be a typed-nil",
-			}
-			if !all {
-				return err
-			}
-			errors = append(errors, err)
-		}
-
-		if all {
-			switch v := interface{}(m.GetPostgresSubset()).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, JobSourceSqlSubetSchemasValidationError{
-						field:  "PostgresSubset",
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, JobSourceSqlSubetSchemasValidationError{
-						field:  "PostgresSubset",
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(m.GetPostgresSubset()).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return JobSourceSqlSubetSchemasValidationError{
-					field:  "PostgresSubset",
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
-
-	case *JobSourceSqlSubetSchemas_MysqlSubset:
-		if v == nil {
-			err := JobSourceSqlSubetSchemasValidationError{
-				field:  "Schemas",
-				reason: "oneof value cannot be a typed-nil",
-			}
-			if !all {
-				return err
-			}
-			errors = append(errors, err)
-		}
-
-		if all {
-			switch v := interface{}(m.GetMysqlSubset()).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, JobSourceSqlSubetSchemasValidationError{
-						field:  "MysqlSubset",
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, JobSourceSqlSubetSchemasValidationError{
-						field:  "MysqlSubset",
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(m.GetMysqlSubset()).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return JobSourceSqlSubetSchemasValidationError{
-					field:  "MysqlSubset",
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
+	// no validation rules for Schema
 
-	default:
-		_ = v // ensures v is used
-	}
+	// no validation rules for Table
 
 	if len(errors) > 0 {
-		return JobSourceSqlSubetSchemasMultiError(errors)
+		return JobWatermarkTableMultiError(errors)
 	}
 
 	return nil
 }
 
-// JobSourceSqlSubetSchemasMultiError is an error wrapping multiple validation
-// errors returned by JobSourceSqlSubetSchemas.ValidateAll() if the designated
-// constraints aren't met.
-type JobSourceSqlSubetSchemasMultiError []error
+// JobWatermarkTableMultiError is an error wrapping multiple validation errors
+// returned by JobWatermarkTable.ValidateAll() if the designated constraints
+// aren't met.
+type JobWatermarkTableMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m JobSourceSqlSubetSchemasMultiError) Error() string {
+func (m JobWatermarkTableMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -5440,11 +12492,11 @@ func (m JobSourceSqlSubetSchemasMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m JobSourceSqlSubetSchemasMultiError) AllErrors() []error { return m }
+func (m JobWatermarkTableMultiError) AllErrors() []error { return m }
 
-// JobSourceSqlSubetSchemasValidationError is the validation error returned by
-// JobSourceSqlSubetSchemas.Validate if the designated constraints aren't met.
-type JobSourceSqlSubetSchemasValidationError struct {
+// JobWatermarkTableValidationError is the validation error returned by
+// JobWatermarkTable.Validate if the designated constraints aren't met.
+type JobWatermarkTableValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -5452,24 +12504,24 @@ type JobSourceSqlSubetSchemasValidationError struct {
 }
 
 // Field function returns field value.
-func (e JobSourceSqlSubetSchemasValidationError) Field() string { return e.field }
+func (e JobWatermarkTableValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e JobSourceSqlSubetSchemasValidationError) Reason() string { return e.reason }
+func (e JobWatermarkTableValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e JobSourceSqlSubetSchemasValidationError) Cause() error { return e.cause }
+func (e JobWatermarkTableValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e JobSourceSqlSubetSchemasValidationError) Key() bool { return e.key }
+func (e JobWatermarkTableValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e JobSourceSqlSubetSchemasValidationError) ErrorName() string {
-	return "JobSourceSqlSubetSchemasValidationError"
+func (e JobWatermarkTableValidationError) ErrorName() string {
+	return "JobWatermarkTableValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e JobSourceSqlSubetSchemasValidationError) Error() string {
+func (e JobWatermarkTableValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -5481,14 +12533,14 @@ func (e JobSourceSqlSubetSchemasValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sJobSourceSqlSubetSchemas.%s: %s%s",
+		"invalid %sJobWatermarkTable.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = JobSourceSqlSubetSchemasValidationError{}
+var _ error = JobWatermarkTableValidationError{}
 
 var _ interface {
 	Field() string
@@ -5496,80 +12548,80 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = JobSourceSqlSubetSchemasValidationError{}
+} = JobWatermarkTableValidationError{}
 
-// Validate checks the field values on SetJobSourceSqlConnectionSubsetsRequest
-// with the rules defined in the proto definition for this message. If any
-// rules are violated, the first error encountered is returned, or nil if
-// there are no violations.
-func (m *SetJobSourceSqlConnectionSubsetsRequest) Validate() error {
+// Validate checks the field values on ResetJobWatermarksRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ResetJobWatermarksRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on
-// SetJobSourceSqlConnectionSubsetsRequest with the rules defined in the proto
-// definition for this message. If any rules are violated, the result is a
-// list of violation errors wrapped in
-// SetJobSourceSqlConnectionSubsetsRequestMultiError, or nil if none found.
-func (m *SetJobSourceSqlConnectionSubsetsRequest) ValidateAll() error {
+// ValidateAll checks the field values on ResetJobWatermarksRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ResetJobWatermarksRequestMultiError, or nil if none found.
+func (m *ResetJobWatermarksRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *SetJobSourceSqlConnectionSubsetsRequest) validate(all bool) error {
+func (m *ResetJobWatermarksRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Id
+	// no validation rules for JobId
 
-	if all {
-		switch v := interface{}(m.GetSchemas()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, SetJobSourceSqlConnectionSubsetsRequestValidationError{
-					field:  "Schemas",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
+	for idx, item := range m.GetTables() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ResetJobWatermarksRequestValidationError{
+						field:  fmt.Sprintf("Tables[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ResetJobWatermarksRequestValidationError{
+						field:  fmt.Sprintf("Tables[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
 			}
-		case interface{ Validate() error }:
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				errors = append(errors, SetJobSourceSqlConnectionSubsetsRequestValidationError{
-					field:  "Schemas",
+				return ResetJobWatermarksRequestValidationError{
+					field:  fmt.Sprintf("Tables[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetSchemas()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return SetJobSourceSqlConnectionSubsetsRequestValidationError{
-				field:  "Schemas",
-				reason: "embedded message failed validation",
-				cause:  err,
+				}
 			}
 		}
-	}
 
-	// no validation rules for SubsetByForeignKeyConstraints
+	}
 
 	if len(errors) > 0 {
-		return SetJobSourceSqlConnectionSubsetsRequestMultiError(errors)
+		return ResetJobWatermarksRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// SetJobSourceSqlConnectionSubsetsRequestMultiError is an error wrapping
-// multiple validation errors returned by
-// SetJobSourceSqlConnectionSubsetsRequest.ValidateAll() if the designated
-// constraints aren't met.
-type SetJobSourceSqlConnectionSubsetsRequestMultiError []error
+// ResetJobWatermarksRequestMultiError is an error wrapping multiple validation
+// errors returned by ResetJobWatermarksRequest.ValidateAll() if the
+// designated constraints aren't met.
+type ResetJobWatermarksRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m SetJobSourceSqlConnectionSubsetsRequestMultiError) Error() string {
+func (m ResetJobWatermarksRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -5578,12 +12630,11 @@ func (m SetJobSourceSqlConnectionSubsetsRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m SetJobSourceSqlConnectionSubsetsRequestMultiError) AllErrors() []error { return m }
+func (m ResetJobWatermarksRequestMultiError) AllErrors() []error { return m }
 
-// SetJobSourceSqlConnectionSubsetsRequestValidationError is the validation
-// error returned by SetJobSourceSqlConnectionSubsetsRequest.Validate if the
-// designated constraints aren't met.
-type SetJobSourceSqlConnectionSubsetsRequestValidationError struct {
+// ResetJobWatermarksRequestValidationError is the validation error returned by
+// ResetJobWatermarksRequest.Validate if the designated constraints aren't met.
+type ResetJobWatermarksRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -5591,24 +12642,24 @@ type SetJobSourceSqlConnectionSubsetsRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e SetJobSourceSqlConnectionSubsetsRequestValidationError) Field() string { return e.field }
+func (e ResetJobWatermarksRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e SetJobSourceSqlConnectionSubsetsRequestValidationError) Reason() string { return e.reason }
+func (e ResetJobWatermarksRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e SetJobSourceSqlConnectionSubsetsRequestValidationError) Cause() error { return e.cause }
+func (e ResetJobWatermarksRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e SetJobSourceSqlConnectionSubsetsRequestValidationError) Key() bool { return e.key }
+func (e ResetJobWatermarksRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e SetJobSourceSqlConnectionSubsetsRequestValidationError) ErrorName() string {
-	return "SetJobSourceSqlConnectionSubsetsRequestValidationError"
+func (e ResetJobWatermarksRequestValidationError) ErrorName() string {
+	return "ResetJobWatermarksRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e SetJobSourceSqlConnectionSubsetsRequestValidationError) Error() string {
+func (e ResetJobWatermarksRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -5620,14 +12671,14 @@ func (e SetJobSourceSqlConnectionSubsetsRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sSetJobSourceSqlConnectionSubsetsRequest.%s: %s%s",
+		"invalid %sResetJobWatermarksRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = SetJobSourceSqlConnectionSubsetsRequestValidationError{}
+var _ error = ResetJobWatermarksRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -5635,76 +12686,44 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = SetJobSourceSqlConnectionSubsetsRequestValidationError{}
+} = ResetJobWatermarksRequestValidationError{}
 
-// Validate checks the field values on SetJobSourceSqlConnectionSubsetsResponse
-// with the rules defined in the proto definition for this message. If any
-// rules are violated, the first error encountered is returned, or nil if
-// there are no violations.
-func (m *SetJobSourceSqlConnectionSubsetsResponse) Validate() error {
+// Validate checks the field values on ResetJobWatermarksResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ResetJobWatermarksResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on
-// SetJobSourceSqlConnectionSubsetsResponse with the rules defined in the
-// proto definition for this message. If any rules are violated, the result is
-// a list of violation errors wrapped in
-// SetJobSourceSqlConnectionSubsetsResponseMultiError, or nil if none found.
-func (m *SetJobSourceSqlConnectionSubsetsResponse) ValidateAll() error {
-	return m.validate(true)
-}
-
-func (m *SetJobSourceSqlConnectionSubsetsResponse) validate(all bool) error {
-	if m == nil {
-		return nil
-	}
-
-	var errors []error
-
-	if all {
-		switch v := interface{}(m.GetJob()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, SetJobSourceSqlConnectionSubsetsResponseValidationError{
-					field:  "Job",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, SetJobSourceSqlConnectionSubsetsResponseValidationError{
-					field:  "Job",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetJob()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return SetJobSourceSqlConnectionSubsetsResponseValidationError{
-				field:  "Job",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
+// ValidateAll checks the field values on ResetJobWatermarksResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ResetJobWatermarksResponseMultiError, or nil if none found.
+func (m *ResetJobWatermarksResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ResetJobWatermarksResponse) validate(all bool) error {
+	if m == nil {
+		return nil
 	}
 
+	var errors []error
+
 	if len(errors) > 0 {
-		return SetJobSourceSqlConnectionSubsetsResponseMultiError(errors)
+		return ResetJobWatermarksResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// SetJobSourceSqlConnectionSubsetsResponseMultiError is an error wrapping
-// multiple validation errors returned by
-// SetJobSourceSqlConnectionSubsetsResponse.ValidateAll() if the designated
-// constraints aren't met.
-type SetJobSourceSqlConnectionSubsetsResponseMultiError []error
+// ResetJobWatermarksResponseMultiError is an error wrapping multiple
+// validation errors returned by ResetJobWatermarksResponse.ValidateAll() if
+// the designated constraints aren't met.
+type ResetJobWatermarksResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m SetJobSourceSqlConnectionSubsetsResponseMultiError) Error() string {
+func (m ResetJobWatermarksResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -5713,12 +12732,11 @@ func (m SetJobSourceSqlConnectionSubsetsResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m SetJobSourceSqlConnectionSubsetsResponseMultiError) AllErrors() []error { return m }
+func (m ResetJobWatermarksResponseMultiError) AllErrors() []error { return m }
 
-// SetJobSourceSqlConnectionSubsetsResponseValidationError is the validation
-// error returned by SetJobSourceSqlConnectionSubsetsResponse.Validate if the
-// designated constraints aren't met.
-type SetJobSourceSqlConnectionSubsetsResponseValidationError struct {
+// ResetJobWatermarksResponseValidationError is the validation error returned
+// by ResetJobWatermarksResponse.Validate if the designated constraints aren't met.
+type ResetJobWatermarksResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -5726,24 +12744,24 @@ type SetJobSourceSqlConnectionSubsetsResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e SetJobSourceSqlConnectionSubsetsResponseValidationError) Field() string { return e.field }
+func (e ResetJobWatermarksResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e SetJobSourceSqlConnectionSubsetsResponseValidationError) Reason() string { return e.reason }
+func (e ResetJobWatermarksResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e SetJobSourceSqlConnectionSubsetsResponseValidationError) Cause() error { return e.cause }
+func (e ResetJobWatermarksResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e SetJobSourceSqlConnectionSubsetsResponseValidationError) Key() bool { return e.key }
+func (e ResetJobWatermarksResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e SetJobSourceSqlConnectionSubsetsResponseValidationError) ErrorName() string {
-	return "SetJobSourceSqlConnectionSubsetsResponseValidationError"
+func (e ResetJobWatermarksResponseValidationError) ErrorName() string {
+	return "ResetJobWatermarksResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e SetJobSourceSqlConnectionSubsetsResponseValidationError) Error() string {
+func (e ResetJobWatermarksResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -5755,14 +12773,14 @@ func (e SetJobSourceSqlConnectionSubsetsResponseValidationError) Error() string
 	}
 
 	return fmt.Sprintf(
-		"invalid %sSetJobSourceSqlConnectionSubsetsResponse.%s: %s%s",
+		"invalid %sResetJobWatermarksResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = SetJobSourceSqlConnectionSubsetsResponseValidationError{}
+var _ error = ResetJobWatermarksResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -5770,25 +12788,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = SetJobSourceSqlConnectionSubsetsResponseValidationError{}
+} = ResetJobWatermarksResponseValidationError{}
 
-// Validate checks the field values on UpdateJobDestinationConnectionRequest
-// with the rules defined in the proto definition for this message. If any
-// rules are violated, the first error encountered is returned, or nil if
-// there are no violations.
-func (m *UpdateJobDestinationConnectionRequest) Validate() error {
+// Validate checks the field values on JobRunValidationReport with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *JobRunValidationReport) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on UpdateJobDestinationConnectionRequest
-// with the rules defined in the proto definition for this message. If any
-// rules are violated, the result is a list of violation errors wrapped in
-// UpdateJobDestinationConnectionRequestMultiError, or nil if none found.
-func (m *UpdateJobDestinationConnectionRequest) ValidateAll() error {
+// ValidateAll checks the field values on JobRunValidationReport with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// JobRunValidationReportMultiError, or nil if none found.
+func (m *JobRunValidationReport) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *UpdateJobDestinationConnectionRequest) validate(all bool) error {
+func (m *JobRunValidationReport) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -5797,54 +12814,87 @@ func (m *UpdateJobDestinationConnectionRequest) validate(all bool) error {
 
 	// no validation rules for JobId
 
-	// no validation rules for ConnectionId
+	// no validation rules for JobRunId
+
+	// no validation rules for Passed
+
+	for idx, item := range m.GetTables() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, JobRunValidationReportValidationError{
+						field:  fmt.Sprintf("Tables[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, JobRunValidationReportValidationError{
+						field:  fmt.Sprintf("Tables[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return JobRunValidationReportValidationError{
+					field:  fmt.Sprintf("Tables[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
 
 	if all {
-		switch v := interface{}(m.GetOptions()).(type) {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, UpdateJobDestinationConnectionRequestValidationError{
-					field:  "Options",
+				errors = append(errors, JobRunValidationReportValidationError{
+					field:  "CreatedAt",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, UpdateJobDestinationConnectionRequestValidationError{
-					field:  "Options",
+				errors = append(errors, JobRunValidationReportValidationError{
+					field:  "CreatedAt",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetOptions()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return UpdateJobDestinationConnectionRequestValidationError{
-				field:  "Options",
+			return JobRunValidationReportValidationError{
+				field:  "CreatedAt",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
 		}
 	}
 
-	// no validation rules for DestinationId
-
 	if len(errors) > 0 {
-		return UpdateJobDestinationConnectionRequestMultiError(errors)
+		return JobRunValidationReportMultiError(errors)
 	}
 
 	return nil
 }
 
-// UpdateJobDestinationConnectionRequestMultiError is an error wrapping
-// multiple validation errors returned by
-// UpdateJobDestinationConnectionRequest.ValidateAll() if the designated
+// JobRunValidationReportMultiError is an error wrapping multiple validation
+// errors returned by JobRunValidationReport.ValidateAll() if the designated
 // constraints aren't met.
-type UpdateJobDestinationConnectionRequestMultiError []error
+type JobRunValidationReportMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m UpdateJobDestinationConnectionRequestMultiError) Error() string {
+func (m JobRunValidationReportMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -5853,12 +12903,11 @@ func (m UpdateJobDestinationConnectionRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m UpdateJobDestinationConnectionRequestMultiError) AllErrors() []error { return m }
+func (m JobRunValidationReportMultiError) AllErrors() []error { return m }
 
-// UpdateJobDestinationConnectionRequestValidationError is the validation error
-// returned by UpdateJobDestinationConnectionRequest.Validate if the
-// designated constraints aren't met.
-type UpdateJobDestinationConnectionRequestValidationError struct {
+// JobRunValidationReportValidationError is the validation error returned by
+// JobRunValidationReport.Validate if the designated constraints aren't met.
+type JobRunValidationReportValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -5866,24 +12915,24 @@ type UpdateJobDestinationConnectionRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e UpdateJobDestinationConnectionRequestValidationError) Field() string { return e.field }
+func (e JobRunValidationReportValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e UpdateJobDestinationConnectionRequestValidationError) Reason() string { return e.reason }
+func (e JobRunValidationReportValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e UpdateJobDestinationConnectionRequestValidationError) Cause() error { return e.cause }
+func (e JobRunValidationReportValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e UpdateJobDestinationConnectionRequestValidationError) Key() bool { return e.key }
+func (e JobRunValidationReportValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e UpdateJobDestinationConnectionRequestValidationError) ErrorName() string {
-	return "UpdateJobDestinationConnectionRequestValidationError"
+func (e JobRunValidationReportValidationError) ErrorName() string {
+	return "JobRunValidationReportValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e UpdateJobDestinationConnectionRequestValidationError) Error() string {
+func (e JobRunValidationReportValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -5895,14 +12944,14 @@ func (e UpdateJobDestinationConnectionRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sUpdateJobDestinationConnectionRequest.%s: %s%s",
+		"invalid %sJobRunValidationReport.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = UpdateJobDestinationConnectionRequestValidationError{}
+var _ error = JobRunValidationReportValidationError{}
 
 var _ interface {
 	Field() string
@@ -5910,76 +12959,128 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = UpdateJobDestinationConnectionRequestValidationError{}
+} = JobRunValidationReportValidationError{}
 
-// Validate checks the field values on UpdateJobDestinationConnectionResponse
-// with the rules defined in the proto definition for this message. If any
-// rules are violated, the first error encountered is returned, or nil if
-// there are no violations.
-func (m *UpdateJobDestinationConnectionResponse) Validate() error {
+// Validate checks the field values on JobRunTableValidation with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *JobRunTableValidation) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on
-// UpdateJobDestinationConnectionResponse with the rules defined in the proto
-// definition for this message. If any rules are violated, the result is a
-// list of violation errors wrapped in
-// UpdateJobDestinationConnectionResponseMultiError, or nil if none found.
-func (m *UpdateJobDestinationConnectionResponse) ValidateAll() error {
+// ValidateAll checks the field values on JobRunTableValidation with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// JobRunTableValidationMultiError, or nil if none found.
+func (m *JobRunTableValidation) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *UpdateJobDestinationConnectionResponse) validate(all bool) error {
+func (m *JobRunTableValidation) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if all {
-		switch v := interface{}(m.GetJob()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, UpdateJobDestinationConnectionResponseValidationError{
-					field:  "Job",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
+	// no validation rules for DestinationConnectionId
+
+	// no validation rules for Schema
+
+	// no validation rules for Table
+
+	// no validation rules for SourceRowCount
+
+	// no validation rules for DestinationRowCount
+
+	for idx, item := range m.GetForeignKeys() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, JobRunTableValidationValidationError{
+						field:  fmt.Sprintf("ForeignKeys[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, JobRunTableValidationValidationError{
+						field:  fmt.Sprintf("ForeignKeys[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
 			}
-		case interface{ Validate() error }:
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				errors = append(errors, UpdateJobDestinationConnectionResponseValidationError{
-					field:  "Job",
+				return JobRunTableValidationValidationError{
+					field:  fmt.Sprintf("ForeignKeys[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
-				})
+				}
 			}
 		}
-	} else if v, ok := interface{}(m.GetJob()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return UpdateJobDestinationConnectionResponseValidationError{
-				field:  "Job",
-				reason: "embedded message failed validation",
-				cause:  err,
+
+	}
+
+	for idx, item := range m.GetColumns() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, JobRunTableValidationValidationError{
+						field:  fmt.Sprintf("Columns[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, JobRunTableValidationValidationError{
+						field:  fmt.Sprintf("Columns[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return JobRunTableValidationValidationError{
+					field:  fmt.Sprintf("Columns[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
 			}
 		}
+
+	}
+
+	// no validation rules for Passed
+
+	if m.ColumnsSkippedReason != nil {
+		// no validation rules for ColumnsSkippedReason
 	}
 
 	if len(errors) > 0 {
-		return UpdateJobDestinationConnectionResponseMultiError(errors)
+		return JobRunTableValidationMultiError(errors)
 	}
 
 	return nil
 }
 
-// UpdateJobDestinationConnectionResponseMultiError is an error wrapping
-// multiple validation errors returned by
-// UpdateJobDestinationConnectionResponse.ValidateAll() if the designated
+// JobRunTableValidationMultiError is an error wrapping multiple validation
+// errors returned by JobRunTableValidation.ValidateAll() if the designated
 // constraints aren't met.
-type UpdateJobDestinationConnectionResponseMultiError []error
+type JobRunTableValidationMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m UpdateJobDestinationConnectionResponseMultiError) Error() string {
+func (m JobRunTableValidationMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -5988,12 +13089,11 @@ func (m UpdateJobDestinationConnectionResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m UpdateJobDestinationConnectionResponseMultiError) AllErrors() []error { return m }
+func (m JobRunTableValidationMultiError) AllErrors() []error { return m }
 
-// UpdateJobDestinationConnectionResponseValidationError is the validation
-// error returned by UpdateJobDestinationConnectionResponse.Validate if the
-// designated constraints aren't met.
-type UpdateJobDestinationConnectionResponseValidationError struct {
+// JobRunTableValidationValidationError is the validation error returned by
+// JobRunTableValidation.Validate if the designated constraints aren't met.
+type JobRunTableValidationValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -6001,24 +13101,24 @@ type UpdateJobDestinationConnectionResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e UpdateJobDestinationConnectionResponseValidationError) Field() string { return e.field }
+func (e JobRunTableValidationValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e UpdateJobDestinationConnectionResponseValidationError) Reason() string { return e.reason }
+func (e JobRunTableValidationValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e UpdateJobDestinationConnectionResponseValidationError) Cause() error { return e.cause }
+func (e JobRunTableValidationValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e UpdateJobDestinationConnectionResponseValidationError) Key() bool { return e.key }
+func (e JobRunTableValidationValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e UpdateJobDestinationConnectionResponseValidationError) ErrorName() string {
-	return "UpdateJobDestinationConnectionResponseValidationError"
+func (e JobRunTableValidationValidationError) ErrorName() string {
+	return "JobRunTableValidationValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e UpdateJobDestinationConnectionResponseValidationError) Error() string {
+func (e JobRunTableValidationValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -6030,14 +13130,14 @@ func (e UpdateJobDestinationConnectionResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sUpdateJobDestinationConnectionResponse.%s: %s%s",
+		"invalid %sJobRunTableValidation.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = UpdateJobDestinationConnectionResponseValidationError{}
+var _ error = JobRunTableValidationValidationError{}
 
 var _ interface {
 	Field() string
@@ -6045,48 +13145,54 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = UpdateJobDestinationConnectionResponseValidationError{}
+} = JobRunTableValidationValidationError{}
 
-// Validate checks the field values on DeleteJobDestinationConnectionRequest
-// with the rules defined in the proto definition for this message. If any
-// rules are violated, the first error encountered is returned, or nil if
-// there are no violations.
-func (m *DeleteJobDestinationConnectionRequest) Validate() error {
+// Validate checks the field values on JobRunForeignKeyValidation with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *JobRunForeignKeyValidation) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on DeleteJobDestinationConnectionRequest
-// with the rules defined in the proto definition for this message. If any
-// rules are violated, the result is a list of violation errors wrapped in
-// DeleteJobDestinationConnectionRequestMultiError, or nil if none found.
-func (m *DeleteJobDestinationConnectionRequest) ValidateAll() error {
+// ValidateAll checks the field values on JobRunForeignKeyValidation with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// JobRunForeignKeyValidationMultiError, or nil if none found.
+func (m *JobRunForeignKeyValidation) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *DeleteJobDestinationConnectionRequest) validate(all bool) error {
+func (m *JobRunForeignKeyValidation) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for DestinationId
+	// no validation rules for Column
+
+	// no validation rules for ForeignSchema
+
+	// no validation rules for ForeignTable
+
+	// no validation rules for ForeignColumn
+
+	// no validation rules for OrphanedRowCount
 
 	if len(errors) > 0 {
-		return DeleteJobDestinationConnectionRequestMultiError(errors)
+		return JobRunForeignKeyValidationMultiError(errors)
 	}
 
 	return nil
 }
 
-// DeleteJobDestinationConnectionRequestMultiError is an error wrapping
-// multiple validation errors returned by
-// DeleteJobDestinationConnectionRequest.ValidateAll() if the designated
-// constraints aren't met.
-type DeleteJobDestinationConnectionRequestMultiError []error
+// JobRunForeignKeyValidationMultiError is an error wrapping multiple
+// validation errors returned by JobRunForeignKeyValidation.ValidateAll() if
+// the designated constraints aren't met.
+type JobRunForeignKeyValidationMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m DeleteJobDestinationConnectionRequestMultiError) Error() string {
+func (m JobRunForeignKeyValidationMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -6095,12 +13201,11 @@ func (m DeleteJobDestinationConnectionRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m DeleteJobDestinationConnectionRequestMultiError) AllErrors() []error { return m }
+func (m JobRunForeignKeyValidationMultiError) AllErrors() []error { return m }
 
-// DeleteJobDestinationConnectionRequestValidationError is the validation error
-// returned by DeleteJobDestinationConnectionRequest.Validate if the
-// designated constraints aren't met.
-type DeleteJobDestinationConnectionRequestValidationError struct {
+// JobRunForeignKeyValidationValidationError is the validation error returned
+// by JobRunForeignKeyValidation.Validate if the designated constraints aren't met.
+type JobRunForeignKeyValidationValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -6108,24 +13213,24 @@ type DeleteJobDestinationConnectionRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e DeleteJobDestinationConnectionRequestValidationError) Field() string { return e.field }
+func (e JobRunForeignKeyValidationValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e DeleteJobDestinationConnectionRequestValidationError) Reason() string { return e.reason }
+func (e JobRunForeignKeyValidationValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e DeleteJobDestinationConnectionRequestValidationError) Cause() error { return e.cause }
+func (e JobRunForeignKeyValidationValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e DeleteJobDestinationConnectionRequestValidationError) Key() bool { return e.key }
+func (e JobRunForeignKeyValidationValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e DeleteJobDestinationConnectionRequestValidationError) ErrorName() string {
-	return "DeleteJobDestinationConnectionRequestValidationError"
+func (e JobRunForeignKeyValidationValidationError) ErrorName() string {
+	return "JobRunForeignKeyValidationValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e DeleteJobDestinationConnectionRequestValidationError) Error() string {
+func (e JobRunForeignKeyValidationValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -6137,14 +13242,14 @@ func (e DeleteJobDestinationConnectionRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sDeleteJobDestinationConnectionRequest.%s: %s%s",
+		"invalid %sJobRunForeignKeyValidation.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = DeleteJobDestinationConnectionRequestValidationError{}
+var _ error = JobRunForeignKeyValidationValidationError{}
 
 var _ interface {
 	Field() string
@@ -6152,47 +13257,52 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = DeleteJobDestinationConnectionRequestValidationError{}
+} = JobRunForeignKeyValidationValidationError{}
 
-// Validate checks the field values on DeleteJobDestinationConnectionResponse
-// with the rules defined in the proto definition for this message. If any
-// rules are violated, the first error encountered is returned, or nil if
-// there are no violations.
-func (m *DeleteJobDestinationConnectionResponse) Validate() error {
+// Validate checks the field values on JobRunColumnValidation with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *JobRunColumnValidation) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on
-// DeleteJobDestinationConnectionResponse with the rules defined in the proto
-// definition for this message. If any rules are violated, the result is a
-// list of violation errors wrapped in
-// DeleteJobDestinationConnectionResponseMultiError, or nil if none found.
-func (m *DeleteJobDestinationConnectionResponse) ValidateAll() error {
+// ValidateAll checks the field values on JobRunColumnValidation with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// JobRunColumnValidationMultiError, or nil if none found.
+func (m *JobRunColumnValidation) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *DeleteJobDestinationConnectionResponse) validate(all bool) error {
+func (m *JobRunColumnValidation) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	// no validation rules for Column
+
+	// no validation rules for TransformerSource
+
+	// no validation rules for SampledRowCount
+
+	// no validation rules for UnchangedRowCount
+
 	if len(errors) > 0 {
-		return DeleteJobDestinationConnectionResponseMultiError(errors)
+		return JobRunColumnValidationMultiError(errors)
 	}
 
 	return nil
 }
 
-// DeleteJobDestinationConnectionResponseMultiError is an error wrapping
-// multiple validation errors returned by
-// DeleteJobDestinationConnectionResponse.ValidateAll() if the designated
+// JobRunColumnValidationMultiError is an error wrapping multiple validation
+// errors returned by JobRunColumnValidation.ValidateAll() if the designated
 // constraints aren't met.
-type DeleteJobDestinationConnectionResponseMultiError []error
+type JobRunColumnValidationMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m DeleteJobDestinationConnectionResponseMultiError) Error() string {
+func (m JobRunColumnValidationMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -6201,12 +13311,11 @@ func (m DeleteJobDestinationConnectionResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m DeleteJobDestinationConnectionResponseMultiError) AllErrors() []error { return m }
+func (m JobRunColumnValidationMultiError) AllErrors() []error { return m }
 
-// DeleteJobDestinationConnectionResponseValidationError is the validation
-// error returned by DeleteJobDestinationConnectionResponse.Validate if the
-// designated constraints aren't met.
-type DeleteJobDestinationConnectionResponseValidationError struct {
+// JobRunColumnValidationValidationError is the validation error returned by
+// JobRunColumnValidation.Validate if the designated constraints aren't met.
+type JobRunColumnValidationValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -6214,24 +13323,24 @@ type DeleteJobDestinationConnectionResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e DeleteJobDestinationConnectionResponseValidationError) Field() string { return e.field }
+func (e JobRunColumnValidationValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e DeleteJobDestinationConnectionResponseValidationError) Reason() string { return e.reason }
+func (e JobRunColumnValidationValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e DeleteJobDestinationConnectionResponseValidationError) Cause() error { return e.cause }
+func (e JobRunColumnValidationValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e DeleteJobDestinationConnectionResponseValidationError) Key() bool { return e.key }
+func (e JobRunColumnValidationValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e DeleteJobDestinationConnectionResponseValidationError) ErrorName() string {
-	return "DeleteJobDestinationConnectionResponseValidationError"
+func (e JobRunColumnValidationValidationError) ErrorName() string {
+	return "JobRunColumnValidationValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e DeleteJobDestinationConnectionResponseValidationError) Error() string {
+func (e JobRunColumnValidationValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -6243,14 +13352,14 @@ func (e DeleteJobDestinationConnectionResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sDeleteJobDestinationConnectionResponse.%s: %s%s",
+		"invalid %sJobRunColumnValidation.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = DeleteJobDestinationConnectionResponseValidationError{}
+var _ error = JobRunColumnValidationValidationError{}
 
 var _ interface {
 	Field() string
@@ -6258,26 +13367,25 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = DeleteJobDestinationConnectionResponseValidationError{}
+} = JobRunColumnValidationValidationError{}
 
-// Validate checks the field values on CreateJobDestinationConnectionsRequest
-// with the rules defined in the proto definition for this message. If any
-// rules are violated, the first error encountered is returned, or nil if
-// there are no violations.
-func (m *CreateJobDestinationConnectionsRequest) Validate() error {
+// Validate checks the field values on SetJobRunValidationReportRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *SetJobRunValidationReportRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on
-// CreateJobDestinationConnectionsRequest with the rules defined in the proto
-// definition for this message. If any rules are violated, the result is a
-// list of violation errors wrapped in
-// CreateJobDestinationConnectionsRequestMultiError, or nil if none found.
-func (m *CreateJobDestinationConnectionsRequest) ValidateAll() error {
+// ValidateAll checks the field values on SetJobRunValidationReportRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// SetJobRunValidationReportRequestMultiError, or nil if none found.
+func (m *SetJobRunValidationReportRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *CreateJobDestinationConnectionsRequest) validate(all bool) error {
+func (m *SetJobRunValidationReportRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -6286,23 +13394,25 @@ func (m *CreateJobDestinationConnectionsRequest) validate(all bool) error {
 
 	// no validation rules for JobId
 
-	for idx, item := range m.GetDestinations() {
+	// no validation rules for JobRunId
+
+	for idx, item := range m.GetTables() {
 		_, _ = idx, item
 
 		if all {
 			switch v := interface{}(item).(type) {
 			case interface{ ValidateAll() error }:
 				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, CreateJobDestinationConnectionsRequestValidationError{
-						field:  fmt.Sprintf("Destinations[%v]", idx),
+					errors = append(errors, SetJobRunValidationReportRequestValidationError{
+						field:  fmt.Sprintf("Tables[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			case interface{ Validate() error }:
 				if err := v.Validate(); err != nil {
-					errors = append(errors, CreateJobDestinationConnectionsRequestValidationError{
-						field:  fmt.Sprintf("Destinations[%v]", idx),
+					errors = append(errors, SetJobRunValidationReportRequestValidationError{
+						field:  fmt.Sprintf("Tables[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
@@ -6310,8 +13420,8 @@ func (m *CreateJobDestinationConnectionsRequest) validate(all bool) error {
 			}
 		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				return CreateJobDestinationConnectionsRequestValidationError{
-					field:  fmt.Sprintf("Destinations[%v]", idx),
+				return SetJobRunValidationReportRequestValidationError{
+					field:  fmt.Sprintf("Tables[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
 				}
@@ -6321,20 +13431,20 @@ func (m *CreateJobDestinationConnectionsRequest) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return CreateJobDestinationConnectionsRequestMultiError(errors)
+		return SetJobRunValidationReportRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// CreateJobDestinationConnectionsRequestMultiError is an error wrapping
-// multiple validation errors returned by
-// CreateJobDestinationConnectionsRequest.ValidateAll() if the designated
+// SetJobRunValidationReportRequestMultiError is an error wrapping multiple
+// validation errors returned by
+// SetJobRunValidationReportRequest.ValidateAll() if the designated
 // constraints aren't met.
-type CreateJobDestinationConnectionsRequestMultiError []error
+type SetJobRunValidationReportRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m CreateJobDestinationConnectionsRequestMultiError) Error() string {
+func (m SetJobRunValidationReportRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -6343,12 +13453,12 @@ func (m CreateJobDestinationConnectionsRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m CreateJobDestinationConnectionsRequestMultiError) AllErrors() []error { return m }
+func (m SetJobRunValidationReportRequestMultiError) AllErrors() []error { return m }
 
-// CreateJobDestinationConnectionsRequestValidationError is the validation
-// error returned by CreateJobDestinationConnectionsRequest.Validate if the
-// designated constraints aren't met.
-type CreateJobDestinationConnectionsRequestValidationError struct {
+// SetJobRunValidationReportRequestValidationError is the validation error
+// returned by SetJobRunValidationReportRequest.Validate if the designated
+// constraints aren't met.
+type SetJobRunValidationReportRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -6356,24 +13466,24 @@ type CreateJobDestinationConnectionsRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e CreateJobDestinationConnectionsRequestValidationError) Field() string { return e.field }
+func (e SetJobRunValidationReportRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CreateJobDestinationConnectionsRequestValidationError) Reason() string { return e.reason }
+func (e SetJobRunValidationReportRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CreateJobDestinationConnectionsRequestValidationError) Cause() error { return e.cause }
+func (e SetJobRunValidationReportRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CreateJobDestinationConnectionsRequestValidationError) Key() bool { return e.key }
+func (e SetJobRunValidationReportRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CreateJobDestinationConnectionsRequestValidationError) ErrorName() string {
-	return "CreateJobDestinationConnectionsRequestValidationError"
+func (e SetJobRunValidationReportRequestValidationError) ErrorName() string {
+	return "SetJobRunValidationReportRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e CreateJobDestinationConnectionsRequestValidationError) Error() string {
+func (e SetJobRunValidationReportRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -6385,14 +13495,14 @@ func (e CreateJobDestinationConnectionsRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCreateJobDestinationConnectionsRequest.%s: %s%s",
+		"invalid %sSetJobRunValidationReportRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CreateJobDestinationConnectionsRequestValidationError{}
+var _ error = SetJobRunValidationReportRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -6400,76 +13510,155 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CreateJobDestinationConnectionsRequestValidationError{}
+} = SetJobRunValidationReportRequestValidationError{}
 
-// Validate checks the field values on CreateJobDestinationConnectionsResponse
-// with the rules defined in the proto definition for this message. If any
-// rules are violated, the first error encountered is returned, or nil if
-// there are no violations.
-func (m *CreateJobDestinationConnectionsResponse) Validate() error {
+// Validate checks the field values on SetJobRunValidationReportResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *SetJobRunValidationReportResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on
-// CreateJobDestinationConnectionsResponse with the rules defined in the proto
-// definition for this message. If any rules are violated, the result is a
-// list of violation errors wrapped in
-// CreateJobDestinationConnectionsResponseMultiError, or nil if none found.
-func (m *CreateJobDestinationConnectionsResponse) ValidateAll() error {
+// ValidateAll checks the field values on SetJobRunValidationReportResponse
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// SetJobRunValidationReportResponseMultiError, or nil if none found.
+func (m *SetJobRunValidationReportResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *CreateJobDestinationConnectionsResponse) validate(all bool) error {
+func (m *SetJobRunValidationReportResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if all {
-		switch v := interface{}(m.GetJob()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, CreateJobDestinationConnectionsResponseValidationError{
-					field:  "Job",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, CreateJobDestinationConnectionsResponseValidationError{
-					field:  "Job",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetJob()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return CreateJobDestinationConnectionsResponseValidationError{
-				field:  "Job",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
+	if len(errors) > 0 {
+		return SetJobRunValidationReportResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// SetJobRunValidationReportResponseMultiError is an error wrapping multiple
+// validation errors returned by
+// SetJobRunValidationReportResponse.ValidateAll() if the designated
+// constraints aren't met.
+type SetJobRunValidationReportResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SetJobRunValidationReportResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SetJobRunValidationReportResponseMultiError) AllErrors() []error { return m }
+
+// SetJobRunValidationReportResponseValidationError is the validation error
+// returned by SetJobRunValidationReportResponse.Validate if the designated
+// constraints aren't met.
+type SetJobRunValidationReportResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SetJobRunValidationReportResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SetJobRunValidationReportResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SetJobRunValidationReportResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SetJobRunValidationReportResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SetJobRunValidationReportResponseValidationError) ErrorName() string {
+	return "SetJobRunValidationReportResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SetJobRunValidationReportResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSetJobRunValidationReportResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SetJobRunValidationReportResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SetJobRunValidationReportResponseValidationError{}
+
+// Validate checks the field values on GetJobRunValidationReportRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *GetJobRunValidationReportRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetJobRunValidationReportRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// GetJobRunValidationReportRequestMultiError, or nil if none found.
+func (m *GetJobRunValidationReportRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetJobRunValidationReportRequest) validate(all bool) error {
+	if m == nil {
+		return nil
 	}
 
+	var errors []error
+
+	// no validation rules for JobId
+
+	// no validation rules for JobRunId
+
 	if len(errors) > 0 {
-		return CreateJobDestinationConnectionsResponseMultiError(errors)
+		return GetJobRunValidationReportRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// CreateJobDestinationConnectionsResponseMultiError is an error wrapping
-// multiple validation errors returned by
-// CreateJobDestinationConnectionsResponse.ValidateAll() if the designated
+// GetJobRunValidationReportRequestMultiError is an error wrapping multiple
+// validation errors returned by
+// GetJobRunValidationReportRequest.ValidateAll() if the designated
 // constraints aren't met.
-type CreateJobDestinationConnectionsResponseMultiError []error
+type GetJobRunValidationReportRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m CreateJobDestinationConnectionsResponseMultiError) Error() string {
+func (m GetJobRunValidationReportRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -6478,12 +13667,12 @@ func (m CreateJobDestinationConnectionsResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m CreateJobDestinationConnectionsResponseMultiError) AllErrors() []error { return m }
+func (m GetJobRunValidationReportRequestMultiError) AllErrors() []error { return m }
 
-// CreateJobDestinationConnectionsResponseValidationError is the validation
-// error returned by CreateJobDestinationConnectionsResponse.Validate if the
-// designated constraints aren't met.
-type CreateJobDestinationConnectionsResponseValidationError struct {
+// GetJobRunValidationReportRequestValidationError is the validation error
+// returned by GetJobRunValidationReportRequest.Validate if the designated
+// constraints aren't met.
+type GetJobRunValidationReportRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -6491,24 +13680,24 @@ type CreateJobDestinationConnectionsResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e CreateJobDestinationConnectionsResponseValidationError) Field() string { return e.field }
+func (e GetJobRunValidationReportRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CreateJobDestinationConnectionsResponseValidationError) Reason() string { return e.reason }
+func (e GetJobRunValidationReportRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CreateJobDestinationConnectionsResponseValidationError) Cause() error { return e.cause }
+func (e GetJobRunValidationReportRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CreateJobDestinationConnectionsResponseValidationError) Key() bool { return e.key }
+func (e GetJobRunValidationReportRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CreateJobDestinationConnectionsResponseValidationError) ErrorName() string {
-	return "CreateJobDestinationConnectionsResponseValidationError"
+func (e GetJobRunValidationReportRequestValidationError) ErrorName() string {
+	return "GetJobRunValidationReportRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e CreateJobDestinationConnectionsResponseValidationError) Error() string {
+func (e GetJobRunValidationReportRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -6520,14 +13709,14 @@ func (e CreateJobDestinationConnectionsResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCreateJobDestinationConnectionsResponse.%s: %s%s",
+		"invalid %sGetJobRunValidationReportRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CreateJobDestinationConnectionsResponseValidationError{}
+var _ error = GetJobRunValidationReportRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -6535,46 +13724,75 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CreateJobDestinationConnectionsResponseValidationError{}
+} = GetJobRunValidationReportRequestValidationError{}
 
-// Validate checks the field values on DeleteJobRequest with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *DeleteJobRequest) Validate() error {
+// Validate checks the field values on GetJobRunValidationReportResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *GetJobRunValidationReportResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on DeleteJobRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// DeleteJobRequestMultiError, or nil if none found.
-func (m *DeleteJobRequest) ValidateAll() error {
+// ValidateAll checks the field values on GetJobRunValidationReportResponse
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// GetJobRunValidationReportResponseMultiError, or nil if none found.
+func (m *GetJobRunValidationReportResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *DeleteJobRequest) validate(all bool) error {
+func (m *GetJobRunValidationReportResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Id
+	if all {
+		switch v := interface{}(m.GetReport()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetJobRunValidationReportResponseValidationError{
+					field:  "Report",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetJobRunValidationReportResponseValidationError{
+					field:  "Report",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetReport()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetJobRunValidationReportResponseValidationError{
+				field:  "Report",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
 
 	if len(errors) > 0 {
-		return DeleteJobRequestMultiError(errors)
+		return GetJobRunValidationReportResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// DeleteJobRequestMultiError is an error wrapping multiple validation errors
-// returned by DeleteJobRequest.ValidateAll() if the designated constraints
-// aren't met.
-type DeleteJobRequestMultiError []error
+// GetJobRunValidationReportResponseMultiError is an error wrapping multiple
+// validation errors returned by
+// GetJobRunValidationReportResponse.ValidateAll() if the designated
+// constraints aren't met.
+type GetJobRunValidationReportResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m DeleteJobRequestMultiError) Error() string {
+func (m GetJobRunValidationReportResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -6583,11 +13801,12 @@ func (m DeleteJobRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m DeleteJobRequestMultiError) AllErrors() []error { return m }
+func (m GetJobRunValidationReportResponseMultiError) AllErrors() []error { return m }
 
-// DeleteJobRequestValidationError is the validation error returned by
-// DeleteJobRequest.Validate if the designated constraints aren't met.
-type DeleteJobRequestValidationError struct {
+// GetJobRunValidationReportResponseValidationError is the validation error
+// returned by GetJobRunValidationReportResponse.Validate if the designated
+// constraints aren't met.
+type GetJobRunValidationReportResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -6595,22 +13814,24 @@ type DeleteJobRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e DeleteJobRequestValidationError) Field() string { return e.field }
+func (e GetJobRunValidationReportResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e DeleteJobRequestValidationError) Reason() string { return e.reason }
+func (e GetJobRunValidationReportResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e DeleteJobRequestValidationError) Cause() error { return e.cause }
+func (e GetJobRunValidationReportResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e DeleteJobRequestValidationError) Key() bool { return e.key }
+func (e GetJobRunValidationReportResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e DeleteJobRequestValidationError) ErrorName() string { return "DeleteJobRequestValidationError" }
+func (e GetJobRunValidationReportResponseValidationError) ErrorName() string {
+	return "GetJobRunValidationReportResponseValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e DeleteJobRequestValidationError) Error() string {
+func (e GetJobRunValidationReportResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -6622,14 +13843,14 @@ func (e DeleteJobRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sDeleteJobRequest.%s: %s%s",
+		"invalid %sGetJobRunValidationReportResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = DeleteJobRequestValidationError{}
+var _ error = GetJobRunValidationReportResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -6637,44 +13858,147 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = DeleteJobRequestValidationError{}
+} = GetJobRunValidationReportResponseValidationError{}
 
-// Validate checks the field values on DeleteJobResponse with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *DeleteJobResponse) Validate() error {
+// Validate checks the field values on JobRunSchemaDriftReport with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *JobRunSchemaDriftReport) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on DeleteJobResponse with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on JobRunSchemaDriftReport with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// DeleteJobResponseMultiError, or nil if none found.
-func (m *DeleteJobResponse) ValidateAll() error {
+// JobRunSchemaDriftReportMultiError, or nil if none found.
+func (m *JobRunSchemaDriftReport) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *DeleteJobResponse) validate(all bool) error {
+func (m *JobRunSchemaDriftReport) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	// no validation rules for JobId
+
+	// no validation rules for JobRunId
+
+	// no validation rules for Halted
+
+	for idx, item := range m.GetChanges() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, JobRunSchemaDriftReportValidationError{
+						field:  fmt.Sprintf("Changes[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, JobRunSchemaDriftReportValidationError{
+						field:  fmt.Sprintf("Changes[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return JobRunSchemaDriftReportValidationError{
+					field:  fmt.Sprintf("Changes[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetColumns() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, JobRunSchemaDriftReportValidationError{
+						field:  fmt.Sprintf("Columns[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, JobRunSchemaDriftReportValidationError{
+						field:  fmt.Sprintf("Columns[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return JobRunSchemaDriftReportValidationError{
+					field:  fmt.Sprintf("Columns[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if all {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, JobRunSchemaDriftReportValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, JobRunSchemaDriftReportValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return JobRunSchemaDriftReportValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
 	if len(errors) > 0 {
-		return DeleteJobResponseMultiError(errors)
+		return JobRunSchemaDriftReportMultiError(errors)
 	}
 
 	return nil
 }
 
-// DeleteJobResponseMultiError is an error wrapping multiple validation errors
-// returned by DeleteJobResponse.ValidateAll() if the designated constraints
-// aren't met.
-type DeleteJobResponseMultiError []error
+// JobRunSchemaDriftReportMultiError is an error wrapping multiple validation
+// errors returned by JobRunSchemaDriftReport.ValidateAll() if the designated
+// constraints aren't met.
+type JobRunSchemaDriftReportMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m DeleteJobResponseMultiError) Error() string {
+func (m JobRunSchemaDriftReportMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -6683,11 +14007,11 @@ func (m DeleteJobResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m DeleteJobResponseMultiError) AllErrors() []error { return m }
+func (m JobRunSchemaDriftReportMultiError) AllErrors() []error { return m }
 
-// DeleteJobResponseValidationError is the validation error returned by
-// DeleteJobResponse.Validate if the designated constraints aren't met.
-type DeleteJobResponseValidationError struct {
+// JobRunSchemaDriftReportValidationError is the validation error returned by
+// JobRunSchemaDriftReport.Validate if the designated constraints aren't met.
+type JobRunSchemaDriftReportValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -6695,24 +14019,24 @@ type DeleteJobResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e DeleteJobResponseValidationError) Field() string { return e.field }
+func (e JobRunSchemaDriftReportValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e DeleteJobResponseValidationError) Reason() string { return e.reason }
+func (e JobRunSchemaDriftReportValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e DeleteJobResponseValidationError) Cause() error { return e.cause }
+func (e JobRunSchemaDriftReportValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e DeleteJobResponseValidationError) Key() bool { return e.key }
+func (e JobRunSchemaDriftReportValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e DeleteJobResponseValidationError) ErrorName() string {
-	return "DeleteJobResponseValidationError"
+func (e JobRunSchemaDriftReportValidationError) ErrorName() string {
+	return "JobRunSchemaDriftReportValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e DeleteJobResponseValidationError) Error() string {
+func (e JobRunSchemaDriftReportValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -6724,14 +14048,14 @@ func (e DeleteJobResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sDeleteJobResponse.%s: %s%s",
+		"invalid %sJobRunSchemaDriftReport.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = DeleteJobResponseValidationError{}
+var _ error = JobRunSchemaDriftReportValidationError{}
 
 var _ interface {
 	Field() string
@@ -6739,48 +14063,99 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = DeleteJobResponseValidationError{}
+} = JobRunSchemaDriftReportValidationError{}
 
-// Validate checks the field values on IsJobNameAvailableRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *IsJobNameAvailableRequest) Validate() error {
+// Validate checks the field values on SchemaDriftChange with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *SchemaDriftChange) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on IsJobNameAvailableRequest with the
-// rules defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on SchemaDriftChange with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// IsJobNameAvailableRequestMultiError, or nil if none found.
-func (m *IsJobNameAvailableRequest) ValidateAll() error {
+// SchemaDriftChangeMultiError, or nil if none found.
+func (m *SchemaDriftChange) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *IsJobNameAvailableRequest) validate(all bool) error {
+func (m *SchemaDriftChange) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Name
+	// no validation rules for Schema
 
-	// no validation rules for AccountId
+	// no validation rules for Table
+
+	// no validation rules for Column
+
+	// no validation rules for Type
+
+	// no validation rules for Action
+
+	if m.RenamedFrom != nil {
+		// no validation rules for RenamedFrom
+	}
+
+	if m.PreviousDataType != nil {
+		// no validation rules for PreviousDataType
+	}
+
+	if m.DataType != nil {
+		// no validation rules for DataType
+	}
+
+	if m.SuggestedTransformer != nil {
+
+		if all {
+			switch v := interface{}(m.GetSuggestedTransformer()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, SchemaDriftChangeValidationError{
+						field:  "SuggestedTransformer",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, SchemaDriftChangeValidationError{
+						field:  "SuggestedTransformer",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetSuggestedTransformer()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return SchemaDriftChangeValidationError{
+					field:  "SuggestedTransformer",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
 
 	if len(errors) > 0 {
-		return IsJobNameAvailableRequestMultiError(errors)
+		return SchemaDriftChangeMultiError(errors)
 	}
 
 	return nil
 }
 
-// IsJobNameAvailableRequestMultiError is an error wrapping multiple validation
-// errors returned by IsJobNameAvailableRequest.ValidateAll() if the
-// designated constraints aren't met.
-type IsJobNameAvailableRequestMultiError []error
+// SchemaDriftChangeMultiError is an error wrapping multiple validation errors
+// returned by SchemaDriftChange.ValidateAll() if the designated constraints
+// aren't met.
+type SchemaDriftChangeMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m IsJobNameAvailableRequestMultiError) Error() string {
+func (m SchemaDriftChangeMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -6789,11 +14164,11 @@ func (m IsJobNameAvailableRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m IsJobNameAvailableRequestMultiError) AllErrors() []error { return m }
+func (m SchemaDriftChangeMultiError) AllErrors() []error { return m }
 
-// IsJobNameAvailableRequestValidationError is the validation error returned by
-// IsJobNameAvailableRequest.Validate if the designated constraints aren't met.
-type IsJobNameAvailableRequestValidationError struct {
+// SchemaDriftChangeValidationError is the validation error returned by
+// SchemaDriftChange.Validate if the designated constraints aren't met.
+type SchemaDriftChangeValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -6801,24 +14176,24 @@ type IsJobNameAvailableRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e IsJobNameAvailableRequestValidationError) Field() string { return e.field }
+func (e SchemaDriftChangeValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e IsJobNameAvailableRequestValidationError) Reason() string { return e.reason }
+func (e SchemaDriftChangeValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e IsJobNameAvailableRequestValidationError) Cause() error { return e.cause }
+func (e SchemaDriftChangeValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e IsJobNameAvailableRequestValidationError) Key() bool { return e.key }
+func (e SchemaDriftChangeValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e IsJobNameAvailableRequestValidationError) ErrorName() string {
-	return "IsJobNameAvailableRequestValidationError"
+func (e SchemaDriftChangeValidationError) ErrorName() string {
+	return "SchemaDriftChangeValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e IsJobNameAvailableRequestValidationError) Error() string {
+func (e SchemaDriftChangeValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -6830,14 +14205,14 @@ func (e IsJobNameAvailableRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sIsJobNameAvailableRequest.%s: %s%s",
+		"invalid %sSchemaDriftChange.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = IsJobNameAvailableRequestValidationError{}
+var _ error = SchemaDriftChangeValidationError{}
 
 var _ interface {
 	Field() string
@@ -6845,46 +14220,52 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = IsJobNameAvailableRequestValidationError{}
-
-// Validate checks the field values on IsJobNameAvailableResponse with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *IsJobNameAvailableResponse) Validate() error {
+} = SchemaDriftChangeValidationError{}
+
+// Validate checks the field values on SchemaDriftColumn with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *SchemaDriftColumn) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on IsJobNameAvailableResponse with the
-// rules defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on SchemaDriftColumn with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// IsJobNameAvailableResponseMultiError, or nil if none found.
-func (m *IsJobNameAvailableResponse) ValidateAll() error {
+// SchemaDriftColumnMultiError, or nil if none found.
+func (m *SchemaDriftColumn) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *IsJobNameAvailableResponse) validate(all bool) error {
+func (m *SchemaDriftColumn) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for IsAvailable
+	// no validation rules for Schema
+
+	// no validation rules for Table
+
+	// no validation rules for Column
+
+	// no validation rules for DataType
 
 	if len(errors) > 0 {
-		return IsJobNameAvailableResponseMultiError(errors)
+		return SchemaDriftColumnMultiError(errors)
 	}
 
 	return nil
 }
 
-// IsJobNameAvailableResponseMultiError is an error wrapping multiple
-// validation errors returned by IsJobNameAvailableResponse.ValidateAll() if
-// the designated constraints aren't met.
-type IsJobNameAvailableResponseMultiError []error
+// SchemaDriftColumnMultiError is an error wrapping multiple validation errors
+// returned by SchemaDriftColumn.ValidateAll() if the designated constraints
+// aren't met.
+type SchemaDriftColumnMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m IsJobNameAvailableResponseMultiError) Error() string {
+func (m SchemaDriftColumnMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -6893,11 +14274,11 @@ func (m IsJobNameAvailableResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m IsJobNameAvailableResponseMultiError) AllErrors() []error { return m }
+func (m SchemaDriftColumnMultiError) AllErrors() []error { return m }
 
-// IsJobNameAvailableResponseValidationError is the validation error returned
-// by IsJobNameAvailableResponse.Validate if the designated constraints aren't met.
-type IsJobNameAvailableResponseValidationError struct {
+// SchemaDriftColumnValidationError is the validation error returned by
+// SchemaDriftColumn.Validate if the designated constraints aren't met.
+type SchemaDriftColumnValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -6905,24 +14286,24 @@ type IsJobNameAvailableResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e IsJobNameAvailableResponseValidationError) Field() string { return e.field }
+func (e SchemaDriftColumnValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e IsJobNameAvailableResponseValidationError) Reason() string { return e.reason }
+func (e SchemaDriftColumnValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e IsJobNameAvailableResponseValidationError) Cause() error { return e.cause }
+func (e SchemaDriftColumnValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e IsJobNameAvailableResponseValidationError) Key() bool { return e.key }
+func (e SchemaDriftColumnValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e IsJobNameAvailableResponseValidationError) ErrorName() string {
-	return "IsJobNameAvailableResponseValidationError"
+func (e SchemaDriftColumnValidationError) ErrorName() string {
+	return "SchemaDriftColumnValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e IsJobNameAvailableResponseValidationError) Error() string {
+func (e SchemaDriftColumnValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -6934,14 +14315,14 @@ func (e IsJobNameAvailableResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sIsJobNameAvailableResponse.%s: %s%s",
+		"invalid %sSchemaDriftColumn.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = IsJobNameAvailableResponseValidationError{}
+var _ error = SchemaDriftColumnValidationError{}
 
 var _ interface {
 	Field() string
@@ -6949,73 +14330,120 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = IsJobNameAvailableResponseValidationError{}
+} = SchemaDriftColumnValidationError{}
 
-// Validate checks the field values on GetJobRunsRequest with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *GetJobRunsRequest) Validate() error {
+// Validate checks the field values on SetJobRunSchemaDriftReportRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *SetJobRunSchemaDriftReportRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetJobRunsRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// GetJobRunsRequestMultiError, or nil if none found.
-func (m *GetJobRunsRequest) ValidateAll() error {
+// ValidateAll checks the field values on SetJobRunSchemaDriftReportRequest
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// SetJobRunSchemaDriftReportRequestMultiError, or nil if none found.
+func (m *SetJobRunSchemaDriftReportRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetJobRunsRequest) validate(all bool) error {
+func (m *SetJobRunSchemaDriftReportRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	switch v := m.Id.(type) {
-	case *GetJobRunsRequest_JobId:
-		if v == nil {
-			err := GetJobRunsRequestValidationError{
-				field:  "Id",
-				reason: "oneof value cannot be a typed-nil",
+	// no validation rules for JobId
+
+	// no validation rules for JobRunId
+
+	// no validation rules for Halted
+
+	for idx, item := range m.GetChanges() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, SetJobRunSchemaDriftReportRequestValidationError{
+						field:  fmt.Sprintf("Changes[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, SetJobRunSchemaDriftReportRequestValidationError{
+						field:  fmt.Sprintf("Changes[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
 			}
-			if !all {
-				return err
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return SetJobRunSchemaDriftReportRequestValidationError{
+					field:  fmt.Sprintf("Changes[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
 			}
-			errors = append(errors, err)
 		}
-		// no validation rules for JobId
-	case *GetJobRunsRequest_AccountId:
-		if v == nil {
-			err := GetJobRunsRequestValidationError{
-				field:  "Id",
-				reason: "oneof value cannot be a typed-nil",
+
+	}
+
+	for idx, item := range m.GetColumns() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, SetJobRunSchemaDriftReportRequestValidationError{
+						field:  fmt.Sprintf("Columns[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, SetJobRunSchemaDriftReportRequestValidationError{
+						field:  fmt.Sprintf("Columns[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
 			}
-			if !all {
-				return err
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return SetJobRunSchemaDriftReportRequestValidationError{
+					field:  fmt.Sprintf("Columns[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
 			}
-			errors = append(errors, err)
 		}
-		// no validation rules for AccountId
-	default:
-		_ = v // ensures v is used
+
 	}
 
 	if len(errors) > 0 {
-		return GetJobRunsRequestMultiError(errors)
+		return SetJobRunSchemaDriftReportRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetJobRunsRequestMultiError is an error wrapping multiple validation errors
-// returned by GetJobRunsRequest.ValidateAll() if the designated constraints
-// aren't met.
-type GetJobRunsRequestMultiError []error
+// SetJobRunSchemaDriftReportRequestMultiError is an error wrapping multiple
+// validation errors returned by
+// SetJobRunSchemaDriftReportRequest.ValidateAll() if the designated
+// constraints aren't met.
+type SetJobRunSchemaDriftReportRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetJobRunsRequestMultiError) Error() string {
+func (m SetJobRunSchemaDriftReportRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -7024,11 +14452,12 @@ func (m GetJobRunsRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetJobRunsRequestMultiError) AllErrors() []error { return m }
+func (m SetJobRunSchemaDriftReportRequestMultiError) AllErrors() []error { return m }
 
-// GetJobRunsRequestValidationError is the validation error returned by
-// GetJobRunsRequest.Validate if the designated constraints aren't met.
-type GetJobRunsRequestValidationError struct {
+// SetJobRunSchemaDriftReportRequestValidationError is the validation error
+// returned by SetJobRunSchemaDriftReportRequest.Validate if the designated
+// constraints aren't met.
+type SetJobRunSchemaDriftReportRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -7036,24 +14465,24 @@ type GetJobRunsRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetJobRunsRequestValidationError) Field() string { return e.field }
+func (e SetJobRunSchemaDriftReportRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetJobRunsRequestValidationError) Reason() string { return e.reason }
+func (e SetJobRunSchemaDriftReportRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetJobRunsRequestValidationError) Cause() error { return e.cause }
+func (e SetJobRunSchemaDriftReportRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetJobRunsRequestValidationError) Key() bool { return e.key }
+func (e SetJobRunSchemaDriftReportRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetJobRunsRequestValidationError) ErrorName() string {
-	return "GetJobRunsRequestValidationError"
+func (e SetJobRunSchemaDriftReportRequestValidationError) ErrorName() string {
+	return "SetJobRunSchemaDriftReportRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetJobRunsRequestValidationError) Error() string {
+func (e SetJobRunSchemaDriftReportRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -7065,14 +14494,14 @@ func (e GetJobRunsRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetJobRunsRequest.%s: %s%s",
+		"invalid %sSetJobRunSchemaDriftReportRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetJobRunsRequestValidationError{}
+var _ error = SetJobRunSchemaDriftReportRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -7080,78 +14509,46 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetJobRunsRequestValidationError{}
+} = SetJobRunSchemaDriftReportRequestValidationError{}
 
-// Validate checks the field values on GetJobRunsResponse with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetJobRunsResponse) Validate() error {
+// Validate checks the field values on SetJobRunSchemaDriftReportResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *SetJobRunSchemaDriftReportResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetJobRunsResponse with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// GetJobRunsResponseMultiError, or nil if none found.
-func (m *GetJobRunsResponse) ValidateAll() error {
+// ValidateAll checks the field values on SetJobRunSchemaDriftReportResponse
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// SetJobRunSchemaDriftReportResponseMultiError, or nil if none found.
+func (m *SetJobRunSchemaDriftReportResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetJobRunsResponse) validate(all bool) error {
+func (m *SetJobRunSchemaDriftReportResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	for idx, item := range m.GetJobRuns() {
-		_, _ = idx, item
-
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, GetJobRunsResponseValidationError{
-						field:  fmt.Sprintf("JobRuns[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, GetJobRunsResponseValidationError{
-						field:  fmt.Sprintf("JobRuns[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return GetJobRunsResponseValidationError{
-					field:  fmt.Sprintf("JobRuns[%v]", idx),
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
-
-	}
-
 	if len(errors) > 0 {
-		return GetJobRunsResponseMultiError(errors)
+		return SetJobRunSchemaDriftReportResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetJobRunsResponseMultiError is an error wrapping multiple validation errors
-// returned by GetJobRunsResponse.ValidateAll() if the designated constraints
-// aren't met.
-type GetJobRunsResponseMultiError []error
+// SetJobRunSchemaDriftReportResponseMultiError is an error wrapping multiple
+// validation errors returned by
+// SetJobRunSchemaDriftReportResponse.ValidateAll() if the designated
+// constraints aren't met.
+type SetJobRunSchemaDriftReportResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetJobRunsResponseMultiError) Error() string {
+func (m SetJobRunSchemaDriftReportResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -7160,11 +14557,12 @@ func (m GetJobRunsResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetJobRunsResponseMultiError) AllErrors() []error { return m }
+func (m SetJobRunSchemaDriftReportResponseMultiError) AllErrors() []error { return m }
 
-// GetJobRunsResponseValidationError is the validation error returned by
-// GetJobRunsResponse.Validate if the designated constraints aren't met.
-type GetJobRunsResponseValidationError struct {
+// SetJobRunSchemaDriftReportResponseValidationError is the validation error
+// returned by SetJobRunSchemaDriftReportResponse.Validate if the designated
+// constraints aren't met.
+type SetJobRunSchemaDriftReportResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -7172,24 +14570,24 @@ type GetJobRunsResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetJobRunsResponseValidationError) Field() string { return e.field }
+func (e SetJobRunSchemaDriftReportResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetJobRunsResponseValidationError) Reason() string { return e.reason }
+func (e SetJobRunSchemaDriftReportResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetJobRunsResponseValidationError) Cause() error { return e.cause }
+func (e SetJobRunSchemaDriftReportResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetJobRunsResponseValidationError) Key() bool { return e.key }
+func (e SetJobRunSchemaDriftReportResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetJobRunsResponseValidationError) ErrorName() string {
-	return "GetJobRunsResponseValidationError"
+func (e SetJobRunSchemaDriftReportResponseValidationError) ErrorName() string {
+	return "SetJobRunSchemaDriftReportResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetJobRunsResponseValidationError) Error() string {
+func (e SetJobRunSchemaDriftReportResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -7201,14 +14599,14 @@ func (e GetJobRunsResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetJobRunsResponse.%s: %s%s",
+		"invalid %sSetJobRunSchemaDriftReportResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetJobRunsResponseValidationError{}
+var _ error = SetJobRunSchemaDriftReportResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -7216,48 +14614,52 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetJobRunsResponseValidationError{}
+} = SetJobRunSchemaDriftReportResponseValidationError{}
 
-// Validate checks the field values on GetJobRunRequest with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *GetJobRunRequest) Validate() error {
+// Validate checks the field values on GetJobRunSchemaDriftReportRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *GetJobRunSchemaDriftReportRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetJobRunRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// GetJobRunRequestMultiError, or nil if none found.
-func (m *GetJobRunRequest) ValidateAll() error {
+// ValidateAll checks the field values on GetJobRunSchemaDriftReportRequest
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// GetJobRunSchemaDriftReportRequestMultiError, or nil if none found.
+func (m *GetJobRunSchemaDriftReportRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetJobRunRequest) validate(all bool) error {
+func (m *GetJobRunSchemaDriftReportRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for JobRunId
+	// no validation rules for JobId
 
-	// no validation rules for AccountId
+	if m.JobRunId != nil {
+		// no validation rules for JobRunId
+	}
 
 	if len(errors) > 0 {
-		return GetJobRunRequestMultiError(errors)
+		return GetJobRunSchemaDriftReportRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetJobRunRequestMultiError is an error wrapping multiple validation errors
-// returned by GetJobRunRequest.ValidateAll() if the designated constraints
-// aren't met.
-type GetJobRunRequestMultiError []error
+// GetJobRunSchemaDriftReportRequestMultiError is an error wrapping multiple
+// validation errors returned by
+// GetJobRunSchemaDriftReportRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetJobRunSchemaDriftReportRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetJobRunRequestMultiError) Error() string {
+func (m GetJobRunSchemaDriftReportRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -7266,11 +14668,12 @@ func (m GetJobRunRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetJobRunRequestMultiError) AllErrors() []error { return m }
+func (m GetJobRunSchemaDriftReportRequestMultiError) AllErrors() []error { return m }
 
-// GetJobRunRequestValidationError is the validation error returned by
-// GetJobRunRequest.Validate if the designated constraints aren't met.
-type GetJobRunRequestValidationError struct {
+// GetJobRunSchemaDriftReportRequestValidationError is the validation error
+// returned by GetJobRunSchemaDriftReportRequest.Validate if the designated
+// constraints aren't met.
+type GetJobRunSchemaDriftReportRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -7278,22 +14681,24 @@ type GetJobRunRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetJobRunRequestValidationError) Field() string { return e.field }
+func (e GetJobRunSchemaDriftReportRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetJobRunRequestValidationError) Reason() string { return e.reason }
+func (e GetJobRunSchemaDriftReportRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetJobRunRequestValidationError) Cause() error { return e.cause }
+func (e GetJobRunSchemaDriftReportRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetJobRunRequestValidationError) Key() bool { return e.key }
+func (e GetJobRunSchemaDriftReportRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetJobRunRequestValidationError) ErrorName() string { return "GetJobRunRequestValidationError" }
+func (e GetJobRunSchemaDriftReportRequestValidationError) ErrorName() string {
+	return "GetJobRunSchemaDriftReportRequestValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e GetJobRunRequestValidationError) Error() string {
+func (e GetJobRunSchemaDriftReportRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -7305,14 +14710,14 @@ func (e GetJobRunRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetJobRunRequest.%s: %s%s",
+		"invalid %sGetJobRunSchemaDriftReportRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetJobRunRequestValidationError{}
+var _ error = GetJobRunSchemaDriftReportRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -7320,24 +14725,25 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetJobRunRequestValidationError{}
+} = GetJobRunSchemaDriftReportRequestValidationError{}
 
-// Validate checks the field values on GetJobRunResponse with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *GetJobRunResponse) Validate() error {
+// Validate checks the field values on GetJobRunSchemaDriftReportResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *GetJobRunSchemaDriftReportResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetJobRunResponse with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// GetJobRunResponseMultiError, or nil if none found.
-func (m *GetJobRunResponse) ValidateAll() error {
+// ValidateAll checks the field values on GetJobRunSchemaDriftReportResponse
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// GetJobRunSchemaDriftReportResponseMultiError, or nil if none found.
+func (m *GetJobRunSchemaDriftReportResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetJobRunResponse) validate(all bool) error {
+func (m *GetJobRunSchemaDriftReportResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -7345,28 +14751,28 @@ func (m *GetJobRunResponse) validate(all bool) error {
 	var errors []error
 
 	if all {
-		switch v := interface{}(m.GetJobRun()).(type) {
+		switch v := interface{}(m.GetReport()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, GetJobRunResponseValidationError{
-					field:  "JobRun",
+				errors = append(errors, GetJobRunSchemaDriftReportResponseValidationError{
+					field:  "Report",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, GetJobRunResponseValidationError{
-					field:  "JobRun",
+				errors = append(errors, GetJobRunSchemaDriftReportResponseValidationError{
+					field:  "Report",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetJobRun()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetReport()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return GetJobRunResponseValidationError{
-				field:  "JobRun",
+			return GetJobRunSchemaDriftReportResponseValidationError{
+				field:  "Report",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
@@ -7374,19 +14780,20 @@ func (m *GetJobRunResponse) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return GetJobRunResponseMultiError(errors)
+		return GetJobRunSchemaDriftReportResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetJobRunResponseMultiError is an error wrapping multiple validation errors
-// returned by GetJobRunResponse.ValidateAll() if the designated constraints
-// aren't met.
-type GetJobRunResponseMultiError []error
+// GetJobRunSchemaDriftReportResponseMultiError is an error wrapping multiple
+// validation errors returned by
+// GetJobRunSchemaDriftReportResponse.ValidateAll() if the designated
+// constraints aren't met.
+type GetJobRunSchemaDriftReportResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetJobRunResponseMultiError) Error() string {
+func (m GetJobRunSchemaDriftReportResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -7395,11 +14802,12 @@ func (m GetJobRunResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetJobRunResponseMultiError) AllErrors() []error { return m }
+func (m GetJobRunSchemaDriftReportResponseMultiError) AllErrors() []error { return m }
 
-// GetJobRunResponseValidationError is the validation error returned by
-// GetJobRunResponse.Validate if the designated constraints aren't met.
-type GetJobRunResponseValidationError struct {
+// GetJobRunSchemaDriftReportResponseValidationError is the validation error
+// returned by GetJobRunSchemaDriftReportResponse.Validate if the designated
+// constraints aren't met.
+type GetJobRunSchemaDriftReportResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -7407,24 +14815,24 @@ type GetJobRunResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetJobRunResponseValidationError) Field() string { return e.field }
+func (e GetJobRunSchemaDriftReportResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetJobRunResponseValidationError) Reason() string { return e.reason }
+func (e GetJobRunSchemaDriftReportResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetJobRunResponseValidationError) Cause() error { return e.cause }
+func (e GetJobRunSchemaDriftReportResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetJobRunResponseValidationError) Key() bool { return e.key }
+func (e GetJobRunSchemaDriftReportResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetJobRunResponseValidationError) ErrorName() string {
-	return "GetJobRunResponseValidationError"
+func (e GetJobRunSchemaDriftReportResponseValidationError) ErrorName() string {
+	return "GetJobRunSchemaDriftReportResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetJobRunResponseValidationError) Error() string {
+func (e GetJobRunSchemaDriftReportResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -7436,14 +14844,14 @@ func (e GetJobRunResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetJobRunResponse.%s: %s%s",
+		"invalid %sGetJobRunSchemaDriftReportResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetJobRunResponseValidationError{}
+var _ error = GetJobRunSchemaDriftReportResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -7451,24 +14859,25 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetJobRunResponseValidationError{}
+} = GetJobRunSchemaDriftReportResponseValidationError{}
 
-// Validate checks the field values on CreateJobRunRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *CreateJobRunRequest) Validate() error {
+// Validate checks the field values on ApplyJobRunSchemaDriftReportRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *ApplyJobRunSchemaDriftReportRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on CreateJobRunRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// CreateJobRunRequestMultiError, or nil if none found.
-func (m *CreateJobRunRequest) ValidateAll() error {
+// ValidateAll checks the field values on ApplyJobRunSchemaDriftReportRequest
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// ApplyJobRunSchemaDriftReportRequestMultiError, or nil if none found.
+func (m *ApplyJobRunSchemaDriftReportRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *CreateJobRunRequest) validate(all bool) error {
+func (m *ApplyJobRunSchemaDriftReportRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -7477,20 +14886,23 @@ func (m *CreateJobRunRequest) validate(all bool) error {
 
 	// no validation rules for JobId
 
+	// no validation rules for JobRunId
+
 	if len(errors) > 0 {
-		return CreateJobRunRequestMultiError(errors)
+		return ApplyJobRunSchemaDriftReportRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// CreateJobRunRequestMultiError is an error wrapping multiple validation
-// errors returned by CreateJobRunRequest.ValidateAll() if the designated
+// ApplyJobRunSchemaDriftReportRequestMultiError is an error wrapping multiple
+// validation errors returned by
+// ApplyJobRunSchemaDriftReportRequest.ValidateAll() if the designated
 // constraints aren't met.
-type CreateJobRunRequestMultiError []error
+type ApplyJobRunSchemaDriftReportRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m CreateJobRunRequestMultiError) Error() string {
+func (m ApplyJobRunSchemaDriftReportRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -7499,11 +14911,12 @@ func (m CreateJobRunRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m CreateJobRunRequestMultiError) AllErrors() []error { return m }
+func (m ApplyJobRunSchemaDriftReportRequestMultiError) AllErrors() []error { return m }
 
-// CreateJobRunRequestValidationError is the validation error returned by
-// CreateJobRunRequest.Validate if the designated constraints aren't met.
-type CreateJobRunRequestValidationError struct {
+// ApplyJobRunSchemaDriftReportRequestValidationError is the validation error
+// returned by ApplyJobRunSchemaDriftReportRequest.Validate if the designated
+// constraints aren't met.
+type ApplyJobRunSchemaDriftReportRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -7511,24 +14924,24 @@ type CreateJobRunRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e CreateJobRunRequestValidationError) Field() string { return e.field }
+func (e ApplyJobRunSchemaDriftReportRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CreateJobRunRequestValidationError) Reason() string { return e.reason }
+func (e ApplyJobRunSchemaDriftReportRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CreateJobRunRequestValidationError) Cause() error { return e.cause }
+func (e ApplyJobRunSchemaDriftReportRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CreateJobRunRequestValidationError) Key() bool { return e.key }
+func (e ApplyJobRunSchemaDriftReportRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CreateJobRunRequestValidationError) ErrorName() string {
-	return "CreateJobRunRequestValidationError"
+func (e ApplyJobRunSchemaDriftReportRequestValidationError) ErrorName() string {
+	return "ApplyJobRunSchemaDriftReportRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e CreateJobRunRequestValidationError) Error() string {
+func (e ApplyJobRunSchemaDriftReportRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -7540,14 +14953,14 @@ func (e CreateJobRunRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCreateJobRunRequest.%s: %s%s",
+		"invalid %sApplyJobRunSchemaDriftReportRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CreateJobRunRequestValidationError{}
+var _ error = ApplyJobRunSchemaDriftReportRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -7555,44 +14968,75 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CreateJobRunRequestValidationError{}
+} = ApplyJobRunSchemaDriftReportRequestValidationError{}
 
-// Validate checks the field values on CreateJobRunResponse with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *CreateJobRunResponse) Validate() error {
+// Validate checks the field values on ApplyJobRunSchemaDriftReportResponse
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the first error encountered is returned, or nil if
+// there are no violations.
+func (m *ApplyJobRunSchemaDriftReportResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on CreateJobRunResponse with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// CreateJobRunResponseMultiError, or nil if none found.
-func (m *CreateJobRunResponse) ValidateAll() error {
+// ValidateAll checks the field values on ApplyJobRunSchemaDriftReportResponse
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// ApplyJobRunSchemaDriftReportResponseMultiError, or nil if none found.
+func (m *ApplyJobRunSchemaDriftReportResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *CreateJobRunResponse) validate(all bool) error {
+func (m *ApplyJobRunSchemaDriftReportResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	if all {
+		switch v := interface{}(m.GetJob()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ApplyJobRunSchemaDriftReportResponseValidationError{
+					field:  "Job",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ApplyJobRunSchemaDriftReportResponseValidationError{
+					field:  "Job",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetJob()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ApplyJobRunSchemaDriftReportResponseValidationError{
+				field:  "Job",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
 	if len(errors) > 0 {
-		return CreateJobRunResponseMultiError(errors)
+		return ApplyJobRunSchemaDriftReportResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// CreateJobRunResponseMultiError is an error wrapping multiple validation
-// errors returned by CreateJobRunResponse.ValidateAll() if the designated
+// ApplyJobRunSchemaDriftReportResponseMultiError is an error wrapping multiple
+// validation errors returned by
+// ApplyJobRunSchemaDriftReportResponse.ValidateAll() if the designated
 // constraints aren't met.
-type CreateJobRunResponseMultiError []error
+type ApplyJobRunSchemaDriftReportResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m CreateJobRunResponseMultiError) Error() string {
+func (m ApplyJobRunSchemaDriftReportResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -7601,11 +15045,12 @@ func (m CreateJobRunResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m CreateJobRunResponseMultiError) AllErrors() []error { return m }
+func (m ApplyJobRunSchemaDriftReportResponseMultiError) AllErrors() []error { return m }
 
-// CreateJobRunResponseValidationError is the validation error returned by
-// CreateJobRunResponse.Validate if the designated constraints aren't met.
-type CreateJobRunResponseValidationError struct {
+// ApplyJobRunSchemaDriftReportResponseValidationError is the validation error
+// returned by ApplyJobRunSchemaDriftReportResponse.Validate if the designated
+// constraints aren't met.
+type ApplyJobRunSchemaDriftReportResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -7613,24 +15058,24 @@ type CreateJobRunResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e CreateJobRunResponseValidationError) Field() string { return e.field }
+func (e ApplyJobRunSchemaDriftReportResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CreateJobRunResponseValidationError) Reason() string { return e.reason }
+func (e ApplyJobRunSchemaDriftReportResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CreateJobRunResponseValidationError) Cause() error { return e.cause }
+func (e ApplyJobRunSchemaDriftReportResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CreateJobRunResponseValidationError) Key() bool { return e.key }
+func (e ApplyJobRunSchemaDriftReportResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CreateJobRunResponseValidationError) ErrorName() string {
-	return "CreateJobRunResponseValidationError"
+func (e ApplyJobRunSchemaDriftReportResponseValidationError) ErrorName() string {
+	return "ApplyJobRunSchemaDriftReportResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e CreateJobRunResponseValidationError) Error() string {
+func (e ApplyJobRunSchemaDriftReportResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -7642,14 +15087,14 @@ func (e CreateJobRunResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCreateJobRunResponse.%s: %s%s",
+		"invalid %sApplyJobRunSchemaDriftReportResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CreateJobRunResponseValidationError{}
+var _ error = ApplyJobRunSchemaDriftReportResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -7657,48 +15102,108 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CreateJobRunResponseValidationError{}
+} = ApplyJobRunSchemaDriftReportResponseValidationError{}
 
-// Validate checks the field values on CancelJobRunRequest with the rules
+// Validate checks the field values on PreviewJobMappingsRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *CancelJobRunRequest) Validate() error {
+func (m *PreviewJobMappingsRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on CancelJobRunRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on PreviewJobMappingsRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// CancelJobRunRequestMultiError, or nil if none found.
-func (m *CancelJobRunRequest) ValidateAll() error {
+// PreviewJobMappingsRequestMultiError, or nil if none found.
+func (m *PreviewJobMappingsRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *CancelJobRunRequest) validate(all bool) error {
+func (m *PreviewJobMappingsRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for JobRunId
-
 	// no validation rules for AccountId
 
+	switch v := m.Source.(type) {
+	case *PreviewJobMappingsRequest_JobId:
+		if v == nil {
+			err := PreviewJobMappingsRequestValidationError{
+				field:  "Source",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+		// no validation rules for JobId
+	case *PreviewJobMappingsRequest_Mappings:
+		if v == nil {
+			err := PreviewJobMappingsRequestValidationError{
+				field:  "Source",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetMappings()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, PreviewJobMappingsRequestValidationError{
+						field:  "Mappings",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, PreviewJobMappingsRequestValidationError{
+						field:  "Mappings",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetMappings()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return PreviewJobMappingsRequestValidationError{
+					field:  "Mappings",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	default:
+		_ = v // ensures v is used
+	}
+
+	if m.RowCount != nil {
+		// no validation rules for RowCount
+	}
+
 	if len(errors) > 0 {
-		return CancelJobRunRequestMultiError(errors)
+		return PreviewJobMappingsRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// CancelJobRunRequestMultiError is an error wrapping multiple validation
-// errors returned by CancelJobRunRequest.ValidateAll() if the designated
-// constraints aren't met.
-type CancelJobRunRequestMultiError []error
+// PreviewJobMappingsRequestMultiError is an error wrapping multiple validation
+// errors returned by PreviewJobMappingsRequest.ValidateAll() if the
+// designated constraints aren't met.
+type PreviewJobMappingsRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m CancelJobRunRequestMultiError) Error() string {
+func (m PreviewJobMappingsRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -7707,11 +15212,11 @@ func (m CancelJobRunRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m CancelJobRunRequestMultiError) AllErrors() []error { return m }
+func (m PreviewJobMappingsRequestMultiError) AllErrors() []error { return m }
 
-// CancelJobRunRequestValidationError is the validation error returned by
-// CancelJobRunRequest.Validate if the designated constraints aren't met.
-type CancelJobRunRequestValidationError struct {
+// PreviewJobMappingsRequestValidationError is the validation error returned by
+// PreviewJobMappingsRequest.Validate if the designated constraints aren't met.
+type PreviewJobMappingsRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -7719,24 +15224,24 @@ type CancelJobRunRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e CancelJobRunRequestValidationError) Field() string { return e.field }
+func (e PreviewJobMappingsRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CancelJobRunRequestValidationError) Reason() string { return e.reason }
+func (e PreviewJobMappingsRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CancelJobRunRequestValidationError) Cause() error { return e.cause }
+func (e PreviewJobMappingsRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CancelJobRunRequestValidationError) Key() bool { return e.key }
+func (e PreviewJobMappingsRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CancelJobRunRequestValidationError) ErrorName() string {
-	return "CancelJobRunRequestValidationError"
+func (e PreviewJobMappingsRequestValidationError) ErrorName() string {
+	return "PreviewJobMappingsRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e CancelJobRunRequestValidationError) Error() string {
+func (e PreviewJobMappingsRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -7748,14 +15253,14 @@ func (e CancelJobRunRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCancelJobRunRequest.%s: %s%s",
+		"invalid %sPreviewJobMappingsRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CancelJobRunRequestValidationError{}
+var _ error = PreviewJobMappingsRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -7763,44 +15268,80 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CancelJobRunRequestValidationError{}
+} = PreviewJobMappingsRequestValidationError{}
 
-// Validate checks the field values on CancelJobRunResponse with the rules
+// Validate checks the field values on JobMappingsPreviewSource with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *CancelJobRunResponse) Validate() error {
+func (m *JobMappingsPreviewSource) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on CancelJobRunResponse with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on JobMappingsPreviewSource with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// CancelJobRunResponseMultiError, or nil if none found.
-func (m *CancelJobRunResponse) ValidateAll() error {
+// JobMappingsPreviewSourceMultiError, or nil if none found.
+func (m *JobMappingsPreviewSource) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *CancelJobRunResponse) validate(all bool) error {
-	if m == nil {
-		return nil
+func (m *JobMappingsPreviewSource) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ConnectionId
+
+	for idx, item := range m.GetMappings() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, JobMappingsPreviewSourceValidationError{
+						field:  fmt.Sprintf("Mappings[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, JobMappingsPreviewSourceValidationError{
+						field:  fmt.Sprintf("Mappings[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return JobMappingsPreviewSourceValidationError{
+					field:  fmt.Sprintf("Mappings[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
 	}
 
-	var errors []error
-
 	if len(errors) > 0 {
-		return CancelJobRunResponseMultiError(errors)
+		return JobMappingsPreviewSourceMultiError(errors)
 	}
 
 	return nil
 }
 
-// CancelJobRunResponseMultiError is an error wrapping multiple validation
-// errors returned by CancelJobRunResponse.ValidateAll() if the designated
+// JobMappingsPreviewSourceMultiError is an error wrapping multiple validation
+// errors returned by JobMappingsPreviewSource.ValidateAll() if the designated
 // constraints aren't met.
-type CancelJobRunResponseMultiError []error
+type JobMappingsPreviewSourceMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m CancelJobRunResponseMultiError) Error() string {
+func (m JobMappingsPreviewSourceMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -7809,11 +15350,11 @@ func (m CancelJobRunResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m CancelJobRunResponseMultiError) AllErrors() []error { return m }
+func (m JobMappingsPreviewSourceMultiError) AllErrors() []error { return m }
 
-// CancelJobRunResponseValidationError is the validation error returned by
-// CancelJobRunResponse.Validate if the designated constraints aren't met.
-type CancelJobRunResponseValidationError struct {
+// JobMappingsPreviewSourceValidationError is the validation error returned by
+// JobMappingsPreviewSource.Validate if the designated constraints aren't met.
+type JobMappingsPreviewSourceValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -7821,24 +15362,24 @@ type CancelJobRunResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e CancelJobRunResponseValidationError) Field() string { return e.field }
+func (e JobMappingsPreviewSourceValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CancelJobRunResponseValidationError) Reason() string { return e.reason }
+func (e JobMappingsPreviewSourceValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CancelJobRunResponseValidationError) Cause() error { return e.cause }
+func (e JobMappingsPreviewSourceValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CancelJobRunResponseValidationError) Key() bool { return e.key }
+func (e JobMappingsPreviewSourceValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CancelJobRunResponseValidationError) ErrorName() string {
-	return "CancelJobRunResponseValidationError"
+func (e JobMappingsPreviewSourceValidationError) ErrorName() string {
+	return "JobMappingsPreviewSourceValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e CancelJobRunResponseValidationError) Error() string {
+func (e JobMappingsPreviewSourceValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -7850,14 +15391,14 @@ func (e CancelJobRunResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCancelJobRunResponse.%s: %s%s",
+		"invalid %sJobMappingsPreviewSource.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CancelJobRunResponseValidationError{}
+var _ error = JobMappingsPreviewSourceValidationError{}
 
 var _ interface {
 	Field() string
@@ -7865,175 +15406,47 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CancelJobRunResponseValidationError{}
+} = JobMappingsPreviewSourceValidationError{}
 
-// Validate checks the field values on Job with the rules defined in the proto
-// definition for this message. If any rules are violated, the first error
-// encountered is returned, or nil if there are no violations.
-func (m *Job) Validate() error {
+// Validate checks the field values on PreviewJobMappingsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *PreviewJobMappingsResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on Job with the rules defined in the
-// proto definition for this message. If any rules are violated, the result is
-// a list of violation errors wrapped in JobMultiError, or nil if none found.
-func (m *Job) ValidateAll() error {
+// ValidateAll checks the field values on PreviewJobMappingsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// PreviewJobMappingsResponseMultiError, or nil if none found.
+func (m *PreviewJobMappingsResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *Job) validate(all bool) error {
+func (m *PreviewJobMappingsResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Id
-
-	// no validation rules for CreatedByUserId
-
-	if all {
-		switch v := interface{}(m.GetCreatedAt()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, JobValidationError{
-					field:  "CreatedAt",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, JobValidationError{
-					field:  "CreatedAt",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return JobValidationError{
-				field:  "CreatedAt",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
-
-	// no validation rules for UpdatedByUserId
-
-	if all {
-		switch v := interface{}(m.GetUpdatedAt()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, JobValidationError{
-					field:  "UpdatedAt",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, JobValidationError{
-					field:  "UpdatedAt",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetUpdatedAt()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return JobValidationError{
-				field:  "UpdatedAt",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
-
-	// no validation rules for Name
-
-	if all {
-		switch v := interface{}(m.GetSource()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, JobValidationError{
-					field:  "Source",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, JobValidationError{
-					field:  "Source",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetSource()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return JobValidationError{
-				field:  "Source",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
-
-	for idx, item := range m.GetDestinations() {
-		_, _ = idx, item
-
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, JobValidationError{
-						field:  fmt.Sprintf("Destinations[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, JobValidationError{
-						field:  fmt.Sprintf("Destinations[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return JobValidationError{
-					field:  fmt.Sprintf("Destinations[%v]", idx),
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
-
-	}
-
-	for idx, item := range m.GetMappings() {
+	for idx, item := range m.GetTables() {
 		_, _ = idx, item
 
 		if all {
 			switch v := interface{}(item).(type) {
 			case interface{ ValidateAll() error }:
 				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, JobValidationError{
-						field:  fmt.Sprintf("Mappings[%v]", idx),
+					errors = append(errors, PreviewJobMappingsResponseValidationError{
+						field:  fmt.Sprintf("Tables[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			case interface{ Validate() error }:
 				if err := v.Validate(); err != nil {
-					errors = append(errors, JobValidationError{
-						field:  fmt.Sprintf("Mappings[%v]", idx),
+					errors = append(errors, PreviewJobMappingsResponseValidationError{
+						field:  fmt.Sprintf("Tables[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
@@ -8041,93 +15454,30 @@ func (m *Job) validate(all bool) error {
 			}
 		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				return JobValidationError{
-					field:  fmt.Sprintf("Mappings[%v]", idx),
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
-
-	}
-
-	// no validation rules for AccountId
-
-	if all {
-		switch v := interface{}(m.GetSyncOptions()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, JobValidationError{
-					field:  "SyncOptions",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, JobValidationError{
-					field:  "SyncOptions",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetSyncOptions()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return JobValidationError{
-				field:  "SyncOptions",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
-
-	if all {
-		switch v := interface{}(m.GetWorkflowOptions()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, JobValidationError{
-					field:  "WorkflowOptions",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, JobValidationError{
-					field:  "WorkflowOptions",
+				return PreviewJobMappingsResponseValidationError{
+					field:  fmt.Sprintf("Tables[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetWorkflowOptions()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return JobValidationError{
-				field:  "WorkflowOptions",
-				reason: "embedded message failed validation",
-				cause:  err,
+				}
 			}
 		}
-	}
 
-	if m.CronSchedule != nil {
-		// no validation rules for CronSchedule
 	}
 
 	if len(errors) > 0 {
-		return JobMultiError(errors)
+		return PreviewJobMappingsResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// JobMultiError is an error wrapping multiple validation errors returned by
-// Job.ValidateAll() if the designated constraints aren't met.
-type JobMultiError []error
+// PreviewJobMappingsResponseMultiError is an error wrapping multiple
+// validation errors returned by PreviewJobMappingsResponse.ValidateAll() if
+// the designated constraints aren't met.
+type PreviewJobMappingsResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m JobMultiError) Error() string {
+func (m PreviewJobMappingsResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -8136,11 +15486,11 @@ func (m JobMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m JobMultiError) AllErrors() []error { return m }
+func (m PreviewJobMappingsResponseMultiError) AllErrors() []error { return m }
 
-// JobValidationError is the validation error returned by Job.Validate if the
-// designated constraints aren't met.
-type JobValidationError struct {
+// PreviewJobMappingsResponseValidationError is the validation error returned
+// by PreviewJobMappingsResponse.Validate if the designated constraints aren't met.
+type PreviewJobMappingsResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -8148,22 +15498,24 @@ type JobValidationError struct {
 }
 
 // Field function returns field value.
-func (e JobValidationError) Field() string { return e.field }
+func (e PreviewJobMappingsResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e JobValidationError) Reason() string { return e.reason }
+func (e PreviewJobMappingsResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e JobValidationError) Cause() error { return e.cause }
+func (e PreviewJobMappingsResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e JobValidationError) Key() bool { return e.key }
+func (e PreviewJobMappingsResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e JobValidationError) ErrorName() string { return "JobValidationError" }
+func (e PreviewJobMappingsResponseValidationError) ErrorName() string {
+	return "PreviewJobMappingsResponseValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e JobValidationError) Error() string {
+func (e PreviewJobMappingsResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -8175,14 +15527,14 @@ func (e JobValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sJob.%s: %s%s",
+		"invalid %sPreviewJobMappingsResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = JobValidationError{}
+var _ error = PreviewJobMappingsResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -8190,74 +15542,82 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = JobValidationError{}
+} = PreviewJobMappingsResponseValidationError{}
 
-// Validate checks the field values on JobRecentRun with the rules defined in
-// the proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *JobRecentRun) Validate() error {
+// Validate checks the field values on JobMappingsTablePreview with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *JobMappingsTablePreview) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on JobRecentRun with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in JobRecentRunMultiError, or
-// nil if none found.
-func (m *JobRecentRun) ValidateAll() error {
+// ValidateAll checks the field values on JobMappingsTablePreview with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// JobMappingsTablePreviewMultiError, or nil if none found.
+func (m *JobMappingsTablePreview) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *JobRecentRun) validate(all bool) error {
+func (m *JobMappingsTablePreview) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if all {
-		switch v := interface{}(m.GetStartTime()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, JobRecentRunValidationError{
-					field:  "StartTime",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
+	// no validation rules for Schema
+
+	// no validation rules for Table
+
+	for idx, item := range m.GetRows() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, JobMappingsTablePreviewValidationError{
+						field:  fmt.Sprintf("Rows[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, JobMappingsTablePreviewValidationError{
+						field:  fmt.Sprintf("Rows[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
 			}
-		case interface{ Validate() error }:
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				errors = append(errors, JobRecentRunValidationError{
-					field:  "StartTime",
+				return JobMappingsTablePreviewValidationError{
+					field:  fmt.Sprintf("Rows[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetStartTime()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return JobRecentRunValidationError{
-				field:  "StartTime",
-				reason: "embedded message failed validation",
-				cause:  err,
+				}
 			}
 		}
-	}
 
-	// no validation rules for JobRunId
+	}
 
 	if len(errors) > 0 {
-		return JobRecentRunMultiError(errors)
+		return JobMappingsTablePreviewMultiError(errors)
 	}
 
 	return nil
 }
 
-// JobRecentRunMultiError is an error wrapping multiple validation errors
-// returned by JobRecentRun.ValidateAll() if the designated constraints aren't met.
-type JobRecentRunMultiError []error
+// JobMappingsTablePreviewMultiError is an error wrapping multiple validation
+// errors returned by JobMappingsTablePreview.ValidateAll() if the designated
+// constraints aren't met.
+type JobMappingsTablePreviewMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m JobRecentRunMultiError) Error() string {
+func (m JobMappingsTablePreviewMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -8266,11 +15626,11 @@ func (m JobRecentRunMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m JobRecentRunMultiError) AllErrors() []error { return m }
+func (m JobMappingsTablePreviewMultiError) AllErrors() []error { return m }
 
-// JobRecentRunValidationError is the validation error returned by
-// JobRecentRun.Validate if the designated constraints aren't met.
-type JobRecentRunValidationError struct {
+// JobMappingsTablePreviewValidationError is the validation error returned by
+// JobMappingsTablePreview.Validate if the designated constraints aren't met.
+type JobMappingsTablePreviewValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -8278,22 +15638,24 @@ type JobRecentRunValidationError struct {
 }
 
 // Field function returns field value.
-func (e JobRecentRunValidationError) Field() string { return e.field }
+func (e JobMappingsTablePreviewValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e JobRecentRunValidationError) Reason() string { return e.reason }
+func (e JobMappingsTablePreviewValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e JobRecentRunValidationError) Cause() error { return e.cause }
+func (e JobMappingsTablePreviewValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e JobRecentRunValidationError) Key() bool { return e.key }
+func (e JobMappingsTablePreviewValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e JobRecentRunValidationError) ErrorName() string { return "JobRecentRunValidationError" }
+func (e JobMappingsTablePreviewValidationError) ErrorName() string {
+	return "JobMappingsTablePreviewValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e JobRecentRunValidationError) Error() string {
+func (e JobMappingsTablePreviewValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -8305,14 +15667,14 @@ func (e JobRecentRunValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sJobRecentRun.%s: %s%s",
+		"invalid %sJobMappingsTablePreview.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = JobRecentRunValidationError{}
+var _ error = JobMappingsTablePreviewValidationError{}
 
 var _ interface {
 	Field() string
@@ -8320,46 +15682,48 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = JobRecentRunValidationError{}
+} = JobMappingsTablePreviewValidationError{}
 
-// Validate checks the field values on GetJobRecentRunsRequest with the rules
+// Validate checks the field values on JobMappingsPreviewRow with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetJobRecentRunsRequest) Validate() error {
+func (m *JobMappingsPreviewRow) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetJobRecentRunsRequest with the
-// rules defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on JobMappingsPreviewRow with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GetJobRecentRunsRequestMultiError, or nil if none found.
-func (m *GetJobRecentRunsRequest) ValidateAll() error {
+// JobMappingsPreviewRowMultiError, or nil if none found.
+func (m *JobMappingsPreviewRow) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetJobRecentRunsRequest) validate(all bool) error {
+func (m *JobMappingsPreviewRow) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for JobId
+	// no validation rules for Before
+
+	// no validation rules for After
 
 	if len(errors) > 0 {
-		return GetJobRecentRunsRequestMultiError(errors)
+		return JobMappingsPreviewRowMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetJobRecentRunsRequestMultiError is an error wrapping multiple validation
-// errors returned by GetJobRecentRunsRequest.ValidateAll() if the designated
+// JobMappingsPreviewRowMultiError is an error wrapping multiple validation
+// errors returned by JobMappingsPreviewRow.ValidateAll() if the designated
 // constraints aren't met.
-type GetJobRecentRunsRequestMultiError []error
+type JobMappingsPreviewRowMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetJobRecentRunsRequestMultiError) Error() string {
+func (m JobMappingsPreviewRowMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -8368,11 +15732,11 @@ func (m GetJobRecentRunsRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetJobRecentRunsRequestMultiError) AllErrors() []error { return m }
+func (m JobMappingsPreviewRowMultiError) AllErrors() []error { return m }
 
-// GetJobRecentRunsRequestValidationError is the validation error returned by
-// GetJobRecentRunsRequest.Validate if the designated constraints aren't met.
-type GetJobRecentRunsRequestValidationError struct {
+// JobMappingsPreviewRowValidationError is the validation error returned by
+// JobMappingsPreviewRow.Validate if the designated constraints aren't met.
+type JobMappingsPreviewRowValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -8380,24 +15744,24 @@ type GetJobRecentRunsRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetJobRecentRunsRequestValidationError) Field() string { return e.field }
+func (e JobMappingsPreviewRowValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetJobRecentRunsRequestValidationError) Reason() string { return e.reason }
+func (e JobMappingsPreviewRowValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetJobRecentRunsRequestValidationError) Cause() error { return e.cause }
+func (e JobMappingsPreviewRowValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetJobRecentRunsRequestValidationError) Key() bool { return e.key }
+func (e JobMappingsPreviewRowValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetJobRecentRunsRequestValidationError) ErrorName() string {
-	return "GetJobRecentRunsRequestValidationError"
+func (e JobMappingsPreviewRowValidationError) ErrorName() string {
+	return "JobMappingsPreviewRowValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetJobRecentRunsRequestValidationError) Error() string {
+func (e JobMappingsPreviewRowValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -8409,14 +15773,14 @@ func (e GetJobRecentRunsRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetJobRecentRunsRequest.%s: %s%s",
+		"invalid %sJobMappingsPreviewRow.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetJobRecentRunsRequestValidationError{}
+var _ error = JobMappingsPreviewRowValidationError{}
 
 var _ interface {
 	Field() string
@@ -8424,78 +15788,48 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetJobRecentRunsRequestValidationError{}
+} = JobMappingsPreviewRowValidationError{}
 
-// Validate checks the field values on GetJobRecentRunsResponse with the rules
+// Validate checks the field values on JobRunEventTaskError with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetJobRecentRunsResponse) Validate() error {
+func (m *JobRunEventTaskError) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetJobRecentRunsResponse with the
-// rules defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on JobRunEventTaskError with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GetJobRecentRunsResponseMultiError, or nil if none found.
-func (m *GetJobRecentRunsResponse) ValidateAll() error {
+// JobRunEventTaskErrorMultiError, or nil if none found.
+func (m *JobRunEventTaskError) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetJobRecentRunsResponse) validate(all bool) error {
+func (m *JobRunEventTaskError) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	for idx, item := range m.GetRecentRuns() {
-		_, _ = idx, item
-
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, GetJobRecentRunsResponseValidationError{
-						field:  fmt.Sprintf("RecentRuns[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, GetJobRecentRunsResponseValidationError{
-						field:  fmt.Sprintf("RecentRuns[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return GetJobRecentRunsResponseValidationError{
-					field:  fmt.Sprintf("RecentRuns[%v]", idx),
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
+	// no validation rules for Message
 
-	}
+	// no validation rules for RetryState
 
 	if len(errors) > 0 {
-		return GetJobRecentRunsResponseMultiError(errors)
+		return JobRunEventTaskErrorMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetJobRecentRunsResponseMultiError is an error wrapping multiple validation
-// errors returned by GetJobRecentRunsResponse.ValidateAll() if the designated
+// JobRunEventTaskErrorMultiError is an error wrapping multiple validation
+// errors returned by JobRunEventTaskError.ValidateAll() if the designated
 // constraints aren't met.
-type GetJobRecentRunsResponseMultiError []error
+type JobRunEventTaskErrorMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetJobRecentRunsResponseMultiError) Error() string {
+func (m JobRunEventTaskErrorMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -8504,11 +15838,11 @@ func (m GetJobRecentRunsResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetJobRecentRunsResponseMultiError) AllErrors() []error { return m }
+func (m JobRunEventTaskErrorMultiError) AllErrors() []error { return m }
 
-// GetJobRecentRunsResponseValidationError is the validation error returned by
-// GetJobRecentRunsResponse.Validate if the designated constraints aren't met.
-type GetJobRecentRunsResponseValidationError struct {
+// JobRunEventTaskErrorValidationError is the validation error returned by
+// JobRunEventTaskError.Validate if the designated constraints aren't met.
+type JobRunEventTaskErrorValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -8516,24 +15850,24 @@ type GetJobRecentRunsResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetJobRecentRunsResponseValidationError) Field() string { return e.field }
+func (e JobRunEventTaskErrorValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetJobRecentRunsResponseValidationError) Reason() string { return e.reason }
+func (e JobRunEventTaskErrorValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetJobRecentRunsResponseValidationError) Cause() error { return e.cause }
+func (e JobRunEventTaskErrorValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetJobRecentRunsResponseValidationError) Key() bool { return e.key }
+func (e JobRunEventTaskErrorValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetJobRecentRunsResponseValidationError) ErrorName() string {
-	return "GetJobRecentRunsResponseValidationError"
+func (e JobRunEventTaskErrorValidationError) ErrorName() string {
+	return "JobRunEventTaskErrorValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetJobRecentRunsResponseValidationError) Error() string {
+func (e JobRunEventTaskErrorValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -8545,14 +15879,14 @@ func (e GetJobRecentRunsResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetJobRecentRunsResponse.%s: %s%s",
+		"invalid %sJobRunEventTaskError.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetJobRecentRunsResponseValidationError{}
+var _ error = JobRunEventTaskErrorValidationError{}
 
 var _ interface {
 	Field() string
@@ -8560,77 +15894,106 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetJobRecentRunsResponseValidationError{}
+} = JobRunEventTaskErrorValidationError{}
 
-// Validate checks the field values on JobNextRuns with the rules defined in
-// the proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *JobNextRuns) Validate() error {
+// Validate checks the field values on JobRunEventTask with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *JobRunEventTask) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on JobNextRuns with the rules defined in
-// the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in JobNextRunsMultiError, or
-// nil if none found.
-func (m *JobNextRuns) ValidateAll() error {
+// ValidateAll checks the field values on JobRunEventTask with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// JobRunEventTaskMultiError, or nil if none found.
+func (m *JobRunEventTask) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *JobNextRuns) validate(all bool) error {
+func (m *JobRunEventTask) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
-	var errors []error
-
-	for idx, item := range m.GetNextRunTimes() {
-		_, _ = idx, item
-
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, JobNextRunsValidationError{
-						field:  fmt.Sprintf("NextRunTimes[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, JobNextRunsValidationError{
-						field:  fmt.Sprintf("NextRunTimes[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Type
+
+	if all {
+		switch v := interface{}(m.GetEventTime()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, JobRunEventTaskValidationError{
+					field:  "EventTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, JobRunEventTaskValidationError{
+					field:  "EventTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetEventTime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return JobRunEventTaskValidationError{
+				field:  "EventTime",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetError()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, JobRunEventTaskValidationError{
+					field:  "Error",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
 			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				return JobNextRunsValidationError{
-					field:  fmt.Sprintf("NextRunTimes[%v]", idx),
+				errors = append(errors, JobRunEventTaskValidationError{
+					field:  "Error",
 					reason: "embedded message failed validation",
 					cause:  err,
-				}
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetError()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return JobRunEventTaskValidationError{
+				field:  "Error",
+				reason: "embedded message failed validation",
+				cause:  err,
 			}
 		}
-
 	}
 
 	if len(errors) > 0 {
-		return JobNextRunsMultiError(errors)
+		return JobRunEventTaskMultiError(errors)
 	}
 
 	return nil
 }
 
-// JobNextRunsMultiError is an error wrapping multiple validation errors
-// returned by JobNextRuns.ValidateAll() if the designated constraints aren't met.
-type JobNextRunsMultiError []error
+// JobRunEventTaskMultiError is an error wrapping multiple validation errors
+// returned by JobRunEventTask.ValidateAll() if the designated constraints
+// aren't met.
+type JobRunEventTaskMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m JobNextRunsMultiError) Error() string {
+func (m JobRunEventTaskMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -8639,11 +16002,11 @@ func (m JobNextRunsMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m JobNextRunsMultiError) AllErrors() []error { return m }
+func (m JobRunEventTaskMultiError) AllErrors() []error { return m }
 
-// JobNextRunsValidationError is the validation error returned by
-// JobNextRuns.Validate if the designated constraints aren't met.
-type JobNextRunsValidationError struct {
+// JobRunEventTaskValidationError is the validation error returned by
+// JobRunEventTask.Validate if the designated constraints aren't met.
+type JobRunEventTaskValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -8651,22 +16014,22 @@ type JobNextRunsValidationError struct {
 }
 
 // Field function returns field value.
-func (e JobNextRunsValidationError) Field() string { return e.field }
+func (e JobRunEventTaskValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e JobNextRunsValidationError) Reason() string { return e.reason }
+func (e JobRunEventTaskValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e JobNextRunsValidationError) Cause() error { return e.cause }
+func (e JobRunEventTaskValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e JobNextRunsValidationError) Key() bool { return e.key }
+func (e JobRunEventTaskValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e JobNextRunsValidationError) ErrorName() string { return "JobNextRunsValidationError" }
+func (e JobRunEventTaskValidationError) ErrorName() string { return "JobRunEventTaskValidationError" }
 
 // Error satisfies the builtin error interface
-func (e JobNextRunsValidationError) Error() string {
+func (e JobRunEventTaskValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -8678,14 +16041,14 @@ func (e JobNextRunsValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sJobNextRuns.%s: %s%s",
+		"invalid %sJobRunEventTask.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = JobNextRunsValidationError{}
+var _ error = JobRunEventTaskValidationError{}
 
 var _ interface {
 	Field() string
@@ -8693,46 +16056,48 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = JobNextRunsValidationError{}
+} = JobRunEventTaskValidationError{}
 
-// Validate checks the field values on GetJobNextRunsRequest with the rules
+// Validate checks the field values on JobRunSyncMetadata with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetJobNextRunsRequest) Validate() error {
+func (m *JobRunSyncMetadata) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetJobNextRunsRequest with the rules
+// ValidateAll checks the field values on JobRunSyncMetadata with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GetJobNextRunsRequestMultiError, or nil if none found.
-func (m *GetJobNextRunsRequest) ValidateAll() error {
+// JobRunSyncMetadataMultiError, or nil if none found.
+func (m *JobRunSyncMetadata) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetJobNextRunsRequest) validate(all bool) error {
+func (m *JobRunSyncMetadata) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for JobId
+	// no validation rules for Schema
+
+	// no validation rules for Table
 
 	if len(errors) > 0 {
-		return GetJobNextRunsRequestMultiError(errors)
+		return JobRunSyncMetadataMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetJobNextRunsRequestMultiError is an error wrapping multiple validation
-// errors returned by GetJobNextRunsRequest.ValidateAll() if the designated
-// constraints aren't met.
-type GetJobNextRunsRequestMultiError []error
+// JobRunSyncMetadataMultiError is an error wrapping multiple validation errors
+// returned by JobRunSyncMetadata.ValidateAll() if the designated constraints
+// aren't met.
+type JobRunSyncMetadataMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetJobNextRunsRequestMultiError) Error() string {
+func (m JobRunSyncMetadataMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -8741,11 +16106,11 @@ func (m GetJobNextRunsRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetJobNextRunsRequestMultiError) AllErrors() []error { return m }
+func (m JobRunSyncMetadataMultiError) AllErrors() []error { return m }
 
-// GetJobNextRunsRequestValidationError is the validation error returned by
-// GetJobNextRunsRequest.Validate if the designated constraints aren't met.
-type GetJobNextRunsRequestValidationError struct {
+// JobRunSyncMetadataValidationError is the validation error returned by
+// JobRunSyncMetadata.Validate if the designated constraints aren't met.
+type JobRunSyncMetadataValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -8753,24 +16118,24 @@ type GetJobNextRunsRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetJobNextRunsRequestValidationError) Field() string { return e.field }
+func (e JobRunSyncMetadataValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetJobNextRunsRequestValidationError) Reason() string { return e.reason }
+func (e JobRunSyncMetadataValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetJobNextRunsRequestValidationError) Cause() error { return e.cause }
+func (e JobRunSyncMetadataValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetJobNextRunsRequestValidationError) Key() bool { return e.key }
+func (e JobRunSyncMetadataValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetJobNextRunsRequestValidationError) ErrorName() string {
-	return "GetJobNextRunsRequestValidationError"
+func (e JobRunSyncMetadataValidationError) ErrorName() string {
+	return "JobRunSyncMetadataValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetJobNextRunsRequestValidationError) Error() string {
+func (e JobRunSyncMetadataValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -8782,14 +16147,14 @@ func (e GetJobNextRunsRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetJobNextRunsRequest.%s: %s%s",
+		"invalid %sJobRunSyncMetadata.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetJobNextRunsRequestValidationError{}
+var _ error = JobRunSyncMetadataValidationError{}
 
 var _ interface {
 	Field() string
@@ -8797,73 +16162,90 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetJobNextRunsRequestValidationError{}
+} = JobRunSyncMetadataValidationError{}
 
-// Validate checks the field values on GetJobNextRunsResponse with the rules
+// Validate checks the field values on JobRunEventMetadata with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetJobNextRunsResponse) Validate() error {
+func (m *JobRunEventMetadata) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetJobNextRunsResponse with the rules
+// ValidateAll checks the field values on JobRunEventMetadata with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GetJobNextRunsResponseMultiError, or nil if none found.
-func (m *GetJobNextRunsResponse) ValidateAll() error {
+// JobRunEventMetadataMultiError, or nil if none found.
+func (m *JobRunEventMetadata) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetJobNextRunsResponse) validate(all bool) error {
+func (m *JobRunEventMetadata) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if all {
-		switch v := interface{}(m.GetNextRuns()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, GetJobNextRunsResponseValidationError{
-					field:  "NextRuns",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
+	switch v := m.Metadata.(type) {
+	case *JobRunEventMetadata_SyncMetadata:
+		if v == nil {
+			err := JobRunEventMetadataValidationError{
+				field:  "Metadata",
+				reason: "oneof value cannot be a typed-nil",
 			}
-		case interface{ Validate() error }:
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetSyncMetadata()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, JobRunEventMetadataValidationError{
+						field:  "SyncMetadata",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, JobRunEventMetadataValidationError{
+						field:  "SyncMetadata",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetSyncMetadata()).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				errors = append(errors, GetJobNextRunsResponseValidationError{
-					field:  "NextRuns",
+				return JobRunEventMetadataValidationError{
+					field:  "SyncMetadata",
 					reason: "embedded message failed validation",
 					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetNextRuns()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return GetJobNextRunsResponseValidationError{
-				field:  "NextRuns",
-				reason: "embedded message failed validation",
-				cause:  err,
+				}
 			}
 		}
+
+	default:
+		_ = v // ensures v is used
 	}
 
 	if len(errors) > 0 {
-		return GetJobNextRunsResponseMultiError(errors)
+		return JobRunEventMetadataMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetJobNextRunsResponseMultiError is an error wrapping multiple validation
-// errors returned by GetJobNextRunsResponse.ValidateAll() if the designated
+// JobRunEventMetadataMultiError is an error wrapping multiple validation
+// errors returned by JobRunEventMetadata.ValidateAll() if the designated
 // constraints aren't met.
-type GetJobNextRunsResponseMultiError []error
+type JobRunEventMetadataMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetJobNextRunsResponseMultiError) Error() string {
+func (m JobRunEventMetadataMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -8872,11 +16254,11 @@ func (m GetJobNextRunsResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetJobNextRunsResponseMultiError) AllErrors() []error { return m }
+func (m JobRunEventMetadataMultiError) AllErrors() []error { return m }
 
-// GetJobNextRunsResponseValidationError is the validation error returned by
-// GetJobNextRunsResponse.Validate if the designated constraints aren't met.
-type GetJobNextRunsResponseValidationError struct {
+// JobRunEventMetadataValidationError is the validation error returned by
+// JobRunEventMetadata.Validate if the designated constraints aren't met.
+type JobRunEventMetadataValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -8884,24 +16266,24 @@ type GetJobNextRunsResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetJobNextRunsResponseValidationError) Field() string { return e.field }
+func (e JobRunEventMetadataValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetJobNextRunsResponseValidationError) Reason() string { return e.reason }
+func (e JobRunEventMetadataValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetJobNextRunsResponseValidationError) Cause() error { return e.cause }
+func (e JobRunEventMetadataValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetJobNextRunsResponseValidationError) Key() bool { return e.key }
+func (e JobRunEventMetadataValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetJobNextRunsResponseValidationError) ErrorName() string {
-	return "GetJobNextRunsResponseValidationError"
+func (e JobRunEventMetadataValidationError) ErrorName() string {
+	return "JobRunEventMetadataValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetJobNextRunsResponseValidationError) Error() string {
+func (e JobRunEventMetadataValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -8913,14 +16295,14 @@ func (e GetJobNextRunsResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetJobNextRunsResponse.%s: %s%s",
+		"invalid %sJobRunEventMetadata.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetJobNextRunsResponseValidationError{}
+var _ error = JobRunEventMetadataValidationError{}
 
 var _ interface {
 	Field() string
@@ -8928,46 +16310,168 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetJobNextRunsResponseValidationError{}
+} = JobRunEventMetadataValidationError{}
 
-// Validate checks the field values on GetJobStatusRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetJobStatusRequest) Validate() error {
+// Validate checks the field values on JobRunEvent with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *JobRunEvent) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetJobStatusRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// GetJobStatusRequestMultiError, or nil if none found.
-func (m *GetJobStatusRequest) ValidateAll() error {
+// ValidateAll checks the field values on JobRunEvent with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in JobRunEventMultiError, or
+// nil if none found.
+func (m *JobRunEvent) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetJobStatusRequest) validate(all bool) error {
+func (m *JobRunEvent) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for JobId
+	// no validation rules for Id
+
+	// no validation rules for Type
+
+	if all {
+		switch v := interface{}(m.GetStartTime()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, JobRunEventValidationError{
+					field:  "StartTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, JobRunEventValidationError{
+					field:  "StartTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetStartTime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return JobRunEventValidationError{
+				field:  "StartTime",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetCloseTime()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, JobRunEventValidationError{
+					field:  "CloseTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, JobRunEventValidationError{
+					field:  "CloseTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCloseTime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return JobRunEventValidationError{
+				field:  "CloseTime",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetMetadata()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, JobRunEventValidationError{
+					field:  "Metadata",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, JobRunEventValidationError{
+					field:  "Metadata",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetMetadata()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return JobRunEventValidationError{
+				field:  "Metadata",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	for idx, item := range m.GetTasks() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, JobRunEventValidationError{
+						field:  fmt.Sprintf("Tasks[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, JobRunEventValidationError{
+						field:  fmt.Sprintf("Tasks[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return JobRunEventValidationError{
+					field:  fmt.Sprintf("Tasks[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
 
 	if len(errors) > 0 {
-		return GetJobStatusRequestMultiError(errors)
+		return JobRunEventMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetJobStatusRequestMultiError is an error wrapping multiple validation
-// errors returned by GetJobStatusRequest.ValidateAll() if the designated
-// constraints aren't met.
-type GetJobStatusRequestMultiError []error
+// JobRunEventMultiError is an error wrapping multiple validation errors
+// returned by JobRunEvent.ValidateAll() if the designated constraints aren't met.
+type JobRunEventMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetJobStatusRequestMultiError) Error() string {
+func (m JobRunEventMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -8976,11 +16480,11 @@ func (m GetJobStatusRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetJobStatusRequestMultiError) AllErrors() []error { return m }
+func (m JobRunEventMultiError) AllErrors() []error { return m }
 
-// GetJobStatusRequestValidationError is the validation error returned by
-// GetJobStatusRequest.Validate if the designated constraints aren't met.
-type GetJobStatusRequestValidationError struct {
+// JobRunEventValidationError is the validation error returned by
+// JobRunEvent.Validate if the designated constraints aren't met.
+type JobRunEventValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -8988,24 +16492,22 @@ type GetJobStatusRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetJobStatusRequestValidationError) Field() string { return e.field }
+func (e JobRunEventValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetJobStatusRequestValidationError) Reason() string { return e.reason }
+func (e JobRunEventValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetJobStatusRequestValidationError) Cause() error { return e.cause }
+func (e JobRunEventValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetJobStatusRequestValidationError) Key() bool { return e.key }
+func (e JobRunEventValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetJobStatusRequestValidationError) ErrorName() string {
-	return "GetJobStatusRequestValidationError"
-}
+func (e JobRunEventValidationError) ErrorName() string { return "JobRunEventValidationError" }
 
 // Error satisfies the builtin error interface
-func (e GetJobStatusRequestValidationError) Error() string {
+func (e JobRunEventValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -9017,14 +16519,14 @@ func (e GetJobStatusRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetJobStatusRequest.%s: %s%s",
+		"invalid %sJobRunEvent.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetJobStatusRequestValidationError{}
+var _ error = JobRunEventValidationError{}
 
 var _ interface {
 	Field() string
@@ -9032,46 +16534,48 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetJobStatusRequestValidationError{}
+} = JobRunEventValidationError{}
 
-// Validate checks the field values on GetJobStatusResponse with the rules
+// Validate checks the field values on GetJobRunEventsRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetJobStatusResponse) Validate() error {
+func (m *GetJobRunEventsRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetJobStatusResponse with the rules
+// ValidateAll checks the field values on GetJobRunEventsRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GetJobStatusResponseMultiError, or nil if none found.
-func (m *GetJobStatusResponse) ValidateAll() error {
+// GetJobRunEventsRequestMultiError, or nil if none found.
+func (m *GetJobRunEventsRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetJobStatusResponse) validate(all bool) error {
+func (m *GetJobRunEventsRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Status
+	// no validation rules for JobRunId
+
+	// no validation rules for AccountId
 
 	if len(errors) > 0 {
-		return GetJobStatusResponseMultiError(errors)
+		return GetJobRunEventsRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetJobStatusResponseMultiError is an error wrapping multiple validation
-// errors returned by GetJobStatusResponse.ValidateAll() if the designated
+// GetJobRunEventsRequestMultiError is an error wrapping multiple validation
+// errors returned by GetJobRunEventsRequest.ValidateAll() if the designated
 // constraints aren't met.
-type GetJobStatusResponseMultiError []error
+type GetJobRunEventsRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetJobStatusResponseMultiError) Error() string {
+func (m GetJobRunEventsRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -9080,11 +16584,11 @@ func (m GetJobStatusResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetJobStatusResponseMultiError) AllErrors() []error { return m }
+func (m GetJobRunEventsRequestMultiError) AllErrors() []error { return m }
 
-// GetJobStatusResponseValidationError is the validation error returned by
-// GetJobStatusResponse.Validate if the designated constraints aren't met.
-type GetJobStatusResponseValidationError struct {
+// GetJobRunEventsRequestValidationError is the validation error returned by
+// GetJobRunEventsRequest.Validate if the designated constraints aren't met.
+type GetJobRunEventsRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -9092,24 +16596,24 @@ type GetJobStatusResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetJobStatusResponseValidationError) Field() string { return e.field }
+func (e GetJobRunEventsRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetJobStatusResponseValidationError) Reason() string { return e.reason }
+func (e GetJobRunEventsRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetJobStatusResponseValidationError) Cause() error { return e.cause }
+func (e GetJobRunEventsRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetJobStatusResponseValidationError) Key() bool { return e.key }
+func (e GetJobRunEventsRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetJobStatusResponseValidationError) ErrorName() string {
-	return "GetJobStatusResponseValidationError"
+func (e GetJobRunEventsRequestValidationError) ErrorName() string {
+	return "GetJobRunEventsRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetJobStatusResponseValidationError) Error() string {
+func (e GetJobRunEventsRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -9121,14 +16625,14 @@ func (e GetJobStatusResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetJobStatusResponse.%s: %s%s",
+		"invalid %sGetJobRunEventsRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetJobStatusResponseValidationError{}
+var _ error = GetJobRunEventsRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -9136,48 +16640,80 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetJobStatusResponseValidationError{}
-
-// Validate checks the field values on JobStatusRecord with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *JobStatusRecord) Validate() error {
+} = GetJobRunEventsRequestValidationError{}
+
+// Validate checks the field values on GetJobRunEventsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetJobRunEventsResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on JobStatusRecord with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on GetJobRunEventsResponse with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// JobStatusRecordMultiError, or nil if none found.
-func (m *JobStatusRecord) ValidateAll() error {
+// GetJobRunEventsResponseMultiError, or nil if none found.
+func (m *GetJobRunEventsResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *JobStatusRecord) validate(all bool) error {
+func (m *GetJobRunEventsResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for JobId
+	for idx, item := range m.GetEvents() {
+		_, _ = idx, item
 
-	// no validation rules for Status
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetJobRunEventsResponseValidationError{
+						field:  fmt.Sprintf("Events[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetJobRunEventsResponseValidationError{
+						field:  fmt.Sprintf("Events[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetJobRunEventsResponseValidationError{
+					field:  fmt.Sprintf("Events[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for IsRunComplete
 
 	if len(errors) > 0 {
-		return JobStatusRecordMultiError(errors)
+		return GetJobRunEventsResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// JobStatusRecordMultiError is an error wrapping multiple validation errors
-// returned by JobStatusRecord.ValidateAll() if the designated constraints
-// aren't met.
-type JobStatusRecordMultiError []error
+// GetJobRunEventsResponseMultiError is an error wrapping multiple validation
+// errors returned by GetJobRunEventsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type GetJobRunEventsResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m JobStatusRecordMultiError) Error() string {
+func (m GetJobRunEventsResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -9186,11 +16722,11 @@ func (m JobStatusRecordMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m JobStatusRecordMultiError) AllErrors() []error { return m }
+func (m GetJobRunEventsResponseMultiError) AllErrors() []error { return m }
 
-// JobStatusRecordValidationError is the validation error returned by
-// JobStatusRecord.Validate if the designated constraints aren't met.
-type JobStatusRecordValidationError struct {
+// GetJobRunEventsResponseValidationError is the validation error returned by
+// GetJobRunEventsResponse.Validate if the designated constraints aren't met.
+type GetJobRunEventsResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -9198,22 +16734,24 @@ type JobStatusRecordValidationError struct {
 }
 
 // Field function returns field value.
-func (e JobStatusRecordValidationError) Field() string { return e.field }
+func (e GetJobRunEventsResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e JobStatusRecordValidationError) Reason() string { return e.reason }
+func (e GetJobRunEventsResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e JobStatusRecordValidationError) Cause() error { return e.cause }
+func (e GetJobRunEventsResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e JobStatusRecordValidationError) Key() bool { return e.key }
+func (e GetJobRunEventsResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e JobStatusRecordValidationError) ErrorName() string { return "JobStatusRecordValidationError" }
+func (e GetJobRunEventsResponseValidationError) ErrorName() string {
+	return "GetJobRunEventsResponseValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e JobStatusRecordValidationError) Error() string {
+func (e GetJobRunEventsResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -9225,14 +16763,14 @@ func (e JobStatusRecordValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sJobStatusRecord.%s: %s%s",
+		"invalid %sGetJobRunEventsResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = JobStatusRecordValidationError{}
+var _ error = GetJobRunEventsResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -9240,46 +16778,48 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = JobStatusRecordValidationError{}
+} = GetJobRunEventsResponseValidationError{}
 
-// Validate checks the field values on GetJobStatusesRequest with the rules
+// Validate checks the field values on DeleteJobRunRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetJobStatusesRequest) Validate() error {
+func (m *DeleteJobRunRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetJobStatusesRequest with the rules
+// ValidateAll checks the field values on DeleteJobRunRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GetJobStatusesRequestMultiError, or nil if none found.
-func (m *GetJobStatusesRequest) ValidateAll() error {
+// DeleteJobRunRequestMultiError, or nil if none found.
+func (m *DeleteJobRunRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetJobStatusesRequest) validate(all bool) error {
+func (m *DeleteJobRunRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	// no validation rules for JobRunId
+
 	// no validation rules for AccountId
 
 	if len(errors) > 0 {
-		return GetJobStatusesRequestMultiError(errors)
+		return DeleteJobRunRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetJobStatusesRequestMultiError is an error wrapping multiple validation
-// errors returned by GetJobStatusesRequest.ValidateAll() if the designated
+// DeleteJobRunRequestMultiError is an error wrapping multiple validation
+// errors returned by DeleteJobRunRequest.ValidateAll() if the designated
 // constraints aren't met.
-type GetJobStatusesRequestMultiError []error
+type DeleteJobRunRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetJobStatusesRequestMultiError) Error() string {
+func (m DeleteJobRunRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -9288,11 +16828,11 @@ func (m GetJobStatusesRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetJobStatusesRequestMultiError) AllErrors() []error { return m }
+func (m DeleteJobRunRequestMultiError) AllErrors() []error { return m }
 
-// GetJobStatusesRequestValidationError is the validation error returned by
-// GetJobStatusesRequest.Validate if the designated constraints aren't met.
-type GetJobStatusesRequestValidationError struct {
+// DeleteJobRunRequestValidationError is the validation error returned by
+// DeleteJobRunRequest.Validate if the designated constraints aren't met.
+type DeleteJobRunRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -9300,24 +16840,24 @@ type GetJobStatusesRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetJobStatusesRequestValidationError) Field() string { return e.field }
+func (e DeleteJobRunRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetJobStatusesRequestValidationError) Reason() string { return e.reason }
+func (e DeleteJobRunRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetJobStatusesRequestValidationError) Cause() error { return e.cause }
+func (e DeleteJobRunRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetJobStatusesRequestValidationError) Key() bool { return e.key }
+func (e DeleteJobRunRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetJobStatusesRequestValidationError) ErrorName() string {
-	return "GetJobStatusesRequestValidationError"
+func (e DeleteJobRunRequestValidationError) ErrorName() string {
+	return "DeleteJobRunRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetJobStatusesRequestValidationError) Error() string {
+func (e DeleteJobRunRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -9329,14 +16869,14 @@ func (e GetJobStatusesRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetJobStatusesRequest.%s: %s%s",
+		"invalid %sDeleteJobRunRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetJobStatusesRequestValidationError{}
+var _ error = DeleteJobRunRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -9344,78 +16884,44 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetJobStatusesRequestValidationError{}
+} = DeleteJobRunRequestValidationError{}
 
-// Validate checks the field values on GetJobStatusesResponse with the rules
+// Validate checks the field values on DeleteJobRunResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetJobStatusesResponse) Validate() error {
+func (m *DeleteJobRunResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetJobStatusesResponse with the rules
+// ValidateAll checks the field values on DeleteJobRunResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GetJobStatusesResponseMultiError, or nil if none found.
-func (m *GetJobStatusesResponse) ValidateAll() error {
+// DeleteJobRunResponseMultiError, or nil if none found.
+func (m *DeleteJobRunResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetJobStatusesResponse) validate(all bool) error {
+func (m *DeleteJobRunResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	for idx, item := range m.GetStatuses() {
-		_, _ = idx, item
-
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, GetJobStatusesResponseValidationError{
-						field:  fmt.Sprintf("Statuses[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, GetJobStatusesResponseValidationError{
-						field:  fmt.Sprintf("Statuses[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return GetJobStatusesResponseValidationError{
-					field:  fmt.Sprintf("Statuses[%v]", idx),
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
-
-	}
-
 	if len(errors) > 0 {
-		return GetJobStatusesResponseMultiError(errors)
+		return DeleteJobRunResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetJobStatusesResponseMultiError is an error wrapping multiple validation
-// errors returned by GetJobStatusesResponse.ValidateAll() if the designated
+// DeleteJobRunResponseMultiError is an error wrapping multiple validation
+// errors returned by DeleteJobRunResponse.ValidateAll() if the designated
 // constraints aren't met.
-type GetJobStatusesResponseMultiError []error
+type DeleteJobRunResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetJobStatusesResponseMultiError) Error() string {
+func (m DeleteJobRunResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -9424,11 +16930,11 @@ func (m GetJobStatusesResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetJobStatusesResponseMultiError) AllErrors() []error { return m }
+func (m DeleteJobRunResponseMultiError) AllErrors() []error { return m }
 
-// GetJobStatusesResponseValidationError is the validation error returned by
-// GetJobStatusesResponse.Validate if the designated constraints aren't met.
-type GetJobStatusesResponseValidationError struct {
+// DeleteJobRunResponseValidationError is the validation error returned by
+// DeleteJobRunResponse.Validate if the designated constraints aren't met.
+type DeleteJobRunResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -9436,24 +16942,24 @@ type GetJobStatusesResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetJobStatusesResponseValidationError) Field() string { return e.field }
+func (e DeleteJobRunResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetJobStatusesResponseValidationError) Reason() string { return e.reason }
+func (e DeleteJobRunResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetJobStatusesResponseValidationError) Cause() error { return e.cause }
+func (e DeleteJobRunResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetJobStatusesResponseValidationError) Key() bool { return e.key }
+func (e DeleteJobRunResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetJobStatusesResponseValidationError) ErrorName() string {
-	return "GetJobStatusesResponseValidationError"
+func (e DeleteJobRunResponseValidationError) ErrorName() string {
+	return "DeleteJobRunResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetJobStatusesResponseValidationError) Error() string {
+func (e DeleteJobRunResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -9465,14 +16971,14 @@ func (e GetJobStatusesResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetJobStatusesResponse.%s: %s%s",
+		"invalid %sDeleteJobRunResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetJobStatusesResponseValidationError{}
+var _ error = DeleteJobRunResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -9480,46 +16986,48 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetJobStatusesResponseValidationError{}
+} = DeleteJobRunResponseValidationError{}
 
-// Validate checks the field values on ActivityFailure with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *ActivityFailure) Validate() error {
+// Validate checks the field values on TerminateJobRunRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *TerminateJobRunRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ActivityFailure with the rules
+// ValidateAll checks the field values on TerminateJobRunRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// ActivityFailureMultiError, or nil if none found.
-func (m *ActivityFailure) ValidateAll() error {
+// TerminateJobRunRequestMultiError, or nil if none found.
+func (m *TerminateJobRunRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ActivityFailure) validate(all bool) error {
+func (m *TerminateJobRunRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Message
+	// no validation rules for JobRunId
+
+	// no validation rules for AccountId
 
 	if len(errors) > 0 {
-		return ActivityFailureMultiError(errors)
+		return TerminateJobRunRequestMultiError(errors)
 	}
 
-	return nil
-}
-
-// ActivityFailureMultiError is an error wrapping multiple validation errors
-// returned by ActivityFailure.ValidateAll() if the designated constraints
-// aren't met.
-type ActivityFailureMultiError []error
+	return nil
+}
+
+// TerminateJobRunRequestMultiError is an error wrapping multiple validation
+// errors returned by TerminateJobRunRequest.ValidateAll() if the designated
+// constraints aren't met.
+type TerminateJobRunRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ActivityFailureMultiError) Error() string {
+func (m TerminateJobRunRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -9528,11 +17036,11 @@ func (m ActivityFailureMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ActivityFailureMultiError) AllErrors() []error { return m }
+func (m TerminateJobRunRequestMultiError) AllErrors() []error { return m }
 
-// ActivityFailureValidationError is the validation error returned by
-// ActivityFailure.Validate if the designated constraints aren't met.
-type ActivityFailureValidationError struct {
+// TerminateJobRunRequestValidationError is the validation error returned by
+// TerminateJobRunRequest.Validate if the designated constraints aren't met.
+type TerminateJobRunRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -9540,22 +17048,24 @@ type ActivityFailureValidationError struct {
 }
 
 // Field function returns field value.
-func (e ActivityFailureValidationError) Field() string { return e.field }
+func (e TerminateJobRunRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ActivityFailureValidationError) Reason() string { return e.reason }
+func (e TerminateJobRunRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ActivityFailureValidationError) Cause() error { return e.cause }
+func (e TerminateJobRunRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ActivityFailureValidationError) Key() bool { return e.key }
+func (e TerminateJobRunRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ActivityFailureValidationError) ErrorName() string { return "ActivityFailureValidationError" }
+func (e TerminateJobRunRequestValidationError) ErrorName() string {
+	return "TerminateJobRunRequestValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e ActivityFailureValidationError) Error() string {
+func (e TerminateJobRunRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -9567,14 +17077,14 @@ func (e ActivityFailureValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sActivityFailure.%s: %s%s",
+		"invalid %sTerminateJobRunRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ActivityFailureValidationError{}
+var _ error = TerminateJobRunRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -9582,81 +17092,44 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ActivityFailureValidationError{}
+} = TerminateJobRunRequestValidationError{}
 
-// Validate checks the field values on PendingActivity with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *PendingActivity) Validate() error {
+// Validate checks the field values on TerminateJobRunResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *TerminateJobRunResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on PendingActivity with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on TerminateJobRunResponse with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// PendingActivityMultiError, or nil if none found.
-func (m *PendingActivity) ValidateAll() error {
+// TerminateJobRunResponseMultiError, or nil if none found.
+func (m *TerminateJobRunResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *PendingActivity) validate(all bool) error {
+func (m *TerminateJobRunResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Status
-
-	// no validation rules for ActivityName
-
-	if m.LastFailure != nil {
-
-		if all {
-			switch v := interface{}(m.GetLastFailure()).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, PendingActivityValidationError{
-						field:  "LastFailure",
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, PendingActivityValidationError{
-						field:  "LastFailure",
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(m.GetLastFailure()).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return PendingActivityValidationError{
-					field:  "LastFailure",
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
-
-	}
-
 	if len(errors) > 0 {
-		return PendingActivityMultiError(errors)
+		return TerminateJobRunResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// PendingActivityMultiError is an error wrapping multiple validation errors
-// returned by PendingActivity.ValidateAll() if the designated constraints
-// aren't met.
-type PendingActivityMultiError []error
+// TerminateJobRunResponseMultiError is an error wrapping multiple validation
+// errors returned by TerminateJobRunResponse.ValidateAll() if the designated
+// constraints aren't met.
+type TerminateJobRunResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m PendingActivityMultiError) Error() string {
+func (m TerminateJobRunResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -9665,11 +17138,11 @@ func (m PendingActivityMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m PendingActivityMultiError) AllErrors() []error { return m }
+func (m TerminateJobRunResponseMultiError) AllErrors() []error { return m }
 
-// PendingActivityValidationError is the validation error returned by
-// PendingActivity.Validate if the designated constraints aren't met.
-type PendingActivityValidationError struct {
+// TerminateJobRunResponseValidationError is the validation error returned by
+// TerminateJobRunResponse.Validate if the designated constraints aren't met.
+type TerminateJobRunResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -9677,22 +17150,24 @@ type PendingActivityValidationError struct {
 }
 
 // Field function returns field value.
-func (e PendingActivityValidationError) Field() string { return e.field }
+func (e TerminateJobRunResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e PendingActivityValidationError) Reason() string { return e.reason }
+func (e TerminateJobRunResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e PendingActivityValidationError) Cause() error { return e.cause }
+func (e TerminateJobRunResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e PendingActivityValidationError) Key() bool { return e.key }
+func (e TerminateJobRunResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e PendingActivityValidationError) ErrorName() string { return "PendingActivityValidationError" }
+func (e TerminateJobRunResponseValidationError) ErrorName() string {
+	return "TerminateJobRunResponseValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e PendingActivityValidationError) Error() string {
+func (e TerminateJobRunResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -9704,14 +17179,14 @@ func (e PendingActivityValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sPendingActivity.%s: %s%s",
+		"invalid %sTerminateJobRunResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = PendingActivityValidationError{}
+var _ error = TerminateJobRunResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -9719,146 +17194,56 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = PendingActivityValidationError{}
+} = TerminateJobRunResponseValidationError{}
 
-// Validate checks the field values on JobRun with the rules defined in the
-// proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *JobRun) Validate() error {
+// Validate checks the field values on GetJobRunLogsStreamRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetJobRunLogsStreamRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on JobRun with the rules defined in the
-// proto definition for this message. If any rules are violated, the result is
-// a list of violation errors wrapped in JobRunMultiError, or nil if none found.
-func (m *JobRun) ValidateAll() error {
+// ValidateAll checks the field values on GetJobRunLogsStreamRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetJobRunLogsStreamRequestMultiError, or nil if none found.
+func (m *GetJobRunLogsStreamRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *JobRun) validate(all bool) error {
+func (m *GetJobRunLogsStreamRequest) validate(all bool) error {
 	if m == nil {
 		return nil
-	}
-
-	var errors []error
-
-	// no validation rules for Id
-
-	// no validation rules for JobId
-
-	// no validation rules for Name
-
-	// no validation rules for Status
-
-	if all {
-		switch v := interface{}(m.GetStartedAt()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, JobRunValidationError{
-					field:  "StartedAt",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, JobRunValidationError{
-					field:  "StartedAt",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetStartedAt()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return JobRunValidationError{
-				field:  "StartedAt",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
-
-	for idx, item := range m.GetPendingActivities() {
-		_, _ = idx, item
-
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, JobRunValidationError{
-						field:  fmt.Sprintf("PendingActivities[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, JobRunValidationError{
-						field:  fmt.Sprintf("PendingActivities[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return JobRunValidationError{
-					field:  fmt.Sprintf("PendingActivities[%v]", idx),
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
-
-	}
-
-	if m.CompletedAt != nil {
-
-		if all {
-			switch v := interface{}(m.GetCompletedAt()).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, JobRunValidationError{
-						field:  "CompletedAt",
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, JobRunValidationError{
-						field:  "CompletedAt",
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(m.GetCompletedAt()).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return JobRunValidationError{
-					field:  "CompletedAt",
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
+	}
+
+	var errors []error
+
+	// no validation rules for JobRunId
+
+	// no validation rules for AccountId
+
+	// no validation rules for Window
+
+	// no validation rules for ShouldTail
 
+	if m.MaxLogLines != nil {
+		// no validation rules for MaxLogLines
 	}
 
 	if len(errors) > 0 {
-		return JobRunMultiError(errors)
+		return GetJobRunLogsStreamRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// JobRunMultiError is an error wrapping multiple validation errors returned by
-// JobRun.ValidateAll() if the designated constraints aren't met.
-type JobRunMultiError []error
+// GetJobRunLogsStreamRequestMultiError is an error wrapping multiple
+// validation errors returned by GetJobRunLogsStreamRequest.ValidateAll() if
+// the designated constraints aren't met.
+type GetJobRunLogsStreamRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m JobRunMultiError) Error() string {
+func (m GetJobRunLogsStreamRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -9867,11 +17252,11 @@ func (m JobRunMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m JobRunMultiError) AllErrors() []error { return m }
+func (m GetJobRunLogsStreamRequestMultiError) AllErrors() []error { return m }
 
-// JobRunValidationError is the validation error returned by JobRun.Validate if
-// the designated constraints aren't met.
-type JobRunValidationError struct {
+// GetJobRunLogsStreamRequestValidationError is the validation error returned
+// by GetJobRunLogsStreamRequest.Validate if the designated constraints aren't met.
+type GetJobRunLogsStreamRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -9879,22 +17264,24 @@ type JobRunValidationError struct {
 }
 
 // Field function returns field value.
-func (e JobRunValidationError) Field() string { return e.field }
+func (e GetJobRunLogsStreamRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e JobRunValidationError) Reason() string { return e.reason }
+func (e GetJobRunLogsStreamRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e JobRunValidationError) Cause() error { return e.cause }
+func (e GetJobRunLogsStreamRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e JobRunValidationError) Key() bool { return e.key }
+func (e GetJobRunLogsStreamRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e JobRunValidationError) ErrorName() string { return "JobRunValidationError" }
+func (e GetJobRunLogsStreamRequestValidationError) ErrorName() string {
+	return "GetJobRunLogsStreamRequestValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e JobRunValidationError) Error() string {
+func (e GetJobRunLogsStreamRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -9906,14 +17293,14 @@ func (e JobRunValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sJobRun.%s: %s%s",
+		"invalid %sGetJobRunLogsStreamRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = JobRunValidationError{}
+var _ error = GetJobRunLogsStreamRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -9921,48 +17308,46 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = JobRunValidationError{}
+} = GetJobRunLogsStreamRequestValidationError{}
 
-// Validate checks the field values on JobRunEventTaskError with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on GetJobRunLogsStreamResponse with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *JobRunEventTaskError) Validate() error {
+func (m *GetJobRunLogsStreamResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on JobRunEventTaskError with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on GetJobRunLogsStreamResponse with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// JobRunEventTaskErrorMultiError, or nil if none found.
-func (m *JobRunEventTaskError) ValidateAll() error {
+// GetJobRunLogsStreamResponseMultiError, or nil if none found.
+func (m *GetJobRunLogsStreamResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *JobRunEventTaskError) validate(all bool) error {
+func (m *GetJobRunLogsStreamResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Message
-
-	// no validation rules for RetryState
+	// no validation rules for LogLine
 
 	if len(errors) > 0 {
-		return JobRunEventTaskErrorMultiError(errors)
+		return GetJobRunLogsStreamResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// JobRunEventTaskErrorMultiError is an error wrapping multiple validation
-// errors returned by JobRunEventTaskError.ValidateAll() if the designated
-// constraints aren't met.
-type JobRunEventTaskErrorMultiError []error
+// GetJobRunLogsStreamResponseMultiError is an error wrapping multiple
+// validation errors returned by GetJobRunLogsStreamResponse.ValidateAll() if
+// the designated constraints aren't met.
+type GetJobRunLogsStreamResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m JobRunEventTaskErrorMultiError) Error() string {
+func (m GetJobRunLogsStreamResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -9971,11 +17356,12 @@ func (m JobRunEventTaskErrorMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m JobRunEventTaskErrorMultiError) AllErrors() []error { return m }
+func (m GetJobRunLogsStreamResponseMultiError) AllErrors() []error { return m }
 
-// JobRunEventTaskErrorValidationError is the validation error returned by
-// JobRunEventTaskError.Validate if the designated constraints aren't met.
-type JobRunEventTaskErrorValidationError struct {
+// GetJobRunLogsStreamResponseValidationError is the validation error returned
+// by GetJobRunLogsStreamResponse.Validate if the designated constraints
+// aren't met.
+type GetJobRunLogsStreamResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -9983,24 +17369,24 @@ type JobRunEventTaskErrorValidationError struct {
 }
 
 // Field function returns field value.
-func (e JobRunEventTaskErrorValidationError) Field() string { return e.field }
+func (e GetJobRunLogsStreamResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e JobRunEventTaskErrorValidationError) Reason() string { return e.reason }
+func (e GetJobRunLogsStreamResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e JobRunEventTaskErrorValidationError) Cause() error { return e.cause }
+func (e GetJobRunLogsStreamResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e JobRunEventTaskErrorValidationError) Key() bool { return e.key }
+func (e GetJobRunLogsStreamResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e JobRunEventTaskErrorValidationError) ErrorName() string {
-	return "JobRunEventTaskErrorValidationError"
+func (e GetJobRunLogsStreamResponseValidationError) ErrorName() string {
+	return "GetJobRunLogsStreamResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e JobRunEventTaskErrorValidationError) Error() string {
+func (e GetJobRunLogsStreamResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -10012,14 +17398,14 @@ func (e JobRunEventTaskErrorValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sJobRunEventTaskError.%s: %s%s",
+		"invalid %sGetJobRunLogsStreamResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = JobRunEventTaskErrorValidationError{}
+var _ error = GetJobRunLogsStreamResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -10027,24 +17413,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = JobRunEventTaskErrorValidationError{}
+} = GetJobRunLogsStreamResponseValidationError{}
 
-// Validate checks the field values on JobRunEventTask with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *JobRunEventTask) Validate() error {
+// Validate checks the field values on SetJobWorkflowOptionsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SetJobWorkflowOptionsRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on JobRunEventTask with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on SetJobWorkflowOptionsRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// JobRunEventTaskMultiError, or nil if none found.
-func (m *JobRunEventTask) ValidateAll() error {
+// SetJobWorkflowOptionsRequestMultiError, or nil if none found.
+func (m *SetJobWorkflowOptionsRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *JobRunEventTask) validate(all bool) error {
+func (m *SetJobWorkflowOptionsRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -10053,60 +17439,161 @@ func (m *JobRunEventTask) validate(all bool) error {
 
 	// no validation rules for Id
 
-	// no validation rules for Type
+	if all {
+		switch v := interface{}(m.GetWorfklowOptions()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SetJobWorkflowOptionsRequestValidationError{
+					field:  "WorfklowOptions",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SetJobWorkflowOptionsRequestValidationError{
+					field:  "WorfklowOptions",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetWorfklowOptions()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SetJobWorkflowOptionsRequestValidationError{
+				field:  "WorfklowOptions",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return SetJobWorkflowOptionsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// SetJobWorkflowOptionsRequestMultiError is an error wrapping multiple
+// validation errors returned by SetJobWorkflowOptionsRequest.ValidateAll() if
+// the designated constraints aren't met.
+type SetJobWorkflowOptionsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SetJobWorkflowOptionsRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SetJobWorkflowOptionsRequestMultiError) AllErrors() []error { return m }
+
+// SetJobWorkflowOptionsRequestValidationError is the validation error returned
+// by SetJobWorkflowOptionsRequest.Validate if the designated constraints
+// aren't met.
+type SetJobWorkflowOptionsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SetJobWorkflowOptionsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SetJobWorkflowOptionsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SetJobWorkflowOptionsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SetJobWorkflowOptionsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SetJobWorkflowOptionsRequestValidationError) ErrorName() string {
+	return "SetJobWorkflowOptionsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SetJobWorkflowOptionsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSetJobWorkflowOptionsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SetJobWorkflowOptionsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SetJobWorkflowOptionsRequestValidationError{}
+
+// Validate checks the field values on SetJobWorkflowOptionsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SetJobWorkflowOptionsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SetJobWorkflowOptionsResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// SetJobWorkflowOptionsResponseMultiError, or nil if none found.
+func (m *SetJobWorkflowOptionsResponse) ValidateAll() error {
+	return m.validate(true)
+}
 
-	if all {
-		switch v := interface{}(m.GetEventTime()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, JobRunEventTaskValidationError{
-					field:  "EventTime",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, JobRunEventTaskValidationError{
-					field:  "EventTime",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetEventTime()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return JobRunEventTaskValidationError{
-				field:  "EventTime",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
+func (m *SetJobWorkflowOptionsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
 	}
 
+	var errors []error
+
 	if all {
-		switch v := interface{}(m.GetError()).(type) {
+		switch v := interface{}(m.GetJob()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, JobRunEventTaskValidationError{
-					field:  "Error",
+				errors = append(errors, SetJobWorkflowOptionsResponseValidationError{
+					field:  "Job",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, JobRunEventTaskValidationError{
-					field:  "Error",
+				errors = append(errors, SetJobWorkflowOptionsResponseValidationError{
+					field:  "Job",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetError()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetJob()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return JobRunEventTaskValidationError{
-				field:  "Error",
+			return SetJobWorkflowOptionsResponseValidationError{
+				field:  "Job",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
@@ -10114,19 +17601,19 @@ func (m *JobRunEventTask) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return JobRunEventTaskMultiError(errors)
+		return SetJobWorkflowOptionsResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// JobRunEventTaskMultiError is an error wrapping multiple validation errors
-// returned by JobRunEventTask.ValidateAll() if the designated constraints
-// aren't met.
-type JobRunEventTaskMultiError []error
+// SetJobWorkflowOptionsResponseMultiError is an error wrapping multiple
+// validation errors returned by SetJobWorkflowOptionsResponse.ValidateAll()
+// if the designated constraints aren't met.
+type SetJobWorkflowOptionsResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m JobRunEventTaskMultiError) Error() string {
+func (m SetJobWorkflowOptionsResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -10135,11 +17622,12 @@ func (m JobRunEventTaskMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m JobRunEventTaskMultiError) AllErrors() []error { return m }
+func (m SetJobWorkflowOptionsResponseMultiError) AllErrors() []error { return m }
 
-// JobRunEventTaskValidationError is the validation error returned by
-// JobRunEventTask.Validate if the designated constraints aren't met.
-type JobRunEventTaskValidationError struct {
+// SetJobWorkflowOptionsResponseValidationError is the validation error
+// returned by SetJobWorkflowOptionsResponse.Validate if the designated
+// constraints aren't met.
+type SetJobWorkflowOptionsResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -10147,22 +17635,24 @@ type JobRunEventTaskValidationError struct {
 }
 
 // Field function returns field value.
-func (e JobRunEventTaskValidationError) Field() string { return e.field }
+func (e SetJobWorkflowOptionsResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e JobRunEventTaskValidationError) Reason() string { return e.reason }
+func (e SetJobWorkflowOptionsResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e JobRunEventTaskValidationError) Cause() error { return e.cause }
+func (e SetJobWorkflowOptionsResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e JobRunEventTaskValidationError) Key() bool { return e.key }
+func (e SetJobWorkflowOptionsResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e JobRunEventTaskValidationError) ErrorName() string { return "JobRunEventTaskValidationError" }
+func (e SetJobWorkflowOptionsResponseValidationError) ErrorName() string {
+	return "SetJobWorkflowOptionsResponseValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e JobRunEventTaskValidationError) Error() string {
+func (e SetJobWorkflowOptionsResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -10174,14 +17664,14 @@ func (e JobRunEventTaskValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sJobRunEventTask.%s: %s%s",
+		"invalid %sSetJobWorkflowOptionsResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = JobRunEventTaskValidationError{}
+var _ error = SetJobWorkflowOptionsResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -10189,48 +17679,75 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = JobRunEventTaskValidationError{}
+} = SetJobWorkflowOptionsResponseValidationError{}
 
-// Validate checks the field values on JobRunSyncMetadata with the rules
+// Validate checks the field values on SetJobSyncOptionsRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *JobRunSyncMetadata) Validate() error {
+func (m *SetJobSyncOptionsRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on JobRunSyncMetadata with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on SetJobSyncOptionsRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// JobRunSyncMetadataMultiError, or nil if none found.
-func (m *JobRunSyncMetadata) ValidateAll() error {
+// SetJobSyncOptionsRequestMultiError, or nil if none found.
+func (m *SetJobSyncOptionsRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *JobRunSyncMetadata) validate(all bool) error {
+func (m *SetJobSyncOptionsRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Schema
+	// no validation rules for Id
 
-	// no validation rules for Table
+	if all {
+		switch v := interface{}(m.GetSyncOptions()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SetJobSyncOptionsRequestValidationError{
+					field:  "SyncOptions",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SetJobSyncOptionsRequestValidationError{
+					field:  "SyncOptions",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSyncOptions()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SetJobSyncOptionsRequestValidationError{
+				field:  "SyncOptions",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
 
 	if len(errors) > 0 {
-		return JobRunSyncMetadataMultiError(errors)
+		return SetJobSyncOptionsRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// JobRunSyncMetadataMultiError is an error wrapping multiple validation errors
-// returned by JobRunSyncMetadata.ValidateAll() if the designated constraints
-// aren't met.
-type JobRunSyncMetadataMultiError []error
+// SetJobSyncOptionsRequestMultiError is an error wrapping multiple validation
+// errors returned by SetJobSyncOptionsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type SetJobSyncOptionsRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m JobRunSyncMetadataMultiError) Error() string {
+func (m SetJobSyncOptionsRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -10239,11 +17756,11 @@ func (m JobRunSyncMetadataMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m JobRunSyncMetadataMultiError) AllErrors() []error { return m }
+func (m SetJobSyncOptionsRequestMultiError) AllErrors() []error { return m }
 
-// JobRunSyncMetadataValidationError is the validation error returned by
-// JobRunSyncMetadata.Validate if the designated constraints aren't met.
-type JobRunSyncMetadataValidationError struct {
+// SetJobSyncOptionsRequestValidationError is the validation error returned by
+// SetJobSyncOptionsRequest.Validate if the designated constraints aren't met.
+type SetJobSyncOptionsRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -10251,24 +17768,24 @@ type JobRunSyncMetadataValidationError struct {
 }
 
 // Field function returns field value.
-func (e JobRunSyncMetadataValidationError) Field() string { return e.field }
+func (e SetJobSyncOptionsRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e JobRunSyncMetadataValidationError) Reason() string { return e.reason }
+func (e SetJobSyncOptionsRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e JobRunSyncMetadataValidationError) Cause() error { return e.cause }
+func (e SetJobSyncOptionsRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e JobRunSyncMetadataValidationError) Key() bool { return e.key }
+func (e SetJobSyncOptionsRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e JobRunSyncMetadataValidationError) ErrorName() string {
-	return "JobRunSyncMetadataValidationError"
+func (e SetJobSyncOptionsRequestValidationError) ErrorName() string {
+	return "SetJobSyncOptionsRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e JobRunSyncMetadataValidationError) Error() string {
+func (e SetJobSyncOptionsRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -10280,14 +17797,14 @@ func (e JobRunSyncMetadataValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sJobRunSyncMetadata.%s: %s%s",
+		"invalid %sSetJobSyncOptionsRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = JobRunSyncMetadataValidationError{}
+var _ error = SetJobSyncOptionsRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -10295,90 +17812,73 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = JobRunSyncMetadataValidationError{}
+} = SetJobSyncOptionsRequestValidationError{}
 
-// Validate checks the field values on JobRunEventMetadata with the rules
+// Validate checks the field values on SetJobSyncOptionsResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *JobRunEventMetadata) Validate() error {
+func (m *SetJobSyncOptionsResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on JobRunEventMetadata with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on SetJobSyncOptionsResponse with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// JobRunEventMetadataMultiError, or nil if none found.
-func (m *JobRunEventMetadata) ValidateAll() error {
+// SetJobSyncOptionsResponseMultiError, or nil if none found.
+func (m *SetJobSyncOptionsResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *JobRunEventMetadata) validate(all bool) error {
+func (m *SetJobSyncOptionsResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	switch v := m.Metadata.(type) {
-	case *JobRunEventMetadata_SyncMetadata:
-		if v == nil {
-			err := JobRunEventMetadataValidationError{
-				field:  "Metadata",
-				reason: "oneof value cannot be a typed-nil",
-			}
-			if !all {
-				return err
-			}
-			errors = append(errors, err)
-		}
-
-		if all {
-			switch v := interface{}(m.GetSyncMetadata()).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, JobRunEventMetadataValidationError{
-						field:  "SyncMetadata",
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, JobRunEventMetadataValidationError{
-						field:  "SyncMetadata",
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
+	if all {
+		switch v := interface{}(m.GetJob()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SetJobSyncOptionsResponseValidationError{
+					field:  "Job",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
 			}
-		} else if v, ok := interface{}(m.GetSyncMetadata()).(interface{ Validate() error }); ok {
+		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				return JobRunEventMetadataValidationError{
-					field:  "SyncMetadata",
+				errors = append(errors, SetJobSyncOptionsResponseValidationError{
+					field:  "Job",
 					reason: "embedded message failed validation",
 					cause:  err,
-				}
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetJob()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SetJobSyncOptionsResponseValidationError{
+				field:  "Job",
+				reason: "embedded message failed validation",
+				cause:  err,
 			}
 		}
-
-	default:
-		_ = v // ensures v is used
 	}
 
 	if len(errors) > 0 {
-		return JobRunEventMetadataMultiError(errors)
+		return SetJobSyncOptionsResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// JobRunEventMetadataMultiError is an error wrapping multiple validation
-// errors returned by JobRunEventMetadata.ValidateAll() if the designated
-// constraints aren't met.
-type JobRunEventMetadataMultiError []error
+// SetJobSyncOptionsResponseMultiError is an error wrapping multiple validation
+// errors returned by SetJobSyncOptionsResponse.ValidateAll() if the
+// designated constraints aren't met.
+type SetJobSyncOptionsResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m JobRunEventMetadataMultiError) Error() string {
+func (m SetJobSyncOptionsResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -10387,11 +17887,11 @@ func (m JobRunEventMetadataMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m JobRunEventMetadataMultiError) AllErrors() []error { return m }
+func (m SetJobSyncOptionsResponseMultiError) AllErrors() []error { return m }
 
-// JobRunEventMetadataValidationError is the validation error returned by
-// JobRunEventMetadata.Validate if the designated constraints aren't met.
-type JobRunEventMetadataValidationError struct {
+// SetJobSyncOptionsResponseValidationError is the validation error returned by
+// SetJobSyncOptionsResponse.Validate if the designated constraints aren't met.
+type SetJobSyncOptionsResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -10399,24 +17899,24 @@ type JobRunEventMetadataValidationError struct {
 }
 
 // Field function returns field value.
-func (e JobRunEventMetadataValidationError) Field() string { return e.field }
+func (e SetJobSyncOptionsResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e JobRunEventMetadataValidationError) Reason() string { return e.reason }
+func (e SetJobSyncOptionsResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e JobRunEventMetadataValidationError) Cause() error { return e.cause }
+func (e SetJobSyncOptionsResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e JobRunEventMetadataValidationError) Key() bool { return e.key }
+func (e SetJobSyncOptionsResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e JobRunEventMetadataValidationError) ErrorName() string {
-	return "JobRunEventMetadataValidationError"
+func (e SetJobSyncOptionsResponseValidationError) ErrorName() string {
+	return "SetJobSyncOptionsResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e JobRunEventMetadataValidationError) Error() string {
+func (e SetJobSyncOptionsResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -10428,14 +17928,14 @@ func (e JobRunEventMetadataValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sJobRunEventMetadata.%s: %s%s",
+		"invalid %sSetJobSyncOptionsResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = JobRunEventMetadataValidationError{}
+var _ error = SetJobSyncOptionsResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -10443,24 +17943,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = JobRunEventMetadataValidationError{}
+} = SetJobSyncOptionsResponseValidationError{}
 
-// Validate checks the field values on JobRunEvent with the rules defined in
-// the proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *JobRunEvent) Validate() error {
+// Validate checks the field values on SetJobTriggersRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SetJobTriggersRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on JobRunEvent with the rules defined in
-// the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in JobRunEventMultiError, or
-// nil if none found.
-func (m *JobRunEvent) ValidateAll() error {
+// ValidateAll checks the field values on SetJobTriggersRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SetJobTriggersRequestMultiError, or nil if none found.
+func (m *SetJobTriggersRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *JobRunEvent) validate(all bool) error {
+func (m *SetJobTriggersRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -10469,112 +17969,23 @@ func (m *JobRunEvent) validate(all bool) error {
 
 	// no validation rules for Id
 
-	// no validation rules for Type
-
-	if all {
-		switch v := interface{}(m.GetStartTime()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, JobRunEventValidationError{
-					field:  "StartTime",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, JobRunEventValidationError{
-					field:  "StartTime",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetStartTime()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return JobRunEventValidationError{
-				field:  "StartTime",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
-
-	if all {
-		switch v := interface{}(m.GetCloseTime()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, JobRunEventValidationError{
-					field:  "CloseTime",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, JobRunEventValidationError{
-					field:  "CloseTime",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetCloseTime()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return JobRunEventValidationError{
-				field:  "CloseTime",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
-
-	if all {
-		switch v := interface{}(m.GetMetadata()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, JobRunEventValidationError{
-					field:  "Metadata",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, JobRunEventValidationError{
-					field:  "Metadata",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetMetadata()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return JobRunEventValidationError{
-				field:  "Metadata",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
-
-	for idx, item := range m.GetTasks() {
+	for idx, item := range m.GetTriggers() {
 		_, _ = idx, item
 
 		if all {
 			switch v := interface{}(item).(type) {
 			case interface{ ValidateAll() error }:
 				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, JobRunEventValidationError{
-						field:  fmt.Sprintf("Tasks[%v]", idx),
+					errors = append(errors, SetJobTriggersRequestValidationError{
+						field:  fmt.Sprintf("Triggers[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			case interface{ Validate() error }:
 				if err := v.Validate(); err != nil {
-					errors = append(errors, JobRunEventValidationError{
-						field:  fmt.Sprintf("Tasks[%v]", idx),
+					errors = append(errors, SetJobTriggersRequestValidationError{
+						field:  fmt.Sprintf("Triggers[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
@@ -10582,8 +17993,8 @@ func (m *JobRunEvent) validate(all bool) error {
 			}
 		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				return JobRunEventValidationError{
-					field:  fmt.Sprintf("Tasks[%v]", idx),
+				return SetJobTriggersRequestValidationError{
+					field:  fmt.Sprintf("Triggers[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
 				}
@@ -10593,18 +18004,19 @@ func (m *JobRunEvent) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return JobRunEventMultiError(errors)
+		return SetJobTriggersRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// JobRunEventMultiError is an error wrapping multiple validation errors
-// returned by JobRunEvent.ValidateAll() if the designated constraints aren't met.
-type JobRunEventMultiError []error
+// SetJobTriggersRequestMultiError is an error wrapping multiple validation
+// errors returned by SetJobTriggersRequest.ValidateAll() if the designated
+// constraints aren't met.
+type SetJobTriggersRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m JobRunEventMultiError) Error() string {
+func (m SetJobTriggersRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -10613,11 +18025,11 @@ func (m JobRunEventMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m JobRunEventMultiError) AllErrors() []error { return m }
+func (m SetJobTriggersRequestMultiError) AllErrors() []error { return m }
 
-// JobRunEventValidationError is the validation error returned by
-// JobRunEvent.Validate if the designated constraints aren't met.
-type JobRunEventValidationError struct {
+// SetJobTriggersRequestValidationError is the validation error returned by
+// SetJobTriggersRequest.Validate if the designated constraints aren't met.
+type SetJobTriggersRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -10625,22 +18037,24 @@ type JobRunEventValidationError struct {
 }
 
 // Field function returns field value.
-func (e JobRunEventValidationError) Field() string { return e.field }
+func (e SetJobTriggersRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e JobRunEventValidationError) Reason() string { return e.reason }
+func (e SetJobTriggersRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e JobRunEventValidationError) Cause() error { return e.cause }
+func (e SetJobTriggersRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e JobRunEventValidationError) Key() bool { return e.key }
+func (e SetJobTriggersRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e JobRunEventValidationError) ErrorName() string { return "JobRunEventValidationError" }
+func (e SetJobTriggersRequestValidationError) ErrorName() string {
+	return "SetJobTriggersRequestValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e JobRunEventValidationError) Error() string {
+func (e SetJobTriggersRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -10652,14 +18066,14 @@ func (e JobRunEventValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sJobRunEvent.%s: %s%s",
+		"invalid %sSetJobTriggersRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = JobRunEventValidationError{}
+var _ error = SetJobTriggersRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -10667,48 +18081,73 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = JobRunEventValidationError{}
+} = SetJobTriggersRequestValidationError{}
 
-// Validate checks the field values on GetJobRunEventsRequest with the rules
+// Validate checks the field values on SetJobTriggersResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetJobRunEventsRequest) Validate() error {
+func (m *SetJobTriggersResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetJobRunEventsRequest with the rules
+// ValidateAll checks the field values on SetJobTriggersResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GetJobRunEventsRequestMultiError, or nil if none found.
-func (m *GetJobRunEventsRequest) ValidateAll() error {
+// SetJobTriggersResponseMultiError, or nil if none found.
+func (m *SetJobTriggersResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetJobRunEventsRequest) validate(all bool) error {
+func (m *SetJobTriggersResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for JobRunId
-
-	// no validation rules for AccountId
+	if all {
+		switch v := interface{}(m.GetJob()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SetJobTriggersResponseValidationError{
+					field:  "Job",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SetJobTriggersResponseValidationError{
+					field:  "Job",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetJob()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SetJobTriggersResponseValidationError{
+				field:  "Job",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
 
 	if len(errors) > 0 {
-		return GetJobRunEventsRequestMultiError(errors)
+		return SetJobTriggersResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetJobRunEventsRequestMultiError is an error wrapping multiple validation
-// errors returned by GetJobRunEventsRequest.ValidateAll() if the designated
+// SetJobTriggersResponseMultiError is an error wrapping multiple validation
+// errors returned by SetJobTriggersResponse.ValidateAll() if the designated
 // constraints aren't met.
-type GetJobRunEventsRequestMultiError []error
+type SetJobTriggersResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetJobRunEventsRequestMultiError) Error() string {
+func (m SetJobTriggersResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -10717,11 +18156,11 @@ func (m GetJobRunEventsRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetJobRunEventsRequestMultiError) AllErrors() []error { return m }
+func (m SetJobTriggersResponseMultiError) AllErrors() []error { return m }
 
-// GetJobRunEventsRequestValidationError is the validation error returned by
-// GetJobRunEventsRequest.Validate if the designated constraints aren't met.
-type GetJobRunEventsRequestValidationError struct {
+// SetJobTriggersResponseValidationError is the validation error returned by
+// SetJobTriggersResponse.Validate if the designated constraints aren't met.
+type SetJobTriggersResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -10729,24 +18168,24 @@ type GetJobRunEventsRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetJobRunEventsRequestValidationError) Field() string { return e.field }
+func (e SetJobTriggersResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetJobRunEventsRequestValidationError) Reason() string { return e.reason }
+func (e SetJobTriggersResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetJobRunEventsRequestValidationError) Cause() error { return e.cause }
+func (e SetJobTriggersResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetJobRunEventsRequestValidationError) Key() bool { return e.key }
+func (e SetJobTriggersResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetJobRunEventsRequestValidationError) ErrorName() string {
-	return "GetJobRunEventsRequestValidationError"
+func (e SetJobTriggersResponseValidationError) ErrorName() string {
+	return "SetJobTriggersResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetJobRunEventsRequestValidationError) Error() string {
+func (e SetJobTriggersResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -10758,14 +18197,14 @@ func (e GetJobRunEventsRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetJobRunEventsRequest.%s: %s%s",
+		"invalid %sSetJobTriggersResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetJobRunEventsRequestValidationError{}
+var _ error = SetJobTriggersResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -10773,80 +18212,50 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetJobRunEventsRequestValidationError{}
+} = SetJobTriggersResponseValidationError{}
 
-// Validate checks the field values on GetJobRunEventsResponse with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on TriggerDependentJobsRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetJobRunEventsResponse) Validate() error {
+func (m *TriggerDependentJobsRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetJobRunEventsResponse with the
+// ValidateAll checks the field values on TriggerDependentJobsRequest with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GetJobRunEventsResponseMultiError, or nil if none found.
-func (m *GetJobRunEventsResponse) ValidateAll() error {
+// TriggerDependentJobsRequestMultiError, or nil if none found.
+func (m *TriggerDependentJobsRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetJobRunEventsResponse) validate(all bool) error {
+func (m *TriggerDependentJobsRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	for idx, item := range m.GetEvents() {
-		_, _ = idx, item
-
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, GetJobRunEventsResponseValidationError{
-						field:  fmt.Sprintf("Events[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, GetJobRunEventsResponseValidationError{
-						field:  fmt.Sprintf("Events[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return GetJobRunEventsResponseValidationError{
-					field:  fmt.Sprintf("Events[%v]", idx),
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
+	// no validation rules for JobId
 
-	}
+	// no validation rules for JobRunId
 
-	// no validation rules for IsRunComplete
+	// no validation rules for Succeeded
 
 	if len(errors) > 0 {
-		return GetJobRunEventsResponseMultiError(errors)
+		return TriggerDependentJobsRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetJobRunEventsResponseMultiError is an error wrapping multiple validation
-// errors returned by GetJobRunEventsResponse.ValidateAll() if the designated
-// constraints aren't met.
-type GetJobRunEventsResponseMultiError []error
+// TriggerDependentJobsRequestMultiError is an error wrapping multiple
+// validation errors returned by TriggerDependentJobsRequest.ValidateAll() if
+// the designated constraints aren't met.
+type TriggerDependentJobsRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetJobRunEventsResponseMultiError) Error() string {
+func (m TriggerDependentJobsRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -10855,11 +18264,12 @@ func (m GetJobRunEventsResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetJobRunEventsResponseMultiError) AllErrors() []error { return m }
+func (m TriggerDependentJobsRequestMultiError) AllErrors() []error { return m }
 
-// GetJobRunEventsResponseValidationError is the validation error returned by
-// GetJobRunEventsResponse.Validate if the designated constraints aren't met.
-type GetJobRunEventsResponseValidationError struct {
+// TriggerDependentJobsRequestValidationError is the validation error returned
+// by TriggerDependentJobsRequest.Validate if the designated constraints
+// aren't met.
+type TriggerDependentJobsRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -10867,24 +18277,24 @@ type GetJobRunEventsResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetJobRunEventsResponseValidationError) Field() string { return e.field }
+func (e TriggerDependentJobsRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetJobRunEventsResponseValidationError) Reason() string { return e.reason }
+func (e TriggerDependentJobsRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetJobRunEventsResponseValidationError) Cause() error { return e.cause }
+func (e TriggerDependentJobsRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetJobRunEventsResponseValidationError) Key() bool { return e.key }
+func (e TriggerDependentJobsRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetJobRunEventsResponseValidationError) ErrorName() string {
-	return "GetJobRunEventsResponseValidationError"
+func (e TriggerDependentJobsRequestValidationError) ErrorName() string {
+	return "TriggerDependentJobsRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetJobRunEventsResponseValidationError) Error() string {
+func (e TriggerDependentJobsRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -10896,14 +18306,14 @@ func (e GetJobRunEventsResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetJobRunEventsResponse.%s: %s%s",
+		"invalid %sTriggerDependentJobsRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetJobRunEventsResponseValidationError{}
+var _ error = TriggerDependentJobsRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -10911,48 +18321,44 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetJobRunEventsResponseValidationError{}
+} = TriggerDependentJobsRequestValidationError{}
 
-// Validate checks the field values on DeleteJobRunRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on TriggerDependentJobsResponse with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *DeleteJobRunRequest) Validate() error {
+func (m *TriggerDependentJobsResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on DeleteJobRunRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on TriggerDependentJobsResponse with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// DeleteJobRunRequestMultiError, or nil if none found.
-func (m *DeleteJobRunRequest) ValidateAll() error {
+// TriggerDependentJobsResponseMultiError, or nil if none found.
+func (m *TriggerDependentJobsResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *DeleteJobRunRequest) validate(all bool) error {
+func (m *TriggerDependentJobsResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for JobRunId
-
-	// no validation rules for AccountId
-
 	if len(errors) > 0 {
-		return DeleteJobRunRequestMultiError(errors)
+		return TriggerDependentJobsResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// DeleteJobRunRequestMultiError is an error wrapping multiple validation
-// errors returned by DeleteJobRunRequest.ValidateAll() if the designated
-// constraints aren't met.
-type DeleteJobRunRequestMultiError []error
+// TriggerDependentJobsResponseMultiError is an error wrapping multiple
+// validation errors returned by TriggerDependentJobsResponse.ValidateAll() if
+// the designated constraints aren't met.
+type TriggerDependentJobsResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m DeleteJobRunRequestMultiError) Error() string {
+func (m TriggerDependentJobsResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -10961,11 +18367,12 @@ func (m DeleteJobRunRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m DeleteJobRunRequestMultiError) AllErrors() []error { return m }
+func (m TriggerDependentJobsResponseMultiError) AllErrors() []error { return m }
 
-// DeleteJobRunRequestValidationError is the validation error returned by
-// DeleteJobRunRequest.Validate if the designated constraints aren't met.
-type DeleteJobRunRequestValidationError struct {
+// TriggerDependentJobsResponseValidationError is the validation error returned
+// by TriggerDependentJobsResponse.Validate if the designated constraints
+// aren't met.
+type TriggerDependentJobsResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -10973,24 +18380,24 @@ type DeleteJobRunRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e DeleteJobRunRequestValidationError) Field() string { return e.field }
+func (e TriggerDependentJobsResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e DeleteJobRunRequestValidationError) Reason() string { return e.reason }
+func (e TriggerDependentJobsResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e DeleteJobRunRequestValidationError) Cause() error { return e.cause }
+func (e TriggerDependentJobsResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e DeleteJobRunRequestValidationError) Key() bool { return e.key }
+func (e TriggerDependentJobsResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e DeleteJobRunRequestValidationError) ErrorName() string {
-	return "DeleteJobRunRequestValidationError"
+func (e TriggerDependentJobsResponseValidationError) ErrorName() string {
+	return "TriggerDependentJobsResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e DeleteJobRunRequestValidationError) Error() string {
+func (e TriggerDependentJobsResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -11002,14 +18409,14 @@ func (e DeleteJobRunRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sDeleteJobRunRequest.%s: %s%s",
+		"invalid %sTriggerDependentJobsResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = DeleteJobRunRequestValidationError{}
+var _ error = TriggerDependentJobsResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -11017,44 +18424,181 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = DeleteJobRunRequestValidationError{}
+} = TriggerDependentJobsResponseValidationError{}
 
-// Validate checks the field values on DeleteJobRunResponse with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *DeleteJobRunResponse) Validate() error {
+// Validate checks the field values on JobVersion with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *JobVersion) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on DeleteJobRunResponse with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// DeleteJobRunResponseMultiError, or nil if none found.
-func (m *DeleteJobRunResponse) ValidateAll() error {
+// ValidateAll checks the field values on JobVersion with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in JobVersionMultiError, or
+// nil if none found.
+func (m *JobVersion) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *DeleteJobRunResponse) validate(all bool) error {
+func (m *JobVersion) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	// no validation rules for Id
+
+	// no validation rules for JobId
+
+	// no validation rules for Version
+
+	if all {
+		switch v := interface{}(m.GetSource()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, JobVersionValidationError{
+					field:  "Source",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, JobVersionValidationError{
+					field:  "Source",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSource()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return JobVersionValidationError{
+				field:  "Source",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	for idx, item := range m.GetMappings() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, JobVersionValidationError{
+						field:  fmt.Sprintf("Mappings[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, JobVersionValidationError{
+						field:  fmt.Sprintf("Mappings[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return JobVersionValidationError{
+					field:  fmt.Sprintf("Mappings[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetDestinations() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, JobVersionValidationError{
+						field:  fmt.Sprintf("Destinations[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, JobVersionValidationError{
+						field:  fmt.Sprintf("Destinations[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return JobVersionValidationError{
+					field:  fmt.Sprintf("Destinations[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for CreatedByUserId
+
+	if all {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, JobVersionValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, JobVersionValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return JobVersionValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if m.CronSchedule != nil {
+		// no validation rules for CronSchedule
+	}
+
 	if len(errors) > 0 {
-		return DeleteJobRunResponseMultiError(errors)
+		return JobVersionMultiError(errors)
 	}
 
 	return nil
 }
 
-// DeleteJobRunResponseMultiError is an error wrapping multiple validation
-// errors returned by DeleteJobRunResponse.ValidateAll() if the designated
-// constraints aren't met.
-type DeleteJobRunResponseMultiError []error
+// JobVersionMultiError is an error wrapping multiple validation errors
+// returned by JobVersion.ValidateAll() if the designated constraints aren't met.
+type JobVersionMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m DeleteJobRunResponseMultiError) Error() string {
+func (m JobVersionMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -11063,11 +18607,11 @@ func (m DeleteJobRunResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m DeleteJobRunResponseMultiError) AllErrors() []error { return m }
+func (m JobVersionMultiError) AllErrors() []error { return m }
 
-// DeleteJobRunResponseValidationError is the validation error returned by
-// DeleteJobRunResponse.Validate if the designated constraints aren't met.
-type DeleteJobRunResponseValidationError struct {
+// JobVersionValidationError is the validation error returned by
+// JobVersion.Validate if the designated constraints aren't met.
+type JobVersionValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -11075,24 +18619,22 @@ type DeleteJobRunResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e DeleteJobRunResponseValidationError) Field() string { return e.field }
+func (e JobVersionValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e DeleteJobRunResponseValidationError) Reason() string { return e.reason }
+func (e JobVersionValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e DeleteJobRunResponseValidationError) Cause() error { return e.cause }
+func (e JobVersionValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e DeleteJobRunResponseValidationError) Key() bool { return e.key }
+func (e JobVersionValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e DeleteJobRunResponseValidationError) ErrorName() string {
-	return "DeleteJobRunResponseValidationError"
-}
+func (e JobVersionValidationError) ErrorName() string { return "JobVersionValidationError" }
 
 // Error satisfies the builtin error interface
-func (e DeleteJobRunResponseValidationError) Error() string {
+func (e JobVersionValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -11104,14 +18646,14 @@ func (e DeleteJobRunResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sDeleteJobRunResponse.%s: %s%s",
+		"invalid %sJobVersion.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = DeleteJobRunResponseValidationError{}
+var _ error = JobVersionValidationError{}
 
 var _ interface {
 	Field() string
@@ -11119,48 +18661,46 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = DeleteJobRunResponseValidationError{}
+} = JobVersionValidationError{}
 
-// Validate checks the field values on TerminateJobRunRequest with the rules
+// Validate checks the field values on GetJobVersionsRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *TerminateJobRunRequest) Validate() error {
+func (m *GetJobVersionsRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on TerminateJobRunRequest with the rules
+// ValidateAll checks the field values on GetJobVersionsRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// TerminateJobRunRequestMultiError, or nil if none found.
-func (m *TerminateJobRunRequest) ValidateAll() error {
+// GetJobVersionsRequestMultiError, or nil if none found.
+func (m *GetJobVersionsRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *TerminateJobRunRequest) validate(all bool) error {
+func (m *GetJobVersionsRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for JobRunId
-
-	// no validation rules for AccountId
+	// no validation rules for JobId
 
 	if len(errors) > 0 {
-		return TerminateJobRunRequestMultiError(errors)
+		return GetJobVersionsRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// TerminateJobRunRequestMultiError is an error wrapping multiple validation
-// errors returned by TerminateJobRunRequest.ValidateAll() if the designated
+// GetJobVersionsRequestMultiError is an error wrapping multiple validation
+// errors returned by GetJobVersionsRequest.ValidateAll() if the designated
 // constraints aren't met.
-type TerminateJobRunRequestMultiError []error
+type GetJobVersionsRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m TerminateJobRunRequestMultiError) Error() string {
+func (m GetJobVersionsRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -11169,11 +18709,11 @@ func (m TerminateJobRunRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m TerminateJobRunRequestMultiError) AllErrors() []error { return m }
+func (m GetJobVersionsRequestMultiError) AllErrors() []error { return m }
 
-// TerminateJobRunRequestValidationError is the validation error returned by
-// TerminateJobRunRequest.Validate if the designated constraints aren't met.
-type TerminateJobRunRequestValidationError struct {
+// GetJobVersionsRequestValidationError is the validation error returned by
+// GetJobVersionsRequest.Validate if the designated constraints aren't met.
+type GetJobVersionsRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -11181,24 +18721,24 @@ type TerminateJobRunRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e TerminateJobRunRequestValidationError) Field() string { return e.field }
+func (e GetJobVersionsRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e TerminateJobRunRequestValidationError) Reason() string { return e.reason }
+func (e GetJobVersionsRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e TerminateJobRunRequestValidationError) Cause() error { return e.cause }
+func (e GetJobVersionsRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e TerminateJobRunRequestValidationError) Key() bool { return e.key }
+func (e GetJobVersionsRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e TerminateJobRunRequestValidationError) ErrorName() string {
-	return "TerminateJobRunRequestValidationError"
+func (e GetJobVersionsRequestValidationError) ErrorName() string {
+	return "GetJobVersionsRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e TerminateJobRunRequestValidationError) Error() string {
+func (e GetJobVersionsRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -11210,14 +18750,14 @@ func (e TerminateJobRunRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sTerminateJobRunRequest.%s: %s%s",
+		"invalid %sGetJobVersionsRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = TerminateJobRunRequestValidationError{}
+var _ error = GetJobVersionsRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -11225,44 +18765,78 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = TerminateJobRunRequestValidationError{}
+} = GetJobVersionsRequestValidationError{}
 
-// Validate checks the field values on TerminateJobRunResponse with the rules
+// Validate checks the field values on GetJobVersionsResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *TerminateJobRunResponse) Validate() error {
+func (m *GetJobVersionsResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on TerminateJobRunResponse with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// TerminateJobRunResponseMultiError, or nil if none found.
-func (m *TerminateJobRunResponse) ValidateAll() error {
+// ValidateAll checks the field values on GetJobVersionsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetJobVersionsResponseMultiError, or nil if none found.
+func (m *GetJobVersionsResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *TerminateJobRunResponse) validate(all bool) error {
+func (m *GetJobVersionsResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	for idx, item := range m.GetVersions() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetJobVersionsResponseValidationError{
+						field:  fmt.Sprintf("Versions[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetJobVersionsResponseValidationError{
+						field:  fmt.Sprintf("Versions[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetJobVersionsResponseValidationError{
+					field:  fmt.Sprintf("Versions[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
 	if len(errors) > 0 {
-		return TerminateJobRunResponseMultiError(errors)
+		return GetJobVersionsResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// TerminateJobRunResponseMultiError is an error wrapping multiple validation
-// errors returned by TerminateJobRunResponse.ValidateAll() if the designated
+// GetJobVersionsResponseMultiError is an error wrapping multiple validation
+// errors returned by GetJobVersionsResponse.ValidateAll() if the designated
 // constraints aren't met.
-type TerminateJobRunResponseMultiError []error
+type GetJobVersionsResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m TerminateJobRunResponseMultiError) Error() string {
+func (m GetJobVersionsResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -11271,11 +18845,11 @@ func (m TerminateJobRunResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m TerminateJobRunResponseMultiError) AllErrors() []error { return m }
+func (m GetJobVersionsResponseMultiError) AllErrors() []error { return m }
 
-// TerminateJobRunResponseValidationError is the validation error returned by
-// TerminateJobRunResponse.Validate if the designated constraints aren't met.
-type TerminateJobRunResponseValidationError struct {
+// GetJobVersionsResponseValidationError is the validation error returned by
+// GetJobVersionsResponse.Validate if the designated constraints aren't met.
+type GetJobVersionsResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -11283,24 +18857,24 @@ type TerminateJobRunResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e TerminateJobRunResponseValidationError) Field() string { return e.field }
+func (e GetJobVersionsResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e TerminateJobRunResponseValidationError) Reason() string { return e.reason }
+func (e GetJobVersionsResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e TerminateJobRunResponseValidationError) Cause() error { return e.cause }
+func (e GetJobVersionsResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e TerminateJobRunResponseValidationError) Key() bool { return e.key }
+func (e GetJobVersionsResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e TerminateJobRunResponseValidationError) ErrorName() string {
-	return "TerminateJobRunResponseValidationError"
+func (e GetJobVersionsResponseValidationError) ErrorName() string {
+	return "GetJobVersionsResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e TerminateJobRunResponseValidationError) Error() string {
+func (e GetJobVersionsResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -11312,14 +18886,14 @@ func (e TerminateJobRunResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sTerminateJobRunResponse.%s: %s%s",
+		"invalid %sGetJobVersionsResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = TerminateJobRunResponseValidationError{}
+var _ error = GetJobVersionsResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -11327,56 +18901,110 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = TerminateJobRunResponseValidationError{}
+} = GetJobVersionsResponseValidationError{}
 
-// Validate checks the field values on GetJobRunLogsStreamRequest with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetJobRunLogsStreamRequest) Validate() error {
+// Validate checks the field values on JobMappingDiff with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *JobMappingDiff) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetJobRunLogsStreamRequest with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// GetJobRunLogsStreamRequestMultiError, or nil if none found.
-func (m *GetJobRunLogsStreamRequest) ValidateAll() error {
+// ValidateAll checks the field values on JobMappingDiff with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in JobMappingDiffMultiError,
+// or nil if none found.
+func (m *JobMappingDiff) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetJobRunLogsStreamRequest) validate(all bool) error {
+func (m *JobMappingDiff) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for JobRunId
+	// no validation rules for Schema
 
-	// no validation rules for AccountId
+	// no validation rules for Table
 
-	// no validation rules for Window
+	// no validation rules for Column
 
-	// no validation rules for ShouldTail
+	// no validation rules for Action
 
-	if m.MaxLogLines != nil {
-		// no validation rules for MaxLogLines
+	if all {
+		switch v := interface{}(m.GetFromTransformer()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, JobMappingDiffValidationError{
+					field:  "FromTransformer",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, JobMappingDiffValidationError{
+					field:  "FromTransformer",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetFromTransformer()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return JobMappingDiffValidationError{
+				field:  "FromTransformer",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetToTransformer()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, JobMappingDiffValidationError{
+					field:  "ToTransformer",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, JobMappingDiffValidationError{
+					field:  "ToTransformer",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetToTransformer()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return JobMappingDiffValidationError{
+				field:  "ToTransformer",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
 	}
 
 	if len(errors) > 0 {
-		return GetJobRunLogsStreamRequestMultiError(errors)
+		return JobMappingDiffMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetJobRunLogsStreamRequestMultiError is an error wrapping multiple
-// validation errors returned by GetJobRunLogsStreamRequest.ValidateAll() if
-// the designated constraints aren't met.
-type GetJobRunLogsStreamRequestMultiError []error
+// JobMappingDiffMultiError is an error wrapping multiple validation errors
+// returned by JobMappingDiff.ValidateAll() if the designated constraints
+// aren't met.
+type JobMappingDiffMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetJobRunLogsStreamRequestMultiError) Error() string {
+func (m JobMappingDiffMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -11385,11 +19013,11 @@ func (m GetJobRunLogsStreamRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetJobRunLogsStreamRequestMultiError) AllErrors() []error { return m }
+func (m JobMappingDiffMultiError) AllErrors() []error { return m }
 
-// GetJobRunLogsStreamRequestValidationError is the validation error returned
-// by GetJobRunLogsStreamRequest.Validate if the designated constraints aren't met.
-type GetJobRunLogsStreamRequestValidationError struct {
+// JobMappingDiffValidationError is the validation error returned by
+// JobMappingDiff.Validate if the designated constraints aren't met.
+type JobMappingDiffValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -11397,24 +19025,22 @@ type GetJobRunLogsStreamRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetJobRunLogsStreamRequestValidationError) Field() string { return e.field }
+func (e JobMappingDiffValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetJobRunLogsStreamRequestValidationError) Reason() string { return e.reason }
+func (e JobMappingDiffValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetJobRunLogsStreamRequestValidationError) Cause() error { return e.cause }
+func (e JobMappingDiffValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetJobRunLogsStreamRequestValidationError) Key() bool { return e.key }
+func (e JobMappingDiffValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetJobRunLogsStreamRequestValidationError) ErrorName() string {
-	return "GetJobRunLogsStreamRequestValidationError"
-}
+func (e JobMappingDiffValidationError) ErrorName() string { return "JobMappingDiffValidationError" }
 
 // Error satisfies the builtin error interface
-func (e GetJobRunLogsStreamRequestValidationError) Error() string {
+func (e JobMappingDiffValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -11426,14 +19052,14 @@ func (e GetJobRunLogsStreamRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetJobRunLogsStreamRequest.%s: %s%s",
+		"invalid %sJobMappingDiff.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetJobRunLogsStreamRequestValidationError{}
+var _ error = JobMappingDiffValidationError{}
 
 var _ interface {
 	Field() string
@@ -11441,46 +19067,106 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetJobRunLogsStreamRequestValidationError{}
+} = JobMappingDiffValidationError{}
 
-// Validate checks the field values on GetJobRunLogsStreamResponse with the
-// rules defined in the proto definition for this message. If any rules are
+// Validate checks the field values on JobDestinationDiff with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetJobRunLogsStreamResponse) Validate() error {
+func (m *JobDestinationDiff) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetJobRunLogsStreamResponse with the
-// rules defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on JobDestinationDiff with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GetJobRunLogsStreamResponseMultiError, or nil if none found.
-func (m *GetJobRunLogsStreamResponse) ValidateAll() error {
+// JobDestinationDiffMultiError, or nil if none found.
+func (m *JobDestinationDiff) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetJobRunLogsStreamResponse) validate(all bool) error {
+func (m *JobDestinationDiff) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for LogLine
+	// no validation rules for ConnectionId
+
+	// no validation rules for Action
+
+	if all {
+		switch v := interface{}(m.GetFromOptions()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, JobDestinationDiffValidationError{
+					field:  "FromOptions",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, JobDestinationDiffValidationError{
+					field:  "FromOptions",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetFromOptions()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return JobDestinationDiffValidationError{
+				field:  "FromOptions",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetToOptions()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, JobDestinationDiffValidationError{
+					field:  "ToOptions",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, JobDestinationDiffValidationError{
+					field:  "ToOptions",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetToOptions()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return JobDestinationDiffValidationError{
+				field:  "ToOptions",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
 
 	if len(errors) > 0 {
-		return GetJobRunLogsStreamResponseMultiError(errors)
+		return JobDestinationDiffMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetJobRunLogsStreamResponseMultiError is an error wrapping multiple
-// validation errors returned by GetJobRunLogsStreamResponse.ValidateAll() if
-// the designated constraints aren't met.
-type GetJobRunLogsStreamResponseMultiError []error
+// JobDestinationDiffMultiError is an error wrapping multiple validation errors
+// returned by JobDestinationDiff.ValidateAll() if the designated constraints
+// aren't met.
+type JobDestinationDiffMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetJobRunLogsStreamResponseMultiError) Error() string {
+func (m JobDestinationDiffMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -11489,12 +19175,11 @@ func (m GetJobRunLogsStreamResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetJobRunLogsStreamResponseMultiError) AllErrors() []error { return m }
+func (m JobDestinationDiffMultiError) AllErrors() []error { return m }
 
-// GetJobRunLogsStreamResponseValidationError is the validation error returned
-// by GetJobRunLogsStreamResponse.Validate if the designated constraints
-// aren't met.
-type GetJobRunLogsStreamResponseValidationError struct {
+// JobDestinationDiffValidationError is the validation error returned by
+// JobDestinationDiff.Validate if the designated constraints aren't met.
+type JobDestinationDiffValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -11502,24 +19187,24 @@ type GetJobRunLogsStreamResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetJobRunLogsStreamResponseValidationError) Field() string { return e.field }
+func (e JobDestinationDiffValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetJobRunLogsStreamResponseValidationError) Reason() string { return e.reason }
+func (e JobDestinationDiffValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetJobRunLogsStreamResponseValidationError) Cause() error { return e.cause }
+func (e JobDestinationDiffValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetJobRunLogsStreamResponseValidationError) Key() bool { return e.key }
+func (e JobDestinationDiffValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetJobRunLogsStreamResponseValidationError) ErrorName() string {
-	return "GetJobRunLogsStreamResponseValidationError"
+func (e JobDestinationDiffValidationError) ErrorName() string {
+	return "JobDestinationDiffValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetJobRunLogsStreamResponseValidationError) Error() string {
+func (e JobDestinationDiffValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -11531,14 +19216,14 @@ func (e GetJobRunLogsStreamResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetJobRunLogsStreamResponse.%s: %s%s",
+		"invalid %sJobDestinationDiff.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetJobRunLogsStreamResponseValidationError{}
+var _ error = JobDestinationDiffValidationError{}
 
 var _ interface {
 	Field() string
@@ -11546,75 +19231,50 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetJobRunLogsStreamResponseValidationError{}
+} = JobDestinationDiffValidationError{}
 
-// Validate checks the field values on SetJobWorkflowOptionsRequest with the
-// rules defined in the proto definition for this message. If any rules are
+// Validate checks the field values on GetJobVersionDiffRequest with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *SetJobWorkflowOptionsRequest) Validate() error {
+func (m *GetJobVersionDiffRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on SetJobWorkflowOptionsRequest with the
+// ValidateAll checks the field values on GetJobVersionDiffRequest with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// SetJobWorkflowOptionsRequestMultiError, or nil if none found.
-func (m *SetJobWorkflowOptionsRequest) ValidateAll() error {
+// GetJobVersionDiffRequestMultiError, or nil if none found.
+func (m *GetJobVersionDiffRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *SetJobWorkflowOptionsRequest) validate(all bool) error {
+func (m *GetJobVersionDiffRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Id
+	// no validation rules for JobId
 
-	if all {
-		switch v := interface{}(m.GetWorfklowOptions()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, SetJobWorkflowOptionsRequestValidationError{
-					field:  "WorfklowOptions",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, SetJobWorkflowOptionsRequestValidationError{
-					field:  "WorfklowOptions",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetWorfklowOptions()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return SetJobWorkflowOptionsRequestValidationError{
-				field:  "WorfklowOptions",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
+	// no validation rules for FromVersionId
+
+	// no validation rules for ToVersionId
 
 	if len(errors) > 0 {
-		return SetJobWorkflowOptionsRequestMultiError(errors)
+		return GetJobVersionDiffRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// SetJobWorkflowOptionsRequestMultiError is an error wrapping multiple
-// validation errors returned by SetJobWorkflowOptionsRequest.ValidateAll() if
-// the designated constraints aren't met.
-type SetJobWorkflowOptionsRequestMultiError []error
+// GetJobVersionDiffRequestMultiError is an error wrapping multiple validation
+// errors returned by GetJobVersionDiffRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetJobVersionDiffRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m SetJobWorkflowOptionsRequestMultiError) Error() string {
+func (m GetJobVersionDiffRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -11623,12 +19283,11 @@ func (m SetJobWorkflowOptionsRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m SetJobWorkflowOptionsRequestMultiError) AllErrors() []error { return m }
+func (m GetJobVersionDiffRequestMultiError) AllErrors() []error { return m }
 
-// SetJobWorkflowOptionsRequestValidationError is the validation error returned
-// by SetJobWorkflowOptionsRequest.Validate if the designated constraints
-// aren't met.
-type SetJobWorkflowOptionsRequestValidationError struct {
+// GetJobVersionDiffRequestValidationError is the validation error returned by
+// GetJobVersionDiffRequest.Validate if the designated constraints aren't met.
+type GetJobVersionDiffRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -11636,24 +19295,24 @@ type SetJobWorkflowOptionsRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e SetJobWorkflowOptionsRequestValidationError) Field() string { return e.field }
+func (e GetJobVersionDiffRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e SetJobWorkflowOptionsRequestValidationError) Reason() string { return e.reason }
+func (e GetJobVersionDiffRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e SetJobWorkflowOptionsRequestValidationError) Cause() error { return e.cause }
+func (e GetJobVersionDiffRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e SetJobWorkflowOptionsRequestValidationError) Key() bool { return e.key }
+func (e GetJobVersionDiffRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e SetJobWorkflowOptionsRequestValidationError) ErrorName() string {
-	return "SetJobWorkflowOptionsRequestValidationError"
+func (e GetJobVersionDiffRequestValidationError) ErrorName() string {
+	return "GetJobVersionDiffRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e SetJobWorkflowOptionsRequestValidationError) Error() string {
+func (e GetJobVersionDiffRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -11665,14 +19324,14 @@ func (e SetJobWorkflowOptionsRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sSetJobWorkflowOptionsRequest.%s: %s%s",
+		"invalid %sGetJobVersionDiffRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = SetJobWorkflowOptionsRequestValidationError{}
+var _ error = GetJobVersionDiffRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -11680,24 +19339,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = SetJobWorkflowOptionsRequestValidationError{}
+} = GetJobVersionDiffRequestValidationError{}
 
-// Validate checks the field values on SetJobWorkflowOptionsResponse with the
-// rules defined in the proto definition for this message. If any rules are
+// Validate checks the field values on GetJobVersionDiffResponse with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *SetJobWorkflowOptionsResponse) Validate() error {
+func (m *GetJobVersionDiffResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on SetJobWorkflowOptionsResponse with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the result is a list of violation errors wrapped in
-// SetJobWorkflowOptionsResponseMultiError, or nil if none found.
-func (m *SetJobWorkflowOptionsResponse) ValidateAll() error {
+// ValidateAll checks the field values on GetJobVersionDiffResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetJobVersionDiffResponseMultiError, or nil if none found.
+func (m *GetJobVersionDiffResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *SetJobWorkflowOptionsResponse) validate(all bool) error {
+func (m *GetJobVersionDiffResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -11705,48 +19364,149 @@ func (m *SetJobWorkflowOptionsResponse) validate(all bool) error {
 	var errors []error
 
 	if all {
-		switch v := interface{}(m.GetJob()).(type) {
+		switch v := interface{}(m.GetFromVersion()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, SetJobWorkflowOptionsResponseValidationError{
-					field:  "Job",
+				errors = append(errors, GetJobVersionDiffResponseValidationError{
+					field:  "FromVersion",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, SetJobWorkflowOptionsResponseValidationError{
-					field:  "Job",
+				errors = append(errors, GetJobVersionDiffResponseValidationError{
+					field:  "FromVersion",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetJob()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetFromVersion()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return SetJobWorkflowOptionsResponseValidationError{
-				field:  "Job",
+			return GetJobVersionDiffResponseValidationError{
+				field:  "FromVersion",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetToVersion()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetJobVersionDiffResponseValidationError{
+					field:  "ToVersion",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetJobVersionDiffResponseValidationError{
+					field:  "ToVersion",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetToVersion()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetJobVersionDiffResponseValidationError{
+				field:  "ToVersion",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
 		}
 	}
 
+	// no validation rules for CronScheduleChanged
+
+	// no validation rules for SourceChanged
+
+	for idx, item := range m.GetMappings() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetJobVersionDiffResponseValidationError{
+						field:  fmt.Sprintf("Mappings[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetJobVersionDiffResponseValidationError{
+						field:  fmt.Sprintf("Mappings[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetJobVersionDiffResponseValidationError{
+					field:  fmt.Sprintf("Mappings[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetDestinations() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetJobVersionDiffResponseValidationError{
+						field:  fmt.Sprintf("Destinations[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetJobVersionDiffResponseValidationError{
+						field:  fmt.Sprintf("Destinations[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetJobVersionDiffResponseValidationError{
+					field:  fmt.Sprintf("Destinations[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
 	if len(errors) > 0 {
-		return SetJobWorkflowOptionsResponseMultiError(errors)
+		return GetJobVersionDiffResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// SetJobWorkflowOptionsResponseMultiError is an error wrapping multiple
-// validation errors returned by SetJobWorkflowOptionsResponse.ValidateAll()
-// if the designated constraints aren't met.
-type SetJobWorkflowOptionsResponseMultiError []error
+// GetJobVersionDiffResponseMultiError is an error wrapping multiple validation
+// errors returned by GetJobVersionDiffResponse.ValidateAll() if the
+// designated constraints aren't met.
+type GetJobVersionDiffResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m SetJobWorkflowOptionsResponseMultiError) Error() string {
+func (m GetJobVersionDiffResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -11755,12 +19515,11 @@ func (m SetJobWorkflowOptionsResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m SetJobWorkflowOptionsResponseMultiError) AllErrors() []error { return m }
+func (m GetJobVersionDiffResponseMultiError) AllErrors() []error { return m }
 
-// SetJobWorkflowOptionsResponseValidationError is the validation error
-// returned by SetJobWorkflowOptionsResponse.Validate if the designated
-// constraints aren't met.
-type SetJobWorkflowOptionsResponseValidationError struct {
+// GetJobVersionDiffResponseValidationError is the validation error returned by
+// GetJobVersionDiffResponse.Validate if the designated constraints aren't met.
+type GetJobVersionDiffResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -11768,24 +19527,24 @@ type SetJobWorkflowOptionsResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e SetJobWorkflowOptionsResponseValidationError) Field() string { return e.field }
+func (e GetJobVersionDiffResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e SetJobWorkflowOptionsResponseValidationError) Reason() string { return e.reason }
+func (e GetJobVersionDiffResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e SetJobWorkflowOptionsResponseValidationError) Cause() error { return e.cause }
+func (e GetJobVersionDiffResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e SetJobWorkflowOptionsResponseValidationError) Key() bool { return e.key }
+func (e GetJobVersionDiffResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e SetJobWorkflowOptionsResponseValidationError) ErrorName() string {
-	return "SetJobWorkflowOptionsResponseValidationError"
+func (e GetJobVersionDiffResponseValidationError) ErrorName() string {
+	return "GetJobVersionDiffResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e SetJobWorkflowOptionsResponseValidationError) Error() string {
+func (e GetJobVersionDiffResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -11797,14 +19556,14 @@ func (e SetJobWorkflowOptionsResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sSetJobWorkflowOptionsResponse.%s: %s%s",
+		"invalid %sGetJobVersionDiffResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = SetJobWorkflowOptionsResponseValidationError{}
+var _ error = GetJobVersionDiffResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -11812,75 +19571,48 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = SetJobWorkflowOptionsResponseValidationError{}
+} = GetJobVersionDiffResponseValidationError{}
 
-// Validate checks the field values on SetJobSyncOptionsRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on RollbackJobToVersionRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *SetJobSyncOptionsRequest) Validate() error {
+func (m *RollbackJobToVersionRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on SetJobSyncOptionsRequest with the
+// ValidateAll checks the field values on RollbackJobToVersionRequest with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// SetJobSyncOptionsRequestMultiError, or nil if none found.
-func (m *SetJobSyncOptionsRequest) ValidateAll() error {
+// RollbackJobToVersionRequestMultiError, or nil if none found.
+func (m *RollbackJobToVersionRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *SetJobSyncOptionsRequest) validate(all bool) error {
+func (m *RollbackJobToVersionRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Id
+	// no validation rules for JobId
 
-	if all {
-		switch v := interface{}(m.GetSyncOptions()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, SetJobSyncOptionsRequestValidationError{
-					field:  "SyncOptions",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, SetJobSyncOptionsRequestValidationError{
-					field:  "SyncOptions",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetSyncOptions()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return SetJobSyncOptionsRequestValidationError{
-				field:  "SyncOptions",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
+	// no validation rules for VersionId
 
 	if len(errors) > 0 {
-		return SetJobSyncOptionsRequestMultiError(errors)
+		return RollbackJobToVersionRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// SetJobSyncOptionsRequestMultiError is an error wrapping multiple validation
-// errors returned by SetJobSyncOptionsRequest.ValidateAll() if the designated
-// constraints aren't met.
-type SetJobSyncOptionsRequestMultiError []error
+// RollbackJobToVersionRequestMultiError is an error wrapping multiple
+// validation errors returned by RollbackJobToVersionRequest.ValidateAll() if
+// the designated constraints aren't met.
+type RollbackJobToVersionRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m SetJobSyncOptionsRequestMultiError) Error() string {
+func (m RollbackJobToVersionRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -11889,11 +19621,12 @@ func (m SetJobSyncOptionsRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m SetJobSyncOptionsRequestMultiError) AllErrors() []error { return m }
+func (m RollbackJobToVersionRequestMultiError) AllErrors() []error { return m }
 
-// SetJobSyncOptionsRequestValidationError is the validation error returned by
-// SetJobSyncOptionsRequest.Validate if the designated constraints aren't met.
-type SetJobSyncOptionsRequestValidationError struct {
+// RollbackJobToVersionRequestValidationError is the validation error returned
+// by RollbackJobToVersionRequest.Validate if the designated constraints
+// aren't met.
+type RollbackJobToVersionRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -11901,24 +19634,24 @@ type SetJobSyncOptionsRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e SetJobSyncOptionsRequestValidationError) Field() string { return e.field }
+func (e RollbackJobToVersionRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e SetJobSyncOptionsRequestValidationError) Reason() string { return e.reason }
+func (e RollbackJobToVersionRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e SetJobSyncOptionsRequestValidationError) Cause() error { return e.cause }
+func (e RollbackJobToVersionRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e SetJobSyncOptionsRequestValidationError) Key() bool { return e.key }
+func (e RollbackJobToVersionRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e SetJobSyncOptionsRequestValidationError) ErrorName() string {
-	return "SetJobSyncOptionsRequestValidationError"
+func (e RollbackJobToVersionRequestValidationError) ErrorName() string {
+	return "RollbackJobToVersionRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e SetJobSyncOptionsRequestValidationError) Error() string {
+func (e RollbackJobToVersionRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -11930,14 +19663,14 @@ func (e SetJobSyncOptionsRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sSetJobSyncOptionsRequest.%s: %s%s",
+		"invalid %sRollbackJobToVersionRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = SetJobSyncOptionsRequestValidationError{}
+var _ error = RollbackJobToVersionRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -11945,24 +19678,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = SetJobSyncOptionsRequestValidationError{}
+} = RollbackJobToVersionRequestValidationError{}
 
-// Validate checks the field values on SetJobSyncOptionsResponse with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on RollbackJobToVersionResponse with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *SetJobSyncOptionsResponse) Validate() error {
+func (m *RollbackJobToVersionResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on SetJobSyncOptionsResponse with the
+// ValidateAll checks the field values on RollbackJobToVersionResponse with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// SetJobSyncOptionsResponseMultiError, or nil if none found.
-func (m *SetJobSyncOptionsResponse) ValidateAll() error {
+// RollbackJobToVersionResponseMultiError, or nil if none found.
+func (m *RollbackJobToVersionResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *SetJobSyncOptionsResponse) validate(all bool) error {
+func (m *RollbackJobToVersionResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -11973,7 +19706,7 @@ func (m *SetJobSyncOptionsResponse) validate(all bool) error {
 		switch v := interface{}(m.GetJob()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, SetJobSyncOptionsResponseValidationError{
+				errors = append(errors, RollbackJobToVersionResponseValidationError{
 					field:  "Job",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -11981,7 +19714,7 @@ func (m *SetJobSyncOptionsResponse) validate(all bool) error {
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, SetJobSyncOptionsResponseValidationError{
+				errors = append(errors, RollbackJobToVersionResponseValidationError{
 					field:  "Job",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -11990,7 +19723,7 @@ func (m *SetJobSyncOptionsResponse) validate(all bool) error {
 		}
 	} else if v, ok := interface{}(m.GetJob()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return SetJobSyncOptionsResponseValidationError{
+			return RollbackJobToVersionResponseValidationError{
 				field:  "Job",
 				reason: "embedded message failed validation",
 				cause:  err,
@@ -11998,20 +19731,49 @@ func (m *SetJobSyncOptionsResponse) validate(all bool) error {
 		}
 	}
 
+	if all {
+		switch v := interface{}(m.GetVersion()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, RollbackJobToVersionResponseValidationError{
+					field:  "Version",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, RollbackJobToVersionResponseValidationError{
+					field:  "Version",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetVersion()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RollbackJobToVersionResponseValidationError{
+				field:  "Version",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
 	if len(errors) > 0 {
-		return SetJobSyncOptionsResponseMultiError(errors)
+		return RollbackJobToVersionResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// SetJobSyncOptionsResponseMultiError is an error wrapping multiple validation
-// errors returned by SetJobSyncOptionsResponse.ValidateAll() if the
-// designated constraints aren't met.
-type SetJobSyncOptionsResponseMultiError []error
+// RollbackJobToVersionResponseMultiError is an error wrapping multiple
+// validation errors returned by RollbackJobToVersionResponse.ValidateAll() if
+// the designated constraints aren't met.
+type RollbackJobToVersionResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m SetJobSyncOptionsResponseMultiError) Error() string {
+func (m RollbackJobToVersionResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -12020,11 +19782,12 @@ func (m SetJobSyncOptionsResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m SetJobSyncOptionsResponseMultiError) AllErrors() []error { return m }
+func (m RollbackJobToVersionResponseMultiError) AllErrors() []error { return m }
 
-// SetJobSyncOptionsResponseValidationError is the validation error returned by
-// SetJobSyncOptionsResponse.Validate if the designated constraints aren't met.
-type SetJobSyncOptionsResponseValidationError struct {
+// RollbackJobToVersionResponseValidationError is the validation error returned
+// by RollbackJobToVersionResponse.Validate if the designated constraints
+// aren't met.
+type RollbackJobToVersionResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -12032,24 +19795,24 @@ type SetJobSyncOptionsResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e SetJobSyncOptionsResponseValidationError) Field() string { return e.field }
+func (e RollbackJobToVersionResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e SetJobSyncOptionsResponseValidationError) Reason() string { return e.reason }
+func (e RollbackJobToVersionResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e SetJobSyncOptionsResponseValidationError) Cause() error { return e.cause }
+func (e RollbackJobToVersionResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e SetJobSyncOptionsResponseValidationError) Key() bool { return e.key }
+func (e RollbackJobToVersionResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e SetJobSyncOptionsResponseValidationError) ErrorName() string {
-	return "SetJobSyncOptionsResponseValidationError"
+func (e RollbackJobToVersionResponseValidationError) ErrorName() string {
+	return "RollbackJobToVersionResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e SetJobSyncOptionsResponseValidationError) Error() string {
+func (e RollbackJobToVersionResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -12061,14 +19824,14 @@ func (e SetJobSyncOptionsResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sSetJobSyncOptionsResponse.%s: %s%s",
+		"invalid %sRollbackJobToVersionResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = SetJobSyncOptionsResponseValidationError{}
+var _ error = RollbackJobToVersionResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -12076,4 +19839,4 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = SetJobSyncOptionsResponseValidationError{}
+} = RollbackJobToVersionResponseValidationError{}
@@ -0,0 +1,117 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: mgmt/v1alpha1/account_spec.proto
+
+package mgmtv1alpha1connect
+
+import (
+	connect "connectrpc.com/connect"
+	context "context"
+	errors "errors"
+	v1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
+	http "net/http"
+	strings "strings"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// AccountSpecServiceName is the fully-qualified name of the AccountSpecService service.
+	AccountSpecServiceName = "mgmt.v1alpha1.AccountSpecService"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// AccountSpecServiceApplyAccountSpecProcedure is the fully-qualified name of the
+	// AccountSpecService's ApplyAccountSpec RPC.
+	AccountSpecServiceApplyAccountSpecProcedure = "/mgmt.v1alpha1.AccountSpecService/ApplyAccountSpec"
+)
+
+// These variables are the protoreflect.Descriptor objects for the RPCs defined in this package.
+var (
+	accountSpecServiceServiceDescriptor                = v1alpha1.File_mgmt_v1alpha1_account_spec_proto.Services().ByName("AccountSpecService")
+	accountSpecServiceApplyAccountSpecMethodDescriptor = accountSpecServiceServiceDescriptor.Methods().ByName("ApplyAccountSpec")
+)
+
+// AccountSpecServiceClient is a client for the mgmt.v1alpha1.AccountSpecService service.
+type AccountSpecServiceClient interface {
+	// Creates or updates the connections, transformers and jobs of an account so that they match the spec.
+	// The whole spec is planned before anything is changed, and resources that were created are removed again if a later change fails.
+	ApplyAccountSpec(context.Context, *connect.Request[v1alpha1.ApplyAccountSpecRequest]) (*connect.Response[v1alpha1.ApplyAccountSpecResponse], error)
+}
+
+// NewAccountSpecServiceClient constructs a client for the mgmt.v1alpha1.AccountSpecService service.
+// By default, it uses the Connect protocol with the binary Protobuf Codec, asks for gzipped
+// responses, and sends uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the
+// connect.WithGRPC() or connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewAccountSpecServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) AccountSpecServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	return &accountSpecServiceClient{
+		applyAccountSpec: connect.NewClient[v1alpha1.ApplyAccountSpecRequest, v1alpha1.ApplyAccountSpecResponse](
+			httpClient,
+			baseURL+AccountSpecServiceApplyAccountSpecProcedure,
+			connect.WithSchema(accountSpecServiceApplyAccountSpecMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// accountSpecServiceClient implements AccountSpecServiceClient.
+type accountSpecServiceClient struct {
+	applyAccountSpec *connect.Client[v1alpha1.ApplyAccountSpecRequest, v1alpha1.ApplyAccountSpecResponse]
+}
+
+// ApplyAccountSpec calls mgmt.v1alpha1.AccountSpecService.ApplyAccountSpec.
+func (c *accountSpecServiceClient) ApplyAccountSpec(ctx context.Context, req *connect.Request[v1alpha1.ApplyAccountSpecRequest]) (*connect.Response[v1alpha1.ApplyAccountSpecResponse], error) {
+	return c.applyAccountSpec.CallUnary(ctx, req)
+}
+
+// AccountSpecServiceHandler is an implementation of the mgmt.v1alpha1.AccountSpecService service.
+type AccountSpecServiceHandler interface {
+	// Creates or updates the connections, transformers and jobs of an account so that they match the spec.
+	// The whole spec is planned before anything is changed, and resources that were created are removed again if a later change fails.
+	ApplyAccountSpec(context.Context, *connect.Request[v1alpha1.ApplyAccountSpecRequest]) (*connect.Response[v1alpha1.ApplyAccountSpecResponse], error)
+}
+
+// NewAccountSpecServiceHandler builds an HTTP handler from the service implementation. It returns
+// the path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewAccountSpecServiceHandler(svc AccountSpecServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	accountSpecServiceApplyAccountSpecHandler := connect.NewUnaryHandler(
+		AccountSpecServiceApplyAccountSpecProcedure,
+		svc.ApplyAccountSpec,
+		connect.WithSchema(accountSpecServiceApplyAccountSpecMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/mgmt.v1alpha1.AccountSpecService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case AccountSpecServiceApplyAccountSpecProcedure:
+			accountSpecServiceApplyAccountSpecHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedAccountSpecServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedAccountSpecServiceHandler struct{}
+
+func (UnimplementedAccountSpecServiceHandler) ApplyAccountSpec(context.Context, *connect.Request[v1alpha1.ApplyAccountSpecRequest]) (*connect.Response[v1alpha1.ApplyAccountSpecResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mgmt.v1alpha1.AccountSpecService.ApplyAccountSpec is not implemented"))
+}
@@ -0,0 +1,113 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: mgmt/v1alpha1/audit.proto
+
+package mgmtv1alpha1connect
+
+import (
+	connect "connectrpc.com/connect"
+	context "context"
+	errors "errors"
+	v1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
+	http "net/http"
+	strings "strings"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// AuditServiceName is the fully-qualified name of the AuditService service.
+	AuditServiceName = "mgmt.v1alpha1.AuditService"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// AuditServiceGetAuditEventsProcedure is the fully-qualified name of the AuditService's
+	// GetAuditEvents RPC.
+	AuditServiceGetAuditEventsProcedure = "/mgmt.v1alpha1.AuditService/GetAuditEvents"
+)
+
+// These variables are the protoreflect.Descriptor objects for the RPCs defined in this package.
+var (
+	auditServiceServiceDescriptor              = v1alpha1.File_mgmt_v1alpha1_audit_proto.Services().ByName("AuditService")
+	auditServiceGetAuditEventsMethodDescriptor = auditServiceServiceDescriptor.Methods().ByName("GetAuditEvents")
+)
+
+// AuditServiceClient is a client for the mgmt.v1alpha1.AuditService service.
+type AuditServiceClient interface {
+	GetAuditEvents(context.Context, *connect.Request[v1alpha1.GetAuditEventsRequest]) (*connect.Response[v1alpha1.GetAuditEventsResponse], error)
+}
+
+// NewAuditServiceClient constructs a client for the mgmt.v1alpha1.AuditService service. By default,
+// it uses the Connect protocol with the binary Protobuf Codec, asks for gzipped responses, and
+// sends uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the connect.WithGRPC()
+// or connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewAuditServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) AuditServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	return &auditServiceClient{
+		getAuditEvents: connect.NewClient[v1alpha1.GetAuditEventsRequest, v1alpha1.GetAuditEventsResponse](
+			httpClient,
+			baseURL+AuditServiceGetAuditEventsProcedure,
+			connect.WithSchema(auditServiceGetAuditEventsMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// auditServiceClient implements AuditServiceClient.
+type auditServiceClient struct {
+	getAuditEvents *connect.Client[v1alpha1.GetAuditEventsRequest, v1alpha1.GetAuditEventsResponse]
+}
+
+// GetAuditEvents calls mgmt.v1alpha1.AuditService.GetAuditEvents.
+func (c *auditServiceClient) GetAuditEvents(ctx context.Context, req *connect.Request[v1alpha1.GetAuditEventsRequest]) (*connect.Response[v1alpha1.GetAuditEventsResponse], error) {
+	return c.getAuditEvents.CallUnary(ctx, req)
+}
+
+// AuditServiceHandler is an implementation of the mgmt.v1alpha1.AuditService service.
+type AuditServiceHandler interface {
+	GetAuditEvents(context.Context, *connect.Request[v1alpha1.GetAuditEventsRequest]) (*connect.Response[v1alpha1.GetAuditEventsResponse], error)
+}
+
+// NewAuditServiceHandler builds an HTTP handler from the service implementation. It returns the
+// path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewAuditServiceHandler(svc AuditServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	auditServiceGetAuditEventsHandler := connect.NewUnaryHandler(
+		AuditServiceGetAuditEventsProcedure,
+		svc.GetAuditEvents,
+		connect.WithSchema(auditServiceGetAuditEventsMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/mgmt.v1alpha1.AuditService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case AuditServiceGetAuditEventsProcedure:
+			auditServiceGetAuditEventsHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedAuditServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedAuditServiceHandler struct{}
+
+func (UnimplementedAuditServiceHandler) GetAuditEvents(context.Context, *connect.Request[v1alpha1.GetAuditEventsRequest]) (*connect.Response[v1alpha1.GetAuditEventsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mgmt.v1alpha1.AuditService.GetAuditEvents is not implemented"))
+}
@@ -51,6 +51,9 @@ const (
 	// ConnectionDataServiceGetConnectionUniqueConstraintsProcedure is the fully-qualified name of the
 	// ConnectionDataService's GetConnectionUniqueConstraints RPC.
 	ConnectionDataServiceGetConnectionUniqueConstraintsProcedure = "/mgmt.v1alpha1.ConnectionDataService/GetConnectionUniqueConstraints"
+	// ConnectionDataServiceScanConnectionPiiProcedure is the fully-qualified name of the
+	// ConnectionDataService's ScanConnectionPii RPC.
+	ConnectionDataServiceScanConnectionPiiProcedure = "/mgmt.v1alpha1.ConnectionDataService/ScanConnectionPii"
 )
 
 // These variables are the protoreflect.Descriptor objects for the RPCs defined in this package.
@@ -62,6 +65,7 @@ var (
 	connectionDataServiceGetConnectionPrimaryConstraintsMethodDescriptor = connectionDataServiceServiceDescriptor.Methods().ByName("GetConnectionPrimaryConstraints")
 	connectionDataServiceGetConnectionInitStatementsMethodDescriptor     = connectionDataServiceServiceDescriptor.Methods().ByName("GetConnectionInitStatements")
 	connectionDataServiceGetConnectionUniqueConstraintsMethodDescriptor  = connectionDataServiceServiceDescriptor.Methods().ByName("GetConnectionUniqueConstraints")
+	connectionDataServiceScanConnectionPiiMethodDescriptor               = connectionDataServiceServiceDescriptor.Methods().ByName("ScanConnectionPii")
 )
 
 // ConnectionDataServiceClient is a client for the mgmt.v1alpha1.ConnectionDataService service.
@@ -82,6 +86,9 @@ type ConnectionDataServiceClient interface {
 	GetConnectionInitStatements(context.Context, *connect.Request[v1alpha1.GetConnectionInitStatementsRequest]) (*connect.Response[v1alpha1.GetConnectionInitStatementsResponse], error)
 	// For a specific connection, returns the unique constraints. Mostly useful for SQL-based connections.
 	GetConnectionUniqueConstraints(context.Context, *connect.Request[v1alpha1.GetConnectionUniqueConstraintsRequest]) (*connect.Response[v1alpha1.GetConnectionUniqueConstraintsResponse], error)
+	// Samples rows from every table in the connection and classifies which columns contain PII.
+	// Returns a suggested job mapping for each of the classified columns.
+	ScanConnectionPii(context.Context, *connect.Request[v1alpha1.ScanConnectionPiiRequest]) (*connect.Response[v1alpha1.ScanConnectionPiiResponse], error)
 }
 
 // NewConnectionDataServiceClient constructs a client for the mgmt.v1alpha1.ConnectionDataService
@@ -130,6 +137,12 @@ func NewConnectionDataServiceClient(httpClient connect.HTTPClient, baseURL strin
 			connect.WithSchema(connectionDataServiceGetConnectionUniqueConstraintsMethodDescriptor),
 			connect.WithClientOptions(opts...),
 		),
+		scanConnectionPii: connect.NewClient[v1alpha1.ScanConnectionPiiRequest, v1alpha1.ScanConnectionPiiResponse](
+			httpClient,
+			baseURL+ConnectionDataServiceScanConnectionPiiProcedure,
+			connect.WithSchema(connectionDataServiceScanConnectionPiiMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
 	}
 }
 
@@ -141,6 +154,7 @@ type connectionDataServiceClient struct {
 	getConnectionPrimaryConstraints *connect.Client[v1alpha1.GetConnectionPrimaryConstraintsRequest, v1alpha1.GetConnectionPrimaryConstraintsResponse]
 	getConnectionInitStatements     *connect.Client[v1alpha1.GetConnectionInitStatementsRequest, v1alpha1.GetConnectionInitStatementsResponse]
 	getConnectionUniqueConstraints  *connect.Client[v1alpha1.GetConnectionUniqueConstraintsRequest, v1alpha1.GetConnectionUniqueConstraintsResponse]
+	scanConnectionPii               *connect.Client[v1alpha1.ScanConnectionPiiRequest, v1alpha1.ScanConnectionPiiResponse]
 }
 
 // GetConnectionDataStream calls mgmt.v1alpha1.ConnectionDataService.GetConnectionDataStream.
@@ -177,6 +191,11 @@ func (c *connectionDataServiceClient) GetConnectionUniqueConstraints(ctx context
 	return c.getConnectionUniqueConstraints.CallUnary(ctx, req)
 }
 
+// ScanConnectionPii calls mgmt.v1alpha1.ConnectionDataService.ScanConnectionPii.
+func (c *connectionDataServiceClient) ScanConnectionPii(ctx context.Context, req *connect.Request[v1alpha1.ScanConnectionPiiRequest]) (*connect.Response[v1alpha1.ScanConnectionPiiResponse], error) {
+	return c.scanConnectionPii.CallUnary(ctx, req)
+}
+
 // ConnectionDataServiceHandler is an implementation of the mgmt.v1alpha1.ConnectionDataService
 // service.
 type ConnectionDataServiceHandler interface {
@@ -196,6 +215,9 @@ type ConnectionDataServiceHandler interface {
 	GetConnectionInitStatements(context.Context, *connect.Request[v1alpha1.GetConnectionInitStatementsRequest]) (*connect.Response[v1alpha1.GetConnectionInitStatementsResponse], error)
 	// For a specific connection, returns the unique constraints. Mostly useful for SQL-based connections.
 	GetConnectionUniqueConstraints(context.Context, *connect.Request[v1alpha1.GetConnectionUniqueConstraintsRequest]) (*connect.Response[v1alpha1.GetConnectionUniqueConstraintsResponse], error)
+	// Samples rows from every table in the connection and classifies which columns contain PII.
+	// Returns a suggested job mapping for each of the classified columns.
+	ScanConnectionPii(context.Context, *connect.Request[v1alpha1.ScanConnectionPiiRequest]) (*connect.Response[v1alpha1.ScanConnectionPiiResponse], error)
 }
 
 // NewConnectionDataServiceHandler builds an HTTP handler from the service implementation. It
@@ -240,6 +262,12 @@ func NewConnectionDataServiceHandler(svc ConnectionDataServiceHandler, opts ...c
 		connect.WithSchema(connectionDataServiceGetConnectionUniqueConstraintsMethodDescriptor),
 		connect.WithHandlerOptions(opts...),
 	)
+	connectionDataServiceScanConnectionPiiHandler := connect.NewUnaryHandler(
+		ConnectionDataServiceScanConnectionPiiProcedure,
+		svc.ScanConnectionPii,
+		connect.WithSchema(connectionDataServiceScanConnectionPiiMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
 	return "/mgmt.v1alpha1.ConnectionDataService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case ConnectionDataServiceGetConnectionDataStreamProcedure:
@@ -254,6 +282,8 @@ func NewConnectionDataServiceHandler(svc ConnectionDataServiceHandler, opts ...c
 			connectionDataServiceGetConnectionInitStatementsHandler.ServeHTTP(w, r)
 		case ConnectionDataServiceGetConnectionUniqueConstraintsProcedure:
 			connectionDataServiceGetConnectionUniqueConstraintsHandler.ServeHTTP(w, r)
+		case ConnectionDataServiceScanConnectionPiiProcedure:
+			connectionDataServiceScanConnectionPiiHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -286,3 +316,7 @@ func (UnimplementedConnectionDataServiceHandler) GetConnectionInitStatements(con
 func (UnimplementedConnectionDataServiceHandler) GetConnectionUniqueConstraints(context.Context, *connect.Request[v1alpha1.GetConnectionUniqueConstraintsRequest]) (*connect.Response[v1alpha1.GetConnectionUniqueConstraintsResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mgmt.v1alpha1.ConnectionDataService.GetConnectionUniqueConstraints is not implemented"))
 }
+
+func (UnimplementedConnectionDataServiceHandler) ScanConnectionPii(context.Context, *connect.Request[v1alpha1.ScanConnectionPiiRequest]) (*connect.Response[v1alpha1.ScanConnectionPiiResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mgmt.v1alpha1.ConnectionDataService.ScanConnectionPii is not implemented"))
+}
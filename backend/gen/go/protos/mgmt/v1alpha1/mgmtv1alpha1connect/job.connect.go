@@ -100,6 +100,48 @@ const (
 	// JobServiceSetJobSyncOptionsProcedure is the fully-qualified name of the JobService's
 	// SetJobSyncOptions RPC.
 	JobServiceSetJobSyncOptionsProcedure = "/mgmt.v1alpha1.JobService/SetJobSyncOptions"
+	// JobServiceSetJobTriggersProcedure is the fully-qualified name of the JobService's SetJobTriggers
+	// RPC.
+	JobServiceSetJobTriggersProcedure = "/mgmt.v1alpha1.JobService/SetJobTriggers"
+	// JobServiceTriggerDependentJobsProcedure is the fully-qualified name of the JobService's
+	// TriggerDependentJobs RPC.
+	JobServiceTriggerDependentJobsProcedure = "/mgmt.v1alpha1.JobService/TriggerDependentJobs"
+	// JobServiceGetJobWatermarksProcedure is the fully-qualified name of the JobService's
+	// GetJobWatermarks RPC.
+	JobServiceGetJobWatermarksProcedure = "/mgmt.v1alpha1.JobService/GetJobWatermarks"
+	// JobServiceSetJobRunWatermarksProcedure is the fully-qualified name of the JobService's
+	// SetJobRunWatermarks RPC.
+	JobServiceSetJobRunWatermarksProcedure = "/mgmt.v1alpha1.JobService/SetJobRunWatermarks"
+	// JobServiceResetJobWatermarksProcedure is the fully-qualified name of the JobService's
+	// ResetJobWatermarks RPC.
+	JobServiceResetJobWatermarksProcedure = "/mgmt.v1alpha1.JobService/ResetJobWatermarks"
+	// JobServiceSetJobRunValidationReportProcedure is the fully-qualified name of the JobService's
+	// SetJobRunValidationReport RPC.
+	JobServiceSetJobRunValidationReportProcedure = "/mgmt.v1alpha1.JobService/SetJobRunValidationReport"
+	// JobServiceGetJobRunValidationReportProcedure is the fully-qualified name of the JobService's
+	// GetJobRunValidationReport RPC.
+	JobServiceGetJobRunValidationReportProcedure = "/mgmt.v1alpha1.JobService/GetJobRunValidationReport"
+	// JobServiceSetJobRunSchemaDriftReportProcedure is the fully-qualified name of the JobService's
+	// SetJobRunSchemaDriftReport RPC.
+	JobServiceSetJobRunSchemaDriftReportProcedure = "/mgmt.v1alpha1.JobService/SetJobRunSchemaDriftReport"
+	// JobServiceGetJobRunSchemaDriftReportProcedure is the fully-qualified name of the JobService's
+	// GetJobRunSchemaDriftReport RPC.
+	JobServiceGetJobRunSchemaDriftReportProcedure = "/mgmt.v1alpha1.JobService/GetJobRunSchemaDriftReport"
+	// JobServiceApplyJobRunSchemaDriftReportProcedure is the fully-qualified name of the JobService's
+	// ApplyJobRunSchemaDriftReport RPC.
+	JobServiceApplyJobRunSchemaDriftReportProcedure = "/mgmt.v1alpha1.JobService/ApplyJobRunSchemaDriftReport"
+	// JobServicePreviewJobMappingsProcedure is the fully-qualified name of the JobService's
+	// PreviewJobMappings RPC.
+	JobServicePreviewJobMappingsProcedure = "/mgmt.v1alpha1.JobService/PreviewJobMappings"
+	// JobServiceGetJobVersionsProcedure is the fully-qualified name of the JobService's GetJobVersions
+	// RPC.
+	JobServiceGetJobVersionsProcedure = "/mgmt.v1alpha1.JobService/GetJobVersions"
+	// JobServiceGetJobVersionDiffProcedure is the fully-qualified name of the JobService's
+	// GetJobVersionDiff RPC.
+	JobServiceGetJobVersionDiffProcedure = "/mgmt.v1alpha1.JobService/GetJobVersionDiff"
+	// JobServiceRollbackJobToVersionProcedure is the fully-qualified name of the JobService's
+	// RollbackJobToVersion RPC.
+	JobServiceRollbackJobToVersionProcedure = "/mgmt.v1alpha1.JobService/RollbackJobToVersion"
 )
 
 // These variables are the protoreflect.Descriptor objects for the RPCs defined in this package.
@@ -131,6 +173,20 @@ var (
 	jobServiceGetJobRunLogsStreamMethodDescriptor              = jobServiceServiceDescriptor.Methods().ByName("GetJobRunLogsStream")
 	jobServiceSetJobWorkflowOptionsMethodDescriptor            = jobServiceServiceDescriptor.Methods().ByName("SetJobWorkflowOptions")
 	jobServiceSetJobSyncOptionsMethodDescriptor                = jobServiceServiceDescriptor.Methods().ByName("SetJobSyncOptions")
+	jobServiceSetJobTriggersMethodDescriptor                   = jobServiceServiceDescriptor.Methods().ByName("SetJobTriggers")
+	jobServiceTriggerDependentJobsMethodDescriptor             = jobServiceServiceDescriptor.Methods().ByName("TriggerDependentJobs")
+	jobServiceGetJobWatermarksMethodDescriptor                 = jobServiceServiceDescriptor.Methods().ByName("GetJobWatermarks")
+	jobServiceSetJobRunWatermarksMethodDescriptor              = jobServiceServiceDescriptor.Methods().ByName("SetJobRunWatermarks")
+	jobServiceResetJobWatermarksMethodDescriptor               = jobServiceServiceDescriptor.Methods().ByName("ResetJobWatermarks")
+	jobServiceSetJobRunValidationReportMethodDescriptor        = jobServiceServiceDescriptor.Methods().ByName("SetJobRunValidationReport")
+	jobServiceGetJobRunValidationReportMethodDescriptor        = jobServiceServiceDescriptor.Methods().ByName("GetJobRunValidationReport")
+	jobServiceSetJobRunSchemaDriftReportMethodDescriptor       = jobServiceServiceDescriptor.Methods().ByName("SetJobRunSchemaDriftReport")
+	jobServiceGetJobRunSchemaDriftReportMethodDescriptor       = jobServiceServiceDescriptor.Methods().ByName("GetJobRunSchemaDriftReport")
+	jobServiceApplyJobRunSchemaDriftReportMethodDescriptor     = jobServiceServiceDescriptor.Methods().ByName("ApplyJobRunSchemaDriftReport")
+	jobServicePreviewJobMappingsMethodDescriptor               = jobServiceServiceDescriptor.Methods().ByName("PreviewJobMappings")
+	jobServiceGetJobVersionsMethodDescriptor                   = jobServiceServiceDescriptor.Methods().ByName("GetJobVersions")
+	jobServiceGetJobVersionDiffMethodDescriptor                = jobServiceServiceDescriptor.Methods().ByName("GetJobVersionDiff")
+	jobServiceRollbackJobToVersionMethodDescriptor             = jobServiceServiceDescriptor.Methods().ByName("RollbackJobToVersion")
 )
 
 // JobServiceClient is a client for the mgmt.v1alpha1.JobService service.
@@ -168,6 +224,34 @@ type JobServiceClient interface {
 	SetJobWorkflowOptions(context.Context, *connect.Request[v1alpha1.SetJobWorkflowOptionsRequest]) (*connect.Response[v1alpha1.SetJobWorkflowOptionsResponse], error)
 	// Set the job sync options. Must provide entire object as it will fully override the previous configuration
 	SetJobSyncOptions(context.Context, *connect.Request[v1alpha1.SetJobSyncOptionsRequest]) (*connect.Response[v1alpha1.SetJobSyncOptionsResponse], error)
+	// Set the jobs whose runs start a run of this job. Must provide every trigger as it will fully override the previous configuration
+	SetJobTriggers(context.Context, *connect.Request[v1alpha1.SetJobTriggersRequest]) (*connect.Response[v1alpha1.SetJobTriggersResponse], error)
+	// Starts a run of every job with a trigger that matches the outcome of the upstream job run. Called by the worker when a job run completes
+	TriggerDependentJobs(context.Context, *connect.Request[v1alpha1.TriggerDependentJobsRequest]) (*connect.Response[v1alpha1.TriggerDependentJobsResponse], error)
+	// Returns the current high-water marks for each table in the job that is configured for incremental sync
+	GetJobWatermarks(context.Context, *connect.Request[v1alpha1.GetJobWatermarksRequest]) (*connect.Response[v1alpha1.GetJobWatermarksResponse], error)
+	// Records the watermarks that were synced by a successful job run and advances the job's high-water marks
+	SetJobRunWatermarks(context.Context, *connect.Request[v1alpha1.SetJobRunWatermarksRequest]) (*connect.Response[v1alpha1.SetJobRunWatermarksResponse], error)
+	// Resets the high-water marks so that the next run re-syncs the affected tables from the beginning
+	ResetJobWatermarks(context.Context, *connect.Request[v1alpha1.ResetJobWatermarksRequest]) (*connect.Response[v1alpha1.ResetJobWatermarksResponse], error)
+	// Records the post-sync validation report for a job run
+	SetJobRunValidationReport(context.Context, *connect.Request[v1alpha1.SetJobRunValidationReportRequest]) (*connect.Response[v1alpha1.SetJobRunValidationReportResponse], error)
+	// Returns the post-sync validation report for a job run
+	GetJobRunValidationReport(context.Context, *connect.Request[v1alpha1.GetJobRunValidationReportRequest]) (*connect.Response[v1alpha1.GetJobRunValidationReportResponse], error)
+	// Records the schema drift report for a job run
+	SetJobRunSchemaDriftReport(context.Context, *connect.Request[v1alpha1.SetJobRunSchemaDriftReportRequest]) (*connect.Response[v1alpha1.SetJobRunSchemaDriftReportResponse], error)
+	// Returns the schema drift report for a job run, or the most recent report of the job
+	GetJobRunSchemaDriftReport(context.Context, *connect.Request[v1alpha1.GetJobRunSchemaDriftReportRequest]) (*connect.Response[v1alpha1.GetJobRunSchemaDriftReportResponse], error)
+	// Updates the job mappings with the changes in a schema drift report. New columns are mapped to their suggested transformer, renamed columns keep their transformer and removed columns are unmapped
+	ApplyJobRunSchemaDriftReport(context.Context, *connect.Request[v1alpha1.ApplyJobRunSchemaDriftReportRequest]) (*connect.Response[v1alpha1.ApplyJobRunSchemaDriftReportResponse], error)
+	// Runs a sample of source rows through the job mappings and returns them before and after they were transformed. Nothing is written to the destinations
+	PreviewJobMappings(context.Context, *connect.Request[v1alpha1.PreviewJobMappingsRequest]) (*connect.Response[v1alpha1.PreviewJobMappingsResponse], error)
+	// Returns every version of the job, newest first
+	GetJobVersions(context.Context, *connect.Request[v1alpha1.GetJobVersionsRequest]) (*connect.Response[v1alpha1.GetJobVersionsResponse], error)
+	// Returns the schedule, source, mapping and destination changes between two versions of a job
+	GetJobVersionDiff(context.Context, *connect.Request[v1alpha1.GetJobVersionDiffRequest]) (*connect.Response[v1alpha1.GetJobVersionDiffResponse], error)
+	// Restores the schedule, source, mappings and destinations of a job to a previous version. The rollback is recorded as a new version
+	RollbackJobToVersion(context.Context, *connect.Request[v1alpha1.RollbackJobToVersionRequest]) (*connect.Response[v1alpha1.RollbackJobToVersionResponse], error)
 }
 
 // NewJobServiceClient constructs a client for the mgmt.v1alpha1.JobService service. By default, it
@@ -336,6 +420,90 @@ func NewJobServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...
 			connect.WithSchema(jobServiceSetJobSyncOptionsMethodDescriptor),
 			connect.WithClientOptions(opts...),
 		),
+		setJobTriggers: connect.NewClient[v1alpha1.SetJobTriggersRequest, v1alpha1.SetJobTriggersResponse](
+			httpClient,
+			baseURL+JobServiceSetJobTriggersProcedure,
+			connect.WithSchema(jobServiceSetJobTriggersMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		triggerDependentJobs: connect.NewClient[v1alpha1.TriggerDependentJobsRequest, v1alpha1.TriggerDependentJobsResponse](
+			httpClient,
+			baseURL+JobServiceTriggerDependentJobsProcedure,
+			connect.WithSchema(jobServiceTriggerDependentJobsMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		getJobWatermarks: connect.NewClient[v1alpha1.GetJobWatermarksRequest, v1alpha1.GetJobWatermarksResponse](
+			httpClient,
+			baseURL+JobServiceGetJobWatermarksProcedure,
+			connect.WithSchema(jobServiceGetJobWatermarksMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		setJobRunWatermarks: connect.NewClient[v1alpha1.SetJobRunWatermarksRequest, v1alpha1.SetJobRunWatermarksResponse](
+			httpClient,
+			baseURL+JobServiceSetJobRunWatermarksProcedure,
+			connect.WithSchema(jobServiceSetJobRunWatermarksMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		resetJobWatermarks: connect.NewClient[v1alpha1.ResetJobWatermarksRequest, v1alpha1.ResetJobWatermarksResponse](
+			httpClient,
+			baseURL+JobServiceResetJobWatermarksProcedure,
+			connect.WithSchema(jobServiceResetJobWatermarksMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		setJobRunValidationReport: connect.NewClient[v1alpha1.SetJobRunValidationReportRequest, v1alpha1.SetJobRunValidationReportResponse](
+			httpClient,
+			baseURL+JobServiceSetJobRunValidationReportProcedure,
+			connect.WithSchema(jobServiceSetJobRunValidationReportMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		getJobRunValidationReport: connect.NewClient[v1alpha1.GetJobRunValidationReportRequest, v1alpha1.GetJobRunValidationReportResponse](
+			httpClient,
+			baseURL+JobServiceGetJobRunValidationReportProcedure,
+			connect.WithSchema(jobServiceGetJobRunValidationReportMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		setJobRunSchemaDriftReport: connect.NewClient[v1alpha1.SetJobRunSchemaDriftReportRequest, v1alpha1.SetJobRunSchemaDriftReportResponse](
+			httpClient,
+			baseURL+JobServiceSetJobRunSchemaDriftReportProcedure,
+			connect.WithSchema(jobServiceSetJobRunSchemaDriftReportMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		getJobRunSchemaDriftReport: connect.NewClient[v1alpha1.GetJobRunSchemaDriftReportRequest, v1alpha1.GetJobRunSchemaDriftReportResponse](
+			httpClient,
+			baseURL+JobServiceGetJobRunSchemaDriftReportProcedure,
+			connect.WithSchema(jobServiceGetJobRunSchemaDriftReportMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		applyJobRunSchemaDriftReport: connect.NewClient[v1alpha1.ApplyJobRunSchemaDriftReportRequest, v1alpha1.ApplyJobRunSchemaDriftReportResponse](
+			httpClient,
+			baseURL+JobServiceApplyJobRunSchemaDriftReportProcedure,
+			connect.WithSchema(jobServiceApplyJobRunSchemaDriftReportMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		previewJobMappings: connect.NewClient[v1alpha1.PreviewJobMappingsRequest, v1alpha1.PreviewJobMappingsResponse](
+			httpClient,
+			baseURL+JobServicePreviewJobMappingsProcedure,
+			connect.WithSchema(jobServicePreviewJobMappingsMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		getJobVersions: connect.NewClient[v1alpha1.GetJobVersionsRequest, v1alpha1.GetJobVersionsResponse](
+			httpClient,
+			baseURL+JobServiceGetJobVersionsProcedure,
+			connect.WithSchema(jobServiceGetJobVersionsMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		getJobVersionDiff: connect.NewClient[v1alpha1.GetJobVersionDiffRequest, v1alpha1.GetJobVersionDiffResponse](
+			httpClient,
+			baseURL+JobServiceGetJobVersionDiffProcedure,
+			connect.WithSchema(jobServiceGetJobVersionDiffMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		rollbackJobToVersion: connect.NewClient[v1alpha1.RollbackJobToVersionRequest, v1alpha1.RollbackJobToVersionResponse](
+			httpClient,
+			baseURL+JobServiceRollbackJobToVersionProcedure,
+			connect.WithSchema(jobServiceRollbackJobToVersionMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
 	}
 }
 
@@ -367,6 +535,20 @@ type jobServiceClient struct {
 	getJobRunLogsStream              *connect.Client[v1alpha1.GetJobRunLogsStreamRequest, v1alpha1.GetJobRunLogsStreamResponse]
 	setJobWorkflowOptions            *connect.Client[v1alpha1.SetJobWorkflowOptionsRequest, v1alpha1.SetJobWorkflowOptionsResponse]
 	setJobSyncOptions                *connect.Client[v1alpha1.SetJobSyncOptionsRequest, v1alpha1.SetJobSyncOptionsResponse]
+	setJobTriggers                   *connect.Client[v1alpha1.SetJobTriggersRequest, v1alpha1.SetJobTriggersResponse]
+	triggerDependentJobs             *connect.Client[v1alpha1.TriggerDependentJobsRequest, v1alpha1.TriggerDependentJobsResponse]
+	getJobWatermarks                 *connect.Client[v1alpha1.GetJobWatermarksRequest, v1alpha1.GetJobWatermarksResponse]
+	setJobRunWatermarks              *connect.Client[v1alpha1.SetJobRunWatermarksRequest, v1alpha1.SetJobRunWatermarksResponse]
+	resetJobWatermarks               *connect.Client[v1alpha1.ResetJobWatermarksRequest, v1alpha1.ResetJobWatermarksResponse]
+	setJobRunValidationReport        *connect.Client[v1alpha1.SetJobRunValidationReportRequest, v1alpha1.SetJobRunValidationReportResponse]
+	getJobRunValidationReport        *connect.Client[v1alpha1.GetJobRunValidationReportRequest, v1alpha1.GetJobRunValidationReportResponse]
+	setJobRunSchemaDriftReport       *connect.Client[v1alpha1.SetJobRunSchemaDriftReportRequest, v1alpha1.SetJobRunSchemaDriftReportResponse]
+	getJobRunSchemaDriftReport       *connect.Client[v1alpha1.GetJobRunSchemaDriftReportRequest, v1alpha1.GetJobRunSchemaDriftReportResponse]
+	applyJobRunSchemaDriftReport     *connect.Client[v1alpha1.ApplyJobRunSchemaDriftReportRequest, v1alpha1.ApplyJobRunSchemaDriftReportResponse]
+	previewJobMappings               *connect.Client[v1alpha1.PreviewJobMappingsRequest, v1alpha1.PreviewJobMappingsResponse]
+	getJobVersions                   *connect.Client[v1alpha1.GetJobVersionsRequest, v1alpha1.GetJobVersionsResponse]
+	getJobVersionDiff                *connect.Client[v1alpha1.GetJobVersionDiffRequest, v1alpha1.GetJobVersionDiffResponse]
+	rollbackJobToVersion             *connect.Client[v1alpha1.RollbackJobToVersionRequest, v1alpha1.RollbackJobToVersionResponse]
 }
 
 // GetJobs calls mgmt.v1alpha1.JobService.GetJobs.
@@ -499,6 +681,76 @@ func (c *jobServiceClient) SetJobSyncOptions(ctx context.Context, req *connect.R
 	return c.setJobSyncOptions.CallUnary(ctx, req)
 }
 
+// SetJobTriggers calls mgmt.v1alpha1.JobService.SetJobTriggers.
+func (c *jobServiceClient) SetJobTriggers(ctx context.Context, req *connect.Request[v1alpha1.SetJobTriggersRequest]) (*connect.Response[v1alpha1.SetJobTriggersResponse], error) {
+	return c.setJobTriggers.CallUnary(ctx, req)
+}
+
+// TriggerDependentJobs calls mgmt.v1alpha1.JobService.TriggerDependentJobs.
+func (c *jobServiceClient) TriggerDependentJobs(ctx context.Context, req *connect.Request[v1alpha1.TriggerDependentJobsRequest]) (*connect.Response[v1alpha1.TriggerDependentJobsResponse], error) {
+	return c.triggerDependentJobs.CallUnary(ctx, req)
+}
+
+// GetJobWatermarks calls mgmt.v1alpha1.JobService.GetJobWatermarks.
+func (c *jobServiceClient) GetJobWatermarks(ctx context.Context, req *connect.Request[v1alpha1.GetJobWatermarksRequest]) (*connect.Response[v1alpha1.GetJobWatermarksResponse], error) {
+	return c.getJobWatermarks.CallUnary(ctx, req)
+}
+
+// SetJobRunWatermarks calls mgmt.v1alpha1.JobService.SetJobRunWatermarks.
+func (c *jobServiceClient) SetJobRunWatermarks(ctx context.Context, req *connect.Request[v1alpha1.SetJobRunWatermarksRequest]) (*connect.Response[v1alpha1.SetJobRunWatermarksResponse], error) {
+	return c.setJobRunWatermarks.CallUnary(ctx, req)
+}
+
+// ResetJobWatermarks calls mgmt.v1alpha1.JobService.ResetJobWatermarks.
+func (c *jobServiceClient) ResetJobWatermarks(ctx context.Context, req *connect.Request[v1alpha1.ResetJobWatermarksRequest]) (*connect.Response[v1alpha1.ResetJobWatermarksResponse], error) {
+	return c.resetJobWatermarks.CallUnary(ctx, req)
+}
+
+// SetJobRunValidationReport calls mgmt.v1alpha1.JobService.SetJobRunValidationReport.
+func (c *jobServiceClient) SetJobRunValidationReport(ctx context.Context, req *connect.Request[v1alpha1.SetJobRunValidationReportRequest]) (*connect.Response[v1alpha1.SetJobRunValidationReportResponse], error) {
+	return c.setJobRunValidationReport.CallUnary(ctx, req)
+}
+
+// GetJobRunValidationReport calls mgmt.v1alpha1.JobService.GetJobRunValidationReport.
+func (c *jobServiceClient) GetJobRunValidationReport(ctx context.Context, req *connect.Request[v1alpha1.GetJobRunValidationReportRequest]) (*connect.Response[v1alpha1.GetJobRunValidationReportResponse], error) {
+	return c.getJobRunValidationReport.CallUnary(ctx, req)
+}
+
+// SetJobRunSchemaDriftReport calls mgmt.v1alpha1.JobService.SetJobRunSchemaDriftReport.
+func (c *jobServiceClient) SetJobRunSchemaDriftReport(ctx context.Context, req *connect.Request[v1alpha1.SetJobRunSchemaDriftReportRequest]) (*connect.Response[v1alpha1.SetJobRunSchemaDriftReportResponse], error) {
+	return c.setJobRunSchemaDriftReport.CallUnary(ctx, req)
+}
+
+// GetJobRunSchemaDriftReport calls mgmt.v1alpha1.JobService.GetJobRunSchemaDriftReport.
+func (c *jobServiceClient) GetJobRunSchemaDriftReport(ctx context.Context, req *connect.Request[v1alpha1.GetJobRunSchemaDriftReportRequest]) (*connect.Response[v1alpha1.GetJobRunSchemaDriftReportResponse], error) {
+	return c.getJobRunSchemaDriftReport.CallUnary(ctx, req)
+}
+
+// ApplyJobRunSchemaDriftReport calls mgmt.v1alpha1.JobService.ApplyJobRunSchemaDriftReport.
+func (c *jobServiceClient) ApplyJobRunSchemaDriftReport(ctx context.Context, req *connect.Request[v1alpha1.ApplyJobRunSchemaDriftReportRequest]) (*connect.Response[v1alpha1.ApplyJobRunSchemaDriftReportResponse], error) {
+	return c.applyJobRunSchemaDriftReport.CallUnary(ctx, req)
+}
+
+// PreviewJobMappings calls mgmt.v1alpha1.JobService.PreviewJobMappings.
+func (c *jobServiceClient) PreviewJobMappings(ctx context.Context, req *connect.Request[v1alpha1.PreviewJobMappingsRequest]) (*connect.Response[v1alpha1.PreviewJobMappingsResponse], error) {
+	return c.previewJobMappings.CallUnary(ctx, req)
+}
+
+// GetJobVersions calls mgmt.v1alpha1.JobService.GetJobVersions.
+func (c *jobServiceClient) GetJobVersions(ctx context.Context, req *connect.Request[v1alpha1.GetJobVersionsRequest]) (*connect.Response[v1alpha1.GetJobVersionsResponse], error) {
+	return c.getJobVersions.CallUnary(ctx, req)
+}
+
+// GetJobVersionDiff calls mgmt.v1alpha1.JobService.GetJobVersionDiff.
+func (c *jobServiceClient) GetJobVersionDiff(ctx context.Context, req *connect.Request[v1alpha1.GetJobVersionDiffRequest]) (*connect.Response[v1alpha1.GetJobVersionDiffResponse], error) {
+	return c.getJobVersionDiff.CallUnary(ctx, req)
+}
+
+// RollbackJobToVersion calls mgmt.v1alpha1.JobService.RollbackJobToVersion.
+func (c *jobServiceClient) RollbackJobToVersion(ctx context.Context, req *connect.Request[v1alpha1.RollbackJobToVersionRequest]) (*connect.Response[v1alpha1.RollbackJobToVersionResponse], error) {
+	return c.rollbackJobToVersion.CallUnary(ctx, req)
+}
+
 // JobServiceHandler is an implementation of the mgmt.v1alpha1.JobService service.
 type JobServiceHandler interface {
 	GetJobs(context.Context, *connect.Request[v1alpha1.GetJobsRequest]) (*connect.Response[v1alpha1.GetJobsResponse], error)
@@ -534,6 +786,34 @@ type JobServiceHandler interface {
 	SetJobWorkflowOptions(context.Context, *connect.Request[v1alpha1.SetJobWorkflowOptionsRequest]) (*connect.Response[v1alpha1.SetJobWorkflowOptionsResponse], error)
 	// Set the job sync options. Must provide entire object as it will fully override the previous configuration
 	SetJobSyncOptions(context.Context, *connect.Request[v1alpha1.SetJobSyncOptionsRequest]) (*connect.Response[v1alpha1.SetJobSyncOptionsResponse], error)
+	// Set the jobs whose runs start a run of this job. Must provide every trigger as it will fully override the previous configuration
+	SetJobTriggers(context.Context, *connect.Request[v1alpha1.SetJobTriggersRequest]) (*connect.Response[v1alpha1.SetJobTriggersResponse], error)
+	// Starts a run of every job with a trigger that matches the outcome of the upstream job run. Called by the worker when a job run completes
+	TriggerDependentJobs(context.Context, *connect.Request[v1alpha1.TriggerDependentJobsRequest]) (*connect.Response[v1alpha1.TriggerDependentJobsResponse], error)
+	// Returns the current high-water marks for each table in the job that is configured for incremental sync
+	GetJobWatermarks(context.Context, *connect.Request[v1alpha1.GetJobWatermarksRequest]) (*connect.Response[v1alpha1.GetJobWatermarksResponse], error)
+	// Records the watermarks that were synced by a successful job run and advances the job's high-water marks
+	SetJobRunWatermarks(context.Context, *connect.Request[v1alpha1.SetJobRunWatermarksRequest]) (*connect.Response[v1alpha1.SetJobRunWatermarksResponse], error)
+	// Resets the high-water marks so that the next run re-syncs the affected tables from the beginning
+	ResetJobWatermarks(context.Context, *connect.Request[v1alpha1.ResetJobWatermarksRequest]) (*connect.Response[v1alpha1.ResetJobWatermarksResponse], error)
+	// Records the post-sync validation report for a job run
+	SetJobRunValidationReport(context.Context, *connect.Request[v1alpha1.SetJobRunValidationReportRequest]) (*connect.Response[v1alpha1.SetJobRunValidationReportResponse], error)
+	// Returns the post-sync validation report for a job run
+	GetJobRunValidationReport(context.Context, *connect.Request[v1alpha1.GetJobRunValidationReportRequest]) (*connect.Response[v1alpha1.GetJobRunValidationReportResponse], error)
+	// Records the schema drift report for a job run
+	SetJobRunSchemaDriftReport(context.Context, *connect.Request[v1alpha1.SetJobRunSchemaDriftReportRequest]) (*connect.Response[v1alpha1.SetJobRunSchemaDriftReportResponse], error)
+	// Returns the schema drift report for a job run, or the most recent report of the job
+	GetJobRunSchemaDriftReport(context.Context, *connect.Request[v1alpha1.GetJobRunSchemaDriftReportRequest]) (*connect.Response[v1alpha1.GetJobRunSchemaDriftReportResponse], error)
+	// Updates the job mappings with the changes in a schema drift report. New columns are mapped to their suggested transformer, renamed columns keep their transformer and removed columns are unmapped
+	ApplyJobRunSchemaDriftReport(context.Context, *connect.Request[v1alpha1.ApplyJobRunSchemaDriftReportRequest]) (*connect.Response[v1alpha1.ApplyJobRunSchemaDriftReportResponse], error)
+	// Runs a sample of source rows through the job mappings and returns them before and after they were transformed. Nothing is written to the destinations
+	PreviewJobMappings(context.Context, *connect.Request[v1alpha1.PreviewJobMappingsRequest]) (*connect.Response[v1alpha1.PreviewJobMappingsResponse], error)
+	// Returns every version of the job, newest first
+	GetJobVersions(context.Context, *connect.Request[v1alpha1.GetJobVersionsRequest]) (*connect.Response[v1alpha1.GetJobVersionsResponse], error)
+	// Returns the schedule, source, mapping and destination changes between two versions of a job
+	GetJobVersionDiff(context.Context, *connect.Request[v1alpha1.GetJobVersionDiffRequest]) (*connect.Response[v1alpha1.GetJobVersionDiffResponse], error)
+	// Restores the schedule, source, mappings and destinations of a job to a previous version. The rollback is recorded as a new version
+	RollbackJobToVersion(context.Context, *connect.Request[v1alpha1.RollbackJobToVersionRequest]) (*connect.Response[v1alpha1.RollbackJobToVersionResponse], error)
 }
 
 // NewJobServiceHandler builds an HTTP handler from the service implementation. It returns the path
@@ -698,6 +978,90 @@ func NewJobServiceHandler(svc JobServiceHandler, opts ...connect.HandlerOption)
 		connect.WithSchema(jobServiceSetJobSyncOptionsMethodDescriptor),
 		connect.WithHandlerOptions(opts...),
 	)
+	jobServiceSetJobTriggersHandler := connect.NewUnaryHandler(
+		JobServiceSetJobTriggersProcedure,
+		svc.SetJobTriggers,
+		connect.WithSchema(jobServiceSetJobTriggersMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	jobServiceTriggerDependentJobsHandler := connect.NewUnaryHandler(
+		JobServiceTriggerDependentJobsProcedure,
+		svc.TriggerDependentJobs,
+		connect.WithSchema(jobServiceTriggerDependentJobsMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	jobServiceGetJobWatermarksHandler := connect.NewUnaryHandler(
+		JobServiceGetJobWatermarksProcedure,
+		svc.GetJobWatermarks,
+		connect.WithSchema(jobServiceGetJobWatermarksMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	jobServiceSetJobRunWatermarksHandler := connect.NewUnaryHandler(
+		JobServiceSetJobRunWatermarksProcedure,
+		svc.SetJobRunWatermarks,
+		connect.WithSchema(jobServiceSetJobRunWatermarksMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	jobServiceResetJobWatermarksHandler := connect.NewUnaryHandler(
+		JobServiceResetJobWatermarksProcedure,
+		svc.ResetJobWatermarks,
+		connect.WithSchema(jobServiceResetJobWatermarksMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	jobServiceSetJobRunValidationReportHandler := connect.NewUnaryHandler(
+		JobServiceSetJobRunValidationReportProcedure,
+		svc.SetJobRunValidationReport,
+		connect.WithSchema(jobServiceSetJobRunValidationReportMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	jobServiceGetJobRunValidationReportHandler := connect.NewUnaryHandler(
+		JobServiceGetJobRunValidationReportProcedure,
+		svc.GetJobRunValidationReport,
+		connect.WithSchema(jobServiceGetJobRunValidationReportMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	jobServiceSetJobRunSchemaDriftReportHandler := connect.NewUnaryHandler(
+		JobServiceSetJobRunSchemaDriftReportProcedure,
+		svc.SetJobRunSchemaDriftReport,
+		connect.WithSchema(jobServiceSetJobRunSchemaDriftReportMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	jobServiceGetJobRunSchemaDriftReportHandler := connect.NewUnaryHandler(
+		JobServiceGetJobRunSchemaDriftReportProcedure,
+		svc.GetJobRunSchemaDriftReport,
+		connect.WithSchema(jobServiceGetJobRunSchemaDriftReportMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	jobServiceApplyJobRunSchemaDriftReportHandler := connect.NewUnaryHandler(
+		JobServiceApplyJobRunSchemaDriftReportProcedure,
+		svc.ApplyJobRunSchemaDriftReport,
+		connect.WithSchema(jobServiceApplyJobRunSchemaDriftReportMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	jobServicePreviewJobMappingsHandler := connect.NewUnaryHandler(
+		JobServicePreviewJobMappingsProcedure,
+		svc.PreviewJobMappings,
+		connect.WithSchema(jobServicePreviewJobMappingsMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	jobServiceGetJobVersionsHandler := connect.NewUnaryHandler(
+		JobServiceGetJobVersionsProcedure,
+		svc.GetJobVersions,
+		connect.WithSchema(jobServiceGetJobVersionsMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	jobServiceGetJobVersionDiffHandler := connect.NewUnaryHandler(
+		JobServiceGetJobVersionDiffProcedure,
+		svc.GetJobVersionDiff,
+		connect.WithSchema(jobServiceGetJobVersionDiffMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	jobServiceRollbackJobToVersionHandler := connect.NewUnaryHandler(
+		JobServiceRollbackJobToVersionProcedure,
+		svc.RollbackJobToVersion,
+		connect.WithSchema(jobServiceRollbackJobToVersionMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
 	return "/mgmt.v1alpha1.JobService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case JobServiceGetJobsProcedure:
@@ -752,6 +1116,34 @@ func NewJobServiceHandler(svc JobServiceHandler, opts ...connect.HandlerOption)
 			jobServiceSetJobWorkflowOptionsHandler.ServeHTTP(w, r)
 		case JobServiceSetJobSyncOptionsProcedure:
 			jobServiceSetJobSyncOptionsHandler.ServeHTTP(w, r)
+		case JobServiceSetJobTriggersProcedure:
+			jobServiceSetJobTriggersHandler.ServeHTTP(w, r)
+		case JobServiceTriggerDependentJobsProcedure:
+			jobServiceTriggerDependentJobsHandler.ServeHTTP(w, r)
+		case JobServiceGetJobWatermarksProcedure:
+			jobServiceGetJobWatermarksHandler.ServeHTTP(w, r)
+		case JobServiceSetJobRunWatermarksProcedure:
+			jobServiceSetJobRunWatermarksHandler.ServeHTTP(w, r)
+		case JobServiceResetJobWatermarksProcedure:
+			jobServiceResetJobWatermarksHandler.ServeHTTP(w, r)
+		case JobServiceSetJobRunValidationReportProcedure:
+			jobServiceSetJobRunValidationReportHandler.ServeHTTP(w, r)
+		case JobServiceGetJobRunValidationReportProcedure:
+			jobServiceGetJobRunValidationReportHandler.ServeHTTP(w, r)
+		case JobServiceSetJobRunSchemaDriftReportProcedure:
+			jobServiceSetJobRunSchemaDriftReportHandler.ServeHTTP(w, r)
+		case JobServiceGetJobRunSchemaDriftReportProcedure:
+			jobServiceGetJobRunSchemaDriftReportHandler.ServeHTTP(w, r)
+		case JobServiceApplyJobRunSchemaDriftReportProcedure:
+			jobServiceApplyJobRunSchemaDriftReportHandler.ServeHTTP(w, r)
+		case JobServicePreviewJobMappingsProcedure:
+			jobServicePreviewJobMappingsHandler.ServeHTTP(w, r)
+		case JobServiceGetJobVersionsProcedure:
+			jobServiceGetJobVersionsHandler.ServeHTTP(w, r)
+		case JobServiceGetJobVersionDiffProcedure:
+			jobServiceGetJobVersionDiffHandler.ServeHTTP(w, r)
+		case JobServiceRollbackJobToVersionProcedure:
+			jobServiceRollbackJobToVersionHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -864,3 +1256,59 @@ func (UnimplementedJobServiceHandler) SetJobWorkflowOptions(context.Context, *co
 func (UnimplementedJobServiceHandler) SetJobSyncOptions(context.Context, *connect.Request[v1alpha1.SetJobSyncOptionsRequest]) (*connect.Response[v1alpha1.SetJobSyncOptionsResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mgmt.v1alpha1.JobService.SetJobSyncOptions is not implemented"))
 }
+
+func (UnimplementedJobServiceHandler) SetJobTriggers(context.Context, *connect.Request[v1alpha1.SetJobTriggersRequest]) (*connect.Response[v1alpha1.SetJobTriggersResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mgmt.v1alpha1.JobService.SetJobTriggers is not implemented"))
+}
+
+func (UnimplementedJobServiceHandler) TriggerDependentJobs(context.Context, *connect.Request[v1alpha1.TriggerDependentJobsRequest]) (*connect.Response[v1alpha1.TriggerDependentJobsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mgmt.v1alpha1.JobService.TriggerDependentJobs is not implemented"))
+}
+
+func (UnimplementedJobServiceHandler) GetJobWatermarks(context.Context, *connect.Request[v1alpha1.GetJobWatermarksRequest]) (*connect.Response[v1alpha1.GetJobWatermarksResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mgmt.v1alpha1.JobService.GetJobWatermarks is not implemented"))
+}
+
+func (UnimplementedJobServiceHandler) SetJobRunWatermarks(context.Context, *connect.Request[v1alpha1.SetJobRunWatermarksRequest]) (*connect.Response[v1alpha1.SetJobRunWatermarksResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mgmt.v1alpha1.JobService.SetJobRunWatermarks is not implemented"))
+}
+
+func (UnimplementedJobServiceHandler) ResetJobWatermarks(context.Context, *connect.Request[v1alpha1.ResetJobWatermarksRequest]) (*connect.Response[v1alpha1.ResetJobWatermarksResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mgmt.v1alpha1.JobService.ResetJobWatermarks is not implemented"))
+}
+
+func (UnimplementedJobServiceHandler) SetJobRunValidationReport(context.Context, *connect.Request[v1alpha1.SetJobRunValidationReportRequest]) (*connect.Response[v1alpha1.SetJobRunValidationReportResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mgmt.v1alpha1.JobService.SetJobRunValidationReport is not implemented"))
+}
+
+func (UnimplementedJobServiceHandler) GetJobRunValidationReport(context.Context, *connect.Request[v1alpha1.GetJobRunValidationReportRequest]) (*connect.Response[v1alpha1.GetJobRunValidationReportResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mgmt.v1alpha1.JobService.GetJobRunValidationReport is not implemented"))
+}
+
+func (UnimplementedJobServiceHandler) SetJobRunSchemaDriftReport(context.Context, *connect.Request[v1alpha1.SetJobRunSchemaDriftReportRequest]) (*connect.Response[v1alpha1.SetJobRunSchemaDriftReportResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mgmt.v1alpha1.JobService.SetJobRunSchemaDriftReport is not implemented"))
+}
+
+func (UnimplementedJobServiceHandler) GetJobRunSchemaDriftReport(context.Context, *connect.Request[v1alpha1.GetJobRunSchemaDriftReportRequest]) (*connect.Response[v1alpha1.GetJobRunSchemaDriftReportResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mgmt.v1alpha1.JobService.GetJobRunSchemaDriftReport is not implemented"))
+}
+
+func (UnimplementedJobServiceHandler) ApplyJobRunSchemaDriftReport(context.Context, *connect.Request[v1alpha1.ApplyJobRunSchemaDriftReportRequest]) (*connect.Response[v1alpha1.ApplyJobRunSchemaDriftReportResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mgmt.v1alpha1.JobService.ApplyJobRunSchemaDriftReport is not implemented"))
+}
+
+func (UnimplementedJobServiceHandler) PreviewJobMappings(context.Context, *connect.Request[v1alpha1.PreviewJobMappingsRequest]) (*connect.Response[v1alpha1.PreviewJobMappingsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mgmt.v1alpha1.JobService.PreviewJobMappings is not implemented"))
+}
+
+func (UnimplementedJobServiceHandler) GetJobVersions(context.Context, *connect.Request[v1alpha1.GetJobVersionsRequest]) (*connect.Response[v1alpha1.GetJobVersionsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mgmt.v1alpha1.JobService.GetJobVersions is not implemented"))
+}
+
+func (UnimplementedJobServiceHandler) GetJobVersionDiff(context.Context, *connect.Request[v1alpha1.GetJobVersionDiffRequest]) (*connect.Response[v1alpha1.GetJobVersionDiffResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mgmt.v1alpha1.JobService.GetJobVersionDiff is not implemented"))
+}
+
+func (UnimplementedJobServiceHandler) RollbackJobToVersion(context.Context, *connect.Request[v1alpha1.RollbackJobToVersionRequest]) (*connect.Response[v1alpha1.RollbackJobToVersionResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mgmt.v1alpha1.JobService.RollbackJobToVersion is not implemented"))
+}
@@ -0,0 +1,452 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mgmtv1alpha1connect
+
+import (
+	context "context"
+
+	connect "connectrpc.com/connect"
+
+	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockConnectionDataServiceClient is an autogenerated mock type for the ConnectionDataServiceClient type
+type MockConnectionDataServiceClient struct {
+	mock.Mock
+}
+
+type MockConnectionDataServiceClient_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockConnectionDataServiceClient) EXPECT() *MockConnectionDataServiceClient_Expecter {
+	return &MockConnectionDataServiceClient_Expecter{mock: &_m.Mock}
+}
+
+// GetConnectionDataStream provides a mock function with given fields: _a0, _a1
+func (_m *MockConnectionDataServiceClient) GetConnectionDataStream(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.GetConnectionDataStreamRequest]) (*connect.ServerStreamForClient[mgmtv1alpha1.GetConnectionDataStreamResponse], error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetConnectionDataStream")
+	}
+
+	var r0 *connect.ServerStreamForClient[mgmtv1alpha1.GetConnectionDataStreamResponse]
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.GetConnectionDataStreamRequest]) (*connect.ServerStreamForClient[mgmtv1alpha1.GetConnectionDataStreamResponse], error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.GetConnectionDataStreamRequest]) *connect.ServerStreamForClient[mgmtv1alpha1.GetConnectionDataStreamResponse]); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*connect.ServerStreamForClient[mgmtv1alpha1.GetConnectionDataStreamResponse])
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *connect.Request[mgmtv1alpha1.GetConnectionDataStreamRequest]) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockConnectionDataServiceClient_GetConnectionDataStream_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetConnectionDataStream'
+type MockConnectionDataServiceClient_GetConnectionDataStream_Call struct {
+	*mock.Call
+}
+
+// GetConnectionDataStream is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *connect.Request[mgmtv1alpha1.GetConnectionDataStreamRequest]
+func (_e *MockConnectionDataServiceClient_Expecter) GetConnectionDataStream(_a0 interface{}, _a1 interface{}) *MockConnectionDataServiceClient_GetConnectionDataStream_Call {
+	return &MockConnectionDataServiceClient_GetConnectionDataStream_Call{Call: _e.mock.On("GetConnectionDataStream", _a0, _a1)}
+}
+
+func (_c *MockConnectionDataServiceClient_GetConnectionDataStream_Call) Run(run func(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.GetConnectionDataStreamRequest])) *MockConnectionDataServiceClient_GetConnectionDataStream_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*connect.Request[mgmtv1alpha1.GetConnectionDataStreamRequest]))
+	})
+	return _c
+}
+
+func (_c *MockConnectionDataServiceClient_GetConnectionDataStream_Call) Return(_a0 *connect.ServerStreamForClient[mgmtv1alpha1.GetConnectionDataStreamResponse], _a1 error) *MockConnectionDataServiceClient_GetConnectionDataStream_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockConnectionDataServiceClient_GetConnectionDataStream_Call) RunAndReturn(run func(context.Context, *connect.Request[mgmtv1alpha1.GetConnectionDataStreamRequest]) (*connect.ServerStreamForClient[mgmtv1alpha1.GetConnectionDataStreamResponse], error)) *MockConnectionDataServiceClient_GetConnectionDataStream_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetConnectionForeignConstraints provides a mock function with given fields: _a0, _a1
+func (_m *MockConnectionDataServiceClient) GetConnectionForeignConstraints(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.GetConnectionForeignConstraintsRequest]) (*connect.Response[mgmtv1alpha1.GetConnectionForeignConstraintsResponse], error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetConnectionForeignConstraints")
+	}
+
+	var r0 *connect.Response[mgmtv1alpha1.GetConnectionForeignConstraintsResponse]
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.GetConnectionForeignConstraintsRequest]) (*connect.Response[mgmtv1alpha1.GetConnectionForeignConstraintsResponse], error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.GetConnectionForeignConstraintsRequest]) *connect.Response[mgmtv1alpha1.GetConnectionForeignConstraintsResponse]); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*connect.Response[mgmtv1alpha1.GetConnectionForeignConstraintsResponse])
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *connect.Request[mgmtv1alpha1.GetConnectionForeignConstraintsRequest]) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockConnectionDataServiceClient_GetConnectionForeignConstraints_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetConnectionForeignConstraints'
+type MockConnectionDataServiceClient_GetConnectionForeignConstraints_Call struct {
+	*mock.Call
+}
+
+// GetConnectionForeignConstraints is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *connect.Request[mgmtv1alpha1.GetConnectionForeignConstraintsRequest]
+func (_e *MockConnectionDataServiceClient_Expecter) GetConnectionForeignConstraints(_a0 interface{}, _a1 interface{}) *MockConnectionDataServiceClient_GetConnectionForeignConstraints_Call {
+	return &MockConnectionDataServiceClient_GetConnectionForeignConstraints_Call{Call: _e.mock.On("GetConnectionForeignConstraints", _a0, _a1)}
+}
+
+func (_c *MockConnectionDataServiceClient_GetConnectionForeignConstraints_Call) Run(run func(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.GetConnectionForeignConstraintsRequest])) *MockConnectionDataServiceClient_GetConnectionForeignConstraints_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*connect.Request[mgmtv1alpha1.GetConnectionForeignConstraintsRequest]))
+	})
+	return _c
+}
+
+func (_c *MockConnectionDataServiceClient_GetConnectionForeignConstraints_Call) Return(_a0 *connect.Response[mgmtv1alpha1.GetConnectionForeignConstraintsResponse], _a1 error) *MockConnectionDataServiceClient_GetConnectionForeignConstraints_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockConnectionDataServiceClient_GetConnectionForeignConstraints_Call) RunAndReturn(run func(context.Context, *connect.Request[mgmtv1alpha1.GetConnectionForeignConstraintsRequest]) (*connect.Response[mgmtv1alpha1.GetConnectionForeignConstraintsResponse], error)) *MockConnectionDataServiceClient_GetConnectionForeignConstraints_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetConnectionInitStatements provides a mock function with given fields: _a0, _a1
+func (_m *MockConnectionDataServiceClient) GetConnectionInitStatements(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.GetConnectionInitStatementsRequest]) (*connect.Response[mgmtv1alpha1.GetConnectionInitStatementsResponse], error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetConnectionInitStatements")
+	}
+
+	var r0 *connect.Response[mgmtv1alpha1.GetConnectionInitStatementsResponse]
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.GetConnectionInitStatementsRequest]) (*connect.Response[mgmtv1alpha1.GetConnectionInitStatementsResponse], error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.GetConnectionInitStatementsRequest]) *connect.Response[mgmtv1alpha1.GetConnectionInitStatementsResponse]); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*connect.Response[mgmtv1alpha1.GetConnectionInitStatementsResponse])
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *connect.Request[mgmtv1alpha1.GetConnectionInitStatementsRequest]) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockConnectionDataServiceClient_GetConnectionInitStatements_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetConnectionInitStatements'
+type MockConnectionDataServiceClient_GetConnectionInitStatements_Call struct {
+	*mock.Call
+}
+
+// GetConnectionInitStatements is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *connect.Request[mgmtv1alpha1.GetConnectionInitStatementsRequest]
+func (_e *MockConnectionDataServiceClient_Expecter) GetConnectionInitStatements(_a0 interface{}, _a1 interface{}) *MockConnectionDataServiceClient_GetConnectionInitStatements_Call {
+	return &MockConnectionDataServiceClient_GetConnectionInitStatements_Call{Call: _e.mock.On("GetConnectionInitStatements", _a0, _a1)}
+}
+
+func (_c *MockConnectionDataServiceClient_GetConnectionInitStatements_Call) Run(run func(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.GetConnectionInitStatementsRequest])) *MockConnectionDataServiceClient_GetConnectionInitStatements_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*connect.Request[mgmtv1alpha1.GetConnectionInitStatementsRequest]))
+	})
+	return _c
+}
+
+func (_c *MockConnectionDataServiceClient_GetConnectionInitStatements_Call) Return(_a0 *connect.Response[mgmtv1alpha1.GetConnectionInitStatementsResponse], _a1 error) *MockConnectionDataServiceClient_GetConnectionInitStatements_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockConnectionDataServiceClient_GetConnectionInitStatements_Call) RunAndReturn(run func(context.Context, *connect.Request[mgmtv1alpha1.GetConnectionInitStatementsRequest]) (*connect.Response[mgmtv1alpha1.GetConnectionInitStatementsResponse], error)) *MockConnectionDataServiceClient_GetConnectionInitStatements_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetConnectionPrimaryConstraints provides a mock function with given fields: _a0, _a1
+func (_m *MockConnectionDataServiceClient) GetConnectionPrimaryConstraints(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.GetConnectionPrimaryConstraintsRequest]) (*connect.Response[mgmtv1alpha1.GetConnectionPrimaryConstraintsResponse], error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetConnectionPrimaryConstraints")
+	}
+
+	var r0 *connect.Response[mgmtv1alpha1.GetConnectionPrimaryConstraintsResponse]
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.GetConnectionPrimaryConstraintsRequest]) (*connect.Response[mgmtv1alpha1.GetConnectionPrimaryConstraintsResponse], error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.GetConnectionPrimaryConstraintsRequest]) *connect.Response[mgmtv1alpha1.GetConnectionPrimaryConstraintsResponse]); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*connect.Response[mgmtv1alpha1.GetConnectionPrimaryConstraintsResponse])
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *connect.Request[mgmtv1alpha1.GetConnectionPrimaryConstraintsRequest]) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockConnectionDataServiceClient_GetConnectionPrimaryConstraints_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetConnectionPrimaryConstraints'
+type MockConnectionDataServiceClient_GetConnectionPrimaryConstraints_Call struct {
+	*mock.Call
+}
+
+// GetConnectionPrimaryConstraints is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *connect.Request[mgmtv1alpha1.GetConnectionPrimaryConstraintsRequest]
+func (_e *MockConnectionDataServiceClient_Expecter) GetConnectionPrimaryConstraints(_a0 interface{}, _a1 interface{}) *MockConnectionDataServiceClient_GetConnectionPrimaryConstraints_Call {
+	return &MockConnectionDataServiceClient_GetConnectionPrimaryConstraints_Call{Call: _e.mock.On("GetConnectionPrimaryConstraints", _a0, _a1)}
+}
+
+func (_c *MockConnectionDataServiceClient_GetConnectionPrimaryConstraints_Call) Run(run func(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.GetConnectionPrimaryConstraintsRequest])) *MockConnectionDataServiceClient_GetConnectionPrimaryConstraints_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*connect.Request[mgmtv1alpha1.GetConnectionPrimaryConstraintsRequest]))
+	})
+	return _c
+}
+
+func (_c *MockConnectionDataServiceClient_GetConnectionPrimaryConstraints_Call) Return(_a0 *connect.Response[mgmtv1alpha1.GetConnectionPrimaryConstraintsResponse], _a1 error) *MockConnectionDataServiceClient_GetConnectionPrimaryConstraints_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockConnectionDataServiceClient_GetConnectionPrimaryConstraints_Call) RunAndReturn(run func(context.Context, *connect.Request[mgmtv1alpha1.GetConnectionPrimaryConstraintsRequest]) (*connect.Response[mgmtv1alpha1.GetConnectionPrimaryConstraintsResponse], error)) *MockConnectionDataServiceClient_GetConnectionPrimaryConstraints_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetConnectionSchema provides a mock function with given fields: _a0, _a1
+func (_m *MockConnectionDataServiceClient) GetConnectionSchema(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.GetConnectionSchemaRequest]) (*connect.Response[mgmtv1alpha1.GetConnectionSchemaResponse], error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetConnectionSchema")
+	}
+
+	var r0 *connect.Response[mgmtv1alpha1.GetConnectionSchemaResponse]
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.GetConnectionSchemaRequest]) (*connect.Response[mgmtv1alpha1.GetConnectionSchemaResponse], error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.GetConnectionSchemaRequest]) *connect.Response[mgmtv1alpha1.GetConnectionSchemaResponse]); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*connect.Response[mgmtv1alpha1.GetConnectionSchemaResponse])
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *connect.Request[mgmtv1alpha1.GetConnectionSchemaRequest]) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockConnectionDataServiceClient_GetConnectionSchema_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetConnectionSchema'
+type MockConnectionDataServiceClient_GetConnectionSchema_Call struct {
+	*mock.Call
+}
+
+// GetConnectionSchema is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *connect.Request[mgmtv1alpha1.GetConnectionSchemaRequest]
+func (_e *MockConnectionDataServiceClient_Expecter) GetConnectionSchema(_a0 interface{}, _a1 interface{}) *MockConnectionDataServiceClient_GetConnectionSchema_Call {
+	return &MockConnectionDataServiceClient_GetConnectionSchema_Call{Call: _e.mock.On("GetConnectionSchema", _a0, _a1)}
+}
+
+func (_c *MockConnectionDataServiceClient_GetConnectionSchema_Call) Run(run func(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.GetConnectionSchemaRequest])) *MockConnectionDataServiceClient_GetConnectionSchema_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*connect.Request[mgmtv1alpha1.GetConnectionSchemaRequest]))
+	})
+	return _c
+}
+
+func (_c *MockConnectionDataServiceClient_GetConnectionSchema_Call) Return(_a0 *connect.Response[mgmtv1alpha1.GetConnectionSchemaResponse], _a1 error) *MockConnectionDataServiceClient_GetConnectionSchema_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockConnectionDataServiceClient_GetConnectionSchema_Call) RunAndReturn(run func(context.Context, *connect.Request[mgmtv1alpha1.GetConnectionSchemaRequest]) (*connect.Response[mgmtv1alpha1.GetConnectionSchemaResponse], error)) *MockConnectionDataServiceClient_GetConnectionSchema_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetConnectionUniqueConstraints provides a mock function with given fields: _a0, _a1
+func (_m *MockConnectionDataServiceClient) GetConnectionUniqueConstraints(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.GetConnectionUniqueConstraintsRequest]) (*connect.Response[mgmtv1alpha1.GetConnectionUniqueConstraintsResponse], error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetConnectionUniqueConstraints")
+	}
+
+	var r0 *connect.Response[mgmtv1alpha1.GetConnectionUniqueConstraintsResponse]
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.GetConnectionUniqueConstraintsRequest]) (*connect.Response[mgmtv1alpha1.GetConnectionUniqueConstraintsResponse], error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.GetConnectionUniqueConstraintsRequest]) *connect.Response[mgmtv1alpha1.GetConnectionUniqueConstraintsResponse]); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*connect.Response[mgmtv1alpha1.GetConnectionUniqueConstraintsResponse])
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *connect.Request[mgmtv1alpha1.GetConnectionUniqueConstraintsRequest]) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockConnectionDataServiceClient_GetConnectionUniqueConstraints_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetConnectionUniqueConstraints'
+type MockConnectionDataServiceClient_GetConnectionUniqueConstraints_Call struct {
+	*mock.Call
+}
+
+// GetConnectionUniqueConstraints is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *connect.Request[mgmtv1alpha1.GetConnectionUniqueConstraintsRequest]
+func (_e *MockConnectionDataServiceClient_Expecter) GetConnectionUniqueConstraints(_a0 interface{}, _a1 interface{}) *MockConnectionDataServiceClient_GetConnectionUniqueConstraints_Call {
+	return &MockConnectionDataServiceClient_GetConnectionUniqueConstraints_Call{Call: _e.mock.On("GetConnectionUniqueConstraints", _a0, _a1)}
+}
+
+func (_c *MockConnectionDataServiceClient_GetConnectionUniqueConstraints_Call) Run(run func(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.GetConnectionUniqueConstraintsRequest])) *MockConnectionDataServiceClient_GetConnectionUniqueConstraints_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*connect.Request[mgmtv1alpha1.GetConnectionUniqueConstraintsRequest]))
+	})
+	return _c
+}
+
+func (_c *MockConnectionDataServiceClient_GetConnectionUniqueConstraints_Call) Return(_a0 *connect.Response[mgmtv1alpha1.GetConnectionUniqueConstraintsResponse], _a1 error) *MockConnectionDataServiceClient_GetConnectionUniqueConstraints_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockConnectionDataServiceClient_GetConnectionUniqueConstraints_Call) RunAndReturn(run func(context.Context, *connect.Request[mgmtv1alpha1.GetConnectionUniqueConstraintsRequest]) (*connect.Response[mgmtv1alpha1.GetConnectionUniqueConstraintsResponse], error)) *MockConnectionDataServiceClient_GetConnectionUniqueConstraints_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ScanConnectionPii provides a mock function with given fields: _a0, _a1
+func (_m *MockConnectionDataServiceClient) ScanConnectionPii(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.ScanConnectionPiiRequest]) (*connect.Response[mgmtv1alpha1.ScanConnectionPiiResponse], error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ScanConnectionPii")
+	}
+
+	var r0 *connect.Response[mgmtv1alpha1.ScanConnectionPiiResponse]
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.ScanConnectionPiiRequest]) (*connect.Response[mgmtv1alpha1.ScanConnectionPiiResponse], error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.ScanConnectionPiiRequest]) *connect.Response[mgmtv1alpha1.ScanConnectionPiiResponse]); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*connect.Response[mgmtv1alpha1.ScanConnectionPiiResponse])
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *connect.Request[mgmtv1alpha1.ScanConnectionPiiRequest]) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockConnectionDataServiceClient_ScanConnectionPii_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ScanConnectionPii'
+type MockConnectionDataServiceClient_ScanConnectionPii_Call struct {
+	*mock.Call
+}
+
+// ScanConnectionPii is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *connect.Request[mgmtv1alpha1.ScanConnectionPiiRequest]
+func (_e *MockConnectionDataServiceClient_Expecter) ScanConnectionPii(_a0 interface{}, _a1 interface{}) *MockConnectionDataServiceClient_ScanConnectionPii_Call {
+	return &MockConnectionDataServiceClient_ScanConnectionPii_Call{Call: _e.mock.On("ScanConnectionPii", _a0, _a1)}
+}
+
+func (_c *MockConnectionDataServiceClient_ScanConnectionPii_Call) Run(run func(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.ScanConnectionPiiRequest])) *MockConnectionDataServiceClient_ScanConnectionPii_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*connect.Request[mgmtv1alpha1.ScanConnectionPiiRequest]))
+	})
+	return _c
+}
+
+func (_c *MockConnectionDataServiceClient_ScanConnectionPii_Call) Return(_a0 *connect.Response[mgmtv1alpha1.ScanConnectionPiiResponse], _a1 error) *MockConnectionDataServiceClient_ScanConnectionPii_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockConnectionDataServiceClient_ScanConnectionPii_Call) RunAndReturn(run func(context.Context, *connect.Request[mgmtv1alpha1.ScanConnectionPiiRequest]) (*connect.Response[mgmtv1alpha1.ScanConnectionPiiResponse], error)) *MockConnectionDataServiceClient_ScanConnectionPii_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockConnectionDataServiceClient creates a new instance of MockConnectionDataServiceClient. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockConnectionDataServiceClient(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockConnectionDataServiceClient {
+	mock := &MockConnectionDataServiceClient{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
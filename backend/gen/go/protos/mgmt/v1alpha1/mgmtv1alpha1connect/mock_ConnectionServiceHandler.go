@@ -28,6 +28,10 @@ func (_m *MockConnectionServiceHandler) EXPECT() *MockConnectionServiceHandler_E
 func (_m *MockConnectionServiceHandler) CheckConnectionConfig(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.CheckConnectionConfigRequest]) (*connect.Response[mgmtv1alpha1.CheckConnectionConfigResponse], error) {
 	ret := _m.Called(_a0, _a1)
 
+	if len(ret) == 0 {
+		panic("no return value specified for CheckConnectionConfig")
+	}
+
 	var r0 *connect.Response[mgmtv1alpha1.CheckConnectionConfigResponse]
 	var r1 error
 	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.CheckConnectionConfigRequest]) (*connect.Response[mgmtv1alpha1.CheckConnectionConfigResponse], error)); ok {
@@ -83,6 +87,10 @@ func (_c *MockConnectionServiceHandler_CheckConnectionConfig_Call) RunAndReturn(
 func (_m *MockConnectionServiceHandler) CheckSqlQuery(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.CheckSqlQueryRequest]) (*connect.Response[mgmtv1alpha1.CheckSqlQueryResponse], error) {
 	ret := _m.Called(_a0, _a1)
 
+	if len(ret) == 0 {
+		panic("no return value specified for CheckSqlQuery")
+	}
+
 	var r0 *connect.Response[mgmtv1alpha1.CheckSqlQueryResponse]
 	var r1 error
 	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.CheckSqlQueryRequest]) (*connect.Response[mgmtv1alpha1.CheckSqlQueryResponse], error)); ok {
@@ -138,6 +146,10 @@ func (_c *MockConnectionServiceHandler_CheckSqlQuery_Call) RunAndReturn(run func
 func (_m *MockConnectionServiceHandler) CreateConnection(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.CreateConnectionRequest]) (*connect.Response[mgmtv1alpha1.CreateConnectionResponse], error) {
 	ret := _m.Called(_a0, _a1)
 
+	if len(ret) == 0 {
+		panic("no return value specified for CreateConnection")
+	}
+
 	var r0 *connect.Response[mgmtv1alpha1.CreateConnectionResponse]
 	var r1 error
 	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.CreateConnectionRequest]) (*connect.Response[mgmtv1alpha1.CreateConnectionResponse], error)); ok {
@@ -193,6 +205,10 @@ func (_c *MockConnectionServiceHandler_CreateConnection_Call) RunAndReturn(run f
 func (_m *MockConnectionServiceHandler) DeleteConnection(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.DeleteConnectionRequest]) (*connect.Response[mgmtv1alpha1.DeleteConnectionResponse], error) {
 	ret := _m.Called(_a0, _a1)
 
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteConnection")
+	}
+
 	var r0 *connect.Response[mgmtv1alpha1.DeleteConnectionResponse]
 	var r1 error
 	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.DeleteConnectionRequest]) (*connect.Response[mgmtv1alpha1.DeleteConnectionResponse], error)); ok {
@@ -248,6 +264,10 @@ func (_c *MockConnectionServiceHandler_DeleteConnection_Call) RunAndReturn(run f
 func (_m *MockConnectionServiceHandler) GetConnection(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.GetConnectionRequest]) (*connect.Response[mgmtv1alpha1.GetConnectionResponse], error) {
 	ret := _m.Called(_a0, _a1)
 
+	if len(ret) == 0 {
+		panic("no return value specified for GetConnection")
+	}
+
 	var r0 *connect.Response[mgmtv1alpha1.GetConnectionResponse]
 	var r1 error
 	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.GetConnectionRequest]) (*connect.Response[mgmtv1alpha1.GetConnectionResponse], error)); ok {
@@ -299,219 +319,14 @@ func (_c *MockConnectionServiceHandler_GetConnection_Call) RunAndReturn(run func
 	return _c
 }
 
-// GetConnectionDataStream provides a mock function with given fields: _a0, _a1, _a2
-func (_m *MockConnectionServiceHandler) GetConnectionDataStream(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.GetConnectionDataStreamRequest], _a2 *connect.ServerStream[mgmtv1alpha1.GetConnectionDataStreamResponse]) error {
-	ret := _m.Called(_a0, _a1, _a2)
-
-	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.GetConnectionDataStreamRequest], *connect.ServerStream[mgmtv1alpha1.GetConnectionDataStreamResponse]) error); ok {
-		r0 = rf(_a0, _a1, _a2)
-	} else {
-		r0 = ret.Error(0)
-	}
-
-	return r0
-}
-
-// MockConnectionServiceHandler_GetConnectionDataStream_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetConnectionDataStream'
-type MockConnectionServiceHandler_GetConnectionDataStream_Call struct {
-	*mock.Call
-}
-
-// GetConnectionDataStream is a helper method to define mock.On call
-//   - _a0 context.Context
-//   - _a1 *connect.Request[mgmtv1alpha1.GetConnectionDataStreamRequest]
-//   - _a2 *connect.ServerStream[mgmtv1alpha1.GetConnectionDataStreamResponse]
-func (_e *MockConnectionServiceHandler_Expecter) GetConnectionDataStream(_a0 interface{}, _a1 interface{}, _a2 interface{}) *MockConnectionServiceHandler_GetConnectionDataStream_Call {
-	return &MockConnectionServiceHandler_GetConnectionDataStream_Call{Call: _e.mock.On("GetConnectionDataStream", _a0, _a1, _a2)}
-}
-
-func (_c *MockConnectionServiceHandler_GetConnectionDataStream_Call) Run(run func(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.GetConnectionDataStreamRequest], _a2 *connect.ServerStream[mgmtv1alpha1.GetConnectionDataStreamResponse])) *MockConnectionServiceHandler_GetConnectionDataStream_Call {
-	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(*connect.Request[mgmtv1alpha1.GetConnectionDataStreamRequest]), args[2].(*connect.ServerStream[mgmtv1alpha1.GetConnectionDataStreamResponse]))
-	})
-	return _c
-}
-
-func (_c *MockConnectionServiceHandler_GetConnectionDataStream_Call) Return(_a0 error) *MockConnectionServiceHandler_GetConnectionDataStream_Call {
-	_c.Call.Return(_a0)
-	return _c
-}
-
-func (_c *MockConnectionServiceHandler_GetConnectionDataStream_Call) RunAndReturn(run func(context.Context, *connect.Request[mgmtv1alpha1.GetConnectionDataStreamRequest], *connect.ServerStream[mgmtv1alpha1.GetConnectionDataStreamResponse]) error) *MockConnectionServiceHandler_GetConnectionDataStream_Call {
-	_c.Call.Return(run)
-	return _c
-}
-
-// GetConnectionForeignConstraints provides a mock function with given fields: _a0, _a1
-func (_m *MockConnectionServiceHandler) GetConnectionForeignConstraints(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.GetConnectionForeignConstraintsRequest]) (*connect.Response[mgmtv1alpha1.GetConnectionForeignConstraintsResponse], error) {
-	ret := _m.Called(_a0, _a1)
-
-	var r0 *connect.Response[mgmtv1alpha1.GetConnectionForeignConstraintsResponse]
-	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.GetConnectionForeignConstraintsRequest]) (*connect.Response[mgmtv1alpha1.GetConnectionForeignConstraintsResponse], error)); ok {
-		return rf(_a0, _a1)
-	}
-	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.GetConnectionForeignConstraintsRequest]) *connect.Response[mgmtv1alpha1.GetConnectionForeignConstraintsResponse]); ok {
-		r0 = rf(_a0, _a1)
-	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*connect.Response[mgmtv1alpha1.GetConnectionForeignConstraintsResponse])
-		}
-	}
-
-	if rf, ok := ret.Get(1).(func(context.Context, *connect.Request[mgmtv1alpha1.GetConnectionForeignConstraintsRequest]) error); ok {
-		r1 = rf(_a0, _a1)
-	} else {
-		r1 = ret.Error(1)
-	}
-
-	return r0, r1
-}
-
-// MockConnectionServiceHandler_GetConnectionForeignConstraints_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetConnectionForeignConstraints'
-type MockConnectionServiceHandler_GetConnectionForeignConstraints_Call struct {
-	*mock.Call
-}
-
-// GetConnectionForeignConstraints is a helper method to define mock.On call
-//   - _a0 context.Context
-//   - _a1 *connect.Request[mgmtv1alpha1.GetConnectionForeignConstraintsRequest]
-func (_e *MockConnectionServiceHandler_Expecter) GetConnectionForeignConstraints(_a0 interface{}, _a1 interface{}) *MockConnectionServiceHandler_GetConnectionForeignConstraints_Call {
-	return &MockConnectionServiceHandler_GetConnectionForeignConstraints_Call{Call: _e.mock.On("GetConnectionForeignConstraints", _a0, _a1)}
-}
-
-func (_c *MockConnectionServiceHandler_GetConnectionForeignConstraints_Call) Run(run func(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.GetConnectionForeignConstraintsRequest])) *MockConnectionServiceHandler_GetConnectionForeignConstraints_Call {
-	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(*connect.Request[mgmtv1alpha1.GetConnectionForeignConstraintsRequest]))
-	})
-	return _c
-}
-
-func (_c *MockConnectionServiceHandler_GetConnectionForeignConstraints_Call) Return(_a0 *connect.Response[mgmtv1alpha1.GetConnectionForeignConstraintsResponse], _a1 error) *MockConnectionServiceHandler_GetConnectionForeignConstraints_Call {
-	_c.Call.Return(_a0, _a1)
-	return _c
-}
-
-func (_c *MockConnectionServiceHandler_GetConnectionForeignConstraints_Call) RunAndReturn(run func(context.Context, *connect.Request[mgmtv1alpha1.GetConnectionForeignConstraintsRequest]) (*connect.Response[mgmtv1alpha1.GetConnectionForeignConstraintsResponse], error)) *MockConnectionServiceHandler_GetConnectionForeignConstraints_Call {
-	_c.Call.Return(run)
-	return _c
-}
-
-// GetConnectionInitStatements provides a mock function with given fields: _a0, _a1
-func (_m *MockConnectionServiceHandler) GetConnectionInitStatements(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.GetConnectionInitStatementsRequest]) (*connect.Response[mgmtv1alpha1.GetConnectionInitStatementsResponse], error) {
-	ret := _m.Called(_a0, _a1)
-
-	var r0 *connect.Response[mgmtv1alpha1.GetConnectionInitStatementsResponse]
-	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.GetConnectionInitStatementsRequest]) (*connect.Response[mgmtv1alpha1.GetConnectionInitStatementsResponse], error)); ok {
-		return rf(_a0, _a1)
-	}
-	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.GetConnectionInitStatementsRequest]) *connect.Response[mgmtv1alpha1.GetConnectionInitStatementsResponse]); ok {
-		r0 = rf(_a0, _a1)
-	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*connect.Response[mgmtv1alpha1.GetConnectionInitStatementsResponse])
-		}
-	}
-
-	if rf, ok := ret.Get(1).(func(context.Context, *connect.Request[mgmtv1alpha1.GetConnectionInitStatementsRequest]) error); ok {
-		r1 = rf(_a0, _a1)
-	} else {
-		r1 = ret.Error(1)
-	}
-
-	return r0, r1
-}
-
-// MockConnectionServiceHandler_GetConnectionInitStatements_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetConnectionInitStatements'
-type MockConnectionServiceHandler_GetConnectionInitStatements_Call struct {
-	*mock.Call
-}
-
-// GetConnectionInitStatements is a helper method to define mock.On call
-//   - _a0 context.Context
-//   - _a1 *connect.Request[mgmtv1alpha1.GetConnectionInitStatementsRequest]
-func (_e *MockConnectionServiceHandler_Expecter) GetConnectionInitStatements(_a0 interface{}, _a1 interface{}) *MockConnectionServiceHandler_GetConnectionInitStatements_Call {
-	return &MockConnectionServiceHandler_GetConnectionInitStatements_Call{Call: _e.mock.On("GetConnectionInitStatements", _a0, _a1)}
-}
-
-func (_c *MockConnectionServiceHandler_GetConnectionInitStatements_Call) Run(run func(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.GetConnectionInitStatementsRequest])) *MockConnectionServiceHandler_GetConnectionInitStatements_Call {
-	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(*connect.Request[mgmtv1alpha1.GetConnectionInitStatementsRequest]))
-	})
-	return _c
-}
-
-func (_c *MockConnectionServiceHandler_GetConnectionInitStatements_Call) Return(_a0 *connect.Response[mgmtv1alpha1.GetConnectionInitStatementsResponse], _a1 error) *MockConnectionServiceHandler_GetConnectionInitStatements_Call {
-	_c.Call.Return(_a0, _a1)
-	return _c
-}
-
-func (_c *MockConnectionServiceHandler_GetConnectionInitStatements_Call) RunAndReturn(run func(context.Context, *connect.Request[mgmtv1alpha1.GetConnectionInitStatementsRequest]) (*connect.Response[mgmtv1alpha1.GetConnectionInitStatementsResponse], error)) *MockConnectionServiceHandler_GetConnectionInitStatements_Call {
-	_c.Call.Return(run)
-	return _c
-}
-
-// GetConnectionSchema provides a mock function with given fields: _a0, _a1
-func (_m *MockConnectionServiceHandler) GetConnectionSchema(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.GetConnectionSchemaRequest]) (*connect.Response[mgmtv1alpha1.GetConnectionSchemaResponse], error) {
-	ret := _m.Called(_a0, _a1)
-
-	var r0 *connect.Response[mgmtv1alpha1.GetConnectionSchemaResponse]
-	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.GetConnectionSchemaRequest]) (*connect.Response[mgmtv1alpha1.GetConnectionSchemaResponse], error)); ok {
-		return rf(_a0, _a1)
-	}
-	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.GetConnectionSchemaRequest]) *connect.Response[mgmtv1alpha1.GetConnectionSchemaResponse]); ok {
-		r0 = rf(_a0, _a1)
-	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*connect.Response[mgmtv1alpha1.GetConnectionSchemaResponse])
-		}
-	}
-
-	if rf, ok := ret.Get(1).(func(context.Context, *connect.Request[mgmtv1alpha1.GetConnectionSchemaRequest]) error); ok {
-		r1 = rf(_a0, _a1)
-	} else {
-		r1 = ret.Error(1)
-	}
-
-	return r0, r1
-}
-
-// MockConnectionServiceHandler_GetConnectionSchema_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetConnectionSchema'
-type MockConnectionServiceHandler_GetConnectionSchema_Call struct {
-	*mock.Call
-}
-
-// GetConnectionSchema is a helper method to define mock.On call
-//   - _a0 context.Context
-//   - _a1 *connect.Request[mgmtv1alpha1.GetConnectionSchemaRequest]
-func (_e *MockConnectionServiceHandler_Expecter) GetConnectionSchema(_a0 interface{}, _a1 interface{}) *MockConnectionServiceHandler_GetConnectionSchema_Call {
-	return &MockConnectionServiceHandler_GetConnectionSchema_Call{Call: _e.mock.On("GetConnectionSchema", _a0, _a1)}
-}
-
-func (_c *MockConnectionServiceHandler_GetConnectionSchema_Call) Run(run func(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.GetConnectionSchemaRequest])) *MockConnectionServiceHandler_GetConnectionSchema_Call {
-	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(*connect.Request[mgmtv1alpha1.GetConnectionSchemaRequest]))
-	})
-	return _c
-}
-
-func (_c *MockConnectionServiceHandler_GetConnectionSchema_Call) Return(_a0 *connect.Response[mgmtv1alpha1.GetConnectionSchemaResponse], _a1 error) *MockConnectionServiceHandler_GetConnectionSchema_Call {
-	_c.Call.Return(_a0, _a1)
-	return _c
-}
-
-func (_c *MockConnectionServiceHandler_GetConnectionSchema_Call) RunAndReturn(run func(context.Context, *connect.Request[mgmtv1alpha1.GetConnectionSchemaRequest]) (*connect.Response[mgmtv1alpha1.GetConnectionSchemaResponse], error)) *MockConnectionServiceHandler_GetConnectionSchema_Call {
-	_c.Call.Return(run)
-	return _c
-}
-
 // GetConnections provides a mock function with given fields: _a0, _a1
 func (_m *MockConnectionServiceHandler) GetConnections(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.GetConnectionsRequest]) (*connect.Response[mgmtv1alpha1.GetConnectionsResponse], error) {
 	ret := _m.Called(_a0, _a1)
 
+	if len(ret) == 0 {
+		panic("no return value specified for GetConnections")
+	}
+
 	var r0 *connect.Response[mgmtv1alpha1.GetConnectionsResponse]
 	var r1 error
 	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.GetConnectionsRequest]) (*connect.Response[mgmtv1alpha1.GetConnectionsResponse], error)); ok {
@@ -567,6 +382,10 @@ func (_c *MockConnectionServiceHandler_GetConnections_Call) RunAndReturn(run fun
 func (_m *MockConnectionServiceHandler) IsConnectionNameAvailable(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.IsConnectionNameAvailableRequest]) (*connect.Response[mgmtv1alpha1.IsConnectionNameAvailableResponse], error) {
 	ret := _m.Called(_a0, _a1)
 
+	if len(ret) == 0 {
+		panic("no return value specified for IsConnectionNameAvailable")
+	}
+
 	var r0 *connect.Response[mgmtv1alpha1.IsConnectionNameAvailableResponse]
 	var r1 error
 	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.IsConnectionNameAvailableRequest]) (*connect.Response[mgmtv1alpha1.IsConnectionNameAvailableResponse], error)); ok {
@@ -622,6 +441,10 @@ func (_c *MockConnectionServiceHandler_IsConnectionNameAvailable_Call) RunAndRet
 func (_m *MockConnectionServiceHandler) UpdateConnection(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.UpdateConnectionRequest]) (*connect.Response[mgmtv1alpha1.UpdateConnectionResponse], error) {
 	ret := _m.Called(_a0, _a1)
 
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateConnection")
+	}
+
 	var r0 *connect.Response[mgmtv1alpha1.UpdateConnectionResponse]
 	var r1 error
 	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.UpdateConnectionRequest]) (*connect.Response[mgmtv1alpha1.UpdateConnectionResponse], error)); ok {
@@ -24,6 +24,65 @@ func (_m *MockJobServiceClient) EXPECT() *MockJobServiceClient_Expecter {
 	return &MockJobServiceClient_Expecter{mock: &_m.Mock}
 }
 
+// ApplyJobRunSchemaDriftReport provides a mock function with given fields: _a0, _a1
+func (_m *MockJobServiceClient) ApplyJobRunSchemaDriftReport(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.ApplyJobRunSchemaDriftReportRequest]) (*connect.Response[mgmtv1alpha1.ApplyJobRunSchemaDriftReportResponse], error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ApplyJobRunSchemaDriftReport")
+	}
+
+	var r0 *connect.Response[mgmtv1alpha1.ApplyJobRunSchemaDriftReportResponse]
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.ApplyJobRunSchemaDriftReportRequest]) (*connect.Response[mgmtv1alpha1.ApplyJobRunSchemaDriftReportResponse], error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.ApplyJobRunSchemaDriftReportRequest]) *connect.Response[mgmtv1alpha1.ApplyJobRunSchemaDriftReportResponse]); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*connect.Response[mgmtv1alpha1.ApplyJobRunSchemaDriftReportResponse])
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *connect.Request[mgmtv1alpha1.ApplyJobRunSchemaDriftReportRequest]) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockJobServiceClient_ApplyJobRunSchemaDriftReport_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ApplyJobRunSchemaDriftReport'
+type MockJobServiceClient_ApplyJobRunSchemaDriftReport_Call struct {
+	*mock.Call
+}
+
+// ApplyJobRunSchemaDriftReport is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *connect.Request[mgmtv1alpha1.ApplyJobRunSchemaDriftReportRequest]
+func (_e *MockJobServiceClient_Expecter) ApplyJobRunSchemaDriftReport(_a0 interface{}, _a1 interface{}) *MockJobServiceClient_ApplyJobRunSchemaDriftReport_Call {
+	return &MockJobServiceClient_ApplyJobRunSchemaDriftReport_Call{Call: _e.mock.On("ApplyJobRunSchemaDriftReport", _a0, _a1)}
+}
+
+func (_c *MockJobServiceClient_ApplyJobRunSchemaDriftReport_Call) Run(run func(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.ApplyJobRunSchemaDriftReportRequest])) *MockJobServiceClient_ApplyJobRunSchemaDriftReport_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*connect.Request[mgmtv1alpha1.ApplyJobRunSchemaDriftReportRequest]))
+	})
+	return _c
+}
+
+func (_c *MockJobServiceClient_ApplyJobRunSchemaDriftReport_Call) Return(_a0 *connect.Response[mgmtv1alpha1.ApplyJobRunSchemaDriftReportResponse], _a1 error) *MockJobServiceClient_ApplyJobRunSchemaDriftReport_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockJobServiceClient_ApplyJobRunSchemaDriftReport_Call) RunAndReturn(run func(context.Context, *connect.Request[mgmtv1alpha1.ApplyJobRunSchemaDriftReportRequest]) (*connect.Response[mgmtv1alpha1.ApplyJobRunSchemaDriftReportResponse], error)) *MockJobServiceClient_ApplyJobRunSchemaDriftReport_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // CancelJobRun provides a mock function with given fields: _a0, _a1
 func (_m *MockJobServiceClient) CancelJobRun(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.CancelJobRunRequest]) (*connect.Response[mgmtv1alpha1.CancelJobRunResponse], error) {
 	ret := _m.Called(_a0, _a1)
@@ -791,6 +850,124 @@ func (_c *MockJobServiceClient_GetJobRunLogsStream_Call) RunAndReturn(run func(c
 	return _c
 }
 
+// GetJobRunSchemaDriftReport provides a mock function with given fields: _a0, _a1
+func (_m *MockJobServiceClient) GetJobRunSchemaDriftReport(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.GetJobRunSchemaDriftReportRequest]) (*connect.Response[mgmtv1alpha1.GetJobRunSchemaDriftReportResponse], error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetJobRunSchemaDriftReport")
+	}
+
+	var r0 *connect.Response[mgmtv1alpha1.GetJobRunSchemaDriftReportResponse]
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.GetJobRunSchemaDriftReportRequest]) (*connect.Response[mgmtv1alpha1.GetJobRunSchemaDriftReportResponse], error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.GetJobRunSchemaDriftReportRequest]) *connect.Response[mgmtv1alpha1.GetJobRunSchemaDriftReportResponse]); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*connect.Response[mgmtv1alpha1.GetJobRunSchemaDriftReportResponse])
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *connect.Request[mgmtv1alpha1.GetJobRunSchemaDriftReportRequest]) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockJobServiceClient_GetJobRunSchemaDriftReport_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetJobRunSchemaDriftReport'
+type MockJobServiceClient_GetJobRunSchemaDriftReport_Call struct {
+	*mock.Call
+}
+
+// GetJobRunSchemaDriftReport is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *connect.Request[mgmtv1alpha1.GetJobRunSchemaDriftReportRequest]
+func (_e *MockJobServiceClient_Expecter) GetJobRunSchemaDriftReport(_a0 interface{}, _a1 interface{}) *MockJobServiceClient_GetJobRunSchemaDriftReport_Call {
+	return &MockJobServiceClient_GetJobRunSchemaDriftReport_Call{Call: _e.mock.On("GetJobRunSchemaDriftReport", _a0, _a1)}
+}
+
+func (_c *MockJobServiceClient_GetJobRunSchemaDriftReport_Call) Run(run func(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.GetJobRunSchemaDriftReportRequest])) *MockJobServiceClient_GetJobRunSchemaDriftReport_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*connect.Request[mgmtv1alpha1.GetJobRunSchemaDriftReportRequest]))
+	})
+	return _c
+}
+
+func (_c *MockJobServiceClient_GetJobRunSchemaDriftReport_Call) Return(_a0 *connect.Response[mgmtv1alpha1.GetJobRunSchemaDriftReportResponse], _a1 error) *MockJobServiceClient_GetJobRunSchemaDriftReport_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockJobServiceClient_GetJobRunSchemaDriftReport_Call) RunAndReturn(run func(context.Context, *connect.Request[mgmtv1alpha1.GetJobRunSchemaDriftReportRequest]) (*connect.Response[mgmtv1alpha1.GetJobRunSchemaDriftReportResponse], error)) *MockJobServiceClient_GetJobRunSchemaDriftReport_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetJobRunValidationReport provides a mock function with given fields: _a0, _a1
+func (_m *MockJobServiceClient) GetJobRunValidationReport(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.GetJobRunValidationReportRequest]) (*connect.Response[mgmtv1alpha1.GetJobRunValidationReportResponse], error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetJobRunValidationReport")
+	}
+
+	var r0 *connect.Response[mgmtv1alpha1.GetJobRunValidationReportResponse]
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.GetJobRunValidationReportRequest]) (*connect.Response[mgmtv1alpha1.GetJobRunValidationReportResponse], error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.GetJobRunValidationReportRequest]) *connect.Response[mgmtv1alpha1.GetJobRunValidationReportResponse]); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*connect.Response[mgmtv1alpha1.GetJobRunValidationReportResponse])
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *connect.Request[mgmtv1alpha1.GetJobRunValidationReportRequest]) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockJobServiceClient_GetJobRunValidationReport_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetJobRunValidationReport'
+type MockJobServiceClient_GetJobRunValidationReport_Call struct {
+	*mock.Call
+}
+
+// GetJobRunValidationReport is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *connect.Request[mgmtv1alpha1.GetJobRunValidationReportRequest]
+func (_e *MockJobServiceClient_Expecter) GetJobRunValidationReport(_a0 interface{}, _a1 interface{}) *MockJobServiceClient_GetJobRunValidationReport_Call {
+	return &MockJobServiceClient_GetJobRunValidationReport_Call{Call: _e.mock.On("GetJobRunValidationReport", _a0, _a1)}
+}
+
+func (_c *MockJobServiceClient_GetJobRunValidationReport_Call) Run(run func(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.GetJobRunValidationReportRequest])) *MockJobServiceClient_GetJobRunValidationReport_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*connect.Request[mgmtv1alpha1.GetJobRunValidationReportRequest]))
+	})
+	return _c
+}
+
+func (_c *MockJobServiceClient_GetJobRunValidationReport_Call) Return(_a0 *connect.Response[mgmtv1alpha1.GetJobRunValidationReportResponse], _a1 error) *MockJobServiceClient_GetJobRunValidationReport_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockJobServiceClient_GetJobRunValidationReport_Call) RunAndReturn(run func(context.Context, *connect.Request[mgmtv1alpha1.GetJobRunValidationReportRequest]) (*connect.Response[mgmtv1alpha1.GetJobRunValidationReportResponse], error)) *MockJobServiceClient_GetJobRunValidationReport_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetJobRuns provides a mock function with given fields: _a0, _a1
 func (_m *MockJobServiceClient) GetJobRuns(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.GetJobRunsRequest]) (*connect.Response[mgmtv1alpha1.GetJobRunsResponse], error) {
 	ret := _m.Called(_a0, _a1)
@@ -968,28 +1145,28 @@ func (_c *MockJobServiceClient_GetJobStatuses_Call) RunAndReturn(run func(contex
 	return _c
 }
 
-// GetJobs provides a mock function with given fields: _a0, _a1
-func (_m *MockJobServiceClient) GetJobs(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.GetJobsRequest]) (*connect.Response[mgmtv1alpha1.GetJobsResponse], error) {
+// GetJobVersionDiff provides a mock function with given fields: _a0, _a1
+func (_m *MockJobServiceClient) GetJobVersionDiff(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.GetJobVersionDiffRequest]) (*connect.Response[mgmtv1alpha1.GetJobVersionDiffResponse], error) {
 	ret := _m.Called(_a0, _a1)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetJobs")
+		panic("no return value specified for GetJobVersionDiff")
 	}
 
-	var r0 *connect.Response[mgmtv1alpha1.GetJobsResponse]
+	var r0 *connect.Response[mgmtv1alpha1.GetJobVersionDiffResponse]
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.GetJobsRequest]) (*connect.Response[mgmtv1alpha1.GetJobsResponse], error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.GetJobVersionDiffRequest]) (*connect.Response[mgmtv1alpha1.GetJobVersionDiffResponse], error)); ok {
 		return rf(_a0, _a1)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.GetJobsRequest]) *connect.Response[mgmtv1alpha1.GetJobsResponse]); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.GetJobVersionDiffRequest]) *connect.Response[mgmtv1alpha1.GetJobVersionDiffResponse]); ok {
 		r0 = rf(_a0, _a1)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*connect.Response[mgmtv1alpha1.GetJobsResponse])
+			r0 = ret.Get(0).(*connect.Response[mgmtv1alpha1.GetJobVersionDiffResponse])
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *connect.Request[mgmtv1alpha1.GetJobsRequest]) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *connect.Request[mgmtv1alpha1.GetJobVersionDiffRequest]) error); ok {
 		r1 = rf(_a0, _a1)
 	} else {
 		r1 = ret.Error(1)
@@ -998,57 +1175,57 @@ func (_m *MockJobServiceClient) GetJobs(_a0 context.Context, _a1 *connect.Reques
 	return r0, r1
 }
 
-// MockJobServiceClient_GetJobs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetJobs'
-type MockJobServiceClient_GetJobs_Call struct {
+// MockJobServiceClient_GetJobVersionDiff_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetJobVersionDiff'
+type MockJobServiceClient_GetJobVersionDiff_Call struct {
 	*mock.Call
 }
 
-// GetJobs is a helper method to define mock.On call
+// GetJobVersionDiff is a helper method to define mock.On call
 //   - _a0 context.Context
-//   - _a1 *connect.Request[mgmtv1alpha1.GetJobsRequest]
-func (_e *MockJobServiceClient_Expecter) GetJobs(_a0 interface{}, _a1 interface{}) *MockJobServiceClient_GetJobs_Call {
-	return &MockJobServiceClient_GetJobs_Call{Call: _e.mock.On("GetJobs", _a0, _a1)}
+//   - _a1 *connect.Request[mgmtv1alpha1.GetJobVersionDiffRequest]
+func (_e *MockJobServiceClient_Expecter) GetJobVersionDiff(_a0 interface{}, _a1 interface{}) *MockJobServiceClient_GetJobVersionDiff_Call {
+	return &MockJobServiceClient_GetJobVersionDiff_Call{Call: _e.mock.On("GetJobVersionDiff", _a0, _a1)}
 }
 
-func (_c *MockJobServiceClient_GetJobs_Call) Run(run func(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.GetJobsRequest])) *MockJobServiceClient_GetJobs_Call {
+func (_c *MockJobServiceClient_GetJobVersionDiff_Call) Run(run func(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.GetJobVersionDiffRequest])) *MockJobServiceClient_GetJobVersionDiff_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(*connect.Request[mgmtv1alpha1.GetJobsRequest]))
+		run(args[0].(context.Context), args[1].(*connect.Request[mgmtv1alpha1.GetJobVersionDiffRequest]))
 	})
 	return _c
 }
 
-func (_c *MockJobServiceClient_GetJobs_Call) Return(_a0 *connect.Response[mgmtv1alpha1.GetJobsResponse], _a1 error) *MockJobServiceClient_GetJobs_Call {
+func (_c *MockJobServiceClient_GetJobVersionDiff_Call) Return(_a0 *connect.Response[mgmtv1alpha1.GetJobVersionDiffResponse], _a1 error) *MockJobServiceClient_GetJobVersionDiff_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockJobServiceClient_GetJobs_Call) RunAndReturn(run func(context.Context, *connect.Request[mgmtv1alpha1.GetJobsRequest]) (*connect.Response[mgmtv1alpha1.GetJobsResponse], error)) *MockJobServiceClient_GetJobs_Call {
+func (_c *MockJobServiceClient_GetJobVersionDiff_Call) RunAndReturn(run func(context.Context, *connect.Request[mgmtv1alpha1.GetJobVersionDiffRequest]) (*connect.Response[mgmtv1alpha1.GetJobVersionDiffResponse], error)) *MockJobServiceClient_GetJobVersionDiff_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// IsJobNameAvailable provides a mock function with given fields: _a0, _a1
-func (_m *MockJobServiceClient) IsJobNameAvailable(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.IsJobNameAvailableRequest]) (*connect.Response[mgmtv1alpha1.IsJobNameAvailableResponse], error) {
+// GetJobVersions provides a mock function with given fields: _a0, _a1
+func (_m *MockJobServiceClient) GetJobVersions(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.GetJobVersionsRequest]) (*connect.Response[mgmtv1alpha1.GetJobVersionsResponse], error) {
 	ret := _m.Called(_a0, _a1)
 
 	if len(ret) == 0 {
-		panic("no return value specified for IsJobNameAvailable")
+		panic("no return value specified for GetJobVersions")
 	}
 
-	var r0 *connect.Response[mgmtv1alpha1.IsJobNameAvailableResponse]
+	var r0 *connect.Response[mgmtv1alpha1.GetJobVersionsResponse]
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.IsJobNameAvailableRequest]) (*connect.Response[mgmtv1alpha1.IsJobNameAvailableResponse], error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.GetJobVersionsRequest]) (*connect.Response[mgmtv1alpha1.GetJobVersionsResponse], error)); ok {
 		return rf(_a0, _a1)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.IsJobNameAvailableRequest]) *connect.Response[mgmtv1alpha1.IsJobNameAvailableResponse]); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.GetJobVersionsRequest]) *connect.Response[mgmtv1alpha1.GetJobVersionsResponse]); ok {
 		r0 = rf(_a0, _a1)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*connect.Response[mgmtv1alpha1.IsJobNameAvailableResponse])
+			r0 = ret.Get(0).(*connect.Response[mgmtv1alpha1.GetJobVersionsResponse])
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *connect.Request[mgmtv1alpha1.IsJobNameAvailableRequest]) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *connect.Request[mgmtv1alpha1.GetJobVersionsRequest]) error); ok {
 		r1 = rf(_a0, _a1)
 	} else {
 		r1 = ret.Error(1)
@@ -1057,57 +1234,57 @@ func (_m *MockJobServiceClient) IsJobNameAvailable(_a0 context.Context, _a1 *con
 	return r0, r1
 }
 
-// MockJobServiceClient_IsJobNameAvailable_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'IsJobNameAvailable'
-type MockJobServiceClient_IsJobNameAvailable_Call struct {
+// MockJobServiceClient_GetJobVersions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetJobVersions'
+type MockJobServiceClient_GetJobVersions_Call struct {
 	*mock.Call
 }
 
-// IsJobNameAvailable is a helper method to define mock.On call
+// GetJobVersions is a helper method to define mock.On call
 //   - _a0 context.Context
-//   - _a1 *connect.Request[mgmtv1alpha1.IsJobNameAvailableRequest]
-func (_e *MockJobServiceClient_Expecter) IsJobNameAvailable(_a0 interface{}, _a1 interface{}) *MockJobServiceClient_IsJobNameAvailable_Call {
-	return &MockJobServiceClient_IsJobNameAvailable_Call{Call: _e.mock.On("IsJobNameAvailable", _a0, _a1)}
+//   - _a1 *connect.Request[mgmtv1alpha1.GetJobVersionsRequest]
+func (_e *MockJobServiceClient_Expecter) GetJobVersions(_a0 interface{}, _a1 interface{}) *MockJobServiceClient_GetJobVersions_Call {
+	return &MockJobServiceClient_GetJobVersions_Call{Call: _e.mock.On("GetJobVersions", _a0, _a1)}
 }
 
-func (_c *MockJobServiceClient_IsJobNameAvailable_Call) Run(run func(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.IsJobNameAvailableRequest])) *MockJobServiceClient_IsJobNameAvailable_Call {
+func (_c *MockJobServiceClient_GetJobVersions_Call) Run(run func(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.GetJobVersionsRequest])) *MockJobServiceClient_GetJobVersions_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(*connect.Request[mgmtv1alpha1.IsJobNameAvailableRequest]))
+		run(args[0].(context.Context), args[1].(*connect.Request[mgmtv1alpha1.GetJobVersionsRequest]))
 	})
 	return _c
 }
 
-func (_c *MockJobServiceClient_IsJobNameAvailable_Call) Return(_a0 *connect.Response[mgmtv1alpha1.IsJobNameAvailableResponse], _a1 error) *MockJobServiceClient_IsJobNameAvailable_Call {
+func (_c *MockJobServiceClient_GetJobVersions_Call) Return(_a0 *connect.Response[mgmtv1alpha1.GetJobVersionsResponse], _a1 error) *MockJobServiceClient_GetJobVersions_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockJobServiceClient_IsJobNameAvailable_Call) RunAndReturn(run func(context.Context, *connect.Request[mgmtv1alpha1.IsJobNameAvailableRequest]) (*connect.Response[mgmtv1alpha1.IsJobNameAvailableResponse], error)) *MockJobServiceClient_IsJobNameAvailable_Call {
+func (_c *MockJobServiceClient_GetJobVersions_Call) RunAndReturn(run func(context.Context, *connect.Request[mgmtv1alpha1.GetJobVersionsRequest]) (*connect.Response[mgmtv1alpha1.GetJobVersionsResponse], error)) *MockJobServiceClient_GetJobVersions_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// PauseJob provides a mock function with given fields: _a0, _a1
-func (_m *MockJobServiceClient) PauseJob(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.PauseJobRequest]) (*connect.Response[mgmtv1alpha1.PauseJobResponse], error) {
+// GetJobWatermarks provides a mock function with given fields: _a0, _a1
+func (_m *MockJobServiceClient) GetJobWatermarks(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.GetJobWatermarksRequest]) (*connect.Response[mgmtv1alpha1.GetJobWatermarksResponse], error) {
 	ret := _m.Called(_a0, _a1)
 
 	if len(ret) == 0 {
-		panic("no return value specified for PauseJob")
+		panic("no return value specified for GetJobWatermarks")
 	}
 
-	var r0 *connect.Response[mgmtv1alpha1.PauseJobResponse]
+	var r0 *connect.Response[mgmtv1alpha1.GetJobWatermarksResponse]
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.PauseJobRequest]) (*connect.Response[mgmtv1alpha1.PauseJobResponse], error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.GetJobWatermarksRequest]) (*connect.Response[mgmtv1alpha1.GetJobWatermarksResponse], error)); ok {
 		return rf(_a0, _a1)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.PauseJobRequest]) *connect.Response[mgmtv1alpha1.PauseJobResponse]); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.GetJobWatermarksRequest]) *connect.Response[mgmtv1alpha1.GetJobWatermarksResponse]); ok {
 		r0 = rf(_a0, _a1)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*connect.Response[mgmtv1alpha1.PauseJobResponse])
+			r0 = ret.Get(0).(*connect.Response[mgmtv1alpha1.GetJobWatermarksResponse])
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *connect.Request[mgmtv1alpha1.PauseJobRequest]) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *connect.Request[mgmtv1alpha1.GetJobWatermarksRequest]) error); ok {
 		r1 = rf(_a0, _a1)
 	} else {
 		r1 = ret.Error(1)
@@ -1116,57 +1293,57 @@ func (_m *MockJobServiceClient) PauseJob(_a0 context.Context, _a1 *connect.Reque
 	return r0, r1
 }
 
-// MockJobServiceClient_PauseJob_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PauseJob'
-type MockJobServiceClient_PauseJob_Call struct {
+// MockJobServiceClient_GetJobWatermarks_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetJobWatermarks'
+type MockJobServiceClient_GetJobWatermarks_Call struct {
 	*mock.Call
 }
 
-// PauseJob is a helper method to define mock.On call
+// GetJobWatermarks is a helper method to define mock.On call
 //   - _a0 context.Context
-//   - _a1 *connect.Request[mgmtv1alpha1.PauseJobRequest]
-func (_e *MockJobServiceClient_Expecter) PauseJob(_a0 interface{}, _a1 interface{}) *MockJobServiceClient_PauseJob_Call {
-	return &MockJobServiceClient_PauseJob_Call{Call: _e.mock.On("PauseJob", _a0, _a1)}
+//   - _a1 *connect.Request[mgmtv1alpha1.GetJobWatermarksRequest]
+func (_e *MockJobServiceClient_Expecter) GetJobWatermarks(_a0 interface{}, _a1 interface{}) *MockJobServiceClient_GetJobWatermarks_Call {
+	return &MockJobServiceClient_GetJobWatermarks_Call{Call: _e.mock.On("GetJobWatermarks", _a0, _a1)}
 }
 
-func (_c *MockJobServiceClient_PauseJob_Call) Run(run func(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.PauseJobRequest])) *MockJobServiceClient_PauseJob_Call {
+func (_c *MockJobServiceClient_GetJobWatermarks_Call) Run(run func(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.GetJobWatermarksRequest])) *MockJobServiceClient_GetJobWatermarks_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(*connect.Request[mgmtv1alpha1.PauseJobRequest]))
+		run(args[0].(context.Context), args[1].(*connect.Request[mgmtv1alpha1.GetJobWatermarksRequest]))
 	})
 	return _c
 }
 
-func (_c *MockJobServiceClient_PauseJob_Call) Return(_a0 *connect.Response[mgmtv1alpha1.PauseJobResponse], _a1 error) *MockJobServiceClient_PauseJob_Call {
+func (_c *MockJobServiceClient_GetJobWatermarks_Call) Return(_a0 *connect.Response[mgmtv1alpha1.GetJobWatermarksResponse], _a1 error) *MockJobServiceClient_GetJobWatermarks_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockJobServiceClient_PauseJob_Call) RunAndReturn(run func(context.Context, *connect.Request[mgmtv1alpha1.PauseJobRequest]) (*connect.Response[mgmtv1alpha1.PauseJobResponse], error)) *MockJobServiceClient_PauseJob_Call {
+func (_c *MockJobServiceClient_GetJobWatermarks_Call) RunAndReturn(run func(context.Context, *connect.Request[mgmtv1alpha1.GetJobWatermarksRequest]) (*connect.Response[mgmtv1alpha1.GetJobWatermarksResponse], error)) *MockJobServiceClient_GetJobWatermarks_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// SetJobSourceSqlConnectionSubsets provides a mock function with given fields: _a0, _a1
-func (_m *MockJobServiceClient) SetJobSourceSqlConnectionSubsets(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.SetJobSourceSqlConnectionSubsetsRequest]) (*connect.Response[mgmtv1alpha1.SetJobSourceSqlConnectionSubsetsResponse], error) {
+// GetJobs provides a mock function with given fields: _a0, _a1
+func (_m *MockJobServiceClient) GetJobs(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.GetJobsRequest]) (*connect.Response[mgmtv1alpha1.GetJobsResponse], error) {
 	ret := _m.Called(_a0, _a1)
 
 	if len(ret) == 0 {
-		panic("no return value specified for SetJobSourceSqlConnectionSubsets")
+		panic("no return value specified for GetJobs")
 	}
 
-	var r0 *connect.Response[mgmtv1alpha1.SetJobSourceSqlConnectionSubsetsResponse]
+	var r0 *connect.Response[mgmtv1alpha1.GetJobsResponse]
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.SetJobSourceSqlConnectionSubsetsRequest]) (*connect.Response[mgmtv1alpha1.SetJobSourceSqlConnectionSubsetsResponse], error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.GetJobsRequest]) (*connect.Response[mgmtv1alpha1.GetJobsResponse], error)); ok {
 		return rf(_a0, _a1)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.SetJobSourceSqlConnectionSubsetsRequest]) *connect.Response[mgmtv1alpha1.SetJobSourceSqlConnectionSubsetsResponse]); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.GetJobsRequest]) *connect.Response[mgmtv1alpha1.GetJobsResponse]); ok {
 		r0 = rf(_a0, _a1)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*connect.Response[mgmtv1alpha1.SetJobSourceSqlConnectionSubsetsResponse])
+			r0 = ret.Get(0).(*connect.Response[mgmtv1alpha1.GetJobsResponse])
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *connect.Request[mgmtv1alpha1.SetJobSourceSqlConnectionSubsetsRequest]) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *connect.Request[mgmtv1alpha1.GetJobsRequest]) error); ok {
 		r1 = rf(_a0, _a1)
 	} else {
 		r1 = ret.Error(1)
@@ -1175,57 +1352,57 @@ func (_m *MockJobServiceClient) SetJobSourceSqlConnectionSubsets(_a0 context.Con
 	return r0, r1
 }
 
-// MockJobServiceClient_SetJobSourceSqlConnectionSubsets_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetJobSourceSqlConnectionSubsets'
-type MockJobServiceClient_SetJobSourceSqlConnectionSubsets_Call struct {
+// MockJobServiceClient_GetJobs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetJobs'
+type MockJobServiceClient_GetJobs_Call struct {
 	*mock.Call
 }
 
-// SetJobSourceSqlConnectionSubsets is a helper method to define mock.On call
+// GetJobs is a helper method to define mock.On call
 //   - _a0 context.Context
-//   - _a1 *connect.Request[mgmtv1alpha1.SetJobSourceSqlConnectionSubsetsRequest]
-func (_e *MockJobServiceClient_Expecter) SetJobSourceSqlConnectionSubsets(_a0 interface{}, _a1 interface{}) *MockJobServiceClient_SetJobSourceSqlConnectionSubsets_Call {
-	return &MockJobServiceClient_SetJobSourceSqlConnectionSubsets_Call{Call: _e.mock.On("SetJobSourceSqlConnectionSubsets", _a0, _a1)}
+//   - _a1 *connect.Request[mgmtv1alpha1.GetJobsRequest]
+func (_e *MockJobServiceClient_Expecter) GetJobs(_a0 interface{}, _a1 interface{}) *MockJobServiceClient_GetJobs_Call {
+	return &MockJobServiceClient_GetJobs_Call{Call: _e.mock.On("GetJobs", _a0, _a1)}
 }
 
-func (_c *MockJobServiceClient_SetJobSourceSqlConnectionSubsets_Call) Run(run func(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.SetJobSourceSqlConnectionSubsetsRequest])) *MockJobServiceClient_SetJobSourceSqlConnectionSubsets_Call {
+func (_c *MockJobServiceClient_GetJobs_Call) Run(run func(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.GetJobsRequest])) *MockJobServiceClient_GetJobs_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(*connect.Request[mgmtv1alpha1.SetJobSourceSqlConnectionSubsetsRequest]))
+		run(args[0].(context.Context), args[1].(*connect.Request[mgmtv1alpha1.GetJobsRequest]))
 	})
 	return _c
 }
 
-func (_c *MockJobServiceClient_SetJobSourceSqlConnectionSubsets_Call) Return(_a0 *connect.Response[mgmtv1alpha1.SetJobSourceSqlConnectionSubsetsResponse], _a1 error) *MockJobServiceClient_SetJobSourceSqlConnectionSubsets_Call {
+func (_c *MockJobServiceClient_GetJobs_Call) Return(_a0 *connect.Response[mgmtv1alpha1.GetJobsResponse], _a1 error) *MockJobServiceClient_GetJobs_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockJobServiceClient_SetJobSourceSqlConnectionSubsets_Call) RunAndReturn(run func(context.Context, *connect.Request[mgmtv1alpha1.SetJobSourceSqlConnectionSubsetsRequest]) (*connect.Response[mgmtv1alpha1.SetJobSourceSqlConnectionSubsetsResponse], error)) *MockJobServiceClient_SetJobSourceSqlConnectionSubsets_Call {
+func (_c *MockJobServiceClient_GetJobs_Call) RunAndReturn(run func(context.Context, *connect.Request[mgmtv1alpha1.GetJobsRequest]) (*connect.Response[mgmtv1alpha1.GetJobsResponse], error)) *MockJobServiceClient_GetJobs_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// SetJobSyncOptions provides a mock function with given fields: _a0, _a1
-func (_m *MockJobServiceClient) SetJobSyncOptions(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.SetJobSyncOptionsRequest]) (*connect.Response[mgmtv1alpha1.SetJobSyncOptionsResponse], error) {
+// IsJobNameAvailable provides a mock function with given fields: _a0, _a1
+func (_m *MockJobServiceClient) IsJobNameAvailable(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.IsJobNameAvailableRequest]) (*connect.Response[mgmtv1alpha1.IsJobNameAvailableResponse], error) {
 	ret := _m.Called(_a0, _a1)
 
 	if len(ret) == 0 {
-		panic("no return value specified for SetJobSyncOptions")
+		panic("no return value specified for IsJobNameAvailable")
 	}
 
-	var r0 *connect.Response[mgmtv1alpha1.SetJobSyncOptionsResponse]
+	var r0 *connect.Response[mgmtv1alpha1.IsJobNameAvailableResponse]
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.SetJobSyncOptionsRequest]) (*connect.Response[mgmtv1alpha1.SetJobSyncOptionsResponse], error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.IsJobNameAvailableRequest]) (*connect.Response[mgmtv1alpha1.IsJobNameAvailableResponse], error)); ok {
 		return rf(_a0, _a1)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.SetJobSyncOptionsRequest]) *connect.Response[mgmtv1alpha1.SetJobSyncOptionsResponse]); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.IsJobNameAvailableRequest]) *connect.Response[mgmtv1alpha1.IsJobNameAvailableResponse]); ok {
 		r0 = rf(_a0, _a1)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*connect.Response[mgmtv1alpha1.SetJobSyncOptionsResponse])
+			r0 = ret.Get(0).(*connect.Response[mgmtv1alpha1.IsJobNameAvailableResponse])
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *connect.Request[mgmtv1alpha1.SetJobSyncOptionsRequest]) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *connect.Request[mgmtv1alpha1.IsJobNameAvailableRequest]) error); ok {
 		r1 = rf(_a0, _a1)
 	} else {
 		r1 = ret.Error(1)
@@ -1234,44 +1411,634 @@ func (_m *MockJobServiceClient) SetJobSyncOptions(_a0 context.Context, _a1 *conn
 	return r0, r1
 }
 
-// MockJobServiceClient_SetJobSyncOptions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetJobSyncOptions'
-type MockJobServiceClient_SetJobSyncOptions_Call struct {
+// MockJobServiceClient_IsJobNameAvailable_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'IsJobNameAvailable'
+type MockJobServiceClient_IsJobNameAvailable_Call struct {
 	*mock.Call
 }
 
-// SetJobSyncOptions is a helper method to define mock.On call
+// IsJobNameAvailable is a helper method to define mock.On call
 //   - _a0 context.Context
-//   - _a1 *connect.Request[mgmtv1alpha1.SetJobSyncOptionsRequest]
-func (_e *MockJobServiceClient_Expecter) SetJobSyncOptions(_a0 interface{}, _a1 interface{}) *MockJobServiceClient_SetJobSyncOptions_Call {
-	return &MockJobServiceClient_SetJobSyncOptions_Call{Call: _e.mock.On("SetJobSyncOptions", _a0, _a1)}
+//   - _a1 *connect.Request[mgmtv1alpha1.IsJobNameAvailableRequest]
+func (_e *MockJobServiceClient_Expecter) IsJobNameAvailable(_a0 interface{}, _a1 interface{}) *MockJobServiceClient_IsJobNameAvailable_Call {
+	return &MockJobServiceClient_IsJobNameAvailable_Call{Call: _e.mock.On("IsJobNameAvailable", _a0, _a1)}
 }
 
-func (_c *MockJobServiceClient_SetJobSyncOptions_Call) Run(run func(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.SetJobSyncOptionsRequest])) *MockJobServiceClient_SetJobSyncOptions_Call {
+func (_c *MockJobServiceClient_IsJobNameAvailable_Call) Run(run func(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.IsJobNameAvailableRequest])) *MockJobServiceClient_IsJobNameAvailable_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(*connect.Request[mgmtv1alpha1.SetJobSyncOptionsRequest]))
+		run(args[0].(context.Context), args[1].(*connect.Request[mgmtv1alpha1.IsJobNameAvailableRequest]))
 	})
 	return _c
 }
 
-func (_c *MockJobServiceClient_SetJobSyncOptions_Call) Return(_a0 *connect.Response[mgmtv1alpha1.SetJobSyncOptionsResponse], _a1 error) *MockJobServiceClient_SetJobSyncOptions_Call {
+func (_c *MockJobServiceClient_IsJobNameAvailable_Call) Return(_a0 *connect.Response[mgmtv1alpha1.IsJobNameAvailableResponse], _a1 error) *MockJobServiceClient_IsJobNameAvailable_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockJobServiceClient_SetJobSyncOptions_Call) RunAndReturn(run func(context.Context, *connect.Request[mgmtv1alpha1.SetJobSyncOptionsRequest]) (*connect.Response[mgmtv1alpha1.SetJobSyncOptionsResponse], error)) *MockJobServiceClient_SetJobSyncOptions_Call {
+func (_c *MockJobServiceClient_IsJobNameAvailable_Call) RunAndReturn(run func(context.Context, *connect.Request[mgmtv1alpha1.IsJobNameAvailableRequest]) (*connect.Response[mgmtv1alpha1.IsJobNameAvailableResponse], error)) *MockJobServiceClient_IsJobNameAvailable_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// SetJobWorkflowOptions provides a mock function with given fields: _a0, _a1
-func (_m *MockJobServiceClient) SetJobWorkflowOptions(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.SetJobWorkflowOptionsRequest]) (*connect.Response[mgmtv1alpha1.SetJobWorkflowOptionsResponse], error) {
+// PauseJob provides a mock function with given fields: _a0, _a1
+func (_m *MockJobServiceClient) PauseJob(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.PauseJobRequest]) (*connect.Response[mgmtv1alpha1.PauseJobResponse], error) {
 	ret := _m.Called(_a0, _a1)
 
 	if len(ret) == 0 {
-		panic("no return value specified for SetJobWorkflowOptions")
+		panic("no return value specified for PauseJob")
 	}
 
-	var r0 *connect.Response[mgmtv1alpha1.SetJobWorkflowOptionsResponse]
+	var r0 *connect.Response[mgmtv1alpha1.PauseJobResponse]
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.PauseJobRequest]) (*connect.Response[mgmtv1alpha1.PauseJobResponse], error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.PauseJobRequest]) *connect.Response[mgmtv1alpha1.PauseJobResponse]); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*connect.Response[mgmtv1alpha1.PauseJobResponse])
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *connect.Request[mgmtv1alpha1.PauseJobRequest]) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockJobServiceClient_PauseJob_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PauseJob'
+type MockJobServiceClient_PauseJob_Call struct {
+	*mock.Call
+}
+
+// PauseJob is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *connect.Request[mgmtv1alpha1.PauseJobRequest]
+func (_e *MockJobServiceClient_Expecter) PauseJob(_a0 interface{}, _a1 interface{}) *MockJobServiceClient_PauseJob_Call {
+	return &MockJobServiceClient_PauseJob_Call{Call: _e.mock.On("PauseJob", _a0, _a1)}
+}
+
+func (_c *MockJobServiceClient_PauseJob_Call) Run(run func(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.PauseJobRequest])) *MockJobServiceClient_PauseJob_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*connect.Request[mgmtv1alpha1.PauseJobRequest]))
+	})
+	return _c
+}
+
+func (_c *MockJobServiceClient_PauseJob_Call) Return(_a0 *connect.Response[mgmtv1alpha1.PauseJobResponse], _a1 error) *MockJobServiceClient_PauseJob_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockJobServiceClient_PauseJob_Call) RunAndReturn(run func(context.Context, *connect.Request[mgmtv1alpha1.PauseJobRequest]) (*connect.Response[mgmtv1alpha1.PauseJobResponse], error)) *MockJobServiceClient_PauseJob_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PreviewJobMappings provides a mock function with given fields: _a0, _a1
+func (_m *MockJobServiceClient) PreviewJobMappings(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.PreviewJobMappingsRequest]) (*connect.Response[mgmtv1alpha1.PreviewJobMappingsResponse], error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PreviewJobMappings")
+	}
+
+	var r0 *connect.Response[mgmtv1alpha1.PreviewJobMappingsResponse]
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.PreviewJobMappingsRequest]) (*connect.Response[mgmtv1alpha1.PreviewJobMappingsResponse], error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.PreviewJobMappingsRequest]) *connect.Response[mgmtv1alpha1.PreviewJobMappingsResponse]); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*connect.Response[mgmtv1alpha1.PreviewJobMappingsResponse])
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *connect.Request[mgmtv1alpha1.PreviewJobMappingsRequest]) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockJobServiceClient_PreviewJobMappings_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PreviewJobMappings'
+type MockJobServiceClient_PreviewJobMappings_Call struct {
+	*mock.Call
+}
+
+// PreviewJobMappings is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *connect.Request[mgmtv1alpha1.PreviewJobMappingsRequest]
+func (_e *MockJobServiceClient_Expecter) PreviewJobMappings(_a0 interface{}, _a1 interface{}) *MockJobServiceClient_PreviewJobMappings_Call {
+	return &MockJobServiceClient_PreviewJobMappings_Call{Call: _e.mock.On("PreviewJobMappings", _a0, _a1)}
+}
+
+func (_c *MockJobServiceClient_PreviewJobMappings_Call) Run(run func(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.PreviewJobMappingsRequest])) *MockJobServiceClient_PreviewJobMappings_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*connect.Request[mgmtv1alpha1.PreviewJobMappingsRequest]))
+	})
+	return _c
+}
+
+func (_c *MockJobServiceClient_PreviewJobMappings_Call) Return(_a0 *connect.Response[mgmtv1alpha1.PreviewJobMappingsResponse], _a1 error) *MockJobServiceClient_PreviewJobMappings_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockJobServiceClient_PreviewJobMappings_Call) RunAndReturn(run func(context.Context, *connect.Request[mgmtv1alpha1.PreviewJobMappingsRequest]) (*connect.Response[mgmtv1alpha1.PreviewJobMappingsResponse], error)) *MockJobServiceClient_PreviewJobMappings_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ResetJobWatermarks provides a mock function with given fields: _a0, _a1
+func (_m *MockJobServiceClient) ResetJobWatermarks(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.ResetJobWatermarksRequest]) (*connect.Response[mgmtv1alpha1.ResetJobWatermarksResponse], error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ResetJobWatermarks")
+	}
+
+	var r0 *connect.Response[mgmtv1alpha1.ResetJobWatermarksResponse]
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.ResetJobWatermarksRequest]) (*connect.Response[mgmtv1alpha1.ResetJobWatermarksResponse], error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.ResetJobWatermarksRequest]) *connect.Response[mgmtv1alpha1.ResetJobWatermarksResponse]); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*connect.Response[mgmtv1alpha1.ResetJobWatermarksResponse])
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *connect.Request[mgmtv1alpha1.ResetJobWatermarksRequest]) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockJobServiceClient_ResetJobWatermarks_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ResetJobWatermarks'
+type MockJobServiceClient_ResetJobWatermarks_Call struct {
+	*mock.Call
+}
+
+// ResetJobWatermarks is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *connect.Request[mgmtv1alpha1.ResetJobWatermarksRequest]
+func (_e *MockJobServiceClient_Expecter) ResetJobWatermarks(_a0 interface{}, _a1 interface{}) *MockJobServiceClient_ResetJobWatermarks_Call {
+	return &MockJobServiceClient_ResetJobWatermarks_Call{Call: _e.mock.On("ResetJobWatermarks", _a0, _a1)}
+}
+
+func (_c *MockJobServiceClient_ResetJobWatermarks_Call) Run(run func(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.ResetJobWatermarksRequest])) *MockJobServiceClient_ResetJobWatermarks_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*connect.Request[mgmtv1alpha1.ResetJobWatermarksRequest]))
+	})
+	return _c
+}
+
+func (_c *MockJobServiceClient_ResetJobWatermarks_Call) Return(_a0 *connect.Response[mgmtv1alpha1.ResetJobWatermarksResponse], _a1 error) *MockJobServiceClient_ResetJobWatermarks_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockJobServiceClient_ResetJobWatermarks_Call) RunAndReturn(run func(context.Context, *connect.Request[mgmtv1alpha1.ResetJobWatermarksRequest]) (*connect.Response[mgmtv1alpha1.ResetJobWatermarksResponse], error)) *MockJobServiceClient_ResetJobWatermarks_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RollbackJobToVersion provides a mock function with given fields: _a0, _a1
+func (_m *MockJobServiceClient) RollbackJobToVersion(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.RollbackJobToVersionRequest]) (*connect.Response[mgmtv1alpha1.RollbackJobToVersionResponse], error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RollbackJobToVersion")
+	}
+
+	var r0 *connect.Response[mgmtv1alpha1.RollbackJobToVersionResponse]
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.RollbackJobToVersionRequest]) (*connect.Response[mgmtv1alpha1.RollbackJobToVersionResponse], error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.RollbackJobToVersionRequest]) *connect.Response[mgmtv1alpha1.RollbackJobToVersionResponse]); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*connect.Response[mgmtv1alpha1.RollbackJobToVersionResponse])
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *connect.Request[mgmtv1alpha1.RollbackJobToVersionRequest]) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockJobServiceClient_RollbackJobToVersion_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RollbackJobToVersion'
+type MockJobServiceClient_RollbackJobToVersion_Call struct {
+	*mock.Call
+}
+
+// RollbackJobToVersion is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *connect.Request[mgmtv1alpha1.RollbackJobToVersionRequest]
+func (_e *MockJobServiceClient_Expecter) RollbackJobToVersion(_a0 interface{}, _a1 interface{}) *MockJobServiceClient_RollbackJobToVersion_Call {
+	return &MockJobServiceClient_RollbackJobToVersion_Call{Call: _e.mock.On("RollbackJobToVersion", _a0, _a1)}
+}
+
+func (_c *MockJobServiceClient_RollbackJobToVersion_Call) Run(run func(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.RollbackJobToVersionRequest])) *MockJobServiceClient_RollbackJobToVersion_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*connect.Request[mgmtv1alpha1.RollbackJobToVersionRequest]))
+	})
+	return _c
+}
+
+func (_c *MockJobServiceClient_RollbackJobToVersion_Call) Return(_a0 *connect.Response[mgmtv1alpha1.RollbackJobToVersionResponse], _a1 error) *MockJobServiceClient_RollbackJobToVersion_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockJobServiceClient_RollbackJobToVersion_Call) RunAndReturn(run func(context.Context, *connect.Request[mgmtv1alpha1.RollbackJobToVersionRequest]) (*connect.Response[mgmtv1alpha1.RollbackJobToVersionResponse], error)) *MockJobServiceClient_RollbackJobToVersion_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetJobRunSchemaDriftReport provides a mock function with given fields: _a0, _a1
+func (_m *MockJobServiceClient) SetJobRunSchemaDriftReport(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.SetJobRunSchemaDriftReportRequest]) (*connect.Response[mgmtv1alpha1.SetJobRunSchemaDriftReportResponse], error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetJobRunSchemaDriftReport")
+	}
+
+	var r0 *connect.Response[mgmtv1alpha1.SetJobRunSchemaDriftReportResponse]
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.SetJobRunSchemaDriftReportRequest]) (*connect.Response[mgmtv1alpha1.SetJobRunSchemaDriftReportResponse], error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.SetJobRunSchemaDriftReportRequest]) *connect.Response[mgmtv1alpha1.SetJobRunSchemaDriftReportResponse]); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*connect.Response[mgmtv1alpha1.SetJobRunSchemaDriftReportResponse])
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *connect.Request[mgmtv1alpha1.SetJobRunSchemaDriftReportRequest]) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockJobServiceClient_SetJobRunSchemaDriftReport_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetJobRunSchemaDriftReport'
+type MockJobServiceClient_SetJobRunSchemaDriftReport_Call struct {
+	*mock.Call
+}
+
+// SetJobRunSchemaDriftReport is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *connect.Request[mgmtv1alpha1.SetJobRunSchemaDriftReportRequest]
+func (_e *MockJobServiceClient_Expecter) SetJobRunSchemaDriftReport(_a0 interface{}, _a1 interface{}) *MockJobServiceClient_SetJobRunSchemaDriftReport_Call {
+	return &MockJobServiceClient_SetJobRunSchemaDriftReport_Call{Call: _e.mock.On("SetJobRunSchemaDriftReport", _a0, _a1)}
+}
+
+func (_c *MockJobServiceClient_SetJobRunSchemaDriftReport_Call) Run(run func(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.SetJobRunSchemaDriftReportRequest])) *MockJobServiceClient_SetJobRunSchemaDriftReport_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*connect.Request[mgmtv1alpha1.SetJobRunSchemaDriftReportRequest]))
+	})
+	return _c
+}
+
+func (_c *MockJobServiceClient_SetJobRunSchemaDriftReport_Call) Return(_a0 *connect.Response[mgmtv1alpha1.SetJobRunSchemaDriftReportResponse], _a1 error) *MockJobServiceClient_SetJobRunSchemaDriftReport_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockJobServiceClient_SetJobRunSchemaDriftReport_Call) RunAndReturn(run func(context.Context, *connect.Request[mgmtv1alpha1.SetJobRunSchemaDriftReportRequest]) (*connect.Response[mgmtv1alpha1.SetJobRunSchemaDriftReportResponse], error)) *MockJobServiceClient_SetJobRunSchemaDriftReport_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetJobRunValidationReport provides a mock function with given fields: _a0, _a1
+func (_m *MockJobServiceClient) SetJobRunValidationReport(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.SetJobRunValidationReportRequest]) (*connect.Response[mgmtv1alpha1.SetJobRunValidationReportResponse], error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetJobRunValidationReport")
+	}
+
+	var r0 *connect.Response[mgmtv1alpha1.SetJobRunValidationReportResponse]
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.SetJobRunValidationReportRequest]) (*connect.Response[mgmtv1alpha1.SetJobRunValidationReportResponse], error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.SetJobRunValidationReportRequest]) *connect.Response[mgmtv1alpha1.SetJobRunValidationReportResponse]); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*connect.Response[mgmtv1alpha1.SetJobRunValidationReportResponse])
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *connect.Request[mgmtv1alpha1.SetJobRunValidationReportRequest]) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockJobServiceClient_SetJobRunValidationReport_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetJobRunValidationReport'
+type MockJobServiceClient_SetJobRunValidationReport_Call struct {
+	*mock.Call
+}
+
+// SetJobRunValidationReport is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *connect.Request[mgmtv1alpha1.SetJobRunValidationReportRequest]
+func (_e *MockJobServiceClient_Expecter) SetJobRunValidationReport(_a0 interface{}, _a1 interface{}) *MockJobServiceClient_SetJobRunValidationReport_Call {
+	return &MockJobServiceClient_SetJobRunValidationReport_Call{Call: _e.mock.On("SetJobRunValidationReport", _a0, _a1)}
+}
+
+func (_c *MockJobServiceClient_SetJobRunValidationReport_Call) Run(run func(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.SetJobRunValidationReportRequest])) *MockJobServiceClient_SetJobRunValidationReport_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*connect.Request[mgmtv1alpha1.SetJobRunValidationReportRequest]))
+	})
+	return _c
+}
+
+func (_c *MockJobServiceClient_SetJobRunValidationReport_Call) Return(_a0 *connect.Response[mgmtv1alpha1.SetJobRunValidationReportResponse], _a1 error) *MockJobServiceClient_SetJobRunValidationReport_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockJobServiceClient_SetJobRunValidationReport_Call) RunAndReturn(run func(context.Context, *connect.Request[mgmtv1alpha1.SetJobRunValidationReportRequest]) (*connect.Response[mgmtv1alpha1.SetJobRunValidationReportResponse], error)) *MockJobServiceClient_SetJobRunValidationReport_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetJobRunWatermarks provides a mock function with given fields: _a0, _a1
+func (_m *MockJobServiceClient) SetJobRunWatermarks(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.SetJobRunWatermarksRequest]) (*connect.Response[mgmtv1alpha1.SetJobRunWatermarksResponse], error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetJobRunWatermarks")
+	}
+
+	var r0 *connect.Response[mgmtv1alpha1.SetJobRunWatermarksResponse]
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.SetJobRunWatermarksRequest]) (*connect.Response[mgmtv1alpha1.SetJobRunWatermarksResponse], error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.SetJobRunWatermarksRequest]) *connect.Response[mgmtv1alpha1.SetJobRunWatermarksResponse]); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*connect.Response[mgmtv1alpha1.SetJobRunWatermarksResponse])
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *connect.Request[mgmtv1alpha1.SetJobRunWatermarksRequest]) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockJobServiceClient_SetJobRunWatermarks_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetJobRunWatermarks'
+type MockJobServiceClient_SetJobRunWatermarks_Call struct {
+	*mock.Call
+}
+
+// SetJobRunWatermarks is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *connect.Request[mgmtv1alpha1.SetJobRunWatermarksRequest]
+func (_e *MockJobServiceClient_Expecter) SetJobRunWatermarks(_a0 interface{}, _a1 interface{}) *MockJobServiceClient_SetJobRunWatermarks_Call {
+	return &MockJobServiceClient_SetJobRunWatermarks_Call{Call: _e.mock.On("SetJobRunWatermarks", _a0, _a1)}
+}
+
+func (_c *MockJobServiceClient_SetJobRunWatermarks_Call) Run(run func(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.SetJobRunWatermarksRequest])) *MockJobServiceClient_SetJobRunWatermarks_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*connect.Request[mgmtv1alpha1.SetJobRunWatermarksRequest]))
+	})
+	return _c
+}
+
+func (_c *MockJobServiceClient_SetJobRunWatermarks_Call) Return(_a0 *connect.Response[mgmtv1alpha1.SetJobRunWatermarksResponse], _a1 error) *MockJobServiceClient_SetJobRunWatermarks_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockJobServiceClient_SetJobRunWatermarks_Call) RunAndReturn(run func(context.Context, *connect.Request[mgmtv1alpha1.SetJobRunWatermarksRequest]) (*connect.Response[mgmtv1alpha1.SetJobRunWatermarksResponse], error)) *MockJobServiceClient_SetJobRunWatermarks_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetJobSourceSqlConnectionSubsets provides a mock function with given fields: _a0, _a1
+func (_m *MockJobServiceClient) SetJobSourceSqlConnectionSubsets(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.SetJobSourceSqlConnectionSubsetsRequest]) (*connect.Response[mgmtv1alpha1.SetJobSourceSqlConnectionSubsetsResponse], error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetJobSourceSqlConnectionSubsets")
+	}
+
+	var r0 *connect.Response[mgmtv1alpha1.SetJobSourceSqlConnectionSubsetsResponse]
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.SetJobSourceSqlConnectionSubsetsRequest]) (*connect.Response[mgmtv1alpha1.SetJobSourceSqlConnectionSubsetsResponse], error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.SetJobSourceSqlConnectionSubsetsRequest]) *connect.Response[mgmtv1alpha1.SetJobSourceSqlConnectionSubsetsResponse]); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*connect.Response[mgmtv1alpha1.SetJobSourceSqlConnectionSubsetsResponse])
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *connect.Request[mgmtv1alpha1.SetJobSourceSqlConnectionSubsetsRequest]) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockJobServiceClient_SetJobSourceSqlConnectionSubsets_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetJobSourceSqlConnectionSubsets'
+type MockJobServiceClient_SetJobSourceSqlConnectionSubsets_Call struct {
+	*mock.Call
+}
+
+// SetJobSourceSqlConnectionSubsets is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *connect.Request[mgmtv1alpha1.SetJobSourceSqlConnectionSubsetsRequest]
+func (_e *MockJobServiceClient_Expecter) SetJobSourceSqlConnectionSubsets(_a0 interface{}, _a1 interface{}) *MockJobServiceClient_SetJobSourceSqlConnectionSubsets_Call {
+	return &MockJobServiceClient_SetJobSourceSqlConnectionSubsets_Call{Call: _e.mock.On("SetJobSourceSqlConnectionSubsets", _a0, _a1)}
+}
+
+func (_c *MockJobServiceClient_SetJobSourceSqlConnectionSubsets_Call) Run(run func(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.SetJobSourceSqlConnectionSubsetsRequest])) *MockJobServiceClient_SetJobSourceSqlConnectionSubsets_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*connect.Request[mgmtv1alpha1.SetJobSourceSqlConnectionSubsetsRequest]))
+	})
+	return _c
+}
+
+func (_c *MockJobServiceClient_SetJobSourceSqlConnectionSubsets_Call) Return(_a0 *connect.Response[mgmtv1alpha1.SetJobSourceSqlConnectionSubsetsResponse], _a1 error) *MockJobServiceClient_SetJobSourceSqlConnectionSubsets_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockJobServiceClient_SetJobSourceSqlConnectionSubsets_Call) RunAndReturn(run func(context.Context, *connect.Request[mgmtv1alpha1.SetJobSourceSqlConnectionSubsetsRequest]) (*connect.Response[mgmtv1alpha1.SetJobSourceSqlConnectionSubsetsResponse], error)) *MockJobServiceClient_SetJobSourceSqlConnectionSubsets_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetJobSyncOptions provides a mock function with given fields: _a0, _a1
+func (_m *MockJobServiceClient) SetJobSyncOptions(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.SetJobSyncOptionsRequest]) (*connect.Response[mgmtv1alpha1.SetJobSyncOptionsResponse], error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetJobSyncOptions")
+	}
+
+	var r0 *connect.Response[mgmtv1alpha1.SetJobSyncOptionsResponse]
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.SetJobSyncOptionsRequest]) (*connect.Response[mgmtv1alpha1.SetJobSyncOptionsResponse], error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.SetJobSyncOptionsRequest]) *connect.Response[mgmtv1alpha1.SetJobSyncOptionsResponse]); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*connect.Response[mgmtv1alpha1.SetJobSyncOptionsResponse])
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *connect.Request[mgmtv1alpha1.SetJobSyncOptionsRequest]) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockJobServiceClient_SetJobSyncOptions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetJobSyncOptions'
+type MockJobServiceClient_SetJobSyncOptions_Call struct {
+	*mock.Call
+}
+
+// SetJobSyncOptions is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *connect.Request[mgmtv1alpha1.SetJobSyncOptionsRequest]
+func (_e *MockJobServiceClient_Expecter) SetJobSyncOptions(_a0 interface{}, _a1 interface{}) *MockJobServiceClient_SetJobSyncOptions_Call {
+	return &MockJobServiceClient_SetJobSyncOptions_Call{Call: _e.mock.On("SetJobSyncOptions", _a0, _a1)}
+}
+
+func (_c *MockJobServiceClient_SetJobSyncOptions_Call) Run(run func(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.SetJobSyncOptionsRequest])) *MockJobServiceClient_SetJobSyncOptions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*connect.Request[mgmtv1alpha1.SetJobSyncOptionsRequest]))
+	})
+	return _c
+}
+
+func (_c *MockJobServiceClient_SetJobSyncOptions_Call) Return(_a0 *connect.Response[mgmtv1alpha1.SetJobSyncOptionsResponse], _a1 error) *MockJobServiceClient_SetJobSyncOptions_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockJobServiceClient_SetJobSyncOptions_Call) RunAndReturn(run func(context.Context, *connect.Request[mgmtv1alpha1.SetJobSyncOptionsRequest]) (*connect.Response[mgmtv1alpha1.SetJobSyncOptionsResponse], error)) *MockJobServiceClient_SetJobSyncOptions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetJobTriggers provides a mock function with given fields: _a0, _a1
+func (_m *MockJobServiceClient) SetJobTriggers(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.SetJobTriggersRequest]) (*connect.Response[mgmtv1alpha1.SetJobTriggersResponse], error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetJobTriggers")
+	}
+
+	var r0 *connect.Response[mgmtv1alpha1.SetJobTriggersResponse]
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.SetJobTriggersRequest]) (*connect.Response[mgmtv1alpha1.SetJobTriggersResponse], error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.SetJobTriggersRequest]) *connect.Response[mgmtv1alpha1.SetJobTriggersResponse]); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*connect.Response[mgmtv1alpha1.SetJobTriggersResponse])
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *connect.Request[mgmtv1alpha1.SetJobTriggersRequest]) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockJobServiceClient_SetJobTriggers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetJobTriggers'
+type MockJobServiceClient_SetJobTriggers_Call struct {
+	*mock.Call
+}
+
+// SetJobTriggers is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *connect.Request[mgmtv1alpha1.SetJobTriggersRequest]
+func (_e *MockJobServiceClient_Expecter) SetJobTriggers(_a0 interface{}, _a1 interface{}) *MockJobServiceClient_SetJobTriggers_Call {
+	return &MockJobServiceClient_SetJobTriggers_Call{Call: _e.mock.On("SetJobTriggers", _a0, _a1)}
+}
+
+func (_c *MockJobServiceClient_SetJobTriggers_Call) Run(run func(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.SetJobTriggersRequest])) *MockJobServiceClient_SetJobTriggers_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*connect.Request[mgmtv1alpha1.SetJobTriggersRequest]))
+	})
+	return _c
+}
+
+func (_c *MockJobServiceClient_SetJobTriggers_Call) Return(_a0 *connect.Response[mgmtv1alpha1.SetJobTriggersResponse], _a1 error) *MockJobServiceClient_SetJobTriggers_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockJobServiceClient_SetJobTriggers_Call) RunAndReturn(run func(context.Context, *connect.Request[mgmtv1alpha1.SetJobTriggersRequest]) (*connect.Response[mgmtv1alpha1.SetJobTriggersResponse], error)) *MockJobServiceClient_SetJobTriggers_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetJobWorkflowOptions provides a mock function with given fields: _a0, _a1
+func (_m *MockJobServiceClient) SetJobWorkflowOptions(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.SetJobWorkflowOptionsRequest]) (*connect.Response[mgmtv1alpha1.SetJobWorkflowOptionsResponse], error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetJobWorkflowOptions")
+	}
+
+	var r0 *connect.Response[mgmtv1alpha1.SetJobWorkflowOptionsResponse]
 	var r1 error
 	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.SetJobWorkflowOptionsRequest]) (*connect.Response[mgmtv1alpha1.SetJobWorkflowOptionsResponse], error)); ok {
 		return rf(_a0, _a1)
@@ -1381,6 +2148,65 @@ func (_c *MockJobServiceClient_TerminateJobRun_Call) RunAndReturn(run func(conte
 	return _c
 }
 
+// TriggerDependentJobs provides a mock function with given fields: _a0, _a1
+func (_m *MockJobServiceClient) TriggerDependentJobs(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.TriggerDependentJobsRequest]) (*connect.Response[mgmtv1alpha1.TriggerDependentJobsResponse], error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for TriggerDependentJobs")
+	}
+
+	var r0 *connect.Response[mgmtv1alpha1.TriggerDependentJobsResponse]
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.TriggerDependentJobsRequest]) (*connect.Response[mgmtv1alpha1.TriggerDependentJobsResponse], error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.TriggerDependentJobsRequest]) *connect.Response[mgmtv1alpha1.TriggerDependentJobsResponse]); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*connect.Response[mgmtv1alpha1.TriggerDependentJobsResponse])
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *connect.Request[mgmtv1alpha1.TriggerDependentJobsRequest]) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockJobServiceClient_TriggerDependentJobs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'TriggerDependentJobs'
+type MockJobServiceClient_TriggerDependentJobs_Call struct {
+	*mock.Call
+}
+
+// TriggerDependentJobs is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *connect.Request[mgmtv1alpha1.TriggerDependentJobsRequest]
+func (_e *MockJobServiceClient_Expecter) TriggerDependentJobs(_a0 interface{}, _a1 interface{}) *MockJobServiceClient_TriggerDependentJobs_Call {
+	return &MockJobServiceClient_TriggerDependentJobs_Call{Call: _e.mock.On("TriggerDependentJobs", _a0, _a1)}
+}
+
+func (_c *MockJobServiceClient_TriggerDependentJobs_Call) Run(run func(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.TriggerDependentJobsRequest])) *MockJobServiceClient_TriggerDependentJobs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*connect.Request[mgmtv1alpha1.TriggerDependentJobsRequest]))
+	})
+	return _c
+}
+
+func (_c *MockJobServiceClient_TriggerDependentJobs_Call) Return(_a0 *connect.Response[mgmtv1alpha1.TriggerDependentJobsResponse], _a1 error) *MockJobServiceClient_TriggerDependentJobs_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockJobServiceClient_TriggerDependentJobs_Call) RunAndReturn(run func(context.Context, *connect.Request[mgmtv1alpha1.TriggerDependentJobsRequest]) (*connect.Response[mgmtv1alpha1.TriggerDependentJobsResponse], error)) *MockJobServiceClient_TriggerDependentJobs_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // UpdateJobDestinationConnection provides a mock function with given fields: _a0, _a1
 func (_m *MockJobServiceClient) UpdateJobDestinationConnection(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.UpdateJobDestinationConnectionRequest]) (*connect.Response[mgmtv1alpha1.UpdateJobDestinationConnectionResponse], error) {
 	ret := _m.Called(_a0, _a1)
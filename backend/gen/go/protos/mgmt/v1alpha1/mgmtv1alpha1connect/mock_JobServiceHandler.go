@@ -24,6 +24,65 @@ func (_m *MockJobServiceHandler) EXPECT() *MockJobServiceHandler_Expecter {
 	return &MockJobServiceHandler_Expecter{mock: &_m.Mock}
 }
 
+// ApplyJobRunSchemaDriftReport provides a mock function with given fields: _a0, _a1
+func (_m *MockJobServiceHandler) ApplyJobRunSchemaDriftReport(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.ApplyJobRunSchemaDriftReportRequest]) (*connect.Response[mgmtv1alpha1.ApplyJobRunSchemaDriftReportResponse], error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ApplyJobRunSchemaDriftReport")
+	}
+
+	var r0 *connect.Response[mgmtv1alpha1.ApplyJobRunSchemaDriftReportResponse]
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.ApplyJobRunSchemaDriftReportRequest]) (*connect.Response[mgmtv1alpha1.ApplyJobRunSchemaDriftReportResponse], error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.ApplyJobRunSchemaDriftReportRequest]) *connect.Response[mgmtv1alpha1.ApplyJobRunSchemaDriftReportResponse]); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*connect.Response[mgmtv1alpha1.ApplyJobRunSchemaDriftReportResponse])
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *connect.Request[mgmtv1alpha1.ApplyJobRunSchemaDriftReportRequest]) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockJobServiceHandler_ApplyJobRunSchemaDriftReport_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ApplyJobRunSchemaDriftReport'
+type MockJobServiceHandler_ApplyJobRunSchemaDriftReport_Call struct {
+	*mock.Call
+}
+
+// ApplyJobRunSchemaDriftReport is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *connect.Request[mgmtv1alpha1.ApplyJobRunSchemaDriftReportRequest]
+func (_e *MockJobServiceHandler_Expecter) ApplyJobRunSchemaDriftReport(_a0 interface{}, _a1 interface{}) *MockJobServiceHandler_ApplyJobRunSchemaDriftReport_Call {
+	return &MockJobServiceHandler_ApplyJobRunSchemaDriftReport_Call{Call: _e.mock.On("ApplyJobRunSchemaDriftReport", _a0, _a1)}
+}
+
+func (_c *MockJobServiceHandler_ApplyJobRunSchemaDriftReport_Call) Run(run func(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.ApplyJobRunSchemaDriftReportRequest])) *MockJobServiceHandler_ApplyJobRunSchemaDriftReport_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*connect.Request[mgmtv1alpha1.ApplyJobRunSchemaDriftReportRequest]))
+	})
+	return _c
+}
+
+func (_c *MockJobServiceHandler_ApplyJobRunSchemaDriftReport_Call) Return(_a0 *connect.Response[mgmtv1alpha1.ApplyJobRunSchemaDriftReportResponse], _a1 error) *MockJobServiceHandler_ApplyJobRunSchemaDriftReport_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockJobServiceHandler_ApplyJobRunSchemaDriftReport_Call) RunAndReturn(run func(context.Context, *connect.Request[mgmtv1alpha1.ApplyJobRunSchemaDriftReportRequest]) (*connect.Response[mgmtv1alpha1.ApplyJobRunSchemaDriftReportResponse], error)) *MockJobServiceHandler_ApplyJobRunSchemaDriftReport_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // CancelJobRun provides a mock function with given fields: _a0, _a1
 func (_m *MockJobServiceHandler) CancelJobRun(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.CancelJobRunRequest]) (*connect.Response[mgmtv1alpha1.CancelJobRunResponse], error) {
 	ret := _m.Called(_a0, _a1)
@@ -780,6 +839,124 @@ func (_c *MockJobServiceHandler_GetJobRunLogsStream_Call) RunAndReturn(run func(
 	return _c
 }
 
+// GetJobRunSchemaDriftReport provides a mock function with given fields: _a0, _a1
+func (_m *MockJobServiceHandler) GetJobRunSchemaDriftReport(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.GetJobRunSchemaDriftReportRequest]) (*connect.Response[mgmtv1alpha1.GetJobRunSchemaDriftReportResponse], error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetJobRunSchemaDriftReport")
+	}
+
+	var r0 *connect.Response[mgmtv1alpha1.GetJobRunSchemaDriftReportResponse]
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.GetJobRunSchemaDriftReportRequest]) (*connect.Response[mgmtv1alpha1.GetJobRunSchemaDriftReportResponse], error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.GetJobRunSchemaDriftReportRequest]) *connect.Response[mgmtv1alpha1.GetJobRunSchemaDriftReportResponse]); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*connect.Response[mgmtv1alpha1.GetJobRunSchemaDriftReportResponse])
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *connect.Request[mgmtv1alpha1.GetJobRunSchemaDriftReportRequest]) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockJobServiceHandler_GetJobRunSchemaDriftReport_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetJobRunSchemaDriftReport'
+type MockJobServiceHandler_GetJobRunSchemaDriftReport_Call struct {
+	*mock.Call
+}
+
+// GetJobRunSchemaDriftReport is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *connect.Request[mgmtv1alpha1.GetJobRunSchemaDriftReportRequest]
+func (_e *MockJobServiceHandler_Expecter) GetJobRunSchemaDriftReport(_a0 interface{}, _a1 interface{}) *MockJobServiceHandler_GetJobRunSchemaDriftReport_Call {
+	return &MockJobServiceHandler_GetJobRunSchemaDriftReport_Call{Call: _e.mock.On("GetJobRunSchemaDriftReport", _a0, _a1)}
+}
+
+func (_c *MockJobServiceHandler_GetJobRunSchemaDriftReport_Call) Run(run func(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.GetJobRunSchemaDriftReportRequest])) *MockJobServiceHandler_GetJobRunSchemaDriftReport_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*connect.Request[mgmtv1alpha1.GetJobRunSchemaDriftReportRequest]))
+	})
+	return _c
+}
+
+func (_c *MockJobServiceHandler_GetJobRunSchemaDriftReport_Call) Return(_a0 *connect.Response[mgmtv1alpha1.GetJobRunSchemaDriftReportResponse], _a1 error) *MockJobServiceHandler_GetJobRunSchemaDriftReport_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockJobServiceHandler_GetJobRunSchemaDriftReport_Call) RunAndReturn(run func(context.Context, *connect.Request[mgmtv1alpha1.GetJobRunSchemaDriftReportRequest]) (*connect.Response[mgmtv1alpha1.GetJobRunSchemaDriftReportResponse], error)) *MockJobServiceHandler_GetJobRunSchemaDriftReport_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetJobRunValidationReport provides a mock function with given fields: _a0, _a1
+func (_m *MockJobServiceHandler) GetJobRunValidationReport(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.GetJobRunValidationReportRequest]) (*connect.Response[mgmtv1alpha1.GetJobRunValidationReportResponse], error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetJobRunValidationReport")
+	}
+
+	var r0 *connect.Response[mgmtv1alpha1.GetJobRunValidationReportResponse]
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.GetJobRunValidationReportRequest]) (*connect.Response[mgmtv1alpha1.GetJobRunValidationReportResponse], error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.GetJobRunValidationReportRequest]) *connect.Response[mgmtv1alpha1.GetJobRunValidationReportResponse]); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*connect.Response[mgmtv1alpha1.GetJobRunValidationReportResponse])
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *connect.Request[mgmtv1alpha1.GetJobRunValidationReportRequest]) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockJobServiceHandler_GetJobRunValidationReport_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetJobRunValidationReport'
+type MockJobServiceHandler_GetJobRunValidationReport_Call struct {
+	*mock.Call
+}
+
+// GetJobRunValidationReport is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *connect.Request[mgmtv1alpha1.GetJobRunValidationReportRequest]
+func (_e *MockJobServiceHandler_Expecter) GetJobRunValidationReport(_a0 interface{}, _a1 interface{}) *MockJobServiceHandler_GetJobRunValidationReport_Call {
+	return &MockJobServiceHandler_GetJobRunValidationReport_Call{Call: _e.mock.On("GetJobRunValidationReport", _a0, _a1)}
+}
+
+func (_c *MockJobServiceHandler_GetJobRunValidationReport_Call) Run(run func(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.GetJobRunValidationReportRequest])) *MockJobServiceHandler_GetJobRunValidationReport_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*connect.Request[mgmtv1alpha1.GetJobRunValidationReportRequest]))
+	})
+	return _c
+}
+
+func (_c *MockJobServiceHandler_GetJobRunValidationReport_Call) Return(_a0 *connect.Response[mgmtv1alpha1.GetJobRunValidationReportResponse], _a1 error) *MockJobServiceHandler_GetJobRunValidationReport_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockJobServiceHandler_GetJobRunValidationReport_Call) RunAndReturn(run func(context.Context, *connect.Request[mgmtv1alpha1.GetJobRunValidationReportRequest]) (*connect.Response[mgmtv1alpha1.GetJobRunValidationReportResponse], error)) *MockJobServiceHandler_GetJobRunValidationReport_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetJobRuns provides a mock function with given fields: _a0, _a1
 func (_m *MockJobServiceHandler) GetJobRuns(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.GetJobRunsRequest]) (*connect.Response[mgmtv1alpha1.GetJobRunsResponse], error) {
 	ret := _m.Called(_a0, _a1)
@@ -957,28 +1134,28 @@ func (_c *MockJobServiceHandler_GetJobStatuses_Call) RunAndReturn(run func(conte
 	return _c
 }
 
-// GetJobs provides a mock function with given fields: _a0, _a1
-func (_m *MockJobServiceHandler) GetJobs(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.GetJobsRequest]) (*connect.Response[mgmtv1alpha1.GetJobsResponse], error) {
+// GetJobVersionDiff provides a mock function with given fields: _a0, _a1
+func (_m *MockJobServiceHandler) GetJobVersionDiff(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.GetJobVersionDiffRequest]) (*connect.Response[mgmtv1alpha1.GetJobVersionDiffResponse], error) {
 	ret := _m.Called(_a0, _a1)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetJobs")
+		panic("no return value specified for GetJobVersionDiff")
 	}
 
-	var r0 *connect.Response[mgmtv1alpha1.GetJobsResponse]
+	var r0 *connect.Response[mgmtv1alpha1.GetJobVersionDiffResponse]
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.GetJobsRequest]) (*connect.Response[mgmtv1alpha1.GetJobsResponse], error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.GetJobVersionDiffRequest]) (*connect.Response[mgmtv1alpha1.GetJobVersionDiffResponse], error)); ok {
 		return rf(_a0, _a1)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.GetJobsRequest]) *connect.Response[mgmtv1alpha1.GetJobsResponse]); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.GetJobVersionDiffRequest]) *connect.Response[mgmtv1alpha1.GetJobVersionDiffResponse]); ok {
 		r0 = rf(_a0, _a1)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*connect.Response[mgmtv1alpha1.GetJobsResponse])
+			r0 = ret.Get(0).(*connect.Response[mgmtv1alpha1.GetJobVersionDiffResponse])
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *connect.Request[mgmtv1alpha1.GetJobsRequest]) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *connect.Request[mgmtv1alpha1.GetJobVersionDiffRequest]) error); ok {
 		r1 = rf(_a0, _a1)
 	} else {
 		r1 = ret.Error(1)
@@ -987,57 +1164,57 @@ func (_m *MockJobServiceHandler) GetJobs(_a0 context.Context, _a1 *connect.Reque
 	return r0, r1
 }
 
-// MockJobServiceHandler_GetJobs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetJobs'
-type MockJobServiceHandler_GetJobs_Call struct {
+// MockJobServiceHandler_GetJobVersionDiff_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetJobVersionDiff'
+type MockJobServiceHandler_GetJobVersionDiff_Call struct {
 	*mock.Call
 }
 
-// GetJobs is a helper method to define mock.On call
+// GetJobVersionDiff is a helper method to define mock.On call
 //   - _a0 context.Context
-//   - _a1 *connect.Request[mgmtv1alpha1.GetJobsRequest]
-func (_e *MockJobServiceHandler_Expecter) GetJobs(_a0 interface{}, _a1 interface{}) *MockJobServiceHandler_GetJobs_Call {
-	return &MockJobServiceHandler_GetJobs_Call{Call: _e.mock.On("GetJobs", _a0, _a1)}
+//   - _a1 *connect.Request[mgmtv1alpha1.GetJobVersionDiffRequest]
+func (_e *MockJobServiceHandler_Expecter) GetJobVersionDiff(_a0 interface{}, _a1 interface{}) *MockJobServiceHandler_GetJobVersionDiff_Call {
+	return &MockJobServiceHandler_GetJobVersionDiff_Call{Call: _e.mock.On("GetJobVersionDiff", _a0, _a1)}
 }
 
-func (_c *MockJobServiceHandler_GetJobs_Call) Run(run func(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.GetJobsRequest])) *MockJobServiceHandler_GetJobs_Call {
+func (_c *MockJobServiceHandler_GetJobVersionDiff_Call) Run(run func(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.GetJobVersionDiffRequest])) *MockJobServiceHandler_GetJobVersionDiff_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(*connect.Request[mgmtv1alpha1.GetJobsRequest]))
+		run(args[0].(context.Context), args[1].(*connect.Request[mgmtv1alpha1.GetJobVersionDiffRequest]))
 	})
 	return _c
 }
 
-func (_c *MockJobServiceHandler_GetJobs_Call) Return(_a0 *connect.Response[mgmtv1alpha1.GetJobsResponse], _a1 error) *MockJobServiceHandler_GetJobs_Call {
+func (_c *MockJobServiceHandler_GetJobVersionDiff_Call) Return(_a0 *connect.Response[mgmtv1alpha1.GetJobVersionDiffResponse], _a1 error) *MockJobServiceHandler_GetJobVersionDiff_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockJobServiceHandler_GetJobs_Call) RunAndReturn(run func(context.Context, *connect.Request[mgmtv1alpha1.GetJobsRequest]) (*connect.Response[mgmtv1alpha1.GetJobsResponse], error)) *MockJobServiceHandler_GetJobs_Call {
+func (_c *MockJobServiceHandler_GetJobVersionDiff_Call) RunAndReturn(run func(context.Context, *connect.Request[mgmtv1alpha1.GetJobVersionDiffRequest]) (*connect.Response[mgmtv1alpha1.GetJobVersionDiffResponse], error)) *MockJobServiceHandler_GetJobVersionDiff_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// IsJobNameAvailable provides a mock function with given fields: _a0, _a1
-func (_m *MockJobServiceHandler) IsJobNameAvailable(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.IsJobNameAvailableRequest]) (*connect.Response[mgmtv1alpha1.IsJobNameAvailableResponse], error) {
+// GetJobVersions provides a mock function with given fields: _a0, _a1
+func (_m *MockJobServiceHandler) GetJobVersions(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.GetJobVersionsRequest]) (*connect.Response[mgmtv1alpha1.GetJobVersionsResponse], error) {
 	ret := _m.Called(_a0, _a1)
 
 	if len(ret) == 0 {
-		panic("no return value specified for IsJobNameAvailable")
+		panic("no return value specified for GetJobVersions")
 	}
 
-	var r0 *connect.Response[mgmtv1alpha1.IsJobNameAvailableResponse]
+	var r0 *connect.Response[mgmtv1alpha1.GetJobVersionsResponse]
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.IsJobNameAvailableRequest]) (*connect.Response[mgmtv1alpha1.IsJobNameAvailableResponse], error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.GetJobVersionsRequest]) (*connect.Response[mgmtv1alpha1.GetJobVersionsResponse], error)); ok {
 		return rf(_a0, _a1)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.IsJobNameAvailableRequest]) *connect.Response[mgmtv1alpha1.IsJobNameAvailableResponse]); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.GetJobVersionsRequest]) *connect.Response[mgmtv1alpha1.GetJobVersionsResponse]); ok {
 		r0 = rf(_a0, _a1)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*connect.Response[mgmtv1alpha1.IsJobNameAvailableResponse])
+			r0 = ret.Get(0).(*connect.Response[mgmtv1alpha1.GetJobVersionsResponse])
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *connect.Request[mgmtv1alpha1.IsJobNameAvailableRequest]) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *connect.Request[mgmtv1alpha1.GetJobVersionsRequest]) error); ok {
 		r1 = rf(_a0, _a1)
 	} else {
 		r1 = ret.Error(1)
@@ -1046,57 +1223,57 @@ func (_m *MockJobServiceHandler) IsJobNameAvailable(_a0 context.Context, _a1 *co
 	return r0, r1
 }
 
-// MockJobServiceHandler_IsJobNameAvailable_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'IsJobNameAvailable'
-type MockJobServiceHandler_IsJobNameAvailable_Call struct {
+// MockJobServiceHandler_GetJobVersions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetJobVersions'
+type MockJobServiceHandler_GetJobVersions_Call struct {
 	*mock.Call
 }
 
-// IsJobNameAvailable is a helper method to define mock.On call
+// GetJobVersions is a helper method to define mock.On call
 //   - _a0 context.Context
-//   - _a1 *connect.Request[mgmtv1alpha1.IsJobNameAvailableRequest]
-func (_e *MockJobServiceHandler_Expecter) IsJobNameAvailable(_a0 interface{}, _a1 interface{}) *MockJobServiceHandler_IsJobNameAvailable_Call {
-	return &MockJobServiceHandler_IsJobNameAvailable_Call{Call: _e.mock.On("IsJobNameAvailable", _a0, _a1)}
+//   - _a1 *connect.Request[mgmtv1alpha1.GetJobVersionsRequest]
+func (_e *MockJobServiceHandler_Expecter) GetJobVersions(_a0 interface{}, _a1 interface{}) *MockJobServiceHandler_GetJobVersions_Call {
+	return &MockJobServiceHandler_GetJobVersions_Call{Call: _e.mock.On("GetJobVersions", _a0, _a1)}
 }
 
-func (_c *MockJobServiceHandler_IsJobNameAvailable_Call) Run(run func(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.IsJobNameAvailableRequest])) *MockJobServiceHandler_IsJobNameAvailable_Call {
+func (_c *MockJobServiceHandler_GetJobVersions_Call) Run(run func(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.GetJobVersionsRequest])) *MockJobServiceHandler_GetJobVersions_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(*connect.Request[mgmtv1alpha1.IsJobNameAvailableRequest]))
+		run(args[0].(context.Context), args[1].(*connect.Request[mgmtv1alpha1.GetJobVersionsRequest]))
 	})
 	return _c
 }
 
-func (_c *MockJobServiceHandler_IsJobNameAvailable_Call) Return(_a0 *connect.Response[mgmtv1alpha1.IsJobNameAvailableResponse], _a1 error) *MockJobServiceHandler_IsJobNameAvailable_Call {
+func (_c *MockJobServiceHandler_GetJobVersions_Call) Return(_a0 *connect.Response[mgmtv1alpha1.GetJobVersionsResponse], _a1 error) *MockJobServiceHandler_GetJobVersions_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockJobServiceHandler_IsJobNameAvailable_Call) RunAndReturn(run func(context.Context, *connect.Request[mgmtv1alpha1.IsJobNameAvailableRequest]) (*connect.Response[mgmtv1alpha1.IsJobNameAvailableResponse], error)) *MockJobServiceHandler_IsJobNameAvailable_Call {
+func (_c *MockJobServiceHandler_GetJobVersions_Call) RunAndReturn(run func(context.Context, *connect.Request[mgmtv1alpha1.GetJobVersionsRequest]) (*connect.Response[mgmtv1alpha1.GetJobVersionsResponse], error)) *MockJobServiceHandler_GetJobVersions_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// PauseJob provides a mock function with given fields: _a0, _a1
-func (_m *MockJobServiceHandler) PauseJob(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.PauseJobRequest]) (*connect.Response[mgmtv1alpha1.PauseJobResponse], error) {
+// GetJobWatermarks provides a mock function with given fields: _a0, _a1
+func (_m *MockJobServiceHandler) GetJobWatermarks(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.GetJobWatermarksRequest]) (*connect.Response[mgmtv1alpha1.GetJobWatermarksResponse], error) {
 	ret := _m.Called(_a0, _a1)
 
 	if len(ret) == 0 {
-		panic("no return value specified for PauseJob")
+		panic("no return value specified for GetJobWatermarks")
 	}
 
-	var r0 *connect.Response[mgmtv1alpha1.PauseJobResponse]
+	var r0 *connect.Response[mgmtv1alpha1.GetJobWatermarksResponse]
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.PauseJobRequest]) (*connect.Response[mgmtv1alpha1.PauseJobResponse], error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.GetJobWatermarksRequest]) (*connect.Response[mgmtv1alpha1.GetJobWatermarksResponse], error)); ok {
 		return rf(_a0, _a1)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.PauseJobRequest]) *connect.Response[mgmtv1alpha1.PauseJobResponse]); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.GetJobWatermarksRequest]) *connect.Response[mgmtv1alpha1.GetJobWatermarksResponse]); ok {
 		r0 = rf(_a0, _a1)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*connect.Response[mgmtv1alpha1.PauseJobResponse])
+			r0 = ret.Get(0).(*connect.Response[mgmtv1alpha1.GetJobWatermarksResponse])
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *connect.Request[mgmtv1alpha1.PauseJobRequest]) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *connect.Request[mgmtv1alpha1.GetJobWatermarksRequest]) error); ok {
 		r1 = rf(_a0, _a1)
 	} else {
 		r1 = ret.Error(1)
@@ -1105,57 +1282,57 @@ func (_m *MockJobServiceHandler) PauseJob(_a0 context.Context, _a1 *connect.Requ
 	return r0, r1
 }
 
-// MockJobServiceHandler_PauseJob_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PauseJob'
-type MockJobServiceHandler_PauseJob_Call struct {
+// MockJobServiceHandler_GetJobWatermarks_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetJobWatermarks'
+type MockJobServiceHandler_GetJobWatermarks_Call struct {
 	*mock.Call
 }
 
-// PauseJob is a helper method to define mock.On call
+// GetJobWatermarks is a helper method to define mock.On call
 //   - _a0 context.Context
-//   - _a1 *connect.Request[mgmtv1alpha1.PauseJobRequest]
-func (_e *MockJobServiceHandler_Expecter) PauseJob(_a0 interface{}, _a1 interface{}) *MockJobServiceHandler_PauseJob_Call {
-	return &MockJobServiceHandler_PauseJob_Call{Call: _e.mock.On("PauseJob", _a0, _a1)}
+//   - _a1 *connect.Request[mgmtv1alpha1.GetJobWatermarksRequest]
+func (_e *MockJobServiceHandler_Expecter) GetJobWatermarks(_a0 interface{}, _a1 interface{}) *MockJobServiceHandler_GetJobWatermarks_Call {
+	return &MockJobServiceHandler_GetJobWatermarks_Call{Call: _e.mock.On("GetJobWatermarks", _a0, _a1)}
 }
 
-func (_c *MockJobServiceHandler_PauseJob_Call) Run(run func(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.PauseJobRequest])) *MockJobServiceHandler_PauseJob_Call {
+func (_c *MockJobServiceHandler_GetJobWatermarks_Call) Run(run func(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.GetJobWatermarksRequest])) *MockJobServiceHandler_GetJobWatermarks_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(*connect.Request[mgmtv1alpha1.PauseJobRequest]))
+		run(args[0].(context.Context), args[1].(*connect.Request[mgmtv1alpha1.GetJobWatermarksRequest]))
 	})
 	return _c
 }
 
-func (_c *MockJobServiceHandler_PauseJob_Call) Return(_a0 *connect.Response[mgmtv1alpha1.PauseJobResponse], _a1 error) *MockJobServiceHandler_PauseJob_Call {
+func (_c *MockJobServiceHandler_GetJobWatermarks_Call) Return(_a0 *connect.Response[mgmtv1alpha1.GetJobWatermarksResponse], _a1 error) *MockJobServiceHandler_GetJobWatermarks_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockJobServiceHandler_PauseJob_Call) RunAndReturn(run func(context.Context, *connect.Request[mgmtv1alpha1.PauseJobRequest]) (*connect.Response[mgmtv1alpha1.PauseJobResponse], error)) *MockJobServiceHandler_PauseJob_Call {
+func (_c *MockJobServiceHandler_GetJobWatermarks_Call) RunAndReturn(run func(context.Context, *connect.Request[mgmtv1alpha1.GetJobWatermarksRequest]) (*connect.Response[mgmtv1alpha1.GetJobWatermarksResponse], error)) *MockJobServiceHandler_GetJobWatermarks_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// SetJobSourceSqlConnectionSubsets provides a mock function with given fields: _a0, _a1
-func (_m *MockJobServiceHandler) SetJobSourceSqlConnectionSubsets(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.SetJobSourceSqlConnectionSubsetsRequest]) (*connect.Response[mgmtv1alpha1.SetJobSourceSqlConnectionSubsetsResponse], error) {
+// GetJobs provides a mock function with given fields: _a0, _a1
+func (_m *MockJobServiceHandler) GetJobs(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.GetJobsRequest]) (*connect.Response[mgmtv1alpha1.GetJobsResponse], error) {
 	ret := _m.Called(_a0, _a1)
 
 	if len(ret) == 0 {
-		panic("no return value specified for SetJobSourceSqlConnectionSubsets")
+		panic("no return value specified for GetJobs")
 	}
 
-	var r0 *connect.Response[mgmtv1alpha1.SetJobSourceSqlConnectionSubsetsResponse]
+	var r0 *connect.Response[mgmtv1alpha1.GetJobsResponse]
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.SetJobSourceSqlConnectionSubsetsRequest]) (*connect.Response[mgmtv1alpha1.SetJobSourceSqlConnectionSubsetsResponse], error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.GetJobsRequest]) (*connect.Response[mgmtv1alpha1.GetJobsResponse], error)); ok {
 		return rf(_a0, _a1)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.SetJobSourceSqlConnectionSubsetsRequest]) *connect.Response[mgmtv1alpha1.SetJobSourceSqlConnectionSubsetsResponse]); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.GetJobsRequest]) *connect.Response[mgmtv1alpha1.GetJobsResponse]); ok {
 		r0 = rf(_a0, _a1)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*connect.Response[mgmtv1alpha1.SetJobSourceSqlConnectionSubsetsResponse])
+			r0 = ret.Get(0).(*connect.Response[mgmtv1alpha1.GetJobsResponse])
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *connect.Request[mgmtv1alpha1.SetJobSourceSqlConnectionSubsetsRequest]) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *connect.Request[mgmtv1alpha1.GetJobsRequest]) error); ok {
 		r1 = rf(_a0, _a1)
 	} else {
 		r1 = ret.Error(1)
@@ -1164,57 +1341,57 @@ func (_m *MockJobServiceHandler) SetJobSourceSqlConnectionSubsets(_a0 context.Co
 	return r0, r1
 }
 
-// MockJobServiceHandler_SetJobSourceSqlConnectionSubsets_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetJobSourceSqlConnectionSubsets'
-type MockJobServiceHandler_SetJobSourceSqlConnectionSubsets_Call struct {
+// MockJobServiceHandler_GetJobs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetJobs'
+type MockJobServiceHandler_GetJobs_Call struct {
 	*mock.Call
 }
 
-// SetJobSourceSqlConnectionSubsets is a helper method to define mock.On call
+// GetJobs is a helper method to define mock.On call
 //   - _a0 context.Context
-//   - _a1 *connect.Request[mgmtv1alpha1.SetJobSourceSqlConnectionSubsetsRequest]
-func (_e *MockJobServiceHandler_Expecter) SetJobSourceSqlConnectionSubsets(_a0 interface{}, _a1 interface{}) *MockJobServiceHandler_SetJobSourceSqlConnectionSubsets_Call {
-	return &MockJobServiceHandler_SetJobSourceSqlConnectionSubsets_Call{Call: _e.mock.On("SetJobSourceSqlConnectionSubsets", _a0, _a1)}
+//   - _a1 *connect.Request[mgmtv1alpha1.GetJobsRequest]
+func (_e *MockJobServiceHandler_Expecter) GetJobs(_a0 interface{}, _a1 interface{}) *MockJobServiceHandler_GetJobs_Call {
+	return &MockJobServiceHandler_GetJobs_Call{Call: _e.mock.On("GetJobs", _a0, _a1)}
 }
 
-func (_c *MockJobServiceHandler_SetJobSourceSqlConnectionSubsets_Call) Run(run func(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.SetJobSourceSqlConnectionSubsetsRequest])) *MockJobServiceHandler_SetJobSourceSqlConnectionSubsets_Call {
+func (_c *MockJobServiceHandler_GetJobs_Call) Run(run func(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.GetJobsRequest])) *MockJobServiceHandler_GetJobs_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(*connect.Request[mgmtv1alpha1.SetJobSourceSqlConnectionSubsetsRequest]))
+		run(args[0].(context.Context), args[1].(*connect.Request[mgmtv1alpha1.GetJobsRequest]))
 	})
 	return _c
 }
 
-func (_c *MockJobServiceHandler_SetJobSourceSqlConnectionSubsets_Call) Return(_a0 *connect.Response[mgmtv1alpha1.SetJobSourceSqlConnectionSubsetsResponse], _a1 error) *MockJobServiceHandler_SetJobSourceSqlConnectionSubsets_Call {
+func (_c *MockJobServiceHandler_GetJobs_Call) Return(_a0 *connect.Response[mgmtv1alpha1.GetJobsResponse], _a1 error) *MockJobServiceHandler_GetJobs_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockJobServiceHandler_SetJobSourceSqlConnectionSubsets_Call) RunAndReturn(run func(context.Context, *connect.Request[mgmtv1alpha1.SetJobSourceSqlConnectionSubsetsRequest]) (*connect.Response[mgmtv1alpha1.SetJobSourceSqlConnectionSubsetsResponse], error)) *MockJobServiceHandler_SetJobSourceSqlConnectionSubsets_Call {
+func (_c *MockJobServiceHandler_GetJobs_Call) RunAndReturn(run func(context.Context, *connect.Request[mgmtv1alpha1.GetJobsRequest]) (*connect.Response[mgmtv1alpha1.GetJobsResponse], error)) *MockJobServiceHandler_GetJobs_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// SetJobSyncOptions provides a mock function with given fields: _a0, _a1
-func (_m *MockJobServiceHandler) SetJobSyncOptions(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.SetJobSyncOptionsRequest]) (*connect.Response[mgmtv1alpha1.SetJobSyncOptionsResponse], error) {
+// IsJobNameAvailable provides a mock function with given fields: _a0, _a1
+func (_m *MockJobServiceHandler) IsJobNameAvailable(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.IsJobNameAvailableRequest]) (*connect.Response[mgmtv1alpha1.IsJobNameAvailableResponse], error) {
 	ret := _m.Called(_a0, _a1)
 
 	if len(ret) == 0 {
-		panic("no return value specified for SetJobSyncOptions")
+		panic("no return value specified for IsJobNameAvailable")
 	}
 
-	var r0 *connect.Response[mgmtv1alpha1.SetJobSyncOptionsResponse]
+	var r0 *connect.Response[mgmtv1alpha1.IsJobNameAvailableResponse]
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.SetJobSyncOptionsRequest]) (*connect.Response[mgmtv1alpha1.SetJobSyncOptionsResponse], error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.IsJobNameAvailableRequest]) (*connect.Response[mgmtv1alpha1.IsJobNameAvailableResponse], error)); ok {
 		return rf(_a0, _a1)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.SetJobSyncOptionsRequest]) *connect.Response[mgmtv1alpha1.SetJobSyncOptionsResponse]); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.IsJobNameAvailableRequest]) *connect.Response[mgmtv1alpha1.IsJobNameAvailableResponse]); ok {
 		r0 = rf(_a0, _a1)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*connect.Response[mgmtv1alpha1.SetJobSyncOptionsResponse])
+			r0 = ret.Get(0).(*connect.Response[mgmtv1alpha1.IsJobNameAvailableResponse])
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *connect.Request[mgmtv1alpha1.SetJobSyncOptionsRequest]) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *connect.Request[mgmtv1alpha1.IsJobNameAvailableRequest]) error); ok {
 		r1 = rf(_a0, _a1)
 	} else {
 		r1 = ret.Error(1)
@@ -1223,44 +1400,634 @@ func (_m *MockJobServiceHandler) SetJobSyncOptions(_a0 context.Context, _a1 *con
 	return r0, r1
 }
 
-// MockJobServiceHandler_SetJobSyncOptions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetJobSyncOptions'
-type MockJobServiceHandler_SetJobSyncOptions_Call struct {
+// MockJobServiceHandler_IsJobNameAvailable_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'IsJobNameAvailable'
+type MockJobServiceHandler_IsJobNameAvailable_Call struct {
 	*mock.Call
 }
 
-// SetJobSyncOptions is a helper method to define mock.On call
+// IsJobNameAvailable is a helper method to define mock.On call
 //   - _a0 context.Context
-//   - _a1 *connect.Request[mgmtv1alpha1.SetJobSyncOptionsRequest]
-func (_e *MockJobServiceHandler_Expecter) SetJobSyncOptions(_a0 interface{}, _a1 interface{}) *MockJobServiceHandler_SetJobSyncOptions_Call {
-	return &MockJobServiceHandler_SetJobSyncOptions_Call{Call: _e.mock.On("SetJobSyncOptions", _a0, _a1)}
+//   - _a1 *connect.Request[mgmtv1alpha1.IsJobNameAvailableRequest]
+func (_e *MockJobServiceHandler_Expecter) IsJobNameAvailable(_a0 interface{}, _a1 interface{}) *MockJobServiceHandler_IsJobNameAvailable_Call {
+	return &MockJobServiceHandler_IsJobNameAvailable_Call{Call: _e.mock.On("IsJobNameAvailable", _a0, _a1)}
 }
 
-func (_c *MockJobServiceHandler_SetJobSyncOptions_Call) Run(run func(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.SetJobSyncOptionsRequest])) *MockJobServiceHandler_SetJobSyncOptions_Call {
+func (_c *MockJobServiceHandler_IsJobNameAvailable_Call) Run(run func(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.IsJobNameAvailableRequest])) *MockJobServiceHandler_IsJobNameAvailable_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(*connect.Request[mgmtv1alpha1.SetJobSyncOptionsRequest]))
+		run(args[0].(context.Context), args[1].(*connect.Request[mgmtv1alpha1.IsJobNameAvailableRequest]))
 	})
 	return _c
 }
 
-func (_c *MockJobServiceHandler_SetJobSyncOptions_Call) Return(_a0 *connect.Response[mgmtv1alpha1.SetJobSyncOptionsResponse], _a1 error) *MockJobServiceHandler_SetJobSyncOptions_Call {
+func (_c *MockJobServiceHandler_IsJobNameAvailable_Call) Return(_a0 *connect.Response[mgmtv1alpha1.IsJobNameAvailableResponse], _a1 error) *MockJobServiceHandler_IsJobNameAvailable_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockJobServiceHandler_SetJobSyncOptions_Call) RunAndReturn(run func(context.Context, *connect.Request[mgmtv1alpha1.SetJobSyncOptionsRequest]) (*connect.Response[mgmtv1alpha1.SetJobSyncOptionsResponse], error)) *MockJobServiceHandler_SetJobSyncOptions_Call {
+func (_c *MockJobServiceHandler_IsJobNameAvailable_Call) RunAndReturn(run func(context.Context, *connect.Request[mgmtv1alpha1.IsJobNameAvailableRequest]) (*connect.Response[mgmtv1alpha1.IsJobNameAvailableResponse], error)) *MockJobServiceHandler_IsJobNameAvailable_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// SetJobWorkflowOptions provides a mock function with given fields: _a0, _a1
-func (_m *MockJobServiceHandler) SetJobWorkflowOptions(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.SetJobWorkflowOptionsRequest]) (*connect.Response[mgmtv1alpha1.SetJobWorkflowOptionsResponse], error) {
+// PauseJob provides a mock function with given fields: _a0, _a1
+func (_m *MockJobServiceHandler) PauseJob(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.PauseJobRequest]) (*connect.Response[mgmtv1alpha1.PauseJobResponse], error) {
 	ret := _m.Called(_a0, _a1)
 
 	if len(ret) == 0 {
-		panic("no return value specified for SetJobWorkflowOptions")
+		panic("no return value specified for PauseJob")
 	}
 
-	var r0 *connect.Response[mgmtv1alpha1.SetJobWorkflowOptionsResponse]
+	var r0 *connect.Response[mgmtv1alpha1.PauseJobResponse]
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.PauseJobRequest]) (*connect.Response[mgmtv1alpha1.PauseJobResponse], error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.PauseJobRequest]) *connect.Response[mgmtv1alpha1.PauseJobResponse]); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*connect.Response[mgmtv1alpha1.PauseJobResponse])
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *connect.Request[mgmtv1alpha1.PauseJobRequest]) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockJobServiceHandler_PauseJob_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PauseJob'
+type MockJobServiceHandler_PauseJob_Call struct {
+	*mock.Call
+}
+
+// PauseJob is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *connect.Request[mgmtv1alpha1.PauseJobRequest]
+func (_e *MockJobServiceHandler_Expecter) PauseJob(_a0 interface{}, _a1 interface{}) *MockJobServiceHandler_PauseJob_Call {
+	return &MockJobServiceHandler_PauseJob_Call{Call: _e.mock.On("PauseJob", _a0, _a1)}
+}
+
+func (_c *MockJobServiceHandler_PauseJob_Call) Run(run func(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.PauseJobRequest])) *MockJobServiceHandler_PauseJob_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*connect.Request[mgmtv1alpha1.PauseJobRequest]))
+	})
+	return _c
+}
+
+func (_c *MockJobServiceHandler_PauseJob_Call) Return(_a0 *connect.Response[mgmtv1alpha1.PauseJobResponse], _a1 error) *MockJobServiceHandler_PauseJob_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockJobServiceHandler_PauseJob_Call) RunAndReturn(run func(context.Context, *connect.Request[mgmtv1alpha1.PauseJobRequest]) (*connect.Response[mgmtv1alpha1.PauseJobResponse], error)) *MockJobServiceHandler_PauseJob_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PreviewJobMappings provides a mock function with given fields: _a0, _a1
+func (_m *MockJobServiceHandler) PreviewJobMappings(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.PreviewJobMappingsRequest]) (*connect.Response[mgmtv1alpha1.PreviewJobMappingsResponse], error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PreviewJobMappings")
+	}
+
+	var r0 *connect.Response[mgmtv1alpha1.PreviewJobMappingsResponse]
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.PreviewJobMappingsRequest]) (*connect.Response[mgmtv1alpha1.PreviewJobMappingsResponse], error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.PreviewJobMappingsRequest]) *connect.Response[mgmtv1alpha1.PreviewJobMappingsResponse]); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*connect.Response[mgmtv1alpha1.PreviewJobMappingsResponse])
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *connect.Request[mgmtv1alpha1.PreviewJobMappingsRequest]) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockJobServiceHandler_PreviewJobMappings_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PreviewJobMappings'
+type MockJobServiceHandler_PreviewJobMappings_Call struct {
+	*mock.Call
+}
+
+// PreviewJobMappings is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *connect.Request[mgmtv1alpha1.PreviewJobMappingsRequest]
+func (_e *MockJobServiceHandler_Expecter) PreviewJobMappings(_a0 interface{}, _a1 interface{}) *MockJobServiceHandler_PreviewJobMappings_Call {
+	return &MockJobServiceHandler_PreviewJobMappings_Call{Call: _e.mock.On("PreviewJobMappings", _a0, _a1)}
+}
+
+func (_c *MockJobServiceHandler_PreviewJobMappings_Call) Run(run func(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.PreviewJobMappingsRequest])) *MockJobServiceHandler_PreviewJobMappings_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*connect.Request[mgmtv1alpha1.PreviewJobMappingsRequest]))
+	})
+	return _c
+}
+
+func (_c *MockJobServiceHandler_PreviewJobMappings_Call) Return(_a0 *connect.Response[mgmtv1alpha1.PreviewJobMappingsResponse], _a1 error) *MockJobServiceHandler_PreviewJobMappings_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockJobServiceHandler_PreviewJobMappings_Call) RunAndReturn(run func(context.Context, *connect.Request[mgmtv1alpha1.PreviewJobMappingsRequest]) (*connect.Response[mgmtv1alpha1.PreviewJobMappingsResponse], error)) *MockJobServiceHandler_PreviewJobMappings_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ResetJobWatermarks provides a mock function with given fields: _a0, _a1
+func (_m *MockJobServiceHandler) ResetJobWatermarks(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.ResetJobWatermarksRequest]) (*connect.Response[mgmtv1alpha1.ResetJobWatermarksResponse], error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ResetJobWatermarks")
+	}
+
+	var r0 *connect.Response[mgmtv1alpha1.ResetJobWatermarksResponse]
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.ResetJobWatermarksRequest]) (*connect.Response[mgmtv1alpha1.ResetJobWatermarksResponse], error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.ResetJobWatermarksRequest]) *connect.Response[mgmtv1alpha1.ResetJobWatermarksResponse]); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*connect.Response[mgmtv1alpha1.ResetJobWatermarksResponse])
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *connect.Request[mgmtv1alpha1.ResetJobWatermarksRequest]) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockJobServiceHandler_ResetJobWatermarks_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ResetJobWatermarks'
+type MockJobServiceHandler_ResetJobWatermarks_Call struct {
+	*mock.Call
+}
+
+// ResetJobWatermarks is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *connect.Request[mgmtv1alpha1.ResetJobWatermarksRequest]
+func (_e *MockJobServiceHandler_Expecter) ResetJobWatermarks(_a0 interface{}, _a1 interface{}) *MockJobServiceHandler_ResetJobWatermarks_Call {
+	return &MockJobServiceHandler_ResetJobWatermarks_Call{Call: _e.mock.On("ResetJobWatermarks", _a0, _a1)}
+}
+
+func (_c *MockJobServiceHandler_ResetJobWatermarks_Call) Run(run func(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.ResetJobWatermarksRequest])) *MockJobServiceHandler_ResetJobWatermarks_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*connect.Request[mgmtv1alpha1.ResetJobWatermarksRequest]))
+	})
+	return _c
+}
+
+func (_c *MockJobServiceHandler_ResetJobWatermarks_Call) Return(_a0 *connect.Response[mgmtv1alpha1.ResetJobWatermarksResponse], _a1 error) *MockJobServiceHandler_ResetJobWatermarks_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockJobServiceHandler_ResetJobWatermarks_Call) RunAndReturn(run func(context.Context, *connect.Request[mgmtv1alpha1.ResetJobWatermarksRequest]) (*connect.Response[mgmtv1alpha1.ResetJobWatermarksResponse], error)) *MockJobServiceHandler_ResetJobWatermarks_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RollbackJobToVersion provides a mock function with given fields: _a0, _a1
+func (_m *MockJobServiceHandler) RollbackJobToVersion(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.RollbackJobToVersionRequest]) (*connect.Response[mgmtv1alpha1.RollbackJobToVersionResponse], error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RollbackJobToVersion")
+	}
+
+	var r0 *connect.Response[mgmtv1alpha1.RollbackJobToVersionResponse]
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.RollbackJobToVersionRequest]) (*connect.Response[mgmtv1alpha1.RollbackJobToVersionResponse], error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.RollbackJobToVersionRequest]) *connect.Response[mgmtv1alpha1.RollbackJobToVersionResponse]); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*connect.Response[mgmtv1alpha1.RollbackJobToVersionResponse])
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *connect.Request[mgmtv1alpha1.RollbackJobToVersionRequest]) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockJobServiceHandler_RollbackJobToVersion_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RollbackJobToVersion'
+type MockJobServiceHandler_RollbackJobToVersion_Call struct {
+	*mock.Call
+}
+
+// RollbackJobToVersion is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *connect.Request[mgmtv1alpha1.RollbackJobToVersionRequest]
+func (_e *MockJobServiceHandler_Expecter) RollbackJobToVersion(_a0 interface{}, _a1 interface{}) *MockJobServiceHandler_RollbackJobToVersion_Call {
+	return &MockJobServiceHandler_RollbackJobToVersion_Call{Call: _e.mock.On("RollbackJobToVersion", _a0, _a1)}
+}
+
+func (_c *MockJobServiceHandler_RollbackJobToVersion_Call) Run(run func(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.RollbackJobToVersionRequest])) *MockJobServiceHandler_RollbackJobToVersion_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*connect.Request[mgmtv1alpha1.RollbackJobToVersionRequest]))
+	})
+	return _c
+}
+
+func (_c *MockJobServiceHandler_RollbackJobToVersion_Call) Return(_a0 *connect.Response[mgmtv1alpha1.RollbackJobToVersionResponse], _a1 error) *MockJobServiceHandler_RollbackJobToVersion_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockJobServiceHandler_RollbackJobToVersion_Call) RunAndReturn(run func(context.Context, *connect.Request[mgmtv1alpha1.RollbackJobToVersionRequest]) (*connect.Response[mgmtv1alpha1.RollbackJobToVersionResponse], error)) *MockJobServiceHandler_RollbackJobToVersion_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetJobRunSchemaDriftReport provides a mock function with given fields: _a0, _a1
+func (_m *MockJobServiceHandler) SetJobRunSchemaDriftReport(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.SetJobRunSchemaDriftReportRequest]) (*connect.Response[mgmtv1alpha1.SetJobRunSchemaDriftReportResponse], error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetJobRunSchemaDriftReport")
+	}
+
+	var r0 *connect.Response[mgmtv1alpha1.SetJobRunSchemaDriftReportResponse]
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.SetJobRunSchemaDriftReportRequest]) (*connect.Response[mgmtv1alpha1.SetJobRunSchemaDriftReportResponse], error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.SetJobRunSchemaDriftReportRequest]) *connect.Response[mgmtv1alpha1.SetJobRunSchemaDriftReportResponse]); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*connect.Response[mgmtv1alpha1.SetJobRunSchemaDriftReportResponse])
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *connect.Request[mgmtv1alpha1.SetJobRunSchemaDriftReportRequest]) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockJobServiceHandler_SetJobRunSchemaDriftReport_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetJobRunSchemaDriftReport'
+type MockJobServiceHandler_SetJobRunSchemaDriftReport_Call struct {
+	*mock.Call
+}
+
+// SetJobRunSchemaDriftReport is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *connect.Request[mgmtv1alpha1.SetJobRunSchemaDriftReportRequest]
+func (_e *MockJobServiceHandler_Expecter) SetJobRunSchemaDriftReport(_a0 interface{}, _a1 interface{}) *MockJobServiceHandler_SetJobRunSchemaDriftReport_Call {
+	return &MockJobServiceHandler_SetJobRunSchemaDriftReport_Call{Call: _e.mock.On("SetJobRunSchemaDriftReport", _a0, _a1)}
+}
+
+func (_c *MockJobServiceHandler_SetJobRunSchemaDriftReport_Call) Run(run func(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.SetJobRunSchemaDriftReportRequest])) *MockJobServiceHandler_SetJobRunSchemaDriftReport_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*connect.Request[mgmtv1alpha1.SetJobRunSchemaDriftReportRequest]))
+	})
+	return _c
+}
+
+func (_c *MockJobServiceHandler_SetJobRunSchemaDriftReport_Call) Return(_a0 *connect.Response[mgmtv1alpha1.SetJobRunSchemaDriftReportResponse], _a1 error) *MockJobServiceHandler_SetJobRunSchemaDriftReport_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockJobServiceHandler_SetJobRunSchemaDriftReport_Call) RunAndReturn(run func(context.Context, *connect.Request[mgmtv1alpha1.SetJobRunSchemaDriftReportRequest]) (*connect.Response[mgmtv1alpha1.SetJobRunSchemaDriftReportResponse], error)) *MockJobServiceHandler_SetJobRunSchemaDriftReport_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetJobRunValidationReport provides a mock function with given fields: _a0, _a1
+func (_m *MockJobServiceHandler) SetJobRunValidationReport(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.SetJobRunValidationReportRequest]) (*connect.Response[mgmtv1alpha1.SetJobRunValidationReportResponse], error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetJobRunValidationReport")
+	}
+
+	var r0 *connect.Response[mgmtv1alpha1.SetJobRunValidationReportResponse]
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.SetJobRunValidationReportRequest]) (*connect.Response[mgmtv1alpha1.SetJobRunValidationReportResponse], error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.SetJobRunValidationReportRequest]) *connect.Response[mgmtv1alpha1.SetJobRunValidationReportResponse]); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*connect.Response[mgmtv1alpha1.SetJobRunValidationReportResponse])
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *connect.Request[mgmtv1alpha1.SetJobRunValidationReportRequest]) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockJobServiceHandler_SetJobRunValidationReport_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetJobRunValidationReport'
+type MockJobServiceHandler_SetJobRunValidationReport_Call struct {
+	*mock.Call
+}
+
+// SetJobRunValidationReport is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *connect.Request[mgmtv1alpha1.SetJobRunValidationReportRequest]
+func (_e *MockJobServiceHandler_Expecter) SetJobRunValidationReport(_a0 interface{}, _a1 interface{}) *MockJobServiceHandler_SetJobRunValidationReport_Call {
+	return &MockJobServiceHandler_SetJobRunValidationReport_Call{Call: _e.mock.On("SetJobRunValidationReport", _a0, _a1)}
+}
+
+func (_c *MockJobServiceHandler_SetJobRunValidationReport_Call) Run(run func(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.SetJobRunValidationReportRequest])) *MockJobServiceHandler_SetJobRunValidationReport_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*connect.Request[mgmtv1alpha1.SetJobRunValidationReportRequest]))
+	})
+	return _c
+}
+
+func (_c *MockJobServiceHandler_SetJobRunValidationReport_Call) Return(_a0 *connect.Response[mgmtv1alpha1.SetJobRunValidationReportResponse], _a1 error) *MockJobServiceHandler_SetJobRunValidationReport_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockJobServiceHandler_SetJobRunValidationReport_Call) RunAndReturn(run func(context.Context, *connect.Request[mgmtv1alpha1.SetJobRunValidationReportRequest]) (*connect.Response[mgmtv1alpha1.SetJobRunValidationReportResponse], error)) *MockJobServiceHandler_SetJobRunValidationReport_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetJobRunWatermarks provides a mock function with given fields: _a0, _a1
+func (_m *MockJobServiceHandler) SetJobRunWatermarks(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.SetJobRunWatermarksRequest]) (*connect.Response[mgmtv1alpha1.SetJobRunWatermarksResponse], error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetJobRunWatermarks")
+	}
+
+	var r0 *connect.Response[mgmtv1alpha1.SetJobRunWatermarksResponse]
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.SetJobRunWatermarksRequest]) (*connect.Response[mgmtv1alpha1.SetJobRunWatermarksResponse], error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.SetJobRunWatermarksRequest]) *connect.Response[mgmtv1alpha1.SetJobRunWatermarksResponse]); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*connect.Response[mgmtv1alpha1.SetJobRunWatermarksResponse])
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *connect.Request[mgmtv1alpha1.SetJobRunWatermarksRequest]) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockJobServiceHandler_SetJobRunWatermarks_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetJobRunWatermarks'
+type MockJobServiceHandler_SetJobRunWatermarks_Call struct {
+	*mock.Call
+}
+
+// SetJobRunWatermarks is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *connect.Request[mgmtv1alpha1.SetJobRunWatermarksRequest]
+func (_e *MockJobServiceHandler_Expecter) SetJobRunWatermarks(_a0 interface{}, _a1 interface{}) *MockJobServiceHandler_SetJobRunWatermarks_Call {
+	return &MockJobServiceHandler_SetJobRunWatermarks_Call{Call: _e.mock.On("SetJobRunWatermarks", _a0, _a1)}
+}
+
+func (_c *MockJobServiceHandler_SetJobRunWatermarks_Call) Run(run func(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.SetJobRunWatermarksRequest])) *MockJobServiceHandler_SetJobRunWatermarks_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*connect.Request[mgmtv1alpha1.SetJobRunWatermarksRequest]))
+	})
+	return _c
+}
+
+func (_c *MockJobServiceHandler_SetJobRunWatermarks_Call) Return(_a0 *connect.Response[mgmtv1alpha1.SetJobRunWatermarksResponse], _a1 error) *MockJobServiceHandler_SetJobRunWatermarks_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockJobServiceHandler_SetJobRunWatermarks_Call) RunAndReturn(run func(context.Context, *connect.Request[mgmtv1alpha1.SetJobRunWatermarksRequest]) (*connect.Response[mgmtv1alpha1.SetJobRunWatermarksResponse], error)) *MockJobServiceHandler_SetJobRunWatermarks_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetJobSourceSqlConnectionSubsets provides a mock function with given fields: _a0, _a1
+func (_m *MockJobServiceHandler) SetJobSourceSqlConnectionSubsets(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.SetJobSourceSqlConnectionSubsetsRequest]) (*connect.Response[mgmtv1alpha1.SetJobSourceSqlConnectionSubsetsResponse], error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetJobSourceSqlConnectionSubsets")
+	}
+
+	var r0 *connect.Response[mgmtv1alpha1.SetJobSourceSqlConnectionSubsetsResponse]
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.SetJobSourceSqlConnectionSubsetsRequest]) (*connect.Response[mgmtv1alpha1.SetJobSourceSqlConnectionSubsetsResponse], error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.SetJobSourceSqlConnectionSubsetsRequest]) *connect.Response[mgmtv1alpha1.SetJobSourceSqlConnectionSubsetsResponse]); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*connect.Response[mgmtv1alpha1.SetJobSourceSqlConnectionSubsetsResponse])
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *connect.Request[mgmtv1alpha1.SetJobSourceSqlConnectionSubsetsRequest]) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockJobServiceHandler_SetJobSourceSqlConnectionSubsets_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetJobSourceSqlConnectionSubsets'
+type MockJobServiceHandler_SetJobSourceSqlConnectionSubsets_Call struct {
+	*mock.Call
+}
+
+// SetJobSourceSqlConnectionSubsets is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *connect.Request[mgmtv1alpha1.SetJobSourceSqlConnectionSubsetsRequest]
+func (_e *MockJobServiceHandler_Expecter) SetJobSourceSqlConnectionSubsets(_a0 interface{}, _a1 interface{}) *MockJobServiceHandler_SetJobSourceSqlConnectionSubsets_Call {
+	return &MockJobServiceHandler_SetJobSourceSqlConnectionSubsets_Call{Call: _e.mock.On("SetJobSourceSqlConnectionSubsets", _a0, _a1)}
+}
+
+func (_c *MockJobServiceHandler_SetJobSourceSqlConnectionSubsets_Call) Run(run func(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.SetJobSourceSqlConnectionSubsetsRequest])) *MockJobServiceHandler_SetJobSourceSqlConnectionSubsets_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*connect.Request[mgmtv1alpha1.SetJobSourceSqlConnectionSubsetsRequest]))
+	})
+	return _c
+}
+
+func (_c *MockJobServiceHandler_SetJobSourceSqlConnectionSubsets_Call) Return(_a0 *connect.Response[mgmtv1alpha1.SetJobSourceSqlConnectionSubsetsResponse], _a1 error) *MockJobServiceHandler_SetJobSourceSqlConnectionSubsets_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockJobServiceHandler_SetJobSourceSqlConnectionSubsets_Call) RunAndReturn(run func(context.Context, *connect.Request[mgmtv1alpha1.SetJobSourceSqlConnectionSubsetsRequest]) (*connect.Response[mgmtv1alpha1.SetJobSourceSqlConnectionSubsetsResponse], error)) *MockJobServiceHandler_SetJobSourceSqlConnectionSubsets_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetJobSyncOptions provides a mock function with given fields: _a0, _a1
+func (_m *MockJobServiceHandler) SetJobSyncOptions(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.SetJobSyncOptionsRequest]) (*connect.Response[mgmtv1alpha1.SetJobSyncOptionsResponse], error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetJobSyncOptions")
+	}
+
+	var r0 *connect.Response[mgmtv1alpha1.SetJobSyncOptionsResponse]
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.SetJobSyncOptionsRequest]) (*connect.Response[mgmtv1alpha1.SetJobSyncOptionsResponse], error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.SetJobSyncOptionsRequest]) *connect.Response[mgmtv1alpha1.SetJobSyncOptionsResponse]); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*connect.Response[mgmtv1alpha1.SetJobSyncOptionsResponse])
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *connect.Request[mgmtv1alpha1.SetJobSyncOptionsRequest]) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockJobServiceHandler_SetJobSyncOptions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetJobSyncOptions'
+type MockJobServiceHandler_SetJobSyncOptions_Call struct {
+	*mock.Call
+}
+
+// SetJobSyncOptions is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *connect.Request[mgmtv1alpha1.SetJobSyncOptionsRequest]
+func (_e *MockJobServiceHandler_Expecter) SetJobSyncOptions(_a0 interface{}, _a1 interface{}) *MockJobServiceHandler_SetJobSyncOptions_Call {
+	return &MockJobServiceHandler_SetJobSyncOptions_Call{Call: _e.mock.On("SetJobSyncOptions", _a0, _a1)}
+}
+
+func (_c *MockJobServiceHandler_SetJobSyncOptions_Call) Run(run func(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.SetJobSyncOptionsRequest])) *MockJobServiceHandler_SetJobSyncOptions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*connect.Request[mgmtv1alpha1.SetJobSyncOptionsRequest]))
+	})
+	return _c
+}
+
+func (_c *MockJobServiceHandler_SetJobSyncOptions_Call) Return(_a0 *connect.Response[mgmtv1alpha1.SetJobSyncOptionsResponse], _a1 error) *MockJobServiceHandler_SetJobSyncOptions_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockJobServiceHandler_SetJobSyncOptions_Call) RunAndReturn(run func(context.Context, *connect.Request[mgmtv1alpha1.SetJobSyncOptionsRequest]) (*connect.Response[mgmtv1alpha1.SetJobSyncOptionsResponse], error)) *MockJobServiceHandler_SetJobSyncOptions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetJobTriggers provides a mock function with given fields: _a0, _a1
+func (_m *MockJobServiceHandler) SetJobTriggers(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.SetJobTriggersRequest]) (*connect.Response[mgmtv1alpha1.SetJobTriggersResponse], error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetJobTriggers")
+	}
+
+	var r0 *connect.Response[mgmtv1alpha1.SetJobTriggersResponse]
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.SetJobTriggersRequest]) (*connect.Response[mgmtv1alpha1.SetJobTriggersResponse], error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.SetJobTriggersRequest]) *connect.Response[mgmtv1alpha1.SetJobTriggersResponse]); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*connect.Response[mgmtv1alpha1.SetJobTriggersResponse])
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *connect.Request[mgmtv1alpha1.SetJobTriggersRequest]) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockJobServiceHandler_SetJobTriggers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetJobTriggers'
+type MockJobServiceHandler_SetJobTriggers_Call struct {
+	*mock.Call
+}
+
+// SetJobTriggers is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *connect.Request[mgmtv1alpha1.SetJobTriggersRequest]
+func (_e *MockJobServiceHandler_Expecter) SetJobTriggers(_a0 interface{}, _a1 interface{}) *MockJobServiceHandler_SetJobTriggers_Call {
+	return &MockJobServiceHandler_SetJobTriggers_Call{Call: _e.mock.On("SetJobTriggers", _a0, _a1)}
+}
+
+func (_c *MockJobServiceHandler_SetJobTriggers_Call) Run(run func(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.SetJobTriggersRequest])) *MockJobServiceHandler_SetJobTriggers_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*connect.Request[mgmtv1alpha1.SetJobTriggersRequest]))
+	})
+	return _c
+}
+
+func (_c *MockJobServiceHandler_SetJobTriggers_Call) Return(_a0 *connect.Response[mgmtv1alpha1.SetJobTriggersResponse], _a1 error) *MockJobServiceHandler_SetJobTriggers_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockJobServiceHandler_SetJobTriggers_Call) RunAndReturn(run func(context.Context, *connect.Request[mgmtv1alpha1.SetJobTriggersRequest]) (*connect.Response[mgmtv1alpha1.SetJobTriggersResponse], error)) *MockJobServiceHandler_SetJobTriggers_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetJobWorkflowOptions provides a mock function with given fields: _a0, _a1
+func (_m *MockJobServiceHandler) SetJobWorkflowOptions(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.SetJobWorkflowOptionsRequest]) (*connect.Response[mgmtv1alpha1.SetJobWorkflowOptionsResponse], error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetJobWorkflowOptions")
+	}
+
+	var r0 *connect.Response[mgmtv1alpha1.SetJobWorkflowOptionsResponse]
 	var r1 error
 	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.SetJobWorkflowOptionsRequest]) (*connect.Response[mgmtv1alpha1.SetJobWorkflowOptionsResponse], error)); ok {
 		return rf(_a0, _a1)
@@ -1370,6 +2137,65 @@ func (_c *MockJobServiceHandler_TerminateJobRun_Call) RunAndReturn(run func(cont
 	return _c
 }
 
+// TriggerDependentJobs provides a mock function with given fields: _a0, _a1
+func (_m *MockJobServiceHandler) TriggerDependentJobs(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.TriggerDependentJobsRequest]) (*connect.Response[mgmtv1alpha1.TriggerDependentJobsResponse], error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for TriggerDependentJobs")
+	}
+
+	var r0 *connect.Response[mgmtv1alpha1.TriggerDependentJobsResponse]
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.TriggerDependentJobsRequest]) (*connect.Response[mgmtv1alpha1.TriggerDependentJobsResponse], error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.TriggerDependentJobsRequest]) *connect.Response[mgmtv1alpha1.TriggerDependentJobsResponse]); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*connect.Response[mgmtv1alpha1.TriggerDependentJobsResponse])
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *connect.Request[mgmtv1alpha1.TriggerDependentJobsRequest]) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockJobServiceHandler_TriggerDependentJobs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'TriggerDependentJobs'
+type MockJobServiceHandler_TriggerDependentJobs_Call struct {
+	*mock.Call
+}
+
+// TriggerDependentJobs is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *connect.Request[mgmtv1alpha1.TriggerDependentJobsRequest]
+func (_e *MockJobServiceHandler_Expecter) TriggerDependentJobs(_a0 interface{}, _a1 interface{}) *MockJobServiceHandler_TriggerDependentJobs_Call {
+	return &MockJobServiceHandler_TriggerDependentJobs_Call{Call: _e.mock.On("TriggerDependentJobs", _a0, _a1)}
+}
+
+func (_c *MockJobServiceHandler_TriggerDependentJobs_Call) Run(run func(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.TriggerDependentJobsRequest])) *MockJobServiceHandler_TriggerDependentJobs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*connect.Request[mgmtv1alpha1.TriggerDependentJobsRequest]))
+	})
+	return _c
+}
+
+func (_c *MockJobServiceHandler_TriggerDependentJobs_Call) Return(_a0 *connect.Response[mgmtv1alpha1.TriggerDependentJobsResponse], _a1 error) *MockJobServiceHandler_TriggerDependentJobs_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockJobServiceHandler_TriggerDependentJobs_Call) RunAndReturn(run func(context.Context, *connect.Request[mgmtv1alpha1.TriggerDependentJobsRequest]) (*connect.Response[mgmtv1alpha1.TriggerDependentJobsResponse], error)) *MockJobServiceHandler_TriggerDependentJobs_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // UpdateJobDestinationConnection provides a mock function with given fields: _a0, _a1
 func (_m *MockJobServiceHandler) UpdateJobDestinationConnection(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.UpdateJobDestinationConnectionRequest]) (*connect.Response[mgmtv1alpha1.UpdateJobDestinationConnectionResponse], error) {
 	ret := _m.Called(_a0, _a1)
@@ -83,6 +83,65 @@ func (_c *MockTransformersServiceClient_CreateUserDefinedTransformer_Call) RunAn
 	return _c
 }
 
+// DecryptFormatPreserving provides a mock function with given fields: _a0, _a1
+func (_m *MockTransformersServiceClient) DecryptFormatPreserving(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.DecryptFormatPreservingRequest]) (*connect.Response[mgmtv1alpha1.DecryptFormatPreservingResponse], error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DecryptFormatPreserving")
+	}
+
+	var r0 *connect.Response[mgmtv1alpha1.DecryptFormatPreservingResponse]
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.DecryptFormatPreservingRequest]) (*connect.Response[mgmtv1alpha1.DecryptFormatPreservingResponse], error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.DecryptFormatPreservingRequest]) *connect.Response[mgmtv1alpha1.DecryptFormatPreservingResponse]); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*connect.Response[mgmtv1alpha1.DecryptFormatPreservingResponse])
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *connect.Request[mgmtv1alpha1.DecryptFormatPreservingRequest]) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockTransformersServiceClient_DecryptFormatPreserving_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DecryptFormatPreserving'
+type MockTransformersServiceClient_DecryptFormatPreserving_Call struct {
+	*mock.Call
+}
+
+// DecryptFormatPreserving is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *connect.Request[mgmtv1alpha1.DecryptFormatPreservingRequest]
+func (_e *MockTransformersServiceClient_Expecter) DecryptFormatPreserving(_a0 interface{}, _a1 interface{}) *MockTransformersServiceClient_DecryptFormatPreserving_Call {
+	return &MockTransformersServiceClient_DecryptFormatPreserving_Call{Call: _e.mock.On("DecryptFormatPreserving", _a0, _a1)}
+}
+
+func (_c *MockTransformersServiceClient_DecryptFormatPreserving_Call) Run(run func(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.DecryptFormatPreservingRequest])) *MockTransformersServiceClient_DecryptFormatPreserving_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*connect.Request[mgmtv1alpha1.DecryptFormatPreservingRequest]))
+	})
+	return _c
+}
+
+func (_c *MockTransformersServiceClient_DecryptFormatPreserving_Call) Return(_a0 *connect.Response[mgmtv1alpha1.DecryptFormatPreservingResponse], _a1 error) *MockTransformersServiceClient_DecryptFormatPreserving_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockTransformersServiceClient_DecryptFormatPreserving_Call) RunAndReturn(run func(context.Context, *connect.Request[mgmtv1alpha1.DecryptFormatPreservingRequest]) (*connect.Response[mgmtv1alpha1.DecryptFormatPreservingResponse], error)) *MockTransformersServiceClient_DecryptFormatPreserving_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // DeleteUserDefinedTransformer provides a mock function with given fields: _a0, _a1
 func (_m *MockTransformersServiceClient) DeleteUserDefinedTransformer(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.DeleteUserDefinedTransformerRequest]) (*connect.Response[mgmtv1alpha1.DeleteUserDefinedTransformerResponse], error) {
 	ret := _m.Called(_a0, _a1)
@@ -496,6 +555,65 @@ func (_c *MockTransformersServiceClient_UpdateUserDefinedTransformer_Call) RunAn
 	return _c
 }
 
+// ValidateTransformJsonConfig provides a mock function with given fields: _a0, _a1
+func (_m *MockTransformersServiceClient) ValidateTransformJsonConfig(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.ValidateTransformJsonConfigRequest]) (*connect.Response[mgmtv1alpha1.ValidateTransformJsonConfigResponse], error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ValidateTransformJsonConfig")
+	}
+
+	var r0 *connect.Response[mgmtv1alpha1.ValidateTransformJsonConfigResponse]
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.ValidateTransformJsonConfigRequest]) (*connect.Response[mgmtv1alpha1.ValidateTransformJsonConfigResponse], error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.ValidateTransformJsonConfigRequest]) *connect.Response[mgmtv1alpha1.ValidateTransformJsonConfigResponse]); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*connect.Response[mgmtv1alpha1.ValidateTransformJsonConfigResponse])
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *connect.Request[mgmtv1alpha1.ValidateTransformJsonConfigRequest]) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockTransformersServiceClient_ValidateTransformJsonConfig_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ValidateTransformJsonConfig'
+type MockTransformersServiceClient_ValidateTransformJsonConfig_Call struct {
+	*mock.Call
+}
+
+// ValidateTransformJsonConfig is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *connect.Request[mgmtv1alpha1.ValidateTransformJsonConfigRequest]
+func (_e *MockTransformersServiceClient_Expecter) ValidateTransformJsonConfig(_a0 interface{}, _a1 interface{}) *MockTransformersServiceClient_ValidateTransformJsonConfig_Call {
+	return &MockTransformersServiceClient_ValidateTransformJsonConfig_Call{Call: _e.mock.On("ValidateTransformJsonConfig", _a0, _a1)}
+}
+
+func (_c *MockTransformersServiceClient_ValidateTransformJsonConfig_Call) Run(run func(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.ValidateTransformJsonConfigRequest])) *MockTransformersServiceClient_ValidateTransformJsonConfig_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*connect.Request[mgmtv1alpha1.ValidateTransformJsonConfigRequest]))
+	})
+	return _c
+}
+
+func (_c *MockTransformersServiceClient_ValidateTransformJsonConfig_Call) Return(_a0 *connect.Response[mgmtv1alpha1.ValidateTransformJsonConfigResponse], _a1 error) *MockTransformersServiceClient_ValidateTransformJsonConfig_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockTransformersServiceClient_ValidateTransformJsonConfig_Call) RunAndReturn(run func(context.Context, *connect.Request[mgmtv1alpha1.ValidateTransformJsonConfigRequest]) (*connect.Response[mgmtv1alpha1.ValidateTransformJsonConfigResponse], error)) *MockTransformersServiceClient_ValidateTransformJsonConfig_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ValidateUserJavascriptCode provides a mock function with given fields: _a0, _a1
 func (_m *MockTransformersServiceClient) ValidateUserJavascriptCode(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.ValidateUserJavascriptCodeRequest]) (*connect.Response[mgmtv1alpha1.ValidateUserJavascriptCodeResponse], error) {
 	ret := _m.Called(_a0, _a1)
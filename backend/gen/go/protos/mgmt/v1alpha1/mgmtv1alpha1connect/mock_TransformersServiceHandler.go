@@ -0,0 +1,747 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mgmtv1alpha1connect
+
+import (
+	context "context"
+
+	connect "connectrpc.com/connect"
+
+	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockTransformersServiceHandler is an autogenerated mock type for the TransformersServiceHandler type
+type MockTransformersServiceHandler struct {
+	mock.Mock
+}
+
+type MockTransformersServiceHandler_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockTransformersServiceHandler) EXPECT() *MockTransformersServiceHandler_Expecter {
+	return &MockTransformersServiceHandler_Expecter{mock: &_m.Mock}
+}
+
+// CreateUserDefinedTransformer provides a mock function with given fields: _a0, _a1
+func (_m *MockTransformersServiceHandler) CreateUserDefinedTransformer(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.CreateUserDefinedTransformerRequest]) (*connect.Response[mgmtv1alpha1.CreateUserDefinedTransformerResponse], error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateUserDefinedTransformer")
+	}
+
+	var r0 *connect.Response[mgmtv1alpha1.CreateUserDefinedTransformerResponse]
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.CreateUserDefinedTransformerRequest]) (*connect.Response[mgmtv1alpha1.CreateUserDefinedTransformerResponse], error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.CreateUserDefinedTransformerRequest]) *connect.Response[mgmtv1alpha1.CreateUserDefinedTransformerResponse]); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*connect.Response[mgmtv1alpha1.CreateUserDefinedTransformerResponse])
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *connect.Request[mgmtv1alpha1.CreateUserDefinedTransformerRequest]) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockTransformersServiceHandler_CreateUserDefinedTransformer_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateUserDefinedTransformer'
+type MockTransformersServiceHandler_CreateUserDefinedTransformer_Call struct {
+	*mock.Call
+}
+
+// CreateUserDefinedTransformer is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *connect.Request[mgmtv1alpha1.CreateUserDefinedTransformerRequest]
+func (_e *MockTransformersServiceHandler_Expecter) CreateUserDefinedTransformer(_a0 interface{}, _a1 interface{}) *MockTransformersServiceHandler_CreateUserDefinedTransformer_Call {
+	return &MockTransformersServiceHandler_CreateUserDefinedTransformer_Call{Call: _e.mock.On("CreateUserDefinedTransformer", _a0, _a1)}
+}
+
+func (_c *MockTransformersServiceHandler_CreateUserDefinedTransformer_Call) Run(run func(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.CreateUserDefinedTransformerRequest])) *MockTransformersServiceHandler_CreateUserDefinedTransformer_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*connect.Request[mgmtv1alpha1.CreateUserDefinedTransformerRequest]))
+	})
+	return _c
+}
+
+func (_c *MockTransformersServiceHandler_CreateUserDefinedTransformer_Call) Return(_a0 *connect.Response[mgmtv1alpha1.CreateUserDefinedTransformerResponse], _a1 error) *MockTransformersServiceHandler_CreateUserDefinedTransformer_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockTransformersServiceHandler_CreateUserDefinedTransformer_Call) RunAndReturn(run func(context.Context, *connect.Request[mgmtv1alpha1.CreateUserDefinedTransformerRequest]) (*connect.Response[mgmtv1alpha1.CreateUserDefinedTransformerResponse], error)) *MockTransformersServiceHandler_CreateUserDefinedTransformer_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DecryptFormatPreserving provides a mock function with given fields: _a0, _a1
+func (_m *MockTransformersServiceHandler) DecryptFormatPreserving(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.DecryptFormatPreservingRequest]) (*connect.Response[mgmtv1alpha1.DecryptFormatPreservingResponse], error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DecryptFormatPreserving")
+	}
+
+	var r0 *connect.Response[mgmtv1alpha1.DecryptFormatPreservingResponse]
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.DecryptFormatPreservingRequest]) (*connect.Response[mgmtv1alpha1.DecryptFormatPreservingResponse], error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.DecryptFormatPreservingRequest]) *connect.Response[mgmtv1alpha1.DecryptFormatPreservingResponse]); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*connect.Response[mgmtv1alpha1.DecryptFormatPreservingResponse])
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *connect.Request[mgmtv1alpha1.DecryptFormatPreservingRequest]) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockTransformersServiceHandler_DecryptFormatPreserving_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DecryptFormatPreserving'
+type MockTransformersServiceHandler_DecryptFormatPreserving_Call struct {
+	*mock.Call
+}
+
+// DecryptFormatPreserving is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *connect.Request[mgmtv1alpha1.DecryptFormatPreservingRequest]
+func (_e *MockTransformersServiceHandler_Expecter) DecryptFormatPreserving(_a0 interface{}, _a1 interface{}) *MockTransformersServiceHandler_DecryptFormatPreserving_Call {
+	return &MockTransformersServiceHandler_DecryptFormatPreserving_Call{Call: _e.mock.On("DecryptFormatPreserving", _a0, _a1)}
+}
+
+func (_c *MockTransformersServiceHandler_DecryptFormatPreserving_Call) Run(run func(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.DecryptFormatPreservingRequest])) *MockTransformersServiceHandler_DecryptFormatPreserving_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*connect.Request[mgmtv1alpha1.DecryptFormatPreservingRequest]))
+	})
+	return _c
+}
+
+func (_c *MockTransformersServiceHandler_DecryptFormatPreserving_Call) Return(_a0 *connect.Response[mgmtv1alpha1.DecryptFormatPreservingResponse], _a1 error) *MockTransformersServiceHandler_DecryptFormatPreserving_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockTransformersServiceHandler_DecryptFormatPreserving_Call) RunAndReturn(run func(context.Context, *connect.Request[mgmtv1alpha1.DecryptFormatPreservingRequest]) (*connect.Response[mgmtv1alpha1.DecryptFormatPreservingResponse], error)) *MockTransformersServiceHandler_DecryptFormatPreserving_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteUserDefinedTransformer provides a mock function with given fields: _a0, _a1
+func (_m *MockTransformersServiceHandler) DeleteUserDefinedTransformer(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.DeleteUserDefinedTransformerRequest]) (*connect.Response[mgmtv1alpha1.DeleteUserDefinedTransformerResponse], error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteUserDefinedTransformer")
+	}
+
+	var r0 *connect.Response[mgmtv1alpha1.DeleteUserDefinedTransformerResponse]
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.DeleteUserDefinedTransformerRequest]) (*connect.Response[mgmtv1alpha1.DeleteUserDefinedTransformerResponse], error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.DeleteUserDefinedTransformerRequest]) *connect.Response[mgmtv1alpha1.DeleteUserDefinedTransformerResponse]); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*connect.Response[mgmtv1alpha1.DeleteUserDefinedTransformerResponse])
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *connect.Request[mgmtv1alpha1.DeleteUserDefinedTransformerRequest]) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockTransformersServiceHandler_DeleteUserDefinedTransformer_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteUserDefinedTransformer'
+type MockTransformersServiceHandler_DeleteUserDefinedTransformer_Call struct {
+	*mock.Call
+}
+
+// DeleteUserDefinedTransformer is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *connect.Request[mgmtv1alpha1.DeleteUserDefinedTransformerRequest]
+func (_e *MockTransformersServiceHandler_Expecter) DeleteUserDefinedTransformer(_a0 interface{}, _a1 interface{}) *MockTransformersServiceHandler_DeleteUserDefinedTransformer_Call {
+	return &MockTransformersServiceHandler_DeleteUserDefinedTransformer_Call{Call: _e.mock.On("DeleteUserDefinedTransformer", _a0, _a1)}
+}
+
+func (_c *MockTransformersServiceHandler_DeleteUserDefinedTransformer_Call) Run(run func(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.DeleteUserDefinedTransformerRequest])) *MockTransformersServiceHandler_DeleteUserDefinedTransformer_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*connect.Request[mgmtv1alpha1.DeleteUserDefinedTransformerRequest]))
+	})
+	return _c
+}
+
+func (_c *MockTransformersServiceHandler_DeleteUserDefinedTransformer_Call) Return(_a0 *connect.Response[mgmtv1alpha1.DeleteUserDefinedTransformerResponse], _a1 error) *MockTransformersServiceHandler_DeleteUserDefinedTransformer_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockTransformersServiceHandler_DeleteUserDefinedTransformer_Call) RunAndReturn(run func(context.Context, *connect.Request[mgmtv1alpha1.DeleteUserDefinedTransformerRequest]) (*connect.Response[mgmtv1alpha1.DeleteUserDefinedTransformerResponse], error)) *MockTransformersServiceHandler_DeleteUserDefinedTransformer_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetSystemTransformerBySource provides a mock function with given fields: _a0, _a1
+func (_m *MockTransformersServiceHandler) GetSystemTransformerBySource(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.GetSystemTransformerBySourceRequest]) (*connect.Response[mgmtv1alpha1.GetSystemTransformerBySourceResponse], error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetSystemTransformerBySource")
+	}
+
+	var r0 *connect.Response[mgmtv1alpha1.GetSystemTransformerBySourceResponse]
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.GetSystemTransformerBySourceRequest]) (*connect.Response[mgmtv1alpha1.GetSystemTransformerBySourceResponse], error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.GetSystemTransformerBySourceRequest]) *connect.Response[mgmtv1alpha1.GetSystemTransformerBySourceResponse]); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*connect.Response[mgmtv1alpha1.GetSystemTransformerBySourceResponse])
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *connect.Request[mgmtv1alpha1.GetSystemTransformerBySourceRequest]) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockTransformersServiceHandler_GetSystemTransformerBySource_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetSystemTransformerBySource'
+type MockTransformersServiceHandler_GetSystemTransformerBySource_Call struct {
+	*mock.Call
+}
+
+// GetSystemTransformerBySource is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *connect.Request[mgmtv1alpha1.GetSystemTransformerBySourceRequest]
+func (_e *MockTransformersServiceHandler_Expecter) GetSystemTransformerBySource(_a0 interface{}, _a1 interface{}) *MockTransformersServiceHandler_GetSystemTransformerBySource_Call {
+	return &MockTransformersServiceHandler_GetSystemTransformerBySource_Call{Call: _e.mock.On("GetSystemTransformerBySource", _a0, _a1)}
+}
+
+func (_c *MockTransformersServiceHandler_GetSystemTransformerBySource_Call) Run(run func(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.GetSystemTransformerBySourceRequest])) *MockTransformersServiceHandler_GetSystemTransformerBySource_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*connect.Request[mgmtv1alpha1.GetSystemTransformerBySourceRequest]))
+	})
+	return _c
+}
+
+func (_c *MockTransformersServiceHandler_GetSystemTransformerBySource_Call) Return(_a0 *connect.Response[mgmtv1alpha1.GetSystemTransformerBySourceResponse], _a1 error) *MockTransformersServiceHandler_GetSystemTransformerBySource_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockTransformersServiceHandler_GetSystemTransformerBySource_Call) RunAndReturn(run func(context.Context, *connect.Request[mgmtv1alpha1.GetSystemTransformerBySourceRequest]) (*connect.Response[mgmtv1alpha1.GetSystemTransformerBySourceResponse], error)) *MockTransformersServiceHandler_GetSystemTransformerBySource_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetSystemTransformers provides a mock function with given fields: _a0, _a1
+func (_m *MockTransformersServiceHandler) GetSystemTransformers(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.GetSystemTransformersRequest]) (*connect.Response[mgmtv1alpha1.GetSystemTransformersResponse], error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetSystemTransformers")
+	}
+
+	var r0 *connect.Response[mgmtv1alpha1.GetSystemTransformersResponse]
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.GetSystemTransformersRequest]) (*connect.Response[mgmtv1alpha1.GetSystemTransformersResponse], error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.GetSystemTransformersRequest]) *connect.Response[mgmtv1alpha1.GetSystemTransformersResponse]); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*connect.Response[mgmtv1alpha1.GetSystemTransformersResponse])
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *connect.Request[mgmtv1alpha1.GetSystemTransformersRequest]) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockTransformersServiceHandler_GetSystemTransformers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetSystemTransformers'
+type MockTransformersServiceHandler_GetSystemTransformers_Call struct {
+	*mock.Call
+}
+
+// GetSystemTransformers is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *connect.Request[mgmtv1alpha1.GetSystemTransformersRequest]
+func (_e *MockTransformersServiceHandler_Expecter) GetSystemTransformers(_a0 interface{}, _a1 interface{}) *MockTransformersServiceHandler_GetSystemTransformers_Call {
+	return &MockTransformersServiceHandler_GetSystemTransformers_Call{Call: _e.mock.On("GetSystemTransformers", _a0, _a1)}
+}
+
+func (_c *MockTransformersServiceHandler_GetSystemTransformers_Call) Run(run func(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.GetSystemTransformersRequest])) *MockTransformersServiceHandler_GetSystemTransformers_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*connect.Request[mgmtv1alpha1.GetSystemTransformersRequest]))
+	})
+	return _c
+}
+
+func (_c *MockTransformersServiceHandler_GetSystemTransformers_Call) Return(_a0 *connect.Response[mgmtv1alpha1.GetSystemTransformersResponse], _a1 error) *MockTransformersServiceHandler_GetSystemTransformers_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockTransformersServiceHandler_GetSystemTransformers_Call) RunAndReturn(run func(context.Context, *connect.Request[mgmtv1alpha1.GetSystemTransformersRequest]) (*connect.Response[mgmtv1alpha1.GetSystemTransformersResponse], error)) *MockTransformersServiceHandler_GetSystemTransformers_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetUserDefinedTransformerById provides a mock function with given fields: _a0, _a1
+func (_m *MockTransformersServiceHandler) GetUserDefinedTransformerById(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.GetUserDefinedTransformerByIdRequest]) (*connect.Response[mgmtv1alpha1.GetUserDefinedTransformerByIdResponse], error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetUserDefinedTransformerById")
+	}
+
+	var r0 *connect.Response[mgmtv1alpha1.GetUserDefinedTransformerByIdResponse]
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.GetUserDefinedTransformerByIdRequest]) (*connect.Response[mgmtv1alpha1.GetUserDefinedTransformerByIdResponse], error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.GetUserDefinedTransformerByIdRequest]) *connect.Response[mgmtv1alpha1.GetUserDefinedTransformerByIdResponse]); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*connect.Response[mgmtv1alpha1.GetUserDefinedTransformerByIdResponse])
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *connect.Request[mgmtv1alpha1.GetUserDefinedTransformerByIdRequest]) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockTransformersServiceHandler_GetUserDefinedTransformerById_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUserDefinedTransformerById'
+type MockTransformersServiceHandler_GetUserDefinedTransformerById_Call struct {
+	*mock.Call
+}
+
+// GetUserDefinedTransformerById is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *connect.Request[mgmtv1alpha1.GetUserDefinedTransformerByIdRequest]
+func (_e *MockTransformersServiceHandler_Expecter) GetUserDefinedTransformerById(_a0 interface{}, _a1 interface{}) *MockTransformersServiceHandler_GetUserDefinedTransformerById_Call {
+	return &MockTransformersServiceHandler_GetUserDefinedTransformerById_Call{Call: _e.mock.On("GetUserDefinedTransformerById", _a0, _a1)}
+}
+
+func (_c *MockTransformersServiceHandler_GetUserDefinedTransformerById_Call) Run(run func(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.GetUserDefinedTransformerByIdRequest])) *MockTransformersServiceHandler_GetUserDefinedTransformerById_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*connect.Request[mgmtv1alpha1.GetUserDefinedTransformerByIdRequest]))
+	})
+	return _c
+}
+
+func (_c *MockTransformersServiceHandler_GetUserDefinedTransformerById_Call) Return(_a0 *connect.Response[mgmtv1alpha1.GetUserDefinedTransformerByIdResponse], _a1 error) *MockTransformersServiceHandler_GetUserDefinedTransformerById_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockTransformersServiceHandler_GetUserDefinedTransformerById_Call) RunAndReturn(run func(context.Context, *connect.Request[mgmtv1alpha1.GetUserDefinedTransformerByIdRequest]) (*connect.Response[mgmtv1alpha1.GetUserDefinedTransformerByIdResponse], error)) *MockTransformersServiceHandler_GetUserDefinedTransformerById_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetUserDefinedTransformers provides a mock function with given fields: _a0, _a1
+func (_m *MockTransformersServiceHandler) GetUserDefinedTransformers(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.GetUserDefinedTransformersRequest]) (*connect.Response[mgmtv1alpha1.GetUserDefinedTransformersResponse], error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetUserDefinedTransformers")
+	}
+
+	var r0 *connect.Response[mgmtv1alpha1.GetUserDefinedTransformersResponse]
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.GetUserDefinedTransformersRequest]) (*connect.Response[mgmtv1alpha1.GetUserDefinedTransformersResponse], error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.GetUserDefinedTransformersRequest]) *connect.Response[mgmtv1alpha1.GetUserDefinedTransformersResponse]); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*connect.Response[mgmtv1alpha1.GetUserDefinedTransformersResponse])
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *connect.Request[mgmtv1alpha1.GetUserDefinedTransformersRequest]) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockTransformersServiceHandler_GetUserDefinedTransformers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUserDefinedTransformers'
+type MockTransformersServiceHandler_GetUserDefinedTransformers_Call struct {
+	*mock.Call
+}
+
+// GetUserDefinedTransformers is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *connect.Request[mgmtv1alpha1.GetUserDefinedTransformersRequest]
+func (_e *MockTransformersServiceHandler_Expecter) GetUserDefinedTransformers(_a0 interface{}, _a1 interface{}) *MockTransformersServiceHandler_GetUserDefinedTransformers_Call {
+	return &MockTransformersServiceHandler_GetUserDefinedTransformers_Call{Call: _e.mock.On("GetUserDefinedTransformers", _a0, _a1)}
+}
+
+func (_c *MockTransformersServiceHandler_GetUserDefinedTransformers_Call) Run(run func(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.GetUserDefinedTransformersRequest])) *MockTransformersServiceHandler_GetUserDefinedTransformers_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*connect.Request[mgmtv1alpha1.GetUserDefinedTransformersRequest]))
+	})
+	return _c
+}
+
+func (_c *MockTransformersServiceHandler_GetUserDefinedTransformers_Call) Return(_a0 *connect.Response[mgmtv1alpha1.GetUserDefinedTransformersResponse], _a1 error) *MockTransformersServiceHandler_GetUserDefinedTransformers_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockTransformersServiceHandler_GetUserDefinedTransformers_Call) RunAndReturn(run func(context.Context, *connect.Request[mgmtv1alpha1.GetUserDefinedTransformersRequest]) (*connect.Response[mgmtv1alpha1.GetUserDefinedTransformersResponse], error)) *MockTransformersServiceHandler_GetUserDefinedTransformers_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// IsTransformerNameAvailable provides a mock function with given fields: _a0, _a1
+func (_m *MockTransformersServiceHandler) IsTransformerNameAvailable(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.IsTransformerNameAvailableRequest]) (*connect.Response[mgmtv1alpha1.IsTransformerNameAvailableResponse], error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for IsTransformerNameAvailable")
+	}
+
+	var r0 *connect.Response[mgmtv1alpha1.IsTransformerNameAvailableResponse]
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.IsTransformerNameAvailableRequest]) (*connect.Response[mgmtv1alpha1.IsTransformerNameAvailableResponse], error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.IsTransformerNameAvailableRequest]) *connect.Response[mgmtv1alpha1.IsTransformerNameAvailableResponse]); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*connect.Response[mgmtv1alpha1.IsTransformerNameAvailableResponse])
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *connect.Request[mgmtv1alpha1.IsTransformerNameAvailableRequest]) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockTransformersServiceHandler_IsTransformerNameAvailable_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'IsTransformerNameAvailable'
+type MockTransformersServiceHandler_IsTransformerNameAvailable_Call struct {
+	*mock.Call
+}
+
+// IsTransformerNameAvailable is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *connect.Request[mgmtv1alpha1.IsTransformerNameAvailableRequest]
+func (_e *MockTransformersServiceHandler_Expecter) IsTransformerNameAvailable(_a0 interface{}, _a1 interface{}) *MockTransformersServiceHandler_IsTransformerNameAvailable_Call {
+	return &MockTransformersServiceHandler_IsTransformerNameAvailable_Call{Call: _e.mock.On("IsTransformerNameAvailable", _a0, _a1)}
+}
+
+func (_c *MockTransformersServiceHandler_IsTransformerNameAvailable_Call) Run(run func(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.IsTransformerNameAvailableRequest])) *MockTransformersServiceHandler_IsTransformerNameAvailable_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*connect.Request[mgmtv1alpha1.IsTransformerNameAvailableRequest]))
+	})
+	return _c
+}
+
+func (_c *MockTransformersServiceHandler_IsTransformerNameAvailable_Call) Return(_a0 *connect.Response[mgmtv1alpha1.IsTransformerNameAvailableResponse], _a1 error) *MockTransformersServiceHandler_IsTransformerNameAvailable_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockTransformersServiceHandler_IsTransformerNameAvailable_Call) RunAndReturn(run func(context.Context, *connect.Request[mgmtv1alpha1.IsTransformerNameAvailableRequest]) (*connect.Response[mgmtv1alpha1.IsTransformerNameAvailableResponse], error)) *MockTransformersServiceHandler_IsTransformerNameAvailable_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateUserDefinedTransformer provides a mock function with given fields: _a0, _a1
+func (_m *MockTransformersServiceHandler) UpdateUserDefinedTransformer(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.UpdateUserDefinedTransformerRequest]) (*connect.Response[mgmtv1alpha1.UpdateUserDefinedTransformerResponse], error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateUserDefinedTransformer")
+	}
+
+	var r0 *connect.Response[mgmtv1alpha1.UpdateUserDefinedTransformerResponse]
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.UpdateUserDefinedTransformerRequest]) (*connect.Response[mgmtv1alpha1.UpdateUserDefinedTransformerResponse], error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.UpdateUserDefinedTransformerRequest]) *connect.Response[mgmtv1alpha1.UpdateUserDefinedTransformerResponse]); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*connect.Response[mgmtv1alpha1.UpdateUserDefinedTransformerResponse])
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *connect.Request[mgmtv1alpha1.UpdateUserDefinedTransformerRequest]) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockTransformersServiceHandler_UpdateUserDefinedTransformer_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateUserDefinedTransformer'
+type MockTransformersServiceHandler_UpdateUserDefinedTransformer_Call struct {
+	*mock.Call
+}
+
+// UpdateUserDefinedTransformer is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *connect.Request[mgmtv1alpha1.UpdateUserDefinedTransformerRequest]
+func (_e *MockTransformersServiceHandler_Expecter) UpdateUserDefinedTransformer(_a0 interface{}, _a1 interface{}) *MockTransformersServiceHandler_UpdateUserDefinedTransformer_Call {
+	return &MockTransformersServiceHandler_UpdateUserDefinedTransformer_Call{Call: _e.mock.On("UpdateUserDefinedTransformer", _a0, _a1)}
+}
+
+func (_c *MockTransformersServiceHandler_UpdateUserDefinedTransformer_Call) Run(run func(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.UpdateUserDefinedTransformerRequest])) *MockTransformersServiceHandler_UpdateUserDefinedTransformer_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*connect.Request[mgmtv1alpha1.UpdateUserDefinedTransformerRequest]))
+	})
+	return _c
+}
+
+func (_c *MockTransformersServiceHandler_UpdateUserDefinedTransformer_Call) Return(_a0 *connect.Response[mgmtv1alpha1.UpdateUserDefinedTransformerResponse], _a1 error) *MockTransformersServiceHandler_UpdateUserDefinedTransformer_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockTransformersServiceHandler_UpdateUserDefinedTransformer_Call) RunAndReturn(run func(context.Context, *connect.Request[mgmtv1alpha1.UpdateUserDefinedTransformerRequest]) (*connect.Response[mgmtv1alpha1.UpdateUserDefinedTransformerResponse], error)) *MockTransformersServiceHandler_UpdateUserDefinedTransformer_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ValidateTransformJsonConfig provides a mock function with given fields: _a0, _a1
+func (_m *MockTransformersServiceHandler) ValidateTransformJsonConfig(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.ValidateTransformJsonConfigRequest]) (*connect.Response[mgmtv1alpha1.ValidateTransformJsonConfigResponse], error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ValidateTransformJsonConfig")
+	}
+
+	var r0 *connect.Response[mgmtv1alpha1.ValidateTransformJsonConfigResponse]
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.ValidateTransformJsonConfigRequest]) (*connect.Response[mgmtv1alpha1.ValidateTransformJsonConfigResponse], error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.ValidateTransformJsonConfigRequest]) *connect.Response[mgmtv1alpha1.ValidateTransformJsonConfigResponse]); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*connect.Response[mgmtv1alpha1.ValidateTransformJsonConfigResponse])
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *connect.Request[mgmtv1alpha1.ValidateTransformJsonConfigRequest]) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockTransformersServiceHandler_ValidateTransformJsonConfig_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ValidateTransformJsonConfig'
+type MockTransformersServiceHandler_ValidateTransformJsonConfig_Call struct {
+	*mock.Call
+}
+
+// ValidateTransformJsonConfig is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *connect.Request[mgmtv1alpha1.ValidateTransformJsonConfigRequest]
+func (_e *MockTransformersServiceHandler_Expecter) ValidateTransformJsonConfig(_a0 interface{}, _a1 interface{}) *MockTransformersServiceHandler_ValidateTransformJsonConfig_Call {
+	return &MockTransformersServiceHandler_ValidateTransformJsonConfig_Call{Call: _e.mock.On("ValidateTransformJsonConfig", _a0, _a1)}
+}
+
+func (_c *MockTransformersServiceHandler_ValidateTransformJsonConfig_Call) Run(run func(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.ValidateTransformJsonConfigRequest])) *MockTransformersServiceHandler_ValidateTransformJsonConfig_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*connect.Request[mgmtv1alpha1.ValidateTransformJsonConfigRequest]))
+	})
+	return _c
+}
+
+func (_c *MockTransformersServiceHandler_ValidateTransformJsonConfig_Call) Return(_a0 *connect.Response[mgmtv1alpha1.ValidateTransformJsonConfigResponse], _a1 error) *MockTransformersServiceHandler_ValidateTransformJsonConfig_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockTransformersServiceHandler_ValidateTransformJsonConfig_Call) RunAndReturn(run func(context.Context, *connect.Request[mgmtv1alpha1.ValidateTransformJsonConfigRequest]) (*connect.Response[mgmtv1alpha1.ValidateTransformJsonConfigResponse], error)) *MockTransformersServiceHandler_ValidateTransformJsonConfig_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ValidateUserJavascriptCode provides a mock function with given fields: _a0, _a1
+func (_m *MockTransformersServiceHandler) ValidateUserJavascriptCode(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.ValidateUserJavascriptCodeRequest]) (*connect.Response[mgmtv1alpha1.ValidateUserJavascriptCodeResponse], error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ValidateUserJavascriptCode")
+	}
+
+	var r0 *connect.Response[mgmtv1alpha1.ValidateUserJavascriptCodeResponse]
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.ValidateUserJavascriptCodeRequest]) (*connect.Response[mgmtv1alpha1.ValidateUserJavascriptCodeResponse], error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.ValidateUserJavascriptCodeRequest]) *connect.Response[mgmtv1alpha1.ValidateUserJavascriptCodeResponse]); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*connect.Response[mgmtv1alpha1.ValidateUserJavascriptCodeResponse])
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *connect.Request[mgmtv1alpha1.ValidateUserJavascriptCodeRequest]) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockTransformersServiceHandler_ValidateUserJavascriptCode_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ValidateUserJavascriptCode'
+type MockTransformersServiceHandler_ValidateUserJavascriptCode_Call struct {
+	*mock.Call
+}
+
+// ValidateUserJavascriptCode is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *connect.Request[mgmtv1alpha1.ValidateUserJavascriptCodeRequest]
+func (_e *MockTransformersServiceHandler_Expecter) ValidateUserJavascriptCode(_a0 interface{}, _a1 interface{}) *MockTransformersServiceHandler_ValidateUserJavascriptCode_Call {
+	return &MockTransformersServiceHandler_ValidateUserJavascriptCode_Call{Call: _e.mock.On("ValidateUserJavascriptCode", _a0, _a1)}
+}
+
+func (_c *MockTransformersServiceHandler_ValidateUserJavascriptCode_Call) Run(run func(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.ValidateUserJavascriptCodeRequest])) *MockTransformersServiceHandler_ValidateUserJavascriptCode_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*connect.Request[mgmtv1alpha1.ValidateUserJavascriptCodeRequest]))
+	})
+	return _c
+}
+
+func (_c *MockTransformersServiceHandler_ValidateUserJavascriptCode_Call) Return(_a0 *connect.Response[mgmtv1alpha1.ValidateUserJavascriptCodeResponse], _a1 error) *MockTransformersServiceHandler_ValidateUserJavascriptCode_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockTransformersServiceHandler_ValidateUserJavascriptCode_Call) RunAndReturn(run func(context.Context, *connect.Request[mgmtv1alpha1.ValidateUserJavascriptCodeRequest]) (*connect.Response[mgmtv1alpha1.ValidateUserJavascriptCodeResponse], error)) *MockTransformersServiceHandler_ValidateUserJavascriptCode_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ValidateUserRegexCode provides a mock function with given fields: _a0, _a1
+func (_m *MockTransformersServiceHandler) ValidateUserRegexCode(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.ValidateUserRegexCodeRequest]) (*connect.Response[mgmtv1alpha1.ValidateUserRegexCodeResponse], error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ValidateUserRegexCode")
+	}
+
+	var r0 *connect.Response[mgmtv1alpha1.ValidateUserRegexCodeResponse]
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.ValidateUserRegexCodeRequest]) (*connect.Response[mgmtv1alpha1.ValidateUserRegexCodeResponse], error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.ValidateUserRegexCodeRequest]) *connect.Response[mgmtv1alpha1.ValidateUserRegexCodeResponse]); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*connect.Response[mgmtv1alpha1.ValidateUserRegexCodeResponse])
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *connect.Request[mgmtv1alpha1.ValidateUserRegexCodeRequest]) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockTransformersServiceHandler_ValidateUserRegexCode_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ValidateUserRegexCode'
+type MockTransformersServiceHandler_ValidateUserRegexCode_Call struct {
+	*mock.Call
+}
+
+// ValidateUserRegexCode is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *connect.Request[mgmtv1alpha1.ValidateUserRegexCodeRequest]
+func (_e *MockTransformersServiceHandler_Expecter) ValidateUserRegexCode(_a0 interface{}, _a1 interface{}) *MockTransformersServiceHandler_ValidateUserRegexCode_Call {
+	return &MockTransformersServiceHandler_ValidateUserRegexCode_Call{Call: _e.mock.On("ValidateUserRegexCode", _a0, _a1)}
+}
+
+func (_c *MockTransformersServiceHandler_ValidateUserRegexCode_Call) Run(run func(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.ValidateUserRegexCodeRequest])) *MockTransformersServiceHandler_ValidateUserRegexCode_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*connect.Request[mgmtv1alpha1.ValidateUserRegexCodeRequest]))
+	})
+	return _c
+}
+
+func (_c *MockTransformersServiceHandler_ValidateUserRegexCode_Call) Return(_a0 *connect.Response[mgmtv1alpha1.ValidateUserRegexCodeResponse], _a1 error) *MockTransformersServiceHandler_ValidateUserRegexCode_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockTransformersServiceHandler_ValidateUserRegexCode_Call) RunAndReturn(run func(context.Context, *connect.Request[mgmtv1alpha1.ValidateUserRegexCodeRequest]) (*connect.Response[mgmtv1alpha1.ValidateUserRegexCodeResponse], error)) *MockTransformersServiceHandler_ValidateUserRegexCode_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockTransformersServiceHandler creates a new instance of MockTransformersServiceHandler. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockTransformersServiceHandler(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockTransformersServiceHandler {
+	mock := &MockTransformersServiceHandler{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -319,6 +319,65 @@ func (_c *MockUserAccountServiceClient_GetAccountTemporalConfig_Call) RunAndRetu
 	return _c
 }
 
+// GetAccountTransformerKey provides a mock function with given fields: _a0, _a1
+func (_m *MockUserAccountServiceClient) GetAccountTransformerKey(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.GetAccountTransformerKeyRequest]) (*connect.Response[mgmtv1alpha1.GetAccountTransformerKeyResponse], error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAccountTransformerKey")
+	}
+
+	var r0 *connect.Response[mgmtv1alpha1.GetAccountTransformerKeyResponse]
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.GetAccountTransformerKeyRequest]) (*connect.Response[mgmtv1alpha1.GetAccountTransformerKeyResponse], error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.GetAccountTransformerKeyRequest]) *connect.Response[mgmtv1alpha1.GetAccountTransformerKeyResponse]); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*connect.Response[mgmtv1alpha1.GetAccountTransformerKeyResponse])
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *connect.Request[mgmtv1alpha1.GetAccountTransformerKeyRequest]) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockUserAccountServiceClient_GetAccountTransformerKey_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAccountTransformerKey'
+type MockUserAccountServiceClient_GetAccountTransformerKey_Call struct {
+	*mock.Call
+}
+
+// GetAccountTransformerKey is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *connect.Request[mgmtv1alpha1.GetAccountTransformerKeyRequest]
+func (_e *MockUserAccountServiceClient_Expecter) GetAccountTransformerKey(_a0 interface{}, _a1 interface{}) *MockUserAccountServiceClient_GetAccountTransformerKey_Call {
+	return &MockUserAccountServiceClient_GetAccountTransformerKey_Call{Call: _e.mock.On("GetAccountTransformerKey", _a0, _a1)}
+}
+
+func (_c *MockUserAccountServiceClient_GetAccountTransformerKey_Call) Run(run func(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.GetAccountTransformerKeyRequest])) *MockUserAccountServiceClient_GetAccountTransformerKey_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*connect.Request[mgmtv1alpha1.GetAccountTransformerKeyRequest]))
+	})
+	return _c
+}
+
+func (_c *MockUserAccountServiceClient_GetAccountTransformerKey_Call) Return(_a0 *connect.Response[mgmtv1alpha1.GetAccountTransformerKeyResponse], _a1 error) *MockUserAccountServiceClient_GetAccountTransformerKey_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockUserAccountServiceClient_GetAccountTransformerKey_Call) RunAndReturn(run func(context.Context, *connect.Request[mgmtv1alpha1.GetAccountTransformerKeyRequest]) (*connect.Response[mgmtv1alpha1.GetAccountTransformerKeyResponse], error)) *MockUserAccountServiceClient_GetAccountTransformerKey_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetSystemInformation provides a mock function with given fields: _a0, _a1
 func (_m *MockUserAccountServiceClient) GetSystemInformation(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.GetSystemInformationRequest]) (*connect.Response[mgmtv1alpha1.GetSystemInformationResponse], error) {
 	ret := _m.Called(_a0, _a1)
@@ -968,6 +1027,65 @@ func (_c *MockUserAccountServiceClient_SetAccountTemporalConfig_Call) RunAndRetu
 	return _c
 }
 
+// SetAccountTransformerKey provides a mock function with given fields: _a0, _a1
+func (_m *MockUserAccountServiceClient) SetAccountTransformerKey(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.SetAccountTransformerKeyRequest]) (*connect.Response[mgmtv1alpha1.SetAccountTransformerKeyResponse], error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetAccountTransformerKey")
+	}
+
+	var r0 *connect.Response[mgmtv1alpha1.SetAccountTransformerKeyResponse]
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.SetAccountTransformerKeyRequest]) (*connect.Response[mgmtv1alpha1.SetAccountTransformerKeyResponse], error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.SetAccountTransformerKeyRequest]) *connect.Response[mgmtv1alpha1.SetAccountTransformerKeyResponse]); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*connect.Response[mgmtv1alpha1.SetAccountTransformerKeyResponse])
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *connect.Request[mgmtv1alpha1.SetAccountTransformerKeyRequest]) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockUserAccountServiceClient_SetAccountTransformerKey_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetAccountTransformerKey'
+type MockUserAccountServiceClient_SetAccountTransformerKey_Call struct {
+	*mock.Call
+}
+
+// SetAccountTransformerKey is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *connect.Request[mgmtv1alpha1.SetAccountTransformerKeyRequest]
+func (_e *MockUserAccountServiceClient_Expecter) SetAccountTransformerKey(_a0 interface{}, _a1 interface{}) *MockUserAccountServiceClient_SetAccountTransformerKey_Call {
+	return &MockUserAccountServiceClient_SetAccountTransformerKey_Call{Call: _e.mock.On("SetAccountTransformerKey", _a0, _a1)}
+}
+
+func (_c *MockUserAccountServiceClient_SetAccountTransformerKey_Call) Run(run func(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.SetAccountTransformerKeyRequest])) *MockUserAccountServiceClient_SetAccountTransformerKey_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*connect.Request[mgmtv1alpha1.SetAccountTransformerKeyRequest]))
+	})
+	return _c
+}
+
+func (_c *MockUserAccountServiceClient_SetAccountTransformerKey_Call) Return(_a0 *connect.Response[mgmtv1alpha1.SetAccountTransformerKeyResponse], _a1 error) *MockUserAccountServiceClient_SetAccountTransformerKey_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockUserAccountServiceClient_SetAccountTransformerKey_Call) RunAndReturn(run func(context.Context, *connect.Request[mgmtv1alpha1.SetAccountTransformerKeyRequest]) (*connect.Response[mgmtv1alpha1.SetAccountTransformerKeyResponse], error)) *MockUserAccountServiceClient_SetAccountTransformerKey_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // SetPersonalAccount provides a mock function with given fields: _a0, _a1
 func (_m *MockUserAccountServiceClient) SetPersonalAccount(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.SetPersonalAccountRequest]) (*connect.Response[mgmtv1alpha1.SetPersonalAccountResponse], error) {
 	ret := _m.Called(_a0, _a1)
@@ -1027,6 +1145,65 @@ func (_c *MockUserAccountServiceClient_SetPersonalAccount_Call) RunAndReturn(run
 	return _c
 }
 
+// SetTeamAccountMemberRole provides a mock function with given fields: _a0, _a1
+func (_m *MockUserAccountServiceClient) SetTeamAccountMemberRole(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.SetTeamAccountMemberRoleRequest]) (*connect.Response[mgmtv1alpha1.SetTeamAccountMemberRoleResponse], error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetTeamAccountMemberRole")
+	}
+
+	var r0 *connect.Response[mgmtv1alpha1.SetTeamAccountMemberRoleResponse]
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.SetTeamAccountMemberRoleRequest]) (*connect.Response[mgmtv1alpha1.SetTeamAccountMemberRoleResponse], error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.SetTeamAccountMemberRoleRequest]) *connect.Response[mgmtv1alpha1.SetTeamAccountMemberRoleResponse]); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*connect.Response[mgmtv1alpha1.SetTeamAccountMemberRoleResponse])
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *connect.Request[mgmtv1alpha1.SetTeamAccountMemberRoleRequest]) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockUserAccountServiceClient_SetTeamAccountMemberRole_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetTeamAccountMemberRole'
+type MockUserAccountServiceClient_SetTeamAccountMemberRole_Call struct {
+	*mock.Call
+}
+
+// SetTeamAccountMemberRole is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *connect.Request[mgmtv1alpha1.SetTeamAccountMemberRoleRequest]
+func (_e *MockUserAccountServiceClient_Expecter) SetTeamAccountMemberRole(_a0 interface{}, _a1 interface{}) *MockUserAccountServiceClient_SetTeamAccountMemberRole_Call {
+	return &MockUserAccountServiceClient_SetTeamAccountMemberRole_Call{Call: _e.mock.On("SetTeamAccountMemberRole", _a0, _a1)}
+}
+
+func (_c *MockUserAccountServiceClient_SetTeamAccountMemberRole_Call) Run(run func(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.SetTeamAccountMemberRoleRequest])) *MockUserAccountServiceClient_SetTeamAccountMemberRole_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*connect.Request[mgmtv1alpha1.SetTeamAccountMemberRoleRequest]))
+	})
+	return _c
+}
+
+func (_c *MockUserAccountServiceClient_SetTeamAccountMemberRole_Call) Return(_a0 *connect.Response[mgmtv1alpha1.SetTeamAccountMemberRoleResponse], _a1 error) *MockUserAccountServiceClient_SetTeamAccountMemberRole_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockUserAccountServiceClient_SetTeamAccountMemberRole_Call) RunAndReturn(run func(context.Context, *connect.Request[mgmtv1alpha1.SetTeamAccountMemberRoleRequest]) (*connect.Response[mgmtv1alpha1.SetTeamAccountMemberRoleResponse], error)) *MockUserAccountServiceClient_SetTeamAccountMemberRole_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // SetUser provides a mock function with given fields: _a0, _a1
 func (_m *MockUserAccountServiceClient) SetUser(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.SetUserRequest]) (*connect.Response[mgmtv1alpha1.SetUserResponse], error) {
 	ret := _m.Called(_a0, _a1)
@@ -0,0 +1,452 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mgmtv1alpha1connect
+
+import (
+	context "context"
+
+	connect "connectrpc.com/connect"
+
+	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockWebhookServiceClient is an autogenerated mock type for the WebhookServiceClient type
+type MockWebhookServiceClient struct {
+	mock.Mock
+}
+
+type MockWebhookServiceClient_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockWebhookServiceClient) EXPECT() *MockWebhookServiceClient_Expecter {
+	return &MockWebhookServiceClient_Expecter{mock: &_m.Mock}
+}
+
+// CreateWebhook provides a mock function with given fields: _a0, _a1
+func (_m *MockWebhookServiceClient) CreateWebhook(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.CreateWebhookRequest]) (*connect.Response[mgmtv1alpha1.CreateWebhookResponse], error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateWebhook")
+	}
+
+	var r0 *connect.Response[mgmtv1alpha1.CreateWebhookResponse]
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.CreateWebhookRequest]) (*connect.Response[mgmtv1alpha1.CreateWebhookResponse], error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.CreateWebhookRequest]) *connect.Response[mgmtv1alpha1.CreateWebhookResponse]); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*connect.Response[mgmtv1alpha1.CreateWebhookResponse])
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *connect.Request[mgmtv1alpha1.CreateWebhookRequest]) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockWebhookServiceClient_CreateWebhook_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateWebhook'
+type MockWebhookServiceClient_CreateWebhook_Call struct {
+	*mock.Call
+}
+
+// CreateWebhook is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *connect.Request[mgmtv1alpha1.CreateWebhookRequest]
+func (_e *MockWebhookServiceClient_Expecter) CreateWebhook(_a0 interface{}, _a1 interface{}) *MockWebhookServiceClient_CreateWebhook_Call {
+	return &MockWebhookServiceClient_CreateWebhook_Call{Call: _e.mock.On("CreateWebhook", _a0, _a1)}
+}
+
+func (_c *MockWebhookServiceClient_CreateWebhook_Call) Run(run func(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.CreateWebhookRequest])) *MockWebhookServiceClient_CreateWebhook_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*connect.Request[mgmtv1alpha1.CreateWebhookRequest]))
+	})
+	return _c
+}
+
+func (_c *MockWebhookServiceClient_CreateWebhook_Call) Return(_a0 *connect.Response[mgmtv1alpha1.CreateWebhookResponse], _a1 error) *MockWebhookServiceClient_CreateWebhook_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockWebhookServiceClient_CreateWebhook_Call) RunAndReturn(run func(context.Context, *connect.Request[mgmtv1alpha1.CreateWebhookRequest]) (*connect.Response[mgmtv1alpha1.CreateWebhookResponse], error)) *MockWebhookServiceClient_CreateWebhook_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteWebhook provides a mock function with given fields: _a0, _a1
+func (_m *MockWebhookServiceClient) DeleteWebhook(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.DeleteWebhookRequest]) (*connect.Response[mgmtv1alpha1.DeleteWebhookResponse], error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteWebhook")
+	}
+
+	var r0 *connect.Response[mgmtv1alpha1.DeleteWebhookResponse]
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.DeleteWebhookRequest]) (*connect.Response[mgmtv1alpha1.DeleteWebhookResponse], error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.DeleteWebhookRequest]) *connect.Response[mgmtv1alpha1.DeleteWebhookResponse]); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*connect.Response[mgmtv1alpha1.DeleteWebhookResponse])
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *connect.Request[mgmtv1alpha1.DeleteWebhookRequest]) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockWebhookServiceClient_DeleteWebhook_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteWebhook'
+type MockWebhookServiceClient_DeleteWebhook_Call struct {
+	*mock.Call
+}
+
+// DeleteWebhook is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *connect.Request[mgmtv1alpha1.DeleteWebhookRequest]
+func (_e *MockWebhookServiceClient_Expecter) DeleteWebhook(_a0 interface{}, _a1 interface{}) *MockWebhookServiceClient_DeleteWebhook_Call {
+	return &MockWebhookServiceClient_DeleteWebhook_Call{Call: _e.mock.On("DeleteWebhook", _a0, _a1)}
+}
+
+func (_c *MockWebhookServiceClient_DeleteWebhook_Call) Run(run func(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.DeleteWebhookRequest])) *MockWebhookServiceClient_DeleteWebhook_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*connect.Request[mgmtv1alpha1.DeleteWebhookRequest]))
+	})
+	return _c
+}
+
+func (_c *MockWebhookServiceClient_DeleteWebhook_Call) Return(_a0 *connect.Response[mgmtv1alpha1.DeleteWebhookResponse], _a1 error) *MockWebhookServiceClient_DeleteWebhook_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockWebhookServiceClient_DeleteWebhook_Call) RunAndReturn(run func(context.Context, *connect.Request[mgmtv1alpha1.DeleteWebhookRequest]) (*connect.Response[mgmtv1alpha1.DeleteWebhookResponse], error)) *MockWebhookServiceClient_DeleteWebhook_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetWebhook provides a mock function with given fields: _a0, _a1
+func (_m *MockWebhookServiceClient) GetWebhook(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.GetWebhookRequest]) (*connect.Response[mgmtv1alpha1.GetWebhookResponse], error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetWebhook")
+	}
+
+	var r0 *connect.Response[mgmtv1alpha1.GetWebhookResponse]
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.GetWebhookRequest]) (*connect.Response[mgmtv1alpha1.GetWebhookResponse], error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.GetWebhookRequest]) *connect.Response[mgmtv1alpha1.GetWebhookResponse]); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*connect.Response[mgmtv1alpha1.GetWebhookResponse])
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *connect.Request[mgmtv1alpha1.GetWebhookRequest]) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockWebhookServiceClient_GetWebhook_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetWebhook'
+type MockWebhookServiceClient_GetWebhook_Call struct {
+	*mock.Call
+}
+
+// GetWebhook is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *connect.Request[mgmtv1alpha1.GetWebhookRequest]
+func (_e *MockWebhookServiceClient_Expecter) GetWebhook(_a0 interface{}, _a1 interface{}) *MockWebhookServiceClient_GetWebhook_Call {
+	return &MockWebhookServiceClient_GetWebhook_Call{Call: _e.mock.On("GetWebhook", _a0, _a1)}
+}
+
+func (_c *MockWebhookServiceClient_GetWebhook_Call) Run(run func(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.GetWebhookRequest])) *MockWebhookServiceClient_GetWebhook_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*connect.Request[mgmtv1alpha1.GetWebhookRequest]))
+	})
+	return _c
+}
+
+func (_c *MockWebhookServiceClient_GetWebhook_Call) Return(_a0 *connect.Response[mgmtv1alpha1.GetWebhookResponse], _a1 error) *MockWebhookServiceClient_GetWebhook_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockWebhookServiceClient_GetWebhook_Call) RunAndReturn(run func(context.Context, *connect.Request[mgmtv1alpha1.GetWebhookRequest]) (*connect.Response[mgmtv1alpha1.GetWebhookResponse], error)) *MockWebhookServiceClient_GetWebhook_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetWebhookDeliveries provides a mock function with given fields: _a0, _a1
+func (_m *MockWebhookServiceClient) GetWebhookDeliveries(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.GetWebhookDeliveriesRequest]) (*connect.Response[mgmtv1alpha1.GetWebhookDeliveriesResponse], error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetWebhookDeliveries")
+	}
+
+	var r0 *connect.Response[mgmtv1alpha1.GetWebhookDeliveriesResponse]
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.GetWebhookDeliveriesRequest]) (*connect.Response[mgmtv1alpha1.GetWebhookDeliveriesResponse], error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.GetWebhookDeliveriesRequest]) *connect.Response[mgmtv1alpha1.GetWebhookDeliveriesResponse]); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*connect.Response[mgmtv1alpha1.GetWebhookDeliveriesResponse])
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *connect.Request[mgmtv1alpha1.GetWebhookDeliveriesRequest]) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockWebhookServiceClient_GetWebhookDeliveries_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetWebhookDeliveries'
+type MockWebhookServiceClient_GetWebhookDeliveries_Call struct {
+	*mock.Call
+}
+
+// GetWebhookDeliveries is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *connect.Request[mgmtv1alpha1.GetWebhookDeliveriesRequest]
+func (_e *MockWebhookServiceClient_Expecter) GetWebhookDeliveries(_a0 interface{}, _a1 interface{}) *MockWebhookServiceClient_GetWebhookDeliveries_Call {
+	return &MockWebhookServiceClient_GetWebhookDeliveries_Call{Call: _e.mock.On("GetWebhookDeliveries", _a0, _a1)}
+}
+
+func (_c *MockWebhookServiceClient_GetWebhookDeliveries_Call) Run(run func(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.GetWebhookDeliveriesRequest])) *MockWebhookServiceClient_GetWebhookDeliveries_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*connect.Request[mgmtv1alpha1.GetWebhookDeliveriesRequest]))
+	})
+	return _c
+}
+
+func (_c *MockWebhookServiceClient_GetWebhookDeliveries_Call) Return(_a0 *connect.Response[mgmtv1alpha1.GetWebhookDeliveriesResponse], _a1 error) *MockWebhookServiceClient_GetWebhookDeliveries_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockWebhookServiceClient_GetWebhookDeliveries_Call) RunAndReturn(run func(context.Context, *connect.Request[mgmtv1alpha1.GetWebhookDeliveriesRequest]) (*connect.Response[mgmtv1alpha1.GetWebhookDeliveriesResponse], error)) *MockWebhookServiceClient_GetWebhookDeliveries_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetWebhooks provides a mock function with given fields: _a0, _a1
+func (_m *MockWebhookServiceClient) GetWebhooks(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.GetWebhooksRequest]) (*connect.Response[mgmtv1alpha1.GetWebhooksResponse], error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetWebhooks")
+	}
+
+	var r0 *connect.Response[mgmtv1alpha1.GetWebhooksResponse]
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.GetWebhooksRequest]) (*connect.Response[mgmtv1alpha1.GetWebhooksResponse], error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.GetWebhooksRequest]) *connect.Response[mgmtv1alpha1.GetWebhooksResponse]); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*connect.Response[mgmtv1alpha1.GetWebhooksResponse])
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *connect.Request[mgmtv1alpha1.GetWebhooksRequest]) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockWebhookServiceClient_GetWebhooks_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetWebhooks'
+type MockWebhookServiceClient_GetWebhooks_Call struct {
+	*mock.Call
+}
+
+// GetWebhooks is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *connect.Request[mgmtv1alpha1.GetWebhooksRequest]
+func (_e *MockWebhookServiceClient_Expecter) GetWebhooks(_a0 interface{}, _a1 interface{}) *MockWebhookServiceClient_GetWebhooks_Call {
+	return &MockWebhookServiceClient_GetWebhooks_Call{Call: _e.mock.On("GetWebhooks", _a0, _a1)}
+}
+
+func (_c *MockWebhookServiceClient_GetWebhooks_Call) Run(run func(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.GetWebhooksRequest])) *MockWebhookServiceClient_GetWebhooks_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*connect.Request[mgmtv1alpha1.GetWebhooksRequest]))
+	})
+	return _c
+}
+
+func (_c *MockWebhookServiceClient_GetWebhooks_Call) Return(_a0 *connect.Response[mgmtv1alpha1.GetWebhooksResponse], _a1 error) *MockWebhookServiceClient_GetWebhooks_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockWebhookServiceClient_GetWebhooks_Call) RunAndReturn(run func(context.Context, *connect.Request[mgmtv1alpha1.GetWebhooksRequest]) (*connect.Response[mgmtv1alpha1.GetWebhooksResponse], error)) *MockWebhookServiceClient_GetWebhooks_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PublishJobRunEvent provides a mock function with given fields: _a0, _a1
+func (_m *MockWebhookServiceClient) PublishJobRunEvent(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.PublishJobRunEventRequest]) (*connect.Response[mgmtv1alpha1.PublishJobRunEventResponse], error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PublishJobRunEvent")
+	}
+
+	var r0 *connect.Response[mgmtv1alpha1.PublishJobRunEventResponse]
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.PublishJobRunEventRequest]) (*connect.Response[mgmtv1alpha1.PublishJobRunEventResponse], error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.PublishJobRunEventRequest]) *connect.Response[mgmtv1alpha1.PublishJobRunEventResponse]); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*connect.Response[mgmtv1alpha1.PublishJobRunEventResponse])
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *connect.Request[mgmtv1alpha1.PublishJobRunEventRequest]) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockWebhookServiceClient_PublishJobRunEvent_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PublishJobRunEvent'
+type MockWebhookServiceClient_PublishJobRunEvent_Call struct {
+	*mock.Call
+}
+
+// PublishJobRunEvent is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *connect.Request[mgmtv1alpha1.PublishJobRunEventRequest]
+func (_e *MockWebhookServiceClient_Expecter) PublishJobRunEvent(_a0 interface{}, _a1 interface{}) *MockWebhookServiceClient_PublishJobRunEvent_Call {
+	return &MockWebhookServiceClient_PublishJobRunEvent_Call{Call: _e.mock.On("PublishJobRunEvent", _a0, _a1)}
+}
+
+func (_c *MockWebhookServiceClient_PublishJobRunEvent_Call) Run(run func(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.PublishJobRunEventRequest])) *MockWebhookServiceClient_PublishJobRunEvent_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*connect.Request[mgmtv1alpha1.PublishJobRunEventRequest]))
+	})
+	return _c
+}
+
+func (_c *MockWebhookServiceClient_PublishJobRunEvent_Call) Return(_a0 *connect.Response[mgmtv1alpha1.PublishJobRunEventResponse], _a1 error) *MockWebhookServiceClient_PublishJobRunEvent_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockWebhookServiceClient_PublishJobRunEvent_Call) RunAndReturn(run func(context.Context, *connect.Request[mgmtv1alpha1.PublishJobRunEventRequest]) (*connect.Response[mgmtv1alpha1.PublishJobRunEventResponse], error)) *MockWebhookServiceClient_PublishJobRunEvent_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateWebhook provides a mock function with given fields: _a0, _a1
+func (_m *MockWebhookServiceClient) UpdateWebhook(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.UpdateWebhookRequest]) (*connect.Response[mgmtv1alpha1.UpdateWebhookResponse], error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateWebhook")
+	}
+
+	var r0 *connect.Response[mgmtv1alpha1.UpdateWebhookResponse]
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.UpdateWebhookRequest]) (*connect.Response[mgmtv1alpha1.UpdateWebhookResponse], error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.UpdateWebhookRequest]) *connect.Response[mgmtv1alpha1.UpdateWebhookResponse]); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*connect.Response[mgmtv1alpha1.UpdateWebhookResponse])
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *connect.Request[mgmtv1alpha1.UpdateWebhookRequest]) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockWebhookServiceClient_UpdateWebhook_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateWebhook'
+type MockWebhookServiceClient_UpdateWebhook_Call struct {
+	*mock.Call
+}
+
+// UpdateWebhook is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *connect.Request[mgmtv1alpha1.UpdateWebhookRequest]
+func (_e *MockWebhookServiceClient_Expecter) UpdateWebhook(_a0 interface{}, _a1 interface{}) *MockWebhookServiceClient_UpdateWebhook_Call {
+	return &MockWebhookServiceClient_UpdateWebhook_Call{Call: _e.mock.On("UpdateWebhook", _a0, _a1)}
+}
+
+func (_c *MockWebhookServiceClient_UpdateWebhook_Call) Run(run func(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.UpdateWebhookRequest])) *MockWebhookServiceClient_UpdateWebhook_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*connect.Request[mgmtv1alpha1.UpdateWebhookRequest]))
+	})
+	return _c
+}
+
+func (_c *MockWebhookServiceClient_UpdateWebhook_Call) Return(_a0 *connect.Response[mgmtv1alpha1.UpdateWebhookResponse], _a1 error) *MockWebhookServiceClient_UpdateWebhook_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockWebhookServiceClient_UpdateWebhook_Call) RunAndReturn(run func(context.Context, *connect.Request[mgmtv1alpha1.UpdateWebhookRequest]) (*connect.Response[mgmtv1alpha1.UpdateWebhookResponse], error)) *MockWebhookServiceClient_UpdateWebhook_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockWebhookServiceClient creates a new instance of MockWebhookServiceClient. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockWebhookServiceClient(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockWebhookServiceClient {
+	mock := &MockWebhookServiceClient{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -63,6 +63,12 @@ const (
 	// TransformersServiceValidateUserRegexCodeProcedure is the fully-qualified name of the
 	// TransformersService's ValidateUserRegexCode RPC.
 	TransformersServiceValidateUserRegexCodeProcedure = "/mgmt.v1alpha1.TransformersService/ValidateUserRegexCode"
+	// TransformersServiceValidateTransformJsonConfigProcedure is the fully-qualified name of the
+	// TransformersService's ValidateTransformJsonConfig RPC.
+	TransformersServiceValidateTransformJsonConfigProcedure = "/mgmt.v1alpha1.TransformersService/ValidateTransformJsonConfig"
+	// TransformersServiceDecryptFormatPreservingProcedure is the fully-qualified name of the
+	// TransformersService's DecryptFormatPreserving RPC.
+	TransformersServiceDecryptFormatPreservingProcedure = "/mgmt.v1alpha1.TransformersService/DecryptFormatPreserving"
 )
 
 // These variables are the protoreflect.Descriptor objects for the RPCs defined in this package.
@@ -78,6 +84,8 @@ var (
 	transformersServiceIsTransformerNameAvailableMethodDescriptor    = transformersServiceServiceDescriptor.Methods().ByName("IsTransformerNameAvailable")
 	transformersServiceValidateUserJavascriptCodeMethodDescriptor    = transformersServiceServiceDescriptor.Methods().ByName("ValidateUserJavascriptCode")
 	transformersServiceValidateUserRegexCodeMethodDescriptor         = transformersServiceServiceDescriptor.Methods().ByName("ValidateUserRegexCode")
+	transformersServiceValidateTransformJsonConfigMethodDescriptor   = transformersServiceServiceDescriptor.Methods().ByName("ValidateTransformJsonConfig")
+	transformersServiceDecryptFormatPreservingMethodDescriptor       = transformersServiceServiceDescriptor.Methods().ByName("DecryptFormatPreserving")
 )
 
 // TransformersServiceClient is a client for the mgmt.v1alpha1.TransformersService service.
@@ -92,6 +100,11 @@ type TransformersServiceClient interface {
 	IsTransformerNameAvailable(context.Context, *connect.Request[v1alpha1.IsTransformerNameAvailableRequest]) (*connect.Response[v1alpha1.IsTransformerNameAvailableResponse], error)
 	ValidateUserJavascriptCode(context.Context, *connect.Request[v1alpha1.ValidateUserJavascriptCodeRequest]) (*connect.Response[v1alpha1.ValidateUserJavascriptCodeResponse], error)
 	ValidateUserRegexCode(context.Context, *connect.Request[v1alpha1.ValidateUserRegexCodeRequest]) (*connect.Response[v1alpha1.ValidateUserRegexCodeResponse], error)
+	// Validates the JSONPath expressions and nested transformers of a transform json config
+	ValidateTransformJsonConfig(context.Context, *connect.Request[v1alpha1.ValidateTransformJsonConfigRequest]) (*connect.Response[v1alpha1.ValidateTransformJsonConfigResponse], error)
+	// Decrypts a value that was encrypted by the format preserving encrypt transformer using the account's transformer key.
+	// Values that were encrypted before the transformer key was changed can not be decrypted.
+	DecryptFormatPreserving(context.Context, *connect.Request[v1alpha1.DecryptFormatPreservingRequest]) (*connect.Response[v1alpha1.DecryptFormatPreservingResponse], error)
 }
 
 // NewTransformersServiceClient constructs a client for the mgmt.v1alpha1.TransformersService
@@ -164,6 +177,18 @@ func NewTransformersServiceClient(httpClient connect.HTTPClient, baseURL string,
 			connect.WithSchema(transformersServiceValidateUserRegexCodeMethodDescriptor),
 			connect.WithClientOptions(opts...),
 		),
+		validateTransformJsonConfig: connect.NewClient[v1alpha1.ValidateTransformJsonConfigRequest, v1alpha1.ValidateTransformJsonConfigResponse](
+			httpClient,
+			baseURL+TransformersServiceValidateTransformJsonConfigProcedure,
+			connect.WithSchema(transformersServiceValidateTransformJsonConfigMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		decryptFormatPreserving: connect.NewClient[v1alpha1.DecryptFormatPreservingRequest, v1alpha1.DecryptFormatPreservingResponse](
+			httpClient,
+			baseURL+TransformersServiceDecryptFormatPreservingProcedure,
+			connect.WithSchema(transformersServiceDecryptFormatPreservingMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
 	}
 }
 
@@ -179,6 +204,8 @@ type transformersServiceClient struct {
 	isTransformerNameAvailable    *connect.Client[v1alpha1.IsTransformerNameAvailableRequest, v1alpha1.IsTransformerNameAvailableResponse]
 	validateUserJavascriptCode    *connect.Client[v1alpha1.ValidateUserJavascriptCodeRequest, v1alpha1.ValidateUserJavascriptCodeResponse]
 	validateUserRegexCode         *connect.Client[v1alpha1.ValidateUserRegexCodeRequest, v1alpha1.ValidateUserRegexCodeResponse]
+	validateTransformJsonConfig   *connect.Client[v1alpha1.ValidateTransformJsonConfigRequest, v1alpha1.ValidateTransformJsonConfigResponse]
+	decryptFormatPreserving       *connect.Client[v1alpha1.DecryptFormatPreservingRequest, v1alpha1.DecryptFormatPreservingResponse]
 }
 
 // GetSystemTransformers calls mgmt.v1alpha1.TransformersService.GetSystemTransformers.
@@ -236,6 +263,16 @@ func (c *transformersServiceClient) ValidateUserRegexCode(ctx context.Context, r
 	return c.validateUserRegexCode.CallUnary(ctx, req)
 }
 
+// ValidateTransformJsonConfig calls mgmt.v1alpha1.TransformersService.ValidateTransformJsonConfig.
+func (c *transformersServiceClient) ValidateTransformJsonConfig(ctx context.Context, req *connect.Request[v1alpha1.ValidateTransformJsonConfigRequest]) (*connect.Response[v1alpha1.ValidateTransformJsonConfigResponse], error) {
+	return c.validateTransformJsonConfig.CallUnary(ctx, req)
+}
+
+// DecryptFormatPreserving calls mgmt.v1alpha1.TransformersService.DecryptFormatPreserving.
+func (c *transformersServiceClient) DecryptFormatPreserving(ctx context.Context, req *connect.Request[v1alpha1.DecryptFormatPreservingRequest]) (*connect.Response[v1alpha1.DecryptFormatPreservingResponse], error) {
+	return c.decryptFormatPreserving.CallUnary(ctx, req)
+}
+
 // TransformersServiceHandler is an implementation of the mgmt.v1alpha1.TransformersService service.
 type TransformersServiceHandler interface {
 	GetSystemTransformers(context.Context, *connect.Request[v1alpha1.GetSystemTransformersRequest]) (*connect.Response[v1alpha1.GetSystemTransformersResponse], error)
@@ -248,6 +285,11 @@ type TransformersServiceHandler interface {
 	IsTransformerNameAvailable(context.Context, *connect.Request[v1alpha1.IsTransformerNameAvailableRequest]) (*connect.Response[v1alpha1.IsTransformerNameAvailableResponse], error)
 	ValidateUserJavascriptCode(context.Context, *connect.Request[v1alpha1.ValidateUserJavascriptCodeRequest]) (*connect.Response[v1alpha1.ValidateUserJavascriptCodeResponse], error)
 	ValidateUserRegexCode(context.Context, *connect.Request[v1alpha1.ValidateUserRegexCodeRequest]) (*connect.Response[v1alpha1.ValidateUserRegexCodeResponse], error)
+	// Validates the JSONPath expressions and nested transformers of a transform json config
+	ValidateTransformJsonConfig(context.Context, *connect.Request[v1alpha1.ValidateTransformJsonConfigRequest]) (*connect.Response[v1alpha1.ValidateTransformJsonConfigResponse], error)
+	// Decrypts a value that was encrypted by the format preserving encrypt transformer using the account's transformer key.
+	// Values that were encrypted before the transformer key was changed can not be decrypted.
+	DecryptFormatPreserving(context.Context, *connect.Request[v1alpha1.DecryptFormatPreservingRequest]) (*connect.Response[v1alpha1.DecryptFormatPreservingResponse], error)
 }
 
 // NewTransformersServiceHandler builds an HTTP handler from the service implementation. It returns
@@ -316,6 +358,18 @@ func NewTransformersServiceHandler(svc TransformersServiceHandler, opts ...conne
 		connect.WithSchema(transformersServiceValidateUserRegexCodeMethodDescriptor),
 		connect.WithHandlerOptions(opts...),
 	)
+	transformersServiceValidateTransformJsonConfigHandler := connect.NewUnaryHandler(
+		TransformersServiceValidateTransformJsonConfigProcedure,
+		svc.ValidateTransformJsonConfig,
+		connect.WithSchema(transformersServiceValidateTransformJsonConfigMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	transformersServiceDecryptFormatPreservingHandler := connect.NewUnaryHandler(
+		TransformersServiceDecryptFormatPreservingProcedure,
+		svc.DecryptFormatPreserving,
+		connect.WithSchema(transformersServiceDecryptFormatPreservingMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
 	return "/mgmt.v1alpha1.TransformersService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case TransformersServiceGetSystemTransformersProcedure:
@@ -338,6 +392,10 @@ func NewTransformersServiceHandler(svc TransformersServiceHandler, opts ...conne
 			transformersServiceValidateUserJavascriptCodeHandler.ServeHTTP(w, r)
 		case TransformersServiceValidateUserRegexCodeProcedure:
 			transformersServiceValidateUserRegexCodeHandler.ServeHTTP(w, r)
+		case TransformersServiceValidateTransformJsonConfigProcedure:
+			transformersServiceValidateTransformJsonConfigHandler.ServeHTTP(w, r)
+		case TransformersServiceDecryptFormatPreservingProcedure:
+			transformersServiceDecryptFormatPreservingHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -386,3 +444,11 @@ func (UnimplementedTransformersServiceHandler) ValidateUserJavascriptCode(contex
 func (UnimplementedTransformersServiceHandler) ValidateUserRegexCode(context.Context, *connect.Request[v1alpha1.ValidateUserRegexCodeRequest]) (*connect.Response[v1alpha1.ValidateUserRegexCodeResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mgmt.v1alpha1.TransformersService.ValidateUserRegexCode is not implemented"))
 }
+
+func (UnimplementedTransformersServiceHandler) ValidateTransformJsonConfig(context.Context, *connect.Request[v1alpha1.ValidateTransformJsonConfigRequest]) (*connect.Response[v1alpha1.ValidateTransformJsonConfigResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mgmt.v1alpha1.TransformersService.ValidateTransformJsonConfig is not implemented"))
+}
+
+func (UnimplementedTransformersServiceHandler) DecryptFormatPreserving(context.Context, *connect.Request[v1alpha1.DecryptFormatPreservingRequest]) (*connect.Response[v1alpha1.DecryptFormatPreservingResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mgmt.v1alpha1.TransformersService.DecryptFormatPreserving is not implemented"))
+}
@@ -60,12 +60,21 @@ const (
 	// UserAccountServiceSetAccountTemporalConfigProcedure is the fully-qualified name of the
 	// UserAccountService's SetAccountTemporalConfig RPC.
 	UserAccountServiceSetAccountTemporalConfigProcedure = "/mgmt.v1alpha1.UserAccountService/SetAccountTemporalConfig"
+	// UserAccountServiceGetAccountTransformerKeyProcedure is the fully-qualified name of the
+	// UserAccountService's GetAccountTransformerKey RPC.
+	UserAccountServiceGetAccountTransformerKeyProcedure = "/mgmt.v1alpha1.UserAccountService/GetAccountTransformerKey"
+	// UserAccountServiceSetAccountTransformerKeyProcedure is the fully-qualified name of the
+	// UserAccountService's SetAccountTransformerKey RPC.
+	UserAccountServiceSetAccountTransformerKeyProcedure = "/mgmt.v1alpha1.UserAccountService/SetAccountTransformerKey"
 	// UserAccountServiceGetTeamAccountMembersProcedure is the fully-qualified name of the
 	// UserAccountService's GetTeamAccountMembers RPC.
 	UserAccountServiceGetTeamAccountMembersProcedure = "/mgmt.v1alpha1.UserAccountService/GetTeamAccountMembers"
 	// UserAccountServiceRemoveTeamAccountMemberProcedure is the fully-qualified name of the
 	// UserAccountService's RemoveTeamAccountMember RPC.
 	UserAccountServiceRemoveTeamAccountMemberProcedure = "/mgmt.v1alpha1.UserAccountService/RemoveTeamAccountMember"
+	// UserAccountServiceSetTeamAccountMemberRoleProcedure is the fully-qualified name of the
+	// UserAccountService's SetTeamAccountMemberRole RPC.
+	UserAccountServiceSetTeamAccountMemberRoleProcedure = "/mgmt.v1alpha1.UserAccountService/SetTeamAccountMemberRole"
 	// UserAccountServiceInviteUserToTeamAccountProcedure is the fully-qualified name of the
 	// UserAccountService's InviteUserToTeamAccount RPC.
 	UserAccountServiceInviteUserToTeamAccountProcedure = "/mgmt.v1alpha1.UserAccountService/InviteUserToTeamAccount"
@@ -101,8 +110,11 @@ var (
 	userAccountServiceIsUserInAccountMethodDescriptor              = userAccountServiceServiceDescriptor.Methods().ByName("IsUserInAccount")
 	userAccountServiceGetAccountTemporalConfigMethodDescriptor     = userAccountServiceServiceDescriptor.Methods().ByName("GetAccountTemporalConfig")
 	userAccountServiceSetAccountTemporalConfigMethodDescriptor     = userAccountServiceServiceDescriptor.Methods().ByName("SetAccountTemporalConfig")
+	userAccountServiceGetAccountTransformerKeyMethodDescriptor     = userAccountServiceServiceDescriptor.Methods().ByName("GetAccountTransformerKey")
+	userAccountServiceSetAccountTransformerKeyMethodDescriptor     = userAccountServiceServiceDescriptor.Methods().ByName("SetAccountTransformerKey")
 	userAccountServiceGetTeamAccountMembersMethodDescriptor        = userAccountServiceServiceDescriptor.Methods().ByName("GetTeamAccountMembers")
 	userAccountServiceRemoveTeamAccountMemberMethodDescriptor      = userAccountServiceServiceDescriptor.Methods().ByName("RemoveTeamAccountMember")
+	userAccountServiceSetTeamAccountMemberRoleMethodDescriptor     = userAccountServiceServiceDescriptor.Methods().ByName("SetTeamAccountMemberRole")
 	userAccountServiceInviteUserToTeamAccountMethodDescriptor      = userAccountServiceServiceDescriptor.Methods().ByName("InviteUserToTeamAccount")
 	userAccountServiceGetTeamAccountInvitesMethodDescriptor        = userAccountServiceServiceDescriptor.Methods().ByName("GetTeamAccountInvites")
 	userAccountServiceRemoveTeamAccountInviteMethodDescriptor      = userAccountServiceServiceDescriptor.Methods().ByName("RemoveTeamAccountInvite")
@@ -123,8 +135,11 @@ type UserAccountServiceClient interface {
 	IsUserInAccount(context.Context, *connect.Request[v1alpha1.IsUserInAccountRequest]) (*connect.Response[v1alpha1.IsUserInAccountResponse], error)
 	GetAccountTemporalConfig(context.Context, *connect.Request[v1alpha1.GetAccountTemporalConfigRequest]) (*connect.Response[v1alpha1.GetAccountTemporalConfigResponse], error)
 	SetAccountTemporalConfig(context.Context, *connect.Request[v1alpha1.SetAccountTemporalConfigRequest]) (*connect.Response[v1alpha1.SetAccountTemporalConfigResponse], error)
+	GetAccountTransformerKey(context.Context, *connect.Request[v1alpha1.GetAccountTransformerKeyRequest]) (*connect.Response[v1alpha1.GetAccountTransformerKeyResponse], error)
+	SetAccountTransformerKey(context.Context, *connect.Request[v1alpha1.SetAccountTransformerKeyRequest]) (*connect.Response[v1alpha1.SetAccountTransformerKeyResponse], error)
 	GetTeamAccountMembers(context.Context, *connect.Request[v1alpha1.GetTeamAccountMembersRequest]) (*connect.Response[v1alpha1.GetTeamAccountMembersResponse], error)
 	RemoveTeamAccountMember(context.Context, *connect.Request[v1alpha1.RemoveTeamAccountMemberRequest]) (*connect.Response[v1alpha1.RemoveTeamAccountMemberResponse], error)
+	SetTeamAccountMemberRole(context.Context, *connect.Request[v1alpha1.SetTeamAccountMemberRoleRequest]) (*connect.Response[v1alpha1.SetTeamAccountMemberRoleResponse], error)
 	InviteUserToTeamAccount(context.Context, *connect.Request[v1alpha1.InviteUserToTeamAccountRequest]) (*connect.Response[v1alpha1.InviteUserToTeamAccountResponse], error)
 	GetTeamAccountInvites(context.Context, *connect.Request[v1alpha1.GetTeamAccountInvitesRequest]) (*connect.Response[v1alpha1.GetTeamAccountInvitesResponse], error)
 	RemoveTeamAccountInvite(context.Context, *connect.Request[v1alpha1.RemoveTeamAccountInviteRequest]) (*connect.Response[v1alpha1.RemoveTeamAccountInviteResponse], error)
@@ -198,6 +213,18 @@ func NewUserAccountServiceClient(httpClient connect.HTTPClient, baseURL string,
 			connect.WithSchema(userAccountServiceSetAccountTemporalConfigMethodDescriptor),
 			connect.WithClientOptions(opts...),
 		),
+		getAccountTransformerKey: connect.NewClient[v1alpha1.GetAccountTransformerKeyRequest, v1alpha1.GetAccountTransformerKeyResponse](
+			httpClient,
+			baseURL+UserAccountServiceGetAccountTransformerKeyProcedure,
+			connect.WithSchema(userAccountServiceGetAccountTransformerKeyMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		setAccountTransformerKey: connect.NewClient[v1alpha1.SetAccountTransformerKeyRequest, v1alpha1.SetAccountTransformerKeyResponse](
+			httpClient,
+			baseURL+UserAccountServiceSetAccountTransformerKeyProcedure,
+			connect.WithSchema(userAccountServiceSetAccountTransformerKeyMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
 		getTeamAccountMembers: connect.NewClient[v1alpha1.GetTeamAccountMembersRequest, v1alpha1.GetTeamAccountMembersResponse](
 			httpClient,
 			baseURL+UserAccountServiceGetTeamAccountMembersProcedure,
@@ -210,6 +237,12 @@ func NewUserAccountServiceClient(httpClient connect.HTTPClient, baseURL string,
 			connect.WithSchema(userAccountServiceRemoveTeamAccountMemberMethodDescriptor),
 			connect.WithClientOptions(opts...),
 		),
+		setTeamAccountMemberRole: connect.NewClient[v1alpha1.SetTeamAccountMemberRoleRequest, v1alpha1.SetTeamAccountMemberRoleResponse](
+			httpClient,
+			baseURL+UserAccountServiceSetTeamAccountMemberRoleProcedure,
+			connect.WithSchema(userAccountServiceSetTeamAccountMemberRoleMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
 		inviteUserToTeamAccount: connect.NewClient[v1alpha1.InviteUserToTeamAccountRequest, v1alpha1.InviteUserToTeamAccountResponse](
 			httpClient,
 			baseURL+UserAccountServiceInviteUserToTeamAccountProcedure,
@@ -266,8 +299,11 @@ type userAccountServiceClient struct {
 	isUserInAccount              *connect.Client[v1alpha1.IsUserInAccountRequest, v1alpha1.IsUserInAccountResponse]
 	getAccountTemporalConfig     *connect.Client[v1alpha1.GetAccountTemporalConfigRequest, v1alpha1.GetAccountTemporalConfigResponse]
 	setAccountTemporalConfig     *connect.Client[v1alpha1.SetAccountTemporalConfigRequest, v1alpha1.SetAccountTemporalConfigResponse]
+	getAccountTransformerKey     *connect.Client[v1alpha1.GetAccountTransformerKeyRequest, v1alpha1.GetAccountTransformerKeyResponse]
+	setAccountTransformerKey     *connect.Client[v1alpha1.SetAccountTransformerKeyRequest, v1alpha1.SetAccountTransformerKeyResponse]
 	getTeamAccountMembers        *connect.Client[v1alpha1.GetTeamAccountMembersRequest, v1alpha1.GetTeamAccountMembersResponse]
 	removeTeamAccountMember      *connect.Client[v1alpha1.RemoveTeamAccountMemberRequest, v1alpha1.RemoveTeamAccountMemberResponse]
+	setTeamAccountMemberRole     *connect.Client[v1alpha1.SetTeamAccountMemberRoleRequest, v1alpha1.SetTeamAccountMemberRoleResponse]
 	inviteUserToTeamAccount      *connect.Client[v1alpha1.InviteUserToTeamAccountRequest, v1alpha1.InviteUserToTeamAccountResponse]
 	getTeamAccountInvites        *connect.Client[v1alpha1.GetTeamAccountInvitesRequest, v1alpha1.GetTeamAccountInvitesResponse]
 	removeTeamAccountInvite      *connect.Client[v1alpha1.RemoveTeamAccountInviteRequest, v1alpha1.RemoveTeamAccountInviteResponse]
@@ -322,6 +358,16 @@ func (c *userAccountServiceClient) SetAccountTemporalConfig(ctx context.Context,
 	return c.setAccountTemporalConfig.CallUnary(ctx, req)
 }
 
+// GetAccountTransformerKey calls mgmt.v1alpha1.UserAccountService.GetAccountTransformerKey.
+func (c *userAccountServiceClient) GetAccountTransformerKey(ctx context.Context, req *connect.Request[v1alpha1.GetAccountTransformerKeyRequest]) (*connect.Response[v1alpha1.GetAccountTransformerKeyResponse], error) {
+	return c.getAccountTransformerKey.CallUnary(ctx, req)
+}
+
+// SetAccountTransformerKey calls mgmt.v1alpha1.UserAccountService.SetAccountTransformerKey.
+func (c *userAccountServiceClient) SetAccountTransformerKey(ctx context.Context, req *connect.Request[v1alpha1.SetAccountTransformerKeyRequest]) (*connect.Response[v1alpha1.SetAccountTransformerKeyResponse], error) {
+	return c.setAccountTransformerKey.CallUnary(ctx, req)
+}
+
 // GetTeamAccountMembers calls mgmt.v1alpha1.UserAccountService.GetTeamAccountMembers.
 func (c *userAccountServiceClient) GetTeamAccountMembers(ctx context.Context, req *connect.Request[v1alpha1.GetTeamAccountMembersRequest]) (*connect.Response[v1alpha1.GetTeamAccountMembersResponse], error) {
 	return c.getTeamAccountMembers.CallUnary(ctx, req)
@@ -332,6 +378,11 @@ func (c *userAccountServiceClient) RemoveTeamAccountMember(ctx context.Context,
 	return c.removeTeamAccountMember.CallUnary(ctx, req)
 }
 
+// SetTeamAccountMemberRole calls mgmt.v1alpha1.UserAccountService.SetTeamAccountMemberRole.
+func (c *userAccountServiceClient) SetTeamAccountMemberRole(ctx context.Context, req *connect.Request[v1alpha1.SetTeamAccountMemberRoleRequest]) (*connect.Response[v1alpha1.SetTeamAccountMemberRoleResponse], error) {
+	return c.setTeamAccountMemberRole.CallUnary(ctx, req)
+}
+
 // InviteUserToTeamAccount calls mgmt.v1alpha1.UserAccountService.InviteUserToTeamAccount.
 func (c *userAccountServiceClient) InviteUserToTeamAccount(ctx context.Context, req *connect.Request[v1alpha1.InviteUserToTeamAccountRequest]) (*connect.Response[v1alpha1.InviteUserToTeamAccountResponse], error) {
 	return c.inviteUserToTeamAccount.CallUnary(ctx, req)
@@ -378,8 +429,11 @@ type UserAccountServiceHandler interface {
 	IsUserInAccount(context.Context, *connect.Request[v1alpha1.IsUserInAccountRequest]) (*connect.Response[v1alpha1.IsUserInAccountResponse], error)
 	GetAccountTemporalConfig(context.Context, *connect.Request[v1alpha1.GetAccountTemporalConfigRequest]) (*connect.Response[v1alpha1.GetAccountTemporalConfigResponse], error)
 	SetAccountTemporalConfig(context.Context, *connect.Request[v1alpha1.SetAccountTemporalConfigRequest]) (*connect.Response[v1alpha1.SetAccountTemporalConfigResponse], error)
+	GetAccountTransformerKey(context.Context, *connect.Request[v1alpha1.GetAccountTransformerKeyRequest]) (*connect.Response[v1alpha1.GetAccountTransformerKeyResponse], error)
+	SetAccountTransformerKey(context.Context, *connect.Request[v1alpha1.SetAccountTransformerKeyRequest]) (*connect.Response[v1alpha1.SetAccountTransformerKeyResponse], error)
 	GetTeamAccountMembers(context.Context, *connect.Request[v1alpha1.GetTeamAccountMembersRequest]) (*connect.Response[v1alpha1.GetTeamAccountMembersResponse], error)
 	RemoveTeamAccountMember(context.Context, *connect.Request[v1alpha1.RemoveTeamAccountMemberRequest]) (*connect.Response[v1alpha1.RemoveTeamAccountMemberResponse], error)
+	SetTeamAccountMemberRole(context.Context, *connect.Request[v1alpha1.SetTeamAccountMemberRoleRequest]) (*connect.Response[v1alpha1.SetTeamAccountMemberRoleResponse], error)
 	InviteUserToTeamAccount(context.Context, *connect.Request[v1alpha1.InviteUserToTeamAccountRequest]) (*connect.Response[v1alpha1.InviteUserToTeamAccountResponse], error)
 	GetTeamAccountInvites(context.Context, *connect.Request[v1alpha1.GetTeamAccountInvitesRequest]) (*connect.Response[v1alpha1.GetTeamAccountInvitesResponse], error)
 	RemoveTeamAccountInvite(context.Context, *connect.Request[v1alpha1.RemoveTeamAccountInviteRequest]) (*connect.Response[v1alpha1.RemoveTeamAccountInviteResponse], error)
@@ -449,6 +503,18 @@ func NewUserAccountServiceHandler(svc UserAccountServiceHandler, opts ...connect
 		connect.WithSchema(userAccountServiceSetAccountTemporalConfigMethodDescriptor),
 		connect.WithHandlerOptions(opts...),
 	)
+	userAccountServiceGetAccountTransformerKeyHandler := connect.NewUnaryHandler(
+		UserAccountServiceGetAccountTransformerKeyProcedure,
+		svc.GetAccountTransformerKey,
+		connect.WithSchema(userAccountServiceGetAccountTransformerKeyMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	userAccountServiceSetAccountTransformerKeyHandler := connect.NewUnaryHandler(
+		UserAccountServiceSetAccountTransformerKeyProcedure,
+		svc.SetAccountTransformerKey,
+		connect.WithSchema(userAccountServiceSetAccountTransformerKeyMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
 	userAccountServiceGetTeamAccountMembersHandler := connect.NewUnaryHandler(
 		UserAccountServiceGetTeamAccountMembersProcedure,
 		svc.GetTeamAccountMembers,
@@ -461,6 +527,12 @@ func NewUserAccountServiceHandler(svc UserAccountServiceHandler, opts ...connect
 		connect.WithSchema(userAccountServiceRemoveTeamAccountMemberMethodDescriptor),
 		connect.WithHandlerOptions(opts...),
 	)
+	userAccountServiceSetTeamAccountMemberRoleHandler := connect.NewUnaryHandler(
+		UserAccountServiceSetTeamAccountMemberRoleProcedure,
+		svc.SetTeamAccountMemberRole,
+		connect.WithSchema(userAccountServiceSetTeamAccountMemberRoleMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
 	userAccountServiceInviteUserToTeamAccountHandler := connect.NewUnaryHandler(
 		UserAccountServiceInviteUserToTeamAccountProcedure,
 		svc.InviteUserToTeamAccount,
@@ -523,10 +595,16 @@ func NewUserAccountServiceHandler(svc UserAccountServiceHandler, opts ...connect
 			userAccountServiceGetAccountTemporalConfigHandler.ServeHTTP(w, r)
 		case UserAccountServiceSetAccountTemporalConfigProcedure:
 			userAccountServiceSetAccountTemporalConfigHandler.ServeHTTP(w, r)
+		case UserAccountServiceGetAccountTransformerKeyProcedure:
+			userAccountServiceGetAccountTransformerKeyHandler.ServeHTTP(w, r)
+		case UserAccountServiceSetAccountTransformerKeyProcedure:
+			userAccountServiceSetAccountTransformerKeyHandler.ServeHTTP(w, r)
 		case UserAccountServiceGetTeamAccountMembersProcedure:
 			userAccountServiceGetTeamAccountMembersHandler.ServeHTTP(w, r)
 		case UserAccountServiceRemoveTeamAccountMemberProcedure:
 			userAccountServiceRemoveTeamAccountMemberHandler.ServeHTTP(w, r)
+		case UserAccountServiceSetTeamAccountMemberRoleProcedure:
+			userAccountServiceSetTeamAccountMemberRoleHandler.ServeHTTP(w, r)
 		case UserAccountServiceInviteUserToTeamAccountProcedure:
 			userAccountServiceInviteUserToTeamAccountHandler.ServeHTTP(w, r)
 		case UserAccountServiceGetTeamAccountInvitesProcedure:
@@ -586,6 +664,14 @@ func (UnimplementedUserAccountServiceHandler) SetAccountTemporalConfig(context.C
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mgmt.v1alpha1.UserAccountService.SetAccountTemporalConfig is not implemented"))
 }
 
+func (UnimplementedUserAccountServiceHandler) GetAccountTransformerKey(context.Context, *connect.Request[v1alpha1.GetAccountTransformerKeyRequest]) (*connect.Response[v1alpha1.GetAccountTransformerKeyResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mgmt.v1alpha1.UserAccountService.GetAccountTransformerKey is not implemented"))
+}
+
+func (UnimplementedUserAccountServiceHandler) SetAccountTransformerKey(context.Context, *connect.Request[v1alpha1.SetAccountTransformerKeyRequest]) (*connect.Response[v1alpha1.SetAccountTransformerKeyResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mgmt.v1alpha1.UserAccountService.SetAccountTransformerKey is not implemented"))
+}
+
 func (UnimplementedUserAccountServiceHandler) GetTeamAccountMembers(context.Context, *connect.Request[v1alpha1.GetTeamAccountMembersRequest]) (*connect.Response[v1alpha1.GetTeamAccountMembersResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mgmt.v1alpha1.UserAccountService.GetTeamAccountMembers is not implemented"))
 }
@@ -594,6 +680,10 @@ func (UnimplementedUserAccountServiceHandler) RemoveTeamAccountMember(context.Co
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mgmt.v1alpha1.UserAccountService.RemoveTeamAccountMember is not implemented"))
 }
 
+func (UnimplementedUserAccountServiceHandler) SetTeamAccountMemberRole(context.Context, *connect.Request[v1alpha1.SetTeamAccountMemberRoleRequest]) (*connect.Response[v1alpha1.SetTeamAccountMemberRoleResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mgmt.v1alpha1.UserAccountService.SetTeamAccountMemberRole is not implemented"))
+}
+
 func (UnimplementedUserAccountServiceHandler) InviteUserToTeamAccount(context.Context, *connect.Request[v1alpha1.InviteUserToTeamAccountRequest]) (*connect.Response[v1alpha1.InviteUserToTeamAccountResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mgmt.v1alpha1.UserAccountService.InviteUserToTeamAccount is not implemented"))
 }
@@ -0,0 +1,307 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: mgmt/v1alpha1/webhook.proto
+
+package mgmtv1alpha1connect
+
+import (
+	connect "connectrpc.com/connect"
+	context "context"
+	errors "errors"
+	v1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
+	http "net/http"
+	strings "strings"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// WebhookServiceName is the fully-qualified name of the WebhookService service.
+	WebhookServiceName = "mgmt.v1alpha1.WebhookService"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// WebhookServiceGetWebhooksProcedure is the fully-qualified name of the WebhookService's
+	// GetWebhooks RPC.
+	WebhookServiceGetWebhooksProcedure = "/mgmt.v1alpha1.WebhookService/GetWebhooks"
+	// WebhookServiceGetWebhookProcedure is the fully-qualified name of the WebhookService's GetWebhook
+	// RPC.
+	WebhookServiceGetWebhookProcedure = "/mgmt.v1alpha1.WebhookService/GetWebhook"
+	// WebhookServiceCreateWebhookProcedure is the fully-qualified name of the WebhookService's
+	// CreateWebhook RPC.
+	WebhookServiceCreateWebhookProcedure = "/mgmt.v1alpha1.WebhookService/CreateWebhook"
+	// WebhookServiceUpdateWebhookProcedure is the fully-qualified name of the WebhookService's
+	// UpdateWebhook RPC.
+	WebhookServiceUpdateWebhookProcedure = "/mgmt.v1alpha1.WebhookService/UpdateWebhook"
+	// WebhookServiceDeleteWebhookProcedure is the fully-qualified name of the WebhookService's
+	// DeleteWebhook RPC.
+	WebhookServiceDeleteWebhookProcedure = "/mgmt.v1alpha1.WebhookService/DeleteWebhook"
+	// WebhookServiceGetWebhookDeliveriesProcedure is the fully-qualified name of the WebhookService's
+	// GetWebhookDeliveries RPC.
+	WebhookServiceGetWebhookDeliveriesProcedure = "/mgmt.v1alpha1.WebhookService/GetWebhookDeliveries"
+	// WebhookServicePublishJobRunEventProcedure is the fully-qualified name of the WebhookService's
+	// PublishJobRunEvent RPC.
+	WebhookServicePublishJobRunEventProcedure = "/mgmt.v1alpha1.WebhookService/PublishJobRunEvent"
+)
+
+// These variables are the protoreflect.Descriptor objects for the RPCs defined in this package.
+var (
+	webhookServiceServiceDescriptor                    = v1alpha1.File_mgmt_v1alpha1_webhook_proto.Services().ByName("WebhookService")
+	webhookServiceGetWebhooksMethodDescriptor          = webhookServiceServiceDescriptor.Methods().ByName("GetWebhooks")
+	webhookServiceGetWebhookMethodDescriptor           = webhookServiceServiceDescriptor.Methods().ByName("GetWebhook")
+	webhookServiceCreateWebhookMethodDescriptor        = webhookServiceServiceDescriptor.Methods().ByName("CreateWebhook")
+	webhookServiceUpdateWebhookMethodDescriptor        = webhookServiceServiceDescriptor.Methods().ByName("UpdateWebhook")
+	webhookServiceDeleteWebhookMethodDescriptor        = webhookServiceServiceDescriptor.Methods().ByName("DeleteWebhook")
+	webhookServiceGetWebhookDeliveriesMethodDescriptor = webhookServiceServiceDescriptor.Methods().ByName("GetWebhookDeliveries")
+	webhookServicePublishJobRunEventMethodDescriptor   = webhookServiceServiceDescriptor.Methods().ByName("PublishJobRunEvent")
+)
+
+// WebhookServiceClient is a client for the mgmt.v1alpha1.WebhookService service.
+type WebhookServiceClient interface {
+	// Retrieves the webhooks of an account
+	GetWebhooks(context.Context, *connect.Request[v1alpha1.GetWebhooksRequest]) (*connect.Response[v1alpha1.GetWebhooksResponse], error)
+	// Retrieves a single webhook
+	GetWebhook(context.Context, *connect.Request[v1alpha1.GetWebhookRequest]) (*connect.Response[v1alpha1.GetWebhookResponse], error)
+	// Creates a webhook. This method returns the secret that payloads are signed with
+	CreateWebhook(context.Context, *connect.Request[v1alpha1.CreateWebhookRequest]) (*connect.Response[v1alpha1.CreateWebhookResponse], error)
+	// Updates a webhook. This method returns the secret if it was changed
+	UpdateWebhook(context.Context, *connect.Request[v1alpha1.UpdateWebhookRequest]) (*connect.Response[v1alpha1.UpdateWebhookResponse], error)
+	// Deletes a webhook along with its delivery log
+	DeleteWebhook(context.Context, *connect.Request[v1alpha1.DeleteWebhookRequest]) (*connect.Response[v1alpha1.DeleteWebhookResponse], error)
+	// Returns the most recent attempts to deliver events to a webhook
+	GetWebhookDeliveries(context.Context, *connect.Request[v1alpha1.GetWebhookDeliveriesRequest]) (*connect.Response[v1alpha1.GetWebhookDeliveriesResponse], error)
+	// Sends a job run event to the webhooks of the job's account. Called by the worker while a job runs
+	PublishJobRunEvent(context.Context, *connect.Request[v1alpha1.PublishJobRunEventRequest]) (*connect.Response[v1alpha1.PublishJobRunEventResponse], error)
+}
+
+// NewWebhookServiceClient constructs a client for the mgmt.v1alpha1.WebhookService service. By
+// default, it uses the Connect protocol with the binary Protobuf Codec, asks for gzipped responses,
+// and sends uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the
+// connect.WithGRPC() or connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewWebhookServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) WebhookServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	return &webhookServiceClient{
+		getWebhooks: connect.NewClient[v1alpha1.GetWebhooksRequest, v1alpha1.GetWebhooksResponse](
+			httpClient,
+			baseURL+WebhookServiceGetWebhooksProcedure,
+			connect.WithSchema(webhookServiceGetWebhooksMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		getWebhook: connect.NewClient[v1alpha1.GetWebhookRequest, v1alpha1.GetWebhookResponse](
+			httpClient,
+			baseURL+WebhookServiceGetWebhookProcedure,
+			connect.WithSchema(webhookServiceGetWebhookMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		createWebhook: connect.NewClient[v1alpha1.CreateWebhookRequest, v1alpha1.CreateWebhookResponse](
+			httpClient,
+			baseURL+WebhookServiceCreateWebhookProcedure,
+			connect.WithSchema(webhookServiceCreateWebhookMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		updateWebhook: connect.NewClient[v1alpha1.UpdateWebhookRequest, v1alpha1.UpdateWebhookResponse](
+			httpClient,
+			baseURL+WebhookServiceUpdateWebhookProcedure,
+			connect.WithSchema(webhookServiceUpdateWebhookMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		deleteWebhook: connect.NewClient[v1alpha1.DeleteWebhookRequest, v1alpha1.DeleteWebhookResponse](
+			httpClient,
+			baseURL+WebhookServiceDeleteWebhookProcedure,
+			connect.WithSchema(webhookServiceDeleteWebhookMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		getWebhookDeliveries: connect.NewClient[v1alpha1.GetWebhookDeliveriesRequest, v1alpha1.GetWebhookDeliveriesResponse](
+			httpClient,
+			baseURL+WebhookServiceGetWebhookDeliveriesProcedure,
+			connect.WithSchema(webhookServiceGetWebhookDeliveriesMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		publishJobRunEvent: connect.NewClient[v1alpha1.PublishJobRunEventRequest, v1alpha1.PublishJobRunEventResponse](
+			httpClient,
+			baseURL+WebhookServicePublishJobRunEventProcedure,
+			connect.WithSchema(webhookServicePublishJobRunEventMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// webhookServiceClient implements WebhookServiceClient.
+type webhookServiceClient struct {
+	getWebhooks          *connect.Client[v1alpha1.GetWebhooksRequest, v1alpha1.GetWebhooksResponse]
+	getWebhook           *connect.Client[v1alpha1.GetWebhookRequest, v1alpha1.GetWebhookResponse]
+	createWebhook        *connect.Client[v1alpha1.CreateWebhookRequest, v1alpha1.CreateWebhookResponse]
+	updateWebhook        *connect.Client[v1alpha1.UpdateWebhookRequest, v1alpha1.UpdateWebhookResponse]
+	deleteWebhook        *connect.Client[v1alpha1.DeleteWebhookRequest, v1alpha1.DeleteWebhookResponse]
+	getWebhookDeliveries *connect.Client[v1alpha1.GetWebhookDeliveriesRequest, v1alpha1.GetWebhookDeliveriesResponse]
+	publishJobRunEvent   *connect.Client[v1alpha1.PublishJobRunEventRequest, v1alpha1.PublishJobRunEventResponse]
+}
+
+// GetWebhooks calls mgmt.v1alpha1.WebhookService.GetWebhooks.
+func (c *webhookServiceClient) GetWebhooks(ctx context.Context, req *connect.Request[v1alpha1.GetWebhooksRequest]) (*connect.Response[v1alpha1.GetWebhooksResponse], error) {
+	return c.getWebhooks.CallUnary(ctx, req)
+}
+
+// GetWebhook calls mgmt.v1alpha1.WebhookService.GetWebhook.
+func (c *webhookServiceClient) GetWebhook(ctx context.Context, req *connect.Request[v1alpha1.GetWebhookRequest]) (*connect.Response[v1alpha1.GetWebhookResponse], error) {
+	return c.getWebhook.CallUnary(ctx, req)
+}
+
+// CreateWebhook calls mgmt.v1alpha1.WebhookService.CreateWebhook.
+func (c *webhookServiceClient) CreateWebhook(ctx context.Context, req *connect.Request[v1alpha1.CreateWebhookRequest]) (*connect.Response[v1alpha1.CreateWebhookResponse], error) {
+	return c.createWebhook.CallUnary(ctx, req)
+}
+
+// UpdateWebhook calls mgmt.v1alpha1.WebhookService.UpdateWebhook.
+func (c *webhookServiceClient) UpdateWebhook(ctx context.Context, req *connect.Request[v1alpha1.UpdateWebhookRequest]) (*connect.Response[v1alpha1.UpdateWebhookResponse], error) {
+	return c.updateWebhook.CallUnary(ctx, req)
+}
+
+// DeleteWebhook calls mgmt.v1alpha1.WebhookService.DeleteWebhook.
+func (c *webhookServiceClient) DeleteWebhook(ctx context.Context, req *connect.Request[v1alpha1.DeleteWebhookRequest]) (*connect.Response[v1alpha1.DeleteWebhookResponse], error) {
+	return c.deleteWebhook.CallUnary(ctx, req)
+}
+
+// GetWebhookDeliveries calls mgmt.v1alpha1.WebhookService.GetWebhookDeliveries.
+func (c *webhookServiceClient) GetWebhookDeliveries(ctx context.Context, req *connect.Request[v1alpha1.GetWebhookDeliveriesRequest]) (*connect.Response[v1alpha1.GetWebhookDeliveriesResponse], error) {
+	return c.getWebhookDeliveries.CallUnary(ctx, req)
+}
+
+// PublishJobRunEvent calls mgmt.v1alpha1.WebhookService.PublishJobRunEvent.
+func (c *webhookServiceClient) PublishJobRunEvent(ctx context.Context, req *connect.Request[v1alpha1.PublishJobRunEventRequest]) (*connect.Response[v1alpha1.PublishJobRunEventResponse], error) {
+	return c.publishJobRunEvent.CallUnary(ctx, req)
+}
+
+// WebhookServiceHandler is an implementation of the mgmt.v1alpha1.WebhookService service.
+type WebhookServiceHandler interface {
+	// Retrieves the webhooks of an account
+	GetWebhooks(context.Context, *connect.Request[v1alpha1.GetWebhooksRequest]) (*connect.Response[v1alpha1.GetWebhooksResponse], error)
+	// Retrieves a single webhook
+	GetWebhook(context.Context, *connect.Request[v1alpha1.GetWebhookRequest]) (*connect.Response[v1alpha1.GetWebhookResponse], error)
+	// Creates a webhook. This method returns the secret that payloads are signed with
+	CreateWebhook(context.Context, *connect.Request[v1alpha1.CreateWebhookRequest]) (*connect.Response[v1alpha1.CreateWebhookResponse], error)
+	// Updates a webhook. This method returns the secret if it was changed
+	UpdateWebhook(context.Context, *connect.Request[v1alpha1.UpdateWebhookRequest]) (*connect.Response[v1alpha1.UpdateWebhookResponse], error)
+	// Deletes a webhook along with its delivery log
+	DeleteWebhook(context.Context, *connect.Request[v1alpha1.DeleteWebhookRequest]) (*connect.Response[v1alpha1.DeleteWebhookResponse], error)
+	// Returns the most recent attempts to deliver events to a webhook
+	GetWebhookDeliveries(context.Context, *connect.Request[v1alpha1.GetWebhookDeliveriesRequest]) (*connect.Response[v1alpha1.GetWebhookDeliveriesResponse], error)
+	// Sends a job run event to the webhooks of the job's account. Called by the worker while a job runs
+	PublishJobRunEvent(context.Context, *connect.Request[v1alpha1.PublishJobRunEventRequest]) (*connect.Response[v1alpha1.PublishJobRunEventResponse], error)
+}
+
+// NewWebhookServiceHandler builds an HTTP handler from the service implementation. It returns the
+// path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewWebhookServiceHandler(svc WebhookServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	webhookServiceGetWebhooksHandler := connect.NewUnaryHandler(
+		WebhookServiceGetWebhooksProcedure,
+		svc.GetWebhooks,
+		connect.WithSchema(webhookServiceGetWebhooksMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	webhookServiceGetWebhookHandler := connect.NewUnaryHandler(
+		WebhookServiceGetWebhookProcedure,
+		svc.GetWebhook,
+		connect.WithSchema(webhookServiceGetWebhookMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	webhookServiceCreateWebhookHandler := connect.NewUnaryHandler(
+		WebhookServiceCreateWebhookProcedure,
+		svc.CreateWebhook,
+		connect.WithSchema(webhookServiceCreateWebhookMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	webhookServiceUpdateWebhookHandler := connect.NewUnaryHandler(
+		WebhookServiceUpdateWebhookProcedure,
+		svc.UpdateWebhook,
+		connect.WithSchema(webhookServiceUpdateWebhookMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	webhookServiceDeleteWebhookHandler := connect.NewUnaryHandler(
+		WebhookServiceDeleteWebhookProcedure,
+		svc.DeleteWebhook,
+		connect.WithSchema(webhookServiceDeleteWebhookMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	webhookServiceGetWebhookDeliveriesHandler := connect.NewUnaryHandler(
+		WebhookServiceGetWebhookDeliveriesProcedure,
+		svc.GetWebhookDeliveries,
+		connect.WithSchema(webhookServiceGetWebhookDeliveriesMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	webhookServicePublishJobRunEventHandler := connect.NewUnaryHandler(
+		WebhookServicePublishJobRunEventProcedure,
+		svc.PublishJobRunEvent,
+		connect.WithSchema(webhookServicePublishJobRunEventMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/mgmt.v1alpha1.WebhookService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case WebhookServiceGetWebhooksProcedure:
+			webhookServiceGetWebhooksHandler.ServeHTTP(w, r)
+		case WebhookServiceGetWebhookProcedure:
+			webhookServiceGetWebhookHandler.ServeHTTP(w, r)
+		case WebhookServiceCreateWebhookProcedure:
+			webhookServiceCreateWebhookHandler.ServeHTTP(w, r)
+		case WebhookServiceUpdateWebhookProcedure:
+			webhookServiceUpdateWebhookHandler.ServeHTTP(w, r)
+		case WebhookServiceDeleteWebhookProcedure:
+			webhookServiceDeleteWebhookHandler.ServeHTTP(w, r)
+		case WebhookServiceGetWebhookDeliveriesProcedure:
+			webhookServiceGetWebhookDeliveriesHandler.ServeHTTP(w, r)
+		case WebhookServicePublishJobRunEventProcedure:
+			webhookServicePublishJobRunEventHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedWebhookServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedWebhookServiceHandler struct{}
+
+func (UnimplementedWebhookServiceHandler) GetWebhooks(context.Context, *connect.Request[v1alpha1.GetWebhooksRequest]) (*connect.Response[v1alpha1.GetWebhooksResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mgmt.v1alpha1.WebhookService.GetWebhooks is not implemented"))
+}
+
+func (UnimplementedWebhookServiceHandler) GetWebhook(context.Context, *connect.Request[v1alpha1.GetWebhookRequest]) (*connect.Response[v1alpha1.GetWebhookResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mgmt.v1alpha1.WebhookService.GetWebhook is not implemented"))
+}
+
+func (UnimplementedWebhookServiceHandler) CreateWebhook(context.Context, *connect.Request[v1alpha1.CreateWebhookRequest]) (*connect.Response[v1alpha1.CreateWebhookResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mgmt.v1alpha1.WebhookService.CreateWebhook is not implemented"))
+}
+
+func (UnimplementedWebhookServiceHandler) UpdateWebhook(context.Context, *connect.Request[v1alpha1.UpdateWebhookRequest]) (*connect.Response[v1alpha1.UpdateWebhookResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mgmt.v1alpha1.WebhookService.UpdateWebhook is not implemented"))
+}
+
+func (UnimplementedWebhookServiceHandler) DeleteWebhook(context.Context, *connect.Request[v1alpha1.DeleteWebhookRequest]) (*connect.Response[v1alpha1.DeleteWebhookResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mgmt.v1alpha1.WebhookService.DeleteWebhook is not implemented"))
+}
+
+func (UnimplementedWebhookServiceHandler) GetWebhookDeliveries(context.Context, *connect.Request[v1alpha1.GetWebhookDeliveriesRequest]) (*connect.Response[v1alpha1.GetWebhookDeliveriesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mgmt.v1alpha1.WebhookService.GetWebhookDeliveries is not implemented"))
+}
+
+func (UnimplementedWebhookServiceHandler) PublishJobRunEvent(context.Context, *connect.Request[v1alpha1.PublishJobRunEventRequest]) (*connect.Response[v1alpha1.PublishJobRunEventResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mgmt.v1alpha1.WebhookService.PublishJobRunEvent is not implemented"))
+}
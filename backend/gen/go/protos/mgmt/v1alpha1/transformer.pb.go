@@ -25,51 +25,64 @@ const (
 type TransformerSource int32
 
 const (
-	TransformerSource_TRANSFORMER_SOURCE_UNSPECIFIED                  TransformerSource = 0
-	TransformerSource_TRANSFORMER_SOURCE_PASSTHROUGH                  TransformerSource = 1
-	TransformerSource_TRANSFORMER_SOURCE_GENERATE_DEFAULT             TransformerSource = 2
-	TransformerSource_TRANSFORMER_SOURCE_TRANSFORM_JAVASCRIPT         TransformerSource = 3
-	TransformerSource_TRANSFORMER_SOURCE_GENERATE_EMAIL               TransformerSource = 4
-	TransformerSource_TRANSFORMER_SOURCE_TRANSFORM_EMAIL              TransformerSource = 5
-	TransformerSource_TRANSFORMER_SOURCE_GENERATE_BOOL                TransformerSource = 6
-	TransformerSource_TRANSFORMER_SOURCE_GENERATE_CARD_NUMBER         TransformerSource = 7
-	TransformerSource_TRANSFORMER_SOURCE_GENERATE_CITY                TransformerSource = 8
-	TransformerSource_TRANSFORMER_SOURCE_GENERATE_E164_PHONE_NUMBER   TransformerSource = 9
-	TransformerSource_TRANSFORMER_SOURCE_GENERATE_FIRST_NAME          TransformerSource = 10
-	TransformerSource_TRANSFORMER_SOURCE_GENERATE_FLOAT64             TransformerSource = 11
-	TransformerSource_TRANSFORMER_SOURCE_GENERATE_FULL_ADDRESS        TransformerSource = 12
-	TransformerSource_TRANSFORMER_SOURCE_GENERATE_FULL_NAME           TransformerSource = 13
-	TransformerSource_TRANSFORMER_SOURCE_GENERATE_GENDER              TransformerSource = 14
-	TransformerSource_TRANSFORMER_SOURCE_GENERATE_INT64_PHONE_NUMBER  TransformerSource = 15
-	TransformerSource_TRANSFORMER_SOURCE_GENERATE_INT64               TransformerSource = 16
-	TransformerSource_TRANSFORMER_SOURCE_GENERATE_RANDOM_INT64        TransformerSource = 17
-	TransformerSource_TRANSFORMER_SOURCE_GENERATE_LAST_NAME           TransformerSource = 18
-	TransformerSource_TRANSFORMER_SOURCE_GENERATE_SHA256HASH          TransformerSource = 19
-	TransformerSource_TRANSFORMER_SOURCE_GENERATE_SSN                 TransformerSource = 20
-	TransformerSource_TRANSFORMER_SOURCE_GENERATE_STATE               TransformerSource = 21
-	TransformerSource_TRANSFORMER_SOURCE_GENERATE_STREET_ADDRESS      TransformerSource = 22
-	TransformerSource_TRANSFORMER_SOURCE_GENERATE_STRING_PHONE_NUMBER TransformerSource = 23
-	TransformerSource_TRANSFORMER_SOURCE_GENERATE_STRING              TransformerSource = 24
-	TransformerSource_TRANSFORMER_SOURCE_GENERATE_RANDOM_STRING       TransformerSource = 25
-	TransformerSource_TRANSFORMER_SOURCE_GENERATE_UNIXTIMESTAMP       TransformerSource = 26
-	TransformerSource_TRANSFORMER_SOURCE_GENERATE_USERNAME            TransformerSource = 27
-	TransformerSource_TRANSFORMER_SOURCE_GENERATE_UTCTIMESTAMP        TransformerSource = 28
-	TransformerSource_TRANSFORMER_SOURCE_GENERATE_UUID                TransformerSource = 29
-	TransformerSource_TRANSFORMER_SOURCE_GENERATE_ZIPCODE             TransformerSource = 30
-	TransformerSource_TRANSFORMER_SOURCE_TRANSFORM_E164_PHONE_NUMBER  TransformerSource = 31
-	TransformerSource_TRANSFORMER_SOURCE_TRANSFORM_FIRST_NAME         TransformerSource = 32
-	TransformerSource_TRANSFORMER_SOURCE_TRANSFORM_FLOAT64            TransformerSource = 33
-	TransformerSource_TRANSFORMER_SOURCE_TRANSFORM_FULL_NAME          TransformerSource = 34
-	TransformerSource_TRANSFORMER_SOURCE_TRANSFORM_INT64_PHONE_NUMBER TransformerSource = 35
-	TransformerSource_TRANSFORMER_SOURCE_TRANSFORM_INT64              TransformerSource = 36
-	TransformerSource_TRANSFORMER_SOURCE_TRANSFORM_LAST_NAME          TransformerSource = 37
-	TransformerSource_TRANSFORMER_SOURCE_TRANSFORM_PHONE_NUMBER       TransformerSource = 38
-	TransformerSource_TRANSFORMER_SOURCE_TRANSFORM_STRING             TransformerSource = 39
-	TransformerSource_TRANSFORMER_SOURCE_GENERATE_NULL                TransformerSource = 40
-	TransformerSource_TRANSFORMER_SOURCE_GENERATE_CATEGORICAL         TransformerSource = 42
-	TransformerSource_TRANSFORMER_SOURCE_TRANSFORM_CHARACTER_SCRAMBLE TransformerSource = 43
-	TransformerSource_TRANSFORMER_SOURCE_USER_DEFINED                 TransformerSource = 44
-	TransformerSource_TRANSFORMER_SOURCE_GENERATE_JAVASCRIPT          TransformerSource = 45
+	TransformerSource_TRANSFORMER_SOURCE_UNSPECIFIED                         TransformerSource = 0
+	TransformerSource_TRANSFORMER_SOURCE_PASSTHROUGH                         TransformerSource = 1
+	TransformerSource_TRANSFORMER_SOURCE_GENERATE_DEFAULT                    TransformerSource = 2
+	TransformerSource_TRANSFORMER_SOURCE_TRANSFORM_JAVASCRIPT                TransformerSource = 3
+	TransformerSource_TRANSFORMER_SOURCE_GENERATE_EMAIL                      TransformerSource = 4
+	TransformerSource_TRANSFORMER_SOURCE_TRANSFORM_EMAIL                     TransformerSource = 5
+	TransformerSource_TRANSFORMER_SOURCE_GENERATE_BOOL                       TransformerSource = 6
+	TransformerSource_TRANSFORMER_SOURCE_GENERATE_CARD_NUMBER                TransformerSource = 7
+	TransformerSource_TRANSFORMER_SOURCE_GENERATE_CITY                       TransformerSource = 8
+	TransformerSource_TRANSFORMER_SOURCE_GENERATE_E164_PHONE_NUMBER          TransformerSource = 9
+	TransformerSource_TRANSFORMER_SOURCE_GENERATE_FIRST_NAME                 TransformerSource = 10
+	TransformerSource_TRANSFORMER_SOURCE_GENERATE_FLOAT64                    TransformerSource = 11
+	TransformerSource_TRANSFORMER_SOURCE_GENERATE_FULL_ADDRESS               TransformerSource = 12
+	TransformerSource_TRANSFORMER_SOURCE_GENERATE_FULL_NAME                  TransformerSource = 13
+	TransformerSource_TRANSFORMER_SOURCE_GENERATE_GENDER                     TransformerSource = 14
+	TransformerSource_TRANSFORMER_SOURCE_GENERATE_INT64_PHONE_NUMBER         TransformerSource = 15
+	TransformerSource_TRANSFORMER_SOURCE_GENERATE_INT64                      TransformerSource = 16
+	TransformerSource_TRANSFORMER_SOURCE_GENERATE_RANDOM_INT64               TransformerSource = 17
+	TransformerSource_TRANSFORMER_SOURCE_GENERATE_LAST_NAME                  TransformerSource = 18
+	TransformerSource_TRANSFORMER_SOURCE_GENERATE_SHA256HASH                 TransformerSource = 19
+	TransformerSource_TRANSFORMER_SOURCE_GENERATE_SSN                        TransformerSource = 20
+	TransformerSource_TRANSFORMER_SOURCE_GENERATE_STATE                      TransformerSource = 21
+	TransformerSource_TRANSFORMER_SOURCE_GENERATE_STREET_ADDRESS             TransformerSource = 22
+	TransformerSource_TRANSFORMER_SOURCE_GENERATE_STRING_PHONE_NUMBER        TransformerSource = 23
+	TransformerSource_TRANSFORMER_SOURCE_GENERATE_STRING                     TransformerSource = 24
+	TransformerSource_TRANSFORMER_SOURCE_GENERATE_RANDOM_STRING              TransformerSource = 25
+	TransformerSource_TRANSFORMER_SOURCE_GENERATE_UNIXTIMESTAMP              TransformerSource = 26
+	TransformerSource_TRANSFORMER_SOURCE_GENERATE_USERNAME                   TransformerSource = 27
+	TransformerSource_TRANSFORMER_SOURCE_GENERATE_UTCTIMESTAMP               TransformerSource = 28
+	TransformerSource_TRANSFORMER_SOURCE_GENERATE_UUID                       TransformerSource = 29
+	TransformerSource_TRANSFORMER_SOURCE_GENERATE_ZIPCODE                    TransformerSource = 30
+	TransformerSource_TRANSFORMER_SOURCE_TRANSFORM_E164_PHONE_NUMBER         TransformerSource = 31
+	TransformerSource_TRANSFORMER_SOURCE_TRANSFORM_FIRST_NAME                TransformerSource = 32
+	TransformerSource_TRANSFORMER_SOURCE_TRANSFORM_FLOAT64                   TransformerSource = 33
+	TransformerSource_TRANSFORMER_SOURCE_TRANSFORM_FULL_NAME                 TransformerSource = 34
+	TransformerSource_TRANSFORMER_SOURCE_TRANSFORM_INT64_PHONE_NUMBER        TransformerSource = 35
+	TransformerSource_TRANSFORMER_SOURCE_TRANSFORM_INT64                     TransformerSource = 36
+	TransformerSource_TRANSFORMER_SOURCE_TRANSFORM_LAST_NAME                 TransformerSource = 37
+	TransformerSource_TRANSFORMER_SOURCE_TRANSFORM_PHONE_NUMBER              TransformerSource = 38
+	TransformerSource_TRANSFORMER_SOURCE_TRANSFORM_STRING                    TransformerSource = 39
+	TransformerSource_TRANSFORMER_SOURCE_GENERATE_NULL                       TransformerSource = 40
+	TransformerSource_TRANSFORMER_SOURCE_GENERATE_CATEGORICAL                TransformerSource = 42
+	TransformerSource_TRANSFORMER_SOURCE_TRANSFORM_CHARACTER_SCRAMBLE        TransformerSource = 43
+	TransformerSource_TRANSFORMER_SOURCE_USER_DEFINED                        TransformerSource = 44
+	TransformerSource_TRANSFORMER_SOURCE_GENERATE_JAVASCRIPT                 TransformerSource = 45
+	TransformerSource_TRANSFORMER_SOURCE_TRANSFORM_FORMAT_PRESERVING_ENCRYPT TransformerSource = 46
+	TransformerSource_TRANSFORMER_SOURCE_TRANSFORM_TIMESTAMP                 TransformerSource = 47
+	TransformerSource_TRANSFORMER_SOURCE_TRANSFORM_DATE                      TransformerSource = 48
+	TransformerSource_TRANSFORMER_SOURCE_TRANSFORM_JSON                      TransformerSource = 49
+	TransformerSource_TRANSFORMER_SOURCE_TRANSFORM_FREE_TEXT                 TransformerSource = 50
+	TransformerSource_TRANSFORMER_SOURCE_GENERATE_IP_ADDRESS                 TransformerSource = 51
+	TransformerSource_TRANSFORMER_SOURCE_TRANSFORM_IP_ADDRESS                TransformerSource = 52
+	TransformerSource_TRANSFORMER_SOURCE_GENERATE_MAC_ADDRESS                TransformerSource = 53
+	TransformerSource_TRANSFORMER_SOURCE_TRANSFORM_MAC_ADDRESS               TransformerSource = 54
+	TransformerSource_TRANSFORMER_SOURCE_GENERATE_URL                        TransformerSource = 55
+	TransformerSource_TRANSFORMER_SOURCE_TRANSFORM_URL                       TransformerSource = 56
+	TransformerSource_TRANSFORMER_SOURCE_GENERATE_DOMAIN                     TransformerSource = 57
+	TransformerSource_TRANSFORMER_SOURCE_TRANSFORM_DOMAIN                    TransformerSource = 58
 )
 
 // Enum value maps for TransformerSource.
@@ -120,53 +133,79 @@ var (
 		43: "TRANSFORMER_SOURCE_TRANSFORM_CHARACTER_SCRAMBLE",
 		44: "TRANSFORMER_SOURCE_USER_DEFINED",
 		45: "TRANSFORMER_SOURCE_GENERATE_JAVASCRIPT",
+		46: "TRANSFORMER_SOURCE_TRANSFORM_FORMAT_PRESERVING_ENCRYPT",
+		47: "TRANSFORMER_SOURCE_TRANSFORM_TIMESTAMP",
+		48: "TRANSFORMER_SOURCE_TRANSFORM_DATE",
+		49: "TRANSFORMER_SOURCE_TRANSFORM_JSON",
+		50: "TRANSFORMER_SOURCE_TRANSFORM_FREE_TEXT",
+		51: "TRANSFORMER_SOURCE_GENERATE_IP_ADDRESS",
+		52: "TRANSFORMER_SOURCE_TRANSFORM_IP_ADDRESS",
+		53: "TRANSFORMER_SOURCE_GENERATE_MAC_ADDRESS",
+		54: "TRANSFORMER_SOURCE_TRANSFORM_MAC_ADDRESS",
+		55: "TRANSFORMER_SOURCE_GENERATE_URL",
+		56: "TRANSFORMER_SOURCE_TRANSFORM_URL",
+		57: "TRANSFORMER_SOURCE_GENERATE_DOMAIN",
+		58: "TRANSFORMER_SOURCE_TRANSFORM_DOMAIN",
 	}
 	TransformerSource_value = map[string]int32{
-		"TRANSFORMER_SOURCE_UNSPECIFIED":                  0,
-		"TRANSFORMER_SOURCE_PASSTHROUGH":                  1,
-		"TRANSFORMER_SOURCE_GENERATE_DEFAULT":             2,
-		"TRANSFORMER_SOURCE_TRANSFORM_JAVASCRIPT":         3,
-		"TRANSFORMER_SOURCE_GENERATE_EMAIL":               4,
-		"TRANSFORMER_SOURCE_TRANSFORM_EMAIL":              5,
-		"TRANSFORMER_SOURCE_GENERATE_BOOL":                6,
-		"TRANSFORMER_SOURCE_GENERATE_CARD_NUMBER":         7,
-		"TRANSFORMER_SOURCE_GENERATE_CITY":                8,
-		"TRANSFORMER_SOURCE_GENERATE_E164_PHONE_NUMBER":   9,
-		"TRANSFORMER_SOURCE_GENERATE_FIRST_NAME":          10,
-		"TRANSFORMER_SOURCE_GENERATE_FLOAT64":             11,
-		"TRANSFORMER_SOURCE_GENERATE_FULL_ADDRESS":        12,
-		"TRANSFORMER_SOURCE_GENERATE_FULL_NAME":           13,
-		"TRANSFORMER_SOURCE_GENERATE_GENDER":              14,
-		"TRANSFORMER_SOURCE_GENERATE_INT64_PHONE_NUMBER":  15,
-		"TRANSFORMER_SOURCE_GENERATE_INT64":               16,
-		"TRANSFORMER_SOURCE_GENERATE_RANDOM_INT64":        17,
-		"TRANSFORMER_SOURCE_GENERATE_LAST_NAME":           18,
-		"TRANSFORMER_SOURCE_GENERATE_SHA256HASH":          19,
-		"TRANSFORMER_SOURCE_GENERATE_SSN":                 20,
-		"TRANSFORMER_SOURCE_GENERATE_STATE":               21,
-		"TRANSFORMER_SOURCE_GENERATE_STREET_ADDRESS":      22,
-		"TRANSFORMER_SOURCE_GENERATE_STRING_PHONE_NUMBER": 23,
-		"TRANSFORMER_SOURCE_GENERATE_STRING":              24,
-		"TRANSFORMER_SOURCE_GENERATE_RANDOM_STRING":       25,
-		"TRANSFORMER_SOURCE_GENERATE_UNIXTIMESTAMP":       26,
-		"TRANSFORMER_SOURCE_GENERATE_USERNAME":            27,
-		"TRANSFORMER_SOURCE_GENERATE_UTCTIMESTAMP":        28,
-		"TRANSFORMER_SOURCE_GENERATE_UUID":                29,
-		"TRANSFORMER_SOURCE_GENERATE_ZIPCODE":             30,
-		"TRANSFORMER_SOURCE_TRANSFORM_E164_PHONE_NUMBER":  31,
-		"TRANSFORMER_SOURCE_TRANSFORM_FIRST_NAME":         32,
-		"TRANSFORMER_SOURCE_TRANSFORM_FLOAT64":            33,
-		"TRANSFORMER_SOURCE_TRANSFORM_FULL_NAME":          34,
-		"TRANSFORMER_SOURCE_TRANSFORM_INT64_PHONE_NUMBER": 35,
-		"TRANSFORMER_SOURCE_TRANSFORM_INT64":              36,
-		"TRANSFORMER_SOURCE_TRANSFORM_LAST_NAME":          37,
-		"TRANSFORMER_SOURCE_TRANSFORM_PHONE_NUMBER":       38,
-		"TRANSFORMER_SOURCE_TRANSFORM_STRING":             39,
-		"TRANSFORMER_SOURCE_GENERATE_NULL":                40,
-		"TRANSFORMER_SOURCE_GENERATE_CATEGORICAL":         42,
-		"TRANSFORMER_SOURCE_TRANSFORM_CHARACTER_SCRAMBLE": 43,
-		"TRANSFORMER_SOURCE_USER_DEFINED":                 44,
-		"TRANSFORMER_SOURCE_GENERATE_JAVASCRIPT":          45,
+		"TRANSFORMER_SOURCE_UNSPECIFIED":                         0,
+		"TRANSFORMER_SOURCE_PASSTHROUGH":                         1,
+		"TRANSFORMER_SOURCE_GENERATE_DEFAULT":                    2,
+		"TRANSFORMER_SOURCE_TRANSFORM_JAVASCRIPT":                3,
+		"TRANSFORMER_SOURCE_GENERATE_EMAIL":                      4,
+		"TRANSFORMER_SOURCE_TRANSFORM_EMAIL":                     5,
+		"TRANSFORMER_SOURCE_GENERATE_BOOL":                       6,
+		"TRANSFORMER_SOURCE_GENERATE_CARD_NUMBER":                7,
+		"TRANSFORMER_SOURCE_GENERATE_CITY":                       8,
+		"TRANSFORMER_SOURCE_GENERATE_E164_PHONE_NUMBER":          9,
+		"TRANSFORMER_SOURCE_GENERATE_FIRST_NAME":                 10,
+		"TRANSFORMER_SOURCE_GENERATE_FLOAT64":                    11,
+		"TRANSFORMER_SOURCE_GENERATE_FULL_ADDRESS":               12,
+		"TRANSFORMER_SOURCE_GENERATE_FULL_NAME":                  13,
+		"TRANSFORMER_SOURCE_GENERATE_GENDER":                     14,
+		"TRANSFORMER_SOURCE_GENERATE_INT64_PHONE_NUMBER":         15,
+		"TRANSFORMER_SOURCE_GENERATE_INT64":                      16,
+		"TRANSFORMER_SOURCE_GENERATE_RANDOM_INT64":               17,
+		"TRANSFORMER_SOURCE_GENERATE_LAST_NAME":                  18,
+		"TRANSFORMER_SOURCE_GENERATE_SHA256HASH":                 19,
+		"TRANSFORMER_SOURCE_GENERATE_SSN":                        20,
+		"TRANSFORMER_SOURCE_GENERATE_STATE":                      21,
+		"TRANSFORMER_SOURCE_GENERATE_STREET_ADDRESS":             22,
+		"TRANSFORMER_SOURCE_GENERATE_STRING_PHONE_NUMBER":        23,
+		"TRANSFORMER_SOURCE_GENERATE_STRING":                     24,
+		"TRANSFORMER_SOURCE_GENERATE_RANDOM_STRING":              25,
+		"TRANSFORMER_SOURCE_GENERATE_UNIXTIMESTAMP":              26,
+		"TRANSFORMER_SOURCE_GENERATE_USERNAME":                   27,
+		"TRANSFORMER_SOURCE_GENERATE_UTCTIMESTAMP":               28,
+		"TRANSFORMER_SOURCE_GENERATE_UUID":                       29,
+		"TRANSFORMER_SOURCE_GENERATE_ZIPCODE":                    30,
+		"TRANSFORMER_SOURCE_TRANSFORM_E164_PHONE_NUMBER":         31,
+		"TRANSFORMER_SOURCE_TRANSFORM_FIRST_NAME":                32,
+		"TRANSFORMER_SOURCE_TRANSFORM_FLOAT64":                   33,
+		"TRANSFORMER_SOURCE_TRANSFORM_FULL_NAME":                 34,
+		"TRANSFORMER_SOURCE_TRANSFORM_INT64_PHONE_NUMBER":        35,
+		"TRANSFORMER_SOURCE_TRANSFORM_INT64":                     36,
+		"TRANSFORMER_SOURCE_TRANSFORM_LAST_NAME":                 37,
+		"TRANSFORMER_SOURCE_TRANSFORM_PHONE_NUMBER":              38,
+		"TRANSFORMER_SOURCE_TRANSFORM_STRING":                    39,
+		"TRANSFORMER_SOURCE_GENERATE_NULL":                       40,
+		"TRANSFORMER_SOURCE_GENERATE_CATEGORICAL":                42,
+		"TRANSFORMER_SOURCE_TRANSFORM_CHARACTER_SCRAMBLE":        43,
+		"TRANSFORMER_SOURCE_USER_DEFINED":                        44,
+		"TRANSFORMER_SOURCE_GENERATE_JAVASCRIPT":                 45,
+		"TRANSFORMER_SOURCE_TRANSFORM_FORMAT_PRESERVING_ENCRYPT": 46,
+		"TRANSFORMER_SOURCE_TRANSFORM_TIMESTAMP":                 47,
+		"TRANSFORMER_SOURCE_TRANSFORM_DATE":                      48,
+		"TRANSFORMER_SOURCE_TRANSFORM_JSON":                      49,
+		"TRANSFORMER_SOURCE_TRANSFORM_FREE_TEXT":                 50,
+		"TRANSFORMER_SOURCE_GENERATE_IP_ADDRESS":                 51,
+		"TRANSFORMER_SOURCE_TRANSFORM_IP_ADDRESS":                52,
+		"TRANSFORMER_SOURCE_GENERATE_MAC_ADDRESS":                53,
+		"TRANSFORMER_SOURCE_TRANSFORM_MAC_ADDRESS":               54,
+		"TRANSFORMER_SOURCE_GENERATE_URL":                        55,
+		"TRANSFORMER_SOURCE_TRANSFORM_URL":                       56,
+		"TRANSFORMER_SOURCE_GENERATE_DOMAIN":                     57,
+		"TRANSFORMER_SOURCE_TRANSFORM_DOMAIN":                    58,
 	}
 )
 
@@ -264,6 +303,320 @@ func (TransformerDataType) EnumDescriptor() ([]byte, []int) {
 	return file_mgmt_v1alpha1_transformer_proto_rawDescGZIP(), []int{1}
 }
 
+type FormatPreservingEncryptionAlgorithm int32
+
+const (
+	FormatPreservingEncryptionAlgorithm_FORMAT_PRESERVING_ENCRYPTION_ALGORITHM_UNSPECIFIED FormatPreservingEncryptionAlgorithm = 0
+	FormatPreservingEncryptionAlgorithm_FORMAT_PRESERVING_ENCRYPTION_ALGORITHM_FF1         FormatPreservingEncryptionAlgorithm = 1
+	FormatPreservingEncryptionAlgorithm_FORMAT_PRESERVING_ENCRYPTION_ALGORITHM_FF3_1       FormatPreservingEncryptionAlgorithm = 2
+)
+
+// Enum value maps for FormatPreservingEncryptionAlgorithm.
+var (
+	FormatPreservingEncryptionAlgorithm_name = map[int32]string{
+		0: "FORMAT_PRESERVING_ENCRYPTION_ALGORITHM_UNSPECIFIED",
+		1: "FORMAT_PRESERVING_ENCRYPTION_ALGORITHM_FF1",
+		2: "FORMAT_PRESERVING_ENCRYPTION_ALGORITHM_FF3_1",
+	}
+	FormatPreservingEncryptionAlgorithm_value = map[string]int32{
+		"FORMAT_PRESERVING_ENCRYPTION_ALGORITHM_UNSPECIFIED": 0,
+		"FORMAT_PRESERVING_ENCRYPTION_ALGORITHM_FF1":         1,
+		"FORMAT_PRESERVING_ENCRYPTION_ALGORITHM_FF3_1":       2,
+	}
+)
+
+func (x FormatPreservingEncryptionAlgorithm) Enum() *FormatPreservingEncryptionAlgorithm {
+	p := new(FormatPreservingEncryptionAlgorithm)
+	*p = x
+	return p
+}
+
+func (x FormatPreservingEncryptionAlgorithm) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (FormatPreservingEncryptionAlgorithm) Descriptor() protoreflect.EnumDescriptor {
+	return file_mgmt_v1alpha1_transformer_proto_enumTypes[2].Descriptor()
+}
+
+func (FormatPreservingEncryptionAlgorithm) Type() protoreflect.EnumType {
+	return &file_mgmt_v1alpha1_transformer_proto_enumTypes[2]
+}
+
+func (x FormatPreservingEncryptionAlgorithm) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use FormatPreservingEncryptionAlgorithm.Descriptor instead.
+func (FormatPreservingEncryptionAlgorithm) EnumDescriptor() ([]byte, []int) {
+	return file_mgmt_v1alpha1_transformer_proto_rawDescGZIP(), []int{2}
+}
+
+type TimeTransformMode int32
+
+const (
+	TimeTransformMode_TIME_TRANSFORM_MODE_UNSPECIFIED TimeTransformMode = 0
+	// moves the value by an offset that is the same for every value of an entity
+	TimeTransformMode_TIME_TRANSFORM_MODE_SHIFT TimeTransformMode = 1
+	// moves the value by a random offset
+	TimeTransformMode_TIME_TRANSFORM_MODE_JITTER TimeTransformMode = 2
+	// truncates the value to the start of its month or year
+	TimeTransformMode_TIME_TRANSFORM_MODE_TRUNCATE TimeTransformMode = 3
+	// limits the value to a range
+	TimeTransformMode_TIME_TRANSFORM_MODE_CLAMP TimeTransformMode = 4
+)
+
+// Enum value maps for TimeTransformMode.
+var (
+	TimeTransformMode_name = map[int32]string{
+		0: "TIME_TRANSFORM_MODE_UNSPECIFIED",
+		1: "TIME_TRANSFORM_MODE_SHIFT",
+		2: "TIME_TRANSFORM_MODE_JITTER",
+		3: "TIME_TRANSFORM_MODE_TRUNCATE",
+		4: "TIME_TRANSFORM_MODE_CLAMP",
+	}
+	TimeTransformMode_value = map[string]int32{
+		"TIME_TRANSFORM_MODE_UNSPECIFIED": 0,
+		"TIME_TRANSFORM_MODE_SHIFT":       1,
+		"TIME_TRANSFORM_MODE_JITTER":      2,
+		"TIME_TRANSFORM_MODE_TRUNCATE":    3,
+		"TIME_TRANSFORM_MODE_CLAMP":       4,
+	}
+)
+
+func (x TimeTransformMode) Enum() *TimeTransformMode {
+	p := new(TimeTransformMode)
+	*p = x
+	return p
+}
+
+func (x TimeTransformMode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TimeTransformMode) Descriptor() protoreflect.EnumDescriptor {
+	return file_mgmt_v1alpha1_transformer_proto_enumTypes[3].Descriptor()
+}
+
+func (TimeTransformMode) Type() protoreflect.EnumType {
+	return &file_mgmt_v1alpha1_transformer_proto_enumTypes[3]
+}
+
+func (x TimeTransformMode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TimeTransformMode.Descriptor instead.
+func (TimeTransformMode) EnumDescriptor() ([]byte, []int) {
+	return file_mgmt_v1alpha1_transformer_proto_rawDescGZIP(), []int{3}
+}
+
+type TimeTruncatePrecision int32
+
+const (
+	TimeTruncatePrecision_TIME_TRUNCATE_PRECISION_UNSPECIFIED TimeTruncatePrecision = 0
+	TimeTruncatePrecision_TIME_TRUNCATE_PRECISION_MONTH       TimeTruncatePrecision = 1
+	TimeTruncatePrecision_TIME_TRUNCATE_PRECISION_YEAR        TimeTruncatePrecision = 2
+)
+
+// Enum value maps for TimeTruncatePrecision.
+var (
+	TimeTruncatePrecision_name = map[int32]string{
+		0: "TIME_TRUNCATE_PRECISION_UNSPECIFIED",
+		1: "TIME_TRUNCATE_PRECISION_MONTH",
+		2: "TIME_TRUNCATE_PRECISION_YEAR",
+	}
+	TimeTruncatePrecision_value = map[string]int32{
+		"TIME_TRUNCATE_PRECISION_UNSPECIFIED": 0,
+		"TIME_TRUNCATE_PRECISION_MONTH":       1,
+		"TIME_TRUNCATE_PRECISION_YEAR":        2,
+	}
+)
+
+func (x TimeTruncatePrecision) Enum() *TimeTruncatePrecision {
+	p := new(TimeTruncatePrecision)
+	*p = x
+	return p
+}
+
+func (x TimeTruncatePrecision) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TimeTruncatePrecision) Descriptor() protoreflect.EnumDescriptor {
+	return file_mgmt_v1alpha1_transformer_proto_enumTypes[4].Descriptor()
+}
+
+func (TimeTruncatePrecision) Type() protoreflect.EnumType {
+	return &file_mgmt_v1alpha1_transformer_proto_enumTypes[4]
+}
+
+func (x TimeTruncatePrecision) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TimeTruncatePrecision.Descriptor instead.
+func (TimeTruncatePrecision) EnumDescriptor() ([]byte, []int) {
+	return file_mgmt_v1alpha1_transformer_proto_rawDescGZIP(), []int{4}
+}
+
+type FreeTextEntityType int32
+
+const (
+	FreeTextEntityType_FREE_TEXT_ENTITY_TYPE_UNSPECIFIED  FreeTextEntityType = 0
+	FreeTextEntityType_FREE_TEXT_ENTITY_TYPE_EMAIL        FreeTextEntityType = 1
+	FreeTextEntityType_FREE_TEXT_ENTITY_TYPE_PHONE_NUMBER FreeTextEntityType = 2
+	// only numbers that pass the luhn check are detected
+	FreeTextEntityType_FREE_TEXT_ENTITY_TYPE_CARD_NUMBER FreeTextEntityType = 3
+	// a first name followed by a last name, or a title such as Mr or Dr followed by a last name
+	FreeTextEntityType_FREE_TEXT_ENTITY_TYPE_NAME FreeTextEntityType = 4
+)
+
+// Enum value maps for FreeTextEntityType.
+var (
+	FreeTextEntityType_name = map[int32]string{
+		0: "FREE_TEXT_ENTITY_TYPE_UNSPECIFIED",
+		1: "FREE_TEXT_ENTITY_TYPE_EMAIL",
+		2: "FREE_TEXT_ENTITY_TYPE_PHONE_NUMBER",
+		3: "FREE_TEXT_ENTITY_TYPE_CARD_NUMBER",
+		4: "FREE_TEXT_ENTITY_TYPE_NAME",
+	}
+	FreeTextEntityType_value = map[string]int32{
+		"FREE_TEXT_ENTITY_TYPE_UNSPECIFIED":  0,
+		"FREE_TEXT_ENTITY_TYPE_EMAIL":        1,
+		"FREE_TEXT_ENTITY_TYPE_PHONE_NUMBER": 2,
+		"FREE_TEXT_ENTITY_TYPE_CARD_NUMBER":  3,
+		"FREE_TEXT_ENTITY_TYPE_NAME":         4,
+	}
+)
+
+func (x FreeTextEntityType) Enum() *FreeTextEntityType {
+	p := new(FreeTextEntityType)
+	*p = x
+	return p
+}
+
+func (x FreeTextEntityType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (FreeTextEntityType) Descriptor() protoreflect.EnumDescriptor {
+	return file_mgmt_v1alpha1_transformer_proto_enumTypes[5].Descriptor()
+}
+
+func (FreeTextEntityType) Type() protoreflect.EnumType {
+	return &file_mgmt_v1alpha1_transformer_proto_enumTypes[5]
+}
+
+func (x FreeTextEntityType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use FreeTextEntityType.Descriptor instead.
+func (FreeTextEntityType) EnumDescriptor() ([]byte, []int) {
+	return file_mgmt_v1alpha1_transformer_proto_rawDescGZIP(), []int{5}
+}
+
+type FreeTextReplacementStrategy int32
+
+const (
+	FreeTextReplacementStrategy_FREE_TEXT_REPLACEMENT_STRATEGY_UNSPECIFIED FreeTextReplacementStrategy = 0
+	// replaces each entity with a token of its type, for example [EMAIL]
+	FreeTextReplacementStrategy_FREE_TEXT_REPLACEMENT_STRATEGY_MASK FreeTextReplacementStrategy = 1
+	// replaces each entity with a fake value of the same type. the same entity is always replaced with the same fake value
+	FreeTextReplacementStrategy_FREE_TEXT_REPLACEMENT_STRATEGY_FAKE FreeTextReplacementStrategy = 2
+)
+
+// Enum value maps for FreeTextReplacementStrategy.
+var (
+	FreeTextReplacementStrategy_name = map[int32]string{
+		0: "FREE_TEXT_REPLACEMENT_STRATEGY_UNSPECIFIED",
+		1: "FREE_TEXT_REPLACEMENT_STRATEGY_MASK",
+		2: "FREE_TEXT_REPLACEMENT_STRATEGY_FAKE",
+	}
+	FreeTextReplacementStrategy_value = map[string]int32{
+		"FREE_TEXT_REPLACEMENT_STRATEGY_UNSPECIFIED": 0,
+		"FREE_TEXT_REPLACEMENT_STRATEGY_MASK":        1,
+		"FREE_TEXT_REPLACEMENT_STRATEGY_FAKE":        2,
+	}
+)
+
+func (x FreeTextReplacementStrategy) Enum() *FreeTextReplacementStrategy {
+	p := new(FreeTextReplacementStrategy)
+	*p = x
+	return p
+}
+
+func (x FreeTextReplacementStrategy) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (FreeTextReplacementStrategy) Descriptor() protoreflect.EnumDescriptor {
+	return file_mgmt_v1alpha1_transformer_proto_enumTypes[6].Descriptor()
+}
+
+func (FreeTextReplacementStrategy) Type() protoreflect.EnumType {
+	return &file_mgmt_v1alpha1_transformer_proto_enumTypes[6]
+}
+
+func (x FreeTextReplacementStrategy) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use FreeTextReplacementStrategy.Descriptor instead.
+func (FreeTextReplacementStrategy) EnumDescriptor() ([]byte, []int) {
+	return file_mgmt_v1alpha1_transformer_proto_rawDescGZIP(), []int{6}
+}
+
+type IpAddressVersion int32
+
+const (
+	IpAddressVersion_IP_ADDRESS_VERSION_UNSPECIFIED IpAddressVersion = 0
+	IpAddressVersion_IP_ADDRESS_VERSION_V4          IpAddressVersion = 1
+	IpAddressVersion_IP_ADDRESS_VERSION_V6          IpAddressVersion = 2
+)
+
+// Enum value maps for IpAddressVersion.
+var (
+	IpAddressVersion_name = map[int32]string{
+		0: "IP_ADDRESS_VERSION_UNSPECIFIED",
+		1: "IP_ADDRESS_VERSION_V4",
+		2: "IP_ADDRESS_VERSION_V6",
+	}
+	IpAddressVersion_value = map[string]int32{
+		"IP_ADDRESS_VERSION_UNSPECIFIED": 0,
+		"IP_ADDRESS_VERSION_V4":          1,
+		"IP_ADDRESS_VERSION_V6":          2,
+	}
+)
+
+func (x IpAddressVersion) Enum() *IpAddressVersion {
+	p := new(IpAddressVersion)
+	*p = x
+	return p
+}
+
+func (x IpAddressVersion) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (IpAddressVersion) Descriptor() protoreflect.EnumDescriptor {
+	return file_mgmt_v1alpha1_transformer_proto_enumTypes[7].Descriptor()
+}
+
+func (IpAddressVersion) Type() protoreflect.EnumType {
+	return &file_mgmt_v1alpha1_transformer_proto_enumTypes[7]
+}
+
+func (x IpAddressVersion) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use IpAddressVersion.Descriptor instead.
+func (IpAddressVersion) EnumDescriptor() ([]byte, []int) {
+	return file_mgmt_v1alpha1_transformer_proto_rawDescGZIP(), []int{7}
+}
+
 type GetSystemTransformersRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1311,6 +1664,19 @@ type TransformerConfig struct {
 	//	*TransformerConfig_GenerateCategoricalConfig
 	//	*TransformerConfig_TransformCharacterScrambleConfig
 	//	*TransformerConfig_GenerateJavascriptConfig
+	//	*TransformerConfig_TransformFormatPreservingEncryptConfig
+	//	*TransformerConfig_TransformTimestampConfig
+	//	*TransformerConfig_TransformDateConfig
+	//	*TransformerConfig_TransformJsonConfig
+	//	*TransformerConfig_TransformFreeTextConfig
+	//	*TransformerConfig_GenerateIpAddressConfig
+	//	*TransformerConfig_TransformIpAddressConfig
+	//	*TransformerConfig_GenerateMacAddressConfig
+	//	*TransformerConfig_TransformMacAddressConfig
+	//	*TransformerConfig_GenerateUrlConfig
+	//	*TransformerConfig_TransformUrlConfig
+	//	*TransformerConfig_GenerateDomainConfig
+	//	*TransformerConfig_TransformDomainConfig
 	Config isTransformerConfig_Config `protobuf_oneof:"config"`
 }
 
@@ -1647,6 +2013,97 @@ func (x *TransformerConfig) GetGenerateJavascriptConfig() *GenerateJavascript {
 	return nil
 }
 
+func (x *TransformerConfig) GetTransformFormatPreservingEncryptConfig() *TransformFormatPreservingEncrypt {
+	if x, ok := x.GetConfig().(*TransformerConfig_TransformFormatPreservingEncryptConfig); ok {
+		return x.TransformFormatPreservingEncryptConfig
+	}
+	return nil
+}
+
+func (x *TransformerConfig) GetTransformTimestampConfig() *TransformTimestamp {
+	if x, ok := x.GetConfig().(*TransformerConfig_TransformTimestampConfig); ok {
+		return x.TransformTimestampConfig
+	}
+	return nil
+}
+
+func (x *TransformerConfig) GetTransformDateConfig() *TransformDate {
+	if x, ok := x.GetConfig().(*TransformerConfig_TransformDateConfig); ok {
+		return x.TransformDateConfig
+	}
+	return nil
+}
+
+func (x *TransformerConfig) GetTransformJsonConfig() *TransformJson {
+	if x, ok := x.GetConfig().(*TransformerConfig_TransformJsonConfig); ok {
+		return x.TransformJsonConfig
+	}
+	return nil
+}
+
+func (x *TransformerConfig) GetTransformFreeTextConfig() *TransformFreeText {
+	if x, ok := x.GetConfig().(*TransformerConfig_TransformFreeTextConfig); ok {
+		return x.TransformFreeTextConfig
+	}
+	return nil
+}
+
+func (x *TransformerConfig) GetGenerateIpAddressConfig() *GenerateIpAddress {
+	if x, ok := x.GetConfig().(*TransformerConfig_GenerateIpAddressConfig); ok {
+		return x.GenerateIpAddressConfig
+	}
+	return nil
+}
+
+func (x *TransformerConfig) GetTransformIpAddressConfig() *TransformIpAddress {
+	if x, ok := x.GetConfig().(*TransformerConfig_TransformIpAddressConfig); ok {
+		return x.TransformIpAddressConfig
+	}
+	return nil
+}
+
+func (x *TransformerConfig) GetGenerateMacAddressConfig() *GenerateMacAddress {
+	if x, ok := x.GetConfig().(*TransformerConfig_GenerateMacAddressConfig); ok {
+		return x.GenerateMacAddressConfig
+	}
+	return nil
+}
+
+func (x *TransformerConfig) GetTransformMacAddressConfig() *TransformMacAddress {
+	if x, ok := x.GetConfig().(*TransformerConfig_TransformMacAddressConfig); ok {
+		return x.TransformMacAddressConfig
+	}
+	return nil
+}
+
+func (x *TransformerConfig) GetGenerateUrlConfig() *GenerateUrl {
+	if x, ok := x.GetConfig().(*TransformerConfig_GenerateUrlConfig); ok {
+		return x.GenerateUrlConfig
+	}
+	return nil
+}
+
+func (x *TransformerConfig) GetTransformUrlConfig() *TransformUrl {
+	if x, ok := x.GetConfig().(*TransformerConfig_TransformUrlConfig); ok {
+		return x.TransformUrlConfig
+	}
+	return nil
+}
+
+func (x *TransformerConfig) GetGenerateDomainConfig() *GenerateDomain {
+	if x, ok := x.GetConfig().(*TransformerConfig_GenerateDomainConfig); ok {
+		return x.GenerateDomainConfig
+	}
+	return nil
+}
+
+func (x *TransformerConfig) GetTransformDomainConfig() *TransformDomain {
+	if x, ok := x.GetConfig().(*TransformerConfig_TransformDomainConfig); ok {
+		return x.TransformDomainConfig
+	}
+	return nil
+}
+
 type isTransformerConfig_Config interface {
 	isTransformerConfig_Config()
 }
@@ -1819,6 +2276,58 @@ type TransformerConfig_GenerateJavascriptConfig struct {
 	GenerateJavascriptConfig *GenerateJavascript `protobuf:"bytes,42,opt,name=generate_javascript_config,json=generateJavascriptConfig,proto3,oneof"`
 }
 
+type TransformerConfig_TransformFormatPreservingEncryptConfig struct {
+	TransformFormatPreservingEncryptConfig *TransformFormatPreservingEncrypt `protobuf:"bytes,43,opt,name=transform_format_preserving_encrypt_config,json=transformFormatPreservingEncryptConfig,proto3,oneof"`
+}
+
+type TransformerConfig_TransformTimestampConfig struct {
+	TransformTimestampConfig *TransformTimestamp `protobuf:"bytes,44,opt,name=transform_timestamp_config,json=transformTimestampConfig,proto3,oneof"`
+}
+
+type TransformerConfig_TransformDateConfig struct {
+	TransformDateConfig *TransformDate `protobuf:"bytes,45,opt,name=transform_date_config,json=transformDateConfig,proto3,oneof"`
+}
+
+type TransformerConfig_TransformJsonConfig struct {
+	TransformJsonConfig *TransformJson `protobuf:"bytes,46,opt,name=transform_json_config,json=transformJsonConfig,proto3,oneof"`
+}
+
+type TransformerConfig_TransformFreeTextConfig struct {
+	TransformFreeTextConfig *TransformFreeText `protobuf:"bytes,47,opt,name=transform_free_text_config,json=transformFreeTextConfig,proto3,oneof"`
+}
+
+type TransformerConfig_GenerateIpAddressConfig struct {
+	GenerateIpAddressConfig *GenerateIpAddress `protobuf:"bytes,48,opt,name=generate_ip_address_config,json=generateIpAddressConfig,proto3,oneof"`
+}
+
+type TransformerConfig_TransformIpAddressConfig struct {
+	TransformIpAddressConfig *TransformIpAddress `protobuf:"bytes,49,opt,name=transform_ip_address_config,json=transformIpAddressConfig,proto3,oneof"`
+}
+
+type TransformerConfig_GenerateMacAddressConfig struct {
+	GenerateMacAddressConfig *GenerateMacAddress `protobuf:"bytes,50,opt,name=generate_mac_address_config,json=generateMacAddressConfig,proto3,oneof"`
+}
+
+type TransformerConfig_TransformMacAddressConfig struct {
+	TransformMacAddressConfig *TransformMacAddress `protobuf:"bytes,51,opt,name=transform_mac_address_config,json=transformMacAddressConfig,proto3,oneof"`
+}
+
+type TransformerConfig_GenerateUrlConfig struct {
+	GenerateUrlConfig *GenerateUrl `protobuf:"bytes,52,opt,name=generate_url_config,json=generateUrlConfig,proto3,oneof"`
+}
+
+type TransformerConfig_TransformUrlConfig struct {
+	TransformUrlConfig *TransformUrl `protobuf:"bytes,53,opt,name=transform_url_config,json=transformUrlConfig,proto3,oneof"`
+}
+
+type TransformerConfig_GenerateDomainConfig struct {
+	GenerateDomainConfig *GenerateDomain `protobuf:"bytes,54,opt,name=generate_domain_config,json=generateDomainConfig,proto3,oneof"`
+}
+
+type TransformerConfig_TransformDomainConfig struct {
+	TransformDomainConfig *TransformDomain `protobuf:"bytes,55,opt,name=transform_domain_config,json=transformDomainConfig,proto3,oneof"`
+}
+
 func (*TransformerConfig_GenerateEmailConfig) isTransformerConfig_Config() {}
 
 func (*TransformerConfig_TransformEmailConfig) isTransformerConfig_Config() {}
@@ -1903,6 +2412,32 @@ func (*TransformerConfig_TransformCharacterScrambleConfig) isTransformerConfig_C
 
 func (*TransformerConfig_GenerateJavascriptConfig) isTransformerConfig_Config() {}
 
+func (*TransformerConfig_TransformFormatPreservingEncryptConfig) isTransformerConfig_Config() {}
+
+func (*TransformerConfig_TransformTimestampConfig) isTransformerConfig_Config() {}
+
+func (*TransformerConfig_TransformDateConfig) isTransformerConfig_Config() {}
+
+func (*TransformerConfig_TransformJsonConfig) isTransformerConfig_Config() {}
+
+func (*TransformerConfig_TransformFreeTextConfig) isTransformerConfig_Config() {}
+
+func (*TransformerConfig_GenerateIpAddressConfig) isTransformerConfig_Config() {}
+
+func (*TransformerConfig_TransformIpAddressConfig) isTransformerConfig_Config() {}
+
+func (*TransformerConfig_GenerateMacAddressConfig) isTransformerConfig_Config() {}
+
+func (*TransformerConfig_TransformMacAddressConfig) isTransformerConfig_Config() {}
+
+func (*TransformerConfig_GenerateUrlConfig) isTransformerConfig_Config() {}
+
+func (*TransformerConfig_TransformUrlConfig) isTransformerConfig_Config() {}
+
+func (*TransformerConfig_GenerateDomainConfig) isTransformerConfig_Config() {}
+
+func (*TransformerConfig_TransformDomainConfig) isTransformerConfig_Config() {}
+
 type GenerateEmail struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1949,6 +2484,8 @@ type TransformEmail struct {
 	PreserveDomain  bool     `protobuf:"varint,1,opt,name=preserve_domain,json=preserveDomain,proto3" json:"preserve_domain,omitempty"`
 	PreserveLength  bool     `protobuf:"varint,2,opt,name=preserve_length,json=preserveLength,proto3" json:"preserve_length,omitempty"`
 	ExcludedDomains []string `protobuf:"bytes,3,rep,name=excluded_domains,json=excludedDomains,proto3" json:"excluded_domains,omitempty"`
+	// transforms the same input value to the same output using the account's transformer key
+	Deterministic bool `protobuf:"varint,4,opt,name=deterministic,proto3" json:"deterministic,omitempty"`
 }
 
 func (x *TransformEmail) Reset() {
@@ -2004,6 +2541,13 @@ func (x *TransformEmail) GetExcludedDomains() []string {
 	return nil
 }
 
+func (x *TransformEmail) GetDeterministic() bool {
+	if x != nil {
+		return x.Deterministic
+	}
+	return false
+}
+
 type GenerateBool struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -2093,6 +2637,12 @@ type GenerateCity struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	// the locale that values are generated for, such as de_DE, ja_JP or pt_BR. defaults to en_US if unspecified
+	Locale *string `protobuf:"bytes,1,opt,name=locale,proto3,oneof" json:"locale,omitempty"`
+	// a column in the same table whose value, such as a locale or country code, overrides the locale for the row.
+	// the locale is used when the value is empty or is not a supported locale
+	LocaleColumn *string `protobuf:"bytes,2,opt,name=locale_column,json=localeColumn,proto3,oneof" json:"locale_column,omitempty"`
 }
 
 func (x *GenerateCity) Reset() {
@@ -2127,11 +2677,25 @@ func (*GenerateCity) Descriptor() ([]byte, []int) {
 	return file_mgmt_v1alpha1_transformer_proto_rawDescGZIP(), []int{23}
 }
 
-type GenerateDefault struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-}
+func (x *GenerateCity) GetLocale() string {
+	if x != nil && x.Locale != nil {
+		return *x.Locale
+	}
+	return ""
+}
+
+func (x *GenerateCity) GetLocaleColumn() string {
+	if x != nil && x.LocaleColumn != nil {
+		return *x.LocaleColumn
+	}
+	return ""
+}
+
+type GenerateDefault struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
 
 func (x *GenerateDefault) Reset() {
 	*x = GenerateDefault{}
@@ -2172,6 +2736,11 @@ type GenerateE164PhoneNumber struct {
 
 	Min int64 `protobuf:"varint,1,opt,name=min,proto3" json:"min,omitempty"`
 	Max int64 `protobuf:"varint,2,opt,name=max,proto3" json:"max,omitempty"`
+	// the locale that values are generated for, such as de_DE, ja_JP or pt_BR. defaults to en_US if unspecified
+	Locale *string `protobuf:"bytes,3,opt,name=locale,proto3,oneof" json:"locale,omitempty"`
+	// a column in the same table whose value, such as a locale or country code, overrides the locale for the row.
+	// the locale is used when the value is empty or is not a supported locale
+	LocaleColumn *string `protobuf:"bytes,4,opt,name=locale_column,json=localeColumn,proto3,oneof" json:"locale_column,omitempty"`
 }
 
 func (x *GenerateE164PhoneNumber) Reset() {
@@ -2220,10 +2789,30 @@ func (x *GenerateE164PhoneNumber) GetMax() int64 {
 	return 0
 }
 
+func (x *GenerateE164PhoneNumber) GetLocale() string {
+	if x != nil && x.Locale != nil {
+		return *x.Locale
+	}
+	return ""
+}
+
+func (x *GenerateE164PhoneNumber) GetLocaleColumn() string {
+	if x != nil && x.LocaleColumn != nil {
+		return *x.LocaleColumn
+	}
+	return ""
+}
+
 type GenerateFirstName struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	// the locale that values are generated for, such as de_DE, ja_JP or pt_BR. defaults to en_US if unspecified
+	Locale *string `protobuf:"bytes,1,opt,name=locale,proto3,oneof" json:"locale,omitempty"`
+	// a column in the same table whose value, such as a locale or country code, overrides the locale for the row.
+	// the locale is used when the value is empty or is not a supported locale
+	LocaleColumn *string `protobuf:"bytes,2,opt,name=locale_column,json=localeColumn,proto3,oneof" json:"locale_column,omitempty"`
 }
 
 func (x *GenerateFirstName) Reset() {
@@ -2258,6 +2847,20 @@ func (*GenerateFirstName) Descriptor() ([]byte, []int) {
 	return file_mgmt_v1alpha1_transformer_proto_rawDescGZIP(), []int{26}
 }
 
+func (x *GenerateFirstName) GetLocale() string {
+	if x != nil && x.Locale != nil {
+		return *x.Locale
+	}
+	return ""
+}
+
+func (x *GenerateFirstName) GetLocaleColumn() string {
+	if x != nil && x.LocaleColumn != nil {
+		return *x.LocaleColumn
+	}
+	return ""
+}
+
 type GenerateFloat64 struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -2333,6 +2936,12 @@ type GenerateFullAddress struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	// the locale that values are generated for, such as de_DE, ja_JP or pt_BR. defaults to en_US if unspecified
+	Locale *string `protobuf:"bytes,1,opt,name=locale,proto3,oneof" json:"locale,omitempty"`
+	// a column in the same table whose value, such as a locale or country code, overrides the locale for the row.
+	// the locale is used when the value is empty or is not a supported locale
+	LocaleColumn *string `protobuf:"bytes,2,opt,name=locale_column,json=localeColumn,proto3,oneof" json:"locale_column,omitempty"`
 }
 
 func (x *GenerateFullAddress) Reset() {
@@ -2367,10 +2976,30 @@ func (*GenerateFullAddress) Descriptor() ([]byte, []int) {
 	return file_mgmt_v1alpha1_transformer_proto_rawDescGZIP(), []int{28}
 }
 
+func (x *GenerateFullAddress) GetLocale() string {
+	if x != nil && x.Locale != nil {
+		return *x.Locale
+	}
+	return ""
+}
+
+func (x *GenerateFullAddress) GetLocaleColumn() string {
+	if x != nil && x.LocaleColumn != nil {
+		return *x.LocaleColumn
+	}
+	return ""
+}
+
 type GenerateFullName struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	// the locale that values are generated for, such as de_DE, ja_JP or pt_BR. defaults to en_US if unspecified
+	Locale *string `protobuf:"bytes,1,opt,name=locale,proto3,oneof" json:"locale,omitempty"`
+	// a column in the same table whose value, such as a locale or country code, overrides the locale for the row.
+	// the locale is used when the value is empty or is not a supported locale
+	LocaleColumn *string `protobuf:"bytes,2,opt,name=locale_column,json=localeColumn,proto3,oneof" json:"locale_column,omitempty"`
 }
 
 func (x *GenerateFullName) Reset() {
@@ -2405,6 +3034,20 @@ func (*GenerateFullName) Descriptor() ([]byte, []int) {
 	return file_mgmt_v1alpha1_transformer_proto_rawDescGZIP(), []int{29}
 }
 
+func (x *GenerateFullName) GetLocale() string {
+	if x != nil && x.Locale != nil {
+		return *x.Locale
+	}
+	return ""
+}
+
+func (x *GenerateFullName) GetLocaleColumn() string {
+	if x != nil && x.LocaleColumn != nil {
+		return *x.LocaleColumn
+	}
+	return ""
+}
+
 type GenerateGender struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -2557,6 +3200,12 @@ type GenerateLastName struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	// the locale that values are generated for, such as de_DE, ja_JP or pt_BR. defaults to en_US if unspecified
+	Locale *string `protobuf:"bytes,1,opt,name=locale,proto3,oneof" json:"locale,omitempty"`
+	// a column in the same table whose value, such as a locale or country code, overrides the locale for the row.
+	// the locale is used when the value is empty or is not a supported locale
+	LocaleColumn *string `protobuf:"bytes,2,opt,name=locale_column,json=localeColumn,proto3,oneof" json:"locale_column,omitempty"`
 }
 
 func (x *GenerateLastName) Reset() {
@@ -2591,6 +3240,20 @@ func (*GenerateLastName) Descriptor() ([]byte, []int) {
 	return file_mgmt_v1alpha1_transformer_proto_rawDescGZIP(), []int{33}
 }
 
+func (x *GenerateLastName) GetLocale() string {
+	if x != nil && x.Locale != nil {
+		return *x.Locale
+	}
+	return ""
+}
+
+func (x *GenerateLastName) GetLocaleColumn() string {
+	if x != nil && x.LocaleColumn != nil {
+		return *x.LocaleColumn
+	}
+	return ""
+}
+
 type GenerateSha256Hash struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -2671,6 +3334,12 @@ type GenerateState struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	// the locale that values are generated for, such as de_DE, ja_JP or pt_BR. defaults to en_US if unspecified
+	Locale *string `protobuf:"bytes,1,opt,name=locale,proto3,oneof" json:"locale,omitempty"`
+	// a column in the same table whose value, such as a locale or country code, overrides the locale for the row.
+	// the locale is used when the value is empty or is not a supported locale
+	LocaleColumn *string `protobuf:"bytes,2,opt,name=locale_column,json=localeColumn,proto3,oneof" json:"locale_column,omitempty"`
 }
 
 func (x *GenerateState) Reset() {
@@ -2705,10 +3374,30 @@ func (*GenerateState) Descriptor() ([]byte, []int) {
 	return file_mgmt_v1alpha1_transformer_proto_rawDescGZIP(), []int{36}
 }
 
+func (x *GenerateState) GetLocale() string {
+	if x != nil && x.Locale != nil {
+		return *x.Locale
+	}
+	return ""
+}
+
+func (x *GenerateState) GetLocaleColumn() string {
+	if x != nil && x.LocaleColumn != nil {
+		return *x.LocaleColumn
+	}
+	return ""
+}
+
 type GenerateStreetAddress struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	// the locale that values are generated for, such as de_DE, ja_JP or pt_BR. defaults to en_US if unspecified
+	Locale *string `protobuf:"bytes,1,opt,name=locale,proto3,oneof" json:"locale,omitempty"`
+	// a column in the same table whose value, such as a locale or country code, overrides the locale for the row.
+	// the locale is used when the value is empty or is not a supported locale
+	LocaleColumn *string `protobuf:"bytes,2,opt,name=locale_column,json=localeColumn,proto3,oneof" json:"locale_column,omitempty"`
 }
 
 func (x *GenerateStreetAddress) Reset() {
@@ -2743,6 +3432,20 @@ func (*GenerateStreetAddress) Descriptor() ([]byte, []int) {
 	return file_mgmt_v1alpha1_transformer_proto_rawDescGZIP(), []int{37}
 }
 
+func (x *GenerateStreetAddress) GetLocale() string {
+	if x != nil && x.Locale != nil {
+		return *x.Locale
+	}
+	return ""
+}
+
+func (x *GenerateStreetAddress) GetLocaleColumn() string {
+	if x != nil && x.LocaleColumn != nil {
+		return *x.LocaleColumn
+	}
+	return ""
+}
+
 type GenerateStringPhoneNumber struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -2750,6 +3453,11 @@ type GenerateStringPhoneNumber struct {
 
 	Min int64 `protobuf:"varint,2,opt,name=min,proto3" json:"min,omitempty"`
 	Max int64 `protobuf:"varint,3,opt,name=max,proto3" json:"max,omitempty"`
+	// the locale that values are generated for, such as de_DE, ja_JP or pt_BR. defaults to en_US if unspecified
+	Locale *string `protobuf:"bytes,4,opt,name=locale,proto3,oneof" json:"locale,omitempty"`
+	// a column in the same table whose value, such as a locale or country code, overrides the locale for the row.
+	// the locale is used when the value is empty or is not a supported locale
+	LocaleColumn *string `protobuf:"bytes,5,opt,name=locale_column,json=localeColumn,proto3,oneof" json:"locale_column,omitempty"`
 }
 
 func (x *GenerateStringPhoneNumber) Reset() {
@@ -2798,6 +3506,20 @@ func (x *GenerateStringPhoneNumber) GetMax() int64 {
 	return 0
 }
 
+func (x *GenerateStringPhoneNumber) GetLocale() string {
+	if x != nil && x.Locale != nil {
+		return *x.Locale
+	}
+	return ""
+}
+
+func (x *GenerateStringPhoneNumber) GetLocaleColumn() string {
+	if x != nil && x.LocaleColumn != nil {
+		return *x.LocaleColumn
+	}
+	return ""
+}
+
 type GenerateString struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -3018,6 +3740,12 @@ type GenerateZipcode struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	// the locale that values are generated for, such as de_DE, ja_JP or pt_BR. defaults to en_US if unspecified
+	Locale *string `protobuf:"bytes,1,opt,name=locale,proto3,oneof" json:"locale,omitempty"`
+	// a column in the same table whose value, such as a locale or country code, overrides the locale for the row.
+	// the locale is used when the value is empty or is not a supported locale
+	LocaleColumn *string `protobuf:"bytes,2,opt,name=locale_column,json=localeColumn,proto3,oneof" json:"locale_column,omitempty"`
 }
 
 func (x *GenerateZipcode) Reset() {
@@ -3052,12 +3780,28 @@ func (*GenerateZipcode) Descriptor() ([]byte, []int) {
 	return file_mgmt_v1alpha1_transformer_proto_rawDescGZIP(), []int{44}
 }
 
+func (x *GenerateZipcode) GetLocale() string {
+	if x != nil && x.Locale != nil {
+		return *x.Locale
+	}
+	return ""
+}
+
+func (x *GenerateZipcode) GetLocaleColumn() string {
+	if x != nil && x.LocaleColumn != nil {
+		return *x.LocaleColumn
+	}
+	return ""
+}
+
 type TransformE164PhoneNumber struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	PreserveLength bool `protobuf:"varint,1,opt,name=preserve_length,json=preserveLength,proto3" json:"preserve_length,omitempty"`
+	// transforms the same input value to the same output using the account's transformer key
+	Deterministic bool `protobuf:"varint,2,opt,name=deterministic,proto3" json:"deterministic,omitempty"`
 }
 
 func (x *TransformE164PhoneNumber) Reset() {
@@ -3099,12 +3843,26 @@ func (x *TransformE164PhoneNumber) GetPreserveLength() bool {
 	return false
 }
 
+func (x *TransformE164PhoneNumber) GetDeterministic() bool {
+	if x != nil {
+		return x.Deterministic
+	}
+	return false
+}
+
 type TransformFirstName struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	PreserveLength bool `protobuf:"varint,1,opt,name=preserve_length,json=preserveLength,proto3" json:"preserve_length,omitempty"`
+	// transforms the same input value to the same output using the account's transformer key
+	Deterministic bool `protobuf:"varint,2,opt,name=deterministic,proto3" json:"deterministic,omitempty"`
+	// the locale that values are generated for, such as de_DE, ja_JP or pt_BR. defaults to en_US if unspecified
+	Locale *string `protobuf:"bytes,3,opt,name=locale,proto3,oneof" json:"locale,omitempty"`
+	// a column in the same table whose value, such as a locale or country code, overrides the locale for the row.
+	// the locale is used when the value is empty or is not a supported locale
+	LocaleColumn *string `protobuf:"bytes,4,opt,name=locale_column,json=localeColumn,proto3,oneof" json:"locale_column,omitempty"`
 }
 
 func (x *TransformFirstName) Reset() {
@@ -3146,6 +3904,27 @@ func (x *TransformFirstName) GetPreserveLength() bool {
 	return false
 }
 
+func (x *TransformFirstName) GetDeterministic() bool {
+	if x != nil {
+		return x.Deterministic
+	}
+	return false
+}
+
+func (x *TransformFirstName) GetLocale() string {
+	if x != nil && x.Locale != nil {
+		return *x.Locale
+	}
+	return ""
+}
+
+func (x *TransformFirstName) GetLocaleColumn() string {
+	if x != nil && x.LocaleColumn != nil {
+		return *x.LocaleColumn
+	}
+	return ""
+}
+
 type TransformFloat64 struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -3153,6 +3932,8 @@ type TransformFloat64 struct {
 
 	RandomizationRangeMin float64 `protobuf:"fixed64,1,opt,name=randomization_range_min,json=randomizationRangeMin,proto3" json:"randomization_range_min,omitempty"`
 	RandomizationRangeMax float64 `protobuf:"fixed64,2,opt,name=randomization_range_max,json=randomizationRangeMax,proto3" json:"randomization_range_max,omitempty"`
+	// transforms the same input value to the same output using the account's transformer key
+	Deterministic bool `protobuf:"varint,3,opt,name=deterministic,proto3" json:"deterministic,omitempty"`
 }
 
 func (x *TransformFloat64) Reset() {
@@ -3201,12 +3982,26 @@ func (x *TransformFloat64) GetRandomizationRangeMax() float64 {
 	return 0
 }
 
+func (x *TransformFloat64) GetDeterministic() bool {
+	if x != nil {
+		return x.Deterministic
+	}
+	return false
+}
+
 type TransformFullName struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	PreserveLength bool `protobuf:"varint,1,opt,name=preserve_length,json=preserveLength,proto3" json:"preserve_length,omitempty"`
+	// transforms the same input value to the same output using the account's transformer key
+	Deterministic bool `protobuf:"varint,2,opt,name=deterministic,proto3" json:"deterministic,omitempty"`
+	// the locale that values are generated for, such as de_DE, ja_JP or pt_BR. defaults to en_US if unspecified
+	Locale *string `protobuf:"bytes,3,opt,name=locale,proto3,oneof" json:"locale,omitempty"`
+	// a column in the same table whose value, such as a locale or country code, overrides the locale for the row.
+	// the locale is used when the value is empty or is not a supported locale
+	LocaleColumn *string `protobuf:"bytes,4,opt,name=locale_column,json=localeColumn,proto3,oneof" json:"locale_column,omitempty"`
 }
 
 func (x *TransformFullName) Reset() {
@@ -3248,12 +4043,35 @@ func (x *TransformFullName) GetPreserveLength() bool {
 	return false
 }
 
+func (x *TransformFullName) GetDeterministic() bool {
+	if x != nil {
+		return x.Deterministic
+	}
+	return false
+}
+
+func (x *TransformFullName) GetLocale() string {
+	if x != nil && x.Locale != nil {
+		return *x.Locale
+	}
+	return ""
+}
+
+func (x *TransformFullName) GetLocaleColumn() string {
+	if x != nil && x.LocaleColumn != nil {
+		return *x.LocaleColumn
+	}
+	return ""
+}
+
 type TransformInt64PhoneNumber struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	PreserveLength bool `protobuf:"varint,1,opt,name=preserve_length,json=preserveLength,proto3" json:"preserve_length,omitempty"`
+	// transforms the same input value to the same output using the account's transformer key
+	Deterministic bool `protobuf:"varint,2,opt,name=deterministic,proto3" json:"deterministic,omitempty"`
 }
 
 func (x *TransformInt64PhoneNumber) Reset() {
@@ -3295,6 +4113,13 @@ func (x *TransformInt64PhoneNumber) GetPreserveLength() bool {
 	return false
 }
 
+func (x *TransformInt64PhoneNumber) GetDeterministic() bool {
+	if x != nil {
+		return x.Deterministic
+	}
+	return false
+}
+
 type TransformInt64 struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -3302,6 +4127,8 @@ type TransformInt64 struct {
 
 	RandomizationRangeMin int64 `protobuf:"varint,1,opt,name=randomization_range_min,json=randomizationRangeMin,proto3" json:"randomization_range_min,omitempty"`
 	RandomizationRangeMax int64 `protobuf:"varint,2,opt,name=randomization_range_max,json=randomizationRangeMax,proto3" json:"randomization_range_max,omitempty"`
+	// transforms the same input value to the same output using the account's transformer key
+	Deterministic bool `protobuf:"varint,3,opt,name=deterministic,proto3" json:"deterministic,omitempty"`
 }
 
 func (x *TransformInt64) Reset() {
@@ -3350,12 +4177,26 @@ func (x *TransformInt64) GetRandomizationRangeMax() int64 {
 	return 0
 }
 
+func (x *TransformInt64) GetDeterministic() bool {
+	if x != nil {
+		return x.Deterministic
+	}
+	return false
+}
+
 type TransformLastName struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	PreserveLength bool `protobuf:"varint,1,opt,name=preserve_length,json=preserveLength,proto3" json:"preserve_length,omitempty"`
+	// transforms the same input value to the same output using the account's transformer key
+	Deterministic bool `protobuf:"varint,2,opt,name=deterministic,proto3" json:"deterministic,omitempty"`
+	// the locale that values are generated for, such as de_DE, ja_JP or pt_BR. defaults to en_US if unspecified
+	Locale *string `protobuf:"bytes,3,opt,name=locale,proto3,oneof" json:"locale,omitempty"`
+	// a column in the same table whose value, such as a locale or country code, overrides the locale for the row.
+	// the locale is used when the value is empty or is not a supported locale
+	LocaleColumn *string `protobuf:"bytes,4,opt,name=locale_column,json=localeColumn,proto3,oneof" json:"locale_column,omitempty"`
 }
 
 func (x *TransformLastName) Reset() {
@@ -3397,12 +4238,40 @@ func (x *TransformLastName) GetPreserveLength() bool {
 	return false
 }
 
+func (x *TransformLastName) GetDeterministic() bool {
+	if x != nil {
+		return x.Deterministic
+	}
+	return false
+}
+
+func (x *TransformLastName) GetLocale() string {
+	if x != nil && x.Locale != nil {
+		return *x.Locale
+	}
+	return ""
+}
+
+func (x *TransformLastName) GetLocaleColumn() string {
+	if x != nil && x.LocaleColumn != nil {
+		return *x.LocaleColumn
+	}
+	return ""
+}
+
 type TransformPhoneNumber struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	PreserveLength bool `protobuf:"varint,1,opt,name=preserve_length,json=preserveLength,proto3" json:"preserve_length,omitempty"`
+	// transforms the same input value to the same output using the account's transformer key
+	Deterministic bool `protobuf:"varint,2,opt,name=deterministic,proto3" json:"deterministic,omitempty"`
+	// the locale that values are generated for, such as de_DE, ja_JP or pt_BR. defaults to en_US if unspecified
+	Locale *string `protobuf:"bytes,3,opt,name=locale,proto3,oneof" json:"locale,omitempty"`
+	// a column in the same table whose value, such as a locale or country code, overrides the locale for the row.
+	// the locale is used when the value is empty or is not a supported locale
+	LocaleColumn *string `protobuf:"bytes,4,opt,name=locale_column,json=localeColumn,proto3,oneof" json:"locale_column,omitempty"`
 }
 
 func (x *TransformPhoneNumber) Reset() {
@@ -3444,12 +4313,35 @@ func (x *TransformPhoneNumber) GetPreserveLength() bool {
 	return false
 }
 
+func (x *TransformPhoneNumber) GetDeterministic() bool {
+	if x != nil {
+		return x.Deterministic
+	}
+	return false
+}
+
+func (x *TransformPhoneNumber) GetLocale() string {
+	if x != nil && x.Locale != nil {
+		return *x.Locale
+	}
+	return ""
+}
+
+func (x *TransformPhoneNumber) GetLocaleColumn() string {
+	if x != nil && x.LocaleColumn != nil {
+		return *x.LocaleColumn
+	}
+	return ""
+}
+
 type TransformString struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	PreserveLength bool `protobuf:"varint,1,opt,name=preserve_length,json=preserveLength,proto3" json:"preserve_length,omitempty"`
+	// transforms the same input value to the same output using the account's transformer key
+	Deterministic bool `protobuf:"varint,2,opt,name=deterministic,proto3" json:"deterministic,omitempty"`
 }
 
 func (x *TransformString) Reset() {
@@ -3491,6 +4383,13 @@ func (x *TransformString) GetPreserveLength() bool {
 	return false
 }
 
+func (x *TransformString) GetDeterministic() bool {
+	if x != nil {
+		return x.Deterministic
+	}
+	return false
+}
+
 type Passthrough struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -3817,6 +4716,8 @@ type TransformCharacterScramble struct {
 
 	// a user provided regular expression that they wish to validate if it compiles in GO
 	UserProvidedRegex *string `protobuf:"bytes,1,opt,name=user_provided_regex,json=userProvidedRegex,proto3,oneof" json:"user_provided_regex,omitempty"`
+	// transforms the same input value to the same output using the account's transformer key
+	Deterministic bool `protobuf:"varint,2,opt,name=deterministic,proto3" json:"deterministic,omitempty"`
 }
 
 func (x *TransformCharacterScramble) Reset() {
@@ -3858,6 +4759,13 @@ func (x *TransformCharacterScramble) GetUserProvidedRegex() string {
 	return ""
 }
 
+func (x *TransformCharacterScramble) GetDeterministic() bool {
+	if x != nil {
+		return x.Deterministic
+	}
+	return false
+}
+
 type GenerateJavascript struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -3905,17 +4813,19 @@ func (x *GenerateJavascript) GetCode() string {
 	return ""
 }
 
-type ValidateUserRegexCodeRequest struct {
+type TransformFormatPreservingEncrypt struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	AccountId         string `protobuf:"bytes,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
-	UserProvidedRegex string `protobuf:"bytes,2,opt,name=user_provided_regex,json=userProvidedRegex,proto3" json:"user_provided_regex,omitempty"`
+	// the format preserving encryption mode. defaults to FF1 if unspecified
+	Algorithm FormatPreservingEncryptionAlgorithm `protobuf:"varint,1,opt,name=algorithm,proto3,enum=mgmt.v1alpha1.FormatPreservingEncryptionAlgorithm" json:"algorithm,omitempty"`
+	// an optional tweak that is mixed into the encryption. the same tweak must be provided to decrypt the value
+	Tweak string `protobuf:"bytes,2,opt,name=tweak,proto3" json:"tweak,omitempty"`
 }
 
-func (x *ValidateUserRegexCodeRequest) Reset() {
-	*x = ValidateUserRegexCodeRequest{}
+func (x *TransformFormatPreservingEncrypt) Reset() {
+	*x = TransformFormatPreservingEncrypt{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_mgmt_v1alpha1_transformer_proto_msgTypes[63]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3923,13 +4833,13 @@ func (x *ValidateUserRegexCodeRequest) Reset() {
 	}
 }
 
-func (x *ValidateUserRegexCodeRequest) String() string {
+func (x *TransformFormatPreservingEncrypt) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ValidateUserRegexCodeRequest) ProtoMessage() {}
+func (*TransformFormatPreservingEncrypt) ProtoMessage() {}
 
-func (x *ValidateUserRegexCodeRequest) ProtoReflect() protoreflect.Message {
+func (x *TransformFormatPreservingEncrypt) ProtoReflect() protoreflect.Message {
 	mi := &file_mgmt_v1alpha1_transformer_proto_msgTypes[63]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3941,35 +4851,48 @@ func (x *ValidateUserRegexCodeRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ValidateUserRegexCodeRequest.ProtoReflect.Descriptor instead.
-func (*ValidateUserRegexCodeRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use TransformFormatPreservingEncrypt.ProtoReflect.Descriptor instead.
+func (*TransformFormatPreservingEncrypt) Descriptor() ([]byte, []int) {
 	return file_mgmt_v1alpha1_transformer_proto_rawDescGZIP(), []int{63}
 }
 
-func (x *ValidateUserRegexCodeRequest) GetAccountId() string {
+func (x *TransformFormatPreservingEncrypt) GetAlgorithm() FormatPreservingEncryptionAlgorithm {
 	if x != nil {
-		return x.AccountId
+		return x.Algorithm
 	}
-	return ""
+	return FormatPreservingEncryptionAlgorithm_FORMAT_PRESERVING_ENCRYPTION_ALGORITHM_UNSPECIFIED
 }
 
-func (x *ValidateUserRegexCodeRequest) GetUserProvidedRegex() string {
+func (x *TransformFormatPreservingEncrypt) GetTweak() string {
 	if x != nil {
-		return x.UserProvidedRegex
+		return x.Tweak
 	}
 	return ""
 }
 
-type ValidateUserRegexCodeResponse struct {
+type TransformTimestamp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Valid bool `protobuf:"varint,1,opt,name=valid,proto3" json:"valid,omitempty"`
-}
-
-func (x *ValidateUserRegexCodeResponse) Reset() {
-	*x = ValidateUserRegexCodeResponse{}
+	Mode TimeTransformMode `protobuf:"varint,1,opt,name=mode,proto3,enum=mgmt.v1alpha1.TimeTransformMode" json:"mode,omitempty"`
+	// shift: the offset is picked in the inclusive range of [-shift_range_days, shift_range_days] days
+	ShiftRangeDays int64 `protobuf:"varint,2,opt,name=shift_range_days,json=shiftRangeDays,proto3" json:"shift_range_days,omitempty"`
+	// shift: a column in the same table that identifies the entity of the row, for example a user id.
+	// values with the same entity are always shifted by the same offset. if unset, every value is shifted by the same offset
+	EntityColumn *string `protobuf:"bytes,3,opt,name=entity_column,json=entityColumn,proto3,oneof" json:"entity_column,omitempty"`
+	// jitter: the offset is picked in the inclusive range of [-jitter_range_seconds, jitter_range_seconds] seconds
+	JitterRangeSeconds int64 `protobuf:"varint,4,opt,name=jitter_range_seconds,json=jitterRangeSeconds,proto3" json:"jitter_range_seconds,omitempty"`
+	// truncate: the precision to truncate the value to. defaults to month if unspecified
+	TruncatePrecision TimeTruncatePrecision `protobuf:"varint,5,opt,name=truncate_precision,json=truncatePrecision,proto3,enum=mgmt.v1alpha1.TimeTruncatePrecision" json:"truncate_precision,omitempty"`
+	// clamp: the lower bound of the range as an RFC 3339 timestamp
+	Min *string `protobuf:"bytes,6,opt,name=min,proto3,oneof" json:"min,omitempty"`
+	// clamp: the upper bound of the range as an RFC 3339 timestamp
+	Max *string `protobuf:"bytes,7,opt,name=max,proto3,oneof" json:"max,omitempty"`
+}
+
+func (x *TransformTimestamp) Reset() {
+	*x = TransformTimestamp{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_mgmt_v1alpha1_transformer_proto_msgTypes[64]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3977,13 +4900,13 @@ func (x *ValidateUserRegexCodeResponse) Reset() {
 	}
 }
 
-func (x *ValidateUserRegexCodeResponse) String() string {
+func (x *TransformTimestamp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ValidateUserRegexCodeResponse) ProtoMessage() {}
+func (*TransformTimestamp) ProtoMessage() {}
 
-func (x *ValidateUserRegexCodeResponse) ProtoReflect() protoreflect.Message {
+func (x *TransformTimestamp) ProtoReflect() protoreflect.Message {
 	mi := &file_mgmt_v1alpha1_transformer_proto_msgTypes[64]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3995,68 +4918,1116 @@ func (x *ValidateUserRegexCodeResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ValidateUserRegexCodeResponse.ProtoReflect.Descriptor instead.
-func (*ValidateUserRegexCodeResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use TransformTimestamp.ProtoReflect.Descriptor instead.
+func (*TransformTimestamp) Descriptor() ([]byte, []int) {
 	return file_mgmt_v1alpha1_transformer_proto_rawDescGZIP(), []int{64}
 }
 
-func (x *ValidateUserRegexCodeResponse) GetValid() bool {
+func (x *TransformTimestamp) GetMode() TimeTransformMode {
 	if x != nil {
-		return x.Valid
+		return x.Mode
 	}
-	return false
+	return TimeTransformMode_TIME_TRANSFORM_MODE_UNSPECIFIED
 }
 
-var File_mgmt_v1alpha1_transformer_proto protoreflect.FileDescriptor
+func (x *TransformTimestamp) GetShiftRangeDays() int64 {
+	if x != nil {
+		return x.ShiftRangeDays
+	}
+	return 0
+}
 
-var file_mgmt_v1alpha1_transformer_proto_rawDesc = []byte{
-	0x0a, 0x1f, 0x6d, 0x67, 0x6d, 0x74, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f,
-	0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x12, 0x0d, 0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31,
-	0x1a, 0x1b, 0x62, 0x75, 0x66, 0x2f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2f, 0x76,
-	0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x67,
-	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74,
-	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x1e,
-	0x0a, 0x1c, 0x47, 0x65, 0x74, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x54, 0x72, 0x61, 0x6e, 0x73,
-	0x66, 0x6f, 0x72, 0x6d, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x65,
-	0x0a, 0x1d, 0x47, 0x65, 0x74, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x54, 0x72, 0x61, 0x6e, 0x73,
-	0x66, 0x6f, 0x72, 0x6d, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x44, 0x0a, 0x0c, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x65, 0x72, 0x73, 0x18,
-	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x76, 0x31, 0x61,
-	0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x54, 0x72, 0x61, 0x6e,
-	0x73, 0x66, 0x6f, 0x72, 0x6d, 0x65, 0x72, 0x52, 0x0c, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f,
-	0x72, 0x6d, 0x65, 0x72, 0x73, 0x22, 0x5f, 0x0a, 0x23, 0x47, 0x65, 0x74, 0x53, 0x79, 0x73, 0x74,
-	0x65, 0x6d, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x65, 0x72, 0x42, 0x79, 0x53,
-	0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x38, 0x0a, 0x06,
-	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x20, 0x2e, 0x6d,
-	0x67, 0x6d, 0x74, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x54, 0x72, 0x61,
-	0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x65, 0x72, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x06,
-	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x22, 0x6a, 0x0a, 0x24, 0x47, 0x65, 0x74, 0x53, 0x79, 0x73,
-	0x74, 0x65, 0x6d, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x65, 0x72, 0x42, 0x79,
-	0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x42,
-	0x0a, 0x0b, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x65, 0x72, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70,
-	0x68, 0x61, 0x31, 0x2e, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66,
-	0x6f, 0x72, 0x6d, 0x65, 0x72, 0x52, 0x0b, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d,
-	0x65, 0x72, 0x22, 0x4c, 0x0a, 0x21, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x44, 0x65, 0x66,
-	0x69, 0x6e, 0x65, 0x64, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x65, 0x72, 0x73,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x27, 0x0a, 0x0a, 0x61, 0x63, 0x63, 0x6f, 0x75,
-	0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0xba, 0x48, 0x05,
-	0x72, 0x03, 0xb0, 0x01, 0x01, 0x52, 0x09, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x64,
-	0x22, 0x6f, 0x0a, 0x22, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x44, 0x65, 0x66, 0x69, 0x6e,
-	0x65, 0x64, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x65, 0x72, 0x73, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x49, 0x0a, 0x0c, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66,
-	0x6f, 0x72, 0x6d, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x6d,
-	0x67, 0x6d, 0x74, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x55, 0x73, 0x65,
-	0x72, 0x44, 0x65, 0x66, 0x69, 0x6e, 0x65, 0x64, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72,
-	0x6d, 0x65, 0x72, 0x52, 0x0c, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x65, 0x72,
-	0x73, 0x22, 0x57, 0x0a, 0x24, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x44, 0x65, 0x66, 0x69,
-	0x6e, 0x65, 0x64, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x65, 0x72, 0x42, 0x79,
-	0x49, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2f, 0x0a, 0x0e, 0x74, 0x72, 0x61,
-	0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x42, 0x08, 0xba, 0x48, 0x05, 0x72, 0x03, 0xb0, 0x01, 0x01, 0x52, 0x0d, 0x74, 0x72, 0x61,
-	0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x65, 0x72, 0x49, 0x64, 0x22, 0x70, 0x0a, 0x25, 0x47, 0x65,
-	0x74, 0x55, 0x73, 0x65, 0x72, 0x44, 0x65, 0x66, 0x69, 0x6e, 0x65, 0x64, 0x54, 0x72, 0x61, 0x6e,
+func (x *TransformTimestamp) GetEntityColumn() string {
+	if x != nil && x.EntityColumn != nil {
+		return *x.EntityColumn
+	}
+	return ""
+}
+
+func (x *TransformTimestamp) GetJitterRangeSeconds() int64 {
+	if x != nil {
+		return x.JitterRangeSeconds
+	}
+	return 0
+}
+
+func (x *TransformTimestamp) GetTruncatePrecision() TimeTruncatePrecision {
+	if x != nil {
+		return x.TruncatePrecision
+	}
+	return TimeTruncatePrecision_TIME_TRUNCATE_PRECISION_UNSPECIFIED
+}
+
+func (x *TransformTimestamp) GetMin() string {
+	if x != nil && x.Min != nil {
+		return *x.Min
+	}
+	return ""
+}
+
+func (x *TransformTimestamp) GetMax() string {
+	if x != nil && x.Max != nil {
+		return *x.Max
+	}
+	return ""
+}
+
+type TransformDate struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Mode TimeTransformMode `protobuf:"varint,1,opt,name=mode,proto3,enum=mgmt.v1alpha1.TimeTransformMode" json:"mode,omitempty"`
+	// shift: the offset is picked in the inclusive range of [-shift_range_days, shift_range_days] days
+	ShiftRangeDays int64 `protobuf:"varint,2,opt,name=shift_range_days,json=shiftRangeDays,proto3" json:"shift_range_days,omitempty"`
+	// shift: a column in the same table that identifies the entity of the row, for example a user id.
+	// values with the same entity are always shifted by the same offset. if unset, every value is shifted by the same offset
+	EntityColumn *string `protobuf:"bytes,3,opt,name=entity_column,json=entityColumn,proto3,oneof" json:"entity_column,omitempty"`
+	// jitter: the offset is picked in the inclusive range of [-jitter_range_days, jitter_range_days] days
+	JitterRangeDays int64 `protobuf:"varint,4,opt,name=jitter_range_days,json=jitterRangeDays,proto3" json:"jitter_range_days,omitempty"`
+	// truncate: the precision to truncate the value to. defaults to month if unspecified
+	TruncatePrecision TimeTruncatePrecision `protobuf:"varint,5,opt,name=truncate_precision,json=truncatePrecision,proto3,enum=mgmt.v1alpha1.TimeTruncatePrecision" json:"truncate_precision,omitempty"`
+	// clamp: the lower bound of the range as a YYYY-MM-DD date
+	Min *string `protobuf:"bytes,6,opt,name=min,proto3,oneof" json:"min,omitempty"`
+	// clamp: the upper bound of the range as a YYYY-MM-DD date
+	Max *string `protobuf:"bytes,7,opt,name=max,proto3,oneof" json:"max,omitempty"`
+}
+
+func (x *TransformDate) Reset() {
+	*x = TransformDate{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mgmt_v1alpha1_transformer_proto_msgTypes[65]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TransformDate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransformDate) ProtoMessage() {}
+
+func (x *TransformDate) ProtoReflect() protoreflect.Message {
+	mi := &file_mgmt_v1alpha1_transformer_proto_msgTypes[65]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransformDate.ProtoReflect.Descriptor instead.
+func (*TransformDate) Descriptor() ([]byte, []int) {
+	return file_mgmt_v1alpha1_transformer_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *TransformDate) GetMode() TimeTransformMode {
+	if x != nil {
+		return x.Mode
+	}
+	return TimeTransformMode_TIME_TRANSFORM_MODE_UNSPECIFIED
+}
+
+func (x *TransformDate) GetShiftRangeDays() int64 {
+	if x != nil {
+		return x.ShiftRangeDays
+	}
+	return 0
+}
+
+func (x *TransformDate) GetEntityColumn() string {
+	if x != nil && x.EntityColumn != nil {
+		return *x.EntityColumn
+	}
+	return ""
+}
+
+func (x *TransformDate) GetJitterRangeDays() int64 {
+	if x != nil {
+		return x.JitterRangeDays
+	}
+	return 0
+}
+
+func (x *TransformDate) GetTruncatePrecision() TimeTruncatePrecision {
+	if x != nil {
+		return x.TruncatePrecision
+	}
+	return TimeTruncatePrecision_TIME_TRUNCATE_PRECISION_UNSPECIFIED
+}
+
+func (x *TransformDate) GetMin() string {
+	if x != nil && x.Min != nil {
+		return *x.Min
+	}
+	return ""
+}
+
+func (x *TransformDate) GetMax() string {
+	if x != nil && x.Max != nil {
+		return *x.Max
+	}
+	return ""
+}
+
+type TransformJson struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// the rules are run in order, so a value that is selected by more than one rule is transformed by each of them
+	Rules []*TransformJsonRule `protobuf:"bytes,1,rep,name=rules,proto3" json:"rules,omitempty"`
+}
+
+func (x *TransformJson) Reset() {
+	*x = TransformJson{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mgmt_v1alpha1_transformer_proto_msgTypes[66]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TransformJson) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransformJson) ProtoMessage() {}
+
+func (x *TransformJson) ProtoReflect() protoreflect.Message {
+	mi := &file_mgmt_v1alpha1_transformer_proto_msgTypes[66]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransformJson.ProtoReflect.Descriptor instead.
+func (*TransformJson) Descriptor() ([]byte, []int) {
+	return file_mgmt_v1alpha1_transformer_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *TransformJson) GetRules() []*TransformJsonRule {
+	if x != nil {
+		return x.Rules
+	}
+	return nil
+}
+
+type TransformJsonRule struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// a JSONPath expression that selects the values to transform, for example $.contact.email or $.tags[*]
+	// supports child keys, quoted keys, array indexes and wildcards
+	Path string `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	// the transformer that is run on every selected value
+	Source TransformerSource  `protobuf:"varint,2,opt,name=source,proto3,enum=mgmt.v1alpha1.TransformerSource" json:"source,omitempty"`
+	Config *TransformerConfig `protobuf:"bytes,3,opt,name=config,proto3" json:"config,omitempty"`
+}
+
+func (x *TransformJsonRule) Reset() {
+	*x = TransformJsonRule{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mgmt_v1alpha1_transformer_proto_msgTypes[67]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TransformJsonRule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransformJsonRule) ProtoMessage() {}
+
+func (x *TransformJsonRule) ProtoReflect() protoreflect.Message {
+	mi := &file_mgmt_v1alpha1_transformer_proto_msgTypes[67]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransformJsonRule.ProtoReflect.Descriptor instead.
+func (*TransformJsonRule) Descriptor() ([]byte, []int) {
+	return file_mgmt_v1alpha1_transformer_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *TransformJsonRule) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *TransformJsonRule) GetSource() TransformerSource {
+	if x != nil {
+		return x.Source
+	}
+	return TransformerSource_TRANSFORMER_SOURCE_UNSPECIFIED
+}
+
+func (x *TransformJsonRule) GetConfig() *TransformerConfig {
+	if x != nil {
+		return x.Config
+	}
+	return nil
+}
+
+type TransformFreeText struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// the entity types to detect. every entity type is detected if empty
+	EntityTypes []FreeTextEntityType `protobuf:"varint,1,rep,packed,name=entity_types,json=entityTypes,proto3,enum=mgmt.v1alpha1.FreeTextEntityType" json:"entity_types,omitempty"`
+	// defaults to mask if unspecified
+	Strategy FreeTextReplacementStrategy `protobuf:"varint,2,opt,name=strategy,proto3,enum=mgmt.v1alpha1.FreeTextReplacementStrategy" json:"strategy,omitempty"`
+}
+
+func (x *TransformFreeText) Reset() {
+	*x = TransformFreeText{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mgmt_v1alpha1_transformer_proto_msgTypes[68]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TransformFreeText) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransformFreeText) ProtoMessage() {}
+
+func (x *TransformFreeText) ProtoReflect() protoreflect.Message {
+	mi := &file_mgmt_v1alpha1_transformer_proto_msgTypes[68]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransformFreeText.ProtoReflect.Descriptor instead.
+func (*TransformFreeText) Descriptor() ([]byte, []int) {
+	return file_mgmt_v1alpha1_transformer_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *TransformFreeText) GetEntityTypes() []FreeTextEntityType {
+	if x != nil {
+		return x.EntityTypes
+	}
+	return nil
+}
+
+func (x *TransformFreeText) GetStrategy() FreeTextReplacementStrategy {
+	if x != nil {
+		return x.Strategy
+	}
+	return FreeTextReplacementStrategy_FREE_TEXT_REPLACEMENT_STRATEGY_UNSPECIFIED
+}
+
+type GenerateIpAddress struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// defaults to ipv4 if unspecified
+	Version IpAddressVersion `protobuf:"varint,1,opt,name=version,proto3,enum=mgmt.v1alpha1.IpAddressVersion" json:"version,omitempty"`
+}
+
+func (x *GenerateIpAddress) Reset() {
+	*x = GenerateIpAddress{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mgmt_v1alpha1_transformer_proto_msgTypes[69]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GenerateIpAddress) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GenerateIpAddress) ProtoMessage() {}
+
+func (x *GenerateIpAddress) ProtoReflect() protoreflect.Message {
+	mi := &file_mgmt_v1alpha1_transformer_proto_msgTypes[69]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GenerateIpAddress.ProtoReflect.Descriptor instead.
+func (*GenerateIpAddress) Descriptor() ([]byte, []int) {
+	return file_mgmt_v1alpha1_transformer_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *GenerateIpAddress) GetVersion() IpAddressVersion {
+	if x != nil {
+		return x.Version
+	}
+	return IpAddressVersion_IP_ADDRESS_VERSION_UNSPECIFIED
+}
+
+type TransformIpAddress struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// the number of leading bits of the address that are kept, for example 24 keeps the 10.1.2 of 10.1.2.3.
+	// the version of the address is always kept. values in CIDR notation keep their prefix length suffix
+	PreservePrefixLength int64 `protobuf:"varint,1,opt,name=preserve_prefix_length,json=preservePrefixLength,proto3" json:"preserve_prefix_length,omitempty"`
+	// keeps an ipv4 address in the same class (A to E) and keeps private, loopback and link local addresses of either version in their range
+	PreserveClass bool `protobuf:"varint,2,opt,name=preserve_class,json=preserveClass,proto3" json:"preserve_class,omitempty"`
+	// transforms the same input value to the same output using the account's transformer key
+	Deterministic bool `protobuf:"varint,3,opt,name=deterministic,proto3" json:"deterministic,omitempty"`
+}
+
+func (x *TransformIpAddress) Reset() {
+	*x = TransformIpAddress{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mgmt_v1alpha1_transformer_proto_msgTypes[70]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TransformIpAddress) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransformIpAddress) ProtoMessage() {}
+
+func (x *TransformIpAddress) ProtoReflect() protoreflect.Message {
+	mi := &file_mgmt_v1alpha1_transformer_proto_msgTypes[70]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransformIpAddress.ProtoReflect.Descriptor instead.
+func (*TransformIpAddress) Descriptor() ([]byte, []int) {
+	return file_mgmt_v1alpha1_transformer_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *TransformIpAddress) GetPreservePrefixLength() int64 {
+	if x != nil {
+		return x.PreservePrefixLength
+	}
+	return 0
+}
+
+func (x *TransformIpAddress) GetPreserveClass() bool {
+	if x != nil {
+		return x.PreserveClass
+	}
+	return false
+}
+
+func (x *TransformIpAddress) GetDeterministic() bool {
+	if x != nil {
+		return x.Deterministic
+	}
+	return false
+}
+
+type GenerateMacAddress struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *GenerateMacAddress) Reset() {
+	*x = GenerateMacAddress{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mgmt_v1alpha1_transformer_proto_msgTypes[71]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GenerateMacAddress) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GenerateMacAddress) ProtoMessage() {}
+
+func (x *GenerateMacAddress) ProtoReflect() protoreflect.Message {
+	mi := &file_mgmt_v1alpha1_transformer_proto_msgTypes[71]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GenerateMacAddress.ProtoReflect.Descriptor instead.
+func (*GenerateMacAddress) Descriptor() ([]byte, []int) {
+	return file_mgmt_v1alpha1_transformer_proto_rawDescGZIP(), []int{71}
+}
+
+type TransformMacAddress struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// keeps the first three bytes of the address, which identify the manufacturer of the device
+	PreserveOui bool `protobuf:"varint,1,opt,name=preserve_oui,json=preserveOui,proto3" json:"preserve_oui,omitempty"`
+	// transforms the same input value to the same output using the account's transformer key
+	Deterministic bool `protobuf:"varint,2,opt,name=deterministic,proto3" json:"deterministic,omitempty"`
+}
+
+func (x *TransformMacAddress) Reset() {
+	*x = TransformMacAddress{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mgmt_v1alpha1_transformer_proto_msgTypes[72]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TransformMacAddress) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransformMacAddress) ProtoMessage() {}
+
+func (x *TransformMacAddress) ProtoReflect() protoreflect.Message {
+	mi := &file_mgmt_v1alpha1_transformer_proto_msgTypes[72]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransformMacAddress.ProtoReflect.Descriptor instead.
+func (*TransformMacAddress) Descriptor() ([]byte, []int) {
+	return file_mgmt_v1alpha1_transformer_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *TransformMacAddress) GetPreserveOui() bool {
+	if x != nil {
+		return x.PreserveOui
+	}
+	return false
+}
+
+func (x *TransformMacAddress) GetDeterministic() bool {
+	if x != nil {
+		return x.Deterministic
+	}
+	return false
+}
+
+type GenerateUrl struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *GenerateUrl) Reset() {
+	*x = GenerateUrl{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mgmt_v1alpha1_transformer_proto_msgTypes[73]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GenerateUrl) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GenerateUrl) ProtoMessage() {}
+
+func (x *GenerateUrl) ProtoReflect() protoreflect.Message {
+	mi := &file_mgmt_v1alpha1_transformer_proto_msgTypes[73]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GenerateUrl.ProtoReflect.Descriptor instead.
+func (*GenerateUrl) Descriptor() ([]byte, []int) {
+	return file_mgmt_v1alpha1_transformer_proto_rawDescGZIP(), []int{73}
+}
+
+type TransformUrl struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// keeps the path as is instead of replacing its characters
+	PreservePath bool `protobuf:"varint,1,opt,name=preserve_path,json=preservePath,proto3" json:"preserve_path,omitempty"`
+	// transforms the same input value to the same output using the account's transformer key
+	Deterministic bool `protobuf:"varint,2,opt,name=deterministic,proto3" json:"deterministic,omitempty"`
+}
+
+func (x *TransformUrl) Reset() {
+	*x = TransformUrl{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mgmt_v1alpha1_transformer_proto_msgTypes[74]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TransformUrl) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransformUrl) ProtoMessage() {}
+
+func (x *TransformUrl) ProtoReflect() protoreflect.Message {
+	mi := &file_mgmt_v1alpha1_transformer_proto_msgTypes[74]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransformUrl.ProtoReflect.Descriptor instead.
+func (*TransformUrl) Descriptor() ([]byte, []int) {
+	return file_mgmt_v1alpha1_transformer_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *TransformUrl) GetPreservePath() bool {
+	if x != nil {
+		return x.PreservePath
+	}
+	return false
+}
+
+func (x *TransformUrl) GetDeterministic() bool {
+	if x != nil {
+		return x.Deterministic
+	}
+	return false
+}
+
+type GenerateDomain struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *GenerateDomain) Reset() {
+	*x = GenerateDomain{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mgmt_v1alpha1_transformer_proto_msgTypes[75]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GenerateDomain) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GenerateDomain) ProtoMessage() {}
+
+func (x *GenerateDomain) ProtoReflect() protoreflect.Message {
+	mi := &file_mgmt_v1alpha1_transformer_proto_msgTypes[75]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GenerateDomain.ProtoReflect.Descriptor instead.
+func (*GenerateDomain) Descriptor() ([]byte, []int) {
+	return file_mgmt_v1alpha1_transformer_proto_rawDescGZIP(), []int{75}
+}
+
+type TransformDomain struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// keeps the top level domain, for example .com or .co.uk
+	PreserveTld bool `protobuf:"varint,1,opt,name=preserve_tld,json=preserveTld,proto3" json:"preserve_tld,omitempty"`
+	// transforms the same input value to the same output using the account's transformer key
+	Deterministic bool `protobuf:"varint,2,opt,name=deterministic,proto3" json:"deterministic,omitempty"`
+}
+
+func (x *TransformDomain) Reset() {
+	*x = TransformDomain{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mgmt_v1alpha1_transformer_proto_msgTypes[76]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TransformDomain) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransformDomain) ProtoMessage() {}
+
+func (x *TransformDomain) ProtoReflect() protoreflect.Message {
+	mi := &file_mgmt_v1alpha1_transformer_proto_msgTypes[76]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransformDomain.ProtoReflect.Descriptor instead.
+func (*TransformDomain) Descriptor() ([]byte, []int) {
+	return file_mgmt_v1alpha1_transformer_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *TransformDomain) GetPreserveTld() bool {
+	if x != nil {
+		return x.PreserveTld
+	}
+	return false
+}
+
+func (x *TransformDomain) GetDeterministic() bool {
+	if x != nil {
+		return x.Deterministic
+	}
+	return false
+}
+
+type ValidateUserRegexCodeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AccountId         string `protobuf:"bytes,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	UserProvidedRegex string `protobuf:"bytes,2,opt,name=user_provided_regex,json=userProvidedRegex,proto3" json:"user_provided_regex,omitempty"`
+}
+
+func (x *ValidateUserRegexCodeRequest) Reset() {
+	*x = ValidateUserRegexCodeRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mgmt_v1alpha1_transformer_proto_msgTypes[77]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ValidateUserRegexCodeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValidateUserRegexCodeRequest) ProtoMessage() {}
+
+func (x *ValidateUserRegexCodeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mgmt_v1alpha1_transformer_proto_msgTypes[77]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ValidateUserRegexCodeRequest.ProtoReflect.Descriptor instead.
+func (*ValidateUserRegexCodeRequest) Descriptor() ([]byte, []int) {
+	return file_mgmt_v1alpha1_transformer_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *ValidateUserRegexCodeRequest) GetAccountId() string {
+	if x != nil {
+		return x.AccountId
+	}
+	return ""
+}
+
+func (x *ValidateUserRegexCodeRequest) GetUserProvidedRegex() string {
+	if x != nil {
+		return x.UserProvidedRegex
+	}
+	return ""
+}
+
+type ValidateUserRegexCodeResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Valid bool `protobuf:"varint,1,opt,name=valid,proto3" json:"valid,omitempty"`
+}
+
+func (x *ValidateUserRegexCodeResponse) Reset() {
+	*x = ValidateUserRegexCodeResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mgmt_v1alpha1_transformer_proto_msgTypes[78]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ValidateUserRegexCodeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValidateUserRegexCodeResponse) ProtoMessage() {}
+
+func (x *ValidateUserRegexCodeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mgmt_v1alpha1_transformer_proto_msgTypes[78]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ValidateUserRegexCodeResponse.ProtoReflect.Descriptor instead.
+func (*ValidateUserRegexCodeResponse) Descriptor() ([]byte, []int) {
+	return file_mgmt_v1alpha1_transformer_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *ValidateUserRegexCodeResponse) GetValid() bool {
+	if x != nil {
+		return x.Valid
+	}
+	return false
+}
+
+type ValidateTransformJsonConfigRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AccountId string         `protobuf:"bytes,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	Config    *TransformJson `protobuf:"bytes,2,opt,name=config,proto3" json:"config,omitempty"`
+}
+
+func (x *ValidateTransformJsonConfigRequest) Reset() {
+	*x = ValidateTransformJsonConfigRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mgmt_v1alpha1_transformer_proto_msgTypes[79]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ValidateTransformJsonConfigRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValidateTransformJsonConfigRequest) ProtoMessage() {}
+
+func (x *ValidateTransformJsonConfigRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mgmt_v1alpha1_transformer_proto_msgTypes[79]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ValidateTransformJsonConfigRequest.ProtoReflect.Descriptor instead.
+func (*ValidateTransformJsonConfigRequest) Descriptor() ([]byte, []int) {
+	return file_mgmt_v1alpha1_transformer_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *ValidateTransformJsonConfigRequest) GetAccountId() string {
+	if x != nil {
+		return x.AccountId
+	}
+	return ""
+}
+
+func (x *ValidateTransformJsonConfigRequest) GetConfig() *TransformJson {
+	if x != nil {
+		return x.Config
+	}
+	return nil
+}
+
+type ValidateTransformJsonConfigResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Valid bool `protobuf:"varint,1,opt,name=valid,proto3" json:"valid,omitempty"`
+	// the reasons that the config is invalid
+	Errors []string `protobuf:"bytes,2,rep,name=errors,proto3" json:"errors,omitempty"`
+}
+
+func (x *ValidateTransformJsonConfigResponse) Reset() {
+	*x = ValidateTransformJsonConfigResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mgmt_v1alpha1_transformer_proto_msgTypes[80]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ValidateTransformJsonConfigResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValidateTransformJsonConfigResponse) ProtoMessage() {}
+
+func (x *ValidateTransformJsonConfigResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mgmt_v1alpha1_transformer_proto_msgTypes[80]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ValidateTransformJsonConfigResponse.ProtoReflect.Descriptor instead.
+func (*ValidateTransformJsonConfigResponse) Descriptor() ([]byte, []int) {
+	return file_mgmt_v1alpha1_transformer_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *ValidateTransformJsonConfigResponse) GetValid() bool {
+	if x != nil {
+		return x.Valid
+	}
+	return false
+}
+
+func (x *ValidateTransformJsonConfigResponse) GetErrors() []string {
+	if x != nil {
+		return x.Errors
+	}
+	return nil
+}
+
+type DecryptFormatPreservingRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AccountId string `protobuf:"bytes,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	// the value that was encrypted by the format preserving encrypt transformer
+	Value     string                              `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	Algorithm FormatPreservingEncryptionAlgorithm `protobuf:"varint,3,opt,name=algorithm,proto3,enum=mgmt.v1alpha1.FormatPreservingEncryptionAlgorithm" json:"algorithm,omitempty"`
+	// the tweak that was configured on the transformer when the value was encrypted
+	Tweak string `protobuf:"bytes,4,opt,name=tweak,proto3" json:"tweak,omitempty"`
+}
+
+func (x *DecryptFormatPreservingRequest) Reset() {
+	*x = DecryptFormatPreservingRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mgmt_v1alpha1_transformer_proto_msgTypes[81]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DecryptFormatPreservingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DecryptFormatPreservingRequest) ProtoMessage() {}
+
+func (x *DecryptFormatPreservingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mgmt_v1alpha1_transformer_proto_msgTypes[81]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DecryptFormatPreservingRequest.ProtoReflect.Descriptor instead.
+func (*DecryptFormatPreservingRequest) Descriptor() ([]byte, []int) {
+	return file_mgmt_v1alpha1_transformer_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *DecryptFormatPreservingRequest) GetAccountId() string {
+	if x != nil {
+		return x.AccountId
+	}
+	return ""
+}
+
+func (x *DecryptFormatPreservingRequest) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+func (x *DecryptFormatPreservingRequest) GetAlgorithm() FormatPreservingEncryptionAlgorithm {
+	if x != nil {
+		return x.Algorithm
+	}
+	return FormatPreservingEncryptionAlgorithm_FORMAT_PRESERVING_ENCRYPTION_ALGORITHM_UNSPECIFIED
+}
+
+func (x *DecryptFormatPreservingRequest) GetTweak() string {
+	if x != nil {
+		return x.Tweak
+	}
+	return ""
+}
+
+type DecryptFormatPreservingResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (x *DecryptFormatPreservingResponse) Reset() {
+	*x = DecryptFormatPreservingResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mgmt_v1alpha1_transformer_proto_msgTypes[82]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DecryptFormatPreservingResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DecryptFormatPreservingResponse) ProtoMessage() {}
+
+func (x *DecryptFormatPreservingResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mgmt_v1alpha1_transformer_proto_msgTypes[82]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DecryptFormatPreservingResponse.ProtoReflect.Descriptor instead.
+func (*DecryptFormatPreservingResponse) Descriptor() ([]byte, []int) {
+	return file_mgmt_v1alpha1_transformer_proto_rawDescGZIP(), []int{82}
+}
+
+func (x *DecryptFormatPreservingResponse) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+var File_mgmt_v1alpha1_transformer_proto protoreflect.FileDescriptor
+
+var file_mgmt_v1alpha1_transformer_proto_rawDesc = []byte{
+	0x0a, 0x1f, 0x6d, 0x67, 0x6d, 0x74, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f,
+	0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x12, 0x0d, 0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31,
+	0x1a, 0x1b, 0x62, 0x75, 0x66, 0x2f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2f, 0x76,
+	0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x1e,
+	0x0a, 0x1c, 0x47, 0x65, 0x74, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x54, 0x72, 0x61, 0x6e, 0x73,
+	0x66, 0x6f, 0x72, 0x6d, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x65,
+	0x0a, 0x1d, 0x47, 0x65, 0x74, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x54, 0x72, 0x61, 0x6e, 0x73,
+	0x66, 0x6f, 0x72, 0x6d, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x44, 0x0a, 0x0c, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x65, 0x72, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x76, 0x31, 0x61,
+	0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x54, 0x72, 0x61, 0x6e,
+	0x73, 0x66, 0x6f, 0x72, 0x6d, 0x65, 0x72, 0x52, 0x0c, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f,
+	0x72, 0x6d, 0x65, 0x72, 0x73, 0x22, 0x5f, 0x0a, 0x23, 0x47, 0x65, 0x74, 0x53, 0x79, 0x73, 0x74,
+	0x65, 0x6d, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x65, 0x72, 0x42, 0x79, 0x53,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x38, 0x0a, 0x06,
+	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x20, 0x2e, 0x6d,
+	0x67, 0x6d, 0x74, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x54, 0x72, 0x61,
+	0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x65, 0x72, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x06,
+	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x22, 0x6a, 0x0a, 0x24, 0x47, 0x65, 0x74, 0x53, 0x79, 0x73,
+	0x74, 0x65, 0x6d, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x65, 0x72, 0x42, 0x79,
+	0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x42,
+	0x0a, 0x0b, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x65, 0x72, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70,
+	0x68, 0x61, 0x31, 0x2e, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66,
+	0x6f, 0x72, 0x6d, 0x65, 0x72, 0x52, 0x0b, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d,
+	0x65, 0x72, 0x22, 0x4c, 0x0a, 0x21, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x44, 0x65, 0x66,
+	0x69, 0x6e, 0x65, 0x64, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x65, 0x72, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x27, 0x0a, 0x0a, 0x61, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0xba, 0x48, 0x05,
+	0x72, 0x03, 0xb0, 0x01, 0x01, 0x52, 0x09, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x64,
+	0x22, 0x6f, 0x0a, 0x22, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x44, 0x65, 0x66, 0x69, 0x6e,
+	0x65, 0x64, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x65, 0x72, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x49, 0x0a, 0x0c, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66,
+	0x6f, 0x72, 0x6d, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x6d,
+	0x67, 0x6d, 0x74, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x55, 0x73, 0x65,
+	0x72, 0x44, 0x65, 0x66, 0x69, 0x6e, 0x65, 0x64, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72,
+	0x6d, 0x65, 0x72, 0x52, 0x0c, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x65, 0x72,
+	0x73, 0x22, 0x57, 0x0a, 0x24, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x44, 0x65, 0x66, 0x69,
+	0x6e, 0x65, 0x64, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x65, 0x72, 0x42, 0x79,
+	0x49, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2f, 0x0a, 0x0e, 0x74, 0x72, 0x61,
+	0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x08, 0xba, 0x48, 0x05, 0x72, 0x03, 0xb0, 0x01, 0x01, 0x52, 0x0d, 0x74, 0x72, 0x61,
+	0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x65, 0x72, 0x49, 0x64, 0x22, 0x70, 0x0a, 0x25, 0x47, 0x65,
+	0x74, 0x55, 0x73, 0x65, 0x72, 0x44, 0x65, 0x66, 0x69, 0x6e, 0x65, 0x64, 0x54, 0x72, 0x61, 0x6e,
 	0x73, 0x66, 0x6f, 0x72, 0x6d, 0x65, 0x72, 0x42, 0x79, 0x49, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f,
 	0x6e, 0x73, 0x65, 0x12, 0x47, 0x0a, 0x0b, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d,
 	0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x6d, 0x67, 0x6d, 0x74, 0x2e,
@@ -4177,7 +6148,7 @@ var file_mgmt_v1alpha1_transformer_proto_rawDesc = []byte{
 	0x65, 0x12, 0x38, 0x0a, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x05, 0x20, 0x01, 0x28,
 	0x0b, 0x32, 0x20, 0x2e, 0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61,
 	0x31, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x65, 0x72, 0x43, 0x6f, 0x6e,
-	0x66, 0x69, 0x67, 0x52, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x22, 0xf7, 0x1f, 0x0a, 0x11,
+	0x66, 0x69, 0x67, 0x52, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x22, 0xd3, 0x29, 0x0a, 0x11,
 	0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69,
 	0x67, 0x12, 0x52, 0x0a, 0x15, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x5f, 0x65, 0x6d,
 	0x61, 0x69, 0x6c, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
@@ -4432,402 +6403,853 @@ var file_mgmt_v1alpha1_transformer_proto_rawDesc = []byte{
 	0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x4a, 0x61,
 	0x76, 0x61, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x48, 0x00, 0x52, 0x18, 0x67, 0x65, 0x6e, 0x65,
 	0x72, 0x61, 0x74, 0x65, 0x4a, 0x61, 0x76, 0x61, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x43, 0x6f,
-	0x6e, 0x66, 0x69, 0x67, 0x42, 0x0f, 0x0a, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x05,
-	0xba, 0x48, 0x02, 0x08, 0x01, 0x22, 0x0f, 0x0a, 0x0d, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74,
-	0x65, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x22, 0x8d, 0x01, 0x0a, 0x0e, 0x54, 0x72, 0x61, 0x6e, 0x73,
-	0x66, 0x6f, 0x72, 0x6d, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x27, 0x0a, 0x0f, 0x70, 0x72, 0x65,
-	0x73, 0x65, 0x72, 0x76, 0x65, 0x5f, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x08, 0x52, 0x0e, 0x70, 0x72, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x44, 0x6f, 0x6d, 0x61,
-	0x69, 0x6e, 0x12, 0x27, 0x0a, 0x0f, 0x70, 0x72, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x5f, 0x6c,
-	0x65, 0x6e, 0x67, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x70, 0x72, 0x65,
-	0x73, 0x65, 0x72, 0x76, 0x65, 0x4c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x12, 0x29, 0x0a, 0x10, 0x65,
-	0x78, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x64, 0x5f, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x73, 0x18,
-	0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0f, 0x65, 0x78, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x64, 0x44,
-	0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x73, 0x22, 0x0e, 0x0a, 0x0c, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61,
-	0x74, 0x65, 0x42, 0x6f, 0x6f, 0x6c, 0x22, 0x33, 0x0a, 0x12, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61,
-	0x74, 0x65, 0x43, 0x61, 0x72, 0x64, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x1d, 0x0a, 0x0a,
-	0x76, 0x61, 0x6c, 0x69, 0x64, 0x5f, 0x6c, 0x75, 0x68, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08,
-	0x52, 0x09, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x4c, 0x75, 0x68, 0x6e, 0x22, 0x0e, 0x0a, 0x0c, 0x47,
-	0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x43, 0x69, 0x74, 0x79, 0x22, 0x11, 0x0a, 0x0f, 0x47,
-	0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x22, 0x3d,
-	0x0a, 0x17, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x45, 0x31, 0x36, 0x34, 0x50, 0x68,
-	0x6f, 0x6e, 0x65, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x69, 0x6e,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x6d, 0x69, 0x6e, 0x12, 0x10, 0x0a, 0x03, 0x6d,
-	0x61, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x6d, 0x61, 0x78, 0x22, 0x13, 0x0a,
-	0x11, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x46, 0x69, 0x72, 0x73, 0x74, 0x4e, 0x61,
-	0x6d, 0x65, 0x22, 0x7a, 0x0a, 0x0f, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x46, 0x6c,
-	0x6f, 0x61, 0x74, 0x36, 0x34, 0x12, 0x25, 0x0a, 0x0e, 0x72, 0x61, 0x6e, 0x64, 0x6f, 0x6d, 0x69,
-	0x7a, 0x65, 0x5f, 0x73, 0x69, 0x67, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x72,
-	0x61, 0x6e, 0x64, 0x6f, 0x6d, 0x69, 0x7a, 0x65, 0x53, 0x69, 0x67, 0x6e, 0x12, 0x10, 0x0a, 0x03,
-	0x6d, 0x69, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x03, 0x6d, 0x69, 0x6e, 0x12, 0x10,
-	0x0a, 0x03, 0x6d, 0x61, 0x78, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x03, 0x6d, 0x61, 0x78,
-	0x12, 0x1c, 0x0a, 0x09, 0x70, 0x72, 0x65, 0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x03, 0x52, 0x09, 0x70, 0x72, 0x65, 0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x15,
-	0x0a, 0x13, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x46, 0x75, 0x6c, 0x6c, 0x41, 0x64,
-	0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0x12, 0x0a, 0x10, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74,
-	0x65, 0x46, 0x75, 0x6c, 0x6c, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x30, 0x0a, 0x0e, 0x47, 0x65, 0x6e,
-	0x65, 0x72, 0x61, 0x74, 0x65, 0x47, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x12, 0x1e, 0x0a, 0x0a, 0x61,
-	0x62, 0x62, 0x72, 0x65, 0x76, 0x69, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52,
-	0x0a, 0x61, 0x62, 0x62, 0x72, 0x65, 0x76, 0x69, 0x61, 0x74, 0x65, 0x22, 0x1a, 0x0a, 0x18, 0x47,
-	0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x50, 0x68, 0x6f, 0x6e,
-	0x65, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x22, 0x5a, 0x0a, 0x0d, 0x47, 0x65, 0x6e, 0x65, 0x72,
-	0x61, 0x74, 0x65, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x12, 0x25, 0x0a, 0x0e, 0x72, 0x61, 0x6e, 0x64,
-	0x6f, 0x6d, 0x69, 0x7a, 0x65, 0x5f, 0x73, 0x69, 0x67, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08,
-	0x52, 0x0d, 0x72, 0x61, 0x6e, 0x64, 0x6f, 0x6d, 0x69, 0x7a, 0x65, 0x53, 0x69, 0x67, 0x6e, 0x12,
-	0x10, 0x0a, 0x03, 0x6d, 0x69, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x6d, 0x69,
-	0x6e, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x61, 0x78, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03,
-	0x6d, 0x61, 0x78, 0x22, 0x12, 0x0a, 0x10, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x4c,
-	0x61, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x14, 0x0a, 0x12, 0x47, 0x65, 0x6e, 0x65, 0x72,
-	0x61, 0x74, 0x65, 0x53, 0x68, 0x61, 0x32, 0x35, 0x36, 0x48, 0x61, 0x73, 0x68, 0x22, 0x0d, 0x0a,
-	0x0b, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x53, 0x53, 0x4e, 0x22, 0x0f, 0x0a, 0x0d,
-	0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x22, 0x17, 0x0a,
-	0x15, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x53, 0x74, 0x72, 0x65, 0x65, 0x74, 0x41,
-	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0x3f, 0x0a, 0x19, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61,
-	0x74, 0x65, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x50, 0x68, 0x6f, 0x6e, 0x65, 0x4e, 0x75, 0x6d,
-	0x62, 0x65, 0x72, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x69, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03,
-	0x52, 0x03, 0x6d, 0x69, 0x6e, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x61, 0x78, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x03, 0x52, 0x03, 0x6d, 0x61, 0x78, 0x22, 0x34, 0x0a, 0x0e, 0x47, 0x65, 0x6e, 0x65, 0x72,
-	0x61, 0x74, 0x65, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x69, 0x6e,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x6d, 0x69, 0x6e, 0x12, 0x10, 0x0a, 0x03, 0x6d,
-	0x61, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x6d, 0x61, 0x78, 0x22, 0x17, 0x0a,
-	0x15, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x55, 0x6e, 0x69, 0x78, 0x54, 0x69, 0x6d,
-	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x22, 0x12, 0x0a, 0x10, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61,
-	0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x16, 0x0a, 0x14, 0x47, 0x65,
-	0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x55, 0x74, 0x63, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
-	0x6d, 0x70, 0x22, 0x37, 0x0a, 0x0c, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x55, 0x75,
-	0x69, 0x64, 0x12, 0x27, 0x0a, 0x0f, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f, 0x68, 0x79,
-	0x70, 0x68, 0x65, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x69, 0x6e, 0x63,
-	0x6c, 0x75, 0x64, 0x65, 0x48, 0x79, 0x70, 0x68, 0x65, 0x6e, 0x73, 0x22, 0x11, 0x0a, 0x0f, 0x47,
-	0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x5a, 0x69, 0x70, 0x63, 0x6f, 0x64, 0x65, 0x22, 0x43,
-	0x0a, 0x18, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x45, 0x31, 0x36, 0x34, 0x50,
+	0x6e, 0x66, 0x69, 0x67, 0x12, 0x8d, 0x01, 0x0a, 0x2a, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f,
+	0x72, 0x6d, 0x5f, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x5f, 0x70, 0x72, 0x65, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x6e, 0x67, 0x5f, 0x65, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x5f, 0x63, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x18, 0x2b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2f, 0x2e, 0x6d, 0x67, 0x6d, 0x74,
+	0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66,
+	0x6f, 0x72, 0x6d, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x50, 0x72, 0x65, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x6e, 0x67, 0x45, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x48, 0x00, 0x52, 0x26, 0x74, 0x72,
+	0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x50, 0x72, 0x65,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x6e, 0x67, 0x45, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x43, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x12, 0x61, 0x0a, 0x1a, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72,
+	0x6d, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x5f, 0x63, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x18, 0x2c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x6d, 0x67, 0x6d, 0x74, 0x2e,
+	0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f,
+	0x72, 0x6d, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x48, 0x00, 0x52, 0x18, 0x74,
+	0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x52, 0x0a, 0x15, 0x74, 0x72, 0x61, 0x6e, 0x73,
+	0x66, 0x6f, 0x72, 0x6d, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x18, 0x2d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x76, 0x31,
+	0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d,
+	0x44, 0x61, 0x74, 0x65, 0x48, 0x00, 0x52, 0x13, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72,
+	0x6d, 0x44, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x52, 0x0a, 0x15, 0x74,
+	0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x5f, 0x6a, 0x73, 0x6f, 0x6e, 0x5f, 0x63, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x18, 0x2e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6d, 0x67, 0x6d,
+	0x74, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73,
+	0x66, 0x6f, 0x72, 0x6d, 0x4a, 0x73, 0x6f, 0x6e, 0x48, 0x00, 0x52, 0x13, 0x74, 0x72, 0x61, 0x6e,
+	0x73, 0x66, 0x6f, 0x72, 0x6d, 0x4a, 0x73, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12,
+	0x5f, 0x0a, 0x1a, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x5f, 0x66, 0x72, 0x65,
+	0x65, 0x5f, 0x74, 0x65, 0x78, 0x74, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x2f, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70,
+	0x68, 0x61, 0x31, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x46, 0x72, 0x65,
+	0x65, 0x54, 0x65, 0x78, 0x74, 0x48, 0x00, 0x52, 0x17, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f,
+	0x72, 0x6d, 0x46, 0x72, 0x65, 0x65, 0x54, 0x65, 0x78, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x12, 0x5f, 0x0a, 0x1a, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x5f, 0x69, 0x70, 0x5f,
+	0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x30,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x76, 0x31, 0x61, 0x6c,
+	0x70, 0x68, 0x61, 0x31, 0x2e, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x49, 0x70, 0x41,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x48, 0x00, 0x52, 0x17, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61,
+	0x74, 0x65, 0x49, 0x70, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x43, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x12, 0x62, 0x0a, 0x1b, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x5f, 0x69,
+	0x70, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x18, 0x31, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x76, 0x31,
+	0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d,
+	0x49, 0x70, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x48, 0x00, 0x52, 0x18, 0x74, 0x72, 0x61,
+	0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x49, 0x70, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x43,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x62, 0x0a, 0x1b, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74,
+	0x65, 0x5f, 0x6d, 0x61, 0x63, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x5f, 0x63, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x18, 0x32, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x6d, 0x67, 0x6d,
+	0x74, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x47, 0x65, 0x6e, 0x65, 0x72,
+	0x61, 0x74, 0x65, 0x4d, 0x61, 0x63, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x48, 0x00, 0x52,
+	0x18, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x4d, 0x61, 0x63, 0x41, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x65, 0x0a, 0x1c, 0x74, 0x72, 0x61,
+	0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x5f, 0x6d, 0x61, 0x63, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x33, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x22, 0x2e, 0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e,
+	0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x4d, 0x61, 0x63, 0x41, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x48, 0x00, 0x52, 0x19, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d,
+	0x4d, 0x61, 0x63, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x12, 0x4c, 0x0a, 0x13, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x5f, 0x75, 0x72, 0x6c,
+	0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x34, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x47, 0x65,
+	0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x55, 0x72, 0x6c, 0x48, 0x00, 0x52, 0x11, 0x67, 0x65, 0x6e,
+	0x65, 0x72, 0x61, 0x74, 0x65, 0x55, 0x72, 0x6c, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x4f,
+	0x0a, 0x14, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x5f, 0x75, 0x72, 0x6c, 0x5f,
+	0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x35, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x6d,
+	0x67, 0x6d, 0x74, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x54, 0x72, 0x61,
+	0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x55, 0x72, 0x6c, 0x48, 0x00, 0x52, 0x12, 0x74, 0x72, 0x61,
+	0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x55, 0x72, 0x6c, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12,
+	0x55, 0x0a, 0x16, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x5f, 0x64, 0x6f, 0x6d, 0x61,
+	0x69, 0x6e, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x36, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1d, 0x2e, 0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e,
+	0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x48, 0x00,
+	0x52, 0x14, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e,
+	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x58, 0x0a, 0x17, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66,
+	0x6f, 0x72, 0x6d, 0x5f, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x18, 0x37, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x76,
+	0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72,
+	0x6d, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x48, 0x00, 0x52, 0x15, 0x74, 0x72, 0x61, 0x6e, 0x73,
+	0x66, 0x6f, 0x72, 0x6d, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x42, 0x0f, 0x0a, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x05, 0xba, 0x48, 0x02, 0x08,
+	0x01, 0x22, 0x0f, 0x0a, 0x0d, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x45, 0x6d, 0x61,
+	0x69, 0x6c, 0x22, 0xb3, 0x01, 0x0a, 0x0e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d,
+	0x45, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x27, 0x0a, 0x0f, 0x70, 0x72, 0x65, 0x73, 0x65, 0x72, 0x76,
+	0x65, 0x5f, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e,
+	0x70, 0x72, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x12, 0x27,
+	0x0a, 0x0f, 0x70, 0x72, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x5f, 0x6c, 0x65, 0x6e, 0x67, 0x74,
+	0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x70, 0x72, 0x65, 0x73, 0x65, 0x72, 0x76,
+	0x65, 0x4c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x12, 0x29, 0x0a, 0x10, 0x65, 0x78, 0x63, 0x6c, 0x75,
+	0x64, 0x65, 0x64, 0x5f, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x0f, 0x65, 0x78, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x64, 0x44, 0x6f, 0x6d, 0x61, 0x69,
+	0x6e, 0x73, 0x12, 0x24, 0x0a, 0x0d, 0x64, 0x65, 0x74, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x69, 0x73,
+	0x74, 0x69, 0x63, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x64, 0x65, 0x74, 0x65, 0x72,
+	0x6d, 0x69, 0x6e, 0x69, 0x73, 0x74, 0x69, 0x63, 0x22, 0x0e, 0x0a, 0x0c, 0x47, 0x65, 0x6e, 0x65,
+	0x72, 0x61, 0x74, 0x65, 0x42, 0x6f, 0x6f, 0x6c, 0x22, 0x33, 0x0a, 0x12, 0x47, 0x65, 0x6e, 0x65,
+	0x72, 0x61, 0x74, 0x65, 0x43, 0x61, 0x72, 0x64, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x1d,
+	0x0a, 0x0a, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x5f, 0x6c, 0x75, 0x68, 0x6e, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x09, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x4c, 0x75, 0x68, 0x6e, 0x22, 0x72, 0x0a,
+	0x0c, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x43, 0x69, 0x74, 0x79, 0x12, 0x1b, 0x0a,
+	0x06, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52,
+	0x06, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x65, 0x88, 0x01, 0x01, 0x12, 0x28, 0x0a, 0x0d, 0x6c, 0x6f,
+	0x63, 0x61, 0x6c, 0x65, 0x5f, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x48, 0x01, 0x52, 0x0c, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x65, 0x43, 0x6f, 0x6c, 0x75, 0x6d,
+	0x6e, 0x88, 0x01, 0x01, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x65, 0x42,
+	0x10, 0x0a, 0x0e, 0x5f, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x65, 0x5f, 0x63, 0x6f, 0x6c, 0x75, 0x6d,
+	0x6e, 0x22, 0x11, 0x0a, 0x0f, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x44, 0x65, 0x66,
+	0x61, 0x75, 0x6c, 0x74, 0x22, 0xa1, 0x01, 0x0a, 0x17, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74,
+	0x65, 0x45, 0x31, 0x36, 0x34, 0x50, 0x68, 0x6f, 0x6e, 0x65, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72,
+	0x12, 0x10, 0x0a, 0x03, 0x6d, 0x69, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x6d,
+	0x69, 0x6e, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x61, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x03, 0x6d, 0x61, 0x78, 0x12, 0x1b, 0x0a, 0x06, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x06, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x65, 0x88, 0x01,
+	0x01, 0x12, 0x28, 0x0a, 0x0d, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x65, 0x5f, 0x63, 0x6f, 0x6c, 0x75,
+	0x6d, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x0c, 0x6c, 0x6f, 0x63, 0x61,
+	0x6c, 0x65, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x88, 0x01, 0x01, 0x42, 0x09, 0x0a, 0x07, 0x5f,
+	0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x65, 0x42, 0x10, 0x0a, 0x0e, 0x5f, 0x6c, 0x6f, 0x63, 0x61, 0x6c,
+	0x65, 0x5f, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x22, 0x77, 0x0a, 0x11, 0x47, 0x65, 0x6e, 0x65,
+	0x72, 0x61, 0x74, 0x65, 0x46, 0x69, 0x72, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1b, 0x0a,
+	0x06, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52,
+	0x06, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x65, 0x88, 0x01, 0x01, 0x12, 0x28, 0x0a, 0x0d, 0x6c, 0x6f,
+	0x63, 0x61, 0x6c, 0x65, 0x5f, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x48, 0x01, 0x52, 0x0c, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x65, 0x43, 0x6f, 0x6c, 0x75, 0x6d,
+	0x6e, 0x88, 0x01, 0x01, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x65, 0x42,
+	0x10, 0x0a, 0x0e, 0x5f, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x65, 0x5f, 0x63, 0x6f, 0x6c, 0x75, 0x6d,
+	0x6e, 0x22, 0x7a, 0x0a, 0x0f, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x46, 0x6c, 0x6f,
+	0x61, 0x74, 0x36, 0x34, 0x12, 0x25, 0x0a, 0x0e, 0x72, 0x61, 0x6e, 0x64, 0x6f, 0x6d, 0x69, 0x7a,
+	0x65, 0x5f, 0x73, 0x69, 0x67, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x72, 0x61,
+	0x6e, 0x64, 0x6f, 0x6d, 0x69, 0x7a, 0x65, 0x53, 0x69, 0x67, 0x6e, 0x12, 0x10, 0x0a, 0x03, 0x6d,
+	0x69, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x03, 0x6d, 0x69, 0x6e, 0x12, 0x10, 0x0a,
+	0x03, 0x6d, 0x61, 0x78, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x03, 0x6d, 0x61, 0x78, 0x12,
+	0x1c, 0x0a, 0x09, 0x70, 0x72, 0x65, 0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x09, 0x70, 0x72, 0x65, 0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x79, 0x0a,
+	0x13, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x46, 0x75, 0x6c, 0x6c, 0x41, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x12, 0x1b, 0x0a, 0x06, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x06, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x65, 0x88, 0x01,
+	0x01, 0x12, 0x28, 0x0a, 0x0d, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x65, 0x5f, 0x63, 0x6f, 0x6c, 0x75,
+	0x6d, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x0c, 0x6c, 0x6f, 0x63, 0x61,
+	0x6c, 0x65, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x88, 0x01, 0x01, 0x42, 0x09, 0x0a, 0x07, 0x5f,
+	0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x65, 0x42, 0x10, 0x0a, 0x0e, 0x5f, 0x6c, 0x6f, 0x63, 0x61, 0x6c,
+	0x65, 0x5f, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x22, 0x76, 0x0a, 0x10, 0x47, 0x65, 0x6e, 0x65,
+	0x72, 0x61, 0x74, 0x65, 0x46, 0x75, 0x6c, 0x6c, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1b, 0x0a, 0x06,
+	0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x06,
+	0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x65, 0x88, 0x01, 0x01, 0x12, 0x28, 0x0a, 0x0d, 0x6c, 0x6f, 0x63,
+	0x61, 0x6c, 0x65, 0x5f, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x48, 0x01, 0x52, 0x0c, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x65, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e,
+	0x88, 0x01, 0x01, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x65, 0x42, 0x10,
+	0x0a, 0x0e, 0x5f, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x65, 0x5f, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e,
+	0x22, 0x30, 0x0a, 0x0e, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x47, 0x65, 0x6e, 0x64,
+	0x65, 0x72, 0x12, 0x1e, 0x0a, 0x0a, 0x61, 0x62, 0x62, 0x72, 0x65, 0x76, 0x69, 0x61, 0x74, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x61, 0x62, 0x62, 0x72, 0x65, 0x76, 0x69, 0x61,
+	0x74, 0x65, 0x22, 0x1a, 0x0a, 0x18, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x49, 0x6e,
+	0x74, 0x36, 0x34, 0x50, 0x68, 0x6f, 0x6e, 0x65, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x22, 0x5a,
+	0x0a, 0x0d, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x12,
+	0x25, 0x0a, 0x0e, 0x72, 0x61, 0x6e, 0x64, 0x6f, 0x6d, 0x69, 0x7a, 0x65, 0x5f, 0x73, 0x69, 0x67,
+	0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x72, 0x61, 0x6e, 0x64, 0x6f, 0x6d, 0x69,
+	0x7a, 0x65, 0x53, 0x69, 0x67, 0x6e, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x69, 0x6e, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x03, 0x6d, 0x69, 0x6e, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x61, 0x78, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x6d, 0x61, 0x78, 0x22, 0x76, 0x0a, 0x10, 0x47, 0x65,
+	0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x4c, 0x61, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1b,
+	0x0a, 0x06, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00,
+	0x52, 0x06, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x65, 0x88, 0x01, 0x01, 0x12, 0x28, 0x0a, 0x0d, 0x6c,
+	0x6f, 0x63, 0x61, 0x6c, 0x65, 0x5f, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x48, 0x01, 0x52, 0x0c, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x65, 0x43, 0x6f, 0x6c, 0x75,
+	0x6d, 0x6e, 0x88, 0x01, 0x01, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x65,
+	0x42, 0x10, 0x0a, 0x0e, 0x5f, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x65, 0x5f, 0x63, 0x6f, 0x6c, 0x75,
+	0x6d, 0x6e, 0x22, 0x14, 0x0a, 0x12, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x53, 0x68,
+	0x61, 0x32, 0x35, 0x36, 0x48, 0x61, 0x73, 0x68, 0x22, 0x0d, 0x0a, 0x0b, 0x47, 0x65, 0x6e, 0x65,
+	0x72, 0x61, 0x74, 0x65, 0x53, 0x53, 0x4e, 0x22, 0x73, 0x0a, 0x0d, 0x47, 0x65, 0x6e, 0x65, 0x72,
+	0x61, 0x74, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x1b, 0x0a, 0x06, 0x6c, 0x6f, 0x63, 0x61,
+	0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x06, 0x6c, 0x6f, 0x63, 0x61,
+	0x6c, 0x65, 0x88, 0x01, 0x01, 0x12, 0x28, 0x0a, 0x0d, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x65, 0x5f,
+	0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x0c,
+	0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x65, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x88, 0x01, 0x01, 0x42,
+	0x09, 0x0a, 0x07, 0x5f, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x65, 0x42, 0x10, 0x0a, 0x0e, 0x5f, 0x6c,
+	0x6f, 0x63, 0x61, 0x6c, 0x65, 0x5f, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x22, 0x7b, 0x0a, 0x15,
+	0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x53, 0x74, 0x72, 0x65, 0x65, 0x74, 0x41, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x1b, 0x0a, 0x06, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x06, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x65, 0x88,
+	0x01, 0x01, 0x12, 0x28, 0x0a, 0x0d, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x65, 0x5f, 0x63, 0x6f, 0x6c,
+	0x75, 0x6d, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x0c, 0x6c, 0x6f, 0x63,
+	0x61, 0x6c, 0x65, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x88, 0x01, 0x01, 0x42, 0x09, 0x0a, 0x07,
+	0x5f, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x65, 0x42, 0x10, 0x0a, 0x0e, 0x5f, 0x6c, 0x6f, 0x63, 0x61,
+	0x6c, 0x65, 0x5f, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x22, 0xa3, 0x01, 0x0a, 0x19, 0x47, 0x65,
+	0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x50, 0x68, 0x6f, 0x6e,
+	0x65, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x69, 0x6e, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x6d, 0x69, 0x6e, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x61, 0x78,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x6d, 0x61, 0x78, 0x12, 0x1b, 0x0a, 0x06, 0x6c,
+	0x6f, 0x63, 0x61, 0x6c, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x06, 0x6c,
+	0x6f, 0x63, 0x61, 0x6c, 0x65, 0x88, 0x01, 0x01, 0x12, 0x28, 0x0a, 0x0d, 0x6c, 0x6f, 0x63, 0x61,
+	0x6c, 0x65, 0x5f, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x48,
+	0x01, 0x52, 0x0c, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x65, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x88,
+	0x01, 0x01, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x65, 0x42, 0x10, 0x0a,
+	0x0e, 0x5f, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x65, 0x5f, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x22,
+	0x34, 0x0a, 0x0e, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x53, 0x74, 0x72, 0x69, 0x6e,
+	0x67, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x69, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03,
+	0x6d, 0x69, 0x6e, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x61, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x03, 0x6d, 0x61, 0x78, 0x22, 0x17, 0x0a, 0x15, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74,
+	0x65, 0x55, 0x6e, 0x69, 0x78, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x22, 0x12,
+	0x0a, 0x10, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x6e, 0x61,
+	0x6d, 0x65, 0x22, 0x16, 0x0a, 0x14, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x55, 0x74,
+	0x63, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x22, 0x37, 0x0a, 0x0c, 0x47, 0x65,
+	0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x55, 0x75, 0x69, 0x64, 0x12, 0x27, 0x0a, 0x0f, 0x69, 0x6e,
+	0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f, 0x68, 0x79, 0x70, 0x68, 0x65, 0x6e, 0x73, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x0e, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x48, 0x79, 0x70, 0x68,
+	0x65, 0x6e, 0x73, 0x22, 0x75, 0x0a, 0x0f, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x5a,
+	0x69, 0x70, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x1b, 0x0a, 0x06, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x06, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x65,
+	0x88, 0x01, 0x01, 0x12, 0x28, 0x0a, 0x0d, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x65, 0x5f, 0x63, 0x6f,
+	0x6c, 0x75, 0x6d, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x0c, 0x6c, 0x6f,
+	0x63, 0x61, 0x6c, 0x65, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x88, 0x01, 0x01, 0x42, 0x09, 0x0a,
+	0x07, 0x5f, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x65, 0x42, 0x10, 0x0a, 0x0e, 0x5f, 0x6c, 0x6f, 0x63,
+	0x61, 0x6c, 0x65, 0x5f, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x22, 0x69, 0x0a, 0x18, 0x54, 0x72,
+	0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x45, 0x31, 0x36, 0x34, 0x50, 0x68, 0x6f, 0x6e, 0x65,
+	0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x27, 0x0a, 0x0f, 0x70, 0x72, 0x65, 0x73, 0x65, 0x72,
+	0x76, 0x65, 0x5f, 0x6c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x0e, 0x70, 0x72, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x4c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x12,
+	0x24, 0x0a, 0x0d, 0x64, 0x65, 0x74, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x69, 0x73, 0x74, 0x69, 0x63,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x64, 0x65, 0x74, 0x65, 0x72, 0x6d, 0x69, 0x6e,
+	0x69, 0x73, 0x74, 0x69, 0x63, 0x22, 0xc7, 0x01, 0x0a, 0x12, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66,
+	0x6f, 0x72, 0x6d, 0x46, 0x69, 0x72, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x27, 0x0a, 0x0f,
+	0x70, 0x72, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x5f, 0x6c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x70, 0x72, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x4c,
+	0x65, 0x6e, 0x67, 0x74, 0x68, 0x12, 0x24, 0x0a, 0x0d, 0x64, 0x65, 0x74, 0x65, 0x72, 0x6d, 0x69,
+	0x6e, 0x69, 0x73, 0x74, 0x69, 0x63, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x64, 0x65,
+	0x74, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x69, 0x73, 0x74, 0x69, 0x63, 0x12, 0x1b, 0x0a, 0x06, 0x6c,
+	0x6f, 0x63, 0x61, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x06, 0x6c,
+	0x6f, 0x63, 0x61, 0x6c, 0x65, 0x88, 0x01, 0x01, 0x12, 0x28, 0x0a, 0x0d, 0x6c, 0x6f, 0x63, 0x61,
+	0x6c, 0x65, 0x5f, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x48,
+	0x01, 0x52, 0x0c, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x65, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x88,
+	0x01, 0x01, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x65, 0x42, 0x10, 0x0a,
+	0x0e, 0x5f, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x65, 0x5f, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x22,
+	0xa8, 0x01, 0x0a, 0x10, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x46, 0x6c, 0x6f,
+	0x61, 0x74, 0x36, 0x34, 0x12, 0x36, 0x0a, 0x17, 0x72, 0x61, 0x6e, 0x64, 0x6f, 0x6d, 0x69, 0x7a,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x5f, 0x6d, 0x69, 0x6e, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x01, 0x52, 0x15, 0x72, 0x61, 0x6e, 0x64, 0x6f, 0x6d, 0x69, 0x7a, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x4d, 0x69, 0x6e, 0x12, 0x36, 0x0a, 0x17,
+	0x72, 0x61, 0x6e, 0x64, 0x6f, 0x6d, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x72, 0x61,
+	0x6e, 0x67, 0x65, 0x5f, 0x6d, 0x61, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x15, 0x72,
+	0x61, 0x6e, 0x64, 0x6f, 0x6d, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x61, 0x6e, 0x67,
+	0x65, 0x4d, 0x61, 0x78, 0x12, 0x24, 0x0a, 0x0d, 0x64, 0x65, 0x74, 0x65, 0x72, 0x6d, 0x69, 0x6e,
+	0x69, 0x73, 0x74, 0x69, 0x63, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x64, 0x65, 0x74,
+	0x65, 0x72, 0x6d, 0x69, 0x6e, 0x69, 0x73, 0x74, 0x69, 0x63, 0x22, 0xc6, 0x01, 0x0a, 0x11, 0x54,
+	0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x46, 0x75, 0x6c, 0x6c, 0x4e, 0x61, 0x6d, 0x65,
+	0x12, 0x27, 0x0a, 0x0f, 0x70, 0x72, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x5f, 0x6c, 0x65, 0x6e,
+	0x67, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x70, 0x72, 0x65, 0x73, 0x65,
+	0x72, 0x76, 0x65, 0x4c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x12, 0x24, 0x0a, 0x0d, 0x64, 0x65, 0x74,
+	0x65, 0x72, 0x6d, 0x69, 0x6e, 0x69, 0x73, 0x74, 0x69, 0x63, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x0d, 0x64, 0x65, 0x74, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x69, 0x73, 0x74, 0x69, 0x63, 0x12,
+	0x1b, 0x0a, 0x06, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x48,
+	0x00, 0x52, 0x06, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x65, 0x88, 0x01, 0x01, 0x12, 0x28, 0x0a, 0x0d,
+	0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x65, 0x5f, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x0c, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x65, 0x43, 0x6f, 0x6c,
+	0x75, 0x6d, 0x6e, 0x88, 0x01, 0x01, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x6c, 0x6f, 0x63, 0x61, 0x6c,
+	0x65, 0x42, 0x10, 0x0a, 0x0e, 0x5f, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x65, 0x5f, 0x63, 0x6f, 0x6c,
+	0x75, 0x6d, 0x6e, 0x22, 0x6a, 0x0a, 0x19, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d,
+	0x49, 0x6e, 0x74, 0x36, 0x34, 0x50, 0x68, 0x6f, 0x6e, 0x65, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72,
+	0x12, 0x27, 0x0a, 0x0f, 0x70, 0x72, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x5f, 0x6c, 0x65, 0x6e,
+	0x67, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x70, 0x72, 0x65, 0x73, 0x65,
+	0x72, 0x76, 0x65, 0x4c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x12, 0x24, 0x0a, 0x0d, 0x64, 0x65, 0x74,
+	0x65, 0x72, 0x6d, 0x69, 0x6e, 0x69, 0x73, 0x74, 0x69, 0x63, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x0d, 0x64, 0x65, 0x74, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x69, 0x73, 0x74, 0x69, 0x63, 0x22,
+	0xa6, 0x01, 0x0a, 0x0e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x49, 0x6e, 0x74,
+	0x36, 0x34, 0x12, 0x36, 0x0a, 0x17, 0x72, 0x61, 0x6e, 0x64, 0x6f, 0x6d, 0x69, 0x7a, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x5f, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x5f, 0x6d, 0x69, 0x6e, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x15, 0x72, 0x61, 0x6e, 0x64, 0x6f, 0x6d, 0x69, 0x7a, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x4d, 0x69, 0x6e, 0x12, 0x36, 0x0a, 0x17, 0x72, 0x61,
+	0x6e, 0x64, 0x6f, 0x6d, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x72, 0x61, 0x6e, 0x67,
+	0x65, 0x5f, 0x6d, 0x61, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x15, 0x72, 0x61, 0x6e,
+	0x64, 0x6f, 0x6d, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x4d,
+	0x61, 0x78, 0x12, 0x24, 0x0a, 0x0d, 0x64, 0x65, 0x74, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x69, 0x73,
+	0x74, 0x69, 0x63, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x64, 0x65, 0x74, 0x65, 0x72,
+	0x6d, 0x69, 0x6e, 0x69, 0x73, 0x74, 0x69, 0x63, 0x22, 0xc6, 0x01, 0x0a, 0x11, 0x54, 0x72, 0x61,
+	0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x4c, 0x61, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x27,
+	0x0a, 0x0f, 0x70, 0x72, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x5f, 0x6c, 0x65, 0x6e, 0x67, 0x74,
+	0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x70, 0x72, 0x65, 0x73, 0x65, 0x72, 0x76,
+	0x65, 0x4c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x12, 0x24, 0x0a, 0x0d, 0x64, 0x65, 0x74, 0x65, 0x72,
+	0x6d, 0x69, 0x6e, 0x69, 0x73, 0x74, 0x69, 0x63, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d,
+	0x64, 0x65, 0x74, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x69, 0x73, 0x74, 0x69, 0x63, 0x12, 0x1b, 0x0a,
+	0x06, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52,
+	0x06, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x65, 0x88, 0x01, 0x01, 0x12, 0x28, 0x0a, 0x0d, 0x6c, 0x6f,
+	0x63, 0x61, 0x6c, 0x65, 0x5f, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x48, 0x01, 0x52, 0x0c, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x65, 0x43, 0x6f, 0x6c, 0x75, 0x6d,
+	0x6e, 0x88, 0x01, 0x01, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x65, 0x42,
+	0x10, 0x0a, 0x0e, 0x5f, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x65, 0x5f, 0x63, 0x6f, 0x6c, 0x75, 0x6d,
+	0x6e, 0x22, 0xc9, 0x01, 0x0a, 0x14, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x50,
 	0x68, 0x6f, 0x6e, 0x65, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x27, 0x0a, 0x0f, 0x70, 0x72,
 	0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x5f, 0x6c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x18, 0x01, 0x20,
 	0x01, 0x28, 0x08, 0x52, 0x0e, 0x70, 0x72, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x4c, 0x65, 0x6e,
-	0x67, 0x74, 0x68, 0x22, 0x3d, 0x0a, 0x12, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d,
-	0x46, 0x69, 0x72, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x70, 0x72, 0x65,
-	0x73, 0x65, 0x72, 0x76, 0x65, 0x5f, 0x6c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x08, 0x52, 0x0e, 0x70, 0x72, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x4c, 0x65, 0x6e, 0x67,
-	0x74, 0x68, 0x22, 0x82, 0x01, 0x0a, 0x10, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d,
-	0x46, 0x6c, 0x6f, 0x61, 0x74, 0x36, 0x34, 0x12, 0x36, 0x0a, 0x17, 0x72, 0x61, 0x6e, 0x64, 0x6f,
-	0x6d, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x5f, 0x6d,
-	0x69, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x52, 0x15, 0x72, 0x61, 0x6e, 0x64, 0x6f, 0x6d,
-	0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x4d, 0x69, 0x6e, 0x12,
-	0x36, 0x0a, 0x17, 0x72, 0x61, 0x6e, 0x64, 0x6f, 0x6d, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x5f, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x5f, 0x6d, 0x61, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01,
-	0x52, 0x15, 0x72, 0x61, 0x6e, 0x64, 0x6f, 0x6d, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
-	0x61, 0x6e, 0x67, 0x65, 0x4d, 0x61, 0x78, 0x22, 0x3c, 0x0a, 0x11, 0x54, 0x72, 0x61, 0x6e, 0x73,
-	0x66, 0x6f, 0x72, 0x6d, 0x46, 0x75, 0x6c, 0x6c, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x27, 0x0a, 0x0f,
-	0x70, 0x72, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x5f, 0x6c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x70, 0x72, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x4c,
-	0x65, 0x6e, 0x67, 0x74, 0x68, 0x22, 0x44, 0x0a, 0x19, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f,
-	0x72, 0x6d, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x50, 0x68, 0x6f, 0x6e, 0x65, 0x4e, 0x75, 0x6d, 0x62,
-	0x65, 0x72, 0x12, 0x27, 0x0a, 0x0f, 0x70, 0x72, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x5f, 0x6c,
-	0x65, 0x6e, 0x67, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x70, 0x72, 0x65,
-	0x73, 0x65, 0x72, 0x76, 0x65, 0x4c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x22, 0x80, 0x01, 0x0a, 0x0e,
-	0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x12, 0x36,
-	0x0a, 0x17, 0x72, 0x61, 0x6e, 0x64, 0x6f, 0x6d, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
-	0x72, 0x61, 0x6e, 0x67, 0x65, 0x5f, 0x6d, 0x69, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52,
-	0x15, 0x72, 0x61, 0x6e, 0x64, 0x6f, 0x6d, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x61,
-	0x6e, 0x67, 0x65, 0x4d, 0x69, 0x6e, 0x12, 0x36, 0x0a, 0x17, 0x72, 0x61, 0x6e, 0x64, 0x6f, 0x6d,
-	0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x5f, 0x6d, 0x61,
-	0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x15, 0x72, 0x61, 0x6e, 0x64, 0x6f, 0x6d, 0x69,
-	0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x4d, 0x61, 0x78, 0x22, 0x3c,
-	0x0a, 0x11, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x4c, 0x61, 0x73, 0x74, 0x4e,
-	0x61, 0x6d, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x70, 0x72, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x5f,
-	0x6c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x70, 0x72,
-	0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x4c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x22, 0x3f, 0x0a, 0x14,
-	0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x50, 0x68, 0x6f, 0x6e, 0x65, 0x4e, 0x75,
-	0x6d, 0x62, 0x65, 0x72, 0x12, 0x27, 0x0a, 0x0f, 0x70, 0x72, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65,
-	0x5f, 0x6c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x70,
-	0x72, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x4c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x22, 0x3a, 0x0a,
+	0x67, 0x74, 0x68, 0x12, 0x24, 0x0a, 0x0d, 0x64, 0x65, 0x74, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x69,
+	0x73, 0x74, 0x69, 0x63, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x64, 0x65, 0x74, 0x65,
+	0x72, 0x6d, 0x69, 0x6e, 0x69, 0x73, 0x74, 0x69, 0x63, 0x12, 0x1b, 0x0a, 0x06, 0x6c, 0x6f, 0x63,
+	0x61, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x06, 0x6c, 0x6f, 0x63,
+	0x61, 0x6c, 0x65, 0x88, 0x01, 0x01, 0x12, 0x28, 0x0a, 0x0d, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x65,
+	0x5f, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52,
+	0x0c, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x65, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x88, 0x01, 0x01,
+	0x42, 0x09, 0x0a, 0x07, 0x5f, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x65, 0x42, 0x10, 0x0a, 0x0e, 0x5f,
+	0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x65, 0x5f, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x22, 0x60, 0x0a,
 	0x0f, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67,
 	0x12, 0x27, 0x0a, 0x0f, 0x70, 0x72, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x5f, 0x6c, 0x65, 0x6e,
 	0x67, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x70, 0x72, 0x65, 0x73, 0x65,
-	0x72, 0x76, 0x65, 0x4c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x22, 0x0d, 0x0a, 0x0b, 0x50, 0x61, 0x73,
-	0x73, 0x74, 0x68, 0x72, 0x6f, 0x75, 0x67, 0x68, 0x22, 0x06, 0x0a, 0x04, 0x4e, 0x75, 0x6c, 0x6c,
-	0x22, 0x29, 0x0a, 0x13, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x4a, 0x61, 0x76,
-	0x61, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x22, 0x38, 0x0a, 0x1c, 0x55,
-	0x73, 0x65, 0x72, 0x44, 0x65, 0x66, 0x69, 0x6e, 0x65, 0x64, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66,
-	0x6f, 0x72, 0x6d, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x18, 0x0a, 0x02, 0x69,
-	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0xba, 0x48, 0x05, 0x72, 0x03, 0xb0, 0x01,
-	0x01, 0x52, 0x02, 0x69, 0x64, 0x22, 0x60, 0x0a, 0x21, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74,
-	0x65, 0x55, 0x73, 0x65, 0x72, 0x4a, 0x61, 0x76, 0x61, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x43,
-	0x6f, 0x64, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x27, 0x0a, 0x0a, 0x61, 0x63,
-	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x08,
-	0xba, 0x48, 0x05, 0x72, 0x03, 0xb0, 0x01, 0x01, 0x52, 0x09, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e,
-	0x74, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x22, 0x3a, 0x0a, 0x22, 0x56, 0x61, 0x6c, 0x69, 0x64,
-	0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x4a, 0x61, 0x76, 0x61, 0x73, 0x63, 0x72, 0x69, 0x70,
-	0x74, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a,
-	0x05, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x76, 0x61,
-	0x6c, 0x69, 0x64, 0x22, 0x35, 0x0a, 0x13, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x43,
-	0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x69, 0x63, 0x61, 0x6c, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x61,
-	0x74, 0x65, 0x67, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
-	0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x22, 0x69, 0x0a, 0x1a, 0x54, 0x72,
-	0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x43, 0x68, 0x61, 0x72, 0x61, 0x63, 0x74, 0x65, 0x72,
-	0x53, 0x63, 0x72, 0x61, 0x6d, 0x62, 0x6c, 0x65, 0x12, 0x33, 0x0a, 0x13, 0x75, 0x73, 0x65, 0x72,
-	0x5f, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x64, 0x5f, 0x72, 0x65, 0x67, 0x65, 0x78, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x11, 0x75, 0x73, 0x65, 0x72, 0x50, 0x72, 0x6f,
-	0x76, 0x69, 0x64, 0x65, 0x64, 0x52, 0x65, 0x67, 0x65, 0x78, 0x88, 0x01, 0x01, 0x42, 0x16, 0x0a,
-	0x14, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x64, 0x5f,
-	0x72, 0x65, 0x67, 0x65, 0x78, 0x22, 0x28, 0x0a, 0x12, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74,
-	0x65, 0x4a, 0x61, 0x76, 0x61, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x63,
-	0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x22,
-	0x77, 0x0a, 0x1c, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x52,
-	0x65, 0x67, 0x65, 0x78, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x72, 0x76, 0x65, 0x4c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x12, 0x24, 0x0a, 0x0d, 0x64, 0x65, 0x74,
+	0x65, 0x72, 0x6d, 0x69, 0x6e, 0x69, 0x73, 0x74, 0x69, 0x63, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x0d, 0x64, 0x65, 0x74, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x69, 0x73, 0x74, 0x69, 0x63, 0x22,
+	0x0d, 0x0a, 0x0b, 0x50, 0x61, 0x73, 0x73, 0x74, 0x68, 0x72, 0x6f, 0x75, 0x67, 0x68, 0x22, 0x06,
+	0x0a, 0x04, 0x4e, 0x75, 0x6c, 0x6c, 0x22, 0x29, 0x0a, 0x13, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66,
+	0x6f, 0x72, 0x6d, 0x4a, 0x61, 0x76, 0x61, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x12, 0x12, 0x0a,
+	0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x6f, 0x64,
+	0x65, 0x22, 0x38, 0x0a, 0x1c, 0x55, 0x73, 0x65, 0x72, 0x44, 0x65, 0x66, 0x69, 0x6e, 0x65, 0x64,
+	0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x12, 0x18, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0xba,
+	0x48, 0x05, 0x72, 0x03, 0xb0, 0x01, 0x01, 0x52, 0x02, 0x69, 0x64, 0x22, 0x60, 0x0a, 0x21, 0x56,
+	0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x4a, 0x61, 0x76, 0x61, 0x73,
+	0x63, 0x72, 0x69, 0x70, 0x74, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x27, 0x0a, 0x0a, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0xba, 0x48, 0x05, 0x72, 0x03, 0xb0, 0x01, 0x01, 0x52, 0x09,
+	0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x22, 0x3a, 0x0a,
+	0x22, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x4a, 0x61, 0x76,
+	0x61, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x22, 0x35, 0x0a, 0x13, 0x47, 0x65, 0x6e,
+	0x65, 0x72, 0x61, 0x74, 0x65, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x69, 0x63, 0x61, 0x6c,
+	0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x69, 0x65, 0x73,
+	0x22, 0x8f, 0x01, 0x0a, 0x1a, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x43, 0x68,
+	0x61, 0x72, 0x61, 0x63, 0x74, 0x65, 0x72, 0x53, 0x63, 0x72, 0x61, 0x6d, 0x62, 0x6c, 0x65, 0x12,
+	0x33, 0x0a, 0x13, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x64,
+	0x5f, 0x72, 0x65, 0x67, 0x65, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x11,
+	0x75, 0x73, 0x65, 0x72, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x64, 0x52, 0x65, 0x67, 0x65,
+	0x78, 0x88, 0x01, 0x01, 0x12, 0x24, 0x0a, 0x0d, 0x64, 0x65, 0x74, 0x65, 0x72, 0x6d, 0x69, 0x6e,
+	0x69, 0x73, 0x74, 0x69, 0x63, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x64, 0x65, 0x74,
+	0x65, 0x72, 0x6d, 0x69, 0x6e, 0x69, 0x73, 0x74, 0x69, 0x63, 0x42, 0x16, 0x0a, 0x14, 0x5f, 0x75,
+	0x73, 0x65, 0x72, 0x5f, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x64, 0x5f, 0x72, 0x65, 0x67,
+	0x65, 0x78, 0x22, 0x28, 0x0a, 0x12, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x4a, 0x61,
+	0x76, 0x61, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x22, 0x8a, 0x01, 0x0a,
+	0x20, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74,
+	0x50, 0x72, 0x65, 0x73, 0x65, 0x72, 0x76, 0x69, 0x6e, 0x67, 0x45, 0x6e, 0x63, 0x72, 0x79, 0x70,
+	0x74, 0x12, 0x50, 0x0a, 0x09, 0x61, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x32, 0x2e, 0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x76, 0x31, 0x61, 0x6c,
+	0x70, 0x68, 0x61, 0x31, 0x2e, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x50, 0x72, 0x65, 0x73, 0x65,
+	0x72, 0x76, 0x69, 0x6e, 0x67, 0x45, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x41,
+	0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x52, 0x09, 0x61, 0x6c, 0x67, 0x6f, 0x72, 0x69,
+	0x74, 0x68, 0x6d, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x77, 0x65, 0x61, 0x6b, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x74, 0x77, 0x65, 0x61, 0x6b, 0x22, 0xf5, 0x02, 0x0a, 0x12, 0x54, 0x72,
+	0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x12, 0x34, 0x0a, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x20,
+	0x2e, 0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x54,
+	0x69, 0x6d, 0x65, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x4d, 0x6f, 0x64, 0x65,
+	0x52, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x12, 0x28, 0x0a, 0x10, 0x73, 0x68, 0x69, 0x66, 0x74, 0x5f,
+	0x72, 0x61, 0x6e, 0x67, 0x65, 0x5f, 0x64, 0x61, 0x79, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x0e, 0x73, 0x68, 0x69, 0x66, 0x74, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x44, 0x61, 0x79, 0x73,
+	0x12, 0x28, 0x0a, 0x0d, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x5f, 0x63, 0x6f, 0x6c, 0x75, 0x6d,
+	0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x0c, 0x65, 0x6e, 0x74, 0x69, 0x74,
+	0x79, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x88, 0x01, 0x01, 0x12, 0x30, 0x0a, 0x14, 0x6a, 0x69,
+	0x74, 0x74, 0x65, 0x72, 0x5f, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e,
+	0x64, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x12, 0x6a, 0x69, 0x74, 0x74, 0x65, 0x72,
+	0x52, 0x61, 0x6e, 0x67, 0x65, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12, 0x53, 0x0a, 0x12,
+	0x74, 0x72, 0x75, 0x6e, 0x63, 0x61, 0x74, 0x65, 0x5f, 0x70, 0x72, 0x65, 0x63, 0x69, 0x73, 0x69,
+	0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x24, 0x2e, 0x6d, 0x67, 0x6d, 0x74, 0x2e,
+	0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x54, 0x72, 0x75,
+	0x6e, 0x63, 0x61, 0x74, 0x65, 0x50, 0x72, 0x65, 0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x11,
+	0x74, 0x72, 0x75, 0x6e, 0x63, 0x61, 0x74, 0x65, 0x50, 0x72, 0x65, 0x63, 0x69, 0x73, 0x69, 0x6f,
+	0x6e, 0x12, 0x15, 0x0a, 0x03, 0x6d, 0x69, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01,
+	0x52, 0x03, 0x6d, 0x69, 0x6e, 0x88, 0x01, 0x01, 0x12, 0x15, 0x0a, 0x03, 0x6d, 0x61, 0x78, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x09, 0x48, 0x02, 0x52, 0x03, 0x6d, 0x61, 0x78, 0x88, 0x01, 0x01, 0x42,
+	0x10, 0x0a, 0x0e, 0x5f, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x5f, 0x63, 0x6f, 0x6c, 0x75, 0x6d,
+	0x6e, 0x42, 0x06, 0x0a, 0x04, 0x5f, 0x6d, 0x69, 0x6e, 0x42, 0x06, 0x0a, 0x04, 0x5f, 0x6d, 0x61,
+	0x78, 0x22, 0xea, 0x02, 0x0a, 0x0d, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x44,
+	0x61, 0x74, 0x65, 0x12, 0x34, 0x0a, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x20, 0x2e, 0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61,
+	0x31, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x4d,
+	0x6f, 0x64, 0x65, 0x52, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x12, 0x28, 0x0a, 0x10, 0x73, 0x68, 0x69,
+	0x66, 0x74, 0x5f, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x5f, 0x64, 0x61, 0x79, 0x73, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x0e, 0x73, 0x68, 0x69, 0x66, 0x74, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x44,
+	0x61, 0x79, 0x73, 0x12, 0x28, 0x0a, 0x0d, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x5f, 0x63, 0x6f,
+	0x6c, 0x75, 0x6d, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x0c, 0x65, 0x6e,
+	0x74, 0x69, 0x74, 0x79, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x88, 0x01, 0x01, 0x12, 0x2a, 0x0a,
+	0x11, 0x6a, 0x69, 0x74, 0x74, 0x65, 0x72, 0x5f, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x5f, 0x64, 0x61,
+	0x79, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x6a, 0x69, 0x74, 0x74, 0x65, 0x72,
+	0x52, 0x61, 0x6e, 0x67, 0x65, 0x44, 0x61, 0x79, 0x73, 0x12, 0x53, 0x0a, 0x12, 0x74, 0x72, 0x75,
+	0x6e, 0x63, 0x61, 0x74, 0x65, 0x5f, 0x70, 0x72, 0x65, 0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x24, 0x2e, 0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x76, 0x31, 0x61,
+	0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x54, 0x72, 0x75, 0x6e, 0x63, 0x61,
+	0x74, 0x65, 0x50, 0x72, 0x65, 0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x11, 0x74, 0x72, 0x75,
+	0x6e, 0x63, 0x61, 0x74, 0x65, 0x50, 0x72, 0x65, 0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x15,
+	0x0a, 0x03, 0x6d, 0x69, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x03, 0x6d,
+	0x69, 0x6e, 0x88, 0x01, 0x01, 0x12, 0x15, 0x0a, 0x03, 0x6d, 0x61, 0x78, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x09, 0x48, 0x02, 0x52, 0x03, 0x6d, 0x61, 0x78, 0x88, 0x01, 0x01, 0x42, 0x10, 0x0a, 0x0e,
+	0x5f, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x5f, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x42, 0x06,
+	0x0a, 0x04, 0x5f, 0x6d, 0x69, 0x6e, 0x42, 0x06, 0x0a, 0x04, 0x5f, 0x6d, 0x61, 0x78, 0x22, 0x47,
+	0x0a, 0x0d, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x4a, 0x73, 0x6f, 0x6e, 0x12,
+	0x36, 0x0a, 0x05, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20,
+	0x2e, 0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x54,
+	0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x4a, 0x73, 0x6f, 0x6e, 0x52, 0x75, 0x6c, 0x65,
+	0x52, 0x05, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x22, 0x9b, 0x01, 0x0a, 0x11, 0x54, 0x72, 0x61, 0x6e,
+	0x73, 0x66, 0x6f, 0x72, 0x6d, 0x4a, 0x73, 0x6f, 0x6e, 0x52, 0x75, 0x6c, 0x65, 0x12, 0x12, 0x0a,
+	0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74,
+	0x68, 0x12, 0x38, 0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x20, 0x2e, 0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61,
+	0x31, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x65, 0x72, 0x53, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x52, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x38, 0x0a, 0x06, 0x63,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x6d, 0x67,
+	0x6d, 0x74, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x54, 0x72, 0x61, 0x6e,
+	0x73, 0x66, 0x6f, 0x72, 0x6d, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x06, 0x63,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x22, 0xa1, 0x01, 0x0a, 0x11, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66,
+	0x6f, 0x72, 0x6d, 0x46, 0x72, 0x65, 0x65, 0x54, 0x65, 0x78, 0x74, 0x12, 0x44, 0x0a, 0x0c, 0x65,
+	0x6e, 0x74, 0x69, 0x74, 0x79, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0e, 0x32, 0x21, 0x2e, 0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61,
+	0x31, 0x2e, 0x46, 0x72, 0x65, 0x65, 0x54, 0x65, 0x78, 0x74, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79,
+	0x54, 0x79, 0x70, 0x65, 0x52, 0x0b, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x54, 0x79, 0x70, 0x65,
+	0x73, 0x12, 0x46, 0x0a, 0x08, 0x73, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x2a, 0x2e, 0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70,
+	0x68, 0x61, 0x31, 0x2e, 0x46, 0x72, 0x65, 0x65, 0x54, 0x65, 0x78, 0x74, 0x52, 0x65, 0x70, 0x6c,
+	0x61, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x52,
+	0x08, 0x73, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x22, 0x4e, 0x0a, 0x11, 0x47, 0x65, 0x6e,
+	0x65, 0x72, 0x61, 0x74, 0x65, 0x49, 0x70, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x39,
+	0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x1f, 0x2e, 0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e,
+	0x49, 0x70, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x97, 0x01, 0x0a, 0x12, 0x54, 0x72,
+	0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x49, 0x70, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x12, 0x34, 0x0a, 0x16, 0x70, 0x72, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x5f, 0x70, 0x72, 0x65,
+	0x66, 0x69, 0x78, 0x5f, 0x6c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x14, 0x70, 0x72, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x50, 0x72, 0x65, 0x66, 0x69, 0x78,
+	0x4c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x12, 0x25, 0x0a, 0x0e, 0x70, 0x72, 0x65, 0x73, 0x65, 0x72,
+	0x76, 0x65, 0x5f, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d,
+	0x70, 0x72, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x12, 0x24, 0x0a,
+	0x0d, 0x64, 0x65, 0x74, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x69, 0x73, 0x74, 0x69, 0x63, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x64, 0x65, 0x74, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x69, 0x73,
+	0x74, 0x69, 0x63, 0x22, 0x14, 0x0a, 0x12, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x4d,
+	0x61, 0x63, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0x5e, 0x0a, 0x13, 0x54, 0x72, 0x61,
+	0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x4d, 0x61, 0x63, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x12, 0x21, 0x0a, 0x0c, 0x70, 0x72, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x5f, 0x6f, 0x75, 0x69,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x70, 0x72, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65,
+	0x4f, 0x75, 0x69, 0x12, 0x24, 0x0a, 0x0d, 0x64, 0x65, 0x74, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x69,
+	0x73, 0x74, 0x69, 0x63, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x64, 0x65, 0x74, 0x65,
+	0x72, 0x6d, 0x69, 0x6e, 0x69, 0x73, 0x74, 0x69, 0x63, 0x22, 0x0d, 0x0a, 0x0b, 0x47, 0x65, 0x6e,
+	0x65, 0x72, 0x61, 0x74, 0x65, 0x55, 0x72, 0x6c, 0x22, 0x59, 0x0a, 0x0c, 0x54, 0x72, 0x61, 0x6e,
+	0x73, 0x66, 0x6f, 0x72, 0x6d, 0x55, 0x72, 0x6c, 0x12, 0x23, 0x0a, 0x0d, 0x70, 0x72, 0x65, 0x73,
+	0x65, 0x72, 0x76, 0x65, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x0c, 0x70, 0x72, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x50, 0x61, 0x74, 0x68, 0x12, 0x24, 0x0a,
+	0x0d, 0x64, 0x65, 0x74, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x69, 0x73, 0x74, 0x69, 0x63, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x64, 0x65, 0x74, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x69, 0x73,
+	0x74, 0x69, 0x63, 0x22, 0x10, 0x0a, 0x0e, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x44,
+	0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x22, 0x5a, 0x0a, 0x0f, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f,
+	0x72, 0x6d, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x12, 0x21, 0x0a, 0x0c, 0x70, 0x72, 0x65, 0x73,
+	0x65, 0x72, 0x76, 0x65, 0x5f, 0x74, 0x6c, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b,
+	0x70, 0x72, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x54, 0x6c, 0x64, 0x12, 0x24, 0x0a, 0x0d, 0x64,
+	0x65, 0x74, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x69, 0x73, 0x74, 0x69, 0x63, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x0d, 0x64, 0x65, 0x74, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x69, 0x73, 0x74, 0x69,
+	0x63, 0x22, 0x77, 0x0a, 0x1c, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65,
+	0x72, 0x52, 0x65, 0x67, 0x65, 0x78, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x27, 0x0a, 0x0a, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0xba, 0x48, 0x05, 0x72, 0x03, 0xb0, 0x01, 0x01, 0x52,
+	0x09, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x2e, 0x0a, 0x13, 0x75, 0x73,
+	0x65, 0x72, 0x5f, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x64, 0x5f, 0x72, 0x65, 0x67, 0x65,
+	0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x75, 0x73, 0x65, 0x72, 0x50, 0x72, 0x6f,
+	0x76, 0x69, 0x64, 0x65, 0x64, 0x52, 0x65, 0x67, 0x65, 0x78, 0x22, 0x35, 0x0a, 0x1d, 0x56, 0x61,
+	0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x67, 0x65, 0x78, 0x43,
+	0x6f, 0x64, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x76,
+	0x61, 0x6c, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x69,
+	0x64, 0x22, 0x83, 0x01, 0x0a, 0x22, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x54, 0x72,
+	0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x4a, 0x73, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x27, 0x0a, 0x0a, 0x61, 0x63, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0xba, 0x48,
+	0x05, 0x72, 0x03, 0xb0, 0x01, 0x01, 0x52, 0x09, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49,
+	0x64, 0x12, 0x34, 0x0a, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1c, 0x2e, 0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61,
+	0x31, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x4a, 0x73, 0x6f, 0x6e, 0x52,
+	0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x22, 0x53, 0x0a, 0x23, 0x56, 0x61, 0x6c, 0x69, 0x64,
+	0x61, 0x74, 0x65, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x4a, 0x73, 0x6f, 0x6e,
+	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14,
+	0x0a, 0x05, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x76,
+	0x61, 0x6c, 0x69, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x18, 0x02,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x22, 0xd0, 0x01, 0x0a,
+	0x1e, 0x44, 0x65, 0x63, 0x72, 0x79, 0x70, 0x74, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x50, 0x72,
+	0x65, 0x73, 0x65, 0x72, 0x76, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
 	0x27, 0x0a, 0x0a, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
 	0x01, 0x28, 0x09, 0x42, 0x08, 0xba, 0x48, 0x05, 0x72, 0x03, 0xb0, 0x01, 0x01, 0x52, 0x09, 0x61,
-	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x2e, 0x0a, 0x13, 0x75, 0x73, 0x65, 0x72,
-	0x5f, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x64, 0x5f, 0x72, 0x65, 0x67, 0x65, 0x78, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x75, 0x73, 0x65, 0x72, 0x50, 0x72, 0x6f, 0x76, 0x69,
-	0x64, 0x65, 0x64, 0x52, 0x65, 0x67, 0x65, 0x78, 0x22, 0x35, 0x0a, 0x1d, 0x56, 0x61, 0x6c, 0x69,
-	0x64, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x67, 0x65, 0x78, 0x43, 0x6f, 0x64,
-	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c,
-	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x2a,
-	0xb1, 0x0f, 0x0a, 0x11, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x65, 0x72, 0x53,
-	0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x22, 0x0a, 0x1e, 0x54, 0x52, 0x41, 0x4e, 0x53, 0x46, 0x4f,
-	0x52, 0x4d, 0x45, 0x52, 0x5f, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50,
-	0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x22, 0x0a, 0x1e, 0x54, 0x52, 0x41,
-	0x4e, 0x53, 0x46, 0x4f, 0x52, 0x4d, 0x45, 0x52, 0x5f, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x5f,
-	0x50, 0x41, 0x53, 0x53, 0x54, 0x48, 0x52, 0x4f, 0x55, 0x47, 0x48, 0x10, 0x01, 0x12, 0x27, 0x0a,
-	0x23, 0x54, 0x52, 0x41, 0x4e, 0x53, 0x46, 0x4f, 0x52, 0x4d, 0x45, 0x52, 0x5f, 0x53, 0x4f, 0x55,
-	0x52, 0x43, 0x45, 0x5f, 0x47, 0x45, 0x4e, 0x45, 0x52, 0x41, 0x54, 0x45, 0x5f, 0x44, 0x45, 0x46,
-	0x41, 0x55, 0x4c, 0x54, 0x10, 0x02, 0x12, 0x2b, 0x0a, 0x27, 0x54, 0x52, 0x41, 0x4e, 0x53, 0x46,
-	0x4f, 0x52, 0x4d, 0x45, 0x52, 0x5f, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x5f, 0x54, 0x52, 0x41,
-	0x4e, 0x53, 0x46, 0x4f, 0x52, 0x4d, 0x5f, 0x4a, 0x41, 0x56, 0x41, 0x53, 0x43, 0x52, 0x49, 0x50,
-	0x54, 0x10, 0x03, 0x12, 0x25, 0x0a, 0x21, 0x54, 0x52, 0x41, 0x4e, 0x53, 0x46, 0x4f, 0x52, 0x4d,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xba, 0x48, 0x04, 0x72, 0x02, 0x10, 0x01,
+	0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x50, 0x0a, 0x09, 0x61, 0x6c, 0x67, 0x6f, 0x72,
+	0x69, 0x74, 0x68, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x32, 0x2e, 0x6d, 0x67, 0x6d,
+	0x74, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x46, 0x6f, 0x72, 0x6d, 0x61,
+	0x74, 0x50, 0x72, 0x65, 0x73, 0x65, 0x72, 0x76, 0x69, 0x6e, 0x67, 0x45, 0x6e, 0x63, 0x72, 0x79,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x52, 0x09,
+	0x61, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x77, 0x65,
+	0x61, 0x6b, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x77, 0x65, 0x61, 0x6b, 0x22,
+	0x37, 0x0a, 0x1f, 0x44, 0x65, 0x63, 0x72, 0x79, 0x70, 0x74, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74,
+	0x50, 0x72, 0x65, 0x73, 0x65, 0x72, 0x76, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x2a, 0xe3, 0x13, 0x0a, 0x11, 0x54, 0x72, 0x61,
+	0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x65, 0x72, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x22,
+	0x0a, 0x1e, 0x54, 0x52, 0x41, 0x4e, 0x53, 0x46, 0x4f, 0x52, 0x4d, 0x45, 0x52, 0x5f, 0x53, 0x4f,
+	0x55, 0x52, 0x43, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44,
+	0x10, 0x00, 0x12, 0x22, 0x0a, 0x1e, 0x54, 0x52, 0x41, 0x4e, 0x53, 0x46, 0x4f, 0x52, 0x4d, 0x45,
+	0x52, 0x5f, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x5f, 0x50, 0x41, 0x53, 0x53, 0x54, 0x48, 0x52,
+	0x4f, 0x55, 0x47, 0x48, 0x10, 0x01, 0x12, 0x27, 0x0a, 0x23, 0x54, 0x52, 0x41, 0x4e, 0x53, 0x46,
+	0x4f, 0x52, 0x4d, 0x45, 0x52, 0x5f, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x5f, 0x47, 0x45, 0x4e,
+	0x45, 0x52, 0x41, 0x54, 0x45, 0x5f, 0x44, 0x45, 0x46, 0x41, 0x55, 0x4c, 0x54, 0x10, 0x02, 0x12,
+	0x2b, 0x0a, 0x27, 0x54, 0x52, 0x41, 0x4e, 0x53, 0x46, 0x4f, 0x52, 0x4d, 0x45, 0x52, 0x5f, 0x53,
+	0x4f, 0x55, 0x52, 0x43, 0x45, 0x5f, 0x54, 0x52, 0x41, 0x4e, 0x53, 0x46, 0x4f, 0x52, 0x4d, 0x5f,
+	0x4a, 0x41, 0x56, 0x41, 0x53, 0x43, 0x52, 0x49, 0x50, 0x54, 0x10, 0x03, 0x12, 0x25, 0x0a, 0x21,
+	0x54, 0x52, 0x41, 0x4e, 0x53, 0x46, 0x4f, 0x52, 0x4d, 0x45, 0x52, 0x5f, 0x53, 0x4f, 0x55, 0x52,
+	0x43, 0x45, 0x5f, 0x47, 0x45, 0x4e, 0x45, 0x52, 0x41, 0x54, 0x45, 0x5f, 0x45, 0x4d, 0x41, 0x49,
+	0x4c, 0x10, 0x04, 0x12, 0x26, 0x0a, 0x22, 0x54, 0x52, 0x41, 0x4e, 0x53, 0x46, 0x4f, 0x52, 0x4d,
+	0x45, 0x52, 0x5f, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x5f, 0x54, 0x52, 0x41, 0x4e, 0x53, 0x46,
+	0x4f, 0x52, 0x4d, 0x5f, 0x45, 0x4d, 0x41, 0x49, 0x4c, 0x10, 0x05, 0x12, 0x24, 0x0a, 0x20, 0x54,
+	0x52, 0x41, 0x4e, 0x53, 0x46, 0x4f, 0x52, 0x4d, 0x45, 0x52, 0x5f, 0x53, 0x4f, 0x55, 0x52, 0x43,
+	0x45, 0x5f, 0x47, 0x45, 0x4e, 0x45, 0x52, 0x41, 0x54, 0x45, 0x5f, 0x42, 0x4f, 0x4f, 0x4c, 0x10,
+	0x06, 0x12, 0x2b, 0x0a, 0x27, 0x54, 0x52, 0x41, 0x4e, 0x53, 0x46, 0x4f, 0x52, 0x4d, 0x45, 0x52,
+	0x5f, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x5f, 0x47, 0x45, 0x4e, 0x45, 0x52, 0x41, 0x54, 0x45,
+	0x5f, 0x43, 0x41, 0x52, 0x44, 0x5f, 0x4e, 0x55, 0x4d, 0x42, 0x45, 0x52, 0x10, 0x07, 0x12, 0x24,
+	0x0a, 0x20, 0x54, 0x52, 0x41, 0x4e, 0x53, 0x46, 0x4f, 0x52, 0x4d, 0x45, 0x52, 0x5f, 0x53, 0x4f,
+	0x55, 0x52, 0x43, 0x45, 0x5f, 0x47, 0x45, 0x4e, 0x45, 0x52, 0x41, 0x54, 0x45, 0x5f, 0x43, 0x49,
+	0x54, 0x59, 0x10, 0x08, 0x12, 0x31, 0x0a, 0x2d, 0x54, 0x52, 0x41, 0x4e, 0x53, 0x46, 0x4f, 0x52,
+	0x4d, 0x45, 0x52, 0x5f, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x5f, 0x47, 0x45, 0x4e, 0x45, 0x52,
+	0x41, 0x54, 0x45, 0x5f, 0x45, 0x31, 0x36, 0x34, 0x5f, 0x50, 0x48, 0x4f, 0x4e, 0x45, 0x5f, 0x4e,
+	0x55, 0x4d, 0x42, 0x45, 0x52, 0x10, 0x09, 0x12, 0x2a, 0x0a, 0x26, 0x54, 0x52, 0x41, 0x4e, 0x53,
+	0x46, 0x4f, 0x52, 0x4d, 0x45, 0x52, 0x5f, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x5f, 0x47, 0x45,
+	0x4e, 0x45, 0x52, 0x41, 0x54, 0x45, 0x5f, 0x46, 0x49, 0x52, 0x53, 0x54, 0x5f, 0x4e, 0x41, 0x4d,
+	0x45, 0x10, 0x0a, 0x12, 0x27, 0x0a, 0x23, 0x54, 0x52, 0x41, 0x4e, 0x53, 0x46, 0x4f, 0x52, 0x4d,
 	0x45, 0x52, 0x5f, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x5f, 0x47, 0x45, 0x4e, 0x45, 0x52, 0x41,
-	0x54, 0x45, 0x5f, 0x45, 0x4d, 0x41, 0x49, 0x4c, 0x10, 0x04, 0x12, 0x26, 0x0a, 0x22, 0x54, 0x52,
+	0x54, 0x45, 0x5f, 0x46, 0x4c, 0x4f, 0x41, 0x54, 0x36, 0x34, 0x10, 0x0b, 0x12, 0x2c, 0x0a, 0x28,
+	0x54, 0x52, 0x41, 0x4e, 0x53, 0x46, 0x4f, 0x52, 0x4d, 0x45, 0x52, 0x5f, 0x53, 0x4f, 0x55, 0x52,
+	0x43, 0x45, 0x5f, 0x47, 0x45, 0x4e, 0x45, 0x52, 0x41, 0x54, 0x45, 0x5f, 0x46, 0x55, 0x4c, 0x4c,
+	0x5f, 0x41, 0x44, 0x44, 0x52, 0x45, 0x53, 0x53, 0x10, 0x0c, 0x12, 0x29, 0x0a, 0x25, 0x54, 0x52,
 	0x41, 0x4e, 0x53, 0x46, 0x4f, 0x52, 0x4d, 0x45, 0x52, 0x5f, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45,
-	0x5f, 0x54, 0x52, 0x41, 0x4e, 0x53, 0x46, 0x4f, 0x52, 0x4d, 0x5f, 0x45, 0x4d, 0x41, 0x49, 0x4c,
-	0x10, 0x05, 0x12, 0x24, 0x0a, 0x20, 0x54, 0x52, 0x41, 0x4e, 0x53, 0x46, 0x4f, 0x52, 0x4d, 0x45,
-	0x52, 0x5f, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x5f, 0x47, 0x45, 0x4e, 0x45, 0x52, 0x41, 0x54,
-	0x45, 0x5f, 0x42, 0x4f, 0x4f, 0x4c, 0x10, 0x06, 0x12, 0x2b, 0x0a, 0x27, 0x54, 0x52, 0x41, 0x4e,
-	0x53, 0x46, 0x4f, 0x52, 0x4d, 0x45, 0x52, 0x5f, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x5f, 0x47,
-	0x45, 0x4e, 0x45, 0x52, 0x41, 0x54, 0x45, 0x5f, 0x43, 0x41, 0x52, 0x44, 0x5f, 0x4e, 0x55, 0x4d,
-	0x42, 0x45, 0x52, 0x10, 0x07, 0x12, 0x24, 0x0a, 0x20, 0x54, 0x52, 0x41, 0x4e, 0x53, 0x46, 0x4f,
+	0x5f, 0x47, 0x45, 0x4e, 0x45, 0x52, 0x41, 0x54, 0x45, 0x5f, 0x46, 0x55, 0x4c, 0x4c, 0x5f, 0x4e,
+	0x41, 0x4d, 0x45, 0x10, 0x0d, 0x12, 0x26, 0x0a, 0x22, 0x54, 0x52, 0x41, 0x4e, 0x53, 0x46, 0x4f,
 	0x52, 0x4d, 0x45, 0x52, 0x5f, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x5f, 0x47, 0x45, 0x4e, 0x45,
-	0x52, 0x41, 0x54, 0x45, 0x5f, 0x43, 0x49, 0x54, 0x59, 0x10, 0x08, 0x12, 0x31, 0x0a, 0x2d, 0x54,
-	0x52, 0x41, 0x4e, 0x53, 0x46, 0x4f, 0x52, 0x4d, 0x45, 0x52, 0x5f, 0x53, 0x4f, 0x55, 0x52, 0x43,
-	0x45, 0x5f, 0x47, 0x45, 0x4e, 0x45, 0x52, 0x41, 0x54, 0x45, 0x5f, 0x45, 0x31, 0x36, 0x34, 0x5f,
-	0x50, 0x48, 0x4f, 0x4e, 0x45, 0x5f, 0x4e, 0x55, 0x4d, 0x42, 0x45, 0x52, 0x10, 0x09, 0x12, 0x2a,
-	0x0a, 0x26, 0x54, 0x52, 0x41, 0x4e, 0x53, 0x46, 0x4f, 0x52, 0x4d, 0x45, 0x52, 0x5f, 0x53, 0x4f,
-	0x55, 0x52, 0x43, 0x45, 0x5f, 0x47, 0x45, 0x4e, 0x45, 0x52, 0x41, 0x54, 0x45, 0x5f, 0x46, 0x49,
-	0x52, 0x53, 0x54, 0x5f, 0x4e, 0x41, 0x4d, 0x45, 0x10, 0x0a, 0x12, 0x27, 0x0a, 0x23, 0x54, 0x52,
-	0x41, 0x4e, 0x53, 0x46, 0x4f, 0x52, 0x4d, 0x45, 0x52, 0x5f, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45,
-	0x5f, 0x47, 0x45, 0x4e, 0x45, 0x52, 0x41, 0x54, 0x45, 0x5f, 0x46, 0x4c, 0x4f, 0x41, 0x54, 0x36,
-	0x34, 0x10, 0x0b, 0x12, 0x2c, 0x0a, 0x28, 0x54, 0x52, 0x41, 0x4e, 0x53, 0x46, 0x4f, 0x52, 0x4d,
-	0x45, 0x52, 0x5f, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x5f, 0x47, 0x45, 0x4e, 0x45, 0x52, 0x41,
-	0x54, 0x45, 0x5f, 0x46, 0x55, 0x4c, 0x4c, 0x5f, 0x41, 0x44, 0x44, 0x52, 0x45, 0x53, 0x53, 0x10,
-	0x0c, 0x12, 0x29, 0x0a, 0x25, 0x54, 0x52, 0x41, 0x4e, 0x53, 0x46, 0x4f, 0x52, 0x4d, 0x45, 0x52,
+	0x52, 0x41, 0x54, 0x45, 0x5f, 0x47, 0x45, 0x4e, 0x44, 0x45, 0x52, 0x10, 0x0e, 0x12, 0x32, 0x0a,
+	0x2e, 0x54, 0x52, 0x41, 0x4e, 0x53, 0x46, 0x4f, 0x52, 0x4d, 0x45, 0x52, 0x5f, 0x53, 0x4f, 0x55,
+	0x52, 0x43, 0x45, 0x5f, 0x47, 0x45, 0x4e, 0x45, 0x52, 0x41, 0x54, 0x45, 0x5f, 0x49, 0x4e, 0x54,
+	0x36, 0x34, 0x5f, 0x50, 0x48, 0x4f, 0x4e, 0x45, 0x5f, 0x4e, 0x55, 0x4d, 0x42, 0x45, 0x52, 0x10,
+	0x0f, 0x12, 0x25, 0x0a, 0x21, 0x54, 0x52, 0x41, 0x4e, 0x53, 0x46, 0x4f, 0x52, 0x4d, 0x45, 0x52,
 	0x5f, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x5f, 0x47, 0x45, 0x4e, 0x45, 0x52, 0x41, 0x54, 0x45,
-	0x5f, 0x46, 0x55, 0x4c, 0x4c, 0x5f, 0x4e, 0x41, 0x4d, 0x45, 0x10, 0x0d, 0x12, 0x26, 0x0a, 0x22,
-	0x54, 0x52, 0x41, 0x4e, 0x53, 0x46, 0x4f, 0x52, 0x4d, 0x45, 0x52, 0x5f, 0x53, 0x4f, 0x55, 0x52,
-	0x43, 0x45, 0x5f, 0x47, 0x45, 0x4e, 0x45, 0x52, 0x41, 0x54, 0x45, 0x5f, 0x47, 0x45, 0x4e, 0x44,
-	0x45, 0x52, 0x10, 0x0e, 0x12, 0x32, 0x0a, 0x2e, 0x54, 0x52, 0x41, 0x4e, 0x53, 0x46, 0x4f, 0x52,
-	0x4d, 0x45, 0x52, 0x5f, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x5f, 0x47, 0x45, 0x4e, 0x45, 0x52,
-	0x41, 0x54, 0x45, 0x5f, 0x49, 0x4e, 0x54, 0x36, 0x34, 0x5f, 0x50, 0x48, 0x4f, 0x4e, 0x45, 0x5f,
-	0x4e, 0x55, 0x4d, 0x42, 0x45, 0x52, 0x10, 0x0f, 0x12, 0x25, 0x0a, 0x21, 0x54, 0x52, 0x41, 0x4e,
+	0x5f, 0x49, 0x4e, 0x54, 0x36, 0x34, 0x10, 0x10, 0x12, 0x2c, 0x0a, 0x28, 0x54, 0x52, 0x41, 0x4e,
 	0x53, 0x46, 0x4f, 0x52, 0x4d, 0x45, 0x52, 0x5f, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x5f, 0x47,
-	0x45, 0x4e, 0x45, 0x52, 0x41, 0x54, 0x45, 0x5f, 0x49, 0x4e, 0x54, 0x36, 0x34, 0x10, 0x10, 0x12,
-	0x2c, 0x0a, 0x28, 0x54, 0x52, 0x41, 0x4e, 0x53, 0x46, 0x4f, 0x52, 0x4d, 0x45, 0x52, 0x5f, 0x53,
-	0x4f, 0x55, 0x52, 0x43, 0x45, 0x5f, 0x47, 0x45, 0x4e, 0x45, 0x52, 0x41, 0x54, 0x45, 0x5f, 0x52,
-	0x41, 0x4e, 0x44, 0x4f, 0x4d, 0x5f, 0x49, 0x4e, 0x54, 0x36, 0x34, 0x10, 0x11, 0x12, 0x29, 0x0a,
-	0x25, 0x54, 0x52, 0x41, 0x4e, 0x53, 0x46, 0x4f, 0x52, 0x4d, 0x45, 0x52, 0x5f, 0x53, 0x4f, 0x55,
-	0x52, 0x43, 0x45, 0x5f, 0x47, 0x45, 0x4e, 0x45, 0x52, 0x41, 0x54, 0x45, 0x5f, 0x4c, 0x41, 0x53,
-	0x54, 0x5f, 0x4e, 0x41, 0x4d, 0x45, 0x10, 0x12, 0x12, 0x2a, 0x0a, 0x26, 0x54, 0x52, 0x41, 0x4e,
-	0x53, 0x46, 0x4f, 0x52, 0x4d, 0x45, 0x52, 0x5f, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x5f, 0x47,
-	0x45, 0x4e, 0x45, 0x52, 0x41, 0x54, 0x45, 0x5f, 0x53, 0x48, 0x41, 0x32, 0x35, 0x36, 0x48, 0x41,
-	0x53, 0x48, 0x10, 0x13, 0x12, 0x23, 0x0a, 0x1f, 0x54, 0x52, 0x41, 0x4e, 0x53, 0x46, 0x4f, 0x52,
-	0x4d, 0x45, 0x52, 0x5f, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x5f, 0x47, 0x45, 0x4e, 0x45, 0x52,
-	0x41, 0x54, 0x45, 0x5f, 0x53, 0x53, 0x4e, 0x10, 0x14, 0x12, 0x25, 0x0a, 0x21, 0x54, 0x52, 0x41,
+	0x45, 0x4e, 0x45, 0x52, 0x41, 0x54, 0x45, 0x5f, 0x52, 0x41, 0x4e, 0x44, 0x4f, 0x4d, 0x5f, 0x49,
+	0x4e, 0x54, 0x36, 0x34, 0x10, 0x11, 0x12, 0x29, 0x0a, 0x25, 0x54, 0x52, 0x41, 0x4e, 0x53, 0x46,
+	0x4f, 0x52, 0x4d, 0x45, 0x52, 0x5f, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x5f, 0x47, 0x45, 0x4e,
+	0x45, 0x52, 0x41, 0x54, 0x45, 0x5f, 0x4c, 0x41, 0x53, 0x54, 0x5f, 0x4e, 0x41, 0x4d, 0x45, 0x10,
+	0x12, 0x12, 0x2a, 0x0a, 0x26, 0x54, 0x52, 0x41, 0x4e, 0x53, 0x46, 0x4f, 0x52, 0x4d, 0x45, 0x52,
+	0x5f, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x5f, 0x47, 0x45, 0x4e, 0x45, 0x52, 0x41, 0x54, 0x45,
+	0x5f, 0x53, 0x48, 0x41, 0x32, 0x35, 0x36, 0x48, 0x41, 0x53, 0x48, 0x10, 0x13, 0x12, 0x23, 0x0a,
+	0x1f, 0x54, 0x52, 0x41, 0x4e, 0x53, 0x46, 0x4f, 0x52, 0x4d, 0x45, 0x52, 0x5f, 0x53, 0x4f, 0x55,
+	0x52, 0x43, 0x45, 0x5f, 0x47, 0x45, 0x4e, 0x45, 0x52, 0x41, 0x54, 0x45, 0x5f, 0x53, 0x53, 0x4e,
+	0x10, 0x14, 0x12, 0x25, 0x0a, 0x21, 0x54, 0x52, 0x41, 0x4e, 0x53, 0x46, 0x4f, 0x52, 0x4d, 0x45,
+	0x52, 0x5f, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x5f, 0x47, 0x45, 0x4e, 0x45, 0x52, 0x41, 0x54,
+	0x45, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x10, 0x15, 0x12, 0x2e, 0x0a, 0x2a, 0x54, 0x52, 0x41,
 	0x4e, 0x53, 0x46, 0x4f, 0x52, 0x4d, 0x45, 0x52, 0x5f, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x5f,
-	0x47, 0x45, 0x4e, 0x45, 0x52, 0x41, 0x54, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x10, 0x15,
-	0x12, 0x2e, 0x0a, 0x2a, 0x54, 0x52, 0x41, 0x4e, 0x53, 0x46, 0x4f, 0x52, 0x4d, 0x45, 0x52, 0x5f,
-	0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x5f, 0x47, 0x45, 0x4e, 0x45, 0x52, 0x41, 0x54, 0x45, 0x5f,
-	0x53, 0x54, 0x52, 0x45, 0x45, 0x54, 0x5f, 0x41, 0x44, 0x44, 0x52, 0x45, 0x53, 0x53, 0x10, 0x16,
-	0x12, 0x33, 0x0a, 0x2f, 0x54, 0x52, 0x41, 0x4e, 0x53, 0x46, 0x4f, 0x52, 0x4d, 0x45, 0x52, 0x5f,
-	0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x5f, 0x47, 0x45, 0x4e, 0x45, 0x52, 0x41, 0x54, 0x45, 0x5f,
-	0x53, 0x54, 0x52, 0x49, 0x4e, 0x47, 0x5f, 0x50, 0x48, 0x4f, 0x4e, 0x45, 0x5f, 0x4e, 0x55, 0x4d,
-	0x42, 0x45, 0x52, 0x10, 0x17, 0x12, 0x26, 0x0a, 0x22, 0x54, 0x52, 0x41, 0x4e, 0x53, 0x46, 0x4f,
+	0x47, 0x45, 0x4e, 0x45, 0x52, 0x41, 0x54, 0x45, 0x5f, 0x53, 0x54, 0x52, 0x45, 0x45, 0x54, 0x5f,
+	0x41, 0x44, 0x44, 0x52, 0x45, 0x53, 0x53, 0x10, 0x16, 0x12, 0x33, 0x0a, 0x2f, 0x54, 0x52, 0x41,
+	0x4e, 0x53, 0x46, 0x4f, 0x52, 0x4d, 0x45, 0x52, 0x5f, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x5f,
+	0x47, 0x45, 0x4e, 0x45, 0x52, 0x41, 0x54, 0x45, 0x5f, 0x53, 0x54, 0x52, 0x49, 0x4e, 0x47, 0x5f,
+	0x50, 0x48, 0x4f, 0x4e, 0x45, 0x5f, 0x4e, 0x55, 0x4d, 0x42, 0x45, 0x52, 0x10, 0x17, 0x12, 0x26,
+	0x0a, 0x22, 0x54, 0x52, 0x41, 0x4e, 0x53, 0x46, 0x4f, 0x52, 0x4d, 0x45, 0x52, 0x5f, 0x53, 0x4f,
+	0x55, 0x52, 0x43, 0x45, 0x5f, 0x47, 0x45, 0x4e, 0x45, 0x52, 0x41, 0x54, 0x45, 0x5f, 0x53, 0x54,
+	0x52, 0x49, 0x4e, 0x47, 0x10, 0x18, 0x12, 0x2d, 0x0a, 0x29, 0x54, 0x52, 0x41, 0x4e, 0x53, 0x46,
+	0x4f, 0x52, 0x4d, 0x45, 0x52, 0x5f, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x5f, 0x47, 0x45, 0x4e,
+	0x45, 0x52, 0x41, 0x54, 0x45, 0x5f, 0x52, 0x41, 0x4e, 0x44, 0x4f, 0x4d, 0x5f, 0x53, 0x54, 0x52,
+	0x49, 0x4e, 0x47, 0x10, 0x19, 0x12, 0x2d, 0x0a, 0x29, 0x54, 0x52, 0x41, 0x4e, 0x53, 0x46, 0x4f,
 	0x52, 0x4d, 0x45, 0x52, 0x5f, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x5f, 0x47, 0x45, 0x4e, 0x45,
-	0x52, 0x41, 0x54, 0x45, 0x5f, 0x53, 0x54, 0x52, 0x49, 0x4e, 0x47, 0x10, 0x18, 0x12, 0x2d, 0x0a,
-	0x29, 0x54, 0x52, 0x41, 0x4e, 0x53, 0x46, 0x4f, 0x52, 0x4d, 0x45, 0x52, 0x5f, 0x53, 0x4f, 0x55,
-	0x52, 0x43, 0x45, 0x5f, 0x47, 0x45, 0x4e, 0x45, 0x52, 0x41, 0x54, 0x45, 0x5f, 0x52, 0x41, 0x4e,
-	0x44, 0x4f, 0x4d, 0x5f, 0x53, 0x54, 0x52, 0x49, 0x4e, 0x47, 0x10, 0x19, 0x12, 0x2d, 0x0a, 0x29,
+	0x52, 0x41, 0x54, 0x45, 0x5f, 0x55, 0x4e, 0x49, 0x58, 0x54, 0x49, 0x4d, 0x45, 0x53, 0x54, 0x41,
+	0x4d, 0x50, 0x10, 0x1a, 0x12, 0x28, 0x0a, 0x24, 0x54, 0x52, 0x41, 0x4e, 0x53, 0x46, 0x4f, 0x52,
+	0x4d, 0x45, 0x52, 0x5f, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x5f, 0x47, 0x45, 0x4e, 0x45, 0x52,
+	0x41, 0x54, 0x45, 0x5f, 0x55, 0x53, 0x45, 0x52, 0x4e, 0x41, 0x4d, 0x45, 0x10, 0x1b, 0x12, 0x2c,
+	0x0a, 0x28, 0x54, 0x52, 0x41, 0x4e, 0x53, 0x46, 0x4f, 0x52, 0x4d, 0x45, 0x52, 0x5f, 0x53, 0x4f,
+	0x55, 0x52, 0x43, 0x45, 0x5f, 0x47, 0x45, 0x4e, 0x45, 0x52, 0x41, 0x54, 0x45, 0x5f, 0x55, 0x54,
+	0x43, 0x54, 0x49, 0x4d, 0x45, 0x53, 0x54, 0x41, 0x4d, 0x50, 0x10, 0x1c, 0x12, 0x24, 0x0a, 0x20,
 	0x54, 0x52, 0x41, 0x4e, 0x53, 0x46, 0x4f, 0x52, 0x4d, 0x45, 0x52, 0x5f, 0x53, 0x4f, 0x55, 0x52,
-	0x43, 0x45, 0x5f, 0x47, 0x45, 0x4e, 0x45, 0x52, 0x41, 0x54, 0x45, 0x5f, 0x55, 0x4e, 0x49, 0x58,
-	0x54, 0x49, 0x4d, 0x45, 0x53, 0x54, 0x41, 0x4d, 0x50, 0x10, 0x1a, 0x12, 0x28, 0x0a, 0x24, 0x54,
+	0x43, 0x45, 0x5f, 0x47, 0x45, 0x4e, 0x45, 0x52, 0x41, 0x54, 0x45, 0x5f, 0x55, 0x55, 0x49, 0x44,
+	0x10, 0x1d, 0x12, 0x27, 0x0a, 0x23, 0x54, 0x52, 0x41, 0x4e, 0x53, 0x46, 0x4f, 0x52, 0x4d, 0x45,
+	0x52, 0x5f, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x5f, 0x47, 0x45, 0x4e, 0x45, 0x52, 0x41, 0x54,
+	0x45, 0x5f, 0x5a, 0x49, 0x50, 0x43, 0x4f, 0x44, 0x45, 0x10, 0x1e, 0x12, 0x32, 0x0a, 0x2e, 0x54,
 	0x52, 0x41, 0x4e, 0x53, 0x46, 0x4f, 0x52, 0x4d, 0x45, 0x52, 0x5f, 0x53, 0x4f, 0x55, 0x52, 0x43,
-	0x45, 0x5f, 0x47, 0x45, 0x4e, 0x45, 0x52, 0x41, 0x54, 0x45, 0x5f, 0x55, 0x53, 0x45, 0x52, 0x4e,
-	0x41, 0x4d, 0x45, 0x10, 0x1b, 0x12, 0x2c, 0x0a, 0x28, 0x54, 0x52, 0x41, 0x4e, 0x53, 0x46, 0x4f,
-	0x52, 0x4d, 0x45, 0x52, 0x5f, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x5f, 0x47, 0x45, 0x4e, 0x45,
-	0x52, 0x41, 0x54, 0x45, 0x5f, 0x55, 0x54, 0x43, 0x54, 0x49, 0x4d, 0x45, 0x53, 0x54, 0x41, 0x4d,
-	0x50, 0x10, 0x1c, 0x12, 0x24, 0x0a, 0x20, 0x54, 0x52, 0x41, 0x4e, 0x53, 0x46, 0x4f, 0x52, 0x4d,
-	0x45, 0x52, 0x5f, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x5f, 0x47, 0x45, 0x4e, 0x45, 0x52, 0x41,
-	0x54, 0x45, 0x5f, 0x55, 0x55, 0x49, 0x44, 0x10, 0x1d, 0x12, 0x27, 0x0a, 0x23, 0x54, 0x52, 0x41,
-	0x4e, 0x53, 0x46, 0x4f, 0x52, 0x4d, 0x45, 0x52, 0x5f, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x5f,
-	0x47, 0x45, 0x4e, 0x45, 0x52, 0x41, 0x54, 0x45, 0x5f, 0x5a, 0x49, 0x50, 0x43, 0x4f, 0x44, 0x45,
-	0x10, 0x1e, 0x12, 0x32, 0x0a, 0x2e, 0x54, 0x52, 0x41, 0x4e, 0x53, 0x46, 0x4f, 0x52, 0x4d, 0x45,
-	0x52, 0x5f, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x5f, 0x54, 0x52, 0x41, 0x4e, 0x53, 0x46, 0x4f,
-	0x52, 0x4d, 0x5f, 0x45, 0x31, 0x36, 0x34, 0x5f, 0x50, 0x48, 0x4f, 0x4e, 0x45, 0x5f, 0x4e, 0x55,
-	0x4d, 0x42, 0x45, 0x52, 0x10, 0x1f, 0x12, 0x2b, 0x0a, 0x27, 0x54, 0x52, 0x41, 0x4e, 0x53, 0x46,
+	0x45, 0x5f, 0x54, 0x52, 0x41, 0x4e, 0x53, 0x46, 0x4f, 0x52, 0x4d, 0x5f, 0x45, 0x31, 0x36, 0x34,
+	0x5f, 0x50, 0x48, 0x4f, 0x4e, 0x45, 0x5f, 0x4e, 0x55, 0x4d, 0x42, 0x45, 0x52, 0x10, 0x1f, 0x12,
+	0x2b, 0x0a, 0x27, 0x54, 0x52, 0x41, 0x4e, 0x53, 0x46, 0x4f, 0x52, 0x4d, 0x45, 0x52, 0x5f, 0x53,
+	0x4f, 0x55, 0x52, 0x43, 0x45, 0x5f, 0x54, 0x52, 0x41, 0x4e, 0x53, 0x46, 0x4f, 0x52, 0x4d, 0x5f,
+	0x46, 0x49, 0x52, 0x53, 0x54, 0x5f, 0x4e, 0x41, 0x4d, 0x45, 0x10, 0x20, 0x12, 0x28, 0x0a, 0x24,
+	0x54, 0x52, 0x41, 0x4e, 0x53, 0x46, 0x4f, 0x52, 0x4d, 0x45, 0x52, 0x5f, 0x53, 0x4f, 0x55, 0x52,
+	0x43, 0x45, 0x5f, 0x54, 0x52, 0x41, 0x4e, 0x53, 0x46, 0x4f, 0x52, 0x4d, 0x5f, 0x46, 0x4c, 0x4f,
+	0x41, 0x54, 0x36, 0x34, 0x10, 0x21, 0x12, 0x2a, 0x0a, 0x26, 0x54, 0x52, 0x41, 0x4e, 0x53, 0x46,
 	0x4f, 0x52, 0x4d, 0x45, 0x52, 0x5f, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x5f, 0x54, 0x52, 0x41,
-	0x4e, 0x53, 0x46, 0x4f, 0x52, 0x4d, 0x5f, 0x46, 0x49, 0x52, 0x53, 0x54, 0x5f, 0x4e, 0x41, 0x4d,
-	0x45, 0x10, 0x20, 0x12, 0x28, 0x0a, 0x24, 0x54, 0x52, 0x41, 0x4e, 0x53, 0x46, 0x4f, 0x52, 0x4d,
-	0x45, 0x52, 0x5f, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x5f, 0x54, 0x52, 0x41, 0x4e, 0x53, 0x46,
-	0x4f, 0x52, 0x4d, 0x5f, 0x46, 0x4c, 0x4f, 0x41, 0x54, 0x36, 0x34, 0x10, 0x21, 0x12, 0x2a, 0x0a,
-	0x26, 0x54, 0x52, 0x41, 0x4e, 0x53, 0x46, 0x4f, 0x52, 0x4d, 0x45, 0x52, 0x5f, 0x53, 0x4f, 0x55,
-	0x52, 0x43, 0x45, 0x5f, 0x54, 0x52, 0x41, 0x4e, 0x53, 0x46, 0x4f, 0x52, 0x4d, 0x5f, 0x46, 0x55,
-	0x4c, 0x4c, 0x5f, 0x4e, 0x41, 0x4d, 0x45, 0x10, 0x22, 0x12, 0x33, 0x0a, 0x2f, 0x54, 0x52, 0x41,
+	0x4e, 0x53, 0x46, 0x4f, 0x52, 0x4d, 0x5f, 0x46, 0x55, 0x4c, 0x4c, 0x5f, 0x4e, 0x41, 0x4d, 0x45,
+	0x10, 0x22, 0x12, 0x33, 0x0a, 0x2f, 0x54, 0x52, 0x41, 0x4e, 0x53, 0x46, 0x4f, 0x52, 0x4d, 0x45,
+	0x52, 0x5f, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x5f, 0x54, 0x52, 0x41, 0x4e, 0x53, 0x46, 0x4f,
+	0x52, 0x4d, 0x5f, 0x49, 0x4e, 0x54, 0x36, 0x34, 0x5f, 0x50, 0x48, 0x4f, 0x4e, 0x45, 0x5f, 0x4e,
+	0x55, 0x4d, 0x42, 0x45, 0x52, 0x10, 0x23, 0x12, 0x26, 0x0a, 0x22, 0x54, 0x52, 0x41, 0x4e, 0x53,
+	0x46, 0x4f, 0x52, 0x4d, 0x45, 0x52, 0x5f, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x5f, 0x54, 0x52,
+	0x41, 0x4e, 0x53, 0x46, 0x4f, 0x52, 0x4d, 0x5f, 0x49, 0x4e, 0x54, 0x36, 0x34, 0x10, 0x24, 0x12,
+	0x2a, 0x0a, 0x26, 0x54, 0x52, 0x41, 0x4e, 0x53, 0x46, 0x4f, 0x52, 0x4d, 0x45, 0x52, 0x5f, 0x53,
+	0x4f, 0x55, 0x52, 0x43, 0x45, 0x5f, 0x54, 0x52, 0x41, 0x4e, 0x53, 0x46, 0x4f, 0x52, 0x4d, 0x5f,
+	0x4c, 0x41, 0x53, 0x54, 0x5f, 0x4e, 0x41, 0x4d, 0x45, 0x10, 0x25, 0x12, 0x2d, 0x0a, 0x29, 0x54,
+	0x52, 0x41, 0x4e, 0x53, 0x46, 0x4f, 0x52, 0x4d, 0x45, 0x52, 0x5f, 0x53, 0x4f, 0x55, 0x52, 0x43,
+	0x45, 0x5f, 0x54, 0x52, 0x41, 0x4e, 0x53, 0x46, 0x4f, 0x52, 0x4d, 0x5f, 0x50, 0x48, 0x4f, 0x4e,
+	0x45, 0x5f, 0x4e, 0x55, 0x4d, 0x42, 0x45, 0x52, 0x10, 0x26, 0x12, 0x27, 0x0a, 0x23, 0x54, 0x52,
+	0x41, 0x4e, 0x53, 0x46, 0x4f, 0x52, 0x4d, 0x45, 0x52, 0x5f, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45,
+	0x5f, 0x54, 0x52, 0x41, 0x4e, 0x53, 0x46, 0x4f, 0x52, 0x4d, 0x5f, 0x53, 0x54, 0x52, 0x49, 0x4e,
+	0x47, 0x10, 0x27, 0x12, 0x24, 0x0a, 0x20, 0x54, 0x52, 0x41, 0x4e, 0x53, 0x46, 0x4f, 0x52, 0x4d,
+	0x45, 0x52, 0x5f, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x5f, 0x47, 0x45, 0x4e, 0x45, 0x52, 0x41,
+	0x54, 0x45, 0x5f, 0x4e, 0x55, 0x4c, 0x4c, 0x10, 0x28, 0x12, 0x2b, 0x0a, 0x27, 0x54, 0x52, 0x41,
 	0x4e, 0x53, 0x46, 0x4f, 0x52, 0x4d, 0x45, 0x52, 0x5f, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x5f,
-	0x54, 0x52, 0x41, 0x4e, 0x53, 0x46, 0x4f, 0x52, 0x4d, 0x5f, 0x49, 0x4e, 0x54, 0x36, 0x34, 0x5f,
-	0x50, 0x48, 0x4f, 0x4e, 0x45, 0x5f, 0x4e, 0x55, 0x4d, 0x42, 0x45, 0x52, 0x10, 0x23, 0x12, 0x26,
-	0x0a, 0x22, 0x54, 0x52, 0x41, 0x4e, 0x53, 0x46, 0x4f, 0x52, 0x4d, 0x45, 0x52, 0x5f, 0x53, 0x4f,
-	0x55, 0x52, 0x43, 0x45, 0x5f, 0x54, 0x52, 0x41, 0x4e, 0x53, 0x46, 0x4f, 0x52, 0x4d, 0x5f, 0x49,
-	0x4e, 0x54, 0x36, 0x34, 0x10, 0x24, 0x12, 0x2a, 0x0a, 0x26, 0x54, 0x52, 0x41, 0x4e, 0x53, 0x46,
+	0x47, 0x45, 0x4e, 0x45, 0x52, 0x41, 0x54, 0x45, 0x5f, 0x43, 0x41, 0x54, 0x45, 0x47, 0x4f, 0x52,
+	0x49, 0x43, 0x41, 0x4c, 0x10, 0x2a, 0x12, 0x33, 0x0a, 0x2f, 0x54, 0x52, 0x41, 0x4e, 0x53, 0x46,
 	0x4f, 0x52, 0x4d, 0x45, 0x52, 0x5f, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x5f, 0x54, 0x52, 0x41,
-	0x4e, 0x53, 0x46, 0x4f, 0x52, 0x4d, 0x5f, 0x4c, 0x41, 0x53, 0x54, 0x5f, 0x4e, 0x41, 0x4d, 0x45,
-	0x10, 0x25, 0x12, 0x2d, 0x0a, 0x29, 0x54, 0x52, 0x41, 0x4e, 0x53, 0x46, 0x4f, 0x52, 0x4d, 0x45,
+	0x4e, 0x53, 0x46, 0x4f, 0x52, 0x4d, 0x5f, 0x43, 0x48, 0x41, 0x52, 0x41, 0x43, 0x54, 0x45, 0x52,
+	0x5f, 0x53, 0x43, 0x52, 0x41, 0x4d, 0x42, 0x4c, 0x45, 0x10, 0x2b, 0x12, 0x23, 0x0a, 0x1f, 0x54,
+	0x52, 0x41, 0x4e, 0x53, 0x46, 0x4f, 0x52, 0x4d, 0x45, 0x52, 0x5f, 0x53, 0x4f, 0x55, 0x52, 0x43,
+	0x45, 0x5f, 0x55, 0x53, 0x45, 0x52, 0x5f, 0x44, 0x45, 0x46, 0x49, 0x4e, 0x45, 0x44, 0x10, 0x2c,
+	0x12, 0x2a, 0x0a, 0x26, 0x54, 0x52, 0x41, 0x4e, 0x53, 0x46, 0x4f, 0x52, 0x4d, 0x45, 0x52, 0x5f,
+	0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x5f, 0x47, 0x45, 0x4e, 0x45, 0x52, 0x41, 0x54, 0x45, 0x5f,
+	0x4a, 0x41, 0x56, 0x41, 0x53, 0x43, 0x52, 0x49, 0x50, 0x54, 0x10, 0x2d, 0x12, 0x3a, 0x0a, 0x36,
+	0x54, 0x52, 0x41, 0x4e, 0x53, 0x46, 0x4f, 0x52, 0x4d, 0x45, 0x52, 0x5f, 0x53, 0x4f, 0x55, 0x52,
+	0x43, 0x45, 0x5f, 0x54, 0x52, 0x41, 0x4e, 0x53, 0x46, 0x4f, 0x52, 0x4d, 0x5f, 0x46, 0x4f, 0x52,
+	0x4d, 0x41, 0x54, 0x5f, 0x50, 0x52, 0x45, 0x53, 0x45, 0x52, 0x56, 0x49, 0x4e, 0x47, 0x5f, 0x45,
+	0x4e, 0x43, 0x52, 0x59, 0x50, 0x54, 0x10, 0x2e, 0x12, 0x2a, 0x0a, 0x26, 0x54, 0x52, 0x41, 0x4e,
+	0x53, 0x46, 0x4f, 0x52, 0x4d, 0x45, 0x52, 0x5f, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x5f, 0x54,
+	0x52, 0x41, 0x4e, 0x53, 0x46, 0x4f, 0x52, 0x4d, 0x5f, 0x54, 0x49, 0x4d, 0x45, 0x53, 0x54, 0x41,
+	0x4d, 0x50, 0x10, 0x2f, 0x12, 0x25, 0x0a, 0x21, 0x54, 0x52, 0x41, 0x4e, 0x53, 0x46, 0x4f, 0x52,
+	0x4d, 0x45, 0x52, 0x5f, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x5f, 0x54, 0x52, 0x41, 0x4e, 0x53,
+	0x46, 0x4f, 0x52, 0x4d, 0x5f, 0x44, 0x41, 0x54, 0x45, 0x10, 0x30, 0x12, 0x25, 0x0a, 0x21, 0x54,
+	0x52, 0x41, 0x4e, 0x53, 0x46, 0x4f, 0x52, 0x4d, 0x45, 0x52, 0x5f, 0x53, 0x4f, 0x55, 0x52, 0x43,
+	0x45, 0x5f, 0x54, 0x52, 0x41, 0x4e, 0x53, 0x46, 0x4f, 0x52, 0x4d, 0x5f, 0x4a, 0x53, 0x4f, 0x4e,
+	0x10, 0x31, 0x12, 0x2a, 0x0a, 0x26, 0x54, 0x52, 0x41, 0x4e, 0x53, 0x46, 0x4f, 0x52, 0x4d, 0x45,
 	0x52, 0x5f, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x5f, 0x54, 0x52, 0x41, 0x4e, 0x53, 0x46, 0x4f,
-	0x52, 0x4d, 0x5f, 0x50, 0x48, 0x4f, 0x4e, 0x45, 0x5f, 0x4e, 0x55, 0x4d, 0x42, 0x45, 0x52, 0x10,
-	0x26, 0x12, 0x27, 0x0a, 0x23, 0x54, 0x52, 0x41, 0x4e, 0x53, 0x46, 0x4f, 0x52, 0x4d, 0x45, 0x52,
-	0x5f, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x5f, 0x54, 0x52, 0x41, 0x4e, 0x53, 0x46, 0x4f, 0x52,
-	0x4d, 0x5f, 0x53, 0x54, 0x52, 0x49, 0x4e, 0x47, 0x10, 0x27, 0x12, 0x24, 0x0a, 0x20, 0x54, 0x52,
+	0x52, 0x4d, 0x5f, 0x46, 0x52, 0x45, 0x45, 0x5f, 0x54, 0x45, 0x58, 0x54, 0x10, 0x32, 0x12, 0x2a,
+	0x0a, 0x26, 0x54, 0x52, 0x41, 0x4e, 0x53, 0x46, 0x4f, 0x52, 0x4d, 0x45, 0x52, 0x5f, 0x53, 0x4f,
+	0x55, 0x52, 0x43, 0x45, 0x5f, 0x47, 0x45, 0x4e, 0x45, 0x52, 0x41, 0x54, 0x45, 0x5f, 0x49, 0x50,
+	0x5f, 0x41, 0x44, 0x44, 0x52, 0x45, 0x53, 0x53, 0x10, 0x33, 0x12, 0x2b, 0x0a, 0x27, 0x54, 0x52,
 	0x41, 0x4e, 0x53, 0x46, 0x4f, 0x52, 0x4d, 0x45, 0x52, 0x5f, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45,
-	0x5f, 0x47, 0x45, 0x4e, 0x45, 0x52, 0x41, 0x54, 0x45, 0x5f, 0x4e, 0x55, 0x4c, 0x4c, 0x10, 0x28,
-	0x12, 0x2b, 0x0a, 0x27, 0x54, 0x52, 0x41, 0x4e, 0x53, 0x46, 0x4f, 0x52, 0x4d, 0x45, 0x52, 0x5f,
-	0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x5f, 0x47, 0x45, 0x4e, 0x45, 0x52, 0x41, 0x54, 0x45, 0x5f,
-	0x43, 0x41, 0x54, 0x45, 0x47, 0x4f, 0x52, 0x49, 0x43, 0x41, 0x4c, 0x10, 0x2a, 0x12, 0x33, 0x0a,
-	0x2f, 0x54, 0x52, 0x41, 0x4e, 0x53, 0x46, 0x4f, 0x52, 0x4d, 0x45, 0x52, 0x5f, 0x53, 0x4f, 0x55,
-	0x52, 0x43, 0x45, 0x5f, 0x54, 0x52, 0x41, 0x4e, 0x53, 0x46, 0x4f, 0x52, 0x4d, 0x5f, 0x43, 0x48,
-	0x41, 0x52, 0x41, 0x43, 0x54, 0x45, 0x52, 0x5f, 0x53, 0x43, 0x52, 0x41, 0x4d, 0x42, 0x4c, 0x45,
-	0x10, 0x2b, 0x12, 0x23, 0x0a, 0x1f, 0x54, 0x52, 0x41, 0x4e, 0x53, 0x46, 0x4f, 0x52, 0x4d, 0x45,
-	0x52, 0x5f, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x5f, 0x55, 0x53, 0x45, 0x52, 0x5f, 0x44, 0x45,
-	0x46, 0x49, 0x4e, 0x45, 0x44, 0x10, 0x2c, 0x12, 0x2a, 0x0a, 0x26, 0x54, 0x52, 0x41, 0x4e, 0x53,
+	0x5f, 0x54, 0x52, 0x41, 0x4e, 0x53, 0x46, 0x4f, 0x52, 0x4d, 0x5f, 0x49, 0x50, 0x5f, 0x41, 0x44,
+	0x44, 0x52, 0x45, 0x53, 0x53, 0x10, 0x34, 0x12, 0x2b, 0x0a, 0x27, 0x54, 0x52, 0x41, 0x4e, 0x53,
 	0x46, 0x4f, 0x52, 0x4d, 0x45, 0x52, 0x5f, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x5f, 0x47, 0x45,
-	0x4e, 0x45, 0x52, 0x41, 0x54, 0x45, 0x5f, 0x4a, 0x41, 0x56, 0x41, 0x53, 0x43, 0x52, 0x49, 0x50,
-	0x54, 0x10, 0x2d, 0x2a, 0xc4, 0x02, 0x0a, 0x13, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72,
-	0x6d, 0x65, 0x72, 0x44, 0x61, 0x74, 0x61, 0x54, 0x79, 0x70, 0x65, 0x12, 0x25, 0x0a, 0x21, 0x54,
-	0x52, 0x41, 0x4e, 0x53, 0x46, 0x4f, 0x52, 0x4d, 0x45, 0x52, 0x5f, 0x44, 0x41, 0x54, 0x41, 0x5f,
-	0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44,
-	0x10, 0x00, 0x12, 0x20, 0x0a, 0x1c, 0x54, 0x52, 0x41, 0x4e, 0x53, 0x46, 0x4f, 0x52, 0x4d, 0x45,
-	0x52, 0x5f, 0x44, 0x41, 0x54, 0x41, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x53, 0x54, 0x52, 0x49,
-	0x4e, 0x47, 0x10, 0x01, 0x12, 0x1f, 0x0a, 0x1b, 0x54, 0x52, 0x41, 0x4e, 0x53, 0x46, 0x4f, 0x52,
-	0x4d, 0x45, 0x52, 0x5f, 0x44, 0x41, 0x54, 0x41, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x49, 0x4e,
-	0x54, 0x36, 0x34, 0x10, 0x02, 0x12, 0x21, 0x0a, 0x1d, 0x54, 0x52, 0x41, 0x4e, 0x53, 0x46, 0x4f,
-	0x52, 0x4d, 0x45, 0x52, 0x5f, 0x44, 0x41, 0x54, 0x41, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x42,
-	0x4f, 0x4f, 0x4c, 0x45, 0x41, 0x4e, 0x10, 0x03, 0x12, 0x21, 0x0a, 0x1d, 0x54, 0x52, 0x41, 0x4e,
-	0x53, 0x46, 0x4f, 0x52, 0x4d, 0x45, 0x52, 0x5f, 0x44, 0x41, 0x54, 0x41, 0x5f, 0x54, 0x59, 0x50,
-	0x45, 0x5f, 0x46, 0x4c, 0x4f, 0x41, 0x54, 0x36, 0x34, 0x10, 0x04, 0x12, 0x1e, 0x0a, 0x1a, 0x54,
-	0x52, 0x41, 0x4e, 0x53, 0x46, 0x4f, 0x52, 0x4d, 0x45, 0x52, 0x5f, 0x44, 0x41, 0x54, 0x41, 0x5f,
-	0x54, 0x59, 0x50, 0x45, 0x5f, 0x4e, 0x55, 0x4c, 0x4c, 0x10, 0x05, 0x12, 0x1d, 0x0a, 0x19, 0x54,
-	0x52, 0x41, 0x4e, 0x53, 0x46, 0x4f, 0x52, 0x4d, 0x45, 0x52, 0x5f, 0x44, 0x41, 0x54, 0x41, 0x5f,
-	0x54, 0x59, 0x50, 0x45, 0x5f, 0x41, 0x4e, 0x59, 0x10, 0x06, 0x12, 0x1e, 0x0a, 0x1a, 0x54, 0x52,
-	0x41, 0x4e, 0x53, 0x46, 0x4f, 0x52, 0x4d, 0x45, 0x52, 0x5f, 0x44, 0x41, 0x54, 0x41, 0x5f, 0x54,
-	0x59, 0x50, 0x45, 0x5f, 0x54, 0x49, 0x4d, 0x45, 0x10, 0x07, 0x12, 0x1e, 0x0a, 0x1a, 0x54, 0x52,
-	0x41, 0x4e, 0x53, 0x46, 0x4f, 0x52, 0x4d, 0x45, 0x52, 0x5f, 0x44, 0x41, 0x54, 0x41, 0x5f, 0x54,
-	0x59, 0x50, 0x45, 0x5f, 0x55, 0x55, 0x49, 0x44, 0x10, 0x08, 0x32, 0xd2, 0x0a, 0x0a, 0x13, 0x54,
-	0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x65, 0x72, 0x73, 0x53, 0x65, 0x72, 0x76, 0x69,
-	0x63, 0x65, 0x12, 0x74, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x54,
-	0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x65, 0x72, 0x73, 0x12, 0x2b, 0x2e, 0x6d, 0x67,
+	0x4e, 0x45, 0x52, 0x41, 0x54, 0x45, 0x5f, 0x4d, 0x41, 0x43, 0x5f, 0x41, 0x44, 0x44, 0x52, 0x45,
+	0x53, 0x53, 0x10, 0x35, 0x12, 0x2c, 0x0a, 0x28, 0x54, 0x52, 0x41, 0x4e, 0x53, 0x46, 0x4f, 0x52,
+	0x4d, 0x45, 0x52, 0x5f, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x5f, 0x54, 0x52, 0x41, 0x4e, 0x53,
+	0x46, 0x4f, 0x52, 0x4d, 0x5f, 0x4d, 0x41, 0x43, 0x5f, 0x41, 0x44, 0x44, 0x52, 0x45, 0x53, 0x53,
+	0x10, 0x36, 0x12, 0x23, 0x0a, 0x1f, 0x54, 0x52, 0x41, 0x4e, 0x53, 0x46, 0x4f, 0x52, 0x4d, 0x45,
+	0x52, 0x5f, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x5f, 0x47, 0x45, 0x4e, 0x45, 0x52, 0x41, 0x54,
+	0x45, 0x5f, 0x55, 0x52, 0x4c, 0x10, 0x37, 0x12, 0x24, 0x0a, 0x20, 0x54, 0x52, 0x41, 0x4e, 0x53,
+	0x46, 0x4f, 0x52, 0x4d, 0x45, 0x52, 0x5f, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x5f, 0x54, 0x52,
+	0x41, 0x4e, 0x53, 0x46, 0x4f, 0x52, 0x4d, 0x5f, 0x55, 0x52, 0x4c, 0x10, 0x38, 0x12, 0x26, 0x0a,
+	0x22, 0x54, 0x52, 0x41, 0x4e, 0x53, 0x46, 0x4f, 0x52, 0x4d, 0x45, 0x52, 0x5f, 0x53, 0x4f, 0x55,
+	0x52, 0x43, 0x45, 0x5f, 0x47, 0x45, 0x4e, 0x45, 0x52, 0x41, 0x54, 0x45, 0x5f, 0x44, 0x4f, 0x4d,
+	0x41, 0x49, 0x4e, 0x10, 0x39, 0x12, 0x27, 0x0a, 0x23, 0x54, 0x52, 0x41, 0x4e, 0x53, 0x46, 0x4f,
+	0x52, 0x4d, 0x45, 0x52, 0x5f, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x5f, 0x54, 0x52, 0x41, 0x4e,
+	0x53, 0x46, 0x4f, 0x52, 0x4d, 0x5f, 0x44, 0x4f, 0x4d, 0x41, 0x49, 0x4e, 0x10, 0x3a, 0x2a, 0xc4,
+	0x02, 0x0a, 0x13, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x65, 0x72, 0x44, 0x61,
+	0x74, 0x61, 0x54, 0x79, 0x70, 0x65, 0x12, 0x25, 0x0a, 0x21, 0x54, 0x52, 0x41, 0x4e, 0x53, 0x46,
+	0x4f, 0x52, 0x4d, 0x45, 0x52, 0x5f, 0x44, 0x41, 0x54, 0x41, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f,
+	0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x20, 0x0a,
+	0x1c, 0x54, 0x52, 0x41, 0x4e, 0x53, 0x46, 0x4f, 0x52, 0x4d, 0x45, 0x52, 0x5f, 0x44, 0x41, 0x54,
+	0x41, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x53, 0x54, 0x52, 0x49, 0x4e, 0x47, 0x10, 0x01, 0x12,
+	0x1f, 0x0a, 0x1b, 0x54, 0x52, 0x41, 0x4e, 0x53, 0x46, 0x4f, 0x52, 0x4d, 0x45, 0x52, 0x5f, 0x44,
+	0x41, 0x54, 0x41, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x49, 0x4e, 0x54, 0x36, 0x34, 0x10, 0x02,
+	0x12, 0x21, 0x0a, 0x1d, 0x54, 0x52, 0x41, 0x4e, 0x53, 0x46, 0x4f, 0x52, 0x4d, 0x45, 0x52, 0x5f,
+	0x44, 0x41, 0x54, 0x41, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x42, 0x4f, 0x4f, 0x4c, 0x45, 0x41,
+	0x4e, 0x10, 0x03, 0x12, 0x21, 0x0a, 0x1d, 0x54, 0x52, 0x41, 0x4e, 0x53, 0x46, 0x4f, 0x52, 0x4d,
+	0x45, 0x52, 0x5f, 0x44, 0x41, 0x54, 0x41, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x46, 0x4c, 0x4f,
+	0x41, 0x54, 0x36, 0x34, 0x10, 0x04, 0x12, 0x1e, 0x0a, 0x1a, 0x54, 0x52, 0x41, 0x4e, 0x53, 0x46,
+	0x4f, 0x52, 0x4d, 0x45, 0x52, 0x5f, 0x44, 0x41, 0x54, 0x41, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f,
+	0x4e, 0x55, 0x4c, 0x4c, 0x10, 0x05, 0x12, 0x1d, 0x0a, 0x19, 0x54, 0x52, 0x41, 0x4e, 0x53, 0x46,
+	0x4f, 0x52, 0x4d, 0x45, 0x52, 0x5f, 0x44, 0x41, 0x54, 0x41, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f,
+	0x41, 0x4e, 0x59, 0x10, 0x06, 0x12, 0x1e, 0x0a, 0x1a, 0x54, 0x52, 0x41, 0x4e, 0x53, 0x46, 0x4f,
+	0x52, 0x4d, 0x45, 0x52, 0x5f, 0x44, 0x41, 0x54, 0x41, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x54,
+	0x49, 0x4d, 0x45, 0x10, 0x07, 0x12, 0x1e, 0x0a, 0x1a, 0x54, 0x52, 0x41, 0x4e, 0x53, 0x46, 0x4f,
+	0x52, 0x4d, 0x45, 0x52, 0x5f, 0x44, 0x41, 0x54, 0x41, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55,
+	0x55, 0x49, 0x44, 0x10, 0x08, 0x2a, 0xbf, 0x01, 0x0a, 0x23, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74,
+	0x50, 0x72, 0x65, 0x73, 0x65, 0x72, 0x76, 0x69, 0x6e, 0x67, 0x45, 0x6e, 0x63, 0x72, 0x79, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x41, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x12, 0x36, 0x0a,
+	0x32, 0x46, 0x4f, 0x52, 0x4d, 0x41, 0x54, 0x5f, 0x50, 0x52, 0x45, 0x53, 0x45, 0x52, 0x56, 0x49,
+	0x4e, 0x47, 0x5f, 0x45, 0x4e, 0x43, 0x52, 0x59, 0x50, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x41, 0x4c,
+	0x47, 0x4f, 0x52, 0x49, 0x54, 0x48, 0x4d, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46,
+	0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x2e, 0x0a, 0x2a, 0x46, 0x4f, 0x52, 0x4d, 0x41, 0x54, 0x5f,
+	0x50, 0x52, 0x45, 0x53, 0x45, 0x52, 0x56, 0x49, 0x4e, 0x47, 0x5f, 0x45, 0x4e, 0x43, 0x52, 0x59,
+	0x50, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x41, 0x4c, 0x47, 0x4f, 0x52, 0x49, 0x54, 0x48, 0x4d, 0x5f,
+	0x46, 0x46, 0x31, 0x10, 0x01, 0x12, 0x30, 0x0a, 0x2c, 0x46, 0x4f, 0x52, 0x4d, 0x41, 0x54, 0x5f,
+	0x50, 0x52, 0x45, 0x53, 0x45, 0x52, 0x56, 0x49, 0x4e, 0x47, 0x5f, 0x45, 0x4e, 0x43, 0x52, 0x59,
+	0x50, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x41, 0x4c, 0x47, 0x4f, 0x52, 0x49, 0x54, 0x48, 0x4d, 0x5f,
+	0x46, 0x46, 0x33, 0x5f, 0x31, 0x10, 0x02, 0x2a, 0xb8, 0x01, 0x0a, 0x11, 0x54, 0x69, 0x6d, 0x65,
+	0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x23, 0x0a,
+	0x1f, 0x54, 0x49, 0x4d, 0x45, 0x5f, 0x54, 0x52, 0x41, 0x4e, 0x53, 0x46, 0x4f, 0x52, 0x4d, 0x5f,
+	0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44,
+	0x10, 0x00, 0x12, 0x1d, 0x0a, 0x19, 0x54, 0x49, 0x4d, 0x45, 0x5f, 0x54, 0x52, 0x41, 0x4e, 0x53,
+	0x46, 0x4f, 0x52, 0x4d, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x53, 0x48, 0x49, 0x46, 0x54, 0x10,
+	0x01, 0x12, 0x1e, 0x0a, 0x1a, 0x54, 0x49, 0x4d, 0x45, 0x5f, 0x54, 0x52, 0x41, 0x4e, 0x53, 0x46,
+	0x4f, 0x52, 0x4d, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x4a, 0x49, 0x54, 0x54, 0x45, 0x52, 0x10,
+	0x02, 0x12, 0x20, 0x0a, 0x1c, 0x54, 0x49, 0x4d, 0x45, 0x5f, 0x54, 0x52, 0x41, 0x4e, 0x53, 0x46,
+	0x4f, 0x52, 0x4d, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x54, 0x52, 0x55, 0x4e, 0x43, 0x41, 0x54,
+	0x45, 0x10, 0x03, 0x12, 0x1d, 0x0a, 0x19, 0x54, 0x49, 0x4d, 0x45, 0x5f, 0x54, 0x52, 0x41, 0x4e,
+	0x53, 0x46, 0x4f, 0x52, 0x4d, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x43, 0x4c, 0x41, 0x4d, 0x50,
+	0x10, 0x04, 0x2a, 0x85, 0x01, 0x0a, 0x15, 0x54, 0x69, 0x6d, 0x65, 0x54, 0x72, 0x75, 0x6e, 0x63,
+	0x61, 0x74, 0x65, 0x50, 0x72, 0x65, 0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x27, 0x0a, 0x23,
+	0x54, 0x49, 0x4d, 0x45, 0x5f, 0x54, 0x52, 0x55, 0x4e, 0x43, 0x41, 0x54, 0x45, 0x5f, 0x50, 0x52,
+	0x45, 0x43, 0x49, 0x53, 0x49, 0x4f, 0x4e, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46,
+	0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x21, 0x0a, 0x1d, 0x54, 0x49, 0x4d, 0x45, 0x5f, 0x54, 0x52,
+	0x55, 0x4e, 0x43, 0x41, 0x54, 0x45, 0x5f, 0x50, 0x52, 0x45, 0x43, 0x49, 0x53, 0x49, 0x4f, 0x4e,
+	0x5f, 0x4d, 0x4f, 0x4e, 0x54, 0x48, 0x10, 0x01, 0x12, 0x20, 0x0a, 0x1c, 0x54, 0x49, 0x4d, 0x45,
+	0x5f, 0x54, 0x52, 0x55, 0x4e, 0x43, 0x41, 0x54, 0x45, 0x5f, 0x50, 0x52, 0x45, 0x43, 0x49, 0x53,
+	0x49, 0x4f, 0x4e, 0x5f, 0x59, 0x45, 0x41, 0x52, 0x10, 0x02, 0x2a, 0xcb, 0x01, 0x0a, 0x12, 0x46,
+	0x72, 0x65, 0x65, 0x54, 0x65, 0x78, 0x74, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x54, 0x79, 0x70,
+	0x65, 0x12, 0x25, 0x0a, 0x21, 0x46, 0x52, 0x45, 0x45, 0x5f, 0x54, 0x45, 0x58, 0x54, 0x5f, 0x45,
+	0x4e, 0x54, 0x49, 0x54, 0x59, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45,
+	0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1f, 0x0a, 0x1b, 0x46, 0x52, 0x45, 0x45,
+	0x5f, 0x54, 0x45, 0x58, 0x54, 0x5f, 0x45, 0x4e, 0x54, 0x49, 0x54, 0x59, 0x5f, 0x54, 0x59, 0x50,
+	0x45, 0x5f, 0x45, 0x4d, 0x41, 0x49, 0x4c, 0x10, 0x01, 0x12, 0x26, 0x0a, 0x22, 0x46, 0x52, 0x45,
+	0x45, 0x5f, 0x54, 0x45, 0x58, 0x54, 0x5f, 0x45, 0x4e, 0x54, 0x49, 0x54, 0x59, 0x5f, 0x54, 0x59,
+	0x50, 0x45, 0x5f, 0x50, 0x48, 0x4f, 0x4e, 0x45, 0x5f, 0x4e, 0x55, 0x4d, 0x42, 0x45, 0x52, 0x10,
+	0x02, 0x12, 0x25, 0x0a, 0x21, 0x46, 0x52, 0x45, 0x45, 0x5f, 0x54, 0x45, 0x58, 0x54, 0x5f, 0x45,
+	0x4e, 0x54, 0x49, 0x54, 0x59, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x43, 0x41, 0x52, 0x44, 0x5f,
+	0x4e, 0x55, 0x4d, 0x42, 0x45, 0x52, 0x10, 0x03, 0x12, 0x1e, 0x0a, 0x1a, 0x46, 0x52, 0x45, 0x45,
+	0x5f, 0x54, 0x45, 0x58, 0x54, 0x5f, 0x45, 0x4e, 0x54, 0x49, 0x54, 0x59, 0x5f, 0x54, 0x59, 0x50,
+	0x45, 0x5f, 0x4e, 0x41, 0x4d, 0x45, 0x10, 0x04, 0x2a, 0x9f, 0x01, 0x0a, 0x1b, 0x46, 0x72, 0x65,
+	0x65, 0x54, 0x65, 0x78, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74,
+	0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x12, 0x2e, 0x0a, 0x2a, 0x46, 0x52, 0x45, 0x45,
+	0x5f, 0x54, 0x45, 0x58, 0x54, 0x5f, 0x52, 0x45, 0x50, 0x4c, 0x41, 0x43, 0x45, 0x4d, 0x45, 0x4e,
+	0x54, 0x5f, 0x53, 0x54, 0x52, 0x41, 0x54, 0x45, 0x47, 0x59, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45,
+	0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x27, 0x0a, 0x23, 0x46, 0x52, 0x45, 0x45,
+	0x5f, 0x54, 0x45, 0x58, 0x54, 0x5f, 0x52, 0x45, 0x50, 0x4c, 0x41, 0x43, 0x45, 0x4d, 0x45, 0x4e,
+	0x54, 0x5f, 0x53, 0x54, 0x52, 0x41, 0x54, 0x45, 0x47, 0x59, 0x5f, 0x4d, 0x41, 0x53, 0x4b, 0x10,
+	0x01, 0x12, 0x27, 0x0a, 0x23, 0x46, 0x52, 0x45, 0x45, 0x5f, 0x54, 0x45, 0x58, 0x54, 0x5f, 0x52,
+	0x45, 0x50, 0x4c, 0x41, 0x43, 0x45, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x53, 0x54, 0x52, 0x41, 0x54,
+	0x45, 0x47, 0x59, 0x5f, 0x46, 0x41, 0x4b, 0x45, 0x10, 0x02, 0x2a, 0x6c, 0x0a, 0x10, 0x49, 0x70,
+	0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x22,
+	0x0a, 0x1e, 0x49, 0x50, 0x5f, 0x41, 0x44, 0x44, 0x52, 0x45, 0x53, 0x53, 0x5f, 0x56, 0x45, 0x52,
+	0x53, 0x49, 0x4f, 0x4e, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44,
+	0x10, 0x00, 0x12, 0x19, 0x0a, 0x15, 0x49, 0x50, 0x5f, 0x41, 0x44, 0x44, 0x52, 0x45, 0x53, 0x53,
+	0x5f, 0x56, 0x45, 0x52, 0x53, 0x49, 0x4f, 0x4e, 0x5f, 0x56, 0x34, 0x10, 0x01, 0x12, 0x19, 0x0a,
+	0x15, 0x49, 0x50, 0x5f, 0x41, 0x44, 0x44, 0x52, 0x45, 0x53, 0x53, 0x5f, 0x56, 0x45, 0x52, 0x53,
+	0x49, 0x4f, 0x4e, 0x5f, 0x56, 0x36, 0x10, 0x02, 0x32, 0xd7, 0x0c, 0x0a, 0x13, 0x54, 0x72, 0x61,
+	0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x65, 0x72, 0x73, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x12, 0x74, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x54, 0x72, 0x61,
+	0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x65, 0x72, 0x73, 0x12, 0x2b, 0x2e, 0x6d, 0x67, 0x6d, 0x74,
+	0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x79, 0x73,
+	0x74, 0x65, 0x6d, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x65, 0x72, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x76, 0x31,
+	0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d,
+	0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x89, 0x01, 0x0a, 0x1c, 0x47, 0x65, 0x74, 0x53, 0x79,
+	0x73, 0x74, 0x65, 0x6d, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x65, 0x72, 0x42,
+	0x79, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x32, 0x2e, 0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x76,
+	0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x79, 0x73, 0x74, 0x65,
+	0x6d, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x65, 0x72, 0x42, 0x79, 0x53, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x33, 0x2e, 0x6d, 0x67,
 	0x6d, 0x74, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x53,
 	0x79, 0x73, 0x74, 0x65, 0x6d, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x65, 0x72,
-	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x6d, 0x67, 0x6d, 0x74, 0x2e,
-	0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x79, 0x73, 0x74,
-	0x65, 0x6d, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x65, 0x72, 0x73, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x89, 0x01, 0x0a, 0x1c, 0x47, 0x65, 0x74,
-	0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x65,
-	0x72, 0x42, 0x79, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x32, 0x2e, 0x6d, 0x67, 0x6d, 0x74,
-	0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x79, 0x73,
-	0x74, 0x65, 0x6d, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x65, 0x72, 0x42, 0x79,
-	0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x33, 0x2e,
-	0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x47, 0x65,
-	0x74, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d,
-	0x65, 0x72, 0x42, 0x79, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x22, 0x00, 0x12, 0x83, 0x01, 0x0a, 0x1a, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72,
-	0x44, 0x65, 0x66, 0x69, 0x6e, 0x65, 0x64, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d,
-	0x65, 0x72, 0x73, 0x12, 0x30, 0x2e, 0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70,
+	0x42, 0x79, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x00, 0x12, 0x83, 0x01, 0x0a, 0x1a, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x44, 0x65,
+	0x66, 0x69, 0x6e, 0x65, 0x64, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x65, 0x72,
+	0x73, 0x12, 0x30, 0x2e, 0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61,
+	0x31, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x44, 0x65, 0x66, 0x69, 0x6e, 0x65, 0x64,
+	0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x31, 0x2e, 0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70,
 	0x68, 0x61, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x44, 0x65, 0x66, 0x69, 0x6e,
 	0x65, 0x64, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x65, 0x72, 0x73, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x31, 0x2e, 0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x76, 0x31, 0x61,
-	0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x44, 0x65, 0x66,
-	0x69, 0x6e, 0x65, 0x64, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x65, 0x72, 0x73,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x8c, 0x01, 0x0a, 0x1d, 0x47,
-	0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x44, 0x65, 0x66, 0x69, 0x6e, 0x65, 0x64, 0x54, 0x72, 0x61,
-	0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x65, 0x72, 0x42, 0x79, 0x49, 0x64, 0x12, 0x33, 0x2e, 0x6d,
-	0x67, 0x6d, 0x74, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x47, 0x65, 0x74,
-	0x55, 0x73, 0x65, 0x72, 0x44, 0x65, 0x66, 0x69, 0x6e, 0x65, 0x64, 0x54, 0x72, 0x61, 0x6e, 0x73,
-	0x66, 0x6f, 0x72, 0x6d, 0x65, 0x72, 0x42, 0x79, 0x49, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x34, 0x2e, 0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61,
-	0x31, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x44, 0x65, 0x66, 0x69, 0x6e, 0x65, 0x64,
-	0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x65, 0x72, 0x42, 0x79, 0x49, 0x64, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x89, 0x01, 0x0a, 0x1c, 0x43, 0x72,
-	0x65, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x44, 0x65, 0x66, 0x69, 0x6e, 0x65, 0x64, 0x54,
-	0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x65, 0x72, 0x12, 0x32, 0x2e, 0x6d, 0x67, 0x6d,
-	0x74, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74,
-	0x65, 0x55, 0x73, 0x65, 0x72, 0x44, 0x65, 0x66, 0x69, 0x6e, 0x65, 0x64, 0x54, 0x72, 0x61, 0x6e,
-	0x73, 0x66, 0x6f, 0x72, 0x6d, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x33,
-	0x2e, 0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x43,
-	0x72, 0x65, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x44, 0x65, 0x66, 0x69, 0x6e, 0x65, 0x64,
-	0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x89, 0x01, 0x0a, 0x1c, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x8c, 0x01, 0x0a, 0x1d, 0x47, 0x65, 0x74,
 	0x55, 0x73, 0x65, 0x72, 0x44, 0x65, 0x66, 0x69, 0x6e, 0x65, 0x64, 0x54, 0x72, 0x61, 0x6e, 0x73,
-	0x66, 0x6f, 0x72, 0x6d, 0x65, 0x72, 0x12, 0x32, 0x2e, 0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x76, 0x31,
-	0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x55, 0x73, 0x65,
-	0x72, 0x44, 0x65, 0x66, 0x69, 0x6e, 0x65, 0x64, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72,
-	0x6d, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x33, 0x2e, 0x6d, 0x67, 0x6d,
-	0x74, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74,
-	0x65, 0x55, 0x73, 0x65, 0x72, 0x44, 0x65, 0x66, 0x69, 0x6e, 0x65, 0x64, 0x54, 0x72, 0x61, 0x6e,
-	0x73, 0x66, 0x6f, 0x72, 0x6d, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0x00, 0x12, 0x89, 0x01, 0x0a, 0x1c, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72,
-	0x44, 0x65, 0x66, 0x69, 0x6e, 0x65, 0x64, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d,
-	0x65, 0x72, 0x12, 0x32, 0x2e, 0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68,
-	0x61, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x44, 0x65, 0x66,
-	0x69, 0x6e, 0x65, 0x64, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x65, 0x72, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x33, 0x2e, 0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x76, 0x31,
-	0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65,
-	0x72, 0x44, 0x65, 0x66, 0x69, 0x6e, 0x65, 0x64, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72,
-	0x6d, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x83, 0x01,
-	0x0a, 0x1a, 0x49, 0x73, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x65, 0x72, 0x4e,
-	0x61, 0x6d, 0x65, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x30, 0x2e, 0x6d,
+	0x66, 0x6f, 0x72, 0x6d, 0x65, 0x72, 0x42, 0x79, 0x49, 0x64, 0x12, 0x33, 0x2e, 0x6d, 0x67, 0x6d,
+	0x74, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x73,
+	0x65, 0x72, 0x44, 0x65, 0x66, 0x69, 0x6e, 0x65, 0x64, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f,
+	0x72, 0x6d, 0x65, 0x72, 0x42, 0x79, 0x49, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x34, 0x2e, 0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e,
+	0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x44, 0x65, 0x66, 0x69, 0x6e, 0x65, 0x64, 0x54, 0x72,
+	0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x65, 0x72, 0x42, 0x79, 0x49, 0x64, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x89, 0x01, 0x0a, 0x1c, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x44, 0x65, 0x66, 0x69, 0x6e, 0x65, 0x64, 0x54, 0x72, 0x61,
+	0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x65, 0x72, 0x12, 0x32, 0x2e, 0x6d, 0x67, 0x6d, 0x74, 0x2e,
+	0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x55,
+	0x73, 0x65, 0x72, 0x44, 0x65, 0x66, 0x69, 0x6e, 0x65, 0x64, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66,
+	0x6f, 0x72, 0x6d, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x33, 0x2e, 0x6d,
+	0x67, 0x6d, 0x74, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x44, 0x65, 0x66, 0x69, 0x6e, 0x65, 0x64, 0x54, 0x72,
+	0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x12, 0x89, 0x01, 0x0a, 0x1c, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x55, 0x73,
+	0x65, 0x72, 0x44, 0x65, 0x66, 0x69, 0x6e, 0x65, 0x64, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f,
+	0x72, 0x6d, 0x65, 0x72, 0x12, 0x32, 0x2e, 0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x76, 0x31, 0x61, 0x6c,
+	0x70, 0x68, 0x61, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x44,
+	0x65, 0x66, 0x69, 0x6e, 0x65, 0x64, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x65,
+	0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x33, 0x2e, 0x6d, 0x67, 0x6d, 0x74, 0x2e,
+	0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x55,
+	0x73, 0x65, 0x72, 0x44, 0x65, 0x66, 0x69, 0x6e, 0x65, 0x64, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66,
+	0x6f, 0x72, 0x6d, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
+	0x89, 0x01, 0x0a, 0x1c, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x44, 0x65,
+	0x66, 0x69, 0x6e, 0x65, 0x64, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x65, 0x72,
+	0x12, 0x32, 0x2e, 0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31,
+	0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x44, 0x65, 0x66, 0x69, 0x6e,
+	0x65, 0x64, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x65, 0x72, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x33, 0x2e, 0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x76, 0x31, 0x61, 0x6c,
+	0x70, 0x68, 0x61, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x44,
+	0x65, 0x66, 0x69, 0x6e, 0x65, 0x64, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x65,
+	0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x83, 0x01, 0x0a, 0x1a,
+	0x49, 0x73, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x65, 0x72, 0x4e, 0x61, 0x6d,
+	0x65, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x30, 0x2e, 0x6d, 0x67, 0x6d,
+	0x74, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x49, 0x73, 0x54, 0x72, 0x61,
+	0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x41, 0x76, 0x61, 0x69,
+	0x6c, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x31, 0x2e, 0x6d,
 	0x67, 0x6d, 0x74, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x49, 0x73, 0x54,
 	0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x41, 0x76,
-	0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x31,
-	0x2e, 0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x49,
-	0x73, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65,
-	0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x00, 0x12, 0x83, 0x01, 0x0a, 0x1a, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65,
-	0x55, 0x73, 0x65, 0x72, 0x4a, 0x61, 0x76, 0x61, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x43, 0x6f,
-	0x64, 0x65, 0x12, 0x30, 0x2e, 0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68,
+	0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x00, 0x12, 0x83, 0x01, 0x0a, 0x1a, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x55, 0x73,
+	0x65, 0x72, 0x4a, 0x61, 0x76, 0x61, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x43, 0x6f, 0x64, 0x65,
+	0x12, 0x30, 0x2e, 0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31,
+	0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x4a, 0x61, 0x76,
+	0x61, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x31, 0x2e, 0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68,
 	0x61, 0x31, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x4a,
-	0x61, 0x76, 0x61, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x31, 0x2e, 0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x76, 0x31, 0x61, 0x6c,
-	0x70, 0x68, 0x61, 0x31, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65,
-	0x72, 0x4a, 0x61, 0x76, 0x61, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x43, 0x6f, 0x64, 0x65, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x74, 0x0a, 0x15, 0x56, 0x61, 0x6c,
-	0x69, 0x64, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x67, 0x65, 0x78, 0x43, 0x6f,
-	0x64, 0x65, 0x12, 0x2b, 0x2e, 0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68,
-	0x61, 0x31, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x52,
-	0x65, 0x67, 0x65, 0x78, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x2c, 0x2e, 0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e,
-	0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x67, 0x65,
-	0x78, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x42,
-	0xcc, 0x01, 0x0a, 0x11, 0x63, 0x6f, 0x6d, 0x2e, 0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x76, 0x31, 0x61,
-	0x6c, 0x70, 0x68, 0x61, 0x31, 0x42, 0x10, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d,
-	0x65, 0x72, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x50, 0x67, 0x69, 0x74, 0x68, 0x75,
-	0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6e, 0x75, 0x63, 0x6c, 0x65, 0x75, 0x73, 0x63, 0x6c, 0x6f,
-	0x75, 0x64, 0x2f, 0x6e, 0x65, 0x6f, 0x73, 0x79, 0x6e, 0x63, 0x2f, 0x62, 0x61, 0x63, 0x6b, 0x65,
-	0x6e, 0x64, 0x2f, 0x67, 0x65, 0x6e, 0x2f, 0x67, 0x6f, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73,
-	0x2f, 0x6d, 0x67, 0x6d, 0x74, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x3b, 0x6d,
-	0x67, 0x6d, 0x74, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0xa2, 0x02, 0x03, 0x4d, 0x58,
-	0x58, 0xaa, 0x02, 0x0d, 0x4d, 0x67, 0x6d, 0x74, 0x2e, 0x56, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61,
-	0x31, 0xca, 0x02, 0x0d, 0x4d, 0x67, 0x6d, 0x74, 0x5c, 0x56, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61,
-	0x31, 0xe2, 0x02, 0x19, 0x4d, 0x67, 0x6d, 0x74, 0x5c, 0x56, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61,
-	0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x0e,
-	0x4d, 0x67, 0x6d, 0x74, 0x3a, 0x3a, 0x56, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x62, 0x06,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x61, 0x76, 0x61, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x74, 0x0a, 0x15, 0x56, 0x61, 0x6c, 0x69, 0x64,
+	0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x67, 0x65, 0x78, 0x43, 0x6f, 0x64, 0x65,
+	0x12, 0x2b, 0x2e, 0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31,
+	0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x67,
+	0x65, 0x78, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e,
+	0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x56, 0x61,
+	0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x67, 0x65, 0x78, 0x43,
+	0x6f, 0x64, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x86, 0x01,
+	0x0a, 0x1b, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66,
+	0x6f, 0x72, 0x6d, 0x4a, 0x73, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x31, 0x2e,
+	0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x56, 0x61,
+	0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x4a,
+	0x73, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x32, 0x2e, 0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31,
+	0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f,
+	0x72, 0x6d, 0x4a, 0x73, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x7a, 0x0a, 0x17, 0x44, 0x65, 0x63, 0x72, 0x79, 0x70,
+	0x74, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x50, 0x72, 0x65, 0x73, 0x65, 0x72, 0x76, 0x69, 0x6e,
+	0x67, 0x12, 0x2d, 0x2e, 0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61,
+	0x31, 0x2e, 0x44, 0x65, 0x63, 0x72, 0x79, 0x70, 0x74, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x50,
+	0x72, 0x65, 0x73, 0x65, 0x72, 0x76, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x2e, 0x2e, 0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31,
+	0x2e, 0x44, 0x65, 0x63, 0x72, 0x79, 0x70, 0x74, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x50, 0x72,
+	0x65, 0x73, 0x65, 0x72, 0x76, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x00, 0x42, 0xcc, 0x01, 0x0a, 0x11, 0x63, 0x6f, 0x6d, 0x2e, 0x6d, 0x67, 0x6d, 0x74, 0x2e,
+	0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x42, 0x10, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66,
+	0x6f, 0x72, 0x6d, 0x65, 0x72, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x50, 0x67, 0x69,
+	0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6e, 0x75, 0x63, 0x6c, 0x65, 0x75, 0x73,
+	0x63, 0x6c, 0x6f, 0x75, 0x64, 0x2f, 0x6e, 0x65, 0x6f, 0x73, 0x79, 0x6e, 0x63, 0x2f, 0x62, 0x61,
+	0x63, 0x6b, 0x65, 0x6e, 0x64, 0x2f, 0x67, 0x65, 0x6e, 0x2f, 0x67, 0x6f, 0x2f, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x73, 0x2f, 0x6d, 0x67, 0x6d, 0x74, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61,
+	0x31, 0x3b, 0x6d, 0x67, 0x6d, 0x74, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0xa2, 0x02,
+	0x03, 0x4d, 0x58, 0x58, 0xaa, 0x02, 0x0d, 0x4d, 0x67, 0x6d, 0x74, 0x2e, 0x56, 0x31, 0x61, 0x6c,
+	0x70, 0x68, 0x61, 0x31, 0xca, 0x02, 0x0d, 0x4d, 0x67, 0x6d, 0x74, 0x5c, 0x56, 0x31, 0x61, 0x6c,
+	0x70, 0x68, 0x61, 0x31, 0xe2, 0x02, 0x19, 0x4d, 0x67, 0x6d, 0x74, 0x5c, 0x56, 0x31, 0x61, 0x6c,
+	0x70, 0x68, 0x61, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
+	0xea, 0x02, 0x0e, 0x4d, 0x67, 0x6d, 0x74, 0x3a, 0x3a, 0x56, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61,
+	0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -4842,164 +7264,218 @@ func file_mgmt_v1alpha1_transformer_proto_rawDescGZIP() []byte {
 	return file_mgmt_v1alpha1_transformer_proto_rawDescData
 }
 
-var file_mgmt_v1alpha1_transformer_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
-var file_mgmt_v1alpha1_transformer_proto_msgTypes = make([]protoimpl.MessageInfo, 65)
+var file_mgmt_v1alpha1_transformer_proto_enumTypes = make([]protoimpl.EnumInfo, 8)
+var file_mgmt_v1alpha1_transformer_proto_msgTypes = make([]protoimpl.MessageInfo, 83)
 var file_mgmt_v1alpha1_transformer_proto_goTypes = []interface{}{
 	(TransformerSource)(0),                        // 0: mgmt.v1alpha1.TransformerSource
 	(TransformerDataType)(0),                      // 1: mgmt.v1alpha1.TransformerDataType
-	(*GetSystemTransformersRequest)(nil),          // 2: mgmt.v1alpha1.GetSystemTransformersRequest
-	(*GetSystemTransformersResponse)(nil),         // 3: mgmt.v1alpha1.GetSystemTransformersResponse
-	(*GetSystemTransformerBySourceRequest)(nil),   // 4: mgmt.v1alpha1.GetSystemTransformerBySourceRequest
-	(*GetSystemTransformerBySourceResponse)(nil),  // 5: mgmt.v1alpha1.GetSystemTransformerBySourceResponse
-	(*GetUserDefinedTransformersRequest)(nil),     // 6: mgmt.v1alpha1.GetUserDefinedTransformersRequest
-	(*GetUserDefinedTransformersResponse)(nil),    // 7: mgmt.v1alpha1.GetUserDefinedTransformersResponse
-	(*GetUserDefinedTransformerByIdRequest)(nil),  // 8: mgmt.v1alpha1.GetUserDefinedTransformerByIdRequest
-	(*GetUserDefinedTransformerByIdResponse)(nil), // 9: mgmt.v1alpha1.GetUserDefinedTransformerByIdResponse
-	(*CreateUserDefinedTransformerRequest)(nil),   // 10: mgmt.v1alpha1.CreateUserDefinedTransformerRequest
-	(*CreateUserDefinedTransformerResponse)(nil),  // 11: mgmt.v1alpha1.CreateUserDefinedTransformerResponse
-	(*DeleteUserDefinedTransformerRequest)(nil),   // 12: mgmt.v1alpha1.DeleteUserDefinedTransformerRequest
-	(*DeleteUserDefinedTransformerResponse)(nil),  // 13: mgmt.v1alpha1.DeleteUserDefinedTransformerResponse
-	(*UpdateUserDefinedTransformerRequest)(nil),   // 14: mgmt.v1alpha1.UpdateUserDefinedTransformerRequest
-	(*UpdateUserDefinedTransformerResponse)(nil),  // 15: mgmt.v1alpha1.UpdateUserDefinedTransformerResponse
-	(*IsTransformerNameAvailableRequest)(nil),     // 16: mgmt.v1alpha1.IsTransformerNameAvailableRequest
-	(*IsTransformerNameAvailableResponse)(nil),    // 17: mgmt.v1alpha1.IsTransformerNameAvailableResponse
-	(*UserDefinedTransformer)(nil),                // 18: mgmt.v1alpha1.UserDefinedTransformer
-	(*SystemTransformer)(nil),                     // 19: mgmt.v1alpha1.SystemTransformer
-	(*TransformerConfig)(nil),                     // 20: mgmt.v1alpha1.TransformerConfig
-	(*GenerateEmail)(nil),                         // 21: mgmt.v1alpha1.GenerateEmail
-	(*TransformEmail)(nil),                        // 22: mgmt.v1alpha1.TransformEmail
-	(*GenerateBool)(nil),                          // 23: mgmt.v1alpha1.GenerateBool
-	(*GenerateCardNumber)(nil),                    // 24: mgmt.v1alpha1.GenerateCardNumber
-	(*GenerateCity)(nil),                          // 25: mgmt.v1alpha1.GenerateCity
-	(*GenerateDefault)(nil),                       // 26: mgmt.v1alpha1.GenerateDefault
-	(*GenerateE164PhoneNumber)(nil),               // 27: mgmt.v1alpha1.GenerateE164PhoneNumber
-	(*GenerateFirstName)(nil),                     // 28: mgmt.v1alpha1.GenerateFirstName
-	(*GenerateFloat64)(nil),                       // 29: mgmt.v1alpha1.GenerateFloat64
-	(*GenerateFullAddress)(nil),                   // 30: mgmt.v1alpha1.GenerateFullAddress
-	(*GenerateFullName)(nil),                      // 31: mgmt.v1alpha1.GenerateFullName
-	(*GenerateGender)(nil),                        // 32: mgmt.v1alpha1.GenerateGender
-	(*GenerateInt64PhoneNumber)(nil),              // 33: mgmt.v1alpha1.GenerateInt64PhoneNumber
-	(*GenerateInt64)(nil),                         // 34: mgmt.v1alpha1.GenerateInt64
-	(*GenerateLastName)(nil),                      // 35: mgmt.v1alpha1.GenerateLastName
-	(*GenerateSha256Hash)(nil),                    // 36: mgmt.v1alpha1.GenerateSha256Hash
-	(*GenerateSSN)(nil),                           // 37: mgmt.v1alpha1.GenerateSSN
-	(*GenerateState)(nil),                         // 38: mgmt.v1alpha1.GenerateState
-	(*GenerateStreetAddress)(nil),                 // 39: mgmt.v1alpha1.GenerateStreetAddress
-	(*GenerateStringPhoneNumber)(nil),             // 40: mgmt.v1alpha1.GenerateStringPhoneNumber
-	(*GenerateString)(nil),                        // 41: mgmt.v1alpha1.GenerateString
-	(*GenerateUnixTimestamp)(nil),                 // 42: mgmt.v1alpha1.GenerateUnixTimestamp
-	(*GenerateUsername)(nil),                      // 43: mgmt.v1alpha1.GenerateUsername
-	(*GenerateUtcTimestamp)(nil),                  // 44: mgmt.v1alpha1.GenerateUtcTimestamp
-	(*GenerateUuid)(nil),                          // 45: mgmt.v1alpha1.GenerateUuid
-	(*GenerateZipcode)(nil),                       // 46: mgmt.v1alpha1.GenerateZipcode
-	(*TransformE164PhoneNumber)(nil),              // 47: mgmt.v1alpha1.TransformE164PhoneNumber
-	(*TransformFirstName)(nil),                    // 48: mgmt.v1alpha1.TransformFirstName
-	(*TransformFloat64)(nil),                      // 49: mgmt.v1alpha1.TransformFloat64
-	(*TransformFullName)(nil),                     // 50: mgmt.v1alpha1.TransformFullName
-	(*TransformInt64PhoneNumber)(nil),             // 51: mgmt.v1alpha1.TransformInt64PhoneNumber
-	(*TransformInt64)(nil),                        // 52: mgmt.v1alpha1.TransformInt64
-	(*TransformLastName)(nil),                     // 53: mgmt.v1alpha1.TransformLastName
-	(*TransformPhoneNumber)(nil),                  // 54: mgmt.v1alpha1.TransformPhoneNumber
-	(*TransformString)(nil),                       // 55: mgmt.v1alpha1.TransformString
-	(*Passthrough)(nil),                           // 56: mgmt.v1alpha1.Passthrough
-	(*Null)(nil),                                  // 57: mgmt.v1alpha1.Null
-	(*TransformJavascript)(nil),                   // 58: mgmt.v1alpha1.TransformJavascript
-	(*UserDefinedTransformerConfig)(nil),          // 59: mgmt.v1alpha1.UserDefinedTransformerConfig
-	(*ValidateUserJavascriptCodeRequest)(nil),     // 60: mgmt.v1alpha1.ValidateUserJavascriptCodeRequest
-	(*ValidateUserJavascriptCodeResponse)(nil),    // 61: mgmt.v1alpha1.ValidateUserJavascriptCodeResponse
-	(*GenerateCategorical)(nil),                   // 62: mgmt.v1alpha1.GenerateCategorical
-	(*TransformCharacterScramble)(nil),            // 63: mgmt.v1alpha1.TransformCharacterScramble
-	(*GenerateJavascript)(nil),                    // 64: mgmt.v1alpha1.GenerateJavascript
-	(*ValidateUserRegexCodeRequest)(nil),          // 65: mgmt.v1alpha1.ValidateUserRegexCodeRequest
-	(*ValidateUserRegexCodeResponse)(nil),         // 66: mgmt.v1alpha1.ValidateUserRegexCodeResponse
-	(*timestamppb.Timestamp)(nil),                 // 67: google.protobuf.Timestamp
+	(FormatPreservingEncryptionAlgorithm)(0),      // 2: mgmt.v1alpha1.FormatPreservingEncryptionAlgorithm
+	(TimeTransformMode)(0),                        // 3: mgmt.v1alpha1.TimeTransformMode
+	(TimeTruncatePrecision)(0),                    // 4: mgmt.v1alpha1.TimeTruncatePrecision
+	(FreeTextEntityType)(0),                       // 5: mgmt.v1alpha1.FreeTextEntityType
+	(FreeTextReplacementStrategy)(0),              // 6: mgmt.v1alpha1.FreeTextReplacementStrategy
+	(IpAddressVersion)(0),                         // 7: mgmt.v1alpha1.IpAddressVersion
+	(*GetSystemTransformersRequest)(nil),          // 8: mgmt.v1alpha1.GetSystemTransformersRequest
+	(*GetSystemTransformersResponse)(nil),         // 9: mgmt.v1alpha1.GetSystemTransformersResponse
+	(*GetSystemTransformerBySourceRequest)(nil),   // 10: mgmt.v1alpha1.GetSystemTransformerBySourceRequest
+	(*GetSystemTransformerBySourceResponse)(nil),  // 11: mgmt.v1alpha1.GetSystemTransformerBySourceResponse
+	(*GetUserDefinedTransformersRequest)(nil),     // 12: mgmt.v1alpha1.GetUserDefinedTransformersRequest
+	(*GetUserDefinedTransformersResponse)(nil),    // 13: mgmt.v1alpha1.GetUserDefinedTransformersResponse
+	(*GetUserDefinedTransformerByIdRequest)(nil),  // 14: mgmt.v1alpha1.GetUserDefinedTransformerByIdRequest
+	(*GetUserDefinedTransformerByIdResponse)(nil), // 15: mgmt.v1alpha1.GetUserDefinedTransformerByIdResponse
+	(*CreateUserDefinedTransformerRequest)(nil),   // 16: mgmt.v1alpha1.CreateUserDefinedTransformerRequest
+	(*CreateUserDefinedTransformerResponse)(nil),  // 17: mgmt.v1alpha1.CreateUserDefinedTransformerResponse
+	(*DeleteUserDefinedTransformerRequest)(nil),   // 18: mgmt.v1alpha1.DeleteUserDefinedTransformerRequest
+	(*DeleteUserDefinedTransformerResponse)(nil),  // 19: mgmt.v1alpha1.DeleteUserDefinedTransformerResponse
+	(*UpdateUserDefinedTransformerRequest)(nil),   // 20: mgmt.v1alpha1.UpdateUserDefinedTransformerRequest
+	(*UpdateUserDefinedTransformerResponse)(nil),  // 21: mgmt.v1alpha1.UpdateUserDefinedTransformerResponse
+	(*IsTransformerNameAvailableRequest)(nil),     // 22: mgmt.v1alpha1.IsTransformerNameAvailableRequest
+	(*IsTransformerNameAvailableResponse)(nil),    // 23: mgmt.v1alpha1.IsTransformerNameAvailableResponse
+	(*UserDefinedTransformer)(nil),                // 24: mgmt.v1alpha1.UserDefinedTransformer
+	(*SystemTransformer)(nil),                     // 25: mgmt.v1alpha1.SystemTransformer
+	(*TransformerConfig)(nil),                     // 26: mgmt.v1alpha1.TransformerConfig
+	(*GenerateEmail)(nil),                         // 27: mgmt.v1alpha1.GenerateEmail
+	(*TransformEmail)(nil),                        // 28: mgmt.v1alpha1.TransformEmail
+	(*GenerateBool)(nil),                          // 29: mgmt.v1alpha1.GenerateBool
+	(*GenerateCardNumber)(nil),                    // 30: mgmt.v1alpha1.GenerateCardNumber
+	(*GenerateCity)(nil),                          // 31: mgmt.v1alpha1.GenerateCity
+	(*GenerateDefault)(nil),                       // 32: mgmt.v1alpha1.GenerateDefault
+	(*GenerateE164PhoneNumber)(nil),               // 33: mgmt.v1alpha1.GenerateE164PhoneNumber
+	(*GenerateFirstName)(nil),                     // 34: mgmt.v1alpha1.GenerateFirstName
+	(*GenerateFloat64)(nil),                       // 35: mgmt.v1alpha1.GenerateFloat64
+	(*GenerateFullAddress)(nil),                   // 36: mgmt.v1alpha1.GenerateFullAddress
+	(*GenerateFullName)(nil),                      // 37: mgmt.v1alpha1.GenerateFullName
+	(*GenerateGender)(nil),                        // 38: mgmt.v1alpha1.GenerateGender
+	(*GenerateInt64PhoneNumber)(nil),              // 39: mgmt.v1alpha1.GenerateInt64PhoneNumber
+	(*GenerateInt64)(nil),                         // 40: mgmt.v1alpha1.GenerateInt64
+	(*GenerateLastName)(nil),                      // 41: mgmt.v1alpha1.GenerateLastName
+	(*GenerateSha256Hash)(nil),                    // 42: mgmt.v1alpha1.GenerateSha256Hash
+	(*GenerateSSN)(nil),                           // 43: mgmt.v1alpha1.GenerateSSN
+	(*GenerateState)(nil),                         // 44: mgmt.v1alpha1.GenerateState
+	(*GenerateStreetAddress)(nil),                 // 45: mgmt.v1alpha1.GenerateStreetAddress
+	(*GenerateStringPhoneNumber)(nil),             // 46: mgmt.v1alpha1.GenerateStringPhoneNumber
+	(*GenerateString)(nil),                        // 47: mgmt.v1alpha1.GenerateString
+	(*GenerateUnixTimestamp)(nil),                 // 48: mgmt.v1alpha1.GenerateUnixTimestamp
+	(*GenerateUsername)(nil),                      // 49: mgmt.v1alpha1.GenerateUsername
+	(*GenerateUtcTimestamp)(nil),                  // 50: mgmt.v1alpha1.GenerateUtcTimestamp
+	(*GenerateUuid)(nil),                          // 51: mgmt.v1alpha1.GenerateUuid
+	(*GenerateZipcode)(nil),                       // 52: mgmt.v1alpha1.GenerateZipcode
+	(*TransformE164PhoneNumber)(nil),              // 53: mgmt.v1alpha1.TransformE164PhoneNumber
+	(*TransformFirstName)(nil),                    // 54: mgmt.v1alpha1.TransformFirstName
+	(*TransformFloat64)(nil),                      // 55: mgmt.v1alpha1.TransformFloat64
+	(*TransformFullName)(nil),                     // 56: mgmt.v1alpha1.TransformFullName
+	(*TransformInt64PhoneNumber)(nil),             // 57: mgmt.v1alpha1.TransformInt64PhoneNumber
+	(*TransformInt64)(nil),                        // 58: mgmt.v1alpha1.TransformInt64
+	(*TransformLastName)(nil),                     // 59: mgmt.v1alpha1.TransformLastName
+	(*TransformPhoneNumber)(nil),                  // 60: mgmt.v1alpha1.TransformPhoneNumber
+	(*TransformString)(nil),                       // 61: mgmt.v1alpha1.TransformString
+	(*Passthrough)(nil),                           // 62: mgmt.v1alpha1.Passthrough
+	(*Null)(nil),                                  // 63: mgmt.v1alpha1.Null
+	(*TransformJavascript)(nil),                   // 64: mgmt.v1alpha1.TransformJavascript
+	(*UserDefinedTransformerConfig)(nil),          // 65: mgmt.v1alpha1.UserDefinedTransformerConfig
+	(*ValidateUserJavascriptCodeRequest)(nil),     // 66: mgmt.v1alpha1.ValidateUserJavascriptCodeRequest
+	(*ValidateUserJavascriptCodeResponse)(nil),    // 67: mgmt.v1alpha1.ValidateUserJavascriptCodeResponse
+	(*GenerateCategorical)(nil),                   // 68: mgmt.v1alpha1.GenerateCategorical
+	(*TransformCharacterScramble)(nil),            // 69: mgmt.v1alpha1.TransformCharacterScramble
+	(*GenerateJavascript)(nil),                    // 70: mgmt.v1alpha1.GenerateJavascript
+	(*TransformFormatPreservingEncrypt)(nil),      // 71: mgmt.v1alpha1.TransformFormatPreservingEncrypt
+	(*TransformTimestamp)(nil),                    // 72: mgmt.v1alpha1.TransformTimestamp
+	(*TransformDate)(nil),                         // 73: mgmt.v1alpha1.TransformDate
+	(*TransformJson)(nil),                         // 74: mgmt.v1alpha1.TransformJson
+	(*TransformJsonRule)(nil),                     // 75: mgmt.v1alpha1.TransformJsonRule
+	(*TransformFreeText)(nil),                     // 76: mgmt.v1alpha1.TransformFreeText
+	(*GenerateIpAddress)(nil),                     // 77: mgmt.v1alpha1.GenerateIpAddress
+	(*TransformIpAddress)(nil),                    // 78: mgmt.v1alpha1.TransformIpAddress
+	(*GenerateMacAddress)(nil),                    // 79: mgmt.v1alpha1.GenerateMacAddress
+	(*TransformMacAddress)(nil),                   // 80: mgmt.v1alpha1.TransformMacAddress
+	(*GenerateUrl)(nil),                           // 81: mgmt.v1alpha1.GenerateUrl
+	(*TransformUrl)(nil),                          // 82: mgmt.v1alpha1.TransformUrl
+	(*GenerateDomain)(nil),                        // 83: mgmt.v1alpha1.GenerateDomain
+	(*TransformDomain)(nil),                       // 84: mgmt.v1alpha1.TransformDomain
+	(*ValidateUserRegexCodeRequest)(nil),          // 85: mgmt.v1alpha1.ValidateUserRegexCodeRequest
+	(*ValidateUserRegexCodeResponse)(nil),         // 86: mgmt.v1alpha1.ValidateUserRegexCodeResponse
+	(*ValidateTransformJsonConfigRequest)(nil),    // 87: mgmt.v1alpha1.ValidateTransformJsonConfigRequest
+	(*ValidateTransformJsonConfigResponse)(nil),   // 88: mgmt.v1alpha1.ValidateTransformJsonConfigResponse
+	(*DecryptFormatPreservingRequest)(nil),        // 89: mgmt.v1alpha1.DecryptFormatPreservingRequest
+	(*DecryptFormatPreservingResponse)(nil),       // 90: mgmt.v1alpha1.DecryptFormatPreservingResponse
+	(*timestamppb.Timestamp)(nil),                 // 91: google.protobuf.Timestamp
 }
 var file_mgmt_v1alpha1_transformer_proto_depIdxs = []int32{
-	19, // 0: mgmt.v1alpha1.GetSystemTransformersResponse.transformers:type_name -> mgmt.v1alpha1.SystemTransformer
+	25, // 0: mgmt.v1alpha1.GetSystemTransformersResponse.transformers:type_name -> mgmt.v1alpha1.SystemTransformer
 	0,  // 1: mgmt.v1alpha1.GetSystemTransformerBySourceRequest.source:type_name -> mgmt.v1alpha1.TransformerSource
-	19, // 2: mgmt.v1alpha1.GetSystemTransformerBySourceResponse.transformer:type_name -> mgmt.v1alpha1.SystemTransformer
-	18, // 3: mgmt.v1alpha1.GetUserDefinedTransformersResponse.transformers:type_name -> mgmt.v1alpha1.UserDefinedTransformer
-	18, // 4: mgmt.v1alpha1.GetUserDefinedTransformerByIdResponse.transformer:type_name -> mgmt.v1alpha1.UserDefinedTransformer
+	25, // 2: mgmt.v1alpha1.GetSystemTransformerBySourceResponse.transformer:type_name -> mgmt.v1alpha1.SystemTransformer
+	24, // 3: mgmt.v1alpha1.GetUserDefinedTransformersResponse.transformers:type_name -> mgmt.v1alpha1.UserDefinedTransformer
+	24, // 4: mgmt.v1alpha1.GetUserDefinedTransformerByIdResponse.transformer:type_name -> mgmt.v1alpha1.UserDefinedTransformer
 	0,  // 5: mgmt.v1alpha1.CreateUserDefinedTransformerRequest.source:type_name -> mgmt.v1alpha1.TransformerSource
-	20, // 6: mgmt.v1alpha1.CreateUserDefinedTransformerRequest.transformer_config:type_name -> mgmt.v1alpha1.TransformerConfig
-	18, // 7: mgmt.v1alpha1.CreateUserDefinedTransformerResponse.transformer:type_name -> mgmt.v1alpha1.UserDefinedTransformer
-	20, // 8: mgmt.v1alpha1.UpdateUserDefinedTransformerRequest.transformer_config:type_name -> mgmt.v1alpha1.TransformerConfig
-	18, // 9: mgmt.v1alpha1.UpdateUserDefinedTransformerResponse.transformer:type_name -> mgmt.v1alpha1.UserDefinedTransformer
+	26, // 6: mgmt.v1alpha1.CreateUserDefinedTransformerRequest.transformer_config:type_name -> mgmt.v1alpha1.TransformerConfig
+	24, // 7: mgmt.v1alpha1.CreateUserDefinedTransformerResponse.transformer:type_name -> mgmt.v1alpha1.UserDefinedTransformer
+	26, // 8: mgmt.v1alpha1.UpdateUserDefinedTransformerRequest.transformer_config:type_name -> mgmt.v1alpha1.TransformerConfig
+	24, // 9: mgmt.v1alpha1.UpdateUserDefinedTransformerResponse.transformer:type_name -> mgmt.v1alpha1.UserDefinedTransformer
 	1,  // 10: mgmt.v1alpha1.UserDefinedTransformer.data_type:type_name -> mgmt.v1alpha1.TransformerDataType
 	0,  // 11: mgmt.v1alpha1.UserDefinedTransformer.source:type_name -> mgmt.v1alpha1.TransformerSource
-	20, // 12: mgmt.v1alpha1.UserDefinedTransformer.config:type_name -> mgmt.v1alpha1.TransformerConfig
-	67, // 13: mgmt.v1alpha1.UserDefinedTransformer.created_at:type_name -> google.protobuf.Timestamp
-	67, // 14: mgmt.v1alpha1.UserDefinedTransformer.updated_at:type_name -> google.protobuf.Timestamp
+	26, // 12: mgmt.v1alpha1.UserDefinedTransformer.config:type_name -> mgmt.v1alpha1.TransformerConfig
+	91, // 13: mgmt.v1alpha1.UserDefinedTransformer.created_at:type_name -> google.protobuf.Timestamp
+	91, // 14: mgmt.v1alpha1.UserDefinedTransformer.updated_at:type_name -> google.protobuf.Timestamp
 	1,  // 15: mgmt.v1alpha1.SystemTransformer.data_type:type_name -> mgmt.v1alpha1.TransformerDataType
 	0,  // 16: mgmt.v1alpha1.SystemTransformer.source:type_name -> mgmt.v1alpha1.TransformerSource
-	20, // 17: mgmt.v1alpha1.SystemTransformer.config:type_name -> mgmt.v1alpha1.TransformerConfig
-	21, // 18: mgmt.v1alpha1.TransformerConfig.generate_email_config:type_name -> mgmt.v1alpha1.GenerateEmail
-	22, // 19: mgmt.v1alpha1.TransformerConfig.transform_email_config:type_name -> mgmt.v1alpha1.TransformEmail
-	23, // 20: mgmt.v1alpha1.TransformerConfig.generate_bool_config:type_name -> mgmt.v1alpha1.GenerateBool
-	24, // 21: mgmt.v1alpha1.TransformerConfig.generate_card_number_config:type_name -> mgmt.v1alpha1.GenerateCardNumber
-	25, // 22: mgmt.v1alpha1.TransformerConfig.generate_city_config:type_name -> mgmt.v1alpha1.GenerateCity
-	27, // 23: mgmt.v1alpha1.TransformerConfig.generate_e164_phone_number_config:type_name -> mgmt.v1alpha1.GenerateE164PhoneNumber
-	28, // 24: mgmt.v1alpha1.TransformerConfig.generate_first_name_config:type_name -> mgmt.v1alpha1.GenerateFirstName
-	29, // 25: mgmt.v1alpha1.TransformerConfig.generate_float64_config:type_name -> mgmt.v1alpha1.GenerateFloat64
-	30, // 26: mgmt.v1alpha1.TransformerConfig.generate_full_address_config:type_name -> mgmt.v1alpha1.GenerateFullAddress
-	31, // 27: mgmt.v1alpha1.TransformerConfig.generate_full_name_config:type_name -> mgmt.v1alpha1.GenerateFullName
-	32, // 28: mgmt.v1alpha1.TransformerConfig.generate_gender_config:type_name -> mgmt.v1alpha1.GenerateGender
-	33, // 29: mgmt.v1alpha1.TransformerConfig.generate_int64_phone_number_config:type_name -> mgmt.v1alpha1.GenerateInt64PhoneNumber
-	34, // 30: mgmt.v1alpha1.TransformerConfig.generate_int64_config:type_name -> mgmt.v1alpha1.GenerateInt64
-	35, // 31: mgmt.v1alpha1.TransformerConfig.generate_last_name_config:type_name -> mgmt.v1alpha1.GenerateLastName
-	36, // 32: mgmt.v1alpha1.TransformerConfig.generate_sha256hash_config:type_name -> mgmt.v1alpha1.GenerateSha256Hash
-	37, // 33: mgmt.v1alpha1.TransformerConfig.generate_ssn_config:type_name -> mgmt.v1alpha1.GenerateSSN
-	38, // 34: mgmt.v1alpha1.TransformerConfig.generate_state_config:type_name -> mgmt.v1alpha1.GenerateState
-	39, // 35: mgmt.v1alpha1.TransformerConfig.generate_street_address_config:type_name -> mgmt.v1alpha1.GenerateStreetAddress
-	40, // 36: mgmt.v1alpha1.TransformerConfig.generate_string_phone_number_config:type_name -> mgmt.v1alpha1.GenerateStringPhoneNumber
-	41, // 37: mgmt.v1alpha1.TransformerConfig.generate_string_config:type_name -> mgmt.v1alpha1.GenerateString
-	42, // 38: mgmt.v1alpha1.TransformerConfig.generate_unixtimestamp_config:type_name -> mgmt.v1alpha1.GenerateUnixTimestamp
-	43, // 39: mgmt.v1alpha1.TransformerConfig.generate_username_config:type_name -> mgmt.v1alpha1.GenerateUsername
-	44, // 40: mgmt.v1alpha1.TransformerConfig.generate_utctimestamp_config:type_name -> mgmt.v1alpha1.GenerateUtcTimestamp
-	45, // 41: mgmt.v1alpha1.TransformerConfig.generate_uuid_config:type_name -> mgmt.v1alpha1.GenerateUuid
-	46, // 42: mgmt.v1alpha1.TransformerConfig.generate_zipcode_config:type_name -> mgmt.v1alpha1.GenerateZipcode
-	47, // 43: mgmt.v1alpha1.TransformerConfig.transform_e164_phone_number_config:type_name -> mgmt.v1alpha1.TransformE164PhoneNumber
-	48, // 44: mgmt.v1alpha1.TransformerConfig.transform_first_name_config:type_name -> mgmt.v1alpha1.TransformFirstName
-	49, // 45: mgmt.v1alpha1.TransformerConfig.transform_float64_config:type_name -> mgmt.v1alpha1.TransformFloat64
-	50, // 46: mgmt.v1alpha1.TransformerConfig.transform_full_name_config:type_name -> mgmt.v1alpha1.TransformFullName
-	51, // 47: mgmt.v1alpha1.TransformerConfig.transform_int64_phone_number_config:type_name -> mgmt.v1alpha1.TransformInt64PhoneNumber
-	52, // 48: mgmt.v1alpha1.TransformerConfig.transform_int64_config:type_name -> mgmt.v1alpha1.TransformInt64
-	53, // 49: mgmt.v1alpha1.TransformerConfig.transform_last_name_config:type_name -> mgmt.v1alpha1.TransformLastName
-	54, // 50: mgmt.v1alpha1.TransformerConfig.transform_phone_number_config:type_name -> mgmt.v1alpha1.TransformPhoneNumber
-	55, // 51: mgmt.v1alpha1.TransformerConfig.transform_string_config:type_name -> mgmt.v1alpha1.TransformString
-	56, // 52: mgmt.v1alpha1.TransformerConfig.passthrough_config:type_name -> mgmt.v1alpha1.Passthrough
-	57, // 53: mgmt.v1alpha1.TransformerConfig.nullconfig:type_name -> mgmt.v1alpha1.Null
-	59, // 54: mgmt.v1alpha1.TransformerConfig.user_defined_transformer_config:type_name -> mgmt.v1alpha1.UserDefinedTransformerConfig
-	26, // 55: mgmt.v1alpha1.TransformerConfig.generate_default_config:type_name -> mgmt.v1alpha1.GenerateDefault
-	58, // 56: mgmt.v1alpha1.TransformerConfig.transform_javascript_config:type_name -> mgmt.v1alpha1.TransformJavascript
-	62, // 57: mgmt.v1alpha1.TransformerConfig.generate_categorical_config:type_name -> mgmt.v1alpha1.GenerateCategorical
-	63, // 58: mgmt.v1alpha1.TransformerConfig.transform_character_scramble_config:type_name -> mgmt.v1alpha1.TransformCharacterScramble
-	64, // 59: mgmt.v1alpha1.TransformerConfig.generate_javascript_config:type_name -> mgmt.v1alpha1.GenerateJavascript
-	2,  // 60: mgmt.v1alpha1.TransformersService.GetSystemTransformers:input_type -> mgmt.v1alpha1.GetSystemTransformersRequest
-	4,  // 61: mgmt.v1alpha1.TransformersService.GetSystemTransformerBySource:input_type -> mgmt.v1alpha1.GetSystemTransformerBySourceRequest
-	6,  // 62: mgmt.v1alpha1.TransformersService.GetUserDefinedTransformers:input_type -> mgmt.v1alpha1.GetUserDefinedTransformersRequest
-	8,  // 63: mgmt.v1alpha1.TransformersService.GetUserDefinedTransformerById:input_type -> mgmt.v1alpha1.GetUserDefinedTransformerByIdRequest
-	10, // 64: mgmt.v1alpha1.TransformersService.CreateUserDefinedTransformer:input_type -> mgmt.v1alpha1.CreateUserDefinedTransformerRequest
-	12, // 65: mgmt.v1alpha1.TransformersService.DeleteUserDefinedTransformer:input_type -> mgmt.v1alpha1.DeleteUserDefinedTransformerRequest
-	14, // 66: mgmt.v1alpha1.TransformersService.UpdateUserDefinedTransformer:input_type -> mgmt.v1alpha1.UpdateUserDefinedTransformerRequest
-	16, // 67: mgmt.v1alpha1.TransformersService.IsTransformerNameAvailable:input_type -> mgmt.v1alpha1.IsTransformerNameAvailableRequest
-	60, // 68: mgmt.v1alpha1.TransformersService.ValidateUserJavascriptCode:input_type -> mgmt.v1alpha1.ValidateUserJavascriptCodeRequest
-	65, // 69: mgmt.v1alpha1.TransformersService.ValidateUserRegexCode:input_type -> mgmt.v1alpha1.ValidateUserRegexCodeRequest
-	3,  // 70: mgmt.v1alpha1.TransformersService.GetSystemTransformers:output_type -> mgmt.v1alpha1.GetSystemTransformersResponse
-	5,  // 71: mgmt.v1alpha1.TransformersService.GetSystemTransformerBySource:output_type -> mgmt.v1alpha1.GetSystemTransformerBySourceResponse
-	7,  // 72: mgmt.v1alpha1.TransformersService.GetUserDefinedTransformers:output_type -> mgmt.v1alpha1.GetUserDefinedTransformersResponse
-	9,  // 73: mgmt.v1alpha1.TransformersService.GetUserDefinedTransformerById:output_type -> mgmt.v1alpha1.GetUserDefinedTransformerByIdResponse
-	11, // 74: mgmt.v1alpha1.TransformersService.CreateUserDefinedTransformer:output_type -> mgmt.v1alpha1.CreateUserDefinedTransformerResponse
-	13, // 75: mgmt.v1alpha1.TransformersService.DeleteUserDefinedTransformer:output_type -> mgmt.v1alpha1.DeleteUserDefinedTransformerResponse
-	15, // 76: mgmt.v1alpha1.TransformersService.UpdateUserDefinedTransformer:output_type -> mgmt.v1alpha1.UpdateUserDefinedTransformerResponse
-	17, // 77: mgmt.v1alpha1.TransformersService.IsTransformerNameAvailable:output_type -> mgmt.v1alpha1.IsTransformerNameAvailableResponse
-	61, // 78: mgmt.v1alpha1.TransformersService.ValidateUserJavascriptCode:output_type -> mgmt.v1alpha1.ValidateUserJavascriptCodeResponse
-	66, // 79: mgmt.v1alpha1.TransformersService.ValidateUserRegexCode:output_type -> mgmt.v1alpha1.ValidateUserRegexCodeResponse
-	70, // [70:80] is the sub-list for method output_type
-	60, // [60:70] is the sub-list for method input_type
-	60, // [60:60] is the sub-list for extension type_name
-	60, // [60:60] is the sub-list for extension extendee
-	0,  // [0:60] is the sub-list for field type_name
+	26, // 17: mgmt.v1alpha1.SystemTransformer.config:type_name -> mgmt.v1alpha1.TransformerConfig
+	27, // 18: mgmt.v1alpha1.TransformerConfig.generate_email_config:type_name -> mgmt.v1alpha1.GenerateEmail
+	28, // 19: mgmt.v1alpha1.TransformerConfig.transform_email_config:type_name -> mgmt.v1alpha1.TransformEmail
+	29, // 20: mgmt.v1alpha1.TransformerConfig.generate_bool_config:type_name -> mgmt.v1alpha1.GenerateBool
+	30, // 21: mgmt.v1alpha1.TransformerConfig.generate_card_number_config:type_name -> mgmt.v1alpha1.GenerateCardNumber
+	31, // 22: mgmt.v1alpha1.TransformerConfig.generate_city_config:type_name -> mgmt.v1alpha1.GenerateCity
+	33, // 23: mgmt.v1alpha1.TransformerConfig.generate_e164_phone_number_config:type_name -> mgmt.v1alpha1.GenerateE164PhoneNumber
+	34, // 24: mgmt.v1alpha1.TransformerConfig.generate_first_name_config:type_name -> mgmt.v1alpha1.GenerateFirstName
+	35, // 25: mgmt.v1alpha1.TransformerConfig.generate_float64_config:type_name -> mgmt.v1alpha1.GenerateFloat64
+	36, // 26: mgmt.v1alpha1.TransformerConfig.generate_full_address_config:type_name -> mgmt.v1alpha1.GenerateFullAddress
+	37, // 27: mgmt.v1alpha1.TransformerConfig.generate_full_name_config:type_name -> mgmt.v1alpha1.GenerateFullName
+	38, // 28: mgmt.v1alpha1.TransformerConfig.generate_gender_config:type_name -> mgmt.v1alpha1.GenerateGender
+	39, // 29: mgmt.v1alpha1.TransformerConfig.generate_int64_phone_number_config:type_name -> mgmt.v1alpha1.GenerateInt64PhoneNumber
+	40, // 30: mgmt.v1alpha1.TransformerConfig.generate_int64_config:type_name -> mgmt.v1alpha1.GenerateInt64
+	41, // 31: mgmt.v1alpha1.TransformerConfig.generate_last_name_config:type_name -> mgmt.v1alpha1.GenerateLastName
+	42, // 32: mgmt.v1alpha1.TransformerConfig.generate_sha256hash_config:type_name -> mgmt.v1alpha1.GenerateSha256Hash
+	43, // 33: mgmt.v1alpha1.TransformerConfig.generate_ssn_config:type_name -> mgmt.v1alpha1.GenerateSSN
+	44, // 34: mgmt.v1alpha1.TransformerConfig.generate_state_config:type_name -> mgmt.v1alpha1.GenerateState
+	45, // 35: mgmt.v1alpha1.TransformerConfig.generate_street_address_config:type_name -> mgmt.v1alpha1.GenerateStreetAddress
+	46, // 36: mgmt.v1alpha1.TransformerConfig.generate_string_phone_number_config:type_name -> mgmt.v1alpha1.GenerateStringPhoneNumber
+	47, // 37: mgmt.v1alpha1.TransformerConfig.generate_string_config:type_name -> mgmt.v1alpha1.GenerateString
+	48, // 38: mgmt.v1alpha1.TransformerConfig.generate_unixtimestamp_config:type_name -> mgmt.v1alpha1.GenerateUnixTimestamp
+	49, // 39: mgmt.v1alpha1.TransformerConfig.generate_username_config:type_name -> mgmt.v1alpha1.GenerateUsername
+	50, // 40: mgmt.v1alpha1.TransformerConfig.generate_utctimestamp_config:type_name -> mgmt.v1alpha1.GenerateUtcTimestamp
+	51, // 41: mgmt.v1alpha1.TransformerConfig.generate_uuid_config:type_name -> mgmt.v1alpha1.GenerateUuid
+	52, // 42: mgmt.v1alpha1.TransformerConfig.generate_zipcode_config:type_name -> mgmt.v1alpha1.GenerateZipcode
+	53, // 43: mgmt.v1alpha1.TransformerConfig.transform_e164_phone_number_config:type_name -> mgmt.v1alpha1.TransformE164PhoneNumber
+	54, // 44: mgmt.v1alpha1.TransformerConfig.transform_first_name_config:type_name -> mgmt.v1alpha1.TransformFirstName
+	55, // 45: mgmt.v1alpha1.TransformerConfig.transform_float64_config:type_name -> mgmt.v1alpha1.TransformFloat64
+	56, // 46: mgmt.v1alpha1.TransformerConfig.transform_full_name_config:type_name -> mgmt.v1alpha1.TransformFullName
+	57, // 47: mgmt.v1alpha1.TransformerConfig.transform_int64_phone_number_config:type_name -> mgmt.v1alpha1.TransformInt64PhoneNumber
+	58, // 48: mgmt.v1alpha1.TransformerConfig.transform_int64_config:type_name -> mgmt.v1alpha1.TransformInt64
+	59, // 49: mgmt.v1alpha1.TransformerConfig.transform_last_name_config:type_name -> mgmt.v1alpha1.TransformLastName
+	60, // 50: mgmt.v1alpha1.TransformerConfig.transform_phone_number_config:type_name -> mgmt.v1alpha1.TransformPhoneNumber
+	61, // 51: mgmt.v1alpha1.TransformerConfig.transform_string_config:type_name -> mgmt.v1alpha1.TransformString
+	62, // 52: mgmt.v1alpha1.TransformerConfig.passthrough_config:type_name -> mgmt.v1alpha1.Passthrough
+	63, // 53: mgmt.v1alpha1.TransformerConfig.nullconfig:type_name -> mgmt.v1alpha1.Null
+	65, // 54: mgmt.v1alpha1.TransformerConfig.user_defined_transformer_config:type_name -> mgmt.v1alpha1.UserDefinedTransformerConfig
+	32, // 55: mgmt.v1alpha1.TransformerConfig.generate_default_config:type_name -> mgmt.v1alpha1.GenerateDefault
+	64, // 56: mgmt.v1alpha1.TransformerConfig.transform_javascript_config:type_name -> mgmt.v1alpha1.TransformJavascript
+	68, // 57: mgmt.v1alpha1.TransformerConfig.generate_categorical_config:type_name -> mgmt.v1alpha1.GenerateCategorical
+	69, // 58: mgmt.v1alpha1.TransformerConfig.transform_character_scramble_config:type_name -> mgmt.v1alpha1.TransformCharacterScramble
+	70, // 59: mgmt.v1alpha1.TransformerConfig.generate_javascript_config:type_name -> mgmt.v1alpha1.GenerateJavascript
+	71, // 60: mgmt.v1alpha1.TransformerConfig.transform_format_preserving_encrypt_config:type_name -> mgmt.v1alpha1.TransformFormatPreservingEncrypt
+	72, // 61: mgmt.v1alpha1.TransformerConfig.transform_timestamp_config:type_name -> mgmt.v1alpha1.TransformTimestamp
+	73, // 62: mgmt.v1alpha1.TransformerConfig.transform_date_config:type_name -> mgmt.v1alpha1.TransformDate
+	74, // 63: mgmt.v1alpha1.TransformerConfig.transform_json_config:type_name -> mgmt.v1alpha1.TransformJson
+	76, // 64: mgmt.v1alpha1.TransformerConfig.transform_free_text_config:type_name -> mgmt.v1alpha1.TransformFreeText
+	77, // 65: mgmt.v1alpha1.TransformerConfig.generate_ip_address_config:type_name -> mgmt.v1alpha1.GenerateIpAddress
+	78, // 66: mgmt.v1alpha1.TransformerConfig.transform_ip_address_config:type_name -> mgmt.v1alpha1.TransformIpAddress
+	79, // 67: mgmt.v1alpha1.TransformerConfig.generate_mac_address_config:type_name -> mgmt.v1alpha1.GenerateMacAddress
+	80, // 68: mgmt.v1alpha1.TransformerConfig.transform_mac_address_config:type_name -> mgmt.v1alpha1.TransformMacAddress
+	81, // 69: mgmt.v1alpha1.TransformerConfig.generate_url_config:type_name -> mgmt.v1alpha1.GenerateUrl
+	82, // 70: mgmt.v1alpha1.TransformerConfig.transform_url_config:type_name -> mgmt.v1alpha1.TransformUrl
+	83, // 71: mgmt.v1alpha1.TransformerConfig.generate_domain_config:type_name -> mgmt.v1alpha1.GenerateDomain
+	84, // 72: mgmt.v1alpha1.TransformerConfig.transform_domain_config:type_name -> mgmt.v1alpha1.TransformDomain
+	2,  // 73: mgmt.v1alpha1.TransformFormatPreservingEncrypt.algorithm:type_name -> mgmt.v1alpha1.FormatPreservingEncryptionAlgorithm
+	3,  // 74: mgmt.v1alpha1.TransformTimestamp.mode:type_name -> mgmt.v1alpha1.TimeTransformMode
+	4,  // 75: mgmt.v1alpha1.TransformTimestamp.truncate_precision:type_name -> mgmt.v1alpha1.TimeTruncatePrecision
+	3,  // 76: mgmt.v1alpha1.TransformDate.mode:type_name -> mgmt.v1alpha1.TimeTransformMode
+	4,  // 77: mgmt.v1alpha1.TransformDate.truncate_precision:type_name -> mgmt.v1alpha1.TimeTruncatePrecision
+	75, // 78: mgmt.v1alpha1.TransformJson.rules:type_name -> mgmt.v1alpha1.TransformJsonRule
+	0,  // 79: mgmt.v1alpha1.TransformJsonRule.source:type_name -> mgmt.v1alpha1.TransformerSource
+	26, // 80: mgmt.v1alpha1.TransformJsonRule.config:type_name -> mgmt.v1alpha1.TransformerConfig
+	5,  // 81: mgmt.v1alpha1.TransformFreeText.entity_types:type_name -> mgmt.v1alpha1.FreeTextEntityType
+	6,  // 82: mgmt.v1alpha1.TransformFreeText.strategy:type_name -> mgmt.v1alpha1.FreeTextReplacementStrategy
+	7,  // 83: mgmt.v1alpha1.GenerateIpAddress.version:type_name -> mgmt.v1alpha1.IpAddressVersion
+	74, // 84: mgmt.v1alpha1.ValidateTransformJsonConfigRequest.config:type_name -> mgmt.v1alpha1.TransformJson
+	2,  // 85: mgmt.v1alpha1.DecryptFormatPreservingRequest.algorithm:type_name -> mgmt.v1alpha1.FormatPreservingEncryptionAlgorithm
+	8,  // 86: mgmt.v1alpha1.TransformersService.GetSystemTransformers:input_type -> mgmt.v1alpha1.GetSystemTransformersRequest
+	10, // 87: mgmt.v1alpha1.TransformersService.GetSystemTransformerBySource:input_type -> mgmt.v1alpha1.GetSystemTransformerBySourceRequest
+	12, // 88: mgmt.v1alpha1.TransformersService.GetUserDefinedTransformers:input_type -> mgmt.v1alpha1.GetUserDefinedTransformersRequest
+	14, // 89: mgmt.v1alpha1.TransformersService.GetUserDefinedTransformerById:input_type -> mgmt.v1alpha1.GetUserDefinedTransformerByIdRequest
+	16, // 90: mgmt.v1alpha1.TransformersService.CreateUserDefinedTransformer:input_type -> mgmt.v1alpha1.CreateUserDefinedTransformerRequest
+	18, // 91: mgmt.v1alpha1.TransformersService.DeleteUserDefinedTransformer:input_type -> mgmt.v1alpha1.DeleteUserDefinedTransformerRequest
+	20, // 92: mgmt.v1alpha1.TransformersService.UpdateUserDefinedTransformer:input_type -> mgmt.v1alpha1.UpdateUserDefinedTransformerRequest
+	22, // 93: mgmt.v1alpha1.TransformersService.IsTransformerNameAvailable:input_type -> mgmt.v1alpha1.IsTransformerNameAvailableRequest
+	66, // 94: mgmt.v1alpha1.TransformersService.ValidateUserJavascriptCode:input_type -> mgmt.v1alpha1.ValidateUserJavascriptCodeRequest
+	85, // 95: mgmt.v1alpha1.TransformersService.ValidateUserRegexCode:input_type -> mgmt.v1alpha1.ValidateUserRegexCodeRequest
+	87, // 96: mgmt.v1alpha1.TransformersService.ValidateTransformJsonConfig:input_type -> mgmt.v1alpha1.ValidateTransformJsonConfigRequest
+	89, // 97: mgmt.v1alpha1.TransformersService.DecryptFormatPreserving:input_type -> mgmt.v1alpha1.DecryptFormatPreservingRequest
+	9,  // 98: mgmt.v1alpha1.TransformersService.GetSystemTransformers:output_type -> mgmt.v1alpha1.GetSystemTransformersResponse
+	11, // 99: mgmt.v1alpha1.TransformersService.GetSystemTransformerBySource:output_type -> mgmt.v1alpha1.GetSystemTransformerBySourceResponse
+	13, // 100: mgmt.v1alpha1.TransformersService.GetUserDefinedTransformers:output_type -> mgmt.v1alpha1.GetUserDefinedTransformersResponse
+	15, // 101: mgmt.v1alpha1.TransformersService.GetUserDefinedTransformerById:output_type -> mgmt.v1alpha1.GetUserDefinedTransformerByIdResponse
+	17, // 102: mgmt.v1alpha1.TransformersService.CreateUserDefinedTransformer:output_type -> mgmt.v1alpha1.CreateUserDefinedTransformerResponse
+	19, // 103: mgmt.v1alpha1.TransformersService.DeleteUserDefinedTransformer:output_type -> mgmt.v1alpha1.DeleteUserDefinedTransformerResponse
+	21, // 104: mgmt.v1alpha1.TransformersService.UpdateUserDefinedTransformer:output_type -> mgmt.v1alpha1.UpdateUserDefinedTransformerResponse
+	23, // 105: mgmt.v1alpha1.TransformersService.IsTransformerNameAvailable:output_type -> mgmt.v1alpha1.IsTransformerNameAvailableResponse
+	67, // 106: mgmt.v1alpha1.TransformersService.ValidateUserJavascriptCode:output_type -> mgmt.v1alpha1.ValidateUserJavascriptCodeResponse
+	86, // 107: mgmt.v1alpha1.TransformersService.ValidateUserRegexCode:output_type -> mgmt.v1alpha1.ValidateUserRegexCodeResponse
+	88, // 108: mgmt.v1alpha1.TransformersService.ValidateTransformJsonConfig:output_type -> mgmt.v1alpha1.ValidateTransformJsonConfigResponse
+	90, // 109: mgmt.v1alpha1.TransformersService.DecryptFormatPreserving:output_type -> mgmt.v1alpha1.DecryptFormatPreservingResponse
+	98, // [98:110] is the sub-list for method output_type
+	86, // [86:98] is the sub-list for method input_type
+	86, // [86:86] is the sub-list for extension type_name
+	86, // [86:86] is the sub-list for extension extendee
+	0,  // [0:86] is the sub-list for field type_name
 }
 
 func init() { file_mgmt_v1alpha1_transformer_proto_init() }
@@ -5765,7 +8241,7 @@ func file_mgmt_v1alpha1_transformer_proto_init() {
 			}
 		}
 		file_mgmt_v1alpha1_transformer_proto_msgTypes[63].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ValidateUserRegexCodeRequest); i {
+			switch v := v.(*TransformFormatPreservingEncrypt); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5777,6 +8253,174 @@ func file_mgmt_v1alpha1_transformer_proto_init() {
 			}
 		}
 		file_mgmt_v1alpha1_transformer_proto_msgTypes[64].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TransformTimestamp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mgmt_v1alpha1_transformer_proto_msgTypes[65].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TransformDate); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mgmt_v1alpha1_transformer_proto_msgTypes[66].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TransformJson); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mgmt_v1alpha1_transformer_proto_msgTypes[67].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TransformJsonRule); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mgmt_v1alpha1_transformer_proto_msgTypes[68].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TransformFreeText); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mgmt_v1alpha1_transformer_proto_msgTypes[69].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GenerateIpAddress); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mgmt_v1alpha1_transformer_proto_msgTypes[70].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TransformIpAddress); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mgmt_v1alpha1_transformer_proto_msgTypes[71].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GenerateMacAddress); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mgmt_v1alpha1_transformer_proto_msgTypes[72].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TransformMacAddress); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mgmt_v1alpha1_transformer_proto_msgTypes[73].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GenerateUrl); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mgmt_v1alpha1_transformer_proto_msgTypes[74].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TransformUrl); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mgmt_v1alpha1_transformer_proto_msgTypes[75].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GenerateDomain); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mgmt_v1alpha1_transformer_proto_msgTypes[76].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TransformDomain); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mgmt_v1alpha1_transformer_proto_msgTypes[77].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ValidateUserRegexCodeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mgmt_v1alpha1_transformer_proto_msgTypes[78].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ValidateUserRegexCodeResponse); i {
 			case 0:
 				return &v.state
@@ -5788,6 +8432,54 @@ func file_mgmt_v1alpha1_transformer_proto_init() {
 				return nil
 			}
 		}
+		file_mgmt_v1alpha1_transformer_proto_msgTypes[79].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ValidateTransformJsonConfigRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mgmt_v1alpha1_transformer_proto_msgTypes[80].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ValidateTransformJsonConfigResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mgmt_v1alpha1_transformer_proto_msgTypes[81].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DecryptFormatPreservingRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mgmt_v1alpha1_transformer_proto_msgTypes[82].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DecryptFormatPreservingResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	file_mgmt_v1alpha1_transformer_proto_msgTypes[18].OneofWrappers = []interface{}{
 		(*TransformerConfig_GenerateEmailConfig)(nil),
@@ -5832,15 +8524,44 @@ func file_mgmt_v1alpha1_transformer_proto_init() {
 		(*TransformerConfig_GenerateCategoricalConfig)(nil),
 		(*TransformerConfig_TransformCharacterScrambleConfig)(nil),
 		(*TransformerConfig_GenerateJavascriptConfig)(nil),
-	}
+		(*TransformerConfig_TransformFormatPreservingEncryptConfig)(nil),
+		(*TransformerConfig_TransformTimestampConfig)(nil),
+		(*TransformerConfig_TransformDateConfig)(nil),
+		(*TransformerConfig_TransformJsonConfig)(nil),
+		(*TransformerConfig_TransformFreeTextConfig)(nil),
+		(*TransformerConfig_GenerateIpAddressConfig)(nil),
+		(*TransformerConfig_TransformIpAddressConfig)(nil),
+		(*TransformerConfig_GenerateMacAddressConfig)(nil),
+		(*TransformerConfig_TransformMacAddressConfig)(nil),
+		(*TransformerConfig_GenerateUrlConfig)(nil),
+		(*TransformerConfig_TransformUrlConfig)(nil),
+		(*TransformerConfig_GenerateDomainConfig)(nil),
+		(*TransformerConfig_TransformDomainConfig)(nil),
+	}
+	file_mgmt_v1alpha1_transformer_proto_msgTypes[23].OneofWrappers = []interface{}{}
+	file_mgmt_v1alpha1_transformer_proto_msgTypes[25].OneofWrappers = []interface{}{}
+	file_mgmt_v1alpha1_transformer_proto_msgTypes[26].OneofWrappers = []interface{}{}
+	file_mgmt_v1alpha1_transformer_proto_msgTypes[28].OneofWrappers = []interface{}{}
+	file_mgmt_v1alpha1_transformer_proto_msgTypes[29].OneofWrappers = []interface{}{}
+	file_mgmt_v1alpha1_transformer_proto_msgTypes[33].OneofWrappers = []interface{}{}
+	file_mgmt_v1alpha1_transformer_proto_msgTypes[36].OneofWrappers = []interface{}{}
+	file_mgmt_v1alpha1_transformer_proto_msgTypes[37].OneofWrappers = []interface{}{}
+	file_mgmt_v1alpha1_transformer_proto_msgTypes[38].OneofWrappers = []interface{}{}
+	file_mgmt_v1alpha1_transformer_proto_msgTypes[44].OneofWrappers = []interface{}{}
+	file_mgmt_v1alpha1_transformer_proto_msgTypes[46].OneofWrappers = []interface{}{}
+	file_mgmt_v1alpha1_transformer_proto_msgTypes[48].OneofWrappers = []interface{}{}
+	file_mgmt_v1alpha1_transformer_proto_msgTypes[51].OneofWrappers = []interface{}{}
+	file_mgmt_v1alpha1_transformer_proto_msgTypes[52].OneofWrappers = []interface{}{}
 	file_mgmt_v1alpha1_transformer_proto_msgTypes[61].OneofWrappers = []interface{}{}
+	file_mgmt_v1alpha1_transformer_proto_msgTypes[64].OneofWrappers = []interface{}{}
+	file_mgmt_v1alpha1_transformer_proto_msgTypes[65].OneofWrappers = []interface{}{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_mgmt_v1alpha1_transformer_proto_rawDesc,
-			NumEnums:      2,
-			NumMessages:   65,
+			NumEnums:      8,
+			NumMessages:   83,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -4068,24 +4068,2344 @@ func (m *TransformerConfig) validate(all bool) error {
 			}
 		}
 
+	case *TransformerConfig_TransformFormatPreservingEncryptConfig:
+		if v == nil {
+			err := TransformerConfigValidationError{
+				field:  "Config",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetTransformFormatPreservingEncryptConfig()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, TransformerConfigValidationError{
+						field:  "TransformFormatPreservingEncryptConfig",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, TransformerConfigValidationError{
+						field:  "TransformFormatPreservingEncryptConfig",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetTransformFormatPreservingEncryptConfig()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return TransformerConfigValidationError{
+					field:  "TransformFormatPreservingEncryptConfig",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *TransformerConfig_TransformTimestampConfig:
+		if v == nil {
+			err := TransformerConfigValidationError{
+				field:  "Config",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetTransformTimestampConfig()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, TransformerConfigValidationError{
+						field:  "TransformTimestampConfig",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, TransformerConfigValidationError{
+						field:  "TransformTimestampConfig",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetTransformTimestampConfig()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return TransformerConfigValidationError{
+					field:  "TransformTimestampConfig",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *TransformerConfig_TransformDateConfig:
+		if v == nil {
+			err := TransformerConfigValidationError{
+				field:  "Config",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetTransformDateConfig()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, TransformerConfigValidationError{
+						field:  "TransformDateConfig",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, TransformerConfigValidationError{
+						field:  "TransformDateConfig",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetTransformDateConfig()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return TransformerConfigValidationError{
+					field:  "TransformDateConfig",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *TransformerConfig_TransformJsonConfig:
+		if v == nil {
+			err := TransformerConfigValidationError{
+				field:  "Config",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetTransformJsonConfig()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, TransformerConfigValidationError{
+						field:  "TransformJsonConfig",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, TransformerConfigValidationError{
+						field:  "TransformJsonConfig",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetTransformJsonConfig()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return TransformerConfigValidationError{
+					field:  "TransformJsonConfig",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *TransformerConfig_TransformFreeTextConfig:
+		if v == nil {
+			err := TransformerConfigValidationError{
+				field:  "Config",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetTransformFreeTextConfig()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, TransformerConfigValidationError{
+						field:  "TransformFreeTextConfig",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, TransformerConfigValidationError{
+						field:  "TransformFreeTextConfig",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetTransformFreeTextConfig()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return TransformerConfigValidationError{
+					field:  "TransformFreeTextConfig",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *TransformerConfig_GenerateIpAddressConfig:
+		if v == nil {
+			err := TransformerConfigValidationError{
+				field:  "Config",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetGenerateIpAddressConfig()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, TransformerConfigValidationError{
+						field:  "GenerateIpAddressConfig",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, TransformerConfigValidationError{
+						field:  "GenerateIpAddressConfig",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetGenerateIpAddressConfig()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return TransformerConfigValidationError{
+					field:  "GenerateIpAddressConfig",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *TransformerConfig_TransformIpAddressConfig:
+		if v == nil {
+			err := TransformerConfigValidationError{
+				field:  "Config",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetTransformIpAddressConfig()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, TransformerConfigValidationError{
+						field:  "TransformIpAddressConfig",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, TransformerConfigValidationError{
+						field:  "TransformIpAddressConfig",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetTransformIpAddressConfig()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return TransformerConfigValidationError{
+					field:  "TransformIpAddressConfig",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *TransformerConfig_GenerateMacAddressConfig:
+		if v == nil {
+			err := TransformerConfigValidationError{
+				field:  "Config",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetGenerateMacAddressConfig()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, TransformerConfigValidationError{
+						field:  "GenerateMacAddressConfig",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, TransformerConfigValidationError{
+						field:  "GenerateMacAddressConfig",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetGenerateMacAddressConfig()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return TransformerConfigValidationError{
+					field:  "GenerateMacAddressConfig",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *TransformerConfig_TransformMacAddressConfig:
+		if v == nil {
+			err := TransformerConfigValidationError{
+				field:  "Config",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetTransformMacAddressConfig()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, TransformerConfigValidationError{
+						field:  "TransformMacAddressConfig",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, TransformerConfigValidationError{
+						field:  "TransformMacAddressConfig",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetTransformMacAddressConfig()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return TransformerConfigValidationError{
+					field:  "TransformMacAddressConfig",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *TransformerConfig_GenerateUrlConfig:
+		if v == nil {
+			err := TransformerConfigValidationError{
+				field:  "Config",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetGenerateUrlConfig()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, TransformerConfigValidationError{
+						field:  "GenerateUrlConfig",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, TransformerConfigValidationError{
+						field:  "GenerateUrlConfig",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetGenerateUrlConfig()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return TransformerConfigValidationError{
+					field:  "GenerateUrlConfig",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *TransformerConfig_TransformUrlConfig:
+		if v == nil {
+			err := TransformerConfigValidationError{
+				field:  "Config",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetTransformUrlConfig()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, TransformerConfigValidationError{
+						field:  "TransformUrlConfig",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, TransformerConfigValidationError{
+						field:  "TransformUrlConfig",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetTransformUrlConfig()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return TransformerConfigValidationError{
+					field:  "TransformUrlConfig",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *TransformerConfig_GenerateDomainConfig:
+		if v == nil {
+			err := TransformerConfigValidationError{
+				field:  "Config",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetGenerateDomainConfig()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, TransformerConfigValidationError{
+						field:  "GenerateDomainConfig",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, TransformerConfigValidationError{
+						field:  "GenerateDomainConfig",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetGenerateDomainConfig()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return TransformerConfigValidationError{
+					field:  "GenerateDomainConfig",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *TransformerConfig_TransformDomainConfig:
+		if v == nil {
+			err := TransformerConfigValidationError{
+				field:  "Config",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetTransformDomainConfig()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, TransformerConfigValidationError{
+						field:  "TransformDomainConfig",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, TransformerConfigValidationError{
+						field:  "TransformDomainConfig",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetTransformDomainConfig()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return TransformerConfigValidationError{
+					field:  "TransformDomainConfig",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
 	default:
 		_ = v // ensures v is used
 	}
 
 	if len(errors) > 0 {
-		return TransformerConfigMultiError(errors)
+		return TransformerConfigMultiError(errors)
+	}
+
+	return nil
+}
+
+// TransformerConfigMultiError is an error wrapping multiple validation errors
+// returned by TransformerConfig.ValidateAll() if the designated constraints
+// aren't met.
+type TransformerConfigMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TransformerConfigMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TransformerConfigMultiError) AllErrors() []error { return m }
+
+// TransformerConfigValidationError is the validation error returned by
+// TransformerConfig.Validate if the designated constraints aren't met.
+type TransformerConfigValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TransformerConfigValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TransformerConfigValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TransformerConfigValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TransformerConfigValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TransformerConfigValidationError) ErrorName() string {
+	return "TransformerConfigValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e TransformerConfigValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTransformerConfig.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TransformerConfigValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TransformerConfigValidationError{}
+
+// Validate checks the field values on GenerateEmail with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *GenerateEmail) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GenerateEmail with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in GenerateEmailMultiError, or
+// nil if none found.
+func (m *GenerateEmail) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GenerateEmail) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return GenerateEmailMultiError(errors)
+	}
+
+	return nil
+}
+
+// GenerateEmailMultiError is an error wrapping multiple validation errors
+// returned by GenerateEmail.ValidateAll() if the designated constraints
+// aren't met.
+type GenerateEmailMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GenerateEmailMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GenerateEmailMultiError) AllErrors() []error { return m }
+
+// GenerateEmailValidationError is the validation error returned by
+// GenerateEmail.Validate if the designated constraints aren't met.
+type GenerateEmailValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GenerateEmailValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GenerateEmailValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GenerateEmailValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GenerateEmailValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GenerateEmailValidationError) ErrorName() string { return "GenerateEmailValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GenerateEmailValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGenerateEmail.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GenerateEmailValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GenerateEmailValidationError{}
+
+// Validate checks the field values on TransformEmail with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *TransformEmail) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on TransformEmail with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in TransformEmailMultiError,
+// or nil if none found.
+func (m *TransformEmail) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *TransformEmail) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for PreserveDomain
+
+	// no validation rules for PreserveLength
+
+	// no validation rules for Deterministic
+
+	if len(errors) > 0 {
+		return TransformEmailMultiError(errors)
+	}
+
+	return nil
+}
+
+// TransformEmailMultiError is an error wrapping multiple validation errors
+// returned by TransformEmail.ValidateAll() if the designated constraints
+// aren't met.
+type TransformEmailMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TransformEmailMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TransformEmailMultiError) AllErrors() []error { return m }
+
+// TransformEmailValidationError is the validation error returned by
+// TransformEmail.Validate if the designated constraints aren't met.
+type TransformEmailValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TransformEmailValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TransformEmailValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TransformEmailValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TransformEmailValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TransformEmailValidationError) ErrorName() string { return "TransformEmailValidationError" }
+
+// Error satisfies the builtin error interface
+func (e TransformEmailValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTransformEmail.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TransformEmailValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TransformEmailValidationError{}
+
+// Validate checks the field values on GenerateBool with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *GenerateBool) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GenerateBool with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in GenerateBoolMultiError, or
+// nil if none found.
+func (m *GenerateBool) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GenerateBool) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return GenerateBoolMultiError(errors)
+	}
+
+	return nil
+}
+
+// GenerateBoolMultiError is an error wrapping multiple validation errors
+// returned by GenerateBool.ValidateAll() if the designated constraints aren't met.
+type GenerateBoolMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GenerateBoolMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GenerateBoolMultiError) AllErrors() []error { return m }
+
+// GenerateBoolValidationError is the validation error returned by
+// GenerateBool.Validate if the designated constraints aren't met.
+type GenerateBoolValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GenerateBoolValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GenerateBoolValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GenerateBoolValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GenerateBoolValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GenerateBoolValidationError) ErrorName() string { return "GenerateBoolValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GenerateBoolValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGenerateBool.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GenerateBoolValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GenerateBoolValidationError{}
+
+// Validate checks the field values on GenerateCardNumber with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GenerateCardNumber) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GenerateCardNumber with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GenerateCardNumberMultiError, or nil if none found.
+func (m *GenerateCardNumber) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GenerateCardNumber) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ValidLuhn
+
+	if len(errors) > 0 {
+		return GenerateCardNumberMultiError(errors)
+	}
+
+	return nil
+}
+
+// GenerateCardNumberMultiError is an error wrapping multiple validation errors
+// returned by GenerateCardNumber.ValidateAll() if the designated constraints
+// aren't met.
+type GenerateCardNumberMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GenerateCardNumberMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GenerateCardNumberMultiError) AllErrors() []error { return m }
+
+// GenerateCardNumberValidationError is the validation error returned by
+// GenerateCardNumber.Validate if the designated constraints aren't met.
+type GenerateCardNumberValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GenerateCardNumberValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GenerateCardNumberValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GenerateCardNumberValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GenerateCardNumberValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GenerateCardNumberValidationError) ErrorName() string {
+	return "GenerateCardNumberValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GenerateCardNumberValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGenerateCardNumber.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GenerateCardNumberValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GenerateCardNumberValidationError{}
+
+// Validate checks the field values on GenerateCity with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *GenerateCity) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GenerateCity with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in GenerateCityMultiError, or
+// nil if none found.
+func (m *GenerateCity) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GenerateCity) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.Locale != nil {
+		// no validation rules for Locale
+	}
+
+	if m.LocaleColumn != nil {
+		// no validation rules for LocaleColumn
+	}
+
+	if len(errors) > 0 {
+		return GenerateCityMultiError(errors)
+	}
+
+	return nil
+}
+
+// GenerateCityMultiError is an error wrapping multiple validation errors
+// returned by GenerateCity.ValidateAll() if the designated constraints aren't met.
+type GenerateCityMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GenerateCityMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GenerateCityMultiError) AllErrors() []error { return m }
+
+// GenerateCityValidationError is the validation error returned by
+// GenerateCity.Validate if the designated constraints aren't met.
+type GenerateCityValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GenerateCityValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GenerateCityValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GenerateCityValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GenerateCityValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GenerateCityValidationError) ErrorName() string { return "GenerateCityValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GenerateCityValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGenerateCity.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GenerateCityValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GenerateCityValidationError{}
+
+// Validate checks the field values on GenerateDefault with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *GenerateDefault) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GenerateDefault with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GenerateDefaultMultiError, or nil if none found.
+func (m *GenerateDefault) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GenerateDefault) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return GenerateDefaultMultiError(errors)
+	}
+
+	return nil
+}
+
+// GenerateDefaultMultiError is an error wrapping multiple validation errors
+// returned by GenerateDefault.ValidateAll() if the designated constraints
+// aren't met.
+type GenerateDefaultMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GenerateDefaultMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GenerateDefaultMultiError) AllErrors() []error { return m }
+
+// GenerateDefaultValidationError is the validation error returned by
+// GenerateDefault.Validate if the designated constraints aren't met.
+type GenerateDefaultValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GenerateDefaultValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GenerateDefaultValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GenerateDefaultValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GenerateDefaultValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GenerateDefaultValidationError) ErrorName() string { return "GenerateDefaultValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GenerateDefaultValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGenerateDefault.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GenerateDefaultValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GenerateDefaultValidationError{}
+
+// Validate checks the field values on GenerateE164PhoneNumber with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GenerateE164PhoneNumber) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GenerateE164PhoneNumber with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GenerateE164PhoneNumberMultiError, or nil if none found.
+func (m *GenerateE164PhoneNumber) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GenerateE164PhoneNumber) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Min
+
+	// no validation rules for Max
+
+	if m.Locale != nil {
+		// no validation rules for Locale
+	}
+
+	if m.LocaleColumn != nil {
+		// no validation rules for LocaleColumn
+	}
+
+	if len(errors) > 0 {
+		return GenerateE164PhoneNumberMultiError(errors)
+	}
+
+	return nil
+}
+
+// GenerateE164PhoneNumberMultiError is an error wrapping multiple validation
+// errors returned by GenerateE164PhoneNumber.ValidateAll() if the designated
+// constraints aren't met.
+type GenerateE164PhoneNumberMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GenerateE164PhoneNumberMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GenerateE164PhoneNumberMultiError) AllErrors() []error { return m }
+
+// GenerateE164PhoneNumberValidationError is the validation error returned by
+// GenerateE164PhoneNumber.Validate if the designated constraints aren't met.
+type GenerateE164PhoneNumberValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GenerateE164PhoneNumberValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GenerateE164PhoneNumberValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GenerateE164PhoneNumberValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GenerateE164PhoneNumberValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GenerateE164PhoneNumberValidationError) ErrorName() string {
+	return "GenerateE164PhoneNumberValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GenerateE164PhoneNumberValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGenerateE164PhoneNumber.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GenerateE164PhoneNumberValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GenerateE164PhoneNumberValidationError{}
+
+// Validate checks the field values on GenerateFirstName with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *GenerateFirstName) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GenerateFirstName with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GenerateFirstNameMultiError, or nil if none found.
+func (m *GenerateFirstName) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GenerateFirstName) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.Locale != nil {
+		// no validation rules for Locale
+	}
+
+	if m.LocaleColumn != nil {
+		// no validation rules for LocaleColumn
+	}
+
+	if len(errors) > 0 {
+		return GenerateFirstNameMultiError(errors)
+	}
+
+	return nil
+}
+
+// GenerateFirstNameMultiError is an error wrapping multiple validation errors
+// returned by GenerateFirstName.ValidateAll() if the designated constraints
+// aren't met.
+type GenerateFirstNameMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GenerateFirstNameMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GenerateFirstNameMultiError) AllErrors() []error { return m }
+
+// GenerateFirstNameValidationError is the validation error returned by
+// GenerateFirstName.Validate if the designated constraints aren't met.
+type GenerateFirstNameValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GenerateFirstNameValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GenerateFirstNameValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GenerateFirstNameValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GenerateFirstNameValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GenerateFirstNameValidationError) ErrorName() string {
+	return "GenerateFirstNameValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GenerateFirstNameValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGenerateFirstName.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GenerateFirstNameValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GenerateFirstNameValidationError{}
+
+// Validate checks the field values on GenerateFloat64 with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *GenerateFloat64) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GenerateFloat64 with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GenerateFloat64MultiError, or nil if none found.
+func (m *GenerateFloat64) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GenerateFloat64) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for RandomizeSign
+
+	// no validation rules for Min
+
+	// no validation rules for Max
+
+	// no validation rules for Precision
+
+	if len(errors) > 0 {
+		return GenerateFloat64MultiError(errors)
+	}
+
+	return nil
+}
+
+// GenerateFloat64MultiError is an error wrapping multiple validation errors
+// returned by GenerateFloat64.ValidateAll() if the designated constraints
+// aren't met.
+type GenerateFloat64MultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GenerateFloat64MultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GenerateFloat64MultiError) AllErrors() []error { return m }
+
+// GenerateFloat64ValidationError is the validation error returned by
+// GenerateFloat64.Validate if the designated constraints aren't met.
+type GenerateFloat64ValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GenerateFloat64ValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GenerateFloat64ValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GenerateFloat64ValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GenerateFloat64ValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GenerateFloat64ValidationError) ErrorName() string { return "GenerateFloat64ValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GenerateFloat64ValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGenerateFloat64.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GenerateFloat64ValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GenerateFloat64ValidationError{}
+
+// Validate checks the field values on GenerateFullAddress with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GenerateFullAddress) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GenerateFullAddress with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GenerateFullAddressMultiError, or nil if none found.
+func (m *GenerateFullAddress) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GenerateFullAddress) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.Locale != nil {
+		// no validation rules for Locale
+	}
+
+	if m.LocaleColumn != nil {
+		// no validation rules for LocaleColumn
+	}
+
+	if len(errors) > 0 {
+		return GenerateFullAddressMultiError(errors)
+	}
+
+	return nil
+}
+
+// GenerateFullAddressMultiError is an error wrapping multiple validation
+// errors returned by GenerateFullAddress.ValidateAll() if the designated
+// constraints aren't met.
+type GenerateFullAddressMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GenerateFullAddressMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GenerateFullAddressMultiError) AllErrors() []error { return m }
+
+// GenerateFullAddressValidationError is the validation error returned by
+// GenerateFullAddress.Validate if the designated constraints aren't met.
+type GenerateFullAddressValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GenerateFullAddressValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GenerateFullAddressValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GenerateFullAddressValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GenerateFullAddressValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GenerateFullAddressValidationError) ErrorName() string {
+	return "GenerateFullAddressValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GenerateFullAddressValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGenerateFullAddress.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GenerateFullAddressValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GenerateFullAddressValidationError{}
+
+// Validate checks the field values on GenerateFullName with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *GenerateFullName) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GenerateFullName with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GenerateFullNameMultiError, or nil if none found.
+func (m *GenerateFullName) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GenerateFullName) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.Locale != nil {
+		// no validation rules for Locale
+	}
+
+	if m.LocaleColumn != nil {
+		// no validation rules for LocaleColumn
+	}
+
+	if len(errors) > 0 {
+		return GenerateFullNameMultiError(errors)
+	}
+
+	return nil
+}
+
+// GenerateFullNameMultiError is an error wrapping multiple validation errors
+// returned by GenerateFullName.ValidateAll() if the designated constraints
+// aren't met.
+type GenerateFullNameMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GenerateFullNameMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GenerateFullNameMultiError) AllErrors() []error { return m }
+
+// GenerateFullNameValidationError is the validation error returned by
+// GenerateFullName.Validate if the designated constraints aren't met.
+type GenerateFullNameValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GenerateFullNameValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GenerateFullNameValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GenerateFullNameValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GenerateFullNameValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GenerateFullNameValidationError) ErrorName() string { return "GenerateFullNameValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GenerateFullNameValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGenerateFullName.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GenerateFullNameValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GenerateFullNameValidationError{}
+
+// Validate checks the field values on GenerateGender with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *GenerateGender) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GenerateGender with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in GenerateGenderMultiError,
+// or nil if none found.
+func (m *GenerateGender) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GenerateGender) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Abbreviate
+
+	if len(errors) > 0 {
+		return GenerateGenderMultiError(errors)
+	}
+
+	return nil
+}
+
+// GenerateGenderMultiError is an error wrapping multiple validation errors
+// returned by GenerateGender.ValidateAll() if the designated constraints
+// aren't met.
+type GenerateGenderMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GenerateGenderMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GenerateGenderMultiError) AllErrors() []error { return m }
+
+// GenerateGenderValidationError is the validation error returned by
+// GenerateGender.Validate if the designated constraints aren't met.
+type GenerateGenderValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GenerateGenderValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GenerateGenderValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GenerateGenderValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GenerateGenderValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GenerateGenderValidationError) ErrorName() string { return "GenerateGenderValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GenerateGenderValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGenerateGender.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GenerateGenderValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GenerateGenderValidationError{}
+
+// Validate checks the field values on GenerateInt64PhoneNumber with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GenerateInt64PhoneNumber) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GenerateInt64PhoneNumber with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GenerateInt64PhoneNumberMultiError, or nil if none found.
+func (m *GenerateInt64PhoneNumber) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GenerateInt64PhoneNumber) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return GenerateInt64PhoneNumberMultiError(errors)
+	}
+
+	return nil
+}
+
+// GenerateInt64PhoneNumberMultiError is an error wrapping multiple validation
+// errors returned by GenerateInt64PhoneNumber.ValidateAll() if the designated
+// constraints aren't met.
+type GenerateInt64PhoneNumberMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GenerateInt64PhoneNumberMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GenerateInt64PhoneNumberMultiError) AllErrors() []error { return m }
+
+// GenerateInt64PhoneNumberValidationError is the validation error returned by
+// GenerateInt64PhoneNumber.Validate if the designated constraints aren't met.
+type GenerateInt64PhoneNumberValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GenerateInt64PhoneNumberValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GenerateInt64PhoneNumberValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GenerateInt64PhoneNumberValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GenerateInt64PhoneNumberValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GenerateInt64PhoneNumberValidationError) ErrorName() string {
+	return "GenerateInt64PhoneNumberValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GenerateInt64PhoneNumberValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGenerateInt64PhoneNumber.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GenerateInt64PhoneNumberValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GenerateInt64PhoneNumberValidationError{}
+
+// Validate checks the field values on GenerateInt64 with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *GenerateInt64) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GenerateInt64 with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in GenerateInt64MultiError, or
+// nil if none found.
+func (m *GenerateInt64) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GenerateInt64) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for RandomizeSign
+
+	// no validation rules for Min
+
+	// no validation rules for Max
+
+	if len(errors) > 0 {
+		return GenerateInt64MultiError(errors)
+	}
+
+	return nil
+}
+
+// GenerateInt64MultiError is an error wrapping multiple validation errors
+// returned by GenerateInt64.ValidateAll() if the designated constraints
+// aren't met.
+type GenerateInt64MultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GenerateInt64MultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GenerateInt64MultiError) AllErrors() []error { return m }
+
+// GenerateInt64ValidationError is the validation error returned by
+// GenerateInt64.Validate if the designated constraints aren't met.
+type GenerateInt64ValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GenerateInt64ValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GenerateInt64ValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GenerateInt64ValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GenerateInt64ValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GenerateInt64ValidationError) ErrorName() string { return "GenerateInt64ValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GenerateInt64ValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGenerateInt64.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GenerateInt64ValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GenerateInt64ValidationError{}
+
+// Validate checks the field values on GenerateLastName with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *GenerateLastName) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GenerateLastName with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GenerateLastNameMultiError, or nil if none found.
+func (m *GenerateLastName) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GenerateLastName) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.Locale != nil {
+		// no validation rules for Locale
+	}
+
+	if m.LocaleColumn != nil {
+		// no validation rules for LocaleColumn
+	}
+
+	if len(errors) > 0 {
+		return GenerateLastNameMultiError(errors)
+	}
+
+	return nil
+}
+
+// GenerateLastNameMultiError is an error wrapping multiple validation errors
+// returned by GenerateLastName.ValidateAll() if the designated constraints
+// aren't met.
+type GenerateLastNameMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GenerateLastNameMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GenerateLastNameMultiError) AllErrors() []error { return m }
+
+// GenerateLastNameValidationError is the validation error returned by
+// GenerateLastName.Validate if the designated constraints aren't met.
+type GenerateLastNameValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GenerateLastNameValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GenerateLastNameValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GenerateLastNameValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GenerateLastNameValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GenerateLastNameValidationError) ErrorName() string { return "GenerateLastNameValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GenerateLastNameValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGenerateLastName.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GenerateLastNameValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GenerateLastNameValidationError{}
+
+// Validate checks the field values on GenerateSha256Hash with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GenerateSha256Hash) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GenerateSha256Hash with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GenerateSha256HashMultiError, or nil if none found.
+func (m *GenerateSha256Hash) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GenerateSha256Hash) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return GenerateSha256HashMultiError(errors)
+	}
+
+	return nil
+}
+
+// GenerateSha256HashMultiError is an error wrapping multiple validation errors
+// returned by GenerateSha256Hash.ValidateAll() if the designated constraints
+// aren't met.
+type GenerateSha256HashMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GenerateSha256HashMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GenerateSha256HashMultiError) AllErrors() []error { return m }
+
+// GenerateSha256HashValidationError is the validation error returned by
+// GenerateSha256Hash.Validate if the designated constraints aren't met.
+type GenerateSha256HashValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GenerateSha256HashValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GenerateSha256HashValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GenerateSha256HashValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GenerateSha256HashValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GenerateSha256HashValidationError) ErrorName() string {
+	return "GenerateSha256HashValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GenerateSha256HashValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGenerateSha256Hash.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GenerateSha256HashValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GenerateSha256HashValidationError{}
+
+// Validate checks the field values on GenerateSSN with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *GenerateSSN) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GenerateSSN with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in GenerateSSNMultiError, or
+// nil if none found.
+func (m *GenerateSSN) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GenerateSSN) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return GenerateSSNMultiError(errors)
 	}
 
 	return nil
 }
 
-// TransformerConfigMultiError is an error wrapping multiple validation errors
-// returned by TransformerConfig.ValidateAll() if the designated constraints
-// aren't met.
-type TransformerConfigMultiError []error
+// GenerateSSNMultiError is an error wrapping multiple validation errors
+// returned by GenerateSSN.ValidateAll() if the designated constraints aren't met.
+type GenerateSSNMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m TransformerConfigMultiError) Error() string {
+func (m GenerateSSNMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -4094,11 +6414,11 @@ func (m TransformerConfigMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m TransformerConfigMultiError) AllErrors() []error { return m }
+func (m GenerateSSNMultiError) AllErrors() []error { return m }
 
-// TransformerConfigValidationError is the validation error returned by
-// TransformerConfig.Validate if the designated constraints aren't met.
-type TransformerConfigValidationError struct {
+// GenerateSSNValidationError is the validation error returned by
+// GenerateSSN.Validate if the designated constraints aren't met.
+type GenerateSSNValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -4106,24 +6426,22 @@ type TransformerConfigValidationError struct {
 }
 
 // Field function returns field value.
-func (e TransformerConfigValidationError) Field() string { return e.field }
+func (e GenerateSSNValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e TransformerConfigValidationError) Reason() string { return e.reason }
+func (e GenerateSSNValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e TransformerConfigValidationError) Cause() error { return e.cause }
+func (e GenerateSSNValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e TransformerConfigValidationError) Key() bool { return e.key }
+func (e GenerateSSNValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e TransformerConfigValidationError) ErrorName() string {
-	return "TransformerConfigValidationError"
-}
+func (e GenerateSSNValidationError) ErrorName() string { return "GenerateSSNValidationError" }
 
 // Error satisfies the builtin error interface
-func (e TransformerConfigValidationError) Error() string {
+func (e GenerateSSNValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -4135,14 +6453,14 @@ func (e TransformerConfigValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sTransformerConfig.%s: %s%s",
+		"invalid %sGenerateSSN.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = TransformerConfigValidationError{}
+var _ error = GenerateSSNValidationError{}
 
 var _ interface {
 	Field() string
@@ -4150,44 +6468,52 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = TransformerConfigValidationError{}
+} = GenerateSSNValidationError{}
 
-// Validate checks the field values on GenerateEmail with the rules defined in
+// Validate checks the field values on GenerateState with the rules defined in
 // the proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
-func (m *GenerateEmail) Validate() error {
+func (m *GenerateState) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GenerateEmail with the rules defined
+// ValidateAll checks the field values on GenerateState with the rules defined
 // in the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in GenerateEmailMultiError, or
+// result is a list of violation errors wrapped in GenerateStateMultiError, or
 // nil if none found.
-func (m *GenerateEmail) ValidateAll() error {
+func (m *GenerateState) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GenerateEmail) validate(all bool) error {
+func (m *GenerateState) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	if m.Locale != nil {
+		// no validation rules for Locale
+	}
+
+	if m.LocaleColumn != nil {
+		// no validation rules for LocaleColumn
+	}
+
 	if len(errors) > 0 {
-		return GenerateEmailMultiError(errors)
+		return GenerateStateMultiError(errors)
 	}
 
 	return nil
 }
 
-// GenerateEmailMultiError is an error wrapping multiple validation errors
-// returned by GenerateEmail.ValidateAll() if the designated constraints
+// GenerateStateMultiError is an error wrapping multiple validation errors
+// returned by GenerateState.ValidateAll() if the designated constraints
 // aren't met.
-type GenerateEmailMultiError []error
+type GenerateStateMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GenerateEmailMultiError) Error() string {
+func (m GenerateStateMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -4196,11 +6522,11 @@ func (m GenerateEmailMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GenerateEmailMultiError) AllErrors() []error { return m }
+func (m GenerateStateMultiError) AllErrors() []error { return m }
 
-// GenerateEmailValidationError is the validation error returned by
-// GenerateEmail.Validate if the designated constraints aren't met.
-type GenerateEmailValidationError struct {
+// GenerateStateValidationError is the validation error returned by
+// GenerateState.Validate if the designated constraints aren't met.
+type GenerateStateValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -4208,22 +6534,22 @@ type GenerateEmailValidationError struct {
 }
 
 // Field function returns field value.
-func (e GenerateEmailValidationError) Field() string { return e.field }
+func (e GenerateStateValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GenerateEmailValidationError) Reason() string { return e.reason }
+func (e GenerateStateValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GenerateEmailValidationError) Cause() error { return e.cause }
+func (e GenerateStateValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GenerateEmailValidationError) Key() bool { return e.key }
+func (e GenerateStateValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GenerateEmailValidationError) ErrorName() string { return "GenerateEmailValidationError" }
+func (e GenerateStateValidationError) ErrorName() string { return "GenerateStateValidationError" }
 
 // Error satisfies the builtin error interface
-func (e GenerateEmailValidationError) Error() string {
+func (e GenerateStateValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -4235,14 +6561,14 @@ func (e GenerateEmailValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGenerateEmail.%s: %s%s",
+		"invalid %sGenerateState.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GenerateEmailValidationError{}
+var _ error = GenerateStateValidationError{}
 
 var _ interface {
 	Field() string
@@ -4250,48 +6576,166 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GenerateEmailValidationError{}
+} = GenerateStateValidationError{}
 
-// Validate checks the field values on TransformEmail with the rules defined in
-// the proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *TransformEmail) Validate() error {
+// Validate checks the field values on GenerateStreetAddress with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GenerateStreetAddress) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on TransformEmail with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in TransformEmailMultiError,
-// or nil if none found.
-func (m *TransformEmail) ValidateAll() error {
+// ValidateAll checks the field values on GenerateStreetAddress with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GenerateStreetAddressMultiError, or nil if none found.
+func (m *GenerateStreetAddress) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GenerateStreetAddress) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.Locale != nil {
+		// no validation rules for Locale
+	}
+
+	if m.LocaleColumn != nil {
+		// no validation rules for LocaleColumn
+	}
+
+	if len(errors) > 0 {
+		return GenerateStreetAddressMultiError(errors)
+	}
+
+	return nil
+}
+
+// GenerateStreetAddressMultiError is an error wrapping multiple validation
+// errors returned by GenerateStreetAddress.ValidateAll() if the designated
+// constraints aren't met.
+type GenerateStreetAddressMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GenerateStreetAddressMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GenerateStreetAddressMultiError) AllErrors() []error { return m }
+
+// GenerateStreetAddressValidationError is the validation error returned by
+// GenerateStreetAddress.Validate if the designated constraints aren't met.
+type GenerateStreetAddressValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GenerateStreetAddressValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GenerateStreetAddressValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GenerateStreetAddressValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GenerateStreetAddressValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GenerateStreetAddressValidationError) ErrorName() string {
+	return "GenerateStreetAddressValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GenerateStreetAddressValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGenerateStreetAddress.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GenerateStreetAddressValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GenerateStreetAddressValidationError{}
+
+// Validate checks the field values on GenerateStringPhoneNumber with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GenerateStringPhoneNumber) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GenerateStringPhoneNumber with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GenerateStringPhoneNumberMultiError, or nil if none found.
+func (m *GenerateStringPhoneNumber) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *TransformEmail) validate(all bool) error {
+func (m *GenerateStringPhoneNumber) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for PreserveDomain
+	// no validation rules for Min
 
-	// no validation rules for PreserveLength
+	// no validation rules for Max
+
+	if m.Locale != nil {
+		// no validation rules for Locale
+	}
+
+	if m.LocaleColumn != nil {
+		// no validation rules for LocaleColumn
+	}
 
 	if len(errors) > 0 {
-		return TransformEmailMultiError(errors)
+		return GenerateStringPhoneNumberMultiError(errors)
 	}
 
 	return nil
 }
 
-// TransformEmailMultiError is an error wrapping multiple validation errors
-// returned by TransformEmail.ValidateAll() if the designated constraints
-// aren't met.
-type TransformEmailMultiError []error
+// GenerateStringPhoneNumberMultiError is an error wrapping multiple validation
+// errors returned by GenerateStringPhoneNumber.ValidateAll() if the
+// designated constraints aren't met.
+type GenerateStringPhoneNumberMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m TransformEmailMultiError) Error() string {
+func (m GenerateStringPhoneNumberMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -4300,11 +6744,11 @@ func (m TransformEmailMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m TransformEmailMultiError) AllErrors() []error { return m }
+func (m GenerateStringPhoneNumberMultiError) AllErrors() []error { return m }
 
-// TransformEmailValidationError is the validation error returned by
-// TransformEmail.Validate if the designated constraints aren't met.
-type TransformEmailValidationError struct {
+// GenerateStringPhoneNumberValidationError is the validation error returned by
+// GenerateStringPhoneNumber.Validate if the designated constraints aren't met.
+type GenerateStringPhoneNumberValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -4312,22 +6756,24 @@ type TransformEmailValidationError struct {
 }
 
 // Field function returns field value.
-func (e TransformEmailValidationError) Field() string { return e.field }
+func (e GenerateStringPhoneNumberValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e TransformEmailValidationError) Reason() string { return e.reason }
+func (e GenerateStringPhoneNumberValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e TransformEmailValidationError) Cause() error { return e.cause }
+func (e GenerateStringPhoneNumberValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e TransformEmailValidationError) Key() bool { return e.key }
+func (e GenerateStringPhoneNumberValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e TransformEmailValidationError) ErrorName() string { return "TransformEmailValidationError" }
+func (e GenerateStringPhoneNumberValidationError) ErrorName() string {
+	return "GenerateStringPhoneNumberValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e TransformEmailValidationError) Error() string {
+func (e GenerateStringPhoneNumberValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -4339,14 +6785,14 @@ func (e TransformEmailValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sTransformEmail.%s: %s%s",
+		"invalid %sGenerateStringPhoneNumber.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = TransformEmailValidationError{}
+var _ error = GenerateStringPhoneNumberValidationError{}
 
 var _ interface {
 	Field() string
@@ -4354,43 +6800,48 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = TransformEmailValidationError{}
+} = GenerateStringPhoneNumberValidationError{}
 
-// Validate checks the field values on GenerateBool with the rules defined in
+// Validate checks the field values on GenerateString with the rules defined in
 // the proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
-func (m *GenerateBool) Validate() error {
+func (m *GenerateString) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GenerateBool with the rules defined
+// ValidateAll checks the field values on GenerateString with the rules defined
 // in the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in GenerateBoolMultiError, or
-// nil if none found.
-func (m *GenerateBool) ValidateAll() error {
+// result is a list of violation errors wrapped in GenerateStringMultiError,
+// or nil if none found.
+func (m *GenerateString) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GenerateBool) validate(all bool) error {
+func (m *GenerateString) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	// no validation rules for Min
+
+	// no validation rules for Max
+
 	if len(errors) > 0 {
-		return GenerateBoolMultiError(errors)
+		return GenerateStringMultiError(errors)
 	}
 
 	return nil
 }
 
-// GenerateBoolMultiError is an error wrapping multiple validation errors
-// returned by GenerateBool.ValidateAll() if the designated constraints aren't met.
-type GenerateBoolMultiError []error
+// GenerateStringMultiError is an error wrapping multiple validation errors
+// returned by GenerateString.ValidateAll() if the designated constraints
+// aren't met.
+type GenerateStringMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GenerateBoolMultiError) Error() string {
+func (m GenerateStringMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -4399,11 +6850,11 @@ func (m GenerateBoolMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GenerateBoolMultiError) AllErrors() []error { return m }
+func (m GenerateStringMultiError) AllErrors() []error { return m }
 
-// GenerateBoolValidationError is the validation error returned by
-// GenerateBool.Validate if the designated constraints aren't met.
-type GenerateBoolValidationError struct {
+// GenerateStringValidationError is the validation error returned by
+// GenerateString.Validate if the designated constraints aren't met.
+type GenerateStringValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -4411,22 +6862,22 @@ type GenerateBoolValidationError struct {
 }
 
 // Field function returns field value.
-func (e GenerateBoolValidationError) Field() string { return e.field }
+func (e GenerateStringValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GenerateBoolValidationError) Reason() string { return e.reason }
+func (e GenerateStringValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GenerateBoolValidationError) Cause() error { return e.cause }
+func (e GenerateStringValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GenerateBoolValidationError) Key() bool { return e.key }
+func (e GenerateStringValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GenerateBoolValidationError) ErrorName() string { return "GenerateBoolValidationError" }
+func (e GenerateStringValidationError) ErrorName() string { return "GenerateStringValidationError" }
 
 // Error satisfies the builtin error interface
-func (e GenerateBoolValidationError) Error() string {
+func (e GenerateStringValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -4438,14 +6889,14 @@ func (e GenerateBoolValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGenerateBool.%s: %s%s",
+		"invalid %sGenerateString.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GenerateBoolValidationError{}
+var _ error = GenerateStringValidationError{}
 
 var _ interface {
 	Field() string
@@ -4453,46 +6904,44 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GenerateBoolValidationError{}
+} = GenerateStringValidationError{}
 
-// Validate checks the field values on GenerateCardNumber with the rules
+// Validate checks the field values on GenerateUnixTimestamp with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GenerateCardNumber) Validate() error {
+func (m *GenerateUnixTimestamp) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GenerateCardNumber with the rules
+// ValidateAll checks the field values on GenerateUnixTimestamp with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GenerateCardNumberMultiError, or nil if none found.
-func (m *GenerateCardNumber) ValidateAll() error {
+// GenerateUnixTimestampMultiError, or nil if none found.
+func (m *GenerateUnixTimestamp) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GenerateCardNumber) validate(all bool) error {
+func (m *GenerateUnixTimestamp) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for ValidLuhn
-
 	if len(errors) > 0 {
-		return GenerateCardNumberMultiError(errors)
+		return GenerateUnixTimestampMultiError(errors)
 	}
 
 	return nil
 }
 
-// GenerateCardNumberMultiError is an error wrapping multiple validation errors
-// returned by GenerateCardNumber.ValidateAll() if the designated constraints
-// aren't met.
-type GenerateCardNumberMultiError []error
+// GenerateUnixTimestampMultiError is an error wrapping multiple validation
+// errors returned by GenerateUnixTimestamp.ValidateAll() if the designated
+// constraints aren't met.
+type GenerateUnixTimestampMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GenerateCardNumberMultiError) Error() string {
+func (m GenerateUnixTimestampMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -4501,11 +6950,11 @@ func (m GenerateCardNumberMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GenerateCardNumberMultiError) AllErrors() []error { return m }
+func (m GenerateUnixTimestampMultiError) AllErrors() []error { return m }
 
-// GenerateCardNumberValidationError is the validation error returned by
-// GenerateCardNumber.Validate if the designated constraints aren't met.
-type GenerateCardNumberValidationError struct {
+// GenerateUnixTimestampValidationError is the validation error returned by
+// GenerateUnixTimestamp.Validate if the designated constraints aren't met.
+type GenerateUnixTimestampValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -4513,24 +6962,24 @@ type GenerateCardNumberValidationError struct {
 }
 
 // Field function returns field value.
-func (e GenerateCardNumberValidationError) Field() string { return e.field }
+func (e GenerateUnixTimestampValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GenerateCardNumberValidationError) Reason() string { return e.reason }
+func (e GenerateUnixTimestampValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GenerateCardNumberValidationError) Cause() error { return e.cause }
+func (e GenerateUnixTimestampValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GenerateCardNumberValidationError) Key() bool { return e.key }
+func (e GenerateUnixTimestampValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GenerateCardNumberValidationError) ErrorName() string {
-	return "GenerateCardNumberValidationError"
+func (e GenerateUnixTimestampValidationError) ErrorName() string {
+	return "GenerateUnixTimestampValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GenerateCardNumberValidationError) Error() string {
+func (e GenerateUnixTimestampValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -4542,14 +6991,14 @@ func (e GenerateCardNumberValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGenerateCardNumber.%s: %s%s",
+		"invalid %sGenerateUnixTimestamp.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GenerateCardNumberValidationError{}
+var _ error = GenerateUnixTimestampValidationError{}
 
 var _ interface {
 	Field() string
@@ -4557,24 +7006,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GenerateCardNumberValidationError{}
+} = GenerateUnixTimestampValidationError{}
 
-// Validate checks the field values on GenerateCity with the rules defined in
-// the proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *GenerateCity) Validate() error {
+// Validate checks the field values on GenerateUsername with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *GenerateUsername) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GenerateCity with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in GenerateCityMultiError, or
-// nil if none found.
-func (m *GenerateCity) ValidateAll() error {
+// ValidateAll checks the field values on GenerateUsername with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GenerateUsernameMultiError, or nil if none found.
+func (m *GenerateUsername) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GenerateCity) validate(all bool) error {
+func (m *GenerateUsername) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -4582,18 +7031,19 @@ func (m *GenerateCity) validate(all bool) error {
 	var errors []error
 
 	if len(errors) > 0 {
-		return GenerateCityMultiError(errors)
+		return GenerateUsernameMultiError(errors)
 	}
 
 	return nil
 }
 
-// GenerateCityMultiError is an error wrapping multiple validation errors
-// returned by GenerateCity.ValidateAll() if the designated constraints aren't met.
-type GenerateCityMultiError []error
+// GenerateUsernameMultiError is an error wrapping multiple validation errors
+// returned by GenerateUsername.ValidateAll() if the designated constraints
+// aren't met.
+type GenerateUsernameMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GenerateCityMultiError) Error() string {
+func (m GenerateUsernameMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -4602,11 +7052,11 @@ func (m GenerateCityMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GenerateCityMultiError) AllErrors() []error { return m }
+func (m GenerateUsernameMultiError) AllErrors() []error { return m }
 
-// GenerateCityValidationError is the validation error returned by
-// GenerateCity.Validate if the designated constraints aren't met.
-type GenerateCityValidationError struct {
+// GenerateUsernameValidationError is the validation error returned by
+// GenerateUsername.Validate if the designated constraints aren't met.
+type GenerateUsernameValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -4614,22 +7064,22 @@ type GenerateCityValidationError struct {
 }
 
 // Field function returns field value.
-func (e GenerateCityValidationError) Field() string { return e.field }
+func (e GenerateUsernameValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GenerateCityValidationError) Reason() string { return e.reason }
+func (e GenerateUsernameValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GenerateCityValidationError) Cause() error { return e.cause }
+func (e GenerateUsernameValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GenerateCityValidationError) Key() bool { return e.key }
+func (e GenerateUsernameValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GenerateCityValidationError) ErrorName() string { return "GenerateCityValidationError" }
+func (e GenerateUsernameValidationError) ErrorName() string { return "GenerateUsernameValidationError" }
 
 // Error satisfies the builtin error interface
-func (e GenerateCityValidationError) Error() string {
+func (e GenerateUsernameValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -4641,14 +7091,14 @@ func (e GenerateCityValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGenerateCity.%s: %s%s",
+		"invalid %sGenerateUsername.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GenerateCityValidationError{}
+var _ error = GenerateUsernameValidationError{}
 
 var _ interface {
 	Field() string
@@ -4656,24 +7106,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GenerateCityValidationError{}
+} = GenerateUsernameValidationError{}
 
-// Validate checks the field values on GenerateDefault with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *GenerateDefault) Validate() error {
+// Validate checks the field values on GenerateUtcTimestamp with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GenerateUtcTimestamp) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GenerateDefault with the rules
+// ValidateAll checks the field values on GenerateUtcTimestamp with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GenerateDefaultMultiError, or nil if none found.
-func (m *GenerateDefault) ValidateAll() error {
+// GenerateUtcTimestampMultiError, or nil if none found.
+func (m *GenerateUtcTimestamp) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GenerateDefault) validate(all bool) error {
+func (m *GenerateUtcTimestamp) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -4681,19 +7131,19 @@ func (m *GenerateDefault) validate(all bool) error {
 	var errors []error
 
 	if len(errors) > 0 {
-		return GenerateDefaultMultiError(errors)
+		return GenerateUtcTimestampMultiError(errors)
 	}
 
 	return nil
 }
 
-// GenerateDefaultMultiError is an error wrapping multiple validation errors
-// returned by GenerateDefault.ValidateAll() if the designated constraints
-// aren't met.
-type GenerateDefaultMultiError []error
+// GenerateUtcTimestampMultiError is an error wrapping multiple validation
+// errors returned by GenerateUtcTimestamp.ValidateAll() if the designated
+// constraints aren't met.
+type GenerateUtcTimestampMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GenerateDefaultMultiError) Error() string {
+func (m GenerateUtcTimestampMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -4702,11 +7152,11 @@ func (m GenerateDefaultMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GenerateDefaultMultiError) AllErrors() []error { return m }
+func (m GenerateUtcTimestampMultiError) AllErrors() []error { return m }
 
-// GenerateDefaultValidationError is the validation error returned by
-// GenerateDefault.Validate if the designated constraints aren't met.
-type GenerateDefaultValidationError struct {
+// GenerateUtcTimestampValidationError is the validation error returned by
+// GenerateUtcTimestamp.Validate if the designated constraints aren't met.
+type GenerateUtcTimestampValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -4714,22 +7164,24 @@ type GenerateDefaultValidationError struct {
 }
 
 // Field function returns field value.
-func (e GenerateDefaultValidationError) Field() string { return e.field }
+func (e GenerateUtcTimestampValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GenerateDefaultValidationError) Reason() string { return e.reason }
+func (e GenerateUtcTimestampValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GenerateDefaultValidationError) Cause() error { return e.cause }
+func (e GenerateUtcTimestampValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GenerateDefaultValidationError) Key() bool { return e.key }
+func (e GenerateUtcTimestampValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GenerateDefaultValidationError) ErrorName() string { return "GenerateDefaultValidationError" }
+func (e GenerateUtcTimestampValidationError) ErrorName() string {
+	return "GenerateUtcTimestampValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e GenerateDefaultValidationError) Error() string {
+func (e GenerateUtcTimestampValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -4741,14 +7193,14 @@ func (e GenerateDefaultValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGenerateDefault.%s: %s%s",
+		"invalid %sGenerateUtcTimestamp.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GenerateDefaultValidationError{}
+var _ error = GenerateUtcTimestampValidationError{}
 
 var _ interface {
 	Field() string
@@ -4756,48 +7208,45 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GenerateDefaultValidationError{}
+} = GenerateUtcTimestampValidationError{}
 
-// Validate checks the field values on GenerateE164PhoneNumber with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GenerateE164PhoneNumber) Validate() error {
+// Validate checks the field values on GenerateUuid with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *GenerateUuid) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GenerateE164PhoneNumber with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// GenerateE164PhoneNumberMultiError, or nil if none found.
-func (m *GenerateE164PhoneNumber) ValidateAll() error {
+// ValidateAll checks the field values on GenerateUuid with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in GenerateUuidMultiError, or
+// nil if none found.
+func (m *GenerateUuid) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GenerateE164PhoneNumber) validate(all bool) error {
+func (m *GenerateUuid) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Min
-
-	// no validation rules for Max
+	// no validation rules for IncludeHyphens
 
 	if len(errors) > 0 {
-		return GenerateE164PhoneNumberMultiError(errors)
+		return GenerateUuidMultiError(errors)
 	}
 
 	return nil
 }
 
-// GenerateE164PhoneNumberMultiError is an error wrapping multiple validation
-// errors returned by GenerateE164PhoneNumber.ValidateAll() if the designated
-// constraints aren't met.
-type GenerateE164PhoneNumberMultiError []error
+// GenerateUuidMultiError is an error wrapping multiple validation errors
+// returned by GenerateUuid.ValidateAll() if the designated constraints aren't met.
+type GenerateUuidMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GenerateE164PhoneNumberMultiError) Error() string {
+func (m GenerateUuidMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -4806,11 +7255,11 @@ func (m GenerateE164PhoneNumberMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GenerateE164PhoneNumberMultiError) AllErrors() []error { return m }
+func (m GenerateUuidMultiError) AllErrors() []error { return m }
 
-// GenerateE164PhoneNumberValidationError is the validation error returned by
-// GenerateE164PhoneNumber.Validate if the designated constraints aren't met.
-type GenerateE164PhoneNumberValidationError struct {
+// GenerateUuidValidationError is the validation error returned by
+// GenerateUuid.Validate if the designated constraints aren't met.
+type GenerateUuidValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -4818,24 +7267,22 @@ type GenerateE164PhoneNumberValidationError struct {
 }
 
 // Field function returns field value.
-func (e GenerateE164PhoneNumberValidationError) Field() string { return e.field }
+func (e GenerateUuidValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GenerateE164PhoneNumberValidationError) Reason() string { return e.reason }
+func (e GenerateUuidValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GenerateE164PhoneNumberValidationError) Cause() error { return e.cause }
+func (e GenerateUuidValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GenerateE164PhoneNumberValidationError) Key() bool { return e.key }
+func (e GenerateUuidValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GenerateE164PhoneNumberValidationError) ErrorName() string {
-	return "GenerateE164PhoneNumberValidationError"
-}
+func (e GenerateUuidValidationError) ErrorName() string { return "GenerateUuidValidationError" }
 
 // Error satisfies the builtin error interface
-func (e GenerateE164PhoneNumberValidationError) Error() string {
+func (e GenerateUuidValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -4847,14 +7294,14 @@ func (e GenerateE164PhoneNumberValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGenerateE164PhoneNumber.%s: %s%s",
+		"invalid %sGenerateUuid.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GenerateE164PhoneNumberValidationError{}
+var _ error = GenerateUuidValidationError{}
 
 var _ interface {
 	Field() string
@@ -4862,44 +7309,52 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GenerateE164PhoneNumberValidationError{}
+} = GenerateUuidValidationError{}
 
-// Validate checks the field values on GenerateFirstName with the rules defined
+// Validate checks the field values on GenerateZipcode with the rules defined
 // in the proto definition for this message. If any rules are violated, the
 // first error encountered is returned, or nil if there are no violations.
-func (m *GenerateFirstName) Validate() error {
+func (m *GenerateZipcode) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GenerateFirstName with the rules
+// ValidateAll checks the field values on GenerateZipcode with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GenerateFirstNameMultiError, or nil if none found.
-func (m *GenerateFirstName) ValidateAll() error {
+// GenerateZipcodeMultiError, or nil if none found.
+func (m *GenerateZipcode) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GenerateFirstName) validate(all bool) error {
+func (m *GenerateZipcode) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	if m.Locale != nil {
+		// no validation rules for Locale
+	}
+
+	if m.LocaleColumn != nil {
+		// no validation rules for LocaleColumn
+	}
+
 	if len(errors) > 0 {
-		return GenerateFirstNameMultiError(errors)
+		return GenerateZipcodeMultiError(errors)
 	}
 
 	return nil
 }
 
-// GenerateFirstNameMultiError is an error wrapping multiple validation errors
-// returned by GenerateFirstName.ValidateAll() if the designated constraints
+// GenerateZipcodeMultiError is an error wrapping multiple validation errors
+// returned by GenerateZipcode.ValidateAll() if the designated constraints
 // aren't met.
-type GenerateFirstNameMultiError []error
+type GenerateZipcodeMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GenerateFirstNameMultiError) Error() string {
+func (m GenerateZipcodeMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -4908,11 +7363,11 @@ func (m GenerateFirstNameMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GenerateFirstNameMultiError) AllErrors() []error { return m }
+func (m GenerateZipcodeMultiError) AllErrors() []error { return m }
 
-// GenerateFirstNameValidationError is the validation error returned by
-// GenerateFirstName.Validate if the designated constraints aren't met.
-type GenerateFirstNameValidationError struct {
+// GenerateZipcodeValidationError is the validation error returned by
+// GenerateZipcode.Validate if the designated constraints aren't met.
+type GenerateZipcodeValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -4920,24 +7375,22 @@ type GenerateFirstNameValidationError struct {
 }
 
 // Field function returns field value.
-func (e GenerateFirstNameValidationError) Field() string { return e.field }
+func (e GenerateZipcodeValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GenerateFirstNameValidationError) Reason() string { return e.reason }
+func (e GenerateZipcodeValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GenerateFirstNameValidationError) Cause() error { return e.cause }
+func (e GenerateZipcodeValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GenerateFirstNameValidationError) Key() bool { return e.key }
+func (e GenerateZipcodeValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GenerateFirstNameValidationError) ErrorName() string {
-	return "GenerateFirstNameValidationError"
-}
+func (e GenerateZipcodeValidationError) ErrorName() string { return "GenerateZipcodeValidationError" }
 
 // Error satisfies the builtin error interface
-func (e GenerateFirstNameValidationError) Error() string {
+func (e GenerateZipcodeValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -4949,14 +7402,14 @@ func (e GenerateFirstNameValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGenerateFirstName.%s: %s%s",
+		"invalid %sGenerateZipcode.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GenerateFirstNameValidationError{}
+var _ error = GenerateZipcodeValidationError{}
 
 var _ interface {
 	Field() string
@@ -4964,52 +7417,48 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GenerateFirstNameValidationError{}
+} = GenerateZipcodeValidationError{}
 
-// Validate checks the field values on GenerateFloat64 with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *GenerateFloat64) Validate() error {
+// Validate checks the field values on TransformE164PhoneNumber with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *TransformE164PhoneNumber) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GenerateFloat64 with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on TransformE164PhoneNumber with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GenerateFloat64MultiError, or nil if none found.
-func (m *GenerateFloat64) ValidateAll() error {
+// TransformE164PhoneNumberMultiError, or nil if none found.
+func (m *TransformE164PhoneNumber) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GenerateFloat64) validate(all bool) error {
+func (m *TransformE164PhoneNumber) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for RandomizeSign
-
-	// no validation rules for Min
-
-	// no validation rules for Max
+	// no validation rules for PreserveLength
 
-	// no validation rules for Precision
+	// no validation rules for Deterministic
 
 	if len(errors) > 0 {
-		return GenerateFloat64MultiError(errors)
+		return TransformE164PhoneNumberMultiError(errors)
 	}
 
 	return nil
 }
 
-// GenerateFloat64MultiError is an error wrapping multiple validation errors
-// returned by GenerateFloat64.ValidateAll() if the designated constraints
-// aren't met.
-type GenerateFloat64MultiError []error
+// TransformE164PhoneNumberMultiError is an error wrapping multiple validation
+// errors returned by TransformE164PhoneNumber.ValidateAll() if the designated
+// constraints aren't met.
+type TransformE164PhoneNumberMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GenerateFloat64MultiError) Error() string {
+func (m TransformE164PhoneNumberMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -5018,11 +7467,11 @@ func (m GenerateFloat64MultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GenerateFloat64MultiError) AllErrors() []error { return m }
+func (m TransformE164PhoneNumberMultiError) AllErrors() []error { return m }
 
-// GenerateFloat64ValidationError is the validation error returned by
-// GenerateFloat64.Validate if the designated constraints aren't met.
-type GenerateFloat64ValidationError struct {
+// TransformE164PhoneNumberValidationError is the validation error returned by
+// TransformE164PhoneNumber.Validate if the designated constraints aren't met.
+type TransformE164PhoneNumberValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -5030,22 +7479,24 @@ type GenerateFloat64ValidationError struct {
 }
 
 // Field function returns field value.
-func (e GenerateFloat64ValidationError) Field() string { return e.field }
+func (e TransformE164PhoneNumberValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GenerateFloat64ValidationError) Reason() string { return e.reason }
+func (e TransformE164PhoneNumberValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GenerateFloat64ValidationError) Cause() error { return e.cause }
+func (e TransformE164PhoneNumberValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GenerateFloat64ValidationError) Key() bool { return e.key }
+func (e TransformE164PhoneNumberValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GenerateFloat64ValidationError) ErrorName() string { return "GenerateFloat64ValidationError" }
+func (e TransformE164PhoneNumberValidationError) ErrorName() string {
+	return "TransformE164PhoneNumberValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e GenerateFloat64ValidationError) Error() string {
+func (e TransformE164PhoneNumberValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -5057,14 +7508,14 @@ func (e GenerateFloat64ValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGenerateFloat64.%s: %s%s",
+		"invalid %sTransformE164PhoneNumber.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GenerateFloat64ValidationError{}
+var _ error = TransformE164PhoneNumberValidationError{}
 
 var _ interface {
 	Field() string
@@ -5072,44 +7523,56 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GenerateFloat64ValidationError{}
+} = TransformE164PhoneNumberValidationError{}
 
-// Validate checks the field values on GenerateFullAddress with the rules
+// Validate checks the field values on TransformFirstName with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GenerateFullAddress) Validate() error {
+func (m *TransformFirstName) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GenerateFullAddress with the rules
+// ValidateAll checks the field values on TransformFirstName with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GenerateFullAddressMultiError, or nil if none found.
-func (m *GenerateFullAddress) ValidateAll() error {
+// TransformFirstNameMultiError, or nil if none found.
+func (m *TransformFirstName) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GenerateFullAddress) validate(all bool) error {
+func (m *TransformFirstName) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	// no validation rules for PreserveLength
+
+	// no validation rules for Deterministic
+
+	if m.Locale != nil {
+		// no validation rules for Locale
+	}
+
+	if m.LocaleColumn != nil {
+		// no validation rules for LocaleColumn
+	}
+
 	if len(errors) > 0 {
-		return GenerateFullAddressMultiError(errors)
+		return TransformFirstNameMultiError(errors)
 	}
 
 	return nil
 }
 
-// GenerateFullAddressMultiError is an error wrapping multiple validation
-// errors returned by GenerateFullAddress.ValidateAll() if the designated
-// constraints aren't met.
-type GenerateFullAddressMultiError []error
+// TransformFirstNameMultiError is an error wrapping multiple validation errors
+// returned by TransformFirstName.ValidateAll() if the designated constraints
+// aren't met.
+type TransformFirstNameMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GenerateFullAddressMultiError) Error() string {
+func (m TransformFirstNameMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -5118,11 +7581,11 @@ func (m GenerateFullAddressMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GenerateFullAddressMultiError) AllErrors() []error { return m }
+func (m TransformFirstNameMultiError) AllErrors() []error { return m }
 
-// GenerateFullAddressValidationError is the validation error returned by
-// GenerateFullAddress.Validate if the designated constraints aren't met.
-type GenerateFullAddressValidationError struct {
+// TransformFirstNameValidationError is the validation error returned by
+// TransformFirstName.Validate if the designated constraints aren't met.
+type TransformFirstNameValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -5130,24 +7593,24 @@ type GenerateFullAddressValidationError struct {
 }
 
 // Field function returns field value.
-func (e GenerateFullAddressValidationError) Field() string { return e.field }
+func (e TransformFirstNameValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GenerateFullAddressValidationError) Reason() string { return e.reason }
+func (e TransformFirstNameValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GenerateFullAddressValidationError) Cause() error { return e.cause }
+func (e TransformFirstNameValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GenerateFullAddressValidationError) Key() bool { return e.key }
+func (e TransformFirstNameValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GenerateFullAddressValidationError) ErrorName() string {
-	return "GenerateFullAddressValidationError"
+func (e TransformFirstNameValidationError) ErrorName() string {
+	return "TransformFirstNameValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GenerateFullAddressValidationError) Error() string {
+func (e TransformFirstNameValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -5159,14 +7622,14 @@ func (e GenerateFullAddressValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGenerateFullAddress.%s: %s%s",
+		"invalid %sTransformFirstName.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GenerateFullAddressValidationError{}
+var _ error = TransformFirstNameValidationError{}
 
 var _ interface {
 	Field() string
@@ -5174,44 +7637,50 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GenerateFullAddressValidationError{}
+} = TransformFirstNameValidationError{}
 
-// Validate checks the field values on GenerateFullName with the rules defined
+// Validate checks the field values on TransformFloat64 with the rules defined
 // in the proto definition for this message. If any rules are violated, the
 // first error encountered is returned, or nil if there are no violations.
-func (m *GenerateFullName) Validate() error {
+func (m *TransformFloat64) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GenerateFullName with the rules
+// ValidateAll checks the field values on TransformFloat64 with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GenerateFullNameMultiError, or nil if none found.
-func (m *GenerateFullName) ValidateAll() error {
+// TransformFloat64MultiError, or nil if none found.
+func (m *TransformFloat64) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GenerateFullName) validate(all bool) error {
+func (m *TransformFloat64) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	// no validation rules for RandomizationRangeMin
+
+	// no validation rules for RandomizationRangeMax
+
+	// no validation rules for Deterministic
+
 	if len(errors) > 0 {
-		return GenerateFullNameMultiError(errors)
+		return TransformFloat64MultiError(errors)
 	}
 
 	return nil
 }
 
-// GenerateFullNameMultiError is an error wrapping multiple validation errors
-// returned by GenerateFullName.ValidateAll() if the designated constraints
+// TransformFloat64MultiError is an error wrapping multiple validation errors
+// returned by TransformFloat64.ValidateAll() if the designated constraints
 // aren't met.
-type GenerateFullNameMultiError []error
+type TransformFloat64MultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GenerateFullNameMultiError) Error() string {
+func (m TransformFloat64MultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -5220,11 +7689,11 @@ func (m GenerateFullNameMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GenerateFullNameMultiError) AllErrors() []error { return m }
+func (m TransformFloat64MultiError) AllErrors() []error { return m }
 
-// GenerateFullNameValidationError is the validation error returned by
-// GenerateFullName.Validate if the designated constraints aren't met.
-type GenerateFullNameValidationError struct {
+// TransformFloat64ValidationError is the validation error returned by
+// TransformFloat64.Validate if the designated constraints aren't met.
+type TransformFloat64ValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -5232,22 +7701,22 @@ type GenerateFullNameValidationError struct {
 }
 
 // Field function returns field value.
-func (e GenerateFullNameValidationError) Field() string { return e.field }
+func (e TransformFloat64ValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GenerateFullNameValidationError) Reason() string { return e.reason }
+func (e TransformFloat64ValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GenerateFullNameValidationError) Cause() error { return e.cause }
+func (e TransformFloat64ValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GenerateFullNameValidationError) Key() bool { return e.key }
+func (e TransformFloat64ValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GenerateFullNameValidationError) ErrorName() string { return "GenerateFullNameValidationError" }
+func (e TransformFloat64ValidationError) ErrorName() string { return "TransformFloat64ValidationError" }
 
 // Error satisfies the builtin error interface
-func (e GenerateFullNameValidationError) Error() string {
+func (e TransformFloat64ValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -5259,14 +7728,14 @@ func (e GenerateFullNameValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGenerateFullName.%s: %s%s",
+		"invalid %sTransformFloat64.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GenerateFullNameValidationError{}
+var _ error = TransformFloat64ValidationError{}
 
 var _ interface {
 	Field() string
@@ -5274,46 +7743,56 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GenerateFullNameValidationError{}
+} = TransformFloat64ValidationError{}
 
-// Validate checks the field values on GenerateGender with the rules defined in
-// the proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *GenerateGender) Validate() error {
+// Validate checks the field values on TransformFullName with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *TransformFullName) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GenerateGender with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in GenerateGenderMultiError,
-// or nil if none found.
-func (m *GenerateGender) ValidateAll() error {
+// ValidateAll checks the field values on TransformFullName with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// TransformFullNameMultiError, or nil if none found.
+func (m *TransformFullName) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GenerateGender) validate(all bool) error {
+func (m *TransformFullName) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Abbreviate
+	// no validation rules for PreserveLength
+
+	// no validation rules for Deterministic
+
+	if m.Locale != nil {
+		// no validation rules for Locale
+	}
+
+	if m.LocaleColumn != nil {
+		// no validation rules for LocaleColumn
+	}
 
 	if len(errors) > 0 {
-		return GenerateGenderMultiError(errors)
+		return TransformFullNameMultiError(errors)
 	}
 
 	return nil
 }
 
-// GenerateGenderMultiError is an error wrapping multiple validation errors
-// returned by GenerateGender.ValidateAll() if the designated constraints
+// TransformFullNameMultiError is an error wrapping multiple validation errors
+// returned by TransformFullName.ValidateAll() if the designated constraints
 // aren't met.
-type GenerateGenderMultiError []error
+type TransformFullNameMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GenerateGenderMultiError) Error() string {
+func (m TransformFullNameMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -5322,11 +7801,11 @@ func (m GenerateGenderMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GenerateGenderMultiError) AllErrors() []error { return m }
+func (m TransformFullNameMultiError) AllErrors() []error { return m }
 
-// GenerateGenderValidationError is the validation error returned by
-// GenerateGender.Validate if the designated constraints aren't met.
-type GenerateGenderValidationError struct {
+// TransformFullNameValidationError is the validation error returned by
+// TransformFullName.Validate if the designated constraints aren't met.
+type TransformFullNameValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -5334,22 +7813,24 @@ type GenerateGenderValidationError struct {
 }
 
 // Field function returns field value.
-func (e GenerateGenderValidationError) Field() string { return e.field }
+func (e TransformFullNameValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GenerateGenderValidationError) Reason() string { return e.reason }
+func (e TransformFullNameValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GenerateGenderValidationError) Cause() error { return e.cause }
+func (e TransformFullNameValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GenerateGenderValidationError) Key() bool { return e.key }
+func (e TransformFullNameValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GenerateGenderValidationError) ErrorName() string { return "GenerateGenderValidationError" }
+func (e TransformFullNameValidationError) ErrorName() string {
+	return "TransformFullNameValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e GenerateGenderValidationError) Error() string {
+func (e TransformFullNameValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -5361,14 +7842,14 @@ func (e GenerateGenderValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGenerateGender.%s: %s%s",
+		"invalid %sTransformFullName.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GenerateGenderValidationError{}
+var _ error = TransformFullNameValidationError{}
 
 var _ interface {
 	Field() string
@@ -5376,44 +7857,48 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GenerateGenderValidationError{}
+} = TransformFullNameValidationError{}
 
-// Validate checks the field values on GenerateInt64PhoneNumber with the rules
+// Validate checks the field values on TransformInt64PhoneNumber with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GenerateInt64PhoneNumber) Validate() error {
+func (m *TransformInt64PhoneNumber) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GenerateInt64PhoneNumber with the
+// ValidateAll checks the field values on TransformInt64PhoneNumber with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GenerateInt64PhoneNumberMultiError, or nil if none found.
-func (m *GenerateInt64PhoneNumber) ValidateAll() error {
+// TransformInt64PhoneNumberMultiError, or nil if none found.
+func (m *TransformInt64PhoneNumber) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GenerateInt64PhoneNumber) validate(all bool) error {
+func (m *TransformInt64PhoneNumber) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	// no validation rules for PreserveLength
+
+	// no validation rules for Deterministic
+
 	if len(errors) > 0 {
-		return GenerateInt64PhoneNumberMultiError(errors)
+		return TransformInt64PhoneNumberMultiError(errors)
 	}
 
 	return nil
 }
 
-// GenerateInt64PhoneNumberMultiError is an error wrapping multiple validation
-// errors returned by GenerateInt64PhoneNumber.ValidateAll() if the designated
-// constraints aren't met.
-type GenerateInt64PhoneNumberMultiError []error
+// TransformInt64PhoneNumberMultiError is an error wrapping multiple validation
+// errors returned by TransformInt64PhoneNumber.ValidateAll() if the
+// designated constraints aren't met.
+type TransformInt64PhoneNumberMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GenerateInt64PhoneNumberMultiError) Error() string {
+func (m TransformInt64PhoneNumberMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -5422,11 +7907,11 @@ func (m GenerateInt64PhoneNumberMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GenerateInt64PhoneNumberMultiError) AllErrors() []error { return m }
+func (m TransformInt64PhoneNumberMultiError) AllErrors() []error { return m }
 
-// GenerateInt64PhoneNumberValidationError is the validation error returned by
-// GenerateInt64PhoneNumber.Validate if the designated constraints aren't met.
-type GenerateInt64PhoneNumberValidationError struct {
+// TransformInt64PhoneNumberValidationError is the validation error returned by
+// TransformInt64PhoneNumber.Validate if the designated constraints aren't met.
+type TransformInt64PhoneNumberValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -5434,24 +7919,24 @@ type GenerateInt64PhoneNumberValidationError struct {
 }
 
 // Field function returns field value.
-func (e GenerateInt64PhoneNumberValidationError) Field() string { return e.field }
+func (e TransformInt64PhoneNumberValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GenerateInt64PhoneNumberValidationError) Reason() string { return e.reason }
+func (e TransformInt64PhoneNumberValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GenerateInt64PhoneNumberValidationError) Cause() error { return e.cause }
+func (e TransformInt64PhoneNumberValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GenerateInt64PhoneNumberValidationError) Key() bool { return e.key }
+func (e TransformInt64PhoneNumberValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GenerateInt64PhoneNumberValidationError) ErrorName() string {
-	return "GenerateInt64PhoneNumberValidationError"
+func (e TransformInt64PhoneNumberValidationError) ErrorName() string {
+	return "TransformInt64PhoneNumberValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GenerateInt64PhoneNumberValidationError) Error() string {
+func (e TransformInt64PhoneNumberValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -5463,14 +7948,14 @@ func (e GenerateInt64PhoneNumberValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGenerateInt64PhoneNumber.%s: %s%s",
+		"invalid %sTransformInt64PhoneNumber.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GenerateInt64PhoneNumberValidationError{}
+var _ error = TransformInt64PhoneNumberValidationError{}
 
 var _ interface {
 	Field() string
@@ -5478,50 +7963,50 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GenerateInt64PhoneNumberValidationError{}
+} = TransformInt64PhoneNumberValidationError{}
 
-// Validate checks the field values on GenerateInt64 with the rules defined in
+// Validate checks the field values on TransformInt64 with the rules defined in
 // the proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
-func (m *GenerateInt64) Validate() error {
+func (m *TransformInt64) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GenerateInt64 with the rules defined
+// ValidateAll checks the field values on TransformInt64 with the rules defined
 // in the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in GenerateInt64MultiError, or
-// nil if none found.
-func (m *GenerateInt64) ValidateAll() error {
+// result is a list of violation errors wrapped in TransformInt64MultiError,
+// or nil if none found.
+func (m *TransformInt64) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GenerateInt64) validate(all bool) error {
+func (m *TransformInt64) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for RandomizeSign
+	// no validation rules for RandomizationRangeMin
 
-	// no validation rules for Min
+	// no validation rules for RandomizationRangeMax
 
-	// no validation rules for Max
+	// no validation rules for Deterministic
 
 	if len(errors) > 0 {
-		return GenerateInt64MultiError(errors)
+		return TransformInt64MultiError(errors)
 	}
 
 	return nil
 }
 
-// GenerateInt64MultiError is an error wrapping multiple validation errors
-// returned by GenerateInt64.ValidateAll() if the designated constraints
+// TransformInt64MultiError is an error wrapping multiple validation errors
+// returned by TransformInt64.ValidateAll() if the designated constraints
 // aren't met.
-type GenerateInt64MultiError []error
+type TransformInt64MultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GenerateInt64MultiError) Error() string {
+func (m TransformInt64MultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -5530,11 +8015,11 @@ func (m GenerateInt64MultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GenerateInt64MultiError) AllErrors() []error { return m }
+func (m TransformInt64MultiError) AllErrors() []error { return m }
 
-// GenerateInt64ValidationError is the validation error returned by
-// GenerateInt64.Validate if the designated constraints aren't met.
-type GenerateInt64ValidationError struct {
+// TransformInt64ValidationError is the validation error returned by
+// TransformInt64.Validate if the designated constraints aren't met.
+type TransformInt64ValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -5542,22 +8027,22 @@ type GenerateInt64ValidationError struct {
 }
 
 // Field function returns field value.
-func (e GenerateInt64ValidationError) Field() string { return e.field }
+func (e TransformInt64ValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GenerateInt64ValidationError) Reason() string { return e.reason }
+func (e TransformInt64ValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GenerateInt64ValidationError) Cause() error { return e.cause }
+func (e TransformInt64ValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GenerateInt64ValidationError) Key() bool { return e.key }
+func (e TransformInt64ValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GenerateInt64ValidationError) ErrorName() string { return "GenerateInt64ValidationError" }
+func (e TransformInt64ValidationError) ErrorName() string { return "TransformInt64ValidationError" }
 
 // Error satisfies the builtin error interface
-func (e GenerateInt64ValidationError) Error() string {
+func (e TransformInt64ValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -5569,14 +8054,14 @@ func (e GenerateInt64ValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGenerateInt64.%s: %s%s",
+		"invalid %sTransformInt64.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GenerateInt64ValidationError{}
+var _ error = TransformInt64ValidationError{}
 
 var _ interface {
 	Field() string
@@ -5584,44 +8069,56 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GenerateInt64ValidationError{}
+} = TransformInt64ValidationError{}
 
-// Validate checks the field values on GenerateLastName with the rules defined
+// Validate checks the field values on TransformLastName with the rules defined
 // in the proto definition for this message. If any rules are violated, the
 // first error encountered is returned, or nil if there are no violations.
-func (m *GenerateLastName) Validate() error {
+func (m *TransformLastName) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GenerateLastName with the rules
+// ValidateAll checks the field values on TransformLastName with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GenerateLastNameMultiError, or nil if none found.
-func (m *GenerateLastName) ValidateAll() error {
+// TransformLastNameMultiError, or nil if none found.
+func (m *TransformLastName) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GenerateLastName) validate(all bool) error {
+func (m *TransformLastName) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	// no validation rules for PreserveLength
+
+	// no validation rules for Deterministic
+
+	if m.Locale != nil {
+		// no validation rules for Locale
+	}
+
+	if m.LocaleColumn != nil {
+		// no validation rules for LocaleColumn
+	}
+
 	if len(errors) > 0 {
-		return GenerateLastNameMultiError(errors)
+		return TransformLastNameMultiError(errors)
 	}
 
 	return nil
 }
 
-// GenerateLastNameMultiError is an error wrapping multiple validation errors
-// returned by GenerateLastName.ValidateAll() if the designated constraints
+// TransformLastNameMultiError is an error wrapping multiple validation errors
+// returned by TransformLastName.ValidateAll() if the designated constraints
 // aren't met.
-type GenerateLastNameMultiError []error
+type TransformLastNameMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GenerateLastNameMultiError) Error() string {
+func (m TransformLastNameMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -5630,11 +8127,11 @@ func (m GenerateLastNameMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GenerateLastNameMultiError) AllErrors() []error { return m }
+func (m TransformLastNameMultiError) AllErrors() []error { return m }
 
-// GenerateLastNameValidationError is the validation error returned by
-// GenerateLastName.Validate if the designated constraints aren't met.
-type GenerateLastNameValidationError struct {
+// TransformLastNameValidationError is the validation error returned by
+// TransformLastName.Validate if the designated constraints aren't met.
+type TransformLastNameValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -5642,22 +8139,24 @@ type GenerateLastNameValidationError struct {
 }
 
 // Field function returns field value.
-func (e GenerateLastNameValidationError) Field() string { return e.field }
+func (e TransformLastNameValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GenerateLastNameValidationError) Reason() string { return e.reason }
+func (e TransformLastNameValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GenerateLastNameValidationError) Cause() error { return e.cause }
+func (e TransformLastNameValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GenerateLastNameValidationError) Key() bool { return e.key }
+func (e TransformLastNameValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GenerateLastNameValidationError) ErrorName() string { return "GenerateLastNameValidationError" }
+func (e TransformLastNameValidationError) ErrorName() string {
+	return "TransformLastNameValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e GenerateLastNameValidationError) Error() string {
+func (e TransformLastNameValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -5669,14 +8168,14 @@ func (e GenerateLastNameValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGenerateLastName.%s: %s%s",
+		"invalid %sTransformLastName.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GenerateLastNameValidationError{}
+var _ error = TransformLastNameValidationError{}
 
 var _ interface {
 	Field() string
@@ -5684,44 +8183,56 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GenerateLastNameValidationError{}
+} = TransformLastNameValidationError{}
 
-// Validate checks the field values on GenerateSha256Hash with the rules
+// Validate checks the field values on TransformPhoneNumber with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GenerateSha256Hash) Validate() error {
+func (m *TransformPhoneNumber) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GenerateSha256Hash with the rules
+// ValidateAll checks the field values on TransformPhoneNumber with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GenerateSha256HashMultiError, or nil if none found.
-func (m *GenerateSha256Hash) ValidateAll() error {
+// TransformPhoneNumberMultiError, or nil if none found.
+func (m *TransformPhoneNumber) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GenerateSha256Hash) validate(all bool) error {
+func (m *TransformPhoneNumber) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	// no validation rules for PreserveLength
+
+	// no validation rules for Deterministic
+
+	if m.Locale != nil {
+		// no validation rules for Locale
+	}
+
+	if m.LocaleColumn != nil {
+		// no validation rules for LocaleColumn
+	}
+
 	if len(errors) > 0 {
-		return GenerateSha256HashMultiError(errors)
+		return TransformPhoneNumberMultiError(errors)
 	}
 
 	return nil
 }
 
-// GenerateSha256HashMultiError is an error wrapping multiple validation errors
-// returned by GenerateSha256Hash.ValidateAll() if the designated constraints
-// aren't met.
-type GenerateSha256HashMultiError []error
+// TransformPhoneNumberMultiError is an error wrapping multiple validation
+// errors returned by TransformPhoneNumber.ValidateAll() if the designated
+// constraints aren't met.
+type TransformPhoneNumberMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GenerateSha256HashMultiError) Error() string {
+func (m TransformPhoneNumberMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -5730,11 +8241,11 @@ func (m GenerateSha256HashMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GenerateSha256HashMultiError) AllErrors() []error { return m }
+func (m TransformPhoneNumberMultiError) AllErrors() []error { return m }
 
-// GenerateSha256HashValidationError is the validation error returned by
-// GenerateSha256Hash.Validate if the designated constraints aren't met.
-type GenerateSha256HashValidationError struct {
+// TransformPhoneNumberValidationError is the validation error returned by
+// TransformPhoneNumber.Validate if the designated constraints aren't met.
+type TransformPhoneNumberValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -5742,24 +8253,24 @@ type GenerateSha256HashValidationError struct {
 }
 
 // Field function returns field value.
-func (e GenerateSha256HashValidationError) Field() string { return e.field }
+func (e TransformPhoneNumberValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GenerateSha256HashValidationError) Reason() string { return e.reason }
+func (e TransformPhoneNumberValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GenerateSha256HashValidationError) Cause() error { return e.cause }
+func (e TransformPhoneNumberValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GenerateSha256HashValidationError) Key() bool { return e.key }
+func (e TransformPhoneNumberValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GenerateSha256HashValidationError) ErrorName() string {
-	return "GenerateSha256HashValidationError"
+func (e TransformPhoneNumberValidationError) ErrorName() string {
+	return "TransformPhoneNumberValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GenerateSha256HashValidationError) Error() string {
+func (e TransformPhoneNumberValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -5771,14 +8282,14 @@ func (e GenerateSha256HashValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGenerateSha256Hash.%s: %s%s",
+		"invalid %sTransformPhoneNumber.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GenerateSha256HashValidationError{}
+var _ error = TransformPhoneNumberValidationError{}
 
 var _ interface {
 	Field() string
@@ -5786,43 +8297,48 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GenerateSha256HashValidationError{}
+} = TransformPhoneNumberValidationError{}
 
-// Validate checks the field values on GenerateSSN with the rules defined in
-// the proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *GenerateSSN) Validate() error {
+// Validate checks the field values on TransformString with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *TransformString) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GenerateSSN with the rules defined in
-// the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in GenerateSSNMultiError, or
-// nil if none found.
-func (m *GenerateSSN) ValidateAll() error {
+// ValidateAll checks the field values on TransformString with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// TransformStringMultiError, or nil if none found.
+func (m *TransformString) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GenerateSSN) validate(all bool) error {
+func (m *TransformString) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	// no validation rules for PreserveLength
+
+	// no validation rules for Deterministic
+
 	if len(errors) > 0 {
-		return GenerateSSNMultiError(errors)
+		return TransformStringMultiError(errors)
 	}
 
 	return nil
 }
 
-// GenerateSSNMultiError is an error wrapping multiple validation errors
-// returned by GenerateSSN.ValidateAll() if the designated constraints aren't met.
-type GenerateSSNMultiError []error
+// TransformStringMultiError is an error wrapping multiple validation errors
+// returned by TransformString.ValidateAll() if the designated constraints
+// aren't met.
+type TransformStringMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GenerateSSNMultiError) Error() string {
+func (m TransformStringMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -5831,11 +8347,11 @@ func (m GenerateSSNMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GenerateSSNMultiError) AllErrors() []error { return m }
+func (m TransformStringMultiError) AllErrors() []error { return m }
 
-// GenerateSSNValidationError is the validation error returned by
-// GenerateSSN.Validate if the designated constraints aren't met.
-type GenerateSSNValidationError struct {
+// TransformStringValidationError is the validation error returned by
+// TransformString.Validate if the designated constraints aren't met.
+type TransformStringValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -5843,22 +8359,22 @@ type GenerateSSNValidationError struct {
 }
 
 // Field function returns field value.
-func (e GenerateSSNValidationError) Field() string { return e.field }
+func (e TransformStringValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GenerateSSNValidationError) Reason() string { return e.reason }
+func (e TransformStringValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GenerateSSNValidationError) Cause() error { return e.cause }
+func (e TransformStringValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GenerateSSNValidationError) Key() bool { return e.key }
+func (e TransformStringValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GenerateSSNValidationError) ErrorName() string { return "GenerateSSNValidationError" }
+func (e TransformStringValidationError) ErrorName() string { return "TransformStringValidationError" }
 
 // Error satisfies the builtin error interface
-func (e GenerateSSNValidationError) Error() string {
+func (e TransformStringValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -5870,14 +8386,14 @@ func (e GenerateSSNValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGenerateSSN.%s: %s%s",
+		"invalid %sTransformString.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GenerateSSNValidationError{}
+var _ error = TransformStringValidationError{}
 
 var _ interface {
 	Field() string
@@ -5885,24 +8401,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GenerateSSNValidationError{}
+} = TransformStringValidationError{}
 
-// Validate checks the field values on GenerateState with the rules defined in
+// Validate checks the field values on Passthrough with the rules defined in
 // the proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
-func (m *GenerateState) Validate() error {
+func (m *Passthrough) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GenerateState with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in GenerateStateMultiError, or
+// ValidateAll checks the field values on Passthrough with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in PassthroughMultiError, or
 // nil if none found.
-func (m *GenerateState) ValidateAll() error {
+func (m *Passthrough) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GenerateState) validate(all bool) error {
+func (m *Passthrough) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -5910,19 +8426,18 @@ func (m *GenerateState) validate(all bool) error {
 	var errors []error
 
 	if len(errors) > 0 {
-		return GenerateStateMultiError(errors)
+		return PassthroughMultiError(errors)
 	}
 
 	return nil
 }
 
-// GenerateStateMultiError is an error wrapping multiple validation errors
-// returned by GenerateState.ValidateAll() if the designated constraints
-// aren't met.
-type GenerateStateMultiError []error
+// PassthroughMultiError is an error wrapping multiple validation errors
+// returned by Passthrough.ValidateAll() if the designated constraints aren't met.
+type PassthroughMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GenerateStateMultiError) Error() string {
+func (m PassthroughMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -5931,11 +8446,11 @@ func (m GenerateStateMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GenerateStateMultiError) AllErrors() []error { return m }
+func (m PassthroughMultiError) AllErrors() []error { return m }
 
-// GenerateStateValidationError is the validation error returned by
-// GenerateState.Validate if the designated constraints aren't met.
-type GenerateStateValidationError struct {
+// PassthroughValidationError is the validation error returned by
+// Passthrough.Validate if the designated constraints aren't met.
+type PassthroughValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -5943,22 +8458,22 @@ type GenerateStateValidationError struct {
 }
 
 // Field function returns field value.
-func (e GenerateStateValidationError) Field() string { return e.field }
+func (e PassthroughValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GenerateStateValidationError) Reason() string { return e.reason }
+func (e PassthroughValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GenerateStateValidationError) Cause() error { return e.cause }
+func (e PassthroughValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GenerateStateValidationError) Key() bool { return e.key }
+func (e PassthroughValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GenerateStateValidationError) ErrorName() string { return "GenerateStateValidationError" }
+func (e PassthroughValidationError) ErrorName() string { return "PassthroughValidationError" }
 
 // Error satisfies the builtin error interface
-func (e GenerateStateValidationError) Error() string {
+func (e PassthroughValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -5970,14 +8485,14 @@ func (e GenerateStateValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGenerateState.%s: %s%s",
+		"invalid %sPassthrough.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GenerateStateValidationError{}
+var _ error = PassthroughValidationError{}
 
 var _ interface {
 	Field() string
@@ -5985,24 +8500,23 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GenerateStateValidationError{}
+} = PassthroughValidationError{}
 
-// Validate checks the field values on GenerateStreetAddress with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GenerateStreetAddress) Validate() error {
+// Validate checks the field values on Null with the rules defined in the proto
+// definition for this message. If any rules are violated, the first error
+// encountered is returned, or nil if there are no violations.
+func (m *Null) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GenerateStreetAddress with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// GenerateStreetAddressMultiError, or nil if none found.
-func (m *GenerateStreetAddress) ValidateAll() error {
+// ValidateAll checks the field values on Null with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in NullMultiError, or nil if none found.
+func (m *Null) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GenerateStreetAddress) validate(all bool) error {
+func (m *Null) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -6010,19 +8524,18 @@ func (m *GenerateStreetAddress) validate(all bool) error {
 	var errors []error
 
 	if len(errors) > 0 {
-		return GenerateStreetAddressMultiError(errors)
+		return NullMultiError(errors)
 	}
 
 	return nil
 }
 
-// GenerateStreetAddressMultiError is an error wrapping multiple validation
-// errors returned by GenerateStreetAddress.ValidateAll() if the designated
-// constraints aren't met.
-type GenerateStreetAddressMultiError []error
+// NullMultiError is an error wrapping multiple validation errors returned by
+// Null.ValidateAll() if the designated constraints aren't met.
+type NullMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GenerateStreetAddressMultiError) Error() string {
+func (m NullMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -6031,11 +8544,11 @@ func (m GenerateStreetAddressMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GenerateStreetAddressMultiError) AllErrors() []error { return m }
+func (m NullMultiError) AllErrors() []error { return m }
 
-// GenerateStreetAddressValidationError is the validation error returned by
-// GenerateStreetAddress.Validate if the designated constraints aren't met.
-type GenerateStreetAddressValidationError struct {
+// NullValidationError is the validation error returned by Null.Validate if the
+// designated constraints aren't met.
+type NullValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -6043,24 +8556,22 @@ type GenerateStreetAddressValidationError struct {
 }
 
 // Field function returns field value.
-func (e GenerateStreetAddressValidationError) Field() string { return e.field }
+func (e NullValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GenerateStreetAddressValidationError) Reason() string { return e.reason }
+func (e NullValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GenerateStreetAddressValidationError) Cause() error { return e.cause }
+func (e NullValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GenerateStreetAddressValidationError) Key() bool { return e.key }
+func (e NullValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GenerateStreetAddressValidationError) ErrorName() string {
-	return "GenerateStreetAddressValidationError"
-}
+func (e NullValidationError) ErrorName() string { return "NullValidationError" }
 
 // Error satisfies the builtin error interface
-func (e GenerateStreetAddressValidationError) Error() string {
+func (e NullValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -6072,14 +8583,14 @@ func (e GenerateStreetAddressValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGenerateStreetAddress.%s: %s%s",
+		"invalid %sNull.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GenerateStreetAddressValidationError{}
+var _ error = NullValidationError{}
 
 var _ interface {
 	Field() string
@@ -6087,48 +8598,46 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GenerateStreetAddressValidationError{}
+} = NullValidationError{}
 
-// Validate checks the field values on GenerateStringPhoneNumber with the rules
+// Validate checks the field values on TransformJavascript with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GenerateStringPhoneNumber) Validate() error {
+func (m *TransformJavascript) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GenerateStringPhoneNumber with the
-// rules defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on TransformJavascript with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GenerateStringPhoneNumberMultiError, or nil if none found.
-func (m *GenerateStringPhoneNumber) ValidateAll() error {
+// TransformJavascriptMultiError, or nil if none found.
+func (m *TransformJavascript) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GenerateStringPhoneNumber) validate(all bool) error {
+func (m *TransformJavascript) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Min
-
-	// no validation rules for Max
+	// no validation rules for Code
 
 	if len(errors) > 0 {
-		return GenerateStringPhoneNumberMultiError(errors)
+		return TransformJavascriptMultiError(errors)
 	}
 
 	return nil
 }
 
-// GenerateStringPhoneNumberMultiError is an error wrapping multiple validation
-// errors returned by GenerateStringPhoneNumber.ValidateAll() if the
-// designated constraints aren't met.
-type GenerateStringPhoneNumberMultiError []error
+// TransformJavascriptMultiError is an error wrapping multiple validation
+// errors returned by TransformJavascript.ValidateAll() if the designated
+// constraints aren't met.
+type TransformJavascriptMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GenerateStringPhoneNumberMultiError) Error() string {
+func (m TransformJavascriptMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -6137,11 +8646,11 @@ func (m GenerateStringPhoneNumberMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GenerateStringPhoneNumberMultiError) AllErrors() []error { return m }
+func (m TransformJavascriptMultiError) AllErrors() []error { return m }
 
-// GenerateStringPhoneNumberValidationError is the validation error returned by
-// GenerateStringPhoneNumber.Validate if the designated constraints aren't met.
-type GenerateStringPhoneNumberValidationError struct {
+// TransformJavascriptValidationError is the validation error returned by
+// TransformJavascript.Validate if the designated constraints aren't met.
+type TransformJavascriptValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -6149,24 +8658,24 @@ type GenerateStringPhoneNumberValidationError struct {
 }
 
 // Field function returns field value.
-func (e GenerateStringPhoneNumberValidationError) Field() string { return e.field }
+func (e TransformJavascriptValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GenerateStringPhoneNumberValidationError) Reason() string { return e.reason }
+func (e TransformJavascriptValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GenerateStringPhoneNumberValidationError) Cause() error { return e.cause }
+func (e TransformJavascriptValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GenerateStringPhoneNumberValidationError) Key() bool { return e.key }
+func (e TransformJavascriptValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GenerateStringPhoneNumberValidationError) ErrorName() string {
-	return "GenerateStringPhoneNumberValidationError"
+func (e TransformJavascriptValidationError) ErrorName() string {
+	return "TransformJavascriptValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GenerateStringPhoneNumberValidationError) Error() string {
+func (e TransformJavascriptValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -6178,14 +8687,14 @@ func (e GenerateStringPhoneNumberValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGenerateStringPhoneNumber.%s: %s%s",
+		"invalid %sTransformJavascript.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GenerateStringPhoneNumberValidationError{}
+var _ error = TransformJavascriptValidationError{}
 
 var _ interface {
 	Field() string
@@ -6193,48 +8702,46 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GenerateStringPhoneNumberValidationError{}
+} = TransformJavascriptValidationError{}
 
-// Validate checks the field values on GenerateString with the rules defined in
-// the proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *GenerateString) Validate() error {
+// Validate checks the field values on UserDefinedTransformerConfig with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UserDefinedTransformerConfig) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GenerateString with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in GenerateStringMultiError,
-// or nil if none found.
-func (m *GenerateString) ValidateAll() error {
+// ValidateAll checks the field values on UserDefinedTransformerConfig with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UserDefinedTransformerConfigMultiError, or nil if none found.
+func (m *UserDefinedTransformerConfig) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GenerateString) validate(all bool) error {
+func (m *UserDefinedTransformerConfig) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Min
-
-	// no validation rules for Max
+	// no validation rules for Id
 
 	if len(errors) > 0 {
-		return GenerateStringMultiError(errors)
+		return UserDefinedTransformerConfigMultiError(errors)
 	}
 
 	return nil
 }
 
-// GenerateStringMultiError is an error wrapping multiple validation errors
-// returned by GenerateString.ValidateAll() if the designated constraints
-// aren't met.
-type GenerateStringMultiError []error
+// UserDefinedTransformerConfigMultiError is an error wrapping multiple
+// validation errors returned by UserDefinedTransformerConfig.ValidateAll() if
+// the designated constraints aren't met.
+type UserDefinedTransformerConfigMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GenerateStringMultiError) Error() string {
+func (m UserDefinedTransformerConfigMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -6243,11 +8750,12 @@ func (m GenerateStringMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GenerateStringMultiError) AllErrors() []error { return m }
+func (m UserDefinedTransformerConfigMultiError) AllErrors() []error { return m }
 
-// GenerateStringValidationError is the validation error returned by
-// GenerateString.Validate if the designated constraints aren't met.
-type GenerateStringValidationError struct {
+// UserDefinedTransformerConfigValidationError is the validation error returned
+// by UserDefinedTransformerConfig.Validate if the designated constraints
+// aren't met.
+type UserDefinedTransformerConfigValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -6255,22 +8763,24 @@ type GenerateStringValidationError struct {
 }
 
 // Field function returns field value.
-func (e GenerateStringValidationError) Field() string { return e.field }
+func (e UserDefinedTransformerConfigValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GenerateStringValidationError) Reason() string { return e.reason }
+func (e UserDefinedTransformerConfigValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GenerateStringValidationError) Cause() error { return e.cause }
+func (e UserDefinedTransformerConfigValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GenerateStringValidationError) Key() bool { return e.key }
+func (e UserDefinedTransformerConfigValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GenerateStringValidationError) ErrorName() string { return "GenerateStringValidationError" }
+func (e UserDefinedTransformerConfigValidationError) ErrorName() string {
+	return "UserDefinedTransformerConfigValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e GenerateStringValidationError) Error() string {
+func (e UserDefinedTransformerConfigValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -6282,14 +8792,14 @@ func (e GenerateStringValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGenerateString.%s: %s%s",
+		"invalid %sUserDefinedTransformerConfig.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GenerateStringValidationError{}
+var _ error = UserDefinedTransformerConfigValidationError{}
 
 var _ interface {
 	Field() string
@@ -6297,44 +8807,50 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GenerateStringValidationError{}
+} = UserDefinedTransformerConfigValidationError{}
 
-// Validate checks the field values on GenerateUnixTimestamp with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GenerateUnixTimestamp) Validate() error {
+// Validate checks the field values on ValidateUserJavascriptCodeRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *ValidateUserJavascriptCodeRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GenerateUnixTimestamp with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// GenerateUnixTimestampMultiError, or nil if none found.
-func (m *GenerateUnixTimestamp) ValidateAll() error {
+// ValidateAll checks the field values on ValidateUserJavascriptCodeRequest
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// ValidateUserJavascriptCodeRequestMultiError, or nil if none found.
+func (m *ValidateUserJavascriptCodeRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GenerateUnixTimestamp) validate(all bool) error {
+func (m *ValidateUserJavascriptCodeRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	// no validation rules for AccountId
+
+	// no validation rules for Code
+
 	if len(errors) > 0 {
-		return GenerateUnixTimestampMultiError(errors)
+		return ValidateUserJavascriptCodeRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// GenerateUnixTimestampMultiError is an error wrapping multiple validation
-// errors returned by GenerateUnixTimestamp.ValidateAll() if the designated
+// ValidateUserJavascriptCodeRequestMultiError is an error wrapping multiple
+// validation errors returned by
+// ValidateUserJavascriptCodeRequest.ValidateAll() if the designated
 // constraints aren't met.
-type GenerateUnixTimestampMultiError []error
+type ValidateUserJavascriptCodeRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GenerateUnixTimestampMultiError) Error() string {
+func (m ValidateUserJavascriptCodeRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -6343,11 +8859,12 @@ func (m GenerateUnixTimestampMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GenerateUnixTimestampMultiError) AllErrors() []error { return m }
+func (m ValidateUserJavascriptCodeRequestMultiError) AllErrors() []error { return m }
 
-// GenerateUnixTimestampValidationError is the validation error returned by
-// GenerateUnixTimestamp.Validate if the designated constraints aren't met.
-type GenerateUnixTimestampValidationError struct {
+// ValidateUserJavascriptCodeRequestValidationError is the validation error
+// returned by ValidateUserJavascriptCodeRequest.Validate if the designated
+// constraints aren't met.
+type ValidateUserJavascriptCodeRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -6355,24 +8872,24 @@ type GenerateUnixTimestampValidationError struct {
 }
 
 // Field function returns field value.
-func (e GenerateUnixTimestampValidationError) Field() string { return e.field }
+func (e ValidateUserJavascriptCodeRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GenerateUnixTimestampValidationError) Reason() string { return e.reason }
+func (e ValidateUserJavascriptCodeRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GenerateUnixTimestampValidationError) Cause() error { return e.cause }
+func (e ValidateUserJavascriptCodeRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GenerateUnixTimestampValidationError) Key() bool { return e.key }
+func (e ValidateUserJavascriptCodeRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GenerateUnixTimestampValidationError) ErrorName() string {
-	return "GenerateUnixTimestampValidationError"
+func (e ValidateUserJavascriptCodeRequestValidationError) ErrorName() string {
+	return "ValidateUserJavascriptCodeRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GenerateUnixTimestampValidationError) Error() string {
+func (e ValidateUserJavascriptCodeRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -6384,14 +8901,14 @@ func (e GenerateUnixTimestampValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGenerateUnixTimestamp.%s: %s%s",
+		"invalid %sValidateUserJavascriptCodeRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GenerateUnixTimestampValidationError{}
+var _ error = ValidateUserJavascriptCodeRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -6399,44 +8916,48 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GenerateUnixTimestampValidationError{}
+} = ValidateUserJavascriptCodeRequestValidationError{}
 
-// Validate checks the field values on GenerateUsername with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *GenerateUsername) Validate() error {
+// Validate checks the field values on ValidateUserJavascriptCodeResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *ValidateUserJavascriptCodeResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GenerateUsername with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// GenerateUsernameMultiError, or nil if none found.
-func (m *GenerateUsername) ValidateAll() error {
+// ValidateAll checks the field values on ValidateUserJavascriptCodeResponse
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// ValidateUserJavascriptCodeResponseMultiError, or nil if none found.
+func (m *ValidateUserJavascriptCodeResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GenerateUsername) validate(all bool) error {
+func (m *ValidateUserJavascriptCodeResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	// no validation rules for Valid
+
 	if len(errors) > 0 {
-		return GenerateUsernameMultiError(errors)
+		return ValidateUserJavascriptCodeResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// GenerateUsernameMultiError is an error wrapping multiple validation errors
-// returned by GenerateUsername.ValidateAll() if the designated constraints
-// aren't met.
-type GenerateUsernameMultiError []error
+// ValidateUserJavascriptCodeResponseMultiError is an error wrapping multiple
+// validation errors returned by
+// ValidateUserJavascriptCodeResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ValidateUserJavascriptCodeResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GenerateUsernameMultiError) Error() string {
+func (m ValidateUserJavascriptCodeResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -6445,11 +8966,12 @@ func (m GenerateUsernameMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GenerateUsernameMultiError) AllErrors() []error { return m }
+func (m ValidateUserJavascriptCodeResponseMultiError) AllErrors() []error { return m }
 
-// GenerateUsernameValidationError is the validation error returned by
-// GenerateUsername.Validate if the designated constraints aren't met.
-type GenerateUsernameValidationError struct {
+// ValidateUserJavascriptCodeResponseValidationError is the validation error
+// returned by ValidateUserJavascriptCodeResponse.Validate if the designated
+// constraints aren't met.
+type ValidateUserJavascriptCodeResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -6457,22 +8979,24 @@ type GenerateUsernameValidationError struct {
 }
 
 // Field function returns field value.
-func (e GenerateUsernameValidationError) Field() string { return e.field }
+func (e ValidateUserJavascriptCodeResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GenerateUsernameValidationError) Reason() string { return e.reason }
+func (e ValidateUserJavascriptCodeResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GenerateUsernameValidationError) Cause() error { return e.cause }
+func (e ValidateUserJavascriptCodeResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GenerateUsernameValidationError) Key() bool { return e.key }
+func (e ValidateUserJavascriptCodeResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GenerateUsernameValidationError) ErrorName() string { return "GenerateUsernameValidationError" }
+func (e ValidateUserJavascriptCodeResponseValidationError) ErrorName() string {
+	return "ValidateUserJavascriptCodeResponseValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e GenerateUsernameValidationError) Error() string {
+func (e ValidateUserJavascriptCodeResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -6484,14 +9008,14 @@ func (e GenerateUsernameValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGenerateUsername.%s: %s%s",
+		"invalid %sValidateUserJavascriptCodeResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GenerateUsernameValidationError{}
+var _ error = ValidateUserJavascriptCodeResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -6499,44 +9023,46 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GenerateUsernameValidationError{}
+} = ValidateUserJavascriptCodeResponseValidationError{}
 
-// Validate checks the field values on GenerateUtcTimestamp with the rules
+// Validate checks the field values on GenerateCategorical with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GenerateUtcTimestamp) Validate() error {
+func (m *GenerateCategorical) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GenerateUtcTimestamp with the rules
+// ValidateAll checks the field values on GenerateCategorical with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GenerateUtcTimestampMultiError, or nil if none found.
-func (m *GenerateUtcTimestamp) ValidateAll() error {
+// GenerateCategoricalMultiError, or nil if none found.
+func (m *GenerateCategorical) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GenerateUtcTimestamp) validate(all bool) error {
+func (m *GenerateCategorical) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	// no validation rules for Categories
+
 	if len(errors) > 0 {
-		return GenerateUtcTimestampMultiError(errors)
+		return GenerateCategoricalMultiError(errors)
 	}
 
 	return nil
 }
 
-// GenerateUtcTimestampMultiError is an error wrapping multiple validation
-// errors returned by GenerateUtcTimestamp.ValidateAll() if the designated
+// GenerateCategoricalMultiError is an error wrapping multiple validation
+// errors returned by GenerateCategorical.ValidateAll() if the designated
 // constraints aren't met.
-type GenerateUtcTimestampMultiError []error
+type GenerateCategoricalMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GenerateUtcTimestampMultiError) Error() string {
+func (m GenerateCategoricalMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -6545,11 +9071,11 @@ func (m GenerateUtcTimestampMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GenerateUtcTimestampMultiError) AllErrors() []error { return m }
+func (m GenerateCategoricalMultiError) AllErrors() []error { return m }
 
-// GenerateUtcTimestampValidationError is the validation error returned by
-// GenerateUtcTimestamp.Validate if the designated constraints aren't met.
-type GenerateUtcTimestampValidationError struct {
+// GenerateCategoricalValidationError is the validation error returned by
+// GenerateCategorical.Validate if the designated constraints aren't met.
+type GenerateCategoricalValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -6557,24 +9083,24 @@ type GenerateUtcTimestampValidationError struct {
 }
 
 // Field function returns field value.
-func (e GenerateUtcTimestampValidationError) Field() string { return e.field }
+func (e GenerateCategoricalValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GenerateUtcTimestampValidationError) Reason() string { return e.reason }
+func (e GenerateCategoricalValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GenerateUtcTimestampValidationError) Cause() error { return e.cause }
+func (e GenerateCategoricalValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GenerateUtcTimestampValidationError) Key() bool { return e.key }
+func (e GenerateCategoricalValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GenerateUtcTimestampValidationError) ErrorName() string {
-	return "GenerateUtcTimestampValidationError"
+func (e GenerateCategoricalValidationError) ErrorName() string {
+	return "GenerateCategoricalValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GenerateUtcTimestampValidationError) Error() string {
+func (e GenerateCategoricalValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -6586,14 +9112,14 @@ func (e GenerateUtcTimestampValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGenerateUtcTimestamp.%s: %s%s",
+		"invalid %sGenerateCategorical.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GenerateUtcTimestampValidationError{}
+var _ error = GenerateCategoricalValidationError{}
 
 var _ interface {
 	Field() string
@@ -6601,45 +9127,50 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GenerateUtcTimestampValidationError{}
+} = GenerateCategoricalValidationError{}
 
-// Validate checks the field values on GenerateUuid with the rules defined in
-// the proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *GenerateUuid) Validate() error {
+// Validate checks the field values on TransformCharacterScramble with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *TransformCharacterScramble) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GenerateUuid with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in GenerateUuidMultiError, or
-// nil if none found.
-func (m *GenerateUuid) ValidateAll() error {
+// ValidateAll checks the field values on TransformCharacterScramble with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// TransformCharacterScrambleMultiError, or nil if none found.
+func (m *TransformCharacterScramble) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GenerateUuid) validate(all bool) error {
+func (m *TransformCharacterScramble) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for IncludeHyphens
+	// no validation rules for Deterministic
+
+	if m.UserProvidedRegex != nil {
+		// no validation rules for UserProvidedRegex
+	}
 
 	if len(errors) > 0 {
-		return GenerateUuidMultiError(errors)
+		return TransformCharacterScrambleMultiError(errors)
 	}
 
 	return nil
 }
 
-// GenerateUuidMultiError is an error wrapping multiple validation errors
-// returned by GenerateUuid.ValidateAll() if the designated constraints aren't met.
-type GenerateUuidMultiError []error
+// TransformCharacterScrambleMultiError is an error wrapping multiple
+// validation errors returned by TransformCharacterScramble.ValidateAll() if
+// the designated constraints aren't met.
+type TransformCharacterScrambleMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GenerateUuidMultiError) Error() string {
+func (m TransformCharacterScrambleMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -6648,11 +9179,11 @@ func (m GenerateUuidMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GenerateUuidMultiError) AllErrors() []error { return m }
+func (m TransformCharacterScrambleMultiError) AllErrors() []error { return m }
 
-// GenerateUuidValidationError is the validation error returned by
-// GenerateUuid.Validate if the designated constraints aren't met.
-type GenerateUuidValidationError struct {
+// TransformCharacterScrambleValidationError is the validation error returned
+// by TransformCharacterScramble.Validate if the designated constraints aren't met.
+type TransformCharacterScrambleValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -6660,22 +9191,24 @@ type GenerateUuidValidationError struct {
 }
 
 // Field function returns field value.
-func (e GenerateUuidValidationError) Field() string { return e.field }
+func (e TransformCharacterScrambleValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GenerateUuidValidationError) Reason() string { return e.reason }
+func (e TransformCharacterScrambleValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GenerateUuidValidationError) Cause() error { return e.cause }
+func (e TransformCharacterScrambleValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GenerateUuidValidationError) Key() bool { return e.key }
+func (e TransformCharacterScrambleValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GenerateUuidValidationError) ErrorName() string { return "GenerateUuidValidationError" }
+func (e TransformCharacterScrambleValidationError) ErrorName() string {
+	return "TransformCharacterScrambleValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e GenerateUuidValidationError) Error() string {
+func (e TransformCharacterScrambleValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -6687,14 +9220,14 @@ func (e GenerateUuidValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGenerateUuid.%s: %s%s",
+		"invalid %sTransformCharacterScramble.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GenerateUuidValidationError{}
+var _ error = TransformCharacterScrambleValidationError{}
 
 var _ interface {
 	Field() string
@@ -6702,44 +9235,46 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GenerateUuidValidationError{}
+} = TransformCharacterScrambleValidationError{}
 
-// Validate checks the field values on GenerateZipcode with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *GenerateZipcode) Validate() error {
+// Validate checks the field values on GenerateJavascript with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GenerateJavascript) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GenerateZipcode with the rules
+// ValidateAll checks the field values on GenerateJavascript with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GenerateZipcodeMultiError, or nil if none found.
-func (m *GenerateZipcode) ValidateAll() error {
+// GenerateJavascriptMultiError, or nil if none found.
+func (m *GenerateJavascript) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GenerateZipcode) validate(all bool) error {
+func (m *GenerateJavascript) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	// no validation rules for Code
+
 	if len(errors) > 0 {
-		return GenerateZipcodeMultiError(errors)
+		return GenerateJavascriptMultiError(errors)
 	}
 
 	return nil
 }
 
-// GenerateZipcodeMultiError is an error wrapping multiple validation errors
-// returned by GenerateZipcode.ValidateAll() if the designated constraints
+// GenerateJavascriptMultiError is an error wrapping multiple validation errors
+// returned by GenerateJavascript.ValidateAll() if the designated constraints
 // aren't met.
-type GenerateZipcodeMultiError []error
+type GenerateJavascriptMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GenerateZipcodeMultiError) Error() string {
+func (m GenerateJavascriptMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -6748,11 +9283,11 @@ func (m GenerateZipcodeMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GenerateZipcodeMultiError) AllErrors() []error { return m }
+func (m GenerateJavascriptMultiError) AllErrors() []error { return m }
 
-// GenerateZipcodeValidationError is the validation error returned by
-// GenerateZipcode.Validate if the designated constraints aren't met.
-type GenerateZipcodeValidationError struct {
+// GenerateJavascriptValidationError is the validation error returned by
+// GenerateJavascript.Validate if the designated constraints aren't met.
+type GenerateJavascriptValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -6760,22 +9295,24 @@ type GenerateZipcodeValidationError struct {
 }
 
 // Field function returns field value.
-func (e GenerateZipcodeValidationError) Field() string { return e.field }
+func (e GenerateJavascriptValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GenerateZipcodeValidationError) Reason() string { return e.reason }
+func (e GenerateJavascriptValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GenerateZipcodeValidationError) Cause() error { return e.cause }
+func (e GenerateJavascriptValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GenerateZipcodeValidationError) Key() bool { return e.key }
+func (e GenerateJavascriptValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GenerateZipcodeValidationError) ErrorName() string { return "GenerateZipcodeValidationError" }
+func (e GenerateJavascriptValidationError) ErrorName() string {
+	return "GenerateJavascriptValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e GenerateZipcodeValidationError) Error() string {
+func (e GenerateJavascriptValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -6787,14 +9324,14 @@ func (e GenerateZipcodeValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGenerateZipcode.%s: %s%s",
+		"invalid %sGenerateJavascript.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GenerateZipcodeValidationError{}
+var _ error = GenerateJavascriptValidationError{}
 
 var _ interface {
 	Field() string
@@ -6802,46 +9339,50 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GenerateZipcodeValidationError{}
+} = GenerateJavascriptValidationError{}
 
-// Validate checks the field values on TransformE164PhoneNumber with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *TransformE164PhoneNumber) Validate() error {
+// Validate checks the field values on TransformFormatPreservingEncrypt with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *TransformFormatPreservingEncrypt) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on TransformE164PhoneNumber with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// TransformE164PhoneNumberMultiError, or nil if none found.
-func (m *TransformE164PhoneNumber) ValidateAll() error {
+// ValidateAll checks the field values on TransformFormatPreservingEncrypt with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// TransformFormatPreservingEncryptMultiError, or nil if none found.
+func (m *TransformFormatPreservingEncrypt) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *TransformE164PhoneNumber) validate(all bool) error {
+func (m *TransformFormatPreservingEncrypt) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for PreserveLength
+	// no validation rules for Algorithm
+
+	// no validation rules for Tweak
 
 	if len(errors) > 0 {
-		return TransformE164PhoneNumberMultiError(errors)
+		return TransformFormatPreservingEncryptMultiError(errors)
 	}
 
 	return nil
 }
 
-// TransformE164PhoneNumberMultiError is an error wrapping multiple validation
-// errors returned by TransformE164PhoneNumber.ValidateAll() if the designated
+// TransformFormatPreservingEncryptMultiError is an error wrapping multiple
+// validation errors returned by
+// TransformFormatPreservingEncrypt.ValidateAll() if the designated
 // constraints aren't met.
-type TransformE164PhoneNumberMultiError []error
+type TransformFormatPreservingEncryptMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m TransformE164PhoneNumberMultiError) Error() string {
+func (m TransformFormatPreservingEncryptMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -6850,11 +9391,12 @@ func (m TransformE164PhoneNumberMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m TransformE164PhoneNumberMultiError) AllErrors() []error { return m }
+func (m TransformFormatPreservingEncryptMultiError) AllErrors() []error { return m }
 
-// TransformE164PhoneNumberValidationError is the validation error returned by
-// TransformE164PhoneNumber.Validate if the designated constraints aren't met.
-type TransformE164PhoneNumberValidationError struct {
+// TransformFormatPreservingEncryptValidationError is the validation error
+// returned by TransformFormatPreservingEncrypt.Validate if the designated
+// constraints aren't met.
+type TransformFormatPreservingEncryptValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -6862,24 +9404,24 @@ type TransformE164PhoneNumberValidationError struct {
 }
 
 // Field function returns field value.
-func (e TransformE164PhoneNumberValidationError) Field() string { return e.field }
+func (e TransformFormatPreservingEncryptValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e TransformE164PhoneNumberValidationError) Reason() string { return e.reason }
+func (e TransformFormatPreservingEncryptValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e TransformE164PhoneNumberValidationError) Cause() error { return e.cause }
+func (e TransformFormatPreservingEncryptValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e TransformE164PhoneNumberValidationError) Key() bool { return e.key }
+func (e TransformFormatPreservingEncryptValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e TransformE164PhoneNumberValidationError) ErrorName() string {
-	return "TransformE164PhoneNumberValidationError"
+func (e TransformFormatPreservingEncryptValidationError) ErrorName() string {
+	return "TransformFormatPreservingEncryptValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e TransformE164PhoneNumberValidationError) Error() string {
+func (e TransformFormatPreservingEncryptValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -6891,14 +9433,14 @@ func (e TransformE164PhoneNumberValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sTransformE164PhoneNumber.%s: %s%s",
+		"invalid %sTransformFormatPreservingEncrypt.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = TransformE164PhoneNumberValidationError{}
+var _ error = TransformFormatPreservingEncryptValidationError{}
 
 var _ interface {
 	Field() string
@@ -6906,46 +9448,64 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = TransformE164PhoneNumberValidationError{}
+} = TransformFormatPreservingEncryptValidationError{}
 
-// Validate checks the field values on TransformFirstName with the rules
+// Validate checks the field values on TransformTimestamp with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *TransformFirstName) Validate() error {
+func (m *TransformTimestamp) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on TransformFirstName with the rules
+// ValidateAll checks the field values on TransformTimestamp with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// TransformFirstNameMultiError, or nil if none found.
-func (m *TransformFirstName) ValidateAll() error {
+// TransformTimestampMultiError, or nil if none found.
+func (m *TransformTimestamp) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *TransformFirstName) validate(all bool) error {
+func (m *TransformTimestamp) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for PreserveLength
+	// no validation rules for Mode
+
+	// no validation rules for ShiftRangeDays
+
+	// no validation rules for JitterRangeSeconds
+
+	// no validation rules for TruncatePrecision
+
+	if m.EntityColumn != nil {
+		// no validation rules for EntityColumn
+	}
+
+	if m.Min != nil {
+		// no validation rules for Min
+	}
+
+	if m.Max != nil {
+		// no validation rules for Max
+	}
 
 	if len(errors) > 0 {
-		return TransformFirstNameMultiError(errors)
+		return TransformTimestampMultiError(errors)
 	}
 
 	return nil
 }
 
-// TransformFirstNameMultiError is an error wrapping multiple validation errors
-// returned by TransformFirstName.ValidateAll() if the designated constraints
+// TransformTimestampMultiError is an error wrapping multiple validation errors
+// returned by TransformTimestamp.ValidateAll() if the designated constraints
 // aren't met.
-type TransformFirstNameMultiError []error
+type TransformTimestampMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m TransformFirstNameMultiError) Error() string {
+func (m TransformTimestampMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -6954,11 +9514,11 @@ func (m TransformFirstNameMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m TransformFirstNameMultiError) AllErrors() []error { return m }
+func (m TransformTimestampMultiError) AllErrors() []error { return m }
 
-// TransformFirstNameValidationError is the validation error returned by
-// TransformFirstName.Validate if the designated constraints aren't met.
-type TransformFirstNameValidationError struct {
+// TransformTimestampValidationError is the validation error returned by
+// TransformTimestamp.Validate if the designated constraints aren't met.
+type TransformTimestampValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -6966,24 +9526,24 @@ type TransformFirstNameValidationError struct {
 }
 
 // Field function returns field value.
-func (e TransformFirstNameValidationError) Field() string { return e.field }
+func (e TransformTimestampValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e TransformFirstNameValidationError) Reason() string { return e.reason }
+func (e TransformTimestampValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e TransformFirstNameValidationError) Cause() error { return e.cause }
+func (e TransformTimestampValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e TransformFirstNameValidationError) Key() bool { return e.key }
+func (e TransformTimestampValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e TransformFirstNameValidationError) ErrorName() string {
-	return "TransformFirstNameValidationError"
+func (e TransformTimestampValidationError) ErrorName() string {
+	return "TransformTimestampValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e TransformFirstNameValidationError) Error() string {
+func (e TransformTimestampValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -6995,14 +9555,14 @@ func (e TransformFirstNameValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sTransformFirstName.%s: %s%s",
+		"invalid %sTransformTimestamp.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = TransformFirstNameValidationError{}
+var _ error = TransformTimestampValidationError{}
 
 var _ interface {
 	Field() string
@@ -7010,48 +9570,64 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = TransformFirstNameValidationError{}
+} = TransformTimestampValidationError{}
 
-// Validate checks the field values on TransformFloat64 with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *TransformFloat64) Validate() error {
+// Validate checks the field values on TransformDate with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *TransformDate) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on TransformFloat64 with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// TransformFloat64MultiError, or nil if none found.
-func (m *TransformFloat64) ValidateAll() error {
+// ValidateAll checks the field values on TransformDate with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in TransformDateMultiError, or
+// nil if none found.
+func (m *TransformDate) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *TransformFloat64) validate(all bool) error {
+func (m *TransformDate) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for RandomizationRangeMin
+	// no validation rules for Mode
 
-	// no validation rules for RandomizationRangeMax
+	// no validation rules for ShiftRangeDays
+
+	// no validation rules for JitterRangeDays
+
+	// no validation rules for TruncatePrecision
+
+	if m.EntityColumn != nil {
+		// no validation rules for EntityColumn
+	}
+
+	if m.Min != nil {
+		// no validation rules for Min
+	}
+
+	if m.Max != nil {
+		// no validation rules for Max
+	}
 
 	if len(errors) > 0 {
-		return TransformFloat64MultiError(errors)
+		return TransformDateMultiError(errors)
 	}
 
 	return nil
 }
 
-// TransformFloat64MultiError is an error wrapping multiple validation errors
-// returned by TransformFloat64.ValidateAll() if the designated constraints
+// TransformDateMultiError is an error wrapping multiple validation errors
+// returned by TransformDate.ValidateAll() if the designated constraints
 // aren't met.
-type TransformFloat64MultiError []error
+type TransformDateMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m TransformFloat64MultiError) Error() string {
+func (m TransformDateMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -7060,11 +9636,11 @@ func (m TransformFloat64MultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m TransformFloat64MultiError) AllErrors() []error { return m }
+func (m TransformDateMultiError) AllErrors() []error { return m }
 
-// TransformFloat64ValidationError is the validation error returned by
-// TransformFloat64.Validate if the designated constraints aren't met.
-type TransformFloat64ValidationError struct {
+// TransformDateValidationError is the validation error returned by
+// TransformDate.Validate if the designated constraints aren't met.
+type TransformDateValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -7072,22 +9648,22 @@ type TransformFloat64ValidationError struct {
 }
 
 // Field function returns field value.
-func (e TransformFloat64ValidationError) Field() string { return e.field }
+func (e TransformDateValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e TransformFloat64ValidationError) Reason() string { return e.reason }
+func (e TransformDateValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e TransformFloat64ValidationError) Cause() error { return e.cause }
+func (e TransformDateValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e TransformFloat64ValidationError) Key() bool { return e.key }
+func (e TransformDateValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e TransformFloat64ValidationError) ErrorName() string { return "TransformFloat64ValidationError" }
+func (e TransformDateValidationError) ErrorName() string { return "TransformDateValidationError" }
 
 // Error satisfies the builtin error interface
-func (e TransformFloat64ValidationError) Error() string {
+func (e TransformDateValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -7099,14 +9675,14 @@ func (e TransformFloat64ValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sTransformFloat64.%s: %s%s",
+		"invalid %sTransformDate.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = TransformFloat64ValidationError{}
+var _ error = TransformDateValidationError{}
 
 var _ interface {
 	Field() string
@@ -7114,46 +9690,78 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = TransformFloat64ValidationError{}
+} = TransformDateValidationError{}
 
-// Validate checks the field values on TransformFullName with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *TransformFullName) Validate() error {
+// Validate checks the field values on TransformJson with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *TransformJson) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on TransformFullName with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// TransformFullNameMultiError, or nil if none found.
-func (m *TransformFullName) ValidateAll() error {
+// ValidateAll checks the field values on TransformJson with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in TransformJsonMultiError, or
+// nil if none found.
+func (m *TransformJson) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *TransformFullName) validate(all bool) error {
+func (m *TransformJson) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for PreserveLength
+	for idx, item := range m.GetRules() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, TransformJsonValidationError{
+						field:  fmt.Sprintf("Rules[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, TransformJsonValidationError{
+						field:  fmt.Sprintf("Rules[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return TransformJsonValidationError{
+					field:  fmt.Sprintf("Rules[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
 
 	if len(errors) > 0 {
-		return TransformFullNameMultiError(errors)
+		return TransformJsonMultiError(errors)
 	}
 
 	return nil
 }
 
-// TransformFullNameMultiError is an error wrapping multiple validation errors
-// returned by TransformFullName.ValidateAll() if the designated constraints
+// TransformJsonMultiError is an error wrapping multiple validation errors
+// returned by TransformJson.ValidateAll() if the designated constraints
 // aren't met.
-type TransformFullNameMultiError []error
+type TransformJsonMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m TransformFullNameMultiError) Error() string {
+func (m TransformJsonMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -7162,11 +9770,11 @@ func (m TransformFullNameMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m TransformFullNameMultiError) AllErrors() []error { return m }
+func (m TransformJsonMultiError) AllErrors() []error { return m }
 
-// TransformFullNameValidationError is the validation error returned by
-// TransformFullName.Validate if the designated constraints aren't met.
-type TransformFullNameValidationError struct {
+// TransformJsonValidationError is the validation error returned by
+// TransformJson.Validate if the designated constraints aren't met.
+type TransformJsonValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -7174,24 +9782,22 @@ type TransformFullNameValidationError struct {
 }
 
 // Field function returns field value.
-func (e TransformFullNameValidationError) Field() string { return e.field }
+func (e TransformJsonValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e TransformFullNameValidationError) Reason() string { return e.reason }
+func (e TransformJsonValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e TransformFullNameValidationError) Cause() error { return e.cause }
+func (e TransformJsonValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e TransformFullNameValidationError) Key() bool { return e.key }
+func (e TransformJsonValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e TransformFullNameValidationError) ErrorName() string {
-	return "TransformFullNameValidationError"
-}
+func (e TransformJsonValidationError) ErrorName() string { return "TransformJsonValidationError" }
 
 // Error satisfies the builtin error interface
-func (e TransformFullNameValidationError) Error() string {
+func (e TransformJsonValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -7203,14 +9809,14 @@ func (e TransformFullNameValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sTransformFullName.%s: %s%s",
+		"invalid %sTransformJson.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = TransformFullNameValidationError{}
+var _ error = TransformJsonValidationError{}
 
 var _ interface {
 	Field() string
@@ -7218,46 +9824,77 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = TransformFullNameValidationError{}
+} = TransformJsonValidationError{}
 
-// Validate checks the field values on TransformInt64PhoneNumber with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *TransformInt64PhoneNumber) Validate() error {
+// Validate checks the field values on TransformJsonRule with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *TransformJsonRule) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on TransformInt64PhoneNumber with the
-// rules defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on TransformJsonRule with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// TransformInt64PhoneNumberMultiError, or nil if none found.
-func (m *TransformInt64PhoneNumber) ValidateAll() error {
+// TransformJsonRuleMultiError, or nil if none found.
+func (m *TransformJsonRule) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *TransformInt64PhoneNumber) validate(all bool) error {
+func (m *TransformJsonRule) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for PreserveLength
+	// no validation rules for Path
+
+	// no validation rules for Source
+
+	if all {
+		switch v := interface{}(m.GetConfig()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, TransformJsonRuleValidationError{
+					field:  "Config",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, TransformJsonRuleValidationError{
+					field:  "Config",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetConfig()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return TransformJsonRuleValidationError{
+				field:  "Config",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
 
 	if len(errors) > 0 {
-		return TransformInt64PhoneNumberMultiError(errors)
+		return TransformJsonRuleMultiError(errors)
 	}
 
 	return nil
 }
 
-// TransformInt64PhoneNumberMultiError is an error wrapping multiple validation
-// errors returned by TransformInt64PhoneNumber.ValidateAll() if the
-// designated constraints aren't met.
-type TransformInt64PhoneNumberMultiError []error
+// TransformJsonRuleMultiError is an error wrapping multiple validation errors
+// returned by TransformJsonRule.ValidateAll() if the designated constraints
+// aren't met.
+type TransformJsonRuleMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m TransformInt64PhoneNumberMultiError) Error() string {
+func (m TransformJsonRuleMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -7266,11 +9903,11 @@ func (m TransformInt64PhoneNumberMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m TransformInt64PhoneNumberMultiError) AllErrors() []error { return m }
+func (m TransformJsonRuleMultiError) AllErrors() []error { return m }
 
-// TransformInt64PhoneNumberValidationError is the validation error returned by
-// TransformInt64PhoneNumber.Validate if the designated constraints aren't met.
-type TransformInt64PhoneNumberValidationError struct {
+// TransformJsonRuleValidationError is the validation error returned by
+// TransformJsonRule.Validate if the designated constraints aren't met.
+type TransformJsonRuleValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -7278,24 +9915,24 @@ type TransformInt64PhoneNumberValidationError struct {
 }
 
 // Field function returns field value.
-func (e TransformInt64PhoneNumberValidationError) Field() string { return e.field }
+func (e TransformJsonRuleValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e TransformInt64PhoneNumberValidationError) Reason() string { return e.reason }
+func (e TransformJsonRuleValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e TransformInt64PhoneNumberValidationError) Cause() error { return e.cause }
+func (e TransformJsonRuleValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e TransformInt64PhoneNumberValidationError) Key() bool { return e.key }
+func (e TransformJsonRuleValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e TransformInt64PhoneNumberValidationError) ErrorName() string {
-	return "TransformInt64PhoneNumberValidationError"
+func (e TransformJsonRuleValidationError) ErrorName() string {
+	return "TransformJsonRuleValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e TransformInt64PhoneNumberValidationError) Error() string {
+func (e TransformJsonRuleValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -7307,14 +9944,14 @@ func (e TransformInt64PhoneNumberValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sTransformInt64PhoneNumber.%s: %s%s",
+		"invalid %sTransformJsonRule.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = TransformInt64PhoneNumberValidationError{}
+var _ error = TransformJsonRuleValidationError{}
 
 var _ interface {
 	Field() string
@@ -7322,48 +9959,46 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = TransformInt64PhoneNumberValidationError{}
+} = TransformJsonRuleValidationError{}
 
-// Validate checks the field values on TransformInt64 with the rules defined in
-// the proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *TransformInt64) Validate() error {
+// Validate checks the field values on TransformFreeText with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *TransformFreeText) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on TransformInt64 with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in TransformInt64MultiError,
-// or nil if none found.
-func (m *TransformInt64) ValidateAll() error {
+// ValidateAll checks the field values on TransformFreeText with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// TransformFreeTextMultiError, or nil if none found.
+func (m *TransformFreeText) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *TransformInt64) validate(all bool) error {
+func (m *TransformFreeText) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for RandomizationRangeMin
-
-	// no validation rules for RandomizationRangeMax
+	// no validation rules for Strategy
 
 	if len(errors) > 0 {
-		return TransformInt64MultiError(errors)
+		return TransformFreeTextMultiError(errors)
 	}
 
 	return nil
 }
 
-// TransformInt64MultiError is an error wrapping multiple validation errors
-// returned by TransformInt64.ValidateAll() if the designated constraints
+// TransformFreeTextMultiError is an error wrapping multiple validation errors
+// returned by TransformFreeText.ValidateAll() if the designated constraints
 // aren't met.
-type TransformInt64MultiError []error
+type TransformFreeTextMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m TransformInt64MultiError) Error() string {
+func (m TransformFreeTextMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -7372,11 +10007,11 @@ func (m TransformInt64MultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m TransformInt64MultiError) AllErrors() []error { return m }
+func (m TransformFreeTextMultiError) AllErrors() []error { return m }
 
-// TransformInt64ValidationError is the validation error returned by
-// TransformInt64.Validate if the designated constraints aren't met.
-type TransformInt64ValidationError struct {
+// TransformFreeTextValidationError is the validation error returned by
+// TransformFreeText.Validate if the designated constraints aren't met.
+type TransformFreeTextValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -7384,22 +10019,24 @@ type TransformInt64ValidationError struct {
 }
 
 // Field function returns field value.
-func (e TransformInt64ValidationError) Field() string { return e.field }
+func (e TransformFreeTextValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e TransformInt64ValidationError) Reason() string { return e.reason }
+func (e TransformFreeTextValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e TransformInt64ValidationError) Cause() error { return e.cause }
+func (e TransformFreeTextValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e TransformInt64ValidationError) Key() bool { return e.key }
+func (e TransformFreeTextValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e TransformInt64ValidationError) ErrorName() string { return "TransformInt64ValidationError" }
+func (e TransformFreeTextValidationError) ErrorName() string {
+	return "TransformFreeTextValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e TransformInt64ValidationError) Error() string {
+func (e TransformFreeTextValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -7411,14 +10048,14 @@ func (e TransformInt64ValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sTransformInt64.%s: %s%s",
+		"invalid %sTransformFreeText.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = TransformInt64ValidationError{}
+var _ error = TransformFreeTextValidationError{}
 
 var _ interface {
 	Field() string
@@ -7426,46 +10063,46 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = TransformInt64ValidationError{}
+} = TransformFreeTextValidationError{}
 
-// Validate checks the field values on TransformLastName with the rules defined
+// Validate checks the field values on GenerateIpAddress with the rules defined
 // in the proto definition for this message. If any rules are violated, the
 // first error encountered is returned, or nil if there are no violations.
-func (m *TransformLastName) Validate() error {
+func (m *GenerateIpAddress) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on TransformLastName with the rules
+// ValidateAll checks the field values on GenerateIpAddress with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// TransformLastNameMultiError, or nil if none found.
-func (m *TransformLastName) ValidateAll() error {
+// GenerateIpAddressMultiError, or nil if none found.
+func (m *GenerateIpAddress) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *TransformLastName) validate(all bool) error {
+func (m *GenerateIpAddress) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for PreserveLength
+	// no validation rules for Version
 
 	if len(errors) > 0 {
-		return TransformLastNameMultiError(errors)
+		return GenerateIpAddressMultiError(errors)
 	}
 
 	return nil
 }
 
-// TransformLastNameMultiError is an error wrapping multiple validation errors
-// returned by TransformLastName.ValidateAll() if the designated constraints
+// GenerateIpAddressMultiError is an error wrapping multiple validation errors
+// returned by GenerateIpAddress.ValidateAll() if the designated constraints
 // aren't met.
-type TransformLastNameMultiError []error
+type GenerateIpAddressMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m TransformLastNameMultiError) Error() string {
+func (m GenerateIpAddressMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -7474,11 +10111,11 @@ func (m TransformLastNameMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m TransformLastNameMultiError) AllErrors() []error { return m }
+func (m GenerateIpAddressMultiError) AllErrors() []error { return m }
 
-// TransformLastNameValidationError is the validation error returned by
-// TransformLastName.Validate if the designated constraints aren't met.
-type TransformLastNameValidationError struct {
+// GenerateIpAddressValidationError is the validation error returned by
+// GenerateIpAddress.Validate if the designated constraints aren't met.
+type GenerateIpAddressValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -7486,24 +10123,24 @@ type TransformLastNameValidationError struct {
 }
 
 // Field function returns field value.
-func (e TransformLastNameValidationError) Field() string { return e.field }
+func (e GenerateIpAddressValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e TransformLastNameValidationError) Reason() string { return e.reason }
+func (e GenerateIpAddressValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e TransformLastNameValidationError) Cause() error { return e.cause }
+func (e GenerateIpAddressValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e TransformLastNameValidationError) Key() bool { return e.key }
+func (e GenerateIpAddressValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e TransformLastNameValidationError) ErrorName() string {
-	return "TransformLastNameValidationError"
+func (e GenerateIpAddressValidationError) ErrorName() string {
+	return "GenerateIpAddressValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e TransformLastNameValidationError) Error() string {
+func (e GenerateIpAddressValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -7515,14 +10152,14 @@ func (e TransformLastNameValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sTransformLastName.%s: %s%s",
+		"invalid %sGenerateIpAddress.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = TransformLastNameValidationError{}
+var _ error = GenerateIpAddressValidationError{}
 
 var _ interface {
 	Field() string
@@ -7530,46 +10167,50 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = TransformLastNameValidationError{}
+} = GenerateIpAddressValidationError{}
 
-// Validate checks the field values on TransformPhoneNumber with the rules
+// Validate checks the field values on TransformIpAddress with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *TransformPhoneNumber) Validate() error {
+func (m *TransformIpAddress) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on TransformPhoneNumber with the rules
+// ValidateAll checks the field values on TransformIpAddress with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// TransformPhoneNumberMultiError, or nil if none found.
-func (m *TransformPhoneNumber) ValidateAll() error {
+// TransformIpAddressMultiError, or nil if none found.
+func (m *TransformIpAddress) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *TransformPhoneNumber) validate(all bool) error {
+func (m *TransformIpAddress) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for PreserveLength
+	// no validation rules for PreservePrefixLength
+
+	// no validation rules for PreserveClass
+
+	// no validation rules for Deterministic
 
 	if len(errors) > 0 {
-		return TransformPhoneNumberMultiError(errors)
+		return TransformIpAddressMultiError(errors)
 	}
 
 	return nil
 }
 
-// TransformPhoneNumberMultiError is an error wrapping multiple validation
-// errors returned by TransformPhoneNumber.ValidateAll() if the designated
-// constraints aren't met.
-type TransformPhoneNumberMultiError []error
+// TransformIpAddressMultiError is an error wrapping multiple validation errors
+// returned by TransformIpAddress.ValidateAll() if the designated constraints
+// aren't met.
+type TransformIpAddressMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m TransformPhoneNumberMultiError) Error() string {
+func (m TransformIpAddressMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -7578,11 +10219,11 @@ func (m TransformPhoneNumberMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m TransformPhoneNumberMultiError) AllErrors() []error { return m }
+func (m TransformIpAddressMultiError) AllErrors() []error { return m }
 
-// TransformPhoneNumberValidationError is the validation error returned by
-// TransformPhoneNumber.Validate if the designated constraints aren't met.
-type TransformPhoneNumberValidationError struct {
+// TransformIpAddressValidationError is the validation error returned by
+// TransformIpAddress.Validate if the designated constraints aren't met.
+type TransformIpAddressValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -7590,24 +10231,24 @@ type TransformPhoneNumberValidationError struct {
 }
 
 // Field function returns field value.
-func (e TransformPhoneNumberValidationError) Field() string { return e.field }
+func (e TransformIpAddressValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e TransformPhoneNumberValidationError) Reason() string { return e.reason }
+func (e TransformIpAddressValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e TransformPhoneNumberValidationError) Cause() error { return e.cause }
+func (e TransformIpAddressValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e TransformPhoneNumberValidationError) Key() bool { return e.key }
+func (e TransformIpAddressValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e TransformPhoneNumberValidationError) ErrorName() string {
-	return "TransformPhoneNumberValidationError"
+func (e TransformIpAddressValidationError) ErrorName() string {
+	return "TransformIpAddressValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e TransformPhoneNumberValidationError) Error() string {
+func (e TransformIpAddressValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -7619,14 +10260,14 @@ func (e TransformPhoneNumberValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sTransformPhoneNumber.%s: %s%s",
+		"invalid %sTransformIpAddress.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = TransformPhoneNumberValidationError{}
+var _ error = TransformIpAddressValidationError{}
 
 var _ interface {
 	Field() string
@@ -7634,46 +10275,44 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = TransformPhoneNumberValidationError{}
+} = TransformIpAddressValidationError{}
 
-// Validate checks the field values on TransformString with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *TransformString) Validate() error {
+// Validate checks the field values on GenerateMacAddress with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GenerateMacAddress) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on TransformString with the rules
+// ValidateAll checks the field values on GenerateMacAddress with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// TransformStringMultiError, or nil if none found.
-func (m *TransformString) ValidateAll() error {
+// GenerateMacAddressMultiError, or nil if none found.
+func (m *GenerateMacAddress) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *TransformString) validate(all bool) error {
+func (m *GenerateMacAddress) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for PreserveLength
-
 	if len(errors) > 0 {
-		return TransformStringMultiError(errors)
+		return GenerateMacAddressMultiError(errors)
 	}
 
 	return nil
 }
 
-// TransformStringMultiError is an error wrapping multiple validation errors
-// returned by TransformString.ValidateAll() if the designated constraints
+// GenerateMacAddressMultiError is an error wrapping multiple validation errors
+// returned by GenerateMacAddress.ValidateAll() if the designated constraints
 // aren't met.
-type TransformStringMultiError []error
+type GenerateMacAddressMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m TransformStringMultiError) Error() string {
+func (m GenerateMacAddressMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -7682,11 +10321,11 @@ func (m TransformStringMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m TransformStringMultiError) AllErrors() []error { return m }
+func (m GenerateMacAddressMultiError) AllErrors() []error { return m }
 
-// TransformStringValidationError is the validation error returned by
-// TransformString.Validate if the designated constraints aren't met.
-type TransformStringValidationError struct {
+// GenerateMacAddressValidationError is the validation error returned by
+// GenerateMacAddress.Validate if the designated constraints aren't met.
+type GenerateMacAddressValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -7694,22 +10333,24 @@ type TransformStringValidationError struct {
 }
 
 // Field function returns field value.
-func (e TransformStringValidationError) Field() string { return e.field }
+func (e GenerateMacAddressValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e TransformStringValidationError) Reason() string { return e.reason }
+func (e GenerateMacAddressValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e TransformStringValidationError) Cause() error { return e.cause }
+func (e GenerateMacAddressValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e TransformStringValidationError) Key() bool { return e.key }
+func (e GenerateMacAddressValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e TransformStringValidationError) ErrorName() string { return "TransformStringValidationError" }
+func (e GenerateMacAddressValidationError) ErrorName() string {
+	return "GenerateMacAddressValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e TransformStringValidationError) Error() string {
+func (e GenerateMacAddressValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -7721,14 +10362,14 @@ func (e TransformStringValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sTransformString.%s: %s%s",
+		"invalid %sGenerateMacAddress.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = TransformStringValidationError{}
+var _ error = GenerateMacAddressValidationError{}
 
 var _ interface {
 	Field() string
@@ -7736,43 +10377,48 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = TransformStringValidationError{}
+} = GenerateMacAddressValidationError{}
 
-// Validate checks the field values on Passthrough with the rules defined in
-// the proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *Passthrough) Validate() error {
+// Validate checks the field values on TransformMacAddress with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *TransformMacAddress) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on Passthrough with the rules defined in
-// the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in PassthroughMultiError, or
-// nil if none found.
-func (m *Passthrough) ValidateAll() error {
+// ValidateAll checks the field values on TransformMacAddress with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// TransformMacAddressMultiError, or nil if none found.
+func (m *TransformMacAddress) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *Passthrough) validate(all bool) error {
+func (m *TransformMacAddress) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	// no validation rules for PreserveOui
+
+	// no validation rules for Deterministic
+
 	if len(errors) > 0 {
-		return PassthroughMultiError(errors)
+		return TransformMacAddressMultiError(errors)
 	}
 
 	return nil
 }
 
-// PassthroughMultiError is an error wrapping multiple validation errors
-// returned by Passthrough.ValidateAll() if the designated constraints aren't met.
-type PassthroughMultiError []error
+// TransformMacAddressMultiError is an error wrapping multiple validation
+// errors returned by TransformMacAddress.ValidateAll() if the designated
+// constraints aren't met.
+type TransformMacAddressMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m PassthroughMultiError) Error() string {
+func (m TransformMacAddressMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -7781,11 +10427,11 @@ func (m PassthroughMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m PassthroughMultiError) AllErrors() []error { return m }
+func (m TransformMacAddressMultiError) AllErrors() []error { return m }
 
-// PassthroughValidationError is the validation error returned by
-// Passthrough.Validate if the designated constraints aren't met.
-type PassthroughValidationError struct {
+// TransformMacAddressValidationError is the validation error returned by
+// TransformMacAddress.Validate if the designated constraints aren't met.
+type TransformMacAddressValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -7793,22 +10439,24 @@ type PassthroughValidationError struct {
 }
 
 // Field function returns field value.
-func (e PassthroughValidationError) Field() string { return e.field }
+func (e TransformMacAddressValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e PassthroughValidationError) Reason() string { return e.reason }
+func (e TransformMacAddressValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e PassthroughValidationError) Cause() error { return e.cause }
+func (e TransformMacAddressValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e PassthroughValidationError) Key() bool { return e.key }
+func (e TransformMacAddressValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e PassthroughValidationError) ErrorName() string { return "PassthroughValidationError" }
+func (e TransformMacAddressValidationError) ErrorName() string {
+	return "TransformMacAddressValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e PassthroughValidationError) Error() string {
+func (e TransformMacAddressValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -7820,14 +10468,14 @@ func (e PassthroughValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sPassthrough.%s: %s%s",
+		"invalid %sTransformMacAddress.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = PassthroughValidationError{}
+var _ error = TransformMacAddressValidationError{}
 
 var _ interface {
 	Field() string
@@ -7835,23 +10483,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = PassthroughValidationError{}
+} = TransformMacAddressValidationError{}
 
-// Validate checks the field values on Null with the rules defined in the proto
-// definition for this message. If any rules are violated, the first error
-// encountered is returned, or nil if there are no violations.
-func (m *Null) Validate() error {
+// Validate checks the field values on GenerateUrl with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *GenerateUrl) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on Null with the rules defined in the
-// proto definition for this message. If any rules are violated, the result is
-// a list of violation errors wrapped in NullMultiError, or nil if none found.
-func (m *Null) ValidateAll() error {
+// ValidateAll checks the field values on GenerateUrl with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in GenerateUrlMultiError, or
+// nil if none found.
+func (m *GenerateUrl) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *Null) validate(all bool) error {
+func (m *GenerateUrl) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -7859,18 +10508,18 @@ func (m *Null) validate(all bool) error {
 	var errors []error
 
 	if len(errors) > 0 {
-		return NullMultiError(errors)
+		return GenerateUrlMultiError(errors)
 	}
 
 	return nil
 }
 
-// NullMultiError is an error wrapping multiple validation errors returned by
-// Null.ValidateAll() if the designated constraints aren't met.
-type NullMultiError []error
+// GenerateUrlMultiError is an error wrapping multiple validation errors
+// returned by GenerateUrl.ValidateAll() if the designated constraints aren't met.
+type GenerateUrlMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m NullMultiError) Error() string {
+func (m GenerateUrlMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -7879,11 +10528,11 @@ func (m NullMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m NullMultiError) AllErrors() []error { return m }
+func (m GenerateUrlMultiError) AllErrors() []error { return m }
 
-// NullValidationError is the validation error returned by Null.Validate if the
-// designated constraints aren't met.
-type NullValidationError struct {
+// GenerateUrlValidationError is the validation error returned by
+// GenerateUrl.Validate if the designated constraints aren't met.
+type GenerateUrlValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -7891,22 +10540,22 @@ type NullValidationError struct {
 }
 
 // Field function returns field value.
-func (e NullValidationError) Field() string { return e.field }
+func (e GenerateUrlValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e NullValidationError) Reason() string { return e.reason }
+func (e GenerateUrlValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e NullValidationError) Cause() error { return e.cause }
+func (e GenerateUrlValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e NullValidationError) Key() bool { return e.key }
+func (e GenerateUrlValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e NullValidationError) ErrorName() string { return "NullValidationError" }
+func (e GenerateUrlValidationError) ErrorName() string { return "GenerateUrlValidationError" }
 
 // Error satisfies the builtin error interface
-func (e NullValidationError) Error() string {
+func (e GenerateUrlValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -7918,14 +10567,14 @@ func (e NullValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sNull.%s: %s%s",
+		"invalid %sGenerateUrl.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = NullValidationError{}
+var _ error = GenerateUrlValidationError{}
 
 var _ interface {
 	Field() string
@@ -7933,46 +10582,47 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = NullValidationError{}
+} = GenerateUrlValidationError{}
 
-// Validate checks the field values on TransformJavascript with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *TransformJavascript) Validate() error {
+// Validate checks the field values on TransformUrl with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *TransformUrl) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on TransformJavascript with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// TransformJavascriptMultiError, or nil if none found.
-func (m *TransformJavascript) ValidateAll() error {
+// ValidateAll checks the field values on TransformUrl with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in TransformUrlMultiError, or
+// nil if none found.
+func (m *TransformUrl) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *TransformJavascript) validate(all bool) error {
+func (m *TransformUrl) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Code
+	// no validation rules for PreservePath
+
+	// no validation rules for Deterministic
 
 	if len(errors) > 0 {
-		return TransformJavascriptMultiError(errors)
+		return TransformUrlMultiError(errors)
 	}
 
 	return nil
 }
 
-// TransformJavascriptMultiError is an error wrapping multiple validation
-// errors returned by TransformJavascript.ValidateAll() if the designated
-// constraints aren't met.
-type TransformJavascriptMultiError []error
+// TransformUrlMultiError is an error wrapping multiple validation errors
+// returned by TransformUrl.ValidateAll() if the designated constraints aren't met.
+type TransformUrlMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m TransformJavascriptMultiError) Error() string {
+func (m TransformUrlMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -7981,11 +10631,11 @@ func (m TransformJavascriptMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m TransformJavascriptMultiError) AllErrors() []error { return m }
+func (m TransformUrlMultiError) AllErrors() []error { return m }
 
-// TransformJavascriptValidationError is the validation error returned by
-// TransformJavascript.Validate if the designated constraints aren't met.
-type TransformJavascriptValidationError struct {
+// TransformUrlValidationError is the validation error returned by
+// TransformUrl.Validate if the designated constraints aren't met.
+type TransformUrlValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -7993,24 +10643,22 @@ type TransformJavascriptValidationError struct {
 }
 
 // Field function returns field value.
-func (e TransformJavascriptValidationError) Field() string { return e.field }
+func (e TransformUrlValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e TransformJavascriptValidationError) Reason() string { return e.reason }
+func (e TransformUrlValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e TransformJavascriptValidationError) Cause() error { return e.cause }
+func (e TransformUrlValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e TransformJavascriptValidationError) Key() bool { return e.key }
+func (e TransformUrlValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e TransformJavascriptValidationError) ErrorName() string {
-	return "TransformJavascriptValidationError"
-}
+func (e TransformUrlValidationError) ErrorName() string { return "TransformUrlValidationError" }
 
 // Error satisfies the builtin error interface
-func (e TransformJavascriptValidationError) Error() string {
+func (e TransformUrlValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -8022,14 +10670,14 @@ func (e TransformJavascriptValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sTransformJavascript.%s: %s%s",
+		"invalid %sTransformUrl.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = TransformJavascriptValidationError{}
+var _ error = TransformUrlValidationError{}
 
 var _ interface {
 	Field() string
@@ -8037,46 +10685,44 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = TransformJavascriptValidationError{}
+} = TransformUrlValidationError{}
 
-// Validate checks the field values on UserDefinedTransformerConfig with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *UserDefinedTransformerConfig) Validate() error {
+// Validate checks the field values on GenerateDomain with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *GenerateDomain) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on UserDefinedTransformerConfig with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// UserDefinedTransformerConfigMultiError, or nil if none found.
-func (m *UserDefinedTransformerConfig) ValidateAll() error {
+// ValidateAll checks the field values on GenerateDomain with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in GenerateDomainMultiError,
+// or nil if none found.
+func (m *GenerateDomain) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *UserDefinedTransformerConfig) validate(all bool) error {
+func (m *GenerateDomain) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Id
-
 	if len(errors) > 0 {
-		return UserDefinedTransformerConfigMultiError(errors)
+		return GenerateDomainMultiError(errors)
 	}
 
 	return nil
 }
 
-// UserDefinedTransformerConfigMultiError is an error wrapping multiple
-// validation errors returned by UserDefinedTransformerConfig.ValidateAll() if
-// the designated constraints aren't met.
-type UserDefinedTransformerConfigMultiError []error
+// GenerateDomainMultiError is an error wrapping multiple validation errors
+// returned by GenerateDomain.ValidateAll() if the designated constraints
+// aren't met.
+type GenerateDomainMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m UserDefinedTransformerConfigMultiError) Error() string {
+func (m GenerateDomainMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -8085,12 +10731,11 @@ func (m UserDefinedTransformerConfigMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m UserDefinedTransformerConfigMultiError) AllErrors() []error { return m }
+func (m GenerateDomainMultiError) AllErrors() []error { return m }
 
-// UserDefinedTransformerConfigValidationError is the validation error returned
-// by UserDefinedTransformerConfig.Validate if the designated constraints
-// aren't met.
-type UserDefinedTransformerConfigValidationError struct {
+// GenerateDomainValidationError is the validation error returned by
+// GenerateDomain.Validate if the designated constraints aren't met.
+type GenerateDomainValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -8098,24 +10743,22 @@ type UserDefinedTransformerConfigValidationError struct {
 }
 
 // Field function returns field value.
-func (e UserDefinedTransformerConfigValidationError) Field() string { return e.field }
+func (e GenerateDomainValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e UserDefinedTransformerConfigValidationError) Reason() string { return e.reason }
+func (e GenerateDomainValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e UserDefinedTransformerConfigValidationError) Cause() error { return e.cause }
+func (e GenerateDomainValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e UserDefinedTransformerConfigValidationError) Key() bool { return e.key }
+func (e GenerateDomainValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e UserDefinedTransformerConfigValidationError) ErrorName() string {
-	return "UserDefinedTransformerConfigValidationError"
-}
+func (e GenerateDomainValidationError) ErrorName() string { return "GenerateDomainValidationError" }
 
 // Error satisfies the builtin error interface
-func (e UserDefinedTransformerConfigValidationError) Error() string {
+func (e GenerateDomainValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -8127,14 +10770,14 @@ func (e UserDefinedTransformerConfigValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sUserDefinedTransformerConfig.%s: %s%s",
+		"invalid %sGenerateDomain.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = UserDefinedTransformerConfigValidationError{}
+var _ error = GenerateDomainValidationError{}
 
 var _ interface {
 	Field() string
@@ -8142,50 +10785,48 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = UserDefinedTransformerConfigValidationError{}
+} = GenerateDomainValidationError{}
 
-// Validate checks the field values on ValidateUserJavascriptCodeRequest with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the first error encountered is returned, or nil if there are
-// no violations.
-func (m *ValidateUserJavascriptCodeRequest) Validate() error {
+// Validate checks the field values on TransformDomain with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *TransformDomain) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ValidateUserJavascriptCodeRequest
-// with the rules defined in the proto definition for this message. If any
-// rules are violated, the result is a list of violation errors wrapped in
-// ValidateUserJavascriptCodeRequestMultiError, or nil if none found.
-func (m *ValidateUserJavascriptCodeRequest) ValidateAll() error {
+// ValidateAll checks the field values on TransformDomain with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// TransformDomainMultiError, or nil if none found.
+func (m *TransformDomain) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ValidateUserJavascriptCodeRequest) validate(all bool) error {
+func (m *TransformDomain) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for AccountId
+	// no validation rules for PreserveTld
 
-	// no validation rules for Code
+	// no validation rules for Deterministic
 
 	if len(errors) > 0 {
-		return ValidateUserJavascriptCodeRequestMultiError(errors)
+		return TransformDomainMultiError(errors)
 	}
 
 	return nil
 }
 
-// ValidateUserJavascriptCodeRequestMultiError is an error wrapping multiple
-// validation errors returned by
-// ValidateUserJavascriptCodeRequest.ValidateAll() if the designated
-// constraints aren't met.
-type ValidateUserJavascriptCodeRequestMultiError []error
+// TransformDomainMultiError is an error wrapping multiple validation errors
+// returned by TransformDomain.ValidateAll() if the designated constraints
+// aren't met.
+type TransformDomainMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ValidateUserJavascriptCodeRequestMultiError) Error() string {
+func (m TransformDomainMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -8194,12 +10835,11 @@ func (m ValidateUserJavascriptCodeRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ValidateUserJavascriptCodeRequestMultiError) AllErrors() []error { return m }
+func (m TransformDomainMultiError) AllErrors() []error { return m }
 
-// ValidateUserJavascriptCodeRequestValidationError is the validation error
-// returned by ValidateUserJavascriptCodeRequest.Validate if the designated
-// constraints aren't met.
-type ValidateUserJavascriptCodeRequestValidationError struct {
+// TransformDomainValidationError is the validation error returned by
+// TransformDomain.Validate if the designated constraints aren't met.
+type TransformDomainValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -8207,24 +10847,22 @@ type ValidateUserJavascriptCodeRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e ValidateUserJavascriptCodeRequestValidationError) Field() string { return e.field }
+func (e TransformDomainValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ValidateUserJavascriptCodeRequestValidationError) Reason() string { return e.reason }
+func (e TransformDomainValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ValidateUserJavascriptCodeRequestValidationError) Cause() error { return e.cause }
+func (e TransformDomainValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ValidateUserJavascriptCodeRequestValidationError) Key() bool { return e.key }
+func (e TransformDomainValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ValidateUserJavascriptCodeRequestValidationError) ErrorName() string {
-	return "ValidateUserJavascriptCodeRequestValidationError"
-}
+func (e TransformDomainValidationError) ErrorName() string { return "TransformDomainValidationError" }
 
 // Error satisfies the builtin error interface
-func (e ValidateUserJavascriptCodeRequestValidationError) Error() string {
+func (e TransformDomainValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -8236,14 +10874,14 @@ func (e ValidateUserJavascriptCodeRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sValidateUserJavascriptCodeRequest.%s: %s%s",
+		"invalid %sTransformDomain.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ValidateUserJavascriptCodeRequestValidationError{}
+var _ error = TransformDomainValidationError{}
 
 var _ interface {
 	Field() string
@@ -8251,48 +10889,48 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ValidateUserJavascriptCodeRequestValidationError{}
+} = TransformDomainValidationError{}
 
-// Validate checks the field values on ValidateUserJavascriptCodeResponse with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the first error encountered is returned, or nil if there are
-// no violations.
-func (m *ValidateUserJavascriptCodeResponse) Validate() error {
+// Validate checks the field values on ValidateUserRegexCodeRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ValidateUserRegexCodeRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ValidateUserJavascriptCodeResponse
-// with the rules defined in the proto definition for this message. If any
-// rules are violated, the result is a list of violation errors wrapped in
-// ValidateUserJavascriptCodeResponseMultiError, or nil if none found.
-func (m *ValidateUserJavascriptCodeResponse) ValidateAll() error {
+// ValidateAll checks the field values on ValidateUserRegexCodeRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ValidateUserRegexCodeRequestMultiError, or nil if none found.
+func (m *ValidateUserRegexCodeRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ValidateUserJavascriptCodeResponse) validate(all bool) error {
+func (m *ValidateUserRegexCodeRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Valid
+	// no validation rules for AccountId
+
+	// no validation rules for UserProvidedRegex
 
 	if len(errors) > 0 {
-		return ValidateUserJavascriptCodeResponseMultiError(errors)
+		return ValidateUserRegexCodeRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// ValidateUserJavascriptCodeResponseMultiError is an error wrapping multiple
-// validation errors returned by
-// ValidateUserJavascriptCodeResponse.ValidateAll() if the designated
-// constraints aren't met.
-type ValidateUserJavascriptCodeResponseMultiError []error
+// ValidateUserRegexCodeRequestMultiError is an error wrapping multiple
+// validation errors returned by ValidateUserRegexCodeRequest.ValidateAll() if
+// the designated constraints aren't met.
+type ValidateUserRegexCodeRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ValidateUserJavascriptCodeResponseMultiError) Error() string {
+func (m ValidateUserRegexCodeRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -8300,13 +10938,13 @@ func (m ValidateUserJavascriptCodeResponseMultiError) Error() string {
 	return strings.Join(msgs, "; ")
 }
 
-// AllErrors returns a list of validation violation errors.
-func (m ValidateUserJavascriptCodeResponseMultiError) AllErrors() []error { return m }
-
-// ValidateUserJavascriptCodeResponseValidationError is the validation error
-// returned by ValidateUserJavascriptCodeResponse.Validate if the designated
-// constraints aren't met.
-type ValidateUserJavascriptCodeResponseValidationError struct {
+// AllErrors returns a list of validation violation errors.
+func (m ValidateUserRegexCodeRequestMultiError) AllErrors() []error { return m }
+
+// ValidateUserRegexCodeRequestValidationError is the validation error returned
+// by ValidateUserRegexCodeRequest.Validate if the designated constraints
+// aren't met.
+type ValidateUserRegexCodeRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -8314,24 +10952,24 @@ type ValidateUserJavascriptCodeResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e ValidateUserJavascriptCodeResponseValidationError) Field() string { return e.field }
+func (e ValidateUserRegexCodeRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ValidateUserJavascriptCodeResponseValidationError) Reason() string { return e.reason }
+func (e ValidateUserRegexCodeRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ValidateUserJavascriptCodeResponseValidationError) Cause() error { return e.cause }
+func (e ValidateUserRegexCodeRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ValidateUserJavascriptCodeResponseValidationError) Key() bool { return e.key }
+func (e ValidateUserRegexCodeRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ValidateUserJavascriptCodeResponseValidationError) ErrorName() string {
-	return "ValidateUserJavascriptCodeResponseValidationError"
+func (e ValidateUserRegexCodeRequestValidationError) ErrorName() string {
+	return "ValidateUserRegexCodeRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ValidateUserJavascriptCodeResponseValidationError) Error() string {
+func (e ValidateUserRegexCodeRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -8343,14 +10981,14 @@ func (e ValidateUserJavascriptCodeResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sValidateUserJavascriptCodeResponse.%s: %s%s",
+		"invalid %sValidateUserRegexCodeRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ValidateUserJavascriptCodeResponseValidationError{}
+var _ error = ValidateUserRegexCodeRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -8358,46 +10996,46 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ValidateUserJavascriptCodeResponseValidationError{}
+} = ValidateUserRegexCodeRequestValidationError{}
 
-// Validate checks the field values on GenerateCategorical with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on ValidateUserRegexCodeResponse with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GenerateCategorical) Validate() error {
+func (m *ValidateUserRegexCodeResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GenerateCategorical with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// GenerateCategoricalMultiError, or nil if none found.
-func (m *GenerateCategorical) ValidateAll() error {
+// ValidateAll checks the field values on ValidateUserRegexCodeResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// ValidateUserRegexCodeResponseMultiError, or nil if none found.
+func (m *ValidateUserRegexCodeResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GenerateCategorical) validate(all bool) error {
+func (m *ValidateUserRegexCodeResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Categories
+	// no validation rules for Valid
 
 	if len(errors) > 0 {
-		return GenerateCategoricalMultiError(errors)
+		return ValidateUserRegexCodeResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// GenerateCategoricalMultiError is an error wrapping multiple validation
-// errors returned by GenerateCategorical.ValidateAll() if the designated
-// constraints aren't met.
-type GenerateCategoricalMultiError []error
+// ValidateUserRegexCodeResponseMultiError is an error wrapping multiple
+// validation errors returned by ValidateUserRegexCodeResponse.ValidateAll()
+// if the designated constraints aren't met.
+type ValidateUserRegexCodeResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GenerateCategoricalMultiError) Error() string {
+func (m ValidateUserRegexCodeResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -8406,11 +11044,12 @@ func (m GenerateCategoricalMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GenerateCategoricalMultiError) AllErrors() []error { return m }
+func (m ValidateUserRegexCodeResponseMultiError) AllErrors() []error { return m }
 
-// GenerateCategoricalValidationError is the validation error returned by
-// GenerateCategorical.Validate if the designated constraints aren't met.
-type GenerateCategoricalValidationError struct {
+// ValidateUserRegexCodeResponseValidationError is the validation error
+// returned by ValidateUserRegexCodeResponse.Validate if the designated
+// constraints aren't met.
+type ValidateUserRegexCodeResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -8418,24 +11057,24 @@ type GenerateCategoricalValidationError struct {
 }
 
 // Field function returns field value.
-func (e GenerateCategoricalValidationError) Field() string { return e.field }
+func (e ValidateUserRegexCodeResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GenerateCategoricalValidationError) Reason() string { return e.reason }
+func (e ValidateUserRegexCodeResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GenerateCategoricalValidationError) Cause() error { return e.cause }
+func (e ValidateUserRegexCodeResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GenerateCategoricalValidationError) Key() bool { return e.key }
+func (e ValidateUserRegexCodeResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GenerateCategoricalValidationError) ErrorName() string {
-	return "GenerateCategoricalValidationError"
+func (e ValidateUserRegexCodeResponseValidationError) ErrorName() string {
+	return "ValidateUserRegexCodeResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GenerateCategoricalValidationError) Error() string {
+func (e ValidateUserRegexCodeResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -8447,14 +11086,14 @@ func (e GenerateCategoricalValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGenerateCategorical.%s: %s%s",
+		"invalid %sValidateUserRegexCodeResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GenerateCategoricalValidationError{}
+var _ error = ValidateUserRegexCodeResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -8462,48 +11101,77 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GenerateCategoricalValidationError{}
+} = ValidateUserRegexCodeResponseValidationError{}
 
-// Validate checks the field values on TransformCharacterScramble with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *TransformCharacterScramble) Validate() error {
+// Validate checks the field values on ValidateTransformJsonConfigRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *ValidateTransformJsonConfigRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on TransformCharacterScramble with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// TransformCharacterScrambleMultiError, or nil if none found.
-func (m *TransformCharacterScramble) ValidateAll() error {
+// ValidateAll checks the field values on ValidateTransformJsonConfigRequest
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// ValidateTransformJsonConfigRequestMultiError, or nil if none found.
+func (m *ValidateTransformJsonConfigRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *TransformCharacterScramble) validate(all bool) error {
+func (m *ValidateTransformJsonConfigRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if m.UserProvidedRegex != nil {
-		// no validation rules for UserProvidedRegex
+	// no validation rules for AccountId
+
+	if all {
+		switch v := interface{}(m.GetConfig()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ValidateTransformJsonConfigRequestValidationError{
+					field:  "Config",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ValidateTransformJsonConfigRequestValidationError{
+					field:  "Config",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetConfig()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ValidateTransformJsonConfigRequestValidationError{
+				field:  "Config",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
 	}
 
 	if len(errors) > 0 {
-		return TransformCharacterScrambleMultiError(errors)
+		return ValidateTransformJsonConfigRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// TransformCharacterScrambleMultiError is an error wrapping multiple
-// validation errors returned by TransformCharacterScramble.ValidateAll() if
-// the designated constraints aren't met.
-type TransformCharacterScrambleMultiError []error
+// ValidateTransformJsonConfigRequestMultiError is an error wrapping multiple
+// validation errors returned by
+// ValidateTransformJsonConfigRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ValidateTransformJsonConfigRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m TransformCharacterScrambleMultiError) Error() string {
+func (m ValidateTransformJsonConfigRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -8512,11 +11180,12 @@ func (m TransformCharacterScrambleMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m TransformCharacterScrambleMultiError) AllErrors() []error { return m }
+func (m ValidateTransformJsonConfigRequestMultiError) AllErrors() []error { return m }
 
-// TransformCharacterScrambleValidationError is the validation error returned
-// by TransformCharacterScramble.Validate if the designated constraints aren't met.
-type TransformCharacterScrambleValidationError struct {
+// ValidateTransformJsonConfigRequestValidationError is the validation error
+// returned by ValidateTransformJsonConfigRequest.Validate if the designated
+// constraints aren't met.
+type ValidateTransformJsonConfigRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -8524,24 +11193,24 @@ type TransformCharacterScrambleValidationError struct {
 }
 
 // Field function returns field value.
-func (e TransformCharacterScrambleValidationError) Field() string { return e.field }
+func (e ValidateTransformJsonConfigRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e TransformCharacterScrambleValidationError) Reason() string { return e.reason }
+func (e ValidateTransformJsonConfigRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e TransformCharacterScrambleValidationError) Cause() error { return e.cause }
+func (e ValidateTransformJsonConfigRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e TransformCharacterScrambleValidationError) Key() bool { return e.key }
+func (e ValidateTransformJsonConfigRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e TransformCharacterScrambleValidationError) ErrorName() string {
-	return "TransformCharacterScrambleValidationError"
+func (e ValidateTransformJsonConfigRequestValidationError) ErrorName() string {
+	return "ValidateTransformJsonConfigRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e TransformCharacterScrambleValidationError) Error() string {
+func (e ValidateTransformJsonConfigRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -8553,14 +11222,14 @@ func (e TransformCharacterScrambleValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sTransformCharacterScramble.%s: %s%s",
+		"invalid %sValidateTransformJsonConfigRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = TransformCharacterScrambleValidationError{}
+var _ error = ValidateTransformJsonConfigRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -8568,46 +11237,48 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = TransformCharacterScrambleValidationError{}
+} = ValidateTransformJsonConfigRequestValidationError{}
 
-// Validate checks the field values on GenerateJavascript with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GenerateJavascript) Validate() error {
+// Validate checks the field values on ValidateTransformJsonConfigResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *ValidateTransformJsonConfigResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GenerateJavascript with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// GenerateJavascriptMultiError, or nil if none found.
-func (m *GenerateJavascript) ValidateAll() error {
+// ValidateAll checks the field values on ValidateTransformJsonConfigResponse
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// ValidateTransformJsonConfigResponseMultiError, or nil if none found.
+func (m *ValidateTransformJsonConfigResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GenerateJavascript) validate(all bool) error {
+func (m *ValidateTransformJsonConfigResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Code
+	// no validation rules for Valid
 
 	if len(errors) > 0 {
-		return GenerateJavascriptMultiError(errors)
+		return ValidateTransformJsonConfigResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// GenerateJavascriptMultiError is an error wrapping multiple validation errors
-// returned by GenerateJavascript.ValidateAll() if the designated constraints
-// aren't met.
-type GenerateJavascriptMultiError []error
+// ValidateTransformJsonConfigResponseMultiError is an error wrapping multiple
+// validation errors returned by
+// ValidateTransformJsonConfigResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ValidateTransformJsonConfigResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GenerateJavascriptMultiError) Error() string {
+func (m ValidateTransformJsonConfigResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -8616,11 +11287,12 @@ func (m GenerateJavascriptMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GenerateJavascriptMultiError) AllErrors() []error { return m }
+func (m ValidateTransformJsonConfigResponseMultiError) AllErrors() []error { return m }
 
-// GenerateJavascriptValidationError is the validation error returned by
-// GenerateJavascript.Validate if the designated constraints aren't met.
-type GenerateJavascriptValidationError struct {
+// ValidateTransformJsonConfigResponseValidationError is the validation error
+// returned by ValidateTransformJsonConfigResponse.Validate if the designated
+// constraints aren't met.
+type ValidateTransformJsonConfigResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -8628,24 +11300,24 @@ type GenerateJavascriptValidationError struct {
 }
 
 // Field function returns field value.
-func (e GenerateJavascriptValidationError) Field() string { return e.field }
+func (e ValidateTransformJsonConfigResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GenerateJavascriptValidationError) Reason() string { return e.reason }
+func (e ValidateTransformJsonConfigResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GenerateJavascriptValidationError) Cause() error { return e.cause }
+func (e ValidateTransformJsonConfigResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GenerateJavascriptValidationError) Key() bool { return e.key }
+func (e ValidateTransformJsonConfigResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GenerateJavascriptValidationError) ErrorName() string {
-	return "GenerateJavascriptValidationError"
+func (e ValidateTransformJsonConfigResponseValidationError) ErrorName() string {
+	return "ValidateTransformJsonConfigResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GenerateJavascriptValidationError) Error() string {
+func (e ValidateTransformJsonConfigResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -8657,14 +11329,14 @@ func (e GenerateJavascriptValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGenerateJavascript.%s: %s%s",
+		"invalid %sValidateTransformJsonConfigResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GenerateJavascriptValidationError{}
+var _ error = ValidateTransformJsonConfigResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -8672,24 +11344,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GenerateJavascriptValidationError{}
+} = ValidateTransformJsonConfigResponseValidationError{}
 
-// Validate checks the field values on ValidateUserRegexCodeRequest with the
+// Validate checks the field values on DecryptFormatPreservingRequest with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ValidateUserRegexCodeRequest) Validate() error {
+func (m *DecryptFormatPreservingRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ValidateUserRegexCodeRequest with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// ValidateUserRegexCodeRequestMultiError, or nil if none found.
-func (m *ValidateUserRegexCodeRequest) ValidateAll() error {
+// ValidateAll checks the field values on DecryptFormatPreservingRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// DecryptFormatPreservingRequestMultiError, or nil if none found.
+func (m *DecryptFormatPreservingRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ValidateUserRegexCodeRequest) validate(all bool) error {
+func (m *DecryptFormatPreservingRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -8698,22 +11370,26 @@ func (m *ValidateUserRegexCodeRequest) validate(all bool) error {
 
 	// no validation rules for AccountId
 
-	// no validation rules for UserProvidedRegex
+	// no validation rules for Value
+
+	// no validation rules for Algorithm
+
+	// no validation rules for Tweak
 
 	if len(errors) > 0 {
-		return ValidateUserRegexCodeRequestMultiError(errors)
+		return DecryptFormatPreservingRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// ValidateUserRegexCodeRequestMultiError is an error wrapping multiple
-// validation errors returned by ValidateUserRegexCodeRequest.ValidateAll() if
-// the designated constraints aren't met.
-type ValidateUserRegexCodeRequestMultiError []error
+// DecryptFormatPreservingRequestMultiError is an error wrapping multiple
+// validation errors returned by DecryptFormatPreservingRequest.ValidateAll()
+// if the designated constraints aren't met.
+type DecryptFormatPreservingRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ValidateUserRegexCodeRequestMultiError) Error() string {
+func (m DecryptFormatPreservingRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -8722,12 +11398,12 @@ func (m ValidateUserRegexCodeRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ValidateUserRegexCodeRequestMultiError) AllErrors() []error { return m }
+func (m DecryptFormatPreservingRequestMultiError) AllErrors() []error { return m }
 
-// ValidateUserRegexCodeRequestValidationError is the validation error returned
-// by ValidateUserRegexCodeRequest.Validate if the designated constraints
-// aren't met.
-type ValidateUserRegexCodeRequestValidationError struct {
+// DecryptFormatPreservingRequestValidationError is the validation error
+// returned by DecryptFormatPreservingRequest.Validate if the designated
+// constraints aren't met.
+type DecryptFormatPreservingRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -8735,24 +11411,24 @@ type ValidateUserRegexCodeRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e ValidateUserRegexCodeRequestValidationError) Field() string { return e.field }
+func (e DecryptFormatPreservingRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ValidateUserRegexCodeRequestValidationError) Reason() string { return e.reason }
+func (e DecryptFormatPreservingRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ValidateUserRegexCodeRequestValidationError) Cause() error { return e.cause }
+func (e DecryptFormatPreservingRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ValidateUserRegexCodeRequestValidationError) Key() bool { return e.key }
+func (e DecryptFormatPreservingRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ValidateUserRegexCodeRequestValidationError) ErrorName() string {
-	return "ValidateUserRegexCodeRequestValidationError"
+func (e DecryptFormatPreservingRequestValidationError) ErrorName() string {
+	return "DecryptFormatPreservingRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ValidateUserRegexCodeRequestValidationError) Error() string {
+func (e DecryptFormatPreservingRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -8764,14 +11440,14 @@ func (e ValidateUserRegexCodeRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sValidateUserRegexCodeRequest.%s: %s%s",
+		"invalid %sDecryptFormatPreservingRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ValidateUserRegexCodeRequestValidationError{}
+var _ error = DecryptFormatPreservingRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -8779,46 +11455,46 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ValidateUserRegexCodeRequestValidationError{}
+} = DecryptFormatPreservingRequestValidationError{}
 
-// Validate checks the field values on ValidateUserRegexCodeResponse with the
+// Validate checks the field values on DecryptFormatPreservingResponse with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ValidateUserRegexCodeResponse) Validate() error {
+func (m *DecryptFormatPreservingResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ValidateUserRegexCodeResponse with
+// ValidateAll checks the field values on DecryptFormatPreservingResponse with
 // the rules defined in the proto definition for this message. If any rules
 // are violated, the result is a list of violation errors wrapped in
-// ValidateUserRegexCodeResponseMultiError, or nil if none found.
-func (m *ValidateUserRegexCodeResponse) ValidateAll() error {
+// DecryptFormatPreservingResponseMultiError, or nil if none found.
+func (m *DecryptFormatPreservingResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ValidateUserRegexCodeResponse) validate(all bool) error {
+func (m *DecryptFormatPreservingResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Valid
+	// no validation rules for Value
 
 	if len(errors) > 0 {
-		return ValidateUserRegexCodeResponseMultiError(errors)
+		return DecryptFormatPreservingResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// ValidateUserRegexCodeResponseMultiError is an error wrapping multiple
-// validation errors returned by ValidateUserRegexCodeResponse.ValidateAll()
+// DecryptFormatPreservingResponseMultiError is an error wrapping multiple
+// validation errors returned by DecryptFormatPreservingResponse.ValidateAll()
 // if the designated constraints aren't met.
-type ValidateUserRegexCodeResponseMultiError []error
+type DecryptFormatPreservingResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ValidateUserRegexCodeResponseMultiError) Error() string {
+func (m DecryptFormatPreservingResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -8827,12 +11503,12 @@ func (m ValidateUserRegexCodeResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ValidateUserRegexCodeResponseMultiError) AllErrors() []error { return m }
+func (m DecryptFormatPreservingResponseMultiError) AllErrors() []error { return m }
 
-// ValidateUserRegexCodeResponseValidationError is the validation error
-// returned by ValidateUserRegexCodeResponse.Validate if the designated
+// DecryptFormatPreservingResponseValidationError is the validation error
+// returned by DecryptFormatPreservingResponse.Validate if the designated
 // constraints aren't met.
-type ValidateUserRegexCodeResponseValidationError struct {
+type DecryptFormatPreservingResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -8840,24 +11516,24 @@ type ValidateUserRegexCodeResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e ValidateUserRegexCodeResponseValidationError) Field() string { return e.field }
+func (e DecryptFormatPreservingResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ValidateUserRegexCodeResponseValidationError) Reason() string { return e.reason }
+func (e DecryptFormatPreservingResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ValidateUserRegexCodeResponseValidationError) Cause() error { return e.cause }
+func (e DecryptFormatPreservingResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ValidateUserRegexCodeResponseValidationError) Key() bool { return e.key }
+func (e DecryptFormatPreservingResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ValidateUserRegexCodeResponseValidationError) ErrorName() string {
-	return "ValidateUserRegexCodeResponseValidationError"
+func (e DecryptFormatPreservingResponseValidationError) ErrorName() string {
+	return "DecryptFormatPreservingResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ValidateUserRegexCodeResponseValidationError) Error() string {
+func (e DecryptFormatPreservingResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -8869,14 +11545,14 @@ func (e ValidateUserRegexCodeResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sValidateUserRegexCodeResponse.%s: %s%s",
+		"invalid %sDecryptFormatPreservingResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ValidateUserRegexCodeResponseValidationError{}
+var _ error = DecryptFormatPreservingResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -8884,4 +11560,4 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ValidateUserRegexCodeResponseValidationError{}
+} = DecryptFormatPreservingResponseValidationError{}
@@ -1913,6 +1913,436 @@ var _ interface {
 	ErrorName() string
 } = SetAccountTemporalConfigResponseValidationError{}
 
+// Validate checks the field values on GetAccountTransformerKeyRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetAccountTransformerKeyRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetAccountTransformerKeyRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// GetAccountTransformerKeyRequestMultiError, or nil if none found.
+func (m *GetAccountTransformerKeyRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetAccountTransformerKeyRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for AccountId
+
+	if len(errors) > 0 {
+		return GetAccountTransformerKeyRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetAccountTransformerKeyRequestMultiError is an error wrapping multiple
+// validation errors returned by GetAccountTransformerKeyRequest.ValidateAll()
+// if the designated constraints aren't met.
+type GetAccountTransformerKeyRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetAccountTransformerKeyRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetAccountTransformerKeyRequestMultiError) AllErrors() []error { return m }
+
+// GetAccountTransformerKeyRequestValidationError is the validation error
+// returned by GetAccountTransformerKeyRequest.Validate if the designated
+// constraints aren't met.
+type GetAccountTransformerKeyRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetAccountTransformerKeyRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetAccountTransformerKeyRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetAccountTransformerKeyRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetAccountTransformerKeyRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetAccountTransformerKeyRequestValidationError) ErrorName() string {
+	return "GetAccountTransformerKeyRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetAccountTransformerKeyRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetAccountTransformerKeyRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetAccountTransformerKeyRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetAccountTransformerKeyRequestValidationError{}
+
+// Validate checks the field values on GetAccountTransformerKeyResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *GetAccountTransformerKeyResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetAccountTransformerKeyResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// GetAccountTransformerKeyResponseMultiError, or nil if none found.
+func (m *GetAccountTransformerKeyResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetAccountTransformerKeyResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Key
+
+	if len(errors) > 0 {
+		return GetAccountTransformerKeyResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetAccountTransformerKeyResponseMultiError is an error wrapping multiple
+// validation errors returned by
+// GetAccountTransformerKeyResponse.ValidateAll() if the designated
+// constraints aren't met.
+type GetAccountTransformerKeyResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetAccountTransformerKeyResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetAccountTransformerKeyResponseMultiError) AllErrors() []error { return m }
+
+// GetAccountTransformerKeyResponseValidationError is the validation error
+// returned by GetAccountTransformerKeyResponse.Validate if the designated
+// constraints aren't met.
+type GetAccountTransformerKeyResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetAccountTransformerKeyResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetAccountTransformerKeyResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetAccountTransformerKeyResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetAccountTransformerKeyResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetAccountTransformerKeyResponseValidationError) ErrorName() string {
+	return "GetAccountTransformerKeyResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetAccountTransformerKeyResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetAccountTransformerKeyResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetAccountTransformerKeyResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetAccountTransformerKeyResponseValidationError{}
+
+// Validate checks the field values on SetAccountTransformerKeyRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SetAccountTransformerKeyRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SetAccountTransformerKeyRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// SetAccountTransformerKeyRequestMultiError, or nil if none found.
+func (m *SetAccountTransformerKeyRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SetAccountTransformerKeyRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for AccountId
+
+	// no validation rules for Force
+
+	if m.Key != nil {
+		// no validation rules for Key
+	}
+
+	if len(errors) > 0 {
+		return SetAccountTransformerKeyRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// SetAccountTransformerKeyRequestMultiError is an error wrapping multiple
+// validation errors returned by SetAccountTransformerKeyRequest.ValidateAll()
+// if the designated constraints aren't met.
+type SetAccountTransformerKeyRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SetAccountTransformerKeyRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SetAccountTransformerKeyRequestMultiError) AllErrors() []error { return m }
+
+// SetAccountTransformerKeyRequestValidationError is the validation error
+// returned by SetAccountTransformerKeyRequest.Validate if the designated
+// constraints aren't met.
+type SetAccountTransformerKeyRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SetAccountTransformerKeyRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SetAccountTransformerKeyRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SetAccountTransformerKeyRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SetAccountTransformerKeyRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SetAccountTransformerKeyRequestValidationError) ErrorName() string {
+	return "SetAccountTransformerKeyRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SetAccountTransformerKeyRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSetAccountTransformerKeyRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SetAccountTransformerKeyRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SetAccountTransformerKeyRequestValidationError{}
+
+// Validate checks the field values on SetAccountTransformerKeyResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *SetAccountTransformerKeyResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SetAccountTransformerKeyResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// SetAccountTransformerKeyResponseMultiError, or nil if none found.
+func (m *SetAccountTransformerKeyResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SetAccountTransformerKeyResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Key
+
+	if len(errors) > 0 {
+		return SetAccountTransformerKeyResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// SetAccountTransformerKeyResponseMultiError is an error wrapping multiple
+// validation errors returned by
+// SetAccountTransformerKeyResponse.ValidateAll() if the designated
+// constraints aren't met.
+type SetAccountTransformerKeyResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SetAccountTransformerKeyResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SetAccountTransformerKeyResponseMultiError) AllErrors() []error { return m }
+
+// SetAccountTransformerKeyResponseValidationError is the validation error
+// returned by SetAccountTransformerKeyResponse.Validate if the designated
+// constraints aren't met.
+type SetAccountTransformerKeyResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SetAccountTransformerKeyResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SetAccountTransformerKeyResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SetAccountTransformerKeyResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SetAccountTransformerKeyResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SetAccountTransformerKeyResponseValidationError) ErrorName() string {
+	return "SetAccountTransformerKeyResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SetAccountTransformerKeyResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSetAccountTransformerKeyResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SetAccountTransformerKeyResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SetAccountTransformerKeyResponseValidationError{}
+
 // Validate checks the field values on AccountTemporalConfig with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
@@ -2259,6 +2689,8 @@ func (m *AccountUser) validate(all bool) error {
 
 	// no validation rules for Email
 
+	// no validation rules for Role
+
 	if len(errors) > 0 {
 		return AccountUserMultiError(errors)
 	}
@@ -2788,6 +3220,249 @@ var _ interface {
 	ErrorName() string
 } = RemoveTeamAccountMemberResponseValidationError{}
 
+// Validate checks the field values on SetTeamAccountMemberRoleRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SetTeamAccountMemberRoleRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SetTeamAccountMemberRoleRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// SetTeamAccountMemberRoleRequestMultiError, or nil if none found.
+func (m *SetTeamAccountMemberRoleRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SetTeamAccountMemberRoleRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for AccountId
+
+	// no validation rules for UserId
+
+	// no validation rules for Role
+
+	if len(errors) > 0 {
+		return SetTeamAccountMemberRoleRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// SetTeamAccountMemberRoleRequestMultiError is an error wrapping multiple
+// validation errors returned by SetTeamAccountMemberRoleRequest.ValidateAll()
+// if the designated constraints aren't met.
+type SetTeamAccountMemberRoleRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SetTeamAccountMemberRoleRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SetTeamAccountMemberRoleRequestMultiError) AllErrors() []error { return m }
+
+// SetTeamAccountMemberRoleRequestValidationError is the validation error
+// returned by SetTeamAccountMemberRoleRequest.Validate if the designated
+// constraints aren't met.
+type SetTeamAccountMemberRoleRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SetTeamAccountMemberRoleRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SetTeamAccountMemberRoleRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SetTeamAccountMemberRoleRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SetTeamAccountMemberRoleRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SetTeamAccountMemberRoleRequestValidationError) ErrorName() string {
+	return "SetTeamAccountMemberRoleRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SetTeamAccountMemberRoleRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSetTeamAccountMemberRoleRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SetTeamAccountMemberRoleRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SetTeamAccountMemberRoleRequestValidationError{}
+
+// Validate checks the field values on SetTeamAccountMemberRoleResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *SetTeamAccountMemberRoleResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SetTeamAccountMemberRoleResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// SetTeamAccountMemberRoleResponseMultiError, or nil if none found.
+func (m *SetTeamAccountMemberRoleResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SetTeamAccountMemberRoleResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetUser()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SetTeamAccountMemberRoleResponseValidationError{
+					field:  "User",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SetTeamAccountMemberRoleResponseValidationError{
+					field:  "User",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUser()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SetTeamAccountMemberRoleResponseValidationError{
+				field:  "User",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return SetTeamAccountMemberRoleResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// SetTeamAccountMemberRoleResponseMultiError is an error wrapping multiple
+// validation errors returned by
+// SetTeamAccountMemberRoleResponse.ValidateAll() if the designated
+// constraints aren't met.
+type SetTeamAccountMemberRoleResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SetTeamAccountMemberRoleResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SetTeamAccountMemberRoleResponseMultiError) AllErrors() []error { return m }
+
+// SetTeamAccountMemberRoleResponseValidationError is the validation error
+// returned by SetTeamAccountMemberRoleResponse.Validate if the designated
+// constraints aren't met.
+type SetTeamAccountMemberRoleResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SetTeamAccountMemberRoleResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SetTeamAccountMemberRoleResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SetTeamAccountMemberRoleResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SetTeamAccountMemberRoleResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SetTeamAccountMemberRoleResponseValidationError) ErrorName() string {
+	return "SetTeamAccountMemberRoleResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SetTeamAccountMemberRoleResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSetTeamAccountMemberRoleResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SetTeamAccountMemberRoleResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SetTeamAccountMemberRoleResponseValidationError{}
+
 // Validate checks the field values on InviteUserToTeamAccountRequest with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
@@ -2814,6 +3489,10 @@ func (m *InviteUserToTeamAccountRequest) validate(all bool) error {
 
 	// no validation rules for Email
 
+	if m.Role != nil {
+		// no validation rules for Role
+	}
+
 	if len(errors) > 0 {
 		return InviteUserToTeamAccountRequestMultiError(errors)
 	}
@@ -3016,6 +3695,8 @@ func (m *AccountInvite) validate(all bool) error {
 		}
 	}
 
+	// no validation rules for Role
+
 	if len(errors) > 0 {
 		return AccountInviteMultiError(errors)
 	}
@@ -26,6 +26,7 @@ require (
 	github.com/google/uuid v1.6.0
 	github.com/jackc/pgx/v5 v5.5.5
 	github.com/nucleuscloud/neosync/worker v0.0.0-00010101000000-000000000000
+	github.com/parquet-go/parquet-go v0.20.0
 	github.com/prometheus/client_golang v1.19.0
 	github.com/prometheus/common v0.51.0
 	github.com/spf13/cobra v1.8.0
@@ -42,6 +43,7 @@ require (
 	k8s.io/api v0.29.3
 	k8s.io/apimachinery v0.29.3
 	k8s.io/client-go v0.29.3
+	modernc.org/sqlite v1.28.0
 )
 
 require (
@@ -263,7 +265,6 @@ require (
 	github.com/olivere/elastic/v7 v7.0.32 // indirect
 	github.com/onsi/ginkgo v1.16.5 // indirect
 	github.com/opensearch-project/opensearch-go/v3 v3.0.0 // indirect
-	github.com/parquet-go/parquet-go v0.20.0 // indirect
 	github.com/paulmach/orb v0.11.1 // indirect
 	github.com/pborman/uuid v1.2.1 // indirect
 	github.com/pelletier/go-toml/v2 v2.1.0 // indirect
@@ -376,7 +377,6 @@ require (
 	modernc.org/opt v0.1.3 // indirect
 	modernc.org/ql v1.0.0 // indirect
 	modernc.org/sortutil v1.1.0 // indirect
-	modernc.org/sqlite v1.28.0 // indirect
 	modernc.org/strutil v1.1.3 // indirect
 	modernc.org/token v1.1.0 // indirect
 	modernc.org/zappy v1.0.0 // indirect
@@ -0,0 +1,58 @@
+package audit
+
+import (
+	"context"
+	"strings"
+	"sync"
+)
+
+type EventContextKey struct{}
+
+// Details of an audit event that are only known once the handler has verified the caller's access to an account
+type EventData struct {
+	mu        sync.Mutex
+	accountId string
+	userId    string
+}
+
+func WithEventData(ctx context.Context) (context.Context, *EventData) {
+	data := &EventData{}
+	return context.WithValue(ctx, EventContextKey{}, data), data
+}
+
+// Records the account that the current procedure acts on and the user that is acting on it.
+// Only the first account is kept as it is the one the request was made against.
+// Does nothing if the procedure is not being audited.
+func SetAccountUser(ctx context.Context, accountId, userId string) {
+	data, ok := ctx.Value(EventContextKey{}).(*EventData)
+	if !ok || data == nil {
+		return
+	}
+	data.mu.Lock()
+	defer data.mu.Unlock()
+	if data.accountId != "" {
+		return
+	}
+	data.accountId = accountId
+	data.userId = userId
+}
+
+func (e *EventData) GetAccountUser() (accountId, userId string) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	return e.accountId, e.userId
+}
+
+var readOnlyMethodPrefixes = []string{"Get", "Is", "Check", "Validate", "Preview", "Scan", "Decrypt"}
+
+// Returns true if the procedure may change the state of an account.
+// Procedures are named after what they do, so every procedure that does not start with a read only verb is audited.
+func IsMutatingProcedure(procedure string) bool {
+	method := procedure[strings.LastIndex(procedure, "/")+1:]
+	for _, prefix := range readOnlyMethodPrefixes {
+		if strings.HasPrefix(method, prefix) {
+			return false
+		}
+	}
+	return true
+}
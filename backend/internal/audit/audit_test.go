@@ -0,0 +1,34 @@
+package audit
+
+import (
+	"context"
+	"testing"
+
+	"github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1/mgmtv1alpha1connect"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_IsMutatingProcedure(t *testing.T) {
+	assert.True(t, IsMutatingProcedure(mgmtv1alpha1connect.ConnectionServiceUpdateConnectionProcedure))
+	assert.True(t, IsMutatingProcedure(mgmtv1alpha1connect.JobServiceCreateJobRunProcedure))
+	assert.True(t, IsMutatingProcedure(mgmtv1alpha1connect.UserAccountServiceRemoveTeamAccountMemberProcedure))
+	assert.True(t, IsMutatingProcedure(mgmtv1alpha1connect.ApiKeyServiceRegenerateAccountApiKeyProcedure))
+
+	assert.False(t, IsMutatingProcedure(mgmtv1alpha1connect.ConnectionServiceGetConnectionsProcedure))
+	assert.False(t, IsMutatingProcedure(mgmtv1alpha1connect.ConnectionServiceCheckConnectionConfigProcedure))
+	assert.False(t, IsMutatingProcedure(mgmtv1alpha1connect.JobServiceIsJobNameAvailableProcedure))
+	assert.False(t, IsMutatingProcedure(mgmtv1alpha1connect.JobServicePreviewJobMappingsProcedure))
+}
+
+func Test_SetAccountUser(t *testing.T) {
+	// does not panic when the procedure is not audited
+	SetAccountUser(context.Background(), "account", "user")
+
+	ctx, data := WithEventData(context.Background())
+	SetAccountUser(ctx, "account-1", "user-1")
+	SetAccountUser(ctx, "account-2", "user-2")
+
+	accountId, userId := data.GetAccountUser()
+	assert.Equal(t, "account-1", accountId)
+	assert.Equal(t, "user-1", userId)
+}
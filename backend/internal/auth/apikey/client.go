@@ -4,13 +4,17 @@ import (
 	"context"
 	"crypto/subtle"
 	"errors"
+	"fmt"
 	"net/http"
 	"time"
 
 	"connectrpc.com/connect"
+	"github.com/jackc/pgx/v5/pgtype"
 	db_queries "github.com/nucleuscloud/neosync/backend/gen/go/db"
 	"github.com/nucleuscloud/neosync/backend/internal/apikey"
+	logger_interceptor "github.com/nucleuscloud/neosync/backend/internal/connect/interceptors/logger"
 	nucleuserrors "github.com/nucleuscloud/neosync/backend/internal/errors"
+	"github.com/nucleuscloud/neosync/backend/internal/nucleusdb"
 	"github.com/nucleuscloud/neosync/backend/internal/utils"
 )
 
@@ -28,6 +32,7 @@ var (
 
 type Queries interface {
 	GetAccountApiKeyByKeyValue(ctx context.Context, db db_queries.DBTX, apiKey string) (db_queries.NeosyncApiAccountApiKey, error)
+	UpdateAccountApiKeyLastUsedAt(ctx context.Context, db db_queries.DBTX, id pgtype.UUID) error
 }
 
 type Client struct {
@@ -68,6 +73,16 @@ func (c *Client) InjectTokenCtx(ctx context.Context, header http.Header, spec co
 		if time.Now().After(apiKey.ExpiresAt.Time) {
 			return nil, ApiKeyExpiredErr
 		}
+		if err := verifyProcedureScopes(apiKey.Scopes, spec.Procedure); err != nil {
+			return nil, err
+		}
+
+		// recorded so that keys which are no longer used can be found, the request is still allowed if this fails
+		if err := c.q.UpdateAccountApiKeyLastUsedAt(ctx, c.db, apiKey.ID); err != nil {
+			logger_interceptor.GetLoggerFromContextOrDefault(ctx).Warn(
+				fmt.Sprintf("unable to update last used at for api key %s: %s", nucleusdb.UUIDString(apiKey.ID), err.Error()),
+			)
+		}
 
 		newctx := context.WithValue(ctx, TokenContextKey{}, &TokenContextData{
 			RawToken:   token,
@@ -12,9 +12,11 @@ import (
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgtype"
 	db_queries "github.com/nucleuscloud/neosync/backend/gen/go/db"
+	"github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1/mgmtv1alpha1connect"
 	"github.com/nucleuscloud/neosync/backend/internal/apikey"
 	"github.com/nucleuscloud/neosync/backend/internal/nucleusdb"
 	"github.com/nucleuscloud/neosync/backend/internal/utils"
+	pg_models "github.com/nucleuscloud/neosync/backend/sql/postgresql/models"
 	"github.com/stretchr/testify/mock"
 	"github.com/zeebo/assert"
 )
@@ -44,6 +46,8 @@ func Test_Client_InjectTokenCtx_Account(t *testing.T) {
 	}
 	mockQuerier.On("GetAccountApiKeyByKeyValue", mock.Anything, mock.Anything, hashedFakeToken).
 		Return(apiKeyRecord, nil)
+	mockQuerier.On("UpdateAccountApiKeyLastUsedAt", mock.Anything, mock.Anything, apiKeyRecord.ID).
+		Return(nil)
 
 	newctx, err := client.InjectTokenCtx(context.Background(), http.Header{
 		"Authorization": []string{fmt.Sprintf("Bearer %s", fakeToken)},
@@ -92,6 +96,46 @@ func Test_Client_InjectTokenCtx_Account_Expired(t *testing.T) {
 	assert.Nil(t, newctx)
 }
 
+func Test_Client_InjectTokenCtx_Account_Scoped(t *testing.T) {
+	mockQuerier := db_queries.NewMockQuerier(t)
+	mockDbTx := db_queries.NewMockDBTX(t)
+
+	client := New(mockQuerier, mockDbTx, []string{}, []string{})
+
+	fakeToken := apikey.NewV1AccountKey()
+	hashedFakeToken := utils.ToSha256(
+		fakeToken,
+	)
+	expiresAt, err := nucleusdb.ToTimestamp(time.Now().Add(5 * time.Minute))
+	assert.NoError(t, err)
+	apiKeyRecord := db_queries.NeosyncApiAccountApiKey{
+		ID:        pgtype.UUID{Valid: true},
+		ExpiresAt: expiresAt,
+		Scopes: &pg_models.AccountApiKeyScopes{
+			AllowedProcedures: []string{
+				mgmtv1alpha1connect.JobServiceCreateJobRunProcedure,
+				mgmtv1alpha1connect.JobServiceGetJobStatusProcedure,
+			},
+		},
+	}
+	mockQuerier.On("GetAccountApiKeyByKeyValue", mock.Anything, mock.Anything, hashedFakeToken).
+		Return(apiKeyRecord, nil)
+	mockQuerier.On("UpdateAccountApiKeyLastUsedAt", mock.Anything, mock.Anything, apiKeyRecord.ID).
+		Return(nil).Once()
+
+	header := http.Header{
+		"Authorization": []string{fmt.Sprintf("Bearer %s", fakeToken)},
+	}
+	newctx, err := client.InjectTokenCtx(context.Background(), header, connect.Spec{Procedure: mgmtv1alpha1connect.JobServiceCreateJobRunProcedure})
+	assert.NoError(t, err)
+	assert.NotNil(t, newctx)
+
+	newctx, err = client.InjectTokenCtx(context.Background(), header, connect.Spec{Procedure: mgmtv1alpha1connect.JobServiceDeleteJobProcedure})
+	assert.Error(t, err)
+	assert.Equal(t, connect.CodePermissionDenied, connect.CodeOf(err))
+	assert.Nil(t, newctx)
+}
+
 func Test_Client_InjectTokenCtx_InvalidHeader(t *testing.T) {
 	client := &Client{}
 	_, err := client.InjectTokenCtx(context.Background(), http.Header{"Authorization": []string{}}, connect.Spec{})
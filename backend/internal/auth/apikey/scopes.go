@@ -0,0 +1,160 @@
+package auth_apikey
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
+	"github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1/mgmtv1alpha1connect"
+	"github.com/nucleuscloud/neosync/backend/internal/apikey"
+	nucleuserrors "github.com/nucleuscloud/neosync/backend/internal/errors"
+	"github.com/nucleuscloud/neosync/backend/internal/rbac"
+	pg_models "github.com/nucleuscloud/neosync/backend/sql/postgresql/models"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+var (
+	// the request fields that identify the job or connection that a procedure acts on
+	jobIdFields        = []protoreflect.Name{"job_id", "id"}
+	connectionIdFields = []protoreflect.Name{"connection_id", "id"}
+
+	connectionServiceNames = []string{
+		mgmtv1alpha1connect.ConnectionServiceName,
+		mgmtv1alpha1connect.ConnectionDataServiceName,
+	}
+)
+
+// Verifies that an api key with the given scopes is allowed to call the procedure.
+// The resources the procedure acts on are verified separately by VerifyRequestScopes once the request has been read.
+func verifyProcedureScopes(scopes *pg_models.AccountApiKeyScopes, procedure string) error {
+	if scopes == nil {
+		return nil
+	}
+	if len(scopes.AllowedProcedures) > 0 && !slices.Contains(scopes.AllowedProcedures, procedure) {
+		return nucleuserrors.NewForbidden(fmt.Sprintf("api key is not allowed to call %s", procedure))
+	}
+	if len(scopes.AllowedJobIds) > 0 || len(scopes.AllowedConnectionIds) > 0 {
+		// procedures of other services do not act on a job or connection that can be verified, ex: inviting account members
+		serviceName := getServiceName(procedure)
+		isJobProcedure := len(scopes.AllowedJobIds) > 0 && serviceName == mgmtv1alpha1connect.JobServiceName
+		isConnectionProcedure := len(scopes.AllowedConnectionIds) > 0 && slices.Contains(connectionServiceNames, serviceName)
+		if !isJobProcedure && !isConnectionProcedure {
+			return nucleuserrors.NewForbidden(fmt.Sprintf("api key is scoped to specific jobs or connections and is not allowed to call %s", procedure))
+		}
+	}
+	if scopes.ReadOnly {
+		role, ok := rbac.GetProcedureRole(procedure)
+		if !ok || !rbac.HasRole(mgmtv1alpha1.AccountRole_ACCOUNT_ROLE_VIEWER, role) {
+			return nucleuserrors.NewForbidden(fmt.Sprintf("api key is read only and is not allowed to call %s", procedure))
+		}
+	}
+	return nil
+}
+
+// Verifies that a request made with a scoped account api key only acts on the jobs and connections that the key is allowed to access.
+// Requests to job or connection procedures that do not name a single job or connection are rejected, as the resources they act on can not be verified.
+// Every connection that a job request refers to, ex: the connection of a mappings preview, must also be allowed when the key is scoped to specific connections.
+// Does nothing if the request was not made with an account api key.
+func VerifyRequestScopes(ctx context.Context, procedure string, msg any) error {
+	data, err := GetTokenDataFromCtx(ctx)
+	if err != nil || data.ApiKeyType != apikey.AccountApiKey || data.ApiKey == nil || data.ApiKey.Scopes == nil {
+		return nil
+	}
+	scopes := data.ApiKey.Scopes
+	serviceName := getServiceName(procedure)
+
+	if len(scopes.AllowedJobIds) > 0 && serviceName == mgmtv1alpha1connect.JobServiceName {
+		jobId := getFirstStringField(msg, jobIdFields)
+		if jobId == "" || !slices.Contains(scopes.AllowedJobIds, jobId) {
+			return nucleuserrors.NewForbidden("api key is not allowed to access this job")
+		}
+		if len(scopes.AllowedConnectionIds) > 0 {
+			for _, connectionId := range getConnectionIdFields(msg) {
+				if !slices.Contains(scopes.AllowedConnectionIds, connectionId) {
+					return nucleuserrors.NewForbidden("api key is not allowed to access this connection")
+				}
+			}
+		}
+	}
+	if len(scopes.AllowedConnectionIds) > 0 && slices.Contains(connectionServiceNames, serviceName) {
+		connectionId := getFirstStringField(msg, connectionIdFields)
+		if connectionId == "" || !slices.Contains(scopes.AllowedConnectionIds, connectionId) {
+			return nucleuserrors.NewForbidden("api key is not allowed to access this connection")
+		}
+	}
+	return nil
+}
+
+// procedures are in the form of /<service>/<method>
+func getServiceName(procedure string) string {
+	serviceName, _, _ := strings.Cut(strings.TrimPrefix(procedure, "/"), "/")
+	return serviceName
+}
+
+func getFirstStringField(msg any, names []protoreflect.Name) string {
+	protoMsg, ok := msg.(proto.Message)
+	if !ok || protoMsg == nil {
+		return ""
+	}
+	reflectMsg := protoMsg.ProtoReflect()
+	for _, name := range names {
+		fd := reflectMsg.Descriptor().Fields().ByName(name)
+		if fd == nil || fd.Kind() != protoreflect.StringKind || fd.IsList() || !reflectMsg.Has(fd) {
+			continue
+		}
+		return reflectMsg.Get(fd).String()
+	}
+	return ""
+}
+
+// Returns the values of every connection id field in the message, including the fields of nested messages, ex: mappings.connection_id
+func getConnectionIdFields(msg any) []string {
+	protoMsg, ok := msg.(proto.Message)
+	if !ok || protoMsg == nil {
+		return nil
+	}
+	ids := []string{}
+	collectConnectionIdFields(protoMsg.ProtoReflect(), &ids)
+	return ids
+}
+
+func collectConnectionIdFields(msg protoreflect.Message, ids *[]string) {
+	msg.Range(func(fd protoreflect.FieldDescriptor, value protoreflect.Value) bool {
+		switch {
+		case fd.IsMap():
+			if fd.MapValue().Kind() == protoreflect.MessageKind {
+				value.Map().Range(func(_ protoreflect.MapKey, v protoreflect.Value) bool {
+					collectConnectionIdFields(v.Message(), ids)
+					return true
+				})
+			}
+		case fd.Kind() == protoreflect.MessageKind || fd.Kind() == protoreflect.GroupKind:
+			if fd.IsList() {
+				list := value.List()
+				for i := 0; i < list.Len(); i++ {
+					collectConnectionIdFields(list.Get(i).Message(), ids)
+				}
+			} else {
+				collectConnectionIdFields(value.Message(), ids)
+			}
+		case fd.Kind() == protoreflect.StringKind && isConnectionIdField(fd.Name()):
+			if fd.IsList() {
+				list := value.List()
+				for i := 0; i < list.Len(); i++ {
+					*ids = append(*ids, list.Get(i).String())
+				}
+			} else {
+				*ids = append(*ids, value.String())
+			}
+		}
+		return true
+	})
+}
+
+// ex: connection_id, fk_source_connection_id
+func isConnectionIdField(name protoreflect.Name) bool {
+	return name == "connection_id" || strings.HasSuffix(string(name), "_connection_id") || name == "connection_ids" || strings.HasSuffix(string(name), "_connection_ids")
+}
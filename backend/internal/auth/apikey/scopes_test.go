@@ -0,0 +1,143 @@
+package auth_apikey
+
+import (
+	"context"
+	"testing"
+
+	"connectrpc.com/connect"
+	db_queries "github.com/nucleuscloud/neosync/backend/gen/go/db"
+	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
+	"github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1/mgmtv1alpha1connect"
+	"github.com/nucleuscloud/neosync/backend/internal/apikey"
+	pg_models "github.com/nucleuscloud/neosync/backend/sql/postgresql/models"
+	"github.com/zeebo/assert"
+)
+
+const (
+	mockJobId        = "6d3d3c1e-4d7c-4a52-9d0e-0a5b7f4e1f3a"
+	mockConnectionId = "9f2b7d3e-58a4-4c8e-b3f1-2a6c7e0d9b14"
+)
+
+func Test_verifyProcedureScopes(t *testing.T) {
+	assert.NoError(t, verifyProcedureScopes(nil, mgmtv1alpha1connect.JobServiceDeleteJobProcedure))
+
+	scopes := &pg_models.AccountApiKeyScopes{
+		AllowedProcedures: []string{mgmtv1alpha1connect.JobServiceCreateJobRunProcedure},
+	}
+	assert.NoError(t, verifyProcedureScopes(scopes, mgmtv1alpha1connect.JobServiceCreateJobRunProcedure))
+	assert.Error(t, verifyProcedureScopes(scopes, mgmtv1alpha1connect.JobServiceDeleteJobProcedure))
+
+	readOnly := &pg_models.AccountApiKeyScopes{ReadOnly: true}
+	assert.NoError(t, verifyProcedureScopes(readOnly, mgmtv1alpha1connect.JobServiceGetJobProcedure))
+	assert.Error(t, verifyProcedureScopes(readOnly, mgmtv1alpha1connect.JobServiceCreateJobRunProcedure))
+	assert.Error(t, verifyProcedureScopes(readOnly, "/mgmt.v1alpha1.JobService/Unknown"))
+
+	jobScoped := &pg_models.AccountApiKeyScopes{AllowedJobIds: []string{mockJobId}}
+	assert.NoError(t, verifyProcedureScopes(jobScoped, mgmtv1alpha1connect.JobServiceGetJobProcedure))
+	assert.Error(t, verifyProcedureScopes(jobScoped, mgmtv1alpha1connect.AccountSpecServiceApplyAccountSpecProcedure))
+}
+
+func Test_verifyProcedureScopes_Resource_Scoped_Cross_Service(t *testing.T) {
+	procedures := []string{
+		mgmtv1alpha1connect.UserAccountServiceInviteUserToTeamAccountProcedure,
+		mgmtv1alpha1connect.UserAccountServiceSetTeamAccountMemberRoleProcedure,
+		mgmtv1alpha1connect.UserAccountServiceGetAccountTransformerKeyProcedure,
+		mgmtv1alpha1connect.TransformersServiceGetUserDefinedTransformersProcedure,
+		mgmtv1alpha1connect.WebhookServiceCreateWebhookProcedure,
+		mgmtv1alpha1connect.AuditServiceGetAuditEventsProcedure,
+		mgmtv1alpha1connect.AccountSpecServiceApplyAccountSpecProcedure,
+	}
+	jobScoped := &pg_models.AccountApiKeyScopes{AllowedJobIds: []string{mockJobId}}
+	connectionScoped := &pg_models.AccountApiKeyScopes{AllowedConnectionIds: []string{mockConnectionId}}
+	for _, procedure := range procedures {
+		assert.Error(t, verifyProcedureScopes(jobScoped, procedure))
+		assert.Error(t, verifyProcedureScopes(connectionScoped, procedure))
+	}
+
+	// a key that is only scoped to jobs can not reach connections and vice versa
+	assert.Error(t, verifyProcedureScopes(jobScoped, mgmtv1alpha1connect.ConnectionServiceGetConnectionProcedure))
+	assert.Error(t, verifyProcedureScopes(jobScoped, mgmtv1alpha1connect.ConnectionDataServiceGetConnectionSchemaProcedure))
+	assert.Error(t, verifyProcedureScopes(connectionScoped, mgmtv1alpha1connect.JobServiceGetJobProcedure))
+	assert.Error(t, verifyProcedureScopes(connectionScoped, mgmtv1alpha1connect.JobServicePreviewJobMappingsProcedure))
+	assert.NoError(t, verifyProcedureScopes(connectionScoped, mgmtv1alpha1connect.ConnectionDataServiceGetConnectionSchemaProcedure))
+
+	bothScoped := &pg_models.AccountApiKeyScopes{AllowedJobIds: []string{mockJobId}, AllowedConnectionIds: []string{mockConnectionId}}
+	assert.NoError(t, verifyProcedureScopes(bothScoped, mgmtv1alpha1connect.JobServiceGetJobProcedure))
+	assert.NoError(t, verifyProcedureScopes(bothScoped, mgmtv1alpha1connect.ConnectionServiceGetConnectionProcedure))
+	assert.Error(t, verifyProcedureScopes(bothScoped, mgmtv1alpha1connect.UserAccountServiceGetAccountTransformerKeyProcedure))
+}
+
+func Test_VerifyRequestScopes_Jobs(t *testing.T) {
+	ctx := getScopedCtx(&pg_models.AccountApiKeyScopes{AllowedJobIds: []string{mockJobId}})
+
+	assert.NoError(t, VerifyRequestScopes(ctx, mgmtv1alpha1connect.JobServiceCreateJobRunProcedure, &mgmtv1alpha1.CreateJobRunRequest{JobId: mockJobId}))
+	assert.NoError(t, VerifyRequestScopes(ctx, mgmtv1alpha1connect.JobServiceGetJobProcedure, &mgmtv1alpha1.GetJobRequest{Id: mockJobId}))
+
+	err := VerifyRequestScopes(ctx, mgmtv1alpha1connect.JobServiceCreateJobRunProcedure, &mgmtv1alpha1.CreateJobRunRequest{JobId: mockConnectionId})
+	assert.Error(t, err)
+	assert.Equal(t, connect.CodePermissionDenied, connect.CodeOf(err))
+	// the jobs returned when listing can not be verified
+	assert.Error(t, VerifyRequestScopes(ctx, mgmtv1alpha1connect.JobServiceGetJobsProcedure, &mgmtv1alpha1.GetJobsRequest{AccountId: mockConnectionId}))
+}
+
+func Test_VerifyRequestScopes_Connections(t *testing.T) {
+	ctx := getScopedCtx(&pg_models.AccountApiKeyScopes{AllowedConnectionIds: []string{mockConnectionId}})
+
+	assert.NoError(t, VerifyRequestScopes(ctx, mgmtv1alpha1connect.ConnectionServiceGetConnectionProcedure, &mgmtv1alpha1.GetConnectionRequest{Id: mockConnectionId}))
+	assert.NoError(t, VerifyRequestScopes(ctx, mgmtv1alpha1connect.ConnectionDataServiceGetConnectionSchemaProcedure, &mgmtv1alpha1.GetConnectionSchemaRequest{ConnectionId: mockConnectionId}))
+	assert.Error(t, VerifyRequestScopes(ctx, mgmtv1alpha1connect.ConnectionServiceDeleteConnectionProcedure, &mgmtv1alpha1.DeleteConnectionRequest{Id: mockJobId}))
+}
+
+func Test_VerifyRequestScopes_Jobs_Nested_Connections(t *testing.T) {
+	const otherConnectionId = "0c1f5e2a-7b3d-4f6e-8a9c-1d2e3f4a5b6c"
+	ctx := getScopedCtx(&pg_models.AccountApiKeyScopes{AllowedJobIds: []string{mockJobId}, AllowedConnectionIds: []string{mockConnectionId}})
+
+	newRequest := func(connectionId string) *mgmtv1alpha1.UpdateJobSourceConnectionRequest {
+		return &mgmtv1alpha1.UpdateJobSourceConnectionRequest{
+			Id: mockJobId,
+			Source: &mgmtv1alpha1.JobSource{
+				Options: &mgmtv1alpha1.JobSourceOptions{
+					Config: &mgmtv1alpha1.JobSourceOptions_Postgres{
+						Postgres: &mgmtv1alpha1.PostgresSourceConnectionOptions{ConnectionId: connectionId},
+					},
+				},
+			},
+		}
+	}
+	assert.NoError(t, VerifyRequestScopes(ctx, mgmtv1alpha1connect.JobServiceUpdateJobSourceConnectionProcedure, newRequest(mockConnectionId)))
+	err := VerifyRequestScopes(ctx, mgmtv1alpha1connect.JobServiceUpdateJobSourceConnectionProcedure, newRequest(otherConnectionId))
+	assert.Error(t, err)
+	assert.Equal(t, connect.CodePermissionDenied, connect.CodeOf(err))
+
+	// previewing mappings against a connection does not act on an allowed job
+	assert.Error(t, VerifyRequestScopes(ctx, mgmtv1alpha1connect.JobServicePreviewJobMappingsProcedure, &mgmtv1alpha1.PreviewJobMappingsRequest{
+		Source: &mgmtv1alpha1.PreviewJobMappingsRequest_Mappings{
+			Mappings: &mgmtv1alpha1.JobMappingsPreviewSource{ConnectionId: otherConnectionId},
+		},
+	}))
+}
+
+func Test_getConnectionIdFields(t *testing.T) {
+	ids := getConnectionIdFields(&mgmtv1alpha1.PreviewJobMappingsRequest{
+		Source: &mgmtv1alpha1.PreviewJobMappingsRequest_Mappings{
+			Mappings: &mgmtv1alpha1.JobMappingsPreviewSource{ConnectionId: mockConnectionId},
+		},
+	})
+	assert.Equal(t, []string{mockConnectionId}, ids)
+	assert.Equal(t, 0, len(getConnectionIdFields(&mgmtv1alpha1.GetJobRequest{Id: mockJobId})))
+}
+
+func Test_VerifyRequestScopes_Unscoped(t *testing.T) {
+	assert.NoError(t, VerifyRequestScopes(context.Background(), mgmtv1alpha1connect.JobServiceGetJobProcedure, &mgmtv1alpha1.GetJobRequest{Id: mockJobId}))
+	assert.NoError(t, VerifyRequestScopes(getScopedCtx(nil), mgmtv1alpha1connect.JobServiceGetJobProcedure, &mgmtv1alpha1.GetJobRequest{Id: mockJobId}))
+
+	workerCtx := context.WithValue(context.Background(), TokenContextKey{}, &TokenContextData{ApiKeyType: apikey.WorkerApiKey})
+	assert.NoError(t, VerifyRequestScopes(workerCtx, mgmtv1alpha1connect.JobServiceGetJobProcedure, &mgmtv1alpha1.GetJobRequest{Id: mockJobId}))
+}
+
+func getScopedCtx(scopes *pg_models.AccountApiKeyScopes) context.Context {
+	return context.WithValue(context.Background(), TokenContextKey{}, &TokenContextData{
+		ApiKey:     &db_queries.NeosyncApiAccountApiKey{Scopes: scopes},
+		ApiKeyType: apikey.AccountApiKey,
+	})
+}
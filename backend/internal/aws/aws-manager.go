@@ -16,6 +16,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/sts"
 	"github.com/aws/smithy-go"
 	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
+	"github.com/nucleuscloud/neosync/backend/pkg/secrets"
 )
 
 type NeosyncAwsManager struct {
@@ -87,6 +88,10 @@ func withS3Region(region *string) func(o *s3.Options) {
 }
 
 func (n *NeosyncAwsManager) getAwsConfig(ctx context.Context, config *mgmtv1alpha1.AwsS3ConnectionConfig) (*aws.Config, error) {
+	config, err := secrets.DefaultResolver().ResolveAwsS3Config(ctx, config)
+	if err != nil {
+		return nil, err
+	}
 	awsCfg := aws.NewConfig()
 
 	configCreds := config.GetCredentials()
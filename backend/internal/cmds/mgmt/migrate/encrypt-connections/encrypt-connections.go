@@ -0,0 +1,126 @@
+package encryptconnections_cmd
+
+import (
+	"errors"
+	"fmt"
+	"log/slog"
+	"os"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/nucleuscloud/neosync/backend/internal/encryption"
+	"github.com/nucleuscloud/neosync/backend/internal/nucleusdb"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+func NewCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "encrypt-connections",
+		Short: "Encrypts the credentials of existing connections and rotates them to the current master key",
+		Long: `Encrypts the credentials of every connection that is still stored as plaintext
+and re-wraps the data keys of connections that were encrypted with an older master key.
+Run this after enabling connection encryption or after changing the current key of the key provider.`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			dbUrl, err := cmd.Flags().GetString("database")
+			if err != nil {
+				return err
+			}
+			if dbUrl == "" {
+				dbUrl, err = getDbUrl()
+				if err != nil {
+					return err
+				}
+			}
+			rotateDataKeys, err := cmd.Flags().GetBool("rotate-data-keys")
+			if err != nil {
+				return err
+			}
+			batchSize, err := cmd.Flags().GetInt32("batch-size")
+			if err != nil {
+				return err
+			}
+			if batchSize <= 0 {
+				return errors.New("batch-size must be greater than 0")
+			}
+
+			keyProvider, err := encryption.NewKeyProvider(&encryption.KeyProviderConfig{
+				Provider:     viper.GetString("ENCRYPTION_KEY_PROVIDER"),
+				LocalKeyFile: viper.GetString("ENCRYPTION_LOCAL_KEY_FILE"),
+			})
+			if err != nil {
+				return err
+			}
+			if keyProvider == nil {
+				return errors.New("must provide ENCRYPTION_KEY_PROVIDER in environment")
+			}
+
+			cmd.SilenceUsage = true
+			logger := slog.New(slog.NewJSONHandler(os.Stdout, nil))
+
+			pool, err := pgxpool.New(cmd.Context(), dbUrl)
+			if err != nil {
+				return err
+			}
+			defer pool.Close()
+
+			updated, err := nucleusdb.New(pool, nil).RotateConnectionEncryption(
+				cmd.Context(),
+				encryption.NewEnvelope(keyProvider),
+				rotateDataKeys,
+				batchSize,
+			)
+			logger.Info("connection encryption migration finished", "updated", updated, "currentKeyId", keyProvider.CurrentKeyId())
+			return err
+		},
+	}
+	cmd.Flags().StringP("database", "d", "", "optionally set the database url, otherwise it will pull from the environment")
+	cmd.Flags().Bool("rotate-data-keys", false, "re-encrypt every connection with a new data key instead of only re-wrapping existing data keys")
+	cmd.Flags().Int32("batch-size", 100, "the number of connections to update per transaction")
+	return cmd
+}
+
+func getDbUrl() (string, error) {
+	dburl := viper.GetString("DB_URL")
+	if dburl != "" {
+		return dburl, nil
+	}
+
+	dbHost := viper.GetString("DB_HOST")
+	if dbHost == "" {
+		return "", fmt.Errorf("must provide DB_HOST in environment")
+	}
+
+	dbPort := viper.GetInt("DB_PORT")
+	if dbPort == 0 {
+		return "", fmt.Errorf("must provide DB_PORT in environment")
+	}
+
+	dbName := viper.GetString("DB_NAME")
+	if dbName == "" {
+		return "", fmt.Errorf("must provide DB_NAME in environment")
+	}
+
+	dbUser := viper.GetString("DB_USER")
+	if dbUser == "" {
+		return "", fmt.Errorf("must provide DB_USER in environment")
+	}
+
+	dbPass := viper.GetString("DB_PASS")
+	if dbPass == "" {
+		return "", fmt.Errorf("must provide DB_PASS in environment")
+	}
+
+	sslMode := "require"
+	if viper.IsSet("DB_SSL_DISABLE") && viper.GetBool("DB_SSL_DISABLE") {
+		sslMode = "disable"
+	}
+
+	return nucleusdb.GetDbUrl(&nucleusdb.ConnectConfig{
+		Host:     dbHost,
+		Port:     dbPort,
+		Database: dbName,
+		User:     dbUser,
+		Pass:     dbPass,
+		SslMode:  &sslMode,
+	}), nil
+}
@@ -1,6 +1,7 @@
 package migrate_cmd
 
 import (
+	encryptconnections_cmd "github.com/nucleuscloud/neosync/backend/internal/cmds/mgmt/migrate/encrypt-connections"
 	up_cmd "github.com/nucleuscloud/neosync/backend/internal/cmds/mgmt/migrate/up"
 	"github.com/spf13/cobra"
 )
@@ -15,5 +16,6 @@ func NewCmd() *cobra.Command {
 	}
 
 	cmd.AddCommand(up_cmd.NewCmd())
+	cmd.AddCommand(encryptconnections_cmd.NewCmd())
 	return cmd
 }
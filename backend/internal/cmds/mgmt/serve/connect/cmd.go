@@ -8,6 +8,9 @@ import (
 	"log/slog"
 	"net/http"
 	"net/url"
+	"os"
+	"os/signal"
+	"syscall"
 	"time"
 
 	"connectrpc.com/connect"
@@ -30,14 +33,23 @@ import (
 	"github.com/nucleuscloud/neosync/backend/internal/authmgmt/keycloak"
 	awsmanager "github.com/nucleuscloud/neosync/backend/internal/aws"
 	up_cmd "github.com/nucleuscloud/neosync/backend/internal/cmds/mgmt/migrate/up"
+	audit_interceptor "github.com/nucleuscloud/neosync/backend/internal/connect/interceptors/audit"
 	auth_interceptor "github.com/nucleuscloud/neosync/backend/internal/connect/interceptors/auth"
 	logger_interceptor "github.com/nucleuscloud/neosync/backend/internal/connect/interceptors/logger"
 	logging_interceptor "github.com/nucleuscloud/neosync/backend/internal/connect/interceptors/logging"
+	rbac_interceptor "github.com/nucleuscloud/neosync/backend/internal/connect/interceptors/rbac"
+	scopes_interceptor "github.com/nucleuscloud/neosync/backend/internal/connect/interceptors/scopes"
+	"github.com/nucleuscloud/neosync/backend/internal/encryption"
 	neosynclogger "github.com/nucleuscloud/neosync/backend/internal/logger"
 	"github.com/nucleuscloud/neosync/backend/internal/nucleusdb"
 	clientmanager "github.com/nucleuscloud/neosync/backend/internal/temporal/client-manager"
+	"github.com/nucleuscloud/neosync/backend/internal/webhooks"
+	dbschemas_mssql "github.com/nucleuscloud/neosync/backend/pkg/dbschemas/mssql"
+	dbschemas_sqlite "github.com/nucleuscloud/neosync/backend/pkg/dbschemas/sqlite"
 	"github.com/nucleuscloud/neosync/backend/pkg/sqlconnect"
+	v1alpha1_accountspecservice "github.com/nucleuscloud/neosync/backend/services/mgmt/v1alpha1/account-spec-service"
 	v1alpha1_apikeyservice "github.com/nucleuscloud/neosync/backend/services/mgmt/v1alpha1/api-key-service"
+	v1alpha1_auditservice "github.com/nucleuscloud/neosync/backend/services/mgmt/v1alpha1/audit-service"
 	v1alpha1_authservice "github.com/nucleuscloud/neosync/backend/services/mgmt/v1alpha1/auth-service"
 	v1alpha1_connectiondataservice "github.com/nucleuscloud/neosync/backend/services/mgmt/v1alpha1/connection-data-service"
 	v1alpha1_connectionservice "github.com/nucleuscloud/neosync/backend/services/mgmt/v1alpha1/connection-service"
@@ -45,6 +57,7 @@ import (
 	v1alpha1_metricsservice "github.com/nucleuscloud/neosync/backend/services/mgmt/v1alpha1/metrics-service"
 	v1alpha1_transformerservice "github.com/nucleuscloud/neosync/backend/services/mgmt/v1alpha1/transformers-service"
 	v1alpha1_useraccountservice "github.com/nucleuscloud/neosync/backend/services/mgmt/v1alpha1/user-account-service"
+	v1alpha1_webhookservice "github.com/nucleuscloud/neosync/backend/services/mgmt/v1alpha1/webhook-service"
 
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
@@ -98,6 +111,9 @@ func serve(ctx context.Context) error {
 		mgmtv1alpha1connect.TransformersServiceName,
 		mgmtv1alpha1connect.ApiKeyServiceName,
 		mgmtv1alpha1connect.ConnectionDataServiceName,
+		mgmtv1alpha1connect.AuditServiceName,
+		mgmtv1alpha1connect.WebhookServiceName,
+		mgmtv1alpha1connect.AccountSpecServiceName,
 	}
 
 	if shouldServiceMetrics() {
@@ -124,6 +140,16 @@ func serve(ctx context.Context) error {
 		return err
 	}
 
+	keyProvider, err := encryption.NewKeyProvider(getEncryptionKeyProviderConfig())
+	if err != nil {
+		return err
+	}
+	var connectionEnvelope *encryption.Envelope
+	if keyProvider != nil {
+		connectionEnvelope = encryption.NewEnvelope(keyProvider)
+	}
+	db.Q = nucleusdb.NewEncryptedConnectionQuerier(db.Q, connectionEnvelope)
+
 	if viper.GetBool("DB_AUTO_MIGRATE") {
 		schemaDir := viper.GetString("DB_SCHEMA_DIR")
 		if schemaDir == "" {
@@ -154,12 +180,14 @@ func serve(ctx context.Context) error {
 	}
 	loggerInterceptor := logger_interceptor.NewInterceptor(logger)
 	loggingInterceptor := logging_interceptor.NewInterceptor(logger)
+	rbacInterceptor := rbac_interceptor.NewInterceptor()
 
 	stdInterceptors := []connect.Interceptor{
 		otelInterceptor,
 		loggerInterceptor,
 		validateInterceptor,
 		loggingInterceptor,
+		rbacInterceptor,
 	}
 
 	// standard auth interceptors that should be applied to most services
@@ -181,14 +209,7 @@ func serve(ctx context.Context) error {
 		if err != nil {
 			return err
 		}
-		apikeyClient := auth_apikey.New(db.Q, db.Db, getAllowedWorkerApiKeys(getIsNeosyncCloud()), []string{
-			mgmtv1alpha1connect.JobServiceGetJobProcedure,
-			mgmtv1alpha1connect.ConnectionServiceGetConnectionProcedure,
-			mgmtv1alpha1connect.TransformersServiceGetUserDefinedTransformerByIdProcedure,
-			mgmtv1alpha1connect.ConnectionDataServiceGetConnectionForeignConstraintsProcedure,
-			mgmtv1alpha1connect.ConnectionDataServiceGetConnectionPrimaryConstraintsProcedure,
-			mgmtv1alpha1connect.ConnectionDataServiceGetConnectionInitStatementsProcedure,
-		})
+		apikeyClient := auth_apikey.New(db.Q, db.Db, getAllowedWorkerApiKeys(getIsNeosyncCloud()), getAllowedWorkerProcedures())
 		stdAuthInterceptors = append(
 			stdAuthInterceptors,
 			auth_interceptor.NewInterceptor(
@@ -197,6 +218,8 @@ func serve(ctx context.Context) error {
 					apikeyClient,
 				).InjectTokenCtx,
 			),
+			scopes_interceptor.NewInterceptor(),
+			rbac_interceptor.NewRoleInterceptor(db.Q, db.Db),
 		)
 		jwtOnlyAuthInterceptors = append(
 			jwtOnlyAuthInterceptors,
@@ -219,6 +242,11 @@ func serve(ctx context.Context) error {
 		)
 	}
 
+	// the audit interceptor runs after authentication so that the actor of each event is known
+	auditInterceptor := audit_interceptor.NewInterceptor(db.Q, db.Db)
+	stdAuthInterceptors = append(stdAuthInterceptors, auditInterceptor)
+	jwtOnlyAuthInterceptors = append(jwtOnlyAuthInterceptors, auditInterceptor)
+
 	api := http.NewServeMux()
 
 	authBaseUrl := getAuthBaseUrl()
@@ -289,8 +317,30 @@ func serve(ctx context.Context) error {
 		),
 	)
 
+	auditService := v1alpha1_auditservice.New(&v1alpha1_auditservice.Config{}, db, useraccountService)
+	api.Handle(
+		mgmtv1alpha1connect.NewAuditServiceHandler(
+			auditService,
+			connect.WithInterceptors(stdInterceptors...),
+			connect.WithInterceptors(stdAuthInterceptors...),
+		),
+	)
+
+	allowWebhookPrivateNetworks := viper.GetBool("WEBHOOKS_ALLOW_PRIVATE_NETWORKS")
+	webhookDispatcher := webhooks.NewDispatcher(db.Q, db.Db, logger, &webhooks.Config{AllowPrivateNetworks: allowWebhookPrivateNetworks})
+	webhookService := v1alpha1_webhookservice.New(&v1alpha1_webhookservice.Config{AllowPrivateNetworks: allowWebhookPrivateNetworks}, db, useraccountService, webhookDispatcher)
+	api.Handle(
+		mgmtv1alpha1connect.NewWebhookServiceHandler(
+			webhookService,
+			connect.WithInterceptors(stdInterceptors...),
+			connect.WithInterceptors(stdAuthInterceptors...),
+		),
+	)
+
 	pgquerier := pg_queries.New()
 	mysqlquerier := mysql_queries.New()
+	mssqlquerier := dbschemas_mssql.New()
+	sqlitequerier := dbschemas_sqlite.New()
 	sqlConnector := &sqlconnect.SqlOpenConnector{}
 	connectionService := v1alpha1_connectionservice.New(&v1alpha1_connectionservice.Config{}, db, useraccountService, sqlConnector, pgquerier,
 		mysqlquerier)
@@ -314,6 +364,7 @@ func serve(ctx context.Context) error {
 		tfwfmgr,
 		connectionService,
 		useraccountService,
+		sqlConnector,
 	)
 	api.Handle(
 		mgmtv1alpha1connect.NewJobServiceHandler(
@@ -342,6 +393,8 @@ func serve(ctx context.Context) error {
 		sqlConnector,
 		pgquerier,
 		mysqlquerier,
+		mssqlquerier,
+		sqlitequerier,
 	)
 	api.Handle(
 		mgmtv1alpha1connect.NewConnectionDataServiceHandler(
@@ -351,6 +404,21 @@ func serve(ctx context.Context) error {
 		),
 	)
 
+	accountSpecService := v1alpha1_accountspecservice.New(
+		&v1alpha1_accountspecservice.Config{},
+		useraccountService,
+		connectionService,
+		transformerService,
+		jobService,
+	)
+	api.Handle(
+		mgmtv1alpha1connect.NewAccountSpecServiceHandler(
+			accountSpecService,
+			connect.WithInterceptors(stdInterceptors...),
+			connect.WithInterceptors(stdAuthInterceptors...),
+		),
+	)
+
 	if shouldServiceMetrics() {
 		roundTripper := promapi.DefaultRoundTripper
 		promApiKey := getPromApiKey()
@@ -388,11 +456,24 @@ func serve(ctx context.Context) error {
 		ReadHeaderTimeout: 10 * time.Second,
 	}
 
+	// stop accepting requests and abandon pending webhook retries on shutdown
+	shutdownCtx, stop := signal.NotifyContext(ctx, os.Interrupt, syscall.SIGTERM)
+	defer stop()
+	go func() {
+		<-shutdownCtx.Done()
+		timeoutCtx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+		defer cancel()
+		if err := httpServer.Shutdown(timeoutCtx); err != nil {
+			logger.Error(fmt.Sprintf("unable to gracefully shut down server: %s", err.Error()))
+		}
+	}()
+
 	logger.Info(fmt.Sprintf("listening on %s", httpServer.Addr))
 
-	if err = httpServer.ListenAndServe(); err != nil {
+	if err = httpServer.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
 		logger.Error(err.Error())
 	}
+	webhookDispatcher.Close()
 	return nil
 }
 
@@ -643,6 +724,33 @@ func getAllowedWorkerApiKeys(isNeosyncCloud bool) []string {
 	return []string{}
 }
 
+// the procedures that the worker is allowed to call with a worker api key
+func getAllowedWorkerProcedures() []string {
+	return []string{
+		mgmtv1alpha1connect.JobServiceGetJobProcedure,
+		mgmtv1alpha1connect.JobServiceGetJobWatermarksProcedure,
+		mgmtv1alpha1connect.JobServiceSetJobRunWatermarksProcedure,
+		mgmtv1alpha1connect.JobServiceSetJobRunValidationReportProcedure,
+		mgmtv1alpha1connect.JobServiceTriggerDependentJobsProcedure,
+		mgmtv1alpha1connect.JobServiceGetJobRunSchemaDriftReportProcedure,
+		mgmtv1alpha1connect.JobServiceSetJobRunSchemaDriftReportProcedure,
+		mgmtv1alpha1connect.WebhookServicePublishJobRunEventProcedure,
+		mgmtv1alpha1connect.ConnectionServiceGetConnectionProcedure,
+		mgmtv1alpha1connect.UserAccountServiceGetAccountTransformerKeyProcedure,
+		mgmtv1alpha1connect.TransformersServiceGetUserDefinedTransformerByIdProcedure,
+		mgmtv1alpha1connect.ConnectionDataServiceGetConnectionForeignConstraintsProcedure,
+		mgmtv1alpha1connect.ConnectionDataServiceGetConnectionPrimaryConstraintsProcedure,
+		mgmtv1alpha1connect.ConnectionDataServiceGetConnectionInitStatementsProcedure,
+	}
+}
+
+func getEncryptionKeyProviderConfig() *encryption.KeyProviderConfig {
+	return &encryption.KeyProviderConfig{
+		Provider:     viper.GetString("ENCRYPTION_KEY_PROVIDER"),
+		LocalKeyFile: viper.GetString("ENCRYPTION_LOCAL_KEY_FILE"),
+	}
+}
+
 func getIsKubernetes() bool {
 	return viper.GetBool("KUBERNETES_ENABLED")
 }
@@ -0,0 +1,50 @@
+package serve_connect
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"connectrpc.com/connect"
+	db_queries "github.com/nucleuscloud/neosync/backend/gen/go/db"
+	"github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1/mgmtv1alpha1connect"
+	"github.com/nucleuscloud/neosync/backend/internal/apikey"
+	auth_apikey "github.com/nucleuscloud/neosync/backend/internal/auth/apikey"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_getAllowedWorkerProcedures_WorkerApiKey(t *testing.T) {
+	workerKey := apikey.NewV1WorkerKey()
+	client := auth_apikey.New(db_queries.NewMockQuerier(t), db_queries.NewMockDBTX(t), []string{workerKey}, getAllowedWorkerProcedures())
+
+	// procedures that are called by the worker during a job run
+	procedures := []string{
+		mgmtv1alpha1connect.UserAccountServiceGetAccountTransformerKeyProcedure,
+		mgmtv1alpha1connect.JobServiceSetJobRunValidationReportProcedure,
+	}
+	for _, procedure := range procedures {
+		procedure := procedure
+		t.Run(procedure, func(t *testing.T) {
+			ctx, err := client.InjectTokenCtx(context.Background(), http.Header{
+				"Authorization": []string{fmt.Sprintf("Bearer %s", workerKey)},
+			}, connect.Spec{Procedure: procedure})
+			require.NoError(t, err)
+
+			data, err := auth_apikey.GetTokenDataFromCtx(ctx)
+			require.NoError(t, err)
+			assert.Equal(t, apikey.WorkerApiKey, data.ApiKeyType)
+		})
+	}
+}
+
+func Test_getAllowedWorkerProcedures_WorkerApiKey_DisAllowed(t *testing.T) {
+	workerKey := apikey.NewV1WorkerKey()
+	client := auth_apikey.New(db_queries.NewMockQuerier(t), db_queries.NewMockDBTX(t), []string{workerKey}, getAllowedWorkerProcedures())
+
+	_, err := client.InjectTokenCtx(context.Background(), http.Header{
+		"Authorization": []string{fmt.Sprintf("Bearer %s", workerKey)},
+	}, connect.Spec{Procedure: mgmtv1alpha1connect.UserAccountServiceSetAccountTransformerKeyProcedure})
+	assert.ErrorIs(t, err, auth_apikey.InvalidApiKeyErr)
+}
@@ -0,0 +1,263 @@
+package audit_interceptor
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"connectrpc.com/connect"
+	"github.com/jackc/pgx/v5/pgtype"
+	db_queries "github.com/nucleuscloud/neosync/backend/gen/go/db"
+	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
+	"github.com/nucleuscloud/neosync/backend/internal/apikey"
+	"github.com/nucleuscloud/neosync/backend/internal/audit"
+	auth_apikey "github.com/nucleuscloud/neosync/backend/internal/auth/apikey"
+	logger_interceptor "github.com/nucleuscloud/neosync/backend/internal/connect/interceptors/logger"
+	"github.com/nucleuscloud/neosync/backend/internal/nucleusdb"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+const (
+	redactedValue = "REDACTED"
+	statusCodeOk  = "ok"
+)
+
+// field names that contain any of these are redacted from the recorded request
+var sensitiveFieldNameParts = []string{"pass", "secret", "token", "url", "cert", "private", "key"}
+
+type queries interface {
+	CreateAuditEvent(ctx context.Context, db db_queries.DBTX, arg db_queries.CreateAuditEventParams) (db_queries.NeosyncApiAuditEvent, error)
+}
+
+// Records an audit event for every call to a mutating procedure that can be tied to an account.
+// Must be applied after the auth interceptors so that the actor is known.
+type Interceptor struct {
+	q  queries
+	db db_queries.DBTX
+}
+
+func NewInterceptor(q queries, db db_queries.DBTX) connect.Interceptor {
+	return &Interceptor{q: q, db: db}
+}
+
+func (i *Interceptor) WrapUnary(next connect.UnaryFunc) connect.UnaryFunc {
+	return func(ctx context.Context, request connect.AnyRequest) (connect.AnyResponse, error) {
+		if !audit.IsMutatingProcedure(request.Spec().Procedure) {
+			return next(ctx, request)
+		}
+		newCtx, eventData := audit.WithEventData(ctx)
+		response, err := next(newCtx, request)
+		i.recordEvent(newCtx, request, response, err, eventData)
+		return response, err
+	}
+}
+
+// streaming procedures only read data and are not audited
+func (i *Interceptor) WrapStreamingClient(next connect.StreamingClientFunc) connect.StreamingClientFunc {
+	return func(ctx context.Context, spec connect.Spec) connect.StreamingClientConn {
+		return next(ctx, spec)
+	}
+}
+
+func (i *Interceptor) WrapStreamingHandler(next connect.StreamingHandlerFunc) connect.StreamingHandlerFunc {
+	return func(ctx context.Context, conn connect.StreamingHandlerConn) error {
+		return next(ctx, conn)
+	}
+}
+
+// Failing to record an event never fails the request, as the change it describes has already been made
+func (i *Interceptor) recordEvent(
+	ctx context.Context,
+	request connect.AnyRequest,
+	response connect.AnyResponse,
+	handlerErr error,
+	eventData *audit.EventData,
+) {
+	logger := logger_interceptor.GetLoggerFromContextOrDefault(ctx)
+	procedure := request.Spec().Procedure
+
+	requestMsg, _ := request.Any().(proto.Message)
+	// the response is a typed nil when the handler fails
+	var responseMsg proto.Message
+	if handlerErr == nil && response != nil {
+		responseMsg, _ = response.Any().(proto.Message)
+	}
+
+	accountId, userId := eventData.GetAccountUser()
+	if accountId == "" {
+		accountId = getStringField(requestMsg, "account_id")
+	}
+	if accountId == "" {
+		accountId = getStringField(responseMsg, "account_id")
+	}
+	accountUuid, err := nucleusdb.ToUuid(accountId)
+	if err != nil {
+		// procedures that are not made against an account are not audited
+		return
+	}
+
+	requestDiff, err := getRedactedRequestDiff(requestMsg)
+	if err != nil {
+		logger.Error(fmt.Sprintf("unable to build audit event request diff for %s: %s", procedure, err.Error()))
+		return
+	}
+
+	actorType, actorId := getActor(ctx, userId)
+	statusCode := statusCodeOk
+	if handlerErr != nil {
+		statusCode = connect.CodeOf(handlerErr).String()
+	}
+
+	resourceId := pgtype.Text{}
+	if id := getResourceId(requestMsg, responseMsg); id != "" {
+		resourceId = pgtype.Text{String: id, Valid: true}
+	}
+
+	_, err = i.q.CreateAuditEvent(ctx, i.db, db_queries.CreateAuditEventParams{
+		AccountID:   accountUuid,
+		ActorType:   int16(actorType),
+		ActorID:     actorId,
+		Procedure:   procedure,
+		ResourceID:  resourceId,
+		RequestDiff: requestDiff,
+		StatusCode:  statusCode,
+	})
+	if err != nil {
+		logger.Error(fmt.Sprintf("unable to record audit event for %s: %s", procedure, err.Error()))
+	}
+}
+
+func getActor(ctx context.Context, userId string) (mgmtv1alpha1.AuditActorType, pgtype.UUID) {
+	apiKeyData, err := auth_apikey.GetTokenDataFromCtx(ctx)
+	if err == nil && apiKeyData != nil {
+		if apiKeyData.ApiKeyType == apikey.WorkerApiKey || apiKeyData.ApiKey == nil {
+			return mgmtv1alpha1.AuditActorType_AUDIT_ACTOR_TYPE_WORKER, pgtype.UUID{}
+		}
+		return mgmtv1alpha1.AuditActorType_AUDIT_ACTOR_TYPE_API_KEY, apiKeyData.ApiKey.ID
+	}
+	userUuid, err := nucleusdb.ToUuid(userId)
+	if err != nil {
+		return mgmtv1alpha1.AuditActorType_AUDIT_ACTOR_TYPE_USER, pgtype.UUID{}
+	}
+	return mgmtv1alpha1.AuditActorType_AUDIT_ACTOR_TYPE_USER, userUuid
+}
+
+// The resource is the first id on the request other than the account id.
+// Procedures that create a resource only return its id, so it is read from the message on the response instead.
+func getResourceId(requestMsg, responseMsg proto.Message) string {
+	if id := getFirstIdField(requestMsg); id != "" {
+		return id
+	}
+	if responseMsg == nil {
+		return ""
+	}
+	var id string
+	responseMsg.ProtoReflect().Range(func(fd protoreflect.FieldDescriptor, v protoreflect.Value) bool {
+		if fd.Kind() == protoreflect.MessageKind && !fd.IsList() && !fd.IsMap() {
+			id = getStringField(v.Message().Interface(), "id")
+		}
+		return id == ""
+	})
+	return id
+}
+
+func getFirstIdField(msg proto.Message) string {
+	if msg == nil {
+		return ""
+	}
+	if id := getStringField(msg, "id"); id != "" {
+		return id
+	}
+	fields := msg.ProtoReflect().Descriptor().Fields()
+	for idx := 0; idx < fields.Len(); idx++ {
+		fd := fields.Get(idx)
+		name := string(fd.Name())
+		if name == "account_id" || !strings.HasSuffix(name, "_id") {
+			continue
+		}
+		if id := getStringField(msg, fd.Name()); id != "" {
+			return id
+		}
+	}
+	return ""
+}
+
+func getStringField(msg proto.Message, name protoreflect.Name) string {
+	if msg == nil {
+		return ""
+	}
+	reflectMsg := msg.ProtoReflect()
+	fd := reflectMsg.Descriptor().Fields().ByName(name)
+	if fd == nil || fd.Kind() != protoreflect.StringKind || fd.IsList() || fd.IsMap() || !reflectMsg.Has(fd) {
+		return ""
+	}
+	return reflectMsg.Get(fd).String()
+}
+
+// Returns the JSON of the fields that were set on the request, with every sensitive value redacted
+func getRedactedRequestDiff(msg proto.Message) ([]byte, error) {
+	if msg == nil {
+		return []byte("{}"), nil
+	}
+	redacted := proto.Clone(msg)
+	redactMessage(redacted.ProtoReflect())
+	return protojson.Marshal(redacted)
+}
+
+func redactMessage(msg protoreflect.Message) {
+	// fields of msg can not be set while ranging over it, so they are collected and redacted after
+	redactedFields := []protoreflect.FieldDescriptor{}
+	msg.Range(func(fd protoreflect.FieldDescriptor, v protoreflect.Value) bool {
+		switch {
+		case fd.IsMap():
+			if fd.MapValue().Kind() == protoreflect.MessageKind {
+				v.Map().Range(func(_ protoreflect.MapKey, mv protoreflect.Value) bool {
+					redactMessage(mv.Message())
+					return true
+				})
+			} else if isSensitiveField(fd) {
+				redactedFields = append(redactedFields, fd)
+			}
+		case fd.IsList():
+			list := v.List()
+			for idx := 0; idx < list.Len(); idx++ {
+				if fd.Kind() == protoreflect.MessageKind {
+					redactMessage(list.Get(idx).Message())
+				} else if isSensitiveField(fd) {
+					list.Set(idx, protoreflect.ValueOfString(redactedValue))
+				}
+			}
+		case fd.Kind() == protoreflect.MessageKind:
+			redactMessage(v.Message())
+		case (fd.Kind() == protoreflect.StringKind || fd.Kind() == protoreflect.BytesKind) && isSensitiveField(fd):
+			redactedFields = append(redactedFields, fd)
+		}
+		return true
+	})
+
+	for _, fd := range redactedFields {
+		switch {
+		case fd.IsMap():
+			msg.Clear(fd)
+		case fd.Kind() == protoreflect.BytesKind:
+			msg.Set(fd, protoreflect.ValueOfBytes([]byte(redactedValue)))
+		default:
+			msg.Set(fd, protoreflect.ValueOfString(redactedValue))
+		}
+	}
+}
+
+func isSensitiveField(fd protoreflect.FieldDescriptor) bool {
+	if fd.IsList() && fd.Kind() != protoreflect.StringKind {
+		return false
+	}
+	name := strings.ToLower(string(fd.Name()))
+	for _, part := range sensitiveFieldNameParts {
+		if strings.Contains(name, part) {
+			return true
+		}
+	}
+	return false
+}
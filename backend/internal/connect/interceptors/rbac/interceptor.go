@@ -0,0 +1,53 @@
+package rbac_interceptor
+
+import (
+	"context"
+	"fmt"
+
+	"connectrpc.com/connect"
+	nucleuserrors "github.com/nucleuscloud/neosync/backend/internal/errors"
+	"github.com/nucleuscloud/neosync/backend/internal/rbac"
+)
+
+// Attaches the role that the procedure requires to the context.
+// The role is enforced by the RoleInterceptor once the caller is known, and again when the handler verifies that the user is in the requested account.
+type Interceptor struct{}
+
+func NewInterceptor() connect.Interceptor {
+	return &Interceptor{}
+}
+
+func (i *Interceptor) WrapUnary(next connect.UnaryFunc) connect.UnaryFunc {
+	return func(ctx context.Context, request connect.AnyRequest) (connect.AnyResponse, error) {
+		newCtx, err := setRequiredRole(ctx, request.Spec().Procedure)
+		if err != nil {
+			return nil, err
+		}
+		return next(newCtx, request)
+	}
+}
+
+func (i *Interceptor) WrapStreamingClient(next connect.StreamingClientFunc) connect.StreamingClientFunc {
+	return func(ctx context.Context, spec connect.Spec) connect.StreamingClientConn {
+		return next(ctx, spec)
+	}
+}
+
+func (i *Interceptor) WrapStreamingHandler(next connect.StreamingHandlerFunc) connect.StreamingHandlerFunc {
+	return func(ctx context.Context, conn connect.StreamingHandlerConn) error {
+		newCtx, err := setRequiredRole(ctx, conn.Spec().Procedure)
+		if err != nil {
+			return err
+		}
+		return next(newCtx, conn)
+	}
+}
+
+// procedures that have not been given a role are rejected so that new procedures are never left unprotected
+func setRequiredRole(ctx context.Context, procedure string) (context.Context, error) {
+	role, ok := rbac.GetProcedureRole(procedure)
+	if !ok {
+		return nil, nucleuserrors.NewForbidden(fmt.Sprintf("procedure %s has not been assigned a role", procedure))
+	}
+	return rbac.SetRequiredRoleInCtx(ctx, role), nil
+}
@@ -0,0 +1,65 @@
+package rbac_interceptor
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"connectrpc.com/connect"
+	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
+	"github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1/mgmtv1alpha1connect"
+	"github.com/nucleuscloud/neosync/backend/internal/rbac"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Interceptor_WrapUnary_InjectRequiredRole(t *testing.T) {
+	var requiredRole mgmtv1alpha1.AccountRole
+
+	mux := http.NewServeMux()
+	mux.Handle(mgmtv1alpha1connect.ConnectionServiceDeleteConnectionProcedure, connect.NewUnaryHandler(
+		mgmtv1alpha1connect.ConnectionServiceDeleteConnectionProcedure,
+		func(ctx context.Context, r *connect.Request[mgmtv1alpha1.DeleteConnectionRequest]) (*connect.Response[mgmtv1alpha1.DeleteConnectionResponse], error) {
+			requiredRole = rbac.GetRequiredRoleFromCtx(ctx)
+			return connect.NewResponse(&mgmtv1alpha1.DeleteConnectionResponse{}), nil
+		},
+		connect.WithInterceptors(NewInterceptor()),
+	))
+	srv := startHTTPServer(t, mux)
+
+	client := mgmtv1alpha1connect.NewConnectionServiceClient(srv.Client(), srv.URL)
+	_, err := client.DeleteConnection(context.Background(), connect.NewRequest(&mgmtv1alpha1.DeleteConnectionRequest{}))
+	assert.Nil(t, err)
+	assert.Equal(t, mgmtv1alpha1.AccountRole_ACCOUNT_ROLE_ADMIN, requiredRole)
+}
+
+func Test_Interceptor_WrapUnary_UnknownProcedure(t *testing.T) {
+	procedure := "/mgmt.v1alpha1.ConnectionService/Unknown"
+	called := false
+
+	mux := http.NewServeMux()
+	mux.Handle(procedure, connect.NewUnaryHandler(
+		procedure,
+		func(ctx context.Context, r *connect.Request[mgmtv1alpha1.DeleteConnectionRequest]) (*connect.Response[mgmtv1alpha1.DeleteConnectionResponse], error) {
+			called = true
+			return connect.NewResponse(&mgmtv1alpha1.DeleteConnectionResponse{}), nil
+		},
+		connect.WithInterceptors(NewInterceptor()),
+	))
+	srv := startHTTPServer(t, mux)
+
+	client := connect.NewClient[mgmtv1alpha1.DeleteConnectionRequest, mgmtv1alpha1.DeleteConnectionResponse](srv.Client(), srv.URL+procedure)
+	_, err := client.CallUnary(context.Background(), connect.NewRequest(&mgmtv1alpha1.DeleteConnectionRequest{}))
+	assert.Error(t, err)
+	assert.Equal(t, connect.CodePermissionDenied, connect.CodeOf(err))
+	assert.False(t, called)
+}
+
+func startHTTPServer(tb testing.TB, h http.Handler) *httptest.Server {
+	tb.Helper()
+	srv := httptest.NewUnstartedServer(h)
+	srv.EnableHTTP2 = true
+	srv.Start()
+	tb.Cleanup(srv.Close)
+	return srv
+}
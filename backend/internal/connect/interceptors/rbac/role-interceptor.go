@@ -0,0 +1,155 @@
+package rbac_interceptor
+
+import (
+	"context"
+	"fmt"
+
+	"connectrpc.com/connect"
+	"github.com/jackc/pgx/v5/pgtype"
+	db_queries "github.com/nucleuscloud/neosync/backend/gen/go/db"
+	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
+	"github.com/nucleuscloud/neosync/backend/internal/apikey"
+	"github.com/nucleuscloud/neosync/backend/internal/auth/tokenctx"
+	nucleuserrors "github.com/nucleuscloud/neosync/backend/internal/errors"
+	"github.com/nucleuscloud/neosync/backend/internal/nucleusdb"
+	"github.com/nucleuscloud/neosync/backend/internal/rbac"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// the request field that names the account a procedure acts on
+const accountIdField protoreflect.Name = "account_id"
+
+type queries interface {
+	GetAccountUserAssociation(ctx context.Context, db db_queries.DBTX, arg db_queries.GetAccountUserAssociationParams) (db_queries.NeosyncApiAccountUserAssociation, error)
+	GetUserAssociationByProviderSub(ctx context.Context, db db_queries.DBTX, providerSub string) (db_queries.NeosyncApiUserIdentityProviderAssociation, error)
+}
+
+// Rejects requests made by callers that do not have the role the procedure requires.
+// Account api keys act with the role of the user that created them in the account of the key, so a key can never do more than its creator.
+// Users are verified against the account named by the request. Procedures that look up their account from another resource are verified by the handler.
+// Must be applied after the auth interceptors so that the caller is known.
+type RoleInterceptor struct {
+	q  queries
+	db db_queries.DBTX
+}
+
+func NewRoleInterceptor(q queries, db db_queries.DBTX) connect.Interceptor {
+	return &RoleInterceptor{q: q, db: db}
+}
+
+func (i *RoleInterceptor) WrapUnary(next connect.UnaryFunc) connect.UnaryFunc {
+	return func(ctx context.Context, request connect.AnyRequest) (connect.AnyResponse, error) {
+		if err := i.verifyRole(ctx, request.Spec().Procedure, request.Any()); err != nil {
+			return nil, err
+		}
+		return next(ctx, request)
+	}
+}
+
+func (i *RoleInterceptor) WrapStreamingClient(next connect.StreamingClientFunc) connect.StreamingClientFunc {
+	return func(ctx context.Context, spec connect.Spec) connect.StreamingClientConn {
+		return next(ctx, spec)
+	}
+}
+
+func (i *RoleInterceptor) WrapStreamingHandler(next connect.StreamingHandlerFunc) connect.StreamingHandlerFunc {
+	return func(ctx context.Context, conn connect.StreamingHandlerConn) error {
+		return next(ctx, &roleStreamingHandlerConn{StreamingHandlerConn: conn, ctx: ctx, interceptor: i})
+	}
+}
+
+// The request of a streaming procedure is only known once it has been received
+type roleStreamingHandlerConn struct {
+	connect.StreamingHandlerConn
+	ctx         context.Context
+	interceptor *RoleInterceptor
+}
+
+func (c *roleStreamingHandlerConn) Receive(msg any) error {
+	if err := c.StreamingHandlerConn.Receive(msg); err != nil {
+		return err
+	}
+	return c.interceptor.verifyRole(c.ctx, c.Spec().Procedure, msg)
+}
+
+func (i *RoleInterceptor) verifyRole(ctx context.Context, procedure string, msg any) error {
+	requiredRole, ok := rbac.GetProcedureRole(procedure)
+	if !ok {
+		return nucleuserrors.NewForbidden(fmt.Sprintf("procedure %s has not been assigned a role", procedure))
+	}
+	if requiredRole == mgmtv1alpha1.AccountRole_ACCOUNT_ROLE_UNSPECIFIED {
+		return nil
+	}
+	tokenctxResp, err := tokenctx.GetTokenCtx(ctx)
+	if err != nil {
+		return err
+	}
+
+	if apiKeyData := tokenctxResp.ApiKeyContextData; apiKeyData != nil {
+		// worker api keys are restricted to the procedures the worker calls
+		if apiKeyData.ApiKeyType != apikey.AccountApiKey || apiKeyData.ApiKey == nil {
+			return nil
+		}
+		role, err := i.getAccountUserRole(ctx, apiKeyData.ApiKey.AccountID, apiKeyData.ApiKey.CreatedByID)
+		if err != nil {
+			return err
+		}
+		if !rbac.HasRole(role, requiredRole) {
+			return nucleuserrors.NewForbidden(fmt.Sprintf("api key must have the %s role in the account", rbac.GetRoleName(requiredRole)))
+		}
+		return nil
+	}
+
+	if jwtData := tokenctxResp.JwtContextData; jwtData != nil {
+		accountId := getAccountId(msg)
+		if accountId == "" {
+			return nil
+		}
+		accountUuid, err := nucleusdb.ToUuid(accountId)
+		if err != nil {
+			return nucleuserrors.NewBadRequest("account id is not a valid uuid")
+		}
+		user, err := i.q.GetUserAssociationByProviderSub(ctx, i.db, jwtData.AuthUserId)
+		if err != nil && !nucleusdb.IsNoRows(err) {
+			return nucleuserrors.New(err)
+		} else if err != nil && nucleusdb.IsNoRows(err) {
+			return nucleuserrors.NewNotFound("unable to find user")
+		}
+		role, err := i.getAccountUserRole(ctx, accountUuid, user.UserID)
+		if err != nil {
+			return err
+		}
+		if !rbac.HasRole(role, requiredRole) {
+			return nucleuserrors.NewForbidden(fmt.Sprintf("user must have the %s role in the account", rbac.GetRoleName(requiredRole)))
+		}
+	}
+	return nil
+}
+
+// Returns the unspecified role if the user is not a member of the account
+func (i *RoleInterceptor) getAccountUserRole(ctx context.Context, accountId, userId pgtype.UUID) (mgmtv1alpha1.AccountRole, error) {
+	association, err := i.q.GetAccountUserAssociation(ctx, i.db, db_queries.GetAccountUserAssociationParams{
+		AccountId: accountId,
+		UserId:    userId,
+	})
+	if err != nil && !nucleusdb.IsNoRows(err) {
+		return mgmtv1alpha1.AccountRole_ACCOUNT_ROLE_UNSPECIFIED, nucleuserrors.New(err)
+	} else if err != nil && nucleusdb.IsNoRows(err) {
+		return mgmtv1alpha1.AccountRole_ACCOUNT_ROLE_UNSPECIFIED, nil
+	}
+	return mgmtv1alpha1.AccountRole(association.Role), nil
+}
+
+func getAccountId(msg any) string {
+	protoMsg, ok := msg.(proto.Message)
+	if !ok || protoMsg == nil {
+		return ""
+	}
+	reflectMsg := protoMsg.ProtoReflect()
+	fd := reflectMsg.Descriptor().Fields().ByName(accountIdField)
+	if fd == nil || fd.Kind() != protoreflect.StringKind || fd.IsList() || !reflectMsg.Has(fd) {
+		return ""
+	}
+	return reflectMsg.Get(fd).String()
+}
@@ -0,0 +1,110 @@
+package rbac_interceptor
+
+import (
+	"context"
+	"testing"
+
+	"connectrpc.com/connect"
+	"github.com/jackc/pgx/v5"
+	db_queries "github.com/nucleuscloud/neosync/backend/gen/go/db"
+	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
+	"github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1/mgmtv1alpha1connect"
+	"github.com/nucleuscloud/neosync/backend/internal/apikey"
+	auth_apikey "github.com/nucleuscloud/neosync/backend/internal/auth/apikey"
+	auth_jwt "github.com/nucleuscloud/neosync/backend/internal/auth/jwt"
+	"github.com/nucleuscloud/neosync/backend/internal/nucleusdb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+const (
+	mockAccountId    = "5629813e-1a35-4874-922c-9827d85f0378"
+	mockUserId       = "d5e29f1f-b920-458c-8b86-f3a180e06d98"
+	mockCreatorId    = "0fd9e2a1-7a4c-4b6b-bb0c-4b1a2b4c1c0e"
+	mockAuthProvider = "test-provider"
+)
+
+func Test_RoleInterceptor_ApiKey_CreatorRole(t *testing.T) {
+	querierMock := db_queries.NewMockQuerier(t)
+	interceptor := &RoleInterceptor{q: querierMock, db: db_queries.NewMockDBTX(t)}
+	ctx := getApiKeyCtx()
+	mockAccountUserRole(querierMock, mockCreatorId, mgmtv1alpha1.AccountRole_ACCOUNT_ROLE_ADMIN)
+
+	assert.NoError(t, interceptor.verifyRole(ctx, mgmtv1alpha1connect.JobServiceCreateJobProcedure, &mgmtv1alpha1.CreateJobRequest{}))
+
+	// owner procedures can not be called with a key that was created by an admin
+	for _, procedure := range []string{
+		mgmtv1alpha1connect.UserAccountServiceGetAccountTransformerKeyProcedure,
+		mgmtv1alpha1connect.UserAccountServiceSetAccountTemporalConfigProcedure,
+	} {
+		err := interceptor.verifyRole(ctx, procedure, &mgmtv1alpha1.GetAccountTransformerKeyRequest{})
+		assert.Error(t, err)
+		assert.Equal(t, connect.CodePermissionDenied, connect.CodeOf(err))
+	}
+}
+
+func Test_RoleInterceptor_ApiKey_CreatorNotInAccount(t *testing.T) {
+	querierMock := db_queries.NewMockQuerier(t)
+	interceptor := &RoleInterceptor{q: querierMock, db: db_queries.NewMockDBTX(t)}
+	querierMock.On("GetAccountUserAssociation", mock.Anything, mock.Anything, mock.Anything).
+		Return(db_queries.NeosyncApiAccountUserAssociation{}, pgx.ErrNoRows)
+
+	err := interceptor.verifyRole(getApiKeyCtx(), mgmtv1alpha1connect.JobServiceGetJobProcedure, &mgmtv1alpha1.GetJobRequest{})
+	assert.Error(t, err)
+	assert.Equal(t, connect.CodePermissionDenied, connect.CodeOf(err))
+}
+
+func Test_RoleInterceptor_WorkerApiKey(t *testing.T) {
+	interceptor := &RoleInterceptor{q: db_queries.NewMockQuerier(t), db: db_queries.NewMockDBTX(t)}
+	ctx := context.WithValue(context.Background(), auth_apikey.TokenContextKey{}, &auth_apikey.TokenContextData{ApiKeyType: apikey.WorkerApiKey})
+
+	assert.NoError(t, interceptor.verifyRole(ctx, mgmtv1alpha1connect.UserAccountServiceGetAccountTransformerKeyProcedure, &mgmtv1alpha1.GetAccountTransformerKeyRequest{}))
+}
+
+func Test_RoleInterceptor_User(t *testing.T) {
+	querierMock := db_queries.NewMockQuerier(t)
+	interceptor := &RoleInterceptor{q: querierMock, db: db_queries.NewMockDBTX(t)}
+	ctx := context.WithValue(context.Background(), auth_jwt.TokenContextKey{}, &auth_jwt.TokenContextData{AuthUserId: mockAuthProvider})
+	userUuid, _ := nucleusdb.ToUuid(mockUserId)
+	querierMock.On("GetUserAssociationByProviderSub", mock.Anything, mock.Anything, mockAuthProvider).
+		Return(db_queries.NeosyncApiUserIdentityProviderAssociation{UserID: userUuid, ProviderSub: mockAuthProvider}, nil)
+	mockAccountUserRole(querierMock, mockUserId, mgmtv1alpha1.AccountRole_ACCOUNT_ROLE_VIEWER)
+
+	assert.NoError(t, interceptor.verifyRole(ctx, mgmtv1alpha1connect.JobServiceGetJobsProcedure, &mgmtv1alpha1.GetJobsRequest{AccountId: mockAccountId}))
+	err := interceptor.verifyRole(ctx, mgmtv1alpha1connect.UserAccountServiceInviteUserToTeamAccountProcedure, &mgmtv1alpha1.InviteUserToTeamAccountRequest{AccountId: mockAccountId})
+	assert.Error(t, err)
+	assert.Equal(t, connect.CodePermissionDenied, connect.CodeOf(err))
+
+	// the account of requests that do not name one is verified by the handler
+	assert.NoError(t, interceptor.verifyRole(ctx, mgmtv1alpha1connect.JobServiceDeleteJobProcedure, &mgmtv1alpha1.DeleteJobRequest{Id: mockUserId}))
+}
+
+func Test_RoleInterceptor_NoRoleRequired(t *testing.T) {
+	interceptor := &RoleInterceptor{q: db_queries.NewMockQuerier(t), db: db_queries.NewMockDBTX(t)}
+
+	assert.NoError(t, interceptor.verifyRole(context.Background(), mgmtv1alpha1connect.UserAccountServiceGetUserProcedure, &mgmtv1alpha1.GetUserRequest{}))
+	assert.Error(t, interceptor.verifyRole(context.Background(), "/mgmt.v1alpha1.JobService/Unknown", &mgmtv1alpha1.GetJobRequest{}))
+}
+
+func getApiKeyCtx() context.Context {
+	accountUuid, _ := nucleusdb.ToUuid(mockAccountId)
+	userUuid, _ := nucleusdb.ToUuid(mockUserId)
+	creatorUuid, _ := nucleusdb.ToUuid(mockCreatorId)
+	return context.WithValue(context.Background(), auth_apikey.TokenContextKey{}, &auth_apikey.TokenContextData{
+		ApiKeyType: apikey.AccountApiKey,
+		ApiKey: &db_queries.NeosyncApiAccountApiKey{
+			AccountID:   accountUuid,
+			UserID:      userUuid,
+			CreatedByID: creatorUuid,
+		},
+	})
+}
+
+func mockAccountUserRole(querierMock *db_queries.MockQuerier, userId string, role mgmtv1alpha1.AccountRole) {
+	accountUuid, _ := nucleusdb.ToUuid(mockAccountId)
+	userUuid, _ := nucleusdb.ToUuid(userId)
+	querierMock.On("GetAccountUserAssociation", mock.Anything, mock.Anything, db_queries.GetAccountUserAssociationParams{
+		AccountId: accountUuid,
+		UserId:    userUuid,
+	}).Return(db_queries.NeosyncApiAccountUserAssociation{AccountID: accountUuid, UserID: userUuid, Role: int16(role)}, nil)
+}
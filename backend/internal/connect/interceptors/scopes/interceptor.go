@@ -0,0 +1,50 @@
+package scopes_interceptor
+
+import (
+	"context"
+
+	"connectrpc.com/connect"
+	auth_apikey "github.com/nucleuscloud/neosync/backend/internal/auth/apikey"
+)
+
+// Rejects requests made with a scoped account api key that act on jobs or connections that the key is not allowed to access.
+// Must be applied after the auth interceptors so that the api key is known.
+type Interceptor struct{}
+
+func NewInterceptor() connect.Interceptor {
+	return &Interceptor{}
+}
+
+func (i *Interceptor) WrapUnary(next connect.UnaryFunc) connect.UnaryFunc {
+	return func(ctx context.Context, request connect.AnyRequest) (connect.AnyResponse, error) {
+		if err := auth_apikey.VerifyRequestScopes(ctx, request.Spec().Procedure, request.Any()); err != nil {
+			return nil, err
+		}
+		return next(ctx, request)
+	}
+}
+
+func (i *Interceptor) WrapStreamingClient(next connect.StreamingClientFunc) connect.StreamingClientFunc {
+	return func(ctx context.Context, spec connect.Spec) connect.StreamingClientConn {
+		return next(ctx, spec)
+	}
+}
+
+func (i *Interceptor) WrapStreamingHandler(next connect.StreamingHandlerFunc) connect.StreamingHandlerFunc {
+	return func(ctx context.Context, conn connect.StreamingHandlerConn) error {
+		return next(ctx, &scopedStreamingHandlerConn{StreamingHandlerConn: conn, ctx: ctx})
+	}
+}
+
+// The request of a streaming procedure is only known once it has been received
+type scopedStreamingHandlerConn struct {
+	connect.StreamingHandlerConn
+	ctx context.Context
+}
+
+func (c *scopedStreamingHandlerConn) Receive(msg any) error {
+	if err := c.StreamingHandlerConn.Receive(msg); err != nil {
+		return err
+	}
+	return auth_apikey.VerifyRequestScopes(c.ctx, c.Spec().Procedure, msg)
+}
@@ -0,0 +1,57 @@
+package scopes_interceptor
+
+import (
+	"context"
+	"testing"
+
+	"connectrpc.com/connect"
+	db_queries "github.com/nucleuscloud/neosync/backend/gen/go/db"
+	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
+	"github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1/mgmtv1alpha1connect"
+	"github.com/nucleuscloud/neosync/backend/internal/apikey"
+	auth_apikey "github.com/nucleuscloud/neosync/backend/internal/auth/apikey"
+	pg_models "github.com/nucleuscloud/neosync/backend/sql/postgresql/models"
+	"github.com/stretchr/testify/assert"
+)
+
+const (
+	mockJobId      = "6d3d3c1e-4d7c-4a52-9d0e-0a5b7f4e1f3a"
+	mockOtherJobId = "9f2b7d3e-58a4-4c8e-b3f1-2a6c7e0d9b14"
+)
+
+func Test_Interceptor_WrapUnary(t *testing.T) {
+	ctx := context.WithValue(context.Background(), auth_apikey.TokenContextKey{}, &auth_apikey.TokenContextData{
+		ApiKey: &db_queries.NeosyncApiAccountApiKey{
+			Scopes: &pg_models.AccountApiKeyScopes{AllowedJobIds: []string{mockJobId}},
+		},
+		ApiKeyType: apikey.AccountApiKey,
+	})
+
+	called := false
+	handler := NewInterceptor().WrapUnary(func(ctx context.Context, ar connect.AnyRequest) (connect.AnyResponse, error) {
+		called = true
+		return connect.NewResponse(&mgmtv1alpha1.CreateJobRunResponse{}), nil
+	})
+
+	_, err := handler(ctx, newRequest(&mgmtv1alpha1.CreateJobRunRequest{JobId: mockOtherJobId}))
+	assert.Error(t, err)
+	assert.Equal(t, connect.CodePermissionDenied, connect.CodeOf(err))
+	assert.False(t, called)
+
+	_, err = handler(ctx, newRequest(&mgmtv1alpha1.CreateJobRunRequest{JobId: mockJobId}))
+	assert.NoError(t, err)
+	assert.True(t, called)
+}
+
+// the spec of a request is only set by connect when it is sent or received, so it is set manually here
+type mockRequest struct {
+	*connect.Request[mgmtv1alpha1.CreateJobRunRequest]
+}
+
+func (r *mockRequest) Spec() connect.Spec {
+	return connect.Spec{Procedure: mgmtv1alpha1connect.JobServiceCreateJobRunProcedure}
+}
+
+func newRequest(msg *mgmtv1alpha1.CreateJobRunRequest) connect.AnyRequest {
+	return &mockRequest{Request: connect.NewRequest(msg)}
+}
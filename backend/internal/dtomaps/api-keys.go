@@ -11,6 +11,14 @@ func ToAccountApiKeyDto(
 	input *db_queries.NeosyncApiAccountApiKey,
 	cleartextKeyValue *string,
 ) *mgmtv1alpha1.AccountApiKey {
+	var scopes *mgmtv1alpha1.AccountApiKeyScopes
+	if input.Scopes != nil {
+		scopes = input.Scopes.ToDto()
+	}
+	var lastUsedAt *timestamppb.Timestamp
+	if input.LastUsedAt.Valid {
+		lastUsedAt = timestamppb.New(input.LastUsedAt.Time)
+	}
 	return &mgmtv1alpha1.AccountApiKey{
 		Id:          nucleusdb.UUIDString(input.ID),
 		Name:        input.KeyName,
@@ -22,5 +30,7 @@ func ToAccountApiKeyDto(
 		KeyValue:    cleartextKeyValue,
 		UserId:      nucleusdb.UUIDString(input.UserID),
 		ExpiresAt:   timestamppb.New(input.ExpiresAt.Time),
+		Scopes:      scopes,
+		LastUsedAt:  lastUsedAt,
 	}
 }
@@ -0,0 +1,30 @@
+package dtomaps
+
+import (
+	db_queries "github.com/nucleuscloud/neosync/backend/gen/go/db"
+	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
+	"github.com/nucleuscloud/neosync/backend/internal/nucleusdb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func ToAuditEventDto(
+	input *db_queries.NeosyncApiAuditEvent,
+) *mgmtv1alpha1.AuditEvent {
+	actor := &mgmtv1alpha1.AuditActor{
+		Type: mgmtv1alpha1.AuditActorType(input.ActorType),
+	}
+	if input.ActorID.Valid {
+		actorId := nucleusdb.UUIDString(input.ActorID)
+		actor.Id = &actorId
+	}
+	return &mgmtv1alpha1.AuditEvent{
+		Id:          nucleusdb.UUIDString(input.ID),
+		AccountId:   nucleusdb.UUIDString(input.AccountID),
+		Actor:       actor,
+		Procedure:   input.Procedure,
+		ResourceId:  nucleusdb.ToNullableString(input.ResourceID),
+		RequestDiff: string(input.RequestDiff),
+		StatusCode:  input.StatusCode,
+		CreatedAt:   timestamppb.New(input.CreatedAt.Time),
+	}
+}
@@ -4,7 +4,9 @@ import (
 	"fmt"
 	"log/slog"
 
+	db_queries "github.com/nucleuscloud/neosync/backend/gen/go/db"
 	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
+	"github.com/nucleuscloud/neosync/backend/internal/nucleusdb"
 	commonpb "go.temporal.io/api/common/v1"
 	"go.temporal.io/api/enums/v1"
 	temporalfailure "go.temporal.io/api/failure/v1"
@@ -15,6 +17,11 @@ import (
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
+const (
+	// The memo field of a job run that holds the id of the job version that the run was started with
+	JobVersionIdMemoKey = "jobVersionId"
+)
+
 // including pending activities
 func ToJobRunDto(
 	logger *slog.Logger,
@@ -32,15 +39,31 @@ func ToJobRunDtoFromWorkflowExecutionInfo(workflow *workflowpb.WorkflowExecution
 		completedTime = workflow.GetCloseTime()
 	}
 	return &mgmtv1alpha1.JobRun{
-		Id:          workflow.Execution.WorkflowId,
-		JobId:       GetJobIdFromWorkflow(logger, workflow.GetSearchAttributes()),
-		Name:        workflow.Type.Name,
-		Status:      toWorfklowStatus(workflow.Status),
-		StartedAt:   workflow.StartTime,
-		CompletedAt: completedTime,
+		Id:           workflow.Execution.WorkflowId,
+		JobId:        GetJobIdFromWorkflow(logger, workflow.GetSearchAttributes()),
+		Name:         workflow.Type.Name,
+		Status:       toWorfklowStatus(workflow.Status),
+		StartedAt:    workflow.StartTime,
+		CompletedAt:  completedTime,
+		JobVersionId: getJobVersionIdFromMemo(logger, workflow.GetMemo()),
 	}
 }
 
+// returns nil for runs that were started before job versions were recorded
+func getJobVersionIdFromMemo(logger *slog.Logger, memo *commonpb.Memo) *string {
+	payload, ok := memo.GetFields()[JobVersionIdMemoKey]
+	if !ok {
+		return nil
+	}
+	var jobVersionId string
+	err := converter.GetDefaultDataConverter().FromPayload(payload, &jobVersionId)
+	if err != nil {
+		logger.Error(fmt.Errorf("unable to get job version id from workflow memo: %w", err).Error())
+		return nil
+	}
+	return &jobVersionId
+}
+
 func GetJobIdFromWorkflow(logger *slog.Logger, searchAttributes *commonpb.SearchAttributes) string {
 	scheduledByIDPayload := searchAttributes.IndexedFields["TemporalScheduledById"]
 	var scheduledByID string
@@ -118,3 +141,54 @@ func toWorfklowStatus(input enums.WorkflowExecutionStatus) mgmtv1alpha1.JobRunSt
 		return mgmtv1alpha1.JobRunStatus_JOB_RUN_STATUS_UNSPECIFIED
 	}
 }
+
+func ToJobRunWatermarkDto(input *db_queries.NeosyncApiJobRunWatermark) *mgmtv1alpha1.JobRunWatermark {
+	return &mgmtv1alpha1.JobRunWatermark{
+		Schema:     input.SchemaName,
+		Table:      input.TableName,
+		Column:     input.ColumnName,
+		StartValue: nucleusdb.ToNullableString(input.StartValue),
+		EndValue:   input.EndValue,
+	}
+}
+
+func ToJobWatermarkDto(input *db_queries.NeosyncApiJobWatermark) *mgmtv1alpha1.JobWatermark {
+	return &mgmtv1alpha1.JobWatermark{
+		Schema:    input.SchemaName,
+		Table:     input.TableName,
+		Column:    input.ColumnName,
+		Value:     input.WatermarkValue,
+		JobRunId:  input.JobRunID,
+		UpdatedAt: timestamppb.New(input.UpdatedAt.Time),
+	}
+}
+
+func ToJobRunValidationReportDto(input *db_queries.NeosyncApiJobRunValidationReport) *mgmtv1alpha1.JobRunValidationReport {
+	tables := []*mgmtv1alpha1.JobRunTableValidation{}
+	if input.Report != nil {
+		tables = input.Report.ToDto()
+	}
+	return &mgmtv1alpha1.JobRunValidationReport{
+		JobId:     nucleusdb.UUIDString(input.JobID),
+		JobRunId:  input.JobRunID,
+		Passed:    input.Passed,
+		Tables:    tables,
+		CreatedAt: timestamppb.New(input.CreatedAt.Time),
+	}
+}
+
+func ToJobRunSchemaDriftReportDto(input *db_queries.NeosyncApiJobRunSchemaDriftReport) *mgmtv1alpha1.JobRunSchemaDriftReport {
+	changes := []*mgmtv1alpha1.SchemaDriftChange{}
+	columns := []*mgmtv1alpha1.SchemaDriftColumn{}
+	if input.Report != nil {
+		changes, columns = input.Report.ToDto()
+	}
+	return &mgmtv1alpha1.JobRunSchemaDriftReport{
+		JobId:     nucleusdb.UUIDString(input.JobID),
+		JobRunId:  input.JobRunID,
+		Halted:    input.Halted,
+		Changes:   changes,
+		Columns:   columns,
+		CreatedAt: timestamppb.New(input.CreatedAt.Time),
+	}
+}
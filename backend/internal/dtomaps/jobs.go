@@ -33,6 +33,11 @@ func ToJobDto(
 		syncOptions = inputJob.SyncOptions.ToDto()
 	}
 
+	triggers := make([]*mgmtv1alpha1.JobTrigger, 0, len(inputJob.Triggers))
+	for _, trigger := range inputJob.Triggers {
+		triggers = append(triggers, trigger.ToDto())
+	}
+
 	return &mgmtv1alpha1.Job{
 		Id:              nucleusdb.UUIDString(inputJob.ID),
 		Name:            inputJob.Name,
@@ -49,6 +54,7 @@ func ToJobDto(
 		AccountId:       nucleusdb.UUIDString(inputJob.AccountID),
 		SyncOptions:     syncOptions,
 		WorkflowOptions: workflowOptions,
+		Triggers:        triggers,
 	}
 }
 
@@ -60,6 +66,28 @@ func toDestinationDto(input *db_queries.NeosyncApiJobDestinationConnectionAssoci
 	}
 }
 
+func ToJobVersionDto(input *db_queries.NeosyncApiJobVersion) *mgmtv1alpha1.JobVersion {
+	mappings := make([]*mgmtv1alpha1.JobMapping, 0, len(input.Mappings))
+	for _, mapping := range input.Mappings {
+		mappings = append(mappings, mapping.ToDto())
+	}
+	destinations := make([]*mgmtv1alpha1.CreateJobDestination, 0, len(input.Destinations))
+	for _, dest := range input.Destinations {
+		destinations = append(destinations, dest.ToDto())
+	}
+	return &mgmtv1alpha1.JobVersion{
+		Id:              nucleusdb.UUIDString(input.ID),
+		JobId:           nucleusdb.UUIDString(input.JobID),
+		Version:         input.Version,
+		CronSchedule:    nucleusdb.ToNullableString(input.CronSchedule),
+		Source:          &mgmtv1alpha1.JobSource{Options: input.ConnectionOptions.ToDto()},
+		Mappings:        mappings,
+		Destinations:    destinations,
+		CreatedByUserId: nucleusdb.UUIDString(input.CreatedByID),
+		CreatedAt:       timestamppb.New(input.CreatedAt.Time),
+	}
+}
+
 func ToJobStatus(inputSchedule *temporalclient.ScheduleDescription) mgmtv1alpha1.JobStatus {
 	if inputSchedule.Schedule.State.Paused {
 		return mgmtv1alpha1.JobStatus_JOB_STATUS_PAUSED
@@ -0,0 +1,52 @@
+package dtomaps
+
+import (
+	db_queries "github.com/nucleuscloud/neosync/backend/gen/go/db"
+	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
+	"github.com/nucleuscloud/neosync/backend/internal/nucleusdb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func ToWebhookDto(
+	input *db_queries.NeosyncApiWebhook,
+	secret *string,
+) *mgmtv1alpha1.Webhook {
+	eventTypes := make([]mgmtv1alpha1.WebhookEventType, len(input.EventTypes))
+	for idx, eventType := range input.EventTypes {
+		eventTypes[idx] = mgmtv1alpha1.WebhookEventType(eventType)
+	}
+	return &mgmtv1alpha1.Webhook{
+		Id:          nucleusdb.UUIDString(input.ID),
+		AccountId:   nucleusdb.UUIDString(input.AccountID),
+		Name:        input.Name,
+		Url:         input.Url,
+		EventTypes:  eventTypes,
+		Enabled:     input.Enabled,
+		CreatedById: nucleusdb.UUIDString(input.CreatedByID),
+		CreatedAt:   timestamppb.New(input.CreatedAt.Time),
+		UpdatedById: nucleusdb.UUIDString(input.UpdatedByID),
+		UpdatedAt:   timestamppb.New(input.UpdatedAt.Time),
+		Secret:      secret,
+	}
+}
+
+func ToWebhookDeliveryDto(
+	input *db_queries.NeosyncApiWebhookDelivery,
+) *mgmtv1alpha1.WebhookDelivery {
+	var statusCode *int32
+	if input.StatusCode.Valid {
+		statusCode = &input.StatusCode.Int32
+	}
+	return &mgmtv1alpha1.WebhookDelivery{
+		Id:         nucleusdb.UUIDString(input.ID),
+		WebhookId:  nucleusdb.UUIDString(input.WebhookID),
+		EventId:    nucleusdb.UUIDString(input.EventID),
+		EventType:  mgmtv1alpha1.WebhookEventType(input.EventType),
+		Payload:    string(input.Payload),
+		Attempt:    uint32(input.Attempt),
+		StatusCode: statusCode,
+		Error:      nucleusdb.ToNullableString(input.Error),
+		Success:    input.Success,
+		CreatedAt:  timestamppb.New(input.CreatedAt.Time),
+	}
+}
@@ -0,0 +1,169 @@
+package encryption
+
+import (
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"fmt"
+
+	pg_models "github.com/nucleuscloud/neosync/backend/sql/postgresql/models"
+)
+
+const (
+	dataKeySize = 32
+)
+
+// Envelope encrypts the secret values of connection configs.
+// Every config is encrypted with its own data key, which is stored alongside the config after being wrapped by the key provider's current master key.
+type Envelope struct {
+	provider KeyProvider
+}
+
+func NewEnvelope(provider KeyProvider) *Envelope {
+	return &Envelope{provider: provider}
+}
+
+// Encrypts the secret values of the connection config in place with a new data key.
+// Does nothing if the config has already been encrypted.
+func (e *Envelope) EncryptConnectionConfig(ctx context.Context, cc *pg_models.ConnectionConfig) error {
+	if cc == nil || cc.EncryptedDataKey != nil {
+		return nil
+	}
+	dataKey := make([]byte, dataKeySize)
+	if _, err := rand.Read(dataKey); err != nil {
+		return fmt.Errorf("unable to generate data key: %w", err)
+	}
+	keyId := e.provider.CurrentKeyId()
+	wrappedKey, err := e.provider.WrapKey(ctx, keyId, dataKey)
+	if err != nil {
+		return fmt.Errorf("unable to wrap data key: %w", err)
+	}
+
+	for _, value := range cc.SecretValues() {
+		ciphertext, err := seal(dataKey, []byte(*value))
+		if err != nil {
+			return err
+		}
+		*value = base64.StdEncoding.EncodeToString(ciphertext)
+	}
+	cc.EncryptedDataKey = &pg_models.EncryptedDataKey{
+		KeyId: keyId,
+		Value: base64.StdEncoding.EncodeToString(wrappedKey),
+	}
+	return nil
+}
+
+// Decrypts the secret values of the connection config in place.
+// Does nothing if the config has not been encrypted.
+func (e *Envelope) DecryptConnectionConfig(ctx context.Context, cc *pg_models.ConnectionConfig) error {
+	if cc == nil || cc.EncryptedDataKey == nil {
+		return nil
+	}
+	dataKey, err := e.unwrapDataKey(ctx, cc.EncryptedDataKey)
+	if err != nil {
+		return err
+	}
+
+	for _, value := range cc.SecretValues() {
+		ciphertext, err := base64.StdEncoding.DecodeString(*value)
+		if err != nil {
+			return fmt.Errorf("unable to decode encrypted value: %w", err)
+		}
+		plaintext, err := open(dataKey, ciphertext)
+		if err != nil {
+			return err
+		}
+		*value = string(plaintext)
+	}
+	cc.EncryptedDataKey = nil
+	return nil
+}
+
+// Brings the connection config in line with the key provider's current master key.
+// Plaintext configs are encrypted and data keys that were wrapped with an older master key are re-wrapped with the current one.
+// If rotateDataKey is set, the secret values are re-encrypted with a new data key.
+// Returns true if the config was modified.
+func (e *Envelope) RotateConnectionConfig(ctx context.Context, cc *pg_models.ConnectionConfig, rotateDataKey bool) (bool, error) {
+	if cc == nil {
+		return false, nil
+	}
+	if cc.EncryptedDataKey == nil || rotateDataKey {
+		if err := e.DecryptConnectionConfig(ctx, cc); err != nil {
+			return false, err
+		}
+		if err := e.EncryptConnectionConfig(ctx, cc); err != nil {
+			return false, err
+		}
+		return true, nil
+	}
+
+	currentKeyId := e.provider.CurrentKeyId()
+	if cc.EncryptedDataKey.KeyId == currentKeyId {
+		return false, nil
+	}
+	dataKey, err := e.unwrapDataKey(ctx, cc.EncryptedDataKey)
+	if err != nil {
+		return false, err
+	}
+	wrappedKey, err := e.provider.WrapKey(ctx, currentKeyId, dataKey)
+	if err != nil {
+		return false, fmt.Errorf("unable to wrap data key: %w", err)
+	}
+	cc.EncryptedDataKey = &pg_models.EncryptedDataKey{
+		KeyId: currentKeyId,
+		Value: base64.StdEncoding.EncodeToString(wrappedKey),
+	}
+	return true, nil
+}
+
+func (e *Envelope) unwrapDataKey(ctx context.Context, encryptedKey *pg_models.EncryptedDataKey) ([]byte, error) {
+	wrappedKey, err := base64.StdEncoding.DecodeString(encryptedKey.Value)
+	if err != nil {
+		return nil, fmt.Errorf("unable to decode data key: %w", err)
+	}
+	dataKey, err := e.provider.UnwrapKey(ctx, encryptedKey.KeyId, wrappedKey)
+	if err != nil {
+		return nil, fmt.Errorf("unable to unwrap data key: %w", err)
+	}
+	return dataKey, nil
+}
+
+// Encrypts the plaintext with AES-256-GCM, returning the nonce followed by the ciphertext
+func seal(key, plaintext []byte) ([]byte, error) {
+	aead, err := newGcm(key)
+	if err != nil {
+		return nil, err
+	}
+	nonce := make([]byte, aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, fmt.Errorf("unable to generate nonce: %w", err)
+	}
+	return aead.Seal(nonce, nonce, plaintext, nil), nil
+}
+
+func open(key, data []byte) ([]byte, error) {
+	aead, err := newGcm(key)
+	if err != nil {
+		return nil, err
+	}
+	if len(data) < aead.NonceSize() {
+		return nil, errors.New("encrypted value is too short")
+	}
+	nonce, ciphertext := data[:aead.NonceSize()], data[aead.NonceSize():]
+	plaintext, err := aead.Open(nil, nonce, ciphertext, nil)
+	if err != nil {
+		return nil, fmt.Errorf("unable to decrypt value: %w", err)
+	}
+	return plaintext, nil
+}
+
+func newGcm(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
@@ -0,0 +1,137 @@
+package encryption
+
+import (
+	"context"
+	"testing"
+
+	pg_models "github.com/nucleuscloud/neosync/backend/sql/postgresql/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_Envelope_EncryptConnectionConfig(t *testing.T) {
+	provider, err := NewLocalKeyProvider(newLocalKeyFile(t, "key-1"))
+	require.NoError(t, err)
+	envelope := NewEnvelope(provider)
+	ctx := context.Background()
+
+	cc := newPgConnectionConfig()
+	require.NoError(t, envelope.EncryptConnectionConfig(ctx, cc))
+	require.NotNil(t, cc.EncryptedDataKey)
+	assert.Equal(t, "key-1", cc.EncryptedDataKey.KeyId)
+	assert.NotEqual(t, "secret-pass", cc.PgConfig.Connection.Pass)
+	assert.NotEqual(t, "private-key", cc.PgConfig.SSHTunnel.SSHAuthentication.SSHPrivateKey.Value)
+	assert.NotEqual(t, "key-pass", *cc.PgConfig.SSHTunnel.SSHAuthentication.SSHPrivateKey.Passphrase)
+	assert.Equal(t, "postgres", cc.PgConfig.Connection.User, "non secret values must not be encrypted")
+	assert.Equal(t, "tunnel-user", cc.PgConfig.SSHTunnel.User)
+
+	encryptedPass := cc.PgConfig.Connection.Pass
+	require.NoError(t, envelope.EncryptConnectionConfig(ctx, cc))
+	assert.Equal(t, encryptedPass, cc.PgConfig.Connection.Pass, "encrypting twice must be a no-op")
+
+	require.NoError(t, envelope.DecryptConnectionConfig(ctx, cc))
+	assert.Equal(t, newPgConnectionConfig(), cc)
+}
+
+func Test_Envelope_EncryptConnectionConfig_AwsS3(t *testing.T) {
+	provider, err := NewLocalKeyProvider(newLocalKeyFile(t, "key-1"))
+	require.NoError(t, err)
+	envelope := NewEnvelope(provider)
+	ctx := context.Background()
+
+	accessKeyId := "access-key-id"
+	secretAccessKey := "secret-access-key"
+	cc := &pg_models.ConnectionConfig{
+		AwsS3Config: &pg_models.AwsS3ConnectionConfig{
+			Bucket:      "bucket",
+			Credentials: &pg_models.AwsS3Credentials{AccessKeyId: &accessKeyId, SecretAccessKey: &secretAccessKey},
+		},
+	}
+	require.NoError(t, envelope.EncryptConnectionConfig(ctx, cc))
+	assert.Equal(t, "access-key-id", *cc.AwsS3Config.Credentials.AccessKeyId)
+	assert.NotEqual(t, "secret-access-key", *cc.AwsS3Config.Credentials.SecretAccessKey)
+	assert.Nil(t, cc.AwsS3Config.Credentials.SessionToken)
+
+	require.NoError(t, envelope.DecryptConnectionConfig(ctx, cc))
+	assert.Equal(t, "secret-access-key", *cc.AwsS3Config.Credentials.SecretAccessKey)
+	assert.Nil(t, cc.EncryptedDataKey)
+}
+
+func Test_Envelope_DecryptConnectionConfig_Tampered(t *testing.T) {
+	provider, err := NewLocalKeyProvider(newLocalKeyFile(t, "key-1"))
+	require.NoError(t, err)
+	envelope := NewEnvelope(provider)
+	ctx := context.Background()
+
+	cc := newPgConnectionConfig()
+	require.NoError(t, envelope.EncryptConnectionConfig(ctx, cc))
+	other := newPgConnectionConfig()
+	require.NoError(t, envelope.EncryptConnectionConfig(ctx, other))
+
+	cc.PgConfig.Connection.Pass = other.PgConfig.Connection.Pass
+	assert.Error(t, envelope.DecryptConnectionConfig(ctx, cc), "values encrypted with another data key must not decrypt")
+}
+
+func Test_Envelope_RotateConnectionConfig(t *testing.T) {
+	keyFile := newLocalKeyFile(t, "key-1")
+	oldProvider, err := NewLocalKeyProvider(keyFile)
+	require.NoError(t, err)
+	ctx := context.Background()
+
+	cc := newPgConnectionConfig()
+	changed, err := NewEnvelope(oldProvider).RotateConnectionConfig(ctx, cc, false)
+	require.NoError(t, err)
+	assert.True(t, changed, "plaintext configs must be encrypted")
+	assert.Equal(t, "key-1", cc.EncryptedDataKey.KeyId)
+
+	changed, err = NewEnvelope(oldProvider).RotateConnectionConfig(ctx, cc, false)
+	require.NoError(t, err)
+	assert.False(t, changed, "configs wrapped with the current key must be left as is")
+
+	keyFile.Keys["key-2"] = newEncodedKey(t)
+	keyFile.CurrentKeyId = "key-2"
+	newProvider, err := NewLocalKeyProvider(keyFile)
+	require.NoError(t, err)
+	newEnvelope := NewEnvelope(newProvider)
+
+	encryptedPass := cc.PgConfig.Connection.Pass
+	changed, err = newEnvelope.RotateConnectionConfig(ctx, cc, false)
+	require.NoError(t, err)
+	assert.True(t, changed)
+	assert.Equal(t, "key-2", cc.EncryptedDataKey.KeyId)
+	assert.Equal(t, encryptedPass, cc.PgConfig.Connection.Pass, "re-wrapping must not re-encrypt the values")
+
+	changed, err = newEnvelope.RotateConnectionConfig(ctx, cc, true)
+	require.NoError(t, err)
+	assert.True(t, changed)
+	assert.NotEqual(t, encryptedPass, cc.PgConfig.Connection.Pass, "rotating the data key must re-encrypt the values")
+
+	delete(keyFile.Keys, "key-1")
+	currentOnlyProvider, err := NewLocalKeyProvider(keyFile)
+	require.NoError(t, err)
+	require.NoError(t, NewEnvelope(currentOnlyProvider).DecryptConnectionConfig(ctx, cc))
+	assert.Equal(t, newPgConnectionConfig(), cc)
+}
+
+func newPgConnectionConfig() *pg_models.ConnectionConfig {
+	keyPassphrase := "key-pass"
+	return &pg_models.ConnectionConfig{
+		PgConfig: &pg_models.PostgresConnectionConfig{
+			Connection: &pg_models.PostgresConnection{
+				Host: "localhost",
+				Port: 5432,
+				Name: "neosync",
+				User: "postgres",
+				Pass: "secret-pass",
+			},
+			SSHTunnel: &pg_models.SSHTunnel{
+				Host: "bastion",
+				Port: 22,
+				User: "tunnel-user",
+				SSHAuthentication: &pg_models.SSHAuthentication{
+					SSHPrivateKey: &pg_models.SSHPrivateKey{Value: "private-key", Passphrase: &keyPassphrase},
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,44 @@
+package encryption
+
+import (
+	"context"
+	"fmt"
+)
+
+const (
+	LocalKeyProviderName = "local"
+)
+
+// A key provider owns the master keys that are used to wrap the per-record data keys.
+// The master keys themselves never leave the provider, which allows plugging in external key management services.
+type KeyProvider interface {
+	// The id of the master key that newly created data keys should be wrapped with
+	CurrentKeyId() string
+	// Encrypts a data key with the master key that has the given id
+	WrapKey(ctx context.Context, keyId string, dataKey []byte) ([]byte, error)
+	// Decrypts a data key that was wrapped with the master key that has the given id
+	UnwrapKey(ctx context.Context, keyId string, wrappedKey []byte) ([]byte, error)
+}
+
+type KeyProviderConfig struct {
+	// The name of the key provider. Currently only "local" is supported.
+	Provider string
+	// Path to the master key file used by the local key provider
+	LocalKeyFile string
+}
+
+// Returns the key provider for the given config, or nil if no provider has been configured
+func NewKeyProvider(cfg *KeyProviderConfig) (KeyProvider, error) {
+	if cfg == nil || cfg.Provider == "" {
+		return nil, nil
+	}
+	switch cfg.Provider {
+	case LocalKeyProviderName:
+		if cfg.LocalKeyFile == "" {
+			return nil, fmt.Errorf("must provide a key file when using the %q key provider", LocalKeyProviderName)
+		}
+		return NewLocalKeyProviderFromFile(cfg.LocalKeyFile)
+	default:
+		return nil, fmt.Errorf("unsupported key provider: %q", cfg.Provider)
+	}
+}
@@ -0,0 +1,86 @@
+package encryption
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"os"
+)
+
+// The format of the master key file used by the local key provider.
+// Old keys should remain in the file until every record has been rotated to the current key.
+type LocalKeyFile struct {
+	// The id of the key that new data keys are wrapped with
+	CurrentKeyId string `json:"currentKeyId"`
+	// Base64 encoded 32 byte AES-256 keys by their id
+	Keys map[string]string `json:"keys"`
+}
+
+type LocalKeyProvider struct {
+	currentKeyId string
+	keys         map[string][]byte
+}
+
+var _ KeyProvider = &LocalKeyProvider{}
+
+func NewLocalKeyProviderFromFile(path string) (*LocalKeyProvider, error) {
+	contents, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read key file: %w", err)
+	}
+	var keyFile LocalKeyFile
+	if err := json.Unmarshal(contents, &keyFile); err != nil {
+		return nil, fmt.Errorf("unable to parse key file: %w", err)
+	}
+	return NewLocalKeyProvider(&keyFile)
+}
+
+func NewLocalKeyProvider(keyFile *LocalKeyFile) (*LocalKeyProvider, error) {
+	if keyFile.CurrentKeyId == "" {
+		return nil, fmt.Errorf("key file must specify a currentKeyId")
+	}
+	keys := make(map[string][]byte, len(keyFile.Keys))
+	for keyId, encodedKey := range keyFile.Keys {
+		key, err := base64.StdEncoding.DecodeString(encodedKey)
+		if err != nil {
+			return nil, fmt.Errorf("unable to decode key %q: %w", keyId, err)
+		}
+		if len(key) != dataKeySize {
+			return nil, fmt.Errorf("key %q must be %d bytes, got %d", keyId, dataKeySize, len(key))
+		}
+		keys[keyId] = key
+	}
+	if _, ok := keys[keyFile.CurrentKeyId]; !ok {
+		return nil, fmt.Errorf("current key %q was not found in key file", keyFile.CurrentKeyId)
+	}
+	return &LocalKeyProvider{currentKeyId: keyFile.CurrentKeyId, keys: keys}, nil
+}
+
+func (p *LocalKeyProvider) CurrentKeyId() string {
+	return p.currentKeyId
+}
+
+func (p *LocalKeyProvider) WrapKey(ctx context.Context, keyId string, dataKey []byte) ([]byte, error) {
+	key, err := p.getKey(keyId)
+	if err != nil {
+		return nil, err
+	}
+	return seal(key, dataKey)
+}
+
+func (p *LocalKeyProvider) UnwrapKey(ctx context.Context, keyId string, wrappedKey []byte) ([]byte, error) {
+	key, err := p.getKey(keyId)
+	if err != nil {
+		return nil, err
+	}
+	return open(key, wrappedKey)
+}
+
+func (p *LocalKeyProvider) getKey(keyId string) ([]byte, error) {
+	key, ok := p.keys[keyId]
+	if !ok {
+		return nil, fmt.Errorf("master key %q was not found", keyId)
+	}
+	return key, nil
+}
@@ -0,0 +1,100 @@
+package encryption
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_NewKeyProvider(t *testing.T) {
+	provider, err := NewKeyProvider(nil)
+	assert.NoError(t, err)
+	assert.Nil(t, provider)
+
+	provider, err = NewKeyProvider(&KeyProviderConfig{})
+	assert.NoError(t, err)
+	assert.Nil(t, provider)
+
+	_, err = NewKeyProvider(&KeyProviderConfig{Provider: "vault"})
+	assert.Error(t, err)
+
+	_, err = NewKeyProvider(&KeyProviderConfig{Provider: LocalKeyProviderName})
+	assert.Error(t, err)
+}
+
+func Test_NewLocalKeyProviderFromFile(t *testing.T) {
+	keyFile := newLocalKeyFile(t, "key-1", "key-2")
+	path := filepath.Join(t.TempDir(), "keys.json")
+	bits, err := json.Marshal(keyFile)
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(path, bits, 0600))
+
+	provider, err := NewKeyProvider(&KeyProviderConfig{Provider: LocalKeyProviderName, LocalKeyFile: path})
+	require.NoError(t, err)
+	assert.Equal(t, "key-1", provider.CurrentKeyId())
+
+	_, err = NewLocalKeyProviderFromFile(filepath.Join(t.TempDir(), "missing.json"))
+	assert.Error(t, err)
+}
+
+func Test_NewLocalKeyProvider_Invalid(t *testing.T) {
+	_, err := NewLocalKeyProvider(&LocalKeyFile{Keys: map[string]string{"key-1": newEncodedKey(t)}})
+	assert.Error(t, err, "missing current key id")
+
+	_, err = NewLocalKeyProvider(&LocalKeyFile{CurrentKeyId: "key-2", Keys: map[string]string{"key-1": newEncodedKey(t)}})
+	assert.Error(t, err, "current key not in file")
+
+	_, err = NewLocalKeyProvider(&LocalKeyFile{CurrentKeyId: "key-1", Keys: map[string]string{"key-1": "not-base64"}})
+	assert.Error(t, err, "invalid encoding")
+
+	_, err = NewLocalKeyProvider(&LocalKeyFile{CurrentKeyId: "key-1", Keys: map[string]string{"key-1": base64.StdEncoding.EncodeToString([]byte("short"))}})
+	assert.Error(t, err, "invalid key length")
+}
+
+func Test_LocalKeyProvider_WrapKey(t *testing.T) {
+	provider, err := NewLocalKeyProvider(newLocalKeyFile(t, "key-1", "key-2"))
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	dataKey := []byte("0123456789abcdef0123456789abcdef")
+	wrapped, err := provider.WrapKey(ctx, "key-2", dataKey)
+	require.NoError(t, err)
+	assert.NotEqual(t, dataKey, wrapped)
+
+	unwrapped, err := provider.UnwrapKey(ctx, "key-2", wrapped)
+	require.NoError(t, err)
+	assert.Equal(t, dataKey, unwrapped)
+
+	_, err = provider.UnwrapKey(ctx, "key-1", wrapped)
+	assert.Error(t, err, "unwrapping with the wrong key must fail")
+
+	_, err = provider.WrapKey(ctx, "key-3", dataKey)
+	assert.Error(t, err)
+}
+
+func newLocalKeyFile(t *testing.T, currentKeyId string, otherKeyIds ...string) *LocalKeyFile {
+	t.Helper()
+	keyFile := &LocalKeyFile{
+		CurrentKeyId: currentKeyId,
+		Keys:         map[string]string{currentKeyId: newEncodedKey(t)},
+	}
+	for _, keyId := range otherKeyIds {
+		keyFile.Keys[keyId] = newEncodedKey(t)
+	}
+	return keyFile
+}
+
+func newEncodedKey(t *testing.T) string {
+	t.Helper()
+	key := make([]byte, dataKeySize)
+	_, err := rand.Read(key)
+	require.NoError(t, err)
+	return base64.StdEncoding.EncodeToString(key)
+}
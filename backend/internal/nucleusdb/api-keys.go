@@ -5,6 +5,7 @@ import (
 
 	"github.com/jackc/pgx/v5/pgtype"
 	db_queries "github.com/nucleuscloud/neosync/backend/gen/go/db"
+	pg_models "github.com/nucleuscloud/neosync/backend/sql/postgresql/models"
 )
 
 type CreateAccountApiKeyRequest struct {
@@ -13,6 +14,7 @@ type CreateAccountApiKeyRequest struct {
 	AccountUuid       pgtype.UUID
 	CreatedByUserUuid pgtype.UUID
 	ExpiresAt         pgtype.Timestamp
+	Scopes            *pg_models.AccountApiKeyScopes
 }
 
 func (d *NucleusDb) CreateAccountApikey(
@@ -37,6 +39,7 @@ func (d *NucleusDb) CreateAccountApikey(
 				CreatedByID: req.CreatedByUserUuid,
 				UpdatedByID: req.CreatedByUserUuid,
 				UserID:      user.ID,
+				Scopes:      req.Scopes,
 			},
 		)
 		if err != nil {
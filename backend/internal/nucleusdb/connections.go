@@ -0,0 +1,196 @@
+package nucleusdb
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	db_queries "github.com/nucleuscloud/neosync/backend/gen/go/db"
+	"github.com/nucleuscloud/neosync/backend/internal/encryption"
+	pg_models "github.com/nucleuscloud/neosync/backend/sql/postgresql/models"
+)
+
+var (
+	ErrConnectionEncryptionNotConfigured = errors.New("connection config is encrypted but no encryption key provider has been configured")
+)
+
+// Wraps a querier so that connection credentials are encrypted before being written and decrypted after being read.
+// If the envelope is nil, configs are written as plaintext and reading an encrypted config returns an error.
+type EncryptedConnectionQuerier struct {
+	db_queries.Querier
+	envelope *encryption.Envelope
+}
+
+var _ db_queries.Querier = &EncryptedConnectionQuerier{}
+
+func NewEncryptedConnectionQuerier(q db_queries.Querier, envelope *encryption.Envelope) *EncryptedConnectionQuerier {
+	return &EncryptedConnectionQuerier{Querier: q, envelope: envelope}
+}
+
+func (q *EncryptedConnectionQuerier) CreateConnection(ctx context.Context, db db_queries.DBTX, arg db_queries.CreateConnectionParams) (db_queries.NeosyncApiConnection, error) {
+	cc, err := q.encrypt(ctx, arg.ConnectionConfig)
+	if err != nil {
+		return db_queries.NeosyncApiConnection{}, err
+	}
+	arg.ConnectionConfig = cc
+	connection, err := q.Querier.CreateConnection(ctx, db, arg)
+	if err != nil {
+		return db_queries.NeosyncApiConnection{}, err
+	}
+	if err := q.decrypt(ctx, &connection); err != nil {
+		return db_queries.NeosyncApiConnection{}, err
+	}
+	return connection, nil
+}
+
+func (q *EncryptedConnectionQuerier) UpdateConnection(ctx context.Context, db db_queries.DBTX, arg db_queries.UpdateConnectionParams) (db_queries.NeosyncApiConnection, error) {
+	cc, err := q.encrypt(ctx, arg.ConnectionConfig)
+	if err != nil {
+		return db_queries.NeosyncApiConnection{}, err
+	}
+	arg.ConnectionConfig = cc
+	connection, err := q.Querier.UpdateConnection(ctx, db, arg)
+	if err != nil {
+		return db_queries.NeosyncApiConnection{}, err
+	}
+	if err := q.decrypt(ctx, &connection); err != nil {
+		return db_queries.NeosyncApiConnection{}, err
+	}
+	return connection, nil
+}
+
+func (q *EncryptedConnectionQuerier) GetConnectionById(ctx context.Context, db db_queries.DBTX, id pgtype.UUID) (db_queries.NeosyncApiConnection, error) {
+	connection, err := q.Querier.GetConnectionById(ctx, db, id)
+	if err != nil {
+		return db_queries.NeosyncApiConnection{}, err
+	}
+	if err := q.decrypt(ctx, &connection); err != nil {
+		return db_queries.NeosyncApiConnection{}, err
+	}
+	return connection, nil
+}
+
+func (q *EncryptedConnectionQuerier) GetConnectionByNameAndAccount(ctx context.Context, db db_queries.DBTX, arg db_queries.GetConnectionByNameAndAccountParams) (db_queries.NeosyncApiConnection, error) {
+	connection, err := q.Querier.GetConnectionByNameAndAccount(ctx, db, arg)
+	if err != nil {
+		return db_queries.NeosyncApiConnection{}, err
+	}
+	if err := q.decrypt(ctx, &connection); err != nil {
+		return db_queries.NeosyncApiConnection{}, err
+	}
+	return connection, nil
+}
+
+func (q *EncryptedConnectionQuerier) GetConnectionsByAccount(ctx context.Context, db db_queries.DBTX, accountid pgtype.UUID) ([]db_queries.NeosyncApiConnection, error) {
+	connections, err := q.Querier.GetConnectionsByAccount(ctx, db, accountid)
+	if err != nil {
+		return nil, err
+	}
+	if err := q.decryptAll(ctx, connections); err != nil {
+		return nil, err
+	}
+	return connections, nil
+}
+
+func (q *EncryptedConnectionQuerier) GetConnectionsByIds(ctx context.Context, db db_queries.DBTX, ids []pgtype.UUID) ([]db_queries.NeosyncApiConnection, error) {
+	connections, err := q.Querier.GetConnectionsByIds(ctx, db, ids)
+	if err != nil {
+		return nil, err
+	}
+	if err := q.decryptAll(ctx, connections); err != nil {
+		return nil, err
+	}
+	return connections, nil
+}
+
+// Returns an encrypted copy of the config so that the caller's config is left as plaintext
+func (q *EncryptedConnectionQuerier) encrypt(ctx context.Context, cc *pg_models.ConnectionConfig) (*pg_models.ConnectionConfig, error) {
+	if q.envelope == nil || cc == nil {
+		return cc, nil
+	}
+	bits, err := json.Marshal(cc)
+	if err != nil {
+		return nil, err
+	}
+	var encrypted pg_models.ConnectionConfig
+	if err := json.Unmarshal(bits, &encrypted); err != nil {
+		return nil, err
+	}
+	if err := q.envelope.EncryptConnectionConfig(ctx, &encrypted); err != nil {
+		return nil, err
+	}
+	return &encrypted, nil
+}
+
+func (q *EncryptedConnectionQuerier) decrypt(ctx context.Context, connection *db_queries.NeosyncApiConnection) error {
+	if connection.ConnectionConfig == nil || connection.ConnectionConfig.EncryptedDataKey == nil {
+		return nil
+	}
+	if q.envelope == nil {
+		return ErrConnectionEncryptionNotConfigured
+	}
+	return q.envelope.DecryptConnectionConfig(ctx, connection.ConnectionConfig)
+}
+
+func (q *EncryptedConnectionQuerier) decryptAll(ctx context.Context, connections []db_queries.NeosyncApiConnection) error {
+	for idx := range connections {
+		if err := q.decrypt(ctx, &connections[idx]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Encrypts the connection configs that are still stored as plaintext and re-wraps the data keys of configs that were encrypted with an older master key.
+// Connections are processed in batches that are each updated in their own transaction. Returns the number of connections that were updated, including those in batches committed before an error.
+func (d *NucleusDb) RotateConnectionEncryption(
+	ctx context.Context,
+	envelope *encryption.Envelope,
+	rotateDataKeys bool,
+	batchSize int32,
+) (int, error) {
+	updated := 0
+	afterId := pgtype.UUID{Valid: true}
+	for {
+		var batchLen, batchUpdated int
+		err := d.WithTx(ctx, nil, func(tx BaseDBTX) error {
+			connections, err := d.Q.GetConnectionsBatchForUpdate(ctx, tx, db_queries.GetConnectionsBatchForUpdateParams{
+				AfterId:   afterId,
+				BatchSize: batchSize,
+			})
+			if err != nil {
+				return err
+			}
+			batchLen = len(connections)
+			for idx := range connections {
+				connection := connections[idx]
+				afterId = connection.ID
+				changed, err := envelope.RotateConnectionConfig(ctx, connection.ConnectionConfig, rotateDataKeys)
+				if err != nil {
+					return fmt.Errorf("unable to rotate encryption of connection %s: %w", UUIDString(connection.ID), err)
+				}
+				if !changed {
+					continue
+				}
+				err = d.Q.UpdateConnectionConfig(ctx, tx, db_queries.UpdateConnectionConfigParams{
+					ConnectionConfig: connection.ConnectionConfig,
+					ID:               connection.ID,
+				})
+				if err != nil {
+					return err
+				}
+				batchUpdated++
+			}
+			return nil
+		})
+		if err != nil {
+			return updated, err
+		}
+		updated += batchUpdated
+		if batchLen < int(batchSize) {
+			return updated, nil
+		}
+	}
+}
@@ -0,0 +1,163 @@
+package nucleusdb
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"testing"
+
+	db_queries "github.com/nucleuscloud/neosync/backend/gen/go/db"
+	"github.com/nucleuscloud/neosync/backend/internal/encryption"
+	pg_models "github.com/nucleuscloud/neosync/backend/sql/postgresql/models"
+	"github.com/stretchr/testify/mock"
+	"github.com/zeebo/assert"
+)
+
+func Test_EncryptedConnectionQuerier_CreateConnection(t *testing.T) {
+	dbtxMock := NewMockDBTX(t)
+	querierMock := db_queries.NewMockQuerier(t)
+	envelope := newTestEnvelope(t)
+	querier := NewEncryptedConnectionQuerier(querierMock, envelope)
+	ctx := context.Background()
+
+	var stored *pg_models.ConnectionConfig
+	querierMock.On("CreateConnection", ctx, dbtxMock, mock.Anything).
+		Run(func(args mock.Arguments) {
+			stored = args.Get(2).(db_queries.CreateConnectionParams).ConnectionConfig
+		}).
+		Return(func(ctx context.Context, db db_queries.DBTX, arg db_queries.CreateConnectionParams) (db_queries.NeosyncApiConnection, error) {
+			return db_queries.NeosyncApiConnection{ConnectionConfig: copyConnectionConfig(t, arg.ConnectionConfig)}, nil
+		})
+
+	cc := newTestConnectionConfig()
+	resp, err := querier.CreateConnection(ctx, dbtxMock, db_queries.CreateConnectionParams{ConnectionConfig: cc})
+	assert.NoError(t, err)
+
+	assert.NotNil(t, stored.EncryptedDataKey)
+	assert.NotEqual(t, "secret-pass", stored.PgConfig.Connection.Pass)
+	assert.Equal(t, newTestConnectionConfig(), cc)
+	assert.Equal(t, newTestConnectionConfig(), resp.ConnectionConfig)
+}
+
+func Test_EncryptedConnectionQuerier_GetConnectionsByAccount(t *testing.T) {
+	dbtxMock := NewMockDBTX(t)
+	querierMock := db_queries.NewMockQuerier(t)
+	envelope := newTestEnvelope(t)
+	querier := NewEncryptedConnectionQuerier(querierMock, envelope)
+	ctx := context.Background()
+
+	encrypted := newTestConnectionConfig()
+	assert.NoError(t, envelope.EncryptConnectionConfig(ctx, encrypted))
+	accountUuid, _ := ToUuid(mockAccountId)
+	querierMock.On("GetConnectionsByAccount", ctx, dbtxMock, accountUuid).
+		Return([]db_queries.NeosyncApiConnection{
+			{ConnectionConfig: encrypted},
+			{ConnectionConfig: newTestConnectionConfig()},
+		}, nil)
+
+	resp, err := querier.GetConnectionsByAccount(ctx, dbtxMock, accountUuid)
+	assert.NoError(t, err)
+	assert.Equal(t, len(resp), 2)
+	for _, connection := range resp {
+		assert.Equal(t, newTestConnectionConfig(), connection.ConnectionConfig)
+	}
+}
+
+func Test_EncryptedConnectionQuerier_NotConfigured(t *testing.T) {
+	dbtxMock := NewMockDBTX(t)
+	querierMock := db_queries.NewMockQuerier(t)
+	querier := NewEncryptedConnectionQuerier(querierMock, nil)
+	ctx := context.Background()
+
+	encrypted := newTestConnectionConfig()
+	assert.NoError(t, newTestEnvelope(t).EncryptConnectionConfig(ctx, encrypted))
+	connUuid, _ := ToUuid(mockConnId)
+	querierMock.On("GetConnectionById", ctx, dbtxMock, connUuid).
+		Return(db_queries.NeosyncApiConnection{ConnectionConfig: encrypted}, nil)
+
+	_, err := querier.GetConnectionById(ctx, dbtxMock, connUuid)
+	assert.Error(t, err)
+	assert.Equal(t, ErrConnectionEncryptionNotConfigured, err)
+
+	var stored *pg_models.ConnectionConfig
+	querierMock.On("UpdateConnection", ctx, dbtxMock, mock.Anything).
+		Run(func(args mock.Arguments) {
+			stored = args.Get(2).(db_queries.UpdateConnectionParams).ConnectionConfig
+		}).
+		Return(db_queries.NeosyncApiConnection{ConnectionConfig: newTestConnectionConfig()}, nil)
+	_, err = querier.UpdateConnection(ctx, dbtxMock, db_queries.UpdateConnectionParams{ConnectionConfig: newTestConnectionConfig()})
+	assert.NoError(t, err)
+	assert.Nil(t, stored.EncryptedDataKey)
+	assert.Equal(t, "secret-pass", stored.PgConfig.Connection.Pass)
+}
+
+func Test_RotateConnectionEncryption(t *testing.T) {
+	dbtxMock := NewMockDBTX(t)
+	querierMock := db_queries.NewMockQuerier(t)
+	mockTx := new(MockTx)
+	envelope := newTestEnvelope(t)
+	ctx := context.Background()
+
+	encrypted := newTestConnectionConfig()
+	assert.NoError(t, envelope.EncryptConnectionConfig(ctx, encrypted))
+	plaintextUuid, _ := ToUuid(mockConnId)
+	encryptedUuid, _ := ToUuid(mockJobId)
+
+	dbtxMock.On("Begin", ctx).Return(mockTx, nil)
+	querierMock.On("GetConnectionsBatchForUpdate", ctx, mockTx, mock.Anything).
+		Return([]db_queries.NeosyncApiConnection{
+			{ID: plaintextUuid, ConnectionConfig: newTestConnectionConfig()},
+			{ID: encryptedUuid, ConnectionConfig: encrypted},
+		}, nil).Once()
+	querierMock.On("GetConnectionsBatchForUpdate", ctx, mockTx, db_queries.GetConnectionsBatchForUpdateParams{AfterId: encryptedUuid, BatchSize: 2}).
+		Return([]db_queries.NeosyncApiConnection{}, nil).Once()
+	querierMock.On("UpdateConnectionConfig", ctx, mockTx, mock.Anything).
+		Run(func(args mock.Arguments) {
+			params := args.Get(2).(db_queries.UpdateConnectionConfigParams)
+			assert.Equal(t, plaintextUuid, params.ID)
+			assert.NotNil(t, params.ConnectionConfig.EncryptedDataKey)
+		}).
+		Return(nil).Once()
+	mockTx.On("Commit", ctx).Return(nil)
+	mockTx.On("Rollback", ctx).Return(nil)
+
+	service := New(dbtxMock, querierMock)
+	updated, err := service.RotateConnectionEncryption(ctx, envelope, false, 2)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, updated)
+}
+
+func newTestEnvelope(t *testing.T) *encryption.Envelope {
+	t.Helper()
+	key := make([]byte, 32)
+	_, err := rand.Read(key)
+	assert.NoError(t, err)
+	provider, err := encryption.NewLocalKeyProvider(&encryption.LocalKeyFile{
+		CurrentKeyId: "key-1",
+		Keys:         map[string]string{"key-1": base64.StdEncoding.EncodeToString(key)},
+	})
+	assert.NoError(t, err)
+	return encryption.NewEnvelope(provider)
+}
+
+func newTestConnectionConfig() *pg_models.ConnectionConfig {
+	return &pg_models.ConnectionConfig{
+		PgConfig: &pg_models.PostgresConnectionConfig{
+			Connection: &pg_models.PostgresConnection{
+				Host: "localhost",
+				Port: 5432,
+				Name: "neosync",
+				User: "postgres",
+				Pass: "secret-pass",
+			},
+		},
+	}
+}
+
+func copyConnectionConfig(t *testing.T, cc *pg_models.ConnectionConfig) *pg_models.ConnectionConfig {
+	t.Helper()
+	copied := *cc
+	connection := *cc.PgConfig.Connection
+	copied.PgConfig = &pg_models.PostgresConnectionConfig{Connection: &connection}
+	return &copied
+}
@@ -89,3 +89,68 @@ func (d *NucleusDb) SetSqlSourceSubsets(
 		return nil
 	})
 }
+
+// Records the watermarks that were synced by the job run and advances the job's current high-water marks
+func (d *NucleusDb) SetJobRunWatermarks(
+	ctx context.Context,
+	jobId pgtype.UUID,
+	jobRunId string,
+	watermarks []*mgmtv1alpha1.JobRunWatermark,
+) error {
+	return d.WithTx(ctx, nil, func(dbtx BaseDBTX) error {
+		for _, wm := range watermarks {
+			startValue := pgtype.Text{}
+			if wm.StartValue != nil {
+				startValue = pgtype.Text{String: *wm.StartValue, Valid: true}
+			}
+			err := d.Q.CreateJobRunWatermark(ctx, dbtx, db_queries.CreateJobRunWatermarkParams{
+				JobID:      jobId,
+				JobRunID:   jobRunId,
+				SchemaName: wm.Schema,
+				TableName:  wm.Table,
+				ColumnName: wm.Column,
+				StartValue: startValue,
+				EndValue:   wm.EndValue,
+			})
+			if err != nil {
+				return fmt.Errorf("unable to create job run watermark for %s.%s: %w", wm.Schema, wm.Table, err)
+			}
+			_, err = d.Q.SetJobWatermark(ctx, dbtx, db_queries.SetJobWatermarkParams{
+				JobID:          jobId,
+				SchemaName:     wm.Schema,
+				TableName:      wm.Table,
+				ColumnName:     wm.Column,
+				WatermarkValue: wm.EndValue,
+				JobRunID:       jobRunId,
+			})
+			if err != nil {
+				return fmt.Errorf("unable to set job watermark for %s.%s: %w", wm.Schema, wm.Table, err)
+			}
+		}
+		return nil
+	})
+}
+
+// Removes the current high-water marks for the provided tables. If no tables are provided, all of the job's watermarks are removed
+func (d *NucleusDb) ResetJobWatermarks(
+	ctx context.Context,
+	jobId pgtype.UUID,
+	tables []*mgmtv1alpha1.JobWatermarkTable,
+) error {
+	if len(tables) == 0 {
+		return d.Q.RemoveJobWatermarks(ctx, d.Db, jobId)
+	}
+	return d.WithTx(ctx, nil, func(dbtx BaseDBTX) error {
+		for _, t := range tables {
+			err := d.Q.RemoveJobWatermark(ctx, dbtx, db_queries.RemoveJobWatermarkParams{
+				JobID:      jobId,
+				SchemaName: t.Schema,
+				TableName:  t.Table,
+			})
+			if err != nil {
+				return fmt.Errorf("unable to reset job watermark for %s.%s: %w", t.Schema, t.Table, err)
+			}
+		}
+		return nil
+	})
+}
@@ -8,6 +8,7 @@ import (
 
 	"github.com/jackc/pgx/v5/pgtype"
 	db_queries "github.com/nucleuscloud/neosync/backend/gen/go/db"
+	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
 	nucleuserrors "github.com/nucleuscloud/neosync/backend/internal/errors"
 )
 
@@ -78,6 +79,7 @@ func (d *NucleusDb) SetPersonalAccount(
 			_, err = d.Q.CreateAccountUserAssociation(ctx, dbtx, db_queries.CreateAccountUserAssociationParams{
 				AccountID: account.ID,
 				UserID:    userId,
+				Role:      int16(mgmtv1alpha1.AccountRole_ACCOUNT_ROLE_OWNER),
 			})
 			if err != nil {
 				return err
@@ -94,6 +96,7 @@ func (d *NucleusDb) SetPersonalAccount(
 				_, err = d.Q.CreateAccountUserAssociation(ctx, dbtx, db_queries.CreateAccountUserAssociationParams{
 					AccountID: account.ID,
 					UserID:    userId,
+					Role:      int16(mgmtv1alpha1.AccountRole_ACCOUNT_ROLE_OWNER),
 				})
 				if err != nil {
 					return err
@@ -133,6 +136,7 @@ func (d *NucleusDb) CreateTeamAccount(
 		_, err = d.Q.CreateAccountUserAssociation(ctx, dbtx, db_queries.CreateAccountUserAssociationParams{
 			AccountID: account.ID,
 			UserID:    userId,
+			Role:      int16(mgmtv1alpha1.AccountRole_ACCOUNT_ROLE_OWNER),
 		})
 		if err != nil {
 			return err
@@ -150,6 +154,7 @@ func (d *NucleusDb) CreateTeamAccountInvite(
 	userId pgtype.UUID,
 	email string,
 	expiresAt pgtype.Timestamp,
+	role mgmtv1alpha1.AccountRole,
 ) (*db_queries.NeosyncApiAccountInvite, error) {
 	var accountInvite *db_queries.NeosyncApiAccountInvite
 	if err := d.WithTx(ctx, nil, func(dbtx BaseDBTX) error {
@@ -175,6 +180,7 @@ func (d *NucleusDb) CreateTeamAccountInvite(
 			SenderUserID: userId,
 			Email:        email,
 			ExpiresAt:    expiresAt,
+			Role:         int16(role),
 		})
 		if err != nil {
 			return err
@@ -230,6 +236,7 @@ func (d *NucleusDb) ValidateInviteAddUserToAccount(
 			_, err := d.Q.CreateAccountUserAssociation(ctx, dbtx, db_queries.CreateAccountUserAssociationParams{
 				AccountID: invite.AccountID,
 				UserID:    userId,
+				Role:      invite.Role,
 			})
 			if err != nil {
 				return err
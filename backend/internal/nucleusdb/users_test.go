@@ -10,6 +10,7 @@ import (
 	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5/pgtype"
 	db_queries "github.com/nucleuscloud/neosync/backend/gen/go/db"
+	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
 	"github.com/stretchr/testify/mock"
 	"github.com/zeebo/assert"
 )
@@ -210,6 +211,7 @@ func Test_SetPersonalAccount_CreateUserAssociation(t *testing.T) {
 	querierMock.On("CreateAccountUserAssociation", ctx, mockTx, db_queries.CreateAccountUserAssociationParams{
 		AccountID: accountUuid,
 		UserID:    userUuid,
+		Role:      int16(mgmtv1alpha1.AccountRole_ACCOUNT_ROLE_OWNER),
 	}).Return(db_queries.NeosyncApiAccountUserAssociation{}, nil)
 	mockTx.On("Commit", ctx).Return(nil)
 	mockTx.On("Rollback", ctx).Return(nil)
@@ -239,6 +241,7 @@ func Test_SetPersonalAccount_CreateAccount(t *testing.T) {
 	querierMock.On("CreateAccountUserAssociation", ctx, mockTx, db_queries.CreateAccountUserAssociationParams{
 		AccountID: accountUuid,
 		UserID:    userUuid,
+		Role:      int16(mgmtv1alpha1.AccountRole_ACCOUNT_ROLE_OWNER),
 	}).Return(db_queries.NeosyncApiAccountUserAssociation{AccountID: accountUuid, UserID: userUuid}, nil)
 	mockTx.On("Commit", ctx).Return(nil)
 	mockTx.On("Rollback", ctx).Return(nil)
@@ -268,6 +271,7 @@ func Test_SetPersonalAccount_Rollback(t *testing.T) {
 	querierMock.On("CreateAccountUserAssociation", ctx, mockTx, db_queries.CreateAccountUserAssociationParams{
 		AccountID: accountUuid,
 		UserID:    userUuid,
+		Role:      int16(mgmtv1alpha1.AccountRole_ACCOUNT_ROLE_OWNER),
 	}).Return(db_queries.NeosyncApiAccountUserAssociation{AccountID: accountUuid, UserID: userUuid}, errors.New("boo"))
 	mockTx.On("Rollback", ctx).Return(nil)
 
@@ -296,6 +300,7 @@ func Test_CreateTeamAccount(t *testing.T) {
 	querierMock.On("CreateAccountUserAssociation", ctx, mockTx, db_queries.CreateAccountUserAssociationParams{
 		AccountID: accountUuid,
 		UserID:    userUuid,
+		Role:      int16(mgmtv1alpha1.AccountRole_ACCOUNT_ROLE_OWNER),
 	}).Return(db_queries.NeosyncApiAccountUserAssociation{}, nil)
 	mockTx.On("Commit", ctx).Return(nil)
 	mockTx.On("Rollback", ctx).Return(nil)
@@ -350,6 +355,7 @@ func Test_CreateTeamAccount_NoRows(t *testing.T) {
 	querierMock.On("CreateAccountUserAssociation", ctx, mockTx, db_queries.CreateAccountUserAssociationParams{
 		AccountID: accountUuid,
 		UserID:    userUuid,
+		Role:      int16(mgmtv1alpha1.AccountRole_ACCOUNT_ROLE_OWNER),
 	}).Return(db_queries.NeosyncApiAccountUserAssociation{}, nil)
 	mockTx.On("Commit", ctx).Return(nil)
 	mockTx.On("Rollback", ctx).Return(nil)
@@ -380,6 +386,7 @@ func Test_CreateTeamAccount_Rollback(t *testing.T) {
 	querierMock.On("CreateAccountUserAssociation", ctx, mockTx, db_queries.CreateAccountUserAssociationParams{
 		AccountID: accountUuid,
 		UserID:    userUuid,
+		Role:      int16(mgmtv1alpha1.AccountRole_ACCOUNT_ROLE_OWNER),
 	}).Return(nilAssociation, errors.New("sad"))
 	mockTx.On("Rollback", ctx).Return(nil)
 
@@ -417,6 +424,7 @@ func Test_CreateTeamAccountInvite(t *testing.T) {
 		SenderUserID: userUuid,
 		Email:        mockEmail,
 		ExpiresAt:    expiresAt,
+		Role:         int16(mgmtv1alpha1.AccountRole_ACCOUNT_ROLE_ADMIN),
 	}).Return(db_queries.NeosyncApiAccountInvite{
 		AccountID:    accountUuid,
 		SenderUserID: userUuid,
@@ -426,7 +434,7 @@ func Test_CreateTeamAccountInvite(t *testing.T) {
 	mockTx.On("Rollback", ctx).Return(nil)
 	mockTx.On("Commit", ctx).Return(nil)
 
-	resp, err := service.CreateTeamAccountInvite(context.Background(), accountUuid, userUuid, mockEmail, expiresAt)
+	resp, err := service.CreateTeamAccountInvite(context.Background(), accountUuid, userUuid, mockEmail, expiresAt, mgmtv1alpha1.AccountRole_ACCOUNT_ROLE_ADMIN)
 
 	assert.NoError(t, err)
 	assert.NotNil(t, resp)
@@ -451,7 +459,7 @@ func Test_CreateTeamAccountInvite_NotTeamAccount(t *testing.T) {
 	mockTx.On("Rollback", ctx).Return(nil)
 	mockTx.On("Commit", ctx).Return(nil)
 
-	resp, err := service.CreateTeamAccountInvite(context.Background(), accountUuid, userUuid, mockEmail, expiresAt)
+	resp, err := service.CreateTeamAccountInvite(context.Background(), accountUuid, userUuid, mockEmail, expiresAt, mgmtv1alpha1.AccountRole_ACCOUNT_ROLE_ADMIN)
 
 	querierMock.AssertNotCalled(t, "UpdateActiveAccountInvitesToExpired", mock.Anything, mock.Anything, mock.Anything)
 	querierMock.AssertNotCalled(t, "CreateAccountInvite", mock.Anything, mock.Anything, mock.Anything)
@@ -629,6 +637,7 @@ func Test_ValidateInviteAddUserToAccount(t *testing.T) {
 		ExpiresAt:    expiresAt,
 		ID:           inviteUuid,
 		Accepted:     pgtype.Bool{Bool: false},
+		Role:         int16(mgmtv1alpha1.AccountRole_ACCOUNT_ROLE_JOB_OPERATOR),
 	}, nil)
 	querierMock.On("UpdateAccountInviteToAccepted", ctx, mockTx, inviteUuid).Return(db_queries.NeosyncApiAccountInvite{}, nil)
 	querierMock.On("GetAccountUserAssociation", ctx, mockTx, db_queries.GetAccountUserAssociationParams{
@@ -638,6 +647,7 @@ func Test_ValidateInviteAddUserToAccount(t *testing.T) {
 	querierMock.On("CreateAccountUserAssociation", ctx, mockTx, db_queries.CreateAccountUserAssociationParams{
 		AccountID: accountUuid,
 		UserID:    userUuid,
+		Role:      int16(mgmtv1alpha1.AccountRole_ACCOUNT_ROLE_JOB_OPERATOR),
 	}).Return(db_queries.NeosyncApiAccountUserAssociation{}, nil)
 	mockTx.On("Rollback", ctx).Return(nil)
 	mockTx.On("Commit", ctx).Return(nil)
@@ -0,0 +1,178 @@
+package rbac
+
+import (
+	"context"
+	"strings"
+
+	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
+	"github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1/mgmtv1alpha1connect"
+)
+
+type RequiredRoleContextKey struct{}
+
+const (
+	viewer      = mgmtv1alpha1.AccountRole_ACCOUNT_ROLE_VIEWER
+	jobOperator = mgmtv1alpha1.AccountRole_ACCOUNT_ROLE_JOB_OPERATOR
+	admin       = mgmtv1alpha1.AccountRole_ACCOUNT_ROLE_ADMIN
+	owner       = mgmtv1alpha1.AccountRole_ACCOUNT_ROLE_OWNER
+	// procedures that are not scoped to an account do not require a role
+	none = mgmtv1alpha1.AccountRole_ACCOUNT_ROLE_UNSPECIFIED
+)
+
+// The minimum role a user must have in an account to call each procedure against it.
+// Every procedure must be listed here, procedures that are missing are rejected.
+var procedureRoles = map[string]mgmtv1alpha1.AccountRole{
+	mgmtv1alpha1connect.AccountSpecServiceApplyAccountSpecProcedure: admin,
+
+	mgmtv1alpha1connect.ApiKeyServiceGetAccountApiKeysProcedure:       viewer,
+	mgmtv1alpha1connect.ApiKeyServiceGetAccountApiKeyProcedure:        viewer,
+	mgmtv1alpha1connect.ApiKeyServiceCreateAccountApiKeyProcedure:     admin,
+	mgmtv1alpha1connect.ApiKeyServiceRegenerateAccountApiKeyProcedure: admin,
+	mgmtv1alpha1connect.ApiKeyServiceDeleteAccountApiKeyProcedure:     admin,
+
+	mgmtv1alpha1connect.AuditServiceGetAuditEventsProcedure: admin,
+
+	mgmtv1alpha1connect.AuthServiceLoginCliProcedure:        none,
+	mgmtv1alpha1connect.AuthServiceRefreshCliProcedure:      none,
+	mgmtv1alpha1connect.AuthServiceCheckTokenProcedure:      none,
+	mgmtv1alpha1connect.AuthServiceGetCliIssuerProcedure:    none,
+	mgmtv1alpha1connect.AuthServiceGetAuthorizeUrlProcedure: none,
+	mgmtv1alpha1connect.AuthServiceGetAuthStatusProcedure:   none,
+
+	mgmtv1alpha1connect.ConnectionServiceGetConnectionsProcedure:            viewer,
+	mgmtv1alpha1connect.ConnectionServiceGetConnectionProcedure:             viewer,
+	mgmtv1alpha1connect.ConnectionServiceIsConnectionNameAvailableProcedure: viewer,
+	mgmtv1alpha1connect.ConnectionServiceCheckConnectionConfigProcedure:     jobOperator,
+	mgmtv1alpha1connect.ConnectionServiceCheckSqlQueryProcedure:             jobOperator,
+	mgmtv1alpha1connect.ConnectionServiceCreateConnectionProcedure:          admin,
+	mgmtv1alpha1connect.ConnectionServiceUpdateConnectionProcedure:          admin,
+	mgmtv1alpha1connect.ConnectionServiceDeleteConnectionProcedure:          admin,
+
+	mgmtv1alpha1connect.ConnectionDataServiceGetConnectionDataStreamProcedure:         viewer,
+	mgmtv1alpha1connect.ConnectionDataServiceGetConnectionSchemaProcedure:             viewer,
+	mgmtv1alpha1connect.ConnectionDataServiceGetConnectionForeignConstraintsProcedure: viewer,
+	mgmtv1alpha1connect.ConnectionDataServiceGetConnectionPrimaryConstraintsProcedure: viewer,
+	mgmtv1alpha1connect.ConnectionDataServiceGetConnectionInitStatementsProcedure:     viewer,
+	mgmtv1alpha1connect.ConnectionDataServiceGetConnectionUniqueConstraintsProcedure:  viewer,
+	mgmtv1alpha1connect.ConnectionDataServiceScanConnectionPiiProcedure:               jobOperator,
+
+	mgmtv1alpha1connect.JobServiceGetJobsProcedure:                          viewer,
+	mgmtv1alpha1connect.JobServiceGetJobProcedure:                           viewer,
+	mgmtv1alpha1connect.JobServiceIsJobNameAvailableProcedure:               viewer,
+	mgmtv1alpha1connect.JobServiceGetJobRecentRunsProcedure:                 viewer,
+	mgmtv1alpha1connect.JobServiceGetJobNextRunsProcedure:                   viewer,
+	mgmtv1alpha1connect.JobServiceGetJobStatusProcedure:                     viewer,
+	mgmtv1alpha1connect.JobServiceGetJobStatusesProcedure:                   viewer,
+	mgmtv1alpha1connect.JobServiceGetJobRunsProcedure:                       viewer,
+	mgmtv1alpha1connect.JobServiceGetJobRunEventsProcedure:                  viewer,
+	mgmtv1alpha1connect.JobServiceGetJobRunProcedure:                        viewer,
+	mgmtv1alpha1connect.JobServiceGetJobRunLogsStreamProcedure:              viewer,
+	mgmtv1alpha1connect.JobServiceGetJobWatermarksProcedure:                 viewer,
+	mgmtv1alpha1connect.JobServiceGetJobRunValidationReportProcedure:        viewer,
+	mgmtv1alpha1connect.JobServiceGetJobVersionsProcedure:                   viewer,
+	mgmtv1alpha1connect.JobServiceGetJobVersionDiffProcedure:                viewer,
+	mgmtv1alpha1connect.JobServiceGetJobRunSchemaDriftReportProcedure:       viewer,
+	mgmtv1alpha1connect.JobServiceCreateJobRunProcedure:                     jobOperator,
+	mgmtv1alpha1connect.JobServiceDeleteJobRunProcedure:                     jobOperator,
+	mgmtv1alpha1connect.JobServiceCancelJobRunProcedure:                     jobOperator,
+	mgmtv1alpha1connect.JobServiceTerminateJobRunProcedure:                  jobOperator,
+	mgmtv1alpha1connect.JobServicePauseJobProcedure:                         jobOperator,
+	mgmtv1alpha1connect.JobServicePreviewJobMappingsProcedure:               jobOperator,
+	mgmtv1alpha1connect.JobServiceCreateJobProcedure:                        admin,
+	mgmtv1alpha1connect.JobServiceDeleteJobProcedure:                        admin,
+	mgmtv1alpha1connect.JobServiceCreateJobDestinationConnectionsProcedure:  admin,
+	mgmtv1alpha1connect.JobServiceUpdateJobDestinationConnectionProcedure:   admin,
+	mgmtv1alpha1connect.JobServiceDeleteJobDestinationConnectionProcedure:   admin,
+	mgmtv1alpha1connect.JobServiceUpdateJobScheduleProcedure:                admin,
+	mgmtv1alpha1connect.JobServiceUpdateJobSourceConnectionProcedure:        admin,
+	mgmtv1alpha1connect.JobServiceSetJobSourceSqlConnectionSubsetsProcedure: admin,
+	mgmtv1alpha1connect.JobServiceSetJobWorkflowOptionsProcedure:            admin,
+	mgmtv1alpha1connect.JobServiceSetJobSyncOptionsProcedure:                admin,
+	mgmtv1alpha1connect.JobServiceSetJobTriggersProcedure:                   admin,
+	mgmtv1alpha1connect.JobServiceResetJobWatermarksProcedure:               admin,
+	mgmtv1alpha1connect.JobServiceRollbackJobToVersionProcedure:             admin,
+	mgmtv1alpha1connect.JobServiceApplyJobRunSchemaDriftReportProcedure:     admin,
+	// these are called by the worker while a job runs
+	mgmtv1alpha1connect.JobServiceSetJobRunWatermarksProcedure:        admin,
+	mgmtv1alpha1connect.JobServiceSetJobRunValidationReportProcedure:  admin,
+	mgmtv1alpha1connect.JobServiceSetJobRunSchemaDriftReportProcedure: admin,
+	mgmtv1alpha1connect.JobServiceTriggerDependentJobsProcedure:       admin,
+
+	mgmtv1alpha1connect.MetricsServiceGetMetricCountProcedure:      viewer,
+	mgmtv1alpha1connect.MetricsServiceGetDailyMetricCountProcedure: viewer,
+
+	mgmtv1alpha1connect.TransformersServiceGetSystemTransformersProcedure:         none,
+	mgmtv1alpha1connect.TransformersServiceGetSystemTransformerBySourceProcedure:  none,
+	mgmtv1alpha1connect.TransformersServiceGetUserDefinedTransformersProcedure:    viewer,
+	mgmtv1alpha1connect.TransformersServiceGetUserDefinedTransformerByIdProcedure: viewer,
+	mgmtv1alpha1connect.TransformersServiceIsTransformerNameAvailableProcedure:    viewer,
+	mgmtv1alpha1connect.TransformersServiceValidateUserJavascriptCodeProcedure:    jobOperator,
+	mgmtv1alpha1connect.TransformersServiceValidateUserRegexCodeProcedure:         jobOperator,
+	mgmtv1alpha1connect.TransformersServiceValidateTransformJsonConfigProcedure:   jobOperator,
+	mgmtv1alpha1connect.TransformersServiceCreateUserDefinedTransformerProcedure:  admin,
+	mgmtv1alpha1connect.TransformersServiceUpdateUserDefinedTransformerProcedure:  admin,
+	mgmtv1alpha1connect.TransformersServiceDeleteUserDefinedTransformerProcedure:  admin,
+	mgmtv1alpha1connect.TransformersServiceDecryptFormatPreservingProcedure:       admin,
+
+	mgmtv1alpha1connect.UserAccountServiceGetUserProcedure:                      none,
+	mgmtv1alpha1connect.UserAccountServiceSetUserProcedure:                      none,
+	mgmtv1alpha1connect.UserAccountServiceGetUserAccountsProcedure:              none,
+	mgmtv1alpha1connect.UserAccountServiceSetPersonalAccountProcedure:           none,
+	mgmtv1alpha1connect.UserAccountServiceConvertPersonalToTeamAccountProcedure: none,
+	mgmtv1alpha1connect.UserAccountServiceCreateTeamAccountProcedure:            none,
+	mgmtv1alpha1connect.UserAccountServiceAcceptTeamAccountInviteProcedure:      none,
+	mgmtv1alpha1connect.UserAccountServiceGetSystemInformationProcedure:         none,
+	mgmtv1alpha1connect.UserAccountServiceIsUserInAccountProcedure:              viewer,
+	mgmtv1alpha1connect.UserAccountServiceGetAccountTemporalConfigProcedure:     viewer,
+	mgmtv1alpha1connect.UserAccountServiceGetTeamAccountMembersProcedure:        viewer,
+	mgmtv1alpha1connect.UserAccountServiceGetTeamAccountInvitesProcedure:        viewer,
+	mgmtv1alpha1connect.UserAccountServiceGetAccountOnboardingConfigProcedure:   viewer,
+	mgmtv1alpha1connect.UserAccountServiceRemoveTeamAccountMemberProcedure:      admin,
+	mgmtv1alpha1connect.UserAccountServiceSetTeamAccountMemberRoleProcedure:     admin,
+	mgmtv1alpha1connect.UserAccountServiceInviteUserToTeamAccountProcedure:      admin,
+	mgmtv1alpha1connect.UserAccountServiceRemoveTeamAccountInviteProcedure:      admin,
+	mgmtv1alpha1connect.UserAccountServiceSetAccountOnboardingConfigProcedure:   admin,
+	mgmtv1alpha1connect.UserAccountServiceGetAccountTransformerKeyProcedure:     owner,
+	mgmtv1alpha1connect.UserAccountServiceSetAccountTransformerKeyProcedure:     owner,
+	mgmtv1alpha1connect.UserAccountServiceSetAccountTemporalConfigProcedure:     owner,
+
+	mgmtv1alpha1connect.WebhookServiceGetWebhooksProcedure:          viewer,
+	mgmtv1alpha1connect.WebhookServiceGetWebhookProcedure:           viewer,
+	mgmtv1alpha1connect.WebhookServiceGetWebhookDeliveriesProcedure: viewer,
+	mgmtv1alpha1connect.WebhookServiceCreateWebhookProcedure:        admin,
+	mgmtv1alpha1connect.WebhookServiceUpdateWebhookProcedure:        admin,
+	mgmtv1alpha1connect.WebhookServiceDeleteWebhookProcedure:        admin,
+	// called by the worker while a job runs
+	mgmtv1alpha1connect.WebhookServicePublishJobRunEventProcedure: admin,
+}
+
+// Returns the minimum role that is required to call the procedure against an account.
+// Returns false if the procedure is unknown.
+func GetProcedureRole(procedure string) (mgmtv1alpha1.AccountRole, bool) {
+	role, ok := procedureRoles[procedure]
+	return role, ok
+}
+
+// Roles are hierarchical, each role is granted everything that the roles below it are granted
+func HasRole(role, requiredRole mgmtv1alpha1.AccountRole) bool {
+	return role >= requiredRole
+}
+
+func SetRequiredRoleInCtx(ctx context.Context, role mgmtv1alpha1.AccountRole) context.Context {
+	return context.WithValue(ctx, RequiredRoleContextKey{}, role)
+}
+
+// Returns the role required by the procedure that is currently being handled.
+// Returns the unspecified role if no role is required.
+func GetRequiredRoleFromCtx(ctx context.Context) mgmtv1alpha1.AccountRole {
+	role, ok := ctx.Value(RequiredRoleContextKey{}).(mgmtv1alpha1.AccountRole)
+	if !ok {
+		return none
+	}
+	return role
+}
+
+// Returns the role without its enum prefix, ex: job_operator
+func GetRoleName(role mgmtv1alpha1.AccountRole) string {
+	return strings.ToLower(strings.TrimPrefix(role.String(), "ACCOUNT_ROLE_"))
+}
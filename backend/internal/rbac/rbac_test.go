@@ -0,0 +1,64 @@
+package rbac
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+func Test_GetProcedureRole_AllProcedures(t *testing.T) {
+	files := []protoreflect.FileDescriptor{
+		mgmtv1alpha1.File_mgmt_v1alpha1_account_spec_proto,
+		mgmtv1alpha1.File_mgmt_v1alpha1_api_key_proto,
+		mgmtv1alpha1.File_mgmt_v1alpha1_audit_proto,
+		mgmtv1alpha1.File_mgmt_v1alpha1_auth_proto,
+		mgmtv1alpha1.File_mgmt_v1alpha1_connection_proto,
+		mgmtv1alpha1.File_mgmt_v1alpha1_connection_data_proto,
+		mgmtv1alpha1.File_mgmt_v1alpha1_job_proto,
+		mgmtv1alpha1.File_mgmt_v1alpha1_metrics_proto,
+		mgmtv1alpha1.File_mgmt_v1alpha1_transformer_proto,
+		mgmtv1alpha1.File_mgmt_v1alpha1_user_account_proto,
+		mgmtv1alpha1.File_mgmt_v1alpha1_webhook_proto,
+	}
+	for _, file := range files {
+		services := file.Services()
+		for i := 0; i < services.Len(); i++ {
+			methods := services.Get(i).Methods()
+			for j := 0; j < methods.Len(); j++ {
+				procedure := fmt.Sprintf("/%s/%s", services.Get(i).FullName(), methods.Get(j).Name())
+				_, ok := GetProcedureRole(procedure)
+				assert.True(t, ok, "procedure %s must be given a role", procedure)
+			}
+		}
+	}
+}
+
+func Test_GetProcedureRole_Unknown(t *testing.T) {
+	_, ok := GetProcedureRole("/mgmt.v1alpha1.JobService/Unknown")
+	assert.False(t, ok)
+}
+
+func Test_HasRole(t *testing.T) {
+	assert.True(t, HasRole(mgmtv1alpha1.AccountRole_ACCOUNT_ROLE_OWNER, mgmtv1alpha1.AccountRole_ACCOUNT_ROLE_ADMIN))
+	assert.True(t, HasRole(mgmtv1alpha1.AccountRole_ACCOUNT_ROLE_JOB_OPERATOR, mgmtv1alpha1.AccountRole_ACCOUNT_ROLE_JOB_OPERATOR))
+	assert.True(t, HasRole(mgmtv1alpha1.AccountRole_ACCOUNT_ROLE_VIEWER, mgmtv1alpha1.AccountRole_ACCOUNT_ROLE_UNSPECIFIED))
+	assert.False(t, HasRole(mgmtv1alpha1.AccountRole_ACCOUNT_ROLE_VIEWER, mgmtv1alpha1.AccountRole_ACCOUNT_ROLE_JOB_OPERATOR))
+	assert.False(t, HasRole(mgmtv1alpha1.AccountRole_ACCOUNT_ROLE_ADMIN, mgmtv1alpha1.AccountRole_ACCOUNT_ROLE_OWNER))
+	assert.False(t, HasRole(mgmtv1alpha1.AccountRole_ACCOUNT_ROLE_UNSPECIFIED, mgmtv1alpha1.AccountRole_ACCOUNT_ROLE_VIEWER))
+}
+
+func Test_RequiredRoleCtx(t *testing.T) {
+	assert.Equal(t, mgmtv1alpha1.AccountRole_ACCOUNT_ROLE_UNSPECIFIED, GetRequiredRoleFromCtx(context.Background()))
+
+	ctx := SetRequiredRoleInCtx(context.Background(), mgmtv1alpha1.AccountRole_ACCOUNT_ROLE_ADMIN)
+	assert.Equal(t, mgmtv1alpha1.AccountRole_ACCOUNT_ROLE_ADMIN, GetRequiredRoleFromCtx(ctx))
+}
+
+func Test_GetRoleName(t *testing.T) {
+	assert.Equal(t, "job_operator", GetRoleName(mgmtv1alpha1.AccountRole_ACCOUNT_ROLE_JOB_OPERATOR))
+	assert.Equal(t, "owner", GetRoleName(mgmtv1alpha1.AccountRole_ACCOUNT_ROLE_OWNER))
+}
@@ -0,0 +1,82 @@
+package webhooks
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/netip"
+	"strings"
+	"syscall"
+	"time"
+)
+
+var (
+	ErrAddressNotAllowed = errors.New("webhooks may not be sent to loopback, private or link-local addresses")
+
+	// shared address space that is commonly used inside cloud and carrier networks
+	sharedAddressSpace = netip.MustParsePrefix("100.64.0.0/10")
+)
+
+// Returns true if webhooks may be sent to the address.
+// Loopback, private, link-local (ex: 169.254.169.254), unspecified and multicast addresses are not allowed so that
+// webhooks can not be used to reach services on the network of the api.
+func IsAllowedAddress(addr netip.Addr) bool {
+	addr = addr.Unmap()
+	return addr.IsValid() &&
+		!addr.IsLoopback() &&
+		!addr.IsPrivate() &&
+		!addr.IsLinkLocalUnicast() &&
+		!addr.IsLinkLocalMulticast() &&
+		!addr.IsInterfaceLocalMulticast() &&
+		!addr.IsMulticast() &&
+		!addr.IsUnspecified() &&
+		!sharedAddressSpace.Contains(addr)
+}
+
+// Returns an error if the host is an address or a well known name that webhooks may not be sent to.
+// Hosts that resolve to such an address are rejected when the webhook is sent.
+func VerifyHost(host string) error {
+	host = strings.TrimSuffix(strings.ToLower(host), ".")
+	if host == "localhost" || strings.HasSuffix(host, ".localhost") {
+		return ErrAddressNotAllowed
+	}
+	addr, err := netip.ParseAddr(strings.Trim(host, "[]"))
+	if err != nil {
+		return nil
+	}
+	if !IsAllowedAddress(addr) {
+		return ErrAddressNotAllowed
+	}
+	return nil
+}
+
+// Returns an http client that refuses to connect to addresses that are not allowed.
+// The address is verified after the host has been resolved, so hosts that resolve to a private address and redirects to them are also refused.
+func newRestrictedHttpClient(timeout time.Duration) *http.Client {
+	dialer := &net.Dialer{
+		Timeout:   timeout,
+		KeepAlive: 30 * time.Second,
+		Control:   verifyDialAddress,
+	}
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	// a proxy would be dialed instead of the webhook host, so the host could not be verified
+	transport.Proxy = nil
+	transport.DialContext = dialer.DialContext
+	return &http.Client{Timeout: timeout, Transport: transport}
+}
+
+func verifyDialAddress(network, address string, _ syscall.RawConn) error {
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return err
+	}
+	addr, err := netip.ParseAddr(host)
+	if err != nil {
+		return fmt.Errorf("unable to parse dialed address %s: %w", address, err)
+	}
+	if !IsAllowedAddress(addr) {
+		return ErrAddressNotAllowed
+	}
+	return nil
+}
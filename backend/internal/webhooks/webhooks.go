@@ -0,0 +1,317 @@
+package webhooks
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	db_queries "github.com/nucleuscloud/neosync/backend/gen/go/db"
+	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
+	"github.com/nucleuscloud/neosync/backend/internal/nucleusdb"
+)
+
+const (
+	SignatureHeader = "X-Neosync-Signature"
+	EventTypeHeader = "X-Neosync-Event"
+	EventIdHeader   = "X-Neosync-Event-Id"
+	secretPrefix    = "whsec_"
+	defaultTimeout  = 10 * time.Second
+)
+
+type queries interface {
+	GetEnabledWebhooksByEventType(ctx context.Context, db db_queries.DBTX, arg db_queries.GetEnabledWebhooksByEventTypeParams) ([]db_queries.NeosyncApiWebhook, error)
+	CreateWebhookDelivery(ctx context.Context, db db_queries.DBTX, arg db_queries.CreateWebhookDeliveryParams) (db_queries.NeosyncApiWebhookDelivery, error)
+}
+
+type Config struct {
+	// The number of times an event is sent to a webhook before giving up. Defaults to 5
+	MaxAttempts int
+	// The delay before the first retry, doubled for each retry after. Defaults to 5 seconds
+	InitialBackoff time.Duration
+	// The longest delay between retries. Defaults to 5 minutes
+	MaxBackoff time.Duration
+	// Defaults to a client with a 10 second timeout that can not connect to loopback, private or link-local addresses
+	HttpClient *http.Client
+	// Allows the default client to send webhooks to loopback, private and link-local addresses, ex: for self hosted receivers
+	AllowPrivateNetworks bool
+}
+
+// Delivers events to the webhooks of an account in the background, retrying with exponential backoff until the webhook
+// responds with a 2xx status code. Each attempt is recorded in the delivery log of the webhook.
+// Deliveries that are still waiting to be retried are abandoned when the dispatcher is closed.
+type Dispatcher struct {
+	q      queries
+	db     db_queries.DBTX
+	logger *slog.Logger
+
+	maxAttempts    int
+	initialBackoff time.Duration
+	maxBackoff     time.Duration
+	httpClient     *http.Client
+
+	// deliveries outlive the request that dispatched them, so they are bound to the lifetime of the dispatcher instead
+	ctx    context.Context
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+func NewDispatcher(q queries, db db_queries.DBTX, logger *slog.Logger, cfg *Config) *Dispatcher {
+	ctx, cancel := context.WithCancel(context.Background())
+	d := &Dispatcher{
+		q:              q,
+		db:             db,
+		logger:         logger,
+		ctx:            ctx,
+		cancel:         cancel,
+		maxAttempts:    5,
+		initialBackoff: 5 * time.Second,
+		maxBackoff:     5 * time.Minute,
+		httpClient:     newRestrictedHttpClient(defaultTimeout),
+	}
+	if cfg == nil {
+		return d
+	}
+	if cfg.AllowPrivateNetworks {
+		d.httpClient = &http.Client{Timeout: defaultTimeout}
+	}
+	if cfg.MaxAttempts > 0 {
+		d.maxAttempts = cfg.MaxAttempts
+	}
+	if cfg.InitialBackoff > 0 {
+		d.initialBackoff = cfg.InitialBackoff
+	}
+	if cfg.MaxBackoff > 0 {
+		d.maxBackoff = cfg.MaxBackoff
+	}
+	if cfg.HttpClient != nil {
+		d.httpClient = cfg.HttpClient
+	}
+	return d
+}
+
+// The JSON body that is sent to a webhook
+type Event struct {
+	Id        string         `json:"id"`
+	Type      string         `json:"type"`
+	AccountId string         `json:"accountId"`
+	CreatedAt time.Time      `json:"createdAt"`
+	Data      map[string]any `json:"data"`
+
+	eventType mgmtv1alpha1.WebhookEventType
+}
+
+// Creates an event of the given type, ex: job_run.succeeded
+func NewEvent(eventType mgmtv1alpha1.WebhookEventType, accountId string, data map[string]any) *Event {
+	return &Event{
+		Id:        uuid.NewString(),
+		Type:      GetEventTypeName(eventType),
+		AccountId: accountId,
+		CreatedAt: time.Now().UTC(),
+		Data:      data,
+		eventType: eventType,
+	}
+}
+
+// Returns the name an event type is sent as, ex: job_run.schema_drift_halted
+func GetEventTypeName(eventType mgmtv1alpha1.WebhookEventType) string {
+	return "job_run." + strings.ToLower(strings.TrimPrefix(eventType.String(), "WEBHOOK_EVENT_TYPE_JOB_RUN_"))
+}
+
+// Sends the event to every enabled webhook of the account that is subscribed to it.
+// The webhooks are looked up before returning, but the event is delivered in the background.
+// Returns the number of webhooks the event is being sent to.
+func (d *Dispatcher) Dispatch(ctx context.Context, event *Event) (int, error) {
+	accountUuid, err := nucleusdb.ToUuid(event.AccountId)
+	if err != nil {
+		return 0, err
+	}
+	hooks, err := d.q.GetEnabledWebhooksByEventType(ctx, d.db, db_queries.GetEnabledWebhooksByEventTypeParams{
+		AccountId: accountUuid,
+		EventType: int32(event.eventType),
+	})
+	if err != nil {
+		return 0, fmt.Errorf("unable to retrieve webhooks: %w", err)
+	}
+	if len(hooks) == 0 {
+		return 0, nil
+	}
+	payload, err := json.Marshal(event)
+	if err != nil {
+		return 0, fmt.Errorf("unable to marshal webhook event: %w", err)
+	}
+	eventUuid, err := nucleusdb.ToUuid(event.Id)
+	if err != nil {
+		return 0, err
+	}
+
+	for idx := range hooks {
+		hook := hooks[idx]
+		d.wg.Add(1)
+		go func() {
+			defer d.wg.Done()
+			d.deliver(d.ctx, &hook, event, eventUuid, payload)
+		}()
+	}
+	return len(hooks), nil
+}
+
+// Blocks until every event that has been dispatched has been delivered or has run out of attempts
+func (d *Dispatcher) Wait() {
+	d.wg.Wait()
+}
+
+// Stops every delivery that is in progress or waiting to be retried and blocks until they have returned
+func (d *Dispatcher) Close() {
+	d.cancel()
+	d.wg.Wait()
+}
+
+func (d *Dispatcher) deliver(
+	ctx context.Context,
+	hook *db_queries.NeosyncApiWebhook,
+	event *Event,
+	eventUuid pgtype.UUID,
+	payload []byte,
+) {
+	logger := d.logger.With("webhookId", nucleusdb.UUIDString(hook.ID), "eventId", event.Id, "eventType", event.Type)
+	for attempt := 1; attempt <= d.maxAttempts; attempt++ {
+		statusCode, err := d.send(ctx, hook, event, payload)
+		params := db_queries.CreateWebhookDeliveryParams{
+			WebhookID: hook.ID,
+			EventID:   eventUuid,
+			EventType: int32(event.eventType),
+			Payload:   payload,
+			Attempt:   int32(attempt),
+			Success:   err == nil,
+		}
+		if statusCode > 0 {
+			params.StatusCode = pgtype.Int4{Int32: int32(statusCode), Valid: true}
+		}
+		if err != nil {
+			params.Error = pgtype.Text{String: err.Error(), Valid: true}
+		}
+		if _, logErr := d.q.CreateWebhookDelivery(ctx, d.db, params); logErr != nil {
+			logger.Error(fmt.Sprintf("unable to record webhook delivery: %s", logErr.Error()))
+		}
+
+		if err == nil {
+			return
+		}
+		if attempt == d.maxAttempts {
+			logger.Warn(fmt.Sprintf("giving up on webhook delivery after %d attempts: %s", attempt, err.Error()))
+			return
+		}
+		timer := time.NewTimer(d.getBackoff(attempt))
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			logger.Warn(fmt.Sprintf("abandoning webhook delivery after %d attempts as the dispatcher has been closed", attempt))
+			return
+		case <-timer.C:
+		}
+	}
+}
+
+// Returns the delay after the given attempt, doubling from the initial backoff up to the max backoff
+func (d *Dispatcher) getBackoff(attempt int) time.Duration {
+	backoff := d.initialBackoff
+	for i := 1; i < attempt && backoff < d.maxBackoff; i++ {
+		backoff *= 2
+	}
+	return min(backoff, d.maxBackoff)
+}
+
+// Returns the status code of the response, or 0 if no response was received
+func (d *Dispatcher) send(ctx context.Context, hook *db_queries.NeosyncApiWebhook, event *Event, payload []byte) (int, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, hook.Url, bytes.NewReader(payload))
+	if err != nil {
+		return 0, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", "Neosync-Webhooks")
+	req.Header.Set(EventTypeHeader, event.Type)
+	req.Header.Set(EventIdHeader, event.Id)
+	req.Header.Set(SignatureHeader, Sign(hook.Secret, time.Now().Unix(), payload))
+
+	resp, err := d.httpClient.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+	// the response body is not recorded as the delivery log is readable by every member of the account
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return resp.StatusCode, fmt.Errorf("webhook responded with status code %d", resp.StatusCode)
+	}
+	return resp.StatusCode, nil
+}
+
+// Returns the value of the signature header, in the form t=<unix timestamp>,v1=<hex hmac>.
+// The signature is the HMAC-SHA256 of "<timestamp>.<body>" so that receivers can reject replayed payloads.
+func Sign(secret string, timestamp int64, payload []byte) string {
+	return fmt.Sprintf("t=%d,v1=%s", timestamp, computeSignature(secret, timestamp, payload))
+}
+
+// Returns true if the signature header is valid for the payload and was created within the tolerance
+func VerifySignature(secret, header string, payload []byte, tolerance time.Duration) bool {
+	var timestamp int64
+	var signatures []string
+	for _, part := range strings.Split(header, ",") {
+		key, value, found := strings.Cut(part, "=")
+		if !found {
+			continue
+		}
+		switch key {
+		case "t":
+			ts, err := strconv.ParseInt(value, 10, 64)
+			if err != nil {
+				return false
+			}
+			timestamp = ts
+		case "v1":
+			signatures = append(signatures, value)
+		}
+	}
+	if timestamp == 0 || len(signatures) == 0 {
+		return false
+	}
+	if tolerance > 0 && time.Since(time.Unix(timestamp, 0)).Abs() > tolerance {
+		return false
+	}
+	expected := computeSignature(secret, timestamp, payload)
+	for _, signature := range signatures {
+		if hmac.Equal([]byte(signature), []byte(expected)) {
+			return true
+		}
+	}
+	return false
+}
+
+func computeSignature(secret string, timestamp int64, payload []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	fmt.Fprintf(mac, "%d.", timestamp)
+	mac.Write(payload)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// Generates a random secret to sign payloads with
+func NewSecret() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return secretPrefix + base64.RawURLEncoding.EncodeToString(b), nil
+}
@@ -0,0 +1,298 @@
+package webhooks
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"net/netip"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	db_queries "github.com/nucleuscloud/neosync/backend/gen/go/db"
+	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
+	"github.com/nucleuscloud/neosync/backend/internal/nucleusdb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+const testSecret = "whsec_test-secret"
+
+func Test_Dispatcher_Dispatch_RetriesUntilSuccess(t *testing.T) {
+	var requests atomic.Int32
+	var mu sync.Mutex
+	var received []*http.Request
+	var bodies [][]byte
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		mu.Lock()
+		received = append(received, r)
+		bodies = append(bodies, body)
+		mu.Unlock()
+		if requests.Add(1) < 3 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	mockQuerier := db_queries.NewMockQuerier(t)
+	mockDbtx := nucleusdb.NewMockDBTX(t)
+	accountId := uuid.NewString()
+	hook := newWebhook(t, accountId, server.URL)
+	mockQuerier.On("GetEnabledWebhooksByEventType", mock.Anything, mock.Anything, mock.Anything).
+		Return([]db_queries.NeosyncApiWebhook{hook}, nil)
+
+	var deliveries []db_queries.CreateWebhookDeliveryParams
+	mockQuerier.On("CreateWebhookDelivery", mock.Anything, mock.Anything, mock.Anything).
+		Run(func(args mock.Arguments) {
+			mu.Lock()
+			defer mu.Unlock()
+			deliveries = append(deliveries, args.Get(2).(db_queries.CreateWebhookDeliveryParams))
+		}).
+		Return(db_queries.NeosyncApiWebhookDelivery{}, nil)
+
+	dispatcher := NewDispatcher(mockQuerier, mockDbtx, slog.Default(), &Config{InitialBackoff: time.Millisecond, MaxBackoff: time.Millisecond, AllowPrivateNetworks: true})
+	event := NewEvent(mgmtv1alpha1.WebhookEventType_WEBHOOK_EVENT_TYPE_JOB_RUN_FAILED, accountId, map[string]any{"jobId": "123", "error": "boom"})
+	count, err := dispatcher.Dispatch(context.Background(), event)
+	require.NoError(t, err)
+	assert.Equal(t, 1, count)
+	dispatcher.Wait()
+
+	require.Len(t, deliveries, 3)
+	for idx, delivery := range deliveries {
+		assert.Equal(t, int32(idx+1), delivery.Attempt)
+		assert.Equal(t, int32(mgmtv1alpha1.WebhookEventType_WEBHOOK_EVENT_TYPE_JOB_RUN_FAILED), delivery.EventType)
+		assert.Equal(t, event.Id, nucleusdb.UUIDString(delivery.EventID))
+	}
+	assert.False(t, deliveries[0].Success)
+	assert.Equal(t, int32(http.StatusServiceUnavailable), deliveries[0].StatusCode.Int32)
+	assert.True(t, deliveries[0].Error.Valid)
+	assert.True(t, deliveries[2].Success)
+	assert.False(t, deliveries[2].Error.Valid)
+
+	require.Len(t, received, 3)
+	req := received[2]
+	assert.Equal(t, "job_run.failed", req.Header.Get(EventTypeHeader))
+	assert.Equal(t, event.Id, req.Header.Get(EventIdHeader))
+	assert.True(t, VerifySignature(testSecret, req.Header.Get(SignatureHeader), bodies[2], time.Minute))
+
+	var payload Event
+	require.NoError(t, json.Unmarshal(bodies[2], &payload))
+	assert.Equal(t, "job_run.failed", payload.Type)
+	assert.Equal(t, accountId, payload.AccountId)
+	assert.Equal(t, "boom", payload.Data["error"])
+}
+
+func Test_Dispatcher_Dispatch_GivesUp(t *testing.T) {
+	var requests atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer server.Close()
+
+	mockQuerier := db_queries.NewMockQuerier(t)
+	mockDbtx := nucleusdb.NewMockDBTX(t)
+	accountId := uuid.NewString()
+	mockQuerier.On("GetEnabledWebhooksByEventType", mock.Anything, mock.Anything, mock.Anything).
+		Return([]db_queries.NeosyncApiWebhook{newWebhook(t, accountId, server.URL)}, nil)
+	mockQuerier.On("CreateWebhookDelivery", mock.Anything, mock.Anything, mock.Anything).
+		Return(db_queries.NeosyncApiWebhookDelivery{}, nil).Times(2)
+
+	dispatcher := NewDispatcher(mockQuerier, mockDbtx, slog.Default(), &Config{MaxAttempts: 2, InitialBackoff: time.Millisecond, AllowPrivateNetworks: true})
+	_, err := dispatcher.Dispatch(context.Background(), NewEvent(mgmtv1alpha1.WebhookEventType_WEBHOOK_EVENT_TYPE_JOB_RUN_STARTED, accountId, nil))
+	require.NoError(t, err)
+	dispatcher.Wait()
+	assert.Equal(t, int32(2), requests.Load())
+}
+
+func Test_Dispatcher_Close_AbandonsRetries(t *testing.T) {
+	var requests atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer server.Close()
+
+	mockQuerier := db_queries.NewMockQuerier(t)
+	mockDbtx := nucleusdb.NewMockDBTX(t)
+	accountId := uuid.NewString()
+	mockQuerier.On("GetEnabledWebhooksByEventType", mock.Anything, mock.Anything, mock.Anything).
+		Return([]db_queries.NeosyncApiWebhook{newWebhook(t, accountId, server.URL)}, nil)
+	recorded := make(chan struct{}, 1)
+	mockQuerier.On("CreateWebhookDelivery", mock.Anything, mock.Anything, mock.Anything).
+		Run(func(args mock.Arguments) { recorded <- struct{}{} }).
+		Return(db_queries.NeosyncApiWebhookDelivery{}, nil).Once()
+
+	dispatcher := NewDispatcher(mockQuerier, mockDbtx, slog.Default(), &Config{InitialBackoff: time.Hour, AllowPrivateNetworks: true})
+	_, err := dispatcher.Dispatch(context.Background(), NewEvent(mgmtv1alpha1.WebhookEventType_WEBHOOK_EVENT_TYPE_JOB_RUN_STARTED, accountId, nil))
+	require.NoError(t, err)
+	<-recorded
+
+	closed := make(chan struct{})
+	go func() {
+		dispatcher.Close()
+		close(closed)
+	}()
+	select {
+	case <-closed:
+	case <-time.After(5 * time.Second):
+		t.Fatal("dispatcher did not stop waiting to retry the delivery")
+	}
+	assert.Equal(t, int32(1), requests.Load())
+}
+
+func Test_Dispatcher_Dispatch_DoesNotRecordResponseBody(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusForbidden)
+		_, _ = w.Write([]byte("internal secret"))
+	}))
+	defer server.Close()
+
+	mockQuerier := db_queries.NewMockQuerier(t)
+	mockDbtx := nucleusdb.NewMockDBTX(t)
+	accountId := uuid.NewString()
+	mockQuerier.On("GetEnabledWebhooksByEventType", mock.Anything, mock.Anything, mock.Anything).
+		Return([]db_queries.NeosyncApiWebhook{newWebhook(t, accountId, server.URL)}, nil)
+	var delivery db_queries.CreateWebhookDeliveryParams
+	mockQuerier.On("CreateWebhookDelivery", mock.Anything, mock.Anything, mock.Anything).
+		Run(func(args mock.Arguments) {
+			delivery = args.Get(2).(db_queries.CreateWebhookDeliveryParams)
+		}).
+		Return(db_queries.NeosyncApiWebhookDelivery{}, nil).Once()
+
+	dispatcher := NewDispatcher(mockQuerier, mockDbtx, slog.Default(), &Config{MaxAttempts: 1, AllowPrivateNetworks: true})
+	_, err := dispatcher.Dispatch(context.Background(), NewEvent(mgmtv1alpha1.WebhookEventType_WEBHOOK_EVENT_TYPE_JOB_RUN_STARTED, accountId, nil))
+	require.NoError(t, err)
+	dispatcher.Wait()
+	assert.Equal(t, int32(http.StatusForbidden), delivery.StatusCode.Int32)
+	assert.NotContains(t, delivery.Error.String, "internal secret")
+}
+
+func Test_Dispatcher_Dispatch_PrivateAddress(t *testing.T) {
+	var requests atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	// hosts are verified once they have been resolved, so names that resolve to loopback are refused as well
+	for _, hookUrl := range []string{server.URL, strings.Replace(server.URL, "127.0.0.1", "localhost", 1)} {
+		mockQuerier := db_queries.NewMockQuerier(t)
+		mockDbtx := nucleusdb.NewMockDBTX(t)
+		accountId := uuid.NewString()
+		mockQuerier.On("GetEnabledWebhooksByEventType", mock.Anything, mock.Anything, mock.Anything).
+			Return([]db_queries.NeosyncApiWebhook{newWebhook(t, accountId, hookUrl)}, nil)
+		var delivery db_queries.CreateWebhookDeliveryParams
+		mockQuerier.On("CreateWebhookDelivery", mock.Anything, mock.Anything, mock.Anything).
+			Run(func(args mock.Arguments) {
+				delivery = args.Get(2).(db_queries.CreateWebhookDeliveryParams)
+			}).
+			Return(db_queries.NeosyncApiWebhookDelivery{}, nil).Once()
+
+		dispatcher := NewDispatcher(mockQuerier, mockDbtx, slog.Default(), &Config{MaxAttempts: 1})
+		_, err := dispatcher.Dispatch(context.Background(), NewEvent(mgmtv1alpha1.WebhookEventType_WEBHOOK_EVENT_TYPE_JOB_RUN_STARTED, accountId, nil))
+		require.NoError(t, err)
+		dispatcher.Wait()
+		assert.False(t, delivery.Success, hookUrl)
+		assert.Contains(t, delivery.Error.String, ErrAddressNotAllowed.Error(), hookUrl)
+	}
+	assert.Equal(t, int32(0), requests.Load())
+}
+
+func Test_IsAllowedAddress(t *testing.T) {
+	disallowed := []string{"127.0.0.1", "10.1.2.3", "172.16.0.1", "192.168.0.1", "169.254.169.254", "100.64.0.1", "0.0.0.0", "::1", "fe80::1", "fd00::1", "::ffff:10.0.0.1", "224.0.0.1"}
+	for _, addr := range disallowed {
+		assert.False(t, IsAllowedAddress(netip.MustParseAddr(addr)), addr)
+	}
+	for _, addr := range []string{"93.184.216.34", "2606:4700::1111"} {
+		assert.True(t, IsAllowedAddress(netip.MustParseAddr(addr)), addr)
+	}
+}
+
+func Test_VerifyHost(t *testing.T) {
+	assert.ErrorIs(t, VerifyHost("localhost"), ErrAddressNotAllowed)
+	assert.ErrorIs(t, VerifyHost("api.localhost"), ErrAddressNotAllowed)
+	assert.ErrorIs(t, VerifyHost("169.254.169.254"), ErrAddressNotAllowed)
+	assert.ErrorIs(t, VerifyHost("[::1]"), ErrAddressNotAllowed)
+	assert.NoError(t, VerifyHost("hooks.example.com"))
+	assert.NoError(t, VerifyHost("93.184.216.34"))
+}
+
+func Test_Dispatcher_Dispatch_NoWebhooks(t *testing.T) {
+	mockQuerier := db_queries.NewMockQuerier(t)
+	mockDbtx := nucleusdb.NewMockDBTX(t)
+	accountId := uuid.NewString()
+	var params db_queries.GetEnabledWebhooksByEventTypeParams
+	mockQuerier.On("GetEnabledWebhooksByEventType", mock.Anything, mock.Anything, mock.Anything).
+		Run(func(args mock.Arguments) {
+			params = args.Get(2).(db_queries.GetEnabledWebhooksByEventTypeParams)
+		}).
+		Return([]db_queries.NeosyncApiWebhook{}, nil)
+
+	dispatcher := NewDispatcher(mockQuerier, mockDbtx, slog.Default(), nil)
+	count, err := dispatcher.Dispatch(context.Background(), NewEvent(mgmtv1alpha1.WebhookEventType_WEBHOOK_EVENT_TYPE_JOB_RUN_CANCELED, accountId, nil))
+	require.NoError(t, err)
+	assert.Equal(t, 0, count)
+	assert.Equal(t, accountId, nucleusdb.UUIDString(params.AccountId))
+	assert.Equal(t, int32(mgmtv1alpha1.WebhookEventType_WEBHOOK_EVENT_TYPE_JOB_RUN_CANCELED), params.EventType)
+}
+
+func Test_Dispatcher_getBackoff(t *testing.T) {
+	dispatcher := NewDispatcher(nil, nil, slog.Default(), &Config{InitialBackoff: time.Second, MaxBackoff: 5 * time.Second})
+	assert.Equal(t, time.Second, dispatcher.getBackoff(1))
+	assert.Equal(t, 2*time.Second, dispatcher.getBackoff(2))
+	assert.Equal(t, 4*time.Second, dispatcher.getBackoff(3))
+	assert.Equal(t, 5*time.Second, dispatcher.getBackoff(4))
+	assert.Equal(t, 5*time.Second, dispatcher.getBackoff(10))
+}
+
+func Test_Sign_VerifySignature(t *testing.T) {
+	payload := []byte(`{"type":"job_run.succeeded"}`)
+	now := time.Now().Unix()
+	header := Sign(testSecret, now, payload)
+
+	assert.True(t, VerifySignature(testSecret, header, payload, time.Minute))
+	assert.False(t, VerifySignature("whsec_other", header, payload, time.Minute))
+	assert.False(t, VerifySignature(testSecret, header, []byte(`{"type":"job_run.failed"}`), time.Minute))
+	assert.False(t, VerifySignature(testSecret, Sign(testSecret, now-3600, payload), payload, time.Minute), "old signatures must be rejected")
+	assert.False(t, VerifySignature(testSecret, "v1=abc", payload, time.Minute))
+}
+
+func Test_GetEventTypeName(t *testing.T) {
+	assert.Equal(t, "job_run.started", GetEventTypeName(mgmtv1alpha1.WebhookEventType_WEBHOOK_EVENT_TYPE_JOB_RUN_STARTED))
+	assert.Equal(t, "job_run.succeeded", GetEventTypeName(mgmtv1alpha1.WebhookEventType_WEBHOOK_EVENT_TYPE_JOB_RUN_SUCCEEDED))
+	assert.Equal(t, "job_run.schema_drift_halted", GetEventTypeName(mgmtv1alpha1.WebhookEventType_WEBHOOK_EVENT_TYPE_JOB_RUN_SCHEMA_DRIFT_HALTED))
+}
+
+func Test_NewSecret(t *testing.T) {
+	secret, err := NewSecret()
+	require.NoError(t, err)
+	assert.Regexp(t, "^whsec_[A-Za-z0-9_-]{43}$", secret)
+}
+
+func newWebhook(t *testing.T, accountId, url string) db_queries.NeosyncApiWebhook {
+	t.Helper()
+	id, err := nucleusdb.ToUuid(uuid.NewString())
+	require.NoError(t, err)
+	accountUuid, err := nucleusdb.ToUuid(accountId)
+	require.NoError(t, err)
+	return db_queries.NeosyncApiWebhook{
+		ID:        id,
+		AccountID: accountUuid,
+		Name:      "test-hook",
+		Url:       url,
+		Secret:    testSecret,
+		Enabled:   true,
+	}
+}
@@ -0,0 +1,437 @@
+package dbschemas_mssql
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"slices"
+	"strings"
+
+	dbschemas "github.com/nucleuscloud/neosync/backend/pkg/dbschemas"
+	"golang.org/x/sync/errgroup"
+)
+
+func GetTableCreateStatement(
+	ctx context.Context,
+	conn DBTX,
+	q Querier,
+	schema string,
+	table string,
+) (string, error) {
+	errgrp, errctx := errgroup.WithContext(ctx)
+
+	var tableSchemas []*GetDatabaseSchemaRow
+	errgrp.Go(func() error {
+		result, err := q.GetDatabaseTableSchema(errctx, conn, &GetDatabaseTableSchemaParams{
+			Schema: schema,
+			Table:  table,
+		})
+		if err != nil {
+			return fmt.Errorf("unable to generate database table schema: %w", err)
+		}
+		tableSchemas = result
+		return nil
+	})
+	var primaryKeys []*GetPrimaryKeyConstraintsRow
+	errgrp.Go(func() error {
+		result, err := q.GetPrimaryKeyConstraints(errctx, conn, schema)
+		if err != nil {
+			return fmt.Errorf("unable to retrieve primary key constraints: %w", err)
+		}
+		primaryKeys = result
+		return nil
+	})
+	var uniqueConstraints []*GetUniqueConstraintsRow
+	errgrp.Go(func() error {
+		result, err := q.GetUniqueConstraints(errctx, conn, schema)
+		if err != nil {
+			return fmt.Errorf("unable to retrieve unique constraints: %w", err)
+		}
+		uniqueConstraints = result
+		return nil
+	})
+	var foreignKeys []*GetForeignKeyConstraintsRow
+	errgrp.Go(func() error {
+		result, err := q.GetForeignKeyConstraints(errctx, conn, schema)
+		if err != nil {
+			return fmt.Errorf("unable to retrieve foreign key constraints: %w", err)
+		}
+		foreignKeys = result
+		return nil
+	})
+	if err := errgrp.Wait(); err != nil {
+		return "", err
+	}
+
+	return generateCreateTableStatement(
+		schema,
+		table,
+		tableSchemas,
+		buildTableConstraints(schema, table, primaryKeys, uniqueConstraints, foreignKeys),
+	), nil
+}
+
+// This assumes that the schemas are for a single table, not an entire db schema
+func generateCreateTableStatement(
+	schema string,
+	table string,
+	tableSchemas []*GetDatabaseSchemaRow,
+	tableConstraints []string,
+) string {
+	columns := make([]string, len(tableSchemas))
+	for idx := range tableSchemas {
+		columns[idx] = buildTableCol(tableSchemas[idx])
+	}
+	tableDefs := append(columns, tableConstraints...) //nolint:gocritic
+	// sql server does not support CREATE TABLE IF NOT EXISTS
+	return fmt.Sprintf(
+		"IF OBJECT_ID(N'%s', N'U') IS NULL CREATE TABLE %s (%s);",
+		strings.ReplaceAll(EscapeMssqlTable(schema, table), "'", "''"),
+		EscapeMssqlTable(schema, table),
+		strings.Join(tableDefs, ", "),
+	)
+}
+
+// Builds the constraint definitions for a single table from the schema wide constraint lists
+func buildTableConstraints(
+	schema string,
+	table string,
+	primaryKeys []*GetPrimaryKeyConstraintsRow,
+	uniqueConstraints []*GetUniqueConstraintsRow,
+	foreignKeys []*GetForeignKeyConstraintsRow,
+) []string {
+	constraints := []string{}
+
+	pkCols := map[string][]string{}
+	pkNames := []string{}
+	for _, pk := range primaryKeys {
+		if pk.SchemaName != schema || pk.TableName != table {
+			continue
+		}
+		if _, ok := pkCols[pk.ConstraintName]; !ok {
+			pkNames = append(pkNames, pk.ConstraintName)
+		}
+		pkCols[pk.ConstraintName] = append(pkCols[pk.ConstraintName], pk.ColumnName)
+	}
+	for _, name := range pkNames {
+		constraints = append(constraints, fmt.Sprintf("CONSTRAINT %s PRIMARY KEY (%s)", EscapeMssqlColumn(name), strings.Join(EscapeMssqlColumns(pkCols[name]), ", ")))
+	}
+
+	uniqueCols := map[string][]string{}
+	uniqueNames := []string{}
+	for _, uc := range uniqueConstraints {
+		if uc.SchemaName != schema || uc.TableName != table {
+			continue
+		}
+		if _, ok := uniqueCols[uc.ConstraintName]; !ok {
+			uniqueNames = append(uniqueNames, uc.ConstraintName)
+		}
+		uniqueCols[uc.ConstraintName] = append(uniqueCols[uc.ConstraintName], uc.ColumnName)
+	}
+	for _, name := range uniqueNames {
+		constraints = append(constraints, fmt.Sprintf("CONSTRAINT %s UNIQUE (%s)", EscapeMssqlColumn(name), strings.Join(EscapeMssqlColumns(uniqueCols[name]), ", ")))
+	}
+
+	fkRows := map[string][]*GetForeignKeyConstraintsRow{}
+	fkNames := []string{}
+	for _, fk := range foreignKeys {
+		if fk.SchemaName != schema || fk.TableName != table {
+			continue
+		}
+		if _, ok := fkRows[fk.ConstraintName]; !ok {
+			fkNames = append(fkNames, fk.ConstraintName)
+		}
+		fkRows[fk.ConstraintName] = append(fkRows[fk.ConstraintName], fk)
+	}
+	for _, name := range fkNames {
+		rows := fkRows[name]
+		cols := make([]string, len(rows))
+		foreignCols := make([]string, len(rows))
+		for idx, row := range rows {
+			cols[idx] = row.ColumnName
+			foreignCols[idx] = row.ForeignColumnName
+		}
+		constraints = append(constraints, fmt.Sprintf(
+			"CONSTRAINT %s FOREIGN KEY (%s) REFERENCES %s (%s)",
+			EscapeMssqlColumn(name),
+			strings.Join(EscapeMssqlColumns(cols), ", "),
+			EscapeMssqlTable(rows[0].ForeignSchemaName, rows[0].ForeignTableName),
+			strings.Join(EscapeMssqlColumns(foreignCols), ", "),
+		))
+	}
+	return constraints
+}
+
+func buildTableCol(record *GetDatabaseSchemaRow) string {
+	pieces := []string{EscapeMssqlColumn(record.ColumnName), buildDataType(record)}
+	if record.IsIdentity {
+		pieces = append(pieces, "IDENTITY(1,1)")
+	}
+	pieces = append(pieces, buildNullableText(record))
+	if record.ColumnDefault != "" && record.ColumnDefault != "NULL" && !record.IsIdentity {
+		pieces = append(pieces, "DEFAULT", record.ColumnDefault)
+	}
+	return strings.Join(pieces, " ")
+}
+
+var (
+	// data types that take a character length. a length of -1 denotes (max)
+	lengthDataTypes = []string{"char", "varchar", "nchar", "nvarchar", "binary", "varbinary"}
+	// data types that take a precision and scale
+	precisionDataTypes = []string{"decimal", "numeric"}
+)
+
+func buildDataType(record *GetDatabaseSchemaRow) string {
+	if slices.Contains(lengthDataTypes, record.DataType) && record.CharacterMaximumLength.Valid {
+		if record.CharacterMaximumLength.Int32 == -1 {
+			return fmt.Sprintf("%s(max)", record.DataType)
+		}
+		return fmt.Sprintf("%s(%d)", record.DataType, record.CharacterMaximumLength.Int32)
+	}
+	if slices.Contains(precisionDataTypes, record.DataType) && record.NumericPrecision.Valid {
+		return fmt.Sprintf("%s(%d,%d)", record.DataType, record.NumericPrecision.Int32, record.NumericScale.Int32)
+	}
+	return record.DataType
+}
+
+func buildNullableText(record *GetDatabaseSchemaRow) string {
+	if record.IsNullable == "NO" {
+		return "NOT NULL"
+	}
+	return "NULL"
+}
+
+// Key is schema.table value is list of tables that key depends on
+func GetMssqlTableDependencies(
+	constraints []*GetForeignKeyConstraintsRow,
+) dbschemas.TableDependency {
+	tableConstraints := map[string]*dbschemas.TableConstraints{}
+	for _, c := range constraints {
+		tableName := dbschemas.BuildTable(c.SchemaName, c.TableName)
+
+		constraint, ok := tableConstraints[tableName]
+		if !ok {
+			tableConstraints[tableName] = &dbschemas.TableConstraints{
+				Constraints: []*dbschemas.ForeignConstraint{
+					{Column: c.ColumnName, IsNullable: dbschemas.ConvertIsNullableToBool(c.IsNullable), ForeignKey: &dbschemas.ForeignKey{
+						Table:  dbschemas.BuildTable(c.ForeignSchemaName, c.ForeignTableName),
+						Column: c.ForeignColumnName,
+					}},
+				},
+			}
+		} else {
+			constraint.Constraints = append(constraint.Constraints, &dbschemas.ForeignConstraint{
+				Column: c.ColumnName, IsNullable: dbschemas.ConvertIsNullableToBool(c.IsNullable), ForeignKey: &dbschemas.ForeignKey{
+					Table:  dbschemas.BuildTable(c.ForeignSchemaName, c.ForeignTableName),
+					Column: c.ForeignColumnName,
+				},
+			})
+		}
+	}
+	return tableConstraints
+}
+
+func GetMssqlTablePrimaryKeys(
+	primaryKeyConstraints []*GetPrimaryKeyConstraintsRow,
+) map[string][]string {
+	pkMap := map[string][]string{}
+	for _, c := range primaryKeyConstraints {
+		key := dbschemas.BuildTable(c.SchemaName, c.TableName)
+		pkMap[key] = append(pkMap[key], c.ColumnName)
+	}
+	return pkMap
+}
+
+func GetMssqlTableUniqueConstraints(
+	uniqueConstraints []*GetUniqueConstraintsRow,
+) map[string][]string {
+	ucMap := map[string][]string{}
+	for _, c := range uniqueConstraints {
+		key := dbschemas.BuildTable(c.SchemaName, c.TableName)
+		ucMap[key] = append(ucMap[key], c.ColumnName)
+	}
+	return ucMap
+}
+
+func GetUniqueSchemaColMappings(
+	schemas []*GetDatabaseSchemaRow,
+) map[string]map[string]*dbschemas.ColumnInfo {
+	groupedSchemas := map[string]map[string]*dbschemas.ColumnInfo{} // ex: {dbo.users: { id: struct{}{}, created_at: struct{}{}}}
+	for _, record := range schemas {
+		key := dbschemas.BuildTable(record.TableSchema, record.TableName)
+		if _, ok := groupedSchemas[key]; ok {
+			groupedSchemas[key][record.ColumnName] = toColumnInfo(record)
+		} else {
+			groupedSchemas[key] = map[string]*dbschemas.ColumnInfo{
+				record.ColumnName: toColumnInfo(record),
+			}
+		}
+	}
+	return groupedSchemas
+}
+
+func toColumnInfo(row *GetDatabaseSchemaRow) *dbschemas.ColumnInfo {
+	return &dbschemas.ColumnInfo{
+		OrdinalPosition:        row.OrdinalPosition,
+		ColumnDefault:          row.ColumnDefault,
+		IsNullable:             row.IsNullable,
+		DataType:               row.DataType,
+		CharacterMaximumLength: fromSqlNullInt32(row.CharacterMaximumLength),
+		NumericPrecision:       fromSqlNullInt32(row.NumericPrecision),
+		NumericScale:           fromSqlNullInt32(row.NumericScale),
+		IsIdentity:             row.IsIdentity,
+	}
+}
+
+func fromSqlNullInt32(nullInt sql.NullInt32) *int32 {
+	if nullInt.Valid {
+		return &nullInt.Int32
+	}
+	return nil
+}
+
+func GetAllMssqlFkConstraints(
+	mssqlquerier Querier,
+	ctx context.Context,
+	conn DBTX,
+	schemas []string,
+) ([]*GetForeignKeyConstraintsRow, error) {
+	holder := make([][]*GetForeignKeyConstraintsRow, len(schemas))
+	errgrp, errctx := errgroup.WithContext(ctx)
+	for idx := range schemas {
+		idx := idx
+		schema := schemas[idx]
+		errgrp.Go(func() error {
+			constraints, err := mssqlquerier.GetForeignKeyConstraints(errctx, conn, schema)
+			if err != nil {
+				return err
+			}
+			holder[idx] = constraints
+			return nil
+		})
+	}
+
+	if err := errgrp.Wait(); err != nil {
+		return nil, err
+	}
+
+	output := []*GetForeignKeyConstraintsRow{}
+	for _, schemas := range holder {
+		output = append(output, schemas...)
+	}
+	return output, nil
+}
+
+func GetAllMssqlPkConstraints(
+	mssqlquerier Querier,
+	ctx context.Context,
+	conn DBTX,
+	schemas []string,
+) ([]*GetPrimaryKeyConstraintsRow, error) {
+	holder := make([][]*GetPrimaryKeyConstraintsRow, len(schemas))
+	errgrp, errctx := errgroup.WithContext(ctx)
+	for idx := range schemas {
+		idx := idx
+		schema := schemas[idx]
+		errgrp.Go(func() error {
+			constraints, err := mssqlquerier.GetPrimaryKeyConstraints(errctx, conn, schema)
+			if err != nil {
+				return err
+			}
+			holder[idx] = constraints
+			return nil
+		})
+	}
+
+	if err := errgrp.Wait(); err != nil {
+		return nil, err
+	}
+
+	output := []*GetPrimaryKeyConstraintsRow{}
+	for _, schemas := range holder {
+		output = append(output, schemas...)
+	}
+	return output, nil
+}
+
+func GetAllMssqlUniqueConstraints(
+	mssqlquerier Querier,
+	ctx context.Context,
+	conn DBTX,
+	schemas []string,
+) ([]*GetUniqueConstraintsRow, error) {
+	holder := make([][]*GetUniqueConstraintsRow, len(schemas))
+	errgrp, errctx := errgroup.WithContext(ctx)
+	for idx := range schemas {
+		idx := idx
+		schema := schemas[idx]
+		errgrp.Go(func() error {
+			constraints, err := mssqlquerier.GetUniqueConstraints(errctx, conn, schema)
+			if err != nil {
+				return err
+			}
+			holder[idx] = constraints
+			return nil
+		})
+	}
+
+	if err := errgrp.Wait(); err != nil {
+		return nil, err
+	}
+
+	output := []*GetUniqueConstraintsRow{}
+	for _, schemas := range holder {
+		output = append(output, schemas...)
+	}
+	return output, nil
+}
+
+// SQL Server does not allow truncating a table that is referenced by a foreign key, even if the constraint is disabled.
+// A DELETE is used instead, so these statements must be run in reverse dependency order.
+func BuildTruncateStatement(
+	schema string,
+	table string,
+) string {
+	return fmt.Sprintf("DELETE FROM %s;", EscapeMssqlTable(schema, table))
+}
+
+func BatchExecStmts(
+	ctx context.Context,
+	pool DBTX,
+	batchSize int,
+	statements []string,
+) error {
+	for i := 0; i < len(statements); i += batchSize {
+		end := i + batchSize
+		if end > len(statements) {
+			end = len(statements)
+		}
+
+		batchCmd := strings.Join(statements[i:end], " ")
+		_, err := pool.ExecContext(ctx, batchCmd)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func EscapeMssqlTable(schema, table string) string {
+	if schema != "" {
+		return fmt.Sprintf("%s.%s", EscapeMssqlColumn(schema), EscapeMssqlColumn(table))
+	}
+	return EscapeMssqlColumn(table)
+}
+
+func EscapeMssqlColumns(cols []string) []string {
+	outcols := make([]string, len(cols))
+	for idx := range cols {
+		outcols[idx] = EscapeMssqlColumn(cols[idx])
+	}
+	return outcols
+}
+
+// To escape an identifier in sql server, it must be wrapped in brackets with any closing brackets doubled
+func EscapeMssqlColumn(col string) string {
+	return fmt.Sprintf("[%s]", strings.ReplaceAll(col, "]", "]]"))
+}
@@ -0,0 +1,108 @@
+package dbschemas_mssql
+
+import (
+	"context"
+	"database/sql"
+	"regexp"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	dbschemas "github.com/nucleuscloud/neosync/backend/pkg/dbschemas"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_GetMssqlTableDependencies(t *testing.T) {
+	constraints := []*GetForeignKeyConstraintsRow{
+		{ConstraintName: "t1_b_c_fkey", SchemaName: "dbo", TableName: "t1", ColumnName: "b", ForeignSchemaName: "dbo", ForeignTableName: "account_user_associations", ForeignColumnName: "account_id", IsNullable: "NO"},
+		{ConstraintName: "t1_b_c_fkey", SchemaName: "dbo", TableName: "t1", ColumnName: "c", ForeignSchemaName: "dbo", ForeignTableName: "account_user_associations", ForeignColumnName: "user_id", IsNullable: "NO"},
+		{ConstraintName: "t2_b_fkey", SchemaName: "dbo", TableName: "t2", ColumnName: "b", ForeignSchemaName: "dbo", ForeignTableName: "t2", ForeignColumnName: "a", IsNullable: "YES"},
+	}
+
+	td := GetMssqlTableDependencies(constraints)
+	assert.Equal(t, td, dbschemas.TableDependency{
+		"dbo.t1": {Constraints: []*dbschemas.ForeignConstraint{
+			{Column: "b", IsNullable: false, ForeignKey: &dbschemas.ForeignKey{Table: "dbo.account_user_associations", Column: "account_id"}},
+			{Column: "c", IsNullable: false, ForeignKey: &dbschemas.ForeignKey{Table: "dbo.account_user_associations", Column: "user_id"}},
+		}},
+		"dbo.t2": {Constraints: []*dbschemas.ForeignConstraint{
+			{Column: "b", IsNullable: true, ForeignKey: &dbschemas.ForeignKey{Table: "dbo.t2", Column: "a"}},
+		}},
+	})
+}
+
+func TestGetUniqueSchemaColMappings(t *testing.T) {
+	mappings := GetUniqueSchemaColMappings(
+		[]*GetDatabaseSchemaRow{
+			{TableSchema: "dbo", TableName: "users", ColumnName: "id", IsIdentity: true},
+			{TableSchema: "dbo", TableName: "users", ColumnName: "created_by"},
+			{TableSchema: "sales", TableName: "accounts", ColumnName: "id", CharacterMaximumLength: sql.NullInt32{Int32: 10, Valid: true}},
+		},
+	)
+	assert.Contains(t, mappings, "dbo.users")
+	assert.Contains(t, mappings, "sales.accounts")
+	assert.Contains(t, mappings["dbo.users"], "id")
+	assert.Contains(t, mappings["dbo.users"], "created_by")
+	assert.Nil(t, mappings["dbo.users"]["id"].CharacterMaximumLength)
+	assert.True(t, mappings["dbo.users"]["id"].IsIdentity)
+	assert.False(t, mappings["dbo.users"]["created_by"].IsIdentity)
+	assert.Equal(t, int32(10), *mappings["sales.accounts"]["id"].CharacterMaximumLength)
+}
+
+func Test_generateCreateTableStatement(t *testing.T) {
+	tableSchemas := []*GetDatabaseSchemaRow{
+		{TableSchema: "dbo", TableName: "users", ColumnName: "id", DataType: "int", IsNullable: "NO", ColumnDefault: "NULL", IsIdentity: true},
+		{TableSchema: "dbo", TableName: "users", ColumnName: "name", DataType: "nvarchar", IsNullable: "YES", ColumnDefault: "NULL", CharacterMaximumLength: sql.NullInt32{Int32: -1, Valid: true}},
+		{TableSchema: "dbo", TableName: "users", ColumnName: "balance", DataType: "decimal", IsNullable: "NO", ColumnDefault: "((0))", NumericPrecision: sql.NullInt32{Int32: 10, Valid: true}, NumericScale: sql.NullInt32{Int32: 2, Valid: true}},
+		{TableSchema: "dbo", TableName: "users", ColumnName: "account_id", DataType: "int", IsNullable: "YES", ColumnDefault: "NULL"},
+	}
+	constraints := buildTableConstraints(
+		"dbo",
+		"users",
+		[]*GetPrimaryKeyConstraintsRow{
+			{SchemaName: "dbo", TableName: "users", ColumnName: "id", ConstraintName: "pk_users"},
+			{SchemaName: "dbo", TableName: "accounts", ColumnName: "id", ConstraintName: "pk_accounts"},
+		},
+		[]*GetUniqueConstraintsRow{
+			{SchemaName: "dbo", TableName: "users", ColumnName: "name", ConstraintName: "uq_users_name"},
+		},
+		[]*GetForeignKeyConstraintsRow{
+			{ConstraintName: "fk_users_account", SchemaName: "dbo", TableName: "users", ColumnName: "account_id", ForeignSchemaName: "dbo", ForeignTableName: "accounts", ForeignColumnName: "id"},
+		},
+	)
+
+	actual := generateCreateTableStatement("dbo", "users", tableSchemas, constraints)
+	assert.Equal(
+		t,
+		"IF OBJECT_ID(N'[dbo].[users]', N'U') IS NULL CREATE TABLE [dbo].[users] ([id] int IDENTITY(1,1) NOT NULL, [name] nvarchar(max) NULL, [balance] decimal(10,2) NOT NULL DEFAULT ((0)), [account_id] int NULL, CONSTRAINT [pk_users] PRIMARY KEY ([id]), CONSTRAINT [uq_users_name] UNIQUE ([name]), CONSTRAINT [fk_users_account] FOREIGN KEY ([account_id]) REFERENCES [dbo].[accounts] ([id]));",
+		actual,
+	)
+}
+
+func Test_BatchExecStmts(t *testing.T) {
+	sqlDbMock, sqlMock, err := sqlmock.New(sqlmock.MonitorPingsOption(false))
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	ctx := context.Background()
+
+	statements := []string{BuildTruncateStatement("dbo", "users"), BuildTruncateStatement("dbo", "accounts"), BuildTruncateStatement("dbo", "departments")}
+	sqlMock.ExpectExec(regexp.QuoteMeta("DELETE FROM [dbo].[users]; DELETE FROM [dbo].[accounts];")).WillReturnResult(sqlmock.NewResult(1, 2))
+	sqlMock.ExpectExec(regexp.QuoteMeta("DELETE FROM [dbo].[departments];")).WillReturnResult(sqlmock.NewResult(1, 1))
+
+	err = BatchExecStmts(ctx, sqlDbMock, 2, statements)
+	assert.NoError(t, err)
+
+	if err := sqlMock.ExpectationsWereMet(); err != nil {
+		t.Errorf("there were unfulfilled expectations: %s", err)
+	}
+}
+
+func Test_EscapeMssqlColumns(t *testing.T) {
+	assert.Empty(t, EscapeMssqlColumns(nil))
+	assert.Equal(
+		t,
+		EscapeMssqlColumns([]string{"foo", "bar", "ba]z"}),
+		[]string{"[foo]", "[bar]", "[ba]]z]"},
+	)
+}
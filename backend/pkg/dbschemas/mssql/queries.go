@@ -0,0 +1,300 @@
+package dbschemas_mssql
+
+import (
+	"context"
+	"database/sql"
+)
+
+// sqlc does not support sql server, so the system queries are maintained by hand here
+// and mirror the generated postgres and mysql query packages
+
+type DBTX interface {
+	ExecContext(context.Context, string, ...any) (sql.Result, error)
+	PrepareContext(context.Context, string) (*sql.Stmt, error)
+	QueryContext(context.Context, string, ...any) (*sql.Rows, error)
+	QueryRowContext(context.Context, string, ...any) *sql.Row
+}
+
+type Querier interface {
+	GetDatabaseSchema(ctx context.Context, db DBTX) ([]*GetDatabaseSchemaRow, error)
+	GetDatabaseTableSchema(ctx context.Context, db DBTX, params *GetDatabaseTableSchemaParams) ([]*GetDatabaseSchemaRow, error)
+	GetForeignKeyConstraints(ctx context.Context, db DBTX, tableSchema string) ([]*GetForeignKeyConstraintsRow, error)
+	GetPrimaryKeyConstraints(ctx context.Context, db DBTX, tableSchema string) ([]*GetPrimaryKeyConstraintsRow, error)
+	GetUniqueConstraints(ctx context.Context, db DBTX, tableSchema string) ([]*GetUniqueConstraintsRow, error)
+}
+
+var _ Querier = (*Queries)(nil)
+
+func New() *Queries {
+	return &Queries{}
+}
+
+type Queries struct {
+}
+
+const databaseSchemaSelect = `
+SELECT
+	c.TABLE_SCHEMA AS table_schema,
+	c.TABLE_NAME AS table_name,
+	c.COLUMN_NAME AS column_name,
+	c.ORDINAL_POSITION AS ordinal_position,
+	COALESCE(c.COLUMN_DEFAULT, 'NULL') AS column_default,
+	c.IS_NULLABLE AS is_nullable,
+	c.DATA_TYPE AS data_type,
+	c.CHARACTER_MAXIMUM_LENGTH AS character_maximum_length,
+	c.NUMERIC_PRECISION AS numeric_precision,
+	c.NUMERIC_SCALE AS numeric_scale,
+	COALESCE(COLUMNPROPERTY(OBJECT_ID(QUOTENAME(c.TABLE_SCHEMA) + '.' + QUOTENAME(c.TABLE_NAME)), c.COLUMN_NAME, 'IsIdentity'), 0) AS is_identity
+FROM
+	INFORMATION_SCHEMA.COLUMNS AS c
+	JOIN INFORMATION_SCHEMA.TABLES AS t ON c.TABLE_SCHEMA = t.TABLE_SCHEMA
+		AND c.TABLE_NAME = t.TABLE_NAME
+WHERE
+	t.TABLE_TYPE = 'BASE TABLE'
+	AND c.TABLE_SCHEMA NOT IN ('sys', 'INFORMATION_SCHEMA')
+`
+
+const getDatabaseSchema = databaseSchemaSelect + `ORDER BY
+	c.TABLE_SCHEMA,
+	c.TABLE_NAME,
+	c.ORDINAL_POSITION;
+`
+
+type GetDatabaseSchemaRow struct {
+	TableSchema            string
+	TableName              string
+	ColumnName             string
+	OrdinalPosition        int32
+	ColumnDefault          string
+	IsNullable             string
+	DataType               string
+	CharacterMaximumLength sql.NullInt32
+	NumericPrecision       sql.NullInt32
+	NumericScale           sql.NullInt32
+	IsIdentity             bool
+}
+
+func (q *Queries) GetDatabaseSchema(ctx context.Context, db DBTX) ([]*GetDatabaseSchemaRow, error) {
+	rows, err := db.QueryContext(ctx, getDatabaseSchema)
+	if err != nil {
+		return nil, err
+	}
+	return scanDatabaseSchemaRows(rows)
+}
+
+const getDatabaseTableSchema = databaseSchemaSelect + `	AND c.TABLE_SCHEMA = @p1
+	AND c.TABLE_NAME = @p2
+ORDER BY
+	c.ORDINAL_POSITION;
+`
+
+type GetDatabaseTableSchemaParams struct {
+	Schema string
+	Table  string
+}
+
+func (q *Queries) GetDatabaseTableSchema(ctx context.Context, db DBTX, params *GetDatabaseTableSchemaParams) ([]*GetDatabaseSchemaRow, error) {
+	rows, err := db.QueryContext(ctx, getDatabaseTableSchema, params.Schema, params.Table)
+	if err != nil {
+		return nil, err
+	}
+	return scanDatabaseSchemaRows(rows)
+}
+
+func scanDatabaseSchemaRows(rows *sql.Rows) ([]*GetDatabaseSchemaRow, error) {
+	defer rows.Close()
+	var items []*GetDatabaseSchemaRow
+	for rows.Next() {
+		var i GetDatabaseSchemaRow
+		var isIdentity int32
+		if err := rows.Scan(
+			&i.TableSchema,
+			&i.TableName,
+			&i.ColumnName,
+			&i.OrdinalPosition,
+			&i.ColumnDefault,
+			&i.IsNullable,
+			&i.DataType,
+			&i.CharacterMaximumLength,
+			&i.NumericPrecision,
+			&i.NumericScale,
+			&isIdentity,
+		); err != nil {
+			return nil, err
+		}
+		i.IsIdentity = isIdentity == 1
+		items = append(items, &i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getForeignKeyConstraints = `
+SELECT
+	fk.name AS constraint_name,
+	SCHEMA_NAME(t.schema_id) AS schema_name,
+	t.name AS table_name,
+	c.name AS column_name,
+	CASE WHEN c.is_nullable = 1 THEN 'YES' ELSE 'NO' END AS is_nullable,
+	SCHEMA_NAME(rt.schema_id) AS foreign_schema_name,
+	rt.name AS foreign_table_name,
+	rc.name AS foreign_column_name
+FROM
+	sys.foreign_keys AS fk
+	JOIN sys.foreign_key_columns AS fkc ON fk.object_id = fkc.constraint_object_id
+	JOIN sys.tables AS t ON fkc.parent_object_id = t.object_id
+	JOIN sys.columns AS c ON fkc.parent_object_id = c.object_id
+		AND fkc.parent_column_id = c.column_id
+	JOIN sys.tables AS rt ON fkc.referenced_object_id = rt.object_id
+	JOIN sys.columns AS rc ON fkc.referenced_object_id = rc.object_id
+		AND fkc.referenced_column_id = rc.column_id
+WHERE
+	SCHEMA_NAME(t.schema_id) = @p1
+ORDER BY
+	fk.name,
+	fkc.constraint_column_id;
+`
+
+type GetForeignKeyConstraintsRow struct {
+	ConstraintName    string
+	SchemaName        string
+	TableName         string
+	ColumnName        string
+	IsNullable        string
+	ForeignSchemaName string
+	ForeignTableName  string
+	ForeignColumnName string
+}
+
+func (q *Queries) GetForeignKeyConstraints(ctx context.Context, db DBTX, tableSchema string) ([]*GetForeignKeyConstraintsRow, error) {
+	rows, err := db.QueryContext(ctx, getForeignKeyConstraints, tableSchema)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*GetForeignKeyConstraintsRow
+	for rows.Next() {
+		var i GetForeignKeyConstraintsRow
+		if err := rows.Scan(
+			&i.ConstraintName,
+			&i.SchemaName,
+			&i.TableName,
+			&i.ColumnName,
+			&i.IsNullable,
+			&i.ForeignSchemaName,
+			&i.ForeignTableName,
+			&i.ForeignColumnName,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const keyConstraintsSelect = `
+SELECT
+	tc.TABLE_SCHEMA AS schema_name,
+	tc.TABLE_NAME AS table_name,
+	kcu.COLUMN_NAME AS column_name,
+	tc.CONSTRAINT_NAME AS constraint_name
+FROM
+	INFORMATION_SCHEMA.TABLE_CONSTRAINTS AS tc
+	JOIN INFORMATION_SCHEMA.KEY_COLUMN_USAGE AS kcu ON tc.CONSTRAINT_NAME = kcu.CONSTRAINT_NAME
+		AND tc.TABLE_SCHEMA = kcu.TABLE_SCHEMA
+		AND tc.TABLE_NAME = kcu.TABLE_NAME
+WHERE
+	tc.TABLE_SCHEMA = @p1
+	AND tc.CONSTRAINT_TYPE = @p2
+ORDER BY
+	tc.TABLE_NAME,
+	tc.CONSTRAINT_NAME,
+	kcu.ORDINAL_POSITION;
+`
+
+type GetPrimaryKeyConstraintsRow struct {
+	SchemaName     string
+	TableName      string
+	ColumnName     string
+	ConstraintName string
+}
+
+func (q *Queries) GetPrimaryKeyConstraints(ctx context.Context, db DBTX, tableSchema string) ([]*GetPrimaryKeyConstraintsRow, error) {
+	rows, err := getKeyConstraints(ctx, db, tableSchema, "PRIMARY KEY")
+	if err != nil {
+		return nil, err
+	}
+	items := make([]*GetPrimaryKeyConstraintsRow, 0, len(rows))
+	for _, row := range rows {
+		items = append(items, &GetPrimaryKeyConstraintsRow{
+			SchemaName:     row.SchemaName,
+			TableName:      row.TableName,
+			ColumnName:     row.ColumnName,
+			ConstraintName: row.ConstraintName,
+		})
+	}
+	return items, nil
+}
+
+type GetUniqueConstraintsRow struct {
+	SchemaName     string
+	TableName      string
+	ConstraintName string
+	ColumnName     string
+}
+
+func (q *Queries) GetUniqueConstraints(ctx context.Context, db DBTX, tableSchema string) ([]*GetUniqueConstraintsRow, error) {
+	rows, err := getKeyConstraints(ctx, db, tableSchema, "UNIQUE")
+	if err != nil {
+		return nil, err
+	}
+	items := make([]*GetUniqueConstraintsRow, 0, len(rows))
+	for _, row := range rows {
+		items = append(items, &GetUniqueConstraintsRow{
+			SchemaName:     row.SchemaName,
+			TableName:      row.TableName,
+			ColumnName:     row.ColumnName,
+			ConstraintName: row.ConstraintName,
+		})
+	}
+	return items, nil
+}
+
+func getKeyConstraints(ctx context.Context, db DBTX, tableSchema, constraintType string) ([]*GetPrimaryKeyConstraintsRow, error) {
+	rows, err := db.QueryContext(ctx, keyConstraintsSelect, tableSchema, constraintType)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*GetPrimaryKeyConstraintsRow
+	for rows.Next() {
+		var i GetPrimaryKeyConstraintsRow
+		if err := rows.Scan(
+			&i.SchemaName,
+			&i.TableName,
+			&i.ColumnName,
+			&i.ConstraintName,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
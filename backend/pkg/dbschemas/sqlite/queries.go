@@ -0,0 +1,200 @@
+package dbschemas_sqlite
+
+import (
+	"context"
+	"database/sql"
+)
+
+// sqlc does not support sqlite pragma functions, so the system queries are maintained by hand here
+// and mirror the generated postgres and mysql query packages
+
+type DBTX interface {
+	ExecContext(context.Context, string, ...any) (sql.Result, error)
+	PrepareContext(context.Context, string) (*sql.Stmt, error)
+	QueryContext(context.Context, string, ...any) (*sql.Rows, error)
+	QueryRowContext(context.Context, string, ...any) *sql.Row
+}
+
+type Querier interface {
+	GetDatabaseSchema(ctx context.Context, db DBTX) ([]*GetDatabaseSchemaRow, error)
+	GetForeignKeyConstraints(ctx context.Context, db DBTX) ([]*GetForeignKeyConstraintsRow, error)
+	GetPrimaryKeyConstraints(ctx context.Context, db DBTX) ([]*GetPrimaryKeyConstraintsRow, error)
+}
+
+var _ Querier = (*Queries)(nil)
+
+func New() *Queries {
+	return &Queries{}
+}
+
+type Queries struct {
+}
+
+const getDatabaseSchema = `
+SELECT
+	m.name AS table_name,
+	p.name AS column_name,
+	p.cid + 1 AS ordinal_position,
+	COALESCE(p.dflt_value, 'NULL') AS column_default,
+	CASE WHEN p."notnull" = 1 OR p.pk > 0 THEN 'NO' ELSE 'YES' END AS is_nullable,
+	p.type AS data_type
+FROM
+	sqlite_master AS m
+	JOIN pragma_table_info(m.name) AS p
+WHERE
+	m.type = 'table'
+	AND m.name NOT LIKE 'sqlite_%'
+ORDER BY
+	m.name,
+	p.cid;
+`
+
+type GetDatabaseSchemaRow struct {
+	TableSchema     string
+	TableName       string
+	ColumnName      string
+	OrdinalPosition int32
+	ColumnDefault   string
+	IsNullable      string
+	DataType        string
+}
+
+func (q *Queries) GetDatabaseSchema(ctx context.Context, db DBTX) ([]*GetDatabaseSchemaRow, error) {
+	rows, err := db.QueryContext(ctx, getDatabaseSchema)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*GetDatabaseSchemaRow
+	for rows.Next() {
+		i := GetDatabaseSchemaRow{TableSchema: DefaultSchema}
+		if err := rows.Scan(
+			&i.TableName,
+			&i.ColumnName,
+			&i.OrdinalPosition,
+			&i.ColumnDefault,
+			&i.IsNullable,
+			&i.DataType,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getForeignKeyConstraints = `
+SELECT
+	m.name AS table_name,
+	f.id AS constraint_id,
+	f."from" AS column_name,
+	CASE WHEN p."notnull" = 1 THEN 'NO' ELSE 'YES' END AS is_nullable,
+	f."table" AS foreign_table_name,
+	f."to" AS foreign_column_name
+FROM
+	sqlite_master AS m
+	JOIN pragma_foreign_key_list(m.name) AS f
+	JOIN pragma_table_info(m.name) AS p ON p.name = f."from"
+WHERE
+	m.type = 'table'
+	AND m.name NOT LIKE 'sqlite_%'
+ORDER BY
+	m.name,
+	f.id,
+	f.seq;
+`
+
+type GetForeignKeyConstraintsRow struct {
+	SchemaName        string
+	TableName         string
+	ConstraintId      int32
+	ColumnName        string
+	IsNullable        string
+	ForeignSchemaName string
+	ForeignTableName  string
+	ForeignColumnName string
+}
+
+func (q *Queries) GetForeignKeyConstraints(ctx context.Context, db DBTX) ([]*GetForeignKeyConstraintsRow, error) {
+	rows, err := db.QueryContext(ctx, getForeignKeyConstraints)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*GetForeignKeyConstraintsRow
+	for rows.Next() {
+		i := GetForeignKeyConstraintsRow{SchemaName: DefaultSchema, ForeignSchemaName: DefaultSchema}
+		if err := rows.Scan(
+			&i.TableName,
+			&i.ConstraintId,
+			&i.ColumnName,
+			&i.IsNullable,
+			&i.ForeignTableName,
+			&i.ForeignColumnName,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getPrimaryKeyConstraints = `
+SELECT
+	m.name AS table_name,
+	p.name AS column_name
+FROM
+	sqlite_master AS m
+	JOIN pragma_table_info(m.name) AS p
+WHERE
+	m.type = 'table'
+	AND m.name NOT LIKE 'sqlite_%'
+	AND p.pk > 0
+ORDER BY
+	m.name,
+	p.pk;
+`
+
+type GetPrimaryKeyConstraintsRow struct {
+	SchemaName string
+	TableName  string
+	ColumnName string
+}
+
+func (q *Queries) GetPrimaryKeyConstraints(ctx context.Context, db DBTX) ([]*GetPrimaryKeyConstraintsRow, error) {
+	rows, err := db.QueryContext(ctx, getPrimaryKeyConstraints)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*GetPrimaryKeyConstraintsRow
+	for rows.Next() {
+		i := GetPrimaryKeyConstraintsRow{SchemaName: DefaultSchema}
+		if err := rows.Scan(
+			&i.TableName,
+			&i.ColumnName,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
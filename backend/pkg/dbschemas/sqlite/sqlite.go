@@ -0,0 +1,168 @@
+package dbschemas_sqlite
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	dbschemas "github.com/nucleuscloud/neosync/backend/pkg/dbschemas"
+)
+
+// SQLite has no notion of schemas within a single database file.
+// Tables are created and referenced by their table name only and are reported under the main schema.
+const DefaultSchema = "main"
+
+type Table struct {
+	Name        string
+	Columns     []*Column
+	PrimaryKeys []string
+	ForeignKeys []*ForeignKey
+}
+
+type Column struct {
+	Name string
+	// The data type of the column as reported by the source database. Ex: character varying, int, jsonb
+	DataType   string
+	IsNullable bool
+}
+
+type ForeignKey struct {
+	Columns        []string
+	ForeignTable   string
+	ForeignColumns []string
+}
+
+// Builds a create table statement that translates the source column types into SQLite type affinities.
+// Column defaults are not carried over as they are frequently dialect specific (sequences, functions, etc.)
+func BuildCreateTableStatement(table *Table) string {
+	pieces := make([]string, 0, len(table.Columns)+len(table.ForeignKeys)+1)
+	for _, col := range table.Columns {
+		pieces = append(pieces, buildTableCol(col))
+	}
+	if len(table.PrimaryKeys) > 0 {
+		pieces = append(pieces, fmt.Sprintf("PRIMARY KEY (%s)", strings.Join(EscapeSqliteColumns(table.PrimaryKeys), ", ")))
+	}
+	for _, fk := range table.ForeignKeys {
+		pieces = append(pieces, fmt.Sprintf(
+			"FOREIGN KEY (%s) REFERENCES %s (%s)",
+			strings.Join(EscapeSqliteColumns(fk.Columns), ", "),
+			EscapeSqliteTable(fk.ForeignTable),
+			strings.Join(EscapeSqliteColumns(fk.ForeignColumns), ", "),
+		))
+	}
+	return fmt.Sprintf("CREATE TABLE IF NOT EXISTS %s (%s);", EscapeSqliteTable(table.Name), strings.Join(pieces, ", "))
+}
+
+func buildTableCol(col *Column) string {
+	pieces := []string{EscapeSqliteColumn(col.Name), TranslateDataType(col.DataType)}
+	if !col.IsNullable {
+		pieces = append(pieces, "NOT NULL")
+	}
+	return strings.Join(pieces, " ")
+}
+
+// Translates a Postgres or MySQL data type into the SQLite type affinity that best stores its values.
+// Anything that is not clearly numeric or binary is stored as TEXT, which includes dates and times.
+func TranslateDataType(dataType string) string {
+	dt := strings.ToLower(strings.TrimSpace(dataType))
+	if strings.HasSuffix(dt, "[]") || dt == "array" {
+		return "TEXT"
+	}
+	if idx := strings.Index(dt, "("); idx != -1 {
+		dt = strings.TrimSpace(dt[:idx])
+	}
+	dt = strings.TrimSpace(strings.TrimSuffix(dt, "unsigned"))
+
+	switch dt {
+	case "smallint", "integer", "int", "bigint", "tinyint", "mediumint",
+		"int2", "int4", "int8", "smallserial", "serial", "bigserial", "serial2", "serial4", "serial8",
+		"boolean", "bool", "bit", "year":
+		return "INTEGER"
+	case "real", "float", "double", "double precision", "float4", "float8":
+		return "REAL"
+	case "numeric", "decimal", "money":
+		return "NUMERIC"
+	case "bytea", "blob", "tinyblob", "mediumblob", "longblob", "binary", "varbinary":
+		return "BLOB"
+	default:
+		return "TEXT"
+	}
+}
+
+// Key is schema.table value is list of tables that key depends on
+func GetSqliteTableDependencies(
+	constraints []*GetForeignKeyConstraintsRow,
+) dbschemas.TableDependency {
+	tableConstraints := map[string]*dbschemas.TableConstraints{}
+	for _, c := range constraints {
+		tableName := dbschemas.BuildTable(c.SchemaName, c.TableName)
+		constraint, ok := tableConstraints[tableName]
+		if !ok {
+			constraint = &dbschemas.TableConstraints{}
+			tableConstraints[tableName] = constraint
+		}
+		constraint.Constraints = append(constraint.Constraints, &dbschemas.ForeignConstraint{
+			Column: c.ColumnName, IsNullable: dbschemas.ConvertIsNullableToBool(c.IsNullable), ForeignKey: &dbschemas.ForeignKey{
+				Table:  dbschemas.BuildTable(c.ForeignSchemaName, c.ForeignTableName),
+				Column: c.ForeignColumnName,
+			},
+		})
+	}
+	return tableConstraints
+}
+
+func GetSqliteTablePrimaryKeys(
+	primaryKeyConstraints []*GetPrimaryKeyConstraintsRow,
+) map[string][]string {
+	pkMap := map[string][]string{}
+	for _, c := range primaryKeyConstraints {
+		key := dbschemas.BuildTable(c.SchemaName, c.TableName)
+		pkMap[key] = append(pkMap[key], c.ColumnName)
+	}
+	return pkMap
+}
+
+// SQLite has no TRUNCATE statement. An unqualified DELETE is optimized by SQLite into a truncate.
+func BuildTruncateStatement(
+	table string,
+) string {
+	return fmt.Sprintf("DELETE FROM %s;", EscapeSqliteTable(table))
+}
+
+func BatchExecStmts(
+	ctx context.Context,
+	db DBTX,
+	batchSize int,
+	statements []string,
+) error {
+	for i := 0; i < len(statements); i += batchSize {
+		end := i + batchSize
+		if end > len(statements) {
+			end = len(statements)
+		}
+
+		batchCmd := strings.Join(statements[i:end], " ")
+		_, err := db.ExecContext(ctx, batchCmd)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func EscapeSqliteTable(table string) string {
+	return EscapeSqliteColumn(table)
+}
+
+func EscapeSqliteColumns(cols []string) []string {
+	outcols := make([]string, len(cols))
+	for idx := range cols {
+		outcols[idx] = EscapeSqliteColumn(cols[idx])
+	}
+	return outcols
+}
+
+// To escape an identifier in sqlite, it must be wrapped in double quotes with any double quotes doubled
+func EscapeSqliteColumn(col string) string {
+	return fmt.Sprintf(`"%s"`, strings.ReplaceAll(col, `"`, `""`))
+}
@@ -0,0 +1,130 @@
+package dbschemas_sqlite
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	dbschemas "github.com/nucleuscloud/neosync/backend/pkg/dbschemas"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	_ "modernc.org/sqlite"
+)
+
+func Test_TranslateDataType(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{"integer", "INTEGER"},
+		{"bigint", "INTEGER"},
+		{"int unsigned", "INTEGER"},
+		{"tinyint(1)", "INTEGER"},
+		{"boolean", "INTEGER"},
+		{"double precision", "REAL"},
+		{"float", "REAL"},
+		{"numeric", "NUMERIC"},
+		{"decimal(10,2)", "NUMERIC"},
+		{"bytea", "BLOB"},
+		{"longblob", "BLOB"},
+		{"character varying", "TEXT"},
+		{"varchar(255)", "TEXT"},
+		{"timestamp without time zone", "TEXT"},
+		{"datetime", "TEXT"},
+		{"jsonb", "TEXT"},
+		{"uuid", "TEXT"},
+		{"integer[]", "TEXT"},
+		{"ARRAY", "TEXT"},
+		{"USER-DEFINED", "TEXT"},
+		{"interval", "TEXT"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			assert.Equal(t, tt.expected, TranslateDataType(tt.input))
+		})
+	}
+}
+
+func Test_BuildCreateTableStatement(t *testing.T) {
+	actual := BuildCreateTableStatement(&Table{
+		Name: "orders",
+		Columns: []*Column{
+			{Name: "id", DataType: "uuid", IsNullable: false},
+			{Name: "user_id", DataType: "integer", IsNullable: true},
+			{Name: "total", DataType: "numeric", IsNullable: false},
+		},
+		PrimaryKeys: []string{"id"},
+		ForeignKeys: []*ForeignKey{
+			{Columns: []string{"user_id"}, ForeignTable: "users", ForeignColumns: []string{"id"}},
+		},
+	})
+	assert.Equal(
+		t,
+		`CREATE TABLE IF NOT EXISTS "orders" ("id" TEXT NOT NULL, "user_id" INTEGER, "total" NUMERIC NOT NULL, PRIMARY KEY ("id"), FOREIGN KEY ("user_id") REFERENCES "users" ("id"));`,
+		actual,
+	)
+}
+
+func Test_BuildTruncateStatement(t *testing.T) {
+	assert.Equal(t, `DELETE FROM "users";`, BuildTruncateStatement("users"))
+}
+
+func Test_EscapeSqliteColumn(t *testing.T) {
+	assert.Equal(t, `"name"`, EscapeSqliteColumn("name"))
+	assert.Equal(t, `"my""col"`, EscapeSqliteColumn(`my"col`))
+	assert.Equal(t, []string{`"a"`, `"b"`}, EscapeSqliteColumns([]string{"a", "b"}))
+}
+
+func Test_Queries(t *testing.T) {
+	ctx := context.Background()
+	db, err := sql.Open("sqlite", ":memory:")
+	require.NoError(t, err)
+	defer db.Close()
+	db.SetMaxOpenConns(1)
+
+	err = BatchExecStmts(ctx, db, 1, []string{
+		BuildCreateTableStatement(&Table{
+			Name:        "users",
+			Columns:     []*Column{{Name: "id", DataType: "integer"}, {Name: "name", DataType: "text", IsNullable: true}},
+			PrimaryKeys: []string{"id"},
+		}),
+		BuildCreateTableStatement(&Table{
+			Name:        "orders",
+			Columns:     []*Column{{Name: "id", DataType: "integer"}, {Name: "user_id", DataType: "integer", IsNullable: true}},
+			PrimaryKeys: []string{"id"},
+			ForeignKeys: []*ForeignKey{{Columns: []string{"user_id"}, ForeignTable: "users", ForeignColumns: []string{"id"}}},
+		}),
+	})
+	require.NoError(t, err)
+
+	querier := New()
+
+	schema, err := querier.GetDatabaseSchema(ctx, db)
+	require.NoError(t, err)
+	assert.Equal(t, []*GetDatabaseSchemaRow{
+		{TableSchema: "main", TableName: "orders", ColumnName: "id", OrdinalPosition: 1, ColumnDefault: "NULL", IsNullable: "NO", DataType: "INTEGER"},
+		{TableSchema: "main", TableName: "orders", ColumnName: "user_id", OrdinalPosition: 2, ColumnDefault: "NULL", IsNullable: "YES", DataType: "INTEGER"},
+		{TableSchema: "main", TableName: "users", ColumnName: "id", OrdinalPosition: 1, ColumnDefault: "NULL", IsNullable: "NO", DataType: "INTEGER"},
+		{TableSchema: "main", TableName: "users", ColumnName: "name", OrdinalPosition: 2, ColumnDefault: "NULL", IsNullable: "YES", DataType: "TEXT"},
+	}, schema)
+
+	fks, err := querier.GetForeignKeyConstraints(ctx, db)
+	require.NoError(t, err)
+	assert.Equal(t, dbschemas.TableDependency{
+		"main.orders": {Constraints: []*dbschemas.ForeignConstraint{
+			{Column: "user_id", IsNullable: true, ForeignKey: &dbschemas.ForeignKey{Table: "main.users", Column: "id"}},
+		}},
+	}, GetSqliteTableDependencies(fks))
+
+	pks, err := querier.GetPrimaryKeyConstraints(ctx, db)
+	require.NoError(t, err)
+	assert.Equal(t, map[string][]string{
+		"main.orders": {"id"},
+		"main.users":  {"id"},
+	}, GetSqliteTablePrimaryKeys(pks))
+
+	err = BatchExecStmts(ctx, db, 10, []string{BuildTruncateStatement("orders"), BuildTruncateStatement("users")})
+	require.NoError(t, err)
+}
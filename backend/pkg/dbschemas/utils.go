@@ -26,6 +26,7 @@ type ColumnInfo struct {
 	CharacterMaximumLength *int32 // Specifies the maximum allowable length of the column for character-based data types. For datatypes such as integers, boolean, dates etc. this is NULL.
 	NumericPrecision       *int32 // Specifies the precision for numeric data types. It represents the TOTAL count of significant digits in the whole number, that is, the number of digits to BOTH sides of the decimal point. Null for non-numeric data types.
 	NumericScale           *int32 // Specifies the scale of the column for numeric data types, specifically non-integers. It represents the number of digits to the RIGHT of the decimal point. Null for non-numeric data types and integers.
+	IsIdentity             bool   // Specifies if the database assigns the column's value from an identity. Only set for mssql.
 }
 
 /* More on NumericPrecision and NumericScale including examples
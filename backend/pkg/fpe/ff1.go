@@ -0,0 +1,132 @@
+package fpe
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math/big"
+)
+
+const ff1Rounds = 10
+
+// Implements the FF1 mode of format preserving encryption as specified in NIST SP 800-38G
+type ff1 struct {
+	block cipher.Block
+	radix int
+}
+
+func newFF1(key []byte, radix int) (*ff1, error) {
+	if radix < 2 || radix > 1<<16 {
+		return nil, fmt.Errorf("radix must be between 2 and 65536, got %d", radix)
+	}
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return &ff1{block: block, radix: radix}, nil
+}
+
+func (f *ff1) encrypt(x []uint16, tweak []byte) ([]uint16, error) {
+	return f.cipher(x, tweak, true)
+}
+
+func (f *ff1) decrypt(x []uint16, tweak []byte) ([]uint16, error) {
+	return f.cipher(x, tweak, false)
+}
+
+func (f *ff1) cipher(x []uint16, tweak []byte, isEncrypt bool) ([]uint16, error) {
+	n := len(x)
+	if n < 2 {
+		return nil, errors.New("ff1 input must be at least 2 numerals")
+	}
+	t := len(tweak)
+	u := n / 2
+	v := n - u
+
+	// number of bytes needed to hold radix^v - 1
+	b := (new(big.Int).Sub(pow(f.radix, v), big.NewInt(1)).BitLen() + 7) / 8
+	d := 4*((b+3)/4) + 4
+
+	p := make([]byte, aes.BlockSize)
+	p[0], p[1], p[2] = 1, 2, 1
+	p[3] = byte(f.radix >> 16)
+	p[4] = byte(f.radix >> 8)
+	p[5] = byte(f.radix)
+	p[6] = 10
+	p[7] = byte(u % 256)
+	binary.BigEndian.PutUint32(p[8:12], uint32(n))
+	binary.BigEndian.PutUint32(p[12:16], uint32(t))
+
+	padding := ((-t-b-1)%aes.BlockSize + aes.BlockSize) % aes.BlockSize
+	q := make([]byte, t+padding+1+b)
+	copy(q, tweak)
+
+	modU := pow(f.radix, u)
+	modV := pow(f.radix, v)
+
+	a := x[:u]
+	bNumerals := x[u:]
+	for round := 0; round < ff1Rounds; round++ {
+		i := round
+		if !isEncrypt {
+			i = ff1Rounds - 1 - round
+		}
+		source := bNumerals
+		if !isEncrypt {
+			source = a
+		}
+		q[t+padding] = byte(i)
+		copy(q[t+padding+1:], numToBytes(num(source, f.radix), b))
+
+		y := new(big.Int).SetBytes(f.expand(f.prf(append(append([]byte{}, p...), q...)), d))
+
+		m, mod := u, modU
+		if i%2 == 1 {
+			m, mod = v, modV
+		}
+
+		if isEncrypt {
+			c := new(big.Int).Add(num(a, f.radix), y)
+			c.Mod(c, mod)
+			a, bNumerals = bNumerals, str(c, f.radix, m)
+		} else {
+			c := new(big.Int).Sub(num(bNumerals, f.radix), y)
+			c.Mod(c, mod)
+			a, bNumerals = str(c, f.radix, m), a
+		}
+	}
+
+	out := make([]uint16, 0, n)
+	out = append(out, a...)
+	return append(out, bNumerals...), nil
+}
+
+// CBC-MAC of the input using a zero IV. The input length must be a multiple of the block size.
+func (f *ff1) prf(input []byte) []byte {
+	y := make([]byte, aes.BlockSize)
+	for i := 0; i < len(input); i += aes.BlockSize {
+		for j := 0; j < aes.BlockSize; j++ {
+			y[j] ^= input[i+j]
+		}
+		f.block.Encrypt(y, y)
+	}
+	return y
+}
+
+// Expands r into d bytes by concatenating r with encryptions of r xor'd with a counter.
+func (f *ff1) expand(r []byte, d int) []byte {
+	s := make([]byte, 0, d+aes.BlockSize)
+	s = append(s, r...)
+	for j := 1; len(s) < d; j++ {
+		block := make([]byte, aes.BlockSize)
+		binary.BigEndian.PutUint64(block[8:], uint64(j))
+		for k := range block {
+			block[k] ^= r[k]
+		}
+		f.block.Encrypt(block, block)
+		s = append(s, block...)
+	}
+	return s[:d]
+}
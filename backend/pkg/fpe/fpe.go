@@ -0,0 +1,235 @@
+package fpe
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+	"unicode/utf8"
+)
+
+type Algorithm string
+
+const (
+	AlgorithmFF1  Algorithm = "ff1"
+	AlgorithmFF31 Algorithm = "ff3_1"
+)
+
+const (
+	// fixed context used to derive the encryption key from the account transformer key
+	keyDerivationContext = "neosync-format-preserving-encryption"
+
+	// smallest domain (radix^length) that a group of characters may be encrypted in, as required by NIST SP 800-38G Rev. 1.
+	// groups of fewer than 6 digits or 5 letters are too small.
+	minDomainSize = 1_000_000
+
+	digitRadix  = 10
+	letterRadix = 26
+
+	ff1TweakLength = 8
+)
+
+type characterClass byte
+
+const (
+	digitClass  characterClass = 'd'
+	letterClass characterClass = 'l'
+)
+
+func ParseAlgorithm(algorithm string) (Algorithm, error) {
+	switch Algorithm(strings.ToLower(algorithm)) {
+	case AlgorithmFF1:
+		return AlgorithmFF1, nil
+	case AlgorithmFF31:
+		return AlgorithmFF31, nil
+	default:
+		return "", fmt.Errorf("unsupported format preserving encryption algorithm: %s", algorithm)
+	}
+}
+
+// Derives the 256 bit AES key used for format preserving encryption from an account's transformer key.
+// Rotating the transformer key changes the derived key, so values encrypted before the rotation can no longer be decrypted.
+func DeriveKey(accountKey string) ([]byte, error) {
+	if accountKey == "" {
+		return nil, errors.New("account key must not be empty")
+	}
+	mac := hmac.New(sha256.New, []byte(accountKey))
+	mac.Write([]byte(keyDerivationContext)) //nolint:errcheck
+	return mac.Sum(nil), nil
+}
+
+// Encrypts the value so that the output has the same length and character classes as the input.
+// Digits are encrypted with other digits and ascii letters with other letters of the same case. All other characters are left in place.
+// The digits or letters of a value are left unchanged when there are too few of them to be encrypted, which is fewer than 6 digits or 5 letters,
+// such as the letter of X1234567.
+func Encrypt(key []byte, algorithm Algorithm, tweak, value string) (string, error) {
+	return transform(key, algorithm, tweak, value, true)
+}
+
+// Decrypts a value that was produced by Encrypt with the same key, algorithm and tweak.
+func Decrypt(key []byte, algorithm Algorithm, tweak, value string) (string, error) {
+	return transform(key, algorithm, tweak, value, false)
+}
+
+func transform(key []byte, algorithm Algorithm, tweak, value string, isEncrypt bool) (string, error) {
+	if !utf8.ValidString(value) {
+		return "", errors.New("value must be a valid utf8 string")
+	}
+	runes := []rune(value)
+
+	var digitIdxs, letterIdxs []int
+	for idx, r := range runes {
+		switch {
+		case isDigit(r):
+			digitIdxs = append(digitIdxs, idx)
+		case isLetter(r):
+			letterIdxs = append(letterIdxs, idx)
+		}
+	}
+
+	if len(digitIdxs) > 0 {
+		digits := make([]uint16, len(digitIdxs))
+		for i, idx := range digitIdxs {
+			digits[i] = uint16(runes[idx] - '0')
+		}
+		out, err := transformNumerals(key, algorithm, tweak, digitClass, digitRadix, digits, isEncrypt)
+		if err != nil {
+			return "", fmt.Errorf("unable to transform digits: %w", err)
+		}
+		for i, idx := range digitIdxs {
+			runes[idx] = '0' + rune(out[i])
+		}
+	}
+
+	if len(letterIdxs) > 0 {
+		letters := make([]uint16, len(letterIdxs))
+		for i, idx := range letterIdxs {
+			letters[i] = uint16(toLower(runes[idx]) - 'a')
+		}
+		out, err := transformNumerals(key, algorithm, tweak, letterClass, letterRadix, letters, isEncrypt)
+		if err != nil {
+			return "", fmt.Errorf("unable to transform letters: %w", err)
+		}
+		for i, idx := range letterIdxs {
+			// the case of each letter is preserved from the input
+			if isUpper(runes[idx]) {
+				runes[idx] = 'A' + rune(out[i])
+			} else {
+				runes[idx] = 'a' + rune(out[i])
+			}
+		}
+	}
+
+	return string(runes), nil
+}
+
+func transformNumerals(
+	key []byte,
+	algorithm Algorithm,
+	tweak string,
+	class characterClass,
+	radix int,
+	numerals []uint16,
+	isEncrypt bool,
+) ([]uint16, error) {
+	// groups that are too small to be encrypted securely, such as the digits of a house number, are left unchanged
+	if pow(radix, len(numerals)).Cmp(big.NewInt(minDomainSize)) < 0 {
+		return numerals, nil
+	}
+
+	switch algorithm {
+	case AlgorithmFF1:
+		cipher, err := newFF1(key, radix)
+		if err != nil {
+			return nil, err
+		}
+		classTweak := deriveTweak(tweak, class, ff1TweakLength)
+		if isEncrypt {
+			return cipher.encrypt(numerals, classTweak)
+		}
+		return cipher.decrypt(numerals, classTweak)
+	case AlgorithmFF31:
+		cipher, err := newFF3(key, radix)
+		if err != nil {
+			return nil, err
+		}
+		// ff3-1 limits the length of its input, so longer groups are encrypted in chunks that each use their own tweak
+		out := make([]uint16, 0, len(numerals))
+		for idx, chunk := range splitNumerals(numerals, cipher.maxLen) {
+			tl, tr, err := ff31Tweak(deriveChunkTweak(tweak, class, idx, ff31TweakLength))
+			if err != nil {
+				return nil, err
+			}
+			var transformed []uint16
+			if isEncrypt {
+				transformed, err = cipher.encrypt(chunk, tl, tr)
+			} else {
+				transformed, err = cipher.decrypt(chunk, tl, tr)
+			}
+			if err != nil {
+				return nil, err
+			}
+			out = append(out, transformed...)
+		}
+		return out, nil
+	default:
+		return nil, fmt.Errorf("unsupported format preserving encryption algorithm: %s", algorithm)
+	}
+}
+
+// Derives a fixed length tweak from the user provided tweak and the character class so that
+// digits and letters are never encrypted under the same tweak.
+func deriveTweak(tweak string, class characterClass, length int) []byte {
+	hash := sha256.Sum256(append([]byte{byte(class)}, []byte(tweak)...))
+	return hash[:length]
+}
+
+// Derives the tweak of a chunk of a group. The first chunk uses the tweak of the whole group.
+func deriveChunkTweak(tweak string, class characterClass, chunk, length int) []byte {
+	if chunk == 0 {
+		return deriveTweak(tweak, class, length)
+	}
+	hash := sha256.Sum256(append([]byte{byte(class), byte(chunk >> 8), byte(chunk)}, []byte(tweak)...))
+	return hash[:length]
+}
+
+// Splits the numerals into the fewest chunks of at most maxLength numerals, with the lengths of the chunks differing by at most one.
+// Each chunk is at least half of maxLength long so that it is never too small to be encrypted.
+func splitNumerals(numerals []uint16, maxLength int) [][]uint16 {
+	count := (len(numerals) + maxLength - 1) / maxLength
+	if count <= 1 {
+		return [][]uint16{numerals}
+	}
+	chunks := make([][]uint16, 0, count)
+	start := 0
+	for idx := 0; idx < count; idx++ {
+		length := len(numerals) / count
+		if idx < len(numerals)%count {
+			length++
+		}
+		chunks = append(chunks, numerals[start:start+length])
+		start += length
+	}
+	return chunks
+}
+
+func isDigit(r rune) bool {
+	return r >= '0' && r <= '9'
+}
+
+func isLetter(r rune) bool {
+	return (r >= 'a' && r <= 'z') || isUpper(r)
+}
+
+func isUpper(r rune) bool {
+	return r >= 'A' && r <= 'Z'
+}
+
+func toLower(r rune) rune {
+	if isUpper(r) {
+		return r + ('a' - 'A')
+	}
+	return r
+}
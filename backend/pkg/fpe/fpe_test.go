@@ -0,0 +1,278 @@
+package fpe
+
+import (
+	"encoding/hex"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const alphabet = "0123456789abcdefghijklmnopqrstuvwxyz"
+
+func toNumerals(t *testing.T, value string) []uint16 {
+	t.Helper()
+	out := make([]uint16, len(value))
+	for i, r := range value {
+		idx := strings.IndexRune(alphabet, r)
+		require.GreaterOrEqual(t, idx, 0)
+		out[i] = uint16(idx)
+	}
+	return out
+}
+
+func fromNumerals(numerals []uint16) string {
+	var sb strings.Builder
+	for _, n := range numerals {
+		sb.WriteByte(alphabet[n])
+	}
+	return sb.String()
+}
+
+func mustDecodeHex(t *testing.T, value string) []byte {
+	t.Helper()
+	out, err := hex.DecodeString(value)
+	require.NoError(t, err)
+	return out
+}
+
+// Sample vectors from the NIST FF1 examples
+func Test_FF1_Vectors(t *testing.T) {
+	type testcase struct {
+		key        string
+		radix      int
+		tweak      string
+		plaintext  string
+		ciphertext string
+	}
+	testcases := []testcase{
+		{"2B7E151628AED2A6ABF7158809CF4F3C", 10, "", "0123456789", "2433477484"},
+		{"2B7E151628AED2A6ABF7158809CF4F3C", 10, "39383736353433323130", "0123456789", "6124200773"},
+		{"2B7E151628AED2A6ABF7158809CF4F3C", 36, "3737373770717273373737", "0123456789abcdefghi", "a9tv40mll9kdu509eum"},
+		{"2B7E151628AED2A6ABF7158809CF4F3CEF4359D8D580AA4F7F036D6F04FC6A94", 10, "", "0123456789", "6657667009"},
+	}
+
+	for _, tc := range testcases {
+		tc := tc
+		t.Run(tc.plaintext, func(t *testing.T) {
+			cipher, err := newFF1(mustDecodeHex(t, tc.key), tc.radix)
+			require.NoError(t, err)
+			tweak := mustDecodeHex(t, tc.tweak)
+
+			ciphertext, err := cipher.encrypt(toNumerals(t, tc.plaintext), tweak)
+			require.NoError(t, err)
+			assert.Equal(t, tc.ciphertext, fromNumerals(ciphertext))
+
+			plaintext, err := cipher.decrypt(ciphertext, tweak)
+			require.NoError(t, err)
+			assert.Equal(t, tc.plaintext, fromNumerals(plaintext))
+		})
+	}
+}
+
+// Sample vectors from the NIST FF3 examples
+func Test_FF3_Vectors(t *testing.T) {
+	type testcase struct {
+		key        string
+		radix      int
+		tweak      string
+		plaintext  string
+		ciphertext string
+	}
+	testcases := []testcase{
+		{"EF4359D8D580AA4F7F036D6F04FC6A94", 10, "D8E7920AFA330A73", "890121234567890000", "750918814058654607"},
+		{"EF4359D8D580AA4F7F036D6F04FC6A94", 10, "9A768A92F60E12D8", "890121234567890000", "018989839189395384"},
+	}
+
+	for _, tc := range testcases {
+		tc := tc
+		t.Run(tc.plaintext, func(t *testing.T) {
+			cipher, err := newFF3(mustDecodeHex(t, tc.key), tc.radix)
+			require.NoError(t, err)
+			tl, tr, err := ff3Tweak(mustDecodeHex(t, tc.tweak))
+			require.NoError(t, err)
+
+			ciphertext, err := cipher.encrypt(toNumerals(t, tc.plaintext), tl, tr)
+			require.NoError(t, err)
+			assert.Equal(t, tc.ciphertext, fromNumerals(ciphertext))
+
+			plaintext, err := cipher.decrypt(ciphertext, tl, tr)
+			require.NoError(t, err)
+			assert.Equal(t, tc.plaintext, fromNumerals(plaintext))
+		})
+	}
+}
+
+func Test_ff31Tweak(t *testing.T) {
+	tl, tr, err := ff31Tweak([]byte{0x01, 0x02, 0x03, 0xAB, 0x04, 0x05, 0x06})
+	require.NoError(t, err)
+	assert.Equal(t, [4]byte{0x01, 0x02, 0x03, 0xA0}, tl)
+	assert.Equal(t, [4]byte{0x04, 0x05, 0x06, 0xB0}, tr)
+
+	_, _, err = ff31Tweak([]byte{0x01})
+	assert.Error(t, err)
+}
+
+func Test_FF3_MaxLength(t *testing.T) {
+	cipher, err := newFF3(mustDecodeHex(t, "EF4359D8D580AA4F7F036D6F04FC6A94"), 10)
+	require.NoError(t, err)
+	assert.Equal(t, 56, cipher.maxLen)
+
+	_, err = cipher.encrypt(make([]uint16, 57), [4]byte{}, [4]byte{})
+	assert.Error(t, err)
+}
+
+func Test_Encrypt_Decrypt_Roundtrip(t *testing.T) {
+	key, err := DeriveKey("account-key")
+	require.NoError(t, err)
+
+	values := []string{
+		"4111-1111-1111-1111",
+		"John Smith",
+		"john.smith@example.com",
+		"123-45-6789",
+		"Über Straße 42, Apt 7b",
+		"abcde",
+		"123456",
+	}
+
+	for _, algorithm := range []Algorithm{AlgorithmFF1, AlgorithmFF31} {
+		for _, value := range values {
+			algorithm := algorithm
+			value := value
+			t.Run(string(algorithm)+"_"+value, func(t *testing.T) {
+				encrypted, err := Encrypt(key, algorithm, "tweak", value)
+				require.NoError(t, err)
+				assert.NotEqual(t, value, encrypted)
+				assertSameFormat(t, value, encrypted)
+
+				again, err := Encrypt(key, algorithm, "tweak", value)
+				require.NoError(t, err)
+				assert.Equal(t, encrypted, again)
+
+				decrypted, err := Decrypt(key, algorithm, "tweak", encrypted)
+				require.NoError(t, err)
+				assert.Equal(t, value, decrypted)
+			})
+		}
+	}
+}
+
+func Test_Encrypt_Tweak_Changes_Output(t *testing.T) {
+	key, err := DeriveKey("account-key")
+	require.NoError(t, err)
+
+	first, err := Encrypt(key, AlgorithmFF1, "one", "4111111111111111")
+	require.NoError(t, err)
+	second, err := Encrypt(key, AlgorithmFF1, "two", "4111111111111111")
+	require.NoError(t, err)
+	assert.NotEqual(t, first, second)
+}
+
+func Test_Encrypt_Group_Sizes(t *testing.T) {
+	key, err := DeriveKey("account-key")
+	require.NoError(t, err)
+
+	type testcase struct {
+		name  string
+		value string
+		// the characters of the value that must be left unchanged
+		unchanged string
+	}
+	testcases := []testcase{
+		{name: "empty", value: "", unchanged: ""},
+		{name: "single letter and digit", value: "a1", unchanged: "a1"},
+		{name: "single letter", value: "X1234567", unchanged: "X"},
+		{name: "single digit", value: "abcdef1", unchanged: "1"},
+		{name: "four letters", value: "Main 123456", unchanged: "Main"},
+		{name: "five letters", value: "Mains", unchanged: ""},
+		{name: "five digits", value: "Apartment 12345", unchanged: "12345"},
+		{name: "six digits", value: "123456", unchanged: ""},
+		{name: "too few letters and digits", value: "ab-1234", unchanged: "ab-1234"},
+		{name: "long letters", value: strings.Repeat("abcdefghij", 5)},
+		{name: "long digits", value: strings.Repeat("0123456789", 7)},
+		{name: "long letters and digits", value: strings.Repeat("abcdefghij0123456789", 5)},
+	}
+
+	for _, algorithm := range []Algorithm{AlgorithmFF1, AlgorithmFF31} {
+		for _, tc := range testcases {
+			algorithm := algorithm
+			tc := tc
+			t.Run(string(algorithm)+"_"+tc.name, func(t *testing.T) {
+				encrypted, err := Encrypt(key, algorithm, "tweak", tc.value)
+				require.NoError(t, err)
+				assertSameFormat(t, tc.value, encrypted)
+				for idx, r := range tc.value {
+					if strings.ContainsRune(tc.unchanged, r) {
+						assert.Equal(t, string(r), string(encrypted[idx]))
+					}
+				}
+				if len(tc.unchanged) < len(tc.value) {
+					assert.NotEqual(t, tc.value, encrypted)
+				}
+
+				decrypted, err := Decrypt(key, algorithm, "tweak", encrypted)
+				require.NoError(t, err)
+				assert.Equal(t, tc.value, decrypted)
+			})
+		}
+	}
+}
+
+func Test_splitNumerals(t *testing.T) {
+	assert.Equal(t, [][]uint16{{1, 2, 3}}, splitNumerals([]uint16{1, 2, 3}, 4))
+	assert.Equal(t, [][]uint16{{1, 2, 3}, {4, 5}}, splitNumerals([]uint16{1, 2, 3, 4, 5}, 4))
+
+	chunks := splitNumerals(make([]uint16, 81), 40)
+	require.Len(t, chunks, 3)
+	for _, chunk := range chunks {
+		assert.Len(t, chunk, 27)
+	}
+}
+
+func Test_DeriveKey(t *testing.T) {
+	key, err := DeriveKey("account-key")
+	require.NoError(t, err)
+	assert.Len(t, key, 32)
+
+	other, err := DeriveKey("other-key")
+	require.NoError(t, err)
+	assert.NotEqual(t, key, other)
+
+	_, err = DeriveKey("")
+	assert.Error(t, err)
+}
+
+func Test_ParseAlgorithm(t *testing.T) {
+	algorithm, err := ParseAlgorithm("FF1")
+	assert.NoError(t, err)
+	assert.Equal(t, AlgorithmFF1, algorithm)
+
+	algorithm, err = ParseAlgorithm("ff3_1")
+	assert.NoError(t, err)
+	assert.Equal(t, AlgorithmFF31, algorithm)
+
+	_, err = ParseAlgorithm("aes")
+	assert.Error(t, err)
+}
+
+func assertSameFormat(t *testing.T, expected, actual string) {
+	t.Helper()
+	expectedRunes := []rune(expected)
+	actualRunes := []rune(actual)
+	require.Len(t, actualRunes, len(expectedRunes))
+	for i := range expectedRunes {
+		e, a := expectedRunes[i], actualRunes[i]
+		switch {
+		case isDigit(e):
+			assert.True(t, isDigit(a))
+		case isUpper(e):
+			assert.True(t, isUpper(a))
+		case isLetter(e):
+			assert.True(t, isLetter(a) && !isUpper(a))
+		default:
+			assert.Equal(t, e, a)
+		}
+	}
+}
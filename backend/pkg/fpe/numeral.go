@@ -0,0 +1,56 @@
+package fpe
+
+import (
+	"math/big"
+)
+
+// Returns the numeral string x as an integer, most significant numeral first.
+func num(x []uint16, radix int) *big.Int {
+	bigRadix := big.NewInt(int64(radix))
+	result := new(big.Int)
+	for _, numeral := range x {
+		result.Mul(result, bigRadix)
+		result.Add(result, big.NewInt(int64(numeral)))
+	}
+	return result
+}
+
+// Returns the integer x as a numeral string of length m, most significant numeral first.
+func str(x *big.Int, radix, m int) []uint16 {
+	bigRadix := big.NewInt(int64(radix))
+	remaining := new(big.Int).Set(x)
+	mod := new(big.Int)
+	out := make([]uint16, m)
+	for i := m - 1; i >= 0; i-- {
+		remaining.DivMod(remaining, bigRadix, mod)
+		out[i] = uint16(mod.Uint64())
+	}
+	return out
+}
+
+// Returns the big-endian byte representation of x left padded to length bytes.
+func numToBytes(x *big.Int, length int) []byte {
+	out := make([]byte, length)
+	return x.FillBytes(out)
+}
+
+func reverseNumerals(x []uint16) []uint16 {
+	out := make([]uint16, len(x))
+	for i := range x {
+		out[len(x)-1-i] = x[i]
+	}
+	return out
+}
+
+func reverseBytes(x []byte) []byte {
+	out := make([]byte, len(x))
+	for i := range x {
+		out[len(x)-1-i] = x[i]
+	}
+	return out
+}
+
+// Returns radix^m.
+func pow(radix, m int) *big.Int {
+	return new(big.Int).Exp(big.NewInt(int64(radix)), big.NewInt(int64(m)), nil)
+}
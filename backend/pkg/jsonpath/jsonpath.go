@@ -0,0 +1,177 @@
+package jsonpath
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+type segmentKind int
+
+const (
+	keySegment segmentKind = iota
+	indexSegment
+	wildcardSegment
+)
+
+type segment struct {
+	kind  segmentKind
+	key   string
+	index int
+}
+
+// A parsed JSONPath expression.
+// Supports the root ($), child keys ($.a or $['a']), array indexes ($[0], $[-1]) and wildcards ($.*, $[*]).
+type Path struct {
+	raw      string
+	segments []segment
+}
+
+func Parse(path string) (*Path, error) {
+	path = strings.TrimSpace(path)
+	if !strings.HasPrefix(path, "$") {
+		return nil, errors.New("path must start with $")
+	}
+
+	segments := []segment{}
+	rest := path[1:]
+	for rest != "" {
+		switch rest[0] {
+		case '.':
+			rest = rest[1:]
+			if strings.HasPrefix(rest, ".") {
+				return nil, errors.New("recursive descent (..) is not supported")
+			}
+			if strings.HasPrefix(rest, "*") {
+				segments = append(segments, segment{kind: wildcardSegment})
+				rest = rest[1:]
+				continue
+			}
+			end := strings.IndexAny(rest, ".[")
+			if end == -1 {
+				end = len(rest)
+			}
+			key := rest[:end]
+			if key == "" {
+				return nil, fmt.Errorf("missing key after . in %s", path)
+			}
+			if strings.ContainsAny(key, " ]'\"*") {
+				return nil, fmt.Errorf("invalid key %q in %s, use the bracket notation for keys with special characters", key, path)
+			}
+			segments = append(segments, segment{kind: keySegment, key: key})
+			rest = rest[end:]
+		case '[':
+			seg, n, err := parseBracket(rest)
+			if err != nil {
+				return nil, fmt.Errorf("%w in %s", err, path)
+			}
+			segments = append(segments, seg)
+			rest = rest[n:]
+		default:
+			return nil, fmt.Errorf("unexpected character %q in %s", rest[0], path)
+		}
+	}
+	return &Path{raw: path, segments: segments}, nil
+}
+
+// Parses a bracket segment at the start of the input and returns the number of bytes that it spans
+func parseBracket(input string) (segment, int, error) {
+	if len(input) > 1 && (input[1] == '\'' || input[1] == '"') {
+		quote := input[1]
+		end := strings.IndexByte(input[2:], quote)
+		if end == -1 {
+			return segment{}, 0, errors.New("unterminated quoted key")
+		}
+		closing := end + 3
+		if closing >= len(input) || input[closing] != ']' {
+			return segment{}, 0, errors.New("expected ] after quoted key")
+		}
+		return segment{kind: keySegment, key: input[2 : end+2]}, closing + 1, nil
+	}
+
+	end := strings.IndexByte(input, ']')
+	if end == -1 {
+		return segment{}, 0, errors.New("unterminated [")
+	}
+	selector := strings.TrimSpace(input[1:end])
+	if selector == "*" {
+		return segment{kind: wildcardSegment}, end + 1, nil
+	}
+	index, err := strconv.Atoi(selector)
+	if err != nil {
+		return segment{}, 0, fmt.Errorf("unsupported selector [%s], only indexes, quoted keys and * are supported", selector)
+	}
+	return segment{kind: indexSegment, index: index}, end + 1, nil
+}
+
+func (p *Path) String() string {
+	return p.raw
+}
+
+// Replaces every value in the document that the path selects with the result of the function.
+// Maps and slices are modified in place. The document is returned since the root itself can be selected by $.
+// Keys and indexes that do not exist in the document are skipped.
+func (p *Path) Transform(doc any, fn func(value any) (any, error)) (any, error) {
+	return transform(doc, p.segments, fn)
+}
+
+func transform(node any, segments []segment, fn func(value any) (any, error)) (any, error) {
+	if len(segments) == 0 {
+		return fn(node)
+	}
+	seg := segments[0]
+	next := segments[1:]
+
+	switch n := node.(type) {
+	case map[string]any:
+		switch seg.kind {
+		case keySegment:
+			child, ok := n[seg.key]
+			if !ok {
+				return n, nil
+			}
+			res, err := transform(child, next, fn)
+			if err != nil {
+				return nil, err
+			}
+			n[seg.key] = res
+		case wildcardSegment:
+			for key, child := range n {
+				res, err := transform(child, next, fn)
+				if err != nil {
+					return nil, err
+				}
+				n[key] = res
+			}
+		}
+		return n, nil
+	case []any:
+		switch seg.kind {
+		case indexSegment:
+			idx := seg.index
+			if idx < 0 {
+				idx += len(n)
+			}
+			if idx < 0 || idx >= len(n) {
+				return n, nil
+			}
+			res, err := transform(n[idx], next, fn)
+			if err != nil {
+				return nil, err
+			}
+			n[idx] = res
+		case wildcardSegment:
+			for idx, child := range n {
+				res, err := transform(child, next, fn)
+				if err != nil {
+					return nil, err
+				}
+				n[idx] = res
+			}
+		}
+		return n, nil
+	default:
+		return node, nil
+	}
+}
@@ -0,0 +1,97 @@
+package jsonpath
+
+import (
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_Parse(t *testing.T) {
+	tests := []struct {
+		path     string
+		expected []segment
+	}{
+		{path: "$", expected: []segment{}},
+		{path: "$.contact.email", expected: []segment{{kind: keySegment, key: "contact"}, {kind: keySegment, key: "email"}}},
+		{path: "$.tags[*]", expected: []segment{{kind: keySegment, key: "tags"}, {kind: wildcardSegment}}},
+		{path: "$.*", expected: []segment{{kind: wildcardSegment}}},
+		{path: "$['first name']", expected: []segment{{kind: keySegment, key: "first name"}}},
+		{path: `$["a.b"][0]`, expected: []segment{{kind: keySegment, key: "a.b"}, {kind: indexSegment, index: 0}}},
+		{path: "$.items[-1].sku", expected: []segment{{kind: keySegment, key: "items"}, {kind: indexSegment, index: -1}, {kind: keySegment, key: "sku"}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.path, func(t *testing.T) {
+			p, err := Parse(tt.path)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, p.segments)
+			assert.Equal(t, tt.path, p.String())
+		})
+	}
+}
+
+func Test_Parse_Invalid(t *testing.T) {
+	paths := []string{
+		"",
+		"contact.email",
+		"$..email",
+		"$.",
+		"$.tags[",
+		"$.tags[?(@.a)]",
+		"$.tags[0:2]",
+		"$['email",
+		"$['email'x",
+		"$email",
+	}
+	for _, path := range paths {
+		t.Run(path, func(t *testing.T) {
+			_, err := Parse(path)
+			assert.Error(t, err)
+		})
+	}
+}
+
+func Test_Path_Transform(t *testing.T) {
+	doc := map[string]any{}
+	err := json.Unmarshal([]byte(`{"contact":{"email":"jane@example.com","phone":"555"},"tags":["a","b"],"items":[{"sku":"x"},{"sku":"y"}]}`), &doc)
+	require.NoError(t, err)
+
+	upper := func(value any) (any, error) {
+		return strings.ToUpper(value.(string)), nil
+	}
+
+	for _, path := range []string{"$.contact.email", "$.tags[*]", "$.items[-1].sku", "$.missing.key", "$.tags[5]"} {
+		p, err := Parse(path)
+		require.NoError(t, err)
+		_, err = p.Transform(doc, upper)
+		require.NoError(t, err)
+	}
+
+	out, err := json.Marshal(doc)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"contact":{"email":"JANE@EXAMPLE.COM","phone":"555"},"tags":["A","B"],"items":[{"sku":"x"},{"sku":"Y"}]}`, string(out))
+}
+
+func Test_Path_Transform_Root(t *testing.T) {
+	p, err := Parse("$")
+	require.NoError(t, err)
+
+	res, err := p.Transform("value", func(value any) (any, error) {
+		return "replaced", nil
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "replaced", res)
+}
+
+func Test_Path_Transform_Error(t *testing.T) {
+	p, err := Parse("$.a")
+	require.NoError(t, err)
+
+	_, err = p.Transform(map[string]any{"a": 1}, func(value any) (any, error) {
+		return nil, errors.New("failed")
+	})
+	assert.Error(t, err)
+}
@@ -0,0 +1,308 @@
+package pii
+
+import (
+	"net"
+	"regexp"
+	"slices"
+	"strings"
+	"unicode"
+)
+
+type Category string
+
+const (
+	CategoryEmail       Category = "email"
+	CategoryPhoneNumber Category = "phone_number"
+	CategoryFirstName   Category = "first_name"
+	CategoryLastName    Category = "last_name"
+	CategoryFullName    Category = "full_name"
+	CategorySsn         Category = "ssn"
+	CategoryAddress     Category = "address"
+	CategoryCardNumber  Category = "card_number"
+	CategoryIpAddress   Category = "ip_address"
+)
+
+// Classifications with a lower confidence than this are not considered PII by default
+const DefaultMinConfidence = 0.5
+
+type ColumnClassification struct {
+	Category   Category
+	Confidence float64
+	// The number of non-empty sample values that were inspected
+	SampledValues int
+	// The number of inspected values that look like the category
+	MatchedValues int
+}
+
+type detector struct {
+	category Category
+	// column name hints made up of snake case tokens. matched against any contiguous run of tokens in the column name
+	nameHints []string
+	// column name hints that only match the entire column name
+	exactNameHints []string
+	matchesValue   func(value string) bool
+	// optional stricter matcher that is used when the column name does not hint at the category.
+	// this prevents numeric identifiers from being mistaken for phone numbers or social security numbers
+	strictMatchesValue func(value string) bool
+	// when true the value matcher is only a plausibility check and the column name carries most of the weight
+	weakValueMatch bool
+}
+
+var (
+	emailRegex   = regexp.MustCompile(`^[^@\s]+@[^@\s]+\.[a-zA-Z]{2,}$`)
+	phoneRegex   = regexp.MustCompile(`^\+?[\d\s\-().]{7,20}$`)
+	ssnRegex     = regexp.MustCompile(`^(\d{3})-?(\d{2})-?(\d{4})$`)
+	cardRegex    = regexp.MustCompile(`^[\d\s\-]{13,23}$`)
+	nameRegex    = regexp.MustCompile(`^\p{L}[\p{L}'\-. ]*$`)
+	addressRegex = regexp.MustCompile(`\d+[A-Za-z]?\s+\p{L}`)
+
+	nonDigitRegex = regexp.MustCompile(`\D`)
+
+	detectors = []*detector{
+		{
+			category:     CategoryEmail,
+			nameHints:    []string{"email", "e_mail", "email_address", "mail"},
+			matchesValue: IsEmail,
+		},
+		{
+			category:     CategoryPhoneNumber,
+			nameHints:    []string{"phone", "phone_number", "telephone", "tel", "mobile", "cell", "fax"},
+			matchesValue: IsPhoneNumber,
+			strictMatchesValue: func(value string) bool {
+				return IsPhoneNumber(value) && nonDigitRegex.MatchString(value)
+			},
+		},
+		{
+			category:     CategorySsn,
+			nameHints:    []string{"ssn", "social_security", "social_security_number"},
+			matchesValue: IsSsn,
+			strictMatchesValue: func(value string) bool {
+				return IsSsn(value) && strings.Contains(value, "-")
+			},
+		},
+		{
+			category:     CategoryCardNumber,
+			nameHints:    []string{"card_number", "credit_card", "cc_number", "pan", "card_no"},
+			matchesValue: IsCardNumber,
+		},
+		{
+			category:     CategoryIpAddress,
+			nameHints:    []string{"ip", "ip_address", "ipv4", "ipv6", "ip_addr", "remote_addr"},
+			matchesValue: IsIpAddress,
+		},
+		{
+			category:       CategoryFirstName,
+			nameHints:      []string{"first_name", "firstname", "fname", "given_name", "forename"},
+			matchesValue:   isNameLike,
+			weakValueMatch: true,
+		},
+		{
+			category:       CategoryLastName,
+			nameHints:      []string{"last_name", "lastname", "lname", "surname", "family_name"},
+			matchesValue:   isNameLike,
+			weakValueMatch: true,
+		},
+		{
+			category:       CategoryFullName,
+			nameHints:      []string{"full_name", "fullname", "display_name", "customer_name", "contact_name"},
+			exactNameHints: []string{"name"},
+			matchesValue:   isNameLike,
+			weakValueMatch: true,
+		},
+		{
+			category:       CategoryAddress,
+			nameHints:      []string{"address", "street", "street_address", "address_line", "addr", "address1", "address2"},
+			matchesValue:   isAddressLike,
+			weakValueMatch: true,
+		},
+	}
+)
+
+// Classifies a column from its name and a sample of its values.
+// Returns nil if the column does not look like it contains any of the known PII categories.
+func ClassifyColumn(column string, values []string, minConfidence float64) *ColumnClassification {
+	tokens := tokenize(column)
+
+	nonEmpty := make([]string, 0, len(values))
+	for _, value := range values {
+		trimmed := strings.TrimSpace(value)
+		if trimmed != "" {
+			nonEmpty = append(nonEmpty, trimmed)
+		}
+	}
+
+	var best *ColumnClassification
+	for _, d := range detectors {
+		nameScore := scoreName(tokens, d)
+		matchesValue := d.matchesValue
+		if nameScore == 0 && d.strictMatchesValue != nil {
+			matchesValue = d.strictMatchesValue
+		}
+		matched := 0
+		for _, value := range nonEmpty {
+			if matchesValue(value) {
+				matched++
+			}
+		}
+
+		var confidence float64
+		switch {
+		case len(nonEmpty) == 0:
+			confidence = 0.7 * nameScore
+		case d.weakValueMatch:
+			// plausible values alone are not enough to flag a column, the name has to hint at the category
+			if nameScore == 0 {
+				continue
+			}
+			confidence = 0.6*nameScore + 0.4*ratio(matched, len(nonEmpty))
+		default:
+			confidence = 0.3*nameScore + 0.7*ratio(matched, len(nonEmpty))
+		}
+
+		if confidence < minConfidence {
+			continue
+		}
+		if best == nil || confidence > best.Confidence {
+			best = &ColumnClassification{
+				Category:      d.category,
+				Confidence:    confidence,
+				SampledValues: len(nonEmpty),
+				MatchedValues: matched,
+			}
+		}
+	}
+	return best
+}
+
+func IsEmail(value string) bool {
+	return emailRegex.MatchString(value)
+}
+
+func IsPhoneNumber(value string) bool {
+	if !phoneRegex.MatchString(value) {
+		return false
+	}
+	digits := nonDigitRegex.ReplaceAllString(value, "")
+	return len(digits) >= 7 && len(digits) <= 15
+}
+
+// Checks that the value is formatted as a US social security number and is in a valid range
+func IsSsn(value string) bool {
+	matches := ssnRegex.FindStringSubmatch(value)
+	if matches == nil {
+		return false
+	}
+	area, group, serial := matches[1], matches[2], matches[3]
+	if area == "000" || area == "666" || area[0] == '9' {
+		return false
+	}
+	return group != "00" && serial != "0000"
+}
+
+func IsCardNumber(value string) bool {
+	if !cardRegex.MatchString(value) {
+		return false
+	}
+	digits := nonDigitRegex.ReplaceAllString(value, "")
+	if len(digits) < 13 || len(digits) > 19 {
+		return false
+	}
+	return IsLuhnValid(digits)
+}
+
+func IsIpAddress(value string) bool {
+	return net.ParseIP(value) != nil
+}
+
+// Validates a string of digits with the Luhn checksum
+func IsLuhnValid(digits string) bool {
+	if digits == "" {
+		return false
+	}
+	sum := 0
+	double := false
+	for i := len(digits) - 1; i >= 0; i-- {
+		if digits[i] < '0' || digits[i] > '9' {
+			return false
+		}
+		digit := int(digits[i] - '0')
+		if double {
+			digit *= 2
+			if digit > 9 {
+				digit -= 9
+			}
+		}
+		sum += digit
+		double = !double
+	}
+	return sum%10 == 0
+}
+
+func isNameLike(value string) bool {
+	return len(value) <= 100 && nameRegex.MatchString(value)
+}
+
+func isAddressLike(value string) bool {
+	return addressRegex.MatchString(value)
+}
+
+func scoreName(tokens []string, d *detector) float64 {
+	joined := strings.Join(tokens, "_")
+	if slices.Contains(d.exactNameHints, joined) {
+		return 1
+	}
+	var best float64
+	for _, hint := range d.nameHints {
+		if hint == joined {
+			return 1
+		}
+		hintTokens := strings.Split(hint, "_")
+		if containsRun(tokens, hintTokens) {
+			// longer hints are more specific
+			score := min(0.8+0.05*float64(len(hintTokens)-1), 0.95)
+			best = max(best, score)
+		}
+	}
+	return best
+}
+
+func containsRun(tokens, run []string) bool {
+	for i := 0; i+len(run) <= len(tokens); i++ {
+		if slices.Equal(tokens[i:i+len(run)], run) {
+			return true
+		}
+	}
+	return false
+}
+
+// Splits a column name into lower case tokens on non alphanumeric characters and camel case boundaries
+func tokenize(column string) []string {
+	tokens := []string{}
+	var current []rune
+	runes := []rune(column)
+	flush := func() {
+		if len(current) > 0 {
+			tokens = append(tokens, strings.ToLower(string(current)))
+			current = nil
+		}
+	}
+	for i, r := range runes {
+		if !unicode.IsLetter(r) && !unicode.IsDigit(r) {
+			flush()
+			continue
+		}
+		if unicode.IsUpper(r) && i > 0 && (unicode.IsLower(runes[i-1]) || (i+1 < len(runes) && unicode.IsLower(runes[i+1]) && unicode.IsUpper(runes[i-1]))) {
+			flush()
+		}
+		current = append(current, r)
+	}
+	flush()
+	return tokens
+}
+
+func ratio(matched, total int) float64 {
+	if total == 0 {
+		return 0
+	}
+	return float64(matched) / float64(total)
+}
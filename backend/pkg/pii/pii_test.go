@@ -0,0 +1,93 @@
+package pii
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_ClassifyColumn(t *testing.T) {
+	type testcase struct {
+		column   string
+		values   []string
+		expected Category
+	}
+	testcases := []testcase{
+		{"email", []string{"alice@corp.com", "bob@example.org"}, CategoryEmail},
+		{"contact", []string{"alice@corp.com", "bob@example.org", ""}, CategoryEmail},
+		{"userEmail", nil, CategoryEmail},
+		{"phone_number", []string{"(555) 555-1234", "+1 555 555 9876"}, CategoryPhoneNumber},
+		{"ssn", []string{"123-45-6789", "234567890"}, CategorySsn},
+		{"cc_number", []string{"4111 1111 1111 1111", "5500-0000-0000-0004"}, CategoryCardNumber},
+		{"last_login_ip", []string{"10.0.0.1", "2001:db8::1"}, CategoryIpAddress},
+		{"ip_address", nil, CategoryIpAddress},
+		{"first_name", []string{"Alice", "Bob"}, CategoryFirstName},
+		{"FirstName", []string{"Alice", "Bob"}, CategoryFirstName},
+		{"surname", []string{"O'Neil", "Smith-Jones"}, CategoryLastName},
+		{"name", []string{"Alice Smith"}, CategoryFullName},
+		{"street_address", []string{"123 Main St", "9b Baker Street"}, CategoryAddress},
+	}
+
+	for _, tc := range testcases {
+		tc := tc
+		t.Run(tc.column, func(t *testing.T) {
+			actual := ClassifyColumn(tc.column, tc.values, DefaultMinConfidence)
+			require.NotNil(t, actual)
+			assert.Equal(t, tc.expected, actual.Category)
+			assert.GreaterOrEqual(t, actual.Confidence, DefaultMinConfidence)
+			assert.LessOrEqual(t, actual.Confidence, float64(1))
+		})
+	}
+}
+
+func Test_ClassifyColumn_No_Pii(t *testing.T) {
+	testcases := map[string][]string{
+		"id":           {"1", "2", "3"},
+		"order_id":     {"1234567", "2345678901", "123456789"},
+		"created_at":   {"2024-01-01T00:00:00Z"},
+		"company_name": {"Acme"},
+		"username":     {"alice", "bob"},
+		"status":       {"active", "inactive"},
+		"quantity":     {"5", "12"},
+	}
+	for column, values := range testcases {
+		assert.Nil(t, ClassifyColumn(column, values, DefaultMinConfidence), column)
+	}
+}
+
+func Test_ClassifyColumn_Values_Lower_Confidence(t *testing.T) {
+	matching := ClassifyColumn("email", []string{"alice@corp.com", "bob@corp.com"}, 0)
+	require.NotNil(t, matching)
+	mixed := ClassifyColumn("email", []string{"alice@corp.com", "not an email"}, 0)
+	require.NotNil(t, mixed)
+
+	assert.Greater(t, matching.Confidence, mixed.Confidence)
+	assert.Equal(t, 2, mixed.SampledValues)
+	assert.Equal(t, 1, mixed.MatchedValues)
+}
+
+func Test_IsLuhnValid(t *testing.T) {
+	assert.True(t, IsLuhnValid("4111111111111111"))
+	assert.True(t, IsLuhnValid("79927398713"))
+	assert.False(t, IsLuhnValid("4111111111111112"))
+	assert.False(t, IsLuhnValid("41111a1111111111"))
+	assert.False(t, IsLuhnValid(""))
+}
+
+func Test_IsSsn(t *testing.T) {
+	assert.True(t, IsSsn("123-45-6789"))
+	assert.True(t, IsSsn("123456789"))
+	assert.False(t, IsSsn("000-45-6789"))
+	assert.False(t, IsSsn("666-45-6789"))
+	assert.False(t, IsSsn("923-45-6789"))
+	assert.False(t, IsSsn("123-00-6789"))
+	assert.False(t, IsSsn("123-45-0000"))
+}
+
+func Test_tokenize(t *testing.T) {
+	assert.Equal(t, []string{"user", "email", "address"}, tokenize("user_email_address"))
+	assert.Equal(t, []string{"first", "name"}, tokenize("firstName"))
+	assert.Equal(t, []string{"user", "ip", "address"}, tokenize("userIPAddress"))
+	assert.Equal(t, []string{"address1"}, tokenize("Address1"))
+}
@@ -0,0 +1,256 @@
+package secrets
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"regexp"
+	"strings"
+
+	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
+	"google.golang.org/protobuf/proto"
+)
+
+// A secret reference in a connection config that could not be resolved
+type ReferenceError struct {
+	// The path of the field that contains the reference. Ex: pg_config.connection.pass
+	Field     string
+	Reference string
+	Err       error
+}
+
+func (e *ReferenceError) Error() string {
+	return fmt.Sprintf("unable to resolve secret reference in %s: %s", e.Field, e.Err.Error())
+}
+
+func (e *ReferenceError) Unwrap() error {
+	return e.Err
+}
+
+// Every secret reference in a connection config that could not be resolved
+type ReferenceErrors []*ReferenceError
+
+func (e ReferenceErrors) Error() string {
+	msgs := make([]string, 0, len(e))
+	for _, err := range e {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// Replaces the password of a connection url when the connection config is redacted
+const RedactedUrlPassword = "REDACTED"
+
+// A url in the go mysql driver format, ex: user:pass@tcp(host:3306)/db
+var mysqlDsnRegex = regexp.MustCompile(`^([^:/@]*):(.*)@(\w*\(.*)$`)
+
+type secretField struct {
+	path  string
+	value *string
+}
+
+// Returns a copy of the connection config with every secret reference replaced by its value.
+// The original config is left untouched so that the references are never persisted as resolved values.
+// If any reference fails to resolve, a ReferenceErrors containing every failure is returned.
+func (r *Resolver) ResolveConnectionConfig(ctx context.Context, cc *mgmtv1alpha1.ConnectionConfig) (*mgmtv1alpha1.ConnectionConfig, error) {
+	if cc == nil || !HasReferences(cc) {
+		return cc, nil
+	}
+	resolved := proto.Clone(cc).(*mgmtv1alpha1.ConnectionConfig)
+
+	var errs ReferenceErrors
+	for _, field := range getSecretFields(resolved) {
+		if !IsReference(*field.value) {
+			continue
+		}
+		value, err := r.Resolve(ctx, *field.value)
+		if err != nil {
+			errs = append(errs, &ReferenceError{Field: field.path, Reference: *field.value, Err: err})
+			continue
+		}
+		*field.value = value
+	}
+	if len(errs) > 0 {
+		return nil, errs
+	}
+	return resolved, nil
+}
+
+// Returns a copy of the S3 connection config with its credential references resolved
+func (r *Resolver) ResolveAwsS3Config(ctx context.Context, cfg *mgmtv1alpha1.AwsS3ConnectionConfig) (*mgmtv1alpha1.AwsS3ConnectionConfig, error) {
+	if cfg == nil {
+		return nil, nil
+	}
+	resolved, err := r.ResolveConnectionConfig(ctx, &mgmtv1alpha1.ConnectionConfig{
+		Config: &mgmtv1alpha1.ConnectionConfig_AwsS3Config{AwsS3Config: cfg},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resolved.GetAwsS3Config(), nil
+}
+
+// Returns true if any of the secret fields of the connection config are references
+func HasReferences(cc *mgmtv1alpha1.ConnectionConfig) bool {
+	for _, field := range getSecretFields(cc) {
+		if IsReference(*field.value) {
+			return true
+		}
+	}
+	return false
+}
+
+func getSecretFields(cc *mgmtv1alpha1.ConnectionConfig) []secretField {
+	fields := []secretField{}
+	switch config := cc.GetConfig().(type) {
+	case *mgmtv1alpha1.ConnectionConfig_PgConfig:
+		if conn := config.PgConfig.GetConnection(); conn != nil {
+			fields = append(fields, secretField{path: "pg_config.connection.pass", value: &conn.Pass})
+		}
+		fields = append(fields, getTunnelSecretFields("pg_config", config.PgConfig.GetTunnel())...)
+	case *mgmtv1alpha1.ConnectionConfig_MysqlConfig:
+		if conn := config.MysqlConfig.GetConnection(); conn != nil {
+			fields = append(fields, secretField{path: "mysql_config.connection.pass", value: &conn.Pass})
+		}
+		fields = append(fields, getTunnelSecretFields("mysql_config", config.MysqlConfig.GetTunnel())...)
+	case *mgmtv1alpha1.ConnectionConfig_MssqlConfig:
+		if conn := config.MssqlConfig.GetConnection(); conn != nil {
+			fields = append(fields, secretField{path: "mssql_config.connection.pass", value: &conn.Pass})
+		}
+		fields = append(fields, getTunnelSecretFields("mssql_config", config.MssqlConfig.GetTunnel())...)
+	case *mgmtv1alpha1.ConnectionConfig_AwsS3Config:
+		fields = append(fields, getAwsS3CredentialsSecretFields(config.AwsS3Config.GetCredentials())...)
+	}
+	return fields
+}
+
+func getTunnelSecretFields(prefix string, tunnel *mgmtv1alpha1.SSHTunnel) []secretField {
+	fields := []secretField{}
+	if passphrase := tunnel.GetAuthentication().GetPassphrase(); passphrase != nil {
+		fields = append(fields, secretField{path: prefix + ".tunnel.authentication.passphrase.value", value: &passphrase.Value})
+	}
+	if privateKey := tunnel.GetAuthentication().GetPrivateKey(); privateKey != nil {
+		fields = append(fields, secretField{path: prefix + ".tunnel.authentication.private_key.value", value: &privateKey.Value})
+		if privateKey.Passphrase != nil {
+			fields = append(fields, secretField{path: prefix + ".tunnel.authentication.private_key.passphrase", value: privateKey.Passphrase})
+		}
+	}
+	return fields
+}
+
+func getAwsS3CredentialsSecretFields(creds *mgmtv1alpha1.AwsS3Credentials) []secretField {
+	fields := []secretField{}
+	if creds == nil {
+		return fields
+	}
+	if creds.AccessKeyId != nil {
+		fields = append(fields, secretField{path: "aws_s3_config.credentials.access_key_id", value: creds.AccessKeyId})
+	}
+	if creds.SecretAccessKey != nil {
+		fields = append(fields, secretField{path: "aws_s3_config.credentials.secret_access_key", value: creds.SecretAccessKey})
+	}
+	if creds.SessionToken != nil {
+		fields = append(fields, secretField{path: "aws_s3_config.credentials.session_token", value: creds.SessionToken})
+	}
+	return fields
+}
+
+// Returns a copy of the connection config with every secret value cleared so that it can be shared, ex: when exported to a spec.
+// The password of a connection url is replaced with RedactedUrlPassword. Secret references are kept as they do not contain the secret itself.
+func RedactConnectionConfig(cc *mgmtv1alpha1.ConnectionConfig) *mgmtv1alpha1.ConnectionConfig {
+	if cc == nil {
+		return nil
+	}
+	redacted := proto.Clone(cc).(*mgmtv1alpha1.ConnectionConfig)
+	for _, field := range getSecretFields(redacted) {
+		if !IsReference(*field.value) {
+			*field.value = ""
+		}
+	}
+	if connUrl := getConnectionUrl(redacted); connUrl != nil {
+		if password, ok := getUrlPassword(*connUrl); ok && password != "" && !IsReference(password) {
+			*connUrl = setUrlPassword(*connUrl, RedactedUrlPassword)
+		}
+	}
+	return redacted
+}
+
+// Returns a copy of the connection config with each empty secret filled in from the existing config.
+// A connection url password of RedactedUrlPassword is replaced with the password of the existing connection url.
+// This is the inverse of RedactConnectionConfig so that a redacted config can be applied without losing the existing secrets.
+func MergeRedactedConnectionConfig(cc, existing *mgmtv1alpha1.ConnectionConfig) *mgmtv1alpha1.ConnectionConfig {
+	if cc == nil {
+		return nil
+	}
+	merged := proto.Clone(cc).(*mgmtv1alpha1.ConnectionConfig)
+	if existing == nil {
+		return merged
+	}
+	existingValues := map[string]string{}
+	for _, field := range getSecretFields(existing) {
+		existingValues[field.path] = *field.value
+	}
+	for _, field := range getSecretFields(merged) {
+		if *field.value != "" {
+			continue
+		}
+		if value, ok := existingValues[field.path]; ok {
+			*field.value = value
+		}
+	}
+	if connUrl, existingUrl := getConnectionUrl(merged), getConnectionUrl(existing); connUrl != nil && existingUrl != nil {
+		if password, ok := getUrlPassword(*connUrl); ok && password == RedactedUrlPassword {
+			if existingPassword, ok := getUrlPassword(*existingUrl); ok {
+				*connUrl = setUrlPassword(*connUrl, existingPassword)
+			}
+		}
+	}
+	return merged
+}
+
+// Returns the url of a database connection config that is configured with a url instead of its separate connection fields
+func getConnectionUrl(cc *mgmtv1alpha1.ConnectionConfig) *string {
+	switch config := cc.GetConfig().(type) {
+	case *mgmtv1alpha1.ConnectionConfig_PgConfig:
+		if connUrl, ok := config.PgConfig.GetConnectionConfig().(*mgmtv1alpha1.PostgresConnectionConfig_Url); ok {
+			return &connUrl.Url
+		}
+	case *mgmtv1alpha1.ConnectionConfig_MysqlConfig:
+		if connUrl, ok := config.MysqlConfig.GetConnectionConfig().(*mgmtv1alpha1.MysqlConnectionConfig_Url); ok {
+			return &connUrl.Url
+		}
+	case *mgmtv1alpha1.ConnectionConfig_MssqlConfig:
+		if connUrl, ok := config.MssqlConfig.GetConnectionConfig().(*mgmtv1alpha1.MssqlConnectionConfig_Url); ok {
+			return &connUrl.Url
+		}
+	}
+	return nil
+}
+
+// Returns the password of a connection url, if it has one
+func getUrlPassword(connUrl string) (string, bool) {
+	if matches := mysqlDsnRegex.FindStringSubmatch(connUrl); matches != nil {
+		return matches[2], true
+	}
+	parsed, err := url.Parse(connUrl)
+	if err != nil || parsed.User == nil {
+		return "", false
+	}
+	return parsed.User.Password()
+}
+
+// Returns the connection url with its password replaced. The url is returned unchanged if it has no password.
+func setUrlPassword(connUrl, password string) string {
+	if matches := mysqlDsnRegex.FindStringSubmatch(connUrl); matches != nil {
+		return fmt.Sprintf("%s:%s@%s", matches[1], password, matches[3])
+	}
+	parsed, err := url.Parse(connUrl)
+	if err != nil || parsed.User == nil {
+		return connUrl
+	}
+	if _, ok := parsed.User.Password(); !ok {
+		return connUrl
+	}
+	parsed.User = url.UserPassword(parsed.User.Username(), password)
+	return parsed.String()
+}
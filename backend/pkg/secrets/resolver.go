@@ -0,0 +1,183 @@
+package secrets
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"sync"
+)
+
+const (
+	envScheme   = "env://"
+	fileScheme  = "file://"
+	vaultScheme = "vault://"
+
+	defaultEnvPrefix = "NEOSYNC_SECRET_"
+)
+
+// Returns true if the value is a reference to a secret that is stored outside of Neosync
+func IsReference(value string) bool {
+	return strings.HasPrefix(value, envScheme) ||
+		strings.HasPrefix(value, fileScheme) ||
+		strings.HasPrefix(value, vaultScheme)
+}
+
+type ResolverConfig struct {
+	// Only environment variables that start with this prefix may be referenced
+	EnvPrefix string
+	// Only files within these directories may be referenced. File references are disabled if empty.
+	FileDirs []string
+	// Client used to resolve vault references. Vault references are disabled if nil.
+	Vault *VaultClient
+	// Only vault secrets under this path may be referenced. Ex: secret/data/neosync. Vault references are disabled if empty.
+	VaultPathPrefix string
+}
+
+// Resolves secret references into their values.
+// References are resolved in the environment of the process that uses the connection, so they are restricted
+// to an env var prefix and a set of directories to prevent a connection config from reading arbitrary secrets of the host.
+type Resolver struct {
+	envPrefix string
+	fileDirs  []string
+	vault     *VaultClient
+	// cleaned and without leading or trailing slashes
+	vaultPathPrefix string
+
+	lookupEnv func(string) (string, bool)
+	readFile  func(string) ([]byte, error)
+}
+
+func NewResolver(cfg *ResolverConfig) *Resolver {
+	fileDirs := make([]string, 0, len(cfg.FileDirs))
+	for _, dir := range cfg.FileDirs {
+		if dir != "" {
+			fileDirs = append(fileDirs, filepath.Clean(dir))
+		}
+	}
+	var vaultPathPrefix string
+	if cfg.VaultPathPrefix != "" {
+		vaultPathPrefix = strings.Trim(path.Clean("/"+cfg.VaultPathPrefix), "/")
+	}
+	return &Resolver{
+		envPrefix:       cfg.EnvPrefix,
+		fileDirs:        fileDirs,
+		vault:           cfg.Vault,
+		vaultPathPrefix: vaultPathPrefix,
+		lookupEnv:       os.LookupEnv,
+		readFile:        os.ReadFile,
+	}
+}
+
+var defaultResolver = sync.OnceValue(func() *Resolver {
+	return NewResolver(getResolverConfigFromEnv())
+})
+
+// Returns the resolver that is configured from the environment of the current process.
+// SECRETS_ENV_PREFIX restricts env references (defaults to NEOSYNC_SECRET_), SECRETS_FILE_DIRS is a comma separated list of directories that file references may read from,
+// VAULT_ADDR, VAULT_TOKEN and VAULT_NAMESPACE configure vault references, and VAULT_PATH_PREFIX restricts them to the secrets under that path.
+func DefaultResolver() *Resolver {
+	return defaultResolver()
+}
+
+func getResolverConfigFromEnv() *ResolverConfig {
+	envPrefix, ok := os.LookupEnv("SECRETS_ENV_PREFIX")
+	if !ok {
+		envPrefix = defaultEnvPrefix
+	}
+	var fileDirs []string
+	if dirs := os.Getenv("SECRETS_FILE_DIRS"); dirs != "" {
+		fileDirs = strings.Split(dirs, ",")
+	}
+	var vault *VaultClient
+	if addr := os.Getenv("VAULT_ADDR"); addr != "" {
+		vault = NewVaultClient(addr, os.Getenv("VAULT_TOKEN"), os.Getenv("VAULT_NAMESPACE"))
+	}
+	return &ResolverConfig{EnvPrefix: envPrefix, FileDirs: fileDirs, Vault: vault, VaultPathPrefix: os.Getenv("VAULT_PATH_PREFIX")}
+}
+
+// Returns the value of the secret if the value is a reference, otherwise returns the value unchanged
+func (r *Resolver) Resolve(ctx context.Context, value string) (string, error) {
+	switch {
+	case strings.HasPrefix(value, envScheme):
+		return r.resolveEnv(strings.TrimPrefix(value, envScheme))
+	case strings.HasPrefix(value, fileScheme):
+		return r.resolveFile(strings.TrimPrefix(value, fileScheme))
+	case strings.HasPrefix(value, vaultScheme):
+		return r.resolveVault(ctx, strings.TrimPrefix(value, vaultScheme))
+	default:
+		return value, nil
+	}
+}
+
+func (r *Resolver) resolveEnv(name string) (string, error) {
+	if name == "" {
+		return "", errors.New("env reference must include a variable name")
+	}
+	if !strings.HasPrefix(name, r.envPrefix) {
+		return "", fmt.Errorf("env reference must start with the %q prefix", r.envPrefix)
+	}
+	value, ok := r.lookupEnv(name)
+	if !ok {
+		return "", fmt.Errorf("environment variable %q is not set", name)
+	}
+	return value, nil
+}
+
+func (r *Resolver) resolveFile(path string) (string, error) {
+	if len(r.fileDirs) == 0 {
+		return "", errors.New("file references have not been enabled")
+	}
+	if !filepath.IsAbs(path) {
+		return "", errors.New("file reference must be an absolute path")
+	}
+	path = filepath.Clean(path)
+	if !r.isAllowedFile(path) {
+		return "", fmt.Errorf("file %q is not in an allowed secrets directory", path)
+	}
+	contents, err := r.readFile(path)
+	if err != nil {
+		return "", fmt.Errorf("unable to read file: %w", err)
+	}
+	// secret files commonly end with a newline that is not part of the secret
+	return strings.TrimRight(string(contents), "\r\n"), nil
+}
+
+func (r *Resolver) isAllowedFile(path string) bool {
+	for _, dir := range r.fileDirs {
+		rel, err := filepath.Rel(dir, path)
+		if err == nil && rel != "." && rel != ".." && !strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+			return true
+		}
+	}
+	return false
+}
+
+func (r *Resolver) resolveVault(ctx context.Context, reference string) (string, error) {
+	if r.vault == nil {
+		return "", errors.New("vault references have not been enabled")
+	}
+	secretPath, key, ok := strings.Cut(reference, "#")
+	if !ok || secretPath == "" || key == "" {
+		return "", errors.New("vault reference must be in the form vault://path#key")
+	}
+	if r.vaultPathPrefix == "" {
+		return "", errors.New("vault references have not been enabled, a vault path prefix must be configured")
+	}
+	// escaped characters and query strings would be interpreted by vault after the prefix has been checked
+	if strings.ContainsAny(secretPath, "%?") {
+		return "", fmt.Errorf("vault path %q contains invalid characters", secretPath)
+	}
+	secretPath = strings.TrimPrefix(path.Clean("/"+secretPath), "/")
+	if !r.isAllowedVaultPath(secretPath) {
+		return "", fmt.Errorf("vault path %q is not under the allowed %q prefix", secretPath, r.vaultPathPrefix)
+	}
+	return r.vault.ReadSecret(ctx, secretPath, key)
+}
+
+func (r *Resolver) isAllowedVaultPath(secretPath string) bool {
+	return secretPath != r.vaultPathPrefix && strings.HasPrefix(secretPath, r.vaultPathPrefix+"/")
+}
@@ -0,0 +1,75 @@
+package secrets
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// Minimal client for reading secrets from the Vault HTTP API.
+// Supports both version 1 and version 2 of the KV secrets engine.
+type VaultClient struct {
+	address   string
+	token     string
+	namespace string
+
+	httpClient *http.Client
+}
+
+func NewVaultClient(address, token, namespace string) *VaultClient {
+	return &VaultClient{
+		address:    strings.TrimSuffix(address, "/"),
+		token:      token,
+		namespace:  namespace,
+		httpClient: &http.Client{Timeout: 10 * time.Second},
+	}
+}
+
+type vaultSecretResponse struct {
+	Data map[string]any `json:"data"`
+}
+
+// Reads the key of the secret at the given path. For KV version 2 the path must include the data segment. Ex: secret/data/neosync
+func (v *VaultClient) ReadSecret(ctx context.Context, path, key string) (string, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, fmt.Sprintf("%s/v1/%s", v.address, strings.TrimPrefix(path, "/")), http.NoBody)
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("X-Vault-Token", v.token)
+	if v.namespace != "" {
+		req.Header.Set("X-Vault-Namespace", v.namespace)
+	}
+
+	resp, err := v.httpClient.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("unable to read vault secret: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("unable to read vault secret %q: received status code %d", path, resp.StatusCode)
+	}
+
+	var body vaultSecretResponse
+	if err := json.NewDecoder(resp.Body).Decode(&body); err != nil {
+		return "", fmt.Errorf("unable to decode vault secret: %w", err)
+	}
+	data := body.Data
+	// KV version 2 nests the secret data alongside its metadata
+	if nested, ok := data["data"].(map[string]any); ok {
+		if _, hasMetadata := data["metadata"]; hasMetadata {
+			data = nested
+		}
+	}
+	value, ok := data[key]
+	if !ok {
+		return "", fmt.Errorf("key %q was not found in vault secret %q", key, path)
+	}
+	str, ok := value.(string)
+	if !ok {
+		return "", fmt.Errorf("key %q in vault secret %q is not a string", key, path)
+	}
+	return str, nil
+}
@@ -5,14 +5,18 @@ import (
 	"database/sql"
 	"errors"
 	"fmt"
+	"io/fs"
 	"log/slog"
 	"net/url"
+	"os"
+	"path/filepath"
 	"strconv"
 	"strings"
 
 	mysql_queries "github.com/nucleuscloud/neosync/backend/gen/go/db/dbschemas/mysql"
 	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
 	nucleuserrors "github.com/nucleuscloud/neosync/backend/internal/errors"
+	"github.com/nucleuscloud/neosync/backend/pkg/secrets"
 	"github.com/nucleuscloud/neosync/backend/pkg/sshtunnel"
 	"golang.org/x/crypto/ssh"
 )
@@ -69,16 +73,26 @@ type ConnectionDetails struct {
 const (
 	mysqlDriver    = "mysql"
 	postgresDriver = "postgres"
+	mssqlDriver    = "sqlserver"
+	sqliteDriver   = "sqlite"
 	localhost      = "localhost"
 	randomPort     = 0
+
+	// sqlite databases are files on the host that opens the connection, so they must be within this directory
+	sqliteDataDirEnvVar = "SQLITE_DATA_DIR"
 )
 
 // Method for retrieving connection details, including tunneling information.
+// Any secret references in the connection config are resolved with the default secrets resolver.
 // Only use if requiring direct access to the SSH Tunnel, otherwise the SqlConnector should be used instead.
 func GetConnectionDetails(c *mgmtv1alpha1.ConnectionConfig, connectionTimeout *uint32, logger *slog.Logger) (*ConnectionDetails, error) {
 	if c == nil {
 		return nil, errors.New("connection config was nil, expected *mgmtv1alpha1.ConnectionConfig")
 	}
+	c, err := secrets.DefaultResolver().ResolveConnectionConfig(context.Background(), c)
+	if err != nil {
+		return nil, err
+	}
 	switch config := c.Config.(type) {
 	case *mgmtv1alpha1.ConnectionConfig_PgConfig:
 		if config.PgConfig.Tunnel != nil {
@@ -170,11 +184,117 @@ func GetConnectionDetails(c *mgmtv1alpha1.ConnectionConfig, connectionTimeout *u
 		return &ConnectionDetails{
 			GeneralDbConnectConfig: *connDetails,
 		}, nil
+	case *mgmtv1alpha1.ConnectionConfig_MssqlConfig:
+		if config.MssqlConfig.Tunnel != nil {
+			destination, err := getEndpointFromMssqlConnectionConfig(config)
+			if err != nil {
+				return nil, err
+			}
+			authmethod, err := getTunnelAuthMethodFromSshConfig(config.MssqlConfig.Tunnel.Authentication)
+			if err != nil {
+				return nil, err
+			}
+			var publickey ssh.PublicKey
+			if config.MssqlConfig.Tunnel.KnownHostPublicKey != nil {
+				publickey, err = sshtunnel.ParseSshKey(*config.MssqlConfig.Tunnel.KnownHostPublicKey)
+				if err != nil {
+					return nil, err
+				}
+			}
+			tunnel := sshtunnel.New(
+				sshtunnel.NewEndpointWithUser(config.MssqlConfig.Tunnel.GetHost(), int(config.MssqlConfig.Tunnel.GetPort()), config.MssqlConfig.Tunnel.GetUser()),
+				authmethod,
+				destination,
+				sshtunnel.NewEndpoint(localhost, randomPort),
+				1,
+				publickey,
+			)
+			connDetails, err := getGeneralDbConnectionConfigFromMssql(config, connectionTimeout)
+			if err != nil {
+				return nil, err
+			}
+			portValue := int32(randomPort)
+			connDetails.Host = localhost
+			connDetails.Port = portValue
+			return &ConnectionDetails{
+				Tunnel:                 tunnel,
+				GeneralDbConnectConfig: *connDetails,
+			}, nil
+		}
+
+		connDetails, err := getGeneralDbConnectionConfigFromMssql(config, connectionTimeout)
+		if err != nil {
+			return nil, err
+		}
+		return &ConnectionDetails{
+			GeneralDbConnectConfig: *connDetails,
+		}, nil
+	case *mgmtv1alpha1.ConnectionConfig_SqliteConfig:
+		// sqlite databases are local files and never require tunneling
+		path, err := getSqliteDatabasePath(config.SqliteConfig.GetPath(), os.Getenv(sqliteDataDirEnvVar))
+		if err != nil {
+			return nil, nucleuserrors.NewBadRequest(err.Error())
+		}
+		// a busy timeout is set as tables are written to concurrently which would otherwise fail with a locked database
+		return &ConnectionDetails{
+			GeneralDbConnectConfig: GeneralDbConnectConfig{
+				Driver:      sqliteDriver,
+				Database:    path,
+				QueryParams: url.Values{"_pragma": []string{"busy_timeout(5000)"}},
+			},
+		}, nil
 	default:
 		return nil, nucleuserrors.NewNotImplemented("this connection config is not currently supported")
 	}
 }
 
+// Returns the absolute path of the sqlite database file.
+// Relative paths are resolved against the data directory and paths that resolve outside of it are rejected
+// so that a connection can not open or create arbitrary files on the host.
+func getSqliteDatabasePath(path, dataDir string) (string, error) {
+	if dataDir == "" {
+		return "", fmt.Errorf("sqlite connections have not been enabled, %s must be set", sqliteDataDirEnvVar)
+	}
+	if path == "" {
+		return "", errors.New("sqlite database path must not be empty")
+	}
+	dataDir, err := filepath.Abs(dataDir)
+	if err != nil {
+		return "", fmt.Errorf("unable to resolve sqlite data directory: %w", err)
+	}
+	if !filepath.IsAbs(path) {
+		path = filepath.Join(dataDir, path)
+	}
+	path = filepath.Clean(path)
+	if !isWithinDir(dataDir, path) {
+		return "", fmt.Errorf("sqlite database path must be within %s", dataDir)
+	}
+
+	// symlinks are followed when the file is opened, so the file they point to must also be within the data directory
+	resolvedPath, err := filepath.EvalSymlinks(path)
+	if err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return "", fmt.Errorf("unable to resolve sqlite database path: %w", err)
+	} else if err == nil {
+		resolvedDataDir, err := filepath.EvalSymlinks(dataDir)
+		if err != nil {
+			return "", fmt.Errorf("unable to resolve sqlite data directory: %w", err)
+		}
+		if !isWithinDir(resolvedDataDir, resolvedPath) {
+			return "", fmt.Errorf("sqlite database path must be within %s", dataDir)
+		}
+	}
+	return path, nil
+}
+
+// Both paths must be absolute and cleaned
+func isWithinDir(dir, path string) bool {
+	rel, err := filepath.Rel(dir, path)
+	if err != nil {
+		return false
+	}
+	return rel != "." && rel != ".." && !strings.HasPrefix(rel, ".."+string(filepath.Separator))
+}
+
 // Auth Method is optional and will return nil if there is no valid method.
 // Will only return error if unable to parse the private key into an auth method
 func getTunnelAuthMethodFromSshConfig(auth *mgmtv1alpha1.SSHAuthentication) (ssh.AuthMethod, error) {
@@ -225,6 +345,21 @@ func getEndpointFromMysqlConnectionConfig(config *mgmtv1alpha1.ConnectionConfig_
 	}
 }
 
+func getEndpointFromMssqlConnectionConfig(config *mgmtv1alpha1.ConnectionConfig_MssqlConfig) (*sshtunnel.Endpoint, error) {
+	switch cc := config.MssqlConfig.ConnectionConfig.(type) {
+	case *mgmtv1alpha1.MssqlConnectionConfig_Connection:
+		return sshtunnel.NewEndpointWithUser(cc.Connection.Host, int(cc.Connection.Port), cc.Connection.User), nil
+	case *mgmtv1alpha1.MssqlConnectionConfig_Url:
+		details, err := getGeneralDbConnectionConfigFromMssql(config, nil)
+		if err != nil {
+			return nil, err
+		}
+		return sshtunnel.NewEndpointWithUser(details.Host, int(details.Port), details.User), nil
+	default:
+		return nil, nucleuserrors.NewBadRequest("must provide valid mssql connection")
+	}
+}
+
 type GeneralDbConnectConfig struct {
 	Driver string
 
@@ -274,6 +409,33 @@ func (g *GeneralDbConnectConfig) String() string {
 		}
 		return dsn
 	}
+	if g.Driver == mssqlDriver {
+		u := url.URL{
+			Scheme: "sqlserver",
+			Host:   fmt.Sprintf("%s:%d", g.Host, g.Port),
+		}
+		if g.User != "" || g.Pass != "" {
+			u.User = url.UserPassword(g.User, g.Pass)
+		}
+		query := url.Values{}
+		for k, v := range g.QueryParams {
+			query[k] = v
+		}
+		if g.Database != "" {
+			query.Set("database", g.Database)
+		}
+		u.RawQuery = query.Encode()
+		return u.String()
+	}
+	if g.Driver == sqliteDriver {
+		// the path is escaped so that it can not add query params to the dsn
+		u := url.URL{
+			Scheme:   "file",
+			Path:     g.Database,
+			RawQuery: g.QueryParams.Encode(),
+		}
+		return u.String()
+	}
 	return ""
 }
 
@@ -302,6 +464,71 @@ func getGeneralDbConnectionConfigFromMysql(config *mgmtv1alpha1.ConnectionConfig
 	}
 }
 
+func getGeneralDbConnectionConfigFromMssql(config *mgmtv1alpha1.ConnectionConfig_MssqlConfig, connectionTimeout *uint32) (*GeneralDbConnectConfig, error) {
+	switch cc := config.MssqlConfig.ConnectionConfig.(type) {
+	case *mgmtv1alpha1.MssqlConnectionConfig_Connection:
+		query := url.Values{}
+		if connectionTimeout != nil {
+			query.Add("connection timeout", fmt.Sprintf("%d", *connectionTimeout))
+		}
+		return &GeneralDbConnectConfig{
+			Driver:      mssqlDriver,
+			Host:        cc.Connection.Host,
+			Port:        cc.Connection.Port,
+			Database:    cc.Connection.Name,
+			User:        cc.Connection.User,
+			Pass:        cc.Connection.Pass,
+			QueryParams: query,
+		}, nil
+	case *mgmtv1alpha1.MssqlConnectionConfig_Url:
+		u, err := url.Parse(cc.Url)
+		if err != nil {
+			return nil, err
+		}
+
+		user := u.User.Username()
+		pass, ok := u.User.Password()
+		if !ok {
+			return nil, errors.New("unable to get password for mssql string")
+		}
+
+		host, portStr := u.Hostname(), u.Port()
+
+		var port int64
+		if portStr != "" {
+			port, err = strconv.ParseInt(portStr, 10, 32)
+			if err != nil {
+				return nil, fmt.Errorf("invalid port: %v", err)
+			}
+		} else {
+			// default to standard sql server port 1433 if port not provided
+			port = int64(1433)
+		}
+
+		query := u.Query()
+		database := query.Get("database")
+		query.Del("database")
+		if database == "" {
+			database = strings.TrimPrefix(u.Path, "/")
+		}
+		if connectionTimeout != nil {
+			query.Set("connection timeout", fmt.Sprintf("%d", *connectionTimeout))
+		}
+
+		return &GeneralDbConnectConfig{
+			Driver:      mssqlDriver,
+			Host:        host,
+			Port:        int32(port),
+			Database:    database,
+			User:        user,
+			Pass:        pass,
+			QueryParams: query,
+		}, nil
+	default:
+		return nil, nucleuserrors.NewBadRequest("must provide valid mssql connection")
+	}
+}
+
 func getGeneralDbConnectConfigFromPg(config *mgmtv1alpha1.ConnectionConfig_PgConfig, connectionTimeout *uint32) (*GeneralDbConnectConfig, error) {
 	switch cc := config.PgConfig.ConnectionConfig.(type) {
 	case *mgmtv1alpha1.PostgresConnectionConfig_Connection:
@@ -0,0 +1,717 @@
+package v1alpha1_accountspecservice
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"slices"
+
+	"connectrpc.com/connect"
+	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
+	logger_interceptor "github.com/nucleuscloud/neosync/backend/internal/connect/interceptors/logger"
+	nucleuserrors "github.com/nucleuscloud/neosync/backend/internal/errors"
+	"github.com/nucleuscloud/neosync/backend/pkg/secrets"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	connectionConfigField = "connection_config"
+	descriptionField      = "description"
+	configField           = "config"
+	cronScheduleField     = "cron_schedule"
+	sourceField           = "source"
+	destinationsField     = "destinations"
+	mappingsField         = "mappings"
+	workflowOptionsField  = "workflow_options"
+	syncOptionsField      = "sync_options"
+	triggersField         = "triggers"
+)
+
+func (s *Service) ApplyAccountSpec(
+	ctx context.Context,
+	req *connect.Request[mgmtv1alpha1.ApplyAccountSpecRequest],
+) (*connect.Response[mgmtv1alpha1.ApplyAccountSpecResponse], error) {
+	logger := logger_interceptor.GetLoggerFromContextOrDefault(ctx)
+	logger = logger.With("accountId", req.Msg.AccountId)
+
+	if err := s.verifyUserInAccount(ctx, req.Msg.AccountId); err != nil {
+		return nil, err
+	}
+
+	plan, err := s.planAccountSpec(ctx, req.Msg.AccountId, req.Msg.Spec)
+	if err != nil {
+		return nil, err
+	}
+
+	if !req.Msg.DryRun {
+		logger.Info("applying account spec")
+		if err := s.applyPlan(ctx, logger, req.Msg.AccountId, plan); err != nil {
+			return nil, err
+		}
+	}
+
+	return connect.NewResponse(&mgmtv1alpha1.ApplyAccountSpecResponse{
+		Changes: plan.getChanges(),
+	}), nil
+}
+
+// Maps the ids that resources are referred to by in the spec to their ids in the account
+type referenceMap struct {
+	resourceType string
+	// Resources that do not exist yet map to their spec id until they are created
+	spec     map[string]string
+	existing map[string]struct{}
+}
+
+func newReferenceMap(resourceType string) *referenceMap {
+	return &referenceMap{resourceType: resourceType, spec: map[string]string{}, existing: map[string]struct{}{}}
+}
+
+// A reference is either the id of a resource in the spec or the id of a resource that already exists in the account
+func (r *referenceMap) resolve(id string) (string, error) {
+	if resolved, ok := r.spec[id]; ok {
+		return resolved, nil
+	}
+	if _, ok := r.existing[id]; ok {
+		return id, nil
+	}
+	return "", nucleuserrors.NewBadRequest(fmt.Sprintf("unknown %s id %s: references must be the id of a %s in the spec or in the account", r.resourceType, id, r.resourceType))
+}
+
+type accountPlan struct {
+	connections  []*connectionChange
+	transformers []*transformerChange
+	jobs         []*jobChange
+
+	connectionRefs  *referenceMap
+	transformerRefs *referenceMap
+	jobRefs         *referenceMap
+}
+
+type connectionChange struct {
+	spec     *mgmtv1alpha1.ConnectionSpec
+	existing *mgmtv1alpha1.Connection
+	// The config that is written, with the secrets of the existing connection filled in
+	config *mgmtv1alpha1.ConnectionConfig
+	change *mgmtv1alpha1.AccountSpecChange
+}
+
+type transformerChange struct {
+	spec     *mgmtv1alpha1.TransformerSpec
+	existing *mgmtv1alpha1.UserDefinedTransformer
+	change   *mgmtv1alpha1.AccountSpecChange
+}
+
+type jobChange struct {
+	spec     *mgmtv1alpha1.JobSpec
+	existing *mgmtv1alpha1.Job
+	change   *mgmtv1alpha1.AccountSpecChange
+}
+
+// The job spec with every reference replaced by the id of the resource in the account
+type resolvedJob struct {
+	source       *mgmtv1alpha1.JobSource
+	destinations []*mgmtv1alpha1.CreateJobDestination
+	mappings     []*mgmtv1alpha1.JobMapping
+	triggers     []*mgmtv1alpha1.JobTrigger
+}
+
+func (p *accountPlan) getChanges() []*mgmtv1alpha1.AccountSpecChange {
+	changes := []*mgmtv1alpha1.AccountSpecChange{}
+	for _, c := range p.connections {
+		changes = append(changes, c.change)
+	}
+	for _, t := range p.transformers {
+		changes = append(changes, t.change)
+	}
+	for _, j := range p.jobs {
+		changes = append(changes, j.change)
+	}
+	return changes
+}
+
+// Compares the spec to the resources of the account. Nothing is changed while planning
+func (s *Service) planAccountSpec(
+	ctx context.Context,
+	accountId string,
+	spec *mgmtv1alpha1.AccountSpec,
+) (*accountPlan, error) {
+	if err := verifySpecIsUnique(spec); err != nil {
+		return nil, err
+	}
+
+	connResp, err := s.connectionService.GetConnections(ctx, connect.NewRequest(&mgmtv1alpha1.GetConnectionsRequest{AccountId: accountId}))
+	if err != nil {
+		return nil, err
+	}
+	transformerResp, err := s.transformerService.GetUserDefinedTransformers(ctx, connect.NewRequest(&mgmtv1alpha1.GetUserDefinedTransformersRequest{AccountId: accountId}))
+	if err != nil {
+		return nil, err
+	}
+	jobResp, err := s.jobService.GetJobs(ctx, connect.NewRequest(&mgmtv1alpha1.GetJobsRequest{AccountId: accountId}))
+	if err != nil {
+		return nil, err
+	}
+
+	plan := &accountPlan{
+		connectionRefs:  newReferenceMap("connection"),
+		transformerRefs: newReferenceMap("transformer"),
+		jobRefs:         newReferenceMap("job"),
+	}
+
+	connectionsByName := map[string]*mgmtv1alpha1.Connection{}
+	for _, connection := range connResp.Msg.Connections {
+		connectionsByName[connection.Name] = connection
+		plan.connectionRefs.existing[connection.Id] = struct{}{}
+	}
+	for _, connSpec := range spec.Connections {
+		existing := connectionsByName[connSpec.Name]
+		c := &connectionChange{
+			spec:     connSpec,
+			existing: existing,
+			change: &mgmtv1alpha1.AccountSpecChange{
+				ResourceType: mgmtv1alpha1.AccountSpecResourceType_ACCOUNT_SPEC_RESOURCE_TYPE_CONNECTION,
+				Name:         connSpec.Name,
+			},
+		}
+		if existing == nil {
+			if connSpec.ConnectionConfig == nil {
+				return nil, nucleuserrors.NewBadRequest(fmt.Sprintf("connection %s does not exist and the spec does not provide its config", connSpec.Name))
+			}
+			c.config = connSpec.ConnectionConfig
+			c.change.Action = mgmtv1alpha1.AccountSpecChangeAction_ACCOUNT_SPEC_CHANGE_ACTION_CREATE
+			plan.connectionRefs.spec[connSpec.Id] = connSpec.Id
+		} else {
+			c.change.Id = &existing.Id
+			c.change.Action = mgmtv1alpha1.AccountSpecChangeAction_ACCOUNT_SPEC_CHANGE_ACTION_UNCHANGED
+			plan.connectionRefs.spec[connSpec.Id] = existing.Id
+			if connSpec.ConnectionConfig != nil {
+				c.config = secrets.MergeRedactedConnectionConfig(connSpec.ConnectionConfig, existing.ConnectionConfig)
+				if !isMessageEqual(c.config, existing.ConnectionConfig) {
+					setUpdate(c.change, []string{connectionConfigField})
+				}
+			}
+		}
+		plan.connections = append(plan.connections, c)
+	}
+
+	transformersByName := map[string]*mgmtv1alpha1.UserDefinedTransformer{}
+	for _, transformer := range transformerResp.Msg.Transformers {
+		transformersByName[transformer.Name] = transformer
+		plan.transformerRefs.existing[transformer.Id] = struct{}{}
+	}
+	for _, transformerSpec := range spec.Transformers {
+		existing := transformersByName[transformerSpec.Name]
+		t := &transformerChange{
+			spec:     transformerSpec,
+			existing: existing,
+			change: &mgmtv1alpha1.AccountSpecChange{
+				ResourceType: mgmtv1alpha1.AccountSpecResourceType_ACCOUNT_SPEC_RESOURCE_TYPE_TRANSFORMER,
+				Name:         transformerSpec.Name,
+			},
+		}
+		if existing == nil {
+			t.change.Action = mgmtv1alpha1.AccountSpecChangeAction_ACCOUNT_SPEC_CHANGE_ACTION_CREATE
+			plan.transformerRefs.spec[transformerSpec.Id] = transformerSpec.Id
+		} else {
+			if existing.Source != transformerSpec.Source {
+				return nil, nucleuserrors.NewBadRequest(fmt.Sprintf("the source of transformer %s can not be changed from %s to %s", transformerSpec.Name, existing.Source, transformerSpec.Source))
+			}
+			t.change.Id = &existing.Id
+			t.change.Action = mgmtv1alpha1.AccountSpecChangeAction_ACCOUNT_SPEC_CHANGE_ACTION_UNCHANGED
+			plan.transformerRefs.spec[transformerSpec.Id] = existing.Id
+			fields := []string{}
+			if transformerSpec.Description != existing.Description {
+				fields = append(fields, descriptionField)
+			}
+			if !isMessageEqual(transformerSpec.Config, existing.Config) {
+				fields = append(fields, configField)
+			}
+			setUpdate(t.change, fields)
+		}
+		plan.transformers = append(plan.transformers, t)
+	}
+
+	jobsByName := map[string]*mgmtv1alpha1.Job{}
+	for _, job := range jobResp.Msg.Jobs {
+		jobsByName[job.Name] = job
+		plan.jobRefs.existing[job.Id] = struct{}{}
+	}
+	// every job is added to the references first as triggers may refer to jobs later in the spec
+	for _, jobSpec := range spec.Jobs {
+		if existing, ok := jobsByName[jobSpec.Name]; ok {
+			plan.jobRefs.spec[jobSpec.Id] = existing.Id
+		} else {
+			plan.jobRefs.spec[jobSpec.Id] = jobSpec.Id
+		}
+	}
+	for _, jobSpec := range spec.Jobs {
+		existing := jobsByName[jobSpec.Name]
+		j := &jobChange{
+			spec:     jobSpec,
+			existing: existing,
+			change: &mgmtv1alpha1.AccountSpecChange{
+				ResourceType: mgmtv1alpha1.AccountSpecResourceType_ACCOUNT_SPEC_RESOURCE_TYPE_JOB,
+				Name:         jobSpec.Name,
+			},
+		}
+		desired, err := plan.resolveJob(jobSpec)
+		if err != nil {
+			return nil, err
+		}
+		if existing == nil {
+			j.change.Action = mgmtv1alpha1.AccountSpecChangeAction_ACCOUNT_SPEC_CHANGE_ACTION_CREATE
+		} else {
+			j.change.Id = &existing.Id
+			j.change.Action = mgmtv1alpha1.AccountSpecChangeAction_ACCOUNT_SPEC_CHANGE_ACTION_UNCHANGED
+			setUpdate(j.change, getJobChangedFields(jobSpec, desired, existing))
+		}
+		plan.jobs = append(plan.jobs, j)
+	}
+	return plan, nil
+}
+
+func setUpdate(change *mgmtv1alpha1.AccountSpecChange, fields []string) {
+	if len(fields) == 0 {
+		return
+	}
+	change.Action = mgmtv1alpha1.AccountSpecChangeAction_ACCOUNT_SPEC_CHANGE_ACTION_UPDATE
+	change.Fields = fields
+}
+
+// Resources are matched by name, so names and the ids they are referred to by must be unique within each kind of resource
+func verifySpecIsUnique(spec *mgmtv1alpha1.AccountSpec) error {
+	type resource struct{ id, name string }
+	sections := map[string][]resource{}
+	for _, c := range spec.Connections {
+		sections["connection"] = append(sections["connection"], resource{c.Id, c.Name})
+	}
+	for _, t := range spec.Transformers {
+		sections["transformer"] = append(sections["transformer"], resource{t.Id, t.Name})
+	}
+	for _, j := range spec.Jobs {
+		sections["job"] = append(sections["job"], resource{j.Id, j.Name})
+	}
+	for _, resourceType := range []string{"connection", "transformer", "job"} {
+		ids := map[string]struct{}{}
+		names := map[string]struct{}{}
+		for _, r := range sections[resourceType] {
+			if _, ok := ids[r.id]; ok {
+				return nucleuserrors.NewBadRequest(fmt.Sprintf("%s id %s is used more than once in the spec", resourceType, r.id))
+			}
+			if _, ok := names[r.name]; ok {
+				return nucleuserrors.NewBadRequest(fmt.Sprintf("%s name %s is used more than once in the spec", resourceType, r.name))
+			}
+			ids[r.id] = struct{}{}
+			names[r.name] = struct{}{}
+		}
+	}
+	return nil
+}
+
+func (p *accountPlan) resolveJob(spec *mgmtv1alpha1.JobSpec) (*resolvedJob, error) {
+	source := proto.Clone(spec.Source).(*mgmtv1alpha1.JobSource)
+	for _, id := range getSourceConnectionIds(source.GetOptions()) {
+		resolved, err := p.connectionRefs.resolve(*id)
+		if err != nil {
+			return nil, err
+		}
+		*id = resolved
+	}
+
+	destinations := make([]*mgmtv1alpha1.CreateJobDestination, 0, len(spec.Destinations))
+	for _, dest := range spec.Destinations {
+		resolved := proto.Clone(dest).(*mgmtv1alpha1.CreateJobDestination)
+		connectionId, err := p.connectionRefs.resolve(dest.ConnectionId)
+		if err != nil {
+			return nil, err
+		}
+		resolved.ConnectionId = connectionId
+		destinations = append(destinations, resolved)
+	}
+
+	mappings := make([]*mgmtv1alpha1.JobMapping, 0, len(spec.Mappings))
+	for _, mapping := range spec.Mappings {
+		resolved := proto.Clone(mapping).(*mgmtv1alpha1.JobMapping)
+		if udtConfig := resolved.GetTransformer().GetConfig().GetUserDefinedTransformerConfig(); udtConfig != nil {
+			transformerId, err := p.transformerRefs.resolve(udtConfig.Id)
+			if err != nil {
+				return nil, err
+			}
+			udtConfig.Id = transformerId
+		}
+		mappings = append(mappings, resolved)
+	}
+
+	triggers := make([]*mgmtv1alpha1.JobTrigger, 0, len(spec.Triggers))
+	for _, trigger := range spec.Triggers {
+		resolved := proto.Clone(trigger).(*mgmtv1alpha1.JobTrigger)
+		jobId, err := p.jobRefs.resolve(trigger.JobId)
+		if err != nil {
+			return nil, err
+		}
+		resolved.JobId = jobId
+		triggers = append(triggers, resolved)
+	}
+
+	return &resolvedJob{
+		source:       source,
+		destinations: destinations,
+		mappings:     mappings,
+		triggers:     triggers,
+	}, nil
+}
+
+// Returns pointers to each connection id in the source options so that they can be replaced
+func getSourceConnectionIds(options *mgmtv1alpha1.JobSourceOptions) []*string {
+	switch config := options.GetConfig().(type) {
+	case *mgmtv1alpha1.JobSourceOptions_Postgres:
+		if config.Postgres != nil {
+			return []*string{&config.Postgres.ConnectionId}
+		}
+	case *mgmtv1alpha1.JobSourceOptions_Mysql:
+		if config.Mysql != nil {
+			return []*string{&config.Mysql.ConnectionId}
+		}
+	case *mgmtv1alpha1.JobSourceOptions_Mssql:
+		if config.Mssql != nil {
+			return []*string{&config.Mssql.ConnectionId}
+		}
+	case *mgmtv1alpha1.JobSourceOptions_PostgresCdc:
+		if config.PostgresCdc != nil {
+			return []*string{&config.PostgresCdc.ConnectionId}
+		}
+	case *mgmtv1alpha1.JobSourceOptions_AwsS3:
+		if config.AwsS3 != nil {
+			return []*string{&config.AwsS3.ConnectionId}
+		}
+	case *mgmtv1alpha1.JobSourceOptions_Generate:
+		if config.Generate != nil && config.Generate.FkSourceConnectionId != nil {
+			return []*string{config.Generate.FkSourceConnectionId}
+		}
+	}
+	return nil
+}
+
+func getJobChangedFields(spec *mgmtv1alpha1.JobSpec, desired *resolvedJob, existing *mgmtv1alpha1.Job) []string {
+	fields := []string{}
+	if spec.CronSchedule != nil && spec.GetCronSchedule() != existing.GetCronSchedule() {
+		fields = append(fields, cronScheduleField)
+	}
+	if !isMessageEqual(desired.source, existing.Source) {
+		fields = append(fields, sourceField)
+	}
+	if !areDestinationsEqual(desired.destinations, existing.Destinations) {
+		fields = append(fields, destinationsField)
+	}
+	if !areMessagesEqual(desired.mappings, existing.Mappings) {
+		fields = append(fields, mappingsField)
+	}
+	if !isMessageEqual(spec.WorkflowOptions, existing.WorkflowOptions) {
+		fields = append(fields, workflowOptionsField)
+	}
+	if !isMessageEqual(spec.SyncOptions, existing.SyncOptions) {
+		fields = append(fields, syncOptionsField)
+	}
+	if !areMessagesEqual(desired.triggers, existing.Triggers) {
+		fields = append(fields, triggersField)
+	}
+	return fields
+}
+
+// Unset messages are equal to empty messages as the api does not distinguish between the two
+func isMessageEqual(a, b proto.Message) bool {
+	if proto.Size(a) == 0 && proto.Size(b) == 0 {
+		return true
+	}
+	return proto.Equal(a, b)
+}
+
+func areMessagesEqual[T proto.Message](a, b []T) bool {
+	return slices.EqualFunc(a, b, func(x, y T) bool {
+		return isMessageEqual(x, y)
+	})
+}
+
+// Destinations are matched by their connection as a job may only have one destination per connection
+func areDestinationsEqual(desired []*mgmtv1alpha1.CreateJobDestination, existing []*mgmtv1alpha1.JobDestination) bool {
+	if len(desired) != len(existing) {
+		return false
+	}
+	existingByConnection := map[string]*mgmtv1alpha1.JobDestination{}
+	for _, dest := range existing {
+		existingByConnection[dest.ConnectionId] = dest
+	}
+	for _, dest := range desired {
+		existingDest, ok := existingByConnection[dest.ConnectionId]
+		if !ok || !isMessageEqual(dest.Options, existingDest.Options) {
+			return false
+		}
+	}
+	return true
+}
+
+// The resources that were created while applying a plan
+type createdResources struct {
+	connectionIds  []string
+	transformerIds []string
+	jobIds         []string
+}
+
+func (s *Service) applyPlan(
+	ctx context.Context,
+	logger *slog.Logger,
+	accountId string,
+	plan *accountPlan,
+) (err error) {
+	created := &createdResources{}
+	defer func() {
+		if err != nil {
+			logger.Error(fmt.Errorf("unable to apply account spec: %w", err).Error())
+			s.removeCreatedResources(ctx, logger, created)
+		}
+	}()
+
+	for _, c := range plan.connections {
+		switch c.change.Action {
+		case mgmtv1alpha1.AccountSpecChangeAction_ACCOUNT_SPEC_CHANGE_ACTION_CREATE:
+			resp, err := s.connectionService.CreateConnection(ctx, connect.NewRequest(&mgmtv1alpha1.CreateConnectionRequest{
+				AccountId:        accountId,
+				Name:             c.spec.Name,
+				ConnectionConfig: c.config,
+			}))
+			if err != nil {
+				return fmt.Errorf("unable to create connection %s: %w", c.spec.Name, err)
+			}
+			id := resp.Msg.Connection.Id
+			created.connectionIds = append(created.connectionIds, id)
+			c.change.Id = &id
+			plan.connectionRefs.spec[c.spec.Id] = id
+		case mgmtv1alpha1.AccountSpecChangeAction_ACCOUNT_SPEC_CHANGE_ACTION_UPDATE:
+			_, err := s.connectionService.UpdateConnection(ctx, connect.NewRequest(&mgmtv1alpha1.UpdateConnectionRequest{
+				Id:               c.existing.Id,
+				Name:             c.spec.Name,
+				ConnectionConfig: c.config,
+			}))
+			if err != nil {
+				return fmt.Errorf("unable to update connection %s: %w", c.spec.Name, err)
+			}
+		}
+	}
+
+	for _, t := range plan.transformers {
+		switch t.change.Action {
+		case mgmtv1alpha1.AccountSpecChangeAction_ACCOUNT_SPEC_CHANGE_ACTION_CREATE:
+			resp, err := s.transformerService.CreateUserDefinedTransformer(ctx, connect.NewRequest(&mgmtv1alpha1.CreateUserDefinedTransformerRequest{
+				AccountId:         accountId,
+				Name:              t.spec.Name,
+				Description:       t.spec.Description,
+				Source:            t.spec.Source,
+				TransformerConfig: t.spec.Config,
+			}))
+			if err != nil {
+				return fmt.Errorf("unable to create transformer %s: %w", t.spec.Name, err)
+			}
+			id := resp.Msg.Transformer.Id
+			created.transformerIds = append(created.transformerIds, id)
+			t.change.Id = &id
+			plan.transformerRefs.spec[t.spec.Id] = id
+		case mgmtv1alpha1.AccountSpecChangeAction_ACCOUNT_SPEC_CHANGE_ACTION_UPDATE:
+			_, err := s.transformerService.UpdateUserDefinedTransformer(ctx, connect.NewRequest(&mgmtv1alpha1.UpdateUserDefinedTransformerRequest{
+				TransformerId:     t.existing.Id,
+				Name:              t.spec.Name,
+				Description:       t.spec.Description,
+				TransformerConfig: t.spec.Config,
+			}))
+			if err != nil {
+				return fmt.Errorf("unable to update transformer %s: %w", t.spec.Name, err)
+			}
+		}
+	}
+
+	// triggers are set once every job exists as they may refer to jobs that are created later in the spec
+	for _, j := range plan.jobs {
+		if j.change.Action == mgmtv1alpha1.AccountSpecChangeAction_ACCOUNT_SPEC_CHANGE_ACTION_UNCHANGED {
+			continue
+		}
+		desired, err := plan.resolveJob(j.spec)
+		if err != nil {
+			return err
+		}
+		if j.change.Action == mgmtv1alpha1.AccountSpecChangeAction_ACCOUNT_SPEC_CHANGE_ACTION_CREATE {
+			resp, err := s.jobService.CreateJob(ctx, connect.NewRequest(&mgmtv1alpha1.CreateJobRequest{
+				AccountId:       accountId,
+				JobName:         j.spec.Name,
+				CronSchedule:    j.spec.CronSchedule,
+				Mappings:        desired.mappings,
+				Source:          desired.source,
+				Destinations:    desired.destinations,
+				WorkflowOptions: j.spec.WorkflowOptions,
+				SyncOptions:     j.spec.SyncOptions,
+			}))
+			if err != nil {
+				return fmt.Errorf("unable to create job %s: %w", j.spec.Name, err)
+			}
+			id := resp.Msg.Job.Id
+			created.jobIds = append(created.jobIds, id)
+			j.change.Id = &id
+			plan.jobRefs.spec[j.spec.Id] = id
+			continue
+		}
+		if err := s.updateJob(ctx, j, desired); err != nil {
+			return fmt.Errorf("unable to update job %s: %w", j.spec.Name, err)
+		}
+	}
+
+	for _, j := range plan.jobs {
+		isNewWithTriggers := j.change.Action == mgmtv1alpha1.AccountSpecChangeAction_ACCOUNT_SPEC_CHANGE_ACTION_CREATE && len(j.spec.Triggers) > 0
+		if !isNewWithTriggers && !slices.Contains(j.change.Fields, triggersField) {
+			continue
+		}
+		desired, err := plan.resolveJob(j.spec)
+		if err != nil {
+			return err
+		}
+		_, err = s.jobService.SetJobTriggers(ctx, connect.NewRequest(&mgmtv1alpha1.SetJobTriggersRequest{
+			Id:       j.change.GetId(),
+			Triggers: desired.triggers,
+		}))
+		if err != nil {
+			return fmt.Errorf("unable to set triggers of job %s: %w", j.spec.Name, err)
+		}
+	}
+	return nil
+}
+
+func (s *Service) updateJob(
+	ctx context.Context,
+	j *jobChange,
+	desired *resolvedJob,
+) error {
+	jobId := j.existing.Id
+	if slices.Contains(j.change.Fields, cronScheduleField) {
+		_, err := s.jobService.UpdateJobSchedule(ctx, connect.NewRequest(&mgmtv1alpha1.UpdateJobScheduleRequest{
+			Id:           jobId,
+			CronSchedule: j.spec.CronSchedule,
+		}))
+		if err != nil {
+			return err
+		}
+	}
+	if slices.Contains(j.change.Fields, sourceField) || slices.Contains(j.change.Fields, mappingsField) {
+		_, err := s.jobService.UpdateJobSourceConnection(ctx, connect.NewRequest(&mgmtv1alpha1.UpdateJobSourceConnectionRequest{
+			Id:       jobId,
+			Source:   desired.source,
+			Mappings: desired.mappings,
+		}))
+		if err != nil {
+			return err
+		}
+	}
+	if slices.Contains(j.change.Fields, destinationsField) {
+		if err := s.updateJobDestinations(ctx, jobId, desired.destinations, j.existing.Destinations); err != nil {
+			return err
+		}
+	}
+	if slices.Contains(j.change.Fields, workflowOptionsField) {
+		_, err := s.jobService.SetJobWorkflowOptions(ctx, connect.NewRequest(&mgmtv1alpha1.SetJobWorkflowOptionsRequest{
+			Id:              jobId,
+			WorfklowOptions: j.spec.WorkflowOptions,
+		}))
+		if err != nil {
+			return err
+		}
+	}
+	if slices.Contains(j.change.Fields, syncOptionsField) {
+		_, err := s.jobService.SetJobSyncOptions(ctx, connect.NewRequest(&mgmtv1alpha1.SetJobSyncOptionsRequest{
+			Id:          jobId,
+			SyncOptions: j.spec.SyncOptions,
+		}))
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// New destinations are added before old ones are removed so that the job always has a destination
+func (s *Service) updateJobDestinations(
+	ctx context.Context,
+	jobId string,
+	desired []*mgmtv1alpha1.CreateJobDestination,
+	existing []*mgmtv1alpha1.JobDestination,
+) error {
+	existingByConnection := map[string]*mgmtv1alpha1.JobDestination{}
+	for _, dest := range existing {
+		existingByConnection[dest.ConnectionId] = dest
+	}
+
+	newDestinations := []*mgmtv1alpha1.CreateJobDestination{}
+	desiredConnections := map[string]struct{}{}
+	for _, dest := range desired {
+		desiredConnections[dest.ConnectionId] = struct{}{}
+		existingDest, ok := existingByConnection[dest.ConnectionId]
+		if !ok {
+			newDestinations = append(newDestinations, dest)
+			continue
+		}
+		if isMessageEqual(dest.Options, existingDest.Options) {
+			continue
+		}
+		_, err := s.jobService.UpdateJobDestinationConnection(ctx, connect.NewRequest(&mgmtv1alpha1.UpdateJobDestinationConnectionRequest{
+			JobId:         jobId,
+			ConnectionId:  dest.ConnectionId,
+			Options:       dest.Options,
+			DestinationId: existingDest.Id,
+		}))
+		if err != nil {
+			return err
+		}
+	}
+	if len(newDestinations) > 0 {
+		_, err := s.jobService.CreateJobDestinationConnections(ctx, connect.NewRequest(&mgmtv1alpha1.CreateJobDestinationConnectionsRequest{
+			JobId:        jobId,
+			Destinations: newDestinations,
+		}))
+		if err != nil {
+			return err
+		}
+	}
+	for _, dest := range existing {
+		if _, ok := desiredConnections[dest.ConnectionId]; ok {
+			continue
+		}
+		_, err := s.jobService.DeleteJobDestinationConnection(ctx, connect.NewRequest(&mgmtv1alpha1.DeleteJobDestinationConnectionRequest{
+			DestinationId: dest.Id,
+		}))
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Removes the resources that were created by an apply that failed part way through.
+// Updates that were made to existing resources before the failure are not reverted
+func (s *Service) removeCreatedResources(
+	ctx context.Context,
+	logger *slog.Logger,
+	created *createdResources,
+) {
+	for idx := len(created.jobIds) - 1; idx >= 0; idx-- {
+		id := created.jobIds[idx]
+		if _, err := s.jobService.DeleteJob(ctx, connect.NewRequest(&mgmtv1alpha1.DeleteJobRequest{Id: id})); err != nil {
+			logger.Error(fmt.Errorf("unable to remove created job %s: %w", id, err).Error())
+		}
+	}
+	for _, id := range created.transformerIds {
+		if _, err := s.transformerService.DeleteUserDefinedTransformer(ctx, connect.NewRequest(&mgmtv1alpha1.DeleteUserDefinedTransformerRequest{TransformerId: id})); err != nil {
+			logger.Error(fmt.Errorf("unable to remove created transformer %s: %w", id, err).Error())
+		}
+	}
+	for _, id := range created.connectionIds {
+		if _, err := s.connectionService.DeleteConnection(ctx, connect.NewRequest(&mgmtv1alpha1.DeleteConnectionRequest{Id: id})); err != nil {
+			logger.Error(fmt.Errorf("unable to remove created connection %s: %w", id, err).Error())
+		}
+	}
+}
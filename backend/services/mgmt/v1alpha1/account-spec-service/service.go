@@ -0,0 +1,32 @@
+package v1alpha1_accountspecservice
+
+import (
+	"github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1/mgmtv1alpha1connect"
+)
+
+type Service struct {
+	cfg *Config
+
+	useraccountService mgmtv1alpha1connect.UserAccountServiceClient
+	connectionService  mgmtv1alpha1connect.ConnectionServiceHandler
+	transformerService mgmtv1alpha1connect.TransformersServiceHandler
+	jobService         mgmtv1alpha1connect.JobServiceHandler
+}
+
+type Config struct{}
+
+func New(
+	cfg *Config,
+	useraccountService mgmtv1alpha1connect.UserAccountServiceClient,
+	connectionService mgmtv1alpha1connect.ConnectionServiceHandler,
+	transformerService mgmtv1alpha1connect.TransformersServiceHandler,
+	jobService mgmtv1alpha1connect.JobServiceHandler,
+) *Service {
+	return &Service{
+		cfg:                cfg,
+		useraccountService: useraccountService,
+		connectionService:  connectionService,
+		transformerService: transformerService,
+		jobService:         jobService,
+	}
+}
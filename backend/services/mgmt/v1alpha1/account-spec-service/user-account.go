@@ -0,0 +1,23 @@
+package v1alpha1_accountspecservice
+
+import (
+	"context"
+
+	"connectrpc.com/connect"
+	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
+	nucleuserrors "github.com/nucleuscloud/neosync/backend/internal/errors"
+)
+
+func (s *Service) verifyUserInAccount(
+	ctx context.Context,
+	accountId string,
+) error {
+	resp, err := s.useraccountService.IsUserInAccount(ctx, connect.NewRequest(&mgmtv1alpha1.IsUserInAccountRequest{AccountId: accountId}))
+	if err != nil {
+		return err
+	}
+	if !resp.Msg.Ok {
+		return nucleuserrors.NewForbidden("user in not in requested account")
+	}
+	return nil
+}
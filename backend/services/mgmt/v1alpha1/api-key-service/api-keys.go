@@ -2,6 +2,7 @@ package v1alpha1_apikeyservice
 
 import (
 	"context"
+	"fmt"
 
 	"connectrpc.com/connect"
 	db_queries "github.com/nucleuscloud/neosync/backend/gen/go/db"
@@ -10,7 +11,9 @@ import (
 	"github.com/nucleuscloud/neosync/backend/internal/dtomaps"
 	nucleuserrors "github.com/nucleuscloud/neosync/backend/internal/errors"
 	"github.com/nucleuscloud/neosync/backend/internal/nucleusdb"
+	"github.com/nucleuscloud/neosync/backend/internal/rbac"
 	"github.com/nucleuscloud/neosync/backend/internal/utils"
+	pg_models "github.com/nucleuscloud/neosync/backend/sql/postgresql/models"
 )
 
 func (s *Service) GetAccountApiKeys(
@@ -82,6 +85,17 @@ func (s *Service) CreateAccountApiKey(
 		return nil, err
 	}
 
+	var scopes *pg_models.AccountApiKeyScopes
+	if req.Msg.Scopes != nil {
+		for _, procedure := range req.Msg.Scopes.GetAllowedProcedures() {
+			if _, ok := rbac.GetProcedureRole(procedure); !ok {
+				return nil, nucleuserrors.NewBadRequest(fmt.Sprintf("unknown procedure in api key scopes: %s", procedure))
+			}
+		}
+		scopes = &pg_models.AccountApiKeyScopes{}
+		scopes.FromDto(req.Msg.Scopes)
+	}
+
 	clearKeyValue := apikey.NewV1AccountKey()
 	hashedKeyValue := utils.ToSha256(
 		clearKeyValue,
@@ -93,6 +107,7 @@ func (s *Service) CreateAccountApiKey(
 		AccountUuid:       *accountUuid,
 		CreatedByUserUuid: *userUuid,
 		ExpiresAt:         expiresAt,
+		Scopes:            scopes,
 	})
 	if err != nil {
 		return nil, err
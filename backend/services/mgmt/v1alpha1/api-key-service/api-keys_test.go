@@ -13,6 +13,7 @@ import (
 	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
 	"github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1/mgmtv1alpha1connect"
 	"github.com/nucleuscloud/neosync/backend/internal/nucleusdb"
+	pg_models "github.com/nucleuscloud/neosync/backend/sql/postgresql/models"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"google.golang.org/protobuf/types/known/timestamppb"
@@ -293,6 +294,84 @@ func Test_Service_RegenerateAccountApiKey_NotFound(t *testing.T) {
 	assert.Nil(t, resp)
 }
 
+func Test_Service_CreateAccountApiKey_Scoped(t *testing.T) {
+	mockDbtx := nucleusdb.NewMockDBTX(t)
+	mockQuerier := db_queries.NewMockQuerier(t)
+	mockTx := new(nucleusdb.MockTx)
+	mockUserAccountService := mgmtv1alpha1connect.NewMockUserAccountServiceClient(t)
+
+	svc := New(&Config{}, nucleusdb.New(mockDbtx, mockQuerier), mockUserAccountService)
+
+	mockUserAccountCalls(mockUserAccountService, true, uuid.NewString())
+	mockDbtx.On("Begin", mock.Anything).Return(mockTx, nil)
+	mockTx.On("Commit", mock.Anything).Return(nil)
+	mockTx.On("Rollback", mock.Anything).Return(nil)
+	user := db_queries.NeosyncApiUser{
+		ID:       newPgUuid(t),
+		UserType: 1,
+	}
+	mockQuerier.On("CreateMachineUser", mock.Anything, mock.Anything, mock.Anything).
+		Return(user, nil)
+
+	jobId := uuid.NewString()
+	expectedScopes := &pg_models.AccountApiKeyScopes{
+		AllowedProcedures: []string{mgmtv1alpha1connect.JobServiceCreateJobRunProcedure},
+		AllowedJobIds:     []string{jobId},
+	}
+	rawData := db_queries.NeosyncApiAccountApiKey{
+		ID:          newPgUuid(t),
+		AccountID:   newPgUuid(t),
+		KeyValue:    "foo",
+		CreatedByID: newPgUuid(t),
+		UpdatedByID: newPgUuid(t),
+		CreatedAt:   pgtype.Timestamp{Time: time.Now(), Valid: true},
+		UpdatedAt:   pgtype.Timestamp{Time: time.Now(), Valid: true},
+		ExpiresAt:   pgtype.Timestamp{Time: time.Now().Add(24 * time.Hour), Valid: true},
+		KeyName:     "foo",
+		UserID:      user.ID,
+		Scopes:      expectedScopes,
+	}
+	mockQuerier.On("CreateAccountApiKey", mock.Anything, mock.Anything, mock.MatchedBy(func(params db_queries.CreateAccountApiKeyParams) bool {
+		return assert.ObjectsAreEqual(expectedScopes, params.Scopes)
+	})).Return(rawData, nil)
+
+	resp, err := svc.CreateAccountApiKey(context.Background(), connect.NewRequest(&mgmtv1alpha1.CreateAccountApiKeyRequest{
+		AccountId: uuid.NewString(),
+		Name:      "foo",
+		ExpiresAt: timestamppb.New(time.Now().Add(24 * time.Hour)),
+		Scopes: &mgmtv1alpha1.AccountApiKeyScopes{
+			AllowedProcedures: []string{mgmtv1alpha1connect.JobServiceCreateJobRunProcedure},
+			AllowedJobIds:     []string{jobId},
+		},
+	}))
+	assert.NoError(t, err)
+	assert.NotNil(t, resp)
+	assert.Equal(t, []string{jobId}, resp.Msg.ApiKey.GetScopes().GetAllowedJobIds())
+	assert.Nil(t, resp.Msg.ApiKey.LastUsedAt)
+}
+
+func Test_Service_CreateAccountApiKey_UnknownScopeProcedure(t *testing.T) {
+	mockDbtx := nucleusdb.NewMockDBTX(t)
+	mockQuerier := db_queries.NewMockQuerier(t)
+	mockUserAccountService := mgmtv1alpha1connect.NewMockUserAccountServiceClient(t)
+
+	svc := New(&Config{}, nucleusdb.New(mockDbtx, mockQuerier), mockUserAccountService)
+
+	mockUserAccountCalls(mockUserAccountService, true, uuid.NewString())
+
+	resp, err := svc.CreateAccountApiKey(context.Background(), connect.NewRequest(&mgmtv1alpha1.CreateAccountApiKeyRequest{
+		AccountId: uuid.NewString(),
+		Name:      "foo",
+		ExpiresAt: timestamppb.New(time.Now().Add(24 * time.Hour)),
+		Scopes: &mgmtv1alpha1.AccountApiKeyScopes{
+			AllowedProcedures: []string{"/mgmt.v1alpha1.JobService/Unknown"},
+		},
+	}))
+	assert.Error(t, err)
+	assert.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+	assert.Nil(t, resp)
+}
+
 func Test_Service_CreateAccountApiKey_ForbiddenAccount(t *testing.T) {
 	mockDbtx := nucleusdb.NewMockDBTX(t)
 	mockQuerier := db_queries.NewMockQuerier(t)
@@ -0,0 +1,132 @@
+package v1alpha1_auditservice
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/jackc/pgx/v5/pgtype"
+	db_queries "github.com/nucleuscloud/neosync/backend/gen/go/db"
+	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
+	"github.com/nucleuscloud/neosync/backend/internal/dtomaps"
+	nucleuserrors "github.com/nucleuscloud/neosync/backend/internal/errors"
+	"github.com/nucleuscloud/neosync/backend/internal/nucleusdb"
+)
+
+const (
+	defaultPageSize = 100
+)
+
+func (s *Service) GetAuditEvents(
+	ctx context.Context,
+	req *connect.Request[mgmtv1alpha1.GetAuditEventsRequest],
+) (*connect.Response[mgmtv1alpha1.GetAuditEventsResponse], error) {
+	accountUuid, err := s.verifyUserInAccount(ctx, req.Msg.AccountId)
+	if err != nil {
+		return nil, err
+	}
+
+	pageSize := int32(defaultPageSize)
+	if req.Msg.PageSize != nil {
+		pageSize = int32(req.Msg.GetPageSize())
+	}
+
+	params := db_queries.GetAuditEventsParams{
+		AccountId: *accountUuid,
+		// one more than the page is requested to know if there is a next page
+		Limit: pageSize + 1,
+	}
+	if req.Msg.ResourceId != nil {
+		params.ResourceId = pgtype.Text{String: req.Msg.GetResourceId(), Valid: true}
+	}
+	if req.Msg.Procedure != nil {
+		params.Procedure = pgtype.Text{String: req.Msg.GetProcedure(), Valid: true}
+	}
+	if req.Msg.ActorId != nil {
+		actorUuid, err := nucleusdb.ToUuid(req.Msg.GetActorId())
+		if err != nil {
+			return nil, err
+		}
+		params.ActorId = actorUuid
+	}
+	if req.Msg.StartTime != nil {
+		startTime, err := nucleusdb.ToTimestamp(req.Msg.GetStartTime().AsTime())
+		if err != nil {
+			return nil, err
+		}
+		params.StartTime = startTime
+	}
+	if req.Msg.EndTime != nil {
+		endTime, err := nucleusdb.ToTimestamp(req.Msg.GetEndTime().AsTime())
+		if err != nil {
+			return nil, err
+		}
+		params.EndTime = endTime
+	}
+	if req.Msg.PageToken != nil {
+		createdAt, id, err := decodePageToken(req.Msg.GetPageToken())
+		if err != nil {
+			return nil, nucleuserrors.NewBadRequest(fmt.Sprintf("invalid page token: %s", err.Error()))
+		}
+		params.CursorCreatedAt = createdAt
+		params.CursorId = id
+	}
+
+	events, err := s.db.Q.GetAuditEvents(ctx, s.db.Db, params)
+	if err != nil {
+		return nil, err
+	}
+
+	var nextPageToken *string
+	if len(events) > int(pageSize) {
+		events = events[:pageSize]
+		lastEvent := events[len(events)-1]
+		token := encodePageToken(lastEvent.CreatedAt, lastEvent.ID)
+		nextPageToken = &token
+	}
+
+	dtos := make([]*mgmtv1alpha1.AuditEvent, len(events))
+	for idx := range events {
+		event := events[idx]
+		dtos[idx] = dtomaps.ToAuditEventDto(&event)
+	}
+
+	return connect.NewResponse(&mgmtv1alpha1.GetAuditEventsResponse{
+		Events:        dtos,
+		NextPageToken: nextPageToken,
+	}), nil
+}
+
+// The page token is the position of the last event that was returned, as events are ordered by their creation time and id
+func encodePageToken(createdAt pgtype.Timestamp, id pgtype.UUID) string {
+	token := fmt.Sprintf("%d_%s", createdAt.Time.UnixNano(), nucleusdb.UUIDString(id))
+	return base64.RawURLEncoding.EncodeToString([]byte(token))
+}
+
+func decodePageToken(token string) (pgtype.Timestamp, pgtype.UUID, error) {
+	decoded, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return pgtype.Timestamp{}, pgtype.UUID{}, err
+	}
+	createdAtStr, idStr, found := strings.Cut(string(decoded), "_")
+	if !found {
+		return pgtype.Timestamp{}, pgtype.UUID{}, fmt.Errorf("expected token to contain a timestamp and id")
+	}
+	createdAtNanos, err := strconv.ParseInt(createdAtStr, 10, 64)
+	if err != nil {
+		return pgtype.Timestamp{}, pgtype.UUID{}, err
+	}
+	createdAt, err := nucleusdb.ToTimestamp(time.Unix(0, createdAtNanos).UTC())
+	if err != nil {
+		return pgtype.Timestamp{}, pgtype.UUID{}, err
+	}
+	id, err := nucleusdb.ToUuid(idStr)
+	if err != nil {
+		return pgtype.Timestamp{}, pgtype.UUID{}, err
+	}
+	return createdAt, id, nil
+}
@@ -0,0 +1,185 @@
+package v1alpha1_auditservice
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	db_queries "github.com/nucleuscloud/neosync/backend/gen/go/db"
+	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
+	"github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1/mgmtv1alpha1connect"
+	"github.com/nucleuscloud/neosync/backend/internal/nucleusdb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func Test_Service_GetAuditEvents(t *testing.T) {
+	mockDbtx := nucleusdb.NewMockDBTX(t)
+	mockQuerier := db_queries.NewMockQuerier(t)
+	mockUserAccountService := mgmtv1alpha1connect.NewMockUserAccountServiceClient(t)
+
+	svc := New(&Config{}, nucleusdb.New(mockDbtx, mockQuerier), mockUserAccountService)
+
+	accountId := uuid.NewString()
+	resourceId := uuid.NewString()
+	rawData := []db_queries.NeosyncApiAuditEvent{
+		newAuditEvent(t, time.Now()),
+		newAuditEvent(t, time.Now().Add(-time.Minute)),
+	}
+	var params db_queries.GetAuditEventsParams
+	mockQuerier.On("GetAuditEvents", mock.Anything, mock.Anything, mock.Anything).
+		Run(func(args mock.Arguments) {
+			params = args.Get(2).(db_queries.GetAuditEventsParams)
+		}).
+		Return(rawData, nil)
+	mockIsUserInAccount(mockUserAccountService, true)
+
+	startTime := time.Now().Add(-time.Hour)
+	resp, err := svc.GetAuditEvents(context.Background(), connect.NewRequest(&mgmtv1alpha1.GetAuditEventsRequest{
+		AccountId:  accountId,
+		ResourceId: &resourceId,
+		StartTime:  timestamppb.New(startTime),
+	}))
+	require.NoError(t, err)
+	assert.Len(t, resp.Msg.Events, len(rawData))
+	assert.Nil(t, resp.Msg.NextPageToken)
+
+	assert.Equal(t, accountId, nucleusdb.UUIDString(params.AccountId))
+	assert.Equal(t, pgtype.Text{String: resourceId, Valid: true}, params.ResourceId)
+	assert.True(t, params.StartTime.Valid)
+	assert.False(t, params.EndTime.Valid)
+	assert.False(t, params.Procedure.Valid)
+	assert.False(t, params.CursorCreatedAt.Valid)
+	assert.Equal(t, int32(defaultPageSize+1), params.Limit)
+
+	event := resp.Msg.Events[0]
+	assert.Equal(t, nucleusdb.UUIDString(rawData[0].ID), event.Id)
+	assert.Equal(t, mgmtv1alpha1.AuditActorType_AUDIT_ACTOR_TYPE_USER, event.Actor.Type)
+	assert.Equal(t, nucleusdb.UUIDString(rawData[0].ActorID), event.Actor.GetId())
+	assert.Equal(t, `{"name":"prod"}`, event.RequestDiff)
+}
+
+func Test_Service_GetAuditEvents_Paginated(t *testing.T) {
+	mockDbtx := nucleusdb.NewMockDBTX(t)
+	mockQuerier := db_queries.NewMockQuerier(t)
+	mockUserAccountService := mgmtv1alpha1connect.NewMockUserAccountServiceClient(t)
+
+	svc := New(&Config{}, nucleusdb.New(mockDbtx, mockQuerier), mockUserAccountService)
+
+	rawData := []db_queries.NeosyncApiAuditEvent{
+		newAuditEvent(t, time.Now()),
+		newAuditEvent(t, time.Now().Add(-time.Minute)),
+		newAuditEvent(t, time.Now().Add(-2*time.Minute)),
+	}
+	mockQuerier.On("GetAuditEvents", mock.Anything, mock.Anything, mock.Anything).
+		Return(rawData, nil).Once()
+	mockIsUserInAccount(mockUserAccountService, true)
+
+	pageSize := uint32(2)
+	accountId := uuid.NewString()
+	resp, err := svc.GetAuditEvents(context.Background(), connect.NewRequest(&mgmtv1alpha1.GetAuditEventsRequest{
+		AccountId: accountId,
+		PageSize:  &pageSize,
+	}))
+	require.NoError(t, err)
+	assert.Len(t, resp.Msg.Events, 2)
+	require.NotNil(t, resp.Msg.NextPageToken)
+
+	var params db_queries.GetAuditEventsParams
+	mockQuerier.On("GetAuditEvents", mock.Anything, mock.Anything, mock.Anything).
+		Run(func(args mock.Arguments) {
+			params = args.Get(2).(db_queries.GetAuditEventsParams)
+		}).
+		Return(rawData[2:], nil).Once()
+
+	resp, err = svc.GetAuditEvents(context.Background(), connect.NewRequest(&mgmtv1alpha1.GetAuditEventsRequest{
+		AccountId: accountId,
+		PageSize:  &pageSize,
+		PageToken: resp.Msg.NextPageToken,
+	}))
+	require.NoError(t, err)
+	assert.Len(t, resp.Msg.Events, 1)
+	assert.Nil(t, resp.Msg.NextPageToken)
+	assert.Equal(t, rawData[1].ID, params.CursorId)
+	assert.True(t, rawData[1].CreatedAt.Time.Equal(params.CursorCreatedAt.Time))
+	assert.Equal(t, int32(3), params.Limit)
+}
+
+func Test_Service_GetAuditEvents_InvalidPageToken(t *testing.T) {
+	mockDbtx := nucleusdb.NewMockDBTX(t)
+	mockQuerier := db_queries.NewMockQuerier(t)
+	mockUserAccountService := mgmtv1alpha1connect.NewMockUserAccountServiceClient(t)
+
+	svc := New(&Config{}, nucleusdb.New(mockDbtx, mockQuerier), mockUserAccountService)
+	mockIsUserInAccount(mockUserAccountService, true)
+
+	pageToken := "foo"
+	resp, err := svc.GetAuditEvents(context.Background(), connect.NewRequest(&mgmtv1alpha1.GetAuditEventsRequest{
+		AccountId: uuid.NewString(),
+		PageToken: &pageToken,
+	}))
+	assert.Error(t, err)
+	assert.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+	assert.Nil(t, resp)
+}
+
+func Test_Service_GetAuditEvents_ForbiddenAccount(t *testing.T) {
+	mockDbtx := nucleusdb.NewMockDBTX(t)
+	mockQuerier := db_queries.NewMockQuerier(t)
+	mockUserAccountService := mgmtv1alpha1connect.NewMockUserAccountServiceClient(t)
+
+	svc := New(&Config{}, nucleusdb.New(mockDbtx, mockQuerier), mockUserAccountService)
+	mockIsUserInAccount(mockUserAccountService, false)
+
+	resp, err := svc.GetAuditEvents(context.Background(), connect.NewRequest(&mgmtv1alpha1.GetAuditEventsRequest{
+		AccountId: uuid.NewString(),
+	}))
+	assert.Error(t, err)
+	assert.Nil(t, resp)
+}
+
+func Test_decodePageToken(t *testing.T) {
+	id := newPgUuid(t)
+	createdAt := pgtype.Timestamp{Time: time.Date(2024, 4, 11, 12, 30, 15, 123456000, time.UTC), Valid: true}
+
+	actualCreatedAt, actualId, err := decodePageToken(encodePageToken(createdAt, id))
+	require.NoError(t, err)
+	assert.Equal(t, id, actualId)
+	assert.True(t, createdAt.Time.Equal(actualCreatedAt.Time))
+
+	_, _, err = decodePageToken("bm90LWEtdG9rZW4")
+	assert.Error(t, err)
+}
+
+func newAuditEvent(t *testing.T, createdAt time.Time) db_queries.NeosyncApiAuditEvent {
+	t.Helper()
+	return db_queries.NeosyncApiAuditEvent{
+		ID:          newPgUuid(t),
+		CreatedAt:   pgtype.Timestamp{Time: createdAt.UTC(), Valid: true},
+		AccountID:   newPgUuid(t),
+		ActorType:   int16(mgmtv1alpha1.AuditActorType_AUDIT_ACTOR_TYPE_USER),
+		ActorID:     newPgUuid(t),
+		Procedure:   mgmtv1alpha1connect.ConnectionServiceUpdateConnectionProcedure,
+		RequestDiff: []byte(`{"name":"prod"}`),
+		StatusCode:  "ok",
+	}
+}
+
+func newPgUuid(t *testing.T) pgtype.UUID {
+	t.Helper()
+	newuuid := uuid.NewString()
+	val, err := nucleusdb.ToUuid(newuuid)
+	assert.NoError(t, err)
+	return val
+}
+
+func mockIsUserInAccount(userAccountServiceMock *mgmtv1alpha1connect.MockUserAccountServiceClient, isInAccount bool) {
+	userAccountServiceMock.On("IsUserInAccount", mock.Anything, mock.Anything).Return(connect.NewResponse(&mgmtv1alpha1.IsUserInAccountResponse{
+		Ok: isInAccount,
+	}), nil)
+}
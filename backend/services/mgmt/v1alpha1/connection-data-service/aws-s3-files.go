@@ -0,0 +1,226 @@
+package v1alpha1_connectiondataservice
+
+import (
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/parquet-go/parquet-go"
+)
+
+type awsS3FileFormat int
+
+const (
+	awsS3FileFormatJsonLinesGzip awsS3FileFormat = iota
+	awsS3FileFormatCsv
+	awsS3FileFormatParquet
+)
+
+const parquetReadBatchSize = 100
+
+// Returned from an onRow callback to stop reading a file early
+var errStopReading = errors.New("stop reading")
+
+// The format of a job run's data files is determined by their extension.
+// Files written before the S3 destination supported multiple formats are always gzipped json lines.
+func getAwsS3FileFormat(key string) awsS3FileFormat {
+	switch {
+	case strings.HasSuffix(key, ".csv"):
+		return awsS3FileFormatCsv
+	case strings.HasSuffix(key, ".parquet"):
+		return awsS3FileFormatParquet
+	default:
+		return awsS3FileFormatJsonLinesGzip
+	}
+}
+
+// Reads every row out of an S3 data file. When onRow returns errStopReading, reading stops without an error.
+func readAwsS3FileRows(
+	key string,
+	body io.Reader,
+	onRow func(row map[string][]byte) error,
+) error {
+	var err error
+	switch getAwsS3FileFormat(key) {
+	case awsS3FileFormatCsv:
+		err = readCsvRows(body, onRow)
+	case awsS3FileFormatParquet:
+		err = readParquetRows(body, onRow)
+	default:
+		err = readJsonLinesGzipRows(body, onRow)
+	}
+	if errors.Is(err, errStopReading) {
+		return nil
+	}
+	return err
+}
+
+// Returns the columns of an S3 data file.
+// Returns nil if the columns can not be determined because the file does not contain any rows
+func getAwsS3FileColumns(key string, body io.Reader) ([]string, error) {
+	switch getAwsS3FileFormat(key) {
+	case awsS3FileFormatCsv:
+		header, err := csv.NewReader(body).Read()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil, nil
+			}
+			return nil, fmt.Errorf("unable to read csv header: %w", err)
+		}
+		return header, nil
+	case awsS3FileFormatParquet:
+		file, err := openParquetFile(body)
+		if err != nil {
+			return nil, err
+		}
+		fields := file.Schema().Fields()
+		columns := make([]string, 0, len(fields))
+		for _, field := range fields {
+			columns = append(columns, field.Name())
+		}
+		return columns, nil
+	default:
+		var columns []string
+		err := readAwsS3FileRows(key, body, func(row map[string][]byte) error {
+			for col := range row {
+				columns = append(columns, col)
+			}
+			return errStopReading
+		})
+		if err != nil {
+			return nil, err
+		}
+		return columns, nil
+	}
+}
+
+func readJsonLinesGzipRows(body io.Reader, onRow func(row map[string][]byte) error) error {
+	gzr, err := gzip.NewReader(body)
+	if err != nil {
+		return fmt.Errorf("error creating gzip reader: %w", err)
+	}
+	defer gzr.Close()
+
+	scanner := bufio.NewScanner(gzr)
+	for scanner.Scan() {
+		var data map[string]any
+		if err := json.Unmarshal(scanner.Bytes(), &data); err != nil {
+			return err
+		}
+		rowMap, err := toAwsS3RowMap(data)
+		if err != nil {
+			return err
+		}
+		if err := onRow(rowMap); err != nil {
+			return err
+		}
+	}
+	return scanner.Err()
+}
+
+// Empty csv fields are returned as null values
+func readCsvRows(body io.Reader, onRow func(row map[string][]byte) error) error {
+	reader := csv.NewReader(body)
+	header, err := reader.Read()
+	if err != nil {
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		return fmt.Errorf("unable to read csv header: %w", err)
+	}
+	for {
+		record, err := reader.Read()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil
+			}
+			return fmt.Errorf("unable to read csv record: %w", err)
+		}
+		rowMap := make(map[string][]byte, len(header))
+		for idx, col := range header {
+			if idx < len(record) && record[idx] != "" {
+				rowMap[col] = []byte(record[idx])
+			} else {
+				rowMap[col] = nil
+			}
+		}
+		if err := onRow(rowMap); err != nil {
+			return err
+		}
+	}
+}
+
+func readParquetRows(body io.Reader, onRow func(row map[string][]byte) error) error {
+	file, err := openParquetFile(body)
+	if err != nil {
+		return err
+	}
+	reader := parquet.NewGenericReader[any](file)
+	defer reader.Close()
+
+	rows := make([]any, parquetReadBatchSize)
+	for {
+		n, err := reader.Read(rows)
+		if err != nil && !errors.Is(err, io.EOF) {
+			return fmt.Errorf("unable to read parquet rows: %w", err)
+		}
+		for _, row := range rows[:n] {
+			data, ok := row.(map[string]any)
+			if !ok {
+				return fmt.Errorf("unable to read parquet row of type %T", row)
+			}
+			rowMap, err := toAwsS3RowMap(data)
+			if err != nil {
+				return err
+			}
+			if err := onRow(rowMap); err != nil {
+				return err
+			}
+		}
+		if n == 0 || errors.Is(err, io.EOF) {
+			return nil
+		}
+	}
+}
+
+// Parquet files can only be read with random access, so the whole object is buffered
+func openParquetFile(body io.Reader) (*parquet.File, error) {
+	bits, err := io.ReadAll(body)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read parquet file: %w", err)
+	}
+	file, err := parquet.OpenFile(bytes.NewReader(bits), int64(len(bits)))
+	if err != nil {
+		return nil, fmt.Errorf("unable to open parquet file: %w", err)
+	}
+	return file, nil
+}
+
+func toAwsS3RowMap(data map[string]any) (map[string][]byte, error) {
+	rowMap := make(map[string][]byte, len(data))
+	for key, value := range data {
+		var byteValue []byte
+		if str, ok := value.(string); ok {
+			// try converting string directly to []byte
+			// prevents quoted strings
+			byteValue = []byte(str)
+		} else {
+			// if not a string use JSON encoding
+			bits, err := json.Marshal(value)
+			if err != nil {
+				return nil, err
+			}
+			if string(bits) != "null" {
+				byteValue = bits
+			}
+		}
+		rowMap[key] = byteValue
+	}
+	return rowMap, nil
+}
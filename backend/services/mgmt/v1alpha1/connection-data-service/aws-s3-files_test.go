@@ -0,0 +1,106 @@
+package v1alpha1_connectiondataservice
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/parquet-go/parquet-go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_getAwsS3FileFormat(t *testing.T) {
+	assert.Equal(t, awsS3FileFormatJsonLinesGzip, getAwsS3FileFormat("workflows/run/activities/public.users/data/1.txt.gz"))
+	assert.Equal(t, awsS3FileFormatCsv, getAwsS3FileFormat("workflows/run/activities/public.users/data/1.csv"))
+	assert.Equal(t, awsS3FileFormatParquet, getAwsS3FileFormat("workflows/run/activities/public.users/data/1.parquet"))
+}
+
+func Test_readAwsS3FileRows_JsonLinesGzip(t *testing.T) {
+	data, err := gzipData([]byte("{\"id\":1,\"name\":\"nick\",\"bio\":null}\n{\"id\":2,\"name\":\"alisha\",\"bio\":\"hi\"}"))
+	require.NoError(t, err)
+
+	rows := readAllAwsS3FileRows(t, "1.txt.gz", data)
+	assert.Equal(t, []map[string][]byte{
+		{"id": []byte("1"), "name": []byte("nick"), "bio": nil},
+		{"id": []byte("2"), "name": []byte("alisha"), "bio": []byte("hi")},
+	}, rows)
+}
+
+func Test_readAwsS3FileRows_Csv(t *testing.T) {
+	data := []byte("\"id\",\"name\",\"bio\"\n\"1\",\"nick \"\"the dev\"\"\",\n\"2\",\"alisha\",\"hi\"")
+
+	rows := readAllAwsS3FileRows(t, "1.csv", data)
+	assert.Equal(t, []map[string][]byte{
+		{"id": []byte("1"), "name": []byte(`nick "the dev"`), "bio": nil},
+		{"id": []byte("2"), "name": []byte("alisha"), "bio": []byte("hi")},
+	}, rows)
+}
+
+type testParquetRow struct {
+	Id   int64   `parquet:"id"`
+	Name *string `parquet:"name,optional"`
+}
+
+func Test_readAwsS3FileRows_Parquet(t *testing.T) {
+	data := writeTestParquetFile(t)
+
+	rows := readAllAwsS3FileRows(t, "1.parquet", data)
+	assert.Equal(t, []map[string][]byte{
+		{"id": []byte("1"), "name": []byte("nick")},
+		{"id": []byte("2"), "name": nil},
+	}, rows)
+}
+
+func Test_readAwsS3FileRows_Stop(t *testing.T) {
+	data := []byte("\"id\"\n\"1\"\n\"2\"")
+
+	count := 0
+	err := readAwsS3FileRows("1.csv", bytes.NewReader(data), func(row map[string][]byte) error {
+		count++
+		return errStopReading
+	})
+	require.NoError(t, err)
+	assert.Equal(t, 1, count)
+}
+
+func Test_getAwsS3FileColumns(t *testing.T) {
+	jsonData, err := gzipData([]byte(`{"id":1,"name":"nick"}`))
+	require.NoError(t, err)
+	columns, err := getAwsS3FileColumns("1.txt.gz", bytes.NewReader(jsonData))
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{"id", "name"}, columns)
+
+	columns, err = getAwsS3FileColumns("1.csv", bytes.NewReader([]byte("\"id\",\"name\"\n\"1\",\"nick\"")))
+	require.NoError(t, err)
+	assert.Equal(t, []string{"id", "name"}, columns)
+
+	columns, err = getAwsS3FileColumns("1.parquet", bytes.NewReader(writeTestParquetFile(t)))
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{"id", "name"}, columns)
+
+	columns, err = getAwsS3FileColumns("1.csv", bytes.NewReader(nil))
+	require.NoError(t, err)
+	assert.Nil(t, columns)
+}
+
+func readAllAwsS3FileRows(t *testing.T, key string, data []byte) []map[string][]byte {
+	t.Helper()
+	rows := []map[string][]byte{}
+	err := readAwsS3FileRows(key, bytes.NewReader(data), func(row map[string][]byte) error {
+		rows = append(rows, row)
+		return nil
+	})
+	require.NoError(t, err)
+	return rows
+}
+
+func writeTestParquetFile(t *testing.T) []byte {
+	t.Helper()
+	name := "nick"
+	buf := bytes.NewBuffer(nil)
+	writer := parquet.NewGenericWriter[testParquetRow](buf)
+	_, err := writer.Write([]testParquetRow{{Id: 1, Name: &name}, {Id: 2}})
+	require.NoError(t, err)
+	require.NoError(t, writer.Close())
+	return buf.Bytes()
+}
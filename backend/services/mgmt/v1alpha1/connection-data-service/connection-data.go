@@ -1,10 +1,7 @@
 package v1alpha1_connectiondataservice
 
 import (
-	"bufio"
-	"compress/gzip"
 	"context"
-	"encoding/json"
 	"errors"
 	"fmt"
 	"log/slog"
@@ -20,8 +17,10 @@ import (
 	nucleuserrors "github.com/nucleuscloud/neosync/backend/internal/errors"
 	"github.com/nucleuscloud/neosync/backend/internal/nucleusdb"
 	dbschemas "github.com/nucleuscloud/neosync/backend/pkg/dbschemas"
+	dbschemas_mssql "github.com/nucleuscloud/neosync/backend/pkg/dbschemas/mssql"
 	dbschemas_mysql "github.com/nucleuscloud/neosync/backend/pkg/dbschemas/mysql"
 	dbschemas_postgres "github.com/nucleuscloud/neosync/backend/pkg/dbschemas/postgres"
+	dbschemas_sqlite "github.com/nucleuscloud/neosync/backend/pkg/dbschemas/sqlite"
 )
 
 type DatabaseSchema struct {
@@ -68,11 +67,55 @@ func (s *Service) GetConnectionDataStream(
 		return err
 	}
 
+	return s.streamConnectionRows(ctx, logger, connection, req.Msg.StreamConfig, req.Msg.Schema, req.Msg.Table, nil, func(row map[string][]byte) error {
+		return stream.Send(&mgmtv1alpha1.GetConnectionDataStreamResponse{Row: row})
+	})
+}
+
+var errRowLimitReached = errors.New("row limit reached")
+
+// Calls onRow for each row in the table. If a row limit is provided, at most that many rows are read from the connection.
+func (s *Service) streamConnectionRows(
+	ctx context.Context,
+	logger *slog.Logger,
+	connection *mgmtv1alpha1.Connection,
+	streamConfig *mgmtv1alpha1.ConnectionStreamConfig,
+	schema, table string,
+	rowLimit *uint32,
+	onRow func(row map[string][]byte) error,
+) error {
+	if rowLimit != nil {
+		handleRow := onRow
+		var count uint32
+		onRow = func(row map[string][]byte) error {
+			if count >= *rowLimit {
+				return errRowLimitReached
+			}
+			count++
+			return handleRow(row)
+		}
+	}
+	err := s.streamRows(ctx, logger, connection, streamConfig, schema, table, rowLimit, onRow)
+	if err != nil && !errors.Is(err, errRowLimitReached) {
+		return err
+	}
+	return nil
+}
+
+func (s *Service) streamRows(
+	ctx context.Context,
+	logger *slog.Logger,
+	connection *mgmtv1alpha1.Connection,
+	streamConfig *mgmtv1alpha1.ConnectionStreamConfig,
+	schema, table string,
+	rowLimit *uint32,
+	onRow func(row map[string][]byte) error,
+) error {
 	connectionTimeout := uint32(5)
 
 	switch config := connection.ConnectionConfig.Config.(type) {
 	case *mgmtv1alpha1.ConnectionConfig_MysqlConfig:
-		err := s.areSchemaAndTableValid(ctx, connection, req.Msg.Schema, req.Msg.Table)
+		err := s.areSchemaAndTableValid(ctx, connection, schema, table)
 		if err != nil {
 			return err
 		}
@@ -88,7 +131,7 @@ func (s *Service) GetConnectionDataStream(
 		}
 
 		// used to get column names
-		query := fmt.Sprintf("SELECT * FROM %s.%s LIMIT 1;", req.Msg.Schema, req.Msg.Table)
+		query := fmt.Sprintf("SELECT * FROM %s.%s LIMIT 1;", schema, table)
 		r, err := db.QueryContext(ctx, query)
 		if err != nil && !nucleusdb.IsNoRows(err) {
 			return err
@@ -99,7 +142,7 @@ func (s *Service) GetConnectionDataStream(
 			return err
 		}
 
-		selectQuery := fmt.Sprintf("SELECT %s FROM %s.%s;", strings.Join(columnNames, ", "), req.Msg.Schema, req.Msg.Table)
+		selectQuery := fmt.Sprintf("SELECT %s FROM %s.%s%s;", strings.Join(columnNames, ", "), schema, table, limitClause(rowLimit))
 		rows, err := db.QueryContext(ctx, selectQuery)
 		if err != nil && !nucleusdb.IsNoRows(err) {
 			return err
@@ -120,13 +163,67 @@ func (s *Service) GetConnectionDataStream(
 				row[col] = v
 			}
 
-			if err := stream.Send(&mgmtv1alpha1.GetConnectionDataStreamResponse{Row: row}); err != nil {
+			if err := onRow(row); err != nil {
+				return err
+			}
+		}
+
+	case *mgmtv1alpha1.ConnectionConfig_MssqlConfig:
+		err := s.areSchemaAndTableValid(ctx, connection, schema, table)
+		if err != nil {
+			return err
+		}
+
+		conn, err := s.sqlConnector.NewDbFromConnectionConfig(connection.ConnectionConfig, &connectionTimeout, logger)
+		if err != nil {
+			return err
+		}
+		defer conn.Close()
+		db, err := conn.Open()
+		if err != nil {
+			return err
+		}
+
+		// used to get column names
+		query := fmt.Sprintf("SELECT TOP 1 * FROM %s;", dbschemas_mssql.EscapeMssqlTable(schema, table))
+		r, err := db.QueryContext(ctx, query)
+		if err != nil && !nucleusdb.IsNoRows(err) {
+			return err
+		}
+
+		columnNames, err := r.Columns()
+		if err != nil {
+			return err
+		}
+
+		selectQuery := fmt.Sprintf("SELECT %s%s FROM %s;", topClause(rowLimit), strings.Join(dbschemas_mssql.EscapeMssqlColumns(columnNames), ", "), dbschemas_mssql.EscapeMssqlTable(schema, table))
+		rows, err := db.QueryContext(ctx, selectQuery)
+		if err != nil && !nucleusdb.IsNoRows(err) {
+			return err
+		}
+
+		for rows.Next() {
+			values := make([][]byte, len(columnNames))
+			valuesWrapped := make([]any, 0, len(columnNames))
+			for i := range values {
+				valuesWrapped = append(valuesWrapped, &values[i])
+			}
+			if err := rows.Scan(valuesWrapped...); err != nil {
+				return err
+			}
+			row := map[string][]byte{}
+			for i, v := range values {
+				col := columnNames[i]
+				row[col] = v
+			}
+
+			if err := onRow(row); err != nil {
 				return err
 			}
 		}
 
 	case *mgmtv1alpha1.ConnectionConfig_PgConfig:
-		err := s.areSchemaAndTableValid(ctx, connection, req.Msg.Schema, req.Msg.Table)
+		err := s.areSchemaAndTableValid(ctx, connection, schema, table)
 		if err != nil {
 			return err
 		}
@@ -142,7 +239,7 @@ func (s *Service) GetConnectionDataStream(
 		defer conn.Close()
 
 		// used to get column names
-		query := fmt.Sprintf("SELECT * FROM %s.%s LIMIT 1;", req.Msg.Schema, req.Msg.Table)
+		query := fmt.Sprintf("SELECT * FROM %s.%s LIMIT 1;", schema, table)
 		r, err := db.Query(ctx, query)
 		if err != nil && !nucleusdb.IsNoRows(err) {
 			return err
@@ -154,7 +251,7 @@ func (s *Service) GetConnectionDataStream(
 			columnNames = append(columnNames, col.Name)
 		}
 
-		selectQuery := fmt.Sprintf("SELECT %s FROM %s.%s;", strings.Join(columnNames, ", "), req.Msg.Schema, req.Msg.Table)
+		selectQuery := fmt.Sprintf("SELECT %s FROM %s.%s%s;", strings.Join(columnNames, ", "), schema, table, limitClause(rowLimit))
 		rows, err := db.Query(ctx, selectQuery)
 		if err != nil && !nucleusdb.IsNoRows(err) {
 			return err
@@ -201,14 +298,14 @@ func (s *Service) GetConnectionDataStream(
 				}
 			}
 
-			if err := stream.Send(&mgmtv1alpha1.GetConnectionDataStreamResponse{Row: row}); err != nil {
+			if err := onRow(row); err != nil {
 				return err
 			}
 		}
 		return nil
 
 	case *mgmtv1alpha1.ConnectionConfig_AwsS3Config:
-		awsS3StreamCfg := req.Msg.StreamConfig.GetAwsS3Config()
+		awsS3StreamCfg := streamConfig.GetAwsS3Config()
 		if awsS3StreamCfg == nil {
 			return nucleuserrors.NewBadRequest("jobId or jobRunId required for AWS S3 connections")
 		}
@@ -235,7 +332,7 @@ func (s *Service) GetConnectionDataStream(
 			return nucleuserrors.NewInternalError("unsupported AWS S3 config id")
 		}
 
-		tableName := fmt.Sprintf("%s.%s", req.Msg.Schema, req.Msg.Table)
+		tableName := fmt.Sprintf("%s.%s", schema, table)
 		path := fmt.Sprintf("workflows/%s/activities/%s/data", jobRunId, tableName)
 		var pageToken *string
 		for {
@@ -260,57 +357,11 @@ func (s *Service) GetConnectionDataStream(
 					return err
 				}
 
-				gzr, err := gzip.NewReader(result.Body)
+				err = readAwsS3FileRows(*item.Key, result.Body, onRow)
+				result.Body.Close()
 				if err != nil {
-					result.Body.Close()
-					return fmt.Errorf("error creating gzip reader: %w", err)
-				}
-
-				scanner := bufio.NewScanner(gzr)
-				for scanner.Scan() {
-					line := scanner.Bytes()
-					var data map[string]any
-					err = json.Unmarshal(line, &data)
-					if err != nil {
-						result.Body.Close()
-						gzr.Close()
-						return err
-					}
-
-					rowMap := make(map[string][]byte)
-					for key, value := range data {
-						var byteValue []byte
-						if str, ok := value.(string); ok {
-							// try converting string directly to []byte
-							// prevents quoted strings
-							byteValue = []byte(str)
-						} else {
-							// if not a string use JSON encoding
-							byteValue, err = json.Marshal(value)
-							if err != nil {
-								result.Body.Close()
-								gzr.Close()
-								return err
-							}
-							if string(byteValue) == "null" {
-								byteValue = nil
-							}
-						}
-						rowMap[key] = byteValue
-					}
-					if err := stream.Send(&mgmtv1alpha1.GetConnectionDataStreamResponse{Row: rowMap}); err != nil {
-						result.Body.Close()
-						gzr.Close()
-						return err
-					}
-				}
-				if err := scanner.Err(); err != nil {
-					result.Body.Close()
-					gzr.Close()
 					return err
 				}
-				result.Body.Close()
-				gzr.Close()
 			}
 			if *output.IsTruncated {
 				pageToken = output.NextContinuationToken
@@ -326,6 +377,20 @@ func (s *Service) GetConnectionDataStream(
 	return nil
 }
 
+func limitClause(rowLimit *uint32) string {
+	if rowLimit == nil {
+		return ""
+	}
+	return fmt.Sprintf(" LIMIT %d", *rowLimit)
+}
+
+func topClause(rowLimit *uint32) string {
+	if rowLimit == nil {
+		return ""
+	}
+	return fmt.Sprintf("TOP %d ", *rowLimit)
+}
+
 func (s *Service) GetConnectionSchema(
 	ctx context.Context,
 	req *connect.Request[mgmtv1alpha1.GetConnectionSchemaRequest],
@@ -382,6 +447,76 @@ func (s *Service) GetConnectionSchema(
 			Schemas: schemas,
 		}), nil
 
+	case *mgmtv1alpha1.ConnectionConfig_MssqlConfig:
+		conn, err := s.sqlConnector.NewDbFromConnectionConfig(connection.ConnectionConfig, &connectionTimeout, logger)
+		if err != nil {
+			return nil, err
+		}
+		defer conn.Close()
+		db, err := conn.Open()
+		if err != nil {
+			return nil, err
+		}
+
+		dbschema, err := s.mssqlquerier.GetDatabaseSchema(ctx, db)
+		if err != nil && !nucleusdb.IsNoRows(err) {
+			return nil, err
+		} else if err != nil && nucleusdb.IsNoRows(err) {
+			return connect.NewResponse(&mgmtv1alpha1.GetConnectionSchemaResponse{
+				Schemas: []*mgmtv1alpha1.DatabaseColumn{},
+			}), nil
+		}
+
+		schemas := []*mgmtv1alpha1.DatabaseColumn{}
+		for _, col := range dbschema {
+			schemas = append(schemas, &mgmtv1alpha1.DatabaseColumn{
+				Schema:     col.TableSchema,
+				Table:      col.TableName,
+				Column:     col.ColumnName,
+				DataType:   col.DataType,
+				IsNullable: col.IsNullable,
+			})
+		}
+
+		return connect.NewResponse(&mgmtv1alpha1.GetConnectionSchemaResponse{
+			Schemas: schemas,
+		}), nil
+
+	case *mgmtv1alpha1.ConnectionConfig_SqliteConfig:
+		conn, err := s.sqlConnector.NewDbFromConnectionConfig(connection.ConnectionConfig, &connectionTimeout, logger)
+		if err != nil {
+			return nil, err
+		}
+		defer conn.Close()
+		db, err := conn.Open()
+		if err != nil {
+			return nil, err
+		}
+
+		dbschema, err := s.sqlitequerier.GetDatabaseSchema(ctx, db)
+		if err != nil && !nucleusdb.IsNoRows(err) {
+			return nil, err
+		} else if err != nil && nucleusdb.IsNoRows(err) {
+			return connect.NewResponse(&mgmtv1alpha1.GetConnectionSchemaResponse{
+				Schemas: []*mgmtv1alpha1.DatabaseColumn{},
+			}), nil
+		}
+
+		schemas := []*mgmtv1alpha1.DatabaseColumn{}
+		for _, col := range dbschema {
+			schemas = append(schemas, &mgmtv1alpha1.DatabaseColumn{
+				Schema:     col.TableSchema,
+				Table:      col.TableName,
+				Column:     col.ColumnName,
+				DataType:   col.DataType,
+				IsNullable: col.IsNullable,
+			})
+		}
+
+		return connect.NewResponse(&mgmtv1alpha1.GetConnectionSchemaResponse{
+			Schemas: schemas,
+		}), nil
+
 	case *mgmtv1alpha1.ConnectionConfig_PgConfig:
 		conn, err := s.sqlConnector.NewPgPoolFromConnectionConfig(config.PgConfig, &connectionTimeout, logger)
 		if err != nil {
@@ -487,38 +622,18 @@ func (s *Service) GetConnectionSchema(
 					return nil, err
 				}
 
-				gzr, err := gzip.NewReader(result.Body)
+				columns, err := getAwsS3FileColumns(*item.Key, result.Body)
+				result.Body.Close()
 				if err != nil {
-					result.Body.Close()
-					return nil, fmt.Errorf("error creating gzip reader: %w", err)
-				}
-
-				scanner := bufio.NewScanner(gzr)
-				if scanner.Scan() {
-					line := scanner.Bytes()
-					var data map[string]any
-					err = json.Unmarshal(line, &data)
-					if err != nil {
-						result.Body.Close()
-						gzr.Close()
-						return nil, err
-					}
-
-					for key := range data {
-						schemas = append(schemas, &mgmtv1alpha1.DatabaseColumn{
-							Schema: schemaTableList[0],
-							Table:  schemaTableList[1],
-							Column: key,
-						})
-					}
-				}
-				if err := scanner.Err(); err != nil {
-					result.Body.Close()
-					gzr.Close()
 					return nil, err
 				}
-				result.Body.Close()
-				gzr.Close()
+				for _, column := range columns {
+					schemas = append(schemas, &mgmtv1alpha1.DatabaseColumn{
+						Schema: schemaTableList[0],
+						Table:  schemaTableList[1],
+						Column: column,
+					})
+				}
 			}
 			if *output.IsTruncated {
 				pageToken = output.NextContinuationToken
@@ -591,6 +706,48 @@ func (s *Service) GetConnectionForeignConstraints(
 		}
 		td = dbschemas_mysql.GetMysqlTableDependencies(allConstraints)
 
+	case *mgmtv1alpha1.ConnectionConfig_MssqlConfig:
+		conn, err := s.sqlConnector.NewDbFromConnectionConfig(connection.Msg.Connection.ConnectionConfig, &connectionTimeout, logger)
+		if err != nil {
+			return nil, err
+		}
+		defer conn.Close()
+		db, err := conn.Open()
+		if err != nil {
+			return nil, err
+		}
+
+		allConstraints, err := dbschemas_mssql.GetAllMssqlFkConstraints(s.mssqlquerier, ctx, db, schemas)
+		if err != nil && !nucleusdb.IsNoRows(err) {
+			return nil, err
+		} else if err != nil && nucleusdb.IsNoRows(err) {
+			return connect.NewResponse(&mgmtv1alpha1.GetConnectionForeignConstraintsResponse{
+				TableConstraints: map[string]*mgmtv1alpha1.ForeignConstraintTables{},
+			}), nil
+		}
+		td = dbschemas_mssql.GetMssqlTableDependencies(allConstraints)
+
+	case *mgmtv1alpha1.ConnectionConfig_SqliteConfig:
+		conn, err := s.sqlConnector.NewDbFromConnectionConfig(connection.Msg.Connection.ConnectionConfig, &connectionTimeout, logger)
+		if err != nil {
+			return nil, err
+		}
+		defer conn.Close()
+		db, err := conn.Open()
+		if err != nil {
+			return nil, err
+		}
+
+		allConstraints, err := s.sqlitequerier.GetForeignKeyConstraints(ctx, db)
+		if err != nil && !nucleusdb.IsNoRows(err) {
+			return nil, err
+		} else if err != nil && nucleusdb.IsNoRows(err) {
+			return connect.NewResponse(&mgmtv1alpha1.GetConnectionForeignConstraintsResponse{
+				TableConstraints: map[string]*mgmtv1alpha1.ForeignConstraintTables{},
+			}), nil
+		}
+		td = dbschemas_sqlite.GetSqliteTableDependencies(allConstraints)
+
 	case *mgmtv1alpha1.ConnectionConfig_PgConfig:
 		conn, err := s.sqlConnector.NewPgPoolFromConnectionConfig(config.PgConfig, &connectionTimeout, logger)
 		if err != nil {
@@ -692,6 +849,48 @@ func (s *Service) GetConnectionPrimaryConstraints(
 		}
 		pc = dbschemas_mysql.GetMysqlTablePrimaryKeys(allConstraints)
 
+	case *mgmtv1alpha1.ConnectionConfig_MssqlConfig:
+		conn, err := s.sqlConnector.NewDbFromConnectionConfig(connection.Msg.Connection.ConnectionConfig, &connectionTimeout, logger)
+		if err != nil {
+			return nil, err
+		}
+		defer conn.Close()
+		db, err := conn.Open()
+		if err != nil {
+			return nil, err
+		}
+
+		allConstraints, err := dbschemas_mssql.GetAllMssqlPkConstraints(s.mssqlquerier, ctx, db, schemas)
+		if err != nil && !nucleusdb.IsNoRows(err) {
+			return nil, err
+		} else if err != nil && nucleusdb.IsNoRows(err) {
+			return connect.NewResponse(&mgmtv1alpha1.GetConnectionPrimaryConstraintsResponse{
+				TableConstraints: map[string]*mgmtv1alpha1.PrimaryConstraint{},
+			}), nil
+		}
+		pc = dbschemas_mssql.GetMssqlTablePrimaryKeys(allConstraints)
+
+	case *mgmtv1alpha1.ConnectionConfig_SqliteConfig:
+		conn, err := s.sqlConnector.NewDbFromConnectionConfig(connection.Msg.Connection.ConnectionConfig, &connectionTimeout, logger)
+		if err != nil {
+			return nil, err
+		}
+		defer conn.Close()
+		db, err := conn.Open()
+		if err != nil {
+			return nil, err
+		}
+
+		allConstraints, err := s.sqlitequerier.GetPrimaryKeyConstraints(ctx, db)
+		if err != nil && !nucleusdb.IsNoRows(err) {
+			return nil, err
+		} else if err != nil && nucleusdb.IsNoRows(err) {
+			return connect.NewResponse(&mgmtv1alpha1.GetConnectionPrimaryConstraintsResponse{
+				TableConstraints: map[string]*mgmtv1alpha1.PrimaryConstraint{},
+			}), nil
+		}
+		pc = dbschemas_sqlite.GetSqliteTablePrimaryKeys(allConstraints)
+
 	case *mgmtv1alpha1.ConnectionConfig_PgConfig:
 		conn, err := s.sqlConnector.NewPgPoolFromConnectionConfig(config.PgConfig, &connectionTimeout, logger)
 		if err != nil {
@@ -791,6 +990,33 @@ func (s *Service) GetConnectionInitStatements(
 			}
 		}
 
+	case *mgmtv1alpha1.ConnectionConfig_MssqlConfig:
+		conn, err := s.sqlConnector.NewDbFromConnectionConfig(connection.Msg.Connection.ConnectionConfig, &connectionTimeout, logger)
+		if err != nil {
+			return nil, err
+		}
+		defer conn.Close()
+		db, err := conn.Open()
+		if err != nil {
+			return nil, err
+		}
+
+		if req.Msg.GetOptions().GetInitSchema() {
+			for k, v := range schemaTableMap {
+				stmt, err := dbschemas_mssql.GetTableCreateStatement(ctx, db, s.mssqlquerier, v.Schema, v.Table)
+				if err != nil {
+					return nil, err
+				}
+				createStmtsMap[k] = stmt
+			}
+		}
+
+		if req.Msg.GetOptions().GetTruncateBeforeInsert() {
+			for k, v := range schemaTableMap {
+				truncateStmtsMap[k] = dbschemas_mssql.BuildTruncateStatement(v.Schema, v.Table)
+			}
+		}
+
 	case *mgmtv1alpha1.ConnectionConfig_PgConfig:
 		conn, err := s.sqlConnector.NewPgPoolFromConnectionConfig(config.PgConfig, &connectionTimeout, logger)
 		if err != nil {
@@ -852,6 +1078,18 @@ func (s *Service) getConnectionSchema(ctx context.Context, connection *mgmtv1alp
 				MysqlConfig: &mgmtv1alpha1.MysqlSchemaConfig{},
 			},
 		}
+	case *mgmtv1alpha1.ConnectionConfig_MssqlConfig:
+		schemaReq.SchemaConfig = &mgmtv1alpha1.ConnectionSchemaConfig{
+			Config: &mgmtv1alpha1.ConnectionSchemaConfig_MssqlConfig{
+				MssqlConfig: &mgmtv1alpha1.MssqlSchemaConfig{},
+			},
+		}
+	case *mgmtv1alpha1.ConnectionConfig_SqliteConfig:
+		schemaReq.SchemaConfig = &mgmtv1alpha1.ConnectionSchemaConfig{
+			Config: &mgmtv1alpha1.ConnectionSchemaConfig_SqliteConfig{
+				SqliteConfig: &mgmtv1alpha1.SqliteSchemaConfig{},
+			},
+		}
 	case *mgmtv1alpha1.ConnectionConfig_AwsS3Config:
 		var cfg *mgmtv1alpha1.AwsS3SchemaConfig
 		if opts.JobRunId != nil && *opts.JobRunId != "" {
@@ -999,6 +1237,27 @@ func (s *Service) GetConnectionUniqueConstraints(
 		}
 		uc = dbschemas_mysql.GetMysqlTableUniqueConstraints(allConstraints)
 
+	case *mgmtv1alpha1.ConnectionConfig_MssqlConfig:
+		conn, err := s.sqlConnector.NewDbFromConnectionConfig(connection.Msg.Connection.ConnectionConfig, &connectionTimeout, logger)
+		if err != nil {
+			return nil, err
+		}
+		defer conn.Close()
+		db, err := conn.Open()
+		if err != nil {
+			return nil, err
+		}
+
+		allConstraints, err := dbschemas_mssql.GetAllMssqlUniqueConstraints(s.mssqlquerier, ctx, db, schemas)
+		if err != nil && !nucleusdb.IsNoRows(err) {
+			return nil, err
+		} else if err != nil && nucleusdb.IsNoRows(err) {
+			return connect.NewResponse(&mgmtv1alpha1.GetConnectionUniqueConstraintsResponse{
+				TableConstraints: map[string]*mgmtv1alpha1.UniqueConstraint{},
+			}), nil
+		}
+		uc = dbschemas_mssql.GetMssqlTableUniqueConstraints(allConstraints)
+
 	case *mgmtv1alpha1.ConnectionConfig_PgConfig:
 		conn, err := s.sqlConnector.NewPgPoolFromConnectionConfig(config.PgConfig, &connectionTimeout, logger)
 		if err != nil {
@@ -26,6 +26,8 @@ import (
 	pg_queries "github.com/nucleuscloud/neosync/backend/gen/go/db/dbschemas/postgresql"
 	awsmanager "github.com/nucleuscloud/neosync/backend/internal/aws"
 	"github.com/nucleuscloud/neosync/backend/internal/nucleusdb"
+	dbschemas_mssql "github.com/nucleuscloud/neosync/backend/pkg/dbschemas/mssql"
+	dbschemas_sqlite "github.com/nucleuscloud/neosync/backend/pkg/dbschemas/sqlite"
 	"github.com/nucleuscloud/neosync/backend/pkg/sqlconnect"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
@@ -44,6 +46,8 @@ type ConnTypeMock string
 
 const (
 	MysqlMock    ConnTypeMock = "mysql"
+	MssqlMock    ConnTypeMock = "mssql"
+	SqliteMock   ConnTypeMock = "sqlite"
 	PostgresMock ConnTypeMock = "postgres"
 	AwsS3Mock    ConnTypeMock = "awsS3"
 )
@@ -209,6 +213,98 @@ func Test_GetConnectionSchema_Mysql(t *testing.T) {
 	}
 }
 
+func Test_GetConnectionSchema_Mssql(t *testing.T) {
+	m := createServiceMock(t)
+	defer m.SqlDbMock.Close()
+
+	mockColumns := []*dbschemas_mssql.GetDatabaseSchemaRow{
+		{
+			TableSchema: "dbo",
+			TableName:   "users",
+			ColumnName:  "id",
+			DataType:    "int",
+		},
+		{
+			TableSchema: "dbo",
+			TableName:   "users",
+			ColumnName:  "name",
+			DataType:    "nvarchar",
+		},
+	}
+
+	connection := getConnectionMock(mockAccountId, mockConnectionName, mockConnectionId, MssqlMock)
+	mockIsUserInAccount(m.UserAccountServiceMock, true)
+	m.ConnectionServiceMock.On("GetConnection", mock.Anything, mock.Anything).Return(connect.NewResponse(&mgmtv1alpha1.GetConnectionResponse{
+		Connection: connection,
+	}), nil)
+	m.SqlDbContainerMock.On("Open").Return(m.SqlDbMock, nil)
+	m.SqlDbContainerMock.On("Close").Return(nil)
+	m.SqlConnectorMock.On("NewDbFromConnectionConfig", mock.Anything, mock.Anything, mock.Anything).Return(m.SqlDbContainerMock, nil)
+
+	m.MssqlQueierMock.On("GetDatabaseSchema", mock.Anything, mock.Anything).
+		Return(mockColumns, nil)
+
+	resp, err := m.Service.GetConnectionSchema(context.Background(), &connect.Request[mgmtv1alpha1.GetConnectionSchemaRequest]{
+		Msg: &mgmtv1alpha1.GetConnectionSchemaRequest{
+			ConnectionId: mockConnectionId,
+		},
+	})
+
+	assert.NoError(t, err)
+	assert.NotNil(t, resp)
+	assert.Equal(t, 2, len(resp.Msg.GetSchemas()))
+	if err := m.SqlMock.ExpectationsWereMet(); err != nil {
+		t.Errorf("there were unfulfilled expectations: %s", err)
+	}
+}
+
+func Test_GetConnectionSchema_Sqlite(t *testing.T) {
+	m := createServiceMock(t)
+	defer m.SqlDbMock.Close()
+
+	mockColumns := []*dbschemas_sqlite.GetDatabaseSchemaRow{
+		{
+			TableSchema: "main",
+			TableName:   "users",
+			ColumnName:  "id",
+			DataType:    "INTEGER",
+			IsNullable:  "NO",
+		},
+		{
+			TableSchema: "main",
+			TableName:   "users",
+			ColumnName:  "name",
+			DataType:    "TEXT",
+			IsNullable:  "YES",
+		},
+	}
+
+	connection := getConnectionMock(mockAccountId, mockConnectionName, mockConnectionId, SqliteMock)
+	mockIsUserInAccount(m.UserAccountServiceMock, true)
+	m.ConnectionServiceMock.On("GetConnection", mock.Anything, mock.Anything).Return(connect.NewResponse(&mgmtv1alpha1.GetConnectionResponse{
+		Connection: connection,
+	}), nil)
+	m.SqlDbContainerMock.On("Open").Return(m.SqlDbMock, nil)
+	m.SqlDbContainerMock.On("Close").Return(nil)
+	m.SqlConnectorMock.On("NewDbFromConnectionConfig", mock.Anything, mock.Anything, mock.Anything).Return(m.SqlDbContainerMock, nil)
+
+	m.SqliteQuerierMock.On("GetDatabaseSchema", mock.Anything, mock.Anything).
+		Return(mockColumns, nil)
+
+	resp, err := m.Service.GetConnectionSchema(context.Background(), &connect.Request[mgmtv1alpha1.GetConnectionSchemaRequest]{
+		Msg: &mgmtv1alpha1.GetConnectionSchemaRequest{
+			ConnectionId: mockConnectionId,
+		},
+	})
+
+	assert.NoError(t, err)
+	assert.NotNil(t, resp)
+	assert.ElementsMatch(t, []*mgmtv1alpha1.DatabaseColumn{
+		{Schema: "main", Table: "users", Column: "id", DataType: "INTEGER", IsNullable: "NO"},
+		{Schema: "main", Table: "users", Column: "name", DataType: "TEXT", IsNullable: "YES"},
+	}, resp.Msg.Schemas)
+}
+
 func Test_GetConnectionSchema_NoRows(t *testing.T) {
 	m := createServiceMock(t)
 	defer m.SqlDbMock.Close()
@@ -700,6 +796,8 @@ type serviceMocks struct {
 	PgPoolContainerMock    *sqlconnect.MockPgPoolContainer
 	PgQueierMock           *pg_queries.MockQuerier
 	MysqlQueierMock        *mysql_queries.MockQuerier
+	MssqlQueierMock        *dbschemas_mssql.MockQuerier
+	SqliteQuerierMock      *dbschemas_sqlite.MockQuerier
 	SqlConnectorMock       *sqlconnect.MockSqlConnector
 	AwsManagerMock         *awsmanager.MockNeosyncAwsManagerClient
 }
@@ -712,6 +810,8 @@ func createServiceMock(t *testing.T) *serviceMocks {
 	mockJobService := mgmtv1alpha1connect.NewMockJobServiceHandler(t)
 	mockPgquerier := pg_queries.NewMockQuerier(t)
 	mockMysqlquerier := mysql_queries.NewMockQuerier(t)
+	mockMssqlquerier := dbschemas_mssql.NewMockQuerier(t)
+	mockSqlitequerier := dbschemas_sqlite.NewMockQuerier(t)
 	mockSqlConnector := sqlconnect.NewMockSqlConnector(t)
 	mockAwsManager := awsmanager.NewMockNeosyncAwsManagerClient(t)
 
@@ -720,7 +820,7 @@ func createServiceMock(t *testing.T) *serviceMocks {
 		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
 	}
 
-	service := New(&Config{}, mockUserAccountService, mockConnectionService, mockJobService, mockAwsManager, mockSqlConnector, mockPgquerier, mockMysqlquerier)
+	service := New(&Config{}, mockUserAccountService, mockConnectionService, mockJobService, mockAwsManager, mockSqlConnector, mockPgquerier, mockMysqlquerier, mockMssqlquerier, mockSqlitequerier)
 
 	return &serviceMocks{
 		Service:                service,
@@ -735,6 +835,8 @@ func createServiceMock(t *testing.T) *serviceMocks {
 		PgPoolContainerMock:    sqlconnect.NewMockPgPoolContainer(t),
 		PgQueierMock:           mockPgquerier,
 		MysqlQueierMock:        mockMysqlquerier,
+		MssqlQueierMock:        mockMssqlquerier,
+		SqliteQuerierMock:      mockSqlitequerier,
 		SqlConnectorMock:       mockSqlConnector,
 		AwsManagerMock:         mockAwsManager,
 	}
@@ -775,6 +877,30 @@ func getConnectionMock(accountId, name string, id string, connType ConnTypeMock)
 				},
 			},
 		}
+	} else if connType == MssqlMock {
+		connection.ConnectionConfig = &mgmtv1alpha1.ConnectionConfig{
+			Config: &mgmtv1alpha1.ConnectionConfig_MssqlConfig{
+				MssqlConfig: &mgmtv1alpha1.MssqlConnectionConfig{
+					ConnectionConfig: &mgmtv1alpha1.MssqlConnectionConfig_Connection{
+						Connection: &mgmtv1alpha1.MssqlConnection{
+							Host: "host",
+							Port: 1433,
+							Name: "database",
+							User: "user",
+							Pass: "topsecret",
+						},
+					},
+				},
+			},
+		}
+	} else if connType == SqliteMock {
+		connection.ConnectionConfig = &mgmtv1alpha1.ConnectionConfig{
+			Config: &mgmtv1alpha1.ConnectionConfig_SqliteConfig{
+				SqliteConfig: &mgmtv1alpha1.SqliteConnectionConfig{
+					Path: "/tmp/neosync.db",
+				},
+			},
+		}
 	} else if connType == PostgresMock {
 		sslMode := "disable"
 		connection.ConnectionConfig = &mgmtv1alpha1.ConnectionConfig{
@@ -0,0 +1,245 @@
+package v1alpha1_connectiondataservice
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"connectrpc.com/connect"
+	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
+	logger_interceptor "github.com/nucleuscloud/neosync/backend/internal/connect/interceptors/logger"
+	"github.com/nucleuscloud/neosync/backend/pkg/pii"
+)
+
+const defaultPiiScanSampleSize = uint32(100)
+
+func (s *Service) ScanConnectionPii(
+	ctx context.Context,
+	req *connect.Request[mgmtv1alpha1.ScanConnectionPiiRequest],
+) (*connect.Response[mgmtv1alpha1.ScanConnectionPiiResponse], error) {
+	logger := logger_interceptor.GetLoggerFromContextOrDefault(ctx)
+	logger = logger.With("connectionId", req.Msg.ConnectionId)
+	connResp, err := s.connectionService.GetConnection(ctx, connect.NewRequest(&mgmtv1alpha1.GetConnectionRequest{
+		Id: req.Msg.ConnectionId,
+	}))
+	if err != nil {
+		return nil, err
+	}
+	connection := connResp.Msg.Connection
+	_, err = s.verifyUserInAccount(ctx, connection.AccountId)
+	if err != nil {
+		return nil, err
+	}
+
+	sampleSize := defaultPiiScanSampleSize
+	if req.Msg.SampleSize != nil {
+		sampleSize = req.Msg.GetSampleSize()
+	}
+	minConfidence := pii.DefaultMinConfidence
+	if req.Msg.MinConfidence != nil {
+		minConfidence = req.Msg.GetMinConfidence()
+	}
+
+	opts := &schemaOpts{}
+	if awsS3Cfg := req.Msg.GetStreamConfig().GetAwsS3Config(); awsS3Cfg != nil {
+		switch id := awsS3Cfg.Id.(type) {
+		case *mgmtv1alpha1.AwsS3StreamConfig_JobId:
+			opts.JobId = &id.JobId
+		case *mgmtv1alpha1.AwsS3StreamConfig_JobRunId:
+			opts.JobRunId = &id.JobRunId
+		}
+	}
+	columns, err := s.getConnectionSchema(ctx, connection, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	tableColumns := map[string][]*mgmtv1alpha1.DatabaseColumn{}
+	tables := []string{}
+	for _, col := range columns {
+		if len(req.Msg.Schemas) > 0 && !slices.Contains(req.Msg.Schemas, col.Schema) {
+			continue
+		}
+		key := fmt.Sprintf("%s.%s", col.Schema, col.Table)
+		if _, ok := tableColumns[key]; !ok {
+			tables = append(tables, key)
+		}
+		tableColumns[key] = append(tableColumns[key], col)
+	}
+
+	reports := []*mgmtv1alpha1.PiiColumnReport{}
+	for _, key := range tables {
+		cols := tableColumns[key]
+		schema, table := cols[0].Schema, cols[0].Table
+
+		samples := map[string][]string{}
+		err := s.streamConnectionRows(ctx, logger, connection, req.Msg.StreamConfig, schema, table, &sampleSize, func(row map[string][]byte) error {
+			for col, value := range row {
+				if value != nil {
+					samples[col] = append(samples[col], string(value))
+				}
+			}
+			return nil
+		})
+		if err != nil {
+			return nil, fmt.Errorf("unable to sample rows from %s: %w", key, err)
+		}
+		logger.Debug(fmt.Sprintf("sampled table %s for pii", key))
+
+		for _, col := range cols {
+			classification := pii.ClassifyColumn(col.Column, samples[col.Column], minConfidence)
+			if classification == nil {
+				continue
+			}
+			reports = append(reports, &mgmtv1alpha1.PiiColumnReport{
+				Schema:        col.Schema,
+				Table:         col.Table,
+				Column:        col.Column,
+				DataType:      col.DataType,
+				Category:      toPiiCategoryDto(classification.Category),
+				Confidence:    classification.Confidence,
+				SampledValues: uint32(classification.SampledValues),
+				MatchedValues: uint32(classification.MatchedValues),
+				SuggestedMapping: &mgmtv1alpha1.JobMapping{
+					Schema:      col.Schema,
+					Table:       col.Table,
+					Column:      col.Column,
+					Transformer: getSuggestedPiiTransformer(classification.Category, col.DataType),
+				},
+			})
+		}
+	}
+
+	return connect.NewResponse(&mgmtv1alpha1.ScanConnectionPiiResponse{
+		Columns: reports,
+	}), nil
+}
+
+func toPiiCategoryDto(category pii.Category) mgmtv1alpha1.PiiCategory {
+	switch category {
+	case pii.CategoryEmail:
+		return mgmtv1alpha1.PiiCategory_PII_CATEGORY_EMAIL
+	case pii.CategoryPhoneNumber:
+		return mgmtv1alpha1.PiiCategory_PII_CATEGORY_PHONE_NUMBER
+	case pii.CategoryFirstName:
+		return mgmtv1alpha1.PiiCategory_PII_CATEGORY_FIRST_NAME
+	case pii.CategoryLastName:
+		return mgmtv1alpha1.PiiCategory_PII_CATEGORY_LAST_NAME
+	case pii.CategoryFullName:
+		return mgmtv1alpha1.PiiCategory_PII_CATEGORY_FULL_NAME
+	case pii.CategorySsn:
+		return mgmtv1alpha1.PiiCategory_PII_CATEGORY_SSN
+	case pii.CategoryAddress:
+		return mgmtv1alpha1.PiiCategory_PII_CATEGORY_ADDRESS
+	case pii.CategoryCardNumber:
+		return mgmtv1alpha1.PiiCategory_PII_CATEGORY_CARD_NUMBER
+	case pii.CategoryIpAddress:
+		return mgmtv1alpha1.PiiCategory_PII_CATEGORY_IP_ADDRESS
+	default:
+		return mgmtv1alpha1.PiiCategory_PII_CATEGORY_UNSPECIFIED
+	}
+}
+
+// Returns the system transformer that is best suited to anonymize the category of PII
+func getSuggestedPiiTransformer(category pii.Category, dataType string) *mgmtv1alpha1.JobMappingTransformer {
+	switch category {
+	case pii.CategoryEmail:
+		return &mgmtv1alpha1.JobMappingTransformer{
+			Source: mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_TRANSFORM_EMAIL,
+			Config: &mgmtv1alpha1.TransformerConfig{
+				Config: &mgmtv1alpha1.TransformerConfig_TransformEmailConfig{
+					TransformEmailConfig: &mgmtv1alpha1.TransformEmail{PreserveDomain: false, PreserveLength: false},
+				},
+			},
+		}
+	case pii.CategoryPhoneNumber:
+		if isIntegerDataType(dataType) {
+			return &mgmtv1alpha1.JobMappingTransformer{
+				Source: mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_TRANSFORM_INT64_PHONE_NUMBER,
+				Config: &mgmtv1alpha1.TransformerConfig{
+					Config: &mgmtv1alpha1.TransformerConfig_TransformInt64PhoneNumberConfig{
+						TransformInt64PhoneNumberConfig: &mgmtv1alpha1.TransformInt64PhoneNumber{PreserveLength: true},
+					},
+				},
+			}
+		}
+		return &mgmtv1alpha1.JobMappingTransformer{
+			Source: mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_TRANSFORM_PHONE_NUMBER,
+			Config: &mgmtv1alpha1.TransformerConfig{
+				Config: &mgmtv1alpha1.TransformerConfig_TransformPhoneNumberConfig{
+					TransformPhoneNumberConfig: &mgmtv1alpha1.TransformPhoneNumber{PreserveLength: true},
+				},
+			},
+		}
+	case pii.CategoryFirstName:
+		return &mgmtv1alpha1.JobMappingTransformer{
+			Source: mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_TRANSFORM_FIRST_NAME,
+			Config: &mgmtv1alpha1.TransformerConfig{
+				Config: &mgmtv1alpha1.TransformerConfig_TransformFirstNameConfig{
+					TransformFirstNameConfig: &mgmtv1alpha1.TransformFirstName{PreserveLength: false},
+				},
+			},
+		}
+	case pii.CategoryLastName:
+		return &mgmtv1alpha1.JobMappingTransformer{
+			Source: mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_TRANSFORM_LAST_NAME,
+			Config: &mgmtv1alpha1.TransformerConfig{
+				Config: &mgmtv1alpha1.TransformerConfig_TransformLastNameConfig{
+					TransformLastNameConfig: &mgmtv1alpha1.TransformLastName{PreserveLength: false},
+				},
+			},
+		}
+	case pii.CategoryFullName:
+		return &mgmtv1alpha1.JobMappingTransformer{
+			Source: mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_TRANSFORM_FULL_NAME,
+			Config: &mgmtv1alpha1.TransformerConfig{
+				Config: &mgmtv1alpha1.TransformerConfig_TransformFullNameConfig{
+					TransformFullNameConfig: &mgmtv1alpha1.TransformFullName{PreserveLength: false},
+				},
+			},
+		}
+	case pii.CategorySsn:
+		return &mgmtv1alpha1.JobMappingTransformer{
+			Source: mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_GENERATE_SSN,
+			Config: &mgmtv1alpha1.TransformerConfig{
+				Config: &mgmtv1alpha1.TransformerConfig_GenerateSsnConfig{
+					GenerateSsnConfig: &mgmtv1alpha1.GenerateSSN{},
+				},
+			},
+		}
+	case pii.CategoryAddress:
+		return &mgmtv1alpha1.JobMappingTransformer{
+			Source: mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_GENERATE_STREET_ADDRESS,
+			Config: &mgmtv1alpha1.TransformerConfig{
+				Config: &mgmtv1alpha1.TransformerConfig_GenerateStreetAddressConfig{
+					GenerateStreetAddressConfig: &mgmtv1alpha1.GenerateStreetAddress{},
+				},
+			},
+		}
+	case pii.CategoryCardNumber:
+		return &mgmtv1alpha1.JobMappingTransformer{
+			Source: mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_GENERATE_CARD_NUMBER,
+			Config: &mgmtv1alpha1.TransformerConfig{
+				Config: &mgmtv1alpha1.TransformerConfig_GenerateCardNumberConfig{
+					GenerateCardNumberConfig: &mgmtv1alpha1.GenerateCardNumber{ValidLuhn: true},
+				},
+			},
+		}
+	default:
+		// scrambling keeps the format of values that do not have a dedicated transformer, ex: 10.0.0.1 -> 83.4.9.7
+		return &mgmtv1alpha1.JobMappingTransformer{
+			Source: mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_TRANSFORM_CHARACTER_SCRAMBLE,
+			Config: &mgmtv1alpha1.TransformerConfig{
+				Config: &mgmtv1alpha1.TransformerConfig_TransformCharacterScrambleConfig{
+					TransformCharacterScrambleConfig: &mgmtv1alpha1.TransformCharacterScramble{},
+				},
+			},
+		}
+	}
+}
+
+func isIntegerDataType(dataType string) bool {
+	dataType = strings.ToLower(dataType)
+	return strings.Contains(dataType, "int") || dataType == "numeric" || dataType == "decimal"
+}
@@ -0,0 +1,115 @@
+package v1alpha1_connectiondataservice
+
+import (
+	"context"
+	"regexp"
+	"testing"
+
+	"connectrpc.com/connect"
+	"github.com/DATA-DOG/go-sqlmock"
+	mysql_queries "github.com/nucleuscloud/neosync/backend/gen/go/db/dbschemas/mysql"
+	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
+	"github.com/nucleuscloud/neosync/backend/pkg/pii"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_ScanConnectionPii_Mysql(t *testing.T) {
+	m := createServiceMock(t)
+	defer m.SqlDbMock.Close()
+
+	mockColumns := []*mysql_queries.GetDatabaseSchemaRow{
+		{TableSchema: "public", TableName: "users", ColumnName: "id", DataType: "int"},
+		{TableSchema: "public", TableName: "users", ColumnName: "contact", DataType: "varchar"},
+		{TableSchema: "public", TableName: "users", ColumnName: "phone", DataType: "bigint"},
+		{TableSchema: "other", TableName: "logs", ColumnName: "ip", DataType: "varchar"},
+	}
+
+	connection := getConnectionMock(mockAccountId, mockConnectionName, mockConnectionId, MysqlMock)
+	mockIsUserInAccount(m.UserAccountServiceMock, true)
+	m.ConnectionServiceMock.On("GetConnection", mock.Anything, mock.Anything).Return(connect.NewResponse(&mgmtv1alpha1.GetConnectionResponse{
+		Connection: connection,
+	}), nil)
+	m.SqlDbContainerMock.On("Open").Return(m.SqlDbMock, nil)
+	m.SqlDbContainerMock.On("Close").Return(nil)
+	m.SqlConnectorMock.On("NewDbFromConnectionConfig", mock.Anything, mock.Anything, mock.Anything).Return(m.SqlDbContainerMock, nil)
+	m.MysqlQueierMock.On("GetDatabaseSchema", mock.Anything, mock.Anything).Return(mockColumns, nil)
+
+	m.SqlMock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM public.users LIMIT 1;")).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "contact", "phone"}))
+	m.SqlMock.ExpectQuery(regexp.QuoteMeta("SELECT id, contact, phone FROM public.users LIMIT 10;")).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "contact", "phone"}).
+			AddRow("1", "alice@corp.com", "5555551234").
+			AddRow("2", "bob@example.org", "5555555678").
+			AddRow("3", nil, nil))
+
+	sampleSize := uint32(10)
+	resp, err := m.Service.ScanConnectionPii(context.Background(), connect.NewRequest(&mgmtv1alpha1.ScanConnectionPiiRequest{
+		ConnectionId: mockConnectionId,
+		Schemas:      []string{"public"},
+		SampleSize:   &sampleSize,
+	}))
+
+	require.NoError(t, err)
+	require.Len(t, resp.Msg.Columns, 2)
+
+	email := resp.Msg.Columns[0]
+	assert.Equal(t, "contact", email.Column)
+	assert.Equal(t, mgmtv1alpha1.PiiCategory_PII_CATEGORY_EMAIL, email.Category)
+	assert.Equal(t, uint32(2), email.SampledValues)
+	assert.Equal(t, uint32(2), email.MatchedValues)
+	assert.Equal(t, mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_TRANSFORM_EMAIL, email.SuggestedMapping.Transformer.Source)
+	assert.Equal(t, "public", email.SuggestedMapping.Schema)
+	assert.Equal(t, "users", email.SuggestedMapping.Table)
+
+	phone := resp.Msg.Columns[1]
+	assert.Equal(t, "phone", phone.Column)
+	assert.Equal(t, mgmtv1alpha1.PiiCategory_PII_CATEGORY_PHONE_NUMBER, phone.Category)
+	assert.Equal(t, mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_TRANSFORM_INT64_PHONE_NUMBER, phone.SuggestedMapping.Transformer.Source)
+
+	if err := m.SqlMock.ExpectationsWereMet(); err != nil {
+		t.Errorf("there were unfulfilled expectations: %s", err)
+	}
+}
+
+func Test_ScanConnectionPii_UnverifiedUser(t *testing.T) {
+	m := createServiceMock(t)
+
+	connection := getConnectionMock(mockAccountId, mockConnectionName, mockConnectionId, MysqlMock)
+	mockIsUserInAccount(m.UserAccountServiceMock, false)
+	m.ConnectionServiceMock.On("GetConnection", mock.Anything, mock.Anything).Return(connect.NewResponse(&mgmtv1alpha1.GetConnectionResponse{
+		Connection: connection,
+	}), nil)
+
+	resp, err := m.Service.ScanConnectionPii(context.Background(), connect.NewRequest(&mgmtv1alpha1.ScanConnectionPiiRequest{
+		ConnectionId: mockConnectionId,
+	}))
+
+	assert.Error(t, err)
+	assert.Nil(t, resp)
+}
+
+func Test_getSuggestedPiiTransformer(t *testing.T) {
+	assert.Equal(t,
+		mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_TRANSFORM_PHONE_NUMBER,
+		getSuggestedPiiTransformer(pii.CategoryPhoneNumber, "character varying").Source,
+	)
+	assert.Equal(t,
+		mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_TRANSFORM_INT64_PHONE_NUMBER,
+		getSuggestedPiiTransformer(pii.CategoryPhoneNumber, "bigint").Source,
+	)
+	assert.True(t, getSuggestedPiiTransformer(pii.CategoryCardNumber, "text").Config.GetGenerateCardNumberConfig().ValidLuhn)
+	assert.Equal(t,
+		mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_TRANSFORM_CHARACTER_SCRAMBLE,
+		getSuggestedPiiTransformer(pii.CategoryIpAddress, "inet").Source,
+	)
+}
+
+func Test_limitClauses(t *testing.T) {
+	limit := uint32(5)
+	assert.Equal(t, "", limitClause(nil))
+	assert.Equal(t, " LIMIT 5", limitClause(&limit))
+	assert.Equal(t, "", topClause(nil))
+	assert.Equal(t, "TOP 5 ", topClause(&limit))
+}
@@ -5,6 +5,8 @@ import (
 	pg_queries "github.com/nucleuscloud/neosync/backend/gen/go/db/dbschemas/postgresql"
 	"github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1/mgmtv1alpha1connect"
 	awsmanager "github.com/nucleuscloud/neosync/backend/internal/aws"
+	dbschemas_mssql "github.com/nucleuscloud/neosync/backend/pkg/dbschemas/mssql"
+	dbschemas_sqlite "github.com/nucleuscloud/neosync/backend/pkg/dbschemas/sqlite"
 	"github.com/nucleuscloud/neosync/backend/pkg/sqlconnect"
 )
 
@@ -16,9 +18,11 @@ type Service struct {
 
 	awsManager awsmanager.NeosyncAwsManagerClient
 
-	sqlConnector sqlconnect.SqlConnector
-	pgquerier    pg_queries.Querier
-	mysqlquerier mysql_queries.Querier
+	sqlConnector  sqlconnect.SqlConnector
+	pgquerier     pg_queries.Querier
+	mysqlquerier  mysql_queries.Querier
+	mssqlquerier  dbschemas_mssql.Querier
+	sqlitequerier dbschemas_sqlite.Querier
 }
 
 type Config struct {
@@ -35,6 +39,8 @@ func New(
 	sqlConnector sqlconnect.SqlConnector,
 	pgquerier pg_queries.Querier,
 	mysqlquerier mysql_queries.Querier,
+	mssqlquerier dbschemas_mssql.Querier,
+	sqlitequerier dbschemas_sqlite.Querier,
 ) *Service {
 	return &Service{
 		cfg:                cfg,
@@ -45,5 +51,7 @@ func New(
 		sqlConnector:       sqlConnector,
 		pgquerier:          pgquerier,
 		mysqlquerier:       mysqlquerier,
+		mssqlquerier:       mssqlquerier,
+		sqlitequerier:      sqlitequerier,
 	}
 }
@@ -18,6 +18,7 @@ import (
 	nucleuserrors "github.com/nucleuscloud/neosync/backend/internal/errors"
 	"github.com/nucleuscloud/neosync/backend/internal/nucleusdb"
 	dbschemas_postgres "github.com/nucleuscloud/neosync/backend/pkg/dbschemas/postgres"
+	"github.com/nucleuscloud/neosync/backend/pkg/secrets"
 	pg_models "github.com/nucleuscloud/neosync/backend/sql/postgresql/models"
 )
 
@@ -28,6 +29,19 @@ func (s *Service) CheckConnectionConfig(
 	logger := logger_interceptor.GetLoggerFromContextOrDefault(ctx)
 	connectionTimeout := uint32(5)
 
+	if _, err := secrets.DefaultResolver().ResolveConnectionConfig(ctx, req.Msg.GetConnectionConfig()); err != nil {
+		var referenceErrs secrets.ReferenceErrors
+		if !errors.As(err, &referenceErrs) {
+			return nil, err
+		}
+		msg := referenceErrs.Error()
+		return connect.NewResponse(&mgmtv1alpha1.CheckConnectionConfigResponse{
+			IsConnected:           false,
+			ConnectionError:       &msg,
+			SecretReferenceErrors: toSecretReferenceErrorsDto(referenceErrs),
+		}), nil
+	}
+
 	switch req.Msg.ConnectionConfig.Config.(type) {
 	case *mgmtv1alpha1.ConnectionConfig_PgConfig:
 
@@ -99,7 +113,7 @@ func (s *Service) CheckConnectionConfig(
 			Privileges:      privs,
 		}), nil
 
-	case *mgmtv1alpha1.ConnectionConfig_MysqlConfig:
+	case *mgmtv1alpha1.ConnectionConfig_MysqlConfig, *mgmtv1alpha1.ConnectionConfig_MssqlConfig, *mgmtv1alpha1.ConnectionConfig_SqliteConfig:
 
 		conn, err := s.sqlConnector.NewDbFromConnectionConfig(req.Msg.ConnectionConfig, &connectionTimeout, logger)
 		if err != nil {
@@ -137,6 +151,18 @@ func (s *Service) CheckConnectionConfig(
 	}
 }
 
+func toSecretReferenceErrorsDto(errs secrets.ReferenceErrors) []*mgmtv1alpha1.SecretReferenceError {
+	dtos := make([]*mgmtv1alpha1.SecretReferenceError, 0, len(errs))
+	for _, err := range errs {
+		dtos = append(dtos, &mgmtv1alpha1.SecretReferenceError{
+			Field:     err.Field,
+			Reference: err.Reference,
+			Error:     err.Err.Error(),
+		})
+	}
+	return dtos
+}
+
 func (s *Service) IsConnectionNameAvailable(
 	ctx context.Context,
 	req *connect.Request[mgmtv1alpha1.IsConnectionNameAvailableRequest],
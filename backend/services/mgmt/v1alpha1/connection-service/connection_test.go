@@ -125,6 +125,28 @@ func Test_CheckConnectionConfigs_Fail(t *testing.T) {
 	}
 }
 
+func Test_CheckConnectionConfig_UnresolvedSecretReferences(t *testing.T) {
+	m := createServiceMock(t)
+
+	cc := getMysqlConfigMock()
+	cc.GetMysqlConfig().GetConnection().Pass = "env://NEOSYNC_SECRET_CHECK_CONNECTION_MISSING"
+
+	resp, err := m.Service.CheckConnectionConfig(context.Background(), &connect.Request[mgmtv1alpha1.CheckConnectionConfigRequest]{
+		Msg: &mgmtv1alpha1.CheckConnectionConfigRequest{
+			ConnectionConfig: cc,
+		},
+	})
+
+	assert.NoError(t, err)
+	assert.NotNil(t, resp)
+	assert.Equal(t, false, resp.Msg.IsConnected)
+	assert.NotNil(t, resp.Msg.ConnectionError)
+	assert.Len(t, resp.Msg.SecretReferenceErrors, 1)
+	assert.Equal(t, "mysql_config.connection.pass", resp.Msg.SecretReferenceErrors[0].Field)
+	assert.Equal(t, "env://NEOSYNC_SECRET_CHECK_CONNECTION_MISSING", resp.Msg.SecretReferenceErrors[0].Reference)
+	m.SqlConnectorMock.AssertNotCalled(t, "NewDbFromConnectionConfig", mock.Anything, mock.Anything, mock.Anything)
+}
+
 func Test_CheckConnectionConfig_NotImplemented(t *testing.T) {
 	m := createServiceMock(t)
 
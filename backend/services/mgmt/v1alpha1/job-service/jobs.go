@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"log/slog"
 	"strings"
 	"time"
 
@@ -16,8 +17,10 @@ import (
 	nucleuserrors "github.com/nucleuscloud/neosync/backend/internal/errors"
 	"github.com/nucleuscloud/neosync/backend/internal/nucleusdb"
 	pg_models "github.com/nucleuscloud/neosync/backend/sql/postgresql/models"
+	cdc_workflow "github.com/nucleuscloud/neosync/worker/pkg/workflows/cdc/workflow"
 	datasync_workflow "github.com/nucleuscloud/neosync/worker/pkg/workflows/datasync/workflow"
 
+	"go.temporal.io/api/enums/v1"
 	workflowpb "go.temporal.io/api/workflow/v1"
 	"go.temporal.io/api/workflowservice/v1"
 	temporalclient "go.temporal.io/sdk/client"
@@ -352,8 +355,12 @@ func (s *Service) CreateJob(
 	switch config := req.Msg.Source.Options.Config.(type) {
 	case *mgmtv1alpha1.JobSourceOptions_Mysql:
 		connectionIds = append(connectionIds, config.Mysql.ConnectionId)
+	case *mgmtv1alpha1.JobSourceOptions_Mssql:
+		connectionIds = append(connectionIds, config.Mssql.ConnectionId)
 	case *mgmtv1alpha1.JobSourceOptions_Postgres:
 		connectionIds = append(connectionIds, config.Postgres.ConnectionId)
+	case *mgmtv1alpha1.JobSourceOptions_PostgresCdc:
+		connectionIds = append(connectionIds, config.PostgresCdc.ConnectionId)
 	case *mgmtv1alpha1.JobSourceOptions_AwsS3:
 		connectionIds = append(connectionIds, config.AwsS3.ConnectionId)
 	default:
@@ -368,8 +375,12 @@ func (s *Service) CreateJob(
 	switch config := req.Msg.Source.Options.Config.(type) {
 	case *mgmtv1alpha1.JobSourceOptions_Mysql:
 		connectionIdToVerify = &config.Mysql.ConnectionId
+	case *mgmtv1alpha1.JobSourceOptions_Mssql:
+		connectionIdToVerify = &config.Mssql.ConnectionId
 	case *mgmtv1alpha1.JobSourceOptions_Postgres:
 		connectionIdToVerify = &config.Postgres.ConnectionId
+	case *mgmtv1alpha1.JobSourceOptions_PostgresCdc:
+		connectionIdToVerify = &config.PostgresCdc.ConnectionId
 	case *mgmtv1alpha1.JobSourceOptions_AwsS3:
 		connectionIdToVerify = &config.AwsS3.ConnectionId
 	case *mgmtv1alpha1.JobSourceOptions_Generate:
@@ -397,6 +408,11 @@ func (s *Service) CreateJob(
 		if !areConnectionsCompatible {
 			return nil, nucleuserrors.NewBadRequest("connection types are incompatible")
 		}
+		if req.Msg.Source.Options.GetPostgresCdc() != nil {
+			if err := verifyCdcDestinationsAreSupported(ctx, s.db, destinations); err != nil {
+				return nil, err
+			}
+		}
 	}
 
 	cronStr := req.Msg.GetCronSchedule()
@@ -455,6 +471,11 @@ func (s *Service) CreateJob(
 		activitySyncOptions.FromDto(req.Msg.SyncOptions)
 	}
 
+	triggers := toJobTriggers(req.Msg.Triggers)
+	if err := s.verifyJobTriggers(ctx, *accountUuid, "", triggers); err != nil {
+		return nil, err
+	}
+
 	tScheduleClient, err := s.temporalWfManager.GetScheduleClientByAccount(ctx, req.Msg.AccountId, logger)
 	if err != nil {
 		return nil, fmt.Errorf("unable to build temporal schedule client by account: %w", err)
@@ -477,6 +498,7 @@ func (s *Service) CreateJob(
 		UpdatedByID:       *userUuid,
 		WorkflowOptions:   workflowOptions,
 		SyncOptions:       activitySyncOptions,
+		Triggers:          triggers,
 	}, connDestParams)
 	if err != nil {
 		return nil, fmt.Errorf("unable to create job: %w", err)
@@ -485,6 +507,17 @@ func (s *Service) CreateJob(
 	logger = logger.With("jobId", jobUuid)
 	logger.Info("created job")
 
+	jobVersion, _, err := s.createJobVersion(ctx, s.db.Db, cj.ID, *userUuid)
+	if err != nil {
+		logger.Error(fmt.Errorf("unable to create job version: %w", err).Error())
+		logger.Info("deleting newly created job")
+		removeJobErr := s.db.Q.RemoveJobById(ctx, s.db.Db, cj.ID)
+		if removeJobErr != nil {
+			return nil, fmt.Errorf("unable to create job version and was unable to fully cleanup partially created resources: %w: %w", removeJobErr, err)
+		}
+		return nil, fmt.Errorf("unable to create job version: %w", err)
+	}
+
 	logger = logger.With("jobId", jobUuid)
 	schedule := nucleusdb.ToNullableString(cj.CronSchedule)
 	paused := true
@@ -494,12 +527,14 @@ func (s *Service) CreateJob(
 		spec.CronExpressions = []string{*schedule}
 		paused = false
 	}
+	scheduleWorkflow, scheduleArgs := getScheduleWorkflow(req.Msg.Source.Options, jobUuid)
 	action := &temporalclient.ScheduleWorkflowAction{
-		Workflow:  datasync_workflow.Workflow,
+		Workflow:  scheduleWorkflow,
 		TaskQueue: tconfig.SyncJobQueueName,
-		Args:      []any{&datasync_workflow.WorkflowRequest{JobId: jobUuid}},
+		Args:      scheduleArgs,
 		ID:        nucleusdb.UUIDString(cj.ID),
 	}
+	setJobVersionMemo(action, nucleusdb.UUIDString(jobVersion.ID))
 	if cj.WorkflowOptions != nil && cj.WorkflowOptions.RunTimeout != nil {
 		action.WorkflowRunTimeout = time.Duration(*cj.WorkflowOptions.RunTimeout)
 	}
@@ -611,6 +646,12 @@ func (s *Service) DeleteJob(
 		}
 	}
 
+	if job.ConnectionOptions != nil && job.ConnectionOptions.PostgresCdcOptions != nil {
+		if err := s.dropReplicationSlot(ctx, logger, req.Msg.Id, job.ConnectionOptions.PostgresCdcOptions); err != nil {
+			return nil, err
+		}
+	}
+
 	logger.Info("deleting job")
 	err = s.db.Q.RemoveJobById(ctx, s.db.Db, job.ID)
 	if err != nil {
@@ -676,6 +717,11 @@ func (s *Service) CreateJobDestinationConnections(
 	if !isInSameAccount {
 		return nil, nucleuserrors.NewBadRequest("connections ids are not unique")
 	}
+	if job.Msg.Job.GetSource().GetOptions().GetPostgresCdc() != nil {
+		if err := verifyCdcDestinationsAreSupported(ctx, s.db, destinations); err != nil {
+			return nil, err
+		}
+	}
 
 	logger.Info("creating job destination connections", "connectionIds", connectionIds)
 	connDestParams := []db_queries.CreateJobConnectionDestinationsParams{}
@@ -691,6 +737,9 @@ func (s *Service) CreateJobDestinationConnections(
 		if err != nil {
 			return nil, err
 		}
+		if err := s.recordJobVersion(ctx, logger, s.db.Db, job.Msg.Job.AccountId, jobUuid, *userUuid); err != nil {
+			return nil, err
+		}
 	}
 
 	updatedJob, err := s.GetJob(ctx, connect.NewRequest(&mgmtv1alpha1.GetJobRequest{
@@ -773,7 +822,7 @@ func (s *Service) UpdateJobSchedule(
 			logger.Error(fmt.Errorf("unable to update schedule: %w", err).Error())
 			return err
 		}
-		return nil
+		return s.recordJobVersion(ctx, logger, dbtx, nucleusdb.UUIDString(job.AccountID), job.ID, *userUuid)
 	}); err != nil {
 		return nil, err
 	}
@@ -875,8 +924,12 @@ func (s *Service) UpdateJobSourceConnection(
 	switch config := req.Msg.Source.Options.Config.(type) {
 	case *mgmtv1alpha1.JobSourceOptions_Mysql:
 		connectionIdToVerify = &config.Mysql.ConnectionId
+	case *mgmtv1alpha1.JobSourceOptions_Mssql:
+		connectionIdToVerify = &config.Mssql.ConnectionId
 	case *mgmtv1alpha1.JobSourceOptions_Postgres:
 		connectionIdToVerify = &config.Postgres.ConnectionId
+	case *mgmtv1alpha1.JobSourceOptions_PostgresCdc:
+		connectionIdToVerify = &config.PostgresCdc.ConnectionId
 	case *mgmtv1alpha1.JobSourceOptions_AwsS3:
 		connectionIdToVerify = &config.AwsS3.ConnectionId
 	case *mgmtv1alpha1.JobSourceOptions_Generate:
@@ -906,12 +959,18 @@ func (s *Service) UpdateJobSourceConnection(
 				return nil, fmt.Errorf("job source option config type and connection type mismatch")
 			}
 		}
-	case *mgmtv1alpha1.ConnectionConfig_PgConfig:
-		if _, ok := req.Msg.Source.Options.Config.(*mgmtv1alpha1.JobSourceOptions_Postgres); !ok {
+	case *mgmtv1alpha1.ConnectionConfig_MssqlConfig:
+		if _, ok := req.Msg.Source.Options.Config.(*mgmtv1alpha1.JobSourceOptions_Mssql); !ok {
 			if _, ok = req.Msg.Source.Options.Config.(*mgmtv1alpha1.JobSourceOptions_Generate); !ok {
 				return nil, fmt.Errorf("job source option config type and connection type mismatch")
 			}
 		}
+	case *mgmtv1alpha1.ConnectionConfig_PgConfig:
+		switch req.Msg.Source.Options.Config.(type) {
+		case *mgmtv1alpha1.JobSourceOptions_Postgres, *mgmtv1alpha1.JobSourceOptions_PostgresCdc, *mgmtv1alpha1.JobSourceOptions_Generate:
+		default:
+			return nil, fmt.Errorf("job source option config type and connection type mismatch")
+		}
 	case *mgmtv1alpha1.ConnectionConfig_AwsS3Config:
 		if _, ok := req.Msg.Source.Options.Config.(*mgmtv1alpha1.JobSourceOptions_AwsS3); !ok {
 			return nil, fmt.Errorf("job source option config type and connection type mismatch")
@@ -936,6 +995,23 @@ func (s *Service) UpdateJobSourceConnection(
 		mappings = append(mappings, jm)
 	}
 
+	// switching between a change data capture source and a batch source changes the workflow that the job's schedule runs
+	isCdcSource := connectionOptions.PostgresCdcOptions != nil
+	hasScheduleWorkflowChanged := job.ConnectionOptions != nil && (job.ConnectionOptions.PostgresCdcOptions != nil) != isCdcSource
+	if hasScheduleWorkflowChanged && isCdcSource {
+		destConns, err := s.db.Q.GetJobConnectionDestinations(ctx, s.db.Db, job.ID)
+		if err != nil {
+			return nil, err
+		}
+		destinations := []*Destination{}
+		for _, dest := range destConns {
+			destinations = append(destinations, &Destination{ConnectionId: dest.ConnectionID, Options: dest.Options})
+		}
+		if err := verifyCdcDestinationsAreSupported(ctx, s.db, destinations); err != nil {
+			return nil, err
+		}
+	}
+
 	if err := s.db.WithTx(ctx, nil, func(dbtx nucleusdb.BaseDBTX) error {
 		_, err = s.db.Q.UpdateJobSource(ctx, dbtx, db_queries.UpdateJobSourceParams{
 			ID:                job.ID,
@@ -958,7 +1034,21 @@ func (s *Service) UpdateJobSourceConnection(
 			return err
 		}
 
-		return nil
+		if hasScheduleWorkflowChanged {
+			if err := s.updateScheduleWorkflow(ctx, nucleusdb.UUIDString(job.AccountID), req.Msg.Id, req.Msg.Source.Options); err != nil {
+				return err
+			}
+			// the change data capture workflow would otherwise keep streaming from the replication slot
+			if !isCdcSource {
+				if err := s.terminateRunningWorkflows(ctx, logger, nucleusdb.UUIDString(job.AccountID), req.Msg.Id); err != nil {
+					return err
+				}
+				if err := s.dropReplicationSlot(ctx, logger, req.Msg.Id, job.ConnectionOptions.PostgresCdcOptions); err != nil {
+					return err
+				}
+			}
+		}
+		return s.recordJobVersion(ctx, logger, dbtx, nucleusdb.UUIDString(job.AccountID), job.ID, *userUuid)
 	}); err != nil {
 		return nil, err
 	}
@@ -1026,7 +1116,7 @@ func (s *Service) SetJobSourceSqlConnectionSubsets(
 	connection := connectionResp.Msg.Connection
 
 	if connection.ConnectionConfig == nil ||
-		(connection.ConnectionConfig.GetPgConfig() == nil && connection.ConnectionConfig.GetMysqlConfig() == nil) {
+		(connection.ConnectionConfig.GetPgConfig() == nil && connection.ConnectionConfig.GetMysqlConfig() == nil && connection.ConnectionConfig.GetMssqlConfig() == nil) {
 		return nil, nucleuserrors.NewBadRequest("may only update subsets if the source connection is a SQL-based connection")
 	}
 
@@ -1039,6 +1129,9 @@ func (s *Service) SetJobSourceSqlConnectionSubsets(
 	); err != nil {
 		return nil, err
 	}
+	if err := s.recordJobVersion(ctx, logger, s.db.Db, nucleusdb.UUIDString(job.AccountID), jobUuid, *userUuid); err != nil {
+		return nil, err
+	}
 
 	updatedJobRes, err := s.GetJob(ctx, connect.NewRequest(&mgmtv1alpha1.GetJobRequest{
 		Id: req.Msg.Id,
@@ -1097,6 +1190,11 @@ func (s *Service) UpdateJobDestinationConnection(
 	if err != nil {
 		return nil, err
 	}
+	if job.Msg.Job.GetSource().GetOptions().GetPostgresCdc() != nil {
+		if err := verifyCdcDestinationsAreSupported(ctx, s.db, []*Destination{{ConnectionId: connectionUuid, Options: options}}); err != nil {
+			return nil, err
+		}
+	}
 
 	logger.Info("updating job destination connection")
 	_, err = s.db.Q.UpdateJobConnectionDestination(ctx, s.db.Db, db_queries.UpdateJobConnectionDestinationParams{
@@ -1117,6 +1215,9 @@ func (s *Service) UpdateJobDestinationConnection(
 			return nil, err
 		}
 	}
+	if err := s.recordJobVersion(ctx, logger, s.db.Db, job.Msg.Job.AccountId, jobUuid, *userUuid); err != nil {
+		return nil, err
+	}
 
 	updatedJob, err := s.GetJob(ctx, connect.NewRequest(&mgmtv1alpha1.GetJobRequest{
 		Id: job.Msg.Job.Id,
@@ -1180,6 +1281,9 @@ func (s *Service) DeleteJobDestinationConnection(
 	} else if err != nil && nucleusdb.IsNoRows(err) {
 		logger.Info("destination not found")
 	}
+	if err := s.recordJobVersion(ctx, logger, s.db.Db, nucleusdb.UUIDString(job.AccountID), job.ID, *userUuid); err != nil {
+		return nil, err
+	}
 
 	return connect.NewResponse(&mgmtv1alpha1.DeleteJobDestinationConnectionResponse{}), nil
 }
@@ -1338,11 +1442,163 @@ func verifyConnectionsAreCompatible(ctx context.Context, db *nucleusdb.NucleusDb
 			// invalid Mysql source cannot habe Postgres destination
 			return false, nil
 		}
+		if sourceConnection.ConnectionConfig.MssqlConfig != nil && (d.ConnectionConfig.PgConfig != nil || d.ConnectionConfig.MysqlConfig != nil || d.ConnectionConfig.SqliteConfig != nil) {
+			// invalid Mssql source can only have a Mssql destination
+			return false, nil
+		}
+		if (sourceConnection.ConnectionConfig.PgConfig != nil || sourceConnection.ConnectionConfig.MysqlConfig != nil) && d.ConnectionConfig.MssqlConfig != nil {
+			// invalid Postgres or Mysql source cannot have Mssql destination
+			return false, nil
+		}
 	}
 
 	return true, nil
 }
 
+// Only Postgres destinations are able to have changes applied to them by a change data capture source
+func verifyCdcDestinationsAreSupported(ctx context.Context, db *nucleusdb.NucleusDb, destinations []*Destination) error {
+	for _, dest := range destinations {
+		connection, err := db.Q.GetConnectionById(ctx, db.Db, dest.ConnectionId)
+		if err != nil {
+			return err
+		}
+		if connection.ConnectionConfig == nil || connection.ConnectionConfig.PgConfig == nil {
+			return nucleuserrors.NewBadRequest("postgres change data capture sources only support postgres destinations")
+		}
+	}
+	return nil
+}
+
+// Change data capture jobs run a long lived workflow that streams changes instead of the batch data sync workflow
+func getScheduleWorkflow(sourceOptions *mgmtv1alpha1.JobSourceOptions, jobId string) (workflow any, args []any) {
+	if sourceOptions.GetPostgresCdc() != nil {
+		return cdc_workflow.CdcWorkflow, []any{&cdc_workflow.WorkflowRequest{JobId: jobId}}
+	}
+	return datasync_workflow.Workflow, []any{&datasync_workflow.WorkflowRequest{JobId: jobId}}
+}
+
+// Terminates the job's workflow executions that are still running
+func (s *Service) terminateRunningWorkflows(ctx context.Context, logger *slog.Logger, accountId, jobId string) error {
+	tclient, err := s.temporalWfManager.GetWorkflowClientByAccount(ctx, accountId, logger)
+	if err != nil {
+		return err
+	}
+	tconfig, err := s.temporalWfManager.GetTemporalConfigByAccount(ctx, accountId)
+	if err != nil {
+		return err
+	}
+	workflows, err := getWorkflowExecutionsByJobIds(ctx, tclient, tconfig.Namespace, []string{jobId})
+	if err != nil {
+		return err
+	}
+	for _, w := range workflows {
+		if w.GetStatus() != enums.WORKFLOW_EXECUTION_STATUS_RUNNING {
+			continue
+		}
+		logger.Info(fmt.Sprintf("terminating workflow execution %s", w.GetExecution().GetWorkflowId()))
+		err := tclient.TerminateWorkflow(ctx, w.GetExecution().GetWorkflowId(), w.GetExecution().GetRunId(), "job source was changed")
+		if err != nil {
+			return fmt.Errorf("unable to terminate workflow execution: %w", err)
+		}
+	}
+	return nil
+}
+
+// Drops the replication slot of a change data capture job.
+// An unused slot retains write-ahead log on the source until it is dropped, so it must be removed when the job stops streaming changes.
+func (s *Service) dropReplicationSlot(
+	ctx context.Context,
+	logger *slog.Logger,
+	jobId string,
+	cdcOptions *pg_models.PostgresCdcSourceOptions,
+) error {
+	connResp, err := s.connectionService.GetConnection(ctx, connect.NewRequest(&mgmtv1alpha1.GetConnectionRequest{
+		Id: cdcOptions.ConnectionId,
+	}))
+	if err != nil && connect.CodeOf(err) == connect.CodeNotFound {
+		logger.Warn("source connection of change data capture job no longer exists, unable to drop replication slot")
+		return nil
+	} else if err != nil {
+		return fmt.Errorf("unable to retrieve source connection: %w", err)
+	}
+	pgconfig := connResp.Msg.GetConnection().GetConnectionConfig().GetPgConfig()
+	if pgconfig == nil {
+		return nucleuserrors.NewBadRequest("change data capture source connection must be a postgres connection")
+	}
+
+	connectionTimeout := uint32(5)
+	conn, err := s.sqlConnector.NewPgPoolFromConnectionConfig(pgconfig, &connectionTimeout, logger)
+	if err != nil {
+		return err
+	}
+	db, err := conn.Open(ctx)
+	if err != nil {
+		return fmt.Errorf("unable to connect to change data capture source: %w", err)
+	}
+	defer conn.Close()
+
+	slotName := getReplicationSlotName(jobId, cdcOptions)
+	// an active slot can not be dropped, so any connection that is still streaming from it is terminated first
+	_, err = db.Exec(
+		ctx,
+		"SELECT pg_catalog.pg_terminate_backend(active_pid) FROM pg_catalog.pg_replication_slots WHERE slot_name = $1 AND active;",
+		slotName,
+	)
+	if err != nil {
+		return fmt.Errorf("unable to release replication slot %s: %w", slotName, err)
+	}
+	_, err = db.Exec(
+		ctx,
+		"SELECT pg_catalog.pg_drop_replication_slot(slot_name) FROM pg_catalog.pg_replication_slots WHERE slot_name = $1;",
+		slotName,
+	)
+	if err != nil {
+		return fmt.Errorf("unable to drop replication slot %s: %w", slotName, err)
+	}
+	logger.Info(fmt.Sprintf("dropped replication slot %s", slotName))
+	return nil
+}
+
+// Must match the name of the slot that the worker creates for the job
+func getReplicationSlotName(jobId string, cdcOptions *pg_models.PostgresCdcSourceOptions) string {
+	if cdcOptions.SlotName != nil && *cdcOptions.SlotName != "" {
+		return *cdcOptions.SlotName
+	}
+	return fmt.Sprintf("neosync_%s", strings.ReplaceAll(strings.ToLower(jobId), "-", "_"))
+}
+
+func (s *Service) updateScheduleWorkflow(
+	ctx context.Context,
+	accountId, jobId string,
+	sourceOptions *mgmtv1alpha1.JobSourceOptions,
+) error {
+	logger := logger_interceptor.GetLoggerFromContextOrDefault(ctx)
+	scheduleHandle, err := s.temporalWfManager.GetScheduleHandleClientByAccount(ctx, accountId, jobId, logger)
+	if err != nil {
+		return fmt.Errorf("unable to retrieve temporal schedule client by job: %w", err)
+	}
+	scheduleWorkflow, scheduleArgs := getScheduleWorkflow(sourceOptions, jobId)
+	err = scheduleHandle.Update(ctx, temporalclient.ScheduleUpdateOptions{
+		DoUpdate: func(schedule temporalclient.ScheduleUpdateInput) (*temporalclient.ScheduleUpdate, error) {
+			action, ok := schedule.Description.Schedule.Action.(*temporalclient.ScheduleWorkflowAction)
+			if !ok {
+				return nil, fmt.Errorf("unable to cast temporal action to *temporalclient.ScheduleWorkflowAction. Type was: %T", schedule.Description.Schedule.Action)
+			}
+			action.Workflow = scheduleWorkflow
+			action.Args = scheduleArgs
+			schedule.Description.Schedule.Action = action
+			return &temporalclient.ScheduleUpdate{
+				Schedule: &schedule.Description.Schedule,
+			}, nil
+		},
+	})
+	if err != nil {
+		logger.Error(fmt.Errorf("unable to update schedule: %w", err).Error())
+		return fmt.Errorf("unable to update workflow on temporal schedule: %w", err)
+	}
+	return nil
+}
+
 func (s *Service) SetJobWorkflowOptions(
 	ctx context.Context,
 	req *connect.Request[mgmtv1alpha1.SetJobWorkflowOptionsRequest],
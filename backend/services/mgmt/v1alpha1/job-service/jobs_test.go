@@ -4,13 +4,16 @@ import (
 	"context"
 	"database/sql"
 	"errors"
+	"strings"
 	"testing"
 	"time"
 
 	"connectrpc.com/connect"
 	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgconn"
 	"github.com/jackc/pgx/v5/pgtype"
 	db_queries "github.com/nucleuscloud/neosync/backend/gen/go/db"
+	pg_queries "github.com/nucleuscloud/neosync/backend/gen/go/db/dbschemas/postgresql"
 	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
 	"github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1/mgmtv1alpha1connect"
 	"github.com/nucleuscloud/neosync/backend/internal/apikey"
@@ -19,8 +22,13 @@ import (
 	pg_models "github.com/nucleuscloud/neosync/backend/sql/postgresql/models"
 
 	"github.com/nucleuscloud/neosync/backend/internal/nucleusdb"
+	"github.com/nucleuscloud/neosync/backend/pkg/sqlconnect"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"go.temporal.io/api/common/v1"
+	"go.temporal.io/api/enums/v1"
+	workflowpb "go.temporal.io/api/workflow/v1"
+	"go.temporal.io/api/workflowservice/v1"
 	temporal "go.temporal.io/sdk/client"
 )
 
@@ -406,9 +414,12 @@ func Test_CreateJob(t *testing.T) {
 		UpdatedByID:     userUuid,
 		WorkflowOptions: &pg_models.WorkflowOptions{},
 		SyncOptions:     &pg_models.ActivityOptions{},
+		Triggers:        []*pg_models.JobTrigger{},
 	}).Return(job1, nil)
 	m.QuerierMock.On("CreateJobConnectionDestinations", mock.Anything, mockTx, destinationParams).Return(int64(1), nil)
 	m.QuerierMock.On("GetJobConnectionDestinations", mock.Anything, mock.Anything, job1.ID).Return([]db_queries.NeosyncApiJobDestinationConnectionAssociation{destConnAssociation}, nil)
+	m.QuerierMock.On("GetJobById", mock.Anything, mock.Anything, job1.ID).Return(job1, nil)
+	mockCreateJobVersion(m.QuerierMock, job1)
 
 	resp, err := m.Service.CreateJob(context.Background(), &connect.Request[mgmtv1alpha1.CreateJobRequest]{
 		Msg: &mgmtv1alpha1.CreateJobRequest{
@@ -546,11 +557,15 @@ func Test_CreateJob_Schedule_Creation_Error(t *testing.T) {
 		UpdatedByID:     userUuid,
 		WorkflowOptions: &pg_models.WorkflowOptions{},
 		SyncOptions:     &pg_models.ActivityOptions{},
+		Triggers:        []*pg_models.JobTrigger{},
 	}).Return(job1, nil)
 	m.QuerierMock.On("CreateJobConnectionDestinations", mock.Anything, mockTx, destinationParams).Return(int64(1), nil)
 
 	mockScheduleClient.On("Create", mock.Anything, mock.Anything).Return(nil, errors.New("test: unable to create temporal schedule"))
 	m.QuerierMock.On("RemoveJobById", mock.Anything, mock.Anything, job1.ID).Return(nil) // job deletion succeeds
+	m.QuerierMock.On("GetJobById", mock.Anything, mock.Anything, job1.ID).Return(job1, nil)
+	m.QuerierMock.On("GetJobConnectionDestinations", mock.Anything, mock.Anything, job1.ID).Return([]db_queries.NeosyncApiJobDestinationConnectionAssociation{}, nil)
+	mockCreateJobVersion(m.QuerierMock, job1)
 
 	resp, err := m.Service.CreateJob(context.Background(), &connect.Request[mgmtv1alpha1.CreateJobRequest]{
 		Msg: &mgmtv1alpha1.CreateJobRequest{
@@ -690,11 +705,15 @@ func Test_CreateJob_Schedule_Creation_Error_JobCleanup_Error(t *testing.T) {
 		UpdatedByID:     userUuid,
 		WorkflowOptions: &pg_models.WorkflowOptions{},
 		SyncOptions:     &pg_models.ActivityOptions{},
+		Triggers:        []*pg_models.JobTrigger{},
 	}).Return(job1, nil)
 	m.QuerierMock.On("CreateJobConnectionDestinations", mock.Anything, mockTx, destinationParams).Return(int64(1), nil)
 
 	mockScheduleClient.On("Create", mock.Anything, mock.Anything).Return(nil, errors.New("test: unable to create temporal schedule"))
 	m.QuerierMock.On("RemoveJobById", mock.Anything, mock.Anything, job1.ID).Return(errors.New("test: unable to remove job")) // job deletion succeeds
+	m.QuerierMock.On("GetJobById", mock.Anything, mock.Anything, job1.ID).Return(job1, nil)
+	m.QuerierMock.On("GetJobConnectionDestinations", mock.Anything, mock.Anything, job1.ID).Return([]db_queries.NeosyncApiJobDestinationConnectionAssociation{}, nil)
+	mockCreateJobVersion(m.QuerierMock, job1)
 
 	resp, err := m.Service.CreateJob(context.Background(), &connect.Request[mgmtv1alpha1.CreateJobRequest]{
 		Msg: &mgmtv1alpha1.CreateJobRequest{
@@ -786,6 +805,7 @@ func Test_CreateJobDestinationConnections(t *testing.T) {
 	}).Return(int64(1), nil)
 
 	jobId := nucleusdb.UUIDString(job.ID)
+	mockRecordJobVersion(m, job)
 
 	resp, err := m.Service.CreateJobDestinationConnections(context.Background(), &connect.Request[mgmtv1alpha1.CreateJobDestinationConnectionsRequest]{
 		Msg: &mgmtv1alpha1.CreateJobDestinationConnectionsRequest{
@@ -878,6 +898,7 @@ func Test_UpdateJobSchedule(t *testing.T) {
 	mockHandle := new(MockScheduleHandle)
 	m.TemporalWfManagerMock.On("GetScheduleHandleClientByAccount", mock.Anything, mockAccountId, jobId, mock.Anything).Return(mockHandle, nil)
 	mockHandle.On("Update", mock.Anything, mock.Anything).Return(nil)
+	mockRecordJobVersion(m, job)
 
 	resp, err := m.Service.UpdateJobSchedule(context.Background(), &connect.Request[mgmtv1alpha1.UpdateJobScheduleRequest]{
 		Msg: &mgmtv1alpha1.UpdateJobScheduleRequest{
@@ -995,6 +1016,7 @@ func Test_UpdateJobSourceConnection_Success(t *testing.T) {
 			},
 		},
 	}), nil)
+	mockRecordJobVersion(m, job)
 
 	resp, err := m.Service.UpdateJobSourceConnection(context.Background(), &connect.Request[mgmtv1alpha1.UpdateJobSourceConnectionRequest]{
 		Msg: &mgmtv1alpha1.UpdateJobSourceConnectionRequest{
@@ -1036,6 +1058,7 @@ func Test_UpdateJobSourceConnection_GenerateSuccess(t *testing.T) {
 	userUuid, _ := nucleusdb.ToUuid(mockUserId)
 	fkString := uuid.NewString()
 	k, _ := nucleusdb.ToUuid(fkString)
+	mockRecordJobVersion(m, job)
 
 	mockUserAccountCalls(m.UserAccountServiceMock, true)
 	mockDbTransaction(m.DbtxMock, mockTx)
@@ -1283,6 +1306,164 @@ func Test_UpdateJobSourceConnection_AwsS3MismatchError(t *testing.T) {
 }
 
 // SetJobSourceSqlConnectionSubsets
+func Test_UpdateJobSourceConnection_FromCdc_DropsReplicationSlot(t *testing.T) {
+	m := createServiceMock(t, &Config{IsAuthEnabled: true})
+	mockTx := new(nucleusdb.MockTx)
+	temporalClientMock := new(MockTemporalClient)
+	pgDbMock := pg_queries.NewMockDBTX(t)
+	pgPoolMock := sqlconnect.NewMockPgPoolContainer(t)
+
+	job := mockJob(mockAccountId, mockUserId, uuid.NewString(), pgtype.Text{})
+	job.ConnectionOptions = &pg_models.JobSourceOptions{
+		PostgresCdcOptions: &pg_models.PostgresCdcSourceOptions{ConnectionId: mockConnectionId, PublicationName: "neosync_pub"},
+	}
+	jobId := nucleusdb.UUIDString(job.ID)
+	slotName := getReplicationSlotName(jobId, job.ConnectionOptions.PostgresCdcOptions)
+	workflowId := uuid.NewString()
+	runId := uuid.NewString()
+
+	mockUserAccountCalls(m.UserAccountServiceMock, true)
+	mockDbTransaction(m.DbtxMock, mockTx)
+	mockGetJob(m.UserAccountServiceMock, m.QuerierMock, job, []db_queries.NeosyncApiJobDestinationConnectionAssociation{})
+	m.QuerierMock.On("IsConnectionInAccount", mock.Anything, mock.Anything, mock.Anything).Return(int64(1), nil)
+	m.QuerierMock.On("UpdateJobSource", mock.Anything, mockTx, mock.Anything).Return(job, nil)
+	m.QuerierMock.On("UpdateJobMappings", mock.Anything, mockTx, mock.Anything).Return(job, nil)
+	m.ConnectionServiceClientMock.On("GetConnection", mock.Anything, mock.Anything).Return(connect.NewResponse(&mgmtv1alpha1.GetConnectionResponse{
+		Connection: &mgmtv1alpha1.Connection{
+			Id: mockConnectionId,
+			ConnectionConfig: &mgmtv1alpha1.ConnectionConfig{
+				Config: &mgmtv1alpha1.ConnectionConfig_PgConfig{
+					PgConfig: &mgmtv1alpha1.PostgresConnectionConfig{},
+				},
+			},
+		},
+	}), nil)
+	mockRecordJobVersion(m, job)
+
+	// the running change data capture workflow is terminated before its slot is dropped
+	m.TemporalWfManagerMock.On("GetWorkflowClientByAccount", mock.Anything, mockAccountId, mock.Anything).Return(temporalClientMock, nil)
+	m.TemporalWfManagerMock.On("GetTemporalConfigByAccount", mock.Anything, mockAccountId).Return(&pg_models.TemporalConfig{Namespace: "default"}, nil)
+	temporalClientMock.On("ListWorkflow", mock.Anything, mock.Anything).Return(&workflowservice.ListWorkflowExecutionsResponse{
+		Executions: []*workflowpb.WorkflowExecutionInfo{
+			{Execution: &common.WorkflowExecution{WorkflowId: workflowId, RunId: runId}, Status: enums.WORKFLOW_EXECUTION_STATUS_RUNNING},
+			{Execution: &common.WorkflowExecution{WorkflowId: uuid.NewString(), RunId: uuid.NewString()}, Status: enums.WORKFLOW_EXECUTION_STATUS_COMPLETED},
+		},
+	}, nil)
+	temporalClientMock.On("TerminateWorkflow", mock.Anything, workflowId, runId, mock.Anything, mock.Anything).Return(nil).Once()
+
+	m.SqlConnectorMock.On("NewPgPoolFromConnectionConfig", mock.Anything, mock.Anything, mock.Anything).Return(pgPoolMock, nil)
+	pgPoolMock.On("Open", mock.Anything).Return(pgDbMock, nil)
+	pgPoolMock.On("Close")
+	pgDbMock.On("Exec", mock.Anything, mock.MatchedBy(func(query string) bool { return strings.Contains(query, "pg_terminate_backend") }), slotName).
+		Return(pgconn.NewCommandTag("SELECT 0"), nil).Once()
+	pgDbMock.On("Exec", mock.Anything, mock.MatchedBy(func(query string) bool { return strings.Contains(query, "pg_drop_replication_slot") }), slotName).
+		Return(pgconn.NewCommandTag("SELECT 1"), nil).Once()
+
+	resp, err := m.Service.UpdateJobSourceConnection(context.Background(), &connect.Request[mgmtv1alpha1.UpdateJobSourceConnectionRequest]{
+		Msg: &mgmtv1alpha1.UpdateJobSourceConnectionRequest{
+			Id: jobId,
+			Source: &mgmtv1alpha1.JobSource{
+				Options: &mgmtv1alpha1.JobSourceOptions{
+					Config: &mgmtv1alpha1.JobSourceOptions_Postgres{
+						Postgres: &mgmtv1alpha1.PostgresSourceConnectionOptions{ConnectionId: mockConnectionId},
+					},
+				},
+			},
+			Mappings: []*mgmtv1alpha1.JobMapping{},
+		},
+	})
+
+	assert.NoError(t, err)
+	assert.NotNil(t, resp)
+	temporalClientMock.AssertExpectations(t)
+}
+
+func Test_DeleteJob_Cdc_DropsReplicationSlot(t *testing.T) {
+	m := createServiceMock(t, &Config{IsAuthEnabled: true})
+	temporalClientMock := new(MockTemporalClient)
+	mockScheduleClient := new(MockScheduleClient)
+	mockHandle := new(MockScheduleHandle)
+	mockScheduleClient.Handle = mockHandle
+	pgDbMock := pg_queries.NewMockDBTX(t)
+	pgPoolMock := sqlconnect.NewMockPgPoolContainer(t)
+
+	slotName := "custom_slot"
+	job := mockJob(mockAccountId, mockUserId, uuid.NewString(), pgtype.Text{})
+	job.ConnectionOptions = &pg_models.JobSourceOptions{
+		PostgresCdcOptions: &pg_models.PostgresCdcSourceOptions{ConnectionId: mockConnectionId, PublicationName: "neosync_pub", SlotName: &slotName},
+	}
+
+	mockIsUserInAccount(m.UserAccountServiceMock, true)
+	m.QuerierMock.On("GetJobById", mock.Anything, mock.Anything, job.ID).Return(job, nil)
+	m.TemporalWfManagerMock.On("GetWorkflowClientByAccount", mock.Anything, mockAccountId, mock.Anything).Return(temporalClientMock, nil)
+	m.TemporalWfManagerMock.On("GetTemporalConfigByAccount", mock.Anything, mockAccountId).Return(&pg_models.TemporalConfig{Namespace: "default"}, nil)
+	temporalClientMock.On("ListWorkflow", mock.Anything, mock.Anything).Return(&workflowservice.ListWorkflowExecutionsResponse{}, nil)
+	temporalClientMock.On("ScheduleClient").Return(mockScheduleClient)
+	mockScheduleClient.On("GetHandle", mock.Anything, mock.Anything).Return(mockHandle)
+	mockHandle.On("Describe", mock.Anything).Return(&temporal.ScheduleDescription{}, nil)
+	mockHandle.On("Delete", mock.Anything).Return(nil)
+
+	m.ConnectionServiceClientMock.On("GetConnection", mock.Anything, mock.Anything).Return(connect.NewResponse(&mgmtv1alpha1.GetConnectionResponse{
+		Connection: &mgmtv1alpha1.Connection{
+			Id: mockConnectionId,
+			ConnectionConfig: &mgmtv1alpha1.ConnectionConfig{
+				Config: &mgmtv1alpha1.ConnectionConfig_PgConfig{
+					PgConfig: &mgmtv1alpha1.PostgresConnectionConfig{},
+				},
+			},
+		},
+	}), nil)
+	m.SqlConnectorMock.On("NewPgPoolFromConnectionConfig", mock.Anything, mock.Anything, mock.Anything).Return(pgPoolMock, nil)
+	pgPoolMock.On("Open", mock.Anything).Return(pgDbMock, nil)
+	pgPoolMock.On("Close")
+	pgDbMock.On("Exec", mock.Anything, mock.MatchedBy(func(query string) bool { return strings.Contains(query, "pg_terminate_backend") }), slotName).
+		Return(pgconn.NewCommandTag("SELECT 0"), nil).Once()
+	pgDbMock.On("Exec", mock.Anything, mock.MatchedBy(func(query string) bool { return strings.Contains(query, "pg_drop_replication_slot") }), slotName).
+		Return(pgconn.NewCommandTag("SELECT 1"), nil).Once()
+	m.QuerierMock.On("RemoveJobById", mock.Anything, mock.Anything, job.ID).Return(nil)
+
+	resp, err := m.Service.DeleteJob(context.Background(), connect.NewRequest(&mgmtv1alpha1.DeleteJobRequest{
+		Id: nucleusdb.UUIDString(job.ID),
+	}))
+
+	assert.NoError(t, err)
+	assert.NotNil(t, resp)
+	mockHandle.AssertExpectations(t)
+}
+
+func Test_DeleteJob_Cdc_DropReplicationSlotError(t *testing.T) {
+	m := createServiceMock(t, &Config{IsAuthEnabled: true})
+	temporalClientMock := new(MockTemporalClient)
+	mockScheduleClient := new(MockScheduleClient)
+	mockHandle := new(MockScheduleHandle)
+	mockScheduleClient.Handle = mockHandle
+
+	job := mockJob(mockAccountId, mockUserId, uuid.NewString(), pgtype.Text{})
+	job.ConnectionOptions = &pg_models.JobSourceOptions{
+		PostgresCdcOptions: &pg_models.PostgresCdcSourceOptions{ConnectionId: mockConnectionId, PublicationName: "neosync_pub"},
+	}
+
+	mockIsUserInAccount(m.UserAccountServiceMock, true)
+	m.QuerierMock.On("GetJobById", mock.Anything, mock.Anything, job.ID).Return(job, nil)
+	m.TemporalWfManagerMock.On("GetWorkflowClientByAccount", mock.Anything, mockAccountId, mock.Anything).Return(temporalClientMock, nil)
+	m.TemporalWfManagerMock.On("GetTemporalConfigByAccount", mock.Anything, mockAccountId).Return(&pg_models.TemporalConfig{Namespace: "default"}, nil)
+	temporalClientMock.On("ListWorkflow", mock.Anything, mock.Anything).Return(&workflowservice.ListWorkflowExecutionsResponse{}, nil)
+	temporalClientMock.On("ScheduleClient").Return(mockScheduleClient)
+	mockScheduleClient.On("GetHandle", mock.Anything, mock.Anything).Return(mockHandle)
+	mockHandle.On("Describe", mock.Anything).Return(&temporal.ScheduleDescription{}, nil)
+	mockHandle.On("Delete", mock.Anything).Return(nil)
+	m.ConnectionServiceClientMock.On("GetConnection", mock.Anything, mock.Anything).Return(nil, errors.New("test: unable to retrieve connection"))
+
+	resp, err := m.Service.DeleteJob(context.Background(), connect.NewRequest(&mgmtv1alpha1.DeleteJobRequest{
+		Id: nucleusdb.UUIDString(job.ID),
+	}))
+
+	// the job is kept so that deleting it can be retried once the slot can be dropped
+	assert.Error(t, err)
+	assert.Nil(t, resp)
+	m.QuerierMock.AssertNotCalled(t, "RemoveJobById", mock.Anything, mock.Anything, mock.Anything)
+}
+
 func Test_SetJobSourceSqlConnectionSubsets_Invalid_Connection_No_ConnectionId(t *testing.T) {
 	m := createServiceMock(t, &Config{IsAuthEnabled: true})
 	job := mockJob(mockAccountId, mockUserId, uuid.NewString(), pgtype.Text{})
@@ -1352,6 +1533,7 @@ func Test_UpdateJobDestinationConnection_Update(t *testing.T) {
 			},
 		},
 	}).Return(db_queries.NeosyncApiJobDestinationConnectionAssociation{}, nil)
+	mockRecordJobVersion(m, job)
 
 	resp, err := m.Service.UpdateJobDestinationConnection(context.Background(), &connect.Request[mgmtv1alpha1.UpdateJobDestinationConnectionRequest]{
 		Msg: &mgmtv1alpha1.UpdateJobDestinationConnectionRequest{
@@ -1404,6 +1586,7 @@ func Test_UpdateJobDestinationConnection_Create(t *testing.T) {
 			},
 		},
 	}).Return(db_queries.NeosyncApiJobDestinationConnectionAssociation{}, nil)
+	mockRecordJobVersion(m, job)
 
 	resp, err := m.Service.UpdateJobDestinationConnection(context.Background(), &connect.Request[mgmtv1alpha1.UpdateJobDestinationConnectionRequest]{
 		Msg: &mgmtv1alpha1.UpdateJobDestinationConnectionRequest{
@@ -1419,6 +1602,43 @@ func Test_UpdateJobDestinationConnection_Create(t *testing.T) {
 	assert.Equal(t, jobId, resp.Msg.Job.Id)
 }
 
+func Test_UpdateJobDestinationConnection_Cdc_UnsupportedDestination(t *testing.T) {
+	m := createServiceMock(t, &Config{IsAuthEnabled: true})
+	job := mockJob(mockAccountId, mockUserId, uuid.NewString(), pgtype.Text{})
+	job.ConnectionOptions = &pg_models.JobSourceOptions{
+		PostgresCdcOptions: &pg_models.PostgresCdcSourceOptions{ConnectionId: uuid.NewString(), PublicationName: "neosync_pub"},
+	}
+	connectionId := uuid.NewString()
+	connectionUuid, _ := nucleusdb.ToUuid(connectionId)
+
+	mockUserAccountCalls(m.UserAccountServiceMock, true)
+	mockGetJob(m.UserAccountServiceMock, m.QuerierMock, job, []db_queries.NeosyncApiJobDestinationConnectionAssociation{})
+	m.QuerierMock.On("IsConnectionInAccount", mock.Anything, mock.Anything, mock.Anything).Return(int64(1), nil)
+	m.QuerierMock.On("GetConnectionById", mock.Anything, mock.Anything, connectionUuid).Return(db_queries.NeosyncApiConnection{
+		ID:               connectionUuid,
+		ConnectionConfig: &pg_models.ConnectionConfig{MysqlConfig: &pg_models.MysqlConnectionConfig{}},
+	}, nil)
+
+	resp, err := m.Service.UpdateJobDestinationConnection(context.Background(), &connect.Request[mgmtv1alpha1.UpdateJobDestinationConnectionRequest]{
+		Msg: &mgmtv1alpha1.UpdateJobDestinationConnectionRequest{
+			JobId:         nucleusdb.UUIDString(job.ID),
+			DestinationId: uuid.NewString(),
+			ConnectionId:  connectionId,
+			Options: &mgmtv1alpha1.JobDestinationOptions{
+				Config: &mgmtv1alpha1.JobDestinationOptions_MysqlOptions{MysqlOptions: &mgmtv1alpha1.MysqlDestinationConnectionOptions{
+					TruncateTable: &mgmtv1alpha1.MysqlTruncateTableConfig{},
+				}},
+			},
+		},
+	})
+
+	m.QuerierMock.AssertNotCalled(t, "UpdateJobConnectionDestination", mock.Anything, mock.Anything, mock.Anything)
+	m.QuerierMock.AssertNotCalled(t, "CreateJobConnectionDestination", mock.Anything, mock.Anything, mock.Anything)
+	assert.Error(t, err)
+	assert.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+	assert.Nil(t, resp)
+}
+
 func Test_DeleteJobDestinationConnection(t *testing.T) {
 	m := createServiceMock(t, &Config{IsAuthEnabled: true})
 	job := mockJob(mockAccountId, mockUserId, uuid.NewString(), pgtype.Text{})
@@ -1433,6 +1653,8 @@ func Test_DeleteJobDestinationConnection(t *testing.T) {
 	m.QuerierMock.On("IsConnectionInAccount", mock.Anything, mock.Anything, mock.Anything).Return(int64(1), nil)
 
 	m.QuerierMock.On("RemoveJobConnectionDestination", mock.Anything, mock.Anything, destinationUuid).Return(nil)
+	m.QuerierMock.On("GetJobConnectionDestinations", mock.Anything, mock.Anything, job.ID).Return([]db_queries.NeosyncApiJobDestinationConnectionAssociation{}, nil)
+	mockRecordJobVersion(m, job)
 
 	resp, err := m.Service.DeleteJobDestinationConnection(context.Background(), &connect.Request[mgmtv1alpha1.DeleteJobDestinationConnectionRequest]{
 		Msg: &mgmtv1alpha1.DeleteJobDestinationConnectionRequest{
@@ -1519,6 +1741,7 @@ type serviceMocks struct {
 	UserAccountServiceMock      *mgmtv1alpha1connect.MockUserAccountServiceClient
 	ConnectionServiceClientMock *mgmtv1alpha1connect.MockConnectionServiceClient
 	TemporalWfManagerMock       *clientmanager.MockTemporalClientManagerClient
+	SqlConnectorMock            *sqlconnect.MockSqlConnector
 }
 
 func createServiceMock(t *testing.T, config *Config) *serviceMocks {
@@ -1527,8 +1750,9 @@ func createServiceMock(t *testing.T, config *Config) *serviceMocks {
 	mockUserAccountService := mgmtv1alpha1connect.NewMockUserAccountServiceClient(t)
 	mockConnectionService := mgmtv1alpha1connect.NewMockConnectionServiceClient(t)
 	mockTemporalWfManager := clientmanager.NewMockTemporalClientManagerClient(t)
+	mockSqlConnector := sqlconnect.NewMockSqlConnector(t)
 
-	service := New(config, nucleusdb.New(mockDbtx, mockQuerier), mockTemporalWfManager, mockConnectionService, mockUserAccountService)
+	service := New(config, nucleusdb.New(mockDbtx, mockQuerier), mockTemporalWfManager, mockConnectionService, mockUserAccountService, mockSqlConnector)
 
 	return &serviceMocks{
 		Service:                     service,
@@ -1537,6 +1761,7 @@ func createServiceMock(t *testing.T, config *Config) *serviceMocks {
 		UserAccountServiceMock:      mockUserAccountService,
 		ConnectionServiceClientMock: mockConnectionService,
 		TemporalWfManagerMock:       mockTemporalWfManager,
+		SqlConnectorMock:            mockSqlConnector,
 	}
 }
 
@@ -1564,6 +1789,22 @@ func mockGetJob(
 	querierMock.On("GetJobConnectionDestinations", mock.Anything, mock.Anything, jobMock.ID).Return(destinationMocks, nil)
 }
 
+//nolint:all
+func mockCreateJobVersion(querierMock *db_queries.MockQuerier, jobMock db_queries.NeosyncApiJob) {
+	querierMock.On("GetLatestJobVersion", mock.Anything, mock.Anything, jobMock.ID).Return(db_queries.NeosyncApiJobVersion{}, sql.ErrNoRows)
+	querierMock.On("CreateJobVersion", mock.Anything, mock.Anything, mock.Anything).Return(mockJobVersion(jobMock, 1), nil)
+}
+
+// mocks a version being created for the job and stamped on its schedule
+//
+//nolint:all
+func mockRecordJobVersion(m *serviceMocks, jobMock db_queries.NeosyncApiJob) {
+	mockCreateJobVersion(m.QuerierMock, jobMock)
+	mockHandle := new(MockScheduleHandle)
+	m.TemporalWfManagerMock.On("GetScheduleHandleClientByAccount", mock.Anything, mockAccountId, nucleusdb.UUIDString(jobMock.ID), mock.Anything).Return(mockHandle, nil)
+	mockHandle.On("Update", mock.Anything, mock.Anything).Return(nil)
+}
+
 //nolint:all
 func mockUserAccountCalls(userAccountServiceMock *mgmtv1alpha1connect.MockUserAccountServiceClient, isInAccount bool) {
 	mockIsUserInAccount(userAccountServiceMock, isInAccount)
@@ -1596,6 +1837,21 @@ func mockJob(accountId, userId, srcConnId string, cronSchedule pgtype.Text) db_q
 
 }
 
+func mockJobVersion(jobMock db_queries.NeosyncApiJob, version int32) db_queries.NeosyncApiJobVersion {
+	id, _ := nucleusdb.ToUuid(uuid.NewString())
+	return db_queries.NeosyncApiJobVersion{
+		ID:                id,
+		CreatedAt:         pgtype.Timestamp{Time: time.Now()},
+		JobID:             jobMock.ID,
+		Version:           version,
+		CronSchedule:      jobMock.CronSchedule,
+		ConnectionOptions: jobMock.ConnectionOptions,
+		Mappings:          jobMock.Mappings,
+		Destinations:      []*pg_models.JobVersionDestination{},
+		CreatedByID:       jobMock.CreatedByID,
+	}
+}
+
 func mockJobDestConnAssociation(jobUuid, connectionUuid pgtype.UUID, options *pg_models.JobDestinationOptions) db_queries.NeosyncApiJobDestinationConnectionAssociation {
 	idUuid, _ := nucleusdb.ToUuid(uuid.NewString())
 	timestamp := pgtype.Timestamp{
@@ -0,0 +1,128 @@
+package v1alpha1_jobservice
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+	db_queries "github.com/nucleuscloud/neosync/backend/gen/go/db"
+	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
+	logger_interceptor "github.com/nucleuscloud/neosync/backend/internal/connect/interceptors/logger"
+	nucleuserrors "github.com/nucleuscloud/neosync/backend/internal/errors"
+	"github.com/nucleuscloud/neosync/backend/internal/nucleusdb"
+	preview_workflow "github.com/nucleuscloud/neosync/worker/pkg/workflows/preview/workflow"
+	temporalclient "go.temporal.io/sdk/client"
+)
+
+const (
+	previewWorkflowTimeout = 5 * time.Minute
+)
+
+// Resolves the mappings and source connection of the preview and executes the preview workflow on the account's worker.
+// The worker reads from the source connection but never writes to any destination.
+func (s *Service) PreviewJobMappings(
+	ctx context.Context,
+	req *connect.Request[mgmtv1alpha1.PreviewJobMappingsRequest],
+) (*connect.Response[mgmtv1alpha1.PreviewJobMappingsResponse], error) {
+	logger := logger_interceptor.GetLoggerFromContextOrDefault(ctx)
+	logger = logger.With("accountId", req.Msg.AccountId)
+
+	var previewSource *mgmtv1alpha1.JobMappingsPreviewSource
+	switch source := req.Msg.Source.(type) {
+	case *mgmtv1alpha1.PreviewJobMappingsRequest_JobId:
+		logger = logger.With("jobId", source.JobId)
+		job, err := s.getVerifiedJob(ctx, source.JobId)
+		if err != nil {
+			return nil, err
+		}
+		if nucleusdb.UUIDString(job.AccountID) != req.Msg.AccountId {
+			return nil, nucleuserrors.NewNotFound("unable to find job by id")
+		}
+		previewSource, err = getJobMappingsPreviewSource(job)
+		if err != nil {
+			return nil, err
+		}
+	case *mgmtv1alpha1.PreviewJobMappingsRequest_Mappings:
+		_, err := s.verifyUserInAccount(ctx, req.Msg.AccountId)
+		if err != nil {
+			return nil, err
+		}
+		connResp, err := s.connectionService.GetConnection(ctx, connect.NewRequest(&mgmtv1alpha1.GetConnectionRequest{
+			Id: source.Mappings.ConnectionId,
+		}))
+		if err != nil {
+			return nil, err
+		}
+		if connResp.Msg.Connection.AccountId != req.Msg.AccountId {
+			return nil, nucleuserrors.NewNotFound("unable to find connection by id")
+		}
+		previewSource = source.Mappings
+	default:
+		return nil, nucleuserrors.NewBadRequest(fmt.Sprintf("unsupported job mappings preview source: %T", source))
+	}
+
+	tclient, err := s.temporalWfManager.GetWorkflowClientByAccount(ctx, req.Msg.AccountId, logger)
+	if err != nil {
+		return nil, err
+	}
+	tconfig, err := s.temporalWfManager.GetTemporalConfigByAccount(ctx, req.Msg.AccountId)
+	if err != nil {
+		return nil, err
+	}
+
+	logger.Info("previewing job mappings")
+	run, err := tclient.ExecuteWorkflow(
+		ctx,
+		temporalclient.StartWorkflowOptions{
+			ID:                       fmt.Sprintf("preview-%s", uuid.NewString()),
+			TaskQueue:                tconfig.SyncJobQueueName,
+			WorkflowExecutionTimeout: previewWorkflowTimeout,
+		},
+		preview_workflow.PreviewWorkflow,
+		&mgmtv1alpha1.PreviewJobMappingsRequest{
+			AccountId: req.Msg.AccountId,
+			Source:    &mgmtv1alpha1.PreviewJobMappingsRequest_Mappings{Mappings: previewSource},
+			RowCount:  req.Msg.RowCount,
+		},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("unable to start job mappings preview: %w", err)
+	}
+	var resp *mgmtv1alpha1.PreviewJobMappingsResponse
+	if err := run.Get(ctx, &resp); err != nil {
+		return nil, fmt.Errorf("unable to preview job mappings: %w", err)
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func getJobMappingsPreviewSource(job *db_queries.NeosyncApiJob) (*mgmtv1alpha1.JobMappingsPreviewSource, error) {
+	var connectionId string
+	if job.ConnectionOptions != nil {
+		if job.ConnectionOptions.PostgresOptions != nil {
+			connectionId = job.ConnectionOptions.PostgresOptions.ConnectionId
+		} else if job.ConnectionOptions.MysqlOptions != nil {
+			connectionId = job.ConnectionOptions.MysqlOptions.ConnectionId
+		} else if job.ConnectionOptions.MssqlOptions != nil {
+			connectionId = job.ConnectionOptions.MssqlOptions.ConnectionId
+		} else if job.ConnectionOptions.PostgresCdcOptions != nil {
+			connectionId = job.ConnectionOptions.PostgresCdcOptions.ConnectionId
+		}
+	}
+	if connectionId == "" {
+		return nil, nucleuserrors.NewBadRequest("only jobs with a SQL-based source connection may be previewed")
+	}
+	if len(job.Mappings) == 0 {
+		return nil, nucleuserrors.NewBadRequest("job does not have any mappings to preview")
+	}
+
+	mappings := make([]*mgmtv1alpha1.JobMapping, 0, len(job.Mappings))
+	for _, mapping := range job.Mappings {
+		mappings = append(mappings, mapping.ToDto())
+	}
+	return &mgmtv1alpha1.JobMappingsPreviewSource{
+		ConnectionId: connectionId,
+		Mappings:     mappings,
+	}, nil
+}
@@ -0,0 +1,185 @@
+package v1alpha1_jobservice
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
+	"github.com/nucleuscloud/neosync/backend/internal/nucleusdb"
+	pg_models "github.com/nucleuscloud/neosync/backend/sql/postgresql/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	temporalmocks "go.temporal.io/sdk/mocks"
+)
+
+var (
+	mockPreviewResponse = &mgmtv1alpha1.PreviewJobMappingsResponse{
+		Tables: []*mgmtv1alpha1.JobMappingsTablePreview{{
+			Schema:  "public",
+			Table:   "users",
+			Columns: []string{"name"},
+			Rows: []*mgmtv1alpha1.JobMappingsPreviewRow{{
+				Before: map[string][]byte{"name": []byte("nick")},
+				After:  map[string][]byte{"name": []byte("alisha")},
+			}},
+		}},
+	}
+)
+
+func mockPreviewWorkflow(t *testing.T, m *serviceMocks) *MockTemporalClient {
+	temporalClientMock := new(MockTemporalClient)
+	workflowRunMock := temporalmocks.NewWorkflowRun(t)
+	m.TemporalWfManagerMock.On("GetWorkflowClientByAccount", mock.Anything, mockAccountId, mock.Anything).Return(temporalClientMock, nil)
+	m.TemporalWfManagerMock.On("GetTemporalConfigByAccount", mock.Anything, mockAccountId).Return(&pg_models.TemporalConfig{
+		Namespace:        "default",
+		SyncJobQueueName: "sync-job",
+		Url:              "localhost:7233",
+	}, nil)
+	temporalClientMock.On("ExecuteWorkflow", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(workflowRunMock, nil)
+	workflowRunMock.On("Get", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+		resp := args.Get(1).(**mgmtv1alpha1.PreviewJobMappingsResponse)
+		*resp = mockPreviewResponse
+	}).Return(nil)
+	return temporalClientMock
+}
+
+func Test_PreviewJobMappings_Job(t *testing.T) {
+	m := createServiceMock(t, &Config{IsAuthEnabled: true})
+	srcConnId := uuid.NewString()
+	job := mockJob(mockAccountId, mockUserId, srcConnId, pgtype.Text{})
+	job.ConnectionOptions = &pg_models.JobSourceOptions{
+		PostgresOptions: &pg_models.PostgresSourceOptions{ConnectionId: srcConnId},
+	}
+	job.Mappings = []*pg_models.JobMapping{{Schema: "public", Table: "users", Column: "name", JobMappingTransformer: &pg_models.JobMappingTransformerModel{
+		Source: int32(mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_PASSTHROUGH),
+		Config: &pg_models.TransformerConfigs{},
+	}}}
+
+	mockIsUserInAccount(m.UserAccountServiceMock, true)
+	m.QuerierMock.On("GetJobById", mock.Anything, mock.Anything, job.ID).Return(job, nil)
+	temporalClientMock := mockPreviewWorkflow(t, m)
+
+	resp, err := m.Service.PreviewJobMappings(context.Background(), connect.NewRequest(&mgmtv1alpha1.PreviewJobMappingsRequest{
+		AccountId: mockAccountId,
+		Source:    &mgmtv1alpha1.PreviewJobMappingsRequest_JobId{JobId: nucleusdb.UUIDString(job.ID)},
+	}))
+
+	require.NoError(t, err)
+	assert.Equal(t, mockPreviewResponse, resp.Msg)
+
+	workflowReq, ok := temporalClientMock.Calls[0].Arguments.Get(3).([]any)[0].(*mgmtv1alpha1.PreviewJobMappingsRequest)
+	require.True(t, ok)
+	assert.Equal(t, srcConnId, workflowReq.GetMappings().GetConnectionId())
+	require.Len(t, workflowReq.GetMappings().GetMappings(), 1)
+	assert.Equal(t, "name", workflowReq.GetMappings().GetMappings()[0].GetColumn())
+}
+
+func Test_PreviewJobMappings_Job_Without_Sql_Source(t *testing.T) {
+	m := createServiceMock(t, &Config{IsAuthEnabled: true})
+	job := mockJob(mockAccountId, mockUserId, uuid.NewString(), pgtype.Text{})
+	job.ConnectionOptions = &pg_models.JobSourceOptions{GenerateOptions: &pg_models.GenerateSourceOptions{}}
+
+	mockIsUserInAccount(m.UserAccountServiceMock, true)
+	m.QuerierMock.On("GetJobById", mock.Anything, mock.Anything, job.ID).Return(job, nil)
+
+	_, err := m.Service.PreviewJobMappings(context.Background(), connect.NewRequest(&mgmtv1alpha1.PreviewJobMappingsRequest{
+		AccountId: mockAccountId,
+		Source:    &mgmtv1alpha1.PreviewJobMappingsRequest_JobId{JobId: nucleusdb.UUIDString(job.ID)},
+	}))
+
+	require.Error(t, err)
+	m.TemporalWfManagerMock.AssertNotCalled(t, "GetWorkflowClientByAccount", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func Test_PreviewJobMappings_Mappings(t *testing.T) {
+	m := createServiceMock(t, &Config{IsAuthEnabled: true})
+	connectionId := uuid.NewString()
+
+	mockIsUserInAccount(m.UserAccountServiceMock, true)
+	m.ConnectionServiceClientMock.On("GetConnection", mock.Anything, mock.Anything).
+		Return(connect.NewResponse(&mgmtv1alpha1.GetConnectionResponse{
+			Connection: &mgmtv1alpha1.Connection{Id: connectionId, AccountId: mockAccountId},
+		}), nil)
+	temporalClientMock := mockPreviewWorkflow(t, m)
+
+	rowCount := uint32(5)
+	resp, err := m.Service.PreviewJobMappings(context.Background(), connect.NewRequest(&mgmtv1alpha1.PreviewJobMappingsRequest{
+		AccountId: mockAccountId,
+		Source: &mgmtv1alpha1.PreviewJobMappingsRequest_Mappings{
+			Mappings: &mgmtv1alpha1.JobMappingsPreviewSource{
+				ConnectionId: connectionId,
+				Mappings:     []*mgmtv1alpha1.JobMapping{{Schema: "public", Table: "users", Column: "name"}},
+			},
+		},
+		RowCount: &rowCount,
+	}))
+
+	require.NoError(t, err)
+	assert.Equal(t, mockPreviewResponse, resp.Msg)
+
+	workflowReq, ok := temporalClientMock.Calls[0].Arguments.Get(3).([]any)[0].(*mgmtv1alpha1.PreviewJobMappingsRequest)
+	require.True(t, ok)
+	assert.Equal(t, connectionId, workflowReq.GetMappings().GetConnectionId())
+	assert.Equal(t, rowCount, workflowReq.GetRowCount())
+}
+
+func Test_PreviewJobMappings_Mappings_Other_Account_Connection(t *testing.T) {
+	m := createServiceMock(t, &Config{IsAuthEnabled: true})
+	connectionId := uuid.NewString()
+
+	mockIsUserInAccount(m.UserAccountServiceMock, true)
+	m.ConnectionServiceClientMock.On("GetConnection", mock.Anything, mock.Anything).
+		Return(connect.NewResponse(&mgmtv1alpha1.GetConnectionResponse{
+			Connection: &mgmtv1alpha1.Connection{Id: connectionId, AccountId: uuid.NewString()},
+		}), nil)
+
+	_, err := m.Service.PreviewJobMappings(context.Background(), connect.NewRequest(&mgmtv1alpha1.PreviewJobMappingsRequest{
+		AccountId: mockAccountId,
+		Source: &mgmtv1alpha1.PreviewJobMappingsRequest_Mappings{
+			Mappings: &mgmtv1alpha1.JobMappingsPreviewSource{
+				ConnectionId: connectionId,
+				Mappings:     []*mgmtv1alpha1.JobMapping{{Schema: "public", Table: "users", Column: "name"}},
+			},
+		},
+	}))
+
+	require.Error(t, err)
+	m.TemporalWfManagerMock.AssertNotCalled(t, "GetWorkflowClientByAccount", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func Test_PreviewJobMappings_Workflow_Error(t *testing.T) {
+	m := createServiceMock(t, &Config{IsAuthEnabled: true})
+	connectionId := uuid.NewString()
+
+	mockIsUserInAccount(m.UserAccountServiceMock, true)
+	m.ConnectionServiceClientMock.On("GetConnection", mock.Anything, mock.Anything).
+		Return(connect.NewResponse(&mgmtv1alpha1.GetConnectionResponse{
+			Connection: &mgmtv1alpha1.Connection{Id: connectionId, AccountId: mockAccountId},
+		}), nil)
+	temporalClientMock := new(MockTemporalClient)
+	workflowRunMock := temporalmocks.NewWorkflowRun(t)
+	m.TemporalWfManagerMock.On("GetWorkflowClientByAccount", mock.Anything, mockAccountId, mock.Anything).Return(temporalClientMock, nil)
+	m.TemporalWfManagerMock.On("GetTemporalConfigByAccount", mock.Anything, mockAccountId).Return(&pg_models.TemporalConfig{
+		SyncJobQueueName: "sync-job",
+	}, nil)
+	temporalClientMock.On("ExecuteWorkflow", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(workflowRunMock, nil)
+	workflowRunMock.On("Get", mock.Anything, mock.Anything).Return(errors.New("column name does not exist"))
+
+	_, err := m.Service.PreviewJobMappings(context.Background(), connect.NewRequest(&mgmtv1alpha1.PreviewJobMappingsRequest{
+		AccountId: mockAccountId,
+		Source: &mgmtv1alpha1.PreviewJobMappingsRequest_Mappings{
+			Mappings: &mgmtv1alpha1.JobMappingsPreviewSource{
+				ConnectionId: connectionId,
+				Mappings:     []*mgmtv1alpha1.JobMapping{{Schema: "public", Table: "users", Column: "name"}},
+			},
+		},
+	}))
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "column name does not exist")
+}
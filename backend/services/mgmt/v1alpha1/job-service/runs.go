@@ -122,6 +122,13 @@ func (s *Service) GetJobRun(
 	}
 
 	dto := dtomaps.ToJobRunDto(logger, res)
+	if dto.JobId != "" {
+		watermarks, err := s.getJobRunWatermarks(ctx, dto.JobId, dto.Id)
+		if err != nil {
+			return nil, err
+		}
+		dto.Watermarks = watermarks
+	}
 	return connect.NewResponse(&mgmtv1alpha1.GetJobRunResponse{
 		JobRun: dto,
 	}), nil
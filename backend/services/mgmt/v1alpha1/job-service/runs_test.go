@@ -112,6 +112,12 @@ func Test_GetJobRun(t *testing.T) {
 	mockIsUserInAccount(m.UserAccountServiceMock, true)
 	mockGetVerifiedJobRun(m.TemporalWfManagerMock, accountUuid, temporalClientMock, workflows)
 	temporalClientMock.On("DescribeWorkflowExecution", mock.Anything, workflowId, runId).Return(workflowExecutionMock, nil)
+	m.QuerierMock.On("GetJobRunWatermarks", mock.Anything, mock.Anything, db_queries.GetJobRunWatermarksParams{
+		JobID:    job.ID,
+		JobRunID: workflowId,
+	}).Return([]db_queries.NeosyncApiJobRunWatermark{
+		{JobID: job.ID, JobRunID: workflowId, SchemaName: "public", TableName: "users", ColumnName: "updated_at", StartValue: pgtype.Text{String: "2024-01-01", Valid: true}, EndValue: "2024-01-02"},
+	}, nil)
 
 	resp, err := m.Service.GetJobRun(context.Background(), &connect.Request[mgmtv1alpha1.GetJobRunRequest]{
 		Msg: &mgmtv1alpha1.GetJobRunRequest{
@@ -122,6 +128,10 @@ func Test_GetJobRun(t *testing.T) {
 
 	assert.NoError(t, err)
 	assert.NotNil(t, resp)
+	startValue := "2024-01-01"
+	assert.Equal(t, []*mgmtv1alpha1.JobRunWatermark{
+		{Schema: "public", Table: "users", Column: "updated_at", StartValue: &startValue, EndValue: "2024-01-02"},
+	}, resp.Msg.JobRun.Watermarks)
 }
 
 // GetJobRunEvents
@@ -0,0 +1,212 @@
+package v1alpha1_jobservice
+
+import (
+	"context"
+	"fmt"
+
+	"connectrpc.com/connect"
+	"github.com/jackc/pgx/v5/pgtype"
+	db_queries "github.com/nucleuscloud/neosync/backend/gen/go/db"
+	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
+	logger_interceptor "github.com/nucleuscloud/neosync/backend/internal/connect/interceptors/logger"
+	"github.com/nucleuscloud/neosync/backend/internal/dtomaps"
+	nucleuserrors "github.com/nucleuscloud/neosync/backend/internal/errors"
+	"github.com/nucleuscloud/neosync/backend/internal/nucleusdb"
+	pg_models "github.com/nucleuscloud/neosync/backend/sql/postgresql/models"
+)
+
+func (s *Service) SetJobRunSchemaDriftReport(
+	ctx context.Context,
+	req *connect.Request[mgmtv1alpha1.SetJobRunSchemaDriftReportRequest],
+) (*connect.Response[mgmtv1alpha1.SetJobRunSchemaDriftReportResponse], error) {
+	logger := logger_interceptor.GetLoggerFromContextOrDefault(ctx)
+	logger = logger.With("jobId", req.Msg.JobId, "jobRunId", req.Msg.JobRunId)
+
+	job, err := s.getVerifiedJob(ctx, req.Msg.JobId)
+	if err != nil {
+		return nil, err
+	}
+
+	report := &pg_models.JobRunSchemaDriftReport{}
+	if err := report.FromDto(req.Msg.Changes, req.Msg.Columns); err != nil {
+		return nil, err
+	}
+
+	logger.Info("setting job run schema drift report", "changes", len(req.Msg.Changes), "halted", req.Msg.Halted)
+	_, err = s.db.Q.SetJobRunSchemaDriftReport(ctx, s.db.Db, db_queries.SetJobRunSchemaDriftReportParams{
+		JobID:    job.ID,
+		JobRunID: req.Msg.JobRunId,
+		Halted:   req.Msg.Halted,
+		Report:   report,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&mgmtv1alpha1.SetJobRunSchemaDriftReportResponse{}), nil
+}
+
+func (s *Service) GetJobRunSchemaDriftReport(
+	ctx context.Context,
+	req *connect.Request[mgmtv1alpha1.GetJobRunSchemaDriftReportRequest],
+) (*connect.Response[mgmtv1alpha1.GetJobRunSchemaDriftReportResponse], error) {
+	job, err := s.getVerifiedJob(ctx, req.Msg.JobId)
+	if err != nil {
+		return nil, err
+	}
+
+	report, err := s.getJobRunSchemaDriftReport(ctx, job.ID, req.Msg.JobRunId)
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&mgmtv1alpha1.GetJobRunSchemaDriftReportResponse{
+		Report: dtomaps.ToJobRunSchemaDriftReportDto(report),
+	}), nil
+}
+
+func (s *Service) ApplyJobRunSchemaDriftReport(
+	ctx context.Context,
+	req *connect.Request[mgmtv1alpha1.ApplyJobRunSchemaDriftReportRequest],
+) (*connect.Response[mgmtv1alpha1.ApplyJobRunSchemaDriftReportResponse], error) {
+	logger := logger_interceptor.GetLoggerFromContextOrDefault(ctx)
+	logger = logger.With("jobId", req.Msg.JobId, "jobRunId", req.Msg.JobRunId)
+
+	job, err := s.getVerifiedJob(ctx, req.Msg.JobId)
+	if err != nil {
+		return nil, err
+	}
+	userUuid, err := s.getUserUuid(ctx)
+	if err != nil {
+		return nil, err
+	}
+	report, err := s.getJobRunSchemaDriftReport(ctx, job.ID, &req.Msg.JobRunId)
+	if err != nil {
+		return nil, err
+	}
+
+	changes := []*pg_models.SchemaDriftChange{}
+	if report.Report != nil {
+		changes = report.Report.Changes
+	}
+	mappings := applySchemaDriftChanges(job.Mappings, changes)
+
+	if err := s.db.WithTx(ctx, nil, func(dbtx nucleusdb.BaseDBTX) error {
+		_, err = s.db.Q.UpdateJobMappings(ctx, dbtx, db_queries.UpdateJobMappingsParams{
+			ID:          job.ID,
+			Mappings:    mappings,
+			UpdatedByID: *userUuid,
+		})
+		if err != nil {
+			return fmt.Errorf("unable to update job mappings: %w", err)
+		}
+		return s.recordJobVersion(ctx, logger, dbtx, nucleusdb.UUIDString(job.AccountID), job.ID, *userUuid)
+	}); err != nil {
+		return nil, err
+	}
+	logger.Info("applied schema drift report to job mappings", "changes", len(changes))
+
+	updatedJob, err := s.GetJob(ctx, connect.NewRequest(&mgmtv1alpha1.GetJobRequest{
+		Id: req.Msg.JobId,
+	}))
+	if err != nil {
+		logger.Error(fmt.Errorf("unable to retrieve job: %w", err).Error())
+		return nil, err
+	}
+	return connect.NewResponse(&mgmtv1alpha1.ApplyJobRunSchemaDriftReportResponse{
+		Job: updatedJob.Msg.Job,
+	}), nil
+}
+
+// Returns the report of the job run, or the latest report of the job if no run is provided
+func (s *Service) getJobRunSchemaDriftReport(
+	ctx context.Context,
+	jobUuid pgtype.UUID,
+	jobRunId *string,
+) (*db_queries.NeosyncApiJobRunSchemaDriftReport, error) {
+	var report db_queries.NeosyncApiJobRunSchemaDriftReport
+	var err error
+	if jobRunId != nil {
+		report, err = s.db.Q.GetJobRunSchemaDriftReport(ctx, s.db.Db, db_queries.GetJobRunSchemaDriftReportParams{
+			JobID:    jobUuid,
+			JobRunID: *jobRunId,
+		})
+	} else {
+		report, err = s.db.Q.GetLatestJobRunSchemaDriftReport(ctx, s.db.Db, jobUuid)
+	}
+	if err != nil && !nucleusdb.IsNoRows(err) {
+		return nil, err
+	} else if err != nil && nucleusdb.IsNoRows(err) {
+		return nil, nucleuserrors.NewNotFound("unable to find schema drift report for job run")
+	}
+	return &report, nil
+}
+
+// Returns the mappings with new columns mapped to their suggested transformer, renamed columns moved to their new name and removed columns unmapped.
+// Type changes are left as is
+func applySchemaDriftChanges(
+	mappings []*pg_models.JobMapping,
+	changes []*pg_models.SchemaDriftChange,
+) []*pg_models.JobMapping {
+	removed := map[string]bool{}
+	renamed := map[string]string{}
+	added := []*pg_models.JobMapping{}
+	mapped := map[string]bool{}
+	for _, mapping := range mappings {
+		mapped[getColumnKey(mapping.Schema, mapping.Table, mapping.Column)] = true
+	}
+
+	for _, change := range changes {
+		key := getColumnKey(change.Schema, change.Table, change.Column)
+		switch mgmtv1alpha1.SchemaDriftChangeType(change.Type) {
+		case mgmtv1alpha1.SchemaDriftChangeType_SCHEMA_DRIFT_CHANGE_TYPE_COLUMN_ADDED:
+			if mapped[key] {
+				continue
+			}
+			mapped[key] = true
+			transformer := change.SuggestedTransformer
+			if transformer == nil {
+				transformer = &pg_models.JobMappingTransformerModel{
+					Source: int32(mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_PASSTHROUGH),
+					Config: &pg_models.TransformerConfigs{Passthrough: &pg_models.PassthroughConfig{}},
+				}
+			}
+			added = append(added, &pg_models.JobMapping{
+				Schema:                change.Schema,
+				Table:                 change.Table,
+				Column:                change.Column,
+				JobMappingTransformer: transformer,
+			})
+		case mgmtv1alpha1.SchemaDriftChangeType_SCHEMA_DRIFT_CHANGE_TYPE_COLUMN_REMOVED:
+			removed[key] = true
+		case mgmtv1alpha1.SchemaDriftChangeType_SCHEMA_DRIFT_CHANGE_TYPE_COLUMN_RENAMED:
+			if change.RenamedFrom == nil || mapped[key] {
+				continue
+			}
+			mapped[key] = true
+			renamed[getColumnKey(change.Schema, change.Table, *change.RenamedFrom)] = change.Column
+		}
+	}
+
+	output := make([]*pg_models.JobMapping, 0, len(mappings)+len(added))
+	for _, mapping := range mappings {
+		key := getColumnKey(mapping.Schema, mapping.Table, mapping.Column)
+		if removed[key] {
+			continue
+		}
+		if column, ok := renamed[key]; ok {
+			output = append(output, &pg_models.JobMapping{
+				Schema:                mapping.Schema,
+				Table:                 mapping.Table,
+				Column:                column,
+				JobMappingTransformer: mapping.JobMappingTransformer,
+			})
+			continue
+		}
+		output = append(output, mapping)
+	}
+	return append(output, added...)
+}
+
+func getColumnKey(schema, table, column string) string {
+	return fmt.Sprintf("%s.%s.%s", schema, table, column)
+}
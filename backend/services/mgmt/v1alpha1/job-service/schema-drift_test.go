@@ -0,0 +1,266 @@
+package v1alpha1_jobservice
+
+import (
+	"context"
+	"testing"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	db_queries "github.com/nucleuscloud/neosync/backend/gen/go/db"
+	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
+	"github.com/nucleuscloud/neosync/backend/internal/nucleusdb"
+	pg_models "github.com/nucleuscloud/neosync/backend/sql/postgresql/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// SetJobRunSchemaDriftReport
+func Test_SetJobRunSchemaDriftReport(t *testing.T) {
+	m := createServiceMock(t, &Config{IsAuthEnabled: true})
+	job := mockJob(mockAccountId, mockUserId, uuid.NewString(), pgtype.Text{})
+	dataType := "text"
+
+	mockIsUserInAccount(m.UserAccountServiceMock, true)
+	m.QuerierMock.On("GetJobById", mock.Anything, mock.Anything, job.ID).Return(job, nil)
+	m.QuerierMock.On("SetJobRunSchemaDriftReport", mock.Anything, mock.Anything, db_queries.SetJobRunSchemaDriftReportParams{
+		JobID:    job.ID,
+		JobRunID: "run-1",
+		Halted:   true,
+		Report: &pg_models.JobRunSchemaDriftReport{
+			Changes: []*pg_models.SchemaDriftChange{
+				{
+					Schema: "public", Table: "users", Column: "email", DataType: &dataType,
+					Type:   int32(mgmtv1alpha1.SchemaDriftChangeType_SCHEMA_DRIFT_CHANGE_TYPE_COLUMN_ADDED),
+					Action: int32(mgmtv1alpha1.SchemaDriftAction_SCHEMA_DRIFT_ACTION_HALTED),
+					SuggestedTransformer: &pg_models.JobMappingTransformerModel{
+						Source: int32(mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_GENERATE_NULL),
+						Config: &pg_models.TransformerConfigs{Null: &pg_models.NullConfig{}},
+					},
+				},
+			},
+			Columns: []*pg_models.SchemaDriftColumn{
+				{Schema: "public", Table: "users", Column: "email", DataType: "text"},
+			},
+		},
+	}).Return(db_queries.NeosyncApiJobRunSchemaDriftReport{}, nil)
+
+	resp, err := m.Service.SetJobRunSchemaDriftReport(context.Background(), connect.NewRequest(&mgmtv1alpha1.SetJobRunSchemaDriftReportRequest{
+		JobId:    nucleusdb.UUIDString(job.ID),
+		JobRunId: "run-1",
+		Halted:   true,
+		Changes: []*mgmtv1alpha1.SchemaDriftChange{
+			{
+				Schema: "public", Table: "users", Column: "email", DataType: &dataType,
+				Type:   mgmtv1alpha1.SchemaDriftChangeType_SCHEMA_DRIFT_CHANGE_TYPE_COLUMN_ADDED,
+				Action: mgmtv1alpha1.SchemaDriftAction_SCHEMA_DRIFT_ACTION_HALTED,
+				SuggestedTransformer: &mgmtv1alpha1.JobMappingTransformer{
+					Source: mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_GENERATE_NULL,
+					Config: &mgmtv1alpha1.TransformerConfig{Config: &mgmtv1alpha1.TransformerConfig_Nullconfig{Nullconfig: &mgmtv1alpha1.Null{}}},
+				},
+			},
+		},
+		Columns: []*mgmtv1alpha1.SchemaDriftColumn{
+			{Schema: "public", Table: "users", Column: "email", DataType: "text"},
+		},
+	}))
+
+	assert.NoError(t, err)
+	assert.NotNil(t, resp)
+}
+
+// GetJobRunSchemaDriftReport
+func Test_GetJobRunSchemaDriftReport(t *testing.T) {
+	m := createServiceMock(t, &Config{IsAuthEnabled: true})
+	job := mockJob(mockAccountId, mockUserId, uuid.NewString(), pgtype.Text{})
+	runId := "run-1"
+
+	mockIsUserInAccount(m.UserAccountServiceMock, true)
+	m.QuerierMock.On("GetJobById", mock.Anything, mock.Anything, job.ID).Return(job, nil)
+	m.QuerierMock.On("GetJobRunSchemaDriftReport", mock.Anything, mock.Anything, db_queries.GetJobRunSchemaDriftReportParams{
+		JobID:    job.ID,
+		JobRunID: runId,
+	}).Return(db_queries.NeosyncApiJobRunSchemaDriftReport{
+		JobID:    job.ID,
+		JobRunID: runId,
+		Report: &pg_models.JobRunSchemaDriftReport{
+			Changes: []*pg_models.SchemaDriftChange{
+				{
+					Schema: "public", Table: "users", Column: "name",
+					Type:   int32(mgmtv1alpha1.SchemaDriftChangeType_SCHEMA_DRIFT_CHANGE_TYPE_COLUMN_REMOVED),
+					Action: int32(mgmtv1alpha1.SchemaDriftAction_SCHEMA_DRIFT_ACTION_AUTO_MAPPED),
+				},
+			},
+		},
+	}, nil)
+
+	resp, err := m.Service.GetJobRunSchemaDriftReport(context.Background(), connect.NewRequest(&mgmtv1alpha1.GetJobRunSchemaDriftReportRequest{
+		JobId:    nucleusdb.UUIDString(job.ID),
+		JobRunId: &runId,
+	}))
+
+	assert.NoError(t, err)
+	assert.False(t, resp.Msg.Report.Halted)
+	assert.Equal(t, runId, resp.Msg.Report.JobRunId)
+	assert.Len(t, resp.Msg.Report.Changes, 1)
+	assert.Equal(t, mgmtv1alpha1.SchemaDriftChangeType_SCHEMA_DRIFT_CHANGE_TYPE_COLUMN_REMOVED, resp.Msg.Report.Changes[0].Type)
+	assert.Empty(t, resp.Msg.Report.Columns)
+}
+
+func Test_GetJobRunSchemaDriftReport_Latest(t *testing.T) {
+	m := createServiceMock(t, &Config{IsAuthEnabled: true})
+	job := mockJob(mockAccountId, mockUserId, uuid.NewString(), pgtype.Text{})
+
+	mockIsUserInAccount(m.UserAccountServiceMock, true)
+	m.QuerierMock.On("GetJobById", mock.Anything, mock.Anything, job.ID).Return(job, nil)
+	m.QuerierMock.On("GetLatestJobRunSchemaDriftReport", mock.Anything, mock.Anything, job.ID).Return(db_queries.NeosyncApiJobRunSchemaDriftReport{
+		JobID:    job.ID,
+		JobRunID: "run-2",
+		Report: &pg_models.JobRunSchemaDriftReport{
+			Columns: []*pg_models.SchemaDriftColumn{
+				{Schema: "public", Table: "users", Column: "id", DataType: "integer"},
+			},
+		},
+	}, nil)
+
+	resp, err := m.Service.GetJobRunSchemaDriftReport(context.Background(), connect.NewRequest(&mgmtv1alpha1.GetJobRunSchemaDriftReportRequest{
+		JobId: nucleusdb.UUIDString(job.ID),
+	}))
+
+	assert.NoError(t, err)
+	assert.Equal(t, "run-2", resp.Msg.Report.JobRunId)
+	assert.Len(t, resp.Msg.Report.Columns, 1)
+	m.QuerierMock.AssertNotCalled(t, "GetJobRunSchemaDriftReport", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func Test_GetJobRunSchemaDriftReport_NotFound(t *testing.T) {
+	m := createServiceMock(t, &Config{IsAuthEnabled: true})
+	job := mockJob(mockAccountId, mockUserId, uuid.NewString(), pgtype.Text{})
+
+	mockIsUserInAccount(m.UserAccountServiceMock, true)
+	m.QuerierMock.On("GetJobById", mock.Anything, mock.Anything, job.ID).Return(job, nil)
+	m.QuerierMock.On("GetLatestJobRunSchemaDriftReport", mock.Anything, mock.Anything, job.ID).Return(db_queries.NeosyncApiJobRunSchemaDriftReport{}, pgx.ErrNoRows)
+
+	resp, err := m.Service.GetJobRunSchemaDriftReport(context.Background(), connect.NewRequest(&mgmtv1alpha1.GetJobRunSchemaDriftReportRequest{
+		JobId: nucleusdb.UUIDString(job.ID),
+	}))
+
+	assert.Error(t, err)
+	assert.Nil(t, resp)
+}
+
+// ApplyJobRunSchemaDriftReport
+func Test_ApplyJobRunSchemaDriftReport(t *testing.T) {
+	m := createServiceMock(t, &Config{IsAuthEnabled: true})
+	mockTx := new(nucleusdb.MockTx)
+	job := mockJob(mockAccountId, mockUserId, uuid.NewString(), pgtype.Text{})
+	userUuid, _ := nucleusdb.ToUuid(mockUserId)
+	passthrough := &pg_models.JobMappingTransformerModel{
+		Source: int32(mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_PASSTHROUGH),
+		Config: &pg_models.TransformerConfigs{Passthrough: &pg_models.PassthroughConfig{}},
+	}
+	nullTransformer := &pg_models.JobMappingTransformerModel{
+		Source: int32(mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_GENERATE_NULL),
+		Config: &pg_models.TransformerConfigs{Null: &pg_models.NullConfig{}},
+	}
+	job.Mappings = []*pg_models.JobMapping{
+		{Schema: "public", Table: "users", Column: "id", JobMappingTransformer: passthrough},
+		{Schema: "public", Table: "users", Column: "name", JobMappingTransformer: passthrough},
+	}
+
+	mockUserAccountCalls(m.UserAccountServiceMock, true)
+	mockDbTransaction(m.DbtxMock, mockTx)
+	mockGetJob(m.UserAccountServiceMock, m.QuerierMock, job, []db_queries.NeosyncApiJobDestinationConnectionAssociation{})
+	mockRecordJobVersion(m, job)
+	m.QuerierMock.On("GetJobRunSchemaDriftReport", mock.Anything, mock.Anything, db_queries.GetJobRunSchemaDriftReportParams{
+		JobID:    job.ID,
+		JobRunID: "run-1",
+	}).Return(db_queries.NeosyncApiJobRunSchemaDriftReport{
+		JobID:    job.ID,
+		JobRunID: "run-1",
+		Halted:   true,
+		Report: &pg_models.JobRunSchemaDriftReport{
+			Changes: []*pg_models.SchemaDriftChange{
+				{
+					Schema: "public", Table: "users", Column: "email",
+					Type:                 int32(mgmtv1alpha1.SchemaDriftChangeType_SCHEMA_DRIFT_CHANGE_TYPE_COLUMN_ADDED),
+					SuggestedTransformer: nullTransformer,
+				},
+			},
+		},
+	}, nil)
+	m.QuerierMock.On("UpdateJobMappings", mock.Anything, mockTx, db_queries.UpdateJobMappingsParams{
+		ID: job.ID,
+		Mappings: []*pg_models.JobMapping{
+			{Schema: "public", Table: "users", Column: "id", JobMappingTransformer: passthrough},
+			{Schema: "public", Table: "users", Column: "name", JobMappingTransformer: passthrough},
+			{Schema: "public", Table: "users", Column: "email", JobMappingTransformer: nullTransformer},
+		},
+		UpdatedByID: userUuid,
+	}).Return(job, nil)
+
+	resp, err := m.Service.ApplyJobRunSchemaDriftReport(context.Background(), connect.NewRequest(&mgmtv1alpha1.ApplyJobRunSchemaDriftReportRequest{
+		JobId:    nucleusdb.UUIDString(job.ID),
+		JobRunId: "run-1",
+	}))
+
+	assert.NoError(t, err)
+	assert.NotNil(t, resp.Msg.Job)
+}
+
+func Test_ApplyJobRunSchemaDriftReport_NotFound(t *testing.T) {
+	m := createServiceMock(t, &Config{IsAuthEnabled: true})
+	job := mockJob(mockAccountId, mockUserId, uuid.NewString(), pgtype.Text{})
+
+	mockUserAccountCalls(m.UserAccountServiceMock, true)
+	m.QuerierMock.On("GetJobById", mock.Anything, mock.Anything, job.ID).Return(job, nil)
+	m.QuerierMock.On("GetJobRunSchemaDriftReport", mock.Anything, mock.Anything, mock.Anything).Return(db_queries.NeosyncApiJobRunSchemaDriftReport{}, pgx.ErrNoRows)
+
+	resp, err := m.Service.ApplyJobRunSchemaDriftReport(context.Background(), connect.NewRequest(&mgmtv1alpha1.ApplyJobRunSchemaDriftReportRequest{
+		JobId:    nucleusdb.UUIDString(job.ID),
+		JobRunId: "run-1",
+	}))
+
+	assert.Error(t, err)
+	assert.Nil(t, resp)
+	m.QuerierMock.AssertNotCalled(t, "UpdateJobMappings", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func Test_applySchemaDriftChanges(t *testing.T) {
+	passthrough := &pg_models.JobMappingTransformerModel{Source: int32(mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_PASSTHROUGH)}
+	email := &pg_models.JobMappingTransformerModel{Source: int32(mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_TRANSFORM_EMAIL)}
+	defaultTransformer := &pg_models.JobMappingTransformerModel{Source: int32(mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_GENERATE_DEFAULT)}
+	mail := "mail"
+
+	mappings := []*pg_models.JobMapping{
+		{Schema: "public", Table: "users", Column: "id", JobMappingTransformer: passthrough},
+		{Schema: "public", Table: "users", Column: "mail", JobMappingTransformer: email},
+		{Schema: "public", Table: "users", Column: "age", JobMappingTransformer: passthrough},
+		{Schema: "public", Table: "users", Column: "name", JobMappingTransformer: passthrough},
+	}
+	changes := []*pg_models.SchemaDriftChange{
+		{Schema: "public", Table: "users", Column: "email", RenamedFrom: &mail, Type: int32(mgmtv1alpha1.SchemaDriftChangeType_SCHEMA_DRIFT_CHANGE_TYPE_COLUMN_RENAMED)},
+		{Schema: "public", Table: "users", Column: "age", Type: int32(mgmtv1alpha1.SchemaDriftChangeType_SCHEMA_DRIFT_CHANGE_TYPE_COLUMN_REMOVED)},
+		{Schema: "public", Table: "users", Column: "name", Type: int32(mgmtv1alpha1.SchemaDriftChangeType_SCHEMA_DRIFT_CHANGE_TYPE_COLUMN_TYPE_CHANGED)},
+		{Schema: "public", Table: "users", Column: "created_at", Type: int32(mgmtv1alpha1.SchemaDriftChangeType_SCHEMA_DRIFT_CHANGE_TYPE_COLUMN_ADDED), SuggestedTransformer: defaultTransformer},
+		{Schema: "public", Table: "users", Column: "id", Type: int32(mgmtv1alpha1.SchemaDriftChangeType_SCHEMA_DRIFT_CHANGE_TYPE_COLUMN_ADDED), SuggestedTransformer: defaultTransformer},
+	}
+
+	actual := applySchemaDriftChanges(mappings, changes)
+	assert.Equal(t, []*pg_models.JobMapping{
+		{Schema: "public", Table: "users", Column: "id", JobMappingTransformer: passthrough},
+		{Schema: "public", Table: "users", Column: "email", JobMappingTransformer: email},
+		{Schema: "public", Table: "users", Column: "name", JobMappingTransformer: passthrough},
+		{Schema: "public", Table: "users", Column: "created_at", JobMappingTransformer: defaultTransformer},
+	}, actual)
+}
+
+func Test_applySchemaDriftChanges_DefaultTransformer(t *testing.T) {
+	actual := applySchemaDriftChanges([]*pg_models.JobMapping{}, []*pg_models.SchemaDriftChange{
+		{Schema: "public", Table: "users", Column: "email", Type: int32(mgmtv1alpha1.SchemaDriftChangeType_SCHEMA_DRIFT_CHANGE_TYPE_COLUMN_ADDED)},
+	})
+	assert.Len(t, actual, 1)
+	assert.Equal(t, int32(mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_PASSTHROUGH), actual[0].JobMappingTransformer.Source)
+	assert.NotNil(t, actual[0].JobMappingTransformer.Config.Passthrough)
+}
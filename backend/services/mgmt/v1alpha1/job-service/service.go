@@ -4,6 +4,7 @@ import (
 	"github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1/mgmtv1alpha1connect"
 	"github.com/nucleuscloud/neosync/backend/internal/nucleusdb"
 	clientmanager "github.com/nucleuscloud/neosync/backend/internal/temporal/client-manager"
+	"github.com/nucleuscloud/neosync/backend/pkg/sqlconnect"
 )
 
 type Service struct {
@@ -11,6 +12,7 @@ type Service struct {
 	db                 *nucleusdb.NucleusDb
 	connectionService  mgmtv1alpha1connect.ConnectionServiceClient
 	useraccountService mgmtv1alpha1connect.UserAccountServiceClient
+	sqlConnector       sqlconnect.SqlConnector
 
 	temporalWfManager clientmanager.TemporalClientManagerClient
 }
@@ -28,6 +30,7 @@ func New(
 	temporalWfManager clientmanager.TemporalClientManagerClient,
 	connectionService mgmtv1alpha1connect.ConnectionServiceClient,
 	useraccountService mgmtv1alpha1connect.UserAccountServiceClient,
+	sqlConnector sqlconnect.SqlConnector,
 ) *Service {
 	return &Service{
 		cfg:                cfg,
@@ -35,5 +38,6 @@ func New(
 		temporalWfManager:  temporalWfManager,
 		connectionService:  connectionService,
 		useraccountService: useraccountService,
+		sqlConnector:       sqlConnector,
 	}
 }
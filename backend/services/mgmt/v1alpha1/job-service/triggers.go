@@ -0,0 +1,204 @@
+package v1alpha1_jobservice
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"connectrpc.com/connect"
+	"github.com/jackc/pgx/v5/pgtype"
+	db_queries "github.com/nucleuscloud/neosync/backend/gen/go/db"
+	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
+	logger_interceptor "github.com/nucleuscloud/neosync/backend/internal/connect/interceptors/logger"
+	nucleuserrors "github.com/nucleuscloud/neosync/backend/internal/errors"
+	"github.com/nucleuscloud/neosync/backend/internal/nucleusdb"
+	pg_models "github.com/nucleuscloud/neosync/backend/sql/postgresql/models"
+	temporalclient "go.temporal.io/sdk/client"
+)
+
+func (s *Service) SetJobTriggers(
+	ctx context.Context,
+	req *connect.Request[mgmtv1alpha1.SetJobTriggersRequest],
+) (*connect.Response[mgmtv1alpha1.SetJobTriggersResponse], error) {
+	logger := logger_interceptor.GetLoggerFromContextOrDefault(ctx)
+	logger = logger.With("jobId", req.Msg.Id)
+
+	job, err := s.getVerifiedJob(ctx, req.Msg.Id)
+	if err != nil {
+		return nil, err
+	}
+	userUuid, err := s.getUserUuid(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	triggers := toJobTriggers(req.Msg.Triggers)
+	if err := s.verifyJobTriggers(ctx, job.AccountID, req.Msg.Id, triggers); err != nil {
+		return nil, err
+	}
+
+	_, err = s.db.Q.SetJobTriggers(ctx, s.db.Db, db_queries.SetJobTriggersParams{
+		ID:          job.ID,
+		Triggers:    triggers,
+		UpdatedByID: *userUuid,
+	})
+	if err != nil {
+		return nil, err
+	}
+	logger.Info("updated job triggers", "triggers", len(triggers))
+
+	updatedJob, err := s.GetJob(ctx, connect.NewRequest(&mgmtv1alpha1.GetJobRequest{
+		Id: req.Msg.Id,
+	}))
+	if err != nil {
+		logger.Error(fmt.Errorf("unable to retrieve job: %w", err).Error())
+		return nil, err
+	}
+	return connect.NewResponse(&mgmtv1alpha1.SetJobTriggersResponse{Job: updatedJob.Msg.Job}), nil
+}
+
+func (s *Service) TriggerDependentJobs(
+	ctx context.Context,
+	req *connect.Request[mgmtv1alpha1.TriggerDependentJobsRequest],
+) (*connect.Response[mgmtv1alpha1.TriggerDependentJobsResponse], error) {
+	logger := logger_interceptor.GetLoggerFromContextOrDefault(ctx)
+	logger = logger.With("jobId", req.Msg.JobId, "jobRunId", req.Msg.JobRunId)
+
+	job, err := s.getVerifiedJob(ctx, req.Msg.JobId)
+	if err != nil {
+		return nil, err
+	}
+	accountId := nucleusdb.UUIDString(job.AccountID)
+
+	dependents, err := s.db.Q.GetJobsTriggeredByJob(ctx, s.db.Db, db_queries.GetJobsTriggeredByJobParams{
+		AccountId: job.AccountID,
+		JobId:     req.Msg.JobId,
+	})
+	if err != nil && !nucleusdb.IsNoRows(err) {
+		return nil, err
+	}
+
+	triggeredJobIds := []string{}
+	for idx := range dependents {
+		dependent := dependents[idx]
+		if !shouldTriggerJob(dependent.Triggers, req.Msg.JobId, req.Msg.Succeeded) {
+			continue
+		}
+		dependentId := nucleusdb.UUIDString(dependent.ID)
+		scheduleHandle, err := s.temporalWfManager.GetScheduleHandleClientByAccount(ctx, accountId, dependentId, logger)
+		if err != nil {
+			// a dependent job that can't be started should not prevent the others from starting
+			logger.Error(fmt.Errorf("unable to retrieve schedule handle for dependent job %s: %w", dependentId, err).Error())
+			continue
+		}
+		err = scheduleHandle.Trigger(ctx, temporalclient.ScheduleTriggerOptions{})
+		if err != nil {
+			logger.Error(fmt.Errorf("unable to trigger dependent job %s: %w", dependentId, err).Error())
+			continue
+		}
+		logger.Info("triggered dependent job", "dependentJobId", dependentId)
+		triggeredJobIds = append(triggeredJobIds, dependentId)
+	}
+
+	return connect.NewResponse(&mgmtv1alpha1.TriggerDependentJobsResponse{
+		JobIds: triggeredJobIds,
+	}), nil
+}
+
+// Verifies that every upstream job is a different job in the same account and that the triggers do not form a cycle.
+// The job id is empty when the job is being created.
+func (s *Service) verifyJobTriggers(
+	ctx context.Context,
+	accountUuid pgtype.UUID,
+	jobId string,
+	triggers []*pg_models.JobTrigger,
+) error {
+	if len(triggers) == 0 {
+		return nil
+	}
+	seen := map[string]struct{}{}
+	for _, trigger := range triggers {
+		if trigger.JobId == jobId {
+			return nucleuserrors.NewBadRequest("a job can not be triggered by itself")
+		}
+		if _, ok := seen[trigger.JobId]; ok {
+			return nucleuserrors.NewBadRequest(fmt.Sprintf("job %s is listed in more than one trigger", trigger.JobId))
+		}
+		seen[trigger.JobId] = struct{}{}
+	}
+
+	jobs, err := s.db.Q.GetJobsByAccount(ctx, s.db.Db, accountUuid)
+	if err != nil && !nucleusdb.IsNoRows(err) {
+		return err
+	}
+	jobTriggers := map[string][]*pg_models.JobTrigger{}
+	for idx := range jobs {
+		jobTriggers[nucleusdb.UUIDString(jobs[idx].ID)] = jobs[idx].Triggers
+	}
+	for _, trigger := range triggers {
+		if _, ok := jobTriggers[trigger.JobId]; !ok {
+			return nucleuserrors.NewBadRequest(fmt.Sprintf("triggering job %s was not found in the account", trigger.JobId))
+		}
+	}
+
+	if jobId == "" {
+		// a new job has no dependents yet so it can't be part of a cycle
+		return nil
+	}
+	jobTriggers[jobId] = triggers
+	if cycle := findJobTriggerCycle(jobId, jobTriggers); len(cycle) > 0 {
+		return nucleuserrors.NewBadRequest(fmt.Sprintf("job triggers may not form a cycle: %s", strings.Join(cycle, " -> ")))
+	}
+	return nil
+}
+
+// Walks the upstream jobs of the given job and returns the path back to the job if one exists.
+// The path starts and ends with the given job, ex: [a, b, a] when a is triggered by b and b is triggered by a
+func findJobTriggerCycle(jobId string, jobTriggers map[string][]*pg_models.JobTrigger) []string {
+	visited := map[string]bool{}
+	var walk func(current string, path []string) []string
+	walk = func(current string, path []string) []string {
+		for _, trigger := range jobTriggers[current] {
+			if trigger.JobId == jobId {
+				return append(path, trigger.JobId)
+			}
+			if visited[trigger.JobId] {
+				continue
+			}
+			visited[trigger.JobId] = true
+			if cycle := walk(trigger.JobId, append(path, trigger.JobId)); len(cycle) > 0 {
+				return cycle
+			}
+		}
+		return nil
+	}
+	return walk(jobId, []string{jobId})
+}
+
+// Returns true if the dependent job has a trigger on the upstream job that matches the outcome of the upstream run
+func shouldTriggerJob(triggers []*pg_models.JobTrigger, upstreamJobId string, succeeded bool) bool {
+	for _, trigger := range triggers {
+		if trigger.JobId != upstreamJobId {
+			continue
+		}
+		switch mgmtv1alpha1.JobTriggerCondition(trigger.Condition) {
+		case mgmtv1alpha1.JobTriggerCondition_JOB_TRIGGER_CONDITION_COMPLETED:
+			return true
+		case mgmtv1alpha1.JobTriggerCondition_JOB_TRIGGER_CONDITION_SUCCEEDED:
+			return succeeded
+		case mgmtv1alpha1.JobTriggerCondition_JOB_TRIGGER_CONDITION_FAILED:
+			return !succeeded
+		}
+	}
+	return false
+}
+
+func toJobTriggers(dtos []*mgmtv1alpha1.JobTrigger) []*pg_models.JobTrigger {
+	triggers := make([]*pg_models.JobTrigger, 0, len(dtos))
+	for _, dto := range dtos {
+		trigger := &pg_models.JobTrigger{}
+		trigger.FromDto(dto)
+		triggers = append(triggers, trigger)
+	}
+	return triggers
+}
@@ -0,0 +1,186 @@
+package v1alpha1_jobservice
+
+import (
+	"context"
+	"testing"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	db_queries "github.com/nucleuscloud/neosync/backend/gen/go/db"
+	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
+	"github.com/nucleuscloud/neosync/backend/internal/nucleusdb"
+	pg_models "github.com/nucleuscloud/neosync/backend/sql/postgresql/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	temporalclient "go.temporal.io/sdk/client"
+)
+
+// SetJobTriggers
+func Test_SetJobTriggers(t *testing.T) {
+	m := createServiceMock(t, &Config{IsAuthEnabled: true})
+	job := mockJob(mockAccountId, mockUserId, uuid.NewString(), pgtype.Text{})
+	upstream := mockJob(mockAccountId, mockUserId, uuid.NewString(), pgtype.Text{})
+	upstreamId := nucleusdb.UUIDString(upstream.ID)
+	userUuid, _ := nucleusdb.ToUuid(mockUserId)
+	triggers := []*pg_models.JobTrigger{{JobId: upstreamId, Condition: int32(mgmtv1alpha1.JobTriggerCondition_JOB_TRIGGER_CONDITION_SUCCEEDED)}}
+	updatedJob := job
+	updatedJob.Triggers = triggers
+
+	mockUserAccountCalls(m.UserAccountServiceMock, true)
+	m.QuerierMock.On("GetJobById", mock.Anything, mock.Anything, job.ID).Return(job, nil).Once()
+	m.QuerierMock.On("GetJobsByAccount", mock.Anything, mock.Anything, job.AccountID).Return([]db_queries.NeosyncApiJob{job, upstream}, nil)
+	m.QuerierMock.On("SetJobTriggers", mock.Anything, mock.Anything, db_queries.SetJobTriggersParams{
+		ID:          job.ID,
+		Triggers:    triggers,
+		UpdatedByID: userUuid,
+	}).Return(updatedJob, nil)
+	m.QuerierMock.On("GetJobById", mock.Anything, mock.Anything, job.ID).Return(updatedJob, nil)
+	m.QuerierMock.On("GetJobConnectionDestinations", mock.Anything, mock.Anything, job.ID).Return([]db_queries.NeosyncApiJobDestinationConnectionAssociation{}, nil)
+
+	resp, err := m.Service.SetJobTriggers(context.Background(), connect.NewRequest(&mgmtv1alpha1.SetJobTriggersRequest{
+		Id: nucleusdb.UUIDString(job.ID),
+		Triggers: []*mgmtv1alpha1.JobTrigger{
+			{JobId: upstreamId, Condition: mgmtv1alpha1.JobTriggerCondition_JOB_TRIGGER_CONDITION_SUCCEEDED},
+		},
+	}))
+
+	assert.NoError(t, err)
+	assert.Len(t, resp.Msg.Job.Triggers, 1)
+	assert.Equal(t, upstreamId, resp.Msg.Job.Triggers[0].JobId)
+	assert.Equal(t, mgmtv1alpha1.JobTriggerCondition_JOB_TRIGGER_CONDITION_SUCCEEDED, resp.Msg.Job.Triggers[0].Condition)
+}
+
+func Test_SetJobTriggers_Cycle(t *testing.T) {
+	m := createServiceMock(t, &Config{IsAuthEnabled: true})
+	job := mockJob(mockAccountId, mockUserId, uuid.NewString(), pgtype.Text{})
+	upstream := mockJob(mockAccountId, mockUserId, uuid.NewString(), pgtype.Text{})
+	// the upstream job is already triggered by the job
+	upstream.Triggers = []*pg_models.JobTrigger{{JobId: nucleusdb.UUIDString(job.ID), Condition: int32(mgmtv1alpha1.JobTriggerCondition_JOB_TRIGGER_CONDITION_COMPLETED)}}
+
+	mockUserAccountCalls(m.UserAccountServiceMock, true)
+	m.QuerierMock.On("GetJobById", mock.Anything, mock.Anything, job.ID).Return(job, nil)
+	m.QuerierMock.On("GetJobsByAccount", mock.Anything, mock.Anything, job.AccountID).Return([]db_queries.NeosyncApiJob{job, upstream}, nil)
+
+	resp, err := m.Service.SetJobTriggers(context.Background(), connect.NewRequest(&mgmtv1alpha1.SetJobTriggersRequest{
+		Id: nucleusdb.UUIDString(job.ID),
+		Triggers: []*mgmtv1alpha1.JobTrigger{
+			{JobId: nucleusdb.UUIDString(upstream.ID), Condition: mgmtv1alpha1.JobTriggerCondition_JOB_TRIGGER_CONDITION_SUCCEEDED},
+		},
+	}))
+
+	assert.Error(t, err)
+	assert.Nil(t, resp)
+	m.QuerierMock.AssertNotCalled(t, "SetJobTriggers", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func Test_SetJobTriggers_UnknownJob(t *testing.T) {
+	m := createServiceMock(t, &Config{IsAuthEnabled: true})
+	job := mockJob(mockAccountId, mockUserId, uuid.NewString(), pgtype.Text{})
+
+	mockUserAccountCalls(m.UserAccountServiceMock, true)
+	m.QuerierMock.On("GetJobById", mock.Anything, mock.Anything, job.ID).Return(job, nil)
+	m.QuerierMock.On("GetJobsByAccount", mock.Anything, mock.Anything, job.AccountID).Return([]db_queries.NeosyncApiJob{job}, nil)
+
+	resp, err := m.Service.SetJobTriggers(context.Background(), connect.NewRequest(&mgmtv1alpha1.SetJobTriggersRequest{
+		Id: nucleusdb.UUIDString(job.ID),
+		Triggers: []*mgmtv1alpha1.JobTrigger{
+			{JobId: uuid.NewString(), Condition: mgmtv1alpha1.JobTriggerCondition_JOB_TRIGGER_CONDITION_SUCCEEDED},
+		},
+	}))
+
+	assert.Error(t, err)
+	assert.Nil(t, resp)
+}
+
+func Test_SetJobTriggers_Self(t *testing.T) {
+	m := createServiceMock(t, &Config{IsAuthEnabled: true})
+	job := mockJob(mockAccountId, mockUserId, uuid.NewString(), pgtype.Text{})
+
+	mockUserAccountCalls(m.UserAccountServiceMock, true)
+	m.QuerierMock.On("GetJobById", mock.Anything, mock.Anything, job.ID).Return(job, nil)
+
+	resp, err := m.Service.SetJobTriggers(context.Background(), connect.NewRequest(&mgmtv1alpha1.SetJobTriggersRequest{
+		Id: nucleusdb.UUIDString(job.ID),
+		Triggers: []*mgmtv1alpha1.JobTrigger{
+			{JobId: nucleusdb.UUIDString(job.ID), Condition: mgmtv1alpha1.JobTriggerCondition_JOB_TRIGGER_CONDITION_SUCCEEDED},
+		},
+	}))
+
+	assert.Error(t, err)
+	assert.Nil(t, resp)
+	m.QuerierMock.AssertNotCalled(t, "GetJobsByAccount", mock.Anything, mock.Anything, mock.Anything)
+}
+
+// TriggerDependentJobs
+func Test_TriggerDependentJobs(t *testing.T) {
+	m := createServiceMock(t, &Config{IsAuthEnabled: true})
+	mockHandle := new(MockScheduleHandle)
+	job := mockJob(mockAccountId, mockUserId, uuid.NewString(), pgtype.Text{})
+	jobId := nucleusdb.UUIDString(job.ID)
+	onSuccess := mockJob(mockAccountId, mockUserId, uuid.NewString(), pgtype.Text{})
+	onSuccess.Triggers = []*pg_models.JobTrigger{{JobId: jobId, Condition: int32(mgmtv1alpha1.JobTriggerCondition_JOB_TRIGGER_CONDITION_SUCCEEDED)}}
+	onFailure := mockJob(mockAccountId, mockUserId, uuid.NewString(), pgtype.Text{})
+	onFailure.Triggers = []*pg_models.JobTrigger{{JobId: jobId, Condition: int32(mgmtv1alpha1.JobTriggerCondition_JOB_TRIGGER_CONDITION_FAILED)}}
+
+	mockIsUserInAccount(m.UserAccountServiceMock, true)
+	m.QuerierMock.On("GetJobById", mock.Anything, mock.Anything, job.ID).Return(job, nil)
+	m.QuerierMock.On("GetJobsTriggeredByJob", mock.Anything, mock.Anything, db_queries.GetJobsTriggeredByJobParams{
+		AccountId: job.AccountID,
+		JobId:     jobId,
+	}).Return([]db_queries.NeosyncApiJob{onSuccess, onFailure}, nil)
+	m.TemporalWfManagerMock.On("GetScheduleHandleClientByAccount", mock.Anything, mockAccountId, nucleusdb.UUIDString(onSuccess.ID), mock.Anything).Return(mockHandle, nil)
+	mockHandle.On("Trigger", mock.Anything, temporalclient.ScheduleTriggerOptions{}).Return(nil)
+
+	resp, err := m.Service.TriggerDependentJobs(context.Background(), connect.NewRequest(&mgmtv1alpha1.TriggerDependentJobsRequest{
+		JobId:     jobId,
+		JobRunId:  "run-1",
+		Succeeded: true,
+	}))
+
+	assert.NoError(t, err)
+	assert.Equal(t, []string{nucleusdb.UUIDString(onSuccess.ID)}, resp.Msg.JobIds)
+	mockHandle.AssertNumberOfCalls(t, "Trigger", 1)
+}
+
+func Test_findJobTriggerCycle(t *testing.T) {
+	trigger := func(jobId string) []*pg_models.JobTrigger {
+		return []*pg_models.JobTrigger{{JobId: jobId, Condition: int32(mgmtv1alpha1.JobTriggerCondition_JOB_TRIGGER_CONDITION_COMPLETED)}}
+	}
+
+	assert.Empty(t, findJobTriggerCycle("a", map[string][]*pg_models.JobTrigger{
+		"a": trigger("b"),
+		"b": trigger("c"),
+		"c": nil,
+	}))
+	assert.Equal(t, []string{"a", "b", "c", "a"}, findJobTriggerCycle("a", map[string][]*pg_models.JobTrigger{
+		"a": trigger("b"),
+		"b": trigger("c"),
+		"c": trigger("a"),
+	}))
+	// cycles that don't include the job were already rejected when they were created
+	assert.Empty(t, findJobTriggerCycle("a", map[string][]*pg_models.JobTrigger{
+		"a": trigger("b"),
+		"b": trigger("c"),
+		"c": trigger("b"),
+	}))
+	assert.Equal(t, []string{"a", "c", "a"}, findJobTriggerCycle("a", map[string][]*pg_models.JobTrigger{
+		"a": append(trigger("b"), trigger("c")...),
+		"b": nil,
+		"c": trigger("a"),
+	}))
+}
+
+func Test_shouldTriggerJob(t *testing.T) {
+	triggers := func(condition mgmtv1alpha1.JobTriggerCondition) []*pg_models.JobTrigger {
+		return []*pg_models.JobTrigger{{JobId: "a", Condition: int32(condition)}}
+	}
+
+	assert.True(t, shouldTriggerJob(triggers(mgmtv1alpha1.JobTriggerCondition_JOB_TRIGGER_CONDITION_SUCCEEDED), "a", true))
+	assert.False(t, shouldTriggerJob(triggers(mgmtv1alpha1.JobTriggerCondition_JOB_TRIGGER_CONDITION_SUCCEEDED), "a", false))
+	assert.True(t, shouldTriggerJob(triggers(mgmtv1alpha1.JobTriggerCondition_JOB_TRIGGER_CONDITION_FAILED), "a", false))
+	assert.False(t, shouldTriggerJob(triggers(mgmtv1alpha1.JobTriggerCondition_JOB_TRIGGER_CONDITION_FAILED), "a", true))
+	assert.True(t, shouldTriggerJob(triggers(mgmtv1alpha1.JobTriggerCondition_JOB_TRIGGER_CONDITION_COMPLETED), "a", true))
+	assert.True(t, shouldTriggerJob(triggers(mgmtv1alpha1.JobTriggerCondition_JOB_TRIGGER_CONDITION_COMPLETED), "a", false))
+	assert.False(t, shouldTriggerJob(triggers(mgmtv1alpha1.JobTriggerCondition_JOB_TRIGGER_CONDITION_COMPLETED), "b", true))
+}
@@ -7,6 +7,7 @@ import (
 	"github.com/jackc/pgx/v5/pgtype"
 	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
 	"github.com/nucleuscloud/neosync/backend/internal/apikey"
+	"github.com/nucleuscloud/neosync/backend/internal/audit"
 	auth_apikey "github.com/nucleuscloud/neosync/backend/internal/auth/apikey"
 	nucleuserrors "github.com/nucleuscloud/neosync/backend/internal/errors"
 	"github.com/nucleuscloud/neosync/backend/internal/nucleusdb"
@@ -22,6 +23,8 @@ func (s *Service) verifyUserInAccount(
 	}
 
 	if isWorkerApiKey(ctx) {
+		// the worker is not a user but its changes to the account's jobs are still audited
+		audit.SetAccountUser(ctx, accountId, "")
 		return &accountUuid, nil
 	}
 
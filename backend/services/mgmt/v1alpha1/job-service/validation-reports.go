@@ -0,0 +1,74 @@
+package v1alpha1_jobservice
+
+import (
+	"context"
+
+	"connectrpc.com/connect"
+	db_queries "github.com/nucleuscloud/neosync/backend/gen/go/db"
+	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
+	logger_interceptor "github.com/nucleuscloud/neosync/backend/internal/connect/interceptors/logger"
+	"github.com/nucleuscloud/neosync/backend/internal/dtomaps"
+	nucleuserrors "github.com/nucleuscloud/neosync/backend/internal/errors"
+	"github.com/nucleuscloud/neosync/backend/internal/nucleusdb"
+	pg_models "github.com/nucleuscloud/neosync/backend/sql/postgresql/models"
+)
+
+func (s *Service) SetJobRunValidationReport(
+	ctx context.Context,
+	req *connect.Request[mgmtv1alpha1.SetJobRunValidationReportRequest],
+) (*connect.Response[mgmtv1alpha1.SetJobRunValidationReportResponse], error) {
+	logger := logger_interceptor.GetLoggerFromContextOrDefault(ctx)
+	logger = logger.With("jobId", req.Msg.JobId, "jobRunId", req.Msg.JobRunId)
+
+	job, err := s.getVerifiedJob(ctx, req.Msg.JobId)
+	if err != nil {
+		return nil, err
+	}
+
+	passed := true
+	for _, table := range req.Msg.Tables {
+		if !table.Passed {
+			passed = false
+			break
+		}
+	}
+
+	report := &pg_models.JobRunValidationReport{}
+	report.FromDto(req.Msg.Tables)
+
+	logger.Info("setting job run validation report", "tables", len(req.Msg.Tables), "passed", passed)
+	_, err = s.db.Q.SetJobRunValidationReport(ctx, s.db.Db, db_queries.SetJobRunValidationReportParams{
+		JobID:    job.ID,
+		JobRunID: req.Msg.JobRunId,
+		Passed:   passed,
+		Report:   report,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&mgmtv1alpha1.SetJobRunValidationReportResponse{}), nil
+}
+
+func (s *Service) GetJobRunValidationReport(
+	ctx context.Context,
+	req *connect.Request[mgmtv1alpha1.GetJobRunValidationReportRequest],
+) (*connect.Response[mgmtv1alpha1.GetJobRunValidationReportResponse], error) {
+	job, err := s.getVerifiedJob(ctx, req.Msg.JobId)
+	if err != nil {
+		return nil, err
+	}
+
+	report, err := s.db.Q.GetJobRunValidationReport(ctx, s.db.Db, db_queries.GetJobRunValidationReportParams{
+		JobID:    job.ID,
+		JobRunID: req.Msg.JobRunId,
+	})
+	if err != nil && !nucleusdb.IsNoRows(err) {
+		return nil, err
+	} else if err != nil && nucleusdb.IsNoRows(err) {
+		return nil, nucleuserrors.NewNotFound("unable to find validation report for job run")
+	}
+
+	return connect.NewResponse(&mgmtv1alpha1.GetJobRunValidationReportResponse{
+		Report: dtomaps.ToJobRunValidationReportDto(&report),
+	}), nil
+}
@@ -0,0 +1,139 @@
+package v1alpha1_jobservice
+
+import (
+	"context"
+	"testing"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	db_queries "github.com/nucleuscloud/neosync/backend/gen/go/db"
+	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
+	"github.com/nucleuscloud/neosync/backend/internal/nucleusdb"
+	pg_models "github.com/nucleuscloud/neosync/backend/sql/postgresql/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// SetJobRunValidationReport
+func Test_SetJobRunValidationReport(t *testing.T) {
+	m := createServiceMock(t, &Config{IsAuthEnabled: true})
+	job := mockJob(mockAccountId, mockUserId, uuid.NewString(), pgtype.Text{})
+
+	mockIsUserInAccount(m.UserAccountServiceMock, true)
+	m.QuerierMock.On("GetJobById", mock.Anything, mock.Anything, job.ID).Return(job, nil)
+	m.QuerierMock.On("SetJobRunValidationReport", mock.Anything, mock.Anything, db_queries.SetJobRunValidationReportParams{
+		JobID:    job.ID,
+		JobRunID: "run-1",
+		Passed:   false,
+		Report: &pg_models.JobRunValidationReport{
+			Tables: []*pg_models.JobRunTableValidation{
+				{
+					DestinationConnectionId: "dest-1", Schema: "public", Table: "users", SourceRowCount: 10, DestinationRowCount: 10, Passed: true,
+					ForeignKeys: []*pg_models.JobRunForeignKeyValidation{},
+					Columns: []*pg_models.JobRunColumnValidation{
+						{Column: "email", TransformerSource: int32(mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_TRANSFORM_EMAIL), SampledRowCount: 10},
+					},
+				},
+				{
+					DestinationConnectionId: "dest-1", Schema: "public", Table: "orders", SourceRowCount: 5, DestinationRowCount: 4, Passed: false,
+					ForeignKeys: []*pg_models.JobRunForeignKeyValidation{
+						{Column: "user_id", ForeignSchema: "public", ForeignTable: "users", ForeignColumn: "id", OrphanedRowCount: 1},
+					},
+					Columns: []*pg_models.JobRunColumnValidation{},
+				},
+			},
+		},
+	}).Return(db_queries.NeosyncApiJobRunValidationReport{}, nil)
+
+	resp, err := m.Service.SetJobRunValidationReport(context.Background(), connect.NewRequest(&mgmtv1alpha1.SetJobRunValidationReportRequest{
+		JobId:    nucleusdb.UUIDString(job.ID),
+		JobRunId: "run-1",
+		Tables: []*mgmtv1alpha1.JobRunTableValidation{
+			{
+				DestinationConnectionId: "dest-1", Schema: "public", Table: "users", SourceRowCount: 10, DestinationRowCount: 10, Passed: true,
+				Columns: []*mgmtv1alpha1.JobRunColumnValidation{
+					{Column: "email", TransformerSource: mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_TRANSFORM_EMAIL, SampledRowCount: 10},
+				},
+			},
+			{
+				DestinationConnectionId: "dest-1", Schema: "public", Table: "orders", SourceRowCount: 5, DestinationRowCount: 4, Passed: false,
+				ForeignKeys: []*mgmtv1alpha1.JobRunForeignKeyValidation{
+					{Column: "user_id", ForeignSchema: "public", ForeignTable: "users", ForeignColumn: "id", OrphanedRowCount: 1},
+				},
+			},
+		},
+	}))
+
+	assert.NoError(t, err)
+	assert.NotNil(t, resp)
+}
+
+// GetJobRunValidationReport
+func Test_GetJobRunValidationReport(t *testing.T) {
+	m := createServiceMock(t, &Config{IsAuthEnabled: true})
+	job := mockJob(mockAccountId, mockUserId, uuid.NewString(), pgtype.Text{})
+
+	mockIsUserInAccount(m.UserAccountServiceMock, true)
+	m.QuerierMock.On("GetJobById", mock.Anything, mock.Anything, job.ID).Return(job, nil)
+	m.QuerierMock.On("GetJobRunValidationReport", mock.Anything, mock.Anything, db_queries.GetJobRunValidationReportParams{
+		JobID:    job.ID,
+		JobRunID: "run-1",
+	}).Return(db_queries.NeosyncApiJobRunValidationReport{
+		JobID:    job.ID,
+		JobRunID: "run-1",
+		Passed:   true,
+		Report: &pg_models.JobRunValidationReport{
+			Tables: []*pg_models.JobRunTableValidation{
+				{DestinationConnectionId: "dest-1", Schema: "public", Table: "users", SourceRowCount: 10, DestinationRowCount: 10, Passed: true},
+			},
+		},
+	}, nil)
+
+	resp, err := m.Service.GetJobRunValidationReport(context.Background(), connect.NewRequest(&mgmtv1alpha1.GetJobRunValidationReportRequest{
+		JobId:    nucleusdb.UUIDString(job.ID),
+		JobRunId: "run-1",
+	}))
+
+	assert.NoError(t, err)
+	assert.True(t, resp.Msg.Report.Passed)
+	assert.Equal(t, "run-1", resp.Msg.Report.JobRunId)
+	assert.Len(t, resp.Msg.Report.Tables, 1)
+	assert.Equal(t, "users", resp.Msg.Report.Tables[0].Table)
+	assert.Equal(t, int64(10), resp.Msg.Report.Tables[0].DestinationRowCount)
+}
+
+func Test_GetJobRunValidationReport_NotFound(t *testing.T) {
+	m := createServiceMock(t, &Config{IsAuthEnabled: true})
+	job := mockJob(mockAccountId, mockUserId, uuid.NewString(), pgtype.Text{})
+
+	mockIsUserInAccount(m.UserAccountServiceMock, true)
+	m.QuerierMock.On("GetJobById", mock.Anything, mock.Anything, job.ID).Return(job, nil)
+	m.QuerierMock.On("GetJobRunValidationReport", mock.Anything, mock.Anything, mock.Anything).Return(db_queries.NeosyncApiJobRunValidationReport{}, pgx.ErrNoRows)
+
+	resp, err := m.Service.GetJobRunValidationReport(context.Background(), connect.NewRequest(&mgmtv1alpha1.GetJobRunValidationReportRequest{
+		JobId:    nucleusdb.UUIDString(job.ID),
+		JobRunId: "run-1",
+	}))
+
+	assert.Error(t, err)
+	assert.Nil(t, resp)
+}
+
+func Test_GetJobRunValidationReport_UnauthorizedUser(t *testing.T) {
+	m := createServiceMock(t, &Config{IsAuthEnabled: true})
+	job := mockJob(mockAccountId, mockUserId, uuid.NewString(), pgtype.Text{})
+
+	mockIsUserInAccount(m.UserAccountServiceMock, false)
+	m.QuerierMock.On("GetJobById", mock.Anything, mock.Anything, job.ID).Return(job, nil)
+
+	resp, err := m.Service.GetJobRunValidationReport(context.Background(), connect.NewRequest(&mgmtv1alpha1.GetJobRunValidationReportRequest{
+		JobId:    nucleusdb.UUIDString(job.ID),
+		JobRunId: "run-1",
+	}))
+
+	assert.Error(t, err)
+	assert.Nil(t, resp)
+	m.QuerierMock.AssertNotCalled(t, "GetJobRunValidationReport", mock.Anything, mock.Anything, mock.Anything)
+}
@@ -0,0 +1,545 @@
+package v1alpha1_jobservice
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"slices"
+	"strings"
+
+	"connectrpc.com/connect"
+	"github.com/jackc/pgx/v5/pgtype"
+	db_queries "github.com/nucleuscloud/neosync/backend/gen/go/db"
+	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
+	logger_interceptor "github.com/nucleuscloud/neosync/backend/internal/connect/interceptors/logger"
+	"github.com/nucleuscloud/neosync/backend/internal/dtomaps"
+	nucleuserrors "github.com/nucleuscloud/neosync/backend/internal/errors"
+	"github.com/nucleuscloud/neosync/backend/internal/nucleusdb"
+	pg_models "github.com/nucleuscloud/neosync/backend/sql/postgresql/models"
+	temporalclient "go.temporal.io/sdk/client"
+	"google.golang.org/protobuf/proto"
+)
+
+func (s *Service) GetJobVersions(
+	ctx context.Context,
+	req *connect.Request[mgmtv1alpha1.GetJobVersionsRequest],
+) (*connect.Response[mgmtv1alpha1.GetJobVersionsResponse], error) {
+	job, err := s.getVerifiedJob(ctx, req.Msg.JobId)
+	if err != nil {
+		return nil, err
+	}
+
+	versions, err := s.db.Q.GetJobVersions(ctx, s.db.Db, job.ID)
+	if err != nil && !nucleusdb.IsNoRows(err) {
+		return nil, err
+	}
+
+	dtos := make([]*mgmtv1alpha1.JobVersion, 0, len(versions))
+	for i := range versions {
+		dtos = append(dtos, dtomaps.ToJobVersionDto(&versions[i]))
+	}
+	return connect.NewResponse(&mgmtv1alpha1.GetJobVersionsResponse{Versions: dtos}), nil
+}
+
+func (s *Service) GetJobVersionDiff(
+	ctx context.Context,
+	req *connect.Request[mgmtv1alpha1.GetJobVersionDiffRequest],
+) (*connect.Response[mgmtv1alpha1.GetJobVersionDiffResponse], error) {
+	job, err := s.getVerifiedJob(ctx, req.Msg.JobId)
+	if err != nil {
+		return nil, err
+	}
+
+	fromVersion, err := s.getJobVersion(ctx, job.ID, req.Msg.FromVersionId)
+	if err != nil {
+		return nil, err
+	}
+	toVersion, err := s.getJobVersion(ctx, job.ID, req.Msg.ToVersionId)
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(getJobVersionDiff(
+		dtomaps.ToJobVersionDto(fromVersion),
+		dtomaps.ToJobVersionDto(toVersion),
+	)), nil
+}
+
+func (s *Service) RollbackJobToVersion(
+	ctx context.Context,
+	req *connect.Request[mgmtv1alpha1.RollbackJobToVersionRequest],
+) (*connect.Response[mgmtv1alpha1.RollbackJobToVersionResponse], error) {
+	logger := logger_interceptor.GetLoggerFromContextOrDefault(ctx)
+	logger = logger.With("jobId", req.Msg.JobId, "jobVersionId", req.Msg.VersionId)
+
+	job, err := s.getVerifiedJob(ctx, req.Msg.JobId)
+	if err != nil {
+		return nil, err
+	}
+	userUuid, err := s.getUserUuid(ctx)
+	if err != nil {
+		return nil, err
+	}
+	version, err := s.getJobVersion(ctx, job.ID, req.Msg.VersionId)
+	if err != nil {
+		return nil, err
+	}
+	versionDto := dtomaps.ToJobVersionDto(version)
+
+	// connections may have been deleted since the version was recorded
+	connectionUuids := []pgtype.UUID{}
+	for _, connectionId := range getJobVersionConnectionIds(versionDto) {
+		connectionUuid, err := nucleusdb.ToUuid(connectionId)
+		if err != nil {
+			return nil, err
+		}
+		connectionUuids = append(connectionUuids, connectionUuid)
+	}
+	count, err := s.db.Q.AreConnectionsInAccount(ctx, s.db.Db, db_queries.AreConnectionsInAccountParams{
+		AccountId:     job.AccountID,
+		ConnectionIds: connectionUuids,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if count != int64(len(connectionUuids)) {
+		return nil, nucleuserrors.NewBadRequest("unable to rollback job. a connection used by the job version no longer exists")
+	}
+
+	accountId := nucleusdb.UUIDString(job.AccountID)
+	var newVersion *db_queries.NeosyncApiJobVersion
+	if err := s.db.WithTx(ctx, nil, func(dbtx nucleusdb.BaseDBTX) error {
+		_, err = s.db.Q.UpdateJobSource(ctx, dbtx, db_queries.UpdateJobSourceParams{
+			ID:                job.ID,
+			ConnectionOptions: version.ConnectionOptions,
+			UpdatedByID:       *userUuid,
+		})
+		if err != nil {
+			return err
+		}
+		_, err = s.db.Q.UpdateJobMappings(ctx, dbtx, db_queries.UpdateJobMappingsParams{
+			ID:          job.ID,
+			Mappings:    version.Mappings,
+			UpdatedByID: *userUuid,
+		})
+		if err != nil {
+			return err
+		}
+		_, err = s.db.Q.UpdateJobSchedule(ctx, dbtx, db_queries.UpdateJobScheduleParams{
+			ID:           job.ID,
+			CronSchedule: version.CronSchedule,
+			UpdatedByID:  *userUuid,
+		})
+		if err != nil {
+			return err
+		}
+		if err := s.rollbackJobDestinations(ctx, dbtx, job.ID, version.Destinations); err != nil {
+			return err
+		}
+
+		newVersion, _, err = s.createJobVersion(ctx, dbtx, job.ID, *userUuid)
+		if err != nil {
+			return err
+		}
+
+		return s.rollbackSchedule(ctx, logger, accountId, nucleusdb.UUIDString(job.ID), versionDto, nucleusdb.UUIDString(newVersion.ID))
+	}); err != nil {
+		return nil, err
+	}
+	logger.Info("rolled back job", "version", version.Version, "newVersion", newVersion.Version)
+
+	updatedJob, err := s.GetJob(ctx, connect.NewRequest(&mgmtv1alpha1.GetJobRequest{
+		Id: req.Msg.JobId,
+	}))
+	if err != nil {
+		logger.Error(fmt.Errorf("unable to retrieve job: %w", err).Error())
+		return nil, err
+	}
+	return connect.NewResponse(&mgmtv1alpha1.RollbackJobToVersionResponse{
+		Job:     updatedJob.Msg.Job,
+		Version: dtomaps.ToJobVersionDto(newVersion),
+	}), nil
+}
+
+// Updates the job's destinations in place so that destinations that are in the version keep their ids
+func (s *Service) rollbackJobDestinations(
+	ctx context.Context,
+	dbtx nucleusdb.BaseDBTX,
+	jobUuid pgtype.UUID,
+	versionDestinations []*pg_models.JobVersionDestination,
+) error {
+	currentDestinations, err := s.db.Q.GetJobConnectionDestinations(ctx, dbtx, jobUuid)
+	if err != nil && !nucleusdb.IsNoRows(err) {
+		return err
+	}
+
+	optionsByConnection := map[string]*pg_models.JobDestinationOptions{}
+	for _, dest := range versionDestinations {
+		optionsByConnection[dest.ConnectionId] = dest.Options
+	}
+
+	removedIds := []pgtype.UUID{}
+	for _, dest := range currentDestinations {
+		connectionId := nucleusdb.UUIDString(dest.ConnectionID)
+		options, ok := optionsByConnection[connectionId]
+		if !ok {
+			removedIds = append(removedIds, dest.ID)
+			continue
+		}
+		delete(optionsByConnection, connectionId)
+		_, err = s.db.Q.UpdateJobConnectionDestination(ctx, dbtx, db_queries.UpdateJobConnectionDestinationParams{
+			ID:           dest.ID,
+			ConnectionID: dest.ConnectionID,
+			Options:      options,
+		})
+		if err != nil {
+			return err
+		}
+	}
+	if len(removedIds) > 0 {
+		if err := s.db.Q.RemoveJobConnectionDestinations(ctx, dbtx, removedIds); err != nil {
+			return err
+		}
+	}
+
+	createParams := []db_queries.CreateJobConnectionDestinationsParams{}
+	for _, dest := range versionDestinations {
+		options, ok := optionsByConnection[dest.ConnectionId]
+		if !ok {
+			continue
+		}
+		connectionUuid, err := nucleusdb.ToUuid(dest.ConnectionId)
+		if err != nil {
+			return err
+		}
+		createParams = append(createParams, db_queries.CreateJobConnectionDestinationsParams{
+			JobID:        jobUuid,
+			ConnectionID: connectionUuid,
+			Options:      options,
+		})
+	}
+	if len(createParams) > 0 {
+		if _, err := s.db.Q.CreateJobConnectionDestinations(ctx, dbtx, createParams); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Restores the cron schedule and workflow of the version on the job's schedule and stamps the new version on its memo
+func (s *Service) rollbackSchedule(
+	ctx context.Context,
+	logger *slog.Logger,
+	accountId, jobId string,
+	version *mgmtv1alpha1.JobVersion,
+	newVersionId string,
+) error {
+	scheduleHandle, err := s.temporalWfManager.GetScheduleHandleClientByAccount(ctx, accountId, jobId, logger)
+	if err != nil {
+		return fmt.Errorf("unable to retrieve temporal schedule client by job: %w", err)
+	}
+	scheduleWorkflow, scheduleArgs := getScheduleWorkflow(version.GetSource().GetOptions(), jobId)
+	cronStr := version.GetCronSchedule()
+	if cronStr == "" {
+		cronStr = defaultCronStr
+	}
+	err = scheduleHandle.Update(ctx, temporalclient.ScheduleUpdateOptions{
+		DoUpdate: func(schedule temporalclient.ScheduleUpdateInput) (*temporalclient.ScheduleUpdate, error) {
+			action, ok := schedule.Description.Schedule.Action.(*temporalclient.ScheduleWorkflowAction)
+			if !ok {
+				return nil, fmt.Errorf("unable to cast temporal action to *temporalclient.ScheduleWorkflowAction. Type was: %T", schedule.Description.Schedule.Action)
+			}
+			action.Workflow = scheduleWorkflow
+			action.Args = scheduleArgs
+			setJobVersionMemo(action, newVersionId)
+			schedule.Description.Schedule.Action = action
+			schedule.Description.Schedule.Spec = &temporalclient.ScheduleSpec{CronExpressions: []string{cronStr}}
+			return &temporalclient.ScheduleUpdate{
+				Schedule: &schedule.Description.Schedule,
+			}, nil
+		},
+	})
+	if err != nil {
+		logger.Error(fmt.Errorf("unable to update schedule: %w", err).Error())
+		return fmt.Errorf("unable to rollback temporal schedule: %w", err)
+	}
+	return nil
+}
+
+// Records the job's current schedule, source, mappings and destinations as a new version and stamps it on the job's schedule.
+// Nothing is recorded if none of them changed since the latest version
+func (s *Service) recordJobVersion(
+	ctx context.Context,
+	logger *slog.Logger,
+	dbtx nucleusdb.BaseDBTX,
+	accountId string,
+	jobUuid, userUuid pgtype.UUID,
+) error {
+	version, created, err := s.createJobVersion(ctx, dbtx, jobUuid, userUuid)
+	if err != nil {
+		return fmt.Errorf("unable to create job version: %w", err)
+	}
+	if !created {
+		return nil
+	}
+	logger.Info("created job version", "version", version.Version)
+	return s.setScheduleJobVersion(ctx, logger, accountId, nucleusdb.UUIDString(jobUuid), nucleusdb.UUIDString(version.ID))
+}
+
+// Returns the latest version instead of creating a new one when the job has not changed since it was recorded
+func (s *Service) createJobVersion(
+	ctx context.Context,
+	dbtx nucleusdb.BaseDBTX,
+	jobUuid, userUuid pgtype.UUID,
+) (version *db_queries.NeosyncApiJobVersion, created bool, err error) {
+	job, err := s.db.Q.GetJobById(ctx, dbtx, jobUuid)
+	if err != nil {
+		return nil, false, err
+	}
+	destinations, err := s.db.Q.GetJobConnectionDestinations(ctx, dbtx, jobUuid)
+	if err != nil && !nucleusdb.IsNoRows(err) {
+		return nil, false, err
+	}
+
+	current := toJobVersion(&job, destinations)
+	latest, err := s.db.Q.GetLatestJobVersion(ctx, dbtx, jobUuid)
+	if err != nil && !nucleusdb.IsNoRows(err) {
+		return nil, false, err
+	} else if err == nil && isJobVersionEqual(dtomaps.ToJobVersionDto(&latest), dtomaps.ToJobVersionDto(current)) {
+		return &latest, false, nil
+	}
+
+	newVersion, err := s.db.Q.CreateJobVersion(ctx, dbtx, db_queries.CreateJobVersionParams{
+		JobId:             jobUuid,
+		CronSchedule:      current.CronSchedule,
+		ConnectionOptions: current.ConnectionOptions,
+		Mappings:          current.Mappings,
+		Destinations:      current.Destinations,
+		CreatedById:       userUuid,
+	})
+	if err != nil {
+		return nil, false, err
+	}
+	return &newVersion, true, nil
+}
+
+func (s *Service) setScheduleJobVersion(
+	ctx context.Context,
+	logger *slog.Logger,
+	accountId, jobId, versionId string,
+) error {
+	scheduleHandle, err := s.temporalWfManager.GetScheduleHandleClientByAccount(ctx, accountId, jobId, logger)
+	if err != nil {
+		return fmt.Errorf("unable to retrieve temporal schedule client by job: %w", err)
+	}
+	err = scheduleHandle.Update(ctx, temporalclient.ScheduleUpdateOptions{
+		DoUpdate: func(schedule temporalclient.ScheduleUpdateInput) (*temporalclient.ScheduleUpdate, error) {
+			action, ok := schedule.Description.Schedule.Action.(*temporalclient.ScheduleWorkflowAction)
+			if !ok {
+				return nil, fmt.Errorf("unable to cast temporal action to *temporalclient.ScheduleWorkflowAction. Type was: %T", schedule.Description.Schedule.Action)
+			}
+			setJobVersionMemo(action, versionId)
+			schedule.Description.Schedule.Action = action
+			return &temporalclient.ScheduleUpdate{
+				Schedule: &schedule.Description.Schedule,
+			}, nil
+		},
+	})
+	if err != nil {
+		logger.Error(fmt.Errorf("unable to update schedule: %w", err).Error())
+		return fmt.Errorf("unable to set job version on temporal schedule: %w", err)
+	}
+	return nil
+}
+
+// Every run started by the schedule copies the memo, which is how runs know the version they ran with
+func setJobVersionMemo(action *temporalclient.ScheduleWorkflowAction, versionId string) {
+	if action.Memo == nil {
+		action.Memo = map[string]any{}
+	}
+	action.Memo[dtomaps.JobVersionIdMemoKey] = versionId
+}
+
+func (s *Service) getJobVersion(
+	ctx context.Context,
+	jobUuid pgtype.UUID,
+	versionId string,
+) (*db_queries.NeosyncApiJobVersion, error) {
+	versionUuid, err := nucleusdb.ToUuid(versionId)
+	if err != nil {
+		return nil, err
+	}
+	version, err := s.db.Q.GetJobVersionById(ctx, s.db.Db, versionUuid)
+	if err != nil && !nucleusdb.IsNoRows(err) {
+		return nil, err
+	} else if err != nil && nucleusdb.IsNoRows(err) {
+		return nil, nucleuserrors.NewNotFound("unable to find job version by id")
+	}
+	if version.JobID != jobUuid {
+		return nil, nucleuserrors.NewNotFound("unable to find job version by id")
+	}
+	return &version, nil
+}
+
+func toJobVersion(
+	job *db_queries.NeosyncApiJob,
+	destinations []db_queries.NeosyncApiJobDestinationConnectionAssociation,
+) *db_queries.NeosyncApiJobVersion {
+	versionDestinations := make([]*pg_models.JobVersionDestination, 0, len(destinations))
+	for _, dest := range destinations {
+		versionDestinations = append(versionDestinations, &pg_models.JobVersionDestination{
+			ConnectionId: nucleusdb.UUIDString(dest.ConnectionID),
+			Options:      dest.Options,
+		})
+	}
+	// destinations are not returned in a stable order
+	slices.SortFunc(versionDestinations, func(a, b *pg_models.JobVersionDestination) int {
+		return strings.Compare(a.ConnectionId, b.ConnectionId)
+	})
+	mappings := job.Mappings
+	if mappings == nil {
+		mappings = []*pg_models.JobMapping{}
+	}
+	return &db_queries.NeosyncApiJobVersion{
+		JobID:             job.ID,
+		CronSchedule:      job.CronSchedule,
+		ConnectionOptions: job.ConnectionOptions,
+		Mappings:          mappings,
+		Destinations:      versionDestinations,
+	}
+}
+
+func isJobVersionEqual(a, b *mgmtv1alpha1.JobVersion) bool {
+	return a.GetCronSchedule() == b.GetCronSchedule() &&
+		proto.Equal(a.GetSource(), b.GetSource()) &&
+		slices.EqualFunc(a.GetMappings(), b.GetMappings(), func(x, y *mgmtv1alpha1.JobMapping) bool { return proto.Equal(x, y) }) &&
+		slices.EqualFunc(a.GetDestinations(), b.GetDestinations(), func(x, y *mgmtv1alpha1.CreateJobDestination) bool { return proto.Equal(x, y) })
+}
+
+func getJobVersionDiff(from, to *mgmtv1alpha1.JobVersion) *mgmtv1alpha1.GetJobVersionDiffResponse {
+	return &mgmtv1alpha1.GetJobVersionDiffResponse{
+		FromVersion:         from,
+		ToVersion:           to,
+		CronScheduleChanged: from.GetCronSchedule() != to.GetCronSchedule(),
+		SourceChanged:       !proto.Equal(from.GetSource(), to.GetSource()),
+		Mappings:            getJobMappingDiffs(from.GetMappings(), to.GetMappings()),
+		Destinations:        getJobDestinationDiffs(from.GetDestinations(), to.GetDestinations()),
+	}
+}
+
+// Mappings are matched by column. Removed and changed columns are listed in the order of the from version, followed by the added columns
+func getJobMappingDiffs(from, to []*mgmtv1alpha1.JobMapping) []*mgmtv1alpha1.JobMappingDiff {
+	toMappings := map[string]*mgmtv1alpha1.JobMapping{}
+	for _, mapping := range to {
+		toMappings[getMappingKey(mapping)] = mapping
+	}
+	fromMappings := map[string]*mgmtv1alpha1.JobMapping{}
+	for _, mapping := range from {
+		fromMappings[getMappingKey(mapping)] = mapping
+	}
+
+	diffs := []*mgmtv1alpha1.JobMappingDiff{}
+	for _, fromMapping := range from {
+		toMapping, ok := toMappings[getMappingKey(fromMapping)]
+		if !ok {
+			diffs = append(diffs, &mgmtv1alpha1.JobMappingDiff{
+				Schema:          fromMapping.Schema,
+				Table:           fromMapping.Table,
+				Column:          fromMapping.Column,
+				Action:          mgmtv1alpha1.JobVersionDiffAction_JOB_VERSION_DIFF_ACTION_REMOVED,
+				FromTransformer: fromMapping.Transformer,
+			})
+		} else if !proto.Equal(fromMapping.Transformer, toMapping.Transformer) {
+			diffs = append(diffs, &mgmtv1alpha1.JobMappingDiff{
+				Schema:          fromMapping.Schema,
+				Table:           fromMapping.Table,
+				Column:          fromMapping.Column,
+				Action:          mgmtv1alpha1.JobVersionDiffAction_JOB_VERSION_DIFF_ACTION_CHANGED,
+				FromTransformer: fromMapping.Transformer,
+				ToTransformer:   toMapping.Transformer,
+			})
+		}
+	}
+	for _, toMapping := range to {
+		if _, ok := fromMappings[getMappingKey(toMapping)]; !ok {
+			diffs = append(diffs, &mgmtv1alpha1.JobMappingDiff{
+				Schema:        toMapping.Schema,
+				Table:         toMapping.Table,
+				Column:        toMapping.Column,
+				Action:        mgmtv1alpha1.JobVersionDiffAction_JOB_VERSION_DIFF_ACTION_ADDED,
+				ToTransformer: toMapping.Transformer,
+			})
+		}
+	}
+	return diffs
+}
+
+func getJobDestinationDiffs(from, to []*mgmtv1alpha1.CreateJobDestination) []*mgmtv1alpha1.JobDestinationDiff {
+	toDestinations := map[string]*mgmtv1alpha1.CreateJobDestination{}
+	for _, dest := range to {
+		toDestinations[dest.ConnectionId] = dest
+	}
+	fromDestinations := map[string]*mgmtv1alpha1.CreateJobDestination{}
+	for _, dest := range from {
+		fromDestinations[dest.ConnectionId] = dest
+	}
+
+	diffs := []*mgmtv1alpha1.JobDestinationDiff{}
+	for _, fromDest := range from {
+		toDest, ok := toDestinations[fromDest.ConnectionId]
+		if !ok {
+			diffs = append(diffs, &mgmtv1alpha1.JobDestinationDiff{
+				ConnectionId: fromDest.ConnectionId,
+				Action:       mgmtv1alpha1.JobVersionDiffAction_JOB_VERSION_DIFF_ACTION_REMOVED,
+				FromOptions:  fromDest.Options,
+			})
+		} else if !proto.Equal(fromDest.Options, toDest.Options) {
+			diffs = append(diffs, &mgmtv1alpha1.JobDestinationDiff{
+				ConnectionId: fromDest.ConnectionId,
+				Action:       mgmtv1alpha1.JobVersionDiffAction_JOB_VERSION_DIFF_ACTION_CHANGED,
+				FromOptions:  fromDest.Options,
+				ToOptions:    toDest.Options,
+			})
+		}
+	}
+	for _, toDest := range to {
+		if _, ok := fromDestinations[toDest.ConnectionId]; !ok {
+			diffs = append(diffs, &mgmtv1alpha1.JobDestinationDiff{
+				ConnectionId: toDest.ConnectionId,
+				Action:       mgmtv1alpha1.JobVersionDiffAction_JOB_VERSION_DIFF_ACTION_ADDED,
+				ToOptions:    toDest.Options,
+			})
+		}
+	}
+	return diffs
+}
+
+func getMappingKey(mapping *mgmtv1alpha1.JobMapping) string {
+	return fmt.Sprintf("%s.%s.%s", mapping.Schema, mapping.Table, mapping.Column)
+}
+
+func getJobVersionConnectionIds(version *mgmtv1alpha1.JobVersion) []string {
+	ids := []string{}
+	switch config := version.GetSource().GetOptions().GetConfig().(type) {
+	case *mgmtv1alpha1.JobSourceOptions_Postgres:
+		ids = append(ids, config.Postgres.GetConnectionId())
+	case *mgmtv1alpha1.JobSourceOptions_Mysql:
+		ids = append(ids, config.Mysql.GetConnectionId())
+	case *mgmtv1alpha1.JobSourceOptions_Mssql:
+		ids = append(ids, config.Mssql.GetConnectionId())
+	case *mgmtv1alpha1.JobSourceOptions_PostgresCdc:
+		ids = append(ids, config.PostgresCdc.GetConnectionId())
+	case *mgmtv1alpha1.JobSourceOptions_AwsS3:
+		ids = append(ids, config.AwsS3.GetConnectionId())
+	case *mgmtv1alpha1.JobSourceOptions_Generate:
+		if config.Generate.GetFkSourceConnectionId() != "" {
+			ids = append(ids, config.Generate.GetFkSourceConnectionId())
+		}
+	}
+	for _, dest := range version.GetDestinations() {
+		if !slices.Contains(ids, dest.ConnectionId) {
+			ids = append(ids, dest.ConnectionId)
+		}
+	}
+	return ids
+}
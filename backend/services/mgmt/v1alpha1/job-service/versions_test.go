@@ -0,0 +1,298 @@
+package v1alpha1_jobservice
+
+import (
+	"context"
+	"testing"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	db_queries "github.com/nucleuscloud/neosync/backend/gen/go/db"
+	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
+	"github.com/nucleuscloud/neosync/backend/internal/nucleusdb"
+	pg_models "github.com/nucleuscloud/neosync/backend/sql/postgresql/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	temporalclient "go.temporal.io/sdk/client"
+)
+
+// GetJobVersions
+func Test_GetJobVersions(t *testing.T) {
+	m := createServiceMock(t, &Config{IsAuthEnabled: true})
+	job := mockJob(mockAccountId, mockUserId, uuid.NewString(), pgtype.Text{})
+	version2 := mockJobVersion(job, 2)
+	version1 := mockJobVersion(job, 1)
+
+	mockIsUserInAccount(m.UserAccountServiceMock, true)
+	m.QuerierMock.On("GetJobById", mock.Anything, mock.Anything, job.ID).Return(job, nil)
+	m.QuerierMock.On("GetJobVersions", mock.Anything, mock.Anything, job.ID).Return([]db_queries.NeosyncApiJobVersion{version2, version1}, nil)
+
+	resp, err := m.Service.GetJobVersions(context.Background(), connect.NewRequest(&mgmtv1alpha1.GetJobVersionsRequest{
+		JobId: nucleusdb.UUIDString(job.ID),
+	}))
+
+	require.NoError(t, err)
+	require.Len(t, resp.Msg.Versions, 2)
+	assert.Equal(t, int32(2), resp.Msg.Versions[0].Version)
+	assert.Equal(t, nucleusdb.UUIDString(version1.ID), resp.Msg.Versions[1].Id)
+}
+
+// GetJobVersionDiff
+func Test_GetJobVersionDiff_VersionOfOtherJob(t *testing.T) {
+	m := createServiceMock(t, &Config{IsAuthEnabled: true})
+	job := mockJob(mockAccountId, mockUserId, uuid.NewString(), pgtype.Text{})
+	otherJob := mockJob(mockAccountId, mockUserId, uuid.NewString(), pgtype.Text{})
+	version := mockJobVersion(otherJob, 1)
+
+	mockIsUserInAccount(m.UserAccountServiceMock, true)
+	m.QuerierMock.On("GetJobById", mock.Anything, mock.Anything, job.ID).Return(job, nil)
+	m.QuerierMock.On("GetJobVersionById", mock.Anything, mock.Anything, version.ID).Return(version, nil)
+
+	resp, err := m.Service.GetJobVersionDiff(context.Background(), connect.NewRequest(&mgmtv1alpha1.GetJobVersionDiffRequest{
+		JobId:         nucleusdb.UUIDString(job.ID),
+		FromVersionId: nucleusdb.UUIDString(version.ID),
+		ToVersionId:   nucleusdb.UUIDString(version.ID),
+	}))
+
+	assert.Error(t, err)
+	assert.Nil(t, resp)
+}
+
+func Test_getJobVersionDiff(t *testing.T) {
+	passthrough := &mgmtv1alpha1.JobMappingTransformer{Source: mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_PASSTHROUGH}
+	null := &mgmtv1alpha1.JobMappingTransformer{Source: mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_GENERATE_NULL}
+	truncate := &mgmtv1alpha1.JobDestinationOptions{
+		Config: &mgmtv1alpha1.JobDestinationOptions_PostgresOptions{
+			PostgresOptions: &mgmtv1alpha1.PostgresDestinationConnectionOptions{
+				TruncateTable: &mgmtv1alpha1.PostgresTruncateTableConfig{TruncateBeforeInsert: true},
+			},
+		},
+	}
+	from := &mgmtv1alpha1.JobVersion{
+		CronSchedule: ptr("0 0 * * *"),
+		Source:       &mgmtv1alpha1.JobSource{Options: &mgmtv1alpha1.JobSourceOptions{}},
+		Mappings: []*mgmtv1alpha1.JobMapping{
+			{Schema: "public", Table: "users", Column: "id", Transformer: passthrough},
+			{Schema: "public", Table: "users", Column: "email", Transformer: passthrough},
+			{Schema: "public", Table: "users", Column: "name", Transformer: passthrough},
+		},
+		Destinations: []*mgmtv1alpha1.CreateJobDestination{
+			{ConnectionId: "dest-1", Options: &mgmtv1alpha1.JobDestinationOptions{}},
+			{ConnectionId: "dest-2", Options: &mgmtv1alpha1.JobDestinationOptions{}},
+		},
+	}
+	to := &mgmtv1alpha1.JobVersion{
+		CronSchedule: ptr("0 0 * * *"),
+		Source:       &mgmtv1alpha1.JobSource{Options: &mgmtv1alpha1.JobSourceOptions{}},
+		Mappings: []*mgmtv1alpha1.JobMapping{
+			{Schema: "public", Table: "users", Column: "id", Transformer: passthrough},
+			{Schema: "public", Table: "users", Column: "email", Transformer: null},
+			{Schema: "public", Table: "users", Column: "age", Transformer: passthrough},
+		},
+		Destinations: []*mgmtv1alpha1.CreateJobDestination{
+			{ConnectionId: "dest-1", Options: truncate},
+			{ConnectionId: "dest-3", Options: &mgmtv1alpha1.JobDestinationOptions{}},
+		},
+	}
+
+	actual := getJobVersionDiff(from, to)
+
+	assert.False(t, actual.CronScheduleChanged)
+	assert.False(t, actual.SourceChanged)
+	require.Len(t, actual.Mappings, 3)
+	assert.Equal(t, "email", actual.Mappings[0].Column)
+	assert.Equal(t, mgmtv1alpha1.JobVersionDiffAction_JOB_VERSION_DIFF_ACTION_CHANGED, actual.Mappings[0].Action)
+	assert.Equal(t, mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_GENERATE_NULL, actual.Mappings[0].ToTransformer.Source)
+	assert.Equal(t, "name", actual.Mappings[1].Column)
+	assert.Equal(t, mgmtv1alpha1.JobVersionDiffAction_JOB_VERSION_DIFF_ACTION_REMOVED, actual.Mappings[1].Action)
+	assert.Equal(t, "age", actual.Mappings[2].Column)
+	assert.Equal(t, mgmtv1alpha1.JobVersionDiffAction_JOB_VERSION_DIFF_ACTION_ADDED, actual.Mappings[2].Action)
+
+	require.Len(t, actual.Destinations, 3)
+	assert.Equal(t, &mgmtv1alpha1.JobDestinationDiff{
+		ConnectionId: "dest-1",
+		Action:       mgmtv1alpha1.JobVersionDiffAction_JOB_VERSION_DIFF_ACTION_CHANGED,
+		FromOptions:  from.Destinations[0].Options,
+		ToOptions:    truncate,
+	}, actual.Destinations[0])
+	assert.Equal(t, mgmtv1alpha1.JobVersionDiffAction_JOB_VERSION_DIFF_ACTION_REMOVED, actual.Destinations[1].Action)
+	assert.Equal(t, "dest-3", actual.Destinations[2].ConnectionId)
+	assert.Equal(t, mgmtv1alpha1.JobVersionDiffAction_JOB_VERSION_DIFF_ACTION_ADDED, actual.Destinations[2].Action)
+
+	to.CronSchedule = ptr("0 1 * * *")
+	assert.True(t, getJobVersionDiff(from, to).CronScheduleChanged)
+}
+
+// createJobVersion
+func Test_createJobVersion_Unchanged(t *testing.T) {
+	m := createServiceMock(t, &Config{IsAuthEnabled: true})
+	job := mockJob(mockAccountId, mockUserId, uuid.NewString(), pgtype.Text{})
+	userUuid, _ := nucleusdb.ToUuid(mockUserId)
+	latest := mockJobVersion(job, 3)
+
+	m.QuerierMock.On("GetJobById", mock.Anything, mock.Anything, job.ID).Return(job, nil)
+	m.QuerierMock.On("GetJobConnectionDestinations", mock.Anything, mock.Anything, job.ID).Return([]db_queries.NeosyncApiJobDestinationConnectionAssociation{}, nil)
+	m.QuerierMock.On("GetLatestJobVersion", mock.Anything, mock.Anything, job.ID).Return(latest, nil)
+
+	version, created, err := m.Service.createJobVersion(context.Background(), m.DbtxMock, job.ID, userUuid)
+
+	require.NoError(t, err)
+	assert.False(t, created)
+	assert.Equal(t, latest.ID, version.ID)
+	m.QuerierMock.AssertNotCalled(t, "CreateJobVersion", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func Test_createJobVersion_Changed(t *testing.T) {
+	m := createServiceMock(t, &Config{IsAuthEnabled: true})
+	cron := pgtype.Text{}
+	_ = cron.Scan(cronSchedule)
+	job := mockJob(mockAccountId, mockUserId, uuid.NewString(), cron)
+	userUuid, _ := nucleusdb.ToUuid(mockUserId)
+	latest := mockJobVersion(job, 3)
+	latest.CronSchedule = pgtype.Text{}
+	connId, _ := nucleusdb.ToUuid(uuid.NewString())
+	destination := mockJobDestConnAssociation(job.ID, connId, &pg_models.JobDestinationOptions{})
+	created := mockJobVersion(job, 4)
+
+	m.QuerierMock.On("GetJobById", mock.Anything, mock.Anything, job.ID).Return(job, nil)
+	m.QuerierMock.On("GetJobConnectionDestinations", mock.Anything, mock.Anything, job.ID).Return([]db_queries.NeosyncApiJobDestinationConnectionAssociation{destination}, nil)
+	m.QuerierMock.On("GetLatestJobVersion", mock.Anything, mock.Anything, job.ID).Return(latest, nil)
+	m.QuerierMock.On("CreateJobVersion", mock.Anything, mock.Anything, db_queries.CreateJobVersionParams{
+		JobId:             job.ID,
+		CronSchedule:      cron,
+		ConnectionOptions: job.ConnectionOptions,
+		Mappings:          job.Mappings,
+		Destinations: []*pg_models.JobVersionDestination{
+			{ConnectionId: nucleusdb.UUIDString(connId), Options: &pg_models.JobDestinationOptions{}},
+		},
+		CreatedById: userUuid,
+	}).Return(created, nil)
+
+	version, isCreated, err := m.Service.createJobVersion(context.Background(), m.DbtxMock, job.ID, userUuid)
+
+	require.NoError(t, err)
+	assert.True(t, isCreated)
+	assert.Equal(t, int32(4), version.Version)
+}
+
+// RollbackJobToVersion
+func Test_RollbackJobToVersion(t *testing.T) {
+	m := createServiceMock(t, &Config{IsAuthEnabled: true})
+	mockTx := new(nucleusdb.MockTx)
+	job := mockJob(mockAccountId, mockUserId, uuid.NewString(), pgtype.Text{})
+	jobId := nucleusdb.UUIDString(job.ID)
+	userUuid, _ := nucleusdb.ToUuid(mockUserId)
+	keptConn := getConnectionMock(mockAccountId, "kept")
+	restoredConn := getConnectionMock(mockAccountId, "restored")
+	removedConnId, _ := nucleusdb.ToUuid(uuid.NewString())
+	keptDestination := mockJobDestConnAssociation(job.ID, keptConn.ID, &pg_models.JobDestinationOptions{})
+	removedDestination := mockJobDestConnAssociation(job.ID, removedConnId, &pg_models.JobDestinationOptions{})
+	truncate := &pg_models.JobDestinationOptions{
+		PostgresOptions: &pg_models.PostgresDestinationOptions{
+			TruncateTableConfig: &pg_models.PostgresTruncateTableConfig{TruncateBeforeInsert: true},
+		},
+	}
+
+	cron := pgtype.Text{}
+	_ = cron.Scan(cronSchedule)
+	version := mockJobVersion(job, 1)
+	version.CronSchedule = cron
+	version.Mappings = []*pg_models.JobMapping{{Schema: "public", Table: "users", Column: "id", JobMappingTransformer: &pg_models.JobMappingTransformerModel{
+		Source: int32(mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_PASSTHROUGH),
+		Config: &pg_models.TransformerConfigs{},
+	}}}
+	version.Destinations = []*pg_models.JobVersionDestination{
+		{ConnectionId: nucleusdb.UUIDString(keptConn.ID), Options: truncate},
+		{ConnectionId: nucleusdb.UUIDString(restoredConn.ID), Options: &pg_models.JobDestinationOptions{}},
+	}
+	newVersion := mockJobVersion(job, 3)
+
+	mockUserAccountCalls(m.UserAccountServiceMock, true)
+	mockDbTransaction(m.DbtxMock, mockTx)
+	mockGetJob(m.UserAccountServiceMock, m.QuerierMock, job, []db_queries.NeosyncApiJobDestinationConnectionAssociation{keptDestination, removedDestination})
+	m.QuerierMock.On("GetJobVersionById", mock.Anything, mock.Anything, version.ID).Return(version, nil)
+	m.QuerierMock.On("AreConnectionsInAccount", mock.Anything, mock.Anything, db_queries.AreConnectionsInAccountParams{
+		AccountId:     job.AccountID,
+		ConnectionIds: []pgtype.UUID{keptConn.ID, restoredConn.ID},
+	}).Return(int64(2), nil)
+	m.QuerierMock.On("UpdateJobSource", mock.Anything, mockTx, db_queries.UpdateJobSourceParams{
+		ID:                job.ID,
+		ConnectionOptions: version.ConnectionOptions,
+		UpdatedByID:       userUuid,
+	}).Return(job, nil)
+	m.QuerierMock.On("UpdateJobMappings", mock.Anything, mockTx, db_queries.UpdateJobMappingsParams{
+		ID:          job.ID,
+		Mappings:    version.Mappings,
+		UpdatedByID: userUuid,
+	}).Return(job, nil)
+	m.QuerierMock.On("UpdateJobSchedule", mock.Anything, mockTx, db_queries.UpdateJobScheduleParams{
+		ID:           job.ID,
+		CronSchedule: cron,
+		UpdatedByID:  userUuid,
+	}).Return(job, nil)
+	m.QuerierMock.On("UpdateJobConnectionDestination", mock.Anything, mockTx, db_queries.UpdateJobConnectionDestinationParams{
+		ID:           keptDestination.ID,
+		ConnectionID: keptConn.ID,
+		Options:      truncate,
+	}).Return(keptDestination, nil)
+	m.QuerierMock.On("RemoveJobConnectionDestinations", mock.Anything, mockTx, []pgtype.UUID{removedDestination.ID}).Return(nil)
+	m.QuerierMock.On("CreateJobConnectionDestinations", mock.Anything, mockTx, []db_queries.CreateJobConnectionDestinationsParams{
+		{JobID: job.ID, ConnectionID: restoredConn.ID, Options: &pg_models.JobDestinationOptions{}},
+	}).Return(int64(1), nil)
+	m.QuerierMock.On("GetLatestJobVersion", mock.Anything, mockTx, job.ID).Return(mockJobVersion(job, 2), nil)
+	m.QuerierMock.On("CreateJobVersion", mock.Anything, mockTx, mock.Anything).Return(newVersion, nil)
+
+	mockHandle := new(MockScheduleHandle)
+	m.TemporalWfManagerMock.On("GetScheduleHandleClientByAccount", mock.Anything, mockAccountId, jobId, mock.Anything).Return(mockHandle, nil)
+	var updatedSchedule *temporalclient.ScheduleUpdate
+	mockHandle.On("Update", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+		options := args.Get(1).(temporalclient.ScheduleUpdateOptions)
+		update, err := options.DoUpdate(temporalclient.ScheduleUpdateInput{
+			Description: temporalclient.ScheduleDescription{
+				Schedule: temporalclient.Schedule{Action: &temporalclient.ScheduleWorkflowAction{}},
+			},
+		})
+		require.NoError(t, err)
+		updatedSchedule = update
+	}).Return(nil)
+
+	resp, err := m.Service.RollbackJobToVersion(context.Background(), connect.NewRequest(&mgmtv1alpha1.RollbackJobToVersionRequest{
+		JobId:     jobId,
+		VersionId: nucleusdb.UUIDString(version.ID),
+	}))
+
+	require.NoError(t, err)
+	assert.Equal(t, int32(3), resp.Msg.Version.Version)
+	assert.Equal(t, jobId, resp.Msg.Job.Id)
+	require.NotNil(t, updatedSchedule)
+	assert.Equal(t, []string{cronSchedule}, updatedSchedule.Schedule.Spec.CronExpressions)
+	action := updatedSchedule.Schedule.Action.(*temporalclient.ScheduleWorkflowAction)
+	assert.Equal(t, nucleusdb.UUIDString(newVersion.ID), action.Memo["jobVersionId"])
+}
+
+func Test_RollbackJobToVersion_ConnectionDeleted(t *testing.T) {
+	m := createServiceMock(t, &Config{IsAuthEnabled: true})
+	job := mockJob(mockAccountId, mockUserId, uuid.NewString(), pgtype.Text{})
+	deletedConnId := uuid.NewString()
+	deletedConnUuid, _ := nucleusdb.ToUuid(deletedConnId)
+	version := mockJobVersion(job, 1)
+	version.Destinations = []*pg_models.JobVersionDestination{{ConnectionId: deletedConnId}}
+
+	mockUserAccountCalls(m.UserAccountServiceMock, true)
+	m.QuerierMock.On("GetJobById", mock.Anything, mock.Anything, job.ID).Return(job, nil)
+	m.QuerierMock.On("GetJobVersionById", mock.Anything, mock.Anything, version.ID).Return(version, nil)
+	m.QuerierMock.On("AreConnectionsInAccount", mock.Anything, mock.Anything, db_queries.AreConnectionsInAccountParams{
+		AccountId:     job.AccountID,
+		ConnectionIds: []pgtype.UUID{deletedConnUuid},
+	}).Return(int64(0), nil)
+
+	resp, err := m.Service.RollbackJobToVersion(context.Background(), connect.NewRequest(&mgmtv1alpha1.RollbackJobToVersionRequest{
+		JobId:     nucleusdb.UUIDString(job.ID),
+		VersionId: nucleusdb.UUIDString(version.ID),
+	}))
+
+	assert.Error(t, err)
+	assert.Nil(t, resp)
+	m.DbtxMock.AssertNotCalled(t, "Begin", mock.Anything)
+}
@@ -0,0 +1,124 @@
+package v1alpha1_jobservice
+
+import (
+	"context"
+
+	"connectrpc.com/connect"
+	db_queries "github.com/nucleuscloud/neosync/backend/gen/go/db"
+	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
+	logger_interceptor "github.com/nucleuscloud/neosync/backend/internal/connect/interceptors/logger"
+	"github.com/nucleuscloud/neosync/backend/internal/dtomaps"
+	nucleuserrors "github.com/nucleuscloud/neosync/backend/internal/errors"
+	"github.com/nucleuscloud/neosync/backend/internal/nucleusdb"
+)
+
+func (s *Service) GetJobWatermarks(
+	ctx context.Context,
+	req *connect.Request[mgmtv1alpha1.GetJobWatermarksRequest],
+) (*connect.Response[mgmtv1alpha1.GetJobWatermarksResponse], error) {
+	job, err := s.getVerifiedJob(ctx, req.Msg.JobId)
+	if err != nil {
+		return nil, err
+	}
+
+	watermarks, err := s.db.Q.GetJobWatermarks(ctx, s.db.Db, job.ID)
+	if err != nil && !nucleusdb.IsNoRows(err) {
+		return nil, err
+	}
+
+	dtos := make([]*mgmtv1alpha1.JobWatermark, len(watermarks))
+	for idx := range watermarks {
+		dtos[idx] = dtomaps.ToJobWatermarkDto(&watermarks[idx])
+	}
+	return connect.NewResponse(&mgmtv1alpha1.GetJobWatermarksResponse{
+		Watermarks: dtos,
+	}), nil
+}
+
+func (s *Service) SetJobRunWatermarks(
+	ctx context.Context,
+	req *connect.Request[mgmtv1alpha1.SetJobRunWatermarksRequest],
+) (*connect.Response[mgmtv1alpha1.SetJobRunWatermarksResponse], error) {
+	logger := logger_interceptor.GetLoggerFromContextOrDefault(ctx)
+	logger = logger.With("jobId", req.Msg.JobId, "jobRunId", req.Msg.JobRunId)
+
+	job, err := s.getVerifiedJob(ctx, req.Msg.JobId)
+	if err != nil {
+		return nil, err
+	}
+	if len(req.Msg.Watermarks) == 0 {
+		return connect.NewResponse(&mgmtv1alpha1.SetJobRunWatermarksResponse{}), nil
+	}
+
+	logger.Info("advancing job watermarks", "tables", len(req.Msg.Watermarks))
+	err = s.db.SetJobRunWatermarks(ctx, job.ID, req.Msg.JobRunId, req.Msg.Watermarks)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&mgmtv1alpha1.SetJobRunWatermarksResponse{}), nil
+}
+
+func (s *Service) ResetJobWatermarks(
+	ctx context.Context,
+	req *connect.Request[mgmtv1alpha1.ResetJobWatermarksRequest],
+) (*connect.Response[mgmtv1alpha1.ResetJobWatermarksResponse], error) {
+	logger := logger_interceptor.GetLoggerFromContextOrDefault(ctx)
+	logger = logger.With("jobId", req.Msg.JobId)
+
+	job, err := s.getVerifiedJob(ctx, req.Msg.JobId)
+	if err != nil {
+		return nil, err
+	}
+
+	logger.Info("resetting job watermarks")
+	err = s.db.ResetJobWatermarks(ctx, job.ID, req.Msg.Tables)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&mgmtv1alpha1.ResetJobWatermarksResponse{}), nil
+}
+
+func (s *Service) getJobRunWatermarks(
+	ctx context.Context,
+	jobId string,
+	jobRunId string,
+) ([]*mgmtv1alpha1.JobRunWatermark, error) {
+	jobUuid, err := nucleusdb.ToUuid(jobId)
+	if err != nil {
+		return nil, err
+	}
+	watermarks, err := s.db.Q.GetJobRunWatermarks(ctx, s.db.Db, db_queries.GetJobRunWatermarksParams{
+		JobID:    jobUuid,
+		JobRunID: jobRunId,
+	})
+	if err != nil && !nucleusdb.IsNoRows(err) {
+		return nil, err
+	}
+	dtos := make([]*mgmtv1alpha1.JobRunWatermark, len(watermarks))
+	for idx := range watermarks {
+		dtos[idx] = dtomaps.ToJobRunWatermarkDto(&watermarks[idx])
+	}
+	return dtos, nil
+}
+
+func (s *Service) getVerifiedJob(
+	ctx context.Context,
+	jobId string,
+) (*db_queries.NeosyncApiJob, error) {
+	jobUuid, err := nucleusdb.ToUuid(jobId)
+	if err != nil {
+		return nil, err
+	}
+	job, err := s.db.Q.GetJobById(ctx, s.db.Db, jobUuid)
+	if err != nil && !nucleusdb.IsNoRows(err) {
+		return nil, err
+	} else if err != nil && nucleusdb.IsNoRows(err) {
+		return nil, nucleuserrors.NewNotFound("unable to find job by id")
+	}
+
+	_, err = s.verifyUserInAccount(ctx, nucleusdb.UUIDString(job.AccountID))
+	if err != nil {
+		return nil, err
+	}
+	return &job, nil
+}
@@ -0,0 +1,136 @@
+package v1alpha1_jobservice
+
+import (
+	"context"
+	"testing"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	db_queries "github.com/nucleuscloud/neosync/backend/gen/go/db"
+	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
+	"github.com/nucleuscloud/neosync/backend/internal/nucleusdb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// GetJobWatermarks
+func Test_GetJobWatermarks(t *testing.T) {
+	m := createServiceMock(t, &Config{IsAuthEnabled: true})
+	job := mockJob(mockAccountId, mockUserId, uuid.NewString(), pgtype.Text{})
+
+	mockIsUserInAccount(m.UserAccountServiceMock, true)
+	m.QuerierMock.On("GetJobById", mock.Anything, mock.Anything, job.ID).Return(job, nil)
+	m.QuerierMock.On("GetJobWatermarks", mock.Anything, mock.Anything, job.ID).Return([]db_queries.NeosyncApiJobWatermark{
+		{JobID: job.ID, SchemaName: "public", TableName: "users", ColumnName: "id", WatermarkValue: "100", JobRunID: "run-1"},
+	}, nil)
+
+	resp, err := m.Service.GetJobWatermarks(context.Background(), connect.NewRequest(&mgmtv1alpha1.GetJobWatermarksRequest{
+		JobId: nucleusdb.UUIDString(job.ID),
+	}))
+
+	assert.NoError(t, err)
+	assert.Len(t, resp.Msg.Watermarks, 1)
+	assert.Equal(t, "public", resp.Msg.Watermarks[0].Schema)
+	assert.Equal(t, "users", resp.Msg.Watermarks[0].Table)
+	assert.Equal(t, "id", resp.Msg.Watermarks[0].Column)
+	assert.Equal(t, "100", resp.Msg.Watermarks[0].Value)
+	assert.Equal(t, "run-1", resp.Msg.Watermarks[0].JobRunId)
+}
+
+func Test_GetJobWatermarks_UnauthorizedUser(t *testing.T) {
+	m := createServiceMock(t, &Config{IsAuthEnabled: true})
+	job := mockJob(mockAccountId, mockUserId, uuid.NewString(), pgtype.Text{})
+
+	mockIsUserInAccount(m.UserAccountServiceMock, false)
+	m.QuerierMock.On("GetJobById", mock.Anything, mock.Anything, job.ID).Return(job, nil)
+
+	resp, err := m.Service.GetJobWatermarks(context.Background(), connect.NewRequest(&mgmtv1alpha1.GetJobWatermarksRequest{
+		JobId: nucleusdb.UUIDString(job.ID),
+	}))
+
+	assert.Error(t, err)
+	assert.Nil(t, resp)
+	m.QuerierMock.AssertNotCalled(t, "GetJobWatermarks", mock.Anything, mock.Anything, mock.Anything)
+}
+
+// SetJobRunWatermarks
+func Test_SetJobRunWatermarks(t *testing.T) {
+	m := createServiceMock(t, &Config{IsAuthEnabled: true})
+	mockTx := new(nucleusdb.MockTx)
+	job := mockJob(mockAccountId, mockUserId, uuid.NewString(), pgtype.Text{})
+	startValue := "100"
+
+	mockIsUserInAccount(m.UserAccountServiceMock, true)
+	mockDbTransaction(m.DbtxMock, mockTx)
+	m.QuerierMock.On("GetJobById", mock.Anything, mock.Anything, job.ID).Return(job, nil)
+	m.QuerierMock.On("CreateJobRunWatermark", mock.Anything, mockTx, db_queries.CreateJobRunWatermarkParams{
+		JobID:      job.ID,
+		JobRunID:   "run-2",
+		SchemaName: "public",
+		TableName:  "users",
+		ColumnName: "id",
+		StartValue: pgtype.Text{String: "100", Valid: true},
+		EndValue:   "250",
+	}).Return(nil)
+	m.QuerierMock.On("SetJobWatermark", mock.Anything, mockTx, db_queries.SetJobWatermarkParams{
+		JobID:          job.ID,
+		SchemaName:     "public",
+		TableName:      "users",
+		ColumnName:     "id",
+		WatermarkValue: "250",
+		JobRunID:       "run-2",
+	}).Return(db_queries.NeosyncApiJobWatermark{}, nil)
+
+	resp, err := m.Service.SetJobRunWatermarks(context.Background(), connect.NewRequest(&mgmtv1alpha1.SetJobRunWatermarksRequest{
+		JobId:    nucleusdb.UUIDString(job.ID),
+		JobRunId: "run-2",
+		Watermarks: []*mgmtv1alpha1.JobRunWatermark{
+			{Schema: "public", Table: "users", Column: "id", StartValue: &startValue, EndValue: "250"},
+		},
+	}))
+
+	assert.NoError(t, err)
+	assert.NotNil(t, resp)
+}
+
+// ResetJobWatermarks
+func Test_ResetJobWatermarks_All(t *testing.T) {
+	m := createServiceMock(t, &Config{IsAuthEnabled: true})
+	job := mockJob(mockAccountId, mockUserId, uuid.NewString(), pgtype.Text{})
+
+	mockIsUserInAccount(m.UserAccountServiceMock, true)
+	m.QuerierMock.On("GetJobById", mock.Anything, mock.Anything, job.ID).Return(job, nil)
+	m.QuerierMock.On("RemoveJobWatermarks", mock.Anything, mock.Anything, job.ID).Return(nil)
+
+	resp, err := m.Service.ResetJobWatermarks(context.Background(), connect.NewRequest(&mgmtv1alpha1.ResetJobWatermarksRequest{
+		JobId: nucleusdb.UUIDString(job.ID),
+	}))
+
+	assert.NoError(t, err)
+	assert.NotNil(t, resp)
+}
+
+func Test_ResetJobWatermarks_Tables(t *testing.T) {
+	m := createServiceMock(t, &Config{IsAuthEnabled: true})
+	mockTx := new(nucleusdb.MockTx)
+	job := mockJob(mockAccountId, mockUserId, uuid.NewString(), pgtype.Text{})
+
+	mockIsUserInAccount(m.UserAccountServiceMock, true)
+	mockDbTransaction(m.DbtxMock, mockTx)
+	m.QuerierMock.On("GetJobById", mock.Anything, mock.Anything, job.ID).Return(job, nil)
+	m.QuerierMock.On("RemoveJobWatermark", mock.Anything, mockTx, db_queries.RemoveJobWatermarkParams{
+		JobID:      job.ID,
+		SchemaName: "public",
+		TableName:  "users",
+	}).Return(nil)
+
+	resp, err := m.Service.ResetJobWatermarks(context.Background(), connect.NewRequest(&mgmtv1alpha1.ResetJobWatermarksRequest{
+		JobId:  nucleusdb.UUIDString(job.ID),
+		Tables: []*mgmtv1alpha1.JobWatermarkTable{{Schema: "public", Table: "users"}},
+	}))
+
+	assert.NoError(t, err)
+	assert.NotNil(t, resp)
+	m.QuerierMock.AssertNotCalled(t, "RemoveJobWatermarks", mock.Anything, mock.Anything, mock.Anything)
+}
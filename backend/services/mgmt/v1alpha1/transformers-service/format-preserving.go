@@ -0,0 +1,58 @@
+package v1alpha1_transformersservice
+
+import (
+	"context"
+	"fmt"
+
+	"connectrpc.com/connect"
+	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
+	nucleuserrors "github.com/nucleuscloud/neosync/backend/internal/errors"
+	"github.com/nucleuscloud/neosync/backend/pkg/fpe"
+)
+
+func (s *Service) DecryptFormatPreserving(
+	ctx context.Context,
+	req *connect.Request[mgmtv1alpha1.DecryptFormatPreservingRequest],
+) (*connect.Response[mgmtv1alpha1.DecryptFormatPreservingResponse], error) {
+	_, err := s.verifyUserInAccount(ctx, req.Msg.AccountId)
+	if err != nil {
+		return nil, err
+	}
+
+	algorithm, err := toFpeAlgorithm(req.Msg.Algorithm)
+	if err != nil {
+		return nil, nucleuserrors.NewBadRequest(err.Error())
+	}
+
+	keyResp, err := s.useraccountService.GetAccountTransformerKey(ctx, connect.NewRequest(&mgmtv1alpha1.GetAccountTransformerKeyRequest{
+		AccountId: req.Msg.AccountId,
+	}))
+	if err != nil {
+		return nil, err
+	}
+	key, err := fpe.DeriveKey(keyResp.Msg.Key)
+	if err != nil {
+		return nil, err
+	}
+
+	value, err := fpe.Decrypt(key, algorithm, req.Msg.Tweak, req.Msg.Value)
+	if err != nil {
+		return nil, nucleuserrors.NewBadRequest(fmt.Sprintf("unable to decrypt value: %s", err.Error()))
+	}
+
+	return connect.NewResponse(&mgmtv1alpha1.DecryptFormatPreservingResponse{
+		Value: value,
+	}), nil
+}
+
+func toFpeAlgorithm(algorithm mgmtv1alpha1.FormatPreservingEncryptionAlgorithm) (fpe.Algorithm, error) {
+	switch algorithm {
+	case mgmtv1alpha1.FormatPreservingEncryptionAlgorithm_FORMAT_PRESERVING_ENCRYPTION_ALGORITHM_UNSPECIFIED,
+		mgmtv1alpha1.FormatPreservingEncryptionAlgorithm_FORMAT_PRESERVING_ENCRYPTION_ALGORITHM_FF1:
+		return fpe.AlgorithmFF1, nil
+	case mgmtv1alpha1.FormatPreservingEncryptionAlgorithm_FORMAT_PRESERVING_ENCRYPTION_ALGORITHM_FF3_1:
+		return fpe.AlgorithmFF31, nil
+	default:
+		return "", fmt.Errorf("unsupported format preserving encryption algorithm: %s", algorithm.String())
+	}
+}
@@ -0,0 +1,74 @@
+package v1alpha1_transformersservice
+
+import (
+	"context"
+	"testing"
+
+	"connectrpc.com/connect"
+	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
+	"github.com/nucleuscloud/neosync/backend/pkg/fpe"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+const mockTransformerKey = "0123456789abcdef0123456789abcdef"
+
+func Test_DecryptFormatPreserving(t *testing.T) {
+	m := createServiceMock(t)
+	mockIsUserInAccount(m.UserAccountServiceMock, true)
+	mockGetAccountTransformerKey(m)
+
+	key, err := fpe.DeriveKey(mockTransformerKey)
+	require.NoError(t, err)
+	encrypted, err := fpe.Encrypt(key, fpe.AlgorithmFF31, "tweak", "4111-1111-1111-1111")
+	require.NoError(t, err)
+
+	resp, err := m.Service.DecryptFormatPreserving(context.Background(), connect.NewRequest(&mgmtv1alpha1.DecryptFormatPreservingRequest{
+		AccountId: mockAccountId,
+		Value:     encrypted,
+		Algorithm: mgmtv1alpha1.FormatPreservingEncryptionAlgorithm_FORMAT_PRESERVING_ENCRYPTION_ALGORITHM_FF3_1,
+		Tweak:     "tweak",
+	}))
+
+	assert.NoError(t, err)
+	assert.Equal(t, "4111-1111-1111-1111", resp.Msg.Value)
+}
+
+func Test_DecryptFormatPreserving_Defaults_To_FF1(t *testing.T) {
+	m := createServiceMock(t)
+	mockIsUserInAccount(m.UserAccountServiceMock, true)
+	mockGetAccountTransformerKey(m)
+
+	key, err := fpe.DeriveKey(mockTransformerKey)
+	require.NoError(t, err)
+	encrypted, err := fpe.Encrypt(key, fpe.AlgorithmFF1, "", "Jane Doe")
+	require.NoError(t, err)
+
+	resp, err := m.Service.DecryptFormatPreserving(context.Background(), connect.NewRequest(&mgmtv1alpha1.DecryptFormatPreservingRequest{
+		AccountId: mockAccountId,
+		Value:     encrypted,
+	}))
+
+	assert.NoError(t, err)
+	assert.Equal(t, "Jane Doe", resp.Msg.Value)
+}
+
+func Test_DecryptFormatPreserving_UnverifiedUser(t *testing.T) {
+	m := createServiceMock(t)
+	mockIsUserInAccount(m.UserAccountServiceMock, false)
+
+	resp, err := m.Service.DecryptFormatPreserving(context.Background(), connect.NewRequest(&mgmtv1alpha1.DecryptFormatPreservingRequest{
+		AccountId: mockAccountId,
+		Value:     "1234567890",
+	}))
+
+	assert.Error(t, err)
+	assert.Nil(t, resp)
+	m.UserAccountServiceMock.AssertNotCalled(t, "GetAccountTransformerKey", mock.Anything, mock.Anything)
+}
+
+func mockGetAccountTransformerKey(m *serviceMocks) {
+	m.UserAccountServiceMock.On("GetAccountTransformerKey", mock.Anything, mock.Anything).
+		Return(connect.NewResponse(&mgmtv1alpha1.GetAccountTransformerKeyResponse{Key: mockTransformerKey}), nil)
+}
@@ -505,6 +505,174 @@ var (
 				},
 			},
 		},
+		{
+			Name:        "Transform Format Preserving Encrypt",
+			Description: "Encrypts a string value using format preserving encryption with the account's transformer key. The length and character classes of the value are preserved and the value can be decrypted again by authorized users.",
+			DataType:    mgmtv1alpha1.TransformerDataType_TRANSFORMER_DATA_TYPE_STRING,
+			Source:      mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_TRANSFORM_FORMAT_PRESERVING_ENCRYPT,
+			Config: &mgmtv1alpha1.TransformerConfig{
+				Config: &mgmtv1alpha1.TransformerConfig_TransformFormatPreservingEncryptConfig{
+					TransformFormatPreservingEncryptConfig: &mgmtv1alpha1.TransformFormatPreservingEncrypt{
+						Algorithm: mgmtv1alpha1.FormatPreservingEncryptionAlgorithm_FORMAT_PRESERVING_ENCRYPTION_ALGORITHM_FF1,
+						Tweak:     "",
+					},
+				},
+			},
+		},
+		{
+			Name:        "Transform Timestamp",
+			Description: "Transforms an existing timestamp by shifting it by a consistent offset per entity, adding random jitter, truncating it to its month or year or clamping it to a range.",
+			DataType:    mgmtv1alpha1.TransformerDataType_TRANSFORMER_DATA_TYPE_TIME,
+			Source:      mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_TRANSFORM_TIMESTAMP,
+			Config: &mgmtv1alpha1.TransformerConfig{
+				Config: &mgmtv1alpha1.TransformerConfig_TransformTimestampConfig{
+					TransformTimestampConfig: &mgmtv1alpha1.TransformTimestamp{
+						Mode:           mgmtv1alpha1.TimeTransformMode_TIME_TRANSFORM_MODE_SHIFT,
+						ShiftRangeDays: 30,
+					},
+				},
+			},
+		},
+		{
+			Name:        "Transform Date",
+			Description: "Transforms an existing date by shifting it by a consistent offset per entity, adding random jitter, truncating it to its month or year or clamping it to a range.",
+			DataType:    mgmtv1alpha1.TransformerDataType_TRANSFORMER_DATA_TYPE_TIME,
+			Source:      mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_TRANSFORM_DATE,
+			Config: &mgmtv1alpha1.TransformerConfig{
+				Config: &mgmtv1alpha1.TransformerConfig_TransformDateConfig{
+					TransformDateConfig: &mgmtv1alpha1.TransformDate{
+						Mode:           mgmtv1alpha1.TimeTransformMode_TIME_TRANSFORM_MODE_SHIFT,
+						ShiftRangeDays: 30,
+					},
+				},
+			},
+		},
+		{
+			Name:        "Transform JSON",
+			Description: "Transforms the values inside of a JSON document. Each rule selects values with a JSONPath expression and transforms them with another transformer.",
+			DataType:    mgmtv1alpha1.TransformerDataType_TRANSFORMER_DATA_TYPE_ANY,
+			Source:      mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_TRANSFORM_JSON,
+			Config: &mgmtv1alpha1.TransformerConfig{
+				Config: &mgmtv1alpha1.TransformerConfig_TransformJsonConfig{
+					TransformJsonConfig: &mgmtv1alpha1.TransformJson{
+						Rules: []*mgmtv1alpha1.TransformJsonRule{},
+					},
+				},
+			},
+		},
+		{
+			Name:        "Transform Free Text",
+			Description: "Detects emails, phone numbers, card numbers and names in free text, such as notes and comments, and replaces them with a masked token or a fake value of the same type.",
+			DataType:    mgmtv1alpha1.TransformerDataType_TRANSFORMER_DATA_TYPE_STRING,
+			Source:      mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_TRANSFORM_FREE_TEXT,
+			Config: &mgmtv1alpha1.TransformerConfig{
+				Config: &mgmtv1alpha1.TransformerConfig_TransformFreeTextConfig{
+					TransformFreeTextConfig: &mgmtv1alpha1.TransformFreeText{
+						EntityTypes: []mgmtv1alpha1.FreeTextEntityType{},
+						Strategy:    mgmtv1alpha1.FreeTextReplacementStrategy_FREE_TEXT_REPLACEMENT_STRATEGY_MASK,
+					},
+				},
+			},
+		},
+		{
+			Name:        "Generate IP Address",
+			Description: "Generates a random IPv4 or IPv6 address.",
+			DataType:    mgmtv1alpha1.TransformerDataType_TRANSFORMER_DATA_TYPE_STRING,
+			Source:      mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_GENERATE_IP_ADDRESS,
+			Config: &mgmtv1alpha1.TransformerConfig{
+				Config: &mgmtv1alpha1.TransformerConfig_GenerateIpAddressConfig{
+					GenerateIpAddressConfig: &mgmtv1alpha1.GenerateIpAddress{
+						Version: mgmtv1alpha1.IpAddressVersion_IP_ADDRESS_VERSION_V4,
+					},
+				},
+			},
+		},
+		{
+			Name:        "Transform IP Address",
+			Description: "Transforms an existing IPv4 or IPv6 address while optionally preserving its subnet prefix or class.",
+			DataType:    mgmtv1alpha1.TransformerDataType_TRANSFORMER_DATA_TYPE_STRING,
+			Source:      mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_TRANSFORM_IP_ADDRESS,
+			Config: &mgmtv1alpha1.TransformerConfig{
+				Config: &mgmtv1alpha1.TransformerConfig_TransformIpAddressConfig{
+					TransformIpAddressConfig: &mgmtv1alpha1.TransformIpAddress{
+						PreservePrefixLength: 0,
+						PreserveClass:        false,
+					},
+				},
+			},
+		},
+		{
+			Name:        "Generate MAC Address",
+			Description: "Generates a random MAC address.",
+			DataType:    mgmtv1alpha1.TransformerDataType_TRANSFORMER_DATA_TYPE_STRING,
+			Source:      mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_GENERATE_MAC_ADDRESS,
+			Config: &mgmtv1alpha1.TransformerConfig{
+				Config: &mgmtv1alpha1.TransformerConfig_GenerateMacAddressConfig{
+					GenerateMacAddressConfig: &mgmtv1alpha1.GenerateMacAddress{},
+				},
+			},
+		},
+		{
+			Name:        "Transform MAC Address",
+			Description: "Transforms an existing MAC address while optionally preserving the manufacturer prefix.",
+			DataType:    mgmtv1alpha1.TransformerDataType_TRANSFORMER_DATA_TYPE_STRING,
+			Source:      mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_TRANSFORM_MAC_ADDRESS,
+			Config: &mgmtv1alpha1.TransformerConfig{
+				Config: &mgmtv1alpha1.TransformerConfig_TransformMacAddressConfig{
+					TransformMacAddressConfig: &mgmtv1alpha1.TransformMacAddress{
+						PreserveOui: false,
+					},
+				},
+			},
+		},
+		{
+			Name:        "Generate URL",
+			Description: "Generates a random URL.",
+			DataType:    mgmtv1alpha1.TransformerDataType_TRANSFORMER_DATA_TYPE_STRING,
+			Source:      mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_GENERATE_URL,
+			Config: &mgmtv1alpha1.TransformerConfig{
+				Config: &mgmtv1alpha1.TransformerConfig_GenerateUrlConfig{
+					GenerateUrlConfig: &mgmtv1alpha1.GenerateUrl{},
+				},
+			},
+		},
+		{
+			Name:        "Transform URL",
+			Description: "Transforms an existing URL by replacing its host and query values while keeping its scheme and the shape of its path.",
+			DataType:    mgmtv1alpha1.TransformerDataType_TRANSFORMER_DATA_TYPE_STRING,
+			Source:      mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_TRANSFORM_URL,
+			Config: &mgmtv1alpha1.TransformerConfig{
+				Config: &mgmtv1alpha1.TransformerConfig_TransformUrlConfig{
+					TransformUrlConfig: &mgmtv1alpha1.TransformUrl{
+						PreservePath: false,
+					},
+				},
+			},
+		},
+		{
+			Name:        "Generate Domain",
+			Description: "Generates a random domain name.",
+			DataType:    mgmtv1alpha1.TransformerDataType_TRANSFORMER_DATA_TYPE_STRING,
+			Source:      mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_GENERATE_DOMAIN,
+			Config: &mgmtv1alpha1.TransformerConfig{
+				Config: &mgmtv1alpha1.TransformerConfig_GenerateDomainConfig{
+					GenerateDomainConfig: &mgmtv1alpha1.GenerateDomain{},
+				},
+			},
+		},
+		{
+			Name:        "Transform Domain",
+			Description: "Transforms an existing domain name while optionally preserving its top level domain.",
+			DataType:    mgmtv1alpha1.TransformerDataType_TRANSFORMER_DATA_TYPE_STRING,
+			Source:      mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_TRANSFORM_DOMAIN,
+			Config: &mgmtv1alpha1.TransformerConfig{
+				Config: &mgmtv1alpha1.TransformerConfig_TransformDomainConfig{
+					TransformDomainConfig: &mgmtv1alpha1.TransformDomain{
+						PreserveTld: true,
+					},
+				},
+			},
+		},
 		{
 			Name:        "Generate Javascript",
 			Description: "Write custom Javascript to generate synthetic data.",
@@ -0,0 +1,68 @@
+package v1alpha1_transformersservice
+
+import (
+	"context"
+	"fmt"
+
+	"connectrpc.com/connect"
+	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
+	"github.com/nucleuscloud/neosync/backend/pkg/jsonpath"
+)
+
+// Transformers that can not be run on a value inside of a JSON document.
+// Javascript transformers run in their own processor, user defined transformers must be resolved first, and passthrough and default have no meaning inside of a document.
+var unsupportedTransformJsonSources = map[mgmtv1alpha1.TransformerSource]struct{}{
+	mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_UNSPECIFIED:          {},
+	mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_PASSTHROUGH:          {},
+	mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_GENERATE_DEFAULT:     {},
+	mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_TRANSFORM_JAVASCRIPT: {},
+	mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_GENERATE_JAVASCRIPT:  {},
+	mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_USER_DEFINED:         {},
+	mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_TRANSFORM_JSON:       {},
+}
+
+func (s *Service) ValidateTransformJsonConfig(
+	ctx context.Context,
+	req *connect.Request[mgmtv1alpha1.ValidateTransformJsonConfigRequest],
+) (*connect.Response[mgmtv1alpha1.ValidateTransformJsonConfigResponse], error) {
+	_, err := s.verifyUserInAccount(ctx, req.Msg.AccountId)
+	if err != nil {
+		return nil, err
+	}
+
+	validationErrors := getTransformJsonConfigErrors(req.Msg.Config)
+	return connect.NewResponse(&mgmtv1alpha1.ValidateTransformJsonConfigResponse{
+		Valid:  len(validationErrors) == 0,
+		Errors: validationErrors,
+	}), nil
+}
+
+func getTransformJsonConfigErrors(config *mgmtv1alpha1.TransformJson) []string {
+	if len(config.GetRules()) == 0 {
+		return []string{"must provide at least one rule"}
+	}
+
+	validationErrors := []string{}
+	for idx, rule := range config.GetRules() {
+		if _, err := jsonpath.Parse(rule.GetPath()); err != nil {
+			validationErrors = append(validationErrors, fmt.Sprintf("rule %d: invalid path: %s", idx+1, err.Error()))
+		}
+
+		if _, ok := unsupportedTransformJsonSources[rule.GetSource()]; ok {
+			validationErrors = append(validationErrors, fmt.Sprintf("rule %d: %s is not supported in a transform json rule", idx+1, rule.GetSource().String()))
+			continue
+		}
+		systemTransformer, ok := systemTransformerSourceMap[rule.GetSource()]
+		if !ok {
+			validationErrors = append(validationErrors, fmt.Sprintf("rule %d: unknown transformer source %s", idx+1, rule.GetSource().String()))
+			continue
+		}
+		// the config must be of the same type as the config of the system transformer with the same source
+		if rule.GetConfig().GetConfig() == nil {
+			validationErrors = append(validationErrors, fmt.Sprintf("rule %d: must provide a transformer config", idx+1))
+		} else if fmt.Sprintf("%T", rule.GetConfig().GetConfig()) != fmt.Sprintf("%T", systemTransformer.GetConfig().GetConfig()) {
+			validationErrors = append(validationErrors, fmt.Sprintf("rule %d: transformer config does not match source %s", idx+1, rule.GetSource().String()))
+		}
+	}
+	return validationErrors
+}
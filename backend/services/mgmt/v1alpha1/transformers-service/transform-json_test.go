@@ -0,0 +1,112 @@
+package v1alpha1_transformersservice
+
+import (
+	"context"
+	"testing"
+
+	"connectrpc.com/connect"
+	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_ValidateTransformJsonConfig(t *testing.T) {
+	m := createServiceMock(t)
+	mockIsUserInAccount(m.UserAccountServiceMock, true)
+
+	resp, err := m.Service.ValidateTransformJsonConfig(context.Background(), connect.NewRequest(&mgmtv1alpha1.ValidateTransformJsonConfigRequest{
+		AccountId: mockAccountId,
+		Config: &mgmtv1alpha1.TransformJson{
+			Rules: []*mgmtv1alpha1.TransformJsonRule{
+				{
+					Path:   "$.contact.email",
+					Source: mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_TRANSFORM_EMAIL,
+					Config: &mgmtv1alpha1.TransformerConfig{
+						Config: &mgmtv1alpha1.TransformerConfig_TransformEmailConfig{
+							TransformEmailConfig: &mgmtv1alpha1.TransformEmail{PreserveDomain: true},
+						},
+					},
+				},
+				{
+					Path:   "$.tags[*]",
+					Source: mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_TRANSFORM_STRING,
+					Config: &mgmtv1alpha1.TransformerConfig{
+						Config: &mgmtv1alpha1.TransformerConfig_TransformStringConfig{
+							TransformStringConfig: &mgmtv1alpha1.TransformString{},
+						},
+					},
+				},
+			},
+		},
+	}))
+
+	require.NoError(t, err)
+	assert.True(t, resp.Msg.Valid)
+	assert.Empty(t, resp.Msg.Errors)
+}
+
+func Test_ValidateTransformJsonConfig_Invalid(t *testing.T) {
+	m := createServiceMock(t)
+	mockIsUserInAccount(m.UserAccountServiceMock, true)
+
+	resp, err := m.Service.ValidateTransformJsonConfig(context.Background(), connect.NewRequest(&mgmtv1alpha1.ValidateTransformJsonConfigRequest{
+		AccountId: mockAccountId,
+		Config: &mgmtv1alpha1.TransformJson{
+			Rules: []*mgmtv1alpha1.TransformJsonRule{
+				{
+					Path:   "contact..email",
+					Source: mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_TRANSFORM_EMAIL,
+					Config: &mgmtv1alpha1.TransformerConfig{
+						Config: &mgmtv1alpha1.TransformerConfig_TransformStringConfig{
+							TransformStringConfig: &mgmtv1alpha1.TransformString{},
+						},
+					},
+				},
+				{
+					Path:   "$.notes",
+					Source: mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_TRANSFORM_JAVASCRIPT,
+				},
+				{
+					Path:   "$.name",
+					Source: mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_TRANSFORM_FULL_NAME,
+				},
+			},
+		},
+	}))
+
+	require.NoError(t, err)
+	assert.False(t, resp.Msg.Valid)
+	assert.Equal(t, []string{
+		"rule 1: invalid path: path must start with $",
+		"rule 1: transformer config does not match source TRANSFORMER_SOURCE_TRANSFORM_EMAIL",
+		"rule 2: TRANSFORMER_SOURCE_TRANSFORM_JAVASCRIPT is not supported in a transform json rule",
+		"rule 3: must provide a transformer config",
+	}, resp.Msg.Errors)
+}
+
+func Test_ValidateTransformJsonConfig_NoRules(t *testing.T) {
+	m := createServiceMock(t)
+	mockIsUserInAccount(m.UserAccountServiceMock, true)
+
+	resp, err := m.Service.ValidateTransformJsonConfig(context.Background(), connect.NewRequest(&mgmtv1alpha1.ValidateTransformJsonConfigRequest{
+		AccountId: mockAccountId,
+		Config:    &mgmtv1alpha1.TransformJson{},
+	}))
+
+	require.NoError(t, err)
+	assert.False(t, resp.Msg.Valid)
+	assert.Equal(t, []string{"must provide at least one rule"}, resp.Msg.Errors)
+}
+
+func Test_ValidateTransformJsonConfig_UnverifiedUser(t *testing.T) {
+	m := createServiceMock(t)
+	mockIsUserInAccount(m.UserAccountServiceMock, false)
+
+	resp, err := m.Service.ValidateTransformJsonConfig(context.Background(), connect.NewRequest(&mgmtv1alpha1.ValidateTransformJsonConfigRequest{
+		AccountId: mockAccountId,
+		Config:    &mgmtv1alpha1.TransformJson{},
+	}))
+
+	assert.Error(t, err)
+	assert.Nil(t, resp)
+}
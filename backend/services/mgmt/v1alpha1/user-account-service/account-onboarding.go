@@ -37,6 +37,9 @@ func (s *Service) GetAccountOnboardingConfig(
 	if count == 0 {
 		return nil, nucleuserrors.NewForbidden("user is not in account")
 	}
+	if err := s.verifyUserAccountRole(ctx, accountUuid, userUuid); err != nil {
+		return nil, err
+	}
 
 	oc, err := s.db.Q.GetAccountOnboardingConfig(ctx, s.db.Db, accountUuid)
 	if err != nil {
@@ -76,6 +79,9 @@ func (s *Service) SetAccountOnboardingConfig(
 	if count == 0 {
 		return nil, nucleuserrors.NewForbidden("user is not in account")
 	}
+	if err := s.verifyUserAccountRole(ctx, accountUuid, userUuid); err != nil {
+		return nil, err
+	}
 
 	tc := &pg_models.AccountOnboardingConfig{}
 	if req.Msg.Config != nil {
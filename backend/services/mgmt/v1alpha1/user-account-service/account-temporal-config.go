@@ -40,6 +40,9 @@ func (s *Service) GetAccountTemporalConfig(
 	if count == 0 {
 		return nil, nucleuserrors.NewForbidden("user is not in account")
 	}
+	if err := s.verifyUserAccountRole(ctx, accountUuid, userUuid); err != nil {
+		return nil, err
+	}
 
 	tc, err := s.temporalClientManager.GetTemporalConfigByAccount(ctx, req.Msg.AccountId)
 	if err != nil {
@@ -82,6 +85,9 @@ func (s *Service) SetAccountTemporalConfig(
 	if count == 0 {
 		return nil, nucleuserrors.NewForbidden("user is not in account")
 	}
+	if err := s.verifyUserAccountRole(ctx, accountUuid, userUuid); err != nil {
+		return nil, err
+	}
 
 	tc := &pg_models.TemporalConfig{}
 	tc.FromDto(req.Msg.Config)
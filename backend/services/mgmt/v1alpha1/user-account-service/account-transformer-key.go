@@ -0,0 +1,159 @@
+package v1alpha1_useraccountservice
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+
+	"connectrpc.com/connect"
+	"github.com/jackc/pgx/v5/pgtype"
+	db_queries "github.com/nucleuscloud/neosync/backend/gen/go/db"
+	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
+	logger_interceptor "github.com/nucleuscloud/neosync/backend/internal/connect/interceptors/logger"
+	nucleuserrors "github.com/nucleuscloud/neosync/backend/internal/errors"
+	pg_models "github.com/nucleuscloud/neosync/backend/sql/postgresql/models"
+)
+
+const transformerKeyByteLength = 32
+
+func (s *Service) GetAccountTransformerKey(
+	ctx context.Context,
+	req *connect.Request[mgmtv1alpha1.GetAccountTransformerKeyRequest],
+) (*connect.Response[mgmtv1alpha1.GetAccountTransformerKeyResponse], error) {
+	accountUuid, err := s.verifyUserInAccount(ctx, req.Msg.AccountId)
+	if err != nil {
+		return nil, err
+	}
+
+	key, err := s.db.Q.GetAccountTransformerKey(ctx, s.db.Db, *accountUuid)
+	if err != nil {
+		return nil, err
+	}
+	if key.Valid {
+		return connect.NewResponse(&mgmtv1alpha1.GetAccountTransformerKeyResponse{
+			Key: key.String,
+		}), nil
+	}
+
+	newKey, err := generateTransformerKey()
+	if err != nil {
+		return nil, err
+	}
+	// only sets the key if it has not been set in the meantime so that concurrent callers all receive the same key
+	key, err = s.db.Q.InitAccountTransformerKey(ctx, s.db.Db, db_queries.InitAccountTransformerKeyParams{
+		TransformerKey: newKey,
+		AccountId:      *accountUuid,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if !key.Valid {
+		return nil, errors.New("unable to initialize account transformer key")
+	}
+
+	return connect.NewResponse(&mgmtv1alpha1.GetAccountTransformerKeyResponse{
+		Key: key.String,
+	}), nil
+}
+
+func (s *Service) SetAccountTransformerKey(
+	ctx context.Context,
+	req *connect.Request[mgmtv1alpha1.SetAccountTransformerKeyRequest],
+) (*connect.Response[mgmtv1alpha1.SetAccountTransformerKeyResponse], error) {
+	accountUuid, err := s.verifyUserInAccount(ctx, req.Msg.AccountId)
+	if err != nil {
+		return nil, err
+	}
+
+	inUse, err := s.isFormatPreservingEncryptionInUse(ctx, *accountUuid)
+	if err != nil {
+		return nil, err
+	}
+	if inUse {
+		if !req.Msg.Force {
+			return nil, nucleuserrors.NewBadRequest(
+				"the transformer key is used by format preserving encrypt transformers and values encrypted with it can no longer be decrypted once it is changed, set force to change it anyway",
+			)
+		}
+		logger_interceptor.GetLoggerFromContextOrDefault(ctx).Warn(
+			"changing the transformer key while format preserving encrypt transformers are in use, previously encrypted values can no longer be decrypted",
+			"accountId", req.Msg.AccountId,
+		)
+	}
+
+	newKey := req.Msg.GetKey()
+	if req.Msg.Key == nil {
+		newKey, err = generateTransformerKey()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	key, err := s.db.Q.UpdateAccountTransformerKey(ctx, s.db.Db, db_queries.UpdateAccountTransformerKeyParams{
+		TransformerKey: newKey,
+		AccountId:      *accountUuid,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&mgmtv1alpha1.SetAccountTransformerKeyResponse{
+		Key: key.String,
+	}), nil
+}
+
+// Returns true if a job mapping or user defined transformer of the account uses the format preserving encrypt transformer,
+// as the values it encrypted can only be decrypted with the current transformer key.
+// Every user defined transformer of the account is checked, which covers the ones that are referenced by job mappings or json rules.
+func (s *Service) isFormatPreservingEncryptionInUse(ctx context.Context, accountUuid pgtype.UUID) (bool, error) {
+	jobs, err := s.db.Q.GetJobsByAccount(ctx, s.db.Db, accountUuid)
+	if err != nil {
+		return false, err
+	}
+	for idx := range jobs {
+		for _, mapping := range jobs[idx].Mappings {
+			if mapping.JobMappingTransformer != nil && isFormatPreservingEncryptConfig(mapping.JobMappingTransformer.Config) {
+				return true, nil
+			}
+		}
+	}
+
+	transformers, err := s.db.Q.GetUserDefinedTransformersByAccount(ctx, s.db.Db, accountUuid)
+	if err != nil {
+		return false, err
+	}
+	for idx := range transformers {
+		if isFormatPreservingEncryptConfig(transformers[idx].TransformerConfig) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// Walks the transformer configs that are nested in the config, such as the rules of the json transformer
+func isFormatPreservingEncryptConfig(config *pg_models.TransformerConfigs) bool {
+	if config == nil {
+		return false
+	}
+	if config.TransformFormatPreservingEncrypt != nil {
+		return true
+	}
+	if config.TransformJson != nil {
+		for _, rule := range config.TransformJson.Rules {
+			if rule != nil && isFormatPreservingEncryptConfig(rule.Config) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func generateTransformerKey() (string, error) {
+	bits := make([]byte, transformerKeyByteLength)
+	_, err := rand.Read(bits)
+	if err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(bits), nil
+}
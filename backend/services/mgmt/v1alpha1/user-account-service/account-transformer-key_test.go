@@ -0,0 +1,226 @@
+package v1alpha1_useraccountservice
+
+import (
+	"context"
+	"testing"
+
+	"connectrpc.com/connect"
+	"github.com/jackc/pgx/v5/pgtype"
+	db_queries "github.com/nucleuscloud/neosync/backend/gen/go/db"
+	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
+	"github.com/nucleuscloud/neosync/backend/internal/apikey"
+	auth_apikey "github.com/nucleuscloud/neosync/backend/internal/auth/apikey"
+	pg_models "github.com/nucleuscloud/neosync/backend/sql/postgresql/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func Test_Service_GetAccountTransformerKey(t *testing.T) {
+	m := createServiceMock(t, &Config{IsAuthEnabled: false})
+	mockUserInAccount(m)
+	m.QuerierMock.On("GetAccountTransformerKey", mock.Anything, mock.Anything, mock.Anything).
+		Return(pgtype.Text{String: "existing-key", Valid: true}, nil)
+
+	resp, err := m.Service.GetAccountTransformerKey(context.Background(), connect.NewRequest(&mgmtv1alpha1.GetAccountTransformerKeyRequest{
+		AccountId: mockAccountId,
+	}))
+
+	assert.NoError(t, err)
+	assert.Equal(t, "existing-key", resp.Msg.Key)
+	m.QuerierMock.AssertNotCalled(t, "InitAccountTransformerKey", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func Test_Service_GetAccountTransformerKey_Initializes_Key(t *testing.T) {
+	m := createServiceMock(t, &Config{IsAuthEnabled: false})
+	mockUserInAccount(m)
+	m.QuerierMock.On("GetAccountTransformerKey", mock.Anything, mock.Anything, mock.Anything).
+		Return(pgtype.Text{}, nil)
+	m.QuerierMock.On("InitAccountTransformerKey", mock.Anything, mock.Anything, mock.Anything).
+		Return(pgtype.Text{String: "new-key", Valid: true}, nil)
+
+	resp, err := m.Service.GetAccountTransformerKey(context.Background(), connect.NewRequest(&mgmtv1alpha1.GetAccountTransformerKeyRequest{
+		AccountId: mockAccountId,
+	}))
+
+	assert.NoError(t, err)
+	assert.Equal(t, "new-key", resp.Msg.Key)
+}
+
+func Test_Service_GetAccountTransformerKey_WorkerApiKey(t *testing.T) {
+	m := createServiceMock(t, &Config{IsAuthEnabled: true})
+	m.QuerierMock.On("GetAccountTransformerKey", mock.Anything, mock.Anything, mock.Anything).
+		Return(pgtype.Text{String: "existing-key", Valid: true}, nil)
+
+	ctx := context.WithValue(context.Background(), auth_apikey.TokenContextKey{}, &auth_apikey.TokenContextData{
+		RawToken:   apikey.NewV1WorkerKey(),
+		ApiKeyType: apikey.WorkerApiKey,
+	})
+	resp, err := m.Service.GetAccountTransformerKey(ctx, connect.NewRequest(&mgmtv1alpha1.GetAccountTransformerKeyRequest{
+		AccountId: mockAccountId,
+	}))
+
+	assert.NoError(t, err)
+	assert.Equal(t, "existing-key", resp.Msg.Key)
+	m.QuerierMock.AssertNotCalled(t, "IsUserInAccount", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func Test_Service_GetAccountTransformerKey_NotInAccount(t *testing.T) {
+	m := createServiceMock(t, &Config{IsAuthEnabled: false})
+	m.QuerierMock.On("GetAnonymousUser", mock.Anything, mock.Anything).Return(*getAnonTestApiUser(), nil)
+	m.QuerierMock.On("IsUserInAccountApiKey", mock.Anything, mock.Anything, mock.Anything).Return(int64(0), nil)
+	m.QuerierMock.On("IsUserInAccount", mock.Anything, mock.Anything, mock.Anything).Return(int64(0), nil)
+
+	resp, err := m.Service.GetAccountTransformerKey(context.Background(), connect.NewRequest(&mgmtv1alpha1.GetAccountTransformerKeyRequest{
+		AccountId: mockAccountId,
+	}))
+
+	assert.Error(t, err)
+	assert.Nil(t, resp)
+	m.QuerierMock.AssertNotCalled(t, "GetAccountTransformerKey", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func Test_Service_SetAccountTransformerKey(t *testing.T) {
+	m := createServiceMock(t, &Config{IsAuthEnabled: false})
+	mockUserInAccount(m)
+	m.QuerierMock.On("GetJobsByAccount", mock.Anything, mock.Anything, mock.Anything).
+		Return([]db_queries.NeosyncApiJob{{Mappings: []*pg_models.JobMapping{{Column: "id"}}}}, nil)
+	m.QuerierMock.On("GetUserDefinedTransformersByAccount", mock.Anything, mock.Anything, mock.Anything).
+		Return([]db_queries.NeosyncApiTransformer{}, nil)
+	m.QuerierMock.On("UpdateAccountTransformerKey", mock.Anything, mock.Anything, mock.Anything).
+		Return(pgtype.Text{String: "provided-key-value", Valid: true}, nil)
+
+	key := "provided-key-value"
+	resp, err := m.Service.SetAccountTransformerKey(context.Background(), connect.NewRequest(&mgmtv1alpha1.SetAccountTransformerKeyRequest{
+		AccountId: mockAccountId,
+		Key:       &key,
+	}))
+
+	assert.NoError(t, err)
+	assert.Equal(t, key, resp.Msg.Key)
+}
+
+func Test_Service_SetAccountTransformerKey_FormatPreservingEncrypt_InUse(t *testing.T) {
+	m := createServiceMock(t, &Config{IsAuthEnabled: false})
+	mockUserInAccount(m)
+	m.QuerierMock.On("GetJobsByAccount", mock.Anything, mock.Anything, mock.Anything).
+		Return([]db_queries.NeosyncApiJob{{Mappings: []*pg_models.JobMapping{
+			{Column: "ssn", JobMappingTransformer: &pg_models.JobMappingTransformerModel{
+				Config: &pg_models.TransformerConfigs{TransformFormatPreservingEncrypt: &pg_models.TransformFormatPreservingEncryptConfig{}},
+			}},
+		}}}, nil)
+
+	resp, err := m.Service.SetAccountTransformerKey(context.Background(), connect.NewRequest(&mgmtv1alpha1.SetAccountTransformerKeyRequest{
+		AccountId: mockAccountId,
+	}))
+
+	assert.Error(t, err)
+	assert.Nil(t, resp)
+	m.QuerierMock.AssertNotCalled(t, "UpdateAccountTransformerKey", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func Test_Service_SetAccountTransformerKey_FormatPreservingEncrypt_UserDefined_Force(t *testing.T) {
+	m := createServiceMock(t, &Config{IsAuthEnabled: false})
+	mockUserInAccount(m)
+	m.QuerierMock.On("GetJobsByAccount", mock.Anything, mock.Anything, mock.Anything).
+		Return([]db_queries.NeosyncApiJob{}, nil)
+	m.QuerierMock.On("GetUserDefinedTransformersByAccount", mock.Anything, mock.Anything, mock.Anything).
+		Return([]db_queries.NeosyncApiTransformer{
+			{TransformerConfig: &pg_models.TransformerConfigs{TransformFormatPreservingEncrypt: &pg_models.TransformFormatPreservingEncryptConfig{}}},
+		}, nil)
+
+	_, err := m.Service.SetAccountTransformerKey(context.Background(), connect.NewRequest(&mgmtv1alpha1.SetAccountTransformerKeyRequest{
+		AccountId: mockAccountId,
+	}))
+	assert.Error(t, err)
+
+	m.QuerierMock.On("UpdateAccountTransformerKey", mock.Anything, mock.Anything, mock.Anything).
+		Return(pgtype.Text{String: "new-key", Valid: true}, nil)
+	resp, err := m.Service.SetAccountTransformerKey(context.Background(), connect.NewRequest(&mgmtv1alpha1.SetAccountTransformerKeyRequest{
+		AccountId: mockAccountId,
+		Force:     true,
+	}))
+	assert.NoError(t, err)
+	assert.Equal(t, "new-key", resp.Msg.Key)
+}
+
+func Test_Service_SetAccountTransformerKey_FormatPreservingEncrypt_JsonRule(t *testing.T) {
+	m := createServiceMock(t, &Config{IsAuthEnabled: false})
+	mockUserInAccount(m)
+	m.QuerierMock.On("GetJobsByAccount", mock.Anything, mock.Anything, mock.Anything).
+		Return([]db_queries.NeosyncApiJob{{Mappings: []*pg_models.JobMapping{
+			{Column: "profile", JobMappingTransformer: &pg_models.JobMappingTransformerModel{
+				Config: &pg_models.TransformerConfigs{TransformJson: &pg_models.TransformJsonConfig{Rules: []*pg_models.TransformJsonRule{
+					{Path: "$.name", Config: &pg_models.TransformerConfigs{Passthrough: &pg_models.PassthroughConfig{}}},
+					{Path: "$.ssn", Config: &pg_models.TransformerConfigs{TransformFormatPreservingEncrypt: &pg_models.TransformFormatPreservingEncryptConfig{}}},
+				}}},
+			}},
+		}}}, nil)
+
+	resp, err := m.Service.SetAccountTransformerKey(context.Background(), connect.NewRequest(&mgmtv1alpha1.SetAccountTransformerKeyRequest{
+		AccountId: mockAccountId,
+	}))
+
+	assert.Error(t, err)
+	assert.Nil(t, resp)
+	m.QuerierMock.AssertNotCalled(t, "UpdateAccountTransformerKey", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func Test_Service_SetAccountTransformerKey_FormatPreservingEncrypt_JsonRule_UserDefined(t *testing.T) {
+	m := createServiceMock(t, &Config{IsAuthEnabled: false})
+	mockUserInAccount(m)
+	// the json rule references a user defined transformer that wraps another json transformer that encrypts
+	m.QuerierMock.On("GetJobsByAccount", mock.Anything, mock.Anything, mock.Anything).
+		Return([]db_queries.NeosyncApiJob{{Mappings: []*pg_models.JobMapping{
+			{Column: "profile", JobMappingTransformer: &pg_models.JobMappingTransformerModel{
+				Config: &pg_models.TransformerConfigs{TransformJson: &pg_models.TransformJsonConfig{Rules: []*pg_models.TransformJsonRule{
+					{Path: "$.address", Config: &pg_models.TransformerConfigs{UserDefinedTransformer: &pg_models.UserDefinedTransformerConfig{Id: "udt-id"}}},
+				}}},
+			}},
+		}}}, nil)
+	m.QuerierMock.On("GetUserDefinedTransformersByAccount", mock.Anything, mock.Anything, mock.Anything).
+		Return([]db_queries.NeosyncApiTransformer{
+			{TransformerConfig: &pg_models.TransformerConfigs{TransformJson: &pg_models.TransformJsonConfig{Rules: []*pg_models.TransformJsonRule{
+				{Path: "$.zip", Config: &pg_models.TransformerConfigs{TransformJson: &pg_models.TransformJsonConfig{Rules: []*pg_models.TransformJsonRule{
+					{Path: "$.code", Config: &pg_models.TransformerConfigs{TransformFormatPreservingEncrypt: &pg_models.TransformFormatPreservingEncryptConfig{}}},
+				}}}},
+			}}}},
+		}, nil)
+
+	resp, err := m.Service.SetAccountTransformerKey(context.Background(), connect.NewRequest(&mgmtv1alpha1.SetAccountTransformerKeyRequest{
+		AccountId: mockAccountId,
+	}))
+
+	assert.Error(t, err)
+	assert.Nil(t, resp)
+	m.QuerierMock.AssertNotCalled(t, "UpdateAccountTransformerKey", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func Test_isFormatPreservingEncryptConfig(t *testing.T) {
+	assert.False(t, isFormatPreservingEncryptConfig(nil))
+	assert.False(t, isFormatPreservingEncryptConfig(&pg_models.TransformerConfigs{Passthrough: &pg_models.PassthroughConfig{}}))
+	assert.False(t, isFormatPreservingEncryptConfig(&pg_models.TransformerConfigs{TransformJson: &pg_models.TransformJsonConfig{
+		Rules: []*pg_models.TransformJsonRule{nil, {Path: "$.name"}},
+	}}))
+	assert.True(t, isFormatPreservingEncryptConfig(&pg_models.TransformerConfigs{TransformFormatPreservingEncrypt: &pg_models.TransformFormatPreservingEncryptConfig{}}))
+	assert.True(t, isFormatPreservingEncryptConfig(&pg_models.TransformerConfigs{TransformJson: &pg_models.TransformJsonConfig{
+		Rules: []*pg_models.TransformJsonRule{
+			{Path: "$.name", Config: &pg_models.TransformerConfigs{TransformFormatPreservingEncrypt: &pg_models.TransformFormatPreservingEncryptConfig{}}},
+		},
+	}}))
+}
+
+func Test_generateTransformerKey(t *testing.T) {
+	key, err := generateTransformerKey()
+	assert.NoError(t, err)
+	assert.Len(t, key, transformerKeyByteLength*2)
+
+	other, err := generateTransformerKey()
+	assert.NoError(t, err)
+	assert.NotEqual(t, key, other)
+}
+
+func mockUserInAccount(m *serviceMocks) {
+	m.QuerierMock.On("GetAnonymousUser", mock.Anything, mock.Anything).Return(*getAnonTestApiUser(), nil)
+	m.QuerierMock.On("IsUserInAccountApiKey", mock.Anything, mock.Anything, mock.Anything).Return(int64(0), nil)
+	m.QuerierMock.On("IsUserInAccount", mock.Anything, mock.Anything, mock.Anything).Return(int64(1), nil)
+}
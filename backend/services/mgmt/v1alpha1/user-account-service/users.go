@@ -9,6 +9,8 @@ import (
 	"github.com/jackc/pgx/v5/pgtype"
 	db_queries "github.com/nucleuscloud/neosync/backend/gen/go/db"
 	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
+	"github.com/nucleuscloud/neosync/backend/internal/apikey"
+	"github.com/nucleuscloud/neosync/backend/internal/audit"
 	auth_apikey "github.com/nucleuscloud/neosync/backend/internal/auth/apikey"
 	authjwt "github.com/nucleuscloud/neosync/backend/internal/auth/jwt"
 	"github.com/nucleuscloud/neosync/backend/internal/auth/tokenctx"
@@ -16,6 +18,7 @@ import (
 	"github.com/nucleuscloud/neosync/backend/internal/dtomaps"
 	nucleuserrors "github.com/nucleuscloud/neosync/backend/internal/errors"
 	"github.com/nucleuscloud/neosync/backend/internal/nucleusdb"
+	"github.com/nucleuscloud/neosync/backend/internal/rbac"
 	"github.com/nucleuscloud/neosync/backend/internal/version"
 	"golang.org/x/sync/errgroup"
 	"google.golang.org/protobuf/types/known/timestamppb"
@@ -204,6 +207,10 @@ func (s *Service) IsUserInAccount(
 		return nil, err
 	}
 	if apiKeyCount > 0 {
+		audit.SetAccountUser(ctx, req.Msg.AccountId, user.Msg.UserId)
+		if err := s.verifyApiKeyAccountRole(ctx, accountId); err != nil {
+			return nil, err
+		}
 		return connect.NewResponse(&mgmtv1alpha1.IsUserInAccountResponse{
 			Ok: apiKeyCount > 0,
 		}), nil
@@ -215,6 +222,13 @@ func (s *Service) IsUserInAccount(
 	if err != nil {
 		return nil, err
 	}
+	if count > 0 {
+		// recorded before the role is verified so that denied calls are attributed to the user
+		audit.SetAccountUser(ctx, req.Msg.AccountId, user.Msg.UserId)
+		if err := s.verifyUserAccountRole(ctx, accountId, userId); err != nil {
+			return nil, err
+		}
+	}
 	return connect.NewResponse(&mgmtv1alpha1.IsUserInAccountResponse{
 		Ok: count > 0,
 	}), nil
@@ -272,7 +286,8 @@ func (s *Service) GetTeamAccountMembers(
 			}
 			if user.ProviderSub != "" {
 				dtoUsers[i] = &mgmtv1alpha1.AccountUser{
-					Id: nucleusdb.UUIDString(user.UserID),
+					Id:   nucleusdb.UUIDString(user.UserID),
+					Role: mgmtv1alpha1.AccountRole(user.Role),
 				}
 				authuser, err := s.authadminclient.GetUserBySub(ctx, user.ProviderSub)
 				if err != nil {
@@ -311,6 +326,18 @@ func (s *Service) RemoveTeamAccountMember(
 	if err != nil {
 		return nil, err
 	}
+	memberRole, err := s.getUserAccountRole(ctx, *accountId, memberUserId)
+	if err != nil {
+		return nil, err
+	}
+	if memberRole == mgmtv1alpha1.AccountRole_ACCOUNT_ROLE_OWNER {
+		if err := s.verifyOwnerChange(ctx, *accountId); err != nil {
+			return nil, err
+		}
+		if err := s.verifyNotLastOwner(ctx, *accountId); err != nil {
+			return nil, err
+		}
+	}
 	err = s.db.Q.RemoveAccountUser(ctx, s.db.Db, db_queries.RemoveAccountUserParams{
 		AccountId: *accountId,
 		UserId:    memberUserId,
@@ -322,6 +349,56 @@ func (s *Service) RemoveTeamAccountMember(
 	return connect.NewResponse(&mgmtv1alpha1.RemoveTeamAccountMemberResponse{}), nil
 }
 
+func (s *Service) SetTeamAccountMemberRole(
+	ctx context.Context,
+	req *connect.Request[mgmtv1alpha1.SetTeamAccountMemberRoleRequest],
+) (*connect.Response[mgmtv1alpha1.SetTeamAccountMemberRoleResponse], error) {
+	accountId, err := s.verifyUserInAccount(ctx, req.Msg.AccountId)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.verifyTeamAccount(ctx, *accountId); err != nil {
+		return nil, err
+	}
+	memberUserId, err := nucleusdb.ToUuid(req.Msg.UserId)
+	if err != nil {
+		return nil, err
+	}
+	memberRole, err := s.getUserAccountRole(ctx, *accountId, memberUserId)
+	if err != nil {
+		return nil, err
+	}
+	if memberRole == mgmtv1alpha1.AccountRole_ACCOUNT_ROLE_UNSPECIFIED {
+		return nil, nucleuserrors.NewNotFound("user is not a member of the account")
+	}
+	if memberRole == mgmtv1alpha1.AccountRole_ACCOUNT_ROLE_OWNER || req.Msg.Role == mgmtv1alpha1.AccountRole_ACCOUNT_ROLE_OWNER {
+		if err := s.verifyOwnerChange(ctx, *accountId); err != nil {
+			return nil, err
+		}
+	}
+	if memberRole == mgmtv1alpha1.AccountRole_ACCOUNT_ROLE_OWNER && req.Msg.Role != mgmtv1alpha1.AccountRole_ACCOUNT_ROLE_OWNER {
+		if err := s.verifyNotLastOwner(ctx, *accountId); err != nil {
+			return nil, err
+		}
+	}
+
+	association, err := s.db.Q.UpdateAccountUserRole(ctx, s.db.Db, db_queries.UpdateAccountUserRoleParams{
+		Role:      int16(req.Msg.Role),
+		AccountId: *accountId,
+		UserId:    memberUserId,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&mgmtv1alpha1.SetTeamAccountMemberRoleResponse{
+		User: &mgmtv1alpha1.AccountUser{
+			Id:   nucleusdb.UUIDString(association.UserID),
+			Role: mgmtv1alpha1.AccountRole(association.Role),
+		},
+	}), nil
+}
+
 func (s *Service) InviteUserToTeamAccount(
 	ctx context.Context,
 	req *connect.Request[mgmtv1alpha1.InviteUserToTeamAccountRequest],
@@ -344,13 +421,23 @@ func (s *Service) InviteUserToTeamAccount(
 		return nil, err
 	}
 
+	role := mgmtv1alpha1.AccountRole_ACCOUNT_ROLE_ADMIN
+	if req.Msg.Role != nil && *req.Msg.Role != mgmtv1alpha1.AccountRole_ACCOUNT_ROLE_UNSPECIFIED {
+		role = *req.Msg.Role
+	}
+	if role == mgmtv1alpha1.AccountRole_ACCOUNT_ROLE_OWNER {
+		if err := s.verifyOwnerChange(ctx, *accountId); err != nil {
+			return nil, err
+		}
+	}
+
 	tomorrow := time.Now().Add(24 * time.Hour)
 	expiresAt, err := nucleusdb.ToTimestamp(tomorrow)
 	if err != nil {
 		return nil, err
 	}
 
-	invite, err := s.db.CreateTeamAccountInvite(ctx, *accountId, userId, req.Msg.Email, expiresAt)
+	invite, err := s.db.CreateTeamAccountInvite(ctx, *accountId, userId, req.Msg.Email, expiresAt, role)
 	if err != nil {
 		return nil, err
 	}
@@ -494,10 +581,112 @@ func (s *Service) verifyTeamAccount(ctx context.Context, accountId pgtype.UUID)
 	return nil
 }
 
+// Verifies that the user has been given the role that the current procedure requires in the account.
+// Roles are only enforced when auth is enabled as every request is made by the same user otherwise.
+func (s *Service) verifyUserAccountRole(ctx context.Context, accountId, userId pgtype.UUID) error {
+	if !s.cfg.IsAuthEnabled {
+		return nil
+	}
+	requiredRole := rbac.GetRequiredRoleFromCtx(ctx)
+	if requiredRole == mgmtv1alpha1.AccountRole_ACCOUNT_ROLE_UNSPECIFIED {
+		return nil
+	}
+	role, err := s.getUserAccountRole(ctx, accountId, userId)
+	if err != nil {
+		return err
+	}
+	if !rbac.HasRole(role, requiredRole) {
+		return nucleuserrors.NewForbidden(fmt.Sprintf("user must have the %s role in the account", rbac.GetRoleName(requiredRole)))
+	}
+	return nil
+}
+
+// Account api keys act with the role of the user that created them, so a key can never be given more access than its creator has
+func (s *Service) verifyApiKeyAccountRole(ctx context.Context, accountId pgtype.UUID) error {
+	data, err := auth_apikey.GetTokenDataFromCtx(ctx)
+	if err != nil || data.ApiKeyType != apikey.AccountApiKey || data.ApiKey == nil {
+		return nil
+	}
+	return s.verifyUserAccountRole(ctx, accountId, data.ApiKey.CreatedByID)
+}
+
+// Returns the unspecified role if the user is not a member of the account
+func (s *Service) getUserAccountRole(ctx context.Context, accountId, userId pgtype.UUID) (mgmtv1alpha1.AccountRole, error) {
+	association, err := s.db.Q.GetAccountUserAssociation(ctx, s.db.Db, db_queries.GetAccountUserAssociationParams{
+		AccountId: accountId,
+		UserId:    userId,
+	})
+	if err != nil && !nucleusdb.IsNoRows(err) {
+		return mgmtv1alpha1.AccountRole_ACCOUNT_ROLE_UNSPECIFIED, err
+	} else if err != nil && nucleusdb.IsNoRows(err) {
+		return mgmtv1alpha1.AccountRole_ACCOUNT_ROLE_UNSPECIFIED, nil
+	}
+	return mgmtv1alpha1.AccountRole(association.Role), nil
+}
+
+// Owners may only be added, removed or demoted by other owners, and an account must always keep at least one owner.
+// Changes made with an account api key are only allowed if the user that created the key is an owner.
+func (s *Service) verifyOwnerChange(ctx context.Context, accountId pgtype.UUID) error {
+	if !s.cfg.IsAuthEnabled {
+		return nil
+	}
+	tokenctxResp, err := tokenctx.GetTokenCtx(ctx)
+	if err != nil {
+		return err
+	}
+	var userId pgtype.UUID
+	if tokenctxResp.ApiKeyContextData != nil {
+		if tokenctxResp.ApiKeyContextData.ApiKey == nil {
+			return nucleuserrors.NewForbidden("only owners may manage the owners of the account")
+		}
+		userId = tokenctxResp.ApiKeyContextData.ApiKey.CreatedByID
+	} else {
+		user, err := s.GetUser(ctx, connect.NewRequest(&mgmtv1alpha1.GetUserRequest{}))
+		if err != nil {
+			return err
+		}
+		userId, err = nucleusdb.ToUuid(user.Msg.UserId)
+		if err != nil {
+			return err
+		}
+	}
+	role, err := s.getUserAccountRole(ctx, accountId, userId)
+	if err != nil {
+		return err
+	}
+	if role != mgmtv1alpha1.AccountRole_ACCOUNT_ROLE_OWNER {
+		return nucleuserrors.NewForbidden("only owners may manage the owners of the account")
+	}
+	return nil
+}
+
+func (s *Service) verifyNotLastOwner(ctx context.Context, accountId pgtype.UUID) error {
+	count, err := s.db.Q.GetAccountUserCountByRole(ctx, s.db.Db, db_queries.GetAccountUserCountByRoleParams{
+		AccountId: accountId,
+		Role:      int16(mgmtv1alpha1.AccountRole_ACCOUNT_ROLE_OWNER),
+	})
+	if err != nil {
+		return err
+	}
+	if count <= 1 {
+		return nucleuserrors.NewBadRequest("account must have at least one owner")
+	}
+	return nil
+}
+
 func (s *Service) verifyUserInAccount(
 	ctx context.Context,
 	accountId string,
 ) (*pgtype.UUID, error) {
+	accountUuid, err := nucleusdb.ToUuid(accountId)
+	if err != nil {
+		return nil, err
+	}
+
+	if isWorkerApiKey(ctx) {
+		return &accountUuid, nil
+	}
+
 	resp, err := s.IsUserInAccount(ctx, connect.NewRequest(&mgmtv1alpha1.IsUserInAccountRequest{AccountId: accountId}))
 	if err != nil {
 		return nil, err
@@ -505,12 +694,15 @@ func (s *Service) verifyUserInAccount(
 	if !resp.Msg.Ok {
 		return nil, nucleuserrors.NewForbidden("user in not in requested account")
 	}
+	return &accountUuid, nil
+}
 
-	accountUuid, err := nucleusdb.ToUuid(accountId)
+func isWorkerApiKey(ctx context.Context) bool {
+	data, err := auth_apikey.GetTokenDataFromCtx(ctx)
 	if err != nil {
-		return nil, err
+		return false
 	}
-	return &accountUuid, nil
+	return data.ApiKeyType == apikey.WorkerApiKey
 }
 
 func (s *Service) GetSystemInformation(ctx context.Context, req *connect.Request[mgmtv1alpha1.GetSystemInformationRequest]) (*connect.Response[mgmtv1alpha1.GetSystemInformationResponse], error) {
@@ -18,6 +18,7 @@ import (
 	authjwt "github.com/nucleuscloud/neosync/backend/internal/auth/jwt"
 	"github.com/nucleuscloud/neosync/backend/internal/authmgmt"
 	"github.com/nucleuscloud/neosync/backend/internal/nucleusdb"
+	"github.com/nucleuscloud/neosync/backend/internal/rbac"
 	clientmanager "github.com/nucleuscloud/neosync/backend/internal/temporal/client-manager"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
@@ -29,6 +30,7 @@ const (
 	mockAuthProvider = "test-provider"
 	mockUserId       = "d5e29f1f-b920-458c-8b86-f3a180e06d98"
 	mockAccountId    = "5629813e-1a35-4874-922c-9827d85f0378"
+	mockMemberId     = "0fd9e2a1-7a4c-4b6b-bb0c-4b1a2b4c1c0e"
 )
 
 // GetUser
@@ -335,6 +337,74 @@ func Test_IsUserInAccount_ApiKey(t *testing.T) {
 	assert.Equal(t, true, resp.Msg.Ok)
 }
 
+func Test_IsUserInAccount_RequiredRole(t *testing.T) {
+	m := createServiceMock(t, &Config{IsAuthEnabled: true})
+
+	ctx := rbac.SetRequiredRoleInCtx(getJwtAuthenticatedCtxMock(mockAuthProvider), mgmtv1alpha1.AccountRole_ACCOUNT_ROLE_JOB_OPERATOR)
+	accountUuid, _ := nucleusdb.ToUuid(mockAccountId)
+	userUuid, _ := nucleusdb.ToUuid(mockUserId)
+	mockVerifyUserInAccount(ctx, m.QuerierMock, accountUuid, userUuid, true)
+	mockAccountUserRole(ctx, m.QuerierMock, accountUuid, userUuid, mgmtv1alpha1.AccountRole_ACCOUNT_ROLE_ADMIN)
+
+	resp, err := m.Service.IsUserInAccount(ctx, &connect.Request[mgmtv1alpha1.IsUserInAccountRequest]{Msg: &mgmtv1alpha1.IsUserInAccountRequest{AccountId: mockAccountId}})
+
+	assert.NoError(t, err)
+	assert.NotNil(t, resp)
+	assert.Equal(t, true, resp.Msg.Ok)
+}
+
+func Test_IsUserInAccount_RequiredRole_Forbidden(t *testing.T) {
+	m := createServiceMock(t, &Config{IsAuthEnabled: true})
+
+	ctx := rbac.SetRequiredRoleInCtx(getJwtAuthenticatedCtxMock(mockAuthProvider), mgmtv1alpha1.AccountRole_ACCOUNT_ROLE_ADMIN)
+	accountUuid, _ := nucleusdb.ToUuid(mockAccountId)
+	userUuid, _ := nucleusdb.ToUuid(mockUserId)
+	mockVerifyUserInAccount(ctx, m.QuerierMock, accountUuid, userUuid, true)
+	mockAccountUserRole(ctx, m.QuerierMock, accountUuid, userUuid, mgmtv1alpha1.AccountRole_ACCOUNT_ROLE_VIEWER)
+
+	resp, err := m.Service.IsUserInAccount(ctx, &connect.Request[mgmtv1alpha1.IsUserInAccountRequest]{Msg: &mgmtv1alpha1.IsUserInAccountRequest{AccountId: mockAccountId}})
+
+	assert.Error(t, err)
+	assert.Equal(t, connect.CodePermissionDenied, connect.CodeOf(err))
+	assert.Nil(t, resp)
+}
+
+func Test_IsUserInAccount_ApiKey_RequiredRole_Forbidden(t *testing.T) {
+	m := createServiceMock(t, &Config{IsAuthEnabled: true})
+
+	// the key was created by an admin and may not call owner procedures
+	ctx := rbac.SetRequiredRoleInCtx(getApiKeyCreatedByCtxMock(mockUserId, mockMemberId), mgmtv1alpha1.AccountRole_ACCOUNT_ROLE_OWNER)
+	accountUuid, _ := nucleusdb.ToUuid(mockAccountId)
+	userUuid, _ := nucleusdb.ToUuid(mockUserId)
+	creatorUuid, _ := nucleusdb.ToUuid(mockMemberId)
+	m.QuerierMock.On("IsUserInAccountApiKey", ctx, mock.Anything, db_queries.IsUserInAccountApiKeyParams{
+		AccountId: accountUuid,
+		UserId:    userUuid,
+	}).Return(int64(1), nil)
+	mockAccountUserRole(ctx, m.QuerierMock, accountUuid, creatorUuid, mgmtv1alpha1.AccountRole_ACCOUNT_ROLE_ADMIN)
+
+	resp, err := m.Service.IsUserInAccount(ctx, &connect.Request[mgmtv1alpha1.IsUserInAccountRequest]{Msg: &mgmtv1alpha1.IsUserInAccountRequest{AccountId: mockAccountId}})
+
+	assert.Error(t, err)
+	assert.Equal(t, connect.CodePermissionDenied, connect.CodeOf(err))
+	assert.Nil(t, resp)
+}
+
+func Test_IsUserInAccount_RequiredRole_AuthDisabled(t *testing.T) {
+	m := createServiceMock(t, &Config{IsAuthEnabled: false})
+
+	ctx := rbac.SetRequiredRoleInCtx(context.Background(), mgmtv1alpha1.AccountRole_ACCOUNT_ROLE_OWNER)
+	m.QuerierMock.On("GetAnonymousUser", ctx, mock.Anything).Return(getUserMock(anonymousUserId), nil)
+	m.QuerierMock.On("IsUserInAccountApiKey", ctx, mock.Anything, mock.Anything).Return(int64(0), nil)
+	m.QuerierMock.On("IsUserInAccount", ctx, mock.Anything, mock.Anything).Return(int64(1), nil)
+
+	resp, err := m.Service.IsUserInAccount(ctx, &connect.Request[mgmtv1alpha1.IsUserInAccountRequest]{Msg: &mgmtv1alpha1.IsUserInAccountRequest{AccountId: mockAccountId}})
+
+	assert.NoError(t, err)
+	assert.Equal(t, true, resp.Msg.Ok)
+	m.QuerierMock.AssertNotCalled(t, "GetAccountUserAssociation", mock.Anything, mock.Anything, mock.Anything)
+}
+
 func Test_CreateTeamAccount(t *testing.T) {
 	m := createServiceMock(t, &Config{IsAuthEnabled: true})
 	mockTx := new(nucleusdb.MockTx)
@@ -351,6 +421,7 @@ func Test_CreateTeamAccount(t *testing.T) {
 	m.QuerierMock.On("CreateAccountUserAssociation", ctx, mockTx, db_queries.CreateAccountUserAssociationParams{
 		AccountID: accountUuid,
 		UserID:    userUuid,
+		Role:      int16(mgmtv1alpha1.AccountRole_ACCOUNT_ROLE_OWNER),
 	}).Return(db_queries.NeosyncApiAccountUserAssociation{}, nil)
 	mockTx.On("Commit", ctx).Return(nil)
 	mockTx.On("Rollback", ctx).Return(nil)
@@ -380,7 +451,7 @@ func Test_GetTeamAccountMembers(t *testing.T) {
 	authProviderId := "auth-provider-id"
 	mockVerifyUserInAccount(ctx, m.QuerierMock, accountUuid, userUuid, true)
 	m.QuerierMock.On("GetUserIdentitiesByTeamAccount", ctx, mock.Anything, accountUuid).Return(
-		[]db_queries.NeosyncApiUserIdentityProviderAssociation{{UserID: userUuid, ProviderSub: authProviderId}},
+		[]db_queries.GetUserIdentitiesByTeamAccountRow{{UserID: userUuid, ProviderSub: authProviderId, Role: int16(mgmtv1alpha1.AccountRole_ACCOUNT_ROLE_VIEWER)}},
 		nil,
 	)
 	m.AuthAdminClientMock.On("GetUserBySub", ctx, authProviderId).Return(&authmgmt.User{
@@ -394,6 +465,7 @@ func Test_GetTeamAccountMembers(t *testing.T) {
 	assert.NotNil(t, resp)
 	assert.Equal(t, 1, len(resp.Msg.Users))
 	assert.Equal(t, mockUserId, resp.Msg.Users[0].Id)
+	assert.Equal(t, mgmtv1alpha1.AccountRole_ACCOUNT_ROLE_VIEWER, resp.Msg.Users[0].Role)
 }
 
 func Test_GetTeamAccountMembers_NoAuthUser(t *testing.T) {
@@ -405,7 +477,7 @@ func Test_GetTeamAccountMembers_NoAuthUser(t *testing.T) {
 	authProviderId := "auth-provider-id"
 	mockVerifyUserInAccount(ctx, m.QuerierMock, accountUuid, userUuid, true)
 	m.QuerierMock.On("GetUserIdentitiesByTeamAccount", ctx, mock.Anything, accountUuid).Return(
-		[]db_queries.NeosyncApiUserIdentityProviderAssociation{{UserID: userUuid, ProviderSub: authProviderId}},
+		[]db_queries.GetUserIdentitiesByTeamAccountRow{{UserID: userUuid, ProviderSub: authProviderId, Role: int16(mgmtv1alpha1.AccountRole_ACCOUNT_ROLE_VIEWER)}},
 		nil,
 	)
 	m.AuthAdminClientMock.On("GetUserBySub", ctx, authProviderId).Return(&authmgmt.User{
@@ -430,6 +502,7 @@ func Test_RemoveTeamAccountMember(t *testing.T) {
 	userUuid, _ := nucleusdb.ToUuid(mockUserId)
 	mockVerifyUserInAccount(ctx, m.QuerierMock, accountUuid, userUuid, true)
 	mockVerifyTeamAccount(ctx, m.QuerierMock, accountUuid, true)
+	mockAccountUserRole(ctx, m.QuerierMock, accountUuid, userUuid, mgmtv1alpha1.AccountRole_ACCOUNT_ROLE_ADMIN)
 	m.QuerierMock.On("RemoveAccountUser", ctx, mock.Anything, db_queries.RemoveAccountUserParams{
 		AccountId: accountUuid,
 		UserId:    userUuid,
@@ -449,6 +522,7 @@ func Test_RemoveTeamAccountMember_NoRows(t *testing.T) {
 	userUuid, _ := nucleusdb.ToUuid(mockUserId)
 	mockVerifyUserInAccount(ctx, m.QuerierMock, accountUuid, userUuid, true)
 	mockVerifyTeamAccount(ctx, m.QuerierMock, accountUuid, true)
+	mockAccountUserRole(ctx, m.QuerierMock, accountUuid, userUuid, mgmtv1alpha1.AccountRole_ACCOUNT_ROLE_ADMIN)
 	m.QuerierMock.On("RemoveAccountUser", ctx, mock.Anything, db_queries.RemoveAccountUserParams{
 		AccountId: accountUuid,
 		UserId:    userUuid,
@@ -460,6 +534,157 @@ func Test_RemoveTeamAccountMember_NoRows(t *testing.T) {
 	assert.NotNil(t, resp)
 }
 
+func Test_RemoveTeamAccountMember_LastOwner(t *testing.T) {
+	m := createServiceMock(t, &Config{IsAuthEnabled: true})
+	ctx := getJwtAuthenticatedCtxMock(mockAuthProvider)
+
+	accountUuid, _ := nucleusdb.ToUuid(mockAccountId)
+	userUuid, _ := nucleusdb.ToUuid(mockUserId)
+	mockVerifyUserInAccount(ctx, m.QuerierMock, accountUuid, userUuid, true)
+	mockVerifyTeamAccount(ctx, m.QuerierMock, accountUuid, true)
+	mockAccountUserRole(ctx, m.QuerierMock, accountUuid, userUuid, mgmtv1alpha1.AccountRole_ACCOUNT_ROLE_OWNER)
+	mockAccountOwnerCount(ctx, m.QuerierMock, accountUuid, 1)
+
+	resp, err := m.Service.RemoveTeamAccountMember(ctx, &connect.Request[mgmtv1alpha1.RemoveTeamAccountMemberRequest]{Msg: &mgmtv1alpha1.RemoveTeamAccountMemberRequest{AccountId: mockAccountId, UserId: mockUserId}})
+
+	m.QuerierMock.AssertNotCalled(t, "RemoveAccountUser", mock.Anything, mock.Anything, mock.Anything)
+	assert.Error(t, err)
+	assert.Nil(t, resp)
+}
+
+// SetTeamAccountMemberRole
+func Test_SetTeamAccountMemberRole(t *testing.T) {
+	m := createServiceMock(t, &Config{IsAuthEnabled: true})
+	ctx := rbac.SetRequiredRoleInCtx(getJwtAuthenticatedCtxMock(mockAuthProvider), mgmtv1alpha1.AccountRole_ACCOUNT_ROLE_ADMIN)
+
+	accountUuid, _ := nucleusdb.ToUuid(mockAccountId)
+	userUuid, _ := nucleusdb.ToUuid(mockUserId)
+	memberUuid, _ := nucleusdb.ToUuid(mockMemberId)
+	mockVerifyUserInAccount(ctx, m.QuerierMock, accountUuid, userUuid, true)
+	mockVerifyTeamAccount(ctx, m.QuerierMock, accountUuid, true)
+	mockAccountUserRole(ctx, m.QuerierMock, accountUuid, userUuid, mgmtv1alpha1.AccountRole_ACCOUNT_ROLE_ADMIN)
+	mockAccountUserRole(ctx, m.QuerierMock, accountUuid, memberUuid, mgmtv1alpha1.AccountRole_ACCOUNT_ROLE_VIEWER)
+	m.QuerierMock.On("UpdateAccountUserRole", ctx, mock.Anything, db_queries.UpdateAccountUserRoleParams{
+		Role:      int16(mgmtv1alpha1.AccountRole_ACCOUNT_ROLE_JOB_OPERATOR),
+		AccountId: accountUuid,
+		UserId:    memberUuid,
+	}).Return(db_queries.NeosyncApiAccountUserAssociation{
+		AccountID: accountUuid,
+		UserID:    memberUuid,
+		Role:      int16(mgmtv1alpha1.AccountRole_ACCOUNT_ROLE_JOB_OPERATOR),
+	}, nil)
+
+	resp, err := m.Service.SetTeamAccountMemberRole(ctx, connect.NewRequest(&mgmtv1alpha1.SetTeamAccountMemberRoleRequest{
+		AccountId: mockAccountId,
+		UserId:    mockMemberId,
+		Role:      mgmtv1alpha1.AccountRole_ACCOUNT_ROLE_JOB_OPERATOR,
+	}))
+
+	require.NoError(t, err)
+	assert.Equal(t, mockMemberId, resp.Msg.User.Id)
+	assert.Equal(t, mgmtv1alpha1.AccountRole_ACCOUNT_ROLE_JOB_OPERATOR, resp.Msg.User.Role)
+}
+
+func Test_SetTeamAccountMemberRole_AdminCannotGrantOwner(t *testing.T) {
+	m := createServiceMock(t, &Config{IsAuthEnabled: true})
+	ctx := rbac.SetRequiredRoleInCtx(getJwtAuthenticatedCtxMock(mockAuthProvider), mgmtv1alpha1.AccountRole_ACCOUNT_ROLE_ADMIN)
+
+	accountUuid, _ := nucleusdb.ToUuid(mockAccountId)
+	userUuid, _ := nucleusdb.ToUuid(mockUserId)
+	memberUuid, _ := nucleusdb.ToUuid(mockMemberId)
+	mockVerifyUserInAccount(ctx, m.QuerierMock, accountUuid, userUuid, true)
+	mockVerifyTeamAccount(ctx, m.QuerierMock, accountUuid, true)
+	mockAccountUserRole(ctx, m.QuerierMock, accountUuid, userUuid, mgmtv1alpha1.AccountRole_ACCOUNT_ROLE_ADMIN)
+	mockAccountUserRole(ctx, m.QuerierMock, accountUuid, memberUuid, mgmtv1alpha1.AccountRole_ACCOUNT_ROLE_VIEWER)
+
+	resp, err := m.Service.SetTeamAccountMemberRole(ctx, connect.NewRequest(&mgmtv1alpha1.SetTeamAccountMemberRoleRequest{
+		AccountId: mockAccountId,
+		UserId:    mockMemberId,
+		Role:      mgmtv1alpha1.AccountRole_ACCOUNT_ROLE_OWNER,
+	}))
+
+	m.QuerierMock.AssertNotCalled(t, "UpdateAccountUserRole", mock.Anything, mock.Anything, mock.Anything)
+	assert.Error(t, err)
+	assert.Equal(t, connect.CodePermissionDenied, connect.CodeOf(err))
+	assert.Nil(t, resp)
+}
+
+func Test_SetTeamAccountMemberRole_ApiKey_CannotGrantOwner(t *testing.T) {
+	m := createServiceMock(t, &Config{IsAuthEnabled: true})
+	const creatorId = "3b7e1f0a-2c4d-4e6f-8a1b-9c0d2e3f4a5b"
+	ctx := rbac.SetRequiredRoleInCtx(getApiKeyCreatedByCtxMock(mockUserId, creatorId), mgmtv1alpha1.AccountRole_ACCOUNT_ROLE_ADMIN)
+
+	accountUuid, _ := nucleusdb.ToUuid(mockAccountId)
+	userUuid, _ := nucleusdb.ToUuid(mockUserId)
+	creatorUuid, _ := nucleusdb.ToUuid(creatorId)
+	memberUuid, _ := nucleusdb.ToUuid(mockMemberId)
+	m.QuerierMock.On("IsUserInAccountApiKey", ctx, mock.Anything, db_queries.IsUserInAccountApiKeyParams{
+		AccountId: accountUuid,
+		UserId:    userUuid,
+	}).Return(int64(1), nil)
+	mockVerifyTeamAccount(ctx, m.QuerierMock, accountUuid, true)
+	mockAccountUserRole(ctx, m.QuerierMock, accountUuid, creatorUuid, mgmtv1alpha1.AccountRole_ACCOUNT_ROLE_ADMIN)
+	mockAccountUserRole(ctx, m.QuerierMock, accountUuid, memberUuid, mgmtv1alpha1.AccountRole_ACCOUNT_ROLE_VIEWER)
+
+	resp, err := m.Service.SetTeamAccountMemberRole(ctx, connect.NewRequest(&mgmtv1alpha1.SetTeamAccountMemberRoleRequest{
+		AccountId: mockAccountId,
+		UserId:    mockMemberId,
+		Role:      mgmtv1alpha1.AccountRole_ACCOUNT_ROLE_OWNER,
+	}))
+
+	m.QuerierMock.AssertNotCalled(t, "UpdateAccountUserRole", mock.Anything, mock.Anything, mock.Anything)
+	assert.Error(t, err)
+	assert.Equal(t, connect.CodePermissionDenied, connect.CodeOf(err))
+	assert.Nil(t, resp)
+}
+
+func Test_SetTeamAccountMemberRole_LastOwner(t *testing.T) {
+	m := createServiceMock(t, &Config{IsAuthEnabled: true})
+	ctx := rbac.SetRequiredRoleInCtx(getJwtAuthenticatedCtxMock(mockAuthProvider), mgmtv1alpha1.AccountRole_ACCOUNT_ROLE_ADMIN)
+
+	accountUuid, _ := nucleusdb.ToUuid(mockAccountId)
+	userUuid, _ := nucleusdb.ToUuid(mockUserId)
+	mockVerifyUserInAccount(ctx, m.QuerierMock, accountUuid, userUuid, true)
+	mockVerifyTeamAccount(ctx, m.QuerierMock, accountUuid, true)
+	mockAccountUserRole(ctx, m.QuerierMock, accountUuid, userUuid, mgmtv1alpha1.AccountRole_ACCOUNT_ROLE_OWNER)
+	mockAccountOwnerCount(ctx, m.QuerierMock, accountUuid, 1)
+
+	resp, err := m.Service.SetTeamAccountMemberRole(ctx, connect.NewRequest(&mgmtv1alpha1.SetTeamAccountMemberRoleRequest{
+		AccountId: mockAccountId,
+		UserId:    mockUserId,
+		Role:      mgmtv1alpha1.AccountRole_ACCOUNT_ROLE_ADMIN,
+	}))
+
+	m.QuerierMock.AssertNotCalled(t, "UpdateAccountUserRole", mock.Anything, mock.Anything, mock.Anything)
+	assert.Error(t, err)
+	assert.Nil(t, resp)
+}
+
+func Test_SetTeamAccountMemberRole_NotMember(t *testing.T) {
+	m := createServiceMock(t, &Config{IsAuthEnabled: true})
+	ctx := getJwtAuthenticatedCtxMock(mockAuthProvider)
+
+	accountUuid, _ := nucleusdb.ToUuid(mockAccountId)
+	userUuid, _ := nucleusdb.ToUuid(mockUserId)
+	memberUuid, _ := nucleusdb.ToUuid(mockMemberId)
+	mockVerifyUserInAccount(ctx, m.QuerierMock, accountUuid, userUuid, true)
+	mockVerifyTeamAccount(ctx, m.QuerierMock, accountUuid, true)
+	m.QuerierMock.On("GetAccountUserAssociation", ctx, mock.Anything, db_queries.GetAccountUserAssociationParams{
+		AccountId: accountUuid,
+		UserId:    memberUuid,
+	}).Return(db_queries.NeosyncApiAccountUserAssociation{}, sql.ErrNoRows)
+
+	resp, err := m.Service.SetTeamAccountMemberRole(ctx, connect.NewRequest(&mgmtv1alpha1.SetTeamAccountMemberRoleRequest{
+		AccountId: mockAccountId,
+		UserId:    mockMemberId,
+		Role:      mgmtv1alpha1.AccountRole_ACCOUNT_ROLE_VIEWER,
+	}))
+
+	assert.Error(t, err)
+	assert.Equal(t, connect.CodeNotFound, connect.CodeOf(err))
+	assert.Nil(t, resp)
+}
+
 // InviteUserToTeamAccount
 func Test_InviteUserToTeamAccount_Unauthorized(t *testing.T) {
 	m := createServiceMock(t, &Config{IsAuthEnabled: true})
@@ -478,6 +703,55 @@ func Test_InviteUserToTeamAccount_Unauthorized(t *testing.T) {
 	assert.Nil(t, resp)
 }
 
+func Test_InviteUserToTeamAccount_Role(t *testing.T) {
+	m := createServiceMock(t, &Config{IsAuthEnabled: true})
+	mockTx := new(nucleusdb.MockTx)
+	ctx := rbac.SetRequiredRoleInCtx(getJwtAuthenticatedCtxMock(mockAuthProvider), mgmtv1alpha1.AccountRole_ACCOUNT_ROLE_ADMIN)
+
+	accountUuid, _ := nucleusdb.ToUuid(mockAccountId)
+	userUuid, _ := nucleusdb.ToUuid(mockUserId)
+	mockVerifyUserInAccount(ctx, m.QuerierMock, accountUuid, userUuid, true)
+	mockVerifyTeamAccount(ctx, m.QuerierMock, accountUuid, true)
+	mockAccountUserRole(ctx, m.QuerierMock, accountUuid, userUuid, mgmtv1alpha1.AccountRole_ACCOUNT_ROLE_ADMIN)
+	m.DbtxMock.On("Begin", ctx).Return(mockTx, nil)
+	m.QuerierMock.On("GetAccount", ctx, mockTx, accountUuid).Return(db_queries.NeosyncApiAccount{ID: accountUuid, AccountType: 1}, nil)
+	m.QuerierMock.On("UpdateActiveAccountInvitesToExpired", ctx, mockTx, mock.Anything).Return(db_queries.NeosyncApiAccountInvite{}, nil)
+	m.QuerierMock.On("CreateAccountInvite", ctx, mockTx, mock.MatchedBy(func(params db_queries.CreateAccountInviteParams) bool {
+		return params.Role == int16(mgmtv1alpha1.AccountRole_ACCOUNT_ROLE_JOB_OPERATOR)
+	})).Return(db_queries.NeosyncApiAccountInvite{
+		AccountID: accountUuid,
+		Email:     "test-email",
+		Role:      int16(mgmtv1alpha1.AccountRole_ACCOUNT_ROLE_JOB_OPERATOR),
+	}, nil)
+	mockTx.On("Commit", ctx).Return(nil)
+	mockTx.On("Rollback", ctx).Return(nil)
+
+	role := mgmtv1alpha1.AccountRole_ACCOUNT_ROLE_JOB_OPERATOR
+	resp, err := m.Service.InviteUserToTeamAccount(ctx, &connect.Request[mgmtv1alpha1.InviteUserToTeamAccountRequest]{Msg: &mgmtv1alpha1.InviteUserToTeamAccountRequest{AccountId: mockAccountId, Email: "test-email", Role: &role}})
+
+	require.NoError(t, err)
+	assert.Equal(t, mgmtv1alpha1.AccountRole_ACCOUNT_ROLE_JOB_OPERATOR, resp.Msg.Invite.Role)
+}
+
+func Test_InviteUserToTeamAccount_OwnerRole_NotOwner(t *testing.T) {
+	m := createServiceMock(t, &Config{IsAuthEnabled: true})
+	ctx := rbac.SetRequiredRoleInCtx(getJwtAuthenticatedCtxMock(mockAuthProvider), mgmtv1alpha1.AccountRole_ACCOUNT_ROLE_ADMIN)
+
+	accountUuid, _ := nucleusdb.ToUuid(mockAccountId)
+	userUuid, _ := nucleusdb.ToUuid(mockUserId)
+	mockVerifyUserInAccount(ctx, m.QuerierMock, accountUuid, userUuid, true)
+	mockVerifyTeamAccount(ctx, m.QuerierMock, accountUuid, true)
+	mockAccountUserRole(ctx, m.QuerierMock, accountUuid, userUuid, mgmtv1alpha1.AccountRole_ACCOUNT_ROLE_ADMIN)
+
+	role := mgmtv1alpha1.AccountRole_ACCOUNT_ROLE_OWNER
+	resp, err := m.Service.InviteUserToTeamAccount(ctx, &connect.Request[mgmtv1alpha1.InviteUserToTeamAccountRequest]{Msg: &mgmtv1alpha1.InviteUserToTeamAccountRequest{AccountId: mockAccountId, Email: "test-email", Role: &role}})
+
+	m.QuerierMock.AssertNotCalled(t, "CreateAccountInvite", mock.Anything, mock.Anything, mock.Anything)
+	assert.Error(t, err)
+	assert.Equal(t, connect.CodePermissionDenied, connect.CodeOf(err))
+	assert.Nil(t, resp)
+}
+
 func Test_InviteUserToTeamAccount_NotTeamAccount(t *testing.T) {
 	m := createServiceMock(t, &Config{IsAuthEnabled: true})
 	ctx := getJwtAuthenticatedCtxMock(mockAuthProvider)
@@ -601,6 +875,7 @@ func Test_AcceptTeamAccountInvite(t *testing.T) {
 		ExpiresAt:    expiresAt,
 		ID:           inviteUuid,
 		Accepted:     pgtype.Bool{Bool: false},
+		Role:         int16(mgmtv1alpha1.AccountRole_ACCOUNT_ROLE_VIEWER),
 	}, nil)
 	m.AuthClientMock.On("GetUserInfo", ctx, mock.Anything).Return(&auth_client.UserInfo{Email: email}, nil)
 	m.QuerierMock.On("UpdateAccountInviteToAccepted", ctx, mockTx, inviteUuid).Return(db_queries.NeosyncApiAccountInvite{}, nil)
@@ -611,6 +886,7 @@ func Test_AcceptTeamAccountInvite(t *testing.T) {
 	m.QuerierMock.On("CreateAccountUserAssociation", ctx, mockTx, db_queries.CreateAccountUserAssociationParams{
 		AccountID: accountUuid,
 		UserID:    userUuid,
+		Role:      int16(mgmtv1alpha1.AccountRole_ACCOUNT_ROLE_VIEWER),
 	}).Return(db_queries.NeosyncApiAccountUserAssociation{}, nil)
 	mockTx.On("Rollback", ctx).Return(nil)
 	mockTx.On("Commit", ctx).Return(nil)
@@ -659,6 +935,7 @@ func Test_AcceptTeamAccountInvite_JwtEmail(t *testing.T) {
 		ExpiresAt:    expiresAt,
 		ID:           inviteUuid,
 		Accepted:     pgtype.Bool{Bool: false},
+		Role:         int16(mgmtv1alpha1.AccountRole_ACCOUNT_ROLE_VIEWER),
 	}, nil)
 	m.QuerierMock.On("UpdateAccountInviteToAccepted", ctx, mockTx, inviteUuid).Return(db_queries.NeosyncApiAccountInvite{}, nil)
 	m.QuerierMock.On("GetAccountUserAssociation", ctx, mockTx, db_queries.GetAccountUserAssociationParams{
@@ -668,6 +945,7 @@ func Test_AcceptTeamAccountInvite_JwtEmail(t *testing.T) {
 	m.QuerierMock.On("CreateAccountUserAssociation", ctx, mockTx, db_queries.CreateAccountUserAssociationParams{
 		AccountID: accountUuid,
 		UserID:    userUuid,
+		Role:      int16(mgmtv1alpha1.AccountRole_ACCOUNT_ROLE_VIEWER),
 	}).Return(db_queries.NeosyncApiAccountUserAssociation{}, nil)
 	mockTx.On("Rollback", ctx).Return(nil)
 	mockTx.On("Commit", ctx).Return(nil)
@@ -741,6 +1019,18 @@ func getApiKeyAuthenticatedCtxMock(userId string) context.Context {
 	return context.WithValue(context.Background(), auth_apikey.TokenContextKey{}, &data)
 }
 
+func getApiKeyCreatedByCtxMock(userId, createdById string) context.Context {
+	idUuid, _ := nucleusdb.ToUuid(userId)
+	createdByUuid, _ := nucleusdb.ToUuid(createdById)
+	accountUuid, _ := nucleusdb.ToUuid(mockAccountId)
+	data := auth_apikey.TokenContextData{ApiKeyType: apikey.AccountApiKey, RawToken: "", ApiKey: &db_queries.NeosyncApiAccountApiKey{
+		UserID:      idUuid,
+		AccountID:   accountUuid,
+		CreatedByID: createdByUuid,
+	}}
+	return context.WithValue(context.Background(), auth_apikey.TokenContextKey{}, &data)
+}
+
 //nolint:all
 func getUserIdentityProviderAssociationMock(userId, providerId string) db_queries.NeosyncApiUserIdentityProviderAssociation {
 	idUuid, _ := nucleusdb.ToUuid(userId)
@@ -776,6 +1066,24 @@ func mockVerifyUserInAccount(ctx context.Context, querierMock *db_queries.MockQu
 	}).Return(int64(0), nil)
 }
 
+func mockAccountUserRole(ctx context.Context, querierMock *db_queries.MockQuerier, accountUuid, userUuid pgtype.UUID, role mgmtv1alpha1.AccountRole) {
+	querierMock.On("GetAccountUserAssociation", ctx, mock.Anything, db_queries.GetAccountUserAssociationParams{
+		AccountId: accountUuid,
+		UserId:    userUuid,
+	}).Return(db_queries.NeosyncApiAccountUserAssociation{
+		AccountID: accountUuid,
+		UserID:    userUuid,
+		Role:      int16(role),
+	}, nil)
+}
+
+func mockAccountOwnerCount(ctx context.Context, querierMock *db_queries.MockQuerier, accountUuid pgtype.UUID, count int64) {
+	querierMock.On("GetAccountUserCountByRole", ctx, mock.Anything, db_queries.GetAccountUserCountByRoleParams{
+		AccountId: accountUuid,
+		Role:      int16(mgmtv1alpha1.AccountRole_ACCOUNT_ROLE_OWNER),
+	}).Return(count, nil)
+}
+
 func mockVerifyTeamAccount(ctx context.Context, querierMock *db_queries.MockQuerier, accountUuid pgtype.UUID, isTeamAccount bool) {
 	var accountType = 0
 	if isTeamAccount {
@@ -0,0 +1,33 @@
+package v1alpha1_webhookservice
+
+import (
+	"github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1/mgmtv1alpha1connect"
+	"github.com/nucleuscloud/neosync/backend/internal/nucleusdb"
+	"github.com/nucleuscloud/neosync/backend/internal/webhooks"
+)
+
+type Service struct {
+	cfg                *Config
+	db                 *nucleusdb.NucleusDb
+	useraccountService mgmtv1alpha1connect.UserAccountServiceClient
+	dispatcher         *webhooks.Dispatcher
+}
+
+type Config struct {
+	// Allows webhooks to be sent to loopback, private and link-local addresses
+	AllowPrivateNetworks bool
+}
+
+func New(
+	cfg *Config,
+	db *nucleusdb.NucleusDb,
+	useraccountService mgmtv1alpha1connect.UserAccountServiceClient,
+	dispatcher *webhooks.Dispatcher,
+) *Service {
+	return &Service{
+		cfg:                cfg,
+		db:                 db,
+		useraccountService: useraccountService,
+		dispatcher:         dispatcher,
+	}
+}
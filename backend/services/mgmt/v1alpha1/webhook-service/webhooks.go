@@ -0,0 +1,294 @@
+package v1alpha1_webhookservice
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"connectrpc.com/connect"
+	"github.com/jackc/pgx/v5/pgtype"
+	db_queries "github.com/nucleuscloud/neosync/backend/gen/go/db"
+	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
+	logger_interceptor "github.com/nucleuscloud/neosync/backend/internal/connect/interceptors/logger"
+	"github.com/nucleuscloud/neosync/backend/internal/dtomaps"
+	nucleuserrors "github.com/nucleuscloud/neosync/backend/internal/errors"
+	"github.com/nucleuscloud/neosync/backend/internal/nucleusdb"
+	"github.com/nucleuscloud/neosync/backend/internal/webhooks"
+)
+
+const (
+	defaultDeliveriesLimit = 100
+)
+
+func (s *Service) GetWebhooks(
+	ctx context.Context,
+	req *connect.Request[mgmtv1alpha1.GetWebhooksRequest],
+) (*connect.Response[mgmtv1alpha1.GetWebhooksResponse], error) {
+	accountUuid, err := s.verifyUserInAccount(ctx, req.Msg.AccountId)
+	if err != nil {
+		return nil, err
+	}
+
+	hooks, err := s.db.Q.GetWebhooksByAccount(ctx, s.db.Db, *accountUuid)
+	if err != nil {
+		return nil, err
+	}
+
+	dtos := make([]*mgmtv1alpha1.Webhook, len(hooks))
+	for idx := range hooks {
+		hook := hooks[idx]
+		dtos[idx] = dtomaps.ToWebhookDto(&hook, nil)
+	}
+
+	return connect.NewResponse(&mgmtv1alpha1.GetWebhooksResponse{
+		Webhooks: dtos,
+	}), nil
+}
+
+func (s *Service) GetWebhook(
+	ctx context.Context,
+	req *connect.Request[mgmtv1alpha1.GetWebhookRequest],
+) (*connect.Response[mgmtv1alpha1.GetWebhookResponse], error) {
+	hook, err := s.getVerifiedWebhook(ctx, req.Msg.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&mgmtv1alpha1.GetWebhookResponse{
+		Webhook: dtomaps.ToWebhookDto(hook, nil),
+	}), nil
+}
+
+func (s *Service) CreateWebhook(
+	ctx context.Context,
+	req *connect.Request[mgmtv1alpha1.CreateWebhookRequest],
+) (*connect.Response[mgmtv1alpha1.CreateWebhookResponse], error) {
+	accountUuid, err := s.verifyUserInAccount(ctx, req.Msg.AccountId)
+	if err != nil {
+		return nil, err
+	}
+	userUuid, err := s.getUserUuid(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := validateWebhookUrl(req.Msg.Url, s.cfg.AllowPrivateNetworks); err != nil {
+		return nil, err
+	}
+
+	secret := req.Msg.GetSecret()
+	if req.Msg.Secret == nil {
+		secret, err = webhooks.NewSecret()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	hook, err := s.db.Q.CreateWebhook(ctx, s.db.Db, db_queries.CreateWebhookParams{
+		Name:        req.Msg.Name,
+		AccountID:   *accountUuid,
+		Url:         req.Msg.Url,
+		Secret:      secret,
+		EventTypes:  toEventTypeValues(req.Msg.EventTypes),
+		Enabled:     req.Msg.Enabled,
+		CreatedByID: *userUuid,
+		UpdatedByID: *userUuid,
+	})
+	if err != nil && nucleusdb.IsConflict(err) {
+		return nil, nucleuserrors.NewAlreadyExists("a webhook with that name already exists in this account")
+	} else if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&mgmtv1alpha1.CreateWebhookResponse{
+		Webhook: dtomaps.ToWebhookDto(&hook, &secret),
+	}), nil
+}
+
+func (s *Service) UpdateWebhook(
+	ctx context.Context,
+	req *connect.Request[mgmtv1alpha1.UpdateWebhookRequest],
+) (*connect.Response[mgmtv1alpha1.UpdateWebhookResponse], error) {
+	hook, err := s.getVerifiedWebhook(ctx, req.Msg.Id)
+	if err != nil {
+		return nil, err
+	}
+	userUuid, err := s.getUserUuid(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := validateWebhookUrl(req.Msg.Url, s.cfg.AllowPrivateNetworks); err != nil {
+		return nil, err
+	}
+
+	params := db_queries.UpdateWebhookParams{
+		ID:          hook.ID,
+		Name:        req.Msg.Name,
+		Url:         req.Msg.Url,
+		EventTypes:  toEventTypeValues(req.Msg.EventTypes),
+		Enabled:     req.Msg.Enabled,
+		UpdatedById: *userUuid,
+	}
+	if req.Msg.Secret != nil {
+		params.Secret = pgtype.Text{String: req.Msg.GetSecret(), Valid: true}
+	}
+	updated, err := s.db.Q.UpdateWebhook(ctx, s.db.Db, params)
+	if err != nil && nucleusdb.IsConflict(err) {
+		return nil, nucleuserrors.NewAlreadyExists("a webhook with that name already exists in this account")
+	} else if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&mgmtv1alpha1.UpdateWebhookResponse{
+		Webhook: dtomaps.ToWebhookDto(&updated, req.Msg.Secret),
+	}), nil
+}
+
+func (s *Service) DeleteWebhook(
+	ctx context.Context,
+	req *connect.Request[mgmtv1alpha1.DeleteWebhookRequest],
+) (*connect.Response[mgmtv1alpha1.DeleteWebhookResponse], error) {
+	hookUuid, err := nucleusdb.ToUuid(req.Msg.Id)
+	if err != nil {
+		return nil, err
+	}
+	hook, err := s.db.Q.GetWebhookById(ctx, s.db.Db, hookUuid)
+	if err != nil && !nucleusdb.IsNoRows(err) {
+		return nil, err
+	} else if err != nil && nucleusdb.IsNoRows(err) {
+		return connect.NewResponse(&mgmtv1alpha1.DeleteWebhookResponse{}), nil
+	}
+
+	_, err = s.verifyUserInAccount(ctx, nucleusdb.UUIDString(hook.AccountID))
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.db.Q.RemoveWebhookById(ctx, s.db.Db, hookUuid)
+	if err != nil && !nucleusdb.IsNoRows(err) {
+		return nil, err
+	}
+	return connect.NewResponse(&mgmtv1alpha1.DeleteWebhookResponse{}), nil
+}
+
+func (s *Service) GetWebhookDeliveries(
+	ctx context.Context,
+	req *connect.Request[mgmtv1alpha1.GetWebhookDeliveriesRequest],
+) (*connect.Response[mgmtv1alpha1.GetWebhookDeliveriesResponse], error) {
+	hook, err := s.getVerifiedWebhook(ctx, req.Msg.WebhookId)
+	if err != nil {
+		return nil, err
+	}
+
+	limit := int32(defaultDeliveriesLimit)
+	if req.Msg.Limit != nil {
+		limit = int32(req.Msg.GetLimit())
+	}
+	deliveries, err := s.db.Q.GetWebhookDeliveries(ctx, s.db.Db, db_queries.GetWebhookDeliveriesParams{
+		WebhookId: hook.ID,
+		Limit:     limit,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	dtos := make([]*mgmtv1alpha1.WebhookDelivery, len(deliveries))
+	for idx := range deliveries {
+		delivery := deliveries[idx]
+		dtos[idx] = dtomaps.ToWebhookDeliveryDto(&delivery)
+	}
+	return connect.NewResponse(&mgmtv1alpha1.GetWebhookDeliveriesResponse{
+		Deliveries: dtos,
+	}), nil
+}
+
+func (s *Service) PublishJobRunEvent(
+	ctx context.Context,
+	req *connect.Request[mgmtv1alpha1.PublishJobRunEventRequest],
+) (*connect.Response[mgmtv1alpha1.PublishJobRunEventResponse], error) {
+	logger := logger_interceptor.GetLoggerFromContextOrDefault(ctx)
+	logger = logger.With("jobId", req.Msg.JobId, "jobRunId", req.Msg.JobRunId)
+
+	jobUuid, err := nucleusdb.ToUuid(req.Msg.JobId)
+	if err != nil {
+		return nil, err
+	}
+	job, err := s.db.Q.GetJobById(ctx, s.db.Db, jobUuid)
+	if err != nil && !nucleusdb.IsNoRows(err) {
+		return nil, err
+	} else if err != nil && nucleusdb.IsNoRows(err) {
+		return nil, nucleuserrors.NewNotFound("unable to find job")
+	}
+	accountId := nucleusdb.UUIDString(job.AccountID)
+	if !isWorkerApiKey(ctx) {
+		if _, err := s.verifyUserInAccount(ctx, accountId); err != nil {
+			return nil, err
+		}
+	}
+
+	data := map[string]any{
+		"jobId":    req.Msg.JobId,
+		"jobName":  job.Name,
+		"jobRunId": req.Msg.JobRunId,
+	}
+	if req.Msg.Error != nil {
+		data["error"] = req.Msg.GetError()
+	}
+	event := webhooks.NewEvent(req.Msg.EventType, accountId, data)
+	count, err := s.dispatcher.Dispatch(ctx, event)
+	if err != nil {
+		return nil, err
+	}
+	logger.Debug(fmt.Sprintf("sending %s event to %d webhooks", event.Type, count))
+	return connect.NewResponse(&mgmtv1alpha1.PublishJobRunEventResponse{}), nil
+}
+
+func (s *Service) getVerifiedWebhook(
+	ctx context.Context,
+	id string,
+) (*db_queries.NeosyncApiWebhook, error) {
+	hookUuid, err := nucleusdb.ToUuid(id)
+	if err != nil {
+		return nil, err
+	}
+	hook, err := s.db.Q.GetWebhookById(ctx, s.db.Db, hookUuid)
+	if err != nil && !nucleusdb.IsNoRows(err) {
+		return nil, err
+	} else if err != nil && nucleusdb.IsNoRows(err) {
+		return nil, nucleuserrors.NewNotFound("unable to find webhook")
+	}
+
+	_, err = s.verifyUserInAccount(ctx, nucleusdb.UUIDString(hook.AccountID))
+	if err != nil {
+		return nil, err
+	}
+	return &hook, nil
+}
+
+// Events are only sent over http(s), and only to public addresses unless private networks are allowed.
+// Hosts that resolve to a private address are also refused when the event is sent.
+func validateWebhookUrl(input string, allowPrivateNetworks bool) error {
+	parsed, err := url.Parse(input)
+	if err != nil {
+		return nucleuserrors.NewBadRequest(fmt.Sprintf("invalid webhook url: %s", err.Error()))
+	}
+	if parsed.Scheme != "http" && parsed.Scheme != "https" {
+		return nucleuserrors.NewBadRequest("webhook url must use the http or https scheme")
+	}
+	if parsed.Host == "" {
+		return nucleuserrors.NewBadRequest("webhook url must include a host")
+	}
+	if !allowPrivateNetworks {
+		if err := webhooks.VerifyHost(parsed.Hostname()); err != nil {
+			return nucleuserrors.NewBadRequest(err.Error())
+		}
+	}
+	return nil
+}
+
+func toEventTypeValues(eventTypes []mgmtv1alpha1.WebhookEventType) []int32 {
+	values := make([]int32, len(eventTypes))
+	for idx, eventType := range eventTypes {
+		values[idx] = int32(eventType)
+	}
+	return values
+}
@@ -0,0 +1,325 @@
+package v1alpha1_webhookservice
+
+import (
+	"context"
+	"database/sql"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	db_queries "github.com/nucleuscloud/neosync/backend/gen/go/db"
+	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
+	"github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1/mgmtv1alpha1connect"
+	"github.com/nucleuscloud/neosync/backend/internal/nucleusdb"
+	"github.com/nucleuscloud/neosync/backend/internal/webhooks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	mockUserId = "d5e29f1f-b920-458c-8b86-f3a180e06d98"
+)
+
+type serviceMocks struct {
+	Service                *Service
+	DbtxMock               *nucleusdb.MockDBTX
+	QuerierMock            *db_queries.MockQuerier
+	UserAccountServiceMock *mgmtv1alpha1connect.MockUserAccountServiceClient
+}
+
+func createServiceMock(t *testing.T) *serviceMocks {
+	t.Helper()
+	mockDbtx := nucleusdb.NewMockDBTX(t)
+	mockQuerier := db_queries.NewMockQuerier(t)
+	mockUserAccountService := mgmtv1alpha1connect.NewMockUserAccountServiceClient(t)
+
+	// test receivers listen on loopback
+	dispatcher := webhooks.NewDispatcher(mockQuerier, mockDbtx, slog.Default(), &webhooks.Config{MaxAttempts: 2, InitialBackoff: time.Millisecond, AllowPrivateNetworks: true})
+	svc := New(&Config{}, nucleusdb.New(mockDbtx, mockQuerier), mockUserAccountService, dispatcher)
+	return &serviceMocks{
+		Service:                svc,
+		DbtxMock:               mockDbtx,
+		QuerierMock:            mockQuerier,
+		UserAccountServiceMock: mockUserAccountService,
+	}
+}
+
+func Test_Service_CreateWebhook(t *testing.T) {
+	m := createServiceMock(t)
+	accountId := uuid.NewString()
+	mockIsUserInAccount(m.UserAccountServiceMock, true)
+	mockGetUser(m.UserAccountServiceMock)
+
+	var params db_queries.CreateWebhookParams
+	m.QuerierMock.On("CreateWebhook", mock.Anything, mock.Anything, mock.Anything).
+		Run(func(args mock.Arguments) {
+			params = args.Get(2).(db_queries.CreateWebhookParams)
+		}).
+		Return(func(_ context.Context, _ db_queries.DBTX, arg db_queries.CreateWebhookParams) db_queries.NeosyncApiWebhook {
+			return newWebhook(t, arg.AccountID, arg.Url, arg.Secret, arg.EventTypes)
+		}, nil)
+
+	resp, err := m.Service.CreateWebhook(context.Background(), connect.NewRequest(&mgmtv1alpha1.CreateWebhookRequest{
+		AccountId:  accountId,
+		Name:       "slack-alerts",
+		Url:        "https://hooks.example.com/neosync",
+		EventTypes: []mgmtv1alpha1.WebhookEventType{mgmtv1alpha1.WebhookEventType_WEBHOOK_EVENT_TYPE_JOB_RUN_FAILED},
+		Enabled:    true,
+	}))
+	require.NoError(t, err)
+
+	assert.Equal(t, accountId, nucleusdb.UUIDString(params.AccountID))
+	assert.Equal(t, mockUserId, nucleusdb.UUIDString(params.CreatedByID))
+	assert.Equal(t, []int32{int32(mgmtv1alpha1.WebhookEventType_WEBHOOK_EVENT_TYPE_JOB_RUN_FAILED)}, params.EventTypes)
+	assert.True(t, params.Enabled)
+	assert.Regexp(t, "^whsec_", params.Secret, "a secret must be generated if one is not provided")
+
+	hook := resp.Msg.Webhook
+	assert.Equal(t, params.Secret, hook.GetSecret(), "the secret must be returned on creation")
+	assert.Equal(t, []mgmtv1alpha1.WebhookEventType{mgmtv1alpha1.WebhookEventType_WEBHOOK_EVENT_TYPE_JOB_RUN_FAILED}, hook.EventTypes)
+}
+
+func Test_Service_CreateWebhook_InvalidUrl(t *testing.T) {
+	m := createServiceMock(t)
+	mockIsUserInAccount(m.UserAccountServiceMock, true)
+	mockGetUser(m.UserAccountServiceMock)
+
+	resp, err := m.Service.CreateWebhook(context.Background(), connect.NewRequest(&mgmtv1alpha1.CreateWebhookRequest{
+		AccountId: uuid.NewString(),
+		Name:      "bad-hook",
+		Url:       "ftp://hooks.example.com/neosync",
+	}))
+	assert.Error(t, err)
+	assert.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+	assert.Nil(t, resp)
+}
+
+func Test_Service_CreateWebhook_PrivateAddress(t *testing.T) {
+	urls := []string{
+		"http://localhost:8080/neosync",
+		"http://127.0.0.1/neosync",
+		"http://10.0.0.5/neosync",
+		"http://192.168.1.10/neosync",
+		"http://169.254.169.254/latest/meta-data",
+		"http://[::1]:8080/neosync",
+		"http://[::ffff:127.0.0.1]/neosync",
+	}
+	for _, webhookUrl := range urls {
+		webhookUrl := webhookUrl
+		t.Run(webhookUrl, func(t *testing.T) {
+			m := createServiceMock(t)
+			mockIsUserInAccount(m.UserAccountServiceMock, true)
+			mockGetUser(m.UserAccountServiceMock)
+
+			resp, err := m.Service.CreateWebhook(context.Background(), connect.NewRequest(&mgmtv1alpha1.CreateWebhookRequest{
+				AccountId: uuid.NewString(),
+				Name:      "internal-hook",
+				Url:       webhookUrl,
+			}))
+			assert.Error(t, err)
+			assert.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+			assert.Nil(t, resp)
+		})
+	}
+}
+
+func Test_validateWebhookUrl_AllowPrivateNetworks(t *testing.T) {
+	assert.NoError(t, validateWebhookUrl("http://10.0.0.5/neosync", true))
+	assert.NoError(t, validateWebhookUrl("https://hooks.example.com/neosync", false))
+}
+
+func Test_Service_GetWebhook_DoesNotReturnSecret(t *testing.T) {
+	m := createServiceMock(t)
+	accountUuid := newPgUuid(t)
+	hook := newWebhook(t, accountUuid, "https://hooks.example.com/neosync", "whsec_secret", nil)
+	m.QuerierMock.On("GetWebhookById", mock.Anything, mock.Anything, hook.ID).Return(hook, nil)
+	mockIsUserInAccount(m.UserAccountServiceMock, true)
+
+	resp, err := m.Service.GetWebhook(context.Background(), connect.NewRequest(&mgmtv1alpha1.GetWebhookRequest{
+		Id: nucleusdb.UUIDString(hook.ID),
+	}))
+	require.NoError(t, err)
+	assert.Nil(t, resp.Msg.Webhook.Secret)
+	assert.Empty(t, resp.Msg.Webhook.EventTypes)
+}
+
+func Test_Service_GetWebhook_ForbiddenAccount(t *testing.T) {
+	m := createServiceMock(t)
+	hook := newWebhook(t, newPgUuid(t), "https://hooks.example.com/neosync", "whsec_secret", nil)
+	m.QuerierMock.On("GetWebhookById", mock.Anything, mock.Anything, hook.ID).Return(hook, nil)
+	mockIsUserInAccount(m.UserAccountServiceMock, false)
+
+	resp, err := m.Service.GetWebhook(context.Background(), connect.NewRequest(&mgmtv1alpha1.GetWebhookRequest{
+		Id: nucleusdb.UUIDString(hook.ID),
+	}))
+	assert.Error(t, err)
+	assert.Nil(t, resp)
+}
+
+func Test_Service_UpdateWebhook_KeepsSecret(t *testing.T) {
+	m := createServiceMock(t)
+	hook := newWebhook(t, newPgUuid(t), "https://hooks.example.com/neosync", "whsec_secret", nil)
+	m.QuerierMock.On("GetWebhookById", mock.Anything, mock.Anything, hook.ID).Return(hook, nil)
+	mockIsUserInAccount(m.UserAccountServiceMock, true)
+	mockGetUser(m.UserAccountServiceMock)
+
+	var params db_queries.UpdateWebhookParams
+	m.QuerierMock.On("UpdateWebhook", mock.Anything, mock.Anything, mock.Anything).
+		Run(func(args mock.Arguments) {
+			params = args.Get(2).(db_queries.UpdateWebhookParams)
+		}).
+		Return(hook, nil)
+
+	resp, err := m.Service.UpdateWebhook(context.Background(), connect.NewRequest(&mgmtv1alpha1.UpdateWebhookRequest{
+		Id:      nucleusdb.UUIDString(hook.ID),
+		Name:    "renamed",
+		Url:     "https://hooks.example.com/other",
+		Enabled: false,
+	}))
+	require.NoError(t, err)
+	assert.False(t, params.Secret.Valid)
+	assert.Equal(t, "renamed", params.Name)
+	assert.Equal(t, mockUserId, nucleusdb.UUIDString(params.UpdatedById))
+	assert.Nil(t, resp.Msg.Webhook.Secret)
+}
+
+func Test_Service_DeleteWebhook_NotFound(t *testing.T) {
+	m := createServiceMock(t)
+	m.QuerierMock.On("GetWebhookById", mock.Anything, mock.Anything, mock.Anything).
+		Return(db_queries.NeosyncApiWebhook{}, sql.ErrNoRows)
+
+	resp, err := m.Service.DeleteWebhook(context.Background(), connect.NewRequest(&mgmtv1alpha1.DeleteWebhookRequest{
+		Id: uuid.NewString(),
+	}))
+	require.NoError(t, err)
+	assert.NotNil(t, resp)
+}
+
+func Test_Service_GetWebhookDeliveries(t *testing.T) {
+	m := createServiceMock(t)
+	hook := newWebhook(t, newPgUuid(t), "https://hooks.example.com/neosync", "whsec_secret", nil)
+	m.QuerierMock.On("GetWebhookById", mock.Anything, mock.Anything, hook.ID).Return(hook, nil)
+	mockIsUserInAccount(m.UserAccountServiceMock, true)
+
+	var params db_queries.GetWebhookDeliveriesParams
+	m.QuerierMock.On("GetWebhookDeliveries", mock.Anything, mock.Anything, mock.Anything).
+		Run(func(args mock.Arguments) {
+			params = args.Get(2).(db_queries.GetWebhookDeliveriesParams)
+		}).
+		Return([]db_queries.NeosyncApiWebhookDelivery{
+			{
+				ID:         newPgUuid(t),
+				WebhookID:  hook.ID,
+				EventID:    newPgUuid(t),
+				EventType:  int32(mgmtv1alpha1.WebhookEventType_WEBHOOK_EVENT_TYPE_JOB_RUN_SUCCEEDED),
+				Payload:    []byte(`{"type":"job_run.succeeded"}`),
+				Attempt:    2,
+				StatusCode: pgtype.Int4{Int32: 200, Valid: true},
+				Success:    true,
+			},
+		}, nil)
+
+	resp, err := m.Service.GetWebhookDeliveries(context.Background(), connect.NewRequest(&mgmtv1alpha1.GetWebhookDeliveriesRequest{
+		WebhookId: nucleusdb.UUIDString(hook.ID),
+	}))
+	require.NoError(t, err)
+	assert.Equal(t, int32(defaultDeliveriesLimit), params.Limit)
+	require.Len(t, resp.Msg.Deliveries, 1)
+	delivery := resp.Msg.Deliveries[0]
+	assert.Equal(t, uint32(2), delivery.Attempt)
+	assert.Equal(t, int32(200), delivery.GetStatusCode())
+	assert.Nil(t, delivery.Error)
+	assert.Equal(t, mgmtv1alpha1.WebhookEventType_WEBHOOK_EVENT_TYPE_JOB_RUN_SUCCEEDED, delivery.EventType)
+}
+
+func Test_Service_PublishJobRunEvent(t *testing.T) {
+	received := make(chan *http.Request, 1)
+	var body []byte
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ = io.ReadAll(r.Body)
+		received <- r
+	}))
+	defer server.Close()
+
+	m := createServiceMock(t)
+	accountUuid := newPgUuid(t)
+	job := db_queries.NeosyncApiJob{ID: newPgUuid(t), AccountID: accountUuid, Name: "nightly-sync"}
+	m.QuerierMock.On("GetJobById", mock.Anything, mock.Anything, job.ID).Return(job, nil)
+	mockIsUserInAccount(m.UserAccountServiceMock, true)
+
+	hook := newWebhook(t, accountUuid, server.URL, "whsec_secret", nil)
+	var webhookParams db_queries.GetEnabledWebhooksByEventTypeParams
+	m.QuerierMock.On("GetEnabledWebhooksByEventType", mock.Anything, mock.Anything, mock.Anything).
+		Run(func(args mock.Arguments) {
+			webhookParams = args.Get(2).(db_queries.GetEnabledWebhooksByEventTypeParams)
+		}).
+		Return([]db_queries.NeosyncApiWebhook{hook}, nil)
+	m.QuerierMock.On("CreateWebhookDelivery", mock.Anything, mock.Anything, mock.Anything).
+		Return(db_queries.NeosyncApiWebhookDelivery{}, nil).Once()
+
+	errMsg := "unable to connect to destination"
+	_, err := m.Service.PublishJobRunEvent(context.Background(), connect.NewRequest(&mgmtv1alpha1.PublishJobRunEventRequest{
+		JobId:     nucleusdb.UUIDString(job.ID),
+		JobRunId:  "nightly-sync-2024-04-13T00:00:00Z",
+		EventType: mgmtv1alpha1.WebhookEventType_WEBHOOK_EVENT_TYPE_JOB_RUN_FAILED,
+		Error:     &errMsg,
+	}))
+	require.NoError(t, err)
+	m.Service.dispatcher.Wait()
+
+	assert.Equal(t, accountUuid, webhookParams.AccountId)
+	assert.Equal(t, int32(mgmtv1alpha1.WebhookEventType_WEBHOOK_EVENT_TYPE_JOB_RUN_FAILED), webhookParams.EventType)
+
+	req := <-received
+	assert.Equal(t, "job_run.failed", req.Header.Get(webhooks.EventTypeHeader))
+	assert.True(t, webhooks.VerifySignature("whsec_secret", req.Header.Get(webhooks.SignatureHeader), body, time.Minute))
+	assert.Contains(t, string(body), `"jobName":"nightly-sync"`)
+	assert.Contains(t, string(body), errMsg)
+}
+
+func newWebhook(t *testing.T, accountUuid pgtype.UUID, url, secret string, eventTypes []int32) db_queries.NeosyncApiWebhook {
+	t.Helper()
+	userUuid, err := nucleusdb.ToUuid(mockUserId)
+	require.NoError(t, err)
+	if eventTypes == nil {
+		eventTypes = []int32{}
+	}
+	return db_queries.NeosyncApiWebhook{
+		ID:          newPgUuid(t),
+		AccountID:   accountUuid,
+		Name:        "test-hook",
+		Url:         url,
+		Secret:      secret,
+		EventTypes:  eventTypes,
+		Enabled:     true,
+		CreatedByID: userUuid,
+		UpdatedByID: userUuid,
+	}
+}
+
+func newPgUuid(t *testing.T) pgtype.UUID {
+	t.Helper()
+	newuuid := uuid.NewString()
+	val, err := nucleusdb.ToUuid(newuuid)
+	assert.NoError(t, err)
+	return val
+}
+
+func mockIsUserInAccount(userAccountServiceMock *mgmtv1alpha1connect.MockUserAccountServiceClient, isInAccount bool) {
+	userAccountServiceMock.On("IsUserInAccount", mock.Anything, mock.Anything).Return(connect.NewResponse(&mgmtv1alpha1.IsUserInAccountResponse{
+		Ok: isInAccount,
+	}), nil)
+}
+
+func mockGetUser(userAccountServiceMock *mgmtv1alpha1connect.MockUserAccountServiceClient) {
+	userAccountServiceMock.On("GetUser", mock.Anything, mock.Anything).Return(connect.NewResponse(&mgmtv1alpha1.GetUserResponse{
+		UserId: mockUserId,
+	}), nil)
+}
@@ -12,6 +12,72 @@ type ConnectionConfig struct {
 	AwsS3Config          *AwsS3ConnectionConfig          `json:"awsS3Config,omitempty"`
 	MysqlConfig          *MysqlConnectionConfig          `json:"mysqlConfig,omitempty"`
 	LocalDirectoryConfig *LocalDirectoryConnectionConfig `json:"localDirConfig,omitempty"`
+	MssqlConfig          *MssqlConnectionConfig          `json:"mssqlConfig,omitempty"`
+	SqliteConfig         *SqliteConnectionConfig         `json:"sqliteConfig,omitempty"`
+
+	// Set when the secret values of the config have been encrypted at rest
+	EncryptedDataKey *EncryptedDataKey `json:"encryptedDataKey,omitempty"`
+}
+
+// The data key that the secret values of a connection config were encrypted with, wrapped by a master key
+type EncryptedDataKey struct {
+	KeyId string `json:"keyId"`
+	// Base64 encoded wrapped data key
+	Value string `json:"value"`
+}
+
+// Returns pointers to the credentials of the connection config so that they can be encrypted or decrypted in place
+func (c *ConnectionConfig) SecretValues() []*string {
+	values := []*string{}
+	if c.PgConfig != nil {
+		if c.PgConfig.Connection != nil {
+			values = append(values, &c.PgConfig.Connection.Pass)
+		}
+		values = appendNonNil(values, c.PgConfig.Url)
+		values = append(values, c.PgConfig.SSHTunnel.secretValues()...)
+	}
+	if c.MysqlConfig != nil {
+		if c.MysqlConfig.Connection != nil {
+			values = append(values, &c.MysqlConfig.Connection.Pass)
+		}
+		values = appendNonNil(values, c.MysqlConfig.Url)
+		values = append(values, c.MysqlConfig.SSHTunnel.secretValues()...)
+	}
+	if c.MssqlConfig != nil {
+		if c.MssqlConfig.Connection != nil {
+			values = append(values, &c.MssqlConfig.Connection.Pass)
+		}
+		values = appendNonNil(values, c.MssqlConfig.Url)
+		values = append(values, c.MssqlConfig.SSHTunnel.secretValues()...)
+	}
+	if c.AwsS3Config != nil && c.AwsS3Config.Credentials != nil {
+		values = appendNonNil(values, c.AwsS3Config.Credentials.SecretAccessKey, c.AwsS3Config.Credentials.SessionToken)
+	}
+	return values
+}
+
+func (s *SSHTunnel) secretValues() []*string {
+	if s == nil || s.SSHAuthentication == nil {
+		return nil
+	}
+	values := []*string{}
+	if s.SSHAuthentication.SSHPassphrase != nil {
+		values = append(values, &s.SSHAuthentication.SSHPassphrase.Value)
+	}
+	if s.SSHAuthentication.SSHPrivateKey != nil {
+		values = append(values, &s.SSHAuthentication.SSHPrivateKey.Value)
+		values = appendNonNil(values, s.SSHAuthentication.SSHPrivateKey.Passphrase)
+	}
+	return values
+}
+
+func appendNonNil(values []*string, candidates ...*string) []*string {
+	for _, candidate := range candidates {
+		if candidate != nil {
+			values = append(values, candidate)
+		}
+	}
+	return values
 }
 
 func (c *ConnectionConfig) ToDto() *mgmtv1alpha1.ConnectionConfig {
@@ -85,6 +151,40 @@ func (c *ConnectionConfig) ToDto() *mgmtv1alpha1.ConnectionConfig {
 				},
 			}
 		}
+	} else if c.MssqlConfig != nil {
+		var tunnel *mgmtv1alpha1.SSHTunnel
+		if c.MssqlConfig.SSHTunnel != nil {
+			tunnel = c.MssqlConfig.SSHTunnel.ToDto()
+		}
+		if c.MssqlConfig.Connection != nil {
+			return &mgmtv1alpha1.ConnectionConfig{
+				Config: &mgmtv1alpha1.ConnectionConfig_MssqlConfig{
+					MssqlConfig: &mgmtv1alpha1.MssqlConnectionConfig{
+						ConnectionConfig: &mgmtv1alpha1.MssqlConnectionConfig_Connection{
+							Connection: &mgmtv1alpha1.MssqlConnection{
+								Host: c.MssqlConfig.Connection.Host,
+								Port: c.MssqlConfig.Connection.Port,
+								Name: c.MssqlConfig.Connection.Name,
+								User: c.MssqlConfig.Connection.User,
+								Pass: c.MssqlConfig.Connection.Pass,
+							},
+						},
+						Tunnel: tunnel,
+					},
+				},
+			}
+		} else if c.MssqlConfig.Url != nil {
+			return &mgmtv1alpha1.ConnectionConfig{
+				Config: &mgmtv1alpha1.ConnectionConfig_MssqlConfig{
+					MssqlConfig: &mgmtv1alpha1.MssqlConnectionConfig{
+						ConnectionConfig: &mgmtv1alpha1.MssqlConnectionConfig_Url{
+							Url: *c.MssqlConfig.Url,
+						},
+						Tunnel: tunnel,
+					},
+				},
+			}
+		}
 	} else if c.AwsS3Config != nil {
 		return &mgmtv1alpha1.ConnectionConfig{
 			Config: &mgmtv1alpha1.ConnectionConfig_AwsS3Config{
@@ -97,6 +197,12 @@ func (c *ConnectionConfig) ToDto() *mgmtv1alpha1.ConnectionConfig {
 				LocalDirConfig: c.LocalDirectoryConfig.ToDto(),
 			},
 		}
+	} else if c.SqliteConfig != nil {
+		return &mgmtv1alpha1.ConnectionConfig{
+			Config: &mgmtv1alpha1.ConnectionConfig_SqliteConfig{
+				SqliteConfig: c.SqliteConfig.ToDto(),
+			},
+		}
 	}
 	return nil
 }
@@ -145,6 +251,26 @@ func (c *ConnectionConfig) FromDto(dto *mgmtv1alpha1.ConnectionConfig) error {
 		default:
 			return fmt.Errorf("invalid mysql format")
 		}
+	case *mgmtv1alpha1.ConnectionConfig_MssqlConfig:
+		c.MssqlConfig = &MssqlConnectionConfig{}
+		if config.MssqlConfig.Tunnel != nil {
+			c.MssqlConfig.SSHTunnel = &SSHTunnel{}
+			c.MssqlConfig.SSHTunnel.FromDto(config.MssqlConfig.Tunnel)
+		}
+		switch mssqlcfg := config.MssqlConfig.ConnectionConfig.(type) {
+		case *mgmtv1alpha1.MssqlConnectionConfig_Connection:
+			c.MssqlConfig.Connection = &MssqlConnection{
+				Host: mssqlcfg.Connection.Host,
+				Port: mssqlcfg.Connection.Port,
+				Name: mssqlcfg.Connection.Name,
+				User: mssqlcfg.Connection.User,
+				Pass: mssqlcfg.Connection.Pass,
+			}
+		case *mgmtv1alpha1.MssqlConnectionConfig_Url:
+			c.MssqlConfig.Url = &mssqlcfg.Url
+		default:
+			return fmt.Errorf("invalid mssql format")
+		}
 	case *mgmtv1alpha1.ConnectionConfig_AwsS3Config:
 		c.AwsS3Config = &AwsS3ConnectionConfig{}
 		err := c.AwsS3Config.FromDto(config.AwsS3Config)
@@ -154,6 +280,9 @@ func (c *ConnectionConfig) FromDto(dto *mgmtv1alpha1.ConnectionConfig) error {
 	case *mgmtv1alpha1.ConnectionConfig_LocalDirConfig:
 		c.LocalDirectoryConfig = &LocalDirectoryConnectionConfig{}
 		c.LocalDirectoryConfig.FromDto(config.LocalDirConfig)
+	case *mgmtv1alpha1.ConnectionConfig_SqliteConfig:
+		c.SqliteConfig = &SqliteConnectionConfig{}
+		c.SqliteConfig.FromDto(config.SqliteConfig)
 	default:
 		return fmt.Errorf("invalid connection config")
 	}
@@ -274,6 +403,20 @@ type MysqlConnection struct {
 	Name     string `json:"name"`
 }
 
+type MssqlConnectionConfig struct {
+	Connection *MssqlConnection `json:"connection,omitempty"`
+	Url        *string          `json:"url,omitempty"`
+	SSHTunnel  *SSHTunnel       `json:"sshTunnel,omitempty"`
+}
+
+type MssqlConnection struct {
+	Host string `json:"host"`
+	Port int32  `json:"port"`
+	Name string `json:"name"`
+	User string `json:"user"`
+	Pass string `json:"pass"`
+}
+
 type AwsS3Credentials struct {
 	Profile         *string `json:"profile,omitempty"`
 	AccessKeyId     *string `json:"accessKeyId,omitempty"`
@@ -297,6 +440,19 @@ func (l *LocalDirectoryConnectionConfig) FromDto(dto *mgmtv1alpha1.LocalDirector
 	l.Path = dto.Path
 }
 
+type SqliteConnectionConfig struct {
+	Path string `json:"path"`
+}
+
+func (s *SqliteConnectionConfig) ToDto() *mgmtv1alpha1.SqliteConnectionConfig {
+	return &mgmtv1alpha1.SqliteConnectionConfig{
+		Path: s.Path,
+	}
+}
+func (s *SqliteConnectionConfig) FromDto(dto *mgmtv1alpha1.SqliteConnectionConfig) {
+	s.Path = dto.Path
+}
+
 func (a *AwsS3Credentials) ToDto() *mgmtv1alpha1.AwsS3Credentials {
 	return &mgmtv1alpha1.AwsS3Credentials{
 		Profile:         a.Profile,
@@ -386,6 +542,9 @@ type JobSourceOptions struct {
 	PostgresOptions *PostgresSourceOptions `json:"postgresOptions,omitempty"`
 	MysqlOptions    *MysqlSourceOptions    `json:"mysqlOptions,omitempty"`
 	GenerateOptions *GenerateSourceOptions `json:"generateOptions,omitempty"`
+	MssqlOptions    *MssqlSourceOptions    `json:"mssqlOptions,omitempty"`
+
+	PostgresCdcOptions *PostgresCdcSourceOptions `json:"postgresCdcOptions,omitempty"`
 }
 
 type MysqlSourceOptions struct {
@@ -393,12 +552,35 @@ type MysqlSourceOptions struct {
 	SubsetByForeignKeyConstraints bool                       `json:"subsetByForeignKeyConstraints"`
 	Schemas                       []*MysqlSourceSchemaOption `json:"schemas"`
 	ConnectionId                  string                     `json:"connectionId"`
+	SchemaDriftOptions            *SchemaDriftOptions        `json:"schemaDriftOptions,omitempty"`
+}
+type MssqlSourceOptions struct {
+	HaltOnNewColumnAddition       bool                       `json:"haltOnNewColumnAddition"`
+	SubsetByForeignKeyConstraints bool                       `json:"subsetByForeignKeyConstraints"`
+	Schemas                       []*MssqlSourceSchemaOption `json:"schemas"`
+	ConnectionId                  string                     `json:"connectionId"`
+	SchemaDriftOptions            *SchemaDriftOptions        `json:"schemaDriftOptions,omitempty"`
 }
 type PostgresSourceOptions struct {
 	HaltOnNewColumnAddition       bool                          `json:"haltOnNewColumnAddition"`
 	SubsetByForeignKeyConstraints bool                          `json:"subsetByForeignKeyConstraints"`
 	Schemas                       []*PostgresSourceSchemaOption `json:"schemas"`
 	ConnectionId                  string                        `json:"connectionId"`
+	SchemaDriftOptions            *SchemaDriftOptions           `json:"schemaDriftOptions,omitempty"`
+}
+
+type SchemaDriftOptions struct {
+	NewColumnPolicy      int32                       `json:"newColumnPolicy"`
+	NewColumnTransformer *JobMappingTransformerModel `json:"newColumnTransformer,omitempty"`
+	RemovedColumnPolicy  int32                       `json:"removedColumnPolicy"`
+	TypeChangePolicy     int32                       `json:"typeChangePolicy"`
+}
+
+type PostgresCdcSourceOptions struct {
+	ConnectionId        string  `json:"connectionId"`
+	PublicationName     string  `json:"publicationName"`
+	SlotName            *string `json:"slotName,omitempty"`
+	SkipInitialSnapshot bool    `json:"skipInitialSnapshot"`
 }
 
 type GenerateSourceOptions struct {
@@ -421,6 +603,9 @@ func (s *PostgresSourceOptions) ToDto() *mgmtv1alpha1.PostgresSourceConnectionOp
 		SubsetByForeignKeyConstraints: s.SubsetByForeignKeyConstraints,
 		ConnectionId:                  s.ConnectionId,
 	}
+	if s.SchemaDriftOptions != nil {
+		dto.SchemaDriftOptions = s.SchemaDriftOptions.ToDto()
+	}
 	dto.Schemas = make([]*mgmtv1alpha1.PostgresSourceSchemaOption, len(s.Schemas))
 	for idx := range s.Schemas {
 		schema := s.Schemas[idx]
@@ -428,8 +613,9 @@ func (s *PostgresSourceOptions) ToDto() *mgmtv1alpha1.PostgresSourceConnectionOp
 		for tidx := range schema.Tables {
 			table := schema.Tables[tidx]
 			tables[tidx] = &mgmtv1alpha1.PostgresSourceTableOption{
-				Table:       table.Table,
-				WhereClause: table.WhereClause,
+				Table:           table.Table,
+				WhereClause:     table.WhereClause,
+				WatermarkColumn: table.WatermarkColumn,
 			}
 		}
 		dto.Schemas[idx] = &mgmtv1alpha1.PostgresSourceSchemaOption{
@@ -440,11 +626,19 @@ func (s *PostgresSourceOptions) ToDto() *mgmtv1alpha1.PostgresSourceConnectionOp
 
 	return dto
 }
-func (s *PostgresSourceOptions) FromDto(dto *mgmtv1alpha1.PostgresSourceConnectionOptions) {
+func (s *PostgresSourceOptions) FromDto(dto *mgmtv1alpha1.PostgresSourceConnectionOptions) error {
 	s.HaltOnNewColumnAddition = dto.HaltOnNewColumnAddition
 	s.SubsetByForeignKeyConstraints = dto.SubsetByForeignKeyConstraints
 	s.Schemas = FromDtoPostgresSourceSchemaOptions(dto.Schemas)
 	s.ConnectionId = dto.ConnectionId
+	if dto.SchemaDriftOptions != nil {
+		driftOpts := &SchemaDriftOptions{}
+		if err := driftOpts.FromDto(dto.SchemaDriftOptions); err != nil {
+			return err
+		}
+		s.SchemaDriftOptions = driftOpts
+	}
+	return nil
 }
 
 func FromDtoPostgresSourceSchemaOptions(dtos []*mgmtv1alpha1.PostgresSourceSchemaOption) []*PostgresSourceSchemaOption {
@@ -455,8 +649,9 @@ func FromDtoPostgresSourceSchemaOptions(dtos []*mgmtv1alpha1.PostgresSourceSchem
 		for tidx := range schema.Tables {
 			table := schema.Tables[tidx]
 			tables[tidx] = &PostgresSourceTableOption{
-				Table:       table.Table,
-				WhereClause: table.WhereClause,
+				Table:           table.Table,
+				WhereClause:     table.WhereClause,
+				WatermarkColumn: table.WatermarkColumn,
 			}
 		}
 		output[idx] = &PostgresSourceSchemaOption{
@@ -474,6 +669,9 @@ func (s *MysqlSourceOptions) ToDto() *mgmtv1alpha1.MysqlSourceConnectionOptions
 		SubsetByForeignKeyConstraints: s.SubsetByForeignKeyConstraints,
 		ConnectionId:                  s.ConnectionId,
 	}
+	if s.SchemaDriftOptions != nil {
+		dto.SchemaDriftOptions = s.SchemaDriftOptions.ToDto()
+	}
 	dto.Schemas = make([]*mgmtv1alpha1.MysqlSourceSchemaOption, len(s.Schemas))
 	for idx := range s.Schemas {
 		schema := s.Schemas[idx]
@@ -481,8 +679,9 @@ func (s *MysqlSourceOptions) ToDto() *mgmtv1alpha1.MysqlSourceConnectionOptions
 		for tidx := range schema.Tables {
 			table := schema.Tables[tidx]
 			tables[tidx] = &mgmtv1alpha1.MysqlSourceTableOption{
-				Table:       table.Table,
-				WhereClause: table.WhereClause,
+				Table:           table.Table,
+				WhereClause:     table.WhereClause,
+				WatermarkColumn: table.WatermarkColumn,
 			}
 		}
 		dto.Schemas[idx] = &mgmtv1alpha1.MysqlSourceSchemaOption{
@@ -493,11 +692,19 @@ func (s *MysqlSourceOptions) ToDto() *mgmtv1alpha1.MysqlSourceConnectionOptions
 
 	return dto
 }
-func (s *MysqlSourceOptions) FromDto(dto *mgmtv1alpha1.MysqlSourceConnectionOptions) {
+func (s *MysqlSourceOptions) FromDto(dto *mgmtv1alpha1.MysqlSourceConnectionOptions) error {
 	s.HaltOnNewColumnAddition = dto.HaltOnNewColumnAddition
 	s.SubsetByForeignKeyConstraints = dto.SubsetByForeignKeyConstraints
 	s.Schemas = FromDtoMysqlSourceSchemaOptions(dto.Schemas)
 	s.ConnectionId = dto.ConnectionId
+	if dto.SchemaDriftOptions != nil {
+		driftOpts := &SchemaDriftOptions{}
+		if err := driftOpts.FromDto(dto.SchemaDriftOptions); err != nil {
+			return err
+		}
+		s.SchemaDriftOptions = driftOpts
+	}
+	return nil
 }
 
 func FromDtoMysqlSourceSchemaOptions(dtos []*mgmtv1alpha1.MysqlSourceSchemaOption) []*MysqlSourceSchemaOption {
@@ -508,11 +715,116 @@ func FromDtoMysqlSourceSchemaOptions(dtos []*mgmtv1alpha1.MysqlSourceSchemaOptio
 		for tidx := range schema.Tables {
 			table := schema.Tables[tidx]
 			tables[tidx] = &MysqlSourceTableOption{
+				Table:           table.Table,
+				WhereClause:     table.WhereClause,
+				WatermarkColumn: table.WatermarkColumn,
+			}
+		}
+		output[idx] = &MysqlSourceSchemaOption{
+			Schema: schema.Schema,
+			Tables: tables,
+		}
+	}
+
+	return output
+}
+
+func (s *MssqlSourceOptions) ToDto() *mgmtv1alpha1.MssqlSourceConnectionOptions {
+	dto := &mgmtv1alpha1.MssqlSourceConnectionOptions{
+		HaltOnNewColumnAddition:       s.HaltOnNewColumnAddition,
+		SubsetByForeignKeyConstraints: s.SubsetByForeignKeyConstraints,
+		ConnectionId:                  s.ConnectionId,
+	}
+	if s.SchemaDriftOptions != nil {
+		dto.SchemaDriftOptions = s.SchemaDriftOptions.ToDto()
+	}
+	dto.Schemas = make([]*mgmtv1alpha1.MssqlSourceSchemaOption, len(s.Schemas))
+	for idx := range s.Schemas {
+		schema := s.Schemas[idx]
+		tables := make([]*mgmtv1alpha1.MssqlSourceTableOption, len(schema.Tables))
+		for tidx := range schema.Tables {
+			table := schema.Tables[tidx]
+			tables[tidx] = &mgmtv1alpha1.MssqlSourceTableOption{
 				Table:       table.Table,
 				WhereClause: table.WhereClause,
 			}
 		}
-		output[idx] = &MysqlSourceSchemaOption{
+		dto.Schemas[idx] = &mgmtv1alpha1.MssqlSourceSchemaOption{
+			Schema: schema.Schema,
+			Tables: tables,
+		}
+	}
+
+	return dto
+}
+func (s *MssqlSourceOptions) FromDto(dto *mgmtv1alpha1.MssqlSourceConnectionOptions) error {
+	s.HaltOnNewColumnAddition = dto.HaltOnNewColumnAddition
+	s.SubsetByForeignKeyConstraints = dto.SubsetByForeignKeyConstraints
+	s.Schemas = FromDtoMssqlSourceSchemaOptions(dto.Schemas)
+	s.ConnectionId = dto.ConnectionId
+	if dto.SchemaDriftOptions != nil {
+		driftOpts := &SchemaDriftOptions{}
+		if err := driftOpts.FromDto(dto.SchemaDriftOptions); err != nil {
+			return err
+		}
+		s.SchemaDriftOptions = driftOpts
+	}
+	return nil
+}
+
+func (s *SchemaDriftOptions) ToDto() *mgmtv1alpha1.SchemaDriftOptions {
+	dto := &mgmtv1alpha1.SchemaDriftOptions{
+		NewColumnPolicy:     mgmtv1alpha1.SchemaDriftPolicy(s.NewColumnPolicy),
+		RemovedColumnPolicy: mgmtv1alpha1.SchemaDriftPolicy(s.RemovedColumnPolicy),
+		TypeChangePolicy:    mgmtv1alpha1.SchemaDriftPolicy(s.TypeChangePolicy),
+	}
+	if s.NewColumnTransformer != nil {
+		dto.NewColumnTransformer = s.NewColumnTransformer.ToTransformerDto()
+	}
+	return dto
+}
+func (s *SchemaDriftOptions) FromDto(dto *mgmtv1alpha1.SchemaDriftOptions) error {
+	s.NewColumnPolicy = int32(dto.GetNewColumnPolicy())
+	s.RemovedColumnPolicy = int32(dto.GetRemovedColumnPolicy())
+	s.TypeChangePolicy = int32(dto.GetTypeChangePolicy())
+	if dto.NewColumnTransformer != nil {
+		transformer := &JobMappingTransformerModel{}
+		if err := transformer.FromTransformerDto(dto.NewColumnTransformer); err != nil {
+			return err
+		}
+		s.NewColumnTransformer = transformer
+	}
+	return nil
+}
+
+func (s *PostgresCdcSourceOptions) ToDto() *mgmtv1alpha1.PostgresCdcSourceConnectionOptions {
+	return &mgmtv1alpha1.PostgresCdcSourceConnectionOptions{
+		ConnectionId:        s.ConnectionId,
+		PublicationName:     s.PublicationName,
+		SlotName:            s.SlotName,
+		SkipInitialSnapshot: s.SkipInitialSnapshot,
+	}
+}
+func (s *PostgresCdcSourceOptions) FromDto(dto *mgmtv1alpha1.PostgresCdcSourceConnectionOptions) {
+	s.ConnectionId = dto.ConnectionId
+	s.PublicationName = dto.PublicationName
+	s.SlotName = dto.SlotName
+	s.SkipInitialSnapshot = dto.SkipInitialSnapshot
+}
+
+func FromDtoMssqlSourceSchemaOptions(dtos []*mgmtv1alpha1.MssqlSourceSchemaOption) []*MssqlSourceSchemaOption {
+	output := make([]*MssqlSourceSchemaOption, len(dtos))
+	for idx := range dtos {
+		schema := dtos[idx]
+		tables := make([]*MssqlSourceTableOption, len(schema.Tables))
+		for tidx := range schema.Tables {
+			table := schema.Tables[tidx]
+			tables[tidx] = &MssqlSourceTableOption{
+				Table:       table.Table,
+				WhereClause: table.WhereClause,
+			}
+		}
+		output[idx] = &MssqlSourceSchemaOption{
 			Schema: schema.Schema,
 			Tables: tables,
 		}
@@ -575,8 +887,9 @@ type PostgresSourceSchemaOption struct {
 	Tables []*PostgresSourceTableOption `json:"tables"`
 }
 type PostgresSourceTableOption struct {
-	Table       string  `json:"table"`
-	WhereClause *string `json:"whereClause,omitempty"`
+	Table           string  `json:"table"`
+	WhereClause     *string `json:"whereClause,omitempty"`
+	WatermarkColumn *string `json:"watermarkColumn,omitempty"`
 }
 
 type MysqlSourceSchemaOption struct {
@@ -584,6 +897,16 @@ type MysqlSourceSchemaOption struct {
 	Tables []*MysqlSourceTableOption `json:"tables"`
 }
 type MysqlSourceTableOption struct {
+	Table           string  `json:"table"`
+	WhereClause     *string `json:"whereClause,omitempty"`
+	WatermarkColumn *string `json:"watermarkColumn,omitempty"`
+}
+
+type MssqlSourceSchemaOption struct {
+	Schema string                    `json:"schema"`
+	Tables []*MssqlSourceTableOption `json:"tables"`
+}
+type MssqlSourceTableOption struct {
 	Table       string  `json:"table"`
 	WhereClause *string `json:"whereClause,omitempty"`
 }
@@ -603,6 +926,20 @@ func (j *JobSourceOptions) ToDto() *mgmtv1alpha1.JobSourceOptions {
 			},
 		}
 	}
+	if j.MssqlOptions != nil {
+		return &mgmtv1alpha1.JobSourceOptions{
+			Config: &mgmtv1alpha1.JobSourceOptions_Mssql{
+				Mssql: j.MssqlOptions.ToDto(),
+			},
+		}
+	}
+	if j.PostgresCdcOptions != nil {
+		return &mgmtv1alpha1.JobSourceOptions{
+			Config: &mgmtv1alpha1.JobSourceOptions_PostgresCdc{
+				PostgresCdc: j.PostgresCdcOptions.ToDto(),
+			},
+		}
+	}
 	if j.GenerateOptions != nil {
 		return &mgmtv1alpha1.JobSourceOptions{
 			Config: &mgmtv1alpha1.JobSourceOptions_Generate{
@@ -617,12 +954,26 @@ func (j *JobSourceOptions) FromDto(dto *mgmtv1alpha1.JobSourceOptions) error {
 	switch config := dto.Config.(type) {
 	case *mgmtv1alpha1.JobSourceOptions_Postgres:
 		sqlOpts := &PostgresSourceOptions{}
-		sqlOpts.FromDto(config.Postgres)
+		if err := sqlOpts.FromDto(config.Postgres); err != nil {
+			return err
+		}
 		j.PostgresOptions = sqlOpts
 	case *mgmtv1alpha1.JobSourceOptions_Mysql:
 		sqlOpts := &MysqlSourceOptions{}
-		sqlOpts.FromDto(config.Mysql)
+		if err := sqlOpts.FromDto(config.Mysql); err != nil {
+			return err
+		}
 		j.MysqlOptions = sqlOpts
+	case *mgmtv1alpha1.JobSourceOptions_Mssql:
+		sqlOpts := &MssqlSourceOptions{}
+		if err := sqlOpts.FromDto(config.Mssql); err != nil {
+			return err
+		}
+		j.MssqlOptions = sqlOpts
+	case *mgmtv1alpha1.JobSourceOptions_PostgresCdc:
+		cdcOpts := &PostgresCdcSourceOptions{}
+		cdcOpts.FromDto(config.PostgresCdc)
+		j.PostgresCdcOptions = cdcOpts
 	case *mgmtv1alpha1.JobSourceOptions_Generate:
 		genOpts := &GenerateSourceOptions{}
 		genOpts.FromDto(config.Generate)
@@ -637,8 +988,26 @@ type JobDestinationOptions struct {
 	PostgresOptions *PostgresDestinationOptions `json:"postgresOptions,omitempty"`
 	AwsS3Options    *AwsS3DestinationOptions    `json:"awsS3Options,omitempty"`
 	MysqlOptions    *MysqlDestinationOptions    `json:"mysqlOptions,omitempty"`
+	MssqlOptions    *MssqlDestinationOptions    `json:"mssqlOptions,omitempty"`
+	SqliteOptions   *SqliteDestinationOptions   `json:"sqliteOptions,omitempty"`
+}
+type AwsS3DestinationOptions struct {
+	Format           int32   `json:"format,omitempty"`
+	MaxFileSizeBytes *uint64 `json:"maxFileSizeBytes,omitempty"`
+}
+
+func (a *AwsS3DestinationOptions) ToDto() *mgmtv1alpha1.AwsS3DestinationConnectionOptions {
+	return &mgmtv1alpha1.AwsS3DestinationConnectionOptions{
+		Format:           mgmtv1alpha1.AwsS3OutputFormat(a.Format),
+		MaxFileSizeBytes: a.MaxFileSizeBytes,
+	}
+}
+
+func (a *AwsS3DestinationOptions) FromDto(dto *mgmtv1alpha1.AwsS3DestinationConnectionOptions) {
+	a.Format = int32(dto.GetFormat())
+	a.MaxFileSizeBytes = dto.MaxFileSizeBytes
 }
-type AwsS3DestinationOptions struct{}
+
 type PostgresDestinationOptions struct {
 	TruncateTableConfig *PostgresTruncateTableConfig `json:"truncateTableconfig,omitempty"`
 	InitTableSchema     bool                         `json:"initTableSchema"`
@@ -678,6 +1047,42 @@ func (t *MysqlTruncateTableConfig) FromDto(dto *mgmtv1alpha1.MysqlTruncateTableC
 	t.TruncateBeforeInsert = dto.TruncateBeforeInsert
 }
 
+type MssqlDestinationOptions struct {
+	TruncateTableConfig *MssqlTruncateTableConfig `json:"truncateTableConfig,omitempty"`
+	InitTableSchema     bool                      `json:"initTableSchema"`
+}
+type MssqlTruncateTableConfig struct {
+	TruncateBeforeInsert bool `json:"truncateBeforeInsert"`
+}
+
+func (t *MssqlTruncateTableConfig) ToDto() *mgmtv1alpha1.MssqlTruncateTableConfig {
+	return &mgmtv1alpha1.MssqlTruncateTableConfig{
+		TruncateBeforeInsert: t.TruncateBeforeInsert,
+	}
+}
+
+func (t *MssqlTruncateTableConfig) FromDto(dto *mgmtv1alpha1.MssqlTruncateTableConfig) {
+	t.TruncateBeforeInsert = dto.TruncateBeforeInsert
+}
+
+type SqliteDestinationOptions struct {
+	TruncateTableConfig *SqliteTruncateTableConfig `json:"truncateTableConfig,omitempty"`
+	InitTableSchema     bool                       `json:"initTableSchema"`
+}
+type SqliteTruncateTableConfig struct {
+	TruncateBeforeInsert bool `json:"truncateBeforeInsert"`
+}
+
+func (t *SqliteTruncateTableConfig) ToDto() *mgmtv1alpha1.SqliteTruncateTableConfig {
+	return &mgmtv1alpha1.SqliteTruncateTableConfig{
+		TruncateBeforeInsert: t.TruncateBeforeInsert,
+	}
+}
+
+func (t *SqliteTruncateTableConfig) FromDto(dto *mgmtv1alpha1.SqliteTruncateTableConfig) {
+	t.TruncateBeforeInsert = dto.TruncateBeforeInsert
+}
+
 func (j *JobDestinationOptions) ToDto() *mgmtv1alpha1.JobDestinationOptions {
 	if j.PostgresOptions != nil {
 		if j.PostgresOptions.TruncateTableConfig == nil {
@@ -705,10 +1110,36 @@ func (j *JobDestinationOptions) ToDto() *mgmtv1alpha1.JobDestinationOptions {
 			},
 		}
 	}
+	if j.MssqlOptions != nil {
+		if j.MssqlOptions.TruncateTableConfig == nil {
+			j.MssqlOptions.TruncateTableConfig = &MssqlTruncateTableConfig{}
+		}
+		return &mgmtv1alpha1.JobDestinationOptions{
+			Config: &mgmtv1alpha1.JobDestinationOptions_MssqlOptions{
+				MssqlOptions: &mgmtv1alpha1.MssqlDestinationConnectionOptions{
+					TruncateTable:   j.MssqlOptions.TruncateTableConfig.ToDto(),
+					InitTableSchema: j.MssqlOptions.InitTableSchema,
+				},
+			},
+		}
+	}
+	if j.SqliteOptions != nil {
+		if j.SqliteOptions.TruncateTableConfig == nil {
+			j.SqliteOptions.TruncateTableConfig = &SqliteTruncateTableConfig{}
+		}
+		return &mgmtv1alpha1.JobDestinationOptions{
+			Config: &mgmtv1alpha1.JobDestinationOptions_SqliteOptions{
+				SqliteOptions: &mgmtv1alpha1.SqliteDestinationConnectionOptions{
+					TruncateTable:   j.SqliteOptions.TruncateTableConfig.ToDto(),
+					InitTableSchema: j.SqliteOptions.InitTableSchema,
+				},
+			},
+		}
+	}
 	if j.AwsS3Options != nil {
 		return &mgmtv1alpha1.JobDestinationOptions{
 			Config: &mgmtv1alpha1.JobDestinationOptions_AwsS3Options{
-				AwsS3Options: &mgmtv1alpha1.AwsS3DestinationConnectionOptions{},
+				AwsS3Options: j.AwsS3Options.ToDto(),
 			},
 		}
 	}
@@ -732,8 +1163,23 @@ func (j *JobDestinationOptions) FromDto(dto *mgmtv1alpha1.JobDestinationOptions)
 			InitTableSchema:     config.MysqlOptions.InitTableSchema,
 			TruncateTableConfig: truncateCfg,
 		}
+	case *mgmtv1alpha1.JobDestinationOptions_MssqlOptions:
+		truncateCfg := &MssqlTruncateTableConfig{}
+		truncateCfg.FromDto(config.MssqlOptions.TruncateTable)
+		j.MssqlOptions = &MssqlDestinationOptions{
+			InitTableSchema:     config.MssqlOptions.InitTableSchema,
+			TruncateTableConfig: truncateCfg,
+		}
+	case *mgmtv1alpha1.JobDestinationOptions_SqliteOptions:
+		truncateCfg := &SqliteTruncateTableConfig{}
+		truncateCfg.FromDto(config.SqliteOptions.TruncateTable)
+		j.SqliteOptions = &SqliteDestinationOptions{
+			InitTableSchema:     config.SqliteOptions.InitTableSchema,
+			TruncateTableConfig: truncateCfg,
+		}
 	case *mgmtv1alpha1.JobDestinationOptions_AwsS3Options:
 		j.AwsS3Options = &AwsS3DestinationOptions{}
+		j.AwsS3Options.FromDto(config.AwsS3Options)
 	default:
 		return fmt.Errorf("invalid job destination options config")
 	}
@@ -837,3 +1283,249 @@ func (t *AccountOnboardingConfig) FromDto(dto *mgmtv1alpha1.AccountOnboardingCon
 	t.HasCreatedJob = dto.GetHasCreatedJob()
 	t.HasInvitedMembers = dto.GetHasInvitedMembers()
 }
+
+type JobRunValidationReport struct {
+	Tables []*JobRunTableValidation `json:"tables"`
+}
+
+type JobRunTableValidation struct {
+	DestinationConnectionId string                        `json:"destinationConnectionId"`
+	Schema                  string                        `json:"schema"`
+	Table                   string                        `json:"table"`
+	SourceRowCount          int64                         `json:"sourceRowCount"`
+	DestinationRowCount     int64                         `json:"destinationRowCount"`
+	ForeignKeys             []*JobRunForeignKeyValidation `json:"foreignKeys,omitempty"`
+	Columns                 []*JobRunColumnValidation     `json:"columns,omitempty"`
+	ColumnsSkippedReason    *string                       `json:"columnsSkippedReason,omitempty"`
+	Passed                  bool                          `json:"passed"`
+}
+
+type JobRunForeignKeyValidation struct {
+	Column           string `json:"column"`
+	ForeignSchema    string `json:"foreignSchema"`
+	ForeignTable     string `json:"foreignTable"`
+	ForeignColumn    string `json:"foreignColumn"`
+	OrphanedRowCount int64  `json:"orphanedRowCount"`
+}
+
+type JobRunColumnValidation struct {
+	Column            string `json:"column"`
+	TransformerSource int32  `json:"transformerSource"`
+	SampledRowCount   int64  `json:"sampledRowCount"`
+	UnchangedRowCount int64  `json:"unchangedRowCount"`
+}
+
+func (r *JobRunValidationReport) ToDto() []*mgmtv1alpha1.JobRunTableValidation {
+	tables := make([]*mgmtv1alpha1.JobRunTableValidation, 0, len(r.Tables))
+	for _, table := range r.Tables {
+		foreignKeys := make([]*mgmtv1alpha1.JobRunForeignKeyValidation, 0, len(table.ForeignKeys))
+		for _, fk := range table.ForeignKeys {
+			foreignKeys = append(foreignKeys, &mgmtv1alpha1.JobRunForeignKeyValidation{
+				Column:           fk.Column,
+				ForeignSchema:    fk.ForeignSchema,
+				ForeignTable:     fk.ForeignTable,
+				ForeignColumn:    fk.ForeignColumn,
+				OrphanedRowCount: fk.OrphanedRowCount,
+			})
+		}
+		columns := make([]*mgmtv1alpha1.JobRunColumnValidation, 0, len(table.Columns))
+		for _, col := range table.Columns {
+			columns = append(columns, &mgmtv1alpha1.JobRunColumnValidation{
+				Column:            col.Column,
+				TransformerSource: mgmtv1alpha1.TransformerSource(col.TransformerSource),
+				SampledRowCount:   col.SampledRowCount,
+				UnchangedRowCount: col.UnchangedRowCount,
+			})
+		}
+		tables = append(tables, &mgmtv1alpha1.JobRunTableValidation{
+			DestinationConnectionId: table.DestinationConnectionId,
+			Schema:                  table.Schema,
+			Table:                   table.Table,
+			SourceRowCount:          table.SourceRowCount,
+			DestinationRowCount:     table.DestinationRowCount,
+			ForeignKeys:             foreignKeys,
+			Columns:                 columns,
+			ColumnsSkippedReason:    table.ColumnsSkippedReason,
+			Passed:                  table.Passed,
+		})
+	}
+	return tables
+}
+
+func (r *JobRunValidationReport) FromDto(dto []*mgmtv1alpha1.JobRunTableValidation) {
+	r.Tables = make([]*JobRunTableValidation, 0, len(dto))
+	for _, table := range dto {
+		foreignKeys := make([]*JobRunForeignKeyValidation, 0, len(table.GetForeignKeys()))
+		for _, fk := range table.GetForeignKeys() {
+			foreignKeys = append(foreignKeys, &JobRunForeignKeyValidation{
+				Column:           fk.GetColumn(),
+				ForeignSchema:    fk.GetForeignSchema(),
+				ForeignTable:     fk.GetForeignTable(),
+				ForeignColumn:    fk.GetForeignColumn(),
+				OrphanedRowCount: fk.GetOrphanedRowCount(),
+			})
+		}
+		columns := make([]*JobRunColumnValidation, 0, len(table.GetColumns()))
+		for _, col := range table.GetColumns() {
+			columns = append(columns, &JobRunColumnValidation{
+				Column:            col.GetColumn(),
+				TransformerSource: int32(col.GetTransformerSource()),
+				SampledRowCount:   col.GetSampledRowCount(),
+				UnchangedRowCount: col.GetUnchangedRowCount(),
+			})
+		}
+		r.Tables = append(r.Tables, &JobRunTableValidation{
+			DestinationConnectionId: table.GetDestinationConnectionId(),
+			Schema:                  table.GetSchema(),
+			Table:                   table.GetTable(),
+			SourceRowCount:          table.GetSourceRowCount(),
+			DestinationRowCount:     table.GetDestinationRowCount(),
+			ForeignKeys:             foreignKeys,
+			Columns:                 columns,
+			ColumnsSkippedReason:    table.ColumnsSkippedReason,
+			Passed:                  table.GetPassed(),
+		})
+	}
+}
+
+type JobRunSchemaDriftReport struct {
+	Changes []*SchemaDriftChange `json:"changes"`
+	Columns []*SchemaDriftColumn `json:"columns"`
+}
+
+type SchemaDriftChange struct {
+	Schema               string                      `json:"schema"`
+	Table                string                      `json:"table"`
+	Column               string                      `json:"column"`
+	Type                 int32                       `json:"type"`
+	RenamedFrom          *string                     `json:"renamedFrom,omitempty"`
+	PreviousDataType     *string                     `json:"previousDataType,omitempty"`
+	DataType             *string                     `json:"dataType,omitempty"`
+	Action               int32                       `json:"action"`
+	SuggestedTransformer *JobMappingTransformerModel `json:"suggestedTransformer,omitempty"`
+}
+
+type SchemaDriftColumn struct {
+	Schema   string `json:"schema"`
+	Table    string `json:"table"`
+	Column   string `json:"column"`
+	DataType string `json:"dataType"`
+}
+
+func (r *JobRunSchemaDriftReport) ToDto() ([]*mgmtv1alpha1.SchemaDriftChange, []*mgmtv1alpha1.SchemaDriftColumn) {
+	changes := make([]*mgmtv1alpha1.SchemaDriftChange, 0, len(r.Changes))
+	for _, change := range r.Changes {
+		dto := &mgmtv1alpha1.SchemaDriftChange{
+			Schema:           change.Schema,
+			Table:            change.Table,
+			Column:           change.Column,
+			Type:             mgmtv1alpha1.SchemaDriftChangeType(change.Type),
+			RenamedFrom:      change.RenamedFrom,
+			PreviousDataType: change.PreviousDataType,
+			DataType:         change.DataType,
+			Action:           mgmtv1alpha1.SchemaDriftAction(change.Action),
+		}
+		if change.SuggestedTransformer != nil {
+			dto.SuggestedTransformer = change.SuggestedTransformer.ToTransformerDto()
+		}
+		changes = append(changes, dto)
+	}
+	columns := make([]*mgmtv1alpha1.SchemaDriftColumn, 0, len(r.Columns))
+	for _, col := range r.Columns {
+		columns = append(columns, &mgmtv1alpha1.SchemaDriftColumn{
+			Schema:   col.Schema,
+			Table:    col.Table,
+			Column:   col.Column,
+			DataType: col.DataType,
+		})
+	}
+	return changes, columns
+}
+
+func (r *JobRunSchemaDriftReport) FromDto(changes []*mgmtv1alpha1.SchemaDriftChange, columns []*mgmtv1alpha1.SchemaDriftColumn) error {
+	r.Changes = make([]*SchemaDriftChange, 0, len(changes))
+	for _, change := range changes {
+		model := &SchemaDriftChange{
+			Schema:           change.GetSchema(),
+			Table:            change.GetTable(),
+			Column:           change.GetColumn(),
+			Type:             int32(change.GetType()),
+			RenamedFrom:      change.RenamedFrom,
+			PreviousDataType: change.PreviousDataType,
+			DataType:         change.DataType,
+			Action:           int32(change.GetAction()),
+		}
+		if change.SuggestedTransformer != nil {
+			transformer := &JobMappingTransformerModel{}
+			if err := transformer.FromTransformerDto(change.SuggestedTransformer); err != nil {
+				return err
+			}
+			model.SuggestedTransformer = transformer
+		}
+		r.Changes = append(r.Changes, model)
+	}
+	r.Columns = make([]*SchemaDriftColumn, 0, len(columns))
+	for _, col := range columns {
+		r.Columns = append(r.Columns, &SchemaDriftColumn{
+			Schema:   col.GetSchema(),
+			Table:    col.GetTable(),
+			Column:   col.GetColumn(),
+			DataType: col.GetDataType(),
+		})
+	}
+	return nil
+}
+
+type AccountApiKeyScopes struct {
+	AllowedProcedures    []string `json:"allowedProcedures,omitempty"`
+	AllowedJobIds        []string `json:"allowedJobIds,omitempty"`
+	AllowedConnectionIds []string `json:"allowedConnectionIds,omitempty"`
+	ReadOnly             bool     `json:"readOnly"`
+}
+
+func (s *AccountApiKeyScopes) ToDto() *mgmtv1alpha1.AccountApiKeyScopes {
+	return &mgmtv1alpha1.AccountApiKeyScopes{
+		AllowedProcedures:    s.AllowedProcedures,
+		AllowedJobIds:        s.AllowedJobIds,
+		AllowedConnectionIds: s.AllowedConnectionIds,
+		ReadOnly:             s.ReadOnly,
+	}
+}
+
+func (s *AccountApiKeyScopes) FromDto(dto *mgmtv1alpha1.AccountApiKeyScopes) {
+	s.AllowedProcedures = dto.GetAllowedProcedures()
+	s.AllowedJobIds = dto.GetAllowedJobIds()
+	s.AllowedConnectionIds = dto.GetAllowedConnectionIds()
+	s.ReadOnly = dto.GetReadOnly()
+}
+
+type JobTrigger struct {
+	JobId     string `json:"jobId"`
+	Condition int32  `json:"condition"`
+}
+
+func (t *JobTrigger) ToDto() *mgmtv1alpha1.JobTrigger {
+	return &mgmtv1alpha1.JobTrigger{
+		JobId:     t.JobId,
+		Condition: mgmtv1alpha1.JobTriggerCondition(t.Condition),
+	}
+}
+
+func (t *JobTrigger) FromDto(dto *mgmtv1alpha1.JobTrigger) {
+	t.JobId = dto.GetJobId()
+	t.Condition = int32(dto.GetCondition())
+}
+
+// A job destination as it was when a job version was created
+type JobVersionDestination struct {
+	ConnectionId string                 `json:"connectionId"`
+	Options      *JobDestinationOptions `json:"options,omitempty"`
+}
+
+func (d *JobVersionDestination) ToDto() *mgmtv1alpha1.CreateJobDestination {
+	dto := &mgmtv1alpha1.CreateJobDestination{ConnectionId: d.ConnectionId}
+	if d.Options != nil {
+		dto.Options = d.Options.ToDto()
+	}
+	return dto
+}
@@ -10,48 +10,61 @@ type JobMappingTransformerModel struct {
 }
 
 type TransformerConfigs struct {
-	GenerateEmail              *GenerateEmailConfig             `json:"generateEmailConfig,omitempty"`
-	TransformEmail             *TransformEmailConfig            `json:"transformEmail,omitempty"`
-	GenerateBool               *GenerateBoolConfig              `json:"generateBool,omitempty"`
-	GenerateCardNumber         *GenerateCardNumberConfig        `json:"generateCardNumber,omitempty"`
-	GenerateCity               *GenerateCityConfig              `json:"generateCity,omitempty"`
-	GenerateDefault            *GenerateDefaultConfig           `json:"generateDefault,omitempty"`
-	GenerateE164PhoneNumber    *GenerateE164PhoneNumberConfig   `json:"generateE164PhoneNumber,omitempty"`
-	GenerateFirstName          *GenerateFirstNameConfig         `json:"generateFirstName,omitempty"`
-	GenerateFloat64            *GenerateFloat64Config           `json:"generateFloat64,omitempty"`
-	GenerateFullAddress        *GenerateFullAddressConfig       `json:"generateFullAddress,omitempty"`
-	GenerateFullName           *GenerateFullNameConfig          `json:"generateFullName,omitempty"`
-	GenerateGender             *GenerateGenderConfig            `json:"generateGender,omitempty"`
-	GenerateInt64PhoneNumber   *GenerateInt64PhoneNumberConfig  `json:"generateInt64PhoneNumber,omitempty"`
-	GenerateInt64              *GenerateInt64Config             `json:"GenerateInt64,omitempty"`
-	GenerateLastName           *GenerateLastNameConfig          `json:"generateLastName,omitempty"`
-	GenerateSha256Hash         *GenerateSha256HashConfig        `json:"generateSha256Hash,omitempty"`
-	GenerateSsn                *GenerateSsnConfig               `json:"generateSsnConfig,omitempty"`
-	GenerateState              *GenerateStateConfig             `json:"generateStateConfig,omitempty"`
-	GenerateStreetAddress      *GenerateStreetAddressConfig     `json:"generateStreetAddressConfig,omitempty"`
-	GenerateStringPhoneNumber  *GenerateStringPhoneNumberConfig `json:"generateStringPhoneNumber,omitempty"`
-	GenerateString             *GenerateStringConfig            `json:"generateString,omitempty"`
-	GenerateUnixTimestamp      *GenerateUnixTimestampConfig     `json:"generateUnixTimestamp,omitempty"`
-	GenerateUsername           *GenerateUsernameConfig          `json:"generateUsername,omitempty"`
-	GenerateUtcTimestamp       *GenerateUtcTimestampConfig      `json:"generateUtcTimestamp,omitempty"`
-	GenerateUuid               *GenerateUuidConfig              `json:"generateUuid,omitempty"`
-	GenerateZipcode            *GenerateZipcodeConfig           `json:"generateZipcode,omitempty"`
-	TransformE164PhoneNumber   *TransformE164PhoneNumberConfig  `json:"transformE164PhoneNumber,omitempty"`
-	TransformFirstname         *TransformFirstNameConfig        `json:"transformFirstName,omitempty"`
-	TransformFloat64           *TransformFloat64Config          `json:"transformFloat64,omitempty"`
-	TransformFullName          *TransformFullNameConfig         `json:"transformFullName,,omitempty"`
-	TransformInt64PhoneNumber  *TransformInt64PhoneNumberConfig `json:"transformInt64PhoneNumber,omitempty"`
-	TransformInt64             *TransformInt64Config            `json:"transformInt64,omitempty"`
-	TransformLastName          *TransformLastNameConfig         `json:"transformLastName,omitempty"`
-	TransformPhoneNumber       *TransformPhoneNumberConfig      `json:"transformPhoneNumber,omitempty"`
-	TransformString            *TransformStringConfig           `json:"transformString,omitempty"`
-	Passthrough                *PassthroughConfig               `json:"passthrough,omitempty"`
-	Null                       *NullConfig                      `json:"null,omitempty"`
-	UserDefinedTransformer     *UserDefinedTransformerConfig    `json:"userDefinedTransformer,omitempty"`
-	TransformJavascript        *TransformJavascriptConfig       `json:"transformJavascript,omitempty"`
-	GenerateCategorical        *GenerateCategoricalConfig       `json:"generateCategorical,omitempty"`
-	TransformCharacterScramble *TransformCharacterScramble      `json:"transformCharacterScramble,omitempty"`
-	GenerateJavascript         *GenerateJavascript              `json:"generateJavascript,omitempty"`
+	GenerateEmail                    *GenerateEmailConfig                    `json:"generateEmailConfig,omitempty"`
+	TransformEmail                   *TransformEmailConfig                   `json:"transformEmail,omitempty"`
+	GenerateBool                     *GenerateBoolConfig                     `json:"generateBool,omitempty"`
+	GenerateCardNumber               *GenerateCardNumberConfig               `json:"generateCardNumber,omitempty"`
+	GenerateCity                     *GenerateCityConfig                     `json:"generateCity,omitempty"`
+	GenerateDefault                  *GenerateDefaultConfig                  `json:"generateDefault,omitempty"`
+	GenerateE164PhoneNumber          *GenerateE164PhoneNumberConfig          `json:"generateE164PhoneNumber,omitempty"`
+	GenerateFirstName                *GenerateFirstNameConfig                `json:"generateFirstName,omitempty"`
+	GenerateFloat64                  *GenerateFloat64Config                  `json:"generateFloat64,omitempty"`
+	GenerateFullAddress              *GenerateFullAddressConfig              `json:"generateFullAddress,omitempty"`
+	GenerateFullName                 *GenerateFullNameConfig                 `json:"generateFullName,omitempty"`
+	GenerateGender                   *GenerateGenderConfig                   `json:"generateGender,omitempty"`
+	GenerateInt64PhoneNumber         *GenerateInt64PhoneNumberConfig         `json:"generateInt64PhoneNumber,omitempty"`
+	GenerateInt64                    *GenerateInt64Config                    `json:"GenerateInt64,omitempty"`
+	GenerateLastName                 *GenerateLastNameConfig                 `json:"generateLastName,omitempty"`
+	GenerateSha256Hash               *GenerateSha256HashConfig               `json:"generateSha256Hash,omitempty"`
+	GenerateSsn                      *GenerateSsnConfig                      `json:"generateSsnConfig,omitempty"`
+	GenerateState                    *GenerateStateConfig                    `json:"generateStateConfig,omitempty"`
+	GenerateStreetAddress            *GenerateStreetAddressConfig            `json:"generateStreetAddressConfig,omitempty"`
+	GenerateStringPhoneNumber        *GenerateStringPhoneNumberConfig        `json:"generateStringPhoneNumber,omitempty"`
+	GenerateString                   *GenerateStringConfig                   `json:"generateString,omitempty"`
+	GenerateUnixTimestamp            *GenerateUnixTimestampConfig            `json:"generateUnixTimestamp,omitempty"`
+	GenerateUsername                 *GenerateUsernameConfig                 `json:"generateUsername,omitempty"`
+	GenerateUtcTimestamp             *GenerateUtcTimestampConfig             `json:"generateUtcTimestamp,omitempty"`
+	GenerateUuid                     *GenerateUuidConfig                     `json:"generateUuid,omitempty"`
+	GenerateZipcode                  *GenerateZipcodeConfig                  `json:"generateZipcode,omitempty"`
+	TransformE164PhoneNumber         *TransformE164PhoneNumberConfig         `json:"transformE164PhoneNumber,omitempty"`
+	TransformFirstname               *TransformFirstNameConfig               `json:"transformFirstName,omitempty"`
+	TransformFloat64                 *TransformFloat64Config                 `json:"transformFloat64,omitempty"`
+	TransformFullName                *TransformFullNameConfig                `json:"transformFullName,,omitempty"`
+	TransformInt64PhoneNumber        *TransformInt64PhoneNumberConfig        `json:"transformInt64PhoneNumber,omitempty"`
+	TransformInt64                   *TransformInt64Config                   `json:"transformInt64,omitempty"`
+	TransformLastName                *TransformLastNameConfig                `json:"transformLastName,omitempty"`
+	TransformPhoneNumber             *TransformPhoneNumberConfig             `json:"transformPhoneNumber,omitempty"`
+	TransformString                  *TransformStringConfig                  `json:"transformString,omitempty"`
+	Passthrough                      *PassthroughConfig                      `json:"passthrough,omitempty"`
+	Null                             *NullConfig                             `json:"null,omitempty"`
+	UserDefinedTransformer           *UserDefinedTransformerConfig           `json:"userDefinedTransformer,omitempty"`
+	TransformJavascript              *TransformJavascriptConfig              `json:"transformJavascript,omitempty"`
+	GenerateCategorical              *GenerateCategoricalConfig              `json:"generateCategorical,omitempty"`
+	TransformCharacterScramble       *TransformCharacterScramble             `json:"transformCharacterScramble,omitempty"`
+	GenerateJavascript               *GenerateJavascript                     `json:"generateJavascript,omitempty"`
+	TransformFormatPreservingEncrypt *TransformFormatPreservingEncryptConfig `json:"transformFormatPreservingEncrypt,omitempty"`
+	TransformTimestamp               *TransformTimestampConfig               `json:"transformTimestamp,omitempty"`
+	TransformDate                    *TransformDateConfig                    `json:"transformDate,omitempty"`
+	TransformJson                    *TransformJsonConfig                    `json:"transformJson,omitempty"`
+	TransformFreeText                *TransformFreeTextConfig                `json:"transformFreeText,omitempty"`
+	GenerateIpAddress                *GenerateIpAddressConfig                `json:"generateIpAddress,omitempty"`
+	TransformIpAddress               *TransformIpAddressConfig               `json:"transformIpAddress,omitempty"`
+	GenerateMacAddress               *GenerateMacAddressConfig               `json:"generateMacAddress,omitempty"`
+	TransformMacAddress              *TransformMacAddressConfig              `json:"transformMacAddress,omitempty"`
+	GenerateUrl                      *GenerateUrlConfig                      `json:"generateUrl,omitempty"`
+	TransformUrl                     *TransformUrlConfig                     `json:"transformUrl,omitempty"`
+	GenerateDomain                   *GenerateDomainConfig                   `json:"generateDomain,omitempty"`
+	TransformDomain                  *TransformDomainConfig                  `json:"transformDomain,omitempty"`
 }
 
 type GenerateEmailConfig struct{}
@@ -60,6 +73,7 @@ type TransformEmailConfig struct {
 	PreserveLength  bool     `json:"preserveLength"`
 	PreserveDomain  bool     `json:"preserveDomain"`
 	ExcludedDomains []string `json:"excludedDomains"`
+	Deterministic   bool     `json:"deterministic"`
 }
 
 type GenerateBoolConfig struct{}
@@ -68,15 +82,23 @@ type GenerateCardNumberConfig struct {
 	ValidLuhn bool `json:"validLuhn"`
 }
 
-type GenerateCityConfig struct{}
+type GenerateCityConfig struct {
+	Locale       *string `json:"locale,omitempty"`
+	LocaleColumn *string `json:"localeColumn,omitempty"`
+}
 
 type GenerateDefaultConfig struct{}
 
 type GenerateE164PhoneNumberConfig struct {
-	Min int64 `json:"min"`
-	Max int64 `json:"max"`
+	Min          int64   `json:"min"`
+	Max          int64   `json:"max"`
+	Locale       *string `json:"locale,omitempty"`
+	LocaleColumn *string `json:"localeColumn,omitempty"`
+}
+type GenerateFirstNameConfig struct {
+	Locale       *string `json:"locale,omitempty"`
+	LocaleColumn *string `json:"localeColumn,omitempty"`
 }
-type GenerateFirstNameConfig struct{}
 
 type GenerateFloat64Config struct {
 	RandomizeSign bool    `json:"randomizeSign"`
@@ -85,9 +107,15 @@ type GenerateFloat64Config struct {
 	Precision     int64   `json:"precision"`
 }
 
-type GenerateFullAddressConfig struct{}
+type GenerateFullAddressConfig struct {
+	Locale       *string `json:"locale,omitempty"`
+	LocaleColumn *string `json:"localeColumn,omitempty"`
+}
 
-type GenerateFullNameConfig struct{}
+type GenerateFullNameConfig struct {
+	Locale       *string `json:"locale,omitempty"`
+	LocaleColumn *string `json:"localeColumn,omitempty"`
+}
 
 type GenerateGenderConfig struct {
 	Abbreviate bool `json:"abbreviate"`
@@ -101,19 +129,30 @@ type GenerateInt64Config struct {
 	Max           int64 `json:"max"`
 }
 
-type GenerateLastNameConfig struct{}
+type GenerateLastNameConfig struct {
+	Locale       *string `json:"locale,omitempty"`
+	LocaleColumn *string `json:"localeColumn,omitempty"`
+}
 
 type GenerateSha256HashConfig struct{}
 
 type GenerateSsnConfig struct{}
 
-type GenerateStateConfig struct{}
+type GenerateStateConfig struct {
+	Locale       *string `json:"locale,omitempty"`
+	LocaleColumn *string `json:"localeColumn,omitempty"`
+}
 
-type GenerateStreetAddressConfig struct{}
+type GenerateStreetAddressConfig struct {
+	Locale       *string `json:"locale,omitempty"`
+	LocaleColumn *string `json:"localeColumn,omitempty"`
+}
 
 type GenerateStringPhoneNumberConfig struct {
-	Min int64 `json:"min"`
-	Max int64 `json:"max"`
+	Min          int64   `json:"min"`
+	Max          int64   `json:"max"`
+	Locale       *string `json:"locale,omitempty"`
+	LocaleColumn *string `json:"localeColumn,omitempty"`
 }
 
 type GenerateStringConfig struct {
@@ -130,44 +169,64 @@ type GenerateUuidConfig struct {
 	IncludeHyphens bool `json:"includeHyphens"`
 }
 
-type GenerateZipcodeConfig struct{}
+type GenerateZipcodeConfig struct {
+	Locale       *string `json:"locale,omitempty"`
+	LocaleColumn *string `json:"localeColumn,omitempty"`
+}
 
 type TransformE164PhoneNumberConfig struct {
 	PreserveLength bool `json:"preserveLength"`
+	Deterministic  bool `json:"deterministic"`
 }
 
 type TransformFirstNameConfig struct {
-	PreserveLength bool `json:"preserveLength"`
+	PreserveLength bool    `json:"preserveLength"`
+	Deterministic  bool    `json:"deterministic"`
+	Locale         *string `json:"locale,omitempty"`
+	LocaleColumn   *string `json:"localeColumn,omitempty"`
 }
 
 type TransformFloat64Config struct {
 	RandomizationRangeMin float64 `json:"randomizationRangeMin"`
 	RandomizationRangeMax float64 `json:"randomizationRangeMax"`
+	Deterministic         bool    `json:"deterministic"`
 }
 
 type TransformFullNameConfig struct {
-	PreserveLength bool `json:"preserveLength"`
+	PreserveLength bool    `json:"preserveLength"`
+	Deterministic  bool    `json:"deterministic"`
+	Locale         *string `json:"locale,omitempty"`
+	LocaleColumn   *string `json:"localeColumn,omitempty"`
 }
 
 type TransformInt64PhoneNumberConfig struct {
 	PreserveLength bool `json:"preserveLength"`
+	Deterministic  bool `json:"deterministic"`
 }
 
 type TransformInt64Config struct {
 	RandomizationRangeMin int64 `json:"randomizationRangeMin"`
 	RandomizationRangeMax int64 `json:"randomizationRangeMax"`
+	Deterministic         bool  `json:"deterministic"`
 }
 
 type TransformLastNameConfig struct {
-	PreserveLength bool `json:"preserveLength"`
+	PreserveLength bool    `json:"preserveLength"`
+	Deterministic  bool    `json:"deterministic"`
+	Locale         *string `json:"locale,omitempty"`
+	LocaleColumn   *string `json:"localeColumn,omitempty"`
 }
 
 type TransformPhoneNumberConfig struct {
-	PreserveLength bool `json:"preserveLength"`
+	PreserveLength bool    `json:"preserveLength"`
+	Deterministic  bool    `json:"deterministic"`
+	Locale         *string `json:"locale,omitempty"`
+	LocaleColumn   *string `json:"localeColumn,omitempty"`
 }
 
 type TransformStringConfig struct {
 	PreserveLength bool `json:"preserveLength"`
+	Deterministic  bool `json:"deterministic"`
 }
 
 type PassthroughConfig struct{}
@@ -188,12 +247,84 @@ type GenerateCategoricalConfig struct {
 
 type TransformCharacterScramble struct {
 	UserProvidedRegex *string `json:"userProvidedRegex,omitempty"`
+	Deterministic     bool    `json:"deterministic"`
 }
 
 type GenerateJavascript struct {
 	Code string `json:"code"`
 }
 
+type TransformFormatPreservingEncryptConfig struct {
+	Algorithm int32  `json:"algorithm"`
+	Tweak     string `json:"tweak"`
+}
+
+type TransformTimestampConfig struct {
+	Mode               int32   `json:"mode"`
+	ShiftRangeDays     int64   `json:"shiftRangeDays"`
+	EntityColumn       *string `json:"entityColumn,omitempty"`
+	JitterRangeSeconds int64   `json:"jitterRangeSeconds"`
+	TruncatePrecision  int32   `json:"truncatePrecision"`
+	Min                *string `json:"min,omitempty"`
+	Max                *string `json:"max,omitempty"`
+}
+
+type TransformDateConfig struct {
+	Mode              int32   `json:"mode"`
+	ShiftRangeDays    int64   `json:"shiftRangeDays"`
+	EntityColumn      *string `json:"entityColumn,omitempty"`
+	JitterRangeDays   int64   `json:"jitterRangeDays"`
+	TruncatePrecision int32   `json:"truncatePrecision"`
+	Min               *string `json:"min,omitempty"`
+	Max               *string `json:"max,omitempty"`
+}
+
+type TransformJsonConfig struct {
+	Rules []*TransformJsonRule `json:"rules"`
+}
+
+type TransformJsonRule struct {
+	Path   string              `json:"path"`
+	Source int32               `json:"source"`
+	Config *TransformerConfigs `json:"config"`
+}
+
+type TransformFreeTextConfig struct {
+	EntityTypes []int32 `json:"entityTypes"`
+	Strategy    int32   `json:"strategy"`
+}
+
+type GenerateIpAddressConfig struct {
+	Version int32 `json:"version"`
+}
+
+type TransformIpAddressConfig struct {
+	PreservePrefixLength int64 `json:"preservePrefixLength"`
+	PreserveClass        bool  `json:"preserveClass"`
+	Deterministic        bool  `json:"deterministic"`
+}
+
+type GenerateMacAddressConfig struct{}
+
+type TransformMacAddressConfig struct {
+	PreserveOui   bool `json:"preserveOui"`
+	Deterministic bool `json:"deterministic"`
+}
+
+type GenerateUrlConfig struct{}
+
+type TransformUrlConfig struct {
+	PreservePath  bool `json:"preservePath"`
+	Deterministic bool `json:"deterministic"`
+}
+
+type GenerateDomainConfig struct{}
+
+type TransformDomainConfig struct {
+	PreserveTld   bool `json:"preserveTld"`
+	Deterministic bool `json:"deterministic"`
+}
+
 // from API -> DB
 func (t *JobMappingTransformerModel) FromTransformerDto(tr *mgmtv1alpha1.JobMappingTransformer) error {
 	t.Source = int32(tr.Source)
@@ -218,6 +349,7 @@ func (t *TransformerConfigs) FromTransformerConfigDto(tr *mgmtv1alpha1.Transform
 			PreserveLength:  tr.GetTransformEmailConfig().PreserveLength,
 			PreserveDomain:  tr.GetTransformEmailConfig().PreserveDomain,
 			ExcludedDomains: tr.GetTransformEmailConfig().ExcludedDomains,
+			Deterministic:   tr.GetTransformEmailConfig().Deterministic,
 		}
 	case *mgmtv1alpha1.TransformerConfig_GenerateBoolConfig:
 		t.GenerateBool = &GenerateBoolConfig{}
@@ -226,16 +358,24 @@ func (t *TransformerConfigs) FromTransformerConfigDto(tr *mgmtv1alpha1.Transform
 			ValidLuhn: tr.GetGenerateCardNumberConfig().ValidLuhn,
 		}
 	case *mgmtv1alpha1.TransformerConfig_GenerateCityConfig:
-		t.GenerateCity = &GenerateCityConfig{}
+		t.GenerateCity = &GenerateCityConfig{
+			Locale:       tr.GetGenerateCityConfig().Locale,
+			LocaleColumn: tr.GetGenerateCityConfig().LocaleColumn,
+		}
 	case *mgmtv1alpha1.TransformerConfig_GenerateDefaultConfig:
 		t.GenerateDefault = &GenerateDefaultConfig{}
 	case *mgmtv1alpha1.TransformerConfig_GenerateE164PhoneNumberConfig:
 		t.GenerateE164PhoneNumber = &GenerateE164PhoneNumberConfig{
-			Min: tr.GetGenerateE164PhoneNumberConfig().Min,
-			Max: tr.GetGenerateE164PhoneNumberConfig().Max,
+			Min:          tr.GetGenerateE164PhoneNumberConfig().Min,
+			Max:          tr.GetGenerateE164PhoneNumberConfig().Max,
+			Locale:       tr.GetGenerateE164PhoneNumberConfig().Locale,
+			LocaleColumn: tr.GetGenerateE164PhoneNumberConfig().LocaleColumn,
 		}
 	case *mgmtv1alpha1.TransformerConfig_GenerateFirstNameConfig:
-		t.GenerateFirstName = &GenerateFirstNameConfig{}
+		t.GenerateFirstName = &GenerateFirstNameConfig{
+			Locale:       tr.GetGenerateFirstNameConfig().Locale,
+			LocaleColumn: tr.GetGenerateFirstNameConfig().LocaleColumn,
+		}
 	case *mgmtv1alpha1.TransformerConfig_GenerateFloat64Config:
 		t.GenerateFloat64 = &GenerateFloat64Config{
 			RandomizeSign: tr.GetGenerateFloat64Config().RandomizeSign,
@@ -244,9 +384,15 @@ func (t *TransformerConfigs) FromTransformerConfigDto(tr *mgmtv1alpha1.Transform
 			Precision:     tr.GetGenerateFloat64Config().Precision,
 		}
 	case *mgmtv1alpha1.TransformerConfig_GenerateFullAddressConfig:
-		t.GenerateFullAddress = &GenerateFullAddressConfig{}
+		t.GenerateFullAddress = &GenerateFullAddressConfig{
+			Locale:       tr.GetGenerateFullAddressConfig().Locale,
+			LocaleColumn: tr.GetGenerateFullAddressConfig().LocaleColumn,
+		}
 	case *mgmtv1alpha1.TransformerConfig_GenerateFullNameConfig:
-		t.GenerateFullName = &GenerateFullNameConfig{}
+		t.GenerateFullName = &GenerateFullNameConfig{
+			Locale:       tr.GetGenerateFullNameConfig().Locale,
+			LocaleColumn: tr.GetGenerateFullNameConfig().LocaleColumn,
+		}
 	case *mgmtv1alpha1.TransformerConfig_GenerateGenderConfig:
 		t.GenerateGender = &GenerateGenderConfig{
 			Abbreviate: tr.GetGenerateGenderConfig().Abbreviate,
@@ -260,19 +406,30 @@ func (t *TransformerConfigs) FromTransformerConfigDto(tr *mgmtv1alpha1.Transform
 			Max:           tr.GetGenerateInt64Config().Max,
 		}
 	case *mgmtv1alpha1.TransformerConfig_GenerateLastNameConfig:
-		t.GenerateLastName = &GenerateLastNameConfig{}
+		t.GenerateLastName = &GenerateLastNameConfig{
+			Locale:       tr.GetGenerateLastNameConfig().Locale,
+			LocaleColumn: tr.GetGenerateLastNameConfig().LocaleColumn,
+		}
 	case *mgmtv1alpha1.TransformerConfig_GenerateSha256HashConfig:
 		t.GenerateSha256Hash = &GenerateSha256HashConfig{}
 	case *mgmtv1alpha1.TransformerConfig_GenerateSsnConfig:
 		t.GenerateSsn = &GenerateSsnConfig{}
 	case *mgmtv1alpha1.TransformerConfig_GenerateStateConfig:
-		t.GenerateState = &GenerateStateConfig{}
+		t.GenerateState = &GenerateStateConfig{
+			Locale:       tr.GetGenerateStateConfig().Locale,
+			LocaleColumn: tr.GetGenerateStateConfig().LocaleColumn,
+		}
 	case *mgmtv1alpha1.TransformerConfig_GenerateStreetAddressConfig:
-		t.GenerateStreetAddress = &GenerateStreetAddressConfig{}
+		t.GenerateStreetAddress = &GenerateStreetAddressConfig{
+			Locale:       tr.GetGenerateStreetAddressConfig().Locale,
+			LocaleColumn: tr.GetGenerateStreetAddressConfig().LocaleColumn,
+		}
 	case *mgmtv1alpha1.TransformerConfig_GenerateStringPhoneNumberConfig:
 		t.GenerateStringPhoneNumber = &GenerateStringPhoneNumberConfig{
-			Min: tr.GetGenerateStringPhoneNumberConfig().Min,
-			Max: tr.GetGenerateStringPhoneNumberConfig().Max,
+			Min:          tr.GetGenerateStringPhoneNumberConfig().Min,
+			Max:          tr.GetGenerateStringPhoneNumberConfig().Max,
+			Locale:       tr.GetGenerateStringPhoneNumberConfig().Locale,
+			LocaleColumn: tr.GetGenerateStringPhoneNumberConfig().LocaleColumn,
 		}
 	case *mgmtv1alpha1.TransformerConfig_GenerateStringConfig:
 		t.GenerateString = &GenerateStringConfig{
@@ -290,44 +447,64 @@ func (t *TransformerConfigs) FromTransformerConfigDto(tr *mgmtv1alpha1.Transform
 			IncludeHyphens: tr.GetGenerateUuidConfig().IncludeHyphens,
 		}
 	case *mgmtv1alpha1.TransformerConfig_GenerateZipcodeConfig:
-		t.GenerateZipcode = &GenerateZipcodeConfig{}
+		t.GenerateZipcode = &GenerateZipcodeConfig{
+			Locale:       tr.GetGenerateZipcodeConfig().Locale,
+			LocaleColumn: tr.GetGenerateZipcodeConfig().LocaleColumn,
+		}
 	case *mgmtv1alpha1.TransformerConfig_TransformE164PhoneNumberConfig:
 		t.TransformE164PhoneNumber = &TransformE164PhoneNumberConfig{
 			PreserveLength: tr.GetTransformE164PhoneNumberConfig().PreserveLength,
+			Deterministic:  tr.GetTransformE164PhoneNumberConfig().Deterministic,
 		}
 	case *mgmtv1alpha1.TransformerConfig_TransformFirstNameConfig:
 		t.TransformFirstname = &TransformFirstNameConfig{
 			PreserveLength: tr.GetTransformFirstNameConfig().PreserveLength,
+			Deterministic:  tr.GetTransformFirstNameConfig().Deterministic,
+			Locale:         tr.GetTransformFirstNameConfig().Locale,
+			LocaleColumn:   tr.GetTransformFirstNameConfig().LocaleColumn,
 		}
 	case *mgmtv1alpha1.TransformerConfig_TransformFloat64Config:
 		t.TransformFloat64 = &TransformFloat64Config{
 			RandomizationRangeMin: tr.GetTransformFloat64Config().RandomizationRangeMin,
 			RandomizationRangeMax: tr.GetTransformFloat64Config().RandomizationRangeMax,
+			Deterministic:         tr.GetTransformFloat64Config().Deterministic,
 		}
 	case *mgmtv1alpha1.TransformerConfig_TransformFullNameConfig:
 		t.TransformFullName = &TransformFullNameConfig{
 			PreserveLength: tr.GetTransformFullNameConfig().PreserveLength,
+			Deterministic:  tr.GetTransformFullNameConfig().Deterministic,
+			Locale:         tr.GetTransformFullNameConfig().Locale,
+			LocaleColumn:   tr.GetTransformFullNameConfig().LocaleColumn,
 		}
 	case *mgmtv1alpha1.TransformerConfig_TransformInt64PhoneNumberConfig:
 		t.TransformInt64PhoneNumber = &TransformInt64PhoneNumberConfig{
 			PreserveLength: tr.GetTransformInt64PhoneNumberConfig().PreserveLength,
+			Deterministic:  tr.GetTransformInt64PhoneNumberConfig().Deterministic,
 		}
 	case *mgmtv1alpha1.TransformerConfig_TransformInt64Config:
 		t.TransformInt64 = &TransformInt64Config{
 			RandomizationRangeMin: tr.GetTransformInt64Config().RandomizationRangeMin,
 			RandomizationRangeMax: tr.GetTransformInt64Config().RandomizationRangeMax,
+			Deterministic:         tr.GetTransformInt64Config().Deterministic,
 		}
 	case *mgmtv1alpha1.TransformerConfig_TransformLastNameConfig:
 		t.TransformLastName = &TransformLastNameConfig{
 			PreserveLength: tr.GetTransformLastNameConfig().PreserveLength,
+			Deterministic:  tr.GetTransformLastNameConfig().Deterministic,
+			Locale:         tr.GetTransformLastNameConfig().Locale,
+			LocaleColumn:   tr.GetTransformLastNameConfig().LocaleColumn,
 		}
 	case *mgmtv1alpha1.TransformerConfig_TransformPhoneNumberConfig:
 		t.TransformPhoneNumber = &TransformPhoneNumberConfig{
 			PreserveLength: tr.GetTransformPhoneNumberConfig().PreserveLength,
+			Deterministic:  tr.GetTransformPhoneNumberConfig().Deterministic,
+			Locale:         tr.GetTransformPhoneNumberConfig().Locale,
+			LocaleColumn:   tr.GetTransformPhoneNumberConfig().LocaleColumn,
 		}
 	case *mgmtv1alpha1.TransformerConfig_TransformStringConfig:
 		t.TransformString = &TransformStringConfig{
 			PreserveLength: tr.GetTransformStringConfig().PreserveLength,
+			Deterministic:  tr.GetTransformStringConfig().Deterministic,
 		}
 	case *mgmtv1alpha1.TransformerConfig_PassthroughConfig:
 		t.Passthrough = &PassthroughConfig{}
@@ -348,11 +525,95 @@ func (t *TransformerConfigs) FromTransformerConfigDto(tr *mgmtv1alpha1.Transform
 	case *mgmtv1alpha1.TransformerConfig_TransformCharacterScrambleConfig:
 		t.TransformCharacterScramble = &TransformCharacterScramble{
 			UserProvidedRegex: tr.GetTransformCharacterScrambleConfig().UserProvidedRegex,
+			Deterministic:     tr.GetTransformCharacterScrambleConfig().Deterministic,
 		}
 	case *mgmtv1alpha1.TransformerConfig_GenerateJavascriptConfig:
 		t.GenerateJavascript = &GenerateJavascript{
 			Code: tr.GetGenerateJavascriptConfig().Code,
 		}
+	case *mgmtv1alpha1.TransformerConfig_TransformFormatPreservingEncryptConfig:
+		t.TransformFormatPreservingEncrypt = &TransformFormatPreservingEncryptConfig{
+			Algorithm: int32(tr.GetTransformFormatPreservingEncryptConfig().Algorithm),
+			Tweak:     tr.GetTransformFormatPreservingEncryptConfig().Tweak,
+		}
+	case *mgmtv1alpha1.TransformerConfig_TransformTimestampConfig:
+		t.TransformTimestamp = &TransformTimestampConfig{
+			Mode:               int32(tr.GetTransformTimestampConfig().Mode),
+			ShiftRangeDays:     tr.GetTransformTimestampConfig().ShiftRangeDays,
+			EntityColumn:       tr.GetTransformTimestampConfig().EntityColumn,
+			JitterRangeSeconds: tr.GetTransformTimestampConfig().JitterRangeSeconds,
+			TruncatePrecision:  int32(tr.GetTransformTimestampConfig().TruncatePrecision),
+			Min:                tr.GetTransformTimestampConfig().Min,
+			Max:                tr.GetTransformTimestampConfig().Max,
+		}
+	case *mgmtv1alpha1.TransformerConfig_TransformDateConfig:
+		t.TransformDate = &TransformDateConfig{
+			Mode:              int32(tr.GetTransformDateConfig().Mode),
+			ShiftRangeDays:    tr.GetTransformDateConfig().ShiftRangeDays,
+			EntityColumn:      tr.GetTransformDateConfig().EntityColumn,
+			JitterRangeDays:   tr.GetTransformDateConfig().JitterRangeDays,
+			TruncatePrecision: int32(tr.GetTransformDateConfig().TruncatePrecision),
+			Min:               tr.GetTransformDateConfig().Min,
+			Max:               tr.GetTransformDateConfig().Max,
+		}
+	case *mgmtv1alpha1.TransformerConfig_TransformJsonConfig:
+		rules := make([]*TransformJsonRule, 0, len(tr.GetTransformJsonConfig().Rules))
+		for _, rule := range tr.GetTransformJsonConfig().Rules {
+			config := &TransformerConfigs{}
+			if rule.Config != nil {
+				if err := config.FromTransformerConfigDto(rule.Config); err != nil {
+					return err
+				}
+			}
+			rules = append(rules, &TransformJsonRule{
+				Path:   rule.Path,
+				Source: int32(rule.Source),
+				Config: config,
+			})
+		}
+		t.TransformJson = &TransformJsonConfig{
+			Rules: rules,
+		}
+	case *mgmtv1alpha1.TransformerConfig_TransformFreeTextConfig:
+		entityTypes := make([]int32, 0, len(tr.GetTransformFreeTextConfig().EntityTypes))
+		for _, entityType := range tr.GetTransformFreeTextConfig().EntityTypes {
+			entityTypes = append(entityTypes, int32(entityType))
+		}
+		t.TransformFreeText = &TransformFreeTextConfig{
+			EntityTypes: entityTypes,
+			Strategy:    int32(tr.GetTransformFreeTextConfig().Strategy),
+		}
+	case *mgmtv1alpha1.TransformerConfig_GenerateIpAddressConfig:
+		t.GenerateIpAddress = &GenerateIpAddressConfig{
+			Version: int32(tr.GetGenerateIpAddressConfig().Version),
+		}
+	case *mgmtv1alpha1.TransformerConfig_TransformIpAddressConfig:
+		t.TransformIpAddress = &TransformIpAddressConfig{
+			PreservePrefixLength: tr.GetTransformIpAddressConfig().PreservePrefixLength,
+			PreserveClass:        tr.GetTransformIpAddressConfig().PreserveClass,
+			Deterministic:        tr.GetTransformIpAddressConfig().Deterministic,
+		}
+	case *mgmtv1alpha1.TransformerConfig_GenerateMacAddressConfig:
+		t.GenerateMacAddress = &GenerateMacAddressConfig{}
+	case *mgmtv1alpha1.TransformerConfig_TransformMacAddressConfig:
+		t.TransformMacAddress = &TransformMacAddressConfig{
+			PreserveOui:   tr.GetTransformMacAddressConfig().PreserveOui,
+			Deterministic: tr.GetTransformMacAddressConfig().Deterministic,
+		}
+	case *mgmtv1alpha1.TransformerConfig_GenerateUrlConfig:
+		t.GenerateUrl = &GenerateUrlConfig{}
+	case *mgmtv1alpha1.TransformerConfig_TransformUrlConfig:
+		t.TransformUrl = &TransformUrlConfig{
+			PreservePath:  tr.GetTransformUrlConfig().PreservePath,
+			Deterministic: tr.GetTransformUrlConfig().Deterministic,
+		}
+	case *mgmtv1alpha1.TransformerConfig_GenerateDomainConfig:
+		t.GenerateDomain = &GenerateDomainConfig{}
+	case *mgmtv1alpha1.TransformerConfig_TransformDomainConfig:
+		t.TransformDomain = &TransformDomainConfig{
+			PreserveTld:   tr.GetTransformDomainConfig().PreserveTld,
+			Deterministic: tr.GetTransformDomainConfig().Deterministic,
+		}
 	default:
 		t = &TransformerConfigs{}
 	}
@@ -390,6 +651,7 @@ func (t *TransformerConfigs) ToTransformerConfigDto() *mgmtv1alpha1.TransformerC
 					PreserveDomain:  t.TransformEmail.PreserveDomain,
 					PreserveLength:  t.TransformEmail.PreserveLength,
 					ExcludedDomains: t.TransformEmail.ExcludedDomains,
+					Deterministic:   t.TransformEmail.Deterministic,
 				},
 			},
 		}
@@ -410,7 +672,10 @@ func (t *TransformerConfigs) ToTransformerConfigDto() *mgmtv1alpha1.TransformerC
 	case t.GenerateCity != nil:
 		return &mgmtv1alpha1.TransformerConfig{
 			Config: &mgmtv1alpha1.TransformerConfig_GenerateCityConfig{
-				GenerateCityConfig: &mgmtv1alpha1.GenerateCity{},
+				GenerateCityConfig: &mgmtv1alpha1.GenerateCity{
+					Locale:       t.GenerateCity.Locale,
+					LocaleColumn: t.GenerateCity.LocaleColumn,
+				},
 			},
 		}
 	case t.GenerateDefault != nil:
@@ -421,15 +686,20 @@ func (t *TransformerConfigs) ToTransformerConfigDto() *mgmtv1alpha1.TransformerC
 		return &mgmtv1alpha1.TransformerConfig{
 			Config: &mgmtv1alpha1.TransformerConfig_GenerateE164PhoneNumberConfig{
 				GenerateE164PhoneNumberConfig: &mgmtv1alpha1.GenerateE164PhoneNumber{
-					Min: t.GenerateE164PhoneNumber.Min,
-					Max: t.GenerateE164PhoneNumber.Max,
+					Min:          t.GenerateE164PhoneNumber.Min,
+					Max:          t.GenerateE164PhoneNumber.Max,
+					Locale:       t.GenerateE164PhoneNumber.Locale,
+					LocaleColumn: t.GenerateE164PhoneNumber.LocaleColumn,
 				},
 			},
 		}
 	case t.GenerateFirstName != nil:
 		return &mgmtv1alpha1.TransformerConfig{
 			Config: &mgmtv1alpha1.TransformerConfig_GenerateFirstNameConfig{
-				GenerateFirstNameConfig: &mgmtv1alpha1.GenerateFirstName{},
+				GenerateFirstNameConfig: &mgmtv1alpha1.GenerateFirstName{
+					Locale:       t.GenerateFirstName.Locale,
+					LocaleColumn: t.GenerateFirstName.LocaleColumn,
+				},
 			},
 		}
 	case t.GenerateFloat64 != nil:
@@ -446,13 +716,19 @@ func (t *TransformerConfigs) ToTransformerConfigDto() *mgmtv1alpha1.TransformerC
 	case t.GenerateFullAddress != nil:
 		return &mgmtv1alpha1.TransformerConfig{
 			Config: &mgmtv1alpha1.TransformerConfig_GenerateFullAddressConfig{
-				GenerateFullAddressConfig: &mgmtv1alpha1.GenerateFullAddress{},
+				GenerateFullAddressConfig: &mgmtv1alpha1.GenerateFullAddress{
+					Locale:       t.GenerateFullAddress.Locale,
+					LocaleColumn: t.GenerateFullAddress.LocaleColumn,
+				},
 			},
 		}
 	case t.GenerateFullName != nil:
 		return &mgmtv1alpha1.TransformerConfig{
 			Config: &mgmtv1alpha1.TransformerConfig_GenerateFullNameConfig{
-				GenerateFullNameConfig: &mgmtv1alpha1.GenerateFullName{},
+				GenerateFullNameConfig: &mgmtv1alpha1.GenerateFullName{
+					Locale:       t.GenerateFullName.Locale,
+					LocaleColumn: t.GenerateFullName.LocaleColumn,
+				},
 			},
 		}
 	case t.GenerateGender != nil:
@@ -482,7 +758,10 @@ func (t *TransformerConfigs) ToTransformerConfigDto() *mgmtv1alpha1.TransformerC
 	case t.GenerateLastName != nil:
 		return &mgmtv1alpha1.TransformerConfig{
 			Config: &mgmtv1alpha1.TransformerConfig_GenerateLastNameConfig{
-				GenerateLastNameConfig: &mgmtv1alpha1.GenerateLastName{},
+				GenerateLastNameConfig: &mgmtv1alpha1.GenerateLastName{
+					Locale:       t.GenerateLastName.Locale,
+					LocaleColumn: t.GenerateLastName.LocaleColumn,
+				},
 			},
 		}
 	case t.GenerateSha256Hash != nil:
@@ -500,21 +779,29 @@ func (t *TransformerConfigs) ToTransformerConfigDto() *mgmtv1alpha1.TransformerC
 	case t.GenerateState != nil:
 		return &mgmtv1alpha1.TransformerConfig{
 			Config: &mgmtv1alpha1.TransformerConfig_GenerateStateConfig{
-				GenerateStateConfig: &mgmtv1alpha1.GenerateState{},
+				GenerateStateConfig: &mgmtv1alpha1.GenerateState{
+					Locale:       t.GenerateState.Locale,
+					LocaleColumn: t.GenerateState.LocaleColumn,
+				},
 			},
 		}
 	case t.GenerateStreetAddress != nil:
 		return &mgmtv1alpha1.TransformerConfig{
 			Config: &mgmtv1alpha1.TransformerConfig_GenerateStreetAddressConfig{
-				GenerateStreetAddressConfig: &mgmtv1alpha1.GenerateStreetAddress{},
+				GenerateStreetAddressConfig: &mgmtv1alpha1.GenerateStreetAddress{
+					Locale:       t.GenerateStreetAddress.Locale,
+					LocaleColumn: t.GenerateStreetAddress.LocaleColumn,
+				},
 			},
 		}
 	case t.GenerateStringPhoneNumber != nil:
 		return &mgmtv1alpha1.TransformerConfig{
 			Config: &mgmtv1alpha1.TransformerConfig_GenerateStringPhoneNumberConfig{
 				GenerateStringPhoneNumberConfig: &mgmtv1alpha1.GenerateStringPhoneNumber{
-					Min: t.GenerateStringPhoneNumber.Min,
-					Max: t.GenerateStringPhoneNumber.Max,
+					Min:          t.GenerateStringPhoneNumber.Min,
+					Max:          t.GenerateStringPhoneNumber.Max,
+					Locale:       t.GenerateStringPhoneNumber.Locale,
+					LocaleColumn: t.GenerateStringPhoneNumber.LocaleColumn,
 				},
 			},
 		}
@@ -556,7 +843,10 @@ func (t *TransformerConfigs) ToTransformerConfigDto() *mgmtv1alpha1.TransformerC
 	case t.GenerateZipcode != nil:
 		return &mgmtv1alpha1.TransformerConfig{
 			Config: &mgmtv1alpha1.TransformerConfig_GenerateZipcodeConfig{
-				GenerateZipcodeConfig: &mgmtv1alpha1.GenerateZipcode{},
+				GenerateZipcodeConfig: &mgmtv1alpha1.GenerateZipcode{
+					Locale:       t.GenerateZipcode.Locale,
+					LocaleColumn: t.GenerateZipcode.LocaleColumn,
+				},
 			},
 		}
 	case t.TransformE164PhoneNumber != nil:
@@ -564,6 +854,7 @@ func (t *TransformerConfigs) ToTransformerConfigDto() *mgmtv1alpha1.TransformerC
 			Config: &mgmtv1alpha1.TransformerConfig_TransformE164PhoneNumberConfig{
 				TransformE164PhoneNumberConfig: &mgmtv1alpha1.TransformE164PhoneNumber{
 					PreserveLength: t.TransformE164PhoneNumber.PreserveLength,
+					Deterministic:  t.TransformE164PhoneNumber.Deterministic,
 				},
 			},
 		}
@@ -572,6 +863,9 @@ func (t *TransformerConfigs) ToTransformerConfigDto() *mgmtv1alpha1.TransformerC
 			Config: &mgmtv1alpha1.TransformerConfig_TransformFirstNameConfig{
 				TransformFirstNameConfig: &mgmtv1alpha1.TransformFirstName{
 					PreserveLength: t.TransformFirstname.PreserveLength,
+					Deterministic:  t.TransformFirstname.Deterministic,
+					Locale:         t.TransformFirstname.Locale,
+					LocaleColumn:   t.TransformFirstname.LocaleColumn,
 				},
 			},
 		}
@@ -581,6 +875,7 @@ func (t *TransformerConfigs) ToTransformerConfigDto() *mgmtv1alpha1.TransformerC
 				TransformFloat64Config: &mgmtv1alpha1.TransformFloat64{
 					RandomizationRangeMin: t.TransformFloat64.RandomizationRangeMin,
 					RandomizationRangeMax: t.TransformFloat64.RandomizationRangeMin,
+					Deterministic:         t.TransformFloat64.Deterministic,
 				},
 			},
 		}
@@ -589,6 +884,9 @@ func (t *TransformerConfigs) ToTransformerConfigDto() *mgmtv1alpha1.TransformerC
 			Config: &mgmtv1alpha1.TransformerConfig_TransformFullNameConfig{
 				TransformFullNameConfig: &mgmtv1alpha1.TransformFullName{
 					PreserveLength: t.TransformFullName.PreserveLength,
+					Deterministic:  t.TransformFullName.Deterministic,
+					Locale:         t.TransformFullName.Locale,
+					LocaleColumn:   t.TransformFullName.LocaleColumn,
 				},
 			},
 		}
@@ -597,6 +895,7 @@ func (t *TransformerConfigs) ToTransformerConfigDto() *mgmtv1alpha1.TransformerC
 			Config: &mgmtv1alpha1.TransformerConfig_TransformInt64PhoneNumberConfig{
 				TransformInt64PhoneNumberConfig: &mgmtv1alpha1.TransformInt64PhoneNumber{
 					PreserveLength: t.TransformInt64PhoneNumber.PreserveLength,
+					Deterministic:  t.TransformInt64PhoneNumber.Deterministic,
 				},
 			},
 		}
@@ -606,6 +905,7 @@ func (t *TransformerConfigs) ToTransformerConfigDto() *mgmtv1alpha1.TransformerC
 				TransformInt64Config: &mgmtv1alpha1.TransformInt64{
 					RandomizationRangeMin: t.TransformInt64.RandomizationRangeMin,
 					RandomizationRangeMax: t.TransformInt64.RandomizationRangeMax,
+					Deterministic:         t.TransformInt64.Deterministic,
 				},
 			},
 		}
@@ -614,6 +914,9 @@ func (t *TransformerConfigs) ToTransformerConfigDto() *mgmtv1alpha1.TransformerC
 			Config: &mgmtv1alpha1.TransformerConfig_TransformLastNameConfig{
 				TransformLastNameConfig: &mgmtv1alpha1.TransformLastName{
 					PreserveLength: t.TransformLastName.PreserveLength,
+					Deterministic:  t.TransformLastName.Deterministic,
+					Locale:         t.TransformLastName.Locale,
+					LocaleColumn:   t.TransformLastName.LocaleColumn,
 				},
 			},
 		}
@@ -622,6 +925,9 @@ func (t *TransformerConfigs) ToTransformerConfigDto() *mgmtv1alpha1.TransformerC
 			Config: &mgmtv1alpha1.TransformerConfig_TransformPhoneNumberConfig{
 				TransformPhoneNumberConfig: &mgmtv1alpha1.TransformPhoneNumber{
 					PreserveLength: t.TransformPhoneNumber.PreserveLength,
+					Deterministic:  t.TransformPhoneNumber.Deterministic,
+					Locale:         t.TransformPhoneNumber.Locale,
+					LocaleColumn:   t.TransformPhoneNumber.LocaleColumn,
 				},
 			},
 		}
@@ -630,6 +936,7 @@ func (t *TransformerConfigs) ToTransformerConfigDto() *mgmtv1alpha1.TransformerC
 			Config: &mgmtv1alpha1.TransformerConfig_TransformStringConfig{
 				TransformStringConfig: &mgmtv1alpha1.TransformString{
 					PreserveLength: t.TransformString.PreserveLength,
+					Deterministic:  t.TransformString.Deterministic,
 				},
 			},
 		}
@@ -674,6 +981,7 @@ func (t *TransformerConfigs) ToTransformerConfigDto() *mgmtv1alpha1.TransformerC
 			Config: &mgmtv1alpha1.TransformerConfig_TransformCharacterScrambleConfig{
 				TransformCharacterScrambleConfig: &mgmtv1alpha1.TransformCharacterScramble{
 					UserProvidedRegex: t.TransformCharacterScramble.UserProvidedRegex,
+					Deterministic:     t.TransformCharacterScramble.Deterministic,
 				},
 			},
 		}
@@ -685,6 +993,139 @@ func (t *TransformerConfigs) ToTransformerConfigDto() *mgmtv1alpha1.TransformerC
 				},
 			},
 		}
+	case t.TransformFormatPreservingEncrypt != nil:
+		return &mgmtv1alpha1.TransformerConfig{
+			Config: &mgmtv1alpha1.TransformerConfig_TransformFormatPreservingEncryptConfig{
+				TransformFormatPreservingEncryptConfig: &mgmtv1alpha1.TransformFormatPreservingEncrypt{
+					Algorithm: mgmtv1alpha1.FormatPreservingEncryptionAlgorithm(t.TransformFormatPreservingEncrypt.Algorithm),
+					Tweak:     t.TransformFormatPreservingEncrypt.Tweak,
+				},
+			},
+		}
+	case t.TransformTimestamp != nil:
+		return &mgmtv1alpha1.TransformerConfig{
+			Config: &mgmtv1alpha1.TransformerConfig_TransformTimestampConfig{
+				TransformTimestampConfig: &mgmtv1alpha1.TransformTimestamp{
+					Mode:               mgmtv1alpha1.TimeTransformMode(t.TransformTimestamp.Mode),
+					ShiftRangeDays:     t.TransformTimestamp.ShiftRangeDays,
+					EntityColumn:       t.TransformTimestamp.EntityColumn,
+					JitterRangeSeconds: t.TransformTimestamp.JitterRangeSeconds,
+					TruncatePrecision:  mgmtv1alpha1.TimeTruncatePrecision(t.TransformTimestamp.TruncatePrecision),
+					Min:                t.TransformTimestamp.Min,
+					Max:                t.TransformTimestamp.Max,
+				},
+			},
+		}
+	case t.TransformDate != nil:
+		return &mgmtv1alpha1.TransformerConfig{
+			Config: &mgmtv1alpha1.TransformerConfig_TransformDateConfig{
+				TransformDateConfig: &mgmtv1alpha1.TransformDate{
+					Mode:              mgmtv1alpha1.TimeTransformMode(t.TransformDate.Mode),
+					ShiftRangeDays:    t.TransformDate.ShiftRangeDays,
+					EntityColumn:      t.TransformDate.EntityColumn,
+					JitterRangeDays:   t.TransformDate.JitterRangeDays,
+					TruncatePrecision: mgmtv1alpha1.TimeTruncatePrecision(t.TransformDate.TruncatePrecision),
+					Min:               t.TransformDate.Min,
+					Max:               t.TransformDate.Max,
+				},
+			},
+		}
+	case t.TransformJson != nil:
+		rules := make([]*mgmtv1alpha1.TransformJsonRule, 0, len(t.TransformJson.Rules))
+		for _, rule := range t.TransformJson.Rules {
+			config := &mgmtv1alpha1.TransformerConfig{}
+			if rule.Config != nil {
+				config = rule.Config.ToTransformerConfigDto()
+			}
+			rules = append(rules, &mgmtv1alpha1.TransformJsonRule{
+				Path:   rule.Path,
+				Source: mgmtv1alpha1.TransformerSource(rule.Source),
+				Config: config,
+			})
+		}
+		return &mgmtv1alpha1.TransformerConfig{
+			Config: &mgmtv1alpha1.TransformerConfig_TransformJsonConfig{
+				TransformJsonConfig: &mgmtv1alpha1.TransformJson{
+					Rules: rules,
+				},
+			},
+		}
+	case t.TransformFreeText != nil:
+		entityTypes := make([]mgmtv1alpha1.FreeTextEntityType, 0, len(t.TransformFreeText.EntityTypes))
+		for _, entityType := range t.TransformFreeText.EntityTypes {
+			entityTypes = append(entityTypes, mgmtv1alpha1.FreeTextEntityType(entityType))
+		}
+		return &mgmtv1alpha1.TransformerConfig{
+			Config: &mgmtv1alpha1.TransformerConfig_TransformFreeTextConfig{
+				TransformFreeTextConfig: &mgmtv1alpha1.TransformFreeText{
+					EntityTypes: entityTypes,
+					Strategy:    mgmtv1alpha1.FreeTextReplacementStrategy(t.TransformFreeText.Strategy),
+				},
+			},
+		}
+	case t.GenerateIpAddress != nil:
+		return &mgmtv1alpha1.TransformerConfig{
+			Config: &mgmtv1alpha1.TransformerConfig_GenerateIpAddressConfig{
+				GenerateIpAddressConfig: &mgmtv1alpha1.GenerateIpAddress{
+					Version: mgmtv1alpha1.IpAddressVersion(t.GenerateIpAddress.Version),
+				},
+			},
+		}
+	case t.TransformIpAddress != nil:
+		return &mgmtv1alpha1.TransformerConfig{
+			Config: &mgmtv1alpha1.TransformerConfig_TransformIpAddressConfig{
+				TransformIpAddressConfig: &mgmtv1alpha1.TransformIpAddress{
+					PreservePrefixLength: t.TransformIpAddress.PreservePrefixLength,
+					PreserveClass:        t.TransformIpAddress.PreserveClass,
+					Deterministic:        t.TransformIpAddress.Deterministic,
+				},
+			},
+		}
+	case t.GenerateMacAddress != nil:
+		return &mgmtv1alpha1.TransformerConfig{
+			Config: &mgmtv1alpha1.TransformerConfig_GenerateMacAddressConfig{
+				GenerateMacAddressConfig: &mgmtv1alpha1.GenerateMacAddress{},
+			},
+		}
+	case t.TransformMacAddress != nil:
+		return &mgmtv1alpha1.TransformerConfig{
+			Config: &mgmtv1alpha1.TransformerConfig_TransformMacAddressConfig{
+				TransformMacAddressConfig: &mgmtv1alpha1.TransformMacAddress{
+					PreserveOui:   t.TransformMacAddress.PreserveOui,
+					Deterministic: t.TransformMacAddress.Deterministic,
+				},
+			},
+		}
+	case t.GenerateUrl != nil:
+		return &mgmtv1alpha1.TransformerConfig{
+			Config: &mgmtv1alpha1.TransformerConfig_GenerateUrlConfig{
+				GenerateUrlConfig: &mgmtv1alpha1.GenerateUrl{},
+			},
+		}
+	case t.TransformUrl != nil:
+		return &mgmtv1alpha1.TransformerConfig{
+			Config: &mgmtv1alpha1.TransformerConfig_TransformUrlConfig{
+				TransformUrlConfig: &mgmtv1alpha1.TransformUrl{
+					PreservePath:  t.TransformUrl.PreservePath,
+					Deterministic: t.TransformUrl.Deterministic,
+				},
+			},
+		}
+	case t.GenerateDomain != nil:
+		return &mgmtv1alpha1.TransformerConfig{
+			Config: &mgmtv1alpha1.TransformerConfig_GenerateDomainConfig{
+				GenerateDomainConfig: &mgmtv1alpha1.GenerateDomain{},
+			},
+		}
+	case t.TransformDomain != nil:
+		return &mgmtv1alpha1.TransformerConfig{
+			Config: &mgmtv1alpha1.TransformerConfig_TransformDomainConfig{
+				TransformDomainConfig: &mgmtv1alpha1.TransformDomain{
+					PreserveTld:   t.TransformDomain.PreserveTld,
+					Deterministic: t.TransformDomain.Deterministic,
+				},
+			},
+		}
 	default:
 		return &mgmtv1alpha1.TransformerConfig{}
 	}
@@ -14,9 +14,9 @@ SELECT * from neosync_api.account_api_keys WHERE key_value = $1;
 
 -- name: CreateAccountApiKey :one
 INSERT INTO neosync_api.account_api_keys (
-  key_name, key_value, account_id, expires_at, created_by_id, updated_by_id, user_id
+  key_name, key_value, account_id, expires_at, created_by_id, updated_by_id, user_id, scopes
 ) VALUES (
-  $1, $2, $3, $4, $5, $6, $7
+  $1, $2, $3, $4, $5, $6, $7, $8
 )
 RETURNING *;
 
@@ -28,6 +28,11 @@ SET key_value = $1,
 WHERE id = $4
 RETURNING *;
 
+-- name: UpdateAccountApiKeyLastUsedAt :exec
+UPDATE neosync_api.account_api_keys
+SET last_used_at = CURRENT_TIMESTAMP
+WHERE id = $1;
+
 
 -- name: IsUserInAccountApiKey :one
 SELECT count(apk.id) from neosync_api.account_api_keys apk 
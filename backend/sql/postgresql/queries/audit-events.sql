@@ -0,0 +1,22 @@
+-- name: CreateAuditEvent :one
+INSERT INTO neosync_api.audit_events (
+  account_id, actor_type, actor_id, procedure, resource_id, request_diff, status_code
+) VALUES (
+  $1, $2, $3, $4, $5, $6, $7
+)
+RETURNING *;
+
+-- name: GetAuditEvents :many
+SELECT * FROM neosync_api.audit_events
+WHERE account_id = sqlc.arg('accountId')
+  AND (sqlc.narg('resourceId')::text IS NULL OR resource_id = sqlc.narg('resourceId')::text)
+  AND (sqlc.narg('procedure')::text IS NULL OR procedure = sqlc.narg('procedure')::text)
+  AND (sqlc.narg('actorId')::uuid IS NULL OR actor_id = sqlc.narg('actorId')::uuid)
+  AND (sqlc.narg('startTime')::timestamp IS NULL OR created_at >= sqlc.narg('startTime')::timestamp)
+  AND (sqlc.narg('endTime')::timestamp IS NULL OR created_at < sqlc.narg('endTime')::timestamp)
+  AND (
+    sqlc.narg('cursorCreatedAt')::timestamp IS NULL
+    OR (created_at, id) < (sqlc.narg('cursorCreatedAt')::timestamp, sqlc.narg('cursorId')::uuid)
+  )
+ORDER BY created_at DESC, id DESC
+LIMIT sqlc.arg('limit');
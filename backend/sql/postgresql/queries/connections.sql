@@ -51,3 +51,15 @@ WHERE a.id = sqlc.arg('accountId') and c.id = sqlc.arg('connectionId');
 SELECT count(c.id) from neosync_api.connections c
 INNER JOIN neosync_api.accounts a ON a.id = c.account_id
 WHERE a.id = sqlc.arg('accountId') and c.id = ANY(sqlc.arg('connectionIds')::uuid[]);;
+
+-- name: GetConnectionsBatchForUpdate :many
+SELECT * from neosync_api.connections
+WHERE id > sqlc.arg('afterId')
+ORDER BY id
+LIMIT sqlc.arg('batchSize')
+FOR UPDATE;
+
+-- name: UpdateConnectionConfig :exec
+UPDATE neosync_api.connections
+SET connection_config = $1
+WHERE id = $2;
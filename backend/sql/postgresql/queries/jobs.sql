@@ -18,9 +18,10 @@ DELETE FROM neosync_api.jobs WHERE id = $1;
 -- name: CreateJob :one
 INSERT INTO neosync_api.jobs (
   name, account_id, status, connection_options, mappings,
-  cron_schedule, created_by_id, updated_by_id, workflow_options, sync_options
+  cron_schedule, created_by_id, updated_by_id, workflow_options, sync_options,
+  triggers
 ) VALUES (
-  $1, $2, $3, $4, $5, $6, $7, $8, $9, $10
+  $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11
 )
 RETURNING *;
 
@@ -48,6 +49,19 @@ updated_by_id = $2
 WHERE id = $3
 RETURNING *;
 
+-- name: SetJobTriggers :one
+UPDATE neosync_api.jobs
+SET triggers = $1,
+updated_by_id = $2
+WHERE id = $3
+RETURNING *;
+
+-- name: GetJobsTriggeredByJob :many
+SELECT j.* from neosync_api.jobs j
+WHERE j.account_id = sqlc.arg('accountId')
+AND j.triggers @> jsonb_build_array(jsonb_build_object('jobId', sqlc.arg('jobId')::text))
+ORDER BY j.created_at ASC;
+
 -- name: UpdateJobMappings :one
 UPDATE neosync_api.jobs
 SET mappings = $1,
@@ -111,3 +125,109 @@ RETURNING *;
 
 -- name: RemoveJobConnectionDestination :exec
 DELETE FROM neosync_api.job_destination_connection_associations WHERE id = $1;
+
+-- name: GetJobWatermarks :many
+SELECT jw.* from neosync_api.job_watermarks jw
+WHERE jw.job_id = $1
+ORDER BY jw.schema_name, jw.table_name;
+
+-- name: SetJobWatermark :one
+INSERT INTO neosync_api.job_watermarks (
+  job_id, schema_name, table_name, column_name, watermark_value, job_run_id
+) VALUES (
+  $1, $2, $3, $4, $5, $6
+)
+ON CONFLICT(job_id, schema_name, table_name)
+DO UPDATE
+SET column_name = EXCLUDED.column_name,
+watermark_value = EXCLUDED.watermark_value,
+job_run_id = EXCLUDED.job_run_id,
+updated_at = CURRENT_TIMESTAMP
+RETURNING *;
+
+-- name: RemoveJobWatermarks :exec
+DELETE FROM neosync_api.job_watermarks WHERE job_id = $1;
+
+-- name: RemoveJobWatermark :exec
+DELETE FROM neosync_api.job_watermarks
+WHERE job_id = $1 AND schema_name = $2 AND table_name = $3;
+
+-- name: CreateJobRunWatermark :exec
+INSERT INTO neosync_api.job_run_watermarks (
+  job_id, job_run_id, schema_name, table_name, column_name, start_value, end_value
+) VALUES (
+  $1, $2, $3, $4, $5, $6, $7
+)
+ON CONFLICT(job_id, job_run_id, schema_name, table_name)
+DO NOTHING;
+
+-- name: GetJobRunWatermarks :many
+SELECT jrw.* from neosync_api.job_run_watermarks jrw
+WHERE jrw.job_id = $1 AND jrw.job_run_id = $2
+ORDER BY jrw.schema_name, jrw.table_name;
+
+-- name: SetJobRunValidationReport :one
+INSERT INTO neosync_api.job_run_validation_reports (
+  job_id, job_run_id, passed, report
+) VALUES (
+  $1, $2, $3, $4
+)
+ON CONFLICT(job_id, job_run_id)
+DO UPDATE
+SET passed = EXCLUDED.passed,
+report = EXCLUDED.report
+RETURNING *;
+
+-- name: GetJobRunValidationReport :one
+SELECT jrvr.* from neosync_api.job_run_validation_reports jrvr
+WHERE jrvr.job_id = $1 AND jrvr.job_run_id = $2;
+
+-- name: SetJobRunSchemaDriftReport :one
+INSERT INTO neosync_api.job_run_schema_drift_reports (
+  job_id, job_run_id, halted, report
+) VALUES (
+  $1, $2, $3, $4
+)
+ON CONFLICT(job_id, job_run_id)
+DO UPDATE
+SET halted = EXCLUDED.halted,
+report = EXCLUDED.report
+RETURNING *;
+
+-- name: GetJobRunSchemaDriftReport :one
+SELECT jrsdr.* from neosync_api.job_run_schema_drift_reports jrsdr
+WHERE jrsdr.job_id = $1 AND jrsdr.job_run_id = $2;
+
+-- name: GetLatestJobRunSchemaDriftReport :one
+SELECT jrsdr.* from neosync_api.job_run_schema_drift_reports jrsdr
+WHERE jrsdr.job_id = $1
+ORDER BY jrsdr.created_at DESC
+LIMIT 1;
+
+-- name: CreateJobVersion :one
+INSERT INTO neosync_api.job_versions (
+  job_id, version, cron_schedule, connection_options, mappings, destinations, created_by_id
+) VALUES (
+  sqlc.arg('jobId'),
+  (SELECT COALESCE(MAX(v.version), 0) + 1 FROM neosync_api.job_versions v WHERE v.job_id = sqlc.arg('jobId')),
+  sqlc.arg('cronSchedule'),
+  sqlc.arg('connectionOptions'),
+  sqlc.arg('mappings'),
+  sqlc.arg('destinations'),
+  sqlc.arg('createdById')
+)
+RETURNING *;
+
+-- name: GetJobVersions :many
+SELECT * from neosync_api.job_versions
+WHERE job_id = $1
+ORDER BY version DESC;
+
+-- name: GetLatestJobVersion :one
+SELECT * from neosync_api.job_versions
+WHERE job_id = $1
+ORDER BY version DESC
+LIMIT 1;
+
+-- name: GetJobVersionById :one
+SELECT * from neosync_api.job_versions WHERE id = $1;
@@ -28,7 +28,7 @@ INSERT INTO neosync_api.users (
 RETURNING *;
 
 -- name: GetUserIdentitiesByTeamAccount :many
-SELECT aipa.* FROM neosync_api.user_identity_provider_associations aipa
+SELECT aipa.*, aua.role FROM neosync_api.user_identity_provider_associations aipa
 JOIN neosync_api.account_user_associations aua ON aua.user_id = aipa.user_id
 JOIN neosync_api.accounts a ON a.id = aua.account_id
 WHERE aua.account_id = sqlc.arg('accountId') AND a.account_type = 1;
@@ -99,9 +99,9 @@ WHERE u.id = $1;
 
 -- name: CreateAccountUserAssociation :one
 INSERT INTO neosync_api.account_user_associations (
-  account_id, user_id
+  account_id, user_id, role
 ) VALUES (
-  $1, $2
+  $1, $2, $3
 )
 RETURNING *;
 
@@ -150,15 +150,25 @@ INNER JOIN neosync_api.account_user_associations aua ON aua.account_id = a.id
 INNER JOIN neosync_api.users u ON u.id = aua.user_id
 WHERE a.id = sqlc.arg('accountId') AND u.id = sqlc.arg('userId');
 
+-- name: UpdateAccountUserRole :one
+UPDATE neosync_api.account_user_associations
+SET role = sqlc.arg('role')
+WHERE account_id = sqlc.arg('accountId') AND user_id = sqlc.arg('userId')
+RETURNING *;
+
+-- name: GetAccountUserCountByRole :one
+SELECT count(aua.id) from neosync_api.account_user_associations aua
+WHERE aua.account_id = sqlc.arg('accountId') AND aua.role = sqlc.arg('role');
+
 -- name: RemoveAccountUser :exec
 DELETE FROM neosync_api.account_user_associations
 WHERE account_id = sqlc.arg('accountId') AND user_id = sqlc.arg('userId');
 
 -- name: CreateAccountInvite :one
 INSERT INTO neosync_api.account_invites (
-  account_id, sender_user_id, email, expires_at
+  account_id, sender_user_id, email, expires_at, role
 ) VALUES (
-  $1, $2, $3, $4
+  $1, $2, $3, $4, $5
 )
 RETURNING *;
 
@@ -202,3 +212,20 @@ SET onboarding_config = $1
 WHERE id = sqlc.arg('accountId')
 RETURNING *;
 
+
+-- name: GetAccountTransformerKey :one
+SELECT transformer_key
+FROM neosync_api.accounts
+WHERE id = $1;
+
+-- name: InitAccountTransformerKey :one
+UPDATE neosync_api.accounts
+SET transformer_key = COALESCE(transformer_key, sqlc.arg('transformerKey')::text)
+WHERE id = sqlc.arg('accountId')
+RETURNING transformer_key;
+
+-- name: UpdateAccountTransformerKey :one
+UPDATE neosync_api.accounts
+SET transformer_key = sqlc.arg('transformerKey')::text
+WHERE id = sqlc.arg('accountId')
+RETURNING transformer_key;
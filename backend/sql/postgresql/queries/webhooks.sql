@@ -0,0 +1,51 @@
+-- name: GetWebhooksByAccount :many
+SELECT * FROM neosync_api.webhooks
+WHERE account_id = $1
+ORDER BY created_at DESC;
+
+-- name: GetWebhookById :one
+SELECT * FROM neosync_api.webhooks
+WHERE id = $1;
+
+-- name: GetEnabledWebhooksByEventType :many
+SELECT * FROM neosync_api.webhooks
+WHERE account_id = sqlc.arg('accountId')
+  AND enabled = true
+  AND (cardinality(event_types) = 0 OR sqlc.arg('eventType')::integer = ANY(event_types));
+
+-- name: CreateWebhook :one
+INSERT INTO neosync_api.webhooks (
+  name, account_id, url, secret, event_types, enabled, created_by_id, updated_by_id
+) VALUES (
+  $1, $2, $3, $4, $5, $6, $7, $8
+)
+RETURNING *;
+
+-- name: UpdateWebhook :one
+UPDATE neosync_api.webhooks
+SET name = sqlc.arg('name'),
+  url = sqlc.arg('url'),
+  secret = COALESCE(sqlc.narg('secret')::text, secret),
+  event_types = sqlc.arg('eventTypes'),
+  enabled = sqlc.arg('enabled'),
+  updated_by_id = sqlc.arg('updatedById'),
+  updated_at = CURRENT_TIMESTAMP
+WHERE id = sqlc.arg('id')
+RETURNING *;
+
+-- name: RemoveWebhookById :exec
+DELETE FROM neosync_api.webhooks WHERE id = $1;
+
+-- name: CreateWebhookDelivery :one
+INSERT INTO neosync_api.webhook_deliveries (
+  webhook_id, event_id, event_type, payload, attempt, status_code, error, success
+) VALUES (
+  $1, $2, $3, $4, $5, $6, $7, $8
+)
+RETURNING *;
+
+-- name: GetWebhookDeliveries :many
+SELECT * FROM neosync_api.webhook_deliveries
+WHERE webhook_id = sqlc.arg('webhookId')
+ORDER BY created_at DESC, id DESC
+LIMIT sqlc.arg('limit');
@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS neosync_api.job_run_watermarks;
+DROP TABLE IF EXISTS neosync_api.job_watermarks;
@@ -0,0 +1,41 @@
+CREATE TABLE IF NOT EXISTS neosync_api.job_watermarks (
+  id uuid NOT NULL DEFAULT gen_random_uuid(),
+	created_at timestamp NOT NULL DEFAULT now(),
+	updated_at timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+  job_id uuid NOT NULL,
+  schema_name text NOT NULL,
+  table_name text NOT NULL,
+  column_name text NOT NULL,
+  watermark_value text NOT NULL,
+  job_run_id text NOT NULL,
+
+  CONSTRAINT job_watermarks_pkey PRIMARY KEY (id),
+  CONSTRAINT fk_jobwatermarks_job_id_jobs_id FOREIGN KEY (job_id) REFERENCES neosync_api.jobs(id) ON DELETE CASCADE,
+  CONSTRAINT job_watermarks_job_id_schema_table UNIQUE (job_id, schema_name, table_name)
+);
+ALTER TABLE neosync_api.job_watermarks OWNER TO neosync_api_owner;
+GRANT ALL ON TABLE neosync_api.job_watermarks TO neosync_api_owner;
+GRANT INSERT, DELETE, UPDATE, SELECT ON TABLE neosync_api.job_watermarks TO neosync_api_readwrite;
+GRANT SELECT ON TABLE neosync_api.job_watermarks TO neosync_api_readonly;
+
+CREATE TABLE IF NOT EXISTS neosync_api.job_run_watermarks (
+  id uuid NOT NULL DEFAULT gen_random_uuid(),
+	created_at timestamp NOT NULL DEFAULT now(),
+
+  job_id uuid NOT NULL,
+  job_run_id text NOT NULL,
+  schema_name text NOT NULL,
+  table_name text NOT NULL,
+  column_name text NOT NULL,
+  start_value text NULL,
+  end_value text NOT NULL,
+
+  CONSTRAINT job_run_watermarks_pkey PRIMARY KEY (id),
+  CONSTRAINT fk_jobrunwatermarks_job_id_jobs_id FOREIGN KEY (job_id) REFERENCES neosync_api.jobs(id) ON DELETE CASCADE,
+  CONSTRAINT job_run_watermarks_job_id_run_id_schema_table UNIQUE (job_id, job_run_id, schema_name, table_name)
+);
+ALTER TABLE neosync_api.job_run_watermarks OWNER TO neosync_api_owner;
+GRANT ALL ON TABLE neosync_api.job_run_watermarks TO neosync_api_owner;
+GRANT INSERT, DELETE, UPDATE, SELECT ON TABLE neosync_api.job_run_watermarks TO neosync_api_readwrite;
+GRANT SELECT ON TABLE neosync_api.job_run_watermarks TO neosync_api_readonly;
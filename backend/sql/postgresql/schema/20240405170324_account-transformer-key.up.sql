@@ -0,0 +1,3 @@
+ALTER TABLE
+  neosync_api.accounts
+ADD COLUMN IF NOT EXISTS transformer_key text NULL;
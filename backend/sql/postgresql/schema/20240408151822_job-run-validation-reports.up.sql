@@ -0,0 +1,17 @@
+CREATE TABLE IF NOT EXISTS neosync_api.job_run_validation_reports (
+  id uuid NOT NULL DEFAULT gen_random_uuid(),
+	created_at timestamp NOT NULL DEFAULT now(),
+
+  job_id uuid NOT NULL,
+  job_run_id text NOT NULL,
+  passed boolean NOT NULL,
+  report jsonb NOT NULL,
+
+  CONSTRAINT job_run_validation_reports_pkey PRIMARY KEY (id),
+  CONSTRAINT fk_jobrunvalidationreports_job_id_jobs_id FOREIGN KEY (job_id) REFERENCES neosync_api.jobs(id) ON DELETE CASCADE,
+  CONSTRAINT job_run_validation_reports_job_id_run_id UNIQUE (job_id, job_run_id)
+);
+ALTER TABLE neosync_api.job_run_validation_reports OWNER TO neosync_api_owner;
+GRANT ALL ON TABLE neosync_api.job_run_validation_reports TO neosync_api_owner;
+GRANT INSERT, DELETE, UPDATE, SELECT ON TABLE neosync_api.job_run_validation_reports TO neosync_api_readwrite;
+GRANT SELECT ON TABLE neosync_api.job_run_validation_reports TO neosync_api_readonly;
@@ -0,0 +1,2 @@
+ALTER TABLE neosync_api.account_invites DROP COLUMN IF EXISTS role;
+ALTER TABLE neosync_api.account_user_associations DROP COLUMN IF EXISTS role;
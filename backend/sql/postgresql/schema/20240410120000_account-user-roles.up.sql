@@ -0,0 +1,7 @@
+-- Existing members keep the access they already had by being made owners of their accounts
+ALTER TABLE neosync_api.account_user_associations ADD COLUMN IF NOT EXISTS role smallint NOT NULL DEFAULT 4;
+ALTER TABLE neosync_api.account_user_associations ALTER COLUMN role DROP DEFAULT;
+
+-- Pending invites are accepted as admins
+ALTER TABLE neosync_api.account_invites ADD COLUMN IF NOT EXISTS role smallint NOT NULL DEFAULT 3;
+ALTER TABLE neosync_api.account_invites ALTER COLUMN role DROP DEFAULT;
@@ -0,0 +1,23 @@
+CREATE TABLE IF NOT EXISTS neosync_api.audit_events (
+  id uuid NOT NULL DEFAULT gen_random_uuid(),
+	created_at timestamp NOT NULL DEFAULT now(),
+
+  account_id uuid NOT NULL,
+  actor_type smallint NOT NULL,
+  actor_id uuid NULL,
+  procedure text NOT NULL,
+  resource_id text NULL,
+  request_diff jsonb NOT NULL,
+  status_code text NOT NULL,
+
+  CONSTRAINT audit_events_pkey PRIMARY KEY (id),
+  CONSTRAINT fk_auditevents_account_id_accounts_id FOREIGN KEY (account_id) REFERENCES neosync_api.accounts(id) ON DELETE CASCADE
+);
+CREATE INDEX IF NOT EXISTS audit_events_account_id_created_at_idx ON neosync_api.audit_events (account_id, created_at DESC, id DESC);
+CREATE INDEX IF NOT EXISTS audit_events_account_id_resource_id_idx ON neosync_api.audit_events (account_id, resource_id);
+
+-- audit events are immutable, the api may only ever insert and read them
+ALTER TABLE neosync_api.audit_events OWNER TO neosync_api_owner;
+GRANT ALL ON TABLE neosync_api.audit_events TO neosync_api_owner;
+GRANT INSERT, SELECT ON TABLE neosync_api.audit_events TO neosync_api_readwrite;
+GRANT SELECT ON TABLE neosync_api.audit_events TO neosync_api_readonly;
@@ -0,0 +1,4 @@
+ALTER TABLE
+  neosync_api.account_api_keys
+DROP COLUMN IF EXISTS last_used_at,
+DROP COLUMN IF EXISTS scopes;
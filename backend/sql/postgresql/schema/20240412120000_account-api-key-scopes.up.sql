@@ -0,0 +1,4 @@
+ALTER TABLE
+  neosync_api.account_api_keys
+ADD COLUMN IF NOT EXISTS scopes jsonb NULL,
+ADD COLUMN IF NOT EXISTS last_used_at timestamp NULL;
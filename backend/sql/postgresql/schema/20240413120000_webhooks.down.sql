@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS neosync_api.webhook_deliveries;
+DROP TABLE IF EXISTS neosync_api.webhooks;
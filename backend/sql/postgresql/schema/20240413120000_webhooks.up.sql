@@ -0,0 +1,49 @@
+CREATE TABLE IF NOT EXISTS neosync_api.webhooks (
+  id uuid NOT NULL DEFAULT gen_random_uuid(),
+	created_at timestamp NOT NULL DEFAULT now(),
+	updated_at timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  name varchar NOT NULL,
+  account_id uuid NOT NULL,
+  url text NOT NULL,
+  secret text NOT NULL,
+  -- the webhook receives every event if empty
+  event_types integer[] NOT NULL DEFAULT '{}',
+  enabled boolean NOT NULL DEFAULT true,
+
+  created_by_id uuid NOT NULL,
+  updated_by_id uuid NOT NULL,
+
+  CONSTRAINT webhooks_pkey PRIMARY KEY (id),
+  CONSTRAINT fk_webhooks_accounts_id FOREIGN KEY (account_id) REFERENCES neosync_api.accounts(id) ON DELETE CASCADE,
+  CONSTRAINT webhooks_name_account_id UNIQUE (name, account_id),
+  CONSTRAINT fk_webhooks_created_by_users_id FOREIGN KEY (created_by_id) REFERENCES neosync_api.users(id),
+  CONSTRAINT fk_webhooks_updated_by_users_id FOREIGN KEY (updated_by_id) REFERENCES neosync_api.users(id)
+);
+ALTER TABLE neosync_api.webhooks OWNER TO neosync_api_owner;
+GRANT ALL ON TABLE neosync_api.webhooks TO neosync_api_owner;
+GRANT INSERT, DELETE, UPDATE, SELECT ON TABLE neosync_api.webhooks TO neosync_api_readwrite;
+GRANT SELECT ON TABLE neosync_api.webhooks TO neosync_api_readonly;
+
+-- one row per attempt to deliver an event to a webhook
+CREATE TABLE IF NOT EXISTS neosync_api.webhook_deliveries (
+  id uuid NOT NULL DEFAULT gen_random_uuid(),
+	created_at timestamp NOT NULL DEFAULT now(),
+
+  webhook_id uuid NOT NULL,
+  event_id uuid NOT NULL,
+  event_type integer NOT NULL,
+  payload jsonb NOT NULL,
+  attempt integer NOT NULL,
+  status_code integer NULL,
+  error text NULL,
+  success boolean NOT NULL,
+
+  CONSTRAINT webhook_deliveries_pkey PRIMARY KEY (id),
+  CONSTRAINT fk_webhookdeliveries_webhook_id_webhooks_id FOREIGN KEY (webhook_id) REFERENCES neosync_api.webhooks(id) ON DELETE CASCADE
+);
+CREATE INDEX IF NOT EXISTS webhook_deliveries_webhook_id_created_at_idx ON neosync_api.webhook_deliveries (webhook_id, created_at DESC);
+
+ALTER TABLE neosync_api.webhook_deliveries OWNER TO neosync_api_owner;
+GRANT ALL ON TABLE neosync_api.webhook_deliveries TO neosync_api_owner;
+GRANT INSERT, SELECT ON TABLE neosync_api.webhook_deliveries TO neosync_api_readwrite;
+GRANT SELECT ON TABLE neosync_api.webhook_deliveries TO neosync_api_readonly;
@@ -0,0 +1,3 @@
+ALTER TABLE
+  neosync_api.jobs
+DROP COLUMN IF EXISTS triggers;
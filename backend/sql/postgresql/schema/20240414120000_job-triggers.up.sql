@@ -0,0 +1,3 @@
+ALTER TABLE
+  neosync_api.jobs
+ADD COLUMN IF NOT EXISTS triggers jsonb NOT NULL DEFAULT '[]'::jsonb;
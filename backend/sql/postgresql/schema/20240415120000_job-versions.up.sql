@@ -0,0 +1,24 @@
+-- immutable snapshots of the parts of a job that decide what a run does
+CREATE TABLE IF NOT EXISTS neosync_api.job_versions (
+  id uuid NOT NULL DEFAULT gen_random_uuid(),
+	created_at timestamp NOT NULL DEFAULT now(),
+
+  job_id uuid NOT NULL,
+  version integer NOT NULL,
+  cron_schedule varchar NULL,
+  connection_options jsonb NOT NULL,
+  mappings jsonb NOT NULL DEFAULT '[]'::jsonb,
+  destinations jsonb NOT NULL DEFAULT '[]'::jsonb,
+
+  created_by_id uuid NOT NULL,
+
+  CONSTRAINT job_versions_pkey PRIMARY KEY (id),
+  CONSTRAINT job_versions_job_id_version UNIQUE (job_id, version),
+  CONSTRAINT fk_jobversions_job_id_jobs_id FOREIGN KEY (job_id) REFERENCES neosync_api.jobs(id) ON DELETE CASCADE,
+  CONSTRAINT fk_jobversions_created_by_users_id FOREIGN KEY (created_by_id) REFERENCES neosync_api.users(id)
+);
+
+ALTER TABLE neosync_api.job_versions OWNER TO neosync_api_owner;
+GRANT ALL ON TABLE neosync_api.job_versions TO neosync_api_owner;
+GRANT INSERT, DELETE, SELECT ON TABLE neosync_api.job_versions TO neosync_api_readwrite;
+GRANT SELECT ON TABLE neosync_api.job_versions TO neosync_api_readonly;
@@ -0,0 +1,72 @@
+package accountspec
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+
+	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
+	"google.golang.org/protobuf/encoding/protojson"
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	// The version of the spec format that this cli reads and writes
+	Version = "v1alpha1"
+)
+
+// Marshals the spec to YAML. Fields keep the order of the proto definition and use their proto names
+func Marshal(spec *mgmtv1alpha1.AccountSpec) ([]byte, error) {
+	jsonBytes, err := protojson.MarshalOptions{UseProtoNames: true}.Marshal(spec)
+	if err != nil {
+		return nil, err
+	}
+	// JSON is valid YAML, so decoding it into a node keeps the field order of the proto
+	var node yaml.Node
+	if err := yaml.Unmarshal(jsonBytes, &node); err != nil {
+		return nil, err
+	}
+	clearStyle(&node)
+	return yaml.Marshal(&node)
+}
+
+// Unmarshals a YAML spec. Unknown fields are rejected so that typos are not silently ignored
+func Unmarshal(data []byte) (*mgmtv1alpha1.AccountSpec, error) {
+	var raw any
+	if err := yaml.Unmarshal(data, &raw); err != nil {
+		return nil, err
+	}
+	jsonBytes, err := json.Marshal(raw)
+	if err != nil {
+		return nil, err
+	}
+	spec := &mgmtv1alpha1.AccountSpec{}
+	if err := protojson.Unmarshal(jsonBytes, spec); err != nil {
+		return nil, err
+	}
+	if spec.Version != Version {
+		return nil, fmt.Errorf("unsupported spec version %q, expected %q", spec.Version, Version)
+	}
+	return spec, nil
+}
+
+func ReadFile(path string) (*mgmtv1alpha1.AccountSpec, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	spec, err := Unmarshal(data)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read account spec %s: %w", path, err)
+	}
+	return spec, nil
+}
+
+// Removes the JSON flow style so that the node is written as block style YAML.
+// Strings that would be read back as another type are still quoted by the encoder
+func clearStyle(node *yaml.Node) {
+	node.Style = 0
+	for _, child := range node.Content {
+		clearStyle(child)
+	}
+}
@@ -0,0 +1,56 @@
+package accountspec
+
+import (
+	"testing"
+
+	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+)
+
+func Test_Marshal_Unmarshal(t *testing.T) {
+	spec := &mgmtv1alpha1.AccountSpec{
+		Version: Version,
+		Connections: []*mgmtv1alpha1.ConnectionSpec{
+			{
+				Id:   "0e4f7d8f-8c4f-4c52-9a1f-8d1d2a8d0c01",
+				Name: "prod-db",
+				ConnectionConfig: &mgmtv1alpha1.ConnectionConfig{
+					Config: &mgmtv1alpha1.ConnectionConfig_PgConfig{
+						PgConfig: &mgmtv1alpha1.PostgresConnectionConfig{
+							ConnectionConfig: &mgmtv1alpha1.PostgresConnectionConfig_Connection{
+								Connection: &mgmtv1alpha1.PostgresConnection{Host: "localhost", Port: 5432, Name: "123", User: "true"},
+							},
+						},
+					},
+				},
+			},
+		},
+		Jobs: []*mgmtv1alpha1.JobSpec{
+			{
+				Id:              "0e4f7d8f-8c4f-4c52-9a1f-8d1d2a8d0c03",
+				Name:            "nightly-sync",
+				CronSchedule:    proto.String("0 0 * * *"),
+				WorkflowOptions: &mgmtv1alpha1.WorkflowOptions{RunTimeout: proto.Int64(3600)},
+			},
+		},
+	}
+
+	data, err := Marshal(spec)
+	require.NoError(t, err)
+	assert.Contains(t, string(data), "version: v1alpha1\n")
+	assert.Contains(t, string(data), "cron_schedule: 0 0 * * *\n")
+
+	actual, err := Unmarshal(data)
+	require.NoError(t, err)
+	assert.True(t, proto.Equal(spec, actual), "expected:\n%v\nactual:\n%v", spec, actual)
+}
+
+func Test_Unmarshal_Errors(t *testing.T) {
+	_, err := Unmarshal([]byte("version: v2\n"))
+	assert.Error(t, err)
+
+	_, err = Unmarshal([]byte("version: v1alpha1\nconections: []\n"))
+	assert.Error(t, err, "unknown fields must be rejected")
+}
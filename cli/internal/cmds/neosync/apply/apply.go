@@ -0,0 +1,174 @@
+package apply_cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"connectrpc.com/connect"
+	"github.com/fatih/color"
+	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
+	"github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1/mgmtv1alpha1connect"
+	"github.com/nucleuscloud/neosync/cli/internal/accountspec"
+	"github.com/nucleuscloud/neosync/cli/internal/auth"
+	auth_interceptor "github.com/nucleuscloud/neosync/cli/internal/connect/interceptors/auth"
+	"github.com/nucleuscloud/neosync/cli/internal/serverconfig"
+	"github.com/nucleuscloud/neosync/cli/internal/userconfig"
+	"github.com/rodaine/table"
+	"github.com/spf13/cobra"
+)
+
+func NewCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "apply",
+		Short: "Creates or updates the resources of an account from a YAML account spec",
+		Long: "Diffs the connections, user defined transformers and jobs in the account spec against the account and creates or updates them so that they match. Resources are matched by name, so applying the same spec again changes nothing.\n" +
+			"Use the jobs export command to create a spec from existing jobs.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			apiKey, err := cmd.Flags().GetString("api-key")
+			if err != nil {
+				return err
+			}
+
+			accountId, err := cmd.Flags().GetString("account-id")
+			if err != nil {
+				return err
+			}
+			file, err := cmd.Flags().GetString("file")
+			if err != nil {
+				return err
+			}
+			if file == "" {
+				return errors.New("must provide the account spec file")
+			}
+			dryRun, err := cmd.Flags().GetBool("dry-run")
+			if err != nil {
+				return err
+			}
+
+			cmd.SilenceUsage = true
+			return applyAccountSpec(cmd.Context(), &apiKey, &accountId, file, dryRun)
+		},
+	}
+	cmd.Flags().String("account-id", "", "Account to apply the spec to. Defaults to account id in cli context")
+	cmd.Flags().StringP("file", "f", "", "Path to the YAML account spec")
+	cmd.Flags().Bool("dry-run", false, "Print the changes that would be made without applying them")
+	return cmd
+}
+
+func applyAccountSpec(
+	ctx context.Context,
+	apiKey, accountIdFlag *string,
+	file string,
+	dryRun bool,
+) error {
+	spec, err := accountspec.ReadFile(file)
+	if err != nil {
+		return err
+	}
+
+	isAuthEnabled, err := auth.IsAuthEnabled(ctx)
+	if err != nil {
+		return err
+	}
+	var accountId = accountIdFlag
+	if accountId == nil || *accountId == "" {
+		aId, err := userconfig.GetAccountId()
+		if err != nil {
+			fmt.Println("Unable to retrieve account id. Please use account switch command to set account.") //nolint:forbidigo
+			return err
+		}
+		accountId = &aId
+	}
+
+	if accountId == nil || *accountId == "" {
+		return errors.New("Account Id not found. Please use account switch command to set account.")
+	}
+
+	accountSpecClient := mgmtv1alpha1connect.NewAccountSpecServiceClient(
+		http.DefaultClient,
+		serverconfig.GetApiBaseUrl(),
+		connect.WithInterceptors(auth_interceptor.NewInterceptor(isAuthEnabled, auth.AuthHeader, auth.GetAuthHeaderTokenFn(apiKey))),
+	)
+	res, err := accountSpecClient.ApplyAccountSpec(ctx, connect.NewRequest(&mgmtv1alpha1.ApplyAccountSpecRequest{
+		AccountId: *accountId,
+		Spec:      spec,
+		DryRun:    dryRun,
+	}))
+	if err != nil {
+		return err
+	}
+
+	fmt.Println() //nolint:forbidigo
+	printChangesTable(res.Msg.Changes)
+	fmt.Println()                                    //nolint:forbidigo
+	fmt.Println(getSummary(res.Msg.Changes, dryRun)) //nolint:forbidigo
+	return nil
+}
+
+func printChangesTable(changes []*mgmtv1alpha1.AccountSpecChange) {
+	tbl := table.
+		New("Action", "Type", "Name", "Fields", "Id").
+		WithHeaderFormatter(
+			color.New(color.FgGreen, color.Underline).SprintfFunc(),
+		).
+		WithFirstColumnFormatter(
+			color.New(color.FgYellow).SprintfFunc(),
+		)
+
+	for _, change := range changes {
+		tbl.AddRow(
+			getActionName(change.Action),
+			getResourceTypeName(change.ResourceType),
+			change.Name,
+			strings.Join(change.Fields, ", "),
+			change.GetId(),
+		)
+	}
+	tbl.Print()
+}
+
+func getSummary(changes []*mgmtv1alpha1.AccountSpecChange, dryRun bool) string {
+	counts := map[mgmtv1alpha1.AccountSpecChangeAction]int{}
+	for _, change := range changes {
+		counts[change.Action]++
+	}
+	format := "Apply complete: %d created, %d updated, %d unchanged."
+	if dryRun {
+		format = "Plan: %d to create, %d to update, %d unchanged."
+	}
+	return fmt.Sprintf(
+		format,
+		counts[mgmtv1alpha1.AccountSpecChangeAction_ACCOUNT_SPEC_CHANGE_ACTION_CREATE],
+		counts[mgmtv1alpha1.AccountSpecChangeAction_ACCOUNT_SPEC_CHANGE_ACTION_UPDATE],
+		counts[mgmtv1alpha1.AccountSpecChangeAction_ACCOUNT_SPEC_CHANGE_ACTION_UNCHANGED],
+	)
+}
+
+func getActionName(action mgmtv1alpha1.AccountSpecChangeAction) string {
+	switch action {
+	case mgmtv1alpha1.AccountSpecChangeAction_ACCOUNT_SPEC_CHANGE_ACTION_CREATE:
+		return "create"
+	case mgmtv1alpha1.AccountSpecChangeAction_ACCOUNT_SPEC_CHANGE_ACTION_UPDATE:
+		return "update"
+	case mgmtv1alpha1.AccountSpecChangeAction_ACCOUNT_SPEC_CHANGE_ACTION_UNCHANGED:
+		return "unchanged"
+	default:
+		return action.String()
+	}
+}
+
+func getResourceTypeName(resourceType mgmtv1alpha1.AccountSpecResourceType) string {
+	switch resourceType {
+	case mgmtv1alpha1.AccountSpecResourceType_ACCOUNT_SPEC_RESOURCE_TYPE_CONNECTION:
+		return "connection"
+	case mgmtv1alpha1.AccountSpecResourceType_ACCOUNT_SPEC_RESOURCE_TYPE_TRANSFORMER:
+		return "transformer"
+	case mgmtv1alpha1.AccountSpecResourceType_ACCOUNT_SPEC_RESOURCE_TYPE_JOB:
+		return "job"
+	default:
+		return resourceType.String()
+	}
+}
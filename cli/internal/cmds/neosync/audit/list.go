@@ -0,0 +1,233 @@
+package audit_cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/fatih/color"
+	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
+	"github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1/mgmtv1alpha1connect"
+	"github.com/nucleuscloud/neosync/cli/internal/auth"
+	auth_interceptor "github.com/nucleuscloud/neosync/cli/internal/connect/interceptors/auth"
+	"github.com/nucleuscloud/neosync/cli/internal/serverconfig"
+	"github.com/nucleuscloud/neosync/cli/internal/userconfig"
+	"github.com/rodaine/table"
+	"github.com/spf13/cobra"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	// the most events the api returns in a single page
+	maxPageSize = 1000
+)
+
+type listConfig struct {
+	AccountId  string
+	ResourceId string
+	Procedure  string
+	ActorId    string
+	Since      *time.Time
+	Until      *time.Time
+	Limit      uint32
+}
+
+func newListCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "list",
+		Aliases: []string{"ls"},
+		Short:   "list the audit events of an account, most recent first",
+		Example: `neosync audit list --resource-id <job-id> --since 24h
+neosync audit list --since 2024-04-01T00:00:00Z --until 2024-04-08T00:00:00Z`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			apiKey, err := cmd.Flags().GetString("api-key")
+			if err != nil {
+				return err
+			}
+
+			config := &listConfig{}
+			config.AccountId, err = cmd.Flags().GetString("account-id")
+			if err != nil {
+				return err
+			}
+			config.ResourceId, err = cmd.Flags().GetString("resource-id")
+			if err != nil {
+				return err
+			}
+			config.Procedure, err = cmd.Flags().GetString("procedure")
+			if err != nil {
+				return err
+			}
+			config.ActorId, err = cmd.Flags().GetString("actor-id")
+			if err != nil {
+				return err
+			}
+			config.Limit, err = cmd.Flags().GetUint32("limit")
+			if err != nil {
+				return err
+			}
+			if config.Limit == 0 {
+				return errors.New("limit must be greater than 0")
+			}
+
+			now := time.Now()
+			since, err := cmd.Flags().GetString("since")
+			if err != nil {
+				return err
+			}
+			config.Since, err = parseTime(since, now)
+			if err != nil {
+				return fmt.Errorf("invalid since: %w", err)
+			}
+			until, err := cmd.Flags().GetString("until")
+			if err != nil {
+				return err
+			}
+			config.Until, err = parseTime(until, now)
+			if err != nil {
+				return fmt.Errorf("invalid until: %w", err)
+			}
+
+			cmd.SilenceUsage = true
+			return listAuditEvents(cmd.Context(), &apiKey, config)
+		},
+	}
+	cmd.Flags().String("account-id", "", "Account to list audit events for. Defaults to account id in cli context")
+	cmd.Flags().String("resource-id", "", "Only list the events for this resource, ex: a job or connection id")
+	cmd.Flags().String("procedure", "", "Only list the events for this procedure, ex: /mgmt.v1alpha1.JobService/DeleteJob")
+	cmd.Flags().String("actor-id", "", "Only list the events made by this user or api key")
+	cmd.Flags().String("since", "", "Only list the events created at or after this time. Either an RFC3339 timestamp or a duration before now, ex: 24h")
+	cmd.Flags().String("until", "", "Only list the events created before this time. Either an RFC3339 timestamp or a duration before now, ex: 1h")
+	cmd.Flags().Uint32("limit", 100, "The maximum number of events to list")
+	return cmd
+}
+
+// Parses either an RFC3339 timestamp or a duration that is subtracted from now
+func parseTime(value string, now time.Time) (*time.Time, error) {
+	if value == "" {
+		return nil, nil
+	}
+	if ts, err := time.Parse(time.RFC3339, value); err == nil {
+		return &ts, nil
+	}
+	duration, err := time.ParseDuration(value)
+	if err != nil {
+		return nil, fmt.Errorf("expected an RFC3339 timestamp or a duration, got %q", value)
+	}
+	ts := now.Add(-duration)
+	return &ts, nil
+}
+
+func listAuditEvents(
+	ctx context.Context,
+	apiKey *string,
+	config *listConfig,
+) error {
+	isAuthEnabled, err := auth.IsAuthEnabled(ctx)
+	if err != nil {
+		return err
+	}
+
+	accountId := config.AccountId
+	if accountId == "" {
+		aId, err := userconfig.GetAccountId()
+		if err != nil {
+			fmt.Println("Unable to retrieve account id. Please use account switch command to set account.") //nolint:forbidigo
+			return err
+		}
+		accountId = aId
+	}
+
+	if accountId == "" {
+		return errors.New("Account Id not found. Please use account switch command to set account.")
+	}
+
+	auditclient := mgmtv1alpha1connect.NewAuditServiceClient(
+		http.DefaultClient,
+		serverconfig.GetApiBaseUrl(),
+		connect.WithInterceptors(
+			auth_interceptor.NewInterceptor(isAuthEnabled, auth.AuthHeader, auth.GetAuthHeaderTokenFn(apiKey)),
+		),
+	)
+
+	req := &mgmtv1alpha1.GetAuditEventsRequest{
+		AccountId: accountId,
+	}
+	if config.ResourceId != "" {
+		req.ResourceId = &config.ResourceId
+	}
+	if config.Procedure != "" {
+		req.Procedure = &config.Procedure
+	}
+	if config.ActorId != "" {
+		req.ActorId = &config.ActorId
+	}
+	if config.Since != nil {
+		req.StartTime = timestamppb.New(*config.Since)
+	}
+	if config.Until != nil {
+		req.EndTime = timestamppb.New(*config.Until)
+	}
+
+	events := []*mgmtv1alpha1.AuditEvent{}
+	for uint32(len(events)) < config.Limit {
+		pageSize := min(config.Limit-uint32(len(events)), maxPageSize)
+		req.PageSize = &pageSize
+		res, err := auditclient.GetAuditEvents(ctx, connect.NewRequest(req))
+		if err != nil {
+			return err
+		}
+		events = append(events, res.Msg.Events...)
+		if res.Msg.NextPageToken == nil {
+			break
+		}
+		req.PageToken = res.Msg.NextPageToken
+	}
+
+	fmt.Println() //nolint:forbidigo
+	printAuditEventsTable(events)
+	fmt.Println() //nolint:forbidigo
+	return nil
+}
+
+func printAuditEventsTable(
+	events []*mgmtv1alpha1.AuditEvent,
+) {
+	tbl := table.
+		New("Created At", "Actor", "Actor Id", "Procedure", "Resource Id", "Status").
+		WithHeaderFormatter(
+			color.New(color.FgGreen, color.Underline).SprintfFunc(),
+		).
+		WithFirstColumnFormatter(
+			color.New(color.FgYellow).SprintfFunc(),
+		)
+
+	for idx := range events {
+		event := events[idx]
+		tbl.AddRow(
+			event.CreatedAt.AsTime().Local().Format(time.RFC3339),
+			getActorType(event.GetActor().GetType()),
+			event.GetActor().GetId(),
+			event.Procedure,
+			event.GetResourceId(),
+			event.StatusCode,
+		)
+	}
+	tbl.Print()
+}
+
+func getActorType(actorType mgmtv1alpha1.AuditActorType) string {
+	switch actorType {
+	case mgmtv1alpha1.AuditActorType_AUDIT_ACTOR_TYPE_USER:
+		return "User"
+	case mgmtv1alpha1.AuditActorType_AUDIT_ACTOR_TYPE_API_KEY:
+		return "Api Key"
+	case mgmtv1alpha1.AuditActorType_AUDIT_ACTOR_TYPE_WORKER:
+		return "Worker"
+	default:
+		return "Unknown"
+	}
+}
@@ -119,6 +119,10 @@ func getCategory(cc *mgmtv1alpha1.ConnectionConfig) string {
 		return "Postgres"
 	case *mgmtv1alpha1.ConnectionConfig_MysqlConfig:
 		return "Mysql"
+	case *mgmtv1alpha1.ConnectionConfig_MssqlConfig:
+		return "Mssql"
+	case *mgmtv1alpha1.ConnectionConfig_SqliteConfig:
+		return "Sqlite"
 	case *mgmtv1alpha1.ConnectionConfig_AwsS3Config:
 		return "AWS S3"
 	default:
@@ -0,0 +1,177 @@
+package connections_cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"connectrpc.com/connect"
+	"github.com/fatih/color"
+	"github.com/google/uuid"
+	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
+	"github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1/mgmtv1alpha1connect"
+	"github.com/nucleuscloud/neosync/cli/internal/auth"
+	auth_interceptor "github.com/nucleuscloud/neosync/cli/internal/connect/interceptors/auth"
+	"github.com/nucleuscloud/neosync/cli/internal/serverconfig"
+	"github.com/rodaine/table"
+	"github.com/spf13/cobra"
+)
+
+type scanConfig struct {
+	connectionId  string
+	schemas       []string
+	sampleSize    *uint32
+	minConfidence *float64
+	jobId         string
+	jobRunId      string
+}
+
+func newScanCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "scan [id]",
+		Short: "scans a connection for columns that contain PII",
+		Long:  "Samples rows from every table in the connection and prints the columns that look like they contain PII along with a suggested transformer for each column.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) != 1 {
+				return errors.New("must provide connection uuid as argument")
+			}
+			connectionUuid, err := uuid.Parse(args[0])
+			if err != nil {
+				return err
+			}
+
+			apiKey, err := cmd.Flags().GetString("api-key")
+			if err != nil {
+				return err
+			}
+
+			config := &scanConfig{connectionId: connectionUuid.String()}
+			config.schemas, err = cmd.Flags().GetStringSlice("schema")
+			if err != nil {
+				return err
+			}
+			if cmd.Flags().Changed("sample-size") {
+				sampleSize, err := cmd.Flags().GetUint32("sample-size")
+				if err != nil {
+					return err
+				}
+				config.sampleSize = &sampleSize
+			}
+			if cmd.Flags().Changed("min-confidence") {
+				minConfidence, err := cmd.Flags().GetFloat64("min-confidence")
+				if err != nil {
+					return err
+				}
+				config.minConfidence = &minConfidence
+			}
+			config.jobId, err = cmd.Flags().GetString("job-id")
+			if err != nil {
+				return err
+			}
+			config.jobRunId, err = cmd.Flags().GetString("job-run-id")
+			if err != nil {
+				return err
+			}
+
+			cmd.SilenceUsage = true
+			return scanConnection(cmd.Context(), &apiKey, config)
+		},
+	}
+	cmd.Flags().StringSlice("schema", []string{}, "Only scan tables in these schemas. Defaults to every schema")
+	cmd.Flags().Uint32("sample-size", 100, "Number of rows to sample from each table")
+	cmd.Flags().Float64("min-confidence", 0.5, "Only report columns that were classified with at least this confidence (0-1)")
+	cmd.Flags().String("job-id", "", "Neosync job id whose latest run should be scanned. Only used for AWS S3 connections")
+	cmd.Flags().String("job-run-id", "", "Neosync job run id to scan. Only used for AWS S3 connections")
+	return cmd
+}
+
+func scanConnection(
+	ctx context.Context,
+	apiKey *string,
+	config *scanConfig,
+) error {
+	isAuthEnabled, err := auth.IsAuthEnabled(ctx)
+	if err != nil {
+		return err
+	}
+
+	connectiondataclient := mgmtv1alpha1connect.NewConnectionDataServiceClient(
+		http.DefaultClient,
+		serverconfig.GetApiBaseUrl(),
+		connect.WithInterceptors(
+			auth_interceptor.NewInterceptor(isAuthEnabled, auth.AuthHeader, auth.GetAuthHeaderTokenFn(apiKey)),
+		),
+	)
+
+	req := &mgmtv1alpha1.ScanConnectionPiiRequest{
+		ConnectionId:  config.connectionId,
+		Schemas:       config.schemas,
+		SampleSize:    config.sampleSize,
+		MinConfidence: config.minConfidence,
+	}
+	if config.jobRunId != "" {
+		req.StreamConfig = &mgmtv1alpha1.ConnectionStreamConfig{
+			Config: &mgmtv1alpha1.ConnectionStreamConfig_AwsS3Config{
+				AwsS3Config: &mgmtv1alpha1.AwsS3StreamConfig{Id: &mgmtv1alpha1.AwsS3StreamConfig_JobRunId{JobRunId: config.jobRunId}},
+			},
+		}
+	} else if config.jobId != "" {
+		req.StreamConfig = &mgmtv1alpha1.ConnectionStreamConfig{
+			Config: &mgmtv1alpha1.ConnectionStreamConfig_AwsS3Config{
+				AwsS3Config: &mgmtv1alpha1.AwsS3StreamConfig{Id: &mgmtv1alpha1.AwsS3StreamConfig_JobId{JobId: config.jobId}},
+			},
+		}
+	}
+
+	res, err := connectiondataclient.ScanConnectionPii(ctx, connect.NewRequest(req))
+	if err != nil {
+		return err
+	}
+
+	fmt.Println() //nolint:forbidigo
+	if len(res.Msg.Columns) == 0 {
+		fmt.Println("No PII was detected in the connection.") //nolint:forbidigo
+	} else {
+		printPiiReportTable(res.Msg.Columns)
+	}
+	fmt.Println() //nolint:forbidigo
+	return nil
+}
+
+func printPiiReportTable(
+	columns []*mgmtv1alpha1.PiiColumnReport,
+) {
+	tbl := table.
+		New("Schema", "Table", "Column", "Data Type", "Category", "Confidence", "Matched", "Suggested Transformer").
+		WithHeaderFormatter(
+			color.New(color.FgGreen, color.Underline).SprintfFunc(),
+		).
+		WithFirstColumnFormatter(
+			color.New(color.FgYellow).SprintfFunc(),
+		)
+
+	for idx := range columns {
+		column := columns[idx]
+		tbl.AddRow(
+			column.Schema,
+			column.Table,
+			column.Column,
+			column.DataType,
+			getPiiCategoryName(column.Category),
+			fmt.Sprintf("%.2f", column.Confidence),
+			fmt.Sprintf("%d/%d", column.MatchedValues, column.SampledValues),
+			getTransformerName(column.GetSuggestedMapping().GetTransformer().GetSource()),
+		)
+	}
+	tbl.Print()
+}
+
+func getPiiCategoryName(category mgmtv1alpha1.PiiCategory) string {
+	return strings.ToLower(strings.TrimPrefix(category.String(), "PII_CATEGORY_"))
+}
+
+func getTransformerName(source mgmtv1alpha1.TransformerSource) string {
+	return strings.ToLower(strings.TrimPrefix(source.String(), "TRANSFORMER_SOURCE_"))
+}
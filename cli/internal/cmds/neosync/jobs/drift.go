@@ -0,0 +1,215 @@
+package jobs_cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"connectrpc.com/connect"
+	"github.com/fatih/color"
+	"github.com/google/uuid"
+	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
+	"github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1/mgmtv1alpha1connect"
+	"github.com/nucleuscloud/neosync/cli/internal/auth"
+	auth_interceptor "github.com/nucleuscloud/neosync/cli/internal/connect/interceptors/auth"
+	"github.com/nucleuscloud/neosync/cli/internal/serverconfig"
+	"github.com/nucleuscloud/neosync/cli/internal/userconfig"
+	"github.com/rodaine/table"
+	"github.com/spf13/cobra"
+)
+
+func newDriftCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "drift [id]",
+		Short: "prints the schema drift report of a job run",
+		Long: "Prints the source columns that were added, removed, renamed or changed type since the job mappings were last updated, along with what the run did with each change. Defaults to the most recent run of the job.\n" +
+			"Use the apply flag to update the job mappings with the changes in the report. New columns are mapped to their suggested transformer, renamed columns keep their transformer and removed columns are unmapped.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) != 1 {
+				return errors.New("must provide job uuid as argument")
+			}
+
+			apiKey, err := cmd.Flags().GetString("api-key")
+			if err != nil {
+				return err
+			}
+
+			accountId, err := cmd.Flags().GetString("account-id")
+			if err != nil {
+				return err
+			}
+			runId, err := cmd.Flags().GetString("run-id")
+			if err != nil {
+				return err
+			}
+			apply, err := cmd.Flags().GetBool("apply")
+			if err != nil {
+				return err
+			}
+
+			jobUuid, err := uuid.Parse(args[0])
+			if err != nil {
+				return err
+			}
+
+			cmd.SilenceUsage = true
+			return printJobDrift(cmd.Context(), jobUuid.String(), runId, apply, &apiKey, &accountId)
+		},
+	}
+	cmd.Flags().String("account-id", "", "Account that job is in. Defaults to account id in cli context")
+	cmd.Flags().String("run-id", "", "Job run to print the report of. Defaults to the most recent run of the job")
+	cmd.Flags().Bool("apply", false, "Update the job mappings with the changes in the report")
+	return cmd
+}
+
+func printJobDrift(
+	ctx context.Context,
+	jobId, runId string,
+	apply bool,
+	apiKey, accountIdFlag *string,
+) error {
+	isAuthEnabled, err := auth.IsAuthEnabled(ctx)
+	if err != nil {
+		return err
+	}
+	var accountId = accountIdFlag
+	if accountId == nil || *accountId == "" {
+		aId, err := userconfig.GetAccountId()
+		if err != nil {
+			fmt.Println("Unable to retrieve account id. Please use account switch command to set account.") //nolint:forbidigo
+			return err
+		}
+		accountId = &aId
+	}
+
+	if accountId == nil || *accountId == "" {
+		return errors.New("Account Id not found. Please use account switch command to set account.")
+	}
+
+	jobclient := mgmtv1alpha1connect.NewJobServiceClient(
+		http.DefaultClient,
+		serverconfig.GetApiBaseUrl(),
+		connect.WithInterceptors(auth_interceptor.NewInterceptor(isAuthEnabled, auth.AuthHeader, auth.GetAuthHeaderTokenFn(apiKey))),
+	)
+	job, err := jobclient.GetJob(ctx, connect.NewRequest(&mgmtv1alpha1.GetJobRequest{
+		Id: jobId,
+	}))
+	if err != nil {
+		return err
+	}
+	if job.Msg.Job.AccountId != *accountId {
+		return fmt.Errorf("Unable to get schema drift report. Job not found. AccountId: %s", *accountId)
+	}
+
+	req := &mgmtv1alpha1.GetJobRunSchemaDriftReportRequest{JobId: jobId}
+	if runId != "" {
+		req.JobRunId = &runId
+	}
+	res, err := jobclient.GetJobRunSchemaDriftReport(ctx, connect.NewRequest(req))
+	if err != nil {
+		return err
+	}
+	report := res.Msg.Report
+
+	fmt.Println() //nolint:forbidigo
+	printDriftTable(report.Changes)
+	fmt.Println() //nolint:forbidigo
+	if len(report.Changes) == 0 {
+		fmt.Printf("No schema drift found in run %s.\n", report.JobRunId) //nolint:forbidigo
+		return nil
+	}
+	if report.Halted {
+		fmt.Printf("Run %s was halted because of schema drift.\n", report.JobRunId) //nolint:forbidigo
+	}
+	if !apply {
+		return nil
+	}
+
+	_, err = jobclient.ApplyJobRunSchemaDriftReport(ctx, connect.NewRequest(&mgmtv1alpha1.ApplyJobRunSchemaDriftReportRequest{
+		JobId:    jobId,
+		JobRunId: report.JobRunId,
+	}))
+	if err != nil {
+		return err
+	}
+	fmt.Printf("Applied %d changes to the job mappings.\n", len(report.Changes)) //nolint:forbidigo
+	return nil
+}
+
+func printDriftTable(changes []*mgmtv1alpha1.SchemaDriftChange) {
+	tbl := table.
+		New("Change", "Schema", "Table", "Column", "Data Type", "Action", "Suggested Transformer").
+		WithHeaderFormatter(
+			color.New(color.FgGreen, color.Underline).SprintfFunc(),
+		).
+		WithFirstColumnFormatter(
+			color.New(color.FgYellow).SprintfFunc(),
+		)
+
+	for _, change := range changes {
+		column := change.Column
+		if change.RenamedFrom != nil {
+			column = fmt.Sprintf("%s -> %s", change.GetRenamedFrom(), change.Column)
+		}
+		dataType := change.GetDataType()
+		if change.PreviousDataType != nil && change.GetPreviousDataType() != dataType {
+			dataType = fmt.Sprintf("%s -> %s", change.GetPreviousDataType(), dataType)
+		}
+		suggestedTransformer := ""
+		if change.SuggestedTransformer != nil {
+			suggestedTransformer = getTransformerSourceName(change.SuggestedTransformer.Source)
+		}
+		tbl.AddRow(
+			getDriftChangeTypeName(change.Type),
+			change.Schema,
+			change.Table,
+			column,
+			dataType,
+			getDriftActionName(change.Action),
+			suggestedTransformer,
+		)
+	}
+	tbl.Print()
+}
+
+func getDriftChangeTypeName(changeType mgmtv1alpha1.SchemaDriftChangeType) string {
+	switch changeType {
+	case mgmtv1alpha1.SchemaDriftChangeType_SCHEMA_DRIFT_CHANGE_TYPE_COLUMN_ADDED:
+		return "added"
+	case mgmtv1alpha1.SchemaDriftChangeType_SCHEMA_DRIFT_CHANGE_TYPE_COLUMN_REMOVED:
+		return "removed"
+	case mgmtv1alpha1.SchemaDriftChangeType_SCHEMA_DRIFT_CHANGE_TYPE_COLUMN_RENAMED:
+		return "renamed"
+	case mgmtv1alpha1.SchemaDriftChangeType_SCHEMA_DRIFT_CHANGE_TYPE_COLUMN_TYPE_CHANGED:
+		return "type changed"
+	default:
+		return changeType.String()
+	}
+}
+
+func getDriftActionName(action mgmtv1alpha1.SchemaDriftAction) string {
+	switch action {
+	case mgmtv1alpha1.SchemaDriftAction_SCHEMA_DRIFT_ACTION_HALTED:
+		return "halted"
+	case mgmtv1alpha1.SchemaDriftAction_SCHEMA_DRIFT_ACTION_AUTO_MAPPED:
+		return "auto mapped"
+	case mgmtv1alpha1.SchemaDriftAction_SCHEMA_DRIFT_ACTION_IGNORED:
+		return "ignored"
+	default:
+		return action.String()
+	}
+}
+
+func getTransformerSourceName(source mgmtv1alpha1.TransformerSource) string {
+	switch source {
+	case mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_PASSTHROUGH:
+		return "passthrough"
+	case mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_GENERATE_NULL:
+		return "null"
+	case mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_GENERATE_DEFAULT:
+		return "default"
+	default:
+		return source.String()
+	}
+}
@@ -0,0 +1,236 @@
+package jobs_cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"slices"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
+	"github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1/mgmtv1alpha1connect"
+	"github.com/nucleuscloud/neosync/backend/pkg/secrets"
+	"github.com/nucleuscloud/neosync/cli/internal/accountspec"
+	"github.com/nucleuscloud/neosync/cli/internal/auth"
+	auth_interceptor "github.com/nucleuscloud/neosync/cli/internal/connect/interceptors/auth"
+	"github.com/nucleuscloud/neosync/cli/internal/serverconfig"
+	"github.com/nucleuscloud/neosync/cli/internal/userconfig"
+	"github.com/spf13/cobra"
+)
+
+func newExportCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "export [id...]",
+		Short: "exports jobs as a YAML account spec",
+		Long: "Exports the jobs along with the connections and user defined transformers they use as a versioned YAML account spec that can be kept in source control and applied with the apply command.\n" +
+			"Connection secrets are left empty in the spec. Empty secrets keep the values of the existing connection when the spec is applied.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			apiKey, err := cmd.Flags().GetString("api-key")
+			if err != nil {
+				return err
+			}
+
+			accountId, err := cmd.Flags().GetString("account-id")
+			if err != nil {
+				return err
+			}
+
+			all, err := cmd.Flags().GetBool("all")
+			if err != nil {
+				return err
+			}
+			file, err := cmd.Flags().GetString("file")
+			if err != nil {
+				return err
+			}
+
+			if all && len(args) > 0 {
+				return errors.New("job uuids may not be provided with the all flag")
+			}
+			if !all && len(args) == 0 {
+				return errors.New("must provide at least one job uuid as argument or the all flag")
+			}
+			jobIds := make([]string, 0, len(args))
+			for _, arg := range args {
+				jobUuid, err := uuid.Parse(arg)
+				if err != nil {
+					return err
+				}
+				jobIds = append(jobIds, jobUuid.String())
+			}
+
+			cmd.SilenceUsage = true
+			return exportJobs(cmd.Context(), &apiKey, &accountId, jobIds, file)
+		},
+	}
+	cmd.Flags().String("account-id", "", "Account that jobs are in. Defaults to account id in cli context")
+	cmd.Flags().Bool("all", false, "Export every job in the account")
+	cmd.Flags().StringP("file", "f", "", "File to write the spec to. Defaults to stdout")
+	return cmd
+}
+
+func exportJobs(
+	ctx context.Context,
+	apiKey, accountIdFlag *string,
+	jobIds []string,
+	file string,
+) error {
+	isAuthEnabled, err := auth.IsAuthEnabled(ctx)
+	if err != nil {
+		return err
+	}
+	var accountId = accountIdFlag
+	if accountId == nil || *accountId == "" {
+		aId, err := userconfig.GetAccountId()
+		if err != nil {
+			fmt.Println("Unable to retrieve account id. Please use account switch command to set account.") //nolint:forbidigo
+			return err
+		}
+		accountId = &aId
+	}
+
+	if accountId == nil || *accountId == "" {
+		return errors.New("Account Id not found. Please use account switch command to set account.")
+	}
+
+	interceptors := connect.WithInterceptors(auth_interceptor.NewInterceptor(isAuthEnabled, auth.AuthHeader, auth.GetAuthHeaderTokenFn(apiKey)))
+	jobclient := mgmtv1alpha1connect.NewJobServiceClient(http.DefaultClient, serverconfig.GetApiBaseUrl(), interceptors)
+	connectionclient := mgmtv1alpha1connect.NewConnectionServiceClient(http.DefaultClient, serverconfig.GetApiBaseUrl(), interceptors)
+	transformerclient := mgmtv1alpha1connect.NewTransformersServiceClient(http.DefaultClient, serverconfig.GetApiBaseUrl(), interceptors)
+
+	jobs := []*mgmtv1alpha1.Job{}
+	if len(jobIds) == 0 {
+		res, err := jobclient.GetJobs(ctx, connect.NewRequest(&mgmtv1alpha1.GetJobsRequest{AccountId: *accountId}))
+		if err != nil {
+			return err
+		}
+		jobs = res.Msg.Jobs
+	}
+	for _, jobId := range jobIds {
+		res, err := jobclient.GetJob(ctx, connect.NewRequest(&mgmtv1alpha1.GetJobRequest{Id: jobId}))
+		if err != nil {
+			return err
+		}
+		if res.Msg.Job.AccountId != *accountId {
+			return fmt.Errorf("Unable to export job. Job %s not found. AccountId: %s", jobId, *accountId)
+		}
+		jobs = append(jobs, res.Msg.Job)
+	}
+
+	// resources keep their ids in the spec so that the jobs refer to them without any changes
+	spec := &mgmtv1alpha1.AccountSpec{Version: accountspec.Version}
+	connectionIds := []string{}
+	transformerIds := []string{}
+	for _, job := range jobs {
+		spec.Jobs = append(spec.Jobs, toJobSpec(job))
+		connectionIds = appendUnique(connectionIds, getJobConnectionIds(job)...)
+		transformerIds = appendUnique(transformerIds, getJobTransformerIds(job)...)
+	}
+	for _, connectionId := range connectionIds {
+		res, err := connectionclient.GetConnection(ctx, connect.NewRequest(&mgmtv1alpha1.GetConnectionRequest{Id: connectionId}))
+		if err != nil {
+			return err
+		}
+		spec.Connections = append(spec.Connections, toConnectionSpec(res.Msg.Connection))
+	}
+	for _, transformerId := range transformerIds {
+		res, err := transformerclient.GetUserDefinedTransformerById(ctx, connect.NewRequest(&mgmtv1alpha1.GetUserDefinedTransformerByIdRequest{TransformerId: transformerId}))
+		if err != nil {
+			return err
+		}
+		spec.Transformers = append(spec.Transformers, &mgmtv1alpha1.TransformerSpec{
+			Id:          res.Msg.Transformer.Id,
+			Name:        res.Msg.Transformer.Name,
+			Description: res.Msg.Transformer.Description,
+			Source:      res.Msg.Transformer.Source,
+			Config:      res.Msg.Transformer.Config,
+		})
+	}
+
+	data, err := accountspec.Marshal(spec)
+	if err != nil {
+		return err
+	}
+	if file == "" {
+		fmt.Print(string(data)) //nolint:forbidigo
+		return nil
+	}
+	return os.WriteFile(file, data, 0600)
+}
+
+// The connection secrets are redacted as the spec is written to disk
+func toConnectionSpec(connection *mgmtv1alpha1.Connection) *mgmtv1alpha1.ConnectionSpec {
+	return &mgmtv1alpha1.ConnectionSpec{
+		Id:               connection.Id,
+		Name:             connection.Name,
+		ConnectionConfig: secrets.RedactConnectionConfig(connection.ConnectionConfig),
+	}
+}
+
+func toJobSpec(job *mgmtv1alpha1.Job) *mgmtv1alpha1.JobSpec {
+	destinations := make([]*mgmtv1alpha1.CreateJobDestination, 0, len(job.Destinations))
+	for _, dest := range job.Destinations {
+		destinations = append(destinations, &mgmtv1alpha1.CreateJobDestination{
+			ConnectionId: dest.ConnectionId,
+			Options:      dest.Options,
+		})
+	}
+	return &mgmtv1alpha1.JobSpec{
+		Id:              job.Id,
+		Name:            job.Name,
+		CronSchedule:    job.CronSchedule,
+		Source:          job.Source,
+		Destinations:    destinations,
+		Mappings:        job.Mappings,
+		WorkflowOptions: job.WorkflowOptions,
+		SyncOptions:     job.SyncOptions,
+		Triggers:        job.Triggers,
+	}
+}
+
+func getJobConnectionIds(job *mgmtv1alpha1.Job) []string {
+	ids := []string{}
+	switch config := job.GetSource().GetOptions().GetConfig().(type) {
+	case *mgmtv1alpha1.JobSourceOptions_Postgres:
+		ids = append(ids, config.Postgres.GetConnectionId())
+	case *mgmtv1alpha1.JobSourceOptions_Mysql:
+		ids = append(ids, config.Mysql.GetConnectionId())
+	case *mgmtv1alpha1.JobSourceOptions_Mssql:
+		ids = append(ids, config.Mssql.GetConnectionId())
+	case *mgmtv1alpha1.JobSourceOptions_PostgresCdc:
+		ids = append(ids, config.PostgresCdc.GetConnectionId())
+	case *mgmtv1alpha1.JobSourceOptions_AwsS3:
+		ids = append(ids, config.AwsS3.GetConnectionId())
+	case *mgmtv1alpha1.JobSourceOptions_Generate:
+		if config.Generate.GetFkSourceConnectionId() != "" {
+			ids = append(ids, config.Generate.GetFkSourceConnectionId())
+		}
+	}
+	for _, dest := range job.Destinations {
+		ids = append(ids, dest.ConnectionId)
+	}
+	return ids
+}
+
+func getJobTransformerIds(job *mgmtv1alpha1.Job) []string {
+	ids := []string{}
+	for _, mapping := range job.Mappings {
+		if udtConfig := mapping.GetTransformer().GetConfig().GetUserDefinedTransformerConfig(); udtConfig != nil {
+			ids = append(ids, udtConfig.Id)
+		}
+	}
+	return ids
+}
+
+func appendUnique(ids []string, values ...string) []string {
+	for _, value := range values {
+		if value == "" || slices.Contains(ids, value) {
+			continue
+		}
+		ids = append(ids, value)
+	}
+	return ids
+}
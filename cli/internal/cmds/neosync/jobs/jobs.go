@@ -15,5 +15,8 @@ func NewCmd() *cobra.Command {
 
 	cmd.AddCommand(newListCmd())
 	cmd.AddCommand(newTriggerCmd())
+	cmd.AddCommand(newPreviewCmd())
+	cmd.AddCommand(newExportCmd())
+	cmd.AddCommand(newDriftCmd())
 	return cmd
 }
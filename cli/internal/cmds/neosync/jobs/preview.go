@@ -0,0 +1,223 @@
+package jobs_cmd
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+
+	"connectrpc.com/connect"
+	"github.com/fatih/color"
+	"github.com/google/uuid"
+	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
+	"github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1/mgmtv1alpha1connect"
+	"github.com/nucleuscloud/neosync/cli/internal/auth"
+	auth_interceptor "github.com/nucleuscloud/neosync/cli/internal/connect/interceptors/auth"
+	"github.com/nucleuscloud/neosync/cli/internal/serverconfig"
+	"github.com/nucleuscloud/neosync/cli/internal/userconfig"
+	"github.com/rodaine/table"
+	"github.com/spf13/cobra"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+const (
+	maxPreviewValueLength = 40
+	nullPreviewValue      = "NULL"
+)
+
+type previewConfig struct {
+	jobId        string
+	connectionId string
+	mappingsFile string
+	rowCount     *uint32
+}
+
+func newPreviewCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "preview [id]",
+		Short: "previews the transformed rows of a job",
+		Long: "Pulls a few rows from each table of the job's source connection, runs them through the job mappings and prints them before and after they were transformed. Nothing is written to the job destinations.\n" +
+			"Mappings that have not been saved to a job can be previewed by providing a source connection id and a JSON file that contains a list of job mappings instead of a job id.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			apiKey, err := cmd.Flags().GetString("api-key")
+			if err != nil {
+				return err
+			}
+
+			accountId, err := cmd.Flags().GetString("account-id")
+			if err != nil {
+				return err
+			}
+
+			config := &previewConfig{}
+			config.connectionId, err = cmd.Flags().GetString("connection-id")
+			if err != nil {
+				return err
+			}
+			config.mappingsFile, err = cmd.Flags().GetString("mappings-file")
+			if err != nil {
+				return err
+			}
+			if cmd.Flags().Changed("row-count") {
+				rowCount, err := cmd.Flags().GetUint32("row-count")
+				if err != nil {
+					return err
+				}
+				config.rowCount = &rowCount
+			}
+
+			if len(args) == 1 {
+				jobUuid, err := uuid.Parse(args[0])
+				if err != nil {
+					return err
+				}
+				config.jobId = jobUuid.String()
+				if config.connectionId != "" || config.mappingsFile != "" {
+					return errors.New("connection-id and mappings-file may not be provided with a job id")
+				}
+			} else if len(args) > 1 {
+				return errors.New("must provide at most one job uuid as argument")
+			} else if config.connectionId == "" || config.mappingsFile == "" {
+				return errors.New("must provide job uuid as argument or both connection-id and mappings-file")
+			}
+
+			cmd.SilenceUsage = true
+			return previewJob(cmd.Context(), &apiKey, &accountId, config)
+		},
+	}
+	cmd.Flags().String("account-id", "", "Account that job is in. Defaults to account id in cli context")
+	cmd.Flags().Uint32("row-count", 10, "Number of rows to preview from each table")
+	cmd.Flags().String("connection-id", "", "Source connection to preview unsaved mappings against. Must be used with mappings-file")
+	cmd.Flags().String("mappings-file", "", "Path to a JSON file that contains a list of job mappings to preview. Must be used with connection-id")
+	return cmd
+}
+
+func previewJob(
+	ctx context.Context,
+	apiKey, accountIdFlag *string,
+	config *previewConfig,
+) error {
+	isAuthEnabled, err := auth.IsAuthEnabled(ctx)
+	if err != nil {
+		return err
+	}
+	var accountId = accountIdFlag
+	if accountId == nil || *accountId == "" {
+		aId, err := userconfig.GetAccountId()
+		if err != nil {
+			fmt.Println("Unable to retrieve account id. Please use account switch command to set account.") //nolint:forbidigo
+			return err
+		}
+		accountId = &aId
+	}
+
+	if accountId == nil || *accountId == "" {
+		return errors.New("Account Id not found. Please use account switch command to set account.")
+	}
+
+	req := &mgmtv1alpha1.PreviewJobMappingsRequest{
+		AccountId: *accountId,
+		RowCount:  config.rowCount,
+	}
+	if config.jobId != "" {
+		req.Source = &mgmtv1alpha1.PreviewJobMappingsRequest_JobId{JobId: config.jobId}
+	} else {
+		mappings, err := readJobMappingsFile(config.mappingsFile)
+		if err != nil {
+			return err
+		}
+		req.Source = &mgmtv1alpha1.PreviewJobMappingsRequest_Mappings{
+			Mappings: &mgmtv1alpha1.JobMappingsPreviewSource{
+				ConnectionId: config.connectionId,
+				Mappings:     mappings,
+			},
+		}
+	}
+
+	jobclient := mgmtv1alpha1connect.NewJobServiceClient(
+		http.DefaultClient,
+		serverconfig.GetApiBaseUrl(),
+		connect.WithInterceptors(auth_interceptor.NewInterceptor(isAuthEnabled, auth.AuthHeader, auth.GetAuthHeaderTokenFn(apiKey))),
+	)
+	res, err := jobclient.PreviewJobMappings(ctx, connect.NewRequest(req))
+	if err != nil {
+		return err
+	}
+
+	fmt.Println() //nolint:forbidigo
+	if len(res.Msg.Tables) == 0 {
+		fmt.Println("No tables were previewed.") //nolint:forbidigo
+		fmt.Println()                            //nolint:forbidigo
+		return nil
+	}
+	for _, tablePreview := range res.Msg.Tables {
+		color.New(color.Bold).Printf("%s.%s\n", tablePreview.Schema, tablePreview.Table) //nolint:forbidigo
+		if len(tablePreview.Rows) == 0 {
+			fmt.Println("No rows were found in the table.") //nolint:forbidigo
+		} else {
+			printPreviewTable(tablePreview)
+		}
+		fmt.Println() //nolint:forbidigo
+	}
+	return nil
+}
+
+// The file may contain the job mappings in the same JSON format that the api returns
+func readJobMappingsFile(path string) ([]*mgmtv1alpha1.JobMapping, error) {
+	bits, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read mappings file: %w", err)
+	}
+	var rawMappings []json.RawMessage
+	if err := json.Unmarshal(bits, &rawMappings); err != nil {
+		return nil, fmt.Errorf("mappings file must contain a list of job mappings: %w", err)
+	}
+	mappings := make([]*mgmtv1alpha1.JobMapping, 0, len(rawMappings))
+	for idx, rawMapping := range rawMappings {
+		mapping := &mgmtv1alpha1.JobMapping{}
+		if err := protojson.Unmarshal(rawMapping, mapping); err != nil {
+			return nil, fmt.Errorf("unable to parse job mapping at index %d: %w", idx, err)
+		}
+		mappings = append(mappings, mapping)
+	}
+	return mappings, nil
+}
+
+func printPreviewTable(
+	tablePreview *mgmtv1alpha1.JobMappingsTablePreview,
+) {
+	tbl := table.
+		New("Row", "Column", "Before", "After").
+		WithHeaderFormatter(
+			color.New(color.FgGreen, color.Underline).SprintfFunc(),
+		).
+		WithFirstColumnFormatter(
+			color.New(color.FgYellow).SprintfFunc(),
+		)
+
+	for idx, row := range tablePreview.Rows {
+		for _, column := range tablePreview.Columns {
+			tbl.AddRow(
+				idx+1,
+				column,
+				formatPreviewValue(row.Before, column),
+				formatPreviewValue(row.After, column),
+			)
+		}
+	}
+	tbl.Print()
+}
+
+func formatPreviewValue(values map[string][]byte, column string) string {
+	value, ok := values[column]
+	if !ok {
+		return nullPreviewValue
+	}
+	runes := []rune(string(value))
+	if len(runes) > maxPreviewValueLength {
+		return string(runes[:maxPreviewValueLength-3]) + "..."
+	}
+	return string(runes)
+}
@@ -7,6 +7,8 @@ import (
 	"path/filepath"
 
 	accounts_cmd "github.com/nucleuscloud/neosync/cli/internal/cmds/neosync/accounts"
+	apply_cmd "github.com/nucleuscloud/neosync/cli/internal/cmds/neosync/apply"
+	audit_cmd "github.com/nucleuscloud/neosync/cli/internal/cmds/neosync/audit"
 	connections_cmd "github.com/nucleuscloud/neosync/cli/internal/cmds/neosync/connections"
 	jobs_cmd "github.com/nucleuscloud/neosync/cli/internal/cmds/neosync/jobs"
 	login_cmd "github.com/nucleuscloud/neosync/cli/internal/cmds/neosync/login"
@@ -79,6 +81,8 @@ func Execute() {
 	rootCmd.AddCommand(sync_cmd.NewCmd())
 	rootCmd.AddCommand(accounts_cmd.NewCmd())
 	rootCmd.AddCommand(connections_cmd.NewCmd())
+	rootCmd.AddCommand(audit_cmd.NewCmd())
+	rootCmd.AddCommand(apply_cmd.NewCmd())
 
 	cobra.CheckErr(rootCmd.Execute())
 }
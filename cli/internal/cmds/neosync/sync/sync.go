@@ -21,8 +21,10 @@ import (
 	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
 	"github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1/mgmtv1alpha1connect"
 	dbschemas_utils "github.com/nucleuscloud/neosync/backend/pkg/dbschemas"
+	dbschemas_mssql "github.com/nucleuscloud/neosync/backend/pkg/dbschemas/mssql"
 	dbschemas_mysql "github.com/nucleuscloud/neosync/backend/pkg/dbschemas/mysql"
 	dbschemas_postgres "github.com/nucleuscloud/neosync/backend/pkg/dbschemas/postgres"
+	dbschemas_sqlite "github.com/nucleuscloud/neosync/backend/pkg/dbschemas/sqlite"
 	tabledependency "github.com/nucleuscloud/neosync/backend/pkg/table-dependency"
 	"github.com/nucleuscloud/neosync/cli/internal/auth"
 	neosync_benthos "github.com/nucleuscloud/neosync/cli/internal/benthos"
@@ -54,10 +56,13 @@ const (
 
 	postgresDriver DriverType = "postgres"
 	mysqlDriver    DriverType = "mysql"
+	mssqlDriver    DriverType = "sqlserver"
+	sqliteDriver   DriverType = "sqlite"
 
 	awsS3Connection    ConnectionType = "awsS3"
 	postgresConnection ConnectionType = "postgres"
 	mysqlConnection    ConnectionType = "mysql"
+	mssqlConnection    ConnectionType = "mssql"
 
 	batchSize = 20
 )
@@ -66,6 +71,8 @@ var (
 	driverMap = map[string]DriverType{
 		string(postgresDriver): postgresDriver,
 		string(mysqlDriver):    mysqlDriver,
+		string(mssqlDriver):    mssqlDriver,
+		string(sqliteDriver):   sqliteDriver,
 	}
 )
 
@@ -243,8 +250,11 @@ func NewCmd() *cobra.Command {
 				return fmt.Errorf("wrong driver type. truncate cascade is only supported in postgres")
 			}
 
-			if config.Destination.Driver != mysqlDriver && config.Destination.Driver != postgresDriver {
-				return errors.New("unsupported destination driver. only postgres and mysql are currently supported")
+			if config.Destination.Driver != mysqlDriver && config.Destination.Driver != postgresDriver && config.Destination.Driver != mssqlDriver && config.Destination.Driver != sqliteDriver {
+				return errors.New("unsupported destination driver. only postgres, mysql, sqlserver and sqlite are currently supported")
+			}
+			if config.Destination.Driver == sqliteDriver {
+				config.Destination.ConnectionUrl = getSqliteBusyTimeoutConnectionUrl(config.Destination.ConnectionUrl)
 			}
 
 			accountId, err := cmd.Flags().GetString("account-id")
@@ -264,7 +274,7 @@ func NewCmd() *cobra.Command {
 	cmd.Flags().String("connection-id", "", "Connection id for sync source")
 	cmd.Flags().String("job-id", "", "Id of Job to sync data from. Only used with AWS S3 connections. Can use job-run-id instead.")
 	cmd.Flags().String("job-run-id", "", "Id of Job run to sync data from. Only used with AWS S3 connections. Can use job-id instead.")
-	cmd.Flags().String("destination-connection-url", "", "Connection url for sync output")
+	cmd.Flags().String("destination-connection-url", "", "Connection url for sync output. For sqlite this is the path to the database file")
 	cmd.Flags().String("destination-driver", "", "Connection driver for sync output")
 	cmd.Flags().String("account-id", "", "Account source connection is in. Defaults to account id in cli context")
 	cmd.Flags().String("config", "", "Location of config file")
@@ -396,6 +406,12 @@ func sync(
 				return nil
 			}
 			syncConfigs = append(syncConfigs, configs...)
+		} else if cmd.Destination.Driver == mssqlDriver {
+			configs := buildSyncConfigs(schemaCfg, buildMssqlInsertQuery, buildMssqlUpdateQuery)
+			if configs == nil {
+				return nil
+			}
+			syncConfigs = append(syncConfigs, configs...)
 		}
 
 	case mysqlConnection:
@@ -415,7 +431,35 @@ func sync(
 		}
 		schemaConfig = schemaCfg
 
-		configs := buildSyncConfigs(schemaCfg, buildMysqlInsertQuery, buildMysqlUpdateQuery)
+		var configs []*syncConfig
+		if cmd.Destination.Driver == sqliteDriver {
+			configs = buildSyncConfigs(schemaCfg, buildSqliteInsertQuery, buildSqliteUpdateQuery)
+		} else {
+			configs = buildSyncConfigs(schemaCfg, buildMysqlInsertQuery, buildMysqlUpdateQuery)
+		}
+		if configs == nil {
+			return nil
+		}
+		syncConfigs = append(syncConfigs, configs...)
+
+	case mssqlConnection:
+		fmt.Println(printlog.Render("Building schema and table constraints...")) //nolint:forbidigo
+		mssqlCfg := &mgmtv1alpha1.ConnectionSchemaConfig{
+			Config: &mgmtv1alpha1.ConnectionSchemaConfig_MssqlConfig{
+				MssqlConfig: &mgmtv1alpha1.MssqlSchemaConfig{},
+			},
+		}
+		schemaCfg, err := getConnectionSchemaConfig(ctx, connectiondataclient, connection, cmd, mssqlCfg)
+		if err != nil {
+			return err
+		}
+		if len(schemaCfg.Schemas) == 0 {
+			fmt.Println(bold.Render("No tables found.")) //nolint:forbidigo
+			return nil
+		}
+		schemaConfig = schemaCfg
+
+		configs := buildSyncConfigs(schemaCfg, buildMssqlInsertQuery, buildMssqlUpdateQuery)
 		if configs == nil {
 			return nil
 		}
@@ -438,7 +482,12 @@ func sync(
 		}
 		schemaConfig = schemaCfg
 
-		configs := buildSyncConfigs(schemaCfg, buildPostgresInsertQuery, buildPostgresUpdateQuery)
+		var configs []*syncConfig
+		if cmd.Destination.Driver == sqliteDriver {
+			configs = buildSyncConfigs(schemaCfg, buildSqliteInsertQuery, buildSqliteUpdateQuery)
+		} else {
+			configs = buildSyncConfigs(schemaCfg, buildPostgresInsertQuery, buildPostgresUpdateQuery)
+		}
 		if configs == nil {
 			return nil
 		}
@@ -487,16 +536,24 @@ func sync(
 func areSourceAndDestCompatible(connection *mgmtv1alpha1.Connection, destinationDriver DriverType) error {
 	switch connection.ConnectionConfig.Config.(type) {
 	case *mgmtv1alpha1.ConnectionConfig_PgConfig:
-		if destinationDriver != postgresDriver {
+		if destinationDriver != postgresDriver && destinationDriver != sqliteDriver {
 			return fmt.Errorf("Connection and destination types are incompatible [postgres, %s]", destinationDriver)
 		}
 	case *mgmtv1alpha1.ConnectionConfig_MysqlConfig:
-		if destinationDriver != mysqlDriver {
+		if destinationDriver != mysqlDriver && destinationDriver != sqliteDriver {
 			return fmt.Errorf("Connection and destination types are incompatible [mysql, %s]", destinationDriver)
 		}
+	case *mgmtv1alpha1.ConnectionConfig_MssqlConfig:
+		if destinationDriver != mssqlDriver {
+			return fmt.Errorf("Connection and destination types are incompatible [mssql, %s]", destinationDriver)
+		}
 	case *mgmtv1alpha1.ConnectionConfig_AwsS3Config:
+		// s3 data carries no column types so the sqlite tables could not be created from it
+		if destinationDriver == sqliteDriver {
+			return fmt.Errorf("Connection and destination types are incompatible [awsS3, %s]", destinationDriver)
+		}
 	default:
-		return errors.New("unsupported destination driver. only postgres and mysql are currently supported")
+		return errors.New("unsupported destination driver. only postgres, mysql and sqlserver are currently supported")
 	}
 	return nil
 }
@@ -629,10 +686,122 @@ func runDestinationInitStatements(ctx context.Context, cmd *cmdConfig, syncConfi
 			fmt.Println("Error truncating tables:", err) //nolint:forbidigo
 			return err
 		}
+	} else if cmd.Destination.Driver == mssqlDriver {
+		orderedTables, err := tabledependency.GetTablesOrderedByDependency(dependencyMap)
+		if err != nil {
+			return err
+		}
+		pool, err := sql.Open(string(mssqlDriver), cmd.Destination.ConnectionUrl)
+		if err != nil {
+			return err
+		}
+		defer pool.Close()
+		if cmd.Destination.InitSchema {
+			orderedInitStatements := []string{}
+			for _, t := range orderedTables {
+				orderedInitStatements = append(orderedInitStatements, schemaConfig.InitTableStatementsMap[t])
+			}
+			err = dbschemas_mssql.BatchExecStmts(ctx, pool, batchSize, orderedInitStatements)
+			if err != nil {
+				fmt.Println("Error creating tables:", err) //nolint:forbidigo
+				return err
+			}
+		}
+		if cmd.Destination.TruncateBeforeInsert {
+			// sql server deletes must run children first
+			orderedTruncateStatements := []string{}
+			for i := len(orderedTables) - 1; i >= 0; i-- {
+				schema, table := splitTableKey(orderedTables[i])
+				orderedTruncateStatements = append(orderedTruncateStatements, dbschemas_mssql.BuildTruncateStatement(schema, table))
+			}
+			err = dbschemas_mssql.BatchExecStmts(ctx, pool, batchSize, orderedTruncateStatements)
+			if err != nil {
+				fmt.Println("Error truncating tables:", err) //nolint:forbidigo
+				return err
+			}
+		}
+	} else if cmd.Destination.Driver == sqliteDriver {
+		orderedTables, err := tabledependency.GetTablesOrderedByDependency(dependencyMap)
+		if err != nil {
+			return err
+		}
+		pool, err := sql.Open(string(sqliteDriver), cmd.Destination.ConnectionUrl)
+		if err != nil {
+			return err
+		}
+		defer pool.Close()
+		if cmd.Destination.InitSchema {
+			orderedInitStatements, err := buildSqliteCreateTableStatements(orderedTables, schemaConfig)
+			if err != nil {
+				return err
+			}
+			err = dbschemas_sqlite.BatchExecStmts(ctx, pool, batchSize, orderedInitStatements)
+			if err != nil {
+				fmt.Println("Error creating tables:", err) //nolint:forbidigo
+				return err
+			}
+		}
+		if cmd.Destination.TruncateBeforeInsert {
+			// deletes run children first in case foreign key enforcement is enabled
+			orderedTruncateStatements := []string{}
+			for i := len(orderedTables) - 1; i >= 0; i-- {
+				_, table := splitTableKey(orderedTables[i])
+				orderedTruncateStatements = append(orderedTruncateStatements, dbschemas_sqlite.BuildTruncateStatement(table))
+			}
+			err = dbschemas_sqlite.BatchExecStmts(ctx, pool, batchSize, orderedTruncateStatements)
+			if err != nil {
+				fmt.Println("Error truncating tables:", err) //nolint:forbidigo
+				return err
+			}
+		}
 	}
 	return nil
 }
 
+// sqlite has no schemas, so each source table is created by its table name using the source column types
+func buildSqliteCreateTableStatements(orderedTables []string, schemaConfig *schemaConfig) ([]string, error) {
+	tableColumns := map[string][]*dbschemas_sqlite.Column{}
+	for _, col := range schemaConfig.Schemas {
+		key := dbschemas_utils.BuildTable(col.Schema, col.Table)
+		tableColumns[key] = append(tableColumns[key], &dbschemas_sqlite.Column{
+			Name:       col.Column,
+			DataType:   col.DataType,
+			IsNullable: dbschemas_utils.ConvertIsNullableToBool(col.IsNullable),
+		})
+	}
+
+	seen := map[string]string{}
+	statements := []string{}
+	for _, key := range orderedTables {
+		_, table := splitTableKey(key)
+		if other, ok := seen[table]; ok {
+			return nil, fmt.Errorf("tables %s and %s would both be created as %s in sqlite", other, key, table)
+		}
+		seen[table] = key
+
+		sqliteTable := &dbschemas_sqlite.Table{
+			Name:        table,
+			Columns:     tableColumns[key],
+			PrimaryKeys: schemaConfig.TablePrimaryKeys[key].GetColumns(),
+		}
+		if tc, ok := schemaConfig.TableConstraints[key]; ok {
+			for _, fk := range tc.Constraints {
+				if fk.ForeignKey == nil {
+					continue
+				}
+				_, foreignTable := splitTableKey(fk.ForeignKey.Table)
+				sqliteTable.ForeignKeys = append(sqliteTable.ForeignKeys, &dbschemas_sqlite.ForeignKey{
+					Columns:        []string{fk.Column},
+					ForeignTable:   foreignTable,
+					ForeignColumns: []string{fk.ForeignKey.Column},
+				})
+			}
+		}
+		statements = append(statements, dbschemas_sqlite.BuildCreateTableStatement(sqliteTable))
+	}
+	return statements, nil
+}
+
 func buildSyncConfigs(
 	schemaConfig *schemaConfig,
 	buildInsertQueryFunc func(schema, table string, cols []string) string,
@@ -722,11 +891,16 @@ func buildDependencyMap(syncConfigs []*syncConfig) map[string][]string {
 }
 
 func getTableInitStatementMap(ctx context.Context, connectiondataclient mgmtv1alpha1connect.ConnectionDataServiceClient, connectionId string, opts *destinationConfig) (*mgmtv1alpha1.GetConnectionInitStatementsResponse, error) {
+	if opts.Driver == sqliteDriver {
+		// source init statements are in the source dialect
+		// sqlite tables are built from the source schema in runDestinationInitStatements function
+		return nil, nil
+	}
 	if opts.InitSchema || opts.TruncateBeforeInsert || opts.TruncateCascade {
 		fmt.Println(printlog.Render("Creating init statements...")) //nolint:forbidigo
 		truncateBeforeInsert := opts.TruncateBeforeInsert
-		if opts.Driver == postgresDriver && truncateBeforeInsert {
-			// postgres truncate must be ordered properly
+		if (opts.Driver == postgresDriver || opts.Driver == mssqlDriver) && truncateBeforeInsert {
+			// postgres and sqlserver truncate must be ordered properly
 			// handled in runDestinationInitStatements function
 			truncateBeforeInsert = false
 		}
@@ -1164,7 +1338,8 @@ func getDestinationSchemaConfig(
 	initTableStatementsMap := map[string]string{}
 	for t := range tableColMap {
 		statements := []string{}
-		if cmd.Destination.TruncateBeforeInsert {
+		// sqlserver truncates are ordered and handled in runDestinationInitStatements function
+		if cmd.Destination.TruncateBeforeInsert && cmd.Destination.Driver != mssqlDriver {
 			if cmd.Destination.TruncateCascade {
 				statements = append(statements, fmt.Sprintf("TRUNCATE TABLE %s CASCADE;", t))
 			} else {
@@ -1216,6 +1391,24 @@ func getDestinationForeignConstraints(ctx context.Context, connectionDriver Driv
 			return nil, err
 		}
 		constraints = dbschemas_mysql.GetMysqlTableDependencies(allConstraints)
+	case mssqlDriver:
+		mssqlquerier := dbschemas_mssql.New()
+		conn, err := sql.Open(string(connectionDriver), connectionUrl)
+		if err != nil {
+			return nil, err
+		}
+		defer func() {
+			if err := conn.Close(); err != nil {
+				fmt.Println(fmt.Errorf("failed to close sqlserver connection: %w", err).Error()) //nolint:forbidigo
+			}
+		}()
+		cctx, cancel := context.WithDeadline(ctx, time.Now().Add(5*time.Second))
+		defer cancel()
+		allConstraints, err := dbschemas_mssql.GetAllMssqlFkConstraints(mssqlquerier, cctx, conn, schemas)
+		if err != nil {
+			return nil, err
+		}
+		constraints = dbschemas_mssql.GetMssqlTableDependencies(allConstraints)
 	default:
 		return nil, errors.New("unsupported fk connection")
 	}
@@ -1257,6 +1450,24 @@ func getDestinationPrimaryKeyConstraints(ctx context.Context, connectionDriver D
 			return nil, err
 		}
 		pc = dbschemas_mysql.GetMysqlTablePrimaryKeys(allConstraints)
+	case mssqlDriver:
+		mssqlquerier := dbschemas_mssql.New()
+		conn, err := sql.Open(string(connectionDriver), connectionUrl)
+		if err != nil {
+			return nil, err
+		}
+		defer func() {
+			if err := conn.Close(); err != nil {
+				fmt.Println(fmt.Errorf("failed to close sqlserver connection: %w", err).Error()) //nolint:forbidigo
+			}
+		}()
+		cctx, cancel := context.WithDeadline(ctx, time.Now().Add(5*time.Second))
+		defer cancel()
+		allConstraints, err := dbschemas_mssql.GetAllMssqlPkConstraints(mssqlquerier, cctx, conn, schemas)
+		if err != nil {
+			return nil, err
+		}
+		pc = dbschemas_mssql.GetMssqlTablePrimaryKeys(allConstraints)
 	default:
 		return nil, errors.New("unsupported fk connection")
 	}
@@ -1353,6 +1564,9 @@ func getConnectionType(connection *mgmtv1alpha1.Connection) (ConnectionType, err
 	if connection.ConnectionConfig.GetPgConfig() != nil {
 		return postgresConnection, nil
 	}
+	if connection.ConnectionConfig.GetMssqlConfig() != nil {
+		return mssqlConnection, nil
+	}
 	return "", errors.New("unsupported connection type")
 }
 
@@ -1409,9 +1623,82 @@ func buildMysqlUpdateQuery(schema, table string, columns, primaryKeys []string)
 	return fmt.Sprintf("UPDATE %s SET %s %s;", fmt.Sprintf("`%s`.`%s`", schema, table), strings.Join(values, ", "), where)
 }
 
+func buildMssqlInsertQuery(schema, table string, columns []string) string {
+	values := make([]string, len(columns))
+	paramCount := 1
+	for i := range columns {
+		values[i] = fmt.Sprintf("@p%d", paramCount)
+		paramCount++
+	}
+	return fmt.Sprintf("INSERT INTO %s (%s) VALUES (%s);", dbschemas_mssql.EscapeMssqlTable(schema, table), strings.Join(dbschemas_mssql.EscapeMssqlColumns(columns), ", "), strings.Join(values, ", "))
+}
+
+func buildMssqlUpdateQuery(schema, table string, columns, primaryKeys []string) string {
+	values := make([]string, len(columns))
+	var where string
+	paramCount := 1
+	for i, col := range columns {
+		values[i] = fmt.Sprintf("%s = @p%d", dbschemas_mssql.EscapeMssqlColumn(col), paramCount)
+		paramCount++
+	}
+	if len(primaryKeys) > 0 {
+		clauses := []string{}
+		for _, col := range primaryKeys {
+			clauses = append(clauses, fmt.Sprintf("%s = @p%d", dbschemas_mssql.EscapeMssqlColumn(col), paramCount))
+			paramCount++
+		}
+		where = fmt.Sprintf("WHERE %s", strings.Join(clauses, " AND "))
+	}
+	return fmt.Sprintf("UPDATE %s SET %s %s;", dbschemas_mssql.EscapeMssqlTable(schema, table), strings.Join(values, ", "), where)
+}
+
+// sqlite has no schemas, so the schema is dropped and rows are written to the table by name
+func buildSqliteInsertQuery(schema, table string, columns []string) string {
+	values := make([]string, len(columns))
+	for i := range columns {
+		values[i] = "?"
+	}
+	return fmt.Sprintf("INSERT INTO %s (%s) VALUES (%s);", dbschemas_sqlite.EscapeSqliteTable(table), strings.Join(dbschemas_sqlite.EscapeSqliteColumns(columns), ", "), strings.Join(values, ", "))
+}
+
+func buildSqliteUpdateQuery(schema, table string, columns, primaryKeys []string) string {
+	values := make([]string, len(columns))
+	var where string
+	for i, col := range columns {
+		values[i] = fmt.Sprintf("%s = ?", dbschemas_sqlite.EscapeSqliteColumn(col))
+	}
+	if len(primaryKeys) > 0 {
+		clauses := []string{}
+		for _, col := range primaryKeys {
+			clauses = append(clauses, fmt.Sprintf("%s = ?", dbschemas_sqlite.EscapeSqliteColumn(col)))
+		}
+		where = fmt.Sprintf("WHERE %s", strings.Join(clauses, " AND "))
+	}
+	return fmt.Sprintf("UPDATE %s SET %s %s;", dbschemas_sqlite.EscapeSqliteTable(table), strings.Join(values, ", "), where)
+}
+
+func splitTableKey(key string) (schema, table string) {
+	schema, table, found := strings.Cut(key, ".")
+	if !found {
+		return "", key
+	}
+	return schema, table
+}
+
 func getMysqlMultiStatementConnectionUrl(connectionUrl string) string {
 	if strings.Contains(connectionUrl, "?") {
 		return fmt.Sprintf("%s&multiStatements=true", connectionUrl)
 	}
 	return fmt.Sprintf("%s?multiStatements=true", connectionUrl)
 }
+
+// tables are synced concurrently into the same sqlite file, so writers need to wait on the lock instead of failing
+func getSqliteBusyTimeoutConnectionUrl(connectionUrl string) string {
+	if strings.Contains(connectionUrl, "busy_timeout") {
+		return connectionUrl
+	}
+	if strings.Contains(connectionUrl, "?") {
+		return fmt.Sprintf("%s&_pragma=busy_timeout(5000)", connectionUrl)
+	}
+	return fmt.Sprintf("%s?_pragma=busy_timeout(5000)", connectionUrl)
+}
@@ -240,6 +240,123 @@ func Test_buildMysqlUpdateQuery(t *testing.T) {
 	}
 }
 
+func Test_buildMssqlInsertQuery(t *testing.T) {
+	tests := []struct {
+		name     string
+		schema   string
+		table    string
+		columns  []string
+		expected string
+	}{
+		{"Single Column", "dbo", "users", []string{"name"}, "INSERT INTO [dbo].[users] ([name]) VALUES (@p1);"},
+		{"Multiple Columns", "dbo", "users", []string{"name", "email"}, "INSERT INTO [dbo].[users] ([name], [email]) VALUES (@p1, @p2);"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			actual := buildMssqlInsertQuery(tt.schema, tt.table, tt.columns)
+			assert.Equal(t, tt.expected, actual)
+		})
+	}
+}
+
+func Test_buildMssqlUpdateQuery(t *testing.T) {
+	tests := []struct {
+		name        string
+		schema      string
+		table       string
+		columns     []string
+		primaryKeys []string
+		expected    string
+	}{
+		{"Single Column", "dbo", "users", []string{"name"}, []string{"id"}, "UPDATE [dbo].[users] SET [name] = @p1 WHERE [id] = @p2;"},
+		{"Multiple Primary Keys", "dbo", "users", []string{"name", "email"}, []string{"id", "other"}, "UPDATE [dbo].[users] SET [name] = @p1, [email] = @p2 WHERE [id] = @p3 AND [other] = @p4;"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			actual := buildMssqlUpdateQuery(tt.schema, tt.table, tt.columns, tt.primaryKeys)
+			assert.Equal(t, tt.expected, actual)
+		})
+	}
+}
+
+func Test_buildSqliteInsertQuery(t *testing.T) {
+	tests := []struct {
+		name     string
+		schema   string
+		table    string
+		columns  []string
+		expected string
+	}{
+		{"Single Column", "public", "users", []string{"name"}, `INSERT INTO "users" ("name") VALUES (?);`},
+		{"Multiple Columns", "public", "users", []string{"name", "email"}, `INSERT INTO "users" ("name", "email") VALUES (?, ?);`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			actual := buildSqliteInsertQuery(tt.schema, tt.table, tt.columns)
+			assert.Equal(t, tt.expected, actual)
+		})
+	}
+}
+
+func Test_buildSqliteUpdateQuery(t *testing.T) {
+	tests := []struct {
+		name        string
+		schema      string
+		table       string
+		columns     []string
+		primaryKeys []string
+		expected    string
+	}{
+		{"Single Column", "public", "users", []string{"name"}, []string{"id"}, `UPDATE "users" SET "name" = ? WHERE "id" = ?;`},
+		{"Multiple Primary Keys", "public", "users", []string{"name", "email"}, []string{"id", "other"}, `UPDATE "users" SET "name" = ?, "email" = ? WHERE "id" = ? AND "other" = ?;`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			actual := buildSqliteUpdateQuery(tt.schema, tt.table, tt.columns, tt.primaryKeys)
+			assert.Equal(t, tt.expected, actual)
+		})
+	}
+}
+
+func Test_buildSqliteCreateTableStatements(t *testing.T) {
+	cfg := &schemaConfig{
+		Schemas: []*mgmtv1alpha1.DatabaseColumn{
+			{Schema: "public", Table: "users", Column: "id", DataType: "uuid", IsNullable: "NO"},
+			{Schema: "public", Table: "users", Column: "account_id", DataType: "bigint", IsNullable: "YES"},
+			{Schema: "public", Table: "accounts", Column: "id", DataType: "bigint", IsNullable: "NO"},
+		},
+		TableConstraints: map[string]*dbschemas_utils.TableConstraints{
+			"public.users": {Constraints: []*dbschemas_utils.ForeignConstraint{
+				{Column: "account_id", IsNullable: true, ForeignKey: &dbschemas_utils.ForeignKey{Table: "public.accounts", Column: "id"}},
+			}},
+		},
+		TablePrimaryKeys: map[string]*mgmtv1alpha1.PrimaryConstraint{
+			"public.users":    {Columns: []string{"id"}},
+			"public.accounts": {Columns: []string{"id"}},
+		},
+	}
+
+	actual, err := buildSqliteCreateTableStatements([]string{"public.accounts", "public.users"}, cfg)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{
+		`CREATE TABLE IF NOT EXISTS "accounts" ("id" INTEGER NOT NULL, PRIMARY KEY ("id"));`,
+		`CREATE TABLE IF NOT EXISTS "users" ("id" TEXT NOT NULL, "account_id" INTEGER, PRIMARY KEY ("id"), FOREIGN KEY ("account_id") REFERENCES "accounts" ("id"));`,
+	}, actual)
+
+	_, err = buildSqliteCreateTableStatements([]string{"public.users", "other.users"}, cfg)
+	assert.Error(t, err)
+}
+
+func Test_getSqliteBusyTimeoutConnectionUrl(t *testing.T) {
+	assert.Equal(t, "/tmp/fixtures.db?_pragma=busy_timeout(5000)", getSqliteBusyTimeoutConnectionUrl("/tmp/fixtures.db"))
+	assert.Equal(t, "file:/tmp/fixtures.db?mode=rwc&_pragma=busy_timeout(5000)", getSqliteBusyTimeoutConnectionUrl("file:/tmp/fixtures.db?mode=rwc"))
+	assert.Equal(t, "/tmp/fixtures.db?_pragma=busy_timeout(100)", getSqliteBusyTimeoutConnectionUrl("/tmp/fixtures.db?_pragma=busy_timeout(100)"))
+}
+
 func Test_buildSyncConfigs_postgres(t *testing.T) {
 	tests := []struct {
 		name   string
@@ -111,17 +111,63 @@ const sidebars: SidebarsConfig = {
             },
           ],
         },
+        {
+          type: 'doc',
+          id: 'cli/apply',
+          label: 'apply',
+        },
+        {
+          type: 'category',
+          label: 'audit',
+          collapsible: true,
+          collapsed: false,
+          items: [
+            {
+              type: 'doc',
+              id: 'cli/audit/list',
+              label: 'list',
+            },
+          ],
+        },
+        {
+          type: 'category',
+          label: 'connections',
+          collapsible: true,
+          collapsed: false,
+          items: [
+            {
+              type: 'doc',
+              id: 'cli/connections/scan',
+              label: 'scan',
+            },
+          ],
+        },
         {
           type: 'category',
           label: 'jobs',
           collapsible: true,
           collapsed: false,
           items: [
+            {
+              type: 'doc',
+              id: 'cli/jobs/drift',
+              label: 'drift',
+            },
+            {
+              type: 'doc',
+              id: 'cli/jobs/export',
+              label: 'export',
+            },
             {
               type: 'doc',
               id: 'cli/jobs/list',
               label: 'list',
             },
+            {
+              type: 'doc',
+              id: 'cli/jobs/preview',
+              label: 'preview',
+            },
             {
               type: 'doc',
               id: 'cli/jobs/trigger',
@@ -176,6 +222,16 @@ const sidebars: SidebarsConfig = {
       id: 'deploy/auth',
       label: 'Authentication',
     },
+    {
+      type: 'doc',
+      id: 'deploy/connection-encryption',
+      label: 'Connection Encryption',
+    },
+    {
+      type: 'doc',
+      id: 'deploy/secret-references',
+      label: 'Secret References',
+    },
 
     {
       type: 'html',
@@ -202,6 +258,26 @@ const sidebars: SidebarsConfig = {
       id: 'guides/using-neosync-in-ci',
       label: 'Using Neosync in CI',
     },
+    {
+      type: 'doc',
+      id: 'guides/job-run-webhooks',
+      label: 'Job Run Webhooks',
+    },
+    {
+      type: 'doc',
+      id: 'guides/job-triggers',
+      label: 'Job Triggers',
+    },
+    {
+      type: 'doc',
+      id: 'guides/job-versions',
+      label: 'Job Versions',
+    },
+    {
+      type: 'doc',
+      id: 'guides/schema-drift',
+      label: 'Schema Drift',
+    },
     {
       type: 'doc',
       id: 'guides/analytics',
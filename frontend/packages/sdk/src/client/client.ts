@@ -5,13 +5,16 @@ import {
   createPromiseClient,
 } from '@connectrpc/connect';
 
+import { AccountSpecService } from './mgmt/v1alpha1/account_spec_connect.js';
 import { ApiKeyService } from './mgmt/v1alpha1/api_key_connect.js';
+import { AuditService } from './mgmt/v1alpha1/audit_connect.js';
 import { ConnectionService } from './mgmt/v1alpha1/connection_connect.js';
 import { ConnectionDataService } from './mgmt/v1alpha1/connection_data_connect.js';
 import { JobService } from './mgmt/v1alpha1/job_connect.js';
 import { MetricsService } from './mgmt/v1alpha1/metrics_connect.js';
 import { TransformersService } from './mgmt/v1alpha1/transformer_connect.js';
 import { UserAccountService } from './mgmt/v1alpha1/user_account_connect.js';
+import { WebhookService } from './mgmt/v1alpha1/webhook_connect.js';
 
 export type NeosyncClient = NeosyncV1alpha1Client;
 export type ClientVersion = 'v1alpha1' | 'latest';
@@ -24,6 +27,9 @@ export interface NeosyncV1alpha1Client {
   apikeys: PromiseClient<typeof ApiKeyService>;
   connectiondata: PromiseClient<typeof ConnectionDataService>;
   metrics: PromiseClient<typeof MetricsService>;
+  audit: PromiseClient<typeof AuditService>;
+  webhooks: PromiseClient<typeof WebhookService>;
+  accountSpecs: PromiseClient<typeof AccountSpecService>;
 }
 
 /**
@@ -89,6 +95,9 @@ export function getNeosyncV1alpha1Client(
     apikeys: createPromiseClient(ApiKeyService, transport),
     connectiondata: createPromiseClient(ConnectionDataService, transport),
     metrics: createPromiseClient(MetricsService, transport),
+    audit: createPromiseClient(AuditService, transport),
+    webhooks: createPromiseClient(WebhookService, transport),
+    accountSpecs: createPromiseClient(AccountSpecService, transport),
   };
 }
 
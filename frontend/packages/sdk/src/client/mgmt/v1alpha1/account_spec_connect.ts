@@ -0,0 +1,31 @@
+// @generated by protoc-gen-connect-es v1.4.0 with parameter "target=ts,import_extension=.js"
+// @generated from file mgmt/v1alpha1/account_spec.proto (package mgmt.v1alpha1, syntax proto3)
+/* eslint-disable */
+// @ts-nocheck
+
+import { ApplyAccountSpecRequest, ApplyAccountSpecResponse } from "./account_spec_pb.js";
+import { MethodKind } from "@bufbuild/protobuf";
+
+/**
+ * Service that manages the resources of an account as a whole
+ *
+ * @generated from service mgmt.v1alpha1.AccountSpecService
+ */
+export const AccountSpecService = {
+  typeName: "mgmt.v1alpha1.AccountSpecService",
+  methods: {
+    /**
+     * Creates or updates the connections, transformers and jobs of an account so that they match the spec.
+     * The whole spec is planned before anything is changed, and resources that were created are removed again if a later change fails.
+     *
+     * @generated from rpc mgmt.v1alpha1.AccountSpecService.ApplyAccountSpec
+     */
+    applyAccountSpec: {
+      name: "ApplyAccountSpec",
+      I: ApplyAccountSpecRequest,
+      O: ApplyAccountSpecResponse,
+      kind: MethodKind.Unary,
+    },
+  }
+} as const;
+
@@ -0,0 +1,500 @@
+// @generated by protoc-gen-es v1.8.0 with parameter "target=ts,import_extension=.js"
+// @generated from file mgmt/v1alpha1/account_spec.proto (package mgmt.v1alpha1, syntax proto3)
+/* eslint-disable */
+// @ts-nocheck
+
+import type { BinaryReadOptions, FieldList, JsonReadOptions, JsonValue, PartialMessage, PlainMessage } from "@bufbuild/protobuf";
+import { Message, proto3 } from "@bufbuild/protobuf";
+import { ConnectionConfig } from "./connection_pb.js";
+import { TransformerConfig, TransformerSource } from "./transformer_pb.js";
+import { ActivityOptions, CreateJobDestination, JobMapping, JobSource, JobTrigger, WorkflowOptions } from "./job_pb.js";
+
+/**
+ * @generated from enum mgmt.v1alpha1.AccountSpecResourceType
+ */
+export enum AccountSpecResourceType {
+  /**
+   * @generated from enum value: ACCOUNT_SPEC_RESOURCE_TYPE_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * @generated from enum value: ACCOUNT_SPEC_RESOURCE_TYPE_CONNECTION = 1;
+   */
+  CONNECTION = 1,
+
+  /**
+   * @generated from enum value: ACCOUNT_SPEC_RESOURCE_TYPE_TRANSFORMER = 2;
+   */
+  TRANSFORMER = 2,
+
+  /**
+   * @generated from enum value: ACCOUNT_SPEC_RESOURCE_TYPE_JOB = 3;
+   */
+  JOB = 3,
+}
+// Retrieve enum metadata with: proto3.getEnumType(AccountSpecResourceType)
+proto3.util.setEnumType(AccountSpecResourceType, "mgmt.v1alpha1.AccountSpecResourceType", [
+  { no: 0, name: "ACCOUNT_SPEC_RESOURCE_TYPE_UNSPECIFIED" },
+  { no: 1, name: "ACCOUNT_SPEC_RESOURCE_TYPE_CONNECTION" },
+  { no: 2, name: "ACCOUNT_SPEC_RESOURCE_TYPE_TRANSFORMER" },
+  { no: 3, name: "ACCOUNT_SPEC_RESOURCE_TYPE_JOB" },
+]);
+
+/**
+ * @generated from enum mgmt.v1alpha1.AccountSpecChangeAction
+ */
+export enum AccountSpecChangeAction {
+  /**
+   * @generated from enum value: ACCOUNT_SPEC_CHANGE_ACTION_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * @generated from enum value: ACCOUNT_SPEC_CHANGE_ACTION_CREATE = 1;
+   */
+  CREATE = 1,
+
+  /**
+   * @generated from enum value: ACCOUNT_SPEC_CHANGE_ACTION_UPDATE = 2;
+   */
+  UPDATE = 2,
+
+  /**
+   * @generated from enum value: ACCOUNT_SPEC_CHANGE_ACTION_UNCHANGED = 3;
+   */
+  UNCHANGED = 3,
+}
+// Retrieve enum metadata with: proto3.getEnumType(AccountSpecChangeAction)
+proto3.util.setEnumType(AccountSpecChangeAction, "mgmt.v1alpha1.AccountSpecChangeAction", [
+  { no: 0, name: "ACCOUNT_SPEC_CHANGE_ACTION_UNSPECIFIED" },
+  { no: 1, name: "ACCOUNT_SPEC_CHANGE_ACTION_CREATE" },
+  { no: 2, name: "ACCOUNT_SPEC_CHANGE_ACTION_UPDATE" },
+  { no: 3, name: "ACCOUNT_SPEC_CHANGE_ACTION_UNCHANGED" },
+]);
+
+/**
+ * A declarative description of the connections, user defined transformers and jobs of an account that can be kept in source control.
+ * Resources are matched to the resources of an account by name when the spec is applied.
+ *
+ * @generated from message mgmt.v1alpha1.AccountSpec
+ */
+export class AccountSpec extends Message<AccountSpec> {
+  /**
+   * The version of the spec format
+   *
+   * @generated from field: string version = 1;
+   */
+  version = "";
+
+  /**
+   * @generated from field: repeated mgmt.v1alpha1.ConnectionSpec connections = 2;
+   */
+  connections: ConnectionSpec[] = [];
+
+  /**
+   * @generated from field: repeated mgmt.v1alpha1.TransformerSpec transformers = 3;
+   */
+  transformers: TransformerSpec[] = [];
+
+  /**
+   * @generated from field: repeated mgmt.v1alpha1.JobSpec jobs = 4;
+   */
+  jobs: JobSpec[] = [];
+
+  constructor(data?: PartialMessage<AccountSpec>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "mgmt.v1alpha1.AccountSpec";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "version", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "connections", kind: "message", T: ConnectionSpec, repeated: true },
+    { no: 3, name: "transformers", kind: "message", T: TransformerSpec, repeated: true },
+    { no: 4, name: "jobs", kind: "message", T: JobSpec, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): AccountSpec {
+    return new AccountSpec().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): AccountSpec {
+    return new AccountSpec().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): AccountSpec {
+    return new AccountSpec().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: AccountSpec | PlainMessage<AccountSpec> | undefined, b: AccountSpec | PlainMessage<AccountSpec> | undefined): boolean {
+    return proto3.util.equals(AccountSpec, a, b);
+  }
+}
+
+/**
+ * @generated from message mgmt.v1alpha1.ConnectionSpec
+ */
+export class ConnectionSpec extends Message<ConnectionSpec> {
+  /**
+   * Identifies the connection within the spec. Jobs refer to the connection by this id
+   *
+   * @generated from field: string id = 1;
+   */
+  id = "";
+
+  /**
+   * @generated from field: string name = 2;
+   */
+  name = "";
+
+  /**
+   * Secrets are left empty when a connection is exported. Empty secrets keep the values of the existing connection when the spec is applied.
+   * The connection is only referenced, and must already exist, if not provided
+   *
+   * @generated from field: optional mgmt.v1alpha1.ConnectionConfig connection_config = 3;
+   */
+  connectionConfig?: ConnectionConfig;
+
+  constructor(data?: PartialMessage<ConnectionSpec>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "mgmt.v1alpha1.ConnectionSpec";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "connection_config", kind: "message", T: ConnectionConfig, opt: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ConnectionSpec {
+    return new ConnectionSpec().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ConnectionSpec {
+    return new ConnectionSpec().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ConnectionSpec {
+    return new ConnectionSpec().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ConnectionSpec | PlainMessage<ConnectionSpec> | undefined, b: ConnectionSpec | PlainMessage<ConnectionSpec> | undefined): boolean {
+    return proto3.util.equals(ConnectionSpec, a, b);
+  }
+}
+
+/**
+ * @generated from message mgmt.v1alpha1.TransformerSpec
+ */
+export class TransformerSpec extends Message<TransformerSpec> {
+  /**
+   * Identifies the transformer within the spec. Job mappings refer to the transformer by this id
+   *
+   * @generated from field: string id = 1;
+   */
+  id = "";
+
+  /**
+   * @generated from field: string name = 2;
+   */
+  name = "";
+
+  /**
+   * @generated from field: string description = 3;
+   */
+  description = "";
+
+  /**
+   * The source of a transformer can not be changed after it has been created
+   *
+   * @generated from field: mgmt.v1alpha1.TransformerSource source = 4;
+   */
+  source = TransformerSource.UNSPECIFIED;
+
+  /**
+   * @generated from field: mgmt.v1alpha1.TransformerConfig config = 5;
+   */
+  config?: TransformerConfig;
+
+  constructor(data?: PartialMessage<TransformerSpec>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "mgmt.v1alpha1.TransformerSpec";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "description", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "source", kind: "enum", T: proto3.getEnumType(TransformerSource) },
+    { no: 5, name: "config", kind: "message", T: TransformerConfig },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): TransformerSpec {
+    return new TransformerSpec().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): TransformerSpec {
+    return new TransformerSpec().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): TransformerSpec {
+    return new TransformerSpec().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: TransformerSpec | PlainMessage<TransformerSpec> | undefined, b: TransformerSpec | PlainMessage<TransformerSpec> | undefined): boolean {
+    return proto3.util.equals(TransformerSpec, a, b);
+  }
+}
+
+/**
+ * @generated from message mgmt.v1alpha1.JobSpec
+ */
+export class JobSpec extends Message<JobSpec> {
+  /**
+   * Identifies the job within the spec. Job triggers refer to the job by this id
+   *
+   * @generated from field: string id = 1;
+   */
+  id = "";
+
+  /**
+   * @generated from field: string name = 2;
+   */
+  name = "";
+
+  /**
+   * The schedule of an existing job is left unchanged if not provided
+   *
+   * @generated from field: optional string cron_schedule = 3;
+   */
+  cronSchedule?: string;
+
+  /**
+   * @generated from field: mgmt.v1alpha1.JobSource source = 4;
+   */
+  source?: JobSource;
+
+  /**
+   * @generated from field: repeated mgmt.v1alpha1.CreateJobDestination destinations = 5;
+   */
+  destinations: CreateJobDestination[] = [];
+
+  /**
+   * @generated from field: repeated mgmt.v1alpha1.JobMapping mappings = 6;
+   */
+  mappings: JobMapping[] = [];
+
+  /**
+   * @generated from field: mgmt.v1alpha1.WorkflowOptions workflow_options = 7;
+   */
+  workflowOptions?: WorkflowOptions;
+
+  /**
+   * @generated from field: mgmt.v1alpha1.ActivityOptions sync_options = 8;
+   */
+  syncOptions?: ActivityOptions;
+
+  /**
+   * @generated from field: repeated mgmt.v1alpha1.JobTrigger triggers = 9;
+   */
+  triggers: JobTrigger[] = [];
+
+  constructor(data?: PartialMessage<JobSpec>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "mgmt.v1alpha1.JobSpec";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "cron_schedule", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+    { no: 4, name: "source", kind: "message", T: JobSource },
+    { no: 5, name: "destinations", kind: "message", T: CreateJobDestination, repeated: true },
+    { no: 6, name: "mappings", kind: "message", T: JobMapping, repeated: true },
+    { no: 7, name: "workflow_options", kind: "message", T: WorkflowOptions },
+    { no: 8, name: "sync_options", kind: "message", T: ActivityOptions },
+    { no: 9, name: "triggers", kind: "message", T: JobTrigger, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): JobSpec {
+    return new JobSpec().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): JobSpec {
+    return new JobSpec().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): JobSpec {
+    return new JobSpec().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: JobSpec | PlainMessage<JobSpec> | undefined, b: JobSpec | PlainMessage<JobSpec> | undefined): boolean {
+    return proto3.util.equals(JobSpec, a, b);
+  }
+}
+
+/**
+ * The change to a single resource of the account
+ *
+ * @generated from message mgmt.v1alpha1.AccountSpecChange
+ */
+export class AccountSpecChange extends Message<AccountSpecChange> {
+  /**
+   * @generated from field: mgmt.v1alpha1.AccountSpecResourceType resource_type = 1;
+   */
+  resourceType = AccountSpecResourceType.UNSPECIFIED;
+
+  /**
+   * @generated from field: string name = 2;
+   */
+  name = "";
+
+  /**
+   * @generated from field: mgmt.v1alpha1.AccountSpecChangeAction action = 3;
+   */
+  action = AccountSpecChangeAction.UNSPECIFIED;
+
+  /**
+   * The fields that differ from the existing resource. Only set for updates
+   *
+   * @generated from field: repeated string fields = 4;
+   */
+  fields: string[] = [];
+
+  /**
+   * The id of the resource in the account. Not set for resources that a dry run would create
+   *
+   * @generated from field: optional string id = 5;
+   */
+  id?: string;
+
+  constructor(data?: PartialMessage<AccountSpecChange>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "mgmt.v1alpha1.AccountSpecChange";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "resource_type", kind: "enum", T: proto3.getEnumType(AccountSpecResourceType) },
+    { no: 2, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "action", kind: "enum", T: proto3.getEnumType(AccountSpecChangeAction) },
+    { no: 4, name: "fields", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+    { no: 5, name: "id", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): AccountSpecChange {
+    return new AccountSpecChange().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): AccountSpecChange {
+    return new AccountSpecChange().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): AccountSpecChange {
+    return new AccountSpecChange().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: AccountSpecChange | PlainMessage<AccountSpecChange> | undefined, b: AccountSpecChange | PlainMessage<AccountSpecChange> | undefined): boolean {
+    return proto3.util.equals(AccountSpecChange, a, b);
+  }
+}
+
+/**
+ * @generated from message mgmt.v1alpha1.ApplyAccountSpecRequest
+ */
+export class ApplyAccountSpecRequest extends Message<ApplyAccountSpecRequest> {
+  /**
+   * @generated from field: string account_id = 1;
+   */
+  accountId = "";
+
+  /**
+   * @generated from field: mgmt.v1alpha1.AccountSpec spec = 2;
+   */
+  spec?: AccountSpec;
+
+  /**
+   * Only plan the changes without applying them
+   *
+   * @generated from field: bool dry_run = 3;
+   */
+  dryRun = false;
+
+  constructor(data?: PartialMessage<ApplyAccountSpecRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "mgmt.v1alpha1.ApplyAccountSpecRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "account_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "spec", kind: "message", T: AccountSpec },
+    { no: 3, name: "dry_run", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ApplyAccountSpecRequest {
+    return new ApplyAccountSpecRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ApplyAccountSpecRequest {
+    return new ApplyAccountSpecRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ApplyAccountSpecRequest {
+    return new ApplyAccountSpecRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ApplyAccountSpecRequest | PlainMessage<ApplyAccountSpecRequest> | undefined, b: ApplyAccountSpecRequest | PlainMessage<ApplyAccountSpecRequest> | undefined): boolean {
+    return proto3.util.equals(ApplyAccountSpecRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message mgmt.v1alpha1.ApplyAccountSpecResponse
+ */
+export class ApplyAccountSpecResponse extends Message<ApplyAccountSpecResponse> {
+  /**
+   * The changes in the order they are applied
+   *
+   * @generated from field: repeated mgmt.v1alpha1.AccountSpecChange changes = 1;
+   */
+  changes: AccountSpecChange[] = [];
+
+  constructor(data?: PartialMessage<ApplyAccountSpecResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "mgmt.v1alpha1.ApplyAccountSpecResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "changes", kind: "message", T: AccountSpecChange, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ApplyAccountSpecResponse {
+    return new ApplyAccountSpecResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ApplyAccountSpecResponse {
+    return new ApplyAccountSpecResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ApplyAccountSpecResponse {
+    return new ApplyAccountSpecResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ApplyAccountSpecResponse | PlainMessage<ApplyAccountSpecResponse> | undefined, b: ApplyAccountSpecResponse | PlainMessage<ApplyAccountSpecResponse> | undefined): boolean {
+    return proto3.util.equals(ApplyAccountSpecResponse, a, b);
+  }
+}
+
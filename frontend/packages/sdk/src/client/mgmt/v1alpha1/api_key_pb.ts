@@ -27,6 +27,13 @@ export class CreateAccountApiKeyRequest extends Message<CreateAccountApiKeyReque
    */
   expiresAt?: Timestamp;
 
+  /**
+   * Restricts what the API key can be used for. The key can call every procedure on every resource in the account if not provided
+   *
+   * @generated from field: mgmt.v1alpha1.AccountApiKeyScopes scopes = 4;
+   */
+  scopes?: AccountApiKeyScopes;
+
   constructor(data?: PartialMessage<CreateAccountApiKeyRequest>) {
     super();
     proto3.util.initPartial(data, this);
@@ -38,6 +45,7 @@ export class CreateAccountApiKeyRequest extends Message<CreateAccountApiKeyReque
     { no: 1, name: "account_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
     { no: 2, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
     { no: 3, name: "expires_at", kind: "message", T: Timestamp },
+    { no: 4, name: "scopes", kind: "message", T: AccountApiKeyScopes },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CreateAccountApiKeyRequest {
@@ -154,6 +162,20 @@ export class AccountApiKey extends Message<AccountApiKey> {
    */
   expiresAt?: Timestamp;
 
+  /**
+   * The restrictions on what the API key can be used for. Not set if the key is unrestricted
+   *
+   * @generated from field: mgmt.v1alpha1.AccountApiKeyScopes scopes = 11;
+   */
+  scopes?: AccountApiKeyScopes;
+
+  /**
+   * The last time the API key was used to authenticate a request. Not set if the key has never been used
+   *
+   * @generated from field: google.protobuf.Timestamp last_used_at = 12;
+   */
+  lastUsedAt?: Timestamp;
+
   constructor(data?: PartialMessage<AccountApiKey>) {
     super();
     proto3.util.initPartial(data, this);
@@ -172,6 +194,8 @@ export class AccountApiKey extends Message<AccountApiKey> {
     { no: 8, name: "key_value", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
     { no: 9, name: "user_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
     { no: 10, name: "expires_at", kind: "message", T: Timestamp },
+    { no: 11, name: "scopes", kind: "message", T: AccountApiKeyScopes },
+    { no: 12, name: "last_used_at", kind: "message", T: Timestamp },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): AccountApiKey {
@@ -191,6 +215,70 @@ export class AccountApiKey extends Message<AccountApiKey> {
   }
 }
 
+/**
+ * @generated from message mgmt.v1alpha1.AccountApiKeyScopes
+ */
+export class AccountApiKeyScopes extends Message<AccountApiKeyScopes> {
+  /**
+   * Only these procedures may be called, ex: /mgmt.v1alpha1.JobService/CreateJobRun. Every procedure may be called if empty
+   *
+   * @generated from field: repeated string allowed_procedures = 1;
+   */
+  allowedProcedures: string[] = [];
+
+  /**
+   * Job service procedures may only be called on these jobs. Procedures that do not act on one of these jobs are rejected. Not restricted if empty
+   * When job or connection ids are set, only the procedures of the services that act on them may be called
+   *
+   * @generated from field: repeated string allowed_job_ids = 2;
+   */
+  allowedJobIds: string[] = [];
+
+  /**
+   * Connection procedures may only be called on these connections. Procedures that do not act on one of these connections are rejected. Not restricted if empty
+   *
+   * @generated from field: repeated string allowed_connection_ids = 3;
+   */
+  allowedConnectionIds: string[] = [];
+
+  /**
+   * Only procedures that do not change the account may be called
+   *
+   * @generated from field: bool read_only = 4;
+   */
+  readOnly = false;
+
+  constructor(data?: PartialMessage<AccountApiKeyScopes>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "mgmt.v1alpha1.AccountApiKeyScopes";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "allowed_procedures", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+    { no: 2, name: "allowed_job_ids", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+    { no: 3, name: "allowed_connection_ids", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+    { no: 4, name: "read_only", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): AccountApiKeyScopes {
+    return new AccountApiKeyScopes().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): AccountApiKeyScopes {
+    return new AccountApiKeyScopes().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): AccountApiKeyScopes {
+    return new AccountApiKeyScopes().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: AccountApiKeyScopes | PlainMessage<AccountApiKeyScopes> | undefined, b: AccountApiKeyScopes | PlainMessage<AccountApiKeyScopes> | undefined): boolean {
+    return proto3.util.equals(AccountApiKeyScopes, a, b);
+  }
+}
+
 /**
  * @generated from message mgmt.v1alpha1.GetAccountApiKeysRequest
  */
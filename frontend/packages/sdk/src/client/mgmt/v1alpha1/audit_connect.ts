@@ -0,0 +1,26 @@
+// @generated by protoc-gen-connect-es v1.4.0 with parameter "target=ts,import_extension=.js"
+// @generated from file mgmt/v1alpha1/audit.proto (package mgmt.v1alpha1, syntax proto3)
+/* eslint-disable */
+// @ts-nocheck
+
+import { GetAuditEventsRequest, GetAuditEventsResponse } from "./audit_pb.js";
+import { MethodKind } from "@bufbuild/protobuf";
+
+/**
+ * @generated from service mgmt.v1alpha1.AuditService
+ */
+export const AuditService = {
+  typeName: "mgmt.v1alpha1.AuditService",
+  methods: {
+    /**
+     * @generated from rpc mgmt.v1alpha1.AuditService.GetAuditEvents
+     */
+    getAuditEvents: {
+      name: "GetAuditEvents",
+      I: GetAuditEventsRequest,
+      O: GetAuditEventsResponse,
+      kind: MethodKind.Unary,
+    },
+  }
+} as const;
+
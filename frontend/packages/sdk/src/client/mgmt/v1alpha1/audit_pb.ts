@@ -0,0 +1,320 @@
+// @generated by protoc-gen-es v1.8.0 with parameter "target=ts,import_extension=.js"
+// @generated from file mgmt/v1alpha1/audit.proto (package mgmt.v1alpha1, syntax proto3)
+/* eslint-disable */
+// @ts-nocheck
+
+import type { BinaryReadOptions, FieldList, JsonReadOptions, JsonValue, PartialMessage, PlainMessage } from "@bufbuild/protobuf";
+import { Message, proto3, Timestamp } from "@bufbuild/protobuf";
+
+/**
+ * @generated from enum mgmt.v1alpha1.AuditActorType
+ */
+export enum AuditActorType {
+  /**
+   * @generated from enum value: AUDIT_ACTOR_TYPE_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * A user that signed in to Neosync
+   *
+   * @generated from enum value: AUDIT_ACTOR_TYPE_USER = 1;
+   */
+  USER = 1,
+
+  /**
+   * An account api key
+   *
+   * @generated from enum value: AUDIT_ACTOR_TYPE_API_KEY = 2;
+   */
+  API_KEY = 2,
+
+  /**
+   * The Neosync worker while running a job
+   *
+   * @generated from enum value: AUDIT_ACTOR_TYPE_WORKER = 3;
+   */
+  WORKER = 3,
+}
+// Retrieve enum metadata with: proto3.getEnumType(AuditActorType)
+proto3.util.setEnumType(AuditActorType, "mgmt.v1alpha1.AuditActorType", [
+  { no: 0, name: "AUDIT_ACTOR_TYPE_UNSPECIFIED" },
+  { no: 1, name: "AUDIT_ACTOR_TYPE_USER" },
+  { no: 2, name: "AUDIT_ACTOR_TYPE_API_KEY" },
+  { no: 3, name: "AUDIT_ACTOR_TYPE_WORKER" },
+]);
+
+/**
+ * @generated from message mgmt.v1alpha1.AuditActor
+ */
+export class AuditActor extends Message<AuditActor> {
+  /**
+   * @generated from field: mgmt.v1alpha1.AuditActorType type = 1;
+   */
+  type = AuditActorType.UNSPECIFIED;
+
+  /**
+   * The id of the user or api key. Not set for the worker
+   *
+   * @generated from field: optional string id = 2;
+   */
+  id?: string;
+
+  constructor(data?: PartialMessage<AuditActor>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "mgmt.v1alpha1.AuditActor";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "type", kind: "enum", T: proto3.getEnumType(AuditActorType) },
+    { no: 2, name: "id", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): AuditActor {
+    return new AuditActor().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): AuditActor {
+    return new AuditActor().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): AuditActor {
+    return new AuditActor().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: AuditActor | PlainMessage<AuditActor> | undefined, b: AuditActor | PlainMessage<AuditActor> | undefined): boolean {
+    return proto3.util.equals(AuditActor, a, b);
+  }
+}
+
+/**
+ * A record of a single call to a procedure that changes the state of an account. Audit events can not be changed or removed
+ *
+ * @generated from message mgmt.v1alpha1.AuditEvent
+ */
+export class AuditEvent extends Message<AuditEvent> {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id = "";
+
+  /**
+   * @generated from field: string account_id = 2;
+   */
+  accountId = "";
+
+  /**
+   * @generated from field: mgmt.v1alpha1.AuditActor actor = 3;
+   */
+  actor?: AuditActor;
+
+  /**
+   * The full name of the procedure that was called, ex: /mgmt.v1alpha1.JobService/UpdateJobSourceConnection
+   *
+   * @generated from field: string procedure = 4;
+   */
+  procedure = "";
+
+  /**
+   * The id of the resource that the procedure acted on, if it could be determined
+   *
+   * @generated from field: optional string resource_id = 5;
+   */
+  resourceId?: string;
+
+  /**
+   * JSON of the fields that were set on the request. Sensitive values such as passwords, keys and connection urls are redacted
+   *
+   * @generated from field: string request_diff = 6;
+   */
+  requestDiff = "";
+
+  /**
+   * The code that the procedure returned, ex: ok or permission_denied
+   *
+   * @generated from field: string status_code = 7;
+   */
+  statusCode = "";
+
+  /**
+   * @generated from field: google.protobuf.Timestamp created_at = 8;
+   */
+  createdAt?: Timestamp;
+
+  constructor(data?: PartialMessage<AuditEvent>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "mgmt.v1alpha1.AuditEvent";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "account_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "actor", kind: "message", T: AuditActor },
+    { no: 4, name: "procedure", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 5, name: "resource_id", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+    { no: 6, name: "request_diff", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 7, name: "status_code", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 8, name: "created_at", kind: "message", T: Timestamp },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): AuditEvent {
+    return new AuditEvent().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): AuditEvent {
+    return new AuditEvent().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): AuditEvent {
+    return new AuditEvent().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: AuditEvent | PlainMessage<AuditEvent> | undefined, b: AuditEvent | PlainMessage<AuditEvent> | undefined): boolean {
+    return proto3.util.equals(AuditEvent, a, b);
+  }
+}
+
+/**
+ * @generated from message mgmt.v1alpha1.GetAuditEventsRequest
+ */
+export class GetAuditEventsRequest extends Message<GetAuditEventsRequest> {
+  /**
+   * @generated from field: string account_id = 1;
+   */
+  accountId = "";
+
+  /**
+   * Only return the events for this resource
+   *
+   * @generated from field: optional string resource_id = 2;
+   */
+  resourceId?: string;
+
+  /**
+   * Only return the events for this procedure, ex: /mgmt.v1alpha1.ConnectionService/UpdateConnection
+   *
+   * @generated from field: optional string procedure = 3;
+   */
+  procedure?: string;
+
+  /**
+   * Only return the events made by this user or api key
+   *
+   * @generated from field: optional string actor_id = 4;
+   */
+  actorId?: string;
+
+  /**
+   * Only return the events created at or after this time
+   *
+   * @generated from field: optional google.protobuf.Timestamp start_time = 5;
+   */
+  startTime?: Timestamp;
+
+  /**
+   * Only return the events created before this time
+   *
+   * @generated from field: optional google.protobuf.Timestamp end_time = 6;
+   */
+  endTime?: Timestamp;
+
+  /**
+   * The maximum number of events to return. Defaults to 100
+   *
+   * @generated from field: optional uint32 page_size = 7;
+   */
+  pageSize?: number;
+
+  /**
+   * The next_page_token returned by a previous call to continue listing events from where it left off
+   *
+   * @generated from field: optional string page_token = 8;
+   */
+  pageToken?: string;
+
+  constructor(data?: PartialMessage<GetAuditEventsRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "mgmt.v1alpha1.GetAuditEventsRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "account_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "resource_id", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+    { no: 3, name: "procedure", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+    { no: 4, name: "actor_id", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+    { no: 5, name: "start_time", kind: "message", T: Timestamp, opt: true },
+    { no: 6, name: "end_time", kind: "message", T: Timestamp, opt: true },
+    { no: 7, name: "page_size", kind: "scalar", T: 13 /* ScalarType.UINT32 */, opt: true },
+    { no: 8, name: "page_token", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetAuditEventsRequest {
+    return new GetAuditEventsRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetAuditEventsRequest {
+    return new GetAuditEventsRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetAuditEventsRequest {
+    return new GetAuditEventsRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GetAuditEventsRequest | PlainMessage<GetAuditEventsRequest> | undefined, b: GetAuditEventsRequest | PlainMessage<GetAuditEventsRequest> | undefined): boolean {
+    return proto3.util.equals(GetAuditEventsRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message mgmt.v1alpha1.GetAuditEventsResponse
+ */
+export class GetAuditEventsResponse extends Message<GetAuditEventsResponse> {
+  /**
+   * The events, most recent first
+   *
+   * @generated from field: repeated mgmt.v1alpha1.AuditEvent events = 1;
+   */
+  events: AuditEvent[] = [];
+
+  /**
+   * Set if there are more events to return
+   *
+   * @generated from field: optional string next_page_token = 2;
+   */
+  nextPageToken?: string;
+
+  constructor(data?: PartialMessage<GetAuditEventsResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "mgmt.v1alpha1.GetAuditEventsResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "events", kind: "message", T: AuditEvent, repeated: true },
+    { no: 2, name: "next_page_token", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetAuditEventsResponse {
+    return new GetAuditEventsResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetAuditEventsResponse {
+    return new GetAuditEventsResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetAuditEventsResponse {
+    return new GetAuditEventsResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GetAuditEventsResponse | PlainMessage<GetAuditEventsResponse> | undefined, b: GetAuditEventsResponse | PlainMessage<GetAuditEventsResponse> | undefined): boolean {
+    return proto3.util.equals(GetAuditEventsResponse, a, b);
+  }
+}
+
@@ -3,7 +3,7 @@
 /* eslint-disable */
 // @ts-nocheck
 
-import { GetConnectionDataStreamRequest, GetConnectionDataStreamResponse, GetConnectionForeignConstraintsRequest, GetConnectionForeignConstraintsResponse, GetConnectionInitStatementsRequest, GetConnectionInitStatementsResponse, GetConnectionPrimaryConstraintsRequest, GetConnectionPrimaryConstraintsResponse, GetConnectionSchemaRequest, GetConnectionSchemaResponse, GetConnectionUniqueConstraintsRequest, GetConnectionUniqueConstraintsResponse } from "./connection_data_pb.js";
+import { GetConnectionDataStreamRequest, GetConnectionDataStreamResponse, GetConnectionForeignConstraintsRequest, GetConnectionForeignConstraintsResponse, GetConnectionInitStatementsRequest, GetConnectionInitStatementsResponse, GetConnectionPrimaryConstraintsRequest, GetConnectionPrimaryConstraintsResponse, GetConnectionSchemaRequest, GetConnectionSchemaResponse, GetConnectionUniqueConstraintsRequest, GetConnectionUniqueConstraintsResponse, ScanConnectionPiiRequest, ScanConnectionPiiResponse } from "./connection_data_pb.js";
 import { MethodKind } from "@bufbuild/protobuf";
 
 /**
@@ -85,6 +85,18 @@ export const ConnectionDataService = {
       O: GetConnectionUniqueConstraintsResponse,
       kind: MethodKind.Unary,
     },
+    /**
+     * Samples rows from every table in the connection and classifies which columns contain PII.
+     * Returns a suggested job mapping for each of the classified columns.
+     *
+     * @generated from rpc mgmt.v1alpha1.ConnectionDataService.ScanConnectionPii
+     */
+    scanConnectionPii: {
+      name: "ScanConnectionPii",
+      I: ScanConnectionPiiRequest,
+      O: ScanConnectionPiiResponse,
+      kind: MethodKind.Unary,
+    },
   }
 } as const;
 
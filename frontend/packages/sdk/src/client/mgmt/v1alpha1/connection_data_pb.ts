@@ -5,6 +5,75 @@
 
 import type { BinaryReadOptions, FieldList, JsonReadOptions, JsonValue, PartialMessage, PlainMessage } from "@bufbuild/protobuf";
 import { Message, proto3 } from "@bufbuild/protobuf";
+import { JobMapping } from "./job_pb.js";
+
+/**
+ * @generated from enum mgmt.v1alpha1.PiiCategory
+ */
+export enum PiiCategory {
+  /**
+   * @generated from enum value: PII_CATEGORY_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * @generated from enum value: PII_CATEGORY_EMAIL = 1;
+   */
+  EMAIL = 1,
+
+  /**
+   * @generated from enum value: PII_CATEGORY_PHONE_NUMBER = 2;
+   */
+  PHONE_NUMBER = 2,
+
+  /**
+   * @generated from enum value: PII_CATEGORY_FIRST_NAME = 3;
+   */
+  FIRST_NAME = 3,
+
+  /**
+   * @generated from enum value: PII_CATEGORY_LAST_NAME = 4;
+   */
+  LAST_NAME = 4,
+
+  /**
+   * @generated from enum value: PII_CATEGORY_FULL_NAME = 5;
+   */
+  FULL_NAME = 5,
+
+  /**
+   * @generated from enum value: PII_CATEGORY_SSN = 6;
+   */
+  SSN = 6,
+
+  /**
+   * @generated from enum value: PII_CATEGORY_ADDRESS = 7;
+   */
+  ADDRESS = 7,
+
+  /**
+   * @generated from enum value: PII_CATEGORY_CARD_NUMBER = 8;
+   */
+  CARD_NUMBER = 8,
+
+  /**
+   * @generated from enum value: PII_CATEGORY_IP_ADDRESS = 9;
+   */
+  IP_ADDRESS = 9,
+}
+// Retrieve enum metadata with: proto3.getEnumType(PiiCategory)
+proto3.util.setEnumType(PiiCategory, "mgmt.v1alpha1.PiiCategory", [
+  { no: 0, name: "PII_CATEGORY_UNSPECIFIED" },
+  { no: 1, name: "PII_CATEGORY_EMAIL" },
+  { no: 2, name: "PII_CATEGORY_PHONE_NUMBER" },
+  { no: 3, name: "PII_CATEGORY_FIRST_NAME" },
+  { no: 4, name: "PII_CATEGORY_LAST_NAME" },
+  { no: 5, name: "PII_CATEGORY_FULL_NAME" },
+  { no: 6, name: "PII_CATEGORY_SSN" },
+  { no: 7, name: "PII_CATEGORY_ADDRESS" },
+  { no: 8, name: "PII_CATEGORY_CARD_NUMBER" },
+  { no: 9, name: "PII_CATEGORY_IP_ADDRESS" },
+]);
 
 /**
  * @generated from message mgmt.v1alpha1.PostgresStreamConfig
@@ -68,6 +137,37 @@ export class MysqlStreamConfig extends Message<MysqlStreamConfig> {
   }
 }
 
+/**
+ * @generated from message mgmt.v1alpha1.MssqlStreamConfig
+ */
+export class MssqlStreamConfig extends Message<MssqlStreamConfig> {
+  constructor(data?: PartialMessage<MssqlStreamConfig>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "mgmt.v1alpha1.MssqlStreamConfig";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): MssqlStreamConfig {
+    return new MssqlStreamConfig().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): MssqlStreamConfig {
+    return new MssqlStreamConfig().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): MssqlStreamConfig {
+    return new MssqlStreamConfig().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: MssqlStreamConfig | PlainMessage<MssqlStreamConfig> | undefined, b: MssqlStreamConfig | PlainMessage<MssqlStreamConfig> | undefined): boolean {
+    return proto3.util.equals(MssqlStreamConfig, a, b);
+  }
+}
+
 /**
  * @generated from message mgmt.v1alpha1.AwsS3StreamConfig
  */
@@ -143,6 +243,12 @@ export class ConnectionStreamConfig extends Message<ConnectionStreamConfig> {
      */
     value: MysqlStreamConfig;
     case: "mysqlConfig";
+  } | {
+    /**
+     * @generated from field: mgmt.v1alpha1.MssqlStreamConfig mssql_config = 4;
+     */
+    value: MssqlStreamConfig;
+    case: "mssqlConfig";
   } | { case: undefined; value?: undefined } = { case: undefined };
 
   constructor(data?: PartialMessage<ConnectionStreamConfig>) {
@@ -156,6 +262,7 @@ export class ConnectionStreamConfig extends Message<ConnectionStreamConfig> {
     { no: 1, name: "pg_config", kind: "message", T: PostgresStreamConfig, oneof: "config" },
     { no: 2, name: "aws_s3_config", kind: "message", T: AwsS3StreamConfig, oneof: "config" },
     { no: 3, name: "mysql_config", kind: "message", T: MysqlStreamConfig, oneof: "config" },
+    { no: 4, name: "mssql_config", kind: "message", T: MssqlStreamConfig, oneof: "config" },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ConnectionStreamConfig {
@@ -333,6 +440,68 @@ export class MysqlSchemaConfig extends Message<MysqlSchemaConfig> {
   }
 }
 
+/**
+ * @generated from message mgmt.v1alpha1.MssqlSchemaConfig
+ */
+export class MssqlSchemaConfig extends Message<MssqlSchemaConfig> {
+  constructor(data?: PartialMessage<MssqlSchemaConfig>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "mgmt.v1alpha1.MssqlSchemaConfig";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): MssqlSchemaConfig {
+    return new MssqlSchemaConfig().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): MssqlSchemaConfig {
+    return new MssqlSchemaConfig().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): MssqlSchemaConfig {
+    return new MssqlSchemaConfig().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: MssqlSchemaConfig | PlainMessage<MssqlSchemaConfig> | undefined, b: MssqlSchemaConfig | PlainMessage<MssqlSchemaConfig> | undefined): boolean {
+    return proto3.util.equals(MssqlSchemaConfig, a, b);
+  }
+}
+
+/**
+ * @generated from message mgmt.v1alpha1.SqliteSchemaConfig
+ */
+export class SqliteSchemaConfig extends Message<SqliteSchemaConfig> {
+  constructor(data?: PartialMessage<SqliteSchemaConfig>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "mgmt.v1alpha1.SqliteSchemaConfig";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SqliteSchemaConfig {
+    return new SqliteSchemaConfig().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SqliteSchemaConfig {
+    return new SqliteSchemaConfig().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SqliteSchemaConfig {
+    return new SqliteSchemaConfig().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: SqliteSchemaConfig | PlainMessage<SqliteSchemaConfig> | undefined, b: SqliteSchemaConfig | PlainMessage<SqliteSchemaConfig> | undefined): boolean {
+    return proto3.util.equals(SqliteSchemaConfig, a, b);
+  }
+}
+
 /**
  * @generated from message mgmt.v1alpha1.AwsS3SchemaConfig
  */
@@ -408,6 +577,18 @@ export class ConnectionSchemaConfig extends Message<ConnectionSchemaConfig> {
      */
     value: MysqlSchemaConfig;
     case: "mysqlConfig";
+  } | {
+    /**
+     * @generated from field: mgmt.v1alpha1.MssqlSchemaConfig mssql_config = 4;
+     */
+    value: MssqlSchemaConfig;
+    case: "mssqlConfig";
+  } | {
+    /**
+     * @generated from field: mgmt.v1alpha1.SqliteSchemaConfig sqlite_config = 5;
+     */
+    value: SqliteSchemaConfig;
+    case: "sqliteConfig";
   } | { case: undefined; value?: undefined } = { case: undefined };
 
   constructor(data?: PartialMessage<ConnectionSchemaConfig>) {
@@ -421,6 +602,8 @@ export class ConnectionSchemaConfig extends Message<ConnectionSchemaConfig> {
     { no: 1, name: "pg_config", kind: "message", T: PostgresSchemaConfig, oneof: "config" },
     { no: 2, name: "aws_s3_config", kind: "message", T: AwsS3SchemaConfig, oneof: "config" },
     { no: 3, name: "mysql_config", kind: "message", T: MysqlSchemaConfig, oneof: "config" },
+    { no: 4, name: "mssql_config", kind: "message", T: MssqlSchemaConfig, oneof: "config" },
+    { no: 5, name: "sqlite_config", kind: "message", T: SqliteSchemaConfig, oneof: "config" },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ConnectionSchemaConfig {
@@ -1169,3 +1352,206 @@ export class UniqueConstraint extends Message<UniqueConstraint> {
   }
 }
 
+/**
+ * @generated from message mgmt.v1alpha1.ScanConnectionPiiRequest
+ */
+export class ScanConnectionPiiRequest extends Message<ScanConnectionPiiRequest> {
+  /**
+   * @generated from field: string connection_id = 1;
+   */
+  connectionId = "";
+
+  /**
+   * Required for AWS S3 connections to determine which job run to sample
+   *
+   * @generated from field: mgmt.v1alpha1.ConnectionStreamConfig stream_config = 2;
+   */
+  streamConfig?: ConnectionStreamConfig;
+
+  /**
+   * Only scans tables in the provided schemas. Scans every schema if empty
+   *
+   * @generated from field: repeated string schemas = 3;
+   */
+  schemas: string[] = [];
+
+  /**
+   * The number of rows to sample from each table. Defaults to 100
+   *
+   * @generated from field: optional uint32 sample_size = 4;
+   */
+  sampleSize?: number;
+
+  /**
+   * Columns classified with a lower confidence are left out of the report. Defaults to 0.5
+   *
+   * @generated from field: optional double min_confidence = 5;
+   */
+  minConfidence?: number;
+
+  constructor(data?: PartialMessage<ScanConnectionPiiRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "mgmt.v1alpha1.ScanConnectionPiiRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "connection_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "stream_config", kind: "message", T: ConnectionStreamConfig },
+    { no: 3, name: "schemas", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+    { no: 4, name: "sample_size", kind: "scalar", T: 13 /* ScalarType.UINT32 */, opt: true },
+    { no: 5, name: "min_confidence", kind: "scalar", T: 1 /* ScalarType.DOUBLE */, opt: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ScanConnectionPiiRequest {
+    return new ScanConnectionPiiRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ScanConnectionPiiRequest {
+    return new ScanConnectionPiiRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ScanConnectionPiiRequest {
+    return new ScanConnectionPiiRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ScanConnectionPiiRequest | PlainMessage<ScanConnectionPiiRequest> | undefined, b: ScanConnectionPiiRequest | PlainMessage<ScanConnectionPiiRequest> | undefined): boolean {
+    return proto3.util.equals(ScanConnectionPiiRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message mgmt.v1alpha1.ScanConnectionPiiResponse
+ */
+export class ScanConnectionPiiResponse extends Message<ScanConnectionPiiResponse> {
+  /**
+   * The columns that were classified as containing PII
+   *
+   * @generated from field: repeated mgmt.v1alpha1.PiiColumnReport columns = 1;
+   */
+  columns: PiiColumnReport[] = [];
+
+  constructor(data?: PartialMessage<ScanConnectionPiiResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "mgmt.v1alpha1.ScanConnectionPiiResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "columns", kind: "message", T: PiiColumnReport, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ScanConnectionPiiResponse {
+    return new ScanConnectionPiiResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ScanConnectionPiiResponse {
+    return new ScanConnectionPiiResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ScanConnectionPiiResponse {
+    return new ScanConnectionPiiResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ScanConnectionPiiResponse | PlainMessage<ScanConnectionPiiResponse> | undefined, b: ScanConnectionPiiResponse | PlainMessage<ScanConnectionPiiResponse> | undefined): boolean {
+    return proto3.util.equals(ScanConnectionPiiResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message mgmt.v1alpha1.PiiColumnReport
+ */
+export class PiiColumnReport extends Message<PiiColumnReport> {
+  /**
+   * @generated from field: string schema = 1;
+   */
+  schema = "";
+
+  /**
+   * @generated from field: string table = 2;
+   */
+  table = "";
+
+  /**
+   * @generated from field: string column = 3;
+   */
+  column = "";
+
+  /**
+   * The datatype of the column
+   *
+   * @generated from field: string data_type = 4;
+   */
+  dataType = "";
+
+  /**
+   * @generated from field: mgmt.v1alpha1.PiiCategory category = 5;
+   */
+  category = PiiCategory.UNSPECIFIED;
+
+  /**
+   * A score between 0 and 1 of how likely it is that the column contains the category of PII
+   *
+   * @generated from field: double confidence = 6;
+   */
+  confidence = 0;
+
+  /**
+   * The number of non-empty values that were sampled from the column
+   *
+   * @generated from field: uint32 sampled_values = 7;
+   */
+  sampledValues = 0;
+
+  /**
+   * The number of sampled values that matched the category
+   *
+   * @generated from field: uint32 matched_values = 8;
+   */
+  matchedValues = 0;
+
+  /**
+   * A job mapping with a transformer that is suggested for the category
+   *
+   * @generated from field: mgmt.v1alpha1.JobMapping suggested_mapping = 9;
+   */
+  suggestedMapping?: JobMapping;
+
+  constructor(data?: PartialMessage<PiiColumnReport>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "mgmt.v1alpha1.PiiColumnReport";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "schema", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "table", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "column", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "data_type", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 5, name: "category", kind: "enum", T: proto3.getEnumType(PiiCategory) },
+    { no: 6, name: "confidence", kind: "scalar", T: 1 /* ScalarType.DOUBLE */ },
+    { no: 7, name: "sampled_values", kind: "scalar", T: 13 /* ScalarType.UINT32 */ },
+    { no: 8, name: "matched_values", kind: "scalar", T: 13 /* ScalarType.UINT32 */ },
+    { no: 9, name: "suggested_mapping", kind: "message", T: JobMapping },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): PiiColumnReport {
+    return new PiiColumnReport().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): PiiColumnReport {
+    return new PiiColumnReport().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): PiiColumnReport {
+    return new PiiColumnReport().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: PiiColumnReport | PlainMessage<PiiColumnReport> | undefined, b: PiiColumnReport | PlainMessage<PiiColumnReport> | undefined): boolean {
+    return proto3.util.equals(PiiColumnReport, a, b);
+  }
+}
+
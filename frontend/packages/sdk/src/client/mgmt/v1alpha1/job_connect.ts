@@ -3,7 +3,7 @@
 /* eslint-disable */
 // @ts-nocheck
 
-import { CancelJobRunRequest, CancelJobRunResponse, CreateJobDestinationConnectionsRequest, CreateJobDestinationConnectionsResponse, CreateJobRequest, CreateJobResponse, CreateJobRunRequest, CreateJobRunResponse, DeleteJobDestinationConnectionRequest, DeleteJobDestinationConnectionResponse, DeleteJobRequest, DeleteJobResponse, DeleteJobRunRequest, DeleteJobRunResponse, GetJobNextRunsRequest, GetJobNextRunsResponse, GetJobRecentRunsRequest, GetJobRecentRunsResponse, GetJobRequest, GetJobResponse, GetJobRunEventsRequest, GetJobRunEventsResponse, GetJobRunLogsStreamRequest, GetJobRunLogsStreamResponse, GetJobRunRequest, GetJobRunResponse, GetJobRunsRequest, GetJobRunsResponse, GetJobsRequest, GetJobsResponse, GetJobStatusesRequest, GetJobStatusesResponse, GetJobStatusRequest, GetJobStatusResponse, IsJobNameAvailableRequest, IsJobNameAvailableResponse, PauseJobRequest, PauseJobResponse, SetJobSourceSqlConnectionSubsetsRequest, SetJobSourceSqlConnectionSubsetsResponse, SetJobSyncOptionsRequest, SetJobSyncOptionsResponse, SetJobWorkflowOptionsRequest, SetJobWorkflowOptionsResponse, TerminateJobRunRequest, TerminateJobRunResponse, UpdateJobDestinationConnectionRequest, UpdateJobDestinationConnectionResponse, UpdateJobScheduleRequest, UpdateJobScheduleResponse, UpdateJobSourceConnectionRequest, UpdateJobSourceConnectionResponse } from "./job_pb.js";
+import { ApplyJobRunSchemaDriftReportRequest, ApplyJobRunSchemaDriftReportResponse, CancelJobRunRequest, CancelJobRunResponse, CreateJobDestinationConnectionsRequest, CreateJobDestinationConnectionsResponse, CreateJobRequest, CreateJobResponse, CreateJobRunRequest, CreateJobRunResponse, DeleteJobDestinationConnectionRequest, DeleteJobDestinationConnectionResponse, DeleteJobRequest, DeleteJobResponse, DeleteJobRunRequest, DeleteJobRunResponse, GetJobNextRunsRequest, GetJobNextRunsResponse, GetJobRecentRunsRequest, GetJobRecentRunsResponse, GetJobRequest, GetJobResponse, GetJobRunEventsRequest, GetJobRunEventsResponse, GetJobRunLogsStreamRequest, GetJobRunLogsStreamResponse, GetJobRunRequest, GetJobRunResponse, GetJobRunSchemaDriftReportRequest, GetJobRunSchemaDriftReportResponse, GetJobRunsRequest, GetJobRunsResponse, GetJobRunValidationReportRequest, GetJobRunValidationReportResponse, GetJobsRequest, GetJobsResponse, GetJobStatusesRequest, GetJobStatusesResponse, GetJobStatusRequest, GetJobStatusResponse, GetJobVersionDiffRequest, GetJobVersionDiffResponse, GetJobVersionsRequest, GetJobVersionsResponse, GetJobWatermarksRequest, GetJobWatermarksResponse, IsJobNameAvailableRequest, IsJobNameAvailableResponse, PauseJobRequest, PauseJobResponse, PreviewJobMappingsRequest, PreviewJobMappingsResponse, ResetJobWatermarksRequest, ResetJobWatermarksResponse, RollbackJobToVersionRequest, RollbackJobToVersionResponse, SetJobRunSchemaDriftReportRequest, SetJobRunSchemaDriftReportResponse, SetJobRunValidationReportRequest, SetJobRunValidationReportResponse, SetJobRunWatermarksRequest, SetJobRunWatermarksResponse, SetJobSourceSqlConnectionSubsetsRequest, SetJobSourceSqlConnectionSubsetsResponse, SetJobSyncOptionsRequest, SetJobSyncOptionsResponse, SetJobTriggersRequest, SetJobTriggersResponse, SetJobWorkflowOptionsRequest, SetJobWorkflowOptionsResponse, TerminateJobRunRequest, TerminateJobRunResponse, TriggerDependentJobsRequest, TriggerDependentJobsResponse, UpdateJobDestinationConnectionRequest, UpdateJobDestinationConnectionResponse, UpdateJobScheduleRequest, UpdateJobScheduleResponse, UpdateJobSourceConnectionRequest, UpdateJobSourceConnectionResponse } from "./job_pb.js";
 import { MethodKind } from "@bufbuild/protobuf";
 
 /**
@@ -260,6 +260,160 @@ export const JobService = {
       O: SetJobSyncOptionsResponse,
       kind: MethodKind.Unary,
     },
+    /**
+     * Set the jobs whose runs start a run of this job. Must provide every trigger as it will fully override the previous configuration
+     *
+     * @generated from rpc mgmt.v1alpha1.JobService.SetJobTriggers
+     */
+    setJobTriggers: {
+      name: "SetJobTriggers",
+      I: SetJobTriggersRequest,
+      O: SetJobTriggersResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * Starts a run of every job with a trigger that matches the outcome of the upstream job run. Called by the worker when a job run completes
+     *
+     * @generated from rpc mgmt.v1alpha1.JobService.TriggerDependentJobs
+     */
+    triggerDependentJobs: {
+      name: "TriggerDependentJobs",
+      I: TriggerDependentJobsRequest,
+      O: TriggerDependentJobsResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * Returns the current high-water marks for each table in the job that is configured for incremental sync
+     *
+     * @generated from rpc mgmt.v1alpha1.JobService.GetJobWatermarks
+     */
+    getJobWatermarks: {
+      name: "GetJobWatermarks",
+      I: GetJobWatermarksRequest,
+      O: GetJobWatermarksResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * Records the watermarks that were synced by a successful job run and advances the job's high-water marks
+     *
+     * @generated from rpc mgmt.v1alpha1.JobService.SetJobRunWatermarks
+     */
+    setJobRunWatermarks: {
+      name: "SetJobRunWatermarks",
+      I: SetJobRunWatermarksRequest,
+      O: SetJobRunWatermarksResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * Resets the high-water marks so that the next run re-syncs the affected tables from the beginning
+     *
+     * @generated from rpc mgmt.v1alpha1.JobService.ResetJobWatermarks
+     */
+    resetJobWatermarks: {
+      name: "ResetJobWatermarks",
+      I: ResetJobWatermarksRequest,
+      O: ResetJobWatermarksResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * Records the post-sync validation report for a job run
+     *
+     * @generated from rpc mgmt.v1alpha1.JobService.SetJobRunValidationReport
+     */
+    setJobRunValidationReport: {
+      name: "SetJobRunValidationReport",
+      I: SetJobRunValidationReportRequest,
+      O: SetJobRunValidationReportResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * Returns the post-sync validation report for a job run
+     *
+     * @generated from rpc mgmt.v1alpha1.JobService.GetJobRunValidationReport
+     */
+    getJobRunValidationReport: {
+      name: "GetJobRunValidationReport",
+      I: GetJobRunValidationReportRequest,
+      O: GetJobRunValidationReportResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * Records the schema drift report for a job run
+     *
+     * @generated from rpc mgmt.v1alpha1.JobService.SetJobRunSchemaDriftReport
+     */
+    setJobRunSchemaDriftReport: {
+      name: "SetJobRunSchemaDriftReport",
+      I: SetJobRunSchemaDriftReportRequest,
+      O: SetJobRunSchemaDriftReportResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * Returns the schema drift report for a job run, or the most recent report of the job
+     *
+     * @generated from rpc mgmt.v1alpha1.JobService.GetJobRunSchemaDriftReport
+     */
+    getJobRunSchemaDriftReport: {
+      name: "GetJobRunSchemaDriftReport",
+      I: GetJobRunSchemaDriftReportRequest,
+      O: GetJobRunSchemaDriftReportResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * Updates the job mappings with the changes in a schema drift report. New columns are mapped to their suggested transformer, renamed columns keep their transformer and removed columns are unmapped
+     *
+     * @generated from rpc mgmt.v1alpha1.JobService.ApplyJobRunSchemaDriftReport
+     */
+    applyJobRunSchemaDriftReport: {
+      name: "ApplyJobRunSchemaDriftReport",
+      I: ApplyJobRunSchemaDriftReportRequest,
+      O: ApplyJobRunSchemaDriftReportResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * Runs a sample of source rows through the job mappings and returns them before and after they were transformed. Nothing is written to the destinations
+     *
+     * @generated from rpc mgmt.v1alpha1.JobService.PreviewJobMappings
+     */
+    previewJobMappings: {
+      name: "PreviewJobMappings",
+      I: PreviewJobMappingsRequest,
+      O: PreviewJobMappingsResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * Returns every version of the job, newest first
+     *
+     * @generated from rpc mgmt.v1alpha1.JobService.GetJobVersions
+     */
+    getJobVersions: {
+      name: "GetJobVersions",
+      I: GetJobVersionsRequest,
+      O: GetJobVersionsResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * Returns the schedule, source, mapping and destination changes between two versions of a job
+     *
+     * @generated from rpc mgmt.v1alpha1.JobService.GetJobVersionDiff
+     */
+    getJobVersionDiff: {
+      name: "GetJobVersionDiff",
+      I: GetJobVersionDiffRequest,
+      O: GetJobVersionDiffResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * Restores the schedule, source, mappings and destinations of a job to a previous version. The rollback is recorded as a new version
+     *
+     * @generated from rpc mgmt.v1alpha1.JobService.RollbackJobToVersion
+     */
+    rollbackJobToVersion: {
+      name: "RollbackJobToVersion",
+      I: RollbackJobToVersionRequest,
+      O: RollbackJobToVersionResponse,
+      kind: MethodKind.Unary,
+    },
   }
 } as const;
 
@@ -7,6 +7,84 @@ import type { BinaryReadOptions, FieldList, JsonReadOptions, JsonValue, PartialM
 import { Message, proto3, protoInt64, Timestamp } from "@bufbuild/protobuf";
 import { TransformerConfig, TransformerSource } from "./transformer_pb.js";
 
+/**
+ * @generated from enum mgmt.v1alpha1.SchemaDriftPolicy
+ */
+export enum SchemaDriftPolicy {
+  /**
+   * @generated from enum value: SCHEMA_DRIFT_POLICY_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * Fails the run
+   *
+   * @generated from enum value: SCHEMA_DRIFT_POLICY_HALT = 1;
+   */
+  HALT = 1,
+
+  /**
+   * Adds a mapping for new columns and removes the mapping of removed columns for the run
+   *
+   * @generated from enum value: SCHEMA_DRIFT_POLICY_AUTO_MAP = 2;
+   */
+  AUTO_MAP = 2,
+
+  /**
+   * Continues the run without changing the mappings. New columns are not synced
+   *
+   * @generated from enum value: SCHEMA_DRIFT_POLICY_IGNORE = 3;
+   */
+  IGNORE = 3,
+}
+// Retrieve enum metadata with: proto3.getEnumType(SchemaDriftPolicy)
+proto3.util.setEnumType(SchemaDriftPolicy, "mgmt.v1alpha1.SchemaDriftPolicy", [
+  { no: 0, name: "SCHEMA_DRIFT_POLICY_UNSPECIFIED" },
+  { no: 1, name: "SCHEMA_DRIFT_POLICY_HALT" },
+  { no: 2, name: "SCHEMA_DRIFT_POLICY_AUTO_MAP" },
+  { no: 3, name: "SCHEMA_DRIFT_POLICY_IGNORE" },
+]);
+
+/**
+ * @generated from enum mgmt.v1alpha1.AwsS3OutputFormat
+ */
+export enum AwsS3OutputFormat {
+  /**
+   * Gzipped JSON lines
+   *
+   * @generated from enum value: AWS_S3_OUTPUT_FORMAT_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * Gzipped JSON lines
+   *
+   * @generated from enum value: AWS_S3_OUTPUT_FORMAT_JSONL_GZ = 1;
+   */
+  JSONL_GZ = 1,
+
+  /**
+   * Comma separated values with a header row
+   *
+   * @generated from enum value: AWS_S3_OUTPUT_FORMAT_CSV = 2;
+   */
+  CSV = 2,
+
+  /**
+   * Snappy compressed Apache Parquet
+   *
+   * @generated from enum value: AWS_S3_OUTPUT_FORMAT_PARQUET = 3;
+   */
+  PARQUET = 3,
+}
+// Retrieve enum metadata with: proto3.getEnumType(AwsS3OutputFormat)
+proto3.util.setEnumType(AwsS3OutputFormat, "mgmt.v1alpha1.AwsS3OutputFormat", [
+  { no: 0, name: "AWS_S3_OUTPUT_FORMAT_UNSPECIFIED" },
+  { no: 1, name: "AWS_S3_OUTPUT_FORMAT_JSONL_GZ" },
+  { no: 2, name: "AWS_S3_OUTPUT_FORMAT_CSV" },
+  { no: 3, name: "AWS_S3_OUTPUT_FORMAT_PARQUET" },
+]);
+
 /**
  * @generated from enum mgmt.v1alpha1.JobStatus
  */
@@ -39,6 +117,44 @@ proto3.util.setEnumType(JobStatus, "mgmt.v1alpha1.JobStatus", [
   { no: 4, name: "JOB_STATUS_DISABLED" },
 ]);
 
+/**
+ * @generated from enum mgmt.v1alpha1.JobTriggerCondition
+ */
+export enum JobTriggerCondition {
+  /**
+   * @generated from enum value: JOB_TRIGGER_CONDITION_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * Start a run when a run of the upstream job succeeds
+   *
+   * @generated from enum value: JOB_TRIGGER_CONDITION_SUCCEEDED = 1;
+   */
+  SUCCEEDED = 1,
+
+  /**
+   * Start a run when a run of the upstream job fails
+   *
+   * @generated from enum value: JOB_TRIGGER_CONDITION_FAILED = 2;
+   */
+  FAILED = 2,
+
+  /**
+   * Start a run when a run of the upstream job succeeds or fails
+   *
+   * @generated from enum value: JOB_TRIGGER_CONDITION_COMPLETED = 3;
+   */
+  COMPLETED = 3,
+}
+// Retrieve enum metadata with: proto3.getEnumType(JobTriggerCondition)
+proto3.util.setEnumType(JobTriggerCondition, "mgmt.v1alpha1.JobTriggerCondition", [
+  { no: 0, name: "JOB_TRIGGER_CONDITION_UNSPECIFIED" },
+  { no: 1, name: "JOB_TRIGGER_CONDITION_SUCCEEDED" },
+  { no: 2, name: "JOB_TRIGGER_CONDITION_FAILED" },
+  { no: 3, name: "JOB_TRIGGER_CONDITION_COMPLETED" },
+]);
+
 /**
  * @generated from enum mgmt.v1alpha1.ActivityStatus
  */
@@ -77,6 +193,84 @@ proto3.util.setEnumType(ActivityStatus, "mgmt.v1alpha1.ActivityStatus", [
   { no: 4, name: "ACTIVITY_STATUS_FAILED" },
 ]);
 
+/**
+ * @generated from enum mgmt.v1alpha1.SchemaDriftChangeType
+ */
+export enum SchemaDriftChangeType {
+  /**
+   * @generated from enum value: SCHEMA_DRIFT_CHANGE_TYPE_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * The source column is not in the job mappings
+   *
+   * @generated from enum value: SCHEMA_DRIFT_CHANGE_TYPE_COLUMN_ADDED = 1;
+   */
+  COLUMN_ADDED = 1,
+
+  /**
+   * The mapped column is no longer in the source
+   *
+   * @generated from enum value: SCHEMA_DRIFT_CHANGE_TYPE_COLUMN_REMOVED = 2;
+   */
+  COLUMN_REMOVED = 2,
+
+  /**
+   * The mapped column is no longer in the source and a new column of the same type was added to the table
+   *
+   * @generated from enum value: SCHEMA_DRIFT_CHANGE_TYPE_COLUMN_RENAMED = 3;
+   */
+  COLUMN_RENAMED = 3,
+
+  /**
+   * The data type of the mapped column changed since the previous run
+   *
+   * @generated from enum value: SCHEMA_DRIFT_CHANGE_TYPE_COLUMN_TYPE_CHANGED = 4;
+   */
+  COLUMN_TYPE_CHANGED = 4,
+}
+// Retrieve enum metadata with: proto3.getEnumType(SchemaDriftChangeType)
+proto3.util.setEnumType(SchemaDriftChangeType, "mgmt.v1alpha1.SchemaDriftChangeType", [
+  { no: 0, name: "SCHEMA_DRIFT_CHANGE_TYPE_UNSPECIFIED" },
+  { no: 1, name: "SCHEMA_DRIFT_CHANGE_TYPE_COLUMN_ADDED" },
+  { no: 2, name: "SCHEMA_DRIFT_CHANGE_TYPE_COLUMN_REMOVED" },
+  { no: 3, name: "SCHEMA_DRIFT_CHANGE_TYPE_COLUMN_RENAMED" },
+  { no: 4, name: "SCHEMA_DRIFT_CHANGE_TYPE_COLUMN_TYPE_CHANGED" },
+]);
+
+/**
+ * @generated from enum mgmt.v1alpha1.SchemaDriftAction
+ */
+export enum SchemaDriftAction {
+  /**
+   * @generated from enum value: SCHEMA_DRIFT_ACTION_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * @generated from enum value: SCHEMA_DRIFT_ACTION_HALTED = 1;
+   */
+  HALTED = 1,
+
+  /**
+   * @generated from enum value: SCHEMA_DRIFT_ACTION_AUTO_MAPPED = 2;
+   */
+  AUTO_MAPPED = 2,
+
+  /**
+   * @generated from enum value: SCHEMA_DRIFT_ACTION_IGNORED = 3;
+   */
+  IGNORED = 3,
+}
+// Retrieve enum metadata with: proto3.getEnumType(SchemaDriftAction)
+proto3.util.setEnumType(SchemaDriftAction, "mgmt.v1alpha1.SchemaDriftAction", [
+  { no: 0, name: "SCHEMA_DRIFT_ACTION_UNSPECIFIED" },
+  { no: 1, name: "SCHEMA_DRIFT_ACTION_HALTED" },
+  { no: 2, name: "SCHEMA_DRIFT_ACTION_AUTO_MAPPED" },
+  { no: 3, name: "SCHEMA_DRIFT_ACTION_IGNORED" },
+]);
+
 /**
  * An enumeration of job run statuses.
  *
@@ -191,6 +385,38 @@ proto3.util.setEnumType(LogWindow, "mgmt.v1alpha1.LogWindow", [
   { no: 3, name: "LOG_WINDOW_ONE_DAY" },
 ]);
 
+/**
+ * @generated from enum mgmt.v1alpha1.JobVersionDiffAction
+ */
+export enum JobVersionDiffAction {
+  /**
+   * @generated from enum value: JOB_VERSION_DIFF_ACTION_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * @generated from enum value: JOB_VERSION_DIFF_ACTION_ADDED = 1;
+   */
+  ADDED = 1,
+
+  /**
+   * @generated from enum value: JOB_VERSION_DIFF_ACTION_REMOVED = 2;
+   */
+  REMOVED = 2,
+
+  /**
+   * @generated from enum value: JOB_VERSION_DIFF_ACTION_CHANGED = 3;
+   */
+  CHANGED = 3,
+}
+// Retrieve enum metadata with: proto3.getEnumType(JobVersionDiffAction)
+proto3.util.setEnumType(JobVersionDiffAction, "mgmt.v1alpha1.JobVersionDiffAction", [
+  { no: 0, name: "JOB_VERSION_DIFF_ACTION_UNSPECIFIED" },
+  { no: 1, name: "JOB_VERSION_DIFF_ACTION_ADDED" },
+  { no: 2, name: "JOB_VERSION_DIFF_ACTION_REMOVED" },
+  { no: 3, name: "JOB_VERSION_DIFF_ACTION_CHANGED" },
+]);
+
 /**
  * @generated from message mgmt.v1alpha1.GetJobsRequest
  */
@@ -333,6 +559,18 @@ export class JobSourceOptions extends Message<JobSourceOptions> {
      */
     value: GenerateSourceOptions;
     case: "generate";
+  } | {
+    /**
+     * @generated from field: mgmt.v1alpha1.MssqlSourceConnectionOptions mssql = 5;
+     */
+    value: MssqlSourceConnectionOptions;
+    case: "mssql";
+  } | {
+    /**
+     * @generated from field: mgmt.v1alpha1.PostgresCdcSourceConnectionOptions postgres_cdc = 6;
+     */
+    value: PostgresCdcSourceConnectionOptions;
+    case: "postgresCdc";
   } | { case: undefined; value?: undefined } = { case: undefined };
 
   constructor(data?: PartialMessage<JobSourceOptions>) {
@@ -347,6 +585,8 @@ export class JobSourceOptions extends Message<JobSourceOptions> {
     { no: 2, name: "aws_s3", kind: "message", T: AwsS3SourceConnectionOptions, oneof: "config" },
     { no: 3, name: "mysql", kind: "message", T: MysqlSourceConnectionOptions, oneof: "config" },
     { no: 4, name: "generate", kind: "message", T: GenerateSourceOptions, oneof: "config" },
+    { no: 5, name: "mssql", kind: "message", T: MssqlSourceConnectionOptions, oneof: "config" },
+    { no: 6, name: "postgres_cdc", kind: "message", T: PostgresCdcSourceConnectionOptions, oneof: "config" },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): JobSourceOptions {
@@ -592,6 +832,8 @@ export class GenerateSourceTableOption extends Message<GenerateSourceTableOption
  */
 export class PostgresSourceConnectionOptions extends Message<PostgresSourceConnectionOptions> {
   /**
+   * Deprecated in favor of schema_drift_options. Halts the run when the source has columns that are not in the job mappings
+   *
    * @generated from field: bool halt_on_new_column_addition = 1;
    */
   haltOnNewColumnAddition = false;
@@ -611,6 +853,13 @@ export class PostgresSourceConnectionOptions extends Message<PostgresSourceConne
    */
   subsetByForeignKeyConstraints = false;
 
+  /**
+   * Determines how each run handles source columns that were added, removed, renamed or changed type
+   *
+   * @generated from field: optional mgmt.v1alpha1.SchemaDriftOptions schema_drift_options = 5;
+   */
+  schemaDriftOptions?: SchemaDriftOptions;
+
   constructor(data?: PartialMessage<PostgresSourceConnectionOptions>) {
     super();
     proto3.util.initPartial(data, this);
@@ -623,6 +872,7 @@ export class PostgresSourceConnectionOptions extends Message<PostgresSourceConne
     { no: 2, name: "schemas", kind: "message", T: PostgresSourceSchemaOption, repeated: true },
     { no: 3, name: "connection_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
     { no: 4, name: "subset_by_foreign_key_constraints", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 5, name: "schema_drift_options", kind: "message", T: SchemaDriftOptions, opt: true },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): PostgresSourceConnectionOptions {
@@ -642,6 +892,73 @@ export class PostgresSourceConnectionOptions extends Message<PostgresSourceConne
   }
 }
 
+/**
+ * @generated from message mgmt.v1alpha1.SchemaDriftOptions
+ */
+export class SchemaDriftOptions extends Message<SchemaDriftOptions> {
+  /**
+   * Applies to columns in the source that are not in the job mappings.
+   * Defaults to halt if halt_on_new_column_addition is set, otherwise ignore
+   *
+   * @generated from field: mgmt.v1alpha1.SchemaDriftPolicy new_column_policy = 1;
+   */
+  newColumnPolicy = SchemaDriftPolicy.UNSPECIFIED;
+
+  /**
+   * The transformer that new columns are mapped to when the new column policy is auto map.
+   * Defaults to generate default if the column has a default, null if the column is nullable, otherwise passthrough
+   *
+   * @generated from field: optional mgmt.v1alpha1.JobMappingTransformer new_column_transformer = 2;
+   */
+  newColumnTransformer?: JobMappingTransformer;
+
+  /**
+   * Applies to mapped columns that were removed or renamed in the source. Ignore is treated as auto map as the run cannot select a removed column.
+   * Defaults to halt
+   *
+   * @generated from field: mgmt.v1alpha1.SchemaDriftPolicy removed_column_policy = 3;
+   */
+  removedColumnPolicy = SchemaDriftPolicy.UNSPECIFIED;
+
+  /**
+   * Applies to mapped columns whose data type changed since the last run. Auto map is treated as ignore as the mapping is kept.
+   * Defaults to ignore
+   *
+   * @generated from field: mgmt.v1alpha1.SchemaDriftPolicy type_change_policy = 4;
+   */
+  typeChangePolicy = SchemaDriftPolicy.UNSPECIFIED;
+
+  constructor(data?: PartialMessage<SchemaDriftOptions>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "mgmt.v1alpha1.SchemaDriftOptions";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "new_column_policy", kind: "enum", T: proto3.getEnumType(SchemaDriftPolicy) },
+    { no: 2, name: "new_column_transformer", kind: "message", T: JobMappingTransformer, opt: true },
+    { no: 3, name: "removed_column_policy", kind: "enum", T: proto3.getEnumType(SchemaDriftPolicy) },
+    { no: 4, name: "type_change_policy", kind: "enum", T: proto3.getEnumType(SchemaDriftPolicy) },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SchemaDriftOptions {
+    return new SchemaDriftOptions().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SchemaDriftOptions {
+    return new SchemaDriftOptions().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SchemaDriftOptions {
+    return new SchemaDriftOptions().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: SchemaDriftOptions | PlainMessage<SchemaDriftOptions> | undefined, b: SchemaDriftOptions | PlainMessage<SchemaDriftOptions> | undefined): boolean {
+    return proto3.util.equals(SchemaDriftOptions, a, b);
+  }
+}
+
 /**
  * @generated from message mgmt.v1alpha1.PostgresSourceSchemaOption
  */
@@ -699,6 +1016,14 @@ export class PostgresSourceTableOption extends Message<PostgresSourceTableOption
    */
   whereClause?: string;
 
+  /**
+   * Enables incremental sync for the table. Each run only selects rows whose value in this column is greater than the high-water mark of the last successful run.
+   * The column should be monotonically increasing (ex: updated_at or an auto-incrementing id) and the table must have a primary key so that rows can be upserted into the destination.
+   *
+   * @generated from field: optional string watermark_column = 3;
+   */
+  watermarkColumn?: string;
+
   constructor(data?: PartialMessage<PostgresSourceTableOption>) {
     super();
     proto3.util.initPartial(data, this);
@@ -709,6 +1034,7 @@ export class PostgresSourceTableOption extends Message<PostgresSourceTableOption
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
     { no: 1, name: "table", kind: "scalar", T: 9 /* ScalarType.STRING */ },
     { no: 2, name: "where_clause", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+    { no: 3, name: "watermark_column", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): PostgresSourceTableOption {
@@ -728,11 +1054,79 @@ export class PostgresSourceTableOption extends Message<PostgresSourceTableOption
   }
 }
 
+/**
+ * Continuously streams changes from a Postgres database using logical replication.
+ * Each change is run through the job's transformers and applied to the destinations in the order it was committed.
+ *
+ * @generated from message mgmt.v1alpha1.PostgresCdcSourceConnectionOptions
+ */
+export class PostgresCdcSourceConnectionOptions extends Message<PostgresCdcSourceConnectionOptions> {
+  /**
+   * @generated from field: string connection_id = 1;
+   */
+  connectionId = "";
+
+  /**
+   * The publication that determines which tables are streamed. Must already exist in the source database.
+   *
+   * @generated from field: string publication_name = 2;
+   */
+  publicationName = "";
+
+  /**
+   * The logical replication slot that changes are consumed from. Created if it does not already exist.
+   * Defaults to a slot name derived from the job id.
+   *
+   * @generated from field: optional string slot_name = 3;
+   */
+  slotName?: string;
+
+  /**
+   * By default, the destinations are populated with a regular sync of the source when the replication slot is first created.
+   * Set this if the destinations have already been seeded.
+   *
+   * @generated from field: bool skip_initial_snapshot = 4;
+   */
+  skipInitialSnapshot = false;
+
+  constructor(data?: PartialMessage<PostgresCdcSourceConnectionOptions>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "mgmt.v1alpha1.PostgresCdcSourceConnectionOptions";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "connection_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "publication_name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "slot_name", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+    { no: 4, name: "skip_initial_snapshot", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): PostgresCdcSourceConnectionOptions {
+    return new PostgresCdcSourceConnectionOptions().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): PostgresCdcSourceConnectionOptions {
+    return new PostgresCdcSourceConnectionOptions().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): PostgresCdcSourceConnectionOptions {
+    return new PostgresCdcSourceConnectionOptions().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: PostgresCdcSourceConnectionOptions | PlainMessage<PostgresCdcSourceConnectionOptions> | undefined, b: PostgresCdcSourceConnectionOptions | PlainMessage<PostgresCdcSourceConnectionOptions> | undefined): boolean {
+    return proto3.util.equals(PostgresCdcSourceConnectionOptions, a, b);
+  }
+}
+
 /**
  * @generated from message mgmt.v1alpha1.MysqlSourceConnectionOptions
  */
 export class MysqlSourceConnectionOptions extends Message<MysqlSourceConnectionOptions> {
   /**
+   * Deprecated in favor of schema_drift_options. Halts the run when the source has columns that are not in the job mappings
+   *
    * @generated from field: bool halt_on_new_column_addition = 1;
    */
   haltOnNewColumnAddition = false;
@@ -752,6 +1146,13 @@ export class MysqlSourceConnectionOptions extends Message<MysqlSourceConnectionO
    */
   subsetByForeignKeyConstraints = false;
 
+  /**
+   * Determines how each run handles source columns that were added, removed, renamed or changed type
+   *
+   * @generated from field: optional mgmt.v1alpha1.SchemaDriftOptions schema_drift_options = 5;
+   */
+  schemaDriftOptions?: SchemaDriftOptions;
+
   constructor(data?: PartialMessage<MysqlSourceConnectionOptions>) {
     super();
     proto3.util.initPartial(data, this);
@@ -764,6 +1165,7 @@ export class MysqlSourceConnectionOptions extends Message<MysqlSourceConnectionO
     { no: 2, name: "schemas", kind: "message", T: MysqlSourceSchemaOption, repeated: true },
     { no: 3, name: "connection_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
     { no: 4, name: "subset_by_foreign_key_constraints", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 5, name: "schema_drift_options", kind: "message", T: SchemaDriftOptions, opt: true },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): MysqlSourceConnectionOptions {
@@ -840,6 +1242,14 @@ export class MysqlSourceTableOption extends Message<MysqlSourceTableOption> {
    */
   whereClause?: string;
 
+  /**
+   * Enables incremental sync for the table. Each run only selects rows whose value in this column is greater than the high-water mark of the last successful run.
+   * The column should be monotonically increasing (ex: updated_at or an auto-incrementing id) and the table must have a primary key so that rows can be upserted into the destination.
+   *
+   * @generated from field: optional string watermark_column = 3;
+   */
+  watermarkColumn?: string;
+
   constructor(data?: PartialMessage<MysqlSourceTableOption>) {
     super();
     proto3.util.initPartial(data, this);
@@ -850,6 +1260,7 @@ export class MysqlSourceTableOption extends Message<MysqlSourceTableOption> {
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
     { no: 1, name: "table", kind: "scalar", T: 9 /* ScalarType.STRING */ },
     { no: 2, name: "where_clause", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+    { no: 3, name: "watermark_column", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): MysqlSourceTableOption {
@@ -870,48 +1281,199 @@ export class MysqlSourceTableOption extends Message<MysqlSourceTableOption> {
 }
 
 /**
- * @generated from message mgmt.v1alpha1.AwsS3SourceConnectionOptions
+ * @generated from message mgmt.v1alpha1.MssqlSourceConnectionOptions
  */
-export class AwsS3SourceConnectionOptions extends Message<AwsS3SourceConnectionOptions> {
+export class MssqlSourceConnectionOptions extends Message<MssqlSourceConnectionOptions> {
   /**
-   * @generated from field: string connection_id = 1;
+   * Deprecated in favor of schema_drift_options. Halts the run when the source has columns that are not in the job mappings
+   *
+   * @generated from field: bool halt_on_new_column_addition = 1;
+   */
+  haltOnNewColumnAddition = false;
+
+  /**
+   * @generated from field: repeated mgmt.v1alpha1.MssqlSourceSchemaOption schemas = 2;
+   */
+  schemas: MssqlSourceSchemaOption[] = [];
+
+  /**
+   * @generated from field: string connection_id = 3;
    */
   connectionId = "";
 
-  constructor(data?: PartialMessage<AwsS3SourceConnectionOptions>) {
+  /**
+   * @generated from field: bool subset_by_foreign_key_constraints = 4;
+   */
+  subsetByForeignKeyConstraints = false;
+
+  /**
+   * Determines how each run handles source columns that were added, removed, renamed or changed type
+   *
+   * @generated from field: optional mgmt.v1alpha1.SchemaDriftOptions schema_drift_options = 5;
+   */
+  schemaDriftOptions?: SchemaDriftOptions;
+
+  constructor(data?: PartialMessage<MssqlSourceConnectionOptions>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "mgmt.v1alpha1.AwsS3SourceConnectionOptions";
+  static readonly typeName = "mgmt.v1alpha1.MssqlSourceConnectionOptions";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "connection_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 1, name: "halt_on_new_column_addition", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 2, name: "schemas", kind: "message", T: MssqlSourceSchemaOption, repeated: true },
+    { no: 3, name: "connection_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "subset_by_foreign_key_constraints", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 5, name: "schema_drift_options", kind: "message", T: SchemaDriftOptions, opt: true },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): AwsS3SourceConnectionOptions {
-    return new AwsS3SourceConnectionOptions().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): MssqlSourceConnectionOptions {
+    return new MssqlSourceConnectionOptions().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): AwsS3SourceConnectionOptions {
-    return new AwsS3SourceConnectionOptions().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): MssqlSourceConnectionOptions {
+    return new MssqlSourceConnectionOptions().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): AwsS3SourceConnectionOptions {
-    return new AwsS3SourceConnectionOptions().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): MssqlSourceConnectionOptions {
+    return new MssqlSourceConnectionOptions().fromJsonString(jsonString, options);
   }
 
-  static equals(a: AwsS3SourceConnectionOptions | PlainMessage<AwsS3SourceConnectionOptions> | undefined, b: AwsS3SourceConnectionOptions | PlainMessage<AwsS3SourceConnectionOptions> | undefined): boolean {
-    return proto3.util.equals(AwsS3SourceConnectionOptions, a, b);
+  static equals(a: MssqlSourceConnectionOptions | PlainMessage<MssqlSourceConnectionOptions> | undefined, b: MssqlSourceConnectionOptions | PlainMessage<MssqlSourceConnectionOptions> | undefined): boolean {
+    return proto3.util.equals(MssqlSourceConnectionOptions, a, b);
   }
 }
 
 /**
- * @generated from message mgmt.v1alpha1.JobDestinationOptions
+ * @generated from message mgmt.v1alpha1.MssqlSourceSchemaOption
  */
-export class JobDestinationOptions extends Message<JobDestinationOptions> {
+export class MssqlSourceSchemaOption extends Message<MssqlSourceSchemaOption> {
   /**
-   * @generated from oneof mgmt.v1alpha1.JobDestinationOptions.config
+   * @generated from field: string schema = 1;
+   */
+  schema = "";
+
+  /**
+   * @generated from field: repeated mgmt.v1alpha1.MssqlSourceTableOption tables = 2;
+   */
+  tables: MssqlSourceTableOption[] = [];
+
+  constructor(data?: PartialMessage<MssqlSourceSchemaOption>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "mgmt.v1alpha1.MssqlSourceSchemaOption";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "schema", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "tables", kind: "message", T: MssqlSourceTableOption, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): MssqlSourceSchemaOption {
+    return new MssqlSourceSchemaOption().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): MssqlSourceSchemaOption {
+    return new MssqlSourceSchemaOption().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): MssqlSourceSchemaOption {
+    return new MssqlSourceSchemaOption().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: MssqlSourceSchemaOption | PlainMessage<MssqlSourceSchemaOption> | undefined, b: MssqlSourceSchemaOption | PlainMessage<MssqlSourceSchemaOption> | undefined): boolean {
+    return proto3.util.equals(MssqlSourceSchemaOption, a, b);
+  }
+}
+
+/**
+ * @generated from message mgmt.v1alpha1.MssqlSourceTableOption
+ */
+export class MssqlSourceTableOption extends Message<MssqlSourceTableOption> {
+  /**
+   * @generated from field: string table = 1;
+   */
+  table = "";
+
+  /**
+   * @generated from field: optional string where_clause = 2;
+   */
+  whereClause?: string;
+
+  constructor(data?: PartialMessage<MssqlSourceTableOption>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "mgmt.v1alpha1.MssqlSourceTableOption";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "table", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "where_clause", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): MssqlSourceTableOption {
+    return new MssqlSourceTableOption().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): MssqlSourceTableOption {
+    return new MssqlSourceTableOption().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): MssqlSourceTableOption {
+    return new MssqlSourceTableOption().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: MssqlSourceTableOption | PlainMessage<MssqlSourceTableOption> | undefined, b: MssqlSourceTableOption | PlainMessage<MssqlSourceTableOption> | undefined): boolean {
+    return proto3.util.equals(MssqlSourceTableOption, a, b);
+  }
+}
+
+/**
+ * @generated from message mgmt.v1alpha1.AwsS3SourceConnectionOptions
+ */
+export class AwsS3SourceConnectionOptions extends Message<AwsS3SourceConnectionOptions> {
+  /**
+   * @generated from field: string connection_id = 1;
+   */
+  connectionId = "";
+
+  constructor(data?: PartialMessage<AwsS3SourceConnectionOptions>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "mgmt.v1alpha1.AwsS3SourceConnectionOptions";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "connection_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): AwsS3SourceConnectionOptions {
+    return new AwsS3SourceConnectionOptions().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): AwsS3SourceConnectionOptions {
+    return new AwsS3SourceConnectionOptions().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): AwsS3SourceConnectionOptions {
+    return new AwsS3SourceConnectionOptions().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: AwsS3SourceConnectionOptions | PlainMessage<AwsS3SourceConnectionOptions> | undefined, b: AwsS3SourceConnectionOptions | PlainMessage<AwsS3SourceConnectionOptions> | undefined): boolean {
+    return proto3.util.equals(AwsS3SourceConnectionOptions, a, b);
+  }
+}
+
+/**
+ * @generated from message mgmt.v1alpha1.JobDestinationOptions
+ */
+export class JobDestinationOptions extends Message<JobDestinationOptions> {
+  /**
+   * @generated from oneof mgmt.v1alpha1.JobDestinationOptions.config
    */
   config: {
     /**
@@ -931,6 +1493,18 @@ export class JobDestinationOptions extends Message<JobDestinationOptions> {
      */
     value: MysqlDestinationConnectionOptions;
     case: "mysqlOptions";
+  } | {
+    /**
+     * @generated from field: mgmt.v1alpha1.MssqlDestinationConnectionOptions mssql_options = 4;
+     */
+    value: MssqlDestinationConnectionOptions;
+    case: "mssqlOptions";
+  } | {
+    /**
+     * @generated from field: mgmt.v1alpha1.SqliteDestinationConnectionOptions sqlite_options = 5;
+     */
+    value: SqliteDestinationConnectionOptions;
+    case: "sqliteOptions";
   } | { case: undefined; value?: undefined } = { case: undefined };
 
   constructor(data?: PartialMessage<JobDestinationOptions>) {
@@ -944,6 +1518,8 @@ export class JobDestinationOptions extends Message<JobDestinationOptions> {
     { no: 1, name: "postgres_options", kind: "message", T: PostgresDestinationConnectionOptions, oneof: "config" },
     { no: 2, name: "aws_s3_options", kind: "message", T: AwsS3DestinationConnectionOptions, oneof: "config" },
     { no: 3, name: "mysql_options", kind: "message", T: MysqlDestinationConnectionOptions, oneof: "config" },
+    { no: 4, name: "mssql_options", kind: "message", T: MssqlDestinationConnectionOptions, oneof: "config" },
+    { no: 5, name: "sqlite_options", kind: "message", T: SqliteDestinationConnectionOptions, oneof: "config" },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): JobDestinationOptions {
@@ -1129,10 +1705,186 @@ export class MysqlTruncateTableConfig extends Message<MysqlTruncateTableConfig>
   }
 }
 
+/**
+ * @generated from message mgmt.v1alpha1.MssqlDestinationConnectionOptions
+ */
+export class MssqlDestinationConnectionOptions extends Message<MssqlDestinationConnectionOptions> {
+  /**
+   * @generated from field: mgmt.v1alpha1.MssqlTruncateTableConfig truncate_table = 1;
+   */
+  truncateTable?: MssqlTruncateTableConfig;
+
+  /**
+   * @generated from field: bool init_table_schema = 2;
+   */
+  initTableSchema = false;
+
+  constructor(data?: PartialMessage<MssqlDestinationConnectionOptions>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "mgmt.v1alpha1.MssqlDestinationConnectionOptions";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "truncate_table", kind: "message", T: MssqlTruncateTableConfig },
+    { no: 2, name: "init_table_schema", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): MssqlDestinationConnectionOptions {
+    return new MssqlDestinationConnectionOptions().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): MssqlDestinationConnectionOptions {
+    return new MssqlDestinationConnectionOptions().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): MssqlDestinationConnectionOptions {
+    return new MssqlDestinationConnectionOptions().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: MssqlDestinationConnectionOptions | PlainMessage<MssqlDestinationConnectionOptions> | undefined, b: MssqlDestinationConnectionOptions | PlainMessage<MssqlDestinationConnectionOptions> | undefined): boolean {
+    return proto3.util.equals(MssqlDestinationConnectionOptions, a, b);
+  }
+}
+
+/**
+ * @generated from message mgmt.v1alpha1.MssqlTruncateTableConfig
+ */
+export class MssqlTruncateTableConfig extends Message<MssqlTruncateTableConfig> {
+  /**
+   * @generated from field: bool truncate_before_insert = 1;
+   */
+  truncateBeforeInsert = false;
+
+  constructor(data?: PartialMessage<MssqlTruncateTableConfig>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "mgmt.v1alpha1.MssqlTruncateTableConfig";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "truncate_before_insert", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): MssqlTruncateTableConfig {
+    return new MssqlTruncateTableConfig().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): MssqlTruncateTableConfig {
+    return new MssqlTruncateTableConfig().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): MssqlTruncateTableConfig {
+    return new MssqlTruncateTableConfig().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: MssqlTruncateTableConfig | PlainMessage<MssqlTruncateTableConfig> | undefined, b: MssqlTruncateTableConfig | PlainMessage<MssqlTruncateTableConfig> | undefined): boolean {
+    return proto3.util.equals(MssqlTruncateTableConfig, a, b);
+  }
+}
+
+/**
+ * @generated from message mgmt.v1alpha1.SqliteDestinationConnectionOptions
+ */
+export class SqliteDestinationConnectionOptions extends Message<SqliteDestinationConnectionOptions> {
+  /**
+   * @generated from field: mgmt.v1alpha1.SqliteTruncateTableConfig truncate_table = 1;
+   */
+  truncateTable?: SqliteTruncateTableConfig;
+
+  /**
+   * @generated from field: bool init_table_schema = 2;
+   */
+  initTableSchema = false;
+
+  constructor(data?: PartialMessage<SqliteDestinationConnectionOptions>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "mgmt.v1alpha1.SqliteDestinationConnectionOptions";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "truncate_table", kind: "message", T: SqliteTruncateTableConfig },
+    { no: 2, name: "init_table_schema", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SqliteDestinationConnectionOptions {
+    return new SqliteDestinationConnectionOptions().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SqliteDestinationConnectionOptions {
+    return new SqliteDestinationConnectionOptions().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SqliteDestinationConnectionOptions {
+    return new SqliteDestinationConnectionOptions().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: SqliteDestinationConnectionOptions | PlainMessage<SqliteDestinationConnectionOptions> | undefined, b: SqliteDestinationConnectionOptions | PlainMessage<SqliteDestinationConnectionOptions> | undefined): boolean {
+    return proto3.util.equals(SqliteDestinationConnectionOptions, a, b);
+  }
+}
+
+/**
+ * @generated from message mgmt.v1alpha1.SqliteTruncateTableConfig
+ */
+export class SqliteTruncateTableConfig extends Message<SqliteTruncateTableConfig> {
+  /**
+   * @generated from field: bool truncate_before_insert = 1;
+   */
+  truncateBeforeInsert = false;
+
+  constructor(data?: PartialMessage<SqliteTruncateTableConfig>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "mgmt.v1alpha1.SqliteTruncateTableConfig";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "truncate_before_insert", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SqliteTruncateTableConfig {
+    return new SqliteTruncateTableConfig().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SqliteTruncateTableConfig {
+    return new SqliteTruncateTableConfig().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SqliteTruncateTableConfig {
+    return new SqliteTruncateTableConfig().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: SqliteTruncateTableConfig | PlainMessage<SqliteTruncateTableConfig> | undefined, b: SqliteTruncateTableConfig | PlainMessage<SqliteTruncateTableConfig> | undefined): boolean {
+    return proto3.util.equals(SqliteTruncateTableConfig, a, b);
+  }
+}
+
 /**
  * @generated from message mgmt.v1alpha1.AwsS3DestinationConnectionOptions
  */
 export class AwsS3DestinationConnectionOptions extends Message<AwsS3DestinationConnectionOptions> {
+  /**
+   * The file format that rows are written in. Defaults to gzipped JSON lines
+   *
+   * @generated from field: mgmt.v1alpha1.AwsS3OutputFormat format = 1;
+   */
+  format = AwsS3OutputFormat.UNSPECIFIED;
+
+  /**
+   * The approximate maximum size of each file, measured on the rows before they are encoded and compressed.
+   * Files are always partitioned by table, so a file never contains rows from more than one table.
+   * When unset, a new file is written for every 100 rows
+   *
+   * @generated from field: optional uint64 max_file_size_bytes = 2;
+   */
+  maxFileSizeBytes?: bigint;
+
   constructor(data?: PartialMessage<AwsS3DestinationConnectionOptions>) {
     super();
     proto3.util.initPartial(data, this);
@@ -1141,6 +1893,8 @@ export class AwsS3DestinationConnectionOptions extends Message<AwsS3DestinationC
   static readonly runtime: typeof proto3 = proto3;
   static readonly typeName = "mgmt.v1alpha1.AwsS3DestinationConnectionOptions";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "format", kind: "enum", T: proto3.getEnumType(AwsS3OutputFormat) },
+    { no: 2, name: "max_file_size_bytes", kind: "scalar", T: 4 /* ScalarType.UINT64 */, opt: true },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): AwsS3DestinationConnectionOptions {
@@ -1223,6 +1977,13 @@ export class CreateJobRequest extends Message<CreateJobRequest> {
    */
   syncOptions?: ActivityOptions;
 
+  /**
+   * Start a run of this job when runs of other jobs complete. The triggers may not form a cycle
+   *
+   * @generated from field: repeated mgmt.v1alpha1.JobTrigger triggers = 10;
+   */
+  triggers: JobTrigger[] = [];
+
   constructor(data?: PartialMessage<CreateJobRequest>) {
     super();
     proto3.util.initPartial(data, this);
@@ -1240,6 +2001,7 @@ export class CreateJobRequest extends Message<CreateJobRequest> {
     { no: 7, name: "initiate_job_run", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
     { no: 8, name: "workflow_options", kind: "message", T: WorkflowOptions },
     { no: 9, name: "sync_options", kind: "message", T: ActivityOptions },
+    { no: 10, name: "triggers", kind: "message", T: JobTrigger, repeated: true },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CreateJobRequest {
@@ -1941,6 +2703,43 @@ export class MysqlSourceSchemaSubset extends Message<MysqlSourceSchemaSubset> {
   }
 }
 
+/**
+ * @generated from message mgmt.v1alpha1.MssqlSourceSchemaSubset
+ */
+export class MssqlSourceSchemaSubset extends Message<MssqlSourceSchemaSubset> {
+  /**
+   * @generated from field: repeated mgmt.v1alpha1.MssqlSourceSchemaOption mssql_schemas = 1;
+   */
+  mssqlSchemas: MssqlSourceSchemaOption[] = [];
+
+  constructor(data?: PartialMessage<MssqlSourceSchemaSubset>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "mgmt.v1alpha1.MssqlSourceSchemaSubset";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "mssql_schemas", kind: "message", T: MssqlSourceSchemaOption, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): MssqlSourceSchemaSubset {
+    return new MssqlSourceSchemaSubset().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): MssqlSourceSchemaSubset {
+    return new MssqlSourceSchemaSubset().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): MssqlSourceSchemaSubset {
+    return new MssqlSourceSchemaSubset().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: MssqlSourceSchemaSubset | PlainMessage<MssqlSourceSchemaSubset> | undefined, b: MssqlSourceSchemaSubset | PlainMessage<MssqlSourceSchemaSubset> | undefined): boolean {
+    return proto3.util.equals(MssqlSourceSchemaSubset, a, b);
+  }
+}
+
 /**
  * @generated from message mgmt.v1alpha1.JobSourceSqlSubetSchemas
  */
@@ -1960,6 +2759,12 @@ export class JobSourceSqlSubetSchemas extends Message<JobSourceSqlSubetSchemas>
      */
     value: MysqlSourceSchemaSubset;
     case: "mysqlSubset";
+  } | {
+    /**
+     * @generated from field: mgmt.v1alpha1.MssqlSourceSchemaSubset mssql_subset = 4;
+     */
+    value: MssqlSourceSchemaSubset;
+    case: "mssqlSubset";
   } | { case: undefined; value?: undefined } = { case: undefined };
 
   constructor(data?: PartialMessage<JobSourceSqlSubetSchemas>) {
@@ -1972,6 +2777,7 @@ export class JobSourceSqlSubetSchemas extends Message<JobSourceSqlSubetSchemas>
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
     { no: 2, name: "postgres_subset", kind: "message", T: PostgresSourceSchemaSubset, oneof: "schemas" },
     { no: 3, name: "mysql_subset", kind: "message", T: MysqlSourceSchemaSubset, oneof: "schemas" },
+    { no: 4, name: "mssql_subset", kind: "message", T: MssqlSourceSchemaSubset, oneof: "schemas" },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): JobSourceSqlSubetSchemas {
@@ -2855,6 +3661,13 @@ export class Job extends Message<Job> {
    */
   workflowOptions?: WorkflowOptions;
 
+  /**
+   * The jobs whose runs start a run of this job when they complete
+   *
+   * @generated from field: repeated mgmt.v1alpha1.JobTrigger triggers = 14;
+   */
+  triggers: JobTrigger[] = [];
+
   constructor(data?: PartialMessage<Job>) {
     super();
     proto3.util.initPartial(data, this);
@@ -2876,6 +3689,7 @@ export class Job extends Message<Job> {
     { no: 11, name: "account_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
     { no: 12, name: "sync_options", kind: "message", T: ActivityOptions },
     { no: 13, name: "workflow_options", kind: "message", T: WorkflowOptions },
+    { no: 14, name: "triggers", kind: "message", T: JobTrigger, repeated: true },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): Job {
@@ -2896,12 +3710,59 @@ export class Job extends Message<Job> {
 }
 
 /**
- * @generated from message mgmt.v1alpha1.JobRecentRun
+ * Starts a run of a job when a run of another job in the same account completes
+ *
+ * @generated from message mgmt.v1alpha1.JobTrigger
  */
-export class JobRecentRun extends Message<JobRecentRun> {
+export class JobTrigger extends Message<JobTrigger> {
   /**
-   * @generated from field: google.protobuf.Timestamp start_time = 1;
-   */
+   * The unique identifier of the upstream job
+   *
+   * @generated from field: string job_id = 1;
+   */
+  jobId = "";
+
+  /**
+   * @generated from field: mgmt.v1alpha1.JobTriggerCondition condition = 2;
+   */
+  condition = JobTriggerCondition.UNSPECIFIED;
+
+  constructor(data?: PartialMessage<JobTrigger>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "mgmt.v1alpha1.JobTrigger";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "job_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "condition", kind: "enum", T: proto3.getEnumType(JobTriggerCondition) },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): JobTrigger {
+    return new JobTrigger().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): JobTrigger {
+    return new JobTrigger().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): JobTrigger {
+    return new JobTrigger().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: JobTrigger | PlainMessage<JobTrigger> | undefined, b: JobTrigger | PlainMessage<JobTrigger> | undefined): boolean {
+    return proto3.util.equals(JobTrigger, a, b);
+  }
+}
+
+/**
+ * @generated from message mgmt.v1alpha1.JobRecentRun
+ */
+export class JobRecentRun extends Message<JobRecentRun> {
+  /**
+   * @generated from field: google.protobuf.Timestamp start_time = 1;
+   */
   startTime?: Timestamp;
 
   /**
@@ -3453,6 +4314,21 @@ export class JobRun extends Message<JobRun> {
    */
   pendingActivities: PendingActivity[] = [];
 
+  /**
+   * The watermark ranges that were synced by the run for tables that are configured for incremental sync.
+   * Watermarks are only returned when retrieving a specific job run and are recorded once the run has successfully completed
+   *
+   * @generated from field: repeated mgmt.v1alpha1.JobRunWatermark watermarks = 9;
+   */
+  watermarks: JobRunWatermark[] = [];
+
+  /**
+   * The version of the job that the run was started with. Not set for runs that were started before the job was versioned
+   *
+   * @generated from field: optional string job_version_id = 10;
+   */
+  jobVersionId?: string;
+
   constructor(data?: PartialMessage<JobRun>) {
     super();
     proto3.util.initPartial(data, this);
@@ -3468,6 +4344,8 @@ export class JobRun extends Message<JobRun> {
     { no: 6, name: "started_at", kind: "message", T: Timestamp },
     { no: 7, name: "completed_at", kind: "message", T: Timestamp, opt: true },
     { no: 8, name: "pending_activities", kind: "message", T: PendingActivity, repeated: true },
+    { no: 9, name: "watermarks", kind: "message", T: JobRunWatermark, repeated: true },
+    { no: 10, name: "job_version_id", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): JobRun {
@@ -3488,727 +4366,2315 @@ export class JobRun extends Message<JobRun> {
 }
 
 /**
- * @generated from message mgmt.v1alpha1.JobRunEventTaskError
+ * @generated from message mgmt.v1alpha1.JobRunWatermark
  */
-export class JobRunEventTaskError extends Message<JobRunEventTaskError> {
+export class JobRunWatermark extends Message<JobRunWatermark> {
   /**
-   * @generated from field: string message = 1;
+   * @generated from field: string schema = 1;
    */
-  message = "";
+  schema = "";
 
   /**
-   * @generated from field: string retry_state = 2;
+   * @generated from field: string table = 2;
    */
-  retryState = "";
+  table = "";
 
-  constructor(data?: PartialMessage<JobRunEventTaskError>) {
+  /**
+   * The column the watermark is tracked against
+   *
+   * @generated from field: string column = 3;
+   */
+  column = "";
+
+  /**
+   * The exclusive lower bound that was used to select rows. Not present if this was the first run for the table
+   *
+   * @generated from field: optional string start_value = 4;
+   */
+  startValue?: string;
+
+  /**
+   * The inclusive upper bound that was used to select rows. This becomes the starting point for the next run
+   *
+   * @generated from field: string end_value = 5;
+   */
+  endValue = "";
+
+  constructor(data?: PartialMessage<JobRunWatermark>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "mgmt.v1alpha1.JobRunEventTaskError";
+  static readonly typeName = "mgmt.v1alpha1.JobRunWatermark";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "message", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 2, name: "retry_state", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 1, name: "schema", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "table", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "column", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "start_value", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+    { no: 5, name: "end_value", kind: "scalar", T: 9 /* ScalarType.STRING */ },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): JobRunEventTaskError {
-    return new JobRunEventTaskError().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): JobRunWatermark {
+    return new JobRunWatermark().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): JobRunEventTaskError {
-    return new JobRunEventTaskError().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): JobRunWatermark {
+    return new JobRunWatermark().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): JobRunEventTaskError {
-    return new JobRunEventTaskError().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): JobRunWatermark {
+    return new JobRunWatermark().fromJsonString(jsonString, options);
   }
 
-  static equals(a: JobRunEventTaskError | PlainMessage<JobRunEventTaskError> | undefined, b: JobRunEventTaskError | PlainMessage<JobRunEventTaskError> | undefined): boolean {
-    return proto3.util.equals(JobRunEventTaskError, a, b);
+  static equals(a: JobRunWatermark | PlainMessage<JobRunWatermark> | undefined, b: JobRunWatermark | PlainMessage<JobRunWatermark> | undefined): boolean {
+    return proto3.util.equals(JobRunWatermark, a, b);
   }
 }
 
 /**
- * @generated from message mgmt.v1alpha1.JobRunEventTask
+ * The current high-water mark for a table in a job
+ *
+ * @generated from message mgmt.v1alpha1.JobWatermark
  */
-export class JobRunEventTask extends Message<JobRunEventTask> {
+export class JobWatermark extends Message<JobWatermark> {
   /**
-   * @generated from field: int64 id = 1;
+   * @generated from field: string schema = 1;
    */
-  id = protoInt64.zero;
+  schema = "";
 
   /**
-   * @generated from field: string type = 2;
+   * @generated from field: string table = 2;
    */
-  type = "";
+  table = "";
 
   /**
-   * @generated from field: google.protobuf.Timestamp event_time = 3;
+   * @generated from field: string column = 3;
    */
-  eventTime?: Timestamp;
+  column = "";
 
   /**
-   * @generated from field: mgmt.v1alpha1.JobRunEventTaskError error = 4;
+   * @generated from field: string value = 4;
    */
-  error?: JobRunEventTaskError;
+  value = "";
 
-  constructor(data?: PartialMessage<JobRunEventTask>) {
+  /**
+   * The job run that last advanced the watermark
+   *
+   * @generated from field: string job_run_id = 5;
+   */
+  jobRunId = "";
+
+  /**
+   * @generated from field: google.protobuf.Timestamp updated_at = 6;
+   */
+  updatedAt?: Timestamp;
+
+  constructor(data?: PartialMessage<JobWatermark>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "mgmt.v1alpha1.JobRunEventTask";
+  static readonly typeName = "mgmt.v1alpha1.JobWatermark";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "id", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
-    { no: 2, name: "type", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 3, name: "event_time", kind: "message", T: Timestamp },
-    { no: 4, name: "error", kind: "message", T: JobRunEventTaskError },
+    { no: 1, name: "schema", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "table", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "column", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "value", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 5, name: "job_run_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 6, name: "updated_at", kind: "message", T: Timestamp },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): JobRunEventTask {
-    return new JobRunEventTask().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): JobWatermark {
+    return new JobWatermark().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): JobRunEventTask {
-    return new JobRunEventTask().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): JobWatermark {
+    return new JobWatermark().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): JobRunEventTask {
-    return new JobRunEventTask().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): JobWatermark {
+    return new JobWatermark().fromJsonString(jsonString, options);
   }
 
-  static equals(a: JobRunEventTask | PlainMessage<JobRunEventTask> | undefined, b: JobRunEventTask | PlainMessage<JobRunEventTask> | undefined): boolean {
-    return proto3.util.equals(JobRunEventTask, a, b);
+  static equals(a: JobWatermark | PlainMessage<JobWatermark> | undefined, b: JobWatermark | PlainMessage<JobWatermark> | undefined): boolean {
+    return proto3.util.equals(JobWatermark, a, b);
   }
 }
 
 /**
- * @generated from message mgmt.v1alpha1.JobRunSyncMetadata
+ * @generated from message mgmt.v1alpha1.GetJobWatermarksRequest
  */
-export class JobRunSyncMetadata extends Message<JobRunSyncMetadata> {
-  /**
-   * @generated from field: string schema = 1;
-   */
-  schema = "";
-
+export class GetJobWatermarksRequest extends Message<GetJobWatermarksRequest> {
   /**
-   * @generated from field: string table = 2;
+   * @generated from field: string job_id = 1;
    */
-  table = "";
+  jobId = "";
 
-  constructor(data?: PartialMessage<JobRunSyncMetadata>) {
+  constructor(data?: PartialMessage<GetJobWatermarksRequest>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "mgmt.v1alpha1.JobRunSyncMetadata";
+  static readonly typeName = "mgmt.v1alpha1.GetJobWatermarksRequest";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "schema", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 2, name: "table", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 1, name: "job_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): JobRunSyncMetadata {
-    return new JobRunSyncMetadata().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetJobWatermarksRequest {
+    return new GetJobWatermarksRequest().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): JobRunSyncMetadata {
-    return new JobRunSyncMetadata().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetJobWatermarksRequest {
+    return new GetJobWatermarksRequest().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): JobRunSyncMetadata {
-    return new JobRunSyncMetadata().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetJobWatermarksRequest {
+    return new GetJobWatermarksRequest().fromJsonString(jsonString, options);
   }
 
-  static equals(a: JobRunSyncMetadata | PlainMessage<JobRunSyncMetadata> | undefined, b: JobRunSyncMetadata | PlainMessage<JobRunSyncMetadata> | undefined): boolean {
-    return proto3.util.equals(JobRunSyncMetadata, a, b);
+  static equals(a: GetJobWatermarksRequest | PlainMessage<GetJobWatermarksRequest> | undefined, b: GetJobWatermarksRequest | PlainMessage<GetJobWatermarksRequest> | undefined): boolean {
+    return proto3.util.equals(GetJobWatermarksRequest, a, b);
   }
 }
 
 /**
- * @generated from message mgmt.v1alpha1.JobRunEventMetadata
+ * @generated from message mgmt.v1alpha1.GetJobWatermarksResponse
  */
-export class JobRunEventMetadata extends Message<JobRunEventMetadata> {
+export class GetJobWatermarksResponse extends Message<GetJobWatermarksResponse> {
   /**
-   * @generated from oneof mgmt.v1alpha1.JobRunEventMetadata.metadata
+   * @generated from field: repeated mgmt.v1alpha1.JobWatermark watermarks = 1;
    */
-  metadata: {
-    /**
-     * @generated from field: mgmt.v1alpha1.JobRunSyncMetadata sync_metadata = 1;
-     */
-    value: JobRunSyncMetadata;
-    case: "syncMetadata";
-  } | { case: undefined; value?: undefined } = { case: undefined };
+  watermarks: JobWatermark[] = [];
 
-  constructor(data?: PartialMessage<JobRunEventMetadata>) {
+  constructor(data?: PartialMessage<GetJobWatermarksResponse>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "mgmt.v1alpha1.JobRunEventMetadata";
+  static readonly typeName = "mgmt.v1alpha1.GetJobWatermarksResponse";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "sync_metadata", kind: "message", T: JobRunSyncMetadata, oneof: "metadata" },
+    { no: 1, name: "watermarks", kind: "message", T: JobWatermark, repeated: true },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): JobRunEventMetadata {
-    return new JobRunEventMetadata().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetJobWatermarksResponse {
+    return new GetJobWatermarksResponse().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): JobRunEventMetadata {
-    return new JobRunEventMetadata().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetJobWatermarksResponse {
+    return new GetJobWatermarksResponse().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): JobRunEventMetadata {
-    return new JobRunEventMetadata().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetJobWatermarksResponse {
+    return new GetJobWatermarksResponse().fromJsonString(jsonString, options);
   }
 
-  static equals(a: JobRunEventMetadata | PlainMessage<JobRunEventMetadata> | undefined, b: JobRunEventMetadata | PlainMessage<JobRunEventMetadata> | undefined): boolean {
-    return proto3.util.equals(JobRunEventMetadata, a, b);
+  static equals(a: GetJobWatermarksResponse | PlainMessage<GetJobWatermarksResponse> | undefined, b: GetJobWatermarksResponse | PlainMessage<GetJobWatermarksResponse> | undefined): boolean {
+    return proto3.util.equals(GetJobWatermarksResponse, a, b);
   }
 }
 
 /**
- * @generated from message mgmt.v1alpha1.JobRunEvent
+ * @generated from message mgmt.v1alpha1.SetJobRunWatermarksRequest
  */
-export class JobRunEvent extends Message<JobRunEvent> {
-  /**
-   * @generated from field: int64 id = 1;
-   */
-  id = protoInt64.zero;
-
-  /**
-   * @generated from field: string type = 2;
-   */
-  type = "";
-
-  /**
-   * @generated from field: google.protobuf.Timestamp start_time = 3;
-   */
-  startTime?: Timestamp;
-
+export class SetJobRunWatermarksRequest extends Message<SetJobRunWatermarksRequest> {
   /**
-   * @generated from field: google.protobuf.Timestamp close_time = 4;
+   * @generated from field: string job_id = 1;
    */
-  closeTime?: Timestamp;
+  jobId = "";
 
   /**
-   * @generated from field: mgmt.v1alpha1.JobRunEventMetadata metadata = 5;
+   * @generated from field: string job_run_id = 2;
    */
-  metadata?: JobRunEventMetadata;
+  jobRunId = "";
 
   /**
-   * @generated from field: repeated mgmt.v1alpha1.JobRunEventTask tasks = 6;
+   * @generated from field: repeated mgmt.v1alpha1.JobRunWatermark watermarks = 3;
    */
-  tasks: JobRunEventTask[] = [];
+  watermarks: JobRunWatermark[] = [];
 
-  constructor(data?: PartialMessage<JobRunEvent>) {
+  constructor(data?: PartialMessage<SetJobRunWatermarksRequest>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "mgmt.v1alpha1.JobRunEvent";
+  static readonly typeName = "mgmt.v1alpha1.SetJobRunWatermarksRequest";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "id", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
-    { no: 2, name: "type", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 3, name: "start_time", kind: "message", T: Timestamp },
-    { no: 4, name: "close_time", kind: "message", T: Timestamp },
-    { no: 5, name: "metadata", kind: "message", T: JobRunEventMetadata },
-    { no: 6, name: "tasks", kind: "message", T: JobRunEventTask, repeated: true },
+    { no: 1, name: "job_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "job_run_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "watermarks", kind: "message", T: JobRunWatermark, repeated: true },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): JobRunEvent {
-    return new JobRunEvent().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SetJobRunWatermarksRequest {
+    return new SetJobRunWatermarksRequest().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): JobRunEvent {
-    return new JobRunEvent().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SetJobRunWatermarksRequest {
+    return new SetJobRunWatermarksRequest().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): JobRunEvent {
-    return new JobRunEvent().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SetJobRunWatermarksRequest {
+    return new SetJobRunWatermarksRequest().fromJsonString(jsonString, options);
   }
 
-  static equals(a: JobRunEvent | PlainMessage<JobRunEvent> | undefined, b: JobRunEvent | PlainMessage<JobRunEvent> | undefined): boolean {
-    return proto3.util.equals(JobRunEvent, a, b);
+  static equals(a: SetJobRunWatermarksRequest | PlainMessage<SetJobRunWatermarksRequest> | undefined, b: SetJobRunWatermarksRequest | PlainMessage<SetJobRunWatermarksRequest> | undefined): boolean {
+    return proto3.util.equals(SetJobRunWatermarksRequest, a, b);
   }
 }
 
 /**
- * @generated from message mgmt.v1alpha1.GetJobRunEventsRequest
+ * @generated from message mgmt.v1alpha1.SetJobRunWatermarksResponse
  */
-export class GetJobRunEventsRequest extends Message<GetJobRunEventsRequest> {
-  /**
-   * @generated from field: string job_run_id = 1;
-   */
-  jobRunId = "";
-
-  /**
-   * @generated from field: string account_id = 2;
-   */
-  accountId = "";
-
-  constructor(data?: PartialMessage<GetJobRunEventsRequest>) {
+export class SetJobRunWatermarksResponse extends Message<SetJobRunWatermarksResponse> {
+  constructor(data?: PartialMessage<SetJobRunWatermarksResponse>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "mgmt.v1alpha1.GetJobRunEventsRequest";
+  static readonly typeName = "mgmt.v1alpha1.SetJobRunWatermarksResponse";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "job_run_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 2, name: "account_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetJobRunEventsRequest {
-    return new GetJobRunEventsRequest().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SetJobRunWatermarksResponse {
+    return new SetJobRunWatermarksResponse().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetJobRunEventsRequest {
-    return new GetJobRunEventsRequest().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SetJobRunWatermarksResponse {
+    return new SetJobRunWatermarksResponse().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetJobRunEventsRequest {
-    return new GetJobRunEventsRequest().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SetJobRunWatermarksResponse {
+    return new SetJobRunWatermarksResponse().fromJsonString(jsonString, options);
   }
 
-  static equals(a: GetJobRunEventsRequest | PlainMessage<GetJobRunEventsRequest> | undefined, b: GetJobRunEventsRequest | PlainMessage<GetJobRunEventsRequest> | undefined): boolean {
-    return proto3.util.equals(GetJobRunEventsRequest, a, b);
+  static equals(a: SetJobRunWatermarksResponse | PlainMessage<SetJobRunWatermarksResponse> | undefined, b: SetJobRunWatermarksResponse | PlainMessage<SetJobRunWatermarksResponse> | undefined): boolean {
+    return proto3.util.equals(SetJobRunWatermarksResponse, a, b);
   }
 }
 
 /**
- * @generated from message mgmt.v1alpha1.GetJobRunEventsResponse
+ * @generated from message mgmt.v1alpha1.JobWatermarkTable
  */
-export class GetJobRunEventsResponse extends Message<GetJobRunEventsResponse> {
+export class JobWatermarkTable extends Message<JobWatermarkTable> {
   /**
-   * @generated from field: repeated mgmt.v1alpha1.JobRunEvent events = 1;
+   * @generated from field: string schema = 1;
    */
-  events: JobRunEvent[] = [];
+  schema = "";
 
   /**
-   * @generated from field: bool is_run_complete = 2;
+   * @generated from field: string table = 2;
    */
-  isRunComplete = false;
+  table = "";
 
-  constructor(data?: PartialMessage<GetJobRunEventsResponse>) {
+  constructor(data?: PartialMessage<JobWatermarkTable>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "mgmt.v1alpha1.GetJobRunEventsResponse";
+  static readonly typeName = "mgmt.v1alpha1.JobWatermarkTable";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "events", kind: "message", T: JobRunEvent, repeated: true },
-    { no: 2, name: "is_run_complete", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 1, name: "schema", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "table", kind: "scalar", T: 9 /* ScalarType.STRING */ },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetJobRunEventsResponse {
-    return new GetJobRunEventsResponse().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): JobWatermarkTable {
+    return new JobWatermarkTable().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetJobRunEventsResponse {
-    return new GetJobRunEventsResponse().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): JobWatermarkTable {
+    return new JobWatermarkTable().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetJobRunEventsResponse {
-    return new GetJobRunEventsResponse().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): JobWatermarkTable {
+    return new JobWatermarkTable().fromJsonString(jsonString, options);
   }
 
-  static equals(a: GetJobRunEventsResponse | PlainMessage<GetJobRunEventsResponse> | undefined, b: GetJobRunEventsResponse | PlainMessage<GetJobRunEventsResponse> | undefined): boolean {
-    return proto3.util.equals(GetJobRunEventsResponse, a, b);
+  static equals(a: JobWatermarkTable | PlainMessage<JobWatermarkTable> | undefined, b: JobWatermarkTable | PlainMessage<JobWatermarkTable> | undefined): boolean {
+    return proto3.util.equals(JobWatermarkTable, a, b);
   }
 }
 
 /**
- * @generated from message mgmt.v1alpha1.DeleteJobRunRequest
+ * @generated from message mgmt.v1alpha1.ResetJobWatermarksRequest
  */
-export class DeleteJobRunRequest extends Message<DeleteJobRunRequest> {
+export class ResetJobWatermarksRequest extends Message<ResetJobWatermarksRequest> {
   /**
-   * @generated from field: string job_run_id = 1;
+   * @generated from field: string job_id = 1;
    */
-  jobRunId = "";
+  jobId = "";
 
   /**
-   * @generated from field: string account_id = 2;
+   * The tables to reset. If none are provided, the watermarks for every table in the job are reset
+   *
+   * @generated from field: repeated mgmt.v1alpha1.JobWatermarkTable tables = 2;
    */
-  accountId = "";
+  tables: JobWatermarkTable[] = [];
 
-  constructor(data?: PartialMessage<DeleteJobRunRequest>) {
+  constructor(data?: PartialMessage<ResetJobWatermarksRequest>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "mgmt.v1alpha1.DeleteJobRunRequest";
+  static readonly typeName = "mgmt.v1alpha1.ResetJobWatermarksRequest";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "job_run_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 2, name: "account_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 1, name: "job_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "tables", kind: "message", T: JobWatermarkTable, repeated: true },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): DeleteJobRunRequest {
-    return new DeleteJobRunRequest().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ResetJobWatermarksRequest {
+    return new ResetJobWatermarksRequest().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): DeleteJobRunRequest {
-    return new DeleteJobRunRequest().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ResetJobWatermarksRequest {
+    return new ResetJobWatermarksRequest().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): DeleteJobRunRequest {
-    return new DeleteJobRunRequest().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ResetJobWatermarksRequest {
+    return new ResetJobWatermarksRequest().fromJsonString(jsonString, options);
   }
 
-  static equals(a: DeleteJobRunRequest | PlainMessage<DeleteJobRunRequest> | undefined, b: DeleteJobRunRequest | PlainMessage<DeleteJobRunRequest> | undefined): boolean {
-    return proto3.util.equals(DeleteJobRunRequest, a, b);
+  static equals(a: ResetJobWatermarksRequest | PlainMessage<ResetJobWatermarksRequest> | undefined, b: ResetJobWatermarksRequest | PlainMessage<ResetJobWatermarksRequest> | undefined): boolean {
+    return proto3.util.equals(ResetJobWatermarksRequest, a, b);
   }
 }
 
 /**
- * @generated from message mgmt.v1alpha1.DeleteJobRunResponse
+ * @generated from message mgmt.v1alpha1.ResetJobWatermarksResponse
  */
-export class DeleteJobRunResponse extends Message<DeleteJobRunResponse> {
-  constructor(data?: PartialMessage<DeleteJobRunResponse>) {
+export class ResetJobWatermarksResponse extends Message<ResetJobWatermarksResponse> {
+  constructor(data?: PartialMessage<ResetJobWatermarksResponse>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "mgmt.v1alpha1.DeleteJobRunResponse";
+  static readonly typeName = "mgmt.v1alpha1.ResetJobWatermarksResponse";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): DeleteJobRunResponse {
-    return new DeleteJobRunResponse().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ResetJobWatermarksResponse {
+    return new ResetJobWatermarksResponse().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): DeleteJobRunResponse {
-    return new DeleteJobRunResponse().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ResetJobWatermarksResponse {
+    return new ResetJobWatermarksResponse().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): DeleteJobRunResponse {
-    return new DeleteJobRunResponse().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ResetJobWatermarksResponse {
+    return new ResetJobWatermarksResponse().fromJsonString(jsonString, options);
   }
 
-  static equals(a: DeleteJobRunResponse | PlainMessage<DeleteJobRunResponse> | undefined, b: DeleteJobRunResponse | PlainMessage<DeleteJobRunResponse> | undefined): boolean {
-    return proto3.util.equals(DeleteJobRunResponse, a, b);
+  static equals(a: ResetJobWatermarksResponse | PlainMessage<ResetJobWatermarksResponse> | undefined, b: ResetJobWatermarksResponse | PlainMessage<ResetJobWatermarksResponse> | undefined): boolean {
+    return proto3.util.equals(ResetJobWatermarksResponse, a, b);
   }
 }
 
 /**
- * @generated from message mgmt.v1alpha1.TerminateJobRunRequest
+ * The result of the checks that are run against each destination once a job run has synced every table
+ *
+ * @generated from message mgmt.v1alpha1.JobRunValidationReport
  */
-export class TerminateJobRunRequest extends Message<TerminateJobRunRequest> {
+export class JobRunValidationReport extends Message<JobRunValidationReport> {
   /**
-   * @generated from field: string job_run_id = 1;
+   * @generated from field: string job_id = 1;
+   */
+  jobId = "";
+
+  /**
+   * @generated from field: string job_run_id = 2;
    */
   jobRunId = "";
 
   /**
-   * @generated from field: string account_id = 2;
+   * True if every table passed each of its checks
+   *
+   * @generated from field: bool passed = 3;
    */
-  accountId = "";
+  passed = false;
 
-  constructor(data?: PartialMessage<TerminateJobRunRequest>) {
+  /**
+   * @generated from field: repeated mgmt.v1alpha1.JobRunTableValidation tables = 4;
+   */
+  tables: JobRunTableValidation[] = [];
+
+  /**
+   * @generated from field: google.protobuf.Timestamp created_at = 5;
+   */
+  createdAt?: Timestamp;
+
+  constructor(data?: PartialMessage<JobRunValidationReport>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "mgmt.v1alpha1.TerminateJobRunRequest";
+  static readonly typeName = "mgmt.v1alpha1.JobRunValidationReport";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "job_run_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 2, name: "account_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 1, name: "job_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "job_run_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "passed", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 4, name: "tables", kind: "message", T: JobRunTableValidation, repeated: true },
+    { no: 5, name: "created_at", kind: "message", T: Timestamp },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): TerminateJobRunRequest {
-    return new TerminateJobRunRequest().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): JobRunValidationReport {
+    return new JobRunValidationReport().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): TerminateJobRunRequest {
-    return new TerminateJobRunRequest().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): JobRunValidationReport {
+    return new JobRunValidationReport().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): TerminateJobRunRequest {
-    return new TerminateJobRunRequest().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): JobRunValidationReport {
+    return new JobRunValidationReport().fromJsonString(jsonString, options);
   }
 
-  static equals(a: TerminateJobRunRequest | PlainMessage<TerminateJobRunRequest> | undefined, b: TerminateJobRunRequest | PlainMessage<TerminateJobRunRequest> | undefined): boolean {
-    return proto3.util.equals(TerminateJobRunRequest, a, b);
+  static equals(a: JobRunValidationReport | PlainMessage<JobRunValidationReport> | undefined, b: JobRunValidationReport | PlainMessage<JobRunValidationReport> | undefined): boolean {
+    return proto3.util.equals(JobRunValidationReport, a, b);
   }
 }
 
 /**
- * @generated from message mgmt.v1alpha1.TerminateJobRunResponse
+ * @generated from message mgmt.v1alpha1.JobRunTableValidation
  */
-export class TerminateJobRunResponse extends Message<TerminateJobRunResponse> {
-  constructor(data?: PartialMessage<TerminateJobRunResponse>) {
+export class JobRunTableValidation extends Message<JobRunTableValidation> {
+  /**
+   * The destination connection that the table was validated against
+   *
+   * @generated from field: string destination_connection_id = 1;
+   */
+  destinationConnectionId = "";
+
+  /**
+   * @generated from field: string schema = 2;
+   */
+  schema = "";
+
+  /**
+   * @generated from field: string table = 3;
+   */
+  table = "";
+
+  /**
+   * The number of rows in the source table, with any subset filter applied
+   *
+   * @generated from field: int64 source_row_count = 4;
+   */
+  sourceRowCount = protoInt64.zero;
+
+  /**
+   * @generated from field: int64 destination_row_count = 5;
+   */
+  destinationRowCount = protoInt64.zero;
+
+  /**
+   * Foreign keys in the destination table along with the number of rows that do not resolve to a referenced row
+   *
+   * @generated from field: repeated mgmt.v1alpha1.JobRunForeignKeyValidation foreign_keys = 6;
+   */
+  foreignKeys: JobRunForeignKeyValidation[] = [];
+
+  /**
+   * Columns mapped to a non-passthrough transformer along with the number of sampled rows whose value was unchanged
+   *
+   * @generated from field: repeated mgmt.v1alpha1.JobRunColumnValidation columns = 7;
+   */
+  columns: JobRunColumnValidation[] = [];
+
+  /**
+   * Set when the column checks could not be run, such as when the table has no primary key to pair source and destination rows with
+   *
+   * @generated from field: optional string columns_skipped_reason = 8;
+   */
+  columnsSkippedReason?: string;
+
+  /**
+   * @generated from field: bool passed = 9;
+   */
+  passed = false;
+
+  constructor(data?: PartialMessage<JobRunTableValidation>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "mgmt.v1alpha1.TerminateJobRunResponse";
+  static readonly typeName = "mgmt.v1alpha1.JobRunTableValidation";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "destination_connection_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "schema", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "table", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "source_row_count", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 5, name: "destination_row_count", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 6, name: "foreign_keys", kind: "message", T: JobRunForeignKeyValidation, repeated: true },
+    { no: 7, name: "columns", kind: "message", T: JobRunColumnValidation, repeated: true },
+    { no: 8, name: "columns_skipped_reason", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+    { no: 9, name: "passed", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): TerminateJobRunResponse {
-    return new TerminateJobRunResponse().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): JobRunTableValidation {
+    return new JobRunTableValidation().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): TerminateJobRunResponse {
-    return new TerminateJobRunResponse().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): JobRunTableValidation {
+    return new JobRunTableValidation().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): TerminateJobRunResponse {
-    return new TerminateJobRunResponse().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): JobRunTableValidation {
+    return new JobRunTableValidation().fromJsonString(jsonString, options);
   }
 
-  static equals(a: TerminateJobRunResponse | PlainMessage<TerminateJobRunResponse> | undefined, b: TerminateJobRunResponse | PlainMessage<TerminateJobRunResponse> | undefined): boolean {
-    return proto3.util.equals(TerminateJobRunResponse, a, b);
+  static equals(a: JobRunTableValidation | PlainMessage<JobRunTableValidation> | undefined, b: JobRunTableValidation | PlainMessage<JobRunTableValidation> | undefined): boolean {
+    return proto3.util.equals(JobRunTableValidation, a, b);
   }
 }
 
 /**
- * @generated from message mgmt.v1alpha1.GetJobRunLogsStreamRequest
+ * @generated from message mgmt.v1alpha1.JobRunForeignKeyValidation
  */
-export class GetJobRunLogsStreamRequest extends Message<GetJobRunLogsStreamRequest> {
+export class JobRunForeignKeyValidation extends Message<JobRunForeignKeyValidation> {
   /**
-   * @generated from field: string job_run_id = 1;
+   * @generated from field: string column = 1;
    */
-  jobRunId = "";
+  column = "";
 
   /**
-   * @generated from field: string account_id = 2;
+   * @generated from field: string foreign_schema = 2;
    */
-  accountId = "";
+  foreignSchema = "";
 
   /**
-   * @generated from field: mgmt.v1alpha1.LogWindow window = 3;
+   * @generated from field: string foreign_table = 3;
    */
-  window = LogWindow.NO_TIME_UNSPECIFIED;
+  foreignTable = "";
 
   /**
-   * @generated from field: bool should_tail = 4;
+   * @generated from field: string foreign_column = 4;
    */
-  shouldTail = false;
+  foreignColumn = "";
 
   /**
-   * @generated from field: optional int64 max_log_lines = 5;
+   * The number of rows whose non-null value is not present in the referenced table
+   *
+   * @generated from field: int64 orphaned_row_count = 5;
    */
-  maxLogLines?: bigint;
+  orphanedRowCount = protoInt64.zero;
 
-  constructor(data?: PartialMessage<GetJobRunLogsStreamRequest>) {
+  constructor(data?: PartialMessage<JobRunForeignKeyValidation>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "mgmt.v1alpha1.GetJobRunLogsStreamRequest";
+  static readonly typeName = "mgmt.v1alpha1.JobRunForeignKeyValidation";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "job_run_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 2, name: "account_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 3, name: "window", kind: "enum", T: proto3.getEnumType(LogWindow) },
-    { no: 4, name: "should_tail", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
-    { no: 5, name: "max_log_lines", kind: "scalar", T: 3 /* ScalarType.INT64 */, opt: true },
+    { no: 1, name: "column", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "foreign_schema", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "foreign_table", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "foreign_column", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 5, name: "orphaned_row_count", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetJobRunLogsStreamRequest {
-    return new GetJobRunLogsStreamRequest().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): JobRunForeignKeyValidation {
+    return new JobRunForeignKeyValidation().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetJobRunLogsStreamRequest {
-    return new GetJobRunLogsStreamRequest().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): JobRunForeignKeyValidation {
+    return new JobRunForeignKeyValidation().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetJobRunLogsStreamRequest {
-    return new GetJobRunLogsStreamRequest().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): JobRunForeignKeyValidation {
+    return new JobRunForeignKeyValidation().fromJsonString(jsonString, options);
   }
 
-  static equals(a: GetJobRunLogsStreamRequest | PlainMessage<GetJobRunLogsStreamRequest> | undefined, b: GetJobRunLogsStreamRequest | PlainMessage<GetJobRunLogsStreamRequest> | undefined): boolean {
-    return proto3.util.equals(GetJobRunLogsStreamRequest, a, b);
+  static equals(a: JobRunForeignKeyValidation | PlainMessage<JobRunForeignKeyValidation> | undefined, b: JobRunForeignKeyValidation | PlainMessage<JobRunForeignKeyValidation> | undefined): boolean {
+    return proto3.util.equals(JobRunForeignKeyValidation, a, b);
   }
 }
 
 /**
- * @generated from message mgmt.v1alpha1.GetJobRunLogsStreamResponse
+ * @generated from message mgmt.v1alpha1.JobRunColumnValidation
  */
-export class GetJobRunLogsStreamResponse extends Message<GetJobRunLogsStreamResponse> {
+export class JobRunColumnValidation extends Message<JobRunColumnValidation> {
   /**
-   * @generated from field: string log_line = 1;
+   * @generated from field: string column = 1;
    */
-  logLine = "";
+  column = "";
 
-  constructor(data?: PartialMessage<GetJobRunLogsStreamResponse>) {
+  /**
+   * @generated from field: mgmt.v1alpha1.TransformerSource transformer_source = 2;
+   */
+  transformerSource = TransformerSource.UNSPECIFIED;
+
+  /**
+   * The number of rows that were present in both the source and the destination
+   *
+   * @generated from field: int64 sampled_row_count = 3;
+   */
+  sampledRowCount = protoInt64.zero;
+
+  /**
+   * The number of sampled rows whose non-null destination value is equal to the source value
+   *
+   * @generated from field: int64 unchanged_row_count = 4;
+   */
+  unchangedRowCount = protoInt64.zero;
+
+  constructor(data?: PartialMessage<JobRunColumnValidation>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "mgmt.v1alpha1.GetJobRunLogsStreamResponse";
+  static readonly typeName = "mgmt.v1alpha1.JobRunColumnValidation";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "log_line", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 1, name: "column", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "transformer_source", kind: "enum", T: proto3.getEnumType(TransformerSource) },
+    { no: 3, name: "sampled_row_count", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 4, name: "unchanged_row_count", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetJobRunLogsStreamResponse {
-    return new GetJobRunLogsStreamResponse().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): JobRunColumnValidation {
+    return new JobRunColumnValidation().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetJobRunLogsStreamResponse {
-    return new GetJobRunLogsStreamResponse().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): JobRunColumnValidation {
+    return new JobRunColumnValidation().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetJobRunLogsStreamResponse {
-    return new GetJobRunLogsStreamResponse().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): JobRunColumnValidation {
+    return new JobRunColumnValidation().fromJsonString(jsonString, options);
   }
 
-  static equals(a: GetJobRunLogsStreamResponse | PlainMessage<GetJobRunLogsStreamResponse> | undefined, b: GetJobRunLogsStreamResponse | PlainMessage<GetJobRunLogsStreamResponse> | undefined): boolean {
-    return proto3.util.equals(GetJobRunLogsStreamResponse, a, b);
+  static equals(a: JobRunColumnValidation | PlainMessage<JobRunColumnValidation> | undefined, b: JobRunColumnValidation | PlainMessage<JobRunColumnValidation> | undefined): boolean {
+    return proto3.util.equals(JobRunColumnValidation, a, b);
   }
 }
 
 /**
- * @generated from message mgmt.v1alpha1.SetJobWorkflowOptionsRequest
+ * @generated from message mgmt.v1alpha1.SetJobRunValidationReportRequest
  */
-export class SetJobWorkflowOptionsRequest extends Message<SetJobWorkflowOptionsRequest> {
+export class SetJobRunValidationReportRequest extends Message<SetJobRunValidationReportRequest> {
   /**
-   * The unique identifier of the job
-   *
-   * @generated from field: string id = 1;
+   * @generated from field: string job_id = 1;
    */
-  id = "";
+  jobId = "";
 
   /**
-   * The workflow options object. The entire object must be provided and will fully overwrite the previous result
-   *
-   * @generated from field: mgmt.v1alpha1.WorkflowOptions worfklow_options = 2;
+   * @generated from field: string job_run_id = 2;
    */
-  worfklowOptions?: WorkflowOptions;
+  jobRunId = "";
 
-  constructor(data?: PartialMessage<SetJobWorkflowOptionsRequest>) {
+  /**
+   * @generated from field: repeated mgmt.v1alpha1.JobRunTableValidation tables = 3;
+   */
+  tables: JobRunTableValidation[] = [];
+
+  constructor(data?: PartialMessage<SetJobRunValidationReportRequest>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "mgmt.v1alpha1.SetJobWorkflowOptionsRequest";
+  static readonly typeName = "mgmt.v1alpha1.SetJobRunValidationReportRequest";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 2, name: "worfklow_options", kind: "message", T: WorkflowOptions },
+    { no: 1, name: "job_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "job_run_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "tables", kind: "message", T: JobRunTableValidation, repeated: true },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SetJobWorkflowOptionsRequest {
-    return new SetJobWorkflowOptionsRequest().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SetJobRunValidationReportRequest {
+    return new SetJobRunValidationReportRequest().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SetJobWorkflowOptionsRequest {
-    return new SetJobWorkflowOptionsRequest().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SetJobRunValidationReportRequest {
+    return new SetJobRunValidationReportRequest().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SetJobWorkflowOptionsRequest {
-    return new SetJobWorkflowOptionsRequest().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SetJobRunValidationReportRequest {
+    return new SetJobRunValidationReportRequest().fromJsonString(jsonString, options);
   }
 
-  static equals(a: SetJobWorkflowOptionsRequest | PlainMessage<SetJobWorkflowOptionsRequest> | undefined, b: SetJobWorkflowOptionsRequest | PlainMessage<SetJobWorkflowOptionsRequest> | undefined): boolean {
-    return proto3.util.equals(SetJobWorkflowOptionsRequest, a, b);
+  static equals(a: SetJobRunValidationReportRequest | PlainMessage<SetJobRunValidationReportRequest> | undefined, b: SetJobRunValidationReportRequest | PlainMessage<SetJobRunValidationReportRequest> | undefined): boolean {
+    return proto3.util.equals(SetJobRunValidationReportRequest, a, b);
   }
 }
 
 /**
- * @generated from message mgmt.v1alpha1.SetJobWorkflowOptionsResponse
+ * @generated from message mgmt.v1alpha1.SetJobRunValidationReportResponse
  */
-export class SetJobWorkflowOptionsResponse extends Message<SetJobWorkflowOptionsResponse> {
-  /**
-   * @generated from field: mgmt.v1alpha1.Job job = 1;
-   */
-  job?: Job;
-
-  constructor(data?: PartialMessage<SetJobWorkflowOptionsResponse>) {
+export class SetJobRunValidationReportResponse extends Message<SetJobRunValidationReportResponse> {
+  constructor(data?: PartialMessage<SetJobRunValidationReportResponse>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "mgmt.v1alpha1.SetJobWorkflowOptionsResponse";
+  static readonly typeName = "mgmt.v1alpha1.SetJobRunValidationReportResponse";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "job", kind: "message", T: Job },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SetJobWorkflowOptionsResponse {
-    return new SetJobWorkflowOptionsResponse().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SetJobRunValidationReportResponse {
+    return new SetJobRunValidationReportResponse().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SetJobWorkflowOptionsResponse {
-    return new SetJobWorkflowOptionsResponse().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SetJobRunValidationReportResponse {
+    return new SetJobRunValidationReportResponse().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SetJobWorkflowOptionsResponse {
-    return new SetJobWorkflowOptionsResponse().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SetJobRunValidationReportResponse {
+    return new SetJobRunValidationReportResponse().fromJsonString(jsonString, options);
   }
 
-  static equals(a: SetJobWorkflowOptionsResponse | PlainMessage<SetJobWorkflowOptionsResponse> | undefined, b: SetJobWorkflowOptionsResponse | PlainMessage<SetJobWorkflowOptionsResponse> | undefined): boolean {
-    return proto3.util.equals(SetJobWorkflowOptionsResponse, a, b);
+  static equals(a: SetJobRunValidationReportResponse | PlainMessage<SetJobRunValidationReportResponse> | undefined, b: SetJobRunValidationReportResponse | PlainMessage<SetJobRunValidationReportResponse> | undefined): boolean {
+    return proto3.util.equals(SetJobRunValidationReportResponse, a, b);
   }
 }
 
 /**
- * @generated from message mgmt.v1alpha1.SetJobSyncOptionsRequest
+ * @generated from message mgmt.v1alpha1.GetJobRunValidationReportRequest
  */
-export class SetJobSyncOptionsRequest extends Message<SetJobSyncOptionsRequest> {
+export class GetJobRunValidationReportRequest extends Message<GetJobRunValidationReportRequest> {
   /**
-   * The unique identifier of the job
-   *
-   * @generated from field: string id = 1;
+   * @generated from field: string job_id = 1;
    */
-  id = "";
+  jobId = "";
 
   /**
-   * The sync options object. The entire object must be provided and will fully overwrite the previous result
-   *
-   * @generated from field: mgmt.v1alpha1.ActivityOptions sync_options = 2;
+   * @generated from field: string job_run_id = 2;
    */
-  syncOptions?: ActivityOptions;
+  jobRunId = "";
 
-  constructor(data?: PartialMessage<SetJobSyncOptionsRequest>) {
+  constructor(data?: PartialMessage<GetJobRunValidationReportRequest>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "mgmt.v1alpha1.SetJobSyncOptionsRequest";
+  static readonly typeName = "mgmt.v1alpha1.GetJobRunValidationReportRequest";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 2, name: "sync_options", kind: "message", T: ActivityOptions },
+    { no: 1, name: "job_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "job_run_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SetJobSyncOptionsRequest {
-    return new SetJobSyncOptionsRequest().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetJobRunValidationReportRequest {
+    return new GetJobRunValidationReportRequest().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SetJobSyncOptionsRequest {
-    return new SetJobSyncOptionsRequest().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetJobRunValidationReportRequest {
+    return new GetJobRunValidationReportRequest().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SetJobSyncOptionsRequest {
-    return new SetJobSyncOptionsRequest().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetJobRunValidationReportRequest {
+    return new GetJobRunValidationReportRequest().fromJsonString(jsonString, options);
   }
 
-  static equals(a: SetJobSyncOptionsRequest | PlainMessage<SetJobSyncOptionsRequest> | undefined, b: SetJobSyncOptionsRequest | PlainMessage<SetJobSyncOptionsRequest> | undefined): boolean {
-    return proto3.util.equals(SetJobSyncOptionsRequest, a, b);
+  static equals(a: GetJobRunValidationReportRequest | PlainMessage<GetJobRunValidationReportRequest> | undefined, b: GetJobRunValidationReportRequest | PlainMessage<GetJobRunValidationReportRequest> | undefined): boolean {
+    return proto3.util.equals(GetJobRunValidationReportRequest, a, b);
   }
 }
 
 /**
- * @generated from message mgmt.v1alpha1.SetJobSyncOptionsResponse
+ * @generated from message mgmt.v1alpha1.GetJobRunValidationReportResponse
  */
-export class SetJobSyncOptionsResponse extends Message<SetJobSyncOptionsResponse> {
+export class GetJobRunValidationReportResponse extends Message<GetJobRunValidationReportResponse> {
   /**
-   * @generated from field: mgmt.v1alpha1.Job job = 1;
+   * @generated from field: mgmt.v1alpha1.JobRunValidationReport report = 1;
    */
-  job?: Job;
+  report?: JobRunValidationReport;
+
+  constructor(data?: PartialMessage<GetJobRunValidationReportResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "mgmt.v1alpha1.GetJobRunValidationReportResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "report", kind: "message", T: JobRunValidationReport },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetJobRunValidationReportResponse {
+    return new GetJobRunValidationReportResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetJobRunValidationReportResponse {
+    return new GetJobRunValidationReportResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetJobRunValidationReportResponse {
+    return new GetJobRunValidationReportResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GetJobRunValidationReportResponse | PlainMessage<GetJobRunValidationReportResponse> | undefined, b: GetJobRunValidationReportResponse | PlainMessage<GetJobRunValidationReportResponse> | undefined): boolean {
+    return proto3.util.equals(GetJobRunValidationReportResponse, a, b);
+  }
+}
+
+/**
+ * The differences between the source columns at the start of a job run and the job mappings or the columns seen by the previous run
+ *
+ * @generated from message mgmt.v1alpha1.JobRunSchemaDriftReport
+ */
+export class JobRunSchemaDriftReport extends Message<JobRunSchemaDriftReport> {
+  /**
+   * @generated from field: string job_id = 1;
+   */
+  jobId = "";
+
+  /**
+   * @generated from field: string job_run_id = 2;
+   */
+  jobRunId = "";
+
+  /**
+   * True if the run was halted because of one of the changes
+   *
+   * @generated from field: bool halted = 3;
+   */
+  halted = false;
+
+  /**
+   * @generated from field: repeated mgmt.v1alpha1.SchemaDriftChange changes = 4;
+   */
+  changes: SchemaDriftChange[] = [];
+
+  /**
+   * The source columns of the mapped schemas at the start of the run. Used as the baseline to detect type changes and renames in the next run
+   *
+   * @generated from field: repeated mgmt.v1alpha1.SchemaDriftColumn columns = 5;
+   */
+  columns: SchemaDriftColumn[] = [];
+
+  /**
+   * @generated from field: google.protobuf.Timestamp created_at = 6;
+   */
+  createdAt?: Timestamp;
+
+  constructor(data?: PartialMessage<JobRunSchemaDriftReport>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "mgmt.v1alpha1.JobRunSchemaDriftReport";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "job_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "job_run_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "halted", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 4, name: "changes", kind: "message", T: SchemaDriftChange, repeated: true },
+    { no: 5, name: "columns", kind: "message", T: SchemaDriftColumn, repeated: true },
+    { no: 6, name: "created_at", kind: "message", T: Timestamp },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): JobRunSchemaDriftReport {
+    return new JobRunSchemaDriftReport().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): JobRunSchemaDriftReport {
+    return new JobRunSchemaDriftReport().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): JobRunSchemaDriftReport {
+    return new JobRunSchemaDriftReport().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: JobRunSchemaDriftReport | PlainMessage<JobRunSchemaDriftReport> | undefined, b: JobRunSchemaDriftReport | PlainMessage<JobRunSchemaDriftReport> | undefined): boolean {
+    return proto3.util.equals(JobRunSchemaDriftReport, a, b);
+  }
+}
+
+/**
+ * @generated from message mgmt.v1alpha1.SchemaDriftChange
+ */
+export class SchemaDriftChange extends Message<SchemaDriftChange> {
+  /**
+   * @generated from field: string schema = 1;
+   */
+  schema = "";
+
+  /**
+   * @generated from field: string table = 2;
+   */
+  table = "";
+
+  /**
+   * The name of the column in the source. For renames, this is the new name of the column
+   *
+   * @generated from field: string column = 3;
+   */
+  column = "";
+
+  /**
+   * @generated from field: mgmt.v1alpha1.SchemaDriftChangeType type = 4;
+   */
+  type = SchemaDriftChangeType.UNSPECIFIED;
+
+  /**
+   * The mapped column that was renamed
+   *
+   * @generated from field: optional string renamed_from = 5;
+   */
+  renamedFrom?: string;
+
+  /**
+   * The data type of the column in the previous run
+   *
+   * @generated from field: optional string previous_data_type = 6;
+   */
+  previousDataType?: string;
+
+  /**
+   * The data type of the column in the source. Not set if the column was removed
+   *
+   * @generated from field: optional string data_type = 7;
+   */
+  dataType?: string;
+
+  /**
+   * What the run did with the change, as determined by the schema drift policies of the job
+   *
+   * @generated from field: mgmt.v1alpha1.SchemaDriftAction action = 8;
+   */
+  action = SchemaDriftAction.UNSPECIFIED;
+
+  /**
+   * The transformer that is used when a mapping is added for the column by the run or by applying the report to the job
+   *
+   * @generated from field: optional mgmt.v1alpha1.JobMappingTransformer suggested_transformer = 9;
+   */
+  suggestedTransformer?: JobMappingTransformer;
+
+  constructor(data?: PartialMessage<SchemaDriftChange>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "mgmt.v1alpha1.SchemaDriftChange";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "schema", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "table", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "column", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "type", kind: "enum", T: proto3.getEnumType(SchemaDriftChangeType) },
+    { no: 5, name: "renamed_from", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+    { no: 6, name: "previous_data_type", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+    { no: 7, name: "data_type", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+    { no: 8, name: "action", kind: "enum", T: proto3.getEnumType(SchemaDriftAction) },
+    { no: 9, name: "suggested_transformer", kind: "message", T: JobMappingTransformer, opt: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SchemaDriftChange {
+    return new SchemaDriftChange().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SchemaDriftChange {
+    return new SchemaDriftChange().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SchemaDriftChange {
+    return new SchemaDriftChange().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: SchemaDriftChange | PlainMessage<SchemaDriftChange> | undefined, b: SchemaDriftChange | PlainMessage<SchemaDriftChange> | undefined): boolean {
+    return proto3.util.equals(SchemaDriftChange, a, b);
+  }
+}
+
+/**
+ * @generated from message mgmt.v1alpha1.SchemaDriftColumn
+ */
+export class SchemaDriftColumn extends Message<SchemaDriftColumn> {
+  /**
+   * @generated from field: string schema = 1;
+   */
+  schema = "";
+
+  /**
+   * @generated from field: string table = 2;
+   */
+  table = "";
+
+  /**
+   * @generated from field: string column = 3;
+   */
+  column = "";
+
+  /**
+   * @generated from field: string data_type = 4;
+   */
+  dataType = "";
+
+  constructor(data?: PartialMessage<SchemaDriftColumn>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "mgmt.v1alpha1.SchemaDriftColumn";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "schema", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "table", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "column", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "data_type", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SchemaDriftColumn {
+    return new SchemaDriftColumn().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SchemaDriftColumn {
+    return new SchemaDriftColumn().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SchemaDriftColumn {
+    return new SchemaDriftColumn().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: SchemaDriftColumn | PlainMessage<SchemaDriftColumn> | undefined, b: SchemaDriftColumn | PlainMessage<SchemaDriftColumn> | undefined): boolean {
+    return proto3.util.equals(SchemaDriftColumn, a, b);
+  }
+}
+
+/**
+ * @generated from message mgmt.v1alpha1.SetJobRunSchemaDriftReportRequest
+ */
+export class SetJobRunSchemaDriftReportRequest extends Message<SetJobRunSchemaDriftReportRequest> {
+  /**
+   * @generated from field: string job_id = 1;
+   */
+  jobId = "";
+
+  /**
+   * @generated from field: string job_run_id = 2;
+   */
+  jobRunId = "";
+
+  /**
+   * @generated from field: bool halted = 3;
+   */
+  halted = false;
+
+  /**
+   * @generated from field: repeated mgmt.v1alpha1.SchemaDriftChange changes = 4;
+   */
+  changes: SchemaDriftChange[] = [];
+
+  /**
+   * @generated from field: repeated mgmt.v1alpha1.SchemaDriftColumn columns = 5;
+   */
+  columns: SchemaDriftColumn[] = [];
+
+  constructor(data?: PartialMessage<SetJobRunSchemaDriftReportRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "mgmt.v1alpha1.SetJobRunSchemaDriftReportRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "job_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "job_run_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "halted", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 4, name: "changes", kind: "message", T: SchemaDriftChange, repeated: true },
+    { no: 5, name: "columns", kind: "message", T: SchemaDriftColumn, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SetJobRunSchemaDriftReportRequest {
+    return new SetJobRunSchemaDriftReportRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SetJobRunSchemaDriftReportRequest {
+    return new SetJobRunSchemaDriftReportRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SetJobRunSchemaDriftReportRequest {
+    return new SetJobRunSchemaDriftReportRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: SetJobRunSchemaDriftReportRequest | PlainMessage<SetJobRunSchemaDriftReportRequest> | undefined, b: SetJobRunSchemaDriftReportRequest | PlainMessage<SetJobRunSchemaDriftReportRequest> | undefined): boolean {
+    return proto3.util.equals(SetJobRunSchemaDriftReportRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message mgmt.v1alpha1.SetJobRunSchemaDriftReportResponse
+ */
+export class SetJobRunSchemaDriftReportResponse extends Message<SetJobRunSchemaDriftReportResponse> {
+  constructor(data?: PartialMessage<SetJobRunSchemaDriftReportResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "mgmt.v1alpha1.SetJobRunSchemaDriftReportResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SetJobRunSchemaDriftReportResponse {
+    return new SetJobRunSchemaDriftReportResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SetJobRunSchemaDriftReportResponse {
+    return new SetJobRunSchemaDriftReportResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SetJobRunSchemaDriftReportResponse {
+    return new SetJobRunSchemaDriftReportResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: SetJobRunSchemaDriftReportResponse | PlainMessage<SetJobRunSchemaDriftReportResponse> | undefined, b: SetJobRunSchemaDriftReportResponse | PlainMessage<SetJobRunSchemaDriftReportResponse> | undefined): boolean {
+    return proto3.util.equals(SetJobRunSchemaDriftReportResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message mgmt.v1alpha1.GetJobRunSchemaDriftReportRequest
+ */
+export class GetJobRunSchemaDriftReportRequest extends Message<GetJobRunSchemaDriftReportRequest> {
+  /**
+   * @generated from field: string job_id = 1;
+   */
+  jobId = "";
+
+  /**
+   * Returns the report of the most recent run of the job if not provided
+   *
+   * @generated from field: optional string job_run_id = 2;
+   */
+  jobRunId?: string;
+
+  constructor(data?: PartialMessage<GetJobRunSchemaDriftReportRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "mgmt.v1alpha1.GetJobRunSchemaDriftReportRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "job_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "job_run_id", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetJobRunSchemaDriftReportRequest {
+    return new GetJobRunSchemaDriftReportRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetJobRunSchemaDriftReportRequest {
+    return new GetJobRunSchemaDriftReportRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetJobRunSchemaDriftReportRequest {
+    return new GetJobRunSchemaDriftReportRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GetJobRunSchemaDriftReportRequest | PlainMessage<GetJobRunSchemaDriftReportRequest> | undefined, b: GetJobRunSchemaDriftReportRequest | PlainMessage<GetJobRunSchemaDriftReportRequest> | undefined): boolean {
+    return proto3.util.equals(GetJobRunSchemaDriftReportRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message mgmt.v1alpha1.GetJobRunSchemaDriftReportResponse
+ */
+export class GetJobRunSchemaDriftReportResponse extends Message<GetJobRunSchemaDriftReportResponse> {
+  /**
+   * @generated from field: mgmt.v1alpha1.JobRunSchemaDriftReport report = 1;
+   */
+  report?: JobRunSchemaDriftReport;
+
+  constructor(data?: PartialMessage<GetJobRunSchemaDriftReportResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "mgmt.v1alpha1.GetJobRunSchemaDriftReportResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "report", kind: "message", T: JobRunSchemaDriftReport },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetJobRunSchemaDriftReportResponse {
+    return new GetJobRunSchemaDriftReportResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetJobRunSchemaDriftReportResponse {
+    return new GetJobRunSchemaDriftReportResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetJobRunSchemaDriftReportResponse {
+    return new GetJobRunSchemaDriftReportResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GetJobRunSchemaDriftReportResponse | PlainMessage<GetJobRunSchemaDriftReportResponse> | undefined, b: GetJobRunSchemaDriftReportResponse | PlainMessage<GetJobRunSchemaDriftReportResponse> | undefined): boolean {
+    return proto3.util.equals(GetJobRunSchemaDriftReportResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message mgmt.v1alpha1.ApplyJobRunSchemaDriftReportRequest
+ */
+export class ApplyJobRunSchemaDriftReportRequest extends Message<ApplyJobRunSchemaDriftReportRequest> {
+  /**
+   * @generated from field: string job_id = 1;
+   */
+  jobId = "";
+
+  /**
+   * @generated from field: string job_run_id = 2;
+   */
+  jobRunId = "";
+
+  constructor(data?: PartialMessage<ApplyJobRunSchemaDriftReportRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "mgmt.v1alpha1.ApplyJobRunSchemaDriftReportRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "job_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "job_run_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ApplyJobRunSchemaDriftReportRequest {
+    return new ApplyJobRunSchemaDriftReportRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ApplyJobRunSchemaDriftReportRequest {
+    return new ApplyJobRunSchemaDriftReportRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ApplyJobRunSchemaDriftReportRequest {
+    return new ApplyJobRunSchemaDriftReportRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ApplyJobRunSchemaDriftReportRequest | PlainMessage<ApplyJobRunSchemaDriftReportRequest> | undefined, b: ApplyJobRunSchemaDriftReportRequest | PlainMessage<ApplyJobRunSchemaDriftReportRequest> | undefined): boolean {
+    return proto3.util.equals(ApplyJobRunSchemaDriftReportRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message mgmt.v1alpha1.ApplyJobRunSchemaDriftReportResponse
+ */
+export class ApplyJobRunSchemaDriftReportResponse extends Message<ApplyJobRunSchemaDriftReportResponse> {
+  /**
+   * The job with the updated mappings
+   *
+   * @generated from field: mgmt.v1alpha1.Job job = 1;
+   */
+  job?: Job;
+
+  constructor(data?: PartialMessage<ApplyJobRunSchemaDriftReportResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "mgmt.v1alpha1.ApplyJobRunSchemaDriftReportResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "job", kind: "message", T: Job },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ApplyJobRunSchemaDriftReportResponse {
+    return new ApplyJobRunSchemaDriftReportResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ApplyJobRunSchemaDriftReportResponse {
+    return new ApplyJobRunSchemaDriftReportResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ApplyJobRunSchemaDriftReportResponse {
+    return new ApplyJobRunSchemaDriftReportResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ApplyJobRunSchemaDriftReportResponse | PlainMessage<ApplyJobRunSchemaDriftReportResponse> | undefined, b: ApplyJobRunSchemaDriftReportResponse | PlainMessage<ApplyJobRunSchemaDriftReportResponse> | undefined): boolean {
+    return proto3.util.equals(ApplyJobRunSchemaDriftReportResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message mgmt.v1alpha1.PreviewJobMappingsRequest
+ */
+export class PreviewJobMappingsRequest extends Message<PreviewJobMappingsRequest> {
+  /**
+   * @generated from field: string account_id = 1;
+   */
+  accountId = "";
+
+  /**
+   * @generated from oneof mgmt.v1alpha1.PreviewJobMappingsRequest.source
+   */
+  source: {
+    /**
+     * Previews the mappings of an existing job against its source connection
+     *
+     * @generated from field: string job_id = 2;
+     */
+    value: string;
+    case: "jobId";
+  } | {
+    /**
+     * Previews a set of mappings that have not been saved to a job yet
+     *
+     * @generated from field: mgmt.v1alpha1.JobMappingsPreviewSource mappings = 3;
+     */
+    value: JobMappingsPreviewSource;
+    case: "mappings";
+  } | { case: undefined; value?: undefined } = { case: undefined };
+
+  /**
+   * The number of rows to pull from each table. Defaults to 10
+   *
+   * @generated from field: optional uint32 row_count = 4;
+   */
+  rowCount?: number;
+
+  constructor(data?: PartialMessage<PreviewJobMappingsRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "mgmt.v1alpha1.PreviewJobMappingsRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "account_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "job_id", kind: "scalar", T: 9 /* ScalarType.STRING */, oneof: "source" },
+    { no: 3, name: "mappings", kind: "message", T: JobMappingsPreviewSource, oneof: "source" },
+    { no: 4, name: "row_count", kind: "scalar", T: 13 /* ScalarType.UINT32 */, opt: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): PreviewJobMappingsRequest {
+    return new PreviewJobMappingsRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): PreviewJobMappingsRequest {
+    return new PreviewJobMappingsRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): PreviewJobMappingsRequest {
+    return new PreviewJobMappingsRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: PreviewJobMappingsRequest | PlainMessage<PreviewJobMappingsRequest> | undefined, b: PreviewJobMappingsRequest | PlainMessage<PreviewJobMappingsRequest> | undefined): boolean {
+    return proto3.util.equals(PreviewJobMappingsRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message mgmt.v1alpha1.JobMappingsPreviewSource
+ */
+export class JobMappingsPreviewSource extends Message<JobMappingsPreviewSource> {
+  /**
+   * The connection the rows are pulled from
+   *
+   * @generated from field: string connection_id = 1;
+   */
+  connectionId = "";
+
+  /**
+   * @generated from field: repeated mgmt.v1alpha1.JobMapping mappings = 2;
+   */
+  mappings: JobMapping[] = [];
+
+  constructor(data?: PartialMessage<JobMappingsPreviewSource>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "mgmt.v1alpha1.JobMappingsPreviewSource";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "connection_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "mappings", kind: "message", T: JobMapping, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): JobMappingsPreviewSource {
+    return new JobMappingsPreviewSource().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): JobMappingsPreviewSource {
+    return new JobMappingsPreviewSource().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): JobMappingsPreviewSource {
+    return new JobMappingsPreviewSource().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: JobMappingsPreviewSource | PlainMessage<JobMappingsPreviewSource> | undefined, b: JobMappingsPreviewSource | PlainMessage<JobMappingsPreviewSource> | undefined): boolean {
+    return proto3.util.equals(JobMappingsPreviewSource, a, b);
+  }
+}
+
+/**
+ * @generated from message mgmt.v1alpha1.PreviewJobMappingsResponse
+ */
+export class PreviewJobMappingsResponse extends Message<PreviewJobMappingsResponse> {
+  /**
+   * @generated from field: repeated mgmt.v1alpha1.JobMappingsTablePreview tables = 1;
+   */
+  tables: JobMappingsTablePreview[] = [];
+
+  constructor(data?: PartialMessage<PreviewJobMappingsResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "mgmt.v1alpha1.PreviewJobMappingsResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "tables", kind: "message", T: JobMappingsTablePreview, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): PreviewJobMappingsResponse {
+    return new PreviewJobMappingsResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): PreviewJobMappingsResponse {
+    return new PreviewJobMappingsResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): PreviewJobMappingsResponse {
+    return new PreviewJobMappingsResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: PreviewJobMappingsResponse | PlainMessage<PreviewJobMappingsResponse> | undefined, b: PreviewJobMappingsResponse | PlainMessage<PreviewJobMappingsResponse> | undefined): boolean {
+    return proto3.util.equals(PreviewJobMappingsResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message mgmt.v1alpha1.JobMappingsTablePreview
+ */
+export class JobMappingsTablePreview extends Message<JobMappingsTablePreview> {
+  /**
+   * @generated from field: string schema = 1;
+   */
+  schema = "";
+
+  /**
+   * @generated from field: string table = 2;
+   */
+  table = "";
+
+  /**
+   * The mapped columns of the table, in the order they appear in the job mappings
+   *
+   * @generated from field: repeated string columns = 3;
+   */
+  columns: string[] = [];
+
+  /**
+   * @generated from field: repeated mgmt.v1alpha1.JobMappingsPreviewRow rows = 4;
+   */
+  rows: JobMappingsPreviewRow[] = [];
+
+  constructor(data?: PartialMessage<JobMappingsTablePreview>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "mgmt.v1alpha1.JobMappingsTablePreview";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "schema", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "table", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "columns", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+    { no: 4, name: "rows", kind: "message", T: JobMappingsPreviewRow, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): JobMappingsTablePreview {
+    return new JobMappingsTablePreview().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): JobMappingsTablePreview {
+    return new JobMappingsTablePreview().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): JobMappingsTablePreview {
+    return new JobMappingsTablePreview().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: JobMappingsTablePreview | PlainMessage<JobMappingsTablePreview> | undefined, b: JobMappingsTablePreview | PlainMessage<JobMappingsTablePreview> | undefined): boolean {
+    return proto3.util.equals(JobMappingsTablePreview, a, b);
+  }
+}
+
+/**
+ * @generated from message mgmt.v1alpha1.JobMappingsPreviewRow
+ */
+export class JobMappingsPreviewRow extends Message<JobMappingsPreviewRow> {
+  /**
+   * A map of column name to the value that was read from the source. Null values are omitted
+   *
+   * @generated from field: map<string, bytes> before = 1;
+   */
+  before: { [key: string]: Uint8Array } = {};
+
+  /**
+   * A map of column name to the value after the job mappings have been applied. Null values are omitted
+   *
+   * @generated from field: map<string, bytes> after = 2;
+   */
+  after: { [key: string]: Uint8Array } = {};
+
+  constructor(data?: PartialMessage<JobMappingsPreviewRow>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "mgmt.v1alpha1.JobMappingsPreviewRow";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "before", kind: "map", K: 9 /* ScalarType.STRING */, V: {kind: "scalar", T: 12 /* ScalarType.BYTES */} },
+    { no: 2, name: "after", kind: "map", K: 9 /* ScalarType.STRING */, V: {kind: "scalar", T: 12 /* ScalarType.BYTES */} },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): JobMappingsPreviewRow {
+    return new JobMappingsPreviewRow().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): JobMappingsPreviewRow {
+    return new JobMappingsPreviewRow().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): JobMappingsPreviewRow {
+    return new JobMappingsPreviewRow().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: JobMappingsPreviewRow | PlainMessage<JobMappingsPreviewRow> | undefined, b: JobMappingsPreviewRow | PlainMessage<JobMappingsPreviewRow> | undefined): boolean {
+    return proto3.util.equals(JobMappingsPreviewRow, a, b);
+  }
+}
+
+/**
+ * @generated from message mgmt.v1alpha1.JobRunEventTaskError
+ */
+export class JobRunEventTaskError extends Message<JobRunEventTaskError> {
+  /**
+   * @generated from field: string message = 1;
+   */
+  message = "";
+
+  /**
+   * @generated from field: string retry_state = 2;
+   */
+  retryState = "";
+
+  constructor(data?: PartialMessage<JobRunEventTaskError>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "mgmt.v1alpha1.JobRunEventTaskError";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "message", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "retry_state", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): JobRunEventTaskError {
+    return new JobRunEventTaskError().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): JobRunEventTaskError {
+    return new JobRunEventTaskError().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): JobRunEventTaskError {
+    return new JobRunEventTaskError().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: JobRunEventTaskError | PlainMessage<JobRunEventTaskError> | undefined, b: JobRunEventTaskError | PlainMessage<JobRunEventTaskError> | undefined): boolean {
+    return proto3.util.equals(JobRunEventTaskError, a, b);
+  }
+}
+
+/**
+ * @generated from message mgmt.v1alpha1.JobRunEventTask
+ */
+export class JobRunEventTask extends Message<JobRunEventTask> {
+  /**
+   * @generated from field: int64 id = 1;
+   */
+  id = protoInt64.zero;
+
+  /**
+   * @generated from field: string type = 2;
+   */
+  type = "";
+
+  /**
+   * @generated from field: google.protobuf.Timestamp event_time = 3;
+   */
+  eventTime?: Timestamp;
+
+  /**
+   * @generated from field: mgmt.v1alpha1.JobRunEventTaskError error = 4;
+   */
+  error?: JobRunEventTaskError;
+
+  constructor(data?: PartialMessage<JobRunEventTask>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "mgmt.v1alpha1.JobRunEventTask";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "id", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 2, name: "type", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "event_time", kind: "message", T: Timestamp },
+    { no: 4, name: "error", kind: "message", T: JobRunEventTaskError },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): JobRunEventTask {
+    return new JobRunEventTask().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): JobRunEventTask {
+    return new JobRunEventTask().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): JobRunEventTask {
+    return new JobRunEventTask().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: JobRunEventTask | PlainMessage<JobRunEventTask> | undefined, b: JobRunEventTask | PlainMessage<JobRunEventTask> | undefined): boolean {
+    return proto3.util.equals(JobRunEventTask, a, b);
+  }
+}
+
+/**
+ * @generated from message mgmt.v1alpha1.JobRunSyncMetadata
+ */
+export class JobRunSyncMetadata extends Message<JobRunSyncMetadata> {
+  /**
+   * @generated from field: string schema = 1;
+   */
+  schema = "";
+
+  /**
+   * @generated from field: string table = 2;
+   */
+  table = "";
+
+  constructor(data?: PartialMessage<JobRunSyncMetadata>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "mgmt.v1alpha1.JobRunSyncMetadata";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "schema", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "table", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): JobRunSyncMetadata {
+    return new JobRunSyncMetadata().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): JobRunSyncMetadata {
+    return new JobRunSyncMetadata().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): JobRunSyncMetadata {
+    return new JobRunSyncMetadata().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: JobRunSyncMetadata | PlainMessage<JobRunSyncMetadata> | undefined, b: JobRunSyncMetadata | PlainMessage<JobRunSyncMetadata> | undefined): boolean {
+    return proto3.util.equals(JobRunSyncMetadata, a, b);
+  }
+}
+
+/**
+ * @generated from message mgmt.v1alpha1.JobRunEventMetadata
+ */
+export class JobRunEventMetadata extends Message<JobRunEventMetadata> {
+  /**
+   * @generated from oneof mgmt.v1alpha1.JobRunEventMetadata.metadata
+   */
+  metadata: {
+    /**
+     * @generated from field: mgmt.v1alpha1.JobRunSyncMetadata sync_metadata = 1;
+     */
+    value: JobRunSyncMetadata;
+    case: "syncMetadata";
+  } | { case: undefined; value?: undefined } = { case: undefined };
+
+  constructor(data?: PartialMessage<JobRunEventMetadata>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "mgmt.v1alpha1.JobRunEventMetadata";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "sync_metadata", kind: "message", T: JobRunSyncMetadata, oneof: "metadata" },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): JobRunEventMetadata {
+    return new JobRunEventMetadata().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): JobRunEventMetadata {
+    return new JobRunEventMetadata().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): JobRunEventMetadata {
+    return new JobRunEventMetadata().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: JobRunEventMetadata | PlainMessage<JobRunEventMetadata> | undefined, b: JobRunEventMetadata | PlainMessage<JobRunEventMetadata> | undefined): boolean {
+    return proto3.util.equals(JobRunEventMetadata, a, b);
+  }
+}
+
+/**
+ * @generated from message mgmt.v1alpha1.JobRunEvent
+ */
+export class JobRunEvent extends Message<JobRunEvent> {
+  /**
+   * @generated from field: int64 id = 1;
+   */
+  id = protoInt64.zero;
+
+  /**
+   * @generated from field: string type = 2;
+   */
+  type = "";
+
+  /**
+   * @generated from field: google.protobuf.Timestamp start_time = 3;
+   */
+  startTime?: Timestamp;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp close_time = 4;
+   */
+  closeTime?: Timestamp;
+
+  /**
+   * @generated from field: mgmt.v1alpha1.JobRunEventMetadata metadata = 5;
+   */
+  metadata?: JobRunEventMetadata;
+
+  /**
+   * @generated from field: repeated mgmt.v1alpha1.JobRunEventTask tasks = 6;
+   */
+  tasks: JobRunEventTask[] = [];
+
+  constructor(data?: PartialMessage<JobRunEvent>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "mgmt.v1alpha1.JobRunEvent";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "id", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 2, name: "type", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "start_time", kind: "message", T: Timestamp },
+    { no: 4, name: "close_time", kind: "message", T: Timestamp },
+    { no: 5, name: "metadata", kind: "message", T: JobRunEventMetadata },
+    { no: 6, name: "tasks", kind: "message", T: JobRunEventTask, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): JobRunEvent {
+    return new JobRunEvent().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): JobRunEvent {
+    return new JobRunEvent().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): JobRunEvent {
+    return new JobRunEvent().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: JobRunEvent | PlainMessage<JobRunEvent> | undefined, b: JobRunEvent | PlainMessage<JobRunEvent> | undefined): boolean {
+    return proto3.util.equals(JobRunEvent, a, b);
+  }
+}
+
+/**
+ * @generated from message mgmt.v1alpha1.GetJobRunEventsRequest
+ */
+export class GetJobRunEventsRequest extends Message<GetJobRunEventsRequest> {
+  /**
+   * @generated from field: string job_run_id = 1;
+   */
+  jobRunId = "";
+
+  /**
+   * @generated from field: string account_id = 2;
+   */
+  accountId = "";
+
+  constructor(data?: PartialMessage<GetJobRunEventsRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "mgmt.v1alpha1.GetJobRunEventsRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "job_run_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "account_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetJobRunEventsRequest {
+    return new GetJobRunEventsRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetJobRunEventsRequest {
+    return new GetJobRunEventsRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetJobRunEventsRequest {
+    return new GetJobRunEventsRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GetJobRunEventsRequest | PlainMessage<GetJobRunEventsRequest> | undefined, b: GetJobRunEventsRequest | PlainMessage<GetJobRunEventsRequest> | undefined): boolean {
+    return proto3.util.equals(GetJobRunEventsRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message mgmt.v1alpha1.GetJobRunEventsResponse
+ */
+export class GetJobRunEventsResponse extends Message<GetJobRunEventsResponse> {
+  /**
+   * @generated from field: repeated mgmt.v1alpha1.JobRunEvent events = 1;
+   */
+  events: JobRunEvent[] = [];
+
+  /**
+   * @generated from field: bool is_run_complete = 2;
+   */
+  isRunComplete = false;
+
+  constructor(data?: PartialMessage<GetJobRunEventsResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "mgmt.v1alpha1.GetJobRunEventsResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "events", kind: "message", T: JobRunEvent, repeated: true },
+    { no: 2, name: "is_run_complete", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetJobRunEventsResponse {
+    return new GetJobRunEventsResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetJobRunEventsResponse {
+    return new GetJobRunEventsResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetJobRunEventsResponse {
+    return new GetJobRunEventsResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GetJobRunEventsResponse | PlainMessage<GetJobRunEventsResponse> | undefined, b: GetJobRunEventsResponse | PlainMessage<GetJobRunEventsResponse> | undefined): boolean {
+    return proto3.util.equals(GetJobRunEventsResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message mgmt.v1alpha1.DeleteJobRunRequest
+ */
+export class DeleteJobRunRequest extends Message<DeleteJobRunRequest> {
+  /**
+   * @generated from field: string job_run_id = 1;
+   */
+  jobRunId = "";
+
+  /**
+   * @generated from field: string account_id = 2;
+   */
+  accountId = "";
+
+  constructor(data?: PartialMessage<DeleteJobRunRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "mgmt.v1alpha1.DeleteJobRunRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "job_run_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "account_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): DeleteJobRunRequest {
+    return new DeleteJobRunRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): DeleteJobRunRequest {
+    return new DeleteJobRunRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): DeleteJobRunRequest {
+    return new DeleteJobRunRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: DeleteJobRunRequest | PlainMessage<DeleteJobRunRequest> | undefined, b: DeleteJobRunRequest | PlainMessage<DeleteJobRunRequest> | undefined): boolean {
+    return proto3.util.equals(DeleteJobRunRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message mgmt.v1alpha1.DeleteJobRunResponse
+ */
+export class DeleteJobRunResponse extends Message<DeleteJobRunResponse> {
+  constructor(data?: PartialMessage<DeleteJobRunResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "mgmt.v1alpha1.DeleteJobRunResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): DeleteJobRunResponse {
+    return new DeleteJobRunResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): DeleteJobRunResponse {
+    return new DeleteJobRunResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): DeleteJobRunResponse {
+    return new DeleteJobRunResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: DeleteJobRunResponse | PlainMessage<DeleteJobRunResponse> | undefined, b: DeleteJobRunResponse | PlainMessage<DeleteJobRunResponse> | undefined): boolean {
+    return proto3.util.equals(DeleteJobRunResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message mgmt.v1alpha1.TerminateJobRunRequest
+ */
+export class TerminateJobRunRequest extends Message<TerminateJobRunRequest> {
+  /**
+   * @generated from field: string job_run_id = 1;
+   */
+  jobRunId = "";
+
+  /**
+   * @generated from field: string account_id = 2;
+   */
+  accountId = "";
+
+  constructor(data?: PartialMessage<TerminateJobRunRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "mgmt.v1alpha1.TerminateJobRunRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "job_run_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "account_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): TerminateJobRunRequest {
+    return new TerminateJobRunRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): TerminateJobRunRequest {
+    return new TerminateJobRunRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): TerminateJobRunRequest {
+    return new TerminateJobRunRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: TerminateJobRunRequest | PlainMessage<TerminateJobRunRequest> | undefined, b: TerminateJobRunRequest | PlainMessage<TerminateJobRunRequest> | undefined): boolean {
+    return proto3.util.equals(TerminateJobRunRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message mgmt.v1alpha1.TerminateJobRunResponse
+ */
+export class TerminateJobRunResponse extends Message<TerminateJobRunResponse> {
+  constructor(data?: PartialMessage<TerminateJobRunResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "mgmt.v1alpha1.TerminateJobRunResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): TerminateJobRunResponse {
+    return new TerminateJobRunResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): TerminateJobRunResponse {
+    return new TerminateJobRunResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): TerminateJobRunResponse {
+    return new TerminateJobRunResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: TerminateJobRunResponse | PlainMessage<TerminateJobRunResponse> | undefined, b: TerminateJobRunResponse | PlainMessage<TerminateJobRunResponse> | undefined): boolean {
+    return proto3.util.equals(TerminateJobRunResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message mgmt.v1alpha1.GetJobRunLogsStreamRequest
+ */
+export class GetJobRunLogsStreamRequest extends Message<GetJobRunLogsStreamRequest> {
+  /**
+   * @generated from field: string job_run_id = 1;
+   */
+  jobRunId = "";
+
+  /**
+   * @generated from field: string account_id = 2;
+   */
+  accountId = "";
+
+  /**
+   * @generated from field: mgmt.v1alpha1.LogWindow window = 3;
+   */
+  window = LogWindow.NO_TIME_UNSPECIFIED;
+
+  /**
+   * @generated from field: bool should_tail = 4;
+   */
+  shouldTail = false;
+
+  /**
+   * @generated from field: optional int64 max_log_lines = 5;
+   */
+  maxLogLines?: bigint;
+
+  constructor(data?: PartialMessage<GetJobRunLogsStreamRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "mgmt.v1alpha1.GetJobRunLogsStreamRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "job_run_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "account_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "window", kind: "enum", T: proto3.getEnumType(LogWindow) },
+    { no: 4, name: "should_tail", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 5, name: "max_log_lines", kind: "scalar", T: 3 /* ScalarType.INT64 */, opt: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetJobRunLogsStreamRequest {
+    return new GetJobRunLogsStreamRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetJobRunLogsStreamRequest {
+    return new GetJobRunLogsStreamRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetJobRunLogsStreamRequest {
+    return new GetJobRunLogsStreamRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GetJobRunLogsStreamRequest | PlainMessage<GetJobRunLogsStreamRequest> | undefined, b: GetJobRunLogsStreamRequest | PlainMessage<GetJobRunLogsStreamRequest> | undefined): boolean {
+    return proto3.util.equals(GetJobRunLogsStreamRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message mgmt.v1alpha1.GetJobRunLogsStreamResponse
+ */
+export class GetJobRunLogsStreamResponse extends Message<GetJobRunLogsStreamResponse> {
+  /**
+   * @generated from field: string log_line = 1;
+   */
+  logLine = "";
+
+  constructor(data?: PartialMessage<GetJobRunLogsStreamResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "mgmt.v1alpha1.GetJobRunLogsStreamResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "log_line", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetJobRunLogsStreamResponse {
+    return new GetJobRunLogsStreamResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetJobRunLogsStreamResponse {
+    return new GetJobRunLogsStreamResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetJobRunLogsStreamResponse {
+    return new GetJobRunLogsStreamResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GetJobRunLogsStreamResponse | PlainMessage<GetJobRunLogsStreamResponse> | undefined, b: GetJobRunLogsStreamResponse | PlainMessage<GetJobRunLogsStreamResponse> | undefined): boolean {
+    return proto3.util.equals(GetJobRunLogsStreamResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message mgmt.v1alpha1.SetJobWorkflowOptionsRequest
+ */
+export class SetJobWorkflowOptionsRequest extends Message<SetJobWorkflowOptionsRequest> {
+  /**
+   * The unique identifier of the job
+   *
+   * @generated from field: string id = 1;
+   */
+  id = "";
+
+  /**
+   * The workflow options object. The entire object must be provided and will fully overwrite the previous result
+   *
+   * @generated from field: mgmt.v1alpha1.WorkflowOptions worfklow_options = 2;
+   */
+  worfklowOptions?: WorkflowOptions;
+
+  constructor(data?: PartialMessage<SetJobWorkflowOptionsRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "mgmt.v1alpha1.SetJobWorkflowOptionsRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "worfklow_options", kind: "message", T: WorkflowOptions },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SetJobWorkflowOptionsRequest {
+    return new SetJobWorkflowOptionsRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SetJobWorkflowOptionsRequest {
+    return new SetJobWorkflowOptionsRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SetJobWorkflowOptionsRequest {
+    return new SetJobWorkflowOptionsRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: SetJobWorkflowOptionsRequest | PlainMessage<SetJobWorkflowOptionsRequest> | undefined, b: SetJobWorkflowOptionsRequest | PlainMessage<SetJobWorkflowOptionsRequest> | undefined): boolean {
+    return proto3.util.equals(SetJobWorkflowOptionsRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message mgmt.v1alpha1.SetJobWorkflowOptionsResponse
+ */
+export class SetJobWorkflowOptionsResponse extends Message<SetJobWorkflowOptionsResponse> {
+  /**
+   * @generated from field: mgmt.v1alpha1.Job job = 1;
+   */
+  job?: Job;
+
+  constructor(data?: PartialMessage<SetJobWorkflowOptionsResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "mgmt.v1alpha1.SetJobWorkflowOptionsResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "job", kind: "message", T: Job },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SetJobWorkflowOptionsResponse {
+    return new SetJobWorkflowOptionsResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SetJobWorkflowOptionsResponse {
+    return new SetJobWorkflowOptionsResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SetJobWorkflowOptionsResponse {
+    return new SetJobWorkflowOptionsResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: SetJobWorkflowOptionsResponse | PlainMessage<SetJobWorkflowOptionsResponse> | undefined, b: SetJobWorkflowOptionsResponse | PlainMessage<SetJobWorkflowOptionsResponse> | undefined): boolean {
+    return proto3.util.equals(SetJobWorkflowOptionsResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message mgmt.v1alpha1.SetJobSyncOptionsRequest
+ */
+export class SetJobSyncOptionsRequest extends Message<SetJobSyncOptionsRequest> {
+  /**
+   * The unique identifier of the job
+   *
+   * @generated from field: string id = 1;
+   */
+  id = "";
+
+  /**
+   * The sync options object. The entire object must be provided and will fully overwrite the previous result
+   *
+   * @generated from field: mgmt.v1alpha1.ActivityOptions sync_options = 2;
+   */
+  syncOptions?: ActivityOptions;
+
+  constructor(data?: PartialMessage<SetJobSyncOptionsRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "mgmt.v1alpha1.SetJobSyncOptionsRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "sync_options", kind: "message", T: ActivityOptions },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SetJobSyncOptionsRequest {
+    return new SetJobSyncOptionsRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SetJobSyncOptionsRequest {
+    return new SetJobSyncOptionsRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SetJobSyncOptionsRequest {
+    return new SetJobSyncOptionsRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: SetJobSyncOptionsRequest | PlainMessage<SetJobSyncOptionsRequest> | undefined, b: SetJobSyncOptionsRequest | PlainMessage<SetJobSyncOptionsRequest> | undefined): boolean {
+    return proto3.util.equals(SetJobSyncOptionsRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message mgmt.v1alpha1.SetJobSyncOptionsResponse
+ */
+export class SetJobSyncOptionsResponse extends Message<SetJobSyncOptionsResponse> {
+  /**
+   * @generated from field: mgmt.v1alpha1.Job job = 1;
+   */
+  job?: Job;
 
   constructor(data?: PartialMessage<SetJobSyncOptionsResponse>) {
     super();
@@ -4216,25 +6682,711 @@ export class SetJobSyncOptionsResponse extends Message<SetJobSyncOptionsResponse
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "mgmt.v1alpha1.SetJobSyncOptionsResponse";
+  static readonly typeName = "mgmt.v1alpha1.SetJobSyncOptionsResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "job", kind: "message", T: Job },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SetJobSyncOptionsResponse {
+    return new SetJobSyncOptionsResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SetJobSyncOptionsResponse {
+    return new SetJobSyncOptionsResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SetJobSyncOptionsResponse {
+    return new SetJobSyncOptionsResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: SetJobSyncOptionsResponse | PlainMessage<SetJobSyncOptionsResponse> | undefined, b: SetJobSyncOptionsResponse | PlainMessage<SetJobSyncOptionsResponse> | undefined): boolean {
+    return proto3.util.equals(SetJobSyncOptionsResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message mgmt.v1alpha1.SetJobTriggersRequest
+ */
+export class SetJobTriggersRequest extends Message<SetJobTriggersRequest> {
+  /**
+   * The unique identifier of the job
+   *
+   * @generated from field: string id = 1;
+   */
+  id = "";
+
+  /**
+   * The triggers fully overwrite the previous triggers. Provide an empty list to remove every trigger
+   *
+   * @generated from field: repeated mgmt.v1alpha1.JobTrigger triggers = 2;
+   */
+  triggers: JobTrigger[] = [];
+
+  constructor(data?: PartialMessage<SetJobTriggersRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "mgmt.v1alpha1.SetJobTriggersRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "triggers", kind: "message", T: JobTrigger, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SetJobTriggersRequest {
+    return new SetJobTriggersRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SetJobTriggersRequest {
+    return new SetJobTriggersRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SetJobTriggersRequest {
+    return new SetJobTriggersRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: SetJobTriggersRequest | PlainMessage<SetJobTriggersRequest> | undefined, b: SetJobTriggersRequest | PlainMessage<SetJobTriggersRequest> | undefined): boolean {
+    return proto3.util.equals(SetJobTriggersRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message mgmt.v1alpha1.SetJobTriggersResponse
+ */
+export class SetJobTriggersResponse extends Message<SetJobTriggersResponse> {
+  /**
+   * @generated from field: mgmt.v1alpha1.Job job = 1;
+   */
+  job?: Job;
+
+  constructor(data?: PartialMessage<SetJobTriggersResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "mgmt.v1alpha1.SetJobTriggersResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "job", kind: "message", T: Job },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SetJobTriggersResponse {
+    return new SetJobTriggersResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SetJobTriggersResponse {
+    return new SetJobTriggersResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SetJobTriggersResponse {
+    return new SetJobTriggersResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: SetJobTriggersResponse | PlainMessage<SetJobTriggersResponse> | undefined, b: SetJobTriggersResponse | PlainMessage<SetJobTriggersResponse> | undefined): boolean {
+    return proto3.util.equals(SetJobTriggersResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message mgmt.v1alpha1.TriggerDependentJobsRequest
+ */
+export class TriggerDependentJobsRequest extends Message<TriggerDependentJobsRequest> {
+  /**
+   * The unique identifier of the upstream job
+   *
+   * @generated from field: string job_id = 1;
+   */
+  jobId = "";
+
+  /**
+   * The run of the upstream job that completed
+   *
+   * @generated from field: string job_run_id = 2;
+   */
+  jobRunId = "";
+
+  /**
+   * Whether the run of the upstream job succeeded
+   *
+   * @generated from field: bool succeeded = 3;
+   */
+  succeeded = false;
+
+  constructor(data?: PartialMessage<TriggerDependentJobsRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "mgmt.v1alpha1.TriggerDependentJobsRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "job_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "job_run_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "succeeded", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): TriggerDependentJobsRequest {
+    return new TriggerDependentJobsRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): TriggerDependentJobsRequest {
+    return new TriggerDependentJobsRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): TriggerDependentJobsRequest {
+    return new TriggerDependentJobsRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: TriggerDependentJobsRequest | PlainMessage<TriggerDependentJobsRequest> | undefined, b: TriggerDependentJobsRequest | PlainMessage<TriggerDependentJobsRequest> | undefined): boolean {
+    return proto3.util.equals(TriggerDependentJobsRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message mgmt.v1alpha1.TriggerDependentJobsResponse
+ */
+export class TriggerDependentJobsResponse extends Message<TriggerDependentJobsResponse> {
+  /**
+   * The jobs that a run was started for
+   *
+   * @generated from field: repeated string job_ids = 1;
+   */
+  jobIds: string[] = [];
+
+  constructor(data?: PartialMessage<TriggerDependentJobsResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "mgmt.v1alpha1.TriggerDependentJobsResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "job_ids", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): TriggerDependentJobsResponse {
+    return new TriggerDependentJobsResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): TriggerDependentJobsResponse {
+    return new TriggerDependentJobsResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): TriggerDependentJobsResponse {
+    return new TriggerDependentJobsResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: TriggerDependentJobsResponse | PlainMessage<TriggerDependentJobsResponse> | undefined, b: TriggerDependentJobsResponse | PlainMessage<TriggerDependentJobsResponse> | undefined): boolean {
+    return proto3.util.equals(TriggerDependentJobsResponse, a, b);
+  }
+}
+
+/**
+ * An immutable snapshot of the schedule, source, mappings and destinations of a job.
+ * A new version is created every time one of them changes
+ *
+ * @generated from message mgmt.v1alpha1.JobVersion
+ */
+export class JobVersion extends Message<JobVersion> {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id = "";
+
+  /**
+   * @generated from field: string job_id = 2;
+   */
+  jobId = "";
+
+  /**
+   * Increases by one with each version of the job, starting at 1
+   *
+   * @generated from field: int32 version = 3;
+   */
+  version = 0;
+
+  /**
+   * @generated from field: optional string cron_schedule = 4;
+   */
+  cronSchedule?: string;
+
+  /**
+   * @generated from field: mgmt.v1alpha1.JobSource source = 5;
+   */
+  source?: JobSource;
+
+  /**
+   * @generated from field: repeated mgmt.v1alpha1.JobMapping mappings = 6;
+   */
+  mappings: JobMapping[] = [];
+
+  /**
+   * @generated from field: repeated mgmt.v1alpha1.CreateJobDestination destinations = 7;
+   */
+  destinations: CreateJobDestination[] = [];
+
+  /**
+   * @generated from field: string created_by_user_id = 8;
+   */
+  createdByUserId = "";
+
+  /**
+   * @generated from field: google.protobuf.Timestamp created_at = 9;
+   */
+  createdAt?: Timestamp;
+
+  constructor(data?: PartialMessage<JobVersion>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "mgmt.v1alpha1.JobVersion";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "job_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "version", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 4, name: "cron_schedule", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+    { no: 5, name: "source", kind: "message", T: JobSource },
+    { no: 6, name: "mappings", kind: "message", T: JobMapping, repeated: true },
+    { no: 7, name: "destinations", kind: "message", T: CreateJobDestination, repeated: true },
+    { no: 8, name: "created_by_user_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 9, name: "created_at", kind: "message", T: Timestamp },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): JobVersion {
+    return new JobVersion().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): JobVersion {
+    return new JobVersion().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): JobVersion {
+    return new JobVersion().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: JobVersion | PlainMessage<JobVersion> | undefined, b: JobVersion | PlainMessage<JobVersion> | undefined): boolean {
+    return proto3.util.equals(JobVersion, a, b);
+  }
+}
+
+/**
+ * @generated from message mgmt.v1alpha1.GetJobVersionsRequest
+ */
+export class GetJobVersionsRequest extends Message<GetJobVersionsRequest> {
+  /**
+   * @generated from field: string job_id = 1;
+   */
+  jobId = "";
+
+  constructor(data?: PartialMessage<GetJobVersionsRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "mgmt.v1alpha1.GetJobVersionsRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "job_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetJobVersionsRequest {
+    return new GetJobVersionsRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetJobVersionsRequest {
+    return new GetJobVersionsRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetJobVersionsRequest {
+    return new GetJobVersionsRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GetJobVersionsRequest | PlainMessage<GetJobVersionsRequest> | undefined, b: GetJobVersionsRequest | PlainMessage<GetJobVersionsRequest> | undefined): boolean {
+    return proto3.util.equals(GetJobVersionsRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message mgmt.v1alpha1.GetJobVersionsResponse
+ */
+export class GetJobVersionsResponse extends Message<GetJobVersionsResponse> {
+  /**
+   * The versions of the job, newest first
+   *
+   * @generated from field: repeated mgmt.v1alpha1.JobVersion versions = 1;
+   */
+  versions: JobVersion[] = [];
+
+  constructor(data?: PartialMessage<GetJobVersionsResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "mgmt.v1alpha1.GetJobVersionsResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "versions", kind: "message", T: JobVersion, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetJobVersionsResponse {
+    return new GetJobVersionsResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetJobVersionsResponse {
+    return new GetJobVersionsResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetJobVersionsResponse {
+    return new GetJobVersionsResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GetJobVersionsResponse | PlainMessage<GetJobVersionsResponse> | undefined, b: GetJobVersionsResponse | PlainMessage<GetJobVersionsResponse> | undefined): boolean {
+    return proto3.util.equals(GetJobVersionsResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message mgmt.v1alpha1.JobMappingDiff
+ */
+export class JobMappingDiff extends Message<JobMappingDiff> {
+  /**
+   * @generated from field: string schema = 1;
+   */
+  schema = "";
+
+  /**
+   * @generated from field: string table = 2;
+   */
+  table = "";
+
+  /**
+   * @generated from field: string column = 3;
+   */
+  column = "";
+
+  /**
+   * @generated from field: mgmt.v1alpha1.JobVersionDiffAction action = 4;
+   */
+  action = JobVersionDiffAction.UNSPECIFIED;
+
+  /**
+   * Not set if the column was added
+   *
+   * @generated from field: mgmt.v1alpha1.JobMappingTransformer from_transformer = 5;
+   */
+  fromTransformer?: JobMappingTransformer;
+
+  /**
+   * Not set if the column was removed
+   *
+   * @generated from field: mgmt.v1alpha1.JobMappingTransformer to_transformer = 6;
+   */
+  toTransformer?: JobMappingTransformer;
+
+  constructor(data?: PartialMessage<JobMappingDiff>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "mgmt.v1alpha1.JobMappingDiff";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "schema", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "table", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "column", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "action", kind: "enum", T: proto3.getEnumType(JobVersionDiffAction) },
+    { no: 5, name: "from_transformer", kind: "message", T: JobMappingTransformer },
+    { no: 6, name: "to_transformer", kind: "message", T: JobMappingTransformer },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): JobMappingDiff {
+    return new JobMappingDiff().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): JobMappingDiff {
+    return new JobMappingDiff().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): JobMappingDiff {
+    return new JobMappingDiff().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: JobMappingDiff | PlainMessage<JobMappingDiff> | undefined, b: JobMappingDiff | PlainMessage<JobMappingDiff> | undefined): boolean {
+    return proto3.util.equals(JobMappingDiff, a, b);
+  }
+}
+
+/**
+ * @generated from message mgmt.v1alpha1.JobDestinationDiff
+ */
+export class JobDestinationDiff extends Message<JobDestinationDiff> {
+  /**
+   * @generated from field: string connection_id = 1;
+   */
+  connectionId = "";
+
+  /**
+   * @generated from field: mgmt.v1alpha1.JobVersionDiffAction action = 2;
+   */
+  action = JobVersionDiffAction.UNSPECIFIED;
+
+  /**
+   * Not set if the destination was added
+   *
+   * @generated from field: mgmt.v1alpha1.JobDestinationOptions from_options = 3;
+   */
+  fromOptions?: JobDestinationOptions;
+
+  /**
+   * Not set if the destination was removed
+   *
+   * @generated from field: mgmt.v1alpha1.JobDestinationOptions to_options = 4;
+   */
+  toOptions?: JobDestinationOptions;
+
+  constructor(data?: PartialMessage<JobDestinationDiff>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "mgmt.v1alpha1.JobDestinationDiff";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "connection_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "action", kind: "enum", T: proto3.getEnumType(JobVersionDiffAction) },
+    { no: 3, name: "from_options", kind: "message", T: JobDestinationOptions },
+    { no: 4, name: "to_options", kind: "message", T: JobDestinationOptions },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): JobDestinationDiff {
+    return new JobDestinationDiff().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): JobDestinationDiff {
+    return new JobDestinationDiff().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): JobDestinationDiff {
+    return new JobDestinationDiff().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: JobDestinationDiff | PlainMessage<JobDestinationDiff> | undefined, b: JobDestinationDiff | PlainMessage<JobDestinationDiff> | undefined): boolean {
+    return proto3.util.equals(JobDestinationDiff, a, b);
+  }
+}
+
+/**
+ * @generated from message mgmt.v1alpha1.GetJobVersionDiffRequest
+ */
+export class GetJobVersionDiffRequest extends Message<GetJobVersionDiffRequest> {
+  /**
+   * @generated from field: string job_id = 1;
+   */
+  jobId = "";
+
+  /**
+   * The older version to compare from
+   *
+   * @generated from field: string from_version_id = 2;
+   */
+  fromVersionId = "";
+
+  /**
+   * The newer version to compare to
+   *
+   * @generated from field: string to_version_id = 3;
+   */
+  toVersionId = "";
+
+  constructor(data?: PartialMessage<GetJobVersionDiffRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "mgmt.v1alpha1.GetJobVersionDiffRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "job_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "from_version_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "to_version_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetJobVersionDiffRequest {
+    return new GetJobVersionDiffRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetJobVersionDiffRequest {
+    return new GetJobVersionDiffRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetJobVersionDiffRequest {
+    return new GetJobVersionDiffRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GetJobVersionDiffRequest | PlainMessage<GetJobVersionDiffRequest> | undefined, b: GetJobVersionDiffRequest | PlainMessage<GetJobVersionDiffRequest> | undefined): boolean {
+    return proto3.util.equals(GetJobVersionDiffRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message mgmt.v1alpha1.GetJobVersionDiffResponse
+ */
+export class GetJobVersionDiffResponse extends Message<GetJobVersionDiffResponse> {
+  /**
+   * @generated from field: mgmt.v1alpha1.JobVersion from_version = 1;
+   */
+  fromVersion?: JobVersion;
+
+  /**
+   * @generated from field: mgmt.v1alpha1.JobVersion to_version = 2;
+   */
+  toVersion?: JobVersion;
+
+  /**
+   * @generated from field: bool cron_schedule_changed = 3;
+   */
+  cronScheduleChanged = false;
+
+  /**
+   * @generated from field: bool source_changed = 4;
+   */
+  sourceChanged = false;
+
+  /**
+   * Only the mappings that differ between the versions
+   *
+   * @generated from field: repeated mgmt.v1alpha1.JobMappingDiff mappings = 5;
+   */
+  mappings: JobMappingDiff[] = [];
+
+  /**
+   * Only the destinations that differ between the versions
+   *
+   * @generated from field: repeated mgmt.v1alpha1.JobDestinationDiff destinations = 6;
+   */
+  destinations: JobDestinationDiff[] = [];
+
+  constructor(data?: PartialMessage<GetJobVersionDiffResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "mgmt.v1alpha1.GetJobVersionDiffResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "from_version", kind: "message", T: JobVersion },
+    { no: 2, name: "to_version", kind: "message", T: JobVersion },
+    { no: 3, name: "cron_schedule_changed", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 4, name: "source_changed", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 5, name: "mappings", kind: "message", T: JobMappingDiff, repeated: true },
+    { no: 6, name: "destinations", kind: "message", T: JobDestinationDiff, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetJobVersionDiffResponse {
+    return new GetJobVersionDiffResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetJobVersionDiffResponse {
+    return new GetJobVersionDiffResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetJobVersionDiffResponse {
+    return new GetJobVersionDiffResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GetJobVersionDiffResponse | PlainMessage<GetJobVersionDiffResponse> | undefined, b: GetJobVersionDiffResponse | PlainMessage<GetJobVersionDiffResponse> | undefined): boolean {
+    return proto3.util.equals(GetJobVersionDiffResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message mgmt.v1alpha1.RollbackJobToVersionRequest
+ */
+export class RollbackJobToVersionRequest extends Message<RollbackJobToVersionRequest> {
+  /**
+   * @generated from field: string job_id = 1;
+   */
+  jobId = "";
+
+  /**
+   * @generated from field: string version_id = 2;
+   */
+  versionId = "";
+
+  constructor(data?: PartialMessage<RollbackJobToVersionRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "mgmt.v1alpha1.RollbackJobToVersionRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "job_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "version_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RollbackJobToVersionRequest {
+    return new RollbackJobToVersionRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RollbackJobToVersionRequest {
+    return new RollbackJobToVersionRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RollbackJobToVersionRequest {
+    return new RollbackJobToVersionRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: RollbackJobToVersionRequest | PlainMessage<RollbackJobToVersionRequest> | undefined, b: RollbackJobToVersionRequest | PlainMessage<RollbackJobToVersionRequest> | undefined): boolean {
+    return proto3.util.equals(RollbackJobToVersionRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message mgmt.v1alpha1.RollbackJobToVersionResponse
+ */
+export class RollbackJobToVersionResponse extends Message<RollbackJobToVersionResponse> {
+  /**
+   * @generated from field: mgmt.v1alpha1.Job job = 1;
+   */
+  job?: Job;
+
+  /**
+   * The version that was created by the rollback
+   *
+   * @generated from field: mgmt.v1alpha1.JobVersion version = 2;
+   */
+  version?: JobVersion;
+
+  constructor(data?: PartialMessage<RollbackJobToVersionResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "mgmt.v1alpha1.RollbackJobToVersionResponse";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
     { no: 1, name: "job", kind: "message", T: Job },
+    { no: 2, name: "version", kind: "message", T: JobVersion },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SetJobSyncOptionsResponse {
-    return new SetJobSyncOptionsResponse().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RollbackJobToVersionResponse {
+    return new RollbackJobToVersionResponse().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SetJobSyncOptionsResponse {
-    return new SetJobSyncOptionsResponse().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RollbackJobToVersionResponse {
+    return new RollbackJobToVersionResponse().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SetJobSyncOptionsResponse {
-    return new SetJobSyncOptionsResponse().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RollbackJobToVersionResponse {
+    return new RollbackJobToVersionResponse().fromJsonString(jsonString, options);
   }
 
-  static equals(a: SetJobSyncOptionsResponse | PlainMessage<SetJobSyncOptionsResponse> | undefined, b: SetJobSyncOptionsResponse | PlainMessage<SetJobSyncOptionsResponse> | undefined): boolean {
-    return proto3.util.equals(SetJobSyncOptionsResponse, a, b);
+  static equals(a: RollbackJobToVersionResponse | PlainMessage<RollbackJobToVersionResponse> | undefined, b: RollbackJobToVersionResponse | PlainMessage<RollbackJobToVersionResponse> | undefined): boolean {
+    return proto3.util.equals(RollbackJobToVersionResponse, a, b);
   }
 }
 
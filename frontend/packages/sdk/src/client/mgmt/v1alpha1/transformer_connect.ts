@@ -3,7 +3,7 @@
 /* eslint-disable */
 // @ts-nocheck
 
-import { CreateUserDefinedTransformerRequest, CreateUserDefinedTransformerResponse, DeleteUserDefinedTransformerRequest, DeleteUserDefinedTransformerResponse, GetSystemTransformerBySourceRequest, GetSystemTransformerBySourceResponse, GetSystemTransformersRequest, GetSystemTransformersResponse, GetUserDefinedTransformerByIdRequest, GetUserDefinedTransformerByIdResponse, GetUserDefinedTransformersRequest, GetUserDefinedTransformersResponse, IsTransformerNameAvailableRequest, IsTransformerNameAvailableResponse, UpdateUserDefinedTransformerRequest, UpdateUserDefinedTransformerResponse, ValidateUserJavascriptCodeRequest, ValidateUserJavascriptCodeResponse, ValidateUserRegexCodeRequest, ValidateUserRegexCodeResponse } from "./transformer_pb.js";
+import { CreateUserDefinedTransformerRequest, CreateUserDefinedTransformerResponse, DecryptFormatPreservingRequest, DecryptFormatPreservingResponse, DeleteUserDefinedTransformerRequest, DeleteUserDefinedTransformerResponse, GetSystemTransformerBySourceRequest, GetSystemTransformerBySourceResponse, GetSystemTransformersRequest, GetSystemTransformersResponse, GetUserDefinedTransformerByIdRequest, GetUserDefinedTransformerByIdResponse, GetUserDefinedTransformersRequest, GetUserDefinedTransformersResponse, IsTransformerNameAvailableRequest, IsTransformerNameAvailableResponse, UpdateUserDefinedTransformerRequest, UpdateUserDefinedTransformerResponse, ValidateTransformJsonConfigRequest, ValidateTransformJsonConfigResponse, ValidateUserJavascriptCodeRequest, ValidateUserJavascriptCodeResponse, ValidateUserRegexCodeRequest, ValidateUserRegexCodeResponse } from "./transformer_pb.js";
 import { MethodKind } from "@bufbuild/protobuf";
 
 /**
@@ -102,6 +102,29 @@ export const TransformersService = {
       O: ValidateUserRegexCodeResponse,
       kind: MethodKind.Unary,
     },
+    /**
+     * Validates the JSONPath expressions and nested transformers of a transform json config
+     *
+     * @generated from rpc mgmt.v1alpha1.TransformersService.ValidateTransformJsonConfig
+     */
+    validateTransformJsonConfig: {
+      name: "ValidateTransformJsonConfig",
+      I: ValidateTransformJsonConfigRequest,
+      O: ValidateTransformJsonConfigResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * Decrypts a value that was encrypted by the format preserving encrypt transformer using the account's transformer key.
+     * Values that were encrypted before the transformer key was changed can not be decrypted.
+     *
+     * @generated from rpc mgmt.v1alpha1.TransformersService.DecryptFormatPreserving
+     */
+    decryptFormatPreserving: {
+      name: "DecryptFormatPreserving",
+      I: DecryptFormatPreservingRequest,
+      O: DecryptFormatPreservingResponse,
+      kind: MethodKind.Unary,
+    },
   }
 } as const;
 
@@ -234,6 +234,71 @@ export enum TransformerSource {
    * @generated from enum value: TRANSFORMER_SOURCE_GENERATE_JAVASCRIPT = 45;
    */
   GENERATE_JAVASCRIPT = 45,
+
+  /**
+   * @generated from enum value: TRANSFORMER_SOURCE_TRANSFORM_FORMAT_PRESERVING_ENCRYPT = 46;
+   */
+  TRANSFORM_FORMAT_PRESERVING_ENCRYPT = 46,
+
+  /**
+   * @generated from enum value: TRANSFORMER_SOURCE_TRANSFORM_TIMESTAMP = 47;
+   */
+  TRANSFORM_TIMESTAMP = 47,
+
+  /**
+   * @generated from enum value: TRANSFORMER_SOURCE_TRANSFORM_DATE = 48;
+   */
+  TRANSFORM_DATE = 48,
+
+  /**
+   * @generated from enum value: TRANSFORMER_SOURCE_TRANSFORM_JSON = 49;
+   */
+  TRANSFORM_JSON = 49,
+
+  /**
+   * @generated from enum value: TRANSFORMER_SOURCE_TRANSFORM_FREE_TEXT = 50;
+   */
+  TRANSFORM_FREE_TEXT = 50,
+
+  /**
+   * @generated from enum value: TRANSFORMER_SOURCE_GENERATE_IP_ADDRESS = 51;
+   */
+  GENERATE_IP_ADDRESS = 51,
+
+  /**
+   * @generated from enum value: TRANSFORMER_SOURCE_TRANSFORM_IP_ADDRESS = 52;
+   */
+  TRANSFORM_IP_ADDRESS = 52,
+
+  /**
+   * @generated from enum value: TRANSFORMER_SOURCE_GENERATE_MAC_ADDRESS = 53;
+   */
+  GENERATE_MAC_ADDRESS = 53,
+
+  /**
+   * @generated from enum value: TRANSFORMER_SOURCE_TRANSFORM_MAC_ADDRESS = 54;
+   */
+  TRANSFORM_MAC_ADDRESS = 54,
+
+  /**
+   * @generated from enum value: TRANSFORMER_SOURCE_GENERATE_URL = 55;
+   */
+  GENERATE_URL = 55,
+
+  /**
+   * @generated from enum value: TRANSFORMER_SOURCE_TRANSFORM_URL = 56;
+   */
+  TRANSFORM_URL = 56,
+
+  /**
+   * @generated from enum value: TRANSFORMER_SOURCE_GENERATE_DOMAIN = 57;
+   */
+  GENERATE_DOMAIN = 57,
+
+  /**
+   * @generated from enum value: TRANSFORMER_SOURCE_TRANSFORM_DOMAIN = 58;
+   */
+  TRANSFORM_DOMAIN = 58,
 }
 // Retrieve enum metadata with: proto3.getEnumType(TransformerSource)
 proto3.util.setEnumType(TransformerSource, "mgmt.v1alpha1.TransformerSource", [
@@ -282,6 +347,19 @@ proto3.util.setEnumType(TransformerSource, "mgmt.v1alpha1.TransformerSource", [
   { no: 43, name: "TRANSFORMER_SOURCE_TRANSFORM_CHARACTER_SCRAMBLE" },
   { no: 44, name: "TRANSFORMER_SOURCE_USER_DEFINED" },
   { no: 45, name: "TRANSFORMER_SOURCE_GENERATE_JAVASCRIPT" },
+  { no: 46, name: "TRANSFORMER_SOURCE_TRANSFORM_FORMAT_PRESERVING_ENCRYPT" },
+  { no: 47, name: "TRANSFORMER_SOURCE_TRANSFORM_TIMESTAMP" },
+  { no: 48, name: "TRANSFORMER_SOURCE_TRANSFORM_DATE" },
+  { no: 49, name: "TRANSFORMER_SOURCE_TRANSFORM_JSON" },
+  { no: 50, name: "TRANSFORMER_SOURCE_TRANSFORM_FREE_TEXT" },
+  { no: 51, name: "TRANSFORMER_SOURCE_GENERATE_IP_ADDRESS" },
+  { no: 52, name: "TRANSFORMER_SOURCE_TRANSFORM_IP_ADDRESS" },
+  { no: 53, name: "TRANSFORMER_SOURCE_GENERATE_MAC_ADDRESS" },
+  { no: 54, name: "TRANSFORMER_SOURCE_TRANSFORM_MAC_ADDRESS" },
+  { no: 55, name: "TRANSFORMER_SOURCE_GENERATE_URL" },
+  { no: 56, name: "TRANSFORMER_SOURCE_TRANSFORM_URL" },
+  { no: 57, name: "TRANSFORMER_SOURCE_GENERATE_DOMAIN" },
+  { no: 58, name: "TRANSFORMER_SOURCE_TRANSFORM_DOMAIN" },
 ]);
 
 /**
@@ -346,6 +424,202 @@ proto3.util.setEnumType(TransformerDataType, "mgmt.v1alpha1.TransformerDataType"
   { no: 8, name: "TRANSFORMER_DATA_TYPE_UUID" },
 ]);
 
+/**
+ * @generated from enum mgmt.v1alpha1.FormatPreservingEncryptionAlgorithm
+ */
+export enum FormatPreservingEncryptionAlgorithm {
+  /**
+   * @generated from enum value: FORMAT_PRESERVING_ENCRYPTION_ALGORITHM_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * @generated from enum value: FORMAT_PRESERVING_ENCRYPTION_ALGORITHM_FF1 = 1;
+   */
+  FF1 = 1,
+
+  /**
+   * @generated from enum value: FORMAT_PRESERVING_ENCRYPTION_ALGORITHM_FF3_1 = 2;
+   */
+  FF3_1 = 2,
+}
+// Retrieve enum metadata with: proto3.getEnumType(FormatPreservingEncryptionAlgorithm)
+proto3.util.setEnumType(FormatPreservingEncryptionAlgorithm, "mgmt.v1alpha1.FormatPreservingEncryptionAlgorithm", [
+  { no: 0, name: "FORMAT_PRESERVING_ENCRYPTION_ALGORITHM_UNSPECIFIED" },
+  { no: 1, name: "FORMAT_PRESERVING_ENCRYPTION_ALGORITHM_FF1" },
+  { no: 2, name: "FORMAT_PRESERVING_ENCRYPTION_ALGORITHM_FF3_1" },
+]);
+
+/**
+ * @generated from enum mgmt.v1alpha1.TimeTransformMode
+ */
+export enum TimeTransformMode {
+  /**
+   * @generated from enum value: TIME_TRANSFORM_MODE_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * moves the value by an offset that is the same for every value of an entity
+   *
+   * @generated from enum value: TIME_TRANSFORM_MODE_SHIFT = 1;
+   */
+  SHIFT = 1,
+
+  /**
+   * moves the value by a random offset
+   *
+   * @generated from enum value: TIME_TRANSFORM_MODE_JITTER = 2;
+   */
+  JITTER = 2,
+
+  /**
+   * truncates the value to the start of its month or year
+   *
+   * @generated from enum value: TIME_TRANSFORM_MODE_TRUNCATE = 3;
+   */
+  TRUNCATE = 3,
+
+  /**
+   * limits the value to a range
+   *
+   * @generated from enum value: TIME_TRANSFORM_MODE_CLAMP = 4;
+   */
+  CLAMP = 4,
+}
+// Retrieve enum metadata with: proto3.getEnumType(TimeTransformMode)
+proto3.util.setEnumType(TimeTransformMode, "mgmt.v1alpha1.TimeTransformMode", [
+  { no: 0, name: "TIME_TRANSFORM_MODE_UNSPECIFIED" },
+  { no: 1, name: "TIME_TRANSFORM_MODE_SHIFT" },
+  { no: 2, name: "TIME_TRANSFORM_MODE_JITTER" },
+  { no: 3, name: "TIME_TRANSFORM_MODE_TRUNCATE" },
+  { no: 4, name: "TIME_TRANSFORM_MODE_CLAMP" },
+]);
+
+/**
+ * @generated from enum mgmt.v1alpha1.TimeTruncatePrecision
+ */
+export enum TimeTruncatePrecision {
+  /**
+   * @generated from enum value: TIME_TRUNCATE_PRECISION_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * @generated from enum value: TIME_TRUNCATE_PRECISION_MONTH = 1;
+   */
+  MONTH = 1,
+
+  /**
+   * @generated from enum value: TIME_TRUNCATE_PRECISION_YEAR = 2;
+   */
+  YEAR = 2,
+}
+// Retrieve enum metadata with: proto3.getEnumType(TimeTruncatePrecision)
+proto3.util.setEnumType(TimeTruncatePrecision, "mgmt.v1alpha1.TimeTruncatePrecision", [
+  { no: 0, name: "TIME_TRUNCATE_PRECISION_UNSPECIFIED" },
+  { no: 1, name: "TIME_TRUNCATE_PRECISION_MONTH" },
+  { no: 2, name: "TIME_TRUNCATE_PRECISION_YEAR" },
+]);
+
+/**
+ * @generated from enum mgmt.v1alpha1.FreeTextEntityType
+ */
+export enum FreeTextEntityType {
+  /**
+   * @generated from enum value: FREE_TEXT_ENTITY_TYPE_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * @generated from enum value: FREE_TEXT_ENTITY_TYPE_EMAIL = 1;
+   */
+  EMAIL = 1,
+
+  /**
+   * @generated from enum value: FREE_TEXT_ENTITY_TYPE_PHONE_NUMBER = 2;
+   */
+  PHONE_NUMBER = 2,
+
+  /**
+   * only numbers that pass the luhn check are detected
+   *
+   * @generated from enum value: FREE_TEXT_ENTITY_TYPE_CARD_NUMBER = 3;
+   */
+  CARD_NUMBER = 3,
+
+  /**
+   * a first name followed by a last name, or a title such as Mr or Dr followed by a last name
+   *
+   * @generated from enum value: FREE_TEXT_ENTITY_TYPE_NAME = 4;
+   */
+  NAME = 4,
+}
+// Retrieve enum metadata with: proto3.getEnumType(FreeTextEntityType)
+proto3.util.setEnumType(FreeTextEntityType, "mgmt.v1alpha1.FreeTextEntityType", [
+  { no: 0, name: "FREE_TEXT_ENTITY_TYPE_UNSPECIFIED" },
+  { no: 1, name: "FREE_TEXT_ENTITY_TYPE_EMAIL" },
+  { no: 2, name: "FREE_TEXT_ENTITY_TYPE_PHONE_NUMBER" },
+  { no: 3, name: "FREE_TEXT_ENTITY_TYPE_CARD_NUMBER" },
+  { no: 4, name: "FREE_TEXT_ENTITY_TYPE_NAME" },
+]);
+
+/**
+ * @generated from enum mgmt.v1alpha1.FreeTextReplacementStrategy
+ */
+export enum FreeTextReplacementStrategy {
+  /**
+   * @generated from enum value: FREE_TEXT_REPLACEMENT_STRATEGY_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * replaces each entity with a token of its type, for example [EMAIL]
+   *
+   * @generated from enum value: FREE_TEXT_REPLACEMENT_STRATEGY_MASK = 1;
+   */
+  MASK = 1,
+
+  /**
+   * replaces each entity with a fake value of the same type. the same entity is always replaced with the same fake value
+   *
+   * @generated from enum value: FREE_TEXT_REPLACEMENT_STRATEGY_FAKE = 2;
+   */
+  FAKE = 2,
+}
+// Retrieve enum metadata with: proto3.getEnumType(FreeTextReplacementStrategy)
+proto3.util.setEnumType(FreeTextReplacementStrategy, "mgmt.v1alpha1.FreeTextReplacementStrategy", [
+  { no: 0, name: "FREE_TEXT_REPLACEMENT_STRATEGY_UNSPECIFIED" },
+  { no: 1, name: "FREE_TEXT_REPLACEMENT_STRATEGY_MASK" },
+  { no: 2, name: "FREE_TEXT_REPLACEMENT_STRATEGY_FAKE" },
+]);
+
+/**
+ * @generated from enum mgmt.v1alpha1.IpAddressVersion
+ */
+export enum IpAddressVersion {
+  /**
+   * @generated from enum value: IP_ADDRESS_VERSION_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * @generated from enum value: IP_ADDRESS_VERSION_V4 = 1;
+   */
+  V4 = 1,
+
+  /**
+   * @generated from enum value: IP_ADDRESS_VERSION_V6 = 2;
+   */
+  V6 = 2,
+}
+// Retrieve enum metadata with: proto3.getEnumType(IpAddressVersion)
+proto3.util.setEnumType(IpAddressVersion, "mgmt.v1alpha1.IpAddressVersion", [
+  { no: 0, name: "IP_ADDRESS_VERSION_UNSPECIFIED" },
+  { no: 1, name: "IP_ADDRESS_VERSION_V4" },
+  { no: 2, name: "IP_ADDRESS_VERSION_V6" },
+]);
+
 /**
  * @generated from message mgmt.v1alpha1.GetSystemTransformersRequest
  */
@@ -1389,6 +1663,84 @@ export class TransformerConfig extends Message<TransformerConfig> {
      */
     value: GenerateJavascript;
     case: "generateJavascriptConfig";
+  } | {
+    /**
+     * @generated from field: mgmt.v1alpha1.TransformFormatPreservingEncrypt transform_format_preserving_encrypt_config = 43;
+     */
+    value: TransformFormatPreservingEncrypt;
+    case: "transformFormatPreservingEncryptConfig";
+  } | {
+    /**
+     * @generated from field: mgmt.v1alpha1.TransformTimestamp transform_timestamp_config = 44;
+     */
+    value: TransformTimestamp;
+    case: "transformTimestampConfig";
+  } | {
+    /**
+     * @generated from field: mgmt.v1alpha1.TransformDate transform_date_config = 45;
+     */
+    value: TransformDate;
+    case: "transformDateConfig";
+  } | {
+    /**
+     * @generated from field: mgmt.v1alpha1.TransformJson transform_json_config = 46;
+     */
+    value: TransformJson;
+    case: "transformJsonConfig";
+  } | {
+    /**
+     * @generated from field: mgmt.v1alpha1.TransformFreeText transform_free_text_config = 47;
+     */
+    value: TransformFreeText;
+    case: "transformFreeTextConfig";
+  } | {
+    /**
+     * @generated from field: mgmt.v1alpha1.GenerateIpAddress generate_ip_address_config = 48;
+     */
+    value: GenerateIpAddress;
+    case: "generateIpAddressConfig";
+  } | {
+    /**
+     * @generated from field: mgmt.v1alpha1.TransformIpAddress transform_ip_address_config = 49;
+     */
+    value: TransformIpAddress;
+    case: "transformIpAddressConfig";
+  } | {
+    /**
+     * @generated from field: mgmt.v1alpha1.GenerateMacAddress generate_mac_address_config = 50;
+     */
+    value: GenerateMacAddress;
+    case: "generateMacAddressConfig";
+  } | {
+    /**
+     * @generated from field: mgmt.v1alpha1.TransformMacAddress transform_mac_address_config = 51;
+     */
+    value: TransformMacAddress;
+    case: "transformMacAddressConfig";
+  } | {
+    /**
+     * @generated from field: mgmt.v1alpha1.GenerateUrl generate_url_config = 52;
+     */
+    value: GenerateUrl;
+    case: "generateUrlConfig";
+  } | {
+    /**
+     * @generated from field: mgmt.v1alpha1.TransformUrl transform_url_config = 53;
+     */
+    value: TransformUrl;
+    case: "transformUrlConfig";
+  } | {
+    /**
+     * @generated from field: mgmt.v1alpha1.GenerateDomain generate_domain_config = 54;
+     */
+    value: GenerateDomain;
+    case: "generateDomainConfig";
+  } | {
+    /**
+     * @generated from field: mgmt.v1alpha1.TransformDomain transform_domain_config = 55;
+     */
+    value: TransformDomain;
+    case: "transformDomainConfig";
   } | { case: undefined; value?: undefined } = { case: undefined };
 
   constructor(data?: PartialMessage<TransformerConfig>) {
@@ -1441,6 +1793,19 @@ export class TransformerConfig extends Message<TransformerConfig> {
     { no: 40, name: "generate_categorical_config", kind: "message", T: GenerateCategorical, oneof: "config" },
     { no: 41, name: "transform_character_scramble_config", kind: "message", T: TransformCharacterScramble, oneof: "config" },
     { no: 42, name: "generate_javascript_config", kind: "message", T: GenerateJavascript, oneof: "config" },
+    { no: 43, name: "transform_format_preserving_encrypt_config", kind: "message", T: TransformFormatPreservingEncrypt, oneof: "config" },
+    { no: 44, name: "transform_timestamp_config", kind: "message", T: TransformTimestamp, oneof: "config" },
+    { no: 45, name: "transform_date_config", kind: "message", T: TransformDate, oneof: "config" },
+    { no: 46, name: "transform_json_config", kind: "message", T: TransformJson, oneof: "config" },
+    { no: 47, name: "transform_free_text_config", kind: "message", T: TransformFreeText, oneof: "config" },
+    { no: 48, name: "generate_ip_address_config", kind: "message", T: GenerateIpAddress, oneof: "config" },
+    { no: 49, name: "transform_ip_address_config", kind: "message", T: TransformIpAddress, oneof: "config" },
+    { no: 50, name: "generate_mac_address_config", kind: "message", T: GenerateMacAddress, oneof: "config" },
+    { no: 51, name: "transform_mac_address_config", kind: "message", T: TransformMacAddress, oneof: "config" },
+    { no: 52, name: "generate_url_config", kind: "message", T: GenerateUrl, oneof: "config" },
+    { no: 53, name: "transform_url_config", kind: "message", T: TransformUrl, oneof: "config" },
+    { no: 54, name: "generate_domain_config", kind: "message", T: GenerateDomain, oneof: "config" },
+    { no: 55, name: "transform_domain_config", kind: "message", T: TransformDomain, oneof: "config" },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): TransformerConfig {
@@ -1510,6 +1875,13 @@ export class TransformEmail extends Message<TransformEmail> {
    */
   excludedDomains: string[] = [];
 
+  /**
+   * transforms the same input value to the same output using the account's transformer key
+   *
+   * @generated from field: bool deterministic = 4;
+   */
+  deterministic = false;
+
   constructor(data?: PartialMessage<TransformEmail>) {
     super();
     proto3.util.initPartial(data, this);
@@ -1521,6 +1893,7 @@ export class TransformEmail extends Message<TransformEmail> {
     { no: 1, name: "preserve_domain", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
     { no: 2, name: "preserve_length", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
     { no: 3, name: "excluded_domains", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+    { no: 4, name: "deterministic", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): TransformEmail {
@@ -1612,6 +1985,21 @@ export class GenerateCardNumber extends Message<GenerateCardNumber> {
  * @generated from message mgmt.v1alpha1.GenerateCity
  */
 export class GenerateCity extends Message<GenerateCity> {
+  /**
+   * the locale that values are generated for, such as de_DE, ja_JP or pt_BR. defaults to en_US if unspecified
+   *
+   * @generated from field: optional string locale = 1;
+   */
+  locale?: string;
+
+  /**
+   * a column in the same table whose value, such as a locale or country code, overrides the locale for the row.
+   * the locale is used when the value is empty or is not a supported locale
+   *
+   * @generated from field: optional string locale_column = 2;
+   */
+  localeColumn?: string;
+
   constructor(data?: PartialMessage<GenerateCity>) {
     super();
     proto3.util.initPartial(data, this);
@@ -1620,6 +2008,8 @@ export class GenerateCity extends Message<GenerateCity> {
   static readonly runtime: typeof proto3 = proto3;
   static readonly typeName = "mgmt.v1alpha1.GenerateCity";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "locale", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+    { no: 2, name: "locale_column", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GenerateCity {
@@ -1684,6 +2074,21 @@ export class GenerateE164PhoneNumber extends Message<GenerateE164PhoneNumber> {
    */
   max = protoInt64.zero;
 
+  /**
+   * the locale that values are generated for, such as de_DE, ja_JP or pt_BR. defaults to en_US if unspecified
+   *
+   * @generated from field: optional string locale = 3;
+   */
+  locale?: string;
+
+  /**
+   * a column in the same table whose value, such as a locale or country code, overrides the locale for the row.
+   * the locale is used when the value is empty or is not a supported locale
+   *
+   * @generated from field: optional string locale_column = 4;
+   */
+  localeColumn?: string;
+
   constructor(data?: PartialMessage<GenerateE164PhoneNumber>) {
     super();
     proto3.util.initPartial(data, this);
@@ -1694,6 +2099,8 @@ export class GenerateE164PhoneNumber extends Message<GenerateE164PhoneNumber> {
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
     { no: 1, name: "min", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
     { no: 2, name: "max", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 3, name: "locale", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+    { no: 4, name: "locale_column", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GenerateE164PhoneNumber {
@@ -1717,6 +2124,21 @@ export class GenerateE164PhoneNumber extends Message<GenerateE164PhoneNumber> {
  * @generated from message mgmt.v1alpha1.GenerateFirstName
  */
 export class GenerateFirstName extends Message<GenerateFirstName> {
+  /**
+   * the locale that values are generated for, such as de_DE, ja_JP or pt_BR. defaults to en_US if unspecified
+   *
+   * @generated from field: optional string locale = 1;
+   */
+  locale?: string;
+
+  /**
+   * a column in the same table whose value, such as a locale or country code, overrides the locale for the row.
+   * the locale is used when the value is empty or is not a supported locale
+   *
+   * @generated from field: optional string locale_column = 2;
+   */
+  localeColumn?: string;
+
   constructor(data?: PartialMessage<GenerateFirstName>) {
     super();
     proto3.util.initPartial(data, this);
@@ -1725,6 +2147,8 @@ export class GenerateFirstName extends Message<GenerateFirstName> {
   static readonly runtime: typeof proto3 = proto3;
   static readonly typeName = "mgmt.v1alpha1.GenerateFirstName";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "locale", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+    { no: 2, name: "locale_column", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GenerateFirstName {
@@ -1803,6 +2227,21 @@ export class GenerateFloat64 extends Message<GenerateFloat64> {
  * @generated from message mgmt.v1alpha1.GenerateFullAddress
  */
 export class GenerateFullAddress extends Message<GenerateFullAddress> {
+  /**
+   * the locale that values are generated for, such as de_DE, ja_JP or pt_BR. defaults to en_US if unspecified
+   *
+   * @generated from field: optional string locale = 1;
+   */
+  locale?: string;
+
+  /**
+   * a column in the same table whose value, such as a locale or country code, overrides the locale for the row.
+   * the locale is used when the value is empty or is not a supported locale
+   *
+   * @generated from field: optional string locale_column = 2;
+   */
+  localeColumn?: string;
+
   constructor(data?: PartialMessage<GenerateFullAddress>) {
     super();
     proto3.util.initPartial(data, this);
@@ -1811,6 +2250,8 @@ export class GenerateFullAddress extends Message<GenerateFullAddress> {
   static readonly runtime: typeof proto3 = proto3;
   static readonly typeName = "mgmt.v1alpha1.GenerateFullAddress";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "locale", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+    { no: 2, name: "locale_column", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GenerateFullAddress {
@@ -1834,6 +2275,21 @@ export class GenerateFullAddress extends Message<GenerateFullAddress> {
  * @generated from message mgmt.v1alpha1.GenerateFullName
  */
 export class GenerateFullName extends Message<GenerateFullName> {
+  /**
+   * the locale that values are generated for, such as de_DE, ja_JP or pt_BR. defaults to en_US if unspecified
+   *
+   * @generated from field: optional string locale = 1;
+   */
+  locale?: string;
+
+  /**
+   * a column in the same table whose value, such as a locale or country code, overrides the locale for the row.
+   * the locale is used when the value is empty or is not a supported locale
+   *
+   * @generated from field: optional string locale_column = 2;
+   */
+  localeColumn?: string;
+
   constructor(data?: PartialMessage<GenerateFullName>) {
     super();
     proto3.util.initPartial(data, this);
@@ -1842,6 +2298,8 @@ export class GenerateFullName extends Message<GenerateFullName> {
   static readonly runtime: typeof proto3 = proto3;
   static readonly typeName = "mgmt.v1alpha1.GenerateFullName";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "locale", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+    { no: 2, name: "locale_column", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GenerateFullName {
@@ -1982,6 +2440,21 @@ export class GenerateInt64 extends Message<GenerateInt64> {
  * @generated from message mgmt.v1alpha1.GenerateLastName
  */
 export class GenerateLastName extends Message<GenerateLastName> {
+  /**
+   * the locale that values are generated for, such as de_DE, ja_JP or pt_BR. defaults to en_US if unspecified
+   *
+   * @generated from field: optional string locale = 1;
+   */
+  locale?: string;
+
+  /**
+   * a column in the same table whose value, such as a locale or country code, overrides the locale for the row.
+   * the locale is used when the value is empty or is not a supported locale
+   *
+   * @generated from field: optional string locale_column = 2;
+   */
+  localeColumn?: string;
+
   constructor(data?: PartialMessage<GenerateLastName>) {
     super();
     proto3.util.initPartial(data, this);
@@ -1990,6 +2463,8 @@ export class GenerateLastName extends Message<GenerateLastName> {
   static readonly runtime: typeof proto3 = proto3;
   static readonly typeName = "mgmt.v1alpha1.GenerateLastName";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "locale", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+    { no: 2, name: "locale_column", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GenerateLastName {
@@ -2075,6 +2550,21 @@ export class GenerateSSN extends Message<GenerateSSN> {
  * @generated from message mgmt.v1alpha1.GenerateState
  */
 export class GenerateState extends Message<GenerateState> {
+  /**
+   * the locale that values are generated for, such as de_DE, ja_JP or pt_BR. defaults to en_US if unspecified
+   *
+   * @generated from field: optional string locale = 1;
+   */
+  locale?: string;
+
+  /**
+   * a column in the same table whose value, such as a locale or country code, overrides the locale for the row.
+   * the locale is used when the value is empty or is not a supported locale
+   *
+   * @generated from field: optional string locale_column = 2;
+   */
+  localeColumn?: string;
+
   constructor(data?: PartialMessage<GenerateState>) {
     super();
     proto3.util.initPartial(data, this);
@@ -2083,6 +2573,8 @@ export class GenerateState extends Message<GenerateState> {
   static readonly runtime: typeof proto3 = proto3;
   static readonly typeName = "mgmt.v1alpha1.GenerateState";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "locale", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+    { no: 2, name: "locale_column", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GenerateState {
@@ -2106,6 +2598,21 @@ export class GenerateState extends Message<GenerateState> {
  * @generated from message mgmt.v1alpha1.GenerateStreetAddress
  */
 export class GenerateStreetAddress extends Message<GenerateStreetAddress> {
+  /**
+   * the locale that values are generated for, such as de_DE, ja_JP or pt_BR. defaults to en_US if unspecified
+   *
+   * @generated from field: optional string locale = 1;
+   */
+  locale?: string;
+
+  /**
+   * a column in the same table whose value, such as a locale or country code, overrides the locale for the row.
+   * the locale is used when the value is empty or is not a supported locale
+   *
+   * @generated from field: optional string locale_column = 2;
+   */
+  localeColumn?: string;
+
   constructor(data?: PartialMessage<GenerateStreetAddress>) {
     super();
     proto3.util.initPartial(data, this);
@@ -2114,6 +2621,8 @@ export class GenerateStreetAddress extends Message<GenerateStreetAddress> {
   static readonly runtime: typeof proto3 = proto3;
   static readonly typeName = "mgmt.v1alpha1.GenerateStreetAddress";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "locale", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+    { no: 2, name: "locale_column", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GenerateStreetAddress {
@@ -2147,16 +2656,33 @@ export class GenerateStringPhoneNumber extends Message<GenerateStringPhoneNumber
    */
   max = protoInt64.zero;
 
-  constructor(data?: PartialMessage<GenerateStringPhoneNumber>) {
-    super();
-    proto3.util.initPartial(data, this);
-  }
-
-  static readonly runtime: typeof proto3 = proto3;
+  /**
+   * the locale that values are generated for, such as de_DE, ja_JP or pt_BR. defaults to en_US if unspecified
+   *
+   * @generated from field: optional string locale = 4;
+   */
+  locale?: string;
+
+  /**
+   * a column in the same table whose value, such as a locale or country code, overrides the locale for the row.
+   * the locale is used when the value is empty or is not a supported locale
+   *
+   * @generated from field: optional string locale_column = 5;
+   */
+  localeColumn?: string;
+
+  constructor(data?: PartialMessage<GenerateStringPhoneNumber>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
   static readonly typeName = "mgmt.v1alpha1.GenerateStringPhoneNumber";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
     { no: 2, name: "min", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
     { no: 3, name: "max", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 4, name: "locale", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+    { no: 5, name: "locale_column", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GenerateStringPhoneNumber {
@@ -2353,6 +2879,21 @@ export class GenerateUuid extends Message<GenerateUuid> {
  * @generated from message mgmt.v1alpha1.GenerateZipcode
  */
 export class GenerateZipcode extends Message<GenerateZipcode> {
+  /**
+   * the locale that values are generated for, such as de_DE, ja_JP or pt_BR. defaults to en_US if unspecified
+   *
+   * @generated from field: optional string locale = 1;
+   */
+  locale?: string;
+
+  /**
+   * a column in the same table whose value, such as a locale or country code, overrides the locale for the row.
+   * the locale is used when the value is empty or is not a supported locale
+   *
+   * @generated from field: optional string locale_column = 2;
+   */
+  localeColumn?: string;
+
   constructor(data?: PartialMessage<GenerateZipcode>) {
     super();
     proto3.util.initPartial(data, this);
@@ -2361,6 +2902,8 @@ export class GenerateZipcode extends Message<GenerateZipcode> {
   static readonly runtime: typeof proto3 = proto3;
   static readonly typeName = "mgmt.v1alpha1.GenerateZipcode";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "locale", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+    { no: 2, name: "locale_column", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GenerateZipcode {
@@ -2389,6 +2932,13 @@ export class TransformE164PhoneNumber extends Message<TransformE164PhoneNumber>
    */
   preserveLength = false;
 
+  /**
+   * transforms the same input value to the same output using the account's transformer key
+   *
+   * @generated from field: bool deterministic = 2;
+   */
+  deterministic = false;
+
   constructor(data?: PartialMessage<TransformE164PhoneNumber>) {
     super();
     proto3.util.initPartial(data, this);
@@ -2398,6 +2948,7 @@ export class TransformE164PhoneNumber extends Message<TransformE164PhoneNumber>
   static readonly typeName = "mgmt.v1alpha1.TransformE164PhoneNumber";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
     { no: 1, name: "preserve_length", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 2, name: "deterministic", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): TransformE164PhoneNumber {
@@ -2426,6 +2977,28 @@ export class TransformFirstName extends Message<TransformFirstName> {
    */
   preserveLength = false;
 
+  /**
+   * transforms the same input value to the same output using the account's transformer key
+   *
+   * @generated from field: bool deterministic = 2;
+   */
+  deterministic = false;
+
+  /**
+   * the locale that values are generated for, such as de_DE, ja_JP or pt_BR. defaults to en_US if unspecified
+   *
+   * @generated from field: optional string locale = 3;
+   */
+  locale?: string;
+
+  /**
+   * a column in the same table whose value, such as a locale or country code, overrides the locale for the row.
+   * the locale is used when the value is empty or is not a supported locale
+   *
+   * @generated from field: optional string locale_column = 4;
+   */
+  localeColumn?: string;
+
   constructor(data?: PartialMessage<TransformFirstName>) {
     super();
     proto3.util.initPartial(data, this);
@@ -2435,6 +3008,9 @@ export class TransformFirstName extends Message<TransformFirstName> {
   static readonly typeName = "mgmt.v1alpha1.TransformFirstName";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
     { no: 1, name: "preserve_length", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 2, name: "deterministic", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 3, name: "locale", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+    { no: 4, name: "locale_column", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): TransformFirstName {
@@ -2468,6 +3044,13 @@ export class TransformFloat64 extends Message<TransformFloat64> {
    */
   randomizationRangeMax = 0;
 
+  /**
+   * transforms the same input value to the same output using the account's transformer key
+   *
+   * @generated from field: bool deterministic = 3;
+   */
+  deterministic = false;
+
   constructor(data?: PartialMessage<TransformFloat64>) {
     super();
     proto3.util.initPartial(data, this);
@@ -2478,6 +3061,7 @@ export class TransformFloat64 extends Message<TransformFloat64> {
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
     { no: 1, name: "randomization_range_min", kind: "scalar", T: 1 /* ScalarType.DOUBLE */ },
     { no: 2, name: "randomization_range_max", kind: "scalar", T: 1 /* ScalarType.DOUBLE */ },
+    { no: 3, name: "deterministic", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): TransformFloat64 {
@@ -2506,6 +3090,28 @@ export class TransformFullName extends Message<TransformFullName> {
    */
   preserveLength = false;
 
+  /**
+   * transforms the same input value to the same output using the account's transformer key
+   *
+   * @generated from field: bool deterministic = 2;
+   */
+  deterministic = false;
+
+  /**
+   * the locale that values are generated for, such as de_DE, ja_JP or pt_BR. defaults to en_US if unspecified
+   *
+   * @generated from field: optional string locale = 3;
+   */
+  locale?: string;
+
+  /**
+   * a column in the same table whose value, such as a locale or country code, overrides the locale for the row.
+   * the locale is used when the value is empty or is not a supported locale
+   *
+   * @generated from field: optional string locale_column = 4;
+   */
+  localeColumn?: string;
+
   constructor(data?: PartialMessage<TransformFullName>) {
     super();
     proto3.util.initPartial(data, this);
@@ -2515,6 +3121,9 @@ export class TransformFullName extends Message<TransformFullName> {
   static readonly typeName = "mgmt.v1alpha1.TransformFullName";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
     { no: 1, name: "preserve_length", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 2, name: "deterministic", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 3, name: "locale", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+    { no: 4, name: "locale_column", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): TransformFullName {
@@ -2543,6 +3152,13 @@ export class TransformInt64PhoneNumber extends Message<TransformInt64PhoneNumber
    */
   preserveLength = false;
 
+  /**
+   * transforms the same input value to the same output using the account's transformer key
+   *
+   * @generated from field: bool deterministic = 2;
+   */
+  deterministic = false;
+
   constructor(data?: PartialMessage<TransformInt64PhoneNumber>) {
     super();
     proto3.util.initPartial(data, this);
@@ -2552,6 +3168,7 @@ export class TransformInt64PhoneNumber extends Message<TransformInt64PhoneNumber
   static readonly typeName = "mgmt.v1alpha1.TransformInt64PhoneNumber";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
     { no: 1, name: "preserve_length", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 2, name: "deterministic", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): TransformInt64PhoneNumber {
@@ -2585,6 +3202,13 @@ export class TransformInt64 extends Message<TransformInt64> {
    */
   randomizationRangeMax = protoInt64.zero;
 
+  /**
+   * transforms the same input value to the same output using the account's transformer key
+   *
+   * @generated from field: bool deterministic = 3;
+   */
+  deterministic = false;
+
   constructor(data?: PartialMessage<TransformInt64>) {
     super();
     proto3.util.initPartial(data, this);
@@ -2595,6 +3219,7 @@ export class TransformInt64 extends Message<TransformInt64> {
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
     { no: 1, name: "randomization_range_min", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
     { no: 2, name: "randomization_range_max", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 3, name: "deterministic", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): TransformInt64 {
@@ -2623,6 +3248,28 @@ export class TransformLastName extends Message<TransformLastName> {
    */
   preserveLength = false;
 
+  /**
+   * transforms the same input value to the same output using the account's transformer key
+   *
+   * @generated from field: bool deterministic = 2;
+   */
+  deterministic = false;
+
+  /**
+   * the locale that values are generated for, such as de_DE, ja_JP or pt_BR. defaults to en_US if unspecified
+   *
+   * @generated from field: optional string locale = 3;
+   */
+  locale?: string;
+
+  /**
+   * a column in the same table whose value, such as a locale or country code, overrides the locale for the row.
+   * the locale is used when the value is empty or is not a supported locale
+   *
+   * @generated from field: optional string locale_column = 4;
+   */
+  localeColumn?: string;
+
   constructor(data?: PartialMessage<TransformLastName>) {
     super();
     proto3.util.initPartial(data, this);
@@ -2632,6 +3279,9 @@ export class TransformLastName extends Message<TransformLastName> {
   static readonly typeName = "mgmt.v1alpha1.TransformLastName";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
     { no: 1, name: "preserve_length", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 2, name: "deterministic", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 3, name: "locale", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+    { no: 4, name: "locale_column", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): TransformLastName {
@@ -2660,6 +3310,28 @@ export class TransformPhoneNumber extends Message<TransformPhoneNumber> {
    */
   preserveLength = false;
 
+  /**
+   * transforms the same input value to the same output using the account's transformer key
+   *
+   * @generated from field: bool deterministic = 2;
+   */
+  deterministic = false;
+
+  /**
+   * the locale that values are generated for, such as de_DE, ja_JP or pt_BR. defaults to en_US if unspecified
+   *
+   * @generated from field: optional string locale = 3;
+   */
+  locale?: string;
+
+  /**
+   * a column in the same table whose value, such as a locale or country code, overrides the locale for the row.
+   * the locale is used when the value is empty or is not a supported locale
+   *
+   * @generated from field: optional string locale_column = 4;
+   */
+  localeColumn?: string;
+
   constructor(data?: PartialMessage<TransformPhoneNumber>) {
     super();
     proto3.util.initPartial(data, this);
@@ -2669,6 +3341,9 @@ export class TransformPhoneNumber extends Message<TransformPhoneNumber> {
   static readonly typeName = "mgmt.v1alpha1.TransformPhoneNumber";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
     { no: 1, name: "preserve_length", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 2, name: "deterministic", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 3, name: "locale", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+    { no: 4, name: "locale_column", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): TransformPhoneNumber {
@@ -2697,6 +3372,13 @@ export class TransformString extends Message<TransformString> {
    */
   preserveLength = false;
 
+  /**
+   * transforms the same input value to the same output using the account's transformer key
+   *
+   * @generated from field: bool deterministic = 2;
+   */
+  deterministic = false;
+
   constructor(data?: PartialMessage<TransformString>) {
     super();
     proto3.util.initPartial(data, this);
@@ -2706,6 +3388,7 @@ export class TransformString extends Message<TransformString> {
   static readonly typeName = "mgmt.v1alpha1.TransformString";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
     { no: 1, name: "preserve_length", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 2, name: "deterministic", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): TransformString {
@@ -2989,6 +3672,13 @@ export class TransformCharacterScramble extends Message<TransformCharacterScramb
    */
   userProvidedRegex?: string;
 
+  /**
+   * transforms the same input value to the same output using the account's transformer key
+   *
+   * @generated from field: bool deterministic = 2;
+   */
+  deterministic = false;
+
   constructor(data?: PartialMessage<TransformCharacterScramble>) {
     super();
     proto3.util.initPartial(data, this);
@@ -2998,6 +3688,7 @@ export class TransformCharacterScramble extends Message<TransformCharacterScramb
   static readonly typeName = "mgmt.v1alpha1.TransformCharacterScramble";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
     { no: 1, name: "user_provided_regex", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+    { no: 2, name: "deterministic", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): TransformCharacterScramble {
@@ -3055,82 +3746,954 @@ export class GenerateJavascript extends Message<GenerateJavascript> {
 }
 
 /**
- * @generated from message mgmt.v1alpha1.ValidateUserRegexCodeRequest
+ * @generated from message mgmt.v1alpha1.TransformFormatPreservingEncrypt
  */
-export class ValidateUserRegexCodeRequest extends Message<ValidateUserRegexCodeRequest> {
+export class TransformFormatPreservingEncrypt extends Message<TransformFormatPreservingEncrypt> {
   /**
-   * @generated from field: string account_id = 1;
+   * the format preserving encryption mode. defaults to FF1 if unspecified
+   *
+   * @generated from field: mgmt.v1alpha1.FormatPreservingEncryptionAlgorithm algorithm = 1;
    */
-  accountId = "";
+  algorithm = FormatPreservingEncryptionAlgorithm.UNSPECIFIED;
 
   /**
-   * @generated from field: string user_provided_regex = 2;
+   * an optional tweak that is mixed into the encryption. the same tweak must be provided to decrypt the value
+   *
+   * @generated from field: string tweak = 2;
    */
-  userProvidedRegex = "";
+  tweak = "";
 
-  constructor(data?: PartialMessage<ValidateUserRegexCodeRequest>) {
+  constructor(data?: PartialMessage<TransformFormatPreservingEncrypt>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "mgmt.v1alpha1.ValidateUserRegexCodeRequest";
+  static readonly typeName = "mgmt.v1alpha1.TransformFormatPreservingEncrypt";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "account_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 2, name: "user_provided_regex", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 1, name: "algorithm", kind: "enum", T: proto3.getEnumType(FormatPreservingEncryptionAlgorithm) },
+    { no: 2, name: "tweak", kind: "scalar", T: 9 /* ScalarType.STRING */ },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ValidateUserRegexCodeRequest {
-    return new ValidateUserRegexCodeRequest().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): TransformFormatPreservingEncrypt {
+    return new TransformFormatPreservingEncrypt().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ValidateUserRegexCodeRequest {
-    return new ValidateUserRegexCodeRequest().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): TransformFormatPreservingEncrypt {
+    return new TransformFormatPreservingEncrypt().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ValidateUserRegexCodeRequest {
-    return new ValidateUserRegexCodeRequest().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): TransformFormatPreservingEncrypt {
+    return new TransformFormatPreservingEncrypt().fromJsonString(jsonString, options);
   }
 
-  static equals(a: ValidateUserRegexCodeRequest | PlainMessage<ValidateUserRegexCodeRequest> | undefined, b: ValidateUserRegexCodeRequest | PlainMessage<ValidateUserRegexCodeRequest> | undefined): boolean {
-    return proto3.util.equals(ValidateUserRegexCodeRequest, a, b);
+  static equals(a: TransformFormatPreservingEncrypt | PlainMessage<TransformFormatPreservingEncrypt> | undefined, b: TransformFormatPreservingEncrypt | PlainMessage<TransformFormatPreservingEncrypt> | undefined): boolean {
+    return proto3.util.equals(TransformFormatPreservingEncrypt, a, b);
   }
 }
 
 /**
- * @generated from message mgmt.v1alpha1.ValidateUserRegexCodeResponse
+ * @generated from message mgmt.v1alpha1.TransformTimestamp
  */
-export class ValidateUserRegexCodeResponse extends Message<ValidateUserRegexCodeResponse> {
+export class TransformTimestamp extends Message<TransformTimestamp> {
   /**
-   * @generated from field: bool valid = 1;
+   * @generated from field: mgmt.v1alpha1.TimeTransformMode mode = 1;
    */
-  valid = false;
+  mode = TimeTransformMode.UNSPECIFIED;
 
-  constructor(data?: PartialMessage<ValidateUserRegexCodeResponse>) {
+  /**
+   * shift: the offset is picked in the inclusive range of [-shift_range_days, shift_range_days] days
+   *
+   * @generated from field: int64 shift_range_days = 2;
+   */
+  shiftRangeDays = protoInt64.zero;
+
+  /**
+   * shift: a column in the same table that identifies the entity of the row, for example a user id.
+   * values with the same entity are always shifted by the same offset. if unset, every value is shifted by the same offset
+   *
+   * @generated from field: optional string entity_column = 3;
+   */
+  entityColumn?: string;
+
+  /**
+   * jitter: the offset is picked in the inclusive range of [-jitter_range_seconds, jitter_range_seconds] seconds
+   *
+   * @generated from field: int64 jitter_range_seconds = 4;
+   */
+  jitterRangeSeconds = protoInt64.zero;
+
+  /**
+   * truncate: the precision to truncate the value to. defaults to month if unspecified
+   *
+   * @generated from field: mgmt.v1alpha1.TimeTruncatePrecision truncate_precision = 5;
+   */
+  truncatePrecision = TimeTruncatePrecision.UNSPECIFIED;
+
+  /**
+   * clamp: the lower bound of the range as an RFC 3339 timestamp
+   *
+   * @generated from field: optional string min = 6;
+   */
+  min?: string;
+
+  /**
+   * clamp: the upper bound of the range as an RFC 3339 timestamp
+   *
+   * @generated from field: optional string max = 7;
+   */
+  max?: string;
+
+  constructor(data?: PartialMessage<TransformTimestamp>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "mgmt.v1alpha1.ValidateUserRegexCodeResponse";
+  static readonly typeName = "mgmt.v1alpha1.TransformTimestamp";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "valid", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 1, name: "mode", kind: "enum", T: proto3.getEnumType(TimeTransformMode) },
+    { no: 2, name: "shift_range_days", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 3, name: "entity_column", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+    { no: 4, name: "jitter_range_seconds", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 5, name: "truncate_precision", kind: "enum", T: proto3.getEnumType(TimeTruncatePrecision) },
+    { no: 6, name: "min", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+    { no: 7, name: "max", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ValidateUserRegexCodeResponse {
-    return new ValidateUserRegexCodeResponse().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): TransformTimestamp {
+    return new TransformTimestamp().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ValidateUserRegexCodeResponse {
-    return new ValidateUserRegexCodeResponse().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): TransformTimestamp {
+    return new TransformTimestamp().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ValidateUserRegexCodeResponse {
-    return new ValidateUserRegexCodeResponse().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): TransformTimestamp {
+    return new TransformTimestamp().fromJsonString(jsonString, options);
   }
 
-  static equals(a: ValidateUserRegexCodeResponse | PlainMessage<ValidateUserRegexCodeResponse> | undefined, b: ValidateUserRegexCodeResponse | PlainMessage<ValidateUserRegexCodeResponse> | undefined): boolean {
-    return proto3.util.equals(ValidateUserRegexCodeResponse, a, b);
+  static equals(a: TransformTimestamp | PlainMessage<TransformTimestamp> | undefined, b: TransformTimestamp | PlainMessage<TransformTimestamp> | undefined): boolean {
+    return proto3.util.equals(TransformTimestamp, a, b);
+  }
+}
+
+/**
+ * @generated from message mgmt.v1alpha1.TransformDate
+ */
+export class TransformDate extends Message<TransformDate> {
+  /**
+   * @generated from field: mgmt.v1alpha1.TimeTransformMode mode = 1;
+   */
+  mode = TimeTransformMode.UNSPECIFIED;
+
+  /**
+   * shift: the offset is picked in the inclusive range of [-shift_range_days, shift_range_days] days
+   *
+   * @generated from field: int64 shift_range_days = 2;
+   */
+  shiftRangeDays = protoInt64.zero;
+
+  /**
+   * shift: a column in the same table that identifies the entity of the row, for example a user id.
+   * values with the same entity are always shifted by the same offset. if unset, every value is shifted by the same offset
+   *
+   * @generated from field: optional string entity_column = 3;
+   */
+  entityColumn?: string;
+
+  /**
+   * jitter: the offset is picked in the inclusive range of [-jitter_range_days, jitter_range_days] days
+   *
+   * @generated from field: int64 jitter_range_days = 4;
+   */
+  jitterRangeDays = protoInt64.zero;
+
+  /**
+   * truncate: the precision to truncate the value to. defaults to month if unspecified
+   *
+   * @generated from field: mgmt.v1alpha1.TimeTruncatePrecision truncate_precision = 5;
+   */
+  truncatePrecision = TimeTruncatePrecision.UNSPECIFIED;
+
+  /**
+   * clamp: the lower bound of the range as a YYYY-MM-DD date
+   *
+   * @generated from field: optional string min = 6;
+   */
+  min?: string;
+
+  /**
+   * clamp: the upper bound of the range as a YYYY-MM-DD date
+   *
+   * @generated from field: optional string max = 7;
+   */
+  max?: string;
+
+  constructor(data?: PartialMessage<TransformDate>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "mgmt.v1alpha1.TransformDate";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "mode", kind: "enum", T: proto3.getEnumType(TimeTransformMode) },
+    { no: 2, name: "shift_range_days", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 3, name: "entity_column", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+    { no: 4, name: "jitter_range_days", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 5, name: "truncate_precision", kind: "enum", T: proto3.getEnumType(TimeTruncatePrecision) },
+    { no: 6, name: "min", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+    { no: 7, name: "max", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): TransformDate {
+    return new TransformDate().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): TransformDate {
+    return new TransformDate().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): TransformDate {
+    return new TransformDate().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: TransformDate | PlainMessage<TransformDate> | undefined, b: TransformDate | PlainMessage<TransformDate> | undefined): boolean {
+    return proto3.util.equals(TransformDate, a, b);
+  }
+}
+
+/**
+ * @generated from message mgmt.v1alpha1.TransformJson
+ */
+export class TransformJson extends Message<TransformJson> {
+  /**
+   * the rules are run in order, so a value that is selected by more than one rule is transformed by each of them
+   *
+   * @generated from field: repeated mgmt.v1alpha1.TransformJsonRule rules = 1;
+   */
+  rules: TransformJsonRule[] = [];
+
+  constructor(data?: PartialMessage<TransformJson>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "mgmt.v1alpha1.TransformJson";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "rules", kind: "message", T: TransformJsonRule, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): TransformJson {
+    return new TransformJson().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): TransformJson {
+    return new TransformJson().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): TransformJson {
+    return new TransformJson().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: TransformJson | PlainMessage<TransformJson> | undefined, b: TransformJson | PlainMessage<TransformJson> | undefined): boolean {
+    return proto3.util.equals(TransformJson, a, b);
+  }
+}
+
+/**
+ * @generated from message mgmt.v1alpha1.TransformJsonRule
+ */
+export class TransformJsonRule extends Message<TransformJsonRule> {
+  /**
+   * a JSONPath expression that selects the values to transform, for example $.contact.email or $.tags[*]
+   * supports child keys, quoted keys, array indexes and wildcards
+   *
+   * @generated from field: string path = 1;
+   */
+  path = "";
+
+  /**
+   * the transformer that is run on every selected value
+   *
+   * @generated from field: mgmt.v1alpha1.TransformerSource source = 2;
+   */
+  source = TransformerSource.UNSPECIFIED;
+
+  /**
+   * @generated from field: mgmt.v1alpha1.TransformerConfig config = 3;
+   */
+  config?: TransformerConfig;
+
+  constructor(data?: PartialMessage<TransformJsonRule>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "mgmt.v1alpha1.TransformJsonRule";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "path", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "source", kind: "enum", T: proto3.getEnumType(TransformerSource) },
+    { no: 3, name: "config", kind: "message", T: TransformerConfig },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): TransformJsonRule {
+    return new TransformJsonRule().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): TransformJsonRule {
+    return new TransformJsonRule().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): TransformJsonRule {
+    return new TransformJsonRule().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: TransformJsonRule | PlainMessage<TransformJsonRule> | undefined, b: TransformJsonRule | PlainMessage<TransformJsonRule> | undefined): boolean {
+    return proto3.util.equals(TransformJsonRule, a, b);
+  }
+}
+
+/**
+ * @generated from message mgmt.v1alpha1.TransformFreeText
+ */
+export class TransformFreeText extends Message<TransformFreeText> {
+  /**
+   * the entity types to detect. every entity type is detected if empty
+   *
+   * @generated from field: repeated mgmt.v1alpha1.FreeTextEntityType entity_types = 1;
+   */
+  entityTypes: FreeTextEntityType[] = [];
+
+  /**
+   * defaults to mask if unspecified
+   *
+   * @generated from field: mgmt.v1alpha1.FreeTextReplacementStrategy strategy = 2;
+   */
+  strategy = FreeTextReplacementStrategy.UNSPECIFIED;
+
+  constructor(data?: PartialMessage<TransformFreeText>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "mgmt.v1alpha1.TransformFreeText";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "entity_types", kind: "enum", T: proto3.getEnumType(FreeTextEntityType), repeated: true },
+    { no: 2, name: "strategy", kind: "enum", T: proto3.getEnumType(FreeTextReplacementStrategy) },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): TransformFreeText {
+    return new TransformFreeText().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): TransformFreeText {
+    return new TransformFreeText().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): TransformFreeText {
+    return new TransformFreeText().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: TransformFreeText | PlainMessage<TransformFreeText> | undefined, b: TransformFreeText | PlainMessage<TransformFreeText> | undefined): boolean {
+    return proto3.util.equals(TransformFreeText, a, b);
+  }
+}
+
+/**
+ * @generated from message mgmt.v1alpha1.GenerateIpAddress
+ */
+export class GenerateIpAddress extends Message<GenerateIpAddress> {
+  /**
+   * defaults to ipv4 if unspecified
+   *
+   * @generated from field: mgmt.v1alpha1.IpAddressVersion version = 1;
+   */
+  version = IpAddressVersion.UNSPECIFIED;
+
+  constructor(data?: PartialMessage<GenerateIpAddress>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "mgmt.v1alpha1.GenerateIpAddress";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "version", kind: "enum", T: proto3.getEnumType(IpAddressVersion) },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GenerateIpAddress {
+    return new GenerateIpAddress().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GenerateIpAddress {
+    return new GenerateIpAddress().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GenerateIpAddress {
+    return new GenerateIpAddress().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GenerateIpAddress | PlainMessage<GenerateIpAddress> | undefined, b: GenerateIpAddress | PlainMessage<GenerateIpAddress> | undefined): boolean {
+    return proto3.util.equals(GenerateIpAddress, a, b);
+  }
+}
+
+/**
+ * @generated from message mgmt.v1alpha1.TransformIpAddress
+ */
+export class TransformIpAddress extends Message<TransformIpAddress> {
+  /**
+   * the number of leading bits of the address that are kept, for example 24 keeps the 10.1.2 of 10.1.2.3.
+   * the version of the address is always kept. values in CIDR notation keep their prefix length suffix
+   *
+   * @generated from field: int64 preserve_prefix_length = 1;
+   */
+  preservePrefixLength = protoInt64.zero;
+
+  /**
+   * keeps an ipv4 address in the same class (A to E) and keeps private, loopback and link local addresses of either version in their range
+   *
+   * @generated from field: bool preserve_class = 2;
+   */
+  preserveClass = false;
+
+  /**
+   * transforms the same input value to the same output using the account's transformer key
+   *
+   * @generated from field: bool deterministic = 3;
+   */
+  deterministic = false;
+
+  constructor(data?: PartialMessage<TransformIpAddress>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "mgmt.v1alpha1.TransformIpAddress";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "preserve_prefix_length", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 2, name: "preserve_class", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 3, name: "deterministic", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): TransformIpAddress {
+    return new TransformIpAddress().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): TransformIpAddress {
+    return new TransformIpAddress().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): TransformIpAddress {
+    return new TransformIpAddress().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: TransformIpAddress | PlainMessage<TransformIpAddress> | undefined, b: TransformIpAddress | PlainMessage<TransformIpAddress> | undefined): boolean {
+    return proto3.util.equals(TransformIpAddress, a, b);
+  }
+}
+
+/**
+ * @generated from message mgmt.v1alpha1.GenerateMacAddress
+ */
+export class GenerateMacAddress extends Message<GenerateMacAddress> {
+  constructor(data?: PartialMessage<GenerateMacAddress>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "mgmt.v1alpha1.GenerateMacAddress";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GenerateMacAddress {
+    return new GenerateMacAddress().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GenerateMacAddress {
+    return new GenerateMacAddress().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GenerateMacAddress {
+    return new GenerateMacAddress().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GenerateMacAddress | PlainMessage<GenerateMacAddress> | undefined, b: GenerateMacAddress | PlainMessage<GenerateMacAddress> | undefined): boolean {
+    return proto3.util.equals(GenerateMacAddress, a, b);
+  }
+}
+
+/**
+ * @generated from message mgmt.v1alpha1.TransformMacAddress
+ */
+export class TransformMacAddress extends Message<TransformMacAddress> {
+  /**
+   * keeps the first three bytes of the address, which identify the manufacturer of the device
+   *
+   * @generated from field: bool preserve_oui = 1;
+   */
+  preserveOui = false;
+
+  /**
+   * transforms the same input value to the same output using the account's transformer key
+   *
+   * @generated from field: bool deterministic = 2;
+   */
+  deterministic = false;
+
+  constructor(data?: PartialMessage<TransformMacAddress>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "mgmt.v1alpha1.TransformMacAddress";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "preserve_oui", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 2, name: "deterministic", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): TransformMacAddress {
+    return new TransformMacAddress().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): TransformMacAddress {
+    return new TransformMacAddress().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): TransformMacAddress {
+    return new TransformMacAddress().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: TransformMacAddress | PlainMessage<TransformMacAddress> | undefined, b: TransformMacAddress | PlainMessage<TransformMacAddress> | undefined): boolean {
+    return proto3.util.equals(TransformMacAddress, a, b);
+  }
+}
+
+/**
+ * @generated from message mgmt.v1alpha1.GenerateUrl
+ */
+export class GenerateUrl extends Message<GenerateUrl> {
+  constructor(data?: PartialMessage<GenerateUrl>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "mgmt.v1alpha1.GenerateUrl";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GenerateUrl {
+    return new GenerateUrl().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GenerateUrl {
+    return new GenerateUrl().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GenerateUrl {
+    return new GenerateUrl().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GenerateUrl | PlainMessage<GenerateUrl> | undefined, b: GenerateUrl | PlainMessage<GenerateUrl> | undefined): boolean {
+    return proto3.util.equals(GenerateUrl, a, b);
+  }
+}
+
+/**
+ * @generated from message mgmt.v1alpha1.TransformUrl
+ */
+export class TransformUrl extends Message<TransformUrl> {
+  /**
+   * keeps the path as is instead of replacing its characters
+   *
+   * @generated from field: bool preserve_path = 1;
+   */
+  preservePath = false;
+
+  /**
+   * transforms the same input value to the same output using the account's transformer key
+   *
+   * @generated from field: bool deterministic = 2;
+   */
+  deterministic = false;
+
+  constructor(data?: PartialMessage<TransformUrl>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "mgmt.v1alpha1.TransformUrl";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "preserve_path", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 2, name: "deterministic", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): TransformUrl {
+    return new TransformUrl().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): TransformUrl {
+    return new TransformUrl().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): TransformUrl {
+    return new TransformUrl().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: TransformUrl | PlainMessage<TransformUrl> | undefined, b: TransformUrl | PlainMessage<TransformUrl> | undefined): boolean {
+    return proto3.util.equals(TransformUrl, a, b);
+  }
+}
+
+/**
+ * @generated from message mgmt.v1alpha1.GenerateDomain
+ */
+export class GenerateDomain extends Message<GenerateDomain> {
+  constructor(data?: PartialMessage<GenerateDomain>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "mgmt.v1alpha1.GenerateDomain";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GenerateDomain {
+    return new GenerateDomain().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GenerateDomain {
+    return new GenerateDomain().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GenerateDomain {
+    return new GenerateDomain().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GenerateDomain | PlainMessage<GenerateDomain> | undefined, b: GenerateDomain | PlainMessage<GenerateDomain> | undefined): boolean {
+    return proto3.util.equals(GenerateDomain, a, b);
+  }
+}
+
+/**
+ * @generated from message mgmt.v1alpha1.TransformDomain
+ */
+export class TransformDomain extends Message<TransformDomain> {
+  /**
+   * keeps the top level domain, for example .com or .co.uk
+   *
+   * @generated from field: bool preserve_tld = 1;
+   */
+  preserveTld = false;
+
+  /**
+   * transforms the same input value to the same output using the account's transformer key
+   *
+   * @generated from field: bool deterministic = 2;
+   */
+  deterministic = false;
+
+  constructor(data?: PartialMessage<TransformDomain>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "mgmt.v1alpha1.TransformDomain";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "preserve_tld", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 2, name: "deterministic", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): TransformDomain {
+    return new TransformDomain().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): TransformDomain {
+    return new TransformDomain().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): TransformDomain {
+    return new TransformDomain().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: TransformDomain | PlainMessage<TransformDomain> | undefined, b: TransformDomain | PlainMessage<TransformDomain> | undefined): boolean {
+    return proto3.util.equals(TransformDomain, a, b);
+  }
+}
+
+/**
+ * @generated from message mgmt.v1alpha1.ValidateUserRegexCodeRequest
+ */
+export class ValidateUserRegexCodeRequest extends Message<ValidateUserRegexCodeRequest> {
+  /**
+   * @generated from field: string account_id = 1;
+   */
+  accountId = "";
+
+  /**
+   * @generated from field: string user_provided_regex = 2;
+   */
+  userProvidedRegex = "";
+
+  constructor(data?: PartialMessage<ValidateUserRegexCodeRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "mgmt.v1alpha1.ValidateUserRegexCodeRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "account_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "user_provided_regex", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ValidateUserRegexCodeRequest {
+    return new ValidateUserRegexCodeRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ValidateUserRegexCodeRequest {
+    return new ValidateUserRegexCodeRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ValidateUserRegexCodeRequest {
+    return new ValidateUserRegexCodeRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ValidateUserRegexCodeRequest | PlainMessage<ValidateUserRegexCodeRequest> | undefined, b: ValidateUserRegexCodeRequest | PlainMessage<ValidateUserRegexCodeRequest> | undefined): boolean {
+    return proto3.util.equals(ValidateUserRegexCodeRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message mgmt.v1alpha1.ValidateUserRegexCodeResponse
+ */
+export class ValidateUserRegexCodeResponse extends Message<ValidateUserRegexCodeResponse> {
+  /**
+   * @generated from field: bool valid = 1;
+   */
+  valid = false;
+
+  constructor(data?: PartialMessage<ValidateUserRegexCodeResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "mgmt.v1alpha1.ValidateUserRegexCodeResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "valid", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ValidateUserRegexCodeResponse {
+    return new ValidateUserRegexCodeResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ValidateUserRegexCodeResponse {
+    return new ValidateUserRegexCodeResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ValidateUserRegexCodeResponse {
+    return new ValidateUserRegexCodeResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ValidateUserRegexCodeResponse | PlainMessage<ValidateUserRegexCodeResponse> | undefined, b: ValidateUserRegexCodeResponse | PlainMessage<ValidateUserRegexCodeResponse> | undefined): boolean {
+    return proto3.util.equals(ValidateUserRegexCodeResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message mgmt.v1alpha1.ValidateTransformJsonConfigRequest
+ */
+export class ValidateTransformJsonConfigRequest extends Message<ValidateTransformJsonConfigRequest> {
+  /**
+   * @generated from field: string account_id = 1;
+   */
+  accountId = "";
+
+  /**
+   * @generated from field: mgmt.v1alpha1.TransformJson config = 2;
+   */
+  config?: TransformJson;
+
+  constructor(data?: PartialMessage<ValidateTransformJsonConfigRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "mgmt.v1alpha1.ValidateTransformJsonConfigRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "account_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "config", kind: "message", T: TransformJson },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ValidateTransformJsonConfigRequest {
+    return new ValidateTransformJsonConfigRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ValidateTransformJsonConfigRequest {
+    return new ValidateTransformJsonConfigRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ValidateTransformJsonConfigRequest {
+    return new ValidateTransformJsonConfigRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ValidateTransformJsonConfigRequest | PlainMessage<ValidateTransformJsonConfigRequest> | undefined, b: ValidateTransformJsonConfigRequest | PlainMessage<ValidateTransformJsonConfigRequest> | undefined): boolean {
+    return proto3.util.equals(ValidateTransformJsonConfigRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message mgmt.v1alpha1.ValidateTransformJsonConfigResponse
+ */
+export class ValidateTransformJsonConfigResponse extends Message<ValidateTransformJsonConfigResponse> {
+  /**
+   * @generated from field: bool valid = 1;
+   */
+  valid = false;
+
+  /**
+   * the reasons that the config is invalid
+   *
+   * @generated from field: repeated string errors = 2;
+   */
+  errors: string[] = [];
+
+  constructor(data?: PartialMessage<ValidateTransformJsonConfigResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "mgmt.v1alpha1.ValidateTransformJsonConfigResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "valid", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 2, name: "errors", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ValidateTransformJsonConfigResponse {
+    return new ValidateTransformJsonConfigResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ValidateTransformJsonConfigResponse {
+    return new ValidateTransformJsonConfigResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ValidateTransformJsonConfigResponse {
+    return new ValidateTransformJsonConfigResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ValidateTransformJsonConfigResponse | PlainMessage<ValidateTransformJsonConfigResponse> | undefined, b: ValidateTransformJsonConfigResponse | PlainMessage<ValidateTransformJsonConfigResponse> | undefined): boolean {
+    return proto3.util.equals(ValidateTransformJsonConfigResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message mgmt.v1alpha1.DecryptFormatPreservingRequest
+ */
+export class DecryptFormatPreservingRequest extends Message<DecryptFormatPreservingRequest> {
+  /**
+   * @generated from field: string account_id = 1;
+   */
+  accountId = "";
+
+  /**
+   * the value that was encrypted by the format preserving encrypt transformer
+   *
+   * @generated from field: string value = 2;
+   */
+  value = "";
+
+  /**
+   * @generated from field: mgmt.v1alpha1.FormatPreservingEncryptionAlgorithm algorithm = 3;
+   */
+  algorithm = FormatPreservingEncryptionAlgorithm.UNSPECIFIED;
+
+  /**
+   * the tweak that was configured on the transformer when the value was encrypted
+   *
+   * @generated from field: string tweak = 4;
+   */
+  tweak = "";
+
+  constructor(data?: PartialMessage<DecryptFormatPreservingRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "mgmt.v1alpha1.DecryptFormatPreservingRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "account_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "value", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "algorithm", kind: "enum", T: proto3.getEnumType(FormatPreservingEncryptionAlgorithm) },
+    { no: 4, name: "tweak", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): DecryptFormatPreservingRequest {
+    return new DecryptFormatPreservingRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): DecryptFormatPreservingRequest {
+    return new DecryptFormatPreservingRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): DecryptFormatPreservingRequest {
+    return new DecryptFormatPreservingRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: DecryptFormatPreservingRequest | PlainMessage<DecryptFormatPreservingRequest> | undefined, b: DecryptFormatPreservingRequest | PlainMessage<DecryptFormatPreservingRequest> | undefined): boolean {
+    return proto3.util.equals(DecryptFormatPreservingRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message mgmt.v1alpha1.DecryptFormatPreservingResponse
+ */
+export class DecryptFormatPreservingResponse extends Message<DecryptFormatPreservingResponse> {
+  /**
+   * @generated from field: string value = 1;
+   */
+  value = "";
+
+  constructor(data?: PartialMessage<DecryptFormatPreservingResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "mgmt.v1alpha1.DecryptFormatPreservingResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "value", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): DecryptFormatPreservingResponse {
+    return new DecryptFormatPreservingResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): DecryptFormatPreservingResponse {
+    return new DecryptFormatPreservingResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): DecryptFormatPreservingResponse {
+    return new DecryptFormatPreservingResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: DecryptFormatPreservingResponse | PlainMessage<DecryptFormatPreservingResponse> | undefined, b: DecryptFormatPreservingResponse | PlainMessage<DecryptFormatPreservingResponse> | undefined): boolean {
+    return proto3.util.equals(DecryptFormatPreservingResponse, a, b);
   }
 }
 
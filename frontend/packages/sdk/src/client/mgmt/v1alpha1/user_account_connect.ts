@@ -3,7 +3,7 @@
 /* eslint-disable */
 // @ts-nocheck
 
-import { AcceptTeamAccountInviteRequest, AcceptTeamAccountInviteResponse, ConvertPersonalToTeamAccountRequest, ConvertPersonalToTeamAccountResponse, CreateTeamAccountRequest, CreateTeamAccountResponse, GetAccountOnboardingConfigRequest, GetAccountOnboardingConfigResponse, GetAccountTemporalConfigRequest, GetAccountTemporalConfigResponse, GetSystemInformationRequest, GetSystemInformationResponse, GetTeamAccountInvitesRequest, GetTeamAccountInvitesResponse, GetTeamAccountMembersRequest, GetTeamAccountMembersResponse, GetUserAccountsRequest, GetUserAccountsResponse, GetUserRequest, GetUserResponse, InviteUserToTeamAccountRequest, InviteUserToTeamAccountResponse, IsUserInAccountRequest, IsUserInAccountResponse, RemoveTeamAccountInviteRequest, RemoveTeamAccountInviteResponse, RemoveTeamAccountMemberRequest, RemoveTeamAccountMemberResponse, SetAccountOnboardingConfigRequest, SetAccountOnboardingConfigResponse, SetAccountTemporalConfigRequest, SetAccountTemporalConfigResponse, SetPersonalAccountRequest, SetPersonalAccountResponse, SetUserRequest, SetUserResponse } from "./user_account_pb.js";
+import { AcceptTeamAccountInviteRequest, AcceptTeamAccountInviteResponse, ConvertPersonalToTeamAccountRequest, ConvertPersonalToTeamAccountResponse, CreateTeamAccountRequest, CreateTeamAccountResponse, GetAccountOnboardingConfigRequest, GetAccountOnboardingConfigResponse, GetAccountTemporalConfigRequest, GetAccountTemporalConfigResponse, GetAccountTransformerKeyRequest, GetAccountTransformerKeyResponse, GetSystemInformationRequest, GetSystemInformationResponse, GetTeamAccountInvitesRequest, GetTeamAccountInvitesResponse, GetTeamAccountMembersRequest, GetTeamAccountMembersResponse, GetUserAccountsRequest, GetUserAccountsResponse, GetUserRequest, GetUserResponse, InviteUserToTeamAccountRequest, InviteUserToTeamAccountResponse, IsUserInAccountRequest, IsUserInAccountResponse, RemoveTeamAccountInviteRequest, RemoveTeamAccountInviteResponse, RemoveTeamAccountMemberRequest, RemoveTeamAccountMemberResponse, SetAccountOnboardingConfigRequest, SetAccountOnboardingConfigResponse, SetAccountTemporalConfigRequest, SetAccountTemporalConfigResponse, SetAccountTransformerKeyRequest, SetAccountTransformerKeyResponse, SetPersonalAccountRequest, SetPersonalAccountResponse, SetTeamAccountMemberRoleRequest, SetTeamAccountMemberRoleResponse, SetUserRequest, SetUserResponse } from "./user_account_pb.js";
 import { MethodKind } from "@bufbuild/protobuf";
 
 /**
@@ -93,6 +93,24 @@ export const UserAccountService = {
       O: SetAccountTemporalConfigResponse,
       kind: MethodKind.Unary,
     },
+    /**
+     * @generated from rpc mgmt.v1alpha1.UserAccountService.GetAccountTransformerKey
+     */
+    getAccountTransformerKey: {
+      name: "GetAccountTransformerKey",
+      I: GetAccountTransformerKeyRequest,
+      O: GetAccountTransformerKeyResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc mgmt.v1alpha1.UserAccountService.SetAccountTransformerKey
+     */
+    setAccountTransformerKey: {
+      name: "SetAccountTransformerKey",
+      I: SetAccountTransformerKeyRequest,
+      O: SetAccountTransformerKeyResponse,
+      kind: MethodKind.Unary,
+    },
     /**
      * @generated from rpc mgmt.v1alpha1.UserAccountService.GetTeamAccountMembers
      */
@@ -111,6 +129,15 @@ export const UserAccountService = {
       O: RemoveTeamAccountMemberResponse,
       kind: MethodKind.Unary,
     },
+    /**
+     * @generated from rpc mgmt.v1alpha1.UserAccountService.SetTeamAccountMemberRole
+     */
+    setTeamAccountMemberRole: {
+      name: "SetTeamAccountMemberRole",
+      I: SetTeamAccountMemberRoleRequest,
+      O: SetTeamAccountMemberRoleResponse,
+      kind: MethodKind.Unary,
+    },
     /**
      * @generated from rpc mgmt.v1alpha1.UserAccountService.InviteUserToTeamAccount
      */
@@ -32,6 +32,54 @@ proto3.util.setEnumType(UserAccountType, "mgmt.v1alpha1.UserAccountType", [
   { no: 2, name: "USER_ACCOUNT_TYPE_TEAM" },
 ]);
 
+/**
+ * The role of a user in an account. Each role is granted everything that the roles below it are granted
+ *
+ * @generated from enum mgmt.v1alpha1.AccountRole
+ */
+export enum AccountRole {
+  /**
+   * @generated from enum value: ACCOUNT_ROLE_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * May view all of the resources in the account
+   *
+   * @generated from enum value: ACCOUNT_ROLE_VIEWER = 1;
+   */
+  VIEWER = 1,
+
+  /**
+   * May additionally trigger, pause, cancel and preview jobs
+   *
+   * @generated from enum value: ACCOUNT_ROLE_JOB_OPERATOR = 2;
+   */
+  JOB_OPERATOR = 2,
+
+  /**
+   * May additionally create, update and delete connections, jobs, transformers and api keys, and manage the members of the account
+   *
+   * @generated from enum value: ACCOUNT_ROLE_ADMIN = 3;
+   */
+  ADMIN = 3,
+
+  /**
+   * May additionally manage the account's owners, temporal config and transformer key
+   *
+   * @generated from enum value: ACCOUNT_ROLE_OWNER = 4;
+   */
+  OWNER = 4,
+}
+// Retrieve enum metadata with: proto3.getEnumType(AccountRole)
+proto3.util.setEnumType(AccountRole, "mgmt.v1alpha1.AccountRole", [
+  { no: 0, name: "ACCOUNT_ROLE_UNSPECIFIED" },
+  { no: 1, name: "ACCOUNT_ROLE_VIEWER" },
+  { no: 2, name: "ACCOUNT_ROLE_JOB_OPERATOR" },
+  { no: 3, name: "ACCOUNT_ROLE_ADMIN" },
+  { no: 4, name: "ACCOUNT_ROLE_OWNER" },
+]);
+
 /**
  * @generated from message mgmt.v1alpha1.GetUserRequest
  */
@@ -643,6 +691,175 @@ export class SetAccountTemporalConfigResponse extends Message<SetAccountTemporal
   }
 }
 
+/**
+ * @generated from message mgmt.v1alpha1.GetAccountTransformerKeyRequest
+ */
+export class GetAccountTransformerKeyRequest extends Message<GetAccountTransformerKeyRequest> {
+  /**
+   * @generated from field: string account_id = 1;
+   */
+  accountId = "";
+
+  constructor(data?: PartialMessage<GetAccountTransformerKeyRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "mgmt.v1alpha1.GetAccountTransformerKeyRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "account_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetAccountTransformerKeyRequest {
+    return new GetAccountTransformerKeyRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetAccountTransformerKeyRequest {
+    return new GetAccountTransformerKeyRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetAccountTransformerKeyRequest {
+    return new GetAccountTransformerKeyRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GetAccountTransformerKeyRequest | PlainMessage<GetAccountTransformerKeyRequest> | undefined, b: GetAccountTransformerKeyRequest | PlainMessage<GetAccountTransformerKeyRequest> | undefined): boolean {
+    return proto3.util.equals(GetAccountTransformerKeyRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message mgmt.v1alpha1.GetAccountTransformerKeyResponse
+ */
+export class GetAccountTransformerKeyResponse extends Message<GetAccountTransformerKeyResponse> {
+  /**
+   * The secret that deterministic transformers are keyed with. Generated the first time it is requested.
+   *
+   * @generated from field: string key = 1;
+   */
+  key = "";
+
+  constructor(data?: PartialMessage<GetAccountTransformerKeyResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "mgmt.v1alpha1.GetAccountTransformerKeyResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "key", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetAccountTransformerKeyResponse {
+    return new GetAccountTransformerKeyResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetAccountTransformerKeyResponse {
+    return new GetAccountTransformerKeyResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetAccountTransformerKeyResponse {
+    return new GetAccountTransformerKeyResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GetAccountTransformerKeyResponse | PlainMessage<GetAccountTransformerKeyResponse> | undefined, b: GetAccountTransformerKeyResponse | PlainMessage<GetAccountTransformerKeyResponse> | undefined): boolean {
+    return proto3.util.equals(GetAccountTransformerKeyResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message mgmt.v1alpha1.SetAccountTransformerKeyRequest
+ */
+export class SetAccountTransformerKeyRequest extends Message<SetAccountTransformerKeyRequest> {
+  /**
+   * @generated from field: string account_id = 1;
+   */
+  accountId = "";
+
+  /**
+   * The new key. A random key is generated if not provided.
+   * Changing the key changes the output of every deterministic transformer in the account.
+   * Values encrypted by the format preserving encrypt transformer with the previous key can no longer be decrypted,
+   * so the key is only changed while a job or user defined transformer of the account uses that transformer if force is set.
+   *
+   * @generated from field: optional string key = 2;
+   */
+  key?: string;
+
+  /**
+   * Changes the key even though format preserving encrypt transformers are in use
+   *
+   * @generated from field: bool force = 3;
+   */
+  force = false;
+
+  constructor(data?: PartialMessage<SetAccountTransformerKeyRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "mgmt.v1alpha1.SetAccountTransformerKeyRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "account_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "key", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+    { no: 3, name: "force", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SetAccountTransformerKeyRequest {
+    return new SetAccountTransformerKeyRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SetAccountTransformerKeyRequest {
+    return new SetAccountTransformerKeyRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SetAccountTransformerKeyRequest {
+    return new SetAccountTransformerKeyRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: SetAccountTransformerKeyRequest | PlainMessage<SetAccountTransformerKeyRequest> | undefined, b: SetAccountTransformerKeyRequest | PlainMessage<SetAccountTransformerKeyRequest> | undefined): boolean {
+    return proto3.util.equals(SetAccountTransformerKeyRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message mgmt.v1alpha1.SetAccountTransformerKeyResponse
+ */
+export class SetAccountTransformerKeyResponse extends Message<SetAccountTransformerKeyResponse> {
+  /**
+   * @generated from field: string key = 1;
+   */
+  key = "";
+
+  constructor(data?: PartialMessage<SetAccountTransformerKeyResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "mgmt.v1alpha1.SetAccountTransformerKeyResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "key", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SetAccountTransformerKeyResponse {
+    return new SetAccountTransformerKeyResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SetAccountTransformerKeyResponse {
+    return new SetAccountTransformerKeyResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SetAccountTransformerKeyResponse {
+    return new SetAccountTransformerKeyResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: SetAccountTransformerKeyResponse | PlainMessage<SetAccountTransformerKeyResponse> | undefined, b: SetAccountTransformerKeyResponse | PlainMessage<SetAccountTransformerKeyResponse> | undefined): boolean {
+    return proto3.util.equals(SetAccountTransformerKeyResponse, a, b);
+  }
+}
+
 /**
  * @generated from message mgmt.v1alpha1.AccountTemporalConfig
  */
@@ -790,6 +1007,13 @@ export class AccountUser extends Message<AccountUser> {
    */
   email = "";
 
+  /**
+   * The role of the user in the account
+   *
+   * @generated from field: mgmt.v1alpha1.AccountRole role = 5;
+   */
+  role = AccountRole.UNSPECIFIED;
+
   constructor(data?: PartialMessage<AccountUser>) {
     super();
     proto3.util.initPartial(data, this);
@@ -802,6 +1026,7 @@ export class AccountUser extends Message<AccountUser> {
     { no: 2, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
     { no: 3, name: "image", kind: "scalar", T: 9 /* ScalarType.STRING */ },
     { no: 4, name: "email", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 5, name: "role", kind: "enum", T: proto3.getEnumType(AccountRole) },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): AccountUser {
@@ -969,6 +1194,92 @@ export class RemoveTeamAccountMemberResponse extends Message<RemoveTeamAccountMe
   }
 }
 
+/**
+ * @generated from message mgmt.v1alpha1.SetTeamAccountMemberRoleRequest
+ */
+export class SetTeamAccountMemberRoleRequest extends Message<SetTeamAccountMemberRoleRequest> {
+  /**
+   * @generated from field: string account_id = 1;
+   */
+  accountId = "";
+
+  /**
+   * @generated from field: string user_id = 2;
+   */
+  userId = "";
+
+  /**
+   * @generated from field: mgmt.v1alpha1.AccountRole role = 3;
+   */
+  role = AccountRole.UNSPECIFIED;
+
+  constructor(data?: PartialMessage<SetTeamAccountMemberRoleRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "mgmt.v1alpha1.SetTeamAccountMemberRoleRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "account_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "user_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "role", kind: "enum", T: proto3.getEnumType(AccountRole) },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SetTeamAccountMemberRoleRequest {
+    return new SetTeamAccountMemberRoleRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SetTeamAccountMemberRoleRequest {
+    return new SetTeamAccountMemberRoleRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SetTeamAccountMemberRoleRequest {
+    return new SetTeamAccountMemberRoleRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: SetTeamAccountMemberRoleRequest | PlainMessage<SetTeamAccountMemberRoleRequest> | undefined, b: SetTeamAccountMemberRoleRequest | PlainMessage<SetTeamAccountMemberRoleRequest> | undefined): boolean {
+    return proto3.util.equals(SetTeamAccountMemberRoleRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message mgmt.v1alpha1.SetTeamAccountMemberRoleResponse
+ */
+export class SetTeamAccountMemberRoleResponse extends Message<SetTeamAccountMemberRoleResponse> {
+  /**
+   * @generated from field: mgmt.v1alpha1.AccountUser user = 1;
+   */
+  user?: AccountUser;
+
+  constructor(data?: PartialMessage<SetTeamAccountMemberRoleResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "mgmt.v1alpha1.SetTeamAccountMemberRoleResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "user", kind: "message", T: AccountUser },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SetTeamAccountMemberRoleResponse {
+    return new SetTeamAccountMemberRoleResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SetTeamAccountMemberRoleResponse {
+    return new SetTeamAccountMemberRoleResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SetTeamAccountMemberRoleResponse {
+    return new SetTeamAccountMemberRoleResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: SetTeamAccountMemberRoleResponse | PlainMessage<SetTeamAccountMemberRoleResponse> | undefined, b: SetTeamAccountMemberRoleResponse | PlainMessage<SetTeamAccountMemberRoleResponse> | undefined): boolean {
+    return proto3.util.equals(SetTeamAccountMemberRoleResponse, a, b);
+  }
+}
+
 /**
  * @generated from message mgmt.v1alpha1.InviteUserToTeamAccountRequest
  */
@@ -983,6 +1294,13 @@ export class InviteUserToTeamAccountRequest extends Message<InviteUserToTeamAcco
    */
   email = "";
 
+  /**
+   * The role the user is given in the account once the invite is accepted. Defaults to admin
+   *
+   * @generated from field: optional mgmt.v1alpha1.AccountRole role = 3;
+   */
+  role?: AccountRole;
+
   constructor(data?: PartialMessage<InviteUserToTeamAccountRequest>) {
     super();
     proto3.util.initPartial(data, this);
@@ -993,6 +1311,7 @@ export class InviteUserToTeamAccountRequest extends Message<InviteUserToTeamAcco
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
     { no: 1, name: "account_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
     { no: 2, name: "email", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "role", kind: "enum", T: proto3.getEnumType(AccountRole), opt: true },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): InviteUserToTeamAccountRequest {
@@ -1061,6 +1380,13 @@ export class AccountInvite extends Message<AccountInvite> {
    */
   expiresAt?: Timestamp;
 
+  /**
+   * The role the user is given in the account once the invite is accepted
+   *
+   * @generated from field: mgmt.v1alpha1.AccountRole role = 10;
+   */
+  role = AccountRole.UNSPECIFIED;
+
   constructor(data?: PartialMessage<AccountInvite>) {
     super();
     proto3.util.initPartial(data, this);
@@ -1078,6 +1404,7 @@ export class AccountInvite extends Message<AccountInvite> {
     { no: 7, name: "created_at", kind: "message", T: Timestamp },
     { no: 8, name: "updated_at", kind: "message", T: Timestamp },
     { no: 9, name: "expires_at", kind: "message", T: Timestamp },
+    { no: 10, name: "role", kind: "enum", T: proto3.getEnumType(AccountRole) },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): AccountInvite {
@@ -0,0 +1,96 @@
+// @generated by protoc-gen-connect-es v1.4.0 with parameter "target=ts,import_extension=.js"
+// @generated from file mgmt/v1alpha1/webhook.proto (package mgmt.v1alpha1, syntax proto3)
+/* eslint-disable */
+// @ts-nocheck
+
+import { CreateWebhookRequest, CreateWebhookResponse, DeleteWebhookRequest, DeleteWebhookResponse, GetWebhookDeliveriesRequest, GetWebhookDeliveriesResponse, GetWebhookRequest, GetWebhookResponse, GetWebhooksRequest, GetWebhooksResponse, PublishJobRunEventRequest, PublishJobRunEventResponse, UpdateWebhookRequest, UpdateWebhookResponse } from "./webhook_pb.js";
+import { MethodKind } from "@bufbuild/protobuf";
+
+/**
+ * Service that manages the webhooks that are notified of the job runs of an account
+ *
+ * @generated from service mgmt.v1alpha1.WebhookService
+ */
+export const WebhookService = {
+  typeName: "mgmt.v1alpha1.WebhookService",
+  methods: {
+    /**
+     * Retrieves the webhooks of an account
+     *
+     * @generated from rpc mgmt.v1alpha1.WebhookService.GetWebhooks
+     */
+    getWebhooks: {
+      name: "GetWebhooks",
+      I: GetWebhooksRequest,
+      O: GetWebhooksResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * Retrieves a single webhook
+     *
+     * @generated from rpc mgmt.v1alpha1.WebhookService.GetWebhook
+     */
+    getWebhook: {
+      name: "GetWebhook",
+      I: GetWebhookRequest,
+      O: GetWebhookResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * Creates a webhook. This method returns the secret that payloads are signed with
+     *
+     * @generated from rpc mgmt.v1alpha1.WebhookService.CreateWebhook
+     */
+    createWebhook: {
+      name: "CreateWebhook",
+      I: CreateWebhookRequest,
+      O: CreateWebhookResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * Updates a webhook. This method returns the secret if it was changed
+     *
+     * @generated from rpc mgmt.v1alpha1.WebhookService.UpdateWebhook
+     */
+    updateWebhook: {
+      name: "UpdateWebhook",
+      I: UpdateWebhookRequest,
+      O: UpdateWebhookResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * Deletes a webhook along with its delivery log
+     *
+     * @generated from rpc mgmt.v1alpha1.WebhookService.DeleteWebhook
+     */
+    deleteWebhook: {
+      name: "DeleteWebhook",
+      I: DeleteWebhookRequest,
+      O: DeleteWebhookResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * Returns the most recent attempts to deliver events to a webhook
+     *
+     * @generated from rpc mgmt.v1alpha1.WebhookService.GetWebhookDeliveries
+     */
+    getWebhookDeliveries: {
+      name: "GetWebhookDeliveries",
+      I: GetWebhookDeliveriesRequest,
+      O: GetWebhookDeliveriesResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * Sends a job run event to the webhooks of the job's account. Called by the worker while a job runs
+     *
+     * @generated from rpc mgmt.v1alpha1.WebhookService.PublishJobRunEvent
+     */
+    publishJobRunEvent: {
+      name: "PublishJobRunEvent",
+      I: PublishJobRunEventRequest,
+      O: PublishJobRunEventResponse,
+      kind: MethodKind.Unary,
+    },
+  }
+} as const;
+
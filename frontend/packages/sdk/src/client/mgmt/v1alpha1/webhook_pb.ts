@@ -0,0 +1,874 @@
+// @generated by protoc-gen-es v1.8.0 with parameter "target=ts,import_extension=.js"
+// @generated from file mgmt/v1alpha1/webhook.proto (package mgmt.v1alpha1, syntax proto3)
+/* eslint-disable */
+// @ts-nocheck
+
+import type { BinaryReadOptions, FieldList, JsonReadOptions, JsonValue, PartialMessage, PlainMessage } from "@bufbuild/protobuf";
+import { Message, proto3, Timestamp } from "@bufbuild/protobuf";
+
+/**
+ * @generated from enum mgmt.v1alpha1.WebhookEventType
+ */
+export enum WebhookEventType {
+  /**
+   * @generated from enum value: WEBHOOK_EVENT_TYPE_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * A job run has started. Sent as job_run.started
+   *
+   * @generated from enum value: WEBHOOK_EVENT_TYPE_JOB_RUN_STARTED = 1;
+   */
+  JOB_RUN_STARTED = 1,
+
+  /**
+   * A job run completed successfully. Sent as job_run.succeeded
+   *
+   * @generated from enum value: WEBHOOK_EVENT_TYPE_JOB_RUN_SUCCEEDED = 2;
+   */
+  JOB_RUN_SUCCEEDED = 2,
+
+  /**
+   * A job run failed. Sent as job_run.failed
+   *
+   * @generated from enum value: WEBHOOK_EVENT_TYPE_JOB_RUN_FAILED = 3;
+   */
+  JOB_RUN_FAILED = 3,
+
+  /**
+   * A job run was canceled before it completed. Sent as job_run.canceled
+   *
+   * @generated from enum value: WEBHOOK_EVENT_TYPE_JOB_RUN_CANCELED = 4;
+   */
+  JOB_RUN_CANCELED = 4,
+
+  /**
+   * A job run was halted because the source schema no longer matches the job mappings. Sent as job_run.schema_drift_halted
+   *
+   * @generated from enum value: WEBHOOK_EVENT_TYPE_JOB_RUN_SCHEMA_DRIFT_HALTED = 5;
+   */
+  JOB_RUN_SCHEMA_DRIFT_HALTED = 5,
+}
+// Retrieve enum metadata with: proto3.getEnumType(WebhookEventType)
+proto3.util.setEnumType(WebhookEventType, "mgmt.v1alpha1.WebhookEventType", [
+  { no: 0, name: "WEBHOOK_EVENT_TYPE_UNSPECIFIED" },
+  { no: 1, name: "WEBHOOK_EVENT_TYPE_JOB_RUN_STARTED" },
+  { no: 2, name: "WEBHOOK_EVENT_TYPE_JOB_RUN_SUCCEEDED" },
+  { no: 3, name: "WEBHOOK_EVENT_TYPE_JOB_RUN_FAILED" },
+  { no: 4, name: "WEBHOOK_EVENT_TYPE_JOB_RUN_CANCELED" },
+  { no: 5, name: "WEBHOOK_EVENT_TYPE_JOB_RUN_SCHEMA_DRIFT_HALTED" },
+]);
+
+/**
+ * An endpoint that is sent a signed JSON payload for each job run event of the account
+ *
+ * @generated from message mgmt.v1alpha1.Webhook
+ */
+export class Webhook extends Message<Webhook> {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id = "";
+
+  /**
+   * @generated from field: string account_id = 2;
+   */
+  accountId = "";
+
+  /**
+   * The friendly name of the webhook
+   *
+   * @generated from field: string name = 3;
+   */
+  name = "";
+
+  /**
+   * The url that events are sent to with a POST request
+   *
+   * @generated from field: string url = 4;
+   */
+  url = "";
+
+  /**
+   * The events that are sent to the webhook. Every event is sent if empty
+   *
+   * @generated from field: repeated mgmt.v1alpha1.WebhookEventType event_types = 5;
+   */
+  eventTypes: WebhookEventType[] = [];
+
+  /**
+   * Events are not sent to disabled webhooks
+   *
+   * @generated from field: bool enabled = 6;
+   */
+  enabled = false;
+
+  /**
+   * @generated from field: string created_by_id = 7;
+   */
+  createdById = "";
+
+  /**
+   * @generated from field: google.protobuf.Timestamp created_at = 8;
+   */
+  createdAt?: Timestamp;
+
+  /**
+   * @generated from field: string updated_by_id = 9;
+   */
+  updatedById = "";
+
+  /**
+   * @generated from field: google.protobuf.Timestamp updated_at = 10;
+   */
+  updatedAt?: Timestamp;
+
+  /**
+   * The secret that payloads are signed with. Only returned when the webhook is created or its secret is changed
+   *
+   * @generated from field: optional string secret = 11;
+   */
+  secret?: string;
+
+  constructor(data?: PartialMessage<Webhook>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "mgmt.v1alpha1.Webhook";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "account_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "url", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 5, name: "event_types", kind: "enum", T: proto3.getEnumType(WebhookEventType), repeated: true },
+    { no: 6, name: "enabled", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 7, name: "created_by_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 8, name: "created_at", kind: "message", T: Timestamp },
+    { no: 9, name: "updated_by_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 10, name: "updated_at", kind: "message", T: Timestamp },
+    { no: 11, name: "secret", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): Webhook {
+    return new Webhook().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): Webhook {
+    return new Webhook().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): Webhook {
+    return new Webhook().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: Webhook | PlainMessage<Webhook> | undefined, b: Webhook | PlainMessage<Webhook> | undefined): boolean {
+    return proto3.util.equals(Webhook, a, b);
+  }
+}
+
+/**
+ * @generated from message mgmt.v1alpha1.GetWebhooksRequest
+ */
+export class GetWebhooksRequest extends Message<GetWebhooksRequest> {
+  /**
+   * @generated from field: string account_id = 1;
+   */
+  accountId = "";
+
+  constructor(data?: PartialMessage<GetWebhooksRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "mgmt.v1alpha1.GetWebhooksRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "account_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetWebhooksRequest {
+    return new GetWebhooksRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetWebhooksRequest {
+    return new GetWebhooksRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetWebhooksRequest {
+    return new GetWebhooksRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GetWebhooksRequest | PlainMessage<GetWebhooksRequest> | undefined, b: GetWebhooksRequest | PlainMessage<GetWebhooksRequest> | undefined): boolean {
+    return proto3.util.equals(GetWebhooksRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message mgmt.v1alpha1.GetWebhooksResponse
+ */
+export class GetWebhooksResponse extends Message<GetWebhooksResponse> {
+  /**
+   * @generated from field: repeated mgmt.v1alpha1.Webhook webhooks = 1;
+   */
+  webhooks: Webhook[] = [];
+
+  constructor(data?: PartialMessage<GetWebhooksResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "mgmt.v1alpha1.GetWebhooksResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "webhooks", kind: "message", T: Webhook, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetWebhooksResponse {
+    return new GetWebhooksResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetWebhooksResponse {
+    return new GetWebhooksResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetWebhooksResponse {
+    return new GetWebhooksResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GetWebhooksResponse | PlainMessage<GetWebhooksResponse> | undefined, b: GetWebhooksResponse | PlainMessage<GetWebhooksResponse> | undefined): boolean {
+    return proto3.util.equals(GetWebhooksResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message mgmt.v1alpha1.GetWebhookRequest
+ */
+export class GetWebhookRequest extends Message<GetWebhookRequest> {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id = "";
+
+  constructor(data?: PartialMessage<GetWebhookRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "mgmt.v1alpha1.GetWebhookRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetWebhookRequest {
+    return new GetWebhookRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetWebhookRequest {
+    return new GetWebhookRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetWebhookRequest {
+    return new GetWebhookRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GetWebhookRequest | PlainMessage<GetWebhookRequest> | undefined, b: GetWebhookRequest | PlainMessage<GetWebhookRequest> | undefined): boolean {
+    return proto3.util.equals(GetWebhookRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message mgmt.v1alpha1.GetWebhookResponse
+ */
+export class GetWebhookResponse extends Message<GetWebhookResponse> {
+  /**
+   * @generated from field: mgmt.v1alpha1.Webhook webhook = 1;
+   */
+  webhook?: Webhook;
+
+  constructor(data?: PartialMessage<GetWebhookResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "mgmt.v1alpha1.GetWebhookResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "webhook", kind: "message", T: Webhook },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetWebhookResponse {
+    return new GetWebhookResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetWebhookResponse {
+    return new GetWebhookResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetWebhookResponse {
+    return new GetWebhookResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GetWebhookResponse | PlainMessage<GetWebhookResponse> | undefined, b: GetWebhookResponse | PlainMessage<GetWebhookResponse> | undefined): boolean {
+    return proto3.util.equals(GetWebhookResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message mgmt.v1alpha1.CreateWebhookRequest
+ */
+export class CreateWebhookRequest extends Message<CreateWebhookRequest> {
+  /**
+   * @generated from field: string account_id = 1;
+   */
+  accountId = "";
+
+  /**
+   * @generated from field: string name = 2;
+   */
+  name = "";
+
+  /**
+   * @generated from field: string url = 3;
+   */
+  url = "";
+
+  /**
+   * @generated from field: repeated mgmt.v1alpha1.WebhookEventType event_types = 4;
+   */
+  eventTypes: WebhookEventType[] = [];
+
+  /**
+   * The secret that payloads are signed with. A random secret is generated if not provided
+   *
+   * @generated from field: optional string secret = 5;
+   */
+  secret?: string;
+
+  /**
+   * @generated from field: bool enabled = 6;
+   */
+  enabled = false;
+
+  constructor(data?: PartialMessage<CreateWebhookRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "mgmt.v1alpha1.CreateWebhookRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "account_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "url", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "event_types", kind: "enum", T: proto3.getEnumType(WebhookEventType), repeated: true },
+    { no: 5, name: "secret", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+    { no: 6, name: "enabled", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CreateWebhookRequest {
+    return new CreateWebhookRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CreateWebhookRequest {
+    return new CreateWebhookRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CreateWebhookRequest {
+    return new CreateWebhookRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: CreateWebhookRequest | PlainMessage<CreateWebhookRequest> | undefined, b: CreateWebhookRequest | PlainMessage<CreateWebhookRequest> | undefined): boolean {
+    return proto3.util.equals(CreateWebhookRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message mgmt.v1alpha1.CreateWebhookResponse
+ */
+export class CreateWebhookResponse extends Message<CreateWebhookResponse> {
+  /**
+   * @generated from field: mgmt.v1alpha1.Webhook webhook = 1;
+   */
+  webhook?: Webhook;
+
+  constructor(data?: PartialMessage<CreateWebhookResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "mgmt.v1alpha1.CreateWebhookResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "webhook", kind: "message", T: Webhook },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CreateWebhookResponse {
+    return new CreateWebhookResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CreateWebhookResponse {
+    return new CreateWebhookResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CreateWebhookResponse {
+    return new CreateWebhookResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: CreateWebhookResponse | PlainMessage<CreateWebhookResponse> | undefined, b: CreateWebhookResponse | PlainMessage<CreateWebhookResponse> | undefined): boolean {
+    return proto3.util.equals(CreateWebhookResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message mgmt.v1alpha1.UpdateWebhookRequest
+ */
+export class UpdateWebhookRequest extends Message<UpdateWebhookRequest> {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id = "";
+
+  /**
+   * @generated from field: string name = 2;
+   */
+  name = "";
+
+  /**
+   * @generated from field: string url = 3;
+   */
+  url = "";
+
+  /**
+   * @generated from field: repeated mgmt.v1alpha1.WebhookEventType event_types = 4;
+   */
+  eventTypes: WebhookEventType[] = [];
+
+  /**
+   * Replaces the secret that payloads are signed with. The current secret is kept if not provided
+   *
+   * @generated from field: optional string secret = 5;
+   */
+  secret?: string;
+
+  /**
+   * @generated from field: bool enabled = 6;
+   */
+  enabled = false;
+
+  constructor(data?: PartialMessage<UpdateWebhookRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "mgmt.v1alpha1.UpdateWebhookRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "url", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "event_types", kind: "enum", T: proto3.getEnumType(WebhookEventType), repeated: true },
+    { no: 5, name: "secret", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+    { no: 6, name: "enabled", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): UpdateWebhookRequest {
+    return new UpdateWebhookRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): UpdateWebhookRequest {
+    return new UpdateWebhookRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): UpdateWebhookRequest {
+    return new UpdateWebhookRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: UpdateWebhookRequest | PlainMessage<UpdateWebhookRequest> | undefined, b: UpdateWebhookRequest | PlainMessage<UpdateWebhookRequest> | undefined): boolean {
+    return proto3.util.equals(UpdateWebhookRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message mgmt.v1alpha1.UpdateWebhookResponse
+ */
+export class UpdateWebhookResponse extends Message<UpdateWebhookResponse> {
+  /**
+   * @generated from field: mgmt.v1alpha1.Webhook webhook = 1;
+   */
+  webhook?: Webhook;
+
+  constructor(data?: PartialMessage<UpdateWebhookResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "mgmt.v1alpha1.UpdateWebhookResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "webhook", kind: "message", T: Webhook },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): UpdateWebhookResponse {
+    return new UpdateWebhookResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): UpdateWebhookResponse {
+    return new UpdateWebhookResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): UpdateWebhookResponse {
+    return new UpdateWebhookResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: UpdateWebhookResponse | PlainMessage<UpdateWebhookResponse> | undefined, b: UpdateWebhookResponse | PlainMessage<UpdateWebhookResponse> | undefined): boolean {
+    return proto3.util.equals(UpdateWebhookResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message mgmt.v1alpha1.DeleteWebhookRequest
+ */
+export class DeleteWebhookRequest extends Message<DeleteWebhookRequest> {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id = "";
+
+  constructor(data?: PartialMessage<DeleteWebhookRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "mgmt.v1alpha1.DeleteWebhookRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): DeleteWebhookRequest {
+    return new DeleteWebhookRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): DeleteWebhookRequest {
+    return new DeleteWebhookRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): DeleteWebhookRequest {
+    return new DeleteWebhookRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: DeleteWebhookRequest | PlainMessage<DeleteWebhookRequest> | undefined, b: DeleteWebhookRequest | PlainMessage<DeleteWebhookRequest> | undefined): boolean {
+    return proto3.util.equals(DeleteWebhookRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message mgmt.v1alpha1.DeleteWebhookResponse
+ */
+export class DeleteWebhookResponse extends Message<DeleteWebhookResponse> {
+  constructor(data?: PartialMessage<DeleteWebhookResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "mgmt.v1alpha1.DeleteWebhookResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): DeleteWebhookResponse {
+    return new DeleteWebhookResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): DeleteWebhookResponse {
+    return new DeleteWebhookResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): DeleteWebhookResponse {
+    return new DeleteWebhookResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: DeleteWebhookResponse | PlainMessage<DeleteWebhookResponse> | undefined, b: DeleteWebhookResponse | PlainMessage<DeleteWebhookResponse> | undefined): boolean {
+    return proto3.util.equals(DeleteWebhookResponse, a, b);
+  }
+}
+
+/**
+ * A single attempt to deliver an event to a webhook
+ *
+ * @generated from message mgmt.v1alpha1.WebhookDelivery
+ */
+export class WebhookDelivery extends Message<WebhookDelivery> {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id = "";
+
+  /**
+   * @generated from field: string webhook_id = 2;
+   */
+  webhookId = "";
+
+  /**
+   * Every attempt to deliver the same event shares its id
+   *
+   * @generated from field: string event_id = 3;
+   */
+  eventId = "";
+
+  /**
+   * @generated from field: mgmt.v1alpha1.WebhookEventType event_type = 4;
+   */
+  eventType = WebhookEventType.UNSPECIFIED;
+
+  /**
+   * The JSON body that was sent
+   *
+   * @generated from field: string payload = 5;
+   */
+  payload = "";
+
+  /**
+   * Starts at 1 and increases with each retry of the event
+   *
+   * @generated from field: uint32 attempt = 6;
+   */
+  attempt = 0;
+
+  /**
+   * The status code the webhook responded with. Not set if no response was received
+   *
+   * @generated from field: optional int32 status_code = 7;
+   */
+  statusCode?: number;
+
+  /**
+   * Why the attempt failed
+   *
+   * @generated from field: optional string error = 8;
+   */
+  error?: string;
+
+  /**
+   * @generated from field: bool success = 9;
+   */
+  success = false;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp created_at = 10;
+   */
+  createdAt?: Timestamp;
+
+  constructor(data?: PartialMessage<WebhookDelivery>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "mgmt.v1alpha1.WebhookDelivery";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "webhook_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "event_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "event_type", kind: "enum", T: proto3.getEnumType(WebhookEventType) },
+    { no: 5, name: "payload", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 6, name: "attempt", kind: "scalar", T: 13 /* ScalarType.UINT32 */ },
+    { no: 7, name: "status_code", kind: "scalar", T: 5 /* ScalarType.INT32 */, opt: true },
+    { no: 8, name: "error", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+    { no: 9, name: "success", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 10, name: "created_at", kind: "message", T: Timestamp },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): WebhookDelivery {
+    return new WebhookDelivery().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): WebhookDelivery {
+    return new WebhookDelivery().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): WebhookDelivery {
+    return new WebhookDelivery().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: WebhookDelivery | PlainMessage<WebhookDelivery> | undefined, b: WebhookDelivery | PlainMessage<WebhookDelivery> | undefined): boolean {
+    return proto3.util.equals(WebhookDelivery, a, b);
+  }
+}
+
+/**
+ * @generated from message mgmt.v1alpha1.GetWebhookDeliveriesRequest
+ */
+export class GetWebhookDeliveriesRequest extends Message<GetWebhookDeliveriesRequest> {
+  /**
+   * @generated from field: string webhook_id = 1;
+   */
+  webhookId = "";
+
+  /**
+   * The maximum number of deliveries to return. Defaults to 100
+   *
+   * @generated from field: optional uint32 limit = 2;
+   */
+  limit?: number;
+
+  constructor(data?: PartialMessage<GetWebhookDeliveriesRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "mgmt.v1alpha1.GetWebhookDeliveriesRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "webhook_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "limit", kind: "scalar", T: 13 /* ScalarType.UINT32 */, opt: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetWebhookDeliveriesRequest {
+    return new GetWebhookDeliveriesRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetWebhookDeliveriesRequest {
+    return new GetWebhookDeliveriesRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetWebhookDeliveriesRequest {
+    return new GetWebhookDeliveriesRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GetWebhookDeliveriesRequest | PlainMessage<GetWebhookDeliveriesRequest> | undefined, b: GetWebhookDeliveriesRequest | PlainMessage<GetWebhookDeliveriesRequest> | undefined): boolean {
+    return proto3.util.equals(GetWebhookDeliveriesRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message mgmt.v1alpha1.GetWebhookDeliveriesResponse
+ */
+export class GetWebhookDeliveriesResponse extends Message<GetWebhookDeliveriesResponse> {
+  /**
+   * The delivery attempts, most recent first
+   *
+   * @generated from field: repeated mgmt.v1alpha1.WebhookDelivery deliveries = 1;
+   */
+  deliveries: WebhookDelivery[] = [];
+
+  constructor(data?: PartialMessage<GetWebhookDeliveriesResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "mgmt.v1alpha1.GetWebhookDeliveriesResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "deliveries", kind: "message", T: WebhookDelivery, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetWebhookDeliveriesResponse {
+    return new GetWebhookDeliveriesResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetWebhookDeliveriesResponse {
+    return new GetWebhookDeliveriesResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetWebhookDeliveriesResponse {
+    return new GetWebhookDeliveriesResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GetWebhookDeliveriesResponse | PlainMessage<GetWebhookDeliveriesResponse> | undefined, b: GetWebhookDeliveriesResponse | PlainMessage<GetWebhookDeliveriesResponse> | undefined): boolean {
+    return proto3.util.equals(GetWebhookDeliveriesResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message mgmt.v1alpha1.PublishJobRunEventRequest
+ */
+export class PublishJobRunEventRequest extends Message<PublishJobRunEventRequest> {
+  /**
+   * @generated from field: string job_id = 1;
+   */
+  jobId = "";
+
+  /**
+   * @generated from field: string job_run_id = 2;
+   */
+  jobRunId = "";
+
+  /**
+   * @generated from field: mgmt.v1alpha1.WebhookEventType event_type = 3;
+   */
+  eventType = WebhookEventType.UNSPECIFIED;
+
+  /**
+   * The reason the run failed or was halted
+   *
+   * @generated from field: optional string error = 4;
+   */
+  error?: string;
+
+  constructor(data?: PartialMessage<PublishJobRunEventRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "mgmt.v1alpha1.PublishJobRunEventRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "job_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "job_run_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "event_type", kind: "enum", T: proto3.getEnumType(WebhookEventType) },
+    { no: 4, name: "error", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): PublishJobRunEventRequest {
+    return new PublishJobRunEventRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): PublishJobRunEventRequest {
+    return new PublishJobRunEventRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): PublishJobRunEventRequest {
+    return new PublishJobRunEventRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: PublishJobRunEventRequest | PlainMessage<PublishJobRunEventRequest> | undefined, b: PublishJobRunEventRequest | PlainMessage<PublishJobRunEventRequest> | undefined): boolean {
+    return proto3.util.equals(PublishJobRunEventRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message mgmt.v1alpha1.PublishJobRunEventResponse
+ */
+export class PublishJobRunEventResponse extends Message<PublishJobRunEventResponse> {
+  constructor(data?: PartialMessage<PublishJobRunEventResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "mgmt.v1alpha1.PublishJobRunEventResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): PublishJobRunEventResponse {
+    return new PublishJobRunEventResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): PublishJobRunEventResponse {
+    return new PublishJobRunEventResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): PublishJobRunEventResponse {
+    return new PublishJobRunEventResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: PublishJobRunEventResponse | PlainMessage<PublishJobRunEventResponse> | undefined, b: PublishJobRunEventResponse | PlainMessage<PublishJobRunEventResponse> | undefined): boolean {
+    return proto3.util.equals(PublishJobRunEventResponse, a, b);
+  }
+}
+
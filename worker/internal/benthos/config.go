@@ -92,15 +92,21 @@ type PipelineConfig struct {
 }
 
 type ProcessorConfig struct {
-	Mutation   *string               `json:"mutation,omitempty" yaml:"mutation,omitempty"`
-	Javascript *JavascriptConfig     `json:"javascript,omitempty" yaml:"javascript,omitempty"`
-	Branch     *BranchConfig         `json:"branch,omitempty" yaml:"branch,omitempty"`
-	Cache      *CacheConfig          `json:"cache,omitempty" yaml:"cache,omitempty"`
-	Mapping    *string               `json:"mapping,omitempty" yaml:"mapping,omitempty"`
-	Redis      *RedisProcessorConfig `json:"redis,omitempty" yaml:"redis,omitempty"`
-	Error      *ErrorProcessorConfig `json:"error,omitempty" yaml:"error,omitempty"`
-	Catch      []*ProcessorConfig    `json:"catch,omitempty" yaml:"catch,omitempty"`
-	While      *WhileProcessorConfig `json:"while,omitempty" yaml:"while,omitempty"`
+	Mutation   *string                `json:"mutation,omitempty" yaml:"mutation,omitempty"`
+	Javascript *JavascriptConfig      `json:"javascript,omitempty" yaml:"javascript,omitempty"`
+	Branch     *BranchConfig          `json:"branch,omitempty" yaml:"branch,omitempty"`
+	Cache      *CacheConfig           `json:"cache,omitempty" yaml:"cache,omitempty"`
+	Mapping    *string                `json:"mapping,omitempty" yaml:"mapping,omitempty"`
+	Redis      *RedisProcessorConfig  `json:"redis,omitempty" yaml:"redis,omitempty"`
+	Error      *ErrorProcessorConfig  `json:"error,omitempty" yaml:"error,omitempty"`
+	Catch      []*ProcessorConfig     `json:"catch,omitempty" yaml:"catch,omitempty"`
+	While      *WhileProcessorConfig  `json:"while,omitempty" yaml:"while,omitempty"`
+	Switch     []*SwitchProcessorCase `json:"switch,omitempty" yaml:"switch,omitempty"`
+}
+
+type SwitchProcessorCase struct {
+	Check      string             `json:"check,omitempty" yaml:"check,omitempty"`
+	Processors []*ProcessorConfig `json:"processors,omitempty" yaml:"processors,omitempty"`
 }
 
 type WhileProcessorConfig struct {
@@ -287,8 +293,10 @@ type Batching struct {
 }
 
 type BatchProcessor struct {
-	Archive  *ArchiveProcessor  `json:"archive,omitempty" yaml:"archive,omitempty"`
-	Compress *CompressProcessor `json:"compress,omitempty" yaml:"compress,omitempty"`
+	Archive       *ArchiveProcessor       `json:"archive,omitempty" yaml:"archive,omitempty"`
+	Compress      *CompressProcessor      `json:"compress,omitempty" yaml:"compress,omitempty"`
+	Mapping       *string                 `json:"mapping,omitempty" yaml:"mapping,omitempty"`
+	ParquetEncode *ParquetEncodeProcessor `json:"parquet_encode,omitempty" yaml:"parquet_encode,omitempty"`
 }
 
 type ArchiveProcessor struct {
@@ -300,6 +308,17 @@ type CompressProcessor struct {
 	Algorithm string `json:"algorithm" yaml:"algorithm"`
 }
 
+type ParquetEncodeProcessor struct {
+	Schema             []*ParquetSchemaField `json:"schema" yaml:"schema"`
+	DefaultCompression string                `json:"default_compression,omitempty" yaml:"default_compression,omitempty"`
+}
+
+type ParquetSchemaField struct {
+	Name     string `json:"name" yaml:"name"`
+	Type     string `json:"type" yaml:"type"`
+	Optional bool   `json:"optional" yaml:"optional"`
+}
+
 type OutputBrokerConfig struct {
 	Pattern string    `json:"pattern" yaml:"pattern"`
 	Outputs []Outputs `json:"outputs" yaml:"outputs"`
@@ -3,6 +3,7 @@
 package main
 
 import (
+	"encoding/csv"
 	"fmt"
 	"os"
 	"sort"
@@ -18,6 +19,9 @@ For a given text file, it will output a generated go file that includes the foll
     the value in the map would be: 2: {0, 3}
  3. an int slice that is a sorted list of keys from the map. This is effectively a discrete, sorted slice of the
     lengths that are available for generation
+
+For a csv file of addresses with an address1, address2, city, state and zipcode header, it will instead output a generated go file
+that includes an Address slice named after the go type name.
 */
 func main() {
 	args := os.Args
@@ -45,6 +49,14 @@ func main() {
 		panic(err)
 	}
 
+	if strings.HasSuffix(datasetFile, ".csv") {
+		err = writeAddresses(outputFile, datasetFile, gotypename)
+		if err != nil {
+			panic(err)
+		}
+		return
+	}
+
 	_, err = outputFile.WriteString(fmt.Sprintf("var %ss = []string{\n", gotypename))
 	if err != nil {
 		panic(err)
@@ -148,3 +160,41 @@ func getSortedMapKeys[T any](input map[int]T) []int {
 	sort.Ints(output)
 	return output
 }
+
+func writeAddresses(outputFile *os.File, datasetFile, gotypename string) error {
+	file, err := os.Open(datasetFile)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	records, err := csv.NewReader(file).ReadAll()
+	if err != nil {
+		return err
+	}
+	if len(records) == 0 {
+		return fmt.Errorf("address dataset %s is empty", datasetFile)
+	}
+
+	header := records[0]
+	fields := []string{"address1", "address2", "city", "state", "zipcode"}
+	if strings.Join(header, ",") != strings.Join(fields, ",") {
+		return fmt.Errorf("address dataset %s must have the header %s", datasetFile, strings.Join(fields, ","))
+	}
+
+	_, err = outputFile.WriteString(fmt.Sprintf("var %s = []Address{\n", gotypename))
+	if err != nil {
+		return err
+	}
+	for _, record := range records[1:] {
+		_, err = outputFile.WriteString(fmt.Sprintf(
+			"\t{\n\t\tAddress1: %q,\n\t\tAddress2: %q,\n\t\tCity:     %q,\n\t\tState:    %q,\n\t\tZipcode:  %q,\n\t},\n",
+			strings.TrimSpace(record[0]), strings.TrimSpace(record[1]), strings.TrimSpace(record[2]), strings.TrimSpace(record[3]), strings.TrimSpace(record[4]),
+		))
+		if err != nil {
+			return err
+		}
+	}
+	_, err = outputFile.WriteString("}\n")
+	return err
+}
@@ -3,3 +3,13 @@ package transformers_dataset
 //go:generate go run data_generator.go datasets/emaildomains.txt EmailDomain $GOPACKAGE
 //go:generate go run data_generator.go datasets/first_names.txt FirstName $GOPACKAGE
 //go:generate go run data_generator.go datasets/last_names.txt LastName $GOPACKAGE
+
+//go:generate go run data_generator.go datasets/de_DE/first_names.txt GermanFirstName $GOPACKAGE
+//go:generate go run data_generator.go datasets/de_DE/last_names.txt GermanLastName $GOPACKAGE
+//go:generate go run data_generator.go datasets/de_DE/addresses.csv GermanAddresses $GOPACKAGE
+//go:generate go run data_generator.go datasets/ja_JP/first_names.txt JapaneseFirstName $GOPACKAGE
+//go:generate go run data_generator.go datasets/ja_JP/last_names.txt JapaneseLastName $GOPACKAGE
+//go:generate go run data_generator.go datasets/ja_JP/addresses.csv JapaneseAddresses $GOPACKAGE
+//go:generate go run data_generator.go datasets/pt_BR/first_names.txt BrazilianFirstName $GOPACKAGE
+//go:generate go run data_generator.go datasets/pt_BR/last_names.txt BrazilianLastName $GOPACKAGE
+//go:generate go run data_generator.go datasets/pt_BR/addresses.csv BrazilianAddresses $GOPACKAGE
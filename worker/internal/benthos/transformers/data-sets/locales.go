@@ -0,0 +1,134 @@
+package transformers_dataset
+
+import (
+	"fmt"
+	"strings"
+)
+
+// The locale that is used when a transformer is not configured with one
+const DefaultLocaleCode = "en_US"
+
+// The datasets that names, addresses and phone numbers are generated from for a locale
+type Locale struct {
+	// The locale code, such as de_DE
+	Code string
+	// The ISO 3166-1 alpha-2 code of the locale's country, such as DE
+	CountryCode string
+
+	FirstNames       []string
+	FirstNameMap     map[int64][2]int
+	FirstNameIndices []int64
+
+	LastNames       []string
+	LastNameMap     map[int64][2]int
+	LastNameIndices []int64
+
+	Addresses []Address
+	// Formats an address the way it is written in the locale's country
+	FormatAddress func(address Address) string
+
+	// The international calling code of the country, such as 49
+	CallingCode int64
+	// The prefix that is dialed before national numbers within the country, such as 0
+	TrunkPrefix string
+	// The leading digits of national phone numbers without the trunk prefix, such as mobile prefixes and area codes.
+	// Phone numbers are made up of random digits when empty.
+	PhonePrefixes []string
+}
+
+var DefaultLocale = &Locale{
+	Code:             DefaultLocaleCode,
+	CountryCode:      "US",
+	FirstNames:       FirstNames,
+	FirstNameMap:     FirstNameMap,
+	FirstNameIndices: FirstNameIndices,
+	LastNames:        LastNames,
+	LastNameMap:      LastNameMap,
+	LastNameIndices:  LastNameIndices,
+	Addresses:        Addresses,
+	FormatAddress: func(address Address) string {
+		return fmt.Sprintf("%s %s %s, %s", address.Address1, address.City, address.State, address.Zipcode)
+	},
+	CallingCode: 1,
+}
+
+var Locales = []*Locale{
+	DefaultLocale,
+	{
+		Code:             "de_DE",
+		CountryCode:      "DE",
+		FirstNames:       GermanFirstNames,
+		FirstNameMap:     GermanFirstNameMap,
+		FirstNameIndices: GermanFirstNameIndices,
+		LastNames:        GermanLastNames,
+		LastNameMap:      GermanLastNameMap,
+		LastNameIndices:  GermanLastNameIndices,
+		Addresses:        GermanAddresses,
+		FormatAddress: func(address Address) string {
+			return fmt.Sprintf("%s, %s %s", address.Address1, address.Zipcode, address.City)
+		},
+		CallingCode:   49,
+		TrunkPrefix:   "0",
+		PhonePrefixes: []string{"151", "152", "157", "160", "162", "170", "171", "172", "175", "176", "177", "179", "30", "40", "69", "89", "211", "221", "711"},
+	},
+	{
+		Code:             "ja_JP",
+		CountryCode:      "JP",
+		FirstNames:       JapaneseFirstNames,
+		FirstNameMap:     JapaneseFirstNameMap,
+		FirstNameIndices: JapaneseFirstNameIndices,
+		LastNames:        JapaneseLastNames,
+		LastNameMap:      JapaneseLastNameMap,
+		LastNameIndices:  JapaneseLastNameIndices,
+		Addresses:        JapaneseAddresses,
+		FormatAddress: func(address Address) string {
+			return fmt.Sprintf("%s, %s, %s %s", address.Address1, address.City, address.State, address.Zipcode)
+		},
+		CallingCode:   81,
+		TrunkPrefix:   "0",
+		PhonePrefixes: []string{"90", "80", "70", "3", "6", "11", "45", "52", "75", "78", "92"},
+	},
+	{
+		Code:             "pt_BR",
+		CountryCode:      "BR",
+		FirstNames:       BrazilianFirstNames,
+		FirstNameMap:     BrazilianFirstNameMap,
+		FirstNameIndices: BrazilianFirstNameIndices,
+		LastNames:        BrazilianLastNames,
+		LastNameMap:      BrazilianLastNameMap,
+		LastNameIndices:  BrazilianLastNameIndices,
+		Addresses:        BrazilianAddresses,
+		FormatAddress: func(address Address) string {
+			return fmt.Sprintf("%s, %s - %s, %s", address.Address1, address.City, address.State, address.Zipcode)
+		},
+		CallingCode: 55,
+		// mobile numbers are made up of a two digit area code and a nine digit number that starts with 9
+		PhonePrefixes: []string{"119", "219", "319", "419", "479", "519", "619", "719", "819", "859", "929"},
+	},
+}
+
+/*
+Returns the locale for a locale code such as de_DE or de-DE, or for a country code such as DE.
+Codes are matched case insensitively.
+*/
+func GetLocale(value string) (*Locale, bool) {
+	code := strings.ReplaceAll(strings.TrimSpace(value), "-", "_")
+	if code == "" {
+		return nil, false
+	}
+	for _, locale := range Locales {
+		if strings.EqualFold(locale.Code, code) || strings.EqualFold(locale.CountryCode, code) {
+			return locale, true
+		}
+	}
+	return nil, false
+}
+
+// Returns the codes of the supported locales
+func GetLocaleCodes() []string {
+	codes := make([]string, 0, len(Locales))
+	for _, locale := range Locales {
+		codes = append(codes, locale.Code)
+	}
+	return codes
+}
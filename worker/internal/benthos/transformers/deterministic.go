@@ -0,0 +1,47 @@
+package transformers
+
+import (
+	"github.com/benthosdev/benthos/v4/public/bloblang"
+	transformer_utils "github.com/nucleuscloud/neosync/worker/internal/benthos/transformers/utils"
+	"github.com/nucleuscloud/neosync/worker/internal/rng"
+)
+
+// Name of the optional param that makes a transformer deterministic.
+// When provided, the randomizer is seeded from a keyed hash of the input value so that the same value is always transformed to the same output.
+const deterministicKeyParam = "deterministic_key"
+
+func newDeterministicKeyParam() bloblang.ParamDefinition {
+	return bloblang.NewStringParam(deterministicKeyParam).Optional()
+}
+
+// Returns the seed derived from the value if the transformer was provided a deterministic key, otherwise nil.
+func getDeterministicSeed(args *bloblang.ParsedParams, value string) (*int64, error) {
+	key, err := args.GetOptionalString(deterministicKeyParam)
+	if err != nil {
+		return nil, err
+	}
+	if key == nil {
+		return nil, nil
+	}
+	seed, err := transformer_utils.GetDeterministicSeed(*key, value)
+	if err != nil {
+		return nil, err
+	}
+	return &seed, nil
+}
+
+// Returns a randomizer that is seeded from the value if the transformer was provided a deterministic key, otherwise from a random seed.
+func getTransformRandomizer(args *bloblang.ParsedParams, value string) (rng.Rand, error) {
+	seed, err := getDeterministicSeed(args, value)
+	if err != nil {
+		return nil, err
+	}
+	if seed != nil {
+		return rng.New(*seed), nil
+	}
+	randomSeed, err := transformer_utils.GenerateCryptoSeed()
+	if err != nil {
+		return nil, err
+	}
+	return rng.New(randomSeed), nil
+}
@@ -0,0 +1,79 @@
+package transformers
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/benthosdev/benthos/v4/public/bloblang"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_Bloblang_Deterministic_Transformers(t *testing.T) {
+	type testcase struct {
+		mapping string
+		input   any
+	}
+	testcases := []testcase{
+		{`root = transform_email(email:this, preserve_length:false, preserve_domain:true, deterministic_key:"%s")`, "alice@corp.com"},
+		{`root = transform_first_name(value:this, preserve_length:false, max_length:20, deterministic_key:"%s")`, "alice"},
+		{`root = transform_last_name(value:this, preserve_length:false, max_length:20, deterministic_key:"%s")`, "smith"},
+		{`root = transform_full_name(value:this, preserve_length:false, max_length:40, deterministic_key:"%s")`, "alice smith"},
+		{`root = transform_string(value:this, preserve_length:false, min_length:5, max_length:20, deterministic_key:"%s")`, "hello world"},
+		{`root = transform_character_scramble(value:this, deterministic_key:"%s")`, "Hello World 123!"},
+		{`root = transform_int64(value:this, randomization_range_min:1, randomization_range_max:1000000, deterministic_key:"%s")`, int64(500000)},
+		{`root = transform_float64(value:this, randomization_range_min:1, randomization_range_max:1000000, deterministic_key:"%s")`, float64(500000.5)},
+		{`root = transform_phone_number(value:this, preserve_length:true, max_length:15, deterministic_key:"%s")`, "5555555555"},
+		{`root = transform_e164_phone_number(value:this, preserve_length:true, max_length:15, deterministic_key:"%s")`, "+15555555555"},
+		{`root = transform_int64_phone_number(value:this, preserve_length:true, deterministic_key:"%s")`, int64(5555555555)},
+		{`root = transform_ip_address(value:this, preserve_prefix_length:16, deterministic_key:"%s")`, "10.1.2.3"},
+		{`root = transform_mac_address(value:this, preserve_oui:true, deterministic_key:"%s")`, "00:1a:2b:3c:4d:5e"},
+		{`root = transform_url(value:this, preserve_path:false, deterministic_key:"%s")`, "https://example.com/users/123?tab=profile"},
+		{`root = transform_domain(value:this, preserve_tld:true, deterministic_key:"%s")`, "example.com"},
+	}
+
+	for _, tc := range testcases {
+		tc := tc
+		t.Run(tc.mapping, func(t *testing.T) {
+			ex, err := bloblang.Parse(fmt.Sprintf(tc.mapping, "key"))
+			require.NoError(t, err)
+
+			first, err := ex.Query(tc.input)
+			require.NoError(t, err)
+			require.NotNil(t, first)
+			second, err := ex.Query(tc.input)
+			require.NoError(t, err)
+			assert.Equal(t, first, second, "the same input and key must always produce the same output")
+
+			otherEx, err := bloblang.Parse(fmt.Sprintf(tc.mapping, "key"))
+			require.NoError(t, err)
+			other, err := otherEx.Query(tc.input)
+			require.NoError(t, err)
+			assert.Equal(t, first, other, "the output must not depend on the mapping instance")
+		})
+	}
+}
+
+func Test_Bloblang_Deterministic_Key_Changes_Output(t *testing.T) {
+	mapping := `root = transform_string(value:this, preserve_length:true, min_length:1, max_length:100, deterministic_key:"%s")`
+	input := "the quick brown fox jumps over the lazy dog"
+
+	ex, err := bloblang.Parse(fmt.Sprintf(mapping, "key"))
+	require.NoError(t, err)
+	first, err := ex.Query(input)
+	require.NoError(t, err)
+
+	otherEx, err := bloblang.Parse(fmt.Sprintf(mapping, "other-key"))
+	require.NoError(t, err)
+	other, err := otherEx.Query(input)
+	require.NoError(t, err)
+
+	assert.NotEqual(t, first, other)
+}
+
+func Test_Bloblang_Deterministic_Empty_Key(t *testing.T) {
+	ex, err := bloblang.Parse(`root = transform_first_name(value:this, deterministic_key:"")`)
+	require.NoError(t, err)
+	_, err = ex.Query("alice")
+	assert.Error(t, err)
+}
@@ -9,7 +9,10 @@ import (
 )
 
 func init() {
-	spec := bloblang.NewPluginSpec().Param(bloblang.NewInt64Param("max_length"))
+	spec := bloblang.NewPluginSpec().
+		Param(bloblang.NewInt64Param("max_length")).
+		Param(newLocaleParam()).
+		Param(newRowLocaleParam())
 
 	err := bloblang.RegisterFunctionV2("generate_city", spec, func(args *bloblang.ParsedParams) (bloblang.Function, error) {
 		maxLength, err := args.GetInt64("max_length")
@@ -17,8 +20,13 @@ func init() {
 			return nil, err
 		}
 
+		locale, err := getLocale(args)
+		if err != nil {
+			return nil, err
+		}
+
 		return func() (any, error) {
-			res, err := GenerateRandomCity(maxLength)
+			res, err := generateRandomLocaleCity(locale, maxLength)
 			if err != nil {
 				return nil, err
 			}
@@ -32,7 +40,12 @@ func init() {
 
 // Generates a randomly selected city that exists in the United States. Accounts for the maxLength of the column and searches for a city that is shorter than the maxLength. If not, it randomly generates a string that len(string) == maxLength
 func GenerateRandomCity(maxLength int64) (string, error) {
-	addresses := transformers_dataset.Addresses
+	return generateRandomLocaleCity(transformers_dataset.DefaultLocale, maxLength)
+}
+
+// Generates a randomly selected city that exists in the country of the locale
+func generateRandomLocaleCity(locale *transformers_dataset.Locale, maxLength int64) (string, error) {
+	addresses := locale.Addresses
 	var filteredCities []string
 
 	for _, address := range addresses {
@@ -49,9 +62,8 @@ func GenerateRandomCity(maxLength int64) (string, error) {
 		return city, nil
 	}
 
-	// -1 because addresses is an array so we don't overflow
 	//nolint:all
-	randomIndex := rand.Intn(len(filteredCities) - 1)
+	randomIndex := rand.Intn(len(filteredCities))
 
 	return filteredCities[randomIndex], nil
 }
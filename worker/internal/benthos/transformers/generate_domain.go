@@ -0,0 +1,48 @@
+package transformers
+
+import (
+	"fmt"
+
+	"github.com/benthosdev/benthos/v4/public/bloblang"
+	transformer_utils "github.com/nucleuscloud/neosync/worker/internal/benthos/transformers/utils"
+	"github.com/nucleuscloud/neosync/worker/internal/rng"
+)
+
+// Top level domains that generated domains end with
+var generateDomainTlds = []string{"com", "net", "org", "io", "dev", "app", "co"}
+
+func init() {
+	spec := bloblang.NewPluginSpec().
+		Param(bloblang.NewInt64Param("seed").Optional())
+
+	err := bloblang.RegisterFunctionV2("generate_domain", spec, func(args *bloblang.ParsedParams) (bloblang.Function, error) {
+		seedArg, err := args.GetOptionalInt64("seed")
+		if err != nil {
+			return nil, err
+		}
+		var seed int64
+		if seedArg != nil {
+			seed = *seedArg
+		} else {
+			seed, err = transformer_utils.GenerateCryptoSeed()
+			if err != nil {
+				return nil, err
+			}
+		}
+		randomizer := rng.New(seed)
+
+		return func() (any, error) {
+			return generateRandomDomain(randomizer), nil
+		}, nil
+	})
+
+	if err != nil {
+		panic(err)
+	}
+}
+
+// Generates a random domain made up of a label of 5 to 12 letters and a common top level domain
+func generateRandomDomain(randomizer rng.Rand) string {
+	label := transformer_utils.GetRandomCharacterString(randomizer, int64(5+randomizer.Intn(8)))
+	return fmt.Sprintf("%s.%s", label, generateDomainTlds[randomizer.Intn(len(generateDomainTlds))])
+}
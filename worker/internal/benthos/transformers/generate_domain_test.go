@@ -0,0 +1,27 @@
+package transformers
+
+import (
+	"testing"
+
+	"github.com/benthosdev/benthos/v4/public/bloblang"
+	"github.com/nucleuscloud/neosync/worker/internal/rng"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_GenerateDomain(t *testing.T) {
+	randomizer := rng.New(1)
+	for i := 0; i < 20; i++ {
+		assert.Regexp(t, `^[a-z]{5,12}\.(com|net|org|io|dev|app|co)$`, generateRandomDomain(randomizer))
+	}
+}
+
+func Test_GenerateDomainTransformer(t *testing.T) {
+	mapping := `root = generate_domain(seed:1)`
+	ex, err := bloblang.Parse(mapping)
+	require.NoError(t, err, "failed to parse the domain generator")
+
+	res, err := ex.Query(nil)
+	assert.NoError(t, err)
+	assert.Equal(t, generateRandomDomain(rng.New(1)), res)
+}
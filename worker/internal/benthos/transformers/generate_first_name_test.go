@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"github.com/benthosdev/benthos/v4/public/bloblang"
+	transformers_dataset "github.com/nucleuscloud/neosync/worker/internal/benthos/transformers/data-sets"
 	"github.com/nucleuscloud/neosync/worker/pkg/workflows/datasync/activities/shared"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -56,3 +57,28 @@ func Test_GenerateRandomFirstNameTransformer(t *testing.T) {
 	assert.NotEmpty(t, resStr)
 	assert.LessOrEqual(t, int64(len(resStr)), maxCharacterLimit, "output should be less than or equal to max char limit")
 }
+
+func Test_GenerateRandomFirstNameTransformer_Locale(t *testing.T) {
+	mapping := fmt.Sprintf(`root = generate_first_name(max_length:%d,locale:"ja_JP")`, maxCharacterLimit)
+	ex, err := bloblang.Parse(mapping)
+	require.NoError(t, err, "failed to parse the first name transformer")
+
+	res, err := ex.Query(nil)
+	require.NoError(t, err)
+	assert.Contains(t, transformers_dataset.JapaneseFirstNames, res, "the first name must come from the locale's dataset")
+}
+
+func Test_GenerateRandomFirstNameTransformer_RowLocale(t *testing.T) {
+	mapping := fmt.Sprintf(`root = generate_first_name(max_length:%d,row_locale:this.country)`, maxCharacterLimit)
+	ex, err := bloblang.Parse(mapping)
+	require.NoError(t, err, "failed to parse the first name transformer")
+
+	results := map[any]struct{}{}
+	for i := 0; i < 20; i++ {
+		res, err := ex.Query(map[string]any{"country": "BR"})
+		require.NoError(t, err)
+		assert.Contains(t, transformers_dataset.BrazilianFirstNames, res, "the first name must come from the dataset of the row's locale")
+		results[res] = struct{}{}
+	}
+	assert.Greater(t, len(results), 1, "names must not repeat for every row when the locale is read from the row")
+}
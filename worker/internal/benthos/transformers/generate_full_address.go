@@ -1,7 +1,6 @@
 package transformers
 
 import (
-	"fmt"
 	"math/rand"
 
 	"github.com/benthosdev/benthos/v4/public/bloblang"
@@ -10,7 +9,10 @@ import (
 )
 
 func init() {
-	spec := bloblang.NewPluginSpec().Param(bloblang.NewInt64Param("max_length"))
+	spec := bloblang.NewPluginSpec().
+		Param(bloblang.NewInt64Param("max_length")).
+		Param(newLocaleParam()).
+		Param(newRowLocaleParam())
 
 	err := bloblang.RegisterFunctionV2("generate_full_address", spec, func(args *bloblang.ParsedParams) (bloblang.Function, error) {
 		maxLength, err := args.GetInt64("max_length")
@@ -18,8 +20,13 @@ func init() {
 			return nil, err
 		}
 
+		locale, err := getLocale(args)
+		if err != nil {
+			return nil, err
+		}
+
 		return func() (any, error) {
-			res, err := GenerateRandomFullAddress(maxLength)
+			res, err := generateRandomLocaleFullAddress(locale, maxLength)
 			if err != nil {
 				return nil, err
 			}
@@ -33,12 +40,17 @@ func init() {
 
 /* Generates a random full address from the US including street address, city, state and zipcode */
 func GenerateRandomFullAddress(maxLength int64) (string, error) {
-	addresses := transformers_dataset.Addresses
+	return generateRandomLocaleFullAddress(transformers_dataset.DefaultLocale, maxLength)
+}
+
+// Generates a random full address from the country of the locale, formatted the way addresses are written in that country
+func generateRandomLocaleFullAddress(locale *transformers_dataset.Locale, maxLength int64) (string, error) {
+	addresses := locale.Addresses
 
 	var filteredAddresses []string
 
 	for _, address := range addresses {
-		addy := fmt.Sprintf(`%s %s %s, %s`, address.Address1, address.City, address.State, address.Zipcode)
+		addy := locale.FormatAddress(address)
 		if len(addy) <= int(maxLength) {
 			filteredAddresses = append(filteredAddresses, addy)
 		}
@@ -53,26 +65,25 @@ func GenerateRandomFullAddress(maxLength int64) (string, error) {
 			}
 			return str, nil
 		} else {
-			sa, err := GenerateRandomStreetAddress(5)
+			sa, err := generateRandomLocaleStreetAddress(locale, 5)
 			if err != nil {
 				return "", err
 			}
-			city, err := GenerateRandomCity(5)
+			city, err := generateRandomLocaleCity(locale, 5)
 			if err != nil {
 				return "", err
 			}
 
-			state := GenerateRandomState()
+			state := generateRandomLocaleState(locale)
 
-			zip := GenerateRandomZipcode()
+			zip := generateRandomLocaleZipcode(locale)
 
-			return fmt.Sprintf(`%s %s %s, %s`, sa, city, state, zip), nil
+			return locale.FormatAddress(transformers_dataset.Address{Address1: sa, City: city, State: state, Zipcode: zip}), nil
 		}
 	}
 
-	// -1 because addresses is an array so we don't overflow
 	//nolint:all
-	randomIndex := rand.Intn(len(filteredAddresses) - 1)
+	randomIndex := rand.Intn(len(filteredAddresses))
 
 	return filteredAddresses[randomIndex], nil
 }
@@ -5,7 +5,9 @@ import (
 	"testing"
 
 	"github.com/benthosdev/benthos/v4/public/bloblang"
+	transformers_dataset "github.com/nucleuscloud/neosync/worker/internal/benthos/transformers/data-sets"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 var faMaxLength = int64(40)
@@ -49,3 +51,30 @@ func Test_FullAddressTransformer(t *testing.T) {
 	assert.IsType(t, "", res, "The returned full address should be a string")
 	assert.LessOrEqual(t, int64(len(res.(string))), faMaxLength, fmt.Sprintf("The city should be less than or equal to the max length. This is the error address:%s", res))
 }
+
+func Test_GenerateFullAddress_Locale(t *testing.T) {
+	locale, ok := transformers_dataset.GetLocale("pt_BR")
+	require.True(t, ok)
+
+	formatted := []string{}
+	for _, address := range transformers_dataset.BrazilianAddresses {
+		formatted = append(formatted, locale.FormatAddress(address))
+	}
+
+	res, err := generateRandomLocaleFullAddress(locale, 100)
+	require.NoError(t, err)
+	assert.Contains(t, formatted, res)
+}
+
+func Test_FullAddressTransformer_Locale(t *testing.T) {
+	ex, err := bloblang.Parse(`root = generate_full_address(max_length:40,locale:"de_DE")`)
+	require.NoError(t, err, "failed to parse the full address transformer")
+
+	res, err := ex.Query(nil)
+	require.NoError(t, err)
+
+	resStr, ok := res.(string)
+	require.True(t, ok)
+	assert.LessOrEqual(t, len(resStr), 40)
+	assert.Regexp(t, `^.+, \d{5} .+$`, resStr, "german addresses end with the zipcode followed by the city")
+}
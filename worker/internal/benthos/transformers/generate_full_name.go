@@ -2,7 +2,6 @@ package transformers
 
 import (
 	"fmt"
-	"time"
 
 	"github.com/benthosdev/benthos/v4/public/bloblang"
 	transformers_dataset "github.com/nucleuscloud/neosync/worker/internal/benthos/transformers/data-sets"
@@ -13,21 +12,37 @@ import (
 func init() {
 	spec := bloblang.NewPluginSpec().
 		Param(bloblang.NewInt64Param("max_length").Default(10000)).
-		Param(bloblang.NewInt64Param("seed").Default(time.Now().UnixNano()))
+		Param(bloblang.NewInt64Param("seed").Optional()).
+		Param(newLocaleParam()).
+		Param(newRowLocaleParam())
 
 	err := bloblang.RegisterFunctionV2("generate_full_name", spec, func(args *bloblang.ParsedParams) (bloblang.Function, error) {
 		maxLength, err := args.GetInt64("max_length")
 		if err != nil {
 			return nil, err
 		}
-		seed, err := args.GetInt64("seed")
+		seedArg, err := args.GetOptionalInt64("seed")
 		if err != nil {
 			return nil, err
 		}
+		var seed int64
+		if seedArg != nil {
+			seed = *seedArg
+		} else {
+			seed, err = transformer_utils.GenerateCryptoSeed()
+			if err != nil {
+				return nil, err
+			}
+		}
 		randomizer := rng.New(seed)
 
+		locale, err := getLocale(args)
+		if err != nil {
+			return nil, err
+		}
+
 		return func() (any, error) {
-			res, err := generateRandomFullName(randomizer, maxLength)
+			res, err := generateRandomLocaleFullName(randomizer, locale, maxLength)
 			return res, err
 		}, nil
 	})
@@ -39,25 +54,29 @@ func init() {
 
 /* Generates a random full name */
 func generateRandomFullName(randomizer rng.Rand, maxLength int64) (string, error) {
+	return generateRandomLocaleFullName(randomizer, transformers_dataset.DefaultLocale, maxLength)
+}
+
+func generateRandomLocaleFullName(randomizer rng.Rand, locale *transformers_dataset.Locale, maxLength int64) (string, error) {
 	maxLengthMinusSpace := maxLength - 1
 	if maxLengthMinusSpace <= 0 {
 		return "", fmt.Errorf("unable to generate full name including space with provided max length: %d", maxLength)
 	}
 	maxFirstNameIdx, maxLastNameIdx := transformer_utils.FindClosestPair(
-		transformers_dataset.FirstNameIndices, transformers_dataset.LastNameIndices,
+		locale.FirstNameIndices, locale.LastNameIndices,
 		maxLengthMinusSpace,
 	)
 	if maxFirstNameIdx == -1 || maxLastNameIdx == -1 {
 		return "", fmt.Errorf("unable to generate a full name with the provided max length: %d", maxLength)
 	}
 
-	maxFirstNameLength := transformers_dataset.FirstNameIndices[maxFirstNameIdx]
-	maxLastNameLength := transformers_dataset.LastNameIndices[maxLastNameIdx]
-	firstname, err := generateRandomFirstName(randomizer, nil, maxFirstNameLength)
+	maxFirstNameLength := locale.FirstNameIndices[maxFirstNameIdx]
+	maxLastNameLength := locale.LastNameIndices[maxLastNameIdx]
+	firstname, err := generateRandomLocaleFirstName(randomizer, locale, nil, maxFirstNameLength)
 	if err != nil {
 		return "", fmt.Errorf("unable to generate random first name with length: %d", maxFirstNameLength)
 	}
-	lastname, err := generateRandomLastName(randomizer, nil, maxLastNameLength)
+	lastname, err := generateRandomLocaleLastName(randomizer, locale, nil, maxLastNameLength)
 	if err != nil {
 		return "", fmt.Errorf("unable to generate random last name with length: %d", maxLastNameLength)
 	}
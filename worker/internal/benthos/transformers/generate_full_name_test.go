@@ -3,9 +3,11 @@ package transformers
 import (
 	"fmt"
 	"math/rand"
+	"strings"
 	"testing"
 
 	"github.com/benthosdev/benthos/v4/public/bloblang"
+	transformers_dataset "github.com/nucleuscloud/neosync/worker/internal/benthos/transformers/data-sets"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -55,3 +57,17 @@ func Test_GenerateRandomFullNameTransformer(t *testing.T) {
 	assert.NotEmpty(t, resStr)
 	assert.LessOrEqual(t, int64(len(resStr)), maxCharacterLimit, "output should be less than or equal to max char limit")
 }
+
+func Test_GenerateRandomFullName_Locale(t *testing.T) {
+	randomizer := rand.New(rand.NewSource(1))
+	locale, ok := transformers_dataset.GetLocale("de_DE")
+	require.True(t, ok)
+
+	res, err := generateRandomLocaleFullName(randomizer, locale, maxCharacterLimit)
+	require.NoError(t, err)
+
+	names := strings.Split(res, " ")
+	require.Len(t, names, 2)
+	assert.Contains(t, transformers_dataset.GermanFirstNames, names[0])
+	assert.Contains(t, transformers_dataset.GermanLastNames, names[1])
+}
@@ -4,6 +4,7 @@ import (
 	"github.com/benthosdev/benthos/v4/public/bloblang"
 	transformers_dataset "github.com/nucleuscloud/neosync/worker/internal/benthos/transformers/data-sets"
 	transformer_utils "github.com/nucleuscloud/neosync/worker/internal/benthos/transformers/utils"
+	"github.com/nucleuscloud/neosync/worker/internal/rng"
 )
 
 var defaultPhoneNumberLength = int64(10)
@@ -25,16 +26,20 @@ func init() {
 
 /* Generates a random 10 digit phone number with a valid US area code and returns it as an int64. */
 func GenerateRandomInt64PhoneNumber() (int64, error) {
+	return generateRandomInt64PhoneNumber(transformer_utils.GlobalRandomizer)
+}
+
+func generateRandomInt64PhoneNumber(randomizer rng.Rand) (int64, error) {
 	ac := transformers_dataset.AreaCodes
 
 	// get a random area code from the areacodes data set
-	randAreaCode, err := transformer_utils.GetRandomValueFromSlice[int64](ac)
+	randAreaCode, err := transformer_utils.GetRandomValueFromSliceWithRandomizer[int64](randomizer, ac)
 	if err != nil {
 		return 0, err
 	}
 
 	// generate the rest of the phone number
-	pn, err := transformer_utils.GenerateRandomInt64FixedLength(defaultPhoneNumberLength - 3)
+	pn, err := transformer_utils.GenerateRandomInt64FixedLengthWithRandomizer(randomizer, defaultPhoneNumberLength-3)
 	if err != nil {
 		return 0, err
 	}
@@ -6,13 +6,17 @@ import (
 	"strings"
 
 	"github.com/benthosdev/benthos/v4/public/bloblang"
+	transformers_dataset "github.com/nucleuscloud/neosync/worker/internal/benthos/transformers/data-sets"
 	transformer_utils "github.com/nucleuscloud/neosync/worker/internal/benthos/transformers/utils"
+	"github.com/nucleuscloud/neosync/worker/internal/rng"
 )
 
 func init() {
 	spec := bloblang.NewPluginSpec().
 		Param(bloblang.NewInt64Param("min")).
-		Param(bloblang.NewInt64Param("max"))
+		Param(bloblang.NewInt64Param("max")).
+		Param(newLocaleParam()).
+		Param(newRowLocaleParam())
 
 	err := bloblang.RegisterFunctionV2("generate_e164_phone_number", spec, func(args *bloblang.ParsedParams) (bloblang.Function, error) {
 		min, err := args.GetInt64("min")
@@ -25,8 +29,13 @@ func init() {
 			return nil, err
 		}
 
+		locale, err := getLocale(args)
+		if err != nil {
+			return nil, err
+		}
+
 		return func() (any, error) {
-			res, err := GenerateInternationalPhoneNumber(min, max)
+			res, err := generateLocaleInternationalPhoneNumber(transformer_utils.GlobalRandomizer, locale, min, max)
 			return res, err
 		}, nil
 	})
@@ -39,11 +48,15 @@ func init() {
 /*  Generates a random phone number in e164 format in the length interval [min, max] with the min length == 9 and the max length == 15.
  */
 func GenerateInternationalPhoneNumber(min, max int64) (string, error) {
+	return generateInternationalPhoneNumber(transformer_utils.GlobalRandomizer, min, max)
+}
+
+func generateInternationalPhoneNumber(randomizer rng.Rand, min, max int64) (string, error) {
 	if min < 9 || max > 15 {
 		return "", errors.New("the length has between 9 and 15 characters long")
 	}
 
-	val, err := transformer_utils.GenerateRandomInt64InLengthRange(min, max)
+	val, err := transformer_utils.GenerateRandomInt64InLengthRangeWithRandomizer(randomizer, min, max)
 	if err != nil {
 		return "", nil
 	}
@@ -51,6 +64,26 @@ func GenerateInternationalPhoneNumber(min, max int64) (string, error) {
 	return fmt.Sprintf("+%d", val), nil
 }
 
+/*
+Generates a phone number of the locale in e164 format in the length interval [min, max] with the min length == 9 and the max length == 15.
+The number starts with the calling code of the locale followed by one of its mobile prefixes or area codes, for example +491761234567 for de_DE.
+*/
+func generateLocaleInternationalPhoneNumber(randomizer rng.Rand, locale *transformers_dataset.Locale, min, max int64) (string, error) {
+	if len(locale.PhonePrefixes) == 0 {
+		return generateInternationalPhoneNumber(randomizer, min, max)
+	}
+	if min < 9 || max > 15 {
+		return "", errors.New("the length has between 9 and 15 characters long")
+	}
+
+	length, err := transformer_utils.GenerateRandomInt64InValueRangeWithRandomizer(randomizer, min, max)
+	if err != nil {
+		return "", err
+	}
+	prefix := fmt.Sprintf("%d%s", locale.CallingCode, locale.PhonePrefixes[randomizer.Intn(len(locale.PhonePrefixes))])
+	return fmt.Sprintf("+%s%s", prefix, generateRandomDigits(randomizer, length-int64(len(prefix)))), nil
+}
+
 func ValidateE164(p string) bool {
 	if len(p) >= 10 && len(p) <= 15 && strings.Contains(p, "+") {
 		return true
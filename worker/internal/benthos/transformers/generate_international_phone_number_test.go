@@ -2,10 +2,14 @@ package transformers
 
 import (
 	"fmt"
+	"strings"
 	"testing"
 
 	"github.com/benthosdev/benthos/v4/public/bloblang"
+	transformers_dataset "github.com/nucleuscloud/neosync/worker/internal/benthos/transformers/data-sets"
+	transformer_utils "github.com/nucleuscloud/neosync/worker/internal/benthos/transformers/utils"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func Test_GenerateInternationalPhoneNumber(t *testing.T) {
@@ -77,3 +81,14 @@ func Test_ValidateE164FalseTooshort(t *testing.T) {
 
 	assert.Equal(t, res, false, "The e164 number should  be 10 < x")
 }
+
+func Test_GenerateInternationalPhoneNumber_Locale(t *testing.T) {
+	locale, ok := transformers_dataset.GetLocale("ja_JP")
+	require.True(t, ok)
+
+	res, err := generateLocaleInternationalPhoneNumber(transformer_utils.GlobalRandomizer, locale, 12, 12)
+	require.NoError(t, err)
+	assert.Len(t, res, 13, "12 digits and the plus sign")
+	assert.True(t, strings.HasPrefix(res, "+81"), res)
+	assert.True(t, ValidateE164(res))
+}
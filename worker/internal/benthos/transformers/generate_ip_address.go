@@ -0,0 +1,97 @@
+package transformers
+
+import (
+	"fmt"
+	"net/netip"
+
+	"github.com/benthosdev/benthos/v4/public/bloblang"
+	transformer_utils "github.com/nucleuscloud/neosync/worker/internal/benthos/transformers/utils"
+	"github.com/nucleuscloud/neosync/worker/internal/rng"
+)
+
+type ipAddressVersion string
+
+const (
+	ipv4Version ipAddressVersion = "ipv4"
+	ipv6Version ipAddressVersion = "ipv6"
+)
+
+var (
+	// generated ipv4 addresses are public unicast addresses
+	generateIpv4Prefix = netip.MustParsePrefix("0.0.0.0/0")
+	// generated ipv6 addresses are global unicast addresses
+	generateIpv6Prefix = netip.MustParsePrefix("2000::/3")
+)
+
+func init() {
+	spec := bloblang.NewPluginSpec().
+		Param(bloblang.NewStringParam("version").Default(string(ipv4Version))).
+		Param(bloblang.NewInt64Param("seed").Optional())
+
+	err := bloblang.RegisterFunctionV2("generate_ip_address", spec, func(args *bloblang.ParsedParams) (bloblang.Function, error) {
+		version, err := args.GetString("version")
+		if err != nil {
+			return nil, err
+		}
+		if version != string(ipv4Version) && version != string(ipv6Version) {
+			return nil, fmt.Errorf("unsupported version %q, must be one of %q or %q", version, ipv4Version, ipv6Version)
+		}
+
+		seedArg, err := args.GetOptionalInt64("seed")
+		if err != nil {
+			return nil, err
+		}
+		var seed int64
+		if seedArg != nil {
+			seed = *seedArg
+		} else {
+			seed, err = transformer_utils.GenerateCryptoSeed()
+			if err != nil {
+				return nil, err
+			}
+		}
+		randomizer := rng.New(seed)
+
+		return func() (any, error) {
+			return generateRandomIpAddress(randomizer, ipAddressVersion(version)), nil
+		}, nil
+	})
+
+	if err != nil {
+		panic(err)
+	}
+}
+
+// Generates a random public ipv4 address or a random global unicast ipv6 address
+func generateRandomIpAddress(randomizer rng.Rand, version ipAddressVersion) string {
+	if version == ipv6Version {
+		return randomIpInPrefix(randomizer, generateIpv6Prefix).String()
+	}
+	return randomIpInRange(randomizer, generateIpv4Prefix, ipv4PublicRanges).String()
+}
+
+// Returns a random address in the prefix by randomizing the bits after the prefix
+func randomIpInPrefix(randomizer rng.Rand, prefix netip.Prefix) netip.Addr {
+	original := prefix.Addr().AsSlice()
+	addr := make([]byte, len(original))
+	for idx := range addr {
+		addr[idx] = byte(randomizer.Intn(256))
+	}
+	copyLeadingBits(addr, original, prefix.Bits())
+	out, _ := netip.AddrFromSlice(addr)
+	return out
+}
+
+// Copies the first n bits of src into dst
+func copyLeadingBits(dst, src []byte, n int) {
+	for idx := 0; idx < len(dst) && n > 0; idx++ {
+		if n >= 8 {
+			dst[idx] = src[idx]
+			n -= 8
+			continue
+		}
+		mask := byte(0xff << (8 - n))
+		dst[idx] = (src[idx] & mask) | (dst[idx] &^ mask)
+		n = 0
+	}
+}
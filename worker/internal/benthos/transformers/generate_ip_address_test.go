@@ -0,0 +1,46 @@
+package transformers
+
+import (
+	"net/netip"
+	"testing"
+
+	"github.com/benthosdev/benthos/v4/public/bloblang"
+	"github.com/nucleuscloud/neosync/worker/internal/rng"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_GenerateIpAddress(t *testing.T) {
+	randomizer := rng.New(1)
+	for i := 0; i < 100; i++ {
+		addr, err := netip.ParseAddr(generateRandomIpAddress(randomizer, ipv4Version))
+		require.NoError(t, err)
+		assert.True(t, addr.Is4())
+		assert.False(t, isInAnyPrefix(addr, ipv4PublicRanges), "the address should be a public unicast address: %s", addr)
+
+		addr, err = netip.ParseAddr(generateRandomIpAddress(randomizer, ipv6Version))
+		require.NoError(t, err)
+		assert.True(t, addr.Is6())
+		assert.True(t, generateIpv6Prefix.Contains(addr), "the address should be a global unicast address: %s", addr)
+	}
+}
+
+func Test_GenerateIpAddressTransformer(t *testing.T) {
+	mapping := `root = generate_ip_address(version:"ipv6")`
+	ex, err := bloblang.Parse(mapping)
+	require.NoError(t, err, "failed to parse the ip address generator")
+
+	res, err := ex.Query(nil)
+	assert.NoError(t, err)
+
+	resStr, ok := res.(string)
+	require.True(t, ok)
+	addr, err := netip.ParseAddr(resStr)
+	assert.NoError(t, err)
+	assert.True(t, addr.Is6())
+}
+
+func Test_GenerateIpAddressTransformer_InvalidVersion(t *testing.T) {
+	_, err := bloblang.Parse(`root = generate_ip_address(version:"ipv5")`)
+	assert.Error(t, err)
+}
@@ -2,7 +2,6 @@ package transformers
 
 import (
 	"fmt"
-	"time"
 
 	"github.com/benthosdev/benthos/v4/public/bloblang"
 	transformers_dataset "github.com/nucleuscloud/neosync/worker/internal/benthos/transformers/data-sets"
@@ -13,21 +12,37 @@ import (
 func init() {
 	spec := bloblang.NewPluginSpec().
 		Param(bloblang.NewInt64Param("max_length").Default(10000)).
-		Param(bloblang.NewInt64Param("seed").Default(time.Now().UnixNano()))
+		Param(bloblang.NewInt64Param("seed").Optional()).
+		Param(newLocaleParam()).
+		Param(newRowLocaleParam())
 
 	err := bloblang.RegisterFunctionV2("generate_last_name", spec, func(args *bloblang.ParsedParams) (bloblang.Function, error) {
 		maxLength, err := args.GetInt64("max_length")
 		if err != nil {
 			return nil, err
 		}
-		seed, err := args.GetInt64("seed")
+		seedArg, err := args.GetOptionalInt64("seed")
 		if err != nil {
 			return nil, err
 		}
+		var seed int64
+		if seedArg != nil {
+			seed = *seedArg
+		} else {
+			seed, err = transformer_utils.GenerateCryptoSeed()
+			if err != nil {
+				return nil, err
+			}
+		}
 		randomizer := rng.New(seed)
 
+		locale, err := getLocale(args)
+		if err != nil {
+			return nil, err
+		}
+
 		return func() (any, error) {
-			output, err := generateRandomLastName(randomizer, nil, maxLength)
+			output, err := generateRandomLocaleLastName(randomizer, locale, nil, maxLength)
 			if err != nil {
 				return nil, fmt.Errorf("unable to run generate_last_name")
 			}
@@ -41,11 +56,15 @@ func init() {
 }
 
 func generateRandomLastName(randomizer rng.Rand, minLength *int64, maxLength int64) (string, error) {
+	return generateRandomLocaleLastName(randomizer, transformers_dataset.DefaultLocale, minLength, maxLength)
+}
+
+func generateRandomLocaleLastName(randomizer rng.Rand, locale *transformers_dataset.Locale, minLength *int64, maxLength int64) (string, error) {
 	return transformer_utils.GenerateStringFromCorpus(
 		randomizer,
-		transformers_dataset.LastNames,
-		transformers_dataset.LastNameMap,
-		transformers_dataset.LastNameIndices,
+		locale.LastNames,
+		locale.LastNameMap,
+		locale.LastNameIndices,
 		minLength,
 		maxLength,
 		nil,
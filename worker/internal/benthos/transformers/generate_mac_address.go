@@ -0,0 +1,56 @@
+package transformers
+
+import (
+	"net"
+
+	"github.com/benthosdev/benthos/v4/public/bloblang"
+	transformer_utils "github.com/nucleuscloud/neosync/worker/internal/benthos/transformers/utils"
+	"github.com/nucleuscloud/neosync/worker/internal/rng"
+)
+
+const (
+	// the bit of the first byte that marks an address as multicast
+	macMulticastBit byte = 0x01
+	// the bit of the first byte that marks an address as locally administered instead of assigned by a manufacturer
+	macLocalBit byte = 0x02
+)
+
+func init() {
+	spec := bloblang.NewPluginSpec().
+		Param(bloblang.NewInt64Param("seed").Optional())
+
+	err := bloblang.RegisterFunctionV2("generate_mac_address", spec, func(args *bloblang.ParsedParams) (bloblang.Function, error) {
+		seedArg, err := args.GetOptionalInt64("seed")
+		if err != nil {
+			return nil, err
+		}
+		var seed int64
+		if seedArg != nil {
+			seed = *seedArg
+		} else {
+			seed, err = transformer_utils.GenerateCryptoSeed()
+			if err != nil {
+				return nil, err
+			}
+		}
+		randomizer := rng.New(seed)
+
+		return func() (any, error) {
+			return generateRandomMacAddress(randomizer), nil
+		}, nil
+	})
+
+	if err != nil {
+		panic(err)
+	}
+}
+
+// Generates a random locally administered unicast MAC address so that it can not belong to a real device
+func generateRandomMacAddress(randomizer rng.Rand) string {
+	addr := make(net.HardwareAddr, 6)
+	for idx := range addr {
+		addr[idx] = byte(randomizer.Intn(256))
+	}
+	addr[0] = (addr[0] | macLocalBit) &^ macMulticastBit
+	return addr.String()
+}
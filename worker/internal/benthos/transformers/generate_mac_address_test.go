@@ -0,0 +1,36 @@
+package transformers
+
+import (
+	"net"
+	"testing"
+
+	"github.com/benthosdev/benthos/v4/public/bloblang"
+	"github.com/nucleuscloud/neosync/worker/internal/rng"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_GenerateMacAddress(t *testing.T) {
+	randomizer := rng.New(1)
+	for i := 0; i < 20; i++ {
+		addr, err := net.ParseMAC(generateRandomMacAddress(randomizer))
+		require.NoError(t, err)
+		assert.Len(t, addr, 6)
+		assert.Equal(t, macLocalBit, addr[0]&macLocalBit, "the address should be locally administered")
+		assert.Zero(t, addr[0]&macMulticastBit, "the address should be unicast")
+	}
+}
+
+func Test_GenerateMacAddressTransformer(t *testing.T) {
+	mapping := `root = generate_mac_address()`
+	ex, err := bloblang.Parse(mapping)
+	require.NoError(t, err, "failed to parse the mac address generator")
+
+	res, err := ex.Query(nil)
+	assert.NoError(t, err)
+
+	resStr, ok := res.(string)
+	require.True(t, ok)
+	_, err = net.ParseMAC(resStr)
+	assert.NoError(t, err)
+}
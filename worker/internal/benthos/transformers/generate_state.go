@@ -8,11 +8,18 @@ import (
 )
 
 func init() {
-	spec := bloblang.NewPluginSpec()
+	spec := bloblang.NewPluginSpec().
+		Param(newLocaleParam()).
+		Param(newRowLocaleParam())
 
 	err := bloblang.RegisterFunctionV2("generate_state", spec, func(args *bloblang.ParsedParams) (bloblang.Function, error) {
+		locale, err := getLocale(args)
+		if err != nil {
+			return nil, err
+		}
+
 		return func() (any, error) {
-			return GenerateRandomState(), nil
+			return generateRandomLocaleState(locale), nil
 		}, nil
 	})
 	if err != nil {
@@ -22,11 +29,15 @@ func init() {
 
 // Generates a randomly selected state that exists in the United States and returns the two-letter state code.
 func GenerateRandomState() string {
-	addresses := transformers_dataset.Addresses
+	return generateRandomLocaleState(transformers_dataset.DefaultLocale)
+}
+
+// Generates a randomly selected state that exists in the country of the locale.
+func generateRandomLocaleState(locale *transformers_dataset.Locale) string {
+	addresses := locale.Addresses
 
-	// -1 because addresses is an array so we don't overflow
 	//nolint:all
-	randomIndex := rand.Intn(len(addresses) - 1)
+	randomIndex := rand.Intn(len(addresses))
 
 	return addresses[randomIndex].State
 }
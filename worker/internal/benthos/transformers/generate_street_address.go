@@ -18,7 +18,10 @@ type Address struct {
 }
 
 func init() {
-	spec := bloblang.NewPluginSpec().Param(bloblang.NewInt64Param("max_length"))
+	spec := bloblang.NewPluginSpec().
+		Param(bloblang.NewInt64Param("max_length")).
+		Param(newLocaleParam()).
+		Param(newRowLocaleParam())
 
 	err := bloblang.RegisterFunctionV2("generate_street_address", spec, func(args *bloblang.ParsedParams) (bloblang.Function, error) {
 		maxLength, err := args.GetInt64("max_length")
@@ -26,8 +29,13 @@ func init() {
 			return nil, err
 		}
 
+		locale, err := getLocale(args)
+		if err != nil {
+			return nil, err
+		}
+
 		return func() (any, error) {
-			res, err := GenerateRandomStreetAddress(maxLength)
+			res, err := generateRandomLocaleStreetAddress(locale, maxLength)
 			if err != nil {
 				return nil, fmt.Errorf("unable to run generate_street_address: %w", err)
 			}
@@ -41,7 +49,12 @@ func init() {
 
 /* Generates a random street address in the United States in the format <house_number> <street name> <street ending>*/
 func GenerateRandomStreetAddress(maxLength int64) (string, error) {
-	addresses := transformers_dataset.Addresses
+	return generateRandomLocaleStreetAddress(transformers_dataset.DefaultLocale, maxLength)
+}
+
+// Generates a random street address in the format used by the country of the locale
+func generateRandomLocaleStreetAddress(locale *transformers_dataset.Locale, maxLength int64) (string, error) {
+	addresses := locale.Addresses
 	var filteredAddresses []string
 
 	for _, address := range addresses {
@@ -73,9 +86,8 @@ func GenerateRandomStreetAddress(maxLength int64) (string, error) {
 		}
 	}
 
-	// -1 because addresses is an array so we don't overflow
 	//nolint:all
-	randomIndex := rand.Intn(len(filteredAddresses) - 1)
+	randomIndex := rand.Intn(len(filteredAddresses))
 
 	return filteredAddresses[randomIndex], nil
 }
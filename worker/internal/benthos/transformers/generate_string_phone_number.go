@@ -2,15 +2,20 @@ package transformers
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/benthosdev/benthos/v4/public/bloblang"
+	transformers_dataset "github.com/nucleuscloud/neosync/worker/internal/benthos/transformers/data-sets"
 	transformer_utils "github.com/nucleuscloud/neosync/worker/internal/benthos/transformers/utils"
+	"github.com/nucleuscloud/neosync/worker/internal/rng"
 )
 
 func init() {
 	spec := bloblang.NewPluginSpec().
 		Param(bloblang.NewInt64Param("min")).
-		Param(bloblang.NewInt64Param("max"))
+		Param(bloblang.NewInt64Param("max")).
+		Param(newLocaleParam()).
+		Param(newRowLocaleParam())
 
 	err := bloblang.RegisterFunctionV2("generate_string_phone_number", spec, func(args *bloblang.ParsedParams) (bloblang.Function, error) {
 		min, err := args.GetInt64("min")
@@ -22,8 +27,14 @@ func init() {
 		if err != nil {
 			return nil, err
 		}
+
+		locale, err := getLocale(args)
+		if err != nil {
+			return nil, err
+		}
+
 		return func() (any, error) {
-			res, err := GenerateStringPhoneNumber(min, max)
+			res, err := generateLocaleStringPhoneNumber(transformer_utils.GlobalRandomizer, locale, min, max)
 			if err != nil {
 				return nil, fmt.Errorf("unable to run generate_string_phone_number: %w", err)
 			}
@@ -39,12 +50,45 @@ func init() {
 /*  Generates a string phone number in the length interval [min, max] with the min length == 9 and the max length == 15.
  */
 func GenerateStringPhoneNumber(min, max int64) (string, error) {
+	return generateStringPhoneNumber(transformer_utils.GlobalRandomizer, min, max)
+}
+
+func generateStringPhoneNumber(randomizer rng.Rand, min, max int64) (string, error) {
 	min = transformer_utils.Floor(min, 9)
 	max = transformer_utils.Ceil(max, 15)
 
-	val, err := transformer_utils.GenerateRandomInt64InLengthRange(min, max)
+	val, err := transformer_utils.GenerateRandomInt64InLengthRangeWithRandomizer(randomizer, min, max)
 	if err != nil {
 		return "", err
 	}
 	return fmt.Sprintf("%d", val), nil
 }
+
+/*
+Generates a national phone number of the locale in the length interval [min, max] with the min length == 9 and the max length == 15.
+The number starts with the trunk prefix of the locale and one of its mobile prefixes or area codes, for example 01761234567 for de_DE.
+*/
+func generateLocaleStringPhoneNumber(randomizer rng.Rand, locale *transformers_dataset.Locale, min, max int64) (string, error) {
+	if len(locale.PhonePrefixes) == 0 {
+		return generateStringPhoneNumber(randomizer, min, max)
+	}
+
+	min = transformer_utils.Floor(min, 9)
+	max = transformer_utils.Ceil(max, 15)
+
+	length, err := transformer_utils.GenerateRandomInt64InValueRangeWithRandomizer(randomizer, min, max)
+	if err != nil {
+		return "", err
+	}
+	prefix := locale.TrunkPrefix + locale.PhonePrefixes[randomizer.Intn(len(locale.PhonePrefixes))]
+	return prefix + generateRandomDigits(randomizer, length-int64(len(prefix))), nil
+}
+
+// Generates a string of random digits of the given length
+func generateRandomDigits(randomizer rng.Rand, length int64) string {
+	var sb strings.Builder
+	for i := int64(0); i < length; i++ {
+		sb.WriteByte(byte('0' + randomizer.Intn(10)))
+	}
+	return sb.String()
+}
@@ -2,10 +2,14 @@ package transformers
 
 import (
 	"fmt"
+	"strings"
 	"testing"
 
 	"github.com/benthosdev/benthos/v4/public/bloblang"
+	transformers_dataset "github.com/nucleuscloud/neosync/worker/internal/benthos/transformers/data-sets"
+	transformer_utils "github.com/nucleuscloud/neosync/worker/internal/benthos/transformers/utils"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func Test_GenerateStringPhoneNumber(t *testing.T) {
@@ -55,3 +59,39 @@ func Test_GenerateStringPhoneNumberTransformer(t *testing.T) {
 	assert.GreaterOrEqual(t, len(res.(string)), 8, "Should be greater than 9 characters in length. 9 for the number and 1 for the plus sign.")
 	assert.LessOrEqual(t, len(res.(string)), 15, "Should be less than 16 characters in length. 15 for the number and 1 for the plus sign.")
 }
+
+func Test_GenerateStringPhoneNumber_Locale(t *testing.T) {
+	locale, ok := transformers_dataset.GetLocale("de_DE")
+	require.True(t, ok)
+
+	for i := 0; i < 20; i++ {
+		res, err := generateLocaleStringPhoneNumber(transformer_utils.GlobalRandomizer, locale, 10, 12)
+		require.NoError(t, err)
+		assert.GreaterOrEqual(t, len(res), 10)
+		assert.LessOrEqual(t, len(res), 12)
+		assert.Regexp(t, `^0\d+$`, res, "german numbers start with the trunk prefix")
+		assert.True(t, hasAnyPrefix(strings.TrimPrefix(res, "0"), locale.PhonePrefixes), res)
+	}
+}
+
+func Test_GenerateStringPhoneNumberTransformer_Locale(t *testing.T) {
+	ex, err := bloblang.Parse(`root = generate_string_phone_number(min:11,max:11,locale:"pt_BR")`)
+	require.NoError(t, err, "failed to parse the phone transformer")
+
+	res, err := ex.Query(nil)
+	require.NoError(t, err)
+
+	resStr, ok := res.(string)
+	require.True(t, ok)
+	assert.Len(t, resStr, 11)
+	assert.Equal(t, "9", resStr[2:3], "brazilian mobile numbers start with 9 after the area code")
+}
+
+func hasAnyPrefix(value string, prefixes []string) bool {
+	for _, prefix := range prefixes {
+		if strings.HasPrefix(value, prefix) {
+			return true
+		}
+	}
+	return false
+}
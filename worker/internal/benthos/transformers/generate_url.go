@@ -0,0 +1,45 @@
+package transformers
+
+import (
+	"fmt"
+
+	"github.com/benthosdev/benthos/v4/public/bloblang"
+	transformer_utils "github.com/nucleuscloud/neosync/worker/internal/benthos/transformers/utils"
+	"github.com/nucleuscloud/neosync/worker/internal/rng"
+)
+
+func init() {
+	spec := bloblang.NewPluginSpec().
+		Param(bloblang.NewInt64Param("seed").Optional())
+
+	err := bloblang.RegisterFunctionV2("generate_url", spec, func(args *bloblang.ParsedParams) (bloblang.Function, error) {
+		seedArg, err := args.GetOptionalInt64("seed")
+		if err != nil {
+			return nil, err
+		}
+		var seed int64
+		if seedArg != nil {
+			seed = *seedArg
+		} else {
+			seed, err = transformer_utils.GenerateCryptoSeed()
+			if err != nil {
+				return nil, err
+			}
+		}
+		randomizer := rng.New(seed)
+
+		return func() (any, error) {
+			return generateRandomUrl(randomizer), nil
+		}, nil
+	})
+
+	if err != nil {
+		panic(err)
+	}
+}
+
+// Generates a random https URL with a random domain and a path of one segment, for example https://wkbnsle.com/fqtzp
+func generateRandomUrl(randomizer rng.Rand) string {
+	path := transformer_utils.GetRandomCharacterString(randomizer, int64(4+randomizer.Intn(7)))
+	return fmt.Sprintf("https://%s/%s", generateRandomDomain(randomizer), path)
+}
@@ -0,0 +1,37 @@
+package transformers
+
+import (
+	"net/url"
+	"testing"
+
+	"github.com/benthosdev/benthos/v4/public/bloblang"
+	"github.com/nucleuscloud/neosync/worker/internal/rng"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_GenerateUrl(t *testing.T) {
+	randomizer := rng.New(1)
+	for i := 0; i < 20; i++ {
+		res := generateRandomUrl(randomizer)
+		parsed, err := url.Parse(res)
+		require.NoError(t, err)
+		assert.Equal(t, "https", parsed.Scheme)
+		assert.Regexp(t, `^[a-z]{5,12}\.[a-z]{2,3}$`, parsed.Host)
+		assert.Regexp(t, `^/[a-z]{4,10}$`, parsed.Path)
+	}
+}
+
+func Test_GenerateUrlTransformer(t *testing.T) {
+	mapping := `root = generate_url()`
+	ex, err := bloblang.Parse(mapping)
+	require.NoError(t, err, "failed to parse the url generator")
+
+	res, err := ex.Query(nil)
+	assert.NoError(t, err)
+
+	resStr, ok := res.(string)
+	require.True(t, ok)
+	_, err = url.ParseRequestURI(resStr)
+	assert.NoError(t, err)
+}
@@ -8,11 +8,18 @@ import (
 )
 
 func init() {
-	spec := bloblang.NewPluginSpec()
+	spec := bloblang.NewPluginSpec().
+		Param(newLocaleParam()).
+		Param(newRowLocaleParam())
 
 	err := bloblang.RegisterFunctionV2("generate_zipcode", spec, func(args *bloblang.ParsedParams) (bloblang.Function, error) {
+		locale, err := getLocale(args)
+		if err != nil {
+			return nil, err
+		}
+
 		return func() (any, error) {
-			return GenerateRandomZipcode(), nil
+			return generateRandomLocaleZipcode(locale), nil
 		}, nil
 	})
 	if err != nil {
@@ -22,10 +29,14 @@ func init() {
 
 // Generates a randomly selected zip code that exists in the United States.
 func GenerateRandomZipcode() string {
-	addresses := transformers_dataset.Addresses
+	return generateRandomLocaleZipcode(transformers_dataset.DefaultLocale)
+}
+
+// Generates a randomly selected zip code that exists in the country of the locale.
+func generateRandomLocaleZipcode(locale *transformers_dataset.Locale) string {
+	addresses := locale.Addresses
 
-	// -1 because addresses is an array so we don't overflow
 	//nolint:gosec
-	randomIndex := rand.Intn(len(addresses) - 1)
+	randomIndex := rand.Intn(len(addresses))
 	return addresses[randomIndex].Zipcode
 }
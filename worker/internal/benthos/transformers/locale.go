@@ -0,0 +1,48 @@
+package transformers
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/benthosdev/benthos/v4/public/bloblang"
+	transformers_dataset "github.com/nucleuscloud/neosync/worker/internal/benthos/transformers/data-sets"
+)
+
+const (
+	// Name of the param that selects the locale that values are generated for
+	localeParam = "locale"
+	// Name of the optional param that is set to a value of the row, such as a country code, that overrides the locale param.
+	// When the value is empty or is not a supported locale, the locale param is used.
+	rowLocaleParam = "row_locale"
+)
+
+func newLocaleParam() bloblang.ParamDefinition {
+	return bloblang.NewStringParam(localeParam).Default(transformers_dataset.DefaultLocaleCode)
+}
+
+func newRowLocaleParam() bloblang.ParamDefinition {
+	return bloblang.NewAnyParam(rowLocaleParam).Optional()
+}
+
+// Returns the locale of the row if it is supported, otherwise the configured locale.
+func getLocale(args *bloblang.ParsedParams) (*transformers_dataset.Locale, error) {
+	code, err := args.GetString(localeParam)
+	if err != nil {
+		return nil, err
+	}
+	locale, ok := transformers_dataset.GetLocale(code)
+	if !ok {
+		return nil, fmt.Errorf("unsupported locale %q, must be one of %s", code, strings.Join(transformers_dataset.GetLocaleCodes(), ", "))
+	}
+
+	rowLocale, err := args.GetOptionalString(rowLocaleParam)
+	if err != nil {
+		return nil, err
+	}
+	if rowLocale != nil {
+		if override, ok := transformers_dataset.GetLocale(*rowLocale); ok {
+			return override, nil
+		}
+	}
+	return locale, nil
+}
@@ -0,0 +1,87 @@
+package transformers
+
+import (
+	"testing"
+
+	"github.com/benthosdev/benthos/v4/public/bloblang"
+	transformers_dataset "github.com/nucleuscloud/neosync/worker/internal/benthos/transformers/data-sets"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_GetLocale(t *testing.T) {
+	type testcase struct {
+		value    string
+		expected string
+	}
+	testcases := []testcase{
+		{"de_DE", "de_DE"},
+		{"de-DE", "de_DE"},
+		{"ja_jp", "ja_JP"},
+		{"BR", "pt_BR"},
+		{"us", "en_US"},
+		{" JP ", "ja_JP"},
+	}
+	for _, tc := range testcases {
+		tc := tc
+		t.Run(tc.value, func(t *testing.T) {
+			locale, ok := transformers_dataset.GetLocale(tc.value)
+			require.True(t, ok)
+			assert.Equal(t, tc.expected, locale.Code)
+		})
+	}
+
+	for _, value := range []string{"", "fr_FR", "FR", "german"} {
+		_, ok := transformers_dataset.GetLocale(value)
+		assert.False(t, ok, value)
+	}
+}
+
+func Test_Locale_Datasets(t *testing.T) {
+	for _, locale := range transformers_dataset.Locales {
+		locale := locale
+		t.Run(locale.Code, func(t *testing.T) {
+			assert.NotEmpty(t, locale.FirstNames)
+			assert.NotEmpty(t, locale.FirstNameIndices)
+			assert.NotEmpty(t, locale.LastNames)
+			assert.NotEmpty(t, locale.LastNameIndices)
+			assert.NotEmpty(t, locale.Addresses)
+			assert.NotNil(t, locale.FormatAddress)
+			assert.NotZero(t, locale.CallingCode)
+		})
+	}
+}
+
+func Test_Locale_Bloblang_Row_Locale(t *testing.T) {
+	ex, err := bloblang.Parse(`root = generate_state(locale:"de_DE", row_locale:this.country_code)`)
+	require.NoError(t, err)
+
+	res, err := ex.Query(map[string]any{"country_code": "JP"})
+	require.NoError(t, err)
+	assert.Contains(t, getLocaleStates(t, "ja_JP"), res, "the locale of the row must be used")
+
+	res, err = ex.Query(map[string]any{"country_code": "FR"})
+	require.NoError(t, err)
+	assert.Contains(t, getLocaleStates(t, "de_DE"), res, "an unsupported row locale must fall back to the locale param")
+
+	res, err = ex.Query(map[string]any{"country_code": nil})
+	require.NoError(t, err)
+	assert.Contains(t, getLocaleStates(t, "de_DE"), res, "a null row locale must fall back to the locale param")
+}
+
+func Test_Locale_Bloblang_Unsupported_Locale(t *testing.T) {
+	_, err := bloblang.Parse(`root = generate_first_name(locale:"fr_FR")`)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "unsupported locale")
+}
+
+func getLocaleStates(t testing.TB, code string) []string {
+	t.Helper()
+	locale, ok := transformers_dataset.GetLocale(code)
+	require.True(t, ok)
+	states := make([]string, 0, len(locale.Addresses))
+	for _, address := range locale.Addresses {
+		states = append(states, address.State)
+	}
+	return states
+}
@@ -8,6 +8,7 @@ import (
 
 	"github.com/benthosdev/benthos/v4/public/bloblang"
 	transformer_utils "github.com/nucleuscloud/neosync/worker/internal/benthos/transformers/utils"
+	"github.com/nucleuscloud/neosync/worker/internal/rng"
 )
 
 const (
@@ -18,7 +19,8 @@ const (
 
 func init() {
 	spec := bloblang.NewPluginSpec().
-		Param(bloblang.NewAnyParam("value").Optional()).Param(bloblang.NewStringParam("user_provided_regex").Optional())
+		Param(bloblang.NewAnyParam("value").Optional()).Param(bloblang.NewStringParam("user_provided_regex").Optional()).
+		Param(newDeterministicKeyParam())
 
 	err := bloblang.RegisterFunctionV2("transform_character_scramble", spec, func(args *bloblang.ParsedParams) (bloblang.Function, error) {
 		valuePtr, err := args.GetOptionalString("value")
@@ -41,8 +43,13 @@ func init() {
 			regex = *regexPtr
 		}
 
+		randomizer, err := getTransformRandomizer(args, value)
+		if err != nil {
+			return nil, err
+		}
+
 		return func() (any, error) {
-			res, err := TransformCharacterScramble(value, regex)
+			res, err := TransformCharacterScramble(randomizer, value, regex)
 			if err != nil {
 				return nil, fmt.Errorf("unable to run transform_character_scramble: %w", err)
 			}
@@ -66,7 +73,7 @@ Substituted: Ifmmp Xpsme 234@%^
 Note that this does not work for hex values: 0x00 -> 0x1F
 */
 
-func TransformCharacterScramble(value, regex string) (*string, error) {
+func TransformCharacterScramble(randomizer rng.Rand, value, regex string) (*string, error) {
 	if value == "" {
 		return nil, nil
 	}
@@ -83,7 +90,7 @@ func TransformCharacterScramble(value, regex string) (*string, error) {
 
 		// if no matches are found just scramble the entire string
 		if matches == nil {
-			transformedString := strings.Map(ScrambleChar, value)
+			transformedString := strings.Map(scrambleCharFn(randomizer), value)
 			return &transformedString, nil
 		}
 
@@ -91,23 +98,29 @@ func TransformCharacterScramble(value, regex string) (*string, error) {
 		for _, match := range matches {
 			start, end := match[0], match[1]
 			// run the scrambler for the substring
-			matchTransformed := strings.Map(ScrambleChar, value[start:end])
+			matchTransformed := strings.Map(scrambleCharFn(randomizer), value[start:end])
 			// replace the original substring with its transformed version
 			transformedString = transformedString[:start] + matchTransformed + transformedString[end:]
 		}
 
 		return &transformedString, nil
 	} else {
-		transformedString := strings.Map(ScrambleChar, value)
+		transformedString := strings.Map(scrambleCharFn(randomizer), value)
 		return &transformedString, nil
 	}
 }
 
-func ScrambleChar(r rune) rune {
+func scrambleCharFn(randomizer rng.Rand) func(r rune) rune {
+	return func(r rune) rune {
+		return ScrambleChar(randomizer, r)
+	}
+}
+
+func ScrambleChar(randomizer rng.Rand, r rune) rune {
 	if unicode.IsSpace(r) {
 		return r
 	} else if unicode.IsLetter(r) {
-		randStringListInd, err := transformer_utils.GenerateRandomInt64InValueRange(0, 25)
+		randStringListInd, err := transformer_utils.GenerateRandomInt64InValueRangeWithRandomizer(randomizer, 0, 25)
 		if err != nil {
 			return r
 		}
@@ -117,14 +130,14 @@ func ScrambleChar(r rune) rune {
 		}
 		return sub
 	} else if unicode.IsDigit(r) {
-		randNumberListInd, err := transformer_utils.GenerateRandomInt64InValueRange(0, 9)
+		randNumberListInd, err := transformer_utils.GenerateRandomInt64InValueRangeWithRandomizer(randomizer, 0, 9)
 		if err != nil {
 			return r
 		}
 
 		return rune(numberList[randNumberListInd])
 	} else if transformer_utils.IsAllowedSpecialChar(r) {
-		randInd, err := transformer_utils.GenerateRandomInt64InValueRange(0, 28)
+		randInd, err := transformer_utils.GenerateRandomInt64InValueRangeWithRandomizer(randomizer, 0, 28)
 		if err != nil {
 			return r
 		}
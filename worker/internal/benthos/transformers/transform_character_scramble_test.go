@@ -2,6 +2,7 @@ package transformers
 
 import (
 	"fmt"
+	"math/rand"
 	"regexp"
 	"testing"
 	"unicode"
@@ -20,7 +21,7 @@ func Test_ScrambleCharacter(t *testing.T) {
 
 	testRune := rune(testStringValue[0])
 
-	res := ScrambleChar(testRune)
+	res := ScrambleChar(rand.New(rand.NewSource(1)), testRune)
 
 	assert.IsType(t, "", string(res))
 	assert.Equal(t, len(testStringValue), len(string(res)), "The output string should be as long as the input string")
@@ -30,7 +31,7 @@ func Test_ScrambleCharacter(t *testing.T) {
 func Test_TransformCharacterSubstitutionLetters(t *testing.T) {
 	testStringValue := "he11o world"
 
-	res, err := TransformCharacterScramble(testStringValue, "e11")
+	res, err := TransformCharacterScramble(rand.New(rand.NewSource(1)), testStringValue, "e11")
 
 	assert.NoError(t, err)
 	assert.IsType(t, "", *res)
@@ -41,7 +42,7 @@ func Test_TransformCharacterSubstitutionLetters(t *testing.T) {
 func Test_TransformCharacterSubstitutionCapitalizationLetters(t *testing.T) {
 	testStringValue := "Hello"
 
-	res, err := TransformCharacterScramble(testStringValue, helloWorldRegex)
+	res, err := TransformCharacterScramble(rand.New(rand.NewSource(1)), testStringValue, helloWorldRegex)
 
 	assert.NoError(t, err)
 	assert.NotNil(t, res, "Result should not be nil")
@@ -55,7 +56,7 @@ func Test_TransformCharacterSubstitutionCapitalizationLetters(t *testing.T) {
 func Test_TransformCharacterSubstitutionNumbers(t *testing.T) {
 	testStringValue := "41323421"
 
-	res, err := TransformCharacterScramble(testStringValue, numberRegex)
+	res, err := TransformCharacterScramble(rand.New(rand.NewSource(1)), testStringValue, numberRegex)
 
 	assert.NoError(t, err)
 	assert.IsType(t, "", *res)
@@ -66,7 +67,7 @@ func Test_TransformCharacterSubstitutionNumbers(t *testing.T) {
 func Test_TransformCharacterSubstitutionLettersNumbers(t *testing.T) {
 	testStringValue := "hello wor23r2ld 221"
 
-	res, err := TransformCharacterScramble(testStringValue, helloWorldRegex)
+	res, err := TransformCharacterScramble(rand.New(rand.NewSource(1)), testStringValue, helloWorldRegex)
 
 	assert.NoError(t, err)
 	assert.IsType(t, "", *res)
@@ -77,7 +78,7 @@ func Test_TransformCharacterSubstitutionLettersNumbers(t *testing.T) {
 func Test_TransformCharacterSubstitutionLettersNumbersCharacters(t *testing.T) {
 	testStringValue := "h#*(&lo wor23r2ld 221"
 
-	res, err := TransformCharacterScramble(testStringValue, `#\*\(&`)
+	res, err := TransformCharacterScramble(rand.New(rand.NewSource(1)), testStringValue, `#\*\(&`)
 
 	assert.NoError(t, err)
 	assert.IsType(t, "", *res)
@@ -87,7 +88,7 @@ func Test_TransformCharacterSubstitutionLettersNumbersCharacters(t *testing.T) {
 
 func Test_TransformCharacterSubstitutionLettersMultipleMatches(t *testing.T) {
 	// should match the first two sections and not that last i.e. h_ello_ello_elo
-	res, err := TransformCharacterScramble(helloTest, `ello`)
+	res, err := TransformCharacterScramble(rand.New(rand.NewSource(1)), helloTest, `ello`)
 
 	assert.NoError(t, err)
 	assert.IsType(t, "", *res)
@@ -99,7 +100,7 @@ func Test_TransformCharacterSubstitutionLettersMultipleMatches(t *testing.T) {
 
 func Test_TransformCharacterSubstitutionLettersNoMatches(t *testing.T) {
 	// should match the first two sections and not that last i.e. h_ello_ello_elo
-	res, err := TransformCharacterScramble(helloTest, `123`)
+	res, err := TransformCharacterScramble(rand.New(rand.NewSource(1)), helloTest, `123`)
 
 	assert.NoError(t, err)
 	assert.IsType(t, "", *res)
@@ -110,7 +111,7 @@ func Test_TransformCharacterSubstitutionLettersNoMatches(t *testing.T) {
 
 func Test_TransformCharacterSubstitutionLettersNilregex(t *testing.T) {
 	// should match the first two sections and not that last i.e. h_ello_ello_elo
-	res, err := TransformCharacterScramble(testStringValue, ``)
+	res, err := TransformCharacterScramble(rand.New(rand.NewSource(1)), testStringValue, ``)
 
 	assert.NoError(t, err)
 	assert.IsType(t, "", *res)
@@ -124,7 +125,7 @@ func Test_TransformCharacterSubstitutionLettersMatchNumbers(t *testing.T) {
 	testStringValue := "MED-133-R123"
 	complexRegex := `\d+`
 
-	res, err := TransformCharacterScramble(testStringValue, complexRegex)
+	res, err := TransformCharacterScramble(rand.New(rand.NewSource(1)), testStringValue, complexRegex)
 
 	assert.NoError(t, err)
 	assert.IsType(t, "", *res)
@@ -150,7 +151,7 @@ func Test_TransformCharacterSubstitutionLettersSemiComplexRegex(t *testing.T) {
 	testStringValue := "MED-133-L123"
 	complexRegex := `-(.+?)-`
 
-	res, err := TransformCharacterScramble(testStringValue, complexRegex)
+	res, err := TransformCharacterScramble(rand.New(rand.NewSource(1)), testStringValue, complexRegex)
 
 	assert.NoError(t, err)
 	assert.IsType(t, "", *res)
@@ -165,7 +166,7 @@ func Test_TransformCharacterSubstitutionLettersComplexRegex(t *testing.T) {
 	testStringValue := "MED-133-A123"
 	complexRegex := `-(.+?)-`
 
-	res, err := TransformCharacterScramble(testStringValue, complexRegex)
+	res, err := TransformCharacterScramble(rand.New(rand.NewSource(1)), testStringValue, complexRegex)
 
 	assert.NoError(t, err)
 	assert.IsType(t, "", *res)
@@ -207,7 +208,7 @@ func Test_TransformCharacterSubsitutitionRegexEmail(t *testing.T) {
 
 	testEmail := "nick@gmail.com"
 
-	res, err := TransformCharacterScramble(testEmail, emailregex)
+	res, err := TransformCharacterScramble(rand.New(rand.NewSource(1)), testEmail, emailregex)
 
 	assert.NoError(t, err)
 	assert.IsType(t, "", *res)
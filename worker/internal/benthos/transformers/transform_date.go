@@ -0,0 +1,93 @@
+package transformers
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/benthosdev/benthos/v4/public/bloblang"
+	transformer_utils "github.com/nucleuscloud/neosync/worker/internal/benthos/transformers/utils"
+	"github.com/nucleuscloud/neosync/worker/internal/rng"
+)
+
+func init() {
+	spec := newTimeTransformPluginSpec("jitter_range_days")
+
+	err := bloblang.RegisterFunctionV2("transform_date", spec, func(args *bloblang.ParsedParams) (bloblang.Function, error) {
+		value, err := args.Get("value")
+		if err != nil {
+			return nil, err
+		}
+
+		opts, err := getTimeTransformOpts(args, "jitter_range_days")
+		if err != nil {
+			return nil, err
+		}
+
+		randomizer, err := getTimeTransformRandomizer(args, opts, value)
+		if err != nil {
+			return nil, err
+		}
+
+		return func() (any, error) {
+			res, err := TransformDate(randomizer, value, opts)
+			if err != nil {
+				return nil, fmt.Errorf("unable to run transform_date: %w", err)
+			}
+			return res, nil
+		}, nil
+	})
+
+	if err != nil {
+		panic(err)
+	}
+}
+
+/*
+Transforms a date while keeping it related to the original value. The time of day is dropped and the result is always at midnight.
+
+shift: moves the value by the shift offset. The offset is derived from the entity of the row so that all of the dates of an entity keep their order.
+jitter: moves the value by a random number of days in the range of [-jitter range, jitter range].
+truncate: truncates the value to the first day of its month or year.
+clamp: limits the value to the range of [min, max].
+
+The value can be a time.Time or a string in one of the supported layouts, and the result is of the same type and layout.
+*/
+func TransformDate(randomizer rng.Rand, value any, opts *TimeTransformOpts) (any, error) {
+	return transformTimeValue(value, func(t time.Time) (time.Time, error) {
+		date := toDate(t, t.Location())
+		switch opts.Mode {
+		case timeTransformModeShift:
+			return date.AddDate(0, 0, int(opts.ShiftOffsetDays)), nil
+		case timeTransformModeJitter:
+			days, err := transformer_utils.GenerateRandomInt64InValueRangeWithRandomizer(randomizer, -opts.JitterRange, opts.JitterRange)
+			if err != nil {
+				return time.Time{}, err
+			}
+			return date.AddDate(0, 0, int(days)), nil
+		case timeTransformModeTruncate:
+			return truncateTime(date, opts.TruncatePrecision), nil
+		case timeTransformModeClamp:
+			if opts.Min != nil {
+				minDate := toDate(*opts.Min, t.Location())
+				if date.Before(minDate) {
+					return minDate, nil
+				}
+			}
+			if opts.Max != nil {
+				maxDate := toDate(*opts.Max, t.Location())
+				if date.After(maxDate) {
+					return maxDate, nil
+				}
+			}
+			return date, nil
+		default:
+			return time.Time{}, fmt.Errorf("unsupported mode: %s", opts.Mode)
+		}
+	})
+}
+
+// Returns midnight of the calendar date of the time in the location
+func toDate(t time.Time, loc *time.Location) time.Time {
+	year, month, day := t.Date()
+	return time.Date(year, month, day, 0, 0, 0, 0, loc)
+}
@@ -0,0 +1,109 @@
+package transformers
+
+import (
+	"fmt"
+	"math/rand"
+	"testing"
+	"time"
+
+	"github.com/benthosdev/benthos/v4/public/bloblang"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_TransformDate_Shift(t *testing.T) {
+	value := time.Date(2024, 2, 27, 0, 0, 0, 0, time.UTC)
+	res, err := TransformDate(nil, value, &TimeTransformOpts{Mode: timeTransformModeShift, ShiftOffsetDays: 3})
+	assert.NoError(t, err)
+	assert.Equal(t, time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC), res)
+}
+
+func Test_TransformDate_DropsTimeOfDay(t *testing.T) {
+	value := time.Date(2024, 2, 27, 23, 59, 0, 0, time.UTC)
+	res, err := TransformDate(nil, value, &TimeTransformOpts{Mode: timeTransformModeShift, ShiftOffsetDays: 1})
+	assert.NoError(t, err)
+	assert.Equal(t, time.Date(2024, 2, 28, 0, 0, 0, 0, time.UTC), res)
+}
+
+func Test_TransformDate_Jitter(t *testing.T) {
+	value := time.Date(2024, 2, 27, 0, 0, 0, 0, time.UTC)
+	res, err := TransformDate(rand.New(rand.NewSource(1)), value, &TimeTransformOpts{Mode: timeTransformModeJitter, JitterRange: 5})
+	assert.NoError(t, err)
+
+	resTime, ok := res.(time.Time)
+	require.True(t, ok)
+	assert.WithinDuration(t, value, resTime, 5*24*time.Hour)
+	assert.Equal(t, 0, resTime.Hour())
+}
+
+func Test_TransformDate_Truncate(t *testing.T) {
+	res, err := TransformDate(nil, "2024-02-27", &TimeTransformOpts{Mode: timeTransformModeTruncate, TruncatePrecision: timeTruncatePrecisionMonth})
+	assert.NoError(t, err)
+	assert.Equal(t, "2024-02-01", res)
+
+	res, err = TransformDate(nil, "2024-02-27", &TimeTransformOpts{Mode: timeTransformModeTruncate, TruncatePrecision: timeTruncatePrecisionYear})
+	assert.NoError(t, err)
+	assert.Equal(t, "2024-01-01", res)
+}
+
+func Test_TransformDate_Clamp(t *testing.T) {
+	minValue := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	maxValue := time.Date(2023, 12, 31, 0, 0, 0, 0, time.UTC)
+	opts := &TimeTransformOpts{Mode: timeTransformModeClamp, Min: &minValue, Max: &maxValue}
+
+	res, err := TransformDate(nil, "1999-05-04", opts)
+	assert.NoError(t, err)
+	assert.Equal(t, "2020-01-01", res)
+
+	res, err = TransformDate(nil, "2021-05-04", opts)
+	assert.NoError(t, err)
+	assert.Equal(t, "2021-05-04", res)
+
+	res, err = TransformDate(nil, time.Date(2031, 5, 4, 0, 0, 0, 0, time.UTC), opts)
+	assert.NoError(t, err)
+	assert.Equal(t, maxValue, res)
+}
+
+func Test_TransformDateTransformer_Shift(t *testing.T) {
+	mapping := fmt.Sprintf(`root = transform_date(value:this.birth_date,mode:"shift",shift_range_days:30,entity:this.id,deterministic_key:%q)`, timeTransformTestKey)
+	ex, err := bloblang.Parse(mapping)
+	require.NoError(t, err, "failed to parse the date transformer")
+
+	res, err := ex.Query(map[string]any{"birth_date": "1990-06-15", "id": "a1"})
+	assert.NoError(t, err)
+	again, err := ex.Query(map[string]any{"birth_date": "1990-06-15", "id": "a1"})
+	assert.NoError(t, err)
+	assert.Equal(t, res, again, "the same entity should always be shifted by the same offset")
+
+	resStr, ok := res.(string)
+	require.True(t, ok)
+	resDate, err := time.Parse(time.DateOnly, resStr)
+	require.NoError(t, err)
+	assert.WithinDuration(t, time.Date(1990, 6, 15, 0, 0, 0, 0, time.UTC), resDate, 30*24*time.Hour)
+}
+
+func Test_TransformDateTransformer_ConsistentWithTimestamp(t *testing.T) {
+	dateEx, err := bloblang.Parse(fmt.Sprintf(`root = transform_date(value:this.signup_date,mode:"shift",shift_range_days:90,entity:this.user_id,deterministic_key:%q)`, timeTransformTestKey))
+	require.NoError(t, err)
+	timestampEx, err := bloblang.Parse(fmt.Sprintf(`root = transform_timestamp(value:this.ordered_at,mode:"shift",shift_range_days:90,entity:this.user_id,deterministic_key:%q)`, timeTransformTestKey))
+	require.NoError(t, err)
+
+	signup, err := dateEx.Query(map[string]any{"signup_date": "2024-01-01", "user_id": 7})
+	assert.NoError(t, err)
+	ordered, err := timestampEx.Query(map[string]any{"ordered_at": "2024-01-01T10:00:00Z", "user_id": 7})
+	assert.NoError(t, err)
+
+	signupDate, err := time.Parse(time.DateOnly, signup.(string))
+	require.NoError(t, err)
+	orderedAt, err := time.Parse(time.RFC3339, ordered.(string))
+	require.NoError(t, err)
+	assert.Equal(t, 10*time.Hour, orderedAt.Sub(signupDate), "a date and a timestamp of the same entity should be shifted by the same offset")
+}
+
+func Test_TransformDateTransformer_InvalidTruncatePrecision(t *testing.T) {
+	ex, err := bloblang.Parse(`root = transform_date(value:this,mode:"truncate",truncate_precision:"week")`)
+	require.NoError(t, err)
+
+	_, err = ex.Query("2024-01-01")
+	assert.Error(t, err)
+}
@@ -0,0 +1,121 @@
+package transformers
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"unicode"
+
+	"github.com/benthosdev/benthos/v4/public/bloblang"
+	"github.com/nucleuscloud/neosync/worker/internal/rng"
+)
+
+// Second level labels that are part of a country's public suffix, such as the co of co.uk
+var secondLevelDomainLabels = []string{"ac", "co", "com", "edu", "gov", "ne", "net", "or", "org"}
+
+func init() {
+	spec := bloblang.NewPluginSpec().
+		Param(bloblang.NewAnyParam("value").Optional()).
+		Param(bloblang.NewBoolParam("preserve_tld").Default(true)).
+		Param(newDeterministicKeyParam())
+
+	err := bloblang.RegisterFunctionV2("transform_domain", spec, func(args *bloblang.ParsedParams) (bloblang.Function, error) {
+		valuePtr, err := args.GetOptionalString("value")
+		if err != nil {
+			return nil, err
+		}
+
+		var value string
+		if valuePtr != nil {
+			value = *valuePtr
+		}
+
+		preserveTld, err := args.GetBool("preserve_tld")
+		if err != nil {
+			return nil, err
+		}
+
+		randomizer, err := getTransformRandomizer(args, value)
+		if err != nil {
+			return nil, err
+		}
+
+		return func() (any, error) {
+			res, err := TransformDomain(randomizer, value, preserveTld)
+			if err != nil {
+				return nil, fmt.Errorf("unable to run transform_domain: %w", err)
+			}
+			return res, nil
+		}, nil
+	})
+
+	if err != nil {
+		panic(err)
+	}
+}
+
+/*
+Transforms a domain by replacing the letters and digits of each of its labels while keeping the number and length of the labels and a leading www label.
+When preserveTld is true, the top level domain is kept, including country suffixes such as co.uk. Otherwise it is replaced with a common top level domain.
+
+For example, with preserveTld: api.example.co.uk -> fqt.wkbnsle.co.uk
+*/
+func TransformDomain(randomizer rng.Rand, value string, preserveTld bool) (*string, error) {
+	if value == "" {
+		return nil, nil
+	}
+
+	trimmed, hasTrailingDot := strings.CutSuffix(value, ".")
+	labels := strings.Split(trimmed, ".")
+	for _, label := range labels {
+		if label == "" {
+			return nil, fmt.Errorf("value is not a valid domain: %s", value)
+		}
+	}
+
+	tldLabels := 0
+	if len(labels) > 1 {
+		tldLabels = 1
+		if len(labels) > 2 && len(labels[len(labels)-1]) == 2 && slices.Contains(secondLevelDomainLabels, strings.ToLower(labels[len(labels)-2])) {
+			tldLabels = 2
+		}
+	}
+
+	output := make([]string, 0, len(labels))
+	for idx, label := range labels[:len(labels)-tldLabels] {
+		if idx == 0 && len(labels) > 2 && strings.EqualFold(label, "www") {
+			output = append(output, label)
+			continue
+		}
+		output = append(output, scrambleAlphanumeric(randomizer, label))
+	}
+	if tldLabels > 0 {
+		if preserveTld {
+			output = append(output, labels[len(labels)-tldLabels:]...)
+		} else {
+			output = append(output, generateDomainTlds[randomizer.Intn(len(generateDomainTlds))])
+		}
+	}
+
+	res := strings.Join(output, ".")
+	if hasTrailingDot {
+		res += "."
+	}
+	return &res, nil
+}
+
+// Replaces every letter with a random letter of the same case and every digit with a random digit. Other characters are kept
+func scrambleAlphanumeric(randomizer rng.Rand, value string) string {
+	return strings.Map(func(r rune) rune {
+		switch {
+		case r >= '0' && r <= '9':
+			return rune('0' + randomizer.Intn(10))
+		case unicode.IsUpper(r):
+			return rune('A' + randomizer.Intn(26))
+		case unicode.IsLetter(r):
+			return rune('a' + randomizer.Intn(26))
+		default:
+			return r
+		}
+	}, value)
+}
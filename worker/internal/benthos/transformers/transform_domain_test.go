@@ -0,0 +1,54 @@
+package transformers
+
+import (
+	"testing"
+
+	"github.com/benthosdev/benthos/v4/public/bloblang"
+	"github.com/nucleuscloud/neosync/worker/internal/rng"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_TransformDomain(t *testing.T) {
+	tests := []struct {
+		value       string
+		preserveTld bool
+		pattern     string
+	}{
+		{value: "example.com", preserveTld: true, pattern: `^[a-z]{7}\.com$`},
+		{value: "api.example.co.uk", preserveTld: true, pattern: `^[a-z]{3}\.[a-z]{7}\.co\.uk$`},
+		{value: "www.my-shop24.de", preserveTld: true, pattern: `^www\.[a-z]{2}-[a-z]{4}\d{2}\.de$`},
+		{value: "Example.COM.", preserveTld: true, pattern: `^[A-Z][a-z]{6}\.COM\.$`},
+		{value: "example.com", preserveTld: false, pattern: `^[a-z]{7}\.(com|net|org|io|dev|app|co)$`},
+		{value: "localhost", preserveTld: true, pattern: `^[a-z]{9}$`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			res, err := TransformDomain(rng.New(1), tt.value, tt.preserveTld)
+			require.NoError(t, err)
+			require.NotNil(t, res)
+			assert.Regexp(t, tt.pattern, *res)
+		})
+	}
+}
+
+func Test_TransformDomain_Invalid(t *testing.T) {
+	_, err := TransformDomain(rng.New(1), "example..com", true)
+	assert.Error(t, err)
+
+	res, err := TransformDomain(rng.New(1), "", true)
+	assert.NoError(t, err)
+	assert.Nil(t, res)
+}
+
+func Test_TransformDomainTransformer(t *testing.T) {
+	mapping := `root = transform_domain(value:"example.com",preserve_tld:true)`
+	ex, err := bloblang.Parse(mapping)
+	require.NoError(t, err, "failed to parse the domain transformer")
+
+	res, err := ex.Query(nil)
+	assert.NoError(t, err)
+	resStr, ok := res.(*string)
+	require.True(t, ok)
+	assert.Regexp(t, `^[a-z]{7}\.com$`, *resStr)
+}
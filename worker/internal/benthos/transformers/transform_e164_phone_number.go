@@ -6,13 +6,15 @@ import (
 
 	"github.com/benthosdev/benthos/v4/public/bloblang"
 	transformer_utils "github.com/nucleuscloud/neosync/worker/internal/benthos/transformers/utils"
+	"github.com/nucleuscloud/neosync/worker/internal/rng"
 )
 
 func init() {
 	spec := bloblang.NewPluginSpec().
 		Param(bloblang.NewAnyParam("value").Optional()).
 		Param(bloblang.NewBoolParam("preserve_length")).
-		Param(bloblang.NewInt64Param("max_length"))
+		Param(bloblang.NewInt64Param("max_length")).
+		Param(newDeterministicKeyParam())
 
 	err := bloblang.RegisterFunctionV2("transform_e164_phone_number", spec, func(args *bloblang.ParsedParams) (bloblang.Function, error) {
 		valuePtr, err := args.GetOptionalString("value")
@@ -35,8 +37,13 @@ func init() {
 			return nil, err
 		}
 
+		randomizer, err := getTransformRandomizer(args, value)
+		if err != nil {
+			return nil, err
+		}
+
 		return func() (any, error) {
-			res, err := TransformE164PhoneNumber(value, preserveLength, maxLength)
+			res, err := TransformE164PhoneNumber(randomizer, value, preserveLength, maxLength)
 			if err != nil {
 				return nil, fmt.Errorf("unable to run transform_e164_phone_number: %w", err)
 			}
@@ -50,7 +57,7 @@ func init() {
 }
 
 // Generates a random phone number and returns it as a string
-func TransformE164PhoneNumber(phone string, preserveLength bool, maxLength int64) (*string, error) {
+func TransformE164PhoneNumber(randomizer rng.Rand, phone string, preserveLength bool, maxLength int64) (*string, error) {
 	var returnValue string
 
 	if phone == "" {
@@ -58,7 +65,7 @@ func TransformE164PhoneNumber(phone string, preserveLength bool, maxLength int64
 	}
 
 	if preserveLength {
-		res, err := GenerateE164FormatPhoneNumberPreserveLength(phone)
+		res, err := GenerateE164FormatPhoneNumberPreserveLength(randomizer, phone)
 		if err != nil {
 			return nil, err
 		}
@@ -68,7 +75,7 @@ func TransformE164PhoneNumber(phone string, preserveLength bool, maxLength int64
 		min := int64(9)
 		max := int64(15)
 
-		res, err := GenerateInternationalPhoneNumber(min, max)
+		res, err := generateInternationalPhoneNumber(randomizer, min, max)
 		if err != nil {
 			return nil, err
 		}
@@ -79,12 +86,12 @@ func TransformE164PhoneNumber(phone string, preserveLength bool, maxLength int64
 }
 
 // generates a random E164 phone number and returns it as a string
-func GenerateE164FormatPhoneNumberPreserveLength(number string) (string, error) {
+func GenerateE164FormatPhoneNumberPreserveLength(randomizer rng.Rand, number string) (string, error) {
 	val := strings.Split(number, "+")
 
 	length := int64(len(val[1]))
 
-	vals, err := transformer_utils.GenerateRandomInt64FixedLength(length)
+	vals, err := transformer_utils.GenerateRandomInt64FixedLengthWithRandomizer(randomizer, length)
 	if err != nil {
 		return "", nil
 	}
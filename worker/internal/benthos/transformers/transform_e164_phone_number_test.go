@@ -2,6 +2,7 @@ package transformers
 
 import (
 	"fmt"
+	"math/rand"
 	"testing"
 
 	"github.com/benthosdev/benthos/v4/public/bloblang"
@@ -11,7 +12,7 @@ import (
 var testE164Phone = "+13782983927"
 
 func Test_TransformE164NumberPreserveLengthTrue(t *testing.T) {
-	res, err := TransformE164PhoneNumber(testE164Phone, true, maxCharacterLimit)
+	res, err := TransformE164PhoneNumber(rand.New(rand.NewSource(1)), testE164Phone, true, maxCharacterLimit)
 
 	assert.NoError(t, err)
 	assert.Equal(t, ValidateE164(*res), ValidateE164(testE164Phone), "The expected value should be a valid e164 number.")
@@ -19,7 +20,7 @@ func Test_TransformE164NumberPreserveLengthTrue(t *testing.T) {
 }
 
 func Test_TransformE164NumberPreserveLengthFalse(t *testing.T) {
-	res, err := TransformE164PhoneNumber(testE164Phone, false, maxCharacterLimit)
+	res, err := TransformE164PhoneNumber(rand.New(rand.NewSource(1)), testE164Phone, false, maxCharacterLimit)
 
 	assert.NoError(t, err)
 	assert.GreaterOrEqual(t, len(*res), 9+1, "Should be greater than 10 characters in length. 9 for the number and 1 for the plus sign.")
@@ -27,7 +28,7 @@ func Test_TransformE164NumberPreserveLengthFalse(t *testing.T) {
 }
 
 func Test_GenerateE164FormatPhoneNumberPreserveLength(t *testing.T) {
-	res, err := GenerateE164FormatPhoneNumberPreserveLength(testE164Phone)
+	res, err := GenerateE164FormatPhoneNumberPreserveLength(rand.New(rand.NewSource(1)), testE164Phone)
 
 	assert.NoError(t, err)
 	assert.Equal(t, ValidateE164(res), ValidateE164(testE164Phone), "The expected value should be a valid e164 number.")
@@ -21,7 +21,8 @@ func init() {
 		Param(bloblang.NewAnyParam("excluded_domains").Default([]any{})).
 		Param(bloblang.NewInt64Param("max_length").Default(10000)).
 		Param(bloblang.NewInt64Param("seed").Optional()).
-		Param(bloblang.NewStringParam("email_type").Default(fullNameEmailType.String()))
+		Param(bloblang.NewStringParam("email_type").Default(fullNameEmailType.String())).
+		Param(newDeterministicKeyParam())
 
 	err := bloblang.RegisterFunctionV2("transform_email", spec, func(args *bloblang.ParsedParams) (bloblang.Function, error) {
 		emailPtr, err := args.GetOptionalString("email")
@@ -69,8 +70,14 @@ func init() {
 		if err != nil {
 			return nil, err
 		}
+		deterministicSeed, err := getDeterministicSeed(args, email)
+		if err != nil {
+			return nil, err
+		}
 		var seed int64
-		if seedArg != nil {
+		if deterministicSeed != nil {
+			seed = *deterministicSeed
+		} else if seedArg != nil {
 			seed = *seedArg
 		} else {
 			// we want a bit more randomness here with generate_email so using something that isn't time based
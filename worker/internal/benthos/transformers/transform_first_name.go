@@ -5,6 +5,7 @@ import (
 	"time"
 
 	"github.com/benthosdev/benthos/v4/public/bloblang"
+	transformers_dataset "github.com/nucleuscloud/neosync/worker/internal/benthos/transformers/data-sets"
 	transformer_utils "github.com/nucleuscloud/neosync/worker/internal/benthos/transformers/utils"
 	"github.com/nucleuscloud/neosync/worker/internal/rng"
 )
@@ -14,7 +15,10 @@ func init() {
 		Param(bloblang.NewInt64Param("max_length").Default(10000)).
 		Param(bloblang.NewAnyParam("value").Optional()).
 		Param(bloblang.NewBoolParam("preserve_length").Default(false)).
-		Param(bloblang.NewInt64Param("seed").Default(time.Now().UnixNano()))
+		Param(bloblang.NewInt64Param("seed").Default(time.Now().UnixNano())).
+		Param(newDeterministicKeyParam()).
+		Param(newLocaleParam()).
+		Param(newRowLocaleParam())
 
 	err := bloblang.RegisterFunctionV2("transform_first_name", spec, func(args *bloblang.ParsedParams) (bloblang.Function, error) {
 		valuePtr, err := args.GetOptionalString("value")
@@ -41,10 +45,22 @@ func init() {
 		if err != nil {
 			return nil, err
 		}
+		deterministicSeed, err := getDeterministicSeed(args, value)
+		if err != nil {
+			return nil, err
+		}
+		if deterministicSeed != nil {
+			seed = *deterministicSeed
+		}
 		randomizer := rng.New(seed)
 
+		locale, err := getLocale(args)
+		if err != nil {
+			return nil, err
+		}
+
 		return func() (any, error) {
-			res, err := transformFirstName(randomizer, value, preserveLength, maxLength)
+			res, err := transformLocaleFirstName(randomizer, locale, value, preserveLength, maxLength)
 			if err != nil {
 				return nil, fmt.Errorf("unable to run transform_first_name: %w", err)
 			}
@@ -59,6 +75,10 @@ func init() {
 
 // Generates a random first name which can be of either random length or as long as the input name
 func transformFirstName(randomizer rng.Rand, name string, preserveLength bool, maxLength int64) (*string, error) {
+	return transformLocaleFirstName(randomizer, transformers_dataset.DefaultLocale, name, preserveLength, maxLength)
+}
+
+func transformLocaleFirstName(randomizer rng.Rand, locale *transformers_dataset.Locale, name string, preserveLength bool, maxLength int64) (*string, error) {
 	if name == "" {
 		return nil, nil
 	}
@@ -70,13 +90,13 @@ func transformFirstName(randomizer rng.Rand, name string, preserveLength bool, m
 	// as with this algorithm, it will only ever use values from the underlying map that are that specific size
 	if preserveLength {
 		maxValue = int64(len(name))
-		output, err := generateRandomFirstName(randomizer, &maxValue, maxValue)
+		output, err := generateRandomLocaleFirstName(randomizer, locale, &maxValue, maxValue)
 		if err == nil {
 			return &output, nil
 		}
 	}
 
-	output, err := generateRandomFirstName(randomizer, nil, maxValue)
+	output, err := generateRandomLocaleFirstName(randomizer, locale, nil, maxValue)
 	if err != nil {
 		return nil, err
 	}
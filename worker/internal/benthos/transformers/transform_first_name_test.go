@@ -8,7 +8,9 @@ import (
 	"time"
 
 	"github.com/benthosdev/benthos/v4/public/bloblang"
+	transformers_dataset "github.com/nucleuscloud/neosync/worker/internal/benthos/transformers/data-sets"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 var name = "evis"
@@ -105,3 +107,17 @@ func Test_TransformFirstNameTransformerWithEmptyValue(t *testing.T) {
 	_, err = ex.Query(nil)
 	assert.NoError(t, err)
 }
+
+func Test_TransformFirstNameTransformer_RowLocale(t *testing.T) {
+	mapping := fmt.Sprintf(`root = transform_first_name(value:this.name,preserve_length:false,max_length:%d,row_locale:this.country)`, maxCharacterLimit)
+	ex, err := bloblang.Parse(mapping)
+	require.NoError(t, err, "failed to parse the first name transformer")
+
+	res, err := ex.Query(map[string]any{"name": "John", "country": "de-DE"})
+	require.NoError(t, err)
+
+	resStr, ok := res.(*string)
+	require.True(t, ok)
+	require.NotNil(t, resStr)
+	assert.Contains(t, transformers_dataset.GermanFirstNames, *resStr)
+}
@@ -2,16 +2,19 @@ package transformers
 
 import (
 	"fmt"
+	"strconv"
 
 	"github.com/benthosdev/benthos/v4/public/bloblang"
 	transformer_utils "github.com/nucleuscloud/neosync/worker/internal/benthos/transformers/utils"
+	"github.com/nucleuscloud/neosync/worker/internal/rng"
 )
 
 func init() {
 	spec := bloblang.NewPluginSpec().
 		Param(bloblang.NewAnyParam("value").Optional()).
 		Param(bloblang.NewFloat64Param("randomization_range_min")).
-		Param(bloblang.NewFloat64Param("randomization_range_max"))
+		Param(bloblang.NewFloat64Param("randomization_range_max")).
+		Param(newDeterministicKeyParam())
 
 	err := bloblang.RegisterFunctionV2("transform_float64", spec, func(args *bloblang.ParsedParams) (bloblang.Function, error) {
 		valuePtr, err := args.GetOptionalFloat64("value")
@@ -32,8 +35,13 @@ func init() {
 		if err != nil {
 			return nil, err
 		}
+
+		randomizer, err := getTransformRandomizer(args, strconv.FormatFloat(value, 'f', -1, 64))
+		if err != nil {
+			return nil, err
+		}
 		return func() (any, error) {
-			res, err := TransformFloat(value, rMin, rMax)
+			res, err := TransformFloat(randomizer, value, rMin, rMax)
 			if err != nil {
 				return nil, fmt.Errorf("unable to run transform_float64: %w", err)
 			}
@@ -46,7 +54,7 @@ func init() {
 	}
 }
 
-func TransformFloat(value, rMin, rMax float64) (*float64, error) {
+func TransformFloat(randomizer rng.Rand, value, rMin, rMax float64) (*float64, error) {
 	if value == 0 {
 		return nil, nil
 	}
@@ -61,7 +69,7 @@ func TransformFloat(value, rMin, rMax float64) (*float64, error) {
 	minRange := value - rMin
 	maxRange := value + rMax
 
-	val, err := transformer_utils.GenerateRandomFloat64WithInclusiveBounds(minRange, maxRange)
+	val, err := transformer_utils.GenerateRandomFloat64WithInclusiveBoundsWithRandomizer(randomizer, minRange, maxRange)
 	if err != nil {
 		return nil, fmt.Errorf("unable to generate a random float64 with inclusive bounds with length [%f:%f]: %w", minRange, maxRange, err)
 	}
@@ -2,6 +2,7 @@ package transformers
 
 import (
 	"fmt"
+	"math/rand"
 	"testing"
 
 	"github.com/benthosdev/benthos/v4/public/bloblang"
@@ -23,7 +24,7 @@ func Test_TransformFloat64InRange(t *testing.T) {
 	rMin := float64(22.12)
 	rMax := float64(29.9823)
 
-	res, err := TransformFloat(val, rMin, rMax)
+	res, err := TransformFloat(rand.New(rand.NewSource(1)), val, rMin, rMax)
 	assert.NoError(t, err)
 
 	assert.GreaterOrEqual(t, *res, val-rMin, "The result should be greater than the min")
@@ -0,0 +1,72 @@
+package transformers
+
+import (
+	"fmt"
+
+	"github.com/benthosdev/benthos/v4/public/bloblang"
+	"github.com/nucleuscloud/neosync/backend/pkg/fpe"
+)
+
+func init() {
+	spec := bloblang.NewPluginSpec().
+		Param(bloblang.NewAnyParam("value").Optional()).
+		Param(bloblang.NewStringParam("algorithm").Default(string(fpe.AlgorithmFF1))).
+		Param(bloblang.NewStringParam("tweak").Default("")).
+		Param(bloblang.NewStringParam("key"))
+
+	err := bloblang.RegisterFunctionV2("transform_format_preserving_encrypt", spec, func(args *bloblang.ParsedParams) (bloblang.Function, error) {
+		valuePtr, err := args.GetOptionalString("value")
+		if err != nil {
+			return nil, err
+		}
+
+		algorithmStr, err := args.GetString("algorithm")
+		if err != nil {
+			return nil, err
+		}
+		algorithm, err := fpe.ParseAlgorithm(algorithmStr)
+		if err != nil {
+			return nil, err
+		}
+
+		tweak, err := args.GetString("tweak")
+		if err != nil {
+			return nil, err
+		}
+
+		accountKey, err := args.GetString("key")
+		if err != nil {
+			return nil, err
+		}
+		key, err := fpe.DeriveKey(accountKey)
+		if err != nil {
+			return nil, err
+		}
+
+		return func() (any, error) {
+			res, err := TransformFormatPreservingEncrypt(key, algorithm, tweak, valuePtr)
+			if err != nil {
+				return nil, fmt.Errorf("unable to run transform_format_preserving_encrypt: %w", err)
+			}
+			return res, nil
+		}, nil
+	})
+
+	if err != nil {
+		panic(err)
+	}
+}
+
+// Encrypts the value with format preserving encryption so that the length and the character class of each character is preserved.
+// The result can be decrypted with the same key, algorithm and tweak.
+func TransformFormatPreservingEncrypt(key []byte, algorithm fpe.Algorithm, tweak string, value *string) (*string, error) {
+	if value == nil || *value == "" {
+		return value, nil
+	}
+
+	res, err := fpe.Encrypt(key, algorithm, tweak, *value)
+	if err != nil {
+		return nil, err
+	}
+	return &res, nil
+}
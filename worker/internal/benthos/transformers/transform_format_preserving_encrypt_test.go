@@ -0,0 +1,82 @@
+package transformers
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/benthosdev/benthos/v4/public/bloblang"
+	"github.com/nucleuscloud/neosync/backend/pkg/fpe"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const fpeTestKey = "0123456789abcdef0123456789abcdef"
+
+func Test_TransformFormatPreservingEncrypt(t *testing.T) {
+	key, err := fpe.DeriveKey(fpeTestKey)
+	require.NoError(t, err)
+
+	value := "4111-1111-1111-1111"
+	res, err := TransformFormatPreservingEncrypt(key, fpe.AlgorithmFF1, "", &value)
+	assert.NoError(t, err)
+	require.NotNil(t, res)
+	assert.Len(t, *res, len(value))
+	assert.NotEqual(t, value, *res)
+	assert.Regexp(t, `^\d{4}-\d{4}-\d{4}-\d{4}$`, *res)
+
+	decrypted, err := fpe.Decrypt(key, fpe.AlgorithmFF1, "", *res)
+	assert.NoError(t, err)
+	assert.Equal(t, value, decrypted)
+}
+
+func Test_TransformFormatPreservingEncrypt_Group_Sizes(t *testing.T) {
+	key, err := fpe.DeriveKey(fpeTestKey)
+	require.NoError(t, err)
+
+	for _, value := range []string{"X1234567", "a1", strings.Repeat("abcdefghij", 5)} {
+		value := value
+		t.Run(value, func(t *testing.T) {
+			res, err := TransformFormatPreservingEncrypt(key, fpe.AlgorithmFF31, "", &value)
+			require.NoError(t, err)
+			require.NotNil(t, res)
+			assert.Len(t, *res, len(value))
+		})
+	}
+}
+
+func Test_TransformFormatPreservingEncrypt_Nil(t *testing.T) {
+	res, err := TransformFormatPreservingEncrypt([]byte{}, fpe.AlgorithmFF1, "", nil)
+	assert.NoError(t, err)
+	assert.Nil(t, res)
+}
+
+func Test_TransformFormatPreservingEncryptTransformer(t *testing.T) {
+	mapping := fmt.Sprintf(`root = transform_format_preserving_encrypt(value:this, algorithm:"ff3_1", tweak:"users.email", key:%q)`, fpeTestKey)
+	ex, err := bloblang.Parse(mapping)
+	require.NoError(t, err, "failed to parse the format preserving encrypt transformer")
+
+	value := "Jane.Doe42@example.com"
+	res, err := ex.Query(value)
+	assert.NoError(t, err)
+
+	encrypted, ok := res.(*string)
+	require.True(t, ok)
+	assert.Len(t, *encrypted, len(value))
+	assert.NotEqual(t, value, *encrypted)
+
+	key, err := fpe.DeriveKey(fpeTestKey)
+	require.NoError(t, err)
+	decrypted, err := fpe.Decrypt(key, fpe.AlgorithmFF31, "users.email", *encrypted)
+	assert.NoError(t, err)
+	assert.Equal(t, value, decrypted)
+}
+
+func Test_TransformFormatPreservingEncryptTransformer_InvalidAlgorithm(t *testing.T) {
+	mapping := fmt.Sprintf(`root = transform_format_preserving_encrypt(value:this, algorithm:"aes", key:%q)`, fpeTestKey)
+	ex, err := bloblang.Parse(mapping)
+	require.NoError(t, err)
+
+	_, err = ex.Query("1234567890")
+	assert.Error(t, err)
+}
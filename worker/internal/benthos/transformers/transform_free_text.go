@@ -0,0 +1,472 @@
+package transformers
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+	"sync"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/benthosdev/benthos/v4/public/bloblang"
+	"github.com/nucleuscloud/neosync/backend/pkg/pii"
+	transformers_dataset "github.com/nucleuscloud/neosync/worker/internal/benthos/transformers/data-sets"
+	transformer_utils "github.com/nucleuscloud/neosync/worker/internal/benthos/transformers/utils"
+	"github.com/nucleuscloud/neosync/worker/internal/rng"
+)
+
+type freeTextEntityType string
+
+const (
+	freeTextEmail       freeTextEntityType = "email"
+	freeTextPhoneNumber freeTextEntityType = "phone_number"
+	freeTextCardNumber  freeTextEntityType = "card_number"
+	freeTextName        freeTextEntityType = "name"
+)
+
+// Entity types are detected in this order and a later entity type can not match text that an earlier one already matched.
+// Card numbers are detected before phone numbers since a grouped card number also looks like a phone number.
+var freeTextEntityTypes = []freeTextEntityType{freeTextEmail, freeTextCardNumber, freeTextPhoneNumber, freeTextName}
+
+const (
+	// the lengths of a phone number that is written without any separators, such as 4155550132
+	minFreeTextBarePhoneLength = 10
+	maxFreeTextBarePhoneLength = 15
+)
+
+type freeTextStrategy string
+
+const (
+	freeTextMask freeTextStrategy = "mask"
+	freeTextFake freeTextStrategy = "fake"
+)
+
+var (
+	freeTextEmailRegex = regexp.MustCompile(`[A-Za-z0-9._%+\-]+@[A-Za-z0-9.\-]+\.[A-Za-z]{2,}`)
+	freeTextCardRegex  = regexp.MustCompile(`\d(?:[ \-]?\d){12,18}`)
+	freeTextPhoneRegex = regexp.MustCompile(`\+?\(?\d{1,4}\)?(?:[ .\-]?\(?\d{1,4}\)?){1,5}`)
+	freeTextDateRegex  = regexp.MustCompile(`^(?:\d{4}[\-./]\d{1,2}[\-./]\d{1,2}|\d{1,2}[\-./]\d{1,2}[\-./]\d{2,4})$`)
+	freeTextWordRegex  = regexp.MustCompile(`\p{Lu}\p{Ll}+(?:['\-]\p{Lu}?\p{Ll}+)?`)
+
+	// titles that are followed by a last name
+	freeTextNameTitles = []string{"Mr", "Mrs", "Ms", "Miss", "Mx", "Dr", "Prof"}
+
+	freeTextNamesOnce  sync.Once
+	freeTextFirstNames map[string]struct{}
+	freeTextLastNames  map[string]struct{}
+)
+
+func init() {
+	spec := bloblang.NewPluginSpec().
+		Param(bloblang.NewAnyParam("value").Optional()).
+		Param(bloblang.NewAnyParam("entity_types").Default([]any{})).
+		Param(bloblang.NewStringParam("strategy").Default(string(freeTextMask))).
+		Param(bloblang.NewInt64Param("max_length").Default(10000)).
+		Param(newDeterministicKeyParam())
+
+	err := bloblang.RegisterFunctionV2("transform_free_text", spec, func(args *bloblang.ParsedParams) (bloblang.Function, error) {
+		valuePtr, err := args.GetOptionalString("value")
+		if err != nil {
+			return nil, err
+		}
+
+		entityTypesArg, err := args.Get("entity_types")
+		if err != nil {
+			return nil, err
+		}
+		entityTypes, err := getFreeTextEntityTypes(entityTypesArg)
+		if err != nil {
+			return nil, err
+		}
+
+		strategy, err := args.GetString("strategy")
+		if err != nil {
+			return nil, err
+		}
+		if strategy != string(freeTextMask) && strategy != string(freeTextFake) {
+			return nil, fmt.Errorf("unsupported strategy %q, must be one of %q or %q", strategy, freeTextMask, freeTextFake)
+		}
+
+		maxLength, err := args.GetInt64("max_length")
+		if err != nil {
+			return nil, err
+		}
+
+		key, err := args.GetOptionalString(deterministicKeyParam)
+		if err != nil {
+			return nil, err
+		}
+
+		return func() (any, error) {
+			res, err := TransformFreeText(valuePtr, &FreeTextOpts{
+				EntityTypes:      entityTypes,
+				Strategy:         freeTextStrategy(strategy),
+				MaxLength:        maxLength,
+				DeterministicKey: key,
+			})
+			if err != nil {
+				return nil, fmt.Errorf("unable to run transform_free_text: %w", err)
+			}
+			return res, nil
+		}, nil
+	})
+
+	if err != nil {
+		panic(err)
+	}
+}
+
+type FreeTextOpts struct {
+	// the entity types to detect, every entity type is detected if empty
+	EntityTypes []freeTextEntityType
+	Strategy    freeTextStrategy
+	MaxLength   int64
+	// when provided, each entity is replaced with a fake value that is seeded from a keyed hash of the entity
+	// so that it is always replaced with the same fake value
+	DeterministicKey *string
+}
+
+type freeTextEntity struct {
+	entityType freeTextEntityType
+	start      int
+	end        int
+}
+
+/*
+Detects emails, phone numbers, card numbers and names in free text and replaces each of them with a masked token, such as [EMAIL], or with a fake value of the same type.
+Within a value, the same entity is always replaced with the same fake value. Across values, this is only the case when a deterministic key is provided.
+*/
+func TransformFreeText(value *string, opts *FreeTextOpts) (*string, error) {
+	if value == nil || *value == "" {
+		return value, nil
+	}
+	text := *value
+
+	entityTypes := opts.EntityTypes
+	if len(entityTypes) == 0 {
+		entityTypes = freeTextEntityTypes
+	}
+
+	entities := []*freeTextEntity{}
+	for _, entityType := range freeTextEntityTypes {
+		if !slices.Contains(entityTypes, entityType) {
+			continue
+		}
+		for _, entity := range findFreeTextEntities(text, entityType) {
+			if !overlapsFreeTextEntity(entities, entity) {
+				entities = append(entities, entity)
+			}
+		}
+	}
+	if len(entities) == 0 {
+		return value, nil
+	}
+	slices.SortFunc(entities, func(a, b *freeTextEntity) int {
+		return a.start - b.start
+	})
+
+	replacer, err := newFreeTextReplacer(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	var sb strings.Builder
+	prev := 0
+	for _, entity := range entities {
+		sb.WriteString(text[prev:entity.start])
+		replacement, err := replacer.replace(entity.entityType, text[entity.start:entity.end])
+		if err != nil {
+			return nil, err
+		}
+		sb.WriteString(replacement)
+		prev = entity.end
+	}
+	sb.WriteString(text[prev:])
+
+	output := transformer_utils.TrimStringIfExceeds(sb.String(), opts.MaxLength)
+	return &output, nil
+}
+
+func getFreeTextEntityTypes(arg any) ([]freeTextEntityType, error) {
+	values, err := fromAnyToStringSlice(arg)
+	if err != nil {
+		return nil, err
+	}
+	entityTypes := make([]freeTextEntityType, 0, len(values))
+	for _, value := range values {
+		entityType := freeTextEntityType(value)
+		if !slices.Contains(freeTextEntityTypes, entityType) {
+			return nil, fmt.Errorf("unsupported entity type %q", value)
+		}
+		entityTypes = append(entityTypes, entityType)
+	}
+	return entityTypes, nil
+}
+
+func findFreeTextEntities(text string, entityType freeTextEntityType) []*freeTextEntity {
+	switch entityType {
+	case freeTextEmail:
+		return findFreeTextMatches(text, entityType, freeTextEmailRegex, pii.IsEmail)
+	case freeTextCardNumber:
+		return findFreeTextMatches(text, entityType, freeTextCardRegex, pii.IsCardNumber)
+	case freeTextPhoneNumber:
+		return findFreeTextMatches(text, entityType, freeTextPhoneRegex, isFreeTextPhoneNumber)
+	case freeTextName:
+		return findFreeTextNames(text)
+	default:
+		return nil
+	}
+}
+
+// Finds the regex matches that are not part of a longer word or number and that pass the validation
+func findFreeTextMatches(text string, entityType freeTextEntityType, regex *regexp.Regexp, isValid func(string) bool) []*freeTextEntity {
+	entities := []*freeTextEntity{}
+	for _, loc := range regex.FindAllStringIndex(text, -1) {
+		start, end := trimFreeTextMatch(text, loc[0], loc[1])
+		if !isFreeTextBoundary(text, start, end) {
+			continue
+		}
+		if isValid(text[start:end]) {
+			entities = append(entities, &freeTextEntity{entityType: entityType, start: start, end: end})
+		}
+	}
+	return entities
+}
+
+// The phone regex can match trailing separators and parentheses that belong to the surrounding text, such as in (call 555-0100)
+func trimFreeTextMatch(text string, start, end int) (trimmedStart, trimmedEnd int) {
+	for end > start {
+		match := text[start:end]
+		switch {
+		case strings.ContainsRune(" .-", rune(match[len(match)-1])):
+			end--
+		case match[len(match)-1] == ')' && strings.Count(match, ")") > strings.Count(match, "("):
+			end--
+		case match[0] == '(' && strings.Count(match, "(") > strings.Count(match, ")"):
+			start++
+		default:
+			return start, end
+		}
+	}
+	return start, end
+}
+
+// Phone numbers must contain a separator or a country code, or be a run of 10 to 15 digits,
+// so that shorter plain numbers, such as order ids, are not mistaken for phone numbers
+func isFreeTextPhoneNumber(value string) bool {
+	if strings.Count(value, "(") != strings.Count(value, ")") {
+		return false
+	}
+	if freeTextDateRegex.MatchString(value) {
+		return false
+	}
+	if !pii.IsPhoneNumber(value) {
+		return false
+	}
+	if strings.ContainsAny(value, "+ .-()") {
+		return true
+	}
+	return len(value) >= minFreeTextBarePhoneLength && len(value) <= maxFreeTextBarePhoneLength && !pii.IsCardNumber(value)
+}
+
+// Names are only detected as a first name followed by a last name or as a title followed by a last name,
+// since a single capitalized word that happens to be in the name datasets is most often not a name.
+// Other forms, such as a last name followed by a first name or a name with a middle initial, are not detected.
+func findFreeTextNames(text string) []*freeTextEntity {
+	loadFreeTextNames()
+
+	words := freeTextWordRegex.FindAllStringIndex(text, -1)
+	entities := []*freeTextEntity{}
+	for idx := 0; idx+1 < len(words); idx++ {
+		word, next := words[idx], words[idx+1]
+		if !isFreeTextBoundary(text, word[0], word[1]) || !isFreeTextBoundary(text, next[0], next[1]) {
+			continue
+		}
+		first, last := text[word[0]:word[1]], text[next[0]:next[1]]
+		if _, ok := freeTextLastNames[last]; !ok {
+			continue
+		}
+
+		between := text[word[1]:next[0]]
+		isTitle := slices.Contains(freeTextNameTitles, first) && (between == " " || between == ". ")
+		_, isFirstName := freeTextFirstNames[first]
+		if !isTitle && !(isFirstName && between == " ") {
+			continue
+		}
+
+		start := word[0]
+		if isTitle {
+			// the title is kept so only the last name is replaced
+			start = next[0]
+		}
+		entities = append(entities, &freeTextEntity{entityType: freeTextName, start: start, end: next[1]})
+		idx++
+	}
+	return entities
+}
+
+func loadFreeTextNames() {
+	freeTextNamesOnce.Do(func() {
+		freeTextFirstNames = transformer_utils.ToSet(transformers_dataset.FirstNames)
+		freeTextLastNames = transformer_utils.ToSet(transformers_dataset.LastNames)
+	})
+}
+
+// Checks that the match is not directly next to a letter or digit
+func isFreeTextBoundary(text string, start, end int) bool {
+	if start > 0 {
+		r, _ := utf8.DecodeLastRuneInString(text[:start])
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			return false
+		}
+	}
+	if end < len(text) {
+		r, _ := utf8.DecodeRuneInString(text[end:])
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			return false
+		}
+	}
+	return true
+}
+
+func overlapsFreeTextEntity(entities []*freeTextEntity, entity *freeTextEntity) bool {
+	for _, existing := range entities {
+		if entity.start < existing.end && existing.start < entity.end {
+			return true
+		}
+	}
+	return false
+}
+
+type freeTextReplacer struct {
+	opts       *FreeTextOpts
+	randomizer rng.Rand
+	// keeps the same entity replaced with the same fake value within a value
+	fakes map[string]string
+}
+
+func newFreeTextReplacer(opts *FreeTextOpts) (*freeTextReplacer, error) {
+	replacer := &freeTextReplacer{opts: opts, fakes: map[string]string{}}
+	if opts.Strategy == freeTextFake && opts.DeterministicKey == nil {
+		seed, err := transformer_utils.GenerateCryptoSeed()
+		if err != nil {
+			return nil, err
+		}
+		replacer.randomizer = rng.New(seed)
+	}
+	return replacer, nil
+}
+
+func (r *freeTextReplacer) replace(entityType freeTextEntityType, value string) (string, error) {
+	if r.opts.Strategy != freeTextFake {
+		return fmt.Sprintf("[%s]", strings.ToUpper(string(entityType))), nil
+	}
+
+	cacheKey := fmt.Sprintf("%s:%s", entityType, normalizeFreeTextEntity(entityType, value))
+	if fake, ok := r.fakes[cacheKey]; ok {
+		return fake, nil
+	}
+
+	randomizer := r.randomizer
+	if r.opts.DeterministicKey != nil {
+		seed, err := transformer_utils.GetDeterministicSeed(*r.opts.DeterministicKey, cacheKey)
+		if err != nil {
+			return "", err
+		}
+		randomizer = rng.New(seed)
+	}
+
+	fake, err := generateFreeTextFake(randomizer, entityType, value)
+	if err != nil {
+		return "", err
+	}
+	r.fakes[cacheKey] = fake
+	return fake, nil
+}
+
+// Normalizes an entity so that different spellings of the same entity are replaced with the same fake value
+func normalizeFreeTextEntity(entityType freeTextEntityType, value string) string {
+	switch entityType {
+	case freeTextPhoneNumber, freeTextCardNumber:
+		return strings.Map(func(r rune) rune {
+			if unicode.IsDigit(r) {
+				return r
+			}
+			return -1
+		}, value)
+	default:
+		return strings.ToLower(value)
+	}
+}
+
+func generateFreeTextFake(randomizer rng.Rand, entityType freeTextEntityType, value string) (string, error) {
+	switch entityType {
+	case freeTextEmail:
+		return generateRandomEmail(randomizer, int64(max(len(value), 40)), fullNameEmailType, []string{})
+	case freeTextPhoneNumber:
+		return replaceFreeTextDigits(randomizer, value), nil
+	case freeTextCardNumber:
+		return generateFreeTextCardNumber(randomizer, value), nil
+	case freeTextName:
+		lastName, err := generateRandomLastName(randomizer, nil, 20)
+		if err != nil {
+			return "", err
+		}
+		// a title is kept in the text so only the last name was matched
+		if !strings.Contains(value, " ") {
+			return lastName, nil
+		}
+		firstName, err := generateRandomFirstName(randomizer, nil, 20)
+		if err != nil {
+			return "", err
+		}
+		return fmt.Sprintf("%s %s", firstName, lastName), nil
+	default:
+		return "", fmt.Errorf("unsupported entity type %q", entityType)
+	}
+}
+
+// Replaces every digit with a random digit while keeping the formatting of the value
+func replaceFreeTextDigits(randomizer rng.Rand, value string) string {
+	first := true
+	return strings.Map(func(r rune) rune {
+		if r < '0' || r > '9' {
+			return r
+		}
+		digit := randomizer.Intn(10)
+		if first {
+			// a leading zero would change how the number is read
+			digit = 1 + randomizer.Intn(9)
+			first = false
+		}
+		return rune('0' + digit)
+	}, value)
+}
+
+// Generates a card number with the same formatting as the value that passes the luhn check
+func generateFreeTextCardNumber(randomizer rng.Rand, value string) string {
+	fake := []byte(replaceFreeTextDigits(randomizer, value))
+
+	lastIdx := -1
+	digits := []byte{}
+	for idx, b := range fake {
+		if b >= '0' && b <= '9' {
+			digits = append(digits, b)
+			lastIdx = idx
+		}
+	}
+	if lastIdx == -1 {
+		return string(fake)
+	}
+
+	// picks the check digit that makes the number pass the luhn check
+	for check := byte('0'); check <= '9'; check++ {
+		digits[len(digits)-1] = check
+		if pii.IsLuhnValid(string(digits)) {
+			fake[lastIdx] = check
+			break
+		}
+	}
+	return string(fake)
+}
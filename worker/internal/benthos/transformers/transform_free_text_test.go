@@ -0,0 +1,133 @@
+package transformers
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/benthosdev/benthos/v4/public/bloblang"
+	"github.com/nucleuscloud/neosync/backend/pkg/pii"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_TransformFreeText_Mask(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{
+			input:    "Customer John Smith (john.smith@example.com) called from +1 415-555-0132 about order 12345.",
+			expected: "Customer [NAME] ([EMAIL]) called from [PHONE_NUMBER] about order 12345.",
+		},
+		{
+			input:    "Card on file is 4111 1111 1111 1111, the old one was 4111 1111 1111 1112.",
+			expected: "Card on file is [CARD_NUMBER], the old one was 4111 1111 1111 1112.",
+		},
+		{
+			input:    "Spoke with Mrs. Johnson on 2024-03-10 (call back at 555-0100).",
+			expected: "Spoke with Mrs. [NAME] on 2024-03-10 (call back at [PHONE_NUMBER]).",
+		},
+		{
+			input:    "Texted 4155550132 and 442071838750 about order 1234567 placed on 20240310.",
+			expected: "Texted [PHONE_NUMBER] and [PHONE_NUMBER] about order 1234567 placed on 20240310.",
+		},
+		{
+			input:    "No personal information in this note.",
+			expected: "No personal information in this note.",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			res, err := TransformFreeText(&tt.input, &FreeTextOpts{Strategy: freeTextMask, MaxLength: 10000})
+			assert.NoError(t, err)
+			require.NotNil(t, res)
+			assert.Equal(t, tt.expected, *res)
+		})
+	}
+}
+
+func Test_TransformFreeText_EntityTypes(t *testing.T) {
+	input := "Email jane.doe@example.com or call 415-555-0132"
+	res, err := TransformFreeText(&input, &FreeTextOpts{
+		EntityTypes: []freeTextEntityType{freeTextEmail},
+		Strategy:    freeTextMask,
+		MaxLength:   10000,
+	})
+	assert.NoError(t, err)
+	require.NotNil(t, res)
+	assert.Equal(t, "Email [EMAIL] or call 415-555-0132", *res)
+}
+
+func Test_isFreeTextPhoneNumber(t *testing.T) {
+	assert.True(t, isFreeTextPhoneNumber("4155550132"))
+	assert.True(t, isFreeTextPhoneNumber("415-555-0132"))
+	assert.False(t, isFreeTextPhoneNumber("5550132"), "short plain numbers are not phone numbers")
+	assert.False(t, isFreeTextPhoneNumber("378282246310005"), "card numbers are not phone numbers")
+	assert.False(t, isFreeTextPhoneNumber("2024-03-10"), "dates are not phone numbers")
+}
+
+func Test_TransformFreeText_Fake(t *testing.T) {
+	input := "John Smith paid with 4111-1111-1111-1111 and wrote to john.smith@example.com. Later John Smith called 415-555-0132."
+	key := "key"
+	opts := &FreeTextOpts{Strategy: freeTextFake, MaxLength: 10000, DeterministicKey: &key}
+
+	res, err := TransformFreeText(&input, opts)
+	assert.NoError(t, err)
+	require.NotNil(t, res)
+
+	output := *res
+	assert.NotContains(t, output, "John Smith")
+	assert.NotContains(t, output, "4111-1111-1111-1111")
+	assert.NotContains(t, output, "john.smith@example.com")
+	assert.NotContains(t, output, "415-555-0132")
+
+	cards := freeTextCardRegex.FindAllString(output, -1)
+	require.Len(t, cards, 1)
+	assert.Regexp(t, `^\d{4}-\d{4}-\d{4}-\d{4}$`, cards[0])
+	assert.True(t, pii.IsLuhnValid(strings.ReplaceAll(cards[0], "-", "")), "the fake card number should pass the luhn check")
+	assert.Len(t, freeTextEmailRegex.FindAllString(output, -1), 1)
+	assert.Regexp(t, `called \d{3}-\d{3}-\d{4}\.$`, output)
+
+	names := findFreeTextNames(output)
+	require.Len(t, names, 2)
+	assert.Equal(t, output[names[0].start:names[0].end], output[names[1].start:names[1].end], "the same name should be replaced with the same fake name")
+
+	again, err := TransformFreeText(&input, opts)
+	assert.NoError(t, err)
+	assert.Equal(t, output, *again, "the same key should produce the same output")
+}
+
+func Test_TransformFreeText_Empty(t *testing.T) {
+	res, err := TransformFreeText(nil, &FreeTextOpts{Strategy: freeTextMask})
+	assert.NoError(t, err)
+	assert.Nil(t, res)
+
+	empty := ""
+	res, err = TransformFreeText(&empty, &FreeTextOpts{Strategy: freeTextMask})
+	assert.NoError(t, err)
+	assert.Equal(t, "", *res)
+}
+
+func Test_TransformFreeTextTransformer(t *testing.T) {
+	input := "Reach Jane Doe at jane.doe@example.com"
+	mapping := fmt.Sprintf(`root = transform_free_text(value:%q,entity_types:["email","name"],strategy:"mask",max_length:100)`, input)
+	ex, err := bloblang.Parse(mapping)
+	require.NoError(t, err, "failed to parse the free text transformer")
+
+	res, err := ex.Query(nil)
+	assert.NoError(t, err)
+
+	resStr, ok := res.(*string)
+	require.True(t, ok)
+	assert.Equal(t, "Reach [NAME] at [EMAIL]", *resStr)
+}
+
+func Test_TransformFreeTextTransformer_InvalidArgs(t *testing.T) {
+	_, err := bloblang.Parse(`root = transform_free_text(value:"text",entity_types:["ssn"])`)
+	assert.Error(t, err)
+
+	_, err = bloblang.Parse(`root = transform_free_text(value:"text",strategy:"hash")`)
+	assert.Error(t, err)
+}
@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"github.com/benthosdev/benthos/v4/public/bloblang"
+	transformers_dataset "github.com/nucleuscloud/neosync/worker/internal/benthos/transformers/data-sets"
 	transformer_utils "github.com/nucleuscloud/neosync/worker/internal/benthos/transformers/utils"
 	"github.com/nucleuscloud/neosync/worker/internal/rng"
 )
@@ -15,7 +16,10 @@ func init() {
 		Param(bloblang.NewInt64Param("max_length").Default(10000)).
 		Param(bloblang.NewAnyParam("value").Optional()).
 		Param(bloblang.NewBoolParam("preserve_length").Default(false)).
-		Param(bloblang.NewInt64Param("seed").Default(time.Now().UnixNano()))
+		Param(bloblang.NewInt64Param("seed").Default(time.Now().UnixNano())).
+		Param(newDeterministicKeyParam()).
+		Param(newLocaleParam()).
+		Param(newRowLocaleParam())
 
 	err := bloblang.RegisterFunctionV2("transform_full_name", spec, func(args *bloblang.ParsedParams) (bloblang.Function, error) {
 		valuePtr, err := args.GetOptionalString("value")
@@ -42,10 +46,22 @@ func init() {
 		if err != nil {
 			return nil, err
 		}
+		deterministicSeed, err := getDeterministicSeed(args, value)
+		if err != nil {
+			return nil, err
+		}
+		if deterministicSeed != nil {
+			seed = *deterministicSeed
+		}
 		randomizer := rng.New(seed)
 
+		locale, err := getLocale(args)
+		if err != nil {
+			return nil, err
+		}
+
 		return func() (any, error) {
-			res, err := transformFullName(randomizer, value, preserveLength, maxLength)
+			res, err := transformLocaleFullName(randomizer, locale, value, preserveLength, maxLength)
 			if err != nil {
 				return nil, fmt.Errorf("unable to run transform_full_name: %w", err)
 			}
@@ -59,6 +75,10 @@ func init() {
 }
 
 func transformFullName(randomizer rng.Rand, name string, preserveLength bool, maxLength int64) (*string, error) {
+	return transformLocaleFullName(randomizer, transformers_dataset.DefaultLocale, name, preserveLength, maxLength)
+}
+
+func transformLocaleFullName(randomizer rng.Rand, locale *transformers_dataset.Locale, name string, preserveLength bool, maxLength int64) (*string, error) {
 	if name == "" {
 		return nil, nil
 	}
@@ -68,17 +88,17 @@ func transformFullName(randomizer rng.Rand, name string, preserveLength bool, ma
 	if preserveLength {
 		firstname, lastname := splitEvenly(name)
 		minFirst := int64(len(firstname))
-		newfirstname, _ := generateRandomFirstName(randomizer, &minFirst, minFirst)
+		newfirstname, _ := generateRandomLocaleFirstName(randomizer, locale, &minFirst, minFirst)
 		if newfirstname == "" {
-			newfirstname, _ = generateRandomFirstName(randomizer, nil, minFirst)
+			newfirstname, _ = generateRandomLocaleFirstName(randomizer, locale, nil, minFirst)
 			if int64(len(newfirstname)) != minFirst {
 				newfirstname += transformer_utils.GetRandomCharacterString(randomizer, minFirst-int64(len(newfirstname)))
 			}
 		}
 		minLast := int64(len(lastname))
-		newlastname, _ := generateRandomLastName(randomizer, &minLast, minLast)
+		newlastname, _ := generateRandomLocaleLastName(randomizer, locale, &minLast, minLast)
 		if newlastname == "" {
-			newfirstname, _ = generateRandomLastName(randomizer, nil, minLast)
+			newfirstname, _ = generateRandomLocaleLastName(randomizer, locale, nil, minLast)
 			if int64(len(newlastname)) != minLast {
 				newlastname += transformer_utils.GetRandomCharacterString(randomizer, minFirst-int64(len(newlastname)))
 			}
@@ -89,7 +109,7 @@ func transformFullName(randomizer rng.Rand, name string, preserveLength bool, ma
 		}
 	}
 
-	output, err := generateRandomFullName(randomizer, maxValue)
+	output, err := generateRandomLocaleFullName(randomizer, locale, maxValue)
 	if err != nil {
 		return nil, err
 	}
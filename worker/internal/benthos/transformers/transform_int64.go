@@ -2,16 +2,19 @@ package transformers
 
 import (
 	"fmt"
+	"strconv"
 
 	"github.com/benthosdev/benthos/v4/public/bloblang"
 	transformer_utils "github.com/nucleuscloud/neosync/worker/internal/benthos/transformers/utils"
+	"github.com/nucleuscloud/neosync/worker/internal/rng"
 )
 
 func init() {
 	spec := bloblang.NewPluginSpec().
 		Param(bloblang.NewAnyParam("value").Optional()).
 		Param(bloblang.NewInt64Param("randomization_range_min")).
-		Param(bloblang.NewInt64Param("randomization_range_max"))
+		Param(bloblang.NewInt64Param("randomization_range_max")).
+		Param(newDeterministicKeyParam())
 
 	err := bloblang.RegisterFunctionV2("transform_int64", spec, func(args *bloblang.ParsedParams) (bloblang.Function, error) {
 		valuePtr, err := args.GetOptionalInt64("value")
@@ -33,8 +36,13 @@ func init() {
 		if err != nil {
 			return nil, err
 		}
+
+		randomizer, err := getTransformRandomizer(args, strconv.FormatInt(value, 10))
+		if err != nil {
+			return nil, err
+		}
 		return func() (any, error) {
-			res, err := TransformInt(value, rMin, rMax)
+			res, err := TransformInt(randomizer, value, rMin, rMax)
 			if err != nil {
 				return nil, fmt.Errorf("unable to run transform_int64: %w", err)
 			}
@@ -47,7 +55,7 @@ func init() {
 	}
 }
 
-func TransformInt(value, rMin, rMax int64) (*int64, error) {
+func TransformInt(randomizer rng.Rand, value, rMin, rMax int64) (*int64, error) {
 	if value == 0 {
 		return nil, nil
 	}
@@ -63,7 +71,7 @@ func TransformInt(value, rMin, rMax int64) (*int64, error) {
 	minRange := value - rMin
 	maxRange := value + rMax
 
-	val, err := transformer_utils.GenerateRandomInt64InValueRange(minRange, maxRange)
+	val, err := transformer_utils.GenerateRandomInt64InValueRangeWithRandomizer(randomizer, minRange, maxRange)
 	if err != nil {
 		return nil, fmt.Errorf("unable to generate a random int64 with length [%d:%d]:%w", minRange, maxRange, err)
 	}
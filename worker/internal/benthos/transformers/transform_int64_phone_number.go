@@ -2,16 +2,19 @@ package transformers
 
 import (
 	"fmt"
+	"strconv"
 
 	"github.com/benthosdev/benthos/v4/public/bloblang"
 	transformers_dataset "github.com/nucleuscloud/neosync/worker/internal/benthos/transformers/data-sets"
 	transformer_utils "github.com/nucleuscloud/neosync/worker/internal/benthos/transformers/utils"
+	"github.com/nucleuscloud/neosync/worker/internal/rng"
 )
 
 func init() {
 	spec := bloblang.NewPluginSpec().
 		Param(bloblang.NewAnyParam("value").Optional()).
-		Param(bloblang.NewBoolParam("preserve_length"))
+		Param(bloblang.NewBoolParam("preserve_length")).
+		Param(newDeterministicKeyParam())
 
 	err := bloblang.RegisterFunctionV2("transform_int64_phone_number", spec, func(args *bloblang.ParsedParams) (bloblang.Function, error) {
 		valuePtr, err := args.GetOptionalInt64("value")
@@ -29,8 +32,13 @@ func init() {
 			return nil, err
 		}
 
+		randomizer, err := getTransformRandomizer(args, strconv.FormatInt(value, 10))
+		if err != nil {
+			return nil, err
+		}
+
 		return func() (any, error) {
-			res, err := TransformInt64PhoneNumber(value, preserveLength)
+			res, err := TransformInt64PhoneNumber(randomizer, value, preserveLength)
 			if err != nil {
 				return nil, fmt.Errorf("unable to run transform_int64_phone_number: %w", err)
 			}
@@ -44,19 +52,19 @@ func init() {
 }
 
 // generates a random phone number and returns it as an int64
-func TransformInt64PhoneNumber(number int64, preserveLength bool) (*int64, error) {
+func TransformInt64PhoneNumber(randomizer rng.Rand, number int64, preserveLength bool) (*int64, error) {
 	if number == 0 {
 		return nil, nil
 	}
 
 	if preserveLength {
-		res, err := GenerateIntPhoneNumberPreserveLength(number)
+		res, err := GenerateIntPhoneNumberPreserveLength(randomizer, number)
 		if err != nil {
 			return nil, err
 		}
 		return &res, nil
 	} else {
-		res, err := GenerateRandomInt64PhoneNumber()
+		res, err := generateRandomInt64PhoneNumber(randomizer)
 		if err != nil {
 			return nil, err
 		}
@@ -64,16 +72,16 @@ func TransformInt64PhoneNumber(number int64, preserveLength bool) (*int64, error
 	}
 }
 
-func GenerateIntPhoneNumberPreserveLength(number int64) (int64, error) {
+func GenerateIntPhoneNumberPreserveLength(randomizer rng.Rand, number int64) (int64, error) {
 	ac := transformers_dataset.AreaCodes
 
 	// get a random area code from the areacodes data set
-	randAreaCode, err := transformer_utils.GetRandomValueFromSlice[int64](ac)
+	randAreaCode, err := transformer_utils.GetRandomValueFromSliceWithRandomizer[int64](randomizer, ac)
 	if err != nil {
 		return 0, err
 	}
 
-	pn, err := transformer_utils.GenerateRandomInt64FixedLength(transformer_utils.GetInt64Length(number) - 3)
+	pn, err := transformer_utils.GenerateRandomInt64FixedLengthWithRandomizer(randomizer, transformer_utils.GetInt64Length(number)-3)
 	if err != nil {
 		return 0, err
 	}
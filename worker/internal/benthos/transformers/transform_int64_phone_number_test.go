@@ -2,6 +2,7 @@ package transformers
 
 import (
 	"fmt"
+	"math/rand"
 	"strconv"
 	"strings"
 	"testing"
@@ -14,7 +15,7 @@ import (
 var testValue = int64(8384928322)
 
 func Test_GenerateIntPhoneNumberPreserveLengthTrue(t *testing.T) {
-	res, err := TransformInt64PhoneNumber(testValue, true)
+	res, err := TransformInt64PhoneNumber(rand.New(rand.NewSource(1)), testValue, true)
 	assert.NoError(t, err)
 
 	numStr := strconv.FormatInt(*res, 10)
@@ -22,7 +23,7 @@ func Test_GenerateIntPhoneNumberPreserveLengthTrue(t *testing.T) {
 }
 
 func Test_GenerateIntPhoneNumberPreserveLengthFalse(t *testing.T) {
-	res, err := TransformInt64PhoneNumber(testValue, false)
+	res, err := TransformInt64PhoneNumber(rand.New(rand.NewSource(1)), testValue, false)
 	assert.NoError(t, err)
 
 	numStr := strconv.FormatInt(*res, 10)
@@ -30,7 +31,7 @@ func Test_GenerateIntPhoneNumberPreserveLengthFalse(t *testing.T) {
 }
 
 func Test_GenerateIntPhoneNumberPreserveLengthFunction(t *testing.T) {
-	res, err := GenerateIntPhoneNumberPreserveLength(testValue)
+	res, err := GenerateIntPhoneNumberPreserveLength(rand.New(rand.NewSource(1)), testValue)
 	assert.NoError(t, err)
 
 	numStr := strconv.FormatInt(res, 10)
@@ -2,6 +2,7 @@ package transformers
 
 import (
 	"fmt"
+	"math/rand"
 	"testing"
 
 	"github.com/benthosdev/benthos/v4/public/bloblang"
@@ -23,7 +24,7 @@ func Test_TransformIntInRange(t *testing.T) {
 	rMin := int64(22)
 	rMax := int64(29)
 
-	res, err := TransformInt(val, rMin, rMax)
+	res, err := TransformInt(rand.New(rand.NewSource(1)), val, rMin, rMax)
 	assert.NoError(t, err)
 
 	assert.GreaterOrEqual(t, *res, val-rMin, "The result should be greater than the min")
@@ -0,0 +1,192 @@
+package transformers
+
+import (
+	"fmt"
+	"net/netip"
+	"strconv"
+	"strings"
+
+	"github.com/benthosdev/benthos/v4/public/bloblang"
+	"github.com/nucleuscloud/neosync/worker/internal/rng"
+)
+
+// Number of times a random address is regenerated when it lands in a range that it should not be in
+const maxIpAddressAttempts = 100
+
+var (
+	// ranges that an address is kept in when preserving its class. more specific ranges come first
+	ipv4SpecialRanges = []netip.Prefix{
+		netip.MustParsePrefix("0.0.0.0/8"),
+		netip.MustParsePrefix("10.0.0.0/8"),
+		netip.MustParsePrefix("100.64.0.0/10"),
+		netip.MustParsePrefix("127.0.0.0/8"),
+		netip.MustParsePrefix("169.254.0.0/16"),
+		netip.MustParsePrefix("172.16.0.0/12"),
+		netip.MustParsePrefix("192.168.0.0/16"),
+	}
+	ipv4ClassRanges = []netip.Prefix{
+		netip.MustParsePrefix("0.0.0.0/1"),   // A
+		netip.MustParsePrefix("128.0.0.0/2"), // B
+		netip.MustParsePrefix("192.0.0.0/3"), // C
+		netip.MustParsePrefix("224.0.0.0/4"), // D
+		netip.MustParsePrefix("240.0.0.0/4"), // E
+	}
+	// ranges that generated ipv4 addresses are kept out of since they are not public unicast addresses
+	ipv4PublicRanges = append(append([]netip.Prefix{}, ipv4SpecialRanges...), ipv4ClassRanges[3:]...)
+
+	ipv6SpecialRanges = []netip.Prefix{
+		netip.MustParsePrefix("::/127"),
+		netip.MustParsePrefix("::ffff:0:0/96"),
+		netip.MustParsePrefix("fc00::/7"),
+		netip.MustParsePrefix("fe80::/10"),
+		netip.MustParsePrefix("ff00::/8"),
+		netip.MustParsePrefix("2000::/3"),
+	}
+)
+
+func init() {
+	spec := bloblang.NewPluginSpec().
+		Param(bloblang.NewAnyParam("value").Optional()).
+		Param(bloblang.NewInt64Param("preserve_prefix_length").Default(0)).
+		Param(bloblang.NewBoolParam("preserve_class").Default(false)).
+		Param(newDeterministicKeyParam())
+
+	err := bloblang.RegisterFunctionV2("transform_ip_address", spec, func(args *bloblang.ParsedParams) (bloblang.Function, error) {
+		valuePtr, err := args.GetOptionalString("value")
+		if err != nil {
+			return nil, err
+		}
+
+		var value string
+		if valuePtr != nil {
+			value = *valuePtr
+		}
+
+		preservePrefixLength, err := args.GetInt64("preserve_prefix_length")
+		if err != nil {
+			return nil, err
+		}
+
+		preserveClass, err := args.GetBool("preserve_class")
+		if err != nil {
+			return nil, err
+		}
+
+		randomizer, err := getTransformRandomizer(args, value)
+		if err != nil {
+			return nil, err
+		}
+
+		return func() (any, error) {
+			res, err := TransformIpAddress(randomizer, value, preservePrefixLength, preserveClass)
+			if err != nil {
+				return nil, fmt.Errorf("unable to run transform_ip_address: %w", err)
+			}
+			return res, nil
+		}, nil
+	})
+
+	if err != nil {
+		panic(err)
+	}
+}
+
+/*
+Transforms an ipv4 or ipv6 address into a random address of the same version.
+
+The first preservePrefixLength bits of the address are kept, so 10.1.2.3 with a prefix length of 24 is transformed into an address in 10.1.2.0/24.
+When preserveClass is true, an ipv4 address is kept in the same class and private, loopback and link local addresses of either version are kept in their range.
+Values in CIDR notation, such as 10.1.2.0/24, keep their prefix length and network addresses are transformed into network addresses.
+*/
+func TransformIpAddress(randomizer rng.Rand, value string, preservePrefixLength int64, preserveClass bool) (*string, error) {
+	if value == "" {
+		return nil, nil
+	}
+
+	addrValue, suffix, hasSuffix := strings.Cut(value, "/")
+	addr, err := netip.ParseAddr(addrValue)
+	if err != nil {
+		return nil, fmt.Errorf("value is not a valid ip address: %w", err)
+	}
+	addr = addr.WithZone("")
+
+	keep := int(min(max(preservePrefixLength, 0), int64(addr.BitLen())))
+	rangePrefix := netip.PrefixFrom(addr, keep).Masked()
+
+	var excluded []netip.Prefix
+	if preserveClass {
+		classRange := getIpClassRange(addr)
+		if classRange.Bits() > rangePrefix.Bits() {
+			rangePrefix = classRange
+		}
+		excluded = ipv4SpecialRanges
+		if addr.Is6() {
+			excluded = ipv6SpecialRanges
+		}
+	}
+
+	output := randomIpInRange(randomizer, rangePrefix, excluded)
+	if !hasSuffix {
+		res := output.String()
+		return &res, nil
+	}
+
+	networkBits, err := strconv.Atoi(suffix)
+	if err != nil || networkBits < 0 || networkBits > addr.BitLen() {
+		return nil, fmt.Errorf("value is not a valid ip address: invalid prefix length %q", suffix)
+	}
+	// cidr values must not have any bits set after the prefix
+	if netip.PrefixFrom(addr, networkBits).Masked().Addr() == addr {
+		output = netip.PrefixFrom(output, networkBits).Masked().Addr()
+	}
+	res := fmt.Sprintf("%s/%d", output.String(), networkBits)
+	return &res, nil
+}
+
+// Returns the most specific special range or ipv4 class that contains the address
+func getIpClassRange(addr netip.Addr) netip.Prefix {
+	if addr.Is4() {
+		for _, prefix := range ipv4SpecialRanges {
+			if prefix.Contains(addr) {
+				return prefix
+			}
+		}
+		for _, prefix := range ipv4ClassRanges {
+			if prefix.Contains(addr) {
+				return prefix
+			}
+		}
+	} else {
+		for _, prefix := range ipv6SpecialRanges {
+			if prefix.Contains(addr) {
+				return prefix
+			}
+		}
+	}
+	return netip.PrefixFrom(addr, 0).Masked()
+}
+
+// Returns a random address in the prefix that is not in any of the excluded ranges that are more specific than the prefix
+func randomIpInRange(randomizer rng.Rand, prefix netip.Prefix, excluded []netip.Prefix) netip.Addr {
+	narrower := []netip.Prefix{}
+	for _, candidate := range excluded {
+		if candidate.Bits() > prefix.Bits() && prefix.Contains(candidate.Addr()) {
+			narrower = append(narrower, candidate)
+		}
+	}
+
+	addr := randomIpInPrefix(randomizer, prefix)
+	for attempt := 1; attempt < maxIpAddressAttempts && isInAnyPrefix(addr, narrower); attempt++ {
+		addr = randomIpInPrefix(randomizer, prefix)
+	}
+	return addr
+}
+
+func isInAnyPrefix(addr netip.Addr, prefixes []netip.Prefix) bool {
+	for _, prefix := range prefixes {
+		if prefix.Contains(addr) {
+			return true
+		}
+	}
+	return false
+}
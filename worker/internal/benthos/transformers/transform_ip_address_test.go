@@ -0,0 +1,122 @@
+package transformers
+
+import (
+	"fmt"
+	"net/netip"
+	"strings"
+	"testing"
+
+	"github.com/benthosdev/benthos/v4/public/bloblang"
+	"github.com/nucleuscloud/neosync/worker/internal/rng"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_TransformIpAddress_PreservePrefixLength(t *testing.T) {
+	tests := []struct {
+		value        string
+		prefixLength int64
+		expected     string
+	}{
+		{value: "10.1.2.3", prefixLength: 24, expected: "10.1.2.0/24"},
+		{value: "10.1.2.3", prefixLength: 12, expected: "10.0.0.0/12"},
+		{value: "203.0.113.7", prefixLength: 0, expected: "0.0.0.0/0"},
+		{value: "2001:db8:85a3::8a2e:370:7334", prefixLength: 48, expected: "2001:db8:85a3::/48"},
+		{value: "2001:db8::1", prefixLength: 200, expected: "2001:db8::1/128"},
+	}
+	for _, tt := range tests {
+		t.Run(fmt.Sprintf("%s/%d", tt.value, tt.prefixLength), func(t *testing.T) {
+			randomizer := rng.New(1)
+			expected := netip.MustParsePrefix(tt.expected)
+			for i := 0; i < 20; i++ {
+				res, err := TransformIpAddress(randomizer, tt.value, tt.prefixLength, false)
+				require.NoError(t, err)
+				require.NotNil(t, res)
+				addr, err := netip.ParseAddr(*res)
+				require.NoError(t, err)
+				assert.True(t, expected.Contains(addr), "%s should be in %s", addr, expected)
+			}
+		})
+	}
+}
+
+func Test_TransformIpAddress_PreserveClass(t *testing.T) {
+	tests := []struct {
+		value    string
+		expected string
+	}{
+		{value: "192.168.1.20", expected: "192.168.0.0/16"},
+		{value: "172.20.4.1", expected: "172.16.0.0/12"},
+		{value: "127.0.0.1", expected: "127.0.0.0/8"},
+		{value: "150.10.20.30", expected: "128.0.0.0/2"},
+		{value: "fe80::1c2b:3cff:fe4d:5e6f", expected: "fe80::/10"},
+		{value: "fd12:3456:789a::1", expected: "fc00::/7"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			randomizer := rng.New(1)
+			expected := netip.MustParsePrefix(tt.expected)
+			for i := 0; i < 20; i++ {
+				res, err := TransformIpAddress(randomizer, tt.value, 0, true)
+				require.NoError(t, err)
+				require.NotNil(t, res)
+				addr, err := netip.ParseAddr(*res)
+				require.NoError(t, err)
+				assert.True(t, expected.Contains(addr), "%s should be in %s", addr, expected)
+			}
+		})
+	}
+
+	// a public class A address should not be moved into a private or loopback range
+	randomizer := rng.New(1)
+	for i := 0; i < 100; i++ {
+		res, err := TransformIpAddress(randomizer, "8.8.8.8", 0, true)
+		require.NoError(t, err)
+		addr := netip.MustParseAddr(*res)
+		assert.True(t, ipv4ClassRanges[0].Contains(addr))
+		assert.False(t, isInAnyPrefix(addr, ipv4SpecialRanges), "%s should not be in a special range", addr)
+	}
+}
+
+func Test_TransformIpAddress_Cidr(t *testing.T) {
+	randomizer := rng.New(1)
+
+	res, err := TransformIpAddress(randomizer, "10.1.2.0/24", 8, false)
+	require.NoError(t, err)
+	require.NotNil(t, res)
+	prefix, err := netip.ParsePrefix(*res)
+	require.NoError(t, err)
+	assert.Equal(t, 24, prefix.Bits())
+	assert.Equal(t, prefix.Masked(), prefix, "a network address should stay a network address")
+	assert.True(t, strings.HasPrefix(*res, "10."))
+
+	res, err = TransformIpAddress(randomizer, "10.1.2.3/24", 0, false)
+	require.NoError(t, err)
+	require.NotNil(t, res)
+	assert.True(t, strings.HasSuffix(*res, "/24"))
+}
+
+func Test_TransformIpAddress_Invalid(t *testing.T) {
+	randomizer := rng.New(1)
+	_, err := TransformIpAddress(randomizer, "not an ip", 0, false)
+	assert.Error(t, err)
+
+	_, err = TransformIpAddress(randomizer, "10.1.2.3/40", 0, false)
+	assert.Error(t, err)
+
+	res, err := TransformIpAddress(randomizer, "", 0, false)
+	assert.NoError(t, err)
+	assert.Nil(t, res)
+}
+
+func Test_TransformIpAddressTransformer(t *testing.T) {
+	mapping := `root = transform_ip_address(value:"10.1.2.3",preserve_prefix_length:24)`
+	ex, err := bloblang.Parse(mapping)
+	require.NoError(t, err, "failed to parse the ip address transformer")
+
+	res, err := ex.Query(nil)
+	assert.NoError(t, err)
+	resStr, ok := res.(*string)
+	require.True(t, ok)
+	assert.True(t, strings.HasPrefix(*resStr, "10.1.2."))
+}
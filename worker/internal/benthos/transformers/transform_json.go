@@ -0,0 +1,220 @@
+package transformers
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+	"sync"
+
+	"github.com/benthosdev/benthos/v4/public/bloblang"
+	"github.com/nucleuscloud/neosync/backend/pkg/jsonpath"
+)
+
+// Name of the field that each selected value is passed to a rule's mapping in
+const transformJsonValueField = "value"
+
+// Rules are compiled once and reused since the function is constructed for every row
+var transformJsonRuleCache sync.Map
+
+func init() {
+	spec := bloblang.NewPluginSpec().
+		Param(bloblang.NewAnyParam("value").Optional()).
+		Param(bloblang.NewAnyParam("rules"))
+
+	err := bloblang.RegisterFunctionV2("transform_json", spec, func(args *bloblang.ParsedParams) (bloblang.Function, error) {
+		value, err := args.Get("value")
+		if err != nil {
+			return nil, err
+		}
+
+		rulesArg, err := args.Get("rules")
+		if err != nil {
+			return nil, err
+		}
+		rules, err := getTransformJsonRules(rulesArg)
+		if err != nil {
+			return nil, err
+		}
+
+		return func() (any, error) {
+			res, err := TransformJson(value, rules)
+			if err != nil {
+				return nil, fmt.Errorf("unable to run transform_json: %w", err)
+			}
+			return res, nil
+		}, nil
+	})
+
+	if err != nil {
+		panic(err)
+	}
+}
+
+type TransformJsonRule struct {
+	Path *jsonpath.Path
+	// runs on every value that the path selects. the selected value is available as this.value
+	Mapping *bloblang.Executor
+}
+
+/*
+Transforms the values inside of a JSON document. Each rule selects values with its path and replaces them with the result of its mapping.
+The rules are run in order.
+
+The value can be JSON text, as Postgres json and jsonb and MySQL JSON columns are read, or an already decoded object or array.
+JSON text is returned as JSON text and decoded values are returned as decoded values.
+*/
+func TransformJson(value any, rules []*TransformJsonRule) (any, error) {
+	switch v := value.(type) {
+	case nil:
+		return nil, nil
+	case string:
+		return transformJsonText(v, rules)
+	case []byte:
+		return transformJsonText(string(v), rules)
+	default:
+		return applyTransformJsonRules(copyJsonValue(v), rules)
+	}
+}
+
+func transformJsonText(value string, rules []*TransformJsonRule) (any, error) {
+	if strings.TrimSpace(value) == "" {
+		return value, nil
+	}
+
+	decoder := json.NewDecoder(strings.NewReader(value))
+	// keeps large numbers from losing precision
+	decoder.UseNumber()
+	var doc any
+	if err := decoder.Decode(&doc); err != nil {
+		return nil, fmt.Errorf("value is not valid json: %w", err)
+	}
+
+	res, err := applyTransformJsonRules(doc, rules)
+	if err != nil {
+		return nil, err
+	}
+
+	var buf bytes.Buffer
+	encoder := json.NewEncoder(&buf)
+	encoder.SetEscapeHTML(false)
+	if err := encoder.Encode(res); err != nil {
+		return nil, err
+	}
+	return strings.TrimSuffix(buf.String(), "\n"), nil
+}
+
+func applyTransformJsonRules(doc any, rules []*TransformJsonRule) (any, error) {
+	var err error
+	for _, rule := range rules {
+		doc, err = rule.Path.Transform(doc, func(value any) (any, error) {
+			res, err := rule.Mapping.Query(map[string]any{transformJsonValueField: fromJsonNumber(value)})
+			if err != nil {
+				return nil, fmt.Errorf("unable to transform value at %s: %w", rule.Path.String(), err)
+			}
+			return derefJsonValue(res), nil
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+	return doc, nil
+}
+
+// Parses the rules argument, which is an array of objects with a path and a mapping
+func getTransformJsonRules(arg any) ([]*TransformJsonRule, error) {
+	rulesArg, ok := arg.([]any)
+	if !ok {
+		return nil, fmt.Errorf("rules must be an array, got %T", arg)
+	}
+
+	rules := make([]*TransformJsonRule, 0, len(rulesArg))
+	for _, ruleArg := range rulesArg {
+		ruleMap, ok := ruleArg.(map[string]any)
+		if !ok {
+			return nil, errors.New("each rule must be an object with a path and a mapping")
+		}
+		path, ok := ruleMap["path"].(string)
+		if !ok {
+			return nil, errors.New("rule path must be a string")
+		}
+		mapping, ok := ruleMap["mapping"].(string)
+		if !ok {
+			return nil, errors.New("rule mapping must be a string")
+		}
+		rule, err := getTransformJsonRule(path, mapping)
+		if err != nil {
+			return nil, err
+		}
+		rules = append(rules, rule)
+	}
+	return rules, nil
+}
+
+func getTransformJsonRule(path, mapping string) (*TransformJsonRule, error) {
+	cacheKey := path + "\x00" + mapping
+	if rule, ok := transformJsonRuleCache.Load(cacheKey); ok {
+		return rule.(*TransformJsonRule), nil
+	}
+
+	parsedPath, err := jsonpath.Parse(path)
+	if err != nil {
+		return nil, fmt.Errorf("invalid rule path: %w", err)
+	}
+	executor, err := bloblang.Parse(fmt.Sprintf("root = %s", mapping))
+	if err != nil {
+		return nil, fmt.Errorf("invalid rule mapping for %s: %w", path, err)
+	}
+	rule := &TransformJsonRule{Path: parsedPath, Mapping: executor}
+	transformJsonRuleCache.Store(cacheKey, rule)
+	return rule, nil
+}
+
+// Copies decoded objects and arrays so that transforming them does not modify the input message
+func copyJsonValue(value any) any {
+	switch v := value.(type) {
+	case map[string]any:
+		out := make(map[string]any, len(v))
+		for key, child := range v {
+			out[key] = copyJsonValue(child)
+		}
+		return out
+	case []any:
+		out := make([]any, len(v))
+		for idx, child := range v {
+			out[idx] = copyJsonValue(child)
+		}
+		return out
+	default:
+		return value
+	}
+}
+
+// Numbers are decoded as json.Number, which the numeric transformers do not accept
+func fromJsonNumber(value any) any {
+	num, ok := value.(json.Number)
+	if !ok {
+		return value
+	}
+	if i, err := num.Int64(); err == nil {
+		return i
+	}
+	if f, err := num.Float64(); err == nil {
+		return f
+	}
+	return value
+}
+
+// Transformers return pointers to their results, which are dereferenced so that later rules receive plain values
+func derefJsonValue(value any) any {
+	rv := reflect.ValueOf(value)
+	if rv.Kind() != reflect.Pointer {
+		return value
+	}
+	if rv.IsNil() {
+		return nil
+	}
+	return rv.Elem().Interface()
+}
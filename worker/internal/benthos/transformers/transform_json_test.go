@@ -0,0 +1,100 @@
+package transformers
+
+import (
+	"testing"
+
+	"github.com/benthosdev/benthos/v4/public/bloblang"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_TransformJson(t *testing.T) {
+	rules, err := getTransformJsonRules([]any{
+		map[string]any{"path": "$.contact.email", "mapping": `"redacted@example.com"`},
+		map[string]any{"path": "$.tags[*]", "mapping": `this.value.uppercase()`},
+	})
+	require.NoError(t, err)
+
+	res, err := TransformJson(`{"contact":{"email":"jane@example.com","age":42},"tags":["a","b"],"id":12345678901234567890}`, rules)
+	assert.NoError(t, err)
+
+	resStr, ok := res.(string)
+	require.True(t, ok)
+	assert.JSONEq(t, `{"contact":{"email":"redacted@example.com","age":42},"tags":["A","B"],"id":12345678901234567890}`, resStr)
+	assert.Contains(t, resStr, "12345678901234567890", "large numbers should not lose precision")
+}
+
+func Test_TransformJson_Decoded(t *testing.T) {
+	rules, err := getTransformJsonRules([]any{
+		map[string]any{"path": "$.name", "mapping": `"Jane"`},
+	})
+	require.NoError(t, err)
+
+	input := map[string]any{"name": "John", "id": 1}
+	res, err := TransformJson(input, rules)
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]any{"name": "Jane", "id": 1}, res)
+	assert.Equal(t, "John", input["name"], "the input should not be modified")
+}
+
+func Test_TransformJson_Nil(t *testing.T) {
+	res, err := TransformJson(nil, nil)
+	assert.NoError(t, err)
+	assert.Nil(t, res)
+}
+
+func Test_TransformJson_InvalidJson(t *testing.T) {
+	rules, err := getTransformJsonRules([]any{
+		map[string]any{"path": "$.name", "mapping": `"Jane"`},
+	})
+	require.NoError(t, err)
+
+	_, err = TransformJson(`{"name":`, rules)
+	assert.Error(t, err)
+}
+
+func Test_getTransformJsonRules_Invalid(t *testing.T) {
+	_, err := getTransformJsonRules("not rules")
+	assert.Error(t, err)
+
+	_, err = getTransformJsonRules([]any{map[string]any{"path": "name", "mapping": `"Jane"`}})
+	assert.Error(t, err)
+
+	_, err = getTransformJsonRules([]any{map[string]any{"path": "$.name", "mapping": `not a (mapping`}})
+	assert.Error(t, err)
+
+	_, err = getTransformJsonRules([]any{map[string]any{"path": "$.name"}})
+	assert.Error(t, err)
+}
+
+func Test_TransformJsonTransformer(t *testing.T) {
+	mapping := `root = transform_json(value:this.profile,rules:[{"path":"$.contact.email","mapping":"transform_email(email:this.\"value\",preserve_domain:true,preserve_length:false,excluded_domains:[],max_length:100)"},{"path":"$.tags[*]","mapping":"transform_string(value:this.\"value\",preserve_length:true,min_length:1,max_length:20)"},{"path":"$.score","mapping":"transform_int64(value:this.\"value\",randomization_range_min:10,randomization_range_max:100)"}])`
+	ex, err := bloblang.Parse(mapping)
+	require.NoError(t, err, "failed to parse the json transformer")
+
+	res, err := ex.Query(map[string]any{
+		"profile": `{"contact":{"email":"jane@example.com"},"tags":["admin","beta"],"score":50}`,
+	})
+	require.NoError(t, err)
+
+	out, err := bloblang.Parse(`root = this.parse_json()`)
+	require.NoError(t, err)
+	doc, err := out.Query(res)
+	require.NoError(t, err)
+
+	docMap, ok := doc.(map[string]any)
+	require.True(t, ok)
+	email := docMap["contact"].(map[string]any)["email"].(string)
+	assert.NotEqual(t, "jane@example.com", email)
+	assert.Contains(t, email, "@example.com")
+
+	tags := docMap["tags"].([]any)
+	require.Len(t, tags, 2)
+	assert.Len(t, tags[0], len("admin"))
+	assert.Len(t, tags[1], len("beta"))
+
+	score, ok := docMap["score"].(float64)
+	require.True(t, ok)
+	assert.GreaterOrEqual(t, score, float64(40))
+	assert.LessOrEqual(t, score, float64(150))
+}
@@ -0,0 +1,128 @@
+package transformers
+
+import (
+	"encoding/hex"
+	"fmt"
+	"net"
+	"strings"
+
+	"github.com/benthosdev/benthos/v4/public/bloblang"
+	"github.com/nucleuscloud/neosync/worker/internal/rng"
+)
+
+// The number of bytes at the start of an address that identify the manufacturer of the device
+const macOuiLength = 3
+
+func init() {
+	spec := bloblang.NewPluginSpec().
+		Param(bloblang.NewAnyParam("value").Optional()).
+		Param(bloblang.NewBoolParam("preserve_oui").Default(false)).
+		Param(newDeterministicKeyParam())
+
+	err := bloblang.RegisterFunctionV2("transform_mac_address", spec, func(args *bloblang.ParsedParams) (bloblang.Function, error) {
+		valuePtr, err := args.GetOptionalString("value")
+		if err != nil {
+			return nil, err
+		}
+
+		var value string
+		if valuePtr != nil {
+			value = *valuePtr
+		}
+
+		preserveOui, err := args.GetBool("preserve_oui")
+		if err != nil {
+			return nil, err
+		}
+
+		randomizer, err := getTransformRandomizer(args, value)
+		if err != nil {
+			return nil, err
+		}
+
+		return func() (any, error) {
+			res, err := TransformMacAddress(randomizer, value, preserveOui)
+			if err != nil {
+				return nil, fmt.Errorf("unable to run transform_mac_address: %w", err)
+			}
+			return res, nil
+		}, nil
+	})
+
+	if err != nil {
+		panic(err)
+	}
+}
+
+/*
+Transforms a MAC address into a random MAC address with the same length and formatting, for example 00:1a:2b:3c:4d:5e, 00-1A-2B-3C-4D-5E, 001a.2b3c.4d5e or 001a2b3c4d5e.
+When preserveOui is true, the first three bytes that identify the manufacturer are kept. Otherwise the multicast and locally administered bits of the address are kept.
+*/
+func TransformMacAddress(randomizer rng.Rand, value string, preserveOui bool) (*string, error) {
+	if value == "" {
+		return nil, nil
+	}
+
+	addr, err := parseMacAddress(value)
+	if err != nil {
+		return nil, err
+	}
+
+	output := make(net.HardwareAddr, len(addr))
+	for idx := range output {
+		output[idx] = byte(randomizer.Intn(256))
+	}
+	if preserveOui {
+		copy(output, addr[:macOuiLength])
+	} else {
+		flags := macMulticastBit | macLocalBit
+		output[0] = (output[0] &^ flags) | (addr[0] & flags)
+	}
+
+	res := formatMacAddress(output, value)
+	return &res, nil
+}
+
+func parseMacAddress(value string) (net.HardwareAddr, error) {
+	// net.ParseMAC does not support addresses without separators
+	if !strings.ContainsAny(value, ":-.") {
+		addr, err := hex.DecodeString(value)
+		if err != nil || (len(addr) != 6 && len(addr) != 8 && len(addr) != 20) {
+			return nil, fmt.Errorf("value is not a valid mac address: %s", value)
+		}
+		return addr, nil
+	}
+	addr, err := net.ParseMAC(value)
+	if err != nil {
+		return nil, fmt.Errorf("value is not a valid mac address: %w", err)
+	}
+	return addr, nil
+}
+
+// Formats the address with the same separator, grouping and letter case as the original value
+func formatMacAddress(addr net.HardwareAddr, original string) string {
+	hexValue := hex.EncodeToString(addr)
+	if strings.ToLower(original) != original {
+		hexValue = strings.ToUpper(hexValue)
+	}
+
+	var separator string
+	groupSize := 2
+	switch {
+	case strings.Contains(original, ":"):
+		separator = ":"
+	case strings.Contains(original, "-"):
+		separator = "-"
+	case strings.Contains(original, "."):
+		separator = "."
+		groupSize = 4
+	default:
+		return hexValue
+	}
+
+	groups := make([]string, 0, len(hexValue)/groupSize)
+	for idx := 0; idx < len(hexValue); idx += groupSize {
+		groups = append(groups, hexValue[idx:idx+groupSize])
+	}
+	return strings.Join(groups, separator)
+}
@@ -0,0 +1,68 @@
+package transformers
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/benthosdev/benthos/v4/public/bloblang"
+	"github.com/nucleuscloud/neosync/worker/internal/rng"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_TransformMacAddress_Formats(t *testing.T) {
+	tests := []struct {
+		value   string
+		pattern string
+	}{
+		{value: "00:1a:2b:3c:4d:5e", pattern: `^00:1a:2b(:[0-9a-f]{2}){3}$`},
+		{value: "00-1A-2B-3C-4D-5E", pattern: `^00-1A-2B(-[0-9A-F]{2}){3}$`},
+		{value: "001a.2b3c.4d5e", pattern: `^001a\.2b[0-9a-f]{2}\.[0-9a-f]{4}$`},
+		{value: "001a2b3c4d5e", pattern: `^001a2b[0-9a-f]{6}$`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			res, err := TransformMacAddress(rng.New(1), tt.value, true)
+			require.NoError(t, err)
+			require.NotNil(t, res)
+			assert.Regexp(t, tt.pattern, *res)
+			assert.NotEqual(t, tt.value, *res)
+		})
+	}
+}
+
+func Test_TransformMacAddress_KeepsFlags(t *testing.T) {
+	randomizer := rng.New(1)
+	for i := 0; i < 20; i++ {
+		res, err := TransformMacAddress(randomizer, "02:00:5e:10:00:01", false)
+		require.NoError(t, err)
+		require.NotNil(t, res)
+		addr, err := parseMacAddress(*res)
+		require.NoError(t, err)
+		assert.Equal(t, macLocalBit, addr[0]&(macLocalBit|macMulticastBit))
+	}
+}
+
+func Test_TransformMacAddress_Invalid(t *testing.T) {
+	_, err := TransformMacAddress(rng.New(1), "not a mac", false)
+	assert.Error(t, err)
+
+	_, err = TransformMacAddress(rng.New(1), "001a2b", false)
+	assert.Error(t, err)
+
+	res, err := TransformMacAddress(rng.New(1), "", false)
+	assert.NoError(t, err)
+	assert.Nil(t, res)
+}
+
+func Test_TransformMacAddressTransformer(t *testing.T) {
+	mapping := `root = transform_mac_address(value:"00:1a:2b:3c:4d:5e",preserve_oui:true)`
+	ex, err := bloblang.Parse(mapping)
+	require.NoError(t, err, "failed to parse the mac address transformer")
+
+	res, err := ex.Query(nil)
+	assert.NoError(t, err)
+	resStr, ok := res.(*string)
+	require.True(t, ok)
+	assert.True(t, strings.HasPrefix(*resStr, "00:1a:2b:"))
+}
@@ -5,6 +5,7 @@ import (
 
 	"github.com/benthosdev/benthos/v4/public/bloblang"
 	transformer_utils "github.com/nucleuscloud/neosync/worker/internal/benthos/transformers/utils"
+	"github.com/nucleuscloud/neosync/worker/internal/rng"
 )
 
 func init() {
@@ -12,7 +13,8 @@ func init() {
 		Param(bloblang.NewAnyParam("value").Optional()).
 		Param(bloblang.NewBoolParam("preserve_length")).
 		Param(bloblang.NewInt64Param("min_length")).
-		Param(bloblang.NewInt64Param("max_length"))
+		Param(bloblang.NewInt64Param("max_length")).
+		Param(newDeterministicKeyParam())
 
 	err := bloblang.RegisterFunctionV2("transform_string", spec, func(args *bloblang.ParsedParams) (bloblang.Function, error) {
 		valuePtr, err := args.GetOptionalString("value")
@@ -40,8 +42,13 @@ func init() {
 			return nil, err
 		}
 
+		randomizer, err := getTransformRandomizer(args, value)
+		if err != nil {
+			return nil, err
+		}
+
 		return func() (any, error) {
-			res, err := TransformString(value, preserveLength, minLength, maxLength)
+			res, err := TransformString(randomizer, value, preserveLength, minLength, maxLength)
 			if err != nil {
 				return nil, fmt.Errorf("unable to run transform_string: %w", err)
 			}
@@ -55,7 +62,7 @@ func init() {
 }
 
 // Transforms an existing string value into another string. Does not account for numbers and other characters. If you want to preserve spaces, capitalization and other characters, use the Transform_Characters transformer.
-func TransformString(value string, preserveLength bool, minLength, maxLength int64) (*string, error) {
+func TransformString(randomizer rng.Rand, value string, preserveLength bool, minLength, maxLength int64) (*string, error) {
 	// todo: this is potentially a bug and we should pass in whether or not the column is nullable
 	// in databases, there is a difference between a null and empty string!
 	if value == "" {
@@ -64,13 +71,13 @@ func TransformString(value string, preserveLength bool, minLength, maxLength int
 
 	if preserveLength {
 		valueLength := int64(len(value))
-		val, err := transformer_utils.GenerateRandomStringWithDefinedLength(valueLength)
+		val, err := transformer_utils.GenerateRandomStringWithDefinedLengthWithRandomizer(randomizer, valueLength)
 		if err != nil {
 			return nil, fmt.Errorf("unable to generate a random string with preserved length: %d: %w", valueLength, err)
 		}
 		return &val, nil
 	}
-	val, err := transformer_utils.GenerateRandomStringWithInclusiveBounds(minLength, maxLength)
+	val, err := transformer_utils.GenerateRandomStringWithInclusiveBoundsWithRandomizer(randomizer, minLength, maxLength)
 	if err != nil {
 		return nil, fmt.Errorf("unable to transform a random string with length: [%d:%d]: %w", minLength, maxLength, err)
 	}
@@ -4,13 +4,18 @@ import (
 	"fmt"
 
 	"github.com/benthosdev/benthos/v4/public/bloblang"
+	transformers_dataset "github.com/nucleuscloud/neosync/worker/internal/benthos/transformers/data-sets"
+	"github.com/nucleuscloud/neosync/worker/internal/rng"
 )
 
 func init() {
 	spec := bloblang.NewPluginSpec().
 		Param(bloblang.NewAnyParam("value").Optional()).
 		Param(bloblang.NewBoolParam("preserve_length")).
-		Param(bloblang.NewInt64Param("max_length"))
+		Param(bloblang.NewInt64Param("max_length")).
+		Param(newDeterministicKeyParam()).
+		Param(newLocaleParam()).
+		Param(newRowLocaleParam())
 
 	err := bloblang.RegisterFunctionV2("transform_phone_number", spec, func(args *bloblang.ParsedParams) (bloblang.Function, error) {
 		valuePtr, err := args.GetOptionalString("value")
@@ -33,8 +38,18 @@ func init() {
 			return nil, err
 		}
 
+		randomizer, err := getTransformRandomizer(args, value)
+		if err != nil {
+			return nil, err
+		}
+
+		locale, err := getLocale(args)
+		if err != nil {
+			return nil, err
+		}
+
 		return func() (any, error) {
-			res, err := TransformPhoneNumber(value, preserveLength, maxLength)
+			res, err := transformLocalePhoneNumber(randomizer, locale, value, preserveLength, maxLength)
 			if err != nil {
 				return nil, fmt.Errorf("unable to run transform_phone_number: %w", err)
 			}
@@ -48,19 +63,24 @@ func init() {
 }
 
 // Generates a random phone number and returns it as a string
-func TransformPhoneNumber(value string, preserveLength bool, maxLength int64) (*string, error) {
+func TransformPhoneNumber(randomizer rng.Rand, value string, preserveLength bool, maxLength int64) (*string, error) {
+	return transformLocalePhoneNumber(randomizer, transformers_dataset.DefaultLocale, value, preserveLength, maxLength)
+}
+
+// Generates a random national phone number of the locale and returns it as a string
+func transformLocalePhoneNumber(randomizer rng.Rand, locale *transformers_dataset.Locale, value string, preserveLength bool, maxLength int64) (*string, error) {
 	if value == "" {
 		return nil, nil
 	}
 
 	if preserveLength {
-		val, err := GenerateStringPhoneNumber(int64(len(value)), int64(len(value)))
+		val, err := generateLocaleStringPhoneNumber(randomizer, locale, int64(len(value)), int64(len(value)))
 		if err != nil {
 			return nil, fmt.Errorf("unable to transformer phone number with length preserved: %w", err)
 		}
 		return &val, nil
 	} else {
-		val, err := GenerateStringPhoneNumber(int64(9), maxLength)
+		val, err := generateLocaleStringPhoneNumber(randomizer, locale, int64(9), maxLength)
 		if err != nil {
 			return nil, fmt.Errorf("unable to transform phone number with max length %d: %w", maxLength, err)
 		}
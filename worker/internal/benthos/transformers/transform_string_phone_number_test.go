@@ -2,23 +2,28 @@ package transformers
 
 import (
 	"fmt"
+	"math/rand"
+	"strings"
 	"testing"
 
 	"github.com/benthosdev/benthos/v4/public/bloblang"
+	transformers_dataset "github.com/nucleuscloud/neosync/worker/internal/benthos/transformers/data-sets"
+	transformer_utils "github.com/nucleuscloud/neosync/worker/internal/benthos/transformers/utils"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 var testPhone = "1234567890"
 
 func Test_TransformStringPhoneNumber(t *testing.T) {
-	res, err := TransformPhoneNumber(testPhone, true, maxCharacterLimit)
+	res, err := TransformPhoneNumber(rand.New(rand.NewSource(1)), testPhone, true, maxCharacterLimit)
 
 	assert.NoError(t, err)
 	assert.Equal(t, len(*res), len(testPhone), "The result should be the same length as the test phone")
 }
 
 func Test_TransformStringPhoneNumberEqualMinMax(t *testing.T) {
-	res, err := TransformPhoneNumber(testPhone, false, maxCharacterLimit)
+	res, err := TransformPhoneNumber(rand.New(rand.NewSource(1)), testPhone, false, maxCharacterLimit)
 
 	assert.NoError(t, err)
 	assert.GreaterOrEqual(t, len(*res), 8, "Should be greater than 9 characters in length. 9 for the number and 1 for the plus sign.")
@@ -56,3 +61,14 @@ func Test_TransformStringPhoneNumberTransformerWithEmptyValue(t *testing.T) {
 	_, err = ex.Query(nil)
 	assert.NoError(t, err)
 }
+
+func Test_TransformStringPhoneNumber_Locale(t *testing.T) {
+	locale, ok := transformers_dataset.GetLocale("ja_JP")
+	require.True(t, ok)
+
+	res, err := transformLocalePhoneNumber(transformer_utils.GlobalRandomizer, locale, "09012345678", true, maxCharacterLimit)
+	require.NoError(t, err)
+	require.NotNil(t, res)
+	assert.Len(t, *res, 11)
+	assert.True(t, strings.HasPrefix(*res, "0"), *res)
+}
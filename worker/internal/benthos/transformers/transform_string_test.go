@@ -2,6 +2,7 @@ package transformers
 
 import (
 	"fmt"
+	"math/rand"
 	"testing"
 
 	"github.com/benthosdev/benthos/v4/public/bloblang"
@@ -11,14 +12,14 @@ import (
 var testStringValue = "hello"
 
 func Test_TransformStringPreserveLengthTrue(t *testing.T) {
-	res, err := TransformString(testStringValue, true, 3, maxCharacterLimit)
+	res, err := TransformString(rand.New(rand.NewSource(1)), testStringValue, true, 3, maxCharacterLimit)
 
 	assert.NoError(t, err)
 	assert.Equal(t, len(testStringValue), len(*res), "The output string should be as long as the input string")
 }
 
 func Test_TransformStringPreserveLengthFalse(t *testing.T) {
-	res, err := TransformString(testStringValue, false, 3, maxCharacterLimit)
+	res, err := TransformString(rand.New(rand.NewSource(1)), testStringValue, false, 3, maxCharacterLimit)
 
 	assert.NoError(t, err)
 	assert.GreaterOrEqual(t, len(*res), 3, "The expected value should be greater than or equal to 3")
@@ -26,7 +27,7 @@ func Test_TransformStringPreserveLengthFalse(t *testing.T) {
 }
 
 func Test_TransformStringMaxLength(t *testing.T) {
-	res, err := TransformString(testStringValue, false, 3, maxCharacterLimit)
+	res, err := TransformString(rand.New(rand.NewSource(1)), testStringValue, false, 3, maxCharacterLimit)
 
 	assert.NoError(t, err)
 	assert.GreaterOrEqual(t, len(*res), 3, "The expected value should be greater than or equal to 3")
@@ -0,0 +1,287 @@
+package transformers
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/benthosdev/benthos/v4/public/bloblang"
+	transformer_utils "github.com/nucleuscloud/neosync/worker/internal/benthos/transformers/utils"
+	"github.com/nucleuscloud/neosync/worker/internal/rng"
+)
+
+const (
+	timeTransformModeShift    = "shift"
+	timeTransformModeJitter   = "jitter"
+	timeTransformModeTruncate = "truncate"
+	timeTransformModeClamp    = "clamp"
+
+	timeTruncatePrecisionMonth = "month"
+	timeTruncatePrecisionYear  = "year"
+)
+
+// Layouts that string values are parsed with, in order.
+// Covers RFC 3339, the text output of Postgres timestamp, timestamptz and date columns and MySQL DATETIME and DATE values.
+// A transformed string value is formatted with the layout that it was parsed with.
+var timeLayouts = []string{
+	time.RFC3339Nano,
+	"2006-01-02 15:04:05.999999999-07:00",
+	"2006-01-02 15:04:05.999999999-07",
+	"2006-01-02T15:04:05.999999999",
+	"2006-01-02 15:04:05.999999999",
+	time.DateOnly,
+}
+
+func init() {
+	spec := newTimeTransformPluginSpec("jitter_range_seconds")
+
+	err := bloblang.RegisterFunctionV2("transform_timestamp", spec, func(args *bloblang.ParsedParams) (bloblang.Function, error) {
+		value, err := args.Get("value")
+		if err != nil {
+			return nil, err
+		}
+
+		opts, err := getTimeTransformOpts(args, "jitter_range_seconds")
+		if err != nil {
+			return nil, err
+		}
+
+		randomizer, err := getTimeTransformRandomizer(args, opts, value)
+		if err != nil {
+			return nil, err
+		}
+
+		return func() (any, error) {
+			res, err := TransformTimestamp(randomizer, value, opts)
+			if err != nil {
+				return nil, fmt.Errorf("unable to run transform_timestamp: %w", err)
+			}
+			return res, nil
+		}, nil
+	})
+
+	if err != nil {
+		panic(err)
+	}
+}
+
+type TimeTransformOpts struct {
+	Mode string
+	// the number of days that the value is moved by in shift mode
+	ShiftOffsetDays int64
+	// the range of the random offset in jitter mode. seconds for timestamps and days for dates
+	JitterRange       int64
+	TruncatePrecision string
+	Min               *time.Time
+	Max               *time.Time
+}
+
+/*
+Transforms a timestamp while keeping it related to the original value.
+
+shift: moves the value by the shift offset. The offset is derived from the entity of the row so that all of the timestamps of an entity keep their order.
+jitter: moves the value by a random number of seconds in the range of [-jitter range, jitter range].
+truncate: truncates the value to the start of its month or year.
+clamp: limits the value to the range of [min, max].
+
+The value can be a time.Time or a string in one of the supported layouts, and the result is of the same type and layout.
+*/
+func TransformTimestamp(randomizer rng.Rand, value any, opts *TimeTransformOpts) (any, error) {
+	return transformTimeValue(value, func(t time.Time) (time.Time, error) {
+		switch opts.Mode {
+		case timeTransformModeShift:
+			return t.AddDate(0, 0, int(opts.ShiftOffsetDays)), nil
+		case timeTransformModeJitter:
+			seconds, err := transformer_utils.GenerateRandomInt64InValueRangeWithRandomizer(randomizer, -opts.JitterRange, opts.JitterRange)
+			if err != nil {
+				return time.Time{}, err
+			}
+			return t.Add(time.Duration(seconds) * time.Second), nil
+		case timeTransformModeTruncate:
+			return truncateTime(t, opts.TruncatePrecision), nil
+		case timeTransformModeClamp:
+			if opts.Min != nil && t.Before(*opts.Min) {
+				return opts.Min.In(t.Location()), nil
+			}
+			if opts.Max != nil && t.After(*opts.Max) {
+				return opts.Max.In(t.Location()), nil
+			}
+			return t, nil
+		default:
+			return time.Time{}, fmt.Errorf("unsupported mode: %s", opts.Mode)
+		}
+	})
+}
+
+func newTimeTransformPluginSpec(jitterParam string) *bloblang.PluginSpec {
+	return bloblang.NewPluginSpec().
+		Param(bloblang.NewAnyParam("value").Optional()).
+		Param(bloblang.NewStringParam("mode")).
+		Param(bloblang.NewInt64Param("shift_range_days").Default(0)).
+		Param(bloblang.NewAnyParam("entity").Optional()).
+		Param(bloblang.NewInt64Param(jitterParam).Default(0)).
+		Param(bloblang.NewStringParam("truncate_precision").Default(timeTruncatePrecisionMonth)).
+		Param(bloblang.NewStringParam("min").Optional()).
+		Param(bloblang.NewStringParam("max").Optional()).
+		Param(newDeterministicKeyParam())
+}
+
+func getTimeTransformOpts(args *bloblang.ParsedParams, jitterParam string) (*TimeTransformOpts, error) {
+	mode, err := args.GetString("mode")
+	if err != nil {
+		return nil, err
+	}
+	opts := &TimeTransformOpts{Mode: mode}
+
+	switch mode {
+	case timeTransformModeShift:
+		shiftRange, err := args.GetInt64("shift_range_days")
+		if err != nil {
+			return nil, err
+		}
+		entity, err := args.Get("entity")
+		if err != nil {
+			return nil, err
+		}
+		key, err := args.GetOptionalString(deterministicKeyParam)
+		if err != nil {
+			return nil, err
+		}
+		if key == nil {
+			return nil, errors.New("shift mode requires a deterministic key so that an entity is always shifted by the same offset")
+		}
+		offset, err := getTimeShiftOffsetDays(*key, entity, shiftRange)
+		if err != nil {
+			return nil, err
+		}
+		opts.ShiftOffsetDays = offset
+	case timeTransformModeJitter:
+		jitterRange, err := args.GetInt64(jitterParam)
+		if err != nil {
+			return nil, err
+		}
+		opts.JitterRange = jitterRange
+	case timeTransformModeTruncate:
+		precision, err := args.GetString("truncate_precision")
+		if err != nil {
+			return nil, err
+		}
+		if precision != timeTruncatePrecisionMonth && precision != timeTruncatePrecisionYear {
+			return nil, fmt.Errorf("unsupported truncate precision: %s", precision)
+		}
+		opts.TruncatePrecision = precision
+	case timeTransformModeClamp:
+		minValue, err := getOptionalTimeParam(args, "min")
+		if err != nil {
+			return nil, err
+		}
+		maxValue, err := getOptionalTimeParam(args, "max")
+		if err != nil {
+			return nil, err
+		}
+		if minValue != nil && maxValue != nil && minValue.After(*maxValue) {
+			return nil, errors.New("min must not be after max")
+		}
+		opts.Min = minValue
+		opts.Max = maxValue
+	default:
+		return nil, fmt.Errorf("unsupported mode: %s", mode)
+	}
+	return opts, nil
+}
+
+// Only jitter mode is random, every other mode always transforms a value to the same output
+func getTimeTransformRandomizer(args *bloblang.ParsedParams, opts *TimeTransformOpts, value any) (rng.Rand, error) {
+	if opts.Mode != timeTransformModeJitter {
+		return nil, nil
+	}
+	return getTransformRandomizer(args, fmt.Sprint(value))
+}
+
+// Derives the shift offset from the entity so that every value of the same entity is shifted by the same number of days.
+// Values without an entity all share the same offset.
+func getTimeShiftOffsetDays(key string, entity any, shiftRange int64) (int64, error) {
+	var entityStr string
+	switch e := entity.(type) {
+	case nil:
+	case []byte:
+		entityStr = string(e)
+	default:
+		entityStr = fmt.Sprint(e)
+	}
+	seed, err := transformer_utils.GetDeterministicSeed(key, entityStr)
+	if err != nil {
+		return 0, err
+	}
+	return transformer_utils.GenerateRandomInt64InValueRangeWithRandomizer(rng.New(seed), -shiftRange, shiftRange)
+}
+
+func getOptionalTimeParam(args *bloblang.ParsedParams, name string) (*time.Time, error) {
+	value, err := args.GetOptionalString(name)
+	if err != nil {
+		return nil, err
+	}
+	if value == nil || *value == "" {
+		return nil, nil
+	}
+	t, _, err := parseTime(*value)
+	if err != nil {
+		return nil, fmt.Errorf("invalid %s: %w", name, err)
+	}
+	return &t, nil
+}
+
+// Runs the transform function on a time.Time value or a string value in one of the supported layouts.
+// Postgres infinity values are returned as is.
+func transformTimeValue(value any, fn func(t time.Time) (time.Time, error)) (any, error) {
+	var str string
+	switch v := value.(type) {
+	case nil:
+		return nil, nil
+	case time.Time:
+		return fn(v)
+	case string:
+		str = v
+	case []byte:
+		str = string(v)
+	default:
+		return nil, fmt.Errorf("unsupported value type: %T", value)
+	}
+
+	if str == "" || isInfinityTime(str) {
+		return str, nil
+	}
+	t, layout, err := parseTime(str)
+	if err != nil {
+		return nil, err
+	}
+	res, err := fn(t)
+	if err != nil {
+		return nil, err
+	}
+	return res.Format(layout), nil
+}
+
+// Returns the parsed time and the layout that it was parsed with
+func parseTime(value string) (time.Time, string, error) {
+	for _, layout := range timeLayouts {
+		t, err := time.Parse(layout, value)
+		if err == nil {
+			return t, layout, nil
+		}
+	}
+	return time.Time{}, "", fmt.Errorf("unable to parse %q as a timestamp or date", value)
+}
+
+func isInfinityTime(value string) bool {
+	v := strings.ToLower(value)
+	return v == "infinity" || v == "-infinity"
+}
+
+func truncateTime(t time.Time, precision string) time.Time {
+	if precision == timeTruncatePrecisionYear {
+		return time.Date(t.Year(), time.January, 1, 0, 0, 0, 0, t.Location())
+	}
+	return time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, t.Location())
+}
@@ -0,0 +1,148 @@
+package transformers
+
+import (
+	"fmt"
+	"math/rand"
+	"testing"
+	"time"
+
+	"github.com/benthosdev/benthos/v4/public/bloblang"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const timeTransformTestKey = "0123456789abcdef0123456789abcdef"
+
+func Test_TransformTimestamp_Shift(t *testing.T) {
+	value := time.Date(2024, 3, 10, 14, 30, 0, 0, time.UTC)
+	res, err := TransformTimestamp(nil, value, &TimeTransformOpts{Mode: timeTransformModeShift, ShiftOffsetDays: -3})
+	assert.NoError(t, err)
+	assert.Equal(t, time.Date(2024, 3, 7, 14, 30, 0, 0, time.UTC), res)
+}
+
+func Test_TransformTimestamp_Jitter(t *testing.T) {
+	value := time.Date(2024, 3, 10, 14, 30, 0, 0, time.UTC)
+	res, err := TransformTimestamp(rand.New(rand.NewSource(1)), value, &TimeTransformOpts{Mode: timeTransformModeJitter, JitterRange: 60})
+	assert.NoError(t, err)
+
+	resTime, ok := res.(time.Time)
+	require.True(t, ok)
+	assert.WithinDuration(t, value, resTime, time.Minute)
+}
+
+func Test_TransformTimestamp_Truncate(t *testing.T) {
+	loc := time.FixedZone("", -5*60*60)
+	value := time.Date(2024, 3, 10, 14, 30, 0, 0, loc)
+
+	res, err := TransformTimestamp(nil, value, &TimeTransformOpts{Mode: timeTransformModeTruncate, TruncatePrecision: timeTruncatePrecisionMonth})
+	assert.NoError(t, err)
+	assert.Equal(t, time.Date(2024, 3, 1, 0, 0, 0, 0, loc), res)
+
+	res, err = TransformTimestamp(nil, value, &TimeTransformOpts{Mode: timeTransformModeTruncate, TruncatePrecision: timeTruncatePrecisionYear})
+	assert.NoError(t, err)
+	assert.Equal(t, time.Date(2024, 1, 1, 0, 0, 0, 0, loc), res)
+}
+
+func Test_TransformTimestamp_Clamp(t *testing.T) {
+	minValue := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	maxValue := time.Date(2023, 12, 31, 0, 0, 0, 0, time.UTC)
+	opts := &TimeTransformOpts{Mode: timeTransformModeClamp, Min: &minValue, Max: &maxValue}
+
+	tests := []struct {
+		value    time.Time
+		expected time.Time
+	}{
+		{value: time.Date(2019, 6, 1, 0, 0, 0, 0, time.UTC), expected: minValue},
+		{value: time.Date(2022, 6, 1, 0, 0, 0, 0, time.UTC), expected: time.Date(2022, 6, 1, 0, 0, 0, 0, time.UTC)},
+		{value: time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC), expected: maxValue},
+	}
+	for _, tt := range tests {
+		t.Run(tt.value.String(), func(t *testing.T) {
+			res, err := TransformTimestamp(nil, tt.value, opts)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, res)
+		})
+	}
+}
+
+func Test_TransformTimestamp_Strings(t *testing.T) {
+	opts := &TimeTransformOpts{Mode: timeTransformModeShift, ShiftOffsetDays: 2}
+
+	tests := []struct {
+		name     string
+		value    any
+		expected any
+	}{
+		{name: "rfc3339", value: "2024-03-10T14:30:00Z", expected: "2024-03-12T14:30:00Z"},
+		{name: "postgres timestamptz", value: "2024-03-10 14:30:00.123456+02", expected: "2024-03-12 14:30:00.123456+02"},
+		{name: "postgres timestamptz with minutes", value: "2024-03-10 14:30:00+05:30", expected: "2024-03-12 14:30:00+05:30"},
+		{name: "mysql datetime", value: "2024-03-10 14:30:00", expected: "2024-03-12 14:30:00"},
+		{name: "mysql datetime bytes", value: []byte("2024-03-10 14:30:00"), expected: "2024-03-12 14:30:00"},
+		{name: "date", value: "2024-03-10", expected: "2024-03-12"},
+		{name: "infinity", value: "infinity", expected: "infinity"},
+		{name: "nil", value: nil, expected: nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			res, err := TransformTimestamp(nil, tt.value, opts)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, res)
+		})
+	}
+}
+
+func Test_TransformTimestamp_InvalidValue(t *testing.T) {
+	opts := &TimeTransformOpts{Mode: timeTransformModeShift, ShiftOffsetDays: 2}
+
+	_, err := TransformTimestamp(nil, "not a timestamp", opts)
+	assert.Error(t, err)
+
+	_, err = TransformTimestamp(nil, true, opts)
+	assert.Error(t, err)
+}
+
+func Test_TransformTimestampTransformer_Shift(t *testing.T) {
+	mapping := fmt.Sprintf(`root = transform_timestamp(value:this.created_at,mode:"shift",shift_range_days:365,entity:this.user_id,deterministic_key:%q)`, timeTransformTestKey)
+	ex, err := bloblang.Parse(mapping)
+	require.NoError(t, err, "failed to parse the timestamp transformer")
+
+	signup := time.Date(2024, 1, 1, 9, 0, 0, 0, time.UTC)
+	firstOrder := time.Date(2024, 1, 2, 9, 0, 0, 0, time.UTC)
+
+	signupRes, err := ex.Query(map[string]any{"created_at": signup, "user_id": int64(42)})
+	assert.NoError(t, err)
+	firstOrderRes, err := ex.Query(map[string]any{"created_at": firstOrder, "user_id": int64(42)})
+	assert.NoError(t, err)
+
+	signupTime, ok := signupRes.(time.Time)
+	require.True(t, ok)
+	firstOrderTime, ok := firstOrderRes.(time.Time)
+	require.True(t, ok)
+	assert.Equal(t, firstOrder.Sub(signup), firstOrderTime.Sub(signupTime), "values of the same entity should be shifted by the same offset")
+	assert.WithinDuration(t, signup, signupTime, 365*24*time.Hour)
+}
+
+func Test_TransformTimestampTransformer_ShiftWithoutKey(t *testing.T) {
+	ex, err := bloblang.Parse(`root = transform_timestamp(value:this,mode:"shift",shift_range_days:365)`)
+	require.NoError(t, err)
+
+	_, err = ex.Query("2024-01-01T00:00:00Z")
+	assert.Error(t, err)
+}
+
+func Test_TransformTimestampTransformer_Clamp(t *testing.T) {
+	ex, err := bloblang.Parse(`root = transform_timestamp(value:this,mode:"clamp",min:"2020-01-01T00:00:00Z",max:"2023-12-31T00:00:00Z")`)
+	require.NoError(t, err)
+
+	res, err := ex.Query("2024-06-01 10:00:00")
+	assert.NoError(t, err)
+	assert.Equal(t, "2023-12-31 00:00:00", res)
+}
+
+func Test_TransformTimestampTransformer_InvalidMode(t *testing.T) {
+	ex, err := bloblang.Parse(`root = transform_timestamp(value:this,mode:"randomize")`)
+	require.NoError(t, err)
+
+	_, err = ex.Query("2024-01-01T00:00:00Z")
+	assert.Error(t, err)
+}
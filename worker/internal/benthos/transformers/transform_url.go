@@ -0,0 +1,155 @@
+package transformers
+
+import (
+	"fmt"
+	"net"
+	"net/netip"
+	"net/url"
+	"path"
+	"strings"
+
+	"github.com/benthosdev/benthos/v4/public/bloblang"
+	"github.com/nucleuscloud/neosync/worker/internal/rng"
+)
+
+func init() {
+	spec := bloblang.NewPluginSpec().
+		Param(bloblang.NewAnyParam("value").Optional()).
+		Param(bloblang.NewBoolParam("preserve_path").Default(false)).
+		Param(newDeterministicKeyParam())
+
+	err := bloblang.RegisterFunctionV2("transform_url", spec, func(args *bloblang.ParsedParams) (bloblang.Function, error) {
+		valuePtr, err := args.GetOptionalString("value")
+		if err != nil {
+			return nil, err
+		}
+
+		var value string
+		if valuePtr != nil {
+			value = *valuePtr
+		}
+
+		preservePath, err := args.GetBool("preserve_path")
+		if err != nil {
+			return nil, err
+		}
+
+		randomizer, err := getTransformRandomizer(args, value)
+		if err != nil {
+			return nil, err
+		}
+
+		return func() (any, error) {
+			res, err := TransformUrl(randomizer, value, preservePath)
+			if err != nil {
+				return nil, fmt.Errorf("unable to run transform_url: %w", err)
+			}
+			return res, nil
+		}, nil
+	})
+
+	if err != nil {
+		panic(err)
+	}
+}
+
+/*
+Transforms an absolute URL by replacing its host, user info and query values while keeping its scheme, port and query keys.
+Unless preservePath is true, the letters and digits of the path and fragment are replaced while keeping their separators and the file extension.
+
+For example: https://api.example.com:8443/users/123/avatar.png?size=large&v=2 -> https://lqe.wkbnsle.com:8443/fkxmr/904/qmtwzb.png?size=pkrvm&v=7
+*/
+func TransformUrl(randomizer rng.Rand, value string, preservePath bool) (*string, error) {
+	if value == "" {
+		return nil, nil
+	}
+
+	parsed, err := url.Parse(value)
+	if err != nil {
+		return nil, fmt.Errorf("value is not a valid url: %w", err)
+	}
+	if parsed.Scheme == "" || parsed.Host == "" {
+		return nil, fmt.Errorf("value is not an absolute url: %s", value)
+	}
+
+	host, err := transformUrlHost(randomizer, parsed.Hostname(), parsed.Port())
+	if err != nil {
+		return nil, err
+	}
+	parsed.Host = host
+
+	if parsed.User != nil {
+		username := scrambleAlphanumeric(randomizer, parsed.User.Username())
+		if password, ok := parsed.User.Password(); ok {
+			parsed.User = url.UserPassword(username, scrambleAlphanumeric(randomizer, password))
+		} else {
+			parsed.User = url.User(username)
+		}
+	}
+
+	if !preservePath {
+		parsed.Path = scrambleUrlPath(randomizer, parsed.Path)
+		parsed.RawPath = ""
+		parsed.Fragment = scrambleAlphanumeric(randomizer, parsed.Fragment)
+		parsed.RawFragment = ""
+	}
+
+	parsed.RawQuery = transformUrlQuery(randomizer, parsed.RawQuery)
+
+	res := parsed.String()
+	return &res, nil
+}
+
+func transformUrlHost(randomizer rng.Rand, hostname, port string) (string, error) {
+	var host *string
+	var err error
+	if addr, parseErr := netip.ParseAddr(hostname); parseErr == nil {
+		host, err = TransformIpAddress(randomizer, addr.String(), 0, false)
+	} else {
+		host, err = TransformDomain(randomizer, hostname, true)
+	}
+	if err != nil {
+		return "", err
+	}
+	if host == nil {
+		return "", fmt.Errorf("url does not have a host")
+	}
+
+	if port != "" {
+		return net.JoinHostPort(*host, port), nil
+	}
+	if strings.Contains(*host, ":") {
+		// ipv6 hosts must be wrapped in brackets
+		return fmt.Sprintf("[%s]", *host), nil
+	}
+	return *host, nil
+}
+
+// Scrambles each segment of the path while keeping the extension of the last segment
+func scrambleUrlPath(randomizer rng.Rand, urlPath string) string {
+	ext := path.Ext(urlPath)
+	if strings.Contains(ext, "/") {
+		ext = ""
+	}
+	return scrambleAlphanumeric(randomizer, strings.TrimSuffix(urlPath, ext)) + ext
+}
+
+// Replaces the value of every query param while keeping the keys and their order
+func transformUrlQuery(randomizer rng.Rand, rawQuery string) string {
+	if rawQuery == "" {
+		return rawQuery
+	}
+	params := strings.Split(rawQuery, "&")
+	for idx, param := range params {
+		key, value, ok := strings.Cut(param, "=")
+		if !ok || value == "" {
+			continue
+		}
+		decoded, err := url.QueryUnescape(value)
+		if err != nil {
+			decoded = value
+		}
+		params[idx] = fmt.Sprintf("%s=%s", key, url.QueryEscape(scrambleAlphanumeric(randomizer, decoded)))
+	}
+	return strings.Join(params, "&")
+}
@@ -2,15 +2,21 @@ package transformer_utils
 
 import (
 	"fmt"
-	"math/rand"
 	"strconv"
 	"strings"
+
+	"github.com/nucleuscloud/neosync/worker/internal/rng"
 )
 
 /* FLOAT MANIPULATION UTILS */
 
 // Generates a random float64 in the range of the min and max float64 values
 func GenerateRandomFloat64WithInclusiveBounds(min, max float64) (float64, error) {
+	return GenerateRandomFloat64WithInclusiveBoundsWithRandomizer(GlobalRandomizer, min, max)
+}
+
+// Generates a random float64 in the range of the min and max float64 values using the provided randomizer
+func GenerateRandomFloat64WithInclusiveBoundsWithRandomizer(randomizer rng.Rand, min, max float64) (float64, error) {
 	if min > max {
 		min, max = max, min
 	}
@@ -20,8 +26,7 @@ func GenerateRandomFloat64WithInclusiveBounds(min, max float64) (float64, error)
 	}
 
 	// generates a rand float64 value from [0.0,1.0)
-	//nolint:all
-	randValue := rand.Float64()
+	randValue := randomizer.Float64()
 
 	// Scale and shift the value to the range
 	returnValue := min + randValue*(max-min)
@@ -1,16 +1,26 @@
 package transformer_utils
 
 import (
+	"crypto/hmac"
 	crypto "crypto/rand"
+	"crypto/sha256"
+	"encoding/binary"
+	"errors"
 	"fmt"
 	"math"
 	"math/big"
-	"math/rand"
 	"strconv"
+
+	"github.com/nucleuscloud/neosync/worker/internal/rng"
 )
 
 /* Generates a random int64 of length l. For example, given a length of 4, possible values will always have a length of 4 digits. */
 func GenerateRandomInt64FixedLength(l int64) (int64, error) {
+	return GenerateRandomInt64FixedLengthWithRandomizer(GlobalRandomizer, l)
+}
+
+// Generates a random int64 of length l using the provided randomizer
+func GenerateRandomInt64FixedLengthWithRandomizer(randomizer rng.Rand, l int64) (int64, error) {
 	if l <= 0 {
 		return 0, fmt.Errorf("the length has to be greater than zero")
 	}
@@ -24,14 +34,18 @@ func GenerateRandomInt64FixedLength(l int64) (int64, error) {
 	max := int64(math.Pow10(int(l))) - 1
 
 	// Generate a random number in the range
-	//nolint:gosec
-	return min + rand.Int63n(max-min+1), nil
+	return min + randomizer.Int63n(max-min+1), nil
 }
 
 /*
 Generates a random int64 with length in the inclusive range of [min, max]. For example, given a length range of [4, 7], possible values will have a length ranging from 4 -> 7 digits.
 */
 func GenerateRandomInt64InLengthRange(min, max int64) (int64, error) {
+	return GenerateRandomInt64InLengthRangeWithRandomizer(GlobalRandomizer, min, max)
+}
+
+// Generates a random int64 with length in the inclusive range of [min, max] using the provided randomizer
+func GenerateRandomInt64InLengthRangeWithRandomizer(randomizer rng.Rand, min, max int64) (int64, error) {
 	if min > max {
 		min, max = max, min
 	}
@@ -41,12 +55,12 @@ func GenerateRandomInt64InLengthRange(min, max int64) (int64, error) {
 		return 0, fmt.Errorf("length of integer must not exceed 19")
 	}
 
-	val, err := GenerateRandomInt64InValueRange(min, max)
+	val, err := GenerateRandomInt64InValueRangeWithRandomizer(randomizer, min, max)
 	if err != nil {
 		return 0, fmt.Errorf("unable to generate a value in the range provided [%d:%d]: %w", min, max, err)
 	}
 
-	res, err := GenerateRandomInt64FixedLength(val)
+	res, err := GenerateRandomInt64FixedLengthWithRandomizer(randomizer, val)
 	if err != nil {
 		return 0, fmt.Errorf("unable to generate fixed int64 in the range provided [%d:%d: %w]", min, max, err)
 	}
@@ -56,6 +70,11 @@ func GenerateRandomInt64InLengthRange(min, max int64) (int64, error) {
 
 /* Generates a random int64 in the inclusive range of [min, max]. For example, given a range of [40, 50], possible values range from 40 -> 50, inclusive. */
 func GenerateRandomInt64InValueRange(min, max int64) (int64, error) {
+	return GenerateRandomInt64InValueRangeWithRandomizer(GlobalRandomizer, min, max)
+}
+
+// Generates a random int64 in the inclusive range of [min, max] using the provided randomizer
+func GenerateRandomInt64InValueRangeWithRandomizer(randomizer rng.Rand, min, max int64) (int64, error) {
 	if min > max {
 		min, max = max, min
 	}
@@ -65,8 +84,7 @@ func GenerateRandomInt64InValueRange(min, max int64) (int64, error) {
 	}
 
 	rangeVal := max - min + 1
-	//nolint:gosec
-	return min + rand.Int63n(rangeVal), nil
+	return min + randomizer.Int63n(rangeVal), nil
 }
 
 // gets the number of digits in an int64
@@ -157,3 +175,17 @@ func GenerateCryptoSeed() (int64, error) {
 	}
 	return n.Int64(), nil
 }
+
+// Derives a seed from the HMAC-SHA256 of the value using the key.
+// The same key and value always result in the same seed, which allows a transformer to consistently map an input to the same output.
+func GetDeterministicSeed(key, value string) (int64, error) {
+	if key == "" {
+		return -1, errors.New("key must not be empty when generating a deterministic seed")
+	}
+	mac := hmac.New(sha256.New, []byte(key))
+	_, err := mac.Write([]byte(value))
+	if err != nil {
+		return -1, err
+	}
+	return int64(binary.BigEndian.Uint64(mac.Sum(nil)[:8]) >> 1), nil
+}
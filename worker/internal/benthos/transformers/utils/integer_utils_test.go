@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/nucleuscloud/neosync/worker/internal/rng"
 	"github.com/nucleuscloud/neosync/worker/pkg/workflows/datasync/activities/shared"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -201,3 +202,34 @@ func Test_ClampInts(t *testing.T) {
 		})
 	}
 }
+
+func Test_GetDeterministicSeed(t *testing.T) {
+	seed, err := GetDeterministicSeed("key", "alice@corp.com")
+	require.NoError(t, err)
+	require.GreaterOrEqual(t, seed, int64(0))
+
+	same, err := GetDeterministicSeed("key", "alice@corp.com")
+	require.NoError(t, err)
+	assert.Equal(t, seed, same, "the same key and value must always produce the same seed")
+
+	otherValue, err := GetDeterministicSeed("key", "bob@corp.com")
+	require.NoError(t, err)
+	assert.NotEqual(t, seed, otherValue)
+
+	otherKey, err := GetDeterministicSeed("other-key", "alice@corp.com")
+	require.NoError(t, err)
+	assert.NotEqual(t, seed, otherKey)
+
+	_, err = GetDeterministicSeed("", "alice@corp.com")
+	assert.Error(t, err)
+}
+
+func Test_GenerateRandomInt64InValueRangeWithRandomizer(t *testing.T) {
+	first, err := GenerateRandomInt64InValueRangeWithRandomizer(rng.New(1), 1, 1000000)
+	require.NoError(t, err)
+	second, err := GenerateRandomInt64InValueRangeWithRandomizer(rng.New(1), 1, 1000000)
+	require.NoError(t, err)
+	assert.Equal(t, first, second, "randomizers with the same seed must generate the same value")
+	assert.GreaterOrEqual(t, first, int64(1))
+	assert.LessOrEqual(t, first, int64(1000000))
+}
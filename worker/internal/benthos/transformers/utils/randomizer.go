@@ -0,0 +1,25 @@
+package transformer_utils
+
+import (
+	"math/rand"
+
+	"github.com/nucleuscloud/neosync/worker/internal/rng"
+)
+
+// Randomizer backed by the math/rand global source.
+// Used by the generate functions that do not take in a seeded randomizer.
+var GlobalRandomizer rng.Rand = globalRandomizer{}
+
+type globalRandomizer struct{}
+
+func (globalRandomizer) Int63n(n int64) int64 {
+	return rand.Int63n(n) //nolint:gosec
+}
+
+func (globalRandomizer) Intn(n int) int {
+	return rand.Intn(n) //nolint:gosec
+}
+
+func (globalRandomizer) Float64() float64 {
+	return rand.Float64() //nolint:gosec
+}
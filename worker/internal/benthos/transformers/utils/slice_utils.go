@@ -3,7 +3,8 @@ package transformer_utils
 import (
 	"errors"
 	"math"
-	"math/rand"
+
+	"github.com/nucleuscloud/neosync/worker/internal/rng"
 )
 
 const alphanumeric = "ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz1234567890"
@@ -12,13 +13,17 @@ const alphanumeric = "ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz123456
 
 // returns a random index from a one-dimensional slice
 func GetRandomValueFromSlice[T any](arr []T) (T, error) {
+	return GetRandomValueFromSliceWithRandomizer(GlobalRandomizer, arr)
+}
+
+// returns a random index from a one-dimensional slice using the provided randomizer
+func GetRandomValueFromSliceWithRandomizer[T any](randomizer rng.Rand, arr []T) (T, error) {
 	if len(arr) == 0 {
 		var zeroValue T
 		return zeroValue, errors.New("slice is empty")
 	}
 
-	//nolint:gosec
-	randomIndex := rand.Intn(len(arr))
+	randomIndex := randomizer.Intn(len(arr))
 
 	return arr[randomIndex], nil
 }
@@ -3,7 +3,6 @@ package transformer_utils
 import (
 	"errors"
 	"fmt"
-	"math/rand"
 	"regexp"
 	"strings"
 	"unicode"
@@ -43,6 +42,11 @@ func SliceString(s string, l int) string {
 
 // Generate a random alphanumeric string of length l
 func GenerateRandomStringWithDefinedLength(length int64) (string, error) {
+	return GenerateRandomStringWithDefinedLengthWithRandomizer(GlobalRandomizer, length)
+}
+
+// Generate a random alphanumeric string of length l using the provided randomizer
+func GenerateRandomStringWithDefinedLengthWithRandomizer(randomizer rng.Rand, length int64) (string, error) {
 	if length < 1 {
 		return "", fmt.Errorf("the length of the string can't be less than 1")
 	}
@@ -50,8 +54,7 @@ func GenerateRandomStringWithDefinedLength(length int64) (string, error) {
 	result := make([]byte, length)
 	for i := int64(0); i < length; i++ {
 		// Generate a random index in the range [0, len(alphabet))
-		//nolint:all
-		index := rand.Intn(len(alphanumeric))
+		index := randomizer.Intn(len(alphanumeric))
 		// Get the character at the generated index and append it to the result
 		result[i] = alphanumeric[index]
 	}
@@ -60,6 +63,11 @@ func GenerateRandomStringWithDefinedLength(length int64) (string, error) {
 
 // Generate a random alphanumeric string within the interval [min, max]
 func GenerateRandomStringWithInclusiveBounds(min, max int64) (string, error) {
+	return GenerateRandomStringWithInclusiveBoundsWithRandomizer(GlobalRandomizer, min, max)
+}
+
+// Generate a random alphanumeric string within the interval [min, max] using the provided randomizer
+func GenerateRandomStringWithInclusiveBoundsWithRandomizer(randomizer rng.Rand, min, max int64) (string, error) {
 	if min < 0 || max < 0 || min > max {
 		return "", fmt.Errorf("invalid bounds when attempting to generate random string: [%d:%d]", min, max)
 	}
@@ -68,7 +76,7 @@ func GenerateRandomStringWithInclusiveBounds(min, max int64) (string, error) {
 	if min == max {
 		length = min
 	} else {
-		randlength, err := GenerateRandomInt64InValueRange(min, max)
+		randlength, err := GenerateRandomInt64InValueRangeWithRandomizer(randomizer, min, max)
 		if err != nil {
 			return "", fmt.Errorf("unable to generate a random length for the string within range [%d:%d]: %w", min, max, err)
 		}
@@ -78,7 +86,7 @@ func GenerateRandomStringWithInclusiveBounds(min, max int64) (string, error) {
 	result := make([]byte, length)
 	for i := int64(0); i < length; i++ {
 		// Generate a random index in the range [0, len(alphabet))
-		index := rand.Intn(len(alphanumeric)) //nolint:gosec
+		index := randomizer.Intn(len(alphanumeric))
 		// Get the character at the generated index and append it to the result
 		result[i] = alphanumeric[index]
 	}
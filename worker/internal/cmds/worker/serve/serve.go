@@ -16,13 +16,21 @@ import (
 	"github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1/mgmtv1alpha1connect"
 	"github.com/nucleuscloud/neosync/backend/pkg/sqlconnect"
 	logger_utils "github.com/nucleuscloud/neosync/worker/internal/logger"
+	pgcdc_activity "github.com/nucleuscloud/neosync/worker/pkg/workflows/cdc/activities/pg-cdc"
+	cdc_workflow "github.com/nucleuscloud/neosync/worker/pkg/workflows/cdc/workflow"
 	genbenthosconfigs_activity "github.com/nucleuscloud/neosync/worker/pkg/workflows/datasync/activities/gen-benthos-configs"
+	publishjobrunevent_activity "github.com/nucleuscloud/neosync/worker/pkg/workflows/datasync/activities/publish-job-run-event"
 	runsqlinittablestmts_activity "github.com/nucleuscloud/neosync/worker/pkg/workflows/datasync/activities/run-sql-init-table-stmts"
 	"github.com/nucleuscloud/neosync/worker/pkg/workflows/datasync/activities/shared"
 	sync_activity "github.com/nucleuscloud/neosync/worker/pkg/workflows/datasync/activities/sync"
 	syncactivityopts_activity "github.com/nucleuscloud/neosync/worker/pkg/workflows/datasync/activities/sync-activity-opts"
+	syncjobwatermarks_activity "github.com/nucleuscloud/neosync/worker/pkg/workflows/datasync/activities/sync-job-watermarks"
 	syncrediscleanup_activity "github.com/nucleuscloud/neosync/worker/pkg/workflows/datasync/activities/sync-redis-clean-up"
+	triggerdependentjobs_activity "github.com/nucleuscloud/neosync/worker/pkg/workflows/datasync/activities/trigger-dependent-jobs"
+	validatejobrun_activity "github.com/nucleuscloud/neosync/worker/pkg/workflows/datasync/activities/validate-job-run"
 	datasync_workflow "github.com/nucleuscloud/neosync/worker/pkg/workflows/datasync/workflow"
+	previewjobmappings_activity "github.com/nucleuscloud/neosync/worker/pkg/workflows/preview/activities/preview-job-mappings"
+	preview_workflow "github.com/nucleuscloud/neosync/worker/pkg/workflows/preview/workflow"
 
 	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/attribute"
@@ -126,6 +134,7 @@ func serve(ctx context.Context) error {
 	connclient := mgmtv1alpha1connect.NewConnectionServiceClient(httpclient, neosyncurl)
 	jobclient := mgmtv1alpha1connect.NewJobServiceClient(httpclient, neosyncurl)
 	transformerclient := mgmtv1alpha1connect.NewTransformersServiceClient(httpclient, neosyncurl)
+	useraccountclient := mgmtv1alpha1connect.NewUserAccountServiceClient(httpclient, neosyncurl)
 	sqlconnector := &sqlconnect.SqlOpenConnector{}
 	redisconfig := shared.GetRedisConfig()
 
@@ -137,14 +146,28 @@ func serve(ctx context.Context) error {
 		redisconfig,
 		getIsOtelEnabled(),
 	)
-	syncActivity := sync_activity.New(connclient, &sync.Map{}, temporalClient, activityMeter, sync_activity.NewBenthosStreamManager())
+	syncActivity := sync_activity.New(connclient, useraccountclient, &sync.Map{}, temporalClient, activityMeter, sync_activity.NewBenthosStreamManager())
+
+	pgcdcActivity := pgcdc_activity.New(jobclient, connclient, transformerclient, useraccountclient, sqlconnector)
+
+	previewActivity := previewjobmappings_activity.New(connclient, transformerclient, useraccountclient, sqlconnector)
 
 	w.RegisterWorkflow(datasync_workflow.Workflow)
+	w.RegisterWorkflow(cdc_workflow.CdcWorkflow)
+	w.RegisterWorkflow(preview_workflow.PreviewWorkflow)
 	w.RegisterActivity(syncActivity.Sync)
 	w.RegisterActivity(syncactivityopts_activity.RetrieveActivityOptions)
 	w.RegisterActivity(runsqlinittablestmts_activity.RunSqlInitTableStatements)
 	w.RegisterActivity(syncrediscleanup_activity.DeleteRedisHash)
+	w.RegisterActivity(syncjobwatermarks_activity.SetJobRunWatermarks)
+	w.RegisterActivity(validatejobrun_activity.ValidateJobRun)
+	w.RegisterActivity(publishjobrunevent_activity.PublishJobRunEvent)
+	w.RegisterActivity(triggerdependentjobs_activity.TriggerDependentJobs)
 	w.RegisterActivity(genbenthosActivity.GenerateBenthosConfigs)
+	w.RegisterActivity(pgcdcActivity.EnsureReplicationSlot)
+	w.RegisterActivity(pgcdcActivity.DropReplicationSlot)
+	w.RegisterActivity(pgcdcActivity.StreamChanges)
+	w.RegisterActivity(previewActivity.PreviewJobMappings)
 
 	if err := w.Start(); err != nil {
 		return err
@@ -0,0 +1,44 @@
+package pgoutput
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Log Sequence Number. A position within the Postgres write-ahead log.
+type LSN uint64
+
+// Formats the LSN the same way Postgres does. Ex: 16/B374D848
+func (l LSN) String() string {
+	return fmt.Sprintf("%X/%X", uint32(l>>32), uint32(l))
+}
+
+// Parses an LSN in the Postgres text format. Ex: 16/B374D848
+func ParseLSN(input string) (LSN, error) {
+	upper, lower, ok := strings.Cut(input, "/")
+	if !ok {
+		return 0, fmt.Errorf("invalid lsn format: %s", input)
+	}
+	upperVal, err := strconv.ParseUint(upper, 16, 32)
+	if err != nil {
+		return 0, fmt.Errorf("invalid lsn format: %s: %w", input, err)
+	}
+	lowerVal, err := strconv.ParseUint(lower, 16, 32)
+	if err != nil {
+		return 0, fmt.Errorf("invalid lsn format: %s: %w", input, err)
+	}
+	return LSN(upperVal<<32 | lowerVal), nil
+}
+
+// Timestamps in the replication protocol are microseconds since the Postgres epoch
+var postgresEpoch = time.Date(2000, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+func pgTimeToTime(microsecs int64) time.Time {
+	return postgresEpoch.Add(time.Duration(microsecs) * time.Microsecond)
+}
+
+func timeToPgTime(t time.Time) int64 {
+	return t.Sub(postgresEpoch).Microseconds()
+}
@@ -0,0 +1,308 @@
+package pgoutput
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// Message types of the pgoutput logical replication protocol (version 1)
+const (
+	MessageTypeBegin    = 'B'
+	MessageTypeCommit   = 'C'
+	MessageTypeOrigin   = 'O'
+	MessageTypeRelation = 'R'
+	MessageTypeType     = 'Y'
+	MessageTypeInsert   = 'I'
+	MessageTypeUpdate   = 'U'
+	MessageTypeDelete   = 'D'
+	MessageTypeTruncate = 'T'
+	MessageTypeMessage  = 'M'
+)
+
+// Kinds of column values that may be present in a tuple
+const (
+	TupleDataTypeNull   = 'n'
+	TupleDataTypeToast  = 'u'
+	TupleDataTypeText   = 't'
+	TupleDataTypeBinary = 'b'
+)
+
+// Identifies which tuple was sent alongside an update or delete
+const (
+	// The old tuple only contains the columns that make up the replica identity of the table
+	TupleTypeKey = 'K'
+	// The old tuple contains every column as the table has a replica identity of full
+	TupleTypeOld = 'O'
+	TupleTypeNew = 'N'
+)
+
+type Message interface {
+	Type() byte
+}
+
+type BeginMessage struct {
+	// The lsn of the commit record of the transaction
+	FinalLSN   LSN
+	CommitTime time.Time
+	Xid        uint32
+}
+
+func (m *BeginMessage) Type() byte { return MessageTypeBegin }
+
+type CommitMessage struct {
+	Flags     uint8
+	CommitLSN LSN
+	// The lsn directly after the commit record. Streaming can be safely resumed from this position.
+	TransactionEndLSN LSN
+	CommitTime        time.Time
+}
+
+func (m *CommitMessage) Type() byte { return MessageTypeCommit }
+
+type RelationMessageColumn struct {
+	Flags        uint8
+	Name         string
+	DataType     uint32
+	TypeModifier int32
+}
+
+// Whether the column is part of the table's replica identity
+func (c *RelationMessageColumn) IsKey() bool {
+	return c.Flags&1 == 1
+}
+
+// Describes a table. Always sent before the first change to a table within a stream and again whenever its definition changes.
+type RelationMessage struct {
+	RelationID      uint32
+	Namespace       string
+	RelationName    string
+	ReplicaIdentity uint8
+	Columns         []*RelationMessageColumn
+}
+
+func (m *RelationMessage) Type() byte { return MessageTypeRelation }
+
+type TupleDataColumn struct {
+	DataType uint8
+	Data     []byte
+}
+
+type TupleData struct {
+	Columns []*TupleDataColumn
+}
+
+type InsertMessage struct {
+	RelationID uint32
+	Tuple      *TupleData
+}
+
+func (m *InsertMessage) Type() byte { return MessageTypeInsert }
+
+type UpdateMessage struct {
+	RelationID uint32
+	// Either TupleTypeKey, TupleTypeOld or 0 if the old tuple was not sent
+	OldTupleType uint8
+	OldTuple     *TupleData
+	NewTuple     *TupleData
+}
+
+func (m *UpdateMessage) Type() byte { return MessageTypeUpdate }
+
+type DeleteMessage struct {
+	RelationID   uint32
+	OldTupleType uint8
+	OldTuple     *TupleData
+}
+
+func (m *DeleteMessage) Type() byte { return MessageTypeDelete }
+
+type TruncateMessage struct {
+	Options     uint8
+	RelationIDs []uint32
+}
+
+func (m *TruncateMessage) Type() byte { return MessageTypeTruncate }
+
+// Messages that are part of the protocol but carry nothing that needs to be applied to a destination (origin, type, logical decoding messages)
+type IgnoredMessage struct {
+	MessageType byte
+}
+
+func (m *IgnoredMessage) Type() byte { return m.MessageType }
+
+// Parses a single pgoutput message from the WALData of an XLogData message
+func Parse(data []byte) (Message, error) {
+	if len(data) == 0 {
+		return nil, errors.New("unable to parse empty pgoutput message")
+	}
+	d := &decoder{buf: data[1:]}
+	var msg Message
+	switch data[0] {
+	case MessageTypeBegin:
+		msg = &BeginMessage{
+			FinalLSN:   LSN(d.uint64()),
+			CommitTime: pgTimeToTime(int64(d.uint64())),
+			Xid:        d.uint32(),
+		}
+	case MessageTypeCommit:
+		msg = &CommitMessage{
+			Flags:             d.uint8(),
+			CommitLSN:         LSN(d.uint64()),
+			TransactionEndLSN: LSN(d.uint64()),
+			CommitTime:        pgTimeToTime(int64(d.uint64())),
+		}
+	case MessageTypeRelation:
+		msg = d.relation()
+	case MessageTypeInsert:
+		relationId := d.uint32()
+		if tupleType := d.uint8(); tupleType != TupleTypeNew {
+			return nil, fmt.Errorf("unexpected tuple type for insert message: %c", tupleType)
+		}
+		msg = &InsertMessage{RelationID: relationId, Tuple: d.tupleData()}
+	case MessageTypeUpdate:
+		update := &UpdateMessage{RelationID: d.uint32()}
+		tupleType := d.uint8()
+		if tupleType == TupleTypeKey || tupleType == TupleTypeOld {
+			update.OldTupleType = tupleType
+			update.OldTuple = d.tupleData()
+			tupleType = d.uint8()
+		}
+		if tupleType != TupleTypeNew {
+			return nil, fmt.Errorf("unexpected tuple type for update message: %c", tupleType)
+		}
+		update.NewTuple = d.tupleData()
+		msg = update
+	case MessageTypeDelete:
+		relationId := d.uint32()
+		tupleType := d.uint8()
+		if tupleType != TupleTypeKey && tupleType != TupleTypeOld {
+			return nil, fmt.Errorf("unexpected tuple type for delete message: %c", tupleType)
+		}
+		msg = &DeleteMessage{RelationID: relationId, OldTupleType: tupleType, OldTuple: d.tupleData()}
+	case MessageTypeTruncate:
+		count := d.uint32()
+		truncate := &TruncateMessage{Options: d.uint8()}
+		for i := uint32(0); i < count && d.err == nil; i++ {
+			truncate.RelationIDs = append(truncate.RelationIDs, d.uint32())
+		}
+		msg = truncate
+	case MessageTypeOrigin, MessageTypeType, MessageTypeMessage:
+		return &IgnoredMessage{MessageType: data[0]}, nil
+	default:
+		return nil, fmt.Errorf("unknown pgoutput message type: %c", data[0])
+	}
+	if d.err != nil {
+		return nil, fmt.Errorf("unable to parse pgoutput message of type %c: %w", data[0], d.err)
+	}
+	return msg, nil
+}
+
+var errShortMessage = errors.New("message is shorter than expected")
+
+// Reads big endian values off of a message. Once a read fails, every following read returns a zero value and the error is retained.
+type decoder struct {
+	buf []byte
+	err error
+}
+
+func (d *decoder) next(n int) []byte {
+	if d.err != nil {
+		return nil
+	}
+	if n < 0 || len(d.buf) < n {
+		d.err = errShortMessage
+		return nil
+	}
+	out := d.buf[:n]
+	d.buf = d.buf[n:]
+	return out
+}
+
+func (d *decoder) uint8() uint8 {
+	b := d.next(1)
+	if b == nil {
+		return 0
+	}
+	return b[0]
+}
+
+func (d *decoder) uint16() uint16 {
+	b := d.next(2)
+	if b == nil {
+		return 0
+	}
+	return binary.BigEndian.Uint16(b)
+}
+
+func (d *decoder) uint32() uint32 {
+	b := d.next(4)
+	if b == nil {
+		return 0
+	}
+	return binary.BigEndian.Uint32(b)
+}
+
+func (d *decoder) uint64() uint64 {
+	b := d.next(8)
+	if b == nil {
+		return 0
+	}
+	return binary.BigEndian.Uint64(b)
+}
+
+// Strings are null terminated
+func (d *decoder) string() string {
+	if d.err != nil {
+		return ""
+	}
+	for i, b := range d.buf {
+		if b == 0 {
+			out := string(d.buf[:i])
+			d.buf = d.buf[i+1:]
+			return out
+		}
+	}
+	d.err = errShortMessage
+	return ""
+}
+
+func (d *decoder) relation() *RelationMessage {
+	msg := &RelationMessage{
+		RelationID:      d.uint32(),
+		Namespace:       d.string(),
+		RelationName:    d.string(),
+		ReplicaIdentity: d.uint8(),
+	}
+	count := d.uint16()
+	for i := uint16(0); i < count && d.err == nil; i++ {
+		msg.Columns = append(msg.Columns, &RelationMessageColumn{
+			Flags:        d.uint8(),
+			Name:         d.string(),
+			DataType:     d.uint32(),
+			TypeModifier: int32(d.uint32()),
+		})
+	}
+	return msg
+}
+
+func (d *decoder) tupleData() *TupleData {
+	count := d.uint16()
+	tuple := &TupleData{}
+	for i := uint16(0); i < count && d.err == nil; i++ {
+		col := &TupleDataColumn{DataType: d.uint8()}
+		switch col.DataType {
+		case TupleDataTypeNull, TupleDataTypeToast:
+		case TupleDataTypeText, TupleDataTypeBinary:
+			length := d.uint32()
+			col.Data = d.next(int(length))
+		default:
+			if d.err == nil {
+				d.err = fmt.Errorf("unknown tuple data type: %c", col.DataType)
+			}
+		}
+		tuple.Columns = append(tuple.Columns, col)
+	}
+	return tuple
+}
@@ -0,0 +1,175 @@
+package pgoutput
+
+import (
+	"encoding/binary"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type testEncoder struct {
+	buf []byte
+}
+
+func (e *testEncoder) byte(b byte) *testEncoder {
+	e.buf = append(e.buf, b)
+	return e
+}
+
+func (e *testEncoder) uint16(v uint16) *testEncoder {
+	e.buf = binary.BigEndian.AppendUint16(e.buf, v)
+	return e
+}
+
+func (e *testEncoder) uint32(v uint32) *testEncoder {
+	e.buf = binary.BigEndian.AppendUint32(e.buf, v)
+	return e
+}
+
+func (e *testEncoder) uint64(v uint64) *testEncoder {
+	e.buf = binary.BigEndian.AppendUint64(e.buf, v)
+	return e
+}
+
+func (e *testEncoder) string(v string) *testEncoder {
+	e.buf = append(e.buf, []byte(v)...)
+	e.buf = append(e.buf, 0)
+	return e
+}
+
+func (e *testEncoder) text(v string) *testEncoder {
+	return e.byte(TupleDataTypeText).uint32(uint32(len(v))).bytes([]byte(v))
+}
+
+func (e *testEncoder) bytes(v []byte) *testEncoder {
+	e.buf = append(e.buf, v...)
+	return e
+}
+
+func Test_Parse_Begin(t *testing.T) {
+	commitTime := time.Date(2024, time.April, 1, 12, 0, 0, 0, time.UTC)
+	enc := (&testEncoder{}).byte(MessageTypeBegin).uint64(100).uint64(uint64(timeToPgTime(commitTime))).uint32(42)
+
+	msg, err := Parse(enc.buf)
+	require.NoError(t, err)
+	assert.Equal(t, &BeginMessage{FinalLSN: 100, CommitTime: commitTime, Xid: 42}, msg)
+}
+
+func Test_Parse_Commit(t *testing.T) {
+	commitTime := time.Date(2024, time.April, 1, 12, 0, 0, 0, time.UTC)
+	enc := (&testEncoder{}).byte(MessageTypeCommit).byte(0).uint64(100).uint64(108).uint64(uint64(timeToPgTime(commitTime)))
+
+	msg, err := Parse(enc.buf)
+	require.NoError(t, err)
+	assert.Equal(t, &CommitMessage{CommitLSN: 100, TransactionEndLSN: 108, CommitTime: commitTime}, msg)
+}
+
+func Test_Parse_Relation(t *testing.T) {
+	enc := (&testEncoder{}).byte(MessageTypeRelation).
+		uint32(16384).string("public").string("users").byte('d').uint16(2).
+		byte(1).string("id").uint32(23).uint32(0xFFFFFFFF).
+		byte(0).string("name").uint32(25).uint32(0xFFFFFFFF)
+
+	msg, err := Parse(enc.buf)
+	require.NoError(t, err)
+	relation, ok := msg.(*RelationMessage)
+	require.True(t, ok)
+	assert.Equal(t, uint32(16384), relation.RelationID)
+	assert.Equal(t, "public", relation.Namespace)
+	assert.Equal(t, "users", relation.RelationName)
+	assert.Equal(t, uint8('d'), relation.ReplicaIdentity)
+	assert.Equal(t, []*RelationMessageColumn{
+		{Flags: 1, Name: "id", DataType: 23, TypeModifier: -1},
+		{Flags: 0, Name: "name", DataType: 25, TypeModifier: -1},
+	}, relation.Columns)
+	assert.True(t, relation.Columns[0].IsKey())
+	assert.False(t, relation.Columns[1].IsKey())
+}
+
+func Test_Parse_Insert(t *testing.T) {
+	enc := (&testEncoder{}).byte(MessageTypeInsert).uint32(16384).byte(TupleTypeNew).
+		uint16(3).text("1").byte(TupleDataTypeNull).byte(TupleDataTypeToast)
+
+	msg, err := Parse(enc.buf)
+	require.NoError(t, err)
+	assert.Equal(t, &InsertMessage{
+		RelationID: 16384,
+		Tuple: &TupleData{Columns: []*TupleDataColumn{
+			{DataType: TupleDataTypeText, Data: []byte("1")},
+			{DataType: TupleDataTypeNull},
+			{DataType: TupleDataTypeToast},
+		}},
+	}, msg)
+}
+
+func Test_Parse_Update(t *testing.T) {
+	enc := (&testEncoder{}).byte(MessageTypeUpdate).uint32(16384).
+		byte(TupleTypeKey).uint16(1).text("1").
+		byte(TupleTypeNew).uint16(1).text("2")
+
+	msg, err := Parse(enc.buf)
+	require.NoError(t, err)
+	assert.Equal(t, &UpdateMessage{
+		RelationID:   16384,
+		OldTupleType: TupleTypeKey,
+		OldTuple:     &TupleData{Columns: []*TupleDataColumn{{DataType: TupleDataTypeText, Data: []byte("1")}}},
+		NewTuple:     &TupleData{Columns: []*TupleDataColumn{{DataType: TupleDataTypeText, Data: []byte("2")}}},
+	}, msg)
+
+	enc = (&testEncoder{}).byte(MessageTypeUpdate).uint32(16384).byte(TupleTypeNew).uint16(1).text("2")
+	msg, err = Parse(enc.buf)
+	require.NoError(t, err)
+	assert.Equal(t, &UpdateMessage{
+		RelationID: 16384,
+		NewTuple:   &TupleData{Columns: []*TupleDataColumn{{DataType: TupleDataTypeText, Data: []byte("2")}}},
+	}, msg)
+}
+
+func Test_Parse_Delete(t *testing.T) {
+	enc := (&testEncoder{}).byte(MessageTypeDelete).uint32(16384).byte(TupleTypeOld).uint16(1).text("1")
+
+	msg, err := Parse(enc.buf)
+	require.NoError(t, err)
+	assert.Equal(t, &DeleteMessage{
+		RelationID:   16384,
+		OldTupleType: TupleTypeOld,
+		OldTuple:     &TupleData{Columns: []*TupleDataColumn{{DataType: TupleDataTypeText, Data: []byte("1")}}},
+	}, msg)
+}
+
+func Test_Parse_Truncate(t *testing.T) {
+	enc := (&testEncoder{}).byte(MessageTypeTruncate).uint32(2).byte(1).uint32(16384).uint32(16390)
+
+	msg, err := Parse(enc.buf)
+	require.NoError(t, err)
+	assert.Equal(t, &TruncateMessage{Options: 1, RelationIDs: []uint32{16384, 16390}}, msg)
+}
+
+func Test_Parse_Ignored(t *testing.T) {
+	enc := (&testEncoder{}).byte(MessageTypeOrigin).uint64(1).string("origin")
+	msg, err := Parse(enc.buf)
+	require.NoError(t, err)
+	assert.Equal(t, byte(MessageTypeOrigin), msg.Type())
+}
+
+func Test_Parse_Errors(t *testing.T) {
+	_, err := Parse(nil)
+	assert.Error(t, err)
+
+	_, err = Parse([]byte{'Z'})
+	assert.Error(t, err)
+
+	// truncated begin message
+	_, err = Parse((&testEncoder{}).byte(MessageTypeBegin).uint64(100).buf)
+	assert.Error(t, err)
+
+	// text column with a length longer than the message
+	_, err = Parse((&testEncoder{}).byte(MessageTypeInsert).uint32(1).byte(TupleTypeNew).uint16(1).byte(TupleDataTypeText).uint32(10).bytes([]byte("1")).buf)
+	assert.Error(t, err)
+
+	// relation name missing its null terminator
+	_, err = Parse((&testEncoder{}).byte(MessageTypeRelation).uint32(1).string("public").bytes([]byte("users")).buf)
+	assert.Error(t, err)
+}
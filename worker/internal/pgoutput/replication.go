@@ -0,0 +1,143 @@
+package pgoutput
+
+import (
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgproto3"
+)
+
+const (
+	// Sent by the server and wraps a chunk of the write-ahead log decoded by the output plugin
+	XLogDataByteID = 'w'
+	// Sent by the server to let the client know that it is still alive
+	PrimaryKeepaliveMessageByteID = 'k'
+	// Sent by the client to report the position it has flushed up to
+	StandbyStatusUpdateByteID = 'r'
+
+	outputPlugin = "pgoutput"
+)
+
+// Opens a connection to Postgres in logical replication mode.
+// Connections in this mode are only able to run replication commands and simple queries.
+func Connect(ctx context.Context, dsn string) (*pgconn.PgConn, error) {
+	replicationDsn, err := withReplicationParam(dsn)
+	if err != nil {
+		return nil, err
+	}
+	return pgconn.Connect(ctx, replicationDsn)
+}
+
+func withReplicationParam(dsn string) (string, error) {
+	u, err := url.Parse(dsn)
+	if err != nil {
+		return "", fmt.Errorf("unable to parse postgres dsn: %w", err)
+	}
+	query := u.Query()
+	query.Set("replication", "database")
+	u.RawQuery = query.Encode()
+	return u.String(), nil
+}
+
+// Builds the command that begins streaming changes from the logical replication slot with the pgoutput plugin
+func BuildStartReplicationCommand(slotName string, startLsn LSN, publicationName string) string {
+	// publication names are parsed by postgres as a list of identifiers
+	publications := fmt.Sprintf(`"%s"`, strings.ReplaceAll(publicationName, `"`, `""`))
+	return fmt.Sprintf(
+		"START_REPLICATION SLOT %s LOGICAL %s (proto_version '1', publication_names '%s')",
+		slotName,
+		startLsn,
+		strings.ReplaceAll(publications, "'", "''"),
+	)
+}
+
+// Begins streaming changes from the logical replication slot.
+// Once started, the connection will only receive CopyData messages until the stream is ended.
+// The server will not send any changes that were committed prior to the start lsn or the confirmed flush lsn of the slot.
+func StartReplication(ctx context.Context, conn *pgconn.PgConn, slotName string, startLsn LSN, publicationName string) error {
+	conn.Frontend().Send(&pgproto3.Query{String: BuildStartReplicationCommand(slotName, startLsn, publicationName)})
+	if err := conn.Frontend().Flush(); err != nil {
+		return fmt.Errorf("unable to send start replication command: %w", err)
+	}
+
+	for {
+		msg, err := conn.ReceiveMessage(ctx)
+		if err != nil {
+			return fmt.Errorf("unable to receive start replication response: %w", err)
+		}
+		switch msg := msg.(type) {
+		case *pgproto3.NoticeResponse:
+		case *pgproto3.ErrorResponse:
+			return pgconn.ErrorResponseToPgError(msg)
+		case *pgproto3.CopyBothResponse:
+			return nil
+		default:
+			return fmt.Errorf("unexpected response to start replication command: %T", msg)
+		}
+	}
+}
+
+// Lets the server know that all changes up to the lsn have been applied.
+// This advances the confirmed flush lsn of the replication slot which allows the server to reclaim the write-ahead log.
+func SendStandbyStatusUpdate(ctx context.Context, conn *pgconn.PgConn, lsn LSN) error {
+	data := make([]byte, 0, 34)
+	data = append(data, StandbyStatusUpdateByteID)
+	data = binary.BigEndian.AppendUint64(data, uint64(lsn)) // written
+	data = binary.BigEndian.AppendUint64(data, uint64(lsn)) // flushed
+	data = binary.BigEndian.AppendUint64(data, uint64(lsn)) // applied
+	data = binary.BigEndian.AppendUint64(data, uint64(timeToPgTime(time.Now())))
+	data = append(data, 0) // reply requested
+
+	conn.Frontend().Send(&pgproto3.CopyData{Data: data})
+	if err := conn.Frontend().Flush(); err != nil {
+		return fmt.Errorf("unable to send standby status update: %w", err)
+	}
+	return nil
+}
+
+type XLogData struct {
+	WALStart     LSN
+	ServerWALEnd LSN
+	ServerTime   time.Time
+	WALData      []byte
+}
+
+// Parses the body of a CopyData message that begins with XLogDataByteID. The body must not include the byte id.
+func ParseXLogData(buf []byte) (*XLogData, error) {
+	if len(buf) < 24 {
+		return nil, fmt.Errorf("XLogData must be at least 24 bytes, got %d", len(buf))
+	}
+	return &XLogData{
+		WALStart:     LSN(binary.BigEndian.Uint64(buf)),
+		ServerWALEnd: LSN(binary.BigEndian.Uint64(buf[8:])),
+		ServerTime:   pgTimeToTime(int64(binary.BigEndian.Uint64(buf[16:]))),
+		WALData:      buf[24:],
+	}, nil
+}
+
+type PrimaryKeepaliveMessage struct {
+	ServerWALEnd LSN
+	ServerTime   time.Time
+	// The server will disconnect the client if a standby status update is not sent soon
+	ReplyRequested bool
+}
+
+// Parses the body of a CopyData message that begins with PrimaryKeepaliveMessageByteID. The body must not include the byte id.
+func ParsePrimaryKeepaliveMessage(buf []byte) (*PrimaryKeepaliveMessage, error) {
+	if len(buf) != 17 {
+		return nil, fmt.Errorf("PrimaryKeepaliveMessage must be 17 bytes, got %d", len(buf))
+	}
+	return &PrimaryKeepaliveMessage{
+		ServerWALEnd:   LSN(binary.BigEndian.Uint64(buf)),
+		ServerTime:     pgTimeToTime(int64(binary.BigEndian.Uint64(buf[8:]))),
+		ReplyRequested: buf[16] != 0,
+	}, nil
+}
+
+var ErrUnexpectedEndOfStream = errors.New("replication stream was ended by the server")
@@ -21,6 +21,13 @@ func Test_Intn(t *testing.T) {
 	require.GreaterOrEqual(t, randomizer.Intn(1), 0)
 }
 
+func Test_Float64(t *testing.T) {
+	randomizer := New(1)
+	val := randomizer.Float64()
+	require.GreaterOrEqual(t, val, float64(0))
+	require.Less(t, val, float64(1))
+}
+
 // Tests that concurrent access is okay as otherwise it would panic
 func Test_Parallel(t *testing.T) {
 	randomizer := New(1)
@@ -0,0 +1,549 @@
+package pgcdc_activity
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"sort"
+	"strings"
+	"time"
+
+	"connectrpc.com/connect"
+	_ "github.com/benthosdev/benthos/v4/public/components/javascript"
+	_ "github.com/benthosdev/benthos/v4/public/components/pure"
+	_ "github.com/benthosdev/benthos/v4/public/components/pure/extended"
+	"github.com/benthosdev/benthos/v4/public/service"
+	"github.com/jackc/pgx/v5/pgconn"
+	pg_queries "github.com/nucleuscloud/neosync/backend/gen/go/db/dbschemas/postgresql"
+	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
+	"github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1/mgmtv1alpha1connect"
+	dbschemas_postgres "github.com/nucleuscloud/neosync/backend/pkg/dbschemas/postgres"
+	"github.com/nucleuscloud/neosync/backend/pkg/sqlconnect"
+	neosync_benthos "github.com/nucleuscloud/neosync/worker/internal/benthos"
+	neosync_benthos_error "github.com/nucleuscloud/neosync/worker/internal/benthos/error"
+	_ "github.com/nucleuscloud/neosync/worker/internal/benthos/transformers"
+	logger_utils "github.com/nucleuscloud/neosync/worker/internal/logger"
+	"github.com/nucleuscloud/neosync/worker/internal/pgoutput"
+	genbenthosconfigs_activity "github.com/nucleuscloud/neosync/worker/pkg/workflows/datasync/activities/gen-benthos-configs"
+	"github.com/nucleuscloud/neosync/worker/pkg/workflows/datasync/activities/shared"
+	"go.temporal.io/sdk/activity"
+	"go.temporal.io/sdk/log"
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	// How long a single StreamChanges activity runs before returning so that the workflow can continue as new
+	MaxStreamDuration = 30 * time.Minute
+	heartbeatInterval = 5 * time.Second
+)
+
+type Activity struct {
+	jobclient         mgmtv1alpha1connect.JobServiceClient
+	connclient        mgmtv1alpha1connect.ConnectionServiceClient
+	transformerclient mgmtv1alpha1connect.TransformersServiceClient
+	useraccountclient mgmtv1alpha1connect.UserAccountServiceClient
+
+	sqlconnector sqlconnect.SqlConnector
+	pgquerier    pg_queries.Querier
+}
+
+func New(
+	jobclient mgmtv1alpha1connect.JobServiceClient,
+	connclient mgmtv1alpha1connect.ConnectionServiceClient,
+	transformerclient mgmtv1alpha1connect.TransformersServiceClient,
+	useraccountclient mgmtv1alpha1connect.UserAccountServiceClient,
+	sqlconnector sqlconnect.SqlConnector,
+) *Activity {
+	return &Activity{
+		jobclient:         jobclient,
+		connclient:        connclient,
+		transformerclient: transformerclient,
+		useraccountclient: useraccountclient,
+		sqlconnector:      sqlconnector,
+		pgquerier:         pg_queries.New(),
+	}
+}
+
+type EnsureReplicationSlotRequest struct {
+	JobId string
+}
+type EnsureReplicationSlotResponse struct {
+	SlotName string
+	// Set when the slot was created by this call and the job has not opted out of the initial snapshot
+	ShouldSnapshot bool
+}
+
+// Creates the logical replication slot for the job if it does not already exist.
+// Changes are retained by the slot from the moment it is created, so a snapshot taken afterwards may overlap with the first streamed changes.
+// This is safe as every change is applied as an idempotent upsert or delete.
+func (a *Activity) EnsureReplicationSlot(ctx context.Context, req *EnsureReplicationSlotRequest) (*EnsureReplicationSlotResponse, error) {
+	logger, slogger := getLoggers(ctx, req.JobId)
+
+	job, cdcOpts, err := a.getCdcJob(ctx, req.JobId)
+	if err != nil {
+		return nil, err
+	}
+	db, closeDb, err := a.openPostgres(ctx, cdcOpts.GetConnectionId(), slogger)
+	if err != nil {
+		return nil, err
+	}
+	defer closeDb()
+
+	var publicationExists bool
+	err = db.QueryRow(ctx, "SELECT EXISTS(SELECT 1 FROM pg_catalog.pg_publication WHERE pubname = $1);", cdcOpts.GetPublicationName()).Scan(&publicationExists)
+	if err != nil {
+		return nil, fmt.Errorf("unable to check for publication: %w", err)
+	}
+	if !publicationExists {
+		return nil, fmt.Errorf("publication %s does not exist in the source database", cdcOpts.GetPublicationName())
+	}
+
+	slotName := getSlotName(job.GetId(), cdcOpts)
+	var slotExists bool
+	err = db.QueryRow(ctx, "SELECT EXISTS(SELECT 1 FROM pg_catalog.pg_replication_slots WHERE slot_name = $1);", slotName).Scan(&slotExists)
+	if err != nil {
+		return nil, fmt.Errorf("unable to check for replication slot: %w", err)
+	}
+	if slotExists {
+		logger.Info(fmt.Sprintf("replication slot %s already exists", slotName))
+		return &EnsureReplicationSlotResponse{SlotName: slotName}, nil
+	}
+
+	if _, err := db.Exec(ctx, "SELECT pg_catalog.pg_create_logical_replication_slot($1, 'pgoutput');", slotName); err != nil {
+		return nil, fmt.Errorf("unable to create replication slot %s: %w", slotName, err)
+	}
+	logger.Info(fmt.Sprintf("created replication slot %s", slotName))
+	return &EnsureReplicationSlotResponse{SlotName: slotName, ShouldSnapshot: !cdcOpts.GetSkipInitialSnapshot()}, nil
+}
+
+type DropReplicationSlotRequest struct {
+	JobId string
+}
+type DropReplicationSlotResponse struct{}
+
+// Removes the replication slot of the job so that a failed initial snapshot is retried the next time the workflow runs
+func (a *Activity) DropReplicationSlot(ctx context.Context, req *DropReplicationSlotRequest) (*DropReplicationSlotResponse, error) {
+	logger, slogger := getLoggers(ctx, req.JobId)
+
+	job, cdcOpts, err := a.getCdcJob(ctx, req.JobId)
+	if err != nil {
+		return nil, err
+	}
+	db, closeDb, err := a.openPostgres(ctx, cdcOpts.GetConnectionId(), slogger)
+	if err != nil {
+		return nil, err
+	}
+	defer closeDb()
+
+	slotName := getSlotName(job.GetId(), cdcOpts)
+	_, err = db.Exec(
+		ctx,
+		"SELECT pg_catalog.pg_drop_replication_slot(slot_name) FROM pg_catalog.pg_replication_slots WHERE slot_name = $1;",
+		slotName,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("unable to drop replication slot %s: %w", slotName, err)
+	}
+	logger.Info(fmt.Sprintf("dropped replication slot %s", slotName))
+	return &DropReplicationSlotResponse{}, nil
+}
+
+type StreamChangesRequest struct {
+	JobId string
+	// Position to resume streaming from. The confirmed flush position of the slot is used when empty.
+	StartLsn string
+}
+type StreamChangesResponse struct {
+	// Position that every change has been applied up to
+	Lsn string
+}
+
+// Streams changes from the replication slot, transforms them and applies them to each destination in commit order.
+// Runs for up to MaxStreamDuration. The applied position is recorded in the heartbeat so that a retried activity resumes where it left off.
+func (a *Activity) StreamChanges(ctx context.Context, req *StreamChangesRequest) (*StreamChangesResponse, error) {
+	logger, slogger := getLoggers(ctx, req.JobId)
+
+	startLsnStr := req.StartLsn
+	if activity.HasHeartbeatDetails(ctx) {
+		var checkpoint string
+		if err := activity.GetHeartbeatDetails(ctx, &checkpoint); err == nil && checkpoint != "" {
+			startLsnStr = checkpoint
+		}
+	}
+	var startLsn pgoutput.LSN
+	if startLsnStr != "" {
+		lsn, err := pgoutput.ParseLSN(startLsnStr)
+		if err != nil {
+			return nil, err
+		}
+		startLsn = lsn
+	}
+
+	ctx, cancel := context.WithCancelCause(ctx)
+	defer cancel(nil)
+	stopActivityChan := make(chan bool, 1)
+	go func() {
+		select {
+		case <-stopActivityChan:
+			cancel(errors.New("received stop activity signal"))
+		case <-activity.GetWorkerStopChannel(ctx):
+			cancel(errors.New("received worker stop signal"))
+		case <-ctx.Done():
+		}
+	}()
+
+	job, cdcOpts, err := a.getCdcJob(ctx, req.JobId)
+	if err != nil {
+		return nil, err
+	}
+
+	sourceDb, closeSourceDb, err := a.openPostgres(ctx, cdcOpts.GetConnectionId(), slogger)
+	if err != nil {
+		return nil, err
+	}
+	defer closeSourceDb()
+	dbschemas, err := a.pgquerier.GetDatabaseSchema(ctx, sourceDb)
+	if err != nil {
+		return nil, fmt.Errorf("unable to get database schema for postgres connection: %w", err)
+	}
+	groupedSchemas := dbschemas_postgres.GetUniqueSchemaColMappings(dbschemas)
+	uniqueSchemas := shared.GetUniqueSchemasFromMappings(job.GetMappings())
+	sourcePks, err := a.getPrimaryKeys(ctx, sourceDb, uniqueSchemas)
+	if err != nil {
+		return nil, fmt.Errorf("unable to get source primary keys: %w", err)
+	}
+
+	tables := buildCdcTables(job.GetMappings(), sourcePks)
+	tableProcessors := map[string][]*neosync_benthos.ProcessorConfig{}
+	for _, tableMapping := range groupMappingsByTable(job.GetMappings()) {
+		key := neosync_benthos.BuildBenthosTable(tableMapping.Schema, tableMapping.Table)
+		if _, ok := tables[key]; !ok {
+			continue
+		}
+		processors, err := genbenthosconfigs_activity.BuildTableProcessorConfigs(ctx, a.transformerclient, tableMapping.Mappings, groupedSchemas[key])
+		if err != nil {
+			return nil, err
+		}
+		tableProcessors[key] = processors
+	}
+
+	destinations := []*destination{}
+	for _, jobDestination := range job.GetDestinations() {
+		dest, closeDest, err := a.openDestination(ctx, jobDestination.GetConnectionId(), uniqueSchemas, slogger)
+		if err != nil {
+			return nil, err
+		}
+		defer closeDest()
+		destinations = append(destinations, dest)
+	}
+
+	benthosenv := service.NewEnvironment()
+	err = neosync_benthos_error.RegisterErrorProcessor(benthosenv, stopActivityChan)
+	if err != nil {
+		return nil, fmt.Errorf("unable to register error processor to benthos instance: %w", err)
+	}
+	streambldr := benthosenv.NewStreamBuilder()
+	streambldr.SetLogger(slogger.With(
+		"benthos", "true",
+	))
+	produce, err := streambldr.AddBatchProducerFunc()
+	if err != nil {
+		return nil, fmt.Errorf("unable to add producer to benthos stream: %w", err)
+	}
+	if switchProcessor := buildSwitchProcessorConfig(tableProcessors); switchProcessor != nil {
+		processorYaml, err := yaml.Marshal(switchProcessor)
+		if err != nil {
+			return nil, fmt.Errorf("unable to convert processors to yaml: %w", err)
+		}
+		if strings.Contains(string(processorYaml), fmt.Sprintf("${%s}", shared.TransformerKeyEnvVarKey)) {
+			keyResp, err := a.useraccountclient.GetAccountTransformerKey(ctx, connect.NewRequest(&mgmtv1alpha1.GetAccountTransformerKeyRequest{
+				AccountId: job.GetAccountId(),
+			}))
+			if err != nil {
+				return nil, fmt.Errorf("unable to retrieve account transformer key: %w", err)
+			}
+			// This must come before AddProcessorYAML as otherwise it will not be invoked
+			streambldr.SetEnvVarLookupFunc(func(key string) (string, bool) {
+				if key == shared.TransformerKeyEnvVarKey {
+					return keyResp.Msg.Key, true
+				}
+				return "", false
+			})
+		}
+		if err := streambldr.AddProcessorYAML(string(processorYaml)); err != nil {
+			return nil, fmt.Errorf("unable to add processors to benthos stream: %w", err)
+		}
+	}
+	err = streambldr.AddBatchConsumerFunc(func(ctx context.Context, batch service.MessageBatch) error {
+		changes, err := fromMessageBatch(batch)
+		if err != nil {
+			return err
+		}
+		for _, dest := range destinations {
+			if err := dest.apply(ctx, tables, changes); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("unable to add consumer to benthos stream: %w", err)
+	}
+	stream, err := streambldr.Build()
+	if err != nil {
+		return nil, fmt.Errorf("unable to build benthos stream: %w", err)
+	}
+	streamErrChan := make(chan error, 1)
+	go func() {
+		err := stream.Run(ctx)
+		// changes can no longer be applied once the stream has exited
+		if err != nil {
+			cancel(fmt.Errorf("unable to run benthos stream: %w", err))
+		} else {
+			cancel(errors.New("benthos stream exited"))
+		}
+		streamErrChan <- err
+	}()
+	defer func() {
+		if err := stream.StopWithin(5 * time.Second); err != nil {
+			logger.Error(fmt.Sprintf("unable to stop benthos stream: %s", err.Error()))
+		}
+		<-streamErrChan
+	}()
+
+	replicationConn, closeReplicationConn, err := a.openReplicationConnection(ctx, cdcOpts.GetConnectionId(), slogger)
+	if err != nil {
+		return nil, err
+	}
+	defer closeReplicationConn()
+
+	slotName := getSlotName(job.GetId(), cdcOpts)
+	if err := pgoutput.StartReplication(ctx, replicationConn, slotName, startLsn, cdcOpts.GetPublicationName()); err != nil {
+		return nil, fmt.Errorf("unable to start replication from slot %s: %w", slotName, err)
+	}
+	logger.Info(fmt.Sprintf("streaming changes from replication slot %s at %s", slotName, startLsn))
+
+	changestream := newChangeStream(tables, startLsn, func(ctx context.Context, changes []*change) error {
+		return produce(ctx, toMessageBatch(changes))
+	})
+	go func() {
+		for {
+			select {
+			case <-time.After(heartbeatInterval):
+				activity.RecordHeartbeat(ctx, changestream.Checkpoint().String())
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	if err := changestream.Run(ctx, replicationConn, time.Now().Add(MaxStreamDuration)); err != nil {
+		if cause := context.Cause(ctx); cause != nil {
+			return nil, fmt.Errorf("%w: %w", cause, err)
+		}
+		return nil, err
+	}
+
+	lsn := changestream.Checkpoint()
+	logger.Info(fmt.Sprintf("applied changes up to %s", lsn))
+	activity.RecordHeartbeat(ctx, lsn.String())
+	if lsn == 0 {
+		return &StreamChangesResponse{}, nil
+	}
+	return &StreamChangesResponse{Lsn: lsn.String()}, nil
+}
+
+func getLoggers(ctx context.Context, jobId string) (log.Logger, *slog.Logger) {
+	info := activity.GetInfo(ctx)
+	loggerKeyVals := []any{
+		"jobId", jobId,
+		"WorkflowID", info.WorkflowExecution.ID,
+		"RunID", info.WorkflowExecution.RunID,
+	}
+	return log.With(activity.GetLogger(ctx), loggerKeyVals...), logger_utils.NewJsonSLogger().With(loggerKeyVals...)
+}
+
+func (a *Activity) getCdcJob(ctx context.Context, jobId string) (*mgmtv1alpha1.Job, *mgmtv1alpha1.PostgresCdcSourceConnectionOptions, error) {
+	jobResp, err := a.jobclient.GetJob(ctx, connect.NewRequest(&mgmtv1alpha1.GetJobRequest{
+		Id: jobId,
+	}))
+	if err != nil {
+		return nil, nil, fmt.Errorf("unable to get job by id: %w", err)
+	}
+	job := jobResp.Msg.GetJob()
+	cdcOpts := job.GetSource().GetOptions().GetPostgresCdc()
+	if cdcOpts == nil {
+		return nil, nil, fmt.Errorf("job %s does not have a postgres change data capture source", jobId)
+	}
+	return job, cdcOpts, nil
+}
+
+func (a *Activity) getPgConfig(ctx context.Context, connectionId string) (*mgmtv1alpha1.ConnectionConfig, error) {
+	connResp, err := a.connclient.GetConnection(ctx, connect.NewRequest(&mgmtv1alpha1.GetConnectionRequest{
+		Id: connectionId,
+	}))
+	if err != nil {
+		return nil, fmt.Errorf("unable to get connection by id: %w", err)
+	}
+	connConfig := connResp.Msg.GetConnection().GetConnectionConfig()
+	if connConfig.GetPgConfig() == nil {
+		return nil, fmt.Errorf("connection %s must be a postgres connection for change data capture", connectionId)
+	}
+	return connConfig, nil
+}
+
+func (a *Activity) openPostgres(ctx context.Context, connectionId string, slogger *slog.Logger) (pg_queries.DBTX, func(), error) {
+	connConfig, err := a.getPgConfig(ctx, connectionId)
+	if err != nil {
+		return nil, nil, err
+	}
+	pgconn, err := a.sqlconnector.NewPgPoolFromConnectionConfig(connConfig.GetPgConfig(), shared.Ptr(uint32(5)), slogger)
+	if err != nil {
+		return nil, nil, fmt.Errorf("unable to create new postgres pool from connection config: %w", err)
+	}
+	db, err := pgconn.Open(ctx)
+	if err != nil {
+		return nil, nil, fmt.Errorf("unable to open postgres connection: %w", err)
+	}
+	return db, pgconn.Close, nil
+}
+
+func (a *Activity) openDestination(ctx context.Context, connectionId string, uniqueSchemas []string, slogger *slog.Logger) (*destination, func(), error) {
+	db, closeDb, err := a.openPostgres(ctx, connectionId, slogger)
+	if err != nil {
+		return nil, nil, err
+	}
+	txdb, ok := db.(pgTxBeginner)
+	if !ok {
+		closeDb()
+		return nil, nil, fmt.Errorf("postgres connection does not support transactions, received: %T", db)
+	}
+	primaryKeys, err := a.getPrimaryKeys(ctx, db, uniqueSchemas)
+	if err != nil {
+		closeDb()
+		return nil, nil, fmt.Errorf("unable to get destination primary keys: %w", err)
+	}
+	return &destination{connectionId: connectionId, db: txdb, primaryKeys: primaryKeys, logger: slogger}, closeDb, nil
+}
+
+func (a *Activity) getPrimaryKeys(ctx context.Context, db pg_queries.DBTX, uniqueSchemas []string) (map[string][]string, error) {
+	constraints, err := dbschemas_postgres.GetAllPostgresPkConstraints(a.pgquerier, ctx, db, uniqueSchemas)
+	if err != nil {
+		return nil, err
+	}
+	return dbschemas_postgres.GetPostgresTablePrimaryKeys(constraints), nil
+}
+
+// Opens a connection in replication mode, tunneling through the bastion when the connection is configured with one
+func (a *Activity) openReplicationConnection(ctx context.Context, connectionId string, slogger *slog.Logger) (*pgconn.PgConn, func(), error) {
+	connConfig, err := a.getPgConfig(ctx, connectionId)
+	if err != nil {
+		return nil, nil, err
+	}
+	details, err := sqlconnect.GetConnectionDetails(connConfig, shared.Ptr(uint32(5)), slogger)
+	if err != nil {
+		return nil, nil, err
+	}
+	closeTunnel := func() {}
+	if details.Tunnel != nil {
+		ready, err := details.Tunnel.Start(slogger)
+		if err != nil {
+			return nil, nil, fmt.Errorf("unable to start ssh tunnel: %w", err)
+		}
+		<-ready
+		_, localport := details.Tunnel.GetLocalHostPort()
+		details.GeneralDbConnectConfig.Port = int32(localport)
+		closeTunnel = details.Tunnel.Close
+	}
+	conn, err := pgoutput.Connect(ctx, details.GeneralDbConnectConfig.String())
+	if err != nil {
+		closeTunnel()
+		return nil, nil, fmt.Errorf("unable to open replication connection: %w", err)
+	}
+	return conn, func() {
+		if err := conn.Close(context.Background()); err != nil {
+			slogger.Error(fmt.Sprintf("unable to close replication connection: %s", err.Error()))
+		}
+		closeTunnel()
+	}, nil
+}
+
+// Replication slot names may only contain lower case letters, numbers and underscores
+func getSlotName(jobId string, cdcOpts *mgmtv1alpha1.PostgresCdcSourceConnectionOptions) string {
+	if cdcOpts.GetSlotName() != "" {
+		return cdcOpts.GetSlotName()
+	}
+	return fmt.Sprintf("neosync_%s", strings.ReplaceAll(strings.ToLower(jobId), "-", "_"))
+}
+
+type tableMapping struct {
+	Schema   string
+	Table    string
+	Mappings []*mgmtv1alpha1.JobMapping
+}
+
+func groupMappingsByTable(mappings []*mgmtv1alpha1.JobMapping) []*tableMapping {
+	output := []*tableMapping{}
+	indexes := map[string]int{}
+	for _, mapping := range mappings {
+		key := neosync_benthos.BuildBenthosTable(mapping.GetSchema(), mapping.GetTable())
+		idx, ok := indexes[key]
+		if !ok {
+			idx = len(output)
+			indexes[key] = idx
+			output = append(output, &tableMapping{Schema: mapping.GetSchema(), Table: mapping.GetTable()})
+		}
+		output[idx].Mappings = append(output[idx].Mappings, mapping)
+	}
+	return output
+}
+
+// Tables where every column generates null are skipped the same as in a batch sync.
+// Columns that use the database default are never written so that the destination default is kept.
+func buildCdcTables(mappings []*mgmtv1alpha1.JobMapping, primaryKeys map[string][]string) map[string]*cdcTable {
+	tables := map[string]*cdcTable{}
+	for _, group := range groupMappingsByTable(mappings) {
+		if shared.AreAllColsNull(group.Mappings) {
+			continue
+		}
+		key := neosync_benthos.BuildBenthosTable(group.Schema, group.Table)
+		columns := map[string]struct{}{}
+		for _, mapping := range group.Mappings {
+			if mapping.GetTransformer().GetSource() == mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_GENERATE_DEFAULT {
+				continue
+			}
+			columns[mapping.GetColumn()] = struct{}{}
+		}
+		tables[key] = &cdcTable{
+			Schema:      group.Schema,
+			Table:       group.Table,
+			Columns:     columns,
+			PrimaryKeys: primaryKeys[key],
+		}
+	}
+	return tables
+}
+
+// Routes each change through the transformers of its table. Truncates carry no row and skip the transformers.
+func buildSwitchProcessorConfig(tableProcessors map[string][]*neosync_benthos.ProcessorConfig) *neosync_benthos.ProcessorConfig {
+	tables := make([]string, 0, len(tableProcessors))
+	for table, processors := range tableProcessors {
+		if len(processors) > 0 {
+			tables = append(tables, table)
+		}
+	}
+	if len(tables) == 0 {
+		return nil
+	}
+	sort.Strings(tables)
+
+	cases := make([]*neosync_benthos.SwitchProcessorCase, 0, len(tables))
+	for _, table := range tables {
+		cases = append(cases, &neosync_benthos.SwitchProcessorCase{
+			Check:      fmt.Sprintf("@%s == %q && @%s != %q", tableMetaKey, table, operationMetaKey, operationTruncate),
+			Processors: tableProcessors[table],
+		})
+	}
+	return &neosync_benthos.ProcessorConfig{Switch: cases}
+}
@@ -0,0 +1,206 @@
+package pgcdc_activity
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/benthosdev/benthos/v4/public/service"
+	"github.com/jackc/pgx/v5"
+	dbschemas_postgres "github.com/nucleuscloud/neosync/backend/pkg/dbschemas/postgres"
+)
+
+// metadata keys that carry a change through the benthos pipeline
+const (
+	tableMetaKey            = "neosync_table"
+	operationMetaKey        = "neosync_operation"
+	unchangedColumnsMetaKey = "neosync_unchanged_columns"
+	cascadeMetaKey          = "neosync_truncate_cascade"
+)
+
+func toMessageBatch(changes []*change) service.MessageBatch {
+	batch := make(service.MessageBatch, 0, len(changes))
+	for _, c := range changes {
+		msg := service.NewMessage(nil)
+		msg.SetStructured(c.Row)
+		msg.MetaSetMut(tableMetaKey, c.Table)
+		msg.MetaSetMut(operationMetaKey, c.Operation)
+		unchanged := make([]any, 0, len(c.UnchangedColumns))
+		for _, col := range c.UnchangedColumns {
+			unchanged = append(unchanged, col)
+		}
+		msg.MetaSetMut(unchangedColumnsMetaKey, unchanged)
+		msg.MetaSetMut(cascadeMetaKey, c.Cascade)
+		batch = append(batch, msg)
+	}
+	return batch
+}
+
+// Converts the transformed batch back into changes. Messages that failed to transform fail the whole batch.
+func fromMessageBatch(batch service.MessageBatch) ([]*change, error) {
+	changes := make([]*change, 0, len(batch))
+	for _, msg := range batch {
+		if err := msg.GetError(); err != nil {
+			return nil, fmt.Errorf("unable to transform change: %w", err)
+		}
+		table, ok := msg.MetaGetMut(tableMetaKey)
+		if !ok {
+			return nil, errors.New("change is missing its table")
+		}
+		operation, ok := msg.MetaGetMut(operationMetaKey)
+		if !ok {
+			return nil, errors.New("change is missing its operation")
+		}
+		structured, err := msg.AsStructured()
+		if err != nil {
+			return nil, fmt.Errorf("unable to read transformed change: %w", err)
+		}
+		row, ok := structured.(map[string]any)
+		if !ok {
+			return nil, fmt.Errorf("transformed change must be an object, received: %T", structured)
+		}
+		c := &change{
+			Table:            fmt.Sprint(table),
+			Operation:        fmt.Sprint(operation),
+			Row:              row,
+			UnchangedColumns: []string{},
+		}
+		if unchanged, ok := msg.MetaGetMut(unchangedColumnsMetaKey); ok {
+			if cols, ok := unchanged.([]any); ok {
+				for _, col := range cols {
+					c.UnchangedColumns = append(c.UnchangedColumns, fmt.Sprint(col))
+				}
+			}
+		}
+		if cascade, ok := msg.MetaGetMut(cascadeMetaKey); ok {
+			c.Cascade = cascade == true
+		}
+		changes = append(changes, c)
+	}
+	return changes, nil
+}
+
+type pgTxBeginner interface {
+	Begin(ctx context.Context) (pgx.Tx, error)
+}
+
+type destination struct {
+	connectionId string
+	db           pgTxBeginner
+	// schema.table -> primary key columns of the destination table
+	primaryKeys map[string][]string
+	logger      *slog.Logger
+}
+
+// Applies the batch to the destination within a single transaction so that a batch is never partially applied
+func (d *destination) apply(ctx context.Context, tables map[string]*cdcTable, changes []*change) error {
+	tx, err := d.db.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("unable to begin transaction on destination %s: %w", d.connectionId, err)
+	}
+	defer func() {
+		if err := tx.Rollback(ctx); err != nil && !errors.Is(err, pgx.ErrTxClosed) {
+			d.logger.Error(fmt.Sprintf("unable to roll back transaction on destination %s: %s", d.connectionId, err.Error()))
+		}
+	}()
+
+	for _, c := range changes {
+		table, ok := tables[c.Table]
+		if !ok {
+			return fmt.Errorf("received change for unmapped table: %s", c.Table)
+		}
+		query, args, err := buildChangeStatement(table.Schema, table.Table, c, d.primaryKeys[c.Table])
+		if err != nil {
+			return err
+		}
+		if _, err := tx.Exec(ctx, query, args...); err != nil {
+			return fmt.Errorf("unable to apply %s to %s on destination %s: %w", c.Operation, c.Table, d.connectionId, err)
+		}
+	}
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("unable to commit transaction on destination %s: %w", d.connectionId, err)
+	}
+	return nil
+}
+
+func buildChangeStatement(schema, table string, c *change, primaryKeys []string) (query string, args []any, err error) {
+	switch c.Operation {
+	case operationUpsert:
+		return buildUpsertStatement(schema, table, c.Row, c.UnchangedColumns, primaryKeys)
+	case operationDelete:
+		return buildDeleteStatement(schema, table, c.Row, primaryKeys)
+	case operationTruncate:
+		return buildTruncateStatement(schema, table, c.Cascade), []any{}, nil
+	default:
+		return "", nil, fmt.Errorf("unsupported change operation: %s", c.Operation)
+	}
+}
+
+// Upserts are used for both inserts and updates so that replaying a change after a restart is safe
+func buildUpsertStatement(schema, table string, row map[string]any, unchangedColumns, primaryKeys []string) (query string, args []any, err error) {
+	if len(primaryKeys) == 0 {
+		return "", nil, fmt.Errorf("destination table %s.%s must have a primary key to apply changes", schema, table)
+	}
+	columns := make([]string, 0, len(row))
+	for col := range row {
+		if !slices.Contains(unchangedColumns, col) {
+			columns = append(columns, col)
+		}
+	}
+	sort.Strings(columns)
+	for _, key := range primaryKeys {
+		if !slices.Contains(columns, key) {
+			return "", nil, fmt.Errorf("change for %s.%s is missing primary key column %s", schema, table, key)
+		}
+	}
+
+	values := make([]string, len(columns))
+	updates := []string{}
+	args = make([]any, len(columns))
+	for idx, col := range columns {
+		values[idx] = fmt.Sprintf("$%d", idx+1)
+		args[idx] = row[col]
+		if !slices.Contains(primaryKeys, col) {
+			updates = append(updates, fmt.Sprintf("%s = EXCLUDED.%s", dbschemas_postgres.EscapePgColumn(col), dbschemas_postgres.EscapePgColumn(col)))
+		}
+	}
+	insertQuery := fmt.Sprintf(
+		"INSERT INTO %s (%s) VALUES (%s)",
+		fmt.Sprintf("%q.%q", schema, table),
+		strings.Join(dbschemas_postgres.EscapePgColumns(columns), ", "),
+		strings.Join(values, ", "),
+	)
+	conflictCols := strings.Join(dbschemas_postgres.EscapePgColumns(primaryKeys), ", ")
+	if len(updates) == 0 {
+		return fmt.Sprintf("%s ON CONFLICT (%s) DO NOTHING;", insertQuery, conflictCols), args, nil
+	}
+	return fmt.Sprintf("%s ON CONFLICT (%s) DO UPDATE SET %s;", insertQuery, conflictCols, strings.Join(updates, ", ")), args, nil
+}
+
+func buildDeleteStatement(schema, table string, row map[string]any, primaryKeys []string) (query string, args []any, err error) {
+	if len(primaryKeys) == 0 {
+		return "", nil, fmt.Errorf("destination table %s.%s must have a primary key to apply changes", schema, table)
+	}
+	conditions := make([]string, len(primaryKeys))
+	args = make([]any, len(primaryKeys))
+	for idx, key := range primaryKeys {
+		val, ok := row[key]
+		if !ok {
+			return "", nil, fmt.Errorf("change for %s.%s is missing primary key column %s", schema, table, key)
+		}
+		conditions[idx] = fmt.Sprintf("%s = $%d", dbschemas_postgres.EscapePgColumn(key), idx+1)
+		args[idx] = val
+	}
+	return fmt.Sprintf("DELETE FROM %q.%q WHERE %s;", schema, table, strings.Join(conditions, " AND ")), args, nil
+}
+
+func buildTruncateStatement(schema, table string, cascade bool) string {
+	if cascade {
+		return fmt.Sprintf("TRUNCATE TABLE %q.%q CASCADE;", schema, table)
+	}
+	return fmt.Sprintf("TRUNCATE TABLE %q.%q;", schema, table)
+}
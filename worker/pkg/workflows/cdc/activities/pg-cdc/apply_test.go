@@ -0,0 +1,121 @@
+package pgcdc_activity
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/benthosdev/benthos/v4/public/service"
+	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
+	neosync_benthos "github.com/nucleuscloud/neosync/worker/internal/benthos"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
+)
+
+func Test_buildUpsertStatement(t *testing.T) {
+	query, args, err := buildUpsertStatement(
+		"public", "users",
+		map[string]any{"id": 1, "name": "nick", "bio": "unchanged"},
+		[]string{"bio"},
+		[]string{"id"},
+	)
+	require.NoError(t, err)
+	assert.Equal(t, `INSERT INTO "public"."users" ("id", "name") VALUES ($1, $2) ON CONFLICT ("id") DO UPDATE SET "name" = EXCLUDED."name";`, query)
+	assert.Equal(t, []any{1, "nick"}, args)
+
+	query, args, err = buildUpsertStatement("public", "users", map[string]any{"id": 1}, []string{}, []string{"id"})
+	require.NoError(t, err)
+	assert.Equal(t, `INSERT INTO "public"."users" ("id") VALUES ($1) ON CONFLICT ("id") DO NOTHING;`, query)
+	assert.Equal(t, []any{1}, args)
+
+	_, _, err = buildUpsertStatement("public", "users", map[string]any{"id": 1}, []string{}, []string{})
+	assert.Error(t, err)
+	_, _, err = buildUpsertStatement("public", "users", map[string]any{"name": "nick"}, []string{}, []string{"id"})
+	assert.Error(t, err)
+}
+
+func Test_buildDeleteStatement(t *testing.T) {
+	query, args, err := buildDeleteStatement("public", "users", map[string]any{"org_id": 2, "id": 1, "name": nil}, []string{"org_id", "id"})
+	require.NoError(t, err)
+	assert.Equal(t, `DELETE FROM "public"."users" WHERE "org_id" = $1 AND "id" = $2;`, query)
+	assert.Equal(t, []any{2, 1}, args)
+
+	_, _, err = buildDeleteStatement("public", "users", map[string]any{"name": nil}, []string{"id"})
+	assert.Error(t, err)
+}
+
+func Test_buildChangeStatement_Truncate(t *testing.T) {
+	query, args, err := buildChangeStatement("public", "users", &change{Operation: operationTruncate, Cascade: true}, []string{})
+	require.NoError(t, err)
+	assert.Equal(t, `TRUNCATE TABLE "public"."users" CASCADE;`, query)
+	assert.Empty(t, args)
+
+	assert.Equal(t, `TRUNCATE TABLE "public"."users";`, buildTruncateStatement("public", "users", false))
+
+	_, _, err = buildChangeStatement("public", "users", &change{Operation: "merge"}, []string{"id"})
+	assert.Error(t, err)
+}
+
+func Test_MessageBatch_RoundTrip(t *testing.T) {
+	changes := []*change{
+		{Table: "public.users", Operation: operationUpsert, Row: map[string]any{"id": int64(1)}, UnchangedColumns: []string{"bio"}},
+		{Table: "public.users", Operation: operationTruncate, Row: map[string]any{}, UnchangedColumns: []string{}, Cascade: true},
+	}
+	actual, err := fromMessageBatch(toMessageBatch(changes))
+	require.NoError(t, err)
+	assert.Equal(t, changes, actual)
+}
+
+func Test_fromMessageBatch_Error(t *testing.T) {
+	batch := toMessageBatch([]*change{{Table: "public.users", Operation: operationUpsert, Row: map[string]any{"id": 1}}})
+	batch[0].SetError(errors.New("transformer failed"))
+	_, err := fromMessageBatch(batch)
+	assert.Error(t, err)
+
+	_, err = fromMessageBatch(service.MessageBatch{service.NewMessage([]byte(`{}`))})
+	assert.Error(t, err)
+}
+
+func Test_buildCdcTables(t *testing.T) {
+	mappings := []*mgmtv1alpha1.JobMapping{
+		{Schema: "public", Table: "users", Column: "id", Transformer: &mgmtv1alpha1.JobMappingTransformer{Source: mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_PASSTHROUGH}},
+		{Schema: "public", Table: "users", Column: "created_at", Transformer: &mgmtv1alpha1.JobMappingTransformer{Source: mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_GENERATE_DEFAULT}},
+		{Schema: "public", Table: "nulls", Column: "id", Transformer: &mgmtv1alpha1.JobMappingTransformer{Source: mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_GENERATE_NULL}},
+	}
+	actual := buildCdcTables(mappings, map[string][]string{"public.users": {"id"}})
+	assert.Equal(t, map[string]*cdcTable{
+		"public.users": {
+			Schema:      "public",
+			Table:       "users",
+			Columns:     map[string]struct{}{"id": {}},
+			PrimaryKeys: []string{"id"},
+		},
+	}, actual)
+}
+
+func Test_buildSwitchProcessorConfig(t *testing.T) {
+	assert.Nil(t, buildSwitchProcessorConfig(map[string][]*neosync_benthos.ProcessorConfig{"public.users": {}}))
+
+	mutation := "root.name = \"redacted\""
+	actual := buildSwitchProcessorConfig(map[string][]*neosync_benthos.ProcessorConfig{
+		"public.users":  {{Mutation: &mutation}},
+		"public.orders": {{Mutation: &mutation}},
+	})
+	require.NotNil(t, actual)
+	out, err := yaml.Marshal(actual)
+	require.NoError(t, err)
+	assert.Equal(t, `switch:
+    - check: '@neosync_table == "public.orders" && @neosync_operation != "truncate"'
+      processors:
+        - mutation: root.name = "redacted"
+    - check: '@neosync_table == "public.users" && @neosync_operation != "truncate"'
+      processors:
+        - mutation: root.name = "redacted"
+`, string(out))
+}
+
+func Test_getSlotName(t *testing.T) {
+	assert.Equal(t, "neosync_3b6bb0e1_5fa5_4d38_b2c3_0f4d3f5c4b7a", getSlotName("3B6BB0E1-5fa5-4d38-b2c3-0f4d3f5c4b7a", &mgmtv1alpha1.PostgresCdcSourceConnectionOptions{}))
+	slot := "my_slot"
+	assert.Equal(t, "my_slot", getSlotName("job", &mgmtv1alpha1.PostgresCdcSourceConnectionOptions{SlotName: &slot}))
+}
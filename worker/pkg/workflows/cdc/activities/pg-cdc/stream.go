@@ -0,0 +1,369 @@
+package pgcdc_activity
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"sync/atomic"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgproto3"
+	"github.com/jackc/pgx/v5/pgtype"
+	neosync_benthos "github.com/nucleuscloud/neosync/worker/internal/benthos"
+	"github.com/nucleuscloud/neosync/worker/internal/pgoutput"
+)
+
+const (
+	operationUpsert   = "upsert"
+	operationDelete   = "delete"
+	operationTruncate = "truncate"
+
+	// how often the server is told which lsn has been applied if it has not asked sooner
+	standbyStatusInterval = 10 * time.Second
+	// large transactions are applied in chunks so that they are not held in memory in full
+	maxPendingChanges = 1000
+)
+
+// A single row level change that is applied to every destination
+type change struct {
+	Table     string // schema.table
+	Operation string
+	// The mapped columns of the row. For deletes this is the old row which may only contain the replica identity.
+	Row map[string]any
+	// Columns that hold unchanged TOASTed values. Postgres does not send these so they must not be overwritten.
+	UnchangedColumns []string
+	// Only set for truncates
+	Cascade bool
+}
+
+// A table that is part of the job mappings
+type cdcTable struct {
+	Schema string
+	Table  string
+	// The mapped columns that are synced to the destination
+	Columns map[string]struct{}
+	// Primary key of the source table, used to detect updates that change the identity of a row.
+	// Falls back to the replica identity of the table when empty.
+	PrimaryKeys []string
+}
+
+// Consumes the pgoutput stream and converts the changes of each committed transaction into batches that are applied in order
+type changeStream struct {
+	tables    map[string]*cdcTable
+	relations map[uint32]*pgoutput.RelationMessage
+	typeMap   *pgtype.Map
+
+	apply func(ctx context.Context, changes []*change) error
+
+	// lsn that every transaction has been applied up to, read by the heartbeat
+	checkpoint atomic.Uint64
+
+	inTransaction   bool
+	skipTransaction bool
+	pending         []*change
+}
+
+func newChangeStream(
+	tables map[string]*cdcTable,
+	startLsn pgoutput.LSN,
+	apply func(ctx context.Context, changes []*change) error,
+) *changeStream {
+	cs := &changeStream{
+		tables:    tables,
+		relations: map[uint32]*pgoutput.RelationMessage{},
+		typeMap:   pgtype.NewMap(),
+		apply:     apply,
+	}
+	cs.checkpoint.Store(uint64(startLsn))
+	return cs
+}
+
+func (s *changeStream) Checkpoint() pgoutput.LSN {
+	return pgoutput.LSN(s.checkpoint.Load())
+}
+
+// Receives changes until the stop time has passed and the stream is between transactions or the context is canceled
+func (s *changeStream) Run(ctx context.Context, conn *pgconn.PgConn, stopAt time.Time) error {
+	nextStatusDeadline := time.Now().Add(standbyStatusInterval)
+	for {
+		if time.Now().After(nextStatusDeadline) {
+			if err := pgoutput.SendStandbyStatusUpdate(ctx, conn, s.Checkpoint()); err != nil {
+				return err
+			}
+			nextStatusDeadline = time.Now().Add(standbyStatusInterval)
+		}
+		if !s.inTransaction && time.Now().After(stopAt) {
+			return pgoutput.SendStandbyStatusUpdate(ctx, conn, s.Checkpoint())
+		}
+
+		recvCtx, cancel := context.WithDeadline(ctx, nextStatusDeadline)
+		rawMsg, err := conn.ReceiveMessage(recvCtx)
+		cancel()
+		if err != nil {
+			if pgconn.Timeout(err) && ctx.Err() == nil {
+				continue
+			}
+			return fmt.Errorf("unable to receive replication message: %w", err)
+		}
+
+		switch msg := rawMsg.(type) {
+		case *pgproto3.ErrorResponse:
+			return pgconn.ErrorResponseToPgError(msg)
+		case *pgproto3.CopyDone:
+			return pgoutput.ErrUnexpectedEndOfStream
+		case *pgproto3.CopyData:
+			if len(msg.Data) == 0 {
+				continue
+			}
+			switch msg.Data[0] {
+			case pgoutput.PrimaryKeepaliveMessageByteID:
+				keepalive, err := pgoutput.ParsePrimaryKeepaliveMessage(msg.Data[1:])
+				if err != nil {
+					return err
+				}
+				s.handleKeepalive(keepalive)
+				if keepalive.ReplyRequested {
+					nextStatusDeadline = time.Time{}
+				}
+			case pgoutput.XLogDataByteID:
+				xld, err := pgoutput.ParseXLogData(msg.Data[1:])
+				if err != nil {
+					return err
+				}
+				logicalMsg, err := pgoutput.Parse(xld.WALData)
+				if err != nil {
+					return err
+				}
+				if err := s.handleMessage(ctx, logicalMsg); err != nil {
+					return err
+				}
+			}
+		}
+	}
+}
+
+// The keepalive carries the position the server has decoded up to.
+// When no transaction is in flight, nothing before it is left to apply, so the slot can be advanced past changes to tables outside of the publication.
+func (s *changeStream) handleKeepalive(msg *pgoutput.PrimaryKeepaliveMessage) {
+	if s.inTransaction || len(s.pending) > 0 {
+		return
+	}
+	if msg.ServerWALEnd > s.Checkpoint() {
+		s.checkpoint.Store(uint64(msg.ServerWALEnd))
+	}
+}
+
+func (s *changeStream) handleMessage(ctx context.Context, msg pgoutput.Message) error {
+	switch msg := msg.(type) {
+	case *pgoutput.RelationMessage:
+		s.relations[msg.RelationID] = msg
+	case *pgoutput.BeginMessage:
+		s.inTransaction = true
+		// transactions that committed before the checkpoint have already been applied
+		s.skipTransaction = msg.FinalLSN < s.Checkpoint()
+	case *pgoutput.CommitMessage:
+		s.inTransaction = false
+		if s.skipTransaction {
+			s.skipTransaction = false
+			return nil
+		}
+		if err := s.flush(ctx); err != nil {
+			return err
+		}
+		s.checkpoint.Store(uint64(msg.TransactionEndLSN))
+	case *pgoutput.InsertMessage, *pgoutput.UpdateMessage, *pgoutput.DeleteMessage, *pgoutput.TruncateMessage:
+		if s.skipTransaction {
+			return nil
+		}
+		changes, err := s.toChanges(msg)
+		if err != nil {
+			return err
+		}
+		s.pending = append(s.pending, changes...)
+		if len(s.pending) >= maxPendingChanges {
+			return s.flush(ctx)
+		}
+	}
+	return nil
+}
+
+func (s *changeStream) flush(ctx context.Context) error {
+	if len(s.pending) == 0 {
+		return nil
+	}
+	if err := s.apply(ctx, s.pending); err != nil {
+		return err
+	}
+	s.pending = nil
+	return nil
+}
+
+func (s *changeStream) toChanges(msg pgoutput.Message) ([]*change, error) {
+	switch msg := msg.(type) {
+	case *pgoutput.InsertMessage:
+		relation, table, err := s.getTable(msg.RelationID)
+		if err != nil || table == nil {
+			return nil, err
+		}
+		row, unchanged, err := s.buildRow(relation, msg.Tuple, table.Columns)
+		if err != nil {
+			return nil, err
+		}
+		return []*change{{Table: tableKey(table), Operation: operationUpsert, Row: row, UnchangedColumns: unchanged}}, nil
+	case *pgoutput.UpdateMessage:
+		relation, table, err := s.getTable(msg.RelationID)
+		if err != nil || table == nil {
+			return nil, err
+		}
+		changes := []*change{}
+		// the row moved to a new key, the old row must be removed as an upsert would leave it behind
+		if msg.OldTuple != nil && haveKeysChanged(relation, getKeyColumns(relation, table), msg.OldTuple, msg.NewTuple) {
+			oldRow, _, err := s.buildRow(relation, msg.OldTuple, table.Columns)
+			if err != nil {
+				return nil, err
+			}
+			changes = append(changes, &change{Table: tableKey(table), Operation: operationDelete, Row: oldRow})
+		}
+		row, unchanged, err := s.buildRow(relation, msg.NewTuple, table.Columns)
+		if err != nil {
+			return nil, err
+		}
+		return append(changes, &change{Table: tableKey(table), Operation: operationUpsert, Row: row, UnchangedColumns: unchanged}), nil
+	case *pgoutput.DeleteMessage:
+		relation, table, err := s.getTable(msg.RelationID)
+		if err != nil || table == nil {
+			return nil, err
+		}
+		row, _, err := s.buildRow(relation, msg.OldTuple, table.Columns)
+		if err != nil {
+			return nil, err
+		}
+		return []*change{{Table: tableKey(table), Operation: operationDelete, Row: row}}, nil
+	case *pgoutput.TruncateMessage:
+		changes := []*change{}
+		for _, relationId := range msg.RelationIDs {
+			_, table, err := s.getTable(relationId)
+			if err != nil {
+				return nil, err
+			}
+			if table == nil {
+				continue
+			}
+			changes = append(changes, &change{
+				Table:     tableKey(table),
+				Operation: operationTruncate,
+				Row:       map[string]any{},
+				Cascade:   msg.Options&1 == 1,
+			})
+		}
+		return changes, nil
+	}
+	return nil, fmt.Errorf("unable to convert pgoutput message of type %c to a change", msg.Type())
+}
+
+// Returns a nil table if the relation is not part of the job mappings
+func (s *changeStream) getTable(relationId uint32) (*pgoutput.RelationMessage, *cdcTable, error) {
+	relation, ok := s.relations[relationId]
+	if !ok {
+		return nil, nil, fmt.Errorf("received change for unknown relation: %d", relationId)
+	}
+	table, ok := s.tables[neosync_benthos.BuildBenthosTable(relation.Namespace, relation.RelationName)]
+	if !ok {
+		return relation, nil, nil
+	}
+	return relation, table, nil
+}
+
+func (s *changeStream) buildRow(
+	relation *pgoutput.RelationMessage,
+	tuple *pgoutput.TupleData,
+	columns map[string]struct{},
+) (row map[string]any, unchangedColumns []string, err error) {
+	if tuple == nil {
+		return nil, nil, errors.New("received change without tuple data")
+	}
+	if len(tuple.Columns) != len(relation.Columns) {
+		return nil, nil, fmt.Errorf(
+			"tuple has %d columns but relation %s.%s has %d",
+			len(tuple.Columns), relation.Namespace, relation.RelationName, len(relation.Columns),
+		)
+	}
+	row = map[string]any{}
+	unchangedColumns = []string{}
+	for idx, col := range relation.Columns {
+		if _, ok := columns[col.Name]; !ok {
+			continue
+		}
+		data := tuple.Columns[idx]
+		if data.DataType == pgoutput.TupleDataTypeToast {
+			unchangedColumns = append(unchangedColumns, col.Name)
+			continue
+		}
+		value, err := s.decodeValue(col.DataType, data)
+		if err != nil {
+			return nil, nil, fmt.Errorf("unable to decode value of column %s.%s.%s: %w", relation.Namespace, relation.RelationName, col.Name, err)
+		}
+		row[col.Name] = value
+	}
+	return row, unchangedColumns, nil
+}
+
+// Decodes values into the same types the database/sql driver returns so that transformers see the same input as a batch sync
+func (s *changeStream) decodeValue(dataType uint32, col *pgoutput.TupleDataColumn) (any, error) {
+	if col.DataType == pgoutput.TupleDataTypeNull {
+		return nil, nil
+	}
+	format := int16(pgtype.TextFormatCode)
+	if col.DataType == pgoutput.TupleDataTypeBinary {
+		format = pgtype.BinaryFormatCode
+	}
+	pgType, ok := s.typeMap.TypeForOID(dataType)
+	if !ok {
+		// user defined types such as enums are sent in their text representation
+		return string(col.Data), nil
+	}
+	return pgType.Codec.DecodeDatabaseSQLValue(s.typeMap, dataType, format, col.Data)
+}
+
+func getKeyColumns(relation *pgoutput.RelationMessage, table *cdcTable) []string {
+	if len(table.PrimaryKeys) > 0 {
+		return table.PrimaryKeys
+	}
+	keys := []string{}
+	for _, col := range relation.Columns {
+		if col.IsKey() {
+			keys = append(keys, col.Name)
+		}
+	}
+	return keys
+}
+
+func haveKeysChanged(relation *pgoutput.RelationMessage, keyColumns []string, oldTuple, newTuple *pgoutput.TupleData) bool {
+	keySet := map[string]struct{}{}
+	for _, key := range keyColumns {
+		keySet[key] = struct{}{}
+	}
+	for idx, col := range relation.Columns {
+		if _, ok := keySet[col.Name]; !ok {
+			continue
+		}
+		if idx >= len(oldTuple.Columns) || idx >= len(newTuple.Columns) {
+			return false
+		}
+		oldVal := oldTuple.Columns[idx]
+		newVal := newTuple.Columns[idx]
+		if newVal.DataType == pgoutput.TupleDataTypeToast {
+			continue
+		}
+		if oldVal.DataType != newVal.DataType || !bytes.Equal(oldVal.Data, newVal.Data) {
+			return true
+		}
+	}
+	return false
+}
+
+func tableKey(table *cdcTable) string {
+	return neosync_benthos.BuildBenthosTable(table.Schema, table.Table)
+}
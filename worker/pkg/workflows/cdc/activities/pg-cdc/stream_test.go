@@ -0,0 +1,243 @@
+package pgcdc_activity
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/nucleuscloud/neosync/worker/internal/pgoutput"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	textOid = 25
+	int4Oid = 23
+)
+
+func newTestChangeStream(startLsn pgoutput.LSN) (*changeStream, *[][]*change) {
+	applied := [][]*change{}
+	tables := map[string]*cdcTable{
+		"public.users": {
+			Schema:      "public",
+			Table:       "users",
+			Columns:     map[string]struct{}{"id": {}, "name": {}},
+			PrimaryKeys: []string{"id"},
+		},
+	}
+	cs := newChangeStream(tables, startLsn, func(ctx context.Context, changes []*change) error {
+		applied = append(applied, changes)
+		return nil
+	})
+	return cs, &applied
+}
+
+func usersRelation() *pgoutput.RelationMessage {
+	return &pgoutput.RelationMessage{
+		RelationID:   1,
+		Namespace:    "public",
+		RelationName: "users",
+		Columns: []*pgoutput.RelationMessageColumn{
+			{Flags: 1, Name: "id", DataType: int4Oid},
+			{Name: "name", DataType: textOid},
+			{Name: "secret", DataType: textOid},
+		},
+	}
+}
+
+func tuple(values ...*pgoutput.TupleDataColumn) *pgoutput.TupleData {
+	return &pgoutput.TupleData{Columns: values}
+}
+
+func text(value string) *pgoutput.TupleDataColumn {
+	return &pgoutput.TupleDataColumn{DataType: pgoutput.TupleDataTypeText, Data: []byte(value)}
+}
+
+var (
+	null  = &pgoutput.TupleDataColumn{DataType: pgoutput.TupleDataTypeNull}
+	toast = &pgoutput.TupleDataColumn{DataType: pgoutput.TupleDataTypeToast}
+)
+
+func handleAll(t *testing.T, cs *changeStream, msgs ...pgoutput.Message) {
+	t.Helper()
+	for _, msg := range msgs {
+		require.NoError(t, cs.handleMessage(context.Background(), msg))
+	}
+}
+
+func Test_changeStream_AppliesTransactionOnCommit(t *testing.T) {
+	cs, applied := newTestChangeStream(0)
+
+	handleAll(
+		t, cs,
+		usersRelation(),
+		&pgoutput.BeginMessage{FinalLSN: 100},
+		&pgoutput.InsertMessage{RelationID: 1, Tuple: tuple(text("1"), text("nick"), text("shh"))},
+	)
+	assert.Empty(t, *applied, "changes must not be applied before the transaction commits")
+
+	handleAll(t, cs, &pgoutput.CommitMessage{CommitLSN: 100, TransactionEndLSN: 108})
+	require.Len(t, *applied, 1)
+	assert.Equal(t, []*change{
+		{
+			Table:            "public.users",
+			Operation:        operationUpsert,
+			Row:              map[string]any{"id": int64(1), "name": "nick"},
+			UnchangedColumns: []string{},
+		},
+	}, (*applied)[0])
+	assert.Equal(t, pgoutput.LSN(108), cs.Checkpoint())
+}
+
+func Test_changeStream_SkipsAppliedTransactions(t *testing.T) {
+	cs, applied := newTestChangeStream(200)
+
+	handleAll(
+		t, cs,
+		usersRelation(),
+		&pgoutput.BeginMessage{FinalLSN: 100},
+		&pgoutput.InsertMessage{RelationID: 1, Tuple: tuple(text("1"), text("nick"), null)},
+		&pgoutput.CommitMessage{CommitLSN: 100, TransactionEndLSN: 108},
+	)
+	assert.Empty(t, *applied)
+	assert.Equal(t, pgoutput.LSN(200), cs.Checkpoint())
+}
+
+func Test_changeStream_IgnoresUnmappedTables(t *testing.T) {
+	cs, applied := newTestChangeStream(0)
+	relation := usersRelation()
+	relation.RelationName = "orders"
+
+	handleAll(
+		t, cs,
+		relation,
+		&pgoutput.BeginMessage{FinalLSN: 100},
+		&pgoutput.InsertMessage{RelationID: 1, Tuple: tuple(text("1"), text("nick"), null)},
+		&pgoutput.CommitMessage{CommitLSN: 100, TransactionEndLSN: 108},
+	)
+	assert.Empty(t, *applied)
+	assert.Equal(t, pgoutput.LSN(108), cs.Checkpoint())
+}
+
+func Test_changeStream_UpdateWithChangedKey(t *testing.T) {
+	cs, applied := newTestChangeStream(0)
+
+	handleAll(
+		t, cs,
+		usersRelation(),
+		&pgoutput.BeginMessage{FinalLSN: 100},
+		&pgoutput.UpdateMessage{
+			RelationID:   1,
+			OldTupleType: pgoutput.TupleTypeKey,
+			OldTuple:     tuple(text("1"), null, null),
+			NewTuple:     tuple(text("2"), text("nick"), toast),
+		},
+		&pgoutput.CommitMessage{CommitLSN: 100, TransactionEndLSN: 108},
+	)
+	require.Len(t, *applied, 1)
+	assert.Equal(t, []*change{
+		{Table: "public.users", Operation: operationDelete, Row: map[string]any{"id": int64(1), "name": nil}},
+		{Table: "public.users", Operation: operationUpsert, Row: map[string]any{"id": int64(2), "name": "nick"}, UnchangedColumns: []string{}},
+	}, (*applied)[0])
+}
+
+func Test_changeStream_UpdateWithUnchangedKey(t *testing.T) {
+	cs, applied := newTestChangeStream(0)
+	tables := cs.tables
+	tables["public.users"].Columns["secret"] = struct{}{}
+
+	handleAll(
+		t, cs,
+		usersRelation(),
+		&pgoutput.BeginMessage{FinalLSN: 100},
+		&pgoutput.UpdateMessage{
+			RelationID:   1,
+			OldTupleType: pgoutput.TupleTypeOld,
+			OldTuple:     tuple(text("1"), text("nick"), toast),
+			NewTuple:     tuple(text("1"), text("nicholas"), toast),
+		},
+		&pgoutput.CommitMessage{CommitLSN: 100, TransactionEndLSN: 108},
+	)
+	require.Len(t, *applied, 1)
+	assert.Equal(t, []*change{
+		{
+			Table:            "public.users",
+			Operation:        operationUpsert,
+			Row:              map[string]any{"id": int64(1), "name": "nicholas"},
+			UnchangedColumns: []string{"secret"},
+		},
+	}, (*applied)[0])
+}
+
+func Test_changeStream_DeleteAndTruncate(t *testing.T) {
+	cs, applied := newTestChangeStream(0)
+
+	handleAll(
+		t, cs,
+		usersRelation(),
+		&pgoutput.BeginMessage{FinalLSN: 100},
+		&pgoutput.DeleteMessage{RelationID: 1, OldTupleType: pgoutput.TupleTypeKey, OldTuple: tuple(text("1"), null, null)},
+		&pgoutput.TruncateMessage{Options: 1, RelationIDs: []uint32{1}},
+		&pgoutput.CommitMessage{CommitLSN: 100, TransactionEndLSN: 108},
+	)
+	require.Len(t, *applied, 1)
+	assert.Equal(t, []*change{
+		{Table: "public.users", Operation: operationDelete, Row: map[string]any{"id": int64(1), "name": nil}},
+		{Table: "public.users", Operation: operationTruncate, Row: map[string]any{}, Cascade: true},
+	}, (*applied)[0])
+}
+
+func Test_changeStream_FlushesLargeTransactions(t *testing.T) {
+	cs, applied := newTestChangeStream(0)
+
+	handleAll(t, cs, usersRelation(), &pgoutput.BeginMessage{FinalLSN: 100})
+	for i := 0; i < maxPendingChanges+1; i++ {
+		handleAll(t, cs, &pgoutput.InsertMessage{RelationID: 1, Tuple: tuple(text("1"), null, null)})
+	}
+	require.Len(t, *applied, 1)
+	assert.Len(t, (*applied)[0], maxPendingChanges)
+	assert.Equal(t, pgoutput.LSN(0), cs.Checkpoint(), "checkpoint must not move until the transaction commits")
+
+	handleAll(t, cs, &pgoutput.CommitMessage{CommitLSN: 100, TransactionEndLSN: 108})
+	require.Len(t, *applied, 2)
+	assert.Len(t, (*applied)[1], 1)
+	assert.Equal(t, pgoutput.LSN(108), cs.Checkpoint())
+}
+
+func Test_changeStream_ApplyError(t *testing.T) {
+	cs := newChangeStream(map[string]*cdcTable{
+		"public.users": {Schema: "public", Table: "users", Columns: map[string]struct{}{"id": {}}},
+	}, 0, func(ctx context.Context, changes []*change) error {
+		return errors.New("boom")
+	})
+
+	handleAll(
+		t, cs,
+		usersRelation(),
+		&pgoutput.BeginMessage{FinalLSN: 100},
+		&pgoutput.InsertMessage{RelationID: 1, Tuple: tuple(text("1"), null, null)},
+	)
+	err := cs.handleMessage(context.Background(), &pgoutput.CommitMessage{CommitLSN: 100, TransactionEndLSN: 108})
+	assert.Error(t, err)
+	assert.Equal(t, pgoutput.LSN(0), cs.Checkpoint())
+}
+
+func Test_changeStream_UnknownRelation(t *testing.T) {
+	cs, _ := newTestChangeStream(0)
+	err := cs.handleMessage(context.Background(), &pgoutput.InsertMessage{RelationID: 5, Tuple: tuple(text("1"))})
+	assert.Error(t, err)
+}
+
+func Test_changeStream_handleKeepalive(t *testing.T) {
+	cs, _ := newTestChangeStream(100)
+
+	cs.handleKeepalive(&pgoutput.PrimaryKeepaliveMessage{ServerWALEnd: 50})
+	assert.Equal(t, pgoutput.LSN(100), cs.Checkpoint())
+
+	cs.handleKeepalive(&pgoutput.PrimaryKeepaliveMessage{ServerWALEnd: 200})
+	assert.Equal(t, pgoutput.LSN(200), cs.Checkpoint())
+
+	handleAll(t, cs, usersRelation(), &pgoutput.BeginMessage{FinalLSN: 300})
+	cs.handleKeepalive(&pgoutput.PrimaryKeepaliveMessage{ServerWALEnd: 400})
+	assert.Equal(t, pgoutput.LSN(200), cs.Checkpoint(), "checkpoint must not move while a transaction is in flight")
+}
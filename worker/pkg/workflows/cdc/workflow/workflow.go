@@ -0,0 +1,96 @@
+package cdc_workflow
+
+import (
+	"fmt"
+	"time"
+
+	pgcdc_activity "github.com/nucleuscloud/neosync/worker/pkg/workflows/cdc/activities/pg-cdc"
+	datasync_workflow "github.com/nucleuscloud/neosync/worker/pkg/workflows/datasync/workflow"
+	"go.temporal.io/sdk/log"
+	"go.temporal.io/sdk/temporal"
+	"go.temporal.io/sdk/workflow"
+)
+
+type WorkflowRequest struct {
+	JobId string
+	// Position that every change has been applied up to. Set when the workflow continues as new.
+	Lsn string
+}
+
+type WorkflowResponse struct{}
+
+// Long lived workflow that keeps the destinations of a job in sync with a postgres logical replication slot.
+// Changes are streamed in bounded activities after which the workflow continues as new with the applied lsn so that its history stays small.
+func CdcWorkflow(wfctx workflow.Context, req *WorkflowRequest) (*WorkflowResponse, error) {
+	wfinfo := workflow.GetInfo(wfctx)
+	logger := log.With(workflow.GetLogger(wfctx), "jobId", req.JobId)
+	logger.Info("cdc workflow starting")
+
+	var pgcdcActivity *pgcdc_activity.Activity
+
+	if req.Lsn == "" {
+		ctx := workflow.WithActivityOptions(wfctx, workflow.ActivityOptions{
+			StartToCloseTimeout: 1 * time.Minute,
+			RetryPolicy: &temporal.RetryPolicy{
+				MaximumAttempts: 2,
+			},
+			HeartbeatTimeout: 1 * time.Minute,
+		})
+		var slotResp *pgcdc_activity.EnsureReplicationSlotResponse
+		logger.Info("scheduling EnsureReplicationSlot for execution.")
+		err := workflow.ExecuteActivity(ctx, pgcdcActivity.EnsureReplicationSlot, &pgcdc_activity.EnsureReplicationSlotRequest{
+			JobId: req.JobId,
+		}).Get(ctx, &slotResp)
+		if err != nil {
+			return nil, err
+		}
+
+		if slotResp.ShouldSnapshot {
+			logger.Info("syncing initial snapshot")
+			childctx := workflow.WithChildOptions(wfctx, workflow.ChildWorkflowOptions{
+				WorkflowID: fmt.Sprintf("%s-snapshot", wfinfo.WorkflowExecution.ID),
+			})
+			err = workflow.ExecuteChildWorkflow(childctx, datasync_workflow.Workflow, &datasync_workflow.WorkflowRequest{
+				JobId: req.JobId,
+			}).Get(childctx, nil)
+			if err != nil {
+				logger.Error("initial snapshot failed, dropping replication slot")
+				dropErr := workflow.ExecuteActivity(ctx, pgcdcActivity.DropReplicationSlot, &pgcdc_activity.DropReplicationSlotRequest{
+					JobId: req.JobId,
+				}).Get(ctx, nil)
+				if dropErr != nil {
+					logger.Error(fmt.Sprintf("unable to drop replication slot: %s", dropErr.Error()))
+				}
+				return nil, err
+			}
+		}
+	}
+
+	ctx := workflow.WithActivityOptions(wfctx, workflow.ActivityOptions{
+		StartToCloseTimeout: pgcdc_activity.MaxStreamDuration + 5*time.Minute,
+		// retried until the workflow is canceled, resuming from the lsn recorded in the last heartbeat
+		RetryPolicy: &temporal.RetryPolicy{
+			MaximumInterval: 5 * time.Minute,
+		},
+		HeartbeatTimeout: 1 * time.Minute,
+	})
+	var streamResp *pgcdc_activity.StreamChangesResponse
+	logger.Info("scheduling StreamChanges for execution.")
+	err := workflow.ExecuteActivity(ctx, pgcdcActivity.StreamChanges, &pgcdc_activity.StreamChangesRequest{
+		JobId:    req.JobId,
+		StartLsn: req.Lsn,
+	}).Get(ctx, &streamResp)
+	if err != nil {
+		return nil, err
+	}
+
+	lsn := streamResp.Lsn
+	if lsn == "" {
+		lsn = req.Lsn
+	}
+	logger.Info(fmt.Sprintf("continuing cdc workflow from %s", lsn))
+	return nil, workflow.NewContinueAsNewError(wfctx, CdcWorkflow, &WorkflowRequest{
+		JobId: req.JobId,
+		Lsn:   lsn,
+	})
+}
@@ -0,0 +1,87 @@
+package cdc_workflow
+
+import (
+	"errors"
+	"testing"
+
+	pgcdc_activity "github.com/nucleuscloud/neosync/worker/pkg/workflows/cdc/activities/pg-cdc"
+	datasync_workflow "github.com/nucleuscloud/neosync/worker/pkg/workflows/datasync/workflow"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.temporal.io/sdk/converter"
+	"go.temporal.io/sdk/testsuite"
+	"go.temporal.io/sdk/workflow"
+)
+
+func Test_CdcWorkflow_Snapshots_And_Continues(t *testing.T) {
+	testSuite := &testsuite.WorkflowTestSuite{}
+	env := testSuite.NewTestWorkflowEnvironment()
+
+	var pgcdcActivity *pgcdc_activity.Activity
+	env.OnActivity(pgcdcActivity.EnsureReplicationSlot, mock.Anything, &pgcdc_activity.EnsureReplicationSlotRequest{JobId: "job-id"}).
+		Return(&pgcdc_activity.EnsureReplicationSlotResponse{SlotName: "neosync_job_id", ShouldSnapshot: true}, nil).Once()
+	env.RegisterWorkflow(datasync_workflow.Workflow)
+	env.OnWorkflow(datasync_workflow.Workflow, mock.Anything, &datasync_workflow.WorkflowRequest{JobId: "job-id"}).
+		Return(&datasync_workflow.WorkflowResponse{}, nil).Once()
+	env.OnActivity(pgcdcActivity.StreamChanges, mock.Anything, &pgcdc_activity.StreamChangesRequest{JobId: "job-id"}).
+		Return(&pgcdc_activity.StreamChangesResponse{Lsn: "0/16B3748"}, nil).Once()
+
+	env.ExecuteWorkflow(CdcWorkflow, &WorkflowRequest{JobId: "job-id"})
+
+	assert.True(t, env.IsWorkflowCompleted())
+	err := env.GetWorkflowError()
+	require.Error(t, err)
+	var continueAsNewErr *workflow.ContinueAsNewError
+	require.True(t, errors.As(err, &continueAsNewErr))
+
+	var nextReq *WorkflowRequest
+	require.NoError(t, converter.GetDefaultDataConverter().FromPayloads(continueAsNewErr.Input, &nextReq))
+	assert.Equal(t, &WorkflowRequest{JobId: "job-id", Lsn: "0/16B3748"}, nextReq)
+
+	env.AssertExpectations(t)
+}
+
+func Test_CdcWorkflow_Resumes_From_Lsn(t *testing.T) {
+	testSuite := &testsuite.WorkflowTestSuite{}
+	env := testSuite.NewTestWorkflowEnvironment()
+
+	var pgcdcActivity *pgcdc_activity.Activity
+	env.OnActivity(pgcdcActivity.StreamChanges, mock.Anything, &pgcdc_activity.StreamChangesRequest{JobId: "job-id", StartLsn: "0/16B3748"}).
+		Return(&pgcdc_activity.StreamChangesResponse{}, nil).Once()
+
+	env.ExecuteWorkflow(CdcWorkflow, &WorkflowRequest{JobId: "job-id", Lsn: "0/16B3748"})
+
+	assert.True(t, env.IsWorkflowCompleted())
+	var continueAsNewErr *workflow.ContinueAsNewError
+	require.True(t, errors.As(env.GetWorkflowError(), &continueAsNewErr))
+	var nextReq *WorkflowRequest
+	require.NoError(t, converter.GetDefaultDataConverter().FromPayloads(continueAsNewErr.Input, &nextReq))
+	assert.Equal(t, &WorkflowRequest{JobId: "job-id", Lsn: "0/16B3748"}, nextReq)
+
+	env.AssertExpectations(t)
+}
+
+func Test_CdcWorkflow_Drops_Slot_When_Snapshot_Fails(t *testing.T) {
+	testSuite := &testsuite.WorkflowTestSuite{}
+	env := testSuite.NewTestWorkflowEnvironment()
+
+	var pgcdcActivity *pgcdc_activity.Activity
+	env.OnActivity(pgcdcActivity.EnsureReplicationSlot, mock.Anything, mock.Anything).
+		Return(&pgcdc_activity.EnsureReplicationSlotResponse{SlotName: "neosync_job_id", ShouldSnapshot: true}, nil).Once()
+	env.RegisterWorkflow(datasync_workflow.Workflow)
+	env.OnWorkflow(datasync_workflow.Workflow, mock.Anything, mock.Anything).
+		Return(nil, errors.New("snapshot failed")).Once()
+	env.OnActivity(pgcdcActivity.DropReplicationSlot, mock.Anything, &pgcdc_activity.DropReplicationSlotRequest{JobId: "job-id"}).
+		Return(&pgcdc_activity.DropReplicationSlotResponse{}, nil).Once()
+
+	env.ExecuteWorkflow(CdcWorkflow, &WorkflowRequest{JobId: "job-id"})
+
+	assert.True(t, env.IsWorkflowCompleted())
+	err := env.GetWorkflowError()
+	require.Error(t, err)
+	var continueAsNewErr *workflow.ContinueAsNewError
+	assert.False(t, errors.As(err, &continueAsNewErr))
+
+	env.AssertExpectations(t)
+}
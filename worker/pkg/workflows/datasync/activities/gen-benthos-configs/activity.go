@@ -7,6 +7,7 @@ import (
 	mysql_queries "github.com/nucleuscloud/neosync/backend/gen/go/db/dbschemas/mysql"
 	pg_queries "github.com/nucleuscloud/neosync/backend/gen/go/db/dbschemas/postgresql"
 	"github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1/mgmtv1alpha1connect"
+	dbschemas_mssql "github.com/nucleuscloud/neosync/backend/pkg/dbschemas/mssql"
 	"github.com/nucleuscloud/neosync/backend/pkg/metrics"
 	"github.com/nucleuscloud/neosync/backend/pkg/sqlconnect"
 	tabledependency "github.com/nucleuscloud/neosync/backend/pkg/table-dependency"
@@ -22,7 +23,10 @@ type GenerateBenthosConfigsRequest struct {
 	WorkflowId string
 }
 type GenerateBenthosConfigsResponse struct {
+	AccountId      string
 	BenthosConfigs []*BenthosConfigResponse
+	// Watermarks for incrementally synced tables that are recorded once every config has synced successfully
+	Watermarks []*shared.JobRunWatermark
 }
 
 type BenthosRedisConfig struct {
@@ -45,6 +49,8 @@ type BenthosConfigResponse struct {
 	primaryKeys    []string
 	excludeColumns []string
 	updateConfig   *tabledependency.RunConfig
+	// set when the table is synced incrementally, rows are upserted on conflict with these columns
+	upsertKeys []string
 
 	metriclabels metrics.MetricLabels
 }
@@ -60,6 +66,7 @@ type Activity struct {
 
 	pgquerier    pg_queries.Querier
 	mysqlquerier mysql_queries.Querier
+	mssqlquerier dbschemas_mssql.Querier
 
 	metricsEnabled bool
 }
@@ -80,6 +87,7 @@ func New(
 		redisConfig:       redisConfig,
 		pgquerier:         pg_queries.New(),
 		mysqlquerier:      mysql_queries.New(),
+		mssqlquerier:      dbschemas_mssql.New(),
 		metricsEnabled:    metricsEnabled,
 	}
 }
@@ -114,12 +122,15 @@ func (a *Activity) GenerateBenthosConfigs(
 
 	pgpoolmap := map[string]pg_queries.DBTX{}
 	mysqlpoolmap := map[string]mysql_queries.DBTX{}
+	mssqlpoolmap := map[string]dbschemas_mssql.DBTX{}
 
 	bbuilder := newBenthosBuilder(
 		pgpoolmap,
 		a.pgquerier,
 		mysqlpoolmap,
 		a.mysqlquerier,
+		mssqlpoolmap,
+		a.mssqlquerier,
 		a.jobclient,
 		a.connclient,
 		a.transformerclient,
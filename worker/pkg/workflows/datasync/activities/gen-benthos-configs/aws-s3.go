@@ -0,0 +1,200 @@
+package genbenthosconfigs_activity
+
+import (
+	"fmt"
+	"strings"
+
+	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
+	dbschemas_utils "github.com/nucleuscloud/neosync/backend/pkg/dbschemas"
+	neosync_benthos "github.com/nucleuscloud/neosync/worker/internal/benthos"
+)
+
+const (
+	defaultAwsS3BatchCount  = 100
+	defaultAwsS3BatchPeriod = "5s"
+
+	parquetTypeUtf8    = "UTF8"
+	parquetTypeInt64   = "INT64"
+	parquetTypeDouble  = "DOUBLE"
+	parquetTypeBoolean = "BOOLEAN"
+)
+
+var (
+	parquetInt64DataTypes = map[string]struct{}{
+		"smallint": {}, "integer": {}, "bigint": {}, "int": {}, "int2": {}, "int4": {}, "int8": {},
+		"tinyint": {}, "mediumint": {}, "smallserial": {}, "serial": {}, "bigserial": {},
+	}
+	parquetDoubleDataTypes = map[string]struct{}{
+		"real": {}, "double precision": {}, "double": {}, "float": {}, "float4": {}, "float8": {},
+	}
+	parquetBooleanDataTypes = map[string]struct{}{
+		"boolean": {}, "bool": {},
+	}
+)
+
+// Builds the S3 output for a single table. Every table is written to its own folder so that each folder
+// can be loaded as a single dataset, regardless of the output format.
+func buildAwsS3Output(
+	connection *mgmtv1alpha1.AwsS3ConnectionConfig,
+	destOpts *mgmtv1alpha1.AwsS3DestinationConnectionOptions,
+	workflowId string,
+	schema, table string,
+	cols []string,
+	colInfoMap map[string]*dbschemas_utils.ColumnInfo,
+) *neosync_benthos.AwsS3Insert {
+	format := destOpts.GetFormat()
+
+	s3pathpieces := []string{}
+	if connection.PathPrefix != nil && *connection.PathPrefix != "" {
+		s3pathpieces = append(s3pathpieces, strings.Trim(*connection.PathPrefix, "/"))
+	}
+	s3pathpieces = append(
+		s3pathpieces,
+		"workflows",
+		workflowId,
+		"activities",
+		neosync_benthos.BuildBenthosTable(schema, table),
+		"data",
+		fmt.Sprintf(`${!count("files")}.%s`, getAwsS3FileExtension(format)),
+	)
+
+	batching := &neosync_benthos.Batching{
+		Count:      defaultAwsS3BatchCount,
+		Period:     defaultAwsS3BatchPeriod,
+		Processors: buildAwsS3BatchProcessors(format, cols, colInfoMap),
+	}
+	if destOpts.GetMaxFileSizeBytes() > 0 {
+		batching.Count = 0
+		batching.ByteSize = int(destOpts.GetMaxFileSizeBytes())
+	}
+
+	return &neosync_benthos.AwsS3Insert{
+		Bucket:      connection.Bucket,
+		MaxInFlight: 64,
+		Path:        fmt.Sprintf("/%s", strings.Join(s3pathpieces, "/")),
+		Batching:    batching,
+		Credentials: buildBenthosS3Credentials(connection.Credentials),
+		Region:      connection.GetRegion(),
+		Endpoint:    connection.GetEndpoint(),
+	}
+}
+
+func getAwsS3FileExtension(format mgmtv1alpha1.AwsS3OutputFormat) string {
+	switch format {
+	case mgmtv1alpha1.AwsS3OutputFormat_AWS_S3_OUTPUT_FORMAT_CSV:
+		return "csv"
+	case mgmtv1alpha1.AwsS3OutputFormat_AWS_S3_OUTPUT_FORMAT_PARQUET:
+		return "parquet"
+	default:
+		// gzipped json lines have always been written with the txt.gz extension
+		return "txt.gz"
+	}
+}
+
+// Each batch becomes a single file, so the batch processors are responsible for encoding the rows into the file format
+func buildAwsS3BatchProcessors(
+	format mgmtv1alpha1.AwsS3OutputFormat,
+	cols []string,
+	colInfoMap map[string]*dbschemas_utils.ColumnInfo,
+) []*neosync_benthos.BatchProcessor {
+	switch format {
+	case mgmtv1alpha1.AwsS3OutputFormat_AWS_S3_OUTPUT_FORMAT_CSV:
+		rowMapping := buildCsvRowMapping(cols)
+		headerMapping := fmt.Sprintf("root = %q + content().string()", buildCsvHeader(cols)+"\n")
+		return []*neosync_benthos.BatchProcessor{
+			{Mapping: &rowMapping},
+			{Archive: &neosync_benthos.ArchiveProcessor{Format: "lines"}},
+			{Mapping: &headerMapping},
+		}
+	case mgmtv1alpha1.AwsS3OutputFormat_AWS_S3_OUTPUT_FORMAT_PARQUET:
+		schema := buildParquetSchema(cols, colInfoMap)
+		rowMapping := buildParquetRowMapping(schema)
+		return []*neosync_benthos.BatchProcessor{
+			{Mapping: &rowMapping},
+			{ParquetEncode: &neosync_benthos.ParquetEncodeProcessor{
+				Schema:             schema,
+				DefaultCompression: "snappy",
+			}},
+		}
+	default:
+		return []*neosync_benthos.BatchProcessor{
+			{Archive: &neosync_benthos.ArchiveProcessor{Format: "lines"}},
+			{Compress: &neosync_benthos.CompressProcessor{Algorithm: "gzip"}},
+		}
+	}
+}
+
+// Null values are written as empty fields. Every other value is quoted so that empty strings can be told apart from nulls.
+func buildCsvRowMapping(cols []string) string {
+	values := make([]string, len(cols))
+	for idx, col := range cols {
+		values[idx] = fmt.Sprintf("this.%q", col)
+	}
+	return fmt.Sprintf(
+		`root = [%s].map_each(v -> if v == null { "" } else { "\"" + v.string().replace_all("\"", "\"\"") + "\"" }).join(",")`,
+		strings.Join(values, ", "),
+	)
+}
+
+func buildCsvHeader(cols []string) string {
+	header := make([]string, len(cols))
+	for idx, col := range cols {
+		header[idx] = fmt.Sprintf(`"%s"`, strings.ReplaceAll(col, `"`, `""`))
+	}
+	return strings.Join(header, ",")
+}
+
+// Columns are typed by their source data type where it maps cleanly onto a parquet type.
+// Everything else, including numerics that would lose precision as a double, is written as a string.
+func buildParquetSchema(
+	cols []string,
+	colInfoMap map[string]*dbschemas_utils.ColumnInfo,
+) []*neosync_benthos.ParquetSchemaField {
+	schema := make([]*neosync_benthos.ParquetSchemaField, len(cols))
+	for idx, col := range cols {
+		parquetType := parquetTypeUtf8
+		if colInfo, ok := colInfoMap[col]; ok && colInfo != nil {
+			parquetType = getParquetType(colInfo.DataType)
+		}
+		schema[idx] = &neosync_benthos.ParquetSchemaField{
+			Name:     col,
+			Type:     parquetType,
+			Optional: true,
+		}
+	}
+	return schema
+}
+
+func getParquetType(dataType string) string {
+	dataType = strings.ToLower(dataType)
+	if _, ok := parquetInt64DataTypes[dataType]; ok {
+		return parquetTypeInt64
+	}
+	if _, ok := parquetDoubleDataTypes[dataType]; ok {
+		return parquetTypeDouble
+	}
+	if _, ok := parquetBooleanDataTypes[dataType]; ok {
+		return parquetTypeBoolean
+	}
+	return parquetTypeUtf8
+}
+
+// Coerces each value into the type that the parquet encoder expects for its column
+func buildParquetRowMapping(schema []*neosync_benthos.ParquetSchemaField) string {
+	mappings := make([]string, len(schema))
+	for idx, field := range schema {
+		var coercion string
+		switch field.Type {
+		case parquetTypeInt64:
+			coercion = "int64()"
+		case parquetTypeDouble:
+			coercion = "number()"
+		case parquetTypeBoolean:
+			coercion = "bool()"
+		default:
+			coercion = "string()"
+		}
+		mappings[idx] = fmt.Sprintf("root.%q = if this.%q == null { null } else { this.%q.%s }", field.Name, field.Name, field.Name, coercion)
+	}
+	return strings.Join(mappings, "\n")
+}
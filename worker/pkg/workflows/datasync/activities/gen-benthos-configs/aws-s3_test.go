@@ -0,0 +1,171 @@
+package genbenthosconfigs_activity
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/benthosdev/benthos/v4/public/service"
+	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
+	dbschemas_utils "github.com/nucleuscloud/neosync/backend/pkg/dbschemas"
+	neosync_benthos "github.com/nucleuscloud/neosync/worker/internal/benthos"
+	"github.com/nucleuscloud/neosync/worker/pkg/workflows/datasync/activities/shared"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
+)
+
+func Test_buildAwsS3Output(t *testing.T) {
+	connection := &mgmtv1alpha1.AwsS3ConnectionConfig{
+		Bucket:     "neosync",
+		PathPrefix: shared.Ptr("/exports/"),
+		Region:     shared.Ptr("us-west-2"),
+	}
+
+	t.Run("defaults to gzipped json lines", func(t *testing.T) {
+		output := buildAwsS3Output(connection, nil, "wf-1", "public", "users", []string{"id"}, nil)
+		assert.Equal(t, "neosync", output.Bucket)
+		assert.Equal(t, "us-west-2", output.Region)
+		assert.Equal(t, `/exports/workflows/wf-1/activities/public.users/data/${!count("files")}.txt.gz`, output.Path)
+		assert.Equal(t, 100, output.Batching.Count)
+		assert.Equal(t, 0, output.Batching.ByteSize)
+		assert.Equal(t, []*neosync_benthos.BatchProcessor{
+			{Archive: &neosync_benthos.ArchiveProcessor{Format: "lines"}},
+			{Compress: &neosync_benthos.CompressProcessor{Algorithm: "gzip"}},
+		}, output.Batching.Processors)
+	})
+
+	t.Run("csv", func(t *testing.T) {
+		output := buildAwsS3Output(connection, &mgmtv1alpha1.AwsS3DestinationConnectionOptions{
+			Format: mgmtv1alpha1.AwsS3OutputFormat_AWS_S3_OUTPUT_FORMAT_CSV,
+		}, "wf-1", "public", "users", []string{"id"}, nil)
+		assert.Equal(t, `/exports/workflows/wf-1/activities/public.users/data/${!count("files")}.csv`, output.Path)
+	})
+
+	t.Run("parquet with max file size", func(t *testing.T) {
+		output := buildAwsS3Output(connection, &mgmtv1alpha1.AwsS3DestinationConnectionOptions{
+			Format:           mgmtv1alpha1.AwsS3OutputFormat_AWS_S3_OUTPUT_FORMAT_PARQUET,
+			MaxFileSizeBytes: shared.Ptr(uint64(64 * 1024 * 1024)),
+		}, "wf-1", "public", "users", []string{"id"}, nil)
+		assert.Equal(t, `/exports/workflows/wf-1/activities/public.users/data/${!count("files")}.parquet`, output.Path)
+		assert.Equal(t, 0, output.Batching.Count)
+		assert.Equal(t, 64*1024*1024, output.Batching.ByteSize)
+		assert.Equal(t, "5s", output.Batching.Period)
+	})
+}
+
+func Test_buildParquetSchema(t *testing.T) {
+	schema := buildParquetSchema(
+		[]string{"id", "score", "active", "name", "balance", "generated"},
+		map[string]*dbschemas_utils.ColumnInfo{
+			"id":      {DataType: "integer"},
+			"score":   {DataType: "double precision"},
+			"active":  {DataType: "boolean"},
+			"name":    {DataType: "character varying"},
+			"balance": {DataType: "numeric"},
+		},
+	)
+	assert.Equal(t, []*neosync_benthos.ParquetSchemaField{
+		{Name: "id", Type: "INT64", Optional: true},
+		{Name: "score", Type: "DOUBLE", Optional: true},
+		{Name: "active", Type: "BOOLEAN", Optional: true},
+		{Name: "name", Type: "UTF8", Optional: true},
+		{Name: "balance", Type: "UTF8", Optional: true},
+		{Name: "generated", Type: "UTF8", Optional: true},
+	}, schema)
+}
+
+func Test_buildAwsS3BatchProcessors_Csv(t *testing.T) {
+	processors := buildAwsS3BatchProcessors(
+		mgmtv1alpha1.AwsS3OutputFormat_AWS_S3_OUTPUT_FORMAT_CSV,
+		[]string{"id", "name", "bio"},
+		nil,
+	)
+	out := runBatchProcessors(t, toProcessorYamls(t, processors), buildStructuredBatch([]map[string]any{
+		{"id": 1, "name": `nick "the dev"`, "bio": ""},
+		{"id": 2, "name": "alisha", "bio": nil},
+	}))
+	require.Len(t, out, 1)
+	assert.Equal(t, "\"id\",\"name\",\"bio\"\n\"1\",\"nick \"\"the dev\"\"\",\"\"\n\"2\",\"alisha\",", string(out[0]))
+}
+
+func Test_buildAwsS3BatchProcessors_Parquet(t *testing.T) {
+	processors := buildAwsS3BatchProcessors(
+		mgmtv1alpha1.AwsS3OutputFormat_AWS_S3_OUTPUT_FORMAT_PARQUET,
+		[]string{"id", "name", "created_at"},
+		map[string]*dbschemas_utils.ColumnInfo{
+			"id":         {DataType: "bigint"},
+			"name":       {DataType: "text"},
+			"created_at": {DataType: "timestamp"},
+		},
+	)
+	createdAt := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	out := runBatchProcessors(t, toProcessorYamls(t, processors), buildStructuredBatch([]map[string]any{
+		{"id": 1, "name": "nick", "created_at": createdAt},
+		{"id": 2, "name": nil, "created_at": nil},
+	}))
+	require.Len(t, out, 1)
+
+	decoded := runBatchProcessors(t, []string{`parquet_decode: {}`}, service.MessageBatch{service.NewMessage(out[0])})
+	require.Len(t, decoded, 2)
+	assert.JSONEq(t, `{"id":1,"name":"nick","created_at":"2024-01-02T03:04:05Z"}`, string(decoded[0]))
+	assert.JSONEq(t, `{"id":2,"name":null,"created_at":null}`, string(decoded[1]))
+}
+
+func toProcessorYamls(t *testing.T, processors []*neosync_benthos.BatchProcessor) []string {
+	t.Helper()
+	processorYamls := make([]string, 0, len(processors))
+	for _, processor := range processors {
+		processorYaml, err := yaml.Marshal(processor)
+		require.NoError(t, err)
+		processorYamls = append(processorYamls, string(processorYaml))
+	}
+	return processorYamls
+}
+
+func buildStructuredBatch(rows []map[string]any) service.MessageBatch {
+	batch := make(service.MessageBatch, 0, len(rows))
+	for _, row := range rows {
+		msg := service.NewMessage(nil)
+		msg.SetStructured(row)
+		batch = append(batch, msg)
+	}
+	return batch
+}
+
+// Runs a single batch through the processors and returns the content of the resulting messages
+func runBatchProcessors(t *testing.T, processorYamls []string, batch service.MessageBatch) [][]byte {
+	t.Helper()
+	streambldr := service.NewEnvironment().NewStreamBuilder()
+	require.NoError(t, streambldr.SetLoggerYAML(`level: none`))
+	for _, processorYaml := range processorYamls {
+		require.NoError(t, streambldr.AddProcessorYAML(processorYaml))
+	}
+
+	produce, err := streambldr.AddBatchProducerFunc()
+	require.NoError(t, err)
+	var out [][]byte
+	err = streambldr.AddBatchConsumerFunc(func(ctx context.Context, batch service.MessageBatch) error {
+		for _, msg := range batch {
+			bits, err := msg.AsBytes()
+			if err != nil {
+				return err
+			}
+			out = append(out, bits)
+		}
+		return nil
+	})
+	require.NoError(t, err)
+
+	stream, err := streambldr.Build()
+	require.NoError(t, err)
+
+	streamErrChan := make(chan error, 1)
+	go func() {
+		streamErrChan <- stream.Run(context.Background())
+	}()
+	require.NoError(t, produce(context.Background(), batch))
+	require.NoError(t, stream.StopWithin(5*time.Second))
+	require.NoError(t, <-streamErrChan)
+	return out
+}
@@ -15,9 +15,13 @@ import (
 	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
 	"github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1/mgmtv1alpha1connect"
 	dbschemas_utils "github.com/nucleuscloud/neosync/backend/pkg/dbschemas"
+	dbschemas_mssql "github.com/nucleuscloud/neosync/backend/pkg/dbschemas/mssql"
 	dbschemas_mysql "github.com/nucleuscloud/neosync/backend/pkg/dbschemas/mysql"
 	dbschemas_postgres "github.com/nucleuscloud/neosync/backend/pkg/dbschemas/postgres"
+	dbschemas_sqlite "github.com/nucleuscloud/neosync/backend/pkg/dbschemas/sqlite"
+	"github.com/nucleuscloud/neosync/backend/pkg/fpe"
 	"github.com/nucleuscloud/neosync/backend/pkg/metrics"
+	"github.com/nucleuscloud/neosync/backend/pkg/secrets"
 	"github.com/nucleuscloud/neosync/backend/pkg/sqlconnect"
 	tabledependency "github.com/nucleuscloud/neosync/backend/pkg/table-dependency"
 	neosync_benthos "github.com/nucleuscloud/neosync/worker/internal/benthos"
@@ -28,6 +32,8 @@ import (
 const (
 	postgresDriver             = "postgres"
 	mysqlDriver                = "mysql"
+	sqlserverDriver            = "sqlserver"
+	sqliteDriver               = "sqlite"
 	generateDefault            = "generate_default"
 	passthrough                = "passthrough"
 	dbDefault                  = "DEFAULT"
@@ -43,6 +49,9 @@ type benthosBuilder struct {
 	mysqlpool    map[string]mysql_queries.DBTX
 	mysqlquerier mysql_queries.Querier
 
+	mssqlpool    map[string]dbschemas_mssql.DBTX
+	mssqlquerier dbschemas_mssql.Querier
+
 	sqlconnector sqlconnect.SqlConnector
 
 	jobclient         mgmtv1alpha1connect.JobServiceClient
@@ -64,6 +73,9 @@ func newBenthosBuilder(
 	mysqlpool map[string]mysql_queries.DBTX,
 	mysqlquerier mysql_queries.Querier,
 
+	mssqlpool map[string]dbschemas_mssql.DBTX,
+	mssqlquerier dbschemas_mssql.Querier,
+
 	jobclient mgmtv1alpha1connect.JobServiceClient,
 	connclient mgmtv1alpha1connect.ConnectionServiceClient,
 	transformerclient mgmtv1alpha1connect.TransformersServiceClient,
@@ -81,6 +93,8 @@ func newBenthosBuilder(
 		pgquerier:         pgquerier,
 		mysqlpool:         mysqlpool,
 		mysqlquerier:      mysqlquerier,
+		mssqlpool:         mssqlpool,
+		mssqlquerier:      mssqlquerier,
 		sqlconnector:      sqlconnector,
 		jobclient:         jobclient,
 		connclient:        connclient,
@@ -101,27 +115,19 @@ func (b *benthosBuilder) GenerateBenthosConfigs(
 	if err != nil {
 		return nil, fmt.Errorf("unable to get job by id: %w", err)
 	}
+	if job.Source != nil {
+		job.Source.Options = shared.GetSnapshotSourceOptions(job.Source.Options)
+	}
 	responses := []*BenthosConfigResponse{}
 
-	groupedMappings := groupMappingsByTable(job.Mappings)
-	groupedTableMapping := map[string]*tableMapping{}
-	for _, tm := range groupedMappings {
-		groupedTableMapping[neosync_benthos.BuildBenthosTable(tm.Schema, tm.Table)] = tm
-	}
+	groupedMappings, groupedTableMapping, colTransformerMap := groupJobMappings(job.Mappings)
 	uniqueSchemas := shared.GetUniqueSchemasFromMappings(job.Mappings)
 
-	colTransformerMap := map[string]map[string]*mgmtv1alpha1.JobMappingTransformer{} // schema.table ->  column -> transformer
-	for table, mapping := range groupedTableMapping {
-		colTransformerMap[table] = map[string]*mgmtv1alpha1.JobMappingTransformer{}
-		for _, m := range mapping.Mappings {
-			colTransformerMap[table][m.Column] = m.Transformer
-		}
-	}
-
 	// reverse of table dependency
 	// map of foreign key to source table + column
 	var tableConstraintsSource map[string]map[string]*dbschemas_utils.ForeignKey // schema.table -> column -> ForeignKey
 	var groupedColInfoMap map[string]map[string]*dbschemas_utils.ColumnInfo
+	var watermarks []*shared.JobRunWatermark
 
 	switch jobSourceConfig := job.Source.Options.Config.(type) {
 	case *mgmtv1alpha1.JobSourceOptions_Generate:
@@ -170,13 +176,12 @@ func (b *benthosBuilder) GenerateBenthosConfigs(
 			return nil, fmt.Errorf("unable to get database schema for postgres connection: %w", err)
 		}
 		groupedSchemas := dbschemas_postgres.GetUniqueSchemaColMappings(dbschemas)
-		if !areMappingsSubsetOfSchemas(groupedSchemas, job.Mappings) {
-			return nil, errors.New(jobmappingSubsetErrMsg)
-		}
-		if sqlOpts != nil && sqlOpts.HaltOnNewColumnAddition &&
-			shouldHaltOnSchemaAddition(groupedSchemas, job.Mappings) {
-			return nil, errors.New(haltOnSchemaAdditionErrMsg)
+		driftPolicies := getSchemaDriftPolicies(sqlOpts.GetHaltOnNewColumnAddition(), sqlOpts.GetSchemaDriftOptions())
+		job.Mappings, err = b.handleSchemaDrift(ctx, req.JobId, groupedSchemas, job.Mappings, driftPolicies, slogger)
+		if err != nil {
+			return nil, err
 		}
+		groupedMappings, groupedTableMapping, colTransformerMap = groupJobMappings(job.Mappings)
 
 		groupedColInfoMap = groupedSchemas
 
@@ -194,6 +199,12 @@ func (b *benthosBuilder) GenerateBenthosConfigs(
 		tables := filterNullTables(groupedMappings)
 		dependencyConfigs := tabledependency.GetRunConfigs(td, tables, tableSubsetMap)
 
+		err = b.setSqlWatermarks(ctx, req.JobId, tables, sourceTableOpts, getPostgresWatermarkEndValueFn(pool))
+		if err != nil {
+			return nil, err
+		}
+		watermarks = getJobRunWatermarks(sourceTableOpts)
+
 		// reverse of table dependency
 		// map of foreign key to source table + column
 		tableConstraintsSource = getForeignKeyToSourceMap(td)
@@ -243,6 +254,12 @@ func (b *benthosBuilder) GenerateBenthosConfigs(
 			} else {
 				return nil, fmt.Errorf("unexpected number of dependency configs")
 			}
+
+			upsertKeys, err := getIncrementalUpsertKeys(tableName, sourceTableOpts, primaryKeys)
+			if err != nil {
+				return nil, err
+			}
+			resp.upsertKeys = upsertKeys
 		}
 
 	case *mgmtv1alpha1.JobSourceOptions_Mysql:
@@ -282,13 +299,12 @@ func (b *benthosBuilder) GenerateBenthosConfigs(
 			return nil, fmt.Errorf("unable to get database schema for mysql connection: %w", err)
 		}
 		groupedSchemas := dbschemas_mysql.GetUniqueSchemaColMappings(dbschemas)
-		if !areMappingsSubsetOfSchemas(groupedSchemas, job.Mappings) {
-			return nil, errors.New(jobmappingSubsetErrMsg)
-		}
-		if sqlOpts != nil && sqlOpts.HaltOnNewColumnAddition &&
-			shouldHaltOnSchemaAddition(groupedSchemas, job.Mappings) {
-			return nil, errors.New(haltOnSchemaAdditionErrMsg)
+		driftPolicies := getSchemaDriftPolicies(sqlOpts.GetHaltOnNewColumnAddition(), sqlOpts.GetSchemaDriftOptions())
+		job.Mappings, err = b.handleSchemaDrift(ctx, req.JobId, groupedSchemas, job.Mappings, driftPolicies, slogger)
+		if err != nil {
+			return nil, err
 		}
+		groupedMappings, groupedTableMapping, colTransformerMap = groupJobMappings(job.Mappings)
 		groupedColInfoMap = groupedSchemas
 
 		allConstraints, err := dbschemas_mysql.GetAllMysqlFkConstraints(b.mysqlquerier, ctx, pool, uniqueSchemas)
@@ -305,6 +321,12 @@ func (b *benthosBuilder) GenerateBenthosConfigs(
 		tables := filterNullTables(groupedMappings)
 		dependencyConfigs := tabledependency.GetRunConfigs(td, tables, tableSubsetMap)
 
+		err = b.setSqlWatermarks(ctx, req.JobId, tables, sourceTableOpts, getMysqlWatermarkEndValueFn(pool))
+		if err != nil {
+			return nil, err
+		}
+		watermarks = getJobRunWatermarks(sourceTableOpts)
+
 		// reverse of table dependency
 		// map of foreign key to source table + column
 		tableConstraintsSource = getForeignKeyToSourceMap(td)
@@ -328,6 +350,120 @@ func (b *benthosBuilder) GenerateBenthosConfigs(
 			}
 		}
 
+		for _, resp := range responses {
+			tableName := neosync_benthos.BuildBenthosTable(resp.TableSchema, resp.TableName)
+			configs := dependencyMap[tableName]
+			if len(configs) > 1 {
+				// circular dependency
+				for _, c := range configs {
+					if c.Columns != nil && c.Columns.Exclude != nil && len(c.Columns.Exclude) > 0 {
+						resp.excludeColumns = c.Columns.Exclude
+						resp.DependsOn = c.DependsOn
+					} else if c.Columns != nil && c.Columns.Include != nil && len(c.Columns.Include) > 0 {
+						pks := primaryKeys[tableName]
+						if len(pks) == 0 {
+							return nil, fmt.Errorf("no primary keys found for table (%s). Unable to build update query", tableName)
+						}
+						// config for sql update
+						resp.updateConfig = c
+						resp.primaryKeys = primaryKeys[tableName]
+					}
+				}
+			} else if len(configs) == 1 {
+				resp.DependsOn = configs[0].DependsOn
+			} else {
+				return nil, fmt.Errorf("unexpected number of benthos configs")
+			}
+
+			upsertKeys, err := getIncrementalUpsertKeys(tableName, sourceTableOpts, primaryKeys)
+			if err != nil {
+				return nil, err
+			}
+			resp.upsertKeys = upsertKeys
+		}
+
+	case *mgmtv1alpha1.JobSourceOptions_Mssql:
+		sourceConnection, err := b.getConnectionById(ctx, jobSourceConfig.Mssql.ConnectionId)
+		if err != nil {
+			return nil, fmt.Errorf("unable to get connection (%s) by id: %w", jobSourceConfig.Mssql.ConnectionId, err)
+		}
+		mssqlconfig := sourceConnection.ConnectionConfig.GetMssqlConfig()
+		if mssqlconfig == nil {
+			return nil, fmt.Errorf("source connection (%s) is not a mssql config", jobSourceConfig.Mssql.ConnectionId)
+		}
+
+		sqlOpts := jobSourceConfig.Mssql
+		var sourceTableOpts map[string]*sqlSourceTableOptions
+		if sqlOpts != nil {
+			sourceTableOpts = groupMssqlSourceOptionsByTable(sqlOpts.Schemas)
+		}
+		tableSubsetMap := buildTableSubsetMap(sourceTableOpts)
+
+		if _, ok := b.mssqlpool[sourceConnection.Id]; !ok {
+			conn, err := b.sqlconnector.NewDbFromConnectionConfig(sourceConnection.ConnectionConfig, shared.Ptr(uint32(5)), slogger)
+			if err != nil {
+				return nil, fmt.Errorf("unable to create new mssql pool from connection config: %w", err)
+			}
+			pool, err := conn.Open()
+			if err != nil {
+				return nil, fmt.Errorf("unable to open mssql connection: %w", err)
+			}
+			defer conn.Close()
+			b.mssqlpool[sourceConnection.Id] = pool
+		}
+		pool := b.mssqlpool[sourceConnection.Id]
+
+		// validate job mappings align with sql connections
+		dbschemas, err := b.mssqlquerier.GetDatabaseSchema(ctx, pool)
+		if err != nil {
+			return nil, fmt.Errorf("unable to get database schema for mssql connection: %w", err)
+		}
+		groupedSchemas := dbschemas_mssql.GetUniqueSchemaColMappings(dbschemas)
+		driftPolicies := getSchemaDriftPolicies(sqlOpts.GetHaltOnNewColumnAddition(), sqlOpts.GetSchemaDriftOptions())
+		job.Mappings, err = b.handleSchemaDrift(ctx, req.JobId, groupedSchemas, job.Mappings, driftPolicies, slogger)
+		if err != nil {
+			return nil, err
+		}
+		groupedMappings, groupedTableMapping, colTransformerMap = groupJobMappings(job.Mappings)
+		groupedColInfoMap = groupedSchemas
+
+		allConstraints, err := dbschemas_mssql.GetAllMssqlFkConstraints(b.mssqlquerier, ctx, pool, uniqueSchemas)
+		if err != nil {
+			return nil, fmt.Errorf("unable to retrieve mssql foreign key constraints: %w", err)
+		}
+		slogger.Info(fmt.Sprintf("found %d foreign key constraints for database", len(allConstraints)))
+		td := dbschemas_mssql.GetMssqlTableDependencies(allConstraints)
+		primaryKeys, err := b.getAllMssqlPkConstraints(ctx, pool, uniqueSchemas)
+		if err != nil {
+			return nil, fmt.Errorf("unable to get all mssql primary key constraints: %w", err)
+		}
+
+		tables := filterNullTables(groupedMappings)
+		dependencyConfigs := tabledependency.GetRunConfigs(td, tables, tableSubsetMap)
+
+		// reverse of table dependency
+		// map of foreign key to source table + column
+		tableConstraintsSource = getForeignKeyToSourceMap(td)
+		tableQueryMap, err := buildSelectQueryMap(sqlserverDriver, groupedTableMapping, sourceTableOpts, td, dependencyConfigs, jobSourceConfig.Mssql.SubsetByForeignKeyConstraints)
+		if err != nil {
+			return nil, fmt.Errorf("unable to build mssql select queries: %w", err)
+		}
+		sourceResponses, err := buildBenthosSqlSourceConfigResponses(ctx, b.transformerclient, groupedMappings, jobSourceConfig.Mssql.ConnectionId, sqlserverDriver, tableQueryMap, groupedSchemas, td, colTransformerMap, primaryKeys, b.jobId, b.runId, b.redisConfig)
+		if err != nil {
+			return nil, fmt.Errorf("unable to build mssql benthos sql source config responses: %w", err)
+		}
+		responses = append(responses, sourceResponses...)
+
+		dependencyMap := map[string][]*tabledependency.RunConfig{}
+		for _, cfg := range dependencyConfigs {
+			_, ok := dependencyMap[cfg.Table]
+			if ok {
+				dependencyMap[cfg.Table] = append(dependencyMap[cfg.Table], cfg)
+			} else {
+				dependencyMap[cfg.Table] = []*tabledependency.RunConfig{cfg}
+			}
+		}
+
 		for _, resp := range responses {
 			tableName := neosync_benthos.BuildBenthosTable(resp.TableSchema, resp.TableName)
 			configs := dependencyMap[tableName]
@@ -432,7 +568,7 @@ func (b *benthosBuilder) GenerateBenthosConfigs(
 
 					if resp.updateConfig != nil {
 						// circular dependency -> create update benthos config
-						updateResp, err := createSqlUpdateBenthosConfig(ctx, b.transformerclient, resp, dsn, resp.TableSchema, resp.TableName, tm, colSourceMap, groupedColInfoMap, tableConstraintsSource[neosync_benthos.BuildBenthosTable(resp.TableSchema, resp.TableName)], b.jobId, b.runId, b.redisConfig)
+						updateResp, err := createSqlUpdateBenthosConfig(ctx, b.transformerclient, resp, postgresDriver, dsn, resp.TableSchema, resp.TableName, tm, colSourceMap, groupedColInfoMap, tableConstraintsSource[neosync_benthos.BuildBenthosTable(resp.TableSchema, resp.TableName)], b.jobId, b.runId, b.redisConfig)
 						if err != nil {
 							return nil, fmt.Errorf("unable to create sql update benthos config: %w", err)
 						}
@@ -490,7 +626,7 @@ func (b *benthosBuilder) GenerateBenthosConfigs(
 					})
 					if resp.updateConfig != nil {
 						// circular dependency -> create update benthos config
-						updateResp, err := createSqlUpdateBenthosConfig(ctx, b.transformerclient, resp, dsn, resp.TableSchema, resp.TableName, tm, colSourceMap, groupedColInfoMap, tableConstraintsSource[neosync_benthos.BuildBenthosTable(resp.TableSchema, resp.TableName)], b.jobId, b.runId, b.redisConfig)
+						updateResp, err := createSqlUpdateBenthosConfig(ctx, b.transformerclient, resp, mysqlDriver, dsn, resp.TableSchema, resp.TableName, tm, colSourceMap, groupedColInfoMap, tableConstraintsSource[neosync_benthos.BuildBenthosTable(resp.TableSchema, resp.TableName)], b.jobId, b.runId, b.redisConfig)
 						if err != nil {
 							return nil, fmt.Errorf("unable to create sql update benthos config: %w", err)
 						}
@@ -523,41 +659,146 @@ func (b *benthosBuilder) GenerateBenthosConfigs(
 					return nil, errors.New("unable to build destination connection due to unsupported source connection")
 				}
 
-			case *mgmtv1alpha1.ConnectionConfig_AwsS3Config:
-				s3pathpieces := []string{}
-				if connection.AwsS3Config.PathPrefix != nil && *connection.AwsS3Config.PathPrefix != "" {
-					s3pathpieces = append(s3pathpieces, strings.Trim(*connection.AwsS3Config.PathPrefix, "/"))
+			case *mgmtv1alpha1.ConnectionConfig_MssqlConfig:
+				if len(resp.upsertKeys) > 0 {
+					return nil, fmt.Errorf("incremental sync is not currently supported for mssql destinations. table: %s", tableKey)
+				}
+				resp.BenthosDsns = append(resp.BenthosDsns, &shared.BenthosDsn{EnvVarKey: dstEnvVarKey, ConnectionId: destination.ConnectionId})
+
+				if resp.Config.Input.SqlSelect != nil || resp.Config.Input.PooledSqlRaw != nil {
+					colSourceMap := map[string]mgmtv1alpha1.TransformerSource{}
+					for _, col := range tm.Mappings {
+						colSourceMap[col.Column] = col.GetTransformer().Source
+					}
+
+					out := buildMssqlOutputQueryAndArgs(resp, tm, resp.TableSchema, resp.TableName, colSourceMap, groupedColInfoMap[tableKey])
+					resp.Columns = out.Columns
+					resp.Config.Output.Broker.Outputs = append(resp.Config.Output.Broker.Outputs, neosync_benthos.Outputs{
+						PooledSqlRaw: &neosync_benthos.PooledSqlRaw{
+							Driver: sqlserverDriver,
+							Dsn:    dsn,
+
+							Query:       out.Query,
+							ArgsMapping: out.ArgsMapping,
+
+							Batching: &neosync_benthos.Batching{
+								Period: "5s",
+								Count:  100,
+							},
+						},
+					})
+					if resp.updateConfig != nil {
+						// circular dependency -> create update benthos config
+						updateResp, err := createSqlUpdateBenthosConfig(ctx, b.transformerclient, resp, sqlserverDriver, dsn, resp.TableSchema, resp.TableName, tm, colSourceMap, groupedColInfoMap, tableConstraintsSource[neosync_benthos.BuildBenthosTable(resp.TableSchema, resp.TableName)], b.jobId, b.runId, b.redisConfig)
+						if err != nil {
+							return nil, fmt.Errorf("unable to create sql update benthos config: %w", err)
+						}
+						updateResponses = append(updateResponses, updateResp)
+					}
+				} else if resp.Config.Input.Generate != nil {
+					cols := buildPlainColumns(tm.Mappings)
+					colSourceMap := map[string]mgmtv1alpha1.TransformerSource{}
+					for _, col := range tm.Mappings {
+						colSourceMap[col.Column] = col.GetTransformer().Source
+					}
+					// filters out default columns
+					filteredCols := filterColsBySource(cols, colSourceMap)
+
+					resp.Config.Output.Broker.Outputs = append(resp.Config.Output.Broker.Outputs, neosync_benthos.Outputs{
+						PooledSqlRaw: &neosync_benthos.PooledSqlRaw{
+							Driver: sqlserverDriver,
+							Dsn:    dsn,
+
+							Query:       buildMssqlInsertQuery(resp.TableSchema, resp.TableName, cols, colSourceMap, groupedColInfoMap[tableKey]),
+							ArgsMapping: buildPlainInsertArgs(filteredCols),
+
+							Batching: &neosync_benthos.Batching{
+								Period: "5s",
+								Count:  100,
+							},
+						},
+					})
+				} else {
+					return nil, errors.New("unable to build destination connection due to unsupported source connection")
 				}
 
-				s3pathpieces = append(
-					s3pathpieces,
-					"workflows",
-					req.WorkflowId,
-					"activities",
-					neosync_benthos.BuildBenthosTable(resp.TableSchema, resp.TableName),
-					"data",
-					`${!count("files")}.txt.gz`,
-				)
+			case *mgmtv1alpha1.ConnectionConfig_SqliteConfig:
+				resp.BenthosDsns = append(resp.BenthosDsns, &shared.BenthosDsn{EnvVarKey: dstEnvVarKey, ConnectionId: destination.ConnectionId})
+
+				if resp.Config.Input.SqlSelect != nil || resp.Config.Input.PooledSqlRaw != nil {
+					colSourceMap := map[string]mgmtv1alpha1.TransformerSource{}
+					for _, col := range tm.Mappings {
+						colSourceMap[col.Column] = col.GetTransformer().Source
+					}
+
+					out := buildSqliteOutputQueryAndArgs(resp, tm, resp.TableName, colSourceMap)
+					resp.Columns = out.Columns
+					resp.Config.Output.Broker.Outputs = append(resp.Config.Output.Broker.Outputs, neosync_benthos.Outputs{
+						PooledSqlRaw: &neosync_benthos.PooledSqlRaw{
+							Driver: sqliteDriver,
+							Dsn:    dsn,
+
+							Query:       out.Query,
+							ArgsMapping: out.ArgsMapping,
+
+							Batching: &neosync_benthos.Batching{
+								Period: "5s",
+								Count:  100,
+							},
+						},
+					})
+					if resp.updateConfig != nil {
+						// circular dependency -> create update benthos config
+						updateResp, err := createSqlUpdateBenthosConfig(ctx, b.transformerclient, resp, sqliteDriver, dsn, resp.TableSchema, resp.TableName, tm, colSourceMap, groupedColInfoMap, tableConstraintsSource[neosync_benthos.BuildBenthosTable(resp.TableSchema, resp.TableName)], b.jobId, b.runId, b.redisConfig)
+						if err != nil {
+							return nil, fmt.Errorf("unable to create sql update benthos config: %w", err)
+						}
+						updateResponses = append(updateResponses, updateResp)
+					}
+				} else if resp.Config.Input.Generate != nil {
+					cols := buildPlainColumns(tm.Mappings)
+					colSourceMap := map[string]mgmtv1alpha1.TransformerSource{}
+					for _, col := range tm.Mappings {
+						colSourceMap[col.Column] = col.GetTransformer().Source
+					}
+					// filters out default columns
+					filteredCols := filterColsBySource(cols, colSourceMap)
+
+					resp.Config.Output.Broker.Outputs = append(resp.Config.Output.Broker.Outputs, neosync_benthos.Outputs{
+						PooledSqlRaw: &neosync_benthos.PooledSqlRaw{
+							Driver: sqliteDriver,
+							Dsn:    dsn,
+
+							Query:       buildSqliteInsertQuery(resp.TableName, cols, colSourceMap),
+							ArgsMapping: buildPlainInsertArgs(filteredCols),
 
+							Batching: &neosync_benthos.Batching{
+								Period: "5s",
+								Count:  100,
+							},
+						},
+					})
+				} else {
+					return nil, errors.New("unable to build destination connection due to unsupported source connection")
+				}
+
+			case *mgmtv1alpha1.ConnectionConfig_AwsS3Config:
+				s3Config, err := secrets.DefaultResolver().ResolveAwsS3Config(ctx, connection.AwsS3Config)
+				if err != nil {
+					return nil, fmt.Errorf("unable to resolve aws s3 credentials for destination connection: %w", err)
+				}
 				cols := buildPlainColumns(tm.Mappings)
 				resp.Columns = cols
 				resp.Config.Output.Broker.Outputs = append(resp.Config.Output.Broker.Outputs, neosync_benthos.Outputs{
-					AwsS3: &neosync_benthos.AwsS3Insert{
-						Bucket:      connection.AwsS3Config.Bucket,
-						MaxInFlight: 64,
-						Path:        fmt.Sprintf("/%s", strings.Join(s3pathpieces, "/")),
-						Batching: &neosync_benthos.Batching{
-							Count:  100,
-							Period: "5s",
-							Processors: []*neosync_benthos.BatchProcessor{
-								{Archive: &neosync_benthos.ArchiveProcessor{Format: "lines"}},
-								{Compress: &neosync_benthos.CompressProcessor{Algorithm: "gzip"}},
-							},
-						},
-						Credentials: buildBenthosS3Credentials(connection.AwsS3Config.Credentials),
-						Region:      connection.AwsS3Config.GetRegion(),
-						Endpoint:    connection.AwsS3Config.GetEndpoint(),
-					},
+					AwsS3: buildAwsS3Output(
+						s3Config,
+						destination.GetOptions().GetAwsS3Options(),
+						req.WorkflowId,
+						resp.TableSchema,
+						resp.TableName,
+						cols,
+						groupedColInfoMap[tableKey],
+					),
 				})
 			default:
 				return nil, fmt.Errorf("unsupported destination connection config")
@@ -585,7 +826,9 @@ func (b *benthosBuilder) GenerateBenthosConfigs(
 
 	slogger.Info(fmt.Sprintf("successfully built %d benthos configs", len(responses)))
 	return &GenerateBenthosConfigsResponse{
+		AccountId:      job.AccountId,
 		BenthosConfigs: responses,
+		Watermarks:     watermarks,
 	}, nil
 }
 
@@ -632,8 +875,12 @@ func buildPostgresOutputQueryAndArgs(resp *BenthosConfigResponse, tm *tableMappi
 		}
 		escapedInsertColumns := buildPlainColumns(filteredInsertMappings)
 		filteredInsertCols := filterColsBySource(buildPlainColumns(filteredInsertMappings), colSourceMap) // filters out default columns
+		query := buildPostgresInsertQuery(schema, table, escapedInsertColumns, colSourceMap)
+		if len(resp.upsertKeys) > 0 {
+			query = buildPostgresUpsertQuery(schema, table, escapedInsertColumns, colSourceMap, resp.upsertKeys)
+		}
 		return &sqlOutput{
-			Query:       buildPostgresInsertQuery(schema, table, escapedInsertColumns, colSourceMap),
+			Query:       query,
 			ArgsMapping: buildPlainInsertArgs(filteredInsertCols),
 			Columns:     escapedInsertColumns,
 		}
@@ -659,8 +906,12 @@ func buildPostgresOutputQueryAndArgs(resp *BenthosConfigResponse, tm *tableMappi
 	} else {
 		cols := buildPlainColumns(tm.Mappings)
 		filteredCols := filterColsBySource(cols, colSourceMap) // filters out default columns
+		query := buildPostgresInsertQuery(schema, table, cols, colSourceMap)
+		if len(resp.upsertKeys) > 0 {
+			query = buildPostgresUpsertQuery(schema, table, cols, colSourceMap, resp.upsertKeys)
+		}
 		return &sqlOutput{
-			Query:       buildPostgresInsertQuery(schema, table, cols, colSourceMap),
+			Query:       query,
 			ArgsMapping: buildPlainInsertArgs(filteredCols),
 			Columns:     cols,
 		}
@@ -677,8 +928,12 @@ func buildMysqlOutputQueryAndArgs(resp *BenthosConfigResponse, tm *tableMapping,
 		}
 		insertCols := buildPlainColumns(filteredInsertMappings)
 		filteredInsertCols := filterColsBySource(insertCols, colSourceMap) // filters out default columns
+		query := buildMysqlInsertQuery(schema, table, insertCols, colSourceMap)
+		if len(resp.upsertKeys) > 0 {
+			query = buildMysqlUpsertQuery(schema, table, insertCols, colSourceMap, resp.upsertKeys)
+		}
 		return &sqlOutput{
-			Query:       buildMysqlInsertQuery(schema, table, insertCols, colSourceMap),
+			Query:       query,
 			ArgsMapping: buildPlainInsertArgs(filteredInsertCols),
 			Columns:     insertCols,
 		}
@@ -704,8 +959,117 @@ func buildMysqlOutputQueryAndArgs(resp *BenthosConfigResponse, tm *tableMapping,
 	} else {
 		cols := buildPlainColumns(tm.Mappings)
 		filteredCols := filterColsBySource(cols, colSourceMap) // filters out default columns
+		query := buildMysqlInsertQuery(schema, table, cols, colSourceMap)
+		if len(resp.upsertKeys) > 0 {
+			query = buildMysqlUpsertQuery(schema, table, cols, colSourceMap, resp.upsertKeys)
+		}
 		return &sqlOutput{
-			Query:       buildMysqlInsertQuery(schema, table, cols, colSourceMap),
+			Query:       query,
+			ArgsMapping: buildPlainInsertArgs(filteredCols),
+			Columns:     cols,
+		}
+	}
+}
+
+func buildMssqlOutputQueryAndArgs(
+	resp *BenthosConfigResponse,
+	tm *tableMapping,
+	schema, table string,
+	colSourceMap map[string]mgmtv1alpha1.TransformerSource,
+	colInfoMap map[string]*dbschemas_utils.ColumnInfo,
+) *sqlOutput {
+	if len(resp.excludeColumns) > 0 {
+		filteredInsertMappings := []*mgmtv1alpha1.JobMapping{}
+		for _, m := range tm.Mappings {
+			if !slices.Contains(resp.excludeColumns, m.Column) {
+				filteredInsertMappings = append(filteredInsertMappings, m)
+			}
+		}
+		insertCols := buildPlainColumns(filteredInsertMappings)
+		filteredInsertCols := filterColsBySource(insertCols, colSourceMap) // filters out default columns
+		return &sqlOutput{
+			Query:       buildMssqlInsertQuery(schema, table, insertCols, colSourceMap, colInfoMap),
+			ArgsMapping: buildPlainInsertArgs(filteredInsertCols),
+			Columns:     insertCols,
+		}
+	} else if resp.updateConfig != nil && resp.updateConfig.Columns != nil && len(resp.updateConfig.Columns.Include) > 0 {
+		filteredUpdateMappings := []*mgmtv1alpha1.JobMapping{}
+
+		for _, m := range tm.Mappings {
+			if slices.Contains(resp.updateConfig.Columns.Include, m.Column) {
+				filteredUpdateMappings = append(filteredUpdateMappings, m)
+			}
+		}
+		updateCols := buildPlainColumns(filteredUpdateMappings)
+		filteredUpdateCols := filterColsBySource(updateCols, colSourceMap) // filters out default columns
+		updateArgsMapping := []string{}
+		updateArgsMapping = append(updateArgsMapping, filteredUpdateCols...)
+		updateArgsMapping = append(updateArgsMapping, resp.primaryKeys...)
+
+		return &sqlOutput{
+			Query:       buildMssqlUpdateQuery(schema, table, updateCols, colSourceMap, resp.primaryKeys),
+			ArgsMapping: buildPlainInsertArgs(updateArgsMapping),
+			Columns:     updateCols,
+		}
+	} else {
+		cols := buildPlainColumns(tm.Mappings)
+		filteredCols := filterColsBySource(cols, colSourceMap) // filters out default columns
+		return &sqlOutput{
+			Query:       buildMssqlInsertQuery(schema, table, cols, colSourceMap, colInfoMap),
+			ArgsMapping: buildPlainInsertArgs(filteredCols),
+			Columns:     cols,
+		}
+	}
+}
+
+// SQLite has no schemas, so the source schema is dropped and rows are written to the table by name
+func buildSqliteOutputQueryAndArgs(resp *BenthosConfigResponse, tm *tableMapping, table string, colSourceMap map[string]mgmtv1alpha1.TransformerSource) *sqlOutput {
+	if len(resp.excludeColumns) > 0 {
+		filteredInsertMappings := []*mgmtv1alpha1.JobMapping{}
+		for _, m := range tm.Mappings {
+			if !slices.Contains(resp.excludeColumns, m.Column) {
+				filteredInsertMappings = append(filteredInsertMappings, m)
+			}
+		}
+		insertCols := buildPlainColumns(filteredInsertMappings)
+		filteredInsertCols := filterColsBySource(insertCols, colSourceMap) // filters out default columns
+		query := buildSqliteInsertQuery(table, insertCols, colSourceMap)
+		if len(resp.upsertKeys) > 0 {
+			query = buildSqliteUpsertQuery(table, insertCols, colSourceMap, resp.upsertKeys)
+		}
+		return &sqlOutput{
+			Query:       query,
+			ArgsMapping: buildPlainInsertArgs(filteredInsertCols),
+			Columns:     insertCols,
+		}
+	} else if resp.updateConfig != nil && resp.updateConfig.Columns != nil && len(resp.updateConfig.Columns.Include) > 0 {
+		filteredUpdateMappings := []*mgmtv1alpha1.JobMapping{}
+
+		for _, m := range tm.Mappings {
+			if slices.Contains(resp.updateConfig.Columns.Include, m.Column) {
+				filteredUpdateMappings = append(filteredUpdateMappings, m)
+			}
+		}
+		updateCols := buildPlainColumns(filteredUpdateMappings)
+		filteredUpdateCols := filterColsBySource(updateCols, colSourceMap) // filters out default columns
+		updateArgsMapping := []string{}
+		updateArgsMapping = append(updateArgsMapping, filteredUpdateCols...)
+		updateArgsMapping = append(updateArgsMapping, resp.primaryKeys...)
+
+		return &sqlOutput{
+			Query:       buildSqliteUpdateQuery(table, updateCols, colSourceMap, resp.primaryKeys),
+			ArgsMapping: buildPlainInsertArgs(updateArgsMapping),
+			Columns:     updateCols,
+		}
+	} else {
+		cols := buildPlainColumns(tm.Mappings)
+		filteredCols := filterColsBySource(cols, colSourceMap) // filters out default columns
+		query := buildSqliteInsertQuery(table, cols, colSourceMap)
+		if len(resp.upsertKeys) > 0 {
+			query = buildSqliteUpsertQuery(table, cols, colSourceMap, resp.upsertKeys)
+		}
+		return &sqlOutput{
+			Query:       query,
 			ArgsMapping: buildPlainInsertArgs(filteredCols),
 			Columns:     cols,
 		}
@@ -856,6 +1220,19 @@ func (b *benthosBuilder) getAllMysqlPkConstraints(
 	return pkMap, nil
 }
 
+func (b *benthosBuilder) getAllMssqlPkConstraints(
+	ctx context.Context,
+	conn dbschemas_mssql.DBTX,
+	uniqueSchemas []string,
+) (map[string][]string, error) {
+	primaryKeyConstraints, err := dbschemas_mssql.GetAllMssqlPkConstraints(b.mssqlquerier, ctx, conn, uniqueSchemas)
+	if err != nil {
+		return nil, err
+	}
+	pkMap := dbschemas_mssql.GetMssqlTablePrimaryKeys(primaryKeyConstraints)
+	return pkMap, nil
+}
+
 func buildPostgresUpdateQuery(schema, table string, columns []string, colSourceMap map[string]mgmtv1alpha1.TransformerSource, primaryKeys []string) string {
 	values := make([]string, len(columns))
 	var where string
@@ -900,6 +1277,23 @@ func buildPostgresInsertQuery(schema, table string, columns []string, colSourceM
 	)
 }
 
+// Inserts the row, overwriting the non-key columns of any existing row that conflicts on the provided keys
+func buildPostgresUpsertQuery(schema, table string, columns []string, colSourceMap map[string]mgmtv1alpha1.TransformerSource, conflictKeys []string) string {
+	insertQuery := strings.TrimSuffix(buildPostgresInsertQuery(schema, table, columns, colSourceMap), ";")
+	updates := []string{}
+	for _, col := range columns {
+		if slices.Contains(conflictKeys, col) {
+			continue
+		}
+		updates = append(updates, fmt.Sprintf("%s = EXCLUDED.%s", dbschemas_postgres.EscapePgColumn(col), dbschemas_postgres.EscapePgColumn(col)))
+	}
+	conflictCols := strings.Join(dbschemas_postgres.EscapePgColumns(conflictKeys), ", ")
+	if len(updates) == 0 {
+		return fmt.Sprintf("%s ON CONFLICT (%s) DO NOTHING;", insertQuery, conflictCols)
+	}
+	return fmt.Sprintf("%s ON CONFLICT (%s) DO UPDATE SET %s;", insertQuery, conflictCols, strings.Join(updates, ", "))
+}
+
 func buildMysqlInsertQuery(schema, table string, columns []string, colSourceMap map[string]mgmtv1alpha1.TransformerSource) string {
 	values := make([]string, len(columns))
 	for i, col := range columns {
@@ -918,6 +1312,23 @@ func buildMysqlInsertQuery(schema, table string, columns []string, colSourceMap
 	)
 }
 
+// Inserts the row, overwriting the non-key columns of any existing row with the same primary or unique key
+func buildMysqlUpsertQuery(schema, table string, columns []string, colSourceMap map[string]mgmtv1alpha1.TransformerSource, conflictKeys []string) string {
+	insertQuery := strings.TrimSuffix(buildMysqlInsertQuery(schema, table, columns, colSourceMap), ";")
+	updates := []string{}
+	for _, col := range columns {
+		if slices.Contains(conflictKeys, col) {
+			continue
+		}
+		updates = append(updates, fmt.Sprintf("%s = VALUES(%s)", dbschemas_mysql.EscapeMysqlColumn(col), dbschemas_mysql.EscapeMysqlColumn(col)))
+	}
+	if len(updates) == 0 {
+		// mysql has no do nothing equivalent, assigning a key to itself leaves the existing row untouched
+		updates = append(updates, fmt.Sprintf("%s = %s", dbschemas_mysql.EscapeMysqlColumn(conflictKeys[0]), dbschemas_mysql.EscapeMysqlColumn(conflictKeys[0])))
+	}
+	return fmt.Sprintf("%s ON DUPLICATE KEY UPDATE %s;", insertQuery, strings.Join(updates, ", "))
+}
+
 func buildMysqlUpdateQuery(schema, table string, columns []string, colSourceMap map[string]mgmtv1alpha1.TransformerSource, primaryKeys []string) string {
 	values := make([]string, len(columns))
 	var where string
@@ -939,6 +1350,133 @@ func buildMysqlUpdateQuery(schema, table string, columns []string, colSourceMap
 	return fmt.Sprintf("UPDATE %s SET %s %s;", fmt.Sprintf("`%s`.`%s`", schema, table), strings.Join(values, ", "), where)
 }
 
+// Identity columns that are generated by the database are left out of the insert so that the database assigns them.
+// Identity columns that are given a value may only be inserted while IDENTITY_INSERT is on for the table.
+func buildMssqlInsertQuery(
+	schema, table string,
+	columns []string,
+	colSourceMap map[string]mgmtv1alpha1.TransformerSource,
+	colInfoMap map[string]*dbschemas_utils.ColumnInfo,
+) string {
+	insertCols := []string{}
+	values := []string{}
+	hasIdentityValue := false
+	paramCount := 1
+	for _, col := range columns {
+		isIdentity := colInfoMap[col] != nil && colInfoMap[col].IsIdentity
+		colSource := colSourceMap[col]
+		if colSource == mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_GENERATE_DEFAULT {
+			if isIdentity {
+				continue
+			}
+			values = append(values, dbDefault)
+		} else {
+			values = append(values, fmt.Sprintf("@p%d", paramCount))
+			paramCount++
+			if isIdentity {
+				hasIdentityValue = true
+			}
+		}
+		insertCols = append(insertCols, col)
+	}
+
+	tableName := dbschemas_mssql.EscapeMssqlTable(schema, table)
+	if len(insertCols) == 0 {
+		return fmt.Sprintf("INSERT INTO %s DEFAULT VALUES;", tableName)
+	}
+	query := fmt.Sprintf(
+		"INSERT INTO %s (%s) VALUES (%s);",
+		tableName,
+		strings.Join(dbschemas_mssql.EscapeMssqlColumns(insertCols), ", "),
+		strings.Join(values, ", "),
+	)
+	if hasIdentityValue {
+		return fmt.Sprintf("SET IDENTITY_INSERT %s ON; %s SET IDENTITY_INSERT %s OFF;", tableName, query, tableName)
+	}
+	return query
+}
+
+func buildMssqlUpdateQuery(schema, table string, columns []string, colSourceMap map[string]mgmtv1alpha1.TransformerSource, primaryKeys []string) string {
+	values := make([]string, len(columns))
+	var where string
+	paramCount := 1
+	for i, col := range columns {
+		colSource := colSourceMap[col]
+		if colSource == mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_GENERATE_DEFAULT {
+			values[i] = fmt.Sprintf("%s = %s", dbschemas_mssql.EscapeMssqlColumn(col), dbDefault)
+		} else {
+			values[i] = fmt.Sprintf("%s = @p%d", dbschemas_mssql.EscapeMssqlColumn(col), paramCount)
+			paramCount++
+		}
+	}
+	if len(primaryKeys) > 0 {
+		clauses := []string{}
+		for _, col := range primaryKeys {
+			clauses = append(clauses, fmt.Sprintf("%s = @p%d", dbschemas_mssql.EscapeMssqlColumn(col), paramCount))
+			paramCount++
+		}
+		where = fmt.Sprintf("WHERE %s", strings.Join(clauses, " AND "))
+	}
+	return fmt.Sprintf("UPDATE %s SET %s %s;", dbschemas_mssql.EscapeMssqlTable(schema, table), strings.Join(values, ", "), where)
+}
+
+// SQLite does not support the DEFAULT keyword in a VALUES list, so default columns are left out of the insert entirely
+func buildSqliteInsertQuery(table string, columns []string, colSourceMap map[string]mgmtv1alpha1.TransformerSource) string {
+	filteredCols := filterColsBySource(columns, colSourceMap)
+	if len(filteredCols) == 0 {
+		return fmt.Sprintf("INSERT INTO %s DEFAULT VALUES;", dbschemas_sqlite.EscapeSqliteTable(table))
+	}
+	values := make([]string, len(filteredCols))
+	for i := range filteredCols {
+		values[i] = "?"
+	}
+	return fmt.Sprintf(
+		"INSERT INTO %s (%s) VALUES (%s);",
+		dbschemas_sqlite.EscapeSqliteTable(table),
+		strings.Join(dbschemas_sqlite.EscapeSqliteColumns(filteredCols), ", "),
+		strings.Join(values, ", "),
+	)
+}
+
+// Inserts the row, overwriting the non-key columns of any existing row that conflicts on the provided keys
+func buildSqliteUpsertQuery(table string, columns []string, colSourceMap map[string]mgmtv1alpha1.TransformerSource, conflictKeys []string) string {
+	filteredCols := filterColsBySource(columns, colSourceMap)
+	if len(filteredCols) == 0 {
+		// sqlite does not support an upsert clause on DEFAULT VALUES inserts
+		return buildSqliteInsertQuery(table, columns, colSourceMap)
+	}
+	insertQuery := strings.TrimSuffix(buildSqliteInsertQuery(table, columns, colSourceMap), ";")
+	updates := []string{}
+	for _, col := range filteredCols {
+		if slices.Contains(conflictKeys, col) {
+			continue
+		}
+		updates = append(updates, fmt.Sprintf("%s = excluded.%s", dbschemas_sqlite.EscapeSqliteColumn(col), dbschemas_sqlite.EscapeSqliteColumn(col)))
+	}
+	conflictCols := strings.Join(dbschemas_sqlite.EscapeSqliteColumns(conflictKeys), ", ")
+	if len(updates) == 0 {
+		return fmt.Sprintf("%s ON CONFLICT (%s) DO NOTHING;", insertQuery, conflictCols)
+	}
+	return fmt.Sprintf("%s ON CONFLICT (%s) DO UPDATE SET %s;", insertQuery, conflictCols, strings.Join(updates, ", "))
+}
+
+func buildSqliteUpdateQuery(table string, columns []string, colSourceMap map[string]mgmtv1alpha1.TransformerSource, primaryKeys []string) string {
+	filteredCols := filterColsBySource(columns, colSourceMap)
+	values := make([]string, len(filteredCols))
+	var where string
+	for i, col := range filteredCols {
+		values[i] = fmt.Sprintf("%s = ?", dbschemas_sqlite.EscapeSqliteColumn(col))
+	}
+	if len(primaryKeys) > 0 {
+		clauses := []string{}
+		for _, col := range primaryKeys {
+			clauses = append(clauses, fmt.Sprintf("%s = ?", dbschemas_sqlite.EscapeSqliteColumn(col)))
+		}
+		where = fmt.Sprintf("WHERE %s", strings.Join(clauses, " AND "))
+	}
+	return fmt.Sprintf("UPDATE %s SET %s %s;", dbschemas_sqlite.EscapeSqliteTable(table), strings.Join(values, ", "), where)
+}
+
 func filterColsBySource(columns []string, colSourceMap map[string]mgmtv1alpha1.TransformerSource) []string {
 	filteredCols := []string{}
 	for _, col := range columns {
@@ -976,6 +1514,7 @@ func createSqlUpdateBenthosConfig(
 	ctx context.Context,
 	transformerclient mgmtv1alpha1connect.TransformersServiceClient,
 	insertConfig *BenthosConfigResponse,
+	destinationDriver string,
 	dsn,
 	schema string,
 	table string,
@@ -1060,12 +1599,17 @@ func createSqlUpdateBenthosConfig(
 		newResp.primaryKeys = insertConfig.primaryKeys
 		newResp.metriclabels = append(newResp.metriclabels, metrics.NewEqLabel(metrics.IsUpdateConfigLabel, "true"))
 		var output *sqlOutput
-		if driver == postgresDriver {
-			out := buildPostgresOutputQueryAndArgs(newResp, tm, schema, table, colSourceMap)
-			output = out
-		} else if driver == mysqlDriver {
-			out := buildMysqlOutputQueryAndArgs(newResp, tm, schema, table, colSourceMap)
-			output = out
+		switch destinationDriver {
+		case postgresDriver:
+			output = buildPostgresOutputQueryAndArgs(newResp, tm, schema, table, colSourceMap)
+		case mysqlDriver:
+			output = buildMysqlOutputQueryAndArgs(newResp, tm, schema, table, colSourceMap)
+		case sqlserverDriver:
+			output = buildMssqlOutputQueryAndArgs(newResp, tm, schema, table, colSourceMap, groupedColInfo[neosync_benthos.BuildBenthosTable(schema, table)])
+		case sqliteDriver:
+			output = buildSqliteOutputQueryAndArgs(newResp, tm, table, colSourceMap)
+		default:
+			return nil, fmt.Errorf("unsupported destination driver for sql update config: %s", destinationDriver)
 		}
 		newResp.Columns = output.Columns
 		if newResp.Config.Input.SqlSelect != nil {
@@ -1076,7 +1620,7 @@ func createSqlUpdateBenthosConfig(
 		newResp.BenthosDsns = insertConfig.BenthosDsns
 		newResp.Config.Output.Broker.Outputs = append(newResp.Config.Output.Broker.Outputs, neosync_benthos.Outputs{
 			PooledSqlRaw: &neosync_benthos.PooledSqlRaw{
-				Driver: driver,
+				Driver: destinationDriver,
 				Dsn:    dsn,
 
 				Query:       output.Query,
@@ -1098,7 +1642,11 @@ func hasTransformer(t mgmtv1alpha1.TransformerSource) bool {
 }
 
 type sqlSourceTableOptions struct {
-	WhereClause *string
+	WhereClause     *string
+	WatermarkColumn *string
+
+	// resolved at run time for tables that are configured for incremental sync
+	watermark *sqlWatermark
 }
 
 func buildBenthosSqlSourceConfigResponses(
@@ -1236,42 +1784,6 @@ func buildBenthosS3Credentials(mgmtCreds *mgmtv1alpha1.AwsS3Credentials) *neosyn
 	return creds
 }
 
-func areMappingsSubsetOfSchemas(
-	groupedSchemas map[string]map[string]*dbschemas_utils.ColumnInfo,
-	mappings []*mgmtv1alpha1.JobMapping,
-) bool {
-	tableColMappings := getUniqueColMappingsMap(mappings)
-
-	for key := range groupedSchemas {
-		// For this method, we only care about the schemas+tables that we currently have mappings for
-		if _, ok := tableColMappings[key]; !ok {
-			delete(groupedSchemas, key)
-		}
-	}
-
-	if len(tableColMappings) != len(groupedSchemas) {
-		return false
-	}
-
-	// tests to make sure that every column in the col mappings is present in the db schema
-	for table, cols := range tableColMappings {
-		schemaCols, ok := groupedSchemas[table]
-		if !ok {
-			return false
-		}
-		// job mappings has more columns than the schema
-		if len(cols) > len(schemaCols) {
-			return false
-		}
-		for col := range cols {
-			if _, ok := schemaCols[col]; !ok {
-				return false
-			}
-		}
-	}
-	return true
-}
-
 func getUniqueColMappingsMap(
 	mappings []*mgmtv1alpha1.JobMapping,
 ) map[string]map[string]struct{} {
@@ -1289,39 +1801,16 @@ func getUniqueColMappingsMap(
 	return tableColMappings
 }
 
-func shouldHaltOnSchemaAddition(
-	groupedSchemas map[string]map[string]*dbschemas_utils.ColumnInfo,
-	mappings []*mgmtv1alpha1.JobMapping,
-) bool {
-	tableColMappings := getUniqueColMappingsMap(mappings)
-
-	if len(tableColMappings) != len(groupedSchemas) {
-		return true
-	}
-
-	for table, cols := range groupedSchemas {
-		mappingCols, ok := tableColMappings[table]
-		if !ok {
-			return true
-		}
-		if len(cols) > len(mappingCols) {
-			return true
-		}
-		for col := range cols {
-			if _, ok := mappingCols[col]; !ok {
-				return true
-			}
-		}
-	}
-	return false
-}
-
 func escapeColsByDriver(cols []string, driver string) []string {
 	switch driver {
 	case postgresDriver:
 		return dbschemas_postgres.EscapePgColumns(cols)
 	case mysqlDriver:
 		return dbschemas_mysql.EscapeMysqlColumns(cols)
+	case sqlserverDriver:
+		return dbschemas_mssql.EscapeMssqlColumns(cols)
+	case sqliteDriver:
+		return dbschemas_sqlite.EscapeSqliteColumns(cols)
 	default:
 		return cols
 	}
@@ -1373,7 +1862,8 @@ func groupPostgresSourceOptionsByTable(
 			tableOpt := schemaOpt.Tables[tidx]
 			key := neosync_benthos.BuildBenthosTable(schemaOpt.Schema, tableOpt.Table)
 			groupedMappings[key] = &sqlSourceTableOptions{
-				WhereClause: tableOpt.WhereClause,
+				WhereClause:     tableOpt.WhereClause,
+				WatermarkColumn: tableOpt.WatermarkColumn,
 			}
 		}
 	}
@@ -1386,6 +1876,26 @@ func groupMysqlSourceOptionsByTable(
 ) map[string]*sqlSourceTableOptions {
 	groupedMappings := map[string]*sqlSourceTableOptions{}
 
+	for idx := range schemaOptions {
+		schemaOpt := schemaOptions[idx]
+		for tidx := range schemaOpt.Tables {
+			tableOpt := schemaOpt.Tables[tidx]
+			key := neosync_benthos.BuildBenthosTable(schemaOpt.Schema, tableOpt.Table)
+			groupedMappings[key] = &sqlSourceTableOptions{
+				WhereClause:     tableOpt.WhereClause,
+				WatermarkColumn: tableOpt.WatermarkColumn,
+			}
+		}
+	}
+
+	return groupedMappings
+}
+
+func groupMssqlSourceOptionsByTable(
+	schemaOptions []*mgmtv1alpha1.MssqlSourceSchemaOption,
+) map[string]*sqlSourceTableOptions {
+	groupedMappings := map[string]*sqlSourceTableOptions{}
+
 	for idx := range schemaOptions {
 		schemaOpt := schemaOptions[idx]
 		for tidx := range schemaOpt.Tables {
@@ -1400,6 +1910,26 @@ func groupMysqlSourceOptionsByTable(
 	return groupedMappings
 }
 
+// Returns the mappings grouped by table, keyed by schema.table, along with map of schema.table -> column -> transformer
+func groupJobMappings(
+	mappings []*mgmtv1alpha1.JobMapping,
+) ([]*tableMapping, map[string]*tableMapping, map[string]map[string]*mgmtv1alpha1.JobMappingTransformer) {
+	groupedMappings := groupMappingsByTable(mappings)
+	groupedTableMapping := map[string]*tableMapping{}
+	for _, tm := range groupedMappings {
+		groupedTableMapping[neosync_benthos.BuildBenthosTable(tm.Schema, tm.Table)] = tm
+	}
+
+	colTransformerMap := map[string]map[string]*mgmtv1alpha1.JobMappingTransformer{} // schema.table ->  column -> transformer
+	for table, mapping := range groupedTableMapping {
+		colTransformerMap[table] = map[string]*mgmtv1alpha1.JobMappingTransformer{}
+		for _, m := range mapping.Mappings {
+			colTransformerMap[table][m.Column] = m.Transformer
+		}
+	}
+	return groupedMappings, groupedTableMapping, colTransformerMap
+}
+
 func groupMappingsByTable(
 	mappings []*mgmtv1alpha1.JobMapping,
 ) []*tableMapping {
@@ -1428,6 +1958,17 @@ type tableMapping struct {
 	Mappings []*mgmtv1alpha1.JobMapping
 }
 
+// Builds the processors that run a single table's rows through the job's transformers.
+// Used by workflows that stream rows outside of a generated benthos config, so foreign key values are not cached in redis.
+func BuildTableProcessorConfigs(
+	ctx context.Context,
+	transformerclient mgmtv1alpha1connect.TransformersServiceClient,
+	cols []*mgmtv1alpha1.JobMapping,
+	tableColumnInfo map[string]*dbschemas_utils.ColumnInfo,
+) ([]*neosync_benthos.ProcessorConfig, error) {
+	return buildProcessorConfigs(ctx, transformerclient, cols, tableColumnInfo, map[string]*dbschemas_utils.ForeignKey{}, []string{}, "", "", nil)
+}
+
 func buildProcessorConfigs(
 	ctx context.Context,
 	transformerclient mgmtv1alpha1connect.TransformersServiceClient,
@@ -1731,20 +2272,25 @@ func computeMutationFunction(col *mgmtv1alpha1.JobMapping, colInfo *dbschemas_ut
 			return "", err
 		}
 
-		return fmt.Sprintf("transform_email(email:this.%q,preserve_domain:%t,preserve_length:%t,excluded_domains:%v,max_length:%d)", col.Column, pd, pl, excludedDomainsStr, maxLen), nil
+		deterministic := col.Transformer.Config.GetTransformEmailConfig().Deterministic
+		return withDeterministicKey(fmt.Sprintf("transform_email(email:this.%q,preserve_domain:%t,preserve_length:%t,excluded_domains:%v,max_length:%d)", col.Column, pd, pl, excludedDomainsStr, maxLen), deterministic), nil
 	case mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_GENERATE_BOOL:
 		return "generate_bool()", nil
 	case mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_GENERATE_CARD_NUMBER:
 		luhn := col.Transformer.Config.GetGenerateCardNumberConfig().ValidLuhn
 		return fmt.Sprintf(`generate_card_number(valid_luhn:%t)`, luhn), nil
 	case mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_GENERATE_CITY:
-		return fmt.Sprintf(`generate_city(max_length:%d)`, maxLen), nil
+		config := col.Transformer.Config.GetGenerateCityConfig()
+		return withLocale(fmt.Sprintf(`generate_city(max_length:%d)`, maxLen), config.Locale, config.LocaleColumn), nil
 	case mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_GENERATE_E164_PHONE_NUMBER:
 		min := col.Transformer.Config.GetGenerateE164PhoneNumberConfig().Min
 		max := col.Transformer.Config.GetGenerateE164PhoneNumberConfig().Max
-		return fmt.Sprintf(`generate_e164_phone_number(min:%d,max:%d)`, min, max), nil
+		locale := col.Transformer.Config.GetGenerateE164PhoneNumberConfig().Locale
+		localeColumn := col.Transformer.Config.GetGenerateE164PhoneNumberConfig().LocaleColumn
+		return withLocale(fmt.Sprintf(`generate_e164_phone_number(min:%d,max:%d)`, min, max), locale, localeColumn), nil
 	case mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_GENERATE_FIRST_NAME:
-		return fmt.Sprintf(`generate_first_name(max_length:%d)`, maxLen), nil
+		config := col.Transformer.Config.GetGenerateFirstNameConfig()
+		return withLocale(fmt.Sprintf(`generate_first_name(max_length:%d)`, maxLen), config.Locale, config.LocaleColumn), nil
 	case mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_GENERATE_FLOAT64:
 		randomSign := col.Transformer.Config.GetGenerateFloat64Config().RandomizeSign
 		min := col.Transformer.Config.GetGenerateFloat64Config().Min
@@ -1752,9 +2298,11 @@ func computeMutationFunction(col *mgmtv1alpha1.JobMapping, colInfo *dbschemas_ut
 		precision := col.Transformer.Config.GetGenerateFloat64Config().Precision
 		return fmt.Sprintf(`generate_float64(randomize_sign:%t, min:%f, max:%f, precision:%d)`, randomSign, min, max, precision), nil
 	case mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_GENERATE_FULL_ADDRESS:
-		return fmt.Sprintf(`generate_full_address(max_length:%d)`, maxLen), nil
+		config := col.Transformer.Config.GetGenerateFullAddressConfig()
+		return withLocale(fmt.Sprintf(`generate_full_address(max_length:%d)`, maxLen), config.Locale, config.LocaleColumn), nil
 	case mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_GENERATE_FULL_NAME:
-		return fmt.Sprintf(`generate_full_name(max_length:%d)`, maxLen), nil
+		config := col.Transformer.Config.GetGenerateFullNameConfig()
+		return withLocale(fmt.Sprintf(`generate_full_name(max_length:%d)`, maxLen), config.Locale, config.LocaleColumn), nil
 	case mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_GENERATE_GENDER:
 		ab := col.Transformer.Config.GetGenerateGenderConfig().Abbreviate
 		return fmt.Sprintf(`generate_gender(abbreviate:%t,max_length:%d)`, ab, maxLen), nil
@@ -1766,21 +2314,26 @@ func computeMutationFunction(col *mgmtv1alpha1.JobMapping, colInfo *dbschemas_ut
 		max := col.Transformer.Config.GetGenerateInt64Config().Max
 		return fmt.Sprintf(`generate_int64(randomize_sign:%t,min:%d, max:%d)`, sign, min, max), nil
 	case mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_GENERATE_LAST_NAME:
-		return fmt.Sprintf(`generate_last_name(max_length:%d)`, maxLen), nil
+		config := col.Transformer.Config.GetGenerateLastNameConfig()
+		return withLocale(fmt.Sprintf(`generate_last_name(max_length:%d)`, maxLen), config.Locale, config.LocaleColumn), nil
 	case mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_GENERATE_SHA256HASH:
 		return `generate_sha256hash()`, nil
 	case mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_GENERATE_SSN:
 		return "generate_ssn()", nil
 	case mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_GENERATE_STATE:
-		return "generate_state()", nil
+		config := col.Transformer.Config.GetGenerateStateConfig()
+		return withLocale("generate_state()", config.Locale, config.LocaleColumn), nil
 	case mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_GENERATE_STREET_ADDRESS:
-		return fmt.Sprintf(`generate_street_address(max_length:%d)`, maxLen), nil
+		config := col.Transformer.Config.GetGenerateStreetAddressConfig()
+		return withLocale(fmt.Sprintf(`generate_street_address(max_length:%d)`, maxLen), config.Locale, config.LocaleColumn), nil
 	case mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_GENERATE_STRING_PHONE_NUMBER:
 		min := col.Transformer.Config.GetGenerateStringPhoneNumberConfig().Min
 		max := col.Transformer.Config.GetGenerateStringPhoneNumberConfig().Max
 		min = transformer_utils.MinInt(min, maxLen)
 		max = transformer_utils.Ceil(max, maxLen)
-		return fmt.Sprintf("generate_string_phone_number(min:%d,max:%d)", min, max), nil
+		locale := col.Transformer.Config.GetGenerateStringPhoneNumberConfig().Locale
+		localeColumn := col.Transformer.Config.GetGenerateStringPhoneNumberConfig().LocaleColumn
+		return withLocale(fmt.Sprintf("generate_string_phone_number(min:%d,max:%d)", min, max), locale, localeColumn), nil
 	case mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_GENERATE_RANDOM_STRING:
 		min := col.Transformer.Config.GetGenerateStringConfig().Min
 		max := col.Transformer.Config.GetGenerateStringConfig().Max
@@ -1798,56 +2351,253 @@ func computeMutationFunction(col *mgmtv1alpha1.JobMapping, colInfo *dbschemas_ut
 		ih := col.Transformer.Config.GetGenerateUuidConfig().IncludeHyphens
 		return fmt.Sprintf("generate_uuid(include_hyphens:%t)", ih), nil
 	case mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_GENERATE_ZIPCODE:
-		return "generate_zipcode()", nil
+		config := col.Transformer.Config.GetGenerateZipcodeConfig()
+		return withLocale("generate_zipcode()", config.Locale, config.LocaleColumn), nil
 	case mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_TRANSFORM_E164_PHONE_NUMBER:
 		pl := col.Transformer.Config.GetTransformE164PhoneNumberConfig().PreserveLength
-		return fmt.Sprintf("transform_e164_phone_number(value:this.%q,preserve_length:%t,max_length:%d)", col.Column, pl, maxLen), nil
+		deterministic := col.Transformer.Config.GetTransformE164PhoneNumberConfig().Deterministic
+		return withDeterministicKey(fmt.Sprintf("transform_e164_phone_number(value:this.%q,preserve_length:%t,max_length:%d)", col.Column, pl, maxLen), deterministic), nil
 	case mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_TRANSFORM_FIRST_NAME:
 		pl := col.Transformer.Config.GetTransformFirstNameConfig().PreserveLength
-		return fmt.Sprintf("transform_first_name(value:this.%q,preserve_length:%t,max_length:%d)", col.Column, pl, maxLen), nil
+		deterministic := col.Transformer.Config.GetTransformFirstNameConfig().Deterministic
+		locale := col.Transformer.Config.GetTransformFirstNameConfig().Locale
+		localeColumn := col.Transformer.Config.GetTransformFirstNameConfig().LocaleColumn
+		return withDeterministicKey(withLocale(fmt.Sprintf("transform_first_name(value:this.%q,preserve_length:%t,max_length:%d)", col.Column, pl, maxLen), locale, localeColumn), deterministic), nil
 	case mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_TRANSFORM_FLOAT64:
 		rMin := col.Transformer.Config.GetTransformFloat64Config().RandomizationRangeMin
 		rMax := col.Transformer.Config.GetTransformFloat64Config().RandomizationRangeMax
-		return fmt.Sprintf(`transform_float64(value:this.%q,randomization_range_min:%f,randomization_range_max:%f)`, col.Column, rMin, rMax), nil
+		deterministic := col.Transformer.Config.GetTransformFloat64Config().Deterministic
+		return withDeterministicKey(fmt.Sprintf(`transform_float64(value:this.%q,randomization_range_min:%f,randomization_range_max:%f)`, col.Column, rMin, rMax), deterministic), nil
 	case mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_TRANSFORM_FULL_NAME:
 		pl := col.Transformer.Config.GetTransformFullNameConfig().PreserveLength
-		return fmt.Sprintf("transform_full_name(value:this.%q,preserve_length:%t,max_length:%d)", col.Column, pl, maxLen), nil
+		deterministic := col.Transformer.Config.GetTransformFullNameConfig().Deterministic
+		locale := col.Transformer.Config.GetTransformFullNameConfig().Locale
+		localeColumn := col.Transformer.Config.GetTransformFullNameConfig().LocaleColumn
+		return withDeterministicKey(withLocale(fmt.Sprintf("transform_full_name(value:this.%q,preserve_length:%t,max_length:%d)", col.Column, pl, maxLen), locale, localeColumn), deterministic), nil
 	case mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_TRANSFORM_INT64_PHONE_NUMBER:
 		pl := col.Transformer.Config.GetTransformInt64PhoneNumberConfig().PreserveLength
-		return fmt.Sprintf("transform_int64_phone_number(value:this.%q,preserve_length:%t)", col.Column, pl), nil
+		deterministic := col.Transformer.Config.GetTransformInt64PhoneNumberConfig().Deterministic
+		return withDeterministicKey(fmt.Sprintf("transform_int64_phone_number(value:this.%q,preserve_length:%t)", col.Column, pl), deterministic), nil
 	case mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_TRANSFORM_INT64:
 		rMin := col.Transformer.Config.GetTransformInt64Config().RandomizationRangeMin
 		rMax := col.Transformer.Config.GetTransformInt64Config().RandomizationRangeMax
-		return fmt.Sprintf(`transform_int64(value:this.%q,randomization_range_min:%d,randomization_range_max:%d)`, col.Column, rMin, rMax), nil
+		deterministic := col.Transformer.Config.GetTransformInt64Config().Deterministic
+		return withDeterministicKey(fmt.Sprintf(`transform_int64(value:this.%q,randomization_range_min:%d,randomization_range_max:%d)`, col.Column, rMin, rMax), deterministic), nil
 	case mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_TRANSFORM_LAST_NAME:
 		pl := col.Transformer.Config.GetTransformLastNameConfig().PreserveLength
-		return fmt.Sprintf("transform_last_name(value:this.%q,preserve_length:%t,max_length:%d)", col.Column, pl, maxLen), nil
+		deterministic := col.Transformer.Config.GetTransformLastNameConfig().Deterministic
+		locale := col.Transformer.Config.GetTransformLastNameConfig().Locale
+		localeColumn := col.Transformer.Config.GetTransformLastNameConfig().LocaleColumn
+		return withDeterministicKey(withLocale(fmt.Sprintf("transform_last_name(value:this.%q,preserve_length:%t,max_length:%d)", col.Column, pl, maxLen), locale, localeColumn), deterministic), nil
 	case mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_TRANSFORM_PHONE_NUMBER:
 		pl := col.Transformer.Config.GetTransformPhoneNumberConfig().PreserveLength
-		return fmt.Sprintf("transform_phone_number(value:this.%q,preserve_length:%t,max_length:%d)", col.Column, pl, maxLen), nil
+		deterministic := col.Transformer.Config.GetTransformPhoneNumberConfig().Deterministic
+		locale := col.Transformer.Config.GetTransformPhoneNumberConfig().Locale
+		localeColumn := col.Transformer.Config.GetTransformPhoneNumberConfig().LocaleColumn
+		return withDeterministicKey(withLocale(fmt.Sprintf("transform_phone_number(value:this.%q,preserve_length:%t,max_length:%d)", col.Column, pl, maxLen), locale, localeColumn), deterministic), nil
 	case mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_TRANSFORM_STRING:
 		pl := col.Transformer.Config.GetTransformStringConfig().PreserveLength
 		minLength := int64(3) // todo: we need to pull in this value from the database schema
-		return fmt.Sprintf(`transform_string(value:this.%q,preserve_length:%t,min_length:%d,max_length:%d)`, col.Column, pl, minLength, maxLen), nil
+		deterministic := col.Transformer.Config.GetTransformStringConfig().Deterministic
+		return withDeterministicKey(fmt.Sprintf(`transform_string(value:this.%q,preserve_length:%t,min_length:%d,max_length:%d)`, col.Column, pl, minLength, maxLen), deterministic), nil
 	case mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_GENERATE_NULL:
 		return shared.NullString, nil
 	case mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_GENERATE_DEFAULT:
 		return "default", nil
 	case mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_TRANSFORM_CHARACTER_SCRAMBLE:
 		regex := col.Transformer.Config.GetTransformCharacterScrambleConfig().UserProvidedRegex
+		deterministic := col.Transformer.Config.GetTransformCharacterScrambleConfig().Deterministic
 
 		if regex != nil {
 			regexValue := *regex
-			return fmt.Sprintf(`transform_character_scramble(value:this.%q,user_provided_regex:%q)`, col.Column, regexValue), nil
+			return withDeterministicKey(fmt.Sprintf(`transform_character_scramble(value:this.%q,user_provided_regex:%q)`, col.Column, regexValue), deterministic), nil
 		} else {
-			return fmt.Sprintf(`transform_character_scramble(value:this.%q)`, col.Column), nil
+			return withDeterministicKey(fmt.Sprintf(`transform_character_scramble(value:this.%q)`, col.Column), deterministic), nil
+		}
+	case mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_TRANSFORM_FORMAT_PRESERVING_ENCRYPT:
+		config := col.Transformer.Config.GetTransformFormatPreservingEncryptConfig()
+		algorithm := fpe.AlgorithmFF1
+		if config.GetAlgorithm() == mgmtv1alpha1.FormatPreservingEncryptionAlgorithm_FORMAT_PRESERVING_ENCRYPTION_ALGORITHM_FF3_1 {
+			algorithm = fpe.AlgorithmFF31
+		}
+		return fmt.Sprintf(`transform_format_preserving_encrypt(value:this.%q,algorithm:%q,tweak:%q,key:"${%s}")`, col.Column, algorithm, config.GetTweak(), shared.TransformerKeyEnvVarKey), nil
+	case mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_TRANSFORM_TIMESTAMP:
+		config := col.Transformer.Config.GetTransformTimestampConfig()
+		return computeTimeTransformFunction("transform_timestamp", col.Column, &timeTransformConfig{
+			mode:              config.GetMode(),
+			shiftRangeDays:    config.GetShiftRangeDays(),
+			entityColumn:      config.EntityColumn,
+			jitterParam:       "jitter_range_seconds",
+			jitterRange:       config.GetJitterRangeSeconds(),
+			truncatePrecision: config.GetTruncatePrecision(),
+			min:               config.Min,
+			max:               config.Max,
+		})
+	case mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_TRANSFORM_DATE:
+		config := col.Transformer.Config.GetTransformDateConfig()
+		return computeTimeTransformFunction("transform_date", col.Column, &timeTransformConfig{
+			mode:              config.GetMode(),
+			shiftRangeDays:    config.GetShiftRangeDays(),
+			entityColumn:      config.EntityColumn,
+			jitterParam:       "jitter_range_days",
+			jitterRange:       config.GetJitterRangeDays(),
+			truncatePrecision: config.GetTruncatePrecision(),
+			min:               config.Min,
+			max:               config.Max,
+		})
+	case mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_TRANSFORM_JSON:
+		return computeTransformJsonFunction(col.Column, col.Transformer.Config.GetTransformJsonConfig())
+	case mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_TRANSFORM_FREE_TEXT:
+		config := col.Transformer.Config.GetTransformFreeTextConfig()
+		entityTypes := make([]string, 0, len(config.GetEntityTypes()))
+		for _, entityType := range config.GetEntityTypes() {
+			name, ok := freeTextEntityTypeNames[entityType]
+			if !ok {
+				return "", fmt.Errorf("unsupported free text entity type: %s", entityType.String())
+			}
+			entityTypes = append(entityTypes, name)
+		}
+		entityTypesStr, err := convertStringSliceToString(entityTypes)
+		if err != nil {
+			return "", err
 		}
+		if config.GetStrategy() == mgmtv1alpha1.FreeTextReplacementStrategy_FREE_TEXT_REPLACEMENT_STRATEGY_FAKE {
+			// the transformer key keeps an entity replaced with the same fake value across rows, tables and runs
+			return withDeterministicKey(fmt.Sprintf(`transform_free_text(value:this.%q,entity_types:%s,strategy:"fake",max_length:%d)`, col.Column, entityTypesStr, maxLen), true), nil
+		}
+		return fmt.Sprintf(`transform_free_text(value:this.%q,entity_types:%s,strategy:"mask",max_length:%d)`, col.Column, entityTypesStr, maxLen), nil
+	case mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_GENERATE_IP_ADDRESS:
+		version := "ipv4"
+		if col.Transformer.Config.GetGenerateIpAddressConfig().GetVersion() == mgmtv1alpha1.IpAddressVersion_IP_ADDRESS_VERSION_V6 {
+			version = "ipv6"
+		}
+		return fmt.Sprintf(`generate_ip_address(version:%q)`, version), nil
+	case mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_TRANSFORM_IP_ADDRESS:
+		config := col.Transformer.Config.GetTransformIpAddressConfig()
+		return withDeterministicKey(fmt.Sprintf(`transform_ip_address(value:this.%q,preserve_prefix_length:%d,preserve_class:%t)`, col.Column, config.GetPreservePrefixLength(), config.GetPreserveClass()), config.GetDeterministic()), nil
+	case mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_GENERATE_MAC_ADDRESS:
+		return "generate_mac_address()", nil
+	case mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_TRANSFORM_MAC_ADDRESS:
+		config := col.Transformer.Config.GetTransformMacAddressConfig()
+		return withDeterministicKey(fmt.Sprintf(`transform_mac_address(value:this.%q,preserve_oui:%t)`, col.Column, config.GetPreserveOui()), config.GetDeterministic()), nil
+	case mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_GENERATE_URL:
+		return "generate_url()", nil
+	case mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_TRANSFORM_URL:
+		config := col.Transformer.Config.GetTransformUrlConfig()
+		return withDeterministicKey(fmt.Sprintf(`transform_url(value:this.%q,preserve_path:%t)`, col.Column, config.GetPreservePath()), config.GetDeterministic()), nil
+	case mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_GENERATE_DOMAIN:
+		return "generate_domain()", nil
+	case mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_TRANSFORM_DOMAIN:
+		config := col.Transformer.Config.GetTransformDomainConfig()
+		return withDeterministicKey(fmt.Sprintf(`transform_domain(value:this.%q,preserve_tld:%t)`, col.Column, config.GetPreserveTld()), config.GetDeterministic()), nil
 
 	default:
 		return "", fmt.Errorf("unsupported transformer")
 	}
 }
 
+// Builds the transform_json function. Each rule's transformer is built as if it were run on a column named value,
+// which is where transform_json passes the value that the rule's path selects.
+func computeTransformJsonFunction(column string, config *mgmtv1alpha1.TransformJson) (string, error) {
+	rules := []string{}
+	for idx, rule := range config.GetRules() {
+		if rule.GetConfig() == nil {
+			return "", fmt.Errorf("transform json rule %d must provide a transformer config", idx+1)
+		}
+		mapping, err := computeMutationFunction(&mgmtv1alpha1.JobMapping{
+			Column: "value",
+			Transformer: &mgmtv1alpha1.JobMappingTransformer{
+				Source: rule.GetSource(),
+				Config: rule.GetConfig(),
+			},
+		}, nil)
+		if err != nil {
+			return "", fmt.Errorf("unable to build transform json rule %d for %s: %w", idx+1, rule.GetPath(), err)
+		}
+		rules = append(rules, fmt.Sprintf(`{"path":%q,"mapping":%q}`, rule.GetPath(), mapping))
+	}
+	return fmt.Sprintf(`transform_json(value:this.%q,rules:[%s])`, column, strings.Join(rules, ",")), nil
+}
+
+var freeTextEntityTypeNames = map[mgmtv1alpha1.FreeTextEntityType]string{
+	mgmtv1alpha1.FreeTextEntityType_FREE_TEXT_ENTITY_TYPE_EMAIL:        "email",
+	mgmtv1alpha1.FreeTextEntityType_FREE_TEXT_ENTITY_TYPE_PHONE_NUMBER: "phone_number",
+	mgmtv1alpha1.FreeTextEntityType_FREE_TEXT_ENTITY_TYPE_CARD_NUMBER:  "card_number",
+	mgmtv1alpha1.FreeTextEntityType_FREE_TEXT_ENTITY_TYPE_NAME:         "name",
+}
+
+type timeTransformConfig struct {
+	mode              mgmtv1alpha1.TimeTransformMode
+	shiftRangeDays    int64
+	entityColumn      *string
+	jitterParam       string
+	jitterRange       int64
+	truncatePrecision mgmtv1alpha1.TimeTruncatePrecision
+	min               *string
+	max               *string
+}
+
+// Builds the transform_timestamp or transform_date function with only the params that the configured mode uses
+func computeTimeTransformFunction(fn, column string, config *timeTransformConfig) (string, error) {
+	switch config.mode {
+	case mgmtv1alpha1.TimeTransformMode_TIME_TRANSFORM_MODE_SHIFT:
+		entity := ""
+		if config.entityColumn != nil && *config.entityColumn != "" {
+			entity = fmt.Sprintf(`,entity:this.%q`, *config.entityColumn)
+		}
+		// the transformer key keeps the offset of an entity the same across columns, tables and runs
+		return withDeterministicKey(fmt.Sprintf(`%s(value:this.%q,mode:"shift",shift_range_days:%d%s)`, fn, column, config.shiftRangeDays, entity), true), nil
+	case mgmtv1alpha1.TimeTransformMode_TIME_TRANSFORM_MODE_JITTER:
+		return fmt.Sprintf(`%s(value:this.%q,mode:"jitter",%s:%d)`, fn, column, config.jitterParam, config.jitterRange), nil
+	case mgmtv1alpha1.TimeTransformMode_TIME_TRANSFORM_MODE_TRUNCATE:
+		precision := "month"
+		if config.truncatePrecision == mgmtv1alpha1.TimeTruncatePrecision_TIME_TRUNCATE_PRECISION_YEAR {
+			precision = "year"
+		}
+		return fmt.Sprintf(`%s(value:this.%q,mode:"truncate",truncate_precision:%q)`, fn, column, precision), nil
+	case mgmtv1alpha1.TimeTransformMode_TIME_TRANSFORM_MODE_CLAMP:
+		bounds := ""
+		if config.min != nil {
+			bounds += fmt.Sprintf(`,min:%q`, *config.min)
+		}
+		if config.max != nil {
+			bounds += fmt.Sprintf(`,max:%q`, *config.max)
+		}
+		return fmt.Sprintf(`%s(value:this.%q,mode:"clamp"%s)`, fn, column, bounds), nil
+	default:
+		return "", fmt.Errorf("unsupported %s mode: %s", fn, config.mode.String())
+	}
+}
+
+// Passes the account's transformer key to the transformer function so that it always transforms an input value to the same output.
+// The key is referenced through an environment variable that is only resolved when the benthos stream is launched.
+func withDeterministicKey(fn string, deterministic bool) string {
+	if !deterministic {
+		return fn
+	}
+	return fmt.Sprintf(`%s,deterministic_key:"${%s}")`, strings.TrimSuffix(fn, ")"), shared.TransformerKeyEnvVarKey)
+}
+
+// Passes the locale that names, addresses and phone numbers are generated for to the transformer function.
+// When a locale column is configured, the value of that column in the row overrides the locale.
+func withLocale(fn string, locale, localeColumn *string) string {
+	args := []string{}
+	if locale != nil && *locale != "" {
+		args = append(args, fmt.Sprintf(`locale:%q`, *locale))
+	}
+	if localeColumn != nil && *localeColumn != "" {
+		args = append(args, fmt.Sprintf(`row_locale:this.%q`, *localeColumn))
+	}
+	if len(args) == 0 {
+		return fn
+	}
+	fn = strings.TrimSuffix(fn, ")")
+	if !strings.HasSuffix(fn, "(") {
+		fn += ","
+	}
+	return fmt.Sprintf("%s%s)", fn, strings.Join(args, ","))
+}
+
 func convertStringSliceToString(slc []string) (string, error) {
 	var returnStr string
 
@@ -16,6 +16,7 @@ import (
 	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
 	"github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1/mgmtv1alpha1connect"
 	dbschemas_utils "github.com/nucleuscloud/neosync/backend/pkg/dbschemas"
+	dbschemas_mssql "github.com/nucleuscloud/neosync/backend/pkg/dbschemas/mssql"
 	"github.com/nucleuscloud/neosync/backend/pkg/sqlconnect"
 	tabledependency "github.com/nucleuscloud/neosync/backend/pkg/table-dependency"
 	pg_models "github.com/nucleuscloud/neosync/backend/sql/postgresql/models"
@@ -142,7 +143,7 @@ func Test_BenthosBuilder_GenerateBenthosConfigs_Basic_Generate_Pg(t *testing.T)
 		},
 	}), nil)
 
-	bbuilder := newBenthosBuilder(pgcache, pgquerier, mysqlcache, mysqlquerier, mockJobClient, mockConnectionClient, mockTransformerClient, mockSqlConnector, mockJobId, mockRunId, nil, false)
+	bbuilder := newBenthosBuilder(pgcache, pgquerier, mysqlcache, mysqlquerier, map[string]dbschemas_mssql.DBTX{}, dbschemas_mssql.NewMockQuerier(t), mockJobClient, mockConnectionClient, mockTransformerClient, mockSqlConnector, mockJobId, mockRunId, nil, false)
 	resp, err := bbuilder.GenerateBenthosConfigs(
 		context.Background(),
 		&GenerateBenthosConfigsRequest{JobId: "123", WorkflowId: "123"},
@@ -303,7 +304,7 @@ func Test_BenthosBuilder_GenerateBenthosConfigs_Metrics(t *testing.T) {
 		},
 	}), nil)
 
-	bbuilder := newBenthosBuilder(pgcache, pgquerier, mysqlcache, mysqlquerier, mockJobClient, mockConnectionClient, mockTransformerClient, mockSqlConnector, mockJobId, mockRunId, nil, true)
+	bbuilder := newBenthosBuilder(pgcache, pgquerier, mysqlcache, mysqlquerier, map[string]dbschemas_mssql.DBTX{}, dbschemas_mssql.NewMockQuerier(t), mockJobClient, mockConnectionClient, mockTransformerClient, mockSqlConnector, mockJobId, mockRunId, nil, true)
 	resp, err := bbuilder.GenerateBenthosConfigs(
 		context.Background(),
 		&GenerateBenthosConfigsRequest{JobId: "123", WorkflowId: "123"},
@@ -471,7 +472,7 @@ func Test_BenthosBuilder_GenerateBenthosConfigs_Generate_Pg_Default(t *testing.T
 		},
 	}), nil)
 
-	bbuilder := newBenthosBuilder(pgcache, pgquerier, mysqlcache, mysqlquerier, mockJobClient, mockConnectionClient, mockTransformerClient, mockSqlConnector, mockJobId, mockRunId, nil, false)
+	bbuilder := newBenthosBuilder(pgcache, pgquerier, mysqlcache, mysqlquerier, map[string]dbschemas_mssql.DBTX{}, dbschemas_mssql.NewMockQuerier(t), mockJobClient, mockConnectionClient, mockTransformerClient, mockSqlConnector, mockJobId, mockRunId, nil, false)
 	resp, err := bbuilder.GenerateBenthosConfigs(
 		context.Background(),
 		&GenerateBenthosConfigsRequest{JobId: "123", WorkflowId: "123"},
@@ -533,6 +534,7 @@ output:
 
 func Test_BenthosBuilder_GenerateBenthosConfigs_Basic_Pg_Pg(t *testing.T) {
 	mockJobClient := mgmtv1alpha1connect.NewMockJobServiceClient(t)
+	mockSchemaDriftReports(mockJobClient)
 	mockConnectionClient := mgmtv1alpha1connect.NewMockConnectionServiceClient(t)
 	mockTransformerClient := mgmtv1alpha1connect.NewMockTransformersServiceClient(t)
 	mockSqlConnector := sqlconnect.NewMockSqlConnector(t)
@@ -647,7 +649,7 @@ func Test_BenthosBuilder_GenerateBenthosConfigs_Basic_Pg_Pg(t *testing.T) {
 			ConstraintName: "name",
 			ColumnName:     "id",
 		}}, nil)
-	bbuilder := newBenthosBuilder(pgcache, pgquerier, mysqlcache, mysqlquerier, mockJobClient, mockConnectionClient, mockTransformerClient, mockSqlConnector, mockJobId, mockRunId, nil, false)
+	bbuilder := newBenthosBuilder(pgcache, pgquerier, mysqlcache, mysqlquerier, map[string]dbschemas_mssql.DBTX{}, dbschemas_mssql.NewMockQuerier(t), mockJobClient, mockConnectionClient, mockTransformerClient, mockSqlConnector, mockJobId, mockRunId, nil, false)
 
 	resp, err := bbuilder.GenerateBenthosConfigs(
 		context.Background(),
@@ -707,8 +709,183 @@ output:
 	assert.NoError(t, err)
 }
 
+func Test_BenthosBuilder_GenerateBenthosConfigs_Basic_Pg_Sqlite(t *testing.T) {
+	mockJobClient := mgmtv1alpha1connect.NewMockJobServiceClient(t)
+	mockSchemaDriftReports(mockJobClient)
+	mockConnectionClient := mgmtv1alpha1connect.NewMockConnectionServiceClient(t)
+	mockTransformerClient := mgmtv1alpha1connect.NewMockTransformersServiceClient(t)
+	mockSqlConnector := sqlconnect.NewMockSqlConnector(t)
+
+	pgcache := map[string]pg_queries.DBTX{
+		"123": pg_queries.NewMockDBTX(t),
+	}
+	pgquerier := pg_queries.NewMockQuerier(t)
+	mysqlcache := map[string]mysql_queries.DBTX{}
+	mysqlquerier := mysql_queries.NewMockQuerier(t)
+
+	mockJobClient.On("GetJob", mock.Anything, mock.Anything).
+		Return(connect.NewResponse(&mgmtv1alpha1.GetJobResponse{
+			Job: &mgmtv1alpha1.Job{
+				Source: &mgmtv1alpha1.JobSource{
+					Options: &mgmtv1alpha1.JobSourceOptions{
+						Config: &mgmtv1alpha1.JobSourceOptions_Postgres{
+							Postgres: &mgmtv1alpha1.PostgresSourceConnectionOptions{
+								ConnectionId: "123",
+							},
+						},
+					},
+				},
+				Mappings: []*mgmtv1alpha1.JobMapping{
+					{
+						Schema: "public",
+						Table:  "users",
+						Column: "id",
+						Transformer: &mgmtv1alpha1.JobMappingTransformer{
+							Source: mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_PASSTHROUGH,
+						},
+					},
+					{
+						Schema: "public",
+						Table:  "users",
+						Column: "name",
+						Transformer: &mgmtv1alpha1.JobMappingTransformer{
+							Source: mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_PASSTHROUGH,
+						},
+					},
+				},
+				Destinations: []*mgmtv1alpha1.JobDestination{
+					{
+						ConnectionId: "456",
+					},
+				},
+			},
+		}), nil)
+	mockConnectionClient.On(
+		"GetConnection",
+		mock.Anything,
+		connect.NewRequest(&mgmtv1alpha1.GetConnectionRequest{
+			Id: "123",
+		}),
+	).Return(connect.NewResponse(&mgmtv1alpha1.GetConnectionResponse{
+		Connection: &mgmtv1alpha1.Connection{
+			Id:   "123",
+			Name: "prod",
+			ConnectionConfig: &mgmtv1alpha1.ConnectionConfig{
+				Config: &mgmtv1alpha1.ConnectionConfig_PgConfig{
+					PgConfig: &mgmtv1alpha1.PostgresConnectionConfig{
+						ConnectionConfig: &mgmtv1alpha1.PostgresConnectionConfig_Url{
+							Url: "fake-prod-url",
+						},
+					},
+				},
+			},
+		},
+	}), nil)
+	mockConnectionClient.On(
+		"GetConnection",
+		mock.Anything,
+		connect.NewRequest(&mgmtv1alpha1.GetConnectionRequest{
+			Id: "456",
+		}),
+	).Return(connect.NewResponse(&mgmtv1alpha1.GetConnectionResponse{
+		Connection: &mgmtv1alpha1.Connection{
+			Id:   "456",
+			Name: "stage",
+			ConnectionConfig: &mgmtv1alpha1.ConnectionConfig{
+				Config: &mgmtv1alpha1.ConnectionConfig_SqliteConfig{
+					SqliteConfig: &mgmtv1alpha1.SqliteConnectionConfig{
+						Path: "/tmp/stage.db",
+					},
+				},
+			},
+		},
+	}), nil)
+
+	pgquerier.On("GetDatabaseSchema", mock.Anything, mock.Anything).
+		Return([]*pg_queries.GetDatabaseSchemaRow{
+			{
+				TableSchema: "public",
+				TableName:   "users",
+				ColumnName:  "id",
+			},
+			{
+				TableSchema: "public",
+				TableName:   "users",
+				ColumnName:  "name",
+			},
+		}, nil)
+	pgquerier.On("GetForeignKeyConstraints", mock.Anything, mock.Anything, mock.Anything).
+		Return([]*pg_queries.GetForeignKeyConstraintsRow{}, nil)
+	pgquerier.On("GetPrimaryKeyConstraints", mock.Anything, mock.Anything, mock.Anything).
+		Return([]*pg_queries.GetPrimaryKeyConstraintsRow{{
+			SchemaName:     "public",
+			TableName:      "users",
+			ConstraintName: "name",
+			ColumnName:     "id",
+		}}, nil)
+	bbuilder := newBenthosBuilder(pgcache, pgquerier, mysqlcache, mysqlquerier, map[string]dbschemas_mssql.DBTX{}, dbschemas_mssql.NewMockQuerier(t), mockJobClient, mockConnectionClient, mockTransformerClient, mockSqlConnector, mockJobId, mockRunId, nil, false)
+
+	resp, err := bbuilder.GenerateBenthosConfigs(
+		context.Background(),
+		&GenerateBenthosConfigsRequest{JobId: "123", WorkflowId: "123"},
+		slog.Default(),
+	)
+	assert.Nil(t, err)
+	assert.NotEmpty(t, resp.BenthosConfigs)
+	assert.Len(t, resp.BenthosConfigs, 1)
+	bc := resp.BenthosConfigs[0]
+	assert.Equal(t, bc.Name, "public.users")
+	assert.Empty(t, bc.DependsOn)
+	out, err := yaml.Marshal(bc.Config)
+	assert.NoError(t, err)
+	assert.Equal(
+		t,
+		strings.TrimSpace(string(out)),
+		strings.TrimSpace(`
+input:
+    label: ""
+    pooled_sql_raw:
+        driver: postgres
+        dsn: ${SOURCE_CONNECTION_DSN}
+        query: SELECT "id", "name" FROM "public"."users";
+pipeline:
+    threads: -1
+    processors: []
+output:
+    label: ""
+    broker:
+        pattern: fan_out
+        outputs:
+            - pooled_sql_raw:
+                driver: sqlite
+                dsn: ${DESTINATION_0_CONNECTION_DSN}
+                query: INSERT INTO "users" ("id", "name") VALUES (?, ?);
+                args_mapping: root = [this."id", this."name"]
+                init_statement: ""
+                batching:
+                    count: 100
+                    byte_size: 0
+                    period: 5s
+                    check: ""
+                    processors: []
+`),
+	)
+
+	benthosenv := service.NewEnvironment()
+	err = neosync_benthos_sql.RegisterPooledSqlRawOutput(benthosenv, nil)
+	assert.NoError(t, err)
+	err = neosync_benthos_sql.RegisterPooledSqlRawInput(benthosenv, nil)
+	assert.NoError(t, err)
+	newSB := benthosenv.NewStreamBuilder()
+
+	// SetYAML parses a full Benthos config and uses it to configure the builder.
+	err = newSB.SetYAML(string(out))
+	assert.NoError(t, err)
+}
+
 func Test_BenthosBuilder_GenerateBenthosConfigs_PrimaryKey_Transformer_Pg_Pg(t *testing.T) {
 	mockJobClient := mgmtv1alpha1connect.NewMockJobServiceClient(t)
+	mockSchemaDriftReports(mockJobClient)
 	mockConnectionClient := mgmtv1alpha1connect.NewMockConnectionServiceClient(t)
 	mockTransformerClient := mgmtv1alpha1connect.NewMockTransformersServiceClient(t)
 	mockSqlConnector := sqlconnect.NewMockSqlConnector(t)
@@ -875,7 +1052,7 @@ func Test_BenthosBuilder_GenerateBenthosConfigs_PrimaryKey_Transformer_Pg_Pg(t *
 			ConstraintName: "orders",
 			ColumnName:     "id",
 		}}, nil)
-	bbuilder := newBenthosBuilder(pgcache, pgquerier, mysqlcache, mysqlquerier, mockJobClient, mockConnectionClient, mockTransformerClient, mockSqlConnector, mockJobId, mockRunId, redisConfig, false)
+	bbuilder := newBenthosBuilder(pgcache, pgquerier, mysqlcache, mysqlquerier, map[string]dbschemas_mssql.DBTX{}, dbschemas_mssql.NewMockQuerier(t), mockJobClient, mockConnectionClient, mockTransformerClient, mockSqlConnector, mockJobId, mockRunId, redisConfig, false)
 
 	resp, err := bbuilder.GenerateBenthosConfigs(
 		context.Background(),
@@ -1002,6 +1179,7 @@ output:
 
 func Test_BenthosBuilder_GenerateBenthosConfigs_PrimaryKey_Passthrough_Pg_Pg(t *testing.T) {
 	mockJobClient := mgmtv1alpha1connect.NewMockJobServiceClient(t)
+	mockSchemaDriftReports(mockJobClient)
 	mockConnectionClient := mgmtv1alpha1connect.NewMockConnectionServiceClient(t)
 	mockTransformerClient := mgmtv1alpha1connect.NewMockTransformersServiceClient(t)
 	mockSqlConnector := sqlconnect.NewMockSqlConnector(t)
@@ -1157,7 +1335,7 @@ func Test_BenthosBuilder_GenerateBenthosConfigs_PrimaryKey_Passthrough_Pg_Pg(t *
 			ConstraintName: "orders",
 			ColumnName:     "id",
 		}}, nil)
-	bbuilder := newBenthosBuilder(pgcache, pgquerier, mysqlcache, mysqlquerier, mockJobClient, mockConnectionClient, mockTransformerClient, mockSqlConnector, mockJobId, mockRunId, nil, false)
+	bbuilder := newBenthosBuilder(pgcache, pgquerier, mysqlcache, mysqlquerier, map[string]dbschemas_mssql.DBTX{}, dbschemas_mssql.NewMockQuerier(t), mockJobClient, mockConnectionClient, mockTransformerClient, mockSqlConnector, mockJobId, mockRunId, nil, false)
 
 	resp, err := bbuilder.GenerateBenthosConfigs(
 		context.Background(),
@@ -1260,6 +1438,7 @@ output:
 
 func Test_BenthosBuilder_GenerateBenthosConfigs_CircularDependency_PrimaryKey_Transformer_Pg_Pg(t *testing.T) {
 	mockJobClient := mgmtv1alpha1connect.NewMockJobServiceClient(t)
+	mockSchemaDriftReports(mockJobClient)
 	mockConnectionClient := mgmtv1alpha1connect.NewMockConnectionServiceClient(t)
 	mockTransformerClient := mgmtv1alpha1connect.NewMockTransformersServiceClient(t)
 	mockSqlConnector := sqlconnect.NewMockSqlConnector(t)
@@ -1395,7 +1574,7 @@ func Test_BenthosBuilder_GenerateBenthosConfigs_CircularDependency_PrimaryKey_Tr
 			ConstraintName: "job",
 			ColumnName:     "id",
 		}}, nil)
-	bbuilder := newBenthosBuilder(pgcache, pgquerier, mysqlcache, mysqlquerier, mockJobClient, mockConnectionClient, mockTransformerClient, mockSqlConnector, mockJobId, mockRunId, redisConfig, false)
+	bbuilder := newBenthosBuilder(pgcache, pgquerier, mysqlcache, mysqlquerier, map[string]dbschemas_mssql.DBTX{}, dbschemas_mssql.NewMockQuerier(t), mockJobClient, mockConnectionClient, mockTransformerClient, mockSqlConnector, mockJobId, mockRunId, redisConfig, false)
 
 	resp, err := bbuilder.GenerateBenthosConfigs(
 		context.Background(),
@@ -1533,6 +1712,7 @@ output:
 
 func Test_BenthosBuilder_GenerateBenthosConfigs_Basic_Pg_Pg_Default(t *testing.T) {
 	mockJobClient := mgmtv1alpha1connect.NewMockJobServiceClient(t)
+	mockSchemaDriftReports(mockJobClient)
 	mockConnectionClient := mgmtv1alpha1connect.NewMockConnectionServiceClient(t)
 	mockTransformerClient := mgmtv1alpha1connect.NewMockTransformersServiceClient(t)
 	mockSqlConnector := sqlconnect.NewMockSqlConnector(t)
@@ -1647,7 +1827,7 @@ func Test_BenthosBuilder_GenerateBenthosConfigs_Basic_Pg_Pg_Default(t *testing.T
 			ConstraintName: "name",
 			ColumnName:     "id",
 		}}, nil)
-	bbuilder := newBenthosBuilder(pgcache, pgquerier, mysqlcache, mysqlquerier, mockJobClient, mockConnectionClient, mockTransformerClient, mockSqlConnector, mockJobId, mockRunId, nil, false)
+	bbuilder := newBenthosBuilder(pgcache, pgquerier, mysqlcache, mysqlquerier, map[string]dbschemas_mssql.DBTX{}, dbschemas_mssql.NewMockQuerier(t), mockJobClient, mockConnectionClient, mockTransformerClient, mockSqlConnector, mockJobId, mockRunId, nil, false)
 
 	resp, err := bbuilder.GenerateBenthosConfigs(
 		context.Background(),
@@ -1710,6 +1890,7 @@ output:
 
 func Test_BenthosBuilder_GenerateBenthosConfigs_Basic_Pg_Pg_With_Constraints(t *testing.T) {
 	mockJobClient := mgmtv1alpha1connect.NewMockJobServiceClient(t)
+	mockSchemaDriftReports(mockJobClient)
 	mockConnectionClient := mgmtv1alpha1connect.NewMockConnectionServiceClient(t)
 	mockTransformerClient := mgmtv1alpha1connect.NewMockTransformersServiceClient(t)
 	mockSqlConnector := sqlconnect.NewMockSqlConnector(t)
@@ -1865,7 +2046,7 @@ func Test_BenthosBuilder_GenerateBenthosConfigs_Basic_Pg_Pg_With_Constraints(t *
 			ConstraintName: "acc_assoc_constraint",
 			ColumnName:     "id",
 		}}, nil)
-	bbuilder := newBenthosBuilder(pgcache, pgquerier, mysqlcache, mysqlquerier, mockJobClient, mockConnectionClient, mockTransformerClient, mockSqlConnector, mockJobId, mockRunId, nil, false)
+	bbuilder := newBenthosBuilder(pgcache, pgquerier, mysqlcache, mysqlquerier, map[string]dbschemas_mssql.DBTX{}, dbschemas_mssql.NewMockQuerier(t), mockJobClient, mockConnectionClient, mockTransformerClient, mockSqlConnector, mockJobId, mockRunId, nil, false)
 
 	resp, err := bbuilder.GenerateBenthosConfigs(
 		context.Background(),
@@ -1970,6 +2151,7 @@ output:
 
 func Test_BenthosBuilder_GenerateBenthosConfigs_Basic_Pg_Pg_With_Circular_Dependency(t *testing.T) {
 	mockJobClient := mgmtv1alpha1connect.NewMockJobServiceClient(t)
+	mockSchemaDriftReports(mockJobClient)
 	mockConnectionClient := mgmtv1alpha1connect.NewMockConnectionServiceClient(t)
 	mockTransformerClient := mgmtv1alpha1connect.NewMockTransformersServiceClient(t)
 	mockSqlConnector := sqlconnect.NewMockSqlConnector(t)
@@ -2151,7 +2333,7 @@ func Test_BenthosBuilder_GenerateBenthosConfigs_Basic_Pg_Pg_With_Circular_Depend
 			ColumnName:     "id",
 		},
 	}, nil)
-	bbuilder := newBenthosBuilder(pgcache, pgquerier, mysqlcache, mysqlquerier, mockJobClient, mockConnectionClient, mockTransformerClient, mockSqlConnector, mockJobId, mockRunId, nil, false)
+	bbuilder := newBenthosBuilder(pgcache, pgquerier, mysqlcache, mysqlquerier, map[string]dbschemas_mssql.DBTX{}, dbschemas_mssql.NewMockQuerier(t), mockJobClient, mockConnectionClient, mockTransformerClient, mockSqlConnector, mockJobId, mockRunId, nil, false)
 
 	resp, err := bbuilder.GenerateBenthosConfigs(
 		context.Background(),
@@ -2295,6 +2477,7 @@ output:
 
 func Test_BenthosBuilder_GenerateBenthosConfigs_Basic_Pg_Pg_With_Circular_Dependency_S3(t *testing.T) {
 	mockJobClient := mgmtv1alpha1connect.NewMockJobServiceClient(t)
+	mockSchemaDriftReports(mockJobClient)
 	mockConnectionClient := mgmtv1alpha1connect.NewMockConnectionServiceClient(t)
 	mockTransformerClient := mgmtv1alpha1connect.NewMockTransformersServiceClient(t)
 	mockSqlConnector := sqlconnect.NewMockSqlConnector(t)
@@ -2506,7 +2689,7 @@ func Test_BenthosBuilder_GenerateBenthosConfigs_Basic_Pg_Pg_With_Circular_Depend
 			ColumnName:     "id",
 		},
 	}, nil)
-	bbuilder := newBenthosBuilder(pgcache, pgquerier, mysqlcache, mysqlquerier, mockJobClient, mockConnectionClient, mockTransformerClient, mockSqlConnector, mockJobId, mockRunId, nil, false)
+	bbuilder := newBenthosBuilder(pgcache, pgquerier, mysqlcache, mysqlquerier, map[string]dbschemas_mssql.DBTX{}, dbschemas_mssql.NewMockQuerier(t), mockJobClient, mockConnectionClient, mockTransformerClient, mockSqlConnector, mockJobId, mockRunId, nil, false)
 
 	resp, err := bbuilder.GenerateBenthosConfigs(
 		context.Background(),
@@ -2782,7 +2965,7 @@ func Test_BenthosBuilder_GenerateBenthosConfigs_Basic_Generate_Mysql(t *testing.
 		},
 	}), nil)
 
-	bbuilder := newBenthosBuilder(pgcache, pgquerier, mysqlcache, mysqlquerier, mockJobClient, mockConnectionClient, mockTransformerClient, mockSqlConnector, mockJobId, mockRunId, nil, false)
+	bbuilder := newBenthosBuilder(pgcache, pgquerier, mysqlcache, mysqlquerier, map[string]dbschemas_mssql.DBTX{}, dbschemas_mssql.NewMockQuerier(t), mockJobClient, mockConnectionClient, mockTransformerClient, mockSqlConnector, mockJobId, mockRunId, nil, false)
 
 	resp, err := bbuilder.GenerateBenthosConfigs(
 		context.Background(),
@@ -2846,6 +3029,7 @@ output:
 
 func Test_BenthosBuilder_GenerateBenthosConfigs_Basic_Mysql_Mysql(t *testing.T) {
 	mockJobClient := mgmtv1alpha1connect.NewMockJobServiceClient(t)
+	mockSchemaDriftReports(mockJobClient)
 	mockConnectionClient := mgmtv1alpha1connect.NewMockConnectionServiceClient(t)
 	mockTransformerClient := mgmtv1alpha1connect.NewMockTransformersServiceClient(t)
 	mockSqlConnector := sqlconnect.NewMockSqlConnector(t)
@@ -2960,7 +3144,7 @@ func Test_BenthosBuilder_GenerateBenthosConfigs_Basic_Mysql_Mysql(t *testing.T)
 			ConstraintName: "pk-id",
 			ColumnName:     "id",
 		}}, nil)
-	bbuilder := newBenthosBuilder(pgcache, pgquerier, mysqlcache, mysqlquerier, mockJobClient, mockConnectionClient, mockTransformerClient, mockSqlConnector, mockJobId, mockRunId, nil, false)
+	bbuilder := newBenthosBuilder(pgcache, pgquerier, mysqlcache, mysqlquerier, map[string]dbschemas_mssql.DBTX{}, dbschemas_mssql.NewMockQuerier(t), mockJobClient, mockConnectionClient, mockTransformerClient, mockSqlConnector, mockJobId, mockRunId, nil, false)
 
 	resp, err := bbuilder.GenerateBenthosConfigs(
 		context.Background(),
@@ -3022,6 +3206,7 @@ output:
 
 func Test_BenthosBuilder_GenerateBenthosConfigs_Basic_Mysql_Mysql_With_Constraints(t *testing.T) {
 	mockJobClient := mgmtv1alpha1connect.NewMockJobServiceClient(t)
+	mockSchemaDriftReports(mockJobClient)
 	mockConnectionClient := mgmtv1alpha1connect.NewMockConnectionServiceClient(t)
 	mockTransformersClient := mgmtv1alpha1connect.NewMockTransformersServiceClient(t)
 	mockSqlConnector := sqlconnect.NewMockSqlConnector(t)
@@ -3177,7 +3362,7 @@ func Test_BenthosBuilder_GenerateBenthosConfigs_Basic_Mysql_Mysql_With_Constrain
 			ConstraintName: "pk-users-assoc-id",
 			ColumnName:     "id",
 		}}, nil)
-	bbuilder := newBenthosBuilder(pgcache, pgquerier, mysqlcache, mysqlquerier, mockJobClient, mockConnectionClient, mockTransformersClient, mockSqlConnector, mockJobId, mockRunId, nil, false)
+	bbuilder := newBenthosBuilder(pgcache, pgquerier, mysqlcache, mysqlquerier, map[string]dbschemas_mssql.DBTX{}, dbschemas_mssql.NewMockQuerier(t), mockJobClient, mockConnectionClient, mockTransformersClient, mockSqlConnector, mockJobId, mockRunId, nil, false)
 
 	resp, err := bbuilder.GenerateBenthosConfigs(
 		context.Background(),
@@ -3282,6 +3467,7 @@ output:
 
 func Test_BenthosBuilder_GenerateBenthosConfigs_Basic_Mysql_Mysql_With_Circular_Dependency(t *testing.T) {
 	mockJobClient := mgmtv1alpha1connect.NewMockJobServiceClient(t)
+	mockSchemaDriftReports(mockJobClient)
 	mockConnectionClient := mgmtv1alpha1connect.NewMockConnectionServiceClient(t)
 	mockTransformerClient := mgmtv1alpha1connect.NewMockTransformersServiceClient(t)
 	mockSqlConnector := sqlconnect.NewMockSqlConnector(t)
@@ -3463,7 +3649,7 @@ func Test_BenthosBuilder_GenerateBenthosConfigs_Basic_Mysql_Mysql_With_Circular_
 			ColumnName:     "id",
 		},
 	}, nil)
-	bbuilder := newBenthosBuilder(pgcache, pgquerier, mysqlcache, mysqlquerier, mockJobClient, mockConnectionClient, mockTransformerClient, mockSqlConnector, mockJobId, mockRunId, nil, false)
+	bbuilder := newBenthosBuilder(pgcache, pgquerier, mysqlcache, mysqlquerier, map[string]dbschemas_mssql.DBTX{}, dbschemas_mssql.NewMockQuerier(t), mockJobClient, mockConnectionClient, mockTransformerClient, mockSqlConnector, mockJobId, mockRunId, nil, false)
 
 	resp, err := bbuilder.GenerateBenthosConfigs(
 		context.Background(),
@@ -3699,7 +3885,7 @@ func Test_BenthosBuilder_GenerateBenthosConfigs_Basic_Generate_Mysql_Default(t *
 		},
 	}), nil)
 
-	bbuilder := newBenthosBuilder(pgcache, pgquerier, mysqlcache, mysqlquerier, mockJobClient, mockConnectionClient, mockTransformerClient, mockSqlConnector, mockJobId, mockRunId, nil, false)
+	bbuilder := newBenthosBuilder(pgcache, pgquerier, mysqlcache, mysqlquerier, map[string]dbschemas_mssql.DBTX{}, dbschemas_mssql.NewMockQuerier(t), mockJobClient, mockConnectionClient, mockTransformerClient, mockSqlConnector, mockJobId, mockRunId, nil, false)
 
 	resp, err := bbuilder.GenerateBenthosConfigs(
 		context.Background(),
@@ -3762,6 +3948,7 @@ output:
 
 func Test_BenthosBuilder_GenerateBenthosConfigs_Basic_Mysql_Default(t *testing.T) {
 	mockJobClient := mgmtv1alpha1connect.NewMockJobServiceClient(t)
+	mockSchemaDriftReports(mockJobClient)
 	mockConnectionClient := mgmtv1alpha1connect.NewMockConnectionServiceClient(t)
 	mockTransformerClient := mgmtv1alpha1connect.NewMockTransformersServiceClient(t)
 	mockSqlConnector := sqlconnect.NewMockSqlConnector(t)
@@ -3876,7 +4063,7 @@ func Test_BenthosBuilder_GenerateBenthosConfigs_Basic_Mysql_Default(t *testing.T
 			ConstraintName: "pk-id",
 			ColumnName:     "id",
 		}}, nil)
-	bbuilder := newBenthosBuilder(pgcache, pgquerier, mysqlcache, mysqlquerier, mockJobClient, mockConnectionClient, mockTransformerClient, mockSqlConnector, mockJobId, mockRunId, nil, false)
+	bbuilder := newBenthosBuilder(pgcache, pgquerier, mysqlcache, mysqlquerier, map[string]dbschemas_mssql.DBTX{}, dbschemas_mssql.NewMockQuerier(t), mockJobClient, mockConnectionClient, mockTransformerClient, mockSqlConnector, mockJobId, mockRunId, nil, false)
 
 	resp, err := bbuilder.GenerateBenthosConfigs(
 		context.Background(),
@@ -4328,111 +4515,6 @@ func Test_ProcessorConfigMutationAndJavascript(t *testing.T) {
       `), strings.TrimSpace(string(out)))
 }
 
-func TestAreMappingsSubsetOfSchemas(t *testing.T) {
-	ok := areMappingsSubsetOfSchemas(
-		map[string]map[string]*dbschemas_utils.ColumnInfo{
-			"public.users": {
-				"id":         &dbschemas_utils.ColumnInfo{},
-				"created_by": &dbschemas_utils.ColumnInfo{},
-				"updated_by": &dbschemas_utils.ColumnInfo{},
-			},
-			"neosync_api.accounts": {
-				"id": &dbschemas_utils.ColumnInfo{},
-			},
-		},
-		[]*mgmtv1alpha1.JobMapping{
-			{Schema: "public", Table: "users", Column: "id"},
-			{Schema: "public", Table: "users", Column: "created_by"},
-		},
-	)
-	assert.True(t, ok, "job mappings are a subset of the present database schemas")
-
-	ok = areMappingsSubsetOfSchemas(
-		map[string]map[string]*dbschemas_utils.ColumnInfo{
-			"public.users": {
-				"id": &dbschemas_utils.ColumnInfo{},
-			},
-		},
-		[]*mgmtv1alpha1.JobMapping{
-			{Schema: "public", Table: "users", Column: "id2"},
-		},
-	)
-	assert.False(t, ok, "job mappings contain mapping that is not in the source schema")
-
-	ok = areMappingsSubsetOfSchemas(
-		map[string]map[string]*dbschemas_utils.ColumnInfo{
-			"public.users": {
-				"id": &dbschemas_utils.ColumnInfo{},
-			},
-		},
-		[]*mgmtv1alpha1.JobMapping{
-			{Schema: "public", Table: "users", Column: "id"},
-			{Schema: "public", Table: "users", Column: "created_by"},
-		},
-	)
-	assert.False(t, ok, "job mappings contain more mappings than are present in the source schema")
-}
-
-func TestShouldHaltOnSchemaAddition(t *testing.T) {
-	ok := shouldHaltOnSchemaAddition(
-		map[string]map[string]*dbschemas_utils.ColumnInfo{
-			"public.users": {
-				"id":         &dbschemas_utils.ColumnInfo{},
-				"created_by": &dbschemas_utils.ColumnInfo{},
-			},
-		},
-		[]*mgmtv1alpha1.JobMapping{
-			{Schema: "public", Table: "users", Column: "id"},
-			{Schema: "public", Table: "users", Column: "created_by"},
-		},
-	)
-	assert.False(t, ok, "job mappings are valid set of database schemas")
-
-	ok = shouldHaltOnSchemaAddition(
-		map[string]map[string]*dbschemas_utils.ColumnInfo{
-			"public.users": {
-				"id":         &dbschemas_utils.ColumnInfo{},
-				"created_by": &dbschemas_utils.ColumnInfo{},
-			},
-			"neosync_api.accounts": {
-				"id": &dbschemas_utils.ColumnInfo{},
-			},
-		},
-		[]*mgmtv1alpha1.JobMapping{
-			{Schema: "public", Table: "users", Column: "id"},
-			{Schema: "public", Table: "users", Column: "created_by"},
-		},
-	)
-	assert.True(t, ok, "job mappings are missing database schema mappings")
-
-	ok = shouldHaltOnSchemaAddition(
-		map[string]map[string]*dbschemas_utils.ColumnInfo{
-			"public.users": {
-				"id":         &dbschemas_utils.ColumnInfo{},
-				"created_by": &dbschemas_utils.ColumnInfo{},
-			},
-		},
-		[]*mgmtv1alpha1.JobMapping{
-			{Schema: "public", Table: "users", Column: "id"},
-		},
-	)
-	assert.True(t, ok, "job mappings are missing table column")
-
-	ok = shouldHaltOnSchemaAddition(
-		map[string]map[string]*dbschemas_utils.ColumnInfo{
-			"public.users": {
-				"id":         &dbschemas_utils.ColumnInfo{},
-				"created_by": &dbschemas_utils.ColumnInfo{},
-			},
-		},
-		[]*mgmtv1alpha1.JobMapping{
-			{Schema: "public", Table: "users", Column: "id"},
-			{Schema: "public", Table: "users", Column: "updated_by"},
-		},
-	)
-	assert.True(t, ok, "job mappings have same column count, but missing specific column")
-}
-
 func Test_buildProcessorConfigsMutation(t *testing.T) {
 	mockTransformerClient := mgmtv1alpha1connect.NewMockTransformersServiceClient(t)
 
@@ -4998,6 +5080,11 @@ func Test_escapeColsByDriver(t *testing.T) {
 		escapeColsByDriver([]string{"foo", "bar", "baz"}, "mysql"),
 		[]string{"`foo`", "`bar`", "`baz`"},
 	)
+	assert.Equal(
+		t,
+		escapeColsByDriver([]string{"foo", "bar", "baz"}, "sqlite"),
+		[]string{`"foo"`, `"bar"`, `"baz"`},
+	)
 	assert.Equal(
 		t,
 		escapeColsByDriver([]string{"foo", "bar", "baz"}, ""),
@@ -5575,40 +5662,570 @@ func Test_computeMutationFunction_handles_Db_Maxlen(t *testing.T) {
 	}
 }
 
-func Test_buildBranchCacheConfigs_null(t *testing.T) {
-	cols := []*mgmtv1alpha1.JobMapping{
+func Test_computeMutationFunction_Deterministic(t *testing.T) {
+	type testcase struct {
+		config   *mgmtv1alpha1.TransformerConfig
+		source   mgmtv1alpha1.TransformerSource
+		expected string
+	}
+	testcases := []testcase{
 		{
-			Schema: "public",
-			Table:  "users",
-			Column: "user_id",
+			source: mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_TRANSFORM_EMAIL,
+			config: &mgmtv1alpha1.TransformerConfig{
+				Config: &mgmtv1alpha1.TransformerConfig_TransformEmailConfig{
+					TransformEmailConfig: &mgmtv1alpha1.TransformEmail{PreserveDomain: true, Deterministic: true},
+				},
+			},
+			expected: `transform_email(email:this."email",preserve_domain:true,preserve_length:false,excluded_domains:[],max_length:10000,deterministic_key:"${NEOSYNC_TRANSFORMER_KEY}")`,
 		},
-	}
-
-	constraints := map[string]*dbschemas_utils.ForeignKey{
-		"name": {
-			Table:  "public.orders",
-			Column: "buyer_id",
+		{
+			source: mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_TRANSFORM_EMAIL,
+			config: &mgmtv1alpha1.TransformerConfig{
+				Config: &mgmtv1alpha1.TransformerConfig_TransformEmailConfig{
+					TransformEmailConfig: &mgmtv1alpha1.TransformEmail{PreserveDomain: true},
+				},
+			},
+			expected: `transform_email(email:this."email",preserve_domain:true,preserve_length:false,excluded_domains:[],max_length:10000)`,
 		},
-	}
-
-	resp, err := buildBranchCacheConfigs(cols, constraints, mockJobId, mockRunId, nil)
-	assert.NoError(t, err)
-	assert.Len(t, resp, 0)
-}
-
-func Test_buildBranchCacheConfigs_missing_redis(t *testing.T) {
-	cols := []*mgmtv1alpha1.JobMapping{
 		{
-			Schema: "public",
-			Table:  "users",
-			Column: "user_id",
+			source: mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_TRANSFORM_INT64,
+			config: &mgmtv1alpha1.TransformerConfig{
+				Config: &mgmtv1alpha1.TransformerConfig_TransformInt64Config{
+					TransformInt64Config: &mgmtv1alpha1.TransformInt64{RandomizationRangeMin: 1, RandomizationRangeMax: 10, Deterministic: true},
+				},
+			},
+			expected: `transform_int64(value:this."email",randomization_range_min:1,randomization_range_max:10,deterministic_key:"${NEOSYNC_TRANSFORMER_KEY}")`,
 		},
-	}
-
-	constraints := map[string]*dbschemas_utils.ForeignKey{
-		"user_id": {
-			Table:  "public.orders",
-			Column: "buyer_id",
+		{
+			source: mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_TRANSFORM_CHARACTER_SCRAMBLE,
+			config: &mgmtv1alpha1.TransformerConfig{
+				Config: &mgmtv1alpha1.TransformerConfig_TransformCharacterScrambleConfig{
+					TransformCharacterScrambleConfig: &mgmtv1alpha1.TransformCharacterScramble{UserProvidedRegex: shared.Ptr("[a-z]+"), Deterministic: true},
+				},
+			},
+			expected: `transform_character_scramble(value:this."email",user_provided_regex:"[a-z]+",deterministic_key:"${NEOSYNC_TRANSFORMER_KEY}")`,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.expected, func(t *testing.T) {
+			out, err := computeMutationFunction(&mgmtv1alpha1.JobMapping{
+				Column:      "email",
+				Transformer: &mgmtv1alpha1.JobMappingTransformer{Source: tc.source, Config: tc.config},
+			}, nil)
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expected, out)
+			_, err = bloblang.Parse(out)
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func Test_computeMutationFunction_FormatPreservingEncrypt(t *testing.T) {
+	type testcase struct {
+		config   *mgmtv1alpha1.TransformFormatPreservingEncrypt
+		expected string
+	}
+	testcases := []testcase{
+		{
+			config:   &mgmtv1alpha1.TransformFormatPreservingEncrypt{},
+			expected: `transform_format_preserving_encrypt(value:this."card_number",algorithm:"ff1",tweak:"",key:"${NEOSYNC_TRANSFORMER_KEY}")`,
+		},
+		{
+			config: &mgmtv1alpha1.TransformFormatPreservingEncrypt{
+				Algorithm: mgmtv1alpha1.FormatPreservingEncryptionAlgorithm_FORMAT_PRESERVING_ENCRYPTION_ALGORITHM_FF3_1,
+				Tweak:     "users",
+			},
+			expected: `transform_format_preserving_encrypt(value:this."card_number",algorithm:"ff3_1",tweak:"users",key:"${NEOSYNC_TRANSFORMER_KEY}")`,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.expected, func(t *testing.T) {
+			out, err := computeMutationFunction(&mgmtv1alpha1.JobMapping{
+				Column: "card_number",
+				Transformer: &mgmtv1alpha1.JobMappingTransformer{
+					Source: mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_TRANSFORM_FORMAT_PRESERVING_ENCRYPT,
+					Config: &mgmtv1alpha1.TransformerConfig{
+						Config: &mgmtv1alpha1.TransformerConfig_TransformFormatPreservingEncryptConfig{
+							TransformFormatPreservingEncryptConfig: tc.config,
+						},
+					},
+				},
+			}, nil)
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expected, out)
+			_, err = bloblang.Parse(out)
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func Test_computeMutationFunction_TransformTimestamp(t *testing.T) {
+	type testcase struct {
+		config   *mgmtv1alpha1.TransformTimestamp
+		expected string
+	}
+	testcases := []testcase{
+		{
+			config: &mgmtv1alpha1.TransformTimestamp{
+				Mode:           mgmtv1alpha1.TimeTransformMode_TIME_TRANSFORM_MODE_SHIFT,
+				ShiftRangeDays: 30,
+			},
+			expected: `transform_timestamp(value:this."created_at",mode:"shift",shift_range_days:30,deterministic_key:"${NEOSYNC_TRANSFORMER_KEY}")`,
+		},
+		{
+			config: &mgmtv1alpha1.TransformTimestamp{
+				Mode:           mgmtv1alpha1.TimeTransformMode_TIME_TRANSFORM_MODE_SHIFT,
+				ShiftRangeDays: 30,
+				EntityColumn:   shared.Ptr("user_id"),
+			},
+			expected: `transform_timestamp(value:this."created_at",mode:"shift",shift_range_days:30,entity:this."user_id",deterministic_key:"${NEOSYNC_TRANSFORMER_KEY}")`,
+		},
+		{
+			config: &mgmtv1alpha1.TransformTimestamp{
+				Mode:               mgmtv1alpha1.TimeTransformMode_TIME_TRANSFORM_MODE_JITTER,
+				JitterRangeSeconds: 3600,
+			},
+			expected: `transform_timestamp(value:this."created_at",mode:"jitter",jitter_range_seconds:3600)`,
+		},
+		{
+			config: &mgmtv1alpha1.TransformTimestamp{
+				Mode:              mgmtv1alpha1.TimeTransformMode_TIME_TRANSFORM_MODE_TRUNCATE,
+				TruncatePrecision: mgmtv1alpha1.TimeTruncatePrecision_TIME_TRUNCATE_PRECISION_YEAR,
+			},
+			expected: `transform_timestamp(value:this."created_at",mode:"truncate",truncate_precision:"year")`,
+		},
+		{
+			config: &mgmtv1alpha1.TransformTimestamp{
+				Mode: mgmtv1alpha1.TimeTransformMode_TIME_TRANSFORM_MODE_CLAMP,
+				Min:  shared.Ptr("2020-01-01T00:00:00Z"),
+			},
+			expected: `transform_timestamp(value:this."created_at",mode:"clamp",min:"2020-01-01T00:00:00Z")`,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.expected, func(t *testing.T) {
+			out, err := computeMutationFunction(&mgmtv1alpha1.JobMapping{
+				Column: "created_at",
+				Transformer: &mgmtv1alpha1.JobMappingTransformer{
+					Source: mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_TRANSFORM_TIMESTAMP,
+					Config: &mgmtv1alpha1.TransformerConfig{
+						Config: &mgmtv1alpha1.TransformerConfig_TransformTimestampConfig{
+							TransformTimestampConfig: tc.config,
+						},
+					},
+				},
+			}, nil)
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expected, out)
+			_, err = bloblang.Parse(out)
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func Test_computeMutationFunction_TransformDate(t *testing.T) {
+	type testcase struct {
+		config   *mgmtv1alpha1.TransformDate
+		expected string
+	}
+	testcases := []testcase{
+		{
+			config: &mgmtv1alpha1.TransformDate{
+				Mode:            mgmtv1alpha1.TimeTransformMode_TIME_TRANSFORM_MODE_JITTER,
+				JitterRangeDays: 7,
+			},
+			expected: `transform_date(value:this."birth_date",mode:"jitter",jitter_range_days:7)`,
+		},
+		{
+			config: &mgmtv1alpha1.TransformDate{
+				Mode: mgmtv1alpha1.TimeTransformMode_TIME_TRANSFORM_MODE_TRUNCATE,
+			},
+			expected: `transform_date(value:this."birth_date",mode:"truncate",truncate_precision:"month")`,
+		},
+		{
+			config: &mgmtv1alpha1.TransformDate{
+				Mode: mgmtv1alpha1.TimeTransformMode_TIME_TRANSFORM_MODE_CLAMP,
+				Min:  shared.Ptr("1950-01-01"),
+				Max:  shared.Ptr("2005-12-31"),
+			},
+			expected: `transform_date(value:this."birth_date",mode:"clamp",min:"1950-01-01",max:"2005-12-31")`,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.expected, func(t *testing.T) {
+			out, err := computeMutationFunction(&mgmtv1alpha1.JobMapping{
+				Column: "birth_date",
+				Transformer: &mgmtv1alpha1.JobMappingTransformer{
+					Source: mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_TRANSFORM_DATE,
+					Config: &mgmtv1alpha1.TransformerConfig{
+						Config: &mgmtv1alpha1.TransformerConfig_TransformDateConfig{
+							TransformDateConfig: tc.config,
+						},
+					},
+				},
+			}, nil)
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expected, out)
+			_, err = bloblang.Parse(out)
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func Test_computeMutationFunction_TransformDate_UnspecifiedMode(t *testing.T) {
+	_, err := computeMutationFunction(&mgmtv1alpha1.JobMapping{
+		Column: "birth_date",
+		Transformer: &mgmtv1alpha1.JobMappingTransformer{
+			Source: mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_TRANSFORM_DATE,
+			Config: &mgmtv1alpha1.TransformerConfig{
+				Config: &mgmtv1alpha1.TransformerConfig_TransformDateConfig{
+					TransformDateConfig: &mgmtv1alpha1.TransformDate{},
+				},
+			},
+		},
+	}, nil)
+	assert.Error(t, err)
+}
+
+func Test_computeMutationFunction_TransformJson(t *testing.T) {
+	out, err := computeMutationFunction(&mgmtv1alpha1.JobMapping{
+		Column: "profile",
+		Transformer: &mgmtv1alpha1.JobMappingTransformer{
+			Source: mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_TRANSFORM_JSON,
+			Config: &mgmtv1alpha1.TransformerConfig{
+				Config: &mgmtv1alpha1.TransformerConfig_TransformJsonConfig{
+					TransformJsonConfig: &mgmtv1alpha1.TransformJson{
+						Rules: []*mgmtv1alpha1.TransformJsonRule{
+							{
+								Path:   "$.contact.email",
+								Source: mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_TRANSFORM_EMAIL,
+								Config: &mgmtv1alpha1.TransformerConfig{
+									Config: &mgmtv1alpha1.TransformerConfig_TransformEmailConfig{
+										TransformEmailConfig: &mgmtv1alpha1.TransformEmail{PreserveDomain: true, Deterministic: true},
+									},
+								},
+							},
+							{
+								Path:   "$.tags[*]",
+								Source: mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_GENERATE_NULL,
+								Config: &mgmtv1alpha1.TransformerConfig{
+									Config: &mgmtv1alpha1.TransformerConfig_Nullconfig{
+										Nullconfig: &mgmtv1alpha1.Null{},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}, nil)
+	assert.NoError(t, err)
+	assert.Equal(
+		t,
+		`transform_json(value:this."profile",rules:[{"path":"$.contact.email","mapping":"transform_email(email:this.\"value\",preserve_domain:true,preserve_length:false,excluded_domains:[],max_length:10000,deterministic_key:\"${NEOSYNC_TRANSFORMER_KEY}\")"},{"path":"$.tags[*]","mapping":"null"}])`,
+		out,
+	)
+	_, err = bloblang.Parse(out)
+	assert.NoError(t, err)
+}
+
+func Test_computeMutationFunction_TransformJson_UnsupportedRule(t *testing.T) {
+	_, err := computeMutationFunction(&mgmtv1alpha1.JobMapping{
+		Column: "profile",
+		Transformer: &mgmtv1alpha1.JobMappingTransformer{
+			Source: mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_TRANSFORM_JSON,
+			Config: &mgmtv1alpha1.TransformerConfig{
+				Config: &mgmtv1alpha1.TransformerConfig_TransformJsonConfig{
+					TransformJsonConfig: &mgmtv1alpha1.TransformJson{
+						Rules: []*mgmtv1alpha1.TransformJsonRule{
+							{
+								Path:   "$.notes",
+								Source: mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_PASSTHROUGH,
+								Config: &mgmtv1alpha1.TransformerConfig{
+									Config: &mgmtv1alpha1.TransformerConfig_PassthroughConfig{
+										PassthroughConfig: &mgmtv1alpha1.Passthrough{},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}, nil)
+	assert.Error(t, err)
+}
+
+func Test_computeMutationFunction_TransformFreeText(t *testing.T) {
+	type testcase struct {
+		config   *mgmtv1alpha1.TransformFreeText
+		expected string
+	}
+	testcases := []testcase{
+		{
+			config:   &mgmtv1alpha1.TransformFreeText{},
+			expected: `transform_free_text(value:this."notes",entity_types:[],strategy:"mask",max_length:10000)`,
+		},
+		{
+			config: &mgmtv1alpha1.TransformFreeText{
+				EntityTypes: []mgmtv1alpha1.FreeTextEntityType{
+					mgmtv1alpha1.FreeTextEntityType_FREE_TEXT_ENTITY_TYPE_EMAIL,
+					mgmtv1alpha1.FreeTextEntityType_FREE_TEXT_ENTITY_TYPE_NAME,
+				},
+				Strategy: mgmtv1alpha1.FreeTextReplacementStrategy_FREE_TEXT_REPLACEMENT_STRATEGY_MASK,
+			},
+			expected: `transform_free_text(value:this."notes",entity_types:["email","name"],strategy:"mask",max_length:10000)`,
+		},
+		{
+			config: &mgmtv1alpha1.TransformFreeText{
+				EntityTypes: []mgmtv1alpha1.FreeTextEntityType{
+					mgmtv1alpha1.FreeTextEntityType_FREE_TEXT_ENTITY_TYPE_PHONE_NUMBER,
+					mgmtv1alpha1.FreeTextEntityType_FREE_TEXT_ENTITY_TYPE_CARD_NUMBER,
+				},
+				Strategy: mgmtv1alpha1.FreeTextReplacementStrategy_FREE_TEXT_REPLACEMENT_STRATEGY_FAKE,
+			},
+			expected: `transform_free_text(value:this."notes",entity_types:["phone_number","card_number"],strategy:"fake",max_length:10000,deterministic_key:"${NEOSYNC_TRANSFORMER_KEY}")`,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.expected, func(t *testing.T) {
+			out, err := computeMutationFunction(&mgmtv1alpha1.JobMapping{
+				Column: "notes",
+				Transformer: &mgmtv1alpha1.JobMappingTransformer{
+					Source: mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_TRANSFORM_FREE_TEXT,
+					Config: &mgmtv1alpha1.TransformerConfig{
+						Config: &mgmtv1alpha1.TransformerConfig_TransformFreeTextConfig{
+							TransformFreeTextConfig: tc.config,
+						},
+					},
+				},
+			}, nil)
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expected, out)
+			_, err = bloblang.Parse(out)
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func Test_computeMutationFunction_NetworkIdentifiers(t *testing.T) {
+	type testcase struct {
+		source   mgmtv1alpha1.TransformerSource
+		config   *mgmtv1alpha1.TransformerConfig
+		expected string
+	}
+	testcases := []testcase{
+		{
+			source: mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_GENERATE_IP_ADDRESS,
+			config: &mgmtv1alpha1.TransformerConfig{
+				Config: &mgmtv1alpha1.TransformerConfig_GenerateIpAddressConfig{
+					GenerateIpAddressConfig: &mgmtv1alpha1.GenerateIpAddress{Version: mgmtv1alpha1.IpAddressVersion_IP_ADDRESS_VERSION_V6},
+				},
+			},
+			expected: `generate_ip_address(version:"ipv6")`,
+		},
+		{
+			source: mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_TRANSFORM_IP_ADDRESS,
+			config: &mgmtv1alpha1.TransformerConfig{
+				Config: &mgmtv1alpha1.TransformerConfig_TransformIpAddressConfig{
+					TransformIpAddressConfig: &mgmtv1alpha1.TransformIpAddress{PreservePrefixLength: 24, PreserveClass: true, Deterministic: true},
+				},
+			},
+			expected: `transform_ip_address(value:this."identifier",preserve_prefix_length:24,preserve_class:true,deterministic_key:"${NEOSYNC_TRANSFORMER_KEY}")`,
+		},
+		{
+			source: mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_GENERATE_MAC_ADDRESS,
+			config: &mgmtv1alpha1.TransformerConfig{
+				Config: &mgmtv1alpha1.TransformerConfig_GenerateMacAddressConfig{
+					GenerateMacAddressConfig: &mgmtv1alpha1.GenerateMacAddress{},
+				},
+			},
+			expected: `generate_mac_address()`,
+		},
+		{
+			source: mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_TRANSFORM_MAC_ADDRESS,
+			config: &mgmtv1alpha1.TransformerConfig{
+				Config: &mgmtv1alpha1.TransformerConfig_TransformMacAddressConfig{
+					TransformMacAddressConfig: &mgmtv1alpha1.TransformMacAddress{PreserveOui: true},
+				},
+			},
+			expected: `transform_mac_address(value:this."identifier",preserve_oui:true)`,
+		},
+		{
+			source: mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_GENERATE_URL,
+			config: &mgmtv1alpha1.TransformerConfig{
+				Config: &mgmtv1alpha1.TransformerConfig_GenerateUrlConfig{
+					GenerateUrlConfig: &mgmtv1alpha1.GenerateUrl{},
+				},
+			},
+			expected: `generate_url()`,
+		},
+		{
+			source: mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_TRANSFORM_URL,
+			config: &mgmtv1alpha1.TransformerConfig{
+				Config: &mgmtv1alpha1.TransformerConfig_TransformUrlConfig{
+					TransformUrlConfig: &mgmtv1alpha1.TransformUrl{PreservePath: true},
+				},
+			},
+			expected: `transform_url(value:this."identifier",preserve_path:true)`,
+		},
+		{
+			source: mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_GENERATE_DOMAIN,
+			config: &mgmtv1alpha1.TransformerConfig{
+				Config: &mgmtv1alpha1.TransformerConfig_GenerateDomainConfig{
+					GenerateDomainConfig: &mgmtv1alpha1.GenerateDomain{},
+				},
+			},
+			expected: `generate_domain()`,
+		},
+		{
+			source: mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_TRANSFORM_DOMAIN,
+			config: &mgmtv1alpha1.TransformerConfig{
+				Config: &mgmtv1alpha1.TransformerConfig_TransformDomainConfig{
+					TransformDomainConfig: &mgmtv1alpha1.TransformDomain{PreserveTld: true, Deterministic: true},
+				},
+			},
+			expected: `transform_domain(value:this."identifier",preserve_tld:true,deterministic_key:"${NEOSYNC_TRANSFORMER_KEY}")`,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.expected, func(t *testing.T) {
+			out, err := computeMutationFunction(&mgmtv1alpha1.JobMapping{
+				Column: "identifier",
+				Transformer: &mgmtv1alpha1.JobMappingTransformer{
+					Source: tc.source,
+					Config: tc.config,
+				},
+			}, nil)
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expected, out)
+			_, err = bloblang.Parse(out)
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func Test_computeMutationFunction_Locale(t *testing.T) {
+	type testcase struct {
+		source   mgmtv1alpha1.TransformerSource
+		config   *mgmtv1alpha1.TransformerConfig
+		expected string
+	}
+	testcases := []testcase{
+		{
+			source: mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_GENERATE_FIRST_NAME,
+			config: &mgmtv1alpha1.TransformerConfig{
+				Config: &mgmtv1alpha1.TransformerConfig_GenerateFirstNameConfig{
+					GenerateFirstNameConfig: &mgmtv1alpha1.GenerateFirstName{},
+				},
+			},
+			expected: `generate_first_name(max_length:10000)`,
+		},
+		{
+			source: mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_GENERATE_FIRST_NAME,
+			config: &mgmtv1alpha1.TransformerConfig{
+				Config: &mgmtv1alpha1.TransformerConfig_GenerateFirstNameConfig{
+					GenerateFirstNameConfig: &mgmtv1alpha1.GenerateFirstName{Locale: shared.Ptr("de_DE")},
+				},
+			},
+			expected: `generate_first_name(max_length:10000,locale:"de_DE")`,
+		},
+		{
+			source: mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_GENERATE_STATE,
+			config: &mgmtv1alpha1.TransformerConfig{
+				Config: &mgmtv1alpha1.TransformerConfig_GenerateStateConfig{
+					GenerateStateConfig: &mgmtv1alpha1.GenerateState{LocaleColumn: shared.Ptr("country_code")},
+				},
+			},
+			expected: `generate_state(row_locale:this."country_code")`,
+		},
+		{
+			source: mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_GENERATE_FULL_ADDRESS,
+			config: &mgmtv1alpha1.TransformerConfig{
+				Config: &mgmtv1alpha1.TransformerConfig_GenerateFullAddressConfig{
+					GenerateFullAddressConfig: &mgmtv1alpha1.GenerateFullAddress{Locale: shared.Ptr("ja_JP"), LocaleColumn: shared.Ptr("country_code")},
+				},
+			},
+			expected: `generate_full_address(max_length:10000,locale:"ja_JP",row_locale:this."country_code")`,
+		},
+		{
+			source: mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_GENERATE_STRING_PHONE_NUMBER,
+			config: &mgmtv1alpha1.TransformerConfig{
+				Config: &mgmtv1alpha1.TransformerConfig_GenerateStringPhoneNumberConfig{
+					GenerateStringPhoneNumberConfig: &mgmtv1alpha1.GenerateStringPhoneNumber{Min: 10, Max: 12, Locale: shared.Ptr("pt_BR")},
+				},
+			},
+			expected: `generate_string_phone_number(min:10,max:12,locale:"pt_BR")`,
+		},
+		{
+			source: mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_TRANSFORM_FULL_NAME,
+			config: &mgmtv1alpha1.TransformerConfig{
+				Config: &mgmtv1alpha1.TransformerConfig_TransformFullNameConfig{
+					TransformFullNameConfig: &mgmtv1alpha1.TransformFullName{PreserveLength: true, Deterministic: true, LocaleColumn: shared.Ptr("country_code")},
+				},
+			},
+			expected: `transform_full_name(value:this."name",preserve_length:true,max_length:10000,row_locale:this."country_code",deterministic_key:"${NEOSYNC_TRANSFORMER_KEY}")`,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.expected, func(t *testing.T) {
+			out, err := computeMutationFunction(&mgmtv1alpha1.JobMapping{
+				Column: "name",
+				Transformer: &mgmtv1alpha1.JobMappingTransformer{
+					Source: tc.source,
+					Config: tc.config,
+				},
+			}, nil)
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expected, out)
+			_, err = bloblang.Parse(out)
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func Test_buildBranchCacheConfigs_null(t *testing.T) {
+	cols := []*mgmtv1alpha1.JobMapping{
+		{
+			Schema: "public",
+			Table:  "users",
+			Column: "user_id",
+		},
+	}
+
+	constraints := map[string]*dbschemas_utils.ForeignKey{
+		"name": {
+			Table:  "public.orders",
+			Column: "buyer_id",
+		},
+	}
+
+	resp, err := buildBranchCacheConfigs(cols, constraints, mockJobId, mockRunId, nil)
+	assert.NoError(t, err)
+	assert.Len(t, resp, 0)
+}
+
+func Test_buildBranchCacheConfigs_missing_redis(t *testing.T) {
+	cols := []*mgmtv1alpha1.JobMapping{
+		{
+			Schema: "public",
+			Table:  "users",
+			Column: "user_id",
+		},
+	}
+
+	constraints := map[string]*dbschemas_utils.ForeignKey{
+		"user_id": {
+			Table:  "public.orders",
+			Column: "buyer_id",
 		},
 	}
 
@@ -5689,3 +6306,156 @@ func Test_ConverStringSliceToStringNotEmptySlice(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Equal(t, `["gmail.com","yahoo.com"]`, res)
 }
+
+func Test_buildMssqlInsertQuery(t *testing.T) {
+	actual := buildMssqlInsertQuery(
+		"dbo",
+		"users",
+		[]string{"id", "name", "created_at"},
+		map[string]mgmtv1alpha1.TransformerSource{
+			"created_at": mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_GENERATE_DEFAULT,
+		},
+		map[string]*dbschemas_utils.ColumnInfo{},
+	)
+	assert.Equal(t, "INSERT INTO [dbo].[users] ([id], [name], [created_at]) VALUES (@p1, @p2, DEFAULT);", actual)
+}
+
+func Test_buildMssqlInsertQuery_IdentityValue(t *testing.T) {
+	actual := buildMssqlInsertQuery(
+		"dbo",
+		"users",
+		[]string{"id", "name"},
+		map[string]mgmtv1alpha1.TransformerSource{},
+		map[string]*dbschemas_utils.ColumnInfo{
+			"id":   {IsIdentity: true},
+			"name": {},
+		},
+	)
+	assert.Equal(
+		t,
+		"SET IDENTITY_INSERT [dbo].[users] ON; INSERT INTO [dbo].[users] ([id], [name]) VALUES (@p1, @p2); SET IDENTITY_INSERT [dbo].[users] OFF;",
+		actual,
+	)
+}
+
+func Test_buildMssqlInsertQuery_GeneratedIdentity(t *testing.T) {
+	actual := buildMssqlInsertQuery(
+		"dbo",
+		"users",
+		[]string{"id", "name"},
+		map[string]mgmtv1alpha1.TransformerSource{
+			"id": mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_GENERATE_DEFAULT,
+		},
+		map[string]*dbschemas_utils.ColumnInfo{
+			"id": {IsIdentity: true},
+		},
+	)
+	assert.Equal(t, "INSERT INTO [dbo].[users] ([name]) VALUES (@p1);", actual)
+
+	actual = buildMssqlInsertQuery(
+		"dbo",
+		"users",
+		[]string{"id"},
+		map[string]mgmtv1alpha1.TransformerSource{
+			"id": mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_GENERATE_DEFAULT,
+		},
+		map[string]*dbschemas_utils.ColumnInfo{
+			"id": {IsIdentity: true},
+		},
+	)
+	assert.Equal(t, "INSERT INTO [dbo].[users] DEFAULT VALUES;", actual)
+}
+
+func Test_buildMssqlUpdateQuery(t *testing.T) {
+	actual := buildMssqlUpdateQuery(
+		"dbo",
+		"users",
+		[]string{"manager_id", "updated_at"},
+		map[string]mgmtv1alpha1.TransformerSource{
+			"updated_at": mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_GENERATE_DEFAULT,
+		},
+		[]string{"id"},
+	)
+	assert.Equal(t, "UPDATE [dbo].[users] SET [manager_id] = @p1, [updated_at] = DEFAULT WHERE [id] = @p2;", actual)
+}
+
+func Test_buildSqliteInsertQuery(t *testing.T) {
+	actual := buildSqliteInsertQuery(
+		"users",
+		[]string{"id", "name", "created_at"},
+		map[string]mgmtv1alpha1.TransformerSource{
+			"created_at": mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_GENERATE_DEFAULT,
+		},
+	)
+	assert.Equal(t, `INSERT INTO "users" ("id", "name") VALUES (?, ?);`, actual)
+}
+
+func Test_buildSqliteInsertQuery_AllDefault(t *testing.T) {
+	actual := buildSqliteInsertQuery(
+		"users",
+		[]string{"id"},
+		map[string]mgmtv1alpha1.TransformerSource{
+			"id": mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_GENERATE_DEFAULT,
+		},
+	)
+	assert.Equal(t, `INSERT INTO "users" DEFAULT VALUES;`, actual)
+}
+
+func Test_buildSqliteUpdateQuery(t *testing.T) {
+	actual := buildSqliteUpdateQuery(
+		"users",
+		[]string{"manager_id", "updated_at"},
+		map[string]mgmtv1alpha1.TransformerSource{
+			"updated_at": mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_GENERATE_DEFAULT,
+		},
+		[]string{"id"},
+	)
+	assert.Equal(t, `UPDATE "users" SET "manager_id" = ? WHERE "id" = ?;`, actual)
+}
+
+func Test_buildPostgresUpsertQuery(t *testing.T) {
+	actual := buildPostgresUpsertQuery(
+		"public",
+		"users",
+		[]string{"id", "name", "updated_at"},
+		map[string]mgmtv1alpha1.TransformerSource{},
+		[]string{"id"},
+	)
+	assert.Equal(
+		t,
+		`INSERT INTO "public"."users" ("id", "name", "updated_at") VALUES ($1, $2, $3) ON CONFLICT ("id") DO UPDATE SET "name" = EXCLUDED."name", "updated_at" = EXCLUDED."updated_at";`,
+		actual,
+	)
+
+	actual = buildPostgresUpsertQuery("public", "users", []string{"id"}, map[string]mgmtv1alpha1.TransformerSource{}, []string{"id"})
+	assert.Equal(t, `INSERT INTO "public"."users" ("id") VALUES ($1) ON CONFLICT ("id") DO NOTHING;`, actual)
+}
+
+func Test_buildMysqlUpsertQuery(t *testing.T) {
+	actual := buildMysqlUpsertQuery(
+		"public",
+		"users",
+		[]string{"id", "name"},
+		map[string]mgmtv1alpha1.TransformerSource{},
+		[]string{"id"},
+	)
+	assert.Equal(t, "INSERT INTO `public`.`users` (`id`, `name`) VALUES (?, ?) ON DUPLICATE KEY UPDATE `name` = VALUES(`name`);", actual)
+
+	actual = buildMysqlUpsertQuery("public", "users", []string{"id"}, map[string]mgmtv1alpha1.TransformerSource{}, []string{"id"})
+	assert.Equal(t, "INSERT INTO `public`.`users` (`id`) VALUES (?) ON DUPLICATE KEY UPDATE `id` = `id`;", actual)
+}
+
+func Test_buildSqliteUpsertQuery(t *testing.T) {
+	actual := buildSqliteUpsertQuery(
+		"users",
+		[]string{"id", "name", "created_at"},
+		map[string]mgmtv1alpha1.TransformerSource{
+			"created_at": mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_GENERATE_DEFAULT,
+		},
+		[]string{"id"},
+	)
+	assert.Equal(t, `INSERT INTO "users" ("id", "name") VALUES (?, ?) ON CONFLICT ("id") DO UPDATE SET "name" = excluded."name";`, actual)
+
+	actual = buildSqliteUpsertQuery("users", []string{"id"}, map[string]mgmtv1alpha1.TransformerSource{}, []string{"id"})
+	assert.Equal(t, `INSERT INTO "users" ("id") VALUES (?) ON CONFLICT ("id") DO NOTHING;`, actual)
+}
@@ -14,6 +14,7 @@ import (
 	// import the dialect
 	_ "github.com/doug-martin/goqu/v9/dialect/mysql"
 	_ "github.com/doug-martin/goqu/v9/dialect/postgres"
+	"github.com/doug-martin/goqu/v9/dialect/sqlserver"
 	"github.com/doug-martin/goqu/v9/exp"
 )
 
@@ -115,6 +116,15 @@ func buildSelectRecursiveQuery(
 		dialectName := "custom-mysql-dialect"
 		goqu.RegisterDialect(dialectName, opts)
 		builder = goqu.Dialect(dialectName)
+	} else if driver == sqlserverDriver {
+		// sql server supports recursive ctes but does not use the RECURSIVE keyword
+		opts := sqlserver.DialectOptions()
+		opts.SupportsWithCTERecursive = true
+		opts.SupportsWithCTE = true
+		opts.RecursiveFragment = []byte("")
+		dialectName := "custom-sqlserver-dialect"
+		goqu.RegisterDialect(dialectName, opts)
+		builder = goqu.Dialect(dialectName)
 	} else {
 		builder = goqu.Dialect(driver)
 	}
@@ -162,6 +172,10 @@ func buildSelectRecursiveQuery(
 
 	// union
 	unionQuery := initialSelect.Union(recursiveSelect)
+	if driver == sqlserverDriver {
+		// sql server requires recursive ctes to use union all
+		unionQuery = initialSelect.UnionAll(recursiveSelect)
+	}
 
 	selectCols := make([]any, len(columns))
 	for i, col := range columns {
@@ -251,6 +265,11 @@ func buildSelectQueryMap(
 			if whereOk {
 				where = &tableWhere
 			}
+			// watermarks only apply to the table itself and are not propagated to subset its dependents
+			where, err := addWatermarkWhereClause(driver, tableMapping.Schema, tableMapping.Table, where, sourceTableOpts[table])
+			if err != nil {
+				return nil, fmt.Errorf("unable to build watermark where clause: %w", err)
+			}
 			fks := tableDependencies[table]
 			runConfigs := dependencyMap[table]
 			selfRefCircularDep := getSelfReferencingColumns(table, fks)
@@ -352,7 +371,10 @@ func buildQueryMapNoSubsetConstraints(
 		}
 
 		tableOpt := sourceTableOpts[table]
-		where := getWhereFromTableOpts(tableOpt)
+		where, err := addWatermarkWhereClause(driver, tableMapping.Schema, tableMapping.Table, getWhereFromTableOpts(tableOpt), tableOpt)
+		if err != nil {
+			return nil, fmt.Errorf("unable to build watermark where clause: %w", err)
+		}
 
 		query, err := buildSelectQuery(
 			driver,
@@ -495,6 +517,9 @@ func qualifyWhereColumnNames(driver, where, schema, table string) (string, error
 			return "", err
 		}
 		updatedSql = sql
+	case sqlserverDriver:
+		// there is no sql server parser available to qualify the columns, so the where clause is used as provided
+		updatedSql = sql
 	default:
 		return "", errors.New("unsupported sql driver type")
 	}
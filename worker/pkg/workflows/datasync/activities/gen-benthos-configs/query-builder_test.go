@@ -188,6 +188,18 @@ func Test_buildSelectRecursiveQuery(t *testing.T) {
 			primaryKeyCol: "employee_id",
 			expected:      `WITH RECURSIVE related AS (SELECT "public"."employees"."employee_id", "public"."employees"."name", "public"."employees"."manager_id", "public"."employees"."department_id", "public"."employees"."big_boss_id" FROM "public"."employees" INNER JOIN "public"."departments" ON ("public"."departments"."id" = "public"."employees"."department_id") WHERE ("public"."employees"."name" = 'alisha' AND "public"."departments"."department_id" = 1) UNION (SELECT "public"."employees"."employee_id", "public"."employees"."name", "public"."employees"."manager_id", "public"."employees"."department_id", "public"."employees"."big_boss_id" FROM "public"."employees" INNER JOIN "related" ON (("public"."employees"."employee_id" = "related"."manager_id") OR ("public"."employees"."employee_id" = "related"."big_boss_id")))) SELECT DISTINCT "employee_id", "name", "manager_id", "department_id", "big_boss_id" FROM "related";`,
 		},
+		{
+			name:          "sqlserver one foreign key no joins",
+			driver:        "sqlserver",
+			schema:        "dbo",
+			table:         "employees",
+			columns:       []string{"employee_id", "name", "manager_id"},
+			joins:         []*sqlJoin{},
+			whereClauses:  []string{`"dbo"."employees"."name" = 'alisha'`},
+			foreignKeys:   []string{"manager_id"},
+			primaryKeyCol: "employee_id",
+			expected:      `WITH related AS (SELECT "dbo"."employees"."employee_id", "dbo"."employees"."name", "dbo"."employees"."manager_id" FROM "dbo"."employees" WHERE "dbo"."employees"."name" = 'alisha' UNION ALL (SELECT "dbo"."employees"."employee_id", "dbo"."employees"."name", "dbo"."employees"."manager_id" FROM "dbo"."employees" INNER JOIN "related" ON ("dbo"."employees"."employee_id" = "related"."manager_id"))) SELECT DISTINCT "employee_id", "name", "manager_id" FROM "related";`,
+		},
 	}
 
 	for _, tt := range tests {
@@ -0,0 +1,435 @@
+package genbenthosconfigs_activity
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"slices"
+	"strings"
+
+	"connectrpc.com/connect"
+	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
+	dbschemas_utils "github.com/nucleuscloud/neosync/backend/pkg/dbschemas"
+	neosync_benthos "github.com/nucleuscloud/neosync/worker/internal/benthos"
+	"github.com/nucleuscloud/neosync/worker/pkg/workflows/datasync/activities/shared"
+)
+
+const (
+	schemaTypeChangeErrMsg = "job mappings contain columns whose data type changed in the source connection since the previous run"
+)
+
+type schemaDriftPolicies struct {
+	NewColumn            mgmtv1alpha1.SchemaDriftPolicy
+	NewColumnTransformer *mgmtv1alpha1.JobMappingTransformer
+	RemovedColumn        mgmtv1alpha1.SchemaDriftPolicy
+	TypeChange           mgmtv1alpha1.SchemaDriftPolicy
+}
+
+// Resolves the unspecified policies to their defaults.
+// New columns only halt the run if the deprecated halt_on_new_column_addition option is set
+func getSchemaDriftPolicies(
+	haltOnNewColumnAddition bool,
+	opts *mgmtv1alpha1.SchemaDriftOptions,
+) *schemaDriftPolicies {
+	policies := &schemaDriftPolicies{
+		NewColumn:            opts.GetNewColumnPolicy(),
+		NewColumnTransformer: opts.GetNewColumnTransformer(),
+		RemovedColumn:        opts.GetRemovedColumnPolicy(),
+		TypeChange:           opts.GetTypeChangePolicy(),
+	}
+	if policies.NewColumn == mgmtv1alpha1.SchemaDriftPolicy_SCHEMA_DRIFT_POLICY_UNSPECIFIED {
+		if haltOnNewColumnAddition {
+			policies.NewColumn = mgmtv1alpha1.SchemaDriftPolicy_SCHEMA_DRIFT_POLICY_HALT
+		} else {
+			policies.NewColumn = mgmtv1alpha1.SchemaDriftPolicy_SCHEMA_DRIFT_POLICY_IGNORE
+		}
+	}
+	if policies.RemovedColumn == mgmtv1alpha1.SchemaDriftPolicy_SCHEMA_DRIFT_POLICY_UNSPECIFIED {
+		policies.RemovedColumn = mgmtv1alpha1.SchemaDriftPolicy_SCHEMA_DRIFT_POLICY_HALT
+	}
+	if policies.TypeChange == mgmtv1alpha1.SchemaDriftPolicy_SCHEMA_DRIFT_POLICY_UNSPECIFIED {
+		policies.TypeChange = mgmtv1alpha1.SchemaDriftPolicy_SCHEMA_DRIFT_POLICY_IGNORE
+	}
+	return policies
+}
+
+// Compares the source schema against the job mappings and the columns seen by the previous run, applies the job's drift policies and records the drift report on the run.
+// Returns the mappings that should be used for this run, or an error if the run should be halted.
+// Tables that are not in the job mappings are removed from the grouped schemas
+func (b *benthosBuilder) handleSchemaDrift(
+	ctx context.Context,
+	jobId string,
+	groupedSchemas map[string]map[string]*dbschemas_utils.ColumnInfo,
+	mappings []*mgmtv1alpha1.JobMapping,
+	policies *schemaDriftPolicies,
+	slogger *slog.Logger,
+) ([]*mgmtv1alpha1.JobMapping, error) {
+	tableColMappings := getUniqueColMappingsMap(mappings)
+	for key := range groupedSchemas {
+		// only the schemas+tables that we currently have mappings for are checked for drift
+		if _, ok := tableColMappings[key]; !ok {
+			delete(groupedSchemas, key)
+		}
+	}
+
+	previousColumns, err := b.getPreviousSchemaDriftColumns(ctx, jobId)
+	if err != nil {
+		return nil, fmt.Errorf("unable to get previous schema drift report: %w", err)
+	}
+
+	changes := getSchemaDriftChanges(groupedSchemas, mappings, previousColumns)
+	runMappings := applySchemaDriftPolicies(changes, mappings, groupedSchemas, policies)
+	haltErr := getSchemaDriftHaltError(changes)
+
+	slogger.Info(fmt.Sprintf("found %d schema drift changes", len(changes)), "halted", haltErr != nil)
+	_, err = b.jobclient.SetJobRunSchemaDriftReport(ctx, connect.NewRequest(&mgmtv1alpha1.SetJobRunSchemaDriftReportRequest{
+		JobId:    jobId,
+		JobRunId: b.runId,
+		Halted:   haltErr != nil,
+		Changes:  changes,
+		Columns:  getSchemaDriftColumns(groupedSchemas),
+	}))
+	if err != nil {
+		return nil, fmt.Errorf("unable to set job run schema drift report: %w", err)
+	}
+	if haltErr != nil {
+		return nil, haltErr
+	}
+	return runMappings, nil
+}
+
+// Returns map of schema.table -> column -> data type of the columns seen by the previous run, or nil if the job has no previous report.
+// Renamed and removed columns from the previous report are carried over so that they are still detected if the job mappings were not updated
+func (b *benthosBuilder) getPreviousSchemaDriftColumns(
+	ctx context.Context,
+	jobId string,
+) (map[string]map[string]string, error) {
+	resp, err := b.jobclient.GetJobRunSchemaDriftReport(ctx, connect.NewRequest(&mgmtv1alpha1.GetJobRunSchemaDriftReportRequest{
+		JobId: jobId,
+	}))
+	if err != nil && connect.CodeOf(err) == connect.CodeNotFound {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	output := map[string]map[string]string{}
+	for _, col := range resp.Msg.GetReport().GetColumns() {
+		key := neosync_benthos.BuildBenthosTable(col.Schema, col.Table)
+		if _, ok := output[key]; !ok {
+			output[key] = map[string]string{}
+		}
+		output[key][col.Column] = col.DataType
+	}
+	for _, change := range resp.Msg.GetReport().GetChanges() {
+		key := neosync_benthos.BuildBenthosTable(change.Schema, change.Table)
+		if change.PreviousDataType == nil {
+			continue
+		}
+		if _, ok := output[key]; !ok {
+			output[key] = map[string]string{}
+		}
+		switch change.Type {
+		case mgmtv1alpha1.SchemaDriftChangeType_SCHEMA_DRIFT_CHANGE_TYPE_COLUMN_RENAMED:
+			if change.RenamedFrom == nil {
+				continue
+			}
+			delete(output[key], change.Column)
+			output[key][*change.RenamedFrom] = *change.PreviousDataType
+		case mgmtv1alpha1.SchemaDriftChangeType_SCHEMA_DRIFT_CHANGE_TYPE_COLUMN_REMOVED:
+			output[key][change.Column] = *change.PreviousDataType
+		}
+	}
+	return output, nil
+}
+
+// Returns the differences between the source columns of the mapped tables and the job mappings.
+// A mapped column that is no longer in the source is detected as renamed when it is the only removed column of its previous type in the table
+// and a single column of that type was added to the table since the previous run. Renames and type changes are only detected if there was a previous run
+func getSchemaDriftChanges(
+	groupedSchemas map[string]map[string]*dbschemas_utils.ColumnInfo,
+	mappings []*mgmtv1alpha1.JobMapping,
+	previousColumns map[string]map[string]string,
+) []*mgmtv1alpha1.SchemaDriftChange {
+	tableColMappings := getUniqueColMappingsMap(mappings)
+	tables := make([]string, 0, len(tableColMappings))
+	for table := range tableColMappings {
+		tables = append(tables, table)
+	}
+	slices.Sort(tables)
+
+	changes := []*mgmtv1alpha1.SchemaDriftChange{}
+	for _, table := range tables {
+		schema, tableName := splitTableKey(table)
+		schemaCols := groupedSchemas[table]
+		mappedCols := tableColMappings[table]
+		previousCols, hasPrevious := previousColumns[table]
+
+		removed := []string{}
+		for col := range mappedCols {
+			if _, ok := schemaCols[col]; !ok {
+				removed = append(removed, col)
+			}
+		}
+		slices.Sort(removed)
+		added := []string{}
+		for col := range schemaCols {
+			if _, ok := mappedCols[col]; !ok {
+				added = append(added, col)
+			}
+		}
+		slices.Sort(added)
+
+		// data type -> columns
+		removedByType := map[string][]string{}
+		newByType := map[string][]string{}
+		if hasPrevious {
+			for _, col := range removed {
+				if dataType, ok := previousCols[col]; ok {
+					removedByType[dataType] = append(removedByType[dataType], col)
+				}
+			}
+			for _, col := range added {
+				if _, ok := previousCols[col]; !ok {
+					dataType := schemaCols[col].DataType
+					newByType[dataType] = append(newByType[dataType], col)
+				}
+			}
+		}
+		renamedTo := map[string]string{}
+		renamedFrom := map[string]string{}
+		for dataType, cols := range removedByType {
+			if len(cols) == 1 && len(newByType[dataType]) == 1 {
+				renamedTo[cols[0]] = newByType[dataType][0]
+				renamedFrom[newByType[dataType][0]] = cols[0]
+			}
+		}
+
+		for _, col := range removed {
+			var previousDataType *string
+			if dataType, ok := previousCols[col]; ok {
+				previousDataType = shared.Ptr(dataType)
+			}
+			if newCol, ok := renamedTo[col]; ok {
+				changes = append(changes, &mgmtv1alpha1.SchemaDriftChange{
+					Schema:           schema,
+					Table:            tableName,
+					Column:           newCol,
+					Type:             mgmtv1alpha1.SchemaDriftChangeType_SCHEMA_DRIFT_CHANGE_TYPE_COLUMN_RENAMED,
+					RenamedFrom:      shared.Ptr(col),
+					PreviousDataType: previousDataType,
+					DataType:         shared.Ptr(schemaCols[newCol].DataType),
+				})
+				continue
+			}
+			changes = append(changes, &mgmtv1alpha1.SchemaDriftChange{
+				Schema:           schema,
+				Table:            tableName,
+				Column:           col,
+				Type:             mgmtv1alpha1.SchemaDriftChangeType_SCHEMA_DRIFT_CHANGE_TYPE_COLUMN_REMOVED,
+				PreviousDataType: previousDataType,
+			})
+		}
+		for _, col := range added {
+			if _, ok := renamedFrom[col]; ok {
+				continue
+			}
+			changes = append(changes, &mgmtv1alpha1.SchemaDriftChange{
+				Schema:   schema,
+				Table:    tableName,
+				Column:   col,
+				Type:     mgmtv1alpha1.SchemaDriftChangeType_SCHEMA_DRIFT_CHANGE_TYPE_COLUMN_ADDED,
+				DataType: shared.Ptr(schemaCols[col].DataType),
+			})
+		}
+
+		mapped := make([]string, 0, len(mappedCols))
+		for col := range mappedCols {
+			mapped = append(mapped, col)
+		}
+		slices.Sort(mapped)
+		for _, col := range mapped {
+			colInfo, ok := schemaCols[col]
+			if !ok {
+				continue
+			}
+			previousDataType, ok := previousCols[col]
+			if !ok || previousDataType == colInfo.DataType {
+				continue
+			}
+			changes = append(changes, &mgmtv1alpha1.SchemaDriftChange{
+				Schema:           schema,
+				Table:            tableName,
+				Column:           col,
+				Type:             mgmtv1alpha1.SchemaDriftChangeType_SCHEMA_DRIFT_CHANGE_TYPE_COLUMN_TYPE_CHANGED,
+				PreviousDataType: shared.Ptr(previousDataType),
+				DataType:         shared.Ptr(colInfo.DataType),
+			})
+		}
+	}
+	return changes
+}
+
+// Sets the action that the run takes for each change along with the transformer that is suggested for new columns.
+// Returns the mappings with new columns that are auto mapped added, renamed columns moved to their new name and removed columns unmapped
+func applySchemaDriftPolicies(
+	changes []*mgmtv1alpha1.SchemaDriftChange,
+	mappings []*mgmtv1alpha1.JobMapping,
+	groupedSchemas map[string]map[string]*dbschemas_utils.ColumnInfo,
+	policies *schemaDriftPolicies,
+) []*mgmtv1alpha1.JobMapping {
+	removed := map[string]bool{}
+	renamed := map[string]string{}
+	added := []*mgmtv1alpha1.JobMapping{}
+
+	for _, change := range changes {
+		table := neosync_benthos.BuildBenthosTable(change.Schema, change.Table)
+		switch change.Type {
+		case mgmtv1alpha1.SchemaDriftChangeType_SCHEMA_DRIFT_CHANGE_TYPE_COLUMN_ADDED:
+			change.SuggestedTransformer = policies.NewColumnTransformer
+			if change.SuggestedTransformer == nil {
+				change.SuggestedTransformer = getDefaultSchemaDriftTransformer(groupedSchemas[table][change.Column])
+			}
+			switch policies.NewColumn {
+			case mgmtv1alpha1.SchemaDriftPolicy_SCHEMA_DRIFT_POLICY_HALT:
+				change.Action = mgmtv1alpha1.SchemaDriftAction_SCHEMA_DRIFT_ACTION_HALTED
+			case mgmtv1alpha1.SchemaDriftPolicy_SCHEMA_DRIFT_POLICY_AUTO_MAP:
+				change.Action = mgmtv1alpha1.SchemaDriftAction_SCHEMA_DRIFT_ACTION_AUTO_MAPPED
+				added = append(added, &mgmtv1alpha1.JobMapping{
+					Schema:      change.Schema,
+					Table:       change.Table,
+					Column:      change.Column,
+					Transformer: change.SuggestedTransformer,
+				})
+			default:
+				change.Action = mgmtv1alpha1.SchemaDriftAction_SCHEMA_DRIFT_ACTION_IGNORED
+			}
+		case mgmtv1alpha1.SchemaDriftChangeType_SCHEMA_DRIFT_CHANGE_TYPE_COLUMN_REMOVED,
+			mgmtv1alpha1.SchemaDriftChangeType_SCHEMA_DRIFT_CHANGE_TYPE_COLUMN_RENAMED:
+			if policies.RemovedColumn == mgmtv1alpha1.SchemaDriftPolicy_SCHEMA_DRIFT_POLICY_HALT {
+				change.Action = mgmtv1alpha1.SchemaDriftAction_SCHEMA_DRIFT_ACTION_HALTED
+				continue
+			}
+			// the removed column can not be selected so the mapping is always updated
+			change.Action = mgmtv1alpha1.SchemaDriftAction_SCHEMA_DRIFT_ACTION_AUTO_MAPPED
+			if change.Type == mgmtv1alpha1.SchemaDriftChangeType_SCHEMA_DRIFT_CHANGE_TYPE_COLUMN_RENAMED {
+				renamed[fmt.Sprintf("%s.%s", table, change.GetRenamedFrom())] = change.Column
+			} else {
+				removed[fmt.Sprintf("%s.%s", table, change.Column)] = true
+			}
+		case mgmtv1alpha1.SchemaDriftChangeType_SCHEMA_DRIFT_CHANGE_TYPE_COLUMN_TYPE_CHANGED:
+			if policies.TypeChange == mgmtv1alpha1.SchemaDriftPolicy_SCHEMA_DRIFT_POLICY_HALT {
+				change.Action = mgmtv1alpha1.SchemaDriftAction_SCHEMA_DRIFT_ACTION_HALTED
+			} else {
+				change.Action = mgmtv1alpha1.SchemaDriftAction_SCHEMA_DRIFT_ACTION_IGNORED
+			}
+		}
+	}
+
+	output := make([]*mgmtv1alpha1.JobMapping, 0, len(mappings)+len(added))
+	for _, mapping := range mappings {
+		key := fmt.Sprintf("%s.%s", neosync_benthos.BuildBenthosTable(mapping.Schema, mapping.Table), mapping.Column)
+		if removed[key] {
+			continue
+		}
+		if column, ok := renamed[key]; ok {
+			output = append(output, &mgmtv1alpha1.JobMapping{
+				Schema:      mapping.Schema,
+				Table:       mapping.Table,
+				Column:      column,
+				Transformer: mapping.Transformer,
+			})
+			continue
+		}
+		output = append(output, mapping)
+	}
+	return append(output, added...)
+}
+
+// Columns with a default fall back to their default, nullable columns are nulled out and every other column is passed through
+func getDefaultSchemaDriftTransformer(colInfo *dbschemas_utils.ColumnInfo) *mgmtv1alpha1.JobMappingTransformer {
+	switch {
+	case colInfo != nil && colInfo.ColumnDefault != "":
+		return &mgmtv1alpha1.JobMappingTransformer{
+			Source: mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_GENERATE_DEFAULT,
+			Config: &mgmtv1alpha1.TransformerConfig{
+				Config: &mgmtv1alpha1.TransformerConfig_GenerateDefaultConfig{GenerateDefaultConfig: &mgmtv1alpha1.GenerateDefault{}},
+			},
+		}
+	case colInfo != nil && colInfo.IsNullable == "YES":
+		return &mgmtv1alpha1.JobMappingTransformer{
+			Source: mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_GENERATE_NULL,
+			Config: &mgmtv1alpha1.TransformerConfig{
+				Config: &mgmtv1alpha1.TransformerConfig_Nullconfig{Nullconfig: &mgmtv1alpha1.Null{}},
+			},
+		}
+	default:
+		return &mgmtv1alpha1.JobMappingTransformer{
+			Source: mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_PASSTHROUGH,
+			Config: &mgmtv1alpha1.TransformerConfig{
+				Config: &mgmtv1alpha1.TransformerConfig_PassthroughConfig{PassthroughConfig: &mgmtv1alpha1.Passthrough{}},
+			},
+		}
+	}
+}
+
+// Returns an error that lists the columns of each kind of change that halted the run
+func getSchemaDriftHaltError(changes []*mgmtv1alpha1.SchemaDriftChange) error {
+	removed := []string{}
+	added := []string{}
+	typeChanged := []string{}
+	for _, change := range changes {
+		if change.Action != mgmtv1alpha1.SchemaDriftAction_SCHEMA_DRIFT_ACTION_HALTED {
+			continue
+		}
+		column := fmt.Sprintf("%s.%s", neosync_benthos.BuildBenthosTable(change.Schema, change.Table), change.Column)
+		switch change.Type {
+		case mgmtv1alpha1.SchemaDriftChangeType_SCHEMA_DRIFT_CHANGE_TYPE_COLUMN_REMOVED:
+			removed = append(removed, column)
+		case mgmtv1alpha1.SchemaDriftChangeType_SCHEMA_DRIFT_CHANGE_TYPE_COLUMN_RENAMED:
+			removed = append(removed, fmt.Sprintf("%s.%s", neosync_benthos.BuildBenthosTable(change.Schema, change.Table), change.GetRenamedFrom()))
+		case mgmtv1alpha1.SchemaDriftChangeType_SCHEMA_DRIFT_CHANGE_TYPE_COLUMN_ADDED:
+			added = append(added, column)
+		case mgmtv1alpha1.SchemaDriftChangeType_SCHEMA_DRIFT_CHANGE_TYPE_COLUMN_TYPE_CHANGED:
+			typeChanged = append(typeChanged, column)
+		}
+	}
+
+	errs := []error{}
+	if len(removed) > 0 {
+		errs = append(errs, fmt.Errorf("%s: %s", jobmappingSubsetErrMsg, strings.Join(removed, ", ")))
+	}
+	if len(added) > 0 {
+		errs = append(errs, fmt.Errorf("%s: %s", haltOnSchemaAdditionErrMsg, strings.Join(added, ", ")))
+	}
+	if len(typeChanged) > 0 {
+		errs = append(errs, fmt.Errorf("%s: %s", schemaTypeChangeErrMsg, strings.Join(typeChanged, ", ")))
+	}
+	return errors.Join(errs...)
+}
+
+// Returns the columns of the mapped tables so that the next run can detect type changes and renames
+func getSchemaDriftColumns(
+	groupedSchemas map[string]map[string]*dbschemas_utils.ColumnInfo,
+) []*mgmtv1alpha1.SchemaDriftColumn {
+	output := []*mgmtv1alpha1.SchemaDriftColumn{}
+	for table, cols := range groupedSchemas {
+		schema, tableName := splitTableKey(table)
+		for col, colInfo := range cols {
+			output = append(output, &mgmtv1alpha1.SchemaDriftColumn{
+				Schema:   schema,
+				Table:    tableName,
+				Column:   col,
+				DataType: colInfo.DataType,
+			})
+		}
+	}
+	slices.SortFunc(output, func(a, b *mgmtv1alpha1.SchemaDriftColumn) int {
+		return strings.Compare(
+			fmt.Sprintf("%s.%s.%s", a.Schema, a.Table, a.Column),
+			fmt.Sprintf("%s.%s.%s", b.Schema, b.Table, b.Column),
+		)
+	})
+	return output
+}
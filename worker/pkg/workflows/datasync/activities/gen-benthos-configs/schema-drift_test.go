@@ -0,0 +1,306 @@
+package genbenthosconfigs_activity
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"testing"
+
+	"connectrpc.com/connect"
+	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
+	"github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1/mgmtv1alpha1connect"
+	dbschemas_utils "github.com/nucleuscloud/neosync/backend/pkg/dbschemas"
+	"github.com/nucleuscloud/neosync/worker/pkg/workflows/datasync/activities/shared"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// mocks a job with no previous schema drift report
+func mockSchemaDriftReports(mockJobClient *mgmtv1alpha1connect.MockJobServiceClient) {
+	mockJobClient.On("GetJobRunSchemaDriftReport", mock.Anything, mock.Anything).
+		Return(nil, connect.NewError(connect.CodeNotFound, errors.New("unable to find schema drift report for job run")))
+	mockJobClient.On("SetJobRunSchemaDriftReport", mock.Anything, mock.Anything).
+		Return(connect.NewResponse(&mgmtv1alpha1.SetJobRunSchemaDriftReportResponse{}), nil)
+}
+
+func Test_getSchemaDriftPolicies(t *testing.T) {
+	policies := getSchemaDriftPolicies(false, nil)
+	assert.Equal(t, mgmtv1alpha1.SchemaDriftPolicy_SCHEMA_DRIFT_POLICY_IGNORE, policies.NewColumn)
+	assert.Equal(t, mgmtv1alpha1.SchemaDriftPolicy_SCHEMA_DRIFT_POLICY_HALT, policies.RemovedColumn)
+	assert.Equal(t, mgmtv1alpha1.SchemaDriftPolicy_SCHEMA_DRIFT_POLICY_IGNORE, policies.TypeChange)
+
+	policies = getSchemaDriftPolicies(true, nil)
+	assert.Equal(t, mgmtv1alpha1.SchemaDriftPolicy_SCHEMA_DRIFT_POLICY_HALT, policies.NewColumn)
+
+	policies = getSchemaDriftPolicies(true, &mgmtv1alpha1.SchemaDriftOptions{
+		NewColumnPolicy:     mgmtv1alpha1.SchemaDriftPolicy_SCHEMA_DRIFT_POLICY_AUTO_MAP,
+		RemovedColumnPolicy: mgmtv1alpha1.SchemaDriftPolicy_SCHEMA_DRIFT_POLICY_IGNORE,
+		TypeChangePolicy:    mgmtv1alpha1.SchemaDriftPolicy_SCHEMA_DRIFT_POLICY_HALT,
+	})
+	assert.Equal(t, mgmtv1alpha1.SchemaDriftPolicy_SCHEMA_DRIFT_POLICY_AUTO_MAP, policies.NewColumn, "schema drift options take precedence over halt on new column addition")
+	assert.Equal(t, mgmtv1alpha1.SchemaDriftPolicy_SCHEMA_DRIFT_POLICY_IGNORE, policies.RemovedColumn)
+	assert.Equal(t, mgmtv1alpha1.SchemaDriftPolicy_SCHEMA_DRIFT_POLICY_HALT, policies.TypeChange)
+}
+
+func Test_getSchemaDriftChanges(t *testing.T) {
+	changes := getSchemaDriftChanges(
+		map[string]map[string]*dbschemas_utils.ColumnInfo{
+			"public.users": {
+				"id":         &dbschemas_utils.ColumnInfo{DataType: "integer"},
+				"created_by": &dbschemas_utils.ColumnInfo{DataType: "text"},
+			},
+		},
+		[]*mgmtv1alpha1.JobMapping{
+			{Schema: "public", Table: "users", Column: "id"},
+			{Schema: "public", Table: "users", Column: "created_by"},
+		},
+		nil,
+	)
+	assert.Empty(t, changes, "job mappings are equal to the database schema")
+
+	changes = getSchemaDriftChanges(
+		map[string]map[string]*dbschemas_utils.ColumnInfo{
+			"public.users": {
+				"id":         &dbschemas_utils.ColumnInfo{DataType: "integer"},
+				"created_by": &dbschemas_utils.ColumnInfo{DataType: "text"},
+			},
+		},
+		[]*mgmtv1alpha1.JobMapping{
+			{Schema: "public", Table: "users", Column: "id"},
+			{Schema: "public", Table: "users", Column: "updated_by"},
+		},
+		nil,
+	)
+	assert.Equal(t, []*mgmtv1alpha1.SchemaDriftChange{
+		{Schema: "public", Table: "users", Column: "updated_by", Type: mgmtv1alpha1.SchemaDriftChangeType_SCHEMA_DRIFT_CHANGE_TYPE_COLUMN_REMOVED},
+		{Schema: "public", Table: "users", Column: "created_by", Type: mgmtv1alpha1.SchemaDriftChangeType_SCHEMA_DRIFT_CHANGE_TYPE_COLUMN_ADDED, DataType: shared.Ptr("text")},
+	}, changes, "renames are not detected without a previous run")
+
+	changes = getSchemaDriftChanges(
+		map[string]map[string]*dbschemas_utils.ColumnInfo{},
+		[]*mgmtv1alpha1.JobMapping{
+			{Schema: "public", Table: "users", Column: "id"},
+		},
+		nil,
+	)
+	assert.Equal(t, []*mgmtv1alpha1.SchemaDriftChange{
+		{Schema: "public", Table: "users", Column: "id", Type: mgmtv1alpha1.SchemaDriftChangeType_SCHEMA_DRIFT_CHANGE_TYPE_COLUMN_REMOVED},
+	}, changes, "mapped table is no longer in the source")
+}
+
+func Test_getSchemaDriftChanges_PreviousRun(t *testing.T) {
+	changes := getSchemaDriftChanges(
+		map[string]map[string]*dbschemas_utils.ColumnInfo{
+			"public.users": {
+				"id":         &dbschemas_utils.ColumnInfo{DataType: "bigint"},
+				"email":      &dbschemas_utils.ColumnInfo{DataType: "text"},
+				"created_at": &dbschemas_utils.ColumnInfo{DataType: "timestamp"},
+				"notes":      &dbschemas_utils.ColumnInfo{DataType: "text"},
+			},
+		},
+		[]*mgmtv1alpha1.JobMapping{
+			{Schema: "public", Table: "users", Column: "id"},
+			{Schema: "public", Table: "users", Column: "mail"},
+			{Schema: "public", Table: "users", Column: "age"},
+		},
+		map[string]map[string]string{
+			"public.users": {
+				"id":    "integer",
+				"mail":  "text",
+				"age":   "integer",
+				"notes": "text",
+			},
+		},
+	)
+	assert.Equal(t, []*mgmtv1alpha1.SchemaDriftChange{
+		{Schema: "public", Table: "users", Column: "age", Type: mgmtv1alpha1.SchemaDriftChangeType_SCHEMA_DRIFT_CHANGE_TYPE_COLUMN_REMOVED, PreviousDataType: shared.Ptr("integer")},
+		{Schema: "public", Table: "users", Column: "email", Type: mgmtv1alpha1.SchemaDriftChangeType_SCHEMA_DRIFT_CHANGE_TYPE_COLUMN_RENAMED, RenamedFrom: shared.Ptr("mail"), PreviousDataType: shared.Ptr("text"), DataType: shared.Ptr("text")},
+		{Schema: "public", Table: "users", Column: "created_at", Type: mgmtv1alpha1.SchemaDriftChangeType_SCHEMA_DRIFT_CHANGE_TYPE_COLUMN_ADDED, DataType: shared.Ptr("timestamp")},
+		{Schema: "public", Table: "users", Column: "notes", Type: mgmtv1alpha1.SchemaDriftChangeType_SCHEMA_DRIFT_CHANGE_TYPE_COLUMN_ADDED, DataType: shared.Ptr("text")},
+		{Schema: "public", Table: "users", Column: "id", Type: mgmtv1alpha1.SchemaDriftChangeType_SCHEMA_DRIFT_CHANGE_TYPE_COLUMN_TYPE_CHANGED, PreviousDataType: shared.Ptr("integer"), DataType: shared.Ptr("bigint")},
+	}, changes)
+}
+
+func Test_applySchemaDriftPolicies(t *testing.T) {
+	groupedSchemas := map[string]map[string]*dbschemas_utils.ColumnInfo{
+		"public.users": {
+			"id":         &dbschemas_utils.ColumnInfo{DataType: "bigint"},
+			"email":      &dbschemas_utils.ColumnInfo{DataType: "text", IsNullable: "YES"},
+			"created_at": &dbschemas_utils.ColumnInfo{DataType: "timestamp", ColumnDefault: "now()", IsNullable: "NO"},
+		},
+	}
+	passthrough := getDefaultSchemaDriftTransformer(nil)
+	mappings := []*mgmtv1alpha1.JobMapping{
+		{Schema: "public", Table: "users", Column: "id", Transformer: passthrough},
+		{Schema: "public", Table: "users", Column: "mail", Transformer: passthrough},
+		{Schema: "public", Table: "users", Column: "age", Transformer: passthrough},
+	}
+	newChanges := func() []*mgmtv1alpha1.SchemaDriftChange {
+		return []*mgmtv1alpha1.SchemaDriftChange{
+			{Schema: "public", Table: "users", Column: "age", Type: mgmtv1alpha1.SchemaDriftChangeType_SCHEMA_DRIFT_CHANGE_TYPE_COLUMN_REMOVED},
+			{Schema: "public", Table: "users", Column: "email", Type: mgmtv1alpha1.SchemaDriftChangeType_SCHEMA_DRIFT_CHANGE_TYPE_COLUMN_RENAMED, RenamedFrom: shared.Ptr("mail")},
+			{Schema: "public", Table: "users", Column: "created_at", Type: mgmtv1alpha1.SchemaDriftChangeType_SCHEMA_DRIFT_CHANGE_TYPE_COLUMN_ADDED},
+			{Schema: "public", Table: "users", Column: "id", Type: mgmtv1alpha1.SchemaDriftChangeType_SCHEMA_DRIFT_CHANGE_TYPE_COLUMN_TYPE_CHANGED},
+		}
+	}
+
+	t.Run("auto map", func(t *testing.T) {
+		changes := newChanges()
+		actual := applySchemaDriftPolicies(changes, mappings, groupedSchemas, getSchemaDriftPolicies(false, &mgmtv1alpha1.SchemaDriftOptions{
+			NewColumnPolicy:     mgmtv1alpha1.SchemaDriftPolicy_SCHEMA_DRIFT_POLICY_AUTO_MAP,
+			RemovedColumnPolicy: mgmtv1alpha1.SchemaDriftPolicy_SCHEMA_DRIFT_POLICY_AUTO_MAP,
+		}))
+		assert.Equal(t, []*mgmtv1alpha1.JobMapping{
+			{Schema: "public", Table: "users", Column: "id", Transformer: passthrough},
+			{Schema: "public", Table: "users", Column: "email", Transformer: passthrough},
+			{Schema: "public", Table: "users", Column: "created_at", Transformer: getDefaultSchemaDriftTransformer(groupedSchemas["public.users"]["created_at"])},
+		}, actual)
+		assert.Equal(t, mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_GENERATE_DEFAULT, changes[2].SuggestedTransformer.Source)
+		assert.Equal(t, mgmtv1alpha1.SchemaDriftAction_SCHEMA_DRIFT_ACTION_AUTO_MAPPED, changes[0].Action)
+		assert.Equal(t, mgmtv1alpha1.SchemaDriftAction_SCHEMA_DRIFT_ACTION_AUTO_MAPPED, changes[1].Action)
+		assert.Equal(t, mgmtv1alpha1.SchemaDriftAction_SCHEMA_DRIFT_ACTION_AUTO_MAPPED, changes[2].Action)
+		assert.Equal(t, mgmtv1alpha1.SchemaDriftAction_SCHEMA_DRIFT_ACTION_IGNORED, changes[3].Action)
+		assert.NoError(t, getSchemaDriftHaltError(changes))
+	})
+
+	t.Run("new column transformer", func(t *testing.T) {
+		changes := newChanges()
+		nullTransformer := getDefaultSchemaDriftTransformer(groupedSchemas["public.users"]["email"])
+		actual := applySchemaDriftPolicies(changes, mappings, groupedSchemas, getSchemaDriftPolicies(false, &mgmtv1alpha1.SchemaDriftOptions{
+			NewColumnPolicy:      mgmtv1alpha1.SchemaDriftPolicy_SCHEMA_DRIFT_POLICY_AUTO_MAP,
+			NewColumnTransformer: nullTransformer,
+			RemovedColumnPolicy:  mgmtv1alpha1.SchemaDriftPolicy_SCHEMA_DRIFT_POLICY_IGNORE,
+		}))
+		assert.Len(t, actual, 3)
+		assert.Equal(t, nullTransformer, actual[2].Transformer)
+		assert.Equal(t, mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_GENERATE_NULL, changes[2].SuggestedTransformer.Source)
+	})
+
+	t.Run("ignore", func(t *testing.T) {
+		changes := newChanges()
+		actual := applySchemaDriftPolicies(changes, mappings, groupedSchemas, getSchemaDriftPolicies(false, &mgmtv1alpha1.SchemaDriftOptions{
+			RemovedColumnPolicy: mgmtv1alpha1.SchemaDriftPolicy_SCHEMA_DRIFT_POLICY_IGNORE,
+		}))
+		assert.Equal(t, []*mgmtv1alpha1.JobMapping{
+			{Schema: "public", Table: "users", Column: "id", Transformer: passthrough},
+			{Schema: "public", Table: "users", Column: "email", Transformer: passthrough},
+		}, actual, "removed columns are unmapped as they can not be selected")
+		assert.Equal(t, mgmtv1alpha1.SchemaDriftAction_SCHEMA_DRIFT_ACTION_IGNORED, changes[2].Action)
+		assert.NotNil(t, changes[2].SuggestedTransformer)
+		assert.NoError(t, getSchemaDriftHaltError(changes))
+	})
+
+	t.Run("halt", func(t *testing.T) {
+		changes := newChanges()
+		applySchemaDriftPolicies(changes, mappings, groupedSchemas, getSchemaDriftPolicies(true, &mgmtv1alpha1.SchemaDriftOptions{
+			TypeChangePolicy: mgmtv1alpha1.SchemaDriftPolicy_SCHEMA_DRIFT_POLICY_HALT,
+		}))
+		for _, change := range changes {
+			assert.Equal(t, mgmtv1alpha1.SchemaDriftAction_SCHEMA_DRIFT_ACTION_HALTED, change.Action)
+		}
+		err := getSchemaDriftHaltError(changes)
+		require.Error(t, err)
+		assert.ErrorContains(t, err, jobmappingSubsetErrMsg+": public.users.age, public.users.mail")
+		assert.ErrorContains(t, err, haltOnSchemaAdditionErrMsg+": public.users.created_at")
+		assert.ErrorContains(t, err, schemaTypeChangeErrMsg+": public.users.id")
+	})
+}
+
+func Test_getDefaultSchemaDriftTransformer(t *testing.T) {
+	assert.Equal(t, mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_GENERATE_DEFAULT, getDefaultSchemaDriftTransformer(&dbschemas_utils.ColumnInfo{ColumnDefault: "now()", IsNullable: "YES"}).Source)
+	assert.Equal(t, mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_GENERATE_NULL, getDefaultSchemaDriftTransformer(&dbschemas_utils.ColumnInfo{IsNullable: "YES"}).Source)
+	assert.Equal(t, mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_PASSTHROUGH, getDefaultSchemaDriftTransformer(&dbschemas_utils.ColumnInfo{IsNullable: "NO"}).Source)
+}
+
+func Test_handleSchemaDrift(t *testing.T) {
+	mockJobClient := mgmtv1alpha1connect.NewMockJobServiceClient(t)
+	mockJobClient.On("GetJobRunSchemaDriftReport", mock.Anything, mock.Anything).
+		Return(connect.NewResponse(&mgmtv1alpha1.GetJobRunSchemaDriftReportResponse{
+			Report: &mgmtv1alpha1.JobRunSchemaDriftReport{
+				Columns: []*mgmtv1alpha1.SchemaDriftColumn{
+					{Schema: "public", Table: "users", Column: "id", DataType: "integer"},
+				},
+				Changes: []*mgmtv1alpha1.SchemaDriftChange{
+					{Schema: "public", Table: "users", Column: "mail", Type: mgmtv1alpha1.SchemaDriftChangeType_SCHEMA_DRIFT_CHANGE_TYPE_COLUMN_REMOVED, PreviousDataType: shared.Ptr("text")},
+				},
+			},
+		}), nil)
+	mockJobClient.On("SetJobRunSchemaDriftReport", mock.Anything, connect.NewRequest(&mgmtv1alpha1.SetJobRunSchemaDriftReportRequest{
+		JobId:    mockJobId,
+		JobRunId: mockRunId,
+		Halted:   false,
+		Changes: []*mgmtv1alpha1.SchemaDriftChange{
+			{
+				Schema: "public", Table: "users", Column: "email",
+				Type:             mgmtv1alpha1.SchemaDriftChangeType_SCHEMA_DRIFT_CHANGE_TYPE_COLUMN_RENAMED,
+				RenamedFrom:      shared.Ptr("mail"),
+				PreviousDataType: shared.Ptr("text"),
+				DataType:         shared.Ptr("text"),
+				Action:           mgmtv1alpha1.SchemaDriftAction_SCHEMA_DRIFT_ACTION_AUTO_MAPPED,
+			},
+		},
+		Columns: []*mgmtv1alpha1.SchemaDriftColumn{
+			{Schema: "public", Table: "users", Column: "email", DataType: "text"},
+			{Schema: "public", Table: "users", Column: "id", DataType: "integer"},
+		},
+	})).Return(connect.NewResponse(&mgmtv1alpha1.SetJobRunSchemaDriftReportResponse{}), nil)
+
+	bbuilder := &benthosBuilder{jobclient: mockJobClient, runId: mockRunId}
+	groupedSchemas := map[string]map[string]*dbschemas_utils.ColumnInfo{
+		"public.users": {
+			"id":    &dbschemas_utils.ColumnInfo{DataType: "integer"},
+			"email": &dbschemas_utils.ColumnInfo{DataType: "text"},
+		},
+		"public.accounts": {
+			"id": &dbschemas_utils.ColumnInfo{DataType: "integer"},
+		},
+	}
+	mappings, err := bbuilder.handleSchemaDrift(
+		context.Background(),
+		mockJobId,
+		groupedSchemas,
+		[]*mgmtv1alpha1.JobMapping{
+			{Schema: "public", Table: "users", Column: "id"},
+			{Schema: "public", Table: "users", Column: "mail"},
+		},
+		getSchemaDriftPolicies(true, &mgmtv1alpha1.SchemaDriftOptions{
+			RemovedColumnPolicy: mgmtv1alpha1.SchemaDriftPolicy_SCHEMA_DRIFT_POLICY_AUTO_MAP,
+		}),
+		slog.Default(),
+	)
+	require.NoError(t, err)
+	assert.Equal(t, []*mgmtv1alpha1.JobMapping{
+		{Schema: "public", Table: "users", Column: "id"},
+		{Schema: "public", Table: "users", Column: "email"},
+	}, mappings)
+	assert.NotContains(t, groupedSchemas, "public.accounts", "unmapped tables are not checked for drift")
+}
+
+func Test_handleSchemaDrift_Halt(t *testing.T) {
+	mockJobClient := mgmtv1alpha1connect.NewMockJobServiceClient(t)
+	mockJobClient.On("GetJobRunSchemaDriftReport", mock.Anything, mock.Anything).
+		Return(nil, connect.NewError(connect.CodeNotFound, errors.New("unable to find schema drift report for job run")))
+	mockJobClient.On("SetJobRunSchemaDriftReport", mock.Anything, mock.MatchedBy(func(req *connect.Request[mgmtv1alpha1.SetJobRunSchemaDriftReportRequest]) bool {
+		return req.Msg.Halted && len(req.Msg.Changes) == 1
+	})).Return(connect.NewResponse(&mgmtv1alpha1.SetJobRunSchemaDriftReportResponse{}), nil)
+
+	bbuilder := &benthosBuilder{jobclient: mockJobClient, runId: mockRunId}
+	mappings, err := bbuilder.handleSchemaDrift(
+		context.Background(),
+		mockJobId,
+		map[string]map[string]*dbschemas_utils.ColumnInfo{
+			"public.users": {
+				"id":         &dbschemas_utils.ColumnInfo{DataType: "integer"},
+				"created_by": &dbschemas_utils.ColumnInfo{DataType: "text"},
+			},
+		},
+		[]*mgmtv1alpha1.JobMapping{
+			{Schema: "public", Table: "users", Column: "id"},
+		},
+		getSchemaDriftPolicies(true, nil),
+		slog.Default(),
+	)
+	assert.ErrorContains(t, err, haltOnSchemaAdditionErrMsg)
+	assert.Nil(t, mappings)
+}
@@ -0,0 +1,230 @@
+package genbenthosconfigs_activity
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"connectrpc.com/connect"
+	"github.com/jackc/pgx/v5"
+	mysql_queries "github.com/nucleuscloud/neosync/backend/gen/go/db/dbschemas/mysql"
+	pg_queries "github.com/nucleuscloud/neosync/backend/gen/go/db/dbschemas/postgresql"
+	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
+	dbschemas_mysql "github.com/nucleuscloud/neosync/backend/pkg/dbschemas/mysql"
+	dbschemas_postgres "github.com/nucleuscloud/neosync/backend/pkg/dbschemas/postgres"
+	neosync_benthos "github.com/nucleuscloud/neosync/worker/internal/benthos"
+	"github.com/nucleuscloud/neosync/worker/pkg/workflows/datasync/activities/shared"
+)
+
+// The range of watermark values that will be selected for a table during this run
+type sqlWatermark struct {
+	Column string
+	// exclusive lower bound, nil if the table has not been synced incrementally before
+	StartValue *string
+	// inclusive upper bound, nil if the source table has no values for the watermark column
+	EndValue *string
+}
+
+type getWatermarkEndValueFn func(ctx context.Context, schema, table, column string) (*string, error)
+
+// Returns map of schema.table -> the job's current high-water mark
+func (b *benthosBuilder) getJobWatermarks(
+	ctx context.Context,
+	jobId string,
+) (map[string]*mgmtv1alpha1.JobWatermark, error) {
+	resp, err := b.jobclient.GetJobWatermarks(ctx, connect.NewRequest(&mgmtv1alpha1.GetJobWatermarksRequest{
+		JobId: jobId,
+	}))
+	if err != nil {
+		return nil, err
+	}
+	output := map[string]*mgmtv1alpha1.JobWatermark{}
+	for _, wm := range resp.Msg.Watermarks {
+		output[neosync_benthos.BuildBenthosTable(wm.Schema, wm.Table)] = wm
+	}
+	return output, nil
+}
+
+// Resolves the watermark range for every table that is configured for incremental sync.
+// The upper bound is captured up front so that rows written while the run is in progress are picked up by the next run.
+func (b *benthosBuilder) setSqlWatermarks(
+	ctx context.Context,
+	jobId string,
+	tables []string,
+	sourceTableOpts map[string]*sqlSourceTableOptions,
+	getEndValue getWatermarkEndValueFn,
+) error {
+	if !hasWatermarkColumns(sourceTableOpts) {
+		return nil
+	}
+	jobWatermarks, err := b.getJobWatermarks(ctx, jobId)
+	if err != nil {
+		return fmt.Errorf("unable to get job watermarks: %w", err)
+	}
+	for _, table := range tables {
+		opts := sourceTableOpts[table]
+		if opts == nil || opts.WatermarkColumn == nil || *opts.WatermarkColumn == "" {
+			continue
+		}
+		schema, tableName := splitTableKey(table)
+		endValue, err := getEndValue(ctx, schema, tableName, *opts.WatermarkColumn)
+		if err != nil {
+			return fmt.Errorf("unable to get watermark for table %s: %w", table, err)
+		}
+		wm := &sqlWatermark{
+			Column:   *opts.WatermarkColumn,
+			EndValue: endValue,
+		}
+		// a watermark that was recorded against a different column can not be used as a starting point
+		if current, ok := jobWatermarks[table]; ok && current.Column == wm.Column {
+			wm.StartValue = shared.Ptr(current.Value)
+		}
+		opts.watermark = wm
+	}
+	return nil
+}
+
+func hasWatermarkColumns(sourceTableOpts map[string]*sqlSourceTableOptions) bool {
+	for _, opts := range sourceTableOpts {
+		if opts != nil && opts.WatermarkColumn != nil && *opts.WatermarkColumn != "" {
+			return true
+		}
+	}
+	return false
+}
+
+// Returns the watermarks that should be recorded once the run has completed successfully
+func getJobRunWatermarks(sourceTableOpts map[string]*sqlSourceTableOptions) []*shared.JobRunWatermark {
+	output := []*shared.JobRunWatermark{}
+	for table, opts := range sourceTableOpts {
+		if opts == nil || opts.watermark == nil || opts.watermark.EndValue == nil {
+			continue
+		}
+		schema, tableName := splitTableKey(table)
+		output = append(output, &shared.JobRunWatermark{
+			Schema:     schema,
+			Table:      tableName,
+			Column:     opts.watermark.Column,
+			StartValue: opts.watermark.StartValue,
+			EndValue:   *opts.watermark.EndValue,
+		})
+	}
+	slices.SortFunc(output, func(a, b *shared.JobRunWatermark) int {
+		return strings.Compare(neosync_benthos.BuildBenthosTable(a.Schema, a.Table), neosync_benthos.BuildBenthosTable(b.Schema, b.Table))
+	})
+	return output
+}
+
+// Incrementally synced tables are upserted into the destination so that rows which changed since the last run overwrite their previous version
+func getIncrementalUpsertKeys(
+	table string,
+	sourceTableOpts map[string]*sqlSourceTableOptions,
+	primaryKeys map[string][]string,
+) ([]string, error) {
+	opts := sourceTableOpts[table]
+	if opts == nil || opts.watermark == nil {
+		return nil, nil
+	}
+	pks := primaryKeys[table]
+	if len(pks) == 0 {
+		return nil, fmt.Errorf("no primary keys found for table (%s). Incremental sync requires a primary key to upsert rows", table)
+	}
+	return pks, nil
+}
+
+func getPostgresWatermarkEndValueFn(db pg_queries.DBTX) getWatermarkEndValueFn {
+	return func(ctx context.Context, schema, table, column string) (*string, error) {
+		query := fmt.Sprintf(
+			"SELECT MAX(%s)::text FROM %s.%s;",
+			dbschemas_postgres.EscapePgColumn(column),
+			dbschemas_postgres.EscapePgColumn(schema),
+			dbschemas_postgres.EscapePgColumn(table),
+		)
+		var value *string
+		err := db.QueryRow(ctx, query).Scan(&value)
+		if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+			return nil, err
+		}
+		return value, nil
+	}
+}
+
+func getMysqlWatermarkEndValueFn(db mysql_queries.DBTX) getWatermarkEndValueFn {
+	return func(ctx context.Context, schema, table, column string) (*string, error) {
+		query := fmt.Sprintf(
+			"SELECT CAST(MAX(%s) AS CHAR) FROM %s.%s;",
+			dbschemas_mysql.EscapeMysqlColumn(column),
+			dbschemas_mysql.EscapeMysqlColumn(schema),
+			dbschemas_mysql.EscapeMysqlColumn(table),
+		)
+		var value sql.NullString
+		err := db.QueryRowContext(ctx, query).Scan(&value)
+		if err != nil && !errors.Is(err, sql.ErrNoRows) {
+			return nil, err
+		}
+		if !value.Valid {
+			return nil, nil
+		}
+		return &value.String, nil
+	}
+}
+
+// Builds the where clause that restricts a select to the rows within the watermark range.
+// Watermark values are stored as text and rely on the database casting the literal to the column type when compared.
+func buildWatermarkWhereClause(driver, schema, table string, wm *sqlWatermark) (string, error) {
+	if wm == nil || (wm.StartValue == nil && wm.EndValue == nil) {
+		return "", nil
+	}
+	var column string
+	var quoteValue func(string) string
+	switch driver {
+	case postgresDriver:
+		column = buildSqlIdentifier(dbschemas_postgres.EscapePgColumn(schema), dbschemas_postgres.EscapePgColumn(table), dbschemas_postgres.EscapePgColumn(wm.Column))
+		quoteValue = quotePostgresLiteral
+	case mysqlDriver:
+		column = buildSqlIdentifier(dbschemas_mysql.EscapeMysqlColumn(schema), dbschemas_mysql.EscapeMysqlColumn(table), dbschemas_mysql.EscapeMysqlColumn(wm.Column))
+		quoteValue = quoteMysqlLiteral
+	default:
+		return "", fmt.Errorf("incremental sync is not supported for driver: %s", driver)
+	}
+
+	clauses := []string{}
+	if wm.StartValue != nil {
+		clauses = append(clauses, fmt.Sprintf("%s > %s", column, quoteValue(*wm.StartValue)))
+	}
+	if wm.EndValue != nil {
+		clauses = append(clauses, fmt.Sprintf("%s <= %s", column, quoteValue(*wm.EndValue)))
+	}
+	return strings.Join(clauses, " AND "), nil
+}
+
+// combines the table's where clause with its watermark range, if one is present
+func addWatermarkWhereClause(driver, schema, table string, where *string, tableOpts *sqlSourceTableOptions) (*string, error) {
+	if tableOpts == nil || tableOpts.watermark == nil {
+		return where, nil
+	}
+	watermarkWhere, err := buildWatermarkWhereClause(driver, schema, table, tableOpts.watermark)
+	if err != nil {
+		return nil, err
+	}
+	if watermarkWhere == "" {
+		return where, nil
+	}
+	if where == nil || *where == "" {
+		return &watermarkWhere, nil
+	}
+	combined := fmt.Sprintf("(%s) AND (%s)", *where, watermarkWhere)
+	return &combined, nil
+}
+
+func quotePostgresLiteral(value string) string {
+	return fmt.Sprintf("'%s'", strings.ReplaceAll(value, "'", "''"))
+}
+
+func quoteMysqlLiteral(value string) string {
+	escaped := strings.ReplaceAll(value, `\`, `\\`)
+	return fmt.Sprintf("'%s'", strings.ReplaceAll(escaped, "'", "''"))
+}
@@ -0,0 +1,148 @@
+package genbenthosconfigs_activity
+
+import (
+	"context"
+	"testing"
+
+	"connectrpc.com/connect"
+	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
+	"github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1/mgmtv1alpha1connect"
+	"github.com/nucleuscloud/neosync/worker/pkg/workflows/datasync/activities/shared"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_buildWatermarkWhereClause(t *testing.T) {
+	tests := []struct {
+		name     string
+		driver   string
+		wm       *sqlWatermark
+		expected string
+	}{
+		{
+			name:     "no watermark",
+			driver:   postgresDriver,
+			wm:       nil,
+			expected: "",
+		},
+		{
+			name:     "postgres first run",
+			driver:   postgresDriver,
+			wm:       &sqlWatermark{Column: "updated_at", EndValue: shared.Ptr("2024-01-02")},
+			expected: `"public"."users"."updated_at" <= '2024-01-02'`,
+		},
+		{
+			name:     "postgres range",
+			driver:   postgresDriver,
+			wm:       &sqlWatermark{Column: "updated_at", StartValue: shared.Ptr("2024-01-01"), EndValue: shared.Ptr("2024-01-02")},
+			expected: `"public"."users"."updated_at" > '2024-01-01' AND "public"."users"."updated_at" <= '2024-01-02'`,
+		},
+		{
+			name:     "postgres quoting",
+			driver:   postgresDriver,
+			wm:       &sqlWatermark{Column: "name", StartValue: shared.Ptr("o'neil")},
+			expected: `"public"."users"."name" > 'o''neil'`,
+		},
+		{
+			name:     "mysql range",
+			driver:   mysqlDriver,
+			wm:       &sqlWatermark{Column: "id", StartValue: shared.Ptr("10"), EndValue: shared.Ptr("20")},
+			expected: "`public`.`users`.`id` > '10' AND `public`.`users`.`id` <= '20'",
+		},
+		{
+			name:     "mysql quoting",
+			driver:   mysqlDriver,
+			wm:       &sqlWatermark{Column: "name", EndValue: shared.Ptr(`a\'b`)},
+			expected: "`public`.`users`.`name` <= 'a\\\\''b'",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			actual, err := buildWatermarkWhereClause(tt.driver, "public", "users", tt.wm)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, actual)
+		})
+	}
+}
+
+func Test_buildWatermarkWhereClause_UnsupportedDriver(t *testing.T) {
+	_, err := buildWatermarkWhereClause("sqlserver", "dbo", "users", &sqlWatermark{Column: "id", EndValue: shared.Ptr("1")})
+	assert.Error(t, err)
+}
+
+func Test_addWatermarkWhereClause(t *testing.T) {
+	opts := &sqlSourceTableOptions{
+		WatermarkColumn: shared.Ptr("id"),
+		watermark:       &sqlWatermark{Column: "id", EndValue: shared.Ptr("20")},
+	}
+
+	actual, err := addWatermarkWhereClause(postgresDriver, "public", "users", nil, opts)
+	require.NoError(t, err)
+	assert.Equal(t, `"public"."users"."id" <= '20'`, *actual)
+
+	actual, err = addWatermarkWhereClause(postgresDriver, "public", "users", shared.Ptr("name = 'foo'"), opts)
+	require.NoError(t, err)
+	assert.Equal(t, `(name = 'foo') AND ("public"."users"."id" <= '20')`, *actual)
+
+	actual, err = addWatermarkWhereClause(postgresDriver, "public", "users", shared.Ptr("name = 'foo'"), &sqlSourceTableOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, "name = 'foo'", *actual)
+}
+
+func Test_setSqlWatermarks(t *testing.T) {
+	mockJobClient := mgmtv1alpha1connect.NewMockJobServiceClient(t)
+	mockJobClient.On("GetJobWatermarks", mock.Anything, mock.Anything).
+		Return(connect.NewResponse(&mgmtv1alpha1.GetJobWatermarksResponse{
+			Watermarks: []*mgmtv1alpha1.JobWatermark{
+				{Schema: "public", Table: "users", Column: "updated_at", Value: "2024-01-01"},
+				{Schema: "public", Table: "orders", Column: "created_at", Value: "2024-01-01"},
+			},
+		}), nil)
+
+	bbuilder := &benthosBuilder{jobclient: mockJobClient}
+	sourceTableOpts := map[string]*sqlSourceTableOptions{
+		"public.users":    {WatermarkColumn: shared.Ptr("updated_at")},
+		"public.orders":   {WatermarkColumn: shared.Ptr("updated_at")},
+		"public.accounts": {},
+	}
+	err := bbuilder.setSqlWatermarks(
+		context.Background(),
+		"job-id",
+		[]string{"public.users", "public.orders", "public.accounts"},
+		sourceTableOpts,
+		func(ctx context.Context, schema, table, column string) (*string, error) {
+			return shared.Ptr("2024-01-02"), nil
+		},
+	)
+	require.NoError(t, err)
+
+	assert.Equal(t, &sqlWatermark{Column: "updated_at", StartValue: shared.Ptr("2024-01-01"), EndValue: shared.Ptr("2024-01-02")}, sourceTableOpts["public.users"].watermark)
+	// the stored watermark was recorded against a different column and is ignored
+	assert.Equal(t, &sqlWatermark{Column: "updated_at", EndValue: shared.Ptr("2024-01-02")}, sourceTableOpts["public.orders"].watermark)
+	assert.Nil(t, sourceTableOpts["public.accounts"].watermark)
+
+	assert.Equal(t, []*shared.JobRunWatermark{
+		{Schema: "public", Table: "orders", Column: "updated_at", EndValue: "2024-01-02"},
+		{Schema: "public", Table: "users", Column: "updated_at", StartValue: shared.Ptr("2024-01-01"), EndValue: "2024-01-02"},
+	}, getJobRunWatermarks(sourceTableOpts))
+}
+
+func Test_getIncrementalUpsertKeys(t *testing.T) {
+	sourceTableOpts := map[string]*sqlSourceTableOptions{
+		"public.users":    {watermark: &sqlWatermark{Column: "updated_at"}},
+		"public.accounts": {},
+	}
+
+	keys, err := getIncrementalUpsertKeys("public.users", sourceTableOpts, map[string][]string{"public.users": {"id"}})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"id"}, keys)
+
+	keys, err = getIncrementalUpsertKeys("public.accounts", sourceTableOpts, map[string][]string{})
+	require.NoError(t, err)
+	assert.Nil(t, keys)
+
+	_, err = getIncrementalUpsertKeys("public.users", sourceTableOpts, map[string][]string{})
+	assert.Error(t, err)
+}
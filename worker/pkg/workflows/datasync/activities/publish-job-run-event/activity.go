@@ -0,0 +1,61 @@
+package publishjobrunevent_activity
+
+import (
+	"context"
+	"fmt"
+
+	"connectrpc.com/connect"
+	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
+	"github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1/mgmtv1alpha1connect"
+	"github.com/nucleuscloud/neosync/worker/pkg/workflows/datasync/activities/shared"
+	"go.temporal.io/sdk/activity"
+	"go.temporal.io/sdk/log"
+)
+
+type PublishJobRunEventRequest struct {
+	JobId string
+	// The job run id, which is the temporal workflow id
+	JobRunId  string
+	EventType mgmtv1alpha1.WebhookEventType
+	// The reason the run failed or was halted
+	Error *string
+}
+
+type PublishJobRunEventResponse struct{}
+
+// Notifies the webhooks of the job's account that the state of the job run has changed
+func PublishJobRunEvent(
+	ctx context.Context,
+	req *PublishJobRunEventRequest,
+) (*PublishJobRunEventResponse, error) {
+	logger := log.With(
+		activity.GetLogger(ctx),
+		"jobId", req.JobId,
+		"WorkflowID", req.JobRunId,
+	)
+
+	webhookclient := mgmtv1alpha1connect.NewWebhookServiceClient(
+		shared.GetNeosyncHttpClient(),
+		shared.GetNeosyncUrl(),
+	)
+	return publishJobRunEvent(ctx, logger, webhookclient, req)
+}
+
+func publishJobRunEvent(
+	ctx context.Context,
+	logger log.Logger,
+	webhookclient mgmtv1alpha1connect.WebhookServiceClient,
+	req *PublishJobRunEventRequest,
+) (*PublishJobRunEventResponse, error) {
+	logger.Debug(fmt.Sprintf("publishing %s job run event", req.EventType.String()))
+	_, err := webhookclient.PublishJobRunEvent(ctx, connect.NewRequest(&mgmtv1alpha1.PublishJobRunEventRequest{
+		JobId:     req.JobId,
+		JobRunId:  req.JobRunId,
+		EventType: req.EventType,
+		Error:     req.Error,
+	}))
+	if err != nil {
+		return nil, fmt.Errorf("unable to publish job run event: %w", err)
+	}
+	return &PublishJobRunEventResponse{}, nil
+}
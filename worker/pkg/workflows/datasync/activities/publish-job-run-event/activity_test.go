@@ -0,0 +1,52 @@
+package publishjobrunevent_activity
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"testing"
+
+	"connectrpc.com/connect"
+	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
+	"github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1/mgmtv1alpha1connect"
+	"github.com/nucleuscloud/neosync/worker/pkg/workflows/datasync/activities/shared"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go.temporal.io/sdk/log"
+)
+
+func Test_publishJobRunEvent(t *testing.T) {
+	webhookclient := mgmtv1alpha1connect.NewMockWebhookServiceClient(t)
+	logger := log.NewStructuredLogger(slog.Default())
+
+	webhookclient.On("PublishJobRunEvent", mock.Anything, connect.NewRequest(&mgmtv1alpha1.PublishJobRunEventRequest{
+		JobId:     "job-id",
+		JobRunId:  "run-id",
+		EventType: mgmtv1alpha1.WebhookEventType_WEBHOOK_EVENT_TYPE_JOB_RUN_FAILED,
+		Error:     shared.Ptr("sync failed"),
+	})).Return(connect.NewResponse(&mgmtv1alpha1.PublishJobRunEventResponse{}), nil)
+
+	resp, err := publishJobRunEvent(context.Background(), logger, webhookclient, &PublishJobRunEventRequest{
+		JobId:     "job-id",
+		JobRunId:  "run-id",
+		EventType: mgmtv1alpha1.WebhookEventType_WEBHOOK_EVENT_TYPE_JOB_RUN_FAILED,
+		Error:     shared.Ptr("sync failed"),
+	})
+	assert.NoError(t, err)
+	assert.NotNil(t, resp)
+}
+
+func Test_publishJobRunEvent_Error(t *testing.T) {
+	webhookclient := mgmtv1alpha1connect.NewMockWebhookServiceClient(t)
+	logger := log.NewStructuredLogger(slog.Default())
+
+	webhookclient.On("PublishJobRunEvent", mock.Anything, mock.Anything).Return(nil, errors.New("unavailable"))
+
+	resp, err := publishJobRunEvent(context.Background(), logger, webhookclient, &PublishJobRunEventRequest{
+		JobId:     "job-id",
+		JobRunId:  "run-id",
+		EventType: mgmtv1alpha1.WebhookEventType_WEBHOOK_EVENT_TYPE_JOB_RUN_STARTED,
+	})
+	assert.Error(t, err)
+	assert.Nil(t, resp)
+}
@@ -7,6 +7,7 @@ import (
 	mysql_queries "github.com/nucleuscloud/neosync/backend/gen/go/db/dbschemas/mysql"
 	pg_queries "github.com/nucleuscloud/neosync/backend/gen/go/db/dbschemas/postgresql"
 	"github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1/mgmtv1alpha1connect"
+	dbschemas_mssql "github.com/nucleuscloud/neosync/backend/pkg/dbschemas/mssql"
 	"github.com/nucleuscloud/neosync/backend/pkg/sqlconnect"
 	logger_utils "github.com/nucleuscloud/neosync/worker/internal/logger"
 	"github.com/nucleuscloud/neosync/worker/pkg/workflows/datasync/activities/shared"
@@ -52,6 +53,8 @@ func RunSqlInitTableStatements(
 	pgquerier := pg_queries.New()
 	mysqlpoolmap := map[string]mysql_queries.DBTX{}
 	mysqlquerier := mysql_queries.New()
+	mssqlpoolmap := map[string]dbschemas_mssql.DBTX{}
+	mssqlquerier := dbschemas_mssql.New()
 
 	jobclient := mgmtv1alpha1connect.NewJobServiceClient(
 		httpClient,
@@ -67,6 +70,8 @@ func RunSqlInitTableStatements(
 		pgquerier,
 		mysqlpoolmap,
 		mysqlquerier,
+		mssqlpoolmap,
+		mssqlquerier,
 		jobclient,
 		connclient,
 		&sqlconnect.SqlOpenConnector{},
@@ -13,8 +13,10 @@ import (
 	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
 	"github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1/mgmtv1alpha1connect"
 	dbschemas_utils "github.com/nucleuscloud/neosync/backend/pkg/dbschemas"
+	dbschemas_mssql "github.com/nucleuscloud/neosync/backend/pkg/dbschemas/mssql"
 	dbschemas_mysql "github.com/nucleuscloud/neosync/backend/pkg/dbschemas/mysql"
 	dbschemas_postgres "github.com/nucleuscloud/neosync/backend/pkg/dbschemas/postgres"
+	dbschemas_sqlite "github.com/nucleuscloud/neosync/backend/pkg/dbschemas/sqlite"
 	"github.com/nucleuscloud/neosync/backend/pkg/sqlconnect"
 	tabledependency "github.com/nucleuscloud/neosync/backend/pkg/table-dependency"
 	"github.com/nucleuscloud/neosync/worker/pkg/workflows/datasync/activities/shared"
@@ -31,6 +33,9 @@ type initStatementBuilder struct {
 	mysqlpool    map[string]mysql_queries.DBTX
 	mysqlquerier mysql_queries.Querier
 
+	mssqlpool    map[string]dbschemas_mssql.DBTX
+	mssqlquerier dbschemas_mssql.Querier
+
 	jobclient  mgmtv1alpha1connect.JobServiceClient
 	connclient mgmtv1alpha1connect.ConnectionServiceClient
 
@@ -44,6 +49,9 @@ func newInitStatementBuilder(
 	mysqlpool map[string]mysql_queries.DBTX,
 	mysqlquerier mysql_queries.Querier,
 
+	mssqlpool map[string]dbschemas_mssql.DBTX,
+	mssqlquerier dbschemas_mssql.Querier,
+
 	jobclient mgmtv1alpha1connect.JobServiceClient,
 	connclient mgmtv1alpha1connect.ConnectionServiceClient,
 
@@ -55,6 +63,8 @@ func newInitStatementBuilder(
 		pgquerier:    pgquerier,
 		mysqlpool:    mysqlpool,
 		mysqlquerier: mysqlquerier,
+		mssqlpool:    mssqlpool,
+		mssqlquerier: mssqlquerier,
 		jobclient:    jobclient,
 		connclient:   connclient,
 		sqlconnector: sqlconnector,
@@ -70,6 +80,9 @@ func (b *initStatementBuilder) RunSqlInitTableStatements(
 	if err != nil {
 		return nil, fmt.Errorf("unable to get job by id: %w", err)
 	}
+	if job.Source != nil {
+		job.Source.Options = shared.GetSnapshotSourceOptions(job.Source.Options)
+	}
 
 	var sourceConnectionId string
 	var tableDependencies map[string]*dbschemas_utils.TableConstraints
@@ -112,6 +125,21 @@ func (b *initStatementBuilder) RunSqlInitTableStatements(
 				b.mysqlpool[sourceConnection.Id] = db
 			}
 			sourceConnectionId = sourceConnection.Id
+
+		case *mgmtv1alpha1.ConnectionConfig_MssqlConfig:
+			if _, ok := b.mssqlpool[sourceConnection.Id]; !ok {
+				conn, err := b.sqlconnector.NewDbFromConnectionConfig(sourceConnection.ConnectionConfig, shared.Ptr(uint32(5)), slogger)
+				if err != nil {
+					return nil, fmt.Errorf("unable to create new mssql pool from connection config: %w", err)
+				}
+				db, err := conn.Open()
+				if err != nil {
+					return nil, fmt.Errorf("unable to open mssql connection: %w", err)
+				}
+				defer conn.Close()
+				b.mssqlpool[sourceConnection.Id] = db
+			}
+			sourceConnectionId = sourceConnection.Id
 		default:
 			return nil, errors.New("unsupported job source connection")
 		}
@@ -170,6 +198,33 @@ func (b *initStatementBuilder) RunSqlInitTableStatements(
 		}
 		tableDependencies = dbschemas_mysql.GetMysqlTableDependencies(allConstraints)
 
+	case *mgmtv1alpha1.JobSourceOptions_Mssql:
+		sourceConnection, err := b.getConnectionById(ctx, jobSourceConfig.Mssql.ConnectionId)
+		if err != nil {
+			return nil, fmt.Errorf("unable to get connection by id (%s): %w", jobSourceConfig.Mssql.ConnectionId, err)
+		}
+
+		if _, ok := b.mssqlpool[sourceConnection.Id]; !ok {
+			conn, err := b.sqlconnector.NewDbFromConnectionConfig(sourceConnection.ConnectionConfig, shared.Ptr(uint32(5)), slogger)
+			if err != nil {
+				return nil, fmt.Errorf("unable to create new mssql pool from connection config: %w", err)
+			}
+			pool, err := conn.Open()
+			if err != nil {
+				return nil, fmt.Errorf("unable to open mssql connection: %w", err)
+			}
+			defer conn.Close()
+			b.mssqlpool[sourceConnection.Id] = pool
+		}
+		pool := b.mssqlpool[sourceConnection.Id]
+		sourceConnectionId = sourceConnection.Id
+
+		allConstraints, err := dbschemas_mssql.GetAllMssqlFkConstraints(b.mssqlquerier, ctx, pool, uniqueSchemas)
+		if err != nil {
+			return nil, fmt.Errorf("unable to retrieve mssql foreign key constraints: %w", err)
+		}
+		tableDependencies = dbschemas_mssql.GetMssqlTableDependencies(allConstraints)
+
 	default:
 		return nil, fmt.Errorf("unsupported job source: %T", jobSourceConfig)
 	}
@@ -371,6 +426,195 @@ func (b *initStatementBuilder) RunSqlInitTableStatements(
 				return nil, errors.New("unable to build destination connection due to unsupported source connection")
 			}
 
+		case *mgmtv1alpha1.ConnectionConfig_MssqlConfig:
+			truncateBeforeInsert := false
+			initSchema := false
+			sqlOpts := destination.Options.GetMssqlOptions()
+			if sqlOpts != nil {
+				initSchema = sqlOpts.InitTableSchema
+				if sqlOpts.TruncateTable != nil {
+					truncateBeforeInsert = sqlOpts.TruncateTable.TruncateBeforeInsert
+				}
+			}
+			if job.Source.Options.GetGenerate() != nil {
+				initSchema = false
+			}
+
+			if !truncateBeforeInsert && !initSchema {
+				slogger.Info("skipping truncate and schema init as none were set to true")
+				continue
+			}
+
+			if job.Source.Options.GetMssql() != nil || job.Source.Options.GetGenerate() != nil {
+				sourcePool := b.mssqlpool[sourceConnectionId]
+				destconn, err := b.sqlconnector.NewDbFromConnectionConfig(destinationConnection.ConnectionConfig, shared.Ptr(uint32(5)), slogger)
+				if err != nil {
+					return nil, fmt.Errorf("unable to create new mssql pool from connection config: %w", err)
+				}
+				pool, err := destconn.Open()
+				if err != nil {
+					return nil, fmt.Errorf("unable to open mssql connection: %w", err)
+				}
+
+				// ordered so that tables are created after the tables they depend on
+				tableForeignDependencyMap := getFilteredForeignToPrimaryTableMap(tableDependencies, uniqueTables)
+				orderedTables, err := tabledependency.GetTablesOrderedByDependency(tableForeignDependencyMap)
+				if err != nil {
+					if err := destconn.Close(); err != nil {
+						slogger.Error(err.Error())
+					}
+					return nil, err
+				}
+
+				// create statements
+				if initSchema {
+					tableCreateStmts := []string{}
+					for _, table := range orderedTables {
+						split := strings.Split(table, ".")
+						initStmt, err := dbschemas_mssql.GetTableCreateStatement(ctx, sourcePool, b.mssqlquerier, split[0], split[1])
+						if err != nil {
+							if err := destconn.Close(); err != nil {
+								slogger.Error(err.Error())
+							}
+							return nil, fmt.Errorf("unable to build init statement for mssql: %w", err)
+						}
+						tableCreateStmts = append(tableCreateStmts, initStmt)
+					}
+
+					slogger.Info(fmt.Sprintf("executing %d sql statements that will initialize tables", len(tableCreateStmts)))
+					err = dbschemas_mssql.BatchExecStmts(ctx, pool, batchSizeConst, tableCreateStmts)
+					if err != nil {
+						if err := destconn.Close(); err != nil {
+							slogger.Error(err.Error())
+						}
+						return nil, err
+					}
+				}
+
+				// truncate statements
+				// sql server does not allow truncating referenced tables, so rows are deleted in reverse dependency order
+				if truncateBeforeInsert {
+					tableTruncate := []string{}
+					for i := len(orderedTables) - 1; i >= 0; i-- {
+						split := strings.Split(orderedTables[i], ".")
+						tableTruncate = append(tableTruncate, dbschemas_mssql.BuildTruncateStatement(split[0], split[1]))
+					}
+					slogger.Info(fmt.Sprintf("executing %d sql statements that will truncate tables", len(tableTruncate)))
+					err := dbschemas_mssql.BatchExecStmts(ctx, pool, batchSizeConst, tableTruncate)
+					if err != nil {
+						if err := destconn.Close(); err != nil {
+							slogger.Error(err.Error())
+						}
+						return nil, err
+					}
+				}
+				if err := destconn.Close(); err != nil {
+					slogger.Error(err.Error())
+				}
+			} else {
+				return nil, errors.New("unable to build destination connection due to unsupported source connection")
+			}
+
+		case *mgmtv1alpha1.ConnectionConfig_SqliteConfig:
+			truncateBeforeInsert := false
+			initSchema := false
+			sqlOpts := destination.Options.GetSqliteOptions()
+			if sqlOpts != nil {
+				initSchema = sqlOpts.InitTableSchema
+				if sqlOpts.TruncateTable != nil {
+					truncateBeforeInsert = sqlOpts.TruncateTable.TruncateBeforeInsert
+				}
+			}
+			if job.Source.Options.GetGenerate() != nil {
+				initSchema = false
+			}
+
+			if !truncateBeforeInsert && !initSchema {
+				slogger.Info("skipping truncate and schema init as none were set to true")
+				continue
+			}
+
+			if job.Source.Options.GetPostgres() != nil || job.Source.Options.GetMysql() != nil || job.Source.Options.GetGenerate() != nil {
+				destconn, err := b.sqlconnector.NewDbFromConnectionConfig(destinationConnection.ConnectionConfig, shared.Ptr(uint32(5)), slogger)
+				if err != nil {
+					return nil, fmt.Errorf("unable to create new sqlite pool from connection config: %w", err)
+				}
+				pool, err := destconn.Open()
+				if err != nil {
+					return nil, fmt.Errorf("unable to open sqlite connection: %w", err)
+				}
+
+				// ordered so that tables are created after the tables they depend on
+				tableForeignDependencyMap := getFilteredForeignToPrimaryTableMap(tableDependencies, uniqueTables)
+				orderedTables, err := tabledependency.GetTablesOrderedByDependency(tableForeignDependencyMap)
+				if err != nil {
+					if err := destconn.Close(); err != nil {
+						slogger.Error(err.Error())
+					}
+					return nil, err
+				}
+
+				// create statements
+				// sqlite does not understand the source dialect, so tables are rebuilt from the source column metadata
+				if initSchema {
+					var sqliteTables map[string]*dbschemas_sqlite.Table
+					if job.Source.Options.GetPostgres() != nil {
+						sqliteTables, err = b.getSqliteTablesFromPostgres(ctx, b.pgpool[sourceConnectionId], uniqueSchemas, uniqueTables)
+					} else {
+						sqliteTables, err = b.getSqliteTablesFromMysql(ctx, b.mysqlpool[sourceConnectionId], uniqueSchemas, uniqueTables)
+					}
+					if err != nil {
+						if err := destconn.Close(); err != nil {
+							slogger.Error(err.Error())
+						}
+						return nil, fmt.Errorf("unable to build init statements for sqlite: %w", err)
+					}
+					tableCreateStmts := []string{}
+					for _, table := range orderedTables {
+						sqliteTable, ok := sqliteTables[table]
+						if !ok {
+							if err := destconn.Close(); err != nil {
+								slogger.Error(err.Error())
+							}
+							return nil, fmt.Errorf("unable to find table %s in source connection", table)
+						}
+						tableCreateStmts = append(tableCreateStmts, dbschemas_sqlite.BuildCreateTableStatement(sqliteTable))
+					}
+
+					slogger.Info(fmt.Sprintf("executing %d sql statements that will initialize tables", len(tableCreateStmts)))
+					err = dbschemas_sqlite.BatchExecStmts(ctx, pool, batchSizeConst, tableCreateStmts)
+					if err != nil {
+						if err := destconn.Close(); err != nil {
+							slogger.Error(err.Error())
+						}
+						return nil, err
+					}
+				}
+
+				// truncate statements
+				// rows are deleted in reverse dependency order in case foreign key enforcement is enabled on the database
+				if truncateBeforeInsert {
+					tableTruncate := []string{}
+					for i := len(orderedTables) - 1; i >= 0; i-- {
+						split := strings.Split(orderedTables[i], ".")
+						tableTruncate = append(tableTruncate, dbschemas_sqlite.BuildTruncateStatement(split[1]))
+					}
+					slogger.Info(fmt.Sprintf("executing %d sql statements that will truncate tables", len(tableTruncate)))
+					err := dbschemas_sqlite.BatchExecStmts(ctx, pool, batchSizeConst, tableTruncate)
+					if err != nil {
+						if err := destconn.Close(); err != nil {
+							slogger.Error(err.Error())
+						}
+						return nil, err
+					}
+				}
+				if err := destconn.Close(); err != nil {
+					slogger.Error(err.Error())
+				}
+			} else {
+				return nil, errors.New("unable to build destination connection due to unsupported source connection")
+			}
+
 		case *mgmtv1alpha1.ConnectionConfig_AwsS3Config:
 			// nothing to do here
 		default:
@@ -436,6 +680,154 @@ func (b *initStatementBuilder) getCreateStatementFromMysql(
 	return stmt, nil
 }
 
+func (b *initStatementBuilder) getSqliteTablesFromPostgres(
+	ctx context.Context,
+	conn pg_queries.DBTX,
+	uniqueSchemas []string,
+	uniqueTables map[string]struct{},
+) (map[string]*dbschemas_sqlite.Table, error) {
+	columns, err := b.pgquerier.GetDatabaseSchema(ctx, conn)
+	if err != nil {
+		return nil, fmt.Errorf("unable to retrieve postgres database schema: %w", err)
+	}
+	primaryKeys, err := dbschemas_postgres.GetAllPostgresPkConstraints(b.pgquerier, ctx, conn, uniqueSchemas)
+	if err != nil {
+		return nil, fmt.Errorf("unable to retrieve postgres primary key constraints: %w", err)
+	}
+	foreignKeys, err := dbschemas_postgres.GetAllPostgresFkConstraints(b.pgquerier, ctx, conn, uniqueSchemas)
+	if err != nil {
+		return nil, fmt.Errorf("unable to retrieve postgres foreign key constraints: %w", err)
+	}
+
+	builder := newSqliteTableBuilder(uniqueTables)
+	for _, c := range columns {
+		builder.addColumn(c.TableSchema, c.TableName, c.ColumnName, c.DataType, c.IsNullable)
+	}
+	for _, pk := range primaryKeys {
+		builder.addPrimaryKey(pk.SchemaName, pk.TableName, pk.ColumnName)
+	}
+	for _, fk := range foreignKeys {
+		builder.addForeignKey(fk.SchemaName, fk.TableName, fk.ConstraintName, fk.ColumnName, fk.ForeignSchemaName, fk.ForeignTableName, fk.ForeignColumnName)
+	}
+	return builder.build()
+}
+
+func (b *initStatementBuilder) getSqliteTablesFromMysql(
+	ctx context.Context,
+	conn mysql_queries.DBTX,
+	uniqueSchemas []string,
+	uniqueTables map[string]struct{},
+) (map[string]*dbschemas_sqlite.Table, error) {
+	columns, err := b.mysqlquerier.GetDatabaseSchema(ctx, conn)
+	if err != nil {
+		return nil, fmt.Errorf("unable to retrieve mysql database schema: %w", err)
+	}
+	primaryKeys, err := dbschemas_mysql.GetAllMysqlPkConstraints(b.mysqlquerier, ctx, conn, uniqueSchemas)
+	if err != nil {
+		return nil, fmt.Errorf("unable to retrieve mysql primary key constraints: %w", err)
+	}
+	foreignKeys, err := dbschemas_mysql.GetAllMysqlFkConstraints(b.mysqlquerier, ctx, conn, uniqueSchemas)
+	if err != nil {
+		return nil, fmt.Errorf("unable to retrieve mysql foreign key constraints: %w", err)
+	}
+
+	builder := newSqliteTableBuilder(uniqueTables)
+	for _, c := range columns {
+		builder.addColumn(c.TableSchema, c.TableName, c.ColumnName, c.DataType, c.IsNullable)
+	}
+	for _, pk := range primaryKeys {
+		builder.addPrimaryKey(pk.SchemaName, pk.TableName, pk.ColumnName)
+	}
+	for _, fk := range foreignKeys {
+		builder.addForeignKey(fk.SchemaName, fk.TableName, fk.ConstraintName, fk.ColumnName, fk.ForeignSchemaName, fk.ForeignTableName, fk.ForeignColumnName)
+	}
+	return builder.build()
+}
+
+// Collects source table metadata into sqlite tables keyed by the source schema.table
+// Only tables found in the job mappings are kept, along with foreign keys that point to one of those tables
+type sqliteTableBuilder struct {
+	uniqueTables map[string]struct{}
+	tables       map[string]*dbschemas_sqlite.Table
+	// schema.table -> constraint name -> foreign key
+	foreignKeys map[string]map[string]*dbschemas_sqlite.ForeignKey
+}
+
+func newSqliteTableBuilder(uniqueTables map[string]struct{}) *sqliteTableBuilder {
+	return &sqliteTableBuilder{
+		uniqueTables: uniqueTables,
+		tables:       map[string]*dbschemas_sqlite.Table{},
+		foreignKeys:  map[string]map[string]*dbschemas_sqlite.ForeignKey{},
+	}
+}
+
+func (s *sqliteTableBuilder) getTable(schema, table string) (*dbschemas_sqlite.Table, bool) {
+	key := dbschemas_utils.BuildTable(schema, table)
+	if _, ok := s.uniqueTables[key]; !ok {
+		return nil, false
+	}
+	t, ok := s.tables[key]
+	if !ok {
+		t = &dbschemas_sqlite.Table{Name: table}
+		s.tables[key] = t
+	}
+	return t, true
+}
+
+func (s *sqliteTableBuilder) addColumn(schema, table, column, dataType, isNullable string) {
+	t, ok := s.getTable(schema, table)
+	if !ok {
+		return
+	}
+	t.Columns = append(t.Columns, &dbschemas_sqlite.Column{
+		Name:       column,
+		DataType:   dataType,
+		IsNullable: dbschemas_utils.ConvertIsNullableToBool(isNullable),
+	})
+}
+
+func (s *sqliteTableBuilder) addPrimaryKey(schema, table, column string) {
+	t, ok := s.getTable(schema, table)
+	if !ok {
+		return
+	}
+	t.PrimaryKeys = append(t.PrimaryKeys, column)
+}
+
+func (s *sqliteTableBuilder) addForeignKey(schema, table, constraintName, column, foreignSchema, foreignTable, foreignColumn string) {
+	if _, ok := s.uniqueTables[dbschemas_utils.BuildTable(foreignSchema, foreignTable)]; !ok {
+		return
+	}
+	t, ok := s.getTable(schema, table)
+	if !ok {
+		return
+	}
+	key := dbschemas_utils.BuildTable(schema, table)
+	if _, ok := s.foreignKeys[key]; !ok {
+		s.foreignKeys[key] = map[string]*dbschemas_sqlite.ForeignKey{}
+	}
+	fk, ok := s.foreignKeys[key][constraintName]
+	if !ok {
+		fk = &dbschemas_sqlite.ForeignKey{ForeignTable: foreignTable}
+		s.foreignKeys[key][constraintName] = fk
+		t.ForeignKeys = append(t.ForeignKeys, fk)
+	}
+	fk.Columns = append(fk.Columns, column)
+	fk.ForeignColumns = append(fk.ForeignColumns, foreignColumn)
+}
+
+// sqlite tables are created by table name only, so the same table name in two schemas cannot be synced to one database
+func (s *sqliteTableBuilder) build() (map[string]*dbschemas_sqlite.Table, error) {
+	seen := map[string]string{}
+	for key, table := range s.tables {
+		if other, ok := seen[table.Name]; ok {
+			return nil, fmt.Errorf("tables %s and %s would both be created as %s in sqlite", other, key, table.Name)
+		}
+		seen[table.Name] = key
+	}
+	return s.tables, nil
+}
+
 // filtered by tables found in job mappings
 func getFilteredForeignToPrimaryTableMap(td map[string]*dbschemas_utils.TableConstraints, uniqueTables map[string]struct{}) map[string][]string {
 	dpMap := map[string][]string{}
@@ -3,6 +3,7 @@ package runsqlinittablestmts_activity
 import (
 	"context"
 	"log/slog"
+	"regexp"
 	"slices"
 	"testing"
 
@@ -14,6 +15,7 @@ import (
 	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
 	"github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1/mgmtv1alpha1connect"
 	dbschemas_utils "github.com/nucleuscloud/neosync/backend/pkg/dbschemas"
+	dbschemas_mssql "github.com/nucleuscloud/neosync/backend/pkg/dbschemas/mssql"
 	"github.com/nucleuscloud/neosync/backend/pkg/sqlconnect"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
@@ -134,7 +136,7 @@ func Test_InitStatementBuilder_Pg_Generate(t *testing.T) {
 	pgPoolContainerMock.On("Close")
 	mockSqlConnector.On("NewPgPoolFromConnectionConfig", mock.Anything, mock.Anything, mock.Anything).Return(pgPoolContainerMock, nil)
 
-	bbuilder := newInitStatementBuilder(pgcache, pgquerier, mysqlcache, mysqlquerier, mockJobClient, mockConnectionClient, mockSqlConnector)
+	bbuilder := newInitStatementBuilder(pgcache, pgquerier, mysqlcache, mysqlquerier, map[string]dbschemas_mssql.DBTX{}, dbschemas_mssql.NewMockQuerier(t), mockJobClient, mockConnectionClient, mockSqlConnector)
 	_, err := bbuilder.RunSqlInitTableStatements(
 		context.Background(),
 		&RunSqlInitTableStatementsRequest{JobId: "123", WorkflowId: "123"},
@@ -240,7 +242,7 @@ func Test_InitStatementBuilder_Pg_Generate_NoInitStatement(t *testing.T) {
 		},
 	}), nil)
 
-	bbuilder := newInitStatementBuilder(pgcache, pgquerier, mysqlcache, mysqlquerier, mockJobClient, mockConnectionClient, mockSqlConnector)
+	bbuilder := newInitStatementBuilder(pgcache, pgquerier, mysqlcache, mysqlquerier, map[string]dbschemas_mssql.DBTX{}, dbschemas_mssql.NewMockQuerier(t), mockJobClient, mockConnectionClient, mockSqlConnector)
 	_, err := bbuilder.RunSqlInitTableStatements(
 		context.Background(),
 		&RunSqlInitTableStatementsRequest{JobId: "123", WorkflowId: "123"},
@@ -389,7 +391,7 @@ func Test_InitStatementBuilder_Pg_TruncateCascade(t *testing.T) {
 	pgPoolContainerMock.On("Close")
 	mockSqlConnector.On("NewPgPoolFromConnectionConfig", mock.Anything, mock.Anything, mock.Anything).Return(pgPoolContainerMock, nil)
 
-	bbuilder := newInitStatementBuilder(pgcache, pgquerier, mysqlcache, mysqlquerier, mockJobClient, mockConnectionClient, mockSqlConnector)
+	bbuilder := newInitStatementBuilder(pgcache, pgquerier, mysqlcache, mysqlquerier, map[string]dbschemas_mssql.DBTX{}, dbschemas_mssql.NewMockQuerier(t), mockJobClient, mockConnectionClient, mockSqlConnector)
 	_, err := bbuilder.RunSqlInitTableStatements(
 		context.Background(),
 		&RunSqlInitTableStatementsRequest{JobId: "123", WorkflowId: "123"},
@@ -543,7 +545,7 @@ func Test_InitStatementBuilder_Pg_Truncate(t *testing.T) {
 	pgPoolContainerMock.On("Close")
 	mockSqlConnector.On("NewPgPoolFromConnectionConfig", mock.Anything, mock.Anything, mock.Anything).Return(pgPoolContainerMock, nil)
 
-	bbuilder := newInitStatementBuilder(pgcache, pgquerier, mysqlcache, mysqlquerier, mockJobClient, mockConnectionClient, mockSqlConnector)
+	bbuilder := newInitStatementBuilder(pgcache, pgquerier, mysqlcache, mysqlquerier, map[string]dbschemas_mssql.DBTX{}, dbschemas_mssql.NewMockQuerier(t), mockJobClient, mockConnectionClient, mockSqlConnector)
 	_, err := bbuilder.RunSqlInitTableStatements(
 		context.Background(),
 		&RunSqlInitTableStatementsRequest{JobId: "123", WorkflowId: "123"},
@@ -760,7 +762,7 @@ func Test_InitStatementBuilder_Pg_InitSchema(t *testing.T) {
 	pgPoolContainerMock.On("Close")
 	mockSqlConnector.On("NewPgPoolFromConnectionConfig", mock.Anything, mock.Anything, mock.Anything).Return(pgPoolContainerMock, nil)
 
-	bbuilder := newInitStatementBuilder(pgcache, pgquerier, mysqlcache, mysqlquerier, mockJobClient, mockConnectionClient, mockSqlConnector)
+	bbuilder := newInitStatementBuilder(pgcache, pgquerier, mysqlcache, mysqlquerier, map[string]dbschemas_mssql.DBTX{}, dbschemas_mssql.NewMockQuerier(t), mockJobClient, mockConnectionClient, mockSqlConnector)
 	_, err := bbuilder.RunSqlInitTableStatements(
 		context.Background(),
 		&RunSqlInitTableStatementsRequest{JobId: "123", WorkflowId: "123"},
@@ -884,7 +886,7 @@ func Test_InitStatementBuilder_Mysql_Generate(t *testing.T) {
 	sqlDbContainerMock.On("Close").Return(nil)
 	mockSqlConnector.On("NewDbFromConnectionConfig", mock.Anything, mock.Anything, mock.Anything).Return(sqlDbContainerMock, nil)
 
-	bbuilder := newInitStatementBuilder(pgcache, pgquerier, mysqlcache, mysqlquerier, mockJobClient, mockConnectionClient, mockSqlConnector)
+	bbuilder := newInitStatementBuilder(pgcache, pgquerier, mysqlcache, mysqlquerier, map[string]dbschemas_mssql.DBTX{}, dbschemas_mssql.NewMockQuerier(t), mockJobClient, mockConnectionClient, mockSqlConnector)
 	_, err = bbuilder.RunSqlInitTableStatements(
 		context.Background(),
 		&RunSqlInitTableStatementsRequest{JobId: "123", WorkflowId: "123"},
@@ -1044,7 +1046,7 @@ func Test_InitStatementBuilder_Mysql_Truncate(t *testing.T) {
 	sqlDbContainerMock.On("Close").Return(nil)
 	mockSqlConnector.On("NewDbFromConnectionConfig", mock.Anything, mock.Anything, mock.Anything).Return(sqlDbContainerMock, nil)
 
-	bbuilder := newInitStatementBuilder(pgcache, pgquerier, mysqlcache, mysqlquerier, mockJobClient, mockConnectionClient, mockSqlConnector)
+	bbuilder := newInitStatementBuilder(pgcache, pgquerier, mysqlcache, mysqlquerier, map[string]dbschemas_mssql.DBTX{}, dbschemas_mssql.NewMockQuerier(t), mockJobClient, mockConnectionClient, mockSqlConnector)
 	_, err = bbuilder.RunSqlInitTableStatements(
 		context.Background(),
 		&RunSqlInitTableStatementsRequest{JobId: "123", WorkflowId: "123"},
@@ -1210,7 +1212,264 @@ func Test_InitStatementBuilder_Mysql_InitSchema(t *testing.T) {
 	sqlDbContainerMock.On("Close").Return(nil)
 	mockSqlConnector.On("NewDbFromConnectionConfig", mock.Anything, mock.Anything, mock.Anything).Return(sqlDbContainerMock, nil)
 
-	bbuilder := newInitStatementBuilder(pgcache, pgquerier, mysqlcache, mysqlquerier, mockJobClient, mockConnectionClient, mockSqlConnector)
+	bbuilder := newInitStatementBuilder(pgcache, pgquerier, mysqlcache, mysqlquerier, map[string]dbschemas_mssql.DBTX{}, dbschemas_mssql.NewMockQuerier(t), mockJobClient, mockConnectionClient, mockSqlConnector)
+	_, err = bbuilder.RunSqlInitTableStatements(
+		context.Background(),
+		&RunSqlInitTableStatementsRequest{JobId: "123", WorkflowId: "123"},
+		slog.Default(),
+	)
+	assert.Nil(t, err)
+	if err := sqlMock.ExpectationsWereMet(); err != nil {
+		t.Errorf("there were unfulfilled expectations: %s", err)
+	}
+}
+
+func Test_InitStatementBuilder_Mssql_Truncate(t *testing.T) {
+	mockJobClient := mgmtv1alpha1connect.NewMockJobServiceClient(t)
+	mockConnectionClient := mgmtv1alpha1connect.NewMockConnectionServiceClient(t)
+	mockSqlConnector := sqlconnect.NewMockSqlConnector(t)
+	sqlDbContainerMock := sqlconnect.NewMockSqlDbContainer(t)
+
+	sqlDbMock, sqlMock, err := sqlmock.New(sqlmock.MonitorPingsOption(false))
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	pgcache := map[string]pg_queries.DBTX{}
+	pgquerier := pg_queries.NewMockQuerier(t)
+	mysqlcache := map[string]mysql_queries.DBTX{}
+	mysqlquerier := mysql_queries.NewMockQuerier(t)
+	mssqlcache := map[string]dbschemas_mssql.DBTX{}
+	mssqlquerier := dbschemas_mssql.NewMockQuerier(t)
+	connectionId := "456"
+
+	mockJobClient.On("GetJob", mock.Anything, mock.Anything).
+		Return(connect.NewResponse(&mgmtv1alpha1.GetJobResponse{
+			Job: &mgmtv1alpha1.Job{
+				Source: &mgmtv1alpha1.JobSource{
+					Options: &mgmtv1alpha1.JobSourceOptions{
+						Config: &mgmtv1alpha1.JobSourceOptions_Mssql{
+							Mssql: &mgmtv1alpha1.MssqlSourceConnectionOptions{
+								ConnectionId: connectionId,
+							},
+						},
+					},
+				},
+				Mappings: []*mgmtv1alpha1.JobMapping{
+					{
+						Schema:      "dbo",
+						Table:       "users",
+						Column:      "id",
+						Transformer: &mgmtv1alpha1.JobMappingTransformer{Source: mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_PASSTHROUGH},
+					},
+					{
+						Schema:      "dbo",
+						Table:       "accounts",
+						Column:      "id",
+						Transformer: &mgmtv1alpha1.JobMappingTransformer{Source: mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_PASSTHROUGH},
+					},
+				},
+				Destinations: []*mgmtv1alpha1.JobDestination{
+					{
+						ConnectionId: "789",
+						Options: &mgmtv1alpha1.JobDestinationOptions{
+							Config: &mgmtv1alpha1.JobDestinationOptions_MssqlOptions{
+								MssqlOptions: &mgmtv1alpha1.MssqlDestinationConnectionOptions{
+									TruncateTable: &mgmtv1alpha1.MssqlTruncateTableConfig{
+										TruncateBeforeInsert: true,
+									},
+									InitTableSchema: false,
+								},
+							},
+						},
+					},
+				},
+			},
+		}), nil)
+
+	mssqlConnection := func(id string) *connect.Response[mgmtv1alpha1.GetConnectionResponse] {
+		return connect.NewResponse(&mgmtv1alpha1.GetConnectionResponse{
+			Connection: &mgmtv1alpha1.Connection{
+				Id:   id,
+				Name: id,
+				ConnectionConfig: &mgmtv1alpha1.ConnectionConfig{
+					Config: &mgmtv1alpha1.ConnectionConfig_MssqlConfig{
+						MssqlConfig: &mgmtv1alpha1.MssqlConnectionConfig{
+							ConnectionConfig: &mgmtv1alpha1.MssqlConnectionConfig_Url{
+								Url: "fake-url",
+							},
+						},
+					},
+				},
+			},
+		})
+	}
+	mockConnectionClient.On("GetConnection", mock.Anything, connect.NewRequest(&mgmtv1alpha1.GetConnectionRequest{Id: connectionId})).
+		Return(mssqlConnection(connectionId), nil)
+	mockConnectionClient.On("GetConnection", mock.Anything, connect.NewRequest(&mgmtv1alpha1.GetConnectionRequest{Id: "789"})).
+		Return(mssqlConnection("789"), nil)
+	mssqlquerier.On("GetForeignKeyConstraints", mock.Anything, mock.Anything, mock.Anything).
+		Return([]*dbschemas_mssql.GetForeignKeyConstraintsRow{
+			{
+				ConstraintName:    "fk_user_account_id",
+				SchemaName:        "dbo",
+				TableName:         "users",
+				ColumnName:        "account_id",
+				ForeignSchemaName: "dbo",
+				ForeignTableName:  "accounts",
+				ForeignColumnName: "id",
+			},
+		}, nil)
+
+	sqlMock.ExpectExec(regexp.QuoteMeta("DELETE FROM [dbo].[users]; DELETE FROM [dbo].[accounts];")).WillReturnResult(sqlmock.NewResult(1, 2))
+	sqlDbContainerMock.On("Open", mock.Anything).Return(sqlDbMock, nil)
+	sqlDbContainerMock.On("Close").Return(nil)
+	mockSqlConnector.On("NewDbFromConnectionConfig", mock.Anything, mock.Anything, mock.Anything).Return(sqlDbContainerMock, nil)
+
+	bbuilder := newInitStatementBuilder(pgcache, pgquerier, mysqlcache, mysqlquerier, mssqlcache, mssqlquerier, mockJobClient, mockConnectionClient, mockSqlConnector)
+	_, err = bbuilder.RunSqlInitTableStatements(
+		context.Background(),
+		&RunSqlInitTableStatementsRequest{JobId: "123", WorkflowId: "123"},
+		slog.Default(),
+	)
+	assert.Nil(t, err)
+	if err := sqlMock.ExpectationsWereMet(); err != nil {
+		t.Errorf("there were unfulfilled expectations: %s", err)
+	}
+}
+
+func Test_InitStatementBuilder_Pg_Sqlite_InitSchema(t *testing.T) {
+	mockJobClient := mgmtv1alpha1connect.NewMockJobServiceClient(t)
+	mockConnectionClient := mgmtv1alpha1connect.NewMockConnectionServiceClient(t)
+	mockSqlConnector := sqlconnect.NewMockSqlConnector(t)
+	sqlDbContainerMock := sqlconnect.NewMockSqlDbContainer(t)
+
+	sqlDbMock, sqlMock, err := sqlmock.New(sqlmock.MonitorPingsOption(false))
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	pgcache := map[string]pg_queries.DBTX{
+		"123": pg_queries.NewMockDBTX(t),
+	}
+	pgquerier := pg_queries.NewMockQuerier(t)
+	mysqlcache := map[string]mysql_queries.DBTX{}
+	mysqlquerier := mysql_queries.NewMockQuerier(t)
+
+	mockJobClient.On("GetJob", mock.Anything, mock.Anything).
+		Return(connect.NewResponse(&mgmtv1alpha1.GetJobResponse{
+			Job: &mgmtv1alpha1.Job{
+				Source: &mgmtv1alpha1.JobSource{
+					Options: &mgmtv1alpha1.JobSourceOptions{
+						Config: &mgmtv1alpha1.JobSourceOptions_Postgres{
+							Postgres: &mgmtv1alpha1.PostgresSourceConnectionOptions{
+								ConnectionId: "123",
+							},
+						},
+					},
+				},
+				Mappings: []*mgmtv1alpha1.JobMapping{
+					{
+						Schema:      "public",
+						Table:       "users",
+						Column:      "id",
+						Transformer: &mgmtv1alpha1.JobMappingTransformer{Source: mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_PASSTHROUGH},
+					},
+					{
+						Schema:      "public",
+						Table:       "users",
+						Column:      "account_id",
+						Transformer: &mgmtv1alpha1.JobMappingTransformer{Source: mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_PASSTHROUGH},
+					},
+					{
+						Schema:      "public",
+						Table:       "accounts",
+						Column:      "id",
+						Transformer: &mgmtv1alpha1.JobMappingTransformer{Source: mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_PASSTHROUGH},
+					},
+				},
+				Destinations: []*mgmtv1alpha1.JobDestination{
+					{
+						ConnectionId: "456",
+						Options: &mgmtv1alpha1.JobDestinationOptions{
+							Config: &mgmtv1alpha1.JobDestinationOptions_SqliteOptions{
+								SqliteOptions: &mgmtv1alpha1.SqliteDestinationConnectionOptions{
+									TruncateTable: &mgmtv1alpha1.SqliteTruncateTableConfig{
+										TruncateBeforeInsert: true,
+									},
+									InitTableSchema: true,
+								},
+							},
+						},
+					},
+				},
+			},
+		}), nil)
+
+	mockConnectionClient.On("GetConnection", mock.Anything, connect.NewRequest(&mgmtv1alpha1.GetConnectionRequest{Id: "123"})).
+		Return(connect.NewResponse(&mgmtv1alpha1.GetConnectionResponse{
+			Connection: &mgmtv1alpha1.Connection{
+				Id:   "123",
+				Name: "prod",
+				ConnectionConfig: &mgmtv1alpha1.ConnectionConfig{
+					Config: &mgmtv1alpha1.ConnectionConfig_PgConfig{
+						PgConfig: &mgmtv1alpha1.PostgresConnectionConfig{
+							ConnectionConfig: &mgmtv1alpha1.PostgresConnectionConfig_Url{
+								Url: "fake-prod-url",
+							},
+						},
+					},
+				},
+			},
+		}), nil)
+	mockConnectionClient.On("GetConnection", mock.Anything, connect.NewRequest(&mgmtv1alpha1.GetConnectionRequest{Id: "456"})).
+		Return(connect.NewResponse(&mgmtv1alpha1.GetConnectionResponse{
+			Connection: &mgmtv1alpha1.Connection{
+				Id:   "456",
+				Name: "stage",
+				ConnectionConfig: &mgmtv1alpha1.ConnectionConfig{
+					Config: &mgmtv1alpha1.ConnectionConfig_SqliteConfig{
+						SqliteConfig: &mgmtv1alpha1.SqliteConnectionConfig{
+							Path: "/tmp/stage.db",
+						},
+					},
+				},
+			},
+		}), nil)
+	pgquerier.On("GetForeignKeyConstraints", mock.Anything, mock.Anything, "public").
+		Return([]*pg_queries.GetForeignKeyConstraintsRow{
+			{
+				ConstraintName:    "fk_user_account_id",
+				SchemaName:        "public",
+				TableName:         "users",
+				ColumnName:        "account_id",
+				IsNullable:        "YES",
+				ForeignSchemaName: "public",
+				ForeignTableName:  "accounts",
+				ForeignColumnName: "id",
+			},
+		}, nil)
+	pgquerier.On("GetPrimaryKeyConstraints", mock.Anything, mock.Anything, "public").
+		Return([]*pg_queries.GetPrimaryKeyConstraintsRow{
+			{SchemaName: "public", TableName: "users", ConstraintName: "users_pkey", ColumnName: "id"},
+			{SchemaName: "public", TableName: "accounts", ConstraintName: "accounts_pkey", ColumnName: "id"},
+		}, nil)
+	pgquerier.On("GetDatabaseSchema", mock.Anything, mock.Anything).
+		Return([]*pg_queries.GetDatabaseSchemaRow{
+			{TableSchema: "public", TableName: "users", ColumnName: "id", DataType: "uuid", IsNullable: "NO"},
+			{TableSchema: "public", TableName: "users", ColumnName: "account_id", DataType: "bigint", IsNullable: "YES"},
+			{TableSchema: "public", TableName: "accounts", ColumnName: "id", DataType: "bigint", IsNullable: "NO"},
+			{TableSchema: "public", TableName: "unmapped", ColumnName: "id", DataType: "bigint", IsNullable: "NO"},
+		}, nil)
+
+	sqlMock.ExpectExec(regexp.QuoteMeta(
+		`CREATE TABLE IF NOT EXISTS "accounts" ("id" INTEGER NOT NULL, PRIMARY KEY ("id")); ` +
+			`CREATE TABLE IF NOT EXISTS "users" ("id" TEXT NOT NULL, "account_id" INTEGER, PRIMARY KEY ("id"), FOREIGN KEY ("account_id") REFERENCES "accounts" ("id"));`,
+	)).WillReturnResult(sqlmock.NewResult(0, 0))
+	sqlMock.ExpectExec(regexp.QuoteMeta(`DELETE FROM "users"; DELETE FROM "accounts";`)).WillReturnResult(sqlmock.NewResult(0, 0))
+	sqlDbContainerMock.On("Open", mock.Anything).Return(sqlDbMock, nil)
+	sqlDbContainerMock.On("Close").Return(nil)
+	mockSqlConnector.On("NewDbFromConnectionConfig", mock.Anything, mock.Anything, mock.Anything).Return(sqlDbContainerMock, nil)
+
+	bbuilder := newInitStatementBuilder(pgcache, pgquerier, mysqlcache, mysqlquerier, map[string]dbschemas_mssql.DBTX{}, dbschemas_mssql.NewMockQuerier(t), mockJobClient, mockConnectionClient, mockSqlConnector)
 	_, err = bbuilder.RunSqlInitTableStatements(
 		context.Background(),
 		&RunSqlInitTableStatementsRequest{JobId: "123", WorkflowId: "123"},
@@ -13,6 +13,10 @@ import (
 const (
 	// The benthos value for null
 	NullString = "null"
+
+	// The environment variable that the account's transformer key is provided under when a benthos stream is launched.
+	// Deterministic transformers reference it instead of the key itself so that the key is never stored in the workflow history.
+	TransformerKeyEnvVarKey = "NEOSYNC_TRANSFORMER_KEY"
 )
 
 // General workflow metadata struct that is intended to be common across activities
@@ -28,6 +32,17 @@ type BenthosDsn struct {
 	ConnectionId string
 }
 
+// The watermark range that a job run synced for a table that is configured for incremental sync
+type JobRunWatermark struct {
+	Schema string
+	Table  string
+	Column string
+	// Exclusive lower bound. Nil if this is the first incremental run for the table
+	StartValue *string
+	// Inclusive upper bound. Becomes the lower bound of the next run once the job run completes successfully
+	EndValue string
+}
+
 // Returns the neosync url found in the environment, otherwise defaults to localhost
 func GetNeosyncUrl() string {
 	neosyncUrl := viper.GetString("NEOSYNC_URL")
@@ -49,6 +64,22 @@ func Ptr[T any](val T) *T {
 }
 
 // Parses the job mappings and returns the unique set of schemas found
+// Change data capture jobs populate their destinations with a regular sync of the source before changes are streamed.
+// Returns the batch source options that the snapshot is synced with.
+func GetSnapshotSourceOptions(options *mgmtv1alpha1.JobSourceOptions) *mgmtv1alpha1.JobSourceOptions {
+	cdcOpts := options.GetPostgresCdc()
+	if cdcOpts == nil {
+		return options
+	}
+	return &mgmtv1alpha1.JobSourceOptions{
+		Config: &mgmtv1alpha1.JobSourceOptions_Postgres{
+			Postgres: &mgmtv1alpha1.PostgresSourceConnectionOptions{
+				ConnectionId: cdcOpts.ConnectionId,
+			},
+		},
+	}
+}
+
 func GetUniqueSchemasFromMappings(mappings []*mgmtv1alpha1.JobMapping) []string {
 	schemas := map[string]struct{}{}
 	for _, mapping := range mappings {
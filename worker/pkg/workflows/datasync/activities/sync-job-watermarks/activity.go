@@ -0,0 +1,69 @@
+package syncjobwatermarks_activity
+
+import (
+	"context"
+	"fmt"
+
+	"connectrpc.com/connect"
+	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
+	"github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1/mgmtv1alpha1connect"
+	"github.com/nucleuscloud/neosync/worker/pkg/workflows/datasync/activities/shared"
+	"go.temporal.io/sdk/activity"
+	"go.temporal.io/sdk/log"
+)
+
+type SetJobRunWatermarksRequest struct {
+	JobId string
+	// The job run id, which is the temporal workflow id
+	JobRunId   string
+	Watermarks []*shared.JobRunWatermark
+}
+
+type SetJobRunWatermarksResponse struct{}
+
+// Advances the job's high-water marks once every table in the run has synced successfully
+func SetJobRunWatermarks(
+	ctx context.Context,
+	req *SetJobRunWatermarksRequest,
+) (*SetJobRunWatermarksResponse, error) {
+	logger := log.With(
+		activity.GetLogger(ctx),
+		"jobId", req.JobId,
+		"WorkflowID", req.JobRunId,
+	)
+
+	jobclient := mgmtv1alpha1connect.NewJobServiceClient(
+		shared.GetNeosyncHttpClient(),
+		shared.GetNeosyncUrl(),
+	)
+	return setJobRunWatermarks(ctx, logger, jobclient, req)
+}
+
+func setJobRunWatermarks(
+	ctx context.Context,
+	logger log.Logger,
+	jobclient mgmtv1alpha1connect.JobServiceClient,
+	req *SetJobRunWatermarksRequest,
+) (*SetJobRunWatermarksResponse, error) {
+	watermarks := make([]*mgmtv1alpha1.JobRunWatermark, len(req.Watermarks))
+	for idx, wm := range req.Watermarks {
+		watermarks[idx] = &mgmtv1alpha1.JobRunWatermark{
+			Schema:     wm.Schema,
+			Table:      wm.Table,
+			Column:     wm.Column,
+			StartValue: wm.StartValue,
+			EndValue:   wm.EndValue,
+		}
+	}
+
+	logger.Info(fmt.Sprintf("setting watermarks for %d tables", len(watermarks)))
+	_, err := jobclient.SetJobRunWatermarks(ctx, connect.NewRequest(&mgmtv1alpha1.SetJobRunWatermarksRequest{
+		JobId:      req.JobId,
+		JobRunId:   req.JobRunId,
+		Watermarks: watermarks,
+	}))
+	if err != nil {
+		return nil, fmt.Errorf("unable to set job run watermarks: %w", err)
+	}
+	return &SetJobRunWatermarksResponse{}, nil
+}
@@ -0,0 +1,40 @@
+package syncjobwatermarks_activity
+
+import (
+	"context"
+	"log/slog"
+	"testing"
+
+	"connectrpc.com/connect"
+	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
+	"github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1/mgmtv1alpha1connect"
+	"github.com/nucleuscloud/neosync/worker/pkg/workflows/datasync/activities/shared"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go.temporal.io/sdk/log"
+)
+
+func Test_setJobRunWatermarks(t *testing.T) {
+	jobclient := mgmtv1alpha1connect.NewMockJobServiceClient(t)
+	logger := log.NewStructuredLogger(slog.Default())
+
+	jobclient.On("SetJobRunWatermarks", mock.Anything, connect.NewRequest(&mgmtv1alpha1.SetJobRunWatermarksRequest{
+		JobId:    "job-id",
+		JobRunId: "run-id",
+		Watermarks: []*mgmtv1alpha1.JobRunWatermark{
+			{Schema: "public", Table: "users", Column: "updated_at", StartValue: shared.Ptr("2024-01-01"), EndValue: "2024-01-02"},
+			{Schema: "public", Table: "orders", Column: "id", EndValue: "100"},
+		},
+	})).Return(connect.NewResponse(&mgmtv1alpha1.SetJobRunWatermarksResponse{}), nil)
+
+	resp, err := setJobRunWatermarks(context.Background(), logger, jobclient, &SetJobRunWatermarksRequest{
+		JobId:    "job-id",
+		JobRunId: "run-id",
+		Watermarks: []*shared.JobRunWatermark{
+			{Schema: "public", Table: "users", Column: "updated_at", StartValue: shared.Ptr("2024-01-01"), EndValue: "2024-01-02"},
+			{Schema: "public", Table: "orders", Column: "id", EndValue: "100"},
+		},
+	})
+	assert.NoError(t, err)
+	assert.NotNil(t, resp)
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strings"
 	"sync"
 	"time"
 
@@ -44,21 +45,25 @@ type SyncMetadata struct {
 type SyncRequest struct {
 	BenthosConfig string
 	BenthosDsns   []*shared.BenthosDsn
+	// Account that the job belongs to. Used to retrieve the account's transformer key when the config references it
+	AccountId string
 }
 type SyncResponse struct{}
 
 func New(
 	connclient mgmtv1alpha1connect.ConnectionServiceClient,
+	useraccountclient mgmtv1alpha1connect.UserAccountServiceClient,
 	tunnelmanagermap *sync.Map,
 	temporalclient client.Client,
 	meter metric.Meter,
 	benthosStreamManager BenthosStreamManagerClient,
 ) *Activity {
-	return &Activity{connclient: connclient, tunnelmanagermap: tunnelmanagermap, temporalclient: temporalclient, meter: meter, benthosStreamManager: benthosStreamManager}
+	return &Activity{connclient: connclient, useraccountclient: useraccountclient, tunnelmanagermap: tunnelmanagermap, temporalclient: temporalclient, meter: meter, benthosStreamManager: benthosStreamManager}
 }
 
 type Activity struct {
 	connclient           mgmtv1alpha1connect.ConnectionServiceClient
+	useraccountclient    mgmtv1alpha1connect.UserAccountServiceClient
 	tunnelmanagermap     *sync.Map
 	temporalclient       client.Client
 	meter                metric.Meter // optional
@@ -251,6 +256,16 @@ func (a *Activity) Sync(ctx context.Context, req *SyncRequest, metadata *SyncMet
 	envKeyMap["TEMPORAL_WORKFLOW_ID"] = info.WorkflowExecution.ID
 	envKeyMap["TEMPORAL_RUN_ID"] = info.WorkflowExecution.RunID
 
+	if strings.Contains(req.BenthosConfig, fmt.Sprintf("${%s}", shared.TransformerKeyEnvVarKey)) {
+		keyResp, err := a.useraccountclient.GetAccountTransformerKey(ctx, connect.NewRequest(&mgmtv1alpha1.GetAccountTransformerKeyRequest{
+			AccountId: req.AccountId,
+		}))
+		if err != nil {
+			return nil, fmt.Errorf("unable to retrieve account transformer key: %w", err)
+		}
+		envKeyMap[shared.TransformerKeyEnvVarKey] = keyResp.Msg.Key
+	}
+
 	streambldr := benthosenv.NewStreamBuilder()
 	// would ideally use the activity logger here but can't convert it into a slog.
 	streambldr.SetLogger(slogger.With(
@@ -9,6 +9,9 @@ import (
 	"testing"
 	"time"
 
+	"connectrpc.com/connect"
+	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
+	"github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1/mgmtv1alpha1connect"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
@@ -21,7 +24,7 @@ func Test_Sync_Run_Success(t *testing.T) {
 	env := testSuite.NewTestActivityEnvironment()
 
 	benthosStreamManager := NewBenthosStreamManager()
-	activity := New(nil, &sync.Map{}, nil, nil, benthosStreamManager)
+	activity := New(nil, nil, &sync.Map{}, nil, nil, benthosStreamManager)
 
 	env.RegisterActivity(activity.Sync)
 
@@ -44,6 +47,41 @@ output:
 	require.NoError(t, err)
 }
 
+func Test_Sync_Run_TransformerKey(t *testing.T) {
+	testSuite := &testsuite.WorkflowTestSuite{}
+	env := testSuite.NewTestActivityEnvironment()
+
+	mockUserAccountClient := mgmtv1alpha1connect.NewMockUserAccountServiceClient(t)
+	mockUserAccountClient.On("GetAccountTransformerKey", mock.Anything, connect.NewRequest(&mgmtv1alpha1.GetAccountTransformerKeyRequest{AccountId: "account-id"})).
+		Return(connect.NewResponse(&mgmtv1alpha1.GetAccountTransformerKeyResponse{Key: "secret"}), nil)
+	benthosStreamManager := NewBenthosStreamManager()
+	activity := New(nil, mockUserAccountClient, &sync.Map{}, nil, nil, benthosStreamManager)
+
+	env.RegisterActivity(activity.Sync)
+
+	val, err := env.ExecuteActivity(activity.Sync, &SyncRequest{
+		AccountId: "account-id",
+		BenthosConfig: strings.TrimSpace(`
+input:
+  generate:
+    count: 1
+    interval: ""
+    mapping: 'root = { "name": "alice" }'
+pipeline:
+  processors:
+    - mutation: 'root.name = transform_first_name(value:this.name,deterministic_key:"${NEOSYNC_TRANSFORMER_KEY}")'
+output:
+  label: ""
+  stdout:
+    codec: lines
+`),
+	}, &SyncMetadata{Schema: "public", Table: "test"})
+	require.NoError(t, err)
+	res := &SyncResponse{}
+	err = val.Get(res)
+	require.NoError(t, err)
+}
+
 func Test_Sync_Run_Metrics_Success(t *testing.T) {
 	testSuite := &testsuite.WorkflowTestSuite{}
 	env := testSuite.NewTestActivityEnvironment()
@@ -51,7 +89,7 @@ func Test_Sync_Run_Metrics_Success(t *testing.T) {
 	meterProvider := metricsdk.NewMeterProvider()
 	meter := meterProvider.Meter("test")
 	benthosStreamManager := NewBenthosStreamManager()
-	activity := New(nil, &sync.Map{}, nil, meter, benthosStreamManager)
+	activity := New(nil, nil, &sync.Map{}, nil, meter, benthosStreamManager)
 
 	env.RegisterActivity(activity.Sync)
 
@@ -81,7 +119,7 @@ func Test_Sync_Fake_Mutation_Success(t *testing.T) {
 	env := testSuite.NewTestActivityEnvironment()
 
 	benthosStreamManager := NewBenthosStreamManager()
-	activity := New(nil, &sync.Map{}, nil, nil, benthosStreamManager)
+	activity := New(nil, nil, &sync.Map{}, nil, nil, benthosStreamManager)
 	env.RegisterActivity(activity.Sync)
 
 	val, err := env.ExecuteActivity(activity.Sync, &SyncRequest{
@@ -113,7 +151,7 @@ func Test_Sync_Run_Success_Javascript(t *testing.T) {
 	env := testSuite.NewTestActivityEnvironment()
 
 	benthosStreamManager := NewBenthosStreamManager()
-	activity := New(nil, &sync.Map{}, nil, nil, benthosStreamManager)
+	activity := New(nil, nil, &sync.Map{}, nil, nil, benthosStreamManager)
 	env.RegisterActivity(activity.Sync)
 
 	tmpFile, err := os.CreateTemp("", "test")
@@ -169,7 +207,7 @@ func Test_Sync_Run_Success_MutataionAndJavascript(t *testing.T) {
 	testSuite := &testsuite.WorkflowTestSuite{}
 	env := testSuite.NewTestActivityEnvironment()
 	benthosStreamManager := NewBenthosStreamManager()
-	activity := New(nil, &sync.Map{}, nil, nil, benthosStreamManager)
+	activity := New(nil, nil, &sync.Map{}, nil, nil, benthosStreamManager)
 	env.RegisterActivity(activity.Sync)
 
 	tmpFile, err := os.CreateTemp("", "test")
@@ -228,7 +266,7 @@ func Test_Sync_Run_Processor_Error(t *testing.T) {
 	env := testSuite.NewTestActivityEnvironment()
 
 	benthosStreamManager := NewBenthosStreamManager()
-	activity := New(nil, &sync.Map{}, nil, nil, benthosStreamManager)
+	activity := New(nil, nil, &sync.Map{}, nil, nil, benthosStreamManager)
 
 	env.RegisterActivity(activity.Sync)
 
@@ -275,7 +313,7 @@ output:
 	mockBenthosStream.On("Run", mock.Anything).After(5 * time.Second).Return(nil)
 	mockBenthosStream.On("StopWithin", mock.Anything).Return(nil)
 
-	activity := New(nil, &sync.Map{}, nil, nil, mockBenthosStreamManager)
+	activity := New(nil, nil, &sync.Map{}, nil, nil, mockBenthosStreamManager)
 	env.RegisterActivity(activity.Sync)
 
 	stopCh := make(chan struct{})
@@ -298,7 +336,7 @@ func Test_Sync_Run_ActivityWorkerStop(t *testing.T) {
 	testSuite := &testsuite.WorkflowTestSuite{}
 	env := testSuite.NewTestActivityEnvironment()
 	benthosStreamManager := NewBenthosStreamManager()
-	activity := New(nil, &sync.Map{}, nil, nil, benthosStreamManager)
+	activity := New(nil, nil, &sync.Map{}, nil, nil, benthosStreamManager)
 
 	env.RegisterActivity(activity.Sync)
 	stopCh := make(chan struct{})
@@ -350,7 +388,7 @@ output:
 	mockBenthosStream.On("Run", mock.Anything).Return(errors.New(errmsg))
 	mockBenthosStream.On("StopWithin", mock.Anything).Return(nil).Maybe()
 
-	activity := New(nil, &sync.Map{}, nil, nil, mockBenthosStreamManager)
+	activity := New(nil, nil, &sync.Map{}, nil, nil, mockBenthosStreamManager)
 
 	env.RegisterActivity(activity.Sync)
 	_, err := env.ExecuteActivity(activity.Sync, &SyncRequest{
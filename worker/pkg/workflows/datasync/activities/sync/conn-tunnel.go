@@ -259,6 +259,10 @@ func getDriverFromConnection(connection *mgmtv1alpha1.Connection) (string, error
 		return "mysql", nil
 	case *mgmtv1alpha1.ConnectionConfig_PgConfig:
 		return "postgres", nil
+	case *mgmtv1alpha1.ConnectionConfig_MssqlConfig:
+		return "sqlserver", nil
+	case *mgmtv1alpha1.ConnectionConfig_SqliteConfig:
+		return "sqlite", nil
 	}
 	return "", errors.New("unsupported connection type when computing driver")
 }
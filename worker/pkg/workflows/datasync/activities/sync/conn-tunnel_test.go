@@ -277,6 +277,18 @@ func Test_getDriverFromConnection(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Equal(t, "mysql", driver)
 
+	driver, err = getDriverFromConnection(&mgmtv1alpha1.Connection{ConnectionConfig: &mgmtv1alpha1.ConnectionConfig{
+		Config: &mgmtv1alpha1.ConnectionConfig_MssqlConfig{},
+	}})
+	assert.NoError(t, err)
+	assert.Equal(t, "sqlserver", driver)
+
+	driver, err = getDriverFromConnection(&mgmtv1alpha1.Connection{ConnectionConfig: &mgmtv1alpha1.ConnectionConfig{
+		Config: &mgmtv1alpha1.ConnectionConfig_SqliteConfig{},
+	}})
+	assert.NoError(t, err)
+	assert.Equal(t, "sqlite", driver)
+
 	driver, err = getDriverFromConnection(&mgmtv1alpha1.Connection{ConnectionConfig: &mgmtv1alpha1.ConnectionConfig{
 		Config: &mgmtv1alpha1.ConnectionConfig_AwsS3Config{},
 	}})
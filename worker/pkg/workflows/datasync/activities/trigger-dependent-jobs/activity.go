@@ -0,0 +1,63 @@
+package triggerdependentjobs_activity
+
+import (
+	"context"
+	"fmt"
+
+	"connectrpc.com/connect"
+	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
+	"github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1/mgmtv1alpha1connect"
+	"github.com/nucleuscloud/neosync/worker/pkg/workflows/datasync/activities/shared"
+	"go.temporal.io/sdk/activity"
+	"go.temporal.io/sdk/log"
+)
+
+type TriggerDependentJobsRequest struct {
+	JobId string
+	// The job run id, which is the temporal workflow id
+	JobRunId  string
+	Succeeded bool
+}
+
+type TriggerDependentJobsResponse struct {
+	// The jobs that a run was started for
+	JobIds []string
+}
+
+// Starts a run of every job that is triggered by the outcome of this job run
+func TriggerDependentJobs(
+	ctx context.Context,
+	req *TriggerDependentJobsRequest,
+) (*TriggerDependentJobsResponse, error) {
+	logger := log.With(
+		activity.GetLogger(ctx),
+		"jobId", req.JobId,
+		"WorkflowID", req.JobRunId,
+	)
+
+	jobclient := mgmtv1alpha1connect.NewJobServiceClient(
+		shared.GetNeosyncHttpClient(),
+		shared.GetNeosyncUrl(),
+	)
+	return triggerDependentJobs(ctx, logger, jobclient, req)
+}
+
+func triggerDependentJobs(
+	ctx context.Context,
+	logger log.Logger,
+	jobclient mgmtv1alpha1connect.JobServiceClient,
+	req *TriggerDependentJobsRequest,
+) (*TriggerDependentJobsResponse, error) {
+	resp, err := jobclient.TriggerDependentJobs(ctx, connect.NewRequest(&mgmtv1alpha1.TriggerDependentJobsRequest{
+		JobId:     req.JobId,
+		JobRunId:  req.JobRunId,
+		Succeeded: req.Succeeded,
+	}))
+	if err != nil {
+		return nil, fmt.Errorf("unable to trigger dependent jobs: %w", err)
+	}
+	if len(resp.Msg.JobIds) > 0 {
+		logger.Info(fmt.Sprintf("triggered %d dependent jobs", len(resp.Msg.JobIds)), "dependentJobIds", resp.Msg.JobIds)
+	}
+	return &TriggerDependentJobsResponse{JobIds: resp.Msg.JobIds}, nil
+}
@@ -0,0 +1,48 @@
+package triggerdependentjobs_activity
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"testing"
+
+	"connectrpc.com/connect"
+	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
+	"github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1/mgmtv1alpha1connect"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go.temporal.io/sdk/log"
+)
+
+func Test_triggerDependentJobs(t *testing.T) {
+	jobclient := mgmtv1alpha1connect.NewMockJobServiceClient(t)
+	logger := log.NewStructuredLogger(slog.Default())
+
+	jobclient.On("TriggerDependentJobs", mock.Anything, connect.NewRequest(&mgmtv1alpha1.TriggerDependentJobsRequest{
+		JobId:     "job-id",
+		JobRunId:  "run-id",
+		Succeeded: true,
+	})).Return(connect.NewResponse(&mgmtv1alpha1.TriggerDependentJobsResponse{JobIds: []string{"dependent-id"}}), nil)
+
+	resp, err := triggerDependentJobs(context.Background(), logger, jobclient, &TriggerDependentJobsRequest{
+		JobId:     "job-id",
+		JobRunId:  "run-id",
+		Succeeded: true,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"dependent-id"}, resp.JobIds)
+}
+
+func Test_triggerDependentJobs_Error(t *testing.T) {
+	jobclient := mgmtv1alpha1connect.NewMockJobServiceClient(t)
+	logger := log.NewStructuredLogger(slog.Default())
+
+	jobclient.On("TriggerDependentJobs", mock.Anything, mock.Anything).Return(nil, errors.New("unavailable"))
+
+	resp, err := triggerDependentJobs(context.Background(), logger, jobclient, &TriggerDependentJobsRequest{
+		JobId:    "job-id",
+		JobRunId: "run-id",
+	})
+	assert.Error(t, err)
+	assert.Nil(t, resp)
+}
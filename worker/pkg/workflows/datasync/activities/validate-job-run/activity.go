@@ -0,0 +1,77 @@
+package validatejobrun_activity
+
+import (
+	"context"
+	"time"
+
+	"github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1/mgmtv1alpha1connect"
+	"github.com/nucleuscloud/neosync/backend/pkg/sqlconnect"
+	logger_utils "github.com/nucleuscloud/neosync/worker/internal/logger"
+	"github.com/nucleuscloud/neosync/worker/pkg/workflows/datasync/activities/shared"
+	"go.temporal.io/sdk/activity"
+	"go.temporal.io/sdk/log"
+)
+
+type ValidateJobRunRequest struct {
+	JobId string
+	// The job run id, which is the temporal workflow id
+	JobRunId string
+}
+
+type ValidateJobRunResponse struct {
+	// True if the job's source or destinations do not support validation and no report was recorded
+	Skipped bool
+	Passed  bool
+}
+
+// Compares the synced destinations against the source and records the resulting validation report against the job run
+func ValidateJobRun(
+	ctx context.Context,
+	req *ValidateJobRunRequest,
+) (*ValidateJobRunResponse, error) {
+	logger := log.With(
+		activity.GetLogger(ctx),
+		"jobId", req.JobId,
+		"WorkflowID", req.JobRunId,
+	)
+	logger.Info("validating job run")
+
+	go func() {
+		for {
+			select {
+			case <-time.After(1 * time.Second):
+				activity.RecordHeartbeat(ctx)
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	neosyncUrl := shared.GetNeosyncUrl()
+	httpClient := shared.GetNeosyncHttpClient()
+
+	jobclient := mgmtv1alpha1connect.NewJobServiceClient(
+		httpClient,
+		neosyncUrl,
+	)
+	connclient := mgmtv1alpha1connect.NewConnectionServiceClient(
+		httpClient,
+		neosyncUrl,
+	)
+	conndataclient := mgmtv1alpha1connect.NewConnectionDataServiceClient(
+		httpClient,
+		neosyncUrl,
+	)
+	validator := newJobRunValidator(
+		jobclient,
+		connclient,
+		conndataclient,
+		&sqlconnect.SqlOpenConnector{},
+		defaultColumnSampleSize,
+	)
+	slogger := logger_utils.NewJsonSLogger().With(
+		"jobId", req.JobId,
+		"WorkflowID", req.JobRunId,
+	)
+	return validator.ValidateJobRun(ctx, req, slogger)
+}
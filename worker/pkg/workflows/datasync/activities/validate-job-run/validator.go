@@ -0,0 +1,595 @@
+package validatejobrun_activity
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strings"
+
+	"connectrpc.com/connect"
+	"github.com/doug-martin/goqu/v9"
+	"github.com/doug-martin/goqu/v9/exp"
+	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
+	"github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1/mgmtv1alpha1connect"
+	dbschemas_utils "github.com/nucleuscloud/neosync/backend/pkg/dbschemas"
+	"github.com/nucleuscloud/neosync/backend/pkg/sqlconnect"
+	"github.com/nucleuscloud/neosync/worker/pkg/workflows/datasync/activities/shared"
+
+	// import the dialect
+	_ "github.com/doug-martin/goqu/v9/dialect/mysql"
+	_ "github.com/doug-martin/goqu/v9/dialect/postgres"
+)
+
+const (
+	defaultColumnSampleSize = 1000
+
+	postgresDriver = "postgres"
+	mysqlDriver    = "mysql"
+)
+
+type jobRunValidator struct {
+	jobclient      mgmtv1alpha1connect.JobServiceClient
+	connclient     mgmtv1alpha1connect.ConnectionServiceClient
+	conndataclient mgmtv1alpha1connect.ConnectionDataServiceClient
+
+	sqlconnector sqlconnect.SqlConnector
+
+	// the max number of rows per table that are compared when checking transformed columns
+	sampleSize uint
+}
+
+func newJobRunValidator(
+	jobclient mgmtv1alpha1connect.JobServiceClient,
+	connclient mgmtv1alpha1connect.ConnectionServiceClient,
+	conndataclient mgmtv1alpha1connect.ConnectionDataServiceClient,
+	sqlconnector sqlconnect.SqlConnector,
+	sampleSize uint,
+) *jobRunValidator {
+	return &jobRunValidator{
+		jobclient:      jobclient,
+		connclient:     connclient,
+		conndataclient: conndataclient,
+		sqlconnector:   sqlconnector,
+		sampleSize:     sampleSize,
+	}
+}
+
+type sourceTableOption struct {
+	WhereClause     *string
+	WatermarkColumn *string
+}
+
+type sourceInfo struct {
+	ConnectionId string
+	Driver       string
+	TableOpts    map[string]*sourceTableOption
+	// True if the source only syncs a subset of its rows
+	IsSubset bool
+}
+
+func (v *jobRunValidator) ValidateJobRun(
+	ctx context.Context,
+	req *ValidateJobRunRequest,
+	slogger *slog.Logger,
+) (*ValidateJobRunResponse, error) {
+	jobResp, err := v.jobclient.GetJob(ctx, connect.NewRequest(&mgmtv1alpha1.GetJobRequest{Id: req.JobId}))
+	if err != nil {
+		return nil, fmt.Errorf("unable to get job by id: %w", err)
+	}
+	job := jobResp.Msg.GetJob()
+
+	source := getSourceInfo(job.GetSource().GetOptions())
+	if source == nil {
+		slogger.Info("skipping job run validation as the job source does not support validation")
+		return &ValidateJobRunResponse{Skipped: true}, nil
+	}
+
+	destinations := []*mgmtv1alpha1.Connection{}
+	for _, destination := range job.GetDestinations() {
+		destConnection, err := v.getConnectionById(ctx, destination.GetConnectionId())
+		if err != nil {
+			return nil, fmt.Errorf("unable to get destination connection by id (%s): %w", destination.GetConnectionId(), err)
+		}
+		if getConnectionDriver(destConnection.GetConnectionConfig()) != source.Driver {
+			slogger.Info("skipping validation of destination as it does not match the source driver", "destinationConnectionId", destination.GetConnectionId())
+			continue
+		}
+		destinations = append(destinations, destConnection)
+	}
+	if len(destinations) == 0 {
+		slogger.Info("skipping job run validation as there are no destinations that support validation")
+		return &ValidateJobRunResponse{Skipped: true}, nil
+	}
+
+	sourceConnection, err := v.getConnectionById(ctx, source.ConnectionId)
+	if err != nil {
+		return nil, fmt.Errorf("unable to get source connection by id (%s): %w", source.ConnectionId, err)
+	}
+
+	// foreign keys are read from the source so that relationships are verified even if the destination does not enforce them
+	fkResp, err := v.conndataclient.GetConnectionForeignConstraints(ctx, connect.NewRequest(&mgmtv1alpha1.GetConnectionForeignConstraintsRequest{
+		ConnectionId: source.ConnectionId,
+	}))
+	if err != nil {
+		return nil, fmt.Errorf("unable to get source connection foreign constraints: %w", err)
+	}
+	pkResp, err := v.conndataclient.GetConnectionPrimaryConstraints(ctx, connect.NewRequest(&mgmtv1alpha1.GetConnectionPrimaryConstraintsRequest{
+		ConnectionId: source.ConnectionId,
+	}))
+	if err != nil {
+		return nil, fmt.Errorf("unable to get source connection primary constraints: %w", err)
+	}
+
+	sourceConn, err := v.sqlconnector.NewDbFromConnectionConfig(sourceConnection.GetConnectionConfig(), shared.Ptr(uint32(5)), slogger)
+	if err != nil {
+		return nil, fmt.Errorf("unable to create source sql connection: %w", err)
+	}
+	sourceDb, err := sourceConn.Open()
+	if err != nil {
+		return nil, fmt.Errorf("unable to open source sql connection: %w", err)
+	}
+	defer func() {
+		if err := sourceConn.Close(); err != nil {
+			slogger.Error(fmt.Sprintf("unable to close source sql connection: %s", err.Error()))
+		}
+	}()
+
+	tables, tableMappings := groupMappingsByTable(job.GetMappings())
+
+	results := []*mgmtv1alpha1.JobRunTableValidation{}
+	passed := true
+	for _, destConnection := range destinations {
+		destConn, err := v.sqlconnector.NewDbFromConnectionConfig(destConnection.GetConnectionConfig(), shared.Ptr(uint32(5)), slogger)
+		if err != nil {
+			return nil, fmt.Errorf("unable to create destination sql connection: %w", err)
+		}
+		destDb, err := destConn.Open()
+		if err != nil {
+			return nil, fmt.Errorf("unable to open destination sql connection: %w", err)
+		}
+
+		for _, table := range tables {
+			result, err := v.validateTable(
+				ctx,
+				source,
+				sourceDb,
+				destDb,
+				destConnection.GetId(),
+				table,
+				tableMappings[table],
+				fkResp.Msg.GetTableConstraints()[table].GetConstraints(),
+				pkResp.Msg.GetTableConstraints()[table].GetColumns(),
+				tableMappings,
+			)
+			if err != nil {
+				if err := destConn.Close(); err != nil {
+					slogger.Error(fmt.Sprintf("unable to close destination sql connection: %s", err.Error()))
+				}
+				return nil, fmt.Errorf("unable to validate table %s: %w", table, err)
+			}
+			if !result.Passed {
+				passed = false
+			}
+			results = append(results, result)
+		}
+		if err := destConn.Close(); err != nil {
+			slogger.Error(fmt.Sprintf("unable to close destination sql connection: %s", err.Error()))
+		}
+	}
+
+	slogger.Info(fmt.Sprintf("validated %d tables", len(results)), "passed", passed)
+	_, err = v.jobclient.SetJobRunValidationReport(ctx, connect.NewRequest(&mgmtv1alpha1.SetJobRunValidationReportRequest{
+		JobId:    req.JobId,
+		JobRunId: req.JobRunId,
+		Tables:   results,
+	}))
+	if err != nil {
+		return nil, fmt.Errorf("unable to set job run validation report: %w", err)
+	}
+	return &ValidateJobRunResponse{Passed: passed}, nil
+}
+
+func (v *jobRunValidator) validateTable(
+	ctx context.Context,
+	source *sourceInfo,
+	sourceDb, destDb sqlconnect.SqlDBTX,
+	destConnectionId string,
+	table string,
+	mappings []*mgmtv1alpha1.JobMapping,
+	foreignKeys []*mgmtv1alpha1.ForeignConstraint,
+	primaryKeys []string,
+	tableMappings map[string][]*mgmtv1alpha1.JobMapping,
+) (*mgmtv1alpha1.JobRunTableValidation, error) {
+	schemaName, tableName := splitTable(table)
+	tableOpt := source.TableOpts[table]
+	var whereClause *string
+	if tableOpt != nil {
+		whereClause = tableOpt.WhereClause
+	}
+
+	result := &mgmtv1alpha1.JobRunTableValidation{
+		DestinationConnectionId: destConnectionId,
+		Schema:                  schemaName,
+		Table:                   tableName,
+		ForeignKeys:             []*mgmtv1alpha1.JobRunForeignKeyValidation{},
+		Columns:                 []*mgmtv1alpha1.JobRunColumnValidation{},
+	}
+
+	sourceCount, err := countRows(ctx, sourceDb, source.Driver, schemaName, tableName, whereClause)
+	if err != nil {
+		return nil, fmt.Errorf("unable to count source rows: %w", err)
+	}
+	destCount, err := countRows(ctx, destDb, source.Driver, schemaName, tableName, nil)
+	if err != nil {
+		return nil, fmt.Errorf("unable to count destination rows: %w", err)
+	}
+	result.SourceRowCount = sourceCount
+	result.DestinationRowCount = destCount
+	passed := isRowCountValid(sourceCount, destCount, source.IsSubset, tableOpt)
+
+	mappedCols := map[string]*mgmtv1alpha1.JobMapping{}
+	for _, mapping := range mappings {
+		mappedCols[mapping.GetColumn()] = mapping
+	}
+
+	for _, fk := range foreignKeys {
+		if fk.GetForeignKey() == nil {
+			continue
+		}
+		// only relationships that were synced as part of the job can be verified
+		if _, ok := mappedCols[fk.GetColumn()]; !ok {
+			continue
+		}
+		if _, ok := tableMappings[fk.GetForeignKey().GetTable()]; !ok {
+			continue
+		}
+		foreignSchema, foreignTable := splitTable(fk.GetForeignKey().GetTable())
+		orphaned, err := countOrphanedRows(ctx, destDb, source.Driver, schemaName, tableName, fk.GetColumn(), foreignSchema, foreignTable, fk.GetForeignKey().GetColumn())
+		if err != nil {
+			return nil, fmt.Errorf("unable to count orphaned rows for foreign key %s: %w", fk.GetColumn(), err)
+		}
+		if orphaned > 0 {
+			passed = false
+		}
+		result.ForeignKeys = append(result.ForeignKeys, &mgmtv1alpha1.JobRunForeignKeyValidation{
+			Column:           fk.GetColumn(),
+			ForeignSchema:    foreignSchema,
+			ForeignTable:     foreignTable,
+			ForeignColumn:    fk.GetForeignKey().GetColumn(),
+			OrphanedRowCount: orphaned,
+		})
+	}
+
+	transformedCols := []*mgmtv1alpha1.JobMapping{}
+	for _, mapping := range mappings {
+		if isTransformed(mapping) {
+			transformedCols = append(transformedCols, mapping)
+		}
+	}
+	if len(transformedCols) == 0 {
+		result.Passed = passed
+		return result, nil
+	}
+
+	skippedReason := getColumnsSkippedReason(primaryKeys, mappedCols)
+	if skippedReason != nil {
+		result.ColumnsSkippedReason = skippedReason
+		result.Passed = passed
+		return result, nil
+	}
+
+	columns := make([]string, 0, len(transformedCols))
+	for _, mapping := range transformedCols {
+		columns = append(columns, mapping.GetColumn())
+	}
+	unchanged, sampled, err := v.countUnchangedValues(ctx, sourceDb, destDb, source.Driver, schemaName, tableName, whereClause, primaryKeys, columns)
+	if err != nil {
+		return nil, fmt.Errorf("unable to compare transformed columns: %w", err)
+	}
+	for idx, mapping := range transformedCols {
+		if unchanged[idx] > 0 {
+			passed = false
+		}
+		result.Columns = append(result.Columns, &mgmtv1alpha1.JobRunColumnValidation{
+			Column:            mapping.GetColumn(),
+			TransformerSource: mapping.GetTransformer().GetSource(),
+			SampledRowCount:   sampled,
+			UnchangedRowCount: unchanged[idx],
+		})
+	}
+
+	result.Passed = passed
+	return result, nil
+}
+
+// Pairs a sample of source rows with their destination rows by primary key and counts, per column, the rows whose value was not changed
+func (v *jobRunValidator) countUnchangedValues(
+	ctx context.Context,
+	sourceDb, destDb sqlconnect.SqlDBTX,
+	driver, schema, table string,
+	whereClause *string,
+	primaryKeys []string,
+	columns []string,
+) (unchanged []int64, sampled int64, err error) {
+	selectCols := make([]any, 0, len(primaryKeys)+len(columns))
+	orderBy := make([]exp.OrderedExpression, 0, len(primaryKeys))
+	for _, pk := range primaryKeys {
+		selectCols = append(selectCols, goqu.C(pk))
+		orderBy = append(orderBy, goqu.C(pk).Asc())
+	}
+	for _, col := range columns {
+		selectCols = append(selectCols, goqu.C(col))
+	}
+
+	sourceQuery := goqu.Dialect(driver).From(goqu.S(schema).Table(table)).Select(selectCols...).Order(orderBy...).Limit(v.sampleSize)
+	if whereClause != nil && *whereClause != "" {
+		sourceQuery = sourceQuery.Where(goqu.L(*whereClause))
+	}
+	sourceRows, err := queryRows(ctx, sourceDb, sourceQuery)
+	if err != nil {
+		return nil, 0, err
+	}
+	unchanged = make([]int64, len(columns))
+	if len(sourceRows) == 0 {
+		return unchanged, 0, nil
+	}
+
+	sourceByKey := make(map[string][]any, len(sourceRows))
+	keyFilters := make([]exp.Expression, 0, len(sourceRows))
+	for _, row := range sourceRows {
+		sourceByKey[buildRowKey(row[:len(primaryKeys)])] = row[len(primaryKeys):]
+		keyFilter := make([]exp.Expression, 0, len(primaryKeys))
+		for idx, pk := range primaryKeys {
+			keyFilter = append(keyFilter, goqu.C(pk).Eq(row[idx]))
+		}
+		keyFilters = append(keyFilters, goqu.And(keyFilter...))
+	}
+
+	destQuery := goqu.Dialect(driver).From(goqu.S(schema).Table(table)).Select(selectCols...).Where(goqu.Or(keyFilters...))
+	destRows, err := queryRows(ctx, destDb, destQuery)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	for _, row := range destRows {
+		sourceValues, ok := sourceByKey[buildRowKey(row[:len(primaryKeys)])]
+		if !ok {
+			continue
+		}
+		sampled++
+		for idx, destValue := range row[len(primaryKeys):] {
+			if isEqualValue(sourceValues[idx], destValue) {
+				unchanged[idx]++
+			}
+		}
+	}
+	return unchanged, sampled, nil
+}
+
+func countRows(
+	ctx context.Context,
+	db sqlconnect.SqlDBTX,
+	driver, schema, table string,
+	whereClause *string,
+) (int64, error) {
+	query := goqu.Dialect(driver).From(goqu.S(schema).Table(table)).Select(goqu.COUNT(goqu.Star()))
+	if whereClause != nil && *whereClause != "" {
+		query = query.Where(goqu.L(*whereClause))
+	}
+	return queryCount(ctx, db, query)
+}
+
+// Counts the rows whose foreign key value does not resolve to a row in the referenced table
+func countOrphanedRows(
+	ctx context.Context,
+	db sqlconnect.SqlDBTX,
+	driver, schema, table, column string,
+	foreignSchema, foreignTable, foreignColumn string,
+) (int64, error) {
+	builder := goqu.Dialect(driver)
+	referenced := builder.
+		From(goqu.S(foreignSchema).Table(foreignTable).As("f")).
+		Select(goqu.L("1")).
+		Where(goqu.T("f").Col(foreignColumn).Eq(goqu.T("t").Col(column)))
+	query := builder.
+		From(goqu.S(schema).Table(table).As("t")).
+		Select(goqu.COUNT(goqu.Star())).
+		Where(
+			goqu.T("t").Col(column).IsNotNull(),
+			goqu.Func("NOT EXISTS", referenced),
+		)
+	return queryCount(ctx, db, query)
+}
+
+func queryCount(ctx context.Context, db sqlconnect.SqlDBTX, query *goqu.SelectDataset) (int64, error) {
+	stmt, args, err := query.Prepared(true).ToSQL()
+	if err != nil {
+		return 0, err
+	}
+	var count int64
+	err = db.QueryRowContext(ctx, stmt, args...).Scan(&count)
+	if err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+func queryRows(ctx context.Context, db sqlconnect.SqlDBTX, query *goqu.SelectDataset) ([][]any, error) {
+	stmt, args, err := query.Prepared(true).ToSQL()
+	if err != nil {
+		return nil, err
+	}
+	rows, err := db.QueryContext(ctx, stmt, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	columns, err := rows.Columns()
+	if err != nil {
+		return nil, err
+	}
+	output := [][]any{}
+	for rows.Next() {
+		values := make([]any, len(columns))
+		valuePtrs := make([]any, len(columns))
+		for i := range values {
+			valuePtrs[i] = &values[i]
+		}
+		if err := rows.Scan(valuePtrs...); err != nil {
+			return nil, err
+		}
+		for i, value := range values {
+			// drivers reuse byte slices between rows so they must be copied
+			if b, ok := value.([]byte); ok {
+				values[i] = string(b)
+			}
+		}
+		output = append(output, values)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return output, nil
+}
+
+func buildRowKey(values []any) string {
+	parts := make([]string, len(values))
+	for idx, value := range values {
+		parts[idx] = fmt.Sprintf("%v", value)
+	}
+	return strings.Join(parts, "\x00")
+}
+
+// Null values are never considered unchanged as transformers commonly preserve nulls
+func isEqualValue(sourceValue, destValue any) bool {
+	if sourceValue == nil || destValue == nil {
+		return false
+	}
+	return fmt.Sprintf("%v", sourceValue) == fmt.Sprintf("%v", destValue)
+}
+
+func isRowCountValid(sourceCount, destCount int64, isSubset bool, tableOpt *sourceTableOption) bool {
+	if tableOpt != nil && tableOpt.WatermarkColumn != nil {
+		// incrementally synced tables accumulate rows from previous runs
+		return true
+	}
+	if isSubset {
+		// subsetting by foreign key constraints can remove rows from tables that have no where clause of their own
+		return destCount <= sourceCount
+	}
+	return destCount == sourceCount
+}
+
+func isTransformed(mapping *mgmtv1alpha1.JobMapping) bool {
+	switch mapping.GetTransformer().GetSource() {
+	case mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_UNSPECIFIED,
+		mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_PASSTHROUGH,
+		mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_GENERATE_DEFAULT:
+		return false
+	default:
+		return true
+	}
+}
+
+func getColumnsSkippedReason(primaryKeys []string, mappedCols map[string]*mgmtv1alpha1.JobMapping) *string {
+	if len(primaryKeys) == 0 {
+		return shared.Ptr("table has no primary key to pair source and destination rows with")
+	}
+	for _, pk := range primaryKeys {
+		mapping, ok := mappedCols[pk]
+		if !ok {
+			return shared.Ptr(fmt.Sprintf("primary key column %s is not mapped", pk))
+		}
+		if isTransformed(mapping) {
+			return shared.Ptr(fmt.Sprintf("primary key column %s is transformed", pk))
+		}
+	}
+	return nil
+}
+
+// Returns the tables in the order they first appear in the mappings along with each table's mappings
+func groupMappingsByTable(mappings []*mgmtv1alpha1.JobMapping) ([]string, map[string][]*mgmtv1alpha1.JobMapping) {
+	tables := []string{}
+	tableMappings := map[string][]*mgmtv1alpha1.JobMapping{}
+	for _, mapping := range mappings {
+		table := dbschemas_utils.BuildTable(mapping.GetSchema(), mapping.GetTable())
+		if _, ok := tableMappings[table]; !ok {
+			tables = append(tables, table)
+		}
+		tableMappings[table] = append(tableMappings[table], mapping)
+	}
+	return tables, tableMappings
+}
+
+func splitTable(table string) (schema, name string) {
+	split := strings.SplitN(table, ".", 2)
+	if len(split) == 1 {
+		return "", split[0]
+	}
+	return split[0], split[1]
+}
+
+func getSourceInfo(options *mgmtv1alpha1.JobSourceOptions) *sourceInfo {
+	switch config := options.GetConfig().(type) {
+	case *mgmtv1alpha1.JobSourceOptions_Postgres:
+		info := &sourceInfo{
+			ConnectionId: config.Postgres.GetConnectionId(),
+			Driver:       postgresDriver,
+			TableOpts:    map[string]*sourceTableOption{},
+		}
+		for _, schemaOpt := range config.Postgres.GetSchemas() {
+			for _, tableOpt := range schemaOpt.GetTables() {
+				info.TableOpts[dbschemas_utils.BuildTable(schemaOpt.GetSchema(), tableOpt.GetTable())] = &sourceTableOption{
+					WhereClause:     tableOpt.WhereClause,
+					WatermarkColumn: tableOpt.WatermarkColumn,
+				}
+				if tableOpt.GetWhereClause() != "" {
+					info.IsSubset = true
+				}
+			}
+		}
+		return info
+	case *mgmtv1alpha1.JobSourceOptions_Mysql:
+		info := &sourceInfo{
+			ConnectionId: config.Mysql.GetConnectionId(),
+			Driver:       mysqlDriver,
+			TableOpts:    map[string]*sourceTableOption{},
+		}
+		for _, schemaOpt := range config.Mysql.GetSchemas() {
+			for _, tableOpt := range schemaOpt.GetTables() {
+				info.TableOpts[dbschemas_utils.BuildTable(schemaOpt.GetSchema(), tableOpt.GetTable())] = &sourceTableOption{
+					WhereClause:     tableOpt.WhereClause,
+					WatermarkColumn: tableOpt.WatermarkColumn,
+				}
+				if tableOpt.GetWhereClause() != "" {
+					info.IsSubset = true
+				}
+			}
+		}
+		return info
+	default:
+		return nil
+	}
+}
+
+func getConnectionDriver(config *mgmtv1alpha1.ConnectionConfig) string {
+	switch config.GetConfig().(type) {
+	case *mgmtv1alpha1.ConnectionConfig_PgConfig:
+		return postgresDriver
+	case *mgmtv1alpha1.ConnectionConfig_MysqlConfig:
+		return mysqlDriver
+	default:
+		return ""
+	}
+}
+
+func (v *jobRunValidator) getConnectionById(ctx context.Context, connectionId string) (*mgmtv1alpha1.Connection, error) {
+	getConnResp, err := v.connclient.GetConnection(ctx, connect.NewRequest(&mgmtv1alpha1.GetConnectionRequest{
+		Id: connectionId,
+	}))
+	if err != nil {
+		return nil, err
+	}
+	return getConnResp.Msg.Connection, nil
+}
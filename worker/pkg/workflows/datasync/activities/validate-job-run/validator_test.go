@@ -0,0 +1,213 @@
+package validatejobrun_activity
+
+import (
+	"context"
+	"log/slog"
+	"regexp"
+	"testing"
+
+	"connectrpc.com/connect"
+	"github.com/DATA-DOG/go-sqlmock"
+	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
+	"github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1/mgmtv1alpha1connect"
+	"github.com/nucleuscloud/neosync/backend/pkg/sqlconnect"
+	"github.com/nucleuscloud/neosync/worker/pkg/workflows/datasync/activities/shared"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_ValidateJobRun_Skips_GenerateSource(t *testing.T) {
+	mockJobClient := mgmtv1alpha1connect.NewMockJobServiceClient(t)
+	mockConnectionClient := mgmtv1alpha1connect.NewMockConnectionServiceClient(t)
+	mockConnectionDataClient := mgmtv1alpha1connect.NewMockConnectionDataServiceClient(t)
+	mockSqlConnector := sqlconnect.NewMockSqlConnector(t)
+
+	mockJobClient.On("GetJob", mock.Anything, mock.Anything).
+		Return(connect.NewResponse(&mgmtv1alpha1.GetJobResponse{
+			Job: &mgmtv1alpha1.Job{
+				Source: &mgmtv1alpha1.JobSource{
+					Options: &mgmtv1alpha1.JobSourceOptions{
+						Config: &mgmtv1alpha1.JobSourceOptions_Generate{
+							Generate: &mgmtv1alpha1.GenerateSourceOptions{},
+						},
+					},
+				},
+			},
+		}), nil)
+
+	validator := newJobRunValidator(mockJobClient, mockConnectionClient, mockConnectionDataClient, mockSqlConnector, defaultColumnSampleSize)
+	resp, err := validator.ValidateJobRun(context.Background(), &ValidateJobRunRequest{JobId: "job-id", JobRunId: "run-id"}, slog.Default())
+
+	require.NoError(t, err)
+	assert.True(t, resp.Skipped)
+	mockJobClient.AssertNotCalled(t, "SetJobRunValidationReport", mock.Anything, mock.Anything)
+}
+
+func Test_ValidateJobRun_Postgres(t *testing.T) {
+	mockJobClient := mgmtv1alpha1connect.NewMockJobServiceClient(t)
+	mockConnectionClient := mgmtv1alpha1connect.NewMockConnectionServiceClient(t)
+	mockConnectionDataClient := mgmtv1alpha1connect.NewMockConnectionDataServiceClient(t)
+	mockSqlConnector := sqlconnect.NewMockSqlConnector(t)
+	sourceContainer := sqlconnect.NewMockSqlDbContainer(t)
+	destContainer := sqlconnect.NewMockSqlDbContainer(t)
+
+	sourceDb, sourceMock, err := sqlmock.New(sqlmock.MonitorPingsOption(false))
+	require.NoError(t, err)
+	destDb, destMock, err := sqlmock.New(sqlmock.MonitorPingsOption(false))
+	require.NoError(t, err)
+
+	sourceConfig := &mgmtv1alpha1.ConnectionConfig{
+		Config: &mgmtv1alpha1.ConnectionConfig_PgConfig{
+			PgConfig: &mgmtv1alpha1.PostgresConnectionConfig{
+				ConnectionConfig: &mgmtv1alpha1.PostgresConnectionConfig_Url{Url: "source-url"},
+			},
+		},
+	}
+	destConfig := &mgmtv1alpha1.ConnectionConfig{
+		Config: &mgmtv1alpha1.ConnectionConfig_PgConfig{
+			PgConfig: &mgmtv1alpha1.PostgresConnectionConfig{
+				ConnectionConfig: &mgmtv1alpha1.PostgresConnectionConfig_Url{Url: "dest-url"},
+			},
+		},
+	}
+
+	mockJobClient.On("GetJob", mock.Anything, mock.Anything).
+		Return(connect.NewResponse(&mgmtv1alpha1.GetJobResponse{
+			Job: &mgmtv1alpha1.Job{
+				Source: &mgmtv1alpha1.JobSource{
+					Options: &mgmtv1alpha1.JobSourceOptions{
+						Config: &mgmtv1alpha1.JobSourceOptions_Postgres{
+							Postgres: &mgmtv1alpha1.PostgresSourceConnectionOptions{
+								ConnectionId: "source-id",
+							},
+						},
+					},
+				},
+				Destinations: []*mgmtv1alpha1.JobDestination{
+					{ConnectionId: "dest-id"},
+				},
+				Mappings: []*mgmtv1alpha1.JobMapping{
+					{Schema: "public", Table: "users", Column: "id", Transformer: &mgmtv1alpha1.JobMappingTransformer{Source: mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_PASSTHROUGH}},
+					{Schema: "public", Table: "users", Column: "email", Transformer: &mgmtv1alpha1.JobMappingTransformer{Source: mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_TRANSFORM_EMAIL}},
+					{Schema: "public", Table: "orders", Column: "id", Transformer: &mgmtv1alpha1.JobMappingTransformer{Source: mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_PASSTHROUGH}},
+					{Schema: "public", Table: "orders", Column: "user_id", Transformer: &mgmtv1alpha1.JobMappingTransformer{Source: mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_PASSTHROUGH}},
+				},
+			},
+		}), nil)
+	mockConnectionClient.On("GetConnection", mock.Anything, connect.NewRequest(&mgmtv1alpha1.GetConnectionRequest{Id: "dest-id"})).
+		Return(connect.NewResponse(&mgmtv1alpha1.GetConnectionResponse{
+			Connection: &mgmtv1alpha1.Connection{Id: "dest-id", ConnectionConfig: destConfig},
+		}), nil)
+	mockConnectionClient.On("GetConnection", mock.Anything, connect.NewRequest(&mgmtv1alpha1.GetConnectionRequest{Id: "source-id"})).
+		Return(connect.NewResponse(&mgmtv1alpha1.GetConnectionResponse{
+			Connection: &mgmtv1alpha1.Connection{Id: "source-id", ConnectionConfig: sourceConfig},
+		}), nil)
+	mockConnectionDataClient.On("GetConnectionForeignConstraints", mock.Anything, mock.Anything).
+		Return(connect.NewResponse(&mgmtv1alpha1.GetConnectionForeignConstraintsResponse{
+			TableConstraints: map[string]*mgmtv1alpha1.ForeignConstraintTables{
+				"public.orders": {Constraints: []*mgmtv1alpha1.ForeignConstraint{
+					{Column: "user_id", ForeignKey: &mgmtv1alpha1.ForeignKey{Table: "public.users", Column: "id"}},
+				}},
+			},
+		}), nil)
+	mockConnectionDataClient.On("GetConnectionPrimaryConstraints", mock.Anything, mock.Anything).
+		Return(connect.NewResponse(&mgmtv1alpha1.GetConnectionPrimaryConstraintsResponse{
+			TableConstraints: map[string]*mgmtv1alpha1.PrimaryConstraint{
+				"public.users":  {Columns: []string{"id"}},
+				"public.orders": {Columns: []string{"id"}},
+			},
+		}), nil)
+
+	mockSqlConnector.On("NewDbFromConnectionConfig", sourceConfig, shared.Ptr(uint32(5)), mock.Anything).Return(sourceContainer, nil)
+	mockSqlConnector.On("NewDbFromConnectionConfig", destConfig, shared.Ptr(uint32(5)), mock.Anything).Return(destContainer, nil)
+	sourceContainer.On("Open").Return(sourceDb, nil)
+	sourceContainer.On("Close").Return(nil)
+	destContainer.On("Open").Return(destDb, nil)
+	destContainer.On("Close").Return(nil)
+
+	// users
+	sourceMock.ExpectQuery(regexp.QuoteMeta(`SELECT COUNT(*) FROM "public"."users"`)).
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(2))
+	destMock.ExpectQuery(regexp.QuoteMeta(`SELECT COUNT(*) FROM "public"."users"`)).
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(2))
+	sourceMock.ExpectQuery(regexp.QuoteMeta(`SELECT "id", "email" FROM "public"."users" ORDER BY "id" ASC LIMIT $1`)).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "email"}).AddRow(1, "alice@example.com").AddRow(2, "bob@example.com"))
+	destMock.ExpectQuery(regexp.QuoteMeta(`SELECT "id", "email" FROM "public"."users" WHERE (("id" = $1) OR ("id" = $2))`)).
+		WithArgs(1, 2).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "email"}).AddRow(1, "xkq@example.com").AddRow(2, "bob@example.com"))
+
+	// orders
+	sourceMock.ExpectQuery(regexp.QuoteMeta(`SELECT COUNT(*) FROM "public"."orders"`)).
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(3))
+	destMock.ExpectQuery(regexp.QuoteMeta(`SELECT COUNT(*) FROM "public"."orders"`)).
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(3))
+	destMock.ExpectQuery(regexp.QuoteMeta(`SELECT COUNT(*) FROM "public"."orders" AS "t" WHERE (("t"."user_id" IS NOT NULL) AND NOT EXISTS((SELECT 1 FROM "public"."users" AS "f" WHERE ("f"."id" = "t"."user_id"))))`)).
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(0))
+
+	mockJobClient.On("SetJobRunValidationReport", mock.Anything, connect.NewRequest(&mgmtv1alpha1.SetJobRunValidationReportRequest{
+		JobId:    "job-id",
+		JobRunId: "run-id",
+		Tables: []*mgmtv1alpha1.JobRunTableValidation{
+			{
+				DestinationConnectionId: "dest-id",
+				Schema:                  "public",
+				Table:                   "users",
+				SourceRowCount:          2,
+				DestinationRowCount:     2,
+				ForeignKeys:             []*mgmtv1alpha1.JobRunForeignKeyValidation{},
+				Columns: []*mgmtv1alpha1.JobRunColumnValidation{
+					{Column: "email", TransformerSource: mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_TRANSFORM_EMAIL, SampledRowCount: 2, UnchangedRowCount: 1},
+				},
+				Passed: false,
+			},
+			{
+				DestinationConnectionId: "dest-id",
+				Schema:                  "public",
+				Table:                   "orders",
+				SourceRowCount:          3,
+				DestinationRowCount:     3,
+				ForeignKeys: []*mgmtv1alpha1.JobRunForeignKeyValidation{
+					{Column: "user_id", ForeignSchema: "public", ForeignTable: "users", ForeignColumn: "id", OrphanedRowCount: 0},
+				},
+				Columns: []*mgmtv1alpha1.JobRunColumnValidation{},
+				Passed:  true,
+			},
+		},
+	})).Return(connect.NewResponse(&mgmtv1alpha1.SetJobRunValidationReportResponse{}), nil)
+
+	validator := newJobRunValidator(mockJobClient, mockConnectionClient, mockConnectionDataClient, mockSqlConnector, defaultColumnSampleSize)
+	resp, err := validator.ValidateJobRun(context.Background(), &ValidateJobRunRequest{JobId: "job-id", JobRunId: "run-id"}, slog.Default())
+
+	require.NoError(t, err)
+	assert.False(t, resp.Skipped)
+	assert.False(t, resp.Passed)
+	assert.NoError(t, sourceMock.ExpectationsWereMet())
+	assert.NoError(t, destMock.ExpectationsWereMet())
+}
+
+func Test_isRowCountValid(t *testing.T) {
+	assert.True(t, isRowCountValid(10, 10, false, nil))
+	assert.False(t, isRowCountValid(10, 9, false, nil))
+	assert.True(t, isRowCountValid(10, 9, true, nil))
+	assert.False(t, isRowCountValid(10, 11, true, nil))
+	assert.True(t, isRowCountValid(10, 25, false, &sourceTableOption{WatermarkColumn: shared.Ptr("updated_at")}))
+}
+
+func Test_getColumnsSkippedReason(t *testing.T) {
+	passthrough := &mgmtv1alpha1.JobMapping{Column: "id", Transformer: &mgmtv1alpha1.JobMappingTransformer{Source: mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_PASSTHROUGH}}
+	transformed := &mgmtv1alpha1.JobMapping{Column: "id", Transformer: &mgmtv1alpha1.JobMappingTransformer{Source: mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_GENERATE_UUID}}
+
+	assert.NotNil(t, getColumnsSkippedReason(nil, map[string]*mgmtv1alpha1.JobMapping{"id": passthrough}))
+	assert.NotNil(t, getColumnsSkippedReason([]string{"id"}, map[string]*mgmtv1alpha1.JobMapping{}))
+	assert.NotNil(t, getColumnsSkippedReason([]string{"id"}, map[string]*mgmtv1alpha1.JobMapping{"id": transformed}))
+	assert.Nil(t, getColumnsSkippedReason([]string{"id"}, map[string]*mgmtv1alpha1.JobMapping{"id": passthrough}))
+}
+
+func Test_isEqualValue(t *testing.T) {
+	assert.True(t, isEqualValue("foo", "foo"))
+	assert.True(t, isEqualValue(int64(1), int64(1)))
+	assert.False(t, isEqualValue("foo", "bar"))
+	assert.False(t, isEqualValue(nil, nil))
+	assert.False(t, isEqualValue("foo", nil))
+}
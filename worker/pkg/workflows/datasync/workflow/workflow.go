@@ -7,13 +7,18 @@ import (
 	"sync"
 	"time"
 
+	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
 	tabledependency "github.com/nucleuscloud/neosync/backend/pkg/table-dependency"
 	genbenthosconfigs_activity "github.com/nucleuscloud/neosync/worker/pkg/workflows/datasync/activities/gen-benthos-configs"
+	publishjobrunevent_activity "github.com/nucleuscloud/neosync/worker/pkg/workflows/datasync/activities/publish-job-run-event"
 	runsqlinittablestmts_activity "github.com/nucleuscloud/neosync/worker/pkg/workflows/datasync/activities/run-sql-init-table-stmts"
 	"github.com/nucleuscloud/neosync/worker/pkg/workflows/datasync/activities/shared"
 	sync_activity "github.com/nucleuscloud/neosync/worker/pkg/workflows/datasync/activities/sync"
 	syncactivityopts_activity "github.com/nucleuscloud/neosync/worker/pkg/workflows/datasync/activities/sync-activity-opts"
+	syncjobwatermarks_activity "github.com/nucleuscloud/neosync/worker/pkg/workflows/datasync/activities/sync-job-watermarks"
 	syncrediscleanup_activity "github.com/nucleuscloud/neosync/worker/pkg/workflows/datasync/activities/sync-redis-clean-up"
+	triggerdependentjobs_activity "github.com/nucleuscloud/neosync/worker/pkg/workflows/datasync/activities/trigger-dependent-jobs"
+	validatejobrun_activity "github.com/nucleuscloud/neosync/worker/pkg/workflows/datasync/activities/validate-job-run"
 	"go.temporal.io/sdk/log"
 	"go.temporal.io/sdk/temporal"
 
@@ -29,6 +34,25 @@ type WorkflowResponse struct{}
 
 func Workflow(wfctx workflow.Context, req *WorkflowRequest) (*WorkflowResponse, error) {
 	wfinfo := workflow.GetInfo(wfctx)
+	logger := log.With(workflow.GetLogger(wfctx), "jobId", req.JobId)
+
+	publishJobRunEvent(wfctx, logger, req.JobId, wfinfo.WorkflowExecution.ID, mgmtv1alpha1.WebhookEventType_WEBHOOK_EVENT_TYPE_JOB_RUN_STARTED, nil)
+	resp, err := executeWorkflow(wfctx, req)
+
+	// the outcome is published from a disconnected context so that it is still sent when the run was canceled
+	eventType, reason := getJobRunOutcome(wfctx, err)
+	disconnectedCtx, cancel := workflow.NewDisconnectedContext(wfctx)
+	defer cancel()
+	publishJobRunEvent(disconnectedCtx, logger, req.JobId, wfinfo.WorkflowExecution.ID, eventType, reason)
+	// a canceled run neither succeeded nor failed so it does not start any dependent jobs
+	if eventType != mgmtv1alpha1.WebhookEventType_WEBHOOK_EVENT_TYPE_JOB_RUN_CANCELED {
+		triggerDependentJobs(disconnectedCtx, logger, req.JobId, wfinfo.WorkflowExecution.ID, err == nil)
+	}
+	return resp, err
+}
+
+func executeWorkflow(wfctx workflow.Context, req *WorkflowRequest) (*WorkflowResponse, error) {
+	wfinfo := workflow.GetInfo(wfctx)
 
 	ctx := workflow.WithActivityOptions(wfctx, workflow.ActivityOptions{
 		StartToCloseTimeout: 5 * time.Minute,
@@ -113,7 +137,7 @@ func Workflow(wfctx workflow.Context, req *WorkflowRequest) (*WorkflowResponse,
 	}
 	for _, bc := range splitConfigs.Root {
 		bc := bc
-		future := invokeSync(bc, childctx, &started, &completed, logger)
+		future := invokeSync(bc, bcResp.AccountId, childctx, &started, &completed, logger)
 		workselector.AddFuture(future, func(f workflow.Future) {
 			logger := log.With(logger, withBenthosConfigResponseLoggerTags(bc)...)
 			logger.Info("config sync completed")
@@ -163,7 +187,7 @@ func Workflow(wfctx workflow.Context, req *WorkflowRequest) (*WorkflowResponse,
 				continue
 			}
 
-			future := invokeSync(bc, childctx, &started, &completed, logger)
+			future := invokeSync(bc, bcResp.AccountId, childctx, &started, &completed, logger)
 			workselector.AddFuture(future, func(f workflow.Future) {
 				logger.Info("config sync completed", "name", bc.Name)
 				var result sync_activity.SyncResponse
@@ -186,11 +210,112 @@ func Workflow(wfctx workflow.Context, req *WorkflowRequest) (*WorkflowResponse,
 			})
 		}
 	}
+
+	if len(bcResp.Watermarks) > 0 {
+		// watermarks are only recorded once every table has synced so that a failed run is retried from the previous high-water mark
+		ctx = workflow.WithActivityOptions(wfctx, workflow.ActivityOptions{
+			StartToCloseTimeout: 1 * time.Minute,
+			RetryPolicy: &temporal.RetryPolicy{
+				MaximumAttempts: 2,
+			},
+			HeartbeatTimeout: 1 * time.Minute,
+		})
+		logger.Info("scheduling SetJobRunWatermarks for execution.")
+		var wmResp *syncjobwatermarks_activity.SetJobRunWatermarksResponse
+		err = workflow.ExecuteActivity(ctx, syncjobwatermarks_activity.SetJobRunWatermarks, &syncjobwatermarks_activity.SetJobRunWatermarksRequest{
+			JobId:      req.JobId,
+			JobRunId:   wfinfo.WorkflowExecution.ID,
+			Watermarks: bcResp.Watermarks,
+		}).Get(ctx, &wmResp)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// the run fails if its validation report could not be recorded, a report that finds issues only logs a warning
+	ctx = workflow.WithActivityOptions(wfctx, *actOptResp.SyncActivityOptions)
+	logger.Info("scheduling ValidateJobRun for execution.")
+	var validateResp *validatejobrun_activity.ValidateJobRunResponse
+	err = workflow.ExecuteActivity(ctx, validatejobrun_activity.ValidateJobRun, &validatejobrun_activity.ValidateJobRunRequest{
+		JobId:    req.JobId,
+		JobRunId: wfinfo.WorkflowExecution.ID,
+	}).Get(ctx, &validateResp)
+	if err != nil {
+		return nil, fmt.Errorf("unable to validate job run: %w", err)
+	}
+	if !validateResp.Skipped && !validateResp.Passed {
+		logger.Warn("job run validation found issues with the synced data")
+	}
 	logger.Info("data sync workflow completed")
 
 	return &WorkflowResponse{}, nil
 }
 
+// Returns the event that describes how the run ended, along with the reason if it did not succeed
+func getJobRunOutcome(wfctx workflow.Context, err error) (mgmtv1alpha1.WebhookEventType, *string) {
+	if err == nil {
+		return mgmtv1alpha1.WebhookEventType_WEBHOOK_EVENT_TYPE_JOB_RUN_SUCCEEDED, nil
+	}
+	reason := err.Error()
+	if temporal.IsCanceledError(err) || wfctx.Err() != nil {
+		return mgmtv1alpha1.WebhookEventType_WEBHOOK_EVENT_TYPE_JOB_RUN_CANCELED, &reason
+	}
+	return mgmtv1alpha1.WebhookEventType_WEBHOOK_EVENT_TYPE_JOB_RUN_FAILED, &reason
+}
+
+// Webhook notifications are best effort, failing to publish an event never fails the run
+func publishJobRunEvent(
+	wfctx workflow.Context,
+	logger log.Logger,
+	jobId, jobRunId string,
+	eventType mgmtv1alpha1.WebhookEventType,
+	reason *string,
+) {
+	ctx := workflow.WithActivityOptions(wfctx, workflow.ActivityOptions{
+		StartToCloseTimeout: 1 * time.Minute,
+		RetryPolicy: &temporal.RetryPolicy{
+			MaximumAttempts: 3,
+		},
+	})
+	logger.Debug("scheduling PublishJobRunEvent for execution.", "eventType", eventType.String())
+	var resp *publishjobrunevent_activity.PublishJobRunEventResponse
+	err := workflow.ExecuteActivity(ctx, publishjobrunevent_activity.PublishJobRunEvent, &publishjobrunevent_activity.PublishJobRunEventRequest{
+		JobId:     jobId,
+		JobRunId:  jobRunId,
+		EventType: eventType,
+		Error:     reason,
+	}).Get(ctx, &resp)
+	if err != nil {
+		logger.Warn("unable to publish job run event", "eventType", eventType.String(), "err", err)
+	}
+}
+
+// Starting the dependent jobs is best effort, failing to start them never fails the run.
+// The activity is not retried as a retry could start a dependent job more than once
+func triggerDependentJobs(
+	wfctx workflow.Context,
+	logger log.Logger,
+	jobId, jobRunId string,
+	succeeded bool,
+) {
+	ctx := workflow.WithActivityOptions(wfctx, workflow.ActivityOptions{
+		StartToCloseTimeout: 1 * time.Minute,
+		RetryPolicy: &temporal.RetryPolicy{
+			MaximumAttempts: 1,
+		},
+	})
+	logger.Debug("scheduling TriggerDependentJobs for execution.", "succeeded", succeeded)
+	var resp *triggerdependentjobs_activity.TriggerDependentJobsResponse
+	err := workflow.ExecuteActivity(ctx, triggerdependentjobs_activity.TriggerDependentJobs, &triggerdependentjobs_activity.TriggerDependentJobsRequest{
+		JobId:     jobId,
+		JobRunId:  jobRunId,
+		Succeeded: succeeded,
+	}).Get(ctx, &resp)
+	if err != nil {
+		logger.Warn("unable to trigger dependent jobs", "err", err)
+	}
+}
+
 func runRedisCleanUpActivity(
 	wfctx workflow.Context,
 	logger log.Logger,
@@ -256,6 +381,7 @@ func getSyncMetadata(config *genbenthosconfigs_activity.BenthosConfigResponse) *
 
 func invokeSync(
 	config *genbenthosconfigs_activity.BenthosConfigResponse,
+	accountId string,
 	ctx workflow.Context,
 	started, completed *sync.Map,
 	logger log.Logger,
@@ -280,7 +406,7 @@ func invokeSync(
 		err = workflow.ExecuteActivity(
 			ctx,
 			activity.Sync,
-			&sync_activity.SyncRequest{BenthosConfig: string(configbits), BenthosDsns: config.BenthosDsns}, metadata).Get(ctx, &result)
+			&sync_activity.SyncRequest{BenthosConfig: string(configbits), BenthosDsns: config.BenthosDsns, AccountId: accountId}, metadata).Get(ctx, &result)
 		if err == nil {
 			tn := fmt.Sprintf("%s.%s", config.TableSchema, config.TableName)
 			err = updateCompletedMap(tn, completed, config.Columns)
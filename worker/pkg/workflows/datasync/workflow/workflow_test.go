@@ -7,13 +7,19 @@ import (
 	"testing"
 	"time"
 
+	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
 	tabledependency "github.com/nucleuscloud/neosync/backend/pkg/table-dependency"
 	neosync_benthos "github.com/nucleuscloud/neosync/worker/internal/benthos"
 	genbenthosconfigs_activity "github.com/nucleuscloud/neosync/worker/pkg/workflows/datasync/activities/gen-benthos-configs"
+	publishjobrunevent_activity "github.com/nucleuscloud/neosync/worker/pkg/workflows/datasync/activities/publish-job-run-event"
 	runsqlinittablestmts_activity "github.com/nucleuscloud/neosync/worker/pkg/workflows/datasync/activities/run-sql-init-table-stmts"
+	"github.com/nucleuscloud/neosync/worker/pkg/workflows/datasync/activities/shared"
 	sync_activity "github.com/nucleuscloud/neosync/worker/pkg/workflows/datasync/activities/sync"
 	syncactivityopts_activity "github.com/nucleuscloud/neosync/worker/pkg/workflows/datasync/activities/sync-activity-opts"
+	syncjobwatermarks_activity "github.com/nucleuscloud/neosync/worker/pkg/workflows/datasync/activities/sync-job-watermarks"
 	syncrediscleanup_activity "github.com/nucleuscloud/neosync/worker/pkg/workflows/datasync/activities/sync-redis-clean-up"
+	triggerdependentjobs_activity "github.com/nucleuscloud/neosync/worker/pkg/workflows/datasync/activities/trigger-dependent-jobs"
+	validatejobrun_activity "github.com/nucleuscloud/neosync/worker/pkg/workflows/datasync/activities/validate-job-run"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"go.temporal.io/sdk/temporal"
@@ -90,6 +96,9 @@ func Test_Workflow_Succeeds_SingleSync(t *testing.T) {
 	syncActivity := sync_activity.Activity{}
 	env.OnActivity(syncActivity.Sync, mock.Anything, mock.Anything, mock.Anything).Return(&sync_activity.SyncResponse{}, nil)
 
+	env.OnActivity(validatejobrun_activity.ValidateJobRun, mock.Anything, mock.Anything).
+		Return(&validatejobrun_activity.ValidateJobRunResponse{Skipped: true}, nil)
+
 	env.ExecuteWorkflow(Workflow, &WorkflowRequest{})
 
 	assert.True(t, env.IsWorkflowCompleted())
@@ -105,6 +114,241 @@ func Test_Workflow_Succeeds_SingleSync(t *testing.T) {
 	env.AssertExpectations(t)
 }
 
+func Test_Workflow_Sets_Watermarks(t *testing.T) {
+	testSuite := &testsuite.WorkflowTestSuite{}
+	env := testSuite.NewTestWorkflowEnvironment()
+
+	watermarks := []*shared.JobRunWatermark{
+		{Schema: "public", Table: "users", Column: "updated_at", EndValue: "2024-01-01"},
+	}
+	var genact *genbenthosconfigs_activity.Activity
+	env.OnActivity(genact.GenerateBenthosConfigs, mock.Anything, mock.Anything).
+		Return(&genbenthosconfigs_activity.GenerateBenthosConfigsResponse{
+			BenthosConfigs: []*genbenthosconfigs_activity.BenthosConfigResponse{
+				{
+					Name:      "public.users",
+					DependsOn: []*tabledependency.DependsOn{},
+					Config:    &neosync_benthos.BenthosConfig{},
+				},
+			},
+			Watermarks: watermarks,
+		}, nil)
+	env.OnActivity(syncactivityopts_activity.RetrieveActivityOptions, mock.Anything, mock.Anything, mock.Anything).
+		Return(&syncactivityopts_activity.RetrieveActivityOptionsResponse{
+			SyncActivityOptions: &workflow.ActivityOptions{
+				StartToCloseTimeout: time.Minute,
+			},
+		}, nil)
+	env.OnActivity(runsqlinittablestmts_activity.RunSqlInitTableStatements, mock.Anything, mock.Anything).
+		Return(&runsqlinittablestmts_activity.RunSqlInitTableStatementsResponse{}, nil)
+	syncActivity := sync_activity.Activity{}
+	env.OnActivity(syncActivity.Sync, mock.Anything, mock.Anything, mock.Anything).Return(&sync_activity.SyncResponse{}, nil)
+	env.OnActivity(syncjobwatermarks_activity.SetJobRunWatermarks, mock.Anything, mock.MatchedBy(func(req *syncjobwatermarks_activity.SetJobRunWatermarksRequest) bool {
+		return req.JobId == "job-id" && len(req.Watermarks) == 1 && req.Watermarks[0].EndValue == "2024-01-01"
+	})).Return(&syncjobwatermarks_activity.SetJobRunWatermarksResponse{}, nil).Once()
+
+	env.OnActivity(validatejobrun_activity.ValidateJobRun, mock.Anything, mock.Anything).
+		Return(&validatejobrun_activity.ValidateJobRunResponse{Skipped: true}, nil)
+
+	env.ExecuteWorkflow(Workflow, &WorkflowRequest{JobId: "job-id"})
+
+	assert.True(t, env.IsWorkflowCompleted())
+
+	err := env.GetWorkflowError()
+	assert.Nil(t, err)
+
+	env.AssertExpectations(t)
+}
+
+func Test_Workflow_Validates_JobRun(t *testing.T) {
+	testSuite := &testsuite.WorkflowTestSuite{}
+	env := testSuite.NewTestWorkflowEnvironment()
+
+	var genact *genbenthosconfigs_activity.Activity
+	env.OnActivity(genact.GenerateBenthosConfigs, mock.Anything, mock.Anything).
+		Return(&genbenthosconfigs_activity.GenerateBenthosConfigsResponse{BenthosConfigs: []*genbenthosconfigs_activity.BenthosConfigResponse{
+			{
+				Name:      "public.users",
+				DependsOn: []*tabledependency.DependsOn{},
+				Config:    &neosync_benthos.BenthosConfig{},
+			},
+		}}, nil)
+	env.OnActivity(syncactivityopts_activity.RetrieveActivityOptions, mock.Anything, mock.Anything, mock.Anything).
+		Return(&syncactivityopts_activity.RetrieveActivityOptionsResponse{
+			SyncActivityOptions: &workflow.ActivityOptions{
+				StartToCloseTimeout: time.Minute,
+			},
+		}, nil)
+	env.OnActivity(runsqlinittablestmts_activity.RunSqlInitTableStatements, mock.Anything, mock.Anything).
+		Return(&runsqlinittablestmts_activity.RunSqlInitTableStatementsResponse{}, nil)
+	syncActivity := sync_activity.Activity{}
+	env.OnActivity(syncActivity.Sync, mock.Anything, mock.Anything, mock.Anything).Return(&sync_activity.SyncResponse{}, nil)
+	env.OnActivity(validatejobrun_activity.ValidateJobRun, mock.Anything, mock.MatchedBy(func(req *validatejobrun_activity.ValidateJobRunRequest) bool {
+		return req.JobId == "job-id" && req.JobRunId != ""
+	})).Return(&validatejobrun_activity.ValidateJobRunResponse{Passed: false}, nil).Once()
+
+	env.ExecuteWorkflow(Workflow, &WorkflowRequest{JobId: "job-id"})
+
+	assert.True(t, env.IsWorkflowCompleted())
+
+	err := env.GetWorkflowError()
+	assert.Nil(t, err)
+
+	env.AssertExpectations(t)
+}
+
+func Test_Workflow_Fails_When_Validation_Fails(t *testing.T) {
+	testSuite := &testsuite.WorkflowTestSuite{}
+	env := testSuite.NewTestWorkflowEnvironment()
+
+	var genact *genbenthosconfigs_activity.Activity
+	env.OnActivity(genact.GenerateBenthosConfigs, mock.Anything, mock.Anything).
+		Return(&genbenthosconfigs_activity.GenerateBenthosConfigsResponse{BenthosConfigs: []*genbenthosconfigs_activity.BenthosConfigResponse{
+			{
+				Name:      "public.users",
+				DependsOn: []*tabledependency.DependsOn{},
+				Config:    &neosync_benthos.BenthosConfig{},
+			},
+		}}, nil)
+	env.OnActivity(syncactivityopts_activity.RetrieveActivityOptions, mock.Anything, mock.Anything, mock.Anything).
+		Return(&syncactivityopts_activity.RetrieveActivityOptionsResponse{
+			SyncActivityOptions: &workflow.ActivityOptions{
+				StartToCloseTimeout: time.Minute,
+			},
+		}, nil)
+	env.OnActivity(runsqlinittablestmts_activity.RunSqlInitTableStatements, mock.Anything, mock.Anything).
+		Return(&runsqlinittablestmts_activity.RunSqlInitTableStatementsResponse{}, nil)
+	syncActivity := sync_activity.Activity{}
+	env.OnActivity(syncActivity.Sync, mock.Anything, mock.Anything, mock.Anything).Return(&sync_activity.SyncResponse{}, nil)
+	env.OnActivity(validatejobrun_activity.ValidateJobRun, mock.Anything, mock.Anything).
+		Return(nil, errors.New("unable to connect to destination")).Once()
+
+	env.ExecuteWorkflow(Workflow, &WorkflowRequest{JobId: "job-id"})
+
+	assert.True(t, env.IsWorkflowCompleted())
+
+	err := env.GetWorkflowError()
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "unable to validate job run")
+
+	env.AssertExpectations(t)
+}
+
+func Test_Workflow_Publishes_JobRunEvents(t *testing.T) {
+	testSuite := &testsuite.WorkflowTestSuite{}
+	env := testSuite.NewTestWorkflowEnvironment()
+
+	var genact *genbenthosconfigs_activity.Activity
+	env.OnActivity(genact.GenerateBenthosConfigs, mock.Anything, mock.Anything).
+		Return(&genbenthosconfigs_activity.GenerateBenthosConfigsResponse{BenthosConfigs: []*genbenthosconfigs_activity.BenthosConfigResponse{
+			{
+				Name:      "public.users",
+				DependsOn: []*tabledependency.DependsOn{},
+				Config:    &neosync_benthos.BenthosConfig{},
+			},
+		}}, nil)
+	env.OnActivity(syncactivityopts_activity.RetrieveActivityOptions, mock.Anything, mock.Anything, mock.Anything).
+		Return(&syncactivityopts_activity.RetrieveActivityOptionsResponse{
+			SyncActivityOptions: &workflow.ActivityOptions{
+				StartToCloseTimeout: time.Minute,
+			},
+		}, nil)
+	env.OnActivity(runsqlinittablestmts_activity.RunSqlInitTableStatements, mock.Anything, mock.Anything).
+		Return(&runsqlinittablestmts_activity.RunSqlInitTableStatementsResponse{}, nil)
+	syncActivity := sync_activity.Activity{}
+	env.OnActivity(syncActivity.Sync, mock.Anything, mock.Anything, mock.Anything).Return(&sync_activity.SyncResponse{}, nil)
+
+	events := []mgmtv1alpha1.WebhookEventType{}
+	env.OnActivity(publishjobrunevent_activity.PublishJobRunEvent, mock.Anything, mock.MatchedBy(func(req *publishjobrunevent_activity.PublishJobRunEventRequest) bool {
+		return req.JobId == "job-id" && req.JobRunId != ""
+	})).Run(func(args mock.Arguments) {
+		req := args.Get(1).(*publishjobrunevent_activity.PublishJobRunEventRequest)
+		events = append(events, req.EventType)
+	}).Return(&publishjobrunevent_activity.PublishJobRunEventResponse{}, nil).Twice()
+	env.OnActivity(triggerdependentjobs_activity.TriggerDependentJobs, mock.Anything, mock.MatchedBy(func(req *triggerdependentjobs_activity.TriggerDependentJobsRequest) bool {
+		return req.JobId == "job-id" && req.JobRunId != "" && req.Succeeded
+	})).Return(&triggerdependentjobs_activity.TriggerDependentJobsResponse{}, nil).Once()
+
+	env.OnActivity(validatejobrun_activity.ValidateJobRun, mock.Anything, mock.Anything).
+		Return(&validatejobrun_activity.ValidateJobRunResponse{Skipped: true}, nil)
+
+	env.ExecuteWorkflow(Workflow, &WorkflowRequest{JobId: "job-id"})
+
+	assert.True(t, env.IsWorkflowCompleted())
+	assert.Nil(t, env.GetWorkflowError())
+	assert.Equal(t, []mgmtv1alpha1.WebhookEventType{
+		mgmtv1alpha1.WebhookEventType_WEBHOOK_EVENT_TYPE_JOB_RUN_STARTED,
+		mgmtv1alpha1.WebhookEventType_WEBHOOK_EVENT_TYPE_JOB_RUN_SUCCEEDED,
+	}, events)
+
+	env.AssertExpectations(t)
+}
+
+func Test_Workflow_Publishes_Failed_JobRunEvent(t *testing.T) {
+	testSuite := &testsuite.WorkflowTestSuite{}
+	env := testSuite.NewTestWorkflowEnvironment()
+
+	var genact *genbenthosconfigs_activity.Activity
+	env.OnActivity(genact.GenerateBenthosConfigs, mock.Anything, mock.Anything).Return(nil, errors.New("TestFailure"))
+	env.OnActivity(publishjobrunevent_activity.PublishJobRunEvent, mock.Anything, mock.MatchedBy(func(req *publishjobrunevent_activity.PublishJobRunEventRequest) bool {
+		return req.EventType == mgmtv1alpha1.WebhookEventType_WEBHOOK_EVENT_TYPE_JOB_RUN_STARTED
+	})).Return(&publishjobrunevent_activity.PublishJobRunEventResponse{}, nil).Once()
+	env.OnActivity(publishjobrunevent_activity.PublishJobRunEvent, mock.Anything, mock.MatchedBy(func(req *publishjobrunevent_activity.PublishJobRunEventRequest) bool {
+		return req.EventType == mgmtv1alpha1.WebhookEventType_WEBHOOK_EVENT_TYPE_JOB_RUN_FAILED && req.Error != nil
+	})).Return(&publishjobrunevent_activity.PublishJobRunEventResponse{}, nil).Once()
+	env.OnActivity(triggerdependentjobs_activity.TriggerDependentJobs, mock.Anything, mock.MatchedBy(func(req *triggerdependentjobs_activity.TriggerDependentJobsRequest) bool {
+		return !req.Succeeded
+	})).Return(&triggerdependentjobs_activity.TriggerDependentJobsResponse{}, nil).Once()
+
+	env.ExecuteWorkflow(Workflow, &WorkflowRequest{JobId: "job-id"})
+
+	assert.True(t, env.IsWorkflowCompleted())
+	assert.Error(t, env.GetWorkflowError())
+
+	env.AssertExpectations(t)
+}
+
+func Test_Workflow_Publishes_Canceled_JobRunEvent(t *testing.T) {
+	testSuite := &testsuite.WorkflowTestSuite{}
+	env := testSuite.NewTestWorkflowEnvironment()
+
+	var genact *genbenthosconfigs_activity.Activity
+	env.OnActivity(genact.GenerateBenthosConfigs, mock.Anything, mock.Anything).
+		After(time.Hour).
+		Return(&genbenthosconfigs_activity.GenerateBenthosConfigsResponse{BenthosConfigs: []*genbenthosconfigs_activity.BenthosConfigResponse{}}, nil)
+	env.OnActivity(publishjobrunevent_activity.PublishJobRunEvent, mock.Anything, mock.MatchedBy(func(req *publishjobrunevent_activity.PublishJobRunEventRequest) bool {
+		return req.EventType == mgmtv1alpha1.WebhookEventType_WEBHOOK_EVENT_TYPE_JOB_RUN_STARTED
+	})).Return(&publishjobrunevent_activity.PublishJobRunEventResponse{}, nil).Once()
+	env.OnActivity(publishjobrunevent_activity.PublishJobRunEvent, mock.Anything, mock.MatchedBy(func(req *publishjobrunevent_activity.PublishJobRunEventRequest) bool {
+		return req.EventType == mgmtv1alpha1.WebhookEventType_WEBHOOK_EVENT_TYPE_JOB_RUN_CANCELED
+	})).Return(&publishjobrunevent_activity.PublishJobRunEventResponse{}, nil).Once()
+	env.RegisterDelayedCallback(env.CancelWorkflow, time.Minute)
+
+	env.ExecuteWorkflow(Workflow, &WorkflowRequest{JobId: "job-id"})
+
+	assert.True(t, env.IsWorkflowCompleted())
+	assert.Error(t, env.GetWorkflowError())
+
+	env.AssertExpectations(t)
+	env.AssertActivityNotCalled(t, "TriggerDependentJobs", mock.Anything, mock.Anything)
+}
+
+func Test_Workflow_Completes_When_Publishing_Events_Fails(t *testing.T) {
+	testSuite := &testsuite.WorkflowTestSuite{}
+	env := testSuite.NewTestWorkflowEnvironment()
+
+	var genact *genbenthosconfigs_activity.Activity
+	env.OnActivity(genact.GenerateBenthosConfigs, mock.Anything, mock.Anything).
+		Return(&genbenthosconfigs_activity.GenerateBenthosConfigsResponse{BenthosConfigs: []*genbenthosconfigs_activity.BenthosConfigResponse{}}, nil)
+	env.OnActivity(publishjobrunevent_activity.PublishJobRunEvent, mock.Anything, mock.Anything).
+		Return(nil, errors.New("unable to reach api"))
+
+	env.ExecuteWorkflow(Workflow, &WorkflowRequest{JobId: "job-id"})
+
+	assert.True(t, env.IsWorkflowCompleted())
+	assert.Nil(t, env.GetWorkflowError())
+}
+
 func Test_Workflow_Follows_Synchronous_DependentFlow(t *testing.T) {
 	testSuite := &testsuite.WorkflowTestSuite{}
 	env := testSuite.NewTestWorkflowEnvironment()
@@ -174,6 +418,9 @@ func Test_Workflow_Follows_Synchronous_DependentFlow(t *testing.T) {
 			return &sync_activity.SyncResponse{}, nil
 		})
 
+	env.OnActivity(validatejobrun_activity.ValidateJobRun, mock.Anything, mock.Anything).
+		Return(&validatejobrun_activity.ValidateJobRunResponse{Skipped: true}, nil)
+
 	env.ExecuteWorkflow(Workflow, &WorkflowRequest{})
 
 	assert.True(t, env.IsWorkflowCompleted())
@@ -282,6 +529,9 @@ func Test_Workflow_Follows_Multiple_Dependents(t *testing.T) {
 			return &sync_activity.SyncResponse{}, nil
 		})
 
+	env.OnActivity(validatejobrun_activity.ValidateJobRun, mock.Anything, mock.Anything).
+		Return(&validatejobrun_activity.ValidateJobRunResponse{Skipped: true}, nil)
+
 	env.ExecuteWorkflow(Workflow, &WorkflowRequest{})
 
 	assert.True(t, env.IsWorkflowCompleted())
@@ -409,6 +659,9 @@ func Test_Workflow_Follows_Multiple_Dependent_Redis_Cleanup(t *testing.T) {
 	env.OnActivity(syncrediscleanup_activity.DeleteRedisHash, mock.Anything, mock.Anything).
 		Return(&syncrediscleanup_activity.DeleteRedisHashResponse{}, nil)
 
+	env.OnActivity(validatejobrun_activity.ValidateJobRun, mock.Anything, mock.Anything).
+		Return(&validatejobrun_activity.ValidateJobRunResponse{Skipped: true}, nil)
+
 	env.ExecuteWorkflow(Workflow, &WorkflowRequest{})
 
 	assert.True(t, env.IsWorkflowCompleted())
@@ -0,0 +1,474 @@
+package previewjobmappings_activity
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+
+	"connectrpc.com/connect"
+	_ "github.com/benthosdev/benthos/v4/public/components/javascript"
+	_ "github.com/benthosdev/benthos/v4/public/components/pure"
+	_ "github.com/benthosdev/benthos/v4/public/components/pure/extended"
+	"github.com/benthosdev/benthos/v4/public/service"
+	"github.com/doug-martin/goqu/v9"
+	mysql_queries "github.com/nucleuscloud/neosync/backend/gen/go/db/dbschemas/mysql"
+	pg_queries "github.com/nucleuscloud/neosync/backend/gen/go/db/dbschemas/postgresql"
+	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
+	"github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1/mgmtv1alpha1connect"
+	dbschemas_utils "github.com/nucleuscloud/neosync/backend/pkg/dbschemas"
+	dbschemas_mssql "github.com/nucleuscloud/neosync/backend/pkg/dbschemas/mssql"
+	dbschemas_mysql "github.com/nucleuscloud/neosync/backend/pkg/dbschemas/mysql"
+	dbschemas_postgres "github.com/nucleuscloud/neosync/backend/pkg/dbschemas/postgres"
+	"github.com/nucleuscloud/neosync/backend/pkg/sqlconnect"
+	neosync_benthos "github.com/nucleuscloud/neosync/worker/internal/benthos"
+	_ "github.com/nucleuscloud/neosync/worker/internal/benthos/transformers"
+	logger_utils "github.com/nucleuscloud/neosync/worker/internal/logger"
+	genbenthosconfigs_activity "github.com/nucleuscloud/neosync/worker/pkg/workflows/datasync/activities/gen-benthos-configs"
+	"github.com/nucleuscloud/neosync/worker/pkg/workflows/datasync/activities/shared"
+	"go.temporal.io/sdk/activity"
+	"go.temporal.io/sdk/log"
+	"gopkg.in/yaml.v3"
+
+	// import the dialect
+	_ "github.com/doug-martin/goqu/v9/dialect/mysql"
+	_ "github.com/doug-martin/goqu/v9/dialect/postgres"
+	_ "github.com/doug-martin/goqu/v9/dialect/sqlserver"
+)
+
+const (
+	defaultRowCount = 10
+
+	postgresDriver  = "postgres"
+	mysqlDriver     = "mysql"
+	sqlserverDriver = "sqlserver"
+)
+
+type Activity struct {
+	connclient        mgmtv1alpha1connect.ConnectionServiceClient
+	transformerclient mgmtv1alpha1connect.TransformersServiceClient
+	useraccountclient mgmtv1alpha1connect.UserAccountServiceClient
+
+	sqlconnector sqlconnect.SqlConnector
+	pgquerier    pg_queries.Querier
+	mysqlquerier mysql_queries.Querier
+	mssqlquerier dbschemas_mssql.Querier
+}
+
+func New(
+	connclient mgmtv1alpha1connect.ConnectionServiceClient,
+	transformerclient mgmtv1alpha1connect.TransformersServiceClient,
+	useraccountclient mgmtv1alpha1connect.UserAccountServiceClient,
+	sqlconnector sqlconnect.SqlConnector,
+) *Activity {
+	return &Activity{
+		connclient:        connclient,
+		transformerclient: transformerclient,
+		useraccountclient: useraccountclient,
+		sqlconnector:      sqlconnector,
+		pgquerier:         pg_queries.New(),
+		mysqlquerier:      mysql_queries.New(),
+		mssqlquerier:      dbschemas_mssql.New(),
+	}
+}
+
+// Pulls a sample of rows from each mapped table of the source connection and runs them through the same processors that a sync would generate.
+// Nothing is written to any destination.
+// The request must contain the mappings and source connection as the job id is resolved by the api before the workflow is started.
+func (a *Activity) PreviewJobMappings(
+	ctx context.Context,
+	req *mgmtv1alpha1.PreviewJobMappingsRequest,
+) (*mgmtv1alpha1.PreviewJobMappingsResponse, error) {
+	logger := log.With(activity.GetLogger(ctx), "accountId", req.GetAccountId())
+	slogger := logger_utils.NewJsonSLogger().With("accountId", req.GetAccountId())
+
+	source := req.GetMappings()
+	if source == nil {
+		return nil, errors.New("job mappings preview must be provided the mappings and source connection")
+	}
+	rowCount := uint(defaultRowCount)
+	if req.RowCount != nil {
+		rowCount = uint(req.GetRowCount())
+	}
+
+	connResp, err := a.connclient.GetConnection(ctx, connect.NewRequest(&mgmtv1alpha1.GetConnectionRequest{
+		Id: source.GetConnectionId(),
+	}))
+	if err != nil {
+		return nil, fmt.Errorf("unable to get connection by id: %w", err)
+	}
+	connection := connResp.Msg.GetConnection()
+	if connection.GetAccountId() != req.GetAccountId() {
+		return nil, fmt.Errorf("connection %s does not belong to account %s", connection.GetId(), req.GetAccountId())
+	}
+
+	driver, groupedSchemas, err := a.getSourceSchema(ctx, connection.GetConnectionConfig(), slogger)
+	if err != nil {
+		return nil, err
+	}
+
+	conn, err := a.sqlconnector.NewDbFromConnectionConfig(connection.GetConnectionConfig(), shared.Ptr(uint32(5)), slogger)
+	if err != nil {
+		return nil, fmt.Errorf("unable to create source sql connection: %w", err)
+	}
+	db, err := conn.Open()
+	if err != nil {
+		return nil, fmt.Errorf("unable to open source sql connection: %w", err)
+	}
+	defer func() {
+		if err := conn.Close(); err != nil {
+			logger.Error(fmt.Sprintf("unable to close source sql connection: %s", err.Error()))
+		}
+	}()
+
+	var transformerKey *string
+	previews := []*mgmtv1alpha1.JobMappingsTablePreview{}
+	for _, tableMapping := range groupMappingsByTable(source.GetMappings()) {
+		key := neosync_benthos.BuildBenthosTable(tableMapping.Schema, tableMapping.Table)
+		colInfo, ok := groupedSchemas[key]
+		if !ok {
+			return nil, fmt.Errorf("table %s does not exist in the source connection", key)
+		}
+		columns := make([]string, 0, len(tableMapping.Mappings))
+		for _, mapping := range tableMapping.Mappings {
+			if _, ok := colInfo[mapping.GetColumn()]; !ok {
+				return nil, fmt.Errorf("column %s does not exist in table %s of the source connection", mapping.GetColumn(), key)
+			}
+			columns = append(columns, mapping.GetColumn())
+		}
+
+		rows, err := getRows(ctx, db, driver, tableMapping.Schema, tableMapping.Table, columns, rowCount)
+		if err != nil {
+			return nil, fmt.Errorf("unable to read rows from table %s: %w", key, err)
+		}
+
+		processors, err := genbenthosconfigs_activity.BuildTableProcessorConfigs(ctx, a.transformerclient, tableMapping.Mappings, colInfo)
+		if err != nil {
+			return nil, err
+		}
+		processorYaml, err := toProcessorYaml(processors)
+		if err != nil {
+			return nil, err
+		}
+		if transformerKey == nil && strings.Contains(strings.Join(processorYaml, "\n"), fmt.Sprintf("${%s}", shared.TransformerKeyEnvVarKey)) {
+			keyResp, err := a.useraccountclient.GetAccountTransformerKey(ctx, connect.NewRequest(&mgmtv1alpha1.GetAccountTransformerKeyRequest{
+				AccountId: req.GetAccountId(),
+			}))
+			if err != nil {
+				return nil, fmt.Errorf("unable to retrieve account transformer key: %w", err)
+			}
+			transformerKey = &keyResp.Msg.Key
+		}
+
+		transformed, err := transformRows(ctx, processorYaml, transformerKey, rows, slogger)
+		if err != nil {
+			return nil, fmt.Errorf("unable to transform rows of table %s: %w", key, err)
+		}
+
+		preview, err := buildTablePreview(tableMapping, columns, rows, transformed)
+		if err != nil {
+			return nil, err
+		}
+		previews = append(previews, preview)
+	}
+	logger.Info(fmt.Sprintf("previewed job mappings of %d tables", len(previews)))
+	return &mgmtv1alpha1.PreviewJobMappingsResponse{Tables: previews}, nil
+}
+
+func (a *Activity) getSourceSchema(
+	ctx context.Context,
+	connectionConfig *mgmtv1alpha1.ConnectionConfig,
+	slogger *slog.Logger,
+) (string, map[string]map[string]*dbschemas_utils.ColumnInfo, error) {
+	switch config := connectionConfig.GetConfig().(type) {
+	case *mgmtv1alpha1.ConnectionConfig_PgConfig:
+		pgconn, err := a.sqlconnector.NewPgPoolFromConnectionConfig(config.PgConfig, shared.Ptr(uint32(5)), slogger)
+		if err != nil {
+			return "", nil, fmt.Errorf("unable to create new postgres pool from connection config: %w", err)
+		}
+		pool, err := pgconn.Open(ctx)
+		if err != nil {
+			return "", nil, fmt.Errorf("unable to open postgres connection: %w", err)
+		}
+		defer pgconn.Close()
+		dbschemas, err := a.pgquerier.GetDatabaseSchema(ctx, pool)
+		if err != nil {
+			return "", nil, fmt.Errorf("unable to get database schema for postgres connection: %w", err)
+		}
+		return postgresDriver, dbschemas_postgres.GetUniqueSchemaColMappings(dbschemas), nil
+	case *mgmtv1alpha1.ConnectionConfig_MysqlConfig, *mgmtv1alpha1.ConnectionConfig_MssqlConfig:
+		conn, err := a.sqlconnector.NewDbFromConnectionConfig(connectionConfig, shared.Ptr(uint32(5)), slogger)
+		if err != nil {
+			return "", nil, fmt.Errorf("unable to create source sql connection: %w", err)
+		}
+		db, err := conn.Open()
+		if err != nil {
+			return "", nil, fmt.Errorf("unable to open source sql connection: %w", err)
+		}
+		defer conn.Close()
+		if connectionConfig.GetMysqlConfig() != nil {
+			dbschemas, err := a.mysqlquerier.GetDatabaseSchema(ctx, db)
+			if err != nil {
+				return "", nil, fmt.Errorf("unable to get database schema for mysql connection: %w", err)
+			}
+			return mysqlDriver, dbschemas_mysql.GetUniqueSchemaColMappings(dbschemas), nil
+		}
+		dbschemas, err := a.mssqlquerier.GetDatabaseSchema(ctx, db)
+		if err != nil {
+			return "", nil, fmt.Errorf("unable to get database schema for mssql connection: %w", err)
+		}
+		return sqlserverDriver, dbschemas_mssql.GetUniqueSchemaColMappings(dbschemas), nil
+	default:
+		return "", nil, fmt.Errorf("job mappings preview is not supported for this connection type: %T", config)
+	}
+}
+
+type tableMapping struct {
+	Schema   string
+	Table    string
+	Mappings []*mgmtv1alpha1.JobMapping
+}
+
+func groupMappingsByTable(mappings []*mgmtv1alpha1.JobMapping) []*tableMapping {
+	output := []*tableMapping{}
+	indexes := map[string]int{}
+	for _, mapping := range mappings {
+		key := neosync_benthos.BuildBenthosTable(mapping.GetSchema(), mapping.GetTable())
+		idx, ok := indexes[key]
+		if !ok {
+			idx = len(output)
+			indexes[key] = idx
+			output = append(output, &tableMapping{Schema: mapping.GetSchema(), Table: mapping.GetTable()})
+		}
+		output[idx].Mappings = append(output[idx].Mappings, mapping)
+	}
+	return output
+}
+
+func getRows(
+	ctx context.Context,
+	db sqlconnect.SqlDBTX,
+	driver, schema, table string,
+	columns []string,
+	rowCount uint,
+) ([]map[string]any, error) {
+	selectCols := make([]any, 0, len(columns))
+	for _, col := range columns {
+		selectCols = append(selectCols, goqu.C(col))
+	}
+	query, args, err := goqu.Dialect(driver).From(goqu.S(schema).Table(table)).Select(selectCols...).Limit(rowCount).Prepared(true).ToSQL()
+	if err != nil {
+		return nil, err
+	}
+	rows, err := db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	output := []map[string]any{}
+	for rows.Next() {
+		values := make([]any, len(columns))
+		valuesWrapped := make([]any, 0, len(columns))
+		for i := range values {
+			valuesWrapped = append(valuesWrapped, &values[i])
+		}
+		if err := rows.Scan(valuesWrapped...); err != nil {
+			return nil, err
+		}
+		row := map[string]any{}
+		for i, v := range values {
+			// matches the sql_raw input so that the processors receive the same types as during a sync
+			if b, ok := v.([]byte); ok {
+				row[columns[i]] = string(b)
+			} else {
+				row[columns[i]] = v
+			}
+		}
+		output = append(output, row)
+	}
+	return output, rows.Err()
+}
+
+// The trailing catch processor stops a sync activity on the first error.
+// It is dropped so that the underlying processor error is returned instead.
+func toProcessorYaml(processors []*neosync_benthos.ProcessorConfig) ([]string, error) {
+	output := []string{}
+	for _, processor := range processors {
+		if processor.Catch != nil {
+			continue
+		}
+		processorYaml, err := yaml.Marshal(processor)
+		if err != nil {
+			return nil, fmt.Errorf("unable to convert processors to yaml: %w", err)
+		}
+		output = append(output, string(processorYaml))
+	}
+	return output, nil
+}
+
+// Runs the rows through a benthos stream that only contains the given processors and returns them in the same order.
+func transformRows(
+	ctx context.Context,
+	processorYaml []string,
+	transformerKey *string,
+	rows []map[string]any,
+	slogger *slog.Logger,
+) ([]map[string]any, error) {
+	if len(processorYaml) == 0 || len(rows) == 0 {
+		return rows, nil
+	}
+
+	streambldr := service.NewEnvironment().NewStreamBuilder()
+	streambldr.SetLogger(slogger.With(
+		"benthos", "true",
+	))
+	produce, err := streambldr.AddBatchProducerFunc()
+	if err != nil {
+		return nil, fmt.Errorf("unable to add producer to benthos stream: %w", err)
+	}
+	if transformerKey != nil {
+		// This must come before AddProcessorYAML as otherwise it will not be invoked
+		streambldr.SetEnvVarLookupFunc(func(key string) (string, bool) {
+			if key == shared.TransformerKeyEnvVarKey {
+				return *transformerKey, true
+			}
+			return "", false
+		})
+	}
+	for _, processor := range processorYaml {
+		if err := streambldr.AddProcessorYAML(processor); err != nil {
+			return nil, fmt.Errorf("unable to add processors to benthos stream: %w", err)
+		}
+	}
+	var output []map[string]any
+	var outputErr error
+	err = streambldr.AddBatchConsumerFunc(func(ctx context.Context, batch service.MessageBatch) error {
+		output, outputErr = fromMessageBatch(batch)
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("unable to add consumer to benthos stream: %w", err)
+	}
+	stream, err := streambldr.Build()
+	if err != nil {
+		return nil, fmt.Errorf("unable to build benthos stream: %w", err)
+	}
+
+	streamErrChan := make(chan error, 1)
+	go func() {
+		streamErrChan <- stream.Run(ctx)
+	}()
+	batch := make(service.MessageBatch, 0, len(rows))
+	for _, row := range rows {
+		msg := service.NewMessage(nil)
+		msg.SetStructured(row)
+		batch = append(batch, msg)
+	}
+	produceErr := produce(ctx, batch)
+	if err := stream.StopWithin(5 * time.Second); err != nil {
+		slogger.Error(fmt.Sprintf("unable to stop benthos stream: %s", err.Error()))
+	}
+	if err := <-streamErrChan; err != nil && !errors.Is(err, context.Canceled) {
+		return nil, fmt.Errorf("unable to run benthos stream: %w", err)
+	}
+	if produceErr != nil {
+		return nil, produceErr
+	}
+	if outputErr != nil {
+		return nil, outputErr
+	}
+	if len(output) != len(rows) {
+		return nil, fmt.Errorf("expected %d transformed rows, received %d", len(rows), len(output))
+	}
+	return output, nil
+}
+
+func fromMessageBatch(batch service.MessageBatch) ([]map[string]any, error) {
+	output := make([]map[string]any, 0, len(batch))
+	for _, msg := range batch {
+		if err := msg.GetError(); err != nil {
+			return nil, err
+		}
+		structured, err := msg.AsStructured()
+		if err != nil {
+			return nil, fmt.Errorf("unable to read transformed row: %w", err)
+		}
+		row, ok := structured.(map[string]any)
+		if !ok {
+			return nil, fmt.Errorf("transformed row must be an object, received: %T", structured)
+		}
+		output = append(output, row)
+	}
+	return output, nil
+}
+
+// Columns that use the database default are never written by a sync so they are left out of the transformed row.
+func buildTablePreview(
+	table *tableMapping,
+	columns []string,
+	before, after []map[string]any,
+) (*mgmtv1alpha1.JobMappingsTablePreview, error) {
+	defaultCols := map[string]struct{}{}
+	for _, mapping := range table.Mappings {
+		if mapping.GetTransformer().GetSource() == mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_GENERATE_DEFAULT {
+			defaultCols[mapping.GetColumn()] = struct{}{}
+		}
+	}
+
+	rows := make([]*mgmtv1alpha1.JobMappingsPreviewRow, 0, len(before))
+	for idx := range before {
+		row := &mgmtv1alpha1.JobMappingsPreviewRow{
+			Before: map[string][]byte{},
+			After:  map[string][]byte{},
+		}
+		for _, col := range columns {
+			value, err := toBytes(before[idx][col])
+			if err != nil {
+				return nil, err
+			}
+			if value != nil {
+				row.Before[col] = value
+			}
+			if _, ok := defaultCols[col]; ok {
+				continue
+			}
+			value, err = toBytes(after[idx][col])
+			if err != nil {
+				return nil, err
+			}
+			if value != nil {
+				row.After[col] = value
+			}
+		}
+		rows = append(rows, row)
+	}
+	return &mgmtv1alpha1.JobMappingsTablePreview{
+		Schema:  table.Schema,
+		Table:   table.Table,
+		Columns: columns,
+		Rows:    rows,
+	}, nil
+}
+
+func toBytes(value any) ([]byte, error) {
+	switch v := value.(type) {
+	case nil:
+		return nil, nil
+	case string:
+		return []byte(v), nil
+	case []byte:
+		return v, nil
+	case time.Time:
+		return []byte(v.Format(time.RFC3339Nano)), nil
+	default:
+		bits, err := json.Marshal(v)
+		if err != nil {
+			return nil, fmt.Errorf("unable to convert value of type %T to bytes: %w", v, err)
+		}
+		return bits, nil
+	}
+}
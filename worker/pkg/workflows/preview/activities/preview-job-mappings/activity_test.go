@@ -0,0 +1,299 @@
+package previewjobmappings_activity
+
+import (
+	"context"
+	"log/slog"
+	"regexp"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/DATA-DOG/go-sqlmock"
+	mysql_queries "github.com/nucleuscloud/neosync/backend/gen/go/db/dbschemas/mysql"
+	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
+	"github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1/mgmtv1alpha1connect"
+	"github.com/nucleuscloud/neosync/backend/pkg/sqlconnect"
+	neosync_benthos "github.com/nucleuscloud/neosync/worker/internal/benthos"
+	"github.com/nucleuscloud/neosync/worker/pkg/workflows/datasync/activities/shared"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.temporal.io/sdk/testsuite"
+)
+
+const (
+	mockAccountId    = "6cee4a1a-8e04-4bd9-bc0c-4e4dc8ee7dba"
+	mockConnectionId = "6d1a6bc8-f5a0-4b2e-9e8e-92e3ad2ac7f1"
+)
+
+func Test_PreviewJobMappings_Mysql(t *testing.T) {
+	mockConnectionClient := mgmtv1alpha1connect.NewMockConnectionServiceClient(t)
+	mockTransformerClient := mgmtv1alpha1connect.NewMockTransformersServiceClient(t)
+	mockUserAccountClient := mgmtv1alpha1connect.NewMockUserAccountServiceClient(t)
+	mockSqlConnector := sqlconnect.NewMockSqlConnector(t)
+	mockContainer := sqlconnect.NewMockSqlDbContainer(t)
+	mockMysqlQuerier := mysql_queries.NewMockQuerier(t)
+
+	db, dbMock, err := sqlmock.New(sqlmock.MonitorPingsOption(false))
+	require.NoError(t, err)
+
+	connectionConfig := &mgmtv1alpha1.ConnectionConfig{
+		Config: &mgmtv1alpha1.ConnectionConfig_MysqlConfig{MysqlConfig: &mgmtv1alpha1.MysqlConnectionConfig{}},
+	}
+	mockConnectionClient.On("GetConnection", mock.Anything, mock.Anything).
+		Return(connect.NewResponse(&mgmtv1alpha1.GetConnectionResponse{
+			Connection: &mgmtv1alpha1.Connection{Id: mockConnectionId, AccountId: mockAccountId, ConnectionConfig: connectionConfig},
+		}), nil)
+	mockSqlConnector.On("NewDbFromConnectionConfig", connectionConfig, mock.Anything, mock.Anything).Return(mockContainer, nil)
+	mockContainer.On("Open").Return(db, nil)
+	mockContainer.On("Close").Return(nil)
+	mockMysqlQuerier.On("GetDatabaseSchema", mock.Anything, mock.Anything).
+		Return([]*mysql_queries.GetDatabaseSchemaRow{
+			{TableSchema: "public", TableName: "users", ColumnName: "id", DataType: "int"},
+			{TableSchema: "public", TableName: "users", ColumnName: "name", DataType: "varchar"},
+			{TableSchema: "public", TableName: "users", ColumnName: "email", DataType: "varchar"},
+			{TableSchema: "public", TableName: "users", ColumnName: "created_at", DataType: "datetime"},
+		}, nil)
+
+	dbMock.ExpectQuery(regexp.QuoteMeta("SELECT `id`, `name`, `email`, `created_at` FROM `public`.`users` LIMIT ?")).
+		WithArgs(2).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "name", "email", "created_at"}).
+			AddRow(int64(1), []byte("nick"), []byte("nick@example.com"), []byte("2024-01-01 00:00:00")).
+			AddRow(int64(2), []byte("alisha"), nil, []byte("2024-01-02 00:00:00")))
+
+	activity := &Activity{
+		connclient:        mockConnectionClient,
+		transformerclient: mockTransformerClient,
+		useraccountclient: mockUserAccountClient,
+		sqlconnector:      mockSqlConnector,
+		mysqlquerier:      mockMysqlQuerier,
+	}
+	testSuite := &testsuite.WorkflowTestSuite{}
+	env := testSuite.NewTestActivityEnvironment()
+	env.RegisterActivity(activity.PreviewJobMappings)
+
+	val, err := env.ExecuteActivity(activity.PreviewJobMappings, &mgmtv1alpha1.PreviewJobMappingsRequest{
+		AccountId: mockAccountId,
+		Source: &mgmtv1alpha1.PreviewJobMappingsRequest_Mappings{
+			Mappings: &mgmtv1alpha1.JobMappingsPreviewSource{
+				ConnectionId: mockConnectionId,
+				Mappings: []*mgmtv1alpha1.JobMapping{
+					{Schema: "public", Table: "users", Column: "id", Transformer: &mgmtv1alpha1.JobMappingTransformer{
+						Source: mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_PASSTHROUGH,
+					}},
+					{Schema: "public", Table: "users", Column: "name", Transformer: &mgmtv1alpha1.JobMappingTransformer{
+						Source: mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_TRANSFORM_JAVASCRIPT,
+						Config: &mgmtv1alpha1.TransformerConfig{
+							Config: &mgmtv1alpha1.TransformerConfig_TransformJavascriptConfig{
+								TransformJavascriptConfig: &mgmtv1alpha1.TransformJavascript{Code: `return value.toUpperCase();`},
+							},
+						},
+					}},
+					{Schema: "public", Table: "users", Column: "email", Transformer: &mgmtv1alpha1.JobMappingTransformer{
+						Source: mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_GENERATE_NULL,
+						Config: &mgmtv1alpha1.TransformerConfig{
+							Config: &mgmtv1alpha1.TransformerConfig_Nullconfig{Nullconfig: &mgmtv1alpha1.Null{}},
+						},
+					}},
+					{Schema: "public", Table: "users", Column: "created_at", Transformer: &mgmtv1alpha1.JobMappingTransformer{
+						Source: mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_GENERATE_DEFAULT,
+						Config: &mgmtv1alpha1.TransformerConfig{
+							Config: &mgmtv1alpha1.TransformerConfig_GenerateDefaultConfig{GenerateDefaultConfig: &mgmtv1alpha1.GenerateDefault{}},
+						},
+					}},
+				},
+			},
+		},
+		RowCount: shared.Ptr(uint32(2)),
+	})
+	require.NoError(t, err)
+	var resp *mgmtv1alpha1.PreviewJobMappingsResponse
+	require.NoError(t, val.Get(&resp))
+
+	require.Len(t, resp.GetTables(), 1)
+	table := resp.GetTables()[0]
+	assert.Equal(t, "public", table.GetSchema())
+	assert.Equal(t, "users", table.GetTable())
+	assert.Equal(t, []string{"id", "name", "email", "created_at"}, table.GetColumns())
+	require.Len(t, table.GetRows(), 2)
+
+	assert.Equal(t, map[string][]byte{
+		"id":         []byte("1"),
+		"name":       []byte("nick"),
+		"email":      []byte("nick@example.com"),
+		"created_at": []byte("2024-01-01 00:00:00"),
+	}, table.GetRows()[0].GetBefore())
+	assert.Equal(t, map[string][]byte{
+		"id":   []byte("1"),
+		"name": []byte("NICK"),
+	}, table.GetRows()[0].GetAfter())
+
+	assert.Equal(t, map[string][]byte{
+		"id":         []byte("2"),
+		"name":       []byte("alisha"),
+		"created_at": []byte("2024-01-02 00:00:00"),
+	}, table.GetRows()[1].GetBefore())
+	assert.Equal(t, map[string][]byte{
+		"id":   []byte("2"),
+		"name": []byte("ALISHA"),
+	}, table.GetRows()[1].GetAfter())
+
+	require.NoError(t, dbMock.ExpectationsWereMet())
+	mockUserAccountClient.AssertNotCalled(t, "GetAccountTransformerKey", mock.Anything, mock.Anything)
+}
+
+func Test_PreviewJobMappings_Unknown_Column(t *testing.T) {
+	mockConnectionClient := mgmtv1alpha1connect.NewMockConnectionServiceClient(t)
+	mockSqlConnector := sqlconnect.NewMockSqlConnector(t)
+	mockContainer := sqlconnect.NewMockSqlDbContainer(t)
+	mockMysqlQuerier := mysql_queries.NewMockQuerier(t)
+
+	db, _, err := sqlmock.New(sqlmock.MonitorPingsOption(false))
+	require.NoError(t, err)
+
+	connectionConfig := &mgmtv1alpha1.ConnectionConfig{
+		Config: &mgmtv1alpha1.ConnectionConfig_MysqlConfig{MysqlConfig: &mgmtv1alpha1.MysqlConnectionConfig{}},
+	}
+	mockConnectionClient.On("GetConnection", mock.Anything, mock.Anything).
+		Return(connect.NewResponse(&mgmtv1alpha1.GetConnectionResponse{
+			Connection: &mgmtv1alpha1.Connection{Id: mockConnectionId, AccountId: mockAccountId, ConnectionConfig: connectionConfig},
+		}), nil)
+	mockSqlConnector.On("NewDbFromConnectionConfig", connectionConfig, mock.Anything, mock.Anything).Return(mockContainer, nil)
+	mockContainer.On("Open").Return(db, nil)
+	mockContainer.On("Close").Return(nil)
+	mockMysqlQuerier.On("GetDatabaseSchema", mock.Anything, mock.Anything).
+		Return([]*mysql_queries.GetDatabaseSchemaRow{
+			{TableSchema: "public", TableName: "users", ColumnName: "id", DataType: "int"},
+		}, nil)
+
+	activity := &Activity{
+		connclient:   mockConnectionClient,
+		sqlconnector: mockSqlConnector,
+		mysqlquerier: mockMysqlQuerier,
+	}
+	testSuite := &testsuite.WorkflowTestSuite{}
+	env := testSuite.NewTestActivityEnvironment()
+	env.RegisterActivity(activity.PreviewJobMappings)
+
+	_, err = env.ExecuteActivity(activity.PreviewJobMappings, &mgmtv1alpha1.PreviewJobMappingsRequest{
+		AccountId: mockAccountId,
+		Source: &mgmtv1alpha1.PreviewJobMappingsRequest_Mappings{
+			Mappings: &mgmtv1alpha1.JobMappingsPreviewSource{
+				ConnectionId: mockConnectionId,
+				Mappings:     []*mgmtv1alpha1.JobMapping{{Schema: "public", Table: "users", Column: "name"}},
+			},
+		},
+	})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "column name does not exist in table public.users")
+}
+
+func Test_PreviewJobMappings_Other_Account_Connection(t *testing.T) {
+	mockConnectionClient := mgmtv1alpha1connect.NewMockConnectionServiceClient(t)
+	mockConnectionClient.On("GetConnection", mock.Anything, mock.Anything).
+		Return(connect.NewResponse(&mgmtv1alpha1.GetConnectionResponse{
+			Connection: &mgmtv1alpha1.Connection{Id: mockConnectionId, AccountId: "other-account"},
+		}), nil)
+
+	activity := &Activity{connclient: mockConnectionClient}
+	testSuite := &testsuite.WorkflowTestSuite{}
+	env := testSuite.NewTestActivityEnvironment()
+	env.RegisterActivity(activity.PreviewJobMappings)
+
+	_, err := env.ExecuteActivity(activity.PreviewJobMappings, &mgmtv1alpha1.PreviewJobMappingsRequest{
+		AccountId: mockAccountId,
+		Source: &mgmtv1alpha1.PreviewJobMappingsRequest_Mappings{
+			Mappings: &mgmtv1alpha1.JobMappingsPreviewSource{
+				ConnectionId: mockConnectionId,
+				Mappings:     []*mgmtv1alpha1.JobMapping{{Schema: "public", Table: "users", Column: "id"}},
+			},
+		},
+	})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "does not belong to account")
+}
+
+func Test_groupMappingsByTable(t *testing.T) {
+	output := groupMappingsByTable([]*mgmtv1alpha1.JobMapping{
+		{Schema: "public", Table: "users", Column: "id"},
+		{Schema: "public", Table: "orders", Column: "id"},
+		{Schema: "public", Table: "users", Column: "name"},
+	})
+	require.Len(t, output, 2)
+	assert.Equal(t, "users", output[0].Table)
+	assert.Len(t, output[0].Mappings, 2)
+	assert.Equal(t, "orders", output[1].Table)
+	assert.Len(t, output[1].Mappings, 1)
+}
+
+func Test_toProcessorYaml_Drops_Catch(t *testing.T) {
+	mutation := `root."id" = 1`
+	output, err := toProcessorYaml([]*neosync_benthos.ProcessorConfig{
+		{Mutation: &mutation},
+		{Catch: []*neosync_benthos.ProcessorConfig{{Error: &neosync_benthos.ErrorProcessorConfig{}}}},
+	})
+	require.NoError(t, err)
+	require.Len(t, output, 1)
+	assert.Contains(t, output[0], "mutation")
+}
+
+func Test_transformRows(t *testing.T) {
+	mutation := `root."name" = this.name.uppercase()`
+	processorYaml, err := toProcessorYaml([]*neosync_benthos.ProcessorConfig{{Mutation: &mutation}})
+	require.NoError(t, err)
+
+	output, err := transformRows(context.Background(), processorYaml, nil, []map[string]any{
+		{"id": 1, "name": "nick"},
+		{"id": 2, "name": "alisha"},
+	}, slog.Default())
+	require.NoError(t, err)
+	require.Len(t, output, 2)
+	assert.Equal(t, "NICK", output[0]["name"])
+	assert.Equal(t, "ALISHA", output[1]["name"])
+}
+
+func Test_transformRows_TransformerKey(t *testing.T) {
+	mutation := `root."key" = "${NEOSYNC_TRANSFORMER_KEY}"`
+	processorYaml, err := toProcessorYaml([]*neosync_benthos.ProcessorConfig{{Mutation: &mutation}})
+	require.NoError(t, err)
+
+	output, err := transformRows(context.Background(), processorYaml, shared.Ptr("secret"), []map[string]any{{"id": 1}}, slog.Default())
+	require.NoError(t, err)
+	require.Len(t, output, 1)
+	assert.Equal(t, "secret", output[0]["key"])
+}
+
+func Test_transformRows_Error(t *testing.T) {
+	mutation := `root."name" = this.name.number()`
+	processorYaml, err := toProcessorYaml([]*neosync_benthos.ProcessorConfig{{Mutation: &mutation}})
+	require.NoError(t, err)
+
+	_, err = transformRows(context.Background(), processorYaml, nil, []map[string]any{{"name": "nick"}}, slog.Default())
+	require.Error(t, err)
+}
+
+func Test_toBytes(t *testing.T) {
+	value, err := toBytes(nil)
+	require.NoError(t, err)
+	assert.Nil(t, value)
+
+	value, err = toBytes("nick")
+	require.NoError(t, err)
+	assert.Equal(t, []byte("nick"), value)
+
+	value, err = toBytes(int64(10))
+	require.NoError(t, err)
+	assert.Equal(t, []byte("10"), value)
+
+	value, err = toBytes(true)
+	require.NoError(t, err)
+	assert.Equal(t, []byte("true"), value)
+
+	value, err = toBytes(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC))
+	require.NoError(t, err)
+	assert.Equal(t, []byte("2024-01-01T00:00:00Z"), value)
+
+	value, err = toBytes(map[string]any{"a": 1})
+	require.NoError(t, err)
+	assert.Equal(t, []byte(`{"a":1}`), value)
+}
@@ -0,0 +1,36 @@
+package preview_workflow
+
+import (
+	"time"
+
+	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
+	previewjobmappings_activity "github.com/nucleuscloud/neosync/worker/pkg/workflows/preview/activities/preview-job-mappings"
+	"go.temporal.io/sdk/log"
+	"go.temporal.io/sdk/temporal"
+	"go.temporal.io/sdk/workflow"
+)
+
+// Short lived workflow that is executed synchronously by the api to preview a set of job mappings.
+// The request must contain the mappings and source connection as the job id is resolved by the api.
+func PreviewWorkflow(wfctx workflow.Context, req *mgmtv1alpha1.PreviewJobMappingsRequest) (*mgmtv1alpha1.PreviewJobMappingsResponse, error) {
+	logger := log.With(workflow.GetLogger(wfctx), "accountId", req.GetAccountId())
+	logger.Info("preview workflow starting")
+
+	ctx := workflow.WithActivityOptions(wfctx, workflow.ActivityOptions{
+		StartToCloseTimeout: 2 * time.Minute,
+		RetryPolicy: &temporal.RetryPolicy{
+			// the caller is waiting on the result so failures are returned immediately
+			MaximumAttempts: 1,
+		},
+	})
+
+	var previewActivity *previewjobmappings_activity.Activity
+	var resp *mgmtv1alpha1.PreviewJobMappingsResponse
+	logger.Info("scheduling PreviewJobMappings for execution.")
+	err := workflow.ExecuteActivity(ctx, previewActivity.PreviewJobMappings, req).Get(ctx, &resp)
+	if err != nil {
+		return nil, err
+	}
+	logger.Info("preview workflow completed")
+	return resp, nil
+}
@@ -0,0 +1,69 @@
+package preview_workflow
+
+import (
+	"errors"
+	"testing"
+
+	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
+	previewjobmappings_activity "github.com/nucleuscloud/neosync/worker/pkg/workflows/preview/activities/preview-job-mappings"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.temporal.io/sdk/testsuite"
+)
+
+func Test_PreviewWorkflow(t *testing.T) {
+	testSuite := &testsuite.WorkflowTestSuite{}
+	env := testSuite.NewTestWorkflowEnvironment()
+
+	req := &mgmtv1alpha1.PreviewJobMappingsRequest{
+		AccountId: "account-id",
+		Source: &mgmtv1alpha1.PreviewJobMappingsRequest_Mappings{
+			Mappings: &mgmtv1alpha1.JobMappingsPreviewSource{
+				ConnectionId: "connection-id",
+				Mappings:     []*mgmtv1alpha1.JobMapping{{Schema: "public", Table: "users", Column: "id"}},
+			},
+		},
+	}
+	expected := &mgmtv1alpha1.PreviewJobMappingsResponse{
+		Tables: []*mgmtv1alpha1.JobMappingsTablePreview{{
+			Schema:  "public",
+			Table:   "users",
+			Columns: []string{"id"},
+			Rows: []*mgmtv1alpha1.JobMappingsPreviewRow{{
+				Before: map[string][]byte{"id": []byte("1")},
+				After:  map[string][]byte{"id": []byte("2")},
+			}},
+		}},
+	}
+
+	var previewActivity *previewjobmappings_activity.Activity
+	env.OnActivity(previewActivity.PreviewJobMappings, mock.Anything, mock.Anything).Return(expected, nil).Once()
+
+	env.ExecuteWorkflow(PreviewWorkflow, req)
+
+	assert.True(t, env.IsWorkflowCompleted())
+	require.NoError(t, env.GetWorkflowError())
+	var resp *mgmtv1alpha1.PreviewJobMappingsResponse
+	require.NoError(t, env.GetWorkflowResult(&resp))
+	require.Len(t, resp.GetTables(), 1)
+	assert.Equal(t, []string{"id"}, resp.GetTables()[0].GetColumns())
+	assert.Equal(t, []byte("2"), resp.GetTables()[0].GetRows()[0].GetAfter()["id"])
+
+	env.AssertExpectations(t)
+}
+
+func Test_PreviewWorkflow_Does_Not_Retry(t *testing.T) {
+	testSuite := &testsuite.WorkflowTestSuite{}
+	env := testSuite.NewTestWorkflowEnvironment()
+
+	var previewActivity *previewjobmappings_activity.Activity
+	env.OnActivity(previewActivity.PreviewJobMappings, mock.Anything, mock.Anything).Return(nil, errors.New("oh no")).Once()
+
+	env.ExecuteWorkflow(PreviewWorkflow, &mgmtv1alpha1.PreviewJobMappingsRequest{AccountId: "account-id"})
+
+	assert.True(t, env.IsWorkflowCompleted())
+	require.Error(t, env.GetWorkflowError())
+
+	env.AssertExpectations(t)
+}